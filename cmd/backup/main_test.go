@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/backup"
+)
+
+func writeTestArchive(t *testing.T, rows int64) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "mud.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	w := backup.NewWriter(f, 90)
+	require.NoError(t, w.AddTable("accounts", []string{"id", "username"}, func(out io.Writer) (int64, error) {
+		_, err := io.WriteString(out, "id,username\n1,vex\n")
+		return rows, err
+	}))
+	_, err = w.Close()
+	require.NoError(t, err)
+	return path
+}
+
+func TestRun_VerifyIntactArchive(t *testing.T) {
+	path := writeTestArchive(t, 1)
+	var out bytes.Buffer
+	require.NoError(t, run(context.Background(), []string{"verify", "-in", path}, &out))
+	assert.Contains(t, out.String(), "accounts  1")
+	assert.Contains(t, out.String(), "is intact: 1 tables at migration 90")
+}
+
+func TestRun_VerifyReportsMismatch(t *testing.T) {
+	path := writeTestArchive(t, 5)
+	err := run(context.Background(), []string{"verify", "-in", path}, io.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "accounts has 1 rows, manifest says 5")
+}
+
+func TestRun_RejectsBadUsage(t *testing.T) {
+	ctx := context.Background()
+	assert.Error(t, run(ctx, nil, io.Discard))
+	assert.ErrorContains(t, run(ctx, []string{"dump"}, io.Discard), `unknown command "dump"`)
+	assert.ErrorContains(t, run(ctx, []string{"verify"}, io.Discard), "-in is required")
+	assert.ErrorContains(t, run(ctx, []string{"restore", "-dry-run"}, io.Discard), "-in is required")
+}
@@ -0,0 +1,221 @@
+// Command charactertool exports a character — its stats, progression,
+// inventory, equipment, and quests — as JSON, and imports such a file into
+// another environment, so a player-reported bug can be reproduced locally
+// with the player's exact character.
+//
+//	charactertool export -config configs/prod.yaml -character Vex -out vex.json
+//	charactertool import -config configs/dev.yaml -in vex.json -account alice [-name VexCopy]
+//
+// Export reads what the database holds, so an online character's unsaved
+// session state is missing; the in-game exportchar command saves the session
+// first. Import creates a new character and never touches existing ones.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/cliactor"
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+const usage = "usage: charactertool export|import [flags]; run charactertool <command> -h for flags"
+
+// exportOptions are the parsed flags of the export command.
+type exportOptions struct {
+	configPath string
+	character  string
+	out        string
+}
+
+// importOptions are the parsed flags of the import command.
+type importOptions struct {
+	configPath string
+	in         string
+	account    string
+	name       string
+}
+
+// parseExportFlags parses and validates the export command's args.
+func parseExportFlags(args []string) (exportOptions, error) {
+	fset := flag.NewFlagSet("charactertool export", flag.ContinueOnError)
+	var o exportOptions
+	fset.StringVar(&o.configPath, "config", "configs/dev.yaml", "path to configuration file")
+	fset.StringVar(&o.character, "character", "", "name of the character to export")
+	fset.StringVar(&o.out, "out", "", "file to write (default standard output)")
+	if err := fset.Parse(args); err != nil {
+		return exportOptions{}, err
+	}
+	if o.character == "" {
+		return exportOptions{}, errors.New("-character is required")
+	}
+	return o, nil
+}
+
+// parseImportFlags parses and validates the import command's args.
+func parseImportFlags(args []string) (importOptions, error) {
+	fset := flag.NewFlagSet("charactertool import", flag.ContinueOnError)
+	var o importOptions
+	fset.StringVar(&o.configPath, "config", "configs/dev.yaml", "path to configuration file")
+	fset.StringVar(&o.in, "in", "", "exported character file to read")
+	fset.StringVar(&o.account, "account", "", "username of the account to own the imported character")
+	fset.StringVar(&o.name, "name", "", "name for the imported character (default the exported name)")
+	if err := fset.Parse(args); err != nil {
+		return importOptions{}, err
+	}
+	if o.in == "" || o.account == "" {
+		return importOptions{}, errors.New("-in and -account are required")
+	}
+	return o, nil
+}
+
+// run is the testable entry point, accepting CLI args directly.
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+	switch args[0] {
+	case "export":
+		o, err := parseExportFlags(args[1:])
+		if err != nil {
+			return err
+		}
+		return runExport(o, out)
+	case "import":
+		o, err := parseImportFlags(args[1:])
+		if err != nil {
+			return err
+		}
+		return runImport(o, out)
+	default:
+		return fmt.Errorf("unknown command %q; %s", args[0], usage)
+	}
+}
+
+// runExport writes the named character as indented JSON to o.out, or to out
+// when o.out is empty.
+func runExport(o exportOptions, out io.Writer) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	pool, err := connect(ctx, o.configPath)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	chars := postgres.NewCharacterRepository(pool.DB())
+	charID, err := chars.GetIDByName(ctx, o.character)
+	if err != nil {
+		return fmt.Errorf("looking up character %q: %w", o.character, err)
+	}
+	exp, err := chars.ExportCharacter(ctx, charID)
+	recordAudit(ctx, pool, "exportchar", o.character, map[string]any{"characterID": charID}, err)
+	if err != nil {
+		return fmt.Errorf("exporting %s: %w", o.character, err)
+	}
+	data, err := json.MarshalIndent(exp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", o.character, err)
+	}
+	data = append(data, '\n')
+	if o.out == "" {
+		_, err = out.Write(data)
+		return err
+	}
+	if err := os.WriteFile(o.out, data, 0o600); err != nil {
+		return fmt.Errorf("writing %s: %w", o.out, err)
+	}
+	fmt.Fprintf(out, "exported %s (#%d) to %s\n", o.character, charID, o.out)
+	return nil
+}
+
+// runImport creates a character for o.account from the exported file o.in.
+func runImport(o importOptions, out io.Writer) error {
+	exp, err := readExport(o.in)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	pool, err := connect(ctx, o.configPath)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	account, err := postgres.NewAccountRepository(pool.DB()).GetByUsername(ctx, o.account)
+	if err != nil {
+		return fmt.Errorf("looking up account %q: %w", o.account, err)
+	}
+	name := o.name
+	if name == "" {
+		name = exp.Name
+	}
+	c, err := postgres.NewCharacterRepository(pool.DB()).ImportCharacter(ctx, account.ID, exp, name)
+	recordAudit(ctx, pool, "importchar", name, map[string]any{"from": exp.Name, "accountID": account.ID, "account": account.Username}, err)
+	if err != nil {
+		return fmt.Errorf("importing %s into %s: %w", name, account.Username, err)
+	}
+	fmt.Fprintf(out, "imported %s as %s (#%d) for %s\n", exp.Name, c.Name, c.ID, account.Username)
+	return nil
+}
+
+// readExport decodes the exported character file at path.
+func readExport(path string) (*postgres.CharacterExport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var exp postgres.CharacterExport
+	if err := json.Unmarshal(data, &exp); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return &exp, nil
+}
+
+// connect loads the configuration at configPath and opens its database.
+func connect(ctx context.Context, configPath string) (*postgres.Pool, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+	pool, err := postgres.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to database: %w", err)
+	}
+	return pool, nil
+}
+
+// recordAudit writes an admin audit entry for action on target. A failure is
+// only a warning on standard error, which keeps an export on standard output
+// valid JSON.
+func recordAudit(ctx context.Context, pool *postgres.Pool, action, target string, args map[string]any, opErr error) {
+	outcome := "ok"
+	if opErr != nil {
+		outcome = opErr.Error()
+	}
+	auditArgs, _ := json.Marshal(args)
+	if err := postgres.NewAdminAuditRepository(pool.DB()).Record(ctx, postgres.AdminAuditEntry{
+		Actor:   cliactor.Name(),
+		Action:  action,
+		Target:  target,
+		Args:    string(auditArgs),
+		Outcome: outcome,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: recording audit entry: %v\n", err)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestParseExportFlags(t *testing.T) {
+	o, err := parseExportFlags([]string{"-character", "Vex", "-out", "vex.json"})
+	require.NoError(t, err)
+	assert.Equal(t, "Vex", o.character)
+	assert.Equal(t, "vex.json", o.out)
+
+	_, err = parseExportFlags(nil)
+	assert.Error(t, err)
+}
+
+func TestParseImportFlags(t *testing.T) {
+	o, err := parseImportFlags([]string{"-in", "vex.json", "-account", "alice", "-name", "VexCopy"})
+	require.NoError(t, err)
+	assert.Equal(t, "vex.json", o.in)
+	assert.Equal(t, "alice", o.account)
+	assert.Equal(t, "VexCopy", o.name)
+
+	for name, args := range map[string][]string{
+		"no file":    {"-account", "alice"},
+		"no account": {"-in", "vex.json"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseImportFlags(args)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRun_RejectsUnknownCommand(t *testing.T) {
+	assert.ErrorContains(t, run(nil, io.Discard), "usage")
+	assert.ErrorContains(t, run([]string{"clone"}, io.Discard), `unknown command "clone"`)
+}
+
+func TestReadExport(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "vex.json")
+	require.NoError(t, os.WriteFile(good, []byte(`{"version":1,"name":"Vex","level":4,"inventory":[{"item":"stim_pack","quantity":2}]}`), 0o600))
+	exp, err := readExport(good)
+	require.NoError(t, err)
+	assert.Equal(t, "Vex", exp.Name)
+	assert.Equal(t, 4, exp.Level)
+	assert.Equal(t, []postgres.ExportedItem{{ItemDefID: "stim_pack", Quantity: 2}}, exp.Inventory)
+
+	bad := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte("not json"), 0o600))
+	_, err = readExport(bad)
+	assert.ErrorContains(t, err, "decoding")
+	_, err = readExport(filepath.Join(dir, "missing.json"))
+	assert.ErrorContains(t, err, "reading")
+}
@@ -12,10 +12,14 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/frontend/handlers"
 	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/help"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/observability"
 	"github.com/cory-johannsen/mud/internal/server"
+	"github.com/cory-johannsen/mud/internal/siteban"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
 func main() {
@@ -23,6 +27,9 @@ func main() {
 
 	configPath := flag.String("config", "configs/dev.yaml", "path to configuration file")
 	regionsDir := flag.String("regions", "content/regions", "path to region YAML files directory")
+	heritagesDir := flag.String("heritages", "content/heritages", "path to heritage YAML files directory")
+	backgroundsFile := flag.String("backgrounds", "content/backgrounds.yaml", "path to character background questionnaire YAML file")
+	helpDir := flag.String("help", "content/help", "path to help topic files directory")
 	teamsDir := flag.String("teams", "content/teams", "path to team YAML files directory")
 	jobsDir := flag.String("jobs", "content/jobs", "path to job YAML files directory")
 	archetypesDir := flag.String("archetypes", "content/archetypes", "path to archetype YAML files directory")
@@ -67,6 +74,38 @@ func main() {
 		logger.Fatal("initializing application", zap.Error(err))
 	}
 
+	if auth, ok := app.TelnetAcceptor.Handler().(*handlers.AuthHandler); ok {
+		auth.SetAccountSettingsLoader(postgres.NewAccountSettingsRepository(app.Pool.DB()))
+		auth.SetLoginFailureStore(postgres.NewLoginFailureRepository(app.Pool.DB()))
+		auth.SetAccountDeleter(postgres.NewAccountRepository(app.Pool.DB()))
+		auth.SetAccountShared(postgres.NewAccountRepository(app.Pool.DB()))
+		auth.SetCharacterSlots(cfg.Characters)
+		heritages, err := ruleset.LoadHeritages(*heritagesDir)
+		if err != nil {
+			logger.Fatal("loading heritages", zap.Error(err))
+		}
+		auth.SetHeritages(heritages)
+		backgrounds, err := ruleset.LoadBackgrounds(*backgroundsFile)
+		if err != nil {
+			logger.Fatal("loading backgrounds", zap.Error(err))
+		}
+		auth.SetBackgrounds(backgrounds)
+		helpTopics, err := help.LoadDir(*helpDir)
+		if err != nil {
+			logger.Fatal("loading help topics", zap.Error(err))
+		}
+		auth.SetHelpTopics(helpTopics)
+	}
+
+	// Refuse site-banned addresses at accept time. The game server edits the
+	// rules, so reload them periodically.
+	siteBans := siteban.NewList(postgres.NewSiteBanRepository(app.Pool.DB()))
+	if err := siteBans.Reload(ctx); err != nil {
+		logger.Fatal("loading site bans", zap.Error(err))
+	}
+	stopSiteBanRefresh := siteBans.StartRefresh(time.Minute, logger)
+	defer stopSiteBanRefresh()
+
 	// Wire lifecycle.
 	lifecycle := server.NewLifecycle(logger)
 
@@ -96,6 +135,7 @@ func main() {
 			zap.String("addr", cfg.Telnet.Addr()),
 		)
 	}
+	playerAcceptor.SetSiteBans(siteBans)
 	lifecycle.Add("telnet", &server.FuncService{
 		StartFn: func() error {
 			return playerAcceptor.ListenAndServe()
@@ -107,6 +147,7 @@ func main() {
 
 	if cfg.Telnet.HeadlessPort != 0 {
 		headlessAcceptor := telnet.NewHeadlessAcceptor(cfg.Telnet, app.TelnetAcceptor.Handler(), logger)
+		headlessAcceptor.SetSiteBans(siteBans)
 		lifecycle.Add("telnet-headless", &server.FuncService{
 			StartFn: func() error {
 				return headlessAcceptor.ListenAndServe()
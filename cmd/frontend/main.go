@@ -10,12 +10,17 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/frontend/handlers"
 	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/help"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/observability"
 	"github.com/cory-johannsen/mud/internal/server"
+	"github.com/cory-johannsen/mud/internal/siteban"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
 func main() {
@@ -23,6 +28,9 @@ func main() {
 
 	configPath := flag.String("config", "configs/dev.yaml", "path to configuration file")
 	regionsDir := flag.String("regions", "content/regions", "path to region YAML files directory")
+	heritagesDir := flag.String("heritages", "content/heritages", "path to heritage YAML files directory")
+	backgroundsFile := flag.String("backgrounds", "content/backgrounds.yaml", "path to character background questionnaire YAML file")
+	helpDir := flag.String("help", "content/help", "path to help topic files directory")
 	teamsDir := flag.String("teams", "content/teams", "path to team YAML files directory")
 	jobsDir := flag.String("jobs", "content/jobs", "path to job YAML files directory")
 	archetypesDir := flag.String("archetypes", "content/archetypes", "path to archetype YAML files directory")
@@ -36,7 +44,7 @@ func main() {
 		log.Fatalf("loading config: %v", err)
 	}
 
-	logger, err := observability.NewLogger(cfg.Logging)
+	logger, logLevel, err := observability.NewLeveledLogger(cfg.Logging)
 	if err != nil {
 		log.Fatalf("initializing logger: %v", err)
 	}
@@ -65,6 +73,58 @@ func main() {
 		logger.Fatal("initializing application", zap.Error(err))
 	}
 
+	if auth, ok := app.TelnetAcceptor.Handler().(*handlers.AuthHandler); ok {
+		auth.SetAccountSettingsLoader(postgres.NewAccountSettingsRepository(app.Pool.DB()))
+		auth.SetLoginFailureStore(postgres.NewLoginFailureRepository(app.Pool.DB()))
+		auth.SetAccountDeleter(postgres.NewAccountRepository(app.Pool.DB()))
+		auth.SetAccountShared(postgres.NewAccountRepository(app.Pool.DB()))
+		auth.SetCharacterSlots(cfg.Characters)
+		heritages, err := ruleset.LoadHeritages(*heritagesDir)
+		if err != nil {
+			logger.Fatal("loading heritages", zap.Error(err))
+		}
+		auth.SetHeritages(heritages)
+		backgrounds, err := ruleset.LoadBackgrounds(*backgroundsFile)
+		if err != nil {
+			logger.Fatal("loading backgrounds", zap.Error(err))
+		}
+		auth.SetBackgrounds(backgrounds)
+		helpTopics, err := help.LoadDir(*helpDir)
+		if err != nil {
+			logger.Fatal("loading help topics", zap.Error(err))
+		}
+		auth.SetHelpTopics(helpTopics)
+	}
+
+	// Re-read the config on SIGHUP. The telnet idle timeouts and the log
+	// level apply to running sessions; other changes wait for a restart.
+	current := cfg
+	stopHangup := server.OnHangup(func() {
+		next, err := config.Load(*configPath)
+		if err != nil {
+			logger.Error("reloading config", zap.Error(err))
+			return
+		}
+		if auth, ok := app.TelnetAcceptor.Handler().(*handlers.AuthHandler); ok {
+			auth.SetIdleTimeouts(next.Telnet.IdleTimeout, next.Telnet.IdleGracePeriod)
+		}
+		if level, err := zapcore.ParseLevel(next.Logging.Level); err == nil {
+			logLevel.SetLevel(level)
+		}
+		observability.LogConfigChanges(logger, config.Diff(current, next), "SIGHUP")
+		current = next
+	})
+	defer stopHangup()
+
+	// Refuse site-banned addresses at accept time. The game server edits the
+	// rules, so reload them periodically.
+	siteBans := siteban.NewList(postgres.NewSiteBanRepository(app.Pool.DB()))
+	if err := siteBans.Reload(ctx); err != nil {
+		logger.Fatal("loading site bans", zap.Error(err))
+	}
+	stopSiteBanRefresh := siteBans.StartRefresh(time.Minute, logger)
+	defer stopSiteBanRefresh()
+
 	// Wire lifecycle.
 	lifecycle := server.NewLifecycle(logger)
 
@@ -98,6 +158,7 @@ func main() {
 			zap.String("addr", cfg.Telnet.Addr()),
 		)
 	}
+	playerAcceptor.SetSiteBans(siteBans)
 	lifecycle.Add("telnet", &server.FuncService{
 		StartFn: func() error {
 			return playerAcceptor.ListenAndServe()
@@ -112,6 +173,7 @@ func main() {
 		// NewHeadlessAcceptor. The seed-authorized handler is the existing
 		// AuthHandler; it now rejects non-seeded usernames per REQ-TD-3b.
 		headlessAcceptor := telnet.NewHeadlessAcceptor(cfg.Telnet, app.TelnetAcceptor.Handler(), logger)
+		headlessAcceptor.SetSiteBans(siteBans)
 		lifecycle.Add("telnet-headless", &server.FuncService{
 			StartFn: func() error {
 				return headlessAcceptor.ListenAndServe()
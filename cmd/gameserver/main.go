@@ -8,19 +8,26 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"os"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/content"
+	"github.com/cory-johannsen/mud/internal/flags"
 	"github.com/cory-johannsen/mud/internal/game/ai"
 	"github.com/cory-johannsen/mud/internal/game/combat"
 	"github.com/cory-johannsen/mud/internal/game/condition"
+	"github.com/cory-johannsen/mud/internal/game/i18n"
 	"github.com/cory-johannsen/mud/internal/game/inventory"
 	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/permission"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/game/technology"
+	"github.com/cory-johannsen/mud/internal/game/vehicle"
 	"github.com/cory-johannsen/mud/internal/game/world"
 	"github.com/cory-johannsen/mud/internal/game/xp"
 	"github.com/cory-johannsen/mud/internal/gameserver"
@@ -28,6 +35,9 @@ import (
 	"github.com/cory-johannsen/mud/internal/observability"
 	"github.com/cory-johannsen/mud/internal/scripting"
 	"github.com/cory-johannsen/mud/internal/server"
+	"github.com/cory-johannsen/mud/internal/siteban"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // AppConfig holds all CLI flag values for the gameserver binary.
@@ -54,6 +64,7 @@ type AppConfig struct {
 	ClassFeatsFile  ruleset.ClassFeaturesFile
 	ArchetypesDir   ruleset.ArchetypesDir
 	RegionsDir      ruleset.RegionsDir
+	HeritagesDir    ruleset.HeritagesDir
 	TechContentDir  technology.TechContentDir
 	RoundDurationMs gameserver.RoundDurationMs
 	XPConfigFile      string
@@ -67,6 +78,10 @@ type AppConfig struct {
 	WeatherChancePerTick    float64
 	WeatherFile             string
 	QuestsDir               string
+	BackgroundsFile         string
+	TutorialFile            string
+	AchievementsFile        string
+	AffixesFile             string
 }
 
 // AppConfigToDatabase extracts database config from AppConfig for wire.
@@ -78,37 +93,6 @@ func main() {
 	start := time.Now()
 
 	configPath := flag.String("config", "configs/dev.yaml", "path to configuration file")
-	zonesDir := flag.String("zones", "content/zones", "path to zone YAML files directory")
-	npcsDir := flag.String("npcs-dir", "content/npcs", "path to NPC YAML templates directory")
-	conditionsDir := flag.String("conditions-dir", "content/conditions", "path to condition YAML definitions directory")
-	scriptRoot := flag.String("script-root", "content/scripts", "root directory for Lua scripts; empty = scripting disabled")
-	condScriptDir := flag.String("condition-scripts", "content/scripts/conditions", "directory of global condition scripts loaded into __global__ VM")
-	weaponsDir := flag.String("weapons-dir", "content/weapons", "path to weapon YAML definitions directory")
-	itemsDir := flag.String("items-dir", "content/items", "path to item YAML definitions directory")
-	explosivesDir := flag.String("explosives-dir", "content/explosives", "path to explosive YAML definitions directory")
-	aiDir := flag.String("ai-dir", "content/ai", "path to HTN AI domain YAML directory")
-	aiScriptDir := flag.String("ai-scripts", "content/scripts/ai", "path to Lua AI precondition scripts")
-	aiTickInterval := flag.Duration("ai-tick", 10*time.Second, "NPC AI tick interval")
-	armorsDir := flag.String("armors-dir", "content/armor", "path to armor YAML definitions directory")
-	preciousMaterialsDir := flag.String("precious-materials-dir", "content/items/precious_materials", "path to precious material YAML definitions directory")
-	jobsDir := flag.String("jobs-dir", "content/jobs", "path to job YAML definitions directory")
-	loadoutsDir := flag.String("loadouts-dir", "content/loadouts", "path to archetype loadout YAML directory")
-	skillsFile := flag.String("skills", "content/skills.yaml", "path to skills YAML file")
-	featsFile := flag.String("feats", "content/feats.yaml", "path to feats YAML file")
-	classFeatsFile := flag.String("class-features", "content/class_features.yaml", "path to class features YAML file")
-	archetypesDir := flag.String("archetypes-dir", "content/archetypes", "path to archetype YAML definitions directory")
-	regionsDir := flag.String("regions-dir", "content/regions", "path to region YAML definitions directory")
-	xpConfigFile := flag.String("xp-config", "content/xp_config.yaml", "path to XP configuration YAML file")
-	techContentDir := flag.String("tech-content-dir", "content/technologies", "path to technology YAML content directory")
-	contentDir := flag.String("content-dir", "content", "path to content directory for world editing")
-	setsDir := flag.String("sets-dir", "content/sets", "path to equipment set YAML definitions directory")
-	substancesDir := flag.String("substances-dir", "content/substances", "path to substance YAML definitions directory")
-	factionsDir := flag.String("factions-dir", "content/factions", "path to faction YAML definitions directory")
-	factionConfigPath := flag.String("faction-config", "content/faction_config.yaml", "path to faction configuration YAML file")
-	materialsFile := flag.String("materials-file", "content/materials.yaml", "path to crafting materials YAML file")
-	recipesDir := flag.String("recipes-dir", "content/recipes", "path to crafting recipe YAML definitions directory")
-	downtimeQueueLimitsFile := flag.String("downtime-queue-limits", "content/downtime_queue_limits.yaml", "path to downtime queue limits YAML file")
-	questsDir := flag.String("quests-dir", "content/quests", "path to quest YAML files directory")
 	flag.Parse()
 
 	ctx := context.Background()
@@ -118,7 +102,7 @@ func main() {
 		log.Fatalf("loading config: %v", err)
 	}
 
-	logger, err := observability.NewLogger(cfg.Logging)
+	logger, logLevel, err := observability.NewLeveledLogger(cfg.Logging)
 	if err != nil {
 		log.Fatalf("initializing logger: %v", err)
 	}
@@ -128,6 +112,23 @@ func main() {
 		zap.String("grpc_addr", cfg.GameServer.Addr()),
 	)
 
+	// Layer the configured overlays over the content root; every content
+	// path below is resolved within the merged tree.
+	tree, err := content.Resolve(cfg.Content.Root, cfg.Content.Overlays)
+	if err != nil {
+		logger.Fatal("resolving content", zap.Error(err))
+	}
+	defer tree.Close()
+	tree.LogManifest(logger)
+	// optional returns the path of rel in the tree, or "" when the tree has
+	// no such file, which disables the feature it configures.
+	optional := func(rel string) string {
+		if _, err := os.Stat(tree.Path(rel)); err != nil {
+			return ""
+		}
+		return tree.Path(rel)
+	}
+
 	// Pre-construct GameClock; its primitive params would collide with wire's string provider.
 	gameClock := gameserver.NewGameClock(
 		int32(cfg.GameServer.GameClockStart),
@@ -135,41 +136,46 @@ func main() {
 	)
 
 	appCfg := &AppConfig{
-		Config:          cfg,
-		ZonesDir:        world.WorldDir(*zonesDir),
-		NPCsDir:         npc.NPCsDir(*npcsDir),
-		ConditionsDir:   condition.ConditionsDir(*conditionsDir),
-		MentalCondDir:   condition.MentalConditionsDir(*conditionsDir + "/mental"),
-		ScriptRoot:      scripting.ScriptRoot(*scriptRoot),
-		CondScriptDir:   scripting.CondScriptDir(*condScriptDir),
-		WeaponsDir:      inventory.WeaponsDir(*weaponsDir),
-		ItemsDir:        inventory.ItemsDir(*itemsDir),
-		ExplosivesDir:   inventory.ExplosivesDir(*explosivesDir),
-		ArmorsDir:            inventory.ArmorsDir(*armorsDir),
-		PreciousMaterialsDir: inventory.PreciousMaterialsDir(*preciousMaterialsDir),
-		AIDir:           *aiDir,
-		AIScriptDir:     scripting.AIScriptDir(*aiScriptDir),
-		AITickInterval:  gameserver.AITickInterval(*aiTickInterval),
-		JobsDir:         ruleset.JobsDir(*jobsDir),
-		LoadoutsDir:     gameserver.LoadoutsDir(*loadoutsDir),
-		SkillsFile:      ruleset.SkillsFile(*skillsFile),
-		FeatsFile:       ruleset.FeatsFile(*featsFile),
-		ClassFeatsFile:  ruleset.ClassFeaturesFile(*classFeatsFile),
-		ArchetypesDir:   ruleset.ArchetypesDir(*archetypesDir),
-		RegionsDir:      ruleset.RegionsDir(*regionsDir),
-		TechContentDir:  technology.TechContentDir(*techContentDir),
-		RoundDurationMs: gameserver.RoundDurationMs(cfg.GameServer.RoundDurationMs),
-		XPConfigFile:    *xpConfigFile,
-		SetsDir:         *setsDir,
-		SubstancesDir:     *substancesDir,
-		FactionsDir:       *factionsDir,
-		FactionConfigPath: *factionConfigPath,
-		MaterialsFile:           *materialsFile,
-		RecipesDir:              *recipesDir,
-		DowntimeQueueLimitsFile: *downtimeQueueLimitsFile,
+		Config:                  cfg,
+		ZonesDir:                world.WorldDir(tree.Path("zones")),
+		NPCsDir:                 npc.NPCsDir(tree.Path("npcs")),
+		ConditionsDir:           condition.ConditionsDir(tree.Path("conditions")),
+		MentalCondDir:           condition.MentalConditionsDir(tree.Path("conditions/mental")),
+		ScriptRoot:              scripting.ScriptRoot(optional("scripts")),
+		CondScriptDir:           scripting.CondScriptDir(tree.Path("scripts/conditions")),
+		WeaponsDir:              inventory.WeaponsDir(tree.Path("weapons")),
+		ItemsDir:                inventory.ItemsDir(tree.Path("items")),
+		ExplosivesDir:           inventory.ExplosivesDir(tree.Path("explosives")),
+		ArmorsDir:               inventory.ArmorsDir(tree.Path("armor")),
+		PreciousMaterialsDir:    inventory.PreciousMaterialsDir(tree.Path("items/precious_materials")),
+		AIDir:                   tree.Path("ai"),
+		AIScriptDir:             scripting.AIScriptDir(tree.Path("scripts/ai")),
+		AITickInterval:          gameserver.AITickInterval(cfg.GameServer.AITickInterval),
+		JobsDir:                 ruleset.JobsDir(tree.Path("jobs")),
+		LoadoutsDir:             gameserver.LoadoutsDir(tree.Path("loadouts")),
+		SkillsFile:              ruleset.SkillsFile(tree.Path("skills.yaml")),
+		FeatsFile:               ruleset.FeatsFile(tree.Path("feats.yaml")),
+		ClassFeatsFile:          ruleset.ClassFeaturesFile(tree.Path("class_features.yaml")),
+		ArchetypesDir:           ruleset.ArchetypesDir(tree.Path("archetypes")),
+		RegionsDir:              ruleset.RegionsDir(tree.Path("regions")),
+		HeritagesDir:            ruleset.HeritagesDir(tree.Path("heritages")),
+		TechContentDir:          technology.TechContentDir(tree.Path("technologies")),
+		RoundDurationMs:         gameserver.RoundDurationMs(cfg.GameServer.RoundDurationMs),
+		XPConfigFile:            tree.Path("xp_config.yaml"),
+		SetsDir:                 tree.Path("sets"),
+		SubstancesDir:           tree.Path("substances"),
+		FactionsDir:             tree.Path("factions"),
+		FactionConfigPath:       tree.Path("faction_config.yaml"),
+		MaterialsFile:           tree.Path("materials.yaml"),
+		RecipesDir:              tree.Path("recipes"),
+		DowntimeQueueLimitsFile: tree.Path("downtime_queue_limits.yaml"),
 		WeatherChancePerTick:    cfg.Weather.ChancePerTick,
 		WeatherFile:             cfg.Weather.ContentFile,
-		QuestsDir:               *questsDir,
+		QuestsDir:               tree.Path("quests"),
+		BackgroundsFile:         tree.Path("backgrounds.yaml"),
+		TutorialFile:            optional("tutorial.yaml"),
+		AchievementsFile:        optional("achievements.yaml"),
+		AffixesFile:             optional("affixes.yaml"),
 	}
 
 	app, err := Initialize(ctx, appCfg, gameClock, logger)
@@ -178,13 +184,93 @@ func main() {
 	}
 
 	// Attempt to initialize WorldEditor for in-game world-editing commands.
-	worldEditor, weErr := world.NewWorldEditor(*contentDir, app.GRPCService.World())
+	worldEditor, weErr := world.NewWorldEditor(cfg.Content.Root, app.GRPCService.World())
 	if weErr != nil {
 		logger.Warn("WARNING: content/ is not writable — world-editing commands disabled.", zap.Error(weErr))
 	} else {
 		app.GRPCService.SetWorldEditor(worldEditor)
 	}
 
+	// Load message catalog translations; the embedded English catalog is used alone on failure.
+	catalog, catErr := i18n.LoadCatalogFromDir(tree.Path("locales"))
+	if catErr != nil {
+		logger.Warn("message catalog translations failed to load; using default locale only", zap.Error(catErr))
+		catalog = i18n.Default()
+	}
+	app.GRPCService.SetCatalog(catalog)
+	combat.SetCatalog(catalog)
+
+	// Load the role capability matrix; content/permissions.yaml optionally
+	// overrides the embedded defaults, which also apply on failure.
+	permissions, permErr := permission.LoadMatrixOrDefault(tree.Path("permissions.yaml"))
+	if permErr != nil {
+		logger.Warn("permission matrix failed to load; using built-in role capabilities", zap.Error(permErr))
+		permissions = permission.Default()
+	}
+	app.GRPCService.SetPermissions(permissions)
+
+	// Install the chat filter; a missing word list disables masking only.
+	var chatWords []string
+	if cfg.Chat.WordsFile != "" {
+		words, wordsErr := gameserver.LoadChatFilterWords(cfg.Chat.WordsFile)
+		if wordsErr != nil {
+			logger.Warn("chat filter word list failed to load; word masking disabled", zap.Error(wordsErr))
+		} else {
+			chatWords = words
+		}
+	}
+	app.GRPCService.SetChatFilter(gameserver.NewChatFilter(cfg.Chat, chatWords))
+	app.GRPCService.SetFloodLimits(cfg.GameServer.Flood)
+	app.GRPCService.SetTimeouts(cfg.GameServer.Timeouts)
+	app.GRPCService.SetCharacterSlots(cfg.Characters)
+	// Feature flags default from the config; admins override them with the
+	// flag command, globally or per account.
+	featureFlags := flags.New(cfg.Flags, postgres.NewFeatureFlagRepository(app.Pool.DB()))
+	if err := featureFlags.Reload(ctx); err != nil {
+		logger.Fatal("loading feature flag overrides", zap.Error(err))
+	}
+	flags.Install(featureFlags)
+	app.GRPCService.SetFeatureFlags(featureFlags)
+	// Game events are exported for economy and balance analysis when a sink
+	// is configured; the emitter is stopped, flushing it, before the pool closes.
+	var events *telemetry.Emitter
+	if sink, err := newTelemetrySink(ctx, cfg.Telemetry, app.Pool.DB()); err != nil {
+		logger.Fatal("configuring telemetry", zap.Error(err))
+	} else if sink != nil {
+		events = telemetry.NewEmitter(sink, cfg.Telemetry.BufferSize, cfg.Telemetry.FlushInterval, logger)
+		app.GRPCService.SetTelemetry(events)
+		logger.Info("exporting telemetry", zap.String("sink", cfg.Telemetry.Sink))
+	}
+	// Re-read the config on SIGHUP or reloadconfig, applying the values that
+	// are safe to change while running.
+	app.GRPCService.SetConfigReload(cfg, func() (config.Config, error) {
+		return config.Load(*configPath)
+	}, logLevel, app.ZoneTickMgr)
+	stopHangup := server.OnHangup(func() {
+		if _, err := app.GRPCService.ReloadConfig("SIGHUP"); err != nil {
+			logger.Error("reloading config", zap.Error(err))
+		}
+	})
+	defer stopHangup()
+	app.GRPCService.SetAmbientInterval(cfg.GameServer.AmbientInterval)
+
+	// Site bans are enforced on every session stream and edited by the siteban command.
+	siteBans := siteban.NewList(postgres.NewSiteBanRepository(app.Pool.DB()))
+	if err := siteBans.Reload(ctx); err != nil {
+		logger.Fatal("loading site bans", zap.Error(err))
+	}
+	app.GRPCService.SetSiteBans(siteBans)
+
+	// Park vehicles in their spawn rooms; without templates the world simply has none.
+	vehicleTemplates, vehErr := vehicle.LoadTemplates(tree.Path("vehicles"))
+	if vehErr != nil {
+		logger.Warn("vehicle templates failed to load; vehicles disabled", zap.Error(vehErr))
+	} else {
+		vehicles := vehicle.NewManager()
+		logger.Info("spawned vehicles", zap.Int("count", vehicles.SpawnAll(vehicleTemplates)))
+		app.GRPCService.SetVehicles(vehicles)
+	}
+
 	// Wire auto-navigation step delay from config (REQ-CNT-2).
 	if cfg.GameServer.AutoNavStepMs >= 100 {
 		app.GRPCService.SetAutoNavStepMs(cfg.GameServer.AutoNavStepMs)
@@ -276,7 +362,7 @@ func main() {
 			out := make([]*scripting.CombatantInfo, 0, len(living))
 			for _, c := range living {
 				kind := "npc"
-				if c.Kind == combat.KindPlayer {
+				if c.OnPlayerSide() {
 					kind = "player"
 				}
 				out = append(out, &scripting.CombatantInfo{
@@ -382,6 +468,28 @@ func main() {
 		}
 	}()
 
+	// Purge characters whose soft-delete window has elapsed, and accounts
+	// whose owners asked to delete them, at startup and hourly.
+	accountRepo := postgres.NewAccountRepository(app.Pool.DB())
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			cutoff := time.Now().Add(-postgres.CharacterDeleteWindow)
+			if n, err := app.CharRepo.PurgeDeletedCharacters(ctx, cutoff); err != nil {
+				logger.Warn("purging deleted characters", zap.Error(err))
+			} else if n > 0 {
+				logger.Info("purged deleted characters", zap.Int64("count", n))
+			}
+			purgeExpiredAccounts(ctx, accountRepo, logger)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
 	// Start out-of-combat health regeneration.
 	app.RegenMgr.Start(ctx)
 
@@ -396,8 +504,69 @@ func main() {
 	stopItemTicks := app.GRPCService.StartItemTickHook()
 	defer stopItemTicks()
 
-	// Create gRPC server.
-	grpcServer := grpc.NewServer()
+	// Announce season changes and run the on_season_change script hook.
+	stopSeasonHook := app.GRPCService.StartSeasonHook()
+	defer stopSeasonHook()
+
+	// Run the scheduled server events, including raids.
+	if err := app.GRPCService.InitRaids(optional("raids.yaml")); err != nil {
+		logger.Fatal("loading raids", zap.Error(err))
+	}
+	if err := app.GRPCService.InitSchedule(optional("schedule.yaml")); err != nil {
+		logger.Fatal("loading scheduled events", zap.Error(err))
+	}
+	stopScheduler := app.GRPCService.StartScheduler()
+	defer stopScheduler()
+
+	// Restore rented rooms and start charging rent.
+	app.GRPCService.InitHousing(ctx)
+	stopHousingTicks := app.GRPCService.StartHousingRentHook()
+	defer stopHousingTicks()
+
+	// Restore player clans and drop deleted characters from them.
+	app.GRPCService.InitClans(ctx)
+	stopClanTicks := app.GRPCService.StartClanReconcileHook()
+	defer stopClanTicks()
+
+	// Restore auction house listings and escrowed claims.
+	app.GRPCService.InitAuctions(ctx)
+
+	// Drop the floor items zones put back on each reset.
+	app.GRPCService.InitFloorItems()
+
+	// Arm the traps declared in zone YAML.
+	if err := app.GRPCService.InitTraps(tree.Path("traps")); err != nil {
+		logger.Fatal("loading trap templates", zap.Error(err))
+	}
+
+	// Flush player stats and recompute the leaderboards periodically.
+	stopStatsRollup := app.GRPCService.StartStatsRollup()
+	defer stopStatsRollup()
+
+	// Keep open play sessions current so a crash loses little playtime.
+	stopPlayHeartbeat := app.GRPCService.StartPlaySessionHeartbeat()
+	defer stopPlayHeartbeat()
+
+	// Start calendar-driven merchant restocking and other NPC state updates.
+	stopNPCTicks := app.GRPCService.StartNPCTickHook()
+	defer stopNPCTicks()
+
+	// Create gRPC server. Recovery runs outermost so a panic anywhere below it
+	// ends only the affected call.
+	grpcMetrics := observability.NewMetrics()
+	stopMetricsReport := grpcMetrics.StartReport(5*time.Minute, logger)
+	defer stopMetricsReport()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			observability.UnaryRecoveryInterceptor(logger),
+			observability.UnaryLoggingInterceptor(logger, grpcMetrics),
+		),
+		grpc.ChainStreamInterceptor(
+			observability.StreamRecoveryInterceptor(logger),
+			siteban.StreamServerInterceptor(siteBans, logger),
+			observability.StreamLoggingInterceptor(logger, grpcMetrics),
+		),
+	)
 	gamev1.RegisterGameServiceServer(grpcServer, app.GRPCService)
 
 	// Wire lifecycle.
@@ -438,7 +607,65 @@ func main() {
 		},
 	})
 
+	if events != nil {
+		stopped := make(chan struct{})
+		lifecycle.Add("telemetry", &server.FuncService{
+			StartFn: func() error {
+				<-stopped
+				return nil
+			},
+			StopFn: func() {
+				if err := events.Close(); err != nil {
+					logger.Warn("closing telemetry", zap.Error(err))
+				}
+				if n := events.Dropped(); n > 0 {
+					logger.Warn("telemetry events were dropped", zap.Int64("dropped", n))
+				}
+				close(stopped)
+			},
+		})
+	}
+
 	if err := lifecycle.Run(ctx); err != nil {
 		logger.Fatal("server error", zap.Error(err))
 	}
 }
+
+// purgeExpiredAccounts deletes every account whose deletion was requested
+// more than postgres.AccountDeleteWindow ago, logging each one purged.
+func purgeExpiredAccounts(ctx context.Context, repo *postgres.AccountRepository, logger *zap.Logger) {
+	ids, err := repo.ListExpiredDeletions(ctx, time.Now().Add(-postgres.AccountDeleteWindow))
+	if err != nil {
+		logger.Warn("listing expired account deletions", zap.Error(err))
+		return
+	}
+	for _, id := range ids {
+		report, err := repo.PurgeAccount(ctx, id, postgres.PurgeDelete)
+		if err != nil {
+			logger.Warn("purging deleted account", zap.Int64("account_id", id), zap.Error(err))
+			continue
+		}
+		logger.Info("purged deleted account",
+			zap.Int64("account_id", id),
+			zap.Int("characters", report.Characters),
+			zap.Int64("events", report.Events),
+			zap.Int64("reports", report.Reports),
+		)
+	}
+}
+
+// newTelemetrySink opens the sink cfg selects.
+//
+// Postcondition: Returns a nil sink and nil error when telemetry is disabled.
+func newTelemetrySink(ctx context.Context, cfg config.TelemetryConfig, pool *pgxpool.Pool) (telemetry.Sink, error) {
+	switch cfg.Sink {
+	case "postgres":
+		return postgres.NewGameEventRepository(pool), nil
+	case "file":
+		return telemetry.NewFileSink(cfg.File)
+	case "nats":
+		return telemetry.NewNATSSink(ctx, cfg.NATSURL, cfg.NATSSubject)
+	default:
+		return nil, nil
+	}
+}
@@ -60,7 +60,7 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 			"ExplosivesDir", "ArmorsDir", "PreciousMaterialsDir", "AIScriptDir",
 			"AITickInterval", "JobsDir", "LoadoutsDir",
 			"SkillsFile", "FeatsFile", "ClassFeatsFile",
-			"ArchetypesDir", "RegionsDir", "TechContentDir", "RoundDurationMs",
+			"ArchetypesDir", "RegionsDir", "HeritagesDir", "TechContentDir", "RoundDurationMs",
 		),
 		postgres.StorageProviders,
 		world.Providers,
@@ -89,6 +89,25 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 		wire.Bind(new(gameserver.InnateTechRepo), new(*postgres.CharacterInnateTechRepository)),
 		wire.Bind(new(gameserver.SpontaneousUsePoolRepo), new(*postgres.CharacterSpontaneousUsePoolRepository)),
 		wire.Bind(new(gameserver.CharacterDowntimeRepository), new(*postgres.CharacterDowntimeRepository)),
+		wire.Bind(new(gameserver.CombatVerbositySaver), new(*postgres.CharacterRepository)),
+		wire.Bind(new(gameserver.AdminAuditStore), new(*postgres.AdminAuditRepository)),
+		wire.Bind(new(gameserver.AccountSettingsSaver), new(*postgres.AccountSettingsRepository)),
+		wire.Bind(new(gameserver.CharacterRenamer), new(*postgres.CharacterRepository)),
+		wire.Bind(new(gameserver.CharacterTransferer), new(*postgres.CharacterRepository)),
+		wire.Bind(new(gameserver.CharacterExporter), new(*postgres.CharacterRepository)),
+		wire.Bind(new(gameserver.TutorialStore), new(*postgres.CharacterRepository)),
+		wire.Bind(new(gameserver.MerchantStateStore), new(*postgres.MerchantStateRepository)),
+		wire.Bind(new(gameserver.HousingStore), new(*postgres.HousingRepository)),
+		wire.Bind(new(gameserver.StashStore), new(*postgres.CharacterRepository)),
+		wire.Bind(new(gameserver.ClanStore), new(*postgres.ClanRepository)),
+		wire.Bind(new(gameserver.AuctionStore), new(*postgres.AuctionRepository)),
+		wire.Bind(new(gameserver.AchievementStore), new(*postgres.AchievementRepository)),
+		wire.Bind(new(gameserver.StatsStore), new(*postgres.StatsRepository)),
+		wire.Bind(new(gameserver.PlaySessionStore), new(*postgres.PlaySessionRepository)),
+		wire.Bind(new(gameserver.TranscriptStore), new(*postgres.TranscriptRepository)),
+		wire.Bind(new(gameserver.ReportStore), new(*postgres.ReportRepository)),
+		wire.Bind(new(gameserver.WeaponPracticeStore), new(*postgres.CharacterWeaponPracticeRepository)),
+		wire.Bind(new(gameserver.PointBuyStore), new(*postgres.CharacterPointBuyRepository)),
 		wire.Struct(new(gameserver.StorageDeps), "*"),
 		wire.Struct(new(gameserver.ContentDeps), "*"),
 		wire.Struct(new(gameserver.HandlerDeps), "*"),
@@ -9,6 +9,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/cory-johannsen/mud/internal/game/achievement"
 	"github.com/cory-johannsen/mud/internal/game/ai"
 	"github.com/cory-johannsen/mud/internal/game/combat"
 	"github.com/cory-johannsen/mud/internal/game/condition"
@@ -24,6 +25,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/session"
 	"github.com/cory-johannsen/mud/internal/game/substance"
 	"github.com/cory-johannsen/mud/internal/game/technology"
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
 	"github.com/cory-johannsen/mud/internal/game/world"
 	"github.com/cory-johannsen/mud/internal/gameserver"
 	"github.com/cory-johannsen/mud/internal/scripting"
@@ -63,6 +65,19 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 	characterDowntimeQueueRepository := postgres.NewCharacterDowntimeQueueRepository(pgxpoolPool)
 	characterFeatLevelGrantsRepository := postgres.NewCharacterFeatLevelGrantsRepository(pgxpoolPool)
 	questRepository := postgres.NewQuestRepository(pgxpoolPool)
+	adminAuditRepository := postgres.NewAdminAuditRepository(pgxpoolPool)
+	accountSettingsRepository := postgres.NewAccountSettingsRepository(pgxpoolPool)
+	merchantStateRepository := postgres.NewMerchantStateRepository(pgxpoolPool)
+	housingRepository := postgres.NewHousingRepository(pgxpoolPool)
+	clanRepository := postgres.NewClanRepository(pgxpoolPool)
+	auctionRepository := postgres.NewAuctionRepository(pgxpoolPool)
+	achievementRepository := postgres.NewAchievementRepository(pgxpoolPool)
+	statsRepository := postgres.NewStatsRepository(pgxpoolPool)
+	playSessionRepository := postgres.NewPlaySessionRepository(pgxpoolPool)
+	transcriptRepository := postgres.NewTranscriptRepository(pgxpoolPool)
+	reportRepository := postgres.NewReportRepository(pgxpoolPool)
+	characterWeaponPracticeRepository := postgres.NewCharacterWeaponPracticeRepository(pgxpoolPool)
+	characterPointBuyRepository := postgres.NewCharacterPointBuyRepository(pgxpoolPool)
 	storageDeps := gameserver.StorageDeps{
 		CharRepo:               characterRepository,
 		AccountRepo:            accountRepoAdapter,
@@ -86,6 +101,28 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 		DowntimeRepo:           characterDowntimeRepository,
 		DowntimeQueueRepo:      characterDowntimeQueueRepository,
 		QuestRepo:              questRepository,
+		CombatVerbosityRepo:    characterRepository,
+		AccountLocaleRepo:      accountRepoAdapter,
+		AdminAuditRepo:         adminAuditRepository,
+		AccountSettingsRepo:    accountSettingsRepository,
+		CharacterRenamer:       characterRepository,
+		CharacterTransferer:    characterRepository,
+		CharacterExporter:      characterRepository,
+		AccountSlotsRepo:       accountRepoAdapter,
+		TutorialRepo:           characterRepository,
+		MerchantStateRepo:      merchantStateRepository,
+		HousingRepo:            housingRepository,
+		StashRepo:              characterRepository,
+		AccountStashRepo:       accountRepoAdapter,
+		ClanRepo:               clanRepository,
+		AuctionRepo:            auctionRepository,
+		AchievementRepo:        achievementRepository,
+		StatsRepo:              statsRepository,
+		PlaySessionRepo:        playSessionRepository,
+		TranscriptRepo:         transcriptRepository,
+		ReportRepo:             reportRepository,
+		WeaponPracticeRepo:     characterWeaponPracticeRepository,
+		PointBuyRepo:           characterPointBuyRepository,
 	}
 	worldDir := cfg.ZonesDir
 	manager, err := world.NewManagerFromDir(worldDir, logger)
@@ -107,9 +144,15 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 	if err != nil {
 		return nil, err
 	}
+	// Load affix definitions (optional — no affixes roll when the path is not configured).
+	if cfg.AffixesFile != "" {
+		if err := inventory.LoadAffixes(registry, cfg.AffixesFile); err != nil {
+			return nil, fmt.Errorf("loading affixes: %w", err)
+		}
+	}
 	npcManager := npc.NewWiredManager(registry)
 	npCsDir := cfg.NPCsDir
-	v, err := npc.LoadTemplatesFromDir(npCsDir, logger)
+	v, err := npc.LoadTemplatesFromDir(npCsDir, registry, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +219,11 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 	if err != nil {
 		return nil, err
 	}
+	heritagesDir := cfg.HeritagesDir
+	v7, err := ruleset.LoadHeritageMap(heritagesDir, logger)
+	if err != nil {
+		return nil, err
+	}
 	scriptRoot := cfg.ScriptRoot
 	condScriptDir := cfg.CondScriptDir
 	aiScriptDir := cfg.AIScriptDir
@@ -223,6 +271,30 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 	if err != nil {
 		return nil, fmt.Errorf("loading quest registry: %w", err)
 	}
+	// Load the background questionnaire (optional — nil when the path is not configured).
+	var backgrounds *ruleset.Backgrounds
+	if cfg.BackgroundsFile != "" {
+		backgrounds, err = ruleset.LoadBackgrounds(cfg.BackgroundsFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading backgrounds: %w", err)
+		}
+	}
+	// Load the first-login tutorial (optional — nil when the path is not configured).
+	var tutorialDef *tutorial.Definition
+	if cfg.TutorialFile != "" {
+		tutorialDef, err = tutorial.Load(cfg.TutorialFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading tutorial: %w", err)
+		}
+	}
+	// Load achievement definitions (optional — nil when the path is not configured).
+	var achievementRegistry *achievement.Registry
+	if cfg.AchievementsFile != "" {
+		achievementRegistry, err = achievement.Load(cfg.AchievementsFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading achievements: %w", err)
+		}
+	}
 	contentDeps := gameserver.ContentDeps{
 		WorldMgr:             manager,
 		NpcMgr:               npcManager,
@@ -241,6 +313,7 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 		JobRegistry:          jobRegistry,
 		ArchetypeMap:         v5,
 		RegionMap:            v6,
+		HeritageMap:          v7,
 		ScriptMgr:            scriptingManager,
 		DiceRoller:           roller,
 		CombatEngine:         engine,
@@ -254,6 +327,9 @@ func Initialize(ctx context.Context, cfg *AppConfig, clock *gameserver.GameClock
 		RecipeRegistry:             recipeRegistry,
 		DowntimeQueueLimitRegistry: downtimeQueueLimitRegistry,
 		QuestRegistry:              questRegistry,
+		Backgrounds:                backgrounds,
+		Tutorial:                   tutorialDef,
+		Achievements:               achievementRegistry,
 	}
 	sessionManager := gameserver.NewSessionManager()
 	worldHandler := gameserver.NewWorldHandlerProvider(manager, sessionManager, npcManager, clock, roomEquipmentManager, registry)
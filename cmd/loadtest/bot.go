@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// Operation names in the report.
+const (
+	opJoin            = "join"
+	opMove            = "move"
+	opSay             = "say"
+	opAttack          = "attack"
+	opRoundResolution = "round_resolution"
+)
+
+// replyTimeout is how long a command may go unanswered before it counts as failed.
+const replyTimeout = 10 * time.Second
+
+// Chance of each action when all are possible; the rest of the time the bot chats.
+const (
+	attackChance = 0.3
+	moveChance   = 0.5
+)
+
+// lines are what simulated players say.
+var lines = []string{
+	"anyone seen a medkit?",
+	"this place is a dump",
+	"heading north",
+	"watch your back",
+	"lol",
+}
+
+// sent is a command awaiting the server's reply.
+type sent struct {
+	op string
+	at time.Time
+}
+
+// bot is one simulated player.
+type bot struct {
+	name     string
+	location string
+	think    time.Duration
+	rng      *rand.Rand
+	rec      *recorder
+
+	// mu guards everything below, which the receive loop updates.
+	mu         sync.Mutex
+	room       *gamev1.RoomView
+	pending    map[string]sent
+	nextID     int
+	roundStart time.Time
+	roundLen   time.Duration
+}
+
+// newBot returns the i'th simulated player.
+func newBot(i int, o options, rec *recorder) *bot {
+	return &bot{
+		name:     fmt.Sprintf("%s%d", o.prefix, i+1),
+		location: o.location,
+		think:    o.think,
+		rng:      rand.New(rand.NewSource(o.seed + int64(i))),
+		rec:      rec,
+		pending:  make(map[string]sent),
+	}
+}
+
+// run joins the world and acts until ctx is done.
+//
+// Postcondition: Commands unanswered for replyTimeout are recorded as failed;
+// those sent too recently to judge when the run ends are not recorded.
+func (b *bot) run(ctx context.Context, client gamev1.GameServiceClient) error {
+	stream, err := client.Session(ctx)
+	if err != nil {
+		return fmt.Errorf("opening session: %w", err)
+	}
+	received := make(chan error, 1)
+	go func() { received <- b.receive(stream) }()
+
+	err = b.send(stream, opJoin, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_JoinWorld{JoinWorld: &gamev1.JoinWorldRequest{
+		Uid:           b.name,
+		Username:      b.name,
+		CharacterName: b.name,
+		CurrentHp:     10,
+		Location:      b.location,
+		Role:          "player",
+		Level:         1,
+		Headless:      true,
+	}}})
+	for err == nil {
+		pause := time.Duration((0.5 + b.rng.Float64()) * float64(b.think))
+		select {
+		case <-ctx.Done():
+		case err = <-received:
+			if err == nil {
+				err = errors.New("server ended the session")
+			}
+		case <-time.After(pause):
+			b.expire()
+			op, msg := b.next()
+			err = b.send(stream, op, msg)
+			continue
+		}
+		break
+	}
+	_ = stream.CloseSend()
+	b.expire()
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// send stamps msg with a fresh request ID and sends it.
+func (b *bot) send(stream gamev1.GameService_SessionClient, op string, msg *gamev1.ClientMessage) error {
+	b.mu.Lock()
+	b.nextID++
+	msg.RequestId = strconv.Itoa(b.nextID)
+	b.pending[msg.RequestId] = sent{op: op, at: time.Now()}
+	b.mu.Unlock()
+	if err := stream.Send(msg); err != nil {
+		return fmt.Errorf("sending %s: %w", op, err)
+	}
+	return nil
+}
+
+// next picks the bot's next action from what it can see.
+func (b *bot) next() (string, *gamev1.ClientMessage) {
+	b.mu.Lock()
+	room := b.room
+	b.mu.Unlock()
+
+	var targets, exits []string
+	if room != nil {
+		for _, n := range room.GetNpcs() {
+			if n.GetNpcType() == "" {
+				targets = append(targets, n.GetName())
+			}
+		}
+		for _, e := range room.GetExits() {
+			if !e.GetLocked() && !e.GetHidden() {
+				exits = append(exits, e.GetDirection())
+			}
+		}
+	}
+	r := b.rng.Float64()
+	switch {
+	case len(targets) > 0 && r < attackChance:
+		return opAttack, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Attack{Attack: &gamev1.AttackRequest{
+			Target: targets[b.rng.Intn(len(targets))],
+		}}}
+	case len(exits) > 0 && r < attackChance+moveChance:
+		return opMove, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Move{Move: &gamev1.MoveRequest{
+			Direction: exits[b.rng.Intn(len(exits))],
+		}}}
+	default:
+		return opSay, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Say{Say: &gamev1.SayRequest{
+			Message: lines[b.rng.Intn(len(lines))],
+		}}}
+	}
+}
+
+// receive records replies and tracks the bot's room and combat rounds until
+// the stream ends.
+//
+// Postcondition: Returns nil when the stream ends because the run is over.
+func (b *bot) receive(stream gamev1.GameService_SessionClient) error {
+	for {
+		evt, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled || status.Code(err) == codes.DeadlineExceeded {
+				return nil
+			}
+			return fmt.Errorf("receiving: %w", err)
+		}
+		now := time.Now()
+		b.mu.Lock()
+		if s, ok := b.pending[evt.GetRequestId()]; ok && evt.GetRequestId() != "" {
+			delete(b.pending, evt.GetRequestId())
+			b.rec.observe(s.op, now.Sub(s.at), evt.GetError() != nil)
+		}
+		switch p := evt.GetPayload().(type) {
+		case *gamev1.ServerEvent_RoomView:
+			b.room = p.RoomView
+		case *gamev1.ServerEvent_RoundStart:
+			b.roundStart = now
+			b.roundLen = time.Duration(p.RoundStart.GetDurationMs()) * time.Millisecond
+		case *gamev1.ServerEvent_RoundEnd:
+			if !b.roundStart.IsZero() {
+				b.rec.observe(opRoundResolution, max(0, now.Sub(b.roundStart)-b.roundLen), false)
+				b.roundStart = time.Time{}
+			}
+		}
+		b.mu.Unlock()
+	}
+}
+
+// expire records commands unanswered for longer than replyTimeout as failed.
+func (b *bot) expire() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for id, s := range b.pending {
+		if waited := time.Since(s.at); waited > replyTimeout {
+			b.rec.observe(s.op, waited, true)
+			delete(b.pending, id)
+		}
+	}
+}
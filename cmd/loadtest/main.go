@@ -0,0 +1,127 @@
+// Command loadtest drives a game server with simulated players and reports
+// how quickly it answers them. Each player is a scripted gRPC client that
+// joins the world and then, with a pause between actions, wanders through
+// exits, chats, and attacks any hostile NPC in the room.
+//
+// The report lists latency percentiles per operation: the time from sending a
+// command to receiving the server's reply to it, and, for combat, how long
+// after a round's timer expired its end arrived ("round_resolution").
+//
+//	go run ./cmd/loadtest -addr 127.0.0.1:50051 -players 200 -duration 2m
+//
+// Players join with character ID 0, so nothing they do is persisted.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// options are the parsed command-line flags.
+type options struct {
+	addr     string
+	players  int
+	duration time.Duration
+	ramp     time.Duration
+	think    time.Duration
+	location string
+	prefix   string
+	seed     int64
+}
+
+// run is the testable entry point, accepting CLI args directly.
+func run(args []string, out io.Writer) error {
+	fset := flag.NewFlagSet("loadtest", flag.ContinueOnError)
+	var o options
+	fset.StringVar(&o.addr, "addr", "127.0.0.1:50051", "game server gRPC address")
+	fset.IntVar(&o.players, "players", 50, "number of simulated players")
+	fset.DurationVar(&o.duration, "duration", time.Minute, "how long to run, including the ramp")
+	fset.DurationVar(&o.ramp, "ramp", 10*time.Second, "spread the players' joins over this long")
+	fset.DurationVar(&o.think, "think", time.Second, "mean pause between a player's actions")
+	fset.StringVar(&o.location, "room", "", "room the players join in; empty uses the start room")
+	fset.StringVar(&o.prefix, "prefix", "loadtest", "prefix of the simulated players' names")
+	fset.Int64Var(&o.seed, "seed", 0, "random seed; 0 seeds from the clock")
+	if err := fset.Parse(args); err != nil {
+		return err
+	}
+	if o.players < 1 {
+		return errors.New("-players must be at least 1")
+	}
+	if o.duration <= 0 || o.think <= 0 || o.ramp < 0 {
+		return errors.New("-duration and -think must be positive and -ramp must not be negative")
+	}
+	if o.seed == 0 {
+		o.seed = time.Now().UnixNano()
+	}
+
+	conn, err := grpc.NewClient(o.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", o.addr, err)
+	}
+	defer conn.Close()
+	client := gamev1.NewGameServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.duration)
+	defer cancel()
+	rec := newRecorder()
+	start := time.Now()
+
+	var (
+		wg       sync.WaitGroup
+		failMu   sync.Mutex
+		failures []error
+	)
+	for i := 0; i < o.players; i++ {
+		delay := time.Duration(0)
+		if o.players > 1 {
+			delay = o.ramp * time.Duration(i) / time.Duration(o.players-1)
+		}
+		b := newBot(i, o, rec)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+			if err := b.run(ctx, client); err != nil {
+				failMu.Lock()
+				failures = append(failures, fmt.Errorf("%s: %w", b.name, err))
+				failMu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	writeReport(out, rec.summary(), time.Since(start), o.players)
+	for i, err := range failures {
+		if i == 5 {
+			fmt.Fprintf(out, "... and %d more player failures\n", len(failures)-i)
+			break
+		}
+		fmt.Fprintf(out, "player failed: %v\n", err)
+	}
+	if len(failures) == o.players {
+		return errors.New("every simulated player failed")
+	}
+	return nil
+}
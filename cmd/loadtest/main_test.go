@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// fakeGame answers every command at once: joins and moves with a room that
+// has an exit and a hostile NPC, attacks with a zero-length combat round,
+// and anything else with a message.
+type fakeGame struct {
+	gamev1.UnimplementedGameServiceServer
+}
+
+func (fakeGame) Session(stream gamev1.GameService_SessionServer) error {
+	room := &gamev1.RoomView{
+		RoomId: "alley",
+		Exits:  []*gamev1.ExitInfo{{Direction: "north"}, {Direction: "vault", Locked: true}},
+		Npcs:   []*gamev1.NpcInfo{{Name: "ganger"}, {Name: "fence", NpcType: "merchant"}},
+	}
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		var reply []*gamev1.ServerEvent
+		switch p := msg.GetPayload().(type) {
+		case *gamev1.ClientMessage_JoinWorld, *gamev1.ClientMessage_Move:
+			if m, ok := p.(*gamev1.ClientMessage_Move); ok && m.Move.GetDirection() != "north" {
+				reply = append(reply, &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Error{Error: &gamev1.ErrorEvent{Message: "no exit"}}})
+				break
+			}
+			reply = append(reply, &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_RoomView{RoomView: room}})
+		case *gamev1.ClientMessage_Attack:
+			if p.Attack.GetTarget() != "ganger" {
+				reply = append(reply, &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Error{Error: &gamev1.ErrorEvent{Message: "not hostile"}}})
+				break
+			}
+			reply = append(reply,
+				&gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: &gamev1.MessageEvent{Content: "You attack."}}},
+				&gamev1.ServerEvent{Payload: &gamev1.ServerEvent_RoundStart{RoundStart: &gamev1.RoundStartEvent{Round: 1}}},
+				&gamev1.ServerEvent{Payload: &gamev1.ServerEvent_RoundEnd{RoundEnd: &gamev1.RoundEndEvent{Round: 1}}},
+			)
+		default:
+			reply = append(reply, &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: &gamev1.MessageEvent{Content: "ok"}}})
+		}
+		reply[0].RequestId = msg.GetRequestId()
+		for _, evt := range reply {
+			if err := stream.Send(evt); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func startFakeGame(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	gamev1.RegisterGameServiceServer(srv, fakeGame{})
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+	return ln.Addr().String()
+}
+
+func TestRun_ReportsEveryOperation(t *testing.T) {
+	addr := startFakeGame(t)
+	var out bytes.Buffer
+	err := run([]string{
+		"-addr", addr, "-players", "4", "-duration", "500ms", "-ramp", "50ms", "-think", "5ms", "-seed", "7",
+	}, &out)
+	require.NoError(t, err)
+
+	report := out.String()
+	assert.Contains(t, report, "4 players for")
+	for _, op := range []string{opJoin, opMove, opSay, opAttack, opRoundResolution} {
+		assert.Contains(t, report, op)
+	}
+	assert.NotContains(t, report, "player failed")
+}
+
+func TestRun_RejectsBadFlags(t *testing.T) {
+	var out bytes.Buffer
+	assert.Error(t, run([]string{"-players", "0"}, &out))
+	assert.Error(t, run([]string{"-think", "0s"}, &out))
+}
+
+func TestRecorder_Summary(t *testing.T) {
+	r := newRecorder()
+	for i := 1; i <= 100; i++ {
+		r.observe(opMove, time.Duration(i)*time.Millisecond, false)
+	}
+	r.observe(opMove, time.Second, true)
+	r.observe(opSay, time.Second, true)
+
+	got := r.summary()
+	require.Len(t, got, 2)
+	assert.Equal(t, opStats{
+		Op: opMove, Count: 100, Errors: 1,
+		P50: 50 * time.Millisecond, P90: 90 * time.Millisecond, P99: 99 * time.Millisecond, Max: 100 * time.Millisecond,
+	}, got[0])
+	assert.Equal(t, opStats{Op: opSay, Errors: 1}, got[1], "an op with only failures still appears")
+}
+
+func TestPercentile_SingleSample(t *testing.T) {
+	one := []time.Duration{3 * time.Millisecond}
+	assert.Equal(t, 3*time.Millisecond, percentile(one, 0.5))
+	assert.Equal(t, 3*time.Millisecond, percentile(one, 0.99))
+}
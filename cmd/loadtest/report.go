@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// recorder collects latency samples per operation. It is safe for concurrent use.
+type recorder struct {
+	mu      sync.Mutex
+	samples map[string][]time.Duration
+	errors  map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{samples: make(map[string][]time.Duration), errors: make(map[string]int)}
+}
+
+// observe records one op that took d.
+//
+// Postcondition: failed ops are counted as errors and kept out of the percentiles.
+func (r *recorder) observe(op string, d time.Duration, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if failed {
+		r.errors[op]++
+		return
+	}
+	r.samples[op] = append(r.samples[op], d)
+}
+
+// opStats summarizes one operation.
+type opStats struct {
+	Op                 string
+	Count, Errors      int
+	P50, P90, P99, Max time.Duration
+}
+
+// summary returns the stats of every operation seen, ordered by name.
+func (r *recorder) summary() []opStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ops := make(map[string]bool, len(r.samples))
+	for op := range r.samples {
+		ops[op] = true
+	}
+	for op := range r.errors {
+		ops[op] = true
+	}
+	out := make([]opStats, 0, len(ops))
+	for op := range ops {
+		sorted := append([]time.Duration(nil), r.samples[op]...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		st := opStats{Op: op, Count: len(sorted), Errors: r.errors[op]}
+		if len(sorted) > 0 {
+			st.P50 = percentile(sorted, 0.50)
+			st.P90 = percentile(sorted, 0.90)
+			st.P99 = percentile(sorted, 0.99)
+			st.Max = sorted[len(sorted)-1]
+		}
+		out = append(out, st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Op < out[j].Op })
+	return out
+}
+
+// percentile returns the nearest-rank q-th percentile of sorted.
+//
+// Precondition: sorted is ascending and non-empty; q is in (0, 1].
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	rank := int(q*float64(len(sorted))+0.999999) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// writeReport prints the per-operation table.
+func writeReport(out io.Writer, stats []opStats, elapsed time.Duration, players int) {
+	fmt.Fprintf(out, "%d players for %s\n", players, elapsed.Round(time.Millisecond))
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "op\tcount\terrors\tp50\tp90\tp99\tmax\t")
+	for _, st := range stats {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t\n", st.Op, st.Count, st.Errors,
+			ms(st.P50), ms(st.P90), ms(st.P99), ms(st.Max))
+	}
+	w.Flush()
+}
+
+// ms renders d in milliseconds with microsecond precision.
+func ms(d time.Duration) string {
+	return fmt.Sprintf("%.3fms", float64(d)/float64(time.Millisecond))
+}
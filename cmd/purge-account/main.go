@@ -0,0 +1,164 @@
+// Command purge-account erases an account's personal data: its characters
+// and everything they own, its settings, and every copy of its username and
+// character names kept in telemetry, player reports, and the admin audit log.
+// Everything for one account happens in a single transaction.
+//
+//	purge-account -username vex                  # show what would be purged
+//	purge-account -username vex -yes             # anonymize the account
+//	purge-account -username vex -mode delete -yes
+//	purge-account -expired -yes                  # delete accounts past their deletion grace period
+//
+// Anonymize keeps the account row, renamed and unable to sign in, and keeps
+// the game's history with names scrubbed; delete removes both. The game
+// server runs the -expired purge hourly on its own.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/cliactor"
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// options are the parsed command-line flags.
+type options struct {
+	configPath string
+	username   string
+	expired    bool
+	mode       postgres.PurgeMode
+	yes        bool
+}
+
+// parseFlags parses and validates args.
+func parseFlags(args []string) (options, error) {
+	fset := flag.NewFlagSet("purge-account", flag.ContinueOnError)
+	var o options
+	var mode string
+	fset.StringVar(&o.configPath, "config", "configs/dev.yaml", "path to configuration file")
+	fset.StringVar(&o.username, "username", "", "account to purge")
+	fset.BoolVar(&o.expired, "expired", false, "purge every account whose deletion grace period has elapsed")
+	fset.StringVar(&mode, "mode", "", "anonymize or delete (default anonymize for -username, delete for -expired)")
+	fset.BoolVar(&o.yes, "yes", false, "purge; without it only show what would be purged")
+	if err := fset.Parse(args); err != nil {
+		return options{}, err
+	}
+	if (o.username == "") == !o.expired {
+		return options{}, errors.New("give exactly one of -username or -expired")
+	}
+	switch {
+	case mode != "":
+		o.mode = postgres.PurgeMode(mode)
+	case o.expired:
+		o.mode = postgres.PurgeDelete
+	default:
+		o.mode = postgres.PurgeAnonymize
+	}
+	if !postgres.ValidPurgeMode(o.mode) {
+		return options{}, fmt.Errorf("invalid -mode %q: must be anonymize or delete", mode)
+	}
+	return o, nil
+}
+
+// run is the testable entry point, accepting CLI args directly.
+func run(args []string, out io.Writer) error {
+	o, err := parseFlags(args)
+	if err != nil {
+		return err
+	}
+	cfg, err := config.Load(o.configPath)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	pool, err := postgres.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return fmt.Errorf("connecting to database: %w", err)
+	}
+	defer pool.Close()
+
+	accounts := postgres.NewAccountRepository(pool.DB())
+	var ids []int64
+	if o.expired {
+		ids, err = accounts.ListExpiredDeletions(ctx, time.Now().Add(-postgres.AccountDeleteWindow))
+		if err != nil {
+			return err
+		}
+	} else {
+		acct, err := accounts.GetByUsername(ctx, o.username)
+		if err != nil {
+			return fmt.Errorf("looking up account %q: %w", o.username, err)
+		}
+		ids = []int64{acct.ID}
+	}
+	if len(ids) == 0 {
+		fmt.Fprintln(out, "no accounts to purge")
+		return nil
+	}
+
+	if !o.yes {
+		chars := postgres.NewCharacterRepository(pool.DB())
+		for _, id := range ids {
+			acct, err := accounts.GetByID(ctx, id)
+			if err != nil {
+				return fmt.Errorf("looking up account %d: %w", id, err)
+			}
+			owned, err := chars.ListByAccount(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing characters of account %d: %w", id, err)
+			}
+			deleted, err := chars.ListDeletedByAccount(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing characters of account %d: %w", id, err)
+			}
+			fmt.Fprintf(out, "would %s account %s (#%d) and its %d character(s)\n", o.mode, acct.Username, acct.ID, len(owned)+len(deleted))
+		}
+		fmt.Fprintln(out, "rerun with -yes to purge")
+		return nil
+	}
+
+	audit := postgres.NewAdminAuditRepository(pool.DB())
+	var failed int
+	for _, id := range ids {
+		report, err := accounts.PurgeAccount(ctx, id, o.mode)
+		outcome := "ok"
+		if err != nil {
+			outcome = err.Error()
+			failed++
+			fmt.Fprintf(out, "account #%d: %v\n", id, err)
+		} else {
+			fmt.Fprintf(out, "%sd account #%d: %d character(s), %d event(s), %d report(s)\n",
+				report.Mode, id, report.Characters, report.Events, report.Reports)
+		}
+		// The audit entry names the account by ID only; its username is what was purged.
+		args, _ := json.Marshal(map[string]any{"accountID": id, "mode": o.mode})
+		if err := audit.Record(ctx, postgres.AdminAuditEntry{
+			Actor:   cliactor.Name(),
+			Action:  "purge",
+			Target:  fmt.Sprintf("account #%d", id),
+			Args:    string(args),
+			Outcome: outcome,
+		}); err != nil {
+			fmt.Fprintf(out, "warning: recording audit entry: %v\n", err)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d account(s) failed to purge", failed, len(ids))
+	}
+	return nil
+}
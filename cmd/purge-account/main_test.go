@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestParseFlags_DefaultModes(t *testing.T) {
+	o, err := parseFlags([]string{"-username", "vex"})
+	require.NoError(t, err)
+	assert.Equal(t, postgres.PurgeAnonymize, o.mode)
+	assert.False(t, o.yes)
+
+	o, err = parseFlags([]string{"-expired", "-yes"})
+	require.NoError(t, err)
+	assert.Equal(t, postgres.PurgeDelete, o.mode)
+	assert.True(t, o.yes)
+
+	o, err = parseFlags([]string{"-username", "vex", "-mode", "delete"})
+	require.NoError(t, err)
+	assert.Equal(t, postgres.PurgeDelete, o.mode)
+}
+
+func TestParseFlags_Rejects(t *testing.T) {
+	for name, args := range map[string][]string{
+		"no target":   {},
+		"two targets": {"-username", "vex", "-expired"},
+		"bad mode":    {"-username", "vex", "-mode", "shred"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseFlags(args)
+			assert.Error(t, err)
+		})
+	}
+}
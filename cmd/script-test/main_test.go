@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, body string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(body), 0644))
+}
+
+func scriptRoot(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "zones", "docks", "quest.lua"), `
+function on_enter(uid, room_id, from_room_id)
+    if engine.player.take_item(uid, "crate_key") then
+        engine.player.adjust_currency(uid, 25)
+        engine.player.message(uid, "The dockmaster pays you.")
+    end
+end
+`)
+	writeFile(t, filepath.Join(root, "zones", "docks", "test_quest.lua"), `
+function test_turn_in_pays()
+    mock.entity("p1", {items = {crate_key = 1}, currency = 5})
+    on_enter("p1", "pier", "")
+    local p = mock.get("p1")
+    expect_eq(p.currency, 30)
+    expect_eq(p.items.crate_key, nil)
+    expect_eq(mock.messages("p1")[1], "The dockmaster pays you.")
+end
+
+function test_world_is_reset_between_tests()
+    expect_eq(mock.get("p1"), nil)
+end
+
+function test_no_key_no_pay()
+    mock.entity("p1", {currency = 5})
+    on_enter("p1", "pier", "")
+    expect_eq(mock.get("p1").currency, 6, "currency")
+end
+`)
+	writeFile(t, filepath.Join(root, "conditions", "bleed.lua"), `
+function bleed_on_tick(uid) engine.combat.apply_damage(uid, 2) end
+`)
+	writeFile(t, filepath.Join(root, "conditions", "test_bleed.lua"), `
+function test_bleed_ticks()
+    mock.entity("n1", {kind = "npc", hp = 5})
+    bleed_on_tick("n1")
+    bleed_on_tick("n1")
+    bleed_on_tick("n1")
+    expect_eq(mock.get("n1").hp, 0)
+end
+`)
+	return root
+}
+
+func TestRun_ReportsPassesAndFailures(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"-root", scriptRoot(t), "-v"}, &out)
+	require.ErrorIs(t, err, errFailed)
+	assert.Contains(t, out.String(), "ok   ")
+	assert.Contains(t, out.String(), "test_bleed_ticks")
+	assert.Contains(t, out.String(), "test_no_key_no_pay: ")
+	assert.Contains(t, out.String(), "currency: expected 6, got 5")
+	assert.Contains(t, out.String(), "3 passed, 1 failed")
+}
+
+func TestRun_FilterByName(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-root", scriptRoot(t), "-run", "turn_in|bleed"}, &out))
+	assert.Equal(t, "2 passed, 0 failed\n", out.String())
+}
+
+func TestRun_NoSpecs(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-root", t.TempDir()}, &out))
+	assert.Contains(t, out.String(), "no test_*.lua specs")
+}
+
+func TestRun_ContentSpecsPass(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-root", "../../content/scripts"}, &out), out.String())
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+// mockEntity is a player or NPC in the mock world.
+type mockEntity struct {
+	name       string
+	kind       string
+	faction    string
+	hp, maxHP  int
+	tempHP     int
+	ac         int
+	room       string
+	conditions []string
+	items      map[string]int
+	currency   int
+}
+
+// mockPrompt is the last question a script asked a player.
+type mockPrompt struct {
+	question string
+	options  []string
+}
+
+// mockWorld stands in for the gameserver behind the engine.* callbacks. Specs
+// build it through the mock global and inspect what their hooks did to it.
+type mockWorld struct {
+	entities   map[string]*mockEntity
+	rooms      map[string]string // room ID → title
+	hostiles   map[string][]string
+	messages   map[string][]string
+	broadcasts map[string][]string
+	prompts    map[string]mockPrompt
+	revealed   map[string][]string
+}
+
+func newMockWorld() *mockWorld {
+	w := &mockWorld{}
+	w.reset()
+	return w
+}
+
+// reset empties the world; it runs before each test.
+func (w *mockWorld) reset() {
+	w.entities = make(map[string]*mockEntity)
+	w.rooms = make(map[string]string)
+	w.hostiles = make(map[string][]string)
+	w.messages = make(map[string][]string)
+	w.broadcasts = make(map[string][]string)
+	w.prompts = make(map[string]mockPrompt)
+	w.revealed = make(map[string][]string)
+}
+
+func (w *mockWorld) info(uid string, e *mockEntity) *scripting.CombatantInfo {
+	return &scripting.CombatantInfo{
+		UID: uid, Name: e.name, HP: e.hp, MaxHP: e.maxHP, TempHP: e.tempHP, AC: e.ac,
+		Conditions: append([]string(nil), e.conditions...),
+		Kind:       e.kind, FactionID: e.faction,
+	}
+}
+
+// wire points every engine callback of mgr at w.
+func (w *mockWorld) wire(mgr *scripting.Manager) {
+	mgr.GetCombatant = func(uid string) *scripting.CombatantInfo {
+		e, ok := w.entities[uid]
+		if !ok {
+			return nil
+		}
+		return w.info(uid, e)
+	}
+	mgr.ApplyCondition = func(uid, condID string, stacks, duration int) error {
+		e, ok := w.entities[uid]
+		if !ok {
+			return fmt.Errorf("unknown entity %q", uid)
+		}
+		e.conditions = append(e.conditions, condID)
+		return nil
+	}
+	mgr.ApplyDamage = func(uid string, hp int) error {
+		e, ok := w.entities[uid]
+		if !ok {
+			return fmt.Errorf("unknown entity %q", uid)
+		}
+		absorbed := min(hp, e.tempHP)
+		e.tempHP -= absorbed
+		e.hp = max(e.hp-(hp-absorbed), 0)
+		return nil
+	}
+	mgr.GrantTempHP = func(uid string, hp int) error {
+		e, ok := w.entities[uid]
+		if !ok {
+			return fmt.Errorf("unknown entity %q", uid)
+		}
+		e.tempHP = max(e.tempHP, hp)
+		return nil
+	}
+	mgr.Broadcast = func(roomID, msg string) {
+		w.broadcasts[roomID] = append(w.broadcasts[roomID], msg)
+	}
+	mgr.QueryRoom = func(roomID string) *scripting.RoomInfo {
+		title, ok := w.rooms[roomID]
+		if !ok {
+			return nil
+		}
+		return &scripting.RoomInfo{ID: roomID, Title: title}
+	}
+	mgr.GetCombatantsInRoom = func(roomID string) []*scripting.CombatantInfo {
+		uids := make([]string, 0, len(w.entities))
+		for uid, e := range w.entities {
+			if e.room == roomID && e.hp > 0 {
+				uids = append(uids, uid)
+			}
+		}
+		sort.Strings(uids)
+		out := make([]*scripting.CombatantInfo, 0, len(uids))
+		for _, uid := range uids {
+			out = append(out, w.info(uid, w.entities[uid]))
+		}
+		return out
+	}
+	mgr.AddCombatant = func(roomID, templateID string) (string, error) {
+		if _, ok := w.rooms[roomID]; !ok {
+			return "", fmt.Errorf("no active combat in room %q", roomID)
+		}
+		uid := fmt.Sprintf("%s-%d", templateID, len(w.entities)+1)
+		w.entities[uid] = &mockEntity{name: templateID, kind: "npc", hp: 10, maxHP: 10, room: roomID}
+		return uid, nil
+	}
+	mgr.GetEntityRoom = func(uid string) string {
+		if e, ok := w.entities[uid]; ok {
+			return e.room
+		}
+		return ""
+	}
+	mgr.RevealZoneMap = func(uid, zoneID string) {
+		w.revealed[uid] = append(w.revealed[uid], zoneID)
+	}
+	mgr.GetFactionHostiles = func(factionID string) []string {
+		return w.hostiles[factionID]
+	}
+	mgr.CountItem = func(uid, itemDefID string) int {
+		if e, ok := w.entities[uid]; ok {
+			return e.items[itemDefID]
+		}
+		return 0
+	}
+	mgr.GiveItem = func(uid, itemDefID string, qty int) error {
+		e, ok := w.entities[uid]
+		if !ok {
+			return fmt.Errorf("player %q not found", uid)
+		}
+		e.items[itemDefID] += qty
+		return nil
+	}
+	mgr.TakeItem = func(uid, itemDefID string, qty int) error {
+		e, ok := w.entities[uid]
+		if !ok {
+			return fmt.Errorf("player %q not found", uid)
+		}
+		if e.items[itemDefID] < qty {
+			return fmt.Errorf("player carries %d of %q, need %d", e.items[itemDefID], itemDefID, qty)
+		}
+		e.items[itemDefID] -= qty
+		return nil
+	}
+	mgr.GetCurrency = func(uid string) int {
+		if e, ok := w.entities[uid]; ok {
+			return e.currency
+		}
+		return 0
+	}
+	mgr.AdjustCurrency = func(uid string, delta int) (int, error) {
+		e, ok := w.entities[uid]
+		if !ok {
+			return 0, fmt.Errorf("player %q not found", uid)
+		}
+		if e.currency+delta < 0 {
+			return e.currency, fmt.Errorf("insufficient credits: have %d, need %d", e.currency, -delta)
+		}
+		e.currency += delta
+		return e.currency, nil
+	}
+	mgr.SendMessage = func(uid, text string) {
+		w.messages[uid] = append(w.messages[uid], text)
+	}
+	mgr.SendPrompt = func(uid, question string, options []string) {
+		w.prompts[uid] = mockPrompt{question: question, options: options}
+	}
+}
+
+// install defines the mock global in L:
+//
+//	mock.entity(uid, {name, kind, faction, hp, max_hp, ac, room, currency, items, conditions})
+//	mock.room(room_id, title)
+//	mock.hostile(faction_id, {faction_id, ...})
+//	mock.get(uid)          -- {hp, currency, items, conditions, room} or nil
+//	mock.messages(uid)     -- messages sent to uid
+//	mock.broadcasts(room)  -- messages broadcast to room
+//	mock.prompt(uid)       -- {question, options} of the last prompt, or nil
+//	mock.revealed(uid)     -- zone IDs whose map was revealed to uid
+func (w *mockWorld) install(L *lua.LState) {
+	t := L.NewTable()
+	L.SetGlobal("mock", t)
+	L.SetField(t, "entity", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		spec := L.OptTable(2, L.NewTable())
+		e := &mockEntity{
+			name:     luaString(spec, "name", uid),
+			kind:     luaString(spec, "kind", "player"),
+			faction:  luaString(spec, "faction", ""),
+			hp:       luaInt(spec, "hp", 10),
+			ac:       luaInt(spec, "ac", 10),
+			room:     luaString(spec, "room", ""),
+			currency: luaInt(spec, "currency", 0),
+			items:    make(map[string]int),
+		}
+		e.maxHP = luaInt(spec, "max_hp", e.hp)
+		e.tempHP = luaInt(spec, "temp_hp", 0)
+		if items, ok := spec.RawGetString("items").(*lua.LTable); ok {
+			items.ForEach(func(k, v lua.LValue) {
+				if n, ok := v.(lua.LNumber); ok {
+					e.items[k.String()] = int(n)
+				}
+			})
+		}
+		e.conditions = luaStrings(spec.RawGetString("conditions"))
+		w.entities[uid] = e
+		return 0
+	}))
+	L.SetField(t, "room", L.NewFunction(func(L *lua.LState) int {
+		w.rooms[L.CheckString(1)] = L.CheckString(2)
+		return 0
+	}))
+	L.SetField(t, "hostile", L.NewFunction(func(L *lua.LState) int {
+		w.hostiles[L.CheckString(1)] = luaStrings(L.CheckTable(2))
+		return 0
+	}))
+	L.SetField(t, "get", L.NewFunction(func(L *lua.LState) int {
+		e, ok := w.entities[L.CheckString(1)]
+		if !ok {
+			L.Push(lua.LNil)
+			return 1
+		}
+		out := L.NewTable()
+		L.SetField(out, "hp", lua.LNumber(e.hp))
+		L.SetField(out, "temp_hp", lua.LNumber(e.tempHP))
+		L.SetField(out, "currency", lua.LNumber(e.currency))
+		L.SetField(out, "room", lua.LString(e.room))
+		items := L.NewTable()
+		for id, n := range e.items {
+			if n > 0 {
+				L.SetField(items, id, lua.LNumber(n))
+			}
+		}
+		L.SetField(out, "items", items)
+		L.SetField(out, "conditions", stringsTable(L, e.conditions))
+		L.Push(out)
+		return 1
+	}))
+	L.SetField(t, "messages", L.NewFunction(func(L *lua.LState) int {
+		L.Push(stringsTable(L, w.messages[L.CheckString(1)]))
+		return 1
+	}))
+	L.SetField(t, "broadcasts", L.NewFunction(func(L *lua.LState) int {
+		L.Push(stringsTable(L, w.broadcasts[L.CheckString(1)]))
+		return 1
+	}))
+	L.SetField(t, "prompt", L.NewFunction(func(L *lua.LState) int {
+		p, ok := w.prompts[L.CheckString(1)]
+		if !ok {
+			L.Push(lua.LNil)
+			return 1
+		}
+		out := L.NewTable()
+		L.SetField(out, "question", lua.LString(p.question))
+		L.SetField(out, "options", stringsTable(L, p.options))
+		L.Push(out)
+		return 1
+	}))
+	L.SetField(t, "revealed", L.NewFunction(func(L *lua.LState) int {
+		L.Push(stringsTable(L, w.revealed[L.CheckString(1)]))
+		return 1
+	}))
+}
+
+func luaString(t *lua.LTable, key, def string) string {
+	if s, ok := t.RawGetString(key).(lua.LString); ok {
+		return string(s)
+	}
+	return def
+}
+
+func luaInt(t *lua.LTable, key string, def int) int {
+	if n, ok := t.RawGetString(key).(lua.LNumber); ok {
+		return int(n)
+	}
+	return def
+}
+
+// luaStrings returns the string elements of the array table v.
+func luaStrings(v lua.LValue) []string {
+	t, ok := v.(*lua.LTable)
+	if !ok {
+		return nil
+	}
+	var out []string
+	for i := 1; i <= t.Len(); i++ {
+		out = append(out, t.RawGetInt(i).String())
+	}
+	return out
+}
+
+func stringsTable(L *lua.LState, ss []string) *lua.LTable {
+	t := L.NewTable()
+	for _, s := range ss {
+		t.Append(lua.LString(s))
+	}
+	return t
+}
@@ -1,14 +1,17 @@
-// Package main provides a CLI tool for setting account roles.
+// Package main provides a CLI tool for setting account roles and reviewing
+// the admin audit log.
 package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
+	"github.com/cory-johannsen/mud/internal/cliactor"
 	"github.com/cory-johannsen/mud/internal/config"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
@@ -17,17 +20,19 @@ func main() {
 	start := time.Now()
 
 	configPath := flag.String("config", "configs/dev.yaml", "path to configuration file")
-	username := flag.String("username", "", "target account username (required)")
-	role := flag.String("role", "", "role to assign: player, editor, or admin (required)")
+	username := flag.String("username", "", "target account username (required unless -audit)")
+	role := flag.String("role", "", "role to assign: player, editor, moderator, or admin (required unless -audit)")
+	audit := flag.Bool("audit", false, "list recent admin audit entries instead of setting a role; -username filters by actor or target")
+	limit := flag.Int("limit", postgres.DefaultAdminAuditLimit, "maximum number of audit entries to list with -audit")
 	flag.Parse()
 
-	if *username == "" || *role == "" {
+	if !*audit && (*username == "" || *role == "") {
 		flag.Usage()
 		os.Exit(1)
 	}
 
-	if !postgres.ValidRole(*role) {
-		log.Fatalf("invalid role %q: must be one of player, editor, admin", *role)
+	if !*audit && !postgres.ValidRole(*role) {
+		log.Fatalf("invalid role %q: must be one of player, editor, moderator, admin", *role)
 	}
 
 	cfg, err := config.Load(*configPath)
@@ -44,6 +49,20 @@ func main() {
 	}
 	defer pool.Close()
 
+	auditRepo := postgres.NewAdminAuditRepository(pool.DB())
+
+	if *audit {
+		entries, err := auditRepo.List(ctx, postgres.AdminAuditQuery{Subject: *username, Limit: *limit})
+		if err != nil {
+			log.Fatalf("listing audit entries: %v", err)
+		}
+		for _, e := range entries {
+			fmt.Fprintf(os.Stdout, "%s  %-16s %-12s %-16s %s  %s\n",
+				e.CreatedAt.UTC().Format(time.RFC3339), e.Actor, e.Action, e.Target, e.Args, e.Outcome)
+		}
+		return
+	}
+
 	repo := postgres.NewAccountRepository(pool.DB())
 
 	acct, err := repo.GetByUsername(ctx, *username)
@@ -55,6 +74,17 @@ func main() {
 		log.Fatalf("setting role: %v", err)
 	}
 
+	args, _ := json.Marshal(map[string]string{"targetUsername": acct.Username, "role": *role})
+	if err := auditRepo.Record(ctx, postgres.AdminAuditEntry{
+		Actor:   cliactor.Name(),
+		Action:  "setrole",
+		Target:  acct.Username,
+		Args:    string(args),
+		Outcome: "ok",
+	}); err != nil {
+		log.Printf("warning: recording audit entry: %v", err)
+	}
+
 	elapsed := time.Since(start)
 	fmt.Fprintf(os.Stdout, "set role for %s (#%d): %s -> %s [%s]\n",
 		acct.Username, acct.ID, acct.Role, *role, elapsed)
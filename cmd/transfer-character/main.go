@@ -0,0 +1,173 @@
+// Command transfer-character moves a character, and everything it owns such
+// as its inventory, equipment presets, stash, and housing, to another
+// account in a single transaction.
+//
+//	transfer-character -character Vex -to alice
+//	transfer-character -character Vex -from bob -to alice
+//
+// The character must be offline, or its session would save it back over the
+// transfer; the tool asks the game server for its sessions first. Pass
+// -offline to skip that check when the game server is not running.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cory-johannsen/mud/internal/cliactor"
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// options are the parsed command-line flags.
+type options struct {
+	configPath string
+	character  string
+	from       string
+	to         string
+	addr       string
+	offline    bool
+}
+
+// parseFlags parses and validates args.
+func parseFlags(args []string) (options, error) {
+	fset := flag.NewFlagSet("transfer-character", flag.ContinueOnError)
+	var o options
+	fset.StringVar(&o.configPath, "config", "configs/dev.yaml", "path to configuration file")
+	fset.StringVar(&o.character, "character", "", "name of the character to move")
+	fset.StringVar(&o.from, "from", "", "username of the account that must own the character (optional safety check)")
+	fset.StringVar(&o.to, "to", "", "username of the account to move the character to")
+	fset.StringVar(&o.addr, "addr", "", "game server gRPC address used to check the character is offline (default from config)")
+	fset.BoolVar(&o.offline, "offline", false, "skip the online check because the game server is not running")
+	if err := fset.Parse(args); err != nil {
+		return options{}, err
+	}
+	if o.character == "" || o.to == "" {
+		return options{}, errors.New("-character and -to are required")
+	}
+	if o.offline && o.addr != "" {
+		return options{}, errors.New("-addr and -offline are mutually exclusive")
+	}
+	return o, nil
+}
+
+// run is the testable entry point, accepting CLI args directly.
+func run(args []string, out io.Writer) error {
+	o, err := parseFlags(args)
+	if err != nil {
+		return err
+	}
+	cfg, err := config.Load(o.configPath)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	pool, err := postgres.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return fmt.Errorf("connecting to database: %w", err)
+	}
+	defer pool.Close()
+
+	chars := postgres.NewCharacterRepository(pool.DB())
+	accounts := postgres.NewAccountRepository(pool.DB())
+	charID, err := chars.GetIDByName(ctx, o.character)
+	if err != nil {
+		return fmt.Errorf("looking up character %q: %w", o.character, err)
+	}
+	if o.from != "" {
+		from, err := accounts.GetByUsername(ctx, o.from)
+		if err != nil {
+			return fmt.Errorf("looking up account %q: %w", o.from, err)
+		}
+		ch, err := chars.GetByID(ctx, charID)
+		if err != nil {
+			return fmt.Errorf("looking up character %q: %w", o.character, err)
+		}
+		if ch.AccountID != from.ID {
+			return fmt.Errorf("character %q does not belong to %s", o.character, from.Username)
+		}
+	}
+	to, err := accounts.GetByUsername(ctx, o.to)
+	if err != nil {
+		return fmt.Errorf("looking up account %q: %w", o.to, err)
+	}
+
+	if !o.offline {
+		addr := o.addr
+		if addr == "" {
+			addr = cfg.GameServer.Addr()
+		}
+		online, err := isOnline(ctx, addr, charID)
+		if err != nil {
+			return fmt.Errorf("checking whether %s is online (pass -offline if the game server is not running): %w", o.character, err)
+		}
+		if online {
+			return fmt.Errorf("%s is online; they must log out before the transfer", o.character)
+		}
+	}
+
+	fromID, err := chars.TransferToAccount(ctx, charID, to.ID, cfg.Characters)
+	outcome := "ok"
+	if err != nil {
+		outcome = err.Error()
+	}
+	auditArgs, _ := json.Marshal(map[string]any{"characterID": charID, "fromAccountID": fromID, "toAccountID": to.ID, "to": to.Username})
+	if auditErr := postgres.NewAdminAuditRepository(pool.DB()).Record(ctx, postgres.AdminAuditEntry{
+		Actor:   cliactor.Name(),
+		Action:  "transfer",
+		Target:  o.character,
+		Args:    string(auditArgs),
+		Outcome: outcome,
+	}); auditErr != nil {
+		fmt.Fprintf(out, "warning: recording audit entry: %v\n", auditErr)
+	}
+	if err != nil {
+		return fmt.Errorf("transferring %s to %s: %w", o.character, to.Username, err)
+	}
+	fmt.Fprintf(out, "transferred %s (#%d) from account #%d to %s (#%d)\n", o.character, charID, fromID, to.Username, to.ID)
+	return nil
+}
+
+// isOnline reports whether the game server at addr has a session for charID.
+func isOnline(ctx context.Context, addr string, charID int64) (bool, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return false, fmt.Errorf("connecting to %s: %w", addr, err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := gamev1.NewGameServiceClient(conn).AdminListSessions(ctx, &gamev1.AdminListSessionsRequest{})
+	if err != nil {
+		return false, fmt.Errorf("listing sessions: %w", err)
+	}
+	return hasSession(resp.GetSessions(), charID), nil
+}
+
+// hasSession reports whether sessions include charID.
+func hasSession(sessions []*gamev1.AdminSessionInfo, charID int64) bool {
+	for _, s := range sessions {
+		if s.GetCharId() == charID {
+			return true
+		}
+	}
+	return false
+}
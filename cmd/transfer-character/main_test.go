@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestParseFlags(t *testing.T) {
+	o, err := parseFlags([]string{"-character", "Vex", "-from", "bob", "-to", "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "Vex", o.character)
+	assert.Equal(t, "bob", o.from)
+	assert.Equal(t, "alice", o.to)
+	assert.False(t, o.offline)
+}
+
+func TestParseFlags_Rejects(t *testing.T) {
+	for name, args := range map[string][]string{
+		"no character":     {"-to", "alice"},
+		"no target":        {"-character", "Vex"},
+		"addr and offline": {"-character", "Vex", "-to", "alice", "-offline", "-addr", "localhost:1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseFlags(args)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHasSession(t *testing.T) {
+	sessions := []*gamev1.AdminSessionInfo{{CharId: 3}, {CharId: 7}}
+	assert.True(t, hasSession(sessions, 7))
+	assert.False(t, hasSession(sessions, 4))
+	assert.False(t, hasSession(nil, 7))
+}
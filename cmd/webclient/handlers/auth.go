@@ -5,13 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
 
 	"github.com/cory-johannsen/mud/cmd/webclient/middleware"
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
@@ -35,11 +38,30 @@ var usernameRe = regexp.MustCompile(`^[a-zA-Z0-9_]{3,20}$`)
 type AuthHandler struct {
 	store     AccountStore
 	jwtSecret string
+	throttle  *loginthrottle.Throttle // nil never locks anyone out
+	logger    *zap.Logger
 }
 
 // NewAuthHandler creates an AuthHandler.
 func NewAuthHandler(store AccountStore, jwtSecret string) *AuthHandler {
-	return &AuthHandler{store: store, jwtSecret: jwtSecret}
+	return &AuthHandler{store: store, jwtSecret: jwtSecret, logger: zap.NewNop()}
+}
+
+// WithLoginThrottle locks out accounts and addresses after repeated failed
+// logins. Sharing the telnet frontend's store makes a lockout apply to both.
+//
+// Postcondition: Returns h for chaining.
+func (h *AuthHandler) WithLoginThrottle(t *loginthrottle.Throttle) *AuthHandler {
+	h.throttle = t
+	return h
+}
+
+// WithLogger attaches a logger to the handler.
+//
+// Postcondition: Returns h for chaining.
+func (h *AuthHandler) WithLogger(l *zap.Logger) *AuthHandler {
+	h.logger = l
+	return h
 }
 
 // Login handles POST /api/auth/login.
@@ -52,18 +74,63 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
-	acct, err := h.store.Authenticate(r.Context(), req.Username, req.Password)
+	ctx := r.Context()
+	addrKey := loginthrottle.HostKey(r.RemoteAddr)
+	if wait, err := h.throttle.Locked(ctx, req.Username, addrKey, time.Now()); err != nil {
+		h.logger.Warn("checking login lockout", zap.String("username", req.Username), zap.Error(err))
+	} else if wait > 0 {
+		writeLockedOut(w, wait)
+		return
+	}
+	acct, err := h.store.Authenticate(ctx, req.Username, req.Password)
 	if err != nil {
-		if errors.Is(err, postgres.ErrInvalidCredentials) || errors.Is(err, postgres.ErrAccountNotFound) {
+		switch {
+		case errors.Is(err, postgres.ErrAccountNotFound):
+			h.recordLoginFailure(ctx, "", addrKey)
 			writeError(w, http.StatusUnauthorized, "invalid credentials")
-			return
+		case errors.Is(err, postgres.ErrInvalidCredentials):
+			if wait := h.recordLoginFailure(ctx, req.Username, addrKey); wait > 0 {
+				writeLockedOut(w, wait)
+				return
+			}
+			writeError(w, http.StatusUnauthorized, "invalid credentials")
+		default:
+			writeError(w, http.StatusInternalServerError, "authentication error")
 		}
-		writeError(w, http.StatusInternalServerError, "authentication error")
 		return
 	}
+	if err := h.throttle.Succeed(ctx, req.Username); err != nil {
+		h.logger.Warn("clearing login failures", zap.String("username", req.Username), zap.Error(err))
+	}
 	writeTokenResponse(w, http.StatusOK, acct, h.jwtSecret)
 }
 
+// recordLoginFailure records a failed login for username (which may be empty)
+// from addrKey and returns the lockout it started, if any.
+func (h *AuthHandler) recordLoginFailure(ctx context.Context, username, addrKey string) time.Duration {
+	wait, err := h.throttle.Fail(ctx, username, addrKey, time.Now())
+	if err != nil {
+		h.logger.Warn("recording login failure", zap.String("username", username), zap.Error(err))
+		return 0
+	}
+	if wait > 0 {
+		h.logger.Info("login locked out",
+			zap.String("username", username),
+			zap.String("addr", addrKey),
+			zap.Duration("lockout", wait),
+		)
+	}
+	return wait
+}
+
+// writeLockedOut refuses a login with 429 and a Retry-After of wait rounded up
+// to whole seconds.
+func writeLockedOut(w http.ResponseWriter, wait time.Duration) {
+	secs := int64((wait + time.Second - 1) / time.Second)
+	w.Header().Set("Retry-After", fmt.Sprintf("%d", secs))
+	writeError(w, http.StatusTooManyRequests, "too many failed login attempts")
+}
+
 // Register handles POST /api/auth/register.
 func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var req struct {
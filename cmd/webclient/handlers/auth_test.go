@@ -14,6 +14,8 @@ import (
 
 	"github.com/cory-johannsen/mud/cmd/webclient/handlers"
 	"github.com/cory-johannsen/mud/cmd/webclient/middleware"
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
@@ -136,6 +138,127 @@ func TestLogin(t *testing.T) {
 	}
 }
 
+func postLogin(h *handlers.AuthHandler, remoteAddr, username, password string) *httptest.ResponseRecorder {
+	bodyBytes, _ := json.Marshal(map[string]string{"username": username, "password": password})
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = remoteAddr
+	rr := httptest.NewRecorder()
+	h.Login(rr, req)
+	return rr
+}
+
+func newThrottledHandler(store handlers.AccountStore, failures loginthrottle.Store) *handlers.AuthHandler {
+	throttle := loginthrottle.New(config.LoginThrottleConfig{
+		AccountFreeAttempts: 2,
+		IPFreeAttempts:      4,
+		BaseLockout:         time.Minute,
+		MaxLockout:          time.Hour,
+		ResetAfter:          time.Hour,
+	}, failures)
+	return newTestHandler(store).WithLoginThrottle(throttle)
+}
+
+func TestLogin_LocksAccountAfterRepeatedFailures(t *testing.T) {
+	authenticated := 0
+	store := &fakeAccountStore{
+		authenticateFn: func(_ context.Context, _, p string) (postgres.Account, error) {
+			authenticated++
+			if p != "password123" {
+				return postgres.Account{}, postgres.ErrInvalidCredentials
+			}
+			return postgres.Account{ID: 1, Username: "alice", Role: "player"}, nil
+		},
+	}
+	failures := loginthrottle.NewMemoryStore()
+	h := newThrottledHandler(store, failures)
+
+	for i := 0; i < 2; i++ {
+		if rr := postLogin(h, "10.0.0.7:5555", "alice", "wrong"); rr.Code != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: status = %d, want %d", i+1, rr.Code, http.StatusUnauthorized)
+		}
+	}
+	rr := postLogin(h, "10.0.0.7:5555", "alice", "wrong")
+	if rr.Code != http.StatusTooManyRequests {
+		t.Fatalf("third failure: status = %d, want %d", rr.Code, http.StatusTooManyRequests)
+	}
+	if rr.Header().Get("Retry-After") != "60" {
+		t.Errorf("Retry-After = %q, want 60", rr.Header().Get("Retry-After"))
+	}
+
+	// The correct password from another address is refused without reaching the store.
+	before := authenticated
+	if rr := postLogin(h, "10.0.0.8:5555", "alice", "password123"); rr.Code != http.StatusTooManyRequests {
+		t.Errorf("locked login: status = %d, want %d", rr.Code, http.StatusTooManyRequests)
+	}
+	if authenticated != before {
+		t.Error("locked login reached the account store")
+	}
+
+	rec, err := failures.Load(context.Background(), loginthrottle.AccountKey("alice"))
+	if err != nil {
+		t.Fatalf("loading failures: %v", err)
+	}
+	if rec.Failures != 3 {
+		t.Errorf("recorded failures = %d, want 3", rec.Failures)
+	}
+}
+
+func TestLogin_UnknownAccountCountsAgainstAddressOnly(t *testing.T) {
+	store := &fakeAccountStore{
+		authenticateFn: func(_ context.Context, _, _ string) (postgres.Account, error) {
+			return postgres.Account{}, postgres.ErrAccountNotFound
+		},
+	}
+	failures := loginthrottle.NewMemoryStore()
+	h := newThrottledHandler(store, failures)
+
+	for i := 0; i < 4; i++ {
+		if rr := postLogin(h, "10.0.0.7:5555", "ghost", "x"); rr.Code != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: status = %d, want %d", i+1, rr.Code, http.StatusUnauthorized)
+		}
+	}
+	if rr := postLogin(h, "10.0.0.7:5555", "ghost", "x"); rr.Code != http.StatusUnauthorized {
+		t.Fatalf("fifth attempt: status = %d, want %d", rr.Code, http.StatusUnauthorized)
+	}
+	if rr := postLogin(h, "10.0.0.7:6666", "ghost", "x"); rr.Code != http.StatusTooManyRequests {
+		t.Errorf("address locked: status = %d, want %d", rr.Code, http.StatusTooManyRequests)
+	}
+
+	rec, err := failures.Load(context.Background(), loginthrottle.AccountKey("ghost"))
+	if err != nil {
+		t.Fatalf("loading failures: %v", err)
+	}
+	if rec.Failures != 0 {
+		t.Errorf("unknown account recorded %d failures, want 0", rec.Failures)
+	}
+}
+
+func TestLogin_SuccessClearsAccountFailures(t *testing.T) {
+	store := &fakeAccountStore{
+		authenticateFn: func(_ context.Context, _, p string) (postgres.Account, error) {
+			if p != "password123" {
+				return postgres.Account{}, postgres.ErrInvalidCredentials
+			}
+			return postgres.Account{ID: 1, Username: "alice", Role: "player"}, nil
+		},
+	}
+	failures := loginthrottle.NewMemoryStore()
+	h := newThrottledHandler(store, failures)
+
+	postLogin(h, "10.0.0.7:5555", "alice", "wrong")
+	if rr := postLogin(h, "10.0.0.7:5555", "alice", "password123"); rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	rec, err := failures.Load(context.Background(), loginthrottle.AccountKey("alice"))
+	if err != nil {
+		t.Fatalf("loading failures: %v", err)
+	}
+	if rec.Failures != 0 {
+		t.Errorf("failures after success = %d, want 0", rec.Failures)
+	}
+}
+
 func TestRegister(t *testing.T) {
 	tests := []struct {
 		name       string
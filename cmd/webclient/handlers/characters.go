@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/cory-johannsen/mud/internal/config"
 	postgres "github.com/cory-johannsen/mud/internal/storage/postgres"
 
 	"github.com/cory-johannsen/mud/internal/game/character"
@@ -32,14 +33,22 @@ type NameChecker interface {
 	IsNameAvailable(ctx context.Context, name string) (bool, error)
 }
 
-// CharacterDeleter permanently removes a character by ID, verifying account ownership.
+// AccountLookup loads an account by ID.
+type AccountLookup interface {
+	GetByID(ctx context.Context, id int64) (postgres.Account, error)
+}
+
+// CharacterDeleter soft-deletes a character by ID, verifying account ownership.
+// The character is purged once postgres.CharacterDeleteWindow elapses.
 type CharacterDeleter interface {
-	DeleteByID(ctx context.Context, accountID, charID int64) error
+	SoftDeleteByID(ctx context.Context, accountID, charID int64) error
 }
 
 // CharacterOptions holds ruleset data loaded at startup for the creation wizard.
 type CharacterOptions struct {
 	Regions      []*ruleset.Region
+	Heritages    []*ruleset.Heritage   // may be nil; the heritage step is then skipped
+	Backgrounds  *ruleset.Backgrounds  // may be nil; the background questionnaire is then skipped
 	Jobs         []*ruleset.Job
 	Archetypes   []*ruleset.Archetype
 	Teams        []*ruleset.Team
@@ -105,6 +114,7 @@ type CharacterResponse struct {
 	CurrentHP  int32  `json:"current_hp"`
 	MaxHP      int32  `json:"max_hp"`
 	Region     string `json:"region"`
+	Heritage   string `json:"heritage,omitempty"`
 	Archetype  string `json:"archetype"`
 	Location   string `json:"location,omitempty"`
 	IsOnline   bool   `json:"is_online"`
@@ -129,6 +139,9 @@ type CharacterHandler struct {
 	registry       *ActiveCharacterRegistry // may be nil
 	// roomLookup maps room ID → "Zone Name — Room Title". May be nil (falls back to raw room ID).
 	roomLookup     map[string]string
+	// slots limits characters per account; enforced only when accounts is non-nil.
+	slots    config.CharactersConfig
+	accounts AccountLookup // may be nil
 }
 
 // NewCharacterHandler creates a CharacterHandler.
@@ -155,6 +168,39 @@ func (h *CharacterHandler) WithGetter(g CharacterGetter) *CharacterHandler {
 	return h
 }
 
+// WithSlotLimits enforces per-account character limits on creation, resolving each
+// account's role and override through accounts.
+func (h *CharacterHandler) WithSlotLimits(slots config.CharactersConfig, accounts AccountLookup) *CharacterHandler {
+	h.slots = slots
+	h.accounts = accounts
+	return h
+}
+
+// checkSlotLimit reports why accountID may not create another character.
+//
+// Postcondition: Returns ("", nil) when creation is allowed or limits are not configured.
+func (h *CharacterHandler) checkSlotLimit(ctx context.Context, accountID int64) (string, error) {
+	if h.accounts == nil {
+		return "", nil
+	}
+	acct, err := h.accounts.GetByID(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+	limit := h.slots.SlotLimit(acct.Role, acct.CharacterSlots)
+	if limit == 0 {
+		return "", nil
+	}
+	chars, err := h.lister.ListByAccount(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+	if len(chars) >= limit {
+		return fmt.Sprintf("character limit reached (%d)", limit), nil
+	}
+	return "", nil
+}
+
 // WithDeleter attaches a CharacterDeleter for DELETE /api/characters/{id}.
 func (h *CharacterHandler) WithDeleter(d CharacterDeleter) *CharacterHandler {
 	h.deleter = d
@@ -231,6 +277,7 @@ func (h *CharacterHandler) ListCharacters(w http.ResponseWriter, r *http.Request
 func characterToResponse(c *character.Character, opts *CharacterOptions, reg *ActiveCharacterRegistry, roomLookup map[string]string) CharacterResponse {
 	job := c.Class
 	region := c.Region
+	heritage := c.Heritage
 	archetype := c.Team
 	if opts != nil {
 		for _, j := range opts.Jobs {
@@ -245,6 +292,12 @@ func characterToResponse(c *character.Character, opts *CharacterOptions, reg *Ac
 				break
 			}
 		}
+		for _, hr := range opts.Heritages {
+			if hr.ID == c.Heritage {
+				heritage = hr.Name
+				break
+			}
+		}
 		for _, t := range opts.Teams {
 			if t.ID == c.Team {
 				archetype = t.Name
@@ -270,6 +323,7 @@ func characterToResponse(c *character.Character, opts *CharacterOptions, reg *Ac
 		CurrentHP:  int32(c.CurrentHP),
 		MaxHP:      int32(c.MaxHP),
 		Region:     region,
+		Heritage:   heritage,
 		Archetype:  archetype,
 		Location:   location,
 		IsOnline:   isOnline,
@@ -296,6 +350,9 @@ type createCharacterRequest struct {
 	Job                string                     `json:"job"`
 	Team               string                     `json:"team"`
 	Region             string                     `json:"region"`
+	Heritage           string                     `json:"heritage"` // optional heritage ID
+	PointBuy           map[string]int             `json:"point_buy"` // optional ability point-buy adjustments
+	BackgroundAnswers  map[string]string          `json:"background_answers"` // optional background question ID → answer ID
 	Gender             string                     `json:"gender"`
 	ArchetypeBoosts    []string                   `json:"archetype_boosts"`     // player's chosen free archetype ability boosts
 	RegionBoosts       []string                   `json:"region_boosts"`        // player's chosen free region ability boosts
@@ -345,6 +402,15 @@ func (h *CharacterHandler) CreateCharacter(w http.ResponseWriter, r *http.Reques
 		return
 	}
 	accountID := AccountIDFromContext(r.Context())
+	if msg, err := h.checkSlotLimit(r.Context(), accountID); err != nil {
+		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+		return
+	} else if msg != "" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+		return
+	}
 	var c *character.Character
 	if h.options != nil {
 		var region *ruleset.Region
@@ -354,6 +420,29 @@ func (h *CharacterHandler) CreateCharacter(w http.ResponseWriter, r *http.Reques
 				break
 			}
 		}
+		var heritage *ruleset.Heritage
+		if req.Heritage != "" {
+			for _, hr := range h.options.Heritages {
+				if hr.ID == req.Heritage {
+					heritage = hr
+					break
+				}
+			}
+			if heritage == nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": "unknown heritage"})
+				return
+			}
+		}
+		if len(req.BackgroundAnswers) > 0 {
+			if err := h.options.Backgrounds.Validate(req.BackgroundAnswers); err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+				return
+			}
+		}
 		var job *ruleset.Job
 		for _, j := range h.options.Jobs {
 			if j.ID == req.Job {
@@ -369,14 +458,22 @@ func (h *CharacterHandler) CreateCharacter(w http.ResponseWriter, r *http.Reques
 			}
 		}
 		if region != nil && job != nil && team != nil {
-			built, buildErr := character.BuildWithJob(strings.TrimSpace(req.Name), region, job, team)
+			built, buildErr := character.BuildWithPointBuy(strings.TrimSpace(req.Name), region, heritage, job, team, req.PointBuy)
 			if buildErr != nil {
-				http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+				// Name, region, job, and team are already validated, so the
+				// remaining failure is a point-buy the rules reject.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": buildErr.Error()})
 				return
 			}
 			c = built
 			c.AccountID = accountID
 			c.Gender = req.Gender
+			c.BackgroundAnswers = req.BackgroundAnswers
+			if room := h.options.Backgrounds.Resolve(req.BackgroundAnswers).StartRoom; room != "" {
+				c.Location = room
+			}
 		}
 	}
 	if c == nil {
@@ -387,8 +484,11 @@ func (h *CharacterHandler) CreateCharacter(w http.ResponseWriter, r *http.Reques
 			Class:     req.Job,
 			Team:      req.Team,
 			Region:    req.Region,
+			Heritage:  req.Heritage,
 			Gender:    req.Gender,
 			Level:     1,
+
+			BackgroundAnswers: req.BackgroundAnswers,
 		}
 	}
 	created, err := h.creator.Create(r.Context(), c)
@@ -574,6 +674,46 @@ type regionResponse struct {
 	AbilityBoosts *abilityBoostGrantResponse `json:"ability_boosts,omitempty"`
 }
 
+type heritageTraitResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type heritageResponse struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Modifiers   map[string]int        `json:"modifiers,omitempty"`
+	Trait       heritageTraitResponse `json:"trait"`
+}
+
+type backgroundItemResponse struct {
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
+}
+
+type backgroundAnswerResponse struct {
+	ID        string                   `json:"id"`
+	Text      string                   `json:"text"`
+	Items     []backgroundItemResponse `json:"items,omitempty"`
+	Condition string                   `json:"condition,omitempty"`
+	StartRoom string                   `json:"start_room,omitempty"`
+}
+
+type backgroundQuestionResponse struct {
+	ID      string                     `json:"id"`
+	Prompt  string                     `json:"prompt"`
+	Answers []backgroundAnswerResponse `json:"answers"`
+}
+
+type pointBuyResponse struct {
+	Pool     int `json:"pool"`
+	MaxShift int `json:"max_shift"`
+	MinScore int `json:"min_score"`
+	MaxScore int `json:"max_score"`
+}
+
 type jobResponse struct {
 	ID                string               `json:"id"`
 	Name              string               `json:"name"`
@@ -661,10 +801,32 @@ func (h *CharacterHandler) spontaneousEntry(id string, level int) spontaneousEnt
 	return e
 }
 
+// backgroundsToResponse converts the background questionnaire for the options response.
+//
+// Postcondition: Returns an empty slice when b is nil.
+func backgroundsToResponse(b *ruleset.Backgrounds) []backgroundQuestionResponse {
+	out := make([]backgroundQuestionResponse, 0)
+	if b == nil {
+		return out
+	}
+	for _, q := range b.Questions {
+		qr := backgroundQuestionResponse{ID: q.ID, Prompt: q.Prompt, Answers: make([]backgroundAnswerResponse, 0, len(q.Answers))}
+		for _, a := range q.Answers {
+			ar := backgroundAnswerResponse{ID: a.ID, Text: a.Text, Condition: a.Condition, StartRoom: a.StartRoom}
+			for _, it := range a.Items {
+				ar.Items = append(ar.Items, backgroundItemResponse{Item: it.ItemID, Quantity: it.Quantity})
+			}
+			qr.Answers = append(qr.Answers, ar)
+		}
+		out = append(out, qr)
+	}
+	return out
+}
+
 // ListOptions handles GET /api/characters/options.
 //
 // Precondition: h.options MUST be non-nil (set via WithOptions at startup).
-// Postcondition: Returns JSON with regions, jobs, and archetypes arrays.
+// Postcondition: Returns JSON with regions, heritages, backgrounds, jobs, and archetypes arrays.
 func (h *CharacterHandler) ListOptions(w http.ResponseWriter, r *http.Request) {
 	if h.options == nil {
 		http.Error(w, `{"error":"options not loaded"}`, http.StatusInternalServerError)
@@ -687,6 +849,20 @@ func (h *CharacterHandler) ListOptions(w http.ResponseWriter, r *http.Request) {
 			AbilityBoosts: abr,
 		})
 	}
+	heritages := make([]heritageResponse, 0, len(h.options.Heritages))
+	for _, hr := range h.options.Heritages {
+		heritages = append(heritages, heritageResponse{
+			ID:          hr.ID,
+			Name:        hr.Name,
+			Description: hr.Description,
+			Modifiers:   hr.Modifiers,
+			Trait: heritageTraitResponse{
+				ID:          hr.Trait.ID,
+				Name:        hr.Trait.Name,
+				Description: hr.Trait.Description,
+			},
+		})
+	}
 	jobs := make([]jobResponse, 0, len(h.options.Jobs))
 	for _, job := range h.options.Jobs {
 		var sg *skillGrantsResponse
@@ -841,6 +1017,14 @@ func (h *CharacterHandler) ListOptions(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(map[string]any{
 		"regions":    regions,
+		"heritages":  heritages,
+		"backgrounds": backgroundsToResponse(h.options.Backgrounds),
+		"point_buy": pointBuyResponse{
+			Pool:     character.PointBuyPool,
+			MaxShift: character.PointBuyMaxShift,
+			MinScore: character.PointBuyMinScore,
+			MaxScore: character.PointBuyMaxScore,
+		},
 		"jobs":       jobs,
 		"archetypes": archetypes,
 		"teams":      teams,
@@ -909,7 +1093,7 @@ func (h *CharacterHandler) HandlePlay(w http.ResponseWriter, r *http.Request) {
 // DeleteCharacter handles DELETE /api/characters/{id}.
 //
 // Precondition: h.deleter MUST be set (via WithDeleter). Request context MUST carry account_id.
-// Postcondition: Deletes the character if owned by the caller; HTTP 403 if not owned; HTTP 404 if not found.
+// Postcondition: Soft-deletes the character if owned by the caller; HTTP 403 if not owned; HTTP 404 if not found.
 func (h *CharacterHandler) DeleteCharacter(w http.ResponseWriter, r *http.Request) {
 	if h.deleter == nil {
 		http.Error(w, `{"error":"delete not configured"}`, http.StatusInternalServerError)
@@ -926,7 +1110,7 @@ func (h *CharacterHandler) DeleteCharacter(w http.ResponseWriter, r *http.Reques
 		return
 	}
 	accountID := AccountIDFromContext(r.Context())
-	if err := h.deleter.DeleteByID(r.Context(), accountID, charID); err != nil {
+	if err := h.deleter.SoftDeleteByID(r.Context(), accountID, charID); err != nil {
 		if errors.Is(err, postgres.ErrCharacterNotFound) {
 			http.Error(w, `{"error":"character not found"}`, http.StatusNotFound)
 			return
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cory-johannsen/mud/cmd/webclient/handlers"
+	"github.com/cory-johannsen/mud/internal/config"
 	"github.com/cory-johannsen/mud/internal/game/character"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	postgres "github.com/cory-johannsen/mud/internal/storage/postgres"
@@ -109,6 +110,39 @@ func TestCreateCharacter_Success(t *testing.T) {
 	assert.Equal(t, "Mira", resp.Character.Name)
 }
 
+// stubAccountLookup implements handlers.AccountLookup for tests.
+type stubAccountLookup struct {
+	acct postgres.Account
+}
+
+func (s *stubAccountLookup) GetByID(_ context.Context, _ int64) (postgres.Account, error) {
+	return s.acct, nil
+}
+
+func TestCreateCharacter_SlotLimit(t *testing.T) {
+	existing := []*character.Character{{ID: 1, Name: "One"}, {ID: 2, Name: "Two"}}
+	slots := config.CharactersConfig{MaxPerAccount: 2}
+	body := `{"name":"Mira","job":"ganger","team":"gun","region":"rustbucket","gender":"female"}`
+
+	creator := &stubCreator{result: &character.Character{ID: 7, Name: "Mira"}}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{chars: existing}, creator, nil).
+		WithSlotLimits(slots, &stubAccountLookup{acct: postgres.Account{ID: 42, Role: postgres.RolePlayer}})
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 42))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Contains(t, rr.Body.String(), "character limit reached (2)")
+
+	h = handlers.NewCharacterHandler(&stubCharacterRepo{chars: existing}, creator, nil).
+		WithSlotLimits(slots, &stubAccountLookup{acct: postgres.Account{ID: 42, Role: postgres.RolePlayer, CharacterSlots: 3}})
+	req = httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 42))
+	rr = httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+	assert.Equal(t, http.StatusCreated, rr.Code, "an account override raises the limit")
+}
+
 func TestCreateCharacter_NameTooShort(t *testing.T) {
 	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, &stubCreator{}, nil)
 	body := `{"name":"ab","job":"ganger","team":"gun","region":"rustbucket","gender":"female"}`
@@ -454,7 +488,7 @@ type stubDeleter struct {
 	lastID   int64
 }
 
-func (s *stubDeleter) DeleteByID(_ context.Context, accountID, charID int64) error {
+func (s *stubDeleter) SoftDeleteByID(_ context.Context, accountID, charID int64) error {
 	s.called = true
 	s.lastAcct = accountID
 	s.lastID = charID
@@ -620,3 +654,184 @@ func TestListCharacters_IsOnlinePopulatedFromRegistry(t *testing.T) {
 	assert.True(t, zork.IsOnline, "Zork should be online (registered)")
 	assert.False(t, mira.IsOnline, "Mira should not be online (not registered)")
 }
+
+// recordingCreator returns the character it was given, with an ID assigned.
+type recordingCreator struct {
+	got *character.Character
+}
+
+func (r *recordingCreator) Create(_ context.Context, c *character.Character) (*character.Character, error) {
+	r.got = c
+	out := *c
+	out.ID = 9
+	return &out, nil
+}
+
+func heritageTestOptions() *handlers.CharacterOptions {
+	return &handlers.CharacterOptions{
+		Regions: []*ruleset.Region{{ID: "rustbucket", Name: "Rustbucket Ridge"}},
+		Heritages: []*ruleset.Heritage{{
+			ID: "street_born", Name: "Street-Born",
+			Modifiers: map[string]int{"quickness": 2},
+			Trait:     ruleset.HeritageTrait{ID: "alley_sense", Name: "Alley Sense"},
+		}},
+		Jobs:  []*ruleset.Job{{ID: "ganger", Name: "Ganger", HitPointsPerLevel: 8}},
+		Teams: []*ruleset.Team{{ID: "gun", Name: "Gun"}},
+	}
+}
+
+func TestCreateCharacter_AppliesHeritage(t *testing.T) {
+	creator := &recordingCreator{}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, creator, nil).WithOptions(heritageTestOptions())
+
+	body := `{"name":"Kira","job":"ganger","team":"gun","region":"rustbucket","heritage":"street_born","gender":"female"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 10))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	require.NotNil(t, creator.got)
+	assert.Equal(t, "street_born", creator.got.Heritage)
+	assert.Equal(t, 12, creator.got.Abilities.Quickness)
+	assert.Contains(t, rr.Body.String(), `"heritage":"Street-Born"`)
+}
+
+func TestCreateCharacter_UnknownHeritage(t *testing.T) {
+	creator := &recordingCreator{}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, creator, nil).WithOptions(heritageTestOptions())
+
+	body := `{"name":"Kira","job":"ganger","team":"gun","region":"rustbucket","heritage":"moon_born","gender":"female"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 10))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "unknown heritage")
+	assert.Nil(t, creator.got)
+}
+
+func TestListOptions_IncludesHeritages(t *testing.T) {
+	h := handlers.NewCharacterHandler(nil, nil, nil).WithOptions(heritageTestOptions())
+	req := httptest.NewRequest(http.MethodGet, "/api/characters/options", nil)
+	rr := httptest.NewRecorder()
+	h.ListOptions(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var body struct {
+		Heritages []struct {
+			ID    string `json:"id"`
+			Trait struct {
+				Name string `json:"name"`
+			} `json:"trait"`
+		} `json:"heritages"`
+	}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	require.Len(t, body.Heritages, 1)
+	assert.Equal(t, "street_born", body.Heritages[0].ID)
+	assert.Equal(t, "Alley Sense", body.Heritages[0].Trait.Name)
+}
+
+func TestCreateCharacter_AppliesPointBuy(t *testing.T) {
+	creator := &recordingCreator{}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, creator, nil).WithOptions(heritageTestOptions())
+
+	body := `{"name":"Kira","job":"ganger","team":"gun","region":"rustbucket","gender":"female","point_buy":{"grit":2,"flair":-2}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 10))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	require.NotNil(t, creator.got)
+	assert.Equal(t, 12, creator.got.Abilities.Grit)
+	assert.Equal(t, 8, creator.got.Abilities.Flair)
+}
+
+func TestCreateCharacter_RejectsInvalidPointBuy(t *testing.T) {
+	creator := &recordingCreator{}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, creator, nil).WithOptions(heritageTestOptions())
+
+	body := `{"name":"Kira","job":"ganger","team":"gun","region":"rustbucket","gender":"female","point_buy":{"grit":2}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 10))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "must balance")
+	assert.Nil(t, creator.got)
+}
+
+func backgroundTestOptions() *handlers.CharacterOptions {
+	opts := heritageTestOptions()
+	opts.Backgrounds = &ruleset.Backgrounds{Questions: []*ruleset.BackgroundQuestion{{
+		ID: "upbringing", Prompt: "Where did you grow up?",
+		Answers: []*ruleset.BackgroundAnswer{{
+			ID: "tunnels", Text: "In the tunnels.", StartRoom: "downtown_underground",
+			Items: []ruleset.BackgroundItem{{ItemID: "scrap_bandage", Quantity: 2}},
+		}},
+	}}}
+	return opts
+}
+
+func TestCreateCharacter_AppliesBackground(t *testing.T) {
+	creator := &recordingCreator{}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, creator, nil).WithOptions(backgroundTestOptions())
+
+	body := `{"name":"Kira","job":"ganger","team":"gun","region":"rustbucket","gender":"female","background_answers":{"upbringing":"tunnels"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 10))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	require.NotNil(t, creator.got)
+	assert.Equal(t, map[string]string{"upbringing": "tunnels"}, creator.got.BackgroundAnswers)
+	assert.Equal(t, "downtown_underground", creator.got.Location)
+}
+
+func TestCreateCharacter_RejectsUnknownBackgroundAnswer(t *testing.T) {
+	creator := &recordingCreator{}
+	h := handlers.NewCharacterHandler(&stubCharacterRepo{}, creator, nil).WithOptions(backgroundTestOptions())
+
+	body := `{"name":"Kira","job":"ganger","team":"gun","region":"rustbucket","gender":"female","background_answers":{"upbringing":"moon"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/characters", strings.NewReader(body))
+	req = req.WithContext(handlers.WithAccountID(req.Context(), 10))
+	rr := httptest.NewRecorder()
+	h.CreateCharacter(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "unknown answer")
+	assert.Nil(t, creator.got)
+}
+
+func TestListOptions_IncludesBackgrounds(t *testing.T) {
+	h := handlers.NewCharacterHandler(nil, nil, nil).WithOptions(backgroundTestOptions())
+	req := httptest.NewRequest(http.MethodGet, "/api/characters/options", nil)
+	rr := httptest.NewRecorder()
+	h.ListOptions(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var body struct {
+		Backgrounds []struct {
+			ID      string `json:"id"`
+			Answers []struct {
+				ID        string `json:"id"`
+				StartRoom string `json:"start_room"`
+				Items     []struct {
+					Item     string `json:"item"`
+					Quantity int    `json:"quantity"`
+				} `json:"items"`
+			} `json:"answers"`
+		} `json:"backgrounds"`
+	}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	require.Len(t, body.Backgrounds, 1)
+	require.Len(t, body.Backgrounds[0].Answers, 1)
+	a := body.Backgrounds[0].Answers[0]
+	assert.Equal(t, "downtown_underground", a.StartRoom)
+	require.Len(t, a.Items, 1)
+	assert.Equal(t, 2, a.Items[0].Quantity)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 
 	"google.golang.org/protobuf/proto"
@@ -29,3 +30,8 @@ func ServerEventEncodedChoiceForTest(event *gamev1.ServerEvent) (json.RawMessage
 func ServerEventInnerForTest(event *gamev1.ServerEvent) (proto.Message, string) {
 	return serverEventInner(event)
 }
+
+// CommandRegistryForTest exposes commandRegistry for unit tests.
+func (h *WSHandler) CommandRegistryForTest(ctx context.Context) *command.Registry {
+	return h.commandRegistry(ctx)
+}
@@ -75,6 +75,10 @@ func (m *mockGameServiceClient) AdminGiveCurrency(_ context.Context, _ *gamev1.A
 	panic("not used in tests")
 }
 
+func (m *mockGameServiceClient) GetCommands(_ context.Context, _ *gamev1.GetCommandsRequest, _ ...grpc.CallOption) (*gamev1.GetCommandsResponse, error) {
+	panic("not used in tests")
+}
+
 // TestGRPCSessionManager_AllSessions_MapsFields verifies that two AdminSessionInfo entries
 // are correctly mapped to ManagedSession implementations.
 func TestGRPCSessionManager_AllSessions_MapsFields(t *testing.T) {
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+	postgres "github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// TranscriptStore reads the session transcripts recorded by an account's characters.
+type TranscriptStore interface {
+	ListTranscripts(ctx context.Context, accountID int64) ([]transcript.Transcript, error)
+	GetTranscript(ctx context.Context, accountID, id int64) (transcript.Transcript, error)
+}
+
+// TranscriptHandler serves session transcripts for download.
+type TranscriptHandler struct {
+	store TranscriptStore
+}
+
+// NewTranscriptHandler creates a TranscriptHandler.
+//
+// Precondition: store must be non-nil.
+func NewTranscriptHandler(store TranscriptStore) *TranscriptHandler {
+	return &TranscriptHandler{store: store}
+}
+
+// transcriptSummary is one entry in the transcript listing.
+type transcriptSummary struct {
+	ID        int64     `json:"id"`
+	CharName  string    `json:"char_name"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+}
+
+// ListTranscripts handles GET /api/transcripts.
+//
+// Postcondition: Returns the account's transcripts, newest first, as a JSON array.
+func (h *TranscriptHandler) ListTranscripts(w http.ResponseWriter, r *http.Request) {
+	list, err := h.store.ListTranscripts(r.Context(), AccountIDFromContext(r.Context()))
+	if err != nil {
+		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+		return
+	}
+	out := make([]transcriptSummary, 0, len(list))
+	for _, t := range list {
+		out = append(out, transcriptSummary{ID: t.ID, CharName: t.CharName, StartedAt: t.StartedAt, EndedAt: t.EndedAt})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// DownloadTranscript handles GET /api/transcripts/{id}.
+//
+// Postcondition: Returns the transcript as a plain-text attachment; HTTP 404
+// when it does not exist or belongs to another account.
+func (h *TranscriptHandler) DownloadTranscript(w http.ResponseWriter, r *http.Request) {
+	var id int64
+	if _, err := fmt.Sscan(r.PathValue("id"), &id); err != nil {
+		http.Error(w, `{"error":"invalid id"}`, http.StatusBadRequest)
+		return
+	}
+	t, err := h.store.GetTranscript(r.Context(), AccountIDFromContext(r.Context()), id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrTranscriptNotFound) {
+			http.Error(w, `{"error":"transcript not found"}`, http.StatusNotFound)
+			return
+		}
+		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="transcript-%d.txt"`, t.ID))
+	fmt.Fprintf(w, "Session log for %s, %s to %s\n\n", t.CharName,
+		t.StartedAt.UTC().Format(time.RFC3339), t.EndedAt.UTC().Format(time.RFC3339))
+	_, _ = w.Write([]byte(t.Body))
+}
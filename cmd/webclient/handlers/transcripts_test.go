@@ -0,0 +1,88 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/cmd/webclient/handlers"
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+	postgres "github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// stubTranscriptStore holds transcripts for account 42 only.
+type stubTranscriptStore struct {
+	transcripts []transcript.Transcript
+}
+
+func (s *stubTranscriptStore) ListTranscripts(_ context.Context, accountID int64) ([]transcript.Transcript, error) {
+	if accountID != 42 {
+		return nil, nil
+	}
+	return s.transcripts, nil
+}
+
+func (s *stubTranscriptStore) GetTranscript(_ context.Context, accountID, id int64) (transcript.Transcript, error) {
+	for _, t := range s.transcripts {
+		if t.ID == id && accountID == 42 {
+			return t, nil
+		}
+	}
+	return transcript.Transcript{}, postgres.ErrTranscriptNotFound
+}
+
+func newTranscriptStore() *stubTranscriptStore {
+	start := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	return &stubTranscriptStore{transcripts: []transcript.Transcript{
+		{ID: 7, CharName: "Mira", StartedAt: start, EndedAt: start.Add(time.Hour), Body: "[20:00:01] You wave.\n"},
+	}}
+}
+
+func transcriptRequest(path, id string, accountID int64) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if id != "" {
+		req.SetPathValue("id", id)
+	}
+	return req.WithContext(handlers.WithAccountID(req.Context(), accountID))
+}
+
+func TestListTranscripts(t *testing.T) {
+	h := handlers.NewTranscriptHandler(newTranscriptStore())
+	rr := httptest.NewRecorder()
+	h.ListTranscripts(rr, transcriptRequest("/api/transcripts", "", 42))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []map[string]any
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, float64(7), got[0]["id"])
+	assert.Equal(t, "Mira", got[0]["char_name"])
+	assert.NotContains(t, got[0], "body")
+
+	rr = httptest.NewRecorder()
+	h.ListTranscripts(rr, transcriptRequest("/api/transcripts", "", 9))
+	assert.JSONEq(t, "[]", rr.Body.String())
+}
+
+func TestDownloadTranscript(t *testing.T) {
+	h := handlers.NewTranscriptHandler(newTranscriptStore())
+	rr := httptest.NewRecorder()
+	h.DownloadTranscript(rr, transcriptRequest("/api/transcripts/7", "7", 42))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `attachment; filename="transcript-7.txt"`, rr.Header().Get("Content-Disposition"))
+	assert.Contains(t, rr.Body.String(), "Session log for Mira, 2026-03-01T20:00:00Z to 2026-03-01T21:00:00Z")
+	assert.Contains(t, rr.Body.String(), "[20:00:01] You wave.")
+
+	rr = httptest.NewRecorder()
+	h.DownloadTranscript(rr, transcriptRequest("/api/transcripts/7", "7", 9))
+	assert.Equal(t, http.StatusNotFound, rr.Code, "other accounts can't download it")
+
+	rr = httptest.NewRecorder()
+	h.DownloadTranscript(rr, transcriptRequest("/api/transcripts/x", "x", 42))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/cory-johannsen/mud/cmd/webclient/session"
 	"github.com/cory-johannsen/mud/internal/game/character"
 	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/settings"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/siteban"
 )
 
 var wsUpgrader = websocket.Upgrader{
@@ -34,6 +36,11 @@ type GameDialer interface {
 	Session(ctx context.Context, opts ...grpc.CallOption) (gamev1.GameService_SessionClient, error)
 }
 
+// CommandCatalogGetter fetches the game server's command catalog.
+type CommandCatalogGetter interface {
+	GetCommands(ctx context.Context, in *gamev1.GetCommandsRequest, opts ...grpc.CallOption) (*gamev1.GetCommandsResponse, error)
+}
+
 // CharacterGetter loads a character by ID.
 type CharacterGetter interface {
 	GetByID(ctx context.Context, id int64) (*character.Character, error)
@@ -44,6 +51,11 @@ type AccountUsernameGetter interface {
 	GetUsernameByID(ctx context.Context, id int64) (string, error)
 }
 
+// AccountSettingsLoader loads account-level preferences for inclusion in JoinWorldRequest.
+type AccountSettingsLoader interface {
+	Get(ctx context.Context, accountID int64) (settings.Settings, error)
+}
+
 // wsClaims holds the JWT claims for a WebSocket session.
 type wsClaims struct {
 	AccountID   int64  `json:"account_id"`
@@ -61,8 +73,10 @@ type WSHandler struct {
 	dialer        GameDialer
 	charGetter    CharacterGetter
 	accountGetter AccountUsernameGetter    // may be nil; username falls back to synthetic "user_<id>"
+	settings      AccountSettingsLoader    // may be nil; JoinWorldRequest then carries default settings
 	bus           *eventbus.EventBus       // may be nil; if set, server events are published
 	registry      *ActiveCharacterRegistry // may be nil; if set, tracks active character sessions
+	catalog       CommandCatalogGetter     // may be nil; commands then resolve against the built-in registry
 	logger        *zap.Logger
 }
 
@@ -85,6 +99,20 @@ func (h *WSHandler) WithAccountGetter(ag AccountUsernameGetter) *WSHandler {
 	return h
 }
 
+// WithAccountSettings attaches an AccountSettingsLoader so the WS handler can send the
+// account's stored preferences in JoinWorldRequest.
+func (h *WSHandler) WithAccountSettings(l AccountSettingsLoader) *WSHandler {
+	h.settings = l
+	return h
+}
+
+// WithCommandCatalog attaches a CommandCatalogGetter so typed commands resolve
+// against the game server's catalog instead of the built-in registry.
+func (h *WSHandler) WithCommandCatalog(c CommandCatalogGetter) *WSHandler {
+	h.catalog = c
+	return h
+}
+
 // WithEventBus attaches an EventBus; all received gRPC ServerEvents will be published.
 func (h *WSHandler) WithEventBus(bus *eventbus.EventBus) *WSHandler {
 	h.bus = bus
@@ -180,7 +208,8 @@ func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		defer h.registry.Deregister(char.ID)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	// Forward the player's address so the game server can enforce site bans.
+	ctx, cancel := context.WithCancel(siteban.WithClientAddr(context.Background(), r.RemoteAddr))
 
 	stream, err := h.dialer.Session(ctx)
 	if err != nil {
@@ -198,6 +227,16 @@ func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Load account settings; fall back to defaults if the loader is unavailable or fails.
+	var accountSettings settings.Settings
+	if h.settings != nil {
+		if st, err := h.settings.Get(r.Context(), claims.AccountID); err == nil {
+			accountSettings = st
+		} else {
+			h.logger.Warn("loading account settings", zap.Int64("account_id", claims.AccountID), zap.Error(err))
+		}
+	}
+
 	// Send JoinWorldRequest with all required fields.
 	joinMsg := &gamev1.ClientMessage{
 		RequestId: "join-0",
@@ -214,6 +253,7 @@ func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				Class:         char.Class,
 				Level:         int32(char.Level),
 				Archetype:     char.Team,
+				Settings:      accountSettings.ToProto(),
 			},
 		},
 	}
@@ -224,6 +264,8 @@ func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	registry := h.commandRegistry(ctx)
+
 	sess := session.New(ctx, cancel, wsConn, stream)
 	sess.Run()
 
@@ -233,7 +275,7 @@ func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	go func() {
 		defer wg.Done()
 		defer sess.Close(websocket.CloseNormalClosure, "")
-		h.wsToGRPC(ctx, wsConn, stream)
+		h.wsToGRPC(ctx, wsConn, stream, registry)
 	}()
 
 	go func() {
@@ -246,9 +288,36 @@ func (h *WSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	sess.Wait()
 }
 
+// commandCatalogTimeout bounds the GetCommands call made as a session starts.
+const commandCatalogTimeout = 5 * time.Second
+
+// commandRegistry fetches the game server's command catalog.
+//
+// Postcondition: Falls back to the built-in commands when no catalog getter
+// is attached or the server cannot provide a usable catalog.
+func (h *WSHandler) commandRegistry(ctx context.Context) *command.Registry {
+	if h.catalog == nil {
+		return command.DefaultRegistry()
+	}
+	ctx, cancel := context.WithTimeout(ctx, commandCatalogTimeout)
+	defer cancel()
+	resp, err := h.catalog.GetCommands(ctx, &gamev1.GetCommandsRequest{})
+	if err != nil {
+		h.logger.Warn("fetching command catalog; using built-in commands", zap.Error(err))
+		return command.DefaultRegistry()
+	}
+	registry, err := command.RegistryFromProto(resp.GetCommands())
+	if err != nil {
+		h.logger.Warn("invalid command catalog; using built-in commands", zap.Error(err))
+		return command.DefaultRegistry()
+	}
+	return registry
+}
+
 // wsToGRPC reads JSON frames from the WebSocket and forwards them as ClientMessage protos.
-func (h *WSHandler) wsToGRPC(ctx context.Context, wsConn *websocket.Conn, stream gamev1.GameService_SessionClient) {
-	registry := command.DefaultRegistry()
+//
+// Precondition: registry must be non-nil.
+func (h *WSHandler) wsToGRPC(ctx context.Context, wsConn *websocket.Conn, stream gamev1.GameService_SessionClient, registry *command.Registry) {
 	requestID := 0
 	for {
 		select {
@@ -353,6 +422,10 @@ func serverEventInner(event *gamev1.ServerEvent) (proto.Message, string) {
 		return p.QuestComplete, "QuestCompleteEvent"
 	case *gamev1.ServerEvent_ApUpdate:
 		return p.ApUpdate, "APUpdateEvent"
+	case *gamev1.ServerEvent_CombatState:
+		return p.CombatState, "CombatStateEvent"
+	case *gamev1.ServerEvent_RoomPeek:
+		return p.RoomPeek, "RoomPeekView"
 	case *gamev1.ServerEvent_ReactionPrompt:
 		return p.ReactionPrompt, "ReactionPromptEvent"
 	default:
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/cory-johannsen/mud/internal/frontend/prompt"
 	"github.com/cory-johannsen/mud/internal/game/command"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
@@ -23,7 +24,7 @@ func buildClientMessageFromText(reqID, text string, registry *command.Registry)
 	if !ok {
 		return buildMoveClientMessage(reqID, parsed.Command), nil
 	}
-	bctx := &webBridgeContext{reqID: reqID, cmd: cmd, parsed: parsed}
+	bctx := &webBridgeContext{reqID: reqID, cmd: cmd, parsed: parsed, registry: registry}
 	return buildMessageFromCommand(bctx)
 }
 
@@ -37,9 +38,10 @@ func buildMoveClientMessage(reqID, direction string) *gamev1.ClientMessage {
 }
 
 type webBridgeContext struct {
-	reqID  string
-	cmd    *command.Command
-	parsed command.ParseResult
+	reqID    string
+	cmd      *command.Command
+	parsed   command.ParseResult
+	registry *command.Registry
 }
 
 func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, error) {
@@ -56,14 +58,27 @@ func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, err
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Move{Move: &gamev1.MoveRequest{Direction: parsed.Command}}}, nil
 	case command.HandlerLook:
+		direction := ""
+		if len(parsed.Args) > 0 {
+			direction = strings.ToLower(parsed.Args[0])
+			if cmd, ok := command.DefaultRegistry().Resolve(direction); ok && cmd.Handler == command.HandlerMove {
+				direction = cmd.Name
+			}
+		}
 		return &gamev1.ClientMessage{RequestId: reqID,
-			Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}}, nil
+			Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{Direction: direction}}}, nil
 	case command.HandlerSay:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Say{Say: &gamev1.SayRequest{Message: rawArgs}}}, nil
 	case command.HandlerEmote:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Emote{Emote: &gamev1.EmoteRequest{Action: rawArgs}}}, nil
+	case command.HandlerShout:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Shout{Shout: &gamev1.ShoutRequest{Message: rawArgs}}}, nil
+	case command.HandlerYell:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Yell{Yell: &gamev1.YellRequest{Message: rawArgs}}}, nil
 	case command.HandlerExits:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Exits{Exits: &gamev1.ExitsRequest{}}}, nil
@@ -76,6 +91,40 @@ func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, err
 	case command.HandlerExamine:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Examine{Examine: &gamev1.ExamineRequest{Target: rawArgs}}}, nil
+	case command.HandlerConsider:
+		if rawArgs == "" {
+			return nil, fmt.Errorf("usage: consider <npc>")
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Consider{Consider: &gamev1.ConsiderRequest{Target: rawArgs}}}, nil
+	case command.HandlerScan:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Scan{Scan: &gamev1.ScanRequest{}}}, nil
+	case command.HandlerLight:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Light{Light: &gamev1.LightRequest{Target: rawArgs}}}, nil
+	case command.HandlerBoard:
+		if rawArgs == "" {
+			return nil, fmt.Errorf("usage: board <vehicle>")
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Board{Board: &gamev1.BoardRequest{Target: rawArgs}}}, nil
+	case command.HandlerDisembark:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Disembark{Disembark: &gamev1.DisembarkRequest{}}}, nil
+	case command.HandlerDrive:
+		if len(parsed.Args) == 0 {
+			return nil, fmt.Errorf("usage: drive <direction>")
+		}
+		direction := strings.ToLower(parsed.Args[0])
+		if cmd, ok := command.DefaultRegistry().Resolve(direction); ok && cmd.Handler == command.HandlerMove {
+			direction = cmd.Name
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Drive{Drive: &gamev1.DriveRequest{Direction: direction}}}, nil
+	case command.HandlerRefuel:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Refuel{Refuel: &gamev1.RefuelRequest{Target: rawArgs}}}, nil
 	case command.HandlerAttack:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Attack{Attack: &gamev1.AttackRequest{Target: arg}}}, nil
@@ -186,6 +235,12 @@ func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, err
 	case command.HandlerDisarmTrap:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_DisarmTrap{DisarmTrap: &gamev1.DisarmTrapRequest{TrapName: rawArgs}}}, nil
+	case command.HandlerDetect:
+		if err := command.HandleDetect(parsed.Args); err != nil {
+			return nil, err
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_DetectTraps{DetectTraps: &gamev1.DetectTrapsRequest{}}}, nil
 	case command.HandlerDeployTrap:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_DeployTrap{DeployTrap: &gamev1.DeployTrapRequest{ItemName: rawArgs}}}, nil
@@ -266,6 +321,243 @@ func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, err
 		}
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_CombatDefault{CombatDefault: &gamev1.CombatDefaultRequest{Action: combatAction}}}, nil
+	case command.HandlerCombatVerbosity:
+		verbosity, verbosityErr := command.HandleCombatVerbosity(parsed.Args)
+		if verbosityErr != nil {
+			return nil, verbosityErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_CombatVerbosity{CombatVerbosity: &gamev1.CombatVerbosityRequest{Level: verbosity}}}, nil
+	case command.HandlerLocale:
+		locale, localeErr := command.HandleLocale(parsed.Args)
+		if localeErr != nil {
+			return nil, localeErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Locale{Locale: &gamev1.LocaleRequest{Locale: locale}}}, nil
+	case command.HandlerChatMute:
+		target, minutes, muteErr := command.HandleChatMute(parsed.Args)
+		if muteErr != nil {
+			return nil, muteErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_ChatMute{ChatMute: &gamev1.ChatMuteRequest{Target: target, Minutes: int32(minutes)}}}, nil
+	case command.HandlerAudit:
+		subject, limit, auditErr := command.HandleAudit(parsed.Args)
+		if auditErr != nil {
+			return nil, auditErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Audit{Audit: &gamev1.AuditRequest{Subject: subject, Limit: int32(limit)}}}, nil
+	case command.HandlerBug, command.HandlerTypo, command.HandlerIdea:
+		reportText, reportErr := command.HandleReport(bctx.cmd.Handler, parsed.RawArgs)
+		if reportErr != nil {
+			return nil, reportErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Report{Report: &gamev1.ReportRequest{Kind: bctx.cmd.Handler, Text: reportText}}}, nil
+	case command.HandlerReports:
+		reportsReq, reportsErr := command.HandleReports(parsed.Args)
+		if reportsErr != nil {
+			return nil, reportsErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Reports{Reports: &gamev1.ReportsRequest{
+				Action: reportsReq.Action, Kind: reportsReq.Kind, All: reportsReq.All, Id: reportsReq.ID,
+			}}}, nil
+	case command.HandlerInvis:
+		invisAction, invisErr := command.HandleInvis(parsed.Args)
+		if invisErr != nil {
+			return nil, invisErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Invis{Invis: &gamev1.InvisRequest{Action: invisAction}}}, nil
+	case command.HandlerSnoop:
+		snoopTarget, snoopReason, snoopErr := command.HandleSnoop(parsed.RawArgs)
+		if snoopErr != nil {
+			return nil, snoopErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Snoop{Snoop: &gamev1.SnoopRequest{Target: snoopTarget, Reason: snoopReason}}}, nil
+	case command.HandlerForce:
+		forceTarget, forceText, forceErr := command.HandleForce(parsed.RawArgs)
+		if forceErr != nil {
+			return nil, forceErr
+		}
+		innerParsed := command.Parse(forceText)
+		if innerCmd, ok := bctx.registry.Resolve(innerParsed.Command); ok && !command.Forceable(innerCmd.Handler) {
+			return nil, fmt.Errorf("%s can't be forced", innerCmd.Name)
+		}
+		inner, innerErr := buildClientMessageFromText(reqID, forceText, bctx.registry)
+		if innerErr != nil {
+			return nil, innerErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Force{Force: &gamev1.ForceRequest{Target: forceTarget, Command: inner, Text: forceText}}}, nil
+	case command.HandlerPuppet:
+		puppetReq, puppetErr := command.HandlePuppet(parsed.RawArgs)
+		if puppetErr != nil {
+			return nil, puppetErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Puppet{Puppet: &gamev1.PuppetRequest{
+				Action: puppetReq.Action, Target: puppetReq.Target, Text: puppetReq.Text,
+			}}}, nil
+	case command.HandlerGM:
+		gmReq, gmErr := command.HandleGM(parsed.RawArgs)
+		if gmErr != nil {
+			return nil, gmErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Gm{Gm: &gamev1.GMRequest{
+				Action: gmReq.Action, Target: gmReq.Target, Amount: int32(gmReq.Amount), Text: gmReq.Text,
+			}}}, nil
+	case command.HandlerSchedule:
+		scheduleAction, scheduleID, scheduleErr := command.HandleSchedule(parsed.RawArgs)
+		if scheduleErr != nil {
+			return nil, scheduleErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Schedule{Schedule: &gamev1.ScheduleRequest{Action: scheduleAction, EventId: scheduleID}}}, nil
+	case command.HandlerEngrave:
+		engraveItem, engraveName, engraveErr := command.HandleEngrave(parsed.RawArgs)
+		if engraveErr != nil {
+			return nil, engraveErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Engrave{Engrave: &gamev1.EngraveRequest{Item: engraveItem, Name: engraveName}}}, nil
+	case command.HandlerDig:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Dig{Dig: &gamev1.DigRequest{}}}, nil
+	case command.HandlerAnswer:
+		answer, answerErr := command.HandleAnswer(parsed.RawArgs)
+		if answerErr != nil {
+			return nil, answerErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Answer{Answer: &gamev1.AnswerRequest{Answer: answer}}}, nil
+	case command.HandlerReloadConfig:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_ReloadConfig{ReloadConfig: &gamev1.ReloadConfigRequest{}}}, nil
+	case command.HandlerFlag:
+		flagAction, flagName, flagUser, flagErr := command.HandleFlag(parsed.Args)
+		if flagErr != nil {
+			return nil, flagErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Flag{Flag: &gamev1.FlagRequest{Action: flagAction, Flag: flagName, Username: flagUser}}}, nil
+	case command.HandlerSettings:
+		key, value, settingsErr := command.HandleSettings(parsed.Args)
+		if settingsErr != nil {
+			return nil, settingsErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Settings{Settings: &gamev1.SettingsRequest{Key: key, Value: value}}}, nil
+	case command.HandlerPrompt:
+		format, show, promptErr := command.HandlePrompt(parsed.RawArgs)
+		if promptErr != nil {
+			return nil, promptErr
+		}
+		if show {
+			// The web client has no text prompt; list the account settings instead.
+			return &gamev1.ClientMessage{RequestId: reqID,
+				Payload: &gamev1.ClientMessage_Settings{Settings: &gamev1.SettingsRequest{}}}, nil
+		}
+		if format != "" {
+			if validateErr := prompt.Validate(format); validateErr != nil {
+				return nil, validateErr
+			}
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Settings{Settings: &gamev1.SettingsRequest{Key: "prompt", Value: format}}}, nil
+	case command.HandlerRename:
+		target, newName, renameErr := command.HandleRename(parsed.Args)
+		if renameErr != nil {
+			return nil, renameErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Rename{Rename: &gamev1.RenameRequest{Target: target, NewName: newName}}}, nil
+	case command.HandlerCharacterSlots:
+		username, slots, slotsErr := command.HandleCharacterSlots(parsed.Args)
+		if slotsErr != nil {
+			return nil, slotsErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_CharacterSlots{CharacterSlots: &gamev1.CharacterSlotsRequest{Username: username, Slots: int32(slots)}}}, nil
+	case command.HandlerTransferCharacter:
+		name, username, transferErr := command.HandleTransferCharacter(parsed.Args)
+		if transferErr != nil {
+			return nil, transferErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_TransferCharacter{TransferCharacter: &gamev1.TransferCharacterRequest{Target: name, Username: username}}}, nil
+	case command.HandlerLog:
+		logAction, logErr := command.HandleLog(parsed.Args)
+		if logErr != nil {
+			return nil, logErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Log{Log: &gamev1.LogRequest{Action: logAction}}}, nil
+	case command.HandlerHistory:
+		// Command history lives in the browser; the input box recalls it with the arrow keys.
+		return nil, fmt.Errorf("use the up and down arrow keys to recall your earlier commands")
+	case command.HandlerTutorial:
+		skip, tutorialErr := command.HandleTutorial(parsed.Args)
+		if tutorialErr != nil {
+			return nil, tutorialErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Tutorial{Tutorial: &gamev1.TutorialRequest{Skip: skip}}}, nil
+	case command.HandlerHome:
+		action, target, qty, homeErr := command.HandleHome(parsed.Args)
+		if homeErr != nil {
+			return nil, homeErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Home{Home: &gamev1.HomeRequest{Action: action, Target: target, Quantity: int32(qty)}}}, nil
+	case command.HandlerClan:
+		action, target, text, amount, clanErr := command.HandleClan(parsed.Args)
+		if clanErr != nil {
+			return nil, clanErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Clan{Clan: &gamev1.ClanRequest{Action: action, Target: target, Text: text, Amount: int32(amount)}}}, nil
+	case command.HandlerClanSay:
+		if rawArgs == "" {
+			return nil, fmt.Errorf("Say what?")
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Clan{Clan: &gamev1.ClanRequest{Action: "say", Text: rawArgs}}}, nil
+	case command.HandlerAchievements:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Achievements{Achievements: &gamev1.AchievementsRequest{}}}, nil
+	case command.HandlerTitle:
+		title, clear := command.HandleTitle(parsed.Args)
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Title{Title: &gamev1.TitleRequest{Title: title, Clear: clear}}}, nil
+	case command.HandlerTop:
+		st, err := command.HandleTop(parsed.Args)
+		if err != nil {
+			return nil, err
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Top{Top: &gamev1.TopRequest{Stat: string(st)}}}, nil
+	case command.HandlerPlayed:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Played{Played: &gamev1.PlayedRequest{}}}, nil
+	case command.HandlerTime:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Time{Time: &gamev1.TimeRequest{}}}, nil
+	case command.HandlerAfk:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Afk{Afk: &gamev1.AfkRequest{Message: rawArgs}}}, nil
+	case command.HandlerSiteBan:
+		action, cidr, reason, banErr := command.HandleSiteBan(parsed.Args)
+		if banErr != nil {
+			return nil, banErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_SiteBan{SiteBan: &gamev1.SiteBanRequest{Action: action, Cidr: cidr, Reason: reason}}}, nil
 	case command.HandlerAction:
 		actionReq, actionErr := command.HandleAction(parsed.Args)
 		if actionErr != nil {
@@ -515,6 +807,13 @@ func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, err
 	case command.HandlerStashBalance:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_StashBalance{StashBalance: &gamev1.StashBalanceRequest{NpcName: rawArgs}}}, nil
+	case command.HandlerSharedStash:
+		action, amount, sharedErr := command.HandleSharedStash(parsed.Args)
+		if sharedErr != nil {
+			return nil, sharedErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_SharedStash{SharedStash: &gamev1.SharedStashRequest{Action: action, Amount: int32(amount)}}}, nil
 	case command.HandlerHire:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_Hire{Hire: &gamev1.HireRequest{NpcName: rawArgs}}}, nil
@@ -548,6 +847,36 @@ func buildMessageFromCommand(bctx *webBridgeContext) (*gamev1.ClientMessage, err
 	case command.HandlerSurrender:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_SurrenderRequest{SurrenderRequest: &gamev1.SurrenderRequest{}}}, nil
+	case command.HandlerPayFine:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_PayFine{PayFine: &gamev1.PayFineRequest{}}}, nil
+	case command.HandlerSteal:
+		stealReq, stealErr := command.HandleSteal(parsed.Args)
+		if stealErr != nil {
+			return nil, stealErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Steal{Steal: &gamev1.StealRequest{Item: stealReq.Item, Target: stealReq.Target}}}, nil
+	case command.HandlerPick:
+		pickReq, pickErr := command.HandlePick(parsed.Args)
+		if pickErr != nil {
+			return nil, pickErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Pick{Pick: &gamev1.PickRequest{Action: pickReq.Action, Target: pickReq.Target}}}, nil
+	case command.HandlerMarket:
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Market{Market: &gamev1.MarketRequest{}}}, nil
+	case command.HandlerAuction:
+		auctionReq, auctionErr := command.HandleAuction(parsed.Args)
+		if auctionErr != nil {
+			return nil, auctionErr
+		}
+		return &gamev1.ClientMessage{RequestId: reqID,
+			Payload: &gamev1.ClientMessage_Auction{Auction: &gamev1.AuctionRequest{
+				Action: auctionReq.Action, Item: auctionReq.Item, Quantity: int32(auctionReq.Quantity),
+				Price: int32(auctionReq.Price), Hours: int32(auctionReq.Hours), ListingId: auctionReq.ListingID,
+			}}}, nil
 	case command.HandlerRelease:
 		return &gamev1.ClientMessage{RequestId: reqID,
 			Payload: &gamev1.ClientMessage_ReleaseRequest{ReleaseRequest: &gamev1.ReleaseRequest{PlayerName: strings.TrimSpace(rawArgs)}}}, nil
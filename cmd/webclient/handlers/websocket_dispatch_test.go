@@ -34,6 +34,21 @@ func TestDispatchWSMessage_CommandText_Say(t *testing.T) {
 	assert.Equal(t, "Hello world", say.Message)
 }
 
+func TestDispatchWSMessage_CommandText_Force(t *testing.T) {
+	registry := command.DefaultRegistry()
+	env := handlers.WSMessageForTest("CommandText", map[string]string{"text": "force Vex say Hello"})
+	msg, err := handlers.DispatchWSMessageForTest(env, "req-f", registry)
+	require.NoError(t, err)
+	force := msg.GetForce()
+	require.NotNil(t, force)
+	assert.Equal(t, "Vex", force.GetTarget())
+	assert.Equal(t, "Hello", force.GetCommand().GetSay().GetMessage())
+
+	env = handlers.WSMessageForTest("CommandText", map[string]string{"text": "force Vex quit"})
+	_, err = handlers.DispatchWSMessageForTest(env, "req-f2", registry)
+	assert.EqualError(t, err, "quit can't be forced")
+}
+
 func TestDispatchWSMessage_DirectProto_MoveRequest(t *testing.T) {
 	env := handlers.WSMessageForTest("MoveRequest", map[string]string{"direction": "south"})
 	registry := command.DefaultRegistry()
@@ -1,6 +1,8 @@
 package handlers_test
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -9,8 +11,11 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/cory-johannsen/mud/cmd/webclient/handlers"
+	"github.com/cory-johannsen/mud/internal/game/command"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
 
 func TestWSHandler_RejectsInvalidJWT(t *testing.T) {
@@ -34,3 +39,31 @@ func TestWSHandler_RejectsMissingToken(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
+
+// fakeCatalog serves a fixed GetCommands response.
+type fakeCatalog struct {
+	resp *gamev1.GetCommandsResponse
+	err  error
+}
+
+func (f *fakeCatalog) GetCommands(context.Context, *gamev1.GetCommandsRequest, ...grpc.CallOption) (*gamev1.GetCommandsResponse, error) {
+	return f.resp, f.err
+}
+
+func TestWSHandler_CommandRegistry(t *testing.T) {
+	builtins := len(command.BuiltinCommands())
+	h := handlers.NewWSHandler("test-secret", nil, nil)
+	assert.Len(t, h.CommandRegistryForTest(context.Background()).Commands(), builtins, "no catalog uses the built-ins")
+
+	h.WithCommandCatalog(&fakeCatalog{resp: &gamev1.GetCommandsResponse{Commands: []*gamev1.CommandInfo{
+		{Name: "look", Aliases: []string{"l"}, Handler: command.HandlerLook},
+	}}})
+	r := h.CommandRegistryForTest(context.Background())
+	require.Len(t, r.Commands(), 1)
+	cmd, ok := r.Resolve("l")
+	require.True(t, ok)
+	assert.Equal(t, command.HandlerLook, cmd.Handler)
+
+	h.WithCommandCatalog(&fakeCatalog{err: errors.New("unavailable")})
+	assert.Len(t, h.CommandRegistryForTest(context.Background()).Commands(), builtins)
+}
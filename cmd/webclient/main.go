@@ -20,6 +20,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/config"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/game/technology"
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
 	"github.com/cory-johannsen/mud/internal/observability"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
@@ -30,6 +31,8 @@ func main() {
 	configPath    := flag.String("config", "configs/dev.yaml", "path to configuration file")
 	jobsDir       := flag.String("jobs-dir", "content/jobs", "path to job YAML definitions")
 	regionsDir    := flag.String("regions-dir", "content/regions", "path to region YAML definitions")
+	heritagesDir  := flag.String("heritages-dir", "content/heritages", "path to heritage YAML definitions")
+	backgroundsFile := flag.String("backgrounds-file", "content/backgrounds.yaml", "path to background questionnaire YAML file")
 	archetypesDir := flag.String("archetypes-dir", "content/archetypes", "path to archetype YAML definitions")
 	teamsDir      := flag.String("teams-dir", "content/teams", "path to team YAML definitions")
 	featsFile     := flag.String("feats-file", "content/feats.yaml", "path to feats YAML file")
@@ -56,6 +59,8 @@ func main() {
 	defer pool.Close()
 
 	accountRepo := postgres.NewAccountRepository(pool.DB())
+	settingsRepo := postgres.NewAccountSettingsRepository(pool.DB())
+	transcriptRepo := postgres.NewTranscriptRepository(pool.DB())
 	charRepo := postgres.NewCharacterRepository(pool.DB())
 
 	// Character creation choice persistence repositories.
@@ -75,6 +80,14 @@ func main() {
 	if regionsErr != nil {
 		logger.Warn("loading regions for character wizard", zap.Error(regionsErr))
 	}
+	heritages, heritagesErr := ruleset.LoadHeritages(*heritagesDir)
+	if heritagesErr != nil {
+		logger.Warn("loading heritages for character wizard", zap.Error(heritagesErr))
+	}
+	backgrounds, backgroundsErr := ruleset.LoadBackgrounds(*backgroundsFile)
+	if backgroundsErr != nil {
+		logger.Warn("loading backgrounds for character wizard", zap.Error(backgroundsErr))
+	}
 	archetypes, archetypesErr := ruleset.LoadArchetypes(*archetypesDir)
 	if archetypesErr != nil {
 		logger.Warn("loading archetypes for character wizard", zap.Error(archetypesErr))
@@ -104,6 +117,8 @@ func main() {
 		charOpts = &handlers.CharacterOptions{
 			Jobs:         jobs,
 			Regions:      regions,
+			Heritages:    heritages,
+			Backgrounds:  backgrounds,
 			Archetypes:   archetypes,
 			Teams:        teams,
 			Feats:        feats,
@@ -121,7 +136,10 @@ func main() {
 		preparedTech:  preparedTechRepo,
 	}
 
-	srv, err := New(cfg.Web, cfg.GameServer.Addr(), accountRepo, charRepo, charOpts, creationRepos, roomLookup, logger)
+	// Web logins share the telnet lockout settings and failure records.
+	loginThrottle := loginthrottle.New(cfg.Telnet.LoginThrottle, postgres.NewLoginFailureRepository(pool.DB()))
+
+	srv, err := New(cfg.Web, cfg.GameServer.Addr(), accountRepo, loginThrottle, settingsRepo, transcriptRepo, charRepo, charOpts, cfg.Characters, creationRepos, roomLookup, logger)
 	if err != nil {
 		logger.Fatal("initializing web server", zap.Error(err))
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/cory-johannsen/mud/cmd/webclient/handlers"
 	"github.com/cory-johannsen/mud/cmd/webclient/middleware"
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
@@ -37,11 +38,15 @@ type Server struct {
 	httpServer        *http.Server
 	grpcConn          *grpc.ClientConn
 	accountRepo       *postgres.AccountRepository
+	loginThrottle     *loginthrottle.Throttle             // may be nil; web logins are then never locked out
+	settingsRepo      *postgres.AccountSettingsRepository // may be nil; JoinWorldRequest then carries default settings
+	transcriptRepo    *postgres.TranscriptRepository      // may be nil; the transcript download routes are then not registered
 	charRepo          *postgres.CharacterRepository
 	gameClient        gamev1.GameServiceClient
 	bus               *eventbus.EventBus
 	logger            *zap.Logger
 	charOptions       *handlers.CharacterOptions
+	charSlots         config.CharactersConfig
 	charCreationRepos *charCreationRepos             // may be nil if not configured
 	activeRegistry    *handlers.ActiveCharacterRegistry
 	roomLookup        map[string]string              // may be nil; maps room ID → "Zone — Room" display string
@@ -56,8 +61,12 @@ func New(
 	cfg config.WebConfig,
 	gameserverAddr string,
 	accountRepo *postgres.AccountRepository,
+	loginThrottle *loginthrottle.Throttle,
+	settingsRepo *postgres.AccountSettingsRepository,
+	transcriptRepo *postgres.TranscriptRepository,
 	charRepo *postgres.CharacterRepository,
 	charOptions *handlers.CharacterOptions,
+	charSlots config.CharactersConfig,
 	creationRepos *charCreationRepos,
 	roomLookup map[string]string,
 	logger *zap.Logger,
@@ -75,11 +84,15 @@ func New(
 		cfg:               cfg,
 		grpcConn:          conn,
 		accountRepo:       accountRepo,
+		loginThrottle:     loginThrottle,
+		settingsRepo:      settingsRepo,
+		transcriptRepo:    transcriptRepo,
 		charRepo:          charRepo,
 		gameClient:        gamev1.NewGameServiceClient(conn),
 		bus:               bus,
 		logger:            logger,
 		charOptions:       charOptions,
+		charSlots:         charSlots,
 		charCreationRepos: creationRepos,
 		activeRegistry:    handlers.NewActiveCharacterRegistry(),
 		roomLookup:        roomLookup,
@@ -203,7 +216,9 @@ func (s *Server) adminMiddleware(next http.Handler) http.Handler {
 // Postcondition: /api/auth/* routes are registered; JWT middleware protects all
 // other /api/* routes; admin routes require role admin or moderator.
 func (s *Server) registerRoutes(mux *http.ServeMux) {
-	authHandler := handlers.NewAuthHandler(s.accountRepo, s.cfg.JWTSecret)
+	authHandler := handlers.NewAuthHandler(s.accountRepo, s.cfg.JWTSecret).
+		WithLogger(s.logger).
+		WithLoginThrottle(s.loginThrottle)
 
 	// Public auth routes — no JWT required.
 	mux.HandleFunc("POST /api/auth/login", authHandler.Login)
@@ -219,6 +234,7 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 		WithGetter(s.charRepo).
 		WithDeleter(s.charRepo).
 		WithOptions(s.charOptions).
+		WithSlotLimits(s.charSlots, s.accountRepo).
 		WithRegistry(s.activeRegistry).
 		WithRoomLookup(s.roomLookup)
 	if s.charCreationRepos != nil {
@@ -245,9 +261,20 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 		WithLogger(s.logger).
 		WithEventBus(s.bus).
 		WithAccountGetter(accountUsernameAdapter{s.accountRepo}).
-		WithRegistry(s.activeRegistry)
+		WithRegistry(s.activeRegistry).
+		WithCommandCatalog(s.gameClient)
+	if s.settingsRepo != nil {
+		wsHandler = wsHandler.WithAccountSettings(s.settingsRepo)
+	}
 	mux.Handle("GET /ws", http.HandlerFunc(wsHandler.ServeHTTP))
 
+	// Session transcripts recorded in game with the log command.
+	if s.transcriptRepo != nil {
+		transcriptHandler := handlers.NewTranscriptHandler(s.transcriptRepo)
+		mux.Handle("GET /api/transcripts", s.authMiddleware(http.HandlerFunc(transcriptHandler.ListTranscripts)))
+		mux.Handle("GET /api/transcripts/{id}", s.authMiddleware(http.HandlerFunc(transcriptHandler.DownloadTranscript)))
+	}
+
 	// Admin API — all protected by JWT + RequireAdminRole.
 	adminHandler := handlers.NewAdminHandler(
 		handlers.NewGRPCSessionManager(s.gameClient),
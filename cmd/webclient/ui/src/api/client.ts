@@ -140,6 +140,20 @@ export interface RegionOption {
   ability_boosts?: AbilityBoostGrant
 }
 
+export interface HeritageTrait {
+  id: string
+  name: string
+  description: string
+}
+
+export interface HeritageOption {
+  id: string
+  name: string
+  description: string
+  modifiers?: Record<string, number>
+  trait: HeritageTrait
+}
+
 export interface TeamOption {
   id: string
   name: string
@@ -187,8 +201,32 @@ export interface SkillOption {
   ability: string
 }
 
+export interface PointBuyRules {
+  pool: number
+  max_shift: number
+  min_score: number
+  max_score: number
+}
+
+export interface BackgroundAnswerOption {
+  id: string
+  text: string
+  items?: { item: string; quantity: number }[]
+  condition?: string
+  start_room?: string
+}
+
+export interface BackgroundQuestion {
+  id: string
+  prompt: string
+  answers: BackgroundAnswerOption[]
+}
+
 export interface CharacterOptions {
   regions: RegionOption[]
+  heritages?: HeritageOption[]
+  point_buy?: PointBuyRules
+  backgrounds?: BackgroundQuestion[]
   teams: TeamOption[]
   archetypes: ArchetypeOption[]
   jobs: JobOption[]
@@ -204,6 +242,7 @@ export interface Character {
   current_hp: number
   max_hp: number
   region: string
+  heritage?: string
   archetype: string
   location?: string
   is_online?: boolean
@@ -220,6 +259,9 @@ export interface CreateCharacterPayload {
   job: string
   team: string
   region: string
+  heritage?: string
+  point_buy?: Record<string, number>
+  background_answers?: Record<string, string>
   gender: string
   archetype_boosts?: string[]
   region_boosts?: string[]
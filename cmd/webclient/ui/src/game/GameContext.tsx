@@ -36,7 +36,9 @@ import type {
   HotbarSlot,
   JobGrantsResponse,
   APUpdateEvent,
+  CombatEvent,
 } from '../proto'
+import { combatNarrativeForRecipient } from './combatPerspective'
 
 const TOKEN_KEY = 'mud_token'
 const FEED_CAP = 500
@@ -526,7 +528,7 @@ export function GameProvider({ children }: { children: ReactNode }) {
           break
         }
         case 'CombatEvent': {
-          const ce = payload as { type?: string; narrative?: string; attacker?: string; target?: string; damage?: number; attackerPosition?: number; attacker_position?: number; attackerX?: number; attackerY?: number; flanking?: boolean; targetHp?: number; targetMaxHp?: number; target_hp?: number; target_max_hp?: number }
+          const ce = payload as CombatEvent & { attacker_position?: number; target_hp?: number; target_max_hp?: number }
           if (ce.type === 'COMBAT_EVENT_TYPE_POSITION') {
             if (ce.attacker) {
               dispatch({
@@ -553,7 +555,7 @@ export function GameProvider({ children }: { children: ReactNode }) {
             dispatch({ type: 'UPDATE_COMBATANT_HP', name: ce.target, current: tHp, max: tMaxHp })
           }
           const text = ce.narrative
-            ? ce.narrative
+            ? combatNarrativeForRecipient(ce)
             : `${ce.attacker ?? '?'} → ${ce.target ?? '?'}: ${ce.damage ?? 0} dmg`
           dispatch({ type: 'APPEND_FEED', entry: makeFeedEntry('combat', text) })
           break
@@ -692,6 +694,15 @@ export function GameProvider({ children }: { children: ReactNode }) {
           }
           break
         }
+        case 'RoomPeekView': {
+          const rp = payload as { direction?: string; title?: string; description?: string; players?: string[]; npcs?: Array<{ name?: string }> }
+          const occupants = [...(rp.players ?? []), ...(rp.npcs ?? []).map((n) => n.name ?? '?')]
+          let text = `Looking ${rp.direction ?? ''}: ${rp.title ?? ''}`
+          if (rp.description) text += `\n${rp.description}`
+          if (occupants.length > 0) text += `\nYou can see: ${occupants.join(', ')}`
+          dispatch({ type: 'APPEND_FEED', entry: makeFeedEntry('system', text) })
+          break
+        }
         case 'NpcView': {
           const nv = payload as { name?: string; description?: string; healthDescription?: string; health_description?: string; level?: number; npcType?: string; npc_type?: string }
           const npcType = nv.npcType ?? nv.npc_type ?? ''
@@ -94,6 +94,7 @@ function ShopRow({
   const buy = item.buyPrice ?? item.buy_price ?? 0
   const sell = item.sellPrice ?? item.sell_price ?? 0
   const stock = item.stock ?? 0
+  const locked = item.lockedReason ?? item.locked_reason ?? ''
   const equipped = equippedLabel(item, sheet)
   const hasStats = !!(item.kind && (item.kind === 'weapon' || item.kind === 'armor' || item.description))
 
@@ -127,7 +128,8 @@ function ShopRow({
         {stock === 0 ? 'out' : stock}
       </td>
       <td style={styles.tdAction}>
-        {stock > 0 && (
+        {locked && <span style={styles.lockedNote} title={locked}>{locked}</span>}
+        {!locked && stock > 0 && (
           <button
             style={styles.buyBtn}
             onClick={() => onBuy(id, 1)}
@@ -489,6 +491,11 @@ const styles: Record<string, React.CSSProperties> = {
     fontFamily: 'monospace',
     fontSize: '0.75rem',
   },
+  lockedNote: {
+    fontSize: '0.62rem',
+    color: '#a86',
+    whiteSpace: 'nowrap' as const,
+  },
   sellBtn: {
     padding: '0.15rem 0.5rem',
     background: '#2a1a1a',
@@ -0,0 +1,41 @@
+import { describe, it, expect } from 'vitest'
+import { combatNarrativeForRecipient, secondPersonVerb } from './combatPerspective'
+
+describe('secondPersonVerb', () => {
+  it('conjugates regular and irregular verbs', () => {
+    expect(secondPersonVerb('hits')).toBe('hit')
+    expect(secondPersonVerb('misses')).toBe('miss')
+    expect(secondPersonVerb('tries')).toBe('try')
+    expect(secondPersonVerb('is')).toBe('are')
+    expect(secondPersonVerb('cannot')).toBe('cannot')
+  })
+})
+
+describe('combatNarrativeForRecipient', () => {
+  it('renders second person for the attacker', () => {
+    expect(combatNarrativeForRecipient({
+      attacker: 'Kira',
+      target: 'Rat',
+      narrative: "Kira attacks Rat. Kira's blade bites deep.",
+      attackerRelation: 'COMBAT_RELATION_SELF',
+    })).toBe('You attack Rat. Your blade bites deep.')
+  })
+
+  it('renders second person for the target', () => {
+    expect(combatNarrativeForRecipient({
+      attacker: 'Rat',
+      target: 'Kira',
+      narrative: 'Rat bites Kira for 3 damage.',
+      targetRelation: 'COMBAT_RELATION_SELF',
+    })).toBe('Rat bites you for 3 damage.')
+  })
+
+  it('leaves observer narratives unchanged', () => {
+    expect(combatNarrativeForRecipient({
+      attacker: 'Kira',
+      target: 'Rat',
+      narrative: 'Kira attacks Rat.',
+      attackerRelation: 'COMBAT_RELATION_ALLY',
+    })).toBe('Kira attacks Rat.')
+  })
+})
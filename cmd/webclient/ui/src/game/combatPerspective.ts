@@ -0,0 +1,71 @@
+import type { CombatEvent } from '../proto'
+
+const IRREGULAR_SECOND_PERSON: Record<string, string> = {
+  is: 'are',
+  was: 'were',
+  has: 'have',
+  does: 'do',
+  goes: 'go',
+}
+
+/**
+ * secondPersonVerb converts a third-person singular verb to its second-person
+ * form ("hits" → "hit", "misses" → "miss", "tries" → "try").
+ *
+ * Postcondition: words that are not recognisably third-person are returned unchanged.
+ */
+export function secondPersonVerb(word: string): string {
+  if (IRREGULAR_SECOND_PERSON[word]) return IRREGULAR_SECOND_PERSON[word]
+  if (word.length > 3 && word.endsWith('ies')) return word.slice(0, -3) + 'y'
+  if (/(sses|shes|ches|xes|zes)$/.test(word)) return word.slice(0, -2)
+  if (word.length > 2 && word.endsWith('s') && !/(ss|us|is)$/.test(word)) return word.slice(0, -1)
+  return word
+}
+
+function escapeRegExp(s: string): string {
+  return s.replace(/[.*+?^${}()|[\]\\]/g, '\\$&')
+}
+
+function isSentenceStart(text: string, index: number): boolean {
+  const before = text.slice(0, index).replace(/[ \t]+$/, '')
+  return before === '' || /[.!?\n\]]$/.test(before)
+}
+
+/**
+ * toSecondPerson rewrites every whole-word mention of name in text as "you",
+ * conjugating the following verb when name is the subject of a sentence.
+ *
+ * Precondition: name is non-empty.
+ */
+export function toSecondPerson(text: string, name: string): string {
+  const re = new RegExp(`\\b${escapeRegExp(name)}('s)?\\b( [a-z]+)?`, 'g')
+  return text.replace(re, (_match, possessive: string | undefined, next: string | undefined, offset: number) => {
+    const start = isSentenceStart(text, offset)
+    let out: string
+    if (possessive) out = start ? 'Your' : 'your'
+    else out = start ? 'You' : 'you'
+    if (next) {
+      const word = next.slice(1)
+      out += ' ' + (start && !possessive ? secondPersonVerb(word) : word)
+    }
+    return out
+  })
+}
+
+/**
+ * combatNarrativeForRecipient renders a CombatEvent narrative from the
+ * receiving player's perspective using the server-supplied relations.
+ *
+ * Postcondition: returns the narrative unchanged when the recipient is neither
+ * the attacker nor the target.
+ */
+export function combatNarrativeForRecipient(ce: CombatEvent): string {
+  const text = ce.narrative ?? ''
+  if (ce.attackerRelation === 'COMBAT_RELATION_SELF' && ce.attacker) {
+    return toSecondPerson(text, ce.attacker)
+  }
+  if (ce.targetRelation === 'COMBAT_RELATION_SELF' && ce.target) {
+    return toSecondPerson(text, ce.target)
+  }
+  return text
+}
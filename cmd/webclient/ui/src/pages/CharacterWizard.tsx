@@ -18,11 +18,16 @@ import {
   type BasicOption,
   type SpontaneousChoice,
   type PreparedTechChoice,
+  type PointBuyRules,
+  type BackgroundAnswerOption,
   ApiError,
 } from '../api/client'
 
 interface WizardState {
   region: string
+  heritage: string
+  pointBuy: Record<string, number>
+  backgroundAnswers: Record<string, string>
   team: string
   archetype: string
   job: string
@@ -40,6 +45,9 @@ interface WizardState {
 
 const EMPTY_STATE: WizardState = {
   region: '',
+  heritage: '',
+  pointBuy: {},
+  backgroundAnswers: {},
   team: '',
   archetype: '',
   job: '',
@@ -64,6 +72,15 @@ interface Props {
 function computeSteps(options: CharacterOptions | null, state: WizardState): string[] {
   const steps = ['Region', 'Team', 'Archetype', 'Job']
   if (!options) return [...steps, 'Name & Gender']
+  if ((options.heritages?.length ?? 0) > 0) {
+    steps.splice(1, 0, 'Heritage')
+  }
+  if (options.point_buy) {
+    steps.push('Point Buy')
+  }
+  if ((options.backgrounds?.length ?? 0) > 0) {
+    steps.push('Background')
+  }
 
   const region = options.regions.find((r) => r.id === state.region)
   const archetype = options.archetypes.find((a) => a.id === state.archetype)
@@ -178,6 +195,7 @@ export function CharacterWizard({ onComplete, onCancel }: Props) {
   }, [])
 
   const region = options?.regions.find((r) => r.id === state.region)
+  const heritage = options?.heritages?.find((h) => h.id === state.heritage)
   const archetype = options?.archetypes.find((a) => a.id === state.archetype)
   const job = options?.jobs.find((j) => j.id === state.job)
 
@@ -187,9 +205,16 @@ export function CharacterWizard({ onComplete, onCancel }: Props) {
   function canAdvance(): boolean {
     const currentStep = computedSteps[step]
     if (currentStep === 'Region') return state.region !== ''
+    if (currentStep === 'Heritage') return state.heritage !== ''
     if (currentStep === 'Team') return state.team !== ''
     if (currentStep === 'Archetype') return state.archetype !== ''
     if (currentStep === 'Job') return state.job !== ''
+    if (currentStep === 'Background') {
+      return (options?.backgrounds ?? []).every((q) => (state.backgroundAnswers[q.id] ?? '') !== '')
+    }
+    if (currentStep === 'Point Buy') {
+      return options?.point_buy ? pointBuyRemaining(options.point_buy, state.pointBuy) !== null : true
+    }
     if (currentStep === 'Ability Boosts') {
       const needed = archetypeFreeBoosts + regionFreeBoosts
       const chosen = state.archetypeBoosts.filter((a) => a !== '').length +
@@ -250,6 +275,9 @@ export function CharacterWizard({ onComplete, onCancel }: Props) {
         job: state.job,
         team: state.team,
         region: state.region,
+        heritage: state.heritage || undefined,
+        point_buy: Object.values(state.pointBuy).some((d) => d !== 0) ? state.pointBuy : undefined,
+        background_answers: Object.keys(state.backgroundAnswers).length > 0 ? state.backgroundAnswers : undefined,
         gender: state.gender,
         archetype_boosts: state.archetypeBoosts.filter((a) => a !== '').length > 0
           ? state.archetypeBoosts.filter((a) => a !== '')
@@ -349,6 +377,18 @@ export function CharacterWizard({ onComplete, onCancel }: Props) {
               onSelect={(id) => update({ region: id })}
             />
           )}
+          {currentStepName === 'Heritage' && (
+            <OptionCards
+              label="Select a Heritage"
+              options={(options.heritages ?? []).map((h) => ({
+                id: h.id,
+                name: h.name,
+                description: `${h.description} ${h.trait.name}: ${h.trait.description}`,
+              }))}
+              selected={state.heritage}
+              onSelect={(id) => update({ heritage: id })}
+            />
+          )}
           {currentStepName === 'Team' && (
             <OptionCards
               label="Select a Team"
@@ -373,6 +413,26 @@ export function CharacterWizard({ onComplete, onCancel }: Props) {
               onSelect={(id) => update({ job: id })}
             />
           )}
+          {currentStepName === 'Point Buy' && options.point_buy && (
+            <PointBuyStep
+              rules={options.point_buy}
+              pointBuy={state.pointBuy}
+              onChange={(pointBuy) => update({ pointBuy })}
+            />
+          )}
+          {currentStepName === 'Background' && (options.backgrounds ?? []).map((q) => (
+            <OptionCards
+              key={q.id}
+              label={q.prompt}
+              options={q.answers.map((a) => ({
+                id: a.id,
+                name: a.text,
+                description: describeBackgroundAnswer(a),
+              }))}
+              selected={state.backgroundAnswers[q.id] ?? ''}
+              onSelect={(id) => update({ backgroundAnswers: { ...state.backgroundAnswers, [q.id]: id } })}
+            />
+          ))}
           {currentStepName === 'Ability Boosts' && (
             <AbilityBoostsStep
               region={region}
@@ -468,6 +528,11 @@ export function CharacterWizard({ onComplete, onCancel }: Props) {
           )}
           {state.name && <p style={styles.previewName}>{state.name}</p>}
           {state.region && <p style={styles.previewTag}>{region?.name ?? state.region}</p>}
+          {heritage && (
+            <p style={styles.previewTag}>
+              {heritage.name} ({heritage.trait.name})
+            </p>
+          )}
           {state.team && (
             <p style={styles.previewTag}>
               {options.teams.find((t) => t.id === state.team)?.name ?? state.team}
@@ -520,6 +585,88 @@ function OptionCards({ label, options, selected, onSelect }: OptionCardsProps) {
   )
 }
 
+// describeBackgroundAnswer summarises what a background answer grants.
+function describeBackgroundAnswer(answer: BackgroundAnswerOption): string {
+  const grants = (answer.items ?? []).map((it) => `${it.quantity}x ${it.item}`)
+  if (answer.condition) grants.push(`trait: ${answer.condition}`)
+  if (answer.start_room) grants.push(`starts in ${answer.start_room}`)
+  return grants.length > 0 ? grants.join('; ') : 'No starting grants.'
+}
+
+// pointBuyRemaining returns how many points the player may still raise, or
+// null when the adjustments do not balance or break the per-ability cap.
+function pointBuyRemaining(rules: PointBuyRules, pointBuy: Record<string, number>): number | null {
+  let net = 0
+  let raised = 0
+  for (const delta of Object.values(pointBuy)) {
+    if (Math.abs(delta) > rules.max_shift) return null
+    net += delta
+    if (delta > 0) raised += delta
+  }
+  if (raised > rules.pool || net !== 0) return null
+  return rules.pool - raised
+}
+
+interface PointBuyStepProps {
+  rules: PointBuyRules
+  pointBuy: Record<string, number>
+  onChange: (pointBuy: Record<string, number>) => void
+}
+
+function PointBuyStep({ rules, pointBuy, onChange }: PointBuyStepProps) {
+  const raised = Object.values(pointBuy).reduce((sum, d) => sum + Math.max(0, d), 0)
+  const lowered = Object.values(pointBuy).reduce((sum, d) => sum + Math.max(0, -d), 0)
+
+  function shift(ability: string, delta: number) {
+    const next = { ...pointBuy, [ability]: (pointBuy[ability] ?? 0) + delta }
+    if (next[ability] === 0) delete next[ability]
+    onChange(next)
+  }
+
+  return (
+    <div>
+      <h2 style={styles.stepHeading}>Point Buy (optional)</h2>
+      <p style={styles.status}>
+        Move up to {rules.pool} points between abilities, at most {rules.max_shift} per ability.
+        Every point raised must be taken from another ability; scores stay between {rules.min_score} and {rules.max_score}.
+      </p>
+      <p style={styles.status}>
+        Raised {raised} / lowered {lowered}
+        {raised !== lowered ? ' — raises and reductions must match' : ''}
+      </p>
+      <dl>
+        {ALL_ABILITIES.map((ability) => {
+          const delta = pointBuy[ability] ?? 0
+          return (
+            <div key={ability} style={styles.statRow}>
+              <dt style={styles.statKey}>{capitalize(ability)}</dt>
+              <dd style={styles.statVal}>
+                <button
+                  type="button"
+                  style={styles.secondaryBtn}
+                  disabled={delta <= -rules.max_shift}
+                  onClick={() => shift(ability, -1)}
+                >
+                  −
+                </button>
+                {' '}{delta > 0 ? `+${delta}` : delta}{' '}
+                <button
+                  type="button"
+                  style={styles.secondaryBtn}
+                  disabled={delta >= rules.max_shift || (delta >= 0 && raised >= rules.pool)}
+                  onClick={() => shift(ability, 1)}
+                >
+                  +
+                </button>
+              </dd>
+            </div>
+          )
+        })}
+      </dl>
+    </div>
+  )
+}
+
 interface AbilityBoostsStepProps {
   region: RegionOption | undefined
   archetype: ArchetypeOption | undefined
@@ -1251,6 +1398,17 @@ function computePreviewStats(
     }
   }
 
+  const heritage = options.heritages?.find((h) => h.id === state.heritage)
+  if (heritage?.modifiers) {
+    for (const [stat, val] of Object.entries(heritage.modifiers)) {
+      merged[stat] = (merged[stat] ?? 0) + val
+    }
+  }
+
+  for (const [stat, val] of Object.entries(state.pointBuy)) {
+    merged[stat] = (merged[stat] ?? 0) + val
+  }
+
   return Object.entries(merged).sort(([a], [b]) => a.localeCompare(b))
 }
 
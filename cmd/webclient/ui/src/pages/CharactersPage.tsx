@@ -58,7 +58,7 @@ export function CharactersPage() {
   }
 
   async function handleDelete(char: Character) {
-    if (!window.confirm(`Delete "${char.name}"? This cannot be undone.`)) return
+    if (!window.confirm(`Delete "${char.name}"? It will be permanently removed after 7 days.`)) return
     try {
       await api.characters.delete(char.id)
       await load()
@@ -573,6 +573,9 @@ export interface ShopItem {
   // Consumable stats (when kind == "consumable")
   effectsSummary?: string
   effects_summary?: string
+  // Why the player can't buy this yet; empty when it is for sale to them.
+  lockedReason?: string
+  locked_reason?: string
 }
 
 export interface ShopView {
@@ -639,8 +642,17 @@ export interface CombatEvent {
   attackerX?: number
   attackerY?: number
   flanking?: boolean
+  attackerRelation?: CombatRelation
+  targetRelation?: CombatRelation
 }
 
+/** CombatRelation is a combatant's relationship to the player receiving a CombatEvent. */
+export type CombatRelation =
+  | 'COMBAT_RELATION_UNSPECIFIED'
+  | 'COMBAT_RELATION_SELF'
+  | 'COMBAT_RELATION_ALLY'
+  | 'COMBAT_RELATION_ENEMY'
+
 export interface CoverObjectPosition {
   itemId?: string
   item_id?: string
@@ -0,0 +1,19 @@
+-- mercenary.lua: Lua preconditions for the mercenary_combat HTN domain.
+-- A hired mercenary fights on its employer's side, so its enemies are the
+-- hostile NPCs in the combat.
+
+-- mercenary_has_enemy: returns true when at least one living enemy is present.
+function mercenary_has_enemy(uid)
+    return engine.combat.enemy_count(uid) > 0
+end
+
+-- mercenary_enemy_below_half: returns true when any living enemy's HP is
+-- strictly below 50% of its maximum, routing to the finish_weakest method.
+function mercenary_enemy_below_half(uid)
+    local enemies = engine.combat.get_enemies(uid)
+    if enemies == nil then return false end
+    for _, e in ipairs(enemies) do
+        if e.hp < (e.max_hp * 0.5) then return true end
+    end
+    return false
+end
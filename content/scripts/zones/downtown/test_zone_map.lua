@@ -0,0 +1,14 @@
+-- Specs for the downtown zone scripts; run with `make test-scripts`.
+
+function test_zone_map_use_reveals_downtown()
+    mock.entity("p1", {room = "downtown_pioneer_square"})
+    local msg = zone_map_use("p1")
+    expect_eq(mock.revealed("p1")[1], "downtown", "revealed zone")
+    expect(string.find(msg, "district map") ~= nil, "use message mentions the map")
+end
+
+function test_combat_hooks_keep_original_values()
+    expect_eq(on_attack_roll("p1", "n1", 17, 14), nil)
+    expect_eq(on_damage_roll("p1", "n1", 6), nil)
+    expect_eq(on_condition_apply("p1", "prone", 1), nil)
+end
@@ -0,0 +1,12 @@
+-- reactions.lua: NPC reactions to what players do in front of them.
+-- Each hook receives (npc_uid, player_uid, event), where event has kind,
+-- item, and team fields. Return a line for the NPC to say, "attack" to
+-- start a fight, or nil to ignore the event.
+
+function strip_mall_scav_eyes_drop(npc_uid, player_uid, event)
+    return "You done with that? Finders keepers."
+end
+
+function strip_mall_scav_warns_draw(npc_uid, player_uid, event)
+    return "Easy. Put that away and we both walk out of here."
+end
@@ -0,0 +1,16 @@
+// Package cliactor names the operator running a command-line admin tool so the
+// tools record the same actor in the admin audit log.
+package cliactor
+
+import "os/user"
+
+// Name returns the actor recorded for an admin CLI invocation.
+//
+// Postcondition: returns "cli:<username>" for the current operating-system user,
+// or "cli" when the user cannot be determined.
+func Name() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return "cli:" + u.Username
+	}
+	return "cli"
+}
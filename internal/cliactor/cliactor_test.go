@@ -0,0 +1,19 @@
+package cliactor_test
+
+import (
+	"os/user"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cory-johannsen/mud/internal/cliactor"
+)
+
+func TestName_NamesCurrentUser(t *testing.T) {
+	u, err := user.Current()
+	if err != nil || u.Username == "" {
+		assert.Equal(t, "cli", cliactor.Name())
+		return
+	}
+	assert.Equal(t, "cli:"+u.Username, cliactor.Name())
+}
@@ -4,10 +4,13 @@ package config
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/cory-johannsen/mud/internal/flags"
 )
 
 // ServerConfig holds top-level server settings.
@@ -66,6 +69,20 @@ type TelnetConfig struct {
 	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
 	// IdleGracePeriod is the additional duration after IdleTimeout before disconnecting.
 	IdleGracePeriod time.Duration `mapstructure:"idle_grace_period"`
+	// KeepaliveInterval is how often connections are probed for liveness.
+	// Zero disables keepalives.
+	KeepaliveInterval time.Duration `mapstructure:"keepalive_interval"`
+	// KeepaliveTimeout is how long a client that answers keepalive probes may
+	// go without answering before it is disconnected. Zero disables the check.
+	KeepaliveTimeout time.Duration `mapstructure:"keepalive_timeout"`
+	// Flood limits how fast a connection may send commands.
+	Flood FloodConfig `mapstructure:"flood"`
+	// MaxConnsPerIP caps concurrent player-port connections from one source
+	// address. Zero means unlimited. The headless port is not capped.
+	MaxConnsPerIP int `mapstructure:"max_conns_per_ip"`
+	// LoginThrottle locks out accounts and addresses after repeated failed logins.
+	// The web client applies the same settings to its logins.
+	LoginThrottle LoginThrottleConfig `mapstructure:"login_throttle"`
 	// HeadlessPort is the TCP port for the headless plain-text telnet listener.
 	// If 0 or absent, the headless listener is not started. The headless
 	// listener is always bound to 127.0.0.1 regardless of Host.
@@ -122,6 +139,15 @@ type GameServerConfig struct {
 	// Valid range [500ms, 30s]. Zero or out-of-range values default to DefaultReactionPromptTimeout.
 	// Per REACTION-12 and REACTION-13.
 	ReactionPromptTimeout time.Duration `mapstructure:"reaction_prompt_timeout"`
+	// AmbientInterval is the shortest time between ambient room messages and
+	// NPC barks in any one room. Zero disables ambient messages.
+	AmbientInterval time.Duration `mapstructure:"ambient_interval"`
+	// Flood limits how fast each player may send commands.
+	Flood FloodConfig `mapstructure:"flood"`
+	// Timeouts bounds how long a single command or script call may run.
+	Timeouts TimeoutConfig `mapstructure:"timeouts"`
+	// AITickInterval is how often each zone's NPC AI runs.
+	AITickInterval time.Duration `mapstructure:"ai_tick_interval"`
 }
 
 // ValidateReactionPromptTimeout clamps ReactionPromptTimeout to [500ms, 30s].
@@ -141,6 +167,110 @@ func (g GameServerConfig) Addr() string {
 	return fmt.Sprintf("%s:%d", g.GRPCHost, g.GRPCPort)
 }
 
+// FloodConfig holds command-rate limits. Commands beyond the rate are dropped
+// with a warning; sustained flooding squelches input and then disconnects.
+type FloodConfig struct {
+	// CommandsPerSecond is the sustained command rate. 0 disables flood protection.
+	CommandsPerSecond float64 `mapstructure:"commands_per_second"`
+	// Burst is how many commands may arrive back to back before the rate applies.
+	Burst int `mapstructure:"burst"`
+	// SquelchAfter is how many dropped commands trigger a squelch.
+	SquelchAfter int `mapstructure:"squelch_after"`
+	// SquelchDuration is how long all input is ignored once squelched.
+	SquelchDuration time.Duration `mapstructure:"squelch_duration"`
+	// DisconnectAfter is how many squelches, without the client easing off in
+	// between, disconnect it.
+	DisconnectAfter int `mapstructure:"disconnect_after"`
+}
+
+// validate returns the violations of f, naming keys under prefix.
+func (f FloodConfig) validate(prefix string) []string {
+	var errs []string
+	if f.CommandsPerSecond < 0 {
+		errs = append(errs, fmt.Sprintf("%s.commands_per_second must be >= 0, got %v", prefix, f.CommandsPerSecond))
+	}
+	if f.CommandsPerSecond == 0 {
+		return errs
+	}
+	if f.Burst < 1 {
+		errs = append(errs, fmt.Sprintf("%s.burst must be >= 1, got %d", prefix, f.Burst))
+	}
+	if f.SquelchAfter < 1 {
+		errs = append(errs, fmt.Sprintf("%s.squelch_after must be >= 1, got %d", prefix, f.SquelchAfter))
+	}
+	if f.SquelchDuration <= 0 {
+		errs = append(errs, fmt.Sprintf("%s.squelch_duration must be > 0, got %v", prefix, f.SquelchDuration))
+	}
+	if f.DisconnectAfter < 1 {
+		errs = append(errs, fmt.Sprintf("%s.disconnect_after must be >= 1, got %d", prefix, f.DisconnectAfter))
+	}
+	return errs
+}
+
+// TimeoutConfig holds the deadlines that keep a slow database or script from
+// wedging a player's command loop.
+type TimeoutConfig struct {
+	// Command bounds each player command, including the database calls it
+	// makes. It is derived from the player's stream, so a disconnect also
+	// cancels the command. 0 disables the deadline.
+	Command time.Duration `mapstructure:"command"`
+	// Script bounds the wall-clock time of each Lua hook call, on top of its
+	// instruction budget. 0 disables the deadline.
+	Script time.Duration `mapstructure:"script"`
+}
+
+// validate returns the violations of t, naming keys under prefix.
+func (t TimeoutConfig) validate(prefix string) []string {
+	var errs []string
+	if t.Command < 0 {
+		errs = append(errs, fmt.Sprintf("%s.command must be >= 0, got %v", prefix, t.Command))
+	}
+	if t.Script < 0 {
+		errs = append(errs, fmt.Sprintf("%s.script must be >= 0, got %v", prefix, t.Script))
+	}
+	return errs
+}
+
+// LoginThrottleConfig holds failed-login lockout settings. Once an account or
+// address exceeds its free attempts, each further failure locks it out for
+// twice as long as the last, from BaseLockout up to MaxLockout.
+type LoginThrottleConfig struct {
+	// AccountFreeAttempts is how many failures an account may accrue before lockouts begin.
+	AccountFreeAttempts int `mapstructure:"account_free_attempts"`
+	// IPFreeAttempts is how many failures a source address may accrue before lockouts begin.
+	IPFreeAttempts int `mapstructure:"ip_free_attempts"`
+	// BaseLockout is the first lockout's length. 0 disables login throttling.
+	BaseLockout time.Duration `mapstructure:"base_lockout"`
+	// MaxLockout caps the length of any single lockout.
+	MaxLockout time.Duration `mapstructure:"max_lockout"`
+	// ResetAfter is how long without a failure before the count starts over.
+	ResetAfter time.Duration `mapstructure:"reset_after"`
+}
+
+// validate returns the violations of l, naming keys under prefix.
+func (l LoginThrottleConfig) validate(prefix string) []string {
+	var errs []string
+	if l.BaseLockout < 0 {
+		errs = append(errs, fmt.Sprintf("%s.base_lockout must not be negative, got %v", prefix, l.BaseLockout))
+	}
+	if l.BaseLockout <= 0 {
+		return errs
+	}
+	if l.AccountFreeAttempts < 0 {
+		errs = append(errs, fmt.Sprintf("%s.account_free_attempts must be >= 0, got %d", prefix, l.AccountFreeAttempts))
+	}
+	if l.IPFreeAttempts < 0 {
+		errs = append(errs, fmt.Sprintf("%s.ip_free_attempts must be >= 0, got %d", prefix, l.IPFreeAttempts))
+	}
+	if l.MaxLockout < l.BaseLockout {
+		errs = append(errs, fmt.Sprintf("%s.max_lockout must be at least %s.base_lockout, got %v", prefix, prefix, l.MaxLockout))
+	}
+	if l.ResetAfter <= 0 {
+		errs = append(errs, fmt.Sprintf("%s.reset_after must be > 0, got %v", prefix, l.ResetAfter))
+	}
+	return errs
+}
+
 // WeatherConfig holds weather engine settings.
 type WeatherConfig struct {
 	// ChancePerTick is the probability [0,1] of a weather change occurring each game tick.
@@ -155,6 +285,74 @@ type HotbarConfig struct {
 	MaxHotbars int `mapstructure:"max_hotbars"`
 }
 
+// ChatConfig holds chat filter settings.
+type ChatConfig struct {
+	// WordsFile is the path to a YAML list of words masked in chat. Empty disables word filtering.
+	WordsFile string `mapstructure:"words_file"`
+	// StripLinks removes URLs from chat messages when true.
+	StripLinks bool `mapstructure:"strip_links"`
+	// RateLimitMessages is the maximum number of chat messages per RateLimitWindow. 0 disables rate limiting.
+	RateLimitMessages int `mapstructure:"rate_limit_messages"`
+	// RateLimitWindow is the sliding window over which RateLimitMessages is counted.
+	RateLimitWindow time.Duration `mapstructure:"rate_limit_window"`
+	// MuteDurations are the escalating automatic mute lengths applied on
+	// successive rate-limit violations; the last entry repeats.
+	MuteDurations []time.Duration `mapstructure:"mute_durations"`
+}
+
+// CharactersConfig holds per-account character slot limits.
+//
+// A limit of 0 means unlimited.
+type CharactersConfig struct {
+	// MaxPerAccount is the number of characters an account may own unless its
+	// role or an admin override says otherwise.
+	MaxPerAccount int `mapstructure:"max_per_account"`
+	// RoleSlots overrides MaxPerAccount for accounts with the given role.
+	RoleSlots map[string]int `mapstructure:"role_slots"`
+}
+
+// SlotLimit returns the character limit for an account with the given role
+// and per-account override, where an override of 0 means none.
+//
+// Postcondition: Returns 0 when the account may own any number of characters.
+func (c CharactersConfig) SlotLimit(role string, override int) int {
+	if override > 0 {
+		return override
+	}
+	if n, ok := c.RoleSlots[role]; ok {
+		return n
+	}
+	return c.MaxPerAccount
+}
+
+// ContentConfig locates the game content. Content is loaded from Root with
+// each of Overlays layered over it in order, so a later overlay's file
+// replaces the file at the same relative path in Root and earlier overlays.
+type ContentConfig struct {
+	// Root is the base content directory, laid out like content/.
+	Root string `mapstructure:"root"`
+	// Overlays are directories layered over Root, lowest precedence first,
+	// such as server-specific overrides followed by seasonal packs.
+	Overlays []string `mapstructure:"overlays"`
+}
+
+// TelemetryConfig selects where game telemetry events are exported.
+type TelemetryConfig struct {
+	// Sink is "none" (the default), "postgres" for the game_events table,
+	// "file" for JSON Lines appended to File, or "nats" to publish to NATSURL.
+	Sink string `mapstructure:"sink"`
+	// File is the path events are appended to when Sink is "file".
+	File string `mapstructure:"file"`
+	// NATSURL is the server, e.g. "nats://localhost:4222", when Sink is "nats".
+	NATSURL string `mapstructure:"nats_url"`
+	// NATSSubject prefixes each event's subject: "<subject>.<type>".
+	NATSSubject string `mapstructure:"nats_subject"`
+	// BufferSize is how many events may await export before new ones are dropped.
+	BufferSize int `mapstructure:"buffer_size"`
+	// FlushInterval is the longest an event waits before it is exported.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
 // WebConfig holds HTTP web server settings.
 type WebConfig struct {
 	// Port is the TCP port for the web HTTP server. Default: 0 (disabled). Set to 0 to disable.
@@ -191,6 +389,13 @@ type Config struct {
 	Web        WebConfig        `mapstructure:"web"`
 	Weather    WeatherConfig    `mapstructure:"weather"`
 	Hotbar     HotbarConfig     `mapstructure:"hotbar"`
+	Chat       ChatConfig       `mapstructure:"chat"`
+	Characters CharactersConfig `mapstructure:"characters"`
+	Content    ContentConfig    `mapstructure:"content"`
+	Telemetry  TelemetryConfig  `mapstructure:"telemetry"`
+	// Flags declares the feature flags and whether each is on by default in
+	// this environment. See package flags.
+	Flags map[string]bool `mapstructure:"flags"`
 }
 
 // Validate checks all configuration invariants.
@@ -223,6 +428,21 @@ func (c Config) Validate() error {
 	if err := validateWeather(c.Weather); err != nil {
 		errs = append(errs, err.Error())
 	}
+	if err := validateChat(c.Chat); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if err := validateCharacters(c.Characters); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if err := validateContent(c.Content); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if err := validateTelemetry(c.Telemetry); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if err := validateFlags(c.Flags); err != nil {
+		errs = append(errs, err.Error())
+	}
 
 	if len(errs) > 0 {
 		return fmt.Errorf("configuration validation failed: %s", strings.Join(errs, "; "))
@@ -285,6 +505,20 @@ func validateTelnet(t TelnetConfig) error {
 	if t.WriteTimeout < 0 {
 		errs = append(errs, "telnet.write_timeout must not be negative")
 	}
+	if t.KeepaliveInterval < 0 {
+		errs = append(errs, "telnet.keepalive_interval must not be negative")
+	}
+	if t.KeepaliveTimeout < 0 {
+		errs = append(errs, "telnet.keepalive_timeout must not be negative")
+	}
+	if t.KeepaliveInterval > 0 && t.KeepaliveTimeout > 0 && t.KeepaliveTimeout < t.KeepaliveInterval {
+		errs = append(errs, "telnet.keepalive_timeout must be at least telnet.keepalive_interval")
+	}
+	errs = append(errs, t.Flood.validate("telnet.flood")...)
+	if t.MaxConnsPerIP < 0 {
+		errs = append(errs, fmt.Sprintf("telnet.max_conns_per_ip must be >= 0, got %d", t.MaxConnsPerIP))
+	}
+	errs = append(errs, t.LoginThrottle.validate("telnet.login_throttle")...)
 	// REQ-TD-1c: with the player flow retired by default, the public telnet
 	// port runs the rejector that refuses player auth and disconnects with a
 	// pointer to the web client. The bind address may be 0.0.0.0 in
@@ -315,9 +549,17 @@ func validateGameServer(g GameServerConfig) error {
 	if g.GameTickDuration <= 0 {
 		errs = append(errs, fmt.Sprintf("gameserver.game_tick_duration must be positive, got %v", g.GameTickDuration))
 	}
+	if g.AmbientInterval < 0 {
+		errs = append(errs, fmt.Sprintf("gameserver.ambient_interval must be >= 0, got %v", g.AmbientInterval))
+	}
 	if g.AutoNavStepMs != 0 && g.AutoNavStepMs < 100 {
 		errs = append(errs, fmt.Sprintf("gameserver.auto_nav_step_ms must be >= 100, got %d", g.AutoNavStepMs))
 	}
+	if g.AITickInterval <= 0 {
+		errs = append(errs, fmt.Sprintf("gameserver.ai_tick_interval must be positive, got %v", g.AITickInterval))
+	}
+	errs = append(errs, g.Flood.validate("gameserver.flood")...)
+	errs = append(errs, g.Timeouts.validate("gameserver.timeouts")...)
 	if len(errs) > 0 {
 		return fmt.Errorf("%s", strings.Join(errs, "; "))
 	}
@@ -342,6 +584,107 @@ func validateWeather(w WeatherConfig) error {
 	return nil
 }
 
+func validateChat(c ChatConfig) error {
+	var errs []string
+	if c.RateLimitMessages < 0 {
+		errs = append(errs, fmt.Sprintf("chat.rate_limit_messages must be >= 0, got %d", c.RateLimitMessages))
+	}
+	if c.RateLimitMessages > 0 && c.RateLimitWindow <= 0 {
+		errs = append(errs, "chat.rate_limit_window must be > 0 when rate limiting is enabled")
+	}
+	for _, d := range c.MuteDurations {
+		if d <= 0 {
+			errs = append(errs, fmt.Sprintf("chat.mute_durations entries must be > 0, got %v", d))
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func validateCharacters(c CharactersConfig) error {
+	var errs []string
+	if c.MaxPerAccount < 0 {
+		errs = append(errs, fmt.Sprintf("characters.max_per_account must be >= 0, got %d", c.MaxPerAccount))
+	}
+	roles := make([]string, 0, len(c.RoleSlots))
+	for role := range c.RoleSlots {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		if c.RoleSlots[role] < 0 {
+			errs = append(errs, fmt.Sprintf("characters.role_slots.%s must be >= 0, got %d", role, c.RoleSlots[role]))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func validateContent(c ContentConfig) error {
+	var errs []string
+	if c.Root == "" {
+		errs = append(errs, "content.root must not be empty")
+	}
+	for i, dir := range c.Overlays {
+		if dir == "" {
+			errs = append(errs, fmt.Sprintf("content.overlays[%d] must not be empty", i))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func validateTelemetry(t TelemetryConfig) error {
+	var errs []string
+	switch t.Sink {
+	case "none", "postgres":
+	case "file":
+		if t.File == "" {
+			errs = append(errs, "telemetry.file must not be empty when telemetry.sink is file")
+		}
+	case "nats":
+		if t.NATSURL == "" {
+			errs = append(errs, "telemetry.nats_url must not be empty when telemetry.sink is nats")
+		}
+		if t.NATSSubject == "" {
+			errs = append(errs, "telemetry.nats_subject must not be empty when telemetry.sink is nats")
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("telemetry.sink must be one of [none, postgres, file, nats], got %q", t.Sink))
+	}
+	if t.BufferSize < 1 {
+		errs = append(errs, fmt.Sprintf("telemetry.buffer_size must be >= 1, got %d", t.BufferSize))
+	}
+	if t.FlushInterval <= 0 {
+		errs = append(errs, "telemetry.flush_interval must be > 0")
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func validateFlags(declared map[string]bool) error {
+	var errs []string
+	for name := range declared {
+		if !flags.ValidName(name) {
+			errs = append(errs, fmt.Sprintf("flags.%s: flag names must be lower-case words joined by underscores", name))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 func validateLogging(l LoggingConfig) error {
 	validLevels := map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
 	if !validLevels[l.Level] {
@@ -426,6 +769,19 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("telnet.write_timeout", "30s")
 	v.SetDefault("telnet.idle_timeout", "4m")
 	v.SetDefault("telnet.idle_grace_period", "30s")
+	v.SetDefault("telnet.keepalive_interval", "30s")
+	v.SetDefault("telnet.keepalive_timeout", "90s")
+	v.SetDefault("telnet.flood.commands_per_second", 5)
+	v.SetDefault("telnet.flood.burst", 15)
+	v.SetDefault("telnet.flood.squelch_after", 10)
+	v.SetDefault("telnet.flood.squelch_duration", "5s")
+	v.SetDefault("telnet.flood.disconnect_after", 3)
+	v.SetDefault("telnet.max_conns_per_ip", 5)
+	v.SetDefault("telnet.login_throttle.account_free_attempts", 3)
+	v.SetDefault("telnet.login_throttle.ip_free_attempts", 10)
+	v.SetDefault("telnet.login_throttle.base_lockout", "5s")
+	v.SetDefault("telnet.login_throttle.max_lockout", "15m")
+	v.SetDefault("telnet.login_throttle.reset_after", "1h")
 	v.SetDefault("telnet.headless_port", 4002)
 	v.SetDefault("telnet.allow_game_commands", false)
 	v.SetDefault("telnet.web_client_url", "https://gunchete.local")
@@ -438,6 +794,17 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("gameserver.game_clock_start", 6)
 	v.SetDefault("gameserver.game_tick_duration", "1m")
 	v.SetDefault("gameserver.auto_nav_step_ms", 1000)
+	v.SetDefault("gameserver.ambient_interval", "2m")
+	v.SetDefault("gameserver.ai_tick_interval", "10s")
+	// The game server also sees client-generated requests (map refreshes,
+	// tab completion), so it allows a little more than the telnet frontend.
+	v.SetDefault("gameserver.flood.commands_per_second", 8)
+	v.SetDefault("gameserver.flood.burst", 20)
+	v.SetDefault("gameserver.flood.squelch_after", 20)
+	v.SetDefault("gameserver.flood.squelch_duration", "5s")
+	v.SetDefault("gameserver.flood.disconnect_after", 3)
+	v.SetDefault("gameserver.timeouts.command", "5s")
+	v.SetDefault("gameserver.timeouts.script", "1s")
 
 	v.SetDefault("web.port", 0)
 
@@ -445,4 +812,23 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("weather.content_file", "content/weather.yaml")
 
 	v.SetDefault("hotbar.max_hotbars", 4)
+
+	v.SetDefault("chat.words_file", "content/chat_filter.yaml")
+	v.SetDefault("chat.strip_links", true)
+	v.SetDefault("chat.rate_limit_messages", 5)
+	v.SetDefault("chat.rate_limit_window", "10s")
+	v.SetDefault("chat.mute_durations", []string{"1m", "5m", "30m"})
+
+	v.SetDefault("characters.max_per_account", 5)
+
+	v.SetDefault("content.root", "content")
+	v.SetDefault("content.overlays", []string{})
+
+	v.SetDefault("telemetry.sink", "none")
+	v.SetDefault("telemetry.file", "telemetry.jsonl")
+	v.SetDefault("telemetry.nats_subject", "mud.events")
+	v.SetDefault("telemetry.buffer_size", 10000)
+	v.SetDefault("telemetry.flush_interval", "5s")
+
+	v.SetDefault("flags", map[string]bool{})
 }
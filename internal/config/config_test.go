@@ -43,11 +43,14 @@ func validConfig() Config {
 			GRPCPort:         50051,
 			GameClockStart:   6,
 			GameTickDuration: time.Minute,
+			AITickInterval:   10 * time.Second,
 		},
 		Weather: WeatherConfig{
 			ChancePerTick: 0.05,
 			ContentFile:   "content/weather.yaml",
 		},
+		Content:   ContentConfig{Root: "content"},
+		Telemetry: TelemetryConfig{Sink: "none", BufferSize: 100, FlushInterval: time.Second},
 	}
 }
 
@@ -362,6 +365,18 @@ func TestGameServerConfig_GameTickDuration_Invalid(t *testing.T) {
 	}
 }
 
+func TestGameServerConfig_AmbientInterval(t *testing.T) {
+	cfg := validConfig()
+	cfg.GameServer.AmbientInterval = 0
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("zero AmbientInterval disables ambient messages and must be valid, got %v", err)
+	}
+	cfg.GameServer.AmbientInterval = -time.Second
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for negative AmbientInterval, got nil")
+	}
+}
+
 func TestProperty_GameClockStart_Range(t *testing.T) {
 	rapid.Check(t, func(t *rapid.T) {
 		start := rapid.IntRange(0, 23).Draw(t, "start")
@@ -446,3 +461,289 @@ func TestGameServerConfig_ReactionPromptTimeoutValidInRange(t *testing.T) {
 		t.Fatalf("in-range value should be unchanged, got %v", cfg.ReactionPromptTimeout)
 	}
 }
+
+func TestLoadChatDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.True(t, cfg.Chat.StripLinks)
+	assert.Equal(t, 5, cfg.Chat.RateLimitMessages)
+	assert.Equal(t, 10*time.Second, cfg.Chat.RateLimitWindow)
+	assert.Equal(t, []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute}, cfg.Chat.MuteDurations)
+}
+
+func TestValidateChatRateLimitWindow(t *testing.T) {
+	cfg := validConfig()
+	cfg.Chat.RateLimitMessages = 3
+	assert.Error(t, cfg.Validate())
+	cfg.Chat.RateLimitWindow = time.Second
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadCharactersConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+characters:
+  role_slots:
+    editor: 10
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.Characters.MaxPerAccount)
+	assert.Equal(t, 10, cfg.Characters.SlotLimit("editor", 0))
+	assert.Equal(t, 5, cfg.Characters.SlotLimit("player", 0))
+	assert.Equal(t, 7, cfg.Characters.SlotLimit("editor", 7))
+}
+
+func TestValidateCharactersRejectsNegativeLimits(t *testing.T) {
+	cfg := validConfig()
+	cfg.Characters.MaxPerAccount = -1
+	assert.Error(t, cfg.Validate())
+	cfg.Characters.MaxPerAccount = 0
+	cfg.Characters.RoleSlots = map[string]int{"player": -2}
+	assert.Error(t, cfg.Validate())
+	cfg.Characters.RoleSlots["player"] = 3
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadTelnetKeepaliveDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, cfg.Telnet.KeepaliveInterval)
+	assert.Equal(t, 90*time.Second, cfg.Telnet.KeepaliveTimeout)
+}
+
+func TestValidateTelnetKeepalive(t *testing.T) {
+	cfg := validConfig()
+	cfg.Telnet.KeepaliveInterval = -time.Second
+	assert.Error(t, cfg.Validate())
+	cfg.Telnet.KeepaliveInterval = time.Minute
+	cfg.Telnet.KeepaliveTimeout = 30 * time.Second
+	assert.Error(t, cfg.Validate(), "timeout shorter than the interval")
+	cfg.Telnet.KeepaliveTimeout = 0
+	assert.NoError(t, cfg.Validate())
+	cfg.Telnet.KeepaliveTimeout = 3 * time.Minute
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadFloodDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, cfg.Telnet.Flood.CommandsPerSecond)
+	assert.Equal(t, 15, cfg.Telnet.Flood.Burst)
+	assert.Equal(t, 8.0, cfg.GameServer.Flood.CommandsPerSecond)
+	assert.Equal(t, 5*time.Second, cfg.GameServer.Flood.SquelchDuration)
+}
+
+func TestValidateFlood(t *testing.T) {
+	cfg := validConfig()
+	cfg.GameServer.Flood = FloodConfig{CommandsPerSecond: 2}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gameserver.flood.burst")
+	assert.Contains(t, err.Error(), "gameserver.flood.squelch_duration")
+
+	cfg.GameServer.Flood = FloodConfig{CommandsPerSecond: 2, Burst: 5, SquelchAfter: 5, SquelchDuration: time.Second, DisconnectAfter: 2}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Telnet.Flood.CommandsPerSecond = -1
+	assert.Error(t, cfg.Validate())
+}
+
+func TestLoadTimeoutDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, cfg.GameServer.Timeouts.Command)
+	assert.Equal(t, time.Second, cfg.GameServer.Timeouts.Script)
+	assert.Equal(t, 10*time.Second, cfg.GameServer.AITickInterval)
+}
+
+func TestLoadFlags(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+flags:
+  pvp: false
+  survival_needs: true
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"pvp": false, "survival_needs": true}, cfg.Flags)
+}
+
+func TestValidateFlags(t *testing.T) {
+	cfg := validConfig()
+	cfg.Flags = map[string]bool{"pvp": true, "Survival-Needs": true}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "flags.Survival-Needs")
+	assert.NotContains(t, err.Error(), "flags.pvp")
+}
+
+func TestValidateAITickInterval(t *testing.T) {
+	cfg := validConfig()
+	cfg.GameServer.AITickInterval = 0
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gameserver.ai_tick_interval")
+}
+
+func TestValidateTimeouts(t *testing.T) {
+	cfg := validConfig()
+	cfg.GameServer.Timeouts = TimeoutConfig{Command: -time.Second, Script: -time.Second}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gameserver.timeouts.command")
+	assert.Contains(t, err.Error(), "gameserver.timeouts.script")
+
+	cfg.GameServer.Timeouts = TimeoutConfig{}
+	assert.NoError(t, cfg.Validate(), "zero disables the deadlines")
+}
+
+func TestLoadContentConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "content", cfg.Content.Root)
+	assert.Empty(t, cfg.Content.Overlays)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+content:
+  root: /srv/content
+  overlays:
+    - /srv/overrides
+    - /srv/packs/halloween
+`), 0644))
+	cfg, err = Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "/srv/content", cfg.Content.Root)
+	assert.Equal(t, []string{"/srv/overrides", "/srv/packs/halloween"}, cfg.Content.Overlays)
+}
+
+func TestValidateContent(t *testing.T) {
+	cfg := validConfig()
+	cfg.Content = ContentConfig{Overlays: []string{"packs/winter", ""}}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "content.root")
+	assert.Contains(t, err.Error(), "content.overlays[1]")
+}
+
+func TestLoadTelemetryDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "none", cfg.Telemetry.Sink)
+	assert.Equal(t, "mud.events", cfg.Telemetry.NATSSubject)
+	assert.Equal(t, 10000, cfg.Telemetry.BufferSize)
+	assert.Equal(t, 5*time.Second, cfg.Telemetry.FlushInterval)
+}
+
+func TestValidateTelemetry(t *testing.T) {
+	cfg := validConfig()
+	cfg.Telemetry = TelemetryConfig{Sink: "kafka", BufferSize: 0}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "telemetry.sink")
+	assert.Contains(t, err.Error(), "telemetry.buffer_size")
+	assert.Contains(t, err.Error(), "telemetry.flush_interval")
+
+	cfg.Telemetry = TelemetryConfig{Sink: "nats", BufferSize: 1, FlushInterval: time.Second}
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "telemetry.nats_url")
+
+	cfg.Telemetry.NATSURL, cfg.Telemetry.NATSSubject = "nats://localhost:4222", "mud.events"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadLoginLimitDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+logging:
+  level: info
+  format: json
+`), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.Telnet.MaxConnsPerIP)
+	assert.Equal(t, 3, cfg.Telnet.LoginThrottle.AccountFreeAttempts)
+	assert.Equal(t, 10, cfg.Telnet.LoginThrottle.IPFreeAttempts)
+	assert.Equal(t, 5*time.Second, cfg.Telnet.LoginThrottle.BaseLockout)
+	assert.Equal(t, 15*time.Minute, cfg.Telnet.LoginThrottle.MaxLockout)
+	assert.Equal(t, time.Hour, cfg.Telnet.LoginThrottle.ResetAfter)
+}
+
+func TestValidateLoginLimits(t *testing.T) {
+	cfg := validConfig()
+	cfg.Telnet.MaxConnsPerIP = -1
+	assert.Error(t, cfg.Validate())
+	cfg.Telnet.MaxConnsPerIP = 0
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Telnet.LoginThrottle = LoginThrottleConfig{BaseLockout: time.Minute, MaxLockout: time.Second}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "telnet.login_throttle.max_lockout")
+	assert.Contains(t, err.Error(), "telnet.login_throttle.reset_after")
+
+	cfg.Telnet.LoginThrottle = LoginThrottleConfig{AccountFreeAttempts: 3, BaseLockout: time.Second, MaxLockout: time.Minute, ResetAfter: time.Hour}
+	assert.NoError(t, cfg.Validate())
+}
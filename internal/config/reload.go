@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// reloadable lists the keys a running server applies on a config reload.
+// Every other key keeps its startup value until the process restarts.
+var reloadable = map[string]bool{
+	"gameserver.round_duration_ms": true,
+	"gameserver.ai_tick_interval":  true,
+	"logging.level":                true,
+	"telnet.idle_timeout":          true,
+	"telnet.idle_grace_period":     true,
+	"flags":                        true,
+}
+
+// IsReloadable reports whether key, a dotted config key such as
+// "logging.level", is applied by a config reload without a restart. Entries
+// of a map, such as "flags.pvp", are reloadable when the map is.
+func IsReloadable(key string) bool {
+	if reloadable[key] {
+		return true
+	}
+	i := strings.LastIndex(key, ".")
+	return i > 0 && reloadable[key[:i]]
+}
+
+// Change is one config value that differs between two loads.
+type Change struct {
+	// Key is the dotted config key, e.g. "telnet.idle_timeout".
+	Key string
+	// Old and New are the values before and after; secrets are redacted.
+	Old, New string
+	// Reloadable is true when the running server applies the change;
+	// otherwise it takes effect only after a restart.
+	Reloadable bool
+}
+
+// Diff returns every config value that differs between old and new, in key
+// order.
+//
+// Postcondition: Returns nil when old and new are equal; values of keys
+// naming a password or secret are reported as "[redacted]".
+func Diff(old, new Config) []Change {
+	before := make(map[string]string)
+	after := make(map[string]string)
+	flatten("", reflect.ValueOf(old), before)
+	flatten("", reflect.ValueOf(new), after)
+
+	keys := make(map[string]bool, len(before))
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+	var changes []Change
+	for key := range keys {
+		was, now := before[key], after[key]
+		if was == now {
+			continue
+		}
+		if secret(key) {
+			was, now = "[redacted]", "[redacted]"
+		}
+		changes = append(changes, Change{Key: key, Old: was, New: now, Reloadable: IsReloadable(key)})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// flatten records every leaf of the struct v in out under its dotted
+// mapstructure key, prefixed by prefix. Each entry of a string-keyed map is
+// a leaf of its own, so entries that are added or removed are reported as
+// changing from or to "".
+func flatten(prefix string, v reflect.Value, out map[string]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("mapstructure")
+		if name == "" || name == "-" || !f.IsExported() {
+			continue
+		}
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		switch {
+		case f.Type.Kind() == reflect.Struct:
+			flatten(key, v.Field(i), out)
+			continue
+		case f.Type.Kind() == reflect.Map && f.Type.Key().Kind() == reflect.String:
+			iter := v.Field(i).MapRange()
+			for iter.Next() {
+				out[key+"."+iter.Key().String()] = fmt.Sprint(iter.Value().Interface())
+			}
+			continue
+		}
+		out[key] = fmt.Sprint(v.Field(i).Interface())
+	}
+}
+
+// secret reports whether key names a credential whose value must not be logged.
+func secret(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") || strings.Contains(key, "secret")
+}
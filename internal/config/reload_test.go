@@ -0,0 +1,63 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff_NoChanges(t *testing.T) {
+	assert.Nil(t, Diff(validConfig(), validConfig()))
+}
+
+func TestDiff_ReportsChangesInKeyOrder(t *testing.T) {
+	old := validConfig()
+	new := validConfig()
+	new.Logging.Level = "debug"
+	new.GameServer.AITickInterval = 5 * time.Second
+	new.Telnet.Flood.Burst = 9
+	new.Content.Overlays = []string{"mods/halloween"}
+
+	changes := Diff(old, new)
+	require.Len(t, changes, 4)
+	assert.Equal(t, Change{Key: "content.overlays", Old: "[]", New: "[mods/halloween]"}, changes[0])
+	assert.Equal(t, Change{Key: "gameserver.ai_tick_interval", Old: "10s", New: "5s", Reloadable: true}, changes[1])
+	assert.Equal(t, Change{Key: "logging.level", Old: "info", New: "debug", Reloadable: true}, changes[2])
+	assert.Equal(t, Change{Key: "telnet.flood.burst", Old: "0", New: "9"}, changes[3])
+}
+
+func TestDiff_RedactsSecrets(t *testing.T) {
+	old := validConfig()
+	new := validConfig()
+	new.Database.Password = "hunter2"
+	new.Web.JWTSecret = "s3cret"
+
+	changes := Diff(old, new)
+	require.Len(t, changes, 2)
+	for _, c := range changes {
+		assert.Equal(t, "[redacted]", c.Old, c.Key)
+		assert.Equal(t, "[redacted]", c.New, c.Key)
+		assert.False(t, c.Reloadable, c.Key)
+	}
+}
+
+func TestIsReloadable(t *testing.T) {
+	assert.True(t, IsReloadable("telnet.idle_timeout"))
+	assert.False(t, IsReloadable("gameserver.grpc_port"))
+}
+
+func TestDiff_FlagsDiffPerEntry(t *testing.T) {
+	old := validConfig()
+	old.Flags = map[string]bool{"pvp": false, "economy": true}
+	new := validConfig()
+	new.Flags = map[string]bool{"pvp": true, "survival_needs": false}
+
+	changes := Diff(old, new)
+	assert.Equal(t, []Change{
+		{Key: "flags.economy", Old: "true", New: "", Reloadable: true},
+		{Key: "flags.pvp", Old: "false", New: "true", Reloadable: true},
+		{Key: "flags.survival_needs", Old: "", New: "false", Reloadable: true},
+	}, changes)
+}
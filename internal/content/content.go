@@ -0,0 +1,156 @@
+// Package content resolves the directory the game server loads its content
+// from. Content is layered: a base root followed by overlays, such as
+// server-specific overrides and seasonal packs, each of which may replace or
+// add files without copying the layers beneath it.
+package content
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// Layer is one directory of a layered content tree and what it contributed.
+type Layer struct {
+	// Dir is the layer's root directory.
+	Dir string
+	// Files is how many files of the merged tree come from this layer.
+	Files int
+	// Overrides lists, in order, the relative paths at which this layer
+	// replaced a file from an earlier layer.
+	Overrides []string
+}
+
+// Tree is a resolved content tree.
+type Tree struct {
+	// Root is the directory content is loaded from: the base root itself when
+	// there are no overlays, otherwise a merged view of every layer.
+	Root string
+	// Layers holds the base root first, then each overlay in precedence order.
+	Layers []Layer
+	// merged is true when Root is a merged view Resolve created.
+	merged bool
+}
+
+// Resolve layers overlays over root. A file in a later layer replaces the file
+// at the same relative path in every earlier layer; all other files from every
+// layer are kept. With overlays, the merged view is a temporary directory of
+// symlinks into the layers, so edits to a layer's files show through it.
+//
+// Precondition: root and every overlay must be existing directories.
+// Postcondition: Returns a Tree whose Root holds the merged content, or an
+// error naming the first layer that cannot be read. Content may be reloaded
+// at runtime, so the caller must keep the tree until shutdown and then Close it.
+func Resolve(root string, overlays []string) (*Tree, error) {
+	dirs := append([]string{root}, overlays...)
+	for _, dir := range dirs {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("content layer %q: %w", dir, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("content layer %q is not a directory", dir)
+		}
+	}
+
+	t := &Tree{Root: root, Layers: make([]Layer, len(dirs))}
+	owner := make(map[string]int) // relative path → index of the layer supplying it
+	var subdirs []string
+	for i, dir := range dirs {
+		t.Layers[i].Dir = dir
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if rel != "." {
+					subdirs = append(subdirs, rel)
+				}
+				return nil
+			}
+			if prev, ok := owner[rel]; ok {
+				t.Layers[prev].Files--
+				t.Layers[i].Overrides = append(t.Layers[i].Overrides, rel)
+			}
+			owner[rel] = i
+			t.Layers[i].Files++
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading content layer %q: %w", dir, err)
+		}
+	}
+	if len(overlays) == 0 {
+		return t, nil
+	}
+
+	merged, err := os.MkdirTemp("", "mud-content-")
+	if err != nil {
+		return nil, fmt.Errorf("creating merged content view: %w", err)
+	}
+	t.Root, t.merged = merged, true
+	if err := t.link(dirs, subdirs, owner); err != nil {
+		_ = t.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// link populates the merged view with subdirs and a symlink for each file to
+// the layer that owns it.
+func (t *Tree) link(dirs, subdirs []string, owner map[string]int) error {
+	for _, rel := range subdirs {
+		if err := os.MkdirAll(filepath.Join(t.Root, rel), 0o755); err != nil {
+			return fmt.Errorf("creating merged content view: %w", err)
+		}
+	}
+	for rel, i := range owner {
+		src, err := filepath.Abs(filepath.Join(dirs[i], rel))
+		if err != nil {
+			return fmt.Errorf("resolving %q: %w", rel, err)
+		}
+		if err := os.Symlink(src, filepath.Join(t.Root, rel)); err != nil {
+			return fmt.Errorf("creating merged content view: %w", err)
+		}
+	}
+	return nil
+}
+
+// Path returns the path of rel, a path relative to the content root, within
+// the tree.
+func (t *Tree) Path(rel string) string {
+	return filepath.Join(t.Root, rel)
+}
+
+// Close removes the merged view, if Resolve created one. The layers themselves
+// are never touched.
+func (t *Tree) Close() error {
+	if !t.merged {
+		return nil
+	}
+	return os.RemoveAll(t.Root)
+}
+
+// LogManifest logs which layers make up the tree and, at debug level, every
+// file an overlay replaced.
+func (t *Tree) LogManifest(logger *zap.Logger) {
+	for i, l := range t.Layers {
+		logger.Info("content layer",
+			zap.Int("precedence", i),
+			zap.String("dir", l.Dir),
+			zap.Int("files", l.Files),
+			zap.Int("overrides", len(l.Overrides)),
+		)
+		for _, rel := range l.Overrides {
+			logger.Debug("content override", zap.String("dir", l.Dir), zap.String("path", rel))
+		}
+	}
+	logger.Info("content resolved", zap.String("root", t.Root), zap.Int("layers", len(t.Layers)))
+}
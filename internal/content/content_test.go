@@ -0,0 +1,113 @@
+package content_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/cory-johannsen/mud/internal/content"
+)
+
+// writeLayer creates a layer directory holding files, keyed by relative path.
+func writeLayer(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for rel, body := range files {
+		path := filepath.Join(dir, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+	}
+	return dir
+}
+
+func read(t *testing.T, tree *content.Tree, rel string) string {
+	t.Helper()
+	b, err := os.ReadFile(tree.Path(rel))
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestResolve_NoOverlaysUsesRoot(t *testing.T) {
+	base := writeLayer(t, map[string]string{"items/a.yaml": "a"})
+	tree, err := content.Resolve(base, nil)
+	require.NoError(t, err)
+	defer tree.Close()
+	assert.Equal(t, base, tree.Root)
+	require.Len(t, tree.Layers, 1)
+	assert.Equal(t, 1, tree.Layers[0].Files)
+}
+
+func TestResolve_LaterLayersWin(t *testing.T) {
+	base := writeLayer(t, map[string]string{
+		"items/a.yaml": "base a",
+		"items/b.yaml": "base b",
+		"skills.yaml":  "base skills",
+	})
+	server := writeLayer(t, map[string]string{
+		"items/a.yaml":  "server a",
+		"quests/q.yaml": "server quest",
+	})
+	season := writeLayer(t, map[string]string{
+		"items/a.yaml": "season a",
+		"items/c.yaml": "season c",
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(season, "recipes"), 0o755))
+
+	tree, err := content.Resolve(base, []string{server, season})
+	require.NoError(t, err)
+	assert.NotEqual(t, base, tree.Root)
+
+	assert.Equal(t, "season a", read(t, tree, "items/a.yaml"))
+	assert.Equal(t, "base b", read(t, tree, "items/b.yaml"))
+	assert.Equal(t, "season c", read(t, tree, "items/c.yaml"))
+	assert.Equal(t, "server quest", read(t, tree, "quests/q.yaml"))
+	assert.Equal(t, "base skills", read(t, tree, "skills.yaml"))
+	assert.DirExists(t, tree.Path("recipes"), "empty directories are kept")
+
+	require.Len(t, tree.Layers, 3)
+	assert.Equal(t, 2, tree.Layers[0].Files)
+	assert.Empty(t, tree.Layers[0].Overrides)
+	assert.Equal(t, 1, tree.Layers[1].Files)
+	assert.Equal(t, []string{filepath.Join("items", "a.yaml")}, tree.Layers[1].Overrides)
+	assert.Equal(t, 2, tree.Layers[2].Files)
+	assert.Equal(t, []string{filepath.Join("items", "a.yaml")}, tree.Layers[2].Overrides)
+
+	require.NoError(t, os.WriteFile(filepath.Join(base, "items/b.yaml"), []byte("edited b"), 0o644))
+	assert.Equal(t, "edited b", read(t, tree, "items/b.yaml"), "the merged view tracks its layers")
+
+	require.NoError(t, tree.Close())
+	assert.NoDirExists(t, tree.Root)
+	assert.FileExists(t, filepath.Join(base, "items/a.yaml"), "closing never touches the layers")
+}
+
+func TestResolve_MissingLayer(t *testing.T) {
+	base := writeLayer(t, nil)
+	_, err := content.Resolve(base, []string{filepath.Join(base, "nope")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nope")
+
+	file := filepath.Join(writeLayer(t, map[string]string{"f": "x"}), "f")
+	_, err = content.Resolve(file, nil)
+	assert.ErrorContains(t, err, "is not a directory")
+}
+
+func TestTree_LogManifest(t *testing.T) {
+	base := writeLayer(t, map[string]string{"a.yaml": "a"})
+	over := writeLayer(t, map[string]string{"a.yaml": "b"})
+	tree, err := content.Resolve(base, []string{over})
+	require.NoError(t, err)
+	defer tree.Close()
+
+	core, logs := observer.New(zap.DebugLevel)
+	tree.LogManifest(zap.New(core))
+	assert.Equal(t, 2, logs.FilterMessage("content layer").Len())
+	overrides := logs.FilterMessage("content override").All()
+	require.Len(t, overrides, 1)
+	assert.Equal(t, "a.yaml", overrides[0].ContextMap()["path"])
+	assert.Equal(t, 1, logs.FilterMessage("content resolved").Len())
+}
@@ -0,0 +1,261 @@
+// Package flags decides whether a feature that ships dark is enabled. Each
+// flag is declared in the server config with its default for that
+// environment; an admin may override the default at runtime for everyone or
+// for a single account, such as a test account, without a restart.
+//
+// Code checks a flag through the installed Set:
+//
+//	if flags.Enabled("pvp") { ... }
+//	if flags.EnabledFor("pvp", sess.AccountID) { ... }
+package flags
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Override replaces a flag's configured default, for everyone when
+// AccountID is 0 or for one account otherwise.
+type Override struct {
+	Flag      string
+	AccountID int64
+	// Username names the account; it is empty for a global override.
+	Username string
+	Enabled  bool
+	SetBy    string
+	SetAt    time.Time
+}
+
+// Store persists overrides.
+type Store interface {
+	ListFlagOverrides(ctx context.Context) ([]Override, error)
+	// SaveFlagOverride inserts o, replacing any override of the same flag
+	// for the same account.
+	SaveFlagOverride(ctx context.Context, o Override) error
+	// DeleteFlagOverride removes the override of flag for accountID,
+	// reporting whether one existed.
+	DeleteFlagOverride(ctx context.Context, flag string, accountID int64) (bool, error)
+}
+
+// namePattern is the form of a flag name: lower-case words joined by underscores.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ValidName reports whether name is a well-formed flag name.
+func ValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// overrideKey identifies an override.
+type overrideKey struct {
+	flag      string
+	accountID int64
+}
+
+// Set holds the declared flags and their overrides. A nil Set enables
+// nothing. It is safe for concurrent use.
+type Set struct {
+	mu        sync.RWMutex
+	defaults  map[string]bool
+	overrides map[overrideKey]Override
+	store     Store
+}
+
+// New returns a Set declaring the flags in defaults, backed by store.
+//
+// Precondition: store may be nil to keep overrides in memory only.
+// Postcondition: Call Reload to load persisted overrides.
+func New(defaults map[string]bool, store Store) *Set {
+	s := &Set{overrides: make(map[overrideKey]Override), store: store}
+	s.SetDefaults(defaults)
+	return s
+}
+
+// SetDefaults replaces the declared flags and their defaults, as on a config
+// reload. Overrides of flags no longer declared are kept but have no effect
+// until the flag is declared again.
+func (s *Set) SetDefaults(defaults map[string]bool) {
+	d := make(map[string]bool, len(defaults))
+	for name, on := range defaults {
+		d[name] = on
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.defaults = d
+}
+
+// Reload replaces the in-memory overrides with the persisted ones.
+//
+// Postcondition: On error the current overrides are kept.
+func (s *Set) Reload(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+	list, err := s.store.ListFlagOverrides(ctx)
+	if err != nil {
+		return fmt.Errorf("loading flag overrides: %w", err)
+	}
+	overrides := make(map[overrideKey]Override, len(list))
+	for _, o := range list {
+		overrides[overrideKey{o.Flag, o.AccountID}] = o
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides = overrides
+	return nil
+}
+
+// Declared reports whether name is declared in the config.
+func (s *Set) Declared(name string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.defaults[name]
+	return ok
+}
+
+// Enabled reports whether name is on for everyone: its global override if
+// one is set, else its configured default. Undeclared flags are off.
+func (s *Set) Enabled(name string) bool {
+	return s.EnabledFor(name, 0)
+}
+
+// EnabledFor reports whether name is on for the account: the account's
+// override if one is set, else Enabled. accountID 0 checks the global value.
+func (s *Set) EnabledFor(name string, accountID int64) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	on, declared := s.defaults[name]
+	if !declared {
+		return false
+	}
+	if accountID != 0 {
+		if o, ok := s.overrides[overrideKey{name, accountID}]; ok {
+			return o.Enabled
+		}
+	}
+	if o, ok := s.overrides[overrideKey{name, 0}]; ok {
+		return o.Enabled
+	}
+	return on
+}
+
+// Flag describes a declared flag for display.
+type Flag struct {
+	Name    string
+	Default bool
+	// Global is the global override, or nil when the default applies.
+	Global *Override
+	// Accounts are the per-account overrides, ordered by username.
+	Accounts []Override
+}
+
+// Flags returns every declared flag ordered by name.
+func (s *Set) Flags() []Flag {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]Flag, 0, len(s.defaults))
+	byName := make(map[string]int, len(s.defaults))
+	for name, on := range s.defaults {
+		byName[name] = len(out)
+		out = append(out, Flag{Name: name, Default: on})
+	}
+	for _, o := range s.overrides {
+		i, ok := byName[o.Flag]
+		if !ok {
+			continue
+		}
+		if o.AccountID == 0 {
+			global := o
+			out[i].Global = &global
+		} else {
+			out[i].Accounts = append(out[i].Accounts, o)
+		}
+	}
+	for i := range out {
+		sort.Slice(out[i].Accounts, func(a, b int) bool {
+			return out[i].Accounts[a].Username < out[i].Accounts[b].Username
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Put persists o and makes it active, replacing any override of the same
+// flag for the same account.
+//
+// Precondition: o.Flag must be declared.
+// Postcondition: A zero o.SetAt is stamped with the current time.
+func (s *Set) Put(ctx context.Context, o Override) error {
+	if !s.Declared(o.Flag) {
+		return fmt.Errorf("unknown flag %q", o.Flag)
+	}
+	if o.SetAt.IsZero() {
+		o.SetAt = time.Now()
+	}
+	if s.store != nil {
+		if err := s.store.SaveFlagOverride(ctx, o); err != nil {
+			return fmt.Errorf("saving override of flag %s: %w", o.Flag, err)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[overrideKey{o.Flag, o.AccountID}] = o
+	return nil
+}
+
+// Clear removes the override of name for accountID (0 for the global
+// override), restoring the default.
+//
+// Postcondition: Reports whether an override existed.
+func (s *Set) Clear(ctx context.Context, name string, accountID int64) (bool, error) {
+	found := false
+	if s.store != nil {
+		var err error
+		if found, err = s.store.DeleteFlagOverride(ctx, name, accountID); err != nil {
+			return false, fmt.Errorf("deleting override of flag %s: %w", name, err)
+		}
+	}
+	key := overrideKey{name, accountID}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.overrides[key]; ok {
+		delete(s.overrides, key)
+		return true, nil
+	}
+	return found, nil
+}
+
+// installed is the Set the package-level checks consult.
+var installed atomic.Pointer[Set]
+
+// Install makes s the Set consulted by Enabled and EnabledFor.
+//
+// Postcondition: s may be nil, which turns every flag off.
+func Install(s *Set) {
+	installed.Store(s)
+}
+
+// Enabled reports whether name is on for everyone in the installed Set.
+// Every flag is off until a Set is installed.
+func Enabled(name string) bool {
+	return installed.Load().Enabled(name)
+}
+
+// EnabledFor reports whether name is on for the account in the installed Set.
+// Every flag is off until a Set is installed.
+func EnabledFor(name string, accountID int64) bool {
+	return installed.Load().EnabledFor(name, accountID)
+}
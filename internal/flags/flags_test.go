@@ -0,0 +1,164 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+type memStore struct {
+	overrides map[overrideKey]Override
+	fail      bool
+}
+
+func newMemStore() *memStore { return &memStore{overrides: make(map[overrideKey]Override)} }
+
+func (m *memStore) ListFlagOverrides(context.Context) ([]Override, error) {
+	if m.fail {
+		return nil, errors.New("db down")
+	}
+	var out []Override
+	for _, o := range m.overrides {
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+func (m *memStore) SaveFlagOverride(_ context.Context, o Override) error {
+	if m.fail {
+		return errors.New("db down")
+	}
+	m.overrides[overrideKey{o.Flag, o.AccountID}] = o
+	return nil
+}
+
+func (m *memStore) DeleteFlagOverride(_ context.Context, flag string, accountID int64) (bool, error) {
+	if m.fail {
+		return false, errors.New("db down")
+	}
+	_, ok := m.overrides[overrideKey{flag, accountID}]
+	delete(m.overrides, overrideKey{flag, accountID})
+	return ok, nil
+}
+
+func TestSet_DefaultsAndOverrides(t *testing.T) {
+	ctx := context.Background()
+	s := New(map[string]bool{"pvp": false, "economy": true}, nil)
+
+	assert.False(t, s.Enabled("pvp"))
+	assert.True(t, s.Enabled("economy"))
+	assert.False(t, s.Enabled("survival_needs"), "undeclared flags are off")
+
+	require.NoError(t, s.Put(ctx, Override{Flag: "pvp", AccountID: 7, Username: "tester", Enabled: true}))
+	assert.True(t, s.EnabledFor("pvp", 7))
+	assert.False(t, s.EnabledFor("pvp", 8))
+	assert.False(t, s.Enabled("pvp"))
+
+	require.NoError(t, s.Put(ctx, Override{Flag: "economy", Enabled: false}))
+	assert.False(t, s.EnabledFor("economy", 7), "the global override applies to accounts without their own")
+
+	found, err := s.Clear(ctx, "economy", 0)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, s.Enabled("economy"))
+
+	found, err = s.Clear(ctx, "economy", 0)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	assert.Error(t, s.Put(ctx, Override{Flag: "survival_needs", Enabled: true}))
+}
+
+func TestSet_PersistsAndReloads(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	s := New(map[string]bool{"pvp": false}, store)
+	require.NoError(t, s.Put(ctx, Override{Flag: "pvp", Enabled: true, SetBy: "admin"}))
+	assert.False(t, store.overrides[overrideKey{"pvp", 0}].SetAt.IsZero())
+
+	other := New(map[string]bool{"pvp": false}, store)
+	require.NoError(t, other.Reload(ctx))
+	assert.True(t, other.Enabled("pvp"))
+
+	store.fail = true
+	assert.Error(t, other.Reload(ctx))
+	assert.True(t, other.Enabled("pvp"), "a failed reload keeps the current overrides")
+	assert.Error(t, other.Put(ctx, Override{Flag: "pvp", AccountID: 3, Enabled: false}))
+	assert.True(t, other.EnabledFor("pvp", 3), "a failed save changes nothing")
+}
+
+func TestSet_SetDefaultsKeepsOverrides(t *testing.T) {
+	ctx := context.Background()
+	s := New(map[string]bool{"pvp": false}, nil)
+	require.NoError(t, s.Put(ctx, Override{Flag: "pvp", Enabled: true}))
+
+	s.SetDefaults(map[string]bool{"economy": true})
+	assert.False(t, s.Enabled("pvp"), "an undeclared flag is off despite its override")
+	s.SetDefaults(map[string]bool{"pvp": false})
+	assert.True(t, s.Enabled("pvp"))
+}
+
+func TestSet_Flags(t *testing.T) {
+	ctx := context.Background()
+	s := New(map[string]bool{"pvp": false, "economy": true}, nil)
+	require.NoError(t, s.Put(ctx, Override{Flag: "pvp", AccountID: 2, Username: "zed", Enabled: true}))
+	require.NoError(t, s.Put(ctx, Override{Flag: "pvp", AccountID: 1, Username: "amy", Enabled: true}))
+	require.NoError(t, s.Put(ctx, Override{Flag: "pvp", Enabled: false}))
+
+	list := s.Flags()
+	require.Len(t, list, 2)
+	assert.Equal(t, "economy", list[0].Name)
+	assert.Nil(t, list[0].Global)
+	assert.Equal(t, "pvp", list[1].Name)
+	require.NotNil(t, list[1].Global)
+	assert.False(t, list[1].Global.Enabled)
+	require.Len(t, list[1].Accounts, 2)
+	assert.Equal(t, "amy", list[1].Accounts[0].Username)
+}
+
+func TestNilSet_EnablesNothing(t *testing.T) {
+	var s *Set
+	assert.False(t, s.Enabled("pvp"))
+	assert.False(t, s.Declared("pvp"))
+	assert.Nil(t, s.Flags())
+}
+
+func TestInstall(t *testing.T) {
+	t.Cleanup(func() { Install(nil) })
+	assert.False(t, Enabled("pvp"))
+
+	s := New(map[string]bool{"pvp": true}, nil)
+	require.NoError(t, s.Put(context.Background(), Override{Flag: "pvp", AccountID: 4, Enabled: false}))
+	Install(s)
+	assert.True(t, Enabled("pvp"))
+	assert.False(t, EnabledFor("pvp", 4))
+}
+
+func TestValidName(t *testing.T) {
+	assert.True(t, ValidName("survival_needs"))
+	assert.True(t, ValidName("pvp2"))
+	for _, name := range []string{"", "PvP", "2fa", "survival-needs", "_pvp", "pvp needs"} {
+		assert.False(t, ValidName(name), name)
+	}
+}
+
+// TestProperty_AccountOverrideWins verifies that an account's own override
+// decides the flag for it whatever the default and global override are.
+func TestProperty_AccountOverrideWins(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		ctx := context.Background()
+		def := rapid.Bool().Draw(rt, "default")
+		s := New(map[string]bool{"pvp": def}, nil)
+		if rapid.Bool().Draw(rt, "hasGlobal") {
+			require.NoError(rt, s.Put(ctx, Override{Flag: "pvp", Enabled: rapid.Bool().Draw(rt, "global")}))
+		}
+		own := rapid.Bool().Draw(rt, "own")
+		id := rapid.Int64Range(1, 1000).Draw(rt, "account")
+		require.NoError(rt, s.Put(ctx, Override{Flag: "pvp", AccountID: id, Enabled: own}))
+		assert.Equal(rt, own, s.EnabledFor("pvp", id))
+	})
+}
@@ -0,0 +1,111 @@
+// Package flood rate-limits command input with a token bucket and escalates
+// sustained flooding from warnings to a temporary squelch to disconnection.
+package flood
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/config"
+)
+
+// Verdict is the outcome of checking one command against a Limiter.
+type Verdict int
+
+const (
+	// Allow means the command may run.
+	Allow Verdict = iota
+	// Warn means the command was dropped and the sender should be warned; it
+	// is returned for the first drop after the sender was last calm.
+	Warn
+	// Drop means the command was dropped silently.
+	Drop
+	// Squelch means the command was dropped and input is now ignored for the
+	// squelch duration.
+	Squelch
+	// Squelched means the command arrived during a squelch and was dropped.
+	Squelched
+	// Disconnect means the sender kept flooding and should be disconnected.
+	Disconnect
+)
+
+// Limiter tracks one sender's command rate. A nil Limiter allows everything.
+// It is safe for concurrent use.
+type Limiter struct {
+	mu  sync.Mutex
+	cfg config.FloodConfig
+
+	tokens float64
+	// last is when tokens was last refilled.
+	last time.Time
+	// drops counts commands dropped since the last squelch or calm period.
+	drops int
+	// squelches counts squelches since the sender was last calm.
+	squelches      int
+	squelchedUntil time.Time
+}
+
+// New returns a limiter for cfg whose bucket starts full at now.
+//
+// Precondition: cfg must have passed config validation.
+// Postcondition: Returns nil when cfg disables flood protection.
+func New(cfg config.FloodConfig, now time.Time) *Limiter {
+	if cfg.CommandsPerSecond <= 0 {
+		return nil
+	}
+	return &Limiter{cfg: cfg, tokens: float64(cfg.Burst), last: now}
+}
+
+// Check records a command arriving at now and returns what to do with it.
+// A sender is calm again, and its escalation forgotten, once its bucket has
+// refilled completely.
+func (l *Limiter) Check(now time.Time) Verdict {
+	if l == nil {
+		return Allow
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.squelchedUntil) {
+		return Squelched
+	}
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.cfg.CommandsPerSecond
+		l.last = now
+	}
+	if burst := float64(l.cfg.Burst); l.tokens >= burst {
+		l.tokens = burst
+		l.drops, l.squelches = 0, 0
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return Allow
+	}
+
+	l.drops++
+	if l.drops >= l.cfg.SquelchAfter {
+		l.drops = 0
+		l.squelches++
+		if l.squelches >= l.cfg.DisconnectAfter {
+			return Disconnect
+		}
+		// The bucket starts refilling only once the squelch ends, so a sender
+		// that resumes flooding straight away escalates towards disconnection.
+		l.tokens = 0
+		l.squelchedUntil = now.Add(l.cfg.SquelchDuration)
+		l.last = l.squelchedUntil
+		return Squelch
+	}
+	if l.drops == 1 {
+		return Warn
+	}
+	return Drop
+}
+
+// SquelchDuration returns how long a squelch lasts.
+func (l *Limiter) SquelchDuration() time.Duration {
+	if l == nil {
+		return 0
+	}
+	return l.cfg.SquelchDuration
+}
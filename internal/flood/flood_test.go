@@ -0,0 +1,113 @@
+package flood_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/flood"
+)
+
+func testConfig() config.FloodConfig {
+	return config.FloodConfig{
+		CommandsPerSecond: 2,
+		Burst:             3,
+		SquelchAfter:      3,
+		SquelchDuration:   5 * time.Second,
+		DisconnectAfter:   2,
+	}
+}
+
+func TestNew_DisabledAllowsEverything(t *testing.T) {
+	l := flood.New(config.FloodConfig{}, time.Now())
+	require.Nil(t, l)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, flood.Allow, l.Check(time.Now()))
+	}
+	assert.Zero(t, l.SquelchDuration())
+}
+
+func TestCheck_EscalatesToDisconnect(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := flood.New(testConfig(), now)
+
+	var got []flood.Verdict
+	for i := 0; i < 6; i++ {
+		got = append(got, l.Check(now))
+	}
+	assert.Equal(t, []flood.Verdict{
+		flood.Allow, flood.Allow, flood.Allow,
+		flood.Warn, flood.Drop, flood.Squelch,
+	}, got)
+
+	assert.Equal(t, flood.Squelched, l.Check(now.Add(4*time.Second)))
+
+	// Resuming the flood as soon as the squelch ends disconnects.
+	now = now.Add(5 * time.Second)
+	got = got[:0]
+	for i := 0; i < 3; i++ {
+		got = append(got, l.Check(now))
+	}
+	assert.Equal(t, []flood.Verdict{flood.Warn, flood.Drop, flood.Disconnect}, got)
+}
+
+func TestCheck_CalmResetsEscalation(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := flood.New(testConfig(), now)
+	for i := 0; i < 6; i++ {
+		l.Check(now)
+	}
+	// Squelched at 0s until 5s; the bucket is full again 1.5s later.
+	now = now.Add(6500 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, flood.Allow, l.Check(now))
+	}
+	assert.Equal(t, flood.Warn, l.Check(now))
+	assert.Equal(t, flood.Drop, l.Check(now))
+	assert.Equal(t, flood.Squelch, l.Check(now), "the earlier squelch was forgiven")
+}
+
+func TestCheck_SteadyRateIsAllowed(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := flood.New(testConfig(), now)
+	for i := 0; i < 100; i++ {
+		now = now.Add(500 * time.Millisecond)
+		require.Equal(t, flood.Allow, l.Check(now), "command %d", i)
+	}
+}
+
+// TestProperty_Check_AllowedNeverExceedsBucket verifies that, over any
+// schedule of commands, the number allowed never exceeds the burst plus what
+// the rate refilled.
+func TestProperty_Check_AllowedNeverExceedsBucket(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		cfg := config.FloodConfig{
+			CommandsPerSecond: float64(rapid.IntRange(1, 10).Draw(rt, "rate")),
+			Burst:             rapid.IntRange(1, 20).Draw(rt, "burst"),
+			SquelchAfter:      rapid.IntRange(1, 10).Draw(rt, "squelchAfter"),
+			SquelchDuration:   time.Duration(rapid.IntRange(1, 10).Draw(rt, "squelchSecs")) * time.Second,
+			DisconnectAfter:   rapid.IntRange(1, 5).Draw(rt, "disconnectAfter"),
+		}
+		start := time.Unix(0, 0)
+		now := start
+		l := flood.New(cfg, start)
+		allowed := 0
+		for i, n := 0, rapid.IntRange(1, 200).Draw(rt, "commands"); i < n; i++ {
+			now = now.Add(time.Duration(rapid.IntRange(0, 1000).Draw(rt, "gapMs")) * time.Millisecond)
+			switch l.Check(now) {
+			case flood.Allow:
+				allowed++
+			case flood.Disconnect:
+				return
+			}
+			limit := float64(cfg.Burst) + now.Sub(start).Seconds()*cfg.CommandsPerSecond
+			if float64(allowed) > limit+1e-9 {
+				rt.Fatalf("allowed %d commands by %v, limit %.2f", allowed, now.Sub(start), limit)
+			}
+		}
+	})
+}
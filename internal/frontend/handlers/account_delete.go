@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// AccountDeleter schedules and cancels the deletion of an account.
+type AccountDeleter interface {
+	RequestDeletion(ctx context.Context, accountID int64) (time.Time, error)
+	CancelDeletion(ctx context.Context, accountID int64) error
+}
+
+// accountDeleteWindowDays is postgres.AccountDeleteWindow in whole days, for player-facing text.
+var accountDeleteWindowDays = int(postgres.AccountDeleteWindow / (24 * time.Hour))
+
+// SetAccountDeleter enables the "delete account" and "cancel deletion"
+// commands on the character menu.
+//
+// Postcondition: Without a deleter the commands are not offered.
+func (h *AuthHandler) SetAccountDeleter(d AccountDeleter) {
+	h.accountDeleter = d
+}
+
+// daysUntilAccountPurge returns the whole days, rounded up, before an account
+// whose deletion was requested at requestedAt is purged.
+func daysUntilAccountPurge(requestedAt, now time.Time) int {
+	return daysUntil(requestedAt.Add(postgres.AccountDeleteWindow), now)
+}
+
+// writeAccountDeletionNotice tells the player their account is about to be
+// deleted and how to keep it.
+//
+// Precondition: acct.DeletionRequestedAt must be non-nil.
+func writeAccountDeletionNotice(conn *telnet.Conn, acct postgres.Account, now time.Time) {
+	_ = conn.WriteLine(telnet.Colorf(telnet.BrightRed,
+		"\r\nYour account and all its characters will be deleted in %d day(s). Type 'cancel deletion' to keep them.",
+		daysUntilAccountPurge(*acct.DeletionRequestedAt, now)))
+}
+
+// deleteAccountPrompt schedules the account for deletion after the player
+// re-enters their password.
+//
+// Precondition: conn must be open; h.accountDeleter must be non-nil.
+// Postcondition: acct.DeletionRequestedAt is set only when the password was
+// correct and the request was stored; returns a non-nil error only when
+// reading from conn fails.
+func (h *AuthHandler) deleteAccountPrompt(ctx context.Context, conn *telnet.Conn, acct *postgres.Account) error {
+	_ = conn.WriteLine(telnet.Colorf(telnet.Red,
+		"This deletes account %q and every character on it, permanently, after %d days.", acct.Username, accountDeleteWindowDays))
+	_ = conn.WritePrompt(telnet.Colorize(telnet.Red, "Enter your password to confirm, or press Enter to cancel: "))
+	password, err := conn.ReadPassword()
+	if err != nil {
+		return fmt.Errorf("reading account deletion confirmation: %w", err)
+	}
+	if password == "" {
+		_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "Account deletion cancelled."))
+		return nil
+	}
+	if _, err := h.accounts.Authenticate(ctx, acct.Username, password); err != nil {
+		if !errors.Is(err, postgres.ErrInvalidCredentials) {
+			h.logger.Warn("confirming account deletion", zap.Int64("account_id", acct.ID), zap.Error(err))
+		}
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Incorrect password. Account deletion cancelled."))
+		return nil
+	}
+	at, err := h.accountDeleter.RequestDeletion(ctx, acct.ID)
+	if err != nil {
+		h.logger.Warn("requesting account deletion", zap.Int64("account_id", acct.ID), zap.Error(err))
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to delete account."))
+		return nil
+	}
+	acct.DeletionRequestedAt = &at
+	h.logger.Info("account deletion requested", zap.Int64("account_id", acct.ID))
+	_ = conn.WriteLine(telnet.Colorf(telnet.Cyan,
+		"Your account will be deleted on %s. Log in and type 'cancel deletion' before then to keep it.",
+		at.Add(postgres.AccountDeleteWindow).UTC().Format("2006-01-02")))
+	return nil
+}
+
+// cancelAccountDeletion keeps an account whose deletion is pending.
+//
+// Precondition: conn must be open; h.accountDeleter must be non-nil.
+// Postcondition: acct.DeletionRequestedAt is cleared on success.
+func (h *AuthHandler) cancelAccountDeletion(ctx context.Context, conn *telnet.Conn, acct *postgres.Account) {
+	if err := h.accountDeleter.CancelDeletion(ctx, acct.ID); err != nil {
+		if errors.Is(err, postgres.ErrNoDeletionPending) {
+			acct.DeletionRequestedAt = nil
+			_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "Your account is not scheduled for deletion."))
+			return
+		}
+		h.logger.Warn("cancelling account deletion", zap.Int64("account_id", acct.ID), zap.Error(err))
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to cancel account deletion."))
+		return
+	}
+	acct.DeletionRequestedAt = nil
+	h.logger.Info("account deletion cancelled", zap.Int64("account_id", acct.ID))
+	_ = conn.WriteLine(telnet.Colorize(telnet.Cyan, "Account deletion cancelled. Welcome back."))
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// mockAccountDeleter records deletion requests in memory.
+type mockAccountDeleter struct {
+	requested map[int64]time.Time
+}
+
+func (m *mockAccountDeleter) RequestDeletion(_ context.Context, accountID int64) (time.Time, error) {
+	at, ok := m.requested[accountID]
+	if !ok {
+		at = time.Now()
+		m.requested[accountID] = at
+	}
+	return at, nil
+}
+
+func (m *mockAccountDeleter) CancelDeletion(_ context.Context, accountID int64) error {
+	if _, ok := m.requested[accountID]; !ok {
+		return postgres.ErrNoDeletionPending
+	}
+	delete(m.requested, accountID)
+	return nil
+}
+
+func newAccountDeleteTestHandler(t *testing.T) (*AuthHandler, *mockAccountDeleter, postgres.Account) {
+	t.Helper()
+	accounts := newMockAccountStore()
+	acct, err := accounts.Create(context.Background(), "vex", "hunter2")
+	require.NoError(t, err)
+	deleter := &mockAccountDeleter{requested: make(map[int64]time.Time)}
+	h := &AuthHandler{accounts: accounts, logger: zaptest.NewLogger(t)}
+	h.SetAccountDeleter(deleter)
+	return h, deleter, acct
+}
+
+func TestDeleteAccountPrompt_RequiresPassword(t *testing.T) {
+	h, deleter, acct := newAccountDeleteTestHandler(t)
+
+	require.NoError(t, h.deleteAccountPrompt(context.Background(), newScriptedConn(t, "wrong\r\n"), &acct))
+	assert.Nil(t, acct.DeletionRequestedAt)
+	assert.Empty(t, deleter.requested)
+
+	require.NoError(t, h.deleteAccountPrompt(context.Background(), newScriptedConn(t, "\r\n"), &acct))
+	assert.Empty(t, deleter.requested, "an empty password cancels")
+
+	require.NoError(t, h.deleteAccountPrompt(context.Background(), newScriptedConn(t, "hunter2\r\n"), &acct))
+	require.NotNil(t, acct.DeletionRequestedAt)
+	assert.Contains(t, deleter.requested, acct.ID)
+}
+
+func TestCancelAccountDeletion(t *testing.T) {
+	h, deleter, acct := newAccountDeleteTestHandler(t)
+	at, err := deleter.RequestDeletion(context.Background(), acct.ID)
+	require.NoError(t, err)
+	acct.DeletionRequestedAt = &at
+
+	h.cancelAccountDeletion(context.Background(), newPipeConn(t), &acct)
+	assert.Nil(t, acct.DeletionRequestedAt)
+	assert.Empty(t, deleter.requested)
+
+	// Cancelling again is harmless.
+	h.cancelAccountDeletion(context.Background(), newPipeConn(t), &acct)
+	assert.Nil(t, acct.DeletionRequestedAt)
+}
+
+func TestDaysUntilAccountPurge(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, accountDeleteWindowDays, daysUntilAccountPurge(now, now))
+	assert.Equal(t, 1, daysUntilAccountPurge(now.Add(-postgres.AccountDeleteWindow+time.Hour), now))
+	assert.Equal(t, 0, daysUntilAccountPurge(now.Add(-postgres.AccountDeleteWindow-time.Hour), now))
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// AccountSharedReader summarizes the resources an account's characters share.
+type AccountSharedReader interface {
+	LoadAccountShared(ctx context.Context, accountID int64) (postgres.AccountShared, error)
+}
+
+// SetAccountShared shows the account's shared stash and account-wide
+// achievements under the character list.
+//
+// Postcondition: Without a reader the summary is not shown.
+func (h *AuthHandler) SetAccountShared(r AccountSharedReader) {
+	h.accountShared = r
+}
+
+// writeAccountShared writes the account's shared resources, if it has any.
+// A lookup failure is logged and the summary skipped.
+//
+// Precondition: conn must be open; accountID must be > 0.
+func (h *AuthHandler) writeAccountShared(ctx context.Context, conn *telnet.Conn, accountID int64) {
+	if h.accountShared == nil {
+		return
+	}
+	shared, err := h.accountShared.LoadAccountShared(ctx, accountID)
+	if err != nil {
+		h.logger.Warn("loading account shared resources", zap.Int64("account_id", accountID), zap.Error(err))
+		return
+	}
+	if shared.StashBalance == 0 && shared.Achievements == 0 {
+		return
+	}
+	_ = conn.WriteLine(telnet.Colorf(telnet.Cyan,
+		"  Shared across your characters: %d credits in the shared stash, %d account achievement(s).",
+		shared.StashBalance, shared.Achievements))
+}
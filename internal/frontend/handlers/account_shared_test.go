@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+type mockAccountShared struct {
+	shared postgres.AccountShared
+	err    error
+}
+
+func (m *mockAccountShared) LoadAccountShared(_ context.Context, _ int64) (postgres.AccountShared, error) {
+	return m.shared, m.err
+}
+
+// sharedSummary returns what writeAccountShared writes for reader.
+func sharedSummary(t *testing.T, reader AccountSharedReader) string {
+	t.Helper()
+	client, server := net.Pipe()
+	t.Cleanup(func() { client.Close() })
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	if reader != nil {
+		h.SetAccountShared(reader)
+	}
+	go func() {
+		conn := telnet.NewConn(server, time.Second, time.Second)
+		h.writeAccountShared(context.Background(), conn, 1)
+		_ = server.Close()
+	}()
+	out, err := io.ReadAll(client)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestWriteAccountShared(t *testing.T) {
+	out := sharedSummary(t, &mockAccountShared{shared: postgres.AccountShared{StashBalance: 250, Achievements: 3}})
+	assert.Contains(t, out, "250 credits in the shared stash")
+	assert.Contains(t, out, "3 account achievement(s)")
+
+	assert.Empty(t, sharedSummary(t, &mockAccountShared{}), "nothing shared")
+	assert.Empty(t, sharedSummary(t, &mockAccountShared{err: errors.New("boom")}), "lookup failure")
+	assert.Empty(t, sharedSummary(t, nil), "no reader")
+}
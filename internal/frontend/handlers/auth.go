@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -15,7 +14,10 @@ import (
 	"github.com/cory-johannsen/mud/internal/config"
 	"github.com/cory-johannsen/mud/internal/frontend/telnet"
 	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/help"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/settings"
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 	"github.com/cory-johannsen/mud/internal/version"
 )
@@ -32,7 +34,9 @@ type CharacterStore interface {
 	Create(ctx context.Context, c *character.Character) (*character.Character, error)
 	GetByID(ctx context.Context, id int64) (*character.Character, error)
 	SaveGender(ctx context.Context, id int64, gender string) error
-	DeleteByAccountAndName(ctx context.Context, accountID int64, name string) error
+	SoftDeleteByAccountAndName(ctx context.Context, accountID int64, name string) error
+	ListDeletedByAccount(ctx context.Context, accountID int64) ([]*character.Character, error)
+	RestoreByAccountAndName(ctx context.Context, accountID int64, name string) error
 }
 
 //go:embed splash/ak47.txt
@@ -114,6 +118,11 @@ func buildWelcomeBanner() string {
 	return sb.String()
 }
 
+// AccountSettingsLoader loads the account-level preferences sent with JoinWorldRequest.
+type AccountSettingsLoader interface {
+	Get(ctx context.Context, accountID int64) (settings.Settings, error)
+}
+
 // CharacterSkillsSetter defines the skill persistence operations required by AuthHandler.
 type CharacterSkillsSetter interface {
 	HasSkills(ctx context.Context, characterID int64) (bool, error)
@@ -139,9 +148,6 @@ type CharacterClassFeaturesSetter interface {
 
 // AuthHandler implements telnet.SessionHandler and processes the
 // authentication loop for a connected client.
-// maxFailedLogins is the number of consecutive failed login attempts before an account is locked out.
-const maxFailedLogins = 5
-
 type AuthHandler struct {
 	accounts       AccountStore
 	characters     CharacterStore
@@ -161,13 +167,29 @@ type AuthHandler struct {
 	logger                 *zap.Logger
 	gameServerAddr string
 	telnetCfg      config.TelnetConfig
-	// loginFailures tracks consecutive failed password attempts per username.
-	loginFailuresMu sync.Mutex
-	loginFailures   map[string]int
+	// idleLimits are the telnet idle timeouts, which a config reload may change.
+	idleLimits IdleLimits
+	// loginThrottle locks out accounts and addresses after repeated failed
+	// logins; nil when throttling is disabled.
+	loginThrottle *loginthrottle.Throttle
 	// seedAuthorized is the set of usernames permitted to authenticate over
 	// the headless / debug surface (REQ-TD-3b — telnet-deprecation #325).
 	// These match the accounts created by cmd/seed-claude-accounts.
 	seedAuthorized map[string]struct{}
+	// accountSettings loads account preferences at login; may be nil.
+	accountSettings AccountSettingsLoader
+	// accountDeleter backs "delete account" and "cancel deletion"; nil hides them.
+	accountDeleter AccountDeleter
+	// accountShared summarizes the shared stash and account achievements; nil hides it.
+	accountShared AccountSharedReader
+	// characterSlots limits how many characters an account may own; the zero value is unlimited.
+	characterSlots config.CharactersConfig
+	// heritages are offered after the home region during creation; empty skips that step.
+	heritages []*ruleset.Heritage
+	// backgrounds is the questionnaire asked before confirming a new character; nil skips it.
+	backgrounds *ruleset.Backgrounds
+	// helpTopics are the guides served by "help <topic>"; nil serves only the command list.
+	helpTopics *help.Library
 }
 
 // SeedAuthorizedAccounts is the canonical list of usernames the headless
@@ -217,7 +239,7 @@ func NewAuthHandler(
 	for _, name := range SeedAuthorizedAccounts {
 		seedSet[name] = struct{}{}
 	}
-	return &AuthHandler{
+	h := &AuthHandler{
 		accounts:        accounts,
 		characters:      characters,
 		regions:         regions,
@@ -236,9 +258,19 @@ func NewAuthHandler(
 		logger:                 logger,
 		gameServerAddr:  gameServerAddr,
 		telnetCfg:       telnetCfg,
-		loginFailures:   make(map[string]int),
+		loginThrottle:   loginthrottle.New(telnetCfg.LoginThrottle, nil),
 		seedAuthorized:  seedSet,
 	}
+	h.idleLimits.Set(telnetCfg.IdleTimeout, telnetCfg.IdleGracePeriod)
+	return h
+}
+
+// SetIdleTimeouts changes the idle timeout and grace period, including for
+// sessions already running.
+//
+// Postcondition: Each session's next idle check uses the new limits.
+func (h *AuthHandler) SetIdleTimeouts(timeout, grace time.Duration) {
+	h.idleLimits.Set(timeout, grace)
 }
 
 // SetSeedAuthorized replaces the set of usernames permitted on the headless
@@ -252,6 +284,36 @@ func (h *AuthHandler) SetSeedAuthorized(usernames []string) {
 	h.seedAuthorized = set
 }
 
+// SetAccountSettingsLoader attaches the account settings store used to load
+// preferences at login.
+//
+// Postcondition: subsequent JoinWorldRequests carry the account's settings.
+func (h *AuthHandler) SetAccountSettingsLoader(loader AccountSettingsLoader) {
+	h.accountSettings = loader
+}
+
+// SetLoginFailureStore persists failed-login records in store so lockouts
+// survive restarts.
+//
+// Postcondition: failure history recorded before the call is discarded.
+func (h *AuthHandler) SetLoginFailureStore(store loginthrottle.Store) {
+	h.loginThrottle = loginthrottle.New(h.telnetCfg.LoginThrottle, store)
+}
+
+// loadAccountSettings returns the account's stored settings, or the zero value
+// when no loader is configured or the lookup fails.
+func (h *AuthHandler) loadAccountSettings(ctx context.Context, accountID int64) settings.Settings {
+	if h.accountSettings == nil {
+		return settings.Settings{}
+	}
+	st, err := h.accountSettings.Get(ctx, accountID)
+	if err != nil {
+		h.logger.Warn("loading account settings", zap.Int64("account_id", accountID), zap.Error(err))
+		return settings.Settings{}
+	}
+	return st
+}
+
 // isSeedAuthorized reports whether the given username is in the seed-bootstrap
 // list (REQ-TD-3b). Returns true when no set is configured (graceful fallback
 // for unit-test mocks that bypass NewAuthHandler).
@@ -540,6 +602,15 @@ func (h *AuthHandler) handleLogin(ctx context.Context, conn *telnet.Conn, args [
 		return postgres.Account{}, fmt.Errorf("reading password: %w", err)
 	}
 
+	addrKey := loginthrottle.IPKey(conn.RemoteAddr())
+	if wait, err := h.loginThrottle.Locked(ctx, username, addrKey, time.Now()); err != nil {
+		h.logger.Warn("checking login lockout", zap.String("username", username), zap.Error(err))
+	} else if wait > 0 {
+		_ = conn.WriteLine(telnet.Colorf(telnet.Red,
+			"Too many failed login attempts. Try again in %s.", formatLockout(wait)))
+		return postgres.Account{}, nil
+	}
+
 	start := time.Now()
 	acct, err := h.accounts.Authenticate(ctx, username, password)
 	elapsed := time.Since(start)
@@ -547,15 +618,13 @@ func (h *AuthHandler) handleLogin(ctx context.Context, conn *telnet.Conn, args [
 	if err != nil {
 		switch {
 		case errors.Is(err, postgres.ErrAccountNotFound):
+			h.recordLoginFailure(ctx, "", addrKey)
 			_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Account not found. Use 'register' to create one."))
 			return postgres.Account{}, nil
 		case errors.Is(err, postgres.ErrInvalidCredentials):
-			h.loginFailuresMu.Lock()
-			h.loginFailures[username]++
-			failures := h.loginFailures[username]
-			h.loginFailuresMu.Unlock()
-			if failures >= maxFailedLogins {
-				_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Too many failed login attempts. Account temporarily locked."))
+			if wait := h.recordLoginFailure(ctx, username, addrKey); wait > 0 {
+				_ = conn.WriteLine(telnet.Colorf(telnet.Red,
+					"Invalid password. Too many failed login attempts; account locked for %s.", formatLockout(wait)))
 			} else {
 				_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Invalid password."))
 			}
@@ -567,10 +636,10 @@ func (h *AuthHandler) handleLogin(ctx context.Context, conn *telnet.Conn, args [
 		}
 	}
 
-	// Correct password: reset failure counter regardless of lockout state.
-	h.loginFailuresMu.Lock()
-	delete(h.loginFailures, username)
-	h.loginFailuresMu.Unlock()
+	// Correct password: forget the account's failures.
+	if err := h.loginThrottle.Succeed(ctx, username); err != nil {
+		h.logger.Warn("clearing login failures", zap.String("username", username), zap.Error(err))
+	}
 
 	_ = conn.WriteLine(telnet.Colorf(telnet.BrightGreen,
 		"Logged in as %s [%s] (account #%d) [%s]",
@@ -579,6 +648,36 @@ func (h *AuthHandler) handleLogin(ctx context.Context, conn *telnet.Conn, args [
 	return acct, nil
 }
 
+// recordLoginFailure records a failed login for username (which may be empty)
+// from addrKey and returns the lockout it started, if any.
+func (h *AuthHandler) recordLoginFailure(ctx context.Context, username, addrKey string) time.Duration {
+	wait, err := h.loginThrottle.Fail(ctx, username, addrKey, time.Now())
+	if err != nil {
+		h.logger.Warn("recording login failure", zap.String("username", username), zap.Error(err))
+		return 0
+	}
+	if wait > 0 {
+		h.logger.Info("login locked out",
+			zap.String("username", username),
+			zap.String("addr", addrKey),
+			zap.Duration("lockout", wait),
+		)
+	}
+	return wait
+}
+
+// formatLockout renders a lockout length rounded up to whole seconds or minutes.
+func formatLockout(d time.Duration) string {
+	n, unit := max(1, int((d+time.Second-1)/time.Second)), "second"
+	if n >= 60 {
+		n, unit = (n+59)/60, "minute"
+	}
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
 // handleRegister creates a new account interactively.
 // Username is taken from args[0] if provided, otherwise prompted.
 // Password is prompted twice with echo suppressed; both entries must match.
@@ -23,6 +23,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/world"
 	"github.com/cory-johannsen/mud/internal/gameserver"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
@@ -68,7 +69,8 @@ func (m *mockAccountStore) Authenticate(_ context.Context, username, password st
 // mockCharacterStore implements CharacterStore for testing.
 // It pre-loads a single character so the character flow selects it immediately.
 type mockCharacterStore struct {
-	chars []*character.Character
+	chars   []*character.Character
+	deleted []*character.Character
 }
 
 func newMockCharacterStore(chars ...*character.Character) *mockCharacterStore {
@@ -98,8 +100,33 @@ func (m *mockCharacterStore) SaveGender(_ context.Context, _ int64, _ string) er
 	return nil
 }
 
-func (m *mockCharacterStore) DeleteByAccountAndName(_ context.Context, _ int64, _ string) error {
-	return nil
+func (m *mockCharacterStore) SoftDeleteByAccountAndName(_ context.Context, _ int64, name string) error {
+	for i, c := range m.chars {
+		if c.Name == name {
+			now := time.Now()
+			c.DeletedAt = &now
+			m.deleted = append(m.deleted, c)
+			m.chars = append(m.chars[:i], m.chars[i+1:]...)
+			return nil
+		}
+	}
+	return postgres.ErrCharacterNotFound
+}
+
+func (m *mockCharacterStore) ListDeletedByAccount(_ context.Context, _ int64) ([]*character.Character, error) {
+	return m.deleted, nil
+}
+
+func (m *mockCharacterStore) RestoreByAccountAndName(_ context.Context, _ int64, name string) error {
+	for i, c := range m.deleted {
+		if c.Name == name {
+			c.DeletedAt = nil
+			m.chars = append(m.chars, c)
+			m.deleted = append(m.deleted[:i], m.deleted[i+1:]...)
+			return nil
+		}
+	}
+	return postgres.ErrCharacterNotFound
 }
 
 // newAuthHandler builds an AuthHandler with empty regions/classes for tests that
@@ -620,6 +647,49 @@ func TestHandleSession_LoginWrongPassword(t *testing.T) {
 	c.readUntil("Invalid password", 2*time.Second)
 }
 
+func TestHandleSession_LoginLockout(t *testing.T) {
+	store := newMockAccountStore()
+	store.accounts["testuser"] = postgres.Account{ID: 1, Username: "testuser"}
+	store.passwords["testuser"] = "correctpass"
+	failures := loginthrottle.NewMemoryStore()
+	newHandler := func() *AuthHandler {
+		handler := newAuthHandler(t, store, "127.0.0.1:50051")
+		handler.telnetCfg.LoginThrottle = config.LoginThrottleConfig{
+			AccountFreeAttempts: 1,
+			IPFreeAttempts:      10,
+			BaseLockout:         time.Minute,
+			MaxLockout:          time.Hour,
+			ResetAfter:          time.Hour,
+		}
+		handler.SetLoginFailureStore(failures)
+		return handler
+	}
+	c := newTestClient(t, testServer(t, newHandler()))
+
+	c.waitForPrompt()
+	c.doLogin("testuser", "wrongpass")
+	c.readUntil("Invalid password.", 2*time.Second)
+	c.doLogin("testuser", "wrongpass")
+	c.readUntil("account locked for 1 minute", 2*time.Second)
+	c.doLogin("TestUser", "correctpass")
+	c.readUntil("Too many failed login attempts. Try again in", 2*time.Second)
+
+	// A restarted frontend sharing the failure store still enforces the lock.
+	c = newTestClient(t, testServer(t, newHandler()))
+	c.waitForPrompt()
+	c.doLogin("testuser", "correctpass")
+	c.readUntil("Too many failed login attempts. Try again in", 2*time.Second)
+}
+
+func TestFormatLockout(t *testing.T) {
+	assert.Equal(t, "1 second", formatLockout(200*time.Millisecond))
+	assert.Equal(t, "5 seconds", formatLockout(4100*time.Millisecond))
+	assert.Equal(t, "59 seconds", formatLockout(59*time.Second))
+	assert.Equal(t, "1 minute", formatLockout(time.Minute))
+	assert.Equal(t, "2 minutes", formatLockout(61*time.Second))
+	assert.Equal(t, "15 minutes", formatLockout(15*time.Minute))
+}
+
 func TestHandleSession_LoginMissingArgs(t *testing.T) {
 	store := newMockAccountStore()
 	store.accounts["testuser"] = postgres.Account{ID: 1, Username: "testuser"}
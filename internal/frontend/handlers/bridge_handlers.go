@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/cory-johannsen/mud/internal/frontend/prompt"
 	"github.com/cory-johannsen/mud/internal/frontend/telnet"
 	"github.com/cory-johannsen/mud/internal/game/combat"
 	"github.com/cory-johannsen/mud/internal/game/command"
@@ -20,11 +21,12 @@ type bridgeContext struct {
 	charName       string
 	role           string
 	stream         gamev1.GameService_SessionClient
-	helpFn         func()                    // called by bridgeHelp to render help output
+	helpFn         func(args []string)       // called by bridgeHelp to render help output
 	promptFn       func() string             // called to build the current colored prompt
+	promptFormatFn func() string             // returns the player's custom prompt format; nil or "" means the default
 	travelResolver    func(zoneName string) (zoneID string, errMsg string) // nil if not available; errMsg non-empty signals failure
-	roomViewFn        func() *gamev1.RoomView           // returns the last cached RoomView; nil if unavailable
 	characterSheetFn  func() *gamev1.CharacterSheetView // returns the last cached CharacterSheetView; nil if unavailable
+	registry          *command.Registry                 // resolves the command force runs; nil if unavailable
 }
 
 // bridgeResult is returned by every bridge handler.
@@ -61,6 +63,8 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerExits:              bridgeExits,
 	command.HandlerSay:                bridgeSay,
 	command.HandlerEmote:              bridgeEmote,
+	command.HandlerShout:              bridgeShout,
+	command.HandlerYell:               bridgeYell,
 	command.HandlerWho:                bridgeWho,
 	command.HandlerQuit:               bridgeQuit,
 	command.HandlerSwitch:             bridgeSwitch,
@@ -73,6 +77,8 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerStatus:             bridgeStatus,
 	command.HandlerEffects:            bridgeEffects,
 	command.HandlerTerrain:            bridgeTerrain,
+	command.HandlerLog:                bridgeLog,
+	command.HandlerHistory:            bridgeHistory,
 	command.HandlerEquip:              bridgeEquip,
 	command.HandlerReload:             bridgeReload,
 	command.HandlerFireBurst:          bridgeFireBurst,
@@ -106,6 +112,7 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerProficiencies:      bridgeProficiencies,
 	command.HandlerLevelUp:            bridgeLevelUp,
 	command.HandlerCombatDefault:      bridgeCombatDefault,
+	command.HandlerCombatVerbosity:    bridgeCombatVerbosity,
 	command.HandlerTrainSkill:         bridgeTrainSkill,
 	command.HandlerAction:             bridgeAction,
 	command.HandlerRaiseShield:        bridgeRaiseShield,
@@ -120,6 +127,7 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerDelay:              bridgeDelay,
 	command.HandlerDisarm:             bridgeDisarm,
 	command.HandlerDisarmTrap:         bridgeDisarmTrap,
+	command.HandlerDetect:             bridgeDetect,
 	command.HandlerDeployTrap:         bridgeDeployTrap,
 	command.HandlerReady:              bridgeReady,
 	command.HandlerClimb:              bridgeClimb,
@@ -151,6 +159,11 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerTalk:               bridgeTalk,
 	command.HandlerBribe:              bridgeBribe,
 	command.HandlerSurrender:          bridgeSurrender,
+	command.HandlerPayFine:            bridgePayFine,
+	command.HandlerSteal:              bridgeSteal,
+	command.HandlerPick:               bridgePick,
+	command.HandlerMarket:             bridgeMarket,
+	command.HandlerAuction:            bridgeAuction,
 	command.HandlerRelease:            bridgeRelease,
 	command.HandlerFaction:            bridgeFaction,
 	command.HandlerFactionInfo:        bridgeFactionInfo,
@@ -164,6 +177,19 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerDeposit:            bridgeDeposit,
 	command.HandlerWithdraw:           bridgeWithdraw,
 	command.HandlerStashBalance:       bridgeStashBalance,
+	command.HandlerSharedStash:        bridgeSharedStash,
+	command.HandlerParley:             bridgeParley,
+	command.HandlerSocial:             bridgeSocial,
+	command.HandlerRestrain:           bridgeRestrain,
+	command.HandlerNonlethal:          bridgeNonlethal,
+	command.HandlerPractice:           bridgePractice,
+	command.HandlerRecap:              bridgeRecap,
+	command.HandlerFormation:          bridgeFormation,
+	command.HandlerOrder:              bridgeOrder,
+	command.HandlerChooseFeat:         bridgeChooseFeat,
+	command.HandlerAbility:            bridgeAbility,
+	command.HandlerRespec:             bridgeRespec,
+	command.HandlerExportCharacter:    bridgeExportCharacter,
 	command.HandlerHire:               bridgeHire,
 	command.HandlerDismiss:            bridgeDismiss,
 	command.HandlerTrainJob:           bridgeTrainJob,
@@ -188,6 +214,46 @@ var bridgeHandlerMap = map[string]bridgeHandlerFunc{
 	command.HandlerExplore:            bridgeExplore,
 	command.HandlerDowntime:           bridgeDowntime,
 	command.HandlerHotbar:             bridgeHotbar,
+	command.HandlerLocale:             bridgeLocale,
+	command.HandlerChatMute:           bridgeChatMute,
+	command.HandlerAudit:              bridgeAudit,
+	command.HandlerBug:                bridgeReport,
+	command.HandlerTypo:               bridgeReport,
+	command.HandlerIdea:               bridgeReport,
+	command.HandlerReports:            bridgeReports,
+	command.HandlerInvis:              bridgeInvis,
+	command.HandlerSnoop:              bridgeSnoop,
+	command.HandlerPuppet:             bridgePuppet,
+	command.HandlerGM:                 bridgeGM,
+	command.HandlerSchedule:           bridgeSchedule,
+	command.HandlerEngrave:            bridgeEngrave,
+	command.HandlerDig:                bridgeDig,
+	command.HandlerAnswer:             bridgeAnswer,
+	command.HandlerReloadConfig:       bridgeReloadConfig,
+	command.HandlerFlag:               bridgeFlag,
+	command.HandlerSettings:           bridgeSettings,
+	command.HandlerPrompt:             bridgePrompt,
+	command.HandlerConsider:           bridgeConsider,
+	command.HandlerScan:               bridgeScan,
+	command.HandlerLight:              bridgeLight,
+	command.HandlerBoard:              bridgeBoard,
+	command.HandlerDisembark:          bridgeDisembark,
+	command.HandlerDrive:              bridgeDrive,
+	command.HandlerRefuel:             bridgeRefuel,
+	command.HandlerRename:             bridgeRename,
+	command.HandlerCharacterSlots:     bridgeCharacterSlots,
+	command.HandlerTransferCharacter:  bridgeTransferCharacter,
+	command.HandlerTutorial:           bridgeTutorial,
+	command.HandlerHome:               bridgeHome,
+	command.HandlerClan:               bridgeClan,
+	command.HandlerClanSay:            bridgeClanSay,
+	command.HandlerAchievements:       bridgeAchievements,
+	command.HandlerTitle:              bridgeTitle,
+	command.HandlerTop:                bridgeTop,
+	command.HandlerPlayed:             bridgePlayed,
+	command.HandlerTime:               bridgeTime,
+	command.HandlerAfk:                bridgeAfk,
+	command.HandlerSiteBan:            bridgeSiteBan,
 }
 
 // writeErrorPrompt writes a red error message and re-issues the prompt, returning done=true.
@@ -215,13 +281,12 @@ func bridgeMove(bctx *bridgeContext) (bridgeResult, error) {
 
 }
 
-// bridgeLook builds a LookRequest for the current room, or handles
-// "look <direction>" locally by describing the exit in that direction.
+// bridgeLook builds a LookRequest for the current room, or for "look <direction>"
+// a LookRequest that peeks at the adjacent room through that exit.
 //
-// Precondition: bctx must be non-nil with a valid conn and reqID.
-// Postcondition: If no direction arg, returns a server LookRequest (done=false).
-// If a direction arg is given and roomViewFn is available, returns a local
-// description of the exit (done=true, consoleMsg set).
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg containing a LookRequest; direction aliases
+// such as "n" are resolved to their full names.
 func bridgeLook(bctx *bridgeContext) (bridgeResult, error) {
 	if len(bctx.parsed.Args) == 0 {
 		return bridgeResult{msg: &gamev1.ClientMessage{
@@ -237,34 +302,10 @@ func bridgeLook(bctx *bridgeContext) (bridgeResult, error) {
 		dirArg = cmd.Name
 	}
 
-	if bctx.roomViewFn == nil {
-		return bridgeResult{
-			done:       true,
-			consoleMsg: "You see nothing special.",
-		}, nil
-	}
-	rv := bctx.roomViewFn()
-	if rv == nil {
-		return bridgeResult{
-			done:       true,
-			consoleMsg: "You see nothing special.",
-		}, nil
-	}
-
-	for _, exit := range rv.Exits {
-		if strings.EqualFold(exit.Direction, dirArg) {
-			desc := fmt.Sprintf("Looking %s: %s.", exit.Direction, exit.TargetTitle)
-			if exit.Locked {
-				desc += " (locked)"
-			}
-			return bridgeResult{done: true, consoleMsg: desc}, nil
-		}
-	}
-
-	return bridgeResult{
-		done:       true,
-		consoleMsg: fmt.Sprintf("You see nothing of interest to the %s.", dirArg),
-	}, nil
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{Direction: dirArg}},
+	}}, nil
 }
 
 // bridgeExits builds an ExitsRequest for the current room.
@@ -292,6 +333,36 @@ func bridgeSay(bctx *bridgeContext) (bridgeResult, error) {
 	}}, nil
 }
 
+// bridgeShout builds a ShoutRequest.
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if RawArgs is empty, writes usage error and returns done=true;
+//
+//	otherwise returns a non-nil msg containing a ShoutRequest.
+func bridgeShout(bctx *bridgeContext) (bridgeResult, error) {
+	if bctx.parsed.RawArgs == "" {
+		return writeErrorPrompt(bctx, "Shout what?")
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Shout{Shout: &gamev1.ShoutRequest{Message: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
+// bridgeYell builds a YellRequest.
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if RawArgs is empty, writes usage error and returns done=true;
+//
+//	otherwise returns a non-nil msg containing a YellRequest.
+func bridgeYell(bctx *bridgeContext) (bridgeResult, error) {
+	if bctx.parsed.RawArgs == "" {
+		return writeErrorPrompt(bctx, "Yell what?")
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Yell{Yell: &gamev1.YellRequest{Message: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
 // bridgeEmote builds an EmoteRequest.
 // Precondition: bctx must be non-nil with a valid conn and reqID.
 // Postcondition: if RawArgs is empty, writes usage error and returns done=true;
@@ -350,12 +421,12 @@ func bridgeSwitch(bctx *bridgeContext) (bridgeResult, error) {
 	}, nil
 }
 
-// bridgeHelp renders in-game help by invoking bctx.helpFn.
+// bridgeHelp renders in-game help by invoking bctx.helpFn with the command's arguments.
 // Precondition: bctx must be non-nil; helpFn may be nil (in which case no output is produced).
 // Postcondition: returns done=true so commandLoop continues without a server round-trip.
 func bridgeHelp(bctx *bridgeContext) (bridgeResult, error) {
 	if bctx.helpFn != nil {
-		bctx.helpFn()
+		bctx.helpFn(bctx.parsed.Args)
 	}
 	return bridgeResult{done: true}, nil
 }
@@ -375,6 +446,95 @@ func bridgeExamine(bctx *bridgeContext) (bridgeResult, error) {
 	}}, nil
 }
 
+// bridgeConsider builds a ConsiderRequest for the named NPC.
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if RawArgs is empty, writes usage error and returns done=true;
+//
+//	otherwise returns a non-nil msg containing a ConsiderRequest.
+func bridgeConsider(bctx *bridgeContext) (bridgeResult, error) {
+	if bctx.parsed.RawArgs == "" {
+		return writeErrorPrompt(bctx, "Usage: consider <npc>")
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Consider{Consider: &gamev1.ConsiderRequest{Target: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
+// bridgeScan builds a ScanRequest.
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg containing a ScanRequest.
+func bridgeScan(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Scan{Scan: &gamev1.ScanRequest{}},
+	}}, nil
+}
+
+// bridgeLight builds a LightRequest; an empty target asks which light is lit.
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg containing a LightRequest.
+func bridgeLight(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Light{Light: &gamev1.LightRequest{Target: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
+// bridgeBoard builds a BoardRequest for the named vehicle.
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if RawArgs is empty, writes usage error and returns done=true;
+//
+//	otherwise returns a non-nil msg containing a BoardRequest.
+func bridgeBoard(bctx *bridgeContext) (bridgeResult, error) {
+	if bctx.parsed.RawArgs == "" {
+		return writeErrorPrompt(bctx, "Usage: board <vehicle>")
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Board{Board: &gamev1.BoardRequest{Target: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
+// bridgeDisembark builds a DisembarkRequest.
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg containing a DisembarkRequest.
+func bridgeDisembark(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Disembark{Disembark: &gamev1.DisembarkRequest{}},
+	}}, nil
+}
+
+// bridgeDrive builds a DriveRequest, resolving direction aliases (e.g., "n" → "north").
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if no direction is given, writes usage error and returns done=true;
+//
+//	otherwise returns a non-nil msg containing a DriveRequest.
+func bridgeDrive(bctx *bridgeContext) (bridgeResult, error) {
+	if len(bctx.parsed.Args) == 0 {
+		return writeErrorPrompt(bctx, "Usage: drive <direction>")
+	}
+	dirArg := strings.ToLower(bctx.parsed.Args[0])
+	if cmd, ok := command.DefaultRegistry().Resolve(dirArg); ok && cmd.Handler == command.HandlerMove {
+		dirArg = cmd.Name
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Drive{Drive: &gamev1.DriveRequest{Direction: dirArg}},
+	}}, nil
+}
+
+// bridgeRefuel builds a RefuelRequest; an empty target means the vehicle being ridden.
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg containing a RefuelRequest.
+func bridgeRefuel(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Refuel{Refuel: &gamev1.RefuelRequest{Target: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
 // bridgeAttack builds an AttackRequest for the named target.
 // Precondition: bctx must be non-nil with a valid conn and reqID.
 // Postcondition: if RawArgs is empty, writes usage error and returns done=true;
@@ -953,90 +1113,654 @@ func bridgeInteract(bctx *bridgeContext) (bridgeResult, error) {
 	}
 	return bridgeResult{msg: &gamev1.ClientMessage{
 		RequestId: bctx.reqID,
-		Payload:   &gamev1.ClientMessage_InteractRequest{InteractRequest: &gamev1.InteractRequest{InstanceId: instanceID}},
+		Payload:   &gamev1.ClientMessage_InteractRequest{InteractRequest: &gamev1.InteractRequest{InstanceId: instanceID}},
+	}}, nil
+}
+
+// bridgeUse builds a UseRequest for tech/feat activation.
+// args format: "<abilityID> [target]"
+// If no ability name given, sends empty feat_id to trigger listing.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: returns a non-nil msg containing a UseRequest with parsed feat_id and optional target.
+func bridgeUse(bctx *bridgeContext) (bridgeResult, error) {
+	parts := strings.Fields(bctx.parsed.RawArgs)
+	var featID, target string
+	if len(parts) >= 1 {
+		featID = parts[0]
+	}
+	if len(parts) >= 2 {
+		target = parts[1]
+	}
+	// Note: TargetX and TargetY are not populated here. The telnet client has no
+	// AoE targeting UI, so feats with aoe_radius will silently fall back to the
+	// single-target path. AoE targeting is supported via the web client only.
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_UseRequest{
+			UseRequest: &gamev1.UseRequest{FeatId: featID, Target: target, TargetX: -1, TargetY: -1},
+		},
+	}}, nil
+}
+
+// bridgeSummonItem builds a SummonItemRequest, delegating arg validation to HandleSummonItem.
+//
+// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.Args.
+// Postcondition: if HandleSummonItem returns a Usage string, writes it and returns done=true;
+// otherwise returns a non-nil msg containing a SummonItemRequest.
+func bridgeSummonItem(bctx *bridgeContext) (bridgeResult, error) {
+	parsed := command.HandleSummonItem(strings.Join(bctx.parsed.Args, " "))
+	if strings.HasPrefix(parsed, "Usage:") {
+		return writeErrorPrompt(bctx, parsed)
+	}
+	parts := strings.Fields(parsed)
+	if len(parts) != 2 {
+		return writeErrorPrompt(bctx, "Usage: summon_item <item_id> [quantity]")
+	}
+	itemID := parts[0]
+	qty, _ := strconv.Atoi(parts[1]) // safe: HandleSummonItem already validated
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_SummonItem{SummonItem: &gamev1.SummonItemRequest{
+			ItemId:   itemID,
+			Quantity: int32(qty),
+		}},
+	}}, nil
+}
+
+// bridgeLevelUp builds a LevelUpRequest, delegating validation to HandleLevelUp.
+//
+// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.RawArgs.
+// Postcondition: if HandleLevelUp returns a usage or error string, writes it and returns done=true;
+// otherwise returns a non-nil msg containing a LevelUpRequest.
+func bridgeLevelUp(bctx *bridgeContext) (bridgeResult, error) {
+	result := command.HandleLevelUp(bctx.parsed.RawArgs)
+	if strings.HasPrefix(result, "Usage:") || strings.HasPrefix(result, "Unknown ability") {
+		return writeErrorPrompt(bctx, result)
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_LevelUp{LevelUp: &gamev1.LevelUpRequest{Ability: result}},
+	}}, nil
+}
+
+// bridgeCombatDefault validates and sends a CombatDefaultRequest to set the player's default combat action.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleCombatDefault returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a CombatDefaultRequest.
+func bridgeCombatDefault(bctx *bridgeContext) (bridgeResult, error) {
+	action, err := command.HandleCombatDefault(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_CombatDefault{CombatDefault: &gamev1.CombatDefaultRequest{Action: action}},
+	}}, nil
+}
+
+// bridgeCombatVerbosity validates and sends a CombatVerbosityRequest to show or set
+// the player's combat narration level.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleCombatVerbosity returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a CombatVerbosityRequest.
+func bridgeCombatVerbosity(bctx *bridgeContext) (bridgeResult, error) {
+	level, err := command.HandleCombatVerbosity(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_CombatVerbosity{CombatVerbosity: &gamev1.CombatVerbosityRequest{Level: level}},
+	}}, nil
+}
+
+// bridgeLocale validates and sends a LocaleRequest to show or set the account's
+// message locale.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleLocale returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a LocaleRequest.
+func bridgeLocale(bctx *bridgeContext) (bridgeResult, error) {
+	locale, err := command.HandleLocale(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Locale{Locale: &gamev1.LocaleRequest{Locale: locale}},
+	}}, nil
+}
+
+// bridgeChatMute validates and sends a ChatMuteRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleChatMute returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a ChatMuteRequest.
+func bridgeChatMute(bctx *bridgeContext) (bridgeResult, error) {
+	target, minutes, err := command.HandleChatMute(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_ChatMute{ChatMute: &gamev1.ChatMuteRequest{
+			Target:  target,
+			Minutes: int32(minutes),
+		}},
+	}}, nil
+}
+
+// bridgeAudit validates and sends an AuditRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleAudit returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing an AuditRequest.
+func bridgeAudit(bctx *bridgeContext) (bridgeResult, error) {
+	subject, limit, err := command.HandleAudit(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Audit{Audit: &gamev1.AuditRequest{Subject: subject, Limit: int32(limit)}},
+	}}, nil
+}
+
+// bridgeReport builds a ReportRequest for the bug, typo, and idea commands.
+// The report kind is the command's handler name.
+//
+// Precondition: bctx must be non-nil with a valid cmd and reqID.
+// Postcondition: if HandleReport returns an error, writes it and returns done=true;
+// otherwise returns a non-nil msg containing a ReportRequest.
+func bridgeReport(bctx *bridgeContext) (bridgeResult, error) {
+	text, err := command.HandleReport(bctx.cmd.Handler, bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Report{Report: &gamev1.ReportRequest{Kind: bctx.cmd.Handler, Text: text}},
+	}}, nil
+}
+
+// bridgeReports validates and sends a ReportsRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleReports returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a ReportsRequest.
+func bridgeReports(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandleReports(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Reports{Reports: &gamev1.ReportsRequest{
+			Action: req.Action, Kind: req.Kind, All: req.All, Id: req.ID,
+		}},
+	}}, nil
+}
+
+// bridgeInvis validates and sends an InvisRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing an InvisRequest, or writes usage and returns done=true.
+func bridgeInvis(bctx *bridgeContext) (bridgeResult, error) {
+	action, err := command.HandleInvis(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Invis{Invis: &gamev1.InvisRequest{Action: action}},
+	}}, nil
+}
+
+// bridgeSnoop validates and sends a SnoopRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing a SnoopRequest, or writes usage and returns done=true.
+func bridgeSnoop(bctx *bridgeContext) (bridgeResult, error) {
+	target, reason, err := command.HandleSnoop(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Snoop{Snoop: &gamev1.SnoopRequest{Target: target, Reason: reason}},
+	}}, nil
+}
+
+// bridgeForce dispatches through bridgeHandlerMap, so it is registered here
+// rather than in the map literal to avoid an initialization cycle.
+func init() {
+	bridgeHandlerMap[command.HandlerForce] = bridgeForce
+}
+
+// bridgeForce parses the command to run as another player with its own
+// bridge handler and sends it wrapped in a ForceRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing a ForceRequest; when the
+// command is malformed or can't be forced, writes the error and returns done=true.
+func bridgeForce(bctx *bridgeContext) (bridgeResult, error) {
+	target, text, err := command.HandleForce(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	if bctx.registry == nil {
+		return writeErrorPrompt(bctx, "force is not available here")
+	}
+	parsed := command.Parse(text)
+	var inner *gamev1.ClientMessage
+	if cmd, ok := bctx.registry.Resolve(parsed.Command); !ok {
+		inner = buildMoveMessage(bctx.reqID, parsed.Command)
+	} else {
+		handlerFn, known := bridgeHandlerMap[cmd.Handler]
+		if !known || !command.Forceable(cmd.Handler) {
+			return writeErrorPrompt(bctx, fmt.Sprintf("%s can't be forced", cmd.Name))
+		}
+		innerCtx := *bctx
+		innerCtx.cmd = cmd
+		innerCtx.parsed = parsed
+		result, err := handlerFn(&innerCtx)
+		if err != nil || result.done {
+			return result, err
+		}
+		if result.msg == nil || result.quit || result.switchCharacter || result.enterMapMode {
+			return writeErrorPrompt(bctx, fmt.Sprintf("%s can't be forced", cmd.Name))
+		}
+		inner = result.msg
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Force{Force: &gamev1.ForceRequest{Target: target, Command: inner, Text: text}},
+	}}, nil
+}
+
+// bridgePuppet validates and sends a PuppetRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing a PuppetRequest, or writes usage and returns done=true.
+func bridgePuppet(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandlePuppet(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Puppet{Puppet: &gamev1.PuppetRequest{
+			Action: req.Action, Target: req.Target, Text: req.Text,
+		}},
+	}}, nil
+}
+
+// bridgeGM validates and sends a GMRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing a GMRequest, or writes usage and returns done=true.
+func bridgeGM(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandleGM(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Gm{Gm: &gamev1.GMRequest{
+			Action: req.Action, Target: req.Target, Amount: int32(req.Amount), Text: req.Text,
+		}},
+	}}, nil
+}
+
+// bridgeSchedule validates and sends a ScheduleRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing a ScheduleRequest, or writes usage and returns done=true.
+func bridgeSchedule(bctx *bridgeContext) (bridgeResult, error) {
+	action, eventID, err := command.HandleSchedule(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Schedule{Schedule: &gamev1.ScheduleRequest{Action: action, EventId: eventID}},
+	}}, nil
+}
+
+// bridgeEngrave validates and sends an EngraveRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing an EngraveRequest, or writes usage and returns done=true.
+func bridgeEngrave(bctx *bridgeContext) (bridgeResult, error) {
+	item, name, err := command.HandleEngrave(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Engrave{Engrave: &gamev1.EngraveRequest{Item: item, Name: name}},
+	}}, nil
+}
+
+// bridgeDig sends a DigRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgeDig(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Dig{Dig: &gamev1.DigRequest{}},
+	}}, nil
+}
+
+// bridgeAnswer validates and sends an AnswerRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing an AnswerRequest, or writes usage and returns done=true.
+func bridgeAnswer(bctx *bridgeContext) (bridgeResult, error) {
+	answer, err := command.HandleAnswer(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Answer{Answer: &gamev1.AnswerRequest{Answer: answer}},
+	}}, nil
+}
+
+// bridgeReloadConfig sends a ReloadConfigRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgeReloadConfig(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_ReloadConfig{ReloadConfig: &gamev1.ReloadConfigRequest{}},
+	}}, nil
+}
+
+// bridgeFlag validates and sends a FlagRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: Returns a non-nil msg containing a FlagRequest, or writes usage and returns done=true.
+func bridgeFlag(bctx *bridgeContext) (bridgeResult, error) {
+	action, flag, username, err := command.HandleFlag(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Flag{Flag: &gamev1.FlagRequest{Action: action, Flag: flag, Username: username}},
+	}}, nil
+}
+
+// bridgeSettings validates and sends a SettingsRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleSettings returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a SettingsRequest.
+func bridgeSettings(bctx *bridgeContext) (bridgeResult, error) {
+	key, value, err := command.HandleSettings(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Settings{Settings: &gamev1.SettingsRequest{Key: key, Value: value}},
+	}}, nil
+}
+
+// bridgePrompt describes the player's prompt and its tokens, or validates a
+// new prompt format and sends it as a "prompt" SettingsRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: A bare "prompt" writes the description locally and returns
+// done=true; an invalid format writes an error and returns done=true;
+// otherwise returns a non-nil msg containing a SettingsRequest.
+func bridgePrompt(bctx *bridgeContext) (bridgeResult, error) {
+	format, show, err := command.HandlePrompt(bctx.parsed.RawArgs)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	if show {
+		current := ""
+		if bctx.promptFormatFn != nil {
+			current = bctx.promptFormatFn()
+		}
+		return bridgeResult{done: true, consoleMsg: describePrompt(current)}, nil
+	}
+	if format != "" {
+		if err := prompt.Validate(format); err != nil {
+			return writeErrorPrompt(bctx, err.Error())
+		}
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Settings{Settings: &gamev1.SettingsRequest{Key: "prompt", Value: format}},
+	}}, nil
+}
+
+// describePrompt renders the prompt command listing for the current format.
+func describePrompt(format string) string {
+	var b strings.Builder
+	if format == "" {
+		b.WriteString("Your prompt: default")
+	} else {
+		fmt.Fprintf(&b, "Your prompt: %s", format)
+	}
+	b.WriteString("\r\n")
+	b.WriteString(telnet.Colorize(telnet.BrightWhite, "Prompt tokens:"))
+	for _, line := range prompt.Help() {
+		b.WriteString("\r\n  ")
+		b.WriteString(line)
+	}
+	b.WriteString("\r\nUsage: prompt set <format> | prompt reset. Example: prompt set %hp/%maxhp %ap %time>")
+	return b.String()
+}
+
+// bridgeRename validates and sends a RenameRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleRename returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a RenameRequest.
+func bridgeRename(bctx *bridgeContext) (bridgeResult, error) {
+	target, newName, err := command.HandleRename(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Rename{Rename: &gamev1.RenameRequest{Target: target, NewName: newName}},
+	}}, nil
+}
+
+// bridgeCharacterSlots validates and sends a CharacterSlotsRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleCharacterSlots returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a CharacterSlotsRequest.
+func bridgeCharacterSlots(bctx *bridgeContext) (bridgeResult, error) {
+	username, slots, err := command.HandleCharacterSlots(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_CharacterSlots{CharacterSlots: &gamev1.CharacterSlotsRequest{Username: username, Slots: int32(slots)}},
+	}}, nil
+}
+
+// bridgeTransferCharacter validates and sends a TransferCharacterRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleTransferCharacter returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a TransferCharacterRequest.
+func bridgeTransferCharacter(bctx *bridgeContext) (bridgeResult, error) {
+	name, username, err := command.HandleTransferCharacter(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_TransferCharacter{TransferCharacter: &gamev1.TransferCharacterRequest{Target: name, Username: username}},
+	}}, nil
+}
+
+// bridgeTutorial validates and sends a TutorialRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleTutorial returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a TutorialRequest.
+func bridgeTutorial(bctx *bridgeContext) (bridgeResult, error) {
+	skip, err := command.HandleTutorial(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Tutorial{Tutorial: &gamev1.TutorialRequest{Skip: skip}},
+	}}, nil
+}
+
+// bridgeHome validates and sends a HomeRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.Args.
+// Postcondition: if HandleHome returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a HomeRequest.
+func bridgeHome(bctx *bridgeContext) (bridgeResult, error) {
+	action, target, qty, err := command.HandleHome(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Home{Home: &gamev1.HomeRequest{
+			Action: action, Target: target, Quantity: int32(qty),
+		}},
+	}}, nil
+}
+
+// bridgeClan validates and sends a ClanRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.Args.
+// Postcondition: if HandleClan returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a ClanRequest.
+func bridgeClan(bctx *bridgeContext) (bridgeResult, error) {
+	action, target, text, amount, err := command.HandleClan(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Clan{Clan: &gamev1.ClanRequest{
+			Action: action, Target: target, Text: text, Amount: int32(amount),
+		}},
+	}}, nil
+}
+
+// bridgeClanSay sends a ClanRequest that speaks on the clan channel.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if RawArgs is empty, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a ClanRequest with action "say".
+func bridgeClanSay(bctx *bridgeContext) (bridgeResult, error) {
+	if bctx.parsed.RawArgs == "" {
+		return writeErrorPrompt(bctx, "Say what?")
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Clan{Clan: &gamev1.ClanRequest{Action: "say", Text: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
+// bridgeAchievements sends an AchievementsRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgeAchievements(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Achievements{Achievements: &gamev1.AchievementsRequest{}},
+	}}, nil
+}
+
+// bridgeTitle sends a TitleRequest built from the title command's arguments.
+//
+// Precondition: bctx must be non-nil with a valid reqID and parsed.Args.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgeTitle(bctx *bridgeContext) (bridgeResult, error) {
+	title, clear := command.HandleTitle(bctx.parsed.Args)
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Title{Title: &gamev1.TitleRequest{Title: title, Clear: clear}},
+	}}, nil
+}
+
+// bridgeTop validates and sends a TopRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.Args.
+// Postcondition: if HandleTop returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a TopRequest.
+func bridgeTop(bctx *bridgeContext) (bridgeResult, error) {
+	st, err := command.HandleTop(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Top{Top: &gamev1.TopRequest{Stat: string(st)}},
 	}}, nil
 }
 
-// bridgeUse builds a UseRequest for tech/feat activation.
-// args format: "<abilityID> [target]"
-// If no ability name given, sends empty feat_id to trigger listing.
+// bridgePlayed sends a PlayedRequest.
 //
 // Precondition: bctx must be non-nil with a valid reqID.
-// Postcondition: returns a non-nil msg containing a UseRequest with parsed feat_id and optional target.
-func bridgeUse(bctx *bridgeContext) (bridgeResult, error) {
-	parts := strings.Fields(bctx.parsed.RawArgs)
-	var featID, target string
-	if len(parts) >= 1 {
-		featID = parts[0]
-	}
-	if len(parts) >= 2 {
-		target = parts[1]
-	}
-	// Note: TargetX and TargetY are not populated here. The telnet client has no
-	// AoE targeting UI, so feats with aoe_radius will silently fall back to the
-	// single-target path. AoE targeting is supported via the web client only.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgePlayed(bctx *bridgeContext) (bridgeResult, error) {
 	return bridgeResult{msg: &gamev1.ClientMessage{
 		RequestId: bctx.reqID,
-		Payload: &gamev1.ClientMessage_UseRequest{
-			UseRequest: &gamev1.UseRequest{FeatId: featID, Target: target, TargetX: -1, TargetY: -1},
-		},
+		Payload:   &gamev1.ClientMessage_Played{Played: &gamev1.PlayedRequest{}},
 	}}, nil
 }
 
-// bridgeSummonItem builds a SummonItemRequest, delegating arg validation to HandleSummonItem.
+// bridgeTime sends a TimeRequest.
 //
-// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.Args.
-// Postcondition: if HandleSummonItem returns a Usage string, writes it and returns done=true;
-// otherwise returns a non-nil msg containing a SummonItemRequest.
-func bridgeSummonItem(bctx *bridgeContext) (bridgeResult, error) {
-	parsed := command.HandleSummonItem(strings.Join(bctx.parsed.Args, " "))
-	if strings.HasPrefix(parsed, "Usage:") {
-		return writeErrorPrompt(bctx, parsed)
-	}
-	parts := strings.Fields(parsed)
-	if len(parts) != 2 {
-		return writeErrorPrompt(bctx, "Usage: summon_item <item_id> [quantity]")
-	}
-	itemID := parts[0]
-	qty, _ := strconv.Atoi(parts[1]) // safe: HandleSummonItem already validated
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgeTime(bctx *bridgeContext) (bridgeResult, error) {
 	return bridgeResult{msg: &gamev1.ClientMessage{
 		RequestId: bctx.reqID,
-		Payload: &gamev1.ClientMessage_SummonItem{SummonItem: &gamev1.SummonItemRequest{
-			ItemId:   itemID,
-			Quantity: int32(qty),
-		}},
+		Payload:   &gamev1.ClientMessage_Time{Time: &gamev1.TimeRequest{}},
 	}}, nil
 }
 
-// bridgeLevelUp builds a LevelUpRequest, delegating validation to HandleLevelUp.
+// bridgeAfk sends an AfkRequest carrying the player's away message, if any.
 //
-// Precondition: bctx must be non-nil with a valid conn, reqID, and parsed.RawArgs.
-// Postcondition: if HandleLevelUp returns a usage or error string, writes it and returns done=true;
-// otherwise returns a non-nil msg containing a LevelUpRequest.
-func bridgeLevelUp(bctx *bridgeContext) (bridgeResult, error) {
-	result := command.HandleLevelUp(bctx.parsed.RawArgs)
-	if strings.HasPrefix(result, "Usage:") || strings.HasPrefix(result, "Unknown ability") {
-		return writeErrorPrompt(bctx, result)
-	}
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a non-nil msg; done is false.
+func bridgeAfk(bctx *bridgeContext) (bridgeResult, error) {
 	return bridgeResult{msg: &gamev1.ClientMessage{
 		RequestId: bctx.reqID,
-		Payload:   &gamev1.ClientMessage_LevelUp{LevelUp: &gamev1.LevelUpRequest{Ability: result}},
+		Payload:   &gamev1.ClientMessage_Afk{Afk: &gamev1.AfkRequest{Message: bctx.parsed.RawArgs}},
 	}}, nil
 }
 
-// bridgeCombatDefault validates and sends a CombatDefaultRequest to set the player's default combat action.
+// bridgeSiteBan validates and sends a SiteBanRequest.
 //
 // Precondition: bctx must be non-nil with a valid conn and reqID.
-// Postcondition: if HandleCombatDefault returns an error, writes usage error and returns done=true;
-// otherwise returns a non-nil msg containing a CombatDefaultRequest.
-func bridgeCombatDefault(bctx *bridgeContext) (bridgeResult, error) {
-	action, err := command.HandleCombatDefault(bctx.parsed.Args)
+// Postcondition: if HandleSiteBan returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a SiteBanRequest.
+func bridgeSiteBan(bctx *bridgeContext) (bridgeResult, error) {
+	action, cidr, reason, err := command.HandleSiteBan(bctx.parsed.Args)
 	if err != nil {
 		return writeErrorPrompt(bctx, err.Error())
 	}
 	return bridgeResult{msg: &gamev1.ClientMessage{
 		RequestId: bctx.reqID,
-		Payload:   &gamev1.ClientMessage_CombatDefault{CombatDefault: &gamev1.CombatDefaultRequest{Action: action}},
+		Payload:   &gamev1.ClientMessage_SiteBan{SiteBan: &gamev1.SiteBanRequest{Action: action, Cidr: cidr, Reason: reason}},
 	}}, nil
 }
 
@@ -1140,13 +1864,15 @@ func bridgeUncover(bctx *bridgeContext) (bridgeResult, error) {
 	}}, nil
 }
 
-// bridgeFirstAid builds a FirstAidRequest.
+// bridgeFirstAid builds a FirstAidRequest, naming the ally to stabilize when
+// one is given.
 // Precondition: bctx must be non-nil with a valid reqID.
 // Postcondition: returns a non-nil msg containing a FirstAidRequest; done is false.
 func bridgeFirstAid(bctx *bridgeContext) (bridgeResult, error) {
+	req, _ := command.HandleFirstAid(bctx.parsed.Args)
 	return bridgeResult{msg: &gamev1.ClientMessage{
 		RequestId: bctx.reqID,
-		Payload:   &gamev1.ClientMessage_FirstAid{FirstAid: &gamev1.FirstAidRequest{}},
+		Payload:   &gamev1.ClientMessage_FirstAid{FirstAid: &gamev1.FirstAidRequest{Target: req.Target}},
 	}}, nil
 }
 
@@ -1272,6 +1998,67 @@ func bridgeDisarmTrap(bctx *bridgeContext) (bridgeResult, error) {
 	}}, nil
 }
 
+// bridgeLog builds a LogRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a Log payload, or a usage error
+// for anything but on, off, or status.
+func bridgeLog(bctx *bridgeContext) (bridgeResult, error) {
+	action, err := command.HandleLog(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Log{Log: &gamev1.LogRequest{Action: action}},
+	}}, nil
+}
+
+// bridgeHistory lists the commands entered on this connection that contain
+// the search term, numbered oldest first. History lookups themselves are left
+// out. Nothing is sent to the server.
+//
+// Precondition: bctx must be non-nil with a valid conn.
+// Postcondition: Returns done=true with the listing in consoleMsg.
+func bridgeHistory(bctx *bridgeContext) (bridgeResult, error) {
+	term := strings.TrimSpace(bctx.parsed.RawArgs)
+	skip := map[string]bool{bctx.cmd.Name: true}
+	for _, alias := range bctx.cmd.Aliases {
+		skip[alias] = true
+	}
+	var b strings.Builder
+	n := 0
+	for _, line := range bctx.conn.SearchHistory(term) {
+		if fields := strings.Fields(line); len(fields) > 0 && skip[strings.ToLower(fields[0])] {
+			continue
+		}
+		n++
+		fmt.Fprintf(&b, "\r\n%4d  %s", n, line)
+	}
+	if n == 0 {
+		if term == "" {
+			return bridgeResult{done: true, consoleMsg: "You haven't entered any commands yet."}, nil
+		}
+		return bridgeResult{done: true, consoleMsg: fmt.Sprintf("No commands in your history contain %q.", term)}, nil
+	}
+	return bridgeResult{done: true, consoleMsg: "Command history:" + b.String()}, nil
+}
+
+// bridgeDetect builds a DetectTrapsRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a DetectTraps payload, or a usage
+// error when the argument is not "traps".
+func bridgeDetect(bctx *bridgeContext) (bridgeResult, error) {
+	if err := command.HandleDetect(bctx.parsed.Args); err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_DetectTraps{DetectTraps: &gamev1.DetectTrapsRequest{}},
+	}}, nil
+}
+
 // bridgeDeployTrap builds a DeployTrapRequest with the item name from the command argument.
 //
 // Precondition: bctx.parsed.RawArgs must be the item name.
@@ -1938,6 +2725,69 @@ func bridgeStashBalance(bctx *bridgeContext) (bridgeResult, error) {
 	}}, nil
 }
 
+// bridgeSharedStash validates and sends a SharedStashRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleSharedStash returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a SharedStashRequest.
+func bridgeSharedStash(bctx *bridgeContext) (bridgeResult, error) {
+	action, amount, err := command.HandleSharedStash(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_SharedStash{SharedStash: &gamev1.SharedStashRequest{Action: action, Amount: int32(amount)}},
+	}}, nil
+}
+
+// bridgeParley validates and sends a ParleyRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleParley returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a ParleyRequest.
+func bridgeParley(bctx *bridgeContext) (bridgeResult, error) {
+	target, option, err := command.HandleParley(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Parley{Parley: &gamev1.ParleyRequest{Target: target, Option: option}},
+	}}, nil
+}
+
+// bridgeSocial validates and sends a SocialRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleSocial returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a SocialRequest.
+func bridgeSocial(bctx *bridgeContext) (bridgeResult, error) {
+	action, target, err := command.HandleSocial(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Social{Social: &gamev1.SocialRequest{Action: action, Target: target}},
+	}}, nil
+}
+
+// bridgeRestrain builds a RestrainRequest with the target name.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: writes a usage error and returns done=true when RawArgs is empty;
+// otherwise returns a non-nil msg containing a RestrainRequest.
+func bridgeRestrain(bctx *bridgeContext) (bridgeResult, error) {
+	if bctx.parsed.RawArgs == "" {
+		return writeErrorPrompt(bctx, "Usage: restrain <npc>")
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Restrain{Restrain: &gamev1.RestrainRequest{Target: bctx.parsed.RawArgs}},
+	}}, nil
+}
+
 // bridgeHire builds a HireRequest for the named hireling NPC.
 // Precondition: bctx must be non-nil with a valid reqID.
 // Postcondition: returns a non-nil msg; done is false.
@@ -2030,6 +2880,79 @@ func bridgeSurrender(bctx *bridgeContext) (bridgeResult, error) {
 	}}, nil
 }
 
+// bridgePayFine builds a PayFineRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: returns a non-nil msg containing a PayFineRequest; done is false.
+func bridgePayFine(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_PayFine{PayFine: &gamev1.PayFineRequest{}},
+	}}, nil
+}
+
+// bridgeSteal builds a StealRequest from "steal <item> from <npc>".
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: if HandleSteal returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a StealRequest.
+func bridgeSteal(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandleSteal(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Steal{Steal: &gamev1.StealRequest{Item: req.Item, Target: req.Target}},
+	}}, nil
+}
+
+// bridgePick builds a PickRequest from "pick <target>" or a pick technique.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: if HandlePick returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing a PickRequest.
+func bridgePick(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandlePick(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Pick{Pick: &gamev1.PickRequest{Action: req.Action, Target: req.Target}},
+	}}, nil
+}
+
+// bridgeMarket builds a MarketRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: returns a non-nil msg containing a MarketRequest; done is false.
+func bridgeMarket(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Market{Market: &gamev1.MarketRequest{}},
+	}}, nil
+}
+
+// bridgeAuction builds an AuctionRequest from the auction subcommand.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: if HandleAuction returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing an AuctionRequest.
+func bridgeAuction(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandleAuction(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Auction{Auction: &gamev1.AuctionRequest{
+			Action: req.Action, Item: req.Item, Quantity: int32(req.Quantity),
+			Price: int32(req.Price), Hours: int32(req.Hours), ListingId: req.ListingID,
+		}},
+	}}, nil
+}
+
 // bridgeRelease builds a ReleaseRequest for the named detained player.
 //
 // Precondition: bctx must be non-nil with a valid reqID.
@@ -2427,3 +3350,143 @@ func bridgeDeleteChar(bctx *bridgeContext) (bridgeResult, error) {
 		},
 	}, nil
 }
+
+// bridgeNonlethal builds a NonlethalRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a Nonlethal payload, or a usage
+// error for anything but on, off, or status.
+func bridgeNonlethal(bctx *bridgeContext) (bridgeResult, error) {
+	mode, err := command.HandleNonlethal(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Nonlethal{Nonlethal: &gamev1.NonlethalRequest{Mode: mode}},
+	}}, nil
+}
+
+// bridgePractice builds a PracticeRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a Practice payload, or a usage
+// error for anything but on, off, or status.
+func bridgePractice(bctx *bridgeContext) (bridgeResult, error) {
+	mode, err := command.HandlePractice(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Practice{Practice: &gamev1.PracticeRequest{Mode: mode}},
+	}}, nil
+}
+
+// bridgeRecap builds a RecapRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a Recap payload.
+func bridgeRecap(bctx *bridgeContext) (bridgeResult, error) {
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Recap{Recap: &gamev1.RecapRequest{}},
+	}}, nil
+}
+
+// bridgeFormation builds a FormationRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a Formation payload, or a usage
+// error for a row other than front, back, or status.
+func bridgeFormation(bctx *bridgeContext) (bridgeResult, error) {
+	member, row, err := command.HandleFormation(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Formation{Formation: &gamev1.FormationRequest{Member: member, Row: row}},
+	}}, nil
+}
+
+// bridgeOrder builds an OrderRequest.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with an Order payload, or a usage
+// error when no companion or order verb is given.
+func bridgeOrder(bctx *bridgeContext) (bridgeResult, error) {
+	companion, action, arg, err := command.HandleOrder(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_Order{Order: &gamev1.OrderRequest{Companion: companion, Action: action, Arg: arg}},
+	}}, nil
+}
+
+// bridgeChooseFeat builds a ChooseFeatRequest. With no arguments the request
+// asks for the player's pending feat choices.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a ChooseFeat payload, or a usage
+// error when a grant level is given without a feat.
+func bridgeChooseFeat(bctx *bridgeContext) (bridgeResult, error) {
+	level, feat, err := command.HandleChooseFeat(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_ChooseFeat{ChooseFeat: &gamev1.ChooseFeatRequest{GrantLevel: int32(level), FeatId: feat}},
+	}}, nil
+}
+
+// bridgeAbility builds a JobAbilityRequest. With no arguments the request
+// asks for the player's job abilities.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a JobAbility payload.
+func bridgeAbility(bctx *bridgeContext) (bridgeResult, error) {
+	ability, target := command.HandleAbility(bctx.parsed.Args)
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_JobAbility{JobAbility: &gamev1.JobAbilityRequest{Ability: ability, Target: target}},
+	}}, nil
+}
+
+// bridgeRespec builds a RespecRequest. With only a trainer named the request
+// asks for the trainer's respec menu.
+//
+// Precondition: bctx must be non-nil with a valid reqID.
+// Postcondition: Returns a ClientMessage with a Respec payload, or writes
+// usage when the arguments don't parse.
+func bridgeRespec(bctx *bridgeContext) (bridgeResult, error) {
+	req, err := command.HandleRespec(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload: &gamev1.ClientMessage_Respec{Respec: &gamev1.RespecRequest{
+			NpcName: req.NPC, Abilities: req.Abilities, OldFeat: req.OldFeat, NewFeat: req.NewFeat,
+		}},
+	}}, nil
+}
+
+// bridgeExportCharacter validates and sends an ExportCharacterRequest.
+//
+// Precondition: bctx must be non-nil with a valid conn and reqID.
+// Postcondition: if HandleExportCharacter returns an error, writes usage error and returns done=true;
+// otherwise returns a non-nil msg containing an ExportCharacterRequest.
+func bridgeExportCharacter(bctx *bridgeContext) (bridgeResult, error) {
+	name, err := command.HandleExportCharacter(bctx.parsed.Args)
+	if err != nil {
+		return writeErrorPrompt(bctx, err.Error())
+	}
+	return bridgeResult{msg: &gamev1.ClientMessage{
+		RequestId: bctx.reqID,
+		Payload:   &gamev1.ClientMessage_ExportCharacter{ExportCharacter: &gamev1.ExportCharacterRequest{Target: name}},
+	}}, nil
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cory-johannsen/mud/internal/game/command"
-	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
 
 // makeBridgeContext builds a minimal bridgeContext for unit-testing bridge handlers.
@@ -91,81 +90,26 @@ func TestBridgeLook_NoArgs(t *testing.T) {
 	assert.NotNil(t, result.msg.GetLook())
 }
 
-// TestBridgeLook_Direction verifies that "look north" returns a local message
-// describing the exit, without sending a server request.
-//
-// Precondition: roomViewFn returns a RoomView with a north exit to "Town Square".
-// Postcondition: result.done is true (handled locally), msg is nil,
-// and the console output contains the target room name.
+// TestBridgeLook_Direction verifies that "look north" asks the server to peek
+// through the north exit.
 func TestBridgeLook_Direction(t *testing.T) {
 	bctx := makeBridgeContext("req1", "north")
 	bctx.parsed.Args = []string{"north"}
-	bctx.roomViewFn = func() *gamev1.RoomView {
-		return &gamev1.RoomView{
-			Exits: []*gamev1.ExitInfo{
-				{Direction: "north", TargetTitle: "Town Square"},
-				{Direction: "east", TargetTitle: "Market", Locked: true},
-			},
-		}
-	}
-	result, err := bridgeLook(bctx)
-	require.NoError(t, err)
-	assert.True(t, result.done, "look <direction> should be handled locally")
-	assert.Nil(t, result.msg, "no server message for look <direction>")
-	assert.Contains(t, result.consoleMsg, "Town Square")
-}
-
-// TestBridgeLook_Direction_Locked verifies that "look east" at a locked exit
-// indicates the exit is locked.
-func TestBridgeLook_Direction_Locked(t *testing.T) {
-	bctx := makeBridgeContext("req1", "east")
-	bctx.parsed.Args = []string{"east"}
-	bctx.roomViewFn = func() *gamev1.RoomView {
-		return &gamev1.RoomView{
-			Exits: []*gamev1.ExitInfo{
-				{Direction: "east", TargetTitle: "Market", Locked: true},
-			},
-		}
-	}
-	result, err := bridgeLook(bctx)
-	require.NoError(t, err)
-	assert.True(t, result.done)
-	assert.Contains(t, result.consoleMsg, "locked")
-}
-
-// TestBridgeLook_Direction_NoExit verifies that "look south" with no south exit
-// returns a "nothing" message.
-func TestBridgeLook_Direction_NoExit(t *testing.T) {
-	bctx := makeBridgeContext("req1", "south")
-	bctx.parsed.Args = []string{"south"}
-	bctx.roomViewFn = func() *gamev1.RoomView {
-		return &gamev1.RoomView{
-			Exits: []*gamev1.ExitInfo{
-				{Direction: "north", TargetTitle: "Town Square"},
-			},
-		}
-	}
 	result, err := bridgeLook(bctx)
 	require.NoError(t, err)
-	assert.True(t, result.done)
-	assert.Contains(t, result.consoleMsg, "nothing")
+	assert.False(t, result.done)
+	require.NotNil(t, result.msg)
+	assert.Equal(t, "north", result.msg.GetLook().GetDirection())
 }
 
 // TestBridgeLook_Direction_Alias verifies that "look n" resolves the alias "n" to "north".
 func TestBridgeLook_Direction_Alias(t *testing.T) {
 	bctx := makeBridgeContext("req1", "n")
 	bctx.parsed.Args = []string{"n"}
-	bctx.roomViewFn = func() *gamev1.RoomView {
-		return &gamev1.RoomView{
-			Exits: []*gamev1.ExitInfo{
-				{Direction: "north", TargetTitle: "Town Square"},
-			},
-		}
-	}
 	result, err := bridgeLook(bctx)
 	require.NoError(t, err)
-	assert.True(t, result.done)
-	assert.Contains(t, result.consoleMsg, "Town Square")
+	require.NotNil(t, result.msg)
+	assert.Equal(t, "north", result.msg.GetLook().GetDirection())
 }
 
 // TestBridgeHotbar_SetBuildsCorrectRequest verifies that "hotbar 3 attack goblin"
@@ -246,3 +190,22 @@ func TestBridgeHotbar_InvalidSlotReturnsDone(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, result.done)
 }
+
+func TestBridgeForce_WrapsParsedCommand(t *testing.T) {
+	bctx := makeBridgeContext("req1", "Vex say hello there")
+	bctx.registry = command.DefaultRegistry()
+	result, err := bridgeForce(bctx)
+	require.NoError(t, err)
+	force := result.msg.GetForce()
+	require.NotNil(t, force)
+	assert.Equal(t, "Vex", force.GetTarget())
+	assert.Equal(t, "say hello there", force.GetText())
+	assert.Equal(t, "hello there", force.GetCommand().GetSay().GetMessage())
+
+	bctx = makeBridgeContext("req2", "Vex quit")
+	bctx.registry = command.DefaultRegistry()
+	result, err = bridgeForce(bctx)
+	require.NoError(t, err)
+	assert.True(t, result.done)
+	assert.Nil(t, result.msg)
+}
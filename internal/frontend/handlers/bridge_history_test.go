@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/command"
+)
+
+func historyContext(rawArgs string, past ...string) *bridgeContext {
+	conn := &telnet.Conn{}
+	for _, line := range past {
+		conn.AppendHistory(line)
+	}
+	bctx := makeBridgeContext("req1", rawArgs)
+	bctx.conn = conn
+	bctx.cmd = &command.Command{Name: "history", Aliases: []string{"hist"}, Handler: command.HandlerHistory}
+	return bctx
+}
+
+func TestBridgeHistory_ListsMatchingCommands(t *testing.T) {
+	bctx := historyContext("say", "say hi", "north", "history", "SAY bye", "hist say")
+	result, err := bridgeHistory(bctx)
+	require.NoError(t, err)
+	assert.True(t, result.done)
+	assert.Nil(t, result.msg)
+	assert.Equal(t, "Command history:\r\n   1  say hi\r\n   2  SAY bye", result.consoleMsg)
+}
+
+func TestBridgeHistory_NothingFound(t *testing.T) {
+	result, err := bridgeHistory(historyContext("", "history"))
+	require.NoError(t, err)
+	assert.Equal(t, "You haven't entered any commands yet.", result.consoleMsg)
+
+	result, err = bridgeHistory(historyContext("attack", "north"))
+	require.NoError(t, err)
+	assert.Equal(t, `No commands in your history contain "attack".`, result.consoleMsg)
+}
+
+func TestBridgeLog_SendsLogRequest(t *testing.T) {
+	bctx := makeBridgeContext("req1", "on")
+	bctx.parsed.Args = []string{"on"}
+	result, err := bridgeLog(bctx)
+	require.NoError(t, err)
+	require.NotNil(t, result.msg.GetLog())
+	assert.Equal(t, "on", result.msg.GetLog().GetAction())
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+)
+
+func TestBridgePrompt_SetSendsSettingsRequest(t *testing.T) {
+	bctx := makeBridgeContext("req1", "set %hp/%maxhp %ap %time>")
+	result, err := bridgePrompt(bctx)
+	require.NoError(t, err)
+	req := result.msg.GetSettings()
+	require.NotNil(t, req)
+	assert.Equal(t, "prompt", req.GetKey())
+	assert.Equal(t, "%hp/%maxhp %ap %time>", req.GetValue())
+}
+
+func TestBridgePrompt_ResetSendsEmptyFormat(t *testing.T) {
+	result, err := bridgePrompt(makeBridgeContext("req1", "reset"))
+	require.NoError(t, err)
+	req := result.msg.GetSettings()
+	require.NotNil(t, req)
+	assert.Equal(t, "prompt", req.GetKey())
+	assert.Empty(t, req.GetValue())
+}
+
+func TestBridgePrompt_RejectsUnknownToken(t *testing.T) {
+	result, err := bridgePrompt(makeBridgeContext("req1", "set %hp %mana>"))
+	require.NoError(t, err)
+	assert.True(t, result.done)
+	assert.Nil(t, result.msg)
+}
+
+func TestBridgePrompt_ShowDescribesFormatAndTokens(t *testing.T) {
+	bctx := makeBridgeContext("req1", "")
+	bctx.promptFormatFn = func() string { return "%hp> " }
+	result, err := bridgePrompt(bctx)
+	require.NoError(t, err)
+	assert.True(t, result.done)
+	text := telnet.StripANSI(result.consoleMsg)
+	assert.Contains(t, text, "Your prompt: %hp> ")
+	assert.Contains(t, text, "%maxreact")
+	assert.Contains(t, text, "prompt reset")
+
+	result, err = bridgePrompt(makeBridgeContext("req1", ""))
+	require.NoError(t, err)
+	assert.Contains(t, result.consoleMsg, "Your prompt: default")
+}
+
+func TestCombatModeHandler_PromptUsesCustomPrompt(t *testing.T) {
+	h := NewCombatModeHandler("Alice", func() {})
+	assert.Equal(t, "[combat]> ", h.Prompt())
+
+	custom := ""
+	h.SetCustomPrompt(func() string { return custom })
+	assert.Equal(t, "[combat]> ", h.Prompt(), "an empty custom prompt keeps the default")
+	custom = "2 AP> "
+	assert.Equal(t, "2 AP> ", h.Prompt())
+}
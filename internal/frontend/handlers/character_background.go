@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+// SetBackgrounds configures the background questionnaire asked during character creation.
+//
+// Postcondition: A nil or empty questionnaire skips the background step entirely.
+func (h *AuthHandler) SetBackgrounds(backgrounds *ruleset.Backgrounds) {
+	h.backgrounds = backgrounds
+}
+
+// FormatBackgroundAnswer returns the menu entry for answer at 1-based position n.
+// Exported for testing.
+//
+// Precondition: answer must be non-nil.
+// Postcondition: Returns the answer text followed by a summary of what it grants, if anything.
+func FormatBackgroundAnswer(n int, answer *ruleset.BackgroundAnswer) string {
+	entry := fmt.Sprintf("  %s%d%s. %s", telnet.Green, n, telnet.Reset, answer.Text)
+	var grants []string
+	for _, it := range answer.Items {
+		grants = append(grants, fmt.Sprintf("%dx %s", it.Quantity, it.ItemID))
+	}
+	if answer.Condition != "" {
+		grants = append(grants, "trait: "+answer.Condition)
+	}
+	if answer.StartRoom != "" {
+		grants = append(grants, "starts in "+answer.StartRoom)
+	}
+	if len(grants) == 0 {
+		return entry
+	}
+	return fmt.Sprintf("%s\r\n     %s[%s]%s", entry, telnet.Yellow, strings.Join(grants, "; "), telnet.Reset)
+}
+
+// promptBackgroundStep asks each background question in turn.
+//
+// Precondition: conn must be open.
+// Postcondition: Returns (nil, false, nil) when no questionnaire is configured;
+// answers maps every question ID to the chosen answer ID otherwise;
+// cancelled is true when the player typed cancel or made an invalid selection;
+// err is non-nil only when reading from conn fails.
+func (h *AuthHandler) promptBackgroundStep(conn *telnet.Conn) (answers map[string]string, cancelled bool, err error) {
+	if h.backgrounds == nil || len(h.backgrounds.Questions) == 0 {
+		return nil, false, nil
+	}
+	_ = conn.WriteLine(telnet.Colorize(telnet.BrightYellow, "\r\nTell us about your background:"))
+	answers = make(map[string]string, len(h.backgrounds.Questions))
+	for _, q := range h.backgrounds.Questions {
+		_ = conn.WriteLine(telnet.Colorize(telnet.BrightWhite, "\r\n"+q.Prompt))
+		for i, a := range q.Answers {
+			_ = conn.WriteLine(FormatBackgroundAnswer(i+1, a))
+		}
+		_ = conn.WriteLine(fmt.Sprintf("  %sR%s. Random (default)", telnet.Green, telnet.Reset))
+		_ = conn.WritePrompt(telnet.Colorf(telnet.BrightWhite,
+			"Select answer [1-%d/R, default=R]: ", len(q.Answers)))
+		line, err := conn.ReadLine()
+		if err != nil {
+			return nil, false, fmt.Errorf("reading background answer: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		if strings.ToLower(line) == "cancel" {
+			return nil, true, nil
+		}
+		if IsRandomInput(line) {
+			a := q.Answers[rand.Intn(len(q.Answers))]
+			_ = conn.WriteLine(telnet.Colorf(telnet.Cyan, "Random answer selected: %s", a.Text))
+			answers[q.ID] = a.ID
+			continue
+		}
+		choice := 0
+		if _, err := fmt.Sscanf(line, "%d", &choice); err != nil || choice < 1 || choice > len(q.Answers) {
+			_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Invalid selection."))
+			return nil, true, nil
+		}
+		answers[q.ID] = q.Answers[choice-1].ID
+	}
+	return answers, false, nil
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func testBackgrounds() *ruleset.Backgrounds {
+	return &ruleset.Backgrounds{Questions: []*ruleset.BackgroundQuestion{
+		{ID: "upbringing", Prompt: "Where did you grow up?", Answers: []*ruleset.BackgroundAnswer{
+			{ID: "tunnels", Text: "In the tunnels.", StartRoom: "downtown_underground",
+				Items: []ruleset.BackgroundItem{{ItemID: "scrap_bandage", Quantity: 2}}},
+			{ID: "crew", Text: "With my crew."},
+		}},
+		{ID: "bond", Prompt: "What keeps you going?", Answers: []*ruleset.BackgroundAnswer{
+			{ID: "debt", Text: "A debt.", Condition: "background_survivor"},
+			{ID: "score", Text: "A big score."},
+		}},
+	}}
+}
+
+func TestFormatBackgroundAnswer(t *testing.T) {
+	q := testBackgrounds().Questions[0]
+	out := FormatBackgroundAnswer(1, q.Answers[0])
+	assert.Contains(t, out, "In the tunnels.")
+	assert.Contains(t, out, "2x scrap_bandage")
+	assert.Contains(t, out, "starts in downtown_underground")
+	assert.NotContains(t, FormatBackgroundAnswer(2, q.Answers[1]), "\r\n", "no grants line without grants")
+}
+
+func TestPromptBackgroundStep_AnswersEveryQuestion(t *testing.T) {
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	h.SetBackgrounds(testBackgrounds())
+
+	got, cancelled, err := h.promptBackgroundStep(newScriptedConn(t, "1\r\n2\r\n"))
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+	assert.Equal(t, map[string]string{"upbringing": "tunnels", "bond": "score"}, got)
+}
+
+func TestPromptBackgroundStep_Cancel(t *testing.T) {
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	h.SetBackgrounds(testBackgrounds())
+
+	got, cancelled, err := h.promptBackgroundStep(newScriptedConn(t, "1\r\ncancel\r\n"))
+	require.NoError(t, err)
+	assert.True(t, cancelled)
+	assert.Nil(t, got)
+}
+
+func TestPromptBackgroundStep_SkippedWithoutQuestionnaire(t *testing.T) {
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	got, cancelled, err := h.promptBackgroundStep(newScriptedConn(t, ""))
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+	assert.Nil(t, got)
+}
+
+func TestProperty_PromptBackgroundStep_RandomAnswersAreValid(t *testing.T) {
+	bg := testBackgrounds()
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	h.SetBackgrounds(bg)
+	rapid.Check(t, func(rt *rapid.T) {
+		input := ""
+		for range bg.Questions {
+			input += rapid.SampledFrom([]string{"", "r", "R"}).Draw(rt, "input") + "\r\n"
+		}
+		got, cancelled, err := h.promptBackgroundStep(newScriptedConn(t, input))
+		if err != nil || cancelled {
+			rt.Fatalf("unexpected result: cancelled=%v err=%v", cancelled, err)
+		}
+		if len(got) != len(bg.Questions) {
+			rt.Fatalf("got %d answers, want %d", len(got), len(bg.Questions))
+		}
+		if err := bg.Validate(got); err != nil {
+			rt.Fatalf("random answers invalid: %v", err)
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// deleteWindowDays is postgres.CharacterDeleteWindow in whole days, for player-facing text.
+var deleteWindowDays = int(postgres.CharacterDeleteWindow / (24 * time.Hour))
+
+// DeleteConfirmPhrase returns the phrase a player must type to confirm deleting
+// the character called name.
+func DeleteConfirmPhrase(name string) string {
+	return "delete " + name
+}
+
+// resolveCharacterRef returns the character ref names: either its 1-based
+// position in chars or its name, case-insensitively.
+//
+// Postcondition: Returns nil when ref matches no character.
+func resolveCharacterRef(chars []*character.Character, ref string) *character.Character {
+	if n, err := strconv.Atoi(ref); err == nil {
+		if n >= 1 && n <= len(chars) {
+			return chars[n-1]
+		}
+		return nil
+	}
+	for _, c := range chars {
+		if strings.EqualFold(c.Name, ref) {
+			return c
+		}
+	}
+	return nil
+}
+
+// daysUntilPurge returns the whole days, rounded up, before a character deleted
+// at deletedAt is purged.
+func daysUntilPurge(deletedAt, now time.Time) int {
+	return daysUntil(deletedAt.Add(postgres.CharacterDeleteWindow), now)
+}
+
+// daysUntil returns the whole days, rounded up, from now until deadline; 0 once it has passed.
+func daysUntil(deadline, now time.Time) int {
+	remaining := deadline.Sub(now)
+	if remaining <= 0 {
+		return 0
+	}
+	return int((remaining + 24*time.Hour - 1) / (24 * time.Hour))
+}
+
+// deleteCharacterPrompt soft-deletes the character ref names after the player
+// types its confirmation phrase.
+//
+// Precondition: conn must be open; chars is the account's live character list.
+// Postcondition: The character is soft-deleted only on an exact confirmation;
+// returns a non-nil error only when reading from conn fails.
+func (h *AuthHandler) deleteCharacterPrompt(ctx context.Context, conn *telnet.Conn, acct postgres.Account, chars []*character.Character, ref string) error {
+	target := resolveCharacterRef(chars, ref)
+	if target == nil {
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Usage: delete <number|name>"))
+		return nil
+	}
+	phrase := DeleteConfirmPhrase(target.Name)
+	_ = conn.WritePrompt(telnet.Colorf(telnet.Red,
+		"Delete %q? It can be restored for %d days. Type '%s' to confirm: ", target.Name, deleteWindowDays, phrase))
+	confirm, err := conn.ReadLine()
+	if err != nil {
+		return fmt.Errorf("reading delete confirmation: %w", err)
+	}
+	if strings.TrimSpace(confirm) != phrase {
+		_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "Delete cancelled."))
+		return nil
+	}
+	if err := h.characters.SoftDeleteByAccountAndName(ctx, acct.ID, target.Name); err != nil {
+		h.logger.Warn("soft-deleting character", zap.Int64("account_id", acct.ID), zap.String("name", target.Name), zap.Error(err))
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to delete character."))
+		return nil
+	}
+	_ = conn.WriteLine(telnet.Colorf(telnet.Cyan,
+		"%q has been deleted. Type 'restore %s' within %d days to recover it.", target.Name, target.Name, deleteWindowDays))
+	return nil
+}
+
+// restoreCharacter recovers the account's soft-deleted character called name.
+//
+// Precondition: conn must be open.
+// Postcondition: The character is live again on success; otherwise the player is told why not.
+func (h *AuthHandler) restoreCharacter(ctx context.Context, conn *telnet.Conn, acct postgres.Account, name string) {
+	deleted, err := h.characters.ListDeletedByAccount(ctx, acct.ID)
+	if err != nil {
+		h.logger.Warn("listing deleted characters", zap.Int64("account_id", acct.ID), zap.Error(err))
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to restore character."))
+		return
+	}
+	var target *character.Character
+	for _, c := range deleted {
+		if strings.EqualFold(c.Name, name) {
+			target = c
+			break
+		}
+	}
+	if target == nil {
+		_ = conn.WriteLine(telnet.Colorf(telnet.Red, "No restorable character named %q.", name))
+		return
+	}
+	if err := h.characters.RestoreByAccountAndName(ctx, acct.ID, target.Name); err != nil {
+		if !errors.Is(err, postgres.ErrCharacterNotFound) {
+			h.logger.Warn("restoring character", zap.Int64("account_id", acct.ID), zap.String("name", target.Name), zap.Error(err))
+		}
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to restore character."))
+		return
+	}
+	_ = conn.WriteLine(telnet.Colorf(telnet.Cyan, "%q has been restored.", target.Name))
+}
+
+// writePendingDeletions lists the account's restorable characters.
+//
+// Postcondition: Returns true when at least one character was listed.
+func (h *AuthHandler) writePendingDeletions(ctx context.Context, conn *telnet.Conn, accountID int64) bool {
+	deleted, err := h.characters.ListDeletedByAccount(ctx, accountID)
+	if err != nil {
+		h.logger.Warn("listing deleted characters", zap.Int64("account_id", accountID), zap.Error(err))
+		return false
+	}
+	if len(deleted) == 0 {
+		return false
+	}
+	_ = conn.WriteLine(telnet.Colorize(telnet.BrightWhite, "\r\nPending deletion:"))
+	now := time.Now()
+	for _, c := range deleted {
+		days := 0
+		if c.DeletedAt != nil {
+			days = daysUntilPurge(*c.DeletedAt, now)
+		}
+		_ = conn.WriteLine(fmt.Sprintf("  %s (purged in %d day(s)) — type 'restore %s' to recover",
+			c.Name, days, c.Name))
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// newScriptedConn returns a telnet.Conn whose client side sends input and
+// discards everything the server writes.
+func newScriptedConn(t *testing.T, input string) *telnet.Conn {
+	t.Helper()
+	client, server := net.Pipe()
+	t.Cleanup(func() { client.Close(); server.Close() })
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			if _, err := client.Read(buf); err != nil {
+				return
+			}
+		}
+	}()
+	go func() { _, _ = client.Write([]byte(input)) }()
+	return telnet.NewConn(server, time.Second, time.Second)
+}
+
+func newDeleteTestHandler(t *testing.T, store *mockCharacterStore) *AuthHandler {
+	t.Helper()
+	return &AuthHandler{characters: store, logger: zaptest.NewLogger(t)}
+}
+
+func TestResolveCharacterRef(t *testing.T) {
+	chars := []*character.Character{{Name: "Zed"}, {Name: "Ada"}}
+	assert.Equal(t, "Zed", resolveCharacterRef(chars, "1").Name)
+	assert.Equal(t, "Ada", resolveCharacterRef(chars, "ADA").Name)
+	assert.Nil(t, resolveCharacterRef(chars, "3"))
+	assert.Nil(t, resolveCharacterRef(chars, "Bob"))
+}
+
+func TestDaysUntilPurge(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, deleteWindowDays, daysUntilPurge(now, now))
+	assert.Equal(t, 1, daysUntilPurge(now.Add(-postgres.CharacterDeleteWindow+time.Minute), now))
+	assert.Equal(t, 0, daysUntilPurge(now.Add(-postgres.CharacterDeleteWindow), now))
+}
+
+func TestPropertyDaysUntilPurge_WithinWindow(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		now := time.Now()
+		age := time.Duration(rapid.Int64Range(0, int64(postgres.CharacterDeleteWindow)).Draw(rt, "age"))
+		days := daysUntilPurge(now.Add(-age), now)
+		if days < 0 || days > deleteWindowDays {
+			rt.Fatalf("days %d outside [0,%d] for age %s", days, deleteWindowDays, age)
+		}
+	})
+}
+
+func TestDeleteCharacterPrompt_RequiresExactPhrase(t *testing.T) {
+	store := newMockCharacterStore(&character.Character{ID: 1, Name: "Zed"})
+	h := newDeleteTestHandler(t, store)
+
+	conn := newScriptedConn(t, "yes\r\n")
+	require.NoError(t, h.deleteCharacterPrompt(context.Background(), conn, postgres.Account{ID: 1}, store.chars, "1"))
+	assert.Len(t, store.chars, 1, "a bare 'yes' must not delete")
+
+	conn = newScriptedConn(t, DeleteConfirmPhrase("Zed")+"\r\n")
+	require.NoError(t, h.deleteCharacterPrompt(context.Background(), conn, postgres.Account{ID: 1}, store.chars, "zed"))
+	assert.Empty(t, store.chars)
+	require.Len(t, store.deleted, 1)
+	assert.NotNil(t, store.deleted[0].DeletedAt)
+}
+
+func TestRestoreCharacter_RecoversPendingDeletion(t *testing.T) {
+	store := newMockCharacterStore(&character.Character{ID: 1, Name: "Zed"})
+	h := newDeleteTestHandler(t, store)
+	require.NoError(t, store.SoftDeleteByAccountAndName(context.Background(), 1, "Zed"))
+
+	conn := newPipeConn(t)
+	h.restoreCharacter(context.Background(), conn, postgres.Account{ID: 1}, "nobody")
+	assert.Empty(t, store.chars)
+
+	h.restoreCharacter(context.Background(), conn, postgres.Account{ID: 1}, "ZED")
+	require.Len(t, store.chars, 1)
+	assert.Nil(t, store.chars[0].DeletedAt)
+	assert.False(t, h.writePendingDeletions(context.Background(), conn, 1))
+}
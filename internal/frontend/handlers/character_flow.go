@@ -102,7 +102,32 @@ func (h *AuthHandler) characterFlow(ctx context.Context, conn *telnet.Conn, acct
 			return fmt.Errorf("listing characters: %w", err)
 		}
 
+		deleting := h.accountDeleter != nil && acct.DeletionRequestedAt != nil
 		if len(chars) == 0 {
+			pending := h.writePendingDeletions(ctx, conn, acct.ID)
+			if deleting {
+				writeAccountDeletionNotice(conn, acct, time.Now())
+			}
+			if pending || deleting {
+				prompt := "Type 'restore <name>' to recover a character, or press Enter to create one: "
+				if !pending {
+					prompt = "Type 'cancel deletion' to keep your account, or press Enter to create a character: "
+				}
+				_ = conn.WritePrompt(telnet.Colorize(telnet.BrightWhite, prompt))
+				line, err := conn.ReadLine()
+				if err != nil {
+					return fmt.Errorf("reading restore selection: %w", err)
+				}
+				line = strings.TrimSpace(line)
+				if strings.HasPrefix(strings.ToLower(line), "restore ") {
+					h.restoreCharacter(ctx, conn, acct, strings.TrimSpace(line[len("restore "):]))
+					continue
+				}
+				if deleting && strings.EqualFold(line, "cancel deletion") {
+					h.cancelAccountDeletion(ctx, conn, &acct)
+					continue
+				}
+			}
 			_ = conn.WriteLine(telnet.Colorize(telnet.BrightYellow,
 				"\r\nYou have no characters. Let's create one."))
 			c, err := h.characterCreationFlow(ctx, conn, acct.ID)
@@ -144,11 +169,19 @@ func (h *AuthHandler) characterFlow(ctx context.Context, conn *telnet.Conn, acct
 				telnet.Green, i+1, telnet.Reset,
 				FormatCharacterSummary(c, h.regionDisplayName(c.Region))))
 		}
-		_ = conn.WriteLine(fmt.Sprintf("  %s%d%s. Create a new character",
-			telnet.Green, len(chars)+1, telnet.Reset))
+		_ = conn.WriteLine(fmt.Sprintf("  %s%d%s. Create a new character%s",
+			telnet.Green, len(chars)+1, telnet.Reset, h.characterSlotsLabel(acct, len(chars))))
 		_ = conn.WriteLine(fmt.Sprintf("  %squit%s. Disconnect",
 			telnet.Green, telnet.Reset))
-		_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "  Type 'delete N' to permanently delete character N."))
+		h.writeAccountShared(ctx, conn, acct.ID)
+		h.writePendingDeletions(ctx, conn, acct.ID)
+		_ = conn.WriteLine(telnet.Colorf(telnet.Yellow, "  Type 'delete N' to delete character N (restorable for %d days).", deleteWindowDays))
+		if h.accountDeleter != nil && !deleting {
+			_ = conn.WriteLine(telnet.Colorf(telnet.Yellow, "  Type 'delete account' to delete your account and all its characters (recoverable for %d days).", accountDeleteWindowDays))
+		}
+		if deleting {
+			writeAccountDeletionNotice(conn, acct, time.Now())
+		}
 
 		_ = conn.WritePrompt(telnet.Colorf(telnet.BrightWhite, "Select [1-%d]: ", len(chars)+1))
 		line, err := conn.ReadLine()
@@ -162,34 +195,29 @@ func (h *AuthHandler) characterFlow(ctx context.Context, conn *telnet.Conn, acct
 			return nil
 		}
 
-		// Handle "delete N" command.
+		// Handle "delete account" and "cancel deletion" when account deletion is enabled.
+		if lower := strings.ToLower(line); h.accountDeleter != nil && lower == "delete account" {
+			if err := h.deleteAccountPrompt(ctx, conn, &acct); err != nil {
+				return err
+			}
+			continue
+		} else if h.accountDeleter != nil && lower == "cancel deletion" {
+			h.cancelAccountDeletion(ctx, conn, &acct)
+			continue
+		}
+
+		// Handle "delete <N|name>" and "restore <name>" commands.
 		if lower := strings.ToLower(line); strings.HasPrefix(lower, "delete ") {
-			parts := strings.Fields(line)
-			if len(parts) == 2 {
-				var delIdx int
-				if _, scanErr := fmt.Sscan(parts[1], &delIdx); scanErr == nil && delIdx >= 1 && delIdx <= len(chars) {
-					target := chars[delIdx-1]
-					_ = conn.WritePrompt(telnet.Colorf(telnet.Red,
-						"Delete %q? This cannot be undone. Type 'yes' to confirm: ", target.Name))
-					confirm, readErr := conn.ReadLine()
-					if readErr != nil {
-						return fmt.Errorf("reading delete confirmation: %w", readErr)
-					}
-					if strings.TrimSpace(strings.ToLower(confirm)) == "yes" {
-						if delErr := h.characters.DeleteByAccountAndName(ctx, acct.ID, target.Name); delErr != nil {
-							_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to delete character."))
-						} else {
-							_ = conn.WriteLine(telnet.Colorf(telnet.Cyan, "%q has been deleted.", target.Name))
-						}
-					} else {
-						_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "Delete cancelled."))
-					}
-				} else {
-					_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Invalid selection for delete."))
-				}
-			} else {
-				_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Usage: delete N"))
+			if err := h.deleteCharacterPrompt(ctx, conn, acct, chars, strings.TrimSpace(line[len("delete "):])); err != nil {
+				return err
+			}
+			continue
+		} else if strings.HasPrefix(lower, "restore ") {
+			if msg, full := h.characterLimitReached(acct, len(chars)); full {
+				_ = conn.WriteLine(telnet.Colorize(telnet.Red, msg))
+				continue
 			}
+			h.restoreCharacter(ctx, conn, acct, strings.TrimSpace(line[len("restore "):]))
 			continue
 		}
 
@@ -200,6 +228,10 @@ func (h *AuthHandler) characterFlow(ctx context.Context, conn *telnet.Conn, acct
 		}
 
 		if choice == len(chars)+1 {
+			if msg, full := h.characterLimitReached(acct, len(chars)); full {
+				_ = conn.WriteLine(telnet.Colorize(telnet.Red, msg))
+				continue
+			}
 			c, err := h.characterCreationFlow(ctx, conn, acct.ID)
 			if err != nil {
 				return err
@@ -326,9 +358,13 @@ func (h *AuthHandler) characterCreationFlow(ctx context.Context, conn *telnet.Co
 			_ = conn.WriteLine(telnet.Colorf(telnet.Red, "Error randomizing selections: %v", err))
 			return nil, nil
 		}
+		heritage := randomHeritage(h.heritages)
+		if heritage != nil {
+			_ = conn.WriteLine(telnet.Colorf(telnet.Cyan, "Random heritage selected: %s", heritage.Name))
+		}
 		_ = conn.WriteLine(telnet.Colorf(telnet.Cyan,
 			"Random selections: Region=%s, Team=%s, Archetype=%s, Job=%s", region.Name, team.Name, job.Archetype, job.Name))
-		return h.buildAndConfirm(ctx, conn, accountID, charName, gender, region, job, team)
+		return h.buildAndConfirm(ctx, conn, accountID, charName, gender, region, heritage, job, team)
 	}
 	regionChoice := 0
 	if _, err := fmt.Sscanf(regionLine, "%d", &regionChoice); err != nil || regionChoice < 1 || regionChoice > len(regions) {
@@ -337,6 +373,15 @@ func (h *AuthHandler) characterCreationFlow(ctx context.Context, conn *telnet.Co
 	}
 	selectedRegion := regions[regionChoice-1]
 
+	// Step 2b: Heritage
+	selectedHeritage, cancelled, err := h.promptHeritageStep(conn)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled {
+		return nil, nil
+	}
+
 	// Step 3: Team selection
 	teams := h.teams
 	_ = conn.WriteLine(telnet.Colorize(telnet.BrightYellow, "\r\nChoose your team:"))
@@ -370,7 +415,7 @@ func (h *AuthHandler) characterCreationFlow(ctx context.Context, conn *telnet.Co
 		}
 		_ = conn.WriteLine(telnet.Colorf(telnet.Cyan,
 			"Random selections: Team=%s, Archetype=%s, Job=%s", team.Name, job.Archetype, job.Name))
-		return h.buildAndConfirm(ctx, conn, accountID, charName, gender, selectedRegion, job, team)
+		return h.buildAndConfirm(ctx, conn, accountID, charName, gender, selectedRegion, selectedHeritage, job, team)
 	}
 	teamChoice := 0
 	if _, err := fmt.Sscanf(teamLine, "%d", &teamChoice); err != nil || teamChoice < 1 || teamChoice > len(teams) {
@@ -466,7 +511,7 @@ func (h *AuthHandler) characterCreationFlow(ctx context.Context, conn *telnet.Co
 		selectedJob = availableJobs[jobChoice-1]
 	}
 
-	return h.buildAndConfirm(ctx, conn, accountID, charName, gender, selectedRegion, selectedJob, selectedTeam)
+	return h.buildAndConfirm(ctx, conn, accountID, charName, gender, selectedRegion, selectedHeritage, selectedJob, selectedTeam)
 }
 
 // ensureSkills checks whether the character has skills recorded and, if not,
@@ -967,10 +1012,11 @@ func PromptGenderStep(conn *telnet.Conn) (string, error) {
 }
 
 // buildAndConfirm builds a character from the given selections, shows the preview,
+// offers the optional point-buy step, asks the background questionnaire,
 // prompts for confirmation, and persists on yes.
 // Returns (nil, nil) if the player declines or cancels.
 //
-// Precondition: all pointer parameters must be non-nil; accountID must be > 0.
+// Precondition: all pointer parameters except heritage must be non-nil; accountID must be > 0.
 // Postcondition: returns persisted *character.Character or (nil, nil) on cancel, decline, build failure, or storage failure.
 func (h *AuthHandler) buildAndConfirm(
 	ctx context.Context,
@@ -979,10 +1025,11 @@ func (h *AuthHandler) buildAndConfirm(
 	charName string,
 	gender string,
 	region *ruleset.Region,
+	heritage *ruleset.Heritage,
 	job *ruleset.Job,
 	team *ruleset.Team,
 ) (*character.Character, error) {
-	newChar, err := character.BuildWithJob(charName, region, job, team)
+	newChar, err := character.BuildWithJob(charName, region, heritage, job, team)
 	if err != nil {
 		h.logger.Error("building character", zap.String("name", charName), zap.Error(err))
 		_ = conn.WriteLine(telnet.Colorf(telnet.Red, "Error building character: %v", err))
@@ -991,7 +1038,33 @@ func (h *AuthHandler) buildAndConfirm(
 	newChar.Gender = gender
 
 	_ = conn.WriteLine(telnet.Colorize(telnet.BrightCyan, "\r\n--- Character Preview ---"))
-	_ = conn.WriteLine(FormatCharacterStats(newChar, region.DisplayName()))
+	_ = conn.WriteLine(FormatCharacterStats(newChar, region.DisplayName(), heritage))
+
+	newChar, cancelled, err := h.pointBuyStep(conn, newChar, region.DisplayName(), heritage,
+		func(adj map[string]int) (*character.Character, error) {
+			return character.BuildWithPointBuy(charName, region, heritage, job, team, adj)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if cancelled {
+		_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "Character creation cancelled."))
+		return nil, nil
+	}
+
+	answers, cancelled, err := h.promptBackgroundStep(conn)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled {
+		_ = conn.WriteLine(telnet.Colorize(telnet.Yellow, "Character creation cancelled."))
+		return nil, nil
+	}
+	newChar.BackgroundAnswers = answers
+	if room := h.backgrounds.Resolve(answers).StartRoom; room != "" {
+		newChar.Location = room
+	}
+
 	_ = conn.WritePrompt(telnet.Colorize(telnet.BrightWhite, "Create this character? [y/N]: "))
 
 	confirm, err := conn.ReadLine()
@@ -1094,12 +1167,16 @@ func FormatCharacterSummary(c *character.Character, regionDisplay string) string
 // FormatCharacterStats returns a multi-line stats block for the character preview.
 // Exported for testing.
 //
-// Precondition: c must be non-nil; regionDisplay must be non-empty.
-// Postcondition: Returns a formatted multi-line string with HP and all six ability scores.
-func FormatCharacterStats(c *character.Character, regionDisplay string) string {
+// Precondition: c must be non-nil; regionDisplay must be non-empty; heritage may be nil.
+// Postcondition: Returns a formatted multi-line string with HP and all six ability scores,
+// plus the heritage and its starting trait when heritage is non-nil.
+func FormatCharacterStats(c *character.Character, regionDisplay string, heritage *ruleset.Heritage) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("  Name:   %s%s%s\r\n", telnet.BrightWhite, c.Name, telnet.Reset))
 	sb.WriteString(fmt.Sprintf("  Region: %s   Class: %s   Level: %d\r\n", regionDisplay, c.Class, c.Level))
+	if heritage != nil {
+		sb.WriteString(fmt.Sprintf("  Heritage: %s   Trait: %s\r\n", heritage.Name, heritage.Trait.Name))
+	}
 	sb.WriteString(fmt.Sprintf("  Gender: %s\r\n", c.Gender))
 	sb.WriteString(fmt.Sprintf("  HP:     %d/%d\r\n", c.CurrentHP, c.MaxHP))
 	sb.WriteString(fmt.Sprintf("  BRT:%2d  QCK:%2d  GRT:%2d  RSN:%2d  SAV:%2d  FLR:%2d\r\n",
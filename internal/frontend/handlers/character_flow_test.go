@@ -111,7 +111,7 @@ func TestFormatCharacterStats(t *testing.T) {
 			Reasoning: 10, Savvy: 8, Flair: 10,
 		},
 	}
-	stats := handlers.FormatCharacterStats(c, "the Northeast")
+	stats := handlers.FormatCharacterStats(c, "the Northeast", nil)
 	assert.Contains(t, stats, "BRT")
 	assert.Contains(t, stats, "14")
 	assert.Contains(t, stats, "HP")
@@ -627,7 +627,7 @@ func TestProperty_FormatCharacterStats(t *testing.T) {
 			},
 		}
 		regionDisplay := rapid.StringMatching(`[A-Za-z ]+`).Draw(rt, "regionDisplay")
-		stats := handlers.FormatCharacterStats(c, regionDisplay)
+		stats := handlers.FormatCharacterStats(c, regionDisplay, nil)
 		assert.NotEmpty(rt, stats)
 		for _, label := range []string{"BRT", "QCK", "GRT", "RSN", "SAV", "FLR", "HP"} {
 			assert.Contains(rt, stats, label)
@@ -766,7 +766,7 @@ func TestFormatCharacterStats_ShowsGender(t *testing.T) {
 		Gender: "non-binary",
 		MaxHP:  10, CurrentHP: 10,
 	}
-	stats := handlers.FormatCharacterStats(c, "Old Town")
+	stats := handlers.FormatCharacterStats(c, "Old Town", nil)
 	assert.Contains(t, stats, "non-binary")
 	assert.Contains(t, stats, "Gender")
 }
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+// SetHeritages configures the heritages offered during character creation.
+//
+// Postcondition: An empty list skips the heritage step entirely.
+func (h *AuthHandler) SetHeritages(heritages []*ruleset.Heritage) {
+	h.heritages = heritages
+}
+
+// randomHeritage returns a random heritage from heritages, or nil when there are none.
+func randomHeritage(heritages []*ruleset.Heritage) *ruleset.Heritage {
+	if len(heritages) == 0 {
+		return nil
+	}
+	return heritages[rand.Intn(len(heritages))]
+}
+
+// FormatHeritageOption returns the menu entry for heritage at 1-based position n.
+// Exported for testing.
+//
+// Precondition: heritage must be non-nil.
+// Postcondition: Returns a string naming the heritage, its modifiers, and its starting trait.
+func FormatHeritageOption(n int, heritage *ruleset.Heritage) string {
+	return fmt.Sprintf("  %s%d%s. %s%s%s (%s)\r\n     %s\r\n     %s[%s]%s %s",
+		telnet.Green, n, telnet.Reset,
+		telnet.BrightWhite, heritage.Name, telnet.Reset,
+		formatModifiers(heritage.Modifiers),
+		strings.TrimSpace(heritage.Description),
+		telnet.Yellow, heritage.Trait.Name, telnet.Reset, heritage.Trait.Description)
+}
+
+// formatModifiers renders ability modifiers as "+2 quickness, -2 reasoning" in a stable order.
+func formatModifiers(mods map[string]int) string {
+	var parts []string
+	for _, ability := range []string{"brutality", "grit", "quickness", "reasoning", "savvy", "flair"} {
+		if d, ok := mods[ability]; ok && d != 0 {
+			parts = append(parts, fmt.Sprintf("%+d %s", d, ability))
+		}
+	}
+	if len(parts) == 0 {
+		return "no modifiers"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// promptHeritageStep asks the player to choose a heritage.
+//
+// Precondition: conn must be open.
+// Postcondition: Returns (nil, false, nil) when no heritages are configured;
+// cancelled is true when the player typed cancel or made an invalid selection;
+// err is non-nil only when reading from conn fails.
+func (h *AuthHandler) promptHeritageStep(conn *telnet.Conn) (heritage *ruleset.Heritage, cancelled bool, err error) {
+	if len(h.heritages) == 0 {
+		return nil, false, nil
+	}
+	_ = conn.WriteLine(telnet.Colorize(telnet.BrightYellow, "\r\nChoose your heritage:"))
+	for i, hr := range h.heritages {
+		_ = conn.WriteLine(FormatHeritageOption(i+1, hr))
+	}
+	_ = conn.WriteLine(fmt.Sprintf("  %sR%s. Random (default)", telnet.Green, telnet.Reset))
+	_ = conn.WritePrompt(telnet.Colorf(telnet.BrightWhite,
+		"Select heritage [1-%d/R, default=R]: ", len(h.heritages)))
+	line, err := conn.ReadLine()
+	if err != nil {
+		return nil, false, fmt.Errorf("reading heritage selection: %w", err)
+	}
+	line = strings.TrimSpace(line)
+	if strings.ToLower(line) == "cancel" {
+		return nil, true, nil
+	}
+	if IsRandomInput(line) {
+		heritage = randomHeritage(h.heritages)
+		_ = conn.WriteLine(telnet.Colorf(telnet.Cyan, "Random heritage selected: %s", heritage.Name))
+		return heritage, false, nil
+	}
+	choice := 0
+	if _, err := fmt.Sscanf(line, "%d", &choice); err != nil || choice < 1 || choice > len(h.heritages) {
+		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Invalid selection."))
+		return nil, true, nil
+	}
+	return h.heritages[choice-1], false, nil
+}
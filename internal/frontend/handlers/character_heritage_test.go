@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func testHeritages() []*ruleset.Heritage {
+	return []*ruleset.Heritage{
+		{ID: "street_born", Name: "Street-Born", Modifiers: map[string]int{"quickness": 2, "reasoning": -2},
+			Trait: ruleset.HeritageTrait{ID: "alley_sense", Name: "Alley Sense", Description: "Knows the way out."}},
+		{ID: "off_grid", Name: "Off-Grid", Modifiers: map[string]int{"savvy": 2},
+			Trait: ruleset.HeritageTrait{ID: "scavengers_eye", Name: "Scavenger's Eye"}},
+	}
+}
+
+func TestFormatHeritageOption(t *testing.T) {
+	out := FormatHeritageOption(1, testHeritages()[0])
+	assert.Contains(t, out, "Street-Born")
+	assert.Contains(t, out, "+2 quickness, -2 reasoning")
+	assert.Contains(t, out, "Alley Sense")
+}
+
+func TestFormatCharacterStats_ShowsHeritage(t *testing.T) {
+	c := &character.Character{Name: "Ash", Class: "ganger", Level: 1, MaxHP: 10, CurrentHP: 10}
+	stats := FormatCharacterStats(c, "Old Town", testHeritages()[0])
+	assert.Contains(t, stats, "Heritage: Street-Born")
+	assert.Contains(t, stats, "Trait: Alley Sense")
+	assert.NotContains(t, FormatCharacterStats(c, "Old Town", nil), "Heritage")
+}
+
+func TestPromptHeritageStep_SelectsByNumber(t *testing.T) {
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	h.SetHeritages(testHeritages())
+
+	got, cancelled, err := h.promptHeritageStep(newScriptedConn(t, "2\r\n"))
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+	require.NotNil(t, got)
+	assert.Equal(t, "off_grid", got.ID)
+
+	got, cancelled, err = h.promptHeritageStep(newScriptedConn(t, "cancel\r\n"))
+	require.NoError(t, err)
+	assert.True(t, cancelled)
+	assert.Nil(t, got)
+}
+
+func TestPromptHeritageStep_SkippedWithoutHeritages(t *testing.T) {
+	h := &AuthHandler{logger: zaptest.NewLogger(t)}
+	got, cancelled, err := h.promptHeritageStep(newScriptedConn(t, ""))
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+	assert.Nil(t, got)
+}
+
+func TestProperty_RandomHeritage_ReturnsMember(t *testing.T) {
+	all := testHeritages()
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(0, len(all)).Draw(rt, "n")
+		got := randomHeritage(all[:n])
+		if n == 0 {
+			if got != nil {
+				rt.Fatalf("expected nil for empty list, got %q", got.ID)
+			}
+			return
+		}
+		for _, h := range all[:n] {
+			if h == got {
+				return
+			}
+		}
+		rt.Fatalf("randomHeritage returned a heritage outside the list")
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+// pointBuyStep offers the optional point-buy step and, when the player takes
+// it, rebuilds the character with their adjustments and shows the updated preview.
+//
+// Precondition: conn must be open; base is the character built without point-buy;
+// rebuild builds a fresh character with the given adjustments.
+// Postcondition: Returns base unchanged when the player declines; the rebuilt
+// character on valid input; cancelled is true when the player typed cancel.
+// err is non-nil only when reading from conn fails.
+func (h *AuthHandler) pointBuyStep(
+	conn *telnet.Conn,
+	base *character.Character,
+	regionDisplay string,
+	heritage *ruleset.Heritage,
+	rebuild func(adj map[string]int) (*character.Character, error),
+) (result *character.Character, cancelled bool, err error) {
+	_ = conn.WritePrompt(telnet.Colorf(telnet.BrightWhite,
+		"Shift ability points (up to %d, ±%d per ability)? [y/N]: ", character.PointBuyPool, character.PointBuyMaxShift))
+	answer, err := conn.ReadLine()
+	if err != nil {
+		return nil, false, fmt.Errorf("reading point-buy choice: %w", err)
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer == "cancel" {
+		return nil, true, nil
+	}
+	if answer != "y" {
+		return base, false, nil
+	}
+	for {
+		_ = conn.WritePrompt(telnet.Colorize(telnet.BrightWhite,
+			"Enter adjustments (e.g. 'grit +2 flair -2'), or blank to keep your scores: "))
+		line, err := conn.ReadLine()
+		if err != nil {
+			return nil, false, fmt.Errorf("reading point-buy adjustments: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		if strings.ToLower(line) == "cancel" {
+			return nil, true, nil
+		}
+		if line == "" {
+			return base, false, nil
+		}
+		adj, err := character.ParsePointBuy(line)
+		if err == nil {
+			var rebuilt *character.Character
+			if rebuilt, err = rebuild(adj); err == nil {
+				rebuilt.Gender = base.Gender
+				_ = conn.WriteLine(telnet.Colorize(telnet.BrightCyan, "\r\n--- Updated Preview ---"))
+				_ = conn.WriteLine(FormatCharacterStats(rebuilt, regionDisplay, heritage))
+				return rebuilt, false, nil
+			}
+		}
+		_ = conn.WriteLine(telnet.Colorf(telnet.Red, "Invalid point-buy: %v", err))
+	}
+}
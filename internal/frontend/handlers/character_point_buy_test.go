@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func pointBuyFixture(t *testing.T) (*AuthHandler, *character.Character, func(map[string]int) (*character.Character, error)) {
+	t.Helper()
+	region := &ruleset.Region{ID: "r", Name: "Region"}
+	job := &ruleset.Job{ID: "j", KeyAbility: "brutality", HitPointsPerLevel: 8}
+	team := &ruleset.Team{ID: "t", StartRoom: "start"}
+	base, err := character.BuildWithJob("Hero", region, nil, job, team)
+	require.NoError(t, err)
+	base.Gender = "female"
+	rebuild := func(adj map[string]int) (*character.Character, error) {
+		return character.BuildWithPointBuy("Hero", region, nil, job, team, adj)
+	}
+	return &AuthHandler{logger: zaptest.NewLogger(t)}, base, rebuild
+}
+
+func TestPointBuyStep_DeclineKeepsBase(t *testing.T) {
+	h, base, rebuild := pointBuyFixture(t)
+	got, cancelled, err := h.pointBuyStep(newScriptedConn(t, "n\r\n"), base, "Region", nil, rebuild)
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+	assert.Same(t, base, got)
+}
+
+func TestPointBuyStep_RetriesUntilValid(t *testing.T) {
+	h, base, rebuild := pointBuyFixture(t)
+	got, cancelled, err := h.pointBuyStep(newScriptedConn(t, "y\r\ngrit +2\r\ngrit +2 flair -2\r\n"), base, "Region", nil, rebuild)
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+	require.NotNil(t, got)
+	assert.Equal(t, 12, got.Abilities.Grit)
+	assert.Equal(t, 8, got.Abilities.Flair)
+	assert.Equal(t, "female", got.Gender)
+}
+
+func TestPointBuyStep_Cancel(t *testing.T) {
+	h, base, rebuild := pointBuyFixture(t)
+	got, cancelled, err := h.pointBuyStep(newScriptedConn(t, "y\r\ncancel\r\n"), base, "Region", nil, rebuild)
+	require.NoError(t, err)
+	assert.True(t, cancelled)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// SetCharacterSlots configures the per-account character limits enforced by
+// the character flow.
+//
+// Postcondition: Subsequent character creation and restore attempts respect cfg.
+func (h *AuthHandler) SetCharacterSlots(cfg config.CharactersConfig) {
+	h.characterSlots = cfg
+}
+
+// characterLimitReached reports whether acct, owning count live characters,
+// may not create or restore another one.
+//
+// Postcondition: When reached is true, msg explains the limit to the player.
+func (h *AuthHandler) characterLimitReached(acct postgres.Account, count int) (msg string, reached bool) {
+	limit := h.characterSlots.SlotLimit(acct.Role, acct.CharacterSlots)
+	if limit == 0 || count < limit {
+		return "", false
+	}
+	return fmt.Sprintf("You have reached your limit of %d characters. Delete one or ask an admin for more slots.", limit), true
+}
+
+// characterSlotsLabel renders "count/limit slots used", or "" when unlimited.
+func (h *AuthHandler) characterSlotsLabel(acct postgres.Account, count int) string {
+	limit := h.characterSlots.SlotLimit(acct.Role, acct.CharacterSlots)
+	if limit == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%d/%d slots used)", count, limit)
+}
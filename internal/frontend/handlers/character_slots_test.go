@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterLimitReached(t *testing.T) {
+	h := &AuthHandler{}
+	player := postgres.Account{Role: postgres.RolePlayer}
+
+	_, full := h.characterLimitReached(player, 100)
+	assert.False(t, full, "the zero config is unlimited")
+	assert.Empty(t, h.characterSlotsLabel(player, 100))
+
+	h.SetCharacterSlots(config.CharactersConfig{MaxPerAccount: 2, RoleSlots: map[string]int{postgres.RoleEditor: 4}})
+	_, full = h.characterLimitReached(player, 1)
+	assert.False(t, full)
+	msg, full := h.characterLimitReached(player, 2)
+	assert.True(t, full)
+	assert.Contains(t, msg, "limit of 2")
+	assert.Equal(t, " (2/2 slots used)", h.characterSlotsLabel(player, 2))
+
+	_, full = h.characterLimitReached(postgres.Account{Role: postgres.RoleEditor}, 3)
+	assert.False(t, full, "role slots replace the default")
+
+	_, full = h.characterLimitReached(postgres.Account{Role: postgres.RolePlayer, CharacterSlots: 3}, 2)
+	assert.False(t, full, "an account override wins over the role limit")
+}
+
+// TestPropertyCharacterLimitReached_MatchesLimit verifies the limit is reached exactly at the configured count.
+func TestPropertyCharacterLimitReached_MatchesLimit(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		limit := rapid.IntRange(1, 20).Draw(rt, "limit")
+		count := rapid.IntRange(0, 40).Draw(rt, "count")
+		h := &AuthHandler{}
+		h.SetCharacterSlots(config.CharactersConfig{MaxPerAccount: limit})
+		_, full := h.characterLimitReached(postgres.Account{Role: postgres.RolePlayer}, count)
+		if full != (count >= limit) {
+			rt.Fatalf("limit %d count %d: full=%v", limit, count, full)
+		}
+	})
+}
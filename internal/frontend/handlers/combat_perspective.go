@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"regexp"
+	"strings"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// irregularSecondPerson maps third-person singular verbs that do not follow
+// the suffix rules to their second-person forms.
+var irregularSecondPerson = map[string]string{
+	"is":   "are",
+	"was":  "were",
+	"has":  "have",
+	"does": "do",
+	"goes": "go",
+}
+
+// secondPersonVerb converts a third-person singular present-tense verb to its
+// second-person form ("hits" → "hit", "misses" → "miss", "tries" → "try").
+//
+// Precondition: none.
+// Postcondition: Words that are not recognisably third-person are returned unchanged.
+func secondPersonVerb(word string) string {
+	if v, ok := irregularSecondPerson[word]; ok {
+		return v
+	}
+	switch {
+	case len(word) > 3 && strings.HasSuffix(word, "ies"):
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "shes"),
+		strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "xes"),
+		strings.HasSuffix(word, "zes"):
+		return word[:len(word)-2]
+	case len(word) > 2 && strings.HasSuffix(word, "s") &&
+		!strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") &&
+		!strings.HasSuffix(word, "is"):
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// isSentenceStart reports whether position i in text begins a sentence.
+func isSentenceStart(text string, i int) bool {
+	j := i
+	for j > 0 && (text[j-1] == ' ' || text[j-1] == '\t') {
+		j--
+	}
+	if j == 0 {
+		return true
+	}
+	switch text[j-1] {
+	case '.', '!', '?', '\n', ']':
+		return true
+	}
+	return false
+}
+
+// toSecondPerson rewrites every whole-word mention of name in text as "you",
+// conjugating the following verb when name is the subject of a sentence.
+//
+// Precondition: name must be non-empty.
+// Postcondition: Text that does not mention name is returned unchanged.
+func toSecondPerson(text, name string) string {
+	re := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `('s)?\b( [a-z]+)?`)
+	var b strings.Builder
+	last := 0
+	for _, m := range re.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(text[last:m[0]])
+		last = m[1]
+		start := isSentenceStart(text, m[0])
+		possessive := m[2] >= 0
+		switch {
+		case possessive && start:
+			b.WriteString("Your")
+		case possessive:
+			b.WriteString("your")
+		case start:
+			b.WriteString("You")
+		default:
+			b.WriteString("you")
+		}
+		if m[4] >= 0 {
+			word := text[m[4]+1 : m[5]]
+			if start && !possessive {
+				word = secondPersonVerb(word)
+			}
+			b.WriteString(" " + word)
+		}
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// CombatNarrativeForRecipient returns ce's narrative rendered from the
+// receiving player's perspective: when the recipient is the attacker or the
+// target, their name is replaced with second-person text.
+//
+// Precondition: ce must be non-nil.
+// Postcondition: Returns ce.Narrative unchanged when the recipient is neither
+// the attacker nor the target.
+func CombatNarrativeForRecipient(ce *gamev1.CombatEvent) string {
+	text := ce.GetNarrative()
+	if ce.GetAttackerRelation() == gamev1.CombatRelation_COMBAT_RELATION_SELF && ce.GetAttacker() != "" {
+		return toSecondPerson(text, ce.GetAttacker())
+	}
+	if ce.GetTargetRelation() == gamev1.CombatRelation_COMBAT_RELATION_SELF && ce.GetTarget() != "" {
+		return toSecondPerson(text, ce.GetTarget())
+	}
+	return text
+}
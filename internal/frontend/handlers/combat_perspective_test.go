@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestSecondPersonVerb(t *testing.T) {
+	cases := map[string]string{
+		"hits":    "hit",
+		"misses":  "miss",
+		"tries":   "try",
+		"strides": "stride",
+		"reaches": "reach",
+		"is":      "are",
+		"has":     "have",
+		"cannot":  "cannot",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, secondPersonVerb(in), in)
+	}
+}
+
+func TestCombatNarrativeForRecipient_Attacker(t *testing.T) {
+	ce := &gamev1.CombatEvent{
+		Attacker:         "Kira",
+		Target:           "Rat",
+		Narrative:        "Kira attacks Rat. Kira's blade bites deep.",
+		AttackerRelation: gamev1.CombatRelation_COMBAT_RELATION_SELF,
+		TargetRelation:   gamev1.CombatRelation_COMBAT_RELATION_ENEMY,
+	}
+	assert.Equal(t, "You attack Rat. Your blade bites deep.", CombatNarrativeForRecipient(ce))
+}
+
+func TestCombatNarrativeForRecipient_Target(t *testing.T) {
+	ce := &gamev1.CombatEvent{
+		Attacker:         "Rat",
+		Target:           "Kira",
+		Narrative:        "Rat bites Kira for 3 damage.",
+		AttackerRelation: gamev1.CombatRelation_COMBAT_RELATION_ENEMY,
+		TargetRelation:   gamev1.CombatRelation_COMBAT_RELATION_SELF,
+	}
+	assert.Equal(t, "Rat bites you for 3 damage.", CombatNarrativeForRecipient(ce))
+}
+
+func TestCombatNarrativeForRecipient_ObserverUnchanged(t *testing.T) {
+	ce := &gamev1.CombatEvent{
+		Attacker:         "Kira",
+		Target:           "Rat",
+		Narrative:        "Kira attacks Rat.",
+		AttackerRelation: gamev1.CombatRelation_COMBAT_RELATION_ALLY,
+		TargetRelation:   gamev1.CombatRelation_COMBAT_RELATION_ENEMY,
+	}
+	assert.Equal(t, "Kira attacks Rat.", CombatNarrativeForRecipient(ce))
+	assert.Contains(t, RenderCombatEvent(ce), "Kira attacks Rat.")
+}
+
+// TestProperty_CombatNarrativeForRecipient_RemovesSelfName verifies that the
+// recipient's own name never survives second-person rendering.
+func TestProperty_CombatNarrativeForRecipient_RemovesSelfName(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		name := rapid.StringMatching(`[A-Z][a-z]{2,8}`).Draw(rt, "name")
+		other := rapid.StringMatching(`[A-Z][a-z]{2,8}`).Draw(rt, "other")
+		if strings.Contains(other, name) {
+			return
+		}
+		narrative := name + " swings at " + other + ". " + other + " strikes " + name + "."
+		ce := &gamev1.CombatEvent{
+			Attacker:         name,
+			Target:           other,
+			Narrative:        narrative,
+			AttackerRelation: gamev1.CombatRelation_COMBAT_RELATION_SELF,
+		}
+		got := CombatNarrativeForRecipient(ce)
+		if strings.Contains(got, name) {
+			rt.Fatalf("self name %q still present in %q", name, got)
+		}
+	})
+}
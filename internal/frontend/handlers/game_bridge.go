@@ -15,11 +15,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/cory-johannsen/mud/internal/flood"
 	"github.com/cory-johannsen/mud/internal/frontend/telnet"
 	"github.com/cory-johannsen/mud/internal/game/character"
 	"github.com/cory-johannsen/mud/internal/game/command"
 	"github.com/cory-johannsen/mud/internal/gameserver"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/siteban"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
@@ -27,6 +29,9 @@ import (
 // characterFlow checks for this sentinel to loop back to character selection.
 var ErrSwitchCharacter = errors.New("switch character")
 
+// errFlooded is returned by commandLoop when the player is disconnected for flooding.
+var errFlooded = errors.New("disconnected for flooding")
+
 // BuildPrompt constructs the colored telnet prompt string.
 //
 // Precondition: maxHP > 0; name must be non-empty.
@@ -69,6 +74,27 @@ func BuildPrompt(name string, currentHP, maxHP int32, conditions []string, focus
 	return prompt + "> "
 }
 
+// checkInputFlood applies limiter to a line of input arriving at now, warning
+// the player when input is dropped.
+//
+// Precondition: conn must be non-nil; limiter may be nil to allow everything.
+// Postcondition: Returns allowed=true when the line may be processed; returns
+// errFlooded, after telling the player, when they are disconnected for flooding.
+func checkInputFlood(conn *telnet.Conn, limiter *flood.Limiter, now time.Time) (allowed bool, err error) {
+	switch limiter.Check(now) {
+	case flood.Allow:
+		return true, nil
+	case flood.Warn:
+		writeFmtConsole(conn, "%s", telnet.Colorize(telnet.Yellow, "You are sending commands too quickly. Slow down."))
+	case flood.Squelch:
+		writeFmtConsole(conn, "%s", telnet.Colorf(telnet.Yellow, "Too many commands. Your input is ignored for %s.", limiter.SquelchDuration().Round(time.Second)))
+	case flood.Disconnect:
+		writeFmtConsole(conn, "%s", telnet.Colorize(telnet.Red, "Disconnecting for flooding."))
+		return false, errFlooded
+	}
+	return false, nil
+}
+
 // IdleMonitorConfig configures the idle monitor goroutine.
 type IdleMonitorConfig struct {
 	// LastInput is the shared atomic timestamp (UnixNano) of the most recent player input.
@@ -77,6 +103,9 @@ type IdleMonitorConfig struct {
 	IdleTimeout time.Duration
 	// GracePeriod is the duration after the warning before the disconnect callback fires.
 	GracePeriod time.Duration
+	// Limits, when non-nil, is consulted on every check instead of
+	// IdleTimeout and GracePeriod, so the limits can change mid-session.
+	Limits *IdleLimits
 	// TickInterval controls how often the monitor checks for idleness.
 	TickInterval time.Duration
 	// OnWarning is called once when the player has been idle for IdleTimeout.
@@ -85,6 +114,38 @@ type IdleMonitorConfig struct {
 	OnDisconnect func()
 }
 
+// IdleLimits holds idle timeouts that may be changed while sessions run.
+// It is safe for concurrent use.
+type IdleLimits struct {
+	timeout atomic.Int64
+	grace   atomic.Int64
+}
+
+// Set replaces both limits.
+func (l *IdleLimits) Set(timeout, grace time.Duration) {
+	l.timeout.Store(int64(timeout))
+	l.grace.Store(int64(grace))
+}
+
+// Get returns the idle timeout and grace period.
+func (l *IdleLimits) Get() (timeout, grace time.Duration) {
+	return time.Duration(l.timeout.Load()), time.Duration(l.grace.Load())
+}
+
+// lockedSessionStream serializes Send on a session stream, which gRPC does not
+// allow from concurrent goroutines.
+type lockedSessionStream struct {
+	gamev1.GameService_SessionClient
+	mu sync.Mutex
+}
+
+// Send sends msg while holding the stream's send lock.
+func (s *lockedSessionStream) Send(msg *gamev1.ClientMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.GameService_SessionClient.Send(msg)
+}
+
 // StartIdleMonitor launches a goroutine that monitors player inactivity.
 // It returns a stop function that terminates the goroutine cleanly.
 //
@@ -105,11 +166,15 @@ func StartIdleMonitor(cfg IdleMonitorConfig) (stop func()) {
 				return
 			case <-ticker.C:
 				idle := time.Duration(time.Now().UnixNano() - cfg.LastInput.Load())
-				if !warningSent && idle >= cfg.IdleTimeout {
+				timeout, grace := cfg.IdleTimeout, cfg.GracePeriod
+				if cfg.Limits != nil {
+					timeout, grace = cfg.Limits.Get()
+				}
+				if !warningSent && idle >= timeout {
 					warningSent = true
 					cfg.OnWarning()
 				}
-				if warningSent && idle >= cfg.IdleTimeout+cfg.GracePeriod {
+				if warningSent && idle >= timeout+grace {
 					cfg.OnDisconnect()
 					return
 				}
@@ -120,6 +185,29 @@ func StartIdleMonitor(cfg IdleMonitorConfig) (stop func()) {
 	return stop
 }
 
+// commandCatalogTimeout bounds the GetCommands call made as a session starts.
+const commandCatalogTimeout = 5 * time.Second
+
+// loadCommandRegistry fetches the game server's command catalog.
+//
+// Postcondition: Falls back to the built-in commands when the server cannot
+// provide a usable catalog.
+func (h *AuthHandler) loadCommandRegistry(ctx context.Context, client gamev1.GameServiceClient) *command.Registry {
+	ctx, cancel := context.WithTimeout(ctx, commandCatalogTimeout)
+	defer cancel()
+	resp, err := client.GetCommands(ctx, &gamev1.GetCommandsRequest{})
+	if err != nil {
+		h.logger.Warn("fetching command catalog; using built-in commands", zap.Error(err))
+		return command.DefaultRegistry()
+	}
+	registry, err := command.RegistryFromProto(resp.GetCommands())
+	if err != nil {
+		h.logger.Warn("invalid command catalog; using built-in commands", zap.Error(err))
+		return command.DefaultRegistry()
+	}
+	return registry
+}
+
 // gameBridge manages the gRPC session between a Telnet client and the game server.
 //
 // Precondition: conn must be open; char must be non-nil with a valid ID.
@@ -140,15 +228,24 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 
 	client := gamev1.NewGameServiceClient(grpcConn)
 
-	streamCtx, cancel := context.WithCancel(ctx)
+	// Forward the player's address so the game server can enforce site bans.
+	streamCtx, cancel := context.WithCancel(siteban.WithClientAddr(ctx, conn.RemoteAddr().String()))
 	defer cancel()
 
-	stream, err := client.Session(streamCtx)
+	// Resolve commands from the server's catalog so input and help match what it dispatches on.
+	registry := h.loadCommandRegistry(ctx, client)
+
+	rawStream, err := client.Session(streamCtx)
 	if err != nil {
 		h.logger.Error("opening game session", zap.Error(err))
 		_ = conn.WriteLine(telnet.Colorize(telnet.Red, "Failed to start game session."))
 		return fmt.Errorf("opening session stream: %w", err)
 	}
+	// The idle monitor sends from its own goroutine, so sends are serialized.
+	stream := &lockedSessionStream{GameService_SessionClient: rawStream}
+
+	accountSettings := h.loadAccountSettings(ctx, acct.ID)
+	conn.SetPageLength(accountSettings.PageLength)
 
 	// Send JoinWorldRequest
 	uid := fmt.Sprintf("%d", char.ID)
@@ -168,6 +265,7 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 				Level:         int32(char.Level),
 				Archetype:     h.archetypeForJob(char.Class),
 				Headless:      conn.Headless,
+				Settings:      accountSettings.ToProto(),
 			},
 		},
 	}); err != nil {
@@ -299,6 +397,7 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 		&currentRoom, &currentTime,
 		&condMu, activeConditions,
 	)
+	roomHandler.SetPromptFormat(accountSettings.PromptFormat)
 	mapHandler := NewMapModeHandler()
 	session := NewSessionInputState(roomHandler)
 
@@ -338,14 +437,14 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 
 	stopIdle := StartIdleMonitor(IdleMonitorConfig{
 		LastInput:    &lastInput,
-		IdleTimeout:  h.telnetCfg.IdleTimeout,
-		GracePeriod:  h.telnetCfg.IdleGracePeriod,
+		Limits:       &h.idleLimits,
 		TickInterval: 10 * time.Second,
 		OnWarning: func() {
+			timeout, grace := h.idleLimits.Get()
 			msg := fmt.Sprintf(
 				"Warning: You have been idle for %s. You will be disconnected in %s.",
-				h.telnetCfg.IdleTimeout.Round(time.Second),
-				h.telnetCfg.IdleGracePeriod.Round(time.Second),
+				timeout.Round(time.Second),
+				grace.Round(time.Second),
 			)
 			colored := telnet.Colorize(telnet.Yellow, msg)
 			var err error
@@ -357,6 +456,13 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 			if err != nil {
 				h.logger.Debug("failed to send idle warning", zap.Error(err))
 			}
+			afk := &gamev1.ClientMessage{
+				RequestId: "idle-afk",
+				Payload:   &gamev1.ClientMessage_Afk{Afk: &gamev1.AfkRequest{Auto: true}},
+			}
+			if err := stream.Send(afk); err != nil {
+				h.logger.Debug("failed to mark idle player AFK", zap.Error(err))
+			}
 		},
 		OnDisconnect: func() {
 			msg := "Disconnecting due to inactivity."
@@ -382,7 +488,7 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 	}()
 
 	// Command loop: read Telnet → parse → send gRPC
-	err = h.commandLoop(streamCtx, stream, conn, char.Name, acct.Role, &lastInput, session, mapHandler, &lastRoomView, &currentHotbar, &lastCharacterSheet)
+	err = h.commandLoop(streamCtx, stream, registry, conn, char.Name, acct.Role, &lastInput, session, mapHandler, &lastRoomView, &currentHotbar, &lastCharacterSheet)
 
 	cancel()
 	wg.Wait()
@@ -394,6 +500,9 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 	if errors.Is(err, ErrSwitchCharacter) {
 		disconnectReason.Store("switch_character")
 	}
+	if errors.Is(err, errFlooded) {
+		disconnectReason.Store("flood")
+	}
 
 	h.logger.Info("player disconnected",
 		zap.String("reason", disconnectReason.Load().(string)),
@@ -415,11 +524,11 @@ func (h *AuthHandler) gameBridge(ctx context.Context, conn *telnet.Conn, acct po
 // commandLoop reads lines from the Telnet connection, parses commands,
 // and sends them as gRPC ClientMessages.
 //
-// Precondition: stream must be open; charName must be non-empty; lastInput must be non-nil; session must be non-nil.
+// Precondition: stream must be open; registry must be non-nil; charName must be non-empty; lastInput must be non-nil; session must be non-nil.
 // Postcondition: Returns nil on clean quit, ctx.Err() on cancellation, or a wrapped error on failure.
-func (h *AuthHandler) commandLoop(ctx context.Context, stream gamev1.GameService_SessionClient, conn *telnet.Conn, charName string, role string, lastInput *atomic.Int64, session *SessionInputState, mapHandler *MapModeHandler, lastRoomView *atomic.Value, currentHotbar *atomic.Value, lastCharacterSheet *atomic.Value) error {
-	registry := command.DefaultRegistry()
+func (h *AuthHandler) commandLoop(ctx context.Context, stream gamev1.GameService_SessionClient, registry *command.Registry, conn *telnet.Conn, charName string, role string, lastInput *atomic.Int64, session *SessionInputState, mapHandler *MapModeHandler, lastRoomView *atomic.Value, currentHotbar *atomic.Value, lastCharacterSheet *atomic.Value) error {
 	requestID := 0
+	limiter := flood.New(h.telnetCfg.Flood, time.Now())
 
 	for {
 		select {
@@ -485,6 +594,13 @@ func (h *AuthHandler) commandLoop(ctx context.Context, stream gamev1.GameService
 			continue
 		}
 
+		// Commands beyond the flood limit never reach the game server.
+		if allowed, err := checkInputFlood(conn, limiter, time.Now()); err != nil {
+			return err
+		} else if !allowed {
+			continue
+		}
+
 		// Any real command while scrolled back: snap to live first.
 		if conn.IsSplitScreen() {
 			if snapErr := conn.SnapToLive(); snapErr != nil {
@@ -520,7 +636,7 @@ func (h *AuthHandler) commandLoop(ctx context.Context, stream gamev1.GameService
 		}
 
 		line = strings.TrimSpace(line)
-		if line != "" && conn.IsSplitScreen() {
+		if line != "" {
 			conn.AppendHistory(line)
 		}
 
@@ -598,21 +714,27 @@ func (h *AuthHandler) commandLoop(ctx context.Context, stream gamev1.GameService
 			role:           role,
 			stream:         stream,
 			promptFn:       session.CurrentPrompt,
+			promptFormatFn: session.Room().PromptFormat,
 			travelResolver: travelResolver,
-			roomViewFn: func() *gamev1.RoomView {
-				if rv, ok := lastRoomView.Load().(*gamev1.RoomView); ok {
-					return rv
-				}
-				return nil
-			},
+			registry:       registry,
 			characterSheetFn: func() *gamev1.CharacterSheetView {
 				if sh, ok := lastCharacterSheet.Load().(*gamev1.CharacterSheetView); ok {
 					return sh
 				}
 				return nil
 			},
-			helpFn: func() {
-				h.showGameHelp(conn, registry, role)
+			helpFn: func(args []string) {
+				if len(args) == 0 {
+					h.showGameHelp(conn, registry, role)
+				}
+				width, height := conn.Dimensions()
+				if text := h.helpText(registry, role, args, width, height); text != "" {
+					if conn.IsSplitScreen() {
+						_ = conn.WriteConsole(text)
+					} else {
+						_ = conn.WriteLine(text)
+					}
+				}
 				if conn.IsSplitScreen() {
 					_ = conn.WritePromptSplit(session.CurrentPrompt())
 				} else {
@@ -745,6 +867,7 @@ func (h *AuthHandler) forwardServerEvents(ctx context.Context, stream gamev1.Gam
 		err  error
 	}
 	combatHandler := NewCombatModeHandler(charName, func() {})
+	combatHandler.SetCustomPrompt(session.Room().CustomPrompt)
 	var combatEndTimer *time.Timer
 
 	recvCh := make(chan recvResult, 4)
@@ -848,6 +971,7 @@ func (h *AuthHandler) forwardServerEvents(ctx context.Context, stream gamev1.Gam
 				if roomID := p.RoomView.GetRoomId(); roomID != "" {
 					currentRoom.Store(roomID)
 				}
+				session.Room().SetRoomName(p.RoomView.GetTitle())
 				if p.RoomView.GetPeriod() != "" {
 					currentTime.Store(&gamev1.TimeOfDayEvent{Hour: p.RoomView.GetHour(), Period: p.RoomView.GetPeriod()})
 					if existing, ok := currentDT.Load().(*gameserver.GameDateTime); ok && existing != nil {
@@ -928,9 +1052,10 @@ func (h *AuthHandler) forwardServerEvents(ctx context.Context, stream gamev1.Gam
 				combatHandler.UpdateCombatEvent(
 					ce.GetAttacker(), ce.GetTarget(),
 					int(ce.GetDamage()), int(ce.GetTargetHp()), int(ce.GetTargetMaxHp()),
-					ce.GetNarrative(), int32(ce.GetType()),
+					CombatNarrativeForRecipient(ce), int32(ce.GetType()),
 				)
 				if ce.GetType() == gamev1.CombatEventType_COMBAT_EVENT_TYPE_END {
+					session.Room().EndCombat()
 					combatHandler.SetSummary("Combat complete.")
 					cw, _ := conn.Dimensions()
 					summary := RenderCombatSummary("Combat complete.", cw)
@@ -989,6 +1114,7 @@ func (h *AuthHandler) forwardServerEvents(ctx context.Context, stream gamev1.Gam
 				turnOrder := make([]string, len(rs.GetTurnOrder()))
 				copy(turnOrder, rs.GetTurnOrder())
 				combatHandler.UpdateRoundStart(int(rs.GetRound()), int(rs.GetActionsPerTurn()), int(rs.GetDurationMs()), turnOrder)
+				session.Room().SetActionPoints(rs.GetActionsPerTurn(), rs.GetActionsPerTurn())
 				// Seed initial 2D grid positions from the round-start event.
 				combatHandler.SetInitialPositions(rs.GetInitialPositions())
 				// Seed player HP from stored values so the HP bar shows immediately.
@@ -1009,8 +1135,13 @@ func (h *AuthHandler) forwardServerEvents(ctx context.Context, stream gamev1.Gam
 				text = RenderRoundStartEvent(rs)
 			case *gamev1.ServerEvent_RoundEnd:
 				text = RenderRoundEndEvent(p.RoundEnd)
+			case *gamev1.ServerEvent_CombatState:
+				cw, _ := conn.Dimensions()
+				text = RenderCombatState(p.CombatState, cw)
 			case *gamev1.ServerEvent_NpcView:
 				text = RenderNpcView(p.NpcView)
+			case *gamev1.ServerEvent_RoomPeek:
+				text = RenderRoomPeek(p.RoomPeek)
 			case *gamev1.ServerEvent_ConditionEvent:
 				ce := p.ConditionEvent
 				if ce.ConditionId == "" {
@@ -1121,6 +1252,40 @@ func (h *AuthHandler) forwardServerEvents(ctx context.Context, stream gamev1.Gam
 					_ = conn.WritePrompt(session.CurrentPrompt())
 				}
 				continue
+			case *gamev1.ServerEvent_TitleUpdate:
+				if room := session.Room(); room != nil {
+					room.SetTitle(p.TitleUpdate.GetTitle())
+				}
+				if conn.IsSplitScreen() {
+					_ = conn.WritePromptSplit(session.CurrentPrompt())
+				} else {
+					_ = conn.WritePrompt(session.CurrentPrompt())
+				}
+				continue
+			case *gamev1.ServerEvent_AccountSettings:
+				// The server confirmed a settings change; only the pager and prompt are applied here.
+				conn.SetPageLength(int(p.AccountSettings.GetPageLength()))
+				session.Room().SetPromptFormat(p.AccountSettings.GetPromptFormat())
+				if conn.IsSplitScreen() {
+					_ = conn.WritePromptSplit(session.CurrentPrompt())
+				}
+				continue
+			case *gamev1.ServerEvent_ApUpdate:
+				// Only the player's own budget feeds the prompt.
+				ap := p.ApUpdate
+				if ap.GetName() != charName {
+					continue
+				}
+				session.Room().SetActionPoints(ap.GetApRemaining(), ap.GetApTotal())
+				if ap.GetReactionMax() > 0 {
+					session.Room().SetReactions(ap.GetReactionMax()-ap.GetReactionSpent(), ap.GetReactionMax())
+				}
+				if conn.IsSplitScreen() {
+					_ = conn.WritePromptSplit(session.CurrentPrompt())
+				} else {
+					_ = conn.WritePrompt(session.CurrentPrompt())
+				}
+				continue
 			case *gamev1.ServerEvent_Disconnected:
 				dcMsg := telnet.Colorf(telnet.Yellow, "Disconnected: %s", p.Disconnected.Reason)
 				if conn.IsSplitScreen() {
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// catalogClient serves GetCommands; every other method is unimplemented.
+type catalogClient struct {
+	gamev1.GameServiceClient
+	resp *gamev1.GetCommandsResponse
+	err  error
+}
+
+func (c *catalogClient) GetCommands(context.Context, *gamev1.GetCommandsRequest, ...grpc.CallOption) (*gamev1.GetCommandsResponse, error) {
+	return c.resp, c.err
+}
+
+func TestLoadCommandRegistry_UsesServerCatalog(t *testing.T) {
+	h := newAuthHandler(t, newMockAccountStore(), "")
+	client := &catalogClient{resp: &gamev1.GetCommandsResponse{Commands: []*gamev1.CommandInfo{
+		{Name: "north", Aliases: []string{"n"}, Category: command.CategoryMovement, Handler: command.HandlerMove},
+	}}}
+	r := h.loadCommandRegistry(context.Background(), client)
+	require.Len(t, r.Commands(), 1)
+	cmd, ok := r.Resolve("n")
+	require.True(t, ok)
+	assert.Equal(t, "north", cmd.Name)
+}
+
+func TestLoadCommandRegistry_FallsBackToBuiltins(t *testing.T) {
+	h := newAuthHandler(t, newMockAccountStore(), "")
+	builtins := len(command.BuiltinCommands())
+
+	r := h.loadCommandRegistry(context.Background(), &catalogClient{err: errors.New("unimplemented")})
+	assert.Len(t, r.Commands(), builtins)
+
+	r = h.loadCommandRegistry(context.Background(), &catalogClient{resp: &gamev1.GetCommandsResponse{}})
+	assert.Len(t, r.Commands(), builtins)
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/flood"
+)
+
+func TestCheckInputFlood(t *testing.T) {
+	conn := newScriptedConn(t, "")
+	now := time.Unix(0, 0)
+	limiter := flood.New(config.FloodConfig{
+		CommandsPerSecond: 1, Burst: 1, SquelchAfter: 2, SquelchDuration: time.Second, DisconnectAfter: 1,
+	}, now)
+
+	allowed, err := checkInputFlood(conn, limiter, now)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = checkInputFlood(conn, limiter, now)
+	require.NoError(t, err)
+	assert.False(t, allowed, "warned")
+
+	allowed, err = checkInputFlood(conn, limiter, now)
+	assert.ErrorIs(t, err, errFlooded)
+	assert.False(t, allowed)
+}
+
+func TestCheckInputFlood_NilLimiterAllows(t *testing.T) {
+	conn := newScriptedConn(t, "")
+	for i := 0; i < 50; i++ {
+		allowed, err := checkInputFlood(conn, nil, time.Now())
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+}
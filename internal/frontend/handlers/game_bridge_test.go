@@ -279,6 +279,42 @@ func TestProperty_IdleMonitor_ActivePlayerNeverDisconnected(t *testing.T) {
 	})
 }
 
+// TestIdleMonitor_LimitsChangeMidSession verifies that shortening the limits
+// of a running monitor takes effect on its next check.
+func TestIdleMonitor_LimitsChangeMidSession(t *testing.T) {
+	var lastInput atomic.Int64
+	lastInput.Store(time.Now().UnixNano())
+
+	var limits handlers.IdleLimits
+	limits.Set(time.Hour, time.Hour)
+	disconnected := make(chan struct{}, 1)
+	tickInterval := 20 * time.Millisecond
+
+	stop := handlers.StartIdleMonitor(handlers.IdleMonitorConfig{
+		LastInput:    &lastInput,
+		Limits:       &limits,
+		TickInterval: tickInterval,
+		OnWarning:    func() {},
+		OnDisconnect: func() {
+			select {
+			case disconnected <- struct{}{}:
+			default:
+			}
+		},
+	})
+	defer stop()
+
+	time.Sleep(3 * tickInterval)
+	limits.Set(10*time.Millisecond, 10*time.Millisecond)
+
+	select {
+	case <-disconnected:
+		// good
+	case <-time.After(20 * tickInterval):
+		t.Fatal("disconnect not called after the limits were shortened")
+	}
+}
+
 func TestBuildPrompt_NoConditions_FormatUnchanged(t *testing.T) {
 	got := handlers.BuildPrompt("Thorald", 50, 60, nil, 0, 0)
 	if !strings.HasSuffix(got, "> ") {
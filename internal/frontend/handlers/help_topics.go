@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/help"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// Help page sizing when the terminal has not reported its dimensions.
+const (
+	defaultHelpPageLines = 20
+	defaultHelpWidth     = 78
+	// helpPageChrome is the rows a page reserves for its title, footer, and prompt.
+	helpPageChrome = 4
+	minHelpPage    = 5
+)
+
+// SetHelpTopics configures the topic pages served by "help <topic>".
+//
+// Postcondition: A nil library limits help to the command list.
+func (h *AuthHandler) SetHelpTopics(l *help.Library) {
+	h.helpTopics = l
+}
+
+// helpText renders the response to "help <args>" for a terminal of the given
+// size; zero dimensions fall back to defaults.
+//
+// Precondition: registry must be non-nil.
+// Postcondition: With no args, returns only the pointer to the topic pages,
+// which is empty when no topics are loaded.
+func (h *AuthHandler) helpText(registry *command.Registry, role string, args []string, width, height int) string {
+	if len(args) == 0 {
+		topics := h.helpTopics.Topics()
+		if len(topics) == 0 {
+			return ""
+		}
+		ids := make([]string, len(topics))
+		for i, t := range topics {
+			ids[i] = t.ID
+		}
+		return telnet.Colorf(telnet.Dim, "Guides: %s. Type 'help <topic>' to read one or 'help search <words>' to search them.",
+			strings.Join(ids, ", "))
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "topics":
+		return h.helpTopicList()
+	case "search":
+		if len(args) < 2 {
+			return telnet.Colorize(telnet.Red, "Usage: help search <words>")
+		}
+		return h.helpSearch(strings.Join(args[1:], " "))
+	}
+
+	name, page := strings.Join(args, " "), 1
+	if len(args) > 1 {
+		if n, err := strconv.Atoi(args[len(args)-1]); err == nil {
+			name, page = strings.Join(args[:len(args)-1], " "), n
+		}
+	}
+	if t, ok := h.helpTopics.Lookup(name); ok {
+		return helpTopicPage(t, page, width, height)
+	}
+	if cmd, ok := registry.Resolve(strings.ToLower(name)); ok && cmd.Category != command.CategoryHidden && roleMayUse(role, cmd) {
+		return helpCommand(cmd)
+	}
+	return telnet.Colorf(telnet.Red, "No help for %q. Try 'help search %s'.", name, name)
+}
+
+// helpTopicList lists every topic with its title.
+func (h *AuthHandler) helpTopicList() string {
+	topics := h.helpTopics.Topics()
+	if len(topics) == 0 {
+		return "No help topics are available."
+	}
+	var b strings.Builder
+	b.WriteString(telnet.Colorize(telnet.BrightWhite, "Help topics:"))
+	for _, t := range topics {
+		b.WriteString("\r\n")
+		b.WriteString(telnet.Colorf(telnet.Green, "  %-14s", t.ID) + t.Title)
+	}
+	return b.String()
+}
+
+// helpSearch lists the topics matching query.
+func (h *AuthHandler) helpSearch(query string) string {
+	hits := h.helpTopics.Search(query)
+	if len(hits) == 0 {
+		return fmt.Sprintf("No help topics match %q.", query)
+	}
+	var b strings.Builder
+	b.WriteString(telnet.Colorf(telnet.BrightWhite, "Help topics matching %q:", query))
+	for _, t := range hits {
+		b.WriteString("\r\n")
+		b.WriteString(telnet.Colorf(telnet.Green, "  %-14s", t.ID) + t.Title)
+	}
+	return b.String()
+}
+
+// helpCommand describes a single command.
+func helpCommand(cmd *command.Command) string {
+	head := cmd.Name
+	if len(cmd.Aliases) > 0 {
+		head += " (" + strings.Join(cmd.Aliases, ", ") + ")"
+	}
+	return telnet.Colorize(telnet.Green, head) + " — " + cmd.Help
+}
+
+// roleMayUse reports whether an account with role may use cmd.
+func roleMayUse(role string, cmd *command.Command) bool {
+	switch cmd.RequiredRole() {
+	case command.RoleAdmin:
+		return role == postgres.RoleAdmin
+	case command.RoleEditor:
+		return role == postgres.RoleEditor || role == postgres.RoleAdmin
+	default:
+		return true
+	}
+}
+
+// helpTopicPage renders page n of t, wrapped to width and split into pages
+// that fit height.
+//
+// Postcondition: n is clamped to the available pages.
+func helpTopicPage(t *help.Topic, n, width, height int) string {
+	pages := helpTopicPages(t, width, height)
+	n = max(1, min(n, len(pages)))
+	var b strings.Builder
+	b.WriteString(telnet.Colorize(telnet.BrightWhite, t.Title))
+	for _, line := range pages[n-1] {
+		b.WriteString("\r\n")
+		b.WriteString(line)
+	}
+	if len(pages) > 1 {
+		b.WriteString("\r\n")
+		if n < len(pages) {
+			b.WriteString(telnet.Colorf(telnet.Dim, "-- Page %d of %d. Type 'help %s %d' for more. --", n, len(pages), t.ID, n+1))
+		} else {
+			b.WriteString(telnet.Colorf(telnet.Dim, "-- Page %d of %d --", n, len(pages)))
+		}
+	}
+	return b.String()
+}
+
+// helpTopicPages wraps t's body to width and splits it into pages that fit
+// height. Markdown headings are highlighted.
+//
+// Postcondition: Returns at least one page.
+func helpTopicPages(t *help.Topic, width, height int) [][]string {
+	if width <= 0 {
+		width = defaultHelpWidth
+	}
+	size := defaultHelpPageLines
+	if height > 0 {
+		size = max(minHelpPage, height-helpPageChrome)
+	}
+
+	var lines []string
+	for _, para := range strings.Split(t.Body, "\n") {
+		if heading, ok := strings.CutPrefix(para, "#"); ok {
+			lines = append(lines, telnet.Colorize(telnet.BrightYellow, strings.TrimSpace(strings.TrimLeft(heading, "#"))))
+			continue
+		}
+		lines = append(lines, wordWrap(para, width, "")...)
+	}
+
+	var pages [][]string
+	for len(lines) > size {
+		cut := size
+		// Prefer to break at a blank line in the back half of the page.
+		for i := size; i > size/2; i-- {
+			if lines[i-1] == "" {
+				cut = i
+				break
+			}
+		}
+		pages = append(pages, lines[:cut])
+		lines = lines[cut:]
+	}
+	return append(pages, lines)
+}
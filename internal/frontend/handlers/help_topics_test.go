@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/help"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func newHelpTestHandler(t *testing.T) *AuthHandler {
+	t.Helper()
+	lib, err := help.NewLibrary([]help.Topic{
+		{ID: "combat", Title: "Combat", Keywords: []string{"fighting"}, Body: "# Rounds\nYou get 3 AP each round."},
+		{ID: "crafting", Title: "Crafting", Body: "Scavenge for materials."},
+	})
+	require.NoError(t, err)
+	h := newAuthHandler(t, newMockAccountStore(), "")
+	h.SetHelpTopics(lib)
+	return h
+}
+
+func helpPlain(h *AuthHandler, role string, args ...string) string {
+	return telnet.StripANSI(h.helpText(command.DefaultRegistry(), role, args, 0, 0))
+}
+
+func TestHelpText_TopicsSearchAndPointer(t *testing.T) {
+	h := newHelpTestHandler(t)
+	assert.Contains(t, helpPlain(h, postgres.RolePlayer), "Guides: combat, crafting.")
+
+	list := helpPlain(h, postgres.RolePlayer, "topics")
+	assert.Contains(t, list, "combat")
+	assert.Contains(t, list, "Crafting")
+
+	assert.Contains(t, helpPlain(h, postgres.RolePlayer, "search", "AP"), "combat")
+	assert.Equal(t, `No help topics match "grenade".`, helpPlain(h, postgres.RolePlayer, "search", "grenade"))
+	assert.Equal(t, "Usage: help search <words>", helpPlain(h, postgres.RolePlayer, "search"))
+
+	h.SetHelpTopics(nil)
+	assert.Empty(t, helpPlain(h, postgres.RolePlayer))
+	assert.Equal(t, "No help topics are available.", helpPlain(h, postgres.RolePlayer, "topics"))
+}
+
+func TestHelpText_TopicPage(t *testing.T) {
+	h := newHelpTestHandler(t)
+	page := helpPlain(h, postgres.RolePlayer, "Fighting")
+	assert.Equal(t, "Combat\r\nRounds\r\nYou get 3 AP each round.", page)
+}
+
+func TestHelpText_CommandsRespectRole(t *testing.T) {
+	h := newHelpTestHandler(t)
+	assert.Equal(t, "north (n) — Move north", helpPlain(h, postgres.RolePlayer, "n"))
+	assert.Contains(t, helpPlain(h, postgres.RolePlayer, "siteban"), `No help for "siteban"`)
+	assert.Contains(t, helpPlain(h, postgres.RoleAdmin, "siteban"), "siteban")
+	assert.Equal(t, `No help for "xyzzy". Try 'help search xyzzy'.`, helpPlain(h, postgres.RolePlayer, "xyzzy"))
+}
+
+func TestHelpTopicPage_Paginates(t *testing.T) {
+	var body []string
+	for i := 0; i < 30; i++ {
+		body = append(body, "line")
+	}
+	topic := &help.Topic{ID: "long", Title: "Long", Body: strings.Join(body, "\n")}
+
+	first := telnet.StripANSI(helpTopicPage(topic, 1, 80, 14))
+	assert.Equal(t, 1+10+1, strings.Count(first, "\r\n")+1, "title, ten body lines, footer")
+	assert.True(t, strings.HasSuffix(first, "-- Page 1 of 3. Type 'help long 2' for more. --"))
+
+	last := telnet.StripANSI(helpTopicPage(topic, 9, 80, 14))
+	assert.True(t, strings.HasSuffix(last, "-- Page 3 of 3 --"), "pages past the end clamp to the last page")
+}
+
+// TestProperty_HelpTopicPagesPreserveLines verifies that pagination keeps every
+// wrapped line, in order, and never overfills a page.
+func TestProperty_HelpTopicPagesPreserveLines(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		lines := rapid.SliceOfN(rapid.SampledFrom([]string{"", "word", "two words here"}), 1, 80).Draw(rt, "lines")
+		height := rapid.IntRange(0, 40).Draw(rt, "height")
+		topic := &help.Topic{ID: "t", Body: strings.Join(lines, "\n")}
+
+		pages := helpTopicPages(topic, 80, height)
+		size := defaultHelpPageLines
+		if height > 0 {
+			size = max(minHelpPage, height-helpPageChrome)
+		}
+		var got []string
+		for _, p := range pages {
+			if len(p) > size {
+				rt.Fatalf("page of %d lines exceeds %d", len(p), size)
+			}
+			got = append(got, p...)
+		}
+		if strings.Join(got, "\n") != strings.Join(lines, "\n") {
+			rt.Fatalf("pages changed the text: %q", got)
+		}
+	})
+}
@@ -71,6 +71,41 @@ func TestSessionInputState_CurrentPrompt_ReflectsActiveHandler(t *testing.T) {
 	assert.Equal(t, "[MAP] prompt", session.CurrentPrompt())
 }
 
+// TestRoomModeHandler_Prompt_ShowsTitle verifies the room prompt shows the
+// character's achievement title after their name until it is cleared.
+func TestRoomModeHandler_Prompt_ShowsTitle(t *testing.T) {
+	room := handlers.NewRoomModeHandlerForTest("Hero", 10, 10)
+	assert.NotContains(t, telnet.StripANSI(room.Prompt()), "the Veteran")
+
+	room.SetTitle("the Veteran")
+	assert.Contains(t, telnet.StripANSI(room.Prompt()), "Hero the Veteran")
+
+	room.SetTitle("")
+	assert.NotContains(t, telnet.StripANSI(room.Prompt()), "the Veteran")
+}
+
+// TestRoomModeHandler_Prompt_CustomFormat verifies a custom prompt format is
+// expanded from the tracked vitals, shows combat budgets only during combat,
+// and that clearing it restores the default prompt.
+func TestRoomModeHandler_Prompt_CustomFormat(t *testing.T) {
+	room := handlers.NewRoomModeHandlerForTest("Hero", 7, 10)
+	room.SetRoomName("Rust Alley")
+	room.SetPromptFormat("%hp/%maxhp %ap/%maxap r%react %room>")
+	assert.Equal(t, "7/10 -/- r- Rust Alley> ", room.Prompt())
+
+	room.SetActionPoints(2, 3)
+	room.SetReactions(1, 1)
+	assert.Equal(t, "7/10 2/3 r1 Rust Alley> ", room.Prompt())
+	assert.Equal(t, room.Prompt(), room.CustomPrompt())
+
+	room.EndCombat()
+	assert.Equal(t, "7/10 -/- r- Rust Alley> ", room.Prompt())
+
+	room.SetPromptFormat("")
+	assert.Empty(t, room.CustomPrompt())
+	assert.Contains(t, telnet.StripANSI(room.Prompt()), "[Hero]")
+}
+
 // TestSessionInputState_Mode_MatchesActiveHandler verifies Mode() returns the
 // active handler's Mode(). REQ-IMR-8A.
 func TestSessionInputState_Mode_MatchesActiveHandler(t *testing.T) {
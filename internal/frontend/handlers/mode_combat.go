@@ -96,6 +96,8 @@ type CombatModeHandler struct {
 	gridPositions  map[string]combatGridCoord
 	log            []string
 	summary        string
+	// customPrompt returns the player's custom prompt, or "" for the default.
+	customPrompt func() string
 }
 
 // NewCombatModeHandler constructs a CombatModeHandler.
@@ -111,8 +113,23 @@ func NewCombatModeHandler(playerName string, onExitFn func()) *CombatModeHandler
 // Mode returns ModeCombat. REQ-IMR-3.
 func (h *CombatModeHandler) Mode() InputMode { return ModeCombat }
 
-// Prompt returns the combat prompt string. REQ-IMR-7.
-func (h *CombatModeHandler) Prompt() string { return "[combat]> " }
+// Prompt returns the player's custom prompt when one is set, otherwise the
+// combat prompt string. REQ-IMR-7.
+func (h *CombatModeHandler) Prompt() string {
+	if h.customPrompt != nil {
+		if p := h.customPrompt(); p != "" {
+			return p
+		}
+	}
+	return "[combat]> "
+}
+
+// SetCustomPrompt makes Prompt use fn's result whenever it is non-empty.
+//
+// Precondition: Must be called before the handler is shared between goroutines.
+func (h *CombatModeHandler) SetCustomPrompt(fn func() string) {
+	h.customPrompt = fn
+}
 
 // Round returns the current combat round (thread-safe).
 func (h *CombatModeHandler) Round() int {
@@ -2,9 +2,11 @@
 package handlers
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 
+	"github.com/cory-johannsen/mud/internal/frontend/prompt"
 	"github.com/cory-johannsen/mud/internal/frontend/telnet"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
@@ -23,6 +25,18 @@ type RoomModeHandler struct {
 	currentTime      *atomic.Value
 	condMu           *sync.Mutex
 	activeConditions map[string]string
+	// title is the achievement title shown after the character name; holds a string.
+	title atomic.Value
+
+	// Custom prompt state. promptFormat and roomName hold strings; the action
+	// point and reaction budgets are only meaningful while inCombat is set.
+	promptFormat atomic.Value
+	roomName     atomic.Value
+	inCombat     atomic.Bool
+	ap           atomic.Int32
+	maxAP        atomic.Int32
+	reactions    atomic.Int32
+	maxReactions atomic.Int32
 
 	// test-only fields: exitLog and enterLog record lifecycle calls for assertions.
 	exitLog  []string
@@ -96,8 +110,12 @@ func (h *RoomModeHandler) OnExit(_ *telnet.Conn) {
 	h.exitLog = append(h.exitLog, "exit")
 }
 
-// Prompt returns the colored room prompt string. REQ-IMR-13.
+// Prompt returns the player's custom prompt when one is set, otherwise the
+// colored room prompt string. REQ-IMR-13.
 func (h *RoomModeHandler) Prompt() string {
+	if p := h.CustomPrompt(); p != "" {
+		return p
+	}
 	var conditions []string
 	if h.condMu != nil {
 		h.condMu.Lock()
@@ -121,7 +139,114 @@ func (h *RoomModeHandler) Prompt() string {
 	if h.maxFP != nil {
 		mfp = h.maxFP.Load()
 	}
-	return BuildPrompt(h.charName, hp, mhp, conditions, fp, mfp)
+	name := h.charName
+	if title, _ := h.title.Load().(string); title != "" {
+		name += " " + title
+	}
+	return BuildPrompt(name, hp, mhp, conditions, fp, mfp)
+}
+
+// CustomPrompt expands the player's prompt format against the tracked vitals.
+//
+// Postcondition: Returns "" when no custom format is set.
+func (h *RoomModeHandler) CustomPrompt() string {
+	format := h.PromptFormat()
+	if format == "" {
+		return ""
+	}
+	return prompt.Expand(format, h.vitals())
+}
+
+// vitals snapshots the values a custom prompt may show.
+func (h *RoomModeHandler) vitals() prompt.Vitals {
+	v := prompt.Vitals{Name: h.charName, HP: 10, MaxHP: 10}
+	v.Title, _ = h.title.Load().(string)
+	v.Room, _ = h.roomName.Load().(string)
+	if h.currentHP != nil {
+		v.HP = h.currentHP.Load()
+	}
+	if h.maxHP != nil {
+		v.MaxHP = h.maxHP.Load()
+	}
+	if h.currentFP != nil {
+		v.FP = h.currentFP.Load()
+	}
+	if h.maxFP != nil {
+		v.MaxFP = h.maxFP.Load()
+	}
+	if h.currentTime != nil {
+		if tod, ok := h.currentTime.Load().(*gamev1.TimeOfDayEvent); ok && tod != nil {
+			v.Hour, v.Period = tod.GetHour(), tod.GetPeriod()
+		}
+	}
+	if h.condMu != nil {
+		h.condMu.Lock()
+		for _, name := range h.activeConditions {
+			v.Conditions = append(v.Conditions, name)
+		}
+		h.condMu.Unlock()
+		sort.Strings(v.Conditions)
+	}
+	if h.inCombat.Load() {
+		v.InCombat = true
+		v.AP, v.MaxAP = h.ap.Load(), h.maxAP.Load()
+		v.Reactions, v.MaxReactions = h.reactions.Load(), h.maxReactions.Load()
+	}
+	return v
+}
+
+// SetPromptFormat sets the player's custom prompt format.
+//
+// Postcondition: "" restores the default prompt.
+func (h *RoomModeHandler) SetPromptFormat(format string) {
+	h.promptFormat.Store(format)
+}
+
+// PromptFormat returns the player's custom prompt format, or "" for the default.
+func (h *RoomModeHandler) PromptFormat() string {
+	f, _ := h.promptFormat.Load().(string)
+	return f
+}
+
+// SetRoomName records the name of the player's current room for the prompt.
+func (h *RoomModeHandler) SetRoomName(name string) {
+	h.roomName.Store(name)
+}
+
+// SetActionPoints records the player's remaining and total action points
+// for the current combat round.
+//
+// Postcondition: The prompt shows combat budgets until EndCombat.
+func (h *RoomModeHandler) SetActionPoints(remaining, total int32) {
+	h.ap.Store(remaining)
+	h.maxAP.Store(total)
+	h.inCombat.Store(true)
+}
+
+// SetReactions records the player's remaining and maximum reactions for the
+// current combat round.
+//
+// Postcondition: The prompt shows combat budgets until EndCombat.
+func (h *RoomModeHandler) SetReactions(remaining, max int32) {
+	h.reactions.Store(remaining)
+	h.maxReactions.Store(max)
+	h.inCombat.Store(true)
+}
+
+// EndCombat clears the combat budgets from the prompt.
+func (h *RoomModeHandler) EndCombat() {
+	h.inCombat.Store(false)
+	h.ap.Store(0)
+	h.maxAP.Store(0)
+	h.reactions.Store(0)
+	h.maxReactions.Store(0)
+}
+
+// SetTitle sets the achievement title shown after the character name in the prompt.
+//
+// Postcondition: Subsequent Prompt calls include title; "" removes it.
+func (h *RoomModeHandler) SetTitle(title string) {
+	h.title.Store(title)
 }
 
 // HandleInput handles empty-line prompt redraw. REQ-IMR-14.
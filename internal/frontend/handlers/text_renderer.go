@@ -80,6 +80,15 @@ func RenderRoomView(rv *gamev1.RoomView, width int, maxLines int, dt gameserver.
 		}
 	}
 
+	// Combat under way — who is fighting, and how to get in.
+	if rv.CombatRound > 0 {
+		lines = append(lines, telnet.Colorf(telnet.BrightRed, "Combat in progress (round %d): %s — type join to fight.",
+			rv.CombatRound, strings.Join(rv.Combatants, ", ")))
+	}
+	if len(rv.RoomEffects) > 0 {
+		lines = append(lines, telnet.Colorf(telnet.Yellow, "On the floor: %s", strings.Join(rv.RoomEffects, ", ")))
+	}
+
 	// Exits — 4 per row with "Exits: " label inline on the first row.
 	if len(rv.Exits) > 0 {
 		lines = append(lines, renderExits(rv.Exits, width)...)
@@ -95,6 +104,11 @@ func RenderRoomView(rv *gamev1.RoomView, width int, maxLines int, dt gameserver.
 		lines = append(lines, renderEquipment(rv.Equipment, width)...)
 	}
 
+	// Vehicles — one line, each with its condition and free seats.
+	if len(rv.Vehicles) > 0 {
+		lines = append(lines, telnet.Colorf(telnet.Cyan, "Vehicles: %s", strings.Join(vehicleLabels(rv.Vehicles), ", ")))
+	}
+
 	// NPCs present — 2 per row with "NPCs:  " label inline on the first row.
 	if len(rv.Npcs) > 0 {
 		lines = append(lines, renderNPCs(rv.Npcs, width)...)
@@ -113,6 +127,17 @@ func RenderRoomView(rv *gamev1.RoomView, width int, maxLines int, dt gameserver.
 	return b.String()
 }
 
+// vehicleLabels formats each vehicle as "Name (condition, n/seats aboard)".
+//
+// Postcondition: Returns one label per vehicle, in order.
+func vehicleLabels(vehicles []*gamev1.VehicleInfo) []string {
+	labels := make([]string, 0, len(vehicles))
+	for _, v := range vehicles {
+		labels = append(labels, fmt.Sprintf("%s (%s, %d/%d aboard)", v.Name, v.Condition, v.Passengers, v.Seats))
+	}
+	return labels
+}
+
 // npcHealthColor returns the ANSI color escape for an NPC health description.
 //
 // Postcondition: Returns a non-empty ANSI escape string.
@@ -124,9 +149,9 @@ func npcHealthColor(desc string) string {
 		return telnet.BrightGreen
 	case "moderately wounded":
 		return telnet.Yellow
-	case "heavily wounded":
+	case "bloodied":
 		return telnet.BrightRed
-	case "critically wounded":
+	case "near death":
 		return telnet.Red
 	default:
 		return telnet.White
@@ -245,6 +270,9 @@ func renderNPCs(npcs []*gamev1.NpcInfo, width int) []string {
 			if tag != "" {
 				// Non-combat NPC: show type tag only, no health
 				entry = fmt.Sprintf("%s %s%s%s", nameStr, telnet.Cyan, tag, telnet.Reset)
+			} else if n.HealthDescription == "" {
+				// Unidentified NPC (e.g. in a dark room): name only
+				entry = nameStr
 			} else {
 				// Combat NPC: show health
 				healthColor := npcHealthColor(n.HealthDescription)
@@ -350,6 +378,35 @@ func renderExits(exits []*gamev1.ExitInfo, width int) []string {
 }
 
 
+// RenderRoomPeek formats a RoomPeekView as Telnet text for "look <direction>".
+//
+// Precondition: rp is non-nil.
+// Postcondition: Returns the room title, brief description, and any occupants.
+func RenderRoomPeek(rp *gamev1.RoomPeekView) string {
+	var b strings.Builder
+	b.WriteString(telnet.Colorf(telnet.BrightCyan, "Looking %s: ", rp.Direction))
+	b.WriteString(telnet.Colorize(telnet.BrightWhite, rp.Title))
+	b.WriteString("\r\n")
+	if rp.Description != "" {
+		b.WriteString(rp.Description)
+		b.WriteString("\r\n")
+	}
+	var occupants []string
+	occupants = append(occupants, rp.Players...)
+	for _, n := range rp.Npcs {
+		if n.HealthDescription != "" && npcTypeTag(n.NpcType) == "" {
+			occupants = append(occupants, fmt.Sprintf("%s (%s)", n.Name, n.HealthDescription))
+		} else {
+			occupants = append(occupants, n.Name)
+		}
+	}
+	if len(occupants) > 0 {
+		b.WriteString(telnet.Colorf(telnet.Yellow, "You can see: %s", strings.Join(occupants, ", ")))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
 // RenderNpcView formats an NpcView as Telnet text for the examine command.
 func RenderNpcView(nv *gamev1.NpcView) string {
 	var b strings.Builder
@@ -418,8 +475,19 @@ func RenderPlayerList(pl *gamev1.PlayerList) string {
 	sb.WriteString(telnet.Colorize(telnet.BrightWhite, "Players here:\r\n"))
 	for _, p := range pl.Players {
 		status := statusLabel(p.Status)
-		sb.WriteString(fmt.Sprintf("  %s%s%s — Lvl %d %s — %s — %s\r\n",
-			telnet.Green, p.Name, telnet.Reset,
+		tag := ""
+		if p.ClanTag != "" {
+			tag = telnet.Colorf(telnet.Cyan, "[%s] ", p.ClanTag)
+		}
+		title := ""
+		if p.Title != "" {
+			title = " " + telnet.Colorize(telnet.BrightYellow, p.Title)
+		}
+		if p.Afk {
+			title += " " + telnet.Colorize(telnet.Dim, "[AFK]")
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s%s%s%s — Lvl %d %s — %s — %s\r\n",
+			tag, telnet.Green, p.Name, telnet.Reset, title,
 			p.Level, p.Job,
 			p.HealthLabel,
 			status))
@@ -566,7 +634,18 @@ func RenderCharacterInfo(ci *gamev1.CharacterInfo) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("  %s%s%s\r\n", telnet.BrightWhite, ci.Name, telnet.Reset))
 	sb.WriteString(fmt.Sprintf("  Region: %s   Job: %s   Level: %d\r\n", ci.Region, ci.Class, ci.Level))
-	sb.WriteString(fmt.Sprintf("  HP: %d/%d\r\n", ci.CurrentHp, ci.MaxHp))
+	if ci.HealthDescription != "" {
+		sb.WriteString(fmt.Sprintf("  Condition: %s\r\n", ci.HealthDescription))
+	} else {
+		sb.WriteString(fmt.Sprintf("  HP: %d/%d\r\n", ci.CurrentHp, ci.MaxHp))
+	}
+	if ci.Afk {
+		afk := "  AFK"
+		if ci.AfkMessage != "" {
+			afk += ": " + ci.AfkMessage
+		}
+		sb.WriteString(telnet.Colorize(telnet.Dim, afk) + "\r\n")
+	}
 	return sb.String()
 }
 
@@ -792,7 +871,11 @@ func RenderCharacterSheet(csv *gamev1.CharacterSheetView, width int) string {
 	// ── Left column: header, stats, armor, currency, skills ──────────────────
 	var left []sheetLine
 
-	left = append(left, sl(telnet.Colorf(telnet.BrightYellow, "=== %s ===", csv.GetName())))
+	header := csv.GetName()
+	if csv.GetTitle() != "" {
+		header += " " + csv.GetTitle()
+	}
+	left = append(left, sl(telnet.Colorf(telnet.BrightYellow, "=== %s ===", header)))
 	left = append(left, slPlain(fmt.Sprintf("Job: %s  Archetype: %s", csv.GetJob(), csv.GetArchetype())))
 	left = append(left, slPlain(fmt.Sprintf("Team: %s  Level: %d", csv.GetTeam(), csv.GetLevel())))
 	left = append(left, slPlain(fmt.Sprintf("Gender: %s", displayGender(csv.GetGender()))))
@@ -1085,7 +1168,8 @@ func RenderCharacterSheet(csv *gamev1.CharacterSheetView, width int) string {
 		for _, e := range profs {
 			rankLabel := fmt.Sprintf("[%s]", e.GetRank())
 			bonusLabel := fmt.Sprintf("+%d", e.GetBonus())
-			visPlain := fmt.Sprintf("  %-18s %-12s %s", e.GetName(), rankLabel, bonusLabel)
+			practice := practiceLabel(e)
+			visPlain := fmt.Sprintf("  %-18s %-12s %s%s", e.GetName(), rankLabel, bonusLabel, practice)
 			coloredRank := proficiencyColor(e.GetRank())
 			// Bonus uses the same color as the rank label so both match.
 			colorCode := proficiencyColorCode(e.GetRank())
@@ -1095,7 +1179,7 @@ func RenderCharacterSheet(csv *gamev1.CharacterSheetView, width int) string {
 			} else {
 				coloredBonus = bonusLabel
 			}
-			text := fmt.Sprintf("  %-18s [%s] %s", e.GetName(), coloredRank, coloredBonus)
+			text := fmt.Sprintf("  %-18s [%s] %s%s", e.GetName(), coloredRank, coloredBonus, practice)
 			right = append(right, sheetLine{text: text, visW: len(visPlain)})
 		}
 	}
@@ -1563,29 +1647,30 @@ func RenderMap(resp *gamev1.MapResponse, width int) string {
 }
 
 func RenderCombatEvent(ce *gamev1.CombatEvent) string {
+	narrative := CombatNarrativeForRecipient(ce)
 	switch ce.Type {
 	case gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK:
 		switch ce.Outcome {
 		case "critical success":
 			// Crit hit: bright yellow with emphasis
-			return telnet.Colorf(telnet.BrightYellow, "[Combat] %s", ce.Narrative)
+			return telnet.Colorf(telnet.BrightYellow, "[Combat] %s", narrative)
 		case "critical failure":
 			// Crit miss: bright red with emphasis
-			return telnet.Colorf(telnet.BrightRed, "[Combat] %s", ce.Narrative)
+			return telnet.Colorf(telnet.BrightRed, "[Combat] %s", narrative)
 		default:
 			if ce.Damage > 0 {
-				return telnet.Colorf(telnet.Red, "[Combat] %s", ce.Narrative)
+				return telnet.Colorf(telnet.Red, "[Combat] %s", narrative)
 			}
-			return telnet.Colorf(telnet.BrightWhite, "[Combat] %s", ce.Narrative)
+			return telnet.Colorf(telnet.BrightWhite, "[Combat] %s", narrative)
 		}
 	case gamev1.CombatEventType_COMBAT_EVENT_TYPE_DEATH:
-		return telnet.Colorf(telnet.Red, "[Combat] %s", ce.Narrative)
+		return telnet.Colorf(telnet.Red, "[Combat] %s", narrative)
 	case gamev1.CombatEventType_COMBAT_EVENT_TYPE_FLEE:
-		return telnet.Colorf(telnet.Yellow, "[Combat] %s", ce.Narrative)
+		return telnet.Colorf(telnet.Yellow, "[Combat] %s", narrative)
 	case gamev1.CombatEventType_COMBAT_EVENT_TYPE_END:
-		return telnet.Colorf(telnet.BrightYellow, "[Combat] %s", ce.Narrative)
+		return telnet.Colorf(telnet.BrightYellow, "[Combat] %s", narrative)
 	default:
-		return telnet.Colorf(telnet.White, "[Combat] %s", ce.Narrative)
+		return telnet.Colorf(telnet.White, "[Combat] %s", narrative)
 	}
 }
 
@@ -1778,7 +1863,7 @@ func RenderProficienciesResponse(pr *gamev1.ProficienciesResponse) string {
 		if e.Kind != "weapon" {
 			continue
 		}
-		line := fmt.Sprintf("  %-18s %-12s +%d", e.Name, "["+e.Rank+"]", e.Bonus)
+		line := fmt.Sprintf("  %-18s %-12s +%d%s", e.Name, "["+e.Rank+"]", e.Bonus, practiceLabel(e))
 		if e.Rank != "untrained" {
 			sb.WriteString(telnet.Colorize(telnet.Cyan, line))
 		} else {
@@ -1790,6 +1875,15 @@ func RenderProficienciesResponse(pr *gamev1.ProficienciesResponse) string {
 	return sb.String()
 }
 
+// practiceLabel returns the " (points/next practice)" suffix for a weapon
+// proficiency entry that use can still advance, or "" otherwise.
+func practiceLabel(e *gamev1.ProficiencyEntry) string {
+	if e.GetPracticeNext() <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%d/%d practice)", e.GetPractice(), e.GetPracticeNext())
+}
+
 // displayGender formats a stored gender value for display.
 // Strips the "custom:" prefix and capitalizes the first letter.
 // Returns the value unchanged if empty.
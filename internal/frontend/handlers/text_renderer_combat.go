@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
 
 // combatLogDisplayLines is the maximum number of log lines shown on screen.
@@ -232,6 +234,51 @@ func RenderRosterRow(c CombatantState, width int) string {
 	return row
 }
 
+// RenderCombatState renders a compact status block: a round header followed by
+// one line per combatant in initiative order.
+// Format:   18 Name         ●●○ 2/3 +5thp shield 2 [cond1,cond2] cooldown: Brace (1)
+// AP is shown for allies only; fallen combatants are marked "down". Temporary HP
+// and a raised shield's remaining block appear only while non-zero, as do job
+// abilities still recharging.
+// Every line MUST NOT exceed width visible characters.
+func RenderCombatState(cs *gamev1.CombatStateEvent, width int) string {
+	if width < 40 {
+		width = 40
+	}
+
+	var sb strings.Builder
+	sb.WriteString(truncateLine(fmt.Sprintf("-- Round %d: turn order --", cs.GetRound()), width))
+	sb.WriteString("\r\n")
+	for _, c := range cs.GetCombatants() {
+		nameField := truncateStr(c.GetName(), 12)
+		nameField = nameField + strings.Repeat(" ", 12-len([]rune(nameField)))
+		row := fmt.Sprintf("%4d %s", c.GetInitiative(), nameField)
+		switch {
+		case c.GetDead():
+			row += " down"
+		case c.GetIsPlayer() && c.GetApTotal() > 0:
+			row += fmt.Sprintf(" %s %d/%d", apDots(int(c.GetApRemaining()), int(c.GetApTotal())), c.GetApRemaining(), c.GetApTotal())
+		}
+		if !c.GetDead() {
+			if c.GetTempHp() > 0 {
+				row += fmt.Sprintf(" +%dthp", c.GetTempHp())
+			}
+			if c.GetShield() > 0 {
+				row += fmt.Sprintf(" shield %d", c.GetShield())
+			}
+		}
+		if len(c.GetConditions()) > 0 && !c.GetDead() {
+			row += " [" + strings.Join(c.GetConditions(), ",") + "]"
+		}
+		if len(c.GetCooldowns()) > 0 && !c.GetDead() {
+			row += " cooldown: " + strings.Join(c.GetCooldowns(), ", ")
+		}
+		sb.WriteString(truncateLine(row, width))
+		sb.WriteString("\r\n")
+	}
+	return sb.String()
+}
+
 // RenderCombatSummary renders a post-combat summary display.
 func RenderCombatSummary(summaryText string, width int) string {
 	if width < 40 {
@@ -4,7 +4,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"pgregory.net/rapid"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
 
 func TestRenderBattlefield_FitsWidth(t *testing.T) {
@@ -172,6 +175,81 @@ func buildSnapFromNames(names []string, playerName string) CombatRenderSnapshot
 	}
 }
 
+func TestRenderCombatState_ShowsOrderAllyAPAndConditions(t *testing.T) {
+	out := RenderCombatState(&gamev1.CombatStateEvent{
+		Round: 3,
+		Combatants: []*gamev1.CombatantStatus{
+			{Name: "Rook", IsPlayer: true, Initiative: 18, ApRemaining: 1, ApTotal: 3, Conditions: []string{"prone"}},
+			{Name: "Ganger", Initiative: 12, Conditions: []string{"frightened 1"}},
+			{Name: "Lookout", Initiative: 7, Dead: true},
+		},
+	}, 80)
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	if assert.Len(t, lines, 4) {
+		assert.Equal(t, "-- Round 3: turn order --", lines[0])
+		assert.Equal(t, "  18 Rook         ●○○ 1/3 [prone]", lines[1])
+		assert.Equal(t, "  12 Ganger       [frightened 1]", lines[2])
+		assert.Equal(t, "   7 Lookout      down", lines[3])
+	}
+}
+
+func TestRenderCombatState_ShowsTempHPAndShield(t *testing.T) {
+	out := RenderCombatState(&gamev1.CombatStateEvent{
+		Round: 1,
+		Combatants: []*gamev1.CombatantStatus{
+			{Name: "Rook", IsPlayer: true, Initiative: 18, ApRemaining: 2, ApTotal: 3, TempHp: 5, Shield: 2},
+			{Name: "Lookout", Initiative: 7, Dead: true, TempHp: 3},
+		},
+	}, 80)
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	if assert.Len(t, lines, 3) {
+		assert.Equal(t, "  18 Rook         ●●○ 2/3 +5thp shield 2", lines[1])
+		assert.Equal(t, "   7 Lookout      down", lines[2])
+	}
+}
+
+func TestRenderCombatState_ShowsCooldowns(t *testing.T) {
+	out := RenderCombatState(&gamev1.CombatStateEvent{
+		Round: 2,
+		Combatants: []*gamev1.CombatantStatus{
+			{Name: "Rook", IsPlayer: true, Initiative: 18, ApRemaining: 1, ApTotal: 3, Conditions: []string{"Inspired"}, Cooldowns: []string{"Haymaker (3)", "War Cry (1)"}},
+		},
+	}, 80)
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	if assert.Len(t, lines, 2) {
+		assert.Equal(t, "  18 Rook         ●○○ 1/3 [Inspired] cooldown: Haymaker (3), War Cry (1)", lines[1])
+	}
+}
+
+func TestRenderCombatState_FitsWidth(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		width := rapid.IntRange(40, 200).Draw(t, "width")
+		n := rapid.IntRange(0, 6).Draw(t, "n")
+		cs := &gamev1.CombatStateEvent{Round: int32(rapid.IntRange(1, 99).Draw(t, "round"))}
+		for i := 0; i < n; i++ {
+			cs.Combatants = append(cs.Combatants, &gamev1.CombatantStatus{
+				Name:        rapid.StringMatching(`[A-Za-z ]{1,20}`).Draw(t, "name"),
+				IsPlayer:    rapid.Bool().Draw(t, "player"),
+				Initiative:  int32(rapid.IntRange(-5, 40).Draw(t, "init")),
+				ApRemaining: int32(rapid.IntRange(0, 6).Draw(t, "ap")),
+				ApTotal:     int32(rapid.IntRange(0, 6).Draw(t, "maxAP")),
+				Conditions:  rapid.SliceOfN(rapid.StringMatching(`[a-z]{3,12}`), 0, 6).Draw(t, "conds"),
+				Cooldowns:   rapid.SliceOfN(rapid.StringMatching(`[A-Za-z]{3,12} \([1-9]\)`), 0, 3).Draw(t, "cooldowns"),
+			})
+		}
+		out := RenderCombatState(cs, width)
+		lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+		if len(lines) != n+1 {
+			t.Fatalf("got %d lines, want %d", len(lines), n+1)
+		}
+		for _, line := range lines {
+			if visibleLen(line) > width {
+				t.Fatalf("line %q exceeds width %d", line, width)
+			}
+		}
+	})
+}
+
 func dedupNames(names []string) []string {
 	seen := map[string]bool{}
 	out := []string{}
@@ -38,6 +38,17 @@ func TestRenderRoomView(t *testing.T) {
 	assert.Contains(t, stripped, "Bob")
 }
 
+func TestRenderRoomView_Vehicles(t *testing.T) {
+	rv := &gamev1.RoomView{
+		Title: "Gas Station",
+		Vehicles: []*gamev1.VehicleInfo{
+			{InstanceId: "rusted_sedan-1", Name: "Rusted Sedan", Kind: "car", Condition: "dented", Passengers: 1, Seats: 4},
+		},
+	}
+	stripped := telnet.StripANSI(RenderRoomView(rv, 80, 0, testDT, ""))
+	assert.Contains(t, stripped, "Vehicles: Rusted Sedan (dented, 1/4 aboard)")
+}
+
 func TestRenderRoomView_ExitsLabelInline(t *testing.T) {
 	rv := &gamev1.RoomView{
 		Title: "Test Room",
@@ -177,6 +188,28 @@ func TestRenderPlayerList(t *testing.T) {
 	assert.Contains(t, stripped, "Bob")
 }
 
+func TestRenderPlayerList_ShowsTitle(t *testing.T) {
+	pl := &gamev1.PlayerList{
+		Players: []*gamev1.PlayerInfo{
+			{Name: "Alice", Level: 2, Job: "Striker (Melee)", HealthLabel: "Healthy", ClanTag: "RR", Title: "the Cartographer"},
+		},
+	}
+	stripped := telnet.StripANSI(RenderPlayerList(pl))
+	assert.Contains(t, stripped, "[RR] Alice the Cartographer — Lvl 2")
+}
+
+func TestRenderPlayerList_ShowsAFK(t *testing.T) {
+	pl := &gamev1.PlayerList{
+		Players: []*gamev1.PlayerInfo{
+			{Name: "Alice", Level: 2, Job: "Striker (Melee)", HealthLabel: "Healthy", Afk: true},
+			{Name: "Bob", Level: 1, Job: "Gunner", HealthLabel: "Healthy"},
+		},
+	}
+	stripped := telnet.StripANSI(RenderPlayerList(pl))
+	assert.Contains(t, stripped, "Alice [AFK] — Lvl 2")
+	assert.Contains(t, stripped, "Bob — Lvl 1")
+}
+
 func TestRenderPlayerList_Empty(t *testing.T) {
 	pl := &gamev1.PlayerList{}
 	stripped := telnet.StripANSI(RenderPlayerList(pl))
@@ -355,6 +388,28 @@ func TestRenderCharacterInfo(t *testing.T) {
 	if !strings.Contains(got, "Gunner") {
 		t.Errorf("expected 'Gunner' in %q", got)
 	}
+	if strings.Contains(got, "AFK") {
+		t.Errorf("expected no AFK line in %q", got)
+	}
+
+	ci.Afk = true
+	ci.AfkMessage = "back in 5"
+	got = telnet.StripANSI(RenderCharacterInfo(ci))
+	if !strings.Contains(got, "AFK: back in 5") {
+		t.Errorf("expected 'AFK: back in 5' in %q", got)
+	}
+}
+
+func TestRenderCharacterInfo_HealthDescriptionReplacesHP(t *testing.T) {
+	ci := &gamev1.CharacterInfo{Name: "Hero", CurrentHp: 5, MaxHp: 20}
+	if got := RenderCharacterInfo(ci); !strings.Contains(got, "HP: 5/20") {
+		t.Errorf("expected raw HP without a descriptor in %q", got)
+	}
+	ci.HealthDescription = "bloodied"
+	got := RenderCharacterInfo(ci)
+	if !strings.Contains(got, "Condition: bloodied") || strings.Contains(got, "5/20") {
+		t.Errorf("expected only the descriptor in %q", got)
+	}
 }
 
 func TestRenderRoomView_WithTimeFields_DescriptionPreserved(t *testing.T) {
@@ -1627,3 +1682,48 @@ func TestRenderNPCs_TechTrainerShowsRoleTag(t *testing.T) {
 	assert.Contains(t, stripped, "[tech trainer]", "tech_trainer NPC must show [tech trainer] role tag")
 	assert.NotContains(t, stripped, "(unharmed)", "tech_trainer NPC must not show health status")
 }
+
+func TestRenderRoomPeek(t *testing.T) {
+	out := telnet.StripANSI(RenderRoomPeek(&gamev1.RoomPeekView{
+		Direction:   "north",
+		Title:       "Rust Alley",
+		Description: "A narrow alley choked with scrap.",
+		Players:     []string{"Rook"},
+		Npcs: []*gamev1.NpcInfo{
+			{Name: "Ganger", HealthDescription: "bloodied", NpcType: "combat"},
+			{Name: "Vera", HealthDescription: "unharmed", NpcType: "merchant"},
+		},
+	}))
+	assert.Contains(t, out, "Looking north: Rust Alley")
+	assert.Contains(t, out, "A narrow alley choked with scrap.")
+	assert.Contains(t, out, "You can see: Rook, Ganger (bloodied), Vera")
+
+	empty := telnet.StripANSI(RenderRoomPeek(&gamev1.RoomPeekView{Direction: "up", Title: "Roof"}))
+	assert.NotContains(t, empty, "You can see")
+}
+
+func TestRenderRoomView_CombatInProgress(t *testing.T) {
+	rv := &gamev1.RoomView{Title: "Alley", CombatRound: 3, Combatants: []string{"Hero", "Goblin"}}
+	stripped := telnet.StripANSI(RenderRoomView(rv, 80, 0, testDT, ""))
+	assert.Contains(t, stripped, "Combat in progress (round 3): Hero, Goblin")
+	assert.Contains(t, stripped, "type join to fight")
+
+	rv.CombatRound = 0
+	assert.NotContains(t, telnet.StripANSI(RenderRoomView(rv, 80, 0, testDT, "")), "Combat in progress")
+}
+
+func TestRenderRoomView_RoomEffectsOnFloor(t *testing.T) {
+	rv := &gamev1.RoomView{Title: "Alley", CombatRound: 2, Combatants: []string{"Hero"},
+		RoomEffects: []string{"burning ground (2 rounds)"}}
+	stripped := telnet.StripANSI(RenderRoomView(rv, 80, 0, testDT, ""))
+	assert.Contains(t, stripped, "On the floor: burning ground (2 rounds)")
+}
+
+func TestRenderProficienciesResponse_ShowsWeaponPractice(t *testing.T) {
+	out := RenderProficienciesResponse(&gamev1.ProficienciesResponse{Proficiencies: []*gamev1.ProficiencyEntry{
+		{Name: "Simple Weapons", Kind: "weapon", Rank: "untrained", Practice: 12, PracticeNext: 40},
+		{Name: "Unarmed", Kind: "weapon", Rank: "master", Bonus: 9},
+	}})
+	assert.Contains(t, out, "(12/40 practice)")
+	assert.Equal(t, 1, strings.Count(out, "practice)"), "a rank use can't advance shows no practice")
+}
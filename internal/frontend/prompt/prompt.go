@@ -0,0 +1,151 @@
+// Package prompt expands player-defined prompt formats such as
+// "%hp/%maxhp %ap %time> " against the vitals a frontend tracks.
+package prompt
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/settings"
+)
+
+// Vitals are the values a prompt format may show.
+type Vitals struct {
+	Name  string
+	Title string
+	HP    int32
+	MaxHP int32
+	FP    int32
+	MaxFP int32
+	// InCombat reports whether the AP and reaction fields are current; outside
+	// combat their tokens expand to "-".
+	InCombat     bool
+	AP           int32
+	MaxAP        int32
+	Reactions    int32
+	MaxReactions int32
+	Room         string
+	Hour         int32
+	Period       string
+	Conditions   []string
+}
+
+// token describes one %-token.
+type token struct {
+	help   string
+	expand func(v Vitals) string
+}
+
+// tokens maps each token name, without its leading %, to its expansion.
+var tokens = map[string]token{
+	"name":     {"character name", func(v Vitals) string { return v.Name }},
+	"title":    {"achievement title", func(v Vitals) string { return v.Title }},
+	"hp":       {"current hit points", func(v Vitals) string { return itoa(v.HP) }},
+	"maxhp":    {"maximum hit points", func(v Vitals) string { return itoa(v.MaxHP) }},
+	"hpp":      {"hit points as a percentage", hpPercent},
+	"fp":       {"current focus points", func(v Vitals) string { return itoa(v.FP) }},
+	"maxfp":    {"maximum focus points", func(v Vitals) string { return itoa(v.MaxFP) }},
+	"ap":       {"action points left this round", func(v Vitals) string { return combatOnly(v, v.AP) }},
+	"maxap":    {"action points per round", func(v Vitals) string { return combatOnly(v, v.MaxAP) }},
+	"react":    {"reactions left this round", func(v Vitals) string { return combatOnly(v, v.Reactions) }},
+	"maxreact": {"reactions per round", func(v Vitals) string { return combatOnly(v, v.MaxReactions) }},
+	"room":     {"room name", func(v Vitals) string { return v.Room }},
+	"time":     {"game time of day", func(v Vitals) string { return fmt.Sprintf("%02d:00", v.Hour) }},
+	"period":   {"period of the day", func(v Vitals) string { return v.Period }},
+	"cond":     {"active conditions", func(v Vitals) string { return strings.Join(v.Conditions, ",") }},
+}
+
+func itoa(n int32) string { return strconv.Itoa(int(n)) }
+
+func hpPercent(v Vitals) string {
+	if v.MaxHP <= 0 {
+		return "0%"
+	}
+	return strconv.Itoa(int(v.HP*100/v.MaxHP)) + "%"
+}
+
+func combatOnly(v Vitals, n int32) string {
+	if !v.InCombat {
+		return "-"
+	}
+	return itoa(n)
+}
+
+// Help lists every token with a short description, one per line, sorted.
+func Help() []string {
+	names := make([]string, 0, len(tokens))
+	for name := range tokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lines := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("%%%-9s %s", name, tokens[name].help))
+	}
+	return append(lines, "%%         a literal %")
+}
+
+// Validate reports the first problem with format: one the settings store
+// would reject, or an unknown token.
+//
+// Postcondition: Returns nil when Expand would replace every token in format.
+func Validate(format string) error {
+	if !settings.IsValidPromptFormat(format) {
+		return fmt.Errorf("a prompt may hold at most %d printable characters", settings.MaxPromptFormatLength)
+	}
+	var unknown error
+	scan(format, func(name string) string {
+		if _, ok := tokens[name]; !ok && unknown == nil {
+			unknown = fmt.Errorf("unknown prompt token %%%s; type 'prompt' to list tokens", name)
+		}
+		return ""
+	})
+	return unknown
+}
+
+// Expand replaces each token in format with its value from v. Unknown tokens
+// are left as written.
+//
+// Postcondition: The result ends with a space so input does not run into it.
+func Expand(format string, v Vitals) string {
+	out := scan(format, func(name string) string {
+		if t, ok := tokens[name]; ok {
+			return t.expand(v)
+		}
+		return "%" + name
+	})
+	if !strings.HasSuffix(out, " ") {
+		out += " "
+	}
+	return out
+}
+
+// scan copies format, calling replace for each %-token name (the letters
+// after %) and writing what it returns. "%%" writes a single %.
+func scan(format string, replace func(name string) string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '%' {
+			b.WriteByte('%')
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(format) && format[j] >= 'a' && format[j] <= 'z' {
+			j++
+		}
+		if j == i+1 {
+			b.WriteByte('%')
+			continue
+		}
+		b.WriteString(replace(format[i+1 : j]))
+		i = j - 1
+	}
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package prompt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestExpand_ReplacesTokens(t *testing.T) {
+	v := Vitals{
+		Name: "Rook", HP: 12, MaxHP: 20,
+		InCombat: true, AP: 2, MaxAP: 3, Reactions: 1, MaxReactions: 1,
+		Room: "Rust Alley", Hour: 6, Period: "Dawn",
+		Conditions: []string{"prone", "dazed"},
+	}
+	assert.Equal(t, "12/20 2/3 06:00> ", Expand("%hp/%maxhp %ap/%maxap %time> ", v))
+	assert.Equal(t, "[Rook] Rust Alley 60% r1 prone,dazed Dawn ", Expand("[%name] %room %hpp r%react %cond %period", v))
+	assert.Equal(t, "100% ", Expand("100%%", v))
+}
+
+func TestExpand_CombatTokensOutsideCombat(t *testing.T) {
+	assert.Equal(t, "AP -/- R - ", Expand("AP %ap/%maxap R %react", Vitals{AP: 3, MaxAP: 3}))
+}
+
+func TestExpand_LeavesUnknownTokensAndStrayPercent(t *testing.T) {
+	assert.Equal(t, "%mana 5% ", Expand("%mana 5%", Vitals{}))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("%hp/%maxhp %ap %time> "))
+	assert.NoError(t, Validate("50%% > "))
+	err := Validate("%hp %mana> ")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "%mana")
+	assert.Error(t, Validate(strings.Repeat("x", 500)))
+	assert.Error(t, Validate("bad\x1b[31m"))
+}
+
+func TestHelp_ListsEveryToken(t *testing.T) {
+	help := strings.Join(Help(), "\n")
+	for name := range tokens {
+		assert.Contains(t, help, "%"+name)
+	}
+}
+
+// TestProperty_ExpandWithoutTokensIsIdentity verifies text without % is
+// copied unchanged apart from the trailing space.
+func TestProperty_ExpandWithoutTokensIsIdentity(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		format := rapid.StringMatching(`[a-zA-Z0-9 <>\[\]/:|-]{0,40}`).Draw(rt, "format")
+		got := Expand(format, Vitals{HP: 5})
+		if strings.TrimSuffix(got, " ") != strings.TrimSuffix(format, " ") || !strings.HasSuffix(got, " ") {
+			rt.Fatalf("Expand(%q) = %q", format, got)
+		}
+		if err := Validate(format); err != nil {
+			rt.Fatalf("Validate(%q) = %v", format, err)
+		}
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/siteban"
 )
 
 // SessionHandler processes a connected Telnet session.
@@ -31,6 +32,12 @@ type Acceptor struct {
 	quit     chan struct{}
 	mu       sync.Mutex
 	running  bool
+
+	// connsMu guards connsPerIP, the open connection count per source host.
+	connsMu    sync.Mutex
+	connsPerIP map[string]int
+	// siteBans refuses banned addresses at accept time; nil allows everyone.
+	siteBans *siteban.List
 }
 
 // NewAcceptor creates a Telnet acceptor with the given configuration.
@@ -70,6 +77,14 @@ func NewHeadlessAcceptor(cfg config.TelnetConfig, handler SessionHandler, logger
 	}
 }
 
+// SetSiteBans makes the acceptor refuse connections from addresses l blocks.
+//
+// Precondition: MUST be called before ListenAndServe.
+// Postcondition: l may be nil to allow every address.
+func (a *Acceptor) SetSiteBans(l *siteban.List) {
+	a.siteBans = l
+}
+
 // Handler returns the SessionHandler used by this acceptor.
 //
 // Postcondition: Returns the non-nil handler configured at construction.
@@ -127,6 +142,32 @@ func (a *Acceptor) handleConn(raw net.Conn) {
 		zap.String("remote_addr", addr),
 	)
 
+	if ip, ok := siteban.AddrOf(raw.RemoteAddr()); ok {
+		d, err := a.siteBans.Check(context.Background(), ip)
+		if err != nil {
+			a.logger.Warn("site ban check failed", zap.String("remote_addr", addr), zap.Error(err))
+		}
+		if d.Blocked {
+			a.logger.Info("refused banned client",
+				zap.String("remote_addr", addr),
+				zap.String("reason", d.Reason),
+			)
+			refuse(raw, d.Message())
+			return
+		}
+	}
+
+	host := remoteHost(raw.RemoteAddr())
+	if !a.admit(host) {
+		a.logger.Warn("connection limit reached",
+			zap.String("remote_addr", addr),
+			zap.Int("max_conns_per_ip", a.cfg.MaxConnsPerIP),
+		)
+		refuse(raw, "Too many connections from your address. Try again later.")
+		return
+	}
+	defer a.release(host)
+
 	var conn *Conn
 	if a.headless {
 		conn = NewHeadlessConn(raw, a.cfg.ReadTimeout, a.cfg.WriteTimeout)
@@ -148,6 +189,10 @@ func (a *Acceptor) handleConn(raw net.Conn) {
 	// flow can render without a pre-established scroll region.
 	conn.AwaitNAWS(time.Second)
 
+	// Reap dead and half-open clients promptly instead of at the read timeout.
+	stopKeepalive := conn.StartKeepalive(a.cfg.KeepaliveInterval, a.cfg.KeepaliveTimeout)
+	defer stopKeepalive()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -174,6 +219,56 @@ func (a *Acceptor) handleConn(raw net.Conn) {
 	}
 }
 
+// refuse writes msg to a connection that will not be served and closes it.
+func refuse(raw net.Conn, msg string) {
+	_ = raw.SetWriteDeadline(time.Now().Add(time.Second))
+	_, _ = raw.Write([]byte(msg + "\r\n"))
+	_ = raw.Close()
+}
+
+// remoteHost returns the host part of addr, or the whole address when it has no port.
+func remoteHost(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
+
+// admit reserves a connection slot for host.
+//
+// Postcondition: Returns false, reserving nothing, when host already holds
+// MaxConnsPerIP connections. The headless acceptor admits everything.
+func (a *Acceptor) admit(host string) bool {
+	if a.headless || a.cfg.MaxConnsPerIP <= 0 {
+		return true
+	}
+	a.connsMu.Lock()
+	defer a.connsMu.Unlock()
+	if a.connsPerIP[host] >= a.cfg.MaxConnsPerIP {
+		return false
+	}
+	if a.connsPerIP == nil {
+		a.connsPerIP = make(map[string]int)
+	}
+	a.connsPerIP[host]++
+	return true
+}
+
+// release frees the slot admit reserved for host.
+func (a *Acceptor) release(host string) {
+	if a.headless || a.cfg.MaxConnsPerIP <= 0 {
+		return
+	}
+	a.connsMu.Lock()
+	defer a.connsMu.Unlock()
+	if a.connsPerIP[host] <= 1 {
+		delete(a.connsPerIP, host)
+		return
+	}
+	a.connsPerIP[host]--
+}
+
 // Stop gracefully stops the acceptor, closing the listener and waiting
 // for all active sessions to finish.
 //
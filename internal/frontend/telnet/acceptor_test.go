@@ -3,6 +3,7 @@ package telnet
 import (
 	"context"
 	"net"
+	"net/netip"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/siteban"
 )
 
 // echoHandler is a test SessionHandler that echoes lines back to the client.
@@ -190,3 +192,77 @@ func TestAcceptorMultipleClients(t *testing.T) {
 	acc.Stop()
 	assert.Equal(t, int32(numClients), handler.sessionCount.Load())
 }
+
+func TestAcceptorMaxConnsPerIP(t *testing.T) {
+	cfg := config.TelnetConfig{
+		Host:          "127.0.0.1",
+		ReadTimeout:   5 * time.Second,
+		WriteTimeout:  5 * time.Second,
+		MaxConnsPerIP: 1,
+	}
+	acc := NewAcceptor(cfg, &echoHandler{}, zaptest.NewLogger(t))
+	go func() { _ = acc.ListenAndServe() }()
+	t.Cleanup(acc.Stop)
+	require.Eventually(t, func() bool { return acc.IsRunning() && acc.Addr() != "" }, 2*time.Second, 10*time.Millisecond)
+
+	first, err := net.DialTimeout("tcp", acc.Addr(), 2*time.Second)
+	require.NoError(t, err)
+	// Telnet negotiation arrives only once the connection has been admitted.
+	buf := make([]byte, 256)
+	_ = first.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, err = first.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, IAC, buf[0])
+
+	second, err := net.DialTimeout("tcp", acc.Addr(), 2*time.Second)
+	require.NoError(t, err)
+	defer second.Close()
+	_ = second.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, _ := second.Read(buf)
+	assert.Contains(t, string(buf[:n]), "Too many connections")
+
+	require.NoError(t, first.Close())
+	require.Eventually(t, func() bool {
+		acc.connsMu.Lock()
+		defer acc.connsMu.Unlock()
+		return len(acc.connsPerIP) == 0
+	}, 7*time.Second, 20*time.Millisecond, "closing the first connection frees its slot")
+}
+
+func TestAcceptorAdmit_CountsPerHost(t *testing.T) {
+	acc := NewAcceptor(config.TelnetConfig{MaxConnsPerIP: 2}, &echoHandler{}, zaptest.NewLogger(t))
+	assert.True(t, acc.admit("10.0.0.1"))
+	assert.True(t, acc.admit("10.0.0.1"))
+	assert.False(t, acc.admit("10.0.0.1"))
+	assert.True(t, acc.admit("10.0.0.2"), "other hosts have their own limit")
+	acc.release("10.0.0.1")
+	assert.True(t, acc.admit("10.0.0.1"))
+
+	unlimited := NewAcceptor(config.TelnetConfig{}, &echoHandler{}, zaptest.NewLogger(t))
+	for i := 0; i < 10; i++ {
+		assert.True(t, unlimited.admit("10.0.0.1"))
+	}
+	headless := NewHeadlessAcceptor(config.TelnetConfig{MaxConnsPerIP: 1}, &echoHandler{}, zaptest.NewLogger(t))
+	assert.True(t, headless.admit("127.0.0.1"))
+	assert.True(t, headless.admit("127.0.0.1"))
+}
+
+func TestAcceptorRefusesSiteBannedAddress(t *testing.T) {
+	bans := siteban.NewList(nil)
+	require.NoError(t, bans.Put(context.Background(), siteban.Rule{Prefix: netip.MustParsePrefix("127.0.0.0/8"), Reason: "testing"}))
+	handler := &echoHandler{}
+	acc := NewAcceptor(config.TelnetConfig{Host: "127.0.0.1", ReadTimeout: 5 * time.Second, WriteTimeout: 5 * time.Second}, handler, zaptest.NewLogger(t))
+	acc.SetSiteBans(bans)
+	go func() { _ = acc.ListenAndServe() }()
+	t.Cleanup(acc.Stop)
+	require.Eventually(t, func() bool { return acc.IsRunning() && acc.Addr() != "" }, 2*time.Second, 10*time.Millisecond)
+
+	conn, err := net.DialTimeout("tcp", acc.Addr(), 2*time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+	buf := make([]byte, 256)
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, _ := conn.Read(buf)
+	assert.Equal(t, "Your address is banned from this site: testing\r\n", string(buf[:n]))
+	assert.Zero(t, handler.sessionCount.Load())
+}
@@ -5,7 +5,9 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
@@ -26,6 +28,7 @@ const (
 	// Telnet options
 	OptEcho            byte = 1
 	OptSuppressGoAhead byte = 3
+	OptTimingMark      byte = 6  // RFC 860; used as a keepalive probe
 	OptNAWS            byte = 31 // Negotiate About Window Size, RFC 1073
 	OptLinemode        byte = 34
 )
@@ -70,6 +73,9 @@ type Conn struct {
 	cmdHistory []string // submitted commands, max cmdHistoryMax
 	historyIdx int      // cursor; len(cmdHistory) = live (past-end)
 
+	// pager holds long output behind a --More-- prompt (guarded by mu).
+	pager pager
+
 	// TabCompleter is called with the current input prefix when the tab key (0x09)
 	// is pressed during ReadLine or ReadLineSplit. If nil, tab is silently discarded.
 	// The callback must not block indefinitely — it is called from the read goroutine.
@@ -80,6 +86,10 @@ type Conn struct {
 	// forwardServerEvents instead of the normal event path. Buffered (1) to
 	// prevent the router from blocking.
 	TabCompleteResponse chan *gamev1.TabCompleteResponse
+
+	// timingMarkAt is the UnixNano time of the client's last TIMING-MARK reply;
+	// zero until the client first answers a keepalive probe.
+	timingMarkAt atomic.Int64
 }
 
 // NewConn wraps a raw TCP connection with Telnet protocol handling.
@@ -157,6 +167,23 @@ func (c *Conn) HistoryDown() (string, bool) {
 	return c.cmdHistory[c.historyIdx], true
 }
 
+// SearchHistory returns the submitted commands containing term, ignoring
+// case, oldest first. An empty term matches every command.
+//
+// Postcondition: The returned slice is a copy; the cursor is unchanged.
+func (c *Conn) SearchHistory(term string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	term = strings.ToLower(term)
+	var out []string
+	for _, cmd := range c.cmdHistory {
+		if strings.Contains(strings.ToLower(cmd), term) {
+			out = append(out, cmd)
+		}
+	}
+	return out
+}
+
 // appendConsoleLine appends a line to the console scroll buffer, enforcing the
 // ring limit by dropping the oldest entries when at capacity.
 // When scrollOffset > 0, increments pendingNew (caller is responsible for redraw).
@@ -235,10 +262,24 @@ func (c *Conn) ResizeCh() <-chan struct{} {
 }
 
 // ReadLine reads a single line of input, filtering Telnet IAC sequences.
-// The returned line does not include the trailing \r\n.
+// The returned line does not include the trailing \r\n. Lines answering a
+// --More-- prompt are consumed by the pager.
 //
 // Postcondition: Returns the next line of text input, or an error (including io.EOF).
 func (c *Conn) ReadLine() (string, error) {
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return line, err
+		}
+		if ok, err := c.pagerInput(line); err != nil || ok {
+			return line, err
+		}
+	}
+}
+
+// readLine reads one line of input for ReadLine.
+func (c *Conn) readLine() (string, error) {
 	if c.readTimeout > 0 {
 		_ = c.raw.SetReadDeadline(time.Now().Add(c.readTimeout))
 	}
@@ -404,7 +445,10 @@ func (c *Conn) handleIAC() error {
 	switch cmd {
 	case WILL, WONT, DO, DONT:
 		// These commands have one option byte following
-		_, err := c.reader.ReadByte()
+		opt, err := c.reader.ReadByte()
+		if err == nil && opt == OptTimingMark && (cmd == WILL || cmd == WONT) {
+			c.timingMarkAt.Store(time.Now().UnixNano())
+		}
 		return err
 	case SB:
 		// Sub-negotiation: read option byte, then data until IAC SE.
@@ -530,9 +574,24 @@ func (c *Conn) RestoreEcho() error {
 // prevents the full-screen scroll that occurs when \r\n is sent at the last row.
 // inputBuf is updated on every keystroke so WriteConsole can redraw partial input.
 //
+// Lines answering a --More-- prompt are consumed by the pager.
+//
 // Postcondition: Returns the complete line (without trailing newline),
 // an arrow-key sentinel ("\x00UP" / "\x00DOWN"), or an error.
 func (c *Conn) ReadLineSplit() (string, error) {
+	for {
+		line, err := c.readLineSplit()
+		if err != nil {
+			return line, err
+		}
+		if ok, err := c.pagerInput(line); err != nil || ok {
+			return line, err
+		}
+	}
+}
+
+// readLineSplit reads one line of input for ReadLineSplit.
+func (c *Conn) readLineSplit() (string, error) {
 	if c.readTimeout > 0 {
 		_ = c.raw.SetReadDeadline(time.Now().Add(c.readTimeout))
 	}
@@ -630,9 +689,11 @@ func (c *Conn) sendEchoControl(cmd byte) error {
 // WriteLine sends a line of text followed by \r\n to the client.
 // In headless mode, ANSI escape codes are stripped before sending so that
 // automated line-oriented clients can match plain-text patterns.
+// In scrolling mode, text taller than a page shows one page and holds the
+// rest behind a --More-- prompt; lines written meanwhile queue behind it.
 //
 // Precondition: text should not contain trailing newline characters.
-// Postcondition: text + \r\n is written to the connection.
+// Postcondition: text + \r\n is written to the connection, or held by the pager.
 func (c *Conn) WriteLine(text string) error {
 	if c.Headless {
 		text = StripANSI(text)
@@ -643,6 +704,19 @@ func (c *Conn) WriteLine(text string) error {
 	if c.writeTimeout > 0 {
 		_ = c.raw.SetWriteDeadline(time.Now().Add(c.writeTimeout))
 	}
+	if c.splitScreen {
+		_, err := fmt.Fprintf(c.raw, "%s\r\n", text)
+		return err
+	}
+	held := c.pager.paused()
+	show, started := c.pager.take(splitLines(text), c.pageSizeLocked(c.height, 2), c.width)
+	switch {
+	case held:
+		return nil
+	case started:
+		_, err := fmt.Fprintf(c.raw, "%s\r\n%s", strings.Join(show, "\r\n"), MorePrompt)
+		return err
+	}
 	_, err := fmt.Fprintf(c.raw, "%s\r\n", text)
 	return err
 }
@@ -681,6 +755,11 @@ func (c *Conn) WritePrompt(prompt string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if c.pager.paused() {
+		// Keep the --More-- prompt; the prompt is restored once the player is done paging.
+		c.pager.prompt, c.pager.hasPrompt = prompt, true
+		return nil
+	}
 	if c.writeTimeout > 0 {
 		_ = c.raw.SetWriteDeadline(time.Now().Add(c.writeTimeout))
 	}
@@ -688,6 +767,59 @@ func (c *Conn) WritePrompt(prompt string) error {
 	return err
 }
 
+// StartKeepalive enables TCP keepalive probes on the underlying connection
+// and, for interactive connections, writes IAC NOP and IAC DO TIMING-MARK every
+// interval. The connection is closed, ending any blocked read, when a probe
+// cannot be written or when a client that has answered TIMING-MARK before has
+// not answered one for timeout. Clients that never answer are kept alive by
+// the TCP probes alone.
+//
+// Precondition: interval > 0 enables keepalives; interval <= 0 makes this a no-op.
+// Postcondition: Returns a stop function; call it to stop the goroutine.
+func (c *Conn) StartKeepalive(interval, timeout time.Duration) (stop func()) {
+	if interval <= 0 {
+		return func() {}
+	}
+	if tcp, ok := c.raw.(*net.TCPConn); ok {
+		_ = tcp.SetKeepAliveConfig(net.KeepAliveConfig{Enable: true, Idle: interval, Interval: interval, Count: 3})
+	}
+	if c.Headless {
+		// Headless clients are line-oriented scripts; keep their byte stream clean.
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	stop = func() { once.Do(func() { close(done) }) }
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if c.unresponsive(timeout) {
+					_ = c.Close()
+					return
+				}
+				if err := c.Write([]byte{IAC, NOP, IAC, DO, OptTimingMark}); err != nil {
+					_ = c.Close()
+					return
+				}
+			}
+		}
+	}()
+	return stop
+}
+
+// unresponsive reports whether a client that has answered TIMING-MARK has
+// gone silent for longer than timeout.
+func (c *Conn) unresponsive(timeout time.Duration) bool {
+	at := c.timingMarkAt.Load()
+	return timeout > 0 && at != 0 && time.Since(time.Unix(0, at)) > timeout
+}
+
 // Close closes the underlying TCP connection.
 //
 // Postcondition: The connection is closed and no longer usable.
@@ -807,6 +807,21 @@ func TestConn_History_RingOverflow(t *testing.T) {
 	assert.False(t, ok, "HistoryUp beyond 100 entries should return false")
 }
 
+func TestConn_SearchHistory(t *testing.T) {
+	c := &Conn{}
+	c.AppendHistory("say Hello there")
+	c.AppendHistory("north")
+	c.AppendHistory("say goodbye")
+	_, _ = c.HistoryUp()
+
+	assert.Equal(t, []string{"say Hello there", "say goodbye"}, c.SearchHistory("SAY"))
+	assert.Equal(t, []string{"say Hello there", "north", "say goodbye"}, c.SearchHistory(""))
+	assert.Empty(t, c.SearchHistory("attack"))
+	got, ok := c.HistoryUp()
+	require.True(t, ok)
+	assert.Equal(t, "north", got, "searching leaves the cursor alone")
+}
+
 func TestProperty_History_ReverseOrder(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
 		n := rapid.IntRange(1, 20).Draw(rt, "n")
@@ -1209,3 +1224,103 @@ func TestConn_ReadLine_TabKey_DoesNotModifyBuffer(t *testing.T) {
 	assert.Equal(t, "abcd", line)
 	assert.NotContains(t, line, "\t")
 }
+
+// --- Keepalive tests ---
+
+// keepaliveProbe is what StartKeepalive writes each interval.
+var keepaliveProbe = []byte{IAC, NOP, IAC, DO, OptTimingMark}
+
+func TestConn_StartKeepalive_WritesProbes(t *testing.T) {
+	conn, client := newTestConn(t)
+	stop := conn.StartKeepalive(10*time.Millisecond, time.Second)
+	defer stop()
+
+	buf := make([]byte, len(keepaliveProbe))
+	_ = client.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, err := io.ReadFull(client, buf)
+	require.NoError(t, err)
+	assert.Equal(t, keepaliveProbe, buf)
+}
+
+func TestConn_StartKeepalive_DisabledOrHeadless(t *testing.T) {
+	conn, client := newTestConn(t)
+	conn.StartKeepalive(0, time.Second)()
+	conn.Headless = true
+	stop := conn.StartKeepalive(10*time.Millisecond, time.Second)
+	defer stop()
+
+	_ = client.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	_, err := client.Read(make([]byte, 16))
+	var nerr net.Error
+	require.ErrorAs(t, err, &nerr)
+	assert.True(t, nerr.Timeout(), "no probe is written")
+}
+
+func TestConn_StartKeepalive_ReapsSilencedClient(t *testing.T) {
+	conn, client := newTestConn(t)
+	readErr := make(chan error, 1)
+	go func() {
+		_, err := conn.ReadLine()
+		readErr <- err
+	}()
+
+	// The client answers the first probe, then goes silent while still
+	// draining output, as a half-open peer behind a buffering proxy would.
+	go func() {
+		buf := make([]byte, len(keepaliveProbe))
+		if _, err := io.ReadFull(client, buf); err != nil {
+			return
+		}
+		_, _ = client.Write([]byte{IAC, WONT, OptTimingMark})
+		_, _ = io.Copy(io.Discard, client)
+	}()
+
+	stop := conn.StartKeepalive(10*time.Millisecond, 50*time.Millisecond)
+	defer stop()
+	select {
+	case err := <-readErr:
+		assert.Error(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("connection was not reaped")
+	}
+}
+
+func TestConn_StartKeepalive_KeepsNonAnsweringClient(t *testing.T) {
+	conn, client := newTestConn(t)
+	go func() { _, _ = io.Copy(io.Discard, client) }()
+	stop := conn.StartKeepalive(5*time.Millisecond, 10*time.Millisecond)
+	defer stop()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, conn.WriteLine("still here"), "clients that never answer TIMING-MARK are not reaped")
+}
+
+func TestConn_StartKeepalive_ClosesOnWriteFailure(t *testing.T) {
+	conn, client := newTestConn(t)
+	require.NoError(t, client.Close())
+	stop := conn.StartKeepalive(10*time.Millisecond, 0)
+	defer stop()
+
+	require.Eventually(t, func() bool {
+		return conn.WriteLine("x") != nil
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+// TestProperty_Conn_Unresponsive verifies that only a client that has answered
+// a probe, and not since timeout, is unresponsive.
+func TestProperty_Conn_Unresponsive(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		conn := &Conn{}
+		// Half-second timeouts keep whole-second ages clear of the boundary.
+		timeout := time.Duration(rapid.IntRange(0, 120).Draw(rt, "timeout"))*time.Second + 500*time.Millisecond
+		answered := rapid.Bool().Draw(rt, "answered")
+		age := time.Duration(rapid.IntRange(0, 240).Draw(rt, "age")) * time.Second
+		if answered {
+			conn.timingMarkAt.Store(time.Now().Add(-age).UnixNano())
+		}
+		want := answered && age > timeout
+		if got := conn.unresponsive(timeout); got != want {
+			rt.Fatalf("unresponsive(%v) with answered=%v age=%v = %v, want %v", timeout, answered, age, got, want)
+		}
+	})
+}
@@ -0,0 +1,213 @@
+package telnet
+
+import (
+	"strings"
+)
+
+// Page lengths accepted by SetPageLength.
+const (
+	// PageAuto pages at the terminal height.
+	PageAuto = 0
+	// PageOff disables the pager.
+	PageOff = -1
+)
+
+// defaultPageRows is the terminal height assumed when the client has not
+// reported its size.
+const defaultPageRows = 24
+
+// pagerHeldMax caps the lines held behind a --More-- prompt; the oldest are
+// dropped beyond it.
+const pagerHeldMax = consoleBufMax
+
+// MorePrompt is shown when output is held for the player to continue.
+var MorePrompt = Colorize(Dim, "--More-- (Enter to continue, q to stop)")
+
+// pager holds the remainder of a render that does not fit one page until the
+// player asks for more. It is guarded by Conn.mu.
+type pager struct {
+	// length is the page length setting: PageAuto, PageOff, or a line count.
+	length int
+	// held are the lines waiting behind the --More-- prompt, in order.
+	held []string
+	// prompt is the game prompt suppressed while output is held.
+	prompt    string
+	hasPrompt bool
+}
+
+// paused reports whether output is held behind a --More-- prompt.
+func (p *pager) paused() bool {
+	return len(p.held) > 0
+}
+
+// take splits lines into those shown now and those held for the next page.
+// While output is already held, every line is held so order is preserved.
+//
+// Precondition: size <= 0 disables paging; width <= 0 counts every line as one row.
+// Postcondition: started is true when this call paused output that was flowing.
+func (p *pager) take(lines []string, size, width int) (show []string, started bool) {
+	if p.paused() {
+		p.hold(lines)
+		return nil, false
+	}
+	if size <= 0 {
+		return lines, false
+	}
+	rows := 0
+	for i, line := range lines {
+		rows += lineRows(line, width)
+		if rows > size && i > 0 {
+			p.hold(lines[i:])
+			return lines[:i], true
+		}
+	}
+	return lines, false
+}
+
+// next releases the next page of held lines.
+//
+// Postcondition: paused() reports whether lines are still held.
+func (p *pager) next(size, width int) []string {
+	held := p.held
+	p.held = nil
+	if size <= 0 {
+		return held
+	}
+	show, _ := p.take(held, size, width)
+	return show
+}
+
+// hold appends lines to the held output, dropping the oldest past pagerHeldMax.
+func (p *pager) hold(lines []string) {
+	p.held = append(p.held, lines...)
+	if len(p.held) > pagerHeldMax {
+		p.held = append([]string(nil), p.held[len(p.held)-pagerHeldMax:]...)
+	}
+}
+
+// lineRows returns the terminal rows line occupies at width.
+func lineRows(line string, width int) int {
+	w := visualWidth(line)
+	if width <= 0 || w <= width {
+		return 1
+	}
+	return (w + width - 1) / width
+}
+
+// splitLines splits rendered text into display lines.
+func splitLines(text string) []string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSuffix(l, "\r")
+	}
+	return lines
+}
+
+// SetPageLength sets how many lines a render may print before the pager
+// holds the rest behind a --More-- prompt.
+//
+// Precondition: n is PageAuto, PageOff, or a positive line count.
+// Postcondition: Output already held stays held until the player continues.
+func (c *Conn) SetPageLength(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pager.length = n
+}
+
+// PageLength returns the page length setting.
+func (c *Conn) PageLength() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pager.length
+}
+
+// IsPaging reports whether output is held behind a --More-- prompt.
+func (c *Conn) IsPaging() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pager.paused()
+}
+
+// pageSizeLocked returns the rows a single render may print, or 0 when
+// paging is disabled. In auto mode the page is rows less reserved, where
+// rows is the visible height of the output region.
+//
+// Precondition: c.mu must be held.
+func (c *Conn) pageSizeLocked(rows, reserved int) int {
+	if c.Headless || c.pager.length < 0 {
+		return 0
+	}
+	if c.pager.length > 0 {
+		return c.pager.length
+	}
+	if rows <= 0 {
+		rows = defaultPageRows
+	}
+	return max(1, rows-reserved)
+}
+
+// pagerInput handles a line the player entered while output may be held.
+// An empty line shows the next page, "q" discards the held output, and any
+// other line discards it and is returned to the caller as a command.
+//
+// Postcondition: Returns true when line should be returned to the caller.
+func (c *Conn) pagerInput(line string) (bool, error) {
+	c.mu.Lock()
+	if !c.pager.paused() || strings.HasPrefix(line, "\x00") {
+		c.mu.Unlock()
+		return true, nil
+	}
+	split := c.splitScreen
+	cmd := strings.TrimSpace(line)
+	if cmd != "" {
+		c.pager.held = nil
+	}
+	var show []string
+	if cmd == "" {
+		if split {
+			show = c.pager.next(c.consoleHeightLocked(), c.width)
+		} else {
+			show = c.pager.next(c.pageSizeLocked(c.height, 2), c.width)
+		}
+	}
+	more := c.pager.paused()
+	prompt, hasPrompt := c.pager.prompt, c.pager.hasPrompt
+	if !more {
+		c.pager.prompt, c.pager.hasPrompt = "", false
+	}
+	c.mu.Unlock()
+
+	if split {
+		if cmd != "" && !strings.EqualFold(cmd, "q") {
+			return true, nil
+		}
+		if len(show) > 0 || more {
+			if err := c.renderConsole(show, more); err != nil {
+				return false, err
+			}
+		}
+		if !more && hasPrompt {
+			return false, c.WritePromptSplit(prompt)
+		}
+		return false, nil
+	}
+
+	if cmd != "" && !strings.EqualFold(cmd, "q") {
+		return true, nil
+	}
+	var b strings.Builder
+	// The client echoed the player's Enter; move back up over the --More--
+	// prompt and erase it.
+	b.WriteString("\033[1A\r\033[K")
+	for _, l := range show {
+		b.WriteString(l)
+		b.WriteString("\r\n")
+	}
+	switch {
+	case more:
+		b.WriteString(MorePrompt)
+	case hasPrompt:
+		b.WriteString(prompt)
+	}
+	return false, c.writeRaw(b.String())
+}
@@ -0,0 +1,183 @@
+package telnet
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func numberedLines(n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %02d", i+1)
+	}
+	return lines
+}
+
+func TestPager_TakeHoldsOverflow(t *testing.T) {
+	var p pager
+	show, started := p.take(numberedLines(5), 3, 0)
+	assert.True(t, started)
+	assert.Equal(t, numberedLines(3), show)
+	assert.True(t, p.paused())
+
+	// Later output queues behind the held lines.
+	show, started = p.take([]string{"late"}, 3, 0)
+	assert.False(t, started)
+	assert.Empty(t, show)
+	assert.Equal(t, []string{"line 04", "line 05", "late"}, p.held)
+
+	assert.Equal(t, []string{"line 04", "line 05", "late"}, p.next(3, 0))
+	assert.False(t, p.paused())
+}
+
+func TestPager_TakeCountsWrappedRows(t *testing.T) {
+	var p pager
+	long := strings.Repeat("x", 25)
+	show, started := p.take([]string{long, "short", "tail"}, 3, 10)
+	assert.True(t, started)
+	assert.Equal(t, []string{long}, show)
+}
+
+func TestPager_DisabledShowsEverything(t *testing.T) {
+	var p pager
+	show, started := p.take(numberedLines(50), 0, 80)
+	assert.False(t, started)
+	assert.Len(t, show, 50)
+	assert.False(t, p.paused())
+}
+
+// TestProperty_PagerShowsEveryLineInOrder verifies paging never drops,
+// duplicates, or reorders output and never shows more than a page at once.
+func TestProperty_PagerShowsEveryLineInOrder(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		size := rapid.IntRange(1, 30).Draw(rt, "size")
+		lines := numberedLines(rapid.IntRange(0, 200).Draw(rt, "lines"))
+		var p pager
+		show, _ := p.take(lines, size, 0)
+		if len(show) > size {
+			rt.Fatalf("first page of %d lines with page size %d", len(show), size)
+		}
+		got := append([]string{}, show...)
+		for p.paused() {
+			page := p.next(size, 0)
+			if len(page) == 0 || len(page) > size {
+				rt.Fatalf("page of %d lines with page size %d", len(page), size)
+			}
+			got = append(got, page...)
+		}
+		if !assert.ObjectsAreEqual(lines, got) {
+			rt.Fatalf("paged output %v, want %v", got, lines)
+		}
+	})
+}
+
+func TestConn_WriteLine_PagesLongText(t *testing.T) {
+	conn, client := newTestConn(t)
+	conn.SetPageLength(10)
+	text := strings.Join(numberedLines(25), "\r\n")
+
+	go func() { assert.NoError(t, conn.WriteLine(text)) }()
+	out := readAll(t, client, 200*time.Millisecond)
+	assert.Contains(t, out, "line 10")
+	assert.NotContains(t, out, "line 11")
+	assert.Contains(t, out, MorePrompt)
+	require.True(t, conn.IsPaging())
+
+	// The game prompt waits until paging ends.
+	require.NoError(t, conn.WritePrompt("> "))
+
+	lines := make(chan string, 1)
+	go func() {
+		line, err := conn.ReadLine()
+		assert.NoError(t, err)
+		lines <- line
+	}()
+
+	go func() { _, _ = client.Write([]byte("\r\n")) }()
+	out = readAll(t, client, 200*time.Millisecond)
+	assert.Contains(t, out, "line 11")
+	assert.Contains(t, out, "line 20")
+	assert.NotContains(t, out, "line 21")
+	assert.Contains(t, out, MorePrompt)
+
+	go func() { _, _ = client.Write([]byte("\r\n")) }()
+	out = readAll(t, client, 200*time.Millisecond)
+	assert.Contains(t, out, "line 25")
+	assert.NotContains(t, out, MorePrompt)
+	assert.True(t, strings.HasSuffix(out, "> "), "prompt restored after the last page: %q", out)
+	assert.False(t, conn.IsPaging())
+
+	go func() { _, _ = client.Write([]byte("look\r\n")) }()
+	select {
+	case line := <-lines:
+		assert.Equal(t, "look", line)
+	case <-time.After(2 * time.Second):
+		t.Fatal("ReadLine did not return the command")
+	}
+}
+
+func TestConn_ReadLine_CommandAbandonsPaging(t *testing.T) {
+	conn, client := newTestConn(t)
+	conn.SetPageLength(10)
+
+	go func() { _ = conn.WriteLine(strings.Join(numberedLines(30), "\n")) }()
+	_ = readAll(t, client, 200*time.Millisecond)
+	require.True(t, conn.IsPaging())
+
+	go func() { _, _ = client.Write([]byte("q\r\nscore\r\n")) }()
+	go func() { _ = readAll(t, client, time.Second) }()
+	line, err := conn.ReadLine()
+	require.NoError(t, err)
+	assert.Equal(t, "score", line)
+	assert.False(t, conn.IsPaging())
+}
+
+func TestConn_WriteLine_PagerOffAndHeadless(t *testing.T) {
+	text := strings.Join(numberedLines(40), "\r\n")
+
+	conn, client := newTestConn(t)
+	conn.SetPageLength(PageOff)
+	go func() { _ = conn.WriteLine(text) }()
+	assert.Equal(t, text+"\r\n", readAll(t, client, 200*time.Millisecond))
+	assert.False(t, conn.IsPaging())
+
+	headless, hclient := newTestHeadlessConn(t)
+	go func() { _ = headless.WriteLine(text) }()
+	assert.Equal(t, text+"\r\n", readAll(t, hclient, 200*time.Millisecond))
+	assert.False(t, headless.IsPaging())
+}
+
+func TestConn_WriteConsole_PagesInSplitScreen(t *testing.T) {
+	conn, client := newSplitConn(t, 80, 40)
+	conn.SetPageLength(10)
+
+	go func() { _ = conn.WriteConsole(strings.Join(numberedLines(15), "\n")) }()
+	out := readAll(t, client, 200*time.Millisecond)
+	assert.Contains(t, out, "line 10")
+	assert.NotContains(t, out, "line 11")
+	assert.Contains(t, out, MorePrompt)
+	require.True(t, conn.IsPaging())
+
+	// Output arriving meanwhile waits behind the prompt.
+	require.NoError(t, conn.WriteConsole("a new message"))
+	require.NoError(t, conn.WritePromptSplit("> "))
+
+	go func() { _, _ = client.Write([]byte("\r\nsay hi\r\n")) }()
+	done := make(chan string, 1)
+	go func() {
+		line, _ := conn.ReadLineSplit()
+		done <- line
+	}()
+	out = readAll(t, client, 300*time.Millisecond)
+	assert.Contains(t, out, "line 15")
+	assert.Contains(t, out, "a new message")
+	assert.Contains(t, out, "> ")
+	assert.Equal(t, "say hi", <-done)
+	assert.False(t, conn.IsPaging())
+}
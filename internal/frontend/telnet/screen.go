@@ -104,6 +104,11 @@ func (c *Conn) InitScreen() error {
 func (c *Conn) consoleHeight() int {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	return c.consoleHeightLocked()
+}
+
+// consoleHeightLocked is consoleHeight for callers holding c.mu.
+func (c *Conn) consoleHeightLocked() int {
 	h := c.height
 	if h <= roomRegionRows+3 {
 		return 1
@@ -273,6 +278,9 @@ func (c *Conn) WriteRoom(content string) error {
 //     This restores any room rows that were shifted up by the scrolling.
 //  4. Navigate to promptRow; redraw input.
 //
+// A message taller than the console region shows one page and holds the rest
+// behind a --More-- prompt; messages arriving meanwhile queue behind it.
+//
 // Precondition:  conn.splitScreen must be true (or conn.Headless); conn.height > 0; conn.width > 0.
 // Postcondition: Message in console area; room and prompt redrawn; cursor at promptRow.
 func (c *Conn) WriteConsole(text string) error {
@@ -281,15 +289,41 @@ func (c *Conn) WriteConsole(text string) error {
 		return c.writeRaw(plain + "\r\n")
 	}
 
+	c.mu.Lock()
+	wrappedLines := wrapText(strings.TrimRight(text, "\r\n"), c.width)
+	// Page only while following live output; scrolled-back players already
+	// control what they read.
+	size := 0
+	if c.scrollOffset == 0 {
+		size = c.pageSizeLocked(c.consoleHeightLocked(), 0)
+	}
+	held := c.pager.paused()
+	wrappedLines, started := c.pager.take(wrappedLines, size, c.width)
+	c.mu.Unlock()
+	if held {
+		return nil
+	}
+	return c.renderConsole(wrappedLines, started)
+}
+
+// renderConsole appends already wrapped lines to the scroll buffer and draws
+// them in the console region, then redraws the prompt row with the player's
+// input, or with the --More-- prompt when more is true.
+//
+// Precondition: conn.splitScreen must be true; c.mu must NOT be held.
+// Postcondition: Lines are in consoleBuf; cursor at promptRow.
+func (c *Conn) renderConsole(wrappedLines []string, more bool) error {
 	c.mu.Lock()
 	h := c.height
 	w := c.width
 	input := c.inputBuf
 	room := c.roomBuf
 	c.mu.Unlock()
+	if more {
+		input = MorePrompt
+	}
 
 	// Buffer lines for scroll history.
-	wrappedLines := wrapText(strings.TrimRight(text, "\r\n"), w)
 	for _, l := range wrappedLines {
 		c.appendConsoleLine(l)
 	}
@@ -468,6 +502,12 @@ func (c *Conn) WritePromptSplit(prompt string) error {
 
 	c.mu.Lock()
 	input := c.inputBuf
+	if c.pager.paused() {
+		// Keep the --More-- prompt; the prompt is restored once the player is done paging.
+		c.pager.prompt, c.pager.hasPrompt = prompt, true
+		c.mu.Unlock()
+		return nil
+	}
 	c.mu.Unlock()
 
 	// Cursor is always at promptRow when WritePromptSplit is called.
@@ -0,0 +1,337 @@
+// Package achievement defines content-driven achievements, the game events
+// that advance them, and a per-character record of progress, unlocks, and the
+// title the character has chosen to display. Account-wide achievements are
+// recorded once per account and shared by all of its characters.
+package achievement
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kind identifies the game event an achievement counts.
+type Kind string
+
+const (
+	// KindKill counts NPCs killed; Target optionally restricts it to one NPC template.
+	KindKill Kind = "kill"
+	// KindBossKill counts boss-tier NPCs killed; Target optionally restricts it to one NPC template.
+	KindBossKill Kind = "boss_kill"
+	// KindExplore counts rooms discovered; Target optionally restricts it to one zone.
+	KindExplore Kind = "explore"
+	// KindLevel tracks the highest level reached rather than a count.
+	KindLevel Kind = "level"
+)
+
+// validKinds lists every Kind the gameserver reports.
+var validKinds = map[Kind]bool{
+	KindKill: true, KindBossKill: true, KindExplore: true, KindLevel: true,
+}
+
+// Definition is one achievement loaded from content.
+type Definition struct {
+	ID          string `yaml:"id"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Event       Kind   `yaml:"event"`
+	// Target restricts the event to one NPC template (kills) or zone (explore); empty matches any.
+	Target string `yaml:"target"`
+	// Count is how many matching events unlock the achievement, or the level to reach for KindLevel.
+	Count int `yaml:"count"`
+	// Title is the title the achievement unlocks; may be empty.
+	Title string `yaml:"title"`
+	// Account makes the achievement account-wide: every character on the
+	// account advances the same progress, and once earned the achievement and
+	// its title belong to all of them.
+	Account bool `yaml:"account"`
+}
+
+// Event is a game event reported to the achievement engine.
+type Event struct {
+	Kind Kind
+	// Target is the NPC template ID for kills or the zone ID for exploration.
+	Target string
+	// Value is the level reached for KindLevel; ignored otherwise.
+	Value int
+}
+
+// Registry holds every achievement definition in file order.
+type Registry struct {
+	defs []*Definition
+	byID map[string]*Definition
+}
+
+// NewRegistry validates defs and indexes them.
+//
+// Postcondition: Returns a non-nil error when an ID is empty or repeated, a
+// name is missing, an event kind is unknown, a count is below 1, or two
+// achievements grant the same title.
+func NewRegistry(defs []*Definition) (*Registry, error) {
+	r := &Registry{byID: make(map[string]*Definition, len(defs))}
+	titles := make(map[string]string)
+	for i, d := range defs {
+		switch {
+		case d.ID == "":
+			return nil, fmt.Errorf("achievement %d: id is required", i+1)
+		case r.byID[d.ID] != nil:
+			return nil, fmt.Errorf("achievement %q: duplicate id", d.ID)
+		case d.Name == "":
+			return nil, fmt.Errorf("achievement %q: name is required", d.ID)
+		case !validKinds[d.Event]:
+			return nil, fmt.Errorf("achievement %q: unknown event %q", d.ID, d.Event)
+		case d.Count < 1:
+			return nil, fmt.Errorf("achievement %q: count must be at least 1", d.ID)
+		}
+		if d.Title != "" {
+			if other, dup := titles[d.Title]; dup {
+				return nil, fmt.Errorf("achievement %q: title %q is already granted by %q", d.ID, d.Title, other)
+			}
+			titles[d.Title] = d.ID
+		}
+		r.defs = append(r.defs, d)
+		r.byID[d.ID] = d
+	}
+	return r, nil
+}
+
+// Load reads achievement definitions from a YAML file with a top-level
+// "achievements" list.
+//
+// Precondition: path must be a readable file.
+// Postcondition: Returns the registry, or a non-nil error when the file cannot
+// be parsed or fails NewRegistry's validation.
+func Load(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var file struct {
+		Achievements []*Definition `yaml:"achievements"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing achievements file %s: %w", path, err)
+	}
+	r, err := NewRegistry(file.Achievements)
+	if err != nil {
+		return nil, fmt.Errorf("achievements file %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// All returns every definition in file order.
+func (r *Registry) All() []*Definition {
+	return r.defs
+}
+
+// Get returns the definition with id.
+func (r *Registry) Get(id string) (*Definition, bool) {
+	d, ok := r.byID[id]
+	return d, ok
+}
+
+// Progress is one character's or one account's achievement record. It is
+// safe for concurrent use.
+type Progress struct {
+	mu sync.Mutex
+	// account is the account-wide record linked with LinkAccount; nil until linked.
+	account *Progress
+	// counts maps achievement IDs to progress toward Count.
+	counts map[string]int
+	// unlocked maps achievement IDs to when they were earned.
+	unlocked map[string]time.Time
+	// title is the displayed title; empty for none.
+	title string
+}
+
+// NewProgress returns a Progress seeded with stored counts, unlock times, and title.
+//
+// Precondition: counts and unlocked may be nil.
+// Postcondition: The maps are copied.
+func NewProgress(counts map[string]int, unlocked map[string]time.Time, title string) *Progress {
+	p := &Progress{counts: make(map[string]int), unlocked: make(map[string]time.Time), title: title}
+	for id, n := range counts {
+		p.counts[id] = n
+	}
+	for id, at := range unlocked {
+		p.unlocked[id] = at
+	}
+	return p
+}
+
+// LinkAccount makes account the record of p's account-wide achievements.
+// Several characters' records may share one account record.
+//
+// Precondition: account must not itself be linked to another record.
+func (p *Progress) LinkAccount(account *Progress) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.account = account
+}
+
+// Account returns the linked account-wide record, or nil when none is linked.
+func (p *Progress) Account() *Progress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.account
+}
+
+// Count returns the progress toward achievement id, including account-wide
+// progress when an account record is linked.
+func (p *Progress) Count(id string) int {
+	p.mu.Lock()
+	n, account := p.counts[id], p.account
+	p.mu.Unlock()
+	if account != nil {
+		n = max(n, account.Count(id))
+	}
+	return n
+}
+
+// UnlockedAt returns when achievement id was earned, by the character or,
+// when an account record is linked, by the account.
+//
+// Postcondition: ok is false when it has not been earned.
+func (p *Progress) UnlockedAt(id string) (at time.Time, ok bool) {
+	p.mu.Lock()
+	at, ok = p.unlocked[id]
+	account := p.account
+	p.mu.Unlock()
+	if !ok && account != nil {
+		return account.UnlockedAt(id)
+	}
+	return at, ok
+}
+
+// unlockedIDs returns the IDs of every achievement earned by p or its account.
+func (p *Progress) unlockedIDs() []string {
+	p.mu.Lock()
+	ids := make([]string, 0, len(p.unlocked))
+	for id := range p.unlocked {
+		ids = append(ids, id)
+	}
+	account := p.account
+	p.mu.Unlock()
+	if account != nil {
+		ids = append(ids, account.unlockedIDs()...)
+	}
+	return ids
+}
+
+// Title returns the displayed title, or "" for none.
+func (p *Progress) Title() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.title
+}
+
+// Update is a change to one achievement's progress produced by Record. When
+// Def.Account is set and an account record is linked, the change is to the
+// account's progress.
+type Update struct {
+	Def   *Definition
+	Count int
+	// UnlockedAt is set when this update earned the achievement.
+	UnlockedAt time.Time
+}
+
+// matches reports whether ev advances d.
+func (d *Definition) matches(ev Event) bool {
+	return d.Event == ev.Kind && (d.Target == "" || d.Target == ev.Target)
+}
+
+// Record applies ev to p. Account-wide achievements advance p's linked
+// account record, or p itself when none is linked.
+//
+// Precondition: p must be non-nil.
+// Postcondition: Returns one Update per unearned achievement whose progress
+// changed, in file order; updates whose progress reached Count are unlocked at now.
+func (r *Registry) Record(p *Progress, ev Event, now time.Time) []Update {
+	account := p.Account()
+	var out []Update
+	for _, d := range r.defs {
+		if !d.matches(ev) {
+			continue
+		}
+		target := p
+		if d.Account && account != nil {
+			target = account
+		}
+		if u, ok := target.advance(d, ev, now); ok {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// advance applies ev to p's progress toward d.
+//
+// Postcondition: ok is false when d is already earned or ev does not change its progress.
+func (p *Progress) advance(d *Definition, ev Event, now time.Time) (Update, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, done := p.unlocked[d.ID]; done {
+		return Update{}, false
+	}
+	n := p.counts[d.ID] + 1
+	if d.Event == KindLevel {
+		if ev.Value <= p.counts[d.ID] {
+			return Update{}, false
+		}
+		n = ev.Value
+	}
+	p.counts[d.ID] = n
+	u := Update{Def: d, Count: n}
+	if n >= d.Count {
+		p.unlocked[d.ID] = now
+		u.UnlockedAt = now
+	}
+	return u, true
+}
+
+// Titles returns the titles p or its linked account has unlocked, sorted.
+//
+// Precondition: p must be non-nil.
+func (r *Registry) Titles(p *Progress) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, id := range p.unlockedIDs() {
+		if d, ok := r.byID[id]; ok && d.Title != "" && !seen[d.Title] {
+			seen[d.Title] = true
+			out = append(out, d.Title)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// SetTitle selects the title p displays, matched case-insensitively. An empty
+// title clears it.
+//
+// Precondition: p must be non-nil.
+// Postcondition: Returns a non-nil error, leaving the title unchanged, when
+// title is not granted by an achievement p has unlocked.
+func (r *Registry) SetTitle(p *Progress, title string) error {
+	if title != "" {
+		canonical := ""
+		for _, t := range r.Titles(p) {
+			if strings.EqualFold(t, title) {
+				canonical = t
+				break
+			}
+		}
+		if canonical == "" {
+			return fmt.Errorf("you have not earned the title %q", title)
+		}
+		title = canonical
+	}
+	p.mu.Lock()
+	p.title = title
+	p.mu.Unlock()
+	return nil
+}
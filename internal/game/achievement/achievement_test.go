@@ -0,0 +1,181 @@
+package achievement_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+)
+
+var epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testRegistry(t *testing.T) *achievement.Registry {
+	t.Helper()
+	r, err := achievement.NewRegistry([]*achievement.Definition{
+		{ID: "first_blood", Name: "First Blood", Event: achievement.KindKill, Count: 1, Title: "the Blooded"},
+		{ID: "rat_catcher", Name: "Rat Catcher", Event: achievement.KindKill, Target: "rat", Count: 3},
+		{ID: "boss_slayer", Name: "Boss Slayer", Event: achievement.KindBossKill, Count: 1, Title: "the Boss Slayer"},
+		{ID: "veteran", Name: "Veteran", Event: achievement.KindLevel, Count: 5},
+	})
+	require.NoError(t, err)
+	return r
+}
+
+func TestNewRegistry_Validation(t *testing.T) {
+	cases := map[string][]*achievement.Definition{
+		"missing id":     {{Name: "x", Event: achievement.KindKill, Count: 1}},
+		"duplicate id":   {{ID: "a", Name: "x", Event: achievement.KindKill, Count: 1}, {ID: "a", Name: "y", Event: achievement.KindKill, Count: 1}},
+		"missing name":   {{ID: "a", Event: achievement.KindKill, Count: 1}},
+		"unknown event":  {{ID: "a", Name: "x", Event: "dance", Count: 1}},
+		"zero count":     {{ID: "a", Name: "x", Event: achievement.KindKill}},
+		"repeated title": {{ID: "a", Name: "x", Event: achievement.KindKill, Count: 1, Title: "t"}, {ID: "b", Name: "y", Event: achievement.KindExplore, Count: 1, Title: "t"}},
+	}
+	for name, defs := range cases {
+		_, err := achievement.NewRegistry(defs)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "achievements.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`achievements:
+  - id: explorer
+    name: Explorer
+    description: Discover 100 rooms.
+    event: explore
+    count: 100
+    title: the Wanderer
+`), 0o644))
+	r, err := achievement.Load(path)
+	require.NoError(t, err)
+	d, ok := r.Get("explorer")
+	require.True(t, ok)
+	assert.Equal(t, achievement.KindExplore, d.Event)
+	assert.Equal(t, 100, d.Count)
+	assert.Equal(t, "the Wanderer", d.Title)
+}
+
+func TestLoad_ContentFile(t *testing.T) {
+	r, err := achievement.Load("../../../content/achievements.yaml")
+	require.NoError(t, err)
+	assert.NotEmpty(t, r.All())
+}
+
+func TestRecord_CountsAndUnlocks(t *testing.T) {
+	r := testRegistry(t)
+	p := achievement.NewProgress(nil, nil, "")
+
+	updates := r.Record(p, achievement.Event{Kind: achievement.KindKill, Target: "rat"}, epoch)
+	require.Len(t, updates, 2)
+	assert.Equal(t, "first_blood", updates[0].Def.ID)
+	assert.Equal(t, epoch, updates[0].UnlockedAt)
+	assert.Equal(t, "rat_catcher", updates[1].Def.ID)
+	assert.True(t, updates[1].UnlockedAt.IsZero())
+
+	updates = r.Record(p, achievement.Event{Kind: achievement.KindKill, Target: "dog"}, epoch)
+	assert.Empty(t, updates, "unlocked achievements stop counting and targets must match")
+
+	r.Record(p, achievement.Event{Kind: achievement.KindKill, Target: "rat"}, epoch)
+	updates = r.Record(p, achievement.Event{Kind: achievement.KindKill, Target: "rat"}, epoch.Add(time.Hour))
+	require.Len(t, updates, 1)
+	assert.Equal(t, 3, updates[0].Count)
+	at, ok := p.UnlockedAt("rat_catcher")
+	require.True(t, ok)
+	assert.Equal(t, epoch.Add(time.Hour), at)
+}
+
+func TestRecord_LevelTracksHighest(t *testing.T) {
+	r := testRegistry(t)
+	p := achievement.NewProgress(nil, nil, "")
+	require.Len(t, r.Record(p, achievement.Event{Kind: achievement.KindLevel, Value: 3}, epoch), 1)
+	assert.Empty(t, r.Record(p, achievement.Event{Kind: achievement.KindLevel, Value: 2}, epoch))
+	updates := r.Record(p, achievement.Event{Kind: achievement.KindLevel, Value: 6}, epoch)
+	require.Len(t, updates, 1)
+	assert.Equal(t, 6, updates[0].Count)
+	assert.False(t, updates[0].UnlockedAt.IsZero())
+}
+
+func TestTitles(t *testing.T) {
+	r := testRegistry(t)
+	p := achievement.NewProgress(nil, map[string]time.Time{"boss_slayer": epoch, "rat_catcher": epoch}, "")
+	assert.Equal(t, []string{"the Boss Slayer"}, r.Titles(p))
+
+	assert.Error(t, r.SetTitle(p, "the Blooded"))
+	require.NoError(t, r.SetTitle(p, "THE BOSS SLAYER"))
+	assert.Equal(t, "the Boss Slayer", p.Title())
+	require.NoError(t, r.SetTitle(p, ""))
+	assert.Equal(t, "", p.Title())
+}
+
+func TestRecord_AccountWideSharedByLinkedCharacters(t *testing.T) {
+	r, err := achievement.NewRegistry([]*achievement.Definition{
+		{ID: "mine", Name: "Mine", Event: achievement.KindKill, Count: 1},
+		{ID: "ours", Name: "Ours", Event: achievement.KindKill, Count: 2, Title: "the Many", Account: true},
+	})
+	require.NoError(t, err)
+	account := achievement.NewProgress(nil, nil, "")
+	alt1 := achievement.NewProgress(nil, nil, "")
+	alt2 := achievement.NewProgress(nil, nil, "")
+	alt1.LinkAccount(account)
+	alt2.LinkAccount(account)
+
+	updates := r.Record(alt1, achievement.Event{Kind: achievement.KindKill}, epoch)
+	require.Len(t, updates, 2)
+	assert.Equal(t, 1, alt2.Count("ours"), "account-wide progress is pooled")
+	assert.Zero(t, alt2.Count("mine"))
+
+	updates = r.Record(alt2, achievement.Event{Kind: achievement.KindKill}, epoch)
+	require.Len(t, updates, 2)
+	assert.Equal(t, "ours", updates[1].Def.ID)
+	assert.Equal(t, epoch, updates[1].UnlockedAt)
+
+	_, ok := alt1.UnlockedAt("ours")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"the Many"}, r.Titles(alt1))
+	require.NoError(t, r.SetTitle(alt1, "the many"))
+	assert.Equal(t, "the Many", alt1.Title())
+	assert.Empty(t, alt2.Title(), "each character picks its own title")
+}
+
+func TestRecord_AccountWideWithoutLinkCountsForCharacter(t *testing.T) {
+	r, err := achievement.NewRegistry([]*achievement.Definition{
+		{ID: "ours", Name: "Ours", Event: achievement.KindKill, Count: 1, Account: true},
+	})
+	require.NoError(t, err)
+	p := achievement.NewProgress(nil, nil, "")
+	require.Len(t, r.Record(p, achievement.Event{Kind: achievement.KindKill}, epoch), 1)
+	_, ok := p.UnlockedAt("ours")
+	assert.True(t, ok)
+}
+
+// TestProperty_Record_UnlocksExactlyAtCount verifies a counted achievement
+// unlocks on the event that brings its count to Count, and never before.
+func TestProperty_Record_UnlocksExactlyAtCount(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		count := rapid.IntRange(1, 20).Draw(rt, "count")
+		r, err := achievement.NewRegistry([]*achievement.Definition{
+			{ID: "a", Name: "A", Event: achievement.KindExplore, Count: count},
+		})
+		if err != nil {
+			rt.Fatal(err)
+		}
+		p := achievement.NewProgress(nil, nil, "")
+		events := rapid.IntRange(0, 40).Draw(rt, "events")
+		for i := 1; i <= events; i++ {
+			r.Record(p, achievement.Event{Kind: achievement.KindExplore, Target: "zone"}, epoch)
+			_, unlocked := p.UnlockedAt("a")
+			if unlocked != (i >= count) {
+				rt.Fatalf("after %d events with count %d unlocked=%v", i, count, unlocked)
+			}
+		}
+		if want := min(events, count); p.Count("a") != want {
+			rt.Fatalf("count = %d, want %d", p.Count("a"), want)
+		}
+	})
+}
@@ -27,19 +27,26 @@ func BuildCombatWorldState(cbt *combat.Combat, inst *npc.Instance, zoneID string
 			ZoneID: zoneID,
 		},
 	}
+	// An NPC fighting for a player plans from the player side: its enemies
+	// are the hostile NPCs.
 	for _, c := range cbt.Combatants {
 		kind := "npc"
-		if c.Kind == combat.KindPlayer {
+		if c.OnPlayerSide() {
 			kind = "player"
 		}
+		if c.ID == inst.ID {
+			ws.NPC.Kind = kind
+			ws.NPC.Melee = combat.AttacksInMelee(c)
+		}
 		ws.Combatants = append(ws.Combatants, &CombatantState{
-			UID:   c.ID,
-			Name:  c.Name,
-			Kind:  kind,
-			HP:    c.CurrentHP,
-			MaxHP: c.MaxHP,
-			AC:    c.AC,
-			Dead:  c.CurrentHP <= 0 || c.Dead,
+			UID:      c.ID,
+			Name:     c.Name,
+			Kind:     kind,
+			HP:       c.CurrentHP,
+			MaxHP:    c.MaxHP,
+			AC:       c.AC,
+			Dead:     c.CurrentHP <= 0 || c.Dead,
+			Screened: cbt.Screened(c),
 		})
 	}
 	return ws
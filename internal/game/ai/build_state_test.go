@@ -47,3 +47,29 @@ func TestBuildCombatWorldState_DeadCombatantsMarked(t *testing.T) {
 		t.Fatal("expected dead player to be marked Dead")
 	}
 }
+
+func TestBuildCombatWorldState_AllyOfPlayerFightsHostiles(t *testing.T) {
+	cbt := &combat.Combat{
+		RoomID: "room1",
+		Combatants: []*combat.Combatant{
+			{ID: "p1", Kind: combat.KindPlayer, Name: "Hero", CurrentHP: 20, MaxHP: 20},
+			{ID: "merc", Kind: combat.KindNPC, Name: "Merc", CurrentHP: 20, MaxHP: 20, AllyOf: "p1"},
+			{ID: "n1", Kind: combat.KindNPC, Name: "Ganger", CurrentHP: 15, MaxHP: 18},
+		},
+	}
+	inst := npc.NewInstance("merc", &npc.Template{ID: "merc", Name: "Merc", Level: 1, MaxHP: 20, AC: 12}, "room1")
+	ws := ai.BuildCombatWorldState(cbt, inst, "z1")
+	enemies := ws.EnemiesOf("merc")
+	if len(enemies) != 1 || enemies[0].UID != "n1" {
+		t.Fatalf("expected the hireling's only enemy to be n1, got %+v", enemies)
+	}
+	if got := ws.ResolveTarget("nearest_enemy"); got != "Ganger" {
+		t.Fatalf("expected nearest_enemy to resolve to Ganger, got %q", got)
+	}
+
+	hostile := npc.NewInstance("n1", &npc.Template{ID: "g", Name: "Ganger", Level: 1, MaxHP: 18, AC: 12}, "room1")
+	ws = ai.BuildCombatWorldState(cbt, hostile, "z1")
+	if got := len(ws.EnemiesOf("n1")); got != 2 {
+		t.Fatalf("expected a hostile NPC to see the player and hireling as enemies, got %d", got)
+	}
+}
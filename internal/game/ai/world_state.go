@@ -11,6 +11,9 @@ type CombatantState struct {
 	MaxHP int
 	AC    int
 	Dead  bool
+	// Screened is true when the combatant stands in its party's back row
+	// behind a living front-row ally.
+	Screened bool
 }
 
 // HPPercent returns current HP as a percentage of MaxHP; 0 if MaxHP == 0.
@@ -31,6 +34,9 @@ type NPCState struct {
 	Awareness int
 	ZoneID     string
 	RoomID     string
+	// Melee is true when the NPC attacks in melee, so screened back-row
+	// enemies are out of its easy reach.
+	Melee bool
 }
 
 // RoomState captures room context at planning time.
@@ -76,6 +82,29 @@ func (ws *WorldState) EnemiesOf(uid string) []*CombatantState {
 	return out
 }
 
+// ReachableEnemies returns the living enemies the NPC should pick targets
+// from: a melee NPC passes over screened back-row enemies while any other
+// enemy stands.
+//
+// Precondition: uid must be the NPC's UID; ws.NPC must not be nil.
+// Postcondition: Returns a subset of EnemiesOf(uid), empty only when it is.
+func (ws *WorldState) ReachableEnemies(uid string) []*CombatantState {
+	enemies := ws.EnemiesOf(uid)
+	if !ws.NPC.Melee {
+		return enemies
+	}
+	var front []*CombatantState
+	for _, e := range enemies {
+		if !e.Screened {
+			front = append(front, e)
+		}
+	}
+	if len(front) == 0 {
+		return enemies
+	}
+	return front
+}
+
 // HasLivingEnemies returns true when at least one living enemy exists.
 //
 // Postcondition: equivalent to len(EnemiesOf(uid)) > 0.
@@ -83,22 +112,24 @@ func (ws *WorldState) HasLivingEnemies(uid string) bool {
 	return len(ws.EnemiesOf(uid)) > 0
 }
 
-// NearestEnemy returns the first living enemy (by Combatants order), or nil.
+// NearestEnemy returns the first reachable living enemy (by Combatants
+// order), or nil.
 //
 // Postcondition: nil if no living enemies exist.
 func (ws *WorldState) NearestEnemy(uid string) *CombatantState {
-	enemies := ws.EnemiesOf(uid)
+	enemies := ws.ReachableEnemies(uid)
 	if len(enemies) == 0 {
 		return nil
 	}
 	return enemies[0]
 }
 
-// WeakestEnemy returns the living enemy with the lowest HP percentage, or nil.
+// WeakestEnemy returns the reachable living enemy with the lowest HP
+// percentage, or nil.
 //
 // Postcondition: nil if no living enemies exist; ties broken by order in Combatants.
 func (ws *WorldState) WeakestEnemy(uid string) *CombatantState {
-	enemies := ws.EnemiesOf(uid)
+	enemies := ws.ReachableEnemies(uid)
 	if len(enemies) == 0 {
 		return nil
 	}
@@ -149,3 +149,21 @@ func TestProperty_WorldState_NearestEnemy_NilWhenNoEnemies(t *testing.T) {
 		}
 	})
 }
+
+func TestWorldState_ReachableEnemies_MeleeSkipsScreenedBackRow(t *testing.T) {
+	combatants := []*ai.CombatantState{
+		{UID: "p1", Name: "Medic", Kind: "player", HP: 5, MaxHP: 20, Screened: true},
+		{UID: "p2", Name: "Bruiser", Kind: "player", HP: 20, MaxHP: 20},
+	}
+	melee := &ai.WorldState{NPC: &ai.NPCState{UID: "n1", Kind: "npc", Melee: true}, Combatants: combatants}
+	if got := melee.ResolveTarget("weakest_enemy"); got != "Bruiser" {
+		t.Fatalf("melee NPC should go for the front row, got %q", got)
+	}
+	if got := melee.ResolveTarget("nearest_enemy"); got != "Bruiser" {
+		t.Fatalf("melee NPC should go for the front row, got %q", got)
+	}
+	ranged := &ai.WorldState{NPC: &ai.NPCState{UID: "n1", Kind: "npc"}, Combatants: combatants}
+	if got := ranged.ResolveTarget("weakest_enemy"); got != "Medic" {
+		t.Fatalf("ranged NPC may pick off the back row, got %q", got)
+	}
+}
@@ -0,0 +1,350 @@
+// Package auction runs the server-wide consignment house: players list items
+// with a broker for a buyout price, other players buy them from any broker, and
+// the house holds sale proceeds and unsold items in escrow until their owners
+// collect them.
+package auction
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// Listing durations.
+const (
+	DefaultDuration = 24 * time.Hour
+	MinDuration     = time.Hour
+	MaxDuration     = 72 * time.Hour
+)
+
+// MaxListingsPerSeller is the most listings one character may have open at once.
+const MaxListingsPerSeller = 10
+
+// DefaultFeeRate is the listing fee, as a fraction of the buyout per day
+// listed, charged by brokers that do not set their own.
+const DefaultFeeRate = 0.05
+
+var (
+	// ErrNotFound is returned for a listing ID the house does not hold.
+	ErrNotFound = errors.New("no such listing")
+	// ErrOwnListing is returned when a seller tries to buy their own listing.
+	ErrOwnListing = errors.New("that listing is yours")
+	// ErrNotSeller is returned when cancelling someone else's listing.
+	ErrNotSeller = errors.New("that listing is not yours")
+	// ErrTooManyListings is returned when a seller already has MaxListingsPerSeller open.
+	ErrTooManyListings = errors.New("too many open listings")
+	// ErrBadListing is returned for a listing with no item, quantity, or price.
+	ErrBadListing = errors.New("a listing needs an item, a quantity, and a price")
+)
+
+// Listing is one lot offered for sale.
+type Listing struct {
+	ID         int64
+	SellerID   int64
+	SellerName string
+	ItemID     string
+	Quantity   int
+	// Metadata is the instance state of the lot (wear, affixes, an engraved
+	// name); nil for plain items. A lot with metadata is a single instance.
+	Metadata *inventory.ItemMetadata
+	// Price is the buyout for the whole lot, in credits.
+	Price     int
+	ListedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Claim is something the house holds for a character to collect: the
+// proceeds of a sale, or an item returned from an expired or cancelled listing.
+type Claim struct {
+	ID          int64
+	CharacterID int64
+	// Credits is non-zero for sale proceeds.
+	Credits int
+	// ItemID and Quantity are set for returned items.
+	ItemID   string
+	Quantity int
+	// Metadata is the returned lot's instance state; nil for plain items.
+	Metadata *inventory.ItemMetadata
+	// Note says where the claim came from, e.g. "listing #3 sold".
+	Note string
+}
+
+// ListingFee returns the fee for listing a lot at price for d.
+//
+// Precondition: price >= 1; d > 0.
+// Postcondition: Returns at least 1.
+func ListingFee(price int, d time.Duration, rate float64) int {
+	if rate <= 0 {
+		rate = DefaultFeeRate
+	}
+	fee := int(math.Ceil(float64(price) * rate * d.Hours() / 24))
+	if fee < 1 {
+		fee = 1
+	}
+	return fee
+}
+
+// House holds every open listing and uncollected claim. It is safe for
+// concurrent use.
+type House struct {
+	mu       sync.Mutex
+	nextID   int64
+	listings map[int64]*Listing
+	claims   map[int64][]*Claim
+}
+
+// NewHouse creates an empty House.
+func NewHouse() *House {
+	return &House{nextID: 1, listings: make(map[int64]*Listing), claims: make(map[int64][]*Claim)}
+}
+
+// Load replaces the house contents with persisted listings and claims.
+//
+// Postcondition: New IDs are assigned above every loaded ID.
+func (h *House) Load(listings []*Listing, claims []*Claim) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.listings = make(map[int64]*Listing, len(listings))
+	h.claims = make(map[int64][]*Claim)
+	h.nextID = 1
+	for _, l := range listings {
+		cp := *l
+		h.listings[l.ID] = &cp
+		if l.ID >= h.nextID {
+			h.nextID = l.ID + 1
+		}
+	}
+	for _, c := range claims {
+		cp := *c
+		h.claims[c.CharacterID] = append(h.claims[c.CharacterID], &cp)
+		if c.ID >= h.nextID {
+			h.nextID = c.ID + 1
+		}
+	}
+}
+
+// newID returns the next unused ID.
+//
+// Precondition: the caller holds h.mu.
+func (h *House) newID() int64 {
+	id := h.nextID
+	h.nextID++
+	return id
+}
+
+// addClaim records c for its character, assigning its ID.
+//
+// Precondition: the caller holds h.mu.
+func (h *House) addClaim(c *Claim) *Claim {
+	c.ID = h.newID()
+	h.claims[c.CharacterID] = append(h.claims[c.CharacterID], c)
+	cp := *c
+	return &cp
+}
+
+// List opens a listing for d starting at now.
+//
+// Precondition: l.SellerID, l.ItemID, l.Quantity, and l.Price are set; d is
+// clamped to [MinDuration, MaxDuration].
+// Postcondition: On success returns a copy of the stored listing with its ID,
+// ListedAt, and ExpiresAt filled in.
+func (h *House) List(l Listing, d time.Duration, now time.Time) (*Listing, error) {
+	if l.ItemID == "" || l.Quantity < 1 || l.Price < 1 {
+		return nil, ErrBadListing
+	}
+	d = ClampDuration(d)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	open := 0
+	for _, existing := range h.listings {
+		if existing.SellerID == l.SellerID {
+			open++
+		}
+	}
+	if open >= MaxListingsPerSeller {
+		return nil, ErrTooManyListings
+	}
+	l.ID = h.newID()
+	l.ListedAt = now
+	l.ExpiresAt = now.Add(d)
+	stored := l
+	h.listings[l.ID] = &stored
+	return &l, nil
+}
+
+// ClampDuration bounds d to [MinDuration, MaxDuration].
+func ClampDuration(d time.Duration) time.Duration {
+	if d < MinDuration {
+		return MinDuration
+	}
+	if d > MaxDuration {
+		return MaxDuration
+	}
+	return d
+}
+
+// Get returns a copy of the listing with id.
+func (h *House) Get(id int64) (*Listing, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	l, ok := h.listings[id]
+	if !ok {
+		return nil, false
+	}
+	cp := *l
+	return &cp, true
+}
+
+// Buy closes listing id as sold to buyerID, escrowing the buyout for the seller.
+// The caller must already have taken the buyout from the buyer.
+//
+// Postcondition: On success the listing is removed and returned along with the
+// seller's proceeds claim; expired listings cannot be bought.
+func (h *House) Buy(id, buyerID int64, now time.Time) (*Listing, *Claim, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	l, ok := h.listings[id]
+	if !ok || !now.Before(l.ExpiresAt) {
+		return nil, nil, ErrNotFound
+	}
+	if l.SellerID == buyerID {
+		return nil, nil, ErrOwnListing
+	}
+	delete(h.listings, id)
+	claim := h.addClaim(&Claim{CharacterID: l.SellerID, Credits: l.Price, Note: saleNote(l)})
+	return l, claim, nil
+}
+
+// Cancel withdraws sellerID's listing id, returning the item to escrow.
+//
+// Postcondition: On success the listing is removed and returned along with the
+// seller's item claim. Listing fees are not refunded.
+func (h *House) Cancel(id, sellerID int64) (*Listing, *Claim, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	l, ok := h.listings[id]
+	if !ok {
+		return nil, nil, ErrNotFound
+	}
+	if l.SellerID != sellerID {
+		return nil, nil, ErrNotSeller
+	}
+	delete(h.listings, id)
+	claim := h.addClaim(&Claim{CharacterID: l.SellerID, ItemID: l.ItemID, Quantity: l.Quantity, Metadata: l.Metadata, Note: "cancelled listing"})
+	return l, claim, nil
+}
+
+// Expired is a listing that ran out of time and the claim returning its item.
+type Expired struct {
+	Listing *Listing
+	Claim   *Claim
+}
+
+// Expire closes every listing whose time has run out by now, returning each
+// item to its seller's escrow.
+//
+// Postcondition: Results are ordered by listing ID.
+func (h *House) Expire(now time.Time) []Expired {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var out []Expired
+	for id, l := range h.listings {
+		if now.Before(l.ExpiresAt) {
+			continue
+		}
+		delete(h.listings, id)
+		claim := h.addClaim(&Claim{CharacterID: l.SellerID, ItemID: l.ItemID, Quantity: l.Quantity, Metadata: l.Metadata, Note: "expired listing"})
+		out = append(out, Expired{Listing: l, Claim: claim})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Listing.ID < out[j].Listing.ID })
+	return out
+}
+
+// Listings returns copies of every open listing, ordered by ID.
+func (h *House) Listings() []*Listing {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := make([]*Listing, 0, len(h.listings))
+	for _, l := range h.listings {
+		cp := *l
+		out = append(out, &cp)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// Claims returns copies of the claims waiting for characterID, oldest first.
+func (h *House) Claims(characterID int64) []*Claim {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := make([]*Claim, 0, len(h.claims[characterID]))
+	for _, c := range h.claims[characterID] {
+		cp := *c
+		out = append(out, &cp)
+	}
+	return out
+}
+
+// Collect removes and returns the claims waiting for characterID, oldest first.
+func (h *House) Collect(characterID int64) []*Claim {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := h.claims[characterID]
+	delete(h.claims, characterID)
+	return out
+}
+
+// Restore puts claims a character could not take back into escrow, keeping
+// their IDs.
+func (h *House) Restore(claims []*Claim) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, c := range claims {
+		h.claims[c.CharacterID] = append(h.claims[c.CharacterID], c)
+	}
+}
+
+// Unlist drops listing id without returning its item, undoing a List whose
+// caller could not finish the sale's bookkeeping.
+//
+// Postcondition: No-op when id is not open.
+func (h *House) Unlist(id int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.listings, id)
+}
+
+// Reopen undoes a Buy, Cancel, or Expire: l goes back on sale and the claim it
+// produced is withdrawn.
+//
+// Precondition: l and claim are the values returned when l was closed.
+func (h *House) Reopen(l *Listing, claim *Claim) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	cp := *l
+	h.listings[l.ID] = &cp
+	if claim == nil {
+		return
+	}
+	kept := h.claims[claim.CharacterID][:0]
+	for _, c := range h.claims[claim.CharacterID] {
+		if c.ID != claim.ID {
+			kept = append(kept, c)
+		}
+	}
+	if len(kept) == 0 {
+		delete(h.claims, claim.CharacterID)
+		return
+	}
+	h.claims[claim.CharacterID] = kept
+}
+
+// saleNote describes the sale of l.
+func saleNote(l *Listing) string {
+	return "listing #" + strconv.FormatInt(l.ID, 10) + " sold"
+}
@@ -0,0 +1,187 @@
+package auction_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/auction"
+)
+
+var t0 = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func listStim(t *testing.T, h *auction.House, seller int64) *auction.Listing {
+	t.Helper()
+	l, err := h.List(auction.Listing{SellerID: seller, SellerName: "Rook", ItemID: "stim_pack", Quantity: 2, Price: 100}, auction.DefaultDuration, t0)
+	require.NoError(t, err)
+	return l
+}
+
+func TestListingFee(t *testing.T) {
+	assert.Equal(t, 5, auction.ListingFee(100, 24*time.Hour, 0))
+	assert.Equal(t, 15, auction.ListingFee(100, 72*time.Hour, 0))
+	assert.Equal(t, 10, auction.ListingFee(100, 24*time.Hour, 0.1))
+	assert.Equal(t, 1, auction.ListingFee(1, time.Hour, 0), "the fee is never zero")
+}
+
+func TestHouse_ListAssignsIDAndExpiry(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+	assert.Equal(t, int64(1), l.ID)
+	assert.Equal(t, t0.Add(auction.DefaultDuration), l.ExpiresAt)
+
+	l2, err := h.List(auction.Listing{SellerID: 1, ItemID: "x", Quantity: 1, Price: 1}, 500*time.Hour, t0)
+	require.NoError(t, err)
+	assert.Equal(t, t0.Add(auction.MaxDuration), l2.ExpiresAt, "durations are clamped")
+	assert.Len(t, h.Listings(), 2)
+}
+
+func TestHouse_ListRejectsBadListing(t *testing.T) {
+	h := auction.NewHouse()
+	_, err := h.List(auction.Listing{SellerID: 1, ItemID: "x", Quantity: 1, Price: 0}, time.Hour, t0)
+	assert.ErrorIs(t, err, auction.ErrBadListing)
+}
+
+func TestHouse_ListCapsOpenListings(t *testing.T) {
+	h := auction.NewHouse()
+	for i := 0; i < auction.MaxListingsPerSeller; i++ {
+		listStim(t, h, 1)
+	}
+	_, err := h.List(auction.Listing{SellerID: 1, ItemID: "x", Quantity: 1, Price: 1}, time.Hour, t0)
+	assert.ErrorIs(t, err, auction.ErrTooManyListings)
+	listStim(t, h, 2)
+}
+
+func TestHouse_BuyEscrowsProceeds(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+
+	_, _, err := h.Buy(l.ID, 1, t0)
+	assert.ErrorIs(t, err, auction.ErrOwnListing)
+
+	sold, claim, err := h.Buy(l.ID, 2, t0.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "stim_pack", sold.ItemID)
+	assert.Equal(t, int64(1), claim.CharacterID)
+	assert.Equal(t, 100, claim.Credits)
+	assert.Empty(t, h.Listings())
+
+	_, _, err = h.Buy(l.ID, 3, t0)
+	assert.ErrorIs(t, err, auction.ErrNotFound)
+
+	claims := h.Collect(1)
+	require.Len(t, claims, 1)
+	assert.Equal(t, 100, claims[0].Credits)
+	assert.Empty(t, h.Claims(1), "collected claims are gone")
+}
+
+func TestHouse_CannotBuyExpired(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+	_, _, err := h.Buy(l.ID, 2, l.ExpiresAt)
+	assert.ErrorIs(t, err, auction.ErrNotFound)
+}
+
+func TestHouse_CancelReturnsItem(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+
+	_, _, err := h.Cancel(l.ID, 2)
+	assert.ErrorIs(t, err, auction.ErrNotSeller)
+
+	_, claim, err := h.Cancel(l.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "stim_pack", claim.ItemID)
+	assert.Equal(t, 2, claim.Quantity)
+	assert.Empty(t, h.Listings())
+}
+
+func TestHouse_ExpireReturnsItems(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+	_, err := h.List(auction.Listing{SellerID: 2, ItemID: "x", Quantity: 1, Price: 1}, auction.MaxDuration, t0)
+	require.NoError(t, err)
+
+	assert.Empty(t, h.Expire(l.ExpiresAt.Add(-time.Second)))
+	expired := h.Expire(l.ExpiresAt)
+	require.Len(t, expired, 1)
+	assert.Equal(t, l.ID, expired[0].Listing.ID)
+	assert.Equal(t, "expired listing", expired[0].Claim.Note)
+	assert.Len(t, h.Listings(), 1)
+	assert.Len(t, h.Claims(1), 1)
+}
+
+func TestHouse_LoadContinuesIDs(t *testing.T) {
+	h := auction.NewHouse()
+	h.Load(
+		[]*auction.Listing{{ID: 7, SellerID: 1, ItemID: "x", Quantity: 1, Price: 5, ExpiresAt: t0.Add(time.Hour)}},
+		[]*auction.Claim{{ID: 9, CharacterID: 1, Credits: 40}},
+	)
+	l := listStim(t, h, 2)
+	assert.Equal(t, int64(10), l.ID)
+	assert.Len(t, h.Claims(1), 1)
+}
+
+func TestHouse_RestoreKeepsClaims(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+	_, _, err := h.Cancel(l.ID, 1)
+	require.NoError(t, err)
+	claims := h.Collect(1)
+	h.Restore(claims)
+	assert.Equal(t, claims[0].ID, h.Claims(1)[0].ID)
+}
+
+// TestProperty_House_ConservesLots verifies every listed lot ends up either
+// still listed or escrowed as exactly one claim, whatever mix of buys,
+// cancels, and expiry happens.
+func TestHouse_ReopenUndoesBuy(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+	sold, claim, err := h.Buy(l.ID, 2, t0)
+	require.NoError(t, err)
+
+	h.Reopen(sold, claim)
+	got, ok := h.Get(l.ID)
+	require.True(t, ok, "the listing is back on sale")
+	assert.Equal(t, l.Price, got.Price)
+	assert.Empty(t, h.Claims(1), "the seller's proceeds are withdrawn")
+}
+
+func TestHouse_UnlistDropsListingWithoutClaim(t *testing.T) {
+	h := auction.NewHouse()
+	l := listStim(t, h, 1)
+	h.Unlist(l.ID)
+	assert.Empty(t, h.Listings())
+	assert.Empty(t, h.Claims(1))
+}
+
+func TestProperty_House_ConservesLots(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		h := auction.NewHouse()
+		n := rapid.IntRange(1, 8).Draw(rt, "lots")
+		ids := make([]int64, 0, n)
+		for i := 0; i < n; i++ {
+			l, err := h.List(auction.Listing{SellerID: 1, ItemID: "x", Quantity: 1, Price: 10}, auction.DefaultDuration, t0)
+			if err != nil {
+				rt.Fatalf("List: %v", err)
+			}
+			ids = append(ids, l.ID)
+		}
+		for _, id := range ids {
+			switch rapid.IntRange(0, 2).Draw(rt, "action") {
+			case 0:
+				_, _, _ = h.Buy(id, 2, t0)
+			case 1:
+				_, _, _ = h.Cancel(id, 1)
+			}
+		}
+		if rapid.Bool().Draw(rt, "expire") {
+			h.Expire(t0.Add(auction.MaxDuration))
+		}
+		assert.Equal(rt, n, len(h.Listings())+len(h.Claims(1)))
+	})
+}
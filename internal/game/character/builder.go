@@ -9,10 +9,14 @@ import (
 
 // applyModifiers starts all abilities at 10 and adds region modifier values.
 func applyModifiers(mods map[string]int) AbilityScores {
-	a := AbilityScores{
+	return addModifiers(AbilityScores{
 		Brutality: 10, Grit: 10, Quickness: 10,
 		Reasoning: 10, Savvy: 10, Flair: 10,
-	}
+	}, mods)
+}
+
+// addModifiers adds each named ability modifier in mods to a; unknown names are ignored.
+func addModifiers(a AbilityScores, mods map[string]int) AbilityScores {
 	for ability, delta := range mods {
 		switch ability {
 		case "brutality":
@@ -51,13 +55,15 @@ func applyKeyAbilityBoost(a AbilityScores, keyAbility string) AbilityScores {
 	return a
 }
 
-// BuildWithJob constructs a new Character from a name, region, job, and team.
-// Ability scores start at 10, region modifiers are applied, then the
-// job key ability receives a +2 boost. HP = max(1, hpPerLevel + GRT modifier).
+// BuildWithJob constructs a new Character from a name, region, heritage, job, and team.
+// Ability scores start at 10, region modifiers are applied, then heritage
+// modifiers, then the job key ability receives a +2 boost.
+// HP = max(1, hpPerLevel + GRT modifier).
 //
 // Precondition: name must be non-empty; region, job, and team must be non-nil.
+// heritage may be nil, in which case no heritage is recorded.
 // Postcondition: Returns a Character ready for persistence, or a non-nil error.
-func BuildWithJob(name string, region *ruleset.Region, job *ruleset.Job, team *ruleset.Team) (*Character, error) {
+func BuildWithJob(name string, region *ruleset.Region, heritage *ruleset.Heritage, job *ruleset.Job, team *ruleset.Team) (*Character, error) {
 	if name == "" {
 		return nil, errors.New("character name must not be empty")
 	}
@@ -72,13 +78,14 @@ func BuildWithJob(name string, region *ruleset.Region, job *ruleset.Job, team *r
 	}
 
 	abilities := applyModifiers(region.Modifiers)
+	heritageID := ""
+	if heritage != nil {
+		abilities = addModifiers(abilities, heritage.Modifiers)
+		heritageID = heritage.ID
+	}
 	abilities = applyKeyAbilityBoost(abilities, job.KeyAbility)
 
-	grtMod := abilities.Modifier(abilities.Grit)
-	maxHP := job.HitPointsPerLevel + grtMod
-	if maxHP < 1 {
-		maxHP = 1
-	}
+	maxHP := startingMaxHP(job, abilities)
 
 	loc := team.StartRoom
 	if loc == "" {
@@ -88,6 +95,7 @@ func BuildWithJob(name string, region *ruleset.Region, job *ruleset.Job, team *r
 	return &Character{
 		Name:      name,
 		Region:    region.ID,
+		Heritage:  heritageID,
 		Class:     job.ID,
 		Team:      team.ID,
 		Level:     1,
@@ -98,6 +106,15 @@ func BuildWithJob(name string, region *ruleset.Region, job *ruleset.Job, team *r
 	}, nil
 }
 
+// startingMaxHP returns max(1, hpPerLevel + GRT modifier) for a level 1 character.
+func startingMaxHP(job *ruleset.Job, abilities AbilityScores) int {
+	maxHP := job.HitPointsPerLevel + abilities.Modifier(abilities.Grit)
+	if maxHP < 1 {
+		maxHP = 1
+	}
+	return maxHP
+}
+
 // BuildSkillsFromJob constructs a full skill proficiency map for a new character.
 // Fixed skills and player-chosen skills are set to "trained". All others are "untrained".
 //
@@ -40,7 +40,7 @@ func TestBuildWithJob_AppliesRegionModifiers(t *testing.T) {
 	job := makeJob("brutality", 10)
 	team := makeTeam()
 
-	c, err := character.BuildWithJob("Hero", region, job, team)
+	c, err := character.BuildWithJob("Hero", region, nil, job, team)
 	require.NoError(t, err)
 
 	assert.Equal(t, 14, c.Abilities.Brutality) // 10 + 2 region + 2 key ability
@@ -56,7 +56,7 @@ func TestBuildWithJob_CalculatesHP(t *testing.T) {
 	job := makeJob("reasoning", 10)
 	team := makeTeam()
 
-	c, err := character.BuildWithJob("Hero", region, job, team)
+	c, err := character.BuildWithJob("Hero", region, nil, job, team)
 	require.NoError(t, err)
 
 	assert.Equal(t, 12, c.Abilities.Grit)
@@ -69,34 +69,34 @@ func TestBuildWithJob_HPNeverBelowOne(t *testing.T) {
 	job := makeJob("brutality", 6)
 	team := makeTeam()
 
-	c, err := character.BuildWithJob("Hero", region, job, team)
+	c, err := character.BuildWithJob("Hero", region, nil, job, team)
 	require.NoError(t, err)
 
 	assert.GreaterOrEqual(t, c.MaxHP, 1)
 }
 
 func TestBuildWithJob_EmptyNameError(t *testing.T) {
-	_, err := character.BuildWithJob("", makeRegion(nil), makeJob("brutality", 8), makeTeam())
+	_, err := character.BuildWithJob("", makeRegion(nil), nil, makeJob("brutality", 8), makeTeam())
 	require.Error(t, err)
 }
 
 func TestBuildWithJob_NilRegionError(t *testing.T) {
-	_, err := character.BuildWithJob("Hero", nil, makeJob("brutality", 8), makeTeam())
+	_, err := character.BuildWithJob("Hero", nil, nil, makeJob("brutality", 8), makeTeam())
 	require.Error(t, err)
 }
 
 func TestBuildWithJob_NilJobError(t *testing.T) {
-	_, err := character.BuildWithJob("Hero", makeRegion(nil), nil, makeTeam())
+	_, err := character.BuildWithJob("Hero", makeRegion(nil), nil, nil, makeTeam())
 	require.Error(t, err)
 }
 
 func TestBuildWithJob_NilTeamError(t *testing.T) {
-	_, err := character.BuildWithJob("Hero", makeRegion(nil), makeJob("brutality", 8), nil)
+	_, err := character.BuildWithJob("Hero", makeRegion(nil), nil, makeJob("brutality", 8), nil)
 	require.Error(t, err)
 }
 
 func TestBuildWithJob_DefaultLocation(t *testing.T) {
-	c, err := character.BuildWithJob("Hero", makeRegion(nil), makeJob("brutality", 8), makeTeam())
+	c, err := character.BuildWithJob("Hero", makeRegion(nil), nil, makeJob("brutality", 8), makeTeam())
 	require.NoError(t, err)
 	assert.Equal(t, "battle_infirmary", c.Location)
 	assert.Equal(t, 1, c.Level)
@@ -104,11 +104,49 @@ func TestBuildWithJob_DefaultLocation(t *testing.T) {
 
 func TestBuildWithJob_FallbackLocationWhenStartRoomEmpty(t *testing.T) {
 	team := &ruleset.Team{ID: "legacy_team", Name: "Legacy Team"}
-	c, err := character.BuildWithJob("Hero", makeRegion(nil), makeJob("brutality", 8), team)
+	c, err := character.BuildWithJob("Hero", makeRegion(nil), nil, makeJob("brutality", 8), team)
 	require.NoError(t, err)
 	assert.Equal(t, "grinders_row", c.Location)
 }
 
+func TestBuildWithJob_AppliesHeritage(t *testing.T) {
+	region := makeRegion(map[string]int{"quickness": 2})
+	heritage := &ruleset.Heritage{
+		ID:        "street_born",
+		Name:      "Street-Born",
+		Modifiers: map[string]int{"quickness": 2, "reasoning": -2},
+		Trait:     ruleset.HeritageTrait{ID: "alley_sense", Name: "Alley Sense"},
+	}
+	c, err := character.BuildWithJob("Hero", region, heritage, makeJob("brutality", 8), makeTeam())
+	require.NoError(t, err)
+	assert.Equal(t, "street_born", c.Heritage)
+	assert.Equal(t, 14, c.Abilities.Quickness)
+	assert.Equal(t, 8, c.Abilities.Reasoning)
+}
+
+func TestBuildWithJob_NilHeritage(t *testing.T) {
+	c, err := character.BuildWithJob("Hero", makeRegion(nil), nil, makeJob("brutality", 8), makeTeam())
+	require.NoError(t, err)
+	assert.Empty(t, c.Heritage)
+}
+
+// Property: heritage modifiers stack on top of region modifiers.
+func TestBuildWithJob_HeritageStacksOnRegion(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		regionMod := rapid.IntRange(-4, 4).Draw(rt, "regionMod")
+		heritageMod := rapid.IntRange(-4, 4).Draw(rt, "heritageMod")
+		region := makeRegion(map[string]int{"savvy": regionMod})
+		heritage := &ruleset.Heritage{ID: "h", Modifiers: map[string]int{"savvy": heritageMod}}
+		c, err := character.BuildWithJob("Hero", region, heritage, makeJob("brutality", 8), makeTeam())
+		if err != nil {
+			rt.Fatal(err)
+		}
+		if want := 10 + regionMod + heritageMod; c.Abilities.Savvy != want {
+			rt.Fatalf("Savvy = %d, want %d", c.Abilities.Savvy, want)
+		}
+	})
+}
+
 // Property: MaxHP is always >= 1 regardless of region modifiers.
 func TestBuildWithJob_MaxHPAlwaysPositive(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
@@ -116,7 +154,7 @@ func TestBuildWithJob_MaxHPAlwaysPositive(t *testing.T) {
 		hpPerLevel := rapid.IntRange(6, 12).Draw(rt, "hpPerLevel")
 		region := makeRegion(map[string]int{"grit": grtMod})
 		job := makeJob("brutality", hpPerLevel)
-		c, err := character.BuildWithJob("Hero", region, job, makeTeam())
+		c, err := character.BuildWithJob("Hero", region, nil, job, makeTeam())
 		if err != nil {
 			rt.Fatal(err)
 		}
@@ -272,7 +310,7 @@ func TestBuildWithJob_CurrentHPEqualsMaxHP(t *testing.T) {
 		hpPerLevel := rapid.IntRange(6, 12).Draw(rt, "hpPerLevel")
 		region := makeRegion(map[string]int{"grit": grtMod})
 		job := makeJob("quickness", hpPerLevel)
-		c, err := character.BuildWithJob("Hero", region, job, makeTeam())
+		c, err := character.BuildWithJob("Hero", region, nil, job, makeTeam())
 		if err != nil {
 			rt.Fatal(err)
 		}
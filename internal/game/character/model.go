@@ -27,6 +27,7 @@ type Character struct {
 
 	Name       string
 	Region     string // home region ID
+	Heritage   string // heritage ID; empty for characters created before heritages existed
 	Class      string // job ID (replaces class for Gunchete)
 	Team       string // team ID: "gun" or "machete"
 	Level      int
@@ -41,6 +42,10 @@ type Character struct {
 	// Persisted to DB; "pass" when unset.
 	DefaultCombatAction string
 
+	// CombatVerbosity is the player's combat narration level: "brief", "normal", or "verbose".
+	// Persisted to DB; "normal" when unset.
+	CombatVerbosity string
+
 	// Gender is the player-selected gender identity string.
 	// One of "male", "female", "non-binary", "indeterminate", or "custom:<text>".
 	// Empty string means unset (will be backfilled at login).
@@ -65,6 +70,21 @@ type Character struct {
 	// Persisted to DB; nil means not detained.
 	DetainedUntil *time.Time
 
+	// DeletedAt is non-nil while the character is soft-deleted and awaiting purge.
+	DeletedAt *time.Time
+
 	// FactionID is the faction ID chosen at character creation; immutable after that.
 	FactionID string
+
+	// BackgroundAnswers maps background question ID to the chosen answer ID.
+	// Set at creation; nil or empty for characters that skipped the questionnaire.
+	BackgroundAnswers map[string]string
+
+	// PointBuy holds the point-buy adjustments chosen at creation, already
+	// included in Abilities. Creation persists it to character_point_buy so
+	// later score recomputes keep it; nil when no points were moved.
+	PointBuy map[string]int
+
+	// TutorialComplete is true once the character has finished or skipped the tutorial.
+	TutorialComplete bool
 }
@@ -0,0 +1,175 @@
+package character
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+const (
+	// PointBuyPool is the number of ability points a player may move during creation.
+	PointBuyPool = 4
+	// PointBuyMaxShift caps how far point-buy may move any single ability, up or down.
+	PointBuyMaxShift = 2
+	// PointBuyMinScore is the lowest score point-buy may lower an ability to.
+	PointBuyMinScore = 8
+	// PointBuyMaxScore is the highest score point-buy may raise an ability to.
+	PointBuyMaxScore = 18
+)
+
+// AbilityNames lists the six ability names in display order.
+var AbilityNames = []string{"brutality", "grit", "quickness", "reasoning", "savvy", "flair"}
+
+// Score returns the score for the named ability.
+//
+// Postcondition: Returns (0, false) when name is not an ability name.
+func (a AbilityScores) Score(name string) (int, bool) {
+	switch name {
+	case "brutality":
+		return a.Brutality, true
+	case "grit":
+		return a.Grit, true
+	case "quickness":
+		return a.Quickness, true
+	case "reasoning":
+		return a.Reasoning, true
+	case "savvy":
+		return a.Savvy, true
+	case "flair":
+		return a.Flair, true
+	}
+	return 0, false
+}
+
+// abilityAbbreviations maps the preview's stat labels to ability names.
+var abilityAbbreviations = map[string]string{
+	"brt": "brutality",
+	"grt": "grit",
+	"qck": "quickness",
+	"rsn": "reasoning",
+	"sav": "savvy",
+	"flr": "flair",
+}
+
+// ParsePointBuy parses point-buy input of the form "grit +2 flair -2".
+// Abilities may be given by full name or by their preview label (e.g. "GRT").
+//
+// Postcondition: Returns the adjustments keyed by full ability name, or an error
+// when the input is not a sequence of <ability> <amount> pairs. Blank input
+// yields an empty map. Rule checks are left to ValidatePointBuy.
+func ParsePointBuy(line string) (map[string]int, error) {
+	fields := strings.Fields(strings.ToLower(line))
+	if len(fields)%2 != 0 {
+		return nil, fmt.Errorf("expected <ability> <amount> pairs, e.g. 'grit +2 flair -2'")
+	}
+	adj := make(map[string]int, len(fields)/2)
+	for i := 0; i < len(fields); i += 2 {
+		name := fields[i]
+		if full, ok := abilityAbbreviations[name]; ok {
+			name = full
+		}
+		delta, err := strconv.Atoi(fields[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q for %s", fields[i+1], name)
+		}
+		adj[name] += delta
+	}
+	return adj, nil
+}
+
+// ValidatePointBuy checks point-buy adjustments against base scores.
+// Adjustments must name real abilities, stay within ±PointBuyMaxShift each,
+// balance to zero, raise no more than PointBuyPool points in total, and keep
+// every adjusted score within [PointBuyMinScore, PointBuyMaxScore].
+//
+// Postcondition: Returns nil for an empty or valid set of adjustments; otherwise
+// an error describing the first violated rule.
+func ValidatePointBuy(base AbilityScores, adj map[string]int) error {
+	names := make([]string, 0, len(adj))
+	for name := range adj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	net, raised := 0, 0
+	for _, name := range names {
+		delta := adj[name]
+		score, ok := base.Score(name)
+		if !ok {
+			return fmt.Errorf("unknown ability %q", name)
+		}
+		if delta > PointBuyMaxShift || delta < -PointBuyMaxShift {
+			return fmt.Errorf("%s may move at most %d points", name, PointBuyMaxShift)
+		}
+		if delta > 0 && score+delta > PointBuyMaxScore {
+			return fmt.Errorf("%s cannot be raised above %d", name, PointBuyMaxScore)
+		}
+		if delta < 0 && score+delta < PointBuyMinScore {
+			return fmt.Errorf("%s cannot be lowered below %d", name, PointBuyMinScore)
+		}
+		net += delta
+		if delta > 0 {
+			raised += delta
+		}
+	}
+	if raised > PointBuyPool {
+		return fmt.Errorf("point-buy may move at most %d points, got %d", PointBuyPool, raised)
+	}
+	if net != 0 {
+		return fmt.Errorf("point-buy adjustments must balance, got %+d", net)
+	}
+	return nil
+}
+
+// BuildWithPointBuy is BuildWithJob followed by the player's point-buy
+// adjustments, with MaxHP recomputed from the adjusted scores.
+//
+// Precondition: as for BuildWithJob; pointBuy may be nil or empty.
+// Postcondition: Returns a Character ready for persistence, with PointBuy
+// holding the non-zero adjustments, or a non-nil error when the build fails or
+// pointBuy is invalid for the built scores.
+func BuildWithPointBuy(name string, region *ruleset.Region, heritage *ruleset.Heritage, job *ruleset.Job, team *ruleset.Team, pointBuy map[string]int) (*Character, error) {
+	c, err := BuildWithJob(name, region, heritage, job, team)
+	if err != nil {
+		return nil, err
+	}
+	if len(pointBuy) == 0 {
+		return c, nil
+	}
+	if err := ValidatePointBuy(c.Abilities, pointBuy); err != nil {
+		return nil, err
+	}
+	c.Abilities = addModifiers(c.Abilities, pointBuy)
+	c.MaxHP = startingMaxHP(job, c.Abilities)
+	c.CurrentHP = c.MaxHP
+	c.PointBuy = make(map[string]int, len(pointBuy))
+	for ability, delta := range pointBuy {
+		if delta != 0 {
+			c.PointBuy[ability] = delta
+		}
+	}
+	return c, nil
+}
+
+// ApplyPointBuy returns base with the point-buy adjustments added.
+//
+// Precondition: adj should already satisfy ValidatePointBuy against base.
+// Postcondition: base is not modified.
+func ApplyPointBuy(base AbilityScores, adj map[string]int) AbilityScores {
+	return addModifiers(base, adj)
+}
+
+// RemovePointBuy returns scores with the point-buy adjustments taken back out,
+// recovering the scores they were applied to.
+//
+// Postcondition: ApplyPointBuy(RemovePointBuy(scores, adj), adj) == scores.
+func RemovePointBuy(scores AbilityScores, adj map[string]int) AbilityScores {
+	neg := make(map[string]int, len(adj))
+	for name, delta := range adj {
+		neg[name] = -delta
+	}
+	return addModifiers(scores, neg)
+}
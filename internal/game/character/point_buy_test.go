@@ -0,0 +1,135 @@
+package character_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+)
+
+func baseScores() character.AbilityScores {
+	return character.AbilityScores{Brutality: 10, Grit: 10, Quickness: 10, Reasoning: 10, Savvy: 10, Flair: 10}
+}
+
+func TestValidatePointBuy_AcceptsBalancedShift(t *testing.T) {
+	assert.NoError(t, character.ValidatePointBuy(baseScores(), nil))
+	assert.NoError(t, character.ValidatePointBuy(baseScores(), map[string]int{"grit": 2, "flair": -2}))
+	assert.NoError(t, character.ValidatePointBuy(baseScores(), map[string]int{"grit": 2, "savvy": 2, "flair": -2, "reasoning": -2}))
+}
+
+func TestValidatePointBuy_Rejects(t *testing.T) {
+	low := baseScores()
+	low.Flair = 8
+	high := baseScores()
+	high.Grit = 17
+	cases := map[string]struct {
+		base character.AbilityScores
+		adj  map[string]int
+	}{
+		"unknown ability": {baseScores(), map[string]int{"luck": 1, "grit": -1}},
+		"shift too large": {baseScores(), map[string]int{"grit": 3, "flair": -3}},
+		"unbalanced":      {baseScores(), map[string]int{"grit": 2}},
+		"pool exceeded":   {baseScores(), map[string]int{"grit": 2, "savvy": 2, "quickness": 1, "flair": -2, "reasoning": -2, "brutality": -1}},
+		"below minimum":   {low, map[string]int{"grit": 1, "flair": -1}},
+		"above maximum":   {high, map[string]int{"grit": 2, "flair": -2}},
+	}
+	for name, tc := range cases {
+		assert.Error(t, character.ValidatePointBuy(tc.base, tc.adj), name)
+	}
+}
+
+func TestBuildWithPointBuy_AppliesShiftAndRecomputesHP(t *testing.T) {
+	job := makeJob("brutality", 8)
+	plain, err := character.BuildWithPointBuy("Hero", makeRegion(nil), nil, job, makeTeam(), nil)
+	require.NoError(t, err)
+
+	c, err := character.BuildWithPointBuy("Hero", makeRegion(nil), nil, job, makeTeam(), map[string]int{"grit": 2, "flair": -2})
+	require.NoError(t, err)
+	assert.Equal(t, 12, c.Abilities.Grit)
+	assert.Equal(t, 8, c.Abilities.Flair)
+	assert.Equal(t, plain.MaxHP+1, c.MaxHP)
+	assert.Equal(t, c.MaxHP, c.CurrentHP)
+	assert.Equal(t, map[string]int{"grit": 2, "flair": -2}, c.PointBuy)
+	assert.Empty(t, plain.PointBuy)
+}
+
+func TestBuildWithPointBuy_DropsZeroAdjustments(t *testing.T) {
+	c, err := character.BuildWithPointBuy("Hero", makeRegion(nil), nil, makeJob("brutality", 8), makeTeam(), map[string]int{"grit": 1, "flair": -1, "savvy": 0})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"grit": 1, "flair": -1}, c.PointBuy)
+}
+
+func TestBuildWithPointBuy_RejectsInvalidShift(t *testing.T) {
+	_, err := character.BuildWithPointBuy("Hero", makeRegion(nil), nil, makeJob("brutality", 8), makeTeam(), map[string]int{"grit": 2})
+	assert.Error(t, err)
+}
+
+// Property: an accepted point-buy never changes the ability total and keeps
+// every adjusted score within the point-buy bounds.
+func TestProperty_ValidatePointBuy_PreservesTotal(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		adj := make(map[string]int)
+		for _, name := range character.AbilityNames {
+			if d := rapid.IntRange(-3, 3).Draw(rt, name); d != 0 {
+				adj[name] = d
+			}
+		}
+		base := baseScores()
+		if character.ValidatePointBuy(base, adj) != nil {
+			return
+		}
+		total := 0
+		for _, name := range character.AbilityNames {
+			score, _ := base.Score(name)
+			adjusted := score + adj[name]
+			if adj[name] != 0 && (adjusted < character.PointBuyMinScore || adjusted > character.PointBuyMaxScore) {
+				rt.Fatalf("%s adjusted to %d, outside bounds", name, adjusted)
+			}
+			total += adj[name]
+		}
+		if total != 0 {
+			rt.Fatalf("accepted adjustments net %+d", total)
+		}
+	})
+}
+
+func TestParsePointBuy(t *testing.T) {
+	adj, err := character.ParsePointBuy("grit +2 FLR -2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"grit": 2, "flair": -2}, adj)
+
+	adj, err = character.ParsePointBuy("   ")
+	require.NoError(t, err)
+	assert.Empty(t, adj)
+
+	_, err = character.ParsePointBuy("grit")
+	assert.Error(t, err)
+	_, err = character.ParsePointBuy("grit lots")
+	assert.Error(t, err)
+}
+
+func TestProperty_ParsePointBuy_RoundTrips(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		name := rapid.SampledFrom(character.AbilityNames).Draw(rt, "name")
+		delta := rapid.IntRange(-9, 9).Draw(rt, "delta")
+		adj, err := character.ParsePointBuy(fmt.Sprintf("%s %+d", name, delta))
+		if err != nil || adj[name] != delta {
+			rt.Fatalf("got (%v, %v) for %s %d", adj, err, name, delta)
+		}
+	})
+}
+
+func TestProperty_RemovePointBuy_UndoesApplyPointBuy(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		name := rapid.SampledFrom(character.AbilityNames).Draw(rt, "name")
+		adj := map[string]int{name: rapid.IntRange(-2, 2).Draw(rt, "delta")}
+		applied := character.ApplyPointBuy(baseScores(), adj)
+		if got := character.RemovePointBuy(applied, adj); got != baseScores() {
+			rt.Fatalf("RemovePointBuy(ApplyPointBuy(base)) = %+v", got)
+		}
+	})
+}
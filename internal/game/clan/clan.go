@@ -0,0 +1,662 @@
+// Package clan tracks player-created clans: their members and ranks, the
+// message of the day, the shared clan bank, and pending invitations.
+package clan
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Name, tag, and MOTD bounds.
+const (
+	MinNameLen = 3
+	MaxNameLen = 24
+	MinTagLen  = 2
+	MaxTagLen  = 4
+	MaxMOTDLen = 200
+)
+
+var (
+	// ErrNameTaken is returned when creating a clan whose name is in use.
+	ErrNameTaken = errors.New("clan name is taken")
+	// ErrTagTaken is returned when creating a clan whose tag is in use.
+	ErrTagTaken = errors.New("clan tag is taken")
+	// ErrInClan is returned when a character who already belongs to a clan creates, joins, or is invited to another.
+	ErrInClan = errors.New("character is already in a clan")
+	// ErrNotMember is returned when a character acts on a clan they do not belong to.
+	ErrNotMember = errors.New("character is not in the clan")
+	// ErrRank is returned when a member's rank does not allow the action.
+	ErrRank = errors.New("rank too low")
+	// ErrNoInvite is returned when accepting without a pending invitation.
+	ErrNoInvite = errors.New("no pending clan invitation")
+	// ErrLeaderCannotLeave is returned when the leader leaves a clan that still has other members.
+	ErrLeaderCannotLeave = errors.New("the leader must promote a successor or disband the clan")
+	// ErrInsufficientFunds is returned when withdrawing more than the clan bank holds.
+	ErrInsufficientFunds = errors.New("not enough credits in the clan bank")
+)
+
+// Rank is a member's standing within a clan. Higher ranks outrank lower ones.
+type Rank int
+
+const (
+	// RankRecruit is the rank of newly joined members.
+	RankRecruit Rank = iota
+	// RankMember may use the clan channel and deposit to the bank.
+	RankMember
+	// RankOfficer may also invite, kick lower ranks, set the MOTD, and withdraw from the bank.
+	RankOfficer
+	// RankLeader may also promote, demote, and disband.
+	RankLeader
+)
+
+// String returns the display name of r.
+func (r Rank) String() string {
+	switch r {
+	case RankRecruit:
+		return "Recruit"
+	case RankMember:
+		return "Member"
+	case RankOfficer:
+		return "Officer"
+	case RankLeader:
+		return "Leader"
+	}
+	return fmt.Sprintf("Rank(%d)", int(r))
+}
+
+// Member is one character's membership in a clan.
+type Member struct {
+	CharacterID int64
+	// Name is the member's character name.
+	Name string
+	Rank Rank
+}
+
+// Clan is a player-created clan.
+type Clan struct {
+	// Name is the clan's display name; lookups are case-insensitive.
+	Name string
+	// Tag is the short upper-case tag shown beside member names.
+	Tag string
+	// MOTD is the message of the day shown to members at login.
+	MOTD string
+	// Bank is the shared credit balance.
+	Bank int
+	// Members maps character IDs to memberships.
+	Members map[int64]Member
+}
+
+// Leader returns the clan's leader.
+//
+// Postcondition: Returns (member, true) when the clan has a leader.
+func (c *Clan) Leader() (Member, bool) {
+	for _, m := range c.Members {
+		if m.Rank == RankLeader {
+			return m, true
+		}
+	}
+	return Member{}, false
+}
+
+// Roster returns the clan's members ordered by rank (highest first), then name.
+func (c *Clan) Roster() []Member {
+	out := make([]Member, 0, len(c.Members))
+	for _, m := range c.Members {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rank != out[j].Rank {
+			return out[i].Rank > out[j].Rank
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// clone returns a deep copy of c.
+func (c *Clan) clone() *Clan {
+	cp := *c
+	cp.Members = make(map[int64]Member, len(c.Members))
+	for id, m := range c.Members {
+		cp.Members[id] = m
+	}
+	return &cp
+}
+
+// ValidateName reports whether name is an acceptable clan name: letters,
+// digits, and single spaces, between MinNameLen and MaxNameLen characters.
+func ValidateName(name string) error {
+	if n := len([]rune(name)); n < MinNameLen || n > MaxNameLen {
+		return fmt.Errorf("clan names must be %d to %d characters", MinNameLen, MaxNameLen)
+	}
+	if strings.TrimSpace(name) != name || strings.Contains(name, "  ") {
+		return fmt.Errorf("clan names may not have leading, trailing, or repeated spaces")
+	}
+	for _, r := range name {
+		if r != ' ' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return fmt.Errorf("clan names may only contain letters, digits, and spaces")
+		}
+	}
+	return nil
+}
+
+// NormalizeTag validates tag and returns it upper-cased.
+//
+// Postcondition: Returns an error unless tag is MinTagLen to MaxTagLen letters or digits.
+func NormalizeTag(tag string) (string, error) {
+	if n := len([]rune(tag)); n < MinTagLen || n > MaxTagLen {
+		return "", fmt.Errorf("clan tags must be %d to %d characters", MinTagLen, MaxTagLen)
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return "", fmt.Errorf("clan tags may only contain letters and digits")
+		}
+	}
+	return strings.ToUpper(tag), nil
+}
+
+// Registry holds every clan and pending invitation. It is safe for concurrent
+// use; all returned clans are copies.
+type Registry struct {
+	mu      sync.Mutex
+	byName  map[string]*Clan // lower-cased name → clan
+	byChar  map[int64]string // character ID → lower-cased clan name
+	invites map[int64]string // character ID → lower-cased name of the inviting clan
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byName:  make(map[string]*Clan),
+		byChar:  make(map[int64]string),
+		invites: make(map[int64]string),
+	}
+}
+
+// Load replaces the registry's clans with clans and clears pending invitations.
+// A clan that has members but lost its leader (for example, when the leader's
+// character was deleted) passes leadership to its highest-ranking member.
+//
+// Precondition: clan names are unique and no character belongs to two clans.
+// Postcondition: Each clan is stored by copy.
+func (r *Registry) Load(clans []*Clan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byName = make(map[string]*Clan, len(clans))
+	r.byChar = make(map[int64]string)
+	r.invites = make(map[int64]string)
+	for _, c := range clans {
+		cp := c.clone()
+		promoteHeir(cp)
+		key := strings.ToLower(cp.Name)
+		r.byName[key] = cp
+		for id := range cp.Members {
+			r.byChar[id] = key
+		}
+	}
+}
+
+// promoteHeir passes leadership of a clan that has members but no leader to
+// its highest-ranking member.
+func promoteHeir(c *Clan) {
+	if _, ok := c.Leader(); ok || len(c.Members) == 0 {
+		return
+	}
+	heir := c.Roster()[0]
+	heir.Rank = RankLeader
+	c.Members[heir.CharacterID] = heir
+}
+
+// Get returns the clan named name, matched case-insensitively.
+//
+// Postcondition: Returns (copy, true) when the clan exists; (nil, false) otherwise.
+func (r *Registry) Get(name string) (*Clan, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.byName[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+	return c.clone(), true
+}
+
+// ForCharacter returns the clan characterID belongs to.
+//
+// Postcondition: Returns (copy, true) when the character is in a clan; (nil, false) otherwise.
+func (r *Registry) ForCharacter(characterID int64) (*Clan, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.clanOf(characterID)
+	if c == nil {
+		return nil, false
+	}
+	return c.clone(), true
+}
+
+// TagFor returns the tag of characterID's clan, or "" when they are in none.
+func (r *Registry) TagFor(characterID int64) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c := r.clanOf(characterID); c != nil {
+		return c.Tag
+	}
+	return ""
+}
+
+func (r *Registry) clanOf(characterID int64) *Clan {
+	key, ok := r.byChar[characterID]
+	if !ok {
+		return nil
+	}
+	return r.byName[key]
+}
+
+// MemberIDs returns the character IDs of every clan member.
+func (r *Registry) MemberIDs() []int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]int64, 0, len(r.byChar))
+	for id := range r.byChar {
+		out = append(out, id)
+	}
+	return out
+}
+
+// RemoveCharacter drops characterID from their clan regardless of rank, as
+// when the character is deleted. A leader's clan passes to its
+// highest-ranking remaining member.
+//
+// Postcondition: Returns the clan as it stands after the removal, or false
+// when the character was in no clan. A clan left without members is deleted;
+// the returned clan then has no members.
+func (r *Registry) RemoveCharacter(characterID int64) (*Clan, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.clanOf(characterID)
+	if c == nil {
+		return nil, false
+	}
+	r.remove(c, characterID)
+	promoteHeir(c)
+	return c.clone(), true
+}
+
+// RenameMember updates characterID's member name after a character rename.
+//
+// Postcondition: Returns true when the character is in a clan and was renamed.
+func (r *Registry) RenameMember(characterID int64, name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.clanOf(characterID)
+	if c == nil {
+		return false
+	}
+	m := c.Members[characterID]
+	m.Name = name
+	c.Members[characterID] = m
+	return true
+}
+
+// Create founds a clan led by leaderID.
+//
+// Precondition: leaderID > 0.
+// Postcondition: Returns the new clan, a validation error, ErrNameTaken,
+// ErrTagTaken, or ErrInClan. Any pending invitation for the leader is cleared.
+func (r *Registry) Create(name, tag string, leaderID int64, leaderName string) (*Clan, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	tag, err := NormalizeTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.clanOf(leaderID) != nil {
+		return nil, ErrInClan
+	}
+	key := strings.ToLower(name)
+	if _, ok := r.byName[key]; ok {
+		return nil, ErrNameTaken
+	}
+	for _, c := range r.byName {
+		if c.Tag == tag {
+			return nil, ErrTagTaken
+		}
+	}
+	c := &Clan{
+		Name:    name,
+		Tag:     tag,
+		Members: map[int64]Member{leaderID: {CharacterID: leaderID, Name: leaderName, Rank: RankLeader}},
+	}
+	r.byName[key] = c
+	r.byChar[leaderID] = key
+	delete(r.invites, leaderID)
+	return c.clone(), nil
+}
+
+// member returns the clan and membership of actorID, requiring at least rank min.
+func (r *Registry) member(actorID int64, min Rank) (*Clan, Member, error) {
+	c := r.clanOf(actorID)
+	if c == nil {
+		return nil, Member{}, ErrNotMember
+	}
+	m := c.Members[actorID]
+	if m.Rank < min {
+		return nil, Member{}, ErrRank
+	}
+	return c, m, nil
+}
+
+// Invite records an invitation from actorID's clan to targetID, replacing any
+// earlier invitation targetID held.
+//
+// Postcondition: Returns the inviting clan, ErrNotMember, ErrRank when the
+// actor is below officer, or ErrInClan when the target already has a clan.
+func (r *Registry) Invite(actorID, targetID int64) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, _, err := r.member(actorID, RankOfficer)
+	if err != nil {
+		return nil, err
+	}
+	if r.clanOf(targetID) != nil {
+		return nil, ErrInClan
+	}
+	r.invites[targetID] = strings.ToLower(c.Name)
+	return c.clone(), nil
+}
+
+// PendingInvite returns the name of the clan that invited characterID.
+func (r *Registry) PendingInvite(characterID int64) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.invites[characterID]
+	if !ok {
+		return "", false
+	}
+	c, ok := r.byName[key]
+	if !ok {
+		delete(r.invites, characterID)
+		return "", false
+	}
+	return c.Name, true
+}
+
+// Decline discards characterID's pending invitation.
+//
+// Postcondition: Returns the inviting clan's name, or ErrNoInvite.
+func (r *Registry) Decline(characterID int64) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.invites[characterID]
+	delete(r.invites, characterID)
+	c := r.byName[key]
+	if !ok || c == nil {
+		return "", ErrNoInvite
+	}
+	return c.Name, nil
+}
+
+// Accept joins characterID to the clan that invited them, as a recruit.
+//
+// Postcondition: Returns the joined clan, ErrNoInvite, or ErrInClan.
+func (r *Registry) Accept(characterID int64, name string) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.invites[characterID]
+	c := r.byName[key]
+	if !ok || c == nil {
+		delete(r.invites, characterID)
+		return nil, ErrNoInvite
+	}
+	if r.clanOf(characterID) != nil {
+		return nil, ErrInClan
+	}
+	delete(r.invites, characterID)
+	c.Members[characterID] = Member{CharacterID: characterID, Name: name, Rank: RankRecruit}
+	r.byChar[characterID] = key
+	return c.clone(), nil
+}
+
+// Leave removes characterID from their clan.
+//
+// Postcondition: Returns the clan as it stands after the departure,
+// ErrNotMember, or ErrLeaderCannotLeave while other members remain. A leader
+// leaving alone disbands the clan; the returned clan then has no members.
+func (r *Registry) Leave(characterID int64) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, m, err := r.member(characterID, RankRecruit)
+	if err != nil {
+		return nil, err
+	}
+	if m.Rank == RankLeader && len(c.Members) > 1 {
+		return nil, ErrLeaderCannotLeave
+	}
+	r.remove(c, characterID)
+	return c.clone(), nil
+}
+
+// remove drops characterID from c, deleting c when it becomes empty.
+func (r *Registry) remove(c *Clan, characterID int64) {
+	delete(c.Members, characterID)
+	delete(r.byChar, characterID)
+	if len(c.Members) == 0 {
+		r.drop(c)
+	}
+}
+
+// drop deletes c and every reference to it.
+func (r *Registry) drop(c *Clan) {
+	key := strings.ToLower(c.Name)
+	delete(r.byName, key)
+	for id, k := range r.byChar {
+		if k == key {
+			delete(r.byChar, id)
+		}
+	}
+	for id, k := range r.invites {
+		if k == key {
+			delete(r.invites, id)
+		}
+	}
+}
+
+// target returns targetID's membership in c.
+func target(c *Clan, targetID int64) (Member, error) {
+	m, ok := c.Members[targetID]
+	if !ok {
+		return Member{}, ErrNotMember
+	}
+	return m, nil
+}
+
+// Kick removes targetID from actorID's clan.
+//
+// Postcondition: Returns the clan after the removal, ErrNotMember when either
+// character is not in the actor's clan, or ErrRank unless the actor is an
+// officer who outranks the target.
+func (r *Registry) Kick(actorID, targetID int64) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, actor, err := r.member(actorID, RankOfficer)
+	if err != nil {
+		return nil, err
+	}
+	t, err := target(c, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Rank >= actor.Rank {
+		return nil, ErrRank
+	}
+	r.remove(c, targetID)
+	return c.clone(), nil
+}
+
+// Promote raises targetID one rank. Promoting an officer hands them
+// leadership, and the former leader becomes an officer.
+//
+// Postcondition: Returns the clan and the target's new rank, ErrNotMember, or
+// ErrRank unless the actor leads the clan and the target is someone else.
+func (r *Registry) Promote(actorID, targetID int64) (*Clan, Rank, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, actor, err := r.member(actorID, RankLeader)
+	if err != nil {
+		return nil, 0, err
+	}
+	t, err := target(c, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if targetID == actorID {
+		return nil, 0, ErrRank
+	}
+	t.Rank++
+	c.Members[targetID] = t
+	if t.Rank == RankLeader {
+		actor.Rank = RankOfficer
+		c.Members[actorID] = actor
+	}
+	return c.clone(), t.Rank, nil
+}
+
+// Demote lowers targetID one rank.
+//
+// Postcondition: Returns the clan and the target's new rank, ErrNotMember, or
+// ErrRank unless the actor leads the clan and the target is above recruit
+// and is not the actor.
+func (r *Registry) Demote(actorID, targetID int64) (*Clan, Rank, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, _, err := r.member(actorID, RankLeader)
+	if err != nil {
+		return nil, 0, err
+	}
+	t, err := target(c, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if targetID == actorID || t.Rank == RankRecruit {
+		return nil, 0, ErrRank
+	}
+	t.Rank--
+	c.Members[targetID] = t
+	return c.clone(), t.Rank, nil
+}
+
+// SetMOTD sets the clan's message of the day.
+//
+// Precondition: len(text) <= MaxMOTDLen; an empty text clears the MOTD.
+// Postcondition: Returns ErrNotMember, or ErrRank when the actor is below officer.
+func (r *Registry) SetMOTD(actorID int64, text string) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, _, err := r.member(actorID, RankOfficer)
+	if err != nil {
+		return nil, err
+	}
+	c.MOTD = text
+	return c.clone(), nil
+}
+
+// Deposit adds amount to the bank of actorID's clan.
+//
+// Precondition: amount > 0.
+// Postcondition: Returns ErrNotMember when the actor has no clan.
+func (r *Registry) Deposit(actorID int64, amount int) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, _, err := r.member(actorID, RankRecruit)
+	if err != nil {
+		return nil, err
+	}
+	c.Bank += amount
+	return c.clone(), nil
+}
+
+// Withdraw removes amount from the bank of actorID's clan.
+//
+// Precondition: amount > 0.
+// Postcondition: Returns ErrNotMember, ErrRank when the actor is below
+// officer, or ErrInsufficientFunds; the bank is unchanged on error.
+func (r *Registry) Withdraw(actorID int64, amount int) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, _, err := r.member(actorID, RankOfficer)
+	if err != nil {
+		return nil, err
+	}
+	if c.Bank < amount {
+		return nil, ErrInsufficientFunds
+	}
+	c.Bank -= amount
+	return c.clone(), nil
+}
+
+// AdjustBank adds delta, which may be negative, to the bank of the clan named
+// name, undoing a deposit or withdrawal the store refused.
+//
+// Postcondition: Returns false when the clan no longer exists.
+func (r *Registry) AdjustBank(name string, delta int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.byName[strings.ToLower(name)]
+	if !ok {
+		return false
+	}
+	c.Bank += delta
+	return true
+}
+
+// Reinstate restores c, a clan returned by Disband, undoing a disband the
+// store refused.
+//
+// Postcondition: Returns ErrNameTaken, ErrTagTaken, or ErrInClan when another
+// clan has since taken c's name or tag or one of its members; otherwise c is
+// stored by copy.
+func (r *Registry) Reinstate(c *Clan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := strings.ToLower(c.Name)
+	if _, ok := r.byName[key]; ok {
+		return ErrNameTaken
+	}
+	for _, other := range r.byName {
+		if other.Tag == c.Tag {
+			return ErrTagTaken
+		}
+	}
+	for id := range c.Members {
+		if _, ok := r.byChar[id]; ok {
+			return ErrInClan
+		}
+	}
+	cp := c.clone()
+	r.byName[key] = cp
+	for id := range cp.Members {
+		r.byChar[id] = key
+	}
+	return nil
+}
+
+// Disband dissolves actorID's clan.
+//
+// Postcondition: Returns the dissolved clan, ErrNotMember, or ErrRank unless
+// the actor is the leader.
+func (r *Registry) Disband(actorID int64) (*Clan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, _, err := r.member(actorID, RankLeader)
+	if err != nil {
+		return nil, err
+	}
+	r.drop(c)
+	return c.clone(), nil
+}
@@ -0,0 +1,277 @@
+package clan_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/clan"
+)
+
+func TestValidateNameAndTag(t *testing.T) {
+	for _, ok := range []string{"Rust Rats", "Ace", "Crew 99"} {
+		assert.NoError(t, clan.ValidateName(ok), ok)
+	}
+	for _, bad := range []string{"", "ab", " Rats", "Rust  Rats", "Rats!", "abcdefghijklmnopqrstuvwxy"} {
+		assert.Error(t, clan.ValidateName(bad), bad)
+	}
+	tag, err := clan.NormalizeTag("rr1")
+	require.NoError(t, err)
+	assert.Equal(t, "RR1", tag)
+	for _, bad := range []string{"r", "toolong", "r-r"} {
+		_, err := clan.NormalizeTag(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestRegistry_CreateAndLookup(t *testing.T) {
+	r := clan.NewRegistry()
+	c, err := r.Create("Rust Rats", "rr", 1, "Ace")
+	require.NoError(t, err)
+	assert.Equal(t, "RR", c.Tag)
+	leader, ok := c.Leader()
+	require.True(t, ok)
+	assert.Equal(t, int64(1), leader.CharacterID)
+
+	_, err = r.Create("rust rats", "xx", 2, "Bee")
+	assert.ErrorIs(t, err, clan.ErrNameTaken)
+	_, err = r.Create("Other", "RR", 2, "Bee")
+	assert.ErrorIs(t, err, clan.ErrTagTaken)
+	_, err = r.Create("Second", "SC", 1, "Ace")
+	assert.ErrorIs(t, err, clan.ErrInClan)
+
+	got, ok := r.Get("RUST RATS")
+	require.True(t, ok)
+	assert.Equal(t, "Rust Rats", got.Name)
+	assert.Equal(t, "RR", r.TagFor(1))
+	assert.Equal(t, "", r.TagFor(2))
+}
+
+func TestRegistry_InviteAcceptAndRanks(t *testing.T) {
+	r := clan.NewRegistry()
+	_, err := r.Create("Rust Rats", "RR", 1, "Ace")
+	require.NoError(t, err)
+
+	_, err = r.Accept(2, "Bee")
+	assert.ErrorIs(t, err, clan.ErrNoInvite)
+	_, err = r.Invite(1, 2)
+	require.NoError(t, err)
+	name, ok := r.PendingInvite(2)
+	require.True(t, ok)
+	assert.Equal(t, "Rust Rats", name)
+	c, err := r.Accept(2, "Bee")
+	require.NoError(t, err)
+	assert.Equal(t, clan.RankRecruit, c.Members[2].Rank)
+
+	_, err = r.Invite(2, 3)
+	assert.ErrorIs(t, err, clan.ErrRank, "recruits cannot invite")
+	_, err = r.Invite(1, 2)
+	assert.ErrorIs(t, err, clan.ErrInClan)
+
+	_, rank, err := r.Promote(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, clan.RankMember, rank)
+	_, rank, err = r.Promote(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, clan.RankOfficer, rank)
+	_, _, err = r.Promote(2, 1)
+	assert.ErrorIs(t, err, clan.ErrRank, "only the leader promotes")
+
+	_, err = r.Invite(2, 3)
+	require.NoError(t, err)
+	_, err = r.Decline(3)
+	require.NoError(t, err)
+	_, err = r.Accept(3, "Cee")
+	assert.ErrorIs(t, err, clan.ErrNoInvite)
+
+	_, err = r.Kick(2, 1)
+	assert.ErrorIs(t, err, clan.ErrRank, "officers cannot kick the leader")
+	_, err = r.Leave(1)
+	assert.ErrorIs(t, err, clan.ErrLeaderCannotLeave)
+
+	c, rank, err = r.Promote(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, clan.RankLeader, rank, "promoting an officer hands over leadership")
+	assert.Equal(t, clan.RankOfficer, c.Members[1].Rank)
+
+	c, err = r.Kick(2, 1)
+	require.NoError(t, err)
+	assert.NotContains(t, c.Members, int64(1))
+	assert.Equal(t, "", r.TagFor(1))
+}
+
+func TestRegistry_BankAndMOTD(t *testing.T) {
+	r := clan.NewRegistry()
+	_, err := r.Create("Rust Rats", "RR", 1, "Ace")
+	require.NoError(t, err)
+	_, err = r.Invite(1, 2)
+	require.NoError(t, err)
+	_, err = r.Accept(2, "Bee")
+	require.NoError(t, err)
+
+	c, err := r.Deposit(2, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 50, c.Bank)
+	_, err = r.Withdraw(2, 10)
+	assert.ErrorIs(t, err, clan.ErrRank, "recruits cannot withdraw")
+	_, err = r.Withdraw(1, 51)
+	assert.ErrorIs(t, err, clan.ErrInsufficientFunds)
+	c, err = r.Withdraw(1, 20)
+	require.NoError(t, err)
+	assert.Equal(t, 30, c.Bank)
+
+	_, err = r.SetMOTD(2, "hi")
+	assert.ErrorIs(t, err, clan.ErrRank)
+	c, err = r.SetMOTD(1, "Meet at the docks.")
+	require.NoError(t, err)
+	assert.Equal(t, "Meet at the docks.", c.MOTD)
+
+	_, err = r.Disband(2)
+	assert.ErrorIs(t, err, clan.ErrRank)
+	_, err = r.Disband(1)
+	require.NoError(t, err)
+	_, ok := r.Get("Rust Rats")
+	assert.False(t, ok)
+	assert.Equal(t, "", r.TagFor(2))
+}
+
+func TestRegistry_LeaderLeavingAloneDisbands(t *testing.T) {
+	r := clan.NewRegistry()
+	_, err := r.Create("Rust Rats", "RR", 1, "Ace")
+	require.NoError(t, err)
+	c, err := r.Leave(1)
+	require.NoError(t, err)
+	assert.Empty(t, c.Members)
+	_, ok := r.Get("Rust Rats")
+	assert.False(t, ok)
+}
+
+func TestRegistry_LoadRestoresLeader(t *testing.T) {
+	r := clan.NewRegistry()
+	r.Load([]*clan.Clan{{
+		Name: "Rust Rats", Tag: "RR",
+		Members: map[int64]clan.Member{
+			2: {CharacterID: 2, Name: "Bee", Rank: clan.RankMember},
+			3: {CharacterID: 3, Name: "Cee", Rank: clan.RankOfficer},
+		},
+	}})
+	c, ok := r.ForCharacter(2)
+	require.True(t, ok)
+	leader, ok := c.Leader()
+	require.True(t, ok)
+	assert.Equal(t, "Cee", leader.Name)
+	assert.Equal(t, []string{"Cee", "Bee"}, []string{c.Roster()[0].Name, c.Roster()[1].Name})
+}
+
+func TestRegistry_RemoveCharacter(t *testing.T) {
+	r := clan.NewRegistry()
+	_, err := r.Create("Rust Rats", "RR", 1, "Ace")
+	require.NoError(t, err)
+	_, err = r.Invite(1, 2)
+	require.NoError(t, err)
+	_, err = r.Accept(2, "Bee")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2}, r.MemberIDs())
+
+	c, ok := r.RemoveCharacter(1)
+	require.True(t, ok)
+	leader, _ := c.Leader()
+	assert.Equal(t, "Bee", leader.Name)
+	_, ok = r.RemoveCharacter(1)
+	assert.False(t, ok)
+
+	c, ok = r.RemoveCharacter(2)
+	require.True(t, ok)
+	assert.Empty(t, c.Members)
+	_, ok = r.Get("Rust Rats")
+	assert.False(t, ok)
+}
+
+func TestRegistry_UndoBankAndDisband(t *testing.T) {
+	r := clan.NewRegistry()
+	_, err := r.Create("Rust Rats", "RR", 1, "Ace")
+	require.NoError(t, err)
+	_, err = r.Deposit(1, 30)
+	require.NoError(t, err)
+	assert.True(t, r.AdjustBank("rust rats", -30))
+	assert.False(t, r.AdjustBank("Nobody", 5))
+
+	c, err := r.Disband(1)
+	require.NoError(t, err)
+	require.NoError(t, r.Reinstate(c))
+	assert.Equal(t, "RR", r.TagFor(1))
+	got, _ := r.Get("Rust Rats")
+	assert.Zero(t, got.Bank)
+	assert.ErrorIs(t, r.Reinstate(c), clan.ErrNameTaken)
+}
+
+func TestRegistry_ReturnsCopies(t *testing.T) {
+	r := clan.NewRegistry()
+	c, err := r.Create("Rust Rats", "RR", 1, "Ace")
+	require.NoError(t, err)
+	c.Members[9] = clan.Member{CharacterID: 9, Rank: clan.RankLeader}
+	c.Bank = 1000
+	got, _ := r.Get("Rust Rats")
+	assert.Len(t, got.Members, 1)
+	assert.Zero(t, got.Bank)
+}
+
+// TestProperty_Registry_OneLeaderPerClan verifies that any sequence of rank
+// changes and departures leaves every surviving clan with exactly one leader.
+func TestProperty_Registry_OneLeaderPerClan(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		r := clan.NewRegistry()
+		if _, err := r.Create("Rust Rats", "RR", 1, "Ace"); err != nil {
+			rt.Fatal(err)
+		}
+		for id := int64(2); id <= 5; id++ {
+			if _, err := r.Invite(1, id); err != nil {
+				rt.Fatal(err)
+			}
+			if _, err := r.Accept(id, "m"); err != nil {
+				rt.Fatal(err)
+			}
+		}
+		ops := rapid.SliceOfN(rapid.IntRange(0, 3), 1, 30).Draw(rt, "ops")
+		for i, op := range ops {
+			actor := rapid.Int64Range(1, 5).Draw(rt, "actor")
+			tgt := rapid.Int64Range(1, 5).Draw(rt, "target")
+			switch op {
+			case 0:
+				_, _, _ = r.Promote(actor, tgt)
+			case 1:
+				_, _, _ = r.Demote(actor, tgt)
+			case 2:
+				_, _ = r.Kick(actor, tgt)
+			case 3:
+				_, _ = r.Leave(actor)
+			}
+			c, ok := r.Get("Rust Rats")
+			if !ok {
+				return
+			}
+			leaders := 0
+			for _, m := range c.Members {
+				if m.Rank == clan.RankLeader {
+					leaders++
+				}
+			}
+			if leaders != 1 {
+				rt.Fatalf("after op %d the clan has %d leaders", i, leaders)
+			}
+		}
+	})
+}
+
+func TestRegistry_RenameMember(t *testing.T) {
+	r := clan.NewRegistry()
+	_, err := r.Create("Rust Saints", "RUST", 1, "Alice")
+	require.NoError(t, err)
+
+	assert.True(t, r.RenameMember(1, "Alicia"))
+	assert.False(t, r.RenameMember(2, "Nobody"), "characters outside a clan are ignored")
+	c, _ := r.ForCharacter(1)
+	assert.Equal(t, "Alicia", c.Members[1].Name)
+}
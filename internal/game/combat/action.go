@@ -1,6 +1,7 @@
 package combat
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cory-johannsen/mud/internal/game/reaction"
@@ -176,6 +177,9 @@ func (o MoveTraitOrdering) String() string {
 	}
 }
 
+// ErrRooted is returned when a rooted combatant tries to move.
+var ErrRooted = errors.New("you are rooted in place and cannot move")
+
 // MaxMovementAP is the maximum action points a combatant may spend on movement
 // (Stride or Step) in a single round per PF2e rules.
 const MaxMovementAP = 2
@@ -184,8 +188,12 @@ const MaxMovementAP = 2
 // Invariant: remaining >= 0 at all times.
 // Invariant: movementAPSpent <= MaxMovementAP at all times.
 type ActionQueue struct {
-	UID             string
-	MaxPoints       int
+	UID       string
+	MaxPoints int
+	// Rooted blocks every movement action this round (immobilized, rooted, grabbed).
+	Rooted bool
+	// MoveSurcharge is the extra AP charged for each AP of movement this round (slowed).
+	MoveSurcharge   int
 	remaining       int
 	movementAPSpent int
 	actions         []QueuedAction
@@ -220,17 +228,29 @@ func (q *ActionQueue) DeductMovementAP(cost int) error {
 	if cost <= 0 {
 		return fmt.Errorf("DeductMovementAP: cost must be positive, got %d", cost)
 	}
+	if q.Rooted {
+		return ErrRooted
+	}
 	if q.movementAPSpent+cost > MaxMovementAP {
 		return fmt.Errorf("movement limit reached: may spend at most %d AP on movement per round (already spent %d)", MaxMovementAP, q.movementAPSpent)
 	}
-	if q.remaining < cost {
-		return fmt.Errorf("not enough AP: have %d, need %d", q.remaining, cost)
+	total := q.MovementCost(cost)
+	if q.remaining < total {
+		return fmt.Errorf("not enough AP: have %d, need %d", q.remaining, total)
 	}
-	q.remaining -= cost
+	q.remaining -= total
 	q.movementAPSpent += cost
 	return nil
 }
 
+// MovementCost returns the AP actually charged for cost AP of movement,
+// including the round's MoveSurcharge.
+//
+// Postcondition: Returns cost * (1 + MoveSurcharge).
+func (q *ActionQueue) MovementCost(cost int) int {
+	return cost * (1 + q.MoveSurcharge)
+}
+
 // MovementAPSpent returns how many action points have been spent on movement this round.
 func (q *ActionQueue) MovementAPSpent() int { return q.movementAPSpent }
 
@@ -301,6 +321,12 @@ func (q *ActionQueue) Enqueue(a QueuedAction) error {
 	if a.Type == ActionUseAbility || a.Type == ActionUseTech {
 		cost = a.AbilityCost
 	}
+	if a.Type == ActionStride {
+		if q.Rooted {
+			return ErrRooted
+		}
+		cost = q.MovementCost(cost)
+	}
 	if a.Type == ActionPass {
 		q.actions = append(q.actions, a)
 		q.remaining = 0
@@ -364,6 +390,9 @@ func (q *ActionQueue) QueueFreeAction(qa QueuedAction) error {
 	if !freeActionAllowlist[qa.Type] {
 		return fmt.Errorf("QueueFreeAction: action type %q is not in the free-action allowlist", qa.Type.String())
 	}
+	if qa.Type == ActionMoveTraitStride && q.Rooted {
+		return ErrRooted
+	}
 	if qa.Type == ActionMoveTraitStride && qa.StrikeAction != 0 {
 		for _, existing := range q.actions {
 			if existing.Type == ActionMoveTraitStride && existing.StrikeAction == qa.StrikeAction {
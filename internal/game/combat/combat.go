@@ -86,10 +86,15 @@ type Combatant struct {
 	// WeaponDamageType is the damage type of the currently equipped main-hand weapon.
 	// Empty string means unarmed (no special type).
 	WeaponDamageType string
-	// Resistances maps damage type → flat damage reduction (minimum 0). Always nil for players.
+	// Resistances maps damage type → flat damage reduction (minimum 0), from the
+	// NPC template or the player's equipped armor.
 	Resistances map[string]int
-	// Weaknesses maps damage type → flat damage addition. Always nil for players.
+	// Weaknesses maps damage type → flat damage addition, from the NPC template
+	// or the player's equipped armor.
 	Weaknesses map[string]int
+	// Immunities lists the damage types this combatant takes no damage from.
+	// Conditions add to all three at damage time; see DamageAdjustmentFor.
+	Immunities []string
 	// ArmorProficiencyRank is the character's proficiency rank for their equipped armor category.
 	// Empty string or "untrained" means no proficiency bonus to AC.
 	ArmorProficiencyRank string
@@ -120,6 +125,9 @@ type Combatant struct {
 	// WeaponBonus is the item bonus from the equipped weapon's "+" designation.
 	// Applied to both attack rolls and damage rolls. Zero for NPCs and unarmed combatants.
 	WeaponBonus int
+	// WeaponAffixes is the summed stat adjustment of the equipped main-hand
+	// weapon's rolled affixes. Zero for NPCs and unaffixed weapons.
+	WeaponAffixes inventory.AffixStats
 	// SpeedFt is this combatant's movement speed in feet per stride action.
 	// 0 means 25 ft (PF2e default). Populated from NPC template at combat start;
 	// always 0 (= 25 ft default) for players.
@@ -178,6 +186,32 @@ type Combatant struct {
 	// Populated at combatant creation from conditions + feat/tech passive bonuses + equipment bonuses.
 	// Kept in sync with ActiveSet condition state via SyncConditionApply/SyncConditionRemove/SyncConditionsTick.
 	Effects *effect.EffectSet
+	// AllyOf is the UID of the player this NPC fights for, such as a hired
+	// mercenary; empty for players and hostile NPCs.
+	AllyOf string
+	// TempHP is the temporary hit point pool granted by conditions, consumables,
+	// or scripts. It absorbs damage before CurrentHP and does not stack: a new
+	// grant only raises the pool to the larger value.
+	TempHP int
+	// ShieldBlock is the per-round damage absorption granted by a raised shield.
+	// It absorbs damage before TempHP and is reset to 0 in StartRoundWithSrc.
+	ShieldBlock int
+	// KnockedOut is true when nonlethal damage dropped this combatant to 0 HP:
+	// it is out of the fight but not dying or dead. A knocked-out NPC counts
+	// as dead to the round; the server pulls it from combat alive.
+	KnockedOut bool
+	// BackRow is true when this combatant fights from the back row of its
+	// party's formation: it may only make ranged attacks, and it is harder to
+	// hit in melee while a front-row ally stands before it.
+	BackRow bool
+	// Order is the standing order given by the player this NPC fights for:
+	// "attack", "guard", or "fallback". Empty leaves the NPC to its own AI.
+	Order string
+	// OrderTarget is the name of the enemy an "attack" order is aimed at.
+	OrderTarget string
+	// Cooldowns maps the name of each job ability this combatant has used to
+	// the rounds left before it can be used again. Ticked in StartRoundWithSrc.
+	Cooldowns map[string]int
 }
 
 // SpeedBudget returns the number of speed budget points available per stride action.
@@ -207,6 +241,14 @@ func (c *Combatant) SpeedSquares() int {
 // Postcondition: Returns true iff Kind == KindPlayer.
 func (c *Combatant) IsPlayer() bool { return c.Kind == KindPlayer }
 
+// IsHostileNPC reports whether this combatant is an NPC fighting against the players.
+// Postcondition: Returns true iff Kind == KindNPC and AllyOf is empty.
+func (c *Combatant) IsHostileNPC() bool { return c.Kind == KindNPC && c.AllyOf == "" }
+
+// OnPlayerSide reports whether this combatant is a player or an NPC fighting for one.
+// Postcondition: Returns true iff Kind == KindPlayer or AllyOf is non-empty.
+func (c *Combatant) OnPlayerSide() bool { return c.Kind == KindPlayer || c.AllyOf != "" }
+
 // IsDead reports whether this combatant is permanently dead.
 // For NPCs: true when CurrentHP <= 0.
 // For players: true when Dead flag is set (dying chain resolved to death).
@@ -237,14 +279,23 @@ func (c *Combatant) ResistanceFor(dt string) int {
 	return c.Resistances[dt]
 }
 
-// ApplyDamage reduces CurrentHP by amount, flooring at zero.
+// ApplyDamage absorbs amount with ShieldBlock, then TempHP, and reduces
+// CurrentHP by the remainder, flooring at zero.
 // Precondition: amount must be >= 0.
-// Postcondition: CurrentHP >= 0.
-func (c *Combatant) ApplyDamage(amount int) {
+// Postcondition: CurrentHP >= 0; returns the damage absorbed by each pool.
+func (c *Combatant) ApplyDamage(amount int) Absorption {
+	var a Absorption
+	a.Shield = min(amount, c.ShieldBlock)
+	c.ShieldBlock -= a.Shield
+	amount -= a.Shield
+	a.TempHP = min(amount, c.TempHP)
+	c.TempHP -= a.TempHP
+	amount -= a.TempHP
 	c.CurrentHP -= amount
 	if c.CurrentHP < 0 {
 		c.CurrentHP = 0
 	}
+	return a
 }
 
 // OutcomeFor determines the PF2E 4-tier attack outcome for a given roll vs AC.
@@ -36,7 +36,7 @@ func scaleBonuses(bonuses []effect.Bonus, stacks int) []effect.Bonus {
 // BuildEffectsOpts carries all sources needed to build a Combatant's EffectSet.
 //
 // Precondition: BearerUID must be non-empty.
-// Any of Conditions / PassiveFeats / PassiveTechs may be nil (treated as empty).
+// Any of Conditions / PassiveFeats / Feats / PassiveTechs may be nil (treated as empty).
 type BuildEffectsOpts struct {
 	// BearerUID is the combatant UID that owns the resulting EffectSet.
 	BearerUID string
@@ -45,6 +45,9 @@ type BuildEffectsOpts struct {
 	// PassiveFeats are the bearer's class features; only those with Active == false
 	// and non-empty PassiveBonuses contribute.
 	PassiveFeats []*ruleset.ClassFeature
+	// Feats are the bearer's feats; only those with Active == false and
+	// non-empty PassiveBonuses contribute.
+	Feats []*ruleset.Feat
 	// PassiveTechs are the bearer's technologies; only those with Passive == true
 	// and non-empty PassiveBonuses contribute.
 	PassiveTechs []*technology.TechnologyDef
@@ -98,6 +101,19 @@ func BuildCombatantEffects(opts BuildEffectsOpts) *effect.EffectSet {
 		})
 	}
 
+	for _, f := range opts.Feats {
+		if f == nil || f.Active || len(f.PassiveBonuses) == 0 {
+			continue
+		}
+		es.Apply(effect.Effect{
+			EffectID:  f.ID,
+			SourceID:  sourcePrefixFeat + f.ID,
+			CasterUID: opts.BearerUID,
+			Bonuses:   f.PassiveBonuses,
+			DurKind:   effect.DurationUntilRemove,
+		})
+	}
+
 	// 3. Tech passive bonuses — only for passive techs with declared bonuses.
 	for _, td := range opts.PassiveTechs {
 		if td == nil || !td.Passive || len(td.PassiveBonuses) == 0 {
@@ -59,6 +59,16 @@ func TestBuildCombatantEffects_FeatPassiveBonusIncluded(t *testing.T) {
 	assert.Equal(t, 2, r.Total)
 }
 
+func TestBuildCombatantEffects_FeatCatalogBonusIncluded(t *testing.T) {
+	feats := []*ruleset.Feat{
+		{ID: "hair_trigger", PassiveBonuses: []effect.Bonus{{Stat: effect.StatAttack, Value: 1, Type: effect.BonusTypeUntyped}}},
+		{ID: "block", Active: true, PassiveBonuses: []effect.Bonus{{Stat: effect.StatAC, Value: 2, Type: effect.BonusTypeUntyped}}},
+	}
+	es := combat.BuildCombatantEffects(combat.BuildEffectsOpts{BearerUID: "uid1", Feats: feats})
+	assert.Equal(t, 1, effect.Resolve(es, effect.StatAttack).Total)
+	assert.Equal(t, 0, effect.Resolve(es, effect.StatAC).Total, "active feats contribute no passive bonus")
+}
+
 func TestBuildCombatantEffects_TechPassiveBonusIncluded(t *testing.T) {
 	techs := []*technology.TechnologyDef{
 		{
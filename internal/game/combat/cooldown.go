@@ -0,0 +1,59 @@
+package combat
+
+import (
+	"fmt"
+	"sort"
+)
+
+// StartCooldown puts the named ability on cooldown for rounds rounds.
+//
+// Precondition: name is non-empty.
+// Postcondition: Cooldown(name) == rounds; a non-positive rounds clears it.
+func (c *Combatant) StartCooldown(name string, rounds int) {
+	if rounds <= 0 {
+		delete(c.Cooldowns, name)
+		return
+	}
+	if c.Cooldowns == nil {
+		c.Cooldowns = make(map[string]int)
+	}
+	c.Cooldowns[name] = rounds
+}
+
+// Cooldown returns the rounds left before the named ability can be used again;
+// 0 means it is ready.
+func (c *Combatant) Cooldown(name string) int {
+	return c.Cooldowns[name]
+}
+
+// TickCooldowns counts every cooldown down by one round, dropping those that
+// reach zero.
+//
+// Postcondition: no entry in c.Cooldowns is <= 0.
+func (c *Combatant) TickCooldowns() {
+	for name, left := range c.Cooldowns {
+		if left <= 1 {
+			delete(c.Cooldowns, name)
+			continue
+		}
+		c.Cooldowns[name] = left - 1
+	}
+}
+
+// CooldownLabels returns "Name (N)" for each ability still on cooldown,
+// sorted by name.
+func (c *Combatant) CooldownLabels() []string {
+	if len(c.Cooldowns) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(c.Cooldowns))
+	for name := range c.Cooldowns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]string, len(names))
+	for i, name := range names {
+		out[i] = fmt.Sprintf("%s (%d)", name, c.Cooldowns[name])
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+func TestCombatant_Cooldowns(t *testing.T) {
+	c := &combat.Combatant{Name: "Alice"}
+	assert.Zero(t, c.Cooldown("Haymaker"))
+	assert.Nil(t, c.CooldownLabels())
+
+	c.StartCooldown("Haymaker", 2)
+	c.StartCooldown("Brace", 1)
+	assert.Equal(t, []string{"Brace (1)", "Haymaker (2)"}, c.CooldownLabels())
+
+	c.TickCooldowns()
+	assert.Zero(t, c.Cooldown("Brace"), "ready again")
+	assert.Equal(t, []string{"Haymaker (1)"}, c.CooldownLabels())
+
+	c.StartCooldown("Haymaker", 0)
+	assert.Zero(t, c.Cooldown("Haymaker"))
+}
+
+func TestStartRound_TicksCooldowns(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	alice := cbt.GetCombatant("p1")
+	alice.StartCooldown("Haymaker", 2)
+
+	cbt.StartRound(3)
+	assert.Equal(t, 1, alice.Cooldown("Haymaker"))
+	cbt.StartRound(3)
+	assert.Zero(t, alice.Cooldown("Haymaker"))
+}
+
+func TestProperty_Cooldown_ReadyAfterItsRounds(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		rounds := rapid.IntRange(1, 10).Draw(rt, "rounds")
+		c := &combat.Combatant{}
+		c.StartCooldown("x", rounds)
+		for i := 1; i <= rounds; i++ {
+			if c.Cooldown("x") == 0 {
+				rt.Fatalf("ready after %d of %d rounds", i-1, rounds)
+			}
+			c.TickCooldowns()
+		}
+		if c.Cooldown("x") != 0 {
+			rt.Fatalf("still cooling down after %d rounds", rounds)
+		}
+	})
+}
@@ -13,6 +13,7 @@ const (
 	StageBase       DamageStage = "base"
 	StageMultiplier DamageStage = "multiplier"
 	StageHalver     DamageStage = "halver"
+	StageImmunity   DamageStage = "immunity"
 	StageWeakness   DamageStage = "weakness"
 	StageResistance DamageStage = "resistance"
 	StageFloor      DamageStage = "floor"
@@ -45,8 +46,10 @@ type DamageInput struct {
 	Multipliers []DamageMultiplier
 	Halvers     []DamageHalver
 	DamageType  string
-	Weakness    int
-	Resistance  int
+	// Immune zeroes the damage after halving; weakness and resistance are skipped.
+	Immune     bool
+	Weakness   int
+	Resistance int
 }
 
 // DamageBreakdownStep records one stage's contribution to the final result.
@@ -65,8 +68,9 @@ type DamageResult struct {
 	Breakdown []DamageBreakdownStep
 }
 
-// ResolveDamage runs the six-stage damage pipeline and returns the final damage and an
-// ordered breakdown.
+// ResolveDamage runs the damage pipeline (base, multiplier, halver, immunity,
+// weakness, resistance, floor) and returns the final damage and an ordered breakdown.
+// An immune target takes 0 and the pipeline stops at the immunity stage.
 //
 // PF2E semantic note: ALL additives (dice, modifier, condition bonus, feat bonus, weapon
 // modifier, extra dice) flow through the same Multiplier stage, so a critical hit doubles
@@ -132,6 +136,18 @@ func ResolveDamage(in DamageInput) DamageResult {
 		cur = afterHalve
 	}
 
+	if in.Immune {
+		steps = append(steps, DamageBreakdownStep{
+			Stage:   StageImmunity,
+			Before:  cur,
+			Delta:   -cur,
+			After:   0,
+			Detail:  fmt.Sprintf("immune to %s", in.DamageType),
+			Sources: []string{"target:immunity"},
+		})
+		return DamageResult{Final: 0, Breakdown: steps}
+	}
+
 	if in.Weakness > 0 {
 		afterWeak := cur + in.Weakness
 		steps = append(steps, DamageBreakdownStep{
@@ -194,6 +210,8 @@ func FormatBreakdownInline(steps []DamageBreakdownStep) string {
 			parts = append(parts, fmt.Sprintf("×%.0f [%s] = %d", eff, step.Detail, step.After))
 		case StageHalver:
 			parts = append(parts, fmt.Sprintf("halved [%s] = %d", step.Detail, step.After))
+		case StageImmunity:
+			parts = append(parts, step.Detail+" = 0")
 		case StageWeakness:
 			parts = append(parts, fmt.Sprintf("weakness +%d = %d", step.Delta, step.After))
 		case StageResistance:
@@ -225,6 +243,8 @@ func FormatBreakdownVerbose(steps []DamageBreakdownStep) string {
 			sb.WriteString(fmt.Sprintf("  after:      %d\n", step.After))
 		case StageHalver:
 			sb.WriteString(fmt.Sprintf("  halver:     %s = %d\n", step.Detail, step.After))
+		case StageImmunity:
+			sb.WriteString(fmt.Sprintf("  immunity:   %s\n", step.Detail))
 		case StageWeakness:
 			sb.WriteString(fmt.Sprintf("  weakness:   %s\n", step.Detail))
 		case StageResistance:
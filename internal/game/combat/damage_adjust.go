@@ -0,0 +1,104 @@
+package combat
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DamageAdjustment is a target's standing against one damage type: immune,
+// or a flat weakness and resistance applied after multipliers and halving.
+type DamageAdjustment struct {
+	Immune     bool
+	Weakness   int
+	Resistance int
+}
+
+// DamageAdjustmentFor returns target's adjustment to dt damage. It merges the
+// target's own matrix (from its NPC template or equipped armor) with every
+// active condition on the target in cbt: any immunity applies, and the highest
+// weakness and highest resistance from any single source apply. This is the
+// one place damage-type adjustments are computed; every damage path reaches it
+// through BuildDamageInput or AdjustDamage.
+//
+// Precondition: target must be non-nil; cbt may be nil (own matrix only).
+// Postcondition: Returns the zero DamageAdjustment for untyped damage (dt == "").
+func DamageAdjustmentFor(cbt *Combat, target *Combatant, dt string) DamageAdjustment {
+	var adj DamageAdjustment
+	if dt == "" || target == nil {
+		return adj
+	}
+	adj.Immune = slices.Contains(target.Immunities, dt)
+	adj.Weakness = target.WeaknessFor(dt)
+	adj.Resistance = target.ResistanceFor(dt)
+	if cbt == nil {
+		return adj
+	}
+	for _, ac := range cbt.GetConditions(target.ID) {
+		if ac.Def == nil {
+			continue
+		}
+		if slices.Contains(ac.Def.Immunities, dt) {
+			adj.Immune = true
+		}
+		adj.Weakness = max(adj.Weakness, ac.Def.Weaknesses[dt])
+		adj.Resistance = max(adj.Resistance, ac.Def.Resistances[dt])
+	}
+	return adj
+}
+
+// AdjustDamage runs amount of dt damage against target through ResolveDamage,
+// for damage sources outside the attack pipeline (explosives, hazards, traps,
+// technologies).
+//
+// Precondition: target must be non-nil; amount >= 0; cbt may be nil.
+// Postcondition: Result.Final >= 0; Result.Breakdown records any immunity,
+// weakness, or resistance applied.
+func AdjustDamage(cbt *Combat, target *Combatant, dt string, amount int, source string) DamageResult {
+	adj := DamageAdjustmentFor(cbt, target, dt)
+	return ResolveDamage(DamageInput{
+		Additives:  []DamageAdditive{{Label: source, Value: amount, Source: source}},
+		DamageType: dt,
+		Immune:     adj.Immune,
+		Weakness:   adj.Weakness,
+		Resistance: adj.Resistance,
+	})
+}
+
+// AdjustmentNote returns a short narrative annotation for the immunity,
+// weakness, and resistance stages of a damage breakdown, or "" when none
+// applied.
+//
+// Postcondition: Returns e.g. "(immune to fire)", "(weakness +2)",
+// "(resisted 3)", or "(weakness +2; resisted 3)".
+func AdjustmentNote(steps []DamageBreakdownStep) string {
+	note := ""
+	add := func(s string) {
+		if note != "" {
+			note += "; "
+		}
+		note += s
+	}
+	for _, step := range steps {
+		switch step.Stage {
+		case StageImmunity:
+			add(step.Detail)
+		case StageWeakness:
+			add(fmt.Sprintf("weakness +%d", step.Delta))
+		case StageResistance:
+			add(fmt.Sprintf("resisted %d", min(-step.Delta, step.Before)))
+		}
+	}
+	if note == "" {
+		return ""
+	}
+	return "(" + note + ")"
+}
+
+// withNote appends note to narrative with a separating space; an empty note
+// leaves narrative unchanged.
+func withNote(narrative, note string) string {
+	if note == "" {
+		return narrative
+	}
+	return narrative + " " + note
+}
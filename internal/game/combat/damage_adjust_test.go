@@ -0,0 +1,106 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/condition"
+)
+
+func newAdjustTestCombat(t *testing.T, defs ...*condition.ConditionDef) *combat.Combat {
+	t.Helper()
+	reg := condition.NewRegistry()
+	for _, d := range defs {
+		reg.Register(d)
+	}
+	combatants := []*combat.Combatant{
+		{ID: "p1", Kind: combat.KindPlayer, Name: "Alice", MaxHP: 20, CurrentHP: 20, AC: 14, Level: 1},
+		{ID: "n1", Kind: combat.KindNPC, Name: "Ganger", MaxHP: 12, CurrentHP: 12, AC: 12, Level: 1},
+	}
+	cbt, err := combat.NewEngine().StartCombat("room1", combatants, reg, nil, "")
+	require.NoError(t, err)
+	return cbt
+}
+
+func TestDamageAdjustmentFor_MergesConditionWithOwnMatrix(t *testing.T) {
+	cbt := newAdjustTestCombat(t, &condition.ConditionDef{
+		ID: "soaked", Name: "Soaked", DurationType: "permanent",
+		Resistances: map[string]int{"fire": 5},
+		Weaknesses:  map[string]int{"electricity": 2},
+	})
+	p1 := cbt.GetCombatant("p1")
+	p1.Resistances = map[string]int{"fire": 2}
+	p1.Weaknesses = map[string]int{"electricity": 4}
+	require.NoError(t, cbt.ApplyCondition("p1", "soaked", 1, -1))
+
+	assert.Equal(t, combat.DamageAdjustment{Resistance: 5}, combat.DamageAdjustmentFor(cbt, p1, "fire"))
+	assert.Equal(t, combat.DamageAdjustment{Weakness: 4}, combat.DamageAdjustmentFor(cbt, p1, "electricity"))
+	assert.Equal(t, combat.DamageAdjustment{}, combat.DamageAdjustmentFor(cbt, p1, ""))
+}
+
+func TestDamageAdjustmentFor_ConditionImmunity(t *testing.T) {
+	cbt := newAdjustTestCombat(t, &condition.ConditionDef{
+		ID: "fireproof", Name: "Fireproof", DurationType: "permanent",
+		Immunities: []string{"fire"},
+	})
+	p1 := cbt.GetCombatant("p1")
+	assert.False(t, combat.DamageAdjustmentFor(cbt, p1, "fire").Immune)
+	require.NoError(t, cbt.ApplyCondition("p1", "fireproof", 1, -1))
+	assert.True(t, combat.DamageAdjustmentFor(cbt, p1, "fire").Immune)
+	assert.False(t, combat.DamageAdjustmentFor(cbt, p1, "cold").Immune)
+}
+
+func TestAdjustDamage_ImmunityZeroesDamage(t *testing.T) {
+	target := &combat.Combatant{ID: "n1", Immunities: []string{"poison"}, Weaknesses: map[string]int{"poison": 5}}
+	r := combat.AdjustDamage(nil, target, "poison", 9, "trap:needle")
+	assert.Equal(t, 0, r.Final)
+	assert.Equal(t, "(immune to poison)", combat.AdjustmentNote(r.Breakdown))
+}
+
+func TestAdjustDamage_ResistanceNote(t *testing.T) {
+	target := &combat.Combatant{ID: "n1", Resistances: map[string]int{"fire": 3}}
+	r := combat.AdjustDamage(nil, target, "fire", 10, "hazard:fire")
+	assert.Equal(t, 7, r.Final)
+	assert.Equal(t, "(resisted 3)", combat.AdjustmentNote(r.Breakdown))
+
+	r = combat.AdjustDamage(nil, target, "fire", 2, "hazard:fire")
+	assert.Equal(t, 0, r.Final)
+	assert.Equal(t, "(resisted 2)", combat.AdjustmentNote(r.Breakdown), "cannot resist more than was dealt")
+}
+
+func TestAdjustmentNote_WeaknessAndResistance(t *testing.T) {
+	target := &combat.Combatant{
+		ID:          "n1",
+		Resistances: map[string]int{"slashing": 3},
+		Weaknesses:  map[string]int{"slashing": 2},
+	}
+	r := combat.AdjustDamage(nil, target, "slashing", 6, "test")
+	assert.Equal(t, 5, r.Final)
+	assert.Equal(t, "(weakness +2; resisted 3)", combat.AdjustmentNote(r.Breakdown))
+	assert.Equal(t, "", combat.AdjustmentNote(combat.AdjustDamage(nil, target, "fire", 6, "test").Breakdown))
+}
+
+func TestAttackNarrative_ShowsResisted(t *testing.T) {
+	cbt := newNarrativeTestCombat(t)
+	cbt.GetCombatant("p1").WeaponDamageType = "fire"
+	cbt.GetCombatant("n1").Resistances = map[string]int{"fire": 1}
+	narrative := resolveAttackNarrative(t, cbt, fixedSrc{val: 13})
+	assert.Contains(t, narrative, "(resisted 1)")
+}
+
+func TestProperty_AdjustDamage_ImmunityAlwaysZero(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		target := &combat.Combatant{
+			ID:          "n1",
+			Immunities:  []string{"acid"},
+			Weaknesses:  map[string]int{"acid": rapid.IntRange(0, 20).Draw(rt, "weak")},
+			Resistances: map[string]int{"acid": rapid.IntRange(0, 20).Draw(rt, "res")},
+		}
+		amount := rapid.IntRange(0, 100).Draw(rt, "amount")
+		assert.Equal(rt, 0, combat.AdjustDamage(nil, target, "acid", amount, "test").Final)
+	})
+}
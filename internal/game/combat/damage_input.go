@@ -2,6 +2,7 @@ package combat
 
 // BuildDamageOpts carries the inputs for assembling a DamageInput at a call site.
 type BuildDamageOpts struct {
+	Combat            *Combat // supplies the target's condition-granted adjustments; may be nil
 	Actor             *Combatant
 	Target            *Combatant
 	AttackResult      AttackResult
@@ -74,23 +75,15 @@ func BuildDamageInput(opts BuildDamageOpts) DamageInput {
 
 	halvers := opts.Halvers
 
-	weakness := 0
-	resistance := 0
-	if r.DamageType != "" && target != nil {
-		if target.Weaknesses != nil {
-			weakness = target.Weaknesses[r.DamageType]
-		}
-		if target.Resistances != nil {
-			resistance = target.Resistances[r.DamageType]
-		}
-	}
+	adj := DamageAdjustmentFor(opts.Combat, target, r.DamageType)
 
 	return DamageInput{
 		Additives:   additives,
 		Multipliers: multipliers,
 		Halvers:     halvers,
 		DamageType:  r.DamageType,
-		Weakness:    weakness,
-		Resistance:  resistance,
+		Immune:      adj.Immune,
+		Weakness:    adj.Weakness,
+		Resistance:  adj.Resistance,
 	}
 }
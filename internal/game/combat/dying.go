@@ -0,0 +1,178 @@
+package combat
+
+import "fmt"
+
+// DeathDyingValue is the dying value at which a player dies.
+const DeathDyingValue = 4
+
+// recoveryCheckDC is the base DC of the round-start recovery flat check; the
+// dying value is added to it.
+const recoveryCheckDC = 10
+
+// IsDying reports whether uid is a living combatant with the dying condition.
+func (c *Combat) IsDying(uid string) bool {
+	cbt := findCombatantByID(c, uid)
+	return cbt != nil && !cbt.IsDead() && c.HasCondition(uid, "dying")
+}
+
+// HasFightingPlayers reports whether any player combatant is still in the
+// fight: standing with HP > 0, or down but dying and not yet dead. A player
+// at 0 HP without the dying condition is out of the fight.
+//
+// Postcondition: Returns true iff some player is standing or dying.
+func (c *Combat) HasFightingPlayers() bool {
+	for _, cbt := range c.Combatants {
+		if cbt.Kind != KindPlayer || cbt.IsDead() {
+			continue
+		}
+		if cbt.CurrentHP > 0 || c.HasCondition(cbt.ID, "dying") {
+			return true
+		}
+	}
+	return false
+}
+
+// recoveryCheck rolls the round-start recovery flat check for a dying
+// combatant: 1d20 against DC 10 + dying value, where a natural 20 or 1 moves
+// the result one degree up or down. A critical success lowers dying by 2, a
+// success by 1, a failure raises it by 1, and a critical failure by 2.
+//
+// Precondition: cbt has the dying condition; src must not be nil.
+// Postcondition: Returns the events describing the check and its result.
+func (c *Combat) recoveryCheck(cbt *Combatant, src Source) []RoundConditionEvent {
+	dying := c.Conditions[cbt.ID].Stacks("dying")
+	dc := recoveryCheckDC + dying
+	roll := src.Intn(20) + 1
+	outcome := OutcomeFor(roll, dc)
+	switch {
+	case roll == 20 && outcome > CritSuccess:
+		outcome--
+	case roll == 1 && outcome < CritFailure:
+		outcome++
+	}
+	var delta int
+	switch outcome {
+	case CritSuccess:
+		delta = -2
+	case Success:
+		delta = -1
+	case Failure:
+		delta = 1
+	default:
+		delta = 2
+	}
+	ev := RoundConditionEvent{
+		UID: cbt.ID, Name: cbt.Name,
+		ConditionID: "dying", CondName: "Dying",
+		Stacks: dying, Applied: true,
+		Narrative: fmt.Sprintf("%s's recovery check: %d vs DC %d — %s.", cbt.Name, roll, dc, outcome),
+	}
+	events := []RoundConditionEvent{ev}
+	if delta < 0 {
+		if dying+delta > 0 {
+			return append(events, c.setDying(cbt, dying+delta)...)
+		}
+		return append(events, c.stabilize(cbt, true)...)
+	}
+	return append(events, c.IncreaseDying(cbt.ID, delta)...)
+}
+
+// IncreaseDying raises uid's dying value by n, e.g. when a dying player takes
+// damage or a stabilization attempt critically fails. Reaching DeathDyingValue
+// kills the player.
+//
+// Precondition: n > 0.
+// Postcondition: Returns nil when uid is not dying; otherwise the events
+// describing the new dying value or the death.
+func (c *Combat) IncreaseDying(uid string, n int) []RoundConditionEvent {
+	cbt := findCombatantByID(c, uid)
+	if cbt == nil || !c.IsDying(uid) {
+		return nil
+	}
+	dying := c.Conditions[uid].Stacks("dying") + n
+	if dying < DeathDyingValue {
+		return c.setDying(cbt, dying)
+	}
+	cbt.CurrentHP = 0
+	cbt.Dead = true
+	c.Conditions[uid].Remove(uid, "dying")
+	SyncConditionRemove(cbt, "dying")
+	return []RoundConditionEvent{{
+		UID: cbt.ID, Name: cbt.Name,
+		ConditionID: "dying", CondName: "Dying",
+		Stacks:    DeathDyingValue,
+		Narrative: fmt.Sprintf("%s reaches dying %d and dies.", cbt.Name, DeathDyingValue),
+	}}
+}
+
+// checkDyingOnDown kills a player whose dying value on going down already
+// reaches DeathDyingValue (e.g. dying 1 plus wounded 3).
+//
+// Postcondition: Returns true when the player died.
+func (c *Combat) checkDyingOnDown(cbt *Combatant) bool {
+	if c.Conditions[cbt.ID].Stacks("dying") < DeathDyingValue {
+		return false
+	}
+	cbt.Dead = true
+	c.Conditions[cbt.ID].Remove(cbt.ID, "dying")
+	SyncConditionRemove(cbt, "dying")
+	return true
+}
+
+// Stabilize ends uid's dying condition and brings them back to 1 HP, as from
+// successful first aid. When wounded is true the player gains wounded 1 (or
+// raises it by 1); a hero point stabilization passes false.
+//
+// Postcondition: Returns an error when uid is not a dying combatant;
+// otherwise the events describing the recovery.
+func (c *Combat) Stabilize(uid string, wounded bool) ([]RoundConditionEvent, error) {
+	cbt := findCombatantByID(c, uid)
+	if cbt == nil || !c.IsDying(uid) {
+		return nil, fmt.Errorf("%q is not dying", uid)
+	}
+	return c.stabilize(cbt, wounded), nil
+}
+
+// stabilize removes cbt's dying condition, optionally adds a wounded stack,
+// and restores cbt to 1 HP.
+func (c *Combat) stabilize(cbt *Combatant, wounded bool) []RoundConditionEvent {
+	s := c.Conditions[cbt.ID]
+	s.Remove(cbt.ID, "dying")
+	SyncConditionRemove(cbt, "dying")
+	cbt.CurrentHP = 1
+	events := []RoundConditionEvent{{
+		UID: cbt.ID, Name: cbt.Name,
+		ConditionID: "dying", CondName: "Dying",
+		Narrative: fmt.Sprintf("%s stabilizes and comes to with 1 HP.", cbt.Name),
+	}}
+	if !wounded {
+		return events
+	}
+	if woundedDef, ok := c.condRegistry.Get("wounded"); ok {
+		_ = s.Apply(cbt.ID, woundedDef, 1, -1)
+		SyncConditionApply(cbt, cbt.ID, woundedDef, s.Stacks("wounded"))
+		events = append(events, RoundConditionEvent{
+			UID: cbt.ID, Name: cbt.Name,
+			ConditionID: "wounded", CondName: woundedDef.Name,
+			Stacks: s.Stacks("wounded"), Applied: true,
+		})
+	}
+	return events
+}
+
+// setDying replaces cbt's dying value with stacks.
+//
+// Precondition: 0 < stacks < DeathDyingValue.
+func (c *Combat) setDying(cbt *Combatant, stacks int) []RoundConditionEvent {
+	s := c.Conditions[cbt.ID]
+	if dyingDef, ok := c.condRegistry.Get("dying"); ok {
+		s.Remove(cbt.ID, "dying")
+		_ = s.Apply(cbt.ID, dyingDef, stacks, -1)
+		SyncConditionApply(cbt, cbt.ID, dyingDef, s.Stacks("dying"))
+	}
+	return []RoundConditionEvent{{
+		UID: cbt.ID, Name: cbt.Name,
+		ConditionID: "dying", CondName: "Dying",
+		Stacks: stacks, Applied: true,
+	}}
+}
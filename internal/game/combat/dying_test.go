@@ -0,0 +1,145 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+// downAlice drops p1 to 0 HP with the given dying value.
+func downAlice(t *testing.T, cbt *combat.Combat, dying int) {
+	t.Helper()
+	cbt.Combatants[0].CurrentHP = 0
+	require.NoError(t, cbt.ApplyCondition("p1", "dying", dying, -1))
+}
+
+func TestRecoveryCheck_DCScalesWithDyingValue(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	downAlice(t, cbt, 3)
+	// roll 13 vs DC 13 → success: dying 3 → 2, still down.
+	events := cbt.StartRoundWithSrc(3, &fixedSrc{val: 12})
+	assert.Equal(t, 2, cbt.DyingStacks("p1"))
+	assert.Equal(t, 0, cbt.Combatants[0].CurrentHP)
+	require.NotEmpty(t, events)
+	assert.Equal(t, "Alice's recovery check: 13 vs DC 13 — success.", events[0].Narrative)
+}
+
+func TestRecoveryCheck_CritSuccess_LowersDyingByTwo(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	downAlice(t, cbt, 3)
+	// natural 20 vs DC 13 → success improved to crit success: dying 3 → 1.
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 19})
+	assert.Equal(t, 1, cbt.DyingStacks("p1"))
+}
+
+func TestRecoveryCheck_NaturalOne_CritFailure_RaisesDyingByTwo(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	downAlice(t, cbt, 1)
+	// natural 1 vs DC 11 → failure worsened to crit failure: dying 1 → 3.
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	assert.Equal(t, 3, cbt.DyingStacks("p1"))
+	assert.False(t, cbt.Combatants[0].IsDead())
+}
+
+func TestIncreaseDying_ReachingFourKills(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	downAlice(t, cbt, 2)
+	events := cbt.IncreaseDying("p1", 2)
+	require.Len(t, events, 1)
+	assert.True(t, cbt.Combatants[0].IsDead())
+	assert.False(t, cbt.IsDying("p1"))
+	assert.Contains(t, events[0].Narrative, "dies")
+}
+
+func TestIncreaseDying_NotDying_NoOp(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	assert.Nil(t, cbt.IncreaseDying("p1", 1))
+	assert.False(t, cbt.HasCondition("p1", "dying"))
+}
+
+func TestStabilize_WithWounded(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	downAlice(t, cbt, 2)
+	_, err := cbt.Stabilize("p1", true)
+	require.NoError(t, err)
+	assert.False(t, cbt.IsDying("p1"))
+	assert.Equal(t, 1, cbt.Combatants[0].CurrentHP)
+	assert.True(t, cbt.HasCondition("p1", "wounded"))
+}
+
+func TestStabilize_WithoutWounded(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	downAlice(t, cbt, 2)
+	_, err := cbt.Stabilize("p1", false)
+	require.NoError(t, err)
+	assert.False(t, cbt.IsDying("p1"))
+	assert.False(t, cbt.HasCondition("p1", "wounded"))
+}
+
+func TestStabilize_NotDying_ReturnsError(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	_, err := cbt.Stabilize("p1", true)
+	assert.Error(t, err)
+}
+
+func TestHasFightingPlayers(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	assert.True(t, cbt.HasFightingPlayers(), "standing player is fighting")
+
+	cbt.Combatants[0].CurrentHP = 0
+	assert.False(t, cbt.HasFightingPlayers(), "downed player without dying is out")
+
+	require.NoError(t, cbt.ApplyCondition("p1", "dying", 1, -1))
+	assert.True(t, cbt.HasFightingPlayers(), "dying player keeps the fight going")
+
+	cbt.IncreaseDying("p1", 3)
+	assert.False(t, cbt.HasFightingPlayers(), "dead player is out")
+}
+
+func TestResolveRound_DyingPlayerHit_DyingAdvances(t *testing.T) {
+	cbt := makeCombatForRoundConditions(t)
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	downAlice(t, cbt, 1)
+	cbt.Combatants[0].AC = 1
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Alice"}))
+	// Intn(20)=19 → crit success on a dying target → dying 1 → 3.
+	_ = combat.ResolveRound(cbt, &fixedSrc{val: 19}, nil, nil, 0)
+	assert.Equal(t, 3, cbt.DyingStacks("p1"))
+}
+
+func TestResolveRound_DownedWithHeavyWounds_DiesOutright(t *testing.T) {
+	cbt := makeCombatForRoundConditions(t)
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	require.NoError(t, cbt.ApplyCondition("p1", "wounded", 3, -1))
+	cbt.Combatants[0].CurrentHP = 1
+	cbt.Combatants[0].AC = 1
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionPass}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Alice"}))
+	_ = combat.ResolveRound(cbt, &fixedSrc{val: 19}, nil, nil, 0)
+	assert.True(t, cbt.Combatants[0].IsDead(), "dying 1 + wounded 3 reaches dying 4")
+}
+
+func TestProperty_RecoveryCheck_DyingStaysInRangeOrResolves(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		_, cbt := makeCombatWithConditions(t)
+		stacks := rapid.IntRange(1, 3).Draw(rt, "stacks")
+		roll := rapid.IntRange(0, 19).Draw(rt, "roll")
+		cbt.Combatants[0].CurrentHP = 0
+		require.NoError(rt, cbt.ApplyCondition("p1", "dying", stacks, -1))
+
+		_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: roll})
+		switch {
+		case cbt.Combatants[0].IsDead():
+			assert.False(rt, cbt.HasCondition("p1", "dying"))
+		case cbt.IsDying("p1"):
+			assert.GreaterOrEqual(rt, cbt.DyingStacks("p1"), 1)
+			assert.Less(rt, cbt.DyingStacks("p1"), combat.DeathDyingValue)
+		default:
+			assert.Equal(rt, 1, cbt.Combatants[0].CurrentHP, "recovered players come to with 1 HP")
+		}
+	})
+}
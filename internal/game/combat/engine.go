@@ -3,6 +3,7 @@ package combat
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 
 	"github.com/cory-johannsen/mud/internal/game/condition"
@@ -32,6 +33,9 @@ type Combat struct {
 	Round int
 	// ActionQueues maps combatant UID to their ActionQueue for the current round.
 	ActionQueues map[string]*ActionQueue
+	// roundAP is the base AP budget of the current round, recorded by
+	// StartRoundWithSrc so combatants joining mid-round get the same budget.
+	roundAP int
 	// Conditions maps combatant UID to their active condition set.
 	Conditions map[string]*condition.ActiveSet
 	// condRegistry is the condition registry for this combat.
@@ -48,6 +52,9 @@ type Combat struct {
 	// DamageDealt maps combatant UID → total damage dealt this combat.
 	// Initialized in StartCombat. Used for highest_damage_enemy target selection.
 	DamageDealt map[string]int
+	// Recap tallies the encounter's statistics round by round for the
+	// end-of-combat recap. Initialized in StartCombat.
+	Recap *Recap
 	// GridWidth is the number of columns in the combat grid. Default: 10.
 	GridWidth int
 	// GridHeight is the number of rows in the combat grid. Default: 10.
@@ -70,6 +77,10 @@ type Combat struct {
 	// ReadyRegistry holds pending Ready entries for the current round.
 	// Populated by QueueAction(ActionReady); consumed by ResolveRound.
 	ReadyRegistry *reaction.ReadyRegistry
+	// DamageMultiplier scales the damage of every attack resolved in this
+	// combat; 0 is treated as 1. The combat handler refreshes it from the
+	// zone's engine.zone modifiers before each round is resolved.
+	DamageMultiplier float64
 	// DetectionStates is the per-pair (observer→target) PF2E detection ladder
 	// driving GateAttack, RoomView filtering, and reaction gating (DETECT-3).
 	// Initialised at StartCombat. Absent pairs default to detection.Observed.
@@ -77,6 +88,9 @@ type Combat struct {
 	// back-compat shim populateDetectionFromLegacyHidden so pinned tests in
 	// round_hidden_test.go keep working unchanged.
 	DetectionStates *detection.Map
+	// RoomEffects are the persisting area effects on the floor of this fight
+	// (burning ground, gas clouds), fired at each round end and on entry.
+	RoomEffects []*RoomEffect
 }
 
 // SkipHazardRoundStart marks uid so that the next StartRoundWithSrc call will
@@ -114,10 +128,16 @@ type RoundConditionEvent struct {
 	CondName    string
 	Stacks      int
 	Applied     bool // true=applied/advanced, false=removed/expired
+	// Narrative, when set, describes the event in place of the default
+	// applied/faded wording (e.g. crowd control taking hold at round start).
+	Narrative string
 }
 
 // StartRound increments Round, ticks conditions, resolves dying recovery checks,
-// applies stunned AP reduction, and resets ActionQueues for all living combatants.
+// and resets ActionQueues for all living combatants. Crowd control shapes each
+// new queue: stunned and other AP-reducing conditions take AP away, rooting
+// conditions block movement, and slowed raises movement costs; each emits a
+// round-start narrative event.
 //
 // Postcondition: Round incremented by 1; ActionQueues reset; returns condition events.
 func (c *Combat) StartRound(actionsPerRound int) []RoundConditionEvent {
@@ -150,6 +170,9 @@ func (c *Combat) StartRoundWithSrc(actionsPerRound int, src Source) []RoundCondi
 		}
 		cbt.ReactionBudget.Reset(baseMax)
 		cbt.MadeSoundThisRound = false
+		// A raised shield only blocks for the round it was raised in.
+		cbt.ShieldBlock = 0
+		cbt.TickCooldowns()
 	}
 
 	var events []RoundConditionEvent
@@ -181,65 +204,9 @@ func (c *Combat) StartRoundWithSrc(actionsPerRound int, src Source) []RoundCondi
 			})
 		}
 
-		// Dying recovery check (DC 15 flat check)
+		// Dying recovery check (flat check vs DC 10 + dying value)
 		if s.Has("dying") {
-			dyingStacks := s.Stacks("dying")
-			roll := src.Intn(20) + 1
-			switch {
-			case roll == 20: // natural 20 = crit success: remove dying entirely, restore to 1 HP
-				s.Remove(cbt.ID, "dying")
-				SyncConditionRemove(cbt, "dying")
-				cbt.CurrentHP = 1
-				events = append(events, RoundConditionEvent{
-					UID: cbt.ID, Name: cbt.Name,
-					ConditionID: "dying", CondName: "Dying",
-					Applied: false,
-				})
-			case roll >= 15: // success: remove dying, apply wounded +1, restore to 1 HP
-				s.Remove(cbt.ID, "dying")
-				SyncConditionRemove(cbt, "dying")
-				if woundedDef, ok := c.condRegistry.Get("wounded"); ok {
-					_ = s.Apply(cbt.ID, woundedDef, 1, -1)
-					SyncConditionApply(cbt, cbt.ID, woundedDef, s.Stacks("wounded"))
-				}
-				cbt.CurrentHP = 1
-				events = append(events, RoundConditionEvent{
-					UID: cbt.ID, Name: cbt.Name,
-					ConditionID: "dying", CondName: "Dying",
-					Applied: false,
-				})
-				events = append(events, RoundConditionEvent{
-					UID: cbt.ID, Name: cbt.Name,
-					ConditionID: "wounded", CondName: "Wounded",
-					Stacks:  s.Stacks("wounded"),
-					Applied: true,
-				})
-			default: // failure: advance dying stacks
-				dyingStacks++
-				if dyingStacks >= 4 {
-					// Dying 4 = death
-					cbt.CurrentHP = 0
-					cbt.Dead = true
-					s.Remove(cbt.ID, "dying")
-					SyncConditionRemove(cbt, "dying")
-					events = append(events, RoundConditionEvent{
-						UID: cbt.ID, Name: cbt.Name,
-						ConditionID: "dying", CondName: "Dying",
-						Stacks: 4, Applied: false,
-					})
-				} else {
-					if dyingDef, ok := c.condRegistry.Get("dying"); ok {
-						s.Remove(cbt.ID, "dying")
-						_ = s.Apply(cbt.ID, dyingDef, dyingStacks, -1)
-						SyncConditionApply(cbt, cbt.ID, dyingDef, s.Stacks("dying"))
-					}
-					events = append(events, RoundConditionEvent{
-						UID: cbt.ID, Name: cbt.Name,
-						ConditionID: "dying", CondName: "Dying",
-						Stacks: dyingStacks, Applied: true,
-					})
-				}
-			}
+			events = append(events, c.recoveryCheck(cbt, src)...)
 		}
 	}
 
@@ -275,37 +242,100 @@ func (c *Combat) StartRoundWithSrc(actionsPerRound int, src Source) []RoundCondi
 	// Prone combatants pay 1 AP at round start and then stand (the condition is
 	// cleared), matching the intent recorded in prone.yaml and the critical-miss
 	// narrative applied in round.go.
+	c.roundAP = actionsPerRound
 	c.ActionQueues = make(map[string]*ActionQueue)
 	for _, cbt := range c.Combatants {
 		if cbt.IsDead() {
 			continue
 		}
-		ap := actionsPerRound
-		s := c.Conditions[cbt.ID]
-		reduction := condition.StunnedAPReduction(s) + condition.APReduction(s)
-
-		if s.Has("prone") {
-			reduction++
-			s.Remove(cbt.ID, "prone")
-			SyncConditionRemove(cbt, "prone")
-			name := "Prone"
-			if def, ok := c.condRegistry.Get("prone"); ok && def != nil {
-				name = def.Name
-			}
-			events = append(events, RoundConditionEvent{
-				UID: cbt.ID, Name: cbt.Name,
-				ConditionID: "prone", CondName: name,
-				Applied: false,
-			})
-		}
+		events = append(events, c.allocateAP(cbt)...)
+	}
 
-		ap -= reduction
-		if ap < 0 {
-			ap = 0
-		}
-		c.ActionQueues[cbt.ID] = NewActionQueue(cbt.ID, ap)
+	return events
+}
+
+// allocateAP builds cbt's action queue for the current round from the round's
+// base AP budget less stunned, ap_reduction, and prone stand-up costs, standing a
+// prone combatant up, and returns the resulting round-start narratives.
+//
+// Precondition: c.ActionQueues must be non-nil; cbt must be a living combatant in c.
+// Postcondition: c.ActionQueues[cbt.ID] holds a fresh queue with >= 0 AP.
+func (c *Combat) allocateAP(cbt *Combatant) []RoundConditionEvent {
+	var events []RoundConditionEvent
+	ap := c.roundAP
+	s := c.Conditions[cbt.ID]
+	reduction := condition.StunnedAPReduction(s) + condition.APReduction(s)
+
+	if s.Has("prone") {
+		reduction++
+		s.Remove(cbt.ID, "prone")
+		SyncConditionRemove(cbt, "prone")
+		name := "Prone"
+		if def, ok := c.condRegistry.Get("prone"); ok && def != nil {
+			name = def.Name
+		}
+		events = append(events, RoundConditionEvent{
+			UID: cbt.ID, Name: cbt.Name,
+			ConditionID: "prone", CondName: name,
+			Applied: false,
+		})
 	}
 
+	ap -= reduction
+	if ap < 0 {
+		ap = 0
+	}
+	q := NewActionQueue(cbt.ID, ap)
+	q.Rooted = condition.IsRooted(s)
+	q.MoveSurcharge = condition.MoveAPSurcharge(s)
+	c.ActionQueues[cbt.ID] = q
+	return append(events, crowdControlEvents(cbt, s, q)...)
+}
+
+// crowdControlEvents returns the round-start narratives for the crowd control
+// shaping cbt's new queue q.
+//
+// Precondition: cbt and q must not be nil; s may be nil.
+func crowdControlEvents(cbt *Combatant, s *condition.ActiveSet, q *ActionQueue) []RoundConditionEvent {
+	var events []RoundConditionEvent
+	if stun := condition.StunnedAPReduction(s); stun > 0 {
+		events = append(events, RoundConditionEvent{
+			UID: cbt.ID, Name: cbt.Name,
+			ConditionID: "stunned", CondName: "Stunned",
+			Stacks: stun, Applied: true,
+			Narrative: fmt.Sprintf("%s is stunned and loses %d AP this round.", cbt.Name, stun),
+		})
+	}
+	if s != nil {
+		active := s.All()
+		sort.Slice(active, func(i, j int) bool { return active[i].Def.ID < active[j].Def.ID })
+		for _, ac := range active {
+			if lost := ac.Def.APReduction * ac.Stacks; lost > 0 {
+				events = append(events, RoundConditionEvent{
+					UID: cbt.ID, Name: cbt.Name,
+					ConditionID: ac.Def.ID, CondName: ac.Def.Name,
+					Stacks: ac.Stacks, Applied: true,
+					Narrative: fmt.Sprintf("%s loses %d AP to %s this round.", cbt.Name, lost, ac.Def.Name),
+				})
+			}
+		}
+	}
+	if q.Rooted {
+		events = append(events, RoundConditionEvent{
+			UID: cbt.ID, Name: cbt.Name,
+			ConditionID: "rooted", CondName: "Rooted",
+			Applied:   true,
+			Narrative: fmt.Sprintf("%s is rooted in place and cannot move this round.", cbt.Name),
+		})
+	}
+	if q.MoveSurcharge > 0 {
+		events = append(events, RoundConditionEvent{
+			UID: cbt.ID, Name: cbt.Name,
+			ConditionID: "slowed", CondName: "Slowed",
+			Stacks: q.MoveSurcharge, Applied: true,
+			Narrative: fmt.Sprintf("%s is slowed; moving costs %d extra AP per step this round.", cbt.Name, q.MoveSurcharge),
+		})
+	}
 	return events
 }
 
@@ -356,7 +386,8 @@ func (realSrc) Intn(n int) int { return rand.Intn(n) }
 // Returns error if uid is not a combatant or condID is unknown.
 //
 // Precondition: uid must be a valid combatant ID; condID must be registered.
-// Postcondition: The condition is active on the combatant.
+// Postcondition: The condition is active on the combatant; any temp_hp it
+// grants has been added to the combatant's TempHP pool.
 func (c *Combat) ApplyCondition(uid, condID string, stacks, duration int) error {
 	def, ok := c.condRegistry.Get(condID)
 	if !ok {
@@ -380,6 +411,9 @@ func (c *Combat) ApplyCondition(uid, condID string, stacks, duration int) error
 	if cbt := findCombatantByID(c, uid); cbt != nil {
 		stored := s.Stacks(condID)
 		SyncConditionApply(cbt, uid, def, stored)
+		if def.TempHP > 0 {
+			cbt.GrantTempHP(def.TempHP)
+		}
 	}
 	return nil
 }
@@ -525,12 +559,13 @@ func (c *Combat) LivingCombatants() []*Combatant {
 	return alive
 }
 
-// HasLivingNPCs reports whether any NPC combatant is still alive.
+// HasLivingNPCs reports whether any hostile NPC combatant is still alive.
+// NPCs fighting for a player do not keep a combat going.
 //
-// Postcondition: Returns true iff at least one KindNPC combatant has CurrentHP > 0.
+// Postcondition: Returns true iff at least one hostile NPC combatant has CurrentHP > 0.
 func (c *Combat) HasLivingNPCs() bool {
 	for _, cbt := range c.Combatants {
-		if cbt.Kind == KindNPC && !cbt.IsDead() {
+		if cbt.IsHostileNPC() && !cbt.IsDead() {
 			return true
 		}
 	}
@@ -602,6 +637,7 @@ func (e *Engine) StartCombat(roomID string, combatants []*Combatant, condRegistr
 		ActionQueues:    make(map[string]*ActionQueue),
 		Conditions:      make(map[string]*condition.ActiveSet),
 		DamageDealt:     make(map[string]int),
+		Recap:           NewRecap(),
 		condRegistry:    condRegistry,
 		scriptMgr:       scriptMgr,
 		zoneID:          zoneID,
@@ -740,7 +776,8 @@ func (e *Engine) EndCombat(roomID string) {
 //
 //	c.ID appended to cbt.Participants if c.Kind == KindPlayer;
 //	cbt.Conditions[c.ID] initialized to match the pattern used in StartCombat.
-//	ActionQueues is NOT populated — StartRound rebuilds it each round.
+//	ActionQueues is NOT populated — StartRound rebuilds it each round;
+//	call Combat.JoinRound to let c act in the round already under way.
 //
 // Locking: acquires e.mu (write lock) for the full duration. Caller must NOT hold e.mu.
 //
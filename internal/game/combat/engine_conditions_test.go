@@ -19,6 +19,8 @@ func makeConditionReg() *condition.Registry {
 	reg.Register(&condition.ConditionDef{ID: "wounded", Name: "Wounded", DurationType: "permanent", MaxStacks: 3})
 	reg.Register(&condition.ConditionDef{ID: "stunned", Name: "Stunned", DurationType: "rounds", MaxStacks: 3})
 	reg.Register(&condition.ConditionDef{ID: "frightened", Name: "Frightened", DurationType: "rounds", MaxStacks: 4, AttackPenalty: 1, ACPenalty: 1})
+	reg.Register(&condition.ConditionDef{ID: "rooted", Name: "Rooted", DurationType: "rounds", RestrictActions: []string{"stride", "flee"}})
+	reg.Register(&condition.ConditionDef{ID: "slowed", Name: "Slowed", DurationType: "rounds", MaxStacks: 2, MoveAPPenalty: 1})
 	return reg
 }
 
@@ -93,11 +95,51 @@ func TestStartRoundWithSrc_StunnedReducesAP(t *testing.T) {
 	assert.Equal(t, 1, q.RemainingPoints(), "3 AP - 2 stunned = 1 remaining")
 }
 
+func TestStartRoundWithSrc_StunnedNarrative(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "stunned", 2, 2))
+	events := cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	var narratives []string
+	for _, e := range events {
+		narratives = append(narratives, e.Narrative)
+	}
+	assert.Contains(t, narratives, "Alice is stunned and loses 2 AP this round.")
+}
+
+func TestStartRoundWithSrc_RootedBlocksMovement(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "rooted", 1, 2))
+	events := cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	q := cbt.ActionQueues["p1"]
+	require.NotNil(t, q)
+	assert.True(t, q.Rooted)
+	assert.ErrorIs(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionStride, Direction: "away"}), combat.ErrRooted)
+	assert.ErrorIs(t, q.DeductMovementAP(1), combat.ErrRooted)
+	assert.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Ganger"}), "rooted combatants can still fight")
+	require.NotEmpty(t, events)
+	assert.Equal(t, "Alice is rooted in place and cannot move this round.", events[len(events)-1].Narrative)
+	assert.False(t, cbt.ActionQueues["n1"].Rooted)
+}
+
+func TestStartRoundWithSrc_SlowedRaisesMovementCost(t *testing.T) {
+	_, cbt := makeCombatWithConditions(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "slowed", 1, 3))
+	events := cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	q := cbt.ActionQueues["p1"]
+	require.NotNil(t, q)
+	assert.Equal(t, 3, q.RemainingPoints(), "slowed costs no AP up front")
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionStride, Direction: "toward"}))
+	assert.Equal(t, 1, q.RemainingPoints(), "a 1 AP stride costs 2 AP while slowed 1")
+	assert.Error(t, q.DeductMovementAP(1), "the remaining AP cannot pay for another step")
+	require.NotEmpty(t, events)
+	assert.Equal(t, "Alice is slowed; moving costs 1 extra AP per step this round.", events[len(events)-1].Narrative)
+}
+
 func TestStartRoundWithSrc_DyingRecovery_Success(t *testing.T) {
 	_, cbt := makeCombatWithConditions(t)
 	cbt.Combatants[0].CurrentHP = 0
 	require.NoError(t, cbt.ApplyCondition("p1", "dying", 1, -1))
-	// Intn(20) returns 14 → roll = 15 → success (>= 15)
+	// Intn(20) returns 14 → roll = 15 vs DC 11 → success: dying 1 → 0
 	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 14})
 	assert.False(t, cbt.HasCondition("p1", "dying"), "dying must be removed on success")
 	assert.True(t, cbt.HasCondition("p1", "wounded"), "wounded must be applied on success")
@@ -108,7 +150,7 @@ func TestStartRoundWithSrc_DyingRecovery_Failure(t *testing.T) {
 	_, cbt := makeCombatWithConditions(t)
 	cbt.Combatants[0].CurrentHP = 0
 	require.NoError(t, cbt.ApplyCondition("p1", "dying", 1, -1))
-	// Intn(20) returns 9 → roll = 10 → failure (< 15)
+	// Intn(20) returns 9 → roll = 10 vs DC 11 → failure
 	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 9})
 	assert.True(t, cbt.HasCondition("p1", "dying"))
 	assert.Equal(t, 2, cbt.DyingStacks("p1"), "dying must advance from 1 to 2 on failure")
@@ -127,10 +169,10 @@ func TestStartRoundWithSrc_DyingRecovery_CritSuccess(t *testing.T) {
 	_, cbt := makeCombatWithConditions(t)
 	cbt.Combatants[0].CurrentHP = 0
 	require.NoError(t, cbt.ApplyCondition("p1", "dying", 1, -1))
-	// Intn(20) returns 19 → roll = 20 → crit success (roll == 20)
+	// Intn(20) returns 19 → roll = 20 vs DC 11 → crit success: dying 1 → 0
 	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 19})
 	assert.False(t, cbt.HasCondition("p1", "dying"), "dying removed on crit success")
-	assert.False(t, cbt.HasCondition("p1", "wounded"), "wounded NOT applied on crit success")
+	assert.True(t, cbt.HasCondition("p1", "wounded"), "losing dying always applies wounded")
 	assert.Equal(t, 1, cbt.Combatants[0].CurrentHP, "HP restored to 1 on crit success")
 }
 
@@ -0,0 +1,186 @@
+package combat
+
+import "fmt"
+
+// StepOutcome is the result of trying to move a combatant one grid cell.
+type StepOutcome int
+
+const (
+	// StepMoved means the combatant entered the cell.
+	StepMoved StepOutcome = iota
+	// StepEdge means the edge of the grid is in the way.
+	StepEdge
+	// StepBlocked means another living combatant or a cover object holds the cell.
+	StepBlocked
+	// StepImpassable means the cell is greater-difficult terrain.
+	StepImpassable
+	// StepTooCostly means the cell costs more movement than the budget left.
+	StepTooCostly
+)
+
+// StepCombatant moves mover one cell by (dx, dy). It is the single cell-entry
+// rule shared by voluntary strides and forced movement: the grid edge, other
+// combatants, cover, and impassable terrain stop the move, and entering a
+// hazardous cell fires its on_enter hazard. A budget >= 0 is the movement the
+// mover has left and must cover the cell's entry cost; forced movement passes
+// a negative budget and ignores cost.
+//
+// Precondition: cbt, mover, and src must not be nil; dx and dy in [-1, 1].
+// Postcondition: On StepMoved, mover stands in the new cell and cost is the
+// cell's entry cost; otherwise mover is unmoved and events is nil.
+func StepCombatant(cbt *Combat, mover *Combatant, dx, dy, budget int, src Source) (outcome StepOutcome, cost int, events []RoundEvent) {
+	width, height := cbt.gridSize()
+	newX := min(max(mover.GridX+dx, 0), width-1)
+	newY := min(max(mover.GridY+dy, 0), height-1)
+	if newX == mover.GridX && newY == mover.GridY {
+		return StepEdge, 0, nil
+	}
+	if CellBlocked(cbt, mover.ID, newX, newY) {
+		return StepBlocked, 0, nil
+	}
+	cost, passable := cbt.EntryCost(newX, newY)
+	if !passable {
+		return StepImpassable, 0, nil
+	}
+	if budget >= 0 && cost > budget {
+		return StepTooCostly, 0, nil
+	}
+	mover.GridX = newX
+	mover.GridY = newY
+	// TERRAIN-9: fire on_enter hazard for hazardous cells.
+	if tc := cbt.TerrainAt(newX, newY); tc.Type == TerrainHazardous && tc.Hazard != nil {
+		events = applyCellHazard(cbt, mover, tc, "on_enter", src)
+	}
+	return StepMoved, cost, events
+}
+
+// gridSize returns the combat grid's width and height, defaulting each to 10.
+func (c *Combat) gridSize() (int, int) {
+	width, height := c.GridWidth, c.GridHeight
+	if width == 0 {
+		width = 10
+	}
+	if height == 0 {
+		height = 10
+	}
+	return width, height
+}
+
+// PushResult reports a forced movement.
+type PushResult struct {
+	// Cells is how many cells the target actually moved.
+	Cells int
+	// Stopped is why the push ended early, or StepMoved when it ran its
+	// full distance.
+	Stopped StepOutcome
+	// EdgeDir is the compass direction ("n", "s", "e", "w") of the grid edge
+	// the target was driven into; empty unless Stopped is StepEdge.
+	EdgeDir string
+	// Events are the hazards the target set off on the way.
+	Events []RoundEvent
+}
+
+// Push forces target up to cells grid cells directly away from (fromX, fromY)
+// — the knockback of a blast or a shove. A target standing on the source
+// cell is pushed east. Dead targets do not move.
+//
+// Precondition: cbt, target, and src must not be nil; cells >= 0.
+// Postcondition: target has moved PushResult.Cells cells; a push that meets
+// the grid edge with distance left reports that edge in EdgeDir.
+func Push(cbt *Combat, target *Combatant, fromX, fromY, cells int, src Source) PushResult {
+	var res PushResult
+	if target.IsDead() {
+		return res
+	}
+	dx, dy := towardDelta(fromX, fromY, target.GridX, target.GridY)
+	if dx == 0 && dy == 0 {
+		dx = 1
+	}
+	for res.Cells < cells {
+		outcome, _, events := StepCombatant(cbt, target, dx, dy, -1, src)
+		res.Events = append(res.Events, events...)
+		if outcome != StepMoved {
+			res.Stopped = outcome
+			if outcome == StepEdge {
+				res.EdgeDir = cbt.edgeDir(target.GridX+dx, target.GridY+dy)
+			}
+			break
+		}
+		res.Cells++
+		if target.IsDead() {
+			break
+		}
+	}
+	return res
+}
+
+// edgeDir names the grid edge that off-grid cell (x, y) lies beyond,
+// preferring east or west for a corner.
+func (c *Combat) edgeDir(x, y int) string {
+	width, height := c.gridSize()
+	switch {
+	case x >= width:
+		return "e"
+	case x < 0:
+		return "w"
+	case y < 0:
+		return "n"
+	case y >= height:
+		return "s"
+	}
+	return ""
+}
+
+// PushNarrative describes res for target pushed by source, e.g. "Ganger is
+// knocked back 10 ft (now 15 ft from Alice)." or "Ganger is shoved against
+// the wall." when the target could not move at all.
+//
+// Precondition: target and source must not be nil.
+func PushNarrative(target, source *Combatant, verb string, res PushResult) string {
+	if res.Cells == 0 {
+		if res.Stopped == StepEdge {
+			return fmt.Sprintf("%s is %s against the wall.", target.Name, verb)
+		}
+		return fmt.Sprintf("%s is %s into an obstacle and holds their ground.", target.Name, verb)
+	}
+	return fmt.Sprintf("%s is %s %d ft (now %d ft from %s).", target.Name, verb, res.Cells*5, CombatRange(*target, *source), source.Name)
+}
+
+// knockbackEvents throws every living target that failed its save against a
+// blast knockbackFt away from the thrower, twice as far on a critical
+// failure. results[i] is targets[i]'s save.
+//
+// Precondition: len(results) == len(targets); actor and src must not be nil.
+// Postcondition: Returns nil when knockbackFt < 5; a target driven off the
+// grid has an event with PushedOut set.
+func knockbackEvents(cbt *Combat, actor *Combatant, knockbackFt int, targets []*Combatant, results []ExplosiveResult, src Source) []RoundEvent {
+	if knockbackFt < 5 {
+		return nil
+	}
+	var events []RoundEvent
+	for i, target := range targets {
+		cells := knockbackFt / 5
+		switch results[i].SaveResult {
+		case Failure:
+		case CritFailure:
+			cells *= 2
+		default:
+			continue
+		}
+		if target.IsDead() {
+			continue
+		}
+		res := Push(cbt, target, actor.GridX, actor.GridY, cells, src)
+		events = append(events, RoundEvent{
+			ActionType: ActionThrow,
+			ActorID:    actor.ID,
+			ActorName:  actor.Name,
+			TargetID:   target.ID,
+			Narrative:  PushNarrative(target, actor, "knocked back", res),
+			Pushed:     res.Cells > 0 || res.EdgeDir != "",
+			PushedOut:  res.EdgeDir,
+		})
+		events = append(events, res.Events...)
+	}
+	return events
+}
@@ -0,0 +1,155 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// placeForPush puts Alice at (ax, 5) and Ganger at (gx, 5) on a 10×10 grid.
+func placeForPush(t *testing.T, ax, gx int) (*combat.Combat, *combat.Combatant, *combat.Combatant) {
+	t.Helper()
+	cbt := newAdjustTestCombat(t)
+	cbt.GridWidth, cbt.GridHeight = 10, 10
+	alice, ganger := cbt.GetCombatant("p1"), cbt.GetCombatant("n1")
+	alice.GridX, alice.GridY = ax, 5
+	ganger.GridX, ganger.GridY = gx, 5
+	return cbt, alice, ganger
+}
+
+func TestPush_MovesTargetAwayFromSource(t *testing.T) {
+	cbt, alice, ganger := placeForPush(t, 3, 4)
+	res := combat.Push(cbt, ganger, alice.GridX, alice.GridY, 2, fixedSrc{val: 0})
+	assert.Equal(t, 2, res.Cells)
+	assert.Equal(t, combat.StepMoved, res.Stopped)
+	assert.Empty(t, res.EdgeDir)
+	assert.Equal(t, 6, ganger.GridX)
+	assert.Equal(t, "Ganger is knocked back 10 ft (now 15 ft from Alice).",
+		combat.PushNarrative(ganger, alice, "knocked back", res))
+}
+
+func TestPush_IntoGridEdgeReportsEdge(t *testing.T) {
+	cbt, alice, ganger := placeForPush(t, 7, 8)
+	res := combat.Push(cbt, ganger, alice.GridX, alice.GridY, 3, fixedSrc{val: 0})
+	assert.Equal(t, 1, res.Cells)
+	assert.Equal(t, combat.StepEdge, res.Stopped)
+	assert.Equal(t, "e", res.EdgeDir)
+	assert.Equal(t, 9, ganger.GridX)
+
+	res = combat.Push(cbt, alice, ganger.GridX, ganger.GridY, 1, fixedSrc{val: 0})
+	assert.Equal(t, 1, res.Cells, "the push ran its full distance")
+	assert.Empty(t, res.EdgeDir)
+}
+
+func TestPush_StoppedByOtherCombatant(t *testing.T) {
+	cbt, alice, ganger := placeForPush(t, 5, 4)
+	res := combat.Push(cbt, alice, 6, 5, 2, fixedSrc{val: 0})
+	assert.Equal(t, 0, res.Cells, "Ganger stands between Alice and the source")
+	assert.Equal(t, combat.StepBlocked, res.Stopped)
+	assert.Equal(t, 5, alice.GridX)
+	assert.Equal(t, "Alice is shoved back into an obstacle and holds their ground.",
+		combat.PushNarrative(alice, ganger, "shoved back", res))
+}
+
+func TestPush_DeadTargetDoesNotMove(t *testing.T) {
+	cbt, alice, ganger := placeForPush(t, 3, 4)
+	ganger.CurrentHP = 0
+	res := combat.Push(cbt, ganger, alice.GridX, alice.GridY, 2, fixedSrc{val: 0})
+	assert.Equal(t, 0, res.Cells)
+	assert.Equal(t, 4, ganger.GridX)
+}
+
+func TestStepCombatant_BudgetMustCoverEntryCost(t *testing.T) {
+	cbt, alice, _ := placeForPush(t, 3, 8)
+	cbt.Terrain = map[combat.GridCell]*combat.TerrainCell{{X: 4, Y: 5}: {X: 4, Y: 5, Type: combat.TerrainDifficult}}
+	outcome, _, _ := combat.StepCombatant(cbt, alice, 1, 0, 1, fixedSrc{val: 0})
+	assert.Equal(t, combat.StepTooCostly, outcome)
+	assert.Equal(t, 3, alice.GridX)
+
+	outcome, cost, _ := combat.StepCombatant(cbt, alice, 1, 0, -1, fixedSrc{val: 0})
+	assert.Equal(t, combat.StepMoved, outcome, "forced movement ignores cost")
+	assert.Equal(t, 2, cost)
+	assert.Equal(t, 4, alice.GridX)
+}
+
+func TestProperty_PushNeverLeavesGridOrExceedsDistance(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		cbt := newAdjustTestCombat(t)
+		cbt.GridWidth, cbt.GridHeight = 10, 10
+		target := cbt.GetCombatant("n1")
+		cbt.GetCombatant("p1").GridX = -100 // off the board; never blocks
+		target.GridX = rapid.IntRange(0, 9).Draw(rt, "x")
+		target.GridY = rapid.IntRange(0, 9).Draw(rt, "y")
+		fromX := rapid.IntRange(0, 9).Draw(rt, "fromX")
+		fromY := rapid.IntRange(0, 9).Draw(rt, "fromY")
+		cells := rapid.IntRange(0, 12).Draw(rt, "cells")
+
+		res := combat.Push(cbt, target, fromX, fromY, cells, fixedSrc{val: 0})
+		if res.Cells > cells {
+			rt.Fatalf("moved %d cells, asked for %d", res.Cells, cells)
+		}
+		if target.GridX < 0 || target.GridX > 9 || target.GridY < 0 || target.GridY > 9 {
+			rt.Fatalf("target left the grid: (%d, %d)", target.GridX, target.GridY)
+		}
+		if res.Cells < cells && res.Stopped == combat.StepMoved {
+			rt.Fatalf("push ended early without a reason")
+		}
+	})
+}
+
+func TestResolveRound_BlastKnocksBackFailedSaves(t *testing.T) {
+	cbt, alice, ganger := placeForPush(t, 2, 3)
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterExplosive(&inventory.ExplosiveDef{
+		ID: "frag_grenade", Name: "Frag Grenade", DamageDice: "1d4", DamageType: "piercing",
+		AreaType: inventory.AreaTypeRoom, SaveType: "reflex", SaveDC: 15, Fuse: inventory.FuseImmediate,
+		KnockbackFt: 5,
+	}))
+	cbt.SetInventoryRegistry(reg)
+	ganger.MaxHP, ganger.CurrentHP = 50, 50
+	_ = cbt.StartRound(3)
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionThrow, ExplosiveID: "frag_grenade"}))
+
+	events := combat.ResolveRound(cbt, fixedSrc{val: 0}, nil, nil, 0)
+	var pushed *combat.RoundEvent
+	for i := range events {
+		if events[i].Pushed {
+			pushed = &events[i]
+		}
+	}
+	require.NotNil(t, pushed, "a failed save is knocked back")
+	assert.Equal(t, "n1", pushed.TargetID)
+	assert.Equal(t, 5, ganger.GridX, "a critical failure doubles the knockback")
+	assert.Equal(t, "Ganger is knocked back 10 ft (now 15 ft from Alice).", pushed.Narrative)
+	assert.Empty(t, pushed.PushedOut)
+	assert.Equal(t, 2, alice.GridX)
+}
+
+func TestResolveRound_BlastIntoWallReportsPushedOut(t *testing.T) {
+	cbt, _, ganger := placeForPush(t, 8, 9)
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterExplosive(&inventory.ExplosiveDef{
+		ID: "frag_grenade", Name: "Frag Grenade", DamageDice: "1d4", DamageType: "piercing",
+		AreaType: inventory.AreaTypeRoom, SaveType: "reflex", SaveDC: 15, Fuse: inventory.FuseImmediate,
+		KnockbackFt: 5,
+	}))
+	cbt.SetInventoryRegistry(reg)
+	ganger.MaxHP, ganger.CurrentHP = 50, 50
+	_ = cbt.StartRound(3)
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionThrow, ExplosiveID: "frag_grenade"}))
+
+	events := combat.ResolveRound(cbt, fixedSrc{val: 0}, nil, nil, 0)
+	for _, ev := range events {
+		if ev.Pushed {
+			assert.Equal(t, "e", ev.PushedOut)
+			assert.Equal(t, "Ganger is knocked back against the wall.", ev.Narrative)
+			return
+		}
+	}
+	t.Fatal("no knockback event")
+}
@@ -0,0 +1,47 @@
+package combat
+
+import "fmt"
+
+// BackRowMeleeACBonus is the AC bonus a screened back-row combatant has
+// against melee attacks.
+const BackRowMeleeACBonus = 2
+
+// AttacksInMelee reports whether c attacks in melee: unarmed or with a main
+// hand weapon that has no range increment.
+//
+// Precondition: c must not be nil.
+func AttacksInMelee(c *Combatant) bool {
+	if c.Loadout == nil || c.Loadout.MainHand == nil || c.Loadout.MainHand.Def == nil {
+		return true
+	}
+	return c.Loadout.MainHand.Def.RangeIncrement == 0
+}
+
+// Screened reports whether target stands in the back row behind a living
+// front-row ally, which keeps melee attackers from reaching it easily. A
+// back-row combatant with no one standing in front of it is not screened.
+//
+// Precondition: target must not be nil.
+func (c *Combat) Screened(target *Combatant) bool {
+	if !target.BackRow {
+		return false
+	}
+	for _, ally := range c.Combatants {
+		if ally.ID != target.ID && !ally.BackRow && ally.OnPlayerSide() == target.OnPlayerSide() &&
+			!ally.IsDead() && !ally.KnockedOut && ally.CurrentHP > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// backRowMeleeEvent returns the event for a back-row actor that tried to
+// attack in melee, which it cannot do from behind its allies.
+func backRowMeleeEvent(actor *Combatant, at ActionType) RoundEvent {
+	return RoundEvent{
+		ActionType: at,
+		ActorID:    actor.ID,
+		ActorName:  actor.Name,
+		Narrative:  fmt.Sprintf("%s is in the back row and can't reach anyone to fight in melee.", actor.Name),
+	}
+}
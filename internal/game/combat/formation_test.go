@@ -0,0 +1,107 @@
+package combat_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// makeFormationCombat starts a fight between Alice and Bob and a Ganger,
+// all within reach of each other.
+func makeFormationCombat(t *testing.T) *combat.Combat {
+	t.Helper()
+	combatants := []*combat.Combatant{
+		{ID: "p1", Kind: combat.KindPlayer, Name: "Alice", MaxHP: 20, CurrentHP: 20, AC: 14, Level: 1, StrMod: 2, Initiative: 15, WeaponProficiencyRank: "trained"},
+		{ID: "p2", Kind: combat.KindPlayer, Name: "Bob", MaxHP: 20, CurrentHP: 20, AC: 14, Level: 1, StrMod: 2, Initiative: 14, WeaponProficiencyRank: "trained"},
+		{ID: "n1", Kind: combat.KindNPC, Name: "Ganger", MaxHP: 50, CurrentHP: 50, AC: 12, Level: 1, StrMod: 1, Initiative: 10},
+	}
+	cbt, err := combat.NewEngine().StartCombat("room1", combatants, makeConditionReg(), nil, "")
+	require.NoError(t, err)
+	cbt.GetCombatant("p1").GridX, cbt.GetCombatant("p1").GridY = 0, 0
+	cbt.GetCombatant("p2").GridX, cbt.GetCombatant("p2").GridY = 1, 0
+	cbt.GetCombatant("n1").GridX, cbt.GetCombatant("n1").GridY = 1, 1
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	return cbt
+}
+
+func narrativesOf(events []combat.RoundEvent) string {
+	var b strings.Builder
+	for _, ev := range events {
+		b.WriteString(ev.Narrative + "\n")
+	}
+	return b.String()
+}
+
+func TestScreened_NeedsAStandingFrontRowAlly(t *testing.T) {
+	cbt := makeFormationCombat(t)
+	alice, bob, ganger := cbt.GetCombatant("p1"), cbt.GetCombatant("p2"), cbt.GetCombatant("n1")
+
+	assert.False(t, cbt.Screened(alice), "the front row is not screened")
+	alice.BackRow = true
+	assert.True(t, cbt.Screened(alice))
+	assert.False(t, cbt.Screened(ganger))
+
+	bob.BackRow = true
+	assert.False(t, cbt.Screened(alice), "nobody holds the front")
+	bob.BackRow = false
+	bob.CurrentHP = 0
+	assert.False(t, cbt.Screened(alice), "a downed ally screens no one")
+}
+
+func TestResolveRound_BackRowCanOnlyAttackAtRange(t *testing.T) {
+	cbt := makeFormationCombat(t)
+	cbt.GetCombatant("p1").BackRow = true
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Ganger"}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionPass}))
+
+	events := combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0)
+
+	assert.Contains(t, narrativesOf(events), "Alice is in the back row and can't reach anyone to fight in melee.")
+	assert.Equal(t, 50, cbt.GetCombatant("n1").CurrentHP)
+
+	cbt = makeFormationCombat(t)
+	alice := cbt.GetCombatant("p1")
+	alice.BackRow = true
+	alice.Loadout = &inventory.WeaponPreset{MainHand: &inventory.EquippedWeapon{Def: &inventory.WeaponDef{
+		ID: "pistol", Name: "Pistol", DamageDice: "1d6", DamageType: "piercing", RangeIncrement: 30,
+	}}}
+	require.False(t, combat.AttacksInMelee(alice))
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Ganger"}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionPass}))
+
+	events = combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0)
+
+	assert.NotContains(t, narrativesOf(events), "back row")
+}
+
+func TestResolveRound_MeleeAgainstScreenedBackRowTakesACPenalty(t *testing.T) {
+	cbt := makeFormationCombat(t)
+	cbt.GetCombatant("p1").BackRow = true
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Alice"}))
+
+	events := combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0)
+
+	assert.Contains(t, narrativesOf(events), "(back row +2 AC)")
+}
+
+func TestScreened_OnlyBackRowWithAFrontRowAlly(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		cbt := &combat.Combat{}
+		n := rapid.IntRange(1, 6).Draw(rt, "n")
+		front := false
+		for i := 0; i < n; i++ {
+			c := &combat.Combatant{ID: string(rune('a' + i)), Kind: combat.KindPlayer, CurrentHP: 10, BackRow: rapid.Bool().Draw(rt, "back")}
+			front = front || !c.BackRow
+			cbt.Combatants = append(cbt.Combatants, c)
+		}
+		for _, c := range cbt.Combatants {
+			assert.Equal(rt, c.BackRow && front, cbt.Screened(c))
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package combat
+
+// JoinRound gives combatant uid, added with Engine.AddCombatant after the
+// current round started, an action queue for the rest of that round. The
+// joiner receives the round's full AP budget less the reductions its own
+// conditions impose, exactly as if it had been present at round start.
+//
+// Precondition: uid must already be a combatant in c.
+// Postcondition: When a round is in progress and uid is a living combatant
+// without a queue, c.ActionQueues[uid] holds its new queue and the resulting
+// round-start narratives are returned; otherwise c is unchanged and nil is returned.
+func (c *Combat) JoinRound(uid string) []RoundConditionEvent {
+	if c.Round == 0 || c.ActionQueues == nil {
+		return nil
+	}
+	if _, queued := c.ActionQueues[uid]; queued {
+		return nil
+	}
+	cbt := c.GetCombatant(uid)
+	if cbt == nil || cbt.IsDead() {
+		return nil
+	}
+	return c.allocateAP(cbt)
+}
@@ -0,0 +1,60 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+func TestJoinRound_GrantsRoundAPLessConditionReductions(t *testing.T) {
+	eng, cbt := makeCombatWithConditions(t)
+	_ = cbt.StartRound(3)
+
+	add := &combat.Combatant{ID: "n2", Kind: combat.KindNPC, Name: "Brute", MaxHP: 10, CurrentHP: 10, AC: 12, Level: 1, Initiative: 12}
+	require.NoError(t, eng.AddCombatant("room1", add))
+	require.NoError(t, cbt.ApplyCondition("n2", "stunned", 1, -1))
+
+	events := cbt.JoinRound("n2")
+	q, ok := cbt.ActionQueues["n2"]
+	require.True(t, ok)
+	assert.Equal(t, 2, q.RemainingPoints())
+	assert.NotEmpty(t, events, "stunned AP loss is narrated")
+	assert.Equal(t, "n2", cbt.Combatants[1].ID, "inserted between initiative 15 and 10")
+}
+
+func TestJoinRound_NoOpBeforeRoundOrWhenQueued(t *testing.T) {
+	eng, cbt := makeCombatWithConditions(t)
+	add := &combat.Combatant{ID: "n2", Kind: combat.KindNPC, Name: "Brute", MaxHP: 10, CurrentHP: 10, AC: 12, Level: 1}
+	require.NoError(t, eng.AddCombatant("room1", add))
+	assert.Nil(t, cbt.JoinRound("n2"))
+	assert.Nil(t, cbt.ActionQueues["n2"], "no round under way yet")
+
+	_ = cbt.StartRound(3)
+	require.NoError(t, cbt.ActionQueues["n2"].DeductAP(2))
+	cbt.JoinRound("n2")
+	assert.Equal(t, 1, cbt.ActionQueues["n2"].RemainingPoints(), "an existing queue is left alone")
+	assert.Nil(t, cbt.JoinRound("ghost"))
+}
+
+func TestJoinRound_JoinerActsInResolution(t *testing.T) {
+	eng, cbt := makeCombatWithConditions(t)
+	_ = cbt.StartRound(3)
+	add := &combat.Combatant{ID: "n2", Kind: combat.KindNPC, Name: "Brute", MaxHP: 10, CurrentHP: 10, AC: 12, Level: 1, StrMod: 1}
+	require.NoError(t, eng.AddCombatant("room1", add))
+	cbt.JoinRound("n2")
+
+	require.NoError(t, cbt.QueueAction("n2", combat.QueuedAction{Type: combat.ActionAttack, Target: "Alice"}))
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionPass}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionPass}))
+	events := combat.ResolveRound(cbt, fixedSrc{val: 13}, func(string, int) {}, nil, 0)
+	acted := false
+	for _, ev := range events {
+		if ev.ActorID == "n2" && ev.ActionType == combat.ActionAttack {
+			acted = true
+		}
+	}
+	assert.True(t, acted, "a mid-round joiner acts in the round it joined")
+}
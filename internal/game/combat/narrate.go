@@ -0,0 +1,28 @@
+package combat
+
+import (
+	"sync/atomic"
+
+	"github.com/cory-johannsen/mud/internal/game/i18n"
+)
+
+// narration is the catalog round narration renders from. Unset, it falls
+// back to the embedded English catalog.
+var narration atomic.Pointer[i18n.Catalog]
+
+// SetCatalog installs the catalog used to render round narration.
+//
+// Round events are broadcast to every player in the room, so narration is
+// rendered once in i18n.DefaultLocale rather than per recipient; a
+// content/locales/en.yaml override translates it server-wide.
+//
+// Precondition: c may be nil, restoring the embedded catalog.
+// Postcondition: Subsequent rounds render through c.
+func SetCatalog(c *i18n.Catalog) {
+	narration.Store(c)
+}
+
+// narrate renders round narration message id with args.
+func narrate(id string, args i18n.Args) string {
+	return narration.Load().T(i18n.DefaultLocale, id, args)
+}
@@ -0,0 +1,31 @@
+package combat
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/i18n"
+)
+
+func TestNarrate_DefaultsToEmbeddedEnglish(t *testing.T) {
+	assert.Equal(t, "Alice passes.", narrate("combat.pass", i18n.Args{"actor": "Alice"}))
+}
+
+func TestNarrate_UsesInstalledCatalog(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "en.yaml"),
+		[]byte(`combat.pass: "{{.actor}} bides their time."`), 0o644))
+	c, err := i18n.LoadCatalogFromDir(dir)
+	require.NoError(t, err)
+
+	SetCatalog(c)
+	t.Cleanup(func() { SetCatalog(nil) })
+
+	assert.Equal(t, "Alice bides their time.", narrate("combat.pass", i18n.Args{"actor": "Alice"}))
+	assert.Equal(t, "Alice reloads.", narrate("combat.reload", i18n.Args{"actor": "Alice"}),
+		"messages the override omits fall back to the embedded catalog")
+}
@@ -0,0 +1,74 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+// stunBaton is a melee weapon with the nonlethal trait.
+func stunBaton() *inventory.WeaponPreset {
+	return &inventory.WeaponPreset{MainHand: &inventory.EquippedWeapon{Def: &inventory.WeaponDef{
+		ID: "stun_baton", Name: "Stun Baton", DamageDice: "1d6", DamageType: "bludgeoning",
+		Traits: []string{"nonlethal"},
+	}}}
+}
+
+func TestResolveRound_NonlethalWeapon_KnocksPlayerOutWithoutDying(t *testing.T) {
+	cbt := makeCombatForRoundConditions(t)
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	alice, ganger := cbt.Combatants[0], cbt.Combatants[1]
+	alice.CurrentHP, alice.AC = 1, 1
+	ganger.Loadout = stunBaton()
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionPass}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Alice"}))
+
+	events := combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0)
+
+	assert.Equal(t, 0, alice.CurrentHP)
+	assert.True(t, alice.KnockedOut)
+	assert.False(t, cbt.IsDying("p1"), "a knockout is not dying")
+	assert.False(t, alice.IsDead())
+	assert.False(t, cbt.HasFightingPlayers(), "a knocked-out player is out of the fight")
+	var narratives []string
+	for _, ev := range events {
+		narratives = append(narratives, ev.Narrative)
+	}
+	assert.Contains(t, narratives[len(narratives)-1], "Alice is knocked out!")
+}
+
+func TestResolveRound_NonlethalPlayer_KnocksNPCOut(t *testing.T) {
+	cbt := makeCombatForRoundConditions(t)
+	cbt.SetSessionGetter(func(uid string) (*session.PlayerSession, bool) {
+		return &session.PlayerSession{UID: uid, Nonlethal: true}, uid == "p1"
+	})
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	ganger := cbt.Combatants[1]
+	ganger.CurrentHP, ganger.AC = 1, 1
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Ganger"}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionPass}))
+
+	_ = combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0)
+
+	assert.True(t, ganger.KnockedOut)
+	assert.False(t, cbt.HasLivingNPCs(), "a knocked-out NPC takes no further part")
+}
+
+func TestResolveRound_LethalAttack_DoesNotKnockOut(t *testing.T) {
+	cbt := makeCombatForRoundConditions(t)
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	alice := cbt.Combatants[0]
+	alice.CurrentHP, alice.AC = 1, 1
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionPass}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Alice"}))
+
+	_ = combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0)
+
+	assert.False(t, alice.KnockedOut)
+	assert.True(t, cbt.IsDying("p1"))
+}
@@ -0,0 +1,136 @@
+package combat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecapLine is one combatant's statistics for an encounter.
+type RecapLine struct {
+	DamageDealt int
+	DamageTaken int
+	Hits        int
+	Misses      int
+	Crits       int
+	Healing     int
+}
+
+// Recap tallies an encounter's statistics from its resolved round events.
+// Healing is not carried by round events, so it is measured as the hit
+// points a combatant gained beyond the damage it was seen to take.
+type Recap struct {
+	// Rounds is the number of rounds tallied.
+	Rounds int
+	// Lines maps combatant ID to its statistics.
+	Lines map[string]*RecapLine
+	// hp is each combatant's hit points when it was last observed.
+	hp map[string]int
+}
+
+// NewRecap returns an empty Recap.
+//
+// Postcondition: Returns a non-nil Recap with no rounds tallied.
+func NewRecap() *Recap {
+	return &Recap{Lines: make(map[string]*RecapLine), hp: make(map[string]int)}
+}
+
+// line returns id's statistics, creating them on first use.
+func (r *Recap) line(id string) *RecapLine {
+	l, ok := r.Lines[id]
+	if !ok {
+		l = &RecapLine{}
+		r.Lines[id] = l
+	}
+	return l
+}
+
+// Observe records the hit points of every combatant not seen before, so a
+// combatant that joined mid-fight has a baseline for healing.
+//
+// Precondition: cbt must not be nil.
+// Postcondition: Every combatant in cbt has a recorded baseline.
+func (r *Recap) Observe(cbt *Combat) {
+	for _, c := range cbt.Combatants {
+		if _, ok := r.hp[c.ID]; !ok {
+			r.hp[c.ID] = c.CurrentHP
+		}
+	}
+}
+
+// Add tallies one resolved round: damage, hits, misses, and crits from its
+// attack events, hazard damage taken, and each combatant's healing since it
+// was last observed.
+//
+// Precondition: cbt must not be nil; Observe was called before the round
+// was resolved.
+// Postcondition: Rounds is incremented and each combatant's baseline is its
+// current hit points.
+func (r *Recap) Add(cbt *Combat, events []RoundEvent) {
+	r.Rounds++
+	taken := make(map[string]int)
+	for _, ev := range events {
+		if ev.ActionType == ActionHazardDamage {
+			taken[ev.ActorID] += ev.Damage
+			continue
+		}
+		if ev.AttackResult == nil {
+			continue
+		}
+		attacker := r.line(ev.ActorID)
+		switch ev.AttackResult.Outcome {
+		case CritSuccess:
+			attacker.Crits++
+			attacker.Hits++
+		case Success:
+			attacker.Hits++
+		default:
+			attacker.Misses++
+		}
+		attacker.DamageDealt += ev.Damage
+		target := ev.TargetID
+		if target == "" {
+			target = ev.AttackResult.TargetID
+		}
+		if target != "" {
+			taken[target] += ev.Damage
+		}
+	}
+	for id, dmg := range taken {
+		r.line(id).DamageTaken += dmg
+	}
+	for _, c := range cbt.Combatants {
+		if before, ok := r.hp[c.ID]; ok {
+			if healed := c.CurrentHP - (before - taken[c.ID]); healed > 0 {
+				r.line(c.ID).Healing += healed
+			}
+		}
+		r.hp[c.ID] = c.CurrentHP
+	}
+}
+
+// Summary returns id's recap as a short report for the player.
+//
+// Postcondition: Returns a non-empty string.
+func (r *Recap) Summary(id string) string {
+	l := RecapLine{}
+	if got, ok := r.Lines[id]; ok {
+		l = *got
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Encounter recap (%d %s):\n", r.Rounds, plural(r.Rounds, "round", "rounds"))
+	fmt.Fprintf(&b, "  Damage dealt: %d   Damage taken: %d\n", l.DamageDealt, l.DamageTaken)
+	fmt.Fprintf(&b, "  Hits: %d   Misses: %d   Crits: %d\n", l.Hits, l.Misses, l.Crits)
+	fmt.Fprintf(&b, "  Healing: %d", l.Healing)
+	if attacks := l.Hits + l.Misses; attacks > 0 && r.Rounds > 0 {
+		fmt.Fprintf(&b, "\n  Accuracy: %d%%   Damage per round: %.1f", l.Hits*100/attacks, float64(l.DamageDealt)/float64(r.Rounds))
+	}
+	return b.String()
+}
+
+// plural returns one when n is 1 and many otherwise.
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,91 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+func TestRecap_TalliesAttacksHazardsAndHealing(t *testing.T) {
+	cbt := &combat.Combat{Combatants: []*combat.Combatant{
+		{ID: "p1", Name: "Alice", Kind: combat.KindPlayer, CurrentHP: 20, MaxHP: 20},
+		{ID: "n1", Name: "Ganger", Kind: combat.KindNPC, CurrentHP: 20, MaxHP: 20},
+	}}
+	r := combat.NewRecap()
+	r.Observe(cbt)
+
+	alice, ganger := cbt.Combatants[0], cbt.Combatants[1]
+	ganger.CurrentHP -= 12
+	alice.CurrentHP -= 5 + 2
+	alice.CurrentHP += 4 // a stim patch between blows
+	r.Add(cbt, []combat.RoundEvent{
+		{ActionType: combat.ActionAttack, ActorID: "p1", Damage: 8,
+			AttackResult: &combat.AttackResult{TargetID: "n1", Outcome: combat.CritSuccess}},
+		{ActionType: combat.ActionAttack, ActorID: "p1", Damage: 4,
+			AttackResult: &combat.AttackResult{TargetID: "n1", Outcome: combat.Success}},
+		{ActionType: combat.ActionAttack, ActorID: "p1",
+			AttackResult: &combat.AttackResult{TargetID: "n1", Outcome: combat.Failure}},
+		{ActionType: combat.ActionAttack, ActorID: "n1", TargetID: "p1", Damage: 5,
+			AttackResult: &combat.AttackResult{Outcome: combat.Success}},
+		{ActionType: combat.ActionHazardDamage, ActorID: "p1", Damage: 2},
+		{ActionType: combat.ActionPass, ActorID: "n1"},
+	})
+
+	assert.Equal(t, 1, r.Rounds)
+	assert.Equal(t, combat.RecapLine{DamageDealt: 12, DamageTaken: 7, Hits: 2, Misses: 1, Crits: 1, Healing: 4}, *r.Lines["p1"])
+	assert.Equal(t, combat.RecapLine{DamageDealt: 5, DamageTaken: 12, Hits: 1}, *r.Lines["n1"])
+
+	summary := r.Summary("p1")
+	assert.Contains(t, summary, "Encounter recap (1 round)")
+	assert.Contains(t, summary, "Damage dealt: 12   Damage taken: 7")
+	assert.Contains(t, summary, "Hits: 2   Misses: 1   Crits: 1")
+	assert.Contains(t, summary, "Healing: 4")
+	assert.Contains(t, summary, "Accuracy: 66%")
+	assert.NotContains(t, r.Summary("nobody"), "Accuracy", "no attacks, no accuracy line")
+}
+
+func TestRecap_ResolveRoundReportsAppliedDamage(t *testing.T) {
+	cbt := makeCombatForRoundConditions(t)
+	require.NotNil(t, cbt.Recap)
+	_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 0})
+	ganger := cbt.Combatants[1]
+	ganger.AC, ganger.CurrentHP, ganger.MaxHP = 1, 100, 100
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Ganger"}))
+	require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionPass}))
+	cbt.Recap.Observe(cbt)
+	before := ganger.CurrentHP
+
+	cbt.Recap.Add(cbt, combat.ResolveRound(cbt, &fixedSrc{val: 10}, nil, nil, 0))
+
+	line := cbt.Recap.Lines["p1"]
+	require.NotNil(t, line)
+	assert.Equal(t, 1, line.Hits)
+	assert.Equal(t, before-ganger.CurrentHP, line.DamageDealt)
+	assert.Equal(t, line.DamageDealt, cbt.Recap.Lines["n1"].DamageTaken)
+}
+
+func TestRecap_AttacksAreHitsOrMisses(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		outcomes := rapid.SliceOf(rapid.SampledFrom([]combat.Outcome{
+			combat.CritSuccess, combat.Success, combat.Failure, combat.CritFailure,
+		})).Draw(rt, "outcomes")
+		var events []combat.RoundEvent
+		for _, o := range outcomes {
+			events = append(events, combat.RoundEvent{ActionType: combat.ActionAttack, ActorID: "p1",
+				AttackResult: &combat.AttackResult{TargetID: "n1", Outcome: o}})
+		}
+		r := combat.NewRecap()
+		r.Add(&combat.Combat{}, events)
+		l := r.Lines["p1"]
+		if len(outcomes) == 0 {
+			assert.Nil(rt, l)
+			return
+		}
+		assert.Equal(rt, len(outcomes), l.Hits+l.Misses)
+		assert.LessOrEqual(rt, l.Crits, l.Hits)
+	})
+}
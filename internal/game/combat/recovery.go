@@ -0,0 +1,62 @@
+package combat
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ResolveRecoverySaves rolls the end-of-round recovery save for every
+// "until_save" condition with a RecoverySave on each living combatant. A
+// critical success clears the condition, a success removes one stack, and a
+// failure leaves it unchanged.
+//
+// Precondition: src must not be nil.
+// Postcondition: Returns one event per save rolled, in combatant order and by
+// condition ID within a combatant; each event carries a narrative.
+func (c *Combat) ResolveRecoverySaves(src Source) []RoundConditionEvent {
+	var events []RoundConditionEvent
+	for _, cbt := range c.Combatants {
+		if cbt.IsDead() {
+			continue
+		}
+		s := c.Conditions[cbt.ID]
+		if s == nil {
+			continue
+		}
+		active := s.All()
+		sort.Slice(active, func(i, j int) bool { return active[i].Def.ID < active[j].Def.ID })
+		for _, ac := range active {
+			def := ac.Def
+			if def.DurationType != "until_save" || def.RecoverySave == nil {
+				continue
+			}
+			outcome := ResolveSave(def.RecoverySave.Save, cbt, def.RecoverySave.DC, src)
+			ev := RoundConditionEvent{
+				UID: cbt.ID, Name: cbt.Name,
+				ConditionID: def.ID, CondName: def.Name,
+			}
+			switch outcome {
+			case CritSuccess:
+				s.Remove(cbt.ID, def.ID)
+				SyncConditionRemove(cbt, def.ID)
+				ev.Narrative = fmt.Sprintf("%s critically succeeds a %s save and shakes off %s.", cbt.Name, def.RecoverySave.Save, def.Name)
+			case Success:
+				ev.Stacks = s.Reduce(cbt.ID, def.ID, 1)
+				if ev.Stacks == 0 {
+					SyncConditionRemove(cbt, def.ID)
+					ev.Narrative = fmt.Sprintf("%s succeeds a %s save and shakes off %s.", cbt.Name, def.RecoverySave.Save, def.Name)
+				} else {
+					SyncConditionApply(cbt, cbt.ID, def, ev.Stacks)
+					ev.Applied = true
+					ev.Narrative = fmt.Sprintf("%s succeeds a %s save; %s eases to %d.", cbt.Name, def.RecoverySave.Save, def.Name, ev.Stacks)
+				}
+			default:
+				ev.Stacks = ac.Stacks
+				ev.Applied = true
+				ev.Narrative = fmt.Sprintf("%s fails a %s save against %s.", cbt.Name, def.RecoverySave.Save, def.Name)
+			}
+			events = append(events, ev)
+		}
+	}
+	return events
+}
@@ -0,0 +1,97 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/condition"
+)
+
+// makeRecoveryCombat returns a combat whose registry holds a "shaken"
+// condition that ends on a DC 5 Cool recovery save, plus "dying" with none.
+// Alice (p1) has no save modifiers, so her save total is the d20 roll.
+func makeRecoveryCombat(t *testing.T) *combat.Combat {
+	t.Helper()
+	reg := condition.NewRegistry()
+	reg.Register(&condition.ConditionDef{
+		ID: "shaken", Name: "Shaken", DurationType: "until_save", MaxStacks: 3,
+		RecoverySave: &condition.RecoverySave{Save: "cool", DC: 5},
+	})
+	reg.Register(&condition.ConditionDef{ID: "dying", Name: "Dying", DurationType: "until_save", MaxStacks: 4})
+	combatants := []*combat.Combatant{
+		{ID: "p1", Kind: combat.KindPlayer, Name: "Alice", MaxHP: 20, CurrentHP: 20, AC: 14, Level: 1},
+		{ID: "n1", Kind: combat.KindNPC, Name: "Ganger", MaxHP: 12, CurrentHP: 12, AC: 12, Level: 1},
+	}
+	cbt, err := combat.NewEngine().StartCombat("room1", combatants, reg, nil, "")
+	require.NoError(t, err)
+	return cbt
+}
+
+func TestResolveRecoverySaves_CritSuccess_ClearsCondition(t *testing.T) {
+	cbt := makeRecoveryCombat(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "shaken", 3, -1))
+
+	events := cbt.ResolveRecoverySaves(fixedSrc{val: 14}) // roll 15 vs DC 5
+	require.Len(t, events, 1)
+	assert.False(t, cbt.HasCondition("p1", "shaken"))
+	assert.False(t, events[0].Applied)
+	assert.Contains(t, events[0].Narrative, "shakes off Shaken")
+}
+
+func TestResolveRecoverySaves_Success_RemovesOneStack(t *testing.T) {
+	cbt := makeRecoveryCombat(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "shaken", 3, -1))
+
+	events := cbt.ResolveRecoverySaves(fixedSrc{val: 5}) // roll 6 vs DC 5
+	require.Len(t, events, 1)
+	assert.Equal(t, 2, cbt.Conditions["p1"].Stacks("shaken"))
+	assert.Equal(t, 2, events[0].Stacks)
+	assert.Contains(t, events[0].Narrative, "eases to 2")
+}
+
+func TestResolveRecoverySaves_Success_LastStackClearsCondition(t *testing.T) {
+	cbt := makeRecoveryCombat(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "shaken", 1, -1))
+
+	events := cbt.ResolveRecoverySaves(fixedSrc{val: 5})
+	require.Len(t, events, 1)
+	assert.False(t, cbt.HasCondition("p1", "shaken"))
+	assert.Contains(t, events[0].Narrative, "shakes off Shaken")
+}
+
+func TestResolveRecoverySaves_Failure_LeavesConditionUnchanged(t *testing.T) {
+	cbt := makeRecoveryCombat(t)
+	require.NoError(t, cbt.ApplyCondition("p1", "shaken", 2, -1))
+
+	events := cbt.ResolveRecoverySaves(fixedSrc{val: 0}) // roll 1 vs DC 5
+	require.Len(t, events, 1)
+	assert.Equal(t, 2, cbt.Conditions["p1"].Stacks("shaken"))
+	assert.True(t, events[0].Applied)
+	assert.Contains(t, events[0].Narrative, "fails a cool save")
+}
+
+func TestResolveRecoverySaves_SkipsConditionsWithoutRecoverySave(t *testing.T) {
+	cbt := makeRecoveryCombat(t)
+	require.NoError(t, cbt.ApplyCondition("n1", "dying", 1, -1))
+
+	events := cbt.ResolveRecoverySaves(fixedSrc{val: 19})
+	assert.Empty(t, events)
+	assert.True(t, cbt.HasCondition("n1", "dying"))
+}
+
+func TestProperty_ResolveRecoverySaves_NeverIncreasesStacks(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		cbt := makeRecoveryCombat(t)
+		stacks := rapid.IntRange(1, 3).Draw(rt, "stacks")
+		roll := rapid.IntRange(0, 19).Draw(rt, "roll")
+		require.NoError(rt, cbt.ApplyCondition("p1", "shaken", stacks, -1))
+
+		events := cbt.ResolveRecoverySaves(fixedSrc{val: roll})
+		require.Len(rt, events, 1)
+		assert.LessOrEqual(rt, cbt.Conditions["p1"].Stacks("shaken"), stacks)
+	})
+}
@@ -57,9 +57,9 @@ type Source interface {
 // Precondition: attacker and target must be non-nil and not dead; src must be non-nil.
 // Postcondition: Returns a fully populated AttackResult.
 func ResolveAttack(attacker, target *Combatant, src Source) AttackResult {
-	// Attack roll: d20 + STR modifier + proficiency bonus + weapon item bonus
+	// Attack roll: d20 + STR modifier + proficiency bonus + weapon item bonus + affix attack bonus
 	d20 := src.Intn(20) + 1
-	atkMod := attacker.StrMod + CombatProficiencyBonus(attacker.Level, attacker.WeaponProficiencyRank) + attacker.WeaponBonus
+	atkMod := attacker.StrMod + CombatProficiencyBonus(attacker.Level, attacker.WeaponProficiencyRank) + attacker.WeaponBonus + attacker.WeaponAffixes.AttackBonus
 	atkTotal := d20 + atkMod
 	outcome := OutcomeFor(atkTotal+attacker.AttackMod, target.AC+target.ACMod)
 
@@ -107,7 +107,7 @@ func ResolveFirearmAttack(attacker, target *Combatant, weapon *inventory.WeaponD
 		rangeIncrements = 0
 	}
 	rangePenalty := rangeIncrements * 2
-	total := rawRoll + attacker.DexMod + profBonus - rangePenalty + attacker.WeaponBonus
+	total := rawRoll + attacker.DexMod + profBonus - rangePenalty + attacker.WeaponBonus + attacker.WeaponAffixes.AttackBonus
 
 	// Damage roll using weapon's damage dice expression + weapon item bonus
 	dmgRoll, err := dice.RollExpr(weapon.DamageDice, src)
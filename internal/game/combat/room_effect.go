@@ -0,0 +1,155 @@
+package combat
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+)
+
+// RoomEffect is a persisting area effect on the floor of a fight — burning
+// ground from an incendiary, a gas cloud from a grenade. It belongs to the
+// room's combat: it damages every living combatant at the end of each round
+// and anyone who enters, and it is gone when its rounds run out or the fight
+// ends.
+type RoomEffect struct {
+	// ID identifies the effect's source, e.g. the explosive ID; a second
+	// effect with the same ID refreshes the first instead of stacking.
+	ID string
+	// Name is shown on the floor and in narratives, e.g. "burning ground".
+	Name       string
+	DamageDice string
+	DamageType string
+	// RoundsRemaining is how many more round ends the effect fires on.
+	RoundsRemaining int
+	// SourceID is the combatant who created the effect; damage it deals to
+	// the other side is credited to them.
+	SourceID string
+}
+
+// Label returns the floor indicator for e, e.g. "burning ground (2 rounds)".
+func (e RoomEffect) Label() string {
+	if e.RoundsRemaining == 1 {
+		return fmt.Sprintf("%s (1 round)", e.Name)
+	}
+	return fmt.Sprintf("%s (%d rounds)", e.Name, e.RoundsRemaining)
+}
+
+// AddRoomEffect lays e on the floor of c. An effect with the same ID already
+// present is refreshed to the longer duration rather than doubled.
+//
+// Precondition: e.RoundsRemaining > 0.
+// Postcondition: c.RoomEffects holds exactly one effect with e.ID.
+func (c *Combat) AddRoomEffect(e RoomEffect) {
+	for _, cur := range c.RoomEffects {
+		if cur.ID == e.ID {
+			cur.RoundsRemaining = max(cur.RoundsRemaining, e.RoundsRemaining)
+			cur.SourceID = e.SourceID
+			return
+		}
+	}
+	c.RoomEffects = append(c.RoomEffects, &e)
+}
+
+// RoomEffectLabels returns the floor indicator of every effect in c.
+//
+// Postcondition: Returns nil when c has no room effects.
+func (c *Combat) RoomEffectLabels() []string {
+	var out []string
+	for _, e := range c.RoomEffects {
+		out = append(out, e.Label())
+	}
+	return out
+}
+
+// TickRoomEffects fires every room effect on each living combatant at the end
+// of a round, then counts the effects down and clears the expired ones.
+//
+// Precondition: src must not be nil.
+// Postcondition: Returns one event per damaged or immune combatant and one per
+// expired effect; targetUpdater is called with each damaged combatant's HP.
+func (c *Combat) TickRoomEffects(src Source, targetUpdater func(id string, hp int)) []RoundEvent {
+	if len(c.RoomEffects) == 0 {
+		return nil
+	}
+	var events []RoundEvent
+	kept := c.RoomEffects[:0]
+	for _, e := range c.RoomEffects {
+		for _, target := range c.Combatants {
+			if target.IsDead() {
+				continue
+			}
+			events = append(events, c.applyRoomEffect(e, target, "", src, targetUpdater)...)
+		}
+		e.RoundsRemaining--
+		if e.RoundsRemaining > 0 {
+			kept = append(kept, e)
+			continue
+		}
+		events = append(events, RoundEvent{
+			ActionType: ActionHazardDamage,
+			Narrative:  fmt.Sprintf("The %s dies away.", e.Name),
+		})
+	}
+	c.RoomEffects = kept
+	return events
+}
+
+// EnterRoomEffects fires every room effect on combatant uid as they enter the
+// fight.
+//
+// Precondition: src must not be nil.
+// Postcondition: Returns nil when uid is not a living combatant or c has no
+// room effects; durations are unchanged.
+func (c *Combat) EnterRoomEffects(uid string, src Source) []RoundEvent {
+	target := c.GetCombatant(uid)
+	if target == nil || target.IsDead() {
+		return nil
+	}
+	var events []RoundEvent
+	for _, e := range c.RoomEffects {
+		events = append(events, c.applyRoomEffect(e, target, " as they enter", src, nil)...)
+	}
+	return events
+}
+
+// RoomEffectDamage rolls e's damage against target and runs it through the
+// damage-type matrix. cbt may be nil for a target outside the fight.
+//
+// Precondition: target must not be nil; src must not be nil.
+// Postcondition: Returns the zero DamageResult when e's dice fail to parse.
+func RoomEffectDamage(cbt *Combat, e RoomEffect, target *Combatant, src Source) DamageResult {
+	roll, err := dice.RollExpr(e.DamageDice, src)
+	if err != nil {
+		return DamageResult{}
+	}
+	return AdjustDamage(cbt, target, e.DamageType, max(roll.Total(), 0), "room_effect:"+e.ID)
+}
+
+// applyRoomEffect deals e's damage to target and narrates it; when is appended
+// after the target's name ("" or " as they enter").
+func (c *Combat) applyRoomEffect(e *RoomEffect, target *Combatant, when string, src Source, targetUpdater func(id string, hp int)) []RoundEvent {
+	result := RoomEffectDamage(c, *e, target, src)
+	note := AdjustmentNote(result.Breakdown)
+	if result.Final <= 0 && note == "" {
+		return nil
+	}
+	absorbed := ""
+	if result.Final > 0 {
+		absorbed = target.ApplyDamage(result.Final).Note()
+		if targetUpdater != nil {
+			targetUpdater(target.ID, target.CurrentHP)
+		}
+		if source := c.GetCombatant(e.SourceID); source != nil && source.Kind == KindPlayer && target.Kind == KindNPC {
+			c.RecordDamage(source.ID, result.Final)
+		}
+	}
+	narrative := withNote(withNote(fmt.Sprintf("The %s hits %s%s for %d damage.", e.Name, target.Name, when, result.Final), note), absorbed)
+	return []RoundEvent{{
+		ActionType: ActionHazardDamage,
+		ActorID:    target.ID,
+		ActorName:  target.Name,
+		TargetID:   target.ID,
+		Damage:     result.Final,
+		Narrative:  narrative,
+	}}
+}
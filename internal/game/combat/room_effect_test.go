@@ -0,0 +1,98 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+func burningGround(rounds int) combat.RoomEffect {
+	return combat.RoomEffect{
+		ID: "incendiary_grenade", Name: "burning ground",
+		DamageDice: "1d6", DamageType: "fire", RoundsRemaining: rounds, SourceID: "p1",
+	}
+}
+
+func TestAddRoomEffect_SameSourceRefreshesInsteadOfStacking(t *testing.T) {
+	cbt := newAdjustTestCombat(t)
+	cbt.AddRoomEffect(burningGround(2))
+	cbt.AddRoomEffect(burningGround(1))
+	require.Len(t, cbt.RoomEffects, 1)
+	assert.Equal(t, 2, cbt.RoomEffects[0].RoundsRemaining)
+	assert.Equal(t, []string{"burning ground (2 rounds)"}, cbt.RoomEffectLabels())
+}
+
+func TestTickRoomEffects_DamagesEveryoneAndExpires(t *testing.T) {
+	cbt := newAdjustTestCombat(t)
+	cbt.AddRoomEffect(burningGround(1))
+	updated := map[string]int{}
+	events := cbt.TickRoomEffects(fixedSrc{val: 3}, func(id string, hp int) { updated[id] = hp })
+
+	assert.Equal(t, 16, cbt.GetCombatant("p1").CurrentHP)
+	assert.Equal(t, 8, cbt.GetCombatant("n1").CurrentHP)
+	assert.Equal(t, map[string]int{"p1": 16, "n1": 8}, updated)
+	assert.Equal(t, 4, cbt.DamageDealt["p1"], "damage to the other side is credited to the thrower")
+	require.Len(t, events, 3)
+	assert.Equal(t, "The burning ground hits Alice for 4 damage.", events[0].Narrative)
+	assert.Equal(t, "The burning ground dies away.", events[2].Narrative)
+	assert.Empty(t, cbt.RoomEffects)
+}
+
+func TestEnterRoomEffects_HitsNewcomerWithoutCountingDown(t *testing.T) {
+	cbt := newAdjustTestCombat(t)
+	cbt.AddRoomEffect(burningGround(2))
+	cbt.GetCombatant("n1").Immunities = []string{"fire"}
+
+	events := cbt.EnterRoomEffects("n1", fixedSrc{val: 3})
+	require.Len(t, events, 1)
+	assert.Equal(t, "The burning ground hits Ganger as they enter for 0 damage. (immune to fire)", events[0].Narrative)
+	assert.Equal(t, 12, cbt.GetCombatant("n1").CurrentHP)
+	assert.Equal(t, 2, cbt.RoomEffects[0].RoundsRemaining)
+	assert.Nil(t, cbt.EnterRoomEffects("nobody", fixedSrc{val: 3}))
+}
+
+func TestResolveRound_LingeringExplosiveLeavesRoomEffect(t *testing.T) {
+	cbt := newAdjustTestCombat(t)
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterExplosive(&inventory.ExplosiveDef{
+		ID: "gas_grenade", Name: "Gas Grenade", DamageDice: "1d6", DamageType: "poison",
+		AreaType: inventory.AreaTypeRoom, SaveType: "reflex", SaveDC: 13, Fuse: inventory.FuseImmediate,
+		Lingering: &inventory.LingeringEffect{Name: "gas cloud", Rounds: 3, DamageDice: "1d4"},
+	}))
+	cbt.SetInventoryRegistry(reg)
+	_ = cbt.StartRound(3)
+	require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionThrow, ExplosiveID: "gas_grenade"}))
+
+	events := combat.ResolveRound(cbt, fixedSrc{val: 1}, nil, nil, 0)
+	require.Len(t, cbt.RoomEffects, 1)
+	e := cbt.RoomEffects[0]
+	assert.Equal(t, "poison", e.DamageType, "lingering damage type defaults to the explosive's")
+	assert.Equal(t, 2, e.RoundsRemaining, "the cloud already fired at this round's end")
+	var narratives []string
+	for _, ev := range events {
+		narratives = append(narratives, ev.Narrative)
+	}
+	assert.Contains(t, narratives, "The gas cloud spreads across the floor (3 rounds).")
+	assert.Contains(t, narratives, "The gas cloud hits Alice for 2 damage.")
+}
+
+func TestProperty_TickRoomEffects_ExpiresAfterRounds(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		cbt := &combat.Combat{Combatants: []*combat.Combatant{
+			{ID: "p1", Kind: combat.KindPlayer, Name: "Alice", MaxHP: 1000, CurrentHP: 1000},
+		}}
+		rounds := rapid.IntRange(1, 10).Draw(rt, "rounds")
+		cbt.AddRoomEffect(burningGround(rounds))
+		for i := 0; i < rounds-1; i++ {
+			cbt.TickRoomEffects(fixedSrc{val: 0}, nil)
+			require.Len(rt, cbt.RoomEffects, 1)
+		}
+		cbt.TickRoomEffects(fixedSrc{val: 0}, nil)
+		assert.Empty(rt, cbt.RoomEffects)
+	})
+}
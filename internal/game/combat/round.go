@@ -3,6 +3,7 @@ package combat
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/detection"
 	"github.com/cory-johannsen/mud/internal/game/dice"
 	"github.com/cory-johannsen/mud/internal/game/effect"
+	"github.com/cory-johannsen/mud/internal/game/i18n"
 	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/inventory/traits"
 	"github.com/cory-johannsen/mud/internal/game/reaction"
 )
 
@@ -99,20 +102,20 @@ func CheckReactiveStrikesCtx(cbt *Combat, ctx ReactionMoveContext, rng Source, t
 		atkTotal := d20 + c.Level
 		outcome := OutcomeFor(atkTotal, mover.AC)
 		dmg := 0
-		hitNarrative := fmt.Sprintf("%s makes a reactive strike against %s!", c.Name, mover.Name)
+		hitNarrative := narrate("combat.reactive_strike", i18n.Args{"actor": c.Name, "target": mover.Name})
 		switch outcome {
 		case CritSuccess:
 			dmg = rng.Intn(6) + 1 + rng.Intn(6) + 1 // double damage die on crit
 			mover.ApplyDamage(dmg)
 			targetUpdater(mover.ID, mover.CurrentHP)
-			hitNarrative = fmt.Sprintf("%s makes a reactive strike against %s! *** CRITICAL HIT! *** Deals %d damage (total %d)!", c.Name, mover.Name, dmg, atkTotal)
+			hitNarrative = narrate("combat.reactive_strike_crit", i18n.Args{"actor": c.Name, "target": mover.Name, "damage": dmg, "total": atkTotal})
 		case Success:
 			dmg = rng.Intn(6) + 1
 			mover.ApplyDamage(dmg)
 			targetUpdater(mover.ID, mover.CurrentHP)
-			hitNarrative = fmt.Sprintf("%s makes a reactive strike against %s! Hit for %d damage (total %d).", c.Name, mover.Name, dmg, atkTotal)
+			hitNarrative = narrate("combat.reactive_strike_hit", i18n.Args{"actor": c.Name, "target": mover.Name, "damage": dmg, "total": atkTotal})
 		default:
-			hitNarrative = fmt.Sprintf("%s makes a reactive strike against %s! Miss (total %d).", c.Name, mover.Name, atkTotal)
+			hitNarrative = narrate("combat.reactive_strike_miss", i18n.Args{"actor": c.Name, "target": mover.Name, "total": atkTotal})
 		}
 
 		r := AttackResult{
@@ -127,6 +130,7 @@ func CheckReactiveStrikesCtx(cbt *Combat, ctx ReactionMoveContext, rng Source, t
 			ActorName:    c.Name,
 			TargetID:     moverID,
 			Narrative:    hitNarrative,
+			Damage:       dmg,
 		})
 	}
 	return events
@@ -198,33 +202,38 @@ func towardDelta(fx, fy, tx, ty int) (int, int) {
 func attackNarrative(actorName, verb, targetName, weaponName string, outcome Outcome, d20Roll, total, targetAC, dmg int) string {
 	with := ""
 	if weaponName != "" {
-		with = " with a " + weaponName
-	}
-	mod := total - d20Roll
-	var rollBreakdown string
-	switch {
-	case mod > 0:
-		rollBreakdown = fmt.Sprintf("[1d20 (%d) +%d = %d vs AC %d]", d20Roll, mod, total, targetAC)
-	case mod < 0:
-		rollBreakdown = fmt.Sprintf("[1d20 (%d) %d = %d vs AC %d]", d20Roll, mod, total, targetAC)
-	default:
-		rollBreakdown = fmt.Sprintf("[1d20 (%d) = %d vs AC %d]", d20Roll, total, targetAC)
+		with = " " + narrate("combat.with_weapon", i18n.Args{"weapon": weaponName})
+	}
+	mod := ""
+	switch m := total - d20Roll; {
+	case m > 0:
+		mod = fmt.Sprintf(" +%d", m)
+	case m < 0:
+		mod = fmt.Sprintf(" %d", m)
+	}
+	args := i18n.Args{
+		"actor":  actorName,
+		"verb":   verb,
+		"target": targetName,
+		"with":   with,
+		"roll":   narrate("combat.roll_breakdown", i18n.Args{"d20": d20Roll, "mod": mod, "total": total, "ac": targetAC}),
+		"damage": dmg,
 	}
 	switch outcome {
 	case CritSuccess:
 		if dmg > 0 {
-			return fmt.Sprintf("*** CRITICAL HIT! *** %s %s %s%s %s for %d damage!", actorName, verb, targetName, with, rollBreakdown, dmg)
+			return narrate("combat.attack_crit_damage", args)
 		}
-		return fmt.Sprintf("*** CRITICAL HIT! *** %s %s %s%s %s!", actorName, verb, targetName, with, rollBreakdown)
+		return narrate("combat.attack_crit", args)
 	case CritFailure:
-		return fmt.Sprintf("*** CRITICAL MISS! *** %s fumbles against %s %s!", actorName, targetName, rollBreakdown)
+		return narrate("combat.attack_fumble", args)
 	case Success:
 		if dmg > 0 {
-			return fmt.Sprintf("%s %s %s%s %s for %d damage.", actorName, verb, targetName, with, rollBreakdown, dmg)
+			return narrate("combat.attack_hit_damage", args)
 		}
-		return fmt.Sprintf("%s %s %s%s %s.", actorName, verb, targetName, with, rollBreakdown)
+		return narrate("combat.attack_hit", args)
 	default: // Failure
-		return fmt.Sprintf("%s %s %s%s %s — miss.", actorName, verb, targetName, with, rollBreakdown)
+		return narrate("combat.attack_miss", args)
 	}
 }
 
@@ -246,7 +255,8 @@ type RoundEvent struct {
 	Narrative        string
 	// Flanking is true when the attacker benefits from the flanking +2 bonus on this attack.
 	Flanking bool
-	// AbilityID is the technology ID for ActionUseTech events; empty for all other event types.
+	// AbilityID is the technology ID for ActionUseTech events and the ability ID for
+	// ActionUseAbility events; empty for all other event types.
 	AbilityID string
 	// TargetX and TargetY are the AoE burst center grid coordinates for ActionUseTech events.
 	// -1 means unset (no AoE targeting).
@@ -257,14 +267,21 @@ type RoundEvent struct {
 	// prepared action after ResolveRound returns; inline execution is deferred
 	// to keep this package's responsibilities focused on dispatch.
 	ReadyEntry *reaction.ReadyEntry
-	// Damage is the post-pipeline damage applied by this event (TERRAIN-9..14).
-	// Used by hazard-damage events; zero for non-damaging events.
+	// Damage is the post-pipeline damage applied by this event (TERRAIN-9..14):
+	// hazard damage, or an attack's damage after shields and armor absorb
+	// their share. Zero for non-damaging events.
 	Damage int
 	// DamageBreakdown is the inline-formatted breakdown line (MULT-14).
 	// Empty string when the breakdown was trivial (only StageBase).
 	DamageBreakdown string
 	// BreakdownSteps is the structured breakdown for verbose rendering (MULT-15).
 	BreakdownSteps []DamageBreakdownStep
+	// Pushed is true when forced movement (a blast's knockback) moved TargetID.
+	Pushed bool
+	// PushedOut is the compass edge ("n", "s", "e", "w") a forced move drove
+	// TargetID into. The gameserver ejects the target through an open exit
+	// that way; empty when the target stayed on the grid.
+	PushedOut string
 }
 
 // findCombatantByName returns the first Combatant in cbt whose Name matches name, or nil.
@@ -339,13 +356,17 @@ func hookPassiveFeatCheck(cbt *Combat, actorID, targetID, featID string, bonus i
 	return bonus
 }
 
-// hookDamageRoll invokes the on_damage_roll Lua hook (if a scriptMgr is present) and returns
-// the (possibly overridden) damage value.
+// hookDamageRoll scales dmg by the combat's zone DamageMultiplier, then invokes the
+// on_damage_roll Lua hook (if a scriptMgr is present) and returns the (possibly
+// overridden) damage value.
 // Precondition: actor and target must be non-nil; dmg >= 0.
-// Postcondition: Returns dmg unchanged if cbt.scriptMgr is nil, dmg <= 0, or hook is absent/returns nil.
+// Postcondition: Returns the scaled dmg if cbt.scriptMgr is nil, dmg <= 0, or hook is absent/returns nil.
 //
 //	Returns hook's integer return value when hook returns a Lua number.
 func hookDamageRoll(cbt *Combat, actor, target *Combatant, dmg int) int {
+	if cbt.DamageMultiplier > 0 && cbt.DamageMultiplier != 1 && dmg > 0 {
+		dmg = int(math.Round(float64(dmg) * cbt.DamageMultiplier))
+	}
 	if cbt.scriptMgr == nil || dmg <= 0 {
 		return dmg
 	}
@@ -412,12 +433,17 @@ func mapPenaltyFor(attacksMade int) int {
 // applyAttackConditions applies conditions triggered by an attack result:
 //   - CritFailure: attacker gains prone (permanent, -2 to attacks)
 //   - CritSuccess: target gains flat_footed (1 round)
-//   - Player target at 0 HP (not already dying): gains dying(1 + wounded stacks)
+//   - Target dropped to 0 HP by a nonlethal attack: knocked out instead of
+//     dying or dead
+//   - Player target at 0 HP (not already dying): gains dying(1 + wounded stacks),
+//     dying outright when that reaches DeathDyingValue
+//   - Dying player target taking damage: dying +1 (+2 on a critical hit)
 //
-// Precondition: cbt, target, and r must be valid; cbt.condRegistry must be non-nil.
+// Precondition: cbt, target, and r must be valid; cbt.condRegistry must be non-nil;
+// dmg is the damage the attack dealt.
 // Postcondition: Conditions are applied in-place on cbt, subject to on_condition_apply hooks.
 // Returns a slice of human-readable narratives describing each condition applied.
-func applyAttackConditions(cbt *Combat, actor, target *Combatant, r AttackResult) []string {
+func applyAttackConditions(cbt *Combat, actor, target *Combatant, r AttackResult, dmg int) []string {
 	var notes []string
 	switch r.Outcome {
 	case CritFailure:
@@ -429,14 +455,55 @@ func applyAttackConditions(cbt *Combat, actor, target *Combatant, r AttackResult
 			notes = append(notes, target.Name+" is flat-footed! (-2 AC this round)")
 		}
 	}
-	// Only apply dying if the target is a player, at 0 HP, and NOT already dying
-	if target.CurrentHP <= 0 && target.Kind == KindPlayer && !cbt.HasCondition(target.ID, "dying") {
-		woundedStacks := cbt.Conditions[target.ID].Stacks("wounded")
-		applyConditionIfAllowed(cbt, target.ID, "dying", 1+woundedStacks, -1)
+	if target.CurrentHP <= 0 && dmg > 0 && !target.Dead && !cbt.HasCondition(target.ID, "dying") && attacksNonlethally(cbt, actor) {
+		if !target.KnockedOut {
+			target.KnockedOut = true
+			notes = append(notes, target.Name+" is knocked out!")
+		}
+		return notes
+	}
+	if target.Kind != KindPlayer || target.IsDead() || target.CurrentHP > 0 {
+		return notes
+	}
+	if cbt.HasCondition(target.ID, "dying") {
+		if dmg <= 0 {
+			return notes
+		}
+		n := 1
+		if r.Outcome == CritSuccess {
+			n = 2
+		}
+		cbt.IncreaseDying(target.ID, n)
+		if target.IsDead() {
+			notes = append(notes, narrate("combat.succumbs", i18n.Args{"target": target.Name}))
+		} else {
+			notes = append(notes, narrate("combat.dying", i18n.Args{"target": target.Name, "stacks": cbt.DyingStacks(target.ID)}))
+		}
+		return notes
+	}
+	woundedStacks := cbt.Conditions[target.ID].Stacks("wounded")
+	if applyConditionIfAllowed(cbt, target.ID, "dying", 1+woundedStacks, -1) && cbt.checkDyingOnDown(target) {
+		notes = append(notes, narrate("combat.succumbs", i18n.Args{"target": target.Name}))
 	}
 	return notes
 }
 
+// attacksNonlethally reports whether actor's attacks knock targets out rather
+// than kill them: its main-hand weapon has the nonlethal trait, or actor is a
+// player who has switched to nonlethal attacks.
+//
+// Precondition: cbt and actor must not be nil.
+func attacksNonlethally(cbt *Combat, actor *Combatant) bool {
+	if actor.Loadout != nil && actor.Loadout.MainHand != nil && actor.Loadout.MainHand.Def.HasTrait(traits.Nonlethal) {
+		return true
+	}
+	if actor.Kind != KindPlayer || cbt.sessionGetter == nil {
+		return false
+	}
+	ps, ok := cbt.sessionGetter(actor.ID)
+	return ok && ps.Nonlethal
+}
+
 // applyPassiveFeats evaluates all active passive feats for actor against target and returns
 // the total bonus damage to add.
 // Precondition: actor and target must be non-nil; dmg is the base hit damage (0 on miss).
@@ -560,7 +627,7 @@ func fireTrigger(
 				return []RoundEvent{{
 					ActorID:   uid,
 					ActorName: combatant.Name,
-					Narrative: fmt.Sprintf("[%s] %s's ready is skipped: reaction budget exhausted.", EventTypeReadyFizzled, combatant.Name),
+					Narrative: narrate("combat.ready_skipped", i18n.Args{"tag": EventTypeReadyFizzled, "actor": combatant.Name}),
 				}}
 			}
 			if !revalidateReadyEntry(cbt, entry) {
@@ -568,7 +635,7 @@ func fireTrigger(
 				return []RoundEvent{{
 					ActorID:   uid,
 					ActorName: combatant.Name,
-					Narrative: fmt.Sprintf("[%s] %s's ready fizzles: target no longer valid.", EventTypeReadyFizzled, combatant.Name),
+					Narrative: narrate("combat.ready_fizzled", i18n.Args{"tag": EventTypeReadyFizzled, "actor": combatant.Name}),
 				}}
 			}
 			// Inline execution of the prepared action is deferred to the
@@ -576,7 +643,7 @@ func fireTrigger(
 			return []RoundEvent{{
 				ActorID:    uid,
 				ActorName:  combatant.Name,
-				Narrative:  fmt.Sprintf("[%s] %s's ready triggers (%s).", EventTypeReactionFired, combatant.Name, entry.Action.Type),
+				Narrative:  narrate("combat.ready_triggered", i18n.Args{"tag": EventTypeReactionFired, "actor": combatant.Name, "action": entry.Action.Type}),
 				ReadyEntry: entry,
 			}}
 		}
@@ -614,7 +681,7 @@ func fireTrigger(
 	return []RoundEvent{{
 		ActorID:   uid,
 		ActorName: combatant.Name,
-		Narrative: fmt.Sprintf("[%s] %s reacts with %s.", EventTypeReactionFired, combatant.Name, chosen.FeatName),
+		Narrative: narrate("combat.reacts_with", i18n.Args{"tag": EventTypeReactionFired, "actor": combatant.Name, "feat": chosen.FeatName}),
 	}}
 }
 
@@ -650,6 +717,8 @@ type reactionDispatchFn func(uid string, trigger reaction.ReactionTriggerType, r
 //   - ActionPass: narrative event, no damage, nil AttackResult.
 //
 // Dead combatants are skipped entirely (no events).
+// Once every queued action is resolved, the room's lingering effects fire on
+// everyone still standing (TickRoomEffects).
 // If a target is dead before a follow-up strike hit, emit a narrative "hit nothing" event.
 // If the ActionStrike target is nil or already dead at the start of the strike, both the first and
 // second attack produce "hit nothing" narrative events with nil AttackResult.
@@ -711,14 +780,6 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				if dir == "" {
 					dir = "toward"
 				}
-				width := cbt.GridWidth
-				if width == 0 {
-					width = 10
-				}
-				height := cbt.GridHeight
-				if height == 0 {
-					height = 10
-				}
 
 				// Find the first living opponent (used for "toward"/"away" legacy directions).
 				// Use the start-of-round snapshot position so later-acting combatants
@@ -742,7 +803,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				// impassable. SpeedBudget defaults to 5 (25 ft) when SpeedFt is 0.
 				// Each step recomputes direction for "toward"/"away" since position changes.
 				budget := actor.SpeedBudget()
-				strideNarrative := fmt.Sprintf("%s strides %s.", actor.Name, dir)
+				strideNarrative := narrate("combat.stride", i18n.Args{"actor": actor.Name, "dir": dir})
 				stepsTaken := 0
 				for budget > 0 {
 					// REQ-STRIDE-STOP: For "toward" strides, stop when already adjacent (≤ 5 ft).
@@ -755,56 +816,30 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						break
 					}
 
-					newX := actor.GridX + dx
-					newY := actor.GridY + dy
-					// Clamp to grid bounds.
-					if newX < 0 {
-						newX = 0
-					} else if newX >= width {
-						newX = width - 1
-					}
-					if newY < 0 {
-						newY = 0
-					} else if newY >= height {
-						newY = height - 1
-					}
-					// Stop if clamping produced no movement (actor is at a grid edge in this direction).
-					if newX == actor.GridX && newY == actor.GridY {
-						break
-					}
-					// REQ-STRIDE-NOOVERLAP: Do not move onto a cell occupied by
-					// another living combatant or a cover object (GH #227).
-					if CellBlocked(cbt, actor.ID, newX, newY) {
-						break
-					}
-					// TERRAIN-8/17: Greater-difficult cells are impassable.
-					cost, passable := cbt.EntryCost(newX, newY)
-					if !passable {
+					// REQ-STRIDE-NOOVERLAP / GH #227 / TERRAIN-7/8/9/17/18: the shared
+					// cell-entry rule stops at edges, occupants, cover, impassable or
+					// unaffordable terrain, and fires on_enter hazards.
+					outcome, cost, stepEvents := StepCombatant(cbt, actor, dx, dy, budget, src)
+					switch outcome {
+					case StepImpassable:
 						if stepsTaken == 0 {
-							strideNarrative = fmt.Sprintf("%s tries to move but the terrain blocks the way.", actor.Name)
+							strideNarrative = narrate("combat.stride_blocked", i18n.Args{"actor": actor.Name})
 						} else {
-							strideNarrative = fmt.Sprintf("%s strides %s and stops at the terrain.", actor.Name, dir)
+							strideNarrative = narrate("combat.stride_stopped", i18n.Args{"actor": actor.Name, "dir": dir})
 						}
-						break
-					}
-					// TERRAIN-7/18: Insufficient budget to enter the next cell.
-					if cost > budget {
+					case StepTooCostly:
 						if stepsTaken == 0 {
-							strideNarrative = fmt.Sprintf("%s cannot afford to move — not enough movement speed.", actor.Name)
+							strideNarrative = narrate("combat.stride_no_speed", i18n.Args{"actor": actor.Name})
 						} else {
-							strideNarrative = fmt.Sprintf("%s strides %s and stops — terrain too rough to continue.", actor.Name, dir)
+							strideNarrative = narrate("combat.stride_too_rough", i18n.Args{"actor": actor.Name, "dir": dir})
 						}
+					}
+					if outcome != StepMoved {
 						break
 					}
 					budget -= cost
-					actor.GridX = newX
-					actor.GridY = newY
 					stepsTaken++
-
-					// TERRAIN-9: fire on_enter hazard for hazardous cells.
-					if tc := cbt.TerrainAt(newX, newY); tc.Type == TerrainHazardous && tc.Hazard != nil {
-						events = append(events, applyCellHazard(cbt, actor, tc, "on_enter", src)...)
-					}
+					events = append(events, stepEvents...)
 
 					// REQ-RXN19: TriggerOnEnemyMoveAdjacent fires when an NPC moves into melee range of a player.
 					if actor.Kind == KindNPC {
@@ -835,19 +870,11 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				// NOT fire TriggerOnEnemyMoveAdjacent for adjacent players when
 				// the mover is an NPC. Movement is bounded by SpeedBudget()
 				// (WMOVE-G1) and travels toward (TargetX, TargetY).
-				width := cbt.GridWidth
-				if width == 0 {
-					width = 10
-				}
-				height := cbt.GridHeight
-				if height == 0 {
-					height = 10
-				}
 				targetX := int(action.TargetX)
 				targetY := int(action.TargetY)
 				budget := actor.SpeedBudget()
 				stepsTaken := 0
-				moveNarrative := fmt.Sprintf("%s strides freely (move trait).", actor.Name)
+				moveNarrative := narrate("combat.move_trait_stride", i18n.Args{"actor": actor.Name})
 				for budget > 0 {
 					if actor.GridX == targetX && actor.GridY == targetY {
 						break
@@ -856,44 +883,19 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 					if dx == 0 && dy == 0 {
 						break
 					}
-					newX := actor.GridX + dx
-					newY := actor.GridY + dy
-					if newX < 0 {
-						newX = 0
-					} else if newX >= width {
-						newX = width - 1
-					}
-					if newY < 0 {
-						newY = 0
-					} else if newY >= height {
-						newY = height - 1
-					}
-					if newX == actor.GridX && newY == actor.GridY {
-						break
-					}
-					if CellBlocked(cbt, actor.ID, newX, newY) {
-						break
-					}
-					cost, passable := cbt.EntryCost(newX, newY)
-					if !passable {
-						break
-					}
-					if cost > budget {
+					outcome, cost, stepEvents := StepCombatant(cbt, actor, dx, dy, budget, src)
+					if outcome != StepMoved {
 						break
 					}
 					budget -= cost
-					actor.GridX = newX
-					actor.GridY = newY
 					stepsTaken++
-					if tc := cbt.TerrainAt(newX, newY); tc.Type == TerrainHazardous && tc.Hazard != nil {
-						events = append(events, applyCellHazard(cbt, actor, tc, "on_enter", src)...)
-					}
+					events = append(events, stepEvents...)
 					// WMOVE-12: explicitly do NOT fire TriggerOnEnemyMoveAdjacent
 					// here — the Mobile trait suppresses reaction triggers on
 					// the granted movement.
 				}
 				if stepsTaken == 0 {
-					moveNarrative = fmt.Sprintf("%s does not move (move trait).", actor.Name)
+					moveNarrative = narrate("combat.move_trait_still", i18n.Args{"actor": actor.Name})
 				}
 				events = append(events, RoundEvent{
 					ActionType: ActionMoveTraitStride,
@@ -907,7 +909,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 					ActionType: ActionPass,
 					ActorID:    actor.ID,
 					ActorName:  actor.Name,
-					Narrative:  fmt.Sprintf("%s passes.", actor.Name),
+					Narrative:  narrate("combat.pass", i18n.Args{"actor": actor.Name}),
 				})
 				// Clear combat-start flat_footed from NPC combatants after their first
 				// action resolves (sucker_punch window). Mid-round flat_footed (crit,
@@ -926,7 +928,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						ActionType: ActionAttack,
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
-						Narrative:  fmt.Sprintf("%s attacks but hits nothing.", actor.Name),
+						Narrative:  narrate("combat.attack_no_target", i18n.Args{"actor": actor.Name}),
 					})
 					continue
 				}
@@ -945,7 +947,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActionType: ActionAttack,
 							ActorID:    actor.ID,
 							ActorName:  actor.Name,
-							Narrative:  fmt.Sprintf("%s attacks %s but fails to locate them (flat check %d)!", actor.Name, target.Name, flatRoll),
+							Narrative:  narrate("combat.attack_unlocated", i18n.Args{"actor": actor.Name, "target": target.Name, "roll": flatRoll}),
 						})
 						continue
 					}
@@ -961,7 +963,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActorID:    actor.ID,
 							ActorName:  actor.Name,
 							TargetID:   target.ID,
-							Narrative:  fmt.Sprintf("%s swings at %s but can't locate them (flat check %d)!", actor.Name, target.Name, flatRoll),
+							Narrative:  narrate("combat.swing_unlocated", i18n.Args{"actor": actor.Name, "target": target.Name, "roll": flatRoll}),
 						})
 						continue
 					}
@@ -978,6 +980,10 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				}
 				dist := CombatRange(*actor, *target)
 				isMelee := mainHandDef == nil || mainHandDef.RangeIncrement == 0
+				if isMelee && actor.BackRow {
+					events = append(events, backRowMeleeEvent(actor, ActionAttack))
+					continue
+				}
 				if isMelee {
 					// Melee weapons (including unarmed) cannot attack beyond 5ft.
 					if dist > 5 {
@@ -985,7 +991,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActionType: ActionAttack,
 							ActorID:    actor.ID,
 							ActorName:  actor.Name,
-							Narrative:  fmt.Sprintf("%s swings but %s is out of melee range.", actor.Name, target.Name),
+							Narrative:  narrate("combat.out_of_melee_range", i18n.Args{"actor": actor.Name, "target": target.Name}),
 						})
 						if actor.Kind == KindNPC && cbt.Conditions[actor.ID] != nil &&
 							cbt.Conditions[actor.ID].Source("flat_footed") == "combat_start" {
@@ -1001,7 +1007,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActionType: ActionAttack,
 							ActorID:    actor.ID,
 							ActorName:  actor.Name,
-							Narrative:  fmt.Sprintf("%s fires but %s is at extreme range.", actor.Name, target.Name),
+							Narrative:  narrate("combat.extreme_range", i18n.Args{"actor": actor.Name, "target": target.Name}),
 						})
 						if actor.Kind == KindNPC && cbt.Conditions[actor.ID] != nil &&
 							cbt.Conditions[actor.ID].Source("flat_footed") == "combat_start" {
@@ -1056,6 +1062,10 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 					r.AttackTotal += 2
 				}
 				effectiveAC := target.AC + target.InitiativeBonus + acBonus
+				screened := isMelee && cbt.Screened(target)
+				if screened {
+					effectiveAC += BackRowMeleeACBonus
+				}
 				r.AttackTotal = hookAttackRoll(cbt, actor, target, r.AttackTotal)
 				r.Outcome = OutcomeFor(r.AttackTotal, effectiveAC)
 				// COVER-11/12: cover absorb-miss — target was hit only because of cover.
@@ -1071,7 +1081,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActorName:        actor.Name,
 							TargetID:         target.ID,
 							CoverEquipmentID: coverEquipID,
-							Narrative:        fmt.Sprintf("%s's attack hits %s's cover!", actor.Name, target.Name),
+							Narrative:        narrate("combat.attack_hits_cover", i18n.Args{"actor": actor.Name, "target": target.Name}),
 						})
 						if destroyed {
 							events = append(events, RoundEvent{
@@ -1079,7 +1089,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 								ActorID:    actor.ID,
 								ActorName:  actor.Name,
 								TargetID:   target.ID,
-								Narrative:  fmt.Sprintf("%s's cover is destroyed!", target.Name),
+								Narrative:  narrate("combat.cover_destroyed", i18n.Args{"target": target.Name}),
 							})
 						}
 					}
@@ -1111,6 +1121,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				}
 				passiveFeatBonus := applyPassiveFeats(cbt, actor, target, passiveFeatGate, src)
 				di := BuildDamageInput(BuildDamageOpts{
+					Combat:            cbt,
 					Actor:             actor,
 					Target:            target,
 					AttackResult:      r,
@@ -1121,17 +1132,6 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				})
 				dmgResult := ResolveDamage(di)
 				dmg := hookDamageRoll(cbt, actor, target, dmgResult.Final)
-				var rwAnnotations []string
-				// MULT-14: derive narrative annotations for resistance/weakness so the
-				// "(...)" suffix on attack narratives still surfaces target defenses to the player.
-				for _, step := range dmgResult.Breakdown {
-					switch step.Stage {
-					case StageWeakness:
-						rwAnnotations = append(rwAnnotations, fmt.Sprintf("weakness +%d", step.Delta))
-					case StageResistance:
-						rwAnnotations = append(rwAnnotations, fmt.Sprintf("resistance %d", -step.Delta))
-					}
-				}
 				// REQ-RXN19: TriggerOnDamageTaken fires before damage is applied so reduce_damage can modify it.
 				if target.Kind == KindPlayer && dmg > 0 {
 					events = append(events, fireReaction(target.ID, reaction.TriggerOnDamageTaken, reaction.ReactionContext{
@@ -1140,8 +1140,9 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						DamagePending: &dmg,
 					})...)
 				}
+				var absorbed Absorption
 				if dmg > 0 {
-					target.ApplyDamage(dmg)
+					absorbed = target.ApplyDamage(dmg)
 					targetUpdater(target.ID, target.CurrentHP)
 					if actor.Kind == KindPlayer && target.Kind == KindNPC {
 						cbt.RecordDamage(actor.ID, dmg)
@@ -1164,21 +1165,28 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						}
 					}
 				}
-				condNotes := applyAttackConditions(cbt, actor, target, r)
+				condNotes := applyAttackConditions(cbt, actor, target, r, dmg-absorbed.Total())
 				attackVerb1 := actor.AttackVerb
 				if attackVerb1 == "" {
-					attackVerb1 = "attacks"
+					attackVerb1 = narrate("combat.default_verb", nil)
 				}
 				narrative := attackNarrative(actor.Name, attackVerb1, target.Name, r.WeaponName, r.Outcome, r.AttackRoll, r.AttackTotal, effectiveAC, dmg)
-				if len(rwAnnotations) > 0 {
-					narrative += " (" + strings.Join(rwAnnotations, "; ") + ")"
+				// MULT-14: surface target immunity, weakness, and resistance to the player.
+				if note := AdjustmentNote(dmgResult.Breakdown); note != "" {
+					narrative += " " + note
 				}
 				if flanked {
-					narrative += " (flanking +2)"
+					narrative += " " + narrate("combat.flanking_note", nil)
+				}
+				if screened {
+					narrative += " " + narrate("combat.back_row_note", i18n.Args{"bonus": BackRowMeleeACBonus})
 				}
 				for _, note := range condNotes {
 					narrative += " " + note
 				}
+				if note := absorbed.Note(); note != "" {
+					narrative += " " + note
+				}
 				events = append(events, RoundEvent{
 					AttackResult:    &r,
 					ActionType:      ActionAttack,
@@ -1186,6 +1194,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 					ActorName:       actor.Name,
 					Narrative:       narrative,
 					Flanking:        flanked,
+					Damage:          dmg - absorbed.Total(),
 					DamageBreakdown: FormatBreakdownInline(dmgResult.Breakdown),
 					BreakdownSteps:  dmgResult.Breakdown,
 				})
@@ -1212,17 +1221,21 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						ActionType: ActionStrike,
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
-						Narrative:  fmt.Sprintf("%s strikes but hits nothing.", actor.Name),
+						Narrative:  narrate("combat.strike_no_target", i18n.Args{"actor": actor.Name}),
 					})
 					// Emit a second event for the follow-up that also hits nothing.
 					events = append(events, RoundEvent{
 						ActionType: ActionStrike,
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
-						Narrative:  fmt.Sprintf("%s strikes again but hits nothing.", actor.Name),
+						Narrative:  narrate("combat.strike_again_no_target", i18n.Args{"actor": actor.Name}),
 					})
 					continue
 				}
+				if actor.BackRow && AttacksInMelee(actor) {
+					events = append(events, backRowMeleeEvent(actor, ActionStrike))
+					continue
+				}
 				// DETECT-19: Strike is an auditory action.
 				actor.MadeSoundThisRound = true
 				// Hidden flat check: NPC striking a hidden player must pass DC 11.
@@ -1238,7 +1251,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActionType: ActionStrike,
 							ActorID:    actor.ID,
 							ActorName:  actor.Name,
-							Narrative:  fmt.Sprintf("%s strikes at %s but fails to locate them (flat check %d)!", actor.Name, target.Name, flatRoll),
+							Narrative:  narrate("combat.strike_unlocated", i18n.Args{"actor": actor.Name, "target": target.Name, "roll": flatRoll}),
 						})
 						continue
 					}
@@ -1261,6 +1274,11 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				r1.AttackTotal += mapPenaltyFor(actor.AttacksMadeThisRound)
 				actor.AttacksMadeThisRound++
 				effectiveAC1 := target.AC + target.InitiativeBonus + acBonus1
+				// A back-row target behind its allies is harder to reach in melee.
+				strikeScreened := AttacksInMelee(actor) && cbt.Screened(target)
+				if strikeScreened {
+					effectiveAC1 += BackRowMeleeACBonus
+				}
 				r1.AttackTotal = hookAttackRoll(cbt, actor, target, r1.AttackTotal)
 				r1.Outcome = OutcomeFor(r1.AttackTotal, effectiveAC1)
 				// COVER-11/12: cover absorb-miss — first strike was a miss only because of cover.
@@ -1276,7 +1294,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActorName:        actor.Name,
 							TargetID:         target.ID,
 							CoverEquipmentID: coverEquipID1,
-							Narrative:        fmt.Sprintf("%s's strike hits %s's cover!", actor.Name, target.Name),
+							Narrative:        narrate("combat.strike_hits_cover", i18n.Args{"actor": actor.Name, "target": target.Name}),
 						})
 						if destroyed1 {
 							events = append(events, RoundEvent{
@@ -1284,7 +1302,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 								ActorID:    actor.ID,
 								ActorName:  actor.Name,
 								TargetID:   target.ID,
-								Narrative:  fmt.Sprintf("%s's cover is destroyed!", target.Name),
+								Narrative:  narrate("combat.cover_destroyed", i18n.Args{"target": target.Name}),
 							})
 						}
 					}
@@ -1300,6 +1318,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				}
 				passiveFeatBonus1 := applyPassiveFeats(cbt, actor, target, passiveFeatGate1, src)
 				di1 := BuildDamageInput(BuildDamageOpts{
+					Combat:            cbt,
 					Actor:             actor,
 					Target:            target,
 					AttackResult:      r1,
@@ -1309,17 +1328,6 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				})
 				dmgResult1 := ResolveDamage(di1)
 				dmg1 := hookDamageRoll(cbt, actor, target, dmgResult1.Final)
-				var rwAnnotations1 []string
-				// MULT-14: derive narrative annotations for resistance/weakness so the
-				// "(...)" suffix on attack narratives still surfaces target defenses to the player.
-				for _, step := range dmgResult1.Breakdown {
-					switch step.Stage {
-					case StageWeakness:
-						rwAnnotations1 = append(rwAnnotations1, fmt.Sprintf("weakness +%d", step.Delta))
-					case StageResistance:
-						rwAnnotations1 = append(rwAnnotations1, fmt.Sprintf("resistance %d", -step.Delta))
-					}
-				}
 				// REQ-RXN19: TriggerOnDamageTaken fires before damage is applied so reduce_damage can modify it.
 				if target.Kind == KindPlayer && dmg1 > 0 {
 					events = append(events, fireReaction(target.ID, reaction.TriggerOnDamageTaken, reaction.ReactionContext{
@@ -1328,8 +1336,9 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						DamagePending: &dmg1,
 					})...)
 				}
+				var absorbed1 Absorption
 				if dmg1 > 0 {
-					target.ApplyDamage(dmg1)
+					absorbed1 = target.ApplyDamage(dmg1)
 					targetUpdater(target.ID, target.CurrentHP)
 					if actor.Kind == KindPlayer && target.Kind == KindNPC {
 						cbt.RecordDamage(actor.ID, dmg1)
@@ -1352,24 +1361,29 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						}
 					}
 				}
-				condNotes1 := applyAttackConditions(cbt, actor, target, r1)
+				condNotes1 := applyAttackConditions(cbt, actor, target, r1, dmg1-absorbed1.Total())
 				strikeVerb1 := actor.AttackVerb
 				if strikeVerb1 == "" {
 					strikeVerb1 = "strikes"
 				}
 				narrative1 := attackNarrative(actor.Name, strikeVerb1, target.Name, r1.WeaponName, r1.Outcome, r1.AttackRoll, r1.AttackTotal, effectiveAC1, dmg1)
-				if len(rwAnnotations1) > 0 {
-					narrative1 += " (" + strings.Join(rwAnnotations1, "; ") + ")"
+				// MULT-14: surface target immunity, weakness, and resistance to the player.
+				if note := AdjustmentNote(dmgResult1.Breakdown); note != "" {
+					narrative1 += " " + note
 				}
 				for _, note := range condNotes1 {
 					narrative1 += " " + note
 				}
+				if note := absorbed1.Note(); note != "" {
+					narrative1 += " " + note
+				}
 				events = append(events, RoundEvent{
 					AttackResult:    &r1,
 					ActionType:      ActionStrike,
 					ActorID:         actor.ID,
 					ActorName:       actor.Name,
 					Narrative:       narrative1,
+					Damage:          dmg1 - absorbed1.Total(),
 					DamageBreakdown: FormatBreakdownInline(dmgResult1.Breakdown),
 					BreakdownSteps:  dmgResult1.Breakdown,
 				})
@@ -1389,7 +1403,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						ActionType: ActionStrike,
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
-						Narrative:  fmt.Sprintf("%s follows up but %s is already dead.", actor.Name, target.Name),
+						Narrative:  narrate("combat.follow_up_dead", i18n.Args{"actor": actor.Name, "target": target.Name}),
 					})
 					continue
 				}
@@ -1417,6 +1431,9 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 					}
 				}
 				effectiveAC2 := target.AC + target.InitiativeBonus + acBonus2
+				if strikeScreened {
+					effectiveAC2 += BackRowMeleeACBonus
+				}
 				r2.AttackTotal = hookAttackRoll(cbt, actor, target, r2.AttackTotal)
 				r2.Outcome = OutcomeFor(r2.AttackTotal, effectiveAC2)
 				// COVER-11/12: cover absorb-miss — second strike was a miss only because of cover.
@@ -1432,7 +1449,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 							ActorName:        actor.Name,
 							TargetID:         target.ID,
 							CoverEquipmentID: coverEquipID2,
-							Narrative:        fmt.Sprintf("%s's strike hits %s's cover!", actor.Name, target.Name),
+							Narrative:        narrate("combat.strike_hits_cover", i18n.Args{"actor": actor.Name, "target": target.Name}),
 						})
 						if destroyed2 {
 							events = append(events, RoundEvent{
@@ -1440,7 +1457,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 								ActorID:    actor.ID,
 								ActorName:  actor.Name,
 								TargetID:   target.ID,
-								Narrative:  fmt.Sprintf("%s's cover is destroyed!", target.Name),
+								Narrative:  narrate("combat.cover_destroyed", i18n.Args{"target": target.Name}),
 							})
 						}
 					}
@@ -1456,6 +1473,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				}
 				passiveFeatBonus2 := applyPassiveFeats(cbt, actor, target, passiveFeatGate2, src)
 				di2 := BuildDamageInput(BuildDamageOpts{
+					Combat:            cbt,
 					Actor:             actor,
 					Target:            target,
 					AttackResult:      r2,
@@ -1465,17 +1483,6 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				})
 				dmgResult2 := ResolveDamage(di2)
 				dmg2 := hookDamageRoll(cbt, actor, target, dmgResult2.Final)
-				var rwAnnotations2 []string
-				// MULT-14: derive narrative annotations for resistance/weakness so the
-				// "(...)" suffix on attack narratives still surfaces target defenses to the player.
-				for _, step := range dmgResult2.Breakdown {
-					switch step.Stage {
-					case StageWeakness:
-						rwAnnotations2 = append(rwAnnotations2, fmt.Sprintf("weakness +%d", step.Delta))
-					case StageResistance:
-						rwAnnotations2 = append(rwAnnotations2, fmt.Sprintf("resistance %d", -step.Delta))
-					}
-				}
 				// REQ-RXN19: TriggerOnDamageTaken fires before damage is applied so reduce_damage can modify it.
 				if target.Kind == KindPlayer && dmg2 > 0 {
 					events = append(events, fireReaction(target.ID, reaction.TriggerOnDamageTaken, reaction.ReactionContext{
@@ -1484,8 +1491,9 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						DamagePending: &dmg2,
 					})...)
 				}
+				var absorbed2 Absorption
 				if dmg2 > 0 {
-					target.ApplyDamage(dmg2)
+					absorbed2 = target.ApplyDamage(dmg2)
 					targetUpdater(target.ID, target.CurrentHP)
 					if actor.Kind == KindPlayer && target.Kind == KindNPC {
 						cbt.RecordDamage(actor.ID, dmg2)
@@ -1503,24 +1511,29 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						}
 					}
 				}
-				condNotes2 := applyAttackConditions(cbt, actor, target, r2)
+				condNotes2 := applyAttackConditions(cbt, actor, target, r2, dmg2-absorbed2.Total())
 				strikeVerb2 := actor.AttackVerb
 				if strikeVerb2 == "" {
 					strikeVerb2 = "strikes"
 				}
 				narrative2 := attackNarrative(actor.Name, strikeVerb2, target.Name, r2.WeaponName, r2.Outcome, r2.AttackRoll, r2.AttackTotal, effectiveAC2, dmg2)
-				if len(rwAnnotations2) > 0 {
-					narrative2 += " (" + strings.Join(rwAnnotations2, "; ") + ")"
+				// MULT-14: surface target immunity, weakness, and resistance to the player.
+				if note := AdjustmentNote(dmgResult2.Breakdown); note != "" {
+					narrative2 += " " + note
 				}
 				for _, note := range condNotes2 {
 					narrative2 += " " + note
 				}
+				if note := absorbed2.Note(); note != "" {
+					narrative2 += " " + note
+				}
 				events = append(events, RoundEvent{
 					AttackResult:    &r2,
 					ActionType:      ActionStrike,
 					ActorID:         actor.ID,
 					ActorName:       actor.Name,
 					Narrative:       narrative2,
+					Damage:          dmg2 - absorbed2.Total(),
 					DamageBreakdown: FormatBreakdownInline(dmgResult2.Breakdown),
 					BreakdownSteps:  dmgResult2.Breakdown,
 				})
@@ -1539,7 +1552,7 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 						ActionType: ActionAid,
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
-						Narrative:  fmt.Sprintf("%s tries to aid but %s is already down.", actor.Name, action.Target),
+						Narrative:  narrate("combat.aid_target_down", i18n.Args{"actor": actor.Name, "target": action.Target}),
 					})
 					continue
 				}
@@ -1557,15 +1570,15 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 				switch outcome {
 				case "critical_success":
 					applyConditionIfAllowed(cbt, target.ID, "aided_strong", 1, 1)
-					narrative = fmt.Sprintf("%s provides critical aid to %s (total %d)!", actor.Name, target.Name, total)
+					narrative = narrate("combat.aid_crit", i18n.Args{"actor": actor.Name, "target": target.Name, "total": total})
 				case "success":
 					applyConditionIfAllowed(cbt, target.ID, "aided", 1, 1)
-					narrative = fmt.Sprintf("%s aids %s (total %d).", actor.Name, target.Name, total)
+					narrative = narrate("combat.aid", i18n.Args{"actor": actor.Name, "target": target.Name, "total": total})
 				case "failure":
-					narrative = fmt.Sprintf("%s fails to aid %s (total %d).", actor.Name, target.Name, total)
+					narrative = narrate("combat.aid_failed", i18n.Args{"actor": actor.Name, "target": target.Name, "total": total})
 				case "critical_failure":
 					applyConditionIfAllowed(cbt, target.ID, "aided_penalty", 1, 1)
-					narrative = fmt.Sprintf("%s fumbles the aid attempt on %s (total %d)!", actor.Name, target.Name, total)
+					narrative = narrate("combat.aid_fumble", i18n.Args{"actor": actor.Name, "target": target.Name, "total": total})
 				}
 				events = append(events, RoundEvent{
 					ActionType: ActionAid,
@@ -1585,22 +1598,34 @@ func ResolveRound(cbt *Combat, src Source, targetUpdater func(id string, hp int)
 					AbilityID:  action.AbilityID,
 					TargetX:    action.TargetX,
 					TargetY:    action.TargetY,
-					Narrative:  fmt.Sprintf("%s uses %s.", actor.Name, action.AbilityID),
+					Narrative:  narrate("combat.use_ability", i18n.Args{"actor": actor.Name, "ability": action.AbilityID}),
+				})
+			case ActionUseAbility:
+				// Record the ability use; job ability effects are applied by the
+				// server after round resolution via abilityUseResolverFn.
+				events = append(events, RoundEvent{
+					ActionType: ActionUseAbility,
+					ActorID:    actor.ID,
+					ActorName:  actor.Name,
+					TargetID:   action.Target,
+					AbilityID:  action.AbilityID,
+					Narrative:  narrate("combat.use_ability", i18n.Args{"actor": actor.Name, "ability": action.AbilityID}),
 				})
 			}
 		}
 	}
 
+	events = append(events, cbt.TickRoomEffects(src, targetUpdater)...)
 	return events
 }
 
 // resolveReload handles ActionReload: calls on_reload Lua hook and restores magazine.
 func resolveReload(cbt *Combat, actor *Combatant, qa QueuedAction) RoundEvent {
-	narrative := actor.Name + " reloads."
+	narrative := narrate("combat.reload", i18n.Args{"actor": actor.Name})
 	if actor.Loadout != nil {
 		if eq := actor.Loadout.MainHand; eq != nil && eq.Magazine != nil {
 			eq.Magazine.Reload()
-			narrative = fmt.Sprintf("%s reloads %s.", actor.Name, eq.Def.Name)
+			narrative = narrate("combat.reload_weapon", i18n.Args{"actor": actor.Name, "weapon": eq.Def.Name})
 		}
 	}
 	if cbt.scriptMgr != nil {
@@ -1616,7 +1641,7 @@ func resolveFireBurst(cbt *Combat, actor *Combatant, qa QueuedAction, src Source
 	target := findCombatantByNameOrID(cbt, qa.Target)
 	if target == nil || target.IsDead() {
 		return []RoundEvent{{ActionType: ActionFireBurst, ActorID: actor.ID, ActorName: actor.Name,
-			Narrative: fmt.Sprintf("%s fires burst but target not found.", actor.Name)}}
+			Narrative: narrate("combat.burst_no_target", i18n.Args{"actor": actor.Name})}}
 	}
 	if coverDegrader == nil {
 		coverDegrader = func(roomID, equipID string) bool { return false }
@@ -1659,7 +1684,7 @@ func resolveFireBurst(cbt *Combat, actor *Combatant, qa QueuedAction, src Source
 					ActorName:        actor.Name,
 					TargetID:         target.ID,
 					CoverEquipmentID: coverEquipIDBurst,
-					Narrative:        fmt.Sprintf("%s's burst fire hits %s's cover!", actor.Name, target.Name),
+					Narrative:        narrate("combat.burst_hits_cover", i18n.Args{"actor": actor.Name, "target": target.Name}),
 				})
 				if destroyed {
 					events = append(events, RoundEvent{
@@ -1667,7 +1692,7 @@ func resolveFireBurst(cbt *Combat, actor *Combatant, qa QueuedAction, src Source
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
 						TargetID:   target.ID,
-						Narrative:  fmt.Sprintf("%s's cover is destroyed!", target.Name),
+						Narrative:  narrate("combat.cover_destroyed", i18n.Args{"target": target.Name}),
 					})
 				}
 			}
@@ -1678,6 +1703,7 @@ func resolveFireBurst(cbt *Combat, actor *Combatant, qa QueuedAction, src Source
 		}
 		// MULT-17: damage now flows through ResolveDamage.
 		di := BuildDamageInput(BuildDamageOpts{
+			Combat:       cbt,
 			Actor:        actor,
 			Target:       target,
 			AttackResult: result,
@@ -1722,7 +1748,8 @@ func resolveFireBurst(cbt *Combat, actor *Combatant, qa QueuedAction, src Source
 			ActionType:      ActionFireBurst,
 			ActorID:         actor.ID,
 			ActorName:       actor.Name,
-			Narrative:       buildNarrative(actor, target, result, dmg),
+			Narrative:       withNote(buildNarrative(actor, target, result, dmg), AdjustmentNote(dmgResult.Breakdown)),
+			Damage:          dmg,
 			DamageBreakdown: FormatBreakdownInline(dmgResult.Breakdown),
 			BreakdownSteps:  dmgResult.Breakdown,
 		})
@@ -1739,7 +1766,7 @@ func resolveFireAutomatic(cbt *Combat, actor *Combatant, qa QueuedAction, src So
 	enemies := livingEnemiesOf(cbt, actor)
 	if len(enemies) == 0 {
 		return []RoundEvent{{ActionType: ActionFireAutomatic, ActorID: actor.ID, ActorName: actor.Name,
-			Narrative: fmt.Sprintf("%s lays down suppressive fire.", actor.Name)}}
+			Narrative: narrate("combat.auto_fire", i18n.Args{"actor": actor.Name})}}
 	}
 	if coverDegrader == nil {
 		coverDegrader = func(roomID, equipID string) bool { return false }
@@ -1786,7 +1813,7 @@ func resolveFireAutomatic(cbt *Combat, actor *Combatant, qa QueuedAction, src So
 					ActorName:        actor.Name,
 					TargetID:         target.ID,
 					CoverEquipmentID: coverEquipIDAutomatic,
-					Narrative:        fmt.Sprintf("%s's automatic fire hits %s's cover!", actor.Name, target.Name),
+					Narrative:        narrate("combat.auto_fire_hits_cover", i18n.Args{"actor": actor.Name, "target": target.Name}),
 				})
 				if destroyed {
 					events = append(events, RoundEvent{
@@ -1794,7 +1821,7 @@ func resolveFireAutomatic(cbt *Combat, actor *Combatant, qa QueuedAction, src So
 						ActorID:    actor.ID,
 						ActorName:  actor.Name,
 						TargetID:   target.ID,
-						Narrative:  fmt.Sprintf("%s's cover is destroyed!", target.Name),
+						Narrative:  narrate("combat.cover_destroyed", i18n.Args{"target": target.Name}),
 					})
 				}
 			}
@@ -1805,6 +1832,7 @@ func resolveFireAutomatic(cbt *Combat, actor *Combatant, qa QueuedAction, src So
 		}
 		// MULT-17: damage now flows through ResolveDamage.
 		di := BuildDamageInput(BuildDamageOpts{
+			Combat:       cbt,
 			Actor:        actor,
 			Target:       target,
 			AttackResult: result,
@@ -1850,7 +1878,8 @@ func resolveFireAutomatic(cbt *Combat, actor *Combatant, qa QueuedAction, src So
 			ActionType:      ActionFireAutomatic,
 			ActorID:         actor.ID,
 			ActorName:       actor.Name,
-			Narrative:       buildNarrative(actor, target, result, dmg),
+			Narrative:       withNote(buildNarrative(actor, target, result, dmg), AdjustmentNote(dmgResult.Breakdown)),
+			Damage:          dmg,
 			DamageBreakdown: FormatBreakdownInline(dmgResult.Breakdown),
 			BreakdownSteps:  dmgResult.Breakdown,
 		})
@@ -1881,12 +1910,12 @@ func explosiveTargetsOf(cbt *Combat, actor *Combatant, grenade *inventory.Explos
 func resolveThrow(cbt *Combat, actor *Combatant, qa QueuedAction, src Source, fireReaction reactionDispatchFn) []RoundEvent {
 	if cbt.invRegistry == nil {
 		return []RoundEvent{{ActionType: ActionThrow, ActorID: actor.ID, ActorName: actor.Name,
-			Narrative: fmt.Sprintf("%s fumbles the throw.", actor.Name)}}
+			Narrative: narrate("combat.throw_fumble", i18n.Args{"actor": actor.Name})}}
 	}
 	grenade := cbt.invRegistry.Explosive(qa.ExplosiveID)
 	if grenade == nil {
 		return []RoundEvent{{ActionType: ActionThrow, ActorID: actor.ID, ActorName: actor.Name,
-			Narrative: fmt.Sprintf("%s reaches for an explosive but finds nothing.", actor.Name)}}
+			Narrative: narrate("combat.throw_no_explosive", i18n.Args{"actor": actor.Name})}}
 	}
 	if cbt.scriptMgr != nil {
 		_, _ = cbt.scriptMgr.CallHook(cbt.zoneID, "on_explosive_throw",
@@ -1913,6 +1942,8 @@ func resolveThrow(cbt *Combat, actor *Combatant, qa QueuedAction, src Source, fi
 				})...)
 			}
 		}
+		adjusted := AdjustDamage(cbt, target, grenade.DamageType, r.BaseDamage, "explosive:"+grenade.ID)
+		r.BaseDamage = adjusted.Final
 		if r.BaseDamage > 0 {
 			target.ApplyDamage(r.BaseDamage)
 			if actor.Kind == KindPlayer && target.Kind == KindNPC {
@@ -1923,13 +1954,30 @@ func resolveThrow(cbt *Combat, actor *Combatant, qa QueuedAction, src Source, fi
 			ActionType: ActionThrow,
 			ActorID:    actor.ID,
 			ActorName:  actor.Name,
-			Narrative: fmt.Sprintf("%s throws %s at %s for %d damage (hustle save: %s).",
-				actor.Name, grenade.Name, target.Name, r.BaseDamage, r.SaveResult),
+			Narrative: withNote(narrate("combat.throw_hit", i18n.Args{
+				"actor": actor.Name, "explosive": grenade.Name, "target": target.Name, "damage": r.BaseDamage, "save": r.SaveResult}), AdjustmentNote(adjusted.Breakdown)),
 		})
 	}
+	events = append(events, knockbackEvents(cbt, actor, grenade.KnockbackFt, targets, results, src)...)
 	if len(events) == 0 {
 		events = append(events, RoundEvent{ActionType: ActionThrow, ActorID: actor.ID, ActorName: actor.Name,
-			Narrative: fmt.Sprintf("%s throws %s but no targets are in range.", actor.Name, grenade.Name)})
+			Narrative: narrate("combat.throw_no_targets", i18n.Args{"actor": actor.Name, "explosive": grenade.Name})})
+	}
+	if l := grenade.Lingering; l != nil {
+		dt := l.DamageType
+		if dt == "" {
+			dt = grenade.DamageType
+		}
+		cbt.AddRoomEffect(RoomEffect{
+			ID:              grenade.ID,
+			Name:            l.Name,
+			DamageDice:      l.DamageDice,
+			DamageType:      dt,
+			RoundsRemaining: l.Rounds,
+			SourceID:        actor.ID,
+		})
+		events = append(events, RoundEvent{ActionType: ActionThrow, ActorID: actor.ID, ActorName: actor.Name,
+			Narrative: narrate("combat.lingering_spreads", i18n.Args{"effect": l.Name, "rounds": l.Rounds})})
 	}
 	return events
 }
@@ -1957,7 +2005,7 @@ func livingEnemiesOf(cbt *Combat, actor *Combatant) []*Combatant {
 }
 
 // weaponModifierDamageBonus returns the flat damage adjustment for the actor's
-// equipped main-hand weapon modifier (REQ-EM-23):
+// equipped main-hand weapon modifier (REQ-EM-23), plus its affixes' damage bonus:
 //
 //   - "tuned"    → +1
 //   - "defective" → -1
@@ -1965,20 +2013,21 @@ func livingEnemiesOf(cbt *Combat, actor *Combatant) []*Combatant {
 //   - ""         → 0
 //
 // Precondition: actor may have a nil Loadout or nil MainHand.
-// Postcondition: Returns 0 when no loadout or no modifier is set.
+// Postcondition: Returns 0 when no loadout is set, or no modifier or affix applies.
 func weaponModifierDamageBonus(actor *Combatant) int {
 	if actor.Loadout == nil || actor.Loadout.MainHand == nil {
 		return 0
 	}
+	bonus := actor.WeaponAffixes.DamageBonus
 	switch actor.Loadout.MainHand.Modifier {
 	case "tuned":
-		return 1
+		bonus++
 	case "defective":
-		return -1
+		bonus--
 	case "cursed":
-		return -2
+		bonus -= 2
 	}
-	return 0
+	return bonus
 }
 
 // primaryFirearm returns the primary slot weapon if it is a firearm matching weaponID.
@@ -1998,23 +2047,24 @@ func primaryFirearm(actor *Combatant, weaponID string) *inventory.WeaponDef {
 
 // buildNarrative returns a human-readable attack narrative string.
 func buildNarrative(actor, target *Combatant, result AttackResult, dmg int) string {
+	args := i18n.Args{"actor": actor.Name, "target": target.Name, "damage": dmg}
 	switch result.Outcome {
 	case CritSuccess:
 		if dmg > 0 {
-			return fmt.Sprintf("*** CRITICAL HIT! *** %s hits %s for %d damage!", actor.Name, target.Name, dmg)
+			return narrate("combat.shot_crit_damage", args)
 		}
-		return fmt.Sprintf("*** CRITICAL HIT! *** %s hits %s!", actor.Name, target.Name)
+		return narrate("combat.shot_crit", args)
 	case Success:
 		if dmg > 0 {
-			return fmt.Sprintf("%s hits %s for %d damage.", actor.Name, target.Name, dmg)
+			return narrate("combat.shot_hit_damage", args)
 		}
-		return fmt.Sprintf("%s hits %s.", actor.Name, target.Name)
+		return narrate("combat.shot_hit", args)
 	case Failure:
-		return fmt.Sprintf("%s misses %s.", actor.Name, target.Name)
+		return narrate("combat.shot_miss", args)
 	case CritFailure:
-		return fmt.Sprintf("*** CRITICAL MISS! *** %s fumbles against %s!", actor.Name, target.Name)
+		return narrate("combat.shot_fumble", args)
 	default:
-		return fmt.Sprintf("%s attacks %s.", actor.Name, target.Name)
+		return narrate("combat.shot_attack", args)
 	}
 }
 
@@ -2023,7 +2073,7 @@ func buildNarrative(actor, target *Combatant, result AttackResult, dmg int) stri
 // Returns zero or more RoundEvents (damage narrative, condition narrative, etc.).
 //
 // Precondition: tc.Type == TerrainHazardous; tc.Hazard must not be nil.
-func applyCellHazard(_ *Combat, victim *Combatant, tc TerrainCell, trigger string, src Source) []RoundEvent {
+func applyCellHazard(cbt *Combat, victim *Combatant, tc TerrainCell, trigger string, src Source) []RoundEvent {
 	if tc.Hazard == nil || tc.Hazard.Def == nil {
 		return nil
 	}
@@ -2035,21 +2085,14 @@ func applyCellHazard(_ *Combat, victim *Combatant, tc TerrainCell, trigger strin
 	if def.DamageExpr != "" {
 		rollResult, err := dice.RollExpr(def.DamageExpr, src)
 		if err == nil && rollResult.Total() > 0 {
-			in := DamageInput{
-				Additives: []DamageAdditive{
-					{Label: def.ID, Value: rollResult.Total(), Source: "hazard:" + def.ID},
-				},
-				DamageType: def.DamageType,
-				Weakness:   victim.WeaknessFor(def.DamageType),
-				Resistance: victim.ResistanceFor(def.DamageType),
-			}
-			result := ResolveDamage(in)
+			result := AdjustDamage(cbt, victim, def.DamageType, rollResult.Total(), "hazard:"+def.ID)
 			victim.ApplyDamage(result.Final)
-			narrative := fmt.Sprintf("%s is hit by %s! (%s → %d damage)",
-				victim.Name, def.ID, def.DamageExpr, result.Final)
+			narrative := narrate("combat.hazard_damage", i18n.Args{
+				"target": victim.Name, "hazard": def.ID, "dice": def.DamageExpr, "damage": result.Final})
 			if def.Message != "" {
-				narrative = fmt.Sprintf("%s — %s (%s → %d damage)", def.Message, victim.Name, def.DamageExpr, result.Final)
+				narrative = narrate("combat.hazard_damage_message", i18n.Args{"message": def.Message, "target": victim.Name, "dice": def.DamageExpr, "damage": result.Final})
 			}
+			narrative = withNote(narrative, AdjustmentNote(result.Breakdown))
 			events = append(events, RoundEvent{
 				ActionType: ActionHazardDamage,
 				ActorID:    victim.ID,
@@ -108,6 +108,34 @@ func TestResolveRound_DamageRollHook_OverridesDamage(t *testing.T) {
 	}
 }
 
+// TestResolveRound_DamageMultiplier_ScalesDamage verifies that a zone DamageMultiplier
+// of 2 doubles the damage the same forced hit deals.
+// Postcondition: Bob loses twice as much HP with the multiplier as without it.
+func TestResolveRound_DamageMultiplier_ScalesDamage(t *testing.T) {
+	damageTaken := func(multiplier float64) int {
+		mgr := newScriptMgr(t, `
+			function on_attack_roll(attacker_uid, target_uid, roll_total, ac)
+				return 35
+			end
+		`)
+		cbt := startHookCombat(t, mgr)
+		cbt.DamageMultiplier = multiplier
+		_ = cbt.StartRoundWithSrc(3, &fixedSrc{val: 5})
+		require.NoError(t, cbt.QueueAction("p1", combat.QueuedAction{Type: combat.ActionAttack, Target: "Bob"}))
+		require.NoError(t, cbt.QueueAction("n1", combat.QueuedAction{Type: combat.ActionPass}))
+		combat.ResolveRound(cbt, &fixedSrc{val: 5}, nil, nil, 0)
+		for _, c := range cbt.Combatants {
+			if c.ID == "n1" {
+				return c.MaxHP - c.CurrentHP
+			}
+		}
+		return 0
+	}
+	base := damageTaken(0)
+	require.Positive(t, base)
+	assert.Equal(t, 2*base, damageTaken(2))
+}
+
 // TestResolveRound_ConditionApplyHook_CancelsCondition verifies that returning false from
 // on_condition_apply prevents the flat_footed condition from being applied on a CritSuccess.
 // A CritSuccess requires roll_total >= AC + 10; with hook override 999 and AC 30, outcome is CritSuccess.
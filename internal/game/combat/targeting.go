@@ -153,7 +153,8 @@ func fail(code TargetingError, format string, args ...any) TargetingResult {
 // Players are always allied with other players. NPCs with matching FactionID
 // are allied; NPCs with empty FactionID are treated as a faction of one
 // (allied only with themselves). Players and NPCs are never allied (the
-// faction-based PvP/recruitment system is out of scope here).
+// faction-based PvP/recruitment system is out of scope here), except that an
+// NPC fighting for a player is allied with every combatant on the player side.
 func areAllies(a, b *Combatant) bool {
 	if a == nil || b == nil {
 		return false
@@ -161,9 +162,12 @@ func areAllies(a, b *Combatant) bool {
 	if a.ID == b.ID {
 		return true
 	}
-	if a.IsPlayer() && b.IsPlayer() {
+	if a.OnPlayerSide() && b.OnPlayerSide() {
 		return true
 	}
+	if a.OnPlayerSide() != b.OnPlayerSide() {
+		return false
+	}
 	if !a.IsPlayer() && !b.IsPlayer() {
 		if a.FactionID == "" || b.FactionID == "" {
 			return false
@@ -159,3 +159,21 @@ func TestValidateSingleTarget_NilGuards(t *testing.T) {
 		t.Errorf("expected failure with nil actor")
 	}
 }
+
+// TestValidateSingleTarget_AllyOfPlayer verifies that an NPC fighting for a
+// player is the players' ally and the hostile NPCs' enemy.
+func TestValidateSingleTarget_AllyOfPlayer(t *testing.T) {
+	cbt := newTargetingCombat(t)
+	merc := &combat.Combatant{ID: "merc", Kind: combat.KindNPC, Name: "Merc", MaxHP: 10, CurrentHP: 10, GridX: 1, GridY: 0, FactionID: "monsters", AllyOf: "p1"}
+	cbt.Combatants = append(cbt.Combatants, merc)
+
+	if got := combat.ValidateSingleTarget(cbt, cbt.GetCombatant("p2"), "merc", combat.TargetSingleAlly, 0, false); !got.OK() {
+		t.Errorf("player targeting hireling as ally: %s (%q)", got.Err, got.Detail)
+	}
+	if got := combat.ValidateSingleTarget(cbt, merc, "n1", combat.TargetSingleEnemy, 0, false); !got.OK() {
+		t.Errorf("hireling targeting hostile NPC as enemy: %s (%q)", got.Err, got.Detail)
+	}
+	if got := combat.ValidateSingleTarget(cbt, cbt.GetCombatant("n1"), "merc", combat.TargetSingleAlly, 0, false); got.Err != combat.ErrWrongCategory {
+		t.Errorf("same-faction hostile NPC targeting hireling as ally = %s; want %s", got.Err, combat.ErrWrongCategory)
+	}
+}
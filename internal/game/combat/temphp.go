@@ -0,0 +1,58 @@
+package combat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Absorption records how much of one damage application was soaked up by a
+// combatant's shield block and temporary hit points before reaching CurrentHP.
+type Absorption struct {
+	Shield int
+	TempHP int
+}
+
+// Total returns the combined damage absorbed by both pools.
+func (a Absorption) Total() int {
+	return a.Shield + a.TempHP
+}
+
+// Note returns a parenthesised narrative suffix describing the absorption,
+// or "" when nothing was absorbed.
+//
+// Postcondition: Returns e.g. "(shield blocks 2; temp HP absorbs 3)".
+func (a Absorption) Note() string {
+	var parts []string
+	if a.Shield > 0 {
+		parts = append(parts, fmt.Sprintf("shield blocks %d", a.Shield))
+	}
+	if a.TempHP > 0 {
+		parts = append(parts, fmt.Sprintf("temp HP absorbs %d", a.TempHP))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(parts, "; ") + ")"
+}
+
+// GrantTempHP raises the combatant's temporary hit points to amount when
+// amount exceeds the current pool. Temporary HP never stacks.
+//
+// Precondition: amount must be >= 0.
+// Postcondition: TempHP == max(previous TempHP, amount); returns true iff the pool grew.
+func (c *Combatant) GrantTempHP(amount int) bool {
+	if amount <= c.TempHP {
+		return false
+	}
+	c.TempHP = amount
+	return true
+}
+
+// RaiseShield sets the combatant's per-round shield absorption to block
+// unless a larger block is already in place.
+//
+// Precondition: block must be >= 0.
+// Postcondition: ShieldBlock == max(previous ShieldBlock, block).
+func (c *Combatant) RaiseShield(block int) {
+	c.ShieldBlock = max(c.ShieldBlock, block)
+}
@@ -0,0 +1,97 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/condition"
+)
+
+func TestApplyDamage_ShieldThenTempHPThenHP(t *testing.T) {
+	c := &combat.Combatant{MaxHP: 20, CurrentHP: 20, TempHP: 3, ShieldBlock: 2}
+	a := c.ApplyDamage(7)
+	assert.Equal(t, combat.Absorption{Shield: 2, TempHP: 3}, a)
+	assert.Equal(t, 0, c.ShieldBlock)
+	assert.Equal(t, 0, c.TempHP)
+	assert.Equal(t, 18, c.CurrentHP)
+}
+
+func TestApplyDamage_FullyAbsorbed_LeavesHP(t *testing.T) {
+	c := &combat.Combatant{MaxHP: 20, CurrentHP: 20, TempHP: 10}
+	a := c.ApplyDamage(4)
+	assert.Equal(t, 4, a.TempHP)
+	assert.Equal(t, 6, c.TempHP)
+	assert.Equal(t, 20, c.CurrentHP)
+}
+
+func TestGrantTempHP_DoesNotStack(t *testing.T) {
+	c := &combat.Combatant{TempHP: 5}
+	assert.False(t, c.GrantTempHP(3))
+	assert.Equal(t, 5, c.TempHP)
+	assert.True(t, c.GrantTempHP(8))
+	assert.Equal(t, 8, c.TempHP)
+}
+
+func TestAbsorption_Note(t *testing.T) {
+	assert.Equal(t, "", combat.Absorption{}.Note())
+	assert.Equal(t, "(shield blocks 2)", combat.Absorption{Shield: 2}.Note())
+	assert.Equal(t, "(shield blocks 2; temp HP absorbs 3)", combat.Absorption{Shield: 2, TempHP: 3}.Note())
+}
+
+func TestApplyCondition_GrantsTempHP(t *testing.T) {
+	reg := condition.NewRegistry()
+	reg.Register(&condition.ConditionDef{ID: "rage", Name: "Rage", DurationType: "rounds", TempHP: 3})
+	combatants := []*combat.Combatant{
+		{ID: "p1", Kind: combat.KindPlayer, Name: "Alice", MaxHP: 20, CurrentHP: 20, AC: 14, Level: 1},
+		{ID: "n1", Kind: combat.KindNPC, Name: "Ganger", MaxHP: 12, CurrentHP: 12, AC: 12, Level: 1},
+	}
+	cbt, err := combat.NewEngine().StartCombat("room1", combatants, reg, nil, "")
+	require.NoError(t, err)
+
+	require.NoError(t, cbt.ApplyCondition("p1", "rage", 1, 2))
+	assert.Equal(t, 3, cbt.GetCombatant("p1").TempHP)
+}
+
+func TestStartRound_ClearsShieldBlock(t *testing.T) {
+	cbt := newNarrativeTestCombat(t)
+	p1 := cbt.GetCombatant("p1")
+	p1.RaiseShield(2)
+	p1.TempHP = 4
+	_ = cbt.StartRound(3)
+	assert.Equal(t, 0, p1.ShieldBlock)
+	assert.Equal(t, 4, p1.TempHP, "temporary HP persists across rounds")
+}
+
+func TestAttackNarrative_ShowsAbsorption(t *testing.T) {
+	cbt := newNarrativeTestCombat(t)
+	n1 := cbt.GetCombatant("n1")
+	n1.TempHP = 100
+	narrative := resolveAttackNarrative(t, cbt, fixedSrc{val: 13})
+	assert.Contains(t, narrative, "temp HP absorbs")
+	assert.Equal(t, 30, n1.CurrentHP)
+}
+
+func TestProperty_ApplyDamage_ConservesDamage(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		hp := rapid.IntRange(1, 100).Draw(rt, "hp")
+		c := &combat.Combatant{
+			MaxHP:       hp,
+			CurrentHP:   hp,
+			TempHP:      rapid.IntRange(0, 30).Draw(rt, "temp"),
+			ShieldBlock: rapid.IntRange(0, 10).Draw(rt, "shield"),
+		}
+		dmg := rapid.IntRange(0, 200).Draw(rt, "dmg")
+		a := c.ApplyDamage(dmg)
+		lost := hp - c.CurrentHP
+		assert.GreaterOrEqual(rt, c.CurrentHP, 0)
+		assert.GreaterOrEqual(rt, c.TempHP, 0)
+		assert.GreaterOrEqual(rt, c.ShieldBlock, 0)
+		if c.CurrentHP > 0 {
+			assert.Equal(rt, dmg, a.Total()+lost)
+		}
+	})
+}
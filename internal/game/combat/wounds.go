@@ -0,0 +1,42 @@
+package combat
+
+import "fmt"
+
+// WoundDescriptor describes how hurt a creature with current of maxHP hit points
+// looks, without revealing the numbers.
+//
+// Postcondition: Returns a non-empty string; "dead" when current <= 0.
+func WoundDescriptor(current, maxHP int) string {
+	if current <= 0 {
+		return "dead"
+	}
+	if maxHP <= 0 {
+		return "unharmed"
+	}
+	pct := float64(current) / float64(maxHP)
+	switch {
+	case pct >= 1.0:
+		return "unharmed"
+	case pct >= 0.85:
+		return "barely scratched"
+	case pct >= 0.60:
+		return "lightly wounded"
+	case pct >= 0.40:
+		return "moderately wounded"
+	case pct >= 0.20:
+		return "bloodied"
+	default:
+		return "near death"
+	}
+}
+
+// HealthLabel returns WoundDescriptor(current, maxHP), or the hit points as
+// "current/maxHP HP" when numeric is set, for players who asked for accessible output.
+//
+// Postcondition: Returns a non-empty string.
+func HealthLabel(current, maxHP int, numeric bool) string {
+	if numeric {
+		return fmt.Sprintf("%d/%d HP", max(current, 0), maxHP)
+	}
+	return WoundDescriptor(current, maxHP)
+}
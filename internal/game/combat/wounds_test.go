@@ -0,0 +1,53 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+func TestWoundDescriptor(t *testing.T) {
+	for _, tc := range []struct {
+		hp   int
+		want string
+	}{
+		{100, "unharmed"},
+		{90, "barely scratched"},
+		{70, "lightly wounded"},
+		{50, "moderately wounded"},
+		{25, "bloodied"},
+		{10, "near death"},
+		{0, "dead"},
+		{-3, "dead"},
+	} {
+		assert.Equal(t, tc.want, combat.WoundDescriptor(tc.hp, 100), "hp=%d", tc.hp)
+	}
+	assert.Equal(t, "unharmed", combat.WoundDescriptor(5, 0))
+}
+
+func TestHealthLabel_NumericForAccessibleMode(t *testing.T) {
+	assert.Equal(t, "bloodied", combat.HealthLabel(6, 20, false))
+	assert.Equal(t, "6/20 HP", combat.HealthLabel(6, 20, true))
+	assert.Equal(t, "0/20 HP", combat.HealthLabel(-4, 20, true))
+}
+
+// TestProperty_WoundDescriptorWorsensWithDamage verifies losing hit points never
+// makes a creature look healthier.
+func TestProperty_WoundDescriptorWorsensWithDamage(t *testing.T) {
+	severity := map[string]int{
+		"unharmed": 0, "barely scratched": 1, "lightly wounded": 2,
+		"moderately wounded": 3, "bloodied": 4, "near death": 5, "dead": 6,
+	}
+	rapid.Check(t, func(rt *rapid.T) {
+		maxHP := rapid.IntRange(1, 500).Draw(rt, "maxHP")
+		hp := rapid.IntRange(-20, maxHP).Draw(rt, "hp")
+		lower := hp - rapid.IntRange(0, 50).Draw(rt, "damage")
+		before, after := combat.WoundDescriptor(hp, maxHP), combat.WoundDescriptor(lower, maxHP)
+		if severity[after] < severity[before] {
+			rt.Fatalf("hp %d->%d of %d: %q -> %q", hp, lower, maxHP, before, after)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package command
+
+import "strings"
+
+// HandleAbility parses the ability command. The first word names the ability
+// and the rest, if any, names the target; the server rejoins them when an
+// ability's name has several words.
+//
+// Precondition: args are the words following "ability".
+// Postcondition: Returns the ability and target named; both are empty when
+// args is empty, which asks for the list of abilities.
+func HandleAbility(args []string) (ability, target string) {
+	if len(args) == 0 {
+		return "", ""
+	}
+	return args[0], strings.Join(args[1:], " ")
+}
@@ -0,0 +1,24 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleAbility(t *testing.T) {
+	cases := []struct {
+		args            []string
+		ability, target string
+	}{
+		{nil, "", ""},
+		{[]string{"brace"}, "brace", ""},
+		{[]string{"haymaker", "Scav", "Boss"}, "haymaker", "Scav Boss"},
+		{[]string{"second", "wind"}, "second", "wind"},
+	}
+	for _, tc := range cases {
+		ability, target := HandleAbility(tc.args)
+		assert.Equal(t, tc.ability, ability, tc.args)
+		assert.Equal(t, tc.target, target, tc.args)
+	}
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// answerUsage is the canonical usage string for the answer command.
+const answerUsage = "usage: answer <number|option>"
+
+// HandleAnswer parses the answer command.
+//
+// Precondition: rawArgs is the text following "answer".
+// Postcondition: Returns the trimmed answer, or an error carrying the usage
+// string when it is empty.
+func HandleAnswer(rawArgs string) (string, error) {
+	answer := strings.TrimSpace(rawArgs)
+	if answer == "" {
+		return "", fmt.Errorf("%s", answerUsage)
+	}
+	return answer, nil
+}
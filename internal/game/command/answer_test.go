@@ -0,0 +1,17 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleAnswer(t *testing.T) {
+	answer, err := HandleAnswer("  a candle  ")
+	require.NoError(t, err)
+	assert.Equal(t, "a candle", answer)
+
+	_, err = HandleAnswer("   ")
+	assert.ErrorContains(t, err, "usage: answer")
+}
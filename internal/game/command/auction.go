@@ -0,0 +1,95 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// auctionUsage is the canonical usage string for the auction command.
+const auctionUsage = "usage: auction [browse [filter]|list [qty] <item> <price> [hours]|buy <id>|cancel <id>|mine|collect]"
+
+// AuctionRequest is the parsed form of the auction command.
+type AuctionRequest struct {
+	// Action is one of browse, list, buy, cancel, mine, or collect.
+	Action string
+	// Item is the item to list, or the browse filter.
+	Item string
+	// Quantity is how many of Item to list; 1 when not given.
+	Quantity int
+	// Price is the buyout for the whole lot.
+	Price int
+	// Hours is how long the listing runs; 0 means the house default.
+	Hours int
+	// ListingID names the listing to buy or cancel.
+	ListingID int64
+}
+
+// HandleAuction parses the auction command.
+//
+// Precondition: args are the words following "auction".
+// Postcondition: Returns a non-nil request, or a non-nil error for unknown
+// actions, missing arguments, or non-positive numbers. A bare "auction" browses.
+func HandleAuction(args []string) (*AuctionRequest, error) {
+	if len(args) == 0 {
+		return &AuctionRequest{Action: "browse"}, nil
+	}
+	action := strings.ToLower(args[0])
+	rest := args[1:]
+	switch action {
+	case "browse":
+		return &AuctionRequest{Action: action, Item: strings.Join(rest, " ")}, nil
+	case "mine", "collect":
+		if len(rest) != 0 {
+			return nil, fmt.Errorf(auctionUsage)
+		}
+		return &AuctionRequest{Action: action}, nil
+	case "buy", "cancel":
+		if len(rest) != 1 {
+			return nil, fmt.Errorf("usage: auction %s <id>", action)
+		}
+		id, err := strconv.ParseInt(strings.TrimPrefix(rest[0], "#"), 10, 64)
+		if err != nil || id < 1 {
+			return nil, fmt.Errorf("listing id must be a positive number")
+		}
+		return &AuctionRequest{Action: action, ListingID: id}, nil
+	case "list":
+		return parseAuctionListing(rest)
+	}
+	return nil, fmt.Errorf(auctionUsage)
+}
+
+// parseAuctionListing parses "[qty] <item> <price> [hours]". Trailing numbers
+// are the price and optional hours; a leading number is the quantity when item
+// words follow it.
+func parseAuctionListing(words []string) (*AuctionRequest, error) {
+	const usage = "usage: auction list [qty] <item> <price> [hours]"
+	if len(words) < 2 {
+		return nil, fmt.Errorf(usage)
+	}
+	req := &AuctionRequest{Action: "list", Quantity: 1}
+	last, err := strconv.Atoi(words[len(words)-1])
+	if err != nil {
+		return nil, fmt.Errorf(usage)
+	}
+	words = words[:len(words)-1]
+	req.Price = last
+	hoursGiven := false
+	if len(words) >= 2 {
+		if n, convErr := strconv.Atoi(words[len(words)-1]); convErr == nil {
+			req.Price, req.Hours, hoursGiven = n, last, true
+			words = words[:len(words)-1]
+		}
+	}
+	if len(words) >= 2 {
+		if n, convErr := strconv.Atoi(words[0]); convErr == nil {
+			req.Quantity = n
+			words = words[1:]
+		}
+	}
+	if req.Price < 1 || req.Quantity < 1 || (hoursGiven && req.Hours < 1) {
+		return nil, fmt.Errorf("price, quantity, and hours must be positive numbers")
+	}
+	req.Item = strings.Join(words, " ")
+	return req, nil
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleAuction(t *testing.T) {
+	cases := []struct {
+		args []string
+		want AuctionRequest
+	}{
+		{nil, AuctionRequest{Action: "browse"}},
+		{[]string{"browse", "stim", "pack"}, AuctionRequest{Action: "browse", Item: "stim pack"}},
+		{[]string{"MINE"}, AuctionRequest{Action: "mine"}},
+		{[]string{"collect"}, AuctionRequest{Action: "collect"}},
+		{[]string{"buy", "12"}, AuctionRequest{Action: "buy", ListingID: 12}},
+		{[]string{"cancel", "#3"}, AuctionRequest{Action: "cancel", ListingID: 3}},
+		{[]string{"list", "stim", "pack", "100"}, AuctionRequest{Action: "list", Item: "stim pack", Quantity: 1, Price: 100}},
+		{[]string{"list", "stim", "pack", "100", "48"}, AuctionRequest{Action: "list", Item: "stim pack", Quantity: 1, Price: 100, Hours: 48}},
+		{[]string{"list", "5", "stim", "pack", "100"}, AuctionRequest{Action: "list", Item: "stim pack", Quantity: 5, Price: 100}},
+		{[]string{"list", "5", "stim", "100", "12"}, AuctionRequest{Action: "list", Item: "stim", Quantity: 5, Price: 100, Hours: 12}},
+	}
+	for _, c := range cases {
+		req, err := HandleAuction(c.args)
+		require.NoError(t, err, "%v", c.args)
+		assert.Equal(t, c.want, *req, "%v", c.args)
+	}
+
+	for _, args := range [][]string{
+		{"sell"}, {"mine", "now"}, {"buy"}, {"buy", "x"}, {"buy", "0"}, {"cancel", "1", "2"},
+		{"list"}, {"list", "stim"}, {"list", "stim", "pack"}, {"list", "stim", "0"}, {"list", "stim", "100", "0"},
+		{"list", "0", "stim", "100"},
+	} {
+		_, err := HandleAuction(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+func TestProperty_HandleAuction_ListPrice(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		price := rapid.IntRange(1, 100000).Draw(rt, "price")
+		hours := rapid.IntRange(1, 72).Draw(rt, "hours")
+		req, err := HandleAuction([]string{"list", "scrap", "armor", fmt.Sprint(price), fmt.Sprint(hours)})
+		if err != nil {
+			rt.Fatalf("HandleAuction: %v", err)
+		}
+		if req.Item != "scrap armor" || req.Price != price || req.Hours != hours || req.Quantity != 1 {
+			rt.Fatalf("HandleAuction parsed %+v", *req)
+		}
+	})
+}
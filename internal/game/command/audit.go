@@ -0,0 +1,37 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// auditUsage is the canonical usage string for the audit command.
+const auditUsage = "usage: audit [player] [count]"
+
+// HandleAudit parses the admin audit log query command.
+//
+// Precondition: args are the words following "audit".
+// Postcondition: Returns the optional actor/target name filter and the entry
+// count (0 selects the server default); returns a non-nil error when more
+// than two arguments are given or the count is not a positive integer.
+func HandleAudit(args []string) (string, int, error) {
+	switch len(args) {
+	case 0:
+		return "", 0, nil
+	case 1:
+		if n, err := strconv.Atoi(args[0]); err == nil {
+			if n <= 0 {
+				return "", 0, fmt.Errorf(auditUsage)
+			}
+			return "", n, nil
+		}
+		return args[0], 0, nil
+	case 2:
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n <= 0 {
+			return "", 0, fmt.Errorf(auditUsage)
+		}
+		return args[0], n, nil
+	}
+	return "", 0, fmt.Errorf(auditUsage)
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleAudit(t *testing.T) {
+	cases := []struct {
+		args    []string
+		subject string
+		limit   int
+	}{
+		{nil, "", 0},
+		{[]string{"50"}, "", 50},
+		{[]string{"Kira"}, "Kira", 0},
+		{[]string{"Kira", "5"}, "Kira", 5},
+	}
+	for _, tc := range cases {
+		subject, limit, err := HandleAudit(tc.args)
+		require.NoError(t, err, "%v", tc.args)
+		assert.Equal(t, tc.subject, subject, "%v", tc.args)
+		assert.Equal(t, tc.limit, limit, "%v", tc.args)
+	}
+}
+
+func TestHandleAudit_Invalid(t *testing.T) {
+	for _, args := range [][]string{{"0"}, {"Kira", "x"}, {"Kira", "-1"}, {"a", "b", "c"}} {
+		_, _, err := HandleAudit(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"errors"
+	"sort"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// Minimum account roles for restricted command categories.
+const (
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// RequiredRole returns the minimum account role needed to use cmd.
+//
+// Postcondition: Returns "" for commands every player may use.
+func (cmd *Command) RequiredRole() string {
+	switch cmd.Category {
+	case CategoryAdmin:
+		return RoleAdmin
+	case CategoryEditor:
+		return RoleEditor
+	default:
+		return ""
+	}
+}
+
+// CatalogProto converts every command in r to its wire form.
+//
+// Postcondition: Returns the commands ordered by name.
+func (r *Registry) CatalogProto() []*gamev1.CommandInfo {
+	cmds := r.Commands()
+	sort.Slice(cmds, func(i, j int) bool { return cmds[i].Name < cmds[j].Name })
+	out := make([]*gamev1.CommandInfo, 0, len(cmds))
+	for _, cmd := range cmds {
+		out = append(out, &gamev1.CommandInfo{
+			Name:         cmd.Name,
+			Aliases:      append([]string(nil), cmd.Aliases...),
+			Help:         cmd.Help,
+			Category:     cmd.Category,
+			Handler:      cmd.Handler,
+			RequiredRole: cmd.RequiredRole(),
+		})
+	}
+	return out
+}
+
+// RegistryFromProto builds a Registry from a catalog returned by the server.
+//
+// Precondition: No two entries may share a name or alias.
+// Postcondition: Returns a Registry, or an error for an empty catalog or on
+// name/alias collisions.
+func RegistryFromProto(infos []*gamev1.CommandInfo) (*Registry, error) {
+	if len(infos) == 0 {
+		return nil, errors.New("empty command catalog")
+	}
+	cmds := make([]Command, 0, len(infos))
+	for _, info := range infos {
+		cmds = append(cmds, Command{
+			Name:     info.GetName(),
+			Aliases:  append([]string(nil), info.GetAliases()...),
+			Help:     info.GetHelp(),
+			Category: info.GetCategory(),
+			Handler:  info.GetHandler(),
+		})
+	}
+	return NewRegistry(cmds)
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestRequiredRole(t *testing.T) {
+	assert.Equal(t, RoleAdmin, (&Command{Category: CategoryAdmin}).RequiredRole())
+	assert.Equal(t, RoleEditor, (&Command{Category: CategoryEditor}).RequiredRole())
+	assert.Empty(t, (&Command{Category: CategoryMovement}).RequiredRole())
+}
+
+func TestCatalogProto_SortedWithRoles(t *testing.T) {
+	infos := DefaultRegistry().CatalogProto()
+	require.Len(t, infos, len(BuiltinCommands()))
+	assert.True(t, sort.SliceIsSorted(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name }))
+	for _, info := range infos {
+		if info.GetName() == "siteban" {
+			assert.Equal(t, RoleAdmin, info.GetRequiredRole())
+			assert.Equal(t, HandlerSiteBan, info.GetHandler())
+		}
+		if info.GetName() == "north" {
+			assert.Empty(t, info.GetRequiredRole())
+			assert.Equal(t, []string{"n"}, info.GetAliases())
+		}
+	}
+}
+
+func TestRegistryFromProto_RoundTrip(t *testing.T) {
+	r, err := RegistryFromProto(DefaultRegistry().CatalogProto())
+	require.NoError(t, err)
+	cmd, ok := r.Resolve("n")
+	require.True(t, ok)
+	assert.Equal(t, "north", cmd.Name)
+	assert.Equal(t, HandlerMove, cmd.Handler)
+	assert.Equal(t, len(BuiltinCommands()), len(r.Commands()))
+}
+
+func TestRegistryFromProto_Invalid(t *testing.T) {
+	_, err := RegistryFromProto(nil)
+	assert.Error(t, err)
+	_, err = RegistryFromProto([]*gamev1.CommandInfo{{Name: "a"}, {Name: "b", Aliases: []string{"a"}}})
+	assert.Error(t, err)
+}
+
+// TestProperty_CatalogRoundTripPreservesCommands verifies that converting any
+// valid set of commands to the wire catalog and back preserves every field.
+func TestProperty_CatalogRoundTripPreservesCommands(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		names := rapid.SliceOfNDistinct(rapid.StringMatching(`[a-z]{1,8}`), 1, 20, rapid.ID[string]).Draw(rt, "names")
+		categories := []string{CategoryMovement, CategoryAdmin, CategoryEditor, CategorySystem}
+		var cmds []Command
+		for _, name := range names {
+			cmds = append(cmds, Command{
+				Name:     name,
+				Help:     rapid.String().Draw(rt, "help"),
+				Category: rapid.SampledFrom(categories).Draw(rt, "category"),
+				Handler:  rapid.StringMatching(`[a-z_]{0,10}`).Draw(rt, "handler"),
+			})
+		}
+		orig, err := NewRegistry(cmds)
+		require.NoError(rt, err)
+		back, err := RegistryFromProto(orig.CatalogProto())
+		require.NoError(rt, err)
+		for _, want := range cmds {
+			got, ok := back.Resolve(want.Name)
+			if !ok {
+				rt.Fatalf("command %q lost", want.Name)
+			}
+			if got.Help != want.Help || got.Category != want.Category || got.Handler != want.Handler {
+				rt.Fatalf("command %q changed: %+v != %+v", want.Name, *got, want)
+			}
+		}
+	})
+}
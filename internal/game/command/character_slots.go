@@ -0,0 +1,30 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// characterSlotsUsage is the canonical usage string for the charslots command.
+const characterSlotsUsage = "usage: charslots <username> <count|default>"
+
+// HandleCharacterSlots parses an admin character slot override command.
+//
+// Precondition: args are the words following "charslots".
+// Postcondition: Returns the account username and the slot override, where 0
+// clears the override ("default"); returns a non-nil error on missing
+// arguments or a non-positive or non-numeric count.
+func HandleCharacterSlots(args []string) (string, int, error) {
+	if len(args) != 2 {
+		return "", 0, fmt.Errorf(characterSlotsUsage)
+	}
+	if strings.EqualFold(args[1], "default") {
+		return args[0], 0, nil
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil || n <= 0 {
+		return "", 0, fmt.Errorf(characterSlotsUsage)
+	}
+	return args[0], n, nil
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleCharacterSlots_Count(t *testing.T) {
+	username, slots, err := HandleCharacterSlots([]string{"kira", "8"})
+	require.NoError(t, err)
+	assert.Equal(t, "kira", username)
+	assert.Equal(t, 8, slots)
+}
+
+func TestHandleCharacterSlots_Default(t *testing.T) {
+	username, slots, err := HandleCharacterSlots([]string{"kira", "Default"})
+	require.NoError(t, err)
+	assert.Equal(t, "kira", username)
+	assert.Equal(t, 0, slots)
+}
+
+func TestHandleCharacterSlots_Invalid(t *testing.T) {
+	for _, args := range [][]string{nil, {"kira"}, {"kira", "0"}, {"kira", "-2"}, {"kira", "many"}, {"kira", "3", "extra"}} {
+		_, _, err := HandleCharacterSlots(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+// TestProperty_HandleCharacterSlots_PositiveCounts verifies every positive count round-trips.
+func TestProperty_HandleCharacterSlots_PositiveCounts(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(1, 1000).Draw(rt, "n")
+		_, slots, err := HandleCharacterSlots([]string{"x", strconv.Itoa(n)})
+		if err != nil || slots != n {
+			rt.Fatalf("got (%d, %v) for %d", slots, err, n)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// chatMuteUsage is the canonical usage string for the chatmute command.
+const chatMuteUsage = "usage: chatmute <player> <minutes|off>"
+
+// HandleChatMute parses a moderator chat mute command.
+//
+// Precondition: args are the words following "chatmute".
+// Postcondition: Returns the target character name and the mute length in
+// minutes, where 0 means lift the mute ("off"); returns a non-nil error on
+// missing arguments or a non-positive or non-numeric duration.
+func HandleChatMute(args []string) (string, int, error) {
+	if len(args) < 2 {
+		return "", 0, fmt.Errorf(chatMuteUsage)
+	}
+	target := args[0]
+	if strings.EqualFold(args[1], "off") {
+		return target, 0, nil
+	}
+	minutes, err := strconv.Atoi(args[1])
+	if err != nil || minutes <= 0 {
+		return "", 0, fmt.Errorf(chatMuteUsage)
+	}
+	return target, minutes, nil
+}
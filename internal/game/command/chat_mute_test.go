@@ -0,0 +1,42 @@
+package command
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleChatMute_Minutes(t *testing.T) {
+	target, minutes, err := HandleChatMute([]string{"Kira", "15"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kira", target)
+	assert.Equal(t, 15, minutes)
+}
+
+func TestHandleChatMute_Off(t *testing.T) {
+	target, minutes, err := HandleChatMute([]string{"Kira", "OFF"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kira", target)
+	assert.Equal(t, 0, minutes)
+}
+
+func TestHandleChatMute_Invalid(t *testing.T) {
+	for _, args := range [][]string{nil, {"Kira"}, {"Kira", "0"}, {"Kira", "soon"}} {
+		_, _, err := HandleChatMute(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+// TestProperty_HandleChatMute_PositiveMinutes verifies every positive duration round-trips.
+func TestProperty_HandleChatMute_PositiveMinutes(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(1, 100000).Draw(rt, "n")
+		_, minutes, err := HandleChatMute([]string{"x", strconv.Itoa(n)})
+		if err != nil || minutes != n {
+			rt.Fatalf("got (%d, %v) for %d", minutes, err, n)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// chooseFeatUsage is the canonical usage string for the choosefeat command.
+const chooseFeatUsage = "usage: choosefeat [<level>] [<feat>]"
+
+// HandleChooseFeat parses the choosefeat command. With no arguments it asks
+// for the pending feat choices; otherwise the feat is every word after an
+// optional leading grant level, so feats may be named with spaces.
+//
+// Precondition: args are the words following "choosefeat".
+// Postcondition: Returns grantLevel >= 0 (0 for "any pending choice") and the
+// feat named (empty to list choices); returns a non-nil error when a level is
+// given without a feat or is not positive.
+func HandleChooseFeat(args []string) (grantLevel int, feat string, err error) {
+	if len(args) == 0 {
+		return 0, "", nil
+	}
+	if n, convErr := strconv.Atoi(args[0]); convErr == nil {
+		if n < 1 || len(args) == 1 {
+			return 0, "", fmt.Errorf(chooseFeatUsage)
+		}
+		grantLevel, args = n, args[1:]
+	}
+	return grantLevel, strings.Join(args, " "), nil
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleChooseFeat(t *testing.T) {
+	cases := []struct {
+		args  []string
+		level int
+		feat  string
+	}{
+		{nil, 0, ""},
+		{[]string{"toughness"}, 0, "toughness"},
+		{[]string{"Hair", "Trigger"}, 0, "Hair Trigger"},
+		{[]string{"4", "steady_aim"}, 4, "steady_aim"},
+	}
+	for _, tc := range cases {
+		level, feat, err := HandleChooseFeat(tc.args)
+		require.NoError(t, err, tc.args)
+		assert.Equal(t, tc.level, level, tc.args)
+		assert.Equal(t, tc.feat, feat, tc.args)
+	}
+	for _, bad := range [][]string{{"4"}, {"0", "toughness"}, {"-2", "toughness"}} {
+		_, _, err := HandleChooseFeat(bad)
+		assert.EqualError(t, err, chooseFeatUsage, bad)
+	}
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// clanUsage is the canonical usage string for the clan command.
+const clanUsage = "usage: clan [create <tag> <name>|invite <player>|accept|decline|leave|kick <player>|promote <player>|demote <player>|motd [text]|say <text>|deposit <amount>|withdraw <amount>|disband]"
+
+// HandleClan parses the clan command.
+//
+// Precondition: args are the words following "clan".
+// Postcondition: Returns the lowercased action ("" for clan info), the target —
+// the player for invite/kick/promote/demote, the tag for create — the free
+// text — the clan name for create, the message for motd and say — and the
+// amount for deposit/withdraw. Returns a non-nil error for unknown actions,
+// missing arguments, or non-positive amounts.
+func HandleClan(args []string) (action, target, text string, amount int, err error) {
+	if len(args) == 0 {
+		return "", "", "", 0, nil
+	}
+	action = strings.ToLower(args[0])
+	rest := args[1:]
+	switch action {
+	case "accept", "decline", "leave", "disband":
+		if len(rest) != 0 {
+			return "", "", "", 0, fmt.Errorf(clanUsage)
+		}
+		return action, "", "", 0, nil
+	case "invite", "kick", "promote", "demote":
+		if len(rest) != 1 {
+			return "", "", "", 0, fmt.Errorf("usage: clan %s <player>", action)
+		}
+		return action, rest[0], "", 0, nil
+	case "create":
+		if len(rest) < 2 {
+			return "", "", "", 0, fmt.Errorf("usage: clan create <tag> <name>")
+		}
+		return action, rest[0], strings.Join(rest[1:], " "), 0, nil
+	case "motd":
+		return action, "", strings.Join(rest, " "), 0, nil
+	case "say":
+		if len(rest) == 0 {
+			return "", "", "", 0, fmt.Errorf("Say what?")
+		}
+		return action, "", strings.Join(rest, " "), 0, nil
+	case "deposit", "withdraw":
+		if len(rest) != 1 {
+			return "", "", "", 0, fmt.Errorf("usage: clan %s <amount>", action)
+		}
+		n, convErr := strconv.Atoi(rest[0])
+		if convErr != nil || n < 1 {
+			return "", "", "", 0, fmt.Errorf("amount must be a positive number")
+		}
+		return action, "", "", n, nil
+	}
+	return "", "", "", 0, fmt.Errorf(clanUsage)
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleClan(t *testing.T) {
+	cases := []struct {
+		args   []string
+		action string
+		target string
+		text   string
+		amount int
+	}{
+		{nil, "", "", "", 0},
+		{[]string{"ACCEPT"}, "accept", "", "", 0},
+		{[]string{"leave"}, "leave", "", "", 0},
+		{[]string{"invite", "Bee"}, "invite", "Bee", "", 0},
+		{[]string{"promote", "Bee"}, "promote", "Bee", "", 0},
+		{[]string{"create", "rr", "Rust", "Rats"}, "create", "rr", "Rust Rats", 0},
+		{[]string{"motd"}, "motd", "", "", 0},
+		{[]string{"motd", "Meet", "at", "noon."}, "motd", "", "Meet at noon.", 0},
+		{[]string{"say", "hello", "all"}, "say", "", "hello all", 0},
+		{[]string{"deposit", "25"}, "deposit", "", "", 25},
+		{[]string{"withdraw", "5"}, "withdraw", "", "", 5},
+	}
+	for _, c := range cases {
+		action, target, text, amount, err := HandleClan(c.args)
+		require.NoError(t, err, "%v", c.args)
+		assert.Equal(t, c.action, action, "%v", c.args)
+		assert.Equal(t, c.target, target, "%v", c.args)
+		assert.Equal(t, c.text, text, "%v", c.args)
+		assert.Equal(t, c.amount, amount, "%v", c.args)
+	}
+
+	for _, args := range [][]string{
+		{"conquer"}, {"leave", "now"}, {"invite"}, {"kick", "a", "b"}, {"create", "rr"},
+		{"say"}, {"deposit"}, {"deposit", "0"}, {"withdraw", "lots"},
+	} {
+		_, _, _, _, err := HandleClan(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+func TestProperty_HandleClan_DepositAmount(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(-1000, 1000).Draw(rt, "n")
+		action, _, _, amount, err := HandleClan([]string{"deposit", fmt.Sprint(n)})
+		if n < 1 {
+			if err == nil {
+				rt.Fatalf("HandleClan(deposit %d) accepted a non-positive amount", n)
+			}
+			return
+		}
+		if err != nil || action != "deposit" || amount != n {
+			rt.Fatalf("HandleClan(deposit %d) = %q, %d, %v", n, action, amount, err)
+		}
+	})
+}
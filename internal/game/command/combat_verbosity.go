@@ -0,0 +1,28 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidCombatVerbosities lists the accepted combat narration levels, from least to most detailed.
+var ValidCombatVerbosities = []string{"brief", "normal", "verbose"}
+
+// HandleCombatVerbosity validates and normalizes the requested combat narration level.
+//
+// Precondition: args may be empty.
+// Postcondition: returns "" and nil when args is empty (a query for the current level);
+// returns the normalized level and nil when args[0] is a valid level;
+// returns "" and a non-nil error otherwise.
+func HandleCombatVerbosity(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	level := strings.ToLower(strings.TrimSpace(args[0]))
+	for _, v := range ValidCombatVerbosities {
+		if v == level {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("invalid combat verbosity %q; usage: combat [%s]", level, strings.Join(ValidCombatVerbosities, "|"))
+}
@@ -0,0 +1,49 @@
+package command_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+)
+
+func TestHandleCombatVerbosity_NoArgs_ReturnsQuery(t *testing.T) {
+	level, err := command.HandleCombatVerbosity(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", level)
+}
+
+func TestHandleCombatVerbosity_InvalidLevel_ReturnsError(t *testing.T) {
+	_, err := command.HandleCombatVerbosity([]string{"loud"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "loud")
+	assert.Contains(t, err.Error(), "usage: combat")
+}
+
+func TestHandleCombatVerbosity_NormalizesCase(t *testing.T) {
+	level, err := command.HandleCombatVerbosity([]string{"  VERBOSE "})
+	require.NoError(t, err)
+	assert.Equal(t, "verbose", level)
+}
+
+func TestPropertyHandleCombatVerbosity_ValidLevels_AlwaysSucceed(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		level := rapid.SampledFrom(command.ValidCombatVerbosities).Draw(rt, "level")
+		upper := rapid.Bool().Draw(rt, "upper")
+		input := level
+		if upper {
+			input = strings.ToUpper(level)
+		}
+		got, err := command.HandleCombatVerbosity([]string{input})
+		if err != nil {
+			rt.Fatalf("expected no error for %q, got %v", input, err)
+		}
+		if got != level {
+			rt.Fatalf("expected %q, got %q", level, got)
+		}
+	})
+}
@@ -31,6 +31,8 @@ const (
 	HandlerExits              = "exits"
 	HandlerSay                = "say"
 	HandlerEmote              = "emote"
+	HandlerShout              = "shout"
+	HandlerYell               = "yell"
 	HandlerWho                = "who"
 	HandlerQuit               = "quit"
 	HandlerHelp               = "help"
@@ -72,6 +74,7 @@ const (
 	HandlerProficiencies      = "proficiencies"
 	HandlerLevelUp            = "levelup"
 	HandlerCombatDefault      = "combat_default"
+	HandlerCombatVerbosity    = "combat_verbosity"
 	HandlerTrainSkill         = "trainskill"
 	HandlerAction             = "action"
 	HandlerRaiseShield        = "raise_shield"
@@ -86,6 +89,7 @@ const (
 	HandlerDisarm             = "disarm"
 	HandlerDisarmTrap         = "disarm_trap"
 	HandlerDeployTrap         = "deploy_trap"
+	HandlerDetect             = "detect"
 	HandlerStride             = "stride"
 	HandlerHide               = "hide"
 	HandlerSneak              = "sneak"
@@ -120,9 +124,22 @@ const (
 	HandlerBuy                = "buy"
 	HandlerSell               = "sell"
 	HandlerNegotiate          = "negotiate"
+	HandlerMarket             = "market"
+	HandlerAuction            = "auction"
 	HandlerDeposit            = "deposit"
 	HandlerWithdraw           = "withdraw"
 	HandlerStashBalance       = "stash_balance"
+	HandlerSharedStash        = "shared"
+	HandlerHome               = "home"
+	HandlerClan               = "clan"
+	HandlerClanSay            = "clansay"
+	HandlerAchievements       = "achievements"
+	HandlerTitle              = "title"
+	HandlerTop                = "top"
+	HandlerPlayed             = "played"
+	HandlerTime               = "time"
+	HandlerAfk                = "afk"
+	HandlerSiteBan            = "siteban"
 	HandlerHire               = "hire"
 	HandlerDismiss            = "dismiss"
 	HandlerTrainJob           = "train_job"
@@ -130,6 +147,7 @@ const (
 	HandlerSetJob             = "setjob"
 	HandlerBribe              = "bribe"
 	HandlerSurrender          = "surrender"
+	HandlerPayFine            = "payfine"
 	HandlerRelease            = "release"
 	HandlerFaction            = "faction"
 	HandlerFactionInfo        = "faction_info"
@@ -151,7 +169,37 @@ const (
 	HandlerExplore            = "explore"
 	HandlerDowntime           = "downtime"
 	HandlerSeduce             = "seduce"
+	HandlerParley             = "parley"
+	HandlerSocial             = "social"
+	HandlerRestrain           = "restrain"
+	HandlerNonlethal          = "nonlethal"
+	HandlerPractice           = "practice"
+	HandlerRecap              = "recap"
+	HandlerFormation          = "formation"
+	HandlerOrder              = "order"
+	HandlerChooseFeat         = "choosefeat"
+	HandlerAbility            = "ability"
+	HandlerRespec             = "respec"
+	HandlerExportCharacter    = "exportchar"
+	HandlerSteal              = "steal"
+	HandlerPick               = "pick"
 	HandlerHotbar             = "hotbar"
+	HandlerLocale             = "locale"
+	HandlerChatMute           = "chatmute"
+	HandlerAudit              = "audit"
+	HandlerRename             = "rename"
+	HandlerCharacterSlots     = "charslots"
+	HandlerTransferCharacter  = "transfer"
+	HandlerSettings           = "settings"
+	HandlerPrompt             = "prompt"
+	HandlerConsider           = "consider"
+	HandlerScan               = "scan"
+	HandlerLight              = "light"
+	HandlerBoard              = "board"
+	HandlerDisembark          = "disembark"
+	HandlerDrive              = "drive"
+	HandlerRefuel             = "refuel"
+	HandlerTutorial           = "tutorial"
 	HandlerGrantItem          = "grant_item"
 	HandlerGrantMoney         = "grant_money"
 	HandlerKillNPC            = "kill_npc"
@@ -159,6 +207,23 @@ const (
 	HandlerInspect            = "inspect"
 	HandlerEffects            = "effects"
 	HandlerTerrain            = "terrain"
+	HandlerLog                = "log"
+	HandlerHistory            = "history"
+	HandlerBug                = "bug"
+	HandlerTypo               = "typo"
+	HandlerIdea               = "idea"
+	HandlerReports            = "reports"
+	HandlerInvis              = "invis"
+	HandlerSnoop              = "snoop"
+	HandlerForce              = "force"
+	HandlerPuppet             = "puppet"
+	HandlerGM                 = "gm"
+	HandlerSchedule           = "schedule"
+	HandlerEngrave            = "engrave"
+	HandlerDig                = "dig"
+	HandlerAnswer             = "answer"
+	HandlerReloadConfig       = "reloadconfig"
+	HandlerFlag               = "flag"
 )
 
 // Command defines a player-invocable command.
@@ -194,6 +259,13 @@ func BuiltinCommands() []Command {
 		{Name: "look", Aliases: []string{"l"}, Help: "Look around the current room", Category: CategoryWorld, Handler: HandlerLook},
 		{Name: "exits", Aliases: nil, Help: "List available exits", Category: CategoryWorld, Handler: HandlerExits},
 		{Name: "examine", Aliases: []string{"ex"}, Help: "Examine an NPC or object in the room", Category: CategoryWorld, Handler: HandlerExamine},
+		{Name: "consider", Aliases: []string{"con"}, Help: "Estimate how hard a fight with an NPC would be (consider <npc>)", Category: CategoryWorld, Handler: HandlerConsider},
+		{Name: "scan", Aliases: nil, Help: "List NPCs in the rooms beyond open exits", Category: CategoryWorld, Handler: HandlerScan},
+		{Name: "light", Aliases: nil, Help: "Light a light source from your backpack, or put it out (light <item>|off)", Category: CategoryWorld, Handler: HandlerLight},
+		{Name: "board", Aliases: nil, Help: "Climb into a vehicle in the room (board <vehicle>)", Category: CategoryWorld, Handler: HandlerBoard},
+		{Name: "disembark", Aliases: nil, Help: "Get out of the vehicle you are riding", Category: CategoryWorld, Handler: HandlerDisembark},
+		{Name: "drive", Aliases: []string{"sail"}, Help: "Drive your vehicle one room, carrying everyone aboard (drive <direction>)", Category: CategoryWorld, Handler: HandlerDrive},
+		{Name: "refuel", Aliases: nil, Help: "Pour fuel from your backpack into a vehicle (refuel [vehicle])", Category: CategoryWorld, Handler: HandlerRefuel},
 		{Name: "attack", Aliases: []string{"att", "kill"}, Help: "Attack a target", Category: CategoryWorld, Handler: HandlerAttack},
 		{Name: "flee", Aliases: []string{"run"}, Help: "Attempt to flee combat", Category: CategoryWorld, Handler: HandlerFlee},
 		{Name: "pass", Aliases: []string{"p"}, Help: "Forfeit remaining action points this round.", Category: CategoryCombat, Handler: HandlerPass},
@@ -213,6 +285,9 @@ func BuiltinCommands() []Command {
 		{Name: "get", Aliases: []string{"take"}, Help: "Pick up item from room floor", Category: CategoryWorld, Handler: HandlerGet},
 		{Name: "drop", Aliases: nil, Help: "Drop an item from your backpack", Category: CategoryWorld, Handler: HandlerDrop},
 		{Name: "inspect", Aliases: nil, Help: "Inspect an item in your backpack (inspect <item_id>)", Category: CategoryWorld, Handler: HandlerInspect},
+		{Name: "engrave", Aliases: nil, Help: "Engrave a custom name on an item in your backpack (engrave <item_id> <name>)", Category: CategoryWorld, Handler: HandlerEngrave},
+		{Name: "dig", Aliases: nil, Help: "Dig for the cache a treasure map in your backpack points to", Category: CategoryWorld, Handler: HandlerDig},
+		{Name: "answer", Aliases: []string{"ans"}, Help: "Answer the question you were last asked (answer <number|option>)", Category: CategoryWorld, Handler: HandlerAnswer},
 		{Name: "balance", Aliases: []string{"bal"}, Help: "Show your currency (Rounds/Clips/Crates)", Category: CategoryWorld, Handler: HandlerBalance},
 
 		// NPC interaction commands
@@ -222,9 +297,13 @@ func BuiltinCommands() []Command {
 		{Name: "buy", Aliases: nil, Help: "Buy an item from a merchant (buy <npc> <item> [qty])", Category: CategoryWorld, Handler: HandlerBuy},
 		{Name: "sell", Aliases: nil, Help: "Sell an item to a merchant (sell <npc> <item> [qty])", Category: CategoryWorld, Handler: HandlerSell},
 		{Name: "negotiate", Aliases: []string{"neg"}, Help: "Negotiate prices with a merchant (negotiate <npc> [smooth_talk|grift])", Category: CategoryWorld, Handler: HandlerNegotiate},
+		{Name: "market", Aliases: nil, Help: "Show which goods are trading above or below their usual price in this zone", Category: CategoryWorld, Handler: HandlerMarket},
+		{Name: "auction", Aliases: []string{"ah"}, Help: "Trade with other players through a broker (auction [browse [filter]|list [qty] <item> <price> [hours]|buy <id>|cancel <id>|mine|collect])", Category: CategoryWorld, Handler: HandlerAuction},
 		{Name: "deposit", Aliases: nil, Help: "Deposit credits with a banker (deposit <npc> <amount>)", Category: CategoryWorld, Handler: HandlerDeposit},
 		{Name: "withdraw", Aliases: nil, Help: "Withdraw credits from a banker (withdraw <npc> <amount>)", Category: CategoryWorld, Handler: HandlerWithdraw},
 		{Name: "stash", Aliases: []string{"stashbal"}, Help: "Check your stash balance at a banker (stash <npc>)", Category: CategoryWorld, Handler: HandlerStashBalance},
+		{Name: "shared", Aliases: []string{"sharedstash"}, Help: "Show or use the stash shared by all your characters (shared [deposit|withdraw <amount>])", Category: CategoryWorld, Handler: HandlerSharedStash},
+		{Name: "home", Aliases: []string{"house"}, Help: "Rent and manage a room of your own; rent is charged from your stash (home [rent|vacate|describe <text>|store <item> [qty]|take <item> [qty]])", Category: CategoryWorld, Handler: HandlerHome},
 		{Name: "hire", Aliases: nil, Help: "Hire a hireling NPC (hire <npc>)", Category: CategoryWorld, Handler: HandlerHire},
 		{Name: "dismiss", Aliases: nil, Help: "Dismiss your current hireling", Category: CategoryWorld, Handler: HandlerDismiss},
 		{Name: "train", Aliases: nil, Help: "Train a job with a job trainer NPC (train <npc> <job>)", Category: CategoryWorld, Handler: HandlerTrainJob},
@@ -232,6 +311,9 @@ func BuiltinCommands() []Command {
 		{Name: "setjob", Aliases: nil, Help: "Set your active job (setjob <job>)", Category: CategoryCharacter, Handler: HandlerSetJob},
 		{Name: "bribe", Aliases: nil, Help: "Bribe law enforcement to reduce wanted level (bribe [npc]) or confirm a pending bribe (bribe confirm)", Category: CategoryWorld, Handler: HandlerBribe},
 		{Name: "surrender", Aliases: nil, Help: "Surrender to law enforcement in the current room", Category: CategoryWorld, Handler: HandlerSurrender},
+		{Name: "payfine", Aliases: nil, Help: "Pay a guard the fine for your crimes to clear your wanted level in this zone", Category: CategoryWorld, Handler: HandlerPayFine},
+		{Name: "steal", Aliases: []string{"pickpocket"}, Help: "Pick an NPC's pocket (steal <item> from <npc>; grift vs Perception; failure gets you caught). Out of combat only.", Category: CategoryWorld, Handler: HandlerSteal},
+		{Name: "pick", Aliases: []string{"picklock"}, Help: "Pick a locked door or item with a lockpick (pick <direction|item>, then pick tension or pick rake for each pin; pick stop to give up)", Category: CategoryWorld, Handler: HandlerPick},
 		{Name: "release", Aliases: nil, Help: "Release a detained player (release <player>)", Category: CategoryWorld, Handler: HandlerRelease},
 		{Name: "faction", Aliases: nil, Help: "Show your current faction, tier, rep, and perks", Category: CategoryWorld, Handler: HandlerFaction},
 		{Name: "faction_info", Aliases: nil, Help: "Show public information about a faction (faction_info <faction_id>)", Category: CategoryWorld, Handler: HandlerFactionInfo},
@@ -241,9 +323,12 @@ func BuiltinCommands() []Command {
 		// Communication commands
 		{Name: "say", Aliases: nil, Help: "Say something to the room", Category: CategoryCommunication, Handler: HandlerSay},
 		{Name: "emote", Aliases: []string{"em"}, Help: "Perform an emote action", Category: CategoryCommunication, Handler: HandlerEmote},
+		{Name: "shout", Aliases: nil, Help: "Shout something the whole zone can hear (shout <message>)", Category: CategoryCommunication, Handler: HandlerShout},
+		{Name: "yell", Aliases: nil, Help: "Yell something the neighbouring rooms can hear (yell <message>)", Category: CategoryCommunication, Handler: HandlerYell},
 
 		// System commands
 		{Name: "who", Aliases: nil, Help: "List players in the room", Category: CategorySystem, Handler: HandlerWho},
+		{Name: "afk", Aliases: []string{"away"}, Help: "Mark yourself away from keyboard, with an optional message (afk [message])", Category: CategorySystem, Handler: HandlerAfk},
 		{Name: "quit", Aliases: []string{"exit"}, Help: "Disconnect from the game", Category: CategorySystem, Handler: HandlerQuit},
 		{Name: "switch", Aliases: nil, Help: "Switch to a different character without disconnecting.", Category: CategorySystem, Handler: HandlerSwitch},
 		{Name: "help", Aliases: []string{"?"}, Help: "Show available commands", Category: CategorySystem, Handler: HandlerHelp},
@@ -253,6 +338,11 @@ func BuiltinCommands() []Command {
 		{Name: "remove", Aliases: []string{"rem"}, Help: "Remove a piece of armor and return it to inventory (remove <slot>)", Category: CategoryCombat, Handler: HandlerRemoveArmor},
 
 		{Name: "char", Aliases: []string{"sheet"}, Help: "Display your character sheet", Category: CategoryWorld, Handler: HandlerChar},
+		{Name: "achievements", Aliases: []string{"ach"}, Help: "List your achievements and progress toward them", Category: CategoryCharacter, Handler: HandlerAchievements},
+		{Name: "title", Aliases: nil, Help: "List your earned titles, or choose one to display (title [<title>|none])", Category: CategoryCharacter, Handler: HandlerTitle},
+		{Name: "top", Aliases: []string{"leaderboard"}, Help: "Show the leaderboard for a stat (top <kills|deaths|damage|explored|playtime>)", Category: CategoryCharacter, Handler: HandlerTop},
+		{Name: "played", Help: "Show total playtime, this session's length, and your character's age", Category: CategoryCharacter, Handler: HandlerPlayed},
+		{Name: "time", Aliases: []string{"date"}, Help: "Show the game time, date, and season", Category: CategoryWorld, Handler: HandlerTime},
 
 		{Name: "archetype_selection", Aliases: nil, Help: "Select archetype during character creation", Category: CategoryHidden, Handler: HandlerArchetypeSelection},
 		{Name: HandlerTabComplete, Aliases: nil, Help: "", Category: CategoryHidden, Handler: HandlerTabComplete},
@@ -262,6 +352,22 @@ func BuiltinCommands() []Command {
 		// Admin commands
 		{Name: "setrole", Aliases: nil, Help: "Set a player's role (admin only)", Category: CategoryAdmin, Handler: HandlerSetRole},
 		{Name: "teleport", Aliases: []string{"tp"}, Help: "Teleport a player to a room (admin only)", Category: CategoryAdmin, Handler: HandlerTeleport},
+		{Name: "chatmute", Aliases: nil, Help: "Mute or unmute a player's chat (chatmute <player> <minutes|off>; moderator or admin)", Category: CategoryAdmin, Handler: HandlerChatMute},
+		{Name: "audit", Aliases: nil, Help: "Show recent admin actions (audit [player] [count]; admin only)", Category: CategoryAdmin, Handler: HandlerAudit},
+		{Name: "reports", Aliases: nil, Help: "Review player bug, typo, and idea reports (reports [all|bug|typo|idea] | reports show <id> | reports close <id>; moderators only)", Category: CategoryAdmin, Handler: HandlerReports},
+		{Name: "invis", Aliases: []string{"wizinvis"}, Help: "Hide from room presence, who lists, and arrivals (invis [on|off]; moderators only)", Category: CategoryAdmin, Handler: HandlerInvis},
+		{Name: "snoop", Aliases: nil, Help: "Watch a player's session; they are told and it is logged (snoop <player> <reason> | snoop off; moderators only)", Category: CategoryAdmin, Handler: HandlerSnoop},
+		{Name: "force", Aliases: nil, Help: "Run a command as another player (force <player> <command>; admin only)", Category: CategoryAdmin, Handler: HandlerForce},
+		{Name: "puppet", Aliases: nil, Help: "Control an NPC's speech and combat actions (puppet <npc> | say <text> | emote <text> | attack <target> | hold | off; editor)", Category: CategoryEditor, Handler: HandlerPuppet},
+		{Name: "gm", Aliases: nil, Help: "Run a live event in your room (gm freeze | unfreeze | heal <target> | damage <target> <amount> | desc <text|clear> | script <hook>; editor)", Category: CategoryEditor, Handler: HandlerGM},
+		{Name: "schedule", Aliases: nil, Help: "List scheduled server events or run one now (schedule [list] | schedule run <event>; admin only)", Category: CategoryAdmin, Handler: HandlerSchedule},
+		{Name: "reloadconfig", Aliases: nil, Help: "Re-read the server config and apply the values that are safe to change without a restart (admin only)", Category: CategoryAdmin, Handler: HandlerReloadConfig},
+		{Name: "flag", Aliases: nil, Help: "List feature flags or override one for everyone or one account (flag [list] | flag <name> on|off|default [username]; admin only)", Category: CategoryAdmin, Handler: HandlerFlag},
+		{Name: "rename", Aliases: nil, Help: "Rename a character (rename <character> <new name>; admin only)", Category: CategoryAdmin, Handler: HandlerRename},
+		{Name: "charslots", Aliases: nil, Help: "Override an account's character limit (charslots <username> <count|default>; admin only)", Category: CategoryAdmin, Handler: HandlerCharacterSlots},
+		{Name: "transfer", Aliases: nil, Help: "Move an offline character to another account (transfer <character> <username>; admin only)", Category: CategoryAdmin, Handler: HandlerTransferCharacter},
+		{Name: "exportchar", Aliases: nil, Help: "Export a character as JSON for charactertool import (exportchar <character>; admin only)", Category: CategoryAdmin, Handler: HandlerExportCharacter},
+		{Name: "siteban", Aliases: nil, Help: "Ban or allow client addresses (siteban list | add <cidr> [reason] | allow <cidr> [reason] | remove <cidr>; admin only)", Category: CategoryAdmin, Handler: HandlerSiteBan},
 		{Name: "roomequip", Aliases: nil, Help: "Manage room equipment (editor)", Category: CategoryEditor, Handler: HandlerRoomEquip},
 		{Name: "grant", Aliases: nil, Help: "Grant XP or money to a player (editor)", Category: CategoryEditor, Handler: HandlerGrant},
 
@@ -288,20 +394,34 @@ func BuiltinCommands() []Command {
 		{Name: "summon_item", Aliases: nil, Help: "Summon an item into the current room (editor+)", Category: CategoryEditor, Handler: HandlerSummonItem},
 		{Name: "levelup", Aliases: []string{"lu"}, Help: "Assign a pending ability boost to the named ability", Category: CategoryCharacter, Handler: HandlerLevelUp},
 		{Name: "combat_default", Aliases: []string{"cd"}, Help: "Set your default combat action (attack/strike/bash/dodge/parry/cast/pass/flee)", Category: CategoryCombat, Handler: HandlerCombatDefault},
-		{Name: "trainskill", Aliases: []string{"ts"}, Help: "Advance a skill proficiency rank using a pending skill increase", Category: CategoryCharacter, Handler: HandlerTrainSkill},
+		{Name: "combat", Aliases: nil, Help: "Show or set combat narration detail (combat [brief|normal|verbose])", Category: CategoryCombat, Handler: HandlerCombatVerbosity},
+		{Name: "trainskill", Aliases: []string{"ts"}, Help: "Advance a skill or weapon category proficiency rank using a pending skill increase", Category: CategoryCharacter, Handler: HandlerTrainSkill},
 		{Name: "action", Aliases: []string{"act"}, Help: "Activate an archetype or job action. Usage: action [name] [target]", Category: CategoryCombat, Handler: HandlerAction},
 		{Name: "raise", Aliases: []string{"rs"}, Help: "Raise your shield (+2 AC until start of next turn). Requires a shield in the off-hand slot.", Category: CategoryCombat, Handler: HandlerRaiseShield},
 		{Name: "cover", Aliases: []string{"tc"}, Help: "Take cover (+2 AC for the encounter). Costs 1 AP in combat.", Category: CategoryCombat, Handler: HandlerTakeCover},
 		{Name: "uncover", Aliases: []string{"uc"}, Help: "Leave cover, removing any active cover condition.", Category: CategoryCombat, Handler: HandlerUncover},
 		{Name: "aid", Aliases: []string{"fa"}, Help: "Aid an ally (DC 20 check; crit +3, success +2, fail 0, crit fail -1 to ally attack). Costs 2 AP.", Category: CategoryCombat, Handler: HandlerAid},
+		{Name: "firstaid", Aliases: []string{"bandage"}, Help: "Patch yourself up (patch_job DC 15; heals 2d8+4), or stabilize a dying ally: firstaid <ally> (DC 15 + dying value). Costs 2 AP in combat.", Category: CategoryCombat, Handler: HandlerFirstAid},
 		{Name: "feint", Aliases: nil, Help: "Feint against a target (grift vs Perception DC; success applies flat_footed -2 AC for 1 round). Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerFeint},
 		{Name: "demoralize", Aliases: []string{"dem"}, Help: "Demoralize a target (smooth_talk vs Level+10 DC; success applies -1 AC and -1 attack for the encounter). Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerDemoralize},
 		{Name: "seduce", Aliases: []string{"sed"}, Help: "Seduce a target NPC (flair vs Savvy; success charms them; failure turns them hostile). Requires flair skill.", Category: CategoryCombat, Handler: HandlerSeduce},
+		{Name: "parley", Aliases: nil, Help: "Deal with an NPC that has surrendered (parley <npc> [loot|interrogate|release])", Category: CategoryCombat, Handler: HandlerParley},
+		{Name: "social", Aliases: nil, Help: "Wear down an NPC's resolve with words (social <npc>, then social persuade|intimidate|deceive|pass|leave)", Category: CategoryWorld, Handler: HandlerSocial},
+		{Name: "restrain", Aliases: nil, Help: "Take a surrendered NPC prisoner with zip ties or other restraints; prisoners follow you until handed over or they escape (restrain <npc>)", Category: CategoryCombat, Handler: HandlerRestrain},
+		{Name: "nonlethal", Aliases: []string{"pull"}, Help: "Pull your blows so targets you drop to 0 HP are knocked out instead of killed (nonlethal [on|off|status])", Category: CategoryCombat, Handler: HandlerNonlethal},
+		{Name: "practice", Aliases: nil, Help: "Practice mode shows every roll, modifier, and damage step in combat; training dummies make safe targets (practice [on|off|status])", Category: CategoryCombat, Handler: HandlerPractice},
+		{Name: "recap", Aliases: nil, Help: "Show the statistics of your last finished fight: damage dealt and taken, hits, misses, crits, healing, and rounds", Category: CategoryCombat, Handler: HandlerRecap},
+		{Name: "formation", Aliases: []string{"row"}, Help: "Fight from the front or back row; the back row is harder to hit in melee but can only use ranged attacks (formation [front|back], or formation <member> <front|back> as party leader)", Category: CategoryCombat, Handler: HandlerFormation},
+		{Name: "order", Aliases: nil, Help: "Give the companion fighting at your side an order for the rest of the fight (order <companion> attack <target> | guard | fallback | use <item>)", Category: CategoryCombat, Handler: HandlerOrder},
+		{Name: "choosefeat", Aliases: []string{"learnfeat"}, Help: "List your pending feat choices from leveling up, or learn one (choosefeat [<level>] <feat>)", Category: CategoryWorld, Handler: HandlerChooseFeat},
+		{Name: "ability", Aliases: []string{"abilities"}, Help: "List your job's combat abilities, or use one (ability <name> [target])", Category: CategoryCombat, Handler: HandlerAbility},
+		{Name: "respec", Aliases: []string{"retrain"}, Help: "Pay a job trainer to re-allocate your point-buy abilities or swap a chosen feat (respec <npc> [<ability> <+/-n> ... | reset | feat <old> to <new>])", Category: CategoryWorld, Handler: HandlerRespec},
 		{Name: "grapple", Aliases: []string{"grp"}, Help: "Grapple a target (muscle vs Level+10 DC; success applies grabbed condition, target is -2 AC for encounter). Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerGrapple},
 		{Name: "trip", Aliases: []string{"trp"}, Help: "Trip a target (muscle vs Level+10 DC; success applies prone, -2 attack for encounter). Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerTrip},
-		{Name: "disarm", Aliases: []string{"dsm"}, Help: "Disarm a target (muscle vs Level+10 DC; success removes NPC weapon and drops it to the floor). Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerDisarm},
+		{Name: "disarm", Aliases: []string{"dsm"}, Help: "Disarm a target (muscle vs Level+10 DC; success removes NPC weapon and drops it to the floor). Costs 1 AP in combat; out of combat, disarm <trap> disarms a detected trap like disarm_trap.", Category: CategoryCombat, Handler: HandlerDisarm},
 		{Name: "disarm_trap", Aliases: []string{"dt"}, Help: "Disarm a detected trap by name. Must have previously detected the trap (Search mode). Uses Thievery.", Category: CategoryCombat, Handler: HandlerDisarmTrap},
 		{Name: "deploy_trap", Aliases: []string{"deploy"}, Help: "deploy <item> — arm a trap item at your current position (1 AP in combat)", Category: CategoryCombat, Handler: HandlerDeployTrap},
+		{Name: "detect", Help: "detect traps — search the room for hidden traps (Perception vs each trap's stealth DC) and list the ones you know of", Category: CategoryCombat, Handler: HandlerDetect},
 		{Name: "stride", Aliases: []string{"str", "close", "move", "approach"}, Help: "Close distance toward your target (1 AP; moves 25ft toward enemy). Combat only.", Category: CategoryCombat, Handler: HandlerStride},
 		{Name: "hide", Aliases: nil, Help: "Attempt to hide (stealth vs highest NPC Perception DC; success applies hidden condition). Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerHide},
 		{Name: "sneak", Aliases: nil, Help: "Move while hidden (stealth vs highest NPC Perception DC; fail removes hidden). Requires hidden condition. Combat only, costs 1 AP.", Category: CategoryCombat, Handler: HandlerSneak},
@@ -325,6 +445,8 @@ func BuiltinCommands() []Command {
 		{Name: "gdecline", Help: "Decline a pending group invitation.", Category: CategoryCommunication, Handler: HandlerDeclineGroup},
 		{Name: "ungroup", Help: "Leave your group. Leaders disband the group for all members.", Category: CategoryCommunication, Handler: HandlerUngroup},
 		{Name: "kick", Help: "Kick a player from your group (leader only).", Category: CategoryCommunication, Handler: HandlerKick},
+		{Name: "clan", Aliases: []string{"guild"}, Help: "Show or manage your clan (clan [create <tag> <name>|invite|accept|decline|leave|kick|promote|demote <player>|motd [text]|say <text>|deposit|withdraw <amount>|disband])", Category: CategoryCommunication, Handler: HandlerClan},
+		{Name: "clansay", Aliases: []string{"csay"}, Help: "Talk on your clan channel (clansay <message>)", Category: CategoryCommunication, Handler: HandlerClanSay},
 		{Name: "rest", Help: "Rest to rearrange your prepared technology slots.", Category: CategoryCharacter, Handler: HandlerRest},
 		{Name: "selecttech", Help: "Select pending technology upgrades from levelling up.", Category: CategoryCharacter, Handler: HandlerSelectTech},
 		{Name: "ready", Aliases: []string{"rdy"}, Help: "ready <action> when <trigger> — ready a reaction (2 AP); actions: strike/step/shield; triggers: enters/attacks/ally", Category: CategoryCombat, Handler: HandlerReady},
@@ -343,6 +465,15 @@ func BuiltinCommands() []Command {
 
 		// System utility commands
 		{Name: "hotbar", Aliases: nil, Help: "Manage hotbar slots. Usage: hotbar [<slot> <text>] | clear <slot>", Category: CategorySystem, Handler: HandlerHotbar},
+		{Name: "locale", Aliases: []string{"language"}, Help: "Show or set your account language (locale [code])", Category: CategorySystem, Handler: HandlerLocale},
+		{Name: "settings", Aliases: []string{"prefs"}, Help: "Show or change account settings shared by all your characters (theme, accessible, mute, unmute, pager)", Category: CategorySystem, Handler: HandlerSettings},
+		{Name: "prompt", Aliases: nil, Help: "Show or customize your prompt with tokens such as %hp, %ap, and %time (prompt [set <format>|reset])", Category: CategorySystem, Handler: HandlerPrompt},
+		{Name: "tutorial", Aliases: nil, Help: "Show your tutorial progress, or leave the tutorial (tutorial [skip])", Category: CategorySystem, Handler: HandlerTutorial},
+		{Name: "log", Aliases: []string{"transcript"}, Help: "Record everything you see to a transcript you can download from the web client (log [on|off|status])", Category: CategorySystem, Handler: HandlerLog},
+		{Name: "bug", Aliases: nil, Help: "Report a bug to the staff; where you are and what you just did are included (bug <text>)", Category: CategorySystem, Handler: HandlerBug},
+		{Name: "typo", Aliases: nil, Help: "Report a spelling or text mistake in the room you're in (typo <text>)", Category: CategorySystem, Handler: HandlerTypo},
+		{Name: "idea", Aliases: []string{"suggest"}, Help: "Suggest an idea to the staff (idea <text>)", Category: CategorySystem, Handler: HandlerIdea},
+		{Name: "history", Aliases: []string{"hist"}, Help: "List the commands you've entered this session, or those containing a search term (history [term])", Category: CategorySystem, Handler: HandlerHistory},
 	}
 }
 
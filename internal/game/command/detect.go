@@ -0,0 +1,22 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HandleDetect parses the detect command. Traps are the only thing that can
+// be detected, so "detect" and "detect traps" are equivalent.
+//
+// Precondition: args are the words following "detect".
+// Postcondition: Returns an error for anything other than no argument or "trap(s)".
+func HandleDetect(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	switch strings.ToLower(strings.Join(args, " ")) {
+	case "trap", "traps":
+		return nil
+	}
+	return fmt.Errorf("usage: detect traps")
+}
@@ -0,0 +1,15 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleDetect(t *testing.T) {
+	assert.NoError(t, HandleDetect(nil))
+	assert.NoError(t, HandleDetect([]string{"traps"}))
+	assert.NoError(t, HandleDetect([]string{"Trap"}))
+	assert.EqualError(t, HandleDetect([]string{"ghosts"}), "usage: detect traps")
+	assert.Error(t, HandleDetect([]string{"traps", "now"}))
+}
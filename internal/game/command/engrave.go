@@ -0,0 +1,25 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// engraveUsage is the canonical usage string for the engrave command.
+const engraveUsage = "usage: engrave <item> <name>"
+
+// HandleEngrave parses the engrave command. The first word names the item;
+// the rest of the line is the name to engrave on it.
+//
+// Precondition: rawArgs is the text following "engrave".
+// Postcondition: Returns the item query and the trimmed name, or an error
+// carrying the usage string when either is missing.
+func HandleEngrave(rawArgs string) (item, name string, err error) {
+	rest := strings.TrimSpace(rawArgs)
+	item, name, ok := strings.Cut(rest, " ")
+	name = strings.TrimSpace(name)
+	if !ok || item == "" || name == "" {
+		return "", "", fmt.Errorf("%s", engraveUsage)
+	}
+	return item, name, nil
+}
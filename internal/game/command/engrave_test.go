@@ -0,0 +1,20 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleEngrave(t *testing.T) {
+	item, name, err := HandleEngrave("  combat_knife   Old Faithful ")
+	require.NoError(t, err)
+	assert.Equal(t, "combat_knife", item)
+	assert.Equal(t, "Old Faithful", name)
+
+	for _, bad := range []string{"", "   ", "combat_knife", "combat_knife   "} {
+		_, _, err := HandleEngrave(bad)
+		assert.ErrorContains(t, err, "usage: engrave", bad)
+	}
+}
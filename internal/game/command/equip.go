@@ -64,6 +64,13 @@ func HandleEquip(sess *session.PlayerSession, reg *inventory.Registry, arg strin
 		}
 	}
 
+	// Exotic weapons can't be wielded without training.
+	if weaponDef.ProficiencyCategory == "specialized" {
+		if rank := sess.Proficiencies["specialized"]; rank == "" || rank == "untrained" {
+			return fmt.Sprintf("You haven't trained with specialized weapons like the %s. Spend a skill increase on them with trainskill specialized.", weaponDef.Name)
+		}
+	}
+
 	// Resolve target preset: 1-based presetIndex, 0 means active.
 	var preset *inventory.WeaponPreset
 	if presetIndex > 0 && presetIndex <= len(sess.LoadoutSet.Presets) {
@@ -84,12 +91,16 @@ func HandleEquip(sess *session.PlayerSession, reg *inventory.Registry, arg strin
 		slotLabel = "main"
 		if equipErr == nil && preset.MainHand != nil {
 			preset.MainHand.ItemDefID = itemDefID
+			preset.MainHand.Affixes = inst.Affixes
+			preset.MainHand.CustomName = inst.CustomName
 		}
 	case "off":
 		equipErr = preset.EquipOffHand(weaponDef)
 		slotLabel = "off"
 		if equipErr == nil && preset.OffHand != nil {
 			preset.OffHand.ItemDefID = itemDefID
+			preset.OffHand.Affixes = inst.Affixes
+			preset.OffHand.CustomName = inst.CustomName
 		}
 	}
 	if equipErr != nil {
@@ -344,3 +344,36 @@ func TestHandleEquip_MinLevelCheck_ExactLevel(t *testing.T) {
 		t.Errorf("expected success at exact min level, got: %q", result)
 	}
 }
+
+// TestHandleEquip_SpecializedNeedsTraining verifies that an exotic weapon can't
+// be equipped until the player is trained with specialized weapons.
+func TestHandleEquip_SpecializedNeedsTraining(t *testing.T) {
+	sess := newTestSessionWithBackpack()
+	reg := newTestRegistry()
+	_ = reg.RegisterWeapon(&inventory.WeaponDef{
+		ID: "net_gun", Name: "Net Gun",
+		DamageDice: "1d4", DamageType: "bludgeoning", RangeIncrement: 20,
+		Kind: inventory.WeaponKindOneHanded, ProficiencyCategory: "specialized",
+		Rarity: "salvage",
+	})
+	_ = reg.RegisterItem(&inventory.ItemDef{
+		ID: "net_gun", Name: "Net Gun", Kind: inventory.KindWeapon, WeaponRef: "net_gun",
+		Weight: 2.0, MaxStack: 1,
+	})
+	if _, err := sess.Backpack.Add("net_gun", 1, reg); err != nil {
+		t.Fatalf("failed to add net gun: %v", err)
+	}
+
+	result := command.HandleEquip(sess, reg, "net_gun main", 0)
+	if !strings.Contains(result, "trainskill specialized") {
+		t.Errorf("expected a training hint, got: %q", result)
+	}
+	if sess.Backpack.UsedSlots() != 1 {
+		t.Errorf("expected item to remain in backpack, got %d items", sess.Backpack.UsedSlots())
+	}
+
+	sess.Proficiencies = map[string]string{"specialized": "trained"}
+	if result := command.HandleEquip(sess, reg, "net_gun main", 0); !strings.HasPrefix(result, "Equipped") {
+		t.Errorf("expected a trained player to equip the net gun, got: %q", result)
+	}
+}
@@ -180,6 +180,9 @@ func formatSlottedItemWithAffixes(item *inventory.SlottedItem, reg *inventory.Re
 		return "empty"
 	}
 	displayName := item.Name
+	if reg != nil {
+		displayName = reg.InstanceName(displayName, item.CustomName, item.Affixes)
+	}
 	// REQ-EM-18/19/20: prefix modifier.
 	switch item.Modifier {
 	case "tuned":
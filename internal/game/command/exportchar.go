@@ -0,0 +1,18 @@
+package command
+
+import "fmt"
+
+// exportCharacterUsage is the canonical usage string for the exportchar command.
+const exportCharacterUsage = "usage: exportchar <character>"
+
+// HandleExportCharacter parses an admin character export command.
+//
+// Precondition: args are the words following "exportchar".
+// Postcondition: Returns the character name; returns a non-nil error unless
+// exactly one name is given.
+func HandleExportCharacter(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(exportCharacterUsage)
+	}
+	return args[0], nil
+}
@@ -0,0 +1,21 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleExportCharacter_Valid(t *testing.T) {
+	name, err := HandleExportCharacter([]string{"Kira"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kira", name)
+}
+
+func TestHandleExportCharacter_Invalid(t *testing.T) {
+	for _, args := range [][]string{nil, {"Kira", "alice"}} {
+		_, err := HandleExportCharacter(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
@@ -1,15 +1,21 @@
 package command
 
-// FirstAidRequest is the parsed form of the aid command.
+import "strings"
+
+// FirstAidRequest is the parsed form of the firstaid command.
 //
 // Precondition: none.
-type FirstAidRequest struct{}
+type FirstAidRequest struct {
+	// Target is the dying ally to stabilize; empty treats the player.
+	Target string
+}
 
-// HandleFirstAid parses the arguments for the "aid" command.
-// Arguments are ignored — aid takes no parameters.
+// HandleFirstAid parses the arguments for the "firstaid" command. With no
+// arguments the player treats themselves; otherwise the arguments name the
+// dying ally to stabilize.
 //
 // Precondition: args may be nil or empty.
 // Postcondition: Returns a non-nil *FirstAidRequest and nil error always.
 func HandleFirstAid(args []string) (*FirstAidRequest, error) {
-	return &FirstAidRequest{}, nil
+	return &FirstAidRequest{Target: strings.TrimSpace(strings.Join(args, " "))}, nil
 }
@@ -16,10 +16,16 @@ func TestHandleFirstAid_NoArgs(t *testing.T) {
 	assert.NotNil(t, req)
 }
 
-func TestHandleFirstAid_WithArgs_Ignored(t *testing.T) {
-	req, err := command.HandleFirstAid([]string{"extra", "args"})
+func TestHandleFirstAid_NoArgs_TreatsSelf(t *testing.T) {
+	req, err := command.HandleFirstAid(nil)
 	require.NoError(t, err)
-	assert.NotNil(t, req)
+	assert.Empty(t, req.Target)
+}
+
+func TestHandleFirstAid_WithArgs_SetsTarget(t *testing.T) {
+	req, err := command.HandleFirstAid([]string{"Big", "Sal"})
+	require.NoError(t, err)
+	assert.Equal(t, "Big Sal", req.Target)
 }
 
 func TestPropertyHandleFirstAid_AlwaysSucceeds(t *testing.T) {
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// flagUsage is the canonical usage string for the flag command.
+const flagUsage = "usage: flag [list] | flag <name> on|off|default [username]"
+
+// HandleFlag parses an admin feature flag command.
+//
+// Precondition: args are the words following "flag".
+// Postcondition: Returns action "list" when args is empty or "list";
+// otherwise the lower-cased flag name, the lower-cased action ("on", "off",
+// or "default"), and the optional username; returns a non-nil error on an
+// unknown action or wrong number of arguments. Whether the flag is declared
+// is checked by the server.
+func HandleFlag(args []string) (action, flag, username string, err error) {
+	if len(args) == 0 || (len(args) == 1 && strings.EqualFold(args[0], "list")) {
+		return "list", "", "", nil
+	}
+	if len(args) < 2 || len(args) > 3 {
+		return "", "", "", fmt.Errorf(flagUsage)
+	}
+	action = strings.ToLower(args[1])
+	if action != "on" && action != "off" && action != "default" {
+		return "", "", "", fmt.Errorf(flagUsage)
+	}
+	if len(args) == 3 {
+		username = args[2]
+	}
+	return action, strings.ToLower(args[0]), username, nil
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleFlag_Actions(t *testing.T) {
+	action, flag, username, err := HandleFlag(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "list", action)
+	assert.Empty(t, flag)
+
+	action, _, _, err = HandleFlag([]string{"LIST"})
+	require.NoError(t, err)
+	assert.Equal(t, "list", action)
+
+	action, flag, username, err = HandleFlag([]string{"PvP", "ON"})
+	require.NoError(t, err)
+	assert.Equal(t, "on", action)
+	assert.Equal(t, "pvp", flag)
+	assert.Empty(t, username)
+
+	action, flag, username, err = HandleFlag([]string{"survival_needs", "default", "Tester"})
+	require.NoError(t, err)
+	assert.Equal(t, "default", action)
+	assert.Equal(t, "survival_needs", flag)
+	assert.Equal(t, "Tester", username, "usernames keep their case")
+}
+
+func TestHandleFlag_Invalid(t *testing.T) {
+	for _, args := range [][]string{{"pvp"}, {"pvp", "maybe"}, {"pvp", "on", "a", "b"}, {"list", "pvp"}} {
+		_, _, _, err := HandleFlag(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unforceable lists handlers force refuses: commands that disconnect the
+// player or change their client's mode, commands that only run on the
+// client, and force itself.
+var unforceable = map[string]bool{
+	HandlerQuit:    true,
+	HandlerSwitch:  true,
+	HandlerHelp:    true,
+	HandlerMap:     true,
+	HandlerHistory: true,
+	HandlerForce:   true,
+}
+
+// HandleForce parses the force command.
+//
+// Precondition: rawArgs is the text following "force".
+// Postcondition: Returns the target player and the command text to run as
+// them; returns an error when either is missing.
+func HandleForce(rawArgs string) (target, commandText string, err error) {
+	fields := strings.Fields(rawArgs)
+	if len(fields) < 2 {
+		return "", "", fmt.Errorf("usage: force <player> <command>")
+	}
+	commandText = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rawArgs), fields[0]))
+	return fields[0], commandText, nil
+}
+
+// Forceable reports whether commands routed to handler may be run on another
+// player's behalf.
+func Forceable(handler string) bool {
+	return !unforceable[handler]
+}
@@ -0,0 +1,28 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleForce(t *testing.T) {
+	target, text, err := HandleForce(" Vex  say  hello there ")
+	require.NoError(t, err)
+	assert.Equal(t, "Vex", target)
+	assert.Equal(t, "say  hello there", text)
+
+	for _, bad := range []string{"", "Vex", "   Vex   "} {
+		_, _, err = HandleForce(bad)
+		assert.EqualError(t, err, "usage: force <player> <command>", bad)
+	}
+}
+
+func TestForceable(t *testing.T) {
+	assert.True(t, Forceable(HandlerSay))
+	assert.True(t, Forceable(HandlerMove))
+	for _, h := range []string{HandlerQuit, HandlerSwitch, HandlerForce, HandlerHelp} {
+		assert.False(t, Forceable(h), h)
+	}
+}
@@ -0,0 +1,27 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formationUsage is the canonical usage string for the formation command.
+const formationUsage = "usage: formation [front|back] | formation <member> <front|back>"
+
+// HandleFormation parses the formation command. A bare row moves the player;
+// a party member's name before the row lets the party leader place them.
+//
+// Precondition: args are the words following "formation".
+// Postcondition: Returns row "front", "back", or "status" (a bare
+// "formation"), and the member named, or "" for the player themself;
+// returns a non-nil error otherwise.
+func HandleFormation(args []string) (member, row string, err error) {
+	if len(args) == 0 {
+		return "", "status", nil
+	}
+	row = strings.ToLower(args[len(args)-1])
+	if row != "front" && row != "back" && (row != "status" || len(args) > 1) {
+		return "", "", fmt.Errorf(formationUsage)
+	}
+	return strings.TrimSpace(strings.Join(args[:len(args)-1], " ")), row, nil
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleFormation(t *testing.T) {
+	cases := []struct {
+		args        []string
+		member, row string
+	}{
+		{nil, "", "status"},
+		{[]string{"status"}, "", "status"},
+		{[]string{"Back"}, "", "back"},
+		{[]string{"front"}, "", "front"},
+		{[]string{"Big", "Sal", "back"}, "Big Sal", "back"},
+	}
+	for _, tc := range cases {
+		member, row, err := HandleFormation(tc.args)
+		require.NoError(t, err, tc.args)
+		assert.Equal(t, tc.member, member, tc.args)
+		assert.Equal(t, tc.row, row, tc.args)
+	}
+	for _, bad := range [][]string{{"middle"}, {"sal", "status"}, {"sal"}} {
+		_, _, err := HandleFormation(bad)
+		assert.EqualError(t, err, formationUsage, bad)
+	}
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// gmUsage is the canonical usage string for the gm command.
+const gmUsage = "usage: gm freeze | gm unfreeze | gm heal <target> | gm damage <target> <amount> | gm desc <text> | gm desc clear | gm script <hook>"
+
+// GMRequest is the parsed form of the gm command.
+type GMRequest struct {
+	// Action is freeze, unfreeze, heal, damage, desc, or script.
+	Action string
+	// Target is the player or NPC to heal or damage.
+	Target string
+	// Amount is the damage to deal.
+	Amount int
+	// Text is the scene description, or the script hook to run. An empty
+	// desc Text restores the room's own description.
+	Text string
+}
+
+// HandleGM parses the gm event command.
+//
+// Precondition: rawArgs is the text following "gm".
+// Postcondition: Returns a request whose Action is always set, or an error
+// carrying the usage string.
+func HandleGM(rawArgs string) (GMRequest, error) {
+	word, rest, _ := strings.Cut(strings.TrimSpace(rawArgs), " ")
+	rest = strings.TrimSpace(rest)
+	switch sub := strings.ToLower(word); sub {
+	case "freeze", "unfreeze":
+		return GMRequest{Action: sub}, nil
+	case "heal":
+		if rest == "" {
+			return GMRequest{}, fmt.Errorf("%s", gmUsage)
+		}
+		return GMRequest{Action: sub, Target: rest}, nil
+	case "damage":
+		i := strings.LastIndex(rest, " ")
+		if i < 0 {
+			return GMRequest{}, fmt.Errorf("%s", gmUsage)
+		}
+		amount, err := strconv.Atoi(rest[i+1:])
+		if err != nil || amount <= 0 {
+			return GMRequest{}, fmt.Errorf("damage must be a positive number; %s", gmUsage)
+		}
+		return GMRequest{Action: sub, Target: strings.TrimSpace(rest[:i]), Amount: amount}, nil
+	case "desc":
+		if rest == "" {
+			return GMRequest{}, fmt.Errorf("%s", gmUsage)
+		}
+		if strings.EqualFold(rest, "clear") {
+			return GMRequest{Action: sub}, nil
+		}
+		return GMRequest{Action: sub, Text: rest}, nil
+	case "script":
+		if rest == "" || strings.ContainsAny(rest, " \t") {
+			return GMRequest{}, fmt.Errorf("%s", gmUsage)
+		}
+		return GMRequest{Action: sub, Text: rest}, nil
+	}
+	return GMRequest{}, fmt.Errorf("%s", gmUsage)
+}
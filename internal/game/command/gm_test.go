@@ -0,0 +1,29 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleGM(t *testing.T) {
+	cases := map[string]GMRequest{
+		"freeze":                      {Action: "freeze"},
+		"UNFREEZE":                    {Action: "unfreeze"},
+		"heal Vex":                    {Action: "heal", Target: "Vex"},
+		"damage ganger boss 12":       {Action: "damage", Target: "ganger boss", Amount: 12},
+		"desc Smoke pours from vents": {Action: "desc", Text: "Smoke pours from vents"},
+		"desc clear":                  {Action: "desc"},
+		"script start_raid":           {Action: "script", Text: "start_raid"},
+	}
+	for in, want := range cases {
+		got, err := HandleGM(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, bad := range []string{"", "dance", "heal", "damage Vex", "damage Vex lots", "damage Vex -3", "desc", "script", "script two words"} {
+		_, err := HandleGM(bad)
+		assert.Error(t, err, bad)
+	}
+}
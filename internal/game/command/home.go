@@ -0,0 +1,50 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// homeUsage is the canonical usage string for the home command.
+const homeUsage = "usage: home [rent|vacate|describe <text>|store <item> [qty]|take <item> [qty]]"
+
+// HandleHome parses the home command.
+//
+// Precondition: args are the words following "home".
+// Postcondition: Returns the lowercased action ("" for status), the target —
+// the description text for describe, the item name for store/take — and the
+// quantity (1 when omitted for store/take, 0 otherwise). Returns a non-nil
+// error for unknown actions, missing items, or non-positive quantities.
+func HandleHome(args []string) (action, target string, qty int, err error) {
+	if len(args) == 0 {
+		return "", "", 0, nil
+	}
+	action = strings.ToLower(args[0])
+	rest := args[1:]
+	switch action {
+	case "rent", "vacate":
+		if len(rest) != 0 {
+			return "", "", 0, fmt.Errorf(homeUsage)
+		}
+		return action, "", 0, nil
+	case "describe":
+		return action, strings.Join(rest, " "), 0, nil
+	case "store", "take":
+		qty = 1
+		if len(rest) > 1 {
+			if n, convErr := strconv.Atoi(rest[len(rest)-1]); convErr == nil {
+				if n < 1 {
+					return "", "", 0, fmt.Errorf("quantity must be at least 1")
+				}
+				qty = n
+				rest = rest[:len(rest)-1]
+			}
+		}
+		if len(rest) == 0 {
+			return "", "", 0, fmt.Errorf(homeUsage)
+		}
+		return action, strings.Join(rest, " "), qty, nil
+	}
+	return "", "", 0, fmt.Errorf(homeUsage)
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleHome(t *testing.T) {
+	cases := []struct {
+		args   []string
+		action string
+		target string
+		qty    int
+	}{
+		{nil, "", "", 0},
+		{[]string{"RENT"}, "rent", "", 0},
+		{[]string{"vacate"}, "vacate", "", 0},
+		{[]string{"describe", "A", "tidy", "flat."}, "describe", "A tidy flat.", 0},
+		{[]string{"describe"}, "describe", "", 0},
+		{[]string{"store", "stim", "pack"}, "store", "stim pack", 1},
+		{[]string{"take", "stim_pack", "3"}, "take", "stim_pack", 3},
+		{[]string{"store", "7"}, "store", "7", 1},
+	}
+	for _, c := range cases {
+		action, target, qty, err := HandleHome(c.args)
+		require.NoError(t, err, "%v", c.args)
+		assert.Equal(t, c.action, action, "%v", c.args)
+		assert.Equal(t, c.target, target, "%v", c.args)
+		assert.Equal(t, c.qty, qty, "%v", c.args)
+	}
+
+	for _, args := range [][]string{{"burn"}, {"rent", "now"}, {"store"}, {"take", "stim", "0"}} {
+		_, _, _, err := HandleHome(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+func TestProperty_HandleHome_StoreQuantity(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		item := rapid.StringMatching(`[a-z_]{1,12}`).Draw(rt, "item")
+		n := rapid.IntRange(1, 999).Draw(rt, "n")
+		action, target, qty, err := HandleHome([]string{"store", item, fmt.Sprint(n)})
+		if err != nil || action != "store" || target != item || qty != n {
+			rt.Fatalf("HandleHome(store %s %d) = %q, %q, %d, %v", item, n, action, target, qty, err)
+		}
+	})
+}
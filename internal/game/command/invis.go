@@ -0,0 +1,23 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HandleInvis parses the invis command.
+//
+// Precondition: args are the words following "invis".
+// Postcondition: Returns "on", "off", or "toggle"; a bare "invis" toggles.
+func HandleInvis(args []string) (string, error) {
+	if len(args) == 0 {
+		return "toggle", nil
+	}
+	if len(args) == 1 {
+		switch action := strings.ToLower(args[0]); action {
+		case "on", "off":
+			return action, nil
+		}
+	}
+	return "", fmt.Errorf("usage: invis [on|off]")
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleInvis(t *testing.T) {
+	for args, want := range map[string]string{"": "toggle", "on": "on", "OFF": "off"} {
+		var in []string
+		if args != "" {
+			in = []string{args}
+		}
+		got, err := HandleInvis(in)
+		require.NoError(t, err, args)
+		assert.Equal(t, want, got, args)
+	}
+	_, err := HandleInvis([]string{"maybe"})
+	assert.EqualError(t, err, "usage: invis [on|off]")
+}
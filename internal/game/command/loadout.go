@@ -83,6 +83,9 @@ func formatEquippedWeaponWithAffixes(ew *inventory.EquippedWeapon, reg *inventor
 		return "empty"
 	}
 	displayName := ew.Def.Name
+	if reg != nil {
+		displayName = reg.InstanceName(displayName, ew.CustomName, ew.Affixes)
+	}
 	// REQ-EM-18/19/20: prefix modifier.
 	switch ew.Modifier {
 	case "tuned":
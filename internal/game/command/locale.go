@@ -0,0 +1,28 @@
+package command
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// localeTagPattern matches simple BCP 47 style tags such as "en", "es", or "pt-br".
+var localeTagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})?$`)
+
+// HandleLocale validates and normalizes the requested locale tag.
+//
+// Precondition: args may be empty.
+// Postcondition: returns "" and nil when args is empty (a query for the current locale);
+// returns the lower-cased, hyphenated tag and nil when args[0] is well-formed;
+// returns "" and a non-nil error otherwise. Whether a catalog exists for the
+// tag is decided by the server.
+func HandleLocale(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	tag := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(args[0])), "_", "-")
+	if !localeTagPattern.MatchString(tag) {
+		return "", fmt.Errorf("invalid locale %q; usage: locale [code], e.g. locale es", args[0])
+	}
+	return tag, nil
+}
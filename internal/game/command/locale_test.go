@@ -0,0 +1,41 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleLocale_NoArgsIsQuery(t *testing.T) {
+	tag, err := HandleLocale(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", tag)
+}
+
+func TestHandleLocale_Normalizes(t *testing.T) {
+	tag, err := HandleLocale([]string{"PT_BR"})
+	require.NoError(t, err)
+	assert.Equal(t, "pt-br", tag)
+}
+
+func TestHandleLocale_RejectsMalformed(t *testing.T) {
+	_, err := HandleLocale([]string{"english!"})
+	assert.Error(t, err)
+}
+
+// TestProperty_HandleLocale_AcceptsTwoLetterCodes verifies any two-letter
+// code is accepted in either case.
+func TestProperty_HandleLocale_AcceptsTwoLetterCodes(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		code := rapid.StringMatching(`[a-zA-Z]{2}`).Draw(rt, "code")
+		tag, err := HandleLocale([]string{code})
+		if err != nil {
+			rt.Fatalf("unexpected error for %q: %v", code, err)
+		}
+		if len(tag) != 2 {
+			rt.Fatalf("unexpected tag %q", tag)
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HandleLog parses the log command.
+//
+// Precondition: args are the words following "log".
+// Postcondition: Returns "on", "off", or "status"; a bare "log" asks for status.
+func HandleLog(args []string) (string, error) {
+	if len(args) == 0 {
+		return "status", nil
+	}
+	if len(args) == 1 {
+		switch action := strings.ToLower(args[0]); action {
+		case "on", "off", "status":
+			return action, nil
+		}
+	}
+	return "", fmt.Errorf("usage: log [on|off|status]")
+}
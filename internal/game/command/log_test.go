@@ -0,0 +1,24 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleLog(t *testing.T) {
+	for args, want := range map[string]string{"": "status", "on": "on", "OFF": "off", "status": "status"} {
+		var in []string
+		if args != "" {
+			in = []string{args}
+		}
+		got, err := HandleLog(in)
+		require.NoError(t, err, args)
+		assert.Equal(t, want, got, args)
+	}
+	_, err := HandleLog([]string{"maybe"})
+	assert.EqualError(t, err, "usage: log [on|off|status]")
+	_, err = HandleLog([]string{"on", "now"})
+	assert.Error(t, err)
+}
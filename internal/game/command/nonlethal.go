@@ -0,0 +1,23 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HandleNonlethal parses the nonlethal command.
+//
+// Precondition: args are the words following "nonlethal".
+// Postcondition: Returns "on", "off", or "status"; a bare "nonlethal" asks for status.
+func HandleNonlethal(args []string) (string, error) {
+	if len(args) == 0 {
+		return "status", nil
+	}
+	if len(args) == 1 {
+		switch mode := strings.ToLower(args[0]); mode {
+		case "on", "off", "status":
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("usage: nonlethal [on|off|status]")
+}
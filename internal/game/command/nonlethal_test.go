@@ -0,0 +1,22 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleNonlethal(t *testing.T) {
+	for args, want := range map[string]string{"": "status", "ON": "on", "off": "off", "status": "status"} {
+		var in []string
+		if args != "" {
+			in = []string{args}
+		}
+		got, err := HandleNonlethal(in)
+		require.NoError(t, err, args)
+		assert.Equal(t, want, got, args)
+	}
+	_, err := HandleNonlethal([]string{"lethal"})
+	assert.EqualError(t, err, "usage: nonlethal [on|off|status]")
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// orderUsage is the canonical usage string for the order command.
+const orderUsage = "usage: order <companion> attack <target> | guard | fallback | use <item>"
+
+// HandleOrder parses the order command. The companion's name is every word
+// before the first order verb, so a companion may have a multi-word name.
+//
+// Precondition: args are the words following "order".
+// Postcondition: Returns a non-empty companion, an action of "attack",
+// "guard", "fallback", or "use", and the attack target or item named (empty
+// for guard and fallback); returns a non-nil error otherwise.
+func HandleOrder(args []string) (companion, action, arg string, err error) {
+	for i, word := range args {
+		verb := strings.ToLower(word)
+		if verb != "attack" && verb != "guard" && verb != "fallback" && verb != "use" {
+			continue
+		}
+		companion = strings.Join(args[:i], " ")
+		arg = strings.Join(args[i+1:], " ")
+		needsArg := verb == "attack" || verb == "use"
+		if companion == "" || needsArg != (arg != "") {
+			return "", "", "", fmt.Errorf(orderUsage)
+		}
+		return companion, verb, arg, nil
+	}
+	return "", "", "", fmt.Errorf(orderUsage)
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleOrder(t *testing.T) {
+	cases := []struct {
+		args                   []string
+		companion, action, arg string
+	}{
+		{[]string{"Sal", "guard"}, "Sal", "guard", ""},
+		{[]string{"Big", "Sal", "Fallback"}, "Big Sal", "fallback", ""},
+		{[]string{"sal", "attack", "Scav", "Boss"}, "sal", "attack", "Scav Boss"},
+		{[]string{"sal", "use", "stim", "patch"}, "sal", "use", "stim patch"},
+	}
+	for _, tc := range cases {
+		companion, action, arg, err := HandleOrder(tc.args)
+		require.NoError(t, err, tc.args)
+		assert.Equal(t, tc.companion, companion, tc.args)
+		assert.Equal(t, tc.action, action, tc.args)
+		assert.Equal(t, tc.arg, arg, tc.args)
+	}
+	for _, bad := range [][]string{nil, {"sal"}, {"guard"}, {"sal", "attack"}, {"sal", "use"}, {"sal", "guard", "boss"}} {
+		_, _, _, err := HandleOrder(bad)
+		assert.EqualError(t, err, orderUsage, bad)
+	}
+}
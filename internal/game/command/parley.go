@@ -0,0 +1,32 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parleyUsage is the canonical usage string for the parley command.
+const parleyUsage = "usage: parley <npc> [loot|interrogate|release]"
+
+// parleyOptions are the ways of dealing with a surrendered NPC.
+var parleyOptions = map[string]bool{"loot": true, "interrogate": true, "release": true}
+
+// HandleParley parses the parley command. The NPC name may span several
+// words; a trailing loot, interrogate, or release picks the option.
+//
+// Precondition: args are the words following "parley".
+// Postcondition: Returns a non-empty target and an option that is "" (list
+// the choices) or one of parleyOptions; returns a non-nil error otherwise.
+func HandleParley(args []string) (target, option string, err error) {
+	if len(args) > 1 {
+		if last := strings.ToLower(args[len(args)-1]); parleyOptions[last] {
+			option = last
+			args = args[:len(args)-1]
+		}
+	}
+	target = strings.TrimSpace(strings.Join(args, " "))
+	if target == "" {
+		return "", "", fmt.Errorf(parleyUsage)
+	}
+	return target, option, nil
+}
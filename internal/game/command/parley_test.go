@@ -0,0 +1,28 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleParley(t *testing.T) {
+	target, option, err := HandleParley([]string{"ganger"})
+	require.NoError(t, err)
+	assert.Equal(t, "ganger", target)
+	assert.Empty(t, option)
+
+	target, option, err = HandleParley([]string{"Highway", "Bandit", "Interrogate"})
+	require.NoError(t, err)
+	assert.Equal(t, "Highway Bandit", target)
+	assert.Equal(t, "interrogate", option)
+
+	target, option, err = HandleParley([]string{"release"})
+	require.NoError(t, err)
+	assert.Equal(t, "release", target, "a lone word is the NPC, not the option")
+	assert.Empty(t, option)
+
+	_, _, err = HandleParley(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pickUsage is the canonical usage string for the pick command.
+const pickUsage = "usage: pick <direction|item> | pick tension | pick rake | pick stop"
+
+// directionShorthand maps movement aliases to their direction names.
+var directionShorthand = map[string]string{
+	"n": "north", "s": "south", "e": "east", "w": "west",
+	"ne": "northeast", "nw": "northwest", "se": "southeast", "sw": "southwest",
+	"u": "up", "d": "down",
+}
+
+// PickRequest is the parsed form of the pick command.
+type PickRequest struct {
+	// Action is start, tension, rake, stop, or status.
+	Action string
+	// Target is the exit direction or room item to pick; set only for start.
+	Target string
+}
+
+// HandlePick parses the pick command. A single direction word, including its
+// shorthand, is expanded to the full direction name.
+//
+// Precondition: args are the words following "pick".
+// Postcondition: Returns a non-nil request; a bare "pick" asks for status.
+func HandlePick(args []string) (*PickRequest, error) {
+	if len(args) == 0 {
+		return &PickRequest{Action: "status"}, nil
+	}
+	first := strings.ToLower(args[0])
+	switch first {
+	case "tension", "rake", "stop", "status":
+		if len(args) != 1 {
+			return nil, fmt.Errorf(pickUsage)
+		}
+		return &PickRequest{Action: first}, nil
+	}
+	target := strings.Join(args, " ")
+	if len(args) == 1 {
+		if dir, ok := directionShorthand[first]; ok {
+			target = dir
+		} else if IsMovementCommand(first) {
+			target = first
+		}
+	}
+	return &PickRequest{Action: "start", Target: target}, nil
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePick_Status(t *testing.T) {
+	req, err := HandlePick(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "status", req.Action)
+}
+
+func TestHandlePick_Techniques(t *testing.T) {
+	for _, action := range []string{"tension", "RAKE", "stop"} {
+		req, err := HandlePick([]string{action})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"tension": "tension", "RAKE": "rake", "stop": "stop"}[action], req.Action)
+		assert.Empty(t, req.Target)
+	}
+	_, err := HandlePick([]string{"rake", "hard"})
+	assert.Error(t, err)
+}
+
+func TestHandlePick_ExpandsDirections(t *testing.T) {
+	req, err := HandlePick([]string{"n"})
+	require.NoError(t, err)
+	assert.Equal(t, &PickRequest{Action: "start", Target: "north"}, req)
+
+	req, err = HandlePick([]string{"Southwest"})
+	require.NoError(t, err)
+	assert.Equal(t, "southwest", req.Target)
+}
+
+func TestHandlePick_ItemTarget(t *testing.T) {
+	req, err := HandlePick([]string{"Evidence", "Locker"})
+	require.NoError(t, err)
+	assert.Equal(t, &PickRequest{Action: "start", Target: "Evidence Locker"}, req)
+}
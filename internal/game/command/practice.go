@@ -0,0 +1,23 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HandlePractice parses the practice command.
+//
+// Precondition: args are the words following "practice".
+// Postcondition: Returns "on", "off", or "status"; a bare "practice" asks for status.
+func HandlePractice(args []string) (string, error) {
+	if len(args) == 0 {
+		return "status", nil
+	}
+	if len(args) == 1 {
+		switch mode := strings.ToLower(args[0]); mode {
+		case "on", "off", "status":
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("usage: practice [on|off|status]")
+}
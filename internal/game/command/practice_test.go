@@ -0,0 +1,22 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePractice(t *testing.T) {
+	for args, want := range map[string]string{"": "status", "on": "on", "OFF": "off", "status": "status"} {
+		var in []string
+		if args != "" {
+			in = []string{args}
+		}
+		got, err := HandlePractice(in)
+		require.NoError(t, err, args)
+		assert.Equal(t, want, got, args)
+	}
+	_, err := HandlePractice([]string{"on", "off"})
+	assert.EqualError(t, err, "usage: practice [on|off|status]")
+}
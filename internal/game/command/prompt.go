@@ -0,0 +1,37 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// promptUsage is the canonical usage string for the prompt command.
+const promptUsage = "usage: prompt [set <format>|reset]"
+
+// HandlePrompt parses the prompt command from the raw text after the command word.
+//
+// Precondition: rawArgs may be empty.
+// Postcondition: show is true when rawArgs is empty (describe the prompt);
+// "set <format>" returns format with its inner spacing kept; "reset" returns
+// an empty format; anything else returns a non-nil error.
+func HandlePrompt(rawArgs string) (format string, show bool, err error) {
+	rawArgs = strings.TrimSpace(rawArgs)
+	if rawArgs == "" {
+		return "", true, nil
+	}
+	word, rest, _ := strings.Cut(rawArgs, " ")
+	switch strings.ToLower(word) {
+	case "reset":
+		if rest != "" {
+			return "", false, fmt.Errorf(promptUsage)
+		}
+		return "", false, nil
+	case "set":
+		format = strings.TrimSpace(rest)
+		if format == "" {
+			return "", false, fmt.Errorf(promptUsage)
+		}
+		return format, false, nil
+	}
+	return "", false, fmt.Errorf(promptUsage)
+}
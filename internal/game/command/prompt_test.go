@@ -0,0 +1,36 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePrompt_Show(t *testing.T) {
+	format, show, err := HandlePrompt("")
+	require.NoError(t, err)
+	assert.True(t, show)
+	assert.Empty(t, format)
+}
+
+func TestHandlePrompt_Set(t *testing.T) {
+	format, show, err := HandlePrompt("SET %hp/%maxhp  %ap %time>")
+	require.NoError(t, err)
+	assert.False(t, show)
+	assert.Equal(t, "%hp/%maxhp  %ap %time>", format)
+}
+
+func TestHandlePrompt_Reset(t *testing.T) {
+	format, show, err := HandlePrompt("reset")
+	require.NoError(t, err)
+	assert.False(t, show)
+	assert.Empty(t, format)
+}
+
+func TestHandlePrompt_Invalid(t *testing.T) {
+	for _, raw := range []string{"set", "set   ", "reset now", "colour red"} {
+		_, _, err := HandlePrompt(raw)
+		assert.Error(t, err, raw)
+	}
+}
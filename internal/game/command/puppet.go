@@ -0,0 +1,50 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// puppetUsage is the canonical usage string for the puppet command.
+const puppetUsage = "usage: puppet <npc> | puppet say <text> | puppet emote <text> | puppet attack <target> | puppet hold | puppet off"
+
+// PuppetRequest is the parsed form of the puppet command.
+type PuppetRequest struct {
+	// Action is control, release, say, emote, attack, hold, or status.
+	Action string
+	// Target is the NPC to control, or the combatant to attack.
+	Target string
+	// Text is what the NPC says or emotes.
+	Text string
+}
+
+// HandlePuppet parses the puppet command. A bare "puppet" asks for status.
+//
+// Precondition: rawArgs is the text following "puppet".
+// Postcondition: Returns a request whose Action is always set, or an error
+// when a subcommand is missing its argument.
+func HandlePuppet(rawArgs string) (PuppetRequest, error) {
+	rawArgs = strings.TrimSpace(rawArgs)
+	if rawArgs == "" {
+		return PuppetRequest{Action: "status"}, nil
+	}
+	word, rest, _ := strings.Cut(rawArgs, " ")
+	rest = strings.TrimSpace(rest)
+	switch sub := strings.ToLower(word); sub {
+	case "off", "release":
+		return PuppetRequest{Action: "release"}, nil
+	case "hold", "status":
+		return PuppetRequest{Action: sub}, nil
+	case "say", "emote":
+		if rest == "" {
+			return PuppetRequest{}, fmt.Errorf("%s", puppetUsage)
+		}
+		return PuppetRequest{Action: sub, Text: rest}, nil
+	case "attack", "kill":
+		if rest == "" {
+			return PuppetRequest{}, fmt.Errorf("%s", puppetUsage)
+		}
+		return PuppetRequest{Action: "attack", Target: rest}, nil
+	}
+	return PuppetRequest{Action: "control", Target: rawArgs}, nil
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePuppet(t *testing.T) {
+	cases := map[string]PuppetRequest{
+		"":                      {Action: "status"},
+		"ganger boss":           {Action: "control", Target: "ganger boss"},
+		"off":                   {Action: "release"},
+		"HOLD":                  {Action: "hold"},
+		"say  Pay up, chooms.":  {Action: "say", Text: "Pay up, chooms."},
+		"emote cracks knuckles": {Action: "emote", Text: "cracks knuckles"},
+		"kill Vex":              {Action: "attack", Target: "Vex"},
+	}
+	for in, want := range cases {
+		got, err := HandlePuppet(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, bad := range []string{"say", "emote  ", "attack"} {
+		_, err := HandlePuppet(bad)
+		assert.Error(t, err, bad)
+	}
+}
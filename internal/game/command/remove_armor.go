@@ -36,9 +36,14 @@ func HandleRemoveArmor(sess *session.PlayerSession, reg *inventory.Registry, arg
 		itemDefID = itemDef.ID
 	}
 
-	if _, err := sess.Backpack.Add(itemDefID, 1, reg); err != nil {
+	returned, err := sess.Backpack.Add(itemDefID, 1, reg)
+	if err != nil {
 		return fmt.Sprintf("Cannot remove %s: inventory full.", slotted.Name)
 	}
+	if mi := sess.Backpack.MutableItem(returned.InstanceID); mi != nil {
+		mi.Affixes = slotted.Affixes
+		mi.CustomName = slotted.CustomName
+	}
 
 	sess.Equipment.Armor[slot] = nil
 	return fmt.Sprintf("Removed %s.", slotted.Name)
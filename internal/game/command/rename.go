@@ -0,0 +1,32 @@
+package command
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// renameUsage is the canonical usage string for the rename command.
+const renameUsage = "usage: rename <character> <new name>"
+
+// Character name length bounds, matching character creation.
+const (
+	MinCharacterNameLen = 2
+	MaxCharacterNameLen = 32
+)
+
+// HandleRename parses an admin character rename command.
+//
+// Precondition: args are the words following "rename".
+// Postcondition: Returns the current and new character names; returns a
+// non-nil error when either is missing, extra words follow, or the new name
+// is outside MinCharacterNameLen..MaxCharacterNameLen characters.
+func HandleRename(args []string) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(renameUsage)
+	}
+	n := utf8.RuneCountInString(args[1])
+	if n < MinCharacterNameLen || n > MaxCharacterNameLen {
+		return "", "", fmt.Errorf("new name must be %d-%d characters", MinCharacterNameLen, MaxCharacterNameLen)
+	}
+	return args[0], args[1], nil
+}
@@ -0,0 +1,36 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleRename_Valid(t *testing.T) {
+	oldName, newName, err := HandleRename([]string{"Kira", "Vex"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kira", oldName)
+	assert.Equal(t, "Vex", newName)
+}
+
+func TestHandleRename_Invalid(t *testing.T) {
+	for _, args := range [][]string{nil, {"Kira"}, {"Kira", "V"}, {"Kira", "Vex", "Prime"}, {"Kira", strings.Repeat("x", 33)}} {
+		_, _, err := HandleRename(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+// TestProperty_HandleRename_LengthBounds verifies names are accepted exactly within the length bounds.
+func TestProperty_HandleRename_LengthBounds(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(0, 64).Draw(rt, "n")
+		_, _, err := HandleRename([]string{"old", strings.Repeat("a", n)})
+		valid := n >= MinCharacterNameLen && n <= MaxCharacterNameLen
+		if valid != (err == nil) {
+			rt.Fatalf("length %d: err=%v", n, err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxReportLength caps the text of a bug, typo, or idea report.
+const MaxReportLength = 1000
+
+// reportsUsage is the canonical usage string for the reports command.
+const reportsUsage = "usage: reports [all|bug|typo|idea] | reports show <id> | reports close <id>"
+
+// HandleReport parses a bug, typo, or idea report. kind is the command's
+// handler name and is used only in the usage message.
+//
+// Precondition: rawArgs is the text following the command.
+// Postcondition: Returns the trimmed report text; returns an error when it is
+// empty or longer than MaxReportLength.
+func HandleReport(kind, rawArgs string) (string, error) {
+	text := strings.TrimSpace(rawArgs)
+	if text == "" {
+		return "", fmt.Errorf("usage: %s <text>", kind)
+	}
+	if len(text) > MaxReportLength {
+		return "", fmt.Errorf("reports are limited to %d characters", MaxReportLength)
+	}
+	return text, nil
+}
+
+// ReportsRequest is the parsed form of the reports command.
+type ReportsRequest struct {
+	// Action is list, show, or close.
+	Action string
+	// Kind filters a listing to bug, typo, or idea reports; empty lists all kinds.
+	Kind string
+	// All includes closed reports in a listing.
+	All bool
+	// ID is the report to show or close.
+	ID int64
+}
+
+// HandleReports parses the reports review command.
+//
+// Precondition: args are the words following "reports".
+// Postcondition: Returns a non-nil request; a bare "reports" lists open reports.
+func HandleReports(args []string) (*ReportsRequest, error) {
+	if len(args) == 0 {
+		return &ReportsRequest{Action: "list"}, nil
+	}
+	first := strings.ToLower(args[0])
+	switch first {
+	case "show", "close":
+		if len(args) != 2 {
+			return nil, fmt.Errorf(reportsUsage)
+		}
+		id, err := strconv.ParseInt(strings.TrimPrefix(args[1], "#"), 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf(reportsUsage)
+		}
+		return &ReportsRequest{Action: first, ID: id}, nil
+	}
+	req := &ReportsRequest{Action: "list"}
+	for _, arg := range args {
+		switch a := strings.ToLower(arg); a {
+		case "all":
+			req.All = true
+		case HandlerBug, HandlerTypo, HandlerIdea:
+			if req.Kind != "" {
+				return nil, fmt.Errorf(reportsUsage)
+			}
+			req.Kind = a
+		default:
+			return nil, fmt.Errorf(reportsUsage)
+		}
+	}
+	return req, nil
+}
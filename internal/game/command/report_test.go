@@ -0,0 +1,54 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleReport(t *testing.T) {
+	text, err := HandleReport(HandlerTypo, "  'recieve' in the bank sign  ")
+	require.NoError(t, err)
+	assert.Equal(t, "'recieve' in the bank sign", text)
+
+	_, err = HandleReport(HandlerBug, "   ")
+	assert.EqualError(t, err, "usage: bug <text>")
+	_, err = HandleReport(HandlerIdea, strings.Repeat("x", MaxReportLength+1))
+	assert.EqualError(t, err, "reports are limited to 1000 characters")
+}
+
+func TestHandleReports(t *testing.T) {
+	cases := map[string]ReportsRequest{
+		"":          {Action: "list"},
+		"all":       {Action: "list", All: true},
+		"bug":       {Action: "list", Kind: "bug"},
+		"typo all":  {Action: "list", Kind: "typo", All: true},
+		"show 12":   {Action: "show", ID: 12},
+		"close #12": {Action: "close", ID: 12},
+	}
+	for in, want := range cases {
+		got, err := HandleReports(strings.Fields(in))
+		require.NoError(t, err, in)
+		assert.Equal(t, want, *got, in)
+	}
+	for _, in := range []string{"show", "close x", "show 0", "bug idea", "frogs"} {
+		_, err := HandleReports(strings.Fields(in))
+		assert.Error(t, err, in)
+	}
+}
+
+func TestProperty_HandleReport_TextWithinLimit(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		raw := rapid.String().Draw(rt, "raw")
+		text, err := HandleReport(HandlerBug, raw)
+		if err != nil {
+			return
+		}
+		if text == "" || len(text) > MaxReportLength {
+			rt.Fatalf("accepted report text %q", text)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// respecUsage explains the respec command's forms.
+const respecUsage = "Usage: respec <npc> [<ability> <+/-n> ... | reset | feat <old> to <new>]"
+
+// RespecRequest is a parsed respec command. Abilities holds point-buy
+// adjustments such as "grit +2 flair -2", or "reset"; OldFeat and NewFeat
+// name a feat swap. All but NPC are empty when the player asks for the menu.
+type RespecRequest struct {
+	NPC       string
+	Abilities string
+	OldFeat   string
+	NewFeat   string
+}
+
+// HandleRespec parses the respec command. The first word names the trainer;
+// "feat <old> to <new>" swaps a feat, and anything else is a point-buy
+// allocation.
+//
+// Precondition: args are the words following "respec".
+// Postcondition: Returns an error when no trainer is named or a feat swap
+// lacks " to ".
+func HandleRespec(args []string) (RespecRequest, error) {
+	if len(args) == 0 {
+		return RespecRequest{}, fmt.Errorf("%s", respecUsage)
+	}
+	req := RespecRequest{NPC: args[0]}
+	rest := args[1:]
+	if len(rest) > 0 && strings.EqualFold(rest[0], "feat") {
+		for i := 1; i < len(rest); i++ {
+			if strings.EqualFold(rest[i], "to") && i > 1 && i < len(rest)-1 {
+				req.OldFeat = strings.Join(rest[1:i], " ")
+				req.NewFeat = strings.Join(rest[i+1:], " ")
+				return req, nil
+			}
+		}
+		return RespecRequest{}, fmt.Errorf("Usage: respec <npc> feat <old> to <new>")
+	}
+	req.Abilities = strings.Join(rest, " ")
+	return req, nil
+}
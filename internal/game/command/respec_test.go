@@ -0,0 +1,37 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleRespec(t *testing.T) {
+	cases := []struct {
+		args []string
+		want RespecRequest
+	}{
+		{[]string{"groove"}, RespecRequest{NPC: "groove"}},
+		{[]string{"groove", "reset"}, RespecRequest{NPC: "groove", Abilities: "reset"}},
+		{[]string{"groove", "grit", "+2", "flair", "-2"}, RespecRequest{NPC: "groove", Abilities: "grit +2 flair -2"}},
+		{[]string{"groove", "feat", "power", "attack", "to", "sucker", "punch"}, RespecRequest{NPC: "groove", OldFeat: "power attack", NewFeat: "sucker punch"}},
+	}
+	for _, tc := range cases {
+		got, err := HandleRespec(tc.args)
+		require.NoError(t, err, tc.args)
+		assert.Equal(t, tc.want, got, tc.args)
+	}
+}
+
+func TestHandleRespec_Errors(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"groove", "feat", "brawler"},
+		{"groove", "feat", "to", "brawler"},
+		{"groove", "feat", "brawler", "to"},
+	} {
+		_, err := HandleRespec(args)
+		assert.Error(t, err, args)
+	}
+}
@@ -0,0 +1,25 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// scheduleUsage is the canonical usage string for the schedule command.
+const scheduleUsage = "usage: schedule [list] | schedule run <event>"
+
+// HandleSchedule parses the schedule command. A bare "schedule" lists events.
+//
+// Precondition: rawArgs is the text following "schedule".
+// Postcondition: Returns action "list" or "run" (with the event ID), or an
+// error carrying the usage string.
+func HandleSchedule(rawArgs string) (action, eventID string, err error) {
+	fields := strings.Fields(rawArgs)
+	switch {
+	case len(fields) == 0, len(fields) == 1 && strings.EqualFold(fields[0], "list"):
+		return "list", "", nil
+	case len(fields) == 2 && strings.EqualFold(fields[0], "run"):
+		return "run", fields[1], nil
+	}
+	return "", "", fmt.Errorf("%s", scheduleUsage)
+}
@@ -0,0 +1,26 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSchedule(t *testing.T) {
+	for _, in := range []string{"", "list", " LIST "} {
+		action, id, err := HandleSchedule(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, "list", action, in)
+		assert.Empty(t, id, in)
+	}
+	action, id, err := HandleSchedule("run double_xp_weekend")
+	require.NoError(t, err)
+	assert.Equal(t, "run", action)
+	assert.Equal(t, "double_xp_weekend", id)
+
+	for _, bad := range []string{"run", "run a b", "list all", "stop a"} {
+		_, _, err := HandleSchedule(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// settingsUsage is the canonical usage string for the settings command.
+const settingsUsage = "usage: settings [theme <name>|accessible <on|off>|mute <channel>|unmute <channel>|pager <auto|off|lines>]"
+
+// settingsKeys lists the settings that may be changed by name.
+var settingsKeys = map[string]bool{"theme": true, "accessible": true, "mute": true, "unmute": true, "pager": true}
+
+// HandleSettings parses the account settings command.
+//
+// Precondition: args may be empty.
+// Postcondition: returns empty key and value when args is empty (list settings);
+// returns the lower-cased key and its value when args name a known setting with
+// exactly one value; returns a non-nil error otherwise. Whether the value is
+// acceptable is decided by the server.
+func HandleSettings(args []string) (string, string, error) {
+	if len(args) == 0 {
+		return "", "", nil
+	}
+	key := strings.ToLower(args[0])
+	if !settingsKeys[key] || len(args) != 2 {
+		return "", "", fmt.Errorf(settingsUsage)
+	}
+	return key, args[1], nil
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSettings_List(t *testing.T) {
+	key, value, err := HandleSettings(nil)
+	require.NoError(t, err)
+	assert.Empty(t, key)
+	assert.Empty(t, value)
+}
+
+func TestHandleSettings_Change(t *testing.T) {
+	key, value, err := HandleSettings([]string{"Theme", "dark"})
+	require.NoError(t, err)
+	assert.Equal(t, "theme", key)
+	assert.Equal(t, "dark", value)
+
+	key, value, err = HandleSettings([]string{"pager", "40"})
+	require.NoError(t, err)
+	assert.Equal(t, "pager", key)
+	assert.Equal(t, "40", value)
+}
+
+func TestHandleSettings_Invalid(t *testing.T) {
+	for _, args := range [][]string{{"color", "red"}, {"theme"}, {"mute", "say", "emote"}} {
+		_, _, err := HandleSettings(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// sharedStashUsage is the canonical usage string for the shared command.
+const sharedStashUsage = "usage: shared [deposit|withdraw <amount>]"
+
+// HandleSharedStash parses the shared stash command.
+//
+// Precondition: args are the words following "shared".
+// Postcondition: Returns an empty action to show the balance, or "deposit" or
+// "withdraw" with a positive amount; returns a non-nil error otherwise.
+func HandleSharedStash(args []string) (string, int, error) {
+	if len(args) == 0 {
+		return "", 0, nil
+	}
+	action := strings.ToLower(args[0])
+	if (action != "deposit" && action != "withdraw") || len(args) != 2 {
+		return "", 0, fmt.Errorf(sharedStashUsage)
+	}
+	amount, err := strconv.Atoi(args[1])
+	if err != nil || amount < 1 {
+		return "", 0, fmt.Errorf("amount must be a positive number")
+	}
+	return action, amount, nil
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSharedStash_Valid(t *testing.T) {
+	action, amount, err := HandleSharedStash(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", action)
+	assert.Zero(t, amount)
+
+	action, amount, err = HandleSharedStash([]string{"Deposit", "25"})
+	require.NoError(t, err)
+	assert.Equal(t, "deposit", action)
+	assert.Equal(t, 25, amount)
+
+	action, _, err = HandleSharedStash([]string{"withdraw", "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "withdraw", action)
+}
+
+func TestHandleSharedStash_Invalid(t *testing.T) {
+	for _, args := range [][]string{{"deposit"}, {"deposit", "0"}, {"withdraw", "-3"}, {"withdraw", "lots"}, {"steal", "5"}, {"deposit", "5", "more"}} {
+		_, _, err := HandleSharedStash(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
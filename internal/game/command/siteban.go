@@ -0,0 +1,42 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// siteBanUsage is the canonical usage string for the siteban command.
+const siteBanUsage = "usage: siteban list | add <cidr> [reason] | allow <cidr> [reason] | remove <cidr>"
+
+// HandleSiteBan parses an admin site ban command.
+//
+// Precondition: args are the words following "siteban".
+// Postcondition: Returns the lower-cased action ("list" when args is empty),
+// the address range for every action but list, and the reason joined from
+// the remaining words for add and allow; returns a non-nil error on an
+// unknown action or wrong number of arguments. The range itself is validated
+// by the server.
+func HandleSiteBan(args []string) (action, cidr, reason string, err error) {
+	if len(args) == 0 {
+		return "list", "", "", nil
+	}
+	action = strings.ToLower(args[0])
+	switch action {
+	case "list":
+		if len(args) != 1 {
+			return "", "", "", fmt.Errorf(siteBanUsage)
+		}
+		return action, "", "", nil
+	case "add", "allow":
+		if len(args) < 2 {
+			return "", "", "", fmt.Errorf(siteBanUsage)
+		}
+		return action, args[1], strings.Join(args[2:], " "), nil
+	case "remove":
+		if len(args) != 2 {
+			return "", "", "", fmt.Errorf(siteBanUsage)
+		}
+		return action, args[1], "", nil
+	}
+	return "", "", "", fmt.Errorf(siteBanUsage)
+}
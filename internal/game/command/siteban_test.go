@@ -0,0 +1,53 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleSiteBan_Actions(t *testing.T) {
+	action, cidr, reason, err := HandleSiteBan(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "list", action)
+	assert.Empty(t, cidr)
+
+	action, cidr, reason, err = HandleSiteBan([]string{"ADD", "10.0.0.0/8", "repeated", "spam"})
+	require.NoError(t, err)
+	assert.Equal(t, "add", action)
+	assert.Equal(t, "10.0.0.0/8", cidr)
+	assert.Equal(t, "repeated spam", reason)
+
+	action, cidr, reason, err = HandleSiteBan([]string{"allow", "10.1.2.3"})
+	require.NoError(t, err)
+	assert.Equal(t, "allow", action)
+	assert.Equal(t, "10.1.2.3", cidr)
+	assert.Empty(t, reason)
+
+	action, cidr, _, err = HandleSiteBan([]string{"remove", "10.0.0.0/8"})
+	require.NoError(t, err)
+	assert.Equal(t, "remove", action)
+	assert.Equal(t, "10.0.0.0/8", cidr)
+}
+
+func TestHandleSiteBan_Invalid(t *testing.T) {
+	for _, args := range [][]string{{"add"}, {"allow"}, {"remove"}, {"remove", "a", "b"}, {"list", "x"}, {"ban", "10.0.0.1"}} {
+		_, _, _, err := HandleSiteBan(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+// TestProperty_HandleSiteBan_ReasonRoundTrips verifies every word after the
+// range is kept, in order, as the reason.
+func TestProperty_HandleSiteBan_ReasonRoundTrips(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		words := rapid.SliceOf(rapid.StringMatching(`[a-z]{1,8}`)).Draw(rt, "words")
+		_, _, reason, err := HandleSiteBan(append([]string{"add", "10.0.0.1"}, words...))
+		if err != nil || reason != strings.Join(words, " ") {
+			rt.Fatalf("got (%q, %v) for %v", reason, err, words)
+		}
+	})
+}
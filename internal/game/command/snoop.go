@@ -0,0 +1,27 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// snoopUsage is the canonical usage string for the snoop command.
+const snoopUsage = "usage: snoop <player> <reason> | snoop off"
+
+// HandleSnoop parses the snoop command. A reason is required so every snoop
+// is recorded with why it was started.
+//
+// Precondition: rawArgs is the text following "snoop".
+// Postcondition: Returns an empty target for "snoop off" or a bare "snoop",
+// which stop snooping; otherwise a target and a non-empty reason.
+func HandleSnoop(rawArgs string) (target, reason string, err error) {
+	fields := strings.Fields(rawArgs)
+	if len(fields) == 0 || (len(fields) == 1 && strings.EqualFold(fields[0], "off")) {
+		return "", "", nil
+	}
+	if len(fields) < 2 {
+		return "", "", fmt.Errorf("%s", snoopUsage)
+	}
+	reason = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rawArgs), fields[0]))
+	return fields[0], reason, nil
+}
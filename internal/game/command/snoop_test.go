@@ -0,0 +1,24 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSnoop(t *testing.T) {
+	target, reason, err := HandleSnoop("  Vex   spamming the market  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Vex", target)
+	assert.Equal(t, "spamming the market", reason)
+
+	for _, stop := range []string{"", "off", " OFF "} {
+		target, _, err = HandleSnoop(stop)
+		require.NoError(t, err, stop)
+		assert.Empty(t, target, stop)
+	}
+
+	_, _, err = HandleSnoop("Vex")
+	assert.EqualError(t, err, "usage: snoop <player> <reason> | snoop off")
+}
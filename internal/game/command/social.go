@@ -0,0 +1,31 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// socialUsage is the canonical usage string for the social command.
+const socialUsage = "usage: social <npc> | social <persuade|intimidate|deceive|pass|leave>"
+
+// socialActions are the moves available inside a social encounter.
+var socialActions = map[string]bool{
+	"persuade": true, "intimidate": true, "deceive": true, "pass": true, "leave": true,
+}
+
+// HandleSocial parses the social command. A leading persuade, intimidate,
+// deceive, pass, or leave is a move in the encounter under way; anything
+// else names the NPC to start an encounter with.
+//
+// Precondition: args are the words following "social".
+// Postcondition: Exactly one of action and target is non-empty; returns a
+// non-nil error when args are empty.
+func HandleSocial(args []string) (action, target string, err error) {
+	if len(args) == 0 {
+		return "", "", fmt.Errorf(socialUsage)
+	}
+	if first := strings.ToLower(args[0]); socialActions[first] {
+		return first, "", nil
+	}
+	return "", strings.Join(args, " "), nil
+}
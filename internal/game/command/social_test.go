@@ -0,0 +1,27 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSocial(t *testing.T) {
+	action, target, err := HandleSocial([]string{"Highway", "Bandit"})
+	require.NoError(t, err)
+	assert.Empty(t, action)
+	assert.Equal(t, "Highway Bandit", target)
+
+	action, target, err = HandleSocial([]string{"Intimidate"})
+	require.NoError(t, err)
+	assert.Equal(t, "intimidate", action)
+	assert.Empty(t, target)
+
+	action, _, err = HandleSocial([]string{"pass"})
+	require.NoError(t, err)
+	assert.Equal(t, "pass", action)
+
+	_, _, err = HandleSocial(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StealRequest is the parsed form of the steal command.
+type StealRequest struct {
+	// Item names what to lift; it may span several words.
+	Item string
+	// Target names the NPC whose pocket is picked.
+	Target string
+}
+
+// HandleSteal parses the arguments for "steal <item> from <npc>". The last
+// "from" separates the item from the target, so item names may contain it.
+//
+// Precondition: args is the slice of words following "steal" (may be empty).
+// Postcondition: Returns a request with non-empty Item and Target, or a usage
+// error.
+func HandleSteal(args []string) (*StealRequest, error) {
+	for i := len(args) - 1; i > 0; i-- {
+		if !strings.EqualFold(args[i], "from") {
+			continue
+		}
+		item := strings.Join(args[:i], " ")
+		target := strings.Join(args[i+1:], " ")
+		if item == "" || target == "" {
+			break
+		}
+		return &StealRequest{Item: item, Target: target}, nil
+	}
+	return nil, fmt.Errorf("usage: steal <item> from <npc>")
+}
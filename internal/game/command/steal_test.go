@@ -0,0 +1,44 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleSteal_ItemFromTarget(t *testing.T) {
+	req, err := HandleSteal([]string{"stim", "pack", "from", "scav"})
+	require.NoError(t, err)
+	assert.Equal(t, "stim pack", req.Item)
+	assert.Equal(t, "scav", req.Target)
+}
+
+func TestHandleSteal_SplitsOnLastFrom(t *testing.T) {
+	req, err := HandleSteal([]string{"letter", "from", "home", "FROM", "courier"})
+	require.NoError(t, err)
+	assert.Equal(t, "letter from home", req.Item)
+	assert.Equal(t, "courier", req.Target)
+}
+
+func TestHandleSteal_Usage(t *testing.T) {
+	for _, args := range [][]string{nil, {"wallet"}, {"from", "scav"}, {"wallet", "from"}, {"wallet", "scav"}} {
+		_, err := HandleSteal(args)
+		assert.Error(t, err, "args %v", args)
+	}
+}
+
+func TestProperty_HandleSteal_RoundTrips(t *testing.T) {
+	word := rapid.StringMatching(`[a-z]{1,8}`).Filter(func(s string) bool { return s != "from" })
+	rapid.Check(t, func(rt *rapid.T) {
+		item := rapid.SliceOfN(word, 1, 4).Draw(rt, "item")
+		target := rapid.SliceOfN(word, 1, 3).Draw(rt, "target")
+		args := append(append(append([]string{}, item...), "from"), target...)
+		req, err := HandleSteal(args)
+		require.NoError(rt, err)
+		assert.Equal(rt, strings.Join(item, " "), req.Item)
+		assert.Equal(rt, strings.Join(target, " "), req.Target)
+	})
+}
@@ -0,0 +1,16 @@
+package command
+
+import "strings"
+
+// HandleTitle parses the title command.
+//
+// Precondition: args are the words following "title".
+// Postcondition: Returns clear true for "title none"; otherwise returns the
+// requested title with its words joined by single spaces ("" lists earned titles).
+func HandleTitle(args []string) (title string, clear bool) {
+	title = strings.Join(args, " ")
+	if strings.EqualFold(title, "none") {
+		return "", true
+	}
+	return title, false
+}
@@ -0,0 +1,41 @@
+package command_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+)
+
+func TestHandleTitle(t *testing.T) {
+	title, clear := command.HandleTitle(nil)
+	assert.Equal(t, "", title)
+	assert.False(t, clear)
+
+	title, clear = command.HandleTitle([]string{"the", "Cartographer"})
+	assert.Equal(t, "the Cartographer", title)
+	assert.False(t, clear)
+
+	title, clear = command.HandleTitle([]string{"NONE"})
+	assert.Equal(t, "", title)
+	assert.True(t, clear)
+}
+
+func TestProperty_HandleTitle_JoinsWords(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		words := rapid.SliceOfN(rapid.StringMatching(`[A-Za-z]{1,8}`), 1, 4).Draw(rt, "words")
+		title, clear := command.HandleTitle(words)
+		if len(words) == 1 && strings.EqualFold(words[0], "none") {
+			if !clear || title != "" {
+				rt.Fatalf("none must clear, got %q %v", title, clear)
+			}
+			return
+		}
+		if clear || title != strings.Join(words, " ") {
+			rt.Fatalf("got %q %v for %v", title, clear, words)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/stats"
+)
+
+// HandleTop parses the top command.
+//
+// Precondition: args are the words following "top".
+// Postcondition: Returns the requested stat, or a non-nil usage error when the
+// stat is missing or unknown.
+func HandleTop(args []string) (stats.Stat, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("usage: top <kills|deaths|damage|explored|playtime>")
+	}
+	return stats.Parse(args[0])
+}
@@ -0,0 +1,35 @@
+package command_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/stats"
+)
+
+func TestHandleTop(t *testing.T) {
+	st, err := command.HandleTop([]string{"Kills"})
+	require.NoError(t, err)
+	assert.Equal(t, stats.Kills, st)
+
+	_, err = command.HandleTop(nil)
+	assert.ErrorContains(t, err, "usage: top")
+	_, err = command.HandleTop([]string{"kills", "deaths"})
+	assert.ErrorContains(t, err, "usage: top")
+	_, err = command.HandleTop([]string{"gold"})
+	assert.ErrorContains(t, err, "unknown stat")
+}
+
+func TestProperty_HandleTop_AcceptsEveryStat(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		want := rapid.SampledFrom(stats.All).Draw(rt, "stat")
+		got, err := command.HandleTop([]string{string(want)})
+		if err != nil || got != want {
+			rt.Fatalf("HandleTop(%q) = %q, %v", want, got, err)
+		}
+	})
+}
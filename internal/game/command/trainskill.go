@@ -14,15 +14,36 @@ var ValidSkillIDs = []string{
 	"hustle", "smooth_talk", "hard_look", "rep",
 }
 
+// WeaponCategoryIDs lists the weapon proficiency categories a skill increase
+// can also be spent on.
+var WeaponCategoryIDs = []string{
+	"simple_weapons", "simple_ranged", "martial_weapons", "martial_ranged",
+	"martial_melee", "unarmed", "specialized",
+}
+
 var validSkillSet = func() map[string]bool {
-	m := make(map[string]bool, len(ValidSkillIDs))
+	m := make(map[string]bool, len(ValidSkillIDs)+len(WeaponCategoryIDs))
 	for _, s := range ValidSkillIDs {
 		m[s] = true
 	}
+	for _, c := range WeaponCategoryIDs {
+		m[c] = true
+	}
 	return m
 }()
 
-// HandleTrainSkill validates a trainskill command argument.
+// IsWeaponCategory reports whether id names a weapon proficiency category.
+func IsWeaponCategory(id string) bool {
+	for _, c := range WeaponCategoryIDs {
+		if c == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleTrainSkill validates a trainskill command argument: a skill or a
+// weapon proficiency category.
 //
 // Precondition: args contains the raw arguments after the command name.
 // Postcondition: returns the normalized skill ID on success;
@@ -30,11 +51,12 @@ var validSkillSet = func() map[string]bool {
 // returns an error with "unknown skill" if the skill ID is invalid.
 func HandleTrainSkill(args []string) (string, error) {
 	if len(args) == 0 {
-		return "", fmt.Errorf("usage: trainskill <skill>  (e.g. trainskill parkour)")
+		return "", fmt.Errorf("usage: trainskill <skill|weapon category>  (e.g. trainskill parkour)")
 	}
 	skillID := strings.ToLower(strings.TrimSpace(args[0]))
 	if !validSkillSet[skillID] {
-		return "", fmt.Errorf("unknown skill %q; valid skills: %s", skillID, strings.Join(ValidSkillIDs, ", "))
+		return "", fmt.Errorf("unknown skill %q; valid skills: %s; weapon categories: %s",
+			skillID, strings.Join(ValidSkillIDs, ", "), strings.Join(WeaponCategoryIDs, ", "))
 	}
 	return skillID, nil
 }
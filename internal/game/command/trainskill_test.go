@@ -34,6 +34,16 @@ func TestHandleTrainSkill_AllValidSkills(t *testing.T) {
 	}
 }
 
+func TestHandleTrainSkill_WeaponCategories(t *testing.T) {
+	for _, cat := range command.WeaponCategoryIDs {
+		id, err := command.HandleTrainSkill([]string{cat})
+		assert.NoError(t, err)
+		assert.Equal(t, cat, id)
+		assert.True(t, command.IsWeaponCategory(cat))
+	}
+	assert.False(t, command.IsWeaponCategory("parkour"))
+}
+
 func TestPropertyHandleTrainSkill_ValidAlwaysSucceeds(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
 		skill := rapid.SampledFrom(command.ValidSkillIDs).Draw(rt, "skill")
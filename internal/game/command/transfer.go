@@ -0,0 +1,18 @@
+package command
+
+import "fmt"
+
+// transferUsage is the canonical usage string for the transfer command.
+const transferUsage = "usage: transfer <character> <username>"
+
+// HandleTransferCharacter parses an admin character transfer command.
+//
+// Precondition: args are the words following "transfer".
+// Postcondition: Returns the character name and the username of the account
+// to move it to; returns a non-nil error unless exactly both are given.
+func HandleTransferCharacter(args []string) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(transferUsage)
+	}
+	return args[0], args[1], nil
+}
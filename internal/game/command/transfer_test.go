@@ -0,0 +1,22 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTransferCharacter_Valid(t *testing.T) {
+	name, username, err := HandleTransferCharacter([]string{"Kira", "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kira", name)
+	assert.Equal(t, "alice", username)
+}
+
+func TestHandleTransferCharacter_Invalid(t *testing.T) {
+	for _, args := range [][]string{nil, {"Kira"}, {"Kira", "alice", "bob"}} {
+		_, _, err := HandleTransferCharacter(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
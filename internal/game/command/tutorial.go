@@ -0,0 +1,24 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tutorialUsage is the canonical usage string for the tutorial command.
+const tutorialUsage = "usage: tutorial [skip]"
+
+// HandleTutorial parses the tutorial command.
+//
+// Precondition: args are the words following "tutorial".
+// Postcondition: Returns skip=true for "tutorial skip" and false for a bare
+// "tutorial"; returns a non-nil error for anything else.
+func HandleTutorial(args []string) (bool, error) {
+	switch {
+	case len(args) == 0:
+		return false, nil
+	case len(args) == 1 && strings.EqualFold(args[0], "skip"):
+		return true, nil
+	}
+	return false, fmt.Errorf(tutorialUsage)
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestHandleTutorial(t *testing.T) {
+	skip, err := HandleTutorial(nil)
+	require.NoError(t, err)
+	assert.False(t, skip)
+
+	skip, err = HandleTutorial([]string{"SKIP"})
+	require.NoError(t, err)
+	assert.True(t, skip)
+
+	for _, args := range [][]string{{"later"}, {"skip", "now"}} {
+		_, err := HandleTutorial(args)
+		assert.Error(t, err, "%v", args)
+	}
+}
+
+func TestProperty_HandleTutorial_OnlySkipIsAccepted(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		word := rapid.StringMatching(`[a-zA-Z]{1,8}`).Draw(rt, "word")
+		skip, err := HandleTutorial([]string{word})
+		if strings.EqualFold(word, "skip") {
+			if err != nil || !skip {
+				rt.Fatalf("HandleTutorial(%q) = %v, %v", word, skip, err)
+			}
+			return
+		}
+		if err == nil {
+			rt.Fatalf("HandleTutorial(%q) accepted", word)
+		}
+	})
+}
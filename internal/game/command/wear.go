@@ -78,11 +78,16 @@ func HandleWear(sess *session.PlayerSession, reg *inventory.Registry, arg string
 			_, _ = sess.Backpack.Add(itemID, 1, reg)
 			return fmt.Sprintf("Cannot find item definition for %s to return to inventory.", prev.Name)
 		}
-		if _, err := sess.Backpack.Add(prevItemDef.ID, 1, reg); err != nil {
+		returned, err := sess.Backpack.Add(prevItemDef.ID, 1, reg)
+		if err != nil {
 			// Rollback: restore the item that was removed.
 			_, _ = sess.Backpack.Add(itemID, 1, reg)
 			return fmt.Sprintf("Inventory full: cannot unequip previous %s.", prev.Name)
 		}
+		if mi := sess.Backpack.MutableItem(returned.InstanceID); mi != nil {
+			mi.Affixes = prev.Affixes
+			mi.CustomName = prev.CustomName
+		}
 	}
 
 	// Store the ArmorDef ID in ItemDefID so ComputedDefenses can resolve it.
@@ -97,6 +102,8 @@ func HandleWear(sess *session.PlayerSession, reg *inventory.Registry, arg string
 		Durability:                inst.Durability,
 		AffixedMaterials:          inst.AffixedMaterials,
 		MaterialMaxDurabilityBonus: inst.MaterialMaxDurabilityBonus,
+		Affixes:                   inst.Affixes,
+		CustomName:                inst.CustomName,
 	}
 	// If durability has the uninitialized sentinel (-1), treat it as full.
 	if slotted.Durability < 0 {
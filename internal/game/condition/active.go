@@ -187,6 +187,25 @@ func (s *ActiveSet) Remove(uid, id string) {
 	}
 }
 
+// Reduce removes n stacks of condition id, removing the condition entirely
+// when no stacks remain. If the condition is not present, Reduce is a no-op.
+//
+// Precondition: n > 0.
+// Postcondition: Returns the stacks left; Has(id) is false when it returns 0.
+func (s *ActiveSet) Reduce(uid, id string, n int) int {
+	ac, ok := s.conditions[id]
+	if !ok {
+		return 0
+	}
+	if ac.Stacks-n <= 0 {
+		s.Remove(uid, id)
+		return 0
+	}
+	ac.Stacks -= n
+	s.syncConditionEffect(uid, ac.Def, ac.Stacks)
+	return ac.Stacks
+}
+
 // Tick decrements the DurationRemaining of all "rounds"-type conditions by 1.
 // Conditions that reach 0 are removed. "permanent" and "until_save" conditions
 // (DurationRemaining == -1) are not affected.
@@ -235,10 +254,11 @@ func (s *ActiveSet) Stacks(id string) int {
 // ClearEncounter removes all conditions with DurationType == "encounter".
 // Called at the end of combat to clear temporary combat-only conditions.
 // Precondition: s must not be nil.
-// Postcondition: all encounter-duration conditions are removed; other conditions unchanged.
+// Postcondition: all encounter-duration conditions, and "until_save" conditions
+// shaken off by a recovery save, are removed; other conditions unchanged.
 func (s *ActiveSet) ClearEncounter() {
 	for id, ac := range s.conditions {
-		if ac.Def.DurationType == "encounter" {
+		if ac.Def.DurationType == "encounter" || (ac.Def.DurationType == "until_save" && ac.Def.RecoverySave != nil) {
 			delete(s.conditions, id)
 			s.effects.RemoveBySource("condition:" + id)
 		}
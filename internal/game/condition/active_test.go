@@ -370,3 +370,27 @@ func TestProperty_CopyTo_AllConditionsPresent(t *testing.T) {
 		}
 	})
 }
+
+func TestActiveSet_Reduce_DecrementsAndRemoves(t *testing.T) {
+	s := condition.NewActiveSet()
+	require.NoError(t, s.Apply("uid", dying(), 3, -1))
+
+	assert.Equal(t, 2, s.Reduce("uid", "dying", 1))
+	assert.Equal(t, 2, s.Stacks("dying"))
+	assert.Equal(t, 0, s.Reduce("uid", "dying", 5))
+	assert.False(t, s.Has("dying"))
+	assert.Equal(t, 0, s.Reduce("uid", "dying", 1), "reducing an absent condition is a no-op")
+}
+
+func TestClearEncounter_RemovesRecoverableUntilSaveConditions(t *testing.T) {
+	s := condition.NewActiveSet()
+	shaken := &condition.ConditionDef{ID: "shaken", Name: "Shaken", DurationType: "until_save", MaxStacks: 3,
+		RecoverySave: &condition.RecoverySave{Save: "cool", DC: 15}}
+	require.NoError(t, s.Apply("uid", shaken, 2, -1))
+	require.NoError(t, s.Apply("uid", dying(), 1, -1))
+
+	s.ClearEncounter()
+
+	assert.False(t, s.Has("shaken"), "until_save condition with a recovery save ends with the encounter")
+	assert.True(t, s.Has("dying"), "until_save condition without a recovery save remains")
+}
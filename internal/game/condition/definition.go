@@ -42,6 +42,9 @@ type ConditionDef struct {
 	// MoveAPCost is the additional AP cost imposed on the bearer when they move.
 	// Only meaningful for terrain conditions (ID prefix "terrain_"). Default 0 = no extra cost.
 	MoveAPCost int `yaml:"move_ap_cost"`
+	// MoveAPPenalty is the extra AP, per stack, charged for each AP the bearer
+	// spends moving in combat (advance, retreat, step). Default 0 = no extra cost.
+	MoveAPPenalty int `yaml:"move_ap_penalty"`
 	// SkillPenalties maps canonical skill IDs (lowercase, underscore-separated, e.g. "flair", "savvy")
 	// to per-skill penalties applied while this condition is active. Applied in addition to SkillPenalty:
 	// total_penalty = SkillPenalty + SkillPenalties[skillID].
@@ -71,12 +74,60 @@ type ConditionDef struct {
 	MaxSeverity       int  `yaml:"max_severity,omitempty"`
 	Stage             int  `yaml:"stage,omitempty"`
 	MaxStage          int  `yaml:"max_stage,omitempty"`
+	// RecoverySave, when set on an "until_save" condition, is the saving throw
+	// the bearer rolls at the end of each combat round to shake it off.
+	RecoverySave *RecoverySave `yaml:"recovery_save,omitempty"`
+	// TempHP is the temporary hit points granted to a combatant when this
+	// condition is applied in combat. 0 means the condition grants none.
+	TempHP int `yaml:"temp_hp,omitempty"`
+	// Resistances maps damage type → flat reduction granted while this
+	// condition is active in combat.
+	Resistances map[string]int `yaml:"resistances,omitempty"`
+	// Weaknesses maps damage type → flat addition imposed while this
+	// condition is active in combat.
+	Weaknesses map[string]int `yaml:"weaknesses,omitempty"`
+	// Immunities lists damage types the bearer takes no damage from while
+	// this condition is active in combat.
+	Immunities []string `yaml:"immunities,omitempty"`
 	// Bonuses is the authoritative typed-bonus list. When non-empty, flat bonus fields
 	// (AttackBonus, AttackPenalty, ACBonus, ACPenalty, etc.) MUST NOT also be set (DEDUP-11).
 	// When absent, flat fields are synthesised as untyped bonuses at load time via SynthesiseBonuses.
 	Bonuses []effect.Bonus `yaml:"bonuses,omitempty"`
 }
 
+// RecoverySave is the end-of-round saving throw that ends an "until_save"
+// condition. A success removes one stack, a critical success removes the
+// condition, and a failure leaves it unchanged.
+type RecoverySave struct {
+	// Save is the saving throw rolled: "toughness", "hustle", or "cool".
+	Save string `yaml:"save"`
+	// DC is the difficulty class the save is rolled against.
+	DC int `yaml:"dc"`
+}
+
+// validateRecoverySave checks d's recovery save, if any, is complete.
+//
+// Postcondition: Returns a non-nil error when the save is set on a condition
+// that is not "until_save", names an unknown save, or has a non-positive DC.
+func (d *ConditionDef) validateRecoverySave() error {
+	rs := d.RecoverySave
+	if rs == nil {
+		return nil
+	}
+	if d.DurationType != "until_save" {
+		return fmt.Errorf("condition %q: recovery_save requires duration_type until_save", d.ID)
+	}
+	switch rs.Save {
+	case "toughness", "hustle", "cool":
+	default:
+		return fmt.Errorf("condition %q: recovery_save has unknown save %q", d.ID, rs.Save)
+	}
+	if rs.DC <= 0 {
+		return fmt.Errorf("condition %q: recovery_save needs a positive dc", d.ID)
+	}
+	return nil
+}
+
 // SynthesiseBonuses populates Bonuses from flat fields if Bonuses is empty.
 // Precondition: called after YAML unmarshal.
 // Postcondition: Bonuses is non-nil after a successful call; each synthesised entry has Type == BonusTypeUntyped.
@@ -194,6 +245,12 @@ func LoadDirectory(dir string) (*Registry, error) {
 		if err := def.SynthesiseBonuses(); err != nil {
 			return nil, fmt.Errorf("synthesising bonuses for %q: %w", path, err)
 		}
+		if err := def.validateRecoverySave(); err != nil {
+			return nil, fmt.Errorf("validating %q: %w", path, err)
+		}
+		if def.TempHP < 0 {
+			return nil, fmt.Errorf("validating %q: temp_hp must be >= 0, got %d", path, def.TempHP)
+		}
 		reg.Register(&def)
 	}
 	return reg, nil
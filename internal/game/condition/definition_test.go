@@ -170,7 +170,7 @@ func TestNewConditions_LoadFromDirectory(t *testing.T) {
 	reg, err := condition.LoadDirectory("../../../content/conditions")
 	require.NoError(t, err)
 
-	for _, id := range []string{"slowed", "immobilized", "blinded", "fleeing"} {
+	for _, id := range []string{"slowed", "immobilized", "rooted", "blinded", "fleeing"} {
 		def, ok := reg.Get(id)
 		require.True(t, ok, "condition %q not found", id)
 		assert.NotEmpty(t, def.Name, "condition %q has empty name", id)
@@ -343,3 +343,32 @@ func TestConditionDef_Seduced_HasAPReduction(t *testing.T) {
 	assert.Equal(t, 2, def.APReduction, "seduced APReduction must be 2")
 	assert.True(t, def.IsMentalCondition, "seduced must be a mental condition")
 }
+
+func TestLoadDirectory_StunnedAndFrightened_HaveRecoverySaves(t *testing.T) {
+	reg, err := condition.LoadDirectory("../../../content/conditions")
+	require.NoError(t, err)
+	for id, save := range map[string]string{"stunned": "toughness", "frightened": "cool"} {
+		def, ok := reg.Get(id)
+		require.True(t, ok, id)
+		assert.Equal(t, "until_save", def.DurationType, id)
+		require.NotNil(t, def.RecoverySave, id)
+		assert.Equal(t, save, def.RecoverySave.Save, id)
+		assert.Positive(t, def.RecoverySave.DC, id)
+	}
+}
+
+func TestLoadDirectory_InvalidRecoverySave_ReturnsError(t *testing.T) {
+	cases := map[string]string{
+		"not until_save": "id: x\nname: X\nduration_type: rounds\nrecovery_save:\n  save: cool\n  dc: 15\n",
+		"unknown save":   "id: x\nname: X\nduration_type: until_save\nrecovery_save:\n  save: luck\n  dc: 15\n",
+		"zero dc":        "id: x\nname: X\nduration_type: until_save\nrecovery_save:\n  save: cool\n",
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "x.yaml"), []byte(body), 0644))
+			_, err := condition.LoadDirectory(dir)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -169,6 +169,37 @@ func IsActionRestricted(s *ActiveSet, actionType string) bool {
 	return false
 }
 
+// IsRooted reports whether an active condition holds the bearer in place in
+// combat: any condition restricting "stride" (immobilized, rooted) or "move"
+// (grabbed). A rooted combatant cannot advance, retreat, step, or flee.
+//
+// Precondition: s may be nil.
+func IsRooted(s *ActiveSet) bool {
+	if s == nil {
+		return false
+	}
+	return IsActionRestricted(s, "stride") || IsActionRestricted(s, "move")
+}
+
+// MoveAPSurcharge returns the extra AP charged for each AP of movement in
+// combat, such as from slowed. Each condition contributes MoveAPPenalty * Stacks.
+//
+// Precondition: s may be nil.
+// Postcondition: Returns >= 0.
+func MoveAPSurcharge(s *ActiveSet) int {
+	if s == nil {
+		return 0
+	}
+	total := 0
+	for _, ac := range s.conditions {
+		total += ac.Def.MoveAPPenalty * ac.Stacks
+	}
+	if total < 0 {
+		total = 0
+	}
+	return total
+}
+
 // ExtraWeaponDice returns the total number of extra weapon damage dice granted by all active conditions.
 // Each die is of the weapon's own die type, rolled on a hit and doubled on a crit.
 //
@@ -75,6 +75,27 @@ func TestStunnedAPReduction_NoStunned_Zero(t *testing.T) {
 	assert.Equal(t, 0, condition.StunnedAPReduction(s))
 }
 
+func TestIsRooted(t *testing.T) {
+	assert.False(t, condition.IsRooted(nil))
+	for id, restrict := range map[string]string{"rooted": "stride", "grabbed": "move"} {
+		s := condition.NewActiveSet()
+		def := &condition.ConditionDef{ID: id, Name: id, DurationType: "rounds", RestrictActions: []string{restrict}}
+		require.NoError(t, s.Apply("testuid", def, 1, 1))
+		assert.True(t, condition.IsRooted(s), id)
+	}
+	assert.False(t, condition.IsRooted(condition.NewActiveSet()))
+}
+
+func TestMoveAPSurcharge_ScalesWithStacks(t *testing.T) {
+	s := condition.NewActiveSet()
+	assert.Equal(t, 0, condition.MoveAPSurcharge(nil))
+	slowed := &condition.ConditionDef{ID: "slowed", Name: "Slowed", DurationType: "rounds", MaxStacks: 2, MoveAPPenalty: 1}
+	terrain := &condition.ConditionDef{ID: "terrain_ice", Name: "Ice", DurationType: "permanent", MoveAPCost: 1}
+	require.NoError(t, s.Apply("testuid", slowed, 2, 1))
+	require.NoError(t, s.Apply("testuid", terrain, 1, -1))
+	assert.Equal(t, 2, condition.MoveAPSurcharge(s))
+}
+
 func TestAttackBonus_NilActiveSet_ReturnsZero(t *testing.T) {
 	result := condition.AttackBonus(nil)
 	if result != 0 {
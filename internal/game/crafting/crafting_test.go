@@ -30,6 +30,26 @@ func TestRecipeRegistry_FatalOnUnknownMaterial(t *testing.T) {
 	assert.Error(t, err) // REQ-CRAFT-6
 }
 
+func TestRecipeRegistry_LoadsItemInputs(t *testing.T) {
+	matReg, _ := crafting.LoadMaterialRegistry("../../../content/materials.yaml")
+	reg, err := crafting.LoadRecipeRegistry("testdata/tagged_item_recipe/", matReg, nil)
+	assert.NoError(t, err)
+	r, ok := reg.Recipe("improvised_shiv")
+	assert.True(t, ok)
+	assert.Equal(t, []crafting.RecipeItem{
+		{Tag: "melee", Quantity: 1},
+		{ItemID: "duct_tape", Quantity: 2},
+	}, r.Items)
+	assert.Equal(t, "any melee", r.Items[0].Label())
+	assert.Equal(t, "duct_tape", r.Items[1].Label())
+}
+
+func TestRecipeRegistry_RejectsItemInputWithIDAndTag(t *testing.T) {
+	matReg, _ := crafting.LoadMaterialRegistry("../../../content/materials.yaml")
+	_, err := crafting.LoadRecipeRegistry("testdata/bad_item_recipe/", matReg, nil)
+	assert.Error(t, err)
+}
+
 func TestCraftingEngine_QuickCraft_CritSuccess(t *testing.T) {
 	engine := crafting.NewEngine()
 	recipe := &crafting.Recipe{OutputCount: 1}
@@ -13,6 +13,23 @@ type RecipeMaterial struct {
 	Quantity int    `yaml:"quantity"`
 }
 
+// RecipeItem is an inventory item a recipe consumes from the crafter's
+// backpack, named either by item ID or by tag. A tagged input accepts any
+// item carrying the tag.
+type RecipeItem struct {
+	ItemID   string `yaml:"item_id,omitempty"`
+	Tag      string `yaml:"tag,omitempty"`
+	Quantity int    `yaml:"quantity"`
+}
+
+// Label returns the item ID, or "any <tag>" for a tagged input.
+func (ri RecipeItem) Label() string {
+	if ri.Tag != "" {
+		return "any " + ri.Tag
+	}
+	return ri.ItemID
+}
+
 type Recipe struct {
 	ID                string           `yaml:"id"`
 	Name              string           `yaml:"name"`
@@ -23,6 +40,7 @@ type Recipe struct {
 	DC                int              `yaml:"dc"`
 	QuickCraftMinRank string           `yaml:"quick_craft_min_rank"`
 	Materials         []RecipeMaterial `yaml:"materials"`
+	Items             []RecipeItem     `yaml:"items,omitempty"`
 	Description       string           `yaml:"description"`
 }
 
@@ -93,6 +111,14 @@ func LoadRecipeRegistry(dir string, matReg *MaterialRegistry, invReg OutputValid
 				return nil, fmt.Errorf("recipe %s: unknown material ID %q (REQ-CRAFT-6)", r.ID, m.ID)
 			}
 		}
+		for i, it := range r.Items {
+			if (it.ItemID == "") == (it.Tag == "") {
+				return nil, fmt.Errorf("recipe %s: items[%d] must set exactly one of item_id or tag", r.ID, i)
+			}
+			if it.Quantity < 1 {
+				return nil, fmt.Errorf("recipe %s: items[%d] quantity must be >= 1, got %d", r.ID, i, it.Quantity)
+			}
+		}
 		if invReg != nil {
 			if err := invReg.ValidateOutput(r.Category, r.OutputItemID); err != nil {
 				return nil, fmt.Errorf("recipe %s: %w (REQ-CRAFT-10)", r.ID, err)
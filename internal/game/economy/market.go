@@ -0,0 +1,167 @@
+// Package economy tracks how much of each item players buy from and sell to
+// vendors in each region, and drifts a per-item price index with that trade so
+// goods players strip from a region grow dear there and goods they dump go
+// cheap.
+package economy
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Config tunes how far and how fast price indices move.
+type Config struct {
+	// Drift is the largest change to an index in one settlement.
+	Drift float64
+	// ReferenceVolume is the net units traded in a day that moves an index by the full Drift.
+	ReferenceVolume int
+	// Recovery is the fraction of its distance from 1.0 an untraded index recovers each settlement.
+	Recovery float64
+	// Min and Max bound every index.
+	Min, Max float64
+}
+
+// DefaultConfig returns the stock tuning: up to 5% movement a day, saturating
+// at 20 units of net trade, with untraded goods recovering a tenth of the way
+// to normal, bounded to half and double price.
+func DefaultConfig() Config {
+	return Config{Drift: 0.05, ReferenceVolume: 20, Recovery: 0.1, Min: 0.5, Max: 2.0}
+}
+
+// Quote is one item's standing in a region.
+type Quote struct {
+	ItemID string
+	// Index multiplies the item's vendor prices; 1.0 is normal.
+	Index float64
+	// Bought and Sold are the units players bought from and sold to vendors since the last settlement.
+	Bought int
+	Sold   int
+}
+
+// entry is the mutable state behind a Quote.
+type entry struct {
+	index  float64
+	bought int
+	sold   int
+}
+
+// Market holds price indices and trade volume per region and item. It is safe
+// for concurrent use.
+type Market struct {
+	cfg     Config
+	mu      sync.Mutex
+	regions map[string]map[string]*entry
+}
+
+// NewMarket creates an empty Market in which every index starts at 1.0.
+//
+// Precondition: cfg.Min <= 1.0 <= cfg.Max.
+// Postcondition: Returns a non-nil Market.
+func NewMarket(cfg Config) *Market {
+	return &Market{cfg: cfg, regions: make(map[string]map[string]*entry)}
+}
+
+// entryFor returns the entry for itemID in region, creating it when absent.
+//
+// Precondition: the caller holds m.mu.
+func (m *Market) entryFor(region, itemID string) *entry {
+	items, ok := m.regions[region]
+	if !ok {
+		items = make(map[string]*entry)
+		m.regions[region] = items
+	}
+	e, ok := items[itemID]
+	if !ok {
+		e = &entry{index: 1.0}
+		items[itemID] = e
+	}
+	return e
+}
+
+// RecordBuy notes that players bought qty of itemID from a vendor in region.
+//
+// Precondition: qty >= 1; non-positive quantities are ignored.
+func (m *Market) RecordBuy(region, itemID string, qty int) {
+	if qty <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entryFor(region, itemID).bought += qty
+}
+
+// RecordSell notes that players sold qty of itemID to a vendor in region.
+//
+// Precondition: qty >= 1; non-positive quantities are ignored.
+func (m *Market) RecordSell(region, itemID string, qty int) {
+	if qty <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entryFor(region, itemID).sold += qty
+}
+
+// Index returns the price index for itemID in region.
+//
+// Postcondition: Returns 1.0 for items never traded in region.
+func (m *Market) Index(region, itemID string) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.regions[region][itemID]; ok {
+		return e.index
+	}
+	return 1.0
+}
+
+// Settle applies a day's trade to every index and clears the volume counters.
+// Net buying raises an index and net selling lowers it, by up to cfg.Drift in
+// proportion to net volume over cfg.ReferenceVolume; an item with no net trade
+// recovers toward 1.0 instead. Items back at 1.0 with no trade are forgotten.
+//
+// Postcondition: Every index lies in [cfg.Min, cfg.Max]; all volumes are zero.
+func (m *Market) Settle() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for region, items := range m.regions {
+		for itemID, e := range items {
+			net := e.bought - e.sold
+			if net == 0 {
+				e.index += (1.0 - e.index) * m.cfg.Recovery
+				if math.Abs(e.index-1.0) < 0.005 {
+					e.index = 1.0
+				}
+			} else {
+				pressure := 1.0
+				if m.cfg.ReferenceVolume > 0 {
+					pressure = math.Max(-1, math.Min(1, float64(net)/float64(m.cfg.ReferenceVolume)))
+				}
+				e.index += m.cfg.Drift * pressure
+			}
+			e.index = math.Max(m.cfg.Min, math.Min(m.cfg.Max, e.index))
+			e.bought, e.sold = 0, 0
+			if e.index == 1.0 {
+				delete(items, itemID)
+			}
+		}
+		if len(items) == 0 {
+			delete(m.regions, region)
+		}
+	}
+}
+
+// Quotes returns every item in region with an index away from 1.0 or trade
+// since the last settlement.
+//
+// Postcondition: Results are sorted by ItemID.
+func (m *Market) Quotes(region string) []Quote {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]Quote, 0, len(m.regions[region]))
+	for itemID, e := range m.regions[region] {
+		out = append(out, Quote{ItemID: itemID, Index: e.index, Bought: e.bought, Sold: e.sold})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ItemID < out[j].ItemID })
+	return out
+}
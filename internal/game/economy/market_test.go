@@ -0,0 +1,77 @@
+package economy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/economy"
+)
+
+func TestMarket_UntradedIndexIsOne(t *testing.T) {
+	m := economy.NewMarket(economy.DefaultConfig())
+	assert.Equal(t, 1.0, m.Index("rustbucket", "stim_pack"))
+	assert.Empty(t, m.Quotes("rustbucket"))
+}
+
+func TestMarket_BuyingRaisesIndex(t *testing.T) {
+	m := economy.NewMarket(economy.DefaultConfig())
+	m.RecordBuy("rustbucket", "stim_pack", 10)
+	m.Settle()
+	assert.InDelta(t, 1.025, m.Index("rustbucket", "stim_pack"), 1e-9)
+	assert.Equal(t, 1.0, m.Index("downtown", "stim_pack"), "trade in one region must not move another")
+}
+
+func TestMarket_SellingLowersIndex(t *testing.T) {
+	m := economy.NewMarket(economy.DefaultConfig())
+	m.RecordSell("rustbucket", "scrap", 100)
+	m.Settle()
+	assert.InDelta(t, 0.95, m.Index("rustbucket", "scrap"), 1e-9, "drift saturates at the reference volume")
+}
+
+func TestMarket_SettleClearsVolume(t *testing.T) {
+	m := economy.NewMarket(economy.DefaultConfig())
+	m.RecordBuy("rustbucket", "stim_pack", 4)
+	m.RecordSell("rustbucket", "stim_pack", 1)
+	quotes := m.Quotes("rustbucket")
+	require.Len(t, quotes, 1)
+	assert.Equal(t, economy.Quote{ItemID: "stim_pack", Index: 1.0, Bought: 4, Sold: 1}, quotes[0])
+
+	m.Settle()
+	quotes = m.Quotes("rustbucket")
+	require.Len(t, quotes, 1)
+	assert.Zero(t, quotes[0].Bought)
+	assert.Zero(t, quotes[0].Sold)
+}
+
+func TestMarket_IdleIndexRecovers(t *testing.T) {
+	m := economy.NewMarket(economy.DefaultConfig())
+	m.RecordBuy("rustbucket", "stim_pack", 20)
+	m.Settle()
+	high := m.Index("rustbucket", "stim_pack")
+	m.Settle()
+	assert.Less(t, m.Index("rustbucket", "stim_pack"), high)
+	for i := 0; i < 100; i++ {
+		m.Settle()
+	}
+	assert.Equal(t, 1.0, m.Index("rustbucket", "stim_pack"))
+	assert.Empty(t, m.Quotes("rustbucket"), "recovered items are forgotten")
+}
+
+func TestProperty_Market_IndexStaysInBounds(t *testing.T) {
+	cfg := economy.DefaultConfig()
+	rapid.Check(t, func(rt *rapid.T) {
+		m := economy.NewMarket(cfg)
+		days := rapid.IntRange(1, 60).Draw(rt, "days")
+		for d := 0; d < days; d++ {
+			m.RecordBuy("r", "x", rapid.IntRange(0, 200).Draw(rt, "bought"))
+			m.RecordSell("r", "x", rapid.IntRange(0, 200).Draw(rt, "sold"))
+			m.Settle()
+			idx := m.Index("r", "x")
+			assert.GreaterOrEqual(rt, idx, cfg.Min)
+			assert.LessOrEqual(rt, idx, cfg.Max)
+		}
+	})
+}
@@ -128,6 +128,33 @@ func (s *Service) DiscountFor(factionID string, rep int) float64 {
 	return tier.PriceDiscount
 }
 
+// StandingDiscount returns the price discount for rep in factionID, scaled
+// continuously with standing: within a tier the discount climbs linearly from
+// that tier's PriceDiscount toward the next tier's, reaching it exactly at the
+// next tier's MinRep. Rep at or beyond the top tier earns the top discount.
+//
+// Precondition: none.
+// Postcondition: Returns 0 for unknown factions; the result never decreases as rep grows.
+func (s *Service) StandingDiscount(factionID string, rep int) float64 {
+	def, ok := s.reg[factionID]
+	if !ok || len(def.Tiers) == 0 {
+		return 0
+	}
+	tiers := def.Tiers
+	if rep <= tiers[0].MinRep {
+		return tiers[0].PriceDiscount
+	}
+	for i := 0; i+1 < len(tiers); i++ {
+		lo, hi := tiers[i], tiers[i+1]
+		if rep >= hi.MinRep {
+			continue
+		}
+		frac := float64(rep-lo.MinRep) / float64(hi.MinRep-lo.MinRep)
+		return lo.PriceDiscount + frac*(hi.PriceDiscount-lo.PriceDiscount)
+	}
+	return tiers[len(tiers)-1].PriceDiscount
+}
+
 // IsEnemyOf returns true iff npcFactionID is non-empty and is hostile to sess.FactionID.
 //
 // Precondition: sess must be non-nil.
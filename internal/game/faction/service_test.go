@@ -1,6 +1,7 @@
 package faction_test
 
 import (
+	"math"
 	"testing"
 
 	"pgregory.net/rapid"
@@ -91,6 +92,34 @@ func TestDiscountFor_DelegatesToTierFor(t *testing.T) {
 	}
 }
 
+func TestStandingDiscount_InterpolatesBetweenTiers(t *testing.T) {
+	svc := faction.NewService(makeTestRegistry())
+	cases := map[int]float64{0: 0, 50: 0.025, 100: 0.05, 200: 0.075, 600: 0.15, 900: 0.15}
+	for rep, want := range cases {
+		if got := svc.StandingDiscount("gun", rep); math.Abs(got-want) > 1e-9 {
+			t.Errorf("rep %d: expected %v, got %v", rep, want, got)
+		}
+	}
+	if got := svc.StandingDiscount("unknown", 500); got != 0 {
+		t.Errorf("unknown faction: expected 0, got %v", got)
+	}
+}
+
+func TestProperty_StandingDiscount_MonotonicAndBounded(t *testing.T) {
+	svc := faction.NewService(makeTestRegistry())
+	rapid.Check(t, func(t *rapid.T) {
+		a := rapid.IntRange(-100, 1000).Draw(t, "a")
+		b := rapid.IntRange(a, 1100).Draw(t, "b")
+		da, db := svc.StandingDiscount("gun", a), svc.StandingDiscount("gun", b)
+		if da > db {
+			t.Fatalf("discount fell from %v at %d to %v at %d", da, a, db, b)
+		}
+		if da < 0 || db > 0.15 {
+			t.Fatalf("discount outside tier bounds: %v, %v", da, db)
+		}
+	})
+}
+
 func TestIsEnemyOf_HostileNPCFaction(t *testing.T) {
 	svc := faction.NewService(makeTestRegistry())
 	sess := &session.PlayerSession{FactionID: "gun", FactionRep: map[string]int{"gun": 0}}
@@ -0,0 +1,225 @@
+// Package help loads the player help pages kept under content/help. Each page
+// is a Markdown file with optional YAML front matter or a YAML file; both
+// give a topic a title, lookup keywords, and a body.
+package help
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Topic is one help page.
+type Topic struct {
+	// ID is the lower-case name players look the topic up by. It defaults to
+	// the file name without its extension.
+	ID    string `yaml:"id"`
+	Title string `yaml:"title"`
+	// Keywords are alternate lookup names that also weigh in search.
+	Keywords []string `yaml:"keywords"`
+	Body     string   `yaml:"body"`
+}
+
+// Library holds help topics by ID and keyword.
+type Library struct {
+	topics map[string]*Topic // ID → topic
+	names  map[string]string // keyword → ID
+}
+
+// NewLibrary indexes topics.
+//
+// Precondition: Every topic must have an ID; no ID or keyword may repeat.
+// Postcondition: Returns a Library or an error naming the first conflict.
+func NewLibrary(topics []Topic) (*Library, error) {
+	l := &Library{topics: make(map[string]*Topic, len(topics)), names: make(map[string]string)}
+	for i := range topics {
+		t := &topics[i]
+		t.ID = strings.ToLower(strings.TrimSpace(t.ID))
+		if t.ID == "" {
+			return nil, fmt.Errorf("help topic %q has no id", t.Title)
+		}
+		if _, ok := l.topics[t.ID]; ok {
+			return nil, fmt.Errorf("duplicate help topic %q", t.ID)
+		}
+		if owner, ok := l.names[t.ID]; ok {
+			return nil, fmt.Errorf("help topic %q conflicts with a keyword of %q", t.ID, owner)
+		}
+		if t.Title == "" {
+			t.Title = t.ID
+		}
+		l.topics[t.ID] = t
+	}
+	for _, t := range l.topics {
+		for _, kw := range t.Keywords {
+			kw = strings.ToLower(strings.TrimSpace(kw))
+			if kw == "" || kw == t.ID {
+				continue
+			}
+			if _, ok := l.topics[kw]; ok {
+				return nil, fmt.Errorf("keyword %q of help topic %q conflicts with a topic", kw, t.ID)
+			}
+			if owner, ok := l.names[kw]; ok && owner != t.ID {
+				return nil, fmt.Errorf("keyword %q is used by help topics %q and %q", kw, owner, t.ID)
+			}
+			l.names[kw] = t.ID
+		}
+	}
+	return l, nil
+}
+
+// LoadDir reads every .md, .yaml, and .yml file in dir as a topic.
+//
+// Precondition: dir must be a readable directory path.
+// Postcondition: Returns the indexed topics or a non-nil error.
+func LoadDir(dir string) (*Library, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading help directory %s: %w", dir, err)
+	}
+	var topics []Topic
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if ext != ".md" && ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		var t Topic
+		if ext == ".md" {
+			t, err = parseMarkdown(data)
+		} else {
+			err = yaml.Unmarshal(data, &t)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing help topic %s: %w", path, err)
+		}
+		if t.ID == "" {
+			t.ID = strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		}
+		t.Body = strings.TrimSpace(t.Body)
+		topics = append(topics, t)
+	}
+	return NewLibrary(topics)
+}
+
+// parseMarkdown splits optional "---" delimited YAML front matter from the body.
+func parseMarkdown(data []byte) (Topic, error) {
+	var t Topic
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if rest, ok := strings.CutPrefix(text, "---\n"); ok {
+		front, body, found := strings.Cut(rest, "\n---\n")
+		if !found {
+			return t, fmt.Errorf("unterminated front matter")
+		}
+		if err := yaml.Unmarshal([]byte(front), &t); err != nil {
+			return t, fmt.Errorf("front matter: %w", err)
+		}
+		text = body
+	}
+	t.Body = text
+	return t, nil
+}
+
+// Lookup finds a topic by ID or keyword, then by a unique ID prefix.
+//
+// Postcondition: Returns (nil, false) when nothing or more than one topic matches.
+func (l *Library) Lookup(name string) (*Topic, bool) {
+	if l == nil {
+		return nil, false
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil, false
+	}
+	if t, ok := l.topics[name]; ok {
+		return t, true
+	}
+	if id, ok := l.names[name]; ok {
+		return l.topics[id], true
+	}
+	var match *Topic
+	for id, t := range l.topics {
+		if strings.HasPrefix(id, name) {
+			if match != nil {
+				return nil, false
+			}
+			match = t
+		}
+	}
+	return match, match != nil
+}
+
+// Topics returns every topic ordered by ID.
+func (l *Library) Topics() []*Topic {
+	if l == nil {
+		return nil
+	}
+	out := make([]*Topic, 0, len(l.topics))
+	for _, t := range l.topics {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// Search returns the topics containing every word of query in their title,
+// keywords, or body. Title matches rank above keyword matches, which rank
+// above body matches.
+//
+// Postcondition: Results are ordered by rank, then ID; an empty query matches nothing.
+func (l *Library) Search(query string) []*Topic {
+	words := strings.Fields(strings.ToLower(query))
+	if l == nil || len(words) == 0 {
+		return nil
+	}
+	type hit struct {
+		t     *Topic
+		score int
+	}
+	var hits []hit
+	for _, t := range l.topics {
+		title := strings.ToLower(t.Title + " " + t.ID)
+		keywords := strings.ToLower(strings.Join(t.Keywords, " "))
+		body := strings.ToLower(t.Body)
+		score := 0
+		for _, w := range words {
+			switch {
+			case strings.Contains(title, w):
+				score += 3
+			case strings.Contains(keywords, w):
+				score += 2
+			case strings.Contains(body, w):
+				score++
+			default:
+				score = 0
+			}
+			if score == 0 {
+				break
+			}
+		}
+		if score > 0 {
+			hits = append(hits, hit{t, score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].t.ID < hits[j].t.ID
+	})
+	out := make([]*Topic, len(hits))
+	for i, h := range hits {
+		out[i] = h.t
+	}
+	return out
+}
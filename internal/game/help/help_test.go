@@ -0,0 +1,150 @@
+package help
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func writeFile(t *testing.T, dir, name, body string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644))
+}
+
+func TestLoadDir_MarkdownAndYAML(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "combat.md", "---\ntitle: Combat\nkeywords: [fighting, AP]\n---\n# Rounds\n\nYou get 3 AP.\n")
+	writeFile(t, dir, "plain.md", "Just a body.\n")
+	writeFile(t, dir, "conditions.yaml", "title: Conditions\nkeywords: [status]\nbody: |\n  Flat-footed is bad.\n")
+	writeFile(t, dir, "notes.txt", "ignored")
+
+	l, err := LoadDir(dir)
+	require.NoError(t, err)
+	var ids []string
+	for _, tp := range l.Topics() {
+		ids = append(ids, tp.ID)
+	}
+	assert.Equal(t, []string{"combat", "conditions", "plain"}, ids)
+
+	combat, ok := l.Lookup("Combat")
+	require.True(t, ok)
+	assert.Equal(t, "Combat", combat.Title)
+	assert.Equal(t, "# Rounds\n\nYou get 3 AP.", combat.Body)
+
+	plain, ok := l.Lookup("plain")
+	require.True(t, ok)
+	assert.Equal(t, "plain", plain.Title)
+	assert.Equal(t, "Just a body.", plain.Body)
+}
+
+func TestLoadDir_Errors(t *testing.T) {
+	_, err := LoadDir(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "bad.md", "---\ntitle: Never closed\n")
+	_, err = LoadDir(dir)
+	assert.ErrorContains(t, err, "unterminated front matter")
+}
+
+func TestNewLibrary_Conflicts(t *testing.T) {
+	_, err := NewLibrary([]Topic{{ID: "a"}, {ID: "A"}})
+	assert.ErrorContains(t, err, "duplicate")
+	_, err = NewLibrary([]Topic{{ID: "a", Keywords: []string{"b"}}, {ID: "b"}})
+	assert.ErrorContains(t, err, "conflicts")
+	_, err = NewLibrary([]Topic{{ID: "a", Keywords: []string{"x"}}, {ID: "b", Keywords: []string{"x"}}})
+	assert.ErrorContains(t, err, "used by")
+	_, err = NewLibrary([]Topic{{Title: "no id"}})
+	assert.Error(t, err)
+}
+
+func TestLookup_KeywordsAndPrefixes(t *testing.T) {
+	l, err := NewLibrary([]Topic{
+		{ID: "combat", Keywords: []string{"fighting"}},
+		{ID: "conditions"},
+		{ID: "crafting"},
+	})
+	require.NoError(t, err)
+
+	tp, ok := l.Lookup("FIGHTING")
+	require.True(t, ok)
+	assert.Equal(t, "combat", tp.ID)
+	tp, ok = l.Lookup("cr")
+	require.True(t, ok)
+	assert.Equal(t, "crafting", tp.ID)
+	_, ok = l.Lookup("co")
+	assert.False(t, ok, "ambiguous prefixes match nothing")
+	_, ok = l.Lookup("")
+	assert.False(t, ok)
+
+	var nilLib *Library
+	_, ok = nilLib.Lookup("combat")
+	assert.False(t, ok)
+	assert.Empty(t, nilLib.Topics())
+	assert.Empty(t, nilLib.Search("combat"))
+}
+
+func TestSearch_RanksTitleAboveKeywordAboveBody(t *testing.T) {
+	l, err := NewLibrary([]Topic{
+		{ID: "a", Title: "Armor", Body: "stops bullets"},
+		{ID: "b", Title: "Bullets", Body: "go fast"},
+		{ID: "c", Title: "Cover", Keywords: []string{"bullets"}, Body: "hide"},
+	})
+	require.NoError(t, err)
+
+	var ids []string
+	for _, tp := range l.Search("BULLETS") {
+		ids = append(ids, tp.ID)
+	}
+	assert.Equal(t, []string{"b", "c", "a"}, ids)
+
+	require.Len(t, l.Search("stops bullets"), 1, "every word must match")
+	assert.Empty(t, l.Search("grenade"))
+	assert.Empty(t, l.Search("   "))
+}
+
+func TestContentHelpTopics(t *testing.T) {
+	l, err := LoadDir("../../../content/help")
+	require.NoError(t, err)
+	for _, id := range []string{"combat", "conditions", "crafting", "newbie"} {
+		tp, ok := l.Lookup(id)
+		if assert.True(t, ok, "missing help topic %q", id) {
+			assert.NotEmpty(t, tp.Body)
+		}
+	}
+}
+
+// TestProperty_SearchResultsContainEveryWord verifies that every topic Search
+// returns mentions each query word somewhere.
+func TestProperty_SearchResultsContainEveryWord(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		word := rapid.StringMatching(`[a-e]{1,3}`)
+		n := rapid.IntRange(1, 8).Draw(rt, "n")
+		var topics []Topic
+		for i := 0; i < n; i++ {
+			topics = append(topics, Topic{
+				ID:       string(rune('a'+i)) + "topic",
+				Title:    word.Draw(rt, "title"),
+				Keywords: []string{fmt.Sprintf("kw%d%s", i, word.Draw(rt, "kw"))},
+				Body:     word.Draw(rt, "body") + " " + word.Draw(rt, "body"),
+			})
+		}
+		l, err := NewLibrary(topics)
+		require.NoError(rt, err)
+		query := word.Draw(rt, "q1") + " " + word.Draw(rt, "q2")
+		for _, tp := range l.Search(query) {
+			text := tp.Title + " " + tp.ID + " " + tp.Keywords[0] + " " + tp.Body
+			for _, w := range strings.Fields(query) {
+				if !strings.Contains(text, w) {
+					rt.Fatalf("topic %q returned for %q but lacks %q", tp.ID, query, w)
+				}
+			}
+		}
+	})
+}
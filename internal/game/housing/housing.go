@@ -0,0 +1,346 @@
+// Package housing tracks player leases on rentable rooms: who holds each room,
+// the owner's custom description, the room's storage container, and when the
+// next rent payment falls due.
+package housing
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// MaxDescriptionLen bounds an owner-written room description.
+const MaxDescriptionLen = 1000
+
+var (
+	// ErrOccupied is returned when renting a room another character holds.
+	ErrOccupied = errors.New("room is already rented")
+	// ErrHasHome is returned when a character who already holds a lease tries to rent another room.
+	ErrHasHome = errors.New("character already rents a room")
+	// ErrNotTenant is returned when a character acts on a room they do not rent.
+	ErrNotTenant = errors.New("character does not rent this room")
+	// ErrInsufficient is returned when taking more of an item than is stored.
+	ErrInsufficient = errors.New("not enough stored")
+	// ErrNotEmpty is returned when vacating a room whose container still holds items.
+	ErrNotEmpty = errors.New("storage is not empty")
+)
+
+// Lease is a character's tenancy of one room.
+type Lease struct {
+	RoomID      string
+	CharacterID int64
+	// OwnerName is the tenant's character name, shown to visitors.
+	OwnerName string
+	// Description replaces the room's authored description when non-empty.
+	Description string
+	// Storage maps item definition IDs to stored quantities.
+	Storage map[string]int
+	// Instances holds stored items that carry instance state (wear, affixes,
+	// an engraved name), one entry per instance. Plain items are counted in Storage.
+	Instances []inventory.InventoryItem
+	// PaidUntil is when the next rent payment falls due.
+	PaidUntil time.Time
+	// PastDueSince is when rent first went unpaid; zero while the lease is current.
+	PastDueSince time.Time
+}
+
+// clone returns a deep copy of l.
+func (l *Lease) clone() *Lease {
+	c := *l
+	c.Storage = make(map[string]int, len(l.Storage))
+	for k, v := range l.Storage {
+		c.Storage[k] = v
+	}
+	c.Instances = append([]inventory.InventoryItem(nil), l.Instances...)
+	return &c
+}
+
+// Empty reports whether l's storage container holds nothing.
+func (l *Lease) Empty() bool {
+	return len(l.Storage) == 0 && len(l.Instances) == 0
+}
+
+// Registry holds every active lease. It is safe for concurrent use; all
+// returned leases are copies.
+type Registry struct {
+	mu     sync.Mutex
+	byRoom map[string]*Lease
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{byRoom: make(map[string]*Lease)}
+}
+
+// Load replaces the registry's contents with leases.
+//
+// Precondition: no two leases share a RoomID.
+// Postcondition: Each lease is stored by copy.
+func (r *Registry) Load(leases []*Lease) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byRoom = make(map[string]*Lease, len(leases))
+	for _, l := range leases {
+		c := l.clone()
+		r.byRoom[c.RoomID] = c
+	}
+}
+
+// Get returns the lease on roomID.
+//
+// Postcondition: Returns (copy, true) when the room is rented; (nil, false) otherwise.
+func (r *Registry) Get(roomID string) (*Lease, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	if !ok {
+		return nil, false
+	}
+	return l.clone(), true
+}
+
+// ForCharacter returns the lease held by characterID.
+//
+// Postcondition: Returns (copy, true) when the character rents a room; (nil, false) otherwise.
+func (r *Registry) ForCharacter(characterID int64) (*Lease, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if l := r.forCharacter(characterID); l != nil {
+		return l.clone(), true
+	}
+	return nil, false
+}
+
+func (r *Registry) forCharacter(characterID int64) *Lease {
+	for _, l := range r.byRoom {
+		if l.CharacterID == characterID {
+			return l
+		}
+	}
+	return nil
+}
+
+// RenameOwner updates the owner name on characterID's lease after a
+// character rename.
+//
+// Postcondition: Returns true when the character rents a room and it was renamed.
+func (r *Registry) RenameOwner(characterID int64, name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l := r.forCharacter(characterID)
+	if l == nil {
+		return false
+	}
+	l.OwnerName = name
+	return true
+}
+
+// CanEnter reports whether characterID may enter roomID.
+//
+// Postcondition: Returns true for unrented rooms and for the room's tenant.
+func (r *Registry) CanEnter(roomID string, characterID int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	return !ok || l.CharacterID == characterID
+}
+
+// Rent creates a lease on roomID paid through paidUntil.
+//
+// Precondition: characterID > 0.
+// Postcondition: Returns the new lease, ErrOccupied when the room is rented, or
+// ErrHasHome when the character already rents a room.
+func (r *Registry) Rent(roomID string, characterID int64, ownerName string, paidUntil time.Time) (*Lease, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byRoom[roomID]; ok {
+		return nil, ErrOccupied
+	}
+	if r.forCharacter(characterID) != nil {
+		return nil, ErrHasHome
+	}
+	l := &Lease{
+		RoomID:      roomID,
+		CharacterID: characterID,
+		OwnerName:   ownerName,
+		Storage:     make(map[string]int),
+		PaidUntil:   paidUntil,
+	}
+	r.byRoom[roomID] = l
+	return l.clone(), nil
+}
+
+// update applies fn to the tenant's lease on roomID and returns a copy of the result.
+func (r *Registry) update(roomID string, characterID int64, fn func(*Lease) error) (*Lease, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	if !ok || l.CharacterID != characterID {
+		return nil, ErrNotTenant
+	}
+	if err := fn(l); err != nil {
+		return nil, err
+	}
+	return l.clone(), nil
+}
+
+// SetDescription sets the custom description of the tenant's room.
+//
+// Precondition: len(text) <= MaxDescriptionLen; an empty text restores the authored description.
+// Postcondition: Returns ErrNotTenant unless characterID rents roomID.
+func (r *Registry) SetDescription(roomID string, characterID int64, text string) (*Lease, error) {
+	return r.update(roomID, characterID, func(l *Lease) error {
+		l.Description = text
+		return nil
+	})
+}
+
+// Store adds qty of itemID to the tenant's storage container.
+//
+// Precondition: qty > 0.
+// Postcondition: Returns ErrNotTenant unless characterID rents roomID.
+func (r *Registry) Store(roomID string, characterID int64, itemID string, qty int) (*Lease, error) {
+	return r.update(roomID, characterID, func(l *Lease) error {
+		l.Storage[itemID] += qty
+		return nil
+	})
+}
+
+// Take removes qty of itemID from the tenant's storage container.
+//
+// Precondition: qty > 0.
+// Postcondition: Returns ErrNotTenant unless characterID rents roomID, or
+// ErrInsufficient when fewer than qty are stored; storage is unchanged on error.
+func (r *Registry) Take(roomID string, characterID int64, itemID string, qty int) (*Lease, error) {
+	return r.update(roomID, characterID, func(l *Lease) error {
+		have := l.Storage[itemID]
+		if have < qty {
+			return ErrInsufficient
+		}
+		if have == qty {
+			delete(l.Storage, itemID)
+		} else {
+			l.Storage[itemID] = have - qty
+		}
+		return nil
+	})
+}
+
+// StoreInstance adds an item carrying instance state to the tenant's storage container.
+//
+// Precondition: item.Quantity > 0.
+// Postcondition: Returns ErrNotTenant unless characterID rents roomID.
+func (r *Registry) StoreInstance(roomID string, characterID int64, item inventory.InventoryItem) (*Lease, error) {
+	return r.update(roomID, characterID, func(l *Lease) error {
+		l.Instances = append(l.Instances, item)
+		return nil
+	})
+}
+
+// TakeInstance removes the stored instance at index i of the lease's Instances.
+//
+// Postcondition: Returns ErrNotTenant unless characterID rents roomID, or
+// ErrInsufficient when i is out of range; storage is unchanged on error.
+func (r *Registry) TakeInstance(roomID string, characterID int64, i int) (*Lease, inventory.InventoryItem, error) {
+	var taken inventory.InventoryItem
+	l, err := r.update(roomID, characterID, func(l *Lease) error {
+		if i < 0 || i >= len(l.Instances) {
+			return ErrInsufficient
+		}
+		taken = l.Instances[i]
+		l.Instances = append(l.Instances[:i:i], l.Instances[i+1:]...)
+		return nil
+	})
+	return l, taken, err
+}
+
+// Vacate ends the tenant's lease voluntarily.
+//
+// Postcondition: Returns ErrNotTenant unless characterID rents roomID, or
+// ErrNotEmpty while the storage container holds items.
+func (r *Registry) Vacate(roomID string, characterID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	if !ok || l.CharacterID != characterID {
+		return ErrNotTenant
+	}
+	if !l.Empty() {
+		return ErrNotEmpty
+	}
+	delete(r.byRoom, roomID)
+	return nil
+}
+
+// Due returns every lease whose rent is due at now, ordered by room ID.
+//
+// Postcondition: Returned leases are copies with PaidUntil not after now.
+func (r *Registry) Due(now time.Time) []*Lease {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*Lease
+	for _, l := range r.byRoom {
+		if !l.PaidUntil.After(now) {
+			out = append(out, l.clone())
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].RoomID < out[j].RoomID })
+	return out
+}
+
+// Renew records a rent payment, extending the lease by period and clearing any arrears.
+//
+// Precondition: period > 0.
+// Postcondition: Returns (copy, true) when roomID is rented; (nil, false) otherwise.
+func (r *Registry) Renew(roomID string, period time.Duration) (*Lease, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	if !ok {
+		return nil, false
+	}
+	l.PaidUntil = l.PaidUntil.Add(period)
+	l.PastDueSince = time.Time{}
+	return l.clone(), true
+}
+
+// MarkPastDue records that rent on roomID went unpaid at now.
+//
+// Postcondition: PastDueSince is set to now only if it was zero; returns
+// (copy, true) when roomID is rented.
+func (r *Registry) MarkPastDue(roomID string, now time.Time) (*Lease, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	if !ok {
+		return nil, false
+	}
+	if l.PastDueSince.IsZero() {
+		l.PastDueSince = now
+	}
+	return l.clone(), true
+}
+
+// Evict removes the lease on roomID regardless of its contents.
+//
+// Postcondition: Returns the removed lease, or (nil, false) when the room was not rented.
+func (r *Registry) Evict(roomID string) (*Lease, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.byRoom[roomID]
+	if !ok {
+		return nil, false
+	}
+	delete(r.byRoom, roomID)
+	return l, true
+}
+
+// ShouldEvict reports whether a past-due lease has exhausted its grace period.
+//
+// Postcondition: Returns false for leases that are not past due.
+func ShouldEvict(l *Lease, grace time.Duration, now time.Time) bool {
+	return !l.PastDueSince.IsZero() && !now.Before(l.PastDueSince.Add(grace))
+}
@@ -0,0 +1,164 @@
+package housing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/housing"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+var epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRegistry_RentAndLock(t *testing.T) {
+	r := housing.NewRegistry()
+	l, err := r.Rent("flat_a", 1, "Ace", epoch.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "Ace", l.OwnerName)
+
+	assert.True(t, r.CanEnter("flat_a", 1))
+	assert.False(t, r.CanEnter("flat_a", 2))
+	assert.True(t, r.CanEnter("flat_b", 2), "unrented rooms are open")
+
+	_, err = r.Rent("flat_a", 2, "Bee", epoch)
+	assert.ErrorIs(t, err, housing.ErrOccupied)
+	_, err = r.Rent("flat_b", 1, "Ace", epoch)
+	assert.ErrorIs(t, err, housing.ErrHasHome)
+
+	got, ok := r.ForCharacter(1)
+	require.True(t, ok)
+	assert.Equal(t, "flat_a", got.RoomID)
+}
+
+func TestRegistry_DescriptionAndStorage(t *testing.T) {
+	r := housing.NewRegistry()
+	_, err := r.Rent("flat_a", 1, "Ace", epoch)
+	require.NoError(t, err)
+
+	_, err = r.SetDescription("flat_a", 2, "mine now")
+	assert.ErrorIs(t, err, housing.ErrNotTenant)
+	l, err := r.SetDescription("flat_a", 1, "Cozy.")
+	require.NoError(t, err)
+	assert.Equal(t, "Cozy.", l.Description)
+
+	_, err = r.Store("flat_a", 1, "stim_pack", 3)
+	require.NoError(t, err)
+	_, err = r.Take("flat_a", 1, "stim_pack", 4)
+	assert.ErrorIs(t, err, housing.ErrInsufficient)
+	assert.ErrorIs(t, r.Vacate("flat_a", 1), housing.ErrNotEmpty)
+
+	l, err = r.Take("flat_a", 1, "stim_pack", 3)
+	require.NoError(t, err)
+	assert.Empty(t, l.Storage)
+	require.NoError(t, r.Vacate("flat_a", 1))
+	_, ok := r.Get("flat_a")
+	assert.False(t, ok)
+}
+
+func TestRegistry_InstancesKeepTheirState(t *testing.T) {
+	r := housing.NewRegistry()
+	_, err := r.Rent("flat_a", 1, "Ace", epoch)
+	require.NoError(t, err)
+
+	rifle := inventory.InventoryItem{ItemDefID: "rifle", Quantity: 1, Metadata: &inventory.ItemMetadata{CustomName: "Old Faithful", Affixes: []string{"keen"}}}
+	_, err = r.StoreInstance("flat_a", 2, rifle)
+	assert.ErrorIs(t, err, housing.ErrNotTenant)
+	l, err := r.StoreInstance("flat_a", 1, rifle)
+	require.NoError(t, err)
+	require.Len(t, l.Instances, 1)
+	assert.ErrorIs(t, r.Vacate("flat_a", 1), housing.ErrNotEmpty, "a stored instance keeps the room occupied")
+
+	_, _, err = r.TakeInstance("flat_a", 1, 1)
+	assert.ErrorIs(t, err, housing.ErrInsufficient)
+	l, got, err := r.TakeInstance("flat_a", 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rifle, got)
+	assert.Empty(t, l.Instances)
+	require.NoError(t, r.Vacate("flat_a", 1))
+}
+
+func TestRegistry_ReturnsCopies(t *testing.T) {
+	r := housing.NewRegistry()
+	l, err := r.Rent("flat_a", 1, "Ace", epoch)
+	require.NoError(t, err)
+	l.Storage["contraband"] = 9
+	got, _ := r.Get("flat_a")
+	assert.Empty(t, got.Storage)
+}
+
+func TestRegistry_RentCycle(t *testing.T) {
+	r := housing.NewRegistry()
+	_, err := r.Rent("flat_a", 1, "Ace", epoch.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, r.Due(epoch))
+
+	due := r.Due(epoch.Add(time.Hour))
+	require.Len(t, due, 1)
+
+	l, ok := r.MarkPastDue("flat_a", epoch.Add(time.Hour))
+	require.True(t, ok)
+	assert.False(t, housing.ShouldEvict(l, 2*time.Hour, epoch.Add(2*time.Hour)))
+	assert.True(t, housing.ShouldEvict(l, 2*time.Hour, epoch.Add(3*time.Hour)))
+
+	l, _ = r.MarkPastDue("flat_a", epoch.Add(2*time.Hour))
+	assert.Equal(t, epoch.Add(time.Hour), l.PastDueSince, "arrears keep their original date")
+
+	l, ok = r.Renew("flat_a", 24*time.Hour)
+	require.True(t, ok)
+	assert.Equal(t, epoch.Add(25*time.Hour), l.PaidUntil)
+	assert.True(t, l.PastDueSince.IsZero())
+	assert.False(t, housing.ShouldEvict(l, 0, epoch.Add(100*time.Hour)))
+
+	evicted, ok := r.Evict("flat_a")
+	require.True(t, ok)
+	assert.Equal(t, int64(1), evicted.CharacterID)
+	assert.True(t, r.CanEnter("flat_a", 2))
+}
+
+func TestProperty_Registry_StorageNeverNegative(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		r := housing.NewRegistry()
+		if _, err := r.Rent("flat", 1, "Ace", epoch); err != nil {
+			rt.Fatal(err)
+		}
+		want := 0
+		ops := rapid.SliceOf(rapid.IntRange(-5, 5)).Draw(rt, "ops")
+		for _, n := range ops {
+			switch {
+			case n > 0:
+				if _, err := r.Store("flat", 1, "scrap", n); err != nil {
+					rt.Fatal(err)
+				}
+				want += n
+			case n < 0:
+				if _, err := r.Take("flat", 1, "scrap", -n); err == nil {
+					want += n
+				} else if -n <= want {
+					rt.Fatalf("take %d failed with %d stored: %v", -n, want, err)
+				}
+			}
+		}
+		l, _ := r.Get("flat")
+		if l.Storage["scrap"] != want {
+			rt.Fatalf("stored %d, want %d", l.Storage["scrap"], want)
+		}
+		if _, present := l.Storage["scrap"]; present && want == 0 {
+			rt.Fatal("emptied item left a zero entry")
+		}
+	})
+}
+
+func TestRegistry_RenameOwner(t *testing.T) {
+	r := housing.NewRegistry()
+	_, err := r.Rent("flat_a", 1, "Ace", epoch.Add(time.Hour))
+	require.NoError(t, err)
+
+	assert.True(t, r.RenameOwner(1, "Acer"))
+	assert.False(t, r.RenameOwner(2, "Bee"), "characters without a lease are ignored")
+	l, _ := r.Get("flat_a")
+	assert.Equal(t, "Acer", l.OwnerName)
+}
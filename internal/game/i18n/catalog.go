@@ -0,0 +1,166 @@
+// Package i18n provides a message catalog for player-facing server narratives.
+//
+// Messages are keyed by a stable dotted ID (e.g. "move.blocked_in_combat") and
+// rendered through text/template, so resolution code never embeds English
+// text directly. The English catalog ships embedded in the binary and is the
+// fallback for every other locale; translations are loaded from
+// content/locales/<locale>.yaml.
+package i18n
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLocale is the locale used when a player has not chosen one and the
+// fallback for messages missing from a translation.
+const DefaultLocale = "en"
+
+//go:embed en.yaml
+var defaultMessages []byte
+
+// Args supplies named template values to a message.
+type Args map[string]any
+
+// Catalog holds compiled message templates per locale.
+//
+// A Catalog is immutable after construction and safe for concurrent use.
+type Catalog struct {
+	messages map[string]map[string]*template.Template
+}
+
+// newCatalog returns an empty catalog.
+func newCatalog() *Catalog {
+	return &Catalog{messages: make(map[string]map[string]*template.Template)}
+}
+
+// Default returns a catalog holding only the embedded English messages.
+//
+// Postcondition: Returns a non-nil catalog; panics only if the embedded file is malformed.
+func Default() *Catalog {
+	c := newCatalog()
+	if err := c.addYAML(DefaultLocale, defaultMessages); err != nil {
+		panic(fmt.Sprintf("i18n: embedded %s catalog: %v", DefaultLocale, err))
+	}
+	return c
+}
+
+// LoadCatalogFromDir returns the default catalog extended with every
+// <locale>.yaml file in dir. A file for the default locale overrides
+// individual embedded messages.
+//
+// Precondition: dir must be a readable directory path.
+// Postcondition: Returns a non-nil catalog or a non-nil error naming the offending file.
+func LoadCatalogFromDir(dir string) (*Catalog, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading locales dir %q: %w", dir, err)
+	}
+	c := Default()
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".yaml" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading locale file %q: %w", path, err)
+		}
+		locale := NormalizeLocale(strings.TrimSuffix(e.Name(), ".yaml"))
+		if err := c.addYAML(locale, data); err != nil {
+			return nil, fmt.Errorf("parsing locale file %q: %w", path, err)
+		}
+	}
+	return c, nil
+}
+
+// addYAML parses a flat id → template YAML mapping into locale.
+func (c *Catalog) addYAML(locale string, data []byte) error {
+	var raw map[string]string
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	msgs, ok := c.messages[locale]
+	if !ok {
+		msgs = make(map[string]*template.Template, len(raw))
+		c.messages[locale] = msgs
+	}
+	for id, text := range raw {
+		tmpl, err := template.New(id).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return fmt.Errorf("message %q: %w", id, err)
+		}
+		msgs[id] = tmpl
+	}
+	return nil
+}
+
+// NormalizeLocale lower-cases a locale tag and converts "_" to "-".
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+// HasLocale reports whether the catalog holds any messages for locale.
+func (c *Catalog) HasLocale(locale string) bool {
+	_, ok := c.messages[NormalizeLocale(locale)]
+	return ok
+}
+
+// Locales returns every locale in the catalog in sorted order.
+func (c *Catalog) Locales() []string {
+	out := make([]string, 0, len(c.messages))
+	for l := range c.messages {
+		out = append(out, l)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// lookup resolves id for locale, falling back to the base language of a
+// regional tag ("pt-br" → "pt") and then to DefaultLocale.
+func (c *Catalog) lookup(locale, id string) (*template.Template, bool) {
+	locale = NormalizeLocale(locale)
+	candidates := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, DefaultLocale)
+	for _, l := range candidates {
+		if tmpl, ok := c.messages[l][id]; ok {
+			return tmpl, true
+		}
+	}
+	return nil, false
+}
+
+// T renders message id for locale with args.
+//
+// Precondition: none; a nil catalog behaves like Default().
+// Postcondition: Returns the rendered message; if id is unknown in every
+// candidate locale, or the template fails to execute, returns id itself so
+// the gap is visible rather than silent.
+func (c *Catalog) T(locale, id string, args Args) string {
+	if c == nil {
+		c = defaultCatalog
+	}
+	tmpl, ok := c.lookup(locale, id)
+	if !ok {
+		return id
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any(args)); err != nil {
+		return id
+	}
+	return buf.String()
+}
+
+// defaultCatalog backs T on a nil *Catalog.
+var defaultCatalog = Default()
@@ -0,0 +1,69 @@
+package i18n_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/i18n"
+)
+
+func TestDefault_RendersEnglish(t *testing.T) {
+	c := i18n.Default()
+	assert.Equal(t, "You drop a knife.", c.T("en", "inventory.drop", i18n.Args{"item": "a knife"}))
+}
+
+func TestT_NilCatalogUsesDefault(t *testing.T) {
+	var c *i18n.Catalog
+	assert.Equal(t, "You cannot move while in combat.", c.T("fr", "move.in_combat", nil))
+}
+
+func TestT_UnknownIDReturnsID(t *testing.T) {
+	assert.Equal(t, "no.such.message", i18n.Default().T("en", "no.such.message", nil))
+}
+
+func TestT_MissingArgReturnsID(t *testing.T) {
+	assert.Equal(t, "inventory.drop", i18n.Default().T("en", "inventory.drop", nil))
+}
+
+func TestLoadCatalogFromDir_TranslationAndFallback(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pt.yaml"), []byte(`inventory.drop: "Você larga {{.item}}."`), 0o644))
+
+	c, err := i18n.LoadCatalogFromDir(dir)
+	require.NoError(t, err)
+	assert.True(t, c.HasLocale("pt"))
+	assert.Equal(t, []string{"en", "pt"}, c.Locales())
+	assert.Equal(t, "Você larga a faca.", c.T("pt", "inventory.drop", i18n.Args{"item": "a faca"}))
+	assert.Equal(t, "Você larga a faca.", c.T("pt_BR", "inventory.drop", i18n.Args{"item": "a faca"}), "regional tag must fall back to base language")
+	assert.Equal(t, "You don't have that.", c.T("pt", "inventory.not_carried", nil), "missing translation must fall back to English")
+}
+
+func TestLoadCatalogFromDir_BadTemplate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "xx.yaml"), []byte(`move.in_combat: "{{.broken"`), 0o644))
+	_, err := i18n.LoadCatalogFromDir(dir)
+	assert.Error(t, err)
+}
+
+func TestLoadCatalogFromDir_ShippedLocales(t *testing.T) {
+	c, err := i18n.LoadCatalogFromDir("../../../content/locales")
+	require.NoError(t, err)
+	assert.True(t, c.HasLocale("es"))
+}
+
+// TestProperty_T_NeverEmpty verifies that every rendered message is non-empty
+// for any locale string.
+func TestProperty_T_NeverEmpty(t *testing.T) {
+	c := i18n.Default()
+	rapid.Check(t, func(rt *rapid.T) {
+		locale := rapid.String().Draw(rt, "locale")
+		if got := c.T(locale, "move.in_combat", nil); got == "" {
+			rt.Fatalf("empty message for locale %q", locale)
+		}
+	})
+}
@@ -19,6 +19,7 @@ type fakeSession struct {
 func (f *fakeSession) GetTeam() string                                          { return f.team }
 func (f *fakeSession) GetStatModifier(stat string) int                          { return 0 }
 func (f *fakeSession) ApplyHeal(amount int)                                     {}
+func (f *fakeSession) ApplyTempHP(amount int) int                               { return amount }
 func (f *fakeSession) ApplyCondition(id string, dur time.Duration)              {}
 func (f *fakeSession) RemoveCondition(id string)                                {}
 func (f *fakeSession) ApplyDisease(id string, sev int)                          {}
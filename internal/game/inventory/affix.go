@@ -0,0 +1,221 @@
+package inventory
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Affix positions.
+const (
+	AffixPrefix = "prefix"
+	AffixSuffix = "suffix"
+)
+
+// AffixDef is a procedural prefix or suffix a dropped weapon or armor piece
+// can roll, such as "Rusty" or "of Precision". Its stat adjustments stack
+// with the item's definition and modifier.
+type AffixDef struct {
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	// Position is AffixPrefix ("Rusty Knife") or AffixSuffix ("Knife of Precision").
+	Position string `yaml:"position"`
+	// AppliesTo lists the item kinds that can roll this affix: weapon, armor.
+	AppliesTo []string `yaml:"applies_to"`
+	// Weight is the relative roll weight among affixes of the same position and kind.
+	Weight int `yaml:"weight"`
+	// AttackBonus adjusts attack rolls made with an affixed weapon.
+	AttackBonus int `yaml:"attack_bonus,omitempty"`
+	// DamageBonus adjusts damage dealt with an affixed weapon.
+	DamageBonus int `yaml:"damage_bonus,omitempty"`
+	// ACBonus adjusts the AC granted by an affixed armor piece.
+	ACBonus int `yaml:"ac_bonus,omitempty"`
+}
+
+// AppliesToKind reports whether the affix can roll on items of the given kind.
+func (a *AffixDef) AppliesToKind(kind string) bool {
+	for _, k := range a.AppliesTo {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the affix is well formed.
+//
+// Postcondition: Returns nil iff ID and Name are set, Position is prefix or
+// suffix, AppliesTo holds only weapon or armor, and Weight > 0.
+func (a *AffixDef) Validate() error {
+	if a.ID == "" || a.Name == "" {
+		return fmt.Errorf("affix must have an id and name")
+	}
+	if a.Position != AffixPrefix && a.Position != AffixSuffix {
+		return fmt.Errorf("affix %q: position must be %q or %q, got %q", a.ID, AffixPrefix, AffixSuffix, a.Position)
+	}
+	if len(a.AppliesTo) == 0 {
+		return fmt.Errorf("affix %q: applies_to must not be empty", a.ID)
+	}
+	for _, k := range a.AppliesTo {
+		if k != KindWeapon && k != KindArmor {
+			return fmt.Errorf("affix %q: applies_to must contain only %q or %q, got %q", a.ID, KindWeapon, KindArmor, k)
+		}
+	}
+	if a.Weight <= 0 {
+		return fmt.Errorf("affix %q: weight must be > 0, got %d", a.ID, a.Weight)
+	}
+	return nil
+}
+
+// AffixStats is the summed stat adjustment of a set of affixes.
+type AffixStats struct {
+	AttackBonus int
+	DamageBonus int
+	ACBonus     int
+}
+
+// affixFile is the top-level layout of the affixes YAML file.
+type affixFile struct {
+	// PrefixChance and SuffixChance are the independent probabilities in
+	// [0, 1] that a dropped weapon or armor piece rolls a prefix or suffix.
+	PrefixChance float64    `yaml:"prefix_chance"`
+	SuffixChance float64    `yaml:"suffix_chance"`
+	Affixes      []AffixDef `yaml:"affixes"`
+}
+
+// LoadAffixes reads the affix file at path and registers its affixes and
+// roll chances in reg.
+//
+// Precondition: reg must not be nil.
+// Postcondition: Returns an error for an unreadable file, a chance outside
+// [0, 1], or an invalid or duplicate affix; reg is unchanged on a parse error.
+func LoadAffixes(reg *Registry, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("LoadAffixes: cannot read file %q: %w", path, err)
+	}
+	var f affixFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("LoadAffixes: cannot parse file %q: %w", path, err)
+	}
+	if f.PrefixChance < 0 || f.PrefixChance > 1 || f.SuffixChance < 0 || f.SuffixChance > 1 {
+		return fmt.Errorf("LoadAffixes: %q: prefix_chance and suffix_chance must be in [0, 1]", path)
+	}
+	for i := range f.Affixes {
+		if err := f.Affixes[i].Validate(); err != nil {
+			return fmt.Errorf("LoadAffixes: %q: %w", path, err)
+		}
+	}
+	for i := range f.Affixes {
+		if err := reg.RegisterAffix(&f.Affixes[i]); err != nil {
+			return fmt.Errorf("LoadAffixes: %q: %w", path, err)
+		}
+	}
+	reg.SetAffixChances(f.PrefixChance, f.SuffixChance)
+	return nil
+}
+
+// affixRollScale is the resolution of affix chance rolls.
+const affixRollScale = 10000
+
+// RollAffixes rolls the affixes for a newly dropped item of the given kind:
+// independently, a prefix with the prefix chance and a suffix with the suffix
+// chance, each picked by weight from the affixes that apply to kind.
+//
+// Precondition: src must not be nil.
+// Postcondition: Returns at most one prefix followed by at most one suffix;
+// nil when kind is neither weapon nor armor or nothing was rolled.
+func (r *Registry) RollAffixes(kind string, src interface{ Intn(n int) int }) []string {
+	if kind != KindWeapon && kind != KindArmor {
+		return nil
+	}
+	var out []string
+	for _, pos := range []struct {
+		position string
+		chance   float64
+	}{{AffixPrefix, r.prefixChance}, {AffixSuffix, r.suffixChance}} {
+		if pos.chance <= 0 || src.Intn(affixRollScale) >= int(pos.chance*affixRollScale) {
+			continue
+		}
+		if id := r.pickAffix(kind, pos.position, src); id != "" {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// pickAffix returns a weighted random affix ID for kind and position, or ""
+// when none applies.
+func (r *Registry) pickAffix(kind, position string, src interface{ Intn(n int) int }) string {
+	var pool []*AffixDef
+	total := 0
+	for _, id := range r.affixOrder {
+		a := r.affixes[id]
+		if a.Position == position && a.AppliesToKind(kind) {
+			pool = append(pool, a)
+			total += a.Weight
+		}
+	}
+	if total == 0 {
+		return ""
+	}
+	roll := src.Intn(total)
+	for _, a := range pool {
+		roll -= a.Weight
+		if roll < 0 {
+			return a.ID
+		}
+	}
+	return ""
+}
+
+// AffixStats sums the stat adjustments of the given affix IDs. Unknown IDs
+// are ignored so that content removals do not break persisted items.
+func (r *Registry) AffixStats(ids []string) AffixStats {
+	var s AffixStats
+	for _, id := range ids {
+		a, ok := r.affixes[id]
+		if !ok {
+			continue
+		}
+		s.AttackBonus += a.AttackBonus
+		s.DamageBonus += a.DamageBonus
+		s.ACBonus += a.ACBonus
+	}
+	return s
+}
+
+// AffixedName decorates name with the prefixes and suffixes among ids, e.g.
+// "Rusty Combat Knife of Precision".
+//
+// Postcondition: Returns name unchanged when ids holds no known affix.
+func (r *Registry) AffixedName(name string, ids []string) string {
+	parts := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		if a, ok := r.affixes[id]; ok && a.Position == AffixPrefix {
+			parts = append(parts, a.Name)
+		}
+	}
+	parts = append(parts, name)
+	for _, id := range ids {
+		if a, ok := r.affixes[id]; ok && a.Position == AffixSuffix {
+			parts = append(parts, a.Name)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// InstanceName is the display name of an item instance: its affixed name,
+// led by its engraved custom name when it has one, e.g.
+// "Widowmaker (Rusty Combat Knife)".
+//
+// Postcondition: Returns AffixedName(name, ids) when customName is "".
+func (r *Registry) InstanceName(name, customName string, ids []string) string {
+	affixed := r.AffixedName(name, ids)
+	if customName == "" {
+		return affixed
+	}
+	return fmt.Sprintf("%s (%s)", customName, affixed)
+}
@@ -0,0 +1,96 @@
+package inventory_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// seqSource returns its values in order, repeating the last one.
+type seqSource struct {
+	vals []int
+	i    int
+}
+
+func (s *seqSource) Intn(n int) int {
+	v := s.vals[len(s.vals)-1]
+	if s.i < len(s.vals) {
+		v = s.vals[s.i]
+	}
+	s.i++
+	return v % n
+}
+
+func affixRegistry(t *testing.T, prefixChance, suffixChance float64) *inventory.Registry {
+	t.Helper()
+	reg := inventory.NewRegistry()
+	for _, a := range []*inventory.AffixDef{
+		{ID: "rusty", Name: "Rusty", Position: inventory.AffixPrefix, AppliesTo: []string{"weapon", "armor"}, Weight: 1, DamageBonus: -1, ACBonus: -1},
+		{ID: "hair_trigger", Name: "Hair-Trigger", Position: inventory.AffixPrefix, AppliesTo: []string{"weapon"}, Weight: 1, AttackBonus: 1},
+		{ID: "reinforced", Name: "Reinforced", Position: inventory.AffixPrefix, AppliesTo: []string{"armor"}, Weight: 1, ACBonus: 1},
+		{ID: "of_precision", Name: "of Precision", Position: inventory.AffixSuffix, AppliesTo: []string{"weapon"}, Weight: 1, AttackBonus: 1},
+	} {
+		require.NoError(t, reg.RegisterAffix(a))
+	}
+	reg.SetAffixChances(prefixChance, suffixChance)
+	return reg
+}
+
+func TestLoadAffixes_ContentFile(t *testing.T) {
+	reg := inventory.NewRegistry()
+	require.NoError(t, inventory.LoadAffixes(reg, "../../../content/affixes.yaml"))
+	a, ok := reg.Affix("hair_trigger")
+	require.True(t, ok)
+	assert.Equal(t, inventory.AffixPrefix, a.Position)
+	assert.True(t, a.AppliesToKind(inventory.KindWeapon))
+}
+
+func TestLoadAffixes_InvalidAffixIsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "affixes.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`prefix_chance: 0.5
+affixes:
+  - id: odd
+    name: Odd
+    position: infix
+    applies_to: [weapon]
+    weight: 1
+`), 0644))
+	err := inventory.LoadAffixes(inventory.NewRegistry(), path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "position")
+}
+
+func TestRegistry_RollAffixes_RespectsKindAndChance(t *testing.T) {
+	reg := affixRegistry(t, 1, 1)
+	// Chance roll 0, prefix pick 1 (hair_trigger), chance roll 0, suffix pick 0.
+	assert.Equal(t, []string{"hair_trigger", "of_precision"}, reg.RollAffixes(inventory.KindWeapon, &seqSource{vals: []int{0, 1, 0, 0}}))
+	// Armor's prefix pool is rusty, reinforced; no suffix applies to armor.
+	assert.Equal(t, []string{"reinforced"}, reg.RollAffixes(inventory.KindArmor, &seqSource{vals: []int{0, 1, 0, 0}}))
+	assert.Nil(t, reg.RollAffixes(inventory.KindJunk, &seqSource{vals: []int{0}}))
+
+	none := affixRegistry(t, 0, 0)
+	assert.Nil(t, none.RollAffixes(inventory.KindWeapon, &seqSource{vals: []int{0}}))
+}
+
+func TestRegistry_AffixedNameAndStats(t *testing.T) {
+	reg := affixRegistry(t, 1, 1)
+	ids := []string{"of_precision", "hair_trigger", "removed_affix"}
+	assert.Equal(t, "Hair-Trigger Combat Knife of Precision", reg.AffixedName("Combat Knife", ids))
+	assert.Equal(t, inventory.AffixStats{AttackBonus: 2}, reg.AffixStats(ids))
+	assert.Equal(t, "Combat Knife", reg.AffixedName("Combat Knife", nil))
+}
+
+func TestEquipment_ComputedDefenses_AddsAffixAC(t *testing.T) {
+	reg := affixRegistry(t, 1, 1)
+	require.NoError(t, reg.RegisterArmor(&inventory.ArmorDef{ID: "vest", Name: "Vest", Slot: inventory.SlotTorso, ACBonus: 2, DexCap: 5}))
+	eq := inventory.NewEquipment()
+	eq.Armor[inventory.SlotTorso] = &inventory.SlottedItem{ItemDefID: "vest", Name: "Vest", Affixes: []string{"reinforced"}}
+	assert.Equal(t, 3, eq.ComputedDefenses(reg, 0).ACBonus)
+	eq.Armor[inventory.SlotTorso].Affixes = []string{"rusty"}
+	assert.Equal(t, 1, eq.ComputedDefenses(reg, 0).ACBonus)
+}
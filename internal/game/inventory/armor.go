@@ -20,6 +20,9 @@ type CrossTeamEffect struct {
 // ArmorDef defines the static properties of an armor piece loaded from YAML.
 type ArmorDef struct {
 	ID              string           `yaml:"id"`
+	// Base is the ID of the armor this one inherits from; every field this
+	// armor does not set comes from the base. Resolved by LoadArmors.
+	Base            string           `yaml:"base,omitempty"`
 	Name            string           `yaml:"name"`
 	Description     string           `yaml:"description"`
 	Slot            ArmorSlot        `yaml:"slot"`
@@ -38,6 +41,8 @@ type ArmorDef struct {
 	Resistances map[string]int `yaml:"resistances"`
 	// Weaknesses maps damage type → flat addition applied to wearer. Additive across equipped slots.
 	Weaknesses  map[string]int `yaml:"weaknesses"`
+	// Immunities lists damage types that deal no damage to the wearer.
+	Immunities []string `yaml:"immunities"`
 	// Rarity is required (REQ-EM-1). One of: salvage, street, mil_spec, black_market, ghost.
 	Rarity string `yaml:"rarity"`
 	// RarityStatMultiplier is set at load time from the rarity tier constants (REQ-EM-2).
@@ -48,6 +53,16 @@ type ArmorDef struct {
 	// UpgradeSlots is the number of material upgrade slots available on this armor.
 	// Derived from RarityDef.FeatureSlots at load time. NOT loaded from YAML.
 	UpgradeSlots int `yaml:"-"`
+	// Tags is an optional list of freeform content labels (e.g. "heavy", "stealthy")
+	// used to select armor by tag; see Registry.ArmorsWithTag.
+	Tags []string `yaml:"tags,omitempty"`
+}
+
+// HasTag reports whether the armor's Tags list contains tag.
+//
+// Postcondition: Returns true iff tag is present in Tags.
+func (a *ArmorDef) HasTag(tag string) bool {
+	return hasTag(a.Tags, tag)
 }
 
 // validArmorSlots is the set of all legal ArmorSlot values.
@@ -123,6 +138,7 @@ func (a *ArmorDef) Validate() error {
 }
 
 // LoadArmors reads all .yaml files in dir and returns parsed ArmorDef slice.
+// An armor naming a base inherits every field it does not set from that armor.
 // Precondition: dir must be a path; if the directory does not exist, an empty slice and nil error are returned.
 // Postcondition: Returns non-nil slice and nil error on success; all returned defs pass Validate.
 func LoadArmors(dir string) ([]*ArmorDef, error) {
@@ -134,7 +150,7 @@ func LoadArmors(dir string) ([]*ArmorDef, error) {
 		return nil, fmt.Errorf("LoadArmors: cannot read directory %q: %w", dir, err)
 	}
 
-	var armors []*ArmorDef
+	var docs []defDoc
 	for _, entry := range entries {
 		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
 			continue
@@ -144,12 +160,20 @@ func LoadArmors(dir string) ([]*ArmorDef, error) {
 		if err != nil {
 			return nil, fmt.Errorf("LoadArmors: cannot read file %q: %w", path, err)
 		}
+		docs = append(docs, defDoc{path: path, data: data})
+	}
+	if err := resolveBases("armor", docs); err != nil {
+		return nil, fmt.Errorf("LoadArmors: %w", err)
+	}
+
+	var armors []*ArmorDef
+	for _, doc := range docs {
 		var a ArmorDef
-		if err := yaml.Unmarshal(data, &a); err != nil {
-			return nil, fmt.Errorf("LoadArmors: cannot parse file %q: %w", path, err)
+		if err := yaml.Unmarshal(doc.data, &a); err != nil {
+			return nil, fmt.Errorf("LoadArmors: cannot parse file %q: %w", doc.path, err)
 		}
 		if err := a.Validate(); err != nil {
-			return nil, fmt.Errorf("LoadArmors: invalid armor in %q: %w", path, err)
+			return nil, fmt.Errorf("LoadArmors: invalid armor in %q: %w", doc.path, err)
 		}
 		// REQ-EM-2: multiply ACBonus by rarity stat multiplier at load time; derive UpgradeSlots.
 		if def, ok := LookupRarity(a.Rarity); ok {
@@ -23,6 +23,17 @@ type ItemInstance struct {
 	// CombatScriptState holds per-encounter Lua state for AI items.
 	// Initialized as nil; set to an empty map at combat start; cleared at combat end.
 	CombatScriptState map[string]interface{}
+	// FuelUsed is the game hours of fuel this light source has burned; 0 = full.
+	FuelUsed int
+	// Affixes holds the IDs of the prefix and suffix AffixDefs this weapon or
+	// armor rolled when it dropped; empty for unaffixed items.
+	Affixes []string
+	// CustomName is the name engraved on this instance; "" when unnamed.
+	CustomName string
+	// MapRoomID is the room a treasure map points to; "" for other items.
+	MapRoomID string
+	// MapClues is how many of the treasure map's clues have been revealed.
+	MapClues int
 }
 
 // Backpack is a container with slot and weight limits.
@@ -78,6 +89,76 @@ func (b *Backpack) Add(itemDefID string, quantity int, reg *Registry) (*ItemInst
 	return b.addNonStackable(def, quantity)
 }
 
+// AddWithMetadata places quantity units of the given item into the backpack
+// carrying the instance-level state in meta. An item with state occupies its
+// own slot as a single instance and never merges into an existing stack.
+//
+// Precondition: quantity > 0, itemDefID exists in reg.
+// Postcondition: with nil meta, behaves exactly like Add; otherwise on success
+// one new instance holding meta is appended; on error, backpack state is unchanged.
+func (b *Backpack) AddWithMetadata(itemDefID string, quantity int, meta *ItemMetadata, reg *Registry) (*ItemInstance, error) {
+	if meta == nil || meta.IsZero() {
+		return b.Add(itemDefID, quantity, reg)
+	}
+	def, ok := reg.Item(itemDefID)
+	if !ok {
+		return nil, fmt.Errorf("backpack: unknown item %q", itemDefID)
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("backpack: quantity must be > 0")
+	}
+	if addedWeight, currentWeight := float64(quantity)*def.Weight, b.TotalWeight(reg); currentWeight+addedWeight > b.MaxWeight {
+		return nil, fmt.Errorf("backpack: adding %d of %q would exceed weight limit (%.2f + %.2f > %.2f)",
+			quantity, itemDefID, currentWeight, addedWeight, b.MaxWeight)
+	}
+	if len(b.items) >= b.MaxSlots {
+		return nil, fmt.Errorf("backpack: not enough slots")
+	}
+	inst := ItemInstance{
+		InstanceID: uuid.New().String(),
+		ItemDefID:  def.ID,
+		Quantity:   quantity,
+	}
+	inst.ApplyMetadata(meta)
+	b.items = append(b.items, inst)
+	return &b.items[len(b.items)-1], nil
+}
+
+// Fits reports whether AddWithMetadata with the same arguments would succeed,
+// without changing the backpack.
+//
+// Postcondition: returns the error AddWithMetadata would return, or nil;
+// backpack state is unchanged.
+func (b *Backpack) Fits(itemDefID string, quantity int, meta *ItemMetadata, reg *Registry) error {
+	trial := &Backpack{MaxSlots: b.MaxSlots, MaxWeight: b.MaxWeight, items: append([]ItemInstance(nil), b.items...)}
+	_, err := trial.AddWithMetadata(itemDefID, quantity, meta, reg)
+	return err
+}
+
+// InventoryItems returns the backpack contents in persisted form. Instances
+// without state are merged into one entry per item def ID; each instance with
+// state gets its own entry carrying its Metadata.
+//
+// Postcondition: entries appear in backpack order of their first instance.
+func (b *Backpack) InventoryItems() []InventoryItem {
+	var out []InventoryItem
+	plain := make(map[string]int)
+	for i := range b.items {
+		inst := &b.items[i]
+		if meta := inst.Metadata(); meta != nil {
+			out = append(out, InventoryItem{ItemDefID: inst.ItemDefID, Quantity: inst.Quantity, Metadata: meta})
+			continue
+		}
+		if idx, ok := plain[inst.ItemDefID]; ok {
+			out[idx].Quantity += inst.Quantity
+			continue
+		}
+		plain[inst.ItemDefID] = len(out)
+		out = append(out, InventoryItem{ItemDefID: inst.ItemDefID, Quantity: inst.Quantity})
+	}
+	return out
+}
+
 func (b *Backpack) addStackable(def *ItemDef, quantity int) (*ItemInstance, error) {
 	type mergeTarget struct {
 		index  int
@@ -121,6 +121,24 @@ func TestBackpack_Add_RejectsSlotOverflow(t *testing.T) {
 	}
 }
 
+func TestBackpack_Fits_LeavesBackpackUnchanged(t *testing.T) {
+	reg := makeRegistry(stackDef("ammo", 0.1, 10))
+	bp := inventory.NewBackpack(1, 100.0)
+	if _, err := bp.Add("ammo", 6, reg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := bp.Fits("ammo", 4, nil, reg); err != nil {
+		t.Errorf("expected 4 more to fit the stack: %v", err)
+	}
+	if err := bp.Fits("ammo", 5, nil, reg); err == nil {
+		t.Error("expected error when the remainder needs a second slot")
+	}
+	if got := bp.Items(); len(got) != 1 || got[0].Quantity != 6 {
+		t.Errorf("Fits changed the backpack: %+v", got)
+	}
+}
+
 func TestBackpack_Add_RejectsWeightOverflow(t *testing.T) {
 	def := junkDef("boulder", 10.0)
 	reg := makeRegistry(def)
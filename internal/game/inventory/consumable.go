@@ -10,6 +10,9 @@ import (
 type ConsumableEffect struct {
 	// Heal is a dice string (e.g. "2d6+4") for HP restoration. Empty = no heal.
 	Heal string `yaml:"heal,omitempty"`
+	// TempHP is a dice string (e.g. "1d4") for temporary hit points granted in
+	// combat. Empty = none.
+	TempHP string `yaml:"temp_hp,omitempty"`
 	// Conditions are condition effects applied after RemoveConditions.
 	Conditions []ConditionEffect `yaml:"conditions,omitempty"`
 	// RemoveConditions lists condition IDs to remove before applying new conditions.
@@ -62,6 +65,9 @@ type ConsumableTarget interface {
 	// Returns 0 for unknown stat names.
 	GetStatModifier(stat string) int
 	ApplyHeal(amount int)
+	// ApplyTempHP grants amount temporary hit points and returns the amount
+	// actually granted (0 when the target is not in combat or already has more).
+	ApplyTempHP(amount int) int
 	ApplyCondition(conditionID string, duration time.Duration)
 	RemoveCondition(conditionID string)
 	ApplyDisease(diseaseID string, severity int)
@@ -71,6 +77,7 @@ type ConsumableTarget interface {
 // ConsumableResult records the resolved effects for display and auditing.
 type ConsumableResult struct {
 	HealApplied        int
+	TempHPApplied      int
 	ConditionsApplied  []string
 	ConditionsRemoved  []string
 	DiseaseApplied     string
@@ -107,7 +114,7 @@ func TeamMultiplier(playerTeam, itemTeam string) float64 {
 //
 // Effect ordering (REQ-EM-43):
 //  1. RemoveConditions
-//  2. Heal (team-multiplied, floored)
+//  2. Heal and temporary HP (team-multiplied, floored)
 //  3. New Conditions (team-multiplied duration)
 //  4. ConsumeCheck (d20 + stat modifier vs DC; natural-1 or total ≤ DC-10 = critical failure)
 //
@@ -143,6 +150,12 @@ func ApplyConsumable(target ConsumableTarget, def *ItemDef, rng Roller) Consumab
 		}
 		result.HealApplied = healAmt
 	}
+	if eff.TempHP != "" {
+		tempAmt := int(math.Floor(float64(rng.Roll(eff.TempHP)) * result.TeamMultiplier))
+		if tempAmt > 0 {
+			result.TempHPApplied = target.ApplyTempHP(tempAmt)
+		}
+	}
 
 	// Step 3: apply new conditions.
 	for _, ce := range eff.Conditions {
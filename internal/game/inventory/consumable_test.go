@@ -17,6 +17,7 @@ type stubTarget struct {
 	team               string
 	statModifiers      map[string]int // for GetStatModifier
 	healApplied        int
+	tempHPApplied      int
 	conditionsApplied  []string
 	conditionsRemoved  []string
 	diseaseApplied     string
@@ -31,6 +32,10 @@ func (s *stubTarget) GetStatModifier(stat string) int {
 	return s.statModifiers[stat]
 }
 func (s *stubTarget) ApplyHeal(amount int) { s.healApplied += amount }
+func (s *stubTarget) ApplyTempHP(amount int) int {
+	s.tempHPApplied = max(s.tempHPApplied, amount)
+	return amount
+}
 func (s *stubTarget) ApplyCondition(conditionID string, _ time.Duration) {
 	s.conditionsApplied = append(s.conditionsApplied, conditionID)
 }
@@ -121,6 +126,21 @@ func TestApplyConsumable_HealOpposingTeam(t *testing.T) {
 	assert.InDelta(t, 0.75, result.TeamMultiplier, 1e-9)
 }
 
+func TestApplyConsumable_TempHPMatchingTeam(t *testing.T) {
+	target := &stubTarget{team: "gun"}
+	def := &inventory.ItemDef{
+		ID: "old_english", Name: "Old English", Kind: "consumable",
+		MaxStack: 1, Weight: 0.3, Team: "gun",
+		Effect: &inventory.ConsumableEffect{TempHP: "1d4"},
+	}
+	rng := &stubRoller{rollResult: 4}
+	result := inventory.ApplyConsumable(target, def, rng)
+	// 4 * 1.25 = 5.0 → floor → 5
+	assert.Equal(t, 5, result.TempHPApplied)
+	assert.Equal(t, 5, target.tempHPApplied)
+	assert.Zero(t, result.HealApplied)
+}
+
 // ── RemoveConditions before applying new ones ─────────────────────────────────
 
 func TestApplyConsumable_RemoveConditionsFirst(t *testing.T) {
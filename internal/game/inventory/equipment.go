@@ -1,5 +1,7 @@
 package inventory
 
+import "slices"
+
 // ArmorSlot identifies a body-armor equipment slot.
 type ArmorSlot string
 
@@ -99,6 +101,10 @@ type SlottedItem struct {
 	AffixedMaterials          []string // each entry: "<material_id>:<grade_id>"
 	// MaterialMaxDurabilityBonus is a cached copy; effective max = base MaxDurability + this
 	MaterialMaxDurabilityBonus int
+	// Affixes is a copy of the worn ItemInstance's rolled affix IDs.
+	Affixes []string
+	// CustomName is a copy of the worn ItemInstance's engraved name.
+	CustomName string
 }
 
 // Equipment holds all armor and accessory slots for a character.
@@ -135,6 +141,8 @@ type DefenseStats struct {
 	Resistances map[string]int
 	// Weaknesses maps damage type → total flat addition (sum across all sources).
 	Weaknesses map[string]int
+	// Immunities lists every damage type any equipped slot grants immunity to, without duplicates.
+	Immunities []string
 }
 
 // ComputedDefenses aggregates PF2e-style defense stats from all currently equipped armor slots.
@@ -171,6 +179,7 @@ func (e *Equipment) ComputedDefenses(reg *Registry, dexMod int) DefenseStats {
 		case "cursed":
 			slotAC -= 2
 		}
+		slotAC += reg.AffixStats(slotted.Affixes).ACBonus
 		stats.ACBonus += slotAC
 		stats.CheckPenalty += def.CheckPenalty
 		stats.SpeedPenalty += def.SpeedPenalty
@@ -189,6 +198,11 @@ func (e *Equipment) ComputedDefenses(reg *Registry, dexMod int) DefenseStats {
 		for dmgType, val := range def.Weaknesses {
 			stats.Weaknesses[dmgType] += val
 		}
+		for _, dmgType := range def.Immunities {
+			if !slices.Contains(stats.Immunities, dmgType) {
+				stats.Immunities = append(stats.Immunities, dmgType)
+			}
+		}
 	}
 	if stats.EffectiveDex > dexMod {
 		stats.EffectiveDex = dexMod
@@ -282,6 +296,7 @@ func (e *Equipment) ComputedDefensesWithProficiencies(reg *Registry, dexMod int,
 		case "cursed":
 			slotAC -= 2
 		}
+		slotAC += reg.AffixStats(slotted.Affixes).ACBonus
 
 		// Determine proficiency rank for this slot's category.
 		rank := ""
@@ -321,6 +336,11 @@ func (e *Equipment) ComputedDefensesWithProficiencies(reg *Registry, dexMod int,
 		for dmgType, val := range def.Weaknesses {
 			stats.Weaknesses[dmgType] += val
 		}
+		for _, dmgType := range def.Immunities {
+			if !slices.Contains(stats.Immunities, dmgType) {
+				stats.Immunities = append(stats.Immunities, dmgType)
+			}
+		}
 	}
 
 	// Apply the proficiency bonus exactly once, using the heaviest proficient category.
@@ -48,6 +48,25 @@ func TestComputedDefenses_Weaknesses_Additive(t *testing.T) {
 	assert.Equal(t, 5, def.Weaknesses["electricity"])
 }
 
+func TestComputedDefenses_Immunities_UnionWithoutDuplicates(t *testing.T) {
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterArmor(&inventory.ArmorDef{
+		ID: "suit", Name: "Suit", Slot: inventory.SlotTorso, Group: "leather",
+		ProficiencyCategory: "light_armor",
+		Immunities:          []string{"poison", "acid"},
+	}))
+	require.NoError(t, reg.RegisterArmor(&inventory.ArmorDef{
+		ID: "mask", Name: "Mask", Slot: inventory.SlotHead, Group: "leather",
+		ProficiencyCategory: "light_armor",
+		Immunities:          []string{"poison"},
+	}))
+	eq := inventory.NewEquipment()
+	eq.Armor[inventory.SlotTorso] = &inventory.SlottedItem{ItemDefID: "suit", Name: "Suit"}
+	eq.Armor[inventory.SlotHead] = &inventory.SlottedItem{ItemDefID: "mask", Name: "Mask"}
+	assert.ElementsMatch(t, []string{"poison", "acid"}, eq.ComputedDefenses(reg, 2).Immunities)
+	assert.ElementsMatch(t, []string{"poison", "acid"}, eq.ComputedDefensesWithProficiencies(reg, 2, nil, 1).Immunities)
+}
+
 func TestComputedDefenses_NoResistances_EmptyMaps(t *testing.T) {
 	reg := inventory.NewRegistry()
 	eq := inventory.NewEquipment()
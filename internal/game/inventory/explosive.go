@@ -59,6 +59,25 @@ type ExplosiveDef struct {
 	// AoeWidth is the width in feet for line shapes. Defaults to 5 ft when zero (AOE-3).
 	AoeWidth int      `yaml:"aoe_width,omitempty"`
 	Traits   []string `yaml:"traits"`
+	// Lingering, when set, is the area effect the blast leaves in the room
+	// (burning ground, a gas cloud). Nil means the blast is over at once.
+	Lingering *LingeringEffect `yaml:"lingering,omitempty"`
+	// KnockbackFt is how far the blast throws a target that fails its save,
+	// doubled on a critical failure. Zero means no knockback.
+	KnockbackFt int `yaml:"knockback_ft,omitempty"`
+}
+
+// LingeringEffect is a persisting area effect an explosive leaves behind. It
+// damages everyone in the fight at the end of each round, and anyone who
+// enters the room, until its rounds run out.
+type LingeringEffect struct {
+	// Name is shown on the floor and in narratives, e.g. "burning ground".
+	Name string `yaml:"name"`
+	// Rounds is how many round ends the effect lasts.
+	Rounds     int    `yaml:"rounds"`
+	DamageDice string `yaml:"damage_dice"`
+	// DamageType defaults to the explosive's damage type when empty.
+	DamageType string `yaml:"damage_type,omitempty"`
 }
 
 // Validate checks that the ExplosiveDef satisfies its invariants.
@@ -87,6 +106,20 @@ func (e *ExplosiveDef) Validate() error {
 	if err := aoe.ValidateAoeFields(e.AoeShape, e.AoERadius, e.AoeLength, e.AoeWidth); err != nil {
 		errs = append(errs, err)
 	}
+	if e.KnockbackFt < 0 {
+		errs = append(errs, errors.New("KnockbackFt must be >= 0"))
+	}
+	if l := e.Lingering; l != nil {
+		if l.Name == "" {
+			errs = append(errs, errors.New("Lingering.Name must not be empty"))
+		}
+		if l.Rounds <= 0 {
+			errs = append(errs, errors.New("Lingering.Rounds must be > 0"))
+		}
+		if l.DamageDice == "" {
+			errs = append(errs, errors.New("Lingering.DamageDice must not be empty"))
+		}
+	}
 	if len(errs) > 0 {
 		return fmt.Errorf("explosive validation failed: %v", errs)
 	}
@@ -134,3 +134,49 @@ func TestExplosiveDef_FriendlyFire_ParsedFromYAML(t *testing.T) {
 		require.NoError(t, e.Validate())
 	}
 }
+
+func TestExplosiveDef_Validate_Lingering(t *testing.T) {
+	e := &inventory.ExplosiveDef{
+		ID: "gas_grenade", Name: "Gas Grenade", DamageDice: "1d6", DamageType: "poison",
+		SaveType: "reflex", SaveDC: 13,
+		Lingering: &inventory.LingeringEffect{Name: "gas cloud", Rounds: 3, DamageDice: "1d4"},
+	}
+	require.NoError(t, e.Validate())
+	e.Lingering.Rounds = 0
+	assert.Error(t, e.Validate(), "a lingering effect must last at least one round")
+}
+
+func TestLoadExplosives_IncendiaryLeavesBurningGround(t *testing.T) {
+	explosives, err := inventory.LoadExplosives("../../../content/explosives")
+	require.NoError(t, err)
+	for _, e := range explosives {
+		if e.ID == "incendiary_grenade" {
+			require.NotNil(t, e.Lingering)
+			assert.Equal(t, "burning ground", e.Lingering.Name)
+			return
+		}
+	}
+	t.Fatal("incendiary_grenade not found")
+}
+
+func TestExplosiveDef_Validate_Knockback(t *testing.T) {
+	e := &inventory.ExplosiveDef{
+		ID: "frag_grenade", Name: "Frag Grenade", DamageDice: "4d6", DamageType: "piercing",
+		SaveType: "reflex", SaveDC: 15, KnockbackFt: 5,
+	}
+	require.NoError(t, e.Validate())
+	e.KnockbackFt = -5
+	assert.Error(t, e.Validate(), "knockback distance must not be negative")
+}
+
+func TestLoadExplosives_FragGrenadeKnocksBack(t *testing.T) {
+	explosives, err := inventory.LoadExplosives("../../../content/explosives")
+	require.NoError(t, err)
+	for _, e := range explosives {
+		if e.ID == "frag_grenade" {
+			assert.Equal(t, 5, e.KnockbackFt)
+			return
+		}
+	}
+	t.Fatal("frag_grenade not found")
+}
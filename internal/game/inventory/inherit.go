@@ -0,0 +1,116 @@
+package inventory
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defDoc is one definition file's YAML as read from disk.
+type defDoc struct {
+	path string
+	data []byte
+}
+
+// defFields is a parsed defDoc, keyed for base lookup.
+type defFields struct {
+	path   string
+	id     string
+	base   string
+	fields map[string]any
+}
+
+// resolveBases applies each document's base chain in place. A definition
+// naming a base inherits every field it does not set from that definition,
+// which may live in any other file of the same kind: nested maps such as
+// resistances merge key by key, while lists and scalars replace the base's
+// value. Documents without a base are left byte for byte as read.
+//
+// Precondition: kind names the definition type for error messages.
+// Postcondition: Returns an error naming the file for the first unparsable
+// document, unknown base, or inheritance cycle.
+func resolveBases(kind string, docs []defDoc) error {
+	parsed := make([]defFields, len(docs))
+	byID := make(map[string]*defFields, len(docs))
+	for i, doc := range docs {
+		var fields map[string]any
+		if err := yaml.Unmarshal(doc.data, &fields); err != nil {
+			return fmt.Errorf("cannot parse file %q: %w", doc.path, err)
+		}
+		id, _ := fields["id"].(string)
+		base, _ := fields["base"].(string)
+		parsed[i] = defFields{path: doc.path, id: id, base: base, fields: fields}
+		if id != "" {
+			byID[id] = &parsed[i]
+		}
+	}
+	for i := range parsed {
+		if parsed[i].base == "" {
+			continue
+		}
+		fields, err := inheritedDefFields(kind, &parsed[i], byID, nil)
+		if err != nil {
+			return fmt.Errorf("invalid %s in %q: %w", kind, parsed[i].path, err)
+		}
+		data, err := yaml.Marshal(fields)
+		if err != nil {
+			return fmt.Errorf("invalid %s in %q: %w", kind, parsed[i].path, err)
+		}
+		docs[i].data = data
+	}
+	return nil
+}
+
+// inheritedDefFields returns def's fields layered over those of its base
+// chain. chain holds the IDs already visited, for cycle detection.
+func inheritedDefFields(kind string, def *defFields, byID map[string]*defFields, chain []string) (map[string]any, error) {
+	for _, id := range chain {
+		if id == def.id {
+			return nil, fmt.Errorf("%s %q: base cycle %s", kind, chain[0], strings.Join(append(chain, def.id), " -> "))
+		}
+	}
+	if def.base == "" {
+		return def.fields, nil
+	}
+	base, ok := byID[def.base]
+	if !ok {
+		return nil, fmt.Errorf("%s %q: unknown base %q", kind, def.id, def.base)
+	}
+	inherited, err := inheritedDefFields(kind, base, byID, append(chain, def.id))
+	if err != nil {
+		return nil, err
+	}
+	return mergeDefFields(inherited, def.fields), nil
+}
+
+// mergeDefFields layers over onto base: maps merge recursively, anything else
+// in over replaces base's value.
+//
+// Postcondition: Neither argument is modified.
+func mergeDefFields(base, over map[string]any) map[string]any {
+	out := make(map[string]any, len(base)+len(over))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range over {
+		if bm, ok := out[k].(map[string]any); ok {
+			if om, ok := v.(map[string]any); ok {
+				out[k] = mergeDefFields(bm, om)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// hasTag reports whether tags contains tag.
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
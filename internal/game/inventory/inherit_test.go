@@ -0,0 +1,144 @@
+package inventory_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+const knifeBaseYAML = `id: knife
+name: Knife
+damage_dice: 1d4
+damage_type: slashing
+range_increment: 0
+kind: one_handed
+group: blade
+proficiency_category: simple_weapons
+rarity: salvage
+traits: [agile]
+tags: [melee, blade]
+`
+
+func writeDefs(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, body := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0644))
+	}
+}
+
+func TestLoadWeapons_BaseSuppliesUnsetFields(t *testing.T) {
+	dir := t.TempDir()
+	writeDefs(t, dir, map[string]string{
+		"knife.yaml": knifeBaseYAML,
+		"combat_knife.yaml": `id: combat_knife
+base: knife
+name: Combat Knife
+damage_dice: 1d6
+rarity: street
+`,
+	})
+	weapons, err := inventory.LoadWeapons(dir)
+	require.NoError(t, err)
+	require.Len(t, weapons, 2)
+	var variant *inventory.WeaponDef
+	for _, w := range weapons {
+		if w.ID == "combat_knife" {
+			variant = w
+		}
+	}
+	require.NotNil(t, variant)
+	assert.Equal(t, "Combat Knife", variant.Name)
+	assert.Equal(t, "1d6", variant.DamageDice)
+	assert.Equal(t, "slashing", variant.DamageType)
+	assert.Equal(t, "blade", variant.Group)
+	assert.Equal(t, []string{"agile"}, variant.Traits)
+	assert.Equal(t, []string{"melee", "blade"}, variant.Tags)
+	assert.Equal(t, "street", variant.Rarity)
+}
+
+func TestLoadWeapons_UnknownBaseIsError(t *testing.T) {
+	dir := t.TempDir()
+	writeDefs(t, dir, map[string]string{
+		"combat_knife.yaml": "id: combat_knife\nbase: knife\nname: Combat Knife\n",
+	})
+	_, err := inventory.LoadWeapons(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown base "knife"`)
+}
+
+func TestLoadItems_BaseCycleIsError(t *testing.T) {
+	dir := t.TempDir()
+	writeDefs(t, dir, map[string]string{
+		"a.yaml": "id: a\nbase: b\nname: A\nkind: junk\nmax_stack: 1\n",
+		"b.yaml": "id: b\nbase: a\nname: B\nkind: junk\nmax_stack: 1\n",
+	})
+	_, err := inventory.LoadItems(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "base cycle")
+}
+
+func TestLoadArmors_BaseMergesResistancesAndAppliesRarityOnce(t *testing.T) {
+	dir := t.TempDir()
+	writeDefs(t, dir, map[string]string{
+		"vest.yaml": `id: vest
+name: Vest
+slot: torso
+ac_bonus: 2
+dex_cap: 3
+strength_req: 10
+bulk: 1
+group: composite
+proficiency_category: light_armor
+rarity: salvage
+resistances:
+  piercing: 2
+tags: [light]
+`,
+		"fire_vest.yaml": `id: fire_vest
+base: vest
+name: Fire Vest
+resistances:
+  fire: 3
+`,
+	})
+	armors, err := inventory.LoadArmors(dir)
+	require.NoError(t, err)
+	var variant *inventory.ArmorDef
+	for _, a := range armors {
+		if a.ID == "fire_vest" {
+			variant = a
+		}
+	}
+	require.NotNil(t, variant)
+	assert.Equal(t, 2, variant.ACBonus)
+	assert.Equal(t, map[string]int{"piercing": 2, "fire": 3}, variant.Resistances)
+	assert.True(t, variant.HasTag("light"))
+}
+
+func TestRegistry_ItemsWithTag_IncludesWeaponAndArmorTags(t *testing.T) {
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterWeapon(&inventory.WeaponDef{ID: "knife", Tags: []string{"melee"}}))
+	require.NoError(t, reg.RegisterWeapon(&inventory.WeaponDef{ID: "pistol", Tags: []string{"ranged"}}))
+	require.NoError(t, reg.RegisterArmor(&inventory.ArmorDef{ID: "vest", Tags: []string{"light"}}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "knife_item", Name: "Knife", Kind: inventory.KindWeapon, WeaponRef: "knife", MaxStack: 1}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "pistol_item", Name: "Pistol", Kind: inventory.KindWeapon, WeaponRef: "pistol", MaxStack: 1}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "vest_item", Name: "Vest", Kind: inventory.KindArmor, ArmorRef: "vest", MaxStack: 1}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "pipe", Name: "Pipe", Kind: inventory.KindJunk, MaxStack: 1, Tags: []string{"melee"}}))
+
+	var ids []string
+	for _, d := range reg.ItemsWithTag("melee") {
+		ids = append(ids, d.ID)
+	}
+	assert.Equal(t, []string{"knife_item", "pipe"}, ids)
+	assert.True(t, reg.ItemHasTag("vest_item", "light"))
+	assert.False(t, reg.ItemHasTag("pistol_item", "melee"))
+	assert.False(t, reg.ItemHasTag("missing", "melee"))
+	require.Len(t, reg.WeaponsWithTag("ranged"), 1)
+	require.Len(t, reg.ArmorsWithTag("light"), 1)
+	assert.Empty(t, reg.ItemsWithTag("nothing"))
+}
@@ -34,6 +34,9 @@ var validKinds = map[string]bool{
 // ItemDef defines the static properties of an inventory item loaded from YAML.
 type ItemDef struct {
 	ID           string  `yaml:"id"`
+	// Base is the ID of the item this one inherits from; every field this
+	// item does not set comes from the base. Resolved by LoadItems.
+	Base         string  `yaml:"base,omitempty"`
 	Name         string  `yaml:"name"`
 	Description  string  `yaml:"description"`
 	Kind         string  `yaml:"kind"`
@@ -87,6 +90,8 @@ type ItemDef struct {
 	// Required when Kind == KindPreciousMaterial. Valid values: weapon, armor.
 	AppliesTo []string `yaml:"applies_to,omitempty"`
 	// Tags is an optional list of content labels for this item (e.g., "fire_material", "camping_gear").
+	// Loot tables, recipe inputs, and merchant stock can select items by tag;
+	// see Registry.ItemsWithTag.
 	Tags []string `yaml:"tags,omitempty"`
 	// CombatDomain is the HTN domain ID that drives this item's combat behavior.
 	// An empty string means the item is not an AI item.
@@ -94,6 +99,15 @@ type ItemDef struct {
 	// CombatScript is the Lua source that defines operator implementations and
 	// precondition hooks for the named HTN domain.
 	CombatScript string `yaml:"combat_script,omitempty"`
+	// LightFuel marks the item as a light source and is its full fuel load in
+	// game hours. 0 means the item gives no light.
+	LightFuel int `yaml:"light_fuel,omitempty"`
+	// TreasureCache marks the item as a treasure map and describes the cache
+	// buried in the room each map instance points to. nil for other items.
+	TreasureCache *TreasureCacheDef `yaml:"treasure_cache,omitempty"`
+	// EscapeDC is the DC a prisoner bound with this restraint must beat to
+	// slip free. 0 means the default; only meaningful for restraint-tagged items.
+	EscapeDC int `yaml:"escape_dc,omitempty"`
 }
 
 // HasTag returns true if item has the given tag in its Tags slice.
@@ -101,12 +115,7 @@ type ItemDef struct {
 // Precondition: d must be non-nil.
 // Postcondition: Returns true iff tag is present in Tags; false otherwise.
 func (d *ItemDef) HasTag(tag string) bool {
-	for _, t := range d.Tags {
-		if t == tag {
-			return true
-		}
-	}
-	return false
+	return hasTag(d.Tags, tag)
 }
 
 // RechargeEntry defines one recharge trigger for an activatable item.
@@ -189,6 +198,20 @@ func (d *ItemDef) Validate() error {
 	if d.ActivationCost > 0 && d.Charges <= 0 {
 		errs = append(errs, fmt.Errorf("charges must be > 0 when activation_cost > 0 (got %d)", d.Charges))
 	}
+	if d.LightFuel < 0 {
+		errs = append(errs, fmt.Errorf("light_fuel must be >= 0 (got %d)", d.LightFuel))
+	}
+	if d.EscapeDC < 0 {
+		errs = append(errs, fmt.Errorf("escape_dc must be >= 0 (got %d)", d.EscapeDC))
+	}
+	if d.TreasureCache != nil {
+		if d.Stackable {
+			errs = append(errs, errors.New("treasure_cache requires a non-stackable item"))
+		}
+		if err := d.TreasureCache.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	// REQ-ACT-8: OnDeplete must be "", "destroy", or "expend".
 	if d.OnDeplete != "" && d.OnDeplete != "destroy" && d.OnDeplete != "expend" {
 		errs = append(errs, fmt.Errorf("on_deplete %q is invalid; must be \"destroy\" or \"expend\"", d.OnDeplete))
@@ -290,7 +313,8 @@ func ValidateConsumableEffects(items []*ItemDef, knownConditions map[string]bool
 }
 
 // LoadItems reads all *.yaml and *.yml files from dir, parses each as an
-// ItemDef, validates it, and returns the collected slice.
+// ItemDef, validates it, and returns the collected slice. An item naming a
+// base inherits every field it does not set from that item.
 //
 // Precondition: dir is a readable directory path.
 // Postcondition: returns all valid ItemDefs or the first encountered error.
@@ -300,7 +324,7 @@ func LoadItems(dir string) ([]*ItemDef, error) {
 		return nil, fmt.Errorf("LoadItems: cannot read directory %q: %w", dir, err)
 	}
 
-	var items []*ItemDef
+	var docs []defDoc
 	for _, entry := range entries {
 		ext := filepath.Ext(entry.Name())
 		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
@@ -311,12 +335,20 @@ func LoadItems(dir string) ([]*ItemDef, error) {
 		if err != nil {
 			return nil, fmt.Errorf("LoadItems: cannot read file %q: %w", path, err)
 		}
+		docs = append(docs, defDoc{path: path, data: data})
+	}
+	if err := resolveBases("item", docs); err != nil {
+		return nil, fmt.Errorf("LoadItems: %w", err)
+	}
+
+	var items []*ItemDef
+	for _, doc := range docs {
 		var d ItemDef
-		if err := yaml.Unmarshal(data, &d); err != nil {
-			return nil, fmt.Errorf("LoadItems: cannot parse file %q: %w", path, err)
+		if err := yaml.Unmarshal(doc.data, &d); err != nil {
+			return nil, fmt.Errorf("LoadItems: cannot parse file %q: %w", doc.path, err)
 		}
 		if err := d.Validate(); err != nil {
-			return nil, fmt.Errorf("LoadItems: invalid item in %q: %w", path, err)
+			return nil, fmt.Errorf("LoadItems: invalid item in %q: %w", doc.path, err)
 		}
 		items = append(items, &d)
 	}
@@ -0,0 +1,26 @@
+package inventory
+
+// IsLightSource reports whether the item gives light when lit.
+//
+// Precondition: d must be non-nil.
+// Postcondition: Returns true iff LightFuel > 0.
+func (d *ItemDef) IsLightSource() bool {
+	return d.LightFuel > 0
+}
+
+// FuelRemaining returns the game hours of fuel left in a light source.
+//
+// Precondition: inst and def must be non-nil; def describes inst.
+// Postcondition: Returns a value in [0, def.LightFuel].
+func FuelRemaining(inst *ItemInstance, def *ItemDef) int {
+	return max(def.LightFuel-inst.FuelUsed, 0)
+}
+
+// BurnFuel burns hours of fuel from a light source.
+//
+// Precondition: inst and def must be non-nil; def describes inst; hours >= 0.
+// Postcondition: inst.FuelUsed never exceeds def.LightFuel; returns the fuel left.
+func BurnFuel(inst *ItemInstance, def *ItemDef, hours int) int {
+	inst.FuelUsed = min(inst.FuelUsed+hours, def.LightFuel)
+	return FuelRemaining(inst, def)
+}
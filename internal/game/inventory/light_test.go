@@ -0,0 +1,41 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+func TestBurnFuel_StopsAtEmpty(t *testing.T) {
+	def := &ItemDef{ID: "flashlight", LightFuel: 3}
+	inst := &ItemInstance{ItemDefID: "flashlight"}
+	assert.True(t, def.IsLightSource())
+	assert.Equal(t, 3, FuelRemaining(inst, def))
+	assert.Equal(t, 1, BurnFuel(inst, def, 2))
+	assert.Equal(t, 0, BurnFuel(inst, def, 5))
+	assert.Equal(t, 3, inst.FuelUsed)
+	assert.False(t, (&ItemDef{ID: "rock"}).IsLightSource())
+}
+
+func TestItemDef_Validate_RejectsNegativeLightFuel(t *testing.T) {
+	def := &ItemDef{ID: "torch", Name: "Torch", Kind: KindJunk, MaxStack: 1, LightFuel: -1}
+	assert.Error(t, def.Validate())
+	def.LightFuel = 4
+	assert.NoError(t, def.Validate())
+}
+
+// TestProperty_BurnFuelStaysInRange verifies fuel never goes negative or above
+// the item's full load however it is burned.
+func TestProperty_BurnFuelStaysInRange(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		def := &ItemDef{LightFuel: rapid.IntRange(1, 48).Draw(rt, "fuel")}
+		inst := &ItemInstance{}
+		for _, h := range rapid.SliceOfN(rapid.IntRange(0, 10), 0, 20).Draw(rt, "burns") {
+			left := BurnFuel(inst, def, h)
+			if left < 0 || left > def.LightFuel {
+				rt.Fatalf("fuel left %d out of range [0, %d]", left, def.LightFuel)
+			}
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package inventory
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ItemMetadata is the instance-level state of an ItemInstance that its
+// ItemDef does not determine: wear, rarity rolls, charges, fuel, affixes.
+// It is persisted as JSON alongside the item's def ID and quantity.
+type ItemMetadata struct {
+	Durability                 int      `json:"durability,omitempty"`
+	MaxDurability              int      `json:"max_durability,omitempty"`
+	Rarity                     string   `json:"rarity,omitempty"`
+	Modifier                   string   `json:"modifier,omitempty"`
+	CurseRevealed              bool     `json:"curse_revealed,omitempty"`
+	ChargesRemaining           int      `json:"charges_remaining,omitempty"`
+	Expended                   bool     `json:"expended,omitempty"`
+	AffixedMaterials           []string `json:"affixed_materials,omitempty"`
+	MaterialMaxDurabilityBonus int      `json:"material_max_durability_bonus,omitempty"`
+	FuelUsed                   int      `json:"fuel_used,omitempty"`
+	Affixes                    []string `json:"affixes,omitempty"`
+	CustomName                 string   `json:"custom_name,omitempty"`
+	MapRoomID                  string   `json:"map_room_id,omitempty"`
+	MapClues                   int      `json:"map_clues,omitempty"`
+}
+
+// IsZero reports whether m carries no state beyond a freshly added instance.
+func (m ItemMetadata) IsZero() bool {
+	if len(m.AffixedMaterials) > 0 || len(m.Affixes) > 0 {
+		return false
+	}
+	m.AffixedMaterials, m.Affixes = nil, nil
+	return reflect.DeepEqual(m, ItemMetadata{})
+}
+
+// Metadata returns the instance-level state of inst.
+//
+// Postcondition: Returns nil when inst carries no state beyond a freshly
+// added instance of its ItemDef; the returned slices are copies.
+func (inst *ItemInstance) Metadata() *ItemMetadata {
+	m := ItemMetadata{
+		Durability:                 inst.Durability,
+		MaxDurability:              inst.MaxDurability,
+		Rarity:                     inst.Rarity,
+		Modifier:                   inst.Modifier,
+		CurseRevealed:              inst.CurseRevealed,
+		ChargesRemaining:           inst.ChargesRemaining,
+		Expended:                   inst.Expended,
+		AffixedMaterials:           append([]string(nil), inst.AffixedMaterials...),
+		MaterialMaxDurabilityBonus: inst.MaterialMaxDurabilityBonus,
+		FuelUsed:                   inst.FuelUsed,
+		Affixes:                    append([]string(nil), inst.Affixes...),
+		CustomName:                 inst.CustomName,
+		MapRoomID:                  inst.MapRoomID,
+		MapClues:                   inst.MapClues,
+	}
+	if m.IsZero() {
+		return nil
+	}
+	return &m
+}
+
+// ApplyMetadata overwrites the instance-level state of inst with m.
+//
+// Precondition: m must not be nil.
+// Postcondition: inst.Metadata() equals m, up to nil vs empty slices.
+func (inst *ItemInstance) ApplyMetadata(m *ItemMetadata) {
+	inst.Durability = m.Durability
+	inst.MaxDurability = m.MaxDurability
+	inst.Rarity = m.Rarity
+	inst.Modifier = m.Modifier
+	inst.CurseRevealed = m.CurseRevealed
+	inst.ChargesRemaining = m.ChargesRemaining
+	inst.Expended = m.Expended
+	inst.AffixedMaterials = append([]string(nil), m.AffixedMaterials...)
+	inst.MaterialMaxDurabilityBonus = m.MaterialMaxDurabilityBonus
+	inst.FuelUsed = m.FuelUsed
+	inst.Affixes = append([]string(nil), m.Affixes...)
+	inst.CustomName = m.CustomName
+	inst.MapRoomID = m.MapRoomID
+	inst.MapClues = m.MapClues
+}
+
+// MaxCustomNameLen is the longest name that can be engraved on an item.
+const MaxCustomNameLen = 32
+
+// ValidateCustomName checks that name can be engraved on an item.
+//
+// Postcondition: Returns nil iff name is 1..MaxCustomNameLen characters of
+// letters, digits, spaces, apostrophes, and hyphens, and does not begin or
+// end with a space.
+func ValidateCustomName(name string) error {
+	if name == "" {
+		return fmt.Errorf("the name must not be empty")
+	}
+	if utf8.RuneCountInString(name) > MaxCustomNameLen {
+		return fmt.Errorf("the name must be at most %d characters", MaxCustomNameLen)
+	}
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("the name must not begin or end with a space")
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' && r != '\'' && r != '-' {
+			return fmt.Errorf("the name may only contain letters, digits, spaces, apostrophes, and hyphens")
+		}
+	}
+	return nil
+}
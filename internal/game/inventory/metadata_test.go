@@ -0,0 +1,40 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+func TestBackpack_InventoryItems_KeepsInstanceState(t *testing.T) {
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "knife", Name: "Knife", Kind: inventory.KindJunk, MaxStack: 1, Weight: 1}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "ammo", Name: "Ammo", Kind: inventory.KindJunk, Stackable: true, MaxStack: 5, Weight: 0.1}))
+	bp := inventory.NewBackpack(10, 100)
+	_, err := bp.Add("knife", 2, reg)
+	require.NoError(t, err)
+	_, err = bp.Add("ammo", 7, reg)
+	require.NoError(t, err)
+	meta := &inventory.ItemMetadata{Durability: 2, MaxDurability: 8, Rarity: "street", Affixes: []string{"rusty"}}
+	worn, err := bp.AddWithMetadata("knife", 1, meta, reg)
+	require.NoError(t, err)
+	assert.Equal(t, meta, worn.Metadata())
+
+	items := bp.InventoryItems()
+	assert.Equal(t, []inventory.InventoryItem{
+		{ItemDefID: "knife", Quantity: 2},
+		{ItemDefID: "ammo", Quantity: 7},
+		{ItemDefID: "knife", Quantity: 1, Metadata: meta},
+	}, items)
+
+	restored := inventory.NewBackpack(10, 100)
+	for _, it := range items {
+		_, err := restored.AddWithMetadata(it.ItemDefID, it.Quantity, it.Metadata, reg)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, items, restored.InventoryItems())
+	assert.Equal(t, 5, restored.UsedSlots())
+}
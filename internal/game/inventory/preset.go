@@ -24,6 +24,10 @@ type EquippedWeapon struct {
 	AffixedMaterials          []string // each entry: "<material_id>:<grade_id>"
 	// MaterialMaxDurabilityBonus is a cached copy; effective max = Def MaxDurability + this
 	MaterialMaxDurabilityBonus int
+	// Affixes is a copy of the equipped ItemInstance's rolled affix IDs.
+	Affixes []string
+	// CustomName is a copy of the equipped ItemInstance's engraved name.
+	CustomName string
 }
 
 // WeaponPreset holds the main-hand and off-hand weapon slots for one loadout preset.
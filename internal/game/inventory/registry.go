@@ -1,6 +1,9 @@
 package inventory
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // Registry holds all loaded weapon, explosive, item, and armor definitions indexed by ID.
 type Registry struct {
@@ -9,6 +12,11 @@ type Registry struct {
 	items      map[string]*ItemDef
 	armors     map[string]*ArmorDef
 	materials  map[string]*MaterialDef // key: "<material_id>:<grade_id>"
+	affixes    map[string]*AffixDef
+	// affixOrder keeps registration order so weighted affix rolls are deterministic for a given source.
+	affixOrder   []string
+	prefixChance float64
+	suffixChance float64
 }
 
 // NewRegistry returns an empty Registry.
@@ -21,6 +29,7 @@ func NewRegistry() *Registry {
 		items:      make(map[string]*ItemDef),
 		armors:     make(map[string]*ArmorDef),
 		materials:  make(map[string]*MaterialDef),
+		affixes:    make(map[string]*AffixDef),
 	}
 }
 
@@ -160,6 +169,72 @@ func (r *Registry) AllArmors() []*ArmorDef {
 	return out
 }
 
+// WeaponsWithTag returns every registered WeaponDef whose Tags contain tag.
+//
+// Postcondition: Returns a non-nil slice sorted by ID.
+func (r *Registry) WeaponsWithTag(tag string) []*WeaponDef {
+	out := make([]*WeaponDef, 0)
+	for _, w := range r.weapons {
+		if w.HasTag(tag) {
+			out = append(out, w)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// ArmorsWithTag returns every registered ArmorDef whose Tags contain tag.
+//
+// Postcondition: Returns a non-nil slice sorted by ID.
+func (r *Registry) ArmorsWithTag(tag string) []*ArmorDef {
+	out := make([]*ArmorDef, 0)
+	for _, a := range r.armors {
+		if a.HasTag(tag) {
+			out = append(out, a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// ItemHasTag reports whether the item with the given ID carries tag, either in
+// its own Tags or in those of the weapon or armor it references. This is the
+// predicate loot tables, recipe inputs, and merchant stock filters match on,
+// so a tag placed on a WeaponDef selects the item that wraps it.
+//
+// Postcondition: Returns false for an unknown item ID.
+func (r *Registry) ItemHasTag(itemDefID, tag string) bool {
+	d, ok := r.items[itemDefID]
+	if !ok {
+		return false
+	}
+	if d.HasTag(tag) {
+		return true
+	}
+	if w, ok := r.weapons[d.WeaponRef]; ok && d.WeaponRef != "" && w.HasTag(tag) {
+		return true
+	}
+	if a, ok := r.armors[d.ArmorRef]; ok && d.ArmorRef != "" && a.HasTag(tag) {
+		return true
+	}
+	return false
+}
+
+// ItemsWithTag returns every registered ItemDef for which ItemHasTag(id, tag)
+// holds.
+//
+// Postcondition: Returns a non-nil slice sorted by ID.
+func (r *Registry) ItemsWithTag(tag string) []*ItemDef {
+	out := make([]*ItemDef, 0)
+	for id, d := range r.items {
+		if r.ItemHasTag(id, tag) {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
 // RegisterMaterial adds a MaterialDef to the registry.
 //
 // Precondition: d must not be nil; d.AppliesTo must contain only "weapon" or "armor".
@@ -183,3 +258,30 @@ func (r *Registry) Material(materialID, gradeID string) (*MaterialDef, bool) {
 	d, ok := r.materials[materialID+":"+gradeID]
 	return d, ok
 }
+
+// RegisterAffix adds an AffixDef to the registry.
+//
+// Precondition: a must not be nil and must have passed Validate.
+// Postcondition: Affix(a.ID) returns (a, true); returns error if a.ID already registered.
+func (r *Registry) RegisterAffix(a *AffixDef) error {
+	if _, exists := r.affixes[a.ID]; exists {
+		return fmt.Errorf("inventory: Registry.RegisterAffix: affix ID %q already registered", a.ID)
+	}
+	r.affixes[a.ID] = a
+	r.affixOrder = append(r.affixOrder, a.ID)
+	return nil
+}
+
+// Affix returns the AffixDef with the given ID and whether it was found.
+func (r *Registry) Affix(id string) (*AffixDef, bool) {
+	a, ok := r.affixes[id]
+	return a, ok
+}
+
+// SetAffixChances sets the probabilities that RollAffixes rolls a prefix and a suffix.
+//
+// Precondition: both values are in [0, 1].
+func (r *Registry) SetAffixChances(prefix, suffix float64) {
+	r.prefixChance = prefix
+	r.suffixChance = suffix
+}
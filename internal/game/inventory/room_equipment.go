@@ -21,7 +21,9 @@ type EquipmentInstance struct {
 	Description string
 	CoverTier   string
 	SkillChecks []skillcheck.TriggerDef
-	configIdx   int
+	// LockDC is the difficulty of picking the item's lock; 0 means it is unlocked.
+	LockDC    int
+	configIdx int
 }
 
 // respawnEntry tracks a pending respawn.
@@ -78,6 +80,7 @@ func (m *RoomEquipmentManager) InitRoom(roomID string, configs []world.RoomEquip
 				Description: cfg.Description,
 				CoverTier:   cfg.CoverTier,
 				SkillChecks: cfg.SkillChecks,
+				LockDC:      cfg.LockDC,
 				configIdx:   idx,
 			})
 		}
@@ -169,6 +172,26 @@ func (m *RoomEquipmentManager) Pickup(roomID, instanceID string) bool {
 	return false
 }
 
+// Unlock clears the lock on the live instance with the given ID.
+//
+// Precondition: roomID and instanceID may be any string.
+// Postcondition: Returns true if the instance was found and locked; its LockDC
+// is now 0 until it respawns.
+func (m *RoomEquipmentManager) Unlock(roomID, instanceID string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, it := range m.rooms[roomID] {
+		if it.InstanceID == instanceID {
+			if it.LockDC == 0 {
+				return false
+			}
+			it.LockDC = 0
+			return true
+		}
+	}
+	return false
+}
+
 // ProcessRespawns spawns new instances for any pending respawn entries whose time has come.
 //
 // Precondition: none.
@@ -201,6 +224,7 @@ func (m *RoomEquipmentManager) ProcessRespawns() {
 				Description: r.description,
 				CoverTier:   r.coverTier,
 				SkillChecks: r.skillChecks,
+				LockDC:      cfg[r.configIdx].LockDC,
 				configIdx:   r.configIdx,
 			})
 		}
@@ -208,6 +232,56 @@ func (m *RoomEquipmentManager) ProcessRespawns() {
 	m.respawns = remaining
 }
 
+// ResetRoom refills every equipment config in the room to MaxCount, relocks
+// locked items, and cancels the room's pending respawns.
+//
+// Precondition: roomID may be any string.
+// Postcondition: Returns the number of instances spawned or relocked.
+func (m *RoomEquipmentManager) ResetRoom(roomID string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	remaining := m.respawns[:0]
+	for _, r := range m.respawns {
+		if r.roomID != roomID {
+			remaining = append(remaining, r)
+		}
+	}
+	m.respawns = remaining
+
+	cfgs := m.configs[roomID]
+	live := make([]int, len(cfgs))
+	restored := 0
+	for _, it := range m.rooms[roomID] {
+		if it.configIdx >= len(cfgs) {
+			continue
+		}
+		live[it.configIdx]++
+		if lockDC := cfgs[it.configIdx].LockDC; it.LockDC != lockDC {
+			it.LockDC = lockDC
+			restored++
+		}
+	}
+	for idx, cfg := range cfgs {
+		for i := live[idx]; i < cfg.MaxCount; i++ {
+			m.rooms[roomID] = append(m.rooms[roomID], &EquipmentInstance{
+				InstanceID:  uuid.New().String(),
+				ItemDefID:   cfg.ItemID,
+				RoomID:      roomID,
+				Immovable:   cfg.Immovable,
+				Script:      cfg.Script,
+				Description: cfg.Description,
+				CoverTier:   cfg.CoverTier,
+				SkillChecks: cfg.SkillChecks,
+				LockDC:      cfg.LockDC,
+				configIdx:   idx,
+			})
+			restored++
+		}
+	}
+	return restored
+}
+
 // AddConfig adds a new equipment config to a room at runtime.
 //
 // Precondition: roomID must be non-empty; cfg.ItemID must be non-empty.
@@ -228,6 +302,7 @@ func (m *RoomEquipmentManager) AddConfig(roomID string, cfg world.RoomEquipmentC
 			Description: cfg.Description,
 			CoverTier:   cfg.CoverTier,
 			SkillChecks: cfg.SkillChecks,
+			LockDC:      cfg.LockDC,
 			configIdx:   idx,
 		})
 	}
@@ -118,6 +118,21 @@ func TestRoomEquipmentManager_ProcessRespawns(t *testing.T) {
 	assert.Len(t, mgr.EquipmentInRoom("r1"), 1)
 }
 
+func TestRoomEquipmentManager_UnlockClearsLock(t *testing.T) {
+	mgr := inventory.NewRoomEquipmentManager()
+	mgr.InitRoom("room1", []world.RoomEquipmentConfig{
+		{ItemID: "cashbox", MaxCount: 1, Immovable: true, LockDC: 18},
+	})
+	items := mgr.EquipmentInRoom("room1")
+	require.Len(t, items, 1)
+	assert.Equal(t, 18, items[0].LockDC)
+
+	assert.True(t, mgr.Unlock("room1", items[0].InstanceID))
+	assert.Zero(t, mgr.GetInstance("room1", items[0].InstanceID).LockDC)
+	assert.False(t, mgr.Unlock("room1", items[0].InstanceID), "an unlocked item reports no change")
+	assert.False(t, mgr.Unlock("room1", "missing"))
+}
+
 func TestProperty_RoomEquipmentManager_SpawnCountNeverExceedsMax(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
 		maxCount := rapid.IntRange(0, 10).Draw(rt, "maxCount")
@@ -201,3 +216,46 @@ func TestRoomEquipmentManager_RemoveConfig_CancelsPendingRespawns(t *testing.T)
 	mgr.ProcessRespawns()
 	assert.Empty(t, mgr.EquipmentInRoom("r1"))
 }
+
+func TestRoomEquipmentManager_ResetRoom_RefillsAndRelocks(t *testing.T) {
+	mgr := inventory.NewRoomEquipmentManager()
+	mgr.InitRoom("r1", []world.RoomEquipmentConfig{
+		{ItemID: "medkit", MaxCount: 2, RespawnAfter: time.Hour},
+		{ItemID: "cashbox", MaxCount: 1, Immovable: true, LockDC: 18},
+	})
+	for _, it := range mgr.EquipmentInRoom("r1") {
+		if it.ItemDefID == "medkit" {
+			require.True(t, mgr.Pickup("r1", it.InstanceID))
+		} else {
+			require.True(t, mgr.Unlock("r1", it.InstanceID))
+		}
+	}
+
+	assert.Equal(t, 3, mgr.ResetRoom("r1"), "two medkits respawned and the cashbox relocked")
+	items := mgr.EquipmentInRoom("r1")
+	assert.Len(t, items, 3)
+	for _, it := range items {
+		if it.ItemDefID == "cashbox" {
+			assert.Equal(t, 18, it.LockDC)
+		}
+	}
+
+	time.Sleep(time.Millisecond)
+	mgr.ProcessRespawns()
+	assert.Len(t, mgr.EquipmentInRoom("r1"), 3, "the reset cancelled the pending respawns")
+	assert.Zero(t, mgr.ResetRoom("r1"), "a full room needs nothing")
+}
+
+func TestProperty_RoomEquipmentManager_ResetRoomRestoresMaxCount(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		maxCount := rapid.IntRange(0, 10).Draw(rt, "maxCount")
+		mgr := inventory.NewRoomEquipmentManager()
+		mgr.InitRoom("r1", []world.RoomEquipmentConfig{{ItemID: "item", MaxCount: maxCount}})
+		taken := rapid.IntRange(0, maxCount).Draw(rt, "taken")
+		for _, it := range mgr.EquipmentInRoom("r1")[:taken] {
+			mgr.Pickup("r1", it.InstanceID)
+		}
+		assert.Equal(rt, taken, mgr.ResetRoom("r1"))
+		assert.Len(rt, mgr.EquipmentInRoom("r1"), maxCount)
+	})
+}
@@ -18,6 +18,9 @@ type ConsumableGrant struct {
 type InventoryItem struct {
 	ItemDefID string
 	Quantity  int
+	// Metadata is the instance-level state of a persisted backpack item;
+	// nil for items with no state beyond a freshly added instance.
+	Metadata *ItemMetadata
 }
 
 // StartingLoadout is the fully-merged starting kit for a character.
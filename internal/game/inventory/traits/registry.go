@@ -26,6 +26,10 @@ const (
 
 	// Reach is reserved here for future use; behaviour ships in a separate ticket.
 	Reach = "reach"
+
+	// Nonlethal marks a weapon whose blows knock a target out at 0 HP instead
+	// of killing it.
+	Nonlethal = "nonlethal"
 )
 
 // Action key constants — used by Behavior.GrantsFreeAction. Combat consumers
@@ -74,6 +78,11 @@ func DefaultRegistry() *Registry {
 				GrantsFreeAction:    ActionMoveTraitStrideKey,
 				SuppressesReactions: true,
 			},
+			Nonlethal: {
+				ID:          Nonlethal,
+				DisplayName: "Nonlethal",
+				Description: "A target reduced to 0 HP by this weapon is knocked out instead of killed.",
+			},
 		},
 		aliases: map[string]string{
 			"move": Mobile,
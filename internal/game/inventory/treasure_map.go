@@ -0,0 +1,60 @@
+package inventory
+
+import "fmt"
+
+// TreasureMapClues is the number of clues a treasure map can reveal about
+// the room it points to.
+const TreasureMapClues = 3
+
+// TreasureCacheDef describes the cache buried in a treasure map's target room.
+type TreasureCacheDef struct {
+	// CurrencyMin and CurrencyMax bound the credits found in the cache.
+	CurrencyMin int `yaml:"currency_min"`
+	CurrencyMax int `yaml:"currency_max"`
+	// ItemTags lists item tags; the cache holds one item chosen at random
+	// from every item carrying each tag.
+	ItemTags []string `yaml:"item_tags,omitempty"`
+}
+
+// Validate checks the cache's currency range.
+//
+// Precondition: c must not be nil.
+// Postcondition: Returns nil iff 0 <= CurrencyMin <= CurrencyMax and no tag is empty.
+func (c *TreasureCacheDef) Validate() error {
+	if c.CurrencyMin < 0 || c.CurrencyMin > c.CurrencyMax {
+		return fmt.Errorf("treasure_cache: currency range [%d, %d] is invalid", c.CurrencyMin, c.CurrencyMax)
+	}
+	for i, tag := range c.ItemTags {
+		if tag == "" {
+			return fmt.Errorf("treasure_cache: item_tags[%d] must not be empty", i)
+		}
+	}
+	return nil
+}
+
+// BuriedCache is the rolled contents of a treasure cache.
+type BuriedCache struct {
+	Currency int
+	ItemIDs  []string
+}
+
+// RollTreasureCache rolls the contents of cache using src. A tag that no
+// registered item carries contributes nothing.
+//
+// Precondition: cache must have passed Validate; src must not be nil.
+// Postcondition: Currency is in [CurrencyMin, CurrencyMax]; ItemIDs holds at
+// most one item per tag.
+func (r *Registry) RollTreasureCache(cache *TreasureCacheDef, src interface{ Intn(n int) int }) BuriedCache {
+	out := BuriedCache{Currency: cache.CurrencyMin}
+	if spread := cache.CurrencyMax - cache.CurrencyMin; spread > 0 {
+		out.Currency += src.Intn(spread + 1)
+	}
+	for _, tag := range cache.ItemTags {
+		candidates := r.ItemsWithTag(tag)
+		if len(candidates) == 0 {
+			continue
+		}
+		out.ItemIDs = append(out.ItemIDs, candidates[src.Intn(len(candidates))].ID)
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+func TestRegistry_RollTreasureCache(t *testing.T) {
+	reg := inventory.NewRegistry()
+	for _, d := range []*inventory.ItemDef{
+		{ID: "idol", Name: "Idol", Kind: inventory.KindJunk, MaxStack: 1, Tags: []string{"buried_treasure"}},
+		{ID: "ring", Name: "Ring", Kind: inventory.KindJunk, MaxStack: 1, Tags: []string{"buried_treasure"}},
+	} {
+		require.NoError(t, reg.RegisterItem(d))
+	}
+	cache := &inventory.TreasureCacheDef{CurrencyMin: 100, CurrencyMax: 200, ItemTags: []string{"buried_treasure", "unused_tag"}}
+	require.NoError(t, cache.Validate())
+
+	got := reg.RollTreasureCache(cache, &seqSource{vals: []int{40, 1}})
+	assert.Equal(t, inventory.BuriedCache{Currency: 140, ItemIDs: []string{"ring"}}, got)
+}
+
+func TestItemDef_Validate_TreasureCache(t *testing.T) {
+	d := &inventory.ItemDef{ID: "map", Name: "Map", Kind: inventory.KindJunk, MaxStack: 1,
+		TreasureCache: &inventory.TreasureCacheDef{CurrencyMin: 10, CurrencyMax: 5}}
+	assert.ErrorContains(t, d.Validate(), "currency range")
+
+	d.TreasureCache.CurrencyMax = 10
+	d.Stackable = true
+	assert.ErrorContains(t, d.Validate(), "non-stackable")
+
+	d.Stackable = false
+	assert.NoError(t, d.Validate())
+}
+
+func TestItemInstance_Metadata_CarriesMapState(t *testing.T) {
+	inst := &inventory.ItemInstance{ItemDefID: "treasure_map", Quantity: 1, Durability: -1, MaxDurability: -1,
+		ChargesRemaining: -1, MapRoomID: "grove", MapClues: 2}
+	m := inst.Metadata()
+	require.NotNil(t, m)
+
+	restored := &inventory.ItemInstance{}
+	restored.ApplyMetadata(m)
+	assert.Equal(t, "grove", restored.MapRoomID)
+	assert.Equal(t, 2, restored.MapClues)
+}
@@ -40,6 +40,9 @@ const (
 // WeaponDef defines the static properties of a weapon loaded from YAML.
 type WeaponDef struct {
 	ID               string       `yaml:"id"`
+	// Base is the ID of the weapon this one inherits from; every field this
+	// weapon does not set comes from the base. Resolved by LoadWeapons.
+	Base             string       `yaml:"base,omitempty"`
 	Name             string       `yaml:"name"`
 	DamageDice       string       `yaml:"damage_dice"`
 	DamageType       string       `yaml:"damage_type"`
@@ -66,6 +69,16 @@ type WeaponDef struct {
 	// Maps to the "+" designation on a weapon (e.g. Vibroblade +3 has Bonus: 3).
 	// Zero means no item bonus (default). Always >= 0.
 	Bonus int `yaml:"bonus,omitempty"`
+	// Tags is an optional list of freeform content labels (e.g. "melee", "improvised")
+	// used to select weapons by tag; see Registry.WeaponsWithTag.
+	Tags []string `yaml:"tags,omitempty"`
+}
+
+// HasTag reports whether the weapon's Tags list contains tag.
+//
+// Postcondition: Returns true iff tag is present in Tags.
+func (w *WeaponDef) HasTag(tag string) bool {
+	return hasTag(w.Tags, tag)
 }
 
 // HasTrait reports whether the weapon's Traits list contains the given trait id
@@ -166,7 +179,8 @@ func (w *WeaponDef) Validate() error {
 }
 
 // LoadWeapons reads all *.yaml files from dir, parses each as a WeaponDef,
-// validates it, and returns the collected slice.
+// validates it, and returns the collected slice. A weapon naming a base
+// inherits every field it does not set from that weapon.
 // Precondition: dir is a readable directory path.
 // Postcondition: returns all valid WeaponDefs or the first encountered error.
 func LoadWeapons(dir string) ([]*WeaponDef, error) {
@@ -175,7 +189,7 @@ func LoadWeapons(dir string) ([]*WeaponDef, error) {
 		return nil, fmt.Errorf("LoadWeapons: cannot read directory %q: %w", dir, err)
 	}
 
-	var weapons []*WeaponDef
+	var docs []defDoc
 	for _, entry := range entries {
 		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
 			continue
@@ -185,12 +199,20 @@ func LoadWeapons(dir string) ([]*WeaponDef, error) {
 		if err != nil {
 			return nil, fmt.Errorf("LoadWeapons: cannot read file %q: %w", path, err)
 		}
+		docs = append(docs, defDoc{path: path, data: data})
+	}
+	if err := resolveBases("weapon", docs); err != nil {
+		return nil, fmt.Errorf("LoadWeapons: %w", err)
+	}
+
+	var weapons []*WeaponDef
+	for _, doc := range docs {
 		var w WeaponDef
-		if err := yaml.Unmarshal(data, &w); err != nil {
-			return nil, fmt.Errorf("LoadWeapons: cannot parse file %q: %w", path, err)
+		if err := yaml.Unmarshal(doc.data, &w); err != nil {
+			return nil, fmt.Errorf("LoadWeapons: cannot parse file %q: %w", doc.path, err)
 		}
 		if err := w.Validate(); err != nil {
-			return nil, fmt.Errorf("LoadWeapons: invalid weapon in %q: %w", path, err)
+			return nil, fmt.Errorf("LoadWeapons: invalid weapon in %q: %w", doc.path, err)
 		}
 		// REQ-EM-2: set RarityStatMultiplier and UpgradeSlots from the rarity constants at load time.
 		if def, ok := LookupRarity(w.Rarity); ok {
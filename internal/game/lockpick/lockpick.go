@@ -0,0 +1,199 @@
+// Package lockpick models picking a lock as a series of stages, one pin at a
+// time. Each stage the picker chooses a technique: steady tension works one pin
+// slowly and suits stiff pins, while raking is quick and can set two pins at
+// once but fumbles stiff ones. The feel of the next pin is reported after every
+// stage so the choice is an informed one, and every stage wears the pick.
+package lockpick
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/skillcheck"
+)
+
+// Technique is how the picker works the current pin.
+type Technique string
+
+const (
+	// Tension works one pin with steady pressure.
+	Tension Technique = "tension"
+	// Rake scrubs across the pins to set them quickly.
+	Rake Technique = "rake"
+)
+
+// Stage delays: how long each technique takes before its result is known.
+const (
+	TensionDelay = 3 * time.Second
+	RakeDelay    = 1500 * time.Millisecond
+)
+
+// PickDurability is the number of stages a fresh lockpick lasts.
+const PickDurability = 12
+
+// ToolTag is the item tag that marks an item as a lockpick.
+const ToolTag = "lockpick"
+
+// Delay returns how long a stage with technique t takes.
+//
+// Postcondition: Returns TensionDelay for anything but Rake.
+func (t Technique) Delay() time.Duration {
+	if t == Rake {
+		return RakeDelay
+	}
+	return TensionDelay
+}
+
+// ParseTechnique returns the technique named s.
+//
+// Postcondition: ok is false for anything but "tension" or "rake".
+func ParseTechnique(s string) (Technique, bool) {
+	switch Technique(s) {
+	case Tension, Rake:
+		return Technique(s), true
+	}
+	return "", false
+}
+
+// PinsFor returns how many pins a lock of the given DC has: one per five
+// points of DC, between 2 and 6.
+func PinsFor(dc int) int {
+	return min(max(dc/5, 2), 6)
+}
+
+// Attempt is one picker's work on one lock.
+type Attempt struct {
+	// Target identifies what is being picked, e.g. "exit:north" or "equip:<id>".
+	Target string
+	// Label is the player-facing name of the lock.
+	Label string
+	// RoomID is the room the lock is in; the attempt ends if the picker leaves.
+	RoomID string
+	// DC is the difficulty of each stage's check.
+	DC int
+	// ToolID is the backpack instance ID of the lockpick in use.
+	ToolID string
+	// Stiff records, per pin, whether it needs tension rather than a rake.
+	Stiff []bool
+	// Set is how many pins are set; the lock opens when Set == len(Stiff).
+	Set int
+	// Working is the technique of the stage in progress, or "" between stages.
+	Working Technique
+}
+
+// NewAttempt starts work on a lock of difficulty dc. intn supplies the
+// randomness that decides which pins are stiff.
+//
+// Precondition: intn must return a value in [0, n).
+// Postcondition: Returns an attempt with PinsFor(dc) pins and none set.
+func NewAttempt(target, label, roomID, toolID string, dc int, intn func(n int) int) *Attempt {
+	a := &Attempt{Target: target, Label: label, RoomID: roomID, DC: dc, ToolID: toolID}
+	a.Stiff = make([]bool, PinsFor(dc))
+	for i := range a.Stiff {
+		a.Stiff[i] = intn(2) == 0
+	}
+	return a
+}
+
+// Open reports whether every pin is set.
+func (a *Attempt) Open() bool {
+	return a.Set >= len(a.Stiff)
+}
+
+// Modifier returns the check modifier for working the current pin with t:
+// +2 for tension on a stiff pin, -4 for raking one, 0 otherwise.
+func (a *Attempt) Modifier(t Technique) int {
+	if a.Open() || !a.Stiff[a.Set] {
+		return 0
+	}
+	if t == Rake {
+		return -4
+	}
+	return 2
+}
+
+// Feel describes the next unset pin to the picker.
+//
+// Postcondition: Returns "" when the lock is open.
+func (a *Attempt) Feel() string {
+	if a.Open() {
+		return ""
+	}
+	if a.Stiff[a.Set] {
+		return "The next pin feels stiff."
+	}
+	return "The next pin feels loose."
+}
+
+// StageResult is the outcome of one stage.
+type StageResult struct {
+	// Outcome is the degree of success of the stage's check.
+	Outcome skillcheck.CheckOutcome
+	// Gained is the net change in set pins; negative when pins drop.
+	Gained int
+	// Wear is the durability the stage took off the pick.
+	Wear int
+	// Message describes what the picker felt.
+	Message string
+}
+
+// Resolve applies a stage worked with t whose check came to total.
+//
+// A success sets one pin with tension and two with a rake; a critical success
+// sets one more. A failure sets nothing. A critical failure drops a set pin
+// under tension and every set pin under a rake. Each stage wears the pick by
+// one, and a critical failure by two.
+//
+// Precondition: The attempt is not open.
+// Postcondition: a.Set is in [0, len(a.Stiff)]; a.Working is cleared.
+func (a *Attempt) Resolve(t Technique, total int) StageResult {
+	a.Working = ""
+	res := StageResult{Outcome: skillcheck.OutcomeFor(total, a.DC), Wear: 1}
+	before := a.Set
+	switch res.Outcome {
+	case skillcheck.CritSuccess, skillcheck.Success:
+		n := 1
+		if t == Rake {
+			n = 2
+		}
+		if res.Outcome == skillcheck.CritSuccess {
+			n++
+		}
+		a.Set = min(a.Set+n, len(a.Stiff))
+	case skillcheck.CritFailure:
+		res.Wear = 2
+		if t == Rake {
+			a.Set = 0
+		} else {
+			a.Set = max(a.Set-1, 0)
+		}
+	}
+	res.Gained = a.Set - before
+	res.Message = a.describe(t, res)
+	return res
+}
+
+// describe renders the feedback for a resolved stage.
+func (a *Attempt) describe(t Technique, res StageResult) string {
+	var msg string
+	switch {
+	case res.Gained > 1:
+		msg = fmt.Sprintf("%d pins click into place.", res.Gained)
+	case res.Gained == 1:
+		msg = "A pin clicks into place."
+	case res.Gained < 0 && t == Rake:
+		msg = "The rake skips and every pin springs back down."
+	case res.Gained < 0:
+		msg = "Too much pressure — a set pin slips back down."
+	case res.Outcome == skillcheck.CritFailure:
+		msg = "The pick jams hard against the pins."
+	case t == Rake:
+		msg = "The rake scrapes across the pins, but nothing catches."
+	default:
+		msg = "The pin binds and won't lift."
+	}
+	if a.Open() {
+		return msg + " The lock turns."
+	}
+	return fmt.Sprintf("%s (%d/%d set) %s", msg, a.Set, len(a.Stiff), a.Feel())
+}
@@ -0,0 +1,109 @@
+package lockpick_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/lockpick"
+	"github.com/cory-johannsen/mud/internal/game/skillcheck"
+)
+
+// allStiff and allLoose build attempts whose pins are uniformly stiff or loose.
+func allStiff(int) int { return 0 }
+func allLoose(int) int { return 1 }
+
+func TestPinsFor(t *testing.T) {
+	assert.Equal(t, 2, lockpick.PinsFor(5))
+	assert.Equal(t, 3, lockpick.PinsFor(15))
+	assert.Equal(t, 4, lockpick.PinsFor(20))
+	assert.Equal(t, 6, lockpick.PinsFor(40))
+}
+
+func TestParseTechnique(t *testing.T) {
+	tech, ok := lockpick.ParseTechnique("rake")
+	assert.True(t, ok)
+	assert.Equal(t, lockpick.Rake, tech)
+	_, ok = lockpick.ParseTechnique("kick")
+	assert.False(t, ok)
+	assert.Greater(t, lockpick.Tension.Delay(), lockpick.Rake.Delay(), "raking is the quick option")
+}
+
+func TestAttempt_ModifierFavoursTensionOnStiffPins(t *testing.T) {
+	stiff := lockpick.NewAttempt("exit:north", "the north door", "r", "pick", 15, allStiff)
+	assert.Equal(t, 2, stiff.Modifier(lockpick.Tension))
+	assert.Equal(t, -4, stiff.Modifier(lockpick.Rake))
+	assert.Equal(t, "The next pin feels stiff.", stiff.Feel())
+
+	loose := lockpick.NewAttempt("exit:north", "the north door", "r", "pick", 15, allLoose)
+	assert.Zero(t, loose.Modifier(lockpick.Tension))
+	assert.Zero(t, loose.Modifier(lockpick.Rake))
+	assert.Equal(t, "The next pin feels loose.", loose.Feel())
+}
+
+func TestAttempt_ResolveSetsPins(t *testing.T) {
+	a := lockpick.NewAttempt("exit:north", "the north door", "r", "pick", 15, allLoose)
+	is := assert.New(t)
+
+	res := a.Resolve(lockpick.Tension, 15)
+	is.Equal(skillcheck.Success, res.Outcome)
+	is.Equal(1, res.Gained)
+	is.Equal(1, res.Wear)
+	is.Contains(res.Message, "A pin clicks into place. (1/3 set)")
+
+	res = a.Resolve(lockpick.Rake, 15)
+	is.Equal(2, res.Gained)
+	is.True(a.Open())
+	is.Contains(res.Message, "The lock turns.")
+}
+
+func TestAttempt_CritFailureDropsPins(t *testing.T) {
+	a := lockpick.NewAttempt("exit:north", "the north door", "r", "pick", 20, allLoose)
+	a.Resolve(lockpick.Rake, 20)
+	assert.Equal(t, 2, a.Set)
+
+	res := a.Resolve(lockpick.Tension, 5)
+	assert.Equal(t, skillcheck.CritFailure, res.Outcome)
+	assert.Equal(t, -1, res.Gained)
+	assert.Equal(t, 2, res.Wear)
+	assert.Equal(t, 1, a.Set)
+
+	a.Set = 3
+	res = a.Resolve(lockpick.Rake, 0)
+	assert.Equal(t, -3, res.Gained)
+	assert.Zero(t, a.Set)
+	assert.Contains(t, res.Message, "every pin springs back down")
+}
+
+func TestAttempt_FailureHoldsPosition(t *testing.T) {
+	a := lockpick.NewAttempt("exit:north", "the north door", "r", "pick", 15, allStiff)
+	res := a.Resolve(lockpick.Tension, 10)
+	assert.Equal(t, skillcheck.Failure, res.Outcome)
+	assert.Zero(t, res.Gained)
+	assert.Contains(t, res.Message, "The pin binds")
+	assert.Contains(t, res.Message, "The next pin feels stiff.")
+}
+
+func TestProperty_Attempt_SetStaysInRange(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		dc := rapid.IntRange(5, 40).Draw(rt, "dc")
+		a := lockpick.NewAttempt("equip:x", "the safe", "r", "pick", dc, func(n int) int {
+			return rapid.IntRange(0, n-1).Draw(rt, "pin")
+		})
+		assert.Equal(rt, lockpick.PinsFor(dc), len(a.Stiff))
+		for i := 0; i < 20 && !a.Open(); i++ {
+			tech := lockpick.Tension
+			if rapid.Bool().Draw(rt, "rake") {
+				tech = lockpick.Rake
+			}
+			res := a.Resolve(tech, rapid.IntRange(-10, 60).Draw(rt, "total"))
+			if a.Set < 0 || a.Set > len(a.Stiff) {
+				rt.Fatalf("set pins %d out of range [0, %d]", a.Set, len(a.Stiff))
+			}
+			if res.Wear < 1 || res.Wear > 2 {
+				rt.Fatalf("wear %d out of range", res.Wear)
+			}
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package npc
+
+// RestraintTag is the item tag that marks an item as a restraint (zip ties,
+// cuffs) that can bind a prisoner.
+const RestraintTag = "restraint"
+
+// DefaultEscapeDC is the escape DC of a restraint whose item sets none.
+const DefaultEscapeDC = 22
+
+// Restrainable reports whether a player can take the NPC prisoner: it must
+// be alive, have surrendered, and not already be someone's prisoner.
+//
+// Precondition: i must not be nil.
+func (i *Instance) Restrainable() bool {
+	return !i.IsDead() && i.Surrendered && i.CaptorUID == ""
+}
+
+// Restrain makes the NPC captorUID's prisoner, bound by a restraint with
+// the given escape DC (DefaultEscapeDC when escapeDC <= 0).
+//
+// Precondition: i must not be nil; captorUID non-empty.
+// Postcondition: i.CaptorUID is captorUID and i.EscapeDC is positive.
+func (i *Instance) Restrain(captorUID string, escapeDC int) {
+	if escapeDC <= 0 {
+		escapeDC = DefaultEscapeDC
+	}
+	i.CaptorUID = captorUID
+	i.EscapeDC = escapeDC
+}
+
+// EscapeCheck resolves one attempt by a prisoner to slip its restraints:
+// d20 roll plus its level and Quickness modifier against its EscapeDC.
+//
+// Precondition: i must not be nil; roll in [1, 20].
+// Postcondition: Returns false when i is not a prisoner.
+func (i *Instance) EscapeCheck(roll int) bool {
+	if i.CaptorUID == "" {
+		return false
+	}
+	return roll+i.Level+(i.Quickness-10)/2 >= i.EscapeDC
+}
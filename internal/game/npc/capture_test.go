@@ -0,0 +1,44 @@
+package npc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+func TestRestrainable(t *testing.T) {
+	inst := &npc.Instance{CurrentHP: 5, MaxHP: 10}
+	assert.False(t, inst.Restrainable(), "a fighting NPC cannot be restrained")
+	inst.Surrendered = true
+	assert.True(t, inst.Restrainable())
+	inst.Restrain("u1", 0)
+	assert.Equal(t, "u1", inst.CaptorUID)
+	assert.Equal(t, npc.DefaultEscapeDC, inst.EscapeDC)
+	assert.False(t, inst.Restrainable(), "already a prisoner")
+}
+
+func TestEscapeCheck(t *testing.T) {
+	inst := &npc.Instance{Level: 2, Quickness: 14, Surrendered: true, CurrentHP: 5, MaxHP: 10}
+	assert.False(t, inst.EscapeCheck(20), "a free NPC has nothing to escape")
+	inst.Restrain("u1", 18)
+	assert.False(t, inst.EscapeCheck(13))
+	assert.True(t, inst.EscapeCheck(14), "14 + 2 level + 2 quickness meets DC 18")
+}
+
+func TestEscapeCheck_MonotonicInRoll(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		inst := &npc.Instance{
+			Level:       rapid.IntRange(0, 20).Draw(rt, "level"),
+			Quickness:   rapid.IntRange(3, 20).Draw(rt, "quickness"),
+			Surrendered: true, CurrentHP: 1, MaxHP: 1,
+		}
+		inst.Restrain("u1", rapid.IntRange(0, 40).Draw(rt, "dc"))
+		roll := rapid.IntRange(1, 19).Draw(rt, "roll")
+		if inst.EscapeCheck(roll) && !inst.EscapeCheck(roll+1) {
+			rt.Fatalf("a higher roll must not fail where a lower one escaped")
+		}
+	})
+}
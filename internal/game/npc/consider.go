@@ -0,0 +1,90 @@
+package npc
+
+// Difficulty is a player's rough estimate of how hard a fight with an NPC would be.
+type Difficulty int
+
+const (
+	DifficultyTrivial Difficulty = iota
+	DifficultyEasy
+	DifficultyEven
+	DifficultyTough
+	DifficultyDeadly
+)
+
+// String returns the difficulty's display name.
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyTrivial:
+		return "trivial"
+	case DifficultyEasy:
+		return "easy"
+	case DifficultyEven:
+		return "even"
+	case DifficultyTough:
+		return "tough"
+	default:
+		return "deadly"
+	}
+}
+
+// Verdict returns a short sentence describing a fight of this difficulty against name.
+//
+// Postcondition: Returns a non-empty sentence.
+func (d Difficulty) Verdict(name string) string {
+	switch d {
+	case DifficultyTrivial:
+		return name + " is no threat to you."
+	case DifficultyEasy:
+		return name + " should go down without much trouble."
+	case DifficultyEven:
+		return name + " looks like a fair fight."
+	case DifficultyTough:
+		return name + " would be a hard fight. Bring help."
+	default:
+		return name + " would kill you. Walk away."
+	}
+}
+
+// CombatStats are the figures Consider compares.
+type CombatStats struct {
+	Level     int
+	CurrentHP int
+	AC        int
+}
+
+// Consider estimates the difficulty of target for a player with stats player.
+// Each level the target holds over the player counts for two points; the
+// target's share of the pair's combined hit points and its AC lead count for
+// up to two points each.
+//
+// Postcondition: Difficulty never decreases as the target's level, HP, or AC rises.
+func Consider(player, target CombatStats) Difficulty {
+	score := 2 * (target.Level - player.Level)
+
+	pHP, tHP := max(player.CurrentHP, 1), max(target.CurrentHP, 1)
+	switch {
+	case tHP >= 2*pHP:
+		score += 2
+	case 4*tHP >= 5*pHP:
+		score++
+	case 2*tHP <= pHP:
+		score -= 2
+	case 5*tHP <= 4*pHP:
+		score--
+	}
+
+	score += min(max((target.AC-player.AC)/3, -2), 2)
+
+	switch {
+	case score <= -5:
+		return DifficultyTrivial
+	case score <= -2:
+		return DifficultyEasy
+	case score <= 1:
+		return DifficultyEven
+	case score <= 4:
+		return DifficultyTough
+	default:
+		return DifficultyDeadly
+	}
+}
@@ -0,0 +1,59 @@
+package npc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+func TestConsider_Tiers(t *testing.T) {
+	player := npc.CombatStats{Level: 5, CurrentHP: 40, AC: 16}
+	cases := []struct {
+		name   string
+		target npc.CombatStats
+		want   npc.Difficulty
+	}{
+		{"much weaker", npc.CombatStats{Level: 1, CurrentHP: 8, AC: 12}, npc.DifficultyTrivial},
+		{"a little weaker", npc.CombatStats{Level: 4, CurrentHP: 20, AC: 15}, npc.DifficultyEasy},
+		{"matched", npc.CombatStats{Level: 5, CurrentHP: 40, AC: 16}, npc.DifficultyEven},
+		{"a little stronger", npc.CombatStats{Level: 6, CurrentHP: 55, AC: 17}, npc.DifficultyTough},
+		{"much stronger", npc.CombatStats{Level: 9, CurrentHP: 120, AC: 24}, npc.DifficultyDeadly},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, npc.Consider(player, tc.target))
+		})
+	}
+}
+
+func TestDifficulty_StringAndVerdict(t *testing.T) {
+	for d := npc.DifficultyTrivial; d <= npc.DifficultyDeadly; d++ {
+		assert.NotEmpty(t, d.String())
+		assert.Contains(t, d.Verdict("Ganger"), "Ganger")
+	}
+	assert.Equal(t, "even", npc.DifficultyEven.String())
+}
+
+// TestProperty_ConsiderIsMonotonic verifies a stronger target is never rated easier.
+func TestProperty_ConsiderIsMonotonic(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		stats := func(label string) npc.CombatStats {
+			return npc.CombatStats{
+				Level:     rapid.IntRange(0, 20).Draw(rt, label+"Level"),
+				CurrentHP: rapid.IntRange(0, 300).Draw(rt, label+"HP"),
+				AC:        rapid.IntRange(5, 35).Draw(rt, label+"AC"),
+			}
+		}
+		player, target := stats("player"), stats("target")
+		stronger := target
+		stronger.Level += rapid.IntRange(0, 5).Draw(rt, "dLevel")
+		stronger.CurrentHP += rapid.IntRange(0, 100).Draw(rt, "dHP")
+		stronger.AC += rapid.IntRange(0, 10).Draw(rt, "dAC")
+		if npc.Consider(player, stronger) < npc.Consider(player, target) {
+			rt.Fatalf("stronger target %+v rated easier than %+v", stronger, target)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package npc
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// templateDoc is one template's YAML as read from disk, before its base is applied.
+type templateDoc struct {
+	path string
+	id   string
+	base string
+	node *yaml.Node
+}
+
+// parseTemplateDocs splits YAML holding a single template (map) or a list of
+// templates (sequence) into one document per template.
+//
+// Postcondition: Returns the documents in file order, or an error naming path.
+func parseTemplateDocs(path string, data []byte) ([]templateDoc, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("loading %q: parsing template YAML: %w", path, err)
+	}
+	if len(root.Content) == 0 {
+		return nil, fmt.Errorf("loading %q: empty template file", path)
+	}
+	nodes := []*yaml.Node{root.Content[0]}
+	if root.Content[0].Kind == yaml.SequenceNode {
+		nodes = root.Content[0].Content
+	}
+	docs := make([]templateDoc, 0, len(nodes))
+	for i, n := range nodes {
+		var head struct {
+			ID   string `yaml:"id"`
+			Base string `yaml:"base"`
+		}
+		if err := n.Decode(&head); err != nil {
+			return nil, fmt.Errorf("loading %q: template %d: %w", path, i, err)
+		}
+		docs = append(docs, templateDoc{path: path, id: head.ID, base: head.Base, node: n})
+	}
+	return docs, nil
+}
+
+// resolveTemplates applies each document's base chain and decodes and
+// validates the result. A template's fields are layered over its base's, so
+// a variant lists only what it changes: nested maps such as abilities and
+// loot merge key by key, while lists and scalars replace the base's value.
+//
+// Postcondition: Returns one validated Template per document in order, or an
+// error for the first unknown base, inheritance cycle, or invalid template.
+func resolveTemplates(docs []templateDoc) ([]*Template, error) {
+	byID := make(map[string]*templateDoc, len(docs))
+	for i := range docs {
+		byID[docs[i].id] = &docs[i]
+	}
+	out := make([]*Template, 0, len(docs))
+	for i := range docs {
+		doc := &docs[i]
+		var tmpl Template
+		if doc.base == "" {
+			if err := doc.node.Decode(&tmpl); err != nil {
+				return nil, fmt.Errorf("loading %q: parsing template YAML: %w", doc.path, err)
+			}
+		} else {
+			fields, err := inheritedFields(doc, byID, nil)
+			if err != nil {
+				return nil, fmt.Errorf("loading %q: %w", doc.path, err)
+			}
+			data, err := yaml.Marshal(fields)
+			if err != nil {
+				return nil, fmt.Errorf("loading %q: npc template %q: %w", doc.path, doc.id, err)
+			}
+			if err := yaml.Unmarshal(data, &tmpl); err != nil {
+				return nil, fmt.Errorf("loading %q: npc template %q: %w", doc.path, doc.id, err)
+			}
+		}
+		if err := tmpl.Validate(); err != nil {
+			return nil, fmt.Errorf("loading %q: %w", doc.path, err)
+		}
+		out = append(out, &tmpl)
+	}
+	return out, nil
+}
+
+// inheritedFields returns doc's fields layered over those of its base chain.
+// chain holds the IDs already visited, for cycle detection.
+func inheritedFields(doc *templateDoc, byID map[string]*templateDoc, chain []string) (map[string]any, error) {
+	for _, id := range chain {
+		if id == doc.id {
+			return nil, fmt.Errorf("npc template %q: base cycle %s", chain[0], strings.Join(append(chain, doc.id), " -> "))
+		}
+	}
+	var fields map[string]any
+	if err := doc.node.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("npc template %q: %w", doc.id, err)
+	}
+	if doc.base == "" {
+		return fields, nil
+	}
+	base, ok := byID[doc.base]
+	if !ok {
+		return nil, fmt.Errorf("npc template %q: unknown base %q", doc.id, doc.base)
+	}
+	inherited, err := inheritedFields(base, byID, append(chain, doc.id))
+	if err != nil {
+		return nil, err
+	}
+	return mergeFields(inherited, fields), nil
+}
+
+// mergeFields layers over onto base: maps merge recursively, anything else in
+// over replaces base's value.
+//
+// Postcondition: Neither argument is modified.
+func mergeFields(base, over map[string]any) map[string]any {
+	out := make(map[string]any, len(base)+len(over))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range over {
+		if bm, ok := out[k].(map[string]any); ok {
+			if om, ok := v.(map[string]any); ok {
+				out[k] = mergeFields(bm, om)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
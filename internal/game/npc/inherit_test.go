@@ -0,0 +1,191 @@
+package npc_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+const gangerBaseYAML = `id: ganger
+name: Ganger
+description: A street tough.
+level: 3
+max_hp: 20
+ac: 14
+respawn_delay: 5m
+abilities:
+  brutality: 14
+  quickness: 12
+  grit: 12
+loot:
+  currency:
+    min: 5
+    max: 10
+taunts:
+  - "Beat it."
+`
+
+func writeTemplates(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, body := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(body), 0644))
+	}
+}
+
+func templateByID(t *testing.T, templates []*npc.Template, id string) *npc.Template {
+	t.Helper()
+	for _, tmpl := range templates {
+		if tmpl.ID == id {
+			return tmpl
+		}
+	}
+	t.Fatalf("template %q not loaded", id)
+	return nil
+}
+
+func TestLoadTemplates_BaseSuppliesUnsetFields(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplates(t, dir, map[string]string{
+		"ganger.yaml": gangerBaseYAML,
+		"elite/ganger_elite.yaml": `id: ganger_elite
+base: ganger
+name: Ganger Elite
+max_hp: 45
+tier: elite
+abilities:
+  brutality: 18
+loot:
+  items:
+    - item: medkit
+      chance: 0.5
+      min_qty: 1
+      max_qty: 1
+taunts:
+  - "You picked the wrong block."
+`,
+	})
+
+	templates, err := npc.LoadTemplates(dir)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+
+	elite := templateByID(t, templates, "ganger_elite")
+	assert.Equal(t, "ganger", elite.Base)
+	assert.Equal(t, "Ganger Elite", elite.Name)
+	assert.Equal(t, "A street tough.", elite.Description)
+	assert.Equal(t, 3, elite.Level)
+	assert.Equal(t, 45, elite.MaxHP)
+	assert.Equal(t, 14, elite.AC)
+	assert.Equal(t, "5m", elite.RespawnDelay)
+	assert.Equal(t, "elite", elite.Tier)
+	assert.Equal(t, 18, elite.Abilities.Brutality, "a nested field is overridden")
+	assert.Equal(t, 12, elite.Abilities.Quickness, "sibling nested fields are kept")
+	require.NotNil(t, elite.Loot)
+	require.NotNil(t, elite.Loot.Currency)
+	assert.Equal(t, 10, elite.Loot.Currency.Max)
+	require.Len(t, elite.Loot.Items, 1)
+	assert.Equal(t, "medkit", elite.Loot.Items[0].ItemID)
+	assert.Equal(t, []string{"You picked the wrong block."}, elite.Taunts, "lists replace the base's")
+
+	base := templateByID(t, templates, "ganger")
+	assert.Equal(t, 20, base.MaxHP, "the base is unchanged")
+	assert.Equal(t, 14, base.Abilities.Brutality)
+	assert.Empty(t, base.Loot.Items)
+}
+
+func TestLoadTemplates_BaseChainInListFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplates(t, dir, map[string]string{
+		"gangers.yaml": `- id: ganger_boss
+  base: ganger_elite
+  name: Ganger Boss
+  tier: boss
+- id: ganger_elite
+  base: ganger
+  max_hp: 45
+`,
+		"ganger.yaml": gangerBaseYAML,
+	})
+
+	templates, err := npc.LoadTemplates(dir)
+	require.NoError(t, err)
+	boss := templateByID(t, templates, "ganger_boss")
+	assert.Equal(t, "Ganger Boss", boss.Name)
+	assert.Equal(t, 45, boss.MaxHP)
+	assert.Equal(t, 14, boss.AC)
+	assert.Equal(t, "boss", boss.Tier)
+}
+
+func TestLoadTemplates_BaseErrors(t *testing.T) {
+	cases := map[string]struct {
+		files map[string]string
+		want  string
+	}{
+		"unknown base": {
+			files: map[string]string{"a.yaml": "id: a\nbase: nobody\nname: A\nlevel: 1\nmax_hp: 5\nac: 10\n"},
+			want:  `npc template "a": unknown base "nobody"`,
+		},
+		"cycle": {
+			files: map[string]string{
+				"a.yaml": "id: a\nbase: b\nname: A\nlevel: 1\nmax_hp: 5\nac: 10\n",
+				"b.yaml": "id: b\nbase: a\nname: B\nlevel: 1\nmax_hp: 5\nac: 10\n",
+			},
+			want: "base cycle",
+		},
+		"invalid result": {
+			files: map[string]string{
+				"ganger.yaml": gangerBaseYAML,
+				"weak.yaml":   "id: weak\nbase: ganger\nmax_hp: 0\n",
+			},
+			want: "max_hp must be >= 1",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTemplates(t, dir, tc.files)
+			_, err := npc.LoadTemplates(dir)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+		})
+	}
+}
+
+func TestLoadTemplateFromBytes_RejectsBase(t *testing.T) {
+	_, err := npc.LoadTemplateFromBytes([]byte("id: a\nbase: ganger\nname: A\nlevel: 1\nmax_hp: 5\nac: 10\n"))
+	assert.ErrorContains(t, err, "LoadTemplates")
+}
+
+func TestProperty_LoadTemplates_VariantOverridesOnlyItsFields(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		hp := rapid.IntRange(1, 500).Draw(rt, "hp")
+		setAC := rapid.Bool().Draw(rt, "setAC")
+		ac := rapid.IntRange(10, 30).Draw(rt, "ac")
+		variant := "id: variant\nbase: ganger\nmax_hp: " + strconv.Itoa(hp) + "\n"
+		if setAC {
+			variant += "ac: " + strconv.Itoa(ac) + "\n"
+		}
+		dir := t.TempDir()
+		writeTemplates(t, dir, map[string]string{"ganger.yaml": gangerBaseYAML, "variant.yaml": variant})
+
+		templates, err := npc.LoadTemplates(dir)
+		require.NoError(rt, err)
+		v := templateByID(t, templates, "variant")
+		assert.Equal(rt, hp, v.MaxHP)
+		if setAC {
+			assert.Equal(rt, ac, v.AC)
+		} else {
+			assert.Equal(rt, 14, v.AC)
+		}
+		assert.Equal(rt, "Ganger", v.Name)
+	})
+}
@@ -28,6 +28,9 @@ type Instance struct {
 	// SeductionRejected maps player UID → true when this NPC has rejected a seduction
 	// attempt from that player (REQ-ZN-8). Runtime-only: no YAML tag. Nil until first rejection.
 	SeductionRejected map[string]bool
+	// SocialRebuffed maps player UID → true when that player lost a social
+	// encounter with this NPC. Runtime-only; nil until the first loss.
+	SocialRebuffed map[string]bool
 	// SeductionProbability is propagated from Template at spawn.
 	SeductionProbability float64
 	// SeductionGender is propagated from Template at spawn.
@@ -69,6 +72,8 @@ type Instance struct {
 	Resistances map[string]int
 	// Weaknesses maps damage type → flat bonus. Copied from template at spawn.
 	Weaknesses map[string]int
+	// Immunities lists damage types that deal no damage. Copied from template at spawn.
+	Immunities []string
 	// WeaponID is the weapon item ID selected at spawn. Empty = unarmed.
 	WeaponID string
 	// ArmorID is the armor item ID selected at spawn. Empty = no armor.
@@ -140,6 +145,9 @@ type Instance struct {
 	SpeedFt int
 	// Immobile prevents this NPC from patrolling or wandering. Copied from template.
 	Immobile bool
+	// TrainingDummy marks a practice target that never fights back, resets at
+	// 0 HP, and grants no rewards. Copied from template.
+	TrainingDummy bool
 	// Roving state — only populated when Template.Roving != nil.
 	// RovingRouteIndex is the current index into RovingConfig.Route.
 	RovingRouteIndex int
@@ -190,10 +198,42 @@ type Instance struct {
 	// Taunts is a list of combat taunts copied from the template at spawn.
 	// On each combat turn there is a 25% chance one is broadcast to the room.
 	Taunts []string
+	// Barks is a list of idle flavor actions copied from the template at spawn.
+	Barks []string
+	// Reactions is the list of room event reactions copied from the template at spawn.
+	Reactions []Reaction
+	// Civilian is copied from the template at spawn; assaulting a civilian in a
+	// protected zone is a crime.
+	Civilian bool
+	// Pickpocket is the steal table copied from the template; nil means there
+	// is nothing to steal.
+	Pickpocket *LootTable
+	// StealAttempts maps player UID → time of that player's last attempt to pick
+	// this NPC's pocket. Runtime-only. Nil until the first attempt.
+	StealAttempts map[string]time.Time
 	// Conditions tracks conditions applied to this NPC instance.
 	// Initialized at spawn. Conditions applied in combat are reflected here and
 	// in the combat engine's Conditions map for the same instance ID.
 	Conditions *condition.ActiveSet
+	// PuppetedBy is the UID of the staff member controlling this NPC with the
+	// puppet command; empty when the NPC acts on its own. Runtime-only.
+	PuppetedBy string
+	// PuppetOrder names the combatant a puppeted NPC attacks each round; empty
+	// means it holds. Runtime-only.
+	PuppetOrder string
+	// Surrender is copied from the template; nil means the NPC never surrenders.
+	Surrender *SurrenderConfig
+	// Surrendered is true once the NPC's morale broke and it gave up. A
+	// surrendered NPC is non-hostile and stays put until released. Runtime-only.
+	Surrendered bool
+	// SurrenderLooted and SurrenderInterrogated record which parley options
+	// players have already taken with a surrendered NPC. Runtime-only.
+	SurrenderLooted       bool
+	SurrenderInterrogated bool
+	// CaptorUID is the player holding this NPC prisoner; empty when it is free.
+	CaptorUID string
+	// EscapeDC is the DC this prisoner must beat to slip its restraints.
+	EscapeDC int
 }
 
 // HasTag reports whether the given tag is present in the instance's tag list.
@@ -312,6 +352,7 @@ func NewInstanceWithResolver(id string, tmpl *Template, roomID string, armorACBo
 		SkillChecks: tmpl.SkillChecks,
 		Resistances:   resolveResistances(tmpl),
 		Weaknesses:    tmpl.Weaknesses,
+		Immunities:    tmpl.Immunities,
 		WeaponID:      weaponID,
 		ArmorID:       armorID,
 		UseCover:      tmpl.Combat.UseCover,
@@ -337,6 +378,7 @@ func NewInstanceWithResolver(id string, tmpl *Template, roomID string, armorACBo
 		AttackVerb: tmpl.AttackVerb,
 		SpeedFt:    tmpl.SpeedFt,
 		Immobile:   tmpl.Immobile,
+		TrainingDummy: tmpl.TrainingDummy,
 		// Cowering defaults to false (zero value).
 		FactionID: tmpl.FactionID,
 		Disposition: func() string {
@@ -346,6 +388,11 @@ func NewInstanceWithResolver(id string, tmpl *Template, roomID string, armorACBo
 			return tmpl.Disposition
 		}(),
 		Taunts:           append([]string(nil), tmpl.Taunts...),
+		Barks:            append([]string(nil), tmpl.Barks...),
+		Reactions:        append([]Reaction(nil), tmpl.Reactions...),
+		Civilian:         tmpl.Civilian,
+		Pickpocket:       tmpl.Pickpocket,
+		Surrender:        tmpl.Surrender,
 		CourageThreshold: tmpl.CourageThreshold,
 		FleeHPPct:        tmpl.FleeHPPct,
 		WanderRadius:     tmpl.WanderRadius,
@@ -434,22 +481,5 @@ func (i *Instance) IsDead() bool {
 //
 // Postcondition: Returns a non-empty string.
 func (i *Instance) HealthDescription() string {
-	if i.CurrentHP <= 0 {
-		return "dead"
-	}
-	pct := float64(i.CurrentHP) / float64(i.MaxHP)
-	switch {
-	case pct >= 1.0:
-		return "unharmed"
-	case pct >= 0.85:
-		return "barely scratched"
-	case pct >= 0.60:
-		return "lightly wounded"
-	case pct >= 0.40:
-		return "moderately wounded"
-	case pct >= 0.20:
-		return "heavily wounded"
-	default:
-		return "critically wounded"
-	}
+	return combat.WoundDescriptor(i.CurrentHP, i.MaxHP)
 }
@@ -0,0 +1,66 @@
+package npc
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// ResolveItemTags expands the tag references in t against reg: each tagged
+// loot or pickpocket entry gets the IDs of every matching item as its
+// Candidates, and each merchant stock_tags filter appends the matching items
+// not already listed to the merchant's Inventory.
+//
+// Precondition: reg must not be nil; t must have passed Validate.
+// Postcondition: Returns an error naming the first tag that matches no item.
+func (t *Template) ResolveItemTags(reg *inventory.Registry) error {
+	for _, lt := range []*LootTable{t.Loot, t.Pickpocket} {
+		if lt == nil {
+			continue
+		}
+		for i := range lt.Items {
+			drop := &lt.Items[i]
+			if drop.Tag == "" {
+				continue
+			}
+			matches := reg.ItemsWithTag(drop.Tag)
+			if len(matches) == 0 {
+				return fmt.Errorf("npc template %q: loot tag %q matches no items", t.ID, drop.Tag)
+			}
+			drop.Candidates = make([]string, len(matches))
+			for j, d := range matches {
+				drop.Candidates[j] = d.ID
+			}
+		}
+	}
+	if t.Merchant == nil {
+		return nil
+	}
+	listed := make(map[string]bool, len(t.Merchant.Inventory))
+	for _, item := range t.Merchant.Inventory {
+		listed[item.ItemID] = true
+	}
+	for _, st := range t.Merchant.StockTags {
+		matches := reg.ItemsWithTag(st.Tag)
+		if len(matches) == 0 {
+			return fmt.Errorf("npc template %q: merchant stock tag %q matches no items", t.ID, st.Tag)
+		}
+		for _, d := range matches {
+			if listed[d.ID] || (st.MaxValue > 0 && d.Value > st.MaxValue) {
+				continue
+			}
+			price := d.Value
+			if price < 1 {
+				price = 1
+			}
+			t.Merchant.Inventory = append(t.Merchant.Inventory, MerchantItem{
+				ItemID:    d.ID,
+				BasePrice: price,
+				InitStock: st.InitStock,
+				MaxStock:  st.MaxStock,
+			})
+			listed[d.ID] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package npc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+func taggedRegistry(t *testing.T) *inventory.Registry {
+	t.Helper()
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterWeapon(&inventory.WeaponDef{ID: "knife", Tags: []string{"melee"}}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "knife_item", Name: "Knife", Kind: inventory.KindWeapon, WeaponRef: "knife", MaxStack: 1, Value: 40}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "pipe", Name: "Pipe", Kind: inventory.KindJunk, MaxStack: 1, Value: 0, Tags: []string{"melee"}}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "katana", Name: "Katana", Kind: inventory.KindJunk, MaxStack: 1, Value: 900, Tags: []string{"melee"}}))
+	return reg
+}
+
+func TestLootTable_Validate_TagOrItemRequired(t *testing.T) {
+	lt := npc.LootTable{Items: []npc.ItemDrop{{Tag: "melee", Chance: 1, MinQty: 1, MaxQty: 1}}}
+	require.NoError(t, lt.Validate())
+	lt.Items[0].ItemID = "pipe"
+	assert.Error(t, lt.Validate())
+	lt.Items[0] = npc.ItemDrop{Chance: 1, MinQty: 1, MaxQty: 1}
+	assert.Error(t, lt.Validate())
+}
+
+func TestResolveItemTags_TaggedLootDropsMatchingItem(t *testing.T) {
+	tmpl := &npc.Template{ID: "ganger", Loot: &npc.LootTable{
+		Items: []npc.ItemDrop{{Tag: "melee", Chance: 1, MinQty: 1, MaxQty: 1}},
+	}}
+	require.NoError(t, tmpl.ResolveItemTags(taggedRegistry(t)))
+	assert.Equal(t, []string{"katana", "knife_item", "pipe"}, tmpl.Loot.Items[0].Candidates)
+
+	for i := 0; i < 20; i++ {
+		result := npc.GenerateLoot(*tmpl.Loot)
+		require.Len(t, result.Items, 1)
+		assert.Contains(t, tmpl.Loot.Items[0].Candidates, result.Items[0].ItemDefID)
+	}
+}
+
+func TestResolveItemTags_UnmatchedTagIsError(t *testing.T) {
+	tmpl := &npc.Template{ID: "ganger", Loot: &npc.LootTable{
+		Items: []npc.ItemDrop{{Tag: "ranged", Chance: 1, MinQty: 1, MaxQty: 1}},
+	}}
+	err := tmpl.ResolveItemTags(taggedRegistry(t))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `loot tag "ranged" matches no items`)
+}
+
+func TestResolveItemTags_MerchantStockTagsExpandInventory(t *testing.T) {
+	tmpl := &npc.Template{ID: "fence", Merchant: &npc.MerchantConfig{
+		Inventory: []npc.MerchantItem{{ItemID: "knife_item", BasePrice: 55, InitStock: 1, MaxStock: 1}},
+		StockTags: []npc.MerchantTagStock{{Tag: "melee", InitStock: 2, MaxStock: 4, MaxValue: 100}},
+	}}
+	require.NoError(t, tmpl.ResolveItemTags(taggedRegistry(t)))
+	inv := tmpl.Merchant.Inventory
+	require.Len(t, inv, 2)
+	// The explicit listing keeps its price; katana exceeds max_value.
+	assert.Equal(t, npc.MerchantItem{ItemID: "knife_item", BasePrice: 55, InitStock: 1, MaxStock: 1}, inv[0])
+	assert.Equal(t, npc.MerchantItem{ItemID: "pipe", BasePrice: 1, InitStock: 2, MaxStock: 4}, inv[1])
+}
@@ -3,6 +3,7 @@ package npc
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 
 	"github.com/google/uuid"
@@ -15,11 +16,16 @@ type CurrencyDrop struct {
 }
 
 // ItemDrop defines a single item entry in a loot table with a drop chance.
+// An entry names either a specific item or a tag; a tagged entry drops one
+// item chosen at random from every item carrying that tag.
 type ItemDrop struct {
 	ItemID string  `yaml:"item"`
+	Tag    string  `yaml:"tag,omitempty"`
 	Chance float64 `yaml:"chance"`
 	MinQty int     `yaml:"min_qty"`
 	MaxQty int     `yaml:"max_qty"`
+	// Candidates holds the item IDs matching Tag; filled by ResolveItemTags.
+	Candidates []string `yaml:"-"`
 }
 
 // MaterialDrop defines a crafting material entry in a loot table with an independent drop chance.
@@ -69,8 +75,11 @@ func (lt *LootTable) Validate() error {
 		}
 	}
 	for i, item := range lt.Items {
-		if item.ItemID == "" {
-			return fmt.Errorf("loot table: item[%d] must have a non-empty item id", i)
+		if item.ItemID == "" && item.Tag == "" {
+			return fmt.Errorf("loot table: item[%d] must have a non-empty item id or tag", i)
+		}
+		if item.ItemID != "" && item.Tag != "" {
+			return fmt.Errorf("loot table: item[%d] must not set both item %q and tag %q", i, item.ItemID, item.Tag)
 		}
 		if item.Chance <= 0 || item.Chance > 1.0 {
 			return fmt.Errorf("loot table: item[%d] chance must be in (0, 1.0], got %f", i, item.Chance)
@@ -191,11 +200,44 @@ func GenerateSalvageLoot(lt LootTable) LootResult {
 	}}}
 }
 
+// ScaleLoot returns a copy of lt with its currency range multiplied by factor
+// and every item and material drop chance multiplied by factor, capped at 1.
+//
+// Precondition: factor > 0.
+// Postcondition: lt is unchanged; a factor of 1 returns an equal table.
+func ScaleLoot(lt LootTable, factor float64) LootTable {
+	if factor == 1 {
+		return lt
+	}
+	scaleChance := func(c float64) float64 { return math.Min(1, c*factor) }
+	if lt.Currency != nil {
+		cur := CurrencyDrop{
+			Min: int(math.Round(float64(lt.Currency.Min) * factor)),
+			Max: int(math.Round(float64(lt.Currency.Max) * factor)),
+		}
+		lt.Currency = &cur
+	}
+	items := make([]ItemDrop, len(lt.Items))
+	for i, it := range lt.Items {
+		it.Chance = scaleChance(it.Chance)
+		items[i] = it
+	}
+	lt.Items = items
+	materials := make([]MaterialDrop, len(lt.MaterialDrops))
+	for i, md := range lt.MaterialDrops {
+		md.Chance = scaleChance(md.Chance)
+		materials[i] = md
+	}
+	lt.MaterialDrops = materials
+	return lt
+}
+
 // GenerateLoot rolls loot from the given LootTable using math/rand.
 //
 // Precondition: lt must have passed Validate().
 // Postcondition: Currency is in [Currency.Min, Currency.Max] if currency is set;
 // each item's Quantity is in [MinQty, MaxQty] for items that pass the chance roll.
+// A tagged entry with no Candidates never drops.
 func GenerateLoot(lt LootTable) LootResult {
 	var result LootResult
 
@@ -209,14 +251,21 @@ func GenerateLoot(lt LootTable) LootResult {
 	}
 
 	for _, item := range lt.Items {
+		if item.Tag != "" && len(item.Candidates) == 0 {
+			continue
+		}
 		if rand.Float64() < item.Chance {
+			itemID := item.ItemID
+			if item.Tag != "" {
+				itemID = item.Candidates[rand.Intn(len(item.Candidates))]
+			}
 			qty := item.MinQty
 			spread := item.MaxQty - item.MinQty
 			if spread > 0 {
 				qty += rand.Intn(spread + 1)
 			}
 			result.Items = append(result.Items, LootItem{
-				ItemDefID:  item.ItemID,
+				ItemDefID:  itemID,
 				InstanceID: uuid.New().String(),
 				Quantity:   qty,
 			})
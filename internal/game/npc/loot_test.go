@@ -374,3 +374,20 @@ func TestProperty_GenerateLoot_MaterialDrop_GuaranteedFixedQty(t *testing.T) {
 		}
 	})
 }
+
+func TestScaleLoot_ScalesCurrencyAndCapsChances(t *testing.T) {
+	lt := validLootTable()
+	lt.MaterialDrops = []npc.MaterialDrop{{ID: "scrap", QuantityMin: 1, QuantityMax: 1, Chance: 0.4}}
+
+	scaled := npc.ScaleLoot(lt, 2)
+	assert.Equal(t, &npc.CurrencyDrop{Min: 10, Max: 40}, scaled.Currency)
+	assert.Equal(t, 1.0, scaled.Items[0].Chance)
+	assert.Equal(t, 1.0, scaled.Items[1].Chance)
+	assert.Equal(t, 0.8, scaled.MaterialDrops[0].Chance)
+	require.NoError(t, scaled.Validate())
+
+	// The source table is untouched.
+	assert.Equal(t, validLootTable().Currency, lt.Currency)
+	assert.Equal(t, 0.5, lt.Items[0].Chance)
+	assert.Equal(t, lt, npc.ScaleLoot(lt, 1))
+}
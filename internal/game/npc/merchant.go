@@ -39,6 +39,7 @@ func nextReplenishInterval(r ReplenishConfig) time.Duration {
 //
 // Precondition: cfg and state must be non-nil.
 // Postcondition: Returns a new MerchantRuntimeState; original is unchanged.
+// Stock below MaxStock is refilled toward it; stock at or above MaxStock is kept.
 func ApplyReplenish(cfg *MerchantConfig, state *MerchantRuntimeState, _ int) *MerchantRuntimeState {
 	next := &MerchantRuntimeState{
 		Stock:           make(map[string]int, len(state.Stock)),
@@ -50,6 +51,10 @@ func ApplyReplenish(cfg *MerchantConfig, state *MerchantRuntimeState, _ int) *Me
 	}
 	for _, item := range cfg.Inventory {
 		cur := next.Stock[item.ItemID]
+		if cur >= item.MaxStock {
+			// Surplus bought from players is kept for resale rather than discarded.
+			continue
+		}
 		if cfg.ReplenishRate.StockRefill == 0 {
 			next.Stock[item.ItemID] = item.MaxStock
 		} else {
@@ -68,6 +73,41 @@ func ApplyReplenish(cfg *MerchantConfig, state *MerchantRuntimeState, _ int) *Me
 	return next
 }
 
+// SupplyDemandMultiplier returns the price multiplier for an item given the
+// merchant's current stock of it. Prices sit at 1.0 when stock == maxStock, rise
+// linearly to 1+elasticity as stock reaches 0, and fall to 1-elasticity at
+// twice maxStock, beyond which they stay flat.
+//
+// Precondition: none.
+// Postcondition: Returns 1.0 when elasticity <= 0 or maxStock <= 0; otherwise a
+// value in [1-elasticity, 1+elasticity].
+func SupplyDemandMultiplier(elasticity float64, stock, maxStock int) float64 {
+	if elasticity <= 0 || maxStock <= 0 {
+		return 1.0
+	}
+	ratio := float64(stock) / float64(maxStock)
+	if ratio < 0 {
+		ratio = 0
+	}
+	if ratio > 2 {
+		ratio = 2
+	}
+	return 1.0 + elasticity*(1.0-ratio)
+}
+
+// AdjustedBasePrice returns the item's base price scaled by the merchant's
+// supply/demand multiplier for the given stock level.
+//
+// Precondition: cfg must be non-nil; item.BasePrice >= 1.
+// Postcondition: Returns a value >= 1; equals item.BasePrice when cfg.PriceElasticity is 0.
+func AdjustedBasePrice(cfg *MerchantConfig, item MerchantItem, stock int) int {
+	price := int(math.Round(float64(item.BasePrice) * SupplyDemandMultiplier(cfg.PriceElasticity, stock, item.MaxStock)))
+	if price < 1 {
+		price = 1
+	}
+	return price
+}
+
 // ComputeBuyPrice returns the credit cost for the player to buy one unit of an
 // item at basePrice, applying sellMargin, wantedSurcharge, then negotiate modifier.
 //
@@ -129,18 +169,20 @@ type BrowseItem struct {
 }
 
 // BrowseLines returns browse display rows for all inventory items, prices
-// adjusted for the active negotiate modifier and wanted surcharge.
+// adjusted for supply/demand, the active negotiate modifier and wanted surcharge.
 //
 // Precondition: cfg and state must be non-nil.
 // Postcondition: Returns one BrowseItem per Inventory entry in config order.
 func BrowseLines(cfg *MerchantConfig, state *MerchantRuntimeState, wantedSurcharge, negotiateMod float64) []BrowseItem {
 	rows := make([]BrowseItem, 0, len(cfg.Inventory))
 	for _, item := range cfg.Inventory {
+		stock := state.Stock[item.ItemID]
+		base := AdjustedBasePrice(cfg, item, stock)
 		rows = append(rows, BrowseItem{
 			ItemID:    item.ItemID,
-			BuyPrice:  ComputeBuyPrice(item.BasePrice, cfg.SellMargin, wantedSurcharge, negotiateMod),
-			SellPrice: ComputeSellPayout(item.BasePrice, cfg.BuyMargin, 1, negotiateMod),
-			Stock:     state.Stock[item.ItemID],
+			BuyPrice:  ComputeBuyPrice(base, cfg.SellMargin, wantedSurcharge, negotiateMod),
+			SellPrice: ComputeSellPayout(base, cfg.BuyMargin, 1, negotiateMod),
+			Stock:     stock,
 		})
 	}
 	return rows
@@ -128,3 +128,61 @@ func TestProperty_ComputeSellPayout_NeverNegative(t *testing.T) {
 		assert.GreaterOrEqual(t, got, 0)
 	})
 }
+
+func TestApplyReplenish_KeepsSurplus(t *testing.T) {
+	cfg := &MerchantConfig{
+		Budget: 500,
+		Inventory: []MerchantItem{
+			{ItemID: "sword", BasePrice: 100, InitStock: 0, MaxStock: 5},
+		},
+		ReplenishRate: ReplenishConfig{MinHours: 1, MaxHours: 1},
+	}
+	state := &MerchantRuntimeState{Stock: map[string]int{"sword": 8}}
+	next := ApplyReplenish(cfg, state, 0)
+	assert.Equal(t, 8, next.Stock["sword"], "stock sold in by players is not discarded")
+}
+
+func TestSupplyDemandMultiplier(t *testing.T) {
+	assert.Equal(t, 1.0, SupplyDemandMultiplier(0, 0, 5), "zero elasticity keeps prices fixed")
+	assert.Equal(t, 1.0, SupplyDemandMultiplier(0.5, 3, 0), "no max stock keeps prices fixed")
+	assert.InDelta(t, 1.5, SupplyDemandMultiplier(0.5, 0, 5), 1e-9)
+	assert.InDelta(t, 1.0, SupplyDemandMultiplier(0.5, 5, 5), 1e-9)
+	assert.InDelta(t, 0.5, SupplyDemandMultiplier(0.5, 10, 5), 1e-9)
+	assert.InDelta(t, 0.5, SupplyDemandMultiplier(0.5, 50, 5), 1e-9, "glut pricing bottoms out")
+}
+
+func TestBrowseLines_ScarcityRaisesPrices(t *testing.T) {
+	cfg := &MerchantConfig{
+		SellMargin:      1.0,
+		BuyMargin:       0.5,
+		PriceElasticity: 0.5,
+		Inventory:       []MerchantItem{{ItemID: "sword", BasePrice: 100, MaxStock: 4}},
+	}
+	full := BrowseLines(cfg, &MerchantRuntimeState{Stock: map[string]int{"sword": 4}}, 1.0, 0)
+	scarce := BrowseLines(cfg, &MerchantRuntimeState{Stock: map[string]int{"sword": 1}}, 1.0, 0)
+	assert.Equal(t, 100, full[0].BuyPrice)
+	assert.Greater(t, scarce[0].BuyPrice, full[0].BuyPrice)
+	assert.Greater(t, scarce[0].SellPrice, full[0].SellPrice)
+}
+
+func TestMerchantConfig_Validate_RejectsBadElasticity(t *testing.T) {
+	assert.Error(t, (&MerchantConfig{PriceElasticity: -0.1}).Validate())
+	assert.Error(t, (&MerchantConfig{PriceElasticity: 1.5}).Validate())
+	assert.NoError(t, (&MerchantConfig{PriceElasticity: 0.4}).Validate())
+}
+
+func TestProperty_SupplyDemandMultiplier_FallsAsStockRises(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		e := rapid.Float64Range(0, 0.9).Draw(rt, "elasticity")
+		maxStock := rapid.IntRange(1, 50).Draw(rt, "max")
+		a := rapid.IntRange(0, 200).Draw(rt, "a")
+		b := rapid.IntRange(a, 200).Draw(rt, "b")
+		ma, mb := SupplyDemandMultiplier(e, a, maxStock), SupplyDemandMultiplier(e, b, maxStock)
+		if mb > ma {
+			rt.Fatalf("multiplier rose with stock: %d→%g, %d→%g", a, ma, b, mb)
+		}
+		if ma < 1-e-1e-9 || ma > 1+e+1e-9 {
+			rt.Fatalf("multiplier %g outside [%g, %g]", ma, 1-e, 1+e)
+		}
+	})
+}
@@ -4,6 +4,8 @@ package npc
 import (
 	"fmt"
 	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/world"
 )
 
 // ---- Merchant ----
@@ -17,6 +19,16 @@ type MerchantConfig struct {
 	Budget        int                   `yaml:"budget"`
 	ReplenishRate ReplenishConfig       `yaml:"replenish_rate"`
 	MaterialStock []MaterialStockItem   `yaml:"material_stock,omitempty"`
+	// StockTags adds every item carrying a tag to Inventory; expanded by
+	// Template.ResolveItemTags once item definitions are loaded.
+	StockTags []MerchantTagStock `yaml:"stock_tags,omitempty"`
+	// PriceElasticity scales prices by supply: items priced at base when stock is at
+	// max_stock, up to (1+elasticity)× when sold out and down to (1-elasticity)× when
+	// players have sold the merchant twice its max. 0 keeps prices fixed; must be in [0, 0.9].
+	PriceElasticity float64 `yaml:"price_elasticity,omitempty"`
+	// EngraveFee, when > 0, is the price this merchant charges to engrave a
+	// custom name on one of the player's items. 0 means no engraving service.
+	EngraveFee int `yaml:"engrave_fee,omitempty"`
 }
 
 // MerchantItem is one entry in a merchant's static inventory.
@@ -28,6 +40,19 @@ type MerchantItem struct {
 	// Modifier is the optional pre-set item modifier: "" | "tuned" | "defective".
 	// "cursed" is explicitly disallowed (REQ-EM-27).
 	Modifier string `yaml:"modifier,omitempty"`
+	// MinRep, when set, refuses the sale to players below the given faction
+	// reputation. The item still shows when browsing, marked as locked.
+	MinRep *world.RepRequirement `yaml:"min_rep,omitempty"`
+}
+
+// MerchantTagStock is a stock filter: the merchant carries every item with
+// Tag, each priced at the item's base value.
+type MerchantTagStock struct {
+	Tag       string `yaml:"tag"`
+	InitStock int    `yaml:"init_stock"`
+	MaxStock  int    `yaml:"max_stock"`
+	// MaxValue, when positive, leaves out items whose base value exceeds it.
+	MaxValue int `yaml:"max_value,omitempty"`
 }
 
 // MaterialStockItem is one entry in a merchant's static material stock.
@@ -37,15 +62,36 @@ type MaterialStockItem struct {
 	RestockQuantity int    `yaml:"restock_quantity"`
 }
 
-// Validate checks REQ-EM-27: merchants MUST NOT stock cursed items.
+// Validate checks REQ-EM-27: merchants MUST NOT stock cursed items, that
+// price_elasticity is in [0, 0.9], engrave_fee is not negative, and that any
+// stock reputation gates and stock_tags filters are well formed.
 //
 // Precondition: cfg must not be nil.
 // Postcondition: Returns an error naming the first cursed item found.
 func (cfg *MerchantConfig) Validate() error {
+	if cfg.PriceElasticity < 0 || cfg.PriceElasticity > 0.9 {
+		return fmt.Errorf("merchant config: price_elasticity must be in [0, 0.9], got %g", cfg.PriceElasticity)
+	}
+	if cfg.EngraveFee < 0 {
+		return fmt.Errorf("merchant config: engrave_fee must be >= 0, got %d", cfg.EngraveFee)
+	}
+	for i, st := range cfg.StockTags {
+		if st.Tag == "" {
+			return fmt.Errorf("merchant config: stock_tags[%d] must have a non-empty tag", i)
+		}
+		if st.InitStock < 0 || st.MaxStock < 0 || st.MaxValue < 0 {
+			return fmt.Errorf("merchant config: stock_tags[%d] (%q) stock and max_value must be >= 0", i, st.Tag)
+		}
+	}
 	for _, item := range cfg.Inventory {
 		if item.Modifier == "cursed" {
 			return fmt.Errorf("merchant config: item %q has modifier 'cursed'; merchants may not stock cursed items (REQ-EM-27)", item.ItemID)
 		}
+		if item.MinRep != nil {
+			if err := item.MinRep.Validate(); err != nil {
+				return fmt.Errorf("merchant config: item %q: %w", item.ItemID, err)
+			}
+		}
 	}
 	return nil
 }
@@ -74,6 +120,7 @@ func (r ReplenishConfig) Validate() error {
 }
 
 // MerchantRuntimeState holds the mutable runtime state of a merchant, persisted to DB.
+// Stock may exceed an item's MaxStock when players sell the merchant surplus.
 type MerchantRuntimeState struct {
 	Stock           map[string]int
 	CurrentBudget   int
@@ -95,6 +142,9 @@ type GuardConfig struct {
 	// BaseCosts maps WantedLevel (1-4) to the base bribe cost in credits.
 	// Required when Bribeable is true. All keys 1-4 must be present with positive values.
 	BaseCosts map[int]int `yaml:"base_costs,omitempty"`
+	// FinePerLevel is the lawful fine, in credits per wanted level, this guard
+	// accepts to clear a player's record. Zero uses the server default.
+	FinePerLevel int `yaml:"fine_per_level,omitempty"`
 }
 
 // Validate checks REQ-WC-2b: when Bribeable is true, MaxBribeWantedLevel must be
@@ -215,6 +265,9 @@ type HirelingConfig struct {
 	DailyCost      int    `yaml:"daily_cost"`
 	CombatRole     string `yaml:"combat_role"`
 	MaxFollowZones int    `yaml:"max_follow_zones"`
+	// LootCut is the percentage of looted credits the hireling keeps as wages
+	// when it fights alongside its employer; 0 takes nothing.
+	LootCut int `yaml:"loot_cut"`
 }
 
 // HirelingRuntimeState holds the mutable runtime state of a hireling, persisted to DB.
@@ -232,11 +285,47 @@ type BankerConfig struct {
 	RateVariance float64 `yaml:"rate_variance"`
 }
 
+// ---- Broker ----
+
+// BrokerConfig marks an NPC as a consignment broker: players can list items for
+// sale, buy other players' listings, and collect escrowed proceeds with them.
+type BrokerConfig struct {
+	// FeeRate is the listing fee as a fraction of the buyout per day listed.
+	// Zero uses the auction house default.
+	FeeRate float64 `yaml:"fee_rate"`
+}
+
+// Validate checks that FeeRate lies in [0, 1).
+func (c *BrokerConfig) Validate() error {
+	if c.FeeRate < 0 || c.FeeRate >= 1 {
+		return fmt.Errorf("broker: fee_rate must be in [0,1), got %g", c.FeeRate)
+	}
+	return nil
+}
+
 // ---- Job Trainer ----
 
+// DefaultRespecCostPerLevel is the respec fee per character level charged by
+// trainers that do not set their own.
+const DefaultRespecCostPerLevel = 50
+
 // JobTrainerConfig holds the static configuration for a job trainer NPC.
 type JobTrainerConfig struct {
 	OfferedJobs []TrainableJob `yaml:"offered_jobs"`
+	// RespecCost is the respec fee per character level; zero uses
+	// DefaultRespecCostPerLevel.
+	RespecCost int `yaml:"respec_cost,omitempty"`
+}
+
+// RespecFee returns what this trainer charges a character of level to respec.
+//
+// Postcondition: Returns a positive fee; levels below 1 are charged as level 1.
+func (c *JobTrainerConfig) RespecFee(level int) int {
+	per := c.RespecCost
+	if per <= 0 {
+		per = DefaultRespecCostPerLevel
+	}
+	return per * max(level, 1)
 }
 
 // TrainableJob describes a job that this trainer can teach.
@@ -279,8 +368,12 @@ func proficiencyRank(rank string) int {
 // REQ-NPC-2a: unknown skill IDs MUST be a fatal load error.
 //
 // Precondition: knownSkills may be nil (treated as empty set, all IDs unknown).
-// Postcondition: Returns nil iff all referenced skill IDs are present in knownSkills.
+// Postcondition: Returns nil iff respec_cost is non-negative and all referenced
+// skill IDs are present in knownSkills.
 func (c *JobTrainerConfig) Validate(knownSkills map[string]bool) error {
+	if c.RespecCost < 0 {
+		return fmt.Errorf("job_trainer: respec_cost must be >= 0, got %d", c.RespecCost)
+	}
 	for _, job := range c.OfferedJobs {
 		for skillID := range job.Prerequisites.MinSkillRanks {
 			if !knownSkills[skillID] {
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/world"
 )
 
 func TestReplenishConfig_Valid(t *testing.T) {
@@ -180,6 +182,20 @@ func TestJobTrainerConfig_Validate_EmptyOfferedJobs(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestJobTrainerConfig_Validate_NegativeRespecCost rejects a negative fee.
+func TestJobTrainerConfig_Validate_NegativeRespecCost(t *testing.T) {
+	cfg := &JobTrainerConfig{RespecCost: -1}
+	assert.ErrorContains(t, cfg.Validate(map[string]bool{}), "respec_cost")
+}
+
+// TestJobTrainerConfig_RespecFee scales the fee with level and falls back to
+// the default rate.
+func TestJobTrainerConfig_RespecFee(t *testing.T) {
+	assert.Equal(t, DefaultRespecCostPerLevel*3, (&JobTrainerConfig{}).RespecFee(3))
+	assert.Equal(t, 75, (&JobTrainerConfig{RespecCost: 25}).RespecFee(3))
+	assert.Equal(t, 25, (&JobTrainerConfig{RespecCost: 25}).RespecFee(0))
+}
+
 // TestCheckJobPrerequisites_MinLevel verifies level gate.
 func TestCheckJobPrerequisites_MinLevel(t *testing.T) {
 	job := TrainableJob{
@@ -409,6 +425,20 @@ func TestMerchantConfig_Validate_RejectsCursedItems(t *testing.T) {
 	assert.Error(t, cfg.Validate(), "merchant config with cursed item must fail validation")
 }
 
+func TestMerchantConfig_Validate_RejectsMalformedRepGate(t *testing.T) {
+	cfg := MerchantConfig{
+		MerchantType: "weapons",
+		Inventory: []MerchantItem{
+			{ItemID: "pistol", BasePrice: 100, InitStock: 5, MaxStock: 10, MinRep: &world.RepRequirement{MinRep: 40}},
+		},
+		ReplenishRate: ReplenishConfig{MinHours: 1, MaxHours: 4},
+	}
+	assert.ErrorContains(t, cfg.Validate(), "pistol")
+
+	cfg.Inventory[0].MinRep.Faction = "gun"
+	assert.NoError(t, cfg.Validate())
+}
+
 // TestCheckJobPrerequisites_RequiredFeat_Missing verifies feat prerequisite gate when feat is absent.
 func TestCheckJobPrerequisites_RequiredFeat_Missing(t *testing.T) {
 	job := TrainableJob{
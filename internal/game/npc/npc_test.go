@@ -251,8 +251,8 @@ func TestInstance_HealthDescription(t *testing.T) {
 		{90, "barely scratched"},
 		{70, "lightly wounded"},
 		{50, "moderately wounded"},
-		{25, "heavily wounded"},
-		{10, "critically wounded"},
+		{25, "bloodied"},
+		{10, "near death"},
 		{0, "dead"},
 	}
 	for _, tc := range tests {
@@ -15,12 +15,18 @@ import (
 // NPCsDir is the path to NPC template YAML files.
 type NPCsDir string
 
-// LoadTemplatesFromDir loads NPC templates from the given directory.
-func LoadTemplatesFromDir(dir NPCsDir, logger *zap.Logger) ([]*Template, error) {
+// LoadTemplatesFromDir loads NPC templates from the given directory and
+// resolves their item tag references against invRegistry.
+func LoadTemplatesFromDir(dir NPCsDir, invRegistry *inventory.Registry, logger *zap.Logger) ([]*Template, error) {
 	templates, err := LoadTemplates(string(dir))
 	if err != nil {
 		return nil, fmt.Errorf("loading npc templates from %q: %w", dir, err)
 	}
+	for _, tmpl := range templates {
+		if err := tmpl.ResolveItemTags(invRegistry); err != nil {
+			return nil, fmt.Errorf("loading npc templates from %q: %w", dir, err)
+		}
+	}
 	logger.Info("loaded npc templates", zap.Int("count", len(templates)))
 	return templates, nil
 }
@@ -0,0 +1,95 @@
+package npc
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Room events an NPC may react to.
+const (
+	// EventDrawWeapon fires when a player equips a weapon.
+	EventDrawWeapon = "draw_weapon"
+	// EventDropItem fires when a player drops an item on the floor.
+	EventDropItem = "drop_item"
+	// EventWearArmor fires when a player puts on armor.
+	EventWearArmor = "wear_armor"
+)
+
+// ReactionEvents lists every event kind a Reaction may name.
+var ReactionEvents = []string{EventDrawWeapon, EventDropItem, EventWearArmor}
+
+// Reaction binds a room event an NPC can observe to a Lua handler.
+//
+// The handler is called as hook(npc_uid, player_uid, event), where event is a
+// table with kind, item, and team fields. Returning "attack" makes the NPC
+// start a fight with the player; returning any other string makes the NPC say it.
+type Reaction struct {
+	// On is the observed event kind, one of ReactionEvents.
+	On string `yaml:"on"`
+	// Team, when set, limits the reaction to gear of that team affinity
+	// ("gun" or "machete"), e.g. to bristle only at rival-team armor.
+	Team string `yaml:"team,omitempty"`
+	// Hook is the Lua global function to call.
+	Hook string `yaml:"hook"`
+}
+
+// RoomEvent is something a player did in view of the NPCs in a room.
+type RoomEvent struct {
+	Kind     string
+	RoomID   string
+	ActorUID string
+	// ItemID is the item definition drawn, dropped, or worn.
+	ItemID string
+	// Team is the team affinity of the item; empty when it has none.
+	Team string
+}
+
+// validate reports a malformed reaction.
+func (r Reaction) validate() error {
+	known := false
+	for _, k := range ReactionEvents {
+		if r.On == k {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return fmt.Errorf("reaction on %q: must be one of %v", r.On, ReactionEvents)
+	}
+	if r.Hook == "" {
+		return fmt.Errorf("reaction on %q: hook must not be empty", r.On)
+	}
+	return nil
+}
+
+// Matches reports whether r fires for ev.
+func (r Reaction) Matches(ev RoomEvent) bool {
+	return r.On == ev.Kind && (r.Team == "" || r.Team == ev.Team)
+}
+
+// Observer is an NPC that reacts to a room event, with the reaction it has.
+type Observer struct {
+	Inst     *Instance
+	Reaction Reaction
+}
+
+// Observers returns the living NPCs in ev.RoomID that react to ev, each with
+// the first of its reactions that matches.
+//
+// Postcondition: Results are ordered by instance ID.
+func (m *Manager) Observers(ev RoomEvent) []Observer {
+	var out []Observer
+	for _, inst := range m.InstancesInRoom(ev.RoomID) {
+		if inst.IsDead() {
+			continue
+		}
+		for _, r := range inst.Reactions {
+			if r.Matches(ev) {
+				out = append(out, Observer{Inst: inst, Reaction: r})
+				break
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Inst.ID < out[j].Inst.ID })
+	return out
+}
@@ -0,0 +1,46 @@
+package npc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+func TestTemplateValidate_Reactions(t *testing.T) {
+	tmpl := &npc.Template{ID: "bouncer", Name: "Bouncer", Level: 1, MaxHP: 10, AC: 10,
+		Reactions: []npc.Reaction{{On: npc.EventDrawWeapon, Hook: "bouncer_warn"}}}
+	require.NoError(t, tmpl.Validate())
+
+	tmpl.Reactions = []npc.Reaction{{On: "sneeze", Hook: "bless_you"}}
+	assert.ErrorContains(t, tmpl.Validate(), `reaction on "sneeze"`)
+	tmpl.Reactions = []npc.Reaction{{On: npc.EventDropItem}}
+	assert.ErrorContains(t, tmpl.Validate(), "hook must not be empty")
+}
+
+func TestManager_Observers(t *testing.T) {
+	m := npc.NewManager()
+	gunner, err := m.Spawn(&npc.Template{ID: "gunner", Name: "Gunner", Level: 1, MaxHP: 10, AC: 10, Reactions: []npc.Reaction{
+		{On: npc.EventWearArmor, Team: "machete", Hook: "gunner_rival"},
+		{On: npc.EventWearArmor, Hook: "gunner_compliment"},
+	}}, "bar")
+	require.NoError(t, err)
+	_, err = m.Spawn(&npc.Template{ID: "drunk", Name: "Drunk", Level: 1, MaxHP: 10, AC: 10}, "bar")
+	require.NoError(t, err)
+	_, err = m.Spawn(&npc.Template{ID: "sweeper", Name: "Sweeper", Level: 1, MaxHP: 10, AC: 10,
+		Reactions: []npc.Reaction{{On: npc.EventDropItem, Hook: "sweeper_tut"}}}, "street")
+	require.NoError(t, err)
+
+	obs := m.Observers(npc.RoomEvent{Kind: npc.EventWearArmor, RoomID: "bar", Team: "machete"})
+	require.Len(t, obs, 1)
+	assert.Equal(t, gunner.ID, obs[0].Inst.ID)
+	assert.Equal(t, "gunner_rival", obs[0].Reaction.Hook, "the first matching reaction wins")
+
+	obs = m.Observers(npc.RoomEvent{Kind: npc.EventWearArmor, RoomID: "bar", Team: "gun"})
+	require.Len(t, obs, 1)
+	assert.Equal(t, "gunner_compliment", obs[0].Reaction.Hook)
+
+	assert.Empty(t, m.Observers(npc.RoomEvent{Kind: npc.EventDropItem, RoomID: "bar"}), "the sweeper is in another room")
+}
@@ -240,20 +240,64 @@ func (r *RespawnManager) Tick(now time.Time, mgr *Manager) {
 		if current >= cfg.Max {
 			continue
 		}
-		inst, _ := mgr.Spawn(tmpl, e.roomID)
-		if inst != nil && inst.HomeRoomID != "" {
-			if zoneID, ok := r.roomToZone[inst.HomeRoomID]; ok {
-				if rooms := r.zoneRooms[zoneID]; len(rooms) > 0 {
-					if dm, err := behavior.BFSDistanceMap(rooms, inst.HomeRoomID); err == nil {
-						inst.HomeRoomBFS = dm
-					}
-				}
+		r.place(tmpl, e.roomID, mgr)
+	}
+}
+
+// Repopulate cancels roomID's pending respawns and spawns each configured
+// template up to its cap. Unlike PopulateRoom it never removes live NPCs.
+//
+// Precondition: roomID must be non-empty; mgr must not be nil.
+// Postcondition: Returns the number of NPCs spawned.
+func (r *RespawnManager) Repopulate(roomID string, mgr *Manager) int {
+	r.mu.Lock()
+	remaining := r.pending[:0]
+	for _, e := range r.pending {
+		if e.roomID != roomID {
+			remaining = append(remaining, e)
+		}
+	}
+	r.pending = remaining
+	configs := append([]RoomSpawn(nil), r.spawns[roomID]...)
+	r.mu.Unlock()
+
+	spawned := 0
+	for _, cfg := range configs {
+		tmpl, ok := r.templates[cfg.TemplateID]
+		if !ok {
+			continue
+		}
+		for i := r.countInRoom(roomID, cfg.TemplateID, mgr); i < cfg.Max; i++ {
+			if r.place(tmpl, roomID, mgr) != nil {
+				spawned++
 			}
 		}
-		if inst != nil && r.AfterPlace != nil {
-			r.AfterPlace(inst, e.roomID)
+	}
+	return spawned
+}
+
+// place spawns tmpl in roomID, computes its home-room distance map, and runs
+// AfterPlace.
+//
+// Postcondition: Returns nil when the spawn failed.
+func (r *RespawnManager) place(tmpl *Template, roomID string, mgr *Manager) *Instance {
+	inst, _ := mgr.Spawn(tmpl, roomID)
+	if inst == nil {
+		return nil
+	}
+	if inst.HomeRoomID != "" {
+		if zoneID, ok := r.roomToZone[inst.HomeRoomID]; ok {
+			if rooms := r.zoneRooms[zoneID]; len(rooms) > 0 {
+				if dm, err := behavior.BFSDistanceMap(rooms, inst.HomeRoomID); err == nil {
+					inst.HomeRoomBFS = dm
+				}
+			}
 		}
 	}
+	if r.AfterPlace != nil {
+		r.AfterPlace(inst, roomID)
+	}
+	return inst
 }
 
 // ResolvedDelay returns the effective respawn delay for templateID in roomID:
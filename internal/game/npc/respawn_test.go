@@ -306,3 +306,43 @@ func TestRespawnManager_PendingCount_ReturnsZeroForUnknownRoom(t *testing.T) {
 	rm := npc.NewRespawnManager(nil, nil, nil, nil)
 	assert.Equal(t, 0, rm.PendingCount("nonexistent"))
 }
+
+// --- Repopulate ---
+
+func TestRespawnManager_Repopulate_FillsMissingAndClearsPending(t *testing.T) {
+	tmpl := makeTemplate("ganger", "5m")
+	mgr := npc.NewManager()
+	rm := makeRespawnManager("r1", "ganger", 3, "", tmpl)
+	_, err := mgr.Spawn(tmpl, "r1")
+	require.NoError(t, err)
+	rm.Schedule("ganger", "r1", time.Now(), time.Minute)
+
+	assert.Equal(t, 2, rm.Repopulate("r1", mgr))
+	assert.Len(t, mgr.InstancesInRoom("r1"), 3)
+	assert.Zero(t, rm.PendingCount("r1"))
+	assert.Zero(t, rm.Repopulate("r1", mgr), "a full room needs nothing")
+}
+
+func TestRespawnManager_Repopulate_KeepsExcess(t *testing.T) {
+	tmpl := makeTemplate("ganger", "5m")
+	mgr := npc.NewManager()
+	rm := makeRespawnManager("r1", "ganger", 1, "", tmpl)
+	for range 2 {
+		_, err := mgr.Spawn(tmpl, "r1")
+		require.NoError(t, err)
+	}
+
+	assert.Zero(t, rm.Repopulate("r1", mgr))
+	assert.Len(t, mgr.InstancesInRoom("r1"), 2, "a reset never removes live NPCs")
+}
+
+func TestRespawnManager_Repopulate_RunsAfterPlace(t *testing.T) {
+	tmpl := makeTemplate("ganger", "5m")
+	mgr := npc.NewManager()
+	rm := makeRespawnManager("r1", "ganger", 2, "", tmpl)
+	var placed []string
+	rm.AfterPlace = func(inst *npc.Instance, roomID string) { placed = append(placed, roomID) }
+
+	rm.Repopulate("r1", mgr)
+	assert.Equal(t, []string{"r1", "r1"}, placed)
+}
@@ -0,0 +1,64 @@
+package npc
+
+import "fmt"
+
+// MoraleAlonePct is how many percentage points an NPC's morale threshold
+// rises when it is the last of its side still fighting.
+const MoraleAlonePct = 25
+
+// SurrenderConfig lets an NPC give up when its morale breaks instead of
+// fighting to the death. A surrendered NPC stops fighting and waits for the
+// players to loot, interrogate, or release it.
+type SurrenderConfig struct {
+	// MoraleHPPct is the HP percentage below which the NPC's morale breaks.
+	MoraleHPPct int `yaml:"morale_hp_pct"`
+	// Info is what the NPC gives up under interrogation, one line each.
+	Info []string `yaml:"info,omitempty"`
+	// ReleaseRep is the reputation with the NPC's faction granted to a player
+	// who lets it go. Zero grants nothing.
+	ReleaseRep int `yaml:"release_rep,omitempty"`
+}
+
+// Validate checks the surrender config's invariants.
+//
+// Precondition: c must not be nil.
+// Postcondition: Returns nil iff MoraleHPPct is in [1, 100] and ReleaseRep >= 0.
+func (c *SurrenderConfig) Validate() error {
+	if c.MoraleHPPct < 1 || c.MoraleHPPct > 100 {
+		return fmt.Errorf("surrender: morale_hp_pct must be in [1, 100] (got %d)", c.MoraleHPPct)
+	}
+	if c.ReleaseRep < 0 {
+		return fmt.Errorf("surrender: release_rep must be >= 0 (got %d)", c.ReleaseRep)
+	}
+	return nil
+}
+
+// MoraleBroken reports whether the NPC gives up: it can surrender, is still
+// standing, and its HP has fallen below its morale threshold — raised by
+// MoraleAlonePct when alone is true.
+//
+// Precondition: i must not be nil.
+// Postcondition: Returns false when i.Surrender is nil, i has already
+// surrendered, or i is dead.
+func (i *Instance) MoraleBroken(alone bool) bool {
+	if i.Surrender == nil || i.Surrendered || i.IsDead() || i.MaxHP <= 0 {
+		return false
+	}
+	threshold := i.Surrender.MoraleHPPct
+	if alone {
+		threshold += MoraleAlonePct
+	}
+	return i.CurrentHP*100 < threshold*i.MaxHP
+}
+
+// Yield puts the NPC into its surrendered state: no longer hostile, no longer
+// holding a grudge or planning to flee.
+//
+// Precondition: i must not be nil.
+// Postcondition: i.Surrendered is true and i.Disposition is "neutral".
+func (i *Instance) Yield() {
+	i.Surrendered = true
+	i.Disposition = "neutral"
+	i.GrudgePlayerID = ""
+	i.PendingFlee = false
+}
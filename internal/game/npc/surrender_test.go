@@ -0,0 +1,68 @@
+package npc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func surrenderTemplate() *Template {
+	return &Template{
+		ID: "ganger", Name: "Ganger", Level: 2, MaxHP: 20, AC: 14,
+		Surrender: &SurrenderConfig{MoraleHPPct: 25, Info: []string{"The stash is under the overpass."}, ReleaseRep: 5},
+	}
+}
+
+func TestSurrenderConfig_Validate(t *testing.T) {
+	assert.NoError(t, (&SurrenderConfig{MoraleHPPct: 25}).Validate())
+	assert.Error(t, (&SurrenderConfig{MoraleHPPct: 0}).Validate(), "morale must break at some HP")
+	assert.Error(t, (&SurrenderConfig{MoraleHPPct: 101}).Validate())
+	assert.Error(t, (&SurrenderConfig{MoraleHPPct: 25, ReleaseRep: -1}).Validate())
+
+	tmpl := surrenderTemplate()
+	tmpl.Surrender.MoraleHPPct = 0
+	assert.Error(t, tmpl.Validate(), "the template validates its surrender block")
+}
+
+func TestMoraleBroken_ThresholdRisesWhenAlone(t *testing.T) {
+	inst := NewInstance("g1", surrenderTemplate(), "room")
+	require.NotNil(t, inst.Surrender, "surrender config is copied at spawn")
+	inst.CurrentHP = 8 // 40%
+	assert.False(t, inst.MoraleBroken(false))
+	assert.True(t, inst.MoraleBroken(true), "alone, morale breaks below 50%")
+	inst.CurrentHP = 4 // 20%
+	assert.True(t, inst.MoraleBroken(false))
+
+	inst.Yield()
+	assert.True(t, inst.Surrendered)
+	assert.Equal(t, "neutral", inst.Disposition)
+	assert.False(t, inst.MoraleBroken(true), "a surrendered NPC cannot surrender again")
+}
+
+func TestMoraleBroken_NeverWithoutSurrenderConfig(t *testing.T) {
+	tmpl := surrenderTemplate()
+	tmpl.Surrender = nil
+	inst := NewInstance("g1", tmpl, "room")
+	inst.CurrentHP = 1
+	assert.False(t, inst.MoraleBroken(true))
+}
+
+func TestProperty_MoraleBrokenMatchesThreshold(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		tmpl := surrenderTemplate()
+		tmpl.Surrender.MoraleHPPct = rapid.IntRange(1, 100).Draw(rt, "pct")
+		inst := NewInstance("g1", tmpl, "room")
+		inst.CurrentHP = rapid.IntRange(1, inst.MaxHP).Draw(rt, "hp")
+		alone := rapid.Bool().Draw(rt, "alone")
+		threshold := tmpl.Surrender.MoraleHPPct
+		if alone {
+			threshold += MoraleAlonePct
+		}
+		want := float64(inst.CurrentHP)/float64(inst.MaxHP)*100 < float64(threshold)
+		if got := inst.MoraleBroken(alone); got != want {
+			rt.Fatalf("MoraleBroken(%v) = %v at %d/%d HP, threshold %d%%", alone, got, inst.CurrentHP, inst.MaxHP, threshold)
+		}
+	})
+}
@@ -52,6 +52,9 @@ type RovingConfig struct {
 // Template defines a reusable NPC archetype loaded from YAML.
 type Template struct {
 	ID          string    `yaml:"id"`
+	// Base is the ID of the template this one inherits from; every field this
+	// template does not set comes from the base. Resolved by LoadTemplates.
+	Base        string    `yaml:"base,omitempty"`
 	Name        string    `yaml:"name"`
 	Description string    `yaml:"description"`
 	// Type is the NPC category used for predators_eye passive matching (e.g. "human", "robot", "mutant").
@@ -91,6 +94,8 @@ type Template struct {
 	Resistances map[string]int `yaml:"resistances"`
 	// Weaknesses maps damage type → flat damage addition applied on any hit.
 	Weaknesses map[string]int `yaml:"weaknesses"`
+	// Immunities lists damage types that deal no damage to this NPC.
+	Immunities []string `yaml:"immunities"`
 	// Weapon is a weighted random table of weapon IDs. Empty = unarmed.
 	Weapon []EquipmentEntry `yaml:"weapon"`
 	// Armor is a weighted random table of armor IDs. Empty = no armor.
@@ -148,6 +153,11 @@ type Template struct {
 	// Immobile prevents this NPC from patrolling or wandering.
 	Immobile bool `yaml:"immobile"`
 
+	// TrainingDummy makes this NPC a practice target: it never fights back,
+	// cannot be killed — at 0 HP it is beaten and resets to full HP — and
+	// grants no XP, loot, or kill credit.
+	TrainingDummy bool `yaml:"training_dummy,omitempty"`
+
 	// RovingConfig holds multi-zone route configuration for roving NPCs.
 	// Precondition: Route must be non-empty if Roving is non-nil.
 	// Roving, when non-nil, makes this NPC traverse a multi-zone route autonomously.
@@ -178,6 +188,27 @@ type Template struct {
 	// Empty means no taunts.
 	Taunts []string `yaml:"taunts,omitempty"`
 
+	// Barks is a list of idle flavor actions, written to follow the NPC's name
+	// (e.g. "spits into the gutter."). Outside combat one may be shown to the
+	// room on a zone tick. Empty means no barks.
+	Barks []string `yaml:"barks,omitempty"`
+
+	// Reactions lists the room events this NPC responds to and the Lua hooks
+	// that decide how. Empty means the NPC ignores what players do.
+	Reactions []Reaction `yaml:"reactions,omitempty"`
+
+	// Civilian marks a bystander: attacking one in a safe or sketchy zone is a
+	// crime that raises the attacker's wanted level there.
+	Civilian bool `yaml:"civilian,omitempty"`
+	// Pickpocket is what a thief can lift from this NPC with the steal
+	// command: currency and items, each item present with its own chance.
+	// Nil means the NPC has nothing worth stealing.
+	Pickpocket *LootTable `yaml:"pickpocket,omitempty"`
+	// Surrender, when set, lets this NPC give up once its morale breaks; the
+	// players may then loot, interrogate, or release it. Nil means it fights
+	// to the death.
+	Surrender *SurrenderConfig `yaml:"surrender,omitempty"`
+
 	// OnHitEffect is the item effect ID applied to the target on a successful hit (e.g. "faygo_splash").
 	// Empty string means no effect is applied.
 	OnHitEffect string `yaml:"on_hit_effect,omitempty"`
@@ -213,6 +244,7 @@ type Template struct {
 	QuestGiver *QuestGiverConfig `yaml:"quest_giver,omitempty"`
 	Hireling   *HirelingConfig   `yaml:"hireling,omitempty"`
 	Banker     *BankerConfig     `yaml:"banker,omitempty"`
+	Broker     *BrokerConfig     `yaml:"broker,omitempty"`
 	JobTrainer  *JobTrainerConfig  `yaml:"job_trainer,omitempty"`
 	TechTrainer *TechTrainerConfig `yaml:"tech_trainer,omitempty"`
 	Crafter     *CrafterConfig     `yaml:"crafter,omitempty"`
@@ -281,6 +313,17 @@ func (t *Template) Validate() error {
 		}
 	}
 
+	if t.Pickpocket != nil {
+		if err := t.Pickpocket.Validate(); err != nil {
+			return fmt.Errorf("npc template %q: pickpocket: %w", t.ID, err)
+		}
+	}
+	if t.Surrender != nil {
+		if err := t.Surrender.Validate(); err != nil {
+			return fmt.Errorf("npc template %q: %w", t.ID, err)
+		}
+	}
+
 	// REQ-NPC-1: default NPCType to "combat".
 	if t.NPCType == "" {
 		t.NPCType = "combat"
@@ -314,6 +357,9 @@ func (t *Template) Validate() error {
 		if err := t.Merchant.ReplenishRate.Validate(); err != nil {
 			return fmt.Errorf("npc template %q: %w", t.ID, err)
 		}
+		if err := t.Merchant.Validate(); err != nil {
+			return fmt.Errorf("npc template %q: %w", t.ID, err)
+		}
 	case "guard":
 		if t.Guard == nil {
 			return fmt.Errorf("npc template %q: npc_type 'guard' requires a guard: config block", t.ID)
@@ -393,6 +439,12 @@ func (t *Template) Validate() error {
 		t.Personality = "cowardly" // normalise empty → cowardly
 	}
 
+	if t.Broker != nil {
+		if err := t.Broker.Validate(); err != nil {
+			return fmt.Errorf("npc template %q: %w", t.ID, err)
+		}
+	}
+
 	if t.Roving != nil {
 		if len(t.Roving.Route) == 0 {
 			return fmt.Errorf("npc template %q: roving.route must not be empty", t.ID)
@@ -409,6 +461,11 @@ func (t *Template) Validate() error {
 			}
 		}
 	}
+	for _, r := range t.Reactions {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("npc template %q: %w", t.ID, err)
+		}
+	}
 
 	return nil
 }
@@ -469,54 +526,56 @@ func LoadTemplateFromBytes(data []byte) (*Template, error) {
 	if err := yaml.Unmarshal(data, &tmpl); err != nil {
 		return nil, fmt.Errorf("parsing template YAML: %w", err)
 	}
+	if tmpl.Base != "" {
+		return nil, fmt.Errorf("npc template %q: base %q can only be resolved by LoadTemplates", tmpl.ID, tmpl.Base)
+	}
 	if err := tmpl.Validate(); err != nil {
 		return nil, err
 	}
 	return &tmpl, nil
 }
 
-// LoadTemplates reads all *.yaml files in dir and returns the parsed templates.
+// loadTemplatesFromBytes parses YAML that may be a single template (map) or a
+// list of templates (sequence). Both formats are supported. A template's base
+// must be defined in the same YAML.
+func loadTemplatesFromBytes(path string, data []byte) ([]*Template, error) {
+	docs, err := parseTemplateDocs(path, data)
+	if err != nil {
+		return nil, err
+	}
+	return resolveTemplates(docs)
+}
+
+// LoadTemplates reads all *.yaml files in dir and its subdirectories and
+// returns the parsed templates. A template naming a base inherits every field
+// it does not set from that template, which may live in any file under dir.
 //
 // Precondition: dir must be a readable directory.
 // Postcondition: Returns all templates or an error on the first parse or validate
 // failure; on error, the partial result is discarded.
-// loadTemplatesFromBytes parses YAML that may be a single template (map) or a
-// list of templates (sequence). Both formats are supported.
-func loadTemplatesFromBytes(path string, data []byte) ([]*Template, error) {
-	// Detect list vs single by attempting list unmarshal first.
-	var list []Template
-	if err := yaml.Unmarshal(data, &list); err == nil && len(list) > 0 {
-		out := make([]*Template, 0, len(list))
-		for i := range list {
-			if err := list[i].Validate(); err != nil {
-				return nil, fmt.Errorf("validating template %d in %q: %w", i, path, err)
-			}
-			out = append(out, &list[i])
-		}
-		return out, nil
-	}
-	// Fall back to single-template format.
-	tmpl, err := LoadTemplateFromBytes(data)
+func LoadTemplates(dir string) ([]*Template, error) {
+	docs, err := readTemplateDocs(dir)
 	if err != nil {
-		return nil, fmt.Errorf("loading %q: %w", path, err)
+		return nil, err
 	}
-	return []*Template{tmpl}, nil
+	return resolveTemplates(docs)
 }
 
-func LoadTemplates(dir string) ([]*Template, error) {
+// readTemplateDocs reads the template documents in every *.yaml file under dir.
+func readTemplateDocs(dir string) ([]templateDoc, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, fmt.Errorf("reading npc dir %q: %w", dir, err)
 	}
 
-	var templates []*Template
+	var docs []templateDoc
 	for _, entry := range entries {
 		if entry.IsDir() {
-			sub, err := LoadTemplates(filepath.Join(dir, entry.Name()))
+			sub, err := readTemplateDocs(filepath.Join(dir, entry.Name()))
 			if err != nil {
 				return nil, err
 			}
-			templates = append(templates, sub...)
+			docs = append(docs, sub...)
 			continue
 		}
 		if !strings.HasSuffix(entry.Name(), ".yaml") {
@@ -529,13 +588,13 @@ func LoadTemplates(dir string) ([]*Template, error) {
 			return nil, fmt.Errorf("reading %q: %w", path, err)
 		}
 
-		loaded, err := loadTemplatesFromBytes(path, data)
+		parsed, err := parseTemplateDocs(path, data)
 		if err != nil {
 			return nil, err
 		}
-		templates = append(templates, loaded...)
+		docs = append(docs, parsed...)
 	}
-	return templates, nil
+	return docs, nil
 }
 
 // parseTravelInterval parses a duration string for roving NPC travel interval.
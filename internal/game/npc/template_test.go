@@ -108,6 +108,88 @@ loot:
 }
 
 
+func TestTemplate_Pickpocket_ParsesFromYAML(t *testing.T) {
+	data := []byte(`
+id: commuter
+name: Commuter
+description: Late for a shift.
+level: 1
+max_hp: 10
+ac: 10
+pickpocket:
+  currency:
+    min: 3
+    max: 12
+  items:
+    - item: bus_pass
+      chance: 0.6
+      min_qty: 1
+      max_qty: 1
+`)
+	tmpl, err := npc.LoadTemplateFromBytes(data)
+	require.NoError(t, err)
+	require.NotNil(t, tmpl.Pickpocket)
+	assert.Equal(t, 12, tmpl.Pickpocket.Currency.Max)
+	require.Len(t, tmpl.Pickpocket.Items, 1)
+	assert.Equal(t, "bus_pass", tmpl.Pickpocket.Items[0].ItemID)
+
+	inst := npc.NewInstance("c-1", tmpl, "room")
+	assert.Same(t, tmpl.Pickpocket, inst.Pickpocket)
+}
+
+func TestTemplate_Pickpocket_InvalidRejected(t *testing.T) {
+	data := []byte(`
+id: commuter
+name: Commuter
+description: Late for a shift.
+level: 1
+max_hp: 10
+ac: 10
+pickpocket:
+  items:
+    - item: bus_pass
+      chance: 1.5
+      min_qty: 1
+      max_qty: 1
+`)
+	_, err := npc.LoadTemplateFromBytes(data)
+	assert.ErrorContains(t, err, "pickpocket")
+}
+
+func TestTemplate_Broker_ParsesFromYAML(t *testing.T) {
+	data := []byte(`
+id: consignor
+name: Consignor
+description: Takes a cut of everything.
+npc_type: broker
+level: 1
+max_hp: 10
+ac: 10
+broker:
+  fee_rate: 0.08
+`)
+	tmpl, err := npc.LoadTemplateFromBytes(data)
+	require.NoError(t, err)
+	require.NotNil(t, tmpl.Broker)
+	assert.Equal(t, 0.08, tmpl.Broker.FeeRate)
+}
+
+func TestTemplate_Broker_InvalidFeeRejected(t *testing.T) {
+	data := []byte(`
+id: consignor
+name: Consignor
+description: Takes a cut of everything.
+npc_type: broker
+level: 1
+max_hp: 10
+ac: 10
+broker:
+  fee_rate: 1.5
+`)
+	_, err := npc.LoadTemplateFromBytes(data)
+	assert.ErrorContains(t, err, "fee_rate")
+}
+
 func TestProperty_Template_InvalidRespawnDelay_ReturnsError(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
 		// Generate invalid duration strings (words that are not valid Go durations)
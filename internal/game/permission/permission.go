@@ -0,0 +1,194 @@
+// Package permission maps account roles to the capabilities they grant.
+//
+// Privileged commands require a named capability (e.g. "can_teleport")
+// rather than a specific role, so operators can reshape roles in YAML
+// without code changes. The default matrix ships embedded in the binary and is
+// the only copy in the tree; an operator may replace it by adding
+// content/permissions.yaml.
+package permission
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Capability names a privileged operation.
+type Capability string
+
+// Capabilities checked by the game server.
+const (
+	CanSetRole              Capability = "can_set_role"
+	CanTeleport             Capability = "can_teleport"
+	CanSummonItems          Capability = "can_summon_items"
+	CanGrant                Capability = "can_grant"
+	CanSpawnNPCs            Capability = "can_spawn_npcs"
+	CanEditWorld            Capability = "can_edit_world"
+	CanManageTestCharacters Capability = "can_manage_test_characters"
+	CanModerateChat         Capability = "can_moderate_chat"
+	CanViewAuditLog         Capability = "can_view_audit_log"
+	CanBypassChatFilter     Capability = "can_bypass_chat_filter"
+	CanRenameCharacters     Capability = "can_rename_characters"
+	CanSetCharacterSlots    Capability = "can_set_character_slots"
+	CanManageSiteBans       Capability = "can_manage_site_bans"
+	CanReviewReports        Capability = "can_review_reports"
+	CanGoInvisible          Capability = "can_go_invisible"
+	CanSnoop                Capability = "can_snoop"
+	CanForce                Capability = "can_force"
+	CanPuppet               Capability = "can_puppet"
+	CanRunEvents            Capability = "can_run_events"
+	CanManageSchedule       Capability = "can_manage_schedule"
+	CanReloadConfig         Capability = "can_reload_config"
+	CanManageFlags          Capability = "can_manage_flags"
+	CanTransferCharacters   Capability = "can_transfer_characters"
+	CanExportCharacters     Capability = "can_export_characters"
+)
+
+// wildcard grants every capability.
+const wildcard = "*"
+
+// known lists every valid capability for configuration validation.
+var known = map[Capability]bool{
+	CanSetRole:              true,
+	CanTeleport:             true,
+	CanSummonItems:          true,
+	CanGrant:                true,
+	CanSpawnNPCs:            true,
+	CanEditWorld:            true,
+	CanManageTestCharacters: true,
+	CanModerateChat:         true,
+	CanViewAuditLog:         true,
+	CanBypassChatFilter:     true,
+	CanRenameCharacters:     true,
+	CanSetCharacterSlots:    true,
+	CanManageSiteBans:       true,
+	CanReviewReports:        true,
+	CanGoInvisible:          true,
+	CanSnoop:                true,
+	CanForce:                true,
+	CanPuppet:               true,
+	CanRunEvents:            true,
+	CanManageSchedule:       true,
+	CanReloadConfig:         true,
+	CanManageFlags:          true,
+	CanTransferCharacters:   true,
+	CanExportCharacters:     true,
+}
+
+//go:embed default.yaml
+var defaultMatrix []byte
+
+// Matrix holds the capabilities granted to each role.
+//
+// A Matrix is immutable after construction and safe for concurrent use.
+type Matrix struct {
+	roles map[string]map[Capability]bool
+	all   map[string]bool
+}
+
+var (
+	defaultOnce   sync.Once
+	defaultParsed *Matrix
+)
+
+// Default returns the embedded default matrix, parsed once and shared.
+//
+// Postcondition: Returns a non-nil matrix; panics only if the embedded file is malformed.
+func Default() *Matrix {
+	defaultOnce.Do(func() {
+		m, err := parse(defaultMatrix)
+		if err != nil {
+			panic(fmt.Sprintf("permission: embedded matrix: %v", err))
+		}
+		defaultParsed = m
+	})
+	return defaultParsed
+}
+
+// LoadMatrixOrDefault returns the matrix at path, or Default() when no file
+// exists there.
+//
+// Postcondition: Returns a non-nil matrix, or a non-nil error when a file at
+// path is unreadable or invalid.
+func LoadMatrixOrDefault(path string) (*Matrix, error) {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return Default(), nil
+	}
+	return LoadMatrix(path)
+}
+
+// LoadMatrix reads a role → capabilities matrix from the YAML file at path.
+//
+// Precondition: path must name a readable YAML file with a "roles" mapping.
+// Postcondition: Returns a non-nil matrix, or a non-nil error when the file is
+// unreadable or names an unknown capability.
+func LoadMatrix(path string) (*Matrix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading permissions %q: %w", path, err)
+	}
+	m, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing permissions %q: %w", path, err)
+	}
+	return m, nil
+}
+
+// parse builds a Matrix from YAML bytes.
+func parse(data []byte) (*Matrix, error) {
+	var doc struct {
+		Roles map[string][]string `yaml:"roles"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	m := &Matrix{roles: make(map[string]map[Capability]bool), all: make(map[string]bool)}
+	for role, caps := range doc.Roles {
+		granted := make(map[Capability]bool, len(caps))
+		for _, c := range caps {
+			if c == wildcard {
+				m.all[role] = true
+				continue
+			}
+			if !known[Capability(c)] {
+				return nil, fmt.Errorf("role %q: unknown capability %q", role, c)
+			}
+			granted[Capability(c)] = true
+		}
+		m.roles[role] = granted
+	}
+	return m, nil
+}
+
+// Allows reports whether role grants c.
+//
+// Postcondition: A nil matrix behaves as Default(); unknown roles grant nothing.
+func (m *Matrix) Allows(role string, c Capability) bool {
+	if m == nil {
+		m = Default()
+	}
+	return m.all[role] || m.roles[role][c]
+}
+
+// Capabilities returns the sorted capabilities granted to role.
+//
+// Postcondition: A wildcard role reports every known capability.
+func (m *Matrix) Capabilities(role string) []Capability {
+	if m == nil {
+		m = Default()
+	}
+	var out []Capability
+	for c := range known {
+		if m.Allows(role, c) {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
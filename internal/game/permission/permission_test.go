@@ -0,0 +1,102 @@
+package permission
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestDefault_MatchesHistoricalRoles(t *testing.T) {
+	m := Default()
+	assert.False(t, m.Allows("player", CanEditWorld))
+	assert.True(t, m.Allows("editor", CanEditWorld))
+	assert.True(t, m.Allows("editor", CanGrant))
+	assert.False(t, m.Allows("editor", CanTeleport))
+	assert.False(t, m.Allows("editor", CanSetRole))
+	assert.True(t, m.Allows("moderator", CanModerateChat))
+	assert.False(t, m.Allows("moderator", CanEditWorld))
+	assert.True(t, m.Allows("moderator", CanReviewReports))
+	assert.False(t, m.Allows("player", CanReviewReports))
+	assert.True(t, m.Allows("moderator", CanSnoop))
+	assert.False(t, m.Allows("editor", CanSnoop))
+	assert.False(t, m.Allows("player", CanGoInvisible))
+	assert.True(t, m.Allows("editor", CanPuppet))
+	assert.True(t, m.Allows("editor", CanRunEvents))
+	assert.False(t, m.Allows("moderator", CanRunEvents))
+	assert.False(t, m.Allows("editor", CanManageSchedule))
+	assert.True(t, m.Allows("admin", CanManageSchedule))
+	assert.False(t, m.Allows("moderator", CanForce))
+	assert.True(t, m.Allows("admin", CanForce))
+	assert.True(t, m.Allows("admin", CanSetRole))
+	assert.True(t, m.Allows("admin", CanViewAuditLog))
+}
+
+func TestNilMatrix_UsesDefault(t *testing.T) {
+	var m *Matrix
+	assert.True(t, m.Allows("admin", CanTeleport))
+	assert.False(t, m.Allows("player", CanTeleport))
+}
+
+func TestLoadMatrix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "permissions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`roles:
+  helper: [can_teleport, can_moderate_chat]
+  admin: ["*"]
+`), 0o644))
+	m, err := LoadMatrix(path)
+	require.NoError(t, err)
+	assert.True(t, m.Allows("helper", CanTeleport))
+	assert.False(t, m.Allows("helper", CanSetRole))
+	assert.False(t, m.Allows("editor", CanEditWorld), "roles absent from the file grant nothing")
+	assert.Equal(t, []Capability{CanModerateChat, CanTeleport}, m.Capabilities("helper"))
+}
+
+func TestLoadMatrix_RejectsUnknownCapability(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "permissions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("roles:\n  admin: [can_fly]\n"), 0o644))
+	_, err := LoadMatrix(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can_fly")
+
+	_, err = LoadMatrix(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+// TestProperty_WildcardGrantsEverything verifies a "*" role allows every known capability.
+func TestProperty_WildcardGrantsEverything(t *testing.T) {
+	m := Default()
+	all := make([]Capability, 0, len(known))
+	for c := range known {
+		all = append(all, c)
+	}
+	rapid.Check(t, func(rt *rapid.T) {
+		c := rapid.SampledFrom(all).Draw(rt, "capability")
+		if !m.Allows("admin", c) {
+			rt.Fatalf("admin denied %s", c)
+		}
+	})
+}
+
+func TestDefault_IsParsedOnce(t *testing.T) {
+	assert.Same(t, Default(), Default())
+}
+
+func TestLoadMatrixOrDefault(t *testing.T) {
+	m, err := LoadMatrixOrDefault(filepath.Join(t.TempDir(), "permissions.yaml"))
+	require.NoError(t, err)
+	assert.Same(t, Default(), m, "a missing override uses the embedded matrix")
+
+	path := filepath.Join(t.TempDir(), "permissions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("roles:\n  helper: [can_teleport]\n"), 0o644))
+	m, err = LoadMatrixOrDefault(path)
+	require.NoError(t, err)
+	assert.True(t, m.Allows("helper", CanTeleport))
+
+	require.NoError(t, os.WriteFile(path, []byte("roles:\n  admin: [can_fly]\n"), 0o644))
+	_, err = LoadMatrixOrDefault(path)
+	assert.Error(t, err, "an invalid override is reported, not ignored")
+}
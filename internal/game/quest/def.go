@@ -7,6 +7,7 @@ import (
 
 var validObjectiveTypes = map[string]bool{
 	"kill": true, "fetch": true, "explore": true, "deliver": true, "use_zone_map": true,
+	"persuade": true, "capture": true,
 }
 
 type QuestRewardItem struct {
@@ -74,6 +74,14 @@ func (r QuestRegistry) CrossValidate(npcIDs, itemIDs, roomIDs map[string]bool) e
 				if !roomIDs[obj.TargetID] {
 					return fmt.Errorf("quest %q objective %q: explore TargetID %q not in world rooms", def.ID, obj.ID, obj.TargetID)
 				}
+			case "persuade":
+				if !npcIDs[obj.TargetID] {
+					return fmt.Errorf("quest %q objective %q: persuade TargetID %q not in NPC registry", def.ID, obj.ID, obj.TargetID)
+				}
+			case "capture":
+				if !npcIDs[obj.TargetID] {
+					return fmt.Errorf("quest %q objective %q: capture TargetID %q not in NPC registry", def.ID, obj.ID, obj.TargetID)
+				}
 			case "deliver":
 				if !npcIDs[obj.TargetID] {
 					return fmt.Errorf("quest %q objective %q: deliver TargetID %q not in NPC registry", def.ID, obj.ID, obj.TargetID)
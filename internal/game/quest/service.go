@@ -308,6 +308,71 @@ func (s *Service) RecordZoneMapUse(ctx context.Context, sess SessionState, chara
 	})
 }
 
+// RecordPersuade increments progress for all active persuade objectives
+// matching npcTemplateID — won over in a social encounter.
+//
+// Precondition: sess non-nil; npcTemplateID non-empty.
+// Postcondition: matching objectives are incremented (clamped at Quantity); maybeComplete called.
+// Returns completion messages if a quest completed, or nil if none completed.
+func (s *Service) RecordPersuade(ctx context.Context, sess SessionState, characterID int64, npcTemplateID string) ([]string, error) {
+	return s.recordProgress(ctx, sess, characterID, func(obj QuestObjective) bool {
+		return obj.Type == "persuade" && obj.TargetID == npcTemplateID
+	})
+}
+
+// RecordCapture counts one prisoner of npcTemplateID handed over for quest
+// questID against the quest's first unfinished matching capture objective.
+//
+// Precondition: sess non-nil; questID and npcTemplateID non-empty.
+// Postcondition: at most one objective is incremented; maybeComplete called when one was.
+// Returns completion messages if the quest completed, or nil if it did not.
+func (s *Service) RecordCapture(ctx context.Context, sess SessionState, characterID int64, questID, npcTemplateID string) ([]string, error) {
+	aq, ok := sess.GetActiveQuests()[questID]
+	if !ok {
+		return nil, fmt.Errorf("quest %q is not active", questID)
+	}
+	def, ok := s.registry[questID]
+	if !ok {
+		return nil, fmt.Errorf("quest %q not found in registry", questID)
+	}
+	changed := false
+	for _, obj := range def.Objectives {
+		if obj.Type != "capture" || obj.TargetID != npcTemplateID || aq.ObjectiveProgress[obj.ID] >= obj.Quantity {
+			continue
+		}
+		next := aq.ObjectiveProgress[obj.ID] + 1
+		aq.ObjectiveProgress[obj.ID] = next
+		changed = true
+		if err := s.repo.SaveObjectiveProgress(ctx, characterID, questID, obj.ID, next); err != nil {
+			return nil, fmt.Errorf("saving objective progress: %w", err)
+		}
+		break
+	}
+	if !changed {
+		return nil, nil
+	}
+	return s.maybeComplete(ctx, sess, characterID, questID)
+}
+
+// WantsPersuaded reports whether one of sess's active quests has an
+// unfinished persuade objective targeting npcTemplateID.
+//
+// Precondition: sess non-nil.
+func (s *Service) WantsPersuaded(sess SessionState, npcTemplateID string) bool {
+	for questID, aq := range sess.GetActiveQuests() {
+		def, ok := s.registry[questID]
+		if !ok {
+			continue
+		}
+		for _, obj := range def.Objectives {
+			if obj.Type == "persuade" && obj.TargetID == npcTemplateID && aq.ObjectiveProgress[obj.ID] < obj.Quantity {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // RecordDeliver marks a specific deliver objective as fully complete.
 //
 // Precondition: sess non-nil; questID and objectiveID non-empty.
@@ -513,15 +578,7 @@ func CompletionMessage(def *QuestDef, invRegistry *inventory.Registry) []string
 // backpackToInventoryItems converts a Backpack's contents to a slice of InventoryItem for persistence.
 //
 // Precondition: bp must be non-nil.
-// Postcondition: returned slice has one entry per ItemInstance in the backpack.
+// Postcondition: returned slice is the backpack's persisted form; see Backpack.InventoryItems.
 func backpackToInventoryItems(bp *inventory.Backpack) []inventory.InventoryItem {
-	instances := bp.Items()
-	out := make([]inventory.InventoryItem, len(instances))
-	for i, inst := range instances {
-		out[i] = inventory.InventoryItem{
-			ItemDefID: inst.ItemDefID,
-			Quantity:  inst.Quantity,
-		}
-	}
-	return out
+	return bp.InventoryItems()
 }
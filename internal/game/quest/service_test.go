@@ -426,6 +426,83 @@ func TestRecordZoneMapUse_WrongZone(t *testing.T) {
 	}
 }
 
+// TestRecordPersuade_CompletesObjective verifies WantsPersuaded tracks an
+// unfinished persuade objective and RecordPersuade completes it.
+func TestRecordPersuade_CompletesObjective(t *testing.T) {
+	def := &quest.QuestDef{
+		ID:         "talk_down_ganger",
+		Title:      "Talk Him Down",
+		GiverNPCID: "fixer",
+		Objectives: []quest.QuestObjective{
+			{ID: "persuade_obj", Type: "persuade", Description: "Win over the ganger", TargetID: "ganger", Quantity: 1},
+		},
+		Rewards: quest.QuestRewards{XP: 50},
+	}
+	svc := quest.NewService(quest.QuestRegistry{"talk_down_ganger": def}, newFakeRepo(), nil, nil, nil)
+	sess := newFakeSession()
+	sess.activeQuests["talk_down_ganger"] = &quest.ActiveQuest{
+		QuestID:           "talk_down_ganger",
+		ObjectiveProgress: map[string]int{"persuade_obj": 0},
+	}
+
+	if !svc.WantsPersuaded(sess, "ganger") {
+		t.Fatal("the ganger should be a persuade target")
+	}
+	if svc.WantsPersuaded(sess, "scavenger") {
+		t.Fatal("the scavenger is no persuade target")
+	}
+	msgs, err := svc.RecordPersuade(context.Background(), sess, 42, "ganger")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(msgs) == 0 {
+		t.Fatal("expected completion messages, got none")
+	}
+	if svc.WantsPersuaded(sess, "ganger") {
+		t.Fatal("a completed quest no longer wants the ganger persuaded")
+	}
+}
+
+// TestRecordCapture_CountsOnePrisonerPerCall verifies each handed-over
+// prisoner advances one capture objective and the last one completes the quest.
+func TestRecordCapture_CountsOnePrisonerPerCall(t *testing.T) {
+	def := &quest.QuestDef{
+		ID:         "bring_them_in",
+		Title:      "Bring Them In",
+		GiverNPCID: "fixer",
+		Objectives: []quest.QuestObjective{
+			{ID: "capture_obj", Type: "capture", Description: "Capture 2 gangers", TargetID: "ganger", Quantity: 2},
+		},
+	}
+	svc := quest.NewService(quest.QuestRegistry{"bring_them_in": def}, newFakeRepo(), nil, nil, nil)
+	sess := newFakeSession()
+	sess.activeQuests["bring_them_in"] = &quest.ActiveQuest{
+		QuestID:           "bring_them_in",
+		ObjectiveProgress: map[string]int{"capture_obj": 0},
+	}
+
+	msgs, err := svc.RecordCapture(context.Background(), sess, 42, "bring_them_in", "scavenger")
+	if err != nil || len(msgs) != 0 || sess.activeQuests["bring_them_in"].ObjectiveProgress["capture_obj"] != 0 {
+		t.Fatalf("a scavenger does not count: msgs=%v err=%v", msgs, err)
+	}
+	if _, err := svc.RecordCapture(context.Background(), sess, 42, "bring_them_in", "ganger"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := sess.activeQuests["bring_them_in"].ObjectiveProgress["capture_obj"]; got != 1 {
+		t.Fatalf("expected progress 1, got %d", got)
+	}
+	msgs, err = svc.RecordCapture(context.Background(), sess, 42, "bring_them_in", "ganger")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(msgs) == 0 {
+		t.Fatal("expected completion messages, got none")
+	}
+	if _, err := svc.RecordCapture(context.Background(), sess, 42, "bring_them_in", "ganger"); err == nil {
+		t.Fatal("a completed quest is no longer active")
+	}
+}
+
 // REQ-QC-2: RecordKillWithResults MUST return empty results when no quest completes.
 func TestRecordKillWithResults_EmptyWhenNoCompletion(t *testing.T) {
 	def := &quest.QuestDef{
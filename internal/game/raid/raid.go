@@ -0,0 +1,117 @@
+// Package raid defines assaults on faction strongholds: waves of NPCs that
+// spawn in a stronghold room and must be repelled by its defenders, loaded
+// from content and started by scheduled events.
+package raid
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultWaveTimeLimit is how long defenders have to clear a wave when a raid
+// does not set its own limit.
+const DefaultWaveTimeLimit = 10 * time.Minute
+
+// Spawn is Count NPCs of one template in a wave.
+type Spawn struct {
+	Template string `yaml:"template"`
+	Count    int    `yaml:"count"`
+}
+
+// Wave is one group of attackers. Message, when set, is sent to players in
+// the raid's zone as the wave arrives.
+type Wave struct {
+	NPCs    []Spawn `yaml:"npcs"`
+	Message string  `yaml:"message"`
+}
+
+// Def is one raid loaded from content. Its waves arrive one at a time in Room;
+// each wave the defenders clear before TimeLimit earns every defender in the
+// room RepPerWave reputation with Faction and CreditsPerWave credits.
+type Def struct {
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	// Faction is the faction whose stronghold is attacked. Players hostile to
+	// it are not defenders.
+	Faction string `yaml:"faction"`
+	// Room is the stronghold room the waves spawn in.
+	Room           string        `yaml:"room"`
+	Waves          []Wave        `yaml:"waves"`
+	TimeLimit      time.Duration `yaml:"time_limit"`
+	RepPerWave     int           `yaml:"rep_per_wave"`
+	CreditsPerWave int           `yaml:"credits_per_wave"`
+	// Victory and Defeat are sent to players in the raid's zone when the last
+	// wave is repelled or a wave outlasts its time limit.
+	Victory string `yaml:"victory"`
+	Defeat  string `yaml:"defeat"`
+}
+
+// WaveTimeLimit returns how long defenders have to clear each wave.
+//
+// Postcondition: Returns a positive duration.
+func (d *Def) WaveTimeLimit() time.Duration {
+	if d.TimeLimit > 0 {
+		return d.TimeLimit
+	}
+	return DefaultWaveTimeLimit
+}
+
+// Validate checks the raid is complete enough to run.
+//
+// Postcondition: Returns a non-nil error describing the first problem found.
+func (d *Def) Validate() error {
+	if d.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if d.Faction == "" || d.Room == "" {
+		return fmt.Errorf("raid %q: faction and room are required", d.ID)
+	}
+	if len(d.Waves) == 0 {
+		return fmt.Errorf("raid %q: at least one wave is required", d.ID)
+	}
+	for i, w := range d.Waves {
+		if len(w.NPCs) == 0 {
+			return fmt.Errorf("raid %q: wave %d has no npcs", d.ID, i+1)
+		}
+		for _, sp := range w.NPCs {
+			if sp.Template == "" || sp.Count < 1 {
+				return fmt.Errorf("raid %q: wave %d needs a template and a positive count for each npc", d.ID, i+1)
+			}
+		}
+	}
+	if d.TimeLimit < 0 || d.RepPerWave < 0 || d.CreditsPerWave < 0 {
+		return fmt.Errorf("raid %q: time_limit, rep_per_wave, and credits_per_wave must not be negative", d.ID)
+	}
+	return nil
+}
+
+// Load reads and validates the raids in path.
+//
+// Postcondition: Returns raids in file order, or an error naming the file
+// and the first bad or duplicate raid.
+func Load(path string) ([]*Def, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var file struct {
+		Raids []*Def `yaml:"raids"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing raid file %s: %w", path, err)
+	}
+	seen := make(map[string]bool, len(file.Raids))
+	for i, d := range file.Raids {
+		if err := d.Validate(); err != nil {
+			return nil, fmt.Errorf("raid file %s: raid %d: %w", path, i+1, err)
+		}
+		if seen[d.ID] {
+			return nil, fmt.Errorf("raid file %s: duplicate raid %q", path, d.ID)
+		}
+		seen[d.ID] = true
+	}
+	return file.Raids, nil
+}
@@ -0,0 +1,82 @@
+package raid
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRaids(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "raids.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	defs, err := Load(writeRaids(t, `
+raids:
+  - id: siege
+    name: The Siege
+    faction: gun
+    room: fort
+    time_limit: 5m
+    rep_per_wave: 10
+    credits_per_wave: 25
+    waves:
+      - message: Here they come!
+        npcs:
+          - template: ganger
+            count: 2
+      - npcs:
+          - template: boss
+            count: 1
+`))
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	d := defs[0]
+	assert.Equal(t, "siege", d.ID)
+	assert.Equal(t, 5*time.Minute, d.WaveTimeLimit())
+	require.Len(t, d.Waves, 2)
+	assert.Equal(t, Spawn{Template: "ganger", Count: 2}, d.Waves[0].NPCs[0])
+}
+
+func TestLoad_RepoRaidsAreValid(t *testing.T) {
+	_, err := Load("../../../content/raids.yaml")
+	require.NoError(t, err)
+}
+
+func TestLoad_RejectsDuplicates(t *testing.T) {
+	_, err := Load(writeRaids(t, `
+raids:
+  - {id: a, faction: gun, room: r, waves: [{npcs: [{template: t, count: 1}]}]}
+  - {id: a, faction: gun, room: r, waves: [{npcs: [{template: t, count: 1}]}]}
+`))
+	assert.ErrorContains(t, err, "duplicate")
+}
+
+func TestWaveTimeLimit_Default(t *testing.T) {
+	assert.Equal(t, DefaultWaveTimeLimit, (&Def{}).WaveTimeLimit())
+}
+
+func TestValidate_Rejects(t *testing.T) {
+	wave := []Wave{{NPCs: []Spawn{{Template: "t", Count: 1}}}}
+	cases := map[string]Def{
+		"no id":          {Faction: "gun", Room: "r", Waves: wave},
+		"no faction":     {ID: "a", Room: "r", Waves: wave},
+		"no room":        {ID: "a", Faction: "gun", Waves: wave},
+		"no waves":       {ID: "a", Faction: "gun", Room: "r"},
+		"empty wave":     {ID: "a", Faction: "gun", Room: "r", Waves: []Wave{{}}},
+		"zero count":     {ID: "a", Faction: "gun", Room: "r", Waves: []Wave{{NPCs: []Spawn{{Template: "t"}}}}},
+		"no template":    {ID: "a", Faction: "gun", Room: "r", Waves: []Wave{{NPCs: []Spawn{{Count: 1}}}}},
+		"negative rep":   {ID: "a", Faction: "gun", Room: "r", Waves: wave, RepPerWave: -1},
+		"negative limit": {ID: "a", Faction: "gun", Room: "r", Waves: wave, TimeLimit: -time.Second},
+	}
+	for name, d := range cases {
+		assert.Error(t, d.Validate(), name)
+	}
+}
@@ -22,6 +22,8 @@ type Archetype struct {
 	LevelUpGrants      map[int]*TechnologyGrants `yaml:"level_up_grants,omitempty"`
 	LevelUpFeatGrants  map[int]*FeatGrants       `yaml:"level_up_feat_grants,omitempty"`
 	CastingModel       CastingModel              `yaml:"casting_model,omitempty"`
+	// Abilities are the active combat abilities every job of this archetype can use.
+	Abilities []*JobAbility `yaml:"abilities,omitempty"`
 }
 
 // LoadArchetypes reads all .yaml files in dir and parses each as an Archetype.
@@ -43,6 +45,16 @@ func LoadArchetypes(dir string) ([]*Archetype, error) {
 		if err := yaml.Unmarshal(data, &a); err != nil {
 			return nil, fmt.Errorf("parsing archetype file %s: %w", path, err)
 		}
+		seen := make(map[string]bool, len(a.Abilities))
+		for _, ab := range a.Abilities {
+			if err := ab.Validate(); err != nil {
+				return nil, fmt.Errorf("archetype %q: %w", a.ID, err)
+			}
+			if seen[ab.ID] {
+				return nil, fmt.Errorf("archetype %q: duplicate job ability %q", a.ID, ab.ID)
+			}
+			seen[ab.ID] = true
+		}
 		archetypes = append(archetypes, &a)
 	}
 	return archetypes, nil
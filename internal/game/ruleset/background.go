@@ -0,0 +1,161 @@
+package ruleset
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BackgroundItem is a themed starting item granted by a background answer.
+type BackgroundItem struct {
+	ItemID   string `yaml:"item"`
+	Quantity int    `yaml:"quantity"`
+}
+
+// BackgroundAnswer is one selectable answer to a background question.
+// Every grant is optional: an answer may only flavour the character.
+type BackgroundAnswer struct {
+	ID        string           `yaml:"id"`
+	Text      string           `yaml:"text"`
+	Items     []BackgroundItem `yaml:"items"`
+	Condition string           `yaml:"condition"`
+	StartRoom string           `yaml:"start_room"`
+}
+
+// BackgroundQuestion is one question of the character creation questionnaire.
+type BackgroundQuestion struct {
+	ID      string              `yaml:"id"`
+	Prompt  string              `yaml:"prompt"`
+	Answers []*BackgroundAnswer `yaml:"answers"`
+}
+
+// Answer returns the answer with the given ID.
+//
+// Postcondition: Returns (answer, true) when found; (nil, false) otherwise.
+func (q *BackgroundQuestion) Answer(id string) (*BackgroundAnswer, bool) {
+	for _, a := range q.Answers {
+		if a.ID == id {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// Backgrounds is the background questionnaire asked during character creation.
+// A nil *Backgrounds behaves as an empty questionnaire.
+type Backgrounds struct {
+	Questions []*BackgroundQuestion `yaml:"questions"`
+}
+
+// BackgroundGrants is the merged result of a set of background answers.
+type BackgroundGrants struct {
+	Items      []BackgroundItem
+	Conditions []string
+	// StartRoom is empty when no answer varies the starting room.
+	StartRoom string
+}
+
+// LoadBackgrounds reads the background questionnaire from a YAML file.
+//
+// Precondition: path must be a readable file.
+// Postcondition: Returns the questionnaire or a non-nil error, including when a
+// question or answer lacks an id, an id is duplicated, a question has no answers,
+// or an item grant has no item id. Item quantities default to 1.
+func LoadBackgrounds(path string) (*Backgrounds, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var b Backgrounds
+	if err := yaml.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parsing backgrounds file %s: %w", path, err)
+	}
+	seenQuestions := make(map[string]bool, len(b.Questions))
+	for _, q := range b.Questions {
+		if q.ID == "" || q.Prompt == "" {
+			return nil, fmt.Errorf("backgrounds file %s: question id and prompt are required", path)
+		}
+		if seenQuestions[q.ID] {
+			return nil, fmt.Errorf("backgrounds file %s: duplicate question %q", path, q.ID)
+		}
+		seenQuestions[q.ID] = true
+		if len(q.Answers) == 0 {
+			return nil, fmt.Errorf("backgrounds file %s: question %q has no answers", path, q.ID)
+		}
+		seenAnswers := make(map[string]bool, len(q.Answers))
+		for _, a := range q.Answers {
+			if a.ID == "" || a.Text == "" {
+				return nil, fmt.Errorf("backgrounds file %s: question %q: answer id and text are required", path, q.ID)
+			}
+			if seenAnswers[a.ID] {
+				return nil, fmt.Errorf("backgrounds file %s: question %q: duplicate answer %q", path, q.ID, a.ID)
+			}
+			seenAnswers[a.ID] = true
+			for i := range a.Items {
+				if a.Items[i].ItemID == "" {
+					return nil, fmt.Errorf("backgrounds file %s: answer %q: item id is required", path, a.ID)
+				}
+				if a.Items[i].Quantity <= 0 {
+					a.Items[i].Quantity = 1
+				}
+			}
+		}
+	}
+	return &b, nil
+}
+
+// Validate checks that every entry in answers names a known question and one of its answers.
+//
+// Postcondition: Returns nil when all answers are known; unanswered questions are allowed.
+func (b *Backgrounds) Validate(answers map[string]string) error {
+	for questionID, answerID := range answers {
+		q, ok := b.question(questionID)
+		if !ok {
+			return fmt.Errorf("unknown background question %q", questionID)
+		}
+		if _, ok := q.Answer(answerID); !ok {
+			return fmt.Errorf("unknown answer %q to background question %q", answerID, questionID)
+		}
+	}
+	return nil
+}
+
+// Resolve merges the grants of the chosen answers in questionnaire order.
+// Items and conditions accumulate; the last answer naming a start room wins.
+// Unknown questions and answers are ignored.
+//
+// Postcondition: Returns the merged grants; zero value when b is nil or answers is empty.
+func (b *Backgrounds) Resolve(answers map[string]string) BackgroundGrants {
+	var g BackgroundGrants
+	if b == nil {
+		return g
+	}
+	for _, q := range b.Questions {
+		a, ok := q.Answer(answers[q.ID])
+		if !ok {
+			continue
+		}
+		g.Items = append(g.Items, a.Items...)
+		if a.Condition != "" {
+			g.Conditions = append(g.Conditions, a.Condition)
+		}
+		if a.StartRoom != "" {
+			g.StartRoom = a.StartRoom
+		}
+	}
+	return g
+}
+
+// question returns the question with the given ID.
+func (b *Backgrounds) question(id string) (*BackgroundQuestion, bool) {
+	if b == nil {
+		return nil, false
+	}
+	for _, q := range b.Questions {
+		if q.ID == id {
+			return q, true
+		}
+	}
+	return nil, false
+}
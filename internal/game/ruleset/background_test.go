@@ -0,0 +1,174 @@
+package ruleset_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+const testBackgroundsYAML = `
+questions:
+  - id: upbringing
+    prompt: Where did you grow up?
+    answers:
+      - id: tunnels
+        text: In the tunnels.
+        start_room: tunnel_room
+        items:
+          - item: bandage
+            quantity: 2
+      - id: crew
+        text: With my crew.
+        items:
+          - item: tape
+  - id: bond
+    prompt: What keeps you going?
+    answers:
+      - id: debt
+        text: A debt.
+        condition: survivor
+        start_room: debt_room
+      - id: nothing
+        text: Nothing at all.
+`
+
+func loadTestBackgrounds(t *testing.T, body string) (*ruleset.Backgrounds, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "backgrounds.yaml")
+	writeFile(t, path, body)
+	return ruleset.LoadBackgrounds(path)
+}
+
+func TestLoadBackgrounds_ParsesYAML(t *testing.T) {
+	b, err := loadTestBackgrounds(t, testBackgroundsYAML)
+	require.NoError(t, err)
+	require.Len(t, b.Questions, 2)
+	q := b.Questions[0]
+	assert.Equal(t, "upbringing", q.ID)
+	a, ok := q.Answer("crew")
+	require.True(t, ok)
+	require.Len(t, a.Items, 1)
+	assert.Equal(t, "tape", a.Items[0].ItemID)
+	assert.Equal(t, 1, a.Items[0].Quantity, "quantity defaults to 1")
+}
+
+func TestLoadBackgrounds_RejectsInvalid(t *testing.T) {
+	cases := map[string]string{
+		"no answers": `
+questions:
+  - id: q
+    prompt: Why?
+`,
+		"duplicate question": `
+questions:
+  - id: q
+    prompt: Why?
+    answers: [{id: a, text: A}]
+  - id: q
+    prompt: Again?
+    answers: [{id: a, text: A}]
+`,
+		"duplicate answer": `
+questions:
+  - id: q
+    prompt: Why?
+    answers: [{id: a, text: A}, {id: a, text: B}]
+`,
+		"missing item id": `
+questions:
+  - id: q
+    prompt: Why?
+    answers:
+      - id: a
+        text: A
+        items: [{quantity: 2}]
+`,
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadTestBackgrounds(t, body)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadBackgrounds_MissingFile(t *testing.T) {
+	_, err := ruleset.LoadBackgrounds(filepath.Join(t.TempDir(), "absent.yaml"))
+	assert.Error(t, err)
+}
+
+func TestBackgrounds_Validate(t *testing.T) {
+	b, err := loadTestBackgrounds(t, testBackgroundsYAML)
+	require.NoError(t, err)
+	assert.NoError(t, b.Validate(map[string]string{"upbringing": "tunnels"}))
+	assert.NoError(t, b.Validate(nil))
+	assert.Error(t, b.Validate(map[string]string{"upbringing": "moon"}))
+	assert.Error(t, b.Validate(map[string]string{"zodiac": "tunnels"}))
+}
+
+func TestBackgrounds_ResolveMergesInQuestionOrder(t *testing.T) {
+	b, err := loadTestBackgrounds(t, testBackgroundsYAML)
+	require.NoError(t, err)
+	g := b.Resolve(map[string]string{"upbringing": "tunnels", "bond": "debt"})
+	assert.Equal(t, []ruleset.BackgroundItem{{ItemID: "bandage", Quantity: 2}}, g.Items)
+	assert.Equal(t, []string{"survivor"}, g.Conditions)
+	assert.Equal(t, "debt_room", g.StartRoom, "later question's start room wins")
+
+	g = b.Resolve(map[string]string{"upbringing": "tunnels", "bond": "nothing"})
+	assert.Equal(t, "tunnel_room", g.StartRoom)
+	assert.Empty(t, g.Conditions)
+}
+
+func TestBackgrounds_NilResolvesEmpty(t *testing.T) {
+	var b *ruleset.Backgrounds
+	assert.Equal(t, ruleset.BackgroundGrants{}, b.Resolve(map[string]string{"upbringing": "tunnels"}))
+	assert.Error(t, b.Validate(map[string]string{"upbringing": "tunnels"}))
+}
+
+func TestProperty_BackgroundsResolveOnlyGrantsChosenAnswers(t *testing.T) {
+	b, err := loadTestBackgrounds(t, testBackgroundsYAML)
+	require.NoError(t, err)
+	rapid.Check(t, func(rt *rapid.T) {
+		answers := make(map[string]string)
+		wantItems := 0
+		for _, q := range b.Questions {
+			if !rapid.Bool().Draw(rt, "answer_"+q.ID) {
+				continue
+			}
+			a := rapid.SampledFrom(q.Answers).Draw(rt, "choice_"+q.ID)
+			answers[q.ID] = a.ID
+			wantItems += len(a.Items)
+		}
+		if err := b.Validate(answers); err != nil {
+			rt.Fatalf("valid answers rejected: %v", err)
+		}
+		g := b.Resolve(answers)
+		if len(g.Items) != wantItems {
+			rt.Fatalf("got %d items, want %d", len(g.Items), wantItems)
+		}
+		if len(answers) == 0 && (g.StartRoom != "" || len(g.Conditions) != 0) {
+			rt.Fatalf("empty answers granted %+v", g)
+		}
+	})
+}
+
+func TestLoadBackgrounds_ShippedContent(t *testing.T) {
+	b, err := ruleset.LoadBackgrounds("../../../content/backgrounds.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, b.Questions)
+	for _, q := range b.Questions {
+		for _, a := range q.Answers {
+			if a.Condition == "" {
+				continue
+			}
+			_, statErr := os.Stat(filepath.Join("../../../content/conditions", a.Condition+".yaml"))
+			assert.NoError(t, statErr, "answer %s references condition %s", a.ID, a.Condition)
+		}
+	}
+}
@@ -29,6 +29,7 @@ type ActionEffect struct {
 	DamageType  string `yaml:"damage_type"`  // for type=damage
 	Skill       string `yaml:"skill"`        // for type=skill_check
 	DC          int    `yaml:"dc"`           // for type=skill_check
+	Rounds      int    `yaml:"rounds"`       // for type=condition; 0 lasts the encounter
 }
 
 // ClassFeature defines one Gunchete class feature and its P2FE equivalent.
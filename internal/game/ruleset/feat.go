@@ -3,10 +3,12 @@ package ruleset
 import (
 	"fmt"
 	"os"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/cory-johannsen/mud/internal/game/aoe"
+	"github.com/cory-johannsen/mud/internal/game/effect"
 	"github.com/cory-johannsen/mud/internal/game/reaction"
 )
 
@@ -65,17 +67,106 @@ type Feat struct {
 	// ActionCost is the number of action points this active feat costs to use.
 	// 0 means the engine defaults to 1 AP. Only meaningful for Active == true feats.
 	ActionCost int `yaml:"action_cost,omitempty"`
+	// PassiveBonuses are always-on typed bonuses the feat grants in combat.
+	// Only meaningful when Active == false (passive feats).
+	PassiveBonuses []effect.Bonus `yaml:"passive_bonuses,omitempty"`
+	// Prerequisites lists what a character must have before taking this feat.
+	// Nil means anyone may take it.
+	Prerequisites *FeatPrerequisites `yaml:"prerequisites,omitempty"`
 }
 
-// Validate returns an error if the feat's AoE fields are inconsistent.
+// FeatPrerequisites lists what a character must have before taking a feat.
+// Every non-empty field must be satisfied.
+type FeatPrerequisites struct {
+	// Level is the minimum character level.
+	Level int `yaml:"level,omitempty"`
+	// Feats are feat IDs the character must already have.
+	Feats []string `yaml:"feats,omitempty"`
+	// Abilities maps an ability name to its minimum score.
+	Abilities map[string]int `yaml:"abilities,omitempty"`
+	// Skills maps a skill ID to its minimum proficiency rank.
+	Skills map[string]string `yaml:"skills,omitempty"`
+}
+
+// FeatCandidate is the part of a character that feat prerequisites test.
+type FeatCandidate struct {
+	Level     int
+	Feats     map[string]bool
+	Abilities map[string]int
+	Skills    map[string]string
+}
+
+// featRankOrder orders proficiency ranks for skill prerequisites.
+var featRankOrder = map[string]int{
+	"untrained": 0,
+	"trained":   1,
+	"expert":    2,
+	"master":    3,
+	"legendary": 4,
+}
+
+// Unmet returns a short description of each prerequisite c fails, in a stable
+// order.
+//
+// Postcondition: Returns nil when p is nil or c meets every prerequisite.
+func (p *FeatPrerequisites) Unmet(c FeatCandidate) []string {
+	if p == nil {
+		return nil
+	}
+	var out []string
+	if c.Level < p.Level {
+		out = append(out, fmt.Sprintf("level %d", p.Level))
+	}
+	for _, id := range p.Feats {
+		if !c.Feats[id] {
+			out = append(out, "the "+id+" feat")
+		}
+	}
+	for _, name := range sortedKeys(p.Abilities) {
+		if c.Abilities[name] < p.Abilities[name] {
+			out = append(out, fmt.Sprintf("%s %d", name, p.Abilities[name]))
+		}
+	}
+	for _, id := range sortedKeys(p.Skills) {
+		if featRankOrder[c.Skills[id]] < featRankOrder[p.Skills[id]] {
+			out = append(out, fmt.Sprintf("%s in %s", p.Skills[id], id))
+		}
+	}
+	return out
+}
+
+// sortedKeys returns m's keys in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Validate returns an error if the feat is malformed.
 //
 // Precondition: f is non-nil.
 // Postcondition: returns nil iff (AoeShape, AoeRadius, AoeLength, AoeWidth)
-// satisfy the AoE field rules.
+// satisfy the AoE field rules, every passive bonus is non-zero, and every
+// skill prerequisite names a known rank.
 func (f *Feat) Validate() error {
 	if err := aoe.ValidateAoeFields(f.AoeShape, f.AoeRadius, f.AoeLength, f.AoeWidth); err != nil {
 		return fmt.Errorf("feat %q: %w", f.ID, err)
 	}
+	for _, b := range f.PassiveBonuses {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("feat %q: %w", f.ID, err)
+		}
+	}
+	if p := f.Prerequisites; p != nil {
+		for id, rank := range p.Skills {
+			if _, ok := featRankOrder[rank]; !ok {
+				return fmt.Errorf("feat %q: prerequisite skill %q has unknown rank %q", f.ID, id, rank)
+			}
+		}
+	}
 	return nil
 }
 
@@ -94,6 +185,9 @@ func LoadFeatsFromBytes(data []byte) ([]*Feat, error) {
 		return nil, fmt.Errorf("parsing feats: %w", err)
 	}
 	for _, feat := range f.Feats {
+		for i := range feat.PassiveBonuses {
+			feat.PassiveBonuses[i].Normalise()
+		}
 		if err := feat.Validate(); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,85 @@
+package ruleset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/effect"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func TestLoadFeatsFromBytes_PrerequisitesAndBonuses(t *testing.T) {
+	feats, err := ruleset.LoadFeatsFromBytes([]byte(`
+feats:
+- id: heavy_hands
+  name: Heavy Hands
+  category: general
+  prerequisites:
+    level: 6
+    feats: [steady_aim]
+    abilities: {brutality: 14}
+    skills: {muscle: trained}
+  passive_bonuses:
+    - {stat: damage, value: 1}
+`))
+	require.NoError(t, err)
+	require.Len(t, feats, 1)
+	f := feats[0]
+	assert.Equal(t, []effect.Bonus{{Stat: effect.StatDamage, Value: 1, Type: effect.BonusTypeUntyped}}, f.PassiveBonuses)
+	require.NotNil(t, f.Prerequisites)
+	assert.Equal(t, []string{"level 6", "the steady_aim feat", "brutality 14", "trained in muscle"},
+		f.Prerequisites.Unmet(ruleset.FeatCandidate{Level: 1}))
+	assert.Empty(t, f.Prerequisites.Unmet(ruleset.FeatCandidate{
+		Level:     6,
+		Feats:     map[string]bool{"steady_aim": true},
+		Abilities: map[string]int{"brutality": 16},
+		Skills:    map[string]string{"muscle": "expert"},
+	}))
+}
+
+func TestLoadFeatsFromBytes_RejectsBadBonusesAndRanks(t *testing.T) {
+	_, err := ruleset.LoadFeatsFromBytes([]byte("feats:\n- id: x\n  passive_bonuses:\n    - {stat: ac, value: 0}\n"))
+	assert.Error(t, err)
+	_, err = ruleset.LoadFeatsFromBytes([]byte("feats:\n- id: x\n  prerequisites:\n    skills: {muscle: godlike}\n"))
+	assert.ErrorContains(t, err, `unknown rank "godlike"`)
+}
+
+func TestContentFeats_CombatTrainingFeatsDeclareBonuses(t *testing.T) {
+	feats, err := ruleset.LoadFeats("../../../content/feats.yaml")
+	require.NoError(t, err)
+	reg := ruleset.NewFeatRegistry(feats)
+	for _, id := range []string{"steady_aim", "combat_footing", "heavy_hands", "iron_guard"} {
+		f, ok := reg.Feat(id)
+		require.True(t, ok, id)
+		assert.NotEmpty(t, f.PassiveBonuses, id)
+		assert.NotNil(t, f.Prerequisites, id)
+	}
+}
+
+func TestProperty_FeatPrerequisites_NilAndExceededAreMet(t *testing.T) {
+	ranks := []string{"untrained", "trained", "expert", "master", "legendary"}
+	rapid.Check(t, func(rt *rapid.T) {
+		need := rapid.IntRange(0, len(ranks)-1).Draw(rt, "need")
+		p := &ruleset.FeatPrerequisites{
+			Level:     rapid.IntRange(0, 20).Draw(rt, "level"),
+			Abilities: map[string]int{"grit": rapid.IntRange(8, 18).Draw(rt, "grit")},
+			Skills:    map[string]string{"parkour": ranks[need]},
+		}
+		have := rapid.IntRange(need, len(ranks)-1).Draw(rt, "have")
+		c := ruleset.FeatCandidate{
+			Level:     p.Level + rapid.IntRange(0, 5).Draw(rt, "extraLevel"),
+			Abilities: map[string]int{"grit": p.Abilities["grit"] + rapid.IntRange(0, 4).Draw(rt, "extraGrit")},
+			Skills:    map[string]string{"parkour": ranks[have]},
+		}
+		if unmet := p.Unmet(c); len(unmet) != 0 {
+			rt.Fatalf("Unmet = %v for a candidate exceeding every requirement", unmet)
+		}
+		var none *ruleset.FeatPrerequisites
+		if unmet := none.Unmet(ruleset.FeatCandidate{}); unmet != nil {
+			rt.Fatalf("nil prerequisites reported %v", unmet)
+		}
+	})
+}
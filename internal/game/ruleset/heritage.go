@@ -0,0 +1,58 @@
+package ruleset
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HeritageTrait is the starting trait a heritage grants. Its ID names the
+// heritage-category feat in content/feats.yaml that carries the trait's
+// effect; the game server grants that feat to the character.
+type HeritageTrait struct {
+	ID          string `yaml:"id"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+// Heritage defines a heritage (PF2E heritage replacement) layered on top of a
+// home region during character creation. Its ability modifiers are applied
+// after the region's, and it grants exactly one starting trait.
+//
+// Precondition: ID, Name, and Trait.ID must be non-empty after loading.
+type Heritage struct {
+	ID          string         `yaml:"id"`
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description"`
+	Modifiers   map[string]int `yaml:"modifiers"`
+	Trait       HeritageTrait  `yaml:"trait"`
+}
+
+// LoadHeritages reads all .yaml files in dir and parses each as a Heritage.
+//
+// Precondition: dir must be a readable directory path.
+// Postcondition: Returns all parsed heritages (may be empty slice) or a non-nil
+// error, including when a heritage lacks an id, name, or trait id.
+func LoadHeritages(dir string) ([]*Heritage, error) {
+	files, err := yamlFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	heritages := make([]*Heritage, 0, len(files))
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		var h Heritage
+		if err := yaml.Unmarshal(data, &h); err != nil {
+			return nil, fmt.Errorf("parsing heritage file %s: %w", path, err)
+		}
+		if h.ID == "" || h.Name == "" || h.Trait.ID == "" {
+			return nil, fmt.Errorf("heritage file %s: id, name, and trait.id are required", path)
+		}
+		heritages = append(heritages, &h)
+	}
+	return heritages, nil
+}
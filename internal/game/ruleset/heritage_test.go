@@ -0,0 +1,78 @@
+package ruleset_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func TestLoadHeritages_ParsesYAML(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "street_born.yaml"), `
+id: street_born
+name: "Street-Born"
+description: "Raised on the pavement."
+modifiers:
+  quickness: 2
+  reasoning: -2
+trait:
+  id: alley_sense
+  name: Alley Sense
+  description: You always know the nearest way out.
+`)
+	heritages, err := ruleset.LoadHeritages(dir)
+	require.NoError(t, err)
+	require.Len(t, heritages, 1)
+	h := heritages[0]
+	assert.Equal(t, "street_born", h.ID)
+	assert.Equal(t, 2, h.Modifiers["quickness"])
+	assert.Equal(t, -2, h.Modifiers["reasoning"])
+	assert.Equal(t, "alley_sense", h.Trait.ID)
+	assert.Equal(t, "Alley Sense", h.Trait.Name)
+}
+
+func TestLoadHeritages_RequiresTrait(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "bad.yaml"), `
+id: bad
+name: "Bad"
+`)
+	_, err := ruleset.LoadHeritages(dir)
+	assert.Error(t, err)
+}
+
+func TestLoadHeritages_MissingDir(t *testing.T) {
+	heritages, err := ruleset.LoadHeritages(filepath.Join(t.TempDir(), "absent"))
+	require.NoError(t, err)
+	assert.Empty(t, heritages)
+}
+
+func TestLoadHeritages_ShippedContent(t *testing.T) {
+	heritages, err := ruleset.LoadHeritages("../../../content/heritages")
+	require.NoError(t, err)
+	require.NotEmpty(t, heritages)
+	for _, h := range heritages {
+		assert.NotEmpty(t, h.Description, h.ID)
+		assert.NotEmpty(t, h.Trait.Name, h.ID)
+		assert.NotEmpty(t, h.Modifiers, h.ID)
+	}
+}
+
+func TestHeritageTraits_NameHeritageFeats(t *testing.T) {
+	heritages, err := ruleset.LoadHeritages("../../../content/heritages")
+	require.NoError(t, err)
+	feats, err := ruleset.LoadFeats("../../../content/feats.yaml")
+	require.NoError(t, err)
+	reg := ruleset.NewFeatRegistry(feats)
+	for _, h := range heritages {
+		f, ok := reg.Feat(h.Trait.ID)
+		require.True(t, ok, "heritage %s: trait %s has no feat", h.ID, h.Trait.ID)
+		assert.Equal(t, "heritage", f.Category, h.ID)
+		assert.False(t, f.Active, h.ID)
+		assert.NotEmpty(t, f.PassiveBonuses, "heritage %s: trait feat has no effect", h.ID)
+	}
+}
@@ -0,0 +1,90 @@
+package ruleset
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+)
+
+// JobAbility is an active combat ability granted to every job of an archetype.
+// Using one spends APCost action points and puts the ability on cooldown for
+// Cooldown rounds; its Effects run in order when the round resolves.
+//
+// Precondition: ID, Name, and at least one effect must be set after loading.
+type JobAbility struct {
+	ID           string         `yaml:"id"`
+	Name         string         `yaml:"name"`
+	Description  string         `yaml:"description"`
+	ActivateText string         `yaml:"activate_text,omitempty"`
+	APCost       int            `yaml:"ap_cost"`
+	Cooldown     int            `yaml:"cooldown"` // rounds before the ability can be used again
+	Effects      []ActionEffect `yaml:"effects"`
+}
+
+// NeedsTarget reports whether any of the ability's effects lands on an enemy.
+func (a *JobAbility) NeedsTarget() bool {
+	for _, e := range a.Effects {
+		if e.Target == "target" {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the ability cannot be used as written.
+//
+// Precondition: a is non-nil.
+// Postcondition: returns nil iff the AP cost is 1-3, the cooldown is
+// non-negative, and every effect is a well-formed damage, heal, or condition
+// step aimed at "self" or "target".
+func (a *JobAbility) Validate() error {
+	if a.ID == "" || a.Name == "" {
+		return fmt.Errorf("job ability needs an id and a name")
+	}
+	if a.APCost < 1 || a.APCost > 3 {
+		return fmt.Errorf("job ability %q: ap_cost %d must be 1-3", a.ID, a.APCost)
+	}
+	if a.Cooldown < 0 {
+		return fmt.Errorf("job ability %q: cooldown %d must not be negative", a.ID, a.Cooldown)
+	}
+	if len(a.Effects) == 0 {
+		return fmt.Errorf("job ability %q has no effects", a.ID)
+	}
+	for i, e := range a.Effects {
+		if e.Target != "self" && e.Target != "target" {
+			return fmt.Errorf("job ability %q effect %d: target %q must be self or target", a.ID, i, e.Target)
+		}
+		switch e.Type {
+		case "damage", "heal":
+			if err := validateEffectAmount(e.Amount); err != nil {
+				return fmt.Errorf("job ability %q effect %d: %w", a.ID, i, err)
+			}
+			if e.Type == "damage" && e.Target != "target" {
+				return fmt.Errorf("job ability %q effect %d: damage must land on the target", a.ID, i)
+			}
+		case "condition":
+			if e.ConditionID == "" {
+				return fmt.Errorf("job ability %q effect %d: condition_id is required", a.ID, i)
+			}
+		default:
+			return fmt.Errorf("job ability %q effect %d: unsupported type %q", a.ID, i, e.Type)
+		}
+	}
+	return nil
+}
+
+// validateEffectAmount accepts a dice expression such as "2d6+1" or a flat
+// positive integer.
+func validateEffectAmount(amount string) error {
+	if n, err := strconv.Atoi(amount); err == nil {
+		if n <= 0 {
+			return fmt.Errorf("amount %d must be positive", n)
+		}
+		return nil
+	}
+	if _, err := dice.Parse(amount); err != nil {
+		return fmt.Errorf("amount %q: %w", amount, err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package ruleset_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func validJobAbility() *ruleset.JobAbility {
+	return &ruleset.JobAbility{
+		ID: "haymaker", Name: "Haymaker", APCost: 2, Cooldown: 3,
+		Effects: []ruleset.ActionEffect{
+			{Type: "damage", Target: "target", Amount: "2d8", DamageType: "bludgeoning"},
+			{Type: "condition", Target: "target", ConditionID: "flat_footed", Rounds: 1},
+		},
+	}
+}
+
+func TestJobAbility_Validate(t *testing.T) {
+	require.NoError(t, validJobAbility().Validate())
+
+	cases := map[string]func(a *ruleset.JobAbility){
+		"no id":             func(a *ruleset.JobAbility) { a.ID = "" },
+		"free":              func(a *ruleset.JobAbility) { a.APCost = 0 },
+		"too costly":        func(a *ruleset.JobAbility) { a.APCost = 4 },
+		"negative cooldown": func(a *ruleset.JobAbility) { a.Cooldown = -1 },
+		"no effects":        func(a *ruleset.JobAbility) { a.Effects = nil },
+		"bad dice":          func(a *ruleset.JobAbility) { a.Effects[0].Amount = "lots" },
+		"self damage":       func(a *ruleset.JobAbility) { a.Effects[0].Target = "self" },
+		"no condition":      func(a *ruleset.JobAbility) { a.Effects[1].ConditionID = "" },
+		"skill check":       func(a *ruleset.JobAbility) { a.Effects[1].Type = "skill_check" },
+	}
+	for name, mutate := range cases {
+		a := validJobAbility()
+		mutate(a)
+		assert.Error(t, a.Validate(), name)
+	}
+}
+
+func TestJobAbility_NeedsTarget(t *testing.T) {
+	a := validJobAbility()
+	assert.True(t, a.NeedsTarget())
+	a.Effects = []ruleset.ActionEffect{{Type: "heal", Target: "self", Amount: "5"}}
+	assert.False(t, a.NeedsTarget())
+	require.NoError(t, a.Validate())
+}
+
+func TestProperty_JobAbility_APCostBounds(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		a := validJobAbility()
+		a.APCost = rapid.IntRange(-2, 6).Draw(rt, "ap")
+		if (a.Validate() == nil) != (a.APCost >= 1 && a.APCost <= 3) {
+			rt.Fatalf("ap_cost %d: Validate() = %v", a.APCost, a.Validate())
+		}
+	})
+}
+
+func TestLoadArchetypes_RejectsDuplicateAbility(t *testing.T) {
+	dir := t.TempDir()
+	raw := `
+id: dup
+name: Dup
+key_ability: brutality
+hit_points_per_level: 8
+abilities:
+  - {id: brace, name: Brace, ap_cost: 1, cooldown: 2, effects: [{type: condition, target: self, condition_id: fortified}]}
+  - {id: brace, name: Brace Again, ap_cost: 1, cooldown: 2, effects: [{type: condition, target: self, condition_id: fortified}]}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dup.yaml"), []byte(raw), 0o644))
+	_, err := ruleset.LoadArchetypes(dir)
+	assert.ErrorContains(t, err, "duplicate job ability")
+}
+
+// Every job inherits its archetype's abilities, so each archetype must carry
+// two or three, and every condition they apply must exist.
+func TestArchetypeContent_JobAbilities(t *testing.T) {
+	archetypes, err := ruleset.LoadArchetypes("../../../content/archetypes")
+	require.NoError(t, err)
+	require.NotEmpty(t, archetypes)
+	for _, a := range archetypes {
+		assert.GreaterOrEqual(t, len(a.Abilities), 2, a.ID)
+		assert.LessOrEqual(t, len(a.Abilities), 3, a.ID)
+		for _, ab := range a.Abilities {
+			for _, e := range ab.Effects {
+				if e.Type != "condition" {
+					continue
+				}
+				_, statErr := os.Stat(filepath.Join("../../../content/conditions", e.ConditionID+".yaml"))
+				assert.NoError(t, statErr, "%s/%s condition %q", a.ID, ab.ID, e.ConditionID)
+			}
+		}
+	}
+}
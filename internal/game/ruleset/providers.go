@@ -25,6 +25,9 @@ type ArchetypesDir string
 // RegionsDir is the path to region YAML definitions.
 type RegionsDir string
 
+// HeritagesDir is the path to heritage YAML definitions.
+type HeritagesDir string
+
 // TeamsDir is the path to team YAML definitions.
 type TeamsDir string
 
@@ -110,6 +113,20 @@ func LoadRegionMap(dir RegionsDir, logger *zap.Logger) (map[string]*Region, erro
 	return m, nil
 }
 
+// LoadHeritageMap loads heritages and returns a map keyed by ID.
+func LoadHeritageMap(dir HeritagesDir, logger *zap.Logger) (map[string]*Heritage, error) {
+	list, err := LoadHeritages(string(dir))
+	if err != nil {
+		return nil, fmt.Errorf("loading heritages: %w", err)
+	}
+	m := make(map[string]*Heritage, len(list))
+	for _, h := range list {
+		m[h.ID] = h
+	}
+	logger.Info("loaded heritage definitions", zap.Int("count", len(list)))
+	return m, nil
+}
+
 // LoadAllTeams loads teams from dir (used by devserver/frontend only).
 func LoadAllTeams(dir TeamsDir, logger *zap.Logger) ([]*Team, error) {
 	teams, err := LoadTeams(string(dir))
@@ -160,6 +177,7 @@ var GameProviders = wire.NewSet(
 	NewClassFeatureRegistryFromFeatures,
 	LoadArchetypeMap,
 	LoadRegionMap,
+	LoadHeritageMap,
 )
 
 // RulesetContentProviders is the minimal ruleset provider set for devserver/frontend.
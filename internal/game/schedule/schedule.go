@@ -0,0 +1,140 @@
+// Package schedule defines server events that run on cron-like real-time or
+// game-time schedules, such as double-XP weekends or a morning market
+// refresh, loaded from content.
+package schedule
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Action is what a scheduled event does when it fires.
+type Action string
+
+const (
+	// ActionAnnounce only broadcasts the event's message.
+	ActionAnnounce Action = "announce"
+	// ActionXPBonus multiplies XP awards by Multiplier for Duration.
+	ActionXPBonus Action = "xp_bonus"
+	// ActionRestock refills every merchant's stock and budget.
+	ActionRestock Action = "restock"
+	// ActionHook calls the Lua function Hook in Zone's VM, or in every VM when Zone is empty.
+	ActionHook Action = "hook"
+	// ActionZoneReset resets Zone now and restarts its reset timer.
+	ActionZoneReset Action = "zone_reset"
+	// ActionRaid starts the raid Raid on its faction's stronghold.
+	ActionRaid Action = "raid"
+)
+
+// Event is one scheduled event loaded from content. Exactly one of Real and
+// Game is set. When Message is set it is broadcast to every player as the
+// event fires.
+type Event struct {
+	ID          string `yaml:"id"`
+	Description string `yaml:"description"`
+	// Real is a real-time schedule in UTC: minute hour day month weekday.
+	Real string `yaml:"real"`
+	// Game is a game-time schedule on the game calendar: hour day month.
+	Game       string        `yaml:"game"`
+	Action     Action        `yaml:"action"`
+	Message    string        `yaml:"message"`
+	Multiplier float64       `yaml:"multiplier"`
+	Duration   time.Duration `yaml:"duration"`
+	Hook       string        `yaml:"hook"`
+	Zone       string        `yaml:"zone"`
+	Raid       string        `yaml:"raid"`
+
+	spec *Spec
+}
+
+// Spec returns the event's parsed schedule.
+//
+// Precondition: e was validated by Validate or Load.
+func (e *Event) Spec() *Spec {
+	return e.spec
+}
+
+// IsGameTime reports whether the event runs on the game calendar.
+func (e *Event) IsGameTime() bool {
+	return e.Game != ""
+}
+
+// Validate parses the event's schedule and checks its action has what it needs.
+//
+// Postcondition: Returns a non-nil error describing the first problem found.
+func (e *Event) Validate() error {
+	if e.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	var err error
+	switch {
+	case e.Real != "" && e.Game != "":
+		return fmt.Errorf("event %q: set real or game, not both", e.ID)
+	case e.Real != "":
+		e.spec, err = ParseReal(e.Real)
+	case e.Game != "":
+		e.spec, err = ParseGame(e.Game)
+	default:
+		return fmt.Errorf("event %q: a real or game schedule is required", e.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("event %q: %w", e.ID, err)
+	}
+	switch e.Action {
+	case ActionAnnounce:
+		if e.Message == "" {
+			return fmt.Errorf("event %q: announce needs a message", e.ID)
+		}
+	case ActionXPBonus:
+		if e.Multiplier <= 0 || e.Duration <= 0 {
+			return fmt.Errorf("event %q: xp_bonus needs a positive multiplier and duration", e.ID)
+		}
+	case ActionRestock:
+	case ActionHook:
+		if e.Hook == "" {
+			return fmt.Errorf("event %q: hook needs a hook name", e.ID)
+		}
+	case ActionZoneReset:
+		if e.Zone == "" {
+			return fmt.Errorf("event %q: zone_reset needs a zone", e.ID)
+		}
+	case ActionRaid:
+		if e.Raid == "" {
+			return fmt.Errorf("event %q: raid needs a raid id", e.ID)
+		}
+	default:
+		return fmt.Errorf("event %q: unknown action %q", e.ID, e.Action)
+	}
+	return nil
+}
+
+// Load reads and validates the scheduled events in path.
+//
+// Postcondition: Returns events in file order, or an error naming the file
+// and the first bad or duplicate event.
+func Load(path string) ([]*Event, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var file struct {
+		Events []*Event `yaml:"events"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing schedule file %s: %w", path, err)
+	}
+	seen := make(map[string]bool, len(file.Events))
+	for i, e := range file.Events {
+		if err := e.Validate(); err != nil {
+			return nil, fmt.Errorf("schedule file %s: event %d: %w", path, i+1, err)
+		}
+		if seen[e.ID] {
+			return nil, fmt.Errorf("schedule file %s: duplicate event %q", path, e.ID)
+		}
+		seen[e.ID] = true
+	}
+	return file.Events, nil
+}
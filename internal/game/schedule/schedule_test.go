@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSchedule(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schedule.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	events, err := Load(writeSchedule(t, `
+events:
+  - id: double_xp
+    real: "0 0 * * 6"
+    action: xp_bonus
+    multiplier: 2
+    duration: 48h
+    message: Double XP weekend!
+  - id: market
+    game: "6 * *"
+    action: restock
+`))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, 48*time.Hour, events[0].Duration)
+	assert.False(t, events[0].IsGameTime())
+	assert.Equal(t, "0 0 * * 6", events[0].Spec().String())
+	assert.True(t, events[1].IsGameTime())
+	assert.True(t, events[1].Spec().MatchesGame(6, 1, 1))
+}
+
+func TestLoad_RepoScheduleIsValid(t *testing.T) {
+	_, err := Load("../../../content/schedule.yaml")
+	require.NoError(t, err)
+}
+
+func TestValidate_Rejects(t *testing.T) {
+	cases := map[string]Event{
+		"no id":          {Real: "* * * * *", Action: ActionRestock},
+		"no schedule":    {ID: "a", Action: ActionRestock},
+		"both schedules": {ID: "a", Real: "* * * * *", Game: "* * *", Action: ActionRestock},
+		"bad schedule":   {ID: "a", Game: "25 * *", Action: ActionRestock},
+		"bad action":     {ID: "a", Game: "* * *", Action: "dance"},
+		"silent notice":  {ID: "a", Game: "* * *", Action: ActionAnnounce},
+		"endless bonus":  {ID: "a", Game: "* * *", Action: ActionXPBonus, Multiplier: 2},
+		"no hook":        {ID: "a", Game: "* * *", Action: ActionHook},
+		"no reset zone":  {ID: "a", Game: "* * *", Action: ActionZoneReset},
+		"no raid":        {ID: "a", Game: "* * *", Action: ActionRaid},
+	}
+	for name, e := range cases {
+		assert.Error(t, e.Validate(), name)
+	}
+}
+
+func TestLoad_RejectsDuplicateIDs(t *testing.T) {
+	_, err := Load(writeSchedule(t, `
+events:
+  - {id: a, game: "* * *", action: restock}
+  - {id: a, game: "* * *", action: restock}
+`))
+	assert.ErrorContains(t, err, `duplicate event "a"`)
+}
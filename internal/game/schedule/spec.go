@@ -0,0 +1,179 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field bounds one position of a schedule expression.
+type field struct {
+	name     string
+	min, max int
+}
+
+// realFields are the positions of a real-time expression, as in cron.
+var realFields = []field{{"minute", 0, 59}, {"hour", 0, 23}, {"day", 1, 31}, {"month", 1, 12}, {"weekday", 0, 6}}
+
+// gameFields are the positions of a game-time expression. The game clock
+// advances an hour at a time, so there is no minute field.
+var gameFields = []field{{"hour", 0, 23}, {"day", 1, 31}, {"month", 1, 12}}
+
+// Spec is a parsed cron-like expression. Each field is "*", a number, a
+// range "a-b", a step "*/n" or "a-b/n", or a comma-separated list of those.
+// A time matches when every field matches.
+type Spec struct {
+	expr string
+	sets []uint64
+}
+
+// ParseReal parses a five-field real-time expression: minute hour day month
+// weekday, with weekday 0 for Sunday. Times are matched in UTC.
+//
+// Postcondition: Returns an error naming the bad field when expr is malformed.
+func ParseReal(expr string) (*Spec, error) {
+	return parse(expr, realFields)
+}
+
+// ParseGame parses a three-field game-time expression: hour day month.
+//
+// Postcondition: Returns an error naming the bad field when expr is malformed.
+func ParseGame(expr string) (*Spec, error) {
+	return parse(expr, gameFields)
+}
+
+func parse(expr string, fields []field) (*Spec, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		names := make([]string, len(fields))
+		for i, f := range fields {
+			names[i] = f.name
+		}
+		return nil, fmt.Errorf("schedule %q: want %d fields (%s), got %d", expr, len(fields), strings.Join(names, " "), len(parts))
+	}
+	spec := &Spec{expr: strings.Join(parts, " "), sets: make([]uint64, len(fields))}
+	for i, f := range fields {
+		set, err := parseField(parts[i], f)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %s: %w", expr, f.name, err)
+		}
+		spec.sets[i] = set
+	}
+	return spec, nil
+}
+
+// parseField returns the bit set of values part selects within f's bounds.
+func parseField(part string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(part, ",") {
+		rng, stepText, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("bad step %q", stepText)
+			}
+			step = n
+		}
+		lo, hi := f.min, f.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+			var errA, errB error
+			lo, errA = strconv.Atoi(a)
+			hi, errB = strconv.Atoi(b)
+			if errA != nil || errB != nil || lo > hi {
+				return 0, fmt.Errorf("bad range %q", rng)
+			}
+		default:
+			n, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("bad value %q", rng)
+			}
+			lo, hi = n, n
+			if hasStep {
+				hi = f.max
+			}
+		}
+		if lo < f.min || hi > f.max {
+			return 0, fmt.Errorf("%q is outside %d-%d", rng, f.min, f.max)
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// String returns the expression in normalized form.
+func (s *Spec) String() string {
+	return s.expr
+}
+
+func (s *Spec) match(values ...int) bool {
+	for i, v := range values {
+		if s.sets[i]&(1<<uint(v)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchesReal reports whether the minute containing t matches a real-time spec.
+//
+// Precondition: s was built by ParseReal.
+func (s *Spec) MatchesReal(t time.Time) bool {
+	t = t.UTC()
+	return s.match(t.Minute(), t.Hour(), t.Day(), int(t.Month()), int(t.Weekday()))
+}
+
+// MatchesGame reports whether a game hour on a calendar date matches a game-time spec.
+//
+// Precondition: s was built by ParseGame.
+func (s *Spec) MatchesGame(hour, day, month int) bool {
+	return s.match(hour, day, month)
+}
+
+// NextReal returns the first minute after the one containing t that matches a
+// real-time spec.
+//
+// Precondition: s was built by ParseReal.
+// Postcondition: ok is false when nothing matches within four years, as for
+// "0 0 31 2 *".
+func (s *Spec) NextReal(t time.Time) (next time.Time, ok bool) {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(4, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.sets[3]&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case s.sets[2]&(1<<uint(t.Day())) == 0 || s.sets[4]&(1<<uint(t.Weekday())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.sets[1]&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.sets[0]&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextGame returns how many game hours after the given game hour and date a
+// game-time spec next matches, using the game calendar's non-leap year.
+//
+// Precondition: s was built by ParseGame; month in [1,12]; day valid for month.
+// Postcondition: ok is false when no date in the calendar matches, as for "0 30 2".
+func (s *Spec) NextGame(hour, day, month int) (hours int, ok bool) {
+	t := time.Date(2001, time.Month(month), day, hour, 0, 0, 0, time.UTC)
+	for h := 1; h <= 366*24; h++ {
+		t = t.Add(time.Hour)
+		if s.MatchesGame(t.Hour(), t.Day(), int(t.Month())) {
+			return h, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,111 @@
+package schedule
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestParseReal_Matches(t *testing.T) {
+	spec, err := ParseReal("0 0 * * 6")
+	require.NoError(t, err)
+	sat := time.Date(2026, time.October, 24, 0, 0, 30, 0, time.UTC)
+	assert.True(t, spec.MatchesReal(sat))
+	assert.False(t, spec.MatchesReal(sat.Add(time.Minute)))
+	assert.False(t, spec.MatchesReal(sat.AddDate(0, 0, 1)))
+
+	spec, err = ParseReal("*/15 9-17 1,15 * 1-5")
+	require.NoError(t, err)
+	assert.True(t, spec.MatchesReal(time.Date(2026, time.October, 15, 9, 45, 0, 0, time.UTC)))
+	assert.False(t, spec.MatchesReal(time.Date(2026, time.October, 15, 9, 50, 0, 0, time.UTC)))
+	assert.False(t, spec.MatchesReal(time.Date(2026, time.October, 15, 18, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "*/15 9-17 1,15 * 1-5", spec.String())
+}
+
+func TestParse_RejectsMalformed(t *testing.T) {
+	for _, bad := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "* * * 13 *", "5-1 * * * *", "*/0 * * * *", "x * * * *"} {
+		_, err := ParseReal(bad)
+		assert.Error(t, err, bad)
+	}
+	for _, bad := range []string{"* *", "24 * *", "* 32 *", "* * * *"} {
+		_, err := ParseGame(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseGame_Matches(t *testing.T) {
+	spec, err := ParseGame("6 * *")
+	require.NoError(t, err)
+	assert.True(t, spec.MatchesGame(6, 14, 3))
+	assert.False(t, spec.MatchesGame(7, 14, 3))
+
+	spec, err = ParseGame("0 1 3,6,9,12")
+	require.NoError(t, err)
+	assert.True(t, spec.MatchesGame(0, 1, 9))
+	assert.False(t, spec.MatchesGame(0, 1, 10))
+}
+
+func TestNextReal(t *testing.T) {
+	spec, err := ParseReal("0 0 * * 6")
+	require.NoError(t, err)
+	from := time.Date(2026, time.October, 18, 12, 34, 0, 0, time.UTC) // a Sunday
+	next, ok := spec.NextReal(from)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, time.October, 24, 0, 0, 0, 0, time.UTC), next)
+
+	next, ok = spec.NextReal(next)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC), next, "strictly after the given minute")
+
+	never, err := ParseReal("0 0 31 2 *")
+	require.NoError(t, err)
+	_, ok = never.NextReal(from)
+	assert.False(t, ok)
+}
+
+func TestNextGame(t *testing.T) {
+	spec, err := ParseGame("6 * *")
+	require.NoError(t, err)
+	hours, ok := spec.NextGame(5, 1, 1)
+	require.True(t, ok)
+	assert.Equal(t, 1, hours)
+	hours, ok = spec.NextGame(6, 1, 1)
+	require.True(t, ok)
+	assert.Equal(t, 24, hours)
+
+	spec, err = ParseGame("0 1 1")
+	require.NoError(t, err)
+	hours, ok = spec.NextGame(23, 31, 12)
+	require.True(t, ok)
+	assert.Equal(t, 1, hours, "wraps into the new year")
+
+	never, err := ParseGame("0 30 2")
+	require.NoError(t, err)
+	_, ok = never.NextGame(0, 1, 1)
+	assert.False(t, ok)
+}
+
+func TestProperty_NextRealMatchesAndNothingEarlierDoes(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		minute := rapid.IntRange(0, 59).Draw(rt, "minute")
+		hour := rapid.IntRange(0, 23).Draw(rt, "hour")
+		weekday := rapid.IntRange(0, 6).Draw(rt, "weekday")
+		spec, err := ParseReal(fmt.Sprintf("%d %d * * %d", minute, hour, weekday))
+		if err != nil {
+			rt.Fatal(err)
+		}
+		from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC).
+			Add(time.Duration(rapid.IntRange(0, 500000).Draw(rt, "offsetMinutes")) * time.Minute)
+		next, ok := spec.NextReal(from)
+		if !ok || !spec.MatchesReal(next) || !next.After(from) {
+			rt.Fatalf("NextReal(%v) = %v, %v", from, next, ok)
+		}
+		if next.Sub(from) > 7*24*time.Hour {
+			rt.Fatalf("a weekly schedule is at most a week away, got %v", next.Sub(from))
+		}
+	})
+}
@@ -8,13 +8,20 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/cory-johannsen/mud/internal/game/achievement"
 	"github.com/cory-johannsen/mud/internal/game/character"
 	"github.com/cory-johannsen/mud/internal/game/condition"
+	"github.com/cory-johannsen/mud/internal/game/i18n"
 	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/lockpick"
 	questpkg "github.com/cory-johannsen/mud/internal/game/quest"
 	"github.com/cory-johannsen/mud/internal/game/reaction"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/settings"
+	"github.com/cory-johannsen/mud/internal/game/stats"
 	"github.com/cory-johannsen/mud/internal/game/substance"
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 	"github.com/google/uuid"
 )
@@ -30,6 +37,13 @@ const (
 	ExploreModePokeAround    = "poke_around"    // Lore mode — secret Recall Knowledge check on entry
 )
 
+// Combat narration level constants.
+const (
+	CombatVerbosityBrief   = "brief"   // one summary line per combatant per round
+	CombatVerbosityNormal  = "normal"  // standard per-action narrative
+	CombatVerbosityVerbose = "verbose" // narrative plus raw rolls, modifiers, and damage breakdowns
+)
+
 // PlayerSession tracks a connected player's state.
 type PlayerSession struct {
 	// UID is the unique player identifier (character ID as string).
@@ -92,6 +106,12 @@ type PlayerSession struct {
 	// Proficiencies maps proficiency category to rank for the active character.
 	// Populated after backfill completes; empty map means all untrained.
 	Proficiencies map[string]string
+	// WeaponPractice maps weapon proficiency category to the practice points
+	// earned by fighting with it; nil until loaded at login.
+	WeaponPractice map[string]int
+	// PointBuy maps ability name to the point-buy adjustment made to it at a
+	// respec; nil until loaded at login.
+	PointBuy map[string]int
 	// Conditions tracks active conditions applied outside of combat (e.g., from skill check effects).
 	// Initialized at login; nil before Session() runs.
 	Conditions *condition.ActiveSet
@@ -120,6 +140,9 @@ type PlayerSession struct {
 	// Weaknesses maps damage type → total flat addition from equipped armor (additive).
 	// Populated from ComputedDefenses at login and after equip/unequip.
 	Weaknesses map[string]int
+	// Immunities lists damage types equipped armor grants immunity to.
+	// Populated from ComputedDefenses at login and after equip/unequip.
+	Immunities []string
 	// GrabberID is the NPC instance ID of the NPC currently grappling this player.
 	// Empty string when the player is not grabbed. Set by NPC grapple; cleared by escape.
 	GrabberID string
@@ -150,6 +173,18 @@ type PlayerSession struct {
 	// ShowDamageBreakdown controls whether the player receives verbose per-stage
 	// damage breakdowns for attacks they deal or receive.
 	ShowDamageBreakdown bool
+	// CombatVerbosity is the player's combat narration level (see CombatVerbosityNormal).
+	// Combat round broadcasts are filtered per recipient according to this value.
+	CombatVerbosity string
+	// AccountID is the database ID of the owning account; 0 when unknown.
+	AccountID int64
+	// Locale is the account's message locale used to render server narratives.
+	Locale string
+	// Settings holds the account-level preferences shared across characters.
+	Settings settings.Settings
+	// LitLightID is the backpack instance ID of the light source the player has
+	// lit; empty when none is lit. Session-only, not persisted.
+	LitLightID string
 	// PendingCombatJoin holds the RoomID of a combat the player has been invited to join.
 	// Empty string means no pending join offer. Protected by combatMu in the gameserver.
 	PendingCombatJoin string
@@ -171,6 +206,33 @@ type PlayerSession struct {
 	// While in this room, the player cannot gain hidden or undetected.
 	// Cleared on room exit by handleMove.
 	LayLowBlockedRoom string
+	// MoveReadyAt is when the player may leave the room after crossing hard
+	// terrain; the zero value means immediately. Session-only, not persisted.
+	MoveReadyAt time.Time
+	// Lockpick is the lock the player is working on, or nil when not picking.
+	// Session-only, not persisted.
+	Lockpick *lockpick.Attempt
+	// Nonlethal is true when the player pulls their blows: a target their
+	// attacks drop to 0 HP is knocked out instead of killed. Session-only.
+	Nonlethal bool
+	// Practice is true while the player is in practice mode: combat is
+	// narrated verbosely with every roll and damage step shown. Session-only.
+	Practice bool
+	// BackRow is true when the player fights from the back row of the party
+	// formation: ranged attacks only, harder to hit in melee. Session-only.
+	BackRow bool
+	// LastRecap is the statistics recap of the player's last finished
+	// encounter, or empty before the first. Session-only.
+	LastRecap string
+	// Transcript records the session's output while the player has logging
+	// on. Never nil for sessions created by AddPlayer.
+	Transcript *transcript.Recorder
+	// RecentCommands holds the player's last RecentCommandsMax commands,
+	// oldest first, for the context attached to bug reports. Session-only.
+	RecentCommands []string
+	// DeepWaterTicks counts refresh ticks spent in deep water without swim gear;
+	// reset to 0 once the player is out of the water.
+	DeepWaterTicks int
 	// Region is the character's home region ID (e.g. "lake_oswego").
 	// Set at login from dbChar.Region. Used for Honkeypot targeting.
 	Region string
@@ -236,6 +298,10 @@ type PlayerSession struct {
 	// NegotiatedMerchantID is the instance ID of the merchant this player already
 	// negotiated with in the current room visit. Blocks repeat negotiate. (REQ-NPC-5)
 	NegotiatedMerchantID string
+	// StashMu guards StashBalance: rent is charged from the calendar goroutine
+	// while command handlers deposit and withdraw. Use Stash, CreditStash and
+	// DebitStash instead of touching StashBalance directly once the session is live.
+	StashMu sync.Mutex
 	// StashBalance is the player's global stash credit balance, accessible at any banker.
 	StashBalance int
 	// PendingBribeNPCName is the name of the NPC this player has initiated a bribe with.
@@ -346,6 +412,56 @@ type PlayerSession struct {
 	// ambient dose in this session; tickAmbientSubstances treats zero as immediately eligible.
 	// REQ-OCF-8: session-only; not persisted.
 	LastAmbientDose time.Time
+
+	// Tutorial tracks first-login tutorial objectives; nil when the character is
+	// not in the tutorial. Completion is persisted on the character row.
+	Tutorial *tutorial.Tracker
+
+	// Achievements records achievement progress, unlocks, and the displayed title;
+	// nil until loaded at login.
+	Achievements *achievement.Progress
+
+	// Stats accumulates lifetime stat increments until the next flush; nil when
+	// stats are not persisted.
+	Stats *stats.Tally
+
+	// LoginAt is when the current session began.
+	LoginAt time.Time
+	// CharacterCreatedAt is when the character was created; zero when unknown.
+	CharacterCreatedAt time.Time
+	// PlaySessionID identifies the current session's play-session record; 0 when
+	// sessions are not recorded.
+	PlaySessionID int64
+
+	// AFK is true while the player is marked away from keyboard, either by the
+	// afk command or by the client's idle monitor. Cleared on the next input.
+	AFK bool
+	// AFKMessage is the note left with the afk command; empty for an automatic AFK.
+	AFKMessage string
+
+	// RemoteAddr is the player's network address as forwarded by the frontend;
+	// empty when unknown.
+	RemoteAddr string
+
+	// Invisible is true while a staff member is hidden with invis. Set it
+	// through Manager.SetInvisible.
+	Invisible bool
+	// Puppet is the ID of the NPC instance the player controls with puppet;
+	// empty when none.
+	Puppet string
+}
+
+// RecentCommandsMax is the number of commands kept in RecentCommands.
+const RecentCommandsMax = 10
+
+// NoteCommand appends cmd to the player's recent commands.
+//
+// Postcondition: RecentCommands ends with cmd and holds at most RecentCommandsMax entries.
+func (sess *PlayerSession) NoteCommand(cmd string) {
+	sess.RecentCommands = append(sess.RecentCommands, cmd)
+	if len(sess.RecentCommands) > RecentCommandsMax {
+		sess.RecentCommands = append([]string(nil), sess.RecentCommands[len(sess.RecentCommands)-RecentCommandsMax:]...)
+	}
 }
 
 // EquippedInstances returns all ItemInstances currently equipped across the active weapon
@@ -434,6 +550,7 @@ type Manager struct {
 	players  map[string]*PlayerSession  // uid → session
 	roomSets map[string]map[string]bool // roomID → set of UIDs
 	groups   map[string]*Group          // groupID → group
+	snoops   map[string]string          // snooped uid → snooping staff uid
 }
 
 // NewManager creates an empty session Manager.
@@ -442,6 +559,7 @@ func NewManager() *Manager {
 		players:  make(map[string]*PlayerSession),
 		roomSets: make(map[string]map[string]bool),
 		groups:   make(map[string]*Group),
+		snoops:   make(map[string]string),
 	}
 }
 
@@ -465,6 +583,10 @@ type AddPlayerOptions struct {
 	Class               string
 	Level               int
 	DefaultCombatAction string
+	CombatVerbosity     string
+	AccountID           int64
+	Locale              string
+	Settings            settings.Settings
 	Gender              string
 	Team                string
 }
@@ -490,6 +612,14 @@ func (m *Manager) AddPlayer(opts AddPlayerOptions) (*PlayerSession, error) {
 	if defaultCombatAction == "" {
 		defaultCombatAction = "pass"
 	}
+	combatVerbosity := opts.CombatVerbosity
+	if combatVerbosity == "" {
+		combatVerbosity = CombatVerbosityNormal
+	}
+	locale := opts.Locale
+	if locale == "" {
+		locale = i18n.DefaultLocale
+	}
 
 	if uid == "" || username == "" || charName == "" || roomID == "" || role == "" {
 		return nil, fmt.Errorf("AddPlayer: uid, username, charName, roomID, and role must be non-empty")
@@ -531,6 +661,10 @@ func (m *Manager) AddPlayer(opts AddPlayerOptions) (*PlayerSession, error) {
 		Class:               class,
 		Level:               level,
 		DefaultCombatAction: defaultCombatAction,
+		CombatVerbosity:     combatVerbosity,
+		AccountID:           opts.AccountID,
+		Locale:              locale,
+		Settings:            opts.Settings,
 		Gender:              opts.Gender,
 		Team:                opts.Team,
 		Entity:              entity,
@@ -554,6 +688,7 @@ func (m *Manager) AddPlayer(opts AddPlayerOptions) (*PlayerSession, error) {
 		InitDone:              make(chan struct{}),
 		Hotbars:               [][10]HotbarSlot{{}},
 		ActiveHotbarIndex:     0,
+		Transcript:            transcript.NewRecorder(),
 	}
 
 	sess.Backpack = inventory.NewBackpack(20, 50.0)
@@ -593,6 +728,8 @@ func (m *Manager) RemovePlayer(uid string) error {
 		}
 	}
 
+	m.dropSnoops(uid)
+
 	// Close entity
 	_ = sess.Entity.Close()
 
@@ -732,6 +869,27 @@ func (m *Manager) GetPlayerByCharNameCI(charName string) *PlayerSession {
 	return nil
 }
 
+// RenamePlayer changes the CharName of the session uid.
+//
+// Precondition: newName must be non-empty.
+// Postcondition: Returns an error when uid has no session or another online
+// player already uses newName case-insensitively; otherwise CharName is updated.
+func (m *Manager) RenamePlayer(uid, newName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sess, ok := m.players[uid]
+	if !ok {
+		return fmt.Errorf("player %q not found", uid)
+	}
+	for otherUID, other := range m.players {
+		if otherUID != uid && strings.EqualFold(other.CharName, newName) {
+			return fmt.Errorf("character name %q is in use", newName)
+		}
+	}
+	sess.CharName = newName
+	return nil
+}
+
 // GetPlayerByCharID returns the PlayerSession whose CharacterID matches id, or nil.
 //
 // Precondition: id must be non-zero for a meaningful lookup.
@@ -932,6 +1090,15 @@ func (m *Manager) BroadcastAll(ev *gamev1.ServerEvent) {
 	}
 }
 
+// CombatNarration returns the level combat is narrated to the player at:
+// verbose in practice mode, otherwise the player's CombatVerbosity.
+func (sess *PlayerSession) CombatNarration() string {
+	if sess.Practice {
+		return CombatVerbosityVerbose
+	}
+	return sess.CombatVerbosity
+}
+
 // GetActiveQuests returns the session's active quest map.
 //
 // Postcondition: satisfies quest.SessionState.
@@ -967,3 +1134,35 @@ func (sess *PlayerSession) GetCurrency() int {
 func (sess *PlayerSession) AddCurrency(delta int) {
 	sess.Currency += delta
 }
+
+// Stash returns the player's stash balance.
+func (sess *PlayerSession) Stash() int {
+	sess.StashMu.Lock()
+	defer sess.StashMu.Unlock()
+	return sess.StashBalance
+}
+
+// CreditStash adds amount to the stash and returns the new balance.
+//
+// Precondition: amount >= 0.
+func (sess *PlayerSession) CreditStash(amount int) int {
+	sess.StashMu.Lock()
+	defer sess.StashMu.Unlock()
+	sess.StashBalance += amount
+	return sess.StashBalance
+}
+
+// DebitStash removes amount from the stash if it holds that much.
+//
+// Precondition: amount >= 0.
+// Postcondition: Returns the resulting balance and true on success, or the
+// unchanged balance and false when the stash is short.
+func (sess *PlayerSession) DebitStash(amount int) (int, bool) {
+	sess.StashMu.Lock()
+	defer sess.StashMu.Unlock()
+	if sess.StashBalance < amount {
+		return sess.StashBalance, false
+	}
+	sess.StashBalance -= amount
+	return sess.StashBalance, true
+}
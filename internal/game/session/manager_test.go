@@ -784,3 +784,38 @@ func TestPlayerSession_RefocusingFields_DefaultZero(t *testing.T) {
 	assert.False(t, sess.RefocusingActive)
 	assert.True(t, sess.RefocusingStartTime.IsZero())
 }
+
+func TestManager_RenamePlayer(t *testing.T) {
+	m := NewManager()
+	for _, name := range []string{"Alice", "Bob"} {
+		_, err := m.AddPlayer(AddPlayerOptions{UID: name, Username: name, CharName: name, RoomID: "room_a", CurrentHP: 10, Role: "player"})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, m.RenamePlayer("Alice", "Alicia"))
+	sess, ok := m.GetPlayerByCharName("Alicia")
+	require.True(t, ok)
+	assert.Equal(t, "Alice", sess.UID)
+	assert.Nil(t, m.GetPlayerByCharNameCI("alice"))
+
+	assert.Error(t, m.RenamePlayer("Alice", "BOB"), "another online player holds the name")
+	assert.Error(t, m.RenamePlayer("nobody", "Carol"))
+	require.NoError(t, m.RenamePlayer("Alice", "ALICIA"), "case-only change of one's own name is allowed")
+}
+
+func TestProperty_NoteCommand_KeepsLatest(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(0, 3*RecentCommandsMax).Draw(rt, "n")
+		sess := &PlayerSession{}
+		for i := 0; i < n; i++ {
+			sess.NoteCommand(fmt.Sprintf("cmd%d", i))
+		}
+		want := min(n, RecentCommandsMax)
+		if len(sess.RecentCommands) != want {
+			rt.Fatalf("kept %d commands, want %d", len(sess.RecentCommands), want)
+		}
+		if n > 0 && sess.RecentCommands[want-1] != fmt.Sprintf("cmd%d", n-1) {
+			rt.Fatalf("last command is %q", sess.RecentCommands[want-1])
+		}
+	})
+}
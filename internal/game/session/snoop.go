@@ -0,0 +1,112 @@
+package session
+
+import "fmt"
+
+// SetInvisible hides or reveals uid from other players.
+//
+// Precondition: uid must be non-empty.
+// Postcondition: Returns an error if the player is not found.
+func (m *Manager) SetInvisible(uid string, on bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sess, ok := m.players[uid]
+	if !ok {
+		return fmt.Errorf("player %q not found", uid)
+	}
+	sess.Invisible = on
+	return nil
+}
+
+// VisiblePlayersInRoom returns the players in roomID that viewerUID can see:
+// everyone except invisible players other than the viewer.
+//
+// Postcondition: Returns a non-nil slice (may be empty).
+func (m *Manager) VisiblePlayersInRoom(roomID, viewerUID string) []*PlayerSession {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make([]*PlayerSession, 0, len(m.roomSets[roomID]))
+	for uid := range m.roomSets[roomID] {
+		if sess, ok := m.players[uid]; ok && (!sess.Invisible || uid == viewerUID) {
+			result = append(result, sess)
+		}
+	}
+	return result
+}
+
+// StartSnoop mirrors targetUID's session to snooperUID, ending any snoop
+// snooperUID already had running.
+//
+// Precondition: snooperUID and targetUID must be non-empty.
+// Postcondition: Returns the UID snooperUID was snooping before, or "".
+// Returns an error, changing nothing, when either player is missing, the
+// target is the snooper, another staff member already snoops the target, or
+// the target (directly or through others) snoops the snooper.
+func (m *Manager) StartSnoop(snooperUID, targetUID string) (previousUID string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.players[snooperUID]; !ok {
+		return "", fmt.Errorf("player %q not found", snooperUID)
+	}
+	target, ok := m.players[targetUID]
+	if !ok {
+		return "", fmt.Errorf("player %q not found", targetUID)
+	}
+	if snooperUID == targetUID {
+		return "", fmt.Errorf("you can't snoop yourself")
+	}
+	if other, ok := m.snoops[targetUID]; ok && other != snooperUID {
+		return "", fmt.Errorf("%s is already being snooped", target.CharName)
+	}
+	// Mirrored output is itself mirrored, so a cycle would echo forever.
+	for uid, ok := m.snoops[snooperUID]; ok; uid, ok = m.snoops[uid] {
+		if uid == targetUID {
+			return "", fmt.Errorf("%s is watching you; snooping back would loop", target.CharName)
+		}
+	}
+	for snooped, by := range m.snoops {
+		if by == snooperUID {
+			previousUID = snooped
+			delete(m.snoops, snooped)
+		}
+	}
+	m.snoops[targetUID] = snooperUID
+	return previousUID, nil
+}
+
+// StopSnoop ends snooperUID's snoop.
+//
+// Postcondition: Returns the UID that was being snooped, or ok false when
+// snooperUID was not snooping anyone.
+func (m *Manager) StopSnoop(snooperUID string) (targetUID string, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for snooped, by := range m.snoops {
+		if by == snooperUID {
+			delete(m.snoops, snooped)
+			return snooped, true
+		}
+	}
+	return "", false
+}
+
+// Snooper returns the UID of the staff member snooping targetUID.
+//
+// Postcondition: ok is false when nobody snoops targetUID.
+func (m *Manager) Snooper(targetUID string) (snooperUID string, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	snooperUID, ok = m.snoops[targetUID]
+	return snooperUID, ok
+}
+
+// dropSnoops ends every snoop uid takes part in, as snooper or target.
+//
+// Precondition: m.mu must be held for writing.
+func (m *Manager) dropSnoops(uid string) {
+	delete(m.snoops, uid)
+	for snooped, by := range m.snoops {
+		if by == uid {
+			delete(m.snoops, snooped)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package session
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestVisiblePlayersInRoom_HidesInvisibleFromOthers(t *testing.T) {
+	mgr := NewManager()
+	addTestPlayer(t, mgr, "staff")
+	addTestPlayer(t, mgr, "pc")
+	require.NoError(t, mgr.SetInvisible("staff", true))
+
+	names := func(viewer string) []string {
+		var out []string
+		for _, p := range mgr.VisiblePlayersInRoom("room-1", viewer) {
+			out = append(out, p.UID)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"pc"}, names("pc"))
+	assert.ElementsMatch(t, []string{"pc", "staff"}, names("staff"), "invisible players see themselves")
+	assert.Empty(t, mgr.VisiblePlayersInRoom("nowhere", "pc"))
+
+	require.NoError(t, mgr.SetInvisible("staff", false))
+	assert.ElementsMatch(t, []string{"pc", "staff"}, names("pc"))
+	assert.Error(t, mgr.SetInvisible("ghost", true))
+}
+
+func TestStartSnoop_Rules(t *testing.T) {
+	mgr := NewManager()
+	for _, uid := range []string{"a", "b", "c"} {
+		addTestPlayer(t, mgr, uid)
+	}
+
+	_, err := mgr.StartSnoop("a", "a")
+	assert.Error(t, err)
+	_, err = mgr.StartSnoop("a", "ghost")
+	assert.Error(t, err)
+	prev, err := mgr.StartSnoop("a", "b")
+	require.NoError(t, err)
+	assert.Empty(t, prev)
+	_, err = mgr.StartSnoop("c", "b")
+	assert.Error(t, err, "one snooper per target")
+	_, err = mgr.StartSnoop("b", "a")
+	assert.Error(t, err, "a snoop cycle is refused")
+
+	prev, err = mgr.StartSnoop("a", "c")
+	require.NoError(t, err, "switching targets")
+	assert.Equal(t, "b", prev)
+	_, ok := mgr.Snooper("b")
+	assert.False(t, ok)
+	by, ok := mgr.Snooper("c")
+	assert.True(t, ok)
+	assert.Equal(t, "a", by)
+
+	target, ok := mgr.StopSnoop("a")
+	assert.True(t, ok)
+	assert.Equal(t, "c", target)
+	_, ok = mgr.StopSnoop("a")
+	assert.False(t, ok)
+}
+
+func TestRemovePlayer_EndsSnoops(t *testing.T) {
+	mgr := NewManager()
+	for _, uid := range []string{"a", "b", "c"} {
+		addTestPlayer(t, mgr, uid)
+	}
+	_, err := mgr.StartSnoop("a", "b")
+	require.NoError(t, err)
+	_, err = mgr.StartSnoop("b", "c")
+	require.NoError(t, err)
+
+	require.NoError(t, mgr.RemovePlayer("b"))
+	_, ok := mgr.Snooper("b")
+	assert.False(t, ok)
+	_, ok = mgr.Snooper("c")
+	assert.False(t, ok)
+}
+
+func TestProperty_SnoopsNeverFormACycle(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		mgr := NewManager()
+		n := rapid.IntRange(2, 6).Draw(rt, "players")
+		for i := 0; i < n; i++ {
+			uid := fmt.Sprintf("p%d", i)
+			if _, err := mgr.AddPlayer(AddPlayerOptions{UID: uid, Username: uid, CharName: uid, RoomID: "r", Role: "player"}); err != nil {
+				rt.Fatal(err)
+			}
+		}
+		ops := rapid.SliceOfN(rapid.IntRange(0, n*n-1), 1, 30).Draw(rt, "ops")
+		for _, op := range ops {
+			_, _ = mgr.StartSnoop(fmt.Sprintf("p%d", op/n), fmt.Sprintf("p%d", op%n))
+		}
+		for start := 0; start < n; start++ {
+			uid := fmt.Sprintf("p%d", start)
+			seen := map[string]bool{uid: true}
+			for next, ok := mgr.Snooper(uid); ok; next, ok = mgr.Snooper(next) {
+				if seen[next] {
+					rt.Fatalf("snoop cycle through %s", next)
+				}
+				seen[next] = true
+			}
+		}
+	})
+}
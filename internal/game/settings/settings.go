@@ -0,0 +1,219 @@
+// Package settings defines account-level player preferences that persist
+// across characters and frontends.
+package settings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// Color themes a frontend may render with.
+const (
+	ThemeDefault      = "default"
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high_contrast"
+)
+
+// Themes lists every valid color theme.
+var Themes = []string{ThemeDefault, ThemeDark, ThemeLight, ThemeHighContrast}
+
+// Chat channels a player may mute.
+const (
+	ChannelSay   = "say"
+	ChannelEmote = "emote"
+)
+
+// Channels lists every mutable chat channel.
+var Channels = []string{ChannelSay, ChannelEmote}
+
+// Page lengths for the --More-- pager. A positive PageLength between
+// MinPageLength and MaxPageLength pages after that many lines.
+const (
+	// PageLengthAuto pages at the terminal height.
+	PageLengthAuto = 0
+	// PageLengthOff disables paging.
+	PageLengthOff = -1
+	MinPageLength = 10
+	MaxPageLength = 200
+)
+
+// MaxPromptFormatLength bounds a custom prompt format in bytes.
+const MaxPromptFormatLength = 120
+
+// Settings holds one account's preferences. The zero value is the default
+// for every field.
+type Settings struct {
+	// ColorTheme is one of Themes; empty means ThemeDefault.
+	ColorTheme string `json:"color_theme,omitempty"`
+	// CombatVerbosity is the preferred combat narration level; empty defers
+	// to the character's own setting.
+	CombatVerbosity string `json:"combat_verbosity,omitempty"`
+	// AccessibleMode requests screen-reader friendly output, such as hit points
+	// shown as numbers instead of wound descriptors.
+	AccessibleMode bool `json:"accessible_mode,omitempty"`
+	// MutedChannels lists chat channels the player does not receive, sorted.
+	MutedChannels []string `json:"muted_channels,omitempty"`
+	// PageLength is the number of lines shown before a --More-- prompt, or
+	// PageLengthAuto or PageLengthOff.
+	PageLength int `json:"page_length,omitempty"`
+	// PromptFormat is the player's custom prompt with %-tokens the frontend
+	// expands; empty means the frontend's default prompt.
+	PromptFormat string `json:"prompt_format,omitempty"`
+}
+
+// IsValidPromptFormat reports whether format fits MaxPromptFormatLength and
+// holds only printable characters. Tokens are checked by the frontend that
+// expands them.
+func IsValidPromptFormat(format string) bool {
+	if len(format) > MaxPromptFormatLength || !utf8.ValidString(format) {
+		return false
+	}
+	for _, r := range format {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidPageLength reports whether n is PageLengthAuto, PageLengthOff, or
+// within MinPageLength and MaxPageLength.
+func IsValidPageLength(n int) bool {
+	return n == PageLengthAuto || n == PageLengthOff || (n >= MinPageLength && n <= MaxPageLength)
+}
+
+// IsValidTheme reports whether theme is one of Themes.
+func IsValidTheme(theme string) bool {
+	for _, t := range Themes {
+		if t == theme {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidChannel reports whether channel is one of Channels.
+func IsValidChannel(channel string) bool {
+	for _, c := range Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate reports the first invalid theme, channel, page length, or prompt
+// format in s.
+//
+// Postcondition: Returns nil when every field holds an accepted value.
+func (s Settings) Validate() error {
+	if s.ColorTheme != "" && !IsValidTheme(s.ColorTheme) {
+		return fmt.Errorf("invalid color theme %q; valid themes: %s", s.ColorTheme, strings.Join(Themes, ", "))
+	}
+	for _, c := range s.MutedChannels {
+		if !IsValidChannel(c) {
+			return fmt.Errorf("invalid channel %q; valid channels: %s", c, strings.Join(Channels, ", "))
+		}
+	}
+	if !IsValidPageLength(s.PageLength) {
+		return fmt.Errorf("invalid page length %d; use %d-%d lines, auto, or off", s.PageLength, MinPageLength, MaxPageLength)
+	}
+	if !IsValidPromptFormat(s.PromptFormat) {
+		return fmt.Errorf("invalid prompt format; use at most %d printable characters", MaxPromptFormatLength)
+	}
+	return nil
+}
+
+// Theme returns the effective color theme.
+func (s Settings) Theme() string {
+	if s.ColorTheme == "" {
+		return ThemeDefault
+	}
+	return s.ColorTheme
+}
+
+// Muted reports whether channel is muted.
+func (s Settings) Muted(channel string) bool {
+	for _, c := range s.MutedChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Mute adds channel to the muted set.
+//
+// Postcondition: MutedChannels contains channel exactly once and stays sorted.
+func (s *Settings) Mute(channel string) {
+	if s.Muted(channel) {
+		return
+	}
+	s.MutedChannels = append(s.MutedChannels, channel)
+	sort.Strings(s.MutedChannels)
+}
+
+// Unmute removes channel from the muted set.
+//
+// Postcondition: MutedChannels does not contain channel.
+func (s *Settings) Unmute(channel string) {
+	kept := s.MutedChannels[:0]
+	for _, c := range s.MutedChannels {
+		if c != channel {
+			kept = append(kept, c)
+		}
+	}
+	if len(kept) == 0 {
+		kept = nil
+	}
+	s.MutedChannels = kept
+}
+
+// ToProto converts s to its wire form.
+//
+// Postcondition: Returns a non-nil message.
+func (s Settings) ToProto() *gamev1.AccountSettings {
+	return &gamev1.AccountSettings{
+		ColorTheme:      s.ColorTheme,
+		CombatVerbosity: s.CombatVerbosity,
+		AccessibleMode:  s.AccessibleMode,
+		MutedChannels:   append([]string(nil), s.MutedChannels...),
+		PageLength:      int32(s.PageLength),
+		PromptFormat:    s.PromptFormat,
+	}
+}
+
+// FromProto converts a wire message to Settings, dropping unknown themes,
+// channels, page lengths, and prompt formats so a stale client cannot inject
+// invalid values.
+//
+// Postcondition: A nil message yields the zero Settings; the result always passes Validate.
+func FromProto(p *gamev1.AccountSettings) Settings {
+	var s Settings
+	if p == nil {
+		return s
+	}
+	if IsValidTheme(p.GetColorTheme()) {
+		s.ColorTheme = p.GetColorTheme()
+	}
+	s.CombatVerbosity = p.GetCombatVerbosity()
+	s.AccessibleMode = p.GetAccessibleMode()
+	for _, c := range p.GetMutedChannels() {
+		if IsValidChannel(c) {
+			s.Mute(c)
+		}
+	}
+	if n := int(p.GetPageLength()); IsValidPageLength(n) {
+		s.PageLength = n
+	}
+	if IsValidPromptFormat(p.GetPromptFormat()) {
+		s.PromptFormat = p.GetPromptFormat()
+	}
+	return s
+}
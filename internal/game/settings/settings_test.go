@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestSettings_ZeroValueDefaults(t *testing.T) {
+	var s Settings
+	assert.Equal(t, ThemeDefault, s.Theme())
+	assert.False(t, s.Muted(ChannelSay))
+	assert.NoError(t, s.Validate())
+}
+
+func TestSettings_Validate(t *testing.T) {
+	assert.Error(t, Settings{ColorTheme: "neon"}.Validate())
+	assert.Error(t, Settings{MutedChannels: []string{"ooc"}}.Validate())
+	assert.NoError(t, Settings{ColorTheme: ThemeHighContrast, MutedChannels: []string{ChannelEmote}}.Validate())
+	assert.Error(t, Settings{PageLength: MinPageLength - 1}.Validate())
+	assert.Error(t, Settings{PageLength: -2}.Validate())
+	assert.NoError(t, Settings{PageLength: PageLengthOff}.Validate())
+	assert.NoError(t, Settings{PageLength: MaxPageLength}.Validate())
+	assert.NoError(t, Settings{PromptFormat: "%hp/%maxhp %ap %time> "}.Validate())
+	assert.Error(t, Settings{PromptFormat: "\x1b[2J"}.Validate())
+	assert.Error(t, Settings{PromptFormat: strings.Repeat("x", MaxPromptFormatLength+1)}.Validate())
+}
+
+func TestSettings_MuteUnmute(t *testing.T) {
+	var s Settings
+	s.Mute(ChannelSay)
+	s.Mute(ChannelEmote)
+	s.Mute(ChannelSay)
+	assert.Equal(t, []string{ChannelEmote, ChannelSay}, s.MutedChannels)
+	s.Unmute(ChannelEmote)
+	assert.Equal(t, []string{ChannelSay}, s.MutedChannels)
+	s.Unmute(ChannelSay)
+	assert.Nil(t, s.MutedChannels)
+}
+
+func TestFromProto_DropsInvalidValues(t *testing.T) {
+	s := FromProto(&gamev1.AccountSettings{
+		ColorTheme:     "neon",
+		AccessibleMode: true,
+		MutedChannels:  []string{"say", "ooc", "say"},
+		PageLength:     3,
+		PromptFormat:   "bad\r\n",
+	})
+	assert.Equal(t, Settings{AccessibleMode: true, MutedChannels: []string{ChannelSay}}, s)
+	assert.Equal(t, Settings{}, FromProto(nil))
+}
+
+// TestProperty_ProtoRoundTrip verifies valid settings survive conversion to and from the wire form.
+func TestProperty_ProtoRoundTrip(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		var s Settings
+		s.ColorTheme = rapid.SampledFrom(append([]string{""}, Themes...)).Draw(rt, "theme")
+		s.CombatVerbosity = rapid.SampledFrom([]string{"", "brief", "normal", "verbose"}).Draw(rt, "verbosity")
+		s.AccessibleMode = rapid.Bool().Draw(rt, "accessible")
+		s.PageLength = rapid.OneOf(
+			rapid.SampledFrom([]int{PageLengthAuto, PageLengthOff}),
+			rapid.IntRange(MinPageLength, MaxPageLength),
+		).Draw(rt, "page_length")
+		s.PromptFormat = rapid.StringMatching(`[ -~]{0,40}`).Draw(rt, "prompt_format")
+		for _, c := range rapid.SliceOf(rapid.SampledFrom(Channels)).Draw(rt, "muted") {
+			s.Mute(c)
+		}
+		got := FromProto(s.ToProto())
+		if !assert.ObjectsAreEqual(s, got) {
+			rt.Fatalf("round trip changed %+v to %+v", s, got)
+		}
+	})
+}
@@ -0,0 +1,237 @@
+// Package social models a non-lethal social encounter: a contest of words
+// against an NPC's resolve, fought in rounds like combat. Each round the
+// player has a pool of action points to spend on social "attacks" —
+// persuading, intimidating, or deceiving — each a skill check against the
+// NPC's will DC. A hit wears the NPC's resolve down; the player wins when it
+// breaks and loses when the NPC's patience runs out first.
+package social
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/skillcheck"
+)
+
+// Approach is the kind of social attack.
+type Approach string
+
+const (
+	// Persuade appeals to reason and self-interest (Smooth Talk).
+	Persuade Approach = "persuade"
+	// Intimidate threatens (Hard Look).
+	Intimidate Approach = "intimidate"
+	// Deceive lies and bluffs (Hustle).
+	Deceive Approach = "deceive"
+)
+
+// APPerRound is the action points a player has each round of an encounter.
+const APPerRound = 3
+
+// AttackCost is the action points one social attack costs.
+const AttackCost = 1
+
+// DefaultPatience is how many rounds an NPC sits through before it has
+// heard enough.
+const DefaultPatience = 3
+
+// Source supplies random numbers for rolls.
+type Source interface {
+	Intn(n int) int
+}
+
+// ParseApproach returns the approach named s.
+//
+// Postcondition: ok is false for anything but persuade, intimidate, or deceive.
+func ParseApproach(s string) (Approach, bool) {
+	switch Approach(s) {
+	case Persuade, Intimidate, Deceive:
+		return Approach(s), true
+	}
+	return "", false
+}
+
+// Skill returns the skill ID a social attack with a checks.
+func (a Approach) Skill() string {
+	switch a {
+	case Intimidate:
+		return "hard_look"
+	case Deceive:
+		return "hustle"
+	default:
+		return "smooth_talk"
+	}
+}
+
+// Encounter is one player's social contest with one NPC.
+type Encounter struct {
+	// NPCID is the instance ID of the NPC being worked on.
+	NPCID string
+	// NPCName is the NPC's display name.
+	NPCName string
+	// RoomID is where the encounter takes place; it ends if the player leaves.
+	RoomID string
+	// DC is the NPC's will DC each social attack rolls against.
+	DC int
+	// Resolve is how much more pressure the NPC can take; it breaks at 0.
+	Resolve int
+	// MaxResolve is the NPC's starting resolve.
+	MaxResolve int
+	// Round is the current round, starting at 1.
+	Round int
+	// Patience is the last round the NPC will sit through.
+	Patience int
+	// Queue holds the player's action points for the round.
+	Queue *combat.ActionQueue
+	// attacksThisRound counts the social attacks made this round.
+	attacksThisRound int
+}
+
+// NewEncounter opens an encounter between player uid and an NPC of the
+// given level and Savvy score. A broken NPC — one that has already
+// surrendered — starts with half its resolve.
+//
+// Precondition: uid and npcID non-empty; level >= 0.
+// Postcondition: The encounter is in round 1 with a full AP pool.
+func NewEncounter(uid, npcID, npcName, roomID string, level, savvy int, broken bool) *Encounter {
+	mod := abilityMod(savvy)
+	resolve := max(6+2*level+mod, 4)
+	if broken {
+		resolve = max(resolve/2, 2)
+	}
+	return &Encounter{
+		NPCID:      npcID,
+		NPCName:    npcName,
+		RoomID:     roomID,
+		DC:         10 + level + mod,
+		Resolve:    resolve,
+		MaxResolve: resolve,
+		Round:      1,
+		Patience:   DefaultPatience,
+		Queue:      combat.NewActionQueue(uid, APPerRound),
+	}
+}
+
+// AttackResult reports one social attack.
+type AttackResult struct {
+	Approach Approach
+	Check    skillcheck.CheckResult
+	// DC is the will DC the check was made against.
+	DC int
+	// Penalty is the pressure penalty on the check for repeated attacks this
+	// round; it is included in Check.AbilityMod.
+	Penalty int
+	// Damage is the resolve the attack took away; negative when a critical
+	// failure stiffened the NPC's resolve instead.
+	Damage int
+	// Broken reports whether the NPC's resolve broke.
+	Broken bool
+}
+
+// Attack makes one social attack with approach a: a d20 skill check plus
+// abilityMod and rank's proficiency against the NPC's will DC. Like attacks
+// in combat, the second attack of a round takes -5 and later ones -10. A
+// success wears away 1d6 plus the ability modifier, a critical success twice
+// that; a critical failure hardens the NPC, restoring 1d4 resolve.
+//
+// Precondition: src must not be nil.
+// Postcondition: Returns an error without rolling when the round's AP is
+// spent or the encounter is already decided.
+func (e *Encounter) Attack(a Approach, abilityMod int, rank string, src Source) (AttackResult, error) {
+	if e.Won() || e.Lost() {
+		return AttackResult{}, fmt.Errorf("the conversation with %s is over", e.NPCName)
+	}
+	if err := e.Queue.DeductAP(AttackCost); err != nil {
+		return AttackResult{}, err
+	}
+	penalty := pressurePenalty(e.attacksThisRound)
+	e.attacksThisRound++
+	check := skillcheck.Resolve(src.Intn(20)+1, abilityMod+penalty, rank, e.DC, skillcheck.TriggerDef{})
+	res := AttackResult{Approach: a, Check: check, DC: e.DC, Penalty: penalty}
+	switch check.Outcome {
+	case skillcheck.CritSuccess:
+		res.Damage = 2 * max(src.Intn(6)+1+abilityMod, 1)
+	case skillcheck.Success:
+		res.Damage = max(src.Intn(6)+1+abilityMod, 1)
+	case skillcheck.CritFailure:
+		res.Damage = -min(src.Intn(4)+1, e.MaxResolve-e.Resolve)
+	}
+	e.Resolve = max(e.Resolve-res.Damage, 0)
+	res.Broken = e.Resolve == 0
+	return res, nil
+}
+
+// EndRound closes the current round and, unless the NPC's patience has run
+// out, starts the next with a fresh AP pool.
+//
+// Postcondition: Round is incremented; the AP pool and pressure penalty are reset.
+func (e *Encounter) EndRound() {
+	e.Round++
+	e.attacksThisRound = 0
+	e.Queue = combat.NewActionQueue(e.Queue.UID, APPerRound)
+}
+
+// Won reports whether the NPC's resolve has broken.
+func (e *Encounter) Won() bool { return e.Resolve <= 0 }
+
+// Lost reports whether the NPC's patience ran out before its resolve broke.
+func (e *Encounter) Lost() bool { return !e.Won() && e.Round > e.Patience }
+
+// Status describes the encounter, e.g. "Ganger: resolve 5/9, round 2 of 3, 2 AP left."
+func (e *Encounter) Status() string {
+	return fmt.Sprintf("%s: resolve %d/%d, round %d of %d, %d AP left.",
+		e.NPCName, e.Resolve, e.MaxResolve, min(e.Round, e.Patience), e.Patience, e.Queue.RemainingPoints())
+}
+
+// Narrative describes res, e.g. "You persuade Ganger (14 vs DC 13): success, resolve -4."
+func (res AttackResult) Narrative(npcName string) string {
+	msg := fmt.Sprintf("You %s %s (%d vs DC %d", res.Approach, npcName, res.Check.Total, res.DC)
+	if res.Penalty != 0 {
+		msg += fmt.Sprintf(", %d pressure", res.Penalty)
+	}
+	msg += fmt.Sprintf("): %s", outcomeLabel(res.Check.Outcome))
+	switch {
+	case res.Damage > 0:
+		msg += fmt.Sprintf(", resolve -%d.", res.Damage)
+	case res.Damage < 0:
+		msg += fmt.Sprintf(" — they dig in, resolve +%d.", -res.Damage)
+	default:
+		msg += "."
+	}
+	return msg
+}
+
+// outcomeLabel returns the player-facing name of o.
+func outcomeLabel(o skillcheck.CheckOutcome) string {
+	switch o {
+	case skillcheck.CritSuccess:
+		return "critical success"
+	case skillcheck.Success:
+		return "success"
+	case skillcheck.Failure:
+		return "failure"
+	default:
+		return "critical failure"
+	}
+}
+
+// pressurePenalty returns the penalty for the social attack after
+// attacksMade earlier ones this round: 0, then -5, then -10.
+func pressurePenalty(attacksMade int) int {
+	switch {
+	case attacksMade <= 0:
+		return 0
+	case attacksMade == 1:
+		return -5
+	default:
+		return -10
+	}
+}
+
+// abilityMod converts an ability score to its modifier.
+func abilityMod(score int) int {
+	if score < 10 {
+		return (score - 11) / 2
+	}
+	return (score - 10) / 2
+}
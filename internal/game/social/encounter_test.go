@@ -0,0 +1,114 @@
+package social_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/skillcheck"
+	"github.com/cory-johannsen/mud/internal/game/social"
+)
+
+// seqSrc returns its values in turn, each clamped to [0, n).
+type seqSrc struct{ vals []int }
+
+func (s *seqSrc) Intn(n int) int {
+	v := s.vals[0]
+	if len(s.vals) > 1 {
+		s.vals = s.vals[1:]
+	}
+	return min(max(v, 0), n-1)
+}
+
+func TestParseApproach(t *testing.T) {
+	a, ok := social.ParseApproach("intimidate")
+	require.True(t, ok)
+	assert.Equal(t, "hard_look", a.Skill())
+	assert.Equal(t, "hustle", social.Deceive.Skill())
+	assert.Equal(t, "smooth_talk", social.Persuade.Skill())
+	_, ok = social.ParseApproach("punch")
+	assert.False(t, ok)
+}
+
+func TestNewEncounter_BrokenNPCHasHalfResolve(t *testing.T) {
+	e := social.NewEncounter("u1", "n1", "Ganger", "r", 2, 10, false)
+	assert.Equal(t, 12, e.DC)
+	assert.Equal(t, 10, e.Resolve)
+	broken := social.NewEncounter("u1", "n1", "Ganger", "r", 2, 10, true)
+	assert.Equal(t, 5, broken.Resolve)
+	assert.Equal(t, 5, broken.MaxResolve)
+}
+
+func TestAttack_SuccessesBreakResolveWithPressurePenalty(t *testing.T) {
+	e := social.NewEncounter("u1", "n1", "Ganger", "r", 2, 10, false)
+	src := &seqSrc{vals: []int{14}}
+
+	first, err := e.Attack(social.Persuade, 2, "trained", src)
+	require.NoError(t, err)
+	assert.Equal(t, skillcheck.Success, first.Check.Outcome)
+	assert.Zero(t, first.Penalty)
+	assert.Equal(t, 8, first.Damage)
+	assert.Equal(t, 2, e.Resolve)
+	assert.Equal(t, "You persuade Ganger (19 vs DC 12): success, resolve -8.", first.Narrative("Ganger"))
+
+	second, err := e.Attack(social.Intimidate, 2, "trained", src)
+	require.NoError(t, err)
+	assert.Equal(t, -5, second.Penalty)
+	assert.Equal(t, 14, second.Check.Total)
+	assert.True(t, second.Broken)
+	assert.True(t, e.Won())
+	_, err = e.Attack(social.Deceive, 2, "trained", src)
+	assert.Error(t, err, "a won encounter is over")
+}
+
+func TestAttack_CritFailureHardensResolve(t *testing.T) {
+	e := social.NewEncounter("u1", "n1", "Ganger", "r", 2, 10, false)
+	_, err := e.Attack(social.Persuade, 0, "", &seqSrc{vals: []int{14, 5}})
+	require.NoError(t, err)
+	require.Equal(t, 4, e.Resolve)
+
+	res, err := e.Attack(social.Deceive, 0, "", &seqSrc{vals: []int{0, 3}})
+	require.NoError(t, err)
+	assert.Equal(t, skillcheck.CritFailure, res.Check.Outcome)
+	assert.Equal(t, -4, res.Damage)
+	assert.Equal(t, 8, e.Resolve)
+	assert.Contains(t, res.Narrative("Ganger"), "they dig in, resolve +4")
+}
+
+func TestAttack_APAndPatience(t *testing.T) {
+	e := social.NewEncounter("u1", "n1", "Ganger", "r", 10, 18, false)
+	src := &seqSrc{vals: []int{5}}
+	for i := 0; i < social.APPerRound; i++ {
+		_, err := e.Attack(social.Persuade, 0, "", src)
+		require.NoError(t, err)
+	}
+	_, err := e.Attack(social.Persuade, 0, "", src)
+	assert.Error(t, err, "the round's AP is spent")
+
+	for e.Round <= e.Patience {
+		assert.False(t, e.Lost())
+		e.EndRound()
+	}
+	assert.True(t, e.Lost())
+	_, err = e.Attack(social.Persuade, 0, "", src)
+	assert.Error(t, err)
+}
+
+func TestAttack_ResolveStaysInRange(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		e := social.NewEncounter("u1", "n1", "X", "r",
+			rapid.IntRange(0, 20).Draw(rt, "level"), rapid.IntRange(3, 20).Draw(rt, "savvy"), rapid.Bool().Draw(rt, "broken"))
+		src := &seqSrc{vals: rapid.SliceOfN(rapid.IntRange(0, 19), 1, 30).Draw(rt, "rolls")}
+		mod := rapid.IntRange(-2, 6).Draw(rt, "mod")
+		for range 9 {
+			if _, err := e.Attack(social.Persuade, mod, "trained", src); err != nil {
+				e.EndRound()
+			}
+			if e.Resolve < 0 || e.Resolve > e.MaxResolve {
+				rt.Fatalf("resolve %d outside [0, %d]", e.Resolve, e.MaxResolve)
+			}
+		}
+	})
+}
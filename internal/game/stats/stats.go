@@ -0,0 +1,167 @@
+// Package stats tracks per-character lifetime statistics — kills, deaths,
+// damage dealt, rooms explored, and playtime — and the leaderboards ranked on them.
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stat names one tracked statistic.
+type Stat string
+
+const (
+	// Kills counts NPCs killed.
+	Kills Stat = "kills"
+	// Deaths counts times the character died.
+	Deaths Stat = "deaths"
+	// Damage totals damage dealt in combat.
+	Damage Stat = "damage"
+	// Explored counts rooms discovered.
+	Explored Stat = "explored"
+	// Playtime totals seconds spent logged in. It is credited from play-session
+	// records as they are extended, not through a Tally.
+	Playtime Stat = "playtime"
+)
+
+// All lists every stat in display order.
+var All = []Stat{Kills, Deaths, Damage, Explored, Playtime}
+
+// aliases maps accepted spellings to stats.
+var aliases = map[string]Stat{
+	"kills": Kills, "kill": Kills,
+	"deaths": Deaths, "death": Deaths,
+	"damage": Damage, "dmg": Damage,
+	"explored": Explored, "explore": Explored, "rooms": Explored,
+	"playtime": Playtime, "played": Playtime, "time": Playtime,
+}
+
+// Parse resolves name, case-insensitively, to a stat.
+//
+// Postcondition: Returns a non-nil error naming the valid stats when name is unknown.
+func Parse(name string) (Stat, error) {
+	if st, ok := aliases[strings.ToLower(name)]; ok {
+		return st, nil
+	}
+	names := make([]string, len(All))
+	for i, st := range All {
+		names[i] = string(st)
+	}
+	return "", fmt.Errorf("unknown stat %q; choose one of: %s", name, strings.Join(names, ", "))
+}
+
+// Label returns the column heading for st.
+func (st Stat) Label() string {
+	switch st {
+	case Kills:
+		return "Kills"
+	case Deaths:
+		return "Deaths"
+	case Damage:
+		return "Damage Dealt"
+	case Explored:
+		return "Rooms Explored"
+	case Playtime:
+		return "Playtime"
+	default:
+		return string(st)
+	}
+}
+
+// Format renders value for st; playtime is shown in hours and minutes.
+func (st Stat) Format(value int64) string {
+	if st == Playtime {
+		d := time.Duration(value) * time.Second
+		return fmt.Sprintf("%dh %02dm", int64(d.Hours()), int64(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%d", value)
+}
+
+// Tally accumulates one session's stat increments until they are flushed to
+// storage. It is safe for concurrent use.
+type Tally struct {
+	mu      sync.Mutex
+	pending map[Stat]int64
+}
+
+// NewTally returns an empty tally.
+func NewTally() *Tally {
+	return &Tally{pending: make(map[Stat]int64)}
+}
+
+// Add increments st by n.
+//
+// Precondition: n >= 0.
+func (t *Tally) Add(st Stat, n int64) {
+	if n <= 0 {
+		return
+	}
+	t.mu.Lock()
+	t.pending[st] += n
+	t.mu.Unlock()
+}
+
+// Drain returns the pending increments and resets the tally.
+//
+// Postcondition: Returns nil when nothing is pending.
+func (t *Tally) Drain() map[Stat]int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.pending) == 0 {
+		return nil
+	}
+	out := t.pending
+	t.pending = make(map[Stat]int64)
+	return out
+}
+
+// Restore returns increments that Drain produced but could not be flushed, so
+// the next flush retries them.
+func (t *Tally) Restore(deltas map[Stat]int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for st, n := range deltas {
+		t.pending[st] += n
+	}
+}
+
+// Entry is one row of a leaderboard.
+type Entry struct {
+	Rank  int
+	Name  string
+	Value int64
+}
+
+// Board caches the most recently computed leaderboards. It is safe for concurrent use.
+type Board struct {
+	mu         sync.RWMutex
+	tops       map[Stat][]Entry
+	computedAt time.Time
+}
+
+// NewBoard returns an empty board.
+func NewBoard() *Board {
+	return &Board{tops: make(map[Stat][]Entry)}
+}
+
+// Set replaces every leaderboard with tops, computed at at.
+func (b *Board) Set(tops map[Stat][]Entry, at time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tops = tops
+	b.computedAt = at
+}
+
+// Top returns the leaderboard for st and when it was computed.
+//
+// Postcondition: ok is false when no rollup has completed yet.
+func (b *Board) Top(st Stat) (entries []Entry, computedAt time.Time, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.computedAt.IsZero() {
+		return nil, time.Time{}, false
+	}
+	return append([]Entry(nil), b.tops[st]...), b.computedAt, true
+}
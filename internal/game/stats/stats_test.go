@@ -0,0 +1,92 @@
+package stats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/stats"
+)
+
+func TestParse(t *testing.T) {
+	for name, want := range map[string]stats.Stat{
+		"kills": stats.Kills, "DMG": stats.Damage, "rooms": stats.Explored, "played": stats.Playtime, "death": stats.Deaths,
+	} {
+		got, err := stats.Parse(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+	_, err := stats.Parse("gold")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "kills, deaths, damage, explored, playtime")
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "42", stats.Kills.Format(42))
+	assert.Equal(t, "2h 05m", stats.Playtime.Format(2*3600+5*60+59))
+}
+
+func TestTally_Drain(t *testing.T) {
+	tally := stats.NewTally()
+	assert.Nil(t, tally.Drain(), "nothing pending")
+
+	tally.Add(stats.Kills, 2)
+	tally.Add(stats.Damage, 0)
+	assert.Equal(t, map[stats.Stat]int64{stats.Kills: 2}, tally.Drain())
+	assert.Nil(t, tally.Drain(), "draining resets the tally")
+}
+
+func TestTally_Restore(t *testing.T) {
+	tally := stats.NewTally()
+	tally.Add(stats.Deaths, 1)
+	failed := tally.Drain()
+	tally.Add(stats.Deaths, 1)
+	tally.Restore(failed)
+	assert.Equal(t, map[stats.Stat]int64{stats.Deaths: 2}, tally.Drain())
+}
+
+func TestBoard(t *testing.T) {
+	b := stats.NewBoard()
+	_, _, ok := b.Top(stats.Kills)
+	assert.False(t, ok)
+
+	at := time.Now()
+	b.Set(map[stats.Stat][]stats.Entry{stats.Kills: {{Rank: 1, Name: "Ace", Value: 9}}}, at)
+	entries, computedAt, ok := b.Top(stats.Kills)
+	require.True(t, ok)
+	assert.Equal(t, at, computedAt)
+	assert.Equal(t, []stats.Entry{{Rank: 1, Name: "Ace", Value: 9}}, entries)
+	entries, _, ok = b.Top(stats.Deaths)
+	assert.True(t, ok)
+	assert.Empty(t, entries)
+}
+
+// TestProperty_Tally_ConservesIncrements verifies that the increments drained
+// from a tally, across any interleaving of adds and drains, sum to what was added.
+func TestProperty_Tally_ConservesIncrements(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		tally := stats.NewTally()
+		added := map[stats.Stat]int64{}
+		drained := map[stats.Stat]int64{}
+		steps := rapid.IntRange(1, 40).Draw(rt, "steps")
+		for i := 0; i < steps; i++ {
+			if rapid.Bool().Draw(rt, "drain") {
+				for st, n := range tally.Drain() {
+					drained[st] += n
+				}
+				continue
+			}
+			st := rapid.SampledFrom([]stats.Stat{stats.Kills, stats.Deaths, stats.Damage, stats.Explored}).Draw(rt, "stat")
+			n := rapid.Int64Range(1, 100).Draw(rt, "n")
+			tally.Add(st, n)
+			added[st] += n
+		}
+		for st, n := range tally.Drain() {
+			drained[st] += n
+		}
+		assert.Equal(rt, added, drained)
+	})
+}
@@ -0,0 +1,142 @@
+// Package transcript records the text a player sees during a session so it
+// can be saved and downloaded later — for roleplay logs or bug reports.
+package transcript
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxBytes caps the size of one transcript body. Output past the cap is
+// dropped and the transcript is marked truncated.
+const MaxBytes = 512 * 1024
+
+// ansiEscape matches terminal color and cursor sequences, which are dropped
+// from transcripts.
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// truncatedNote ends a transcript that hit MaxBytes.
+const truncatedNote = "[transcript truncated]\n"
+
+// Transcript is one finished recording.
+type Transcript struct {
+	ID          int64
+	CharacterID int64
+	CharName    string
+	StartedAt   time.Time
+	EndedAt     time.Time
+	Body        string
+}
+
+// Recorder captures one session's output while logging is on.
+//
+// Postcondition: All methods are safe for concurrent use.
+type Recorder struct {
+	mu        sync.Mutex
+	active    bool
+	startedAt time.Time
+	buf       strings.Builder
+	truncated bool
+	lastRoom  string
+}
+
+// NewRecorder returns a Recorder that is not logging.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Start begins a new recording at now.
+//
+// Postcondition: Returns false, changing nothing, when already logging.
+func (r *Recorder) Start(now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.active {
+		return false
+	}
+	r.active = true
+	r.startedAt = now
+	r.buf.Reset()
+	r.truncated = false
+	r.lastRoom = ""
+	return true
+}
+
+// Stop ends the recording at now and returns it.
+//
+// Postcondition: Returns ok false when not logging; otherwise the recorder is
+// idle and the returned Transcript carries the times and body.
+func (r *Recorder) Stop(now time.Time) (Transcript, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.active {
+		return Transcript{}, false
+	}
+	body := r.buf.String()
+	if r.truncated {
+		body += truncatedNote
+	}
+	t := Transcript{StartedAt: r.startedAt, EndedAt: now, Body: body}
+	r.active = false
+	r.buf.Reset()
+	return t, true
+}
+
+// Active reports whether the recorder is logging.
+func (r *Recorder) Active() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.active
+}
+
+// StartedAt returns when the current recording began.
+//
+// Postcondition: Returns the zero time when not logging.
+func (r *Recorder) StartedAt() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.active {
+		return time.Time{}
+	}
+	return r.startedAt
+}
+
+// Write appends text, stamped with now, when logging.
+//
+// Postcondition: Blank text is ignored; nothing is recorded past MaxBytes.
+func (r *Recorder) Write(now time.Time, text string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.write(now, text)
+}
+
+// WriteRoom appends a room description, skipping it when roomID is the room
+// last written. Room views are re-sent on a timer, and a transcript full of the
+// same room is useless.
+//
+// Postcondition: lastRoom is roomID when the recorder is logging.
+func (r *Recorder) WriteRoom(now time.Time, roomID, text string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.active || roomID == r.lastRoom {
+		return
+	}
+	r.lastRoom = roomID
+	r.write(now, text)
+}
+
+// write appends text under the lock.
+func (r *Recorder) write(now time.Time, text string) {
+	text = strings.TrimRight(ansiEscape.ReplaceAllString(text, ""), "\r\n ")
+	if !r.active || r.truncated || strings.TrimSpace(text) == "" {
+		return
+	}
+	line := "[" + now.Format("15:04:05") + "] " + strings.ReplaceAll(text, "\r\n", "\n") + "\n"
+	if r.buf.Len()+len(line) > MaxBytes {
+		r.truncated = true
+		return
+	}
+	r.buf.WriteString(line)
+}
@@ -0,0 +1,84 @@
+package transcript_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+)
+
+var t0 = time.Date(2026, 3, 1, 20, 15, 0, 0, time.UTC)
+
+func TestRecorder_RecordsOnlyWhileActive(t *testing.T) {
+	r := transcript.NewRecorder()
+	r.Write(t0, "before")
+	require.True(t, r.Start(t0))
+	assert.False(t, r.Start(t0.Add(time.Minute)), "already logging")
+	assert.Equal(t, t0, r.StartedAt())
+
+	r.Write(t0.Add(time.Second), "\x1b[97mMira says: hello\x1b[0m\r\n")
+	r.Write(t0.Add(2*time.Second), "   ")
+	got, ok := r.Stop(t0.Add(time.Hour))
+	require.True(t, ok)
+	assert.Equal(t, "[20:15:01] Mira says: hello\n", got.Body)
+	assert.Equal(t, t0, got.StartedAt)
+	assert.Equal(t, t0.Add(time.Hour), got.EndedAt)
+
+	r.Write(t0, "after")
+	_, ok = r.Stop(t0)
+	assert.False(t, ok)
+	assert.False(t, r.Active())
+	assert.True(t, r.StartedAt().IsZero())
+}
+
+func TestRecorder_WriteRoomSkipsRepeats(t *testing.T) {
+	r := transcript.NewRecorder()
+	r.WriteRoom(t0, "a", "ignored while idle")
+	r.Start(t0)
+	r.WriteRoom(t0, "a", "Room A")
+	r.WriteRoom(t0, "a", "Room A")
+	r.WriteRoom(t0, "b", "Room B")
+	r.WriteRoom(t0, "a", "Room A")
+	got, _ := r.Stop(t0)
+	assert.Equal(t, 3, strings.Count(got.Body, "\n"))
+
+	r.Start(t0)
+	r.WriteRoom(t0, "a", "Room A")
+	got, _ = r.Stop(t0)
+	assert.Contains(t, got.Body, "Room A", "a new recording starts fresh")
+}
+
+func TestRecorder_Truncates(t *testing.T) {
+	r := transcript.NewRecorder()
+	r.Start(t0)
+	line := strings.Repeat("x", 1000)
+	for i := 0; i < transcript.MaxBytes/1000+10; i++ {
+		r.Write(t0, line)
+	}
+	got, _ := r.Stop(t0)
+	assert.LessOrEqual(t, len(got.Body), transcript.MaxBytes+len("[transcript truncated]\n"))
+	assert.True(t, strings.HasSuffix(got.Body, "[transcript truncated]\n"))
+}
+
+func TestProperty_BodyNeverExceedsCap(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		r := transcript.NewRecorder()
+		r.Start(t0)
+		lines := rapid.SliceOf(rapid.StringN(0, 40000, -1)).Draw(rt, "lines")
+		for _, l := range lines {
+			r.Write(t0, l)
+		}
+		got, ok := r.Stop(t0)
+		if !ok {
+			rt.Fatal("Stop after Start must succeed")
+		}
+		if len(got.Body) > transcript.MaxBytes+len("[transcript truncated]\n") {
+			rt.Fatalf("body of %d bytes exceeds the cap", len(got.Body))
+		}
+	})
+}
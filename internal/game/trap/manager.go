@@ -10,6 +10,9 @@ import (
 // TrapKindConsumable is the instance key kind for player-deployed consumable traps.
 const TrapKindConsumable = "consumable"
 
+// TrapKindExit is the instance key kind for door traps; the id is the exit direction.
+const TrapKindExit = "exit"
+
 // TrapInstanceState holds runtime state for one trap instance.
 type TrapInstanceState struct {
 	// TemplateID references the TrapTemplate for this instance.
@@ -60,7 +63,8 @@ func trapInstanceID(zoneID, roomID, kind, id string) string {
 // TrapInstanceID is the public helper used by the gameserver to construct instance keys.
 //
 // Precondition: zoneID, roomID, kind, and id must all be non-empty.
-// kind MUST be "room" for room-level traps or "equip" for equipment-level traps.
+// kind MUST be "room" for room-level traps, "equip" for equipment-level traps,
+// or "exit" for door traps, whose id is the exit direction.
 func TrapInstanceID(zoneID, roomID, kind, id string) string {
 	return trapInstanceID(zoneID, roomID, kind, id)
 }
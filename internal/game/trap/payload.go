@@ -68,6 +68,10 @@ func ResolveTrigger(tmpl *TrapTemplate, dangerLevel string, templates map[string
 	}
 
 	if tmpl.Payload == nil {
+		if tmpl.Hook != "" {
+			// Script-only trap: the hook supplies the whole effect.
+			return TriggerResult{}, nil
+		}
 		return TriggerResult{}, fmt.Errorf("trap %q: no payload defined", tmpl.ID)
 	}
 	return resolvePayload(tmpl.Payload, tmpl, dangerLevel)
@@ -75,7 +79,17 @@ func ResolveTrigger(tmpl *TrapTemplate, dangerLevel string, templates map[string
 
 // resolvePayload converts a TrapPayload + template scaling into a TriggerResult.
 // scalingTmpl is the template whose DangerScaling block is applied (may differ from payload source for Pressure Plate).
+// A payload Message replaces the type's stock narrative.
 func resolvePayload(payload *TrapPayload, scalingTmpl *TrapTemplate, dangerLevel string) (TriggerResult, error) {
+	result, err := resolvePayloadType(payload, scalingTmpl, dangerLevel)
+	if err == nil && payload.Message != "" {
+		result.Narrative = payload.Message
+	}
+	return result, err
+}
+
+// resolvePayloadType builds the TriggerResult for payload.Type.
+func resolvePayloadType(payload *TrapPayload, scalingTmpl *TrapTemplate, dangerLevel string) (TriggerResult, error) {
 	scaling := ScalingFor(scalingTmpl, dangerLevel)
 
 	switch payload.Type {
@@ -184,3 +184,37 @@ func TestResolveTrigger_SubstanceID_Propagated(t *testing.T) {
 		t.Fatalf("SubstanceID = %q, want %q", result.SubstanceID, "viper_venom")
 	}
 }
+
+func TestResolveTrigger_MessageOverridesNarrative(t *testing.T) {
+	tmpl := &trap.TrapTemplate{
+		ID:      "needle_lock",
+		Trigger: trap.TriggerInteraction,
+		Payload: &trap.TrapPayload{Type: "trip_wire", Damage: "1d4", Message: "A needle jabs out of the lock!"},
+	}
+	result, err := trap.ResolveTrigger(tmpl, "safe", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Narrative != "A needle jabs out of the lock!" {
+		t.Errorf("Narrative = %q, want the payload message", result.Narrative)
+	}
+	if result.DamageDice != "1d4" {
+		t.Errorf("DamageDice = %q, want %q", result.DamageDice, "1d4")
+	}
+}
+
+func TestResolveTrigger_HookOnlyTemplate(t *testing.T) {
+	tmpl := &trap.TrapTemplate{ID: "glyph", Trigger: trap.TriggerEntry, Hook: "on_glyph"}
+	result, err := trap.ResolveTrigger(tmpl, "safe", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Narrative != "" || result.DamageDice != "" || result.ConditionID != "" {
+		t.Errorf("hook-only trap resolved to %+v, want an empty result", result)
+	}
+
+	tmpl.Hook = ""
+	if _, err := trap.ResolveTrigger(tmpl, "safe", nil); err == nil {
+		t.Error("expected an error for a trap with neither payload nor hook")
+	}
+}
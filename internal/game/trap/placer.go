@@ -2,6 +2,7 @@ package trap
 
 import (
 	"math/rand"
+	"strings"
 
 	"github.com/cory-johannsen/mud/internal/game/world"
 	"github.com/google/uuid"
@@ -15,6 +16,10 @@ var DefaultProbabilities = map[string][2]float64{
 	"all_out_war": {0.60, 0.75},
 }
 
+// ExitPositionPrefix marks a RoomTrapConfig position that attaches the trap to
+// an exit, as in "exit:north".
+const ExitPositionPrefix = "exit:"
+
 // selectFromPool picks one template ID from pool using weighted random selection.
 // Returns "" if pool is empty.
 func selectFromPool(pool []world.TrapPoolEntry, rng *rand.Rand) string {
@@ -93,15 +98,8 @@ func PlaceTraps(zone *world.Zone, templates map[string]*TrapTemplate, defaultPoo
 			coverChance = float64(*room.CoverTrapChance) / 100.0
 		}
 
-		// Register static room-level traps (REQ-TR-9: never overwrite).
-		staticRoomTrapPlaced := false
-		for _, tc := range room.Traps {
-			if tc.Position == "room" {
-				instanceID := trapInstanceID(zone.ID, room.ID, "room", uuid.NewString())
-				mgr.AddTrap(instanceID, tc.TemplateID, true)
-				staticRoomTrapPlaced = true
-			}
-		}
+		// Register static traps first (REQ-TR-9: never overwrite).
+		staticRoomTrapPlaced := placeStaticRoomTraps(zone.ID, room, mgr)
 
 		// Procedural room-level trap (only if no static room trap exists — REQ-TR-9).
 		if !staticRoomTrapPlaced && dangerLevel != "safe" && roomChance > 0 {
@@ -120,13 +118,11 @@ func PlaceTraps(zone *world.Zone, templates map[string]*TrapTemplate, defaultPoo
 			}
 		}
 
-		// Register static equipment-level traps; then roll for procedural cover traps.
+		// Roll for procedural cover traps on equipment without a static trap.
 		for i := range room.Equipment {
 			eq := &room.Equipment[i]
 			if eq.TrapTemplate != "" {
-				// Static equipment trap (REQ-TR-9: already defined in YAML).
-				instanceID := trapInstanceID(zone.ID, room.ID, "equip", eq.Description)
-				mgr.AddTrap(instanceID, eq.TrapTemplate, true)
+				// Static equipment trap, already registered (REQ-TR-9).
 				continue
 			}
 			// Roll for procedural cover trap (only for cover items).
@@ -147,3 +143,50 @@ func PlaceTraps(zone *world.Zone, templates map[string]*TrapTemplate, defaultPoo
 		}
 	}
 }
+
+// PlaceStaticTraps registers only the traps declared in zone YAML: room traps,
+// door traps, and equipment traps. Nothing is placed procedurally.
+//
+// Precondition: zone and mgr must be non-nil; the same call-once rule as PlaceTraps applies.
+// Postcondition: Every static trap in the zone is registered in mgr, Armed=true.
+func PlaceStaticTraps(zone *world.Zone, mgr *TrapManager) {
+	for _, room := range zone.Rooms {
+		placeStaticRoomTraps(zone.ID, room, mgr)
+	}
+}
+
+// placeStaticRoomTraps registers room's YAML-declared traps and reports whether
+// a room-level trap was among them.
+//
+// Postcondition: Equipment named by a trap position gets that trap as its
+// TrapTemplate unless it already carries one.
+func placeStaticRoomTraps(zoneID string, room *world.Room, mgr *TrapManager) bool {
+	roomTrapPlaced := false
+	for _, tc := range room.Traps {
+		if tc.Position == "room" {
+			mgr.AddTrap(trapInstanceID(zoneID, room.ID, "room", uuid.NewString()), tc.TemplateID, true)
+			roomTrapPlaced = true
+			continue
+		}
+		// Door traps guard one exit and fire when it is used.
+		if dir, ok := strings.CutPrefix(tc.Position, ExitPositionPrefix); ok && dir != "" {
+			mgr.AddTrap(trapInstanceID(zoneID, room.ID, TrapKindExit, dir), tc.TemplateID, true)
+			continue
+		}
+		// Any other position names a piece of equipment.
+		for i := range room.Equipment {
+			eq := &room.Equipment[i]
+			if eq.Description == tc.Position && eq.TrapTemplate == "" {
+				eq.TrapTemplate = tc.TemplateID
+				break
+			}
+		}
+	}
+	for i := range room.Equipment {
+		eq := &room.Equipment[i]
+		if eq.TrapTemplate != "" {
+			mgr.AddTrap(trapInstanceID(zoneID, room.ID, "equip", eq.Description), eq.TrapTemplate, true)
+		}
+	}
+	return roomTrapPlaced
+}
@@ -152,3 +152,45 @@ func TestSelectFromPool_Property_AlwaysInPool(t *testing.T) {
 		}
 	})
 }
+
+func TestPlaceStaticTraps_DoorAndContainerTraps(t *testing.T) {
+	zone := makeDangerousZone()
+	// Static placement ignores the procedural chances entirely.
+	zone.TrapProbabilities = &world.TrapProbabilities{
+		RoomTrapChance:  new(1.0),
+		CoverTrapChance: new(1.0),
+	}
+	room := zone.Rooms["room1"]
+	room.Equipment = []world.RoomEquipmentConfig{
+		{ItemID: "strongbox", Description: "Strongbox", CoverTier: "standard"},
+		{ItemID: "crate", Description: "Crate", TrapTemplate: "mine"},
+	}
+	room.Traps = []world.RoomTrapConfig{
+		{TemplateID: "needle", Position: "exit:north"},
+		{TemplateID: "needle", Position: "Strongbox"},
+		{TemplateID: "needle", Position: "Crate"},
+	}
+	mgr := trap.NewTrapManager()
+
+	trap.PlaceStaticTraps(zone, mgr)
+
+	door, ok := mgr.GetTrap(trap.TrapInstanceID("zone1", "room1", trap.TrapKindExit, "north"))
+	if !ok || door.TemplateID != "needle" || !door.Armed {
+		t.Errorf("door trap = %+v (found %v), want an armed needle", door, ok)
+	}
+	box, ok := mgr.GetTrap(trap.TrapInstanceID("zone1", "room1", "equip", "Strongbox"))
+	if !ok || box.TemplateID != "needle" {
+		t.Errorf("strongbox trap = %+v (found %v), want needle", box, ok)
+	}
+	if room.Equipment[0].TrapTemplate != "needle" {
+		t.Errorf("strongbox TrapTemplate = %q, want needle", room.Equipment[0].TrapTemplate)
+	}
+	// REQ-TR-9: an item's own trap is never overwritten.
+	crate, ok := mgr.GetTrap(trap.TrapInstanceID("zone1", "room1", "equip", "Crate"))
+	if !ok || crate.TemplateID != "mine" {
+		t.Errorf("crate trap = %+v (found %v), want mine", crate, ok)
+	}
+	if n := len(mgr.TrapsForRoom("zone1", "room1")); n != 3 {
+		t.Errorf("TrapsForRoom returned %d traps, want 3", n)
+	}
+}
@@ -42,6 +42,8 @@ type TrapPayload struct {
 	// SubstanceID is the substance ID applied on trap fire (empty = no substance).
 	// REQ-AH-22: caller must call ApplySubstanceByID when non-empty.
 	SubstanceID string `yaml:"substance_id,omitempty"`
+	// Message replaces the payload type's stock narrative when set.
+	Message string `yaml:"message,omitempty"`
 }
 
 // DangerScalingEntry holds per-danger-level bonus overrides for one template.
@@ -79,6 +81,10 @@ type TrapTemplate struct {
 	DangerScaling   *DangerScalingTier `yaml:"danger_scaling,omitempty"`
 	TriggerRangeFt  int                `yaml:"trigger_range_ft"`
 	BlastRadiusFt   int                `yaml:"blast_radius_ft"`
+	// Hook names a Lua function in the zone's scripts, called as
+	// hook(uid, template_id, instance_id) when the trap fires. A string
+	// return value is shown to the victim. A template with a hook needs no payload.
+	Hook string `yaml:"hook,omitempty"`
 }
 
 // EffectiveTriggerRange returns TriggerRangeFt, or 5 if zero (the default trigger range in feet).
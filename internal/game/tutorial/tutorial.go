@@ -0,0 +1,168 @@
+// Package tutorial defines the first-login tutorial and a lightweight tracker
+// for its ordered objectives.
+package tutorial
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kind identifies the player action that completes an objective.
+type Kind string
+
+const (
+	KindMove   Kind = "move"
+	KindLook   Kind = "look"
+	KindAttack Kind = "attack"
+	KindLoot   Kind = "loot"
+	KindEquip  Kind = "equip"
+)
+
+// validKinds lists every Kind the gameserver reports.
+var validKinds = map[Kind]bool{
+	KindMove: true, KindLook: true, KindAttack: true, KindLoot: true, KindEquip: true,
+}
+
+// Objective is one scripted tutorial step.
+type Objective struct {
+	Kind Kind `yaml:"kind"`
+	// Hint tells the player what to do next.
+	Hint string `yaml:"hint"`
+	// Done is shown when the objective completes; may be empty.
+	Done string `yaml:"done"`
+}
+
+// Definition is the tutorial loaded from content.
+type Definition struct {
+	// StartRoom is where new characters spawn until the tutorial is finished.
+	StartRoom string `yaml:"start_room"`
+	// Intro is shown when the tutorial begins.
+	Intro string `yaml:"intro"`
+	// Outro is shown when the final objective completes.
+	Outro      string      `yaml:"outro"`
+	Objectives []Objective `yaml:"objectives"`
+}
+
+// Load reads a tutorial definition from a YAML file.
+//
+// Precondition: path must be a readable file.
+// Postcondition: Returns the definition, or a non-nil error when start_room is
+// empty, there are no objectives, or an objective has an unknown kind or no hint.
+func Load(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var def Definition
+	if err := yaml.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("parsing tutorial file %s: %w", path, err)
+	}
+	if def.StartRoom == "" {
+		return nil, fmt.Errorf("tutorial file %s: start_room is required", path)
+	}
+	if len(def.Objectives) == 0 {
+		return nil, fmt.Errorf("tutorial file %s: at least one objective is required", path)
+	}
+	for i, o := range def.Objectives {
+		if !validKinds[o.Kind] {
+			return nil, fmt.Errorf("tutorial file %s: objective %d: unknown kind %q", path, i+1, o.Kind)
+		}
+		if o.Hint == "" {
+			return nil, fmt.Errorf("tutorial file %s: objective %d: hint is required", path, i+1)
+		}
+	}
+	return &def, nil
+}
+
+// Tracker follows one player's progress through the objectives in order.
+// Only the current objective can complete; other actions are ignored.
+// A Tracker is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	def      *Definition
+	next     int
+	finished bool
+	// ReturnRoom is where the player goes when the tutorial ends. Immutable.
+	ReturnRoom string
+}
+
+// NewTracker starts a tracker at the first objective.
+//
+// Precondition: def must be non-nil with at least one objective.
+// Postcondition: Current returns the first objective.
+func NewTracker(def *Definition, returnRoom string) *Tracker {
+	return &Tracker{def: def, ReturnRoom: returnRoom}
+}
+
+// Current returns the objective the player is working on.
+//
+// Postcondition: ok is false once every objective is complete or the tutorial is finished.
+func (t *Tracker) Current() (Objective, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.current()
+}
+
+func (t *Tracker) current() (Objective, bool) {
+	if t.finished || t.next >= len(t.def.Objectives) {
+		return Objective{}, false
+	}
+	return t.def.Objectives[t.next], true
+}
+
+// Record reports a player action.
+//
+// Postcondition: Returns the completed objective and true when kind matches the
+// current objective, advancing the tracker; otherwise returns false unchanged.
+func (t *Tracker) Record(kind Kind) (Objective, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	cur, ok := t.current()
+	if !ok || cur.Kind != kind {
+		return Objective{}, false
+	}
+	t.next++
+	return cur, true
+}
+
+// Done reports whether every objective is complete.
+func (t *Tracker) Done() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.next >= len(t.def.Objectives)
+}
+
+// Finish ends the tutorial, whether or not every objective is complete.
+//
+// Postcondition: Returns true only for the first call; Record is a no-op afterwards.
+func (t *Tracker) Finish() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.finished {
+		return false
+	}
+	t.finished = true
+	return true
+}
+
+// Finished reports whether Finish has been called.
+func (t *Tracker) Finished() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.finished
+}
+
+// Progress returns the number of completed objectives and the total.
+func (t *Tracker) Progress() (done, total int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.next, len(t.def.Objectives)
+}
+
+// Definition returns the tutorial being tracked.
+func (t *Tracker) Definition() *Definition {
+	return t.def
+}
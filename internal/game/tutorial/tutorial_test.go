@@ -0,0 +1,133 @@
+package tutorial_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
+)
+
+func writeTutorial(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tutorial.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+	return path
+}
+
+func testDefinition() *tutorial.Definition {
+	return &tutorial.Definition{
+		StartRoom: "tut_start",
+		Objectives: []tutorial.Objective{
+			{Kind: tutorial.KindMove, Hint: "Walk north."},
+			{Kind: tutorial.KindLook, Hint: "Look around."},
+			{Kind: tutorial.KindAttack, Hint: "Attack the dummy."},
+		},
+	}
+}
+
+func TestLoad_ParsesYAML(t *testing.T) {
+	def, err := tutorial.Load(writeTutorial(t, `
+start_room: tut_start
+intro: Welcome.
+objectives:
+  - kind: move
+    hint: Walk north.
+    done: Nice walking.
+  - kind: equip
+    hint: Equip the blade.
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "tut_start", def.StartRoom)
+	require.Len(t, def.Objectives, 2)
+	assert.Equal(t, tutorial.KindEquip, def.Objectives[1].Kind)
+	assert.Equal(t, "Nice walking.", def.Objectives[0].Done)
+}
+
+func TestLoad_RejectsInvalid(t *testing.T) {
+	cases := map[string]string{
+		"no start room": "objectives: [{kind: move, hint: go}]",
+		"no objectives": "start_room: r",
+		"unknown kind":  "start_room: r\nobjectives: [{kind: dance, hint: go}]",
+		"missing hint":  "start_room: r\nobjectives: [{kind: move}]",
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tutorial.Load(writeTutorial(t, body))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoad_ShippedContent(t *testing.T) {
+	def, err := tutorial.Load("../../../content/tutorial.yaml")
+	require.NoError(t, err)
+	kinds := make([]tutorial.Kind, 0, len(def.Objectives))
+	for _, o := range def.Objectives {
+		kinds = append(kinds, o.Kind)
+	}
+	assert.Equal(t, []tutorial.Kind{
+		tutorial.KindMove, tutorial.KindLook, tutorial.KindAttack, tutorial.KindLoot, tutorial.KindEquip,
+	}, kinds)
+}
+
+func TestTracker_AdvancesInOrder(t *testing.T) {
+	tr := tutorial.NewTracker(testDefinition(), "home")
+	assert.Equal(t, "home", tr.ReturnRoom)
+
+	_, ok := tr.Record(tutorial.KindLook)
+	assert.False(t, ok, "look is not the current objective")
+
+	done, ok := tr.Record(tutorial.KindMove)
+	require.True(t, ok)
+	assert.Equal(t, tutorial.KindMove, done.Kind)
+	cur, ok := tr.Current()
+	require.True(t, ok)
+	assert.Equal(t, tutorial.KindLook, cur.Kind)
+
+	tr.Record(tutorial.KindLook)
+	tr.Record(tutorial.KindAttack)
+	assert.True(t, tr.Done())
+	_, ok = tr.Current()
+	assert.False(t, ok)
+	_, ok = tr.Record(tutorial.KindAttack)
+	assert.False(t, ok, "nothing records after completion")
+}
+
+func TestTracker_FinishStopsRecording(t *testing.T) {
+	tr := tutorial.NewTracker(testDefinition(), "home")
+	assert.True(t, tr.Finish())
+	assert.False(t, tr.Finish(), "only the first Finish reports true")
+	assert.True(t, tr.Finished())
+	_, ok := tr.Record(tutorial.KindMove)
+	assert.False(t, ok)
+	_, ok = tr.Current()
+	assert.False(t, ok)
+}
+
+func TestProperty_Tracker_ProgressMatchesCompletedPrefix(t *testing.T) {
+	def := testDefinition()
+	kinds := []tutorial.Kind{tutorial.KindMove, tutorial.KindLook, tutorial.KindAttack, tutorial.KindLoot, tutorial.KindEquip}
+	rapid.Check(t, func(rt *rapid.T) {
+		tr := tutorial.NewTracker(def, "")
+		actions := rapid.SliceOf(rapid.SampledFrom(kinds)).Draw(rt, "actions")
+		want := 0
+		for _, k := range actions {
+			if want < len(def.Objectives) && def.Objectives[want].Kind == k {
+				want++
+			}
+			tr.Record(k)
+		}
+		done, total := tr.Progress()
+		if done != want || total != len(def.Objectives) {
+			rt.Fatalf("progress = %d/%d, want %d/%d", done, total, want, len(def.Objectives))
+		}
+		if tr.Done() != (want == len(def.Objectives)) {
+			rt.Fatalf("Done() = %v with %d/%d complete", tr.Done(), done, total)
+		}
+	})
+}
@@ -0,0 +1,319 @@
+package vehicle
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrAboard is returned when boarding while already aboard a vehicle.
+	ErrAboard = errors.New("already aboard a vehicle")
+	// ErrNotAboard is returned when acting on a vehicle the player is not in.
+	ErrNotAboard = errors.New("not aboard a vehicle")
+	// ErrFull is returned when every seat is taken.
+	ErrFull = errors.New("no free seats")
+	// ErrWrecked is returned when boarding, driving, or refuelling a wrecked vehicle.
+	ErrWrecked = errors.New("vehicle is wrecked")
+	// ErrNotDriver is returned when a passenger other than the driver tries to drive.
+	ErrNotDriver = errors.New("not the driver")
+	// ErrNoFuel is returned when driving with an empty tank.
+	ErrNoFuel = errors.New("out of fuel")
+	// ErrUnknown is returned for a vehicle ID the manager does not hold.
+	ErrUnknown = errors.New("no such vehicle")
+)
+
+// Instance is one vehicle in the world.
+type Instance struct {
+	ID          string
+	TemplateID  string
+	Name        string
+	Description string
+	Kind        string
+	RoomID      string
+	CurrentHP   int
+	MaxHP       int
+	AC          int
+	Fuel        int
+	MaxFuel     int
+	Seats       int
+	// Driver is the UID of the player at the wheel; empty when nobody drives.
+	Driver string
+	// Passengers lists the UIDs aboard in boarding order, driver included.
+	Passengers []string
+}
+
+// clone returns a deep copy of v.
+func (v *Instance) clone() *Instance {
+	c := *v
+	c.Passengers = append([]string(nil), v.Passengers...)
+	return &c
+}
+
+// Wrecked reports whether the vehicle has been destroyed.
+func (v *Instance) Wrecked() bool {
+	return v.CurrentHP <= 0
+}
+
+// Condition describes the vehicle's damage for room listings.
+//
+// Postcondition: Returns one of pristine, dented, battered, smoking, or wrecked.
+func (v *Instance) Condition() string {
+	switch {
+	case v.CurrentHP <= 0:
+		return "wrecked"
+	case v.CurrentHP >= v.MaxHP:
+		return "pristine"
+	case 4*v.CurrentHP >= 3*v.MaxHP:
+		return "dented"
+	case 4*v.CurrentHP >= v.MaxHP:
+		return "battered"
+	default:
+		return "smoking"
+	}
+}
+
+// Manager holds every vehicle instance and who is aboard. It is safe for
+// concurrent use; all returned instances are copies.
+type Manager struct {
+	mu     sync.Mutex
+	byID   map[string]*Instance
+	aboard map[string]string // player UID → vehicle ID
+	seq    int
+}
+
+// NewManager returns an empty Manager.
+func NewManager() *Manager {
+	return &Manager{byID: make(map[string]*Instance), aboard: make(map[string]string)}
+}
+
+// Spawn places a new, fully fuelled vehicle from t in roomID.
+//
+// Precondition: t must be valid; roomID must be non-empty.
+// Postcondition: Returns a copy of the new instance.
+func (m *Manager) Spawn(t *Template, roomID string) *Instance {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seq++
+	v := &Instance{
+		ID:          fmt.Sprintf("%s-%d", t.ID, m.seq),
+		TemplateID:  t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Kind:        t.Kind,
+		RoomID:      roomID,
+		CurrentHP:   t.MaxHP,
+		MaxHP:       t.MaxHP,
+		AC:          t.AC,
+		Fuel:        t.MaxFuel,
+		MaxFuel:     t.MaxFuel,
+		Seats:       t.Seats,
+	}
+	m.byID[v.ID] = v
+	return v.clone()
+}
+
+// SpawnAll places one vehicle from each template in every room it lists.
+//
+// Postcondition: Returns the number of vehicles spawned.
+func (m *Manager) SpawnAll(templates []*Template) int {
+	n := 0
+	for _, t := range templates {
+		for _, roomID := range t.SpawnRooms {
+			m.Spawn(t, roomID)
+			n++
+		}
+	}
+	return n
+}
+
+// InRoom returns the vehicles in roomID, sorted by name then ID.
+func (m *Manager) InRoom(roomID string) []*Instance {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Instance
+	for _, v := range m.byID {
+		if v.RoomID == roomID {
+			out = append(out, v.clone())
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// FindInRoom returns the first vehicle in roomID whose ID matches query, or
+// whose name or any word of it starts with query, ignoring case.
+//
+// Postcondition: Returns (copy, true) on a match; (nil, false) otherwise.
+func (m *Manager) FindInRoom(roomID, query string) (*Instance, bool) {
+	q := strings.ToLower(strings.TrimSpace(query))
+	if q == "" {
+		return nil, false
+	}
+	for _, v := range m.InRoom(roomID) {
+		name := strings.ToLower(v.Name)
+		if strings.ToLower(v.ID) == q || strings.HasPrefix(name, q) {
+			return v, true
+		}
+		for _, word := range strings.Fields(name) {
+			if strings.HasPrefix(word, q) {
+				return v, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Aboard returns the vehicle uid is riding in.
+//
+// Postcondition: Returns (copy, true) when uid is aboard; (nil, false) otherwise.
+func (m *Manager) Aboard(uid string) (*Instance, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.byID[m.aboard[uid]]
+	if !ok {
+		return nil, false
+	}
+	return v.clone(), true
+}
+
+// Board puts uid aboard vehicleID. The first player aboard takes the wheel.
+//
+// Postcondition: Returns ErrUnknown, ErrAboard, ErrWrecked, or ErrFull without
+// changing state; otherwise a copy of the boarded vehicle.
+func (m *Manager) Board(vehicleID, uid string) (*Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.byID[vehicleID]
+	if !ok {
+		return nil, ErrUnknown
+	}
+	if _, ok := m.aboard[uid]; ok {
+		return nil, ErrAboard
+	}
+	if v.Wrecked() {
+		return nil, ErrWrecked
+	}
+	if len(v.Passengers) >= v.Seats {
+		return nil, ErrFull
+	}
+	v.Passengers = append(v.Passengers, uid)
+	if v.Driver == "" {
+		v.Driver = uid
+	}
+	m.aboard[uid] = v.ID
+	return v.clone(), nil
+}
+
+// Disembark takes uid out of their vehicle. When the driver leaves, the
+// next passenger in boarding order takes the wheel.
+//
+// Postcondition: Returns ErrNotAboard when uid is not aboard; otherwise a copy
+// of the vehicle after uid left.
+func (m *Manager) Disembark(uid string) (*Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.byID[m.aboard[uid]]
+	if !ok {
+		return nil, ErrNotAboard
+	}
+	m.leave(v, uid)
+	return v.clone(), nil
+}
+
+// leave removes uid from v. The caller must hold m.mu.
+func (m *Manager) leave(v *Instance, uid string) {
+	delete(m.aboard, uid)
+	for i, p := range v.Passengers {
+		if p == uid {
+			v.Passengers = append(v.Passengers[:i], v.Passengers[i+1:]...)
+			break
+		}
+	}
+	if v.Driver == uid {
+		v.Driver = ""
+		if len(v.Passengers) > 0 {
+			v.Driver = v.Passengers[0]
+		}
+	}
+}
+
+// Drive moves the vehicle uid is driving to toRoomID, carrying every
+// passenger and burning one unit of fuel.
+//
+// Precondition: the caller has checked the route is passable for the vehicle's kind.
+// Postcondition: Returns ErrNotAboard, ErrNotDriver, ErrWrecked, or ErrNoFuel
+// without changing state; otherwise a copy of the moved vehicle.
+func (m *Manager) Drive(uid, toRoomID string) (*Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.byID[m.aboard[uid]]
+	if !ok {
+		return nil, ErrNotAboard
+	}
+	if v.Driver != uid {
+		return nil, ErrNotDriver
+	}
+	if v.Wrecked() {
+		return nil, ErrWrecked
+	}
+	if v.Fuel <= 0 {
+		return nil, ErrNoFuel
+	}
+	v.Fuel--
+	v.RoomID = toRoomID
+	return v.clone(), nil
+}
+
+// Damage deals dmg to vehicleID. A vehicle reduced to 0 HP is wrecked and
+// everyone aboard is thrown out.
+//
+// Precondition: dmg >= 0.
+// Postcondition: Returns ErrUnknown for an unknown vehicle; otherwise a copy of
+// the vehicle and the UIDs ejected by this hit (nil unless it wrecked the vehicle).
+func (m *Manager) Damage(vehicleID string, dmg int) (*Instance, []string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.byID[vehicleID]
+	if !ok {
+		return nil, nil, ErrUnknown
+	}
+	if v.Wrecked() {
+		return v.clone(), nil, nil
+	}
+	v.CurrentHP = max(v.CurrentHP-dmg, 0)
+	var ejected []string
+	if v.Wrecked() {
+		ejected = append(ejected, v.Passengers...)
+		for _, uid := range ejected {
+			m.leave(v, uid)
+		}
+	}
+	return v.clone(), ejected, nil
+}
+
+// Refuel adds amount fuel to vehicleID, up to its tank size.
+//
+// Precondition: amount > 0.
+// Postcondition: Returns ErrUnknown or ErrWrecked without changing state;
+// otherwise a copy of the refuelled vehicle.
+func (m *Manager) Refuel(vehicleID string, amount int) (*Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.byID[vehicleID]
+	if !ok {
+		return nil, ErrUnknown
+	}
+	if v.Wrecked() {
+		return nil, ErrWrecked
+	}
+	v.Fuel = min(v.Fuel+amount, v.MaxFuel)
+	return v.clone(), nil
+}
@@ -0,0 +1,98 @@
+// Package vehicle tracks drivable transports — cars and boats — that sit in
+// rooms, carry players, burn fuel as they travel, and can be wrecked.
+package vehicle
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Vehicle kinds. Cars travel along road exits; boats travel between water rooms.
+const (
+	KindCar  = "car"
+	KindBoat = "boat"
+)
+
+// Template is the YAML definition of a vehicle.
+type Template struct {
+	ID          string `yaml:"id"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Kind is KindCar or KindBoat.
+	Kind  string `yaml:"kind"`
+	MaxHP int    `yaml:"max_hp"`
+	AC    int    `yaml:"ac"`
+	// MaxFuel is the tank size; each room travelled burns one unit.
+	MaxFuel int `yaml:"max_fuel"`
+	// Seats is how many players fit aboard, driver included.
+	Seats int `yaml:"seats"`
+	// SpawnRooms lists the rooms that hold one of this vehicle at startup.
+	SpawnRooms []string `yaml:"spawn_rooms"`
+}
+
+// Validate checks that the Template satisfies its invariants.
+//
+// Precondition: t must be non-nil.
+// Postcondition: Returns nil iff all fields are valid.
+func (t *Template) Validate() error {
+	var errs []error
+	if t.ID == "" {
+		errs = append(errs, errors.New("id must not be empty"))
+	}
+	if t.Name == "" {
+		errs = append(errs, errors.New("name must not be empty"))
+	}
+	if t.Kind != KindCar && t.Kind != KindBoat {
+		errs = append(errs, fmt.Errorf("kind must be %q or %q; got %q", KindCar, KindBoat, t.Kind))
+	}
+	if t.MaxHP < 1 {
+		errs = append(errs, errors.New("max_hp must be >= 1"))
+	}
+	if t.MaxFuel < 1 {
+		errs = append(errs, errors.New("max_fuel must be >= 1"))
+	}
+	if t.Seats < 1 {
+		errs = append(errs, errors.New("seats must be >= 1"))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("vehicle %q: %w", t.ID, errors.Join(errs...))
+	}
+	return nil
+}
+
+// LoadTemplates reads every *.yaml file in dir, each holding one Template.
+//
+// Precondition: dir must be a readable directory.
+// Postcondition: Returns all templates, or an error on the first read, parse,
+// or validation failure.
+func LoadTemplates(dir string) ([]*Template, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading vehicle dir %q: %w", dir, err)
+	}
+	var out []*Template
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %w", path, err)
+		}
+		var t Template
+		if err := yaml.Unmarshal(data, &t); err != nil {
+			return nil, fmt.Errorf("parsing %q: %w", path, err)
+		}
+		if err := t.Validate(); err != nil {
+			return nil, fmt.Errorf("loading %q: %w", path, err)
+		}
+		out = append(out, &t)
+	}
+	return out, nil
+}
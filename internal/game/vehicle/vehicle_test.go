@@ -0,0 +1,164 @@
+package vehicle_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/vehicle"
+)
+
+func sedan() *vehicle.Template {
+	return &vehicle.Template{ID: "sedan", Name: "Rusted Sedan", Kind: vehicle.KindCar, MaxHP: 20, AC: 12, MaxFuel: 2, Seats: 2}
+}
+
+func TestLoadTemplates(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sedan.yaml"), []byte(`id: sedan
+name: Rusted Sedan
+kind: car
+max_hp: 20
+ac: 12
+max_fuel: 10
+seats: 4
+spawn_rooms: [lot]
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a vehicle"), 0o644))
+
+	ts, err := vehicle.LoadTemplates(dir)
+	require.NoError(t, err)
+	require.Len(t, ts, 1)
+	assert.Equal(t, []string{"lot"}, ts[0].SpawnRooms)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.yaml"), []byte("id: bad\nname: Bad\nkind: hovercraft\n"), 0o644))
+	_, err = vehicle.LoadTemplates(dir)
+	assert.ErrorContains(t, err, "kind must be")
+}
+
+func TestManager_BoardDriveDisembark(t *testing.T) {
+	m := vehicle.NewManager()
+	v := m.Spawn(sedan(), "lot")
+
+	got, ok := m.FindInRoom("lot", "sed")
+	require.True(t, ok)
+	assert.Equal(t, v.ID, got.ID)
+	_, ok = m.FindInRoom("lot", "boat")
+	assert.False(t, ok)
+
+	v, err := m.Board(v.ID, "ace")
+	require.NoError(t, err)
+	assert.Equal(t, "ace", v.Driver)
+	_, err = m.Board(v.ID, "ace")
+	assert.ErrorIs(t, err, vehicle.ErrAboard)
+	_, err = m.Board(v.ID, "bee")
+	require.NoError(t, err)
+	_, err = m.Board(v.ID, "cat")
+	assert.ErrorIs(t, err, vehicle.ErrFull)
+
+	_, err = m.Drive("bee", "street")
+	assert.ErrorIs(t, err, vehicle.ErrNotDriver)
+	v, err = m.Drive("ace", "street")
+	require.NoError(t, err)
+	assert.Equal(t, "street", v.RoomID)
+	assert.Equal(t, 1, v.Fuel)
+	assert.Empty(t, m.InRoom("lot"))
+
+	_, err = m.Drive("ace", "corner")
+	require.NoError(t, err)
+	_, err = m.Drive("ace", "alley")
+	assert.ErrorIs(t, err, vehicle.ErrNoFuel)
+
+	v, err = m.Disembark("ace")
+	require.NoError(t, err)
+	assert.Equal(t, "bee", v.Driver, "the next passenger takes the wheel")
+	_, err = m.Disembark("ace")
+	assert.ErrorIs(t, err, vehicle.ErrNotAboard)
+
+	v, err = m.Refuel(v.ID, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v.Fuel, "refuelling stops at a full tank")
+}
+
+func TestManager_DamageWrecksAndEjects(t *testing.T) {
+	m := vehicle.NewManager()
+	v := m.Spawn(sedan(), "lot")
+	_, err := m.Board(v.ID, "ace")
+	require.NoError(t, err)
+
+	v, ejected, err := m.Damage(v.ID, 4)
+	require.NoError(t, err)
+	assert.Empty(t, ejected)
+	assert.Equal(t, "dented", v.Condition())
+
+	v, ejected, err = m.Damage(v.ID, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ace"}, ejected)
+	assert.True(t, v.Wrecked())
+	assert.Equal(t, "wrecked", v.Condition())
+	_, ok := m.Aboard("ace")
+	assert.False(t, ok)
+
+	_, err = m.Board(v.ID, "ace")
+	assert.ErrorIs(t, err, vehicle.ErrWrecked)
+	_, err = m.Refuel(v.ID, 1)
+	assert.ErrorIs(t, err, vehicle.ErrWrecked)
+}
+
+func TestManager_ReturnsCopies(t *testing.T) {
+	m := vehicle.NewManager()
+	v := m.Spawn(sedan(), "lot")
+	_, err := m.Board(v.ID, "ace")
+	require.NoError(t, err)
+	got, _ := m.Aboard("ace")
+	got.Passengers[0] = "mallory"
+	got.Fuel = 0
+	again, _ := m.Aboard("ace")
+	assert.Equal(t, []string{"ace"}, again.Passengers)
+	assert.Equal(t, 2, again.Fuel)
+}
+
+func TestProperty_SeatsAndAboardStayConsistent(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		m := vehicle.NewManager()
+		seats := rapid.IntRange(1, 4).Draw(rt, "seats")
+		tmpl := sedan()
+		tmpl.Seats = seats
+		v := m.Spawn(tmpl, "lot")
+		uids := []string{"a", "b", "c", "d", "e"}
+		ops := rapid.SliceOfN(rapid.IntRange(0, 2*len(uids)-1), 1, 40).Draw(rt, "ops")
+		for _, op := range ops {
+			uid := uids[op%len(uids)]
+			if op < len(uids) {
+				_, _ = m.Board(v.ID, uid)
+			} else {
+				_, _ = m.Disembark(uid)
+			}
+			cur := m.InRoom("lot")[0]
+			if len(cur.Passengers) > seats {
+				rt.Fatalf("%d passengers in %d seats", len(cur.Passengers), seats)
+			}
+			if len(cur.Passengers) > 0 && cur.Driver != cur.Passengers[0] {
+				rt.Fatalf("driver %q is not first aboard %v", cur.Driver, cur.Passengers)
+			}
+			for _, u := range uids {
+				_, aboard := m.Aboard(u)
+				if aboard != containsUID(cur.Passengers, u) {
+					rt.Fatalf("Aboard(%q)=%v but passengers are %v", u, aboard, cur.Passengers)
+				}
+			}
+		}
+	})
+}
+
+func containsUID(uids []string, uid string) bool {
+	for _, u := range uids {
+		if u == uid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package world
+
+// LightLevel is how well a room is lit.
+type LightLevel int
+
+const (
+	LightDark LightLevel = iota
+	LightDim
+	LightBright
+)
+
+// String returns the light level's display name.
+func (l LightLevel) String() string {
+	switch l {
+	case LightDark:
+		return "dark"
+	case LightDim:
+		return "dim"
+	default:
+		return "bright"
+	}
+}
+
+// lightingLevels maps values of the room "lighting" property to a light level.
+// Values not listed here count as bright.
+var lightingLevels = map[string]LightLevel{
+	"dark":        LightDark,
+	"pitch_black": LightDark,
+	"shadow":      LightDark,
+	"dim":         LightDim,
+	"shaded":      LightDim,
+	"flickering":  LightDim,
+	"emergency":   LightDim,
+	"candlelight": LightDim,
+	"candlelit":   LightDim,
+	"firelight":   LightDim,
+	"torchlight":  LightDim,
+	"lantern":     LightDim,
+	"lamplight":   LightDim,
+	"lamplit":     LightDim,
+	"streetlamp":  LightDim,
+	"forge_glow":  LightDim,
+	"led_blue":    LightDim,
+	"neon":        LightDim,
+	"misty":       LightDim,
+	"hazy":        LightDim,
+	"smoky":       LightDim,
+}
+
+// BaseLight returns the room's light level from its "lighting" property,
+// ignoring time of day.
+//
+// Postcondition: Returns LightBright when the property is absent or unrecognised.
+func (r *Room) BaseLight() LightLevel {
+	if l, ok := lightingLevels[r.Properties["lighting"]]; ok {
+		return l
+	}
+	return LightBright
+}
+
+// Light returns the room's effective light level. Outdoor rooms lose one
+// level during dark periods of the day.
+//
+// Postcondition: Returns a level no brighter than BaseLight.
+func (r *Room) Light(darkPeriod bool) LightLevel {
+	l := r.BaseLight()
+	if darkPeriod && r.Properties["outdoor"] == "true" && l > LightDark {
+		l--
+	}
+	return l
+}
@@ -0,0 +1,43 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+func TestRoom_BaseLight(t *testing.T) {
+	assert.Equal(t, LightBright, (&Room{}).BaseLight())
+	assert.Equal(t, LightBright, (&Room{Properties: map[string]string{"lighting": "fluorescent"}}).BaseLight())
+	assert.Equal(t, LightDim, (&Room{Properties: map[string]string{"lighting": "dim"}}).BaseLight())
+	assert.Equal(t, LightDark, (&Room{Properties: map[string]string{"lighting": "dark"}}).BaseLight())
+}
+
+func TestRoom_Light_DarkPeriodDimsOutdoorRoomsOnly(t *testing.T) {
+	outdoor := &Room{Properties: map[string]string{"outdoor": "true", "lighting": "dim"}}
+	indoor := &Room{Properties: map[string]string{"lighting": "dim"}}
+
+	assert.Equal(t, LightDim, outdoor.Light(false))
+	assert.Equal(t, LightDark, outdoor.Light(true))
+	assert.Equal(t, LightDim, indoor.Light(true))
+	assert.Equal(t, LightDim, (&Room{Properties: map[string]string{"outdoor": "true"}}).Light(true))
+}
+
+// TestProperty_RoomLightNeverExceedsBase verifies the day/night modifier only
+// ever darkens a room and never drops below dark.
+func TestProperty_RoomLightNeverExceedsBase(t *testing.T) {
+	values := []string{"", "dark", "dim", "overcast", "neon", "fluorescent", "shadow"}
+	rapid.Check(t, func(rt *rapid.T) {
+		r := &Room{Properties: map[string]string{
+			"lighting": rapid.SampledFrom(values).Draw(rt, "lighting"),
+		}}
+		if rapid.Bool().Draw(rt, "outdoor") {
+			r.Properties["outdoor"] = "true"
+		}
+		got := r.Light(rapid.Bool().Draw(rt, "dark"))
+		if got > r.BaseLight() || got < LightDark {
+			rt.Fatalf("Light = %v, base %v", got, r.BaseLight())
+		}
+	})
+}
@@ -35,6 +35,20 @@ type yamlZone struct {
 	MaxLevel               int                    `yaml:"max_level,omitempty"`
 	ZoneEffects            []RoomEffect           `yaml:"zone_effects,omitempty"`
 	FactionID              string                 `yaml:"faction_id,omitempty"`
+	Reset                  *yamlZoneReset         `yaml:"reset,omitempty"`
+}
+
+// yamlZoneReset is the YAML representation of a zone's reset config.
+type yamlZoneReset struct {
+	Interval string `yaml:"interval"`
+	Warning  string `yaml:"warning,omitempty"`
+	Message  string `yaml:"message,omitempty"`
+}
+
+// yamlRoomFloorItem is the YAML representation of an item a reset puts on the floor.
+type yamlRoomFloorItem struct {
+	ItemID   string `yaml:"item_id"`
+	Quantity int    `yaml:"quantity,omitempty"`
 }
 
 // yamlTrapProbabilities is the YAML representation of zone trap placement config.
@@ -76,6 +90,7 @@ type yamlRoomEquipment struct {
 	CoverTier         string                  `yaml:"cover_tier,omitempty"`
 	CoverDestructible bool                    `yaml:"cover_destructible"`
 	CoverHP           int                     `yaml:"cover_hp"`
+	LockDC            int                     `yaml:"lock_dc,omitempty"`
 }
 
 // yamlRoom is the YAML representation of a room.
@@ -87,6 +102,7 @@ type yamlRoom struct {
 	Properties      map[string]string       `yaml:"properties"`
 	Spawns          []yamlRoomSpawn         `yaml:"spawns"`
 	Equipment       []yamlRoomEquipment     `yaml:"equipment"`
+	FloorItems      []yamlRoomFloorItem     `yaml:"floor_items,omitempty"`
 	Traps           []yamlRoomTrap          `yaml:"traps"`
 	SkillChecks     []skillcheck.TriggerDef `yaml:"skill_checks"`
 	Effects         []RoomEffect            `yaml:"effects"`
@@ -97,17 +113,24 @@ type yamlRoom struct {
 	CoverTrapChance *int                    `yaml:"cover_trap_chance,omitempty"`
 	Indoor           bool                    `yaml:"indoor"`
 	AmbientSubstance string                  `yaml:"ambient_substance,omitempty"`
+	Ambient          []string                `yaml:"ambient,omitempty"`
 	BossRoom         bool                    `yaml:"boss_room,omitempty"`
 	Hazards          []HazardDef             `yaml:"hazards,omitempty"`
 	MinFactionTierID string                  `yaml:"min_faction_tier_id,omitempty"`
+	MinRep           *RepRequirement         `yaml:"min_rep,omitempty"`
+	Housing          *HousingConfig          `yaml:"housing,omitempty"`
+	Terrain          string                  `yaml:"terrain,omitempty"`
 }
 
 // yamlExit is the YAML representation of an exit.
 type yamlExit struct {
-	Direction string `yaml:"direction"`
-	Target    string `yaml:"target"`
-	Locked    bool   `yaml:"locked"`
-	Hidden    bool   `yaml:"hidden"`
+	Direction string          `yaml:"direction"`
+	Target    string          `yaml:"target"`
+	Locked    bool            `yaml:"locked"`
+	LockDC    int             `yaml:"lock_dc,omitempty"`
+	Hidden    bool            `yaml:"hidden"`
+	Road      bool            `yaml:"road,omitempty"`
+	MinRep    *RepRequirement `yaml:"min_rep,omitempty"`
 }
 
 // LoadZoneFromFile reads and validates a single zone YAML file.
@@ -210,6 +233,19 @@ func convertYAMLZone(yz yamlZone) (*Zone, error) {
 		}
 		zone.TrapProbabilities = tp
 	}
+	if yr := yz.Reset; yr != nil {
+		reset := &ZoneReset{Warning: DefaultZoneResetWarning, Message: yr.Message}
+		var err error
+		if reset.Interval, err = time.ParseDuration(yr.Interval); err != nil {
+			return nil, fmt.Errorf("zone %q: reset interval %q is not a valid duration: %w", yz.ID, yr.Interval, err)
+		}
+		if yr.Warning != "" {
+			if reset.Warning, err = time.ParseDuration(yr.Warning); err != nil {
+				return nil, fmt.Errorf("zone %q: reset warning %q is not a valid duration: %w", yz.ID, yr.Warning, err)
+			}
+		}
+		zone.Reset = reset
+	}
 
 	for _, yr := range yz.Rooms {
 		if yr.MapX == nil {
@@ -233,19 +269,30 @@ func convertYAMLZone(yz yamlZone) (*Zone, error) {
 			CoverTrapChance:  yr.CoverTrapChance,
 			Indoor:           yr.Indoor,
 			AmbientSubstance: yr.AmbientSubstance,
+			Ambient:          yr.Ambient,
 			BossRoom:         yr.BossRoom,
 			Hazards:          yr.Hazards,
 			MinFactionTierID: yr.MinFactionTierID,
+			MinRep:           yr.MinRep,
+			Housing:          yr.Housing,
+			Terrain:          yr.Terrain,
 		}
 		if room.Properties == nil {
 			room.Properties = make(map[string]string)
 		}
+		// Most zone files tag terrain under properties alongside lighting.
+		if room.Terrain == "" {
+			room.Terrain = room.Properties["terrain"]
+		}
 		for _, ye := range yr.Exits {
 			room.Exits = append(room.Exits, Exit{
 				Direction:  Direction(ye.Direction),
 				TargetRoom: ye.Target,
 				Locked:     ye.Locked,
+				LockDC:     ye.LockDC,
 				Hidden:     ye.Hidden,
+				Road:       ye.Road,
+				MinRep:     ye.MinRep,
 			})
 		}
 		for _, ys := range yr.Spawns {
@@ -272,9 +319,17 @@ func convertYAMLZone(yz yamlZone) (*Zone, error) {
 				CoverTier:         e.CoverTier,
 				CoverDestructible: e.CoverDestructible,
 				CoverHP:           e.CoverHP,
+				LockDC:            e.LockDC,
 			}
 			room.Equipment = append(room.Equipment, eq)
 		}
+		for _, yf := range yr.FloorItems {
+			qty := yf.Quantity
+			if qty == 0 {
+				qty = 1
+			}
+			room.FloorItems = append(room.FloorItems, RoomFloorItem{ItemID: yf.ItemID, Quantity: qty})
+		}
 		for _, yt := range yr.Traps {
 			room.Traps = append(room.Traps, RoomTrapConfig{
 				TemplateID: yt.Template,
@@ -313,16 +368,22 @@ func zoneToYAML(zone *Zone) yamlZoneFile {
 			CoverTrapChance:  room.CoverTrapChance,
 			Indoor:           room.Indoor,
 			AmbientSubstance: room.AmbientSubstance,
+			Ambient:          room.Ambient,
 			BossRoom:         room.BossRoom,
 			Hazards:          room.Hazards,
 			MinFactionTierID: room.MinFactionTierID,
+			MinRep:           room.MinRep,
+			Housing:          room.Housing,
 		}
 		for _, exit := range room.Exits {
 			yr.Exits = append(yr.Exits, yamlExit{
 				Direction: string(exit.Direction),
 				Target:    exit.TargetRoom,
 				Locked:    exit.Locked,
+				LockDC:    exit.LockDC,
 				Hidden:    exit.Hidden,
+				Road:      exit.Road,
+				MinRep:    exit.MinRep,
 			})
 		}
 		for _, sp := range room.Spawns {
@@ -349,8 +410,12 @@ func zoneToYAML(zone *Zone) yamlZoneFile {
 				CoverTier:         eq.CoverTier,
 				CoverDestructible: eq.CoverDestructible,
 				CoverHP:           eq.CoverHP,
+				LockDC:            eq.LockDC,
 			})
 		}
+		for _, f := range room.FloorItems {
+			yr.FloorItems = append(yr.FloorItems, yamlRoomFloorItem{ItemID: f.ItemID, Quantity: f.Quantity})
+		}
 		for _, tr := range room.Traps {
 			yr.Traps = append(yr.Traps, yamlRoomTrap{
 				Template: tr.TemplateID,
@@ -383,5 +448,8 @@ func zoneToYAML(zone *Zone) yamlZoneFile {
 		}
 		yz.TrapProbabilities = tp
 	}
+	if r := zone.Reset; r != nil {
+		yz.Reset = &yamlZoneReset{Interval: r.Interval.String(), Warning: r.Warning.String(), Message: r.Message}
+	}
 	return yamlZoneFile{Zone: yz}
 }
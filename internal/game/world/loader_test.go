@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cory-johannsen/mud/internal/game/skillcheck"
 	"github.com/stretchr/testify/assert"
@@ -1200,3 +1201,196 @@ zone:
 	assert.Equal(t, 0, z.MinLevel)
 	assert.Equal(t, 0, z.MaxLevel)
 }
+
+func TestLoadZoneFromBytes_Housing(t *testing.T) {
+	data := []byte(`
+zone:
+  id: test
+  name: Test Zone
+  description: desc
+  start_room: r1
+  rooms:
+    - id: r1
+      title: Room 1
+      description: A room.
+      map_x: 0
+      map_y: 0
+      housing:
+        rent: 40
+        period_hours: 24
+`)
+	z, err := LoadZoneFromBytes(data)
+	require.NoError(t, err)
+	require.NotNil(t, z.Rooms["r1"].Housing)
+	assert.Equal(t, 40, z.Rooms["r1"].Housing.Rent)
+	assert.Equal(t, 24*time.Hour, z.Rooms["r1"].Housing.Period())
+}
+
+func TestLoadZoneFromBytes_HousingWithoutRent_ReturnsError(t *testing.T) {
+	data := []byte(`
+zone:
+  id: test
+  name: Test Zone
+  description: desc
+  start_room: r1
+  rooms:
+    - id: r1
+      title: Room 1
+      description: A room.
+      map_x: 0
+      map_y: 0
+      housing:
+        period_hours: 24
+`)
+	_, err := LoadZoneFromBytes(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "housing")
+}
+
+func TestLoadZoneFromBytes_Ambient(t *testing.T) {
+	zone, err := LoadZoneFromBytes([]byte(`
+zone:
+  id: test
+  name: "Test Zone"
+  start_room: room_a
+  rooms:
+    - id: room_a
+      title: "Room A"
+      description: "This is room A."
+      ambient:
+        - "A siren wails somewhere to the south."
+        - "Rain drips through the broken skylight."
+      map_x: 0
+      map_y: 0
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A siren wails somewhere to the south.", "Rain drips through the broken skylight."}, zone.Rooms["room_a"].Ambient)
+
+	data, err := yaml.Marshal(zoneToYAML(zone))
+	require.NoError(t, err)
+	again, err := LoadZoneFromBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, zone.Rooms["room_a"].Ambient, again.Rooms["room_a"].Ambient)
+}
+
+func TestLoadZoneFromBytes_MinRep(t *testing.T) {
+	zone, err := LoadZoneFromBytes([]byte(`
+zone:
+  id: test
+  name: "Test Zone"
+  start_room: room_a
+  rooms:
+    - id: room_a
+      title: "Room A"
+      description: "This is room A."
+      exits:
+        - direction: north
+          target: room_b
+          min_rep:
+            faction: gun
+            min_rep: 25
+      map_x: 0
+      map_y: 0
+    - id: room_b
+      title: "Room B"
+      description: "This is room B."
+      min_rep:
+        faction: machete
+        min_rep: 100
+      map_x: 0
+      map_y: 1
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &RepRequirement{Faction: "gun", MinRep: 25}, zone.Rooms["room_a"].Exits[0].MinRep)
+	assert.Equal(t, &RepRequirement{Faction: "machete", MinRep: 100}, zone.Rooms["room_b"].MinRep)
+
+	data, err := yaml.Marshal(zoneToYAML(zone))
+	require.NoError(t, err)
+	again, err := LoadZoneFromBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, zone.Rooms["room_a"].Exits[0].MinRep, again.Rooms["room_a"].Exits[0].MinRep)
+	assert.Equal(t, zone.Rooms["room_b"].MinRep, again.Rooms["room_b"].MinRep)
+}
+
+func TestLoadZoneFromBytes_MinRepInvalid(t *testing.T) {
+	_, err := LoadZoneFromBytes([]byte(`
+zone:
+  id: test
+  name: "Test Zone"
+  start_room: room_a
+  rooms:
+    - id: room_a
+      title: "Room A"
+      description: "This is room A."
+      min_rep:
+        min_rep: 10
+      map_x: 0
+      map_y: 0
+`))
+	assert.ErrorContains(t, err, "faction must not be empty")
+}
+
+func TestLoadZoneFromBytes_ResetAndFloorItems(t *testing.T) {
+	data := []byte(`
+zone:
+  id: test
+  name: Test Zone
+  description: desc
+  start_room: r1
+  reset:
+    interval: 30m
+    message: The warehouse lights flicker.
+  rooms:
+    - id: r1
+      title: Room 1
+      description: A room.
+      map_x: 0
+      map_y: 0
+      floor_items:
+        - item_id: crowbar
+        - item_id: bandage
+          quantity: 3
+`)
+	z, err := LoadZoneFromBytes(data)
+	require.NoError(t, err)
+	require.NotNil(t, z.Reset)
+	assert.Equal(t, 30*time.Minute, z.Reset.Interval)
+	assert.Equal(t, DefaultZoneResetWarning, z.Reset.Warning)
+	assert.Equal(t, "The warehouse lights flicker.", z.Reset.Message)
+	assert.Equal(t, []RoomFloorItem{{ItemID: "crowbar", Quantity: 1}, {ItemID: "bandage", Quantity: 3}}, z.Rooms["r1"].FloorItems)
+
+	out, err := yaml.Marshal(zoneToYAML(z))
+	require.NoError(t, err)
+	again, err := LoadZoneFromBytes(out)
+	require.NoError(t, err)
+	assert.Equal(t, z.Reset, again.Reset)
+	assert.Equal(t, z.Rooms["r1"].FloorItems, again.Rooms["r1"].FloorItems)
+}
+
+func TestLoadZoneFromBytes_InvalidReset_ReturnsError(t *testing.T) {
+	for name, reset := range map[string]string{
+		"bad interval":     "interval: soon",
+		"warning too long": "interval: 5m\n    warning: 10m",
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := []byte(`
+zone:
+  id: test
+  name: Test Zone
+  description: desc
+  start_room: r1
+  reset:
+    ` + reset + `
+  rooms:
+    - id: r1
+      title: Room 1
+      description: A room.
+      map_x: 0
+      map_y: 0
+`)
+			_, err := LoadZoneFromBytes(data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "reset")
+		})
+	}
+}
@@ -13,6 +13,8 @@ type Manager struct {
 	zones     map[string]*Zone
 	rooms     map[string]*Room
 	startRoom string
+	// doors holds each room's exits as loaded, for ResetDoors.
+	doors map[string][]Exit
 }
 
 // NewManager creates a Manager from the given zones.
@@ -23,6 +25,7 @@ func NewManager(zones []*Zone) (*Manager, error) {
 	m := &Manager{
 		zones: make(map[string]*Zone, len(zones)),
 		rooms: make(map[string]*Room),
+		doors: make(map[string][]Exit),
 	}
 
 	for _, z := range zones {
@@ -35,6 +38,7 @@ func NewManager(zones []*Zone) (*Manager, error) {
 				return nil, fmt.Errorf("duplicate room ID %q: in zone %q and %q", id, existing.ZoneID, z.ID)
 			}
 			m.rooms[id] = room
+			m.doors[id] = append([]Exit(nil), room.Exits...)
 		}
 	}
 
@@ -164,6 +168,61 @@ func (m *Manager) RevealExit(roomID, direction string) bool {
 	return false
 }
 
+// UnlockExit unlocks the exit in the given direction from the specified room.
+//
+// Precondition: roomID must identify a valid room; direction is lowercase (e.g., "north").
+// Postcondition: if a locked exit in that direction exists, it is now unlocked; returns true.
+// Returns false if the room does not exist, the direction is not found, or the exit was already unlocked.
+func (m *Manager) UnlockExit(roomID, direction string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	room, ok := m.rooms[roomID]
+	if !ok {
+		return false
+	}
+	for i := range room.Exits {
+		if strings.EqualFold(string(room.Exits[i].Direction), direction) {
+			if room.Exits[i].Locked {
+				room.Exits[i].Locked = false
+				return true
+			}
+			return false // already unlocked
+		}
+	}
+	return false
+}
+
+// ResetDoors relocks and re-hides every exit in the zone that was locked or
+// hidden when the zone was loaded.
+//
+// Precondition: zoneID may be any string.
+// Postcondition: Returns the number of exits restored; 0 for an unknown zone.
+func (m *Manager) ResetDoors(zoneID string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	zone, ok := m.zones[zoneID]
+	if !ok {
+		return 0
+	}
+	restored := 0
+	for id, room := range zone.Rooms {
+		for _, orig := range m.doors[id] {
+			for i := range room.Exits {
+				e := &room.Exits[i]
+				if e.Direction != orig.Direction {
+					continue
+				}
+				if e.Locked != orig.Locked || e.Hidden != orig.Hidden {
+					e.Locked, e.Hidden = orig.Locked, orig.Hidden
+					restored++
+				}
+				break
+			}
+		}
+	}
+	return restored
+}
+
 // ZoneCount returns the number of loaded zones.
 func (m *Manager) ZoneCount() int {
 	m.mu.RLock()
@@ -209,6 +268,7 @@ func (m *Manager) ReloadZone(zone *Zone) error {
 	if old, ok := m.zones[zone.ID]; ok {
 		for id := range old.Rooms {
 			delete(m.rooms, id)
+			delete(m.doors, id)
 		}
 	}
 	delete(m.zones, zone.ID)
@@ -217,6 +277,7 @@ func (m *Manager) ReloadZone(zone *Zone) error {
 	m.zones[zone.ID] = zone
 	for id, room := range zone.Rooms {
 		m.rooms[id] = room
+		m.doors[id] = append([]Exit(nil), room.Exits...)
 	}
 
 	// Validate exits for the reloaded zone only.
@@ -516,3 +516,69 @@ func TestReloadZoneProperty(t *testing.T) {
 		}
 	})
 }
+
+// TestUnlockExit_LockedExitBecomesPassable verifies that unlocking a locked exit
+// lets Navigate through it, and that a second unlock reports nothing changed.
+//
+// Precondition: room_a's north exit is locked.
+// Postcondition: UnlockExit returns true once, then false; Navigate north succeeds.
+func TestUnlockExit_LockedExitBecomesPassable(t *testing.T) {
+	mgr := testZoneWithHiddenExit(t)
+	room, ok := mgr.GetRoom("room_a")
+	require.True(t, ok)
+	room.Exits[0].Locked = true
+
+	_, err := mgr.Navigate("room_a", North)
+	require.Error(t, err)
+
+	assert.True(t, mgr.UnlockExit("room_a", "north"))
+	_, err = mgr.Navigate("room_a", North)
+	assert.NoError(t, err)
+	assert.False(t, mgr.UnlockExit("room_a", "north"), "an unlocked exit reports no change")
+	assert.False(t, mgr.UnlockExit("no_such_room", "north"))
+}
+
+func TestResetDoors_RestoresLoadedState(t *testing.T) {
+	zone := validTestZone()
+	zone.Rooms["room_a"].Exits[0].Locked = true
+	zone.Rooms["room_b"].Exits[0].Hidden = true
+	mgr, err := NewManager([]*Zone{zone})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, mgr.ResetDoors("test"), "nothing to restore before anyone touches a door")
+	require.True(t, mgr.UnlockExit("room_a", "north"))
+	require.True(t, mgr.RevealExit("room_b", "south"))
+
+	assert.Equal(t, 2, mgr.ResetDoors("test"))
+	a, _ := mgr.GetRoom("room_a")
+	b, _ := mgr.GetRoom("room_b")
+	assert.True(t, a.Exits[0].Locked)
+	assert.True(t, b.Exits[0].Hidden)
+	assert.Equal(t, 0, mgr.ResetDoors("no_such_zone"))
+}
+
+func TestProperty_ResetDoors_UndoesAnyUnlocks(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		lockA := rapid.Bool().Draw(t, "lockA")
+		lockB := rapid.Bool().Draw(t, "lockB")
+		zone := validTestZone()
+		zone.Rooms["room_a"].Exits[0].Locked = lockA
+		zone.Rooms["room_b"].Exits[0].Locked = lockB
+		mgr, err := NewManager([]*Zone{zone})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rapid.Bool().Draw(t, "unlockA") {
+			mgr.UnlockExit("room_a", "north")
+		}
+		if rapid.Bool().Draw(t, "unlockB") {
+			mgr.UnlockExit("room_b", "south")
+		}
+		mgr.ResetDoors("test")
+		a, _ := mgr.GetRoom("room_a")
+		b, _ := mgr.GetRoom("room_b")
+		if a.Exits[0].Locked != lockA || b.Exits[0].Locked != lockB {
+			t.Fatalf("doors not restored: got %v/%v, want %v/%v", a.Exits[0].Locked, b.Exits[0].Locked, lockA, lockB)
+		}
+	})
+}
@@ -82,8 +82,14 @@ type Exit struct {
 	TargetRoom string
 	// Locked indicates the exit requires a key or condition to pass.
 	Locked bool
+	// LockDC is the difficulty of picking a locked exit; 0 means it can't be picked.
+	LockDC int
 	// Hidden indicates the exit is not visible by default.
 	Hidden bool
+	// Road marks an exit a car can drive along.
+	Road bool
+	// MinRep, when set, bars players below the reputation from taking the exit.
+	MinRep  *RepRequirement
 	ClimbDC int `yaml:"climb_dc"` // 0 = not climbable (unless terrain default applies)
 	Height  int `yaml:"height"`   // feet; used for fall damage: max(1, floor(Height/10)) d6
 	SwimDC  int `yaml:"swim_dc"`  // 0 = not swimmable (unless terrain default applies)
@@ -124,6 +130,30 @@ type RoomEquipmentConfig struct {
 	// TrapTemplate is the trap template ID assigned to this equipment item.
 	// "" means no trap. Set by static YAML authoring or procedural generation.
 	TrapTemplate string `yaml:"trap_template,omitempty"`
+	// LockDC, when positive, locks the item until a player picks it at this difficulty.
+	LockDC int `yaml:"lock_dc,omitempty"`
+}
+
+// RoomFloorItem is an item a zone reset puts back on a room's floor.
+type RoomFloorItem struct {
+	// ItemID references inventory.ItemDef.ID.
+	ItemID string
+	// Quantity is how many of the item the floor holds after a reset.
+	Quantity int
+}
+
+// DefaultZoneResetWarning is how long before a reset players in the zone are
+// warned when the zone does not set its own warning.
+const DefaultZoneResetWarning = time.Minute
+
+// ZoneReset configures a zone's periodic repop.
+type ZoneReset struct {
+	// Interval is the time between resets.
+	Interval time.Duration
+	// Warning is how long before a reset players in the zone are warned.
+	Warning time.Duration
+	// Message is the warning players see; empty uses a stock message.
+	Message string
 }
 
 // RoomEffect declares a persistent mental-state aura for a room.
@@ -152,8 +182,9 @@ type RoomEffect struct {
 type RoomTrapConfig struct {
 	// TemplateID references the TrapTemplate in content/traps/.
 	TemplateID string `yaml:"template"`
-	// Position is "room" for a room-level trap or a RoomEquipmentConfig.Description
-	// string to attach the trap to a specific equipment item.
+	// Position is "room" for a room-level trap, "exit:<direction>" for a door
+	// trap on that exit, or a RoomEquipmentConfig.Description string to attach
+	// the trap to a specific equipment item.
 	Position string `yaml:"position"`
 }
 
@@ -175,6 +206,9 @@ type Room struct {
 	Spawns []RoomSpawnConfig
 	// Equipment lists static or respawning items present in this room.
 	Equipment []RoomEquipmentConfig
+	// FloorItems lists loose items dropped on the floor at startup and put
+	// back by each zone reset.
+	FloorItems []RoomFloorItem
 	// MapX is the column position of this room on the zone map grid (required).
 	MapX int
 	// MapY is the row position of this room on the zone map grid (required).
@@ -183,7 +217,8 @@ type Room struct {
 	SkillChecks []skillcheck.TriggerDef
 	// Effects lists persistent mental-state auras that apply to players in this room.
 	Effects []RoomEffect
-	// Terrain is an optional terrain type tag: rubble, cliff, wall, sewer, river, ocean, flooded.
+	// Terrain is an optional terrain type tag: difficult, rubble, cliff, wall, sewer,
+	// shallows, river, ocean, flooded. See TerrainRuleFor for the movement rules.
 	Terrain string `yaml:"terrain"`
 	// DangerLevel overrides the zone's danger level for this specific room.
 	// Empty string means inherit from the zone.
@@ -211,9 +246,30 @@ type Room struct {
 	// MinFactionTierID is the minimum faction tier ID required to enter this room.
 	// Empty string means no gating.
 	MinFactionTierID string `yaml:"min_faction_tier_id"`
+	// MinRep, when set, bars players below the reputation from entering this
+	// room by any exit.
+	MinRep *RepRequirement `yaml:"min_rep,omitempty"`
 	// AmbientSubstance is the substance ID dosed to players in this room every 60s
 	// by the ambient substance ticker. Empty string means no ambient dosing.
 	AmbientSubstance string `yaml:"ambient_substance,omitempty"`
+	// Ambient lists flavor lines occasionally shown to players in this room on
+	// zone ticks. Empty means the room is quiet.
+	Ambient []string `yaml:"ambient,omitempty"`
+	// Housing marks the room as rentable player housing. nil means not rentable.
+	Housing *HousingConfig `yaml:"housing,omitempty"`
+}
+
+// HousingConfig holds the rent terms for a rentable room.
+type HousingConfig struct {
+	// Rent is the credits charged from the tenant's stash each period.
+	Rent int `yaml:"rent"`
+	// PeriodHours is the real-time length of one rent period.
+	PeriodHours int `yaml:"period_hours"`
+}
+
+// Period returns the rent period as a duration.
+func (h *HousingConfig) Period() time.Duration {
+	return time.Duration(h.PeriodHours) * time.Hour
 }
 
 // HazardDef defines an environmental hazard in a room.
@@ -362,6 +418,8 @@ type Zone struct {
 	// ZoneEffects defines persistent mental-state auras applied to every room in the zone.
 	// At world load time these are appended to each room's Effects slice.
 	ZoneEffects []RoomEffect `yaml:"zone_effects,omitempty"`
+	// Reset configures the zone's periodic repop; nil means the zone never resets.
+	Reset *ZoneReset `yaml:"reset,omitempty"`
 }
 
 // NPCLevelRegistry is the minimal interface needed to look up NPC template levels.
@@ -477,8 +535,21 @@ func (z *Zone) Validate() error {
 			if exit.TargetRoom == "" {
 				return fmt.Errorf("zone %q: room %q: exit %q has empty target", z.ID, id, exit.Direction)
 			}
+			if exit.MinRep != nil {
+				if err := exit.MinRep.Validate(); err != nil {
+					return fmt.Errorf("zone %q: room %q: exit %q: %w", z.ID, id, exit.Direction, err)
+				}
+			}
 			// Cross-zone exits are validated at the Manager level via ValidateExits.
 		}
+		if room.MinRep != nil {
+			if err := room.MinRep.Validate(); err != nil {
+				return fmt.Errorf("zone %q: room %q: %w", z.ID, id, err)
+			}
+		}
+		if h := room.Housing; h != nil && (h.Rent < 1 || h.PeriodHours < 1) {
+			return fmt.Errorf("zone %q: room %q: housing rent and period_hours must be >= 1", z.ID, id)
+		}
 		for i, s := range room.Spawns {
 			if s.Template == "" {
 				return fmt.Errorf("zone %q: room %q: spawn[%d]: template must not be empty", z.ID, id, i)
@@ -492,6 +563,22 @@ func (z *Zone) Validate() error {
 				}
 			}
 		}
+		for i, f := range room.FloorItems {
+			if f.ItemID == "" {
+				return fmt.Errorf("zone %q: room %q: floor_items[%d]: item_id must not be empty", z.ID, id, i)
+			}
+			if f.Quantity < 1 {
+				return fmt.Errorf("zone %q: room %q: floor_items[%d]: quantity must be >= 1", z.ID, id, i)
+			}
+		}
+	}
+	if r := z.Reset; r != nil {
+		if r.Interval <= 0 {
+			return fmt.Errorf("zone %q: reset interval must be positive", z.ID)
+		}
+		if r.Warning < 0 || r.Warning >= r.Interval {
+			return fmt.Errorf("zone %q: reset warning must be at least 0 and shorter than the interval", z.ID)
+		}
 	}
 	// Enforce unique map coordinates across all rooms.
 	coordSeen := make(map[[2]int]string) // (x,y) → first room ID
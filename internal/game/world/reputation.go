@@ -0,0 +1,33 @@
+package world
+
+import "fmt"
+
+// RepRequirement gates a room, exit, or merchant stock entry behind a minimum
+// reputation with one faction. Reputation counts whether or not the player has
+// joined the faction.
+type RepRequirement struct {
+	// Faction is the faction ID whose reputation is checked.
+	Faction string `yaml:"faction"`
+	// MinRep is the lowest reputation score that passes.
+	MinRep int `yaml:"min_rep"`
+}
+
+// Validate reports a malformed requirement.
+//
+// Precondition: r must not be nil.
+// Postcondition: Returns nil iff Faction is set and MinRep is positive.
+func (r *RepRequirement) Validate() error {
+	if r.Faction == "" {
+		return fmt.Errorf("min_rep: faction must not be empty")
+	}
+	if r.MinRep < 1 {
+		return fmt.Errorf("min_rep: min_rep for faction %q must be >= 1, got %d", r.Faction, r.MinRep)
+	}
+	return nil
+}
+
+// Met reports whether a player with factionRep (faction ID → reputation)
+// satisfies r. A nil requirement is always met.
+func (r *RepRequirement) Met(factionRep map[string]int) bool {
+	return r == nil || factionRep[r.Faction] >= r.MinRep
+}
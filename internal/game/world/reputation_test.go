@@ -0,0 +1,29 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+func TestRepRequirement_NilAlwaysMet(t *testing.T) {
+	var r *RepRequirement
+	assert.True(t, r.Met(nil))
+}
+
+func TestRepRequirement_Validate(t *testing.T) {
+	assert.NoError(t, (&RepRequirement{Faction: "gun", MinRep: 1}).Validate())
+	assert.Error(t, (&RepRequirement{MinRep: 10}).Validate())
+	assert.Error(t, (&RepRequirement{Faction: "gun"}).Validate())
+}
+
+func TestProperty_RepRequirement_MetIffRepReachesMin(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		min := rapid.IntRange(1, 500).Draw(rt, "min")
+		rep := rapid.IntRange(0, 1000).Draw(rt, "rep")
+		r := &RepRequirement{Faction: "gun", MinRep: min}
+		assert.Equal(rt, rep >= min, r.Met(map[string]int{"gun": rep}))
+		assert.False(rt, r.Met(map[string]int{"machete": rep}), "reputation with another faction must not count")
+	})
+}
@@ -0,0 +1,77 @@
+package world
+
+import "sort"
+
+// Loudness is how far a sound carries beyond the room it is made in.
+type Loudness int
+
+const (
+	// LoudnessRoom sounds are heard only in the room they are made in.
+	LoudnessRoom Loudness = iota
+	// LoudnessAdjacent sounds carry, muffled, into every room one exit away.
+	LoudnessAdjacent
+	// LoudnessZone sounds carry across the whole zone.
+	LoudnessZone
+)
+
+// Hearing is a room that hears a sound made elsewhere.
+type Hearing struct {
+	RoomID string
+	// Direction is the exit in RoomID that leads toward the sound; empty when
+	// the sound is only heard somewhere in the distance.
+	Direction Direction
+}
+
+// SoundReach returns the rooms, other than roomID, that hear a sound of the
+// given loudness made in roomID. Adjacent rooms carry the direction the sound
+// comes from; the rest of the zone hears it without one.
+//
+// Precondition: roomID should name a loaded room.
+// Postcondition: Returns nil for LoudnessRoom or an unknown room; otherwise
+// one Hearing per room, sorted by RoomID.
+func (m *Manager) SoundReach(roomID string, loud Loudness) []Hearing {
+	if loud <= LoudnessRoom {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	src, ok := m.rooms[roomID]
+	if !ok {
+		return nil
+	}
+
+	heard := make(map[string]Direction)
+	for _, exit := range src.Exits {
+		dest, ok := m.rooms[exit.TargetRoom]
+		if !ok || dest.ID == roomID {
+			continue
+		}
+		if _, seen := heard[dest.ID]; seen {
+			continue
+		}
+		dir := exit.Direction.Opposite()
+		for _, back := range dest.Exits {
+			if back.TargetRoom == roomID {
+				dir = back.Direction
+				break
+			}
+		}
+		heard[dest.ID] = dir
+	}
+	if loud >= LoudnessZone {
+		if zone, ok := m.zones[src.ZoneID]; ok {
+			for id := range zone.Rooms {
+				if _, seen := heard[id]; !seen && id != roomID {
+					heard[id] = ""
+				}
+			}
+		}
+	}
+
+	out := make([]Hearing, 0, len(heard))
+	for id, dir := range heard {
+		out = append(out, Hearing{RoomID: id, Direction: dir})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].RoomID < out[j].RoomID })
+	return out
+}
@@ -0,0 +1,81 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// soundTestManager builds a street with a bar to the east, a cellar below
+// the bar reached only by a one-way hatch, a far corner two rooms away, and
+// a second zone next door.
+func soundTestManager(t *testing.T) *Manager {
+	t.Helper()
+	street := &Zone{ID: "street", Name: "Street", StartRoom: "road", Rooms: map[string]*Room{
+		"road": {ID: "road", ZoneID: "street", Exits: []Exit{
+			{Direction: East, TargetRoom: "bar"},
+			{Direction: West, TargetRoom: "docks"},
+		}},
+		"bar": {ID: "bar", ZoneID: "street", Exits: []Exit{
+			{Direction: West, TargetRoom: "road"},
+			{Direction: East, TargetRoom: "corner"},
+			{Direction: Down, TargetRoom: "cellar"},
+		}},
+		"cellar": {ID: "cellar", ZoneID: "street"},
+		"corner": {ID: "corner", ZoneID: "street", Exits: []Exit{
+			{Direction: West, TargetRoom: "bar"},
+		}},
+	}}
+	docks := &Zone{ID: "docks", Name: "Docks", StartRoom: "docks", Rooms: map[string]*Room{
+		"docks": {ID: "docks", ZoneID: "docks", Exits: []Exit{{Direction: East, TargetRoom: "road"}}},
+		"pier":  {ID: "pier", ZoneID: "docks"},
+	}}
+	m, err := NewManager([]*Zone{street, docks})
+	require.NoError(t, err)
+	return m
+}
+
+func TestSoundReach_Adjacent(t *testing.T) {
+	m := soundTestManager(t)
+	assert.Nil(t, m.SoundReach("bar", LoudnessRoom))
+	assert.Nil(t, m.SoundReach("nowhere", LoudnessZone))
+
+	assert.Equal(t, []Hearing{
+		{RoomID: "cellar", Direction: Up},
+		{RoomID: "corner", Direction: West},
+		{RoomID: "road", Direction: East},
+	}, m.SoundReach("bar", LoudnessAdjacent))
+
+	assert.Equal(t, []Hearing{
+		{RoomID: "bar", Direction: West},
+		{RoomID: "docks", Direction: East},
+	}, m.SoundReach("road", LoudnessAdjacent), "sound crosses into the next zone one room deep")
+}
+
+func TestSoundReach_Zone(t *testing.T) {
+	m := soundTestManager(t)
+	assert.Equal(t, []Hearing{
+		{RoomID: "pier"},
+		{RoomID: "road", Direction: West},
+	}, m.SoundReach("docks", LoudnessZone), "the rest of the zone hears it without a direction")
+}
+
+func TestProperty_SoundReach_NeverIncludesSourceAndGrowsWithLoudness(t *testing.T) {
+	m := soundTestManager(t)
+	rooms := []string{"road", "bar", "cellar", "corner", "docks", "pier"}
+	rapid.Check(t, func(rt *rapid.T) {
+		src := rapid.SampledFrom(rooms).Draw(rt, "room")
+		adjacent := m.SoundReach(src, LoudnessAdjacent)
+		zone := m.SoundReach(src, LoudnessZone)
+		if len(zone) < len(adjacent) {
+			rt.Fatalf("zone reach %v smaller than adjacent reach %v", zone, adjacent)
+		}
+		for _, h := range zone {
+			if h.RoomID == src {
+				rt.Fatalf("%q hears its own sound", src)
+			}
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package world
+
+import "time"
+
+// Item tags that let a player cross terrain without a skill check.
+const (
+	GearSwim  = "swim_gear"
+	GearClimb = "climbing_gear"
+)
+
+// TerrainRule describes what it takes to enter a room with a given Terrain tag.
+type TerrainRule struct {
+	// Verb describes how the player crosses into the room ("swim", "climb").
+	Verb string
+	// DC is the muscle check DC to enter without Gear; 0 means no check.
+	DC int
+	// Gear is the item tag that lets a player enter without a check; empty
+	// means no gear helps.
+	Gear string
+	// MoveDelay is the extra time the crossing takes before the player can move again.
+	MoveDelay time.Duration
+	// Water marks terrain a boat can travel through.
+	Water bool
+	// Deep marks water a player drowns in when lingering without Gear.
+	Deep bool
+	// Fall marks vertical terrain where a critical failure drops the player.
+	Fall bool
+}
+
+// terrainRules maps room Terrain tags to their movement rules. DCs match the
+// exit defaults used by the climb and swim commands.
+var terrainRules = map[string]TerrainRule{
+	"difficult": {Verb: "pick your way", MoveDelay: 2 * time.Second},
+	"rubble":    {Verb: "scramble", DC: 12, MoveDelay: 3 * time.Second},
+	"sewer":     {Verb: "wade", DC: 10, Gear: GearSwim, MoveDelay: 3 * time.Second, Water: true},
+	"shallows":  {Verb: "wade", DC: 10, Gear: GearSwim, MoveDelay: 3 * time.Second, Water: true},
+	"flooded":   {Verb: "swim", DC: 12, Gear: GearSwim, MoveDelay: 4 * time.Second, Water: true, Deep: true},
+	"river":     {Verb: "swim", DC: 15, Gear: GearSwim, MoveDelay: 4 * time.Second, Water: true, Deep: true},
+	"ocean":     {Verb: "swim", DC: 20, Gear: GearSwim, MoveDelay: 5 * time.Second, Water: true, Deep: true},
+	"wall":      {Verb: "climb", DC: 15, Gear: GearClimb, MoveDelay: 5 * time.Second, Fall: true},
+	"cliff":     {Verb: "climb", DC: 20, Gear: GearClimb, MoveDelay: 6 * time.Second, Fall: true},
+}
+
+// TerrainRuleFor returns the movement rule for a room's Terrain tag.
+//
+// Postcondition: ok is false for an empty or unrecognised tag, meaning
+// the room is entered normally.
+func TerrainRuleFor(terrain string) (rule TerrainRule, ok bool) {
+	rule, ok = terrainRules[terrain]
+	return rule, ok
+}
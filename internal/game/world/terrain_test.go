@@ -0,0 +1,55 @@
+package world
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerrainRuleFor(t *testing.T) {
+	_, ok := TerrainRuleFor("")
+	assert.False(t, ok)
+	_, ok = TerrainRuleFor("lava")
+	assert.False(t, ok)
+
+	river, ok := TerrainRuleFor("river")
+	require.True(t, ok)
+	assert.True(t, river.Deep)
+	assert.Equal(t, GearSwim, river.Gear)
+	assert.Equal(t, 15, river.DC)
+
+	cliff, ok := TerrainRuleFor("cliff")
+	require.True(t, ok)
+	assert.True(t, cliff.Fall)
+	assert.Equal(t, GearClimb, cliff.Gear)
+
+	difficult, ok := TerrainRuleFor("difficult")
+	require.True(t, ok)
+	assert.Zero(t, difficult.DC, "difficult terrain only slows movement")
+	assert.Positive(t, difficult.MoveDelay)
+}
+
+func TestTerrainRules_AreConsistent(t *testing.T) {
+	for name, rule := range terrainRules {
+		assert.NotEmpty(t, rule.Verb, name)
+		assert.Positive(t, rule.MoveDelay, name)
+		if rule.Deep {
+			assert.Equal(t, GearSwim, rule.Gear, "%s: deep water needs swim gear to be survivable", name)
+		}
+		if rule.Fall {
+			assert.Equal(t, GearClimb, rule.Gear, name)
+		}
+	}
+}
+
+func TestLoadZoneFromBytes_TerrainFromProperties(t *testing.T) {
+	yamlSrc := strings.Replace(validZoneYAML, "        lighting: bright\n", "        lighting: bright\n        terrain: rubble\n", 1)
+	yamlSrc = strings.Replace(yamlSrc, "      description: \"This is room B.\"\n", "      description: \"This is room B.\"\n      terrain: river\n", 1)
+	zone, err := LoadZoneFromBytes([]byte(yamlSrc))
+	require.NoError(t, err)
+	assert.Equal(t, "rubble", zone.Rooms["room_a"].Terrain)
+	assert.Equal(t, "river", zone.Rooms["room_b"].Terrain)
+	assert.Empty(t, zone.Rooms["room_c"].Terrain)
+}
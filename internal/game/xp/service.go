@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cory-johannsen/mud/internal/game/session"
 )
@@ -28,6 +30,11 @@ type Service struct {
 	cfg         *XPConfig
 	saver       ProgressSaver
 	skillSaver  SkillIncreaseSaver
+
+	// bonus multiplies every award until bonusUntil; guarded by bonusMu.
+	bonusMu    sync.Mutex
+	bonus      float64
+	bonusUntil time.Time
 }
 
 // NewService creates a new XP Service.
@@ -45,6 +52,30 @@ func (s *Service) SetSkillIncreaseSaver(saver SkillIncreaseSaver) {
 	s.skillSaver = saver
 }
 
+// SetBonus multiplies every XP award by multiplier until until, replacing
+// any bonus already running.
+//
+// Precondition: multiplier > 0.
+// Postcondition: Bonus reports multiplier until until passes.
+func (s *Service) SetBonus(multiplier float64, until time.Time) {
+	s.bonusMu.Lock()
+	defer s.bonusMu.Unlock()
+	s.bonus = multiplier
+	s.bonusUntil = until
+}
+
+// Bonus returns the XP multiplier in effect at now and when it ends.
+//
+// Postcondition: Returns (1, zero time) when no bonus is running.
+func (s *Service) Bonus(now time.Time) (multiplier float64, until time.Time) {
+	s.bonusMu.Lock()
+	defer s.bonusMu.Unlock()
+	if s.bonus <= 0 || !now.Before(s.bonusUntil) {
+		return 1, time.Time{}
+	}
+	return s.bonus, s.bonusUntil
+}
+
 // Config returns the XPConfig used by this Service.
 //
 // Postcondition: Returns a non-nil *XPConfig.
@@ -131,6 +162,9 @@ func skillDisplayName(id string) string {
 
 // award applies awardXP to sess and handles level-up side effects.
 func (s *Service) award(ctx context.Context, sess *session.PlayerSession, characterID int64, awardXP int) ([]string, error) {
+	if multiplier, _ := s.Bonus(time.Now()); multiplier != 1 {
+		awardXP = int(math.Round(float64(awardXP) * multiplier))
+	}
 	result := Award(sess.Level, sess.Experience, awardXP, s.cfg)
 	sess.Experience = result.NewXP
 	sess.Level = result.NewLevel
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -268,6 +269,30 @@ func TestService_AwardXPAmount_AwardsCorrectXP(t *testing.T) {
 	assert.Equal(t, 25, sess.Experience, "expected 25 XP awarded")
 }
 
+func TestService_Bonus_MultipliesAwardsUntilItEnds(t *testing.T) {
+	svc := xp.NewService(testCfg(), &fakeProgressSaver{})
+	sess := testSess(1, 0, 10)
+
+	multiplier, _ := svc.Bonus(time.Now())
+	assert.Equal(t, 1.0, multiplier)
+
+	until := time.Now().Add(time.Hour)
+	svc.SetBonus(1.5, until)
+	multiplier, ends := svc.Bonus(time.Now())
+	assert.Equal(t, 1.5, multiplier)
+	assert.Equal(t, until, ends)
+	_, err := svc.AwardXPAmount(context.Background(), sess, 0, 25)
+	require.NoError(t, err)
+	assert.Equal(t, 38, sess.Experience, "25 x 1.5 rounds to 38")
+
+	multiplier, _ = svc.Bonus(until)
+	assert.Equal(t, 1.0, multiplier, "the bonus ends at until")
+	svc.SetBonus(2, time.Now().Add(-time.Second))
+	_, err = svc.AwardXPAmount(context.Background(), sess, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 48, sess.Experience, "an expired bonus is ignored")
+}
+
 func TestService_AwardXPAmount_ZeroXP_NoChange(t *testing.T) {
 	saver := &fakeProgressSaver{}
 	svc := xp.NewService(testCfg(), saver)
@@ -29,6 +29,35 @@ func (a *AccountRepoAdapter) GetAccountByUsername(ctx context.Context, username
 	}, nil
 }
 
+// GetAccountLocale returns the preferred message locale for an account.
+func (a *AccountRepoAdapter) GetAccountLocale(ctx context.Context, accountID int64) (string, error) {
+	acct, err := a.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+	return acct.Locale, nil
+}
+
+// SetAccountLocale updates an account's preferred message locale.
+func (a *AccountRepoAdapter) SetAccountLocale(ctx context.Context, accountID int64, locale string) error {
+	return a.repo.SetLocale(ctx, accountID, locale)
+}
+
+// SetAccountCharacterSlots sets an account's character limit override; 0 clears it.
+func (a *AccountRepoAdapter) SetAccountCharacterSlots(ctx context.Context, accountID int64, slots int) error {
+	return a.repo.SetCharacterSlots(ctx, accountID, slots)
+}
+
+// LoadSharedStash returns the balance of the stash shared by an account's characters.
+func (a *AccountRepoAdapter) LoadSharedStash(ctx context.Context, accountID int64) (int, error) {
+	return a.repo.LoadSharedStash(ctx, accountID)
+}
+
+// AdjustSharedStash adds delta to an account's shared stash and returns the new balance.
+func (a *AccountRepoAdapter) AdjustSharedStash(ctx context.Context, accountID int64, delta int) (int, error) {
+	return a.repo.AdjustSharedStash(ctx, accountID, delta)
+}
+
 // SetAccountRole updates an account's role.
 func (a *AccountRepoAdapter) SetAccountRole(ctx context.Context, accountID int64, role string) error {
 	return a.repo.SetRole(ctx, accountID, role)
@@ -260,7 +260,7 @@ func (h *CombatHandler) buildItemCombatSnapshot(
 ) ai.ItemCombatSnapshot {
 	var enemies []ai.ItemEnemySnapshot
 	for _, c := range cbt.Combatants {
-		if c.Kind != combat.KindNPC || c.IsDead() {
+		if !c.IsHostileNPC() || c.IsDead() {
 			continue
 		}
 		var condIDs []string
@@ -14,6 +14,26 @@ type GameDateTime struct {
 	Tick  int64    // monotonically-increasing game-hour counter; 0 on first boot
 }
 
+// Season returns the season dt falls in: spring, summer, fall, or winter.
+func (dt GameDateTime) Season() string {
+	return SeasonForMonth(dt.Month)
+}
+
+// DayOfSeason returns which day of its season dt falls on, counting from 1.
+// Seasons start on the first of March, June, September, and December.
+//
+// Precondition: dt.Month in [1,12]; dt.Day in [1,31].
+func (dt GameDateTime) DayOfSeason() int {
+	// Same non-leap year as the calendar goroutine; winter starts in the year before.
+	date := time.Date(2001, time.Month(dt.Month), dt.Day, 0, 0, 0, 0, time.UTC)
+	startMonth := (dt.Month / 3) * 3
+	start := time.Date(2001, time.Month(startMonth), 1, 0, 0, 0, 0, time.UTC)
+	if startMonth == 0 {
+		start = time.Date(2000, time.December, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return int(date.Sub(start).Hours()/24) + 1
+}
+
 // CalendarRepo persists the in-game day, month, hour, and tick across server restarts.
 //
 // Precondition for Load: table may be empty (first boot).
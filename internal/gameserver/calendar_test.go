@@ -35,6 +35,46 @@ func TestFormatDate_OrdinalSuffixes(t *testing.T) {
 	}
 }
 
+func TestGameDateTime_SeasonAndDayOfSeason(t *testing.T) {
+	cases := []struct {
+		month, day int
+		season     string
+		dayOf      int
+	}{
+		{3, 1, "spring", 1},
+		{5, 31, "spring", 92},
+		{6, 1, "summer", 1},
+		{9, 15, "fall", 15},
+		{12, 1, "winter", 1},
+		{1, 1, "winter", 32},
+		{2, 28, "winter", 90},
+	}
+	for _, c := range cases {
+		dt := GameDateTime{Month: c.month, Day: c.day}
+		assert.Equal(t, c.season, dt.Season(), "%d/%d", c.month, c.day)
+		assert.Equal(t, c.dayOf, dt.DayOfSeason(), "%d/%d", c.month, c.day)
+	}
+}
+
+func TestProperty_DayOfSeason_AdvancesWithinASeason(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		day := rapid.IntRange(0, 364).Draw(rt, "dayOfYear")
+		date := time.Date(2001, time.January, 1+day, 0, 0, 0, 0, time.UTC)
+		next := date.AddDate(0, 0, 1)
+		dt := GameDateTime{Month: int(date.Month()), Day: date.Day()}
+		nextDT := GameDateTime{Month: int(next.Month()), Day: next.Day()}
+		if dt.DayOfSeason() < 1 || dt.DayOfSeason() > 92 {
+			rt.Fatalf("day of season %d out of range for %v", dt.DayOfSeason(), date)
+		}
+		if nextDT.Season() == dt.Season() && nextDT.DayOfSeason() != dt.DayOfSeason()+1 {
+			rt.Fatalf("%v -> %v: day of season %d -> %d", date, next, dt.DayOfSeason(), nextDT.DayOfSeason())
+		}
+		if nextDT.Season() != dt.Season() && nextDT.DayOfSeason() != 1 {
+			rt.Fatalf("a new season starts on day 1, got %d", nextDT.DayOfSeason())
+		}
+	})
+}
+
 func TestGameCalendar_BroadcastsEveryTick(t *testing.T) {
 	clock := NewGameClock(6, 50*time.Millisecond)
 	cal := NewGameCalendar(clock, 1, 1, 0, &noopRepo{})
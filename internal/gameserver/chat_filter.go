@@ -0,0 +1,216 @@
+package gameserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/game/permission"
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+// ErrChatMuted is wrapped by ChatFilter.Apply when the sender is muted.
+var ErrChatMuted = errors.New("muted")
+
+// chatLinkPattern matches http(s) URLs and bare www. hosts.
+var chatLinkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// chatFilterState tracks one sender's recent messages and mute status.
+type chatFilterState struct {
+	// sent holds the timestamps of messages inside the current rate-limit window.
+	sent []time.Time
+	// strikes counts rate-limit violations; it selects the next mute duration.
+	strikes int
+	// mutedUntil is the instant the current mute expires; zero when not muted.
+	mutedUntil time.Time
+}
+
+// ChatFilter masks listed words, strips links, and rate-limits chat senders,
+// muting repeat offenders for escalating durations.
+//
+// Roles granted permission.CanBypassChatFilter (editors and admins by
+// default) bypass the filter so they can test content verbatim.
+// All methods are safe for concurrent use.
+type ChatFilter struct {
+	mu         sync.Mutex
+	wordRe     *regexp.Regexp
+	stripLinks bool
+	rateLimit  int
+	window     time.Duration
+	mutes      []time.Duration
+	state      map[string]*chatFilterState
+	now        func() time.Time
+	// perms decides filter bypass; nil applies the default matrix.
+	perms *permission.Matrix
+}
+
+// NewChatFilter builds a ChatFilter from cfg and the given masked word list.
+//
+// Precondition: cfg must have passed config validation.
+// Postcondition: Returns a non-nil filter; an empty word list disables masking.
+func NewChatFilter(cfg config.ChatConfig, words []string) *ChatFilter {
+	f := &ChatFilter{
+		stripLinks: cfg.StripLinks,
+		rateLimit:  cfg.RateLimitMessages,
+		window:     cfg.RateLimitWindow,
+		mutes:      cfg.MuteDurations,
+		state:      make(map[string]*chatFilterState),
+		now:        time.Now,
+	}
+	var quoted []string
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			quoted = append(quoted, regexp.QuoteMeta(w))
+		}
+	}
+	if len(quoted) > 0 {
+		f.wordRe = regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+	}
+	return f
+}
+
+// LoadChatFilterWords reads a YAML list of masked words from path.
+//
+// Precondition: path must name a readable YAML file containing a "words" list.
+// Postcondition: Returns the word list or a non-nil error.
+func LoadChatFilterWords(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading chat filter words %q: %w", path, err)
+	}
+	var doc struct {
+		Words []string `yaml:"words"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing chat filter words %q: %w", path, err)
+	}
+	return doc.Words, nil
+}
+
+// SetPermissions replaces the matrix deciding who bypasses the filter.
+//
+// Precondition: m may be nil to use the embedded default matrix.
+func (f *ChatFilter) SetPermissions(m *permission.Matrix) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.perms = m
+}
+
+// bypasses reports whether sess is exempt from chat filtering.
+func (f *ChatFilter) bypasses(sess *session.PlayerSession) bool {
+	f.mu.Lock()
+	perms := f.perms
+	f.mu.Unlock()
+	return perms.Allows(sess.Role, permission.CanBypassChatFilter)
+}
+
+// Apply filters text sent by sess.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: Returns the cleaned text, or an error wrapping ErrChatMuted
+// when the sender is muted (including a mute just triggered by exceeding the
+// rate limit). Cleaned text may be empty when only links were sent.
+func (f *ChatFilter) Apply(sess *session.PlayerSession, text string) (string, error) {
+	if f.bypasses(sess) {
+		return text, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := f.now()
+	st := f.stateFor(sess.UID)
+	if now.Before(st.mutedUntil) {
+		return "", fmt.Errorf("%w: you cannot chat for another %s", ErrChatMuted, st.mutedUntil.Sub(now).Round(time.Second))
+	}
+	if f.rateLimit > 0 {
+		cutoff := now.Add(-f.window)
+		kept := st.sent[:0]
+		for _, t := range st.sent {
+			if t.After(cutoff) {
+				kept = append(kept, t)
+			}
+		}
+		st.sent = kept
+		if len(st.sent) >= f.rateLimit {
+			d := f.nextMute(st)
+			st.mutedUntil = now.Add(d)
+			st.sent = nil
+			return "", fmt.Errorf("%w: slow down — you have been muted for %s", ErrChatMuted, d)
+		}
+		st.sent = append(st.sent, now)
+	}
+	if f.stripLinks {
+		text = strings.TrimSpace(chatLinkPattern.ReplaceAllString(text, "[link removed]"))
+	}
+	if f.wordRe != nil {
+		text = f.wordRe.ReplaceAllStringFunc(text, func(w string) string {
+			return strings.Repeat("*", len([]rune(w)))
+		})
+	}
+	return text, nil
+}
+
+// nextMute records a strike and returns the mute duration it earns.
+//
+// Precondition: f.mu must be held.
+func (f *ChatFilter) nextMute(st *chatFilterState) time.Duration {
+	st.strikes++
+	if len(f.mutes) == 0 {
+		return time.Minute
+	}
+	i := st.strikes - 1
+	if i >= len(f.mutes) {
+		i = len(f.mutes) - 1
+	}
+	return f.mutes[i]
+}
+
+// stateFor returns the mutable state for uid, creating it on first use.
+//
+// Precondition: f.mu must be held.
+func (f *ChatFilter) stateFor(uid string) *chatFilterState {
+	st, ok := f.state[uid]
+	if !ok {
+		st = &chatFilterState{}
+		f.state[uid] = st
+	}
+	return st
+}
+
+// Mute silences uid for d, overriding any automatic mute.
+//
+// Precondition: d must be > 0.
+// Postcondition: Apply rejects messages from uid until d has elapsed.
+func (f *ChatFilter) Mute(uid string, d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stateFor(uid).mutedUntil = f.now().Add(d)
+}
+
+// Unmute lifts any mute on uid and clears its strike history.
+//
+// Postcondition: Apply accepts messages from uid subject to the rate limit.
+func (f *ChatFilter) Unmute(uid string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.state, uid)
+}
+
+// Forget drops uid's rate-limit history when its session ends. An active
+// mute is kept so reconnecting does not lift it.
+//
+// Postcondition: uid holds no filter state unless it is still muted.
+func (f *ChatFilter) Forget(uid string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if st, ok := f.state[uid]; ok && f.now().Before(st.mutedUntil) {
+		return
+	}
+	delete(f.state, uid)
+}
@@ -0,0 +1,165 @@
+package gameserver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// testChatConfig returns a chat config allowing 3 messages per 10s.
+func testChatConfig() config.ChatConfig {
+	return config.ChatConfig{
+		StripLinks:        true,
+		RateLimitMessages: 3,
+		RateLimitWindow:   10 * time.Second,
+		MuteDurations:     []time.Duration{time.Minute, 5 * time.Minute},
+	}
+}
+
+// newTestChatFilter returns a filter whose clock is controlled by the returned pointer.
+func newTestChatFilter(words ...string) (*ChatFilter, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	f := NewChatFilter(testChatConfig(), words)
+	f.now = func() time.Time { return now }
+	return f, &now
+}
+
+func chatSender(role string) *session.PlayerSession {
+	return &session.PlayerSession{UID: "u1", CharName: "Alice", Role: role}
+}
+
+func TestChatFilter_MasksWordsCaseInsensitively(t *testing.T) {
+	f, _ := newTestChatFilter("darn")
+	out, err := f.Apply(chatSender(postgres.RolePlayer), "Well DARN it, darnation")
+	require.NoError(t, err)
+	assert.Equal(t, "Well **** it, darnation", out)
+}
+
+func TestChatFilter_StripsLinks(t *testing.T) {
+	f, _ := newTestChatFilter()
+	out, err := f.Apply(chatSender(postgres.RolePlayer), "visit https://spam.example/x and www.spam.example now")
+	require.NoError(t, err)
+	assert.Equal(t, "visit [link removed] and [link removed] now", out)
+}
+
+func TestChatFilter_RateLimitEscalatesMutes(t *testing.T) {
+	f, now := newTestChatFilter()
+	sender := chatSender(postgres.RolePlayer)
+	for i := 0; i < 3; i++ {
+		_, err := f.Apply(sender, "hi")
+		require.NoError(t, err)
+	}
+	_, err := f.Apply(sender, "hi")
+	require.True(t, errors.Is(err, ErrChatMuted))
+	assert.Contains(t, err.Error(), "1m0s")
+
+	*now = now.Add(61 * time.Second)
+	for i := 0; i < 3; i++ {
+		_, err = f.Apply(sender, "hi")
+		require.NoError(t, err)
+	}
+	_, err = f.Apply(sender, "hi")
+	require.True(t, errors.Is(err, ErrChatMuted))
+	assert.Contains(t, err.Error(), "5m0s")
+
+	*now = now.Add(time.Minute)
+	_, err = f.Apply(sender, "hi")
+	require.True(t, errors.Is(err, ErrChatMuted), "still muted inside the second mute")
+}
+
+func TestChatFilter_WindowSlides(t *testing.T) {
+	f, now := newTestChatFilter()
+	sender := chatSender(postgres.RolePlayer)
+	for i := 0; i < 10; i++ {
+		_, err := f.Apply(sender, "hi")
+		require.NoError(t, err)
+		*now = now.Add(4 * time.Second)
+	}
+}
+
+func TestChatFilter_EditorsAndAdminsBypass(t *testing.T) {
+	for _, role := range []string{postgres.RoleEditor, postgres.RoleAdmin} {
+		f, _ := newTestChatFilter("darn")
+		for i := 0; i < 10; i++ {
+			out, err := f.Apply(chatSender(role), "darn http://x.example")
+			require.NoError(t, err, role)
+			assert.Equal(t, "darn http://x.example", out, role)
+		}
+	}
+}
+
+func TestChatFilter_MuteAndUnmute(t *testing.T) {
+	f, now := newTestChatFilter()
+	sender := chatSender(postgres.RolePlayer)
+	f.Mute(sender.UID, 2*time.Minute)
+	_, err := f.Apply(sender, "hi")
+	require.True(t, errors.Is(err, ErrChatMuted))
+
+	f.Unmute(sender.UID)
+	_, err = f.Apply(sender, "hi")
+	require.NoError(t, err)
+
+	f.Mute(sender.UID, time.Minute)
+	*now = now.Add(time.Minute)
+	_, err = f.Apply(sender, "hi")
+	require.NoError(t, err, "mute expires")
+}
+
+func TestLoadChatFilterWords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("words:\n  - darn\n  - heck\n"), 0o644))
+	words, err := LoadChatFilterWords(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"darn", "heck"}, words)
+
+	_, err = LoadChatFilterWords(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+// TestProperty_ChatFilter_MaskPreservesLength verifies masking never changes
+// the rune length of a message without links.
+func TestProperty_ChatFilter_MaskPreservesLength(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		word := rapid.StringMatching(`[a-z]{2,8}`).Draw(rt, "word")
+		text := rapid.StringMatching(`[a-z ]{0,40}`).Draw(rt, "text")
+		cfg := testChatConfig()
+		cfg.RateLimitMessages = 0
+		f := NewChatFilter(cfg, []string{word})
+		out, err := f.Apply(chatSender(postgres.RolePlayer), text)
+		if err != nil {
+			rt.Fatalf("unexpected error: %v", err)
+		}
+		if len([]rune(out)) != len([]rune(strings.TrimSpace(text))) && len([]rune(out)) != len([]rune(text)) {
+			rt.Fatalf("length changed: %q -> %q", text, out)
+		}
+	})
+}
+
+func TestChatFilter_ForgetDropsHistoryButKeepsActiveMute(t *testing.T) {
+	f, now := newTestChatFilter()
+	sender := chatSender(postgres.RolePlayer)
+	_, err := f.Apply(sender, "hi")
+	require.NoError(t, err)
+	f.Forget(sender.UID)
+	assert.Empty(t, f.state, "rate-limit history is dropped with the session")
+
+	f.Mute(sender.UID, time.Minute)
+	f.Forget(sender.UID)
+	_, err = f.Apply(sender, "hi")
+	require.True(t, errors.Is(err, ErrChatMuted), "reconnecting does not lift a mute")
+
+	*now = now.Add(time.Minute)
+	f.Forget(sender.UID)
+	assert.Empty(t, f.state, "an expired mute is dropped")
+}
@@ -3,6 +3,7 @@ package gameserver
 import (
 	"fmt"
 
+	"github.com/cory-johannsen/mud/internal/game/clan"
 	"github.com/cory-johannsen/mud/internal/game/command"
 	"github.com/cory-johannsen/mud/internal/game/session"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
@@ -11,6 +12,10 @@ import (
 // ChatHandler handles say, emote, and who commands.
 type ChatHandler struct {
 	sessions *session.Manager
+	// filter screens say and emote text before broadcast. May be nil (no filtering).
+	filter *ChatFilter
+	// clans supplies the clan tags listed by Who. May be nil (no tags).
+	clans *clan.Registry
 }
 
 // NewChatHandler creates a ChatHandler with the given dependencies.
@@ -22,15 +27,59 @@ func NewChatHandler(sessMgr *session.Manager) *ChatHandler {
 	}
 }
 
+// SetFilter installs the chat filter applied to say and emote text.
+//
+// Precondition: f may be nil to disable filtering.
+// Postcondition: Subsequent Say and Emote calls are screened by f.
+func (h *ChatHandler) SetFilter(f *ChatFilter) {
+	h.filter = f
+}
+
+// SetClans installs the clan registry Who reads clan tags from.
+//
+// Precondition: reg may be nil to omit clan tags.
+// Postcondition: Subsequent Who calls tag clan members.
+func (h *ChatHandler) SetClans(reg *clan.Registry) {
+	h.clans = reg
+}
+
+// Filter returns the installed chat filter, or nil when none is configured.
+func (h *ChatHandler) Filter() *ChatFilter {
+	return h.filter
+}
+
+// screen applies the chat filter to text from sess.
+//
+// Postcondition: Returns text unchanged when no filter is installed; returns an
+// error when the message must not be broadcast.
+func (h *ChatHandler) screen(sess *session.PlayerSession, text string) (string, error) {
+	if h.filter == nil {
+		return text, nil
+	}
+	cleaned, err := h.filter.Apply(sess, text)
+	if err != nil {
+		return "", err
+	}
+	if cleaned == "" {
+		return "", fmt.Errorf("nothing left to say after filtering")
+	}
+	return cleaned, nil
+}
+
 // Say broadcasts a chat message to all players in the sender's room.
 //
 // Precondition: uid must be a valid connected player.
-// Postcondition: Returns a MessageEvent to broadcast, or an error.
+// Postcondition: Returns a MessageEvent to broadcast, or an error when the
+// sender is unknown or the chat filter rejects the message.
 func (h *ChatHandler) Say(uid string, message string) (*gamev1.MessageEvent, error) {
 	sess, ok := h.sessions.GetPlayer(uid)
 	if !ok {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
+	message, err := h.screen(sess, message)
+	if err != nil {
+		return nil, err
+	}
 
 	return &gamev1.MessageEvent{
 		Sender:  sess.CharName,
@@ -42,12 +91,17 @@ func (h *ChatHandler) Say(uid string, message string) (*gamev1.MessageEvent, err
 // Emote broadcasts an emote action to all players in the sender's room.
 //
 // Precondition: uid must be a valid connected player.
-// Postcondition: Returns a MessageEvent to broadcast, or an error.
+// Postcondition: Returns a MessageEvent to broadcast, or an error when the
+// sender is unknown or the chat filter rejects the message.
 func (h *ChatHandler) Emote(uid string, action string) (*gamev1.MessageEvent, error) {
 	sess, ok := h.sessions.GetPlayer(uid)
 	if !ok {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
+	action, err := h.screen(sess, action)
+	if err != nil {
+		return nil, err
+	}
 
 	return &gamev1.MessageEvent{
 		Sender:  sess.CharName,
@@ -57,6 +111,7 @@ func (h *ChatHandler) Emote(uid string, action string) (*gamev1.MessageEvent, er
 }
 
 // Who returns the list of players in the sender's room with full detail.
+// Invisible staff are left out for everyone but themselves.
 //
 // Precondition: uid must be a valid connected player.
 // Postcondition: Returns a PlayerList with PlayerInfo entries, or an error.
@@ -66,15 +121,22 @@ func (h *ChatHandler) Who(uid string) (*gamev1.PlayerList, error) {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
 
-	sessions := h.sessions.PlayersInRoomDetails(sess.RoomID)
+	sessions := h.sessions.VisiblePlayersInRoom(sess.RoomID, uid)
 	players := make([]*gamev1.PlayerInfo, 0, len(sessions))
 	for _, s := range sessions {
+		var tag string
+		if h.clans != nil {
+			tag = h.clans.TagFor(s.CharacterID)
+		}
 		players = append(players, &gamev1.PlayerInfo{
 			Name:        s.CharName,
 			Level:       int32(s.Level),
 			Job:         s.Class,
 			HealthLabel: command.HealthLabel(s.CurrentHP, s.MaxHP),
 			Status:      gamev1.CombatStatus(s.Status),
+			ClanTag:     tag,
+			Title:       displayTitle(s),
+			Afk:         s.AFK,
 		})
 	}
 	return &gamev1.PlayerList{
@@ -148,3 +148,26 @@ func TestChatHandler_Who_PopulatesPlayerInfo(t *testing.T) {
 	assert.Equal(t, "Lightly Wounded", p.HealthLabel)
 	assert.Equal(t, gamev1.CombatStatus_COMBAT_STATUS_IDLE, p.Status)
 }
+
+func TestChatHandler_Say_AppliesFilter(t *testing.T) {
+	sessMgr := session.NewManager()
+	h := NewChatHandler(sessMgr)
+	h.SetFilter(NewChatFilter(testChatConfig(), []string{"darn"}))
+
+	_, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u1", Username: "Alice", CharName: "Alice",
+		RoomID: "room_a", CurrentHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+
+	evt, err := h.Say("u1", "darn it")
+	require.NoError(t, err)
+	assert.Equal(t, "**** it", evt.Content)
+
+	evt, err = h.Emote("u1", "shares https://spam.example")
+	require.NoError(t, err)
+	assert.Equal(t, "shares [link removed]", evt.Content)
+
+	_, err = h.Say("u1", "   ")
+	assert.Error(t, err, "a message that filters to nothing is rejected")
+}
@@ -86,6 +86,11 @@ func (c *GameClock) CurrentHour() GameHour {
 	return GameHour(c.hour)
 }
 
+// TickInterval returns how much real time one game hour lasts.
+func (c *GameClock) TickInterval() time.Duration {
+	return c.tickInterval
+}
+
 // Subscribe registers ch to receive a GameHour value on each tick.
 // If ch is full, the tick is dropped for that subscriber (non-blocking).
 //
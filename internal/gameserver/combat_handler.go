@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -31,6 +33,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/xp"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 	"github.com/cory-johannsen/mud/internal/scripting"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // CurrencySaver persists a player's currency to durable storage.
@@ -60,7 +63,9 @@ type CombatHandler struct {
 	broadcastFn            func(roomID string, events []*gamev1.CombatEvent)
 	roundStartBroadcastFn  func(roomID string, evt *gamev1.RoundStartEvent)  // optional; sends RoundStartEvent to all room sessions
 	apUpdateBroadcastFn    func(roomID string, evt *gamev1.APUpdateEvent)    // optional; broadcasts AP update to all room sessions
-	roundDuration time.Duration
+	combatStateBroadcastFn func(roomID string, evt *gamev1.CombatStateEvent) // optional; broadcasts turn order and AP to all room sessions
+	// roundDuration is the round timer length in nanoseconds; see RoundDuration.
+	roundDuration atomic.Int64
 	condRegistry  *condition.Registry
 	worldMgr      *world.Manager
 	scriptMgr     *scripting.Manager
@@ -77,11 +82,18 @@ type CombatHandler struct {
 	// Precondition: instID is non-empty.
 	// Postcondition: returns the owner UID or "".
 	hirelingOwnerOf func(instID string) string // optional; enforces REQ-NPC-8; may be nil
+	// hiredHirelingOf returns the hireling uid has hired, or nil; hirelings
+	// it returns join their employer's fights. Optional; may be nil.
+	hiredHirelingOf func(uid string) *npc.Instance
 	onCombatantMoved   func(roomID, movedCombatantID string)         // optional; called after Stride/Step/Shove resolves; may be nil
+	// onForcedMoveFn is called, outside combatMu, for each combatant a blast
+	// knocked across the grid; toRoomID is set when it was driven out of the
+	// room. Optional; may be nil.
+	onForcedMoveFn func(roomID, movedID, toRoomID string)
 	xpSvc          *xp.Service            // optional; awards kill XP on NPC death; may be nil
 	currencySaver  CurrencySaver          // optional; persists currency after loot award; may be nil
 	mentalStateMgr *mentalstate.Manager   // optional; manages mental state conditions; may be nil
-	featRegistry   *ruleset.FeatRegistry  // optional; used for NPC feat bonus resolution; may be nil
+	featRegistry   *ruleset.FeatRegistry  // optional; used for NPC and player feat bonus resolution; may be nil
 	logger         *zap.Logger            // optional; used for error logging; may be nil
 	substanceSvc   SubstanceService       // optional; applies poison substances on weapon hit (REQ-AH-21); may be nil
 	factionSvc    *faction.Service       // optional; awards faction rep on NPC kill; may be nil
@@ -90,6 +102,8 @@ type CombatHandler struct {
 	combatMu      sync.RWMutex
 	timersMu      sync.Mutex
 	timers        map[string]*combat.RoundTimer
+	// frozen holds the rooms whose round timer a GM has stopped; guarded by timersMu.
+	frozen        map[string]bool
 	loadoutsMu    sync.Mutex
 	loadouts      map[string]*inventory.WeaponPreset
 	// roomCoverState tracks current HP for destructible cover objects.
@@ -103,6 +117,9 @@ type CombatHandler struct {
 	// REQ-JD-10: triggers on_take_damage_in_one_hit_above_threshold drawback evaluation.
 	// May be nil; no-op when nil.
 	onMassiveDamage func(uid string)
+	// soundFn is an optional callback that carries a round's gunfire, fighting, or explosions
+	// to players in neighbouring rooms. May be nil; no-op when nil.
+	soundFn func(roomID string, n noise)
 	// onPlayerDeath is an optional callback fired for each player who is downed (HP=0) when all-player-down
 	// combat ends. The callback receives the downed player's uid and should handle respawn.
 	// May be nil; no-op when nil.
@@ -115,6 +132,24 @@ type CombatHandler struct {
 	// The callback receives the NPC instance ID.
 	// May be nil; no-op when nil.
 	onNPCDeath func(instID string)
+	// onNPCKilledFn is an optional callback fired once per living participant when an
+	// NPC dies in combat, before removal. Called with combatMu held.
+	// May be nil; no-op when nil.
+	onNPCKilledFn func(sess *session.PlayerSession, inst *npc.Instance)
+	// onDamageDealtFn is an optional callback fired once per player attack that deals
+	// damage, with the damage dealt. Called with combatMu held.
+	// May be nil; no-op when nil.
+	onDamageDealtFn func(sess *session.PlayerSession, amount int)
+	// onWeaponPracticeFn is an optional callback fired once per player attack,
+	// hit or miss, with the weapon proficiency category attacked with; it
+	// returns the player's rank with the category. Called with combatMu held.
+	// May be nil; no-op when nil.
+	onWeaponPracticeFn func(sess *session.PlayerSession, category string) string
+	// onCurrencyChangedFn is an optional callback fired when combat loot or an
+	// NPC robbery changes a player's credits, with the signed change and a
+	// telemetry source. Called with combatMu held.
+	// May be nil; no-op when nil.
+	onCurrencyChangedFn func(sess *session.PlayerSession, delta int, source string)
 	// seduceConditions is the shared map of NPC instance ID → condition.ActiveSet used for charmed tracking.
 	// Set after construction via SetSeduceConditions; nil means charmed-save processing is skipped.
 	seduceConditions map[string]*condition.ActiveSet
@@ -136,6 +171,9 @@ type CombatHandler struct {
 	// saveInventoryFn is an optional callback invoked after items are directly granted to a
 	// player's backpack to persist the new inventory state. May be nil; persistence is skipped.
 	saveInventoryFn func(sess *session.PlayerSession) error
+	// onItemsLootedFn is an optional callback invoked after loot items are granted to a
+	// player's backpack. Called with combatMu held. May be nil; no-op when nil.
+	onItemsLootedFn func(uid string)
 	// onLevelUpFn is an optional callback invoked when a player levels up during combat XP award.
 	// fromLevel is the level before the award; toLevel is the level after.
 	// May be nil; no-op when nil.
@@ -145,6 +183,10 @@ type CombatHandler struct {
 	// cbt is the active Combat, passed directly to avoid re-acquiring combatMu inside the callback.
 	// May be nil; tech effects are skipped when nil.
 	techUseResolverFn func(uid, techID, targetID string, targetX, targetY int32, cbt *combat.Combat)
+	// abilityUseResolverFn is an optional callback invoked after round resolution for
+	// each ActionUseAbility event. It applies job ability effects; cbt is passed as for
+	// techUseResolverFn. May be nil; ability effects are skipped when nil.
+	abilityUseResolverFn func(uid, abilityID, target string, cbt *combat.Combat)
 	// reactionPromptTimeout bounds the interactive reaction callback (GH #244 REACTION-13).
 	// Non-positive values are treated as combat.DefaultReactionTimeout by ResolveRound.
 	// Wired in via SetReactionPromptTimeout from GameServerConfig.ReactionPromptTimeout.
@@ -201,13 +243,12 @@ func NewCombatHandler(
 	floorMgr       *inventory.FloorManager,
 	mentalStateMgr *mentalstate.Manager,
 ) *CombatHandler {
-	return &CombatHandler{
+	h := &CombatHandler{
 		engine:        engine,
 		npcMgr:        npcMgr,
 		sessions:      sessions,
 		dice:          diceRoller,
 		broadcastFn:   broadcastFn,
-		roundDuration: roundDuration,
 		condRegistry:  condRegistry,
 		worldMgr:      worldMgr,
 		scriptMgr:     scriptMgr,
@@ -218,9 +259,27 @@ func NewCombatHandler(
 		floorMgr:       floorMgr,
 		mentalStateMgr: mentalStateMgr,
 		timers:         make(map[string]*combat.RoundTimer),
+		frozen:         make(map[string]bool),
 		loadouts:       make(map[string]*inventory.WeaponPreset),
 		roomCoverState: make(map[string]int),
 	}
+	h.roundDuration.Store(int64(roundDuration))
+	return h
+}
+
+// RoundDuration returns the length of a combat round.
+func (h *CombatHandler) RoundDuration() time.Duration {
+	return time.Duration(h.roundDuration.Load())
+}
+
+// SetRoundDuration changes the length of combat rounds. Rounds already
+// running keep their length; the next round started uses d.
+//
+// Precondition: d must be > 0.
+func (h *CombatHandler) SetRoundDuration(d time.Duration) {
+	if d > 0 {
+		h.roundDuration.Store(int64(d))
+	}
 }
 
 // coverKey returns the map key for a room+equipment pair.
@@ -347,6 +406,16 @@ func (h *CombatHandler) SetSaveInventoryFn(fn func(sess *session.PlayerSession)
 	h.saveInventoryFn = fn
 }
 
+// SetOnItemsLootedFn registers a callback invoked after post-combat loot items are
+// granted to a player's backpack.
+//
+// Precondition: fn may be nil (no-op when nil); fn runs with combatMu held and must
+// not call back into the CombatHandler synchronously.
+// Postcondition: fn is called once per player after their backpack is updated with loot.
+func (h *CombatHandler) SetOnItemsLootedFn(fn func(uid string)) {
+	h.onItemsLootedFn = fn
+}
+
 // SetOnLevelUpFn registers a callback invoked when organic XP causes a level-up,
 // used to apply technology grants from the new level(s).
 //
@@ -380,10 +449,11 @@ func (h *CombatHandler) SetLogger(logger *zap.Logger) {
 	h.logger = logger
 }
 
-// SetFeatRegistry registers the feat registry used to compute NPC passive feat bonuses (REQ-AE-16).
+// SetFeatRegistry registers the feat registry used to compute NPC passive feat bonuses (REQ-AE-16)
+// and players' passive feat effects.
 //
 // Precondition: registry must be non-nil.
-// Postcondition: NPC feat bonuses are applied when building combatants.
+// Postcondition: NPC and player feat bonuses are applied when building combatants.
 func (h *CombatHandler) SetFeatRegistry(registry *ruleset.FeatRegistry) {
 	h.featRegistry = registry
 }
@@ -444,6 +514,63 @@ func (h *CombatHandler) broadcastAPUpdate(roomID, name string, remaining, total,
 	h.apUpdateBroadcastFn(roomID, evt)
 }
 
+// SetCombatStateBroadcastFn registers a callback that fires after an action is queued and
+// when a round starts. The callback receives the room ID and a populated CombatStateEvent.
+// If nil, no CombatStateEvent is sent.
+func (h *CombatHandler) SetCombatStateBroadcastFn(fn func(roomID string, evt *gamev1.CombatStateEvent)) {
+	h.combatStateBroadcastFn = fn
+}
+
+// broadcastCombatState emits a CombatStateEvent describing cbt to all players in roomID.
+// No-op if combatStateBroadcastFn is nil.
+//
+// Precondition: combatMu is held; cbt is non-nil.
+// Postcondition: CombatStateEvent is delivered to all sessions in roomID via combatStateBroadcastFn.
+func (h *CombatHandler) broadcastCombatState(roomID string, cbt *combat.Combat) {
+	if h.combatStateBroadcastFn == nil {
+		return
+	}
+	h.combatStateBroadcastFn(roomID, combatStateEvent(cbt))
+}
+
+// combatStateEvent builds the CombatStateEvent for cbt. Combatants are listed in
+// initiative order. AP is reported for players only, since every player is an ally
+// of every other; NPC AP stays hidden.
+//
+// Precondition: cbt is non-nil.
+// Postcondition: Returns one CombatantStatus per combatant with conditions sorted by name.
+func combatStateEvent(cbt *combat.Combat) *gamev1.CombatStateEvent {
+	evt := &gamev1.CombatStateEvent{Round: int32(cbt.Round)}
+	for _, c := range cbt.Combatants {
+		st := &gamev1.CombatantStatus{
+			Name:       c.Name,
+			IsPlayer:   c.IsPlayer(),
+			Initiative: int32(c.Initiative),
+			Dead:       c.IsDead(),
+			TempHp:     int32(c.TempHP),
+			Shield:     int32(c.ShieldBlock),
+			Cooldowns:  c.CooldownLabels(),
+		}
+		if q, ok := cbt.ActionQueues[c.ID]; ok && c.IsPlayer() {
+			st.ApRemaining = int32(q.RemainingPoints())
+			st.ApTotal = int32(q.MaxPoints)
+		}
+		for _, ac := range cbt.GetConditions(c.ID) {
+			if ac.Def == nil {
+				continue
+			}
+			name := ac.Def.Name
+			if ac.Stacks > 1 {
+				name = fmt.Sprintf("%s %d", name, ac.Stacks)
+			}
+			st.Conditions = append(st.Conditions, name)
+		}
+		sort.Strings(st.Conditions)
+		evt.Combatants = append(evt.Combatants, st)
+	}
+	return evt
+}
+
 // SetOnCoverHit registers a callback that fires when an attack misses due to cover.
 // The callback receives the room ID, attacker ID, and cover equipment ID.
 // The callback may be nil; if so, no action is taken.
@@ -458,6 +585,16 @@ func (h *CombatHandler) SetOnCombatantMoved(fn func(roomID, movedCombatantID str
 	h.onCombatantMoved = fn
 }
 
+// SetOnForcedMove registers a callback invoked after a round in which a blast
+// knocked combatants across the grid or out of the room.
+//
+// Precondition: fn may be nil (disables the callback).
+// Postcondition: fn is called, without combatMu held, once per moved combatant
+// with the room the combatant was driven into, or "" when it stayed.
+func (h *CombatHandler) SetOnForcedMove(fn func(roomID, movedID, toRoomID string)) {
+	h.onForcedMoveFn = fn
+}
+
 // SetOnNPCDamageTaken registers a callback invoked when an NPC takes damage in combat.
 //
 // Precondition: fn may be nil (disables the callback).
@@ -474,6 +611,53 @@ func (h *CombatHandler) SetOnNPCDeath(fn func(instID string)) {
 	h.onNPCDeath = fn
 }
 
+// SetOnNPCKilledFn registers a callback invoked for each living participant when
+// an NPC dies in combat.
+//
+// Precondition: fn may be nil (no-op when nil); fn runs with combatMu held and must
+// not call back into the CombatHandler synchronously.
+// Postcondition: fn is called once per living participant before the NPC is removed.
+func (h *CombatHandler) SetOnNPCKilledFn(fn func(sess *session.PlayerSession, inst *npc.Instance)) {
+	h.onNPCKilledFn = fn
+}
+
+// SetOnDamageDealtFn registers a callback invoked for each player attack that deals damage.
+//
+// Precondition: fn may be nil (no-op when nil); fn runs with combatMu held and must
+// not call back into the CombatHandler synchronously.
+// Postcondition: fn is called with the attacking player and the effective damage.
+func (h *CombatHandler) SetOnDamageDealtFn(fn func(sess *session.PlayerSession, amount int)) {
+	h.onDamageDealtFn = fn
+}
+
+// SetOnWeaponPracticeFn registers a callback invoked for each player attack
+// with the weapon proficiency category attacked with.
+//
+// Precondition: fn may be nil (no-op when nil); fn runs with combatMu held and must
+// not call back into the CombatHandler synchronously.
+// Postcondition: fn is called once per attack; the main-hand rank it returns
+// applies to the player's later attacks.
+func (h *CombatHandler) SetOnWeaponPracticeFn(fn func(sess *session.PlayerSession, category string) string) {
+	h.onWeaponPracticeFn = fn
+}
+
+// SetOnCurrencyChangedFn registers a callback invoked whenever combat loot or an
+// NPC robbery changes a player's credits.
+//
+// Precondition: fn may be nil (no-op when nil); fn runs with combatMu held and must
+// not call back into the CombatHandler synchronously.
+// Postcondition: fn is called with the player, the signed change, and a telemetry source.
+func (h *CombatHandler) SetOnCurrencyChangedFn(fn func(sess *session.PlayerSession, delta int, source string)) {
+	h.onCurrencyChangedFn = fn
+}
+
+// currencyChanged reports a change to sess's credits to onCurrencyChangedFn.
+func (h *CombatHandler) currencyChanged(sess *session.PlayerSession, delta int, source string) {
+	if h.onCurrencyChangedFn != nil {
+		h.onCurrencyChangedFn(sess, delta, source)
+	}
+}
+
 // SetSeduceConditions wires the shared seduceConditions map into the CombatHandler
 // so charmed NPCs can make saving throws at round end (REQ-ZN-9).
 //
@@ -614,6 +798,9 @@ func (h *CombatHandler) InitiateGuardCombat(uid, zoneID string, wantedLevel int)
 // Precondition: npcInst must not be nil; playerUID must be a valid connected player.
 // Postcondition: combat is started in the player's room; events are broadcast.
 func (h *CombatHandler) InitiateNPCCombat(npcInst *npc.Instance, playerUID string) {
+	if npcInst.Surrendered {
+		return
+	}
 	sess, ok := h.sessions.GetPlayer(playerUID)
 	if !ok {
 		return
@@ -674,6 +861,9 @@ func (h *CombatHandler) Attack(uid, target string) ([]*gamev1.CombatEvent, error
 	if inst.IsDead() {
 		return nil, fmt.Errorf("%s is already dead", inst.Name())
 	}
+	if inst.Surrendered {
+		return nil, fmt.Errorf("%s has surrendered; parley with them instead", inst.Name())
+	}
 
 	// REQ-NPC-4: non-combat NPCs cannot be attacked directly.
 	// Guards enter combat via their own engage behavior (sub-project 4).
@@ -703,11 +893,17 @@ func (h *CombatHandler) Attack(uid, target string) ([]*gamev1.CombatEvent, error
 		}
 		// COMBATMSG-5: push player-initiated combat message before first round output.
 		h.pushMessageToUID(uid, combat.FormatPlayerInitiationMsg(inst.Name()))
+	} else if cbt.GetCombatant(uid) == nil {
+		// A bystander who attacks is engaged: they join the fight under way.
+		if _, err := h.joinPlayerLocked(cbt, sess); err != nil {
+			return nil, fmt.Errorf("joining combat: %w", err)
+		}
 	}
 
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionAttack, Target: inst.Name()}); err != nil {
 		return nil, fmt.Errorf("queuing attack: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	// BUG-31: include remaining AP in confirmation so the player can plan.
 	apMsg := h.formatAPRemaining(uid, cbt)
@@ -849,6 +1045,7 @@ func (h *CombatHandler) StrikeWithMoveTrait(uid, target string, moveStride *Move
 	}); err != nil {
 		return nil, fmt.Errorf("queuing strike: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	if moveStride != nil && moveStride.Ordering == combat.MoveOrderingAfter {
 		if err := cbt.QueueFreeAction(uid, combat.QueuedAction{
@@ -933,6 +1130,7 @@ func (h *CombatHandler) Aid(uid, allyName string) ([]*gamev1.CombatEvent, error)
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionAid, Target: canonicalName}); err != nil {
 		return nil, fmt.Errorf("queuing aid: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	apMsg := h.formatAPRemaining(uid, cbt)
 
@@ -995,6 +1193,7 @@ func (h *CombatHandler) QueueTechUse(uid, techID, techName, targetID string, cos
 	if err := cbt.QueueAction(uid, qa); err != nil {
 		return err
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	display := techName
 	if display == "" {
@@ -1030,6 +1229,7 @@ func (h *CombatHandler) ActivateAbility(uid string, qa combat.QueuedAction) erro
 	if err := cbt.QueueAction(uid, qa); err != nil {
 		return fmt.Errorf("queuing ability: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 	return nil
 }
 
@@ -1098,6 +1298,7 @@ func (h *CombatHandler) SpendAP(uid string, cost int) error {
 		return err
 	}
 	h.broadcastAPUpdate(sess.RoomID, sess.CharName, q.RemainingPoints(), q.MaxPoints, combat.MaxMovementAP-q.MovementAPSpent(), cbt.GetCombatant(uid))
+	h.broadcastCombatState(sess.RoomID, cbt)
 	return nil
 }
 
@@ -1128,6 +1329,7 @@ func (h *CombatHandler) SpendMovementAP(uid string, cost int) error {
 		return err
 	}
 	h.broadcastAPUpdate(sess.RoomID, sess.CharName, q.RemainingPoints(), q.MaxPoints, combat.MaxMovementAP-q.MovementAPSpent(), cbt.GetCombatant(uid))
+	h.broadcastCombatState(sess.RoomID, cbt)
 	return nil
 }
 
@@ -1151,11 +1353,14 @@ func (h *CombatHandler) CheckMovementAPAvailable(uid string, cost int) error {
 	if !ok {
 		return fmt.Errorf("no action queue for player %q", uid)
 	}
+	if q.Rooted {
+		return combat.ErrRooted
+	}
 	if q.MovementAPSpent()+cost > combat.MaxMovementAP {
 		return fmt.Errorf("not enough movement AP: need %d more, movement limit already reached (%d/%d spent)", cost, q.MovementAPSpent(), combat.MaxMovementAP)
 	}
-	if q.RemainingPoints() < cost {
-		return fmt.Errorf("not enough AP: have %d, need %d", q.RemainingPoints(), cost)
+	if total := q.MovementCost(cost); q.RemainingPoints() < total {
+		return fmt.Errorf("not enough AP: have %d, need %d", q.RemainingPoints(), total)
 	}
 	return nil
 }
@@ -1330,7 +1535,7 @@ func (h *CombatHandler) BroadcastAllPositions(roomID string) {
 // the call-for-help or protecting message to all player combatants in that combat.
 //
 // Precondition: inst must not be nil; pendingRoomID must be non-empty.
-// Postcondition: NPC is added to active combat; message pushed to targeted player(s); no-op if no active combat.
+// Postcondition: NPC is added to active combat and acts this round; message pushed to targeted player(s); no-op if no active combat.
 func (h *CombatHandler) JoinPendingNPCCombat(inst *npc.Instance, pendingRoomID string) {
 	h.combatMu.Lock()
 	defer h.combatMu.Unlock()
@@ -1350,72 +1555,12 @@ func (h *CombatHandler) JoinPendingNPCCombat(inst *npc.Instance, pendingRoomID s
 		}
 		h.pushMessageToUID(c.ID, combat.FormatNPCInitiationMsg(inst.Name(), reason, inst.ProtectedNPCName))
 	}
-	// Build and add the NPC combatant.
-	var npcFeatStats NPCEffectiveStats
-	if h.featRegistry != nil {
-		roomNPCs := h.npcMgr.InstancesInRoom(pendingRoomID)
-		npcFeatStats = ComputeNPCAttackStats(inst, nil, h.featRegistry, roomNPCs)
-	}
-	npcWeaponName := ""
-	npcWeaponDefID := ""
-	npcWeaponBonus := 0
-	if inst.WeaponID != "" && h.invRegistry != nil {
-		if wDef := h.invRegistry.Weapon(inst.WeaponID); wDef != nil {
-			npcWeaponName = wDef.Name
-			npcWeaponDefID = wDef.ID
-			npcWeaponBonus = wDef.Bonus
-		}
-	}
-	npcStrMod := combat.AbilityMod(inst.Awareness) + npcFeatStats.DamageBonus + npcFeatStats.AttackBonus
-	npcCbt := &combat.Combatant{
-		ID:          inst.ID,
-		Kind:        combat.KindNPC,
-		Name:        inst.Name(),
-		MaxHP:       inst.MaxHP,
-		CurrentHP:   inst.CurrentHP,
-		AC:          inst.AC + npcFeatStats.ACBonus,
-		Level:       inst.Level,
-		StrMod:      npcStrMod,
-		DexMod:      1,
-		NPCType:     inst.Type,
-		Resistances: inst.Resistances,
-		Weaknesses:  inst.Weaknesses,
-		WeaponName:  npcWeaponName,
-		WeaponDefID: npcWeaponDefID,
-		WeaponBonus: npcWeaponBonus,
-		AttackVerb:  inst.AttackVerb,
-		SpeedFt:     inst.SpeedFt,
-		FactionID:   inst.FactionID,
-	}
-	combat.RollInitiative([]*combat.Combatant{npcCbt}, h.dice.Src())
-	// Assign NPC on right edge, stacked vertically from center.
-	existingNPCs := 0
-	for _, c := range cbt.Combatants {
-		if c.Kind == combat.KindNPC {
-			existingNPCs++
-		}
-	}
-	npcCbt.GridX = 19
-	npcCbt.GridY = 10 + existingNPCs
-	if addErr := h.engine.AddCombatant(pendingRoomID, npcCbt); addErr != nil {
+	if err := h.addCombatantLocked(cbt, h.buildNPCCombatant(inst, pendingRoomID)); err != nil {
 		h.logger.Warn("JoinPendingNPCCombat: AddCombatant failed",
 			zap.String("npc_id", inst.ID),
 			zap.String("room_id", pendingRoomID),
-			zap.Error(addErr),
+			zap.Error(err),
 		)
-		return
-	}
-	// Populate the newly added NPC's Effects set so it participates in the
-	// typed-bonus pipeline alongside the existing combatants (DEDUP-5). Uses
-	// the combatant's WeaponDefID so the dedup key matches the one produced by
-	// populateCombatantEffects at combat start.
-	if joinedCbt := cbt.GetCombatant(inst.ID); joinedCbt != nil {
-		joinedCbt.Effects = combat.BuildCombatantEffects(combat.BuildEffectsOpts{
-			BearerUID:        joinedCbt.ID,
-			Conditions:       cbt.Conditions[joinedCbt.ID],
-			WeaponSourceID:   joinedCbt.WeaponDefID,
-			WeaponBonusValue: joinedCbt.WeaponBonus,
-		})
 	}
 }
 
@@ -1428,16 +1573,15 @@ func (h *CombatHandler) JoinPendingNPCCombat(inst *npc.Instance, pendingRoomID s
 // Weapon item-typed bonuses are sourced from cbt.Combatant.WeaponBonus for
 // every combatant that has a non-zero bonus. The source identifier is the
 // combatant's WeaponDefID (the inventory registry key), which is the same
-// stable key used by JoinPendingNPCCombat so the dedup namespace is unified
+// stable key used by addCombatantLocked so the dedup namespace is unified
 // across all combatant-creation paths.
 //
-// Passive feat/technology bonuses are NOT wired here yet; that is deferred
-// to a later task. Only conditions and weapon bonuses contribute at this
-// stage.
+// A player's passive feats contribute their typed bonuses; passive
+// technology bonuses are NOT wired here yet.
 //
 // Precondition: cbt must be non-nil.
 // Postcondition: every Combatant in cbt.Combatants has a non-nil Effects field.
-func populateCombatantEffects(cbt *combat.Combat) {
+func (h *CombatHandler) populateCombatantEffects(cbt *combat.Combat) {
 	if cbt == nil {
 		return
 	}
@@ -1448,12 +1592,39 @@ func populateCombatantEffects(cbt *combat.Combat) {
 		c.Effects = combat.BuildCombatantEffects(combat.BuildEffectsOpts{
 			BearerUID:        c.ID,
 			Conditions:       cbt.Conditions[c.ID],
+			Feats:            h.playerFeats(c),
 			WeaponSourceID:   c.WeaponDefID,
 			WeaponBonusValue: c.WeaponBonus,
 		})
 	}
 }
 
+// playerFeats returns the passive feats of the player behind c, in ID order,
+// or nil when c is not a player or no feat registry is set.
+func (h *CombatHandler) playerFeats(c *combat.Combatant) []*ruleset.Feat {
+	if h.featRegistry == nil || c.Kind != combat.KindPlayer {
+		return nil
+	}
+	sess, ok := h.sessions.GetPlayer(c.ID)
+	if !ok {
+		return nil
+	}
+	ids := make([]string, 0, len(sess.PassiveFeats))
+	for id, on := range sess.PassiveFeats {
+		if on {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	var feats []*ruleset.Feat
+	for _, id := range ids {
+		if f, ok := h.featRegistry.Feat(id); ok {
+			feats = append(feats, f)
+		}
+	}
+	return feats
+}
+
 // pushMessageToUID sends a plain text message event to the player identified by uid.
 //
 // Precondition: uid must be non-empty; content must be non-empty.
@@ -1687,7 +1858,7 @@ func (h *CombatHandler) GetCombatantsInRoom(roomID string) []*scripting.Combatan
 	out := make([]*scripting.CombatantInfo, 0, len(cbt.Combatants))
 	for _, c := range cbt.Combatants {
 		kind := "npc"
-		if c.Kind == combat.KindPlayer {
+		if c.OnPlayerSide() {
 			kind = "player"
 		}
 		out = append(out, &scripting.CombatantInfo{
@@ -1695,6 +1866,7 @@ func (h *CombatHandler) GetCombatantsInRoom(roomID string) []*scripting.Combatan
 			Name:      c.Name,
 			HP:        c.CurrentHP,
 			MaxHP:     c.MaxHP,
+			TempHP:    c.TempHP,
 			AC:        c.AC,
 			Kind:      kind,
 			FactionID: c.FactionID,
@@ -1779,6 +1951,7 @@ func (h *CombatHandler) Pass(uid string) ([]*gamev1.CombatEvent, error) {
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionPass}); err != nil {
 		return nil, fmt.Errorf("queuing pass: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	// proto has no PASS/ROUND type; ATTACK is the closest available sentinel — client uses Narrative for display
 	confirmEvent := &gamev1.CombatEvent{
@@ -1834,8 +2007,19 @@ func (h *CombatHandler) Flee(uid string) ([]*gamev1.CombatEvent, bool, error) {
 		return []*gamev1.CombatEvent{evt}, false, nil
 	}
 
-	// FLEE-1 / FLEE-2: AP guard — inline to avoid re-acquiring combatMu (SpendAP locks it).
+	// Rooting conditions applied in combat (immobilized, rooted) also prevent fleeing.
 	q, hasQ := cbt.ActionQueues[uid]
+	if cs := cbt.Conditions[uid]; (hasQ && q.Rooted) || condition.IsRooted(cs) || (cs != nil && condition.IsActionRestricted(cs, "flee")) {
+		h.combatMu.Unlock()
+		evt := &gamev1.CombatEvent{
+			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_FLEE,
+			Attacker:  uid,
+			Narrative: "You are rooted in place and cannot flee!",
+		}
+		return []*gamev1.CombatEvent{evt}, false, nil
+	}
+
+	// FLEE-1 / FLEE-2: AP guard — inline to avoid re-acquiring combatMu (SpendAP locks it).
 	if !hasQ || q.RemainingPoints() < 1 {
 		h.combatMu.Unlock()
 		return nil, false, fmt.Errorf("you need at least 1 AP to flee")
@@ -1962,7 +2146,7 @@ func (h *CombatHandler) resolvePursuitLocked(cbt *combat.Combat, playerSess *ses
 	var pursuers []*npc.Instance
 
 	for _, c := range cbt.Combatants {
-		if c.Kind != combat.KindNPC || c.IsDead() {
+		if !c.IsHostileNPC() || c.IsDead() {
 			continue
 		}
 		inst, ok := h.npcMgr.Get(c.ID)
@@ -2039,6 +2223,7 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 		Level:     playerSess.Level,
 		StrMod:    combat.AbilityMod(playerSess.Abilities.Brutality),
 		DexMod:    dexMod,
+		BackRow:   playerSess.BackRow,
 	}
 
 	h.loadoutsMu.Lock()
@@ -2070,6 +2255,13 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 		playerCbt.WeaponDefID = playerCbt.Loadout.MainHand.Def.ID
 		playerCbt.WeaponDamageType = playerCbt.Loadout.MainHand.Def.DamageType
 		playerCbt.WeaponBonus = playerCbt.Loadout.MainHand.Def.Bonus
+		if h.invRegistry != nil {
+			playerCbt.WeaponName = h.invRegistry.AffixedName(playerCbt.WeaponName, playerCbt.Loadout.MainHand.Affixes)
+			playerCbt.WeaponAffixes = h.invRegistry.AffixStats(playerCbt.Loadout.MainHand.Affixes)
+		}
+		if playerCbt.Loadout.MainHand.CustomName != "" {
+			playerCbt.WeaponName = playerCbt.Loadout.MainHand.CustomName
+		}
 	} else {
 		playerCbt.WeaponName = "fists"
 		playerCbt.WeaponDamageType = "bludgeoning"
@@ -2078,6 +2270,7 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 	// Resistances / weaknesses.
 	playerCbt.Resistances = playerSess.Resistances
 	playerCbt.Weaknesses = playerSess.Weaknesses
+	playerCbt.Immunities = playerSess.Immunities
 
 	// Save mods and proficiency ranks.
 	playerCbt.GritMod = combat.AbilityMod(playerSess.Abilities.Grit)
@@ -2114,6 +2307,7 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 			NPCType:     inst.Type,
 			Resistances: inst.Resistances,
 			Weaknesses:  inst.Weaknesses,
+			Immunities:  inst.Immunities,
 			WeaponName:  npcWeaponName,
 			WeaponDefID: npcWeaponDefID,
 			AttackVerb:  inst.AttackVerb,
@@ -2176,7 +2370,7 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 
 	// Populate per-combatant Effects sets (DEDUP-5). Must run AFTER conditions
 	// are fully populated (CopyTo + flat_footed application above).
-	populateCombatantEffects(cbt)
+	h.populateCombatantEffects(cbt)
 
 	cbt.SetSessionGetter(func(uid string) (*session.PlayerSession, bool) {
 		return h.sessions.GetPlayer(uid)
@@ -2248,7 +2442,7 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 		h.roundStartBroadcastFn(playerSess.RoomID, &gamev1.RoundStartEvent{
 			Round:            int32(cbt.Round),
 			ActionsPerTurn:   3,
-			DurationMs:       int32(h.roundDuration.Milliseconds()),
+			DurationMs:       int32(h.RoundDuration().Milliseconds()),
 			TurnOrder:        pursuitTurnOrder,
 			InitialPositions: initialPositions,
 			GridWidth:        int32(cbt.GridWidth),
@@ -2257,6 +2451,7 @@ func (h *CombatHandler) startPursuitCombatLocked(playerSess *session.PlayerSessi
 			Terrain:          terrainToProto(cbt.Terrain),
 		})
 	}
+	h.broadcastCombatState(playerSess.RoomID, cbt)
 
 	h.startTimerLocked(playerSess.RoomID)
 	return events, nil
@@ -2391,6 +2586,7 @@ func (h *CombatHandler) Reload(uid string) ([]*gamev1.CombatEvent, error) {
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionReload, WeaponID: weaponID}); err != nil {
 		return nil, fmt.Errorf("queuing reload: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	h.pushMessageToUID(uid, fmt.Sprintf("Reload queued.%s", h.formatAPRemaining(uid, cbt)))
 
@@ -2434,6 +2630,7 @@ func (h *CombatHandler) FireBurst(uid, target string) ([]*gamev1.CombatEvent, er
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionFireBurst, Target: target, WeaponID: weaponID}); err != nil {
 		return nil, fmt.Errorf("queuing fire burst: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	h.pushMessageToUID(uid, fmt.Sprintf("Burst fire queued.%s", h.formatAPRemaining(uid, cbt)))
 
@@ -2477,6 +2674,7 @@ func (h *CombatHandler) FireAutomatic(uid, target string) ([]*gamev1.CombatEvent
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionFireAutomatic, Target: target, WeaponID: weaponID}); err != nil {
 		return nil, fmt.Errorf("queuing fire automatic: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	h.pushMessageToUID(uid, fmt.Sprintf("Full auto queued.%s", h.formatAPRemaining(uid, cbt)))
 
@@ -2513,6 +2711,7 @@ func (h *CombatHandler) Throw(uid, explosiveID string) ([]*gamev1.CombatEvent, e
 	if err := cbt.QueueAction(uid, combat.QueuedAction{Type: combat.ActionThrow, ExplosiveID: explosiveID}); err != nil {
 		return nil, fmt.Errorf("queuing throw: %w", err)
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	h.pushMessageToUID(uid, fmt.Sprintf("Throw queued.%s", h.formatAPRemaining(uid, cbt)))
 
@@ -2631,8 +2830,18 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 		}
 		return false, nil, nil
 	})
+	cbt.DamageMultiplier = h.zoneModifier(cbt.RoomID, scripting.ModDamageMultiplier)
+	if cbt.Recap != nil {
+		cbt.Recap.Observe(cbt)
+	}
 	roundEvents := combat.ResolveRound(cbt, h.dice.Src(), targetUpdater, reactionFn, h.reactionPromptTimeout, coverDegrader)
 
+	if h.soundFn != nil {
+		if n, ok := h.roundNoise(roundEvents); ok {
+			h.soundFn(roomID, n)
+		}
+	}
+
 	// REQ-JD-10: Fire on_take_damage_in_one_hit_above_threshold drawback trigger for players
 	// that received ≥50% of their max HP in a single hit this round.
 	if h.onMassiveDamage != nil {
@@ -2693,6 +2902,43 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 		}
 	}
 
+	// Credit players with the damage they dealt this round.
+	if h.onDamageDealtFn != nil {
+		for _, ev := range roundEvents {
+			if ev.AttackResult == nil || ev.AttackResult.EffectiveDamage() <= 0 {
+				continue
+			}
+			if actor, isPlayer := h.sessions.GetPlayer(ev.ActorID); isPlayer {
+				h.onDamageDealtFn(actor, ev.AttackResult.EffectiveDamage())
+			}
+		}
+	}
+
+	// Players practice with whatever they attacked with, hit or miss.
+	if h.onWeaponPracticeFn != nil {
+		for _, ev := range roundEvents {
+			if ev.AttackResult == nil {
+				continue
+			}
+			actor, isPlayer := h.sessions.GetPlayer(ev.ActorID)
+			c := cbt.GetCombatant(ev.ActorID)
+			if !isPlayer || c == nil {
+				continue
+			}
+			armed := c.Loadout != nil && c.Loadout.MainHand != nil && c.Loadout.MainHand.Def != nil
+			category := "unarmed"
+			if armed {
+				category = c.Loadout.MainHand.Def.ProficiencyCategory
+			}
+			if category == "" {
+				continue
+			}
+			if rank := h.onWeaponPracticeFn(actor, category); armed {
+				c.WeaponProficiencyRank = rank
+			}
+		}
+	}
+
 	// REQ-NB-13: Set GrudgePlayerID and OnDamageTaken for NPCs hit by players this round.
 	for _, ev := range roundEvents {
 		if ev.AttackResult == nil || ev.AttackResult.EffectiveDamage() <= 0 {
@@ -2903,6 +3149,9 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 		}
 	}
 
+	// End-of-round recovery saves for until_save conditions (stunned, frightened, ...).
+	recoveryEvents := conditionEventsToProto(cbt.ResolveRecoverySaves(h.dice.Src()), h.condRegistry)
+
 	// Advance mental state for all players in this combat and collect narrative messages.
 	var mentalStateEvents []*gamev1.CombatEvent
 	if h.mentalStateMgr != nil {
@@ -2930,11 +3179,27 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 			}
 		}
 	}
+	if h.abilityUseResolverFn != nil {
+		for _, re := range roundEvents {
+			if re.ActionType == combat.ActionUseAbility {
+				h.abilityUseResolverFn(re.ActorID, re.AbilityID, re.TargetID, cbt)
+			}
+		}
+	}
+
+	if cbt.Recap != nil {
+		cbt.Recap.Add(cbt, roundEvents)
+	}
 
 	var events []*gamev1.CombatEvent
 	for _, re := range roundEvents {
 		events = append(events, h.roundEventToProto(re))
 	}
+	events = append(events, h.resolveForcedMovesLocked(cbt, roundEvents)...)
+	events = append(events, recoveryEvents...)
+	events = append(events, h.checkKnockoutsLocked(cbt)...)
+	events = append(events, h.beatTrainingDummiesLocked(cbt)...)
+	events = append(events, h.checkSurrendersLocked(cbt)...)
 
 	// proto has no PASS/ROUND type; ATTACK is the closest available sentinel — client uses Narrative for display
 	events = append(events, &gamev1.CombatEvent{
@@ -2943,10 +3208,23 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 	})
 	events = append(events, mentalStateEvents...)
 
-	if !cbt.HasLivingNPCs() || !cbt.HasUndowedPlayers() {
+	// Combat goes on while any player is standing or still dying: the dying
+	// loop's recovery checks run at the start of each round.
+	if !cbt.HasLivingNPCs() || !cbt.HasFightingPlayers() {
+		// A knockout is not a death: knocked-out players wake before the
+		// defeat and respawn handling below.
+		knockedOut := h.wakeKnockedOutPlayersLocked(cbt)
 		var endNarrative string
 		if !cbt.HasLivingNPCs() {
 			endNarrative = "Combat is over. You stand victorious."
+			// Allies patch up anyone still dying once the fight is won.
+			for _, c := range cbt.Combatants {
+				if c.Kind == combat.KindPlayer && cbt.IsDying(c.ID) {
+					stabilized, _ := cbt.Stabilize(c.ID, true)
+					events = append(events, conditionEventsToProto(stabilized, h.condRegistry)...)
+				}
+			}
+			h.syncPlayerHPLocked(cbt)
 		} else {
 			endNarrative = "Everything goes dark."
 			// Mark all downed player combatants so sess.Dead == true.
@@ -2966,11 +3244,13 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_END,
 			Narrative: endNarrative,
 		})
+		events = append(events, knockedOut...)
 		h.broadcastFn(roomID, events)
+		h.deliverRecapsLocked(cbt)
 		h.removeDeadNPCsLocked(cbt)
 		// REQ-NB-41: set ReturningHome for surviving NPCs not in their home room; clear grudge.
 		for _, c := range cbt.Combatants {
-			if c.Kind != combat.KindNPC || c.IsDead() {
+			if !c.IsHostileNPC() || c.IsDead() {
 				continue
 			}
 			if npcInst, found := h.npcMgr.Get(c.ID); found && npcInst != nil {
@@ -2995,7 +3275,15 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 		// Collect downed player UIDs for respawn before releasing the lock.
 		var downedUIDs []string
 		if !cbt.HasLivingNPCs() {
-			// Victory — no downed players to respawn.
+			// Victory — only players who died during the fight respawn.
+			for _, c := range cbt.Combatants {
+				if c.Kind == combat.KindPlayer && c.IsDead() {
+					if sess, ok := h.sessions.GetPlayer(c.ID); ok {
+						sess.Dead = true
+					}
+					downedUIDs = append(downedUIDs, c.ID)
+				}
+			}
 		} else {
 			for _, c := range cbt.Combatants {
 				if c.Kind == combat.KindPlayer && c.CurrentHP <= 0 {
@@ -3053,9 +3341,11 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 		}
 	}
 
-	// Start the next round.
-	condEvents := cbt.StartRound(3)
+	// Start the next round. Round-start rolls (recovery checks, stunned)
+	// use the handler's dice like the round that was just resolved.
+	condEvents := cbt.StartRoundWithSrc(3, h.dice.Src())
 	condCombatEvents := conditionEventsToProto(condEvents, h.condRegistry)
+	h.syncPlayerHPLocked(cbt)
 
 	// Apply MotiveBonus from sense motive critical failures to NPC AttackMod.
 	// Precondition: combatMu held; cbt.StartRound has reset per-round state.
@@ -3231,7 +3521,7 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 		h.roundStartBroadcastFn(roomID, &gamev1.RoundStartEvent{
 			Round:            int32(cbt.Round),
 			ActionsPerTurn:   3,
-			DurationMs:       int32(h.roundDuration.Milliseconds()),
+			DurationMs:       int32(h.RoundDuration().Milliseconds()),
 			TurnOrder:        nextTurnOrder,
 			InitialPositions: initialPositions,
 			GridWidth:        int32(cbt.GridWidth),
@@ -3239,6 +3529,7 @@ func (h *CombatHandler) resolveAndAdvanceLocked(roomID string, cbt *combat.Comba
 			Terrain:          terrainToProto(cbt.Terrain),
 		})
 	}
+	h.broadcastCombatState(roomID, cbt)
 
 	h.startTimerLocked(roomID)
 	return events
@@ -3270,6 +3561,7 @@ func buildPlayerCombatant(sess *session.PlayerSession, h *CombatHandler) *combat
 		Level:     sess.Level,
 		StrMod:    combat.AbilityMod(sess.Abilities.Brutality),
 		DexMod:    dexMod,
+		BackRow:   sess.BackRow,
 	}
 
 	h.loadoutsMu.Lock()
@@ -3301,6 +3593,13 @@ func buildPlayerCombatant(sess *session.PlayerSession, h *CombatHandler) *combat
 		playerCbt.WeaponDefID = playerCbt.Loadout.MainHand.Def.ID
 		playerCbt.WeaponDamageType = playerCbt.Loadout.MainHand.Def.DamageType
 		playerCbt.WeaponBonus = playerCbt.Loadout.MainHand.Def.Bonus
+		if h.invRegistry != nil {
+			playerCbt.WeaponName = h.invRegistry.AffixedName(playerCbt.WeaponName, playerCbt.Loadout.MainHand.Affixes)
+			playerCbt.WeaponAffixes = h.invRegistry.AffixStats(playerCbt.Loadout.MainHand.Affixes)
+		}
+		if playerCbt.Loadout.MainHand.CustomName != "" {
+			playerCbt.WeaponName = playerCbt.Loadout.MainHand.CustomName
+		}
 	} else {
 		playerCbt.WeaponName = "fists"
 		playerCbt.WeaponDamageType = "bludgeoning"
@@ -3309,6 +3608,7 @@ func buildPlayerCombatant(sess *session.PlayerSession, h *CombatHandler) *combat
 	// Wire player resistances/weaknesses from equipped armor.
 	playerCbt.Resistances = sess.Resistances
 	playerCbt.Weaknesses = sess.Weaknesses
+	playerCbt.Immunities = sess.Immunities
 
 	// Wire save ability mods from character ability scores.
 	playerCbt.GritMod = combat.AbilityMod(sess.Abilities.Grit)
@@ -3368,6 +3668,7 @@ func (h *CombatHandler) startCombatLocked(sess *session.PlayerSession, inst *npc
 		NPCType:     inst.Type,
 		Resistances: inst.Resistances,
 		Weaknesses:  inst.Weaknesses,
+		Immunities:  inst.Immunities,
 		WeaponName:  npcWeaponName,
 		WeaponDefID: npcWeaponDefID,
 		AttackVerb:  inst.AttackVerb,
@@ -3478,6 +3779,9 @@ func (h *CombatHandler) startCombatLocked(sess *session.PlayerSession, inst *npc
 		}
 	}
 
+	// Hired hirelings in the room fight alongside their employers.
+	h.joinHirelingsLocked(cbt)
+
 	// Apply flat_footed to all NPC combatants at combat start (sucker_punch window).
 	// Duration is -1 so Tick leaves it alone; the per-round inline clear in ResolveRound
 	// removes it after the NPC's first action. The Source tag distinguishes this from
@@ -3496,7 +3800,7 @@ func (h *CombatHandler) startCombatLocked(sess *session.PlayerSession, inst *npc
 	// Populate per-combatant Effects sets (DEDUP-5). Must run AFTER conditions
 	// are fully populated (CopyTo + flat_footed application above) so condition
 	// bonuses are captured.
-	populateCombatantEffects(cbt)
+	h.populateCombatantEffects(cbt)
 
 	// Register session getter so ResolveRound can look up passive feats.
 	cbt.SetSessionGetter(func(uid string) (*session.PlayerSession, bool) {
@@ -3615,7 +3919,7 @@ func (h *CombatHandler) startCombatLocked(sess *session.PlayerSession, inst *npc
 		h.roundStartBroadcastFn(sess.RoomID, &gamev1.RoundStartEvent{
 			Round:            int32(cbt.Round),
 			ActionsPerTurn:   3,
-			DurationMs:       int32(h.roundDuration.Milliseconds()),
+			DurationMs:       int32(h.RoundDuration().Milliseconds()),
 			TurnOrder:        turnOrder,
 			InitialPositions: initialPositions,
 			GridWidth:        int32(cbt.GridWidth),
@@ -3624,6 +3928,7 @@ func (h *CombatHandler) startCombatLocked(sess *session.PlayerSession, inst *npc
 			Terrain:          terrainToProto(cbt.Terrain),
 		})
 	}
+	h.broadcastCombatState(sess.RoomID, cbt)
 
 	return cbt, events, nil
 }
@@ -3822,7 +4127,7 @@ func (h *CombatHandler) autoQueuePlayersLocked(cbt *combat.Combat) {
 		var targetName string
 		if sess.LastCombatTarget != "" {
 			for _, combatant := range cbt.Combatants {
-				if combatant.Kind == combat.KindNPC && !combatant.IsDead() && combatant.Name == sess.LastCombatTarget {
+				if combatant.IsHostileNPC() && !combatant.IsDead() && combatant.Name == sess.LastCombatTarget {
 					targetName = combatant.Name
 					break
 				}
@@ -3831,7 +4136,7 @@ func (h *CombatHandler) autoQueuePlayersLocked(cbt *combat.Combat) {
 		// Fallback: first living NPC in combat.
 		if targetName == "" {
 			for _, combatant := range cbt.Combatants {
-				if combatant.Kind == combat.KindNPC && !combatant.IsDead() {
+				if combatant.IsHostileNPC() && !combatant.IsDead() {
 					targetName = combatant.Name
 					break
 				}
@@ -4058,98 +4363,127 @@ func (h *CombatHandler) autoQueueNPCsLocked(cbt *combat.Combat) {
 		if c.Kind != combat.KindNPC || c.IsDead() {
 			continue
 		}
+		h.autoQueueNPCLocked(cbt, c, room)
+	}
+}
 
-		// REQ-ZN-10: charmed NPCs treat players as allied — skip their entire action queue.
-		if h.seduceConditions != nil {
-			if cs, ok := h.seduceConditions[c.ID]; ok && cs.Has("charmed") {
-				continue
+// autoQueueNPCLocked queues this round's actions for the living NPC combatant c,
+// honouring companion orders, charm and puppet control, NPC-initiated seduction, auto-cover, and
+// the HTN planner before falling back to a simple attack.
+//
+// Precondition: h.combatMu is held; cbt and c must not be nil; room may be nil.
+func (h *CombatHandler) autoQueueNPCLocked(cbt *combat.Combat, c *combat.Combatant, room *world.Room) {
+	// A training dummy just stands there and takes it.
+	if inst, ok := h.npcMgr.Get(c.ID); ok && inst.TrainingDummy {
+		return
+	}
+
+	// A companion under a standing order from its employer follows it.
+	if c.Order != "" && h.queueCompanionOrderLocked(cbt, c) {
+		return
+	}
+
+	// REQ-ZN-10: charmed NPCs treat players as allied — skip their entire action queue.
+	if h.seduceConditions != nil {
+		if cs, ok := h.seduceConditions[c.ID]; ok && cs.Has("charmed") {
+			return
+		}
+	}
+
+	// A puppeted NPC attacks only what its puppeteer orders; with no order it holds.
+	if inst, ok := h.npcMgr.Get(c.ID); ok && inst.PuppetedBy != "" {
+		for _, target := range cbt.Combatants {
+			if inst.PuppetOrder != "" && target.ID != c.ID && !target.IsDead() && strings.EqualFold(target.Name, inst.PuppetOrder) {
+				_ = cbt.QueueAction(c.ID, combat.QueuedAction{Type: combat.ActionAttack, Target: target.Name})
+				break
 			}
 		}
+		return
+	}
 
-		// NPC-initiated seduction (Phase 2): neutral NPCs with SeductionProbability > 0
-		// may attempt to seduce player combatants at the start of their turn.
-		if h.condRegistry != nil {
-			if inst, ok := h.npcMgr.Get(c.ID); ok && inst.SeductionProbability > 0 && inst.Disposition != "hostile" {
-				seducedDef, hasDef := h.condRegistry.Get("seduced")
-				if hasDef {
-					for _, pc := range cbt.Combatants {
-						if pc.Kind != combat.KindPlayer || pc.IsDead() {
-							continue
-						}
-						if inst.SeductionRejected != nil && inst.SeductionRejected[pc.ID] {
-							continue
-						}
-						sess, ok := h.sessions.GetPlayer(pc.ID)
-						if !ok {
-							continue
-						}
-						if h.ResolveNPCSeductionGenderCheck(inst, pc.ID, sess.Gender) {
-							continue
-						}
-						roll := h.dice.Src().Intn(20) + 1
-						if float64(roll) > inst.SeductionProbability*20 {
-							continue
-						}
-						npcRoll := h.dice.Src().Intn(20) + 1
-						playerRoll := h.dice.Src().Intn(20) + 1
-						if cbt.Conditions[pc.ID] == nil {
-							cbt.Conditions[pc.ID] = condition.NewActiveSet()
-						}
-						h.ResolveNPCSeductionContest(inst, pc.ID, sess.Abilities.Savvy, seducedDef, cbt.Conditions[pc.ID], npcRoll, playerRoll)
+	// NPC-initiated seduction (Phase 2): neutral NPCs with SeductionProbability > 0
+	// may attempt to seduce player combatants at the start of their turn.
+	if h.condRegistry != nil {
+		if inst, ok := h.npcMgr.Get(c.ID); ok && inst.SeductionProbability > 0 && inst.Disposition != "hostile" {
+			seducedDef, hasDef := h.condRegistry.Get("seduced")
+			if hasDef {
+				for _, pc := range cbt.Combatants {
+					if pc.Kind != combat.KindPlayer || pc.IsDead() {
+						continue
+					}
+					if inst.SeductionRejected != nil && inst.SeductionRejected[pc.ID] {
+						continue
+					}
+					sess, ok := h.sessions.GetPlayer(pc.ID)
+					if !ok {
+						continue
 					}
+					if h.ResolveNPCSeductionGenderCheck(inst, pc.ID, sess.Gender) {
+						continue
+					}
+					roll := h.dice.Src().Intn(20) + 1
+					if float64(roll) > inst.SeductionProbability*20 {
+						continue
+					}
+					npcRoll := h.dice.Src().Intn(20) + 1
+					playerRoll := h.dice.Src().Intn(20) + 1
+					if cbt.Conditions[pc.ID] == nil {
+						cbt.Conditions[pc.ID] = condition.NewActiveSet()
+					}
+					h.ResolveNPCSeductionContest(inst, pc.ID, sess.Abilities.Savvy, seducedDef, cbt.Conditions[pc.ID], npcRoll, playerRoll)
 				}
 			}
 		}
+	}
 
-		// Auto-use-cover: apply cover at start of NPC turn when strategy enables it
-		// and the NPC is not already in cover.
-		if c.CoverTier == "" {
-			if inst, ok := h.npcMgr.Get(c.ID); ok && inst.UseCover && room != nil {
-				if bestEquip, bestTier := bestCoverInRoom(room); bestTier != "" {
-					c.CoverEquipmentID = bestEquip.ItemID
-					c.CoverTier = bestTier
-					condID := bestTier + "_cover"
-					if h.condRegistry != nil {
-						if def, ok := h.condRegistry.Get(condID); ok {
-							if cbt.Conditions[c.ID] == nil {
-								cbt.Conditions[c.ID] = condition.NewActiveSet()
-							}
-							_ = cbt.Conditions[c.ID].Apply(c.ID, def, 1, -1)
-							combat.SyncConditionApply(c, c.ID, def, cbt.Conditions[c.ID].Stacks(condID))
+	// Auto-use-cover: apply cover at start of NPC turn when strategy enables it
+	// and the NPC is not already in cover.
+	if c.CoverTier == "" {
+		if inst, ok := h.npcMgr.Get(c.ID); ok && inst.UseCover && room != nil {
+			if bestEquip, bestTier := bestCoverInRoom(room); bestTier != "" {
+				c.CoverEquipmentID = bestEquip.ItemID
+				c.CoverTier = bestTier
+				condID := bestTier + "_cover"
+				if h.condRegistry != nil {
+					if def, ok := h.condRegistry.Get(condID); ok {
+						if cbt.Conditions[c.ID] == nil {
+							cbt.Conditions[c.ID] = condition.NewActiveSet()
 						}
-					}
-					if bestEquip.CoverDestructible && bestEquip.CoverHP > 0 && h.GetCoverHP(cbt.RoomID, bestEquip.ItemID) < 0 {
-						h.InitCoverState(cbt.RoomID, bestEquip.ItemID, bestEquip.CoverHP)
+						_ = cbt.Conditions[c.ID].Apply(c.ID, def, 1, -1)
+						combat.SyncConditionApply(c, c.ID, def, cbt.Conditions[c.ID].Stacks(condID))
 					}
 				}
+				if bestEquip.CoverDestructible && bestEquip.CoverHP > 0 && h.GetCoverHP(cbt.RoomID, bestEquip.ItemID) < 0 {
+					h.InitCoverState(cbt.RoomID, bestEquip.ItemID, bestEquip.CoverHP)
+				}
 			}
 		}
+	}
 
-		// Attempt HTN planning.
-		if h.aiRegistry != nil {
-			inst, ok := h.npcMgr.Get(c.ID)
-			if ok && inst.AIDomain != "" {
-				if planner, ok := h.aiRegistry.PlannerFor(inst.AIDomain); ok {
-					zoneID := h.zoneIDForRoom(cbt.RoomID)
-					ws := ai.BuildCombatWorldState(cbt, inst, zoneID)
-					actions, err := planner.Plan(ws)
-					if err == nil {
-						actions = FilterAnimalPlanActions(actions, inst.IsAnimal())
-						if len(actions) > 0 {
-							h.applyPlanLocked(cbt, c, actions)
-							h.maybeBroadcastTauntLocked(cbt, c)
-							continue
-						}
-						// Empty plan after filtering (e.g. animal with only say tasks):
-						// fall through to legacyAutoQueueLocked below.
+	// Attempt HTN planning.
+	if h.aiRegistry != nil {
+		inst, ok := h.npcMgr.Get(c.ID)
+		if ok && inst.AIDomain != "" {
+			if planner, ok := h.aiRegistry.PlannerFor(inst.AIDomain); ok {
+				zoneID := h.zoneIDForRoom(cbt.RoomID)
+				ws := ai.BuildCombatWorldState(cbt, inst, zoneID)
+				actions, err := planner.Plan(ws)
+				if err == nil {
+					actions = FilterAnimalPlanActions(actions, inst.IsAnimal())
+					if len(actions) > 0 {
+						h.applyPlanLocked(cbt, c, actions)
+						h.maybeBroadcastTauntLocked(cbt, c)
+						return
 					}
+					// Empty plan after filtering (e.g. animal with only say tasks):
+					// fall through to legacyAutoQueueLocked below.
 				}
 			}
 		}
-		// Fallback: attack first living player.
-		h.legacyAutoQueueLocked(cbt, c)
-		h.maybeBroadcastTauntLocked(cbt, c)
 	}
+	// Fallback: attack first living enemy.
+	h.legacyAutoQueueLocked(cbt, c)
+	h.maybeBroadcastTauntLocked(cbt, c)
 }
 
 // applyPlanLocked converts PlannedActions to QueuedActions and enqueues them.
@@ -4564,7 +4898,7 @@ func (h *CombatHandler) npcMovementStrideLocked(cbt *combat.Combat, c *combat.Co
 
 	var target *combat.Combatant
 	for _, comb := range cbt.Combatants {
-		if comb.Kind == combat.KindPlayer && !comb.IsDead() {
+		if comb.OnPlayerSide() != c.OnPlayerSide() && !comb.IsDead() {
 			target = comb
 			break
 		}
@@ -4575,7 +4909,7 @@ func (h *CombatHandler) npcMovementStrideLocked(cbt *combat.Combat, c *combat.Co
 
 	allies := make([]*combat.Combatant, 0, len(cbt.Combatants))
 	for _, comb := range cbt.Combatants {
-		if comb == c || comb.IsDead() || comb.Kind != c.Kind {
+		if comb == c || comb.IsDead() || comb.OnPlayerSide() != c.OnPlayerSide() {
 			continue
 		}
 		allies = append(allies, comb)
@@ -4623,7 +4957,8 @@ func (h *CombatHandler) npcMovementStrideLocked(cbt *combat.Combat, c *combat.Co
 	return ""
 }
 
-// legacyAutoQueueLocked queues ActionAttack for c targeting the first living player.
+// legacyAutoQueueLocked queues ActionAttack for c targeting the first living
+// combatant on the other side: a player, or a hostile NPC when c fights for one.
 // Prepends an ActionStride when movement is needed based on weapon type and distance.
 func (h *CombatHandler) legacyAutoQueueLocked(cbt *combat.Combat, c *combat.Combatant) {
 	// REQ-ZN-10: charmed NPCs treat players as allied — do not attack.
@@ -4636,8 +4971,13 @@ func (h *CombatHandler) legacyAutoQueueLocked(cbt *combat.Combat, c *combat.Comb
 	if dir := h.npcMovementStrideLocked(cbt, c); dir != "" {
 		_ = cbt.QueueAction(c.ID, combat.QueuedAction{Type: combat.ActionStride, Direction: dir})
 	}
+	// A melee attacker passes over back-row enemies screened by the front row.
+	melee := combat.AttacksInMelee(c)
 	for _, combatant := range cbt.Combatants {
-		if combatant.Kind == combat.KindPlayer && !combatant.IsDead() {
+		if combatant.OnPlayerSide() != c.OnPlayerSide() && !combatant.IsDead() {
+			if melee && cbt.Screened(combatant) {
+				continue
+			}
 			_ = cbt.QueueAction(c.ID, combat.QueuedAction{
 				Type:   combat.ActionAttack,
 				Target: combatant.Name,
@@ -4779,15 +5119,20 @@ func (h *CombatHandler) zoneIDForRoom(roomID string) string {
 // Caller must hold combatMu. The timer callback acquires combatMu independently.
 //
 // Precondition: combatMu is held; roomID must be non-empty.
-// Postcondition: A running RoundTimer is stored for roomID.
+// Postcondition: A RoundTimer is stored for roomID; it is running unless the room is frozen.
 func (h *CombatHandler) startTimerLocked(roomID string) {
 	h.timersMu.Lock()
 	if existing, ok := h.timers[roomID]; ok {
 		existing.Stop()
 	}
-	h.timers[roomID] = combat.NewRoundTimer(h.roundDuration, func() {
+	t := combat.NewRoundTimer(h.RoundDuration(), func() {
 		h.resolveAndAdvance(roomID)
 	})
+	if h.frozen[roomID] {
+		// A frozen room keeps its combat but its clock does not run.
+		t.Stop()
+	}
+	h.timers[roomID] = t
 	h.timersMu.Unlock()
 }
 
@@ -4831,13 +5176,13 @@ func (h *CombatHandler) cancelTimer(roomID string) {
 
 // deliverVerboseBreakdowns delivers the verbose damage-breakdown block (MULT-15)
 // to each player session in roomID whose ShowDamageBreakdown preference is
-// enabled. The verbose block is sent as a MessageEvent so it reaches only
-// opted-in observers and does not pollute the shared combat narrative.
+// enabled or whose combat narration is verbose, as in practice mode. The
+// verbose block is sent as a MessageEvent so it reaches only opted-in
+// observers and does not pollute the shared combat narrative.
 //
 // Precondition: roomID is a valid room identifier; roundEvents may be empty.
 // Postcondition: For every event with non-empty BreakdownSteps and a non-empty
-// inline DamageBreakdown, each player in roomID with ShowDamageBreakdown=true
-// receives one MessageEvent containing the verbose render.
+// inline DamageBreakdown, each opted-in player in roomID receives one MessageEvent containing the verbose render.
 func (h *CombatHandler) deliverVerboseBreakdowns(roomID string, roundEvents []combat.RoundEvent) {
 	if len(roundEvents) == 0 {
 		return
@@ -4853,7 +5198,7 @@ func (h *CombatHandler) deliverVerboseBreakdowns(roomID string, roundEvents []co
 		if !ok || sess == nil || sess.Entity == nil {
 			continue
 		}
-		if !sess.ShowDamageBreakdown {
+		if !sess.ShowDamageBreakdown && sess.CombatNarration() != session.CombatVerbosityVerbose {
 			continue
 		}
 		observers = append(observers, sess)
@@ -4950,7 +5295,7 @@ func (h *CombatHandler) findCombatant(cbt *combat.Combat, id string) *combat.Com
 func (h *CombatHandler) bestNPCCombatant(cbt *combat.Combat) *combat.Combatant {
 	var best *combat.Combatant
 	for _, c := range cbt.Combatants {
-		if c.Kind == combat.KindNPC && !c.IsDead() {
+		if c.IsHostileNPC() && !c.IsDead() {
 			if best == nil || c.StrMod > best.StrMod {
 				best = c
 			}
@@ -4991,27 +5336,33 @@ func (h *CombatHandler) removeDeadNPCsLocked(cbt *combat.Combat) {
 		if !ok {
 			continue
 		}
+		if c.AllyOf != "" {
+			h.removeDeadHirelingLocked(c, inst)
+			continue
+		}
 		templateID := inst.TemplateID
 		roomID := inst.RoomID
 		// Generate loot from NPC's loot table before removal so that
 		// the instance data is still accessible and removal serves as
 		// a happens-before signal for tests polling npcMgr.Get.
 		if inst.Loot != nil {
+			loot := npc.ScaleLoot(*inst.Loot, h.zoneModifier(roomID, scripting.ModLootMultiplier))
 			var result npc.LootResult
 			switch {
 			case inst.IsAnimal():
-				result = npc.GenerateOrganicLoot(*inst.Loot)
+				result = npc.GenerateOrganicLoot(loot)
 			case inst.IsRobot():
-				salvage := npc.GenerateSalvageLoot(*inst.Loot)
-				std := npc.GenerateLoot(*inst.Loot)
+				salvage := npc.GenerateSalvageLoot(loot)
+				std := npc.GenerateLoot(loot)
 				result.Currency = std.Currency
 				result.Items = append(salvage.Items, std.Items...)
 			default:
-				result = npc.GenerateLoot(*inst.Loot)
+				result = npc.GenerateLoot(loot)
 			}
 			// Distribute currency equally among all living participants.
 			totalCurrency := result.Currency + inst.Currency
 			inst.Currency = 0
+			totalCurrency = h.takeHirelingCutsLocked(cbt, totalCurrency)
 			livingParticipants := h.livingParticipantSessions(cbt)
 			h.distributeCurrencyLocked(context.Background(), livingParticipants, totalCurrency)
 			if totalCurrency > 0 {
@@ -5030,6 +5381,7 @@ func (h *CombatHandler) removeDeadNPCsLocked(cbt *combat.Combat) {
 			// No loot table but NPC has rob wallet — distribute to living participants.
 			totalCurrency := inst.Currency
 			inst.Currency = 0
+			totalCurrency = h.takeHirelingCutsLocked(cbt, totalCurrency)
 			livingParticipants := h.livingParticipantSessions(cbt)
 			h.distributeCurrencyLocked(context.Background(), livingParticipants, totalCurrency)
 			h.pushCurrencyMessages(livingParticipants, totalCurrency, c.Name)
@@ -5159,6 +5511,12 @@ func (h *CombatHandler) removeDeadNPCsLocked(cbt *combat.Combat) {
 			}
 		}
 
+		if h.onNPCKilledFn != nil {
+			for _, p := range h.livingParticipantSessions(cbt) {
+				h.onNPCKilledFn(p, inst)
+			}
+		}
+
 		// Remove cannot fail: Get confirmed existence above, and combatMu prevents concurrent removal.
 		_ = h.npcMgr.Remove(c.ID)
 		if h.onNPCDeath != nil {
@@ -5166,6 +5524,9 @@ func (h *CombatHandler) removeDeadNPCsLocked(cbt *combat.Combat) {
 		}
 		if h.respawnMgr != nil {
 			delay := h.respawnMgr.ResolvedDelay(templateID, roomID)
+			if rate := h.zoneModifier(roomID, scripting.ModRespawnRate); rate != 1 {
+				delay = time.Duration(float64(delay) / rate)
+			}
 			h.respawnMgr.Schedule(templateID, roomID, time.Now(), delay)
 		}
 	}
@@ -5206,6 +5567,7 @@ func (h *CombatHandler) distributeCurrencyLocked(ctx context.Context, livingPart
 	share := totalCurrency / len(livingParticipants)
 	if share == 0 {
 		livingParticipants[0].Currency++
+		h.currencyChanged(livingParticipants[0], 1, telemetry.SourceLoot)
 		if h.currencySaver != nil {
 			if err := h.currencySaver.SaveCurrency(ctx, livingParticipants[0].CharacterID, livingParticipants[0].Currency); err != nil && h.logger != nil {
 				h.logger.Warn("SaveCurrency failed (share=0 fallback)",
@@ -5218,6 +5580,7 @@ func (h *CombatHandler) distributeCurrencyLocked(ctx context.Context, livingPart
 	}
 	for _, p := range livingParticipants {
 		p.Currency += share
+		h.currencyChanged(p, share, telemetry.SourceLoot)
 		if h.currencySaver != nil {
 			if err := h.currencySaver.SaveCurrency(ctx, p.CharacterID, p.Currency); err != nil && h.logger != nil {
 				h.logger.Warn("SaveCurrency failed",
@@ -5345,7 +5708,8 @@ func (h *CombatHandler) distributeItemsLocked(livingParticipants []*session.Play
 			continue
 		}
 		for _, lootItem := range items {
-			if _, err := p.Backpack.Add(lootItem.ItemDefID, lootItem.Quantity, h.invRegistry); err != nil {
+			inst, err := p.Backpack.Add(lootItem.ItemDefID, lootItem.Quantity, h.invRegistry)
+			if err != nil {
 				if h.logger != nil {
 					h.logger.Warn("distributeItemsLocked: failed to add item to backpack",
 						zap.String("uid", p.UID),
@@ -5353,7 +5717,10 @@ func (h *CombatHandler) distributeItemsLocked(livingParticipants []*session.Play
 						zap.Error(err),
 					)
 				}
+				continue
 			}
+			h.rollLootAffixes(p, inst)
+			h.chartLootMap(p, inst)
 		}
 		if h.saveInventoryFn != nil {
 			if err := h.saveInventoryFn(p); err != nil && h.logger != nil {
@@ -5366,6 +5733,71 @@ func (h *CombatHandler) distributeItemsLocked(livingParticipants []*session.Play
 		if h.pushInventoryFn != nil {
 			h.pushInventoryFn(p)
 		}
+		if h.onItemsLootedFn != nil {
+			h.onItemsLootedFn(p.UID)
+		}
+	}
+}
+
+// rollLootAffixes rolls prefix and suffix affixes onto a freshly looted
+// weapon or armor instance. Stackable items and rolls without a dice roller
+// are left unaffixed.
+//
+// Precondition: inst was just added to p's backpack.
+// Postcondition: The backpack instance's Affixes holds the rolled affix IDs.
+func (h *CombatHandler) rollLootAffixes(p *session.PlayerSession, inst *inventory.ItemInstance) {
+	if inst == nil || h.dice == nil {
+		return
+	}
+	def, ok := h.invRegistry.Item(inst.ItemDefID)
+	if !ok || def.Stackable {
+		return
+	}
+	affixes := h.invRegistry.RollAffixes(def.Kind, h.dice.Src())
+	if len(affixes) == 0 {
+		return
+	}
+	if mi := p.Backpack.MutableItem(inst.InstanceID); mi != nil {
+		mi.Affixes = affixes
+	}
+}
+
+// zoneModifier returns the engine.zone modifier name set by the scripts of
+// the zone containing roomID.
+//
+// Postcondition: Returns 1 when scripting is not configured, the room is
+// unknown, or the zone has not set the modifier.
+func (h *CombatHandler) zoneModifier(roomID, name string) float64 {
+	if h.scriptMgr == nil || h.worldMgr == nil {
+		return 1
+	}
+	room, ok := h.worldMgr.GetRoom(roomID)
+	if !ok {
+		return 1
+	}
+	return h.scriptMgr.ZoneModifier(room.ZoneID, name)
+}
+
+// chartLootMap points a freshly looted treasure map at a room in the zone
+// where it dropped and reveals its first clue.
+//
+// Precondition: inst was just added to p's backpack.
+// Postcondition: A treasure map instance has MapRoomID set and MapClues == 1,
+// unless the zone has no room to bury a cache in.
+func (h *CombatHandler) chartLootMap(p *session.PlayerSession, inst *inventory.ItemInstance) {
+	if inst == nil || h.dice == nil || h.worldMgr == nil {
+		return
+	}
+	def, ok := h.invRegistry.Item(inst.ItemDefID)
+	if !ok || def.TreasureCache == nil {
+		return
+	}
+	target, ok := pickTreasureRoom(h.worldMgr, p.RoomID, h.dice.Src())
+	if !ok {
+		return
+	}
+	if mi := p.Backpack.MutableItem(inst.InstanceID); mi != nil {
+		mi.MapRoomID, mi.MapClues = target, 1
 	}
 }
 
@@ -5457,7 +5889,7 @@ func (h *CombatHandler) robPlayersLocked(cbt *combat.Combat) []*gamev1.CombatEve
 	var robbedSessions []*session.PlayerSession
 
 	for _, c := range cbt.Combatants {
-		if c.Kind != combat.KindNPC || c.IsDead() {
+		if !c.IsHostileNPC() || c.IsDead() {
 			continue
 		}
 		inst, ok := h.npcMgr.Get(c.ID)
@@ -5478,6 +5910,7 @@ func (h *CombatHandler) robPlayersLocked(cbt *combat.Combat) []*gamev1.CombatEve
 			}
 			inst.Currency += stolen
 			sess.Currency -= stolen
+			h.currencyChanged(sess, -stolen, telemetry.SourceRobbed)
 			robbedSessions = append(robbedSessions, sess)
 			msg := fmt.Sprintf("The %s rifles through your pockets, taking %d rounds.", inst.Name(), stolen)
 			events = append(events, &gamev1.CombatEvent{
@@ -5521,10 +5954,12 @@ func conditionEventsToProto(events []combat.RoundConditionEvent, reg *condition.
 		if def != nil {
 			name = def.Name
 		}
-		var narrative string
-		if ce.Applied {
+		narrative := ce.Narrative
+		switch {
+		case narrative != "":
+		case ce.Applied:
 			narrative = fmt.Sprintf("%s is now %s (stacks: %d).", ce.Name, name, ce.Stacks)
-		} else {
+		default:
 			narrative = fmt.Sprintf("%s fades from %s.", name, ce.Name)
 		}
 		result = append(result, &gamev1.CombatEvent{
@@ -5659,15 +6094,17 @@ func (h *CombatHandler) DisarmNPC(uid, npcInstID string) (weaponItemID string, e
 	return weaponItemID, nil
 }
 
-// ShoveNPC adjusts the NPC combatant's grid position by the given push distance (in feet).
-// The direction of the push is away from the player along the X axis.
+// ShoveNPC pushes the NPC combatant the given distance (in feet) directly away
+// from the player. A shove into the edge of the grid drives the NPC out of the
+// room when an open exit lies that way.
 //
 // Precondition: uid must be a valid connected player in active combat; npcInstID must be a combatant in that combat.
-// Postcondition: NPC GridX is increased by pushFt/5 cells if NPC.GridX >= player.GridX, otherwise decreased (floored at 0).
-func (h *CombatHandler) ShoveNPC(uid, npcInstID string, pushFt int) error {
+// Postcondition: The NPC has moved up to pushFt/5 cells (minimum 1) and stopped at
+// the first wall, combatant, or obstacle; the returned ForcedMove describes the push.
+func (h *CombatHandler) ShoveNPC(uid, npcInstID string, pushFt int) (ForcedMove, error) {
 	sess, ok := h.sessions.GetPlayer(uid)
 	if !ok {
-		return fmt.Errorf("player %q not found", uid)
+		return ForcedMove{}, fmt.Errorf("player %q not found", uid)
 	}
 
 	h.combatMu.Lock()
@@ -5675,7 +6112,7 @@ func (h *CombatHandler) ShoveNPC(uid, npcInstID string, pushFt int) error {
 
 	cbt, ok := h.engine.GetCombat(sess.RoomID)
 	if !ok {
-		return fmt.Errorf("player %q is not in active combat", uid)
+		return ForcedMove{}, fmt.Errorf("player %q is not in active combat", uid)
 	}
 
 	var playerCbt *combat.Combatant
@@ -5689,10 +6126,10 @@ func (h *CombatHandler) ShoveNPC(uid, npcInstID string, pushFt int) error {
 		}
 	}
 	if playerCbt == nil {
-		return fmt.Errorf("player combatant %q not found", uid)
+		return ForcedMove{}, fmt.Errorf("player combatant %q not found", uid)
 	}
 	if npcCbt == nil {
-		return fmt.Errorf("NPC combatant %q not found", npcInstID)
+		return ForcedMove{}, fmt.Errorf("NPC combatant %q not found", npcInstID)
 	}
 
 	// Convert feet to grid cells (1 cell = 5 ft), minimum 1 cell.
@@ -5700,22 +6137,25 @@ func (h *CombatHandler) ShoveNPC(uid, npcInstID string, pushFt int) error {
 	if pushCells < 1 {
 		pushCells = 1
 	}
-	width := 10
-	if cbt.GridWidth > 0 {
-		width = cbt.GridWidth
+	var src combat.Source = globalRandSrc{}
+	if h.dice != nil {
+		src = h.dice.Src()
 	}
-	if npcCbt.GridX >= playerCbt.GridX {
-		npcCbt.GridX += pushCells
-		if npcCbt.GridX >= width {
-			npcCbt.GridX = width - 1
-		}
-	} else {
-		npcCbt.GridX -= pushCells
-		if npcCbt.GridX < 0 {
-			npcCbt.GridX = 0
+	res := combat.Push(cbt, npcCbt, playerCbt.GridX, playerCbt.GridY, pushCells, src)
+	move := ForcedMove{Narrative: combat.PushNarrative(npcCbt, playerCbt, "shoved back", res)}
+	for _, ev := range res.Events {
+		move.Narrative += "\n" + ev.Narrative
+	}
+	if inst, ok := h.npcMgr.Get(npcInstID); ok {
+		inst.CurrentHP = npcCbt.CurrentHP
+	}
+	if res.EdgeDir != "" {
+		if toRoomID, narrative := h.ejectLocked(cbt, npcCbt, res.EdgeDir); toRoomID != "" {
+			move.ExitRoomID = toRoomID
+			move.Narrative += "\n" + narrative
 		}
 	}
-	return nil
+	return move, nil
 }
 
 // applyMentalStateChanges applies condition swaps from mental state transitions to the player session.
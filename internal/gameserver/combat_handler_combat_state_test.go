@@ -0,0 +1,130 @@
+package gameserver
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/condition"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestCombatStateEvent_ListsInitiativeOrderAndAllyAP(t *testing.T) {
+	player := &combat.Combatant{ID: "u1", Kind: combat.KindPlayer, Name: "Rook", Initiative: 18, MaxHP: 10, CurrentHP: 10}
+	ganger := &combat.Combatant{ID: "n1", Kind: combat.KindNPC, Name: "Ganger", Initiative: 12, MaxHP: 8, CurrentHP: 0}
+	q := combat.NewActionQueue("u1", 3)
+	require.NoError(t, q.DeductAP(1))
+	frightened := &condition.ConditionDef{ID: "frightened", Name: "frightened", DurationType: "rounds", MaxStacks: 4}
+	prone := &condition.ConditionDef{ID: "prone", Name: "prone", DurationType: "permanent"}
+	conds := condition.NewActiveSet()
+	require.NoError(t, conds.Apply("u1", prone, 1, -1))
+	require.NoError(t, conds.Apply("u1", frightened, 2, 2))
+	cbt := &combat.Combat{
+		Round:        2,
+		Combatants:   []*combat.Combatant{player, ganger},
+		ActionQueues: map[string]*combat.ActionQueue{"u1": q, "n1": combat.NewActionQueue("n1", 3)},
+		Conditions:   map[string]*condition.ActiveSet{"u1": conds},
+	}
+
+	evt := combatStateEvent(cbt)
+	assert.Equal(t, int32(2), evt.Round)
+	require.Len(t, evt.Combatants, 2)
+
+	rook := evt.Combatants[0]
+	assert.Equal(t, "Rook", rook.Name)
+	assert.True(t, rook.IsPlayer)
+	assert.Equal(t, int32(18), rook.Initiative)
+	assert.Equal(t, int32(2), rook.ApRemaining)
+	assert.Equal(t, int32(3), rook.ApTotal)
+	assert.Equal(t, []string{"frightened 2", "prone"}, rook.Conditions)
+
+	npc := evt.Combatants[1]
+	assert.Equal(t, "Ganger", npc.Name)
+	assert.False(t, npc.IsPlayer)
+	assert.Zero(t, npc.ApRemaining, "NPC AP stays hidden")
+	assert.Zero(t, npc.ApTotal)
+	assert.True(t, npc.Dead)
+}
+
+func TestCombatState_BroadcastAfterQueueAndRoundStart(t *testing.T) {
+	h := makeCombatHandler(t, func(string, []*gamev1.CombatEvent) {})
+	const roomID = "room-combat-state"
+	h.SetOnCombatEnd(func(string) {})
+
+	var mu sync.Mutex
+	var states []*gamev1.CombatStateEvent
+	h.SetCombatStateBroadcastFn(func(rid string, evt *gamev1.CombatStateEvent) {
+		assert.Equal(t, roomID, rid)
+		mu.Lock()
+		defer mu.Unlock()
+		states = append(states, evt)
+	})
+
+	inst := spawnTestNPC(t, h.npcMgr, roomID)
+	addTestPlayer(t, h.sessions, "player-state", roomID)
+	_, err := h.Attack("player-state", inst.Name())
+	require.NoError(t, err)
+	h.cancelTimer(roomID)
+
+	mu.Lock()
+	require.NotEmpty(t, states)
+	last := states[len(states)-1]
+	mu.Unlock()
+	require.Len(t, last.Combatants, 2)
+	for _, c := range last.Combatants {
+		if c.IsPlayer {
+			assert.Less(t, c.ApRemaining, c.ApTotal, "queued attack spends AP")
+		}
+	}
+
+	h.combatMu.Lock()
+	cbt, ok := h.engine.GetCombat(roomID)
+	require.True(t, ok)
+	before := len(states)
+	h.resolveAndAdvanceLocked(roomID, cbt)
+	h.combatMu.Unlock()
+	h.cancelTimer(roomID)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, active := h.engine.GetCombat(roomID); active {
+		require.Greater(t, len(states), before, "round start broadcasts combat state")
+		assert.Equal(t, int32(cbt.Round), states[len(states)-1].Round)
+	}
+}
+
+// TestProperty_CombatStateEventPreservesCombatantOrder verifies the event lists
+// every combatant once, in the combat's initiative order, and reports AP only
+// for players.
+func TestProperty_CombatStateEventPreservesCombatantOrder(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(0, 8).Draw(rt, "n")
+		cbt := &combat.Combat{ActionQueues: map[string]*combat.ActionQueue{}}
+		for i := 0; i < n; i++ {
+			kind := combat.KindNPC
+			if rapid.Bool().Draw(rt, "player") {
+				kind = combat.KindPlayer
+			}
+			id := string(rune('a' + i))
+			cbt.Combatants = append(cbt.Combatants, &combat.Combatant{ID: id, Kind: kind, Name: id, MaxHP: 1, CurrentHP: 1})
+			cbt.ActionQueues[id] = combat.NewActionQueue(id, 3)
+		}
+		evt := combatStateEvent(cbt)
+		if len(evt.Combatants) != n {
+			rt.Fatalf("got %d combatants, want %d", len(evt.Combatants), n)
+		}
+		for i, st := range evt.Combatants {
+			c := cbt.Combatants[i]
+			if st.Name != c.Name {
+				rt.Fatalf("position %d: got %q, want %q", i, st.Name, c.Name)
+			}
+			if wantAP := c.IsPlayer(); (st.ApTotal > 0) != wantAP {
+				rt.Fatalf("%s: ap_total %d for player=%v", c.Name, st.ApTotal, wantAP)
+			}
+		}
+	})
+}
@@ -0,0 +1,204 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+// companionOf returns the living combatant in cbt fighting for uid whose name
+// matches name, or nil.
+//
+// Precondition: cbt must not be nil.
+func companionOf(cbt *combat.Combat, uid, name string) *combat.Combatant {
+	for _, c := range cbt.Combatants {
+		if c.AllyOf == uid && !c.IsDead() && strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// OrderCompanion gives the companion fighting for uid a standing order for
+// the rest of the fight and requeues its actions for this round to follow it.
+// An "attack" order names the enemy in target.
+//
+// Precondition: order is "attack", "guard", or "fallback".
+// Postcondition: On success the companion's Order is order and returns its
+// name; returns an error when uid is not fighting alongside the companion or
+// the attack target is not an enemy in the fight.
+func (h *CombatHandler) OrderCompanion(uid, companion, order, target string) (string, error) {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return "", fmt.Errorf("player %q not found", uid)
+	}
+
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	cbt, ok := h.engine.GetCombat(sess.RoomID)
+	if !ok || cbt.GetCombatant(uid) == nil {
+		return "", fmt.Errorf("you are not in combat")
+	}
+	c := companionOf(cbt, uid, companion)
+	if c == nil {
+		return "", fmt.Errorf("%s is not fighting at your side", companion)
+	}
+	if order == "attack" {
+		enemy := orderTarget(cbt, c, target)
+		if enemy == nil {
+			return "", fmt.Errorf("%s can't see %q in this fight", c.Name, target)
+		}
+		target = enemy.Name
+	}
+	c.Order, c.OrderTarget = order, target
+	h.requeueCompanionLocked(cbt, c, 0)
+	return c.Name, nil
+}
+
+// SpendCompanionAP has the companion fighting for uid spend cost AP this
+// round on an errand for its employer, such as handing over a consumable.
+// Its remaining AP follow its standing order or its AI.
+//
+// Precondition: cost > 0.
+// Postcondition: On success returns the companion's name and it has cost
+// fewer AP this round; returns an error when uid is not fighting alongside
+// the companion or it has too few AP left.
+func (h *CombatHandler) SpendCompanionAP(uid, companion string, cost int) (string, error) {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return "", fmt.Errorf("player %q not found", uid)
+	}
+
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	cbt, ok := h.engine.GetCombat(sess.RoomID)
+	if !ok || cbt.GetCombatant(uid) == nil {
+		return "", fmt.Errorf("you are not in combat")
+	}
+	c := companionOf(cbt, uid, companion)
+	if c == nil {
+		return "", fmt.Errorf("%s is not fighting at your side", companion)
+	}
+	q, ok := cbt.ActionQueues[c.ID]
+	if !ok || q.MaxPoints < cost {
+		return "", fmt.Errorf("%s is too busy to help this round", c.Name)
+	}
+	h.requeueCompanionLocked(cbt, c, cost)
+	return c.Name, nil
+}
+
+// requeueCompanionLocked discards companion c's queued actions for this
+// round, holds back reserved AP, and queues the rest from its standing order,
+// or its AI when it has none.
+//
+// Precondition: h.combatMu is held; cbt and c must not be nil; reserved >= 0.
+func (h *CombatHandler) requeueCompanionLocked(cbt *combat.Combat, c *combat.Combatant, reserved int) {
+	q, ok := cbt.ActionQueues[c.ID]
+	if !ok {
+		return
+	}
+	q.ClearActions()
+	if reserved > 0 {
+		if err := q.DeductAP(reserved); err != nil {
+			return
+		}
+	}
+	if q.RemainingPoints() == 0 {
+		return
+	}
+	if !h.queueCompanionOrderLocked(cbt, c) {
+		h.legacyAutoQueueLocked(cbt, c)
+	}
+}
+
+// queueCompanionOrderLocked queues companion c's actions this round from its
+// standing order. An attack order whose target has fallen is dropped.
+//
+// Precondition: h.combatMu is held; cbt and c must not be nil.
+// Postcondition: Returns false when c has no order to follow, leaving its
+// queue untouched.
+func (h *CombatHandler) queueCompanionOrderLocked(cbt *combat.Combat, c *combat.Combatant) bool {
+	switch c.Order {
+	case "attack":
+		target := orderTarget(cbt, c, c.OrderTarget)
+		if target == nil {
+			c.Order, c.OrderTarget = "", ""
+			if c.AllyOf != "" {
+				h.pushMessageToUID(c.AllyOf, fmt.Sprintf("%s's target is down, so they go back to fighting on their own judgement.", c.Name))
+			}
+			return false
+		}
+		c.BackRow = false
+		h.queueOrderedAttacksLocked(cbt, c, target)
+	case "guard":
+		c.BackRow = false
+		protect := cbt.GetCombatant(c.AllyOf)
+		if protect == nil || protect.IsDead() {
+			protect = c
+		}
+		if target := nearestEnemy(cbt, c, protect); target != nil {
+			h.queueOrderedAttacksLocked(cbt, c, target)
+		} else {
+			_ = cbt.QueueAction(c.ID, combat.QueuedAction{Type: combat.ActionPass})
+		}
+	case "fallback":
+		c.BackRow = true
+		target := nearestEnemy(cbt, c, c)
+		if combat.AttacksInMelee(c) || target == nil {
+			_ = cbt.QueueAction(c.ID, combat.QueuedAction{Type: combat.ActionPass})
+			return true
+		}
+		h.queueOrderedAttacksLocked(cbt, c, target)
+	default:
+		return false
+	}
+	return true
+}
+
+// queueOrderedAttacksLocked queues c's attacks on target with all its AP,
+// striding in first when it fights in melee and target is out of reach.
+//
+// Precondition: h.combatMu is held; cbt, c, and target must not be nil.
+func (h *CombatHandler) queueOrderedAttacksLocked(cbt *combat.Combat, c, target *combat.Combatant) {
+	if combat.AttacksInMelee(c) && combat.CombatRange(*c, *target) > 5 {
+		_ = cbt.QueueAction(c.ID, combat.QueuedAction{Type: combat.ActionStride, Direction: "toward"})
+	}
+	for {
+		if err := cbt.QueueAction(c.ID, combat.QueuedAction{Type: combat.ActionAttack, Target: target.Name}); err != nil {
+			return
+		}
+	}
+}
+
+// orderTarget returns the living enemy of c in cbt named name, or nil.
+//
+// Precondition: cbt and c must not be nil.
+func orderTarget(cbt *combat.Combat, c *combat.Combatant, name string) *combat.Combatant {
+	for _, e := range cbt.Combatants {
+		if e.OnPlayerSide() != c.OnPlayerSide() && !e.IsDead() && strings.EqualFold(e.Name, name) {
+			return e
+		}
+	}
+	return nil
+}
+
+// nearestEnemy returns the living enemy of c in cbt closest to from, or nil.
+// A melee attacker passes over enemies screened in the back row.
+//
+// Precondition: cbt, c, and from must not be nil.
+func nearestEnemy(cbt *combat.Combat, c, from *combat.Combatant) *combat.Combatant {
+	melee := combat.AttacksInMelee(c)
+	var best *combat.Combatant
+	for _, e := range cbt.Combatants {
+		if e.OnPlayerSide() == c.OnPlayerSide() || e.IsDead() || (melee && cbt.Screened(e)) {
+			continue
+		}
+		if best == nil || combat.CombatRange(*from, *e) < combat.CombatRange(*from, *best) {
+			best = e
+		}
+	}
+	return best
+}
@@ -0,0 +1,135 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+// newCompanionFight starts a fight between player "ord-p1" with their hired
+// Merc and a Goblin and a Brute in roomID, with a fresh round under way.
+func newCompanionFight(t *testing.T, roomID string) (*CombatHandler, *combat.Combat, *combat.Combatant) {
+	t.Helper()
+	h, npcMgr, sessMgr := makeAutoQueueHandler(t)
+	spawnAutoQueueNPC(t, npcMgr, roomID, "Goblin")
+	brute := spawnAutoQueueNPC(t, npcMgr, roomID, "Brute")
+	merc := spawnTestMercenary(t, npcMgr, roomID, 0)
+	addAutoQueuePlayer(t, sessMgr, "ord-p1", "Hero", roomID, "attack", "")
+	h.SetHiredHirelingOf(func(uid string) *npc.Instance {
+		if uid == "ord-p1" {
+			return merc
+		}
+		return nil
+	})
+	cbt := startTestCombat(t, h, "ord-p1", roomID, "Goblin")
+	require.NoError(t, h.engine.AddCombatant(roomID, &combat.Combatant{
+		ID: brute.ID, Kind: combat.KindNPC, Name: "Brute", MaxHP: 20, CurrentHP: 20, AC: 13, GridX: 5, GridY: 5,
+	}))
+	mc := cbt.GetCombatant(merc.ID)
+	require.NotNil(t, mc)
+	_ = cbt.StartRound(3)
+	return h, cbt, mc
+}
+
+// combatantNamed returns the combatant in cbt named name, or nil.
+func combatantNamed(cbt *combat.Combat, name string) *combat.Combatant {
+	for _, c := range cbt.Combatants {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// queuedTargets returns the targets of c's queued attacks.
+func queuedTargets(cbt *combat.Combat, c *combat.Combatant) []string {
+	var out []string
+	for _, a := range cbt.ActionQueues[c.ID].QueuedActions() {
+		if a.Type == combat.ActionAttack {
+			out = append(out, a.Target)
+		}
+	}
+	return out
+}
+
+func TestOrderCompanion_AttackStandsUntilTheTargetFalls(t *testing.T) {
+	h, cbt, mc := newCompanionFight(t, "ord-room-1")
+
+	name, err := h.OrderCompanion("ord-p1", "merc", "attack", "brute")
+	require.NoError(t, err)
+	assert.Equal(t, "Merc", name)
+	assert.Equal(t, "Brute", mc.OrderTarget)
+	require.NotEmpty(t, queuedTargets(cbt, mc))
+	for _, target := range queuedTargets(cbt, mc) {
+		assert.Equal(t, "Brute", target)
+	}
+	assert.Zero(t, cbt.ActionQueues[mc.ID].RemainingPoints(), "the order spends all the companion's AP")
+
+	// The order stands into the next round.
+	_ = cbt.StartRound(3)
+	h.combatMu.Lock()
+	h.autoQueueNPCLocked(cbt, mc, nil)
+	h.combatMu.Unlock()
+	assert.Contains(t, queuedTargets(cbt, mc), "Brute")
+
+	// With the Brute down the order lapses and the companion fights on its own.
+	combatantNamed(cbt, "Brute").CurrentHP = 0
+	_ = cbt.StartRound(3)
+	h.combatMu.Lock()
+	h.autoQueueNPCLocked(cbt, mc, nil)
+	h.combatMu.Unlock()
+	assert.Empty(t, mc.Order)
+	assert.Equal(t, []string{"Goblin"}, queuedTargets(cbt, mc))
+}
+
+func TestOrderCompanion_FallbackAndGuard(t *testing.T) {
+	h, cbt, mc := newCompanionFight(t, "ord-room-2")
+
+	_, err := h.OrderCompanion("ord-p1", "Merc", "fallback", "")
+	require.NoError(t, err)
+	assert.True(t, mc.BackRow)
+	actions := cbt.ActionQueues[mc.ID].QueuedActions()
+	require.Len(t, actions, 1, "an unarmed companion in the back row holds")
+	assert.Equal(t, combat.ActionPass, actions[0].Type)
+
+	hero := cbt.GetCombatant("ord-p1")
+	brute := combatantNamed(cbt, "Brute")
+	goblin := combatantNamed(cbt, "Goblin")
+	hero.GridX, hero.GridY = 0, 0
+	brute.GridX, brute.GridY = 1, 0
+	goblin.GridX, goblin.GridY = 9, 9
+	mc.GridX, mc.GridY = 0, 1
+	_, err = h.OrderCompanion("ord-p1", "Merc", "guard", "")
+	require.NoError(t, err)
+	assert.False(t, mc.BackRow)
+	require.NotEmpty(t, queuedTargets(cbt, mc))
+	assert.Equal(t, "Brute", queuedTargets(cbt, mc)[0], "a guard takes on the enemy closest to its employer")
+}
+
+func TestOrderCompanion_Errors(t *testing.T) {
+	h, _, _ := newCompanionFight(t, "ord-room-3")
+
+	_, err := h.OrderCompanion("ord-p1", "Sal", "guard", "")
+	assert.EqualError(t, err, "Sal is not fighting at your side")
+	_, err = h.OrderCompanion("ord-p1", "Merc", "attack", "Hero")
+	assert.EqualError(t, err, `Merc can't see "Hero" in this fight`)
+	_, err = h.OrderCompanion("nobody", "Merc", "guard", "")
+	assert.Error(t, err)
+}
+
+func TestSpendCompanionAP_HoldsBackAnAction(t *testing.T) {
+	h, cbt, mc := newCompanionFight(t, "ord-room-4")
+	_, err := h.OrderCompanion("ord-p1", "Merc", "attack", "Goblin")
+	require.NoError(t, err)
+	before := len(queuedTargets(cbt, mc))
+
+	name, err := h.SpendCompanionAP("ord-p1", "Merc", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Merc", name)
+	assert.Equal(t, before-1, len(queuedTargets(cbt, mc)), "one action goes to the errand")
+	assert.Equal(t, "attack", mc.Order, "the standing order survives the errand")
+}
@@ -0,0 +1,88 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+// DyingValue returns uid's dying value in the combat in their room, or 0 when
+// they are not dying or not in combat.
+//
+// Precondition: uid non-empty.
+func (h *CombatHandler) DyingValue(uid string) int {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return 0
+	}
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	cbt, ok := h.engine.GetCombat(sess.RoomID)
+	if !ok || !cbt.IsDying(uid) {
+		return 0
+	}
+	return cbt.DyingStacks(uid)
+}
+
+// StabilizeDying ends uid's dying condition and brings them back to 1 HP,
+// adding a wounded stack when wounded is true.
+//
+// Precondition: uid non-empty.
+// Postcondition: Returns an error when uid is not dying in an active combat;
+// otherwise the room is told and the session's HP is 1.
+func (h *CombatHandler) StabilizeDying(uid string, wounded bool) error {
+	return h.withDyingCombat(uid, func(cbt *combat.Combat) ([]combat.RoundConditionEvent, error) {
+		return cbt.Stabilize(uid, wounded)
+	})
+}
+
+// WorsenDying raises uid's dying value by n, killing them at
+// combat.DeathDyingValue.
+//
+// Precondition: uid non-empty; n > 0.
+// Postcondition: Returns an error when uid is not dying in an active combat;
+// otherwise the room is told of the new dying value or the death.
+func (h *CombatHandler) WorsenDying(uid string, n int) error {
+	return h.withDyingCombat(uid, func(cbt *combat.Combat) ([]combat.RoundConditionEvent, error) {
+		return cbt.IncreaseDying(uid, n), nil
+	})
+}
+
+// withDyingCombat runs fn against the combat in which uid is dying, then
+// broadcasts the resulting events and syncs player HP back to sessions.
+func (h *CombatHandler) withDyingCombat(uid string, fn func(*combat.Combat) ([]combat.RoundConditionEvent, error)) error {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return fmt.Errorf("player %q not found", uid)
+	}
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	cbt, ok := h.engine.GetCombat(sess.RoomID)
+	if !ok || !cbt.IsDying(uid) {
+		return fmt.Errorf("%s is not dying", sess.CharName)
+	}
+	events, err := fn(cbt)
+	if err != nil {
+		return err
+	}
+	h.syncPlayerHPLocked(cbt)
+	h.broadcastFn(sess.RoomID, conditionEventsToProto(events, h.condRegistry))
+	return nil
+}
+
+// syncPlayerHPLocked copies the HP of each downed player combatant to their
+// session, so recoveries made by the dying loop outside damage resolution
+// reach the session. Sessions with HP left are untouched: out-of-band healing
+// updates the session, not the combatant.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+func (h *CombatHandler) syncPlayerHPLocked(cbt *combat.Combat) {
+	for _, c := range cbt.Combatants {
+		if c.Kind != combat.KindPlayer {
+			continue
+		}
+		if sess, ok := h.sessions.GetPlayer(c.ID); ok && sess.CurrentHP <= 0 {
+			sess.CurrentHP = c.CurrentHP
+		}
+	}
+}
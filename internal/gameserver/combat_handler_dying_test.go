@@ -0,0 +1,141 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newDyingSvc builds a GameServiceServer whose combat handler and checks roll
+// Intn(n) == val, with a fight under way in room_a between "ally" (Sal) and a
+// goblin. Sal is down at 0 HP with the given dying value.
+func newDyingSvc(t *testing.T, val, dying int) (*GameServiceServer, *session.Manager, *CombatHandler) {
+	t.Helper()
+	logger := zaptest.NewLogger(t)
+	roller := dice.NewLoggedRoller(&fixedDiceSource{val: val}, logger)
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcMgr := npc.NewManager()
+	combatH := NewCombatHandler(combat.NewEngine(), npcMgr, sessMgr, roller, func(string, []*gamev1.CombatEvent) {}, testRoundDuration, makeTestConditionRegistry(), worldMgr, nil, nil, nil, nil, nil, nil, nil)
+	svc := newTestGameServiceServer(
+		worldMgr, sessMgr,
+		command.DefaultRegistry(),
+		NewWorldHandler(worldMgr, sessMgr, npcMgr, nil, nil, nil),
+		NewChatHandler(sessMgr),
+		logger,
+		nil, roller, nil, nil, combatH, nil,
+		nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, "",
+		nil, nil, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil,
+		nil, nil,
+		nil,
+		nil,
+		nil, nil,
+	)
+
+	inst := spawnTestNPC(t, npcMgr, "room_a")
+	ally, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ally", Username: "Sal", CharName: "Sal", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	_, err = combatH.Attack("ally", inst.Name())
+	require.NoError(t, err)
+	combatH.cancelTimer("room_a")
+
+	combatH.combatMu.Lock()
+	cbt, ok := combatH.engine.GetCombat("room_a")
+	require.True(t, ok)
+	cbt.GetCombatant("ally").CurrentHP = 0
+	require.NoError(t, cbt.ApplyCondition("ally", "dying", dying, -1))
+	combatH.combatMu.Unlock()
+	ally.CurrentHP = 0
+	return svc, sessMgr, combatH
+}
+
+func addMedic(t *testing.T, sessMgr *session.Manager) *session.PlayerSession {
+	t.Helper()
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "medic", Username: "Medic", CharName: "Medic", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.Skills = map[string]string{"patch_job": "trained"}
+	return sess
+}
+
+func TestResolveAndAdvance_DyingPlayer_KeepsCombatGoing(t *testing.T) {
+	_, _, combatH := newDyingSvc(t, 10, 1)
+
+	combatH.combatMu.Lock()
+	cbt, _ := combatH.engine.GetCombat("room_a")
+	combatH.resolveAndAdvanceLocked("room_a", cbt)
+	combatH.combatMu.Unlock()
+	combatH.cancelTimer("room_a")
+
+	_, ok := combatH.engine.GetCombat("room_a")
+	assert.True(t, ok, "combat must continue while the downed player is still dying")
+}
+
+func TestHandleFirstAid_StabilizesDyingAlly(t *testing.T) {
+	// roll 19 + trained 2 = 21 vs DC 15 + dying 1 = 16 → success.
+	svc, sessMgr, combatH := newDyingSvc(t, 18, 1)
+	addMedic(t, sessMgr)
+
+	event, err := svc.handleFirstAid("medic", &gamev1.FirstAidRequest{Target: "sal"})
+	require.NoError(t, err)
+	require.NotNil(t, event.GetMessage(), "expected a message event, got %v", event)
+	assert.Contains(t, event.GetMessage().Content, "success")
+	assert.Equal(t, 0, combatH.DyingValue("ally"))
+	ally, _ := sessMgr.GetPlayer("ally")
+	assert.Equal(t, 1, ally.CurrentHP, "stabilized ally comes to with 1 HP")
+}
+
+func TestHandleFirstAid_CritFailure_WorsensDying(t *testing.T) {
+	// roll 1 + trained 2 = 3 vs DC 17 → critical failure.
+	svc, sessMgr, combatH := newDyingSvc(t, 0, 2)
+	addMedic(t, sessMgr)
+
+	event, err := svc.handleFirstAid("medic", &gamev1.FirstAidRequest{Target: "Sal"})
+	require.NoError(t, err)
+	assert.Contains(t, event.GetMessage().Content, "critical failure")
+	assert.Equal(t, 3, combatH.DyingValue("ally"))
+}
+
+func TestHandleFirstAid_TargetNotDying_ReturnsError(t *testing.T) {
+	svc, sessMgr, _ := newDyingSvc(t, 18, 1)
+	addMedic(t, sessMgr)
+
+	event, err := svc.handleFirstAid("ally", &gamev1.FirstAidRequest{Target: "Medic"})
+	require.NoError(t, err)
+	require.NotNil(t, event.GetError())
+	assert.Contains(t, event.GetError().Message, "not dying")
+}
+
+func TestHandleHeroPointStabilize_DyingInCombat_RecoversWithoutWounded(t *testing.T) {
+	svc, sessMgr, combatH := newDyingSvc(t, 10, 2)
+	ally, _ := sessMgr.GetPlayer("ally")
+	ally.HeroPoints = 1
+
+	event, err := svc.handleHeroPoint("ally", &gamev1.HeroPointRequest{Subcommand: "stabilize"})
+	require.NoError(t, err)
+	require.NotNil(t, event.GetMessage())
+	assert.Equal(t, 0, ally.HeroPoints)
+	assert.Equal(t, 1, ally.CurrentHP)
+	assert.Equal(t, 0, combatH.DyingValue("ally"))
+
+	combatH.combatMu.Lock()
+	cbt, _ := combatH.engine.GetCombat("room_a")
+	assert.False(t, cbt.HasCondition("ally", "wounded"), "heroic recovery does not add wounded")
+	combatH.combatMu.Unlock()
+}
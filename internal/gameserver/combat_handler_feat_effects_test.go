@@ -0,0 +1,30 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/effect"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+func TestPopulateCombatantEffects_AppliesPlayerFeatBonuses(t *testing.T) {
+	h, npcMgr, sessMgr := makeAutoQueueHandler(t)
+	h.SetFeatRegistry(ruleset.NewFeatRegistry([]*ruleset.Feat{
+		{ID: "combat_footing", PassiveBonuses: []effect.Bonus{{Stat: effect.StatAC, Value: 1, Type: effect.BonusTypeCircumstance}}},
+		{ID: "steady_aim", PassiveBonuses: []effect.Bonus{{Stat: effect.StatAttack, Value: 1, Type: effect.BonusTypeCircumstance}}},
+	}))
+	spawnAutoQueueNPC(t, npcMgr, "feat-room-1", "Goblin")
+	addAutoQueuePlayer(t, sessMgr, "feat-p1", "Hero", "feat-room-1", "attack", "")
+	sess, ok := sessMgr.GetPlayer("feat-p1")
+	require.True(t, ok)
+	sess.PassiveFeats = map[string]bool{"combat_footing": true}
+
+	cbt := startTestCombat(t, h, "feat-p1", "feat-room-1", "Goblin")
+	hero := cbt.GetCombatant("feat-p1")
+	require.NotNil(t, hero)
+	assert.Equal(t, 1, effect.Resolve(hero.Effects, effect.StatAC).Total)
+	assert.Zero(t, effect.Resolve(hero.Effects, effect.StatAttack).Total, "only feats the player has apply")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cory-johannsen/mud/internal/game/ai"
 	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/condition"
 	"github.com/cory-johannsen/mud/internal/game/dice"
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	"github.com/cory-johannsen/mud/internal/game/session"
@@ -500,3 +501,41 @@ func addTestPlayerWithHP(t *testing.T, sessMgr *session.Manager, uid, roomID str
 	}
 	return sess
 }
+
+// TestFlee_PlayerRootedCannotFlee verifies that a player held by a rooting
+// combat condition keeps their AP and stays in combat when trying to flee.
+func TestFlee_PlayerRootedCannotFlee(t *testing.T) {
+	const roomID = "room-a"
+	h, _ := makeFleeHandler(t, func(_ string, _ []*gamev1.CombatEvent) {})
+
+	inst := spawnHTNTestNPC(t, h.npcMgr, roomID, "")
+	addTestPlayer(t, h.sessions, "player-rooted", roomID)
+	if _, err := h.Attack("player-rooted", inst.Name()); err != nil {
+		t.Fatalf("Attack: %v", err)
+	}
+	defer h.cancelTimer(roomID)
+
+	h.combatMu.Lock()
+	cbt, _ := h.engine.GetCombat(roomID)
+	rooted := &condition.ConditionDef{ID: "rooted", Name: "Rooted", DurationType: "rounds", RestrictActions: []string{"stride", "flee"}}
+	if err := cbt.Conditions["player-rooted"].Apply("player-rooted", rooted, 1, 2); err != nil {
+		h.combatMu.Unlock()
+		t.Fatalf("Apply: %v", err)
+	}
+	apBefore := cbt.ActionQueues["player-rooted"].RemainingPoints()
+	h.combatMu.Unlock()
+
+	events, fled, err := h.Flee("player-rooted")
+	if err != nil {
+		t.Fatalf("Flee: %v", err)
+	}
+	if fled {
+		t.Fatal("expected a rooted player not to flee")
+	}
+	if len(events) != 1 || events[0].Narrative != "You are rooted in place and cannot flee!" {
+		t.Fatalf("unexpected flee events: %v", events)
+	}
+	if got := h.RemainingAP("player-rooted"); got != apBefore {
+		t.Errorf("expected AP to be kept: had %d, now %d", apBefore, got)
+	}
+}
@@ -0,0 +1,121 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"go.uber.org/zap"
+)
+
+// ForcedMove reports a combatant pushed across the grid by a shove or blast.
+type ForcedMove struct {
+	// Narrative describes the push, e.g. "Ganger is shoved back 5 ft (now 10 ft from Alice)."
+	Narrative string
+	// ExitRoomID is the room the combatant was driven into through an open
+	// exit; empty when it stayed in the fight.
+	ExitRoomID string
+}
+
+// edgeExitDirections maps a grid edge to the room exit that lies beyond it.
+var edgeExitDirections = map[string]world.Direction{
+	"n": world.North,
+	"s": world.South,
+	"e": world.East,
+	"w": world.West,
+}
+
+// forcedMoveTarget names a combatant moved by forced movement and the room it
+// was driven into, if any.
+type forcedMoveTarget struct {
+	id       string
+	toRoomID string
+}
+
+// resolveForcedMovesLocked ejects every combatant a blast drove off the grid
+// through the open exit on that side, then schedules the forced-move callback
+// for each combatant the round's knockback moved.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: Returns one event per ejected combatant.
+func (h *CombatHandler) resolveForcedMovesLocked(cbt *combat.Combat, roundEvents []combat.RoundEvent) []*gamev1.CombatEvent {
+	var events []*gamev1.CombatEvent
+	var moved []forcedMoveTarget
+	for _, re := range roundEvents {
+		if !re.Pushed {
+			continue
+		}
+		c := cbt.GetCombatant(re.TargetID)
+		if c == nil {
+			continue
+		}
+		target := forcedMoveTarget{id: c.ID}
+		if re.PushedOut != "" {
+			if toRoomID, narrative := h.ejectLocked(cbt, c, re.PushedOut); toRoomID != "" {
+				target.toRoomID = toRoomID
+				events = append(events, &gamev1.CombatEvent{
+					Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_POSITION,
+					Target:    c.Name,
+					Narrative: narrative,
+				})
+			}
+		}
+		moved = append(moved, target)
+	}
+	// The callback rebuilds room views, which re-acquires combatMu.
+	if h.onForcedMoveFn != nil && len(moved) > 0 {
+		fn := h.onForcedMoveFn
+		roomID := cbt.RoomID
+		go func() {
+			for _, m := range moved {
+				fn(roomID, m.id, m.toRoomID)
+			}
+		}()
+	}
+	return events
+}
+
+// ejectLocked drives living combatant c out of cbt's room through the exit
+// beyond grid edge edgeDir, as a blast or shove into a doorway does. An NPC
+// moves to the next room; a player leaves the fight and stands there idle.
+//
+// Precondition: h.combatMu is held; cbt and c must not be nil.
+// Postcondition: Returns ("", "") and leaves c in the fight when c is dead or
+// no open, visible exit lies beyond edgeDir; otherwise c is no longer a
+// combatant and the destination room and a narrative are returned.
+func (h *CombatHandler) ejectLocked(cbt *combat.Combat, c *combat.Combatant, edgeDir string) (toRoomID, narrative string) {
+	dir, ok := edgeExitDirections[edgeDir]
+	if !ok || c.IsDead() || h.worldMgr == nil {
+		return "", ""
+	}
+	room, ok := h.worldMgr.GetRoom(cbt.RoomID)
+	if !ok {
+		return "", ""
+	}
+	exit, ok := room.ExitForDirection(dir)
+	if !ok || exit.Hidden || exit.Locked {
+		return "", ""
+	}
+	switch c.Kind {
+	case combat.KindPlayer:
+		sess, ok := h.sessions.GetPlayer(c.ID)
+		if !ok {
+			return "", ""
+		}
+		if _, err := h.sessions.MovePlayer(c.ID, exit.TargetRoom); err != nil {
+			return "", ""
+		}
+		sess.Status = int32(1) // idle
+	default:
+		if err := h.npcMgr.Move(c.ID, exit.TargetRoom); err != nil {
+			if h.logger != nil {
+				h.logger.Warn("ejectLocked: moving npc", zap.String("npc_id", c.ID), zap.Error(err))
+			}
+			return "", ""
+		}
+	}
+	cbt.RemoveCombatant(c.ID)
+	delete(cbt.ActionQueues, c.ID)
+	return exit.TargetRoom, fmt.Sprintf("%s is thrown out through the %s exit!", c.Name, dir)
+}
@@ -0,0 +1,105 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newForcedMoveFight starts a fight in room_a (one open exit, north) between
+// Fighter and a level-1 Ganger, with dice that always roll 10.
+func newForcedMoveFight(t *testing.T) (*GameServiceServer, *session.Manager, *npc.Manager, *CombatHandler, *npc.Instance) {
+	t.Helper()
+	roller := dice.NewLoggedRoller(&fixedDiceSource{val: 10}, zaptest.NewLogger(t))
+	svc, _, sessMgr, npcMgr, combatHandler := newFleeSvcWithCombat(t, roller)
+	inst, err := npcMgr.Spawn(&npc.Template{
+		ID: "ganger-forced", Name: "Ganger", Level: 1, MaxHP: 20, AC: 13, Awareness: 5,
+		Abilities: npc.Abilities{Brutality: 10, Quickness: 10, Savvy: 10},
+	}, "room_a")
+	require.NoError(t, err)
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_forced", Username: "Fighter", CharName: "Fighter",
+		RoomID: "room_a", CurrentHP: 10, MaxHP: 20, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.Status = statusInCombat
+	_, err = combatHandler.Attack("u_forced", "Ganger")
+	require.NoError(t, err)
+	combatHandler.cancelTimer("room_a")
+	return svc, sessMgr, npcMgr, combatHandler, inst
+}
+
+func TestHandleShove_ThroughOpenExitEjectsNPC(t *testing.T) {
+	svc, _, npcMgr, combatHandler, inst := newForcedMoveFight(t)
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	player, ganger := cbt.GetCombatant("u_forced"), cbt.GetCombatant(inst.ID)
+	player.GridX, player.GridY = 5, 1
+	ganger.GridX, ganger.GridY = 5, 0
+
+	event, err := svc.handleShove("u_forced", &gamev1.ShoveRequest{Target: "Ganger"})
+	require.NoError(t, err)
+	msg := event.GetMessage().GetContent()
+	assert.Contains(t, msg, "Ganger is shoved back against the wall.")
+	assert.Contains(t, msg, "Ganger is thrown out through the north exit!")
+	assert.Nil(t, cbt.GetCombatant(inst.ID), "the ganger is out of the fight")
+	moved, ok := npcMgr.Get(inst.ID)
+	require.True(t, ok)
+	assert.Equal(t, "room_b", moved.RoomID)
+	assert.False(t, moved.IsDead(), "an ejected NPC is not killed")
+}
+
+func TestHandleShove_IntoWallWithoutExitStays(t *testing.T) {
+	svc, _, npcMgr, combatHandler, inst := newForcedMoveFight(t)
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	player, ganger := cbt.GetCombatant("u_forced"), cbt.GetCombatant(inst.ID)
+	player.GridX, player.GridY = 1, 5
+	ganger.GridX, ganger.GridY = 0, 5
+
+	event, err := svc.handleShove("u_forced", &gamev1.ShoveRequest{Target: "Ganger"})
+	require.NoError(t, err)
+	assert.Contains(t, event.GetMessage().GetContent(), "Ganger is shoved back against the wall.")
+	assert.NotNil(t, cbt.GetCombatant(inst.ID), "there is no exit to the west")
+	stayed, _ := npcMgr.Get(inst.ID)
+	assert.Equal(t, "room_a", stayed.RoomID)
+}
+
+func TestResolveForcedMovesLocked_BlastEjectsPlayerAndNotifies(t *testing.T) {
+	_, sessMgr, _, combatHandler, _ := newForcedMoveFight(t)
+	type forced struct{ roomID, movedID, toRoomID string }
+	got := make(chan forced, 1)
+	combatHandler.SetOnForcedMove(func(roomID, movedID, toRoomID string) {
+		got <- forced{roomID, movedID, toRoomID}
+	})
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+
+	combatHandler.combatMu.Lock()
+	events := combatHandler.resolveForcedMovesLocked(cbt, []combat.RoundEvent{
+		{ActionType: combat.ActionThrow, TargetID: "u_forced", Pushed: true, PushedOut: "n"},
+	})
+	combatHandler.combatMu.Unlock()
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "Fighter is thrown out through the north exit!", events[0].Narrative)
+	assert.Nil(t, cbt.GetCombatant("u_forced"))
+	sess, _ := sessMgr.GetPlayer("u_forced")
+	assert.Equal(t, "room_b", sess.RoomID)
+	assert.NotEqual(t, int32(statusInCombat), sess.Status)
+	select {
+	case f := <-got:
+		assert.Equal(t, forced{"room_a", "u_forced", "room_b"}, f)
+	case <-time.After(time.Second):
+		t.Fatal("forced-move callback was not invoked")
+	}
+}
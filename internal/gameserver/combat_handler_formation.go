@@ -0,0 +1,18 @@
+package gameserver
+
+// SetCombatantBackRow moves combatant id in roomID's active combat to the
+// back row or the front row, so a formation change made mid-fight applies
+// from the next attack on.
+//
+// Postcondition: No-op when roomID has no combat or id is not a combatant.
+func (h *CombatHandler) SetCombatantBackRow(roomID, id string, back bool) {
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	cbt, ok := h.engine.GetCombat(roomID)
+	if !ok {
+		return
+	}
+	if c := cbt.GetCombatant(id); c != nil {
+		c.BackRow = back
+	}
+}
@@ -0,0 +1,66 @@
+package gameserver
+
+// FreezeRoom stops the combat round clock in roomID until UnfreezeRoom is
+// called. Rounds still resolve early once every combatant has acted; only the
+// timer that forces the round to end is held.
+//
+// Precondition: roomID must be non-empty.
+// Postcondition: Returns false when roomID was already frozen.
+func (h *CombatHandler) FreezeRoom(roomID string) bool {
+	h.timersMu.Lock()
+	defer h.timersMu.Unlock()
+	if h.frozen[roomID] {
+		return false
+	}
+	h.frozen[roomID] = true
+	if t, ok := h.timers[roomID]; ok {
+		t.Stop()
+	}
+	return true
+}
+
+// UnfreezeRoom restarts the combat round clock in roomID with a full round.
+//
+// Precondition: roomID must be non-empty.
+// Postcondition: Returns false when roomID was not frozen.
+func (h *CombatHandler) UnfreezeRoom(roomID string) bool {
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	h.timersMu.Lock()
+	wasFrozen := h.frozen[roomID]
+	delete(h.frozen, roomID)
+	h.timersMu.Unlock()
+	if !wasFrozen {
+		return false
+	}
+	if _, ok := h.engine.GetCombat(roomID); ok {
+		h.startTimerLocked(roomID)
+	}
+	return true
+}
+
+// IsRoomFrozen reports whether a GM has frozen the combat clock in roomID.
+func (h *CombatHandler) IsRoomFrozen(roomID string) bool {
+	h.timersMu.Lock()
+	defer h.timersMu.Unlock()
+	return h.frozen[roomID]
+}
+
+// SetCombatantHP sets the current HP of combatant id in roomID's active
+// combat, so changes made outside a round are seen by the next one.
+//
+// Postcondition: No-op when roomID has no combat or id is not a combatant.
+func (h *CombatHandler) SetCombatantHP(roomID, id string, hp int) {
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	cbt, ok := h.engine.GetCombat(roomID)
+	if !ok {
+		return
+	}
+	for _, c := range cbt.Combatants {
+		if c.ID == id {
+			c.CurrentHP = hp
+			return
+		}
+	}
+}
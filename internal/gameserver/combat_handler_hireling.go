@@ -0,0 +1,154 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// SetHiredHirelingOf registers a callback that returns the hireling a player
+// has hired, or nil. Hirelings in their employer's room join the employer's
+// fights on the player side.
+//
+// Precondition: fn may be nil (hirelings never join combat).
+// Postcondition: h.hiredHirelingOf == fn.
+func (h *CombatHandler) SetHiredHirelingOf(fn func(uid string) *npc.Instance) {
+	h.hiredHirelingOf = fn
+}
+
+// joinHirelingsLocked adds the hireling of each player combatant in cbt that
+// stands in the combat room, fighting on the player side.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: Each eligible hireling is a combatant with AllyOf set to its employer.
+func (h *CombatHandler) joinHirelingsLocked(cbt *combat.Combat) {
+	if h.hiredHirelingOf == nil {
+		return
+	}
+	var owners []string
+	for _, c := range cbt.Combatants {
+		if c.Kind == combat.KindPlayer {
+			owners = append(owners, c.ID)
+		}
+	}
+	for _, uid := range owners {
+		inst := h.hiredHirelingOf(uid)
+		if inst == nil || inst.RoomID != cbt.RoomID || inst.IsDead() || cbt.GetCombatant(inst.ID) != nil {
+			continue
+		}
+		hc := h.buildHirelingCombatant(inst, uid)
+		combat.RollInitiative([]*combat.Combatant{hc}, h.dice.Src())
+		for _, c := range cbt.Combatants {
+			if c.OnPlayerSide() {
+				hc.GridX++
+			}
+		}
+		hc.GridY = 10
+		if err := h.engine.AddCombatant(cbt.RoomID, hc); err != nil {
+			if h.logger != nil {
+				h.logger.Warn("hireling failed to join combat",
+					zap.String("npc_id", inst.ID),
+					zap.String("room_id", cbt.RoomID),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+		h.pushMessageToUID(uid, fmt.Sprintf("%s joins the fight at your side.", inst.Name()))
+	}
+}
+
+// buildHirelingCombatant returns the combatant for hireling inst fighting for ownerUID.
+//
+// Precondition: inst must not be nil; ownerUID must be non-empty.
+func (h *CombatHandler) buildHirelingCombatant(inst *npc.Instance, ownerUID string) *combat.Combatant {
+	weaponName, weaponDefID := "", ""
+	if inst.WeaponID != "" && h.invRegistry != nil {
+		if wDef := h.invRegistry.Weapon(inst.WeaponID); wDef != nil {
+			weaponName = wDef.Name
+			weaponDefID = wDef.ID
+		}
+	}
+	return &combat.Combatant{
+		ID:          inst.ID,
+		Kind:        combat.KindNPC,
+		Name:        inst.Name(),
+		MaxHP:       inst.MaxHP,
+		CurrentHP:   inst.CurrentHP,
+		AC:          inst.AC,
+		Level:       inst.Level,
+		StrMod:      combat.AbilityMod(inst.Awareness),
+		DexMod:      1,
+		NPCType:     inst.Type,
+		Resistances: inst.Resistances,
+		Weaknesses:  inst.Weaknesses,
+		Immunities:  inst.Immunities,
+		WeaponName:  weaponName,
+		WeaponDefID: weaponDefID,
+		AttackVerb:  inst.AttackVerb,
+		SpeedFt:     inst.SpeedFt,
+		FactionID:   inst.FactionID,
+		AllyOf:      ownerUID,
+	}
+}
+
+// takeHirelingCutsLocked withholds each living hireling's wage cut from total
+// credits looted in cbt. The cut goes into the hireling's pocket.
+//
+// Precondition: h.combatMu is held; total >= 0.
+// Postcondition: Returns the credits left for the players; the employer of
+// each hireling that took a cut is told how much.
+func (h *CombatHandler) takeHirelingCutsLocked(cbt *combat.Combat, total int) int {
+	remaining := total
+	for _, c := range cbt.Combatants {
+		if c.AllyOf == "" || c.IsDead() {
+			continue
+		}
+		inst, ok := h.npcMgr.Get(c.ID)
+		if !ok {
+			continue
+		}
+		tmpl := h.npcMgr.TemplateByID(inst.TemplateID)
+		if tmpl == nil || tmpl.Hireling == nil {
+			continue
+		}
+		cut := total * tmpl.Hireling.LootCut / 100
+		if cut <= 0 || cut > remaining {
+			continue
+		}
+		remaining -= cut
+		inst.Currency += cut
+		h.pushMessageToUID(c.AllyOf, fmt.Sprintf("%s takes a %d-credit cut of the loot.", c.Name, cut))
+	}
+	return remaining
+}
+
+// removeDeadHirelingLocked removes a hireling that died fighting for a player.
+// Its employer earns nothing for the death, and it respawns at its post.
+//
+// Precondition: h.combatMu is held; c.AllyOf must be non-empty; inst is c's instance.
+// Postcondition: inst is removed from npcMgr and its respawn is scheduled.
+func (h *CombatHandler) removeDeadHirelingLocked(c *combat.Combatant, inst *npc.Instance) {
+	h.broadcastFn(inst.RoomID, []*gamev1.CombatEvent{{
+		Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_DEATH,
+		Attacker:  c.Name,
+		Narrative: fmt.Sprintf("%s falls in battle.", c.Name),
+	}})
+	templateID := inst.TemplateID
+	homeRoomID := inst.HomeRoomID
+	if homeRoomID == "" {
+		homeRoomID = inst.RoomID
+	}
+	_ = h.npcMgr.Remove(c.ID)
+	if h.onNPCDeath != nil {
+		h.onNPCDeath(c.ID)
+	}
+	if h.respawnMgr != nil {
+		h.respawnMgr.Schedule(templateID, homeRoomID, time.Now(), h.respawnMgr.ResolvedDelay(templateID, homeRoomID))
+	}
+}
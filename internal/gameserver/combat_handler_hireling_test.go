@@ -0,0 +1,98 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+// spawnTestMercenary spawns a hireling that keeps lootCut percent of looted credits.
+func spawnTestMercenary(t *testing.T, npcMgr *npc.Manager, roomID string, lootCut int) *npc.Instance {
+	t.Helper()
+	inst, err := npcMgr.Spawn(&npc.Template{
+		ID:        "test_merc",
+		Name:      "Merc",
+		NPCType:   "hireling",
+		Level:     2,
+		MaxHP:     30,
+		AC:        14,
+		Awareness: 3,
+		Hireling:  &npc.HirelingConfig{DailyCost: 10, LootCut: lootCut},
+	}, roomID)
+	require.NoError(t, err)
+	return inst
+}
+
+func TestHirelingJoinsEmployerCombat(t *testing.T) {
+	const roomID = "hire-room-1"
+	h, npcMgr, sessMgr := makeAutoQueueHandler(t)
+	spawnAutoQueueNPC(t, npcMgr, roomID, "Goblin")
+	merc := spawnTestMercenary(t, npcMgr, roomID, 0)
+	addAutoQueuePlayer(t, sessMgr, "hire-p1", "Hero", roomID, "attack", "")
+	h.SetHiredHirelingOf(func(uid string) *npc.Instance {
+		if uid == "hire-p1" {
+			return merc
+		}
+		return nil
+	})
+
+	cbt := startTestCombat(t, h, "hire-p1", roomID, "Goblin")
+	mc := cbt.GetCombatant(merc.ID)
+	require.NotNil(t, mc, "the hireling joins its employer's fight")
+	assert.Equal(t, "hire-p1", mc.AllyOf)
+	assert.True(t, mc.OnPlayerSide())
+
+	// The hireling attacks the hostile NPC, not its employer.
+	_ = cbt.StartRound(3)
+	h.combatMu.Lock()
+	h.legacyAutoQueueLocked(cbt, mc)
+	h.combatMu.Unlock()
+	actions := cbt.ActionQueues[merc.ID].QueuedActions()
+	require.NotEmpty(t, actions)
+	assert.Equal(t, "Goblin", actions[len(actions)-1].Target)
+
+	// A living hireling does not keep the fight going once the hostiles are dead.
+	for _, c := range cbt.Combatants {
+		if c.IsHostileNPC() {
+			c.CurrentHP = 0
+		}
+	}
+	assert.False(t, cbt.HasLivingNPCs())
+}
+
+func TestHirelingStaysOutOfOthersCombat(t *testing.T) {
+	const roomID = "hire-room-2"
+	h, npcMgr, sessMgr := makeAutoQueueHandler(t)
+	spawnAutoQueueNPC(t, npcMgr, roomID, "Goblin")
+	merc := spawnTestMercenary(t, npcMgr, "elsewhere", 0)
+	addAutoQueuePlayer(t, sessMgr, "hire-p2", "Hero", roomID, "attack", "")
+	h.SetHiredHirelingOf(func(string) *npc.Instance { return merc })
+
+	cbt := startTestCombat(t, h, "hire-p2", roomID, "Goblin")
+	assert.Nil(t, cbt.GetCombatant(merc.ID), "a hireling in another room does not join")
+}
+
+func TestTakeHirelingCutsLocked(t *testing.T) {
+	const roomID = "hire-room-3"
+	h, npcMgr, sessMgr := makeAutoQueueHandler(t)
+	spawnAutoQueueNPC(t, npcMgr, roomID, "Goblin")
+	merc := spawnTestMercenary(t, npcMgr, roomID, 20)
+	addAutoQueuePlayer(t, sessMgr, "hire-p3", "Hero", roomID, "attack", "")
+	h.SetHiredHirelingOf(func(string) *npc.Instance { return merc })
+	cbt := startTestCombat(t, h, "hire-p3", roomID, "Goblin")
+
+	h.combatMu.Lock()
+	left := h.takeHirelingCutsLocked(cbt, 100)
+	h.combatMu.Unlock()
+	assert.Equal(t, 80, left)
+	assert.Equal(t, 20, merc.Currency)
+
+	cbt.GetCombatant(merc.ID).CurrentHP = 0
+	h.combatMu.Lock()
+	left = h.takeHirelingCutsLocked(cbt, 100)
+	h.combatMu.Unlock()
+	assert.Equal(t, 100, left, "a dead hireling takes no cut")
+}
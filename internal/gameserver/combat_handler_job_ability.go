@@ -0,0 +1,109 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+// SetAbilityUseResolverFn registers the callback that applies job ability
+// effects during post-round processing. Called once for each ActionUseAbility
+// event after ResolveRound, with combatMu held.
+//
+// Precondition: fn may be nil (ability effects are skipped when nil).
+// Postcondition: h.abilityUseResolverFn is set to fn.
+func (h *CombatHandler) SetAbilityUseResolverFn(fn func(uid, abilityID, target string, cbt *combat.Combat)) {
+	h.abilityUseResolverFn = fn
+}
+
+// QueueJobAbility queues ab for the player's next round resolution, spending
+// its AP cost and starting its cooldown. Abilities that land on an enemy aim
+// at target, or at the player's last combat target (else the first enemy)
+// when target is empty.
+//
+// Precondition: uid must be a valid connected player; ab must be valid.
+// Postcondition: On success the ability is queued and on cooldown; returns an
+// error when the player is not in combat, the ability is recharging, no enemy
+// matches, or the player has too few AP.
+func (h *CombatHandler) QueueJobAbility(uid string, ab *ruleset.JobAbility, target string) error {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return fmt.Errorf("player %q not found", uid)
+	}
+
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	cbt, ok := h.engine.GetCombat(sess.RoomID)
+	if !ok {
+		return fmt.Errorf("you are not in combat")
+	}
+	c := cbt.GetCombatant(uid)
+	if c == nil || c.IsDead() {
+		return fmt.Errorf("you are not in combat")
+	}
+	if left := c.Cooldown(ab.Name); left > 0 {
+		return fmt.Errorf("%s is recharging: ready in %d round(s)", ab.Name, left)
+	}
+	if ab.NeedsTarget() {
+		enemy := abilityTarget(cbt, sess, target)
+		if enemy == nil {
+			if target == "" {
+				return fmt.Errorf("there is no enemy to use %s on", ab.Name)
+			}
+			return fmt.Errorf("you can't see %q in this fight", target)
+		}
+		target = enemy.Name
+	} else {
+		target = ""
+	}
+
+	qa := combat.QueuedAction{
+		Type:        combat.ActionUseAbility,
+		AbilityID:   ab.ID,
+		Target:      target,
+		AbilityCost: ab.APCost,
+	}
+	if err := cbt.QueueAction(uid, qa); err != nil {
+		return err
+	}
+	c.StartCooldown(ab.Name, ab.Cooldown)
+	h.broadcastCombatState(sess.RoomID, cbt)
+	h.pushMessageToUID(uid, fmt.Sprintf("%s queued for round resolution.%s", ab.Name, h.formatAPRemaining(uid, cbt)))
+
+	if cbt.AllActionsSubmitted() {
+		h.stopTimerLocked(sess.RoomID)
+		h.resolveAndAdvanceLocked(sess.RoomID, cbt)
+	}
+	return nil
+}
+
+// abilityTarget returns the living hostile NPC named name. When name is
+// empty it prefers the player's last combat target, then the first living
+// hostile NPC.
+//
+// Precondition: cbt and sess are non-nil.
+func abilityTarget(cbt *combat.Combat, sess *session.PlayerSession, name string) *combat.Combatant {
+	var fallback *combat.Combatant
+	for _, c := range cbt.Combatants {
+		if !c.IsHostileNPC() || c.IsDead() {
+			continue
+		}
+		if name == "" {
+			if c.Name == sess.LastCombatTarget {
+				return c
+			}
+			if fallback == nil {
+				fallback = c
+			}
+			continue
+		}
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return fallback
+}
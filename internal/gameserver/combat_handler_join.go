@@ -0,0 +1,306 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// AddCombatant inserts c into the active combat in roomID mid-round. This is
+// the sanctioned way to bring boss adds, reinforcements, and late pets into a
+// fight: c rolls initiative and slots into the turn order, is placed on its
+// side's edge of the grid, receives this round's AP, and — when it is an NPC —
+// queues its actions for the round immediately.
+//
+// Precondition: roomID non-empty; c must not be nil and must carry a unique ID.
+// Postcondition: Returns an error when roomID has no active combat or c.ID is
+// already a combatant there; otherwise c is in the combat and the join is
+// broadcast to the room.
+func (h *CombatHandler) AddCombatant(roomID string, c *combat.Combatant) error {
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	cbt, ok := h.engine.GetCombat(roomID)
+	if !ok {
+		return fmt.Errorf("no active combat in room %q", roomID)
+	}
+	return h.addCombatantLocked(cbt, c)
+}
+
+// AddNPCCombatant brings NPC instance inst into the active combat in roomID,
+// moving it there first when it stands elsewhere (reinforcements arriving
+// from an adjacent room). A non-empty allyOf makes inst fight on that
+// player's side, as a pet or hireling does.
+//
+// Precondition: inst must not be nil; roomID non-empty.
+// Postcondition: Returns an error when inst is dead, cannot be moved, or
+// cannot join; otherwise inst is a combatant in roomID's combat.
+func (h *CombatHandler) AddNPCCombatant(roomID string, inst *npc.Instance, allyOf string) error {
+	if inst.IsDead() {
+		return fmt.Errorf("npc %q is dead", inst.ID)
+	}
+	if inst.RoomID != roomID {
+		if err := h.npcMgr.Move(inst.ID, roomID); err != nil {
+			return fmt.Errorf("moving npc %q to %q: %w", inst.ID, roomID, err)
+		}
+	}
+	c := h.buildNPCCombatant(inst, roomID)
+	if allyOf != "" {
+		c = h.buildHirelingCombatant(inst, allyOf)
+	}
+	return h.AddCombatant(roomID, c)
+}
+
+// SpawnCombatant spawns a new NPC from templateID in roomID and adds it to the
+// room's active combat, returning the new instance's ID.
+//
+// Precondition: roomID and templateID non-empty.
+// Postcondition: Returns an error when the template is unknown, roomID has no
+// active combat, or the spawn fails; a spawned NPC that cannot join is removed.
+func (h *CombatHandler) SpawnCombatant(roomID, templateID string) (string, error) {
+	tmpl := h.npcMgr.TemplateByID(templateID)
+	if tmpl == nil {
+		return "", fmt.Errorf("unknown npc template %q", templateID)
+	}
+	if _, ok := h.GetCombatForRoom(roomID); !ok {
+		return "", fmt.Errorf("no active combat in room %q", roomID)
+	}
+	inst, err := h.npcMgr.Spawn(tmpl, roomID)
+	if err != nil {
+		return "", fmt.Errorf("spawning %q: %w", templateID, err)
+	}
+	if err := h.AddNPCCombatant(roomID, inst, ""); err != nil {
+		_ = h.npcMgr.Remove(inst.ID)
+		return "", err
+	}
+	return inst.ID, nil
+}
+
+// JoinCombat inserts player uid into the combat under way in their current
+// room, so a newcomer can fight instead of watching. The player rolls
+// initiative into the turn order and gets this round's AP.
+//
+// Precondition: uid must identify a connected player.
+// Postcondition: Returns an error when the room has no active combat, the
+// player is already in it, or the player is down; otherwise the player is a
+// combatant, their status is in-combat, any pending join invitation is
+// cleared, and their initiative and AP for the round are returned.
+func (h *CombatHandler) JoinCombat(uid string) (initiative, ap int, err error) {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return 0, 0, fmt.Errorf("player %q not found", uid)
+	}
+	if sess.CurrentHP <= 0 {
+		return 0, 0, fmt.Errorf("you are in no shape to fight")
+	}
+
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	cbt, ok := h.engine.GetCombat(sess.RoomID)
+	if !ok {
+		return 0, 0, fmt.Errorf("there is no fight here to join")
+	}
+	if cbt.GetCombatant(uid) != nil {
+		return 0, 0, fmt.Errorf("you are already in the fight")
+	}
+	c, err := h.joinPlayerLocked(cbt, sess)
+	if err != nil {
+		return 0, 0, err
+	}
+	if q, ok := cbt.ActionQueues[uid]; ok {
+		ap = q.RemainingPoints()
+	}
+	return c.Initiative, ap, nil
+}
+
+// CombatSummary reports the combat under way in roomID for the room view:
+// its round and its living combatants in turn order.
+//
+// Precondition: roomID non-empty.
+// Postcondition: Returns (0, nil) when roomID has no active combat.
+func (h *CombatHandler) CombatSummary(roomID string) (round int, combatants []string) {
+	h.combatMu.RLock()
+	defer h.combatMu.RUnlock()
+
+	cbt, ok := h.engine.GetCombat(roomID)
+	if !ok {
+		return 0, nil
+	}
+	for _, c := range cbt.Combatants {
+		if !c.IsDead() {
+			combatants = append(combatants, c.Name)
+		}
+	}
+	return max(cbt.Round, 1), combatants
+}
+
+// RoomEffects returns a snapshot of the lingering area effects on the floor
+// of the combat in roomID.
+//
+// Precondition: roomID non-empty.
+// Postcondition: Returns nil when roomID has no active combat or no effects.
+func (h *CombatHandler) RoomEffects(roomID string) []combat.RoomEffect {
+	h.combatMu.RLock()
+	defer h.combatMu.RUnlock()
+
+	cbt, ok := h.engine.GetCombat(roomID)
+	if !ok {
+		return nil
+	}
+	var out []combat.RoomEffect
+	for _, e := range cbt.RoomEffects {
+		out = append(out, *e)
+	}
+	return out
+}
+
+// joinPlayerLocked builds sess's combatant and adds it to cbt mid-round.
+//
+// Precondition: h.combatMu is held; cbt and sess must not be nil.
+// Postcondition: On success sess.Status is in-combat and sess.PendingCombatJoin is empty.
+func (h *CombatHandler) joinPlayerLocked(cbt *combat.Combat, sess *session.PlayerSession) (*combat.Combatant, error) {
+	c := buildPlayerCombatant(sess, h)
+	if err := h.addCombatantLocked(cbt, c); err != nil {
+		return nil, err
+	}
+	sess.Status = statusInCombat
+	sess.PendingCombatJoin = ""
+	return c, nil
+}
+
+// addCombatantLocked rolls c's initiative, places it on its side's grid edge,
+// inserts it into cbt in initiative order, builds its typed-bonus effects,
+// grants it this round's AP, and queues an NPC's actions.
+//
+// Precondition: h.combatMu is held; cbt and c must not be nil.
+// Postcondition: Returns an error when c.ID is already in cbt or the engine
+// rejects the insert; otherwise c is a combatant of cbt.
+func (h *CombatHandler) addCombatantLocked(cbt *combat.Combat, c *combat.Combatant) error {
+	if cbt.GetCombatant(c.ID) != nil {
+		return fmt.Errorf("%q is already in combat", c.ID)
+	}
+	if h.dice != nil {
+		c.Initiative = h.dice.Src().Intn(20) + 1 + c.DexMod
+	}
+	if c.OnPlayerSide() {
+		c.GridX = 0
+		for _, other := range cbt.Combatants {
+			if other.OnPlayerSide() {
+				c.GridX++
+			}
+		}
+		c.GridY = 10
+	} else {
+		c.GridX = 19
+		c.GridY = 10
+		for _, other := range cbt.Combatants {
+			if !other.OnPlayerSide() {
+				c.GridY++
+			}
+		}
+	}
+	if err := h.engine.AddCombatant(cbt.RoomID, c); err != nil {
+		return err
+	}
+	// A spawn renames same-template NPCs ("Goblin" becomes "Goblin A");
+	// keep the turn order in step so targeting by name still works.
+	for _, other := range cbt.Combatants {
+		if other.Kind != combat.KindNPC {
+			continue
+		}
+		if inst, ok := h.npcMgr.Get(other.ID); ok {
+			other.Name = inst.Name()
+		}
+	}
+	// A player carries session conditions (weather, lingering effects) in.
+	if c.Kind == combat.KindPlayer {
+		if sess, ok := h.sessions.GetPlayer(c.ID); ok && sess.Conditions != nil && cbt.Conditions[c.ID] != nil {
+			sess.Conditions.CopyTo(cbt.Conditions[c.ID], c.ID)
+		}
+	}
+	// DEDUP-5: join the typed-bonus pipeline under the same weapon source key
+	// populateCombatantEffects uses at combat start.
+	c.Effects = combat.BuildCombatantEffects(combat.BuildEffectsOpts{
+		BearerUID:        c.ID,
+		Conditions:       cbt.Conditions[c.ID],
+		Feats:            h.playerFeats(c),
+		WeaponSourceID:   c.WeaponDefID,
+		WeaponBonusValue: c.WeaponBonus,
+	})
+
+	events := []*gamev1.CombatEvent{{
+		Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_INITIATIVE,
+		Attacker:  c.Name,
+		Narrative: fmt.Sprintf("%s joins the fight (initiative %d).", c.Name, c.Initiative),
+	}}
+	events = append(events, conditionEventsToProto(cbt.JoinRound(c.ID), h.condRegistry)...)
+	// Players meet the room's lingering effects as they walk in
+	// (exposeToRoomEffects); adds and reinforcements meet them as they join.
+	if c.Kind != combat.KindPlayer && h.dice != nil {
+		for _, re := range cbt.EnterRoomEffects(c.ID, h.dice.Src()) {
+			events = append(events, h.roundEventToProto(re))
+		}
+		if inst, ok := h.npcMgr.Get(c.ID); ok {
+			inst.CurrentHP = c.CurrentHP
+		}
+	}
+	if c.Kind == combat.KindNPC && cbt.ActionQueues[c.ID] != nil {
+		var room *world.Room
+		if h.worldMgr != nil {
+			if r, ok := h.worldMgr.GetRoom(cbt.RoomID); ok {
+				room = r
+			}
+		}
+		h.autoQueueNPCLocked(cbt, c, room)
+	}
+	if h.broadcastFn != nil {
+		h.broadcastFn(cbt.RoomID, events)
+	}
+	h.broadcastCombatState(cbt.RoomID, cbt)
+	return nil
+}
+
+// buildNPCCombatant returns the hostile combatant for inst fighting in roomID,
+// folding in its passive feat bonuses and equipped weapon.
+//
+// Precondition: inst must not be nil; roomID non-empty.
+func (h *CombatHandler) buildNPCCombatant(inst *npc.Instance, roomID string) *combat.Combatant {
+	var featStats NPCEffectiveStats
+	if h.featRegistry != nil {
+		featStats = ComputeNPCAttackStats(inst, nil, h.featRegistry, h.npcMgr.InstancesInRoom(roomID))
+	}
+	weaponName, weaponDefID, weaponBonus := "", "", 0
+	if inst.WeaponID != "" && h.invRegistry != nil {
+		if wDef := h.invRegistry.Weapon(inst.WeaponID); wDef != nil {
+			weaponName = wDef.Name
+			weaponDefID = wDef.ID
+			weaponBonus = wDef.Bonus
+		}
+	}
+	return &combat.Combatant{
+		ID:          inst.ID,
+		Kind:        combat.KindNPC,
+		Name:        inst.Name(),
+		MaxHP:       inst.MaxHP,
+		CurrentHP:   inst.CurrentHP,
+		AC:          inst.AC + featStats.ACBonus,
+		Level:       inst.Level,
+		StrMod:      combat.AbilityMod(inst.Awareness) + featStats.DamageBonus + featStats.AttackBonus,
+		DexMod:      1,
+		NPCType:     inst.Type,
+		Resistances: inst.Resistances,
+		Weaknesses:  inst.Weaknesses,
+		Immunities:  inst.Immunities,
+		WeaponName:  weaponName,
+		WeaponDefID: weaponDefID,
+		WeaponBonus: weaponBonus,
+		AttackVerb:  inst.AttackVerb,
+		SpeedFt:     inst.SpeedFt,
+		FactionID:   inst.FactionID,
+	}
+}
@@ -0,0 +1,118 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+func TestSpawnCombatant_JoinsMidRoundWithAPAndQueuedActions(t *testing.T) {
+	_, _, combatH, first := newTempHPSvc(t)
+
+	uid, err := combatH.SpawnCombatant("room_a", "goblin")
+	require.NoError(t, err)
+
+	combatH.combatMu.Lock()
+	defer combatH.combatMu.Unlock()
+	cbt, ok := combatH.engine.GetCombat("room_a")
+	require.True(t, ok)
+	joined := cbt.GetCombatant(uid)
+	require.NotNil(t, joined)
+	assert.Equal(t, combat.KindNPC, joined.Kind)
+	assert.Equal(t, "Goblin B", joined.Name)
+	assert.Equal(t, "Goblin A", cbt.GetCombatant(first.ID).Name, "existing combatant renamed in step")
+	q, ok := cbt.ActionQueues[uid]
+	require.True(t, ok, "joiner must get an action queue this round")
+	assert.NotEmpty(t, q.QueuedActions(), "an NPC joiner queues its actions immediately")
+	for i := 1; i < len(cbt.Combatants); i++ {
+		assert.GreaterOrEqual(t, cbt.Combatants[i-1].Initiative, cbt.Combatants[i].Initiative)
+	}
+}
+
+func TestSpawnCombatant_NoCombat_ReturnsError(t *testing.T) {
+	_, _, combatH, _ := newTempHPSvc(t)
+	_, err := combatH.SpawnCombatant("room_b", "goblin")
+	assert.Error(t, err)
+	_, err = combatH.SpawnCombatant("room_a", "no_such_template")
+	assert.Error(t, err)
+}
+
+func TestAddNPCCombatant_ReinforcementMovesInAndAlliesWithOwner(t *testing.T) {
+	_, _, combatH, _ := newTempHPSvc(t)
+	pet := spawnTestNPC(t, combatH.npcMgr, "room_b")
+
+	require.NoError(t, combatH.AddNPCCombatant("room_a", pet, "hero"))
+	assert.Equal(t, "room_a", pet.RoomID)
+
+	combatH.combatMu.Lock()
+	defer combatH.combatMu.Unlock()
+	cbt, _ := combatH.engine.GetCombat("room_a")
+	c := cbt.GetCombatant(pet.ID)
+	require.NotNil(t, c)
+	assert.Equal(t, "hero", c.AllyOf)
+	assert.True(t, c.OnPlayerSide())
+	assert.Error(t, combatH.addCombatantLocked(cbt, c), "a combatant cannot join twice")
+}
+
+func TestJoinCombat_NewcomerGetsInitiativeAndAP(t *testing.T) {
+	_, _, combatH, _ := newTempHPSvc(t)
+	addTestPlayerNamed(t, combatH.sessions, "late", "room_a", "Latecomer")
+
+	initiative, ap, err := combatH.JoinCombat("late")
+	require.NoError(t, err)
+	assert.Positive(t, ap, "a joiner acts this round")
+
+	sess, _ := combatH.sessions.GetPlayer("late")
+	assert.Equal(t, statusInCombat, sess.Status)
+	cbt, _ := combatH.GetCombatForRoom("room_a")
+	c := cbt.GetCombatant("late")
+	require.NotNil(t, c)
+	assert.Equal(t, initiative, c.Initiative)
+
+	_, _, err = combatH.JoinCombat("late")
+	assert.ErrorContains(t, err, "already in the fight")
+}
+
+func TestJoinCombat_NoFightOrDown_ReturnsError(t *testing.T) {
+	_, _, combatH, _ := newTempHPSvc(t)
+	addTestPlayerNamed(t, combatH.sessions, "idle", "room_b", "Idler")
+	_, _, err := combatH.JoinCombat("idle")
+	assert.ErrorContains(t, err, "no fight here")
+
+	down := addTestPlayerNamed(t, combatH.sessions, "down", "room_a", "Downed")
+	down.CurrentHP = 0
+	_, _, err = combatH.JoinCombat("down")
+	assert.ErrorContains(t, err, "no shape to fight")
+}
+
+func TestAttack_BystanderJoinsCombatInProgress(t *testing.T) {
+	_, _, combatH, goblin := newTempHPSvc(t)
+	addTestPlayerNamed(t, combatH.sessions, "late", "room_a", "Latecomer")
+
+	_, err := combatH.Attack("late", goblin.Name())
+	require.NoError(t, err)
+
+	cbt, _ := combatH.GetCombatForRoom("room_a")
+	require.NotNil(t, cbt.GetCombatant("late"), "attacking engages the bystander")
+	sess, _ := combatH.sessions.GetPlayer("late")
+	assert.Equal(t, statusInCombat, sess.Status)
+}
+
+func TestRoomView_ShowsCombatInProgress(t *testing.T) {
+	svc, _, combatH, _ := newTempHPSvc(t)
+	addTestPlayerNamed(t, combatH.sessions, "late", "room_a", "Latecomer")
+
+	rv, err := svc.worldH.Look("late")
+	require.NoError(t, err)
+	assert.Positive(t, rv.CombatRound)
+	assert.Contains(t, rv.Combatants, "Hero")
+
+	addTestPlayerNamed(t, combatH.sessions, "idle", "room_b", "Idler")
+	rv, err = svc.worldH.Look("idle")
+	require.NoError(t, err)
+	assert.Zero(t, rv.CombatRound)
+	assert.Empty(t, rv.Combatants)
+}
@@ -114,7 +114,7 @@ func TestMartryrsResolve_StabilizesPlayerAt1HP(t *testing.T) {
 // does not trigger when UsesRemaining == 0.
 //
 // Precondition: player (HP=1) has martyrs_resolve with MaxUses=1, UsesRemaining=0.
-// Postcondition: Player HP drops to 0 or below (feat does nothing).
+// Postcondition: Player goes down and later recovers wounded (feat does nothing).
 func TestMartryrsResolve_ExpiredSlot_DoesNotActivate(t *testing.T) {
 	t.Parallel()
 
@@ -153,11 +153,13 @@ func TestMartryrsResolve_ExpiredSlot_DoesNotActivate(t *testing.T) {
 	h.combatMu.Unlock()
 	h.cancelTimer(roomID)
 
-	// With expired slot, player should be at 0 or below.
-	sess, ok = h.sessions.GetPlayer(uid)
-	require.True(t, ok)
-	assert.LessOrEqual(t, sess.CurrentHP, 0,
-		"expired Martyr's Resolve must not stabilize player; HP must be <= 0")
+	// With an expired slot the player drops to 0 HP and starts dying; the next
+	// round's recovery check (a natural 20 with this dice source) brings them
+	// back with a wounded stack, which Martyr's Resolve never adds.
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	assert.True(t, cbt.HasCondition(uid, "wounded"),
+		"expired Martyr's Resolve must not stabilize player; they must go down")
 }
 
 // TestMartryrsResolve_UnlimitedSlot_ActivatesWithoutDecrement verifies that
@@ -255,8 +257,11 @@ func TestMartryrsResolve_NoSlot_PlayerDies(t *testing.T) {
 	h.combatMu.Unlock()
 	h.cancelTimer(roomID)
 
-	sess, ok = h.sessions.GetPlayer(uid)
-	require.True(t, ok)
-	assert.LessOrEqual(t, sess.CurrentHP, 0,
+	// The player drops to 0 HP and starts dying; the next round's recovery
+	// check (a natural 20 with this dice source) brings them back at 1 HP with
+	// a wounded stack, which only a player who went down can have.
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+	assert.True(t, cbt.HasCondition(uid, "wounded"),
 		"player without Martyr's Resolve must be reduced to 0 HP")
 }
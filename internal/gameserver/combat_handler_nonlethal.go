@@ -0,0 +1,67 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// checkKnockoutsLocked pulls every hostile NPC knocked out by nonlethal
+// damage this round from the fight. It comes to beaten with 1 HP and no fight
+// left, surrendered, so the players can parley with or restrain it; it earns
+// no kill credit, loot drop, or respawn.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: Each knocked-out NPC is no longer a combatant and its
+// instance is Surrendered with 1 HP; returns one event per knockout.
+func (h *CombatHandler) checkKnockoutsLocked(cbt *combat.Combat) []*gamev1.CombatEvent {
+	var out []*combat.Combatant
+	for _, c := range cbt.Combatants {
+		if c.IsHostileNPC() && c.KnockedOut && c.CurrentHP <= 0 {
+			out = append(out, c)
+		}
+	}
+	var events []*gamev1.CombatEvent
+	for _, c := range out {
+		if inst, ok := h.npcMgr.Get(c.ID); ok {
+			inst.CurrentHP = 1
+			inst.Yield()
+		}
+		cbt.RemoveCombatant(c.ID)
+		delete(cbt.ActionQueues, c.ID)
+		events = append(events, &gamev1.CombatEvent{
+			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_FLEE,
+			Attacker:  c.Name,
+			Narrative: fmt.Sprintf("%s is out cold. When they come to they have no fight left. (parley %s)", c.Name, c.Name),
+		})
+	}
+	return events
+}
+
+// wakeKnockedOutPlayersLocked brings every player knocked out by nonlethal
+// damage back to 1 HP once the fight is over, so a knockout never enters the
+// death and respawn pipeline.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: No player combatant is knocked out at 0 HP; returns one
+// event per player woken.
+func (h *CombatHandler) wakeKnockedOutPlayersLocked(cbt *combat.Combat) []*gamev1.CombatEvent {
+	var events []*gamev1.CombatEvent
+	for _, c := range cbt.Combatants {
+		if c.Kind != combat.KindPlayer || !c.KnockedOut || c.IsDead() || c.CurrentHP > 0 {
+			continue
+		}
+		c.KnockedOut = false
+		c.CurrentHP = 1
+		if sess, ok := h.sessions.GetPlayer(c.ID); ok {
+			sess.CurrentHP = 1
+		}
+		events = append(events, &gamev1.CombatEvent{
+			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_CONDITION,
+			Target:    c.Name,
+			Narrative: fmt.Sprintf("%s comes to, bruised, with 1 HP.", c.Name),
+		})
+	}
+	return events
+}
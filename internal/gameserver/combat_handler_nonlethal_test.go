@@ -0,0 +1,75 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestCheckKnockoutsLocked_KnockedOutNPCLeavesFightAlive(t *testing.T) {
+	_, _, npcMgr, combatHandler, inst := newSurrenderFight(t)
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	c := cbt.GetCombatant(inst.ID)
+	c.CurrentHP = 0
+	c.KnockedOut = true
+	inst.CurrentHP = 0
+
+	events := combatHandler.checkKnockoutsLocked(cbt)
+
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0].GetNarrative(), "Ganger is out cold.")
+	assert.Nil(t, cbt.GetCombatant(inst.ID))
+	_, present := npcMgr.Get(inst.ID)
+	assert.True(t, present, "a knocked-out NPC is not removed as dead")
+	assert.True(t, inst.Surrendered)
+	assert.Equal(t, 1, inst.CurrentHP)
+	assert.True(t, inst.Restrainable(), "a knocked-out NPC can be taken prisoner")
+}
+
+func TestCheckKnockoutsLocked_KilledNPCIsLeftToTheDeathPipeline(t *testing.T) {
+	_, _, _, combatHandler, inst := newSurrenderFight(t)
+	cbt, _ := combatHandler.GetCombatForRoom("room_a")
+	cbt.GetCombatant(inst.ID).CurrentHP = 0
+
+	assert.Empty(t, combatHandler.checkKnockoutsLocked(cbt))
+	assert.NotNil(t, cbt.GetCombatant(inst.ID))
+	assert.False(t, inst.Surrendered)
+}
+
+func TestWakeKnockedOutPlayersLocked(t *testing.T) {
+	_, sess, _, combatHandler, _ := newSurrenderFight(t)
+	cbt, _ := combatHandler.GetCombatForRoom("room_a")
+	c := cbt.GetCombatant(sess.UID)
+	c.CurrentHP = 0
+	c.KnockedOut = true
+	sess.CurrentHP = 0
+
+	events := combatHandler.wakeKnockedOutPlayersLocked(cbt)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "Fighter comes to, bruised, with 1 HP.", events[0].GetNarrative())
+	assert.Equal(t, 1, c.CurrentHP)
+	assert.Equal(t, 1, sess.CurrentHP)
+	assert.False(t, c.KnockedOut)
+	assert.Empty(t, combatHandler.wakeKnockedOutPlayersLocked(cbt))
+}
+
+func TestHandleNonlethal(t *testing.T) {
+	svc, sess, _, _, _ := newSurrenderFight(t)
+	set := func(mode string) string {
+		event, err := svc.handleNonlethal(sess.UID, &gamev1.NonlethalRequest{Mode: mode})
+		require.NoError(t, err)
+		return event.GetMessage().GetContent()
+	}
+
+	assert.Contains(t, set("status"), "fighting to kill")
+	assert.Contains(t, set("on"), "pull your blows")
+	assert.True(t, sess.Nonlethal)
+	assert.Contains(t, set("status"), "pulling your blows")
+	set("off")
+	assert.False(t, sess.Nonlethal)
+}
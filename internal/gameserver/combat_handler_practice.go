@@ -0,0 +1,41 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// beatTrainingDummiesLocked takes every training dummy beaten to 0 HP this
+// round out of the fight and sets it back up at full HP. A dummy never dies,
+// so it drops no loot and grants no XP or kill credit.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: Each beaten dummy is no longer a combatant and its instance
+// is at MaxHP; returns one event per dummy.
+func (h *CombatHandler) beatTrainingDummiesLocked(cbt *combat.Combat) []*gamev1.CombatEvent {
+	var beaten []*combat.Combatant
+	for _, c := range cbt.Combatants {
+		if c.Kind != combat.KindNPC || c.CurrentHP > 0 {
+			continue
+		}
+		if inst, ok := h.npcMgr.Get(c.ID); ok && inst.TrainingDummy {
+			beaten = append(beaten, c)
+		}
+	}
+	var events []*gamev1.CombatEvent
+	for _, c := range beaten {
+		if inst, ok := h.npcMgr.Get(c.ID); ok {
+			inst.CurrentHP = inst.MaxHP
+		}
+		cbt.RemoveCombatant(c.ID)
+		delete(cbt.ActionQueues, c.ID)
+		events = append(events, &gamev1.CombatEvent{
+			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_FLEE,
+			Attacker:  c.Name,
+			Narrative: fmt.Sprintf("%s is beaten! It rocks back upright, good as new.", c.Name),
+		})
+	}
+	return events
+}
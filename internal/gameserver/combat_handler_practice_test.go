@@ -0,0 +1,91 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newDummyFight starts a fight in room_a between Trainee and a Sparring Bag.
+func newDummyFight(t *testing.T) (*GameServiceServer, *session.PlayerSession, *CombatHandler, *npc.Instance) {
+	t.Helper()
+	roller := dice.NewLoggedRoller(&fixedDiceSource{val: 10}, zaptest.NewLogger(t))
+	svc, _, sessMgr, npcMgr, combatHandler := newFleeSvcWithCombat(t, roller)
+	inst, err := npcMgr.Spawn(&npc.Template{
+		ID: "practice_dummy", Name: "Sparring Bag", Level: 1, MaxHP: 30, AC: 12,
+		Immobile: true, TrainingDummy: true,
+		Loot: &npc.LootTable{Currency: &npc.CurrencyDrop{Min: 50, Max: 50}},
+	}, "room_a")
+	require.NoError(t, err)
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_trainee", Username: "Trainee", CharName: "Trainee",
+		RoomID: "room_a", CurrentHP: 20, MaxHP: 20, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.Status = statusInCombat
+	_, err = combatHandler.Attack("u_trainee", "Sparring Bag")
+	require.NoError(t, err)
+	combatHandler.cancelTimer("room_a")
+	return svc, sess, combatHandler, inst
+}
+
+func TestBeatTrainingDummiesLocked_ResetsInsteadOfDying(t *testing.T) {
+	_, sess, combatHandler, inst := newDummyFight(t)
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	cbt.GetCombatant(inst.ID).CurrentHP = 0
+	inst.CurrentHP = 0
+	currency := sess.Currency
+
+	events := combatHandler.beatTrainingDummiesLocked(cbt)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "Sparring Bag is beaten! It rocks back upright, good as new.", events[0].GetNarrative())
+	assert.Nil(t, cbt.GetCombatant(inst.ID))
+	assert.Equal(t, 30, inst.CurrentHP)
+	_, present := combatHandler.npcMgr.Get(inst.ID)
+	assert.True(t, present, "a dummy is never removed as dead")
+	combatHandler.removeDeadNPCsLocked(cbt)
+	assert.Equal(t, currency, sess.Currency, "a dummy drops nothing")
+	assert.False(t, cbt.HasLivingNPCs(), "beating the dummy wins the bout")
+}
+
+func TestBeatTrainingDummiesLocked_StandingDummyStays(t *testing.T) {
+	_, _, combatHandler, inst := newDummyFight(t)
+	cbt, _ := combatHandler.GetCombatForRoom("room_a")
+
+	assert.Empty(t, combatHandler.beatTrainingDummiesLocked(cbt))
+	assert.NotNil(t, cbt.GetCombatant(inst.ID))
+}
+
+func TestAutoQueueNPCLocked_TrainingDummyNeverFightsBack(t *testing.T) {
+	_, _, combatHandler, inst := newDummyFight(t)
+	cbt, _ := combatHandler.GetCombatForRoom("room_a")
+
+	combatHandler.autoQueueNPCLocked(cbt, cbt.GetCombatant(inst.ID), nil)
+
+	assert.Empty(t, cbt.ActionQueues[inst.ID].QueuedActions())
+}
+
+func TestHandlePractice_NarratesCombatVerbosely(t *testing.T) {
+	svc, sess, _, _ := newDummyFight(t)
+	sess.CombatVerbosity = session.CombatVerbosityBrief
+	set := func(mode string) string {
+		event, err := svc.handlePractice(sess.UID, &gamev1.PracticeRequest{Mode: mode})
+		require.NoError(t, err)
+		return event.GetMessage().GetContent()
+	}
+
+	assert.Contains(t, set("status"), "Practice mode is off.")
+	assert.Contains(t, set("on"), "Practice mode is on.")
+	assert.Equal(t, session.CombatVerbosityVerbose, sess.CombatNarration())
+	assert.Equal(t, "Practice mode is off. Combat narration is back to brief.", set("off"))
+	assert.Equal(t, session.CombatVerbosityBrief, sess.CombatNarration())
+}
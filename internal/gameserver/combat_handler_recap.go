@@ -0,0 +1,28 @@
+package gameserver
+
+import (
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+// deliverRecapsLocked sends every player still in the finished fight the
+// statistics recap of the encounter and keeps it on their session for the
+// recap command.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: Each player combatant's session LastRecap is their recap.
+func (h *CombatHandler) deliverRecapsLocked(cbt *combat.Combat) {
+	if cbt.Recap == nil {
+		return
+	}
+	for _, c := range cbt.Combatants {
+		if c.Kind != combat.KindPlayer {
+			continue
+		}
+		sess, ok := h.sessions.GetPlayer(c.ID)
+		if !ok {
+			continue
+		}
+		sess.LastRecap = cbt.Recap.Summary(c.ID)
+		h.pushMessageToUID(c.ID, sess.LastRecap)
+	}
+}
@@ -0,0 +1,39 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func recapOf(t *testing.T, svc *GameServiceServer) string {
+	t.Helper()
+	event, err := svc.handleRecap("u_trainee", &gamev1.RecapRequest{})
+	require.NoError(t, err)
+	return event.GetMessage().GetContent()
+}
+
+func TestResolveAndAdvance_CombatEnd_DeliversRecap(t *testing.T) {
+	svc, sess, combatHandler, inst := newDummyFight(t)
+	assert.Equal(t, "You have no finished fight to recap.", recapOf(t, svc))
+
+	combatHandler.combatMu.Lock()
+	cbt, ok := combatHandler.engine.GetCombat("room_a")
+	require.True(t, ok)
+	bag := cbt.GetCombatant(inst.ID)
+	bag.AC, bag.CurrentHP = 1, 1
+	trainee := cbt.GetCombatant("u_trainee")
+	trainee.GridX, trainee.GridY = bag.GridX-1, bag.GridY
+	combatHandler.resolveAndAdvanceLocked("room_a", cbt)
+	combatHandler.combatMu.Unlock()
+
+	_, ongoing := combatHandler.engine.GetCombat("room_a")
+	require.False(t, ongoing, "beating the only dummy ends the fight")
+	assert.Contains(t, sess.LastRecap, "Encounter recap (1 round)")
+	assert.Contains(t, sess.LastRecap, "Hits: 1   Misses: 0")
+	assert.Contains(t, sess.LastRecap, "Damage taken: 0")
+	assert.Equal(t, sess.LastRecap, recapOf(t, svc), "recap re-shows the last encounter")
+}
@@ -0,0 +1,94 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+// checkSurrendersLocked runs the end-of-round morale check: every living
+// hostile NPC whose morale breaks throws down its weapon, leaves the fight,
+// and waits in the room for the players to parley with it.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+// Postcondition: Each surrendering NPC is no longer a combatant and its
+// instance is Surrendered; returns one event per surrender.
+func (h *CombatHandler) checkSurrendersLocked(cbt *combat.Combat) []*gamev1.CombatEvent {
+	var surrendering []*combat.Combatant
+	for _, c := range cbt.Combatants {
+		if !c.IsHostileNPC() || c.IsDead() {
+			continue
+		}
+		inst, ok := h.npcMgr.Get(c.ID)
+		if !ok {
+			continue
+		}
+		inst.CurrentHP = c.CurrentHP
+		if inst.MoraleBroken(h.livingHostilesLocked(cbt) == 1) {
+			surrendering = append(surrendering, c)
+		}
+	}
+	var events []*gamev1.CombatEvent
+	for _, c := range surrendering {
+		if inst, ok := h.npcMgr.Get(c.ID); ok {
+			inst.Yield()
+		}
+		cbt.RemoveCombatant(c.ID)
+		delete(cbt.ActionQueues, c.ID)
+		events = append(events, &gamev1.CombatEvent{
+			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_FLEE,
+			Attacker:  c.Name,
+			Narrative: fmt.Sprintf("%s throws down their weapon and surrenders! (parley %s)", c.Name, c.Name),
+		})
+	}
+	return events
+}
+
+// livingHostilesLocked counts the hostile NPCs still standing in cbt.
+//
+// Precondition: h.combatMu is held; cbt must not be nil.
+func (h *CombatHandler) livingHostilesLocked(cbt *combat.Combat) int {
+	n := 0
+	for _, c := range cbt.Combatants {
+		if c.IsHostileNPC() && !c.IsDead() {
+			n++
+		}
+	}
+	return n
+}
+
+// LootSurrendered hands sess everything a surrendered NPC carries: a roll on
+// its loot table plus any credits it has robbed. An NPC can be looted once.
+//
+// Precondition: sess and inst must not be nil; inst.Surrendered is true.
+// Postcondition: Returns false when inst was already looted; otherwise the
+// loot is in sess's backpack and wallet and sess has been told what it got.
+func (h *CombatHandler) LootSurrendered(sess *session.PlayerSession, inst *npc.Instance) bool {
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	if inst.SurrenderLooted {
+		return false
+	}
+	inst.SurrenderLooted = true
+	var result npc.LootResult
+	if inst.Loot != nil {
+		result = npc.GenerateLoot(npc.ScaleLoot(*inst.Loot, h.zoneModifier(inst.RoomID, scripting.ModLootMultiplier)))
+	}
+	currency := result.Currency + inst.Currency
+	inst.Currency = 0
+	taker := []*session.PlayerSession{sess}
+	h.distributeCurrencyLocked(context.Background(), taker, currency)
+	h.pushCurrencyMessages(taker, currency, inst.Name())
+	h.distributeItemsLocked(taker, result.Items)
+	h.pushLootMessages(taker, result.Items)
+	if h.floorMgr != nil && len(result.Materials) > 0 {
+		h.floorMgr.DropMaterials(inst.RoomID, result.Materials)
+	}
+	return true
+}
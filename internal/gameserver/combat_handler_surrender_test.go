@@ -0,0 +1,112 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newSurrenderFight starts a fight in room_a between Fighter and a Ganger
+// that surrenders below 25% HP.
+func newSurrenderFight(t *testing.T) (*GameServiceServer, *session.PlayerSession, *npc.Manager, *CombatHandler, *npc.Instance) {
+	t.Helper()
+	roller := dice.NewLoggedRoller(&fixedDiceSource{val: 10}, zaptest.NewLogger(t))
+	svc, _, sessMgr, npcMgr, combatHandler := newFleeSvcWithCombat(t, roller)
+	inst, err := npcMgr.Spawn(&npc.Template{
+		ID: "ganger-surrender", Name: "Ganger", Level: 1, MaxHP: 20, AC: 13, Awareness: 5,
+		Abilities: npc.Abilities{Brutality: 10, Quickness: 10, Savvy: 10},
+		Loot:      &npc.LootTable{Currency: &npc.CurrencyDrop{Min: 12, Max: 12}},
+		Surrender: &npc.SurrenderConfig{MoraleHPPct: 25, Info: []string{"The stash is under the stairs."}},
+	}, "room_a")
+	require.NoError(t, err)
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_surrender", Username: "Fighter", CharName: "Fighter",
+		RoomID: "room_a", CurrentHP: 20, MaxHP: 20, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.Status = statusInCombat
+	_, err = combatHandler.Attack("u_surrender", "Ganger")
+	require.NoError(t, err)
+	combatHandler.cancelTimer("room_a")
+	return svc, sess, npcMgr, combatHandler, inst
+}
+
+func TestCheckSurrendersLocked_BrokenMoraleLeavesFight(t *testing.T) {
+	_, _, _, combatHandler, inst := newSurrenderFight(t)
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	cbt.GetCombatant(inst.ID).CurrentHP = 4
+
+	events := combatHandler.checkSurrendersLocked(cbt)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0].GetNarrative(), "Ganger throws down their weapon and surrenders!")
+	assert.Nil(t, cbt.GetCombatant(inst.ID))
+	assert.True(t, inst.Surrendered)
+	assert.Equal(t, 4, inst.CurrentHP)
+	assert.False(t, cbt.HasLivingNPCs(), "the fight is won")
+}
+
+func TestCheckSurrendersLocked_HealthyNPCFightsOn(t *testing.T) {
+	_, _, _, combatHandler, inst := newSurrenderFight(t)
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	// Alone, the Ganger's threshold rises to 50%; 12/20 is still above it.
+	cbt.GetCombatant(inst.ID).CurrentHP = 12
+
+	assert.Empty(t, combatHandler.checkSurrendersLocked(cbt))
+	assert.NotNil(t, cbt.GetCombatant(inst.ID))
+	assert.False(t, inst.Surrendered)
+}
+
+func TestAttack_SurrenderedNPCRefused(t *testing.T) {
+	_, _, _, combatHandler, inst := newSurrenderFight(t)
+	cbt, _ := combatHandler.GetCombatForRoom("room_a")
+	cbt.GetCombatant(inst.ID).CurrentHP = 4
+	combatHandler.checkSurrendersLocked(cbt)
+	combatHandler.engine.EndCombat("room_a")
+
+	_, err := combatHandler.Attack("u_surrender", "Ganger")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has surrendered")
+}
+
+func TestHandleParley(t *testing.T) {
+	svc, sess, npcMgr, combatHandler, inst := newSurrenderFight(t)
+	parley := func(option string) string {
+		t.Helper()
+		event, err := svc.handleParley("u_surrender", &gamev1.ParleyRequest{Target: "Ganger", Option: option})
+		require.NoError(t, err)
+		return event.GetMessage().GetContent()
+	}
+	assert.Contains(t, parley(""), "has not surrendered")
+
+	cbt, _ := combatHandler.GetCombatForRoom("room_a")
+	cbt.GetCombatant(inst.ID).CurrentHP = 4
+	combatHandler.checkSurrendersLocked(cbt)
+	combatHandler.engine.EndCombat("room_a")
+	sess.Status = int32(gamev1.CombatStatus_COMBAT_STATUS_IDLE)
+
+	assert.Contains(t, parley(""), "loot, interrogate, or release")
+
+	before := sess.Currency
+	assert.Contains(t, parley("loot"), "empties their pockets")
+	assert.Equal(t, before+12, sess.Currency)
+	assert.Contains(t, parley("loot"), "nothing left to give")
+
+	assert.Contains(t, parley("interrogate"), "You square off with Ganger.")
+	event, err := svc.handleSocial("u_surrender", &gamev1.SocialRequest{Action: "intimidate"})
+	require.NoError(t, err)
+	assert.Contains(t, event.GetMessage().GetContent(), "The stash is under the stairs.")
+	assert.Contains(t, parley("interrogate"), "already told you everything")
+
+	assert.Contains(t, parley("release"), "You let Ganger go.")
+	_, ok := npcMgr.Get(inst.ID)
+	assert.False(t, ok, "a released NPC leaves the world")
+}
@@ -0,0 +1,75 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+// defaultShieldBlock is the per-round absorption of a raised shield whose
+// definition carries no hardness.
+const defaultShieldBlock = 2
+
+// GrantTempHP raises the temporary HP pool of combatant uid — a player or an
+// NPC instance — in their active combat. Temporary HP does not stack: the pool
+// only grows when amount exceeds it.
+//
+// Precondition: uid non-empty; amount >= 0.
+// Postcondition: Returns an error when uid is not in an active combat;
+// otherwise the combatant's TempHP is max(TempHP, amount) and the room's
+// combat state is rebroadcast when it changed.
+func (h *CombatHandler) GrantTempHP(uid string, amount int) error {
+	return h.withCombatant(uid, func(roomID string, cbt *combat.Combat, c *combat.Combatant) {
+		if c.GrantTempHP(amount) {
+			h.broadcastCombatState(roomID, cbt)
+		}
+	})
+}
+
+// RaiseShield sets uid's per-round shield absorption to block in their active
+// combat. The block is cleared at the start of the next round.
+//
+// Precondition: uid non-empty; block >= 0.
+// Postcondition: Returns an error when uid is not in an active combat;
+// otherwise the combatant's ShieldBlock is at least block.
+func (h *CombatHandler) RaiseShield(uid string, block int) error {
+	return h.withCombatant(uid, func(roomID string, cbt *combat.Combat, c *combat.Combatant) {
+		c.RaiseShield(block)
+		h.broadcastCombatState(roomID, cbt)
+	})
+}
+
+// withCombatant runs fn with combatMu held against uid's combatant in the
+// combat of the room uid occupies, resolving players through their session
+// and NPCs through the NPC manager.
+//
+// Precondition: uid non-empty; fn non-nil.
+// Postcondition: Returns an error when uid's room has no active combat or uid
+// is not one of its combatants; fn is not called in that case.
+func (h *CombatHandler) withCombatant(uid string, fn func(roomID string, cbt *combat.Combat, c *combat.Combatant)) error {
+	roomID := ""
+	if sess, ok := h.sessions.GetPlayer(uid); ok {
+		roomID = sess.RoomID
+	} else if h.npcMgr != nil {
+		if inst, ok := h.npcMgr.Get(uid); ok {
+			roomID = inst.RoomID
+		}
+	}
+	if roomID == "" {
+		return fmt.Errorf("combatant %q not found", uid)
+	}
+
+	h.combatMu.Lock()
+	defer h.combatMu.Unlock()
+
+	cbt, ok := h.engine.GetCombat(roomID)
+	if !ok {
+		return fmt.Errorf("%q is not in active combat", uid)
+	}
+	c := h.findCombatant(cbt, uid)
+	if c == nil {
+		return fmt.Errorf("combatant %q not found in combat", uid)
+	}
+	fn(roomID, cbt, c)
+	return nil
+}
@@ -0,0 +1,124 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/condition"
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newTempHPSvc builds a GameServiceServer with a fight under way in room_a
+// between "hero" (carrying a hardness-3 shield) and a goblin, whose instance
+// is returned.
+func newTempHPSvc(t *testing.T) (*GameServiceServer, *session.PlayerSession, *CombatHandler, *npc.Instance) {
+	t.Helper()
+	logger := zaptest.NewLogger(t)
+	roller := dice.NewLoggedRoller(&fixedDiceSource{val: 10}, logger)
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcMgr := npc.NewManager()
+	condReg := makeTestConditionRegistry()
+	condReg.Register(&condition.ConditionDef{ID: "shield_raised", Name: "Shield Raised", DurationType: "rounds"})
+	combatH := NewCombatHandler(combat.NewEngine(), npcMgr, sessMgr, roller, func(string, []*gamev1.CombatEvent) {}, testRoundDuration, condReg, worldMgr, nil, nil, nil, nil, nil, nil, nil)
+	svc := newTestGameServiceServer(
+		worldMgr, sessMgr,
+		command.DefaultRegistry(),
+		NewWorldHandler(worldMgr, sessMgr, npcMgr, nil, nil, nil),
+		NewChatHandler(sessMgr),
+		logger,
+		nil, roller, nil, nil, combatH, nil,
+		nil, nil, nil, nil, nil, nil,
+		nil, nil, condReg, nil, nil, nil, nil, nil, "",
+		nil, nil, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil,
+		nil, nil,
+		nil,
+		nil,
+		nil, nil,
+	)
+
+	inst := spawnTestNPC(t, npcMgr, "room_a")
+	hero, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "hero", Username: "Hero", CharName: "Hero", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	hero.LoadoutSet = inventory.NewLoadoutSet()
+	require.NoError(t, hero.LoadoutSet.ActivePreset().EquipOffHand(&inventory.WeaponDef{
+		ID: "riot_shield", Name: "Riot Shield", Kind: inventory.WeaponKindShield,
+		DamageDice: "1d4", DamageType: "bludgeoning", ProficiencyCategory: "simple_weapons",
+		Rarity: "salvage", Hardness: 3,
+	}))
+	hero.Conditions = condition.NewActiveSet()
+	_, err = combatH.Attack("hero", inst.Name())
+	require.NoError(t, err)
+	combatH.cancelTimer("room_a")
+	return svc, hero, combatH, inst
+}
+
+func TestHandleRaiseShield_InCombat_SetsShieldBlock(t *testing.T) {
+	svc, _, combatH, _ := newTempHPSvc(t)
+
+	event, err := svc.handleRaiseShield("hero")
+	require.NoError(t, err)
+	require.NotNil(t, event.GetMessage(), "expected a message event, got %v", event)
+	assert.Contains(t, event.GetMessage().Content, "blocks 3 damage")
+
+	combatH.combatMu.Lock()
+	defer combatH.combatMu.Unlock()
+	cbt, ok := combatH.engine.GetCombat("room_a")
+	require.True(t, ok)
+	assert.Equal(t, 3, cbt.GetCombatant("hero").ShieldBlock)
+	var reported int32
+	for _, st := range combatStateEvent(cbt).GetCombatants() {
+		reported += st.GetShield()
+	}
+	assert.Equal(t, int32(3), reported)
+}
+
+func TestGrantTempHP_NPCCombatant_ReportedInState(t *testing.T) {
+	_, _, combatH, inst := newTempHPSvc(t)
+
+	require.NoError(t, combatH.GrantTempHP(inst.ID, 6))
+	require.NoError(t, combatH.GrantTempHP(inst.ID, 2))
+
+	combatH.combatMu.Lock()
+	defer combatH.combatMu.Unlock()
+	cbt, _ := combatH.engine.GetCombat("room_a")
+	assert.Equal(t, 6, cbt.GetCombatant(inst.ID).TempHP, "temporary HP must not stack")
+	var reported int32
+	for _, st := range combatStateEvent(cbt).GetCombatants() {
+		reported += st.GetTempHp()
+	}
+	assert.Equal(t, int32(6), reported)
+}
+
+func TestGrantTempHP_NotInCombat_ReturnsError(t *testing.T) {
+	_, _, combatH, _ := newTempHPSvc(t)
+	assert.Error(t, combatH.GrantTempHP("nobody", 5))
+}
+
+func TestPlayerActivateAdapter_ApplyTempHP_OnlyInCombat(t *testing.T) {
+	svc, hero, combatH, _ := newTempHPSvc(t)
+	adapter := &playerActivateAdapter{sess: hero, svc: svc}
+
+	hero.Status = statusInCombat
+	assert.Equal(t, 4, adapter.ApplyTempHP(4))
+	combatH.combatMu.Lock()
+	cbt, _ := combatH.engine.GetCombat("room_a")
+	assert.Equal(t, 4, cbt.GetCombatant("hero").TempHP)
+	combatH.combatMu.Unlock()
+
+	hero.Status = 0
+	assert.Equal(t, 0, adapter.ApplyTempHP(9))
+}
@@ -504,6 +504,15 @@ func TestConditionEventsToProto_NarrativesNonEmpty(t *testing.T) {
 	})
 }
 
+func TestConditionEventsToProto_UsesEventNarrative(t *testing.T) {
+	result := conditionEventsToProto([]combat.RoundConditionEvent{{
+		UID: "player-1", Name: "Alice", ConditionID: "stunned", Stacks: 2, Applied: true,
+		Narrative: "Alice is stunned and loses 2 AP this round.",
+	}}, makeTestConditionRegistry())
+	require.Len(t, result, 1)
+	assert.Equal(t, "Alice is stunned and loses 2 AP this round.", result[0].Narrative)
+}
+
 // TestConditionEventsToProto_RegistryMissFallback is a property-based test verifying
 // that an unknown conditionID does not panic and falls back to the conditionID string
 // in the narrative.
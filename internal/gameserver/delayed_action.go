@@ -0,0 +1,88 @@
+package gameserver
+
+import (
+	"sync"
+	"time"
+)
+
+// delayedActions runs timed player actions — work that takes a few seconds
+// to finish, such as a lockpicking stage — once their delay has passed. Each
+// player has at most one pending action; scheduling another replaces it.
+//
+// Postcondition: All methods are safe for concurrent use.
+type delayedActions struct {
+	mu sync.Mutex
+	// after starts a timer that calls fn after d and returns its stop func.
+	// It must not call fn before returning.
+	after   func(d time.Duration, fn func()) (stop func() bool)
+	pending map[string]*delayedAction
+}
+
+// delayedAction is one player's pending action.
+type delayedAction struct {
+	label string
+	stop  func() bool
+}
+
+// newDelayedActions creates a scheduler backed by time.AfterFunc.
+func newDelayedActions() *delayedActions {
+	return &delayedActions{
+		after: func(d time.Duration, fn func()) func() bool {
+			return time.AfterFunc(d, fn).Stop
+		},
+		pending: make(map[string]*delayedAction),
+	}
+}
+
+// Schedule runs fn after delay unless it is cancelled or replaced first.
+// label names the action for Pending.
+//
+// Precondition: uid must be non-empty; fn must be non-nil.
+// Postcondition: Any earlier pending action for uid is cancelled; fn runs at
+// most once, on the timer's goroutine.
+func (d *delayedActions) Schedule(uid, label string, delay time.Duration, fn func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if old, ok := d.pending[uid]; ok {
+		old.stop()
+	}
+	a := &delayedAction{label: label}
+	a.stop = d.after(delay, func() {
+		d.mu.Lock()
+		if d.pending[uid] != a {
+			d.mu.Unlock()
+			return
+		}
+		delete(d.pending, uid)
+		d.mu.Unlock()
+		fn()
+	})
+	d.pending[uid] = a
+}
+
+// Cancel drops uid's pending action.
+//
+// Postcondition: Returns the cancelled action's label, or ok false when
+// nothing was pending.
+func (d *delayedActions) Cancel(uid string) (label string, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	a, ok := d.pending[uid]
+	if !ok {
+		return "", false
+	}
+	a.stop()
+	delete(d.pending, uid)
+	return a.label, true
+}
+
+// Pending returns the label of uid's pending action, if any.
+func (d *delayedActions) Pending(uid string) (label string, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	a, ok := d.pending[uid]
+	if !ok {
+		return "", false
+	}
+	return a.label, true
+}
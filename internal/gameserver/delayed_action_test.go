@@ -0,0 +1,98 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// manualTimers stands in for time.AfterFunc: timers fire only when the test
+// calls fire.
+type manualTimers struct {
+	fns     []func()
+	stopped []bool
+}
+
+func (m *manualTimers) after(_ time.Duration, fn func()) func() bool {
+	i := len(m.fns)
+	m.fns = append(m.fns, fn)
+	m.stopped = append(m.stopped, false)
+	return func() bool {
+		was := m.stopped[i]
+		m.stopped[i] = true
+		return !was
+	}
+}
+
+// fire runs every timer that has not been stopped, including any scheduled
+// while firing.
+func (m *manualTimers) fire() {
+	for i := 0; i < len(m.fns); i++ {
+		if !m.stopped[i] {
+			m.stopped[i] = true
+			m.fns[i]()
+		}
+	}
+}
+
+func newManualDelayedActions() (*delayedActions, *manualTimers) {
+	timers := &manualTimers{}
+	d := newDelayedActions()
+	d.after = timers.after
+	return d, timers
+}
+
+func TestDelayedActions_RunsOnce(t *testing.T) {
+	d, timers := newManualDelayedActions()
+	runs := 0
+	d.Schedule("u1", "work", time.Second, func() { runs++ })
+
+	label, ok := d.Pending("u1")
+	require.True(t, ok)
+	assert.Equal(t, "work", label)
+
+	timers.fire()
+	timers.fire()
+	assert.Equal(t, 1, runs)
+	_, ok = d.Pending("u1")
+	assert.False(t, ok)
+}
+
+func TestDelayedActions_ScheduleReplaces(t *testing.T) {
+	d, timers := newManualDelayedActions()
+	var ran []string
+	d.Schedule("u1", "first", time.Second, func() { ran = append(ran, "first") })
+	d.Schedule("u1", "second", time.Second, func() { ran = append(ran, "second") })
+	d.Schedule("u2", "other", time.Second, func() { ran = append(ran, "other") })
+
+	timers.fire()
+	assert.Equal(t, []string{"second", "other"}, ran)
+}
+
+func TestDelayedActions_Cancel(t *testing.T) {
+	d, timers := newManualDelayedActions()
+	runs := 0
+	d.Schedule("u1", "work", time.Second, func() { runs++ })
+
+	label, ok := d.Cancel("u1")
+	assert.True(t, ok)
+	assert.Equal(t, "work", label)
+	_, ok = d.Cancel("u1")
+	assert.False(t, ok)
+
+	timers.fire()
+	assert.Zero(t, runs)
+}
+
+func TestDelayedActions_RealTimer(t *testing.T) {
+	d := newDelayedActions()
+	done := make(chan struct{})
+	d.Schedule("u1", "work", time.Millisecond, func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("delayed action never ran")
+	}
+}
@@ -1,6 +1,7 @@
 package gameserver
 
 import (
+	"github.com/cory-johannsen/mud/internal/game/achievement"
 	"github.com/cory-johannsen/mud/internal/game/ai"
 	"github.com/cory-johannsen/mud/internal/game/combat"
 	"github.com/cory-johannsen/mud/internal/game/condition"
@@ -15,6 +16,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/game/substance"
 	"github.com/cory-johannsen/mud/internal/game/technology"
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
 	"github.com/cory-johannsen/mud/internal/game/world"
 	"github.com/cory-johannsen/mud/internal/scripting"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
@@ -57,6 +59,72 @@ type StorageDeps struct {
 	// CharacterJobsRepo persists all jobs held by each character in the character_jobs table.
 	// May be nil when the job persistence feature is not yet configured.
 	CharacterJobsRepo CharacterJobsRepository
+	// CombatVerbosityRepo persists each character's combat narration level.
+	// May be nil when the setting should not persist across sessions.
+	CombatVerbosityRepo CombatVerbositySaver
+	// AccountLocaleRepo loads and persists each account's message locale.
+	// May be nil when locale selection should not persist across sessions.
+	AccountLocaleRepo AccountLocaleStore
+	// AdminAuditRepo persists the privileged command audit trail.
+	// May be nil, in which case admin actions are only logged.
+	AdminAuditRepo AdminAuditStore
+	// AccountSettingsRepo persists account-level preferences.
+	// May be nil when preference changes should last for the session only.
+	AccountSettingsRepo AccountSettingsSaver
+	// CharacterRenamer renames characters for the admin rename command.
+	// May be nil, in which case rename is unavailable.
+	CharacterRenamer CharacterRenamer
+	// CharacterTransferer moves characters between accounts for the admin transfer command.
+	// May be nil, in which case transfer is unavailable.
+	CharacterTransferer CharacterTransferer
+	// CharacterExporter snapshots characters for the admin exportchar command.
+	// May be nil, in which case exportchar is unavailable.
+	CharacterExporter CharacterExporter
+	// AccountSlotsRepo persists per-account character limit overrides.
+	// May be nil, in which case charslots is unavailable.
+	AccountSlotsRepo AccountSlotsStore
+	// TutorialRepo records when a character finishes or skips the tutorial.
+	// May be nil, in which case completion lasts for the session only.
+	TutorialRepo TutorialStore
+	// MerchantStateRepo persists merchant stock, budget and restock timers.
+	// May be nil, in which case merchants restart fully stocked after a restart.
+	MerchantStateRepo MerchantStateStore
+	// HousingRepo persists player room leases.
+	// May be nil, in which case leases last until the server restarts.
+	HousingRepo HousingStore
+	// StashRepo persists stash (bank) balances and charges rent against them.
+	// May be nil, in which case stash balances last for the session only.
+	StashRepo StashStore
+	// AccountStashRepo persists the stash shared by an account's characters.
+	// May be nil, in which case the shared stash is unavailable.
+	AccountStashRepo AccountStashStore
+	// ClanRepo persists player clans.
+	// May be nil, in which case clans last until the server restarts.
+	ClanRepo ClanStore
+	// AuctionRepo persists auction house listings and escrowed claims.
+	// May be nil, in which case listings last until the server restarts.
+	AuctionRepo AuctionStore
+	// AchievementRepo persists achievement progress and displayed titles.
+	// May be nil, in which case progress lasts for the session only.
+	AchievementRepo AchievementStore
+	// StatsRepo persists lifetime character statistics for the leaderboards.
+	// May be nil, in which case stats are not tracked and leaderboards are unavailable.
+	StatsRepo StatsStore
+	// PlaySessionRepo records login sessions for the played command.
+	// May be nil, in which case only the current session's time is shown.
+	PlaySessionRepo PlaySessionStore
+	// TranscriptRepo stores session transcripts recorded with the log command.
+	// May be nil, in which case session logging is unavailable.
+	TranscriptRepo TranscriptStore
+	// ReportRepo stores player bug, typo, and idea reports.
+	// May be nil, in which case reports can't be filed.
+	ReportRepo ReportStore
+	// WeaponPracticeRepo persists the practice characters earn with weapon categories.
+	// May be nil, in which case practice lasts for the session only.
+	WeaponPracticeRepo WeaponPracticeStore
+	// PointBuyRepo persists the point-buy adjustments characters make to their abilities.
+	// May be nil, in which case respecced abilities are reset on the next login.
+	PointBuyRepo PointBuyStore
 }
 
 // ContentDeps groups all content/world dependencies for GameServiceServer.
@@ -81,6 +149,9 @@ type ContentDeps struct {
 	JobRegistry          *ruleset.JobRegistry
 	ArchetypeMap         map[string]*ruleset.Archetype
 	RegionMap            map[string]*ruleset.Region
+	// HeritageMap holds heritages by ID; their ability modifiers are part of
+	// every character's base scores. May be nil (no heritage modifiers).
+	HeritageMap          map[string]*ruleset.Heritage
 	ScriptMgr            *scripting.Manager
 	DiceRoller           *dice.Roller
 	CombatEngine         *combat.Engine
@@ -110,6 +181,15 @@ type ContentDeps struct {
 	// DowntimeQueueLimitRegistry holds per-tier/per-level queue slot limits.
 	// May be nil when the downtime queue feature is not yet configured.
 	DowntimeQueueLimitRegistry *downtime.DowntimeQueueLimitRegistry
+	// Backgrounds is the character creation questionnaire whose answers grant
+	// starting items and trait conditions. May be nil (no background grants).
+	Backgrounds *ruleset.Backgrounds
+	// Tutorial is the first-login tutorial new characters play through.
+	// May be nil (new characters skip the tutorial).
+	Tutorial *tutorial.Definition
+	// Achievements defines the achievements players can earn and the titles they unlock.
+	// May be nil (achievements and titles are disabled).
+	Achievements *achievement.Registry
 }
 
 // HandlerDeps groups all handler dependencies for GameServiceServer.
@@ -7,7 +7,6 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/game/session"
 	"github.com/cory-johannsen/mud/internal/game/technology"
-	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
 
 // ExportedBuildOptions exposes buildOptions for white-box testing.
@@ -35,15 +34,8 @@ func ExportedFleeNPCImmobile(inst *npc.Instance) bool {
 	return inst.RoomID != before
 }
 
-// RequireEditor exposes requireEditor for white-box testing.
-var RequireEditor = func(sess *session.PlayerSession) *gamev1.ServerEvent {
-	return requireEditor(sess)
-}
-
-// RequireAdmin exposes requireAdmin for white-box testing.
-var RequireAdmin = func(sess *session.PlayerSession) *gamev1.ServerEvent {
-	return requireAdmin(sess)
-}
+// RequiredCapability exposes requiredCapability for white-box testing.
+var RequiredCapability = requiredCapability
 
 // ApplyExploreModeOnCombatStartForTest is an exported test shim for applyExploreModeOnCombatStart.
 func ApplyExploreModeOnCombatStartForTest(sess *session.PlayerSession, playerCbt *combat.Combatant, h *CombatHandler) []string {
@@ -29,13 +29,15 @@ func ApplyFeatGrant(
 	featReg *ruleset.FeatRegistry,
 	featsRepo CharacterFeatsRepo,
 ) ([]string, error) {
-	return applyFeatGrantWithBaseline(ctx, characterID, existing, existing, grants, featReg, featsRepo)
+	return applyFeatGrantWithBaseline(ctx, characterID, existing, existing, grants, featReg, featsRepo, nil)
 }
 
 // applyFeatGrantWithBaseline is the internal implementation that separates the
 // "already satisfied" count (uses preExisting snapshot) from the dedup guard
 // (uses existing live map). This ensures that feats granted in the current run
 // do not count toward satisfying subsequent pool grants from the same backfill.
+// When candidate is non-nil, pool feats whose prerequisites it fails are
+// passed over.
 func applyFeatGrantWithBaseline(
 	ctx context.Context,
 	characterID int64,
@@ -44,6 +46,7 @@ func applyFeatGrantWithBaseline(
 	grants *ruleset.FeatGrants,
 	featReg *ruleset.FeatRegistry,
 	featsRepo CharacterFeatsRepo,
+	candidate *ruleset.FeatCandidate,
 ) ([]string, error) {
 	var granted []string
 
@@ -91,6 +94,9 @@ func applyFeatGrantWithBaseline(
 			if featReg != nil {
 				if f, ok := featReg.Feat(id); ok {
 					canonicalID = f.ID
+					if candidate != nil && len(f.Prerequisites.Unmet(*candidate)) > 0 {
+						continue // prerequisites not met; leave for a later pick
+					}
 				} else {
 					continue // feat not found in registry; skip
 				}
@@ -112,7 +118,8 @@ func applyFeatGrantWithBaseline(
 
 // BackfillLevelUpFeats retroactively applies all feat level-up grants the player
 // should have earned for levels 2..sess.Level but does not yet have. Auto-assigns
-// by first-available pool order. Safe to call on every login (idempotent).
+// by first-available pool order, passing over feats whose prerequisites the
+// character did not meet at that level. Safe to call on every login (idempotent).
 //
 // levelGrantsRepo, when non-nil, is consulted to determine whether a given level
 // has already been processed. This prevents re-granting when creation feats share
@@ -171,8 +178,10 @@ func BackfillLevelUpFeats(
 		}
 		// Use an empty preExisting baseline so that pool quota counts only
 		// feats granted in THIS run, not creation feats.
+		candidate := featCandidate(sess, existing)
+		candidate.Level = lvl
 		if _, err := applyFeatGrantWithBaseline(ctx, characterID,
-			existing, make(map[string]bool), grants, featReg, featsRepo,
+			existing, make(map[string]bool), grants, featReg, featsRepo, &candidate,
 		); err != nil {
 			return err
 		}
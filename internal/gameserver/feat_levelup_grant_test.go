@@ -32,6 +32,10 @@ func (r *fakeFeatsRepo) Add(_ context.Context, _ int64, featID string) error {
 	r.feats[featID] = true
 	return nil
 }
+func (r *fakeFeatsRepo) Remove(_ context.Context, _ int64, featID string) error {
+	delete(r.feats, featID)
+	return nil
+}
 
 // ---------------------------------------------------------------------------
 // Test double for CharacterFeatLevelGrantsRepo
@@ -177,6 +177,59 @@ func (CombatStatus) EnumDescriptor() ([]byte, []int) {
 	return file_game_v1_game_proto_rawDescGZIP(), []int{2}
 }
 
+// CombatRelation is a combatant's relationship to the player receiving an event.
+type CombatRelation int32
+
+const (
+	CombatRelation_COMBAT_RELATION_UNSPECIFIED CombatRelation = 0
+	CombatRelation_COMBAT_RELATION_SELF        CombatRelation = 1
+	CombatRelation_COMBAT_RELATION_ALLY        CombatRelation = 2
+	CombatRelation_COMBAT_RELATION_ENEMY       CombatRelation = 3
+)
+
+// Enum value maps for CombatRelation.
+var (
+	CombatRelation_name = map[int32]string{
+		0: "COMBAT_RELATION_UNSPECIFIED",
+		1: "COMBAT_RELATION_SELF",
+		2: "COMBAT_RELATION_ALLY",
+		3: "COMBAT_RELATION_ENEMY",
+	}
+	CombatRelation_value = map[string]int32{
+		"COMBAT_RELATION_UNSPECIFIED": 0,
+		"COMBAT_RELATION_SELF":        1,
+		"COMBAT_RELATION_ALLY":        2,
+		"COMBAT_RELATION_ENEMY":       3,
+	}
+)
+
+func (x CombatRelation) Enum() *CombatRelation {
+	p := new(CombatRelation)
+	*p = x
+	return p
+}
+
+func (x CombatRelation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CombatRelation) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_v1_game_proto_enumTypes[3].Descriptor()
+}
+
+func (CombatRelation) Type() protoreflect.EnumType {
+	return &file_game_v1_game_proto_enumTypes[3]
+}
+
+func (x CombatRelation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CombatRelation.Descriptor instead.
+func (CombatRelation) EnumDescriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
 // CombatEventType distinguishes combat narration events.
 type CombatEventType int32
 
@@ -232,11 +285,11 @@ func (x CombatEventType) String() string {
 }
 
 func (CombatEventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_v1_game_proto_enumTypes[3].Descriptor()
+	return file_game_v1_game_proto_enumTypes[4].Descriptor()
 }
 
 func (CombatEventType) Type() protoreflect.EnumType {
-	return &file_game_v1_game_proto_enumTypes[3]
+	return &file_game_v1_game_proto_enumTypes[4]
 }
 
 func (x CombatEventType) Number() protoreflect.EnumNumber {
@@ -245,7 +298,7 @@ func (x CombatEventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CombatEventType.Descriptor instead.
 func (CombatEventType) EnumDescriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{3}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{4}
 }
 
 type AoeTemplate_Shape int32
@@ -284,11 +337,11 @@ func (x AoeTemplate_Shape) String() string {
 }
 
 func (AoeTemplate_Shape) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_v1_game_proto_enumTypes[4].Descriptor()
+	return file_game_v1_game_proto_enumTypes[5].Descriptor()
 }
 
 func (AoeTemplate_Shape) Type() protoreflect.EnumType {
-	return &file_game_v1_game_proto_enumTypes[4]
+	return &file_game_v1_game_proto_enumTypes[5]
 }
 
 func (x AoeTemplate_Shape) Number() protoreflect.EnumNumber {
@@ -297,7 +350,7 @@ func (x AoeTemplate_Shape) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AoeTemplate_Shape.Descriptor instead.
 func (AoeTemplate_Shape) EnumDescriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{133, 0}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{139, 0}
 }
 
 type AoeTemplate_Direction int32
@@ -351,11 +404,11 @@ func (x AoeTemplate_Direction) String() string {
 }
 
 func (AoeTemplate_Direction) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_v1_game_proto_enumTypes[5].Descriptor()
+	return file_game_v1_game_proto_enumTypes[6].Descriptor()
 }
 
 func (AoeTemplate_Direction) Type() protoreflect.EnumType {
-	return &file_game_v1_game_proto_enumTypes[5]
+	return &file_game_v1_game_proto_enumTypes[6]
 }
 
 func (x AoeTemplate_Direction) Number() protoreflect.EnumNumber {
@@ -364,7 +417,7 @@ func (x AoeTemplate_Direction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AoeTemplate_Direction.Descriptor instead.
 func (AoeTemplate_Direction) EnumDescriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{133, 1}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{139, 1}
 }
 
 // ClientMessage wraps all client-to-server commands.
@@ -513,6 +566,65 @@ type ClientMessage struct {
 	//	*ClientMessage_ChooseFeat
 	//	*ClientMessage_MoveTo
 	//	*ClientMessage_ReactionResponse
+	//	*ClientMessage_CombatVerbosity
+	//	*ClientMessage_Locale
+	//	*ClientMessage_ChatMute
+	//	*ClientMessage_Audit
+	//	*ClientMessage_Settings
+	//	*ClientMessage_Rename
+	//	*ClientMessage_CharacterSlots
+	//	*ClientMessage_Tutorial
+	//	*ClientMessage_Home
+	//	*ClientMessage_Clan
+	//	*ClientMessage_Achievements
+	//	*ClientMessage_Title
+	//	*ClientMessage_Top
+	//	*ClientMessage_Played
+	//	*ClientMessage_Afk
+	//	*ClientMessage_SiteBan
+	//	*ClientMessage_Consider
+	//	*ClientMessage_Scan
+	//	*ClientMessage_Light
+	//	*ClientMessage_Board
+	//	*ClientMessage_Disembark
+	//	*ClientMessage_Drive
+	//	*ClientMessage_Refuel
+	//	*ClientMessage_Shout
+	//	*ClientMessage_Yell
+	//	*ClientMessage_PayFine
+	//	*ClientMessage_Steal
+	//	*ClientMessage_Market
+	//	*ClientMessage_Auction
+	//	*ClientMessage_Pick
+	//	*ClientMessage_DetectTraps
+	//	*ClientMessage_Log
+	//	*ClientMessage_Report
+	//	*ClientMessage_Reports
+	//	*ClientMessage_Invis
+	//	*ClientMessage_Snoop
+	//	*ClientMessage_Force
+	//	*ClientMessage_Puppet
+	//	*ClientMessage_Gm
+	//	*ClientMessage_Time
+	//	*ClientMessage_Schedule
+	//	*ClientMessage_Engrave
+	//	*ClientMessage_Dig
+	//	*ClientMessage_Answer
+	//	*ClientMessage_ReloadConfig
+	//	*ClientMessage_Flag
+	//	*ClientMessage_TransferCharacter
+	//	*ClientMessage_SharedStash
+	//	*ClientMessage_Parley
+	//	*ClientMessage_Social
+	//	*ClientMessage_Restrain
+	//	*ClientMessage_Nonlethal
+	//	*ClientMessage_Practice
+	//	*ClientMessage_Recap
+	//	*ClientMessage_Formation
+	//	*ClientMessage_Order
+	//	*ClientMessage_JobAbility
+	//	*ClientMessage_Respec
+	//	*ClientMessage_ExportCharacter
 	Payload       isClientMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1822,361 +1934,892 @@ func (x *ClientMessage) GetReactionResponse() *ReactionResponse {
 	return nil
 }
 
-type isClientMessage_Payload interface {
-	isClientMessage_Payload()
+func (x *ClientMessage) GetCombatVerbosity() *CombatVerbosityRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_CombatVerbosity); ok {
+			return x.CombatVerbosity
+		}
+	}
+	return nil
 }
 
-type ClientMessage_JoinWorld struct {
-	JoinWorld *JoinWorldRequest `protobuf:"bytes,2,opt,name=join_world,json=joinWorld,proto3,oneof"`
+func (x *ClientMessage) GetLocale() *LocaleRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Locale); ok {
+			return x.Locale
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Move struct {
-	Move *MoveRequest `protobuf:"bytes,3,opt,name=move,proto3,oneof"`
+func (x *ClientMessage) GetChatMute() *ChatMuteRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_ChatMute); ok {
+			return x.ChatMute
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Look struct {
-	Look *LookRequest `protobuf:"bytes,4,opt,name=look,proto3,oneof"`
+func (x *ClientMessage) GetAudit() *AuditRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Audit); ok {
+			return x.Audit
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Say struct {
-	Say *SayRequest `protobuf:"bytes,5,opt,name=say,proto3,oneof"`
+func (x *ClientMessage) GetSettings() *SettingsRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Settings); ok {
+			return x.Settings
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Emote struct {
-	Emote *EmoteRequest `protobuf:"bytes,6,opt,name=emote,proto3,oneof"`
+func (x *ClientMessage) GetRename() *RenameRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Rename); ok {
+			return x.Rename
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Who struct {
-	Who *WhoRequest `protobuf:"bytes,7,opt,name=who,proto3,oneof"`
+func (x *ClientMessage) GetCharacterSlots() *CharacterSlotsRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_CharacterSlots); ok {
+			return x.CharacterSlots
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Exits struct {
-	Exits *ExitsRequest `protobuf:"bytes,8,opt,name=exits,proto3,oneof"`
+func (x *ClientMessage) GetTutorial() *TutorialRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Tutorial); ok {
+			return x.Tutorial
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Quit struct {
-	Quit *QuitRequest `protobuf:"bytes,9,opt,name=quit,proto3,oneof"`
+func (x *ClientMessage) GetHome() *HomeRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Home); ok {
+			return x.Home
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Examine struct {
-	Examine *ExamineRequest `protobuf:"bytes,10,opt,name=examine,proto3,oneof"`
+func (x *ClientMessage) GetClan() *ClanRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Clan); ok {
+			return x.Clan
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Attack struct {
-	Attack *AttackRequest `protobuf:"bytes,11,opt,name=attack,proto3,oneof"`
+func (x *ClientMessage) GetAchievements() *AchievementsRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Achievements); ok {
+			return x.Achievements
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Flee struct {
-	Flee *FleeRequest `protobuf:"bytes,12,opt,name=flee,proto3,oneof"`
+func (x *ClientMessage) GetTitle() *TitleRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Title); ok {
+			return x.Title
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Pass struct {
-	Pass *PassRequest `protobuf:"bytes,13,opt,name=pass,proto3,oneof"`
+func (x *ClientMessage) GetTop() *TopRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Top); ok {
+			return x.Top
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Strike struct {
-	Strike *StrikeRequest `protobuf:"bytes,14,opt,name=strike,proto3,oneof"`
+func (x *ClientMessage) GetPlayed() *PlayedRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Played); ok {
+			return x.Played
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Status struct {
-	Status *StatusRequest `protobuf:"bytes,15,opt,name=status,proto3,oneof"`
+func (x *ClientMessage) GetAfk() *AfkRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Afk); ok {
+			return x.Afk
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Equip struct {
-	Equip *EquipRequest `protobuf:"bytes,16,opt,name=equip,proto3,oneof"`
+func (x *ClientMessage) GetSiteBan() *SiteBanRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_SiteBan); ok {
+			return x.SiteBan
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Reload struct {
-	Reload *ReloadRequest `protobuf:"bytes,17,opt,name=reload,proto3,oneof"`
+func (x *ClientMessage) GetConsider() *ConsiderRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Consider); ok {
+			return x.Consider
+		}
+	}
+	return nil
 }
 
-type ClientMessage_FireBurst struct {
-	FireBurst *FireBurstRequest `protobuf:"bytes,18,opt,name=fire_burst,json=fireBurst,proto3,oneof"`
+func (x *ClientMessage) GetScan() *ScanRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Scan); ok {
+			return x.Scan
+		}
+	}
+	return nil
 }
 
-type ClientMessage_FireAutomatic struct {
-	FireAutomatic *FireAutomaticRequest `protobuf:"bytes,19,opt,name=fire_automatic,json=fireAutomatic,proto3,oneof"`
+func (x *ClientMessage) GetLight() *LightRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Light); ok {
+			return x.Light
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Throw struct {
-	Throw *ThrowRequest `protobuf:"bytes,20,opt,name=throw,proto3,oneof"`
+func (x *ClientMessage) GetBoard() *BoardRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Board); ok {
+			return x.Board
+		}
+	}
+	return nil
 }
 
-type ClientMessage_InventoryReq struct {
-	InventoryReq *InventoryRequest `protobuf:"bytes,21,opt,name=inventory_req,json=inventoryReq,proto3,oneof"`
+func (x *ClientMessage) GetDisembark() *DisembarkRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Disembark); ok {
+			return x.Disembark
+		}
+	}
+	return nil
 }
 
-type ClientMessage_GetItem struct {
-	GetItem *GetItemRequest `protobuf:"bytes,22,opt,name=get_item,json=getItem,proto3,oneof"`
+func (x *ClientMessage) GetDrive() *DriveRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Drive); ok {
+			return x.Drive
+		}
+	}
+	return nil
 }
 
-type ClientMessage_DropItem struct {
-	DropItem *DropItemRequest `protobuf:"bytes,23,opt,name=drop_item,json=dropItem,proto3,oneof"`
+func (x *ClientMessage) GetRefuel() *RefuelRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Refuel); ok {
+			return x.Refuel
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Balance struct {
-	Balance *BalanceRequest `protobuf:"bytes,24,opt,name=balance,proto3,oneof"`
+func (x *ClientMessage) GetShout() *ShoutRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Shout); ok {
+			return x.Shout
+		}
+	}
+	return nil
 }
 
-type ClientMessage_SetRole struct {
-	SetRole *SetRoleRequest `protobuf:"bytes,25,opt,name=set_role,json=setRole,proto3,oneof"`
+func (x *ClientMessage) GetYell() *YellRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Yell); ok {
+			return x.Yell
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Teleport struct {
-	Teleport *TeleportRequest `protobuf:"bytes,26,opt,name=teleport,proto3,oneof"`
+func (x *ClientMessage) GetPayFine() *PayFineRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_PayFine); ok {
+			return x.PayFine
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Loadout struct {
-	Loadout *LoadoutRequest `protobuf:"bytes,27,opt,name=loadout,proto3,oneof"`
+func (x *ClientMessage) GetSteal() *StealRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Steal); ok {
+			return x.Steal
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Unequip struct {
-	Unequip *UnequipRequest `protobuf:"bytes,28,opt,name=unequip,proto3,oneof"`
+func (x *ClientMessage) GetMarket() *MarketRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Market); ok {
+			return x.Market
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Equipment struct {
-	Equipment *EquipmentRequest `protobuf:"bytes,29,opt,name=equipment,proto3,oneof"`
+func (x *ClientMessage) GetAuction() *AuctionRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Auction); ok {
+			return x.Auction
+		}
+	}
+	return nil
 }
 
-type ClientMessage_SwitchCharacter struct {
-	SwitchCharacter *SwitchCharacterRequest `protobuf:"bytes,30,opt,name=switch_character,json=switchCharacter,proto3,oneof"`
+func (x *ClientMessage) GetPick() *PickRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Pick); ok {
+			return x.Pick
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Wear struct {
-	Wear *WearRequest `protobuf:"bytes,31,opt,name=wear,proto3,oneof"`
+func (x *ClientMessage) GetDetectTraps() *DetectTrapsRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_DetectTraps); ok {
+			return x.DetectTraps
+		}
+	}
+	return nil
 }
 
-type ClientMessage_RemoveArmor struct {
-	RemoveArmor *RemoveArmorRequest `protobuf:"bytes,32,opt,name=remove_armor,json=removeArmor,proto3,oneof"`
+func (x *ClientMessage) GetLog() *LogRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Log); ok {
+			return x.Log
+		}
+	}
+	return nil
 }
 
-type ClientMessage_CharSheet struct {
-	CharSheet *CharacterSheetRequest `protobuf:"bytes,33,opt,name=char_sheet,json=charSheet,proto3,oneof"`
+func (x *ClientMessage) GetReport() *ReportRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Report); ok {
+			return x.Report
+		}
+	}
+	return nil
 }
 
-type ClientMessage_ArchetypeSelection struct {
-	ArchetypeSelection *ArchetypeSelectionRequest `protobuf:"bytes,34,opt,name=archetype_selection,json=archetypeSelection,proto3,oneof"`
+func (x *ClientMessage) GetReports() *ReportsRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Reports); ok {
+			return x.Reports
+		}
+	}
+	return nil
 }
 
-type ClientMessage_UseEquipment struct {
-	UseEquipment *UseEquipmentRequest `protobuf:"bytes,35,opt,name=use_equipment,json=useEquipment,proto3,oneof"`
+func (x *ClientMessage) GetInvis() *InvisRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Invis); ok {
+			return x.Invis
+		}
+	}
+	return nil
 }
 
-type ClientMessage_RoomEquip struct {
-	RoomEquip *RoomEquipRequest `protobuf:"bytes,36,opt,name=room_equip,json=roomEquip,proto3,oneof"`
+func (x *ClientMessage) GetSnoop() *SnoopRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Snoop); ok {
+			return x.Snoop
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Map struct {
-	Map *MapRequest `protobuf:"bytes,37,opt,name=map,proto3,oneof"`
+func (x *ClientMessage) GetForce() *ForceRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Force); ok {
+			return x.Force
+		}
+	}
+	return nil
 }
 
-type ClientMessage_SkillsRequest struct {
-	SkillsRequest *SkillsRequest `protobuf:"bytes,38,opt,name=skills_request,json=skillsRequest,proto3,oneof"`
+func (x *ClientMessage) GetPuppet() *PuppetRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Puppet); ok {
+			return x.Puppet
+		}
+	}
+	return nil
 }
 
-type ClientMessage_FeatsRequest struct {
-	FeatsRequest *FeatsRequest `protobuf:"bytes,39,opt,name=feats_request,json=featsRequest,proto3,oneof"`
+func (x *ClientMessage) GetGm() *GMRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Gm); ok {
+			return x.Gm
+		}
+	}
+	return nil
 }
 
-type ClientMessage_InteractRequest struct {
-	InteractRequest *InteractRequest `protobuf:"bytes,40,opt,name=interact_request,json=interactRequest,proto3,oneof"`
+func (x *ClientMessage) GetTime() *TimeRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Time); ok {
+			return x.Time
+		}
+	}
+	return nil
 }
 
-type ClientMessage_UseRequest struct {
-	UseRequest *UseRequest `protobuf:"bytes,41,opt,name=use_request,json=useRequest,proto3,oneof"`
+func (x *ClientMessage) GetSchedule() *ScheduleRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Schedule); ok {
+			return x.Schedule
+		}
+	}
+	return nil
 }
 
-type ClientMessage_ClassFeaturesRequest struct {
-	ClassFeaturesRequest *ClassFeaturesRequest `protobuf:"bytes,42,opt,name=class_features_request,json=classFeaturesRequest,proto3,oneof"`
+func (x *ClientMessage) GetEngrave() *EngraveRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Engrave); ok {
+			return x.Engrave
+		}
+	}
+	return nil
 }
 
-type ClientMessage_SummonItem struct {
-	SummonItem *SummonItemRequest `protobuf:"bytes,43,opt,name=summon_item,json=summonItem,proto3,oneof"`
+func (x *ClientMessage) GetDig() *DigRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Dig); ok {
+			return x.Dig
+		}
+	}
+	return nil
 }
 
-type ClientMessage_ProficienciesRequest struct {
-	ProficienciesRequest *ProficienciesRequest `protobuf:"bytes,44,opt,name=proficiencies_request,json=proficienciesRequest,proto3,oneof"`
+func (x *ClientMessage) GetAnswer() *AnswerRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Answer); ok {
+			return x.Answer
+		}
+	}
+	return nil
 }
 
-type ClientMessage_LevelUp struct {
-	LevelUp *LevelUpRequest `protobuf:"bytes,45,opt,name=level_up,json=levelUp,proto3,oneof"`
+func (x *ClientMessage) GetReloadConfig() *ReloadConfigRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_ReloadConfig); ok {
+			return x.ReloadConfig
+		}
+	}
+	return nil
 }
 
-type ClientMessage_CombatDefault struct {
-	CombatDefault *CombatDefaultRequest `protobuf:"bytes,46,opt,name=combat_default,json=combatDefault,proto3,oneof"`
+func (x *ClientMessage) GetFlag() *FlagRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Flag); ok {
+			return x.Flag
+		}
+	}
+	return nil
 }
 
-type ClientMessage_TrainSkill struct {
-	TrainSkill *TrainSkillRequest `protobuf:"bytes,47,opt,name=train_skill,json=trainSkill,proto3,oneof"`
+func (x *ClientMessage) GetTransferCharacter() *TransferCharacterRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_TransferCharacter); ok {
+			return x.TransferCharacter
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Action struct {
-	Action *ActionRequest `protobuf:"bytes,48,opt,name=action,proto3,oneof"`
+func (x *ClientMessage) GetSharedStash() *SharedStashRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_SharedStash); ok {
+			return x.SharedStash
+		}
+	}
+	return nil
 }
 
-type ClientMessage_RaiseShield struct {
-	RaiseShield *RaiseShieldRequest `protobuf:"bytes,49,opt,name=raise_shield,json=raiseShield,proto3,oneof"`
+func (x *ClientMessage) GetParley() *ParleyRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Parley); ok {
+			return x.Parley
+		}
+	}
+	return nil
 }
 
-type ClientMessage_TakeCover struct {
-	TakeCover *TakeCoverRequest `protobuf:"bytes,50,opt,name=take_cover,json=takeCover,proto3,oneof"`
+func (x *ClientMessage) GetSocial() *SocialRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Social); ok {
+			return x.Social
+		}
+	}
+	return nil
 }
 
-type ClientMessage_FirstAid struct {
-	FirstAid *FirstAidRequest `protobuf:"bytes,51,opt,name=first_aid,json=firstAid,proto3,oneof"`
+func (x *ClientMessage) GetRestrain() *RestrainRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Restrain); ok {
+			return x.Restrain
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Feint struct {
-	Feint *FeintRequest `protobuf:"bytes,52,opt,name=feint,proto3,oneof"`
+func (x *ClientMessage) GetNonlethal() *NonlethalRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Nonlethal); ok {
+			return x.Nonlethal
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Demoralize struct {
-	Demoralize *DemoralizeRequest `protobuf:"bytes,53,opt,name=demoralize,proto3,oneof"`
+func (x *ClientMessage) GetPractice() *PracticeRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Practice); ok {
+			return x.Practice
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Grapple struct {
-	Grapple *GrappleRequest `protobuf:"bytes,54,opt,name=grapple,proto3,oneof"`
+func (x *ClientMessage) GetRecap() *RecapRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Recap); ok {
+			return x.Recap
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Trip struct {
-	Trip *TripRequest `protobuf:"bytes,55,opt,name=trip,proto3,oneof"`
+func (x *ClientMessage) GetFormation() *FormationRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Formation); ok {
+			return x.Formation
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Hide struct {
-	Hide *HideRequest `protobuf:"bytes,56,opt,name=hide,proto3,oneof"`
+func (x *ClientMessage) GetOrder() *OrderRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Order); ok {
+			return x.Order
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Sneak struct {
-	Sneak *SneakRequest `protobuf:"bytes,57,opt,name=sneak,proto3,oneof"`
+func (x *ClientMessage) GetJobAbility() *JobAbilityRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_JobAbility); ok {
+			return x.JobAbility
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Divert struct {
-	Divert *DivertRequest `protobuf:"bytes,58,opt,name=divert,proto3,oneof"`
+func (x *ClientMessage) GetRespec() *RespecRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_Respec); ok {
+			return x.Respec
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Escape struct {
-	Escape *EscapeRequest `protobuf:"bytes,59,opt,name=escape,proto3,oneof"`
+func (x *ClientMessage) GetExportCharacter() *ExportCharacterRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ClientMessage_ExportCharacter); ok {
+			return x.ExportCharacter
+		}
+	}
+	return nil
 }
 
-type ClientMessage_Grant struct {
-	Grant *GrantRequest `protobuf:"bytes,60,opt,name=grant,proto3,oneof"`
+type isClientMessage_Payload interface {
+	isClientMessage_Payload()
 }
 
-type ClientMessage_Disarm struct {
-	Disarm *DisarmRequest `protobuf:"bytes,61,opt,name=disarm,proto3,oneof"`
+type ClientMessage_JoinWorld struct {
+	JoinWorld *JoinWorldRequest `protobuf:"bytes,2,opt,name=join_world,json=joinWorld,proto3,oneof"`
 }
 
-type ClientMessage_Stride struct {
-	Stride *StrideRequest `protobuf:"bytes,62,opt,name=stride,proto3,oneof"`
+type ClientMessage_Move struct {
+	Move *MoveRequest `protobuf:"bytes,3,opt,name=move,proto3,oneof"`
 }
 
-type ClientMessage_Shove struct {
-	Shove *ShoveRequest `protobuf:"bytes,63,opt,name=shove,proto3,oneof"`
+type ClientMessage_Look struct {
+	Look *LookRequest `protobuf:"bytes,4,opt,name=look,proto3,oneof"`
 }
 
-type ClientMessage_Step struct {
-	Step *StepRequest `protobuf:"bytes,64,opt,name=step,proto3,oneof"`
+type ClientMessage_Say struct {
+	Say *SayRequest `protobuf:"bytes,5,opt,name=say,proto3,oneof"`
 }
 
-type ClientMessage_Tumble struct {
-	Tumble *TumbleRequest `protobuf:"bytes,65,opt,name=tumble,proto3,oneof"`
+type ClientMessage_Emote struct {
+	Emote *EmoteRequest `protobuf:"bytes,6,opt,name=emote,proto3,oneof"`
 }
 
-type ClientMessage_Seek struct {
-	Seek *SeekRequest `protobuf:"bytes,66,opt,name=seek,proto3,oneof"`
+type ClientMessage_Who struct {
+	Who *WhoRequest `protobuf:"bytes,7,opt,name=who,proto3,oneof"`
 }
 
-type ClientMessage_Climb struct {
-	Climb *ClimbRequest `protobuf:"bytes,67,opt,name=climb,proto3,oneof"`
+type ClientMessage_Exits struct {
+	Exits *ExitsRequest `protobuf:"bytes,8,opt,name=exits,proto3,oneof"`
 }
 
-type ClientMessage_Swim struct {
-	Swim *SwimRequest `protobuf:"bytes,68,opt,name=swim,proto3,oneof"`
+type ClientMessage_Quit struct {
+	Quit *QuitRequest `protobuf:"bytes,9,opt,name=quit,proto3,oneof"`
 }
 
-type ClientMessage_Motive struct {
-	Motive *MotiveRequest `protobuf:"bytes,69,opt,name=motive,proto3,oneof"`
+type ClientMessage_Examine struct {
+	Examine *ExamineRequest `protobuf:"bytes,10,opt,name=examine,proto3,oneof"`
 }
 
-type ClientMessage_Calm struct {
-	Calm *CalmRequest `protobuf:"bytes,70,opt,name=calm,proto3,oneof"`
+type ClientMessage_Attack struct {
+	Attack *AttackRequest `protobuf:"bytes,11,opt,name=attack,proto3,oneof"`
 }
 
-type ClientMessage_HeroPoint struct {
-	HeroPoint *HeroPointRequest `protobuf:"bytes,71,opt,name=hero_point,json=heroPoint,proto3,oneof"`
+type ClientMessage_Flee struct {
+	Flee *FleeRequest `protobuf:"bytes,12,opt,name=flee,proto3,oneof"`
 }
 
-type ClientMessage_Delay struct {
-	Delay *DelayRequest `protobuf:"bytes,72,opt,name=delay,proto3,oneof"`
+type ClientMessage_Pass struct {
+	Pass *PassRequest `protobuf:"bytes,13,opt,name=pass,proto3,oneof"`
 }
 
-type ClientMessage_Join struct {
-	Join *JoinRequest `protobuf:"bytes,73,opt,name=join,proto3,oneof"`
+type ClientMessage_Strike struct {
+	Strike *StrikeRequest `protobuf:"bytes,14,opt,name=strike,proto3,oneof"`
 }
 
-type ClientMessage_Decline struct {
-	Decline *DeclineRequest `protobuf:"bytes,74,opt,name=decline,proto3,oneof"`
+type ClientMessage_Status struct {
+	Status *StatusRequest `protobuf:"bytes,15,opt,name=status,proto3,oneof"`
 }
 
-type ClientMessage_Group struct {
-	Group *GroupRequest `protobuf:"bytes,75,opt,name=group,proto3,oneof"`
+type ClientMessage_Equip struct {
+	Equip *EquipRequest `protobuf:"bytes,16,opt,name=equip,proto3,oneof"`
 }
 
-type ClientMessage_Invite struct {
-	Invite *InviteRequest `protobuf:"bytes,76,opt,name=invite,proto3,oneof"`
+type ClientMessage_Reload struct {
+	Reload *ReloadRequest `protobuf:"bytes,17,opt,name=reload,proto3,oneof"`
 }
 
-type ClientMessage_AcceptGroup struct {
-	AcceptGroup *AcceptGroupRequest `protobuf:"bytes,77,opt,name=accept_group,json=acceptGroup,proto3,oneof"`
+type ClientMessage_FireBurst struct {
+	FireBurst *FireBurstRequest `protobuf:"bytes,18,opt,name=fire_burst,json=fireBurst,proto3,oneof"`
 }
 
-type ClientMessage_DeclineGroup struct {
-	DeclineGroup *DeclineGroupRequest `protobuf:"bytes,78,opt,name=decline_group,json=declineGroup,proto3,oneof"`
+type ClientMessage_FireAutomatic struct {
+	FireAutomatic *FireAutomaticRequest `protobuf:"bytes,19,opt,name=fire_automatic,json=fireAutomatic,proto3,oneof"`
 }
 
-type ClientMessage_Ungroup struct {
-	Ungroup *UngroupRequest `protobuf:"bytes,79,opt,name=ungroup,proto3,oneof"`
+type ClientMessage_Throw struct {
+	Throw *ThrowRequest `protobuf:"bytes,20,opt,name=throw,proto3,oneof"`
 }
 
-type ClientMessage_Kick struct {
-	Kick *KickRequest `protobuf:"bytes,80,opt,name=kick,proto3,oneof"`
+type ClientMessage_InventoryReq struct {
+	InventoryReq *InventoryRequest `protobuf:"bytes,21,opt,name=inventory_req,json=inventoryReq,proto3,oneof"`
 }
 
-type ClientMessage_Rest struct {
-	Rest *RestRequest `protobuf:"bytes,81,opt,name=rest,proto3,oneof"`
+type ClientMessage_GetItem struct {
+	GetItem *GetItemRequest `protobuf:"bytes,22,opt,name=get_item,json=getItem,proto3,oneof"`
 }
 
-type ClientMessage_SelectTech struct {
-	SelectTech *SelectTechRequest `protobuf:"bytes,82,opt,name=select_tech,json=selectTech,proto3,oneof"`
+type ClientMessage_DropItem struct {
+	DropItem *DropItemRequest `protobuf:"bytes,23,opt,name=drop_item,json=dropItem,proto3,oneof"`
 }
 
-type ClientMessage_Aid struct {
-	Aid *AidRequest `protobuf:"bytes,83,opt,name=aid,proto3,oneof"`
+type ClientMessage_Balance struct {
+	Balance *BalanceRequest `protobuf:"bytes,24,opt,name=balance,proto3,oneof"`
 }
 
-type ClientMessage_DisarmTrap struct {
-	DisarmTrap *DisarmTrapRequest `protobuf:"bytes,84,opt,name=disarm_trap,json=disarmTrap,proto3,oneof"`
+type ClientMessage_SetRole struct {
+	SetRole *SetRoleRequest `protobuf:"bytes,25,opt,name=set_role,json=setRole,proto3,oneof"`
 }
 
-type ClientMessage_DeployTrap struct {
-	DeployTrap *DeployTrapRequest `protobuf:"bytes,85,opt,name=deploy_trap,json=deployTrap,proto3,oneof"`
+type ClientMessage_Teleport struct {
+	Teleport *TeleportRequest `protobuf:"bytes,26,opt,name=teleport,proto3,oneof"`
 }
 
-type ClientMessage_Ready struct {
-	Ready *ReadyRequest `protobuf:"bytes,86,opt,name=ready,proto3,oneof"`
+type ClientMessage_Loadout struct {
+	Loadout *LoadoutRequest `protobuf:"bytes,27,opt,name=loadout,proto3,oneof"`
 }
 
-type ClientMessage_Browse struct {
-	Browse *BrowseRequest `protobuf:"bytes,87,opt,name=browse,proto3,oneof"`
+type ClientMessage_Unequip struct {
+	Unequip *UnequipRequest `protobuf:"bytes,28,opt,name=unequip,proto3,oneof"`
 }
 
-type ClientMessage_Buy struct {
-	Buy *BuyRequest `protobuf:"bytes,88,opt,name=buy,proto3,oneof"`
+type ClientMessage_Equipment struct {
+	Equipment *EquipmentRequest `protobuf:"bytes,29,opt,name=equipment,proto3,oneof"`
 }
 
-type ClientMessage_Sell struct {
-	Sell *SellRequest `protobuf:"bytes,89,opt,name=sell,proto3,oneof"`
-}
+type ClientMessage_SwitchCharacter struct {
+	SwitchCharacter *SwitchCharacterRequest `protobuf:"bytes,30,opt,name=switch_character,json=switchCharacter,proto3,oneof"`
+}
+
+type ClientMessage_Wear struct {
+	Wear *WearRequest `protobuf:"bytes,31,opt,name=wear,proto3,oneof"`
+}
+
+type ClientMessage_RemoveArmor struct {
+	RemoveArmor *RemoveArmorRequest `protobuf:"bytes,32,opt,name=remove_armor,json=removeArmor,proto3,oneof"`
+}
+
+type ClientMessage_CharSheet struct {
+	CharSheet *CharacterSheetRequest `protobuf:"bytes,33,opt,name=char_sheet,json=charSheet,proto3,oneof"`
+}
+
+type ClientMessage_ArchetypeSelection struct {
+	ArchetypeSelection *ArchetypeSelectionRequest `protobuf:"bytes,34,opt,name=archetype_selection,json=archetypeSelection,proto3,oneof"`
+}
+
+type ClientMessage_UseEquipment struct {
+	UseEquipment *UseEquipmentRequest `protobuf:"bytes,35,opt,name=use_equipment,json=useEquipment,proto3,oneof"`
+}
+
+type ClientMessage_RoomEquip struct {
+	RoomEquip *RoomEquipRequest `protobuf:"bytes,36,opt,name=room_equip,json=roomEquip,proto3,oneof"`
+}
+
+type ClientMessage_Map struct {
+	Map *MapRequest `protobuf:"bytes,37,opt,name=map,proto3,oneof"`
+}
+
+type ClientMessage_SkillsRequest struct {
+	SkillsRequest *SkillsRequest `protobuf:"bytes,38,opt,name=skills_request,json=skillsRequest,proto3,oneof"`
+}
+
+type ClientMessage_FeatsRequest struct {
+	FeatsRequest *FeatsRequest `protobuf:"bytes,39,opt,name=feats_request,json=featsRequest,proto3,oneof"`
+}
+
+type ClientMessage_InteractRequest struct {
+	InteractRequest *InteractRequest `protobuf:"bytes,40,opt,name=interact_request,json=interactRequest,proto3,oneof"`
+}
+
+type ClientMessage_UseRequest struct {
+	UseRequest *UseRequest `protobuf:"bytes,41,opt,name=use_request,json=useRequest,proto3,oneof"`
+}
+
+type ClientMessage_ClassFeaturesRequest struct {
+	ClassFeaturesRequest *ClassFeaturesRequest `protobuf:"bytes,42,opt,name=class_features_request,json=classFeaturesRequest,proto3,oneof"`
+}
+
+type ClientMessage_SummonItem struct {
+	SummonItem *SummonItemRequest `protobuf:"bytes,43,opt,name=summon_item,json=summonItem,proto3,oneof"`
+}
+
+type ClientMessage_ProficienciesRequest struct {
+	ProficienciesRequest *ProficienciesRequest `protobuf:"bytes,44,opt,name=proficiencies_request,json=proficienciesRequest,proto3,oneof"`
+}
+
+type ClientMessage_LevelUp struct {
+	LevelUp *LevelUpRequest `protobuf:"bytes,45,opt,name=level_up,json=levelUp,proto3,oneof"`
+}
+
+type ClientMessage_CombatDefault struct {
+	CombatDefault *CombatDefaultRequest `protobuf:"bytes,46,opt,name=combat_default,json=combatDefault,proto3,oneof"`
+}
+
+type ClientMessage_TrainSkill struct {
+	TrainSkill *TrainSkillRequest `protobuf:"bytes,47,opt,name=train_skill,json=trainSkill,proto3,oneof"`
+}
+
+type ClientMessage_Action struct {
+	Action *ActionRequest `protobuf:"bytes,48,opt,name=action,proto3,oneof"`
+}
+
+type ClientMessage_RaiseShield struct {
+	RaiseShield *RaiseShieldRequest `protobuf:"bytes,49,opt,name=raise_shield,json=raiseShield,proto3,oneof"`
+}
+
+type ClientMessage_TakeCover struct {
+	TakeCover *TakeCoverRequest `protobuf:"bytes,50,opt,name=take_cover,json=takeCover,proto3,oneof"`
+}
+
+type ClientMessage_FirstAid struct {
+	FirstAid *FirstAidRequest `protobuf:"bytes,51,opt,name=first_aid,json=firstAid,proto3,oneof"`
+}
+
+type ClientMessage_Feint struct {
+	Feint *FeintRequest `protobuf:"bytes,52,opt,name=feint,proto3,oneof"`
+}
+
+type ClientMessage_Demoralize struct {
+	Demoralize *DemoralizeRequest `protobuf:"bytes,53,opt,name=demoralize,proto3,oneof"`
+}
+
+type ClientMessage_Grapple struct {
+	Grapple *GrappleRequest `protobuf:"bytes,54,opt,name=grapple,proto3,oneof"`
+}
+
+type ClientMessage_Trip struct {
+	Trip *TripRequest `protobuf:"bytes,55,opt,name=trip,proto3,oneof"`
+}
+
+type ClientMessage_Hide struct {
+	Hide *HideRequest `protobuf:"bytes,56,opt,name=hide,proto3,oneof"`
+}
+
+type ClientMessage_Sneak struct {
+	Sneak *SneakRequest `protobuf:"bytes,57,opt,name=sneak,proto3,oneof"`
+}
+
+type ClientMessage_Divert struct {
+	Divert *DivertRequest `protobuf:"bytes,58,opt,name=divert,proto3,oneof"`
+}
+
+type ClientMessage_Escape struct {
+	Escape *EscapeRequest `protobuf:"bytes,59,opt,name=escape,proto3,oneof"`
+}
+
+type ClientMessage_Grant struct {
+	Grant *GrantRequest `protobuf:"bytes,60,opt,name=grant,proto3,oneof"`
+}
+
+type ClientMessage_Disarm struct {
+	Disarm *DisarmRequest `protobuf:"bytes,61,opt,name=disarm,proto3,oneof"`
+}
+
+type ClientMessage_Stride struct {
+	Stride *StrideRequest `protobuf:"bytes,62,opt,name=stride,proto3,oneof"`
+}
+
+type ClientMessage_Shove struct {
+	Shove *ShoveRequest `protobuf:"bytes,63,opt,name=shove,proto3,oneof"`
+}
+
+type ClientMessage_Step struct {
+	Step *StepRequest `protobuf:"bytes,64,opt,name=step,proto3,oneof"`
+}
+
+type ClientMessage_Tumble struct {
+	Tumble *TumbleRequest `protobuf:"bytes,65,opt,name=tumble,proto3,oneof"`
+}
+
+type ClientMessage_Seek struct {
+	Seek *SeekRequest `protobuf:"bytes,66,opt,name=seek,proto3,oneof"`
+}
+
+type ClientMessage_Climb struct {
+	Climb *ClimbRequest `protobuf:"bytes,67,opt,name=climb,proto3,oneof"`
+}
+
+type ClientMessage_Swim struct {
+	Swim *SwimRequest `protobuf:"bytes,68,opt,name=swim,proto3,oneof"`
+}
+
+type ClientMessage_Motive struct {
+	Motive *MotiveRequest `protobuf:"bytes,69,opt,name=motive,proto3,oneof"`
+}
+
+type ClientMessage_Calm struct {
+	Calm *CalmRequest `protobuf:"bytes,70,opt,name=calm,proto3,oneof"`
+}
+
+type ClientMessage_HeroPoint struct {
+	HeroPoint *HeroPointRequest `protobuf:"bytes,71,opt,name=hero_point,json=heroPoint,proto3,oneof"`
+}
+
+type ClientMessage_Delay struct {
+	Delay *DelayRequest `protobuf:"bytes,72,opt,name=delay,proto3,oneof"`
+}
+
+type ClientMessage_Join struct {
+	Join *JoinRequest `protobuf:"bytes,73,opt,name=join,proto3,oneof"`
+}
+
+type ClientMessage_Decline struct {
+	Decline *DeclineRequest `protobuf:"bytes,74,opt,name=decline,proto3,oneof"`
+}
+
+type ClientMessage_Group struct {
+	Group *GroupRequest `protobuf:"bytes,75,opt,name=group,proto3,oneof"`
+}
+
+type ClientMessage_Invite struct {
+	Invite *InviteRequest `protobuf:"bytes,76,opt,name=invite,proto3,oneof"`
+}
+
+type ClientMessage_AcceptGroup struct {
+	AcceptGroup *AcceptGroupRequest `protobuf:"bytes,77,opt,name=accept_group,json=acceptGroup,proto3,oneof"`
+}
+
+type ClientMessage_DeclineGroup struct {
+	DeclineGroup *DeclineGroupRequest `protobuf:"bytes,78,opt,name=decline_group,json=declineGroup,proto3,oneof"`
+}
+
+type ClientMessage_Ungroup struct {
+	Ungroup *UngroupRequest `protobuf:"bytes,79,opt,name=ungroup,proto3,oneof"`
+}
+
+type ClientMessage_Kick struct {
+	Kick *KickRequest `protobuf:"bytes,80,opt,name=kick,proto3,oneof"`
+}
+
+type ClientMessage_Rest struct {
+	Rest *RestRequest `protobuf:"bytes,81,opt,name=rest,proto3,oneof"`
+}
+
+type ClientMessage_SelectTech struct {
+	SelectTech *SelectTechRequest `protobuf:"bytes,82,opt,name=select_tech,json=selectTech,proto3,oneof"`
+}
+
+type ClientMessage_Aid struct {
+	Aid *AidRequest `protobuf:"bytes,83,opt,name=aid,proto3,oneof"`
+}
+
+type ClientMessage_DisarmTrap struct {
+	DisarmTrap *DisarmTrapRequest `protobuf:"bytes,84,opt,name=disarm_trap,json=disarmTrap,proto3,oneof"`
+}
+
+type ClientMessage_DeployTrap struct {
+	DeployTrap *DeployTrapRequest `protobuf:"bytes,85,opt,name=deploy_trap,json=deployTrap,proto3,oneof"`
+}
+
+type ClientMessage_Ready struct {
+	Ready *ReadyRequest `protobuf:"bytes,86,opt,name=ready,proto3,oneof"`
+}
+
+type ClientMessage_Browse struct {
+	Browse *BrowseRequest `protobuf:"bytes,87,opt,name=browse,proto3,oneof"`
+}
+
+type ClientMessage_Buy struct {
+	Buy *BuyRequest `protobuf:"bytes,88,opt,name=buy,proto3,oneof"`
+}
+
+type ClientMessage_Sell struct {
+	Sell *SellRequest `protobuf:"bytes,89,opt,name=sell,proto3,oneof"`
+}
 
 type ClientMessage_Negotiate struct {
 	Negotiate *NegotiateRequest `protobuf:"bytes,90,opt,name=negotiate,proto3,oneof"`
@@ -2386,23 +3029,259 @@ type ClientMessage_ReactionResponse struct {
 	ReactionResponse *ReactionResponse `protobuf:"bytes,141,opt,name=reaction_response,json=reactionResponse,proto3,oneof"`
 }
 
-func (*ClientMessage_JoinWorld) isClientMessage_Payload() {}
+type ClientMessage_CombatVerbosity struct {
+	CombatVerbosity *CombatVerbosityRequest `protobuf:"bytes,142,opt,name=combat_verbosity,json=combatVerbosity,proto3,oneof"`
+}
 
-func (*ClientMessage_Move) isClientMessage_Payload() {}
+type ClientMessage_Locale struct {
+	Locale *LocaleRequest `protobuf:"bytes,143,opt,name=locale,proto3,oneof"`
+}
 
-func (*ClientMessage_Look) isClientMessage_Payload() {}
+type ClientMessage_ChatMute struct {
+	ChatMute *ChatMuteRequest `protobuf:"bytes,144,opt,name=chat_mute,json=chatMute,proto3,oneof"`
+}
 
-func (*ClientMessage_Say) isClientMessage_Payload() {}
+type ClientMessage_Audit struct {
+	Audit *AuditRequest `protobuf:"bytes,145,opt,name=audit,proto3,oneof"`
+}
 
-func (*ClientMessage_Emote) isClientMessage_Payload() {}
+type ClientMessage_Settings struct {
+	Settings *SettingsRequest `protobuf:"bytes,146,opt,name=settings,proto3,oneof"`
+}
 
-func (*ClientMessage_Who) isClientMessage_Payload() {}
+type ClientMessage_Rename struct {
+	Rename *RenameRequest `protobuf:"bytes,147,opt,name=rename,proto3,oneof"`
+}
 
-func (*ClientMessage_Exits) isClientMessage_Payload() {}
+type ClientMessage_CharacterSlots struct {
+	CharacterSlots *CharacterSlotsRequest `protobuf:"bytes,148,opt,name=character_slots,json=characterSlots,proto3,oneof"`
+}
 
-func (*ClientMessage_Quit) isClientMessage_Payload() {}
+type ClientMessage_Tutorial struct {
+	Tutorial *TutorialRequest `protobuf:"bytes,149,opt,name=tutorial,proto3,oneof"`
+}
 
-func (*ClientMessage_Examine) isClientMessage_Payload() {}
+type ClientMessage_Home struct {
+	Home *HomeRequest `protobuf:"bytes,150,opt,name=home,proto3,oneof"`
+}
+
+type ClientMessage_Clan struct {
+	Clan *ClanRequest `protobuf:"bytes,151,opt,name=clan,proto3,oneof"`
+}
+
+type ClientMessage_Achievements struct {
+	Achievements *AchievementsRequest `protobuf:"bytes,152,opt,name=achievements,proto3,oneof"`
+}
+
+type ClientMessage_Title struct {
+	Title *TitleRequest `protobuf:"bytes,153,opt,name=title,proto3,oneof"`
+}
+
+type ClientMessage_Top struct {
+	Top *TopRequest `protobuf:"bytes,154,opt,name=top,proto3,oneof"`
+}
+
+type ClientMessage_Played struct {
+	Played *PlayedRequest `protobuf:"bytes,155,opt,name=played,proto3,oneof"`
+}
+
+type ClientMessage_Afk struct {
+	Afk *AfkRequest `protobuf:"bytes,156,opt,name=afk,proto3,oneof"`
+}
+
+type ClientMessage_SiteBan struct {
+	SiteBan *SiteBanRequest `protobuf:"bytes,157,opt,name=site_ban,json=siteBan,proto3,oneof"`
+}
+
+type ClientMessage_Consider struct {
+	Consider *ConsiderRequest `protobuf:"bytes,158,opt,name=consider,proto3,oneof"`
+}
+
+type ClientMessage_Scan struct {
+	Scan *ScanRequest `protobuf:"bytes,159,opt,name=scan,proto3,oneof"`
+}
+
+type ClientMessage_Light struct {
+	Light *LightRequest `protobuf:"bytes,160,opt,name=light,proto3,oneof"`
+}
+
+type ClientMessage_Board struct {
+	Board *BoardRequest `protobuf:"bytes,161,opt,name=board,proto3,oneof"`
+}
+
+type ClientMessage_Disembark struct {
+	Disembark *DisembarkRequest `protobuf:"bytes,162,opt,name=disembark,proto3,oneof"`
+}
+
+type ClientMessage_Drive struct {
+	Drive *DriveRequest `protobuf:"bytes,163,opt,name=drive,proto3,oneof"`
+}
+
+type ClientMessage_Refuel struct {
+	Refuel *RefuelRequest `protobuf:"bytes,164,opt,name=refuel,proto3,oneof"`
+}
+
+type ClientMessage_Shout struct {
+	Shout *ShoutRequest `protobuf:"bytes,165,opt,name=shout,proto3,oneof"`
+}
+
+type ClientMessage_Yell struct {
+	Yell *YellRequest `protobuf:"bytes,166,opt,name=yell,proto3,oneof"`
+}
+
+type ClientMessage_PayFine struct {
+	PayFine *PayFineRequest `protobuf:"bytes,167,opt,name=pay_fine,json=payFine,proto3,oneof"`
+}
+
+type ClientMessage_Steal struct {
+	Steal *StealRequest `protobuf:"bytes,168,opt,name=steal,proto3,oneof"`
+}
+
+type ClientMessage_Market struct {
+	Market *MarketRequest `protobuf:"bytes,169,opt,name=market,proto3,oneof"`
+}
+
+type ClientMessage_Auction struct {
+	Auction *AuctionRequest `protobuf:"bytes,170,opt,name=auction,proto3,oneof"`
+}
+
+type ClientMessage_Pick struct {
+	Pick *PickRequest `protobuf:"bytes,171,opt,name=pick,proto3,oneof"`
+}
+
+type ClientMessage_DetectTraps struct {
+	DetectTraps *DetectTrapsRequest `protobuf:"bytes,172,opt,name=detect_traps,json=detectTraps,proto3,oneof"`
+}
+
+type ClientMessage_Log struct {
+	Log *LogRequest `protobuf:"bytes,173,opt,name=log,proto3,oneof"`
+}
+
+type ClientMessage_Report struct {
+	Report *ReportRequest `protobuf:"bytes,174,opt,name=report,proto3,oneof"`
+}
+
+type ClientMessage_Reports struct {
+	Reports *ReportsRequest `protobuf:"bytes,175,opt,name=reports,proto3,oneof"`
+}
+
+type ClientMessage_Invis struct {
+	Invis *InvisRequest `protobuf:"bytes,176,opt,name=invis,proto3,oneof"`
+}
+
+type ClientMessage_Snoop struct {
+	Snoop *SnoopRequest `protobuf:"bytes,177,opt,name=snoop,proto3,oneof"`
+}
+
+type ClientMessage_Force struct {
+	Force *ForceRequest `protobuf:"bytes,178,opt,name=force,proto3,oneof"`
+}
+
+type ClientMessage_Puppet struct {
+	Puppet *PuppetRequest `protobuf:"bytes,179,opt,name=puppet,proto3,oneof"`
+}
+
+type ClientMessage_Gm struct {
+	Gm *GMRequest `protobuf:"bytes,180,opt,name=gm,proto3,oneof"`
+}
+
+type ClientMessage_Time struct {
+	Time *TimeRequest `protobuf:"bytes,181,opt,name=time,proto3,oneof"`
+}
+
+type ClientMessage_Schedule struct {
+	Schedule *ScheduleRequest `protobuf:"bytes,182,opt,name=schedule,proto3,oneof"`
+}
+
+type ClientMessage_Engrave struct {
+	Engrave *EngraveRequest `protobuf:"bytes,183,opt,name=engrave,proto3,oneof"`
+}
+
+type ClientMessage_Dig struct {
+	Dig *DigRequest `protobuf:"bytes,184,opt,name=dig,proto3,oneof"`
+}
+
+type ClientMessage_Answer struct {
+	Answer *AnswerRequest `protobuf:"bytes,185,opt,name=answer,proto3,oneof"`
+}
+
+type ClientMessage_ReloadConfig struct {
+	ReloadConfig *ReloadConfigRequest `protobuf:"bytes,186,opt,name=reload_config,json=reloadConfig,proto3,oneof"`
+}
+
+type ClientMessage_Flag struct {
+	Flag *FlagRequest `protobuf:"bytes,187,opt,name=flag,proto3,oneof"`
+}
+
+type ClientMessage_TransferCharacter struct {
+	TransferCharacter *TransferCharacterRequest `protobuf:"bytes,188,opt,name=transfer_character,json=transferCharacter,proto3,oneof"`
+}
+
+type ClientMessage_SharedStash struct {
+	SharedStash *SharedStashRequest `protobuf:"bytes,189,opt,name=shared_stash,json=sharedStash,proto3,oneof"`
+}
+
+type ClientMessage_Parley struct {
+	Parley *ParleyRequest `protobuf:"bytes,190,opt,name=parley,proto3,oneof"`
+}
+
+type ClientMessage_Social struct {
+	Social *SocialRequest `protobuf:"bytes,191,opt,name=social,proto3,oneof"`
+}
+
+type ClientMessage_Restrain struct {
+	Restrain *RestrainRequest `protobuf:"bytes,192,opt,name=restrain,proto3,oneof"`
+}
+
+type ClientMessage_Nonlethal struct {
+	Nonlethal *NonlethalRequest `protobuf:"bytes,193,opt,name=nonlethal,proto3,oneof"`
+}
+
+type ClientMessage_Practice struct {
+	Practice *PracticeRequest `protobuf:"bytes,194,opt,name=practice,proto3,oneof"`
+}
+
+type ClientMessage_Recap struct {
+	Recap *RecapRequest `protobuf:"bytes,195,opt,name=recap,proto3,oneof"`
+}
+
+type ClientMessage_Formation struct {
+	Formation *FormationRequest `protobuf:"bytes,196,opt,name=formation,proto3,oneof"`
+}
+
+type ClientMessage_Order struct {
+	Order *OrderRequest `protobuf:"bytes,197,opt,name=order,proto3,oneof"`
+}
+
+type ClientMessage_JobAbility struct {
+	JobAbility *JobAbilityRequest `protobuf:"bytes,198,opt,name=job_ability,json=jobAbility,proto3,oneof"`
+}
+
+type ClientMessage_Respec struct {
+	Respec *RespecRequest `protobuf:"bytes,199,opt,name=respec,proto3,oneof"`
+}
+
+type ClientMessage_ExportCharacter struct {
+	ExportCharacter *ExportCharacterRequest `protobuf:"bytes,200,opt,name=export_character,json=exportCharacter,proto3,oneof"`
+}
+
+func (*ClientMessage_JoinWorld) isClientMessage_Payload() {}
+
+func (*ClientMessage_Move) isClientMessage_Payload() {}
+
+func (*ClientMessage_Look) isClientMessage_Payload() {}
+
+func (*ClientMessage_Say) isClientMessage_Payload() {}
+
+func (*ClientMessage_Emote) isClientMessage_Payload() {}
+
+func (*ClientMessage_Who) isClientMessage_Payload() {}
+
+func (*ClientMessage_Exits) isClientMessage_Payload() {}
+
+func (*ClientMessage_Quit) isClientMessage_Payload() {}
+
+func (*ClientMessage_Examine) isClientMessage_Payload() {}
 
 func (*ClientMessage_Attack) isClientMessage_Payload() {}
 
@@ -2666,6 +3545,124 @@ func (*ClientMessage_MoveTo) isClientMessage_Payload() {}
 
 func (*ClientMessage_ReactionResponse) isClientMessage_Payload() {}
 
+func (*ClientMessage_CombatVerbosity) isClientMessage_Payload() {}
+
+func (*ClientMessage_Locale) isClientMessage_Payload() {}
+
+func (*ClientMessage_ChatMute) isClientMessage_Payload() {}
+
+func (*ClientMessage_Audit) isClientMessage_Payload() {}
+
+func (*ClientMessage_Settings) isClientMessage_Payload() {}
+
+func (*ClientMessage_Rename) isClientMessage_Payload() {}
+
+func (*ClientMessage_CharacterSlots) isClientMessage_Payload() {}
+
+func (*ClientMessage_Tutorial) isClientMessage_Payload() {}
+
+func (*ClientMessage_Home) isClientMessage_Payload() {}
+
+func (*ClientMessage_Clan) isClientMessage_Payload() {}
+
+func (*ClientMessage_Achievements) isClientMessage_Payload() {}
+
+func (*ClientMessage_Title) isClientMessage_Payload() {}
+
+func (*ClientMessage_Top) isClientMessage_Payload() {}
+
+func (*ClientMessage_Played) isClientMessage_Payload() {}
+
+func (*ClientMessage_Afk) isClientMessage_Payload() {}
+
+func (*ClientMessage_SiteBan) isClientMessage_Payload() {}
+
+func (*ClientMessage_Consider) isClientMessage_Payload() {}
+
+func (*ClientMessage_Scan) isClientMessage_Payload() {}
+
+func (*ClientMessage_Light) isClientMessage_Payload() {}
+
+func (*ClientMessage_Board) isClientMessage_Payload() {}
+
+func (*ClientMessage_Disembark) isClientMessage_Payload() {}
+
+func (*ClientMessage_Drive) isClientMessage_Payload() {}
+
+func (*ClientMessage_Refuel) isClientMessage_Payload() {}
+
+func (*ClientMessage_Shout) isClientMessage_Payload() {}
+
+func (*ClientMessage_Yell) isClientMessage_Payload() {}
+
+func (*ClientMessage_PayFine) isClientMessage_Payload() {}
+
+func (*ClientMessage_Steal) isClientMessage_Payload() {}
+
+func (*ClientMessage_Market) isClientMessage_Payload() {}
+
+func (*ClientMessage_Auction) isClientMessage_Payload() {}
+
+func (*ClientMessage_Pick) isClientMessage_Payload() {}
+
+func (*ClientMessage_DetectTraps) isClientMessage_Payload() {}
+
+func (*ClientMessage_Log) isClientMessage_Payload() {}
+
+func (*ClientMessage_Report) isClientMessage_Payload() {}
+
+func (*ClientMessage_Reports) isClientMessage_Payload() {}
+
+func (*ClientMessage_Invis) isClientMessage_Payload() {}
+
+func (*ClientMessage_Snoop) isClientMessage_Payload() {}
+
+func (*ClientMessage_Force) isClientMessage_Payload() {}
+
+func (*ClientMessage_Puppet) isClientMessage_Payload() {}
+
+func (*ClientMessage_Gm) isClientMessage_Payload() {}
+
+func (*ClientMessage_Time) isClientMessage_Payload() {}
+
+func (*ClientMessage_Schedule) isClientMessage_Payload() {}
+
+func (*ClientMessage_Engrave) isClientMessage_Payload() {}
+
+func (*ClientMessage_Dig) isClientMessage_Payload() {}
+
+func (*ClientMessage_Answer) isClientMessage_Payload() {}
+
+func (*ClientMessage_ReloadConfig) isClientMessage_Payload() {}
+
+func (*ClientMessage_Flag) isClientMessage_Payload() {}
+
+func (*ClientMessage_TransferCharacter) isClientMessage_Payload() {}
+
+func (*ClientMessage_SharedStash) isClientMessage_Payload() {}
+
+func (*ClientMessage_Parley) isClientMessage_Payload() {}
+
+func (*ClientMessage_Social) isClientMessage_Payload() {}
+
+func (*ClientMessage_Restrain) isClientMessage_Payload() {}
+
+func (*ClientMessage_Nonlethal) isClientMessage_Payload() {}
+
+func (*ClientMessage_Practice) isClientMessage_Payload() {}
+
+func (*ClientMessage_Recap) isClientMessage_Payload() {}
+
+func (*ClientMessage_Formation) isClientMessage_Payload() {}
+
+func (*ClientMessage_Order) isClientMessage_Payload() {}
+
+func (*ClientMessage_JobAbility) isClientMessage_Payload() {}
+
+func (*ClientMessage_Respec) isClientMessage_Payload() {}
+
+func (*ClientMessage_ExportCharacter) isClientMessage_Payload() {}
+
 // UncoverRequest asks the server to drop the player's current cover condition.
 type UncoverRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -4064,6 +5061,10 @@ type ServerEvent struct {
 	//	*ServerEvent_TechTrainerView
 	//	*ServerEvent_GameConfig
 	//	*ServerEvent_ReactionPrompt
+	//	*ServerEvent_TitleUpdate
+	//	*ServerEvent_AccountSettings
+	//	*ServerEvent_CombatState
+	//	*ServerEvent_RoomPeek
 	Payload       isServerEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -4491,6 +5492,42 @@ func (x *ServerEvent) GetReactionPrompt() *ReactionPromptEvent {
 	return nil
 }
 
+func (x *ServerEvent) GetTitleUpdate() *TitleEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerEvent_TitleUpdate); ok {
+			return x.TitleUpdate
+		}
+	}
+	return nil
+}
+
+func (x *ServerEvent) GetAccountSettings() *AccountSettings {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerEvent_AccountSettings); ok {
+			return x.AccountSettings
+		}
+	}
+	return nil
+}
+
+func (x *ServerEvent) GetCombatState() *CombatStateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerEvent_CombatState); ok {
+			return x.CombatState
+		}
+	}
+	return nil
+}
+
+func (x *ServerEvent) GetRoomPeek() *RoomPeekView {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerEvent_RoomPeek); ok {
+			return x.RoomPeek
+		}
+	}
+	return nil
+}
+
 type isServerEvent_Payload interface {
 	isServerEvent_Payload()
 }
@@ -4663,6 +5700,22 @@ type ServerEvent_ReactionPrompt struct {
 	ReactionPrompt *ReactionPromptEvent `protobuf:"bytes,43,opt,name=reaction_prompt,json=reactionPrompt,proto3,oneof"`
 }
 
+type ServerEvent_TitleUpdate struct {
+	TitleUpdate *TitleEvent `protobuf:"bytes,44,opt,name=title_update,json=titleUpdate,proto3,oneof"`
+}
+
+type ServerEvent_AccountSettings struct {
+	AccountSettings *AccountSettings `protobuf:"bytes,45,opt,name=account_settings,json=accountSettings,proto3,oneof"`
+}
+
+type ServerEvent_CombatState struct {
+	CombatState *CombatStateEvent `protobuf:"bytes,46,opt,name=combat_state,json=combatState,proto3,oneof"`
+}
+
+type ServerEvent_RoomPeek struct {
+	RoomPeek *RoomPeekView `protobuf:"bytes,47,opt,name=room_peek,json=roomPeek,proto3,oneof"`
+}
+
 func (*ServerEvent_RoomView) isServerEvent_Payload() {}
 
 func (*ServerEvent_Message) isServerEvent_Payload() {}
@@ -4747,6 +5800,14 @@ func (*ServerEvent_GameConfig) isServerEvent_Payload() {}
 
 func (*ServerEvent_ReactionPrompt) isServerEvent_Payload() {}
 
+func (*ServerEvent_TitleUpdate) isServerEvent_Payload() {}
+
+func (*ServerEvent_AccountSettings) isServerEvent_Payload() {}
+
+func (*ServerEvent_CombatState) isServerEvent_Payload() {}
+
+func (*ServerEvent_RoomPeek) isServerEvent_Payload() {}
+
 // ReactionPromptEvent is sent when the server needs the player to decide whether
 // to spend their reaction. The player responds with ReactionResponse (matching
 // prompt_id). The server honours ctx timeout independently; if no response
@@ -4941,8 +6002,11 @@ type ShopItem struct {
 	ArmorProfCategory string `protobuf:"bytes,16,opt,name=armor_prof_category,json=armorProfCategory,proto3" json:"armor_prof_category,omitempty"`
 	// Consumable stats (populated when kind == "consumable").
 	EffectsSummary string `protobuf:"bytes,17,opt,name=effects_summary,json=effectsSummary,proto3" json:"effects_summary,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Why the player cannot buy this yet (e.g. a reputation requirement);
+	// empty when the item is for sale to them.
+	LockedReason  string `protobuf:"bytes,18,opt,name=locked_reason,json=lockedReason,proto3" json:"locked_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ShopItem) Reset() {
@@ -5094,6 +6158,13 @@ func (x *ShopItem) GetEffectsSummary() string {
 	return ""
 }
 
+func (x *ShopItem) GetLockedReason() string {
+	if x != nil {
+		return x.LockedReason
+	}
+	return ""
+}
+
 // ShopView delivers structured merchant inventory in response to BrowseRequest.
 type ShopView struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5217,42 +6288,29 @@ func (x *HpUpdateEvent) GetMaxFocusPoints() int32 {
 	return 0
 }
 
-// JoinWorldRequest is sent by the client to enter the game world after authentication.
-type JoinWorldRequest struct {
+// TitleEvent delivers the player's displayed title to refresh the prompt bar.
+// No console text is displayed; only the prompt is redrawn.
+type TitleEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	CharacterId   int64                  `protobuf:"varint,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	CharacterName string                 `protobuf:"bytes,4,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
-	CurrentHp     int32                  `protobuf:"varint,5,opt,name=current_hp,json=currentHp,proto3" json:"current_hp,omitempty"`
-	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Role          string                 `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
-	RegionDisplay string                 `protobuf:"bytes,8,opt,name=region_display,json=regionDisplay,proto3" json:"region_display,omitempty"`
-	Class         string                 `protobuf:"bytes,9,opt,name=class,proto3" json:"class,omitempty"`
-	Level         int32                  `protobuf:"varint,10,opt,name=level,proto3" json:"level,omitempty"`
-	Archetype     string                 `protobuf:"bytes,11,opt,name=archetype,proto3" json:"archetype,omitempty"`
-	// headless indicates the session is driven by an automated client (e.g. e2e tests).
-	// When true, the server must auto-select the first valid option for all interactive
-	// prompts rather than blocking on stream.Recv().
-	Headless      bool `protobuf:"varint,12,opt,name=headless,proto3" json:"headless,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinWorldRequest) Reset() {
-	*x = JoinWorldRequest{}
+func (x *TitleEvent) Reset() {
+	*x = TitleEvent{}
 	mi := &file_game_v1_game_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinWorldRequest) String() string {
+func (x *TitleEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinWorldRequest) ProtoMessage() {}
+func (*TitleEvent) ProtoMessage() {}
 
-func (x *JoinWorldRequest) ProtoReflect() protoreflect.Message {
+func (x *TitleEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_game_v1_game_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5264,24 +6322,85 @@ func (x *JoinWorldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinWorldRequest.ProtoReflect.Descriptor instead.
-func (*JoinWorldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TitleEvent.ProtoReflect.Descriptor instead.
+func (*TitleEvent) Descriptor() ([]byte, []int) {
 	return file_game_v1_game_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *JoinWorldRequest) GetUid() string {
+func (x *TitleEvent) GetTitle() string {
 	if x != nil {
-		return x.Uid
+		return x.Title
 	}
 	return ""
 }
 
-func (x *JoinWorldRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
+// JoinWorldRequest is sent by the client to enter the game world after authentication.
+type JoinWorldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CharacterId   int64                  `protobuf:"varint,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	CharacterName string                 `protobuf:"bytes,4,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	CurrentHp     int32                  `protobuf:"varint,5,opt,name=current_hp,json=currentHp,proto3" json:"current_hp,omitempty"`
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Role          string                 `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	RegionDisplay string                 `protobuf:"bytes,8,opt,name=region_display,json=regionDisplay,proto3" json:"region_display,omitempty"`
+	Class         string                 `protobuf:"bytes,9,opt,name=class,proto3" json:"class,omitempty"`
+	Level         int32                  `protobuf:"varint,10,opt,name=level,proto3" json:"level,omitempty"`
+	Archetype     string                 `protobuf:"bytes,11,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	// headless indicates the session is driven by an automated client (e.g. e2e tests).
+	// When true, the server must auto-select the first valid option for all interactive
+	// prompts rather than blocking on stream.Recv().
+	Headless bool `protobuf:"varint,12,opt,name=headless,proto3" json:"headless,omitempty"`
+	// settings carries the account-level preferences loaded by the frontend at login.
+	Settings      *AccountSettings `protobuf:"bytes,13,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWorldRequest) Reset() {
+	*x = JoinWorldRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWorldRequest) ProtoMessage() {}
+
+func (x *JoinWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWorldRequest.ProtoReflect.Descriptor instead.
+func (*JoinWorldRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *JoinWorldRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *JoinWorldRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
 
 func (x *JoinWorldRequest) GetCharacterId() int64 {
 	if x != nil {
@@ -5353,6 +6472,98 @@ func (x *JoinWorldRequest) GetHeadless() bool {
 	return false
 }
 
+func (x *JoinWorldRequest) GetSettings() *AccountSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// AccountSettings holds account-level preferences shared by all of an account's characters.
+type AccountSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ColorTheme      string                 `protobuf:"bytes,1,opt,name=color_theme,json=colorTheme,proto3" json:"color_theme,omitempty"`                // "default", "dark", "light", or "high_contrast"; empty = default
+	CombatVerbosity string                 `protobuf:"bytes,2,opt,name=combat_verbosity,json=combatVerbosity,proto3" json:"combat_verbosity,omitempty"` // "brief", "normal", or "verbose"; empty = per-character setting
+	AccessibleMode  bool                   `protobuf:"varint,3,opt,name=accessible_mode,json=accessibleMode,proto3" json:"accessible_mode,omitempty"`
+	MutedChannels   []string               `protobuf:"bytes,4,rep,name=muted_channels,json=mutedChannels,proto3" json:"muted_channels,omitempty"` // chat channels not delivered, e.g. "say", "emote"
+	PageLength      int32                  `protobuf:"varint,5,opt,name=page_length,json=pageLength,proto3" json:"page_length,omitempty"`         // lines per --More-- page; 0 = terminal height, -1 = off
+	PromptFormat    string                 `protobuf:"bytes,6,opt,name=prompt_format,json=promptFormat,proto3" json:"prompt_format,omitempty"`    // custom telnet prompt with %-tokens; empty = default prompt
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccountSettings) Reset() {
+	*x = AccountSettings{}
+	mi := &file_game_v1_game_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSettings) ProtoMessage() {}
+
+func (x *AccountSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSettings.ProtoReflect.Descriptor instead.
+func (*AccountSettings) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AccountSettings) GetColorTheme() string {
+	if x != nil {
+		return x.ColorTheme
+	}
+	return ""
+}
+
+func (x *AccountSettings) GetCombatVerbosity() string {
+	if x != nil {
+		return x.CombatVerbosity
+	}
+	return ""
+}
+
+func (x *AccountSettings) GetAccessibleMode() bool {
+	if x != nil {
+		return x.AccessibleMode
+	}
+	return false
+}
+
+func (x *AccountSettings) GetMutedChannels() []string {
+	if x != nil {
+		return x.MutedChannels
+	}
+	return nil
+}
+
+func (x *AccountSettings) GetPageLength() int32 {
+	if x != nil {
+		return x.PageLength
+	}
+	return 0
+}
+
+func (x *AccountSettings) GetPromptFormat() string {
+	if x != nil {
+		return x.PromptFormat
+	}
+	return ""
+}
+
 // MoveRequest asks the server to move the player in the given direction.
 type MoveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5363,7 +6574,7 @@ type MoveRequest struct {
 
 func (x *MoveRequest) Reset() {
 	*x = MoveRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[38]
+	mi := &file_game_v1_game_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5375,7 +6586,7 @@ func (x *MoveRequest) String() string {
 func (*MoveRequest) ProtoMessage() {}
 
 func (x *MoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[38]
+	mi := &file_game_v1_game_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5388,7 +6599,7 @@ func (x *MoveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
 func (*MoveRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{38}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *MoveRequest) GetDirection() string {
@@ -5401,13 +6612,14 @@ func (x *MoveRequest) GetDirection() string {
 // LookRequest asks the server for the current room description.
 type LookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"` // peek through this exit; empty looks at the current room
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LookRequest) Reset() {
 	*x = LookRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[39]
+	mi := &file_game_v1_game_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5419,7 +6631,7 @@ func (x *LookRequest) String() string {
 func (*LookRequest) ProtoMessage() {}
 
 func (x *LookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[39]
+	mi := &file_game_v1_game_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5432,7 +6644,14 @@ func (x *LookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookRequest.ProtoReflect.Descriptor instead.
 func (*LookRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{39}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LookRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
 }
 
 // SayRequest sends a chat message to all players in the same room.
@@ -5445,7 +6664,7 @@ type SayRequest struct {
 
 func (x *SayRequest) Reset() {
 	*x = SayRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[40]
+	mi := &file_game_v1_game_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5457,7 +6676,7 @@ func (x *SayRequest) String() string {
 func (*SayRequest) ProtoMessage() {}
 
 func (x *SayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[40]
+	mi := &file_game_v1_game_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5470,7 +6689,7 @@ func (x *SayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SayRequest.ProtoReflect.Descriptor instead.
 func (*SayRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{40}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SayRequest) GetMessage() string {
@@ -5490,7 +6709,7 @@ type EmoteRequest struct {
 
 func (x *EmoteRequest) Reset() {
 	*x = EmoteRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[41]
+	mi := &file_game_v1_game_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5502,7 +6721,7 @@ func (x *EmoteRequest) String() string {
 func (*EmoteRequest) ProtoMessage() {}
 
 func (x *EmoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[41]
+	mi := &file_game_v1_game_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5515,7 +6734,7 @@ func (x *EmoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmoteRequest.ProtoReflect.Descriptor instead.
 func (*EmoteRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{41}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *EmoteRequest) GetAction() string {
@@ -5534,7 +6753,7 @@ type WhoRequest struct {
 
 func (x *WhoRequest) Reset() {
 	*x = WhoRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[42]
+	mi := &file_game_v1_game_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5546,7 +6765,7 @@ func (x *WhoRequest) String() string {
 func (*WhoRequest) ProtoMessage() {}
 
 func (x *WhoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[42]
+	mi := &file_game_v1_game_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5559,7 +6778,7 @@ func (x *WhoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhoRequest.ProtoReflect.Descriptor instead.
 func (*WhoRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{42}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{44}
 }
 
 // ExitsRequest asks for the list of exits from the current room.
@@ -5571,7 +6790,7 @@ type ExitsRequest struct {
 
 func (x *ExitsRequest) Reset() {
 	*x = ExitsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[43]
+	mi := &file_game_v1_game_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5583,7 +6802,7 @@ func (x *ExitsRequest) String() string {
 func (*ExitsRequest) ProtoMessage() {}
 
 func (x *ExitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[43]
+	mi := &file_game_v1_game_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5596,7 +6815,7 @@ func (x *ExitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExitsRequest.ProtoReflect.Descriptor instead.
 func (*ExitsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{43}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{45}
 }
 
 // QuitRequest signals the client wants to disconnect.
@@ -5608,7 +6827,7 @@ type QuitRequest struct {
 
 func (x *QuitRequest) Reset() {
 	*x = QuitRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[44]
+	mi := &file_game_v1_game_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5620,7 +6839,7 @@ func (x *QuitRequest) String() string {
 func (*QuitRequest) ProtoMessage() {}
 
 func (x *QuitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[44]
+	mi := &file_game_v1_game_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5633,7 +6852,7 @@ func (x *QuitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuitRequest.ProtoReflect.Descriptor instead.
 func (*QuitRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{44}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{46}
 }
 
 // SwitchCharacterRequest asks the server to save and remove the current character
@@ -5646,7 +6865,7 @@ type SwitchCharacterRequest struct {
 
 func (x *SwitchCharacterRequest) Reset() {
 	*x = SwitchCharacterRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[45]
+	mi := &file_game_v1_game_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5658,7 +6877,7 @@ func (x *SwitchCharacterRequest) String() string {
 func (*SwitchCharacterRequest) ProtoMessage() {}
 
 func (x *SwitchCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[45]
+	mi := &file_game_v1_game_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5671,7 +6890,7 @@ func (x *SwitchCharacterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchCharacterRequest.ProtoReflect.Descriptor instead.
 func (*SwitchCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{45}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{47}
 }
 
 // RoomView describes the player's current room.
@@ -5689,13 +6908,18 @@ type RoomView struct {
 	Period           string                 `protobuf:"bytes,10,opt,name=period,proto3" json:"period,omitempty"`
 	Equipment        []*RoomEquipmentItem   `protobuf:"bytes,11,rep,name=equipment,proto3" json:"equipment,omitempty"`
 	ZoneName         string                 `protobuf:"bytes,12,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Dark             bool                   `protobuf:"varint,13,opt,name=dark,proto3" json:"dark,omitempty"` // too dark to see; description, NPC names, and items are hidden
+	Vehicles         []*VehicleInfo         `protobuf:"bytes,14,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	CombatRound      int32                  `protobuf:"varint,15,opt,name=combat_round,json=combatRound,proto3" json:"combat_round,omitempty"` // round of the combat under way here; 0 when none
+	Combatants       []string               `protobuf:"bytes,16,rep,name=combatants,proto3" json:"combatants,omitempty"`                       // living combatants in turn order while combat_round > 0
+	RoomEffects      []string               `protobuf:"bytes,17,rep,name=room_effects,json=roomEffects,proto3" json:"room_effects,omitempty"`  // lingering area effects on the floor of the fight, e.g. "burning ground (2 rounds)"
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RoomView) Reset() {
 	*x = RoomView{}
-	mi := &file_game_v1_game_proto_msgTypes[46]
+	mi := &file_game_v1_game_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5707,7 +6931,7 @@ func (x *RoomView) String() string {
 func (*RoomView) ProtoMessage() {}
 
 func (x *RoomView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[46]
+	mi := &file_game_v1_game_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5720,7 +6944,7 @@ func (x *RoomView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomView.ProtoReflect.Descriptor instead.
 func (*RoomView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{46}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *RoomView) GetRoomId() string {
@@ -5807,33 +7031,69 @@ func (x *RoomView) GetZoneName() string {
 	return ""
 }
 
-// ExitInfo describes a single exit from a room.
-type ExitInfo struct {
+func (x *RoomView) GetDark() bool {
+	if x != nil {
+		return x.Dark
+	}
+	return false
+}
+
+func (x *RoomView) GetVehicles() []*VehicleInfo {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *RoomView) GetCombatRound() int32 {
+	if x != nil {
+		return x.CombatRound
+	}
+	return 0
+}
+
+func (x *RoomView) GetCombatants() []string {
+	if x != nil {
+		return x.Combatants
+	}
+	return nil
+}
+
+func (x *RoomView) GetRoomEffects() []string {
+	if x != nil {
+		return x.RoomEffects
+	}
+	return nil
+}
+
+// VehicleInfo describes a vehicle parked or travelling in a room.
+type VehicleInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
-	TargetRoomId  string                 `protobuf:"bytes,2,opt,name=target_room_id,json=targetRoomId,proto3" json:"target_room_id,omitempty"`
-	Locked        bool                   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
-	Hidden        bool                   `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	TargetTitle   string                 `protobuf:"bytes,5,opt,name=target_title,json=targetTitle,proto3" json:"target_title,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`           // "car" or "boat"
+	Condition     string                 `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"` // pristine, dented, battered, smoking, or wrecked
+	Passengers    int32                  `protobuf:"varint,5,opt,name=passengers,proto3" json:"passengers,omitempty"`
+	Seats         int32                  `protobuf:"varint,6,opt,name=seats,proto3" json:"seats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExitInfo) Reset() {
-	*x = ExitInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[47]
+func (x *VehicleInfo) Reset() {
+	*x = VehicleInfo{}
+	mi := &file_game_v1_game_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExitInfo) String() string {
+func (x *VehicleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExitInfo) ProtoMessage() {}
+func (*VehicleInfo) ProtoMessage() {}
 
-func (x *ExitInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[47]
+func (x *VehicleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5844,49 +7104,210 @@ func (x *ExitInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExitInfo.ProtoReflect.Descriptor instead.
-func (*ExitInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use VehicleInfo.ProtoReflect.Descriptor instead.
+func (*VehicleInfo) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ExitInfo) GetDirection() string {
+func (x *VehicleInfo) GetInstanceId() string {
 	if x != nil {
-		return x.Direction
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *ExitInfo) GetTargetRoomId() string {
+func (x *VehicleInfo) GetName() string {
 	if x != nil {
-		return x.TargetRoomId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExitInfo) GetLocked() bool {
+func (x *VehicleInfo) GetKind() string {
 	if x != nil {
-		return x.Locked
+		return x.Kind
 	}
-	return false
+	return ""
 }
 
-func (x *ExitInfo) GetHidden() bool {
+func (x *VehicleInfo) GetCondition() string {
 	if x != nil {
-		return x.Hidden
+		return x.Condition
 	}
-	return false
+	return ""
 }
 
-func (x *ExitInfo) GetTargetTitle() string {
+func (x *VehicleInfo) GetPassengers() int32 {
 	if x != nil {
-		return x.TargetTitle
+		return x.Passengers
 	}
-	return ""
+	return 0
 }
 
-// MessageEvent delivers a chat message or emote from another player.
-type MessageEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *VehicleInfo) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+// RoomPeekView describes an adjacent room seen through an open exit.
+type RoomPeekView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // first sentence of the room description
+	Players       []string               `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	Npcs          []*NpcInfo             `protobuf:"bytes,5,rep,name=npcs,proto3" json:"npcs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomPeekView) Reset() {
+	*x = RoomPeekView{}
+	mi := &file_game_v1_game_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomPeekView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomPeekView) ProtoMessage() {}
+
+func (x *RoomPeekView) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomPeekView.ProtoReflect.Descriptor instead.
+func (*RoomPeekView) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RoomPeekView) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *RoomPeekView) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RoomPeekView) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoomPeekView) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *RoomPeekView) GetNpcs() []*NpcInfo {
+	if x != nil {
+		return x.Npcs
+	}
+	return nil
+}
+
+// ExitInfo describes a single exit from a room.
+type ExitInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	TargetRoomId  string                 `protobuf:"bytes,2,opt,name=target_room_id,json=targetRoomId,proto3" json:"target_room_id,omitempty"`
+	Locked        bool                   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	Hidden        bool                   `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	TargetTitle   string                 `protobuf:"bytes,5,opt,name=target_title,json=targetTitle,proto3" json:"target_title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExitInfo) Reset() {
+	*x = ExitInfo{}
+	mi := &file_game_v1_game_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitInfo) ProtoMessage() {}
+
+func (x *ExitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitInfo.ProtoReflect.Descriptor instead.
+func (*ExitInfo) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExitInfo) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ExitInfo) GetTargetRoomId() string {
+	if x != nil {
+		return x.TargetRoomId
+	}
+	return ""
+}
+
+func (x *ExitInfo) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *ExitInfo) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *ExitInfo) GetTargetTitle() string {
+	if x != nil {
+		return x.TargetTitle
+	}
+	return ""
+}
+
+// MessageEvent delivers a chat message or emote from another player.
+type MessageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sender        string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Type          MessageType            `protobuf:"varint,3,opt,name=type,proto3,enum=game.v1.MessageType" json:"type,omitempty"`
@@ -5896,7 +7317,7 @@ type MessageEvent struct {
 
 func (x *MessageEvent) Reset() {
 	*x = MessageEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[48]
+	mi := &file_game_v1_game_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5908,7 +7329,7 @@ func (x *MessageEvent) String() string {
 func (*MessageEvent) ProtoMessage() {}
 
 func (x *MessageEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[48]
+	mi := &file_game_v1_game_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5921,7 +7342,7 @@ func (x *MessageEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageEvent.ProtoReflect.Descriptor instead.
 func (*MessageEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{48}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *MessageEvent) GetSender() string {
@@ -5957,7 +7378,7 @@ type RoomEvent struct {
 
 func (x *RoomEvent) Reset() {
 	*x = RoomEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[49]
+	mi := &file_game_v1_game_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5969,7 +7390,7 @@ func (x *RoomEvent) String() string {
 func (*RoomEvent) ProtoMessage() {}
 
 func (x *RoomEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[49]
+	mi := &file_game_v1_game_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5982,7 +7403,7 @@ func (x *RoomEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
 func (*RoomEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{49}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *RoomEvent) GetPlayer() string {
@@ -6017,7 +7438,7 @@ type PlayerList struct {
 
 func (x *PlayerList) Reset() {
 	*x = PlayerList{}
-	mi := &file_game_v1_game_proto_msgTypes[50]
+	mi := &file_game_v1_game_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6029,7 +7450,7 @@ func (x *PlayerList) String() string {
 func (*PlayerList) ProtoMessage() {}
 
 func (x *PlayerList) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[50]
+	mi := &file_game_v1_game_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6042,7 +7463,7 @@ func (x *PlayerList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerList.ProtoReflect.Descriptor instead.
 func (*PlayerList) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{50}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *PlayerList) GetRoomTitle() string {
@@ -6067,13 +7488,16 @@ type PlayerInfo struct {
 	Job           string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
 	HealthLabel   string                 `protobuf:"bytes,4,opt,name=health_label,json=healthLabel,proto3" json:"health_label,omitempty"`
 	Status        CombatStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=game.v1.CombatStatus" json:"status,omitempty"`
+	ClanTag       string                 `protobuf:"bytes,6,opt,name=clan_tag,json=clanTag,proto3" json:"clan_tag,omitempty"`
+	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Afk           bool                   `protobuf:"varint,8,opt,name=afk,proto3" json:"afk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerInfo) Reset() {
 	*x = PlayerInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[51]
+	mi := &file_game_v1_game_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6085,7 +7509,7 @@ func (x *PlayerInfo) String() string {
 func (*PlayerInfo) ProtoMessage() {}
 
 func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[51]
+	mi := &file_game_v1_game_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6098,7 +7522,7 @@ func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
 func (*PlayerInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{51}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *PlayerInfo) GetName() string {
@@ -6136,6 +7560,27 @@ func (x *PlayerInfo) GetStatus() CombatStatus {
 	return CombatStatus_COMBAT_STATUS_UNSPECIFIED
 }
 
+func (x *PlayerInfo) GetClanTag() string {
+	if x != nil {
+		return x.ClanTag
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetAfk() bool {
+	if x != nil {
+		return x.Afk
+	}
+	return false
+}
+
 // ExitList contains the exits from the current room.
 type ExitList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -6146,7 +7591,7 @@ type ExitList struct {
 
 func (x *ExitList) Reset() {
 	*x = ExitList{}
-	mi := &file_game_v1_game_proto_msgTypes[52]
+	mi := &file_game_v1_game_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6158,7 +7603,7 @@ func (x *ExitList) String() string {
 func (*ExitList) ProtoMessage() {}
 
 func (x *ExitList) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[52]
+	mi := &file_game_v1_game_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6171,7 +7616,7 @@ func (x *ExitList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExitList.ProtoReflect.Descriptor instead.
 func (*ExitList) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{52}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ExitList) GetExits() []*ExitInfo {
@@ -6191,7 +7636,7 @@ type ErrorEvent struct {
 
 func (x *ErrorEvent) Reset() {
 	*x = ErrorEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[53]
+	mi := &file_game_v1_game_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6203,7 +7648,7 @@ func (x *ErrorEvent) String() string {
 func (*ErrorEvent) ProtoMessage() {}
 
 func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[53]
+	mi := &file_game_v1_game_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6216,7 +7661,7 @@ func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorEvent.ProtoReflect.Descriptor instead.
 func (*ErrorEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{53}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ErrorEvent) GetMessage() string {
@@ -6236,7 +7681,7 @@ type Disconnected struct {
 
 func (x *Disconnected) Reset() {
 	*x = Disconnected{}
-	mi := &file_game_v1_game_proto_msgTypes[54]
+	mi := &file_game_v1_game_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6248,7 +7693,7 @@ func (x *Disconnected) String() string {
 func (*Disconnected) ProtoMessage() {}
 
 func (x *Disconnected) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[54]
+	mi := &file_game_v1_game_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6261,7 +7706,7 @@ func (x *Disconnected) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Disconnected.ProtoReflect.Descriptor instead.
 func (*Disconnected) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{54}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *Disconnected) GetReason() string {
@@ -6284,7 +7729,7 @@ type TimeOfDayEvent struct {
 
 func (x *TimeOfDayEvent) Reset() {
 	*x = TimeOfDayEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[55]
+	mi := &file_game_v1_game_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6296,7 +7741,7 @@ func (x *TimeOfDayEvent) String() string {
 func (*TimeOfDayEvent) ProtoMessage() {}
 
 func (x *TimeOfDayEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[55]
+	mi := &file_game_v1_game_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6309,7 +7754,7 @@ func (x *TimeOfDayEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeOfDayEvent.ProtoReflect.Descriptor instead.
 func (*TimeOfDayEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{55}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TimeOfDayEvent) GetHour() int32 {
@@ -6342,28 +7787,34 @@ func (x *TimeOfDayEvent) GetMonth() int32 {
 
 // CharacterInfo is sent to the client on session join to display character stats.
 type CharacterInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CharacterId   int64                  `protobuf:"varint,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
-	Class         string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
-	Level         int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
-	Experience    int32                  `protobuf:"varint,6,opt,name=experience,proto3" json:"experience,omitempty"`
-	MaxHp         int32                  `protobuf:"varint,7,opt,name=max_hp,json=maxHp,proto3" json:"max_hp,omitempty"`
-	CurrentHp     int32                  `protobuf:"varint,8,opt,name=current_hp,json=currentHp,proto3" json:"current_hp,omitempty"`
-	Brutality     int32                  `protobuf:"varint,9,opt,name=brutality,proto3" json:"brutality,omitempty"`
-	Quickness     int32                  `protobuf:"varint,10,opt,name=quickness,proto3" json:"quickness,omitempty"`
-	Grit          int32                  `protobuf:"varint,11,opt,name=grit,proto3" json:"grit,omitempty"`
-	Reasoning     int32                  `protobuf:"varint,12,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
-	Savvy         int32                  `protobuf:"varint,13,opt,name=savvy,proto3" json:"savvy,omitempty"`
-	Flair         int32                  `protobuf:"varint,14,opt,name=flair,proto3" json:"flair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId int64                  `protobuf:"varint,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region      string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Class       string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
+	Level       int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
+	Experience  int32                  `protobuf:"varint,6,opt,name=experience,proto3" json:"experience,omitempty"`
+	MaxHp       int32                  `protobuf:"varint,7,opt,name=max_hp,json=maxHp,proto3" json:"max_hp,omitempty"`
+	CurrentHp   int32                  `protobuf:"varint,8,opt,name=current_hp,json=currentHp,proto3" json:"current_hp,omitempty"`
+	Brutality   int32                  `protobuf:"varint,9,opt,name=brutality,proto3" json:"brutality,omitempty"`
+	Quickness   int32                  `protobuf:"varint,10,opt,name=quickness,proto3" json:"quickness,omitempty"`
+	Grit        int32                  `protobuf:"varint,11,opt,name=grit,proto3" json:"grit,omitempty"`
+	Reasoning   int32                  `protobuf:"varint,12,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	Savvy       int32                  `protobuf:"varint,13,opt,name=savvy,proto3" json:"savvy,omitempty"`
+	Flair       int32                  `protobuf:"varint,14,opt,name=flair,proto3" json:"flair,omitempty"`
+	Afk         bool                   `protobuf:"varint,15,opt,name=afk,proto3" json:"afk,omitempty"`
+	AfkMessage  string                 `protobuf:"bytes,16,opt,name=afk_message,json=afkMessage,proto3" json:"afk_message,omitempty"`
+	// health_description is set when another player examines this character:
+	// a wound descriptor, or "current/max HP" in accessible mode. Frontends
+	// show it in place of the raw hit points.
+	HealthDescription string `protobuf:"bytes,17,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CharacterInfo) Reset() {
 	*x = CharacterInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[56]
+	mi := &file_game_v1_game_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6375,7 +7826,7 @@ func (x *CharacterInfo) String() string {
 func (*CharacterInfo) ProtoMessage() {}
 
 func (x *CharacterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[56]
+	mi := &file_game_v1_game_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6388,7 +7839,7 @@ func (x *CharacterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterInfo.ProtoReflect.Descriptor instead.
 func (*CharacterInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{56}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CharacterInfo) GetCharacterId() int64 {
@@ -6489,6 +7940,27 @@ func (x *CharacterInfo) GetFlair() int32 {
 	return 0
 }
 
+func (x *CharacterInfo) GetAfk() bool {
+	if x != nil {
+		return x.Afk
+	}
+	return false
+}
+
+func (x *CharacterInfo) GetAfkMessage() string {
+	if x != nil {
+		return x.AfkMessage
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetHealthDescription() string {
+	if x != nil {
+		return x.HealthDescription
+	}
+	return ""
+}
+
 // NpcInfo summarises an NPC visible in the room.
 type NpcInfo struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -6505,7 +7977,7 @@ type NpcInfo struct {
 
 func (x *NpcInfo) Reset() {
 	*x = NpcInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[57]
+	mi := &file_game_v1_game_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6517,7 +7989,7 @@ func (x *NpcInfo) String() string {
 func (*NpcInfo) ProtoMessage() {}
 
 func (x *NpcInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[57]
+	mi := &file_game_v1_game_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6530,7 +8002,7 @@ func (x *NpcInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NpcInfo.ProtoReflect.Descriptor instead.
 func (*NpcInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{57}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *NpcInfo) GetInstanceId() string {
@@ -6592,7 +8064,7 @@ type ExamineRequest struct {
 
 func (x *ExamineRequest) Reset() {
 	*x = ExamineRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[58]
+	mi := &file_game_v1_game_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6604,7 +8076,7 @@ func (x *ExamineRequest) String() string {
 func (*ExamineRequest) ProtoMessage() {}
 
 func (x *ExamineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[58]
+	mi := &file_game_v1_game_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6617,7 +8089,7 @@ func (x *ExamineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExamineRequest.ProtoReflect.Descriptor instead.
 func (*ExamineRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{58}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ExamineRequest) GetTarget() string {
@@ -6642,7 +8114,7 @@ type NpcView struct {
 
 func (x *NpcView) Reset() {
 	*x = NpcView{}
-	mi := &file_game_v1_game_proto_msgTypes[59]
+	mi := &file_game_v1_game_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6654,7 +8126,7 @@ func (x *NpcView) String() string {
 func (*NpcView) ProtoMessage() {}
 
 func (x *NpcView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[59]
+	mi := &file_game_v1_game_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6667,7 +8139,7 @@ func (x *NpcView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NpcView.ProtoReflect.Descriptor instead.
 func (*NpcView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{59}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *NpcView) GetInstanceId() string {
@@ -6730,7 +8202,7 @@ type HealerView struct {
 
 func (x *HealerView) Reset() {
 	*x = HealerView{}
-	mi := &file_game_v1_game_proto_msgTypes[60]
+	mi := &file_game_v1_game_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6742,7 +8214,7 @@ func (x *HealerView) String() string {
 func (*HealerView) ProtoMessage() {}
 
 func (x *HealerView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[60]
+	mi := &file_game_v1_game_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6755,7 +8227,7 @@ func (x *HealerView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealerView.ProtoReflect.Descriptor instead.
 func (*HealerView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{60}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *HealerView) GetNpcName() string {
@@ -6836,7 +8308,7 @@ type JobOfferEntry struct {
 
 func (x *JobOfferEntry) Reset() {
 	*x = JobOfferEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[61]
+	mi := &file_game_v1_game_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6848,7 +8320,7 @@ func (x *JobOfferEntry) String() string {
 func (*JobOfferEntry) ProtoMessage() {}
 
 func (x *JobOfferEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[61]
+	mi := &file_game_v1_game_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6861,7 +8333,7 @@ func (x *JobOfferEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobOfferEntry.ProtoReflect.Descriptor instead.
 func (*JobOfferEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{61}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *JobOfferEntry) GetJobId() string {
@@ -6919,7 +8391,7 @@ type TrainerView struct {
 
 func (x *TrainerView) Reset() {
 	*x = TrainerView{}
-	mi := &file_game_v1_game_proto_msgTypes[62]
+	mi := &file_game_v1_game_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6931,7 +8403,7 @@ func (x *TrainerView) String() string {
 func (*TrainerView) ProtoMessage() {}
 
 func (x *TrainerView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[62]
+	mi := &file_game_v1_game_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6944,7 +8416,7 @@ func (x *TrainerView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrainerView.ProtoReflect.Descriptor instead.
 func (*TrainerView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{62}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *TrainerView) GetNpcName() string {
@@ -6988,7 +8460,7 @@ type TechTrainerView struct {
 
 func (x *TechTrainerView) Reset() {
 	*x = TechTrainerView{}
-	mi := &file_game_v1_game_proto_msgTypes[63]
+	mi := &file_game_v1_game_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7000,7 +8472,7 @@ func (x *TechTrainerView) String() string {
 func (*TechTrainerView) ProtoMessage() {}
 
 func (x *TechTrainerView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[63]
+	mi := &file_game_v1_game_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7013,7 +8485,7 @@ func (x *TechTrainerView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TechTrainerView.ProtoReflect.Descriptor instead.
 func (*TechTrainerView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{63}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TechTrainerView) GetNpcName() string {
@@ -7058,7 +8530,7 @@ type TechOfferEntry struct {
 
 func (x *TechOfferEntry) Reset() {
 	*x = TechOfferEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[64]
+	mi := &file_game_v1_game_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7070,7 +8542,7 @@ func (x *TechOfferEntry) String() string {
 func (*TechOfferEntry) ProtoMessage() {}
 
 func (x *TechOfferEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[64]
+	mi := &file_game_v1_game_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7083,7 +8555,7 @@ func (x *TechOfferEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TechOfferEntry.ProtoReflect.Descriptor instead.
 func (*TechOfferEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{64}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *TechOfferEntry) GetTechId() string {
@@ -7137,7 +8609,7 @@ type FixerView struct {
 
 func (x *FixerView) Reset() {
 	*x = FixerView{}
-	mi := &file_game_v1_game_proto_msgTypes[65]
+	mi := &file_game_v1_game_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7149,7 +8621,7 @@ func (x *FixerView) String() string {
 func (*FixerView) ProtoMessage() {}
 
 func (x *FixerView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[65]
+	mi := &file_game_v1_game_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7162,7 +8634,7 @@ func (x *FixerView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixerView.ProtoReflect.Descriptor instead.
 func (*FixerView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{65}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *FixerView) GetNpcName() string {
@@ -7224,7 +8696,7 @@ type RestView struct {
 
 func (x *RestView) Reset() {
 	*x = RestView{}
-	mi := &file_game_v1_game_proto_msgTypes[66]
+	mi := &file_game_v1_game_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7236,7 +8708,7 @@ func (x *RestView) String() string {
 func (*RestView) ProtoMessage() {}
 
 func (x *RestView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[66]
+	mi := &file_game_v1_game_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7249,7 +8721,7 @@ func (x *RestView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestView.ProtoReflect.Descriptor instead.
 func (*RestView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{66}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *RestView) GetNpcName() string {
@@ -7311,7 +8783,7 @@ type AttackRequest struct {
 
 func (x *AttackRequest) Reset() {
 	*x = AttackRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[67]
+	mi := &file_game_v1_game_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7323,7 +8795,7 @@ func (x *AttackRequest) String() string {
 func (*AttackRequest) ProtoMessage() {}
 
 func (x *AttackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[67]
+	mi := &file_game_v1_game_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7336,7 +8808,7 @@ func (x *AttackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttackRequest.ProtoReflect.Descriptor instead.
 func (*AttackRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{67}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *AttackRequest) GetTarget() string {
@@ -7355,7 +8827,7 @@ type FleeRequest struct {
 
 func (x *FleeRequest) Reset() {
 	*x = FleeRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[68]
+	mi := &file_game_v1_game_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7367,7 +8839,7 @@ func (x *FleeRequest) String() string {
 func (*FleeRequest) ProtoMessage() {}
 
 func (x *FleeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[68]
+	mi := &file_game_v1_game_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7380,7 +8852,7 @@ func (x *FleeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FleeRequest.ProtoReflect.Descriptor instead.
 func (*FleeRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{68}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{72}
 }
 
 // PassRequest forfeits the player's remaining action points for the current round.
@@ -7392,7 +8864,7 @@ type PassRequest struct {
 
 func (x *PassRequest) Reset() {
 	*x = PassRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[69]
+	mi := &file_game_v1_game_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7404,7 +8876,7 @@ func (x *PassRequest) String() string {
 func (*PassRequest) ProtoMessage() {}
 
 func (x *PassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[69]
+	mi := &file_game_v1_game_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7417,7 +8889,7 @@ func (x *PassRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PassRequest.ProtoReflect.Descriptor instead.
 func (*PassRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{69}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{73}
 }
 
 // StrikeRequest queues a 2-AP full attack routine (two attacks with MAP) against a target.
@@ -7430,7 +8902,7 @@ type StrikeRequest struct {
 
 func (x *StrikeRequest) Reset() {
 	*x = StrikeRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[70]
+	mi := &file_game_v1_game_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7442,7 +8914,7 @@ func (x *StrikeRequest) String() string {
 func (*StrikeRequest) ProtoMessage() {}
 
 func (x *StrikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[70]
+	mi := &file_game_v1_game_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7455,7 +8927,7 @@ func (x *StrikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StrikeRequest.ProtoReflect.Descriptor instead.
 func (*StrikeRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{70}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *StrikeRequest) GetTarget() string {
@@ -7477,7 +8949,7 @@ type EquipRequest struct {
 
 func (x *EquipRequest) Reset() {
 	*x = EquipRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[71]
+	mi := &file_game_v1_game_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7489,7 +8961,7 @@ func (x *EquipRequest) String() string {
 func (*EquipRequest) ProtoMessage() {}
 
 func (x *EquipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[71]
+	mi := &file_game_v1_game_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7502,7 +8974,7 @@ func (x *EquipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EquipRequest.ProtoReflect.Descriptor instead.
 func (*EquipRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{71}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *EquipRequest) GetWeaponId() string {
@@ -7536,7 +9008,7 @@ type ReloadRequest struct {
 
 func (x *ReloadRequest) Reset() {
 	*x = ReloadRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[72]
+	mi := &file_game_v1_game_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7548,7 +9020,7 @@ func (x *ReloadRequest) String() string {
 func (*ReloadRequest) ProtoMessage() {}
 
 func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[72]
+	mi := &file_game_v1_game_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7561,7 +9033,7 @@ func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReloadRequest.ProtoReflect.Descriptor instead.
 func (*ReloadRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{72}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ReloadRequest) GetWeaponId() string {
@@ -7582,7 +9054,7 @@ type FireBurstRequest struct {
 
 func (x *FireBurstRequest) Reset() {
 	*x = FireBurstRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[73]
+	mi := &file_game_v1_game_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7594,7 +9066,7 @@ func (x *FireBurstRequest) String() string {
 func (*FireBurstRequest) ProtoMessage() {}
 
 func (x *FireBurstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[73]
+	mi := &file_game_v1_game_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7607,7 +9079,7 @@ func (x *FireBurstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FireBurstRequest.ProtoReflect.Descriptor instead.
 func (*FireBurstRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{73}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *FireBurstRequest) GetTarget() string {
@@ -7635,7 +9107,7 @@ type FireAutomaticRequest struct {
 
 func (x *FireAutomaticRequest) Reset() {
 	*x = FireAutomaticRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[74]
+	mi := &file_game_v1_game_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7647,7 +9119,7 @@ func (x *FireAutomaticRequest) String() string {
 func (*FireAutomaticRequest) ProtoMessage() {}
 
 func (x *FireAutomaticRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[74]
+	mi := &file_game_v1_game_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7660,7 +9132,7 @@ func (x *FireAutomaticRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FireAutomaticRequest.ProtoReflect.Descriptor instead.
 func (*FireAutomaticRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{74}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *FireAutomaticRequest) GetTarget() string {
@@ -7687,7 +9159,7 @@ type ThrowRequest struct {
 
 func (x *ThrowRequest) Reset() {
 	*x = ThrowRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[75]
+	mi := &file_game_v1_game_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7699,7 +9171,7 @@ func (x *ThrowRequest) String() string {
 func (*ThrowRequest) ProtoMessage() {}
 
 func (x *ThrowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[75]
+	mi := &file_game_v1_game_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7712,7 +9184,7 @@ func (x *ThrowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ThrowRequest.ProtoReflect.Descriptor instead.
 func (*ThrowRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{75}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ThrowRequest) GetExplosiveId() string {
@@ -7731,7 +9203,7 @@ type InventoryRequest struct {
 
 func (x *InventoryRequest) Reset() {
 	*x = InventoryRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[76]
+	mi := &file_game_v1_game_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7743,7 +9215,7 @@ func (x *InventoryRequest) String() string {
 func (*InventoryRequest) ProtoMessage() {}
 
 func (x *InventoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[76]
+	mi := &file_game_v1_game_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7756,7 +9228,7 @@ func (x *InventoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryRequest.ProtoReflect.Descriptor instead.
 func (*InventoryRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{76}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{80}
 }
 
 // GetItemRequest asks the server to pick up an item from the room floor.
@@ -7769,7 +9241,7 @@ type GetItemRequest struct {
 
 func (x *GetItemRequest) Reset() {
 	*x = GetItemRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[77]
+	mi := &file_game_v1_game_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7781,7 +9253,7 @@ func (x *GetItemRequest) String() string {
 func (*GetItemRequest) ProtoMessage() {}
 
 func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[77]
+	mi := &file_game_v1_game_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7794,7 +9266,7 @@ func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
 func (*GetItemRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{77}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetItemRequest) GetTarget() string {
@@ -7814,7 +9286,7 @@ type DropItemRequest struct {
 
 func (x *DropItemRequest) Reset() {
 	*x = DropItemRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[78]
+	mi := &file_game_v1_game_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7826,7 +9298,7 @@ func (x *DropItemRequest) String() string {
 func (*DropItemRequest) ProtoMessage() {}
 
 func (x *DropItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[78]
+	mi := &file_game_v1_game_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7839,7 +9311,7 @@ func (x *DropItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropItemRequest.ProtoReflect.Descriptor instead.
 func (*DropItemRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{78}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DropItemRequest) GetTarget() string {
@@ -7858,7 +9330,7 @@ type BalanceRequest struct {
 
 func (x *BalanceRequest) Reset() {
 	*x = BalanceRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[79]
+	mi := &file_game_v1_game_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7870,7 +9342,7 @@ func (x *BalanceRequest) String() string {
 func (*BalanceRequest) ProtoMessage() {}
 
 func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[79]
+	mi := &file_game_v1_game_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7883,7 +9355,7 @@ func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
 func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{79}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{83}
 }
 
 // SetRoleRequest asks the server to change a player's privilege level.
@@ -7897,7 +9369,7 @@ type SetRoleRequest struct {
 
 func (x *SetRoleRequest) Reset() {
 	*x = SetRoleRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[80]
+	mi := &file_game_v1_game_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7909,7 +9381,7 @@ func (x *SetRoleRequest) String() string {
 func (*SetRoleRequest) ProtoMessage() {}
 
 func (x *SetRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[80]
+	mi := &file_game_v1_game_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7922,7 +9394,7 @@ func (x *SetRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetRoleRequest.ProtoReflect.Descriptor instead.
 func (*SetRoleRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{80}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *SetRoleRequest) GetTargetUsername() string {
@@ -7950,7 +9422,7 @@ type LoadoutRequest struct {
 
 func (x *LoadoutRequest) Reset() {
 	*x = LoadoutRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[81]
+	mi := &file_game_v1_game_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7962,7 +9434,7 @@ func (x *LoadoutRequest) String() string {
 func (*LoadoutRequest) ProtoMessage() {}
 
 func (x *LoadoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[81]
+	mi := &file_game_v1_game_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7975,7 +9447,7 @@ func (x *LoadoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadoutRequest.ProtoReflect.Descriptor instead.
 func (*LoadoutRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{81}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *LoadoutRequest) GetArg() string {
@@ -7998,7 +9470,7 @@ type LoadoutWeaponPreset struct {
 
 func (x *LoadoutWeaponPreset) Reset() {
 	*x = LoadoutWeaponPreset{}
-	mi := &file_game_v1_game_proto_msgTypes[82]
+	mi := &file_game_v1_game_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8010,7 +9482,7 @@ func (x *LoadoutWeaponPreset) String() string {
 func (*LoadoutWeaponPreset) ProtoMessage() {}
 
 func (x *LoadoutWeaponPreset) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[82]
+	mi := &file_game_v1_game_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8023,7 +9495,7 @@ func (x *LoadoutWeaponPreset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadoutWeaponPreset.ProtoReflect.Descriptor instead.
 func (*LoadoutWeaponPreset) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{82}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *LoadoutWeaponPreset) GetMainHand() string {
@@ -8066,7 +9538,7 @@ type LoadoutView struct {
 
 func (x *LoadoutView) Reset() {
 	*x = LoadoutView{}
-	mi := &file_game_v1_game_proto_msgTypes[83]
+	mi := &file_game_v1_game_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8078,7 +9550,7 @@ func (x *LoadoutView) String() string {
 func (*LoadoutView) ProtoMessage() {}
 
 func (x *LoadoutView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[83]
+	mi := &file_game_v1_game_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8091,7 +9563,7 @@ func (x *LoadoutView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadoutView.ProtoReflect.Descriptor instead.
 func (*LoadoutView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{83}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *LoadoutView) GetPresets() []*LoadoutWeaponPreset {
@@ -8121,7 +9593,7 @@ type QuestObjectiveView struct {
 
 func (x *QuestObjectiveView) Reset() {
 	*x = QuestObjectiveView{}
-	mi := &file_game_v1_game_proto_msgTypes[84]
+	mi := &file_game_v1_game_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8133,7 +9605,7 @@ func (x *QuestObjectiveView) String() string {
 func (*QuestObjectiveView) ProtoMessage() {}
 
 func (x *QuestObjectiveView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[84]
+	mi := &file_game_v1_game_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8146,7 +9618,7 @@ func (x *QuestObjectiveView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestObjectiveView.ProtoReflect.Descriptor instead.
 func (*QuestObjectiveView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{84}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *QuestObjectiveView) GetId() string {
@@ -8198,7 +9670,7 @@ type QuestEntryView struct {
 
 func (x *QuestEntryView) Reset() {
 	*x = QuestEntryView{}
-	mi := &file_game_v1_game_proto_msgTypes[85]
+	mi := &file_game_v1_game_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8210,7 +9682,7 @@ func (x *QuestEntryView) String() string {
 func (*QuestEntryView) ProtoMessage() {}
 
 func (x *QuestEntryView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[85]
+	mi := &file_game_v1_game_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8223,7 +9695,7 @@ func (x *QuestEntryView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestEntryView.ProtoReflect.Descriptor instead.
 func (*QuestEntryView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{85}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *QuestEntryView) GetQuestId() string {
@@ -8295,7 +9767,7 @@ type QuestGiverView struct {
 
 func (x *QuestGiverView) Reset() {
 	*x = QuestGiverView{}
-	mi := &file_game_v1_game_proto_msgTypes[86]
+	mi := &file_game_v1_game_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8307,7 +9779,7 @@ func (x *QuestGiverView) String() string {
 func (*QuestGiverView) ProtoMessage() {}
 
 func (x *QuestGiverView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[86]
+	mi := &file_game_v1_game_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8320,7 +9792,7 @@ func (x *QuestGiverView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestGiverView.ProtoReflect.Descriptor instead.
 func (*QuestGiverView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{86}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *QuestGiverView) GetNpcName() string {
@@ -8355,7 +9827,7 @@ type QuestLogView struct {
 
 func (x *QuestLogView) Reset() {
 	*x = QuestLogView{}
-	mi := &file_game_v1_game_proto_msgTypes[87]
+	mi := &file_game_v1_game_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8367,7 +9839,7 @@ func (x *QuestLogView) String() string {
 func (*QuestLogView) ProtoMessage() {}
 
 func (x *QuestLogView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[87]
+	mi := &file_game_v1_game_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8380,7 +9852,7 @@ func (x *QuestLogView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestLogView.ProtoReflect.Descriptor instead.
 func (*QuestLogView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{87}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *QuestLogView) GetQuests() []*QuestEntryView {
@@ -8405,7 +9877,7 @@ type QuestCompleteEvent struct {
 
 func (x *QuestCompleteEvent) Reset() {
 	*x = QuestCompleteEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[88]
+	mi := &file_game_v1_game_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8417,7 +9889,7 @@ func (x *QuestCompleteEvent) String() string {
 func (*QuestCompleteEvent) ProtoMessage() {}
 
 func (x *QuestCompleteEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[88]
+	mi := &file_game_v1_game_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8430,7 +9902,7 @@ func (x *QuestCompleteEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestCompleteEvent.ProtoReflect.Descriptor instead.
 func (*QuestCompleteEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{88}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *QuestCompleteEvent) GetQuestId() string {
@@ -8477,7 +9949,7 @@ type QuestLogRequest struct {
 
 func (x *QuestLogRequest) Reset() {
 	*x = QuestLogRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[89]
+	mi := &file_game_v1_game_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8489,7 +9961,7 @@ func (x *QuestLogRequest) String() string {
 func (*QuestLogRequest) ProtoMessage() {}
 
 func (x *QuestLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[89]
+	mi := &file_game_v1_game_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8502,7 +9974,7 @@ func (x *QuestLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestLogRequest.ProtoReflect.Descriptor instead.
 func (*QuestLogRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{89}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{93}
 }
 
 // UnequipRequest removes the item in the named slot and returns it to the backpack.
@@ -8515,7 +9987,7 @@ type UnequipRequest struct {
 
 func (x *UnequipRequest) Reset() {
 	*x = UnequipRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[90]
+	mi := &file_game_v1_game_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8527,7 +9999,7 @@ func (x *UnequipRequest) String() string {
 func (*UnequipRequest) ProtoMessage() {}
 
 func (x *UnequipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[90]
+	mi := &file_game_v1_game_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8540,7 +10012,7 @@ func (x *UnequipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnequipRequest.ProtoReflect.Descriptor instead.
 func (*UnequipRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{90}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *UnequipRequest) GetSlot() string {
@@ -8559,7 +10031,7 @@ type EquipmentRequest struct {
 
 func (x *EquipmentRequest) Reset() {
 	*x = EquipmentRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[91]
+	mi := &file_game_v1_game_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8571,7 +10043,7 @@ func (x *EquipmentRequest) String() string {
 func (*EquipmentRequest) ProtoMessage() {}
 
 func (x *EquipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[91]
+	mi := &file_game_v1_game_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8584,7 +10056,7 @@ func (x *EquipmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EquipmentRequest.ProtoReflect.Descriptor instead.
 func (*EquipmentRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{91}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{95}
 }
 
 // TeleportRequest asks the server to teleport a player to a specific room.
@@ -8598,7 +10070,7 @@ type TeleportRequest struct {
 
 func (x *TeleportRequest) Reset() {
 	*x = TeleportRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[92]
+	mi := &file_game_v1_game_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8610,7 +10082,7 @@ func (x *TeleportRequest) String() string {
 func (*TeleportRequest) ProtoMessage() {}
 
 func (x *TeleportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[92]
+	mi := &file_game_v1_game_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8623,7 +10095,7 @@ func (x *TeleportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeleportRequest.ProtoReflect.Descriptor instead.
 func (*TeleportRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{92}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *TeleportRequest) GetTargetCharacter() string {
@@ -8651,7 +10123,7 @@ type SummonItemRequest struct {
 
 func (x *SummonItemRequest) Reset() {
 	*x = SummonItemRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[93]
+	mi := &file_game_v1_game_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8663,7 +10135,7 @@ func (x *SummonItemRequest) String() string {
 func (*SummonItemRequest) ProtoMessage() {}
 
 func (x *SummonItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[93]
+	mi := &file_game_v1_game_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8676,7 +10148,7 @@ func (x *SummonItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummonItemRequest.ProtoReflect.Descriptor instead.
 func (*SummonItemRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{93}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *SummonItemRequest) GetItemId() string {
@@ -8705,7 +10177,7 @@ type FloorItem struct {
 
 func (x *FloorItem) Reset() {
 	*x = FloorItem{}
-	mi := &file_game_v1_game_proto_msgTypes[94]
+	mi := &file_game_v1_game_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8717,7 +10189,7 @@ func (x *FloorItem) String() string {
 func (*FloorItem) ProtoMessage() {}
 
 func (x *FloorItem) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[94]
+	mi := &file_game_v1_game_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8730,7 +10202,7 @@ func (x *FloorItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloorItem.ProtoReflect.Descriptor instead.
 func (*FloorItem) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{94}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *FloorItem) GetInstanceId() string {
@@ -8769,7 +10241,7 @@ type RoomEquipmentItem struct {
 
 func (x *RoomEquipmentItem) Reset() {
 	*x = RoomEquipmentItem{}
-	mi := &file_game_v1_game_proto_msgTypes[95]
+	mi := &file_game_v1_game_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8781,7 +10253,7 @@ func (x *RoomEquipmentItem) String() string {
 func (*RoomEquipmentItem) ProtoMessage() {}
 
 func (x *RoomEquipmentItem) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[95]
+	mi := &file_game_v1_game_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8794,7 +10266,7 @@ func (x *RoomEquipmentItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomEquipmentItem.ProtoReflect.Descriptor instead.
 func (*RoomEquipmentItem) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{95}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *RoomEquipmentItem) GetInstanceId() string {
@@ -8849,7 +10321,7 @@ type UseEquipmentRequest struct {
 
 func (x *UseEquipmentRequest) Reset() {
 	*x = UseEquipmentRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[96]
+	mi := &file_game_v1_game_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8861,7 +10333,7 @@ func (x *UseEquipmentRequest) String() string {
 func (*UseEquipmentRequest) ProtoMessage() {}
 
 func (x *UseEquipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[96]
+	mi := &file_game_v1_game_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8874,7 +10346,7 @@ func (x *UseEquipmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseEquipmentRequest.ProtoReflect.Descriptor instead.
 func (*UseEquipmentRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{96}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *UseEquipmentRequest) GetInstanceId() string {
@@ -8894,7 +10366,7 @@ type MapRequest struct {
 
 func (x *MapRequest) Reset() {
 	*x = MapRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[97]
+	mi := &file_game_v1_game_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8906,7 +10378,7 @@ func (x *MapRequest) String() string {
 func (*MapRequest) ProtoMessage() {}
 
 func (x *MapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[97]
+	mi := &file_game_v1_game_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8919,7 +10391,7 @@ func (x *MapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapRequest.ProtoReflect.Descriptor instead.
 func (*MapRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{97}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *MapRequest) GetView() string {
@@ -8940,7 +10412,7 @@ type PoiWithNpc struct {
 
 func (x *PoiWithNpc) Reset() {
 	*x = PoiWithNpc{}
-	mi := &file_game_v1_game_proto_msgTypes[98]
+	mi := &file_game_v1_game_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8952,7 +10424,7 @@ func (x *PoiWithNpc) String() string {
 func (*PoiWithNpc) ProtoMessage() {}
 
 func (x *PoiWithNpc) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[98]
+	mi := &file_game_v1_game_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8965,7 +10437,7 @@ func (x *PoiWithNpc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoiWithNpc.ProtoReflect.Descriptor instead.
 func (*PoiWithNpc) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{98}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *PoiWithNpc) GetPoiId() string {
@@ -8994,7 +10466,7 @@ type ZoneExitInfo struct {
 
 func (x *ZoneExitInfo) Reset() {
 	*x = ZoneExitInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[99]
+	mi := &file_game_v1_game_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9006,7 +10478,7 @@ func (x *ZoneExitInfo) String() string {
 func (*ZoneExitInfo) ProtoMessage() {}
 
 func (x *ZoneExitInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[99]
+	mi := &file_game_v1_game_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9019,7 +10491,7 @@ func (x *ZoneExitInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneExitInfo.ProtoReflect.Descriptor instead.
 func (*ZoneExitInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{99}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ZoneExitInfo) GetDirection() string {
@@ -9054,7 +10526,7 @@ type SameZoneExitTarget struct {
 
 func (x *SameZoneExitTarget) Reset() {
 	*x = SameZoneExitTarget{}
-	mi := &file_game_v1_game_proto_msgTypes[100]
+	mi := &file_game_v1_game_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9066,7 +10538,7 @@ func (x *SameZoneExitTarget) String() string {
 func (*SameZoneExitTarget) ProtoMessage() {}
 
 func (x *SameZoneExitTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[100]
+	mi := &file_game_v1_game_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9079,7 +10551,7 @@ func (x *SameZoneExitTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SameZoneExitTarget.ProtoReflect.Descriptor instead.
 func (*SameZoneExitTarget) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{100}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *SameZoneExitTarget) GetDirection() string {
@@ -9118,7 +10590,7 @@ type MapTile struct {
 
 func (x *MapTile) Reset() {
 	*x = MapTile{}
-	mi := &file_game_v1_game_proto_msgTypes[101]
+	mi := &file_game_v1_game_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9130,7 +10602,7 @@ func (x *MapTile) String() string {
 func (*MapTile) ProtoMessage() {}
 
 func (x *MapTile) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[101]
+	mi := &file_game_v1_game_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9143,7 +10615,7 @@ func (x *MapTile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapTile.ProtoReflect.Descriptor instead.
 func (*MapTile) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{101}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *MapTile) GetRoomId() string {
@@ -9257,7 +10729,7 @@ type WorldZoneTile struct {
 
 func (x *WorldZoneTile) Reset() {
 	*x = WorldZoneTile{}
-	mi := &file_game_v1_game_proto_msgTypes[102]
+	mi := &file_game_v1_game_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9269,7 +10741,7 @@ func (x *WorldZoneTile) String() string {
 func (*WorldZoneTile) ProtoMessage() {}
 
 func (x *WorldZoneTile) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[102]
+	mi := &file_game_v1_game_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9282,7 +10754,7 @@ func (x *WorldZoneTile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldZoneTile.ProtoReflect.Descriptor instead.
 func (*WorldZoneTile) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{102}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *WorldZoneTile) GetZoneId() string {
@@ -9372,7 +10844,7 @@ type GameConfig struct {
 
 func (x *GameConfig) Reset() {
 	*x = GameConfig{}
-	mi := &file_game_v1_game_proto_msgTypes[103]
+	mi := &file_game_v1_game_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9384,7 +10856,7 @@ func (x *GameConfig) String() string {
 func (*GameConfig) ProtoMessage() {}
 
 func (x *GameConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[103]
+	mi := &file_game_v1_game_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9397,7 +10869,7 @@ func (x *GameConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
 func (*GameConfig) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{103}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GameConfig) GetAutoNavStepMs() int32 {
@@ -9419,7 +10891,7 @@ type MapResponse struct {
 
 func (x *MapResponse) Reset() {
 	*x = MapResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[104]
+	mi := &file_game_v1_game_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9431,7 +10903,7 @@ func (x *MapResponse) String() string {
 func (*MapResponse) ProtoMessage() {}
 
 func (x *MapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[104]
+	mi := &file_game_v1_game_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9444,7 +10916,7 @@ func (x *MapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapResponse.ProtoReflect.Descriptor instead.
 func (*MapResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{104}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *MapResponse) GetTiles() []*MapTile {
@@ -9470,7 +10942,7 @@ type SkillsRequest struct {
 
 func (x *SkillsRequest) Reset() {
 	*x = SkillsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[105]
+	mi := &file_game_v1_game_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9482,7 +10954,7 @@ func (x *SkillsRequest) String() string {
 func (*SkillsRequest) ProtoMessage() {}
 
 func (x *SkillsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[105]
+	mi := &file_game_v1_game_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9495,7 +10967,7 @@ func (x *SkillsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkillsRequest.ProtoReflect.Descriptor instead.
 func (*SkillsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{105}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{109}
 }
 
 // SkillEntry represents one skill with its proficiency rank.
@@ -9513,7 +10985,7 @@ type SkillEntry struct {
 
 func (x *SkillEntry) Reset() {
 	*x = SkillEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[106]
+	mi := &file_game_v1_game_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9525,7 +10997,7 @@ func (x *SkillEntry) String() string {
 func (*SkillEntry) ProtoMessage() {}
 
 func (x *SkillEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[106]
+	mi := &file_game_v1_game_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9538,7 +11010,7 @@ func (x *SkillEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkillEntry.ProtoReflect.Descriptor instead.
 func (*SkillEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{106}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *SkillEntry) GetSkillId() string {
@@ -9593,7 +11065,7 @@ type SkillsResponse struct {
 
 func (x *SkillsResponse) Reset() {
 	*x = SkillsResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[107]
+	mi := &file_game_v1_game_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9605,7 +11077,7 @@ func (x *SkillsResponse) String() string {
 func (*SkillsResponse) ProtoMessage() {}
 
 func (x *SkillsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[107]
+	mi := &file_game_v1_game_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9618,7 +11090,7 @@ func (x *SkillsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkillsResponse.ProtoReflect.Descriptor instead.
 func (*SkillsResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{107}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *SkillsResponse) GetSkills() []*SkillEntry {
@@ -9643,7 +11115,7 @@ type RoomEquipRequest struct {
 
 func (x *RoomEquipRequest) Reset() {
 	*x = RoomEquipRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[108]
+	mi := &file_game_v1_game_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9655,7 +11127,7 @@ func (x *RoomEquipRequest) String() string {
 func (*RoomEquipRequest) ProtoMessage() {}
 
 func (x *RoomEquipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[108]
+	mi := &file_game_v1_game_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9668,7 +11140,7 @@ func (x *RoomEquipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomEquipRequest.ProtoReflect.Descriptor instead.
 func (*RoomEquipRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{108}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *RoomEquipRequest) GetSubCommand() string {
@@ -9733,7 +11205,7 @@ type InventoryItem struct {
 
 func (x *InventoryItem) Reset() {
 	*x = InventoryItem{}
-	mi := &file_game_v1_game_proto_msgTypes[109]
+	mi := &file_game_v1_game_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9745,7 +11217,7 @@ func (x *InventoryItem) String() string {
 func (*InventoryItem) ProtoMessage() {}
 
 func (x *InventoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[109]
+	mi := &file_game_v1_game_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9758,7 +11230,7 @@ func (x *InventoryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
 func (*InventoryItem) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{109}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *InventoryItem) GetInstanceId() string {
@@ -9847,7 +11319,7 @@ type InventoryView struct {
 
 func (x *InventoryView) Reset() {
 	*x = InventoryView{}
-	mi := &file_game_v1_game_proto_msgTypes[110]
+	mi := &file_game_v1_game_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9859,7 +11331,7 @@ func (x *InventoryView) String() string {
 func (*InventoryView) ProtoMessage() {}
 
 func (x *InventoryView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[110]
+	mi := &file_game_v1_game_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9872,7 +11344,7 @@ func (x *InventoryView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryView.ProtoReflect.Descriptor instead.
 func (*InventoryView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{110}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *InventoryView) GetItems() []*InventoryItem {
@@ -9940,7 +11412,7 @@ type CombatantPosition struct {
 
 func (x *CombatantPosition) Reset() {
 	*x = CombatantPosition{}
-	mi := &file_game_v1_game_proto_msgTypes[111]
+	mi := &file_game_v1_game_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9952,7 +11424,7 @@ func (x *CombatantPosition) String() string {
 func (*CombatantPosition) ProtoMessage() {}
 
 func (x *CombatantPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[111]
+	mi := &file_game_v1_game_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9965,7 +11437,7 @@ func (x *CombatantPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CombatantPosition.ProtoReflect.Descriptor instead.
 func (*CombatantPosition) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{111}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *CombatantPosition) GetName() string {
@@ -10031,7 +11503,7 @@ type CoverObjectPosition struct {
 
 func (x *CoverObjectPosition) Reset() {
 	*x = CoverObjectPosition{}
-	mi := &file_game_v1_game_proto_msgTypes[112]
+	mi := &file_game_v1_game_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10043,7 +11515,7 @@ func (x *CoverObjectPosition) String() string {
 func (*CoverObjectPosition) ProtoMessage() {}
 
 func (x *CoverObjectPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[112]
+	mi := &file_game_v1_game_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10056,7 +11528,7 @@ func (x *CoverObjectPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CoverObjectPosition.ProtoReflect.Descriptor instead.
 func (*CoverObjectPosition) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{112}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *CoverObjectPosition) GetItemId() string {
@@ -10108,7 +11580,7 @@ type TerrainCell struct {
 
 func (x *TerrainCell) Reset() {
 	*x = TerrainCell{}
-	mi := &file_game_v1_game_proto_msgTypes[113]
+	mi := &file_game_v1_game_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10120,7 +11592,7 @@ func (x *TerrainCell) String() string {
 func (*TerrainCell) ProtoMessage() {}
 
 func (x *TerrainCell) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[113]
+	mi := &file_game_v1_game_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10133,7 +11605,7 @@ func (x *TerrainCell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TerrainCell.ProtoReflect.Descriptor instead.
 func (*TerrainCell) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{113}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *TerrainCell) GetX() int32 {
@@ -10182,7 +11654,7 @@ type RoundStartEvent struct {
 
 func (x *RoundStartEvent) Reset() {
 	*x = RoundStartEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[114]
+	mi := &file_game_v1_game_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10194,7 +11666,7 @@ func (x *RoundStartEvent) String() string {
 func (*RoundStartEvent) ProtoMessage() {}
 
 func (x *RoundStartEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[114]
+	mi := &file_game_v1_game_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10207,7 +11679,7 @@ func (x *RoundStartEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundStartEvent.ProtoReflect.Descriptor instead.
 func (*RoundStartEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{114}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *RoundStartEvent) GetRound() int32 {
@@ -10283,7 +11755,7 @@ type RoundEndEvent struct {
 
 func (x *RoundEndEvent) Reset() {
 	*x = RoundEndEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[115]
+	mi := &file_game_v1_game_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10295,7 +11767,7 @@ func (x *RoundEndEvent) String() string {
 func (*RoundEndEvent) ProtoMessage() {}
 
 func (x *RoundEndEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[115]
+	mi := &file_game_v1_game_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10308,7 +11780,7 @@ func (x *RoundEndEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundEndEvent.ProtoReflect.Descriptor instead.
 func (*RoundEndEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{115}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *RoundEndEvent) GetRound() int32 {
@@ -10334,7 +11806,7 @@ type APUpdateEvent struct {
 
 func (x *APUpdateEvent) Reset() {
 	*x = APUpdateEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[116]
+	mi := &file_game_v1_game_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10346,7 +11818,7 @@ func (x *APUpdateEvent) String() string {
 func (*APUpdateEvent) ProtoMessage() {}
 
 func (x *APUpdateEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[116]
+	mi := &file_game_v1_game_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10359,7 +11831,7 @@ func (x *APUpdateEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APUpdateEvent.ProtoReflect.Descriptor instead.
 func (*APUpdateEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{116}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *APUpdateEvent) GetName() string {
@@ -10404,6 +11876,178 @@ func (x *APUpdateEvent) GetReactionSpent() int32 {
 	return 0
 }
 
+// CombatStateEvent is broadcast to all room combatants after an action is
+// queued and when a round starts, so clients can show the turn order and how
+// much each ally has left to spend.
+type CombatStateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Combatants    []*CombatantStatus     `protobuf:"bytes,2,rep,name=combatants,proto3" json:"combatants,omitempty"` // initiative order, highest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CombatStateEvent) Reset() {
+	*x = CombatStateEvent{}
+	mi := &file_game_v1_game_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CombatStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombatStateEvent) ProtoMessage() {}
+
+func (x *CombatStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombatStateEvent.ProtoReflect.Descriptor instead.
+func (*CombatStateEvent) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CombatStateEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *CombatStateEvent) GetCombatants() []*CombatantStatus {
+	if x != nil {
+		return x.Combatants
+	}
+	return nil
+}
+
+// CombatantStatus is one combatant's entry in a CombatStateEvent.
+type CombatantStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsPlayer      bool                   `protobuf:"varint,2,opt,name=is_player,json=isPlayer,proto3" json:"is_player,omitempty"` // players are allies of every recipient
+	Initiative    int32                  `protobuf:"varint,3,opt,name=initiative,proto3" json:"initiative,omitempty"`
+	ApRemaining   int32                  `protobuf:"varint,4,opt,name=ap_remaining,json=apRemaining,proto3" json:"ap_remaining,omitempty"` // players only; 0 for NPCs
+	ApTotal       int32                  `protobuf:"varint,5,opt,name=ap_total,json=apTotal,proto3" json:"ap_total,omitempty"`             // players only; 0 for NPCs
+	Conditions    []string               `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions,omitempty"`                       // active condition names
+	Dead          bool                   `protobuf:"varint,7,opt,name=dead,proto3" json:"dead,omitempty"`
+	TempHp        int32                  `protobuf:"varint,8,opt,name=temp_hp,json=tempHp,proto3" json:"temp_hp,omitempty"` // temporary hit points remaining
+	Shield        int32                  `protobuf:"varint,9,opt,name=shield,proto3" json:"shield,omitempty"`               // raised-shield block remaining this round
+	Cooldowns     []string               `protobuf:"bytes,10,rep,name=cooldowns,proto3" json:"cooldowns,omitempty"`         // job abilities still recharging, e.g. "Shield Wall (2)"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CombatantStatus) Reset() {
+	*x = CombatantStatus{}
+	mi := &file_game_v1_game_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CombatantStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombatantStatus) ProtoMessage() {}
+
+func (x *CombatantStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombatantStatus.ProtoReflect.Descriptor instead.
+func (*CombatantStatus) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CombatantStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CombatantStatus) GetIsPlayer() bool {
+	if x != nil {
+		return x.IsPlayer
+	}
+	return false
+}
+
+func (x *CombatantStatus) GetInitiative() int32 {
+	if x != nil {
+		return x.Initiative
+	}
+	return 0
+}
+
+func (x *CombatantStatus) GetApRemaining() int32 {
+	if x != nil {
+		return x.ApRemaining
+	}
+	return 0
+}
+
+func (x *CombatantStatus) GetApTotal() int32 {
+	if x != nil {
+		return x.ApTotal
+	}
+	return 0
+}
+
+func (x *CombatantStatus) GetConditions() []string {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *CombatantStatus) GetDead() bool {
+	if x != nil {
+		return x.Dead
+	}
+	return false
+}
+
+func (x *CombatantStatus) GetTempHp() int32 {
+	if x != nil {
+		return x.TempHp
+	}
+	return 0
+}
+
+func (x *CombatantStatus) GetShield() int32 {
+	if x != nil {
+		return x.Shield
+	}
+	return 0
+}
+
+func (x *CombatantStatus) GetCooldowns() []string {
+	if x != nil {
+		return x.Cooldowns
+	}
+	return nil
+}
+
 // CombatEvent delivers combat narration to all players in the room.
 type CombatEvent struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -10423,13 +12067,18 @@ type CombatEvent struct {
 	AttackerX        int32 `protobuf:"varint,13,opt,name=attacker_x,json=attackerX,proto3" json:"attacker_x,omitempty"`
 	AttackerY        int32 `protobuf:"varint,14,opt,name=attacker_y,json=attackerY,proto3" json:"attacker_y,omitempty"`
 	Flanking         bool  `protobuf:"varint,15,opt,name=flanking,proto3" json:"flanking,omitempty"`
+	// attacker_relation and target_relation describe the attacker and target
+	// relative to the receiving player so frontends can render second-person
+	// text ("You hit ...") for the actor. Set per recipient by the server.
+	AttackerRelation CombatRelation `protobuf:"varint,16,opt,name=attacker_relation,json=attackerRelation,proto3,enum=game.v1.CombatRelation" json:"attacker_relation,omitempty"`
+	TargetRelation   CombatRelation `protobuf:"varint,17,opt,name=target_relation,json=targetRelation,proto3,enum=game.v1.CombatRelation" json:"target_relation,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CombatEvent) Reset() {
 	*x = CombatEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[117]
+	mi := &file_game_v1_game_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10441,7 +12090,7 @@ func (x *CombatEvent) String() string {
 func (*CombatEvent) ProtoMessage() {}
 
 func (x *CombatEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[117]
+	mi := &file_game_v1_game_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10454,7 +12103,7 @@ func (x *CombatEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CombatEvent.ProtoReflect.Descriptor instead.
 func (*CombatEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{117}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CombatEvent) GetType() CombatEventType {
@@ -10563,6 +12212,20 @@ func (x *CombatEvent) GetFlanking() bool {
 	return false
 }
 
+func (x *CombatEvent) GetAttackerRelation() CombatRelation {
+	if x != nil {
+		return x.AttackerRelation
+	}
+	return CombatRelation_COMBAT_RELATION_UNSPECIFIED
+}
+
+func (x *CombatEvent) GetTargetRelation() CombatRelation {
+	if x != nil {
+		return x.TargetRelation
+	}
+	return CombatRelation_COMBAT_RELATION_UNSPECIFIED
+}
+
 // StatusRequest asks the server to return the player's active conditions.
 type StatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -10572,7 +12235,7 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[118]
+	mi := &file_game_v1_game_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10584,7 +12247,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[118]
+	mi := &file_game_v1_game_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10597,7 +12260,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{118}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{124}
 }
 
 // ConditionEvent reports a condition being applied to or removed from an entity.
@@ -10615,7 +12278,7 @@ type ConditionEvent struct {
 
 func (x *ConditionEvent) Reset() {
 	*x = ConditionEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[119]
+	mi := &file_game_v1_game_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10627,7 +12290,7 @@ func (x *ConditionEvent) String() string {
 func (*ConditionEvent) ProtoMessage() {}
 
 func (x *ConditionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[119]
+	mi := &file_game_v1_game_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10640,7 +12303,7 @@ func (x *ConditionEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionEvent.ProtoReflect.Descriptor instead.
 func (*ConditionEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{119}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *ConditionEvent) GetTargetUid() string {
@@ -10696,7 +12359,7 @@ type WearRequest struct {
 
 func (x *WearRequest) Reset() {
 	*x = WearRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[120]
+	mi := &file_game_v1_game_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10708,7 +12371,7 @@ func (x *WearRequest) String() string {
 func (*WearRequest) ProtoMessage() {}
 
 func (x *WearRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[120]
+	mi := &file_game_v1_game_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10721,7 +12384,7 @@ func (x *WearRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WearRequest.ProtoReflect.Descriptor instead.
 func (*WearRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{120}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *WearRequest) GetItemId() string {
@@ -10748,7 +12411,7 @@ type RemoveArmorRequest struct {
 
 func (x *RemoveArmorRequest) Reset() {
 	*x = RemoveArmorRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[121]
+	mi := &file_game_v1_game_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10760,7 +12423,7 @@ func (x *RemoveArmorRequest) String() string {
 func (*RemoveArmorRequest) ProtoMessage() {}
 
 func (x *RemoveArmorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[121]
+	mi := &file_game_v1_game_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10773,7 +12436,7 @@ func (x *RemoveArmorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveArmorRequest.ProtoReflect.Descriptor instead.
 func (*RemoveArmorRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{121}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *RemoveArmorRequest) GetSlot() string {
@@ -10797,7 +12460,7 @@ type ConditionInfo struct {
 
 func (x *ConditionInfo) Reset() {
 	*x = ConditionInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[122]
+	mi := &file_game_v1_game_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10809,7 +12472,7 @@ func (x *ConditionInfo) String() string {
 func (*ConditionInfo) ProtoMessage() {}
 
 func (x *ConditionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[122]
+	mi := &file_game_v1_game_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10822,7 +12485,7 @@ func (x *ConditionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionInfo.ProtoReflect.Descriptor instead.
 func (*ConditionInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{122}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *ConditionInfo) GetId() string {
@@ -10869,7 +12532,7 @@ type CharacterSheetRequest struct {
 
 func (x *CharacterSheetRequest) Reset() {
 	*x = CharacterSheetRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[123]
+	mi := &file_game_v1_game_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10881,7 +12544,7 @@ func (x *CharacterSheetRequest) String() string {
 func (*CharacterSheetRequest) ProtoMessage() {}
 
 func (x *CharacterSheetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[123]
+	mi := &file_game_v1_game_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10894,7 +12557,7 @@ func (x *CharacterSheetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterSheetRequest.ProtoReflect.Descriptor instead.
 func (*CharacterSheetRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{123}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{129}
 }
 
 // ArchetypeSelectionRequest asks the server to assign an archetype to the player's character.
@@ -10907,7 +12570,7 @@ type ArchetypeSelectionRequest struct {
 
 func (x *ArchetypeSelectionRequest) Reset() {
 	*x = ArchetypeSelectionRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[124]
+	mi := &file_game_v1_game_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10919,7 +12582,7 @@ func (x *ArchetypeSelectionRequest) String() string {
 func (*ArchetypeSelectionRequest) ProtoMessage() {}
 
 func (x *ArchetypeSelectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[124]
+	mi := &file_game_v1_game_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10932,7 +12595,7 @@ func (x *ArchetypeSelectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchetypeSelectionRequest.ProtoReflect.Descriptor instead.
 func (*ArchetypeSelectionRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{124}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *ArchetypeSelectionRequest) GetArchetypeId() string {
@@ -10951,7 +12614,7 @@ type FeatsRequest struct {
 
 func (x *FeatsRequest) Reset() {
 	*x = FeatsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[125]
+	mi := &file_game_v1_game_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10963,7 +12626,7 @@ func (x *FeatsRequest) String() string {
 func (*FeatsRequest) ProtoMessage() {}
 
 func (x *FeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[125]
+	mi := &file_game_v1_game_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10976,7 +12639,7 @@ func (x *FeatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatsRequest.ProtoReflect.Descriptor instead.
 func (*FeatsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{125}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{131}
 }
 
 // FeatEntry represents one feat with its details.
@@ -10997,7 +12660,7 @@ type FeatEntry struct {
 
 func (x *FeatEntry) Reset() {
 	*x = FeatEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[126]
+	mi := &file_game_v1_game_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11009,7 +12672,7 @@ func (x *FeatEntry) String() string {
 func (*FeatEntry) ProtoMessage() {}
 
 func (x *FeatEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[126]
+	mi := &file_game_v1_game_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11022,7 +12685,7 @@ func (x *FeatEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatEntry.ProtoReflect.Descriptor instead.
 func (*FeatEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{126}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *FeatEntry) GetFeatId() string {
@@ -11098,7 +12761,7 @@ type FeatsResponse struct {
 
 func (x *FeatsResponse) Reset() {
 	*x = FeatsResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[127]
+	mi := &file_game_v1_game_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11110,7 +12773,7 @@ func (x *FeatsResponse) String() string {
 func (*FeatsResponse) ProtoMessage() {}
 
 func (x *FeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[127]
+	mi := &file_game_v1_game_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11123,7 +12786,7 @@ func (x *FeatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatsResponse.ProtoReflect.Descriptor instead.
 func (*FeatsResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{127}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *FeatsResponse) GetFeats() []*FeatEntry {
@@ -11142,7 +12805,7 @@ type ClassFeaturesRequest struct {
 
 func (x *ClassFeaturesRequest) Reset() {
 	*x = ClassFeaturesRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[128]
+	mi := &file_game_v1_game_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11154,7 +12817,7 @@ func (x *ClassFeaturesRequest) String() string {
 func (*ClassFeaturesRequest) ProtoMessage() {}
 
 func (x *ClassFeaturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[128]
+	mi := &file_game_v1_game_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11167,7 +12830,7 @@ func (x *ClassFeaturesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassFeaturesRequest.ProtoReflect.Descriptor instead.
 func (*ClassFeaturesRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{128}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{134}
 }
 
 // ClassFeatureEntry represents one class feature with its details.
@@ -11186,7 +12849,7 @@ type ClassFeatureEntry struct {
 
 func (x *ClassFeatureEntry) Reset() {
 	*x = ClassFeatureEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[129]
+	mi := &file_game_v1_game_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11198,7 +12861,7 @@ func (x *ClassFeatureEntry) String() string {
 func (*ClassFeatureEntry) ProtoMessage() {}
 
 func (x *ClassFeatureEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[129]
+	mi := &file_game_v1_game_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11211,7 +12874,7 @@ func (x *ClassFeatureEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassFeatureEntry.ProtoReflect.Descriptor instead.
 func (*ClassFeatureEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{129}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ClassFeatureEntry) GetFeatureId() string {
@@ -11274,7 +12937,7 @@ type ClassFeaturesResponse struct {
 
 func (x *ClassFeaturesResponse) Reset() {
 	*x = ClassFeaturesResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[130]
+	mi := &file_game_v1_game_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11286,7 +12949,7 @@ func (x *ClassFeaturesResponse) String() string {
 func (*ClassFeaturesResponse) ProtoMessage() {}
 
 func (x *ClassFeaturesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[130]
+	mi := &file_game_v1_game_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11299,7 +12962,7 @@ func (x *ClassFeaturesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassFeaturesResponse.ProtoReflect.Descriptor instead.
 func (*ClassFeaturesResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{130}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *ClassFeaturesResponse) GetArchetypeFeatures() []*ClassFeatureEntry {
@@ -11326,7 +12989,7 @@ type InteractRequest struct {
 
 func (x *InteractRequest) Reset() {
 	*x = InteractRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[131]
+	mi := &file_game_v1_game_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11338,7 +13001,7 @@ func (x *InteractRequest) String() string {
 func (*InteractRequest) ProtoMessage() {}
 
 func (x *InteractRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[131]
+	mi := &file_game_v1_game_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11351,7 +13014,7 @@ func (x *InteractRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractRequest.ProtoReflect.Descriptor instead.
 func (*InteractRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{131}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *InteractRequest) GetInstanceId() string {
@@ -11371,7 +13034,7 @@ type InteractResponse struct {
 
 func (x *InteractResponse) Reset() {
 	*x = InteractResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[132]
+	mi := &file_game_v1_game_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11383,7 +13046,7 @@ func (x *InteractResponse) String() string {
 func (*InteractResponse) ProtoMessage() {}
 
 func (x *InteractResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[132]
+	mi := &file_game_v1_game_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11396,7 +13059,7 @@ func (x *InteractResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractResponse.ProtoReflect.Descriptor instead.
 func (*InteractResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{132}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *InteractResponse) GetMessage() string {
@@ -11422,7 +13085,7 @@ type AoeTemplate struct {
 
 func (x *AoeTemplate) Reset() {
 	*x = AoeTemplate{}
-	mi := &file_game_v1_game_proto_msgTypes[133]
+	mi := &file_game_v1_game_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11434,7 +13097,7 @@ func (x *AoeTemplate) String() string {
 func (*AoeTemplate) ProtoMessage() {}
 
 func (x *AoeTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[133]
+	mi := &file_game_v1_game_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11447,7 +13110,7 @@ func (x *AoeTemplate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AoeTemplate.ProtoReflect.Descriptor instead.
 func (*AoeTemplate) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{133}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *AoeTemplate) GetShape() AoeTemplate_Shape {
@@ -11500,7 +13163,7 @@ type UseRequest struct {
 
 func (x *UseRequest) Reset() {
 	*x = UseRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[134]
+	mi := &file_game_v1_game_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11512,7 +13175,7 @@ func (x *UseRequest) String() string {
 func (*UseRequest) ProtoMessage() {}
 
 func (x *UseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[134]
+	mi := &file_game_v1_game_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11525,7 +13188,7 @@ func (x *UseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseRequest.ProtoReflect.Descriptor instead.
 func (*UseRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{134}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *UseRequest) GetFeatId() string {
@@ -11574,7 +13237,7 @@ type UseResponse struct {
 
 func (x *UseResponse) Reset() {
 	*x = UseResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[135]
+	mi := &file_game_v1_game_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11586,7 +13249,7 @@ func (x *UseResponse) String() string {
 func (*UseResponse) ProtoMessage() {}
 
 func (x *UseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[135]
+	mi := &file_game_v1_game_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11599,7 +13262,7 @@ func (x *UseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseResponse.ProtoReflect.Descriptor instead.
 func (*UseResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{135}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *UseResponse) GetMessage() string {
@@ -11632,7 +13295,7 @@ type PreparedSlotView struct {
 
 func (x *PreparedSlotView) Reset() {
 	*x = PreparedSlotView{}
-	mi := &file_game_v1_game_proto_msgTypes[136]
+	mi := &file_game_v1_game_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11644,7 +13307,7 @@ func (x *PreparedSlotView) String() string {
 func (*PreparedSlotView) ProtoMessage() {}
 
 func (x *PreparedSlotView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[136]
+	mi := &file_game_v1_game_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11657,7 +13320,7 @@ func (x *PreparedSlotView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreparedSlotView.ProtoReflect.Descriptor instead.
 func (*PreparedSlotView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{136}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *PreparedSlotView) GetTechId() string {
@@ -11724,7 +13387,7 @@ type HardwiredSlotView struct {
 
 func (x *HardwiredSlotView) Reset() {
 	*x = HardwiredSlotView{}
-	mi := &file_game_v1_game_proto_msgTypes[137]
+	mi := &file_game_v1_game_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11736,7 +13399,7 @@ func (x *HardwiredSlotView) String() string {
 func (*HardwiredSlotView) ProtoMessage() {}
 
 func (x *HardwiredSlotView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[137]
+	mi := &file_game_v1_game_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11749,7 +13412,7 @@ func (x *HardwiredSlotView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HardwiredSlotView.ProtoReflect.Descriptor instead.
 func (*HardwiredSlotView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{137}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *HardwiredSlotView) GetTechId() string {
@@ -11809,7 +13472,7 @@ type SpontaneousKnownEntry struct {
 
 func (x *SpontaneousKnownEntry) Reset() {
 	*x = SpontaneousKnownEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[138]
+	mi := &file_game_v1_game_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11821,7 +13484,7 @@ func (x *SpontaneousKnownEntry) String() string {
 func (*SpontaneousKnownEntry) ProtoMessage() {}
 
 func (x *SpontaneousKnownEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[138]
+	mi := &file_game_v1_game_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11834,7 +13497,7 @@ func (x *SpontaneousKnownEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpontaneousKnownEntry.ProtoReflect.Descriptor instead.
 func (*SpontaneousKnownEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{138}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *SpontaneousKnownEntry) GetTechId() string {
@@ -11954,13 +13617,15 @@ type CharacterSheetView struct {
 	// produced by effect/render.RenderEffectsBlock. Populated server-side at
 	// handleChar time so both telnet and web clients render identical content.
 	EffectsSummary string `protobuf:"bytes,65,opt,name=effects_summary,json=effectsSummary,proto3" json:"effects_summary,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// title is the achievement title the character displays; empty for none.
+	Title         string `protobuf:"bytes,66,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CharacterSheetView) Reset() {
 	*x = CharacterSheetView{}
-	mi := &file_game_v1_game_proto_msgTypes[139]
+	mi := &file_game_v1_game_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11972,7 +13637,7 @@ func (x *CharacterSheetView) String() string {
 func (*CharacterSheetView) ProtoMessage() {}
 
 func (x *CharacterSheetView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[139]
+	mi := &file_game_v1_game_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11985,7 +13650,7 @@ func (x *CharacterSheetView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterSheetView.ProtoReflect.Descriptor instead.
 func (*CharacterSheetView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{139}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *CharacterSheetView) GetName() string {
@@ -12303,178 +13968,3155 @@ func (x *CharacterSheetView) GetSpontaneousUsePools() []*SpontaneousUsePoolView
 	return nil
 }
 
-func (x *CharacterSheetView) GetInnateSlots() []*InnateSlotView {
+func (x *CharacterSheetView) GetInnateSlots() []*InnateSlotView {
+	if x != nil {
+		return x.InnateSlots
+	}
+	return nil
+}
+
+func (x *CharacterSheetView) GetActiveSetBonuses() []string {
+	if x != nil {
+		return x.ActiveSetBonuses
+	}
+	return nil
+}
+
+func (x *CharacterSheetView) GetFocusPoints() int32 {
+	if x != nil {
+		return x.FocusPoints
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetMaxFocusPoints() int32 {
+	if x != nil {
+		return x.MaxFocusPoints
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetHardwiredSlots() []*HardwiredSlotView {
+	if x != nil {
+		return x.HardwiredSlots
+	}
+	return nil
+}
+
+func (x *CharacterSheetView) GetSpontaneousKnown() []*SpontaneousKnownEntry {
+	if x != nil {
+		return x.SpontaneousKnown
+	}
+	return nil
+}
+
+func (x *CharacterSheetView) GetArmorCategories() map[string]string {
+	if x != nil {
+		return x.ArmorCategories
+	}
+	return nil
+}
+
+func (x *CharacterSheetView) GetProficiencyAcBonus() int32 {
+	if x != nil {
+		return x.ProficiencyAcBonus
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetEffectiveArmorCategory() string {
+	if x != nil {
+		return x.EffectiveArmorCategory
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetMainHandAbilityBonus() int32 {
+	if x != nil {
+		return x.MainHandAbilityBonus
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetMainHandProfBonus() int32 {
+	if x != nil {
+		return x.MainHandProfBonus
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetMainHandProfRank() string {
+	if x != nil {
+		return x.MainHandProfRank
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetOffHandAbilityBonus() int32 {
+	if x != nil {
+		return x.OffHandAbilityBonus
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetOffHandProfBonus() int32 {
+	if x != nil {
+		return x.OffHandProfBonus
+	}
+	return 0
+}
+
+func (x *CharacterSheetView) GetOffHandProfRank() string {
+	if x != nil {
+		return x.OffHandProfRank
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetExploreMode() string {
+	if x != nil {
+		return x.ExploreMode
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetTechTradition() string {
+	if x != nil {
+		return x.TechTradition
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetMainHandProfCategory() string {
+	if x != nil {
+		return x.MainHandProfCategory
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetOffHandProfCategory() string {
+	if x != nil {
+		return x.OffHandProfCategory
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetEffectsSummary() string {
+	if x != nil {
+		return x.EffectsSummary
+	}
+	return ""
+}
+
+func (x *CharacterSheetView) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// InnateSlotView delivers the per-tech innate use slot state for the character sheet.
+type InnateSlotView struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TechId         string                 `protobuf:"bytes,1,opt,name=tech_id,json=techId,proto3" json:"tech_id,omitempty"`
+	UsesRemaining  int32                  `protobuf:"varint,2,opt,name=uses_remaining,json=usesRemaining,proto3" json:"uses_remaining,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	TechName       string                 `protobuf:"bytes,4,opt,name=tech_name,json=techName,proto3" json:"tech_name,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	IsReaction     bool                   `protobuf:"varint,6,opt,name=is_reaction,json=isReaction,proto3" json:"is_reaction,omitempty"`
+	EffectsSummary string                 `protobuf:"bytes,7,opt,name=effects_summary,json=effectsSummary,proto3" json:"effects_summary,omitempty"`
+	ShortName      string                 `protobuf:"bytes,8,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Passive        bool                   `protobuf:"varint,9,opt,name=passive,proto3" json:"passive,omitempty"`
+	TechLevel      int32                  `protobuf:"varint,10,opt,name=tech_level,json=techLevel,proto3" json:"tech_level,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InnateSlotView) Reset() {
+	*x = InnateSlotView{}
+	mi := &file_game_v1_game_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InnateSlotView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InnateSlotView) ProtoMessage() {}
+
+func (x *InnateSlotView) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InnateSlotView.ProtoReflect.Descriptor instead.
+func (*InnateSlotView) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *InnateSlotView) GetTechId() string {
+	if x != nil {
+		return x.TechId
+	}
+	return ""
+}
+
+func (x *InnateSlotView) GetUsesRemaining() int32 {
+	if x != nil {
+		return x.UsesRemaining
+	}
+	return 0
+}
+
+func (x *InnateSlotView) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *InnateSlotView) GetTechName() string {
+	if x != nil {
+		return x.TechName
+	}
+	return ""
+}
+
+func (x *InnateSlotView) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InnateSlotView) GetIsReaction() bool {
+	if x != nil {
+		return x.IsReaction
+	}
+	return false
+}
+
+func (x *InnateSlotView) GetEffectsSummary() string {
+	if x != nil {
+		return x.EffectsSummary
+	}
+	return ""
+}
+
+func (x *InnateSlotView) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *InnateSlotView) GetPassive() bool {
+	if x != nil {
+		return x.Passive
+	}
+	return false
+}
+
+func (x *InnateSlotView) GetTechLevel() int32 {
+	if x != nil {
+		return x.TechLevel
+	}
+	return 0
+}
+
+// SpontaneousUsePoolView delivers the daily use pool for one spontaneous tech level.
+type SpontaneousUsePoolView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TechLevel     int32                  `protobuf:"varint,1,opt,name=tech_level,json=techLevel,proto3" json:"tech_level,omitempty"`
+	UsesRemaining int32                  `protobuf:"varint,2,opt,name=uses_remaining,json=usesRemaining,proto3" json:"uses_remaining,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpontaneousUsePoolView) Reset() {
+	*x = SpontaneousUsePoolView{}
+	mi := &file_game_v1_game_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpontaneousUsePoolView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpontaneousUsePoolView) ProtoMessage() {}
+
+func (x *SpontaneousUsePoolView) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpontaneousUsePoolView.ProtoReflect.Descriptor instead.
+func (*SpontaneousUsePoolView) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *SpontaneousUsePoolView) GetTechLevel() int32 {
+	if x != nil {
+		return x.TechLevel
+	}
+	return 0
+}
+
+func (x *SpontaneousUsePoolView) GetUsesRemaining() int32 {
+	if x != nil {
+		return x.UsesRemaining
+	}
+	return 0
+}
+
+func (x *SpontaneousUsePoolView) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+// ResistanceEntry represents one player damage-type resistance or weakness.
+type ResistanceEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DamageType    string                 `protobuf:"bytes,1,opt,name=damage_type,json=damageType,proto3" json:"damage_type,omitempty"` // e.g. "fire", "piercing"
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`                            // flat amount
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResistanceEntry) Reset() {
+	*x = ResistanceEntry{}
+	mi := &file_game_v1_game_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResistanceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResistanceEntry) ProtoMessage() {}
+
+func (x *ResistanceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResistanceEntry.ProtoReflect.Descriptor instead.
+func (*ResistanceEntry) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ResistanceEntry) GetDamageType() string {
+	if x != nil {
+		return x.DamageType
+	}
+	return ""
+}
+
+func (x *ResistanceEntry) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ProficienciesRequest is sent by the client to view armor/weapon proficiencies.
+type ProficienciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProficienciesRequest) Reset() {
+	*x = ProficienciesRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProficienciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProficienciesRequest) ProtoMessage() {}
+
+func (x *ProficienciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProficienciesRequest.ProtoReflect.Descriptor instead.
+func (*ProficienciesRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{149}
+}
+
+// ProficiencyEntry represents one proficiency category with its rank and bonus.
+type ProficiencyEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`                              // e.g. "light_armor", "simple_weapons"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // e.g. "Light Armor", "Simple Weapons"
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`                                      // "untrained", "trained", etc.
+	Bonus         int32                  `protobuf:"varint,4,opt,name=bonus,proto3" json:"bonus,omitempty"`                                   // CombatProficiencyBonus(level, rank)
+	Kind          string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`                                      // "armor" or "weapon"
+	Practice      int32                  `protobuf:"varint,6,opt,name=practice,proto3" json:"practice,omitempty"`                             // weapon practice points earned by use
+	PracticeNext  int32                  `protobuf:"varint,7,opt,name=practice_next,json=practiceNext,proto3" json:"practice_next,omitempty"` // practice points at which use advances the rank; 0 when it can't
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProficiencyEntry) Reset() {
+	*x = ProficiencyEntry{}
+	mi := &file_game_v1_game_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProficiencyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProficiencyEntry) ProtoMessage() {}
+
+func (x *ProficiencyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProficiencyEntry.ProtoReflect.Descriptor instead.
+func (*ProficiencyEntry) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ProficiencyEntry) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProficiencyEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProficiencyEntry) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *ProficiencyEntry) GetBonus() int32 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+func (x *ProficiencyEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ProficiencyEntry) GetPractice() int32 {
+	if x != nil {
+		return x.Practice
+	}
+	return 0
+}
+
+func (x *ProficiencyEntry) GetPracticeNext() int32 {
+	if x != nil {
+		return x.PracticeNext
+	}
+	return 0
+}
+
+// ProficienciesResponse contains all proficiency entries for the character.
+type ProficienciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proficiencies []*ProficiencyEntry    `protobuf:"bytes,1,rep,name=proficiencies,proto3" json:"proficiencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProficienciesResponse) Reset() {
+	*x = ProficienciesResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProficienciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProficienciesResponse) ProtoMessage() {}
+
+func (x *ProficienciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProficienciesResponse.ProtoReflect.Descriptor instead.
+func (*ProficienciesResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ProficienciesResponse) GetProficiencies() []*ProficiencyEntry {
+	if x != nil {
+		return x.Proficiencies
+	}
+	return nil
+}
+
+// LevelUpRequest asks the server to apply a pending ability boost to the named ability.
+type LevelUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ability       string                 `protobuf:"bytes,1,opt,name=ability,proto3" json:"ability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LevelUpRequest) Reset() {
+	*x = LevelUpRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelUpRequest) ProtoMessage() {}
+
+func (x *LevelUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelUpRequest.ProtoReflect.Descriptor instead.
+func (*LevelUpRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *LevelUpRequest) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+// CombatDefaultRequest asks the server to persist the player's preferred default combat action.
+type CombatDefaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CombatDefaultRequest) Reset() {
+	*x = CombatDefaultRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CombatDefaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombatDefaultRequest) ProtoMessage() {}
+
+func (x *CombatDefaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombatDefaultRequest.ProtoReflect.Descriptor instead.
+func (*CombatDefaultRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CombatDefaultRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// CombatVerbosityRequest asks the server to show or persist the player's combat narration level.
+// An empty level queries the current setting; otherwise one of "brief", "normal", or "verbose".
+type CombatVerbosityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CombatVerbosityRequest) Reset() {
+	*x = CombatVerbosityRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CombatVerbosityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombatVerbosityRequest) ProtoMessage() {}
+
+func (x *CombatVerbosityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombatVerbosityRequest.ProtoReflect.Descriptor instead.
+func (*CombatVerbosityRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CombatVerbosityRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// LocaleRequest asks the server to show or persist the account's message locale.
+// An empty locale queries the current setting; otherwise a tag such as "en" or "es".
+type LocaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocaleRequest) Reset() {
+	*x = LocaleRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocaleRequest) ProtoMessage() {}
+
+func (x *LocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocaleRequest.ProtoReflect.Descriptor instead.
+func (*LocaleRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *LocaleRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// ChatMuteRequest asks the server to mute or unmute a player's chat (moderator/admin only).
+// minutes = 0 lifts any mute on the target.
+type ChatMuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Minutes       int32                  `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMuteRequest) Reset() {
+	*x = ChatMuteRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMuteRequest) ProtoMessage() {}
+
+func (x *ChatMuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMuteRequest.ProtoReflect.Descriptor instead.
+func (*ChatMuteRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ChatMuteRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ChatMuteRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+// AuditRequest asks the server for recent admin audit log entries (admin only).
+// subject filters by actor or target name; limit = 0 selects the server default.
+type AuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequest) ProtoMessage() {}
+
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *AuditRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SettingsRequest shows or changes an account-level preference.
+// An empty key lists the current settings; otherwise key is one of
+// "theme", "accessible", "mute", or "unmute" and value is its argument.
+type SettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettingsRequest) Reset() {
+	*x = SettingsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsRequest) ProtoMessage() {}
+
+func (x *SettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsRequest.ProtoReflect.Descriptor instead.
+func (*SettingsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SettingsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SettingsRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RenameRequest asks the server to rename a character (admin only).
+// target is the character's current name, online or offline.
+type RenameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameRequest) Reset() {
+	*x = RenameRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRequest) ProtoMessage() {}
+
+func (x *RenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
+func (*RenameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *RenameRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *RenameRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// CharacterSlotsRequest overrides an account's character limit (admin only).
+// slots = 0 clears the override so the configured role limit applies.
+type CharacterSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Slots         int32                  `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterSlotsRequest) Reset() {
+	*x = CharacterSlotsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterSlotsRequest) ProtoMessage() {}
+
+func (x *CharacterSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterSlotsRequest.ProtoReflect.Descriptor instead.
+func (*CharacterSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CharacterSlotsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CharacterSlotsRequest) GetSlots() int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+// TutorialRequest shows tutorial progress, or leaves the tutorial when skip is set.
+type TutorialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          bool                   `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorialRequest) Reset() {
+	*x = TutorialRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorialRequest) ProtoMessage() {}
+
+func (x *TutorialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorialRequest.ProtoReflect.Descriptor instead.
+func (*TutorialRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *TutorialRequest) GetSkip() bool {
+	if x != nil {
+		return x.Skip
+	}
+	return false
+}
+
+// HomeRequest manages the player's rented room.
+// action is "", "rent", "vacate", "describe", "store", or "take"; target is the
+// description text or the item; quantity applies to store and take.
+type HomeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HomeRequest) Reset() {
+	*x = HomeRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HomeRequest) ProtoMessage() {}
+
+func (x *HomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HomeRequest.ProtoReflect.Descriptor instead.
+func (*HomeRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *HomeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *HomeRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *HomeRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ClanRequest shows or manages the player's clan.
+// action "" shows clan info; see command.HandleClan for the rest.
+type ClanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClanRequest) Reset() {
+	*x = ClanRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClanRequest) ProtoMessage() {}
+
+func (x *ClanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClanRequest.ProtoReflect.Descriptor instead.
+func (*ClanRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ClanRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ClanRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ClanRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ClanRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// AchievementsRequest lists the player's achievement progress and earned titles.
+type AchievementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementsRequest) Reset() {
+	*x = AchievementsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementsRequest) ProtoMessage() {}
+
+func (x *AchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementsRequest.ProtoReflect.Descriptor instead.
+func (*AchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{164}
+}
+
+// TitleRequest selects the achievement title the player displays.
+// An empty title with clear unset lists the titles the player has earned.
+type TitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Clear         bool                   `protobuf:"varint,2,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TitleRequest) Reset() {
+	*x = TitleRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TitleRequest) ProtoMessage() {}
+
+func (x *TitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TitleRequest.ProtoReflect.Descriptor instead.
+func (*TitleRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *TitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TitleRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+// TopRequest shows the leaderboard for one stat (see stats.Stat).
+type TopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stat          string                 `protobuf:"bytes,1,opt,name=stat,proto3" json:"stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopRequest) Reset() {
+	*x = TopRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopRequest) ProtoMessage() {}
+
+func (x *TopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopRequest.ProtoReflect.Descriptor instead.
+func (*TopRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *TopRequest) GetStat() string {
+	if x != nil {
+		return x.Stat
+	}
+	return ""
+}
+
+// PlayedRequest shows the character's total playtime, session length, and age.
+type PlayedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayedRequest) Reset() {
+	*x = PlayedRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayedRequest) ProtoMessage() {}
+
+func (x *PlayedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayedRequest.ProtoReflect.Descriptor instead.
+func (*PlayedRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{167}
+}
+
+// AfkRequest marks the player away from keyboard. auto is set by the client's
+// idle monitor; message is the player's own note and is ignored when auto is set.
+type AfkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Auto          bool                   `protobuf:"varint,2,opt,name=auto,proto3" json:"auto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AfkRequest) Reset() {
+	*x = AfkRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AfkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AfkRequest) ProtoMessage() {}
+
+func (x *AfkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AfkRequest.ProtoReflect.Descriptor instead.
+func (*AfkRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AfkRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AfkRequest) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+// SiteBanRequest lists or edits the site allow/deny rules (admin only).
+// action is one of "list", "add", "allow", or "remove"; cidr is a CIDR range
+// or a single address.
+type SiteBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Cidr          string                 `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteBanRequest) Reset() {
+	*x = SiteBanRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteBanRequest) ProtoMessage() {}
+
+func (x *SiteBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteBanRequest.ProtoReflect.Descriptor instead.
+func (*SiteBanRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *SiteBanRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SiteBanRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *SiteBanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ConsiderRequest asks for a rough difficulty estimate of an NPC in the room.
+type ConsiderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsiderRequest) Reset() {
+	*x = ConsiderRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsiderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsiderRequest) ProtoMessage() {}
+
+func (x *ConsiderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsiderRequest.ProtoReflect.Descriptor instead.
+func (*ConsiderRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *ConsiderRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// ScanRequest lists the NPCs in the rooms beyond the player's open exits.
+type ScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanRequest) Reset() {
+	*x = ScanRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRequest) ProtoMessage() {}
+
+func (x *ScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRequest.ProtoReflect.Descriptor instead.
+func (*ScanRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{171}
+}
+
+// LightRequest lights a light source in the player's backpack, or puts out the
+// lit one when target is "off".
+type LightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LightRequest) Reset() {
+	*x = LightRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightRequest) ProtoMessage() {}
+
+func (x *LightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightRequest.ProtoReflect.Descriptor instead.
+func (*LightRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *LightRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// BoardRequest climbs into a vehicle in the room.
+type BoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardRequest) Reset() {
+	*x = BoardRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRequest) ProtoMessage() {}
+
+func (x *BoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRequest.ProtoReflect.Descriptor instead.
+func (*BoardRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *BoardRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// DisembarkRequest leaves the vehicle the player is riding in.
+type DisembarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisembarkRequest) Reset() {
+	*x = DisembarkRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisembarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisembarkRequest) ProtoMessage() {}
+
+func (x *DisembarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisembarkRequest.ProtoReflect.Descriptor instead.
+func (*DisembarkRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{174}
+}
+
+// DriveRequest moves the player's vehicle, and everyone aboard, one room.
+type DriveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriveRequest) Reset() {
+	*x = DriveRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriveRequest) ProtoMessage() {}
+
+func (x *DriveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriveRequest.ProtoReflect.Descriptor instead.
+func (*DriveRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *DriveRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+// RefuelRequest pours fuel from the player's backpack into a vehicle in the
+// room, or into the one they are riding when target is empty.
+type RefuelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefuelRequest) Reset() {
+	*x = RefuelRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefuelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefuelRequest) ProtoMessage() {}
+
+func (x *RefuelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefuelRequest.ProtoReflect.Descriptor instead.
+func (*RefuelRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *RefuelRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// ShoutRequest speaks to the sender's room loudly enough to be heard across
+// the zone.
+type ShoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoutRequest) Reset() {
+	*x = ShoutRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoutRequest) ProtoMessage() {}
+
+func (x *ShoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoutRequest.ProtoReflect.Descriptor instead.
+func (*ShoutRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ShoutRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// YellRequest speaks to the sender's room loudly enough to be heard in the
+// neighbouring rooms.
+type YellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *YellRequest) Reset() {
+	*x = YellRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YellRequest) ProtoMessage() {}
+
+func (x *YellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YellRequest.ProtoReflect.Descriptor instead.
+func (*YellRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *YellRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PayFineRequest pays a guard in the room the fine that clears the player's
+// wanted level in the current zone.
+type PayFineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayFineRequest) Reset() {
+	*x = PayFineRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayFineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayFineRequest) ProtoMessage() {}
+
+func (x *PayFineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayFineRequest.ProtoReflect.Descriptor instead.
+func (*PayFineRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{179}
+}
+
+// StealRequest tries to pick an item from an NPC's pocket.
+type StealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StealRequest) Reset() {
+	*x = StealRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StealRequest) ProtoMessage() {}
+
+func (x *StealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StealRequest.ProtoReflect.Descriptor instead.
+func (*StealRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *StealRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *StealRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// MarketRequest asks for the price indices of the current zone's market.
+type MarketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketRequest) Reset() {
+	*x = MarketRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketRequest) ProtoMessage() {}
+
+func (x *MarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketRequest.ProtoReflect.Descriptor instead.
+func (*MarketRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{181}
+}
+
+// AuctionRequest uses the auction house through a broker in the room.
+// action is one of browse, list, buy, cancel, mine, or collect.
+type AuctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Item          string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Hours         int32                  `protobuf:"varint,5,opt,name=hours,proto3" json:"hours,omitempty"`
+	ListingId     int64                  `protobuf:"varint,6,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuctionRequest) Reset() {
+	*x = AuctionRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionRequest) ProtoMessage() {}
+
+func (x *AuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionRequest.ProtoReflect.Descriptor instead.
+func (*AuctionRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *AuctionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuctionRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *AuctionRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AuctionRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AuctionRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *AuctionRequest) GetListingId() int64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+// PickRequest works a lock with a lockpick.
+// action is one of start, tension, rake, stop, or status; target, set only
+// for start, is an exit direction or a room item.
+type PickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickRequest) Reset() {
+	*x = PickRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickRequest) ProtoMessage() {}
+
+func (x *PickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickRequest.ProtoReflect.Descriptor instead.
+func (*PickRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *PickRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PickRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// DetectTrapsRequest searches the player's room for hidden traps.
+type DetectTrapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTrapsRequest) Reset() {
+	*x = DetectTrapsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTrapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTrapsRequest) ProtoMessage() {}
+
+func (x *DetectTrapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTrapsRequest.ProtoReflect.Descriptor instead.
+func (*DetectTrapsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{184}
+}
+
+// LogRequest turns session logging on or off, or reports it.
+// action is one of on, off, or status.
+type LogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *LogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// ReportRequest files a player report with the staff.
+// kind is one of bug, typo, or idea.
+type ReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ReportRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// ReportsRequest reviews player reports (moderators only).
+// action is one of list, show, or close. For list, kind filters by report
+// kind and all includes closed reports; show and close act on id.
+type ReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	All           bool                   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportsRequest) Reset() {
+	*x = ReportsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportsRequest) ProtoMessage() {}
+
+func (x *ReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportsRequest.ProtoReflect.Descriptor instead.
+func (*ReportsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ReportsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReportsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReportsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ReportsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// InvisRequest hides or reveals a staff member (moderators only).
+// action is one of on, off, or toggle.
+type InvisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvisRequest) Reset() {
+	*x = InvisRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvisRequest) ProtoMessage() {}
+
+func (x *InvisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvisRequest.ProtoReflect.Descriptor instead.
+func (*InvisRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *InvisRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// SnoopRequest starts or stops mirroring a player's session to a staff
+// member (moderators only). An empty target stops snooping.
+type SnoopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoopRequest) Reset() {
+	*x = SnoopRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoopRequest) ProtoMessage() {}
+
+func (x *SnoopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoopRequest.ProtoReflect.Descriptor instead.
+func (*SnoopRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *SnoopRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SnoopRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ForceRequest runs a command as another player (admins only). command is
+// the already-parsed command; text is what the admin typed, for display.
+type ForceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Command       *ClientMessage         `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceRequest) Reset() {
+	*x = ForceRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRequest) ProtoMessage() {}
+
+func (x *ForceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRequest.ProtoReflect.Descriptor instead.
+func (*ForceRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *ForceRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ForceRequest) GetCommand() *ClientMessage {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ForceRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// PuppetRequest controls an NPC (editors and admins). action is one of
+// control, release, say, emote, attack, hold, or status. target names the NPC
+// for control and the combatant for attack; text is the say or emote text.
+type PuppetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuppetRequest) Reset() {
+	*x = PuppetRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuppetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuppetRequest) ProtoMessage() {}
+
+func (x *PuppetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuppetRequest.ProtoReflect.Descriptor instead.
+func (*PuppetRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *PuppetRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PuppetRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *PuppetRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// ScheduleRequest lists scheduled server events or runs one now (admins
+// only). action is "list" or "run"; event_id names the event to run.
+type ScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRequest) Reset() {
+	*x = ScheduleRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRequest) ProtoMessage() {}
+
+func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ScheduleRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// EngraveRequest engraves a custom name on an item in the player's pack.
+// item is the item ID or name; name is the text to engrave.
+type EngraveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EngraveRequest) Reset() {
+	*x = EngraveRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngraveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngraveRequest) ProtoMessage() {}
+
+func (x *EngraveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngraveRequest.ProtoReflect.Descriptor instead.
+func (*EngraveRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *EngraveRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *EngraveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DigRequest digs for a buried cache in the player's current room using a
+// treasure map from their pack.
+type DigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigRequest) Reset() {
+	*x = DigRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigRequest) ProtoMessage() {}
+
+func (x *DigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigRequest.ProtoReflect.Descriptor instead.
+func (*DigRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{194}
+}
+
+// AnswerRequest answers the question a zone script last asked the player.
+// answer is an option number or the text of an option.
+type AnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        string                 `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerRequest) Reset() {
+	*x = AnswerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerRequest) ProtoMessage() {}
+
+func (x *AnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerRequest.ProtoReflect.Descriptor instead.
+func (*AnswerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *AnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+// ReloadConfigRequest re-reads the server configuration file and applies the
+// values that are safe to change without a restart (admin only).
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{196}
+}
+
+// FlagRequest lists the feature flags or overrides one (admin only). action
+// is one of "list", "on", "off", or "default", which clears the override;
+// username, when set, limits the override to that account.
+type FlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Flag          string                 `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagRequest) Reset() {
+	*x = FlagRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagRequest) ProtoMessage() {}
+
+func (x *FlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagRequest.ProtoReflect.Descriptor instead.
+func (*FlagRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *FlagRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *FlagRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *FlagRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// TransferCharacterRequest moves an offline character to the account named
+// username (admin only).
+type TransferCharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCharacterRequest) Reset() {
+	*x = TransferCharacterRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCharacterRequest) ProtoMessage() {}
+
+func (x *TransferCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCharacterRequest.ProtoReflect.Descriptor instead.
+func (*TransferCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *TransferCharacterRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TransferCharacterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// SharedStashRequest shows the account-wide shared stash or moves credits
+// between it and the character's stash. action is "", "deposit", or "withdraw".
+type SharedStashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedStashRequest) Reset() {
+	*x = SharedStashRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedStashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedStashRequest) ProtoMessage() {}
+
+func (x *SharedStashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[199]
 	if x != nil {
-		return x.InnateSlots
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CharacterSheetView) GetActiveSetBonuses() []string {
-	if x != nil {
-		return x.ActiveSetBonuses
-	}
-	return nil
+// Deprecated: Use SharedStashRequest.ProtoReflect.Descriptor instead.
+func (*SharedStashRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *CharacterSheetView) GetFocusPoints() int32 {
+func (x *SharedStashRequest) GetAction() string {
 	if x != nil {
-		return x.FocusPoints
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-func (x *CharacterSheetView) GetMaxFocusPoints() int32 {
+func (x *SharedStashRequest) GetAmount() int32 {
 	if x != nil {
-		return x.MaxFocusPoints
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *CharacterSheetView) GetHardwiredSlots() []*HardwiredSlotView {
-	if x != nil {
-		return x.HardwiredSlots
-	}
-	return nil
+// ParleyRequest deals with a surrendered NPC in the room. option is "" (list the
+// choices), "loot", "interrogate", or "release".
+type ParleyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Option        string                 `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CharacterSheetView) GetSpontaneousKnown() []*SpontaneousKnownEntry {
-	if x != nil {
-		return x.SpontaneousKnown
-	}
-	return nil
+func (x *ParleyRequest) Reset() {
+	*x = ParleyRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CharacterSheetView) GetArmorCategories() map[string]string {
-	if x != nil {
-		return x.ArmorCategories
-	}
-	return nil
+func (x *ParleyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CharacterSheetView) GetProficiencyAcBonus() int32 {
+func (*ParleyRequest) ProtoMessage() {}
+
+func (x *ParleyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[200]
 	if x != nil {
-		return x.ProficiencyAcBonus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CharacterSheetView) GetEffectiveArmorCategory() string {
+// Deprecated: Use ParleyRequest.ProtoReflect.Descriptor instead.
+func (*ParleyRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ParleyRequest) GetTarget() string {
 	if x != nil {
-		return x.EffectiveArmorCategory
+		return x.Target
 	}
 	return ""
 }
 
-func (x *CharacterSheetView) GetMainHandAbilityBonus() int32 {
+func (x *ParleyRequest) GetOption() string {
 	if x != nil {
-		return x.MainHandAbilityBonus
+		return x.Option
 	}
-	return 0
+	return ""
 }
 
-func (x *CharacterSheetView) GetMainHandProfBonus() int32 {
-	if x != nil {
-		return x.MainHandProfBonus
-	}
-	return 0
+// SocialRequest makes a move in a social encounter. With action empty it opens an
+// encounter with the NPC named by target; otherwise action is "persuade",
+// "intimidate", "deceive", "pass", or "leave".
+type SocialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CharacterSheetView) GetMainHandProfRank() string {
-	if x != nil {
-		return x.MainHandProfRank
-	}
-	return ""
+func (x *SocialRequest) Reset() {
+	*x = SocialRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CharacterSheetView) GetOffHandAbilityBonus() int32 {
-	if x != nil {
-		return x.OffHandAbilityBonus
-	}
-	return 0
+func (x *SocialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CharacterSheetView) GetOffHandProfBonus() int32 {
+func (*SocialRequest) ProtoMessage() {}
+
+func (x *SocialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[201]
 	if x != nil {
-		return x.OffHandProfBonus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CharacterSheetView) GetOffHandProfRank() string {
-	if x != nil {
-		return x.OffHandProfRank
-	}
-	return ""
+// Deprecated: Use SocialRequest.ProtoReflect.Descriptor instead.
+func (*SocialRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *CharacterSheetView) GetExploreMode() string {
+func (x *SocialRequest) GetAction() string {
 	if x != nil {
-		return x.ExploreMode
+		return x.Action
 	}
 	return ""
 }
 
-func (x *CharacterSheetView) GetTechTradition() string {
+func (x *SocialRequest) GetTarget() string {
 	if x != nil {
-		return x.TechTradition
+		return x.Target
 	}
 	return ""
 }
 
-func (x *CharacterSheetView) GetMainHandProfCategory() string {
-	if x != nil {
-		return x.MainHandProfCategory
-	}
-	return ""
+// RestrainRequest takes a surrendered NPC in the room prisoner with a restraint
+// from the player's backpack.
+type RestrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CharacterSheetView) GetOffHandProfCategory() string {
+func (x *RestrainRequest) Reset() {
+	*x = RestrainRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestrainRequest) ProtoMessage() {}
+
+func (x *RestrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[202]
 	if x != nil {
-		return x.OffHandProfCategory
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CharacterSheetView) GetEffectsSummary() string {
+// Deprecated: Use RestrainRequest.ProtoReflect.Descriptor instead.
+func (*RestrainRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *RestrainRequest) GetTarget() string {
 	if x != nil {
-		return x.EffectsSummary
+		return x.Target
 	}
 	return ""
 }
 
-// InnateSlotView delivers the per-tech innate use slot state for the character sheet.
-type InnateSlotView struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TechId         string                 `protobuf:"bytes,1,opt,name=tech_id,json=techId,proto3" json:"tech_id,omitempty"`
-	UsesRemaining  int32                  `protobuf:"varint,2,opt,name=uses_remaining,json=usesRemaining,proto3" json:"uses_remaining,omitempty"`
-	MaxUses        int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
-	TechName       string                 `protobuf:"bytes,4,opt,name=tech_name,json=techName,proto3" json:"tech_name,omitempty"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	IsReaction     bool                   `protobuf:"varint,6,opt,name=is_reaction,json=isReaction,proto3" json:"is_reaction,omitempty"`
-	EffectsSummary string                 `protobuf:"bytes,7,opt,name=effects_summary,json=effectsSummary,proto3" json:"effects_summary,omitempty"`
-	ShortName      string                 `protobuf:"bytes,8,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
-	Passive        bool                   `protobuf:"varint,9,opt,name=passive,proto3" json:"passive,omitempty"`
-	TechLevel      int32                  `protobuf:"varint,10,opt,name=tech_level,json=techLevel,proto3" json:"tech_level,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// NonlethalRequest switches the player between lethal and nonlethal attacks.
+// Mode is "on", "off", or "status".
+type NonlethalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InnateSlotView) Reset() {
-	*x = InnateSlotView{}
-	mi := &file_game_v1_game_proto_msgTypes[140]
+func (x *NonlethalRequest) Reset() {
+	*x = NonlethalRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InnateSlotView) String() string {
+func (x *NonlethalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InnateSlotView) ProtoMessage() {}
+func (*NonlethalRequest) ProtoMessage() {}
 
-func (x *InnateSlotView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[140]
+func (x *NonlethalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12485,106 +17127,127 @@ func (x *InnateSlotView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InnateSlotView.ProtoReflect.Descriptor instead.
-func (*InnateSlotView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use NonlethalRequest.ProtoReflect.Descriptor instead.
+func (*NonlethalRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *InnateSlotView) GetTechId() string {
+func (x *NonlethalRequest) GetMode() string {
 	if x != nil {
-		return x.TechId
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *InnateSlotView) GetUsesRemaining() int32 {
-	if x != nil {
-		return x.UsesRemaining
-	}
-	return 0
+// PracticeRequest switches practice mode, which narrates combat with every roll
+// shown. Mode is "on", "off", or "status".
+type PracticeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InnateSlotView) GetMaxUses() int32 {
-	if x != nil {
-		return x.MaxUses
-	}
-	return 0
+func (x *PracticeRequest) Reset() {
+	*x = PracticeRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InnateSlotView) GetTechName() string {
-	if x != nil {
-		return x.TechName
-	}
-	return ""
+func (x *PracticeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InnateSlotView) GetDescription() string {
+func (*PracticeRequest) ProtoMessage() {}
+
+func (x *PracticeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[204]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InnateSlotView) GetIsReaction() bool {
-	if x != nil {
-		return x.IsReaction
-	}
-	return false
+// Deprecated: Use PracticeRequest.ProtoReflect.Descriptor instead.
+func (*PracticeRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *InnateSlotView) GetEffectsSummary() string {
+func (x *PracticeRequest) GetMode() string {
 	if x != nil {
-		return x.EffectsSummary
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *InnateSlotView) GetShortName() string {
-	if x != nil {
-		return x.ShortName
-	}
-	return ""
+// RecapRequest re-shows the statistics recap of the player's last finished
+// encounter.
+type RecapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InnateSlotView) GetPassive() bool {
-	if x != nil {
-		return x.Passive
-	}
-	return false
+func (x *RecapRequest) Reset() {
+	*x = RecapRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InnateSlotView) GetTechLevel() int32 {
+func (x *RecapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapRequest) ProtoMessage() {}
+
+func (x *RecapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[205]
 	if x != nil {
-		return x.TechLevel
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// SpontaneousUsePoolView delivers the daily use pool for one spontaneous tech level.
-type SpontaneousUsePoolView struct {
+// Deprecated: Use RecapRequest.ProtoReflect.Descriptor instead.
+func (*RecapRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{205}
+}
+
+// FormationRequest moves a party member to the front or back row. Member is
+// empty for the player themself; Row is "front", "back", or "status".
+type FormationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TechLevel     int32                  `protobuf:"varint,1,opt,name=tech_level,json=techLevel,proto3" json:"tech_level,omitempty"`
-	UsesRemaining int32                  `protobuf:"varint,2,opt,name=uses_remaining,json=usesRemaining,proto3" json:"uses_remaining,omitempty"`
-	MaxUses       int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Member        string                 `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Row           string                 `protobuf:"bytes,2,opt,name=row,proto3" json:"row,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpontaneousUsePoolView) Reset() {
-	*x = SpontaneousUsePoolView{}
-	mi := &file_game_v1_game_proto_msgTypes[141]
+func (x *FormationRequest) Reset() {
+	*x = FormationRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpontaneousUsePoolView) String() string {
+func (x *FormationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpontaneousUsePoolView) ProtoMessage() {}
+func (*FormationRequest) ProtoMessage() {}
 
-func (x *SpontaneousUsePoolView) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[141]
+func (x *FormationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12595,56 +17258,52 @@ func (x *SpontaneousUsePoolView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpontaneousUsePoolView.ProtoReflect.Descriptor instead.
-func (*SpontaneousUsePoolView) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{141}
-}
-
-func (x *SpontaneousUsePoolView) GetTechLevel() int32 {
-	if x != nil {
-		return x.TechLevel
-	}
-	return 0
+// Deprecated: Use FormationRequest.ProtoReflect.Descriptor instead.
+func (*FormationRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *SpontaneousUsePoolView) GetUsesRemaining() int32 {
+func (x *FormationRequest) GetMember() string {
 	if x != nil {
-		return x.UsesRemaining
+		return x.Member
 	}
-	return 0
+	return ""
 }
 
-func (x *SpontaneousUsePoolView) GetMaxUses() int32 {
+func (x *FormationRequest) GetRow() string {
 	if x != nil {
-		return x.MaxUses
+		return x.Row
 	}
-	return 0
+	return ""
 }
 
-// ResistanceEntry represents one player damage-type resistance or weakness.
-type ResistanceEntry struct {
+// OrderRequest gives the companion fighting at the player's side an order.
+// Action is "attack", "guard", "fallback", or "use"; Arg is the attack
+// target or the item to use.
+type OrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DamageType    string                 `protobuf:"bytes,1,opt,name=damage_type,json=damageType,proto3" json:"damage_type,omitempty"` // e.g. "fire", "piercing"
-	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`                            // flat amount
+	Companion     string                 `protobuf:"bytes,1,opt,name=companion,proto3" json:"companion,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Arg           string                 `protobuf:"bytes,3,opt,name=arg,proto3" json:"arg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResistanceEntry) Reset() {
-	*x = ResistanceEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[142]
+func (x *OrderRequest) Reset() {
+	*x = OrderRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResistanceEntry) String() string {
+func (x *OrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResistanceEntry) ProtoMessage() {}
+func (*OrderRequest) ProtoMessage() {}
 
-func (x *ResistanceEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[142]
+func (x *OrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12655,47 +17314,58 @@ func (x *ResistanceEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResistanceEntry.ProtoReflect.Descriptor instead.
-func (*ResistanceEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use OrderRequest.ProtoReflect.Descriptor instead.
+func (*OrderRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *ResistanceEntry) GetDamageType() string {
+func (x *OrderRequest) GetCompanion() string {
 	if x != nil {
-		return x.DamageType
+		return x.Companion
 	}
 	return ""
 }
 
-func (x *ResistanceEntry) GetValue() int32 {
+func (x *OrderRequest) GetAction() string {
 	if x != nil {
-		return x.Value
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-// ProficienciesRequest is sent by the client to view armor/weapon proficiencies.
-type ProficienciesRequest struct {
+func (x *OrderRequest) GetArg() string {
+	if x != nil {
+		return x.Arg
+	}
+	return ""
+}
+
+// JobAbilityRequest uses one of the player's job abilities in combat.
+// Target is optional; abilities aimed at an enemy default to the
+// player's current combat target.
+type JobAbilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ability       string                 `protobuf:"bytes,1,opt,name=ability,proto3" json:"ability,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProficienciesRequest) Reset() {
-	*x = ProficienciesRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[143]
+func (x *JobAbilityRequest) Reset() {
+	*x = JobAbilityRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProficienciesRequest) String() string {
+func (x *JobAbilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProficienciesRequest) ProtoMessage() {}
+func (*JobAbilityRequest) ProtoMessage() {}
 
-func (x *ProficienciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[143]
+func (x *JobAbilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12706,38 +17376,54 @@ func (x *ProficienciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProficienciesRequest.ProtoReflect.Descriptor instead.
-func (*ProficienciesRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use JobAbilityRequest.ProtoReflect.Descriptor instead.
+func (*JobAbilityRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{208}
 }
 
-// ProficiencyEntry represents one proficiency category with its rank and bonus.
-type ProficiencyEntry struct {
+func (x *JobAbilityRequest) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+func (x *JobAbilityRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// RespecRequest re-allocates point-buy abilities or swaps a chosen feat
+// at a job trainer for a fee. Abilities holds point-buy adjustments such
+// as "grit +2 flair -2", or "reset"; with neither abilities nor a feat
+// swap the trainer lists what a respec would cost.
+type RespecRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // e.g. "light_armor", "simple_weapons"
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // e.g. "Light Armor", "Simple Weapons"
-	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`         // "untrained", "trained", etc.
-	Bonus         int32                  `protobuf:"varint,4,opt,name=bonus,proto3" json:"bonus,omitempty"`      // CombatProficiencyBonus(level, rank)
-	Kind          string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`         // "armor" or "weapon"
+	NpcName       string                 `protobuf:"bytes,1,opt,name=npc_name,json=npcName,proto3" json:"npc_name,omitempty"`
+	Abilities     string                 `protobuf:"bytes,2,opt,name=abilities,proto3" json:"abilities,omitempty"`
+	OldFeat       string                 `protobuf:"bytes,3,opt,name=old_feat,json=oldFeat,proto3" json:"old_feat,omitempty"`
+	NewFeat       string                 `protobuf:"bytes,4,opt,name=new_feat,json=newFeat,proto3" json:"new_feat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProficiencyEntry) Reset() {
-	*x = ProficiencyEntry{}
-	mi := &file_game_v1_game_proto_msgTypes[144]
+func (x *RespecRequest) Reset() {
+	*x = RespecRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProficiencyEntry) String() string {
+func (x *RespecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProficiencyEntry) ProtoMessage() {}
+func (*RespecRequest) ProtoMessage() {}
 
-func (x *ProficiencyEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[144]
+func (x *RespecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12748,69 +17434,63 @@ func (x *ProficiencyEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProficiencyEntry.ProtoReflect.Descriptor instead.
-func (*ProficiencyEntry) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use RespecRequest.ProtoReflect.Descriptor instead.
+func (*RespecRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *ProficiencyEntry) GetCategory() string {
+func (x *RespecRequest) GetNpcName() string {
 	if x != nil {
-		return x.Category
+		return x.NpcName
 	}
 	return ""
 }
 
-func (x *ProficiencyEntry) GetName() string {
+func (x *RespecRequest) GetAbilities() string {
 	if x != nil {
-		return x.Name
+		return x.Abilities
 	}
 	return ""
 }
 
-func (x *ProficiencyEntry) GetRank() string {
+func (x *RespecRequest) GetOldFeat() string {
 	if x != nil {
-		return x.Rank
+		return x.OldFeat
 	}
 	return ""
 }
 
-func (x *ProficiencyEntry) GetBonus() int32 {
-	if x != nil {
-		return x.Bonus
-	}
-	return 0
-}
-
-func (x *ProficiencyEntry) GetKind() string {
+func (x *RespecRequest) GetNewFeat() string {
 	if x != nil {
-		return x.Kind
+		return x.NewFeat
 	}
 	return ""
 }
 
-// ProficienciesResponse contains all proficiency entries for the character.
-type ProficienciesResponse struct {
+// ExportCharacterRequest returns a character as JSON for charactertool import
+// (admin only). An online character is saved first.
+type ExportCharacterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Proficiencies []*ProficiencyEntry    `protobuf:"bytes,1,rep,name=proficiencies,proto3" json:"proficiencies,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProficienciesResponse) Reset() {
-	*x = ProficienciesResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[145]
+func (x *ExportCharacterRequest) Reset() {
+	*x = ExportCharacterRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProficienciesResponse) String() string {
+func (x *ExportCharacterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProficienciesResponse) ProtoMessage() {}
+func (*ExportCharacterRequest) ProtoMessage() {}
 
-func (x *ProficienciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[145]
+func (x *ExportCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12821,41 +17501,40 @@ func (x *ProficienciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProficienciesResponse.ProtoReflect.Descriptor instead.
-func (*ProficienciesResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use ExportCharacterRequest.ProtoReflect.Descriptor instead.
+func (*ExportCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *ProficienciesResponse) GetProficiencies() []*ProficiencyEntry {
+func (x *ExportCharacterRequest) GetTarget() string {
 	if x != nil {
-		return x.Proficiencies
+		return x.Target
 	}
-	return nil
+	return ""
 }
 
-// LevelUpRequest asks the server to apply a pending ability boost to the named ability.
-type LevelUpRequest struct {
+// TimeRequest asks for the game time, date, and season.
+type TimeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ability       string                 `protobuf:"bytes,1,opt,name=ability,proto3" json:"ability,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LevelUpRequest) Reset() {
-	*x = LevelUpRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[146]
+func (x *TimeRequest) Reset() {
+	*x = TimeRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LevelUpRequest) String() string {
+func (x *TimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LevelUpRequest) ProtoMessage() {}
+func (*TimeRequest) ProtoMessage() {}
 
-func (x *LevelUpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[146]
+func (x *TimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12866,41 +17545,40 @@ func (x *LevelUpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LevelUpRequest.ProtoReflect.Descriptor instead.
-func (*LevelUpRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{146}
-}
-
-func (x *LevelUpRequest) GetAbility() string {
-	if x != nil {
-		return x.Ability
-	}
-	return ""
+// Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
+func (*TimeRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{211}
 }
 
-// CombatDefaultRequest asks the server to persist the player's preferred default combat action.
-type CombatDefaultRequest struct {
+// GMRequest runs a live-event action in the sender's room (editors and
+// admins). action is one of freeze, unfreeze, heal, damage, desc, or script.
+// target names who to heal or damage; text is the scene description (empty
+// restores the room's own) or the script hook to call.
+type GMRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CombatDefaultRequest) Reset() {
-	*x = CombatDefaultRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[147]
+func (x *GMRequest) Reset() {
+	*x = GMRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CombatDefaultRequest) String() string {
+func (x *GMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CombatDefaultRequest) ProtoMessage() {}
+func (*GMRequest) ProtoMessage() {}
 
-func (x *CombatDefaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[147]
+func (x *GMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12911,18 +17589,39 @@ func (x *CombatDefaultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CombatDefaultRequest.ProtoReflect.Descriptor instead.
-func (*CombatDefaultRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use GMRequest.ProtoReflect.Descriptor instead.
+func (*GMRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *CombatDefaultRequest) GetAction() string {
+func (x *GMRequest) GetAction() string {
 	if x != nil {
 		return x.Action
 	}
 	return ""
 }
 
+func (x *GMRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GMRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GMRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 // TrainSkillRequest asks the server to advance a skill proficiency rank.
 type TrainSkillRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -12933,7 +17632,7 @@ type TrainSkillRequest struct {
 
 func (x *TrainSkillRequest) Reset() {
 	*x = TrainSkillRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[148]
+	mi := &file_game_v1_game_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12945,7 +17644,7 @@ func (x *TrainSkillRequest) String() string {
 func (*TrainSkillRequest) ProtoMessage() {}
 
 func (x *TrainSkillRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[148]
+	mi := &file_game_v1_game_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12958,7 +17657,7 @@ func (x *TrainSkillRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrainSkillRequest.ProtoReflect.Descriptor instead.
 func (*TrainSkillRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{148}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *TrainSkillRequest) GetSkillId() string {
@@ -12980,7 +17679,7 @@ type ActionRequest struct {
 
 func (x *ActionRequest) Reset() {
 	*x = ActionRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[149]
+	mi := &file_game_v1_game_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12992,7 +17691,7 @@ func (x *ActionRequest) String() string {
 func (*ActionRequest) ProtoMessage() {}
 
 func (x *ActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[149]
+	mi := &file_game_v1_game_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13005,7 +17704,7 @@ func (x *ActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionRequest.ProtoReflect.Descriptor instead.
 func (*ActionRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{149}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *ActionRequest) GetName() string {
@@ -13031,7 +17730,7 @@ type RaiseShieldRequest struct {
 
 func (x *RaiseShieldRequest) Reset() {
 	*x = RaiseShieldRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[150]
+	mi := &file_game_v1_game_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13043,7 +17742,7 @@ func (x *RaiseShieldRequest) String() string {
 func (*RaiseShieldRequest) ProtoMessage() {}
 
 func (x *RaiseShieldRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[150]
+	mi := &file_game_v1_game_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13056,7 +17755,7 @@ func (x *RaiseShieldRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RaiseShieldRequest.ProtoReflect.Descriptor instead.
 func (*RaiseShieldRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{150}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{215}
 }
 
 // TakeCoverRequest asks the server to have the player take cover.
@@ -13068,7 +17767,7 @@ type TakeCoverRequest struct {
 
 func (x *TakeCoverRequest) Reset() {
 	*x = TakeCoverRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[151]
+	mi := &file_game_v1_game_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13080,7 +17779,7 @@ func (x *TakeCoverRequest) String() string {
 func (*TakeCoverRequest) ProtoMessage() {}
 
 func (x *TakeCoverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[151]
+	mi := &file_game_v1_game_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13093,19 +17792,20 @@ func (x *TakeCoverRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TakeCoverRequest.ProtoReflect.Descriptor instead.
 func (*TakeCoverRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{151}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{216}
 }
 
 // FirstAidRequest asks the server to apply first aid to the player.
 type FirstAidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FirstAidRequest) Reset() {
 	*x = FirstAidRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[152]
+	mi := &file_game_v1_game_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13117,7 +17817,7 @@ func (x *FirstAidRequest) String() string {
 func (*FirstAidRequest) ProtoMessage() {}
 
 func (x *FirstAidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[152]
+	mi := &file_game_v1_game_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13130,7 +17830,14 @@ func (x *FirstAidRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirstAidRequest.ProtoReflect.Descriptor instead.
 func (*FirstAidRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{152}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *FirstAidRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
 }
 
 // FeintRequest asks the server to feint against a target NPC.
@@ -13143,7 +17850,7 @@ type FeintRequest struct {
 
 func (x *FeintRequest) Reset() {
 	*x = FeintRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[153]
+	mi := &file_game_v1_game_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13155,7 +17862,7 @@ func (x *FeintRequest) String() string {
 func (*FeintRequest) ProtoMessage() {}
 
 func (x *FeintRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[153]
+	mi := &file_game_v1_game_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13168,7 +17875,7 @@ func (x *FeintRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeintRequest.ProtoReflect.Descriptor instead.
 func (*FeintRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{153}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *FeintRequest) GetTarget() string {
@@ -13188,7 +17895,7 @@ type DemoralizeRequest struct {
 
 func (x *DemoralizeRequest) Reset() {
 	*x = DemoralizeRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[154]
+	mi := &file_game_v1_game_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13200,7 +17907,7 @@ func (x *DemoralizeRequest) String() string {
 func (*DemoralizeRequest) ProtoMessage() {}
 
 func (x *DemoralizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[154]
+	mi := &file_game_v1_game_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13213,7 +17920,7 @@ func (x *DemoralizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DemoralizeRequest.ProtoReflect.Descriptor instead.
 func (*DemoralizeRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{154}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *DemoralizeRequest) GetTarget() string {
@@ -13233,7 +17940,7 @@ type GrappleRequest struct {
 
 func (x *GrappleRequest) Reset() {
 	*x = GrappleRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[155]
+	mi := &file_game_v1_game_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13245,7 +17952,7 @@ func (x *GrappleRequest) String() string {
 func (*GrappleRequest) ProtoMessage() {}
 
 func (x *GrappleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[155]
+	mi := &file_game_v1_game_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13258,7 +17965,7 @@ func (x *GrappleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrappleRequest.ProtoReflect.Descriptor instead.
 func (*GrappleRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{155}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *GrappleRequest) GetTarget() string {
@@ -13278,7 +17985,7 @@ type TripRequest struct {
 
 func (x *TripRequest) Reset() {
 	*x = TripRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[156]
+	mi := &file_game_v1_game_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13290,7 +17997,7 @@ func (x *TripRequest) String() string {
 func (*TripRequest) ProtoMessage() {}
 
 func (x *TripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[156]
+	mi := &file_game_v1_game_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13303,7 +18010,7 @@ func (x *TripRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TripRequest.ProtoReflect.Descriptor instead.
 func (*TripRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{156}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *TripRequest) GetTarget() string {
@@ -13323,7 +18030,7 @@ type DisarmRequest struct {
 
 func (x *DisarmRequest) Reset() {
 	*x = DisarmRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[157]
+	mi := &file_game_v1_game_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13335,7 +18042,7 @@ func (x *DisarmRequest) String() string {
 func (*DisarmRequest) ProtoMessage() {}
 
 func (x *DisarmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[157]
+	mi := &file_game_v1_game_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13348,7 +18055,7 @@ func (x *DisarmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisarmRequest.ProtoReflect.Descriptor instead.
 func (*DisarmRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{157}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *DisarmRequest) GetTarget() string {
@@ -13368,7 +18075,7 @@ type StrideRequest struct {
 
 func (x *StrideRequest) Reset() {
 	*x = StrideRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[158]
+	mi := &file_game_v1_game_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13380,7 +18087,7 @@ func (x *StrideRequest) String() string {
 func (*StrideRequest) ProtoMessage() {}
 
 func (x *StrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[158]
+	mi := &file_game_v1_game_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13393,7 +18100,7 @@ func (x *StrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StrideRequest.ProtoReflect.Descriptor instead.
 func (*StrideRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{158}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{223}
 }
 
 func (x *StrideRequest) GetDirection() string {
@@ -13416,7 +18123,7 @@ type MoveToRequest struct {
 
 func (x *MoveToRequest) Reset() {
 	*x = MoveToRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[159]
+	mi := &file_game_v1_game_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13428,7 +18135,7 @@ func (x *MoveToRequest) String() string {
 func (*MoveToRequest) ProtoMessage() {}
 
 func (x *MoveToRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[159]
+	mi := &file_game_v1_game_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13441,7 +18148,7 @@ func (x *MoveToRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveToRequest.ProtoReflect.Descriptor instead.
 func (*MoveToRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{159}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{224}
 }
 
 func (x *MoveToRequest) GetTargetX() int32 {
@@ -13468,7 +18175,7 @@ type ShoveRequest struct {
 
 func (x *ShoveRequest) Reset() {
 	*x = ShoveRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[160]
+	mi := &file_game_v1_game_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13480,7 +18187,7 @@ func (x *ShoveRequest) String() string {
 func (*ShoveRequest) ProtoMessage() {}
 
 func (x *ShoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[160]
+	mi := &file_game_v1_game_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13493,7 +18200,7 @@ func (x *ShoveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShoveRequest.ProtoReflect.Descriptor instead.
 func (*ShoveRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{160}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{225}
 }
 
 func (x *ShoveRequest) GetTarget() string {
@@ -13514,7 +18221,7 @@ type StepRequest struct {
 
 func (x *StepRequest) Reset() {
 	*x = StepRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[161]
+	mi := &file_game_v1_game_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13526,7 +18233,7 @@ func (x *StepRequest) String() string {
 func (*StepRequest) ProtoMessage() {}
 
 func (x *StepRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[161]
+	mi := &file_game_v1_game_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13539,7 +18246,7 @@ func (x *StepRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StepRequest.ProtoReflect.Descriptor instead.
 func (*StepRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{161}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{226}
 }
 
 func (x *StepRequest) GetDirection() string {
@@ -13558,7 +18265,7 @@ type HideRequest struct {
 
 func (x *HideRequest) Reset() {
 	*x = HideRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[162]
+	mi := &file_game_v1_game_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13570,7 +18277,7 @@ func (x *HideRequest) String() string {
 func (*HideRequest) ProtoMessage() {}
 
 func (x *HideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[162]
+	mi := &file_game_v1_game_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13583,7 +18290,7 @@ func (x *HideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HideRequest.ProtoReflect.Descriptor instead.
 func (*HideRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{162}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{227}
 }
 
 // SneakRequest asks the server to attempt to sneak while hidden.
@@ -13595,7 +18302,7 @@ type SneakRequest struct {
 
 func (x *SneakRequest) Reset() {
 	*x = SneakRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[163]
+	mi := &file_game_v1_game_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13607,7 +18314,7 @@ func (x *SneakRequest) String() string {
 func (*SneakRequest) ProtoMessage() {}
 
 func (x *SneakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[163]
+	mi := &file_game_v1_game_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13620,7 +18327,7 @@ func (x *SneakRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SneakRequest.ProtoReflect.Descriptor instead.
 func (*SneakRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{163}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{228}
 }
 
 // DivertRequest asks the server to create a diversion to hide the player.
@@ -13632,7 +18339,7 @@ type DivertRequest struct {
 
 func (x *DivertRequest) Reset() {
 	*x = DivertRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[164]
+	mi := &file_game_v1_game_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13644,7 +18351,7 @@ func (x *DivertRequest) String() string {
 func (*DivertRequest) ProtoMessage() {}
 
 func (x *DivertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[164]
+	mi := &file_game_v1_game_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13657,7 +18364,7 @@ func (x *DivertRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DivertRequest.ProtoReflect.Descriptor instead.
 func (*DivertRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{164}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{229}
 }
 
 // EscapeRequest asks the server to escape from the grabbed condition.
@@ -13669,7 +18376,7 @@ type EscapeRequest struct {
 
 func (x *EscapeRequest) Reset() {
 	*x = EscapeRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[165]
+	mi := &file_game_v1_game_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13681,7 +18388,7 @@ func (x *EscapeRequest) String() string {
 func (*EscapeRequest) ProtoMessage() {}
 
 func (x *EscapeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[165]
+	mi := &file_game_v1_game_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13694,7 +18401,7 @@ func (x *EscapeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscapeRequest.ProtoReflect.Descriptor instead.
 func (*EscapeRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{165}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{230}
 }
 
 // TumbleRequest asks the server to tumble through the target NPC's space (Acrobatics vs Hustle DC).
@@ -13707,7 +18414,7 @@ type TumbleRequest struct {
 
 func (x *TumbleRequest) Reset() {
 	*x = TumbleRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[166]
+	mi := &file_game_v1_game_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13719,7 +18426,7 @@ func (x *TumbleRequest) String() string {
 func (*TumbleRequest) ProtoMessage() {}
 
 func (x *TumbleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[166]
+	mi := &file_game_v1_game_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13732,7 +18439,7 @@ func (x *TumbleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TumbleRequest.ProtoReflect.Descriptor instead.
 func (*TumbleRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{166}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{231}
 }
 
 func (x *TumbleRequest) GetTarget() string {
@@ -13751,7 +18458,7 @@ type SeekRequest struct {
 
 func (x *SeekRequest) Reset() {
 	*x = SeekRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[167]
+	mi := &file_game_v1_game_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13763,7 +18470,7 @@ func (x *SeekRequest) String() string {
 func (*SeekRequest) ProtoMessage() {}
 
 func (x *SeekRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[167]
+	mi := &file_game_v1_game_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13776,7 +18483,7 @@ func (x *SeekRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeekRequest.ProtoReflect.Descriptor instead.
 func (*SeekRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{167}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{232}
 }
 
 // ClimbRequest asks the server to attempt climbing a climbable surface.
@@ -13789,7 +18496,7 @@ type ClimbRequest struct {
 
 func (x *ClimbRequest) Reset() {
 	*x = ClimbRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[168]
+	mi := &file_game_v1_game_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13801,7 +18508,7 @@ func (x *ClimbRequest) String() string {
 func (*ClimbRequest) ProtoMessage() {}
 
 func (x *ClimbRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[168]
+	mi := &file_game_v1_game_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13814,7 +18521,7 @@ func (x *ClimbRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClimbRequest.ProtoReflect.Descriptor instead.
 func (*ClimbRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{168}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{233}
 }
 
 func (x *ClimbRequest) GetDirection() string {
@@ -13834,7 +18541,7 @@ type SwimRequest struct {
 
 func (x *SwimRequest) Reset() {
 	*x = SwimRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[169]
+	mi := &file_game_v1_game_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13846,7 +18553,7 @@ func (x *SwimRequest) String() string {
 func (*SwimRequest) ProtoMessage() {}
 
 func (x *SwimRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[169]
+	mi := &file_game_v1_game_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13859,7 +18566,7 @@ func (x *SwimRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwimRequest.ProtoReflect.Descriptor instead.
 func (*SwimRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{169}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{234}
 }
 
 func (x *SwimRequest) GetDirection() string {
@@ -13878,7 +18585,7 @@ type CalmRequest struct {
 
 func (x *CalmRequest) Reset() {
 	*x = CalmRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[170]
+	mi := &file_game_v1_game_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13890,7 +18597,7 @@ func (x *CalmRequest) String() string {
 func (*CalmRequest) ProtoMessage() {}
 
 func (x *CalmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[170]
+	mi := &file_game_v1_game_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13903,7 +18610,7 @@ func (x *CalmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalmRequest.ProtoReflect.Descriptor instead.
 func (*CalmRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{170}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{235}
 }
 
 // HeroPointRequest asks the server to spend a hero point.
@@ -13916,7 +18623,7 @@ type HeroPointRequest struct {
 
 func (x *HeroPointRequest) Reset() {
 	*x = HeroPointRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[171]
+	mi := &file_game_v1_game_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13928,7 +18635,7 @@ func (x *HeroPointRequest) String() string {
 func (*HeroPointRequest) ProtoMessage() {}
 
 func (x *HeroPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[171]
+	mi := &file_game_v1_game_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13941,7 +18648,7 @@ func (x *HeroPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeroPointRequest.ProtoReflect.Descriptor instead.
 func (*HeroPointRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{171}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{236}
 }
 
 func (x *HeroPointRequest) GetSubcommand() string {
@@ -13960,7 +18667,7 @@ type DelayRequest struct {
 
 func (x *DelayRequest) Reset() {
 	*x = DelayRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[172]
+	mi := &file_game_v1_game_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13972,7 +18679,7 @@ func (x *DelayRequest) String() string {
 func (*DelayRequest) ProtoMessage() {}
 
 func (x *DelayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[172]
+	mi := &file_game_v1_game_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13985,7 +18692,7 @@ func (x *DelayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelayRequest.ProtoReflect.Descriptor instead.
 func (*DelayRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{172}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{237}
 }
 
 // JoinRequest asks the server to join active combat in the current room.
@@ -13997,7 +18704,7 @@ type JoinRequest struct {
 
 func (x *JoinRequest) Reset() {
 	*x = JoinRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[173]
+	mi := &file_game_v1_game_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14009,7 +18716,7 @@ func (x *JoinRequest) String() string {
 func (*JoinRequest) ProtoMessage() {}
 
 func (x *JoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[173]
+	mi := &file_game_v1_game_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14022,7 +18729,7 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
 func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{173}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{238}
 }
 
 // DeclineRequest asks the server to decline joining active combat.
@@ -14034,7 +18741,7 @@ type DeclineRequest struct {
 
 func (x *DeclineRequest) Reset() {
 	*x = DeclineRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[174]
+	mi := &file_game_v1_game_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14046,7 +18753,7 @@ func (x *DeclineRequest) String() string {
 func (*DeclineRequest) ProtoMessage() {}
 
 func (x *DeclineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[174]
+	mi := &file_game_v1_game_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14059,7 +18766,7 @@ func (x *DeclineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclineRequest.ProtoReflect.Descriptor instead.
 func (*DeclineRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{174}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{239}
 }
 
 // GroupRequest asks the server to create a group or show group info.
@@ -14073,7 +18780,7 @@ type GroupRequest struct {
 
 func (x *GroupRequest) Reset() {
 	*x = GroupRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[175]
+	mi := &file_game_v1_game_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14085,7 +18792,7 @@ func (x *GroupRequest) String() string {
 func (*GroupRequest) ProtoMessage() {}
 
 func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[175]
+	mi := &file_game_v1_game_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14098,7 +18805,7 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
 func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{175}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{240}
 }
 
 func (x *GroupRequest) GetArgs() string {
@@ -14118,7 +18825,7 @@ type InviteRequest struct {
 
 func (x *InviteRequest) Reset() {
 	*x = InviteRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[176]
+	mi := &file_game_v1_game_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14130,7 +18837,7 @@ func (x *InviteRequest) String() string {
 func (*InviteRequest) ProtoMessage() {}
 
 func (x *InviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[176]
+	mi := &file_game_v1_game_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14143,7 +18850,7 @@ func (x *InviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteRequest.ProtoReflect.Descriptor instead.
 func (*InviteRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{176}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{241}
 }
 
 func (x *InviteRequest) GetPlayer() string {
@@ -14162,7 +18869,7 @@ type AcceptGroupRequest struct {
 
 func (x *AcceptGroupRequest) Reset() {
 	*x = AcceptGroupRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[177]
+	mi := &file_game_v1_game_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14174,7 +18881,7 @@ func (x *AcceptGroupRequest) String() string {
 func (*AcceptGroupRequest) ProtoMessage() {}
 
 func (x *AcceptGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[177]
+	mi := &file_game_v1_game_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14187,7 +18894,7 @@ func (x *AcceptGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptGroupRequest.ProtoReflect.Descriptor instead.
 func (*AcceptGroupRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{177}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{242}
 }
 
 // DeclineGroupRequest asks the server to decline a pending group invitation.
@@ -14199,7 +18906,7 @@ type DeclineGroupRequest struct {
 
 func (x *DeclineGroupRequest) Reset() {
 	*x = DeclineGroupRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[178]
+	mi := &file_game_v1_game_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14211,7 +18918,7 @@ func (x *DeclineGroupRequest) String() string {
 func (*DeclineGroupRequest) ProtoMessage() {}
 
 func (x *DeclineGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[178]
+	mi := &file_game_v1_game_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14224,7 +18931,7 @@ func (x *DeclineGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclineGroupRequest.ProtoReflect.Descriptor instead.
 func (*DeclineGroupRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{178}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{243}
 }
 
 // UngroupRequest asks the server to leave (or disband) the sender's group.
@@ -14236,7 +18943,7 @@ type UngroupRequest struct {
 
 func (x *UngroupRequest) Reset() {
 	*x = UngroupRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[179]
+	mi := &file_game_v1_game_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14248,7 +18955,7 @@ func (x *UngroupRequest) String() string {
 func (*UngroupRequest) ProtoMessage() {}
 
 func (x *UngroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[179]
+	mi := &file_game_v1_game_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14261,7 +18968,7 @@ func (x *UngroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UngroupRequest.ProtoReflect.Descriptor instead.
 func (*UngroupRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{179}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{244}
 }
 
 // KickRequest asks the server to remove a player from the sender's group.
@@ -14274,7 +18981,7 @@ type KickRequest struct {
 
 func (x *KickRequest) Reset() {
 	*x = KickRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[180]
+	mi := &file_game_v1_game_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14286,7 +18993,7 @@ func (x *KickRequest) String() string {
 func (*KickRequest) ProtoMessage() {}
 
 func (x *KickRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[180]
+	mi := &file_game_v1_game_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14299,7 +19006,7 @@ func (x *KickRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickRequest.ProtoReflect.Descriptor instead.
 func (*KickRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{180}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{245}
 }
 
 func (x *KickRequest) GetPlayer() string {
@@ -14319,7 +19026,7 @@ type MotiveRequest struct {
 
 func (x *MotiveRequest) Reset() {
 	*x = MotiveRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[181]
+	mi := &file_game_v1_game_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14331,7 +19038,7 @@ func (x *MotiveRequest) String() string {
 func (*MotiveRequest) ProtoMessage() {}
 
 func (x *MotiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[181]
+	mi := &file_game_v1_game_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14344,7 +19051,7 @@ func (x *MotiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MotiveRequest.ProtoReflect.Descriptor instead.
 func (*MotiveRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{181}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{246}
 }
 
 func (x *MotiveRequest) GetTarget() string {
@@ -14367,7 +19074,7 @@ type GrantRequest struct {
 
 func (x *GrantRequest) Reset() {
 	*x = GrantRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[182]
+	mi := &file_game_v1_game_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14379,7 +19086,7 @@ func (x *GrantRequest) String() string {
 func (*GrantRequest) ProtoMessage() {}
 
 func (x *GrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[182]
+	mi := &file_game_v1_game_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14392,7 +19099,7 @@ func (x *GrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantRequest.ProtoReflect.Descriptor instead.
 func (*GrantRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{182}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{247}
 }
 
 func (x *GrantRequest) GetGrantType() string {
@@ -14434,7 +19141,7 @@ type SpawnNPCRequest struct {
 
 func (x *SpawnNPCRequest) Reset() {
 	*x = SpawnNPCRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[183]
+	mi := &file_game_v1_game_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14446,7 +19153,7 @@ func (x *SpawnNPCRequest) String() string {
 func (*SpawnNPCRequest) ProtoMessage() {}
 
 func (x *SpawnNPCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[183]
+	mi := &file_game_v1_game_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14459,7 +19166,7 @@ func (x *SpawnNPCRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawnNPCRequest.ProtoReflect.Descriptor instead.
 func (*SpawnNPCRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{183}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{248}
 }
 
 func (x *SpawnNPCRequest) GetTemplateId() string {
@@ -14486,7 +19193,7 @@ type KillNPCRequest struct {
 
 func (x *KillNPCRequest) Reset() {
 	*x = KillNPCRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[184]
+	mi := &file_game_v1_game_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14498,7 +19205,7 @@ func (x *KillNPCRequest) String() string {
 func (*KillNPCRequest) ProtoMessage() {}
 
 func (x *KillNPCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[184]
+	mi := &file_game_v1_game_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14511,7 +19218,7 @@ func (x *KillNPCRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KillNPCRequest.ProtoReflect.Descriptor instead.
 func (*KillNPCRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{184}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{249}
 }
 
 func (x *KillNPCRequest) GetTemplateId() string {
@@ -14533,7 +19240,7 @@ type AddRoomRequest struct {
 
 func (x *AddRoomRequest) Reset() {
 	*x = AddRoomRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[185]
+	mi := &file_game_v1_game_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14545,7 +19252,7 @@ func (x *AddRoomRequest) String() string {
 func (*AddRoomRequest) ProtoMessage() {}
 
 func (x *AddRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[185]
+	mi := &file_game_v1_game_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14558,7 +19265,7 @@ func (x *AddRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddRoomRequest.ProtoReflect.Descriptor instead.
 func (*AddRoomRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{185}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{250}
 }
 
 func (x *AddRoomRequest) GetZoneId() string {
@@ -14594,7 +19301,7 @@ type AddLinkRequest struct {
 
 func (x *AddLinkRequest) Reset() {
 	*x = AddLinkRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[186]
+	mi := &file_game_v1_game_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14606,7 +19313,7 @@ func (x *AddLinkRequest) String() string {
 func (*AddLinkRequest) ProtoMessage() {}
 
 func (x *AddLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[186]
+	mi := &file_game_v1_game_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14619,7 +19326,7 @@ func (x *AddLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddLinkRequest.ProtoReflect.Descriptor instead.
 func (*AddLinkRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{186}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{251}
 }
 
 func (x *AddLinkRequest) GetFromRoomId() string {
@@ -14654,7 +19361,7 @@ type RemoveLinkRequest struct {
 
 func (x *RemoveLinkRequest) Reset() {
 	*x = RemoveLinkRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[187]
+	mi := &file_game_v1_game_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14666,7 +19373,7 @@ func (x *RemoveLinkRequest) String() string {
 func (*RemoveLinkRequest) ProtoMessage() {}
 
 func (x *RemoveLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[187]
+	mi := &file_game_v1_game_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14679,7 +19386,7 @@ func (x *RemoveLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveLinkRequest.ProtoReflect.Descriptor instead.
 func (*RemoveLinkRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{187}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{252}
 }
 
 func (x *RemoveLinkRequest) GetRoomId() string {
@@ -14707,7 +19414,7 @@ type SetRoomRequest struct {
 
 func (x *SetRoomRequest) Reset() {
 	*x = SetRoomRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[188]
+	mi := &file_game_v1_game_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14719,7 +19426,7 @@ func (x *SetRoomRequest) String() string {
 func (*SetRoomRequest) ProtoMessage() {}
 
 func (x *SetRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[188]
+	mi := &file_game_v1_game_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14732,7 +19439,7 @@ func (x *SetRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetRoomRequest.ProtoReflect.Descriptor instead.
 func (*SetRoomRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{188}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{253}
 }
 
 func (x *SetRoomRequest) GetField() string {
@@ -14758,7 +19465,7 @@ type EditorCmdsRequest struct {
 
 func (x *EditorCmdsRequest) Reset() {
 	*x = EditorCmdsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[189]
+	mi := &file_game_v1_game_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14770,7 +19477,7 @@ func (x *EditorCmdsRequest) String() string {
 func (*EditorCmdsRequest) ProtoMessage() {}
 
 func (x *EditorCmdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[189]
+	mi := &file_game_v1_game_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14783,7 +19490,7 @@ func (x *EditorCmdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditorCmdsRequest.ProtoReflect.Descriptor instead.
 func (*EditorCmdsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{189}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{254}
 }
 
 // SpawnCharRequest asks the server to create a test character for the claude_player account.
@@ -14796,7 +19503,7 @@ type SpawnCharRequest struct {
 
 func (x *SpawnCharRequest) Reset() {
 	*x = SpawnCharRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[190]
+	mi := &file_game_v1_game_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14808,7 +19515,7 @@ func (x *SpawnCharRequest) String() string {
 func (*SpawnCharRequest) ProtoMessage() {}
 
 func (x *SpawnCharRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[190]
+	mi := &file_game_v1_game_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14821,7 +19528,7 @@ func (x *SpawnCharRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawnCharRequest.ProtoReflect.Descriptor instead.
 func (*SpawnCharRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{190}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{255}
 }
 
 func (x *SpawnCharRequest) GetName() string {
@@ -14841,7 +19548,7 @@ type DeleteCharRequest struct {
 
 func (x *DeleteCharRequest) Reset() {
 	*x = DeleteCharRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[191]
+	mi := &file_game_v1_game_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14853,7 +19560,7 @@ func (x *DeleteCharRequest) String() string {
 func (*DeleteCharRequest) ProtoMessage() {}
 
 func (x *DeleteCharRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[191]
+	mi := &file_game_v1_game_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14866,7 +19573,7 @@ func (x *DeleteCharRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCharRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCharRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{191}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{256}
 }
 
 func (x *DeleteCharRequest) GetName() string {
@@ -14885,7 +19592,7 @@ type FactionRequest struct {
 
 func (x *FactionRequest) Reset() {
 	*x = FactionRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[192]
+	mi := &file_game_v1_game_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14897,7 +19604,7 @@ func (x *FactionRequest) String() string {
 func (*FactionRequest) ProtoMessage() {}
 
 func (x *FactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[192]
+	mi := &file_game_v1_game_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14910,7 +19617,7 @@ func (x *FactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactionRequest.ProtoReflect.Descriptor instead.
 func (*FactionRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{192}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{257}
 }
 
 // FactionInfoRequest asks the server for public information about a specific faction.
@@ -14923,7 +19630,7 @@ type FactionInfoRequest struct {
 
 func (x *FactionInfoRequest) Reset() {
 	*x = FactionInfoRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[193]
+	mi := &file_game_v1_game_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14935,7 +19642,7 @@ func (x *FactionInfoRequest) String() string {
 func (*FactionInfoRequest) ProtoMessage() {}
 
 func (x *FactionInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[193]
+	mi := &file_game_v1_game_proto_msgTypes[258]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14948,7 +19655,7 @@ func (x *FactionInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactionInfoRequest.ProtoReflect.Descriptor instead.
 func (*FactionInfoRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{193}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{258}
 }
 
 func (x *FactionInfoRequest) GetFactionId() string {
@@ -14967,7 +19674,7 @@ type FactionStandingRequest struct {
 
 func (x *FactionStandingRequest) Reset() {
 	*x = FactionStandingRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[194]
+	mi := &file_game_v1_game_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14979,7 +19686,7 @@ func (x *FactionStandingRequest) String() string {
 func (*FactionStandingRequest) ProtoMessage() {}
 
 func (x *FactionStandingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[194]
+	mi := &file_game_v1_game_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14992,7 +19699,7 @@ func (x *FactionStandingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactionStandingRequest.ProtoReflect.Descriptor instead.
 func (*FactionStandingRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{194}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{259}
 }
 
 // ChangeRepRequest asks a Fixer NPC to improve the player's faction standing for currency.
@@ -15005,7 +19712,7 @@ type ChangeRepRequest struct {
 
 func (x *ChangeRepRequest) Reset() {
 	*x = ChangeRepRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[195]
+	mi := &file_game_v1_game_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15017,7 +19724,7 @@ func (x *ChangeRepRequest) String() string {
 func (*ChangeRepRequest) ProtoMessage() {}
 
 func (x *ChangeRepRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[195]
+	mi := &file_game_v1_game_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15030,7 +19737,7 @@ func (x *ChangeRepRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeRepRequest.ProtoReflect.Descriptor instead.
 func (*ChangeRepRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{195}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{260}
 }
 
 func (x *ChangeRepRequest) GetFactionId() string {
@@ -15050,7 +19757,7 @@ type TabCompleteRequest struct {
 
 func (x *TabCompleteRequest) Reset() {
 	*x = TabCompleteRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[196]
+	mi := &file_game_v1_game_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15062,7 +19769,7 @@ func (x *TabCompleteRequest) String() string {
 func (*TabCompleteRequest) ProtoMessage() {}
 
 func (x *TabCompleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[196]
+	mi := &file_game_v1_game_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15075,7 +19782,7 @@ func (x *TabCompleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TabCompleteRequest.ProtoReflect.Descriptor instead.
 func (*TabCompleteRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{196}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{261}
 }
 
 func (x *TabCompleteRequest) GetPrefix() string {
@@ -15095,7 +19802,7 @@ type TabCompleteResponse struct {
 
 func (x *TabCompleteResponse) Reset() {
 	*x = TabCompleteResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[197]
+	mi := &file_game_v1_game_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15107,7 +19814,7 @@ func (x *TabCompleteResponse) String() string {
 func (*TabCompleteResponse) ProtoMessage() {}
 
 func (x *TabCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[197]
+	mi := &file_game_v1_game_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15120,7 +19827,7 @@ func (x *TabCompleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TabCompleteResponse.ProtoReflect.Descriptor instead.
 func (*TabCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{197}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{262}
 }
 
 func (x *TabCompleteResponse) GetCompletions() []string {
@@ -15140,7 +19847,7 @@ type MaterialsRequest struct {
 
 func (x *MaterialsRequest) Reset() {
 	*x = MaterialsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[198]
+	mi := &file_game_v1_game_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15152,7 +19859,7 @@ func (x *MaterialsRequest) String() string {
 func (*MaterialsRequest) ProtoMessage() {}
 
 func (x *MaterialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[198]
+	mi := &file_game_v1_game_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15165,7 +19872,7 @@ func (x *MaterialsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaterialsRequest.ProtoReflect.Descriptor instead.
 func (*MaterialsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{198}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{263}
 }
 
 func (x *MaterialsRequest) GetCategory() string {
@@ -15185,7 +19892,7 @@ type CraftListRequest struct {
 
 func (x *CraftListRequest) Reset() {
 	*x = CraftListRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[199]
+	mi := &file_game_v1_game_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15197,7 +19904,7 @@ func (x *CraftListRequest) String() string {
 func (*CraftListRequest) ProtoMessage() {}
 
 func (x *CraftListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[199]
+	mi := &file_game_v1_game_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15210,7 +19917,7 @@ func (x *CraftListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftListRequest.ProtoReflect.Descriptor instead.
 func (*CraftListRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{199}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{264}
 }
 
 func (x *CraftListRequest) GetCategory() string {
@@ -15230,7 +19937,7 @@ type CraftRequest struct {
 
 func (x *CraftRequest) Reset() {
 	*x = CraftRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[200]
+	mi := &file_game_v1_game_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15242,7 +19949,7 @@ func (x *CraftRequest) String() string {
 func (*CraftRequest) ProtoMessage() {}
 
 func (x *CraftRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[200]
+	mi := &file_game_v1_game_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15255,7 +19962,7 @@ func (x *CraftRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftRequest.ProtoReflect.Descriptor instead.
 func (*CraftRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{200}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{265}
 }
 
 func (x *CraftRequest) GetRecipeId() string {
@@ -15274,7 +19981,7 @@ type CraftConfirmRequest struct {
 
 func (x *CraftConfirmRequest) Reset() {
 	*x = CraftConfirmRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[201]
+	mi := &file_game_v1_game_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15286,7 +19993,7 @@ func (x *CraftConfirmRequest) String() string {
 func (*CraftConfirmRequest) ProtoMessage() {}
 
 func (x *CraftConfirmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[201]
+	mi := &file_game_v1_game_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15299,7 +20006,7 @@ func (x *CraftConfirmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftConfirmRequest.ProtoReflect.Descriptor instead.
 func (*CraftConfirmRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{201}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{266}
 }
 
 // ScavengeRequest asks the server to scavenge the current room for materials.
@@ -15311,7 +20018,7 @@ type ScavengeRequest struct {
 
 func (x *ScavengeRequest) Reset() {
 	*x = ScavengeRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[202]
+	mi := &file_game_v1_game_proto_msgTypes[267]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15323,7 +20030,7 @@ func (x *ScavengeRequest) String() string {
 func (*ScavengeRequest) ProtoMessage() {}
 
 func (x *ScavengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[202]
+	mi := &file_game_v1_game_proto_msgTypes[267]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15336,7 +20043,7 @@ func (x *ScavengeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScavengeRequest.ProtoReflect.Descriptor instead.
 func (*ScavengeRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{202}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{267}
 }
 
 // AffixRequest asks the server to affix a precious material to an equipped item.
@@ -15350,7 +20057,7 @@ type AffixRequest struct {
 
 func (x *AffixRequest) Reset() {
 	*x = AffixRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[203]
+	mi := &file_game_v1_game_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15362,7 +20069,7 @@ func (x *AffixRequest) String() string {
 func (*AffixRequest) ProtoMessage() {}
 
 func (x *AffixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[203]
+	mi := &file_game_v1_game_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15375,7 +20082,7 @@ func (x *AffixRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AffixRequest.ProtoReflect.Descriptor instead.
 func (*AffixRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{203}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{268}
 }
 
 func (x *AffixRequest) GetMaterialQuery() string {
@@ -15408,7 +20115,7 @@ type ExploreRequest struct {
 
 func (x *ExploreRequest) Reset() {
 	*x = ExploreRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[204]
+	mi := &file_game_v1_game_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15420,7 +20127,7 @@ func (x *ExploreRequest) String() string {
 func (*ExploreRequest) ProtoMessage() {}
 
 func (x *ExploreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[204]
+	mi := &file_game_v1_game_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15433,7 +20140,7 @@ func (x *ExploreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExploreRequest.ProtoReflect.Descriptor instead.
 func (*ExploreRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{204}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{269}
 }
 
 func (x *ExploreRequest) GetMode() string {
@@ -15459,7 +20166,7 @@ type RefocusRequest struct {
 
 func (x *RefocusRequest) Reset() {
 	*x = RefocusRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[205]
+	mi := &file_game_v1_game_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15471,7 +20178,7 @@ func (x *RefocusRequest) String() string {
 func (*RefocusRequest) ProtoMessage() {}
 
 func (x *RefocusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[205]
+	mi := &file_game_v1_game_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15484,7 +20191,7 @@ func (x *RefocusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefocusRequest.ProtoReflect.Descriptor instead.
 func (*RefocusRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{205}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{270}
 }
 
 // SeduceRequest asks the server to attempt to seduce a target NPC (REQ-ZN-7).
@@ -15497,7 +20204,7 @@ type SeduceRequest struct {
 
 func (x *SeduceRequest) Reset() {
 	*x = SeduceRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[206]
+	mi := &file_game_v1_game_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15509,7 +20216,7 @@ func (x *SeduceRequest) String() string {
 func (*SeduceRequest) ProtoMessage() {}
 
 func (x *SeduceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[206]
+	mi := &file_game_v1_game_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15522,7 +20229,7 @@ func (x *SeduceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeduceRequest.ProtoReflect.Descriptor instead.
 func (*SeduceRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{206}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{271}
 }
 
 func (x *SeduceRequest) GetTarget() string {
@@ -15557,7 +20264,7 @@ type HotbarSlot struct {
 
 func (x *HotbarSlot) Reset() {
 	*x = HotbarSlot{}
-	mi := &file_game_v1_game_proto_msgTypes[207]
+	mi := &file_game_v1_game_proto_msgTypes[272]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15569,7 +20276,7 @@ func (x *HotbarSlot) String() string {
 func (*HotbarSlot) ProtoMessage() {}
 
 func (x *HotbarSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[207]
+	mi := &file_game_v1_game_proto_msgTypes[272]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15582,7 +20289,7 @@ func (x *HotbarSlot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HotbarSlot.ProtoReflect.Descriptor instead.
 func (*HotbarSlot) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{207}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{272}
 }
 
 func (x *HotbarSlot) GetKind() string {
@@ -15665,7 +20372,7 @@ type HotbarRequest struct {
 
 func (x *HotbarRequest) Reset() {
 	*x = HotbarRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[208]
+	mi := &file_game_v1_game_proto_msgTypes[273]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15677,7 +20384,7 @@ func (x *HotbarRequest) String() string {
 func (*HotbarRequest) ProtoMessage() {}
 
 func (x *HotbarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[208]
+	mi := &file_game_v1_game_proto_msgTypes[273]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15690,7 +20397,7 @@ func (x *HotbarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HotbarRequest.ProtoReflect.Descriptor instead.
 func (*HotbarRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{208}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{273}
 }
 
 func (x *HotbarRequest) GetAction() string {
@@ -15750,7 +20457,7 @@ type HotbarUpdateEvent struct {
 
 func (x *HotbarUpdateEvent) Reset() {
 	*x = HotbarUpdateEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[209]
+	mi := &file_game_v1_game_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15762,7 +20469,7 @@ func (x *HotbarUpdateEvent) String() string {
 func (*HotbarUpdateEvent) ProtoMessage() {}
 
 func (x *HotbarUpdateEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[209]
+	mi := &file_game_v1_game_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15775,7 +20482,7 @@ func (x *HotbarUpdateEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HotbarUpdateEvent.ProtoReflect.Descriptor instead.
 func (*HotbarUpdateEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{209}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{274}
 }
 
 func (x *HotbarUpdateEvent) GetSlots() []*HotbarSlot {
@@ -15817,7 +20524,7 @@ type DowntimeRequest struct {
 
 func (x *DowntimeRequest) Reset() {
 	*x = DowntimeRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[210]
+	mi := &file_game_v1_game_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15829,7 +20536,7 @@ func (x *DowntimeRequest) String() string {
 func (*DowntimeRequest) ProtoMessage() {}
 
 func (x *DowntimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[210]
+	mi := &file_game_v1_game_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15842,7 +20549,7 @@ func (x *DowntimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DowntimeRequest.ProtoReflect.Descriptor instead.
 func (*DowntimeRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{210}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{275}
 }
 
 func (x *DowntimeRequest) GetSubcommand() string {
@@ -15870,7 +20577,7 @@ type QuestRequest struct {
 
 func (x *QuestRequest) Reset() {
 	*x = QuestRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[211]
+	mi := &file_game_v1_game_proto_msgTypes[276]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15882,7 +20589,7 @@ func (x *QuestRequest) String() string {
 func (*QuestRequest) ProtoMessage() {}
 
 func (x *QuestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[211]
+	mi := &file_game_v1_game_proto_msgTypes[276]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15895,7 +20602,7 @@ func (x *QuestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestRequest.ProtoReflect.Descriptor instead.
 func (*QuestRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{211}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{276}
 }
 
 func (x *QuestRequest) GetArgs() string {
@@ -15916,7 +20623,7 @@ type MaterialLoss struct {
 
 func (x *MaterialLoss) Reset() {
 	*x = MaterialLoss{}
-	mi := &file_game_v1_game_proto_msgTypes[212]
+	mi := &file_game_v1_game_proto_msgTypes[277]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15928,7 +20635,7 @@ func (x *MaterialLoss) String() string {
 func (*MaterialLoss) ProtoMessage() {}
 
 func (x *MaterialLoss) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[212]
+	mi := &file_game_v1_game_proto_msgTypes[277]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15941,7 +20648,7 @@ func (x *MaterialLoss) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaterialLoss.ProtoReflect.Descriptor instead.
 func (*MaterialLoss) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{212}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{277}
 }
 
 func (x *MaterialLoss) GetMaterialId() string {
@@ -15971,7 +20678,7 @@ type CraftResultEvent struct {
 
 func (x *CraftResultEvent) Reset() {
 	*x = CraftResultEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[213]
+	mi := &file_game_v1_game_proto_msgTypes[278]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15983,7 +20690,7 @@ func (x *CraftResultEvent) String() string {
 func (*CraftResultEvent) ProtoMessage() {}
 
 func (x *CraftResultEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[213]
+	mi := &file_game_v1_game_proto_msgTypes[278]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15996,7 +20703,7 @@ func (x *CraftResultEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftResultEvent.ProtoReflect.Descriptor instead.
 func (*CraftResultEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{213}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{278}
 }
 
 func (x *CraftResultEvent) GetSuccess() bool {
@@ -16038,7 +20745,7 @@ type UncurseRequest struct {
 
 func (x *UncurseRequest) Reset() {
 	*x = UncurseRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[214]
+	mi := &file_game_v1_game_proto_msgTypes[279]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16050,7 +20757,7 @@ func (x *UncurseRequest) String() string {
 func (*UncurseRequest) ProtoMessage() {}
 
 func (x *UncurseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[214]
+	mi := &file_game_v1_game_proto_msgTypes[279]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16063,7 +20770,7 @@ func (x *UncurseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UncurseRequest.ProtoReflect.Descriptor instead.
 func (*UncurseRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{214}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{279}
 }
 
 func (x *UncurseRequest) GetNpcName() string {
@@ -16092,7 +20799,7 @@ type WeatherEvent struct {
 
 func (x *WeatherEvent) Reset() {
 	*x = WeatherEvent{}
-	mi := &file_game_v1_game_proto_msgTypes[215]
+	mi := &file_game_v1_game_proto_msgTypes[280]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16104,7 +20811,7 @@ func (x *WeatherEvent) String() string {
 func (*WeatherEvent) ProtoMessage() {}
 
 func (x *WeatherEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[215]
+	mi := &file_game_v1_game_proto_msgTypes[280]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16117,7 +20824,7 @@ func (x *WeatherEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherEvent.ProtoReflect.Descriptor instead.
 func (*WeatherEvent) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{215}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{280}
 }
 
 func (x *WeatherEvent) GetWeatherName() string {
@@ -16150,7 +20857,7 @@ type JobGrantsRequest struct {
 
 func (x *JobGrantsRequest) Reset() {
 	*x = JobGrantsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[216]
+	mi := &file_game_v1_game_proto_msgTypes[281]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16162,7 +20869,7 @@ func (x *JobGrantsRequest) String() string {
 func (*JobGrantsRequest) ProtoMessage() {}
 
 func (x *JobGrantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[216]
+	mi := &file_game_v1_game_proto_msgTypes[281]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16175,7 +20882,7 @@ func (x *JobGrantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobGrantsRequest.ProtoReflect.Descriptor instead.
 func (*JobGrantsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{216}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{281}
 }
 
 // JobFeatGrant describes a single feat granted by the job at a specific level.
@@ -16190,7 +20897,7 @@ type JobFeatGrant struct {
 
 func (x *JobFeatGrant) Reset() {
 	*x = JobFeatGrant{}
-	mi := &file_game_v1_game_proto_msgTypes[217]
+	mi := &file_game_v1_game_proto_msgTypes[282]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16202,7 +20909,7 @@ func (x *JobFeatGrant) String() string {
 func (*JobFeatGrant) ProtoMessage() {}
 
 func (x *JobFeatGrant) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[217]
+	mi := &file_game_v1_game_proto_msgTypes[282]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16215,7 +20922,7 @@ func (x *JobFeatGrant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobFeatGrant.ProtoReflect.Descriptor instead.
 func (*JobFeatGrant) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{217}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{282}
 }
 
 func (x *JobFeatGrant) GetGrantLevel() int32 {
@@ -16253,7 +20960,7 @@ type JobTechGrant struct {
 
 func (x *JobTechGrant) Reset() {
 	*x = JobTechGrant{}
-	mi := &file_game_v1_game_proto_msgTypes[218]
+	mi := &file_game_v1_game_proto_msgTypes[283]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16265,7 +20972,7 @@ func (x *JobTechGrant) String() string {
 func (*JobTechGrant) ProtoMessage() {}
 
 func (x *JobTechGrant) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[218]
+	mi := &file_game_v1_game_proto_msgTypes[283]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16278,7 +20985,7 @@ func (x *JobTechGrant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobTechGrant.ProtoReflect.Descriptor instead.
 func (*JobTechGrant) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{218}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{283}
 }
 
 func (x *JobTechGrant) GetGrantLevel() int32 {
@@ -16328,7 +21035,7 @@ type JobGrantsResponse struct {
 
 func (x *JobGrantsResponse) Reset() {
 	*x = JobGrantsResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[219]
+	mi := &file_game_v1_game_proto_msgTypes[284]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16340,7 +21047,7 @@ func (x *JobGrantsResponse) String() string {
 func (*JobGrantsResponse) ProtoMessage() {}
 
 func (x *JobGrantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[219]
+	mi := &file_game_v1_game_proto_msgTypes[284]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16353,7 +21060,7 @@ func (x *JobGrantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobGrantsResponse.ProtoReflect.Descriptor instead.
 func (*JobGrantsResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{219}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{284}
 }
 
 func (x *JobGrantsResponse) GetFeatGrants() []*JobFeatGrant {
@@ -16392,7 +21099,7 @@ type FeatOption struct {
 
 func (x *FeatOption) Reset() {
 	*x = FeatOption{}
-	mi := &file_game_v1_game_proto_msgTypes[220]
+	mi := &file_game_v1_game_proto_msgTypes[285]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16404,7 +21111,7 @@ func (x *FeatOption) String() string {
 func (*FeatOption) ProtoMessage() {}
 
 func (x *FeatOption) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[220]
+	mi := &file_game_v1_game_proto_msgTypes[285]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16417,7 +21124,7 @@ func (x *FeatOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatOption.ProtoReflect.Descriptor instead.
 func (*FeatOption) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{220}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{285}
 }
 
 func (x *FeatOption) GetFeatId() string {
@@ -16462,7 +21169,7 @@ type PendingFeatChoice struct {
 
 func (x *PendingFeatChoice) Reset() {
 	*x = PendingFeatChoice{}
-	mi := &file_game_v1_game_proto_msgTypes[221]
+	mi := &file_game_v1_game_proto_msgTypes[286]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16474,7 +21181,7 @@ func (x *PendingFeatChoice) String() string {
 func (*PendingFeatChoice) ProtoMessage() {}
 
 func (x *PendingFeatChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[221]
+	mi := &file_game_v1_game_proto_msgTypes[286]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16487,7 +21194,7 @@ func (x *PendingFeatChoice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingFeatChoice.ProtoReflect.Descriptor instead.
 func (*PendingFeatChoice) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{221}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{286}
 }
 
 func (x *PendingFeatChoice) GetGrantLevel() int32 {
@@ -16524,7 +21231,7 @@ type ChooseFeatRequest struct {
 
 func (x *ChooseFeatRequest) Reset() {
 	*x = ChooseFeatRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[222]
+	mi := &file_game_v1_game_proto_msgTypes[287]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16536,7 +21243,7 @@ func (x *ChooseFeatRequest) String() string {
 func (*ChooseFeatRequest) ProtoMessage() {}
 
 func (x *ChooseFeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[222]
+	mi := &file_game_v1_game_proto_msgTypes[287]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16549,7 +21256,7 @@ func (x *ChooseFeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChooseFeatRequest.ProtoReflect.Descriptor instead.
 func (*ChooseFeatRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{222}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{287}
 }
 
 func (x *ChooseFeatRequest) GetGrantLevel() int32 {
@@ -16582,7 +21289,7 @@ type AdminSessionInfo struct {
 
 func (x *AdminSessionInfo) Reset() {
 	*x = AdminSessionInfo{}
-	mi := &file_game_v1_game_proto_msgTypes[223]
+	mi := &file_game_v1_game_proto_msgTypes[288]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16594,7 +21301,7 @@ func (x *AdminSessionInfo) String() string {
 func (*AdminSessionInfo) ProtoMessage() {}
 
 func (x *AdminSessionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[223]
+	mi := &file_game_v1_game_proto_msgTypes[288]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16607,7 +21314,7 @@ func (x *AdminSessionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminSessionInfo.ProtoReflect.Descriptor instead.
 func (*AdminSessionInfo) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{223}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{288}
 }
 
 func (x *AdminSessionInfo) GetCharId() int64 {
@@ -16667,7 +21374,7 @@ type AdminListSessionsRequest struct {
 
 func (x *AdminListSessionsRequest) Reset() {
 	*x = AdminListSessionsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[224]
+	mi := &file_game_v1_game_proto_msgTypes[289]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16679,7 +21386,7 @@ func (x *AdminListSessionsRequest) String() string {
 func (*AdminListSessionsRequest) ProtoMessage() {}
 
 func (x *AdminListSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[224]
+	mi := &file_game_v1_game_proto_msgTypes[289]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16692,7 +21399,7 @@ func (x *AdminListSessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListSessionsRequest.ProtoReflect.Descriptor instead.
 func (*AdminListSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{224}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{289}
 }
 
 type AdminListSessionsResponse struct {
@@ -16704,7 +21411,7 @@ type AdminListSessionsResponse struct {
 
 func (x *AdminListSessionsResponse) Reset() {
 	*x = AdminListSessionsResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[225]
+	mi := &file_game_v1_game_proto_msgTypes[290]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16716,7 +21423,7 @@ func (x *AdminListSessionsResponse) String() string {
 func (*AdminListSessionsResponse) ProtoMessage() {}
 
 func (x *AdminListSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[225]
+	mi := &file_game_v1_game_proto_msgTypes[290]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16729,7 +21436,7 @@ func (x *AdminListSessionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListSessionsResponse.ProtoReflect.Descriptor instead.
 func (*AdminListSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{225}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{290}
 }
 
 func (x *AdminListSessionsResponse) GetSessions() []*AdminSessionInfo {
@@ -16748,7 +21455,7 @@ type AdminKickRequest struct {
 
 func (x *AdminKickRequest) Reset() {
 	*x = AdminKickRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[226]
+	mi := &file_game_v1_game_proto_msgTypes[291]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16760,7 +21467,7 @@ func (x *AdminKickRequest) String() string {
 func (*AdminKickRequest) ProtoMessage() {}
 
 func (x *AdminKickRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[226]
+	mi := &file_game_v1_game_proto_msgTypes[291]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16773,7 +21480,7 @@ func (x *AdminKickRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminKickRequest.ProtoReflect.Descriptor instead.
 func (*AdminKickRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{226}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{291}
 }
 
 func (x *AdminKickRequest) GetCharId() int64 {
@@ -16791,7 +21498,7 @@ type AdminKickResponse struct {
 
 func (x *AdminKickResponse) Reset() {
 	*x = AdminKickResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[227]
+	mi := &file_game_v1_game_proto_msgTypes[292]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16803,7 +21510,7 @@ func (x *AdminKickResponse) String() string {
 func (*AdminKickResponse) ProtoMessage() {}
 
 func (x *AdminKickResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[227]
+	mi := &file_game_v1_game_proto_msgTypes[292]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16816,7 +21523,7 @@ func (x *AdminKickResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminKickResponse.ProtoReflect.Descriptor instead.
 func (*AdminKickResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{227}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{292}
 }
 
 type AdminMessageRequest struct {
@@ -16829,7 +21536,7 @@ type AdminMessageRequest struct {
 
 func (x *AdminMessageRequest) Reset() {
 	*x = AdminMessageRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[228]
+	mi := &file_game_v1_game_proto_msgTypes[293]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16841,7 +21548,7 @@ func (x *AdminMessageRequest) String() string {
 func (*AdminMessageRequest) ProtoMessage() {}
 
 func (x *AdminMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[228]
+	mi := &file_game_v1_game_proto_msgTypes[293]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16854,7 +21561,7 @@ func (x *AdminMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminMessageRequest.ProtoReflect.Descriptor instead.
 func (*AdminMessageRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{228}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{293}
 }
 
 func (x *AdminMessageRequest) GetCharId() int64 {
@@ -16879,7 +21586,7 @@ type AdminMessageResponse struct {
 
 func (x *AdminMessageResponse) Reset() {
 	*x = AdminMessageResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[229]
+	mi := &file_game_v1_game_proto_msgTypes[294]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16891,7 +21598,7 @@ func (x *AdminMessageResponse) String() string {
 func (*AdminMessageResponse) ProtoMessage() {}
 
 func (x *AdminMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[229]
+	mi := &file_game_v1_game_proto_msgTypes[294]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16904,7 +21611,7 @@ func (x *AdminMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminMessageResponse.ProtoReflect.Descriptor instead.
 func (*AdminMessageResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{229}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{294}
 }
 
 type AdminTeleportRequest struct {
@@ -16917,7 +21624,7 @@ type AdminTeleportRequest struct {
 
 func (x *AdminTeleportRequest) Reset() {
 	*x = AdminTeleportRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[230]
+	mi := &file_game_v1_game_proto_msgTypes[295]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16929,7 +21636,7 @@ func (x *AdminTeleportRequest) String() string {
 func (*AdminTeleportRequest) ProtoMessage() {}
 
 func (x *AdminTeleportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[230]
+	mi := &file_game_v1_game_proto_msgTypes[295]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16942,7 +21649,7 @@ func (x *AdminTeleportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTeleportRequest.ProtoReflect.Descriptor instead.
 func (*AdminTeleportRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{230}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{295}
 }
 
 func (x *AdminTeleportRequest) GetCharId() int64 {
@@ -16967,7 +21674,7 @@ type AdminTeleportResponse struct {
 
 func (x *AdminTeleportResponse) Reset() {
 	*x = AdminTeleportResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[231]
+	mi := &file_game_v1_game_proto_msgTypes[296]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16979,7 +21686,7 @@ func (x *AdminTeleportResponse) String() string {
 func (*AdminTeleportResponse) ProtoMessage() {}
 
 func (x *AdminTeleportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[231]
+	mi := &file_game_v1_game_proto_msgTypes[296]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16992,7 +21699,7 @@ func (x *AdminTeleportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTeleportResponse.ProtoReflect.Descriptor instead.
 func (*AdminTeleportResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{231}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{296}
 }
 
 type AdminListZonesRequest struct {
@@ -17003,7 +21710,7 @@ type AdminListZonesRequest struct {
 
 func (x *AdminListZonesRequest) Reset() {
 	*x = AdminListZonesRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[232]
+	mi := &file_game_v1_game_proto_msgTypes[297]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17015,7 +21722,7 @@ func (x *AdminListZonesRequest) String() string {
 func (*AdminListZonesRequest) ProtoMessage() {}
 
 func (x *AdminListZonesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[232]
+	mi := &file_game_v1_game_proto_msgTypes[297]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17028,7 +21735,7 @@ func (x *AdminListZonesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListZonesRequest.ProtoReflect.Descriptor instead.
 func (*AdminListZonesRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{232}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{297}
 }
 
 type AdminZoneSummary struct {
@@ -17043,7 +21750,7 @@ type AdminZoneSummary struct {
 
 func (x *AdminZoneSummary) Reset() {
 	*x = AdminZoneSummary{}
-	mi := &file_game_v1_game_proto_msgTypes[233]
+	mi := &file_game_v1_game_proto_msgTypes[298]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17055,7 +21762,7 @@ func (x *AdminZoneSummary) String() string {
 func (*AdminZoneSummary) ProtoMessage() {}
 
 func (x *AdminZoneSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[233]
+	mi := &file_game_v1_game_proto_msgTypes[298]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17068,7 +21775,7 @@ func (x *AdminZoneSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminZoneSummary.ProtoReflect.Descriptor instead.
 func (*AdminZoneSummary) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{233}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{298}
 }
 
 func (x *AdminZoneSummary) GetId() string {
@@ -17108,7 +21815,7 @@ type AdminListZonesResponse struct {
 
 func (x *AdminListZonesResponse) Reset() {
 	*x = AdminListZonesResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[234]
+	mi := &file_game_v1_game_proto_msgTypes[299]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17120,7 +21827,7 @@ func (x *AdminListZonesResponse) String() string {
 func (*AdminListZonesResponse) ProtoMessage() {}
 
 func (x *AdminListZonesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[234]
+	mi := &file_game_v1_game_proto_msgTypes[299]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17133,7 +21840,7 @@ func (x *AdminListZonesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListZonesResponse.ProtoReflect.Descriptor instead.
 func (*AdminListZonesResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{234}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{299}
 }
 
 func (x *AdminListZonesResponse) GetZones() []*AdminZoneSummary {
@@ -17152,7 +21859,7 @@ type AdminListRoomsRequest struct {
 
 func (x *AdminListRoomsRequest) Reset() {
 	*x = AdminListRoomsRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[235]
+	mi := &file_game_v1_game_proto_msgTypes[300]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17164,7 +21871,7 @@ func (x *AdminListRoomsRequest) String() string {
 func (*AdminListRoomsRequest) ProtoMessage() {}
 
 func (x *AdminListRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[235]
+	mi := &file_game_v1_game_proto_msgTypes[300]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17177,7 +21884,7 @@ func (x *AdminListRoomsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListRoomsRequest.ProtoReflect.Descriptor instead.
 func (*AdminListRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{235}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{300}
 }
 
 func (x *AdminListRoomsRequest) GetZoneId() string {
@@ -17199,7 +21906,7 @@ type AdminRoomSummary struct {
 
 func (x *AdminRoomSummary) Reset() {
 	*x = AdminRoomSummary{}
-	mi := &file_game_v1_game_proto_msgTypes[236]
+	mi := &file_game_v1_game_proto_msgTypes[301]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17211,7 +21918,7 @@ func (x *AdminRoomSummary) String() string {
 func (*AdminRoomSummary) ProtoMessage() {}
 
 func (x *AdminRoomSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[236]
+	mi := &file_game_v1_game_proto_msgTypes[301]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17224,7 +21931,7 @@ func (x *AdminRoomSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRoomSummary.ProtoReflect.Descriptor instead.
 func (*AdminRoomSummary) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{236}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{301}
 }
 
 func (x *AdminRoomSummary) GetId() string {
@@ -17264,7 +21971,7 @@ type AdminListRoomsResponse struct {
 
 func (x *AdminListRoomsResponse) Reset() {
 	*x = AdminListRoomsResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[237]
+	mi := &file_game_v1_game_proto_msgTypes[302]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17276,7 +21983,7 @@ func (x *AdminListRoomsResponse) String() string {
 func (*AdminListRoomsResponse) ProtoMessage() {}
 
 func (x *AdminListRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[237]
+	mi := &file_game_v1_game_proto_msgTypes[302]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17289,7 +21996,7 @@ func (x *AdminListRoomsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListRoomsResponse.ProtoReflect.Descriptor instead.
 func (*AdminListRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{237}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{302}
 }
 
 func (x *AdminListRoomsResponse) GetRooms() []*AdminRoomSummary {
@@ -17311,7 +22018,7 @@ type AdminUpdateRoomRequest struct {
 
 func (x *AdminUpdateRoomRequest) Reset() {
 	*x = AdminUpdateRoomRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[238]
+	mi := &file_game_v1_game_proto_msgTypes[303]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17323,7 +22030,7 @@ func (x *AdminUpdateRoomRequest) String() string {
 func (*AdminUpdateRoomRequest) ProtoMessage() {}
 
 func (x *AdminUpdateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[238]
+	mi := &file_game_v1_game_proto_msgTypes[303]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17336,7 +22043,7 @@ func (x *AdminUpdateRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateRoomRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{238}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{303}
 }
 
 func (x *AdminUpdateRoomRequest) GetRoomId() string {
@@ -17375,7 +22082,7 @@ type AdminUpdateRoomResponse struct {
 
 func (x *AdminUpdateRoomResponse) Reset() {
 	*x = AdminUpdateRoomResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[239]
+	mi := &file_game_v1_game_proto_msgTypes[304]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17387,7 +22094,7 @@ func (x *AdminUpdateRoomResponse) String() string {
 func (*AdminUpdateRoomResponse) ProtoMessage() {}
 
 func (x *AdminUpdateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[239]
+	mi := &file_game_v1_game_proto_msgTypes[304]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17400,7 +22107,7 @@ func (x *AdminUpdateRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateRoomResponse.ProtoReflect.Descriptor instead.
 func (*AdminUpdateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{239}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{304}
 }
 
 type AdminListNPCTemplatesRequest struct {
@@ -17411,7 +22118,7 @@ type AdminListNPCTemplatesRequest struct {
 
 func (x *AdminListNPCTemplatesRequest) Reset() {
 	*x = AdminListNPCTemplatesRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[240]
+	mi := &file_game_v1_game_proto_msgTypes[305]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17423,7 +22130,7 @@ func (x *AdminListNPCTemplatesRequest) String() string {
 func (*AdminListNPCTemplatesRequest) ProtoMessage() {}
 
 func (x *AdminListNPCTemplatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[240]
+	mi := &file_game_v1_game_proto_msgTypes[305]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17436,7 +22143,7 @@ func (x *AdminListNPCTemplatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListNPCTemplatesRequest.ProtoReflect.Descriptor instead.
 func (*AdminListNPCTemplatesRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{240}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{305}
 }
 
 type AdminNPCTemplateSummary struct {
@@ -17451,7 +22158,7 @@ type AdminNPCTemplateSummary struct {
 
 func (x *AdminNPCTemplateSummary) Reset() {
 	*x = AdminNPCTemplateSummary{}
-	mi := &file_game_v1_game_proto_msgTypes[241]
+	mi := &file_game_v1_game_proto_msgTypes[306]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17463,7 +22170,7 @@ func (x *AdminNPCTemplateSummary) String() string {
 func (*AdminNPCTemplateSummary) ProtoMessage() {}
 
 func (x *AdminNPCTemplateSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[241]
+	mi := &file_game_v1_game_proto_msgTypes[306]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17476,7 +22183,7 @@ func (x *AdminNPCTemplateSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminNPCTemplateSummary.ProtoReflect.Descriptor instead.
 func (*AdminNPCTemplateSummary) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{241}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{306}
 }
 
 func (x *AdminNPCTemplateSummary) GetId() string {
@@ -17516,7 +22223,7 @@ type AdminListNPCTemplatesResponse struct {
 
 func (x *AdminListNPCTemplatesResponse) Reset() {
 	*x = AdminListNPCTemplatesResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[242]
+	mi := &file_game_v1_game_proto_msgTypes[307]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17528,7 +22235,7 @@ func (x *AdminListNPCTemplatesResponse) String() string {
 func (*AdminListNPCTemplatesResponse) ProtoMessage() {}
 
 func (x *AdminListNPCTemplatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[242]
+	mi := &file_game_v1_game_proto_msgTypes[307]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17541,7 +22248,7 @@ func (x *AdminListNPCTemplatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListNPCTemplatesResponse.ProtoReflect.Descriptor instead.
 func (*AdminListNPCTemplatesResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{242}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{307}
 }
 
 func (x *AdminListNPCTemplatesResponse) GetTemplates() []*AdminNPCTemplateSummary {
@@ -17562,7 +22269,7 @@ type AdminSpawnNPCRequest struct {
 
 func (x *AdminSpawnNPCRequest) Reset() {
 	*x = AdminSpawnNPCRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[243]
+	mi := &file_game_v1_game_proto_msgTypes[308]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17574,7 +22281,7 @@ func (x *AdminSpawnNPCRequest) String() string {
 func (*AdminSpawnNPCRequest) ProtoMessage() {}
 
 func (x *AdminSpawnNPCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[243]
+	mi := &file_game_v1_game_proto_msgTypes[308]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17587,7 +22294,7 @@ func (x *AdminSpawnNPCRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminSpawnNPCRequest.ProtoReflect.Descriptor instead.
 func (*AdminSpawnNPCRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{243}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{308}
 }
 
 func (x *AdminSpawnNPCRequest) GetTemplateId() string {
@@ -17620,7 +22327,7 @@ type AdminSpawnNPCResponse struct {
 
 func (x *AdminSpawnNPCResponse) Reset() {
 	*x = AdminSpawnNPCResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[244]
+	mi := &file_game_v1_game_proto_msgTypes[309]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17632,7 +22339,7 @@ func (x *AdminSpawnNPCResponse) String() string {
 func (*AdminSpawnNPCResponse) ProtoMessage() {}
 
 func (x *AdminSpawnNPCResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[244]
+	mi := &file_game_v1_game_proto_msgTypes[309]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17645,7 +22352,7 @@ func (x *AdminSpawnNPCResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminSpawnNPCResponse.ProtoReflect.Descriptor instead.
 func (*AdminSpawnNPCResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{244}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{309}
 }
 
 func (x *AdminSpawnNPCResponse) GetSpawnedCount() int32 {
@@ -17666,7 +22373,7 @@ type AdminGiveItemRequest struct {
 
 func (x *AdminGiveItemRequest) Reset() {
 	*x = AdminGiveItemRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[245]
+	mi := &file_game_v1_game_proto_msgTypes[310]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17678,7 +22385,7 @@ func (x *AdminGiveItemRequest) String() string {
 func (*AdminGiveItemRequest) ProtoMessage() {}
 
 func (x *AdminGiveItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[245]
+	mi := &file_game_v1_game_proto_msgTypes[310]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17691,7 +22398,7 @@ func (x *AdminGiveItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGiveItemRequest.ProtoReflect.Descriptor instead.
 func (*AdminGiveItemRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{245}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{310}
 }
 
 func (x *AdminGiveItemRequest) GetCharId() int64 {
@@ -17723,7 +22430,7 @@ type AdminGiveItemResponse struct {
 
 func (x *AdminGiveItemResponse) Reset() {
 	*x = AdminGiveItemResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[246]
+	mi := &file_game_v1_game_proto_msgTypes[311]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17735,7 +22442,7 @@ func (x *AdminGiveItemResponse) String() string {
 func (*AdminGiveItemResponse) ProtoMessage() {}
 
 func (x *AdminGiveItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[246]
+	mi := &file_game_v1_game_proto_msgTypes[311]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17748,7 +22455,7 @@ func (x *AdminGiveItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGiveItemResponse.ProtoReflect.Descriptor instead.
 func (*AdminGiveItemResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{246}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{311}
 }
 
 type AdminGiveCurrencyRequest struct {
@@ -17761,7 +22468,7 @@ type AdminGiveCurrencyRequest struct {
 
 func (x *AdminGiveCurrencyRequest) Reset() {
 	*x = AdminGiveCurrencyRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[247]
+	mi := &file_game_v1_game_proto_msgTypes[312]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17773,7 +22480,7 @@ func (x *AdminGiveCurrencyRequest) String() string {
 func (*AdminGiveCurrencyRequest) ProtoMessage() {}
 
 func (x *AdminGiveCurrencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[247]
+	mi := &file_game_v1_game_proto_msgTypes[312]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17786,7 +22493,7 @@ func (x *AdminGiveCurrencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGiveCurrencyRequest.ProtoReflect.Descriptor instead.
 func (*AdminGiveCurrencyRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{247}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{312}
 }
 
 func (x *AdminGiveCurrencyRequest) GetCharId() int64 {
@@ -17811,7 +22518,7 @@ type AdminGiveCurrencyResponse struct {
 
 func (x *AdminGiveCurrencyResponse) Reset() {
 	*x = AdminGiveCurrencyResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[248]
+	mi := &file_game_v1_game_proto_msgTypes[313]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17823,7 +22530,7 @@ func (x *AdminGiveCurrencyResponse) String() string {
 func (*AdminGiveCurrencyResponse) ProtoMessage() {}
 
 func (x *AdminGiveCurrencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[248]
+	mi := &file_game_v1_game_proto_msgTypes[313]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17836,7 +22543,175 @@ func (x *AdminGiveCurrencyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGiveCurrencyResponse.ProtoReflect.Descriptor instead.
 func (*AdminGiveCurrencyResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{248}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{313}
+}
+
+// CommandInfo describes one player-facing command.
+type CommandInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases  []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Help     string                 `protobuf:"bytes,3,opt,name=help,proto3" json:"help,omitempty"`
+	Category string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// handler names the ClientMessage payload or frontend-local handler.
+	Handler string `protobuf:"bytes,5,opt,name=handler,proto3" json:"handler,omitempty"`
+	// required_role is the minimum account role ("editor", "admin"); empty
+	// means every player may use the command.
+	RequiredRole  string `protobuf:"bytes,6,opt,name=required_role,json=requiredRole,proto3" json:"required_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandInfo) Reset() {
+	*x = CommandInfo{}
+	mi := &file_game_v1_game_proto_msgTypes[314]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandInfo) ProtoMessage() {}
+
+func (x *CommandInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[314]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandInfo.ProtoReflect.Descriptor instead.
+func (*CommandInfo) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{314}
+}
+
+func (x *CommandInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CommandInfo) GetHelp() string {
+	if x != nil {
+		return x.Help
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *CommandInfo) GetRequiredRole() string {
+	if x != nil {
+		return x.RequiredRole
+	}
+	return ""
+}
+
+type GetCommandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandsRequest) Reset() {
+	*x = GetCommandsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[315]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandsRequest) ProtoMessage() {}
+
+func (x *GetCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[315]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{315}
+}
+
+type GetCommandsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commands      []*CommandInfo         `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandsResponse) Reset() {
+	*x = GetCommandsResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[316]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandsResponse) ProtoMessage() {}
+
+func (x *GetCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[316]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{316}
+}
+
+func (x *GetCommandsResponse) GetCommands() []*CommandInfo {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
 }
 
 type AoeTemplate_Cell struct {
@@ -17849,7 +22724,7 @@ type AoeTemplate_Cell struct {
 
 func (x *AoeTemplate_Cell) Reset() {
 	*x = AoeTemplate_Cell{}
-	mi := &file_game_v1_game_proto_msgTypes[250]
+	mi := &file_game_v1_game_proto_msgTypes[318]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17861,7 +22736,7 @@ func (x *AoeTemplate_Cell) String() string {
 func (*AoeTemplate_Cell) ProtoMessage() {}
 
 func (x *AoeTemplate_Cell) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[250]
+	mi := &file_game_v1_game_proto_msgTypes[318]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17874,7 +22749,7 @@ func (x *AoeTemplate_Cell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AoeTemplate_Cell.ProtoReflect.Descriptor instead.
 func (*AoeTemplate_Cell) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{133, 0}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{139, 0}
 }
 
 func (x *AoeTemplate_Cell) GetX() int32 {
@@ -17895,7 +22770,7 @@ var File_game_v1_game_proto protoreflect.FileDescriptor
 
 const file_game_v1_game_proto_rawDesc = "" +
 	"\n" +
-	"\x12game/v1/game.proto\x12\agame.v1\"\xa9@\n" +
+	"\x12game/v1/game.proto\x12\agame.v1\"\x88Y\n" +
 	"\rClientMessage\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12:\n" +
@@ -18058,7 +22933,67 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\vchoose_feat\x18\x8b\x01 \x01(\v2\x1a.game.v1.ChooseFeatRequestH\x00R\n" +
 	"chooseFeat\x122\n" +
 	"\amove_to\x18\x8c\x01 \x01(\v2\x16.game.v1.MoveToRequestH\x00R\x06moveTo\x12I\n" +
-	"\x11reaction_response\x18\x8d\x01 \x01(\v2\x19.game.v1.ReactionResponseH\x00R\x10reactionResponseB\t\n" +
+	"\x11reaction_response\x18\x8d\x01 \x01(\v2\x19.game.v1.ReactionResponseH\x00R\x10reactionResponse\x12M\n" +
+	"\x10combat_verbosity\x18\x8e\x01 \x01(\v2\x1f.game.v1.CombatVerbosityRequestH\x00R\x0fcombatVerbosity\x121\n" +
+	"\x06locale\x18\x8f\x01 \x01(\v2\x16.game.v1.LocaleRequestH\x00R\x06locale\x128\n" +
+	"\tchat_mute\x18\x90\x01 \x01(\v2\x18.game.v1.ChatMuteRequestH\x00R\bchatMute\x12.\n" +
+	"\x05audit\x18\x91\x01 \x01(\v2\x15.game.v1.AuditRequestH\x00R\x05audit\x127\n" +
+	"\bsettings\x18\x92\x01 \x01(\v2\x18.game.v1.SettingsRequestH\x00R\bsettings\x121\n" +
+	"\x06rename\x18\x93\x01 \x01(\v2\x16.game.v1.RenameRequestH\x00R\x06rename\x12J\n" +
+	"\x0fcharacter_slots\x18\x94\x01 \x01(\v2\x1e.game.v1.CharacterSlotsRequestH\x00R\x0echaracterSlots\x127\n" +
+	"\btutorial\x18\x95\x01 \x01(\v2\x18.game.v1.TutorialRequestH\x00R\btutorial\x12+\n" +
+	"\x04home\x18\x96\x01 \x01(\v2\x14.game.v1.HomeRequestH\x00R\x04home\x12+\n" +
+	"\x04clan\x18\x97\x01 \x01(\v2\x14.game.v1.ClanRequestH\x00R\x04clan\x12C\n" +
+	"\fachievements\x18\x98\x01 \x01(\v2\x1c.game.v1.AchievementsRequestH\x00R\fachievements\x12.\n" +
+	"\x05title\x18\x99\x01 \x01(\v2\x15.game.v1.TitleRequestH\x00R\x05title\x12(\n" +
+	"\x03top\x18\x9a\x01 \x01(\v2\x13.game.v1.TopRequestH\x00R\x03top\x121\n" +
+	"\x06played\x18\x9b\x01 \x01(\v2\x16.game.v1.PlayedRequestH\x00R\x06played\x12(\n" +
+	"\x03afk\x18\x9c\x01 \x01(\v2\x13.game.v1.AfkRequestH\x00R\x03afk\x125\n" +
+	"\bsite_ban\x18\x9d\x01 \x01(\v2\x17.game.v1.SiteBanRequestH\x00R\asiteBan\x127\n" +
+	"\bconsider\x18\x9e\x01 \x01(\v2\x18.game.v1.ConsiderRequestH\x00R\bconsider\x12+\n" +
+	"\x04scan\x18\x9f\x01 \x01(\v2\x14.game.v1.ScanRequestH\x00R\x04scan\x12.\n" +
+	"\x05light\x18\xa0\x01 \x01(\v2\x15.game.v1.LightRequestH\x00R\x05light\x12.\n" +
+	"\x05board\x18\xa1\x01 \x01(\v2\x15.game.v1.BoardRequestH\x00R\x05board\x12:\n" +
+	"\tdisembark\x18\xa2\x01 \x01(\v2\x19.game.v1.DisembarkRequestH\x00R\tdisembark\x12.\n" +
+	"\x05drive\x18\xa3\x01 \x01(\v2\x15.game.v1.DriveRequestH\x00R\x05drive\x121\n" +
+	"\x06refuel\x18\xa4\x01 \x01(\v2\x16.game.v1.RefuelRequestH\x00R\x06refuel\x12.\n" +
+	"\x05shout\x18\xa5\x01 \x01(\v2\x15.game.v1.ShoutRequestH\x00R\x05shout\x12+\n" +
+	"\x04yell\x18\xa6\x01 \x01(\v2\x14.game.v1.YellRequestH\x00R\x04yell\x125\n" +
+	"\bpay_fine\x18\xa7\x01 \x01(\v2\x17.game.v1.PayFineRequestH\x00R\apayFine\x12.\n" +
+	"\x05steal\x18\xa8\x01 \x01(\v2\x15.game.v1.StealRequestH\x00R\x05steal\x121\n" +
+	"\x06market\x18\xa9\x01 \x01(\v2\x16.game.v1.MarketRequestH\x00R\x06market\x124\n" +
+	"\aauction\x18\xaa\x01 \x01(\v2\x17.game.v1.AuctionRequestH\x00R\aauction\x12+\n" +
+	"\x04pick\x18\xab\x01 \x01(\v2\x14.game.v1.PickRequestH\x00R\x04pick\x12A\n" +
+	"\fdetect_traps\x18\xac\x01 \x01(\v2\x1b.game.v1.DetectTrapsRequestH\x00R\vdetectTraps\x12(\n" +
+	"\x03log\x18\xad\x01 \x01(\v2\x13.game.v1.LogRequestH\x00R\x03log\x121\n" +
+	"\x06report\x18\xae\x01 \x01(\v2\x16.game.v1.ReportRequestH\x00R\x06report\x124\n" +
+	"\areports\x18\xaf\x01 \x01(\v2\x17.game.v1.ReportsRequestH\x00R\areports\x12.\n" +
+	"\x05invis\x18\xb0\x01 \x01(\v2\x15.game.v1.InvisRequestH\x00R\x05invis\x12.\n" +
+	"\x05snoop\x18\xb1\x01 \x01(\v2\x15.game.v1.SnoopRequestH\x00R\x05snoop\x12.\n" +
+	"\x05force\x18\xb2\x01 \x01(\v2\x15.game.v1.ForceRequestH\x00R\x05force\x121\n" +
+	"\x06puppet\x18\xb3\x01 \x01(\v2\x16.game.v1.PuppetRequestH\x00R\x06puppet\x12%\n" +
+	"\x02gm\x18\xb4\x01 \x01(\v2\x12.game.v1.GMRequestH\x00R\x02gm\x12+\n" +
+	"\x04time\x18\xb5\x01 \x01(\v2\x14.game.v1.TimeRequestH\x00R\x04time\x127\n" +
+	"\bschedule\x18\xb6\x01 \x01(\v2\x18.game.v1.ScheduleRequestH\x00R\bschedule\x124\n" +
+	"\aengrave\x18\xb7\x01 \x01(\v2\x17.game.v1.EngraveRequestH\x00R\aengrave\x12(\n" +
+	"\x03dig\x18\xb8\x01 \x01(\v2\x13.game.v1.DigRequestH\x00R\x03dig\x121\n" +
+	"\x06answer\x18\xb9\x01 \x01(\v2\x16.game.v1.AnswerRequestH\x00R\x06answer\x12D\n" +
+	"\rreload_config\x18\xba\x01 \x01(\v2\x1c.game.v1.ReloadConfigRequestH\x00R\freloadConfig\x12+\n" +
+	"\x04flag\x18\xbb\x01 \x01(\v2\x14.game.v1.FlagRequestH\x00R\x04flag\x12S\n" +
+	"\x12transfer_character\x18\xbc\x01 \x01(\v2!.game.v1.TransferCharacterRequestH\x00R\x11transferCharacter\x12A\n" +
+	"\fshared_stash\x18\xbd\x01 \x01(\v2\x1b.game.v1.SharedStashRequestH\x00R\vsharedStash\x121\n" +
+	"\x06parley\x18\xbe\x01 \x01(\v2\x16.game.v1.ParleyRequestH\x00R\x06parley\x121\n" +
+	"\x06social\x18\xbf\x01 \x01(\v2\x16.game.v1.SocialRequestH\x00R\x06social\x127\n" +
+	"\brestrain\x18\xc0\x01 \x01(\v2\x18.game.v1.RestrainRequestH\x00R\brestrain\x12:\n" +
+	"\tnonlethal\x18\xc1\x01 \x01(\v2\x19.game.v1.NonlethalRequestH\x00R\tnonlethal\x127\n" +
+	"\bpractice\x18\xc2\x01 \x01(\v2\x18.game.v1.PracticeRequestH\x00R\bpractice\x12.\n" +
+	"\x05recap\x18\xc3\x01 \x01(\v2\x15.game.v1.RecapRequestH\x00R\x05recap\x12:\n" +
+	"\tformation\x18\xc4\x01 \x01(\v2\x19.game.v1.FormationRequestH\x00R\tformation\x12.\n" +
+	"\x05order\x18\xc5\x01 \x01(\v2\x15.game.v1.OrderRequestH\x00R\x05order\x12>\n" +
+	"\vjob_ability\x18\xc6\x01 \x01(\v2\x1a.game.v1.JobAbilityRequestH\x00R\n" +
+	"jobAbility\x121\n" +
+	"\x06respec\x18\xc7\x01 \x01(\v2\x16.game.v1.RespecRequestH\x00R\x06respec\x12M\n" +
+	"\x10export_character\x18\xc8\x01 \x01(\v2\x1f.game.v1.ExportCharacterRequestH\x00R\x0fexportCharacterB\t\n" +
 	"\apayload\"\x10\n" +
 	"\x0eUncoverRequest\"\r\n" +
 	"\vRestRequest\"\x13\n" +
@@ -18126,7 +23061,7 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\azone_id\x18\x01 \x01(\tR\x06zoneId\"4\n" +
 	"\x13ActivateItemRequest\x12\x1d\n" +
 	"\n" +
-	"item_query\x18\x01 \x01(\tR\titemQuery\"\xc2\x14\n" +
+	"item_query\x18\x01 \x01(\tR\titemQuery\"\xb9\x16\n" +
 	"\vServerEvent\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x120\n" +
@@ -18178,7 +23113,11 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x11tech_trainer_view\x18) \x01(\v2\x18.game.v1.TechTrainerViewH\x00R\x0ftechTrainerView\x126\n" +
 	"\vgame_config\x18* \x01(\v2\x13.game.v1.GameConfigH\x00R\n" +
 	"gameConfig\x12G\n" +
-	"\x0freaction_prompt\x18+ \x01(\v2\x1c.game.v1.ReactionPromptEventH\x00R\x0ereactionPromptB\t\n" +
+	"\x0freaction_prompt\x18+ \x01(\v2\x1c.game.v1.ReactionPromptEventH\x00R\x0ereactionPrompt\x128\n" +
+	"\ftitle_update\x18, \x01(\v2\x13.game.v1.TitleEventH\x00R\vtitleUpdate\x12E\n" +
+	"\x10account_settings\x18- \x01(\v2\x18.game.v1.AccountSettingsH\x00R\x0faccountSettings\x12>\n" +
+	"\fcombat_state\x18. \x01(\v2\x19.game.v1.CombatStateEventH\x00R\vcombatState\x124\n" +
+	"\troom_peek\x18/ \x01(\v2\x15.game.v1.RoomPeekViewH\x00R\broomPeekB\t\n" +
 	"\apayload\"\x95\x01\n" +
 	"\x13ReactionPromptEvent\x12\x1b\n" +
 	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12(\n" +
@@ -18189,7 +23128,7 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x05label\x18\x02 \x01(\tR\x05label\"G\n" +
 	"\x10ReactionResponse\x12\x1b\n" +
 	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x16\n" +
-	"\x06chosen\x18\x02 \x01(\tR\x06chosen\"\xd8\x04\n" +
+	"\x06chosen\x18\x02 \x01(\tR\x06chosen\"\xfd\x04\n" +
 	"\bShopItem\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
 	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x1b\n" +
@@ -18210,7 +23149,8 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x13armor_check_penalty\x18\x0e \x01(\x05R\x11armorCheckPenalty\x12.\n" +
 	"\x13armor_speed_penalty\x18\x0f \x01(\x05R\x11armorSpeedPenalty\x12.\n" +
 	"\x13armor_prof_category\x18\x10 \x01(\tR\x11armorProfCategory\x12'\n" +
-	"\x0feffects_summary\x18\x11 \x01(\tR\x0eeffectsSummary\"N\n" +
+	"\x0feffects_summary\x18\x11 \x01(\tR\x0eeffectsSummary\x12#\n" +
+	"\rlocked_reason\x18\x12 \x01(\tR\flockedReason\"N\n" +
 	"\bShopView\x12\x19\n" +
 	"\bnpc_name\x18\x01 \x01(\tR\anpcName\x12'\n" +
 	"\x05items\x18\x02 \x03(\v2\x11.game.v1.ShopItemR\x05items\"\x92\x01\n" +
@@ -18219,7 +23159,10 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"current_hp\x18\x01 \x01(\x05R\tcurrentHp\x12\x15\n" +
 	"\x06max_hp\x18\x02 \x01(\x05R\x05maxHp\x12!\n" +
 	"\ffocus_points\x18\x03 \x01(\x05R\vfocusPoints\x12(\n" +
-	"\x10max_focus_points\x18\x04 \x01(\x05R\x0emaxFocusPoints\"\xe6\x02\n" +
+	"\x10max_focus_points\x18\x04 \x01(\x05R\x0emaxFocusPoints\"\"\n" +
+	"\n" +
+	"TitleEvent\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"\x9c\x03\n" +
 	"\x10JoinWorldRequest\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
@@ -18234,10 +23177,21 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x05level\x18\n" +
 	" \x01(\x05R\x05level\x12\x1c\n" +
 	"\tarchetype\x18\v \x01(\tR\tarchetype\x12\x1a\n" +
-	"\bheadless\x18\f \x01(\bR\bheadless\"+\n" +
+	"\bheadless\x18\f \x01(\bR\bheadless\x124\n" +
+	"\bsettings\x18\r \x01(\v2\x18.game.v1.AccountSettingsR\bsettings\"\xf3\x01\n" +
+	"\x0fAccountSettings\x12\x1f\n" +
+	"\vcolor_theme\x18\x01 \x01(\tR\n" +
+	"colorTheme\x12)\n" +
+	"\x10combat_verbosity\x18\x02 \x01(\tR\x0fcombatVerbosity\x12'\n" +
+	"\x0faccessible_mode\x18\x03 \x01(\bR\x0eaccessibleMode\x12%\n" +
+	"\x0emuted_channels\x18\x04 \x03(\tR\rmutedChannels\x12\x1f\n" +
+	"\vpage_length\x18\x05 \x01(\x05R\n" +
+	"pageLength\x12#\n" +
+	"\rprompt_format\x18\x06 \x01(\tR\fpromptFormat\"+\n" +
 	"\vMoveRequest\x12\x1c\n" +
-	"\tdirection\x18\x01 \x01(\tR\tdirection\"\r\n" +
-	"\vLookRequest\"&\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\"+\n" +
+	"\vLookRequest\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\"&\n" +
 	"\n" +
 	"SayRequest\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"&\n" +
@@ -18247,7 +23201,7 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"WhoRequest\"\x0e\n" +
 	"\fExitsRequest\"\r\n" +
 	"\vQuitRequest\"\x18\n" +
-	"\x16SwitchCharacterRequest\"\xc1\x03\n" +
+	"\x16SwitchCharacterRequest\"\xed\x04\n" +
 	"\bRoomView\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -18262,7 +23216,30 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x06period\x18\n" +
 	" \x01(\tR\x06period\x128\n" +
 	"\tequipment\x18\v \x03(\v2\x1a.game.v1.RoomEquipmentItemR\tequipment\x12\x1b\n" +
-	"\tzone_name\x18\f \x01(\tR\bzoneName\"\xa1\x01\n" +
+	"\tzone_name\x18\f \x01(\tR\bzoneName\x12\x12\n" +
+	"\x04dark\x18\r \x01(\bR\x04dark\x120\n" +
+	"\bvehicles\x18\x0e \x03(\v2\x14.game.v1.VehicleInfoR\bvehicles\x12!\n" +
+	"\fcombat_round\x18\x0f \x01(\x05R\vcombatRound\x12\x1e\n" +
+	"\n" +
+	"combatants\x18\x10 \x03(\tR\n" +
+	"combatants\x12!\n" +
+	"\froom_effects\x18\x11 \x03(\tR\vroomEffects\"\xaa\x01\n" +
+	"\vVehicleInfo\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12\x1e\n" +
+	"\n" +
+	"passengers\x18\x05 \x01(\x05R\n" +
+	"passengers\x12\x14\n" +
+	"\x05seats\x18\x06 \x01(\x05R\x05seats\"\xa4\x01\n" +
+	"\fRoomPeekView\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aplayers\x18\x04 \x03(\tR\aplayers\x12$\n" +
+	"\x04npcs\x18\x05 \x03(\v2\x10.game.v1.NpcInfoR\x04npcs\"\xa1\x01\n" +
 	"\bExitInfo\x12\x1c\n" +
 	"\tdirection\x18\x01 \x01(\tR\tdirection\x12$\n" +
 	"\x0etarget_room_id\x18\x02 \x01(\tR\ftargetRoomId\x12\x16\n" +
@@ -18281,14 +23258,17 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"PlayerList\x12\x1d\n" +
 	"\n" +
 	"room_title\x18\x01 \x01(\tR\troomTitle\x12-\n" +
-	"\aplayers\x18\x02 \x03(\v2\x13.game.v1.PlayerInfoR\aplayers\"\x9a\x01\n" +
+	"\aplayers\x18\x02 \x03(\v2\x13.game.v1.PlayerInfoR\aplayers\"\xdd\x01\n" +
 	"\n" +
 	"PlayerInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x10\n" +
 	"\x03job\x18\x03 \x01(\tR\x03job\x12!\n" +
 	"\fhealth_label\x18\x04 \x01(\tR\vhealthLabel\x12-\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x15.game.v1.CombatStatusR\x06status\"3\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.game.v1.CombatStatusR\x06status\x12\x19\n" +
+	"\bclan_tag\x18\x06 \x01(\tR\aclanTag\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x10\n" +
+	"\x03afk\x18\b \x01(\bR\x03afk\"3\n" +
 	"\bExitList\x12'\n" +
 	"\x05exits\x18\x01 \x03(\v2\x11.game.v1.ExitInfoR\x05exits\"&\n" +
 	"\n" +
@@ -18300,7 +23280,7 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12\x16\n" +
 	"\x06period\x18\x02 \x01(\tR\x06period\x12\x10\n" +
 	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x14\n" +
-	"\x05month\x18\x04 \x01(\x05R\x05month\"\xfa\x02\n" +
+	"\x05month\x18\x04 \x01(\x05R\x05month\"\xdc\x03\n" +
 	"\rCharacterInfo\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\x03R\vcharacterId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -18319,7 +23299,11 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x04grit\x18\v \x01(\x05R\x04grit\x12\x1c\n" +
 	"\treasoning\x18\f \x01(\x05R\treasoning\x12\x14\n" +
 	"\x05savvy\x18\r \x01(\x05R\x05savvy\x12\x14\n" +
-	"\x05flair\x18\x0e \x01(\x05R\x05flair\"\xef\x01\n" +
+	"\x05flair\x18\x0e \x01(\x05R\x05flair\x12\x10\n" +
+	"\x03afk\x18\x0f \x01(\bR\x03afk\x12\x1f\n" +
+	"\vafk_message\x18\x10 \x01(\tR\n" +
+	"afkMessage\x12-\n" +
+	"\x12health_description\x18\x11 \x01(\tR\x11healthDescription\"\xef\x01\n" +
 	"\aNpcInfo\x12\x1f\n" +
 	"\vinstance_id\x18\x01 \x01(\tR\n" +
 	"instanceId\x12\x12\n" +
@@ -18636,7 +23620,28 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\bap_total\x18\x03 \x01(\x05R\aapTotal\x122\n" +
 	"\x15movement_ap_remaining\x18\x04 \x01(\x05R\x13movementApRemaining\x12!\n" +
 	"\freaction_max\x18\x05 \x01(\x05R\vreactionMax\x12%\n" +
-	"\x0ereaction_spent\x18\x06 \x01(\x05R\rreactionSpent\"\xf0\x03\n" +
+	"\x0ereaction_spent\x18\x06 \x01(\x05R\rreactionSpent\"b\n" +
+	"\x10CombatStateEvent\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x128\n" +
+	"\n" +
+	"combatants\x18\x02 \x03(\v2\x18.game.v1.CombatantStatusR\n" +
+	"combatants\"\xa3\x02\n" +
+	"\x0fCombatantStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_player\x18\x02 \x01(\bR\bisPlayer\x12\x1e\n" +
+	"\n" +
+	"initiative\x18\x03 \x01(\x05R\n" +
+	"initiative\x12!\n" +
+	"\fap_remaining\x18\x04 \x01(\x05R\vapRemaining\x12\x19\n" +
+	"\bap_total\x18\x05 \x01(\x05R\aapTotal\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\x06 \x03(\tR\n" +
+	"conditions\x12\x12\n" +
+	"\x04dead\x18\a \x01(\bR\x04dead\x12\x17\n" +
+	"\atemp_hp\x18\b \x01(\x05R\x06tempHp\x12\x16\n" +
+	"\x06shield\x18\t \x01(\x05R\x06shield\x12\x1c\n" +
+	"\tcooldowns\x18\n" +
+	" \x03(\tR\tcooldowns\"\xf8\x04\n" +
 	"\vCombatEvent\x12,\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x18.game.v1.CombatEventTypeR\x04type\x12\x1a\n" +
 	"\battacker\x18\x02 \x01(\tR\battacker\x12\x16\n" +
@@ -18657,7 +23662,9 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"attacker_x\x18\r \x01(\x05R\tattackerX\x12\x1d\n" +
 	"\n" +
 	"attacker_y\x18\x0e \x01(\x05R\tattackerY\x12\x1a\n" +
-	"\bflanking\x18\x0f \x01(\bR\bflanking\"\x0f\n" +
+	"\bflanking\x18\x0f \x01(\bR\bflanking\x12D\n" +
+	"\x11attacker_relation\x18\x10 \x01(\x0e2\x17.game.v1.CombatRelationR\x10attackerRelation\x12@\n" +
+	"\x0ftarget_relation\x18\x11 \x01(\x0e2\x17.game.v1.CombatRelationR\x0etargetRelation\"\x0f\n" +
 	"\rStatusRequest\"\xcc\x01\n" +
 	"\x0eConditionEvent\x12\x1d\n" +
 	"\n" +
@@ -18782,7 +23789,7 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12'\n" +
 	"\x0feffects_summary\x18\x05 \x01(\tR\x0eeffectsSummary\x12\x1d\n" +
 	"\n" +
-	"short_name\x18\x06 \x01(\tR\tshortName\"\xd8\x17\n" +
+	"short_name\x18\x06 \x01(\tR\tshortName\"\xee\x17\n" +
 	"\x12CharacterSheetView\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03job\x18\x02 \x01(\tR\x03job\x12\x1c\n" +
@@ -18855,7 +23862,8 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x0etech_tradition\x18> \x01(\tR\rtechTradition\x125\n" +
 	"\x17main_hand_prof_category\x18? \x01(\tR\x14mainHandProfCategory\x123\n" +
 	"\x16off_hand_prof_category\x18@ \x01(\tR\x13offHandProfCategory\x12'\n" +
-	"\x0feffects_summary\x18A \x01(\tR\x0eeffectsSummary\x1a8\n" +
+	"\x0feffects_summary\x18A \x01(\tR\x0eeffectsSummary\x12\x14\n" +
+	"\x05title\x18B \x01(\tR\x05title\x1a8\n" +
 	"\n" +
 	"ArmorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
@@ -18890,27 +23898,190 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\vdamage_type\x18\x01 \x01(\tR\n" +
 	"damageType\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value\"\x16\n" +
-	"\x14ProficienciesRequest\"\x80\x01\n" +
+	"\x14ProficienciesRequest\"\xc1\x01\n" +
 	"\x10ProficiencyEntry\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04rank\x18\x03 \x01(\tR\x04rank\x12\x14\n" +
 	"\x05bonus\x18\x04 \x01(\x05R\x05bonus\x12\x12\n" +
-	"\x04kind\x18\x05 \x01(\tR\x04kind\"X\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bpractice\x18\x06 \x01(\x05R\bpractice\x12#\n" +
+	"\rpractice_next\x18\a \x01(\x05R\fpracticeNext\"X\n" +
 	"\x15ProficienciesResponse\x12?\n" +
 	"\rproficiencies\x18\x01 \x03(\v2\x19.game.v1.ProficiencyEntryR\rproficiencies\"*\n" +
 	"\x0eLevelUpRequest\x12\x18\n" +
 	"\aability\x18\x01 \x01(\tR\aability\".\n" +
 	"\x14CombatDefaultRequest\x12\x16\n" +
 	"\x06action\x18\x01 \x01(\tR\x06action\".\n" +
+	"\x16CombatVerbosityRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\"'\n" +
+	"\rLocaleRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"C\n" +
+	"\x0fChatMuteRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x18\n" +
+	"\aminutes\x18\x02 \x01(\x05R\aminutes\">\n" +
+	"\fAuditRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"9\n" +
+	"\x0fSettingsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"B\n" +
+	"\rRenameRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\"I\n" +
+	"\x15CharacterSlotsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05slots\x18\x02 \x01(\x05R\x05slots\"%\n" +
+	"\x0fTutorialRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\bR\x04skip\"Y\n" +
+	"\vHomeRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"i\n" +
+	"\vClanRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\"\x15\n" +
+	"\x13AchievementsRequest\":\n" +
+	"\fTitleRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05clear\x18\x02 \x01(\bR\x05clear\" \n" +
+	"\n" +
+	"TopRequest\x12\x12\n" +
+	"\x04stat\x18\x01 \x01(\tR\x04stat\"\x0f\n" +
+	"\rPlayedRequest\":\n" +
+	"\n" +
+	"AfkRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04auto\x18\x02 \x01(\bR\x04auto\"T\n" +
+	"\x0eSiteBanRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04cidr\x18\x02 \x01(\tR\x04cidr\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\")\n" +
+	"\x0fConsiderRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"\r\n" +
+	"\vScanRequest\"&\n" +
+	"\fLightRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"&\n" +
+	"\fBoardRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"\x12\n" +
+	"\x10DisembarkRequest\",\n" +
+	"\fDriveRequest\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\"'\n" +
+	"\rRefuelRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"(\n" +
+	"\fShoutRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"'\n" +
+	"\vYellRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x10\n" +
+	"\x0ePayFineRequest\":\n" +
+	"\fStealRequest\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x0f\n" +
+	"\rMarketRequest\"\xa3\x01\n" +
+	"\x0eAuctionRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04item\x18\x02 \x01(\tR\x04item\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x05R\x05price\x12\x14\n" +
+	"\x05hours\x18\x05 \x01(\x05R\x05hours\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x06 \x01(\x03R\tlistingId\"=\n" +
+	"\vPickRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x14\n" +
+	"\x12DetectTrapsRequest\"$\n" +
+	"\n" +
+	"LogRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\"7\n" +
+	"\rReportRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"^\n" +
+	"\x0eReportsRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\"&\n" +
+	"\fInvisRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\">\n" +
+	"\fSnoopRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"l\n" +
+	"\fForceRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x120\n" +
+	"\acommand\x18\x02 \x01(\v2\x16.game.v1.ClientMessageR\acommand\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"S\n" +
+	"\rPuppetRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"D\n" +
+	"\x0fScheduleRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"8\n" +
+	"\x0eEngraveRequest\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\f\n" +
+	"\n" +
+	"DigRequest\"'\n" +
+	"\rAnswerRequest\x12\x16\n" +
+	"\x06answer\x18\x01 \x01(\tR\x06answer\"\x15\n" +
+	"\x13ReloadConfigRequest\"U\n" +
+	"\vFlagRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04flag\x18\x02 \x01(\tR\x04flag\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"N\n" +
+	"\x18TransferCharacterRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"D\n" +
+	"\x12SharedStashRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\"?\n" +
+	"\rParleyRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x16\n" +
+	"\x06option\x18\x02 \x01(\tR\x06option\"?\n" +
+	"\rSocialRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\")\n" +
+	"\x0fRestrainRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"&\n" +
+	"\x10NonlethalRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"%\n" +
+	"\x0fPracticeRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"\x0e\n" +
+	"\fRecapRequest\"<\n" +
+	"\x10FormationRequest\x12\x16\n" +
+	"\x06member\x18\x01 \x01(\tR\x06member\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\tR\x03row\"V\n" +
+	"\fOrderRequest\x12\x1c\n" +
+	"\tcompanion\x18\x01 \x01(\tR\tcompanion\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x10\n" +
+	"\x03arg\x18\x03 \x01(\tR\x03arg\"E\n" +
+	"\x11JobAbilityRequest\x12\x18\n" +
+	"\aability\x18\x01 \x01(\tR\aability\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"~\n" +
+	"\rRespecRequest\x12\x19\n" +
+	"\bnpc_name\x18\x01 \x01(\tR\anpcName\x12\x1c\n" +
+	"\tabilities\x18\x02 \x01(\tR\tabilities\x12\x19\n" +
+	"\bold_feat\x18\x03 \x01(\tR\aoldFeat\x12\x19\n" +
+	"\bnew_feat\x18\x04 \x01(\tR\anewFeat\"0\n" +
+	"\x16ExportCharacterRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"\r\n" +
+	"\vTimeRequest\"g\n" +
+	"\tGMRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\".\n" +
 	"\x11TrainSkillRequest\x12\x19\n" +
 	"\bskill_id\x18\x01 \x01(\tR\askillId\";\n" +
 	"\rActionRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06target\x18\x02 \x01(\tR\x06target\"\x14\n" +
 	"\x12RaiseShieldRequest\"\x12\n" +
-	"\x10TakeCoverRequest\"\x11\n" +
-	"\x0fFirstAidRequest\"&\n" +
+	"\x10TakeCoverRequest\")\n" +
+	"\x0fFirstAidRequest\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\"&\n" +
 	"\fFeintRequest\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\"+\n" +
 	"\x11DemoralizeRequest\x12\x16\n" +
@@ -19177,7 +24348,17 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x18AdminGiveCurrencyRequest\x12\x17\n" +
 	"\achar_id\x18\x01 \x01(\x03R\x06charId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x05R\x06amount\"\x1b\n" +
-	"\x19AdminGiveCurrencyResponse*Y\n" +
+	"\x19AdminGiveCurrencyResponse\"\xaa\x01\n" +
+	"\vCommandInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x02 \x03(\tR\aaliases\x12\x12\n" +
+	"\x04help\x18\x03 \x01(\tR\x04help\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x18\n" +
+	"\ahandler\x18\x05 \x01(\tR\ahandler\x12#\n" +
+	"\rrequired_role\x18\x06 \x01(\tR\frequiredRole\"\x14\n" +
+	"\x12GetCommandsRequest\"G\n" +
+	"\x13GetCommandsResponse\x120\n" +
+	"\bcommands\x18\x01 \x03(\v2\x14.game.v1.CommandInfoR\bcommands*Y\n" +
 	"\vMessageType\x12\x1c\n" +
 	"\x18MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10MESSAGE_TYPE_SAY\x10\x01\x12\x16\n" +
@@ -19191,7 +24372,12 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x12COMBAT_STATUS_IDLE\x10\x01\x12\x1b\n" +
 	"\x17COMBAT_STATUS_IN_COMBAT\x10\x02\x12\x19\n" +
 	"\x15COMBAT_STATUS_RESTING\x10\x03\x12\x1d\n" +
-	"\x19COMBAT_STATUS_UNCONSCIOUS\x10\x04*\xc4\x02\n" +
+	"\x19COMBAT_STATUS_UNCONSCIOUS\x10\x04*\x80\x01\n" +
+	"\x0eCombatRelation\x12\x1f\n" +
+	"\x1bCOMBAT_RELATION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14COMBAT_RELATION_SELF\x10\x01\x12\x18\n" +
+	"\x14COMBAT_RELATION_ALLY\x10\x02\x12\x19\n" +
+	"\x15COMBAT_RELATION_ENEMY\x10\x03*\xc4\x02\n" +
 	"\x0fCombatEventType\x12!\n" +
 	"\x1dCOMBAT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cCOMBAT_EVENT_TYPE_INITIATIVE\x10\x01\x12\x1c\n" +
@@ -19202,7 +24388,7 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x1bCOMBAT_EVENT_TYPE_CONDITION\x10\x06\x12\x1c\n" +
 	"\x18COMBAT_EVENT_TYPE_RELOAD\x10\a\x12\x1b\n" +
 	"\x17COMBAT_EVENT_TYPE_THROW\x10\b\x12\x1e\n" +
-	"\x1aCOMBAT_EVENT_TYPE_POSITION\x10\t2\xf9\a\n" +
+	"\x1aCOMBAT_EVENT_TYPE_POSITION\x10\t2\xc3\b\n" +
 	"\vGameService\x12;\n" +
 	"\aSession\x12\x16.game.v1.ClientMessage\x1a\x14.game.v1.ServerEvent(\x010\x01\x12Z\n" +
 	"\x11AdminListSessions\x12!.game.v1.AdminListSessionsRequest\x1a\".game.v1.AdminListSessionsResponse\x12H\n" +
@@ -19215,7 +24401,8 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x15AdminListNPCTemplates\x12%.game.v1.AdminListNPCTemplatesRequest\x1a&.game.v1.AdminListNPCTemplatesResponse\x12N\n" +
 	"\rAdminSpawnNPC\x12\x1d.game.v1.AdminSpawnNPCRequest\x1a\x1e.game.v1.AdminSpawnNPCResponse\x12N\n" +
 	"\rAdminGiveItem\x12\x1d.game.v1.AdminGiveItemRequest\x1a\x1e.game.v1.AdminGiveItemResponse\x12Z\n" +
-	"\x11AdminGiveCurrency\x12!.game.v1.AdminGiveCurrencyRequest\x1a\".game.v1.AdminGiveCurrencyResponseB:Z8github.com/cory-johannsen/mud/internal/gameserver/gamev1b\x06proto3"
+	"\x11AdminGiveCurrency\x12!.game.v1.AdminGiveCurrencyRequest\x1a\".game.v1.AdminGiveCurrencyResponse\x12H\n" +
+	"\vGetCommands\x12\x1b.game.v1.GetCommandsRequest\x1a\x1c.game.v1.GetCommandsResponseB:Z8github.com/cory-johannsen/mud/internal/gameserver/gamev1b\x06proto3"
 
 var (
 	file_game_v1_game_proto_rawDescOnce sync.Once
@@ -19229,545 +24416,687 @@ func file_game_v1_game_proto_rawDescGZIP() []byte {
 	return file_game_v1_game_proto_rawDescData
 }
 
-var file_game_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 254)
+var file_game_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 322)
 var file_game_v1_game_proto_goTypes = []any{
 	(MessageType)(0),                      // 0: game.v1.MessageType
 	(RoomEventType)(0),                    // 1: game.v1.RoomEventType
 	(CombatStatus)(0),                     // 2: game.v1.CombatStatus
-	(CombatEventType)(0),                  // 3: game.v1.CombatEventType
-	(AoeTemplate_Shape)(0),                // 4: game.v1.AoeTemplate.Shape
-	(AoeTemplate_Direction)(0),            // 5: game.v1.AoeTemplate.Direction
-	(*ClientMessage)(nil),                 // 6: game.v1.ClientMessage
-	(*UncoverRequest)(nil),                // 7: game.v1.UncoverRequest
-	(*RestRequest)(nil),                   // 8: game.v1.RestRequest
-	(*SelectTechRequest)(nil),             // 9: game.v1.SelectTechRequest
-	(*AidRequest)(nil),                    // 10: game.v1.AidRequest
-	(*DisarmTrapRequest)(nil),             // 11: game.v1.DisarmTrapRequest
-	(*ReadyRequest)(nil),                  // 12: game.v1.ReadyRequest
-	(*BrowseRequest)(nil),                 // 13: game.v1.BrowseRequest
-	(*BuyRequest)(nil),                    // 14: game.v1.BuyRequest
-	(*SellRequest)(nil),                   // 15: game.v1.SellRequest
-	(*NegotiateRequest)(nil),              // 16: game.v1.NegotiateRequest
-	(*StashDepositRequest)(nil),           // 17: game.v1.StashDepositRequest
-	(*StashWithdrawRequest)(nil),          // 18: game.v1.StashWithdrawRequest
-	(*StashBalanceRequest)(nil),           // 19: game.v1.StashBalanceRequest
-	(*HealRequest)(nil),                   // 20: game.v1.HealRequest
-	(*HealAmountRequest)(nil),             // 21: game.v1.HealAmountRequest
-	(*TrainJobRequest)(nil),               // 22: game.v1.TrainJobRequest
-	(*TrainTechRequest)(nil),              // 23: game.v1.TrainTechRequest
-	(*ListJobsRequest)(nil),               // 24: game.v1.ListJobsRequest
-	(*SetJobRequest)(nil),                 // 25: game.v1.SetJobRequest
-	(*HireRequest)(nil),                   // 26: game.v1.HireRequest
-	(*DismissRequest)(nil),                // 27: game.v1.DismissRequest
-	(*TalkRequest)(nil),                   // 28: game.v1.TalkRequest
-	(*BribeRequest)(nil),                  // 29: game.v1.BribeRequest
-	(*BribeConfirmRequest)(nil),           // 30: game.v1.BribeConfirmRequest
-	(*SurrenderRequest)(nil),              // 31: game.v1.SurrenderRequest
-	(*ReleaseRequest)(nil),                // 32: game.v1.ReleaseRequest
-	(*DeployTrapRequest)(nil),             // 33: game.v1.DeployTrapRequest
-	(*TravelRequest)(nil),                 // 34: game.v1.TravelRequest
-	(*ActivateItemRequest)(nil),           // 35: game.v1.ActivateItemRequest
-	(*ServerEvent)(nil),                   // 36: game.v1.ServerEvent
-	(*ReactionPromptEvent)(nil),           // 37: game.v1.ReactionPromptEvent
-	(*ReactionPromptOption)(nil),          // 38: game.v1.ReactionPromptOption
-	(*ReactionResponse)(nil),              // 39: game.v1.ReactionResponse
-	(*ShopItem)(nil),                      // 40: game.v1.ShopItem
-	(*ShopView)(nil),                      // 41: game.v1.ShopView
-	(*HpUpdateEvent)(nil),                 // 42: game.v1.HpUpdateEvent
-	(*JoinWorldRequest)(nil),              // 43: game.v1.JoinWorldRequest
-	(*MoveRequest)(nil),                   // 44: game.v1.MoveRequest
-	(*LookRequest)(nil),                   // 45: game.v1.LookRequest
-	(*SayRequest)(nil),                    // 46: game.v1.SayRequest
-	(*EmoteRequest)(nil),                  // 47: game.v1.EmoteRequest
-	(*WhoRequest)(nil),                    // 48: game.v1.WhoRequest
-	(*ExitsRequest)(nil),                  // 49: game.v1.ExitsRequest
-	(*QuitRequest)(nil),                   // 50: game.v1.QuitRequest
-	(*SwitchCharacterRequest)(nil),        // 51: game.v1.SwitchCharacterRequest
-	(*RoomView)(nil),                      // 52: game.v1.RoomView
-	(*ExitInfo)(nil),                      // 53: game.v1.ExitInfo
-	(*MessageEvent)(nil),                  // 54: game.v1.MessageEvent
-	(*RoomEvent)(nil),                     // 55: game.v1.RoomEvent
-	(*PlayerList)(nil),                    // 56: game.v1.PlayerList
-	(*PlayerInfo)(nil),                    // 57: game.v1.PlayerInfo
-	(*ExitList)(nil),                      // 58: game.v1.ExitList
-	(*ErrorEvent)(nil),                    // 59: game.v1.ErrorEvent
-	(*Disconnected)(nil),                  // 60: game.v1.Disconnected
-	(*TimeOfDayEvent)(nil),                // 61: game.v1.TimeOfDayEvent
-	(*CharacterInfo)(nil),                 // 62: game.v1.CharacterInfo
-	(*NpcInfo)(nil),                       // 63: game.v1.NpcInfo
-	(*ExamineRequest)(nil),                // 64: game.v1.ExamineRequest
-	(*NpcView)(nil),                       // 65: game.v1.NpcView
-	(*HealerView)(nil),                    // 66: game.v1.HealerView
-	(*JobOfferEntry)(nil),                 // 67: game.v1.JobOfferEntry
-	(*TrainerView)(nil),                   // 68: game.v1.TrainerView
-	(*TechTrainerView)(nil),               // 69: game.v1.TechTrainerView
-	(*TechOfferEntry)(nil),                // 70: game.v1.TechOfferEntry
-	(*FixerView)(nil),                     // 71: game.v1.FixerView
-	(*RestView)(nil),                      // 72: game.v1.RestView
-	(*AttackRequest)(nil),                 // 73: game.v1.AttackRequest
-	(*FleeRequest)(nil),                   // 74: game.v1.FleeRequest
-	(*PassRequest)(nil),                   // 75: game.v1.PassRequest
-	(*StrikeRequest)(nil),                 // 76: game.v1.StrikeRequest
-	(*EquipRequest)(nil),                  // 77: game.v1.EquipRequest
-	(*ReloadRequest)(nil),                 // 78: game.v1.ReloadRequest
-	(*FireBurstRequest)(nil),              // 79: game.v1.FireBurstRequest
-	(*FireAutomaticRequest)(nil),          // 80: game.v1.FireAutomaticRequest
-	(*ThrowRequest)(nil),                  // 81: game.v1.ThrowRequest
-	(*InventoryRequest)(nil),              // 82: game.v1.InventoryRequest
-	(*GetItemRequest)(nil),                // 83: game.v1.GetItemRequest
-	(*DropItemRequest)(nil),               // 84: game.v1.DropItemRequest
-	(*BalanceRequest)(nil),                // 85: game.v1.BalanceRequest
-	(*SetRoleRequest)(nil),                // 86: game.v1.SetRoleRequest
-	(*LoadoutRequest)(nil),                // 87: game.v1.LoadoutRequest
-	(*LoadoutWeaponPreset)(nil),           // 88: game.v1.LoadoutWeaponPreset
-	(*LoadoutView)(nil),                   // 89: game.v1.LoadoutView
-	(*QuestObjectiveView)(nil),            // 90: game.v1.QuestObjectiveView
-	(*QuestEntryView)(nil),                // 91: game.v1.QuestEntryView
-	(*QuestGiverView)(nil),                // 92: game.v1.QuestGiverView
-	(*QuestLogView)(nil),                  // 93: game.v1.QuestLogView
-	(*QuestCompleteEvent)(nil),            // 94: game.v1.QuestCompleteEvent
-	(*QuestLogRequest)(nil),               // 95: game.v1.QuestLogRequest
-	(*UnequipRequest)(nil),                // 96: game.v1.UnequipRequest
-	(*EquipmentRequest)(nil),              // 97: game.v1.EquipmentRequest
-	(*TeleportRequest)(nil),               // 98: game.v1.TeleportRequest
-	(*SummonItemRequest)(nil),             // 99: game.v1.SummonItemRequest
-	(*FloorItem)(nil),                     // 100: game.v1.FloorItem
-	(*RoomEquipmentItem)(nil),             // 101: game.v1.RoomEquipmentItem
-	(*UseEquipmentRequest)(nil),           // 102: game.v1.UseEquipmentRequest
-	(*MapRequest)(nil),                    // 103: game.v1.MapRequest
-	(*PoiWithNpc)(nil),                    // 104: game.v1.PoiWithNpc
-	(*ZoneExitInfo)(nil),                  // 105: game.v1.ZoneExitInfo
-	(*SameZoneExitTarget)(nil),            // 106: game.v1.SameZoneExitTarget
-	(*MapTile)(nil),                       // 107: game.v1.MapTile
-	(*WorldZoneTile)(nil),                 // 108: game.v1.WorldZoneTile
-	(*GameConfig)(nil),                    // 109: game.v1.GameConfig
-	(*MapResponse)(nil),                   // 110: game.v1.MapResponse
-	(*SkillsRequest)(nil),                 // 111: game.v1.SkillsRequest
-	(*SkillEntry)(nil),                    // 112: game.v1.SkillEntry
-	(*SkillsResponse)(nil),                // 113: game.v1.SkillsResponse
-	(*RoomEquipRequest)(nil),              // 114: game.v1.RoomEquipRequest
-	(*InventoryItem)(nil),                 // 115: game.v1.InventoryItem
-	(*InventoryView)(nil),                 // 116: game.v1.InventoryView
-	(*CombatantPosition)(nil),             // 117: game.v1.CombatantPosition
-	(*CoverObjectPosition)(nil),           // 118: game.v1.CoverObjectPosition
-	(*TerrainCell)(nil),                   // 119: game.v1.TerrainCell
-	(*RoundStartEvent)(nil),               // 120: game.v1.RoundStartEvent
-	(*RoundEndEvent)(nil),                 // 121: game.v1.RoundEndEvent
-	(*APUpdateEvent)(nil),                 // 122: game.v1.APUpdateEvent
-	(*CombatEvent)(nil),                   // 123: game.v1.CombatEvent
-	(*StatusRequest)(nil),                 // 124: game.v1.StatusRequest
-	(*ConditionEvent)(nil),                // 125: game.v1.ConditionEvent
-	(*WearRequest)(nil),                   // 126: game.v1.WearRequest
-	(*RemoveArmorRequest)(nil),            // 127: game.v1.RemoveArmorRequest
-	(*ConditionInfo)(nil),                 // 128: game.v1.ConditionInfo
-	(*CharacterSheetRequest)(nil),         // 129: game.v1.CharacterSheetRequest
-	(*ArchetypeSelectionRequest)(nil),     // 130: game.v1.ArchetypeSelectionRequest
-	(*FeatsRequest)(nil),                  // 131: game.v1.FeatsRequest
-	(*FeatEntry)(nil),                     // 132: game.v1.FeatEntry
-	(*FeatsResponse)(nil),                 // 133: game.v1.FeatsResponse
-	(*ClassFeaturesRequest)(nil),          // 134: game.v1.ClassFeaturesRequest
-	(*ClassFeatureEntry)(nil),             // 135: game.v1.ClassFeatureEntry
-	(*ClassFeaturesResponse)(nil),         // 136: game.v1.ClassFeaturesResponse
-	(*InteractRequest)(nil),               // 137: game.v1.InteractRequest
-	(*InteractResponse)(nil),              // 138: game.v1.InteractResponse
-	(*AoeTemplate)(nil),                   // 139: game.v1.AoeTemplate
-	(*UseRequest)(nil),                    // 140: game.v1.UseRequest
-	(*UseResponse)(nil),                   // 141: game.v1.UseResponse
-	(*PreparedSlotView)(nil),              // 142: game.v1.PreparedSlotView
-	(*HardwiredSlotView)(nil),             // 143: game.v1.HardwiredSlotView
-	(*SpontaneousKnownEntry)(nil),         // 144: game.v1.SpontaneousKnownEntry
-	(*CharacterSheetView)(nil),            // 145: game.v1.CharacterSheetView
-	(*InnateSlotView)(nil),                // 146: game.v1.InnateSlotView
-	(*SpontaneousUsePoolView)(nil),        // 147: game.v1.SpontaneousUsePoolView
-	(*ResistanceEntry)(nil),               // 148: game.v1.ResistanceEntry
-	(*ProficienciesRequest)(nil),          // 149: game.v1.ProficienciesRequest
-	(*ProficiencyEntry)(nil),              // 150: game.v1.ProficiencyEntry
-	(*ProficienciesResponse)(nil),         // 151: game.v1.ProficienciesResponse
-	(*LevelUpRequest)(nil),                // 152: game.v1.LevelUpRequest
-	(*CombatDefaultRequest)(nil),          // 153: game.v1.CombatDefaultRequest
-	(*TrainSkillRequest)(nil),             // 154: game.v1.TrainSkillRequest
-	(*ActionRequest)(nil),                 // 155: game.v1.ActionRequest
-	(*RaiseShieldRequest)(nil),            // 156: game.v1.RaiseShieldRequest
-	(*TakeCoverRequest)(nil),              // 157: game.v1.TakeCoverRequest
-	(*FirstAidRequest)(nil),               // 158: game.v1.FirstAidRequest
-	(*FeintRequest)(nil),                  // 159: game.v1.FeintRequest
-	(*DemoralizeRequest)(nil),             // 160: game.v1.DemoralizeRequest
-	(*GrappleRequest)(nil),                // 161: game.v1.GrappleRequest
-	(*TripRequest)(nil),                   // 162: game.v1.TripRequest
-	(*DisarmRequest)(nil),                 // 163: game.v1.DisarmRequest
-	(*StrideRequest)(nil),                 // 164: game.v1.StrideRequest
-	(*MoveToRequest)(nil),                 // 165: game.v1.MoveToRequest
-	(*ShoveRequest)(nil),                  // 166: game.v1.ShoveRequest
-	(*StepRequest)(nil),                   // 167: game.v1.StepRequest
-	(*HideRequest)(nil),                   // 168: game.v1.HideRequest
-	(*SneakRequest)(nil),                  // 169: game.v1.SneakRequest
-	(*DivertRequest)(nil),                 // 170: game.v1.DivertRequest
-	(*EscapeRequest)(nil),                 // 171: game.v1.EscapeRequest
-	(*TumbleRequest)(nil),                 // 172: game.v1.TumbleRequest
-	(*SeekRequest)(nil),                   // 173: game.v1.SeekRequest
-	(*ClimbRequest)(nil),                  // 174: game.v1.ClimbRequest
-	(*SwimRequest)(nil),                   // 175: game.v1.SwimRequest
-	(*CalmRequest)(nil),                   // 176: game.v1.CalmRequest
-	(*HeroPointRequest)(nil),              // 177: game.v1.HeroPointRequest
-	(*DelayRequest)(nil),                  // 178: game.v1.DelayRequest
-	(*JoinRequest)(nil),                   // 179: game.v1.JoinRequest
-	(*DeclineRequest)(nil),                // 180: game.v1.DeclineRequest
-	(*GroupRequest)(nil),                  // 181: game.v1.GroupRequest
-	(*InviteRequest)(nil),                 // 182: game.v1.InviteRequest
-	(*AcceptGroupRequest)(nil),            // 183: game.v1.AcceptGroupRequest
-	(*DeclineGroupRequest)(nil),           // 184: game.v1.DeclineGroupRequest
-	(*UngroupRequest)(nil),                // 185: game.v1.UngroupRequest
-	(*KickRequest)(nil),                   // 186: game.v1.KickRequest
-	(*MotiveRequest)(nil),                 // 187: game.v1.MotiveRequest
-	(*GrantRequest)(nil),                  // 188: game.v1.GrantRequest
-	(*SpawnNPCRequest)(nil),               // 189: game.v1.SpawnNPCRequest
-	(*KillNPCRequest)(nil),                // 190: game.v1.KillNPCRequest
-	(*AddRoomRequest)(nil),                // 191: game.v1.AddRoomRequest
-	(*AddLinkRequest)(nil),                // 192: game.v1.AddLinkRequest
-	(*RemoveLinkRequest)(nil),             // 193: game.v1.RemoveLinkRequest
-	(*SetRoomRequest)(nil),                // 194: game.v1.SetRoomRequest
-	(*EditorCmdsRequest)(nil),             // 195: game.v1.EditorCmdsRequest
-	(*SpawnCharRequest)(nil),              // 196: game.v1.SpawnCharRequest
-	(*DeleteCharRequest)(nil),             // 197: game.v1.DeleteCharRequest
-	(*FactionRequest)(nil),                // 198: game.v1.FactionRequest
-	(*FactionInfoRequest)(nil),            // 199: game.v1.FactionInfoRequest
-	(*FactionStandingRequest)(nil),        // 200: game.v1.FactionStandingRequest
-	(*ChangeRepRequest)(nil),              // 201: game.v1.ChangeRepRequest
-	(*TabCompleteRequest)(nil),            // 202: game.v1.TabCompleteRequest
-	(*TabCompleteResponse)(nil),           // 203: game.v1.TabCompleteResponse
-	(*MaterialsRequest)(nil),              // 204: game.v1.MaterialsRequest
-	(*CraftListRequest)(nil),              // 205: game.v1.CraftListRequest
-	(*CraftRequest)(nil),                  // 206: game.v1.CraftRequest
-	(*CraftConfirmRequest)(nil),           // 207: game.v1.CraftConfirmRequest
-	(*ScavengeRequest)(nil),               // 208: game.v1.ScavengeRequest
-	(*AffixRequest)(nil),                  // 209: game.v1.AffixRequest
-	(*ExploreRequest)(nil),                // 210: game.v1.ExploreRequest
-	(*RefocusRequest)(nil),                // 211: game.v1.RefocusRequest
-	(*SeduceRequest)(nil),                 // 212: game.v1.SeduceRequest
-	(*HotbarSlot)(nil),                    // 213: game.v1.HotbarSlot
-	(*HotbarRequest)(nil),                 // 214: game.v1.HotbarRequest
-	(*HotbarUpdateEvent)(nil),             // 215: game.v1.HotbarUpdateEvent
-	(*DowntimeRequest)(nil),               // 216: game.v1.DowntimeRequest
-	(*QuestRequest)(nil),                  // 217: game.v1.QuestRequest
-	(*MaterialLoss)(nil),                  // 218: game.v1.MaterialLoss
-	(*CraftResultEvent)(nil),              // 219: game.v1.CraftResultEvent
-	(*UncurseRequest)(nil),                // 220: game.v1.UncurseRequest
-	(*WeatherEvent)(nil),                  // 221: game.v1.WeatherEvent
-	(*JobGrantsRequest)(nil),              // 222: game.v1.JobGrantsRequest
-	(*JobFeatGrant)(nil),                  // 223: game.v1.JobFeatGrant
-	(*JobTechGrant)(nil),                  // 224: game.v1.JobTechGrant
-	(*JobGrantsResponse)(nil),             // 225: game.v1.JobGrantsResponse
-	(*FeatOption)(nil),                    // 226: game.v1.FeatOption
-	(*PendingFeatChoice)(nil),             // 227: game.v1.PendingFeatChoice
-	(*ChooseFeatRequest)(nil),             // 228: game.v1.ChooseFeatRequest
-	(*AdminSessionInfo)(nil),              // 229: game.v1.AdminSessionInfo
-	(*AdminListSessionsRequest)(nil),      // 230: game.v1.AdminListSessionsRequest
-	(*AdminListSessionsResponse)(nil),     // 231: game.v1.AdminListSessionsResponse
-	(*AdminKickRequest)(nil),              // 232: game.v1.AdminKickRequest
-	(*AdminKickResponse)(nil),             // 233: game.v1.AdminKickResponse
-	(*AdminMessageRequest)(nil),           // 234: game.v1.AdminMessageRequest
-	(*AdminMessageResponse)(nil),          // 235: game.v1.AdminMessageResponse
-	(*AdminTeleportRequest)(nil),          // 236: game.v1.AdminTeleportRequest
-	(*AdminTeleportResponse)(nil),         // 237: game.v1.AdminTeleportResponse
-	(*AdminListZonesRequest)(nil),         // 238: game.v1.AdminListZonesRequest
-	(*AdminZoneSummary)(nil),              // 239: game.v1.AdminZoneSummary
-	(*AdminListZonesResponse)(nil),        // 240: game.v1.AdminListZonesResponse
-	(*AdminListRoomsRequest)(nil),         // 241: game.v1.AdminListRoomsRequest
-	(*AdminRoomSummary)(nil),              // 242: game.v1.AdminRoomSummary
-	(*AdminListRoomsResponse)(nil),        // 243: game.v1.AdminListRoomsResponse
-	(*AdminUpdateRoomRequest)(nil),        // 244: game.v1.AdminUpdateRoomRequest
-	(*AdminUpdateRoomResponse)(nil),       // 245: game.v1.AdminUpdateRoomResponse
-	(*AdminListNPCTemplatesRequest)(nil),  // 246: game.v1.AdminListNPCTemplatesRequest
-	(*AdminNPCTemplateSummary)(nil),       // 247: game.v1.AdminNPCTemplateSummary
-	(*AdminListNPCTemplatesResponse)(nil), // 248: game.v1.AdminListNPCTemplatesResponse
-	(*AdminSpawnNPCRequest)(nil),          // 249: game.v1.AdminSpawnNPCRequest
-	(*AdminSpawnNPCResponse)(nil),         // 250: game.v1.AdminSpawnNPCResponse
-	(*AdminGiveItemRequest)(nil),          // 251: game.v1.AdminGiveItemRequest
-	(*AdminGiveItemResponse)(nil),         // 252: game.v1.AdminGiveItemResponse
-	(*AdminGiveCurrencyRequest)(nil),      // 253: game.v1.AdminGiveCurrencyRequest
-	(*AdminGiveCurrencyResponse)(nil),     // 254: game.v1.AdminGiveCurrencyResponse
-	nil,                                   // 255: game.v1.FixerView.BribeCostsEntry
-	(*AoeTemplate_Cell)(nil),              // 256: game.v1.AoeTemplate.Cell
-	nil,                                   // 257: game.v1.CharacterSheetView.ArmorEntry
-	nil,                                   // 258: game.v1.CharacterSheetView.AccessoriesEntry
-	nil,                                   // 259: game.v1.CharacterSheetView.ArmorCategoriesEntry
+	(CombatRelation)(0),                   // 3: game.v1.CombatRelation
+	(CombatEventType)(0),                  // 4: game.v1.CombatEventType
+	(AoeTemplate_Shape)(0),                // 5: game.v1.AoeTemplate.Shape
+	(AoeTemplate_Direction)(0),            // 6: game.v1.AoeTemplate.Direction
+	(*ClientMessage)(nil),                 // 7: game.v1.ClientMessage
+	(*UncoverRequest)(nil),                // 8: game.v1.UncoverRequest
+	(*RestRequest)(nil),                   // 9: game.v1.RestRequest
+	(*SelectTechRequest)(nil),             // 10: game.v1.SelectTechRequest
+	(*AidRequest)(nil),                    // 11: game.v1.AidRequest
+	(*DisarmTrapRequest)(nil),             // 12: game.v1.DisarmTrapRequest
+	(*ReadyRequest)(nil),                  // 13: game.v1.ReadyRequest
+	(*BrowseRequest)(nil),                 // 14: game.v1.BrowseRequest
+	(*BuyRequest)(nil),                    // 15: game.v1.BuyRequest
+	(*SellRequest)(nil),                   // 16: game.v1.SellRequest
+	(*NegotiateRequest)(nil),              // 17: game.v1.NegotiateRequest
+	(*StashDepositRequest)(nil),           // 18: game.v1.StashDepositRequest
+	(*StashWithdrawRequest)(nil),          // 19: game.v1.StashWithdrawRequest
+	(*StashBalanceRequest)(nil),           // 20: game.v1.StashBalanceRequest
+	(*HealRequest)(nil),                   // 21: game.v1.HealRequest
+	(*HealAmountRequest)(nil),             // 22: game.v1.HealAmountRequest
+	(*TrainJobRequest)(nil),               // 23: game.v1.TrainJobRequest
+	(*TrainTechRequest)(nil),              // 24: game.v1.TrainTechRequest
+	(*ListJobsRequest)(nil),               // 25: game.v1.ListJobsRequest
+	(*SetJobRequest)(nil),                 // 26: game.v1.SetJobRequest
+	(*HireRequest)(nil),                   // 27: game.v1.HireRequest
+	(*DismissRequest)(nil),                // 28: game.v1.DismissRequest
+	(*TalkRequest)(nil),                   // 29: game.v1.TalkRequest
+	(*BribeRequest)(nil),                  // 30: game.v1.BribeRequest
+	(*BribeConfirmRequest)(nil),           // 31: game.v1.BribeConfirmRequest
+	(*SurrenderRequest)(nil),              // 32: game.v1.SurrenderRequest
+	(*ReleaseRequest)(nil),                // 33: game.v1.ReleaseRequest
+	(*DeployTrapRequest)(nil),             // 34: game.v1.DeployTrapRequest
+	(*TravelRequest)(nil),                 // 35: game.v1.TravelRequest
+	(*ActivateItemRequest)(nil),           // 36: game.v1.ActivateItemRequest
+	(*ServerEvent)(nil),                   // 37: game.v1.ServerEvent
+	(*ReactionPromptEvent)(nil),           // 38: game.v1.ReactionPromptEvent
+	(*ReactionPromptOption)(nil),          // 39: game.v1.ReactionPromptOption
+	(*ReactionResponse)(nil),              // 40: game.v1.ReactionResponse
+	(*ShopItem)(nil),                      // 41: game.v1.ShopItem
+	(*ShopView)(nil),                      // 42: game.v1.ShopView
+	(*HpUpdateEvent)(nil),                 // 43: game.v1.HpUpdateEvent
+	(*TitleEvent)(nil),                    // 44: game.v1.TitleEvent
+	(*JoinWorldRequest)(nil),              // 45: game.v1.JoinWorldRequest
+	(*AccountSettings)(nil),               // 46: game.v1.AccountSettings
+	(*MoveRequest)(nil),                   // 47: game.v1.MoveRequest
+	(*LookRequest)(nil),                   // 48: game.v1.LookRequest
+	(*SayRequest)(nil),                    // 49: game.v1.SayRequest
+	(*EmoteRequest)(nil),                  // 50: game.v1.EmoteRequest
+	(*WhoRequest)(nil),                    // 51: game.v1.WhoRequest
+	(*ExitsRequest)(nil),                  // 52: game.v1.ExitsRequest
+	(*QuitRequest)(nil),                   // 53: game.v1.QuitRequest
+	(*SwitchCharacterRequest)(nil),        // 54: game.v1.SwitchCharacterRequest
+	(*RoomView)(nil),                      // 55: game.v1.RoomView
+	(*VehicleInfo)(nil),                   // 56: game.v1.VehicleInfo
+	(*RoomPeekView)(nil),                  // 57: game.v1.RoomPeekView
+	(*ExitInfo)(nil),                      // 58: game.v1.ExitInfo
+	(*MessageEvent)(nil),                  // 59: game.v1.MessageEvent
+	(*RoomEvent)(nil),                     // 60: game.v1.RoomEvent
+	(*PlayerList)(nil),                    // 61: game.v1.PlayerList
+	(*PlayerInfo)(nil),                    // 62: game.v1.PlayerInfo
+	(*ExitList)(nil),                      // 63: game.v1.ExitList
+	(*ErrorEvent)(nil),                    // 64: game.v1.ErrorEvent
+	(*Disconnected)(nil),                  // 65: game.v1.Disconnected
+	(*TimeOfDayEvent)(nil),                // 66: game.v1.TimeOfDayEvent
+	(*CharacterInfo)(nil),                 // 67: game.v1.CharacterInfo
+	(*NpcInfo)(nil),                       // 68: game.v1.NpcInfo
+	(*ExamineRequest)(nil),                // 69: game.v1.ExamineRequest
+	(*NpcView)(nil),                       // 70: game.v1.NpcView
+	(*HealerView)(nil),                    // 71: game.v1.HealerView
+	(*JobOfferEntry)(nil),                 // 72: game.v1.JobOfferEntry
+	(*TrainerView)(nil),                   // 73: game.v1.TrainerView
+	(*TechTrainerView)(nil),               // 74: game.v1.TechTrainerView
+	(*TechOfferEntry)(nil),                // 75: game.v1.TechOfferEntry
+	(*FixerView)(nil),                     // 76: game.v1.FixerView
+	(*RestView)(nil),                      // 77: game.v1.RestView
+	(*AttackRequest)(nil),                 // 78: game.v1.AttackRequest
+	(*FleeRequest)(nil),                   // 79: game.v1.FleeRequest
+	(*PassRequest)(nil),                   // 80: game.v1.PassRequest
+	(*StrikeRequest)(nil),                 // 81: game.v1.StrikeRequest
+	(*EquipRequest)(nil),                  // 82: game.v1.EquipRequest
+	(*ReloadRequest)(nil),                 // 83: game.v1.ReloadRequest
+	(*FireBurstRequest)(nil),              // 84: game.v1.FireBurstRequest
+	(*FireAutomaticRequest)(nil),          // 85: game.v1.FireAutomaticRequest
+	(*ThrowRequest)(nil),                  // 86: game.v1.ThrowRequest
+	(*InventoryRequest)(nil),              // 87: game.v1.InventoryRequest
+	(*GetItemRequest)(nil),                // 88: game.v1.GetItemRequest
+	(*DropItemRequest)(nil),               // 89: game.v1.DropItemRequest
+	(*BalanceRequest)(nil),                // 90: game.v1.BalanceRequest
+	(*SetRoleRequest)(nil),                // 91: game.v1.SetRoleRequest
+	(*LoadoutRequest)(nil),                // 92: game.v1.LoadoutRequest
+	(*LoadoutWeaponPreset)(nil),           // 93: game.v1.LoadoutWeaponPreset
+	(*LoadoutView)(nil),                   // 94: game.v1.LoadoutView
+	(*QuestObjectiveView)(nil),            // 95: game.v1.QuestObjectiveView
+	(*QuestEntryView)(nil),                // 96: game.v1.QuestEntryView
+	(*QuestGiverView)(nil),                // 97: game.v1.QuestGiverView
+	(*QuestLogView)(nil),                  // 98: game.v1.QuestLogView
+	(*QuestCompleteEvent)(nil),            // 99: game.v1.QuestCompleteEvent
+	(*QuestLogRequest)(nil),               // 100: game.v1.QuestLogRequest
+	(*UnequipRequest)(nil),                // 101: game.v1.UnequipRequest
+	(*EquipmentRequest)(nil),              // 102: game.v1.EquipmentRequest
+	(*TeleportRequest)(nil),               // 103: game.v1.TeleportRequest
+	(*SummonItemRequest)(nil),             // 104: game.v1.SummonItemRequest
+	(*FloorItem)(nil),                     // 105: game.v1.FloorItem
+	(*RoomEquipmentItem)(nil),             // 106: game.v1.RoomEquipmentItem
+	(*UseEquipmentRequest)(nil),           // 107: game.v1.UseEquipmentRequest
+	(*MapRequest)(nil),                    // 108: game.v1.MapRequest
+	(*PoiWithNpc)(nil),                    // 109: game.v1.PoiWithNpc
+	(*ZoneExitInfo)(nil),                  // 110: game.v1.ZoneExitInfo
+	(*SameZoneExitTarget)(nil),            // 111: game.v1.SameZoneExitTarget
+	(*MapTile)(nil),                       // 112: game.v1.MapTile
+	(*WorldZoneTile)(nil),                 // 113: game.v1.WorldZoneTile
+	(*GameConfig)(nil),                    // 114: game.v1.GameConfig
+	(*MapResponse)(nil),                   // 115: game.v1.MapResponse
+	(*SkillsRequest)(nil),                 // 116: game.v1.SkillsRequest
+	(*SkillEntry)(nil),                    // 117: game.v1.SkillEntry
+	(*SkillsResponse)(nil),                // 118: game.v1.SkillsResponse
+	(*RoomEquipRequest)(nil),              // 119: game.v1.RoomEquipRequest
+	(*InventoryItem)(nil),                 // 120: game.v1.InventoryItem
+	(*InventoryView)(nil),                 // 121: game.v1.InventoryView
+	(*CombatantPosition)(nil),             // 122: game.v1.CombatantPosition
+	(*CoverObjectPosition)(nil),           // 123: game.v1.CoverObjectPosition
+	(*TerrainCell)(nil),                   // 124: game.v1.TerrainCell
+	(*RoundStartEvent)(nil),               // 125: game.v1.RoundStartEvent
+	(*RoundEndEvent)(nil),                 // 126: game.v1.RoundEndEvent
+	(*APUpdateEvent)(nil),                 // 127: game.v1.APUpdateEvent
+	(*CombatStateEvent)(nil),              // 128: game.v1.CombatStateEvent
+	(*CombatantStatus)(nil),               // 129: game.v1.CombatantStatus
+	(*CombatEvent)(nil),                   // 130: game.v1.CombatEvent
+	(*StatusRequest)(nil),                 // 131: game.v1.StatusRequest
+	(*ConditionEvent)(nil),                // 132: game.v1.ConditionEvent
+	(*WearRequest)(nil),                   // 133: game.v1.WearRequest
+	(*RemoveArmorRequest)(nil),            // 134: game.v1.RemoveArmorRequest
+	(*ConditionInfo)(nil),                 // 135: game.v1.ConditionInfo
+	(*CharacterSheetRequest)(nil),         // 136: game.v1.CharacterSheetRequest
+	(*ArchetypeSelectionRequest)(nil),     // 137: game.v1.ArchetypeSelectionRequest
+	(*FeatsRequest)(nil),                  // 138: game.v1.FeatsRequest
+	(*FeatEntry)(nil),                     // 139: game.v1.FeatEntry
+	(*FeatsResponse)(nil),                 // 140: game.v1.FeatsResponse
+	(*ClassFeaturesRequest)(nil),          // 141: game.v1.ClassFeaturesRequest
+	(*ClassFeatureEntry)(nil),             // 142: game.v1.ClassFeatureEntry
+	(*ClassFeaturesResponse)(nil),         // 143: game.v1.ClassFeaturesResponse
+	(*InteractRequest)(nil),               // 144: game.v1.InteractRequest
+	(*InteractResponse)(nil),              // 145: game.v1.InteractResponse
+	(*AoeTemplate)(nil),                   // 146: game.v1.AoeTemplate
+	(*UseRequest)(nil),                    // 147: game.v1.UseRequest
+	(*UseResponse)(nil),                   // 148: game.v1.UseResponse
+	(*PreparedSlotView)(nil),              // 149: game.v1.PreparedSlotView
+	(*HardwiredSlotView)(nil),             // 150: game.v1.HardwiredSlotView
+	(*SpontaneousKnownEntry)(nil),         // 151: game.v1.SpontaneousKnownEntry
+	(*CharacterSheetView)(nil),            // 152: game.v1.CharacterSheetView
+	(*InnateSlotView)(nil),                // 153: game.v1.InnateSlotView
+	(*SpontaneousUsePoolView)(nil),        // 154: game.v1.SpontaneousUsePoolView
+	(*ResistanceEntry)(nil),               // 155: game.v1.ResistanceEntry
+	(*ProficienciesRequest)(nil),          // 156: game.v1.ProficienciesRequest
+	(*ProficiencyEntry)(nil),              // 157: game.v1.ProficiencyEntry
+	(*ProficienciesResponse)(nil),         // 158: game.v1.ProficienciesResponse
+	(*LevelUpRequest)(nil),                // 159: game.v1.LevelUpRequest
+	(*CombatDefaultRequest)(nil),          // 160: game.v1.CombatDefaultRequest
+	(*CombatVerbosityRequest)(nil),        // 161: game.v1.CombatVerbosityRequest
+	(*LocaleRequest)(nil),                 // 162: game.v1.LocaleRequest
+	(*ChatMuteRequest)(nil),               // 163: game.v1.ChatMuteRequest
+	(*AuditRequest)(nil),                  // 164: game.v1.AuditRequest
+	(*SettingsRequest)(nil),               // 165: game.v1.SettingsRequest
+	(*RenameRequest)(nil),                 // 166: game.v1.RenameRequest
+	(*CharacterSlotsRequest)(nil),         // 167: game.v1.CharacterSlotsRequest
+	(*TutorialRequest)(nil),               // 168: game.v1.TutorialRequest
+	(*HomeRequest)(nil),                   // 169: game.v1.HomeRequest
+	(*ClanRequest)(nil),                   // 170: game.v1.ClanRequest
+	(*AchievementsRequest)(nil),           // 171: game.v1.AchievementsRequest
+	(*TitleRequest)(nil),                  // 172: game.v1.TitleRequest
+	(*TopRequest)(nil),                    // 173: game.v1.TopRequest
+	(*PlayedRequest)(nil),                 // 174: game.v1.PlayedRequest
+	(*AfkRequest)(nil),                    // 175: game.v1.AfkRequest
+	(*SiteBanRequest)(nil),                // 176: game.v1.SiteBanRequest
+	(*ConsiderRequest)(nil),               // 177: game.v1.ConsiderRequest
+	(*ScanRequest)(nil),                   // 178: game.v1.ScanRequest
+	(*LightRequest)(nil),                  // 179: game.v1.LightRequest
+	(*BoardRequest)(nil),                  // 180: game.v1.BoardRequest
+	(*DisembarkRequest)(nil),              // 181: game.v1.DisembarkRequest
+	(*DriveRequest)(nil),                  // 182: game.v1.DriveRequest
+	(*RefuelRequest)(nil),                 // 183: game.v1.RefuelRequest
+	(*ShoutRequest)(nil),                  // 184: game.v1.ShoutRequest
+	(*YellRequest)(nil),                   // 185: game.v1.YellRequest
+	(*PayFineRequest)(nil),                // 186: game.v1.PayFineRequest
+	(*StealRequest)(nil),                  // 187: game.v1.StealRequest
+	(*MarketRequest)(nil),                 // 188: game.v1.MarketRequest
+	(*AuctionRequest)(nil),                // 189: game.v1.AuctionRequest
+	(*PickRequest)(nil),                   // 190: game.v1.PickRequest
+	(*DetectTrapsRequest)(nil),            // 191: game.v1.DetectTrapsRequest
+	(*LogRequest)(nil),                    // 192: game.v1.LogRequest
+	(*ReportRequest)(nil),                 // 193: game.v1.ReportRequest
+	(*ReportsRequest)(nil),                // 194: game.v1.ReportsRequest
+	(*InvisRequest)(nil),                  // 195: game.v1.InvisRequest
+	(*SnoopRequest)(nil),                  // 196: game.v1.SnoopRequest
+	(*ForceRequest)(nil),                  // 197: game.v1.ForceRequest
+	(*PuppetRequest)(nil),                 // 198: game.v1.PuppetRequest
+	(*ScheduleRequest)(nil),               // 199: game.v1.ScheduleRequest
+	(*EngraveRequest)(nil),                // 200: game.v1.EngraveRequest
+	(*DigRequest)(nil),                    // 201: game.v1.DigRequest
+	(*AnswerRequest)(nil),                 // 202: game.v1.AnswerRequest
+	(*ReloadConfigRequest)(nil),           // 203: game.v1.ReloadConfigRequest
+	(*FlagRequest)(nil),                   // 204: game.v1.FlagRequest
+	(*TransferCharacterRequest)(nil),      // 205: game.v1.TransferCharacterRequest
+	(*SharedStashRequest)(nil),            // 206: game.v1.SharedStashRequest
+	(*ParleyRequest)(nil),                 // 207: game.v1.ParleyRequest
+	(*SocialRequest)(nil),                 // 208: game.v1.SocialRequest
+	(*RestrainRequest)(nil),               // 209: game.v1.RestrainRequest
+	(*NonlethalRequest)(nil),              // 210: game.v1.NonlethalRequest
+	(*PracticeRequest)(nil),               // 211: game.v1.PracticeRequest
+	(*RecapRequest)(nil),                  // 212: game.v1.RecapRequest
+	(*FormationRequest)(nil),              // 213: game.v1.FormationRequest
+	(*OrderRequest)(nil),                  // 214: game.v1.OrderRequest
+	(*JobAbilityRequest)(nil),             // 215: game.v1.JobAbilityRequest
+	(*RespecRequest)(nil),                 // 216: game.v1.RespecRequest
+	(*ExportCharacterRequest)(nil),        // 217: game.v1.ExportCharacterRequest
+	(*TimeRequest)(nil),                   // 218: game.v1.TimeRequest
+	(*GMRequest)(nil),                     // 219: game.v1.GMRequest
+	(*TrainSkillRequest)(nil),             // 220: game.v1.TrainSkillRequest
+	(*ActionRequest)(nil),                 // 221: game.v1.ActionRequest
+	(*RaiseShieldRequest)(nil),            // 222: game.v1.RaiseShieldRequest
+	(*TakeCoverRequest)(nil),              // 223: game.v1.TakeCoverRequest
+	(*FirstAidRequest)(nil),               // 224: game.v1.FirstAidRequest
+	(*FeintRequest)(nil),                  // 225: game.v1.FeintRequest
+	(*DemoralizeRequest)(nil),             // 226: game.v1.DemoralizeRequest
+	(*GrappleRequest)(nil),                // 227: game.v1.GrappleRequest
+	(*TripRequest)(nil),                   // 228: game.v1.TripRequest
+	(*DisarmRequest)(nil),                 // 229: game.v1.DisarmRequest
+	(*StrideRequest)(nil),                 // 230: game.v1.StrideRequest
+	(*MoveToRequest)(nil),                 // 231: game.v1.MoveToRequest
+	(*ShoveRequest)(nil),                  // 232: game.v1.ShoveRequest
+	(*StepRequest)(nil),                   // 233: game.v1.StepRequest
+	(*HideRequest)(nil),                   // 234: game.v1.HideRequest
+	(*SneakRequest)(nil),                  // 235: game.v1.SneakRequest
+	(*DivertRequest)(nil),                 // 236: game.v1.DivertRequest
+	(*EscapeRequest)(nil),                 // 237: game.v1.EscapeRequest
+	(*TumbleRequest)(nil),                 // 238: game.v1.TumbleRequest
+	(*SeekRequest)(nil),                   // 239: game.v1.SeekRequest
+	(*ClimbRequest)(nil),                  // 240: game.v1.ClimbRequest
+	(*SwimRequest)(nil),                   // 241: game.v1.SwimRequest
+	(*CalmRequest)(nil),                   // 242: game.v1.CalmRequest
+	(*HeroPointRequest)(nil),              // 243: game.v1.HeroPointRequest
+	(*DelayRequest)(nil),                  // 244: game.v1.DelayRequest
+	(*JoinRequest)(nil),                   // 245: game.v1.JoinRequest
+	(*DeclineRequest)(nil),                // 246: game.v1.DeclineRequest
+	(*GroupRequest)(nil),                  // 247: game.v1.GroupRequest
+	(*InviteRequest)(nil),                 // 248: game.v1.InviteRequest
+	(*AcceptGroupRequest)(nil),            // 249: game.v1.AcceptGroupRequest
+	(*DeclineGroupRequest)(nil),           // 250: game.v1.DeclineGroupRequest
+	(*UngroupRequest)(nil),                // 251: game.v1.UngroupRequest
+	(*KickRequest)(nil),                   // 252: game.v1.KickRequest
+	(*MotiveRequest)(nil),                 // 253: game.v1.MotiveRequest
+	(*GrantRequest)(nil),                  // 254: game.v1.GrantRequest
+	(*SpawnNPCRequest)(nil),               // 255: game.v1.SpawnNPCRequest
+	(*KillNPCRequest)(nil),                // 256: game.v1.KillNPCRequest
+	(*AddRoomRequest)(nil),                // 257: game.v1.AddRoomRequest
+	(*AddLinkRequest)(nil),                // 258: game.v1.AddLinkRequest
+	(*RemoveLinkRequest)(nil),             // 259: game.v1.RemoveLinkRequest
+	(*SetRoomRequest)(nil),                // 260: game.v1.SetRoomRequest
+	(*EditorCmdsRequest)(nil),             // 261: game.v1.EditorCmdsRequest
+	(*SpawnCharRequest)(nil),              // 262: game.v1.SpawnCharRequest
+	(*DeleteCharRequest)(nil),             // 263: game.v1.DeleteCharRequest
+	(*FactionRequest)(nil),                // 264: game.v1.FactionRequest
+	(*FactionInfoRequest)(nil),            // 265: game.v1.FactionInfoRequest
+	(*FactionStandingRequest)(nil),        // 266: game.v1.FactionStandingRequest
+	(*ChangeRepRequest)(nil),              // 267: game.v1.ChangeRepRequest
+	(*TabCompleteRequest)(nil),            // 268: game.v1.TabCompleteRequest
+	(*TabCompleteResponse)(nil),           // 269: game.v1.TabCompleteResponse
+	(*MaterialsRequest)(nil),              // 270: game.v1.MaterialsRequest
+	(*CraftListRequest)(nil),              // 271: game.v1.CraftListRequest
+	(*CraftRequest)(nil),                  // 272: game.v1.CraftRequest
+	(*CraftConfirmRequest)(nil),           // 273: game.v1.CraftConfirmRequest
+	(*ScavengeRequest)(nil),               // 274: game.v1.ScavengeRequest
+	(*AffixRequest)(nil),                  // 275: game.v1.AffixRequest
+	(*ExploreRequest)(nil),                // 276: game.v1.ExploreRequest
+	(*RefocusRequest)(nil),                // 277: game.v1.RefocusRequest
+	(*SeduceRequest)(nil),                 // 278: game.v1.SeduceRequest
+	(*HotbarSlot)(nil),                    // 279: game.v1.HotbarSlot
+	(*HotbarRequest)(nil),                 // 280: game.v1.HotbarRequest
+	(*HotbarUpdateEvent)(nil),             // 281: game.v1.HotbarUpdateEvent
+	(*DowntimeRequest)(nil),               // 282: game.v1.DowntimeRequest
+	(*QuestRequest)(nil),                  // 283: game.v1.QuestRequest
+	(*MaterialLoss)(nil),                  // 284: game.v1.MaterialLoss
+	(*CraftResultEvent)(nil),              // 285: game.v1.CraftResultEvent
+	(*UncurseRequest)(nil),                // 286: game.v1.UncurseRequest
+	(*WeatherEvent)(nil),                  // 287: game.v1.WeatherEvent
+	(*JobGrantsRequest)(nil),              // 288: game.v1.JobGrantsRequest
+	(*JobFeatGrant)(nil),                  // 289: game.v1.JobFeatGrant
+	(*JobTechGrant)(nil),                  // 290: game.v1.JobTechGrant
+	(*JobGrantsResponse)(nil),             // 291: game.v1.JobGrantsResponse
+	(*FeatOption)(nil),                    // 292: game.v1.FeatOption
+	(*PendingFeatChoice)(nil),             // 293: game.v1.PendingFeatChoice
+	(*ChooseFeatRequest)(nil),             // 294: game.v1.ChooseFeatRequest
+	(*AdminSessionInfo)(nil),              // 295: game.v1.AdminSessionInfo
+	(*AdminListSessionsRequest)(nil),      // 296: game.v1.AdminListSessionsRequest
+	(*AdminListSessionsResponse)(nil),     // 297: game.v1.AdminListSessionsResponse
+	(*AdminKickRequest)(nil),              // 298: game.v1.AdminKickRequest
+	(*AdminKickResponse)(nil),             // 299: game.v1.AdminKickResponse
+	(*AdminMessageRequest)(nil),           // 300: game.v1.AdminMessageRequest
+	(*AdminMessageResponse)(nil),          // 301: game.v1.AdminMessageResponse
+	(*AdminTeleportRequest)(nil),          // 302: game.v1.AdminTeleportRequest
+	(*AdminTeleportResponse)(nil),         // 303: game.v1.AdminTeleportResponse
+	(*AdminListZonesRequest)(nil),         // 304: game.v1.AdminListZonesRequest
+	(*AdminZoneSummary)(nil),              // 305: game.v1.AdminZoneSummary
+	(*AdminListZonesResponse)(nil),        // 306: game.v1.AdminListZonesResponse
+	(*AdminListRoomsRequest)(nil),         // 307: game.v1.AdminListRoomsRequest
+	(*AdminRoomSummary)(nil),              // 308: game.v1.AdminRoomSummary
+	(*AdminListRoomsResponse)(nil),        // 309: game.v1.AdminListRoomsResponse
+	(*AdminUpdateRoomRequest)(nil),        // 310: game.v1.AdminUpdateRoomRequest
+	(*AdminUpdateRoomResponse)(nil),       // 311: game.v1.AdminUpdateRoomResponse
+	(*AdminListNPCTemplatesRequest)(nil),  // 312: game.v1.AdminListNPCTemplatesRequest
+	(*AdminNPCTemplateSummary)(nil),       // 313: game.v1.AdminNPCTemplateSummary
+	(*AdminListNPCTemplatesResponse)(nil), // 314: game.v1.AdminListNPCTemplatesResponse
+	(*AdminSpawnNPCRequest)(nil),          // 315: game.v1.AdminSpawnNPCRequest
+	(*AdminSpawnNPCResponse)(nil),         // 316: game.v1.AdminSpawnNPCResponse
+	(*AdminGiveItemRequest)(nil),          // 317: game.v1.AdminGiveItemRequest
+	(*AdminGiveItemResponse)(nil),         // 318: game.v1.AdminGiveItemResponse
+	(*AdminGiveCurrencyRequest)(nil),      // 319: game.v1.AdminGiveCurrencyRequest
+	(*AdminGiveCurrencyResponse)(nil),     // 320: game.v1.AdminGiveCurrencyResponse
+	(*CommandInfo)(nil),                   // 321: game.v1.CommandInfo
+	(*GetCommandsRequest)(nil),            // 322: game.v1.GetCommandsRequest
+	(*GetCommandsResponse)(nil),           // 323: game.v1.GetCommandsResponse
+	nil,                                   // 324: game.v1.FixerView.BribeCostsEntry
+	(*AoeTemplate_Cell)(nil),              // 325: game.v1.AoeTemplate.Cell
+	nil,                                   // 326: game.v1.CharacterSheetView.ArmorEntry
+	nil,                                   // 327: game.v1.CharacterSheetView.AccessoriesEntry
+	nil,                                   // 328: game.v1.CharacterSheetView.ArmorCategoriesEntry
 }
 var file_game_v1_game_proto_depIdxs = []int32{
-	43,  // 0: game.v1.ClientMessage.join_world:type_name -> game.v1.JoinWorldRequest
-	44,  // 1: game.v1.ClientMessage.move:type_name -> game.v1.MoveRequest
-	45,  // 2: game.v1.ClientMessage.look:type_name -> game.v1.LookRequest
-	46,  // 3: game.v1.ClientMessage.say:type_name -> game.v1.SayRequest
-	47,  // 4: game.v1.ClientMessage.emote:type_name -> game.v1.EmoteRequest
-	48,  // 5: game.v1.ClientMessage.who:type_name -> game.v1.WhoRequest
-	49,  // 6: game.v1.ClientMessage.exits:type_name -> game.v1.ExitsRequest
-	50,  // 7: game.v1.ClientMessage.quit:type_name -> game.v1.QuitRequest
-	64,  // 8: game.v1.ClientMessage.examine:type_name -> game.v1.ExamineRequest
-	73,  // 9: game.v1.ClientMessage.attack:type_name -> game.v1.AttackRequest
-	74,  // 10: game.v1.ClientMessage.flee:type_name -> game.v1.FleeRequest
-	75,  // 11: game.v1.ClientMessage.pass:type_name -> game.v1.PassRequest
-	76,  // 12: game.v1.ClientMessage.strike:type_name -> game.v1.StrikeRequest
-	124, // 13: game.v1.ClientMessage.status:type_name -> game.v1.StatusRequest
-	77,  // 14: game.v1.ClientMessage.equip:type_name -> game.v1.EquipRequest
-	78,  // 15: game.v1.ClientMessage.reload:type_name -> game.v1.ReloadRequest
-	79,  // 16: game.v1.ClientMessage.fire_burst:type_name -> game.v1.FireBurstRequest
-	80,  // 17: game.v1.ClientMessage.fire_automatic:type_name -> game.v1.FireAutomaticRequest
-	81,  // 18: game.v1.ClientMessage.throw:type_name -> game.v1.ThrowRequest
-	82,  // 19: game.v1.ClientMessage.inventory_req:type_name -> game.v1.InventoryRequest
-	83,  // 20: game.v1.ClientMessage.get_item:type_name -> game.v1.GetItemRequest
-	84,  // 21: game.v1.ClientMessage.drop_item:type_name -> game.v1.DropItemRequest
-	85,  // 22: game.v1.ClientMessage.balance:type_name -> game.v1.BalanceRequest
-	86,  // 23: game.v1.ClientMessage.set_role:type_name -> game.v1.SetRoleRequest
-	98,  // 24: game.v1.ClientMessage.teleport:type_name -> game.v1.TeleportRequest
-	87,  // 25: game.v1.ClientMessage.loadout:type_name -> game.v1.LoadoutRequest
-	96,  // 26: game.v1.ClientMessage.unequip:type_name -> game.v1.UnequipRequest
-	97,  // 27: game.v1.ClientMessage.equipment:type_name -> game.v1.EquipmentRequest
-	51,  // 28: game.v1.ClientMessage.switch_character:type_name -> game.v1.SwitchCharacterRequest
-	126, // 29: game.v1.ClientMessage.wear:type_name -> game.v1.WearRequest
-	127, // 30: game.v1.ClientMessage.remove_armor:type_name -> game.v1.RemoveArmorRequest
-	129, // 31: game.v1.ClientMessage.char_sheet:type_name -> game.v1.CharacterSheetRequest
-	130, // 32: game.v1.ClientMessage.archetype_selection:type_name -> game.v1.ArchetypeSelectionRequest
-	102, // 33: game.v1.ClientMessage.use_equipment:type_name -> game.v1.UseEquipmentRequest
-	114, // 34: game.v1.ClientMessage.room_equip:type_name -> game.v1.RoomEquipRequest
-	103, // 35: game.v1.ClientMessage.map:type_name -> game.v1.MapRequest
-	111, // 36: game.v1.ClientMessage.skills_request:type_name -> game.v1.SkillsRequest
-	131, // 37: game.v1.ClientMessage.feats_request:type_name -> game.v1.FeatsRequest
-	137, // 38: game.v1.ClientMessage.interact_request:type_name -> game.v1.InteractRequest
-	140, // 39: game.v1.ClientMessage.use_request:type_name -> game.v1.UseRequest
-	134, // 40: game.v1.ClientMessage.class_features_request:type_name -> game.v1.ClassFeaturesRequest
-	99,  // 41: game.v1.ClientMessage.summon_item:type_name -> game.v1.SummonItemRequest
-	149, // 42: game.v1.ClientMessage.proficiencies_request:type_name -> game.v1.ProficienciesRequest
-	152, // 43: game.v1.ClientMessage.level_up:type_name -> game.v1.LevelUpRequest
-	153, // 44: game.v1.ClientMessage.combat_default:type_name -> game.v1.CombatDefaultRequest
-	154, // 45: game.v1.ClientMessage.train_skill:type_name -> game.v1.TrainSkillRequest
-	155, // 46: game.v1.ClientMessage.action:type_name -> game.v1.ActionRequest
-	156, // 47: game.v1.ClientMessage.raise_shield:type_name -> game.v1.RaiseShieldRequest
-	157, // 48: game.v1.ClientMessage.take_cover:type_name -> game.v1.TakeCoverRequest
-	158, // 49: game.v1.ClientMessage.first_aid:type_name -> game.v1.FirstAidRequest
-	159, // 50: game.v1.ClientMessage.feint:type_name -> game.v1.FeintRequest
-	160, // 51: game.v1.ClientMessage.demoralize:type_name -> game.v1.DemoralizeRequest
-	161, // 52: game.v1.ClientMessage.grapple:type_name -> game.v1.GrappleRequest
-	162, // 53: game.v1.ClientMessage.trip:type_name -> game.v1.TripRequest
-	168, // 54: game.v1.ClientMessage.hide:type_name -> game.v1.HideRequest
-	169, // 55: game.v1.ClientMessage.sneak:type_name -> game.v1.SneakRequest
-	170, // 56: game.v1.ClientMessage.divert:type_name -> game.v1.DivertRequest
-	171, // 57: game.v1.ClientMessage.escape:type_name -> game.v1.EscapeRequest
-	188, // 58: game.v1.ClientMessage.grant:type_name -> game.v1.GrantRequest
-	163, // 59: game.v1.ClientMessage.disarm:type_name -> game.v1.DisarmRequest
-	164, // 60: game.v1.ClientMessage.stride:type_name -> game.v1.StrideRequest
-	166, // 61: game.v1.ClientMessage.shove:type_name -> game.v1.ShoveRequest
-	167, // 62: game.v1.ClientMessage.step:type_name -> game.v1.StepRequest
-	172, // 63: game.v1.ClientMessage.tumble:type_name -> game.v1.TumbleRequest
-	173, // 64: game.v1.ClientMessage.seek:type_name -> game.v1.SeekRequest
-	174, // 65: game.v1.ClientMessage.climb:type_name -> game.v1.ClimbRequest
-	175, // 66: game.v1.ClientMessage.swim:type_name -> game.v1.SwimRequest
-	187, // 67: game.v1.ClientMessage.motive:type_name -> game.v1.MotiveRequest
-	176, // 68: game.v1.ClientMessage.calm:type_name -> game.v1.CalmRequest
-	177, // 69: game.v1.ClientMessage.hero_point:type_name -> game.v1.HeroPointRequest
-	178, // 70: game.v1.ClientMessage.delay:type_name -> game.v1.DelayRequest
-	179, // 71: game.v1.ClientMessage.join:type_name -> game.v1.JoinRequest
-	180, // 72: game.v1.ClientMessage.decline:type_name -> game.v1.DeclineRequest
-	181, // 73: game.v1.ClientMessage.group:type_name -> game.v1.GroupRequest
-	182, // 74: game.v1.ClientMessage.invite:type_name -> game.v1.InviteRequest
-	183, // 75: game.v1.ClientMessage.accept_group:type_name -> game.v1.AcceptGroupRequest
-	184, // 76: game.v1.ClientMessage.decline_group:type_name -> game.v1.DeclineGroupRequest
-	185, // 77: game.v1.ClientMessage.ungroup:type_name -> game.v1.UngroupRequest
-	186, // 78: game.v1.ClientMessage.kick:type_name -> game.v1.KickRequest
-	8,   // 79: game.v1.ClientMessage.rest:type_name -> game.v1.RestRequest
-	9,   // 80: game.v1.ClientMessage.select_tech:type_name -> game.v1.SelectTechRequest
-	10,  // 81: game.v1.ClientMessage.aid:type_name -> game.v1.AidRequest
-	11,  // 82: game.v1.ClientMessage.disarm_trap:type_name -> game.v1.DisarmTrapRequest
-	33,  // 83: game.v1.ClientMessage.deploy_trap:type_name -> game.v1.DeployTrapRequest
-	12,  // 84: game.v1.ClientMessage.ready:type_name -> game.v1.ReadyRequest
-	13,  // 85: game.v1.ClientMessage.browse:type_name -> game.v1.BrowseRequest
-	14,  // 86: game.v1.ClientMessage.buy:type_name -> game.v1.BuyRequest
-	15,  // 87: game.v1.ClientMessage.sell:type_name -> game.v1.SellRequest
-	16,  // 88: game.v1.ClientMessage.negotiate:type_name -> game.v1.NegotiateRequest
-	17,  // 89: game.v1.ClientMessage.stash_deposit:type_name -> game.v1.StashDepositRequest
-	18,  // 90: game.v1.ClientMessage.stash_withdraw:type_name -> game.v1.StashWithdrawRequest
-	19,  // 91: game.v1.ClientMessage.stash_balance:type_name -> game.v1.StashBalanceRequest
-	20,  // 92: game.v1.ClientMessage.heal:type_name -> game.v1.HealRequest
-	21,  // 93: game.v1.ClientMessage.heal_amount:type_name -> game.v1.HealAmountRequest
-	22,  // 94: game.v1.ClientMessage.train_job:type_name -> game.v1.TrainJobRequest
-	24,  // 95: game.v1.ClientMessage.list_jobs:type_name -> game.v1.ListJobsRequest
-	25,  // 96: game.v1.ClientMessage.set_job:type_name -> game.v1.SetJobRequest
-	26,  // 97: game.v1.ClientMessage.hire:type_name -> game.v1.HireRequest
-	27,  // 98: game.v1.ClientMessage.dismiss:type_name -> game.v1.DismissRequest
-	28,  // 99: game.v1.ClientMessage.talk:type_name -> game.v1.TalkRequest
-	29,  // 100: game.v1.ClientMessage.bribe_request:type_name -> game.v1.BribeRequest
-	30,  // 101: game.v1.ClientMessage.bribe_confirm_request:type_name -> game.v1.BribeConfirmRequest
-	31,  // 102: game.v1.ClientMessage.surrender_request:type_name -> game.v1.SurrenderRequest
-	32,  // 103: game.v1.ClientMessage.release_request:type_name -> game.v1.ReleaseRequest
-	189, // 104: game.v1.ClientMessage.spawn_npc:type_name -> game.v1.SpawnNPCRequest
-	191, // 105: game.v1.ClientMessage.add_room:type_name -> game.v1.AddRoomRequest
-	192, // 106: game.v1.ClientMessage.add_link:type_name -> game.v1.AddLinkRequest
-	193, // 107: game.v1.ClientMessage.remove_link:type_name -> game.v1.RemoveLinkRequest
-	194, // 108: game.v1.ClientMessage.set_room:type_name -> game.v1.SetRoomRequest
-	195, // 109: game.v1.ClientMessage.editor_cmds:type_name -> game.v1.EditorCmdsRequest
-	34,  // 110: game.v1.ClientMessage.travel:type_name -> game.v1.TravelRequest
-	35,  // 111: game.v1.ClientMessage.activate_item:type_name -> game.v1.ActivateItemRequest
-	198, // 112: game.v1.ClientMessage.faction_request:type_name -> game.v1.FactionRequest
-	199, // 113: game.v1.ClientMessage.faction_info_request:type_name -> game.v1.FactionInfoRequest
-	200, // 114: game.v1.ClientMessage.faction_standing_request:type_name -> game.v1.FactionStandingRequest
-	201, // 115: game.v1.ClientMessage.change_rep_request:type_name -> game.v1.ChangeRepRequest
-	202, // 116: game.v1.ClientMessage.tab_complete:type_name -> game.v1.TabCompleteRequest
-	204, // 117: game.v1.ClientMessage.materials_request:type_name -> game.v1.MaterialsRequest
-	205, // 118: game.v1.ClientMessage.craft_list_request:type_name -> game.v1.CraftListRequest
-	206, // 119: game.v1.ClientMessage.craft_request:type_name -> game.v1.CraftRequest
-	207, // 120: game.v1.ClientMessage.craft_confirm_request:type_name -> game.v1.CraftConfirmRequest
-	208, // 121: game.v1.ClientMessage.scavenge_request:type_name -> game.v1.ScavengeRequest
-	209, // 122: game.v1.ClientMessage.affix_request:type_name -> game.v1.AffixRequest
-	210, // 123: game.v1.ClientMessage.explore_request:type_name -> game.v1.ExploreRequest
-	217, // 124: game.v1.ClientMessage.quest_request:type_name -> game.v1.QuestRequest
-	220, // 125: game.v1.ClientMessage.uncurse_request:type_name -> game.v1.UncurseRequest
-	216, // 126: game.v1.ClientMessage.downtime_request:type_name -> game.v1.DowntimeRequest
-	211, // 127: game.v1.ClientMessage.refocus_request:type_name -> game.v1.RefocusRequest
-	212, // 128: game.v1.ClientMessage.seduce_request:type_name -> game.v1.SeduceRequest
-	214, // 129: game.v1.ClientMessage.hotbar_request:type_name -> game.v1.HotbarRequest
-	196, // 130: game.v1.ClientMessage.spawn_char_request:type_name -> game.v1.SpawnCharRequest
-	197, // 131: game.v1.ClientMessage.delete_char_request:type_name -> game.v1.DeleteCharRequest
-	190, // 132: game.v1.ClientMessage.kill_npc_request:type_name -> game.v1.KillNPCRequest
-	7,   // 133: game.v1.ClientMessage.uncover_request:type_name -> game.v1.UncoverRequest
-	222, // 134: game.v1.ClientMessage.job_grants_request:type_name -> game.v1.JobGrantsRequest
-	95,  // 135: game.v1.ClientMessage.quest_log_request:type_name -> game.v1.QuestLogRequest
-	23,  // 136: game.v1.ClientMessage.train_tech:type_name -> game.v1.TrainTechRequest
-	228, // 137: game.v1.ClientMessage.choose_feat:type_name -> game.v1.ChooseFeatRequest
-	165, // 138: game.v1.ClientMessage.move_to:type_name -> game.v1.MoveToRequest
-	39,  // 139: game.v1.ClientMessage.reaction_response:type_name -> game.v1.ReactionResponse
-	52,  // 140: game.v1.ServerEvent.room_view:type_name -> game.v1.RoomView
-	54,  // 141: game.v1.ServerEvent.message:type_name -> game.v1.MessageEvent
-	55,  // 142: game.v1.ServerEvent.room_event:type_name -> game.v1.RoomEvent
-	56,  // 143: game.v1.ServerEvent.player_list:type_name -> game.v1.PlayerList
-	58,  // 144: game.v1.ServerEvent.exit_list:type_name -> game.v1.ExitList
-	59,  // 145: game.v1.ServerEvent.error:type_name -> game.v1.ErrorEvent
-	60,  // 146: game.v1.ServerEvent.disconnected:type_name -> game.v1.Disconnected
-	62,  // 147: game.v1.ServerEvent.character_info:type_name -> game.v1.CharacterInfo
-	65,  // 148: game.v1.ServerEvent.npc_view:type_name -> game.v1.NpcView
-	123, // 149: game.v1.ServerEvent.combat_event:type_name -> game.v1.CombatEvent
-	120, // 150: game.v1.ServerEvent.round_start:type_name -> game.v1.RoundStartEvent
-	121, // 151: game.v1.ServerEvent.round_end:type_name -> game.v1.RoundEndEvent
-	125, // 152: game.v1.ServerEvent.condition_event:type_name -> game.v1.ConditionEvent
-	116, // 153: game.v1.ServerEvent.inventory_view:type_name -> game.v1.InventoryView
-	61,  // 154: game.v1.ServerEvent.time_of_day:type_name -> game.v1.TimeOfDayEvent
-	145, // 155: game.v1.ServerEvent.character_sheet:type_name -> game.v1.CharacterSheetView
-	110, // 156: game.v1.ServerEvent.map:type_name -> game.v1.MapResponse
-	113, // 157: game.v1.ServerEvent.skills_response:type_name -> game.v1.SkillsResponse
-	133, // 158: game.v1.ServerEvent.feats_response:type_name -> game.v1.FeatsResponse
-	138, // 159: game.v1.ServerEvent.interact_response:type_name -> game.v1.InteractResponse
-	141, // 160: game.v1.ServerEvent.use_response:type_name -> game.v1.UseResponse
-	136, // 161: game.v1.ServerEvent.class_features_response:type_name -> game.v1.ClassFeaturesResponse
-	151, // 162: game.v1.ServerEvent.proficiencies_response:type_name -> game.v1.ProficienciesResponse
-	42,  // 163: game.v1.ServerEvent.hp_update:type_name -> game.v1.HpUpdateEvent
-	203, // 164: game.v1.ServerEvent.tab_complete:type_name -> game.v1.TabCompleteResponse
-	219, // 165: game.v1.ServerEvent.craft_result:type_name -> game.v1.CraftResultEvent
-	215, // 166: game.v1.ServerEvent.hotbar_update:type_name -> game.v1.HotbarUpdateEvent
-	41,  // 167: game.v1.ServerEvent.shop_view:type_name -> game.v1.ShopView
-	66,  // 168: game.v1.ServerEvent.healer_view:type_name -> game.v1.HealerView
-	68,  // 169: game.v1.ServerEvent.trainer_view:type_name -> game.v1.TrainerView
-	221, // 170: game.v1.ServerEvent.weather:type_name -> game.v1.WeatherEvent
-	89,  // 171: game.v1.ServerEvent.loadout_view:type_name -> game.v1.LoadoutView
-	71,  // 172: game.v1.ServerEvent.fixer_view:type_name -> game.v1.FixerView
-	225, // 173: game.v1.ServerEvent.job_grants_response:type_name -> game.v1.JobGrantsResponse
-	72,  // 174: game.v1.ServerEvent.rest_view:type_name -> game.v1.RestView
-	122, // 175: game.v1.ServerEvent.ap_update:type_name -> game.v1.APUpdateEvent
-	92,  // 176: game.v1.ServerEvent.quest_giver_view:type_name -> game.v1.QuestGiverView
-	93,  // 177: game.v1.ServerEvent.quest_log_view:type_name -> game.v1.QuestLogView
-	94,  // 178: game.v1.ServerEvent.quest_complete:type_name -> game.v1.QuestCompleteEvent
-	69,  // 179: game.v1.ServerEvent.tech_trainer_view:type_name -> game.v1.TechTrainerView
-	109, // 180: game.v1.ServerEvent.game_config:type_name -> game.v1.GameConfig
-	37,  // 181: game.v1.ServerEvent.reaction_prompt:type_name -> game.v1.ReactionPromptEvent
-	38,  // 182: game.v1.ReactionPromptEvent.options:type_name -> game.v1.ReactionPromptOption
-	40,  // 183: game.v1.ShopView.items:type_name -> game.v1.ShopItem
-	53,  // 184: game.v1.RoomView.exits:type_name -> game.v1.ExitInfo
-	63,  // 185: game.v1.RoomView.npcs:type_name -> game.v1.NpcInfo
-	128, // 186: game.v1.RoomView.active_conditions:type_name -> game.v1.ConditionInfo
-	100, // 187: game.v1.RoomView.floor_items:type_name -> game.v1.FloorItem
-	101, // 188: game.v1.RoomView.equipment:type_name -> game.v1.RoomEquipmentItem
-	0,   // 189: game.v1.MessageEvent.type:type_name -> game.v1.MessageType
-	1,   // 190: game.v1.RoomEvent.type:type_name -> game.v1.RoomEventType
-	57,  // 191: game.v1.PlayerList.players:type_name -> game.v1.PlayerInfo
-	2,   // 192: game.v1.PlayerInfo.status:type_name -> game.v1.CombatStatus
-	53,  // 193: game.v1.ExitList.exits:type_name -> game.v1.ExitInfo
-	67,  // 194: game.v1.TrainerView.jobs:type_name -> game.v1.JobOfferEntry
-	70,  // 195: game.v1.TechTrainerView.offers:type_name -> game.v1.TechOfferEntry
-	255, // 196: game.v1.FixerView.bribe_costs:type_name -> game.v1.FixerView.BribeCostsEntry
-	88,  // 197: game.v1.LoadoutView.presets:type_name -> game.v1.LoadoutWeaponPreset
-	90,  // 198: game.v1.QuestEntryView.objectives:type_name -> game.v1.QuestObjectiveView
-	91,  // 199: game.v1.QuestGiverView.quests:type_name -> game.v1.QuestEntryView
-	91,  // 200: game.v1.QuestLogView.quests:type_name -> game.v1.QuestEntryView
-	104, // 201: game.v1.MapTile.poi_npcs:type_name -> game.v1.PoiWithNpc
-	105, // 202: game.v1.MapTile.zone_exits:type_name -> game.v1.ZoneExitInfo
-	106, // 203: game.v1.MapTile.same_zone_exit_targets:type_name -> game.v1.SameZoneExitTarget
-	107, // 204: game.v1.MapResponse.tiles:type_name -> game.v1.MapTile
-	108, // 205: game.v1.MapResponse.world_tiles:type_name -> game.v1.WorldZoneTile
-	112, // 206: game.v1.SkillsResponse.skills:type_name -> game.v1.SkillEntry
-	115, // 207: game.v1.InventoryView.items:type_name -> game.v1.InventoryItem
-	117, // 208: game.v1.RoundStartEvent.initial_positions:type_name -> game.v1.CombatantPosition
-	118, // 209: game.v1.RoundStartEvent.cover_objects:type_name -> game.v1.CoverObjectPosition
-	119, // 210: game.v1.RoundStartEvent.terrain:type_name -> game.v1.TerrainCell
-	3,   // 211: game.v1.CombatEvent.type:type_name -> game.v1.CombatEventType
-	132, // 212: game.v1.FeatsResponse.feats:type_name -> game.v1.FeatEntry
-	135, // 213: game.v1.ClassFeaturesResponse.archetype_features:type_name -> game.v1.ClassFeatureEntry
-	135, // 214: game.v1.ClassFeaturesResponse.job_features:type_name -> game.v1.ClassFeatureEntry
-	4,   // 215: game.v1.AoeTemplate.shape:type_name -> game.v1.AoeTemplate.Shape
-	5,   // 216: game.v1.AoeTemplate.facing:type_name -> game.v1.AoeTemplate.Direction
-	256, // 217: game.v1.AoeTemplate.cells:type_name -> game.v1.AoeTemplate.Cell
-	139, // 218: game.v1.UseRequest.template:type_name -> game.v1.AoeTemplate
-	132, // 219: game.v1.UseResponse.choices:type_name -> game.v1.FeatEntry
-	257, // 220: game.v1.CharacterSheetView.armor:type_name -> game.v1.CharacterSheetView.ArmorEntry
-	258, // 221: game.v1.CharacterSheetView.accessories:type_name -> game.v1.CharacterSheetView.AccessoriesEntry
-	148, // 222: game.v1.CharacterSheetView.player_resistances:type_name -> game.v1.ResistanceEntry
-	148, // 223: game.v1.CharacterSheetView.player_weaknesses:type_name -> game.v1.ResistanceEntry
-	112, // 224: game.v1.CharacterSheetView.skills:type_name -> game.v1.SkillEntry
-	132, // 225: game.v1.CharacterSheetView.feats:type_name -> game.v1.FeatEntry
-	135, // 226: game.v1.CharacterSheetView.class_features:type_name -> game.v1.ClassFeatureEntry
-	150, // 227: game.v1.CharacterSheetView.proficiencies:type_name -> game.v1.ProficiencyEntry
-	142, // 228: game.v1.CharacterSheetView.prepared_slots:type_name -> game.v1.PreparedSlotView
-	147, // 229: game.v1.CharacterSheetView.spontaneous_use_pools:type_name -> game.v1.SpontaneousUsePoolView
-	146, // 230: game.v1.CharacterSheetView.innate_slots:type_name -> game.v1.InnateSlotView
-	143, // 231: game.v1.CharacterSheetView.hardwired_slots:type_name -> game.v1.HardwiredSlotView
-	144, // 232: game.v1.CharacterSheetView.spontaneous_known:type_name -> game.v1.SpontaneousKnownEntry
-	259, // 233: game.v1.CharacterSheetView.armor_categories:type_name -> game.v1.CharacterSheetView.ArmorCategoriesEntry
-	150, // 234: game.v1.ProficienciesResponse.proficiencies:type_name -> game.v1.ProficiencyEntry
-	213, // 235: game.v1.HotbarUpdateEvent.slots:type_name -> game.v1.HotbarSlot
-	218, // 236: game.v1.CraftResultEvent.materials_lost:type_name -> game.v1.MaterialLoss
-	223, // 237: game.v1.JobGrantsResponse.feat_grants:type_name -> game.v1.JobFeatGrant
-	224, // 238: game.v1.JobGrantsResponse.tech_grants:type_name -> game.v1.JobTechGrant
-	227, // 239: game.v1.JobGrantsResponse.pending_feat_choices:type_name -> game.v1.PendingFeatChoice
-	226, // 240: game.v1.PendingFeatChoice.options:type_name -> game.v1.FeatOption
-	229, // 241: game.v1.AdminListSessionsResponse.sessions:type_name -> game.v1.AdminSessionInfo
-	239, // 242: game.v1.AdminListZonesResponse.zones:type_name -> game.v1.AdminZoneSummary
-	242, // 243: game.v1.AdminListRoomsResponse.rooms:type_name -> game.v1.AdminRoomSummary
-	247, // 244: game.v1.AdminListNPCTemplatesResponse.templates:type_name -> game.v1.AdminNPCTemplateSummary
-	6,   // 245: game.v1.GameService.Session:input_type -> game.v1.ClientMessage
-	230, // 246: game.v1.GameService.AdminListSessions:input_type -> game.v1.AdminListSessionsRequest
-	232, // 247: game.v1.GameService.AdminKickPlayer:input_type -> game.v1.AdminKickRequest
-	234, // 248: game.v1.GameService.AdminMessagePlayer:input_type -> game.v1.AdminMessageRequest
-	236, // 249: game.v1.GameService.AdminTeleportPlayer:input_type -> game.v1.AdminTeleportRequest
-	238, // 250: game.v1.GameService.AdminListZones:input_type -> game.v1.AdminListZonesRequest
-	241, // 251: game.v1.GameService.AdminListRooms:input_type -> game.v1.AdminListRoomsRequest
-	244, // 252: game.v1.GameService.AdminUpdateRoom:input_type -> game.v1.AdminUpdateRoomRequest
-	246, // 253: game.v1.GameService.AdminListNPCTemplates:input_type -> game.v1.AdminListNPCTemplatesRequest
-	249, // 254: game.v1.GameService.AdminSpawnNPC:input_type -> game.v1.AdminSpawnNPCRequest
-	251, // 255: game.v1.GameService.AdminGiveItem:input_type -> game.v1.AdminGiveItemRequest
-	253, // 256: game.v1.GameService.AdminGiveCurrency:input_type -> game.v1.AdminGiveCurrencyRequest
-	36,  // 257: game.v1.GameService.Session:output_type -> game.v1.ServerEvent
-	231, // 258: game.v1.GameService.AdminListSessions:output_type -> game.v1.AdminListSessionsResponse
-	233, // 259: game.v1.GameService.AdminKickPlayer:output_type -> game.v1.AdminKickResponse
-	235, // 260: game.v1.GameService.AdminMessagePlayer:output_type -> game.v1.AdminMessageResponse
-	237, // 261: game.v1.GameService.AdminTeleportPlayer:output_type -> game.v1.AdminTeleportResponse
-	240, // 262: game.v1.GameService.AdminListZones:output_type -> game.v1.AdminListZonesResponse
-	243, // 263: game.v1.GameService.AdminListRooms:output_type -> game.v1.AdminListRoomsResponse
-	245, // 264: game.v1.GameService.AdminUpdateRoom:output_type -> game.v1.AdminUpdateRoomResponse
-	248, // 265: game.v1.GameService.AdminListNPCTemplates:output_type -> game.v1.AdminListNPCTemplatesResponse
-	250, // 266: game.v1.GameService.AdminSpawnNPC:output_type -> game.v1.AdminSpawnNPCResponse
-	252, // 267: game.v1.GameService.AdminGiveItem:output_type -> game.v1.AdminGiveItemResponse
-	254, // 268: game.v1.GameService.AdminGiveCurrency:output_type -> game.v1.AdminGiveCurrencyResponse
-	257, // [257:269] is the sub-list for method output_type
-	245, // [245:257] is the sub-list for method input_type
-	245, // [245:245] is the sub-list for extension type_name
-	245, // [245:245] is the sub-list for extension extendee
-	0,   // [0:245] is the sub-list for field type_name
+	45,  // 0: game.v1.ClientMessage.join_world:type_name -> game.v1.JoinWorldRequest
+	47,  // 1: game.v1.ClientMessage.move:type_name -> game.v1.MoveRequest
+	48,  // 2: game.v1.ClientMessage.look:type_name -> game.v1.LookRequest
+	49,  // 3: game.v1.ClientMessage.say:type_name -> game.v1.SayRequest
+	50,  // 4: game.v1.ClientMessage.emote:type_name -> game.v1.EmoteRequest
+	51,  // 5: game.v1.ClientMessage.who:type_name -> game.v1.WhoRequest
+	52,  // 6: game.v1.ClientMessage.exits:type_name -> game.v1.ExitsRequest
+	53,  // 7: game.v1.ClientMessage.quit:type_name -> game.v1.QuitRequest
+	69,  // 8: game.v1.ClientMessage.examine:type_name -> game.v1.ExamineRequest
+	78,  // 9: game.v1.ClientMessage.attack:type_name -> game.v1.AttackRequest
+	79,  // 10: game.v1.ClientMessage.flee:type_name -> game.v1.FleeRequest
+	80,  // 11: game.v1.ClientMessage.pass:type_name -> game.v1.PassRequest
+	81,  // 12: game.v1.ClientMessage.strike:type_name -> game.v1.StrikeRequest
+	131, // 13: game.v1.ClientMessage.status:type_name -> game.v1.StatusRequest
+	82,  // 14: game.v1.ClientMessage.equip:type_name -> game.v1.EquipRequest
+	83,  // 15: game.v1.ClientMessage.reload:type_name -> game.v1.ReloadRequest
+	84,  // 16: game.v1.ClientMessage.fire_burst:type_name -> game.v1.FireBurstRequest
+	85,  // 17: game.v1.ClientMessage.fire_automatic:type_name -> game.v1.FireAutomaticRequest
+	86,  // 18: game.v1.ClientMessage.throw:type_name -> game.v1.ThrowRequest
+	87,  // 19: game.v1.ClientMessage.inventory_req:type_name -> game.v1.InventoryRequest
+	88,  // 20: game.v1.ClientMessage.get_item:type_name -> game.v1.GetItemRequest
+	89,  // 21: game.v1.ClientMessage.drop_item:type_name -> game.v1.DropItemRequest
+	90,  // 22: game.v1.ClientMessage.balance:type_name -> game.v1.BalanceRequest
+	91,  // 23: game.v1.ClientMessage.set_role:type_name -> game.v1.SetRoleRequest
+	103, // 24: game.v1.ClientMessage.teleport:type_name -> game.v1.TeleportRequest
+	92,  // 25: game.v1.ClientMessage.loadout:type_name -> game.v1.LoadoutRequest
+	101, // 26: game.v1.ClientMessage.unequip:type_name -> game.v1.UnequipRequest
+	102, // 27: game.v1.ClientMessage.equipment:type_name -> game.v1.EquipmentRequest
+	54,  // 28: game.v1.ClientMessage.switch_character:type_name -> game.v1.SwitchCharacterRequest
+	133, // 29: game.v1.ClientMessage.wear:type_name -> game.v1.WearRequest
+	134, // 30: game.v1.ClientMessage.remove_armor:type_name -> game.v1.RemoveArmorRequest
+	136, // 31: game.v1.ClientMessage.char_sheet:type_name -> game.v1.CharacterSheetRequest
+	137, // 32: game.v1.ClientMessage.archetype_selection:type_name -> game.v1.ArchetypeSelectionRequest
+	107, // 33: game.v1.ClientMessage.use_equipment:type_name -> game.v1.UseEquipmentRequest
+	119, // 34: game.v1.ClientMessage.room_equip:type_name -> game.v1.RoomEquipRequest
+	108, // 35: game.v1.ClientMessage.map:type_name -> game.v1.MapRequest
+	116, // 36: game.v1.ClientMessage.skills_request:type_name -> game.v1.SkillsRequest
+	138, // 37: game.v1.ClientMessage.feats_request:type_name -> game.v1.FeatsRequest
+	144, // 38: game.v1.ClientMessage.interact_request:type_name -> game.v1.InteractRequest
+	147, // 39: game.v1.ClientMessage.use_request:type_name -> game.v1.UseRequest
+	141, // 40: game.v1.ClientMessage.class_features_request:type_name -> game.v1.ClassFeaturesRequest
+	104, // 41: game.v1.ClientMessage.summon_item:type_name -> game.v1.SummonItemRequest
+	156, // 42: game.v1.ClientMessage.proficiencies_request:type_name -> game.v1.ProficienciesRequest
+	159, // 43: game.v1.ClientMessage.level_up:type_name -> game.v1.LevelUpRequest
+	160, // 44: game.v1.ClientMessage.combat_default:type_name -> game.v1.CombatDefaultRequest
+	220, // 45: game.v1.ClientMessage.train_skill:type_name -> game.v1.TrainSkillRequest
+	221, // 46: game.v1.ClientMessage.action:type_name -> game.v1.ActionRequest
+	222, // 47: game.v1.ClientMessage.raise_shield:type_name -> game.v1.RaiseShieldRequest
+	223, // 48: game.v1.ClientMessage.take_cover:type_name -> game.v1.TakeCoverRequest
+	224, // 49: game.v1.ClientMessage.first_aid:type_name -> game.v1.FirstAidRequest
+	225, // 50: game.v1.ClientMessage.feint:type_name -> game.v1.FeintRequest
+	226, // 51: game.v1.ClientMessage.demoralize:type_name -> game.v1.DemoralizeRequest
+	227, // 52: game.v1.ClientMessage.grapple:type_name -> game.v1.GrappleRequest
+	228, // 53: game.v1.ClientMessage.trip:type_name -> game.v1.TripRequest
+	234, // 54: game.v1.ClientMessage.hide:type_name -> game.v1.HideRequest
+	235, // 55: game.v1.ClientMessage.sneak:type_name -> game.v1.SneakRequest
+	236, // 56: game.v1.ClientMessage.divert:type_name -> game.v1.DivertRequest
+	237, // 57: game.v1.ClientMessage.escape:type_name -> game.v1.EscapeRequest
+	254, // 58: game.v1.ClientMessage.grant:type_name -> game.v1.GrantRequest
+	229, // 59: game.v1.ClientMessage.disarm:type_name -> game.v1.DisarmRequest
+	230, // 60: game.v1.ClientMessage.stride:type_name -> game.v1.StrideRequest
+	232, // 61: game.v1.ClientMessage.shove:type_name -> game.v1.ShoveRequest
+	233, // 62: game.v1.ClientMessage.step:type_name -> game.v1.StepRequest
+	238, // 63: game.v1.ClientMessage.tumble:type_name -> game.v1.TumbleRequest
+	239, // 64: game.v1.ClientMessage.seek:type_name -> game.v1.SeekRequest
+	240, // 65: game.v1.ClientMessage.climb:type_name -> game.v1.ClimbRequest
+	241, // 66: game.v1.ClientMessage.swim:type_name -> game.v1.SwimRequest
+	253, // 67: game.v1.ClientMessage.motive:type_name -> game.v1.MotiveRequest
+	242, // 68: game.v1.ClientMessage.calm:type_name -> game.v1.CalmRequest
+	243, // 69: game.v1.ClientMessage.hero_point:type_name -> game.v1.HeroPointRequest
+	244, // 70: game.v1.ClientMessage.delay:type_name -> game.v1.DelayRequest
+	245, // 71: game.v1.ClientMessage.join:type_name -> game.v1.JoinRequest
+	246, // 72: game.v1.ClientMessage.decline:type_name -> game.v1.DeclineRequest
+	247, // 73: game.v1.ClientMessage.group:type_name -> game.v1.GroupRequest
+	248, // 74: game.v1.ClientMessage.invite:type_name -> game.v1.InviteRequest
+	249, // 75: game.v1.ClientMessage.accept_group:type_name -> game.v1.AcceptGroupRequest
+	250, // 76: game.v1.ClientMessage.decline_group:type_name -> game.v1.DeclineGroupRequest
+	251, // 77: game.v1.ClientMessage.ungroup:type_name -> game.v1.UngroupRequest
+	252, // 78: game.v1.ClientMessage.kick:type_name -> game.v1.KickRequest
+	9,   // 79: game.v1.ClientMessage.rest:type_name -> game.v1.RestRequest
+	10,  // 80: game.v1.ClientMessage.select_tech:type_name -> game.v1.SelectTechRequest
+	11,  // 81: game.v1.ClientMessage.aid:type_name -> game.v1.AidRequest
+	12,  // 82: game.v1.ClientMessage.disarm_trap:type_name -> game.v1.DisarmTrapRequest
+	34,  // 83: game.v1.ClientMessage.deploy_trap:type_name -> game.v1.DeployTrapRequest
+	13,  // 84: game.v1.ClientMessage.ready:type_name -> game.v1.ReadyRequest
+	14,  // 85: game.v1.ClientMessage.browse:type_name -> game.v1.BrowseRequest
+	15,  // 86: game.v1.ClientMessage.buy:type_name -> game.v1.BuyRequest
+	16,  // 87: game.v1.ClientMessage.sell:type_name -> game.v1.SellRequest
+	17,  // 88: game.v1.ClientMessage.negotiate:type_name -> game.v1.NegotiateRequest
+	18,  // 89: game.v1.ClientMessage.stash_deposit:type_name -> game.v1.StashDepositRequest
+	19,  // 90: game.v1.ClientMessage.stash_withdraw:type_name -> game.v1.StashWithdrawRequest
+	20,  // 91: game.v1.ClientMessage.stash_balance:type_name -> game.v1.StashBalanceRequest
+	21,  // 92: game.v1.ClientMessage.heal:type_name -> game.v1.HealRequest
+	22,  // 93: game.v1.ClientMessage.heal_amount:type_name -> game.v1.HealAmountRequest
+	23,  // 94: game.v1.ClientMessage.train_job:type_name -> game.v1.TrainJobRequest
+	25,  // 95: game.v1.ClientMessage.list_jobs:type_name -> game.v1.ListJobsRequest
+	26,  // 96: game.v1.ClientMessage.set_job:type_name -> game.v1.SetJobRequest
+	27,  // 97: game.v1.ClientMessage.hire:type_name -> game.v1.HireRequest
+	28,  // 98: game.v1.ClientMessage.dismiss:type_name -> game.v1.DismissRequest
+	29,  // 99: game.v1.ClientMessage.talk:type_name -> game.v1.TalkRequest
+	30,  // 100: game.v1.ClientMessage.bribe_request:type_name -> game.v1.BribeRequest
+	31,  // 101: game.v1.ClientMessage.bribe_confirm_request:type_name -> game.v1.BribeConfirmRequest
+	32,  // 102: game.v1.ClientMessage.surrender_request:type_name -> game.v1.SurrenderRequest
+	33,  // 103: game.v1.ClientMessage.release_request:type_name -> game.v1.ReleaseRequest
+	255, // 104: game.v1.ClientMessage.spawn_npc:type_name -> game.v1.SpawnNPCRequest
+	257, // 105: game.v1.ClientMessage.add_room:type_name -> game.v1.AddRoomRequest
+	258, // 106: game.v1.ClientMessage.add_link:type_name -> game.v1.AddLinkRequest
+	259, // 107: game.v1.ClientMessage.remove_link:type_name -> game.v1.RemoveLinkRequest
+	260, // 108: game.v1.ClientMessage.set_room:type_name -> game.v1.SetRoomRequest
+	261, // 109: game.v1.ClientMessage.editor_cmds:type_name -> game.v1.EditorCmdsRequest
+	35,  // 110: game.v1.ClientMessage.travel:type_name -> game.v1.TravelRequest
+	36,  // 111: game.v1.ClientMessage.activate_item:type_name -> game.v1.ActivateItemRequest
+	264, // 112: game.v1.ClientMessage.faction_request:type_name -> game.v1.FactionRequest
+	265, // 113: game.v1.ClientMessage.faction_info_request:type_name -> game.v1.FactionInfoRequest
+	266, // 114: game.v1.ClientMessage.faction_standing_request:type_name -> game.v1.FactionStandingRequest
+	267, // 115: game.v1.ClientMessage.change_rep_request:type_name -> game.v1.ChangeRepRequest
+	268, // 116: game.v1.ClientMessage.tab_complete:type_name -> game.v1.TabCompleteRequest
+	270, // 117: game.v1.ClientMessage.materials_request:type_name -> game.v1.MaterialsRequest
+	271, // 118: game.v1.ClientMessage.craft_list_request:type_name -> game.v1.CraftListRequest
+	272, // 119: game.v1.ClientMessage.craft_request:type_name -> game.v1.CraftRequest
+	273, // 120: game.v1.ClientMessage.craft_confirm_request:type_name -> game.v1.CraftConfirmRequest
+	274, // 121: game.v1.ClientMessage.scavenge_request:type_name -> game.v1.ScavengeRequest
+	275, // 122: game.v1.ClientMessage.affix_request:type_name -> game.v1.AffixRequest
+	276, // 123: game.v1.ClientMessage.explore_request:type_name -> game.v1.ExploreRequest
+	283, // 124: game.v1.ClientMessage.quest_request:type_name -> game.v1.QuestRequest
+	286, // 125: game.v1.ClientMessage.uncurse_request:type_name -> game.v1.UncurseRequest
+	282, // 126: game.v1.ClientMessage.downtime_request:type_name -> game.v1.DowntimeRequest
+	277, // 127: game.v1.ClientMessage.refocus_request:type_name -> game.v1.RefocusRequest
+	278, // 128: game.v1.ClientMessage.seduce_request:type_name -> game.v1.SeduceRequest
+	280, // 129: game.v1.ClientMessage.hotbar_request:type_name -> game.v1.HotbarRequest
+	262, // 130: game.v1.ClientMessage.spawn_char_request:type_name -> game.v1.SpawnCharRequest
+	263, // 131: game.v1.ClientMessage.delete_char_request:type_name -> game.v1.DeleteCharRequest
+	256, // 132: game.v1.ClientMessage.kill_npc_request:type_name -> game.v1.KillNPCRequest
+	8,   // 133: game.v1.ClientMessage.uncover_request:type_name -> game.v1.UncoverRequest
+	288, // 134: game.v1.ClientMessage.job_grants_request:type_name -> game.v1.JobGrantsRequest
+	100, // 135: game.v1.ClientMessage.quest_log_request:type_name -> game.v1.QuestLogRequest
+	24,  // 136: game.v1.ClientMessage.train_tech:type_name -> game.v1.TrainTechRequest
+	294, // 137: game.v1.ClientMessage.choose_feat:type_name -> game.v1.ChooseFeatRequest
+	231, // 138: game.v1.ClientMessage.move_to:type_name -> game.v1.MoveToRequest
+	40,  // 139: game.v1.ClientMessage.reaction_response:type_name -> game.v1.ReactionResponse
+	161, // 140: game.v1.ClientMessage.combat_verbosity:type_name -> game.v1.CombatVerbosityRequest
+	162, // 141: game.v1.ClientMessage.locale:type_name -> game.v1.LocaleRequest
+	163, // 142: game.v1.ClientMessage.chat_mute:type_name -> game.v1.ChatMuteRequest
+	164, // 143: game.v1.ClientMessage.audit:type_name -> game.v1.AuditRequest
+	165, // 144: game.v1.ClientMessage.settings:type_name -> game.v1.SettingsRequest
+	166, // 145: game.v1.ClientMessage.rename:type_name -> game.v1.RenameRequest
+	167, // 146: game.v1.ClientMessage.character_slots:type_name -> game.v1.CharacterSlotsRequest
+	168, // 147: game.v1.ClientMessage.tutorial:type_name -> game.v1.TutorialRequest
+	169, // 148: game.v1.ClientMessage.home:type_name -> game.v1.HomeRequest
+	170, // 149: game.v1.ClientMessage.clan:type_name -> game.v1.ClanRequest
+	171, // 150: game.v1.ClientMessage.achievements:type_name -> game.v1.AchievementsRequest
+	172, // 151: game.v1.ClientMessage.title:type_name -> game.v1.TitleRequest
+	173, // 152: game.v1.ClientMessage.top:type_name -> game.v1.TopRequest
+	174, // 153: game.v1.ClientMessage.played:type_name -> game.v1.PlayedRequest
+	175, // 154: game.v1.ClientMessage.afk:type_name -> game.v1.AfkRequest
+	176, // 155: game.v1.ClientMessage.site_ban:type_name -> game.v1.SiteBanRequest
+	177, // 156: game.v1.ClientMessage.consider:type_name -> game.v1.ConsiderRequest
+	178, // 157: game.v1.ClientMessage.scan:type_name -> game.v1.ScanRequest
+	179, // 158: game.v1.ClientMessage.light:type_name -> game.v1.LightRequest
+	180, // 159: game.v1.ClientMessage.board:type_name -> game.v1.BoardRequest
+	181, // 160: game.v1.ClientMessage.disembark:type_name -> game.v1.DisembarkRequest
+	182, // 161: game.v1.ClientMessage.drive:type_name -> game.v1.DriveRequest
+	183, // 162: game.v1.ClientMessage.refuel:type_name -> game.v1.RefuelRequest
+	184, // 163: game.v1.ClientMessage.shout:type_name -> game.v1.ShoutRequest
+	185, // 164: game.v1.ClientMessage.yell:type_name -> game.v1.YellRequest
+	186, // 165: game.v1.ClientMessage.pay_fine:type_name -> game.v1.PayFineRequest
+	187, // 166: game.v1.ClientMessage.steal:type_name -> game.v1.StealRequest
+	188, // 167: game.v1.ClientMessage.market:type_name -> game.v1.MarketRequest
+	189, // 168: game.v1.ClientMessage.auction:type_name -> game.v1.AuctionRequest
+	190, // 169: game.v1.ClientMessage.pick:type_name -> game.v1.PickRequest
+	191, // 170: game.v1.ClientMessage.detect_traps:type_name -> game.v1.DetectTrapsRequest
+	192, // 171: game.v1.ClientMessage.log:type_name -> game.v1.LogRequest
+	193, // 172: game.v1.ClientMessage.report:type_name -> game.v1.ReportRequest
+	194, // 173: game.v1.ClientMessage.reports:type_name -> game.v1.ReportsRequest
+	195, // 174: game.v1.ClientMessage.invis:type_name -> game.v1.InvisRequest
+	196, // 175: game.v1.ClientMessage.snoop:type_name -> game.v1.SnoopRequest
+	197, // 176: game.v1.ClientMessage.force:type_name -> game.v1.ForceRequest
+	198, // 177: game.v1.ClientMessage.puppet:type_name -> game.v1.PuppetRequest
+	219, // 178: game.v1.ClientMessage.gm:type_name -> game.v1.GMRequest
+	218, // 179: game.v1.ClientMessage.time:type_name -> game.v1.TimeRequest
+	199, // 180: game.v1.ClientMessage.schedule:type_name -> game.v1.ScheduleRequest
+	200, // 181: game.v1.ClientMessage.engrave:type_name -> game.v1.EngraveRequest
+	201, // 182: game.v1.ClientMessage.dig:type_name -> game.v1.DigRequest
+	202, // 183: game.v1.ClientMessage.answer:type_name -> game.v1.AnswerRequest
+	203, // 184: game.v1.ClientMessage.reload_config:type_name -> game.v1.ReloadConfigRequest
+	204, // 185: game.v1.ClientMessage.flag:type_name -> game.v1.FlagRequest
+	205, // 186: game.v1.ClientMessage.transfer_character:type_name -> game.v1.TransferCharacterRequest
+	206, // 187: game.v1.ClientMessage.shared_stash:type_name -> game.v1.SharedStashRequest
+	207, // 188: game.v1.ClientMessage.parley:type_name -> game.v1.ParleyRequest
+	208, // 189: game.v1.ClientMessage.social:type_name -> game.v1.SocialRequest
+	209, // 190: game.v1.ClientMessage.restrain:type_name -> game.v1.RestrainRequest
+	210, // 191: game.v1.ClientMessage.nonlethal:type_name -> game.v1.NonlethalRequest
+	211, // 192: game.v1.ClientMessage.practice:type_name -> game.v1.PracticeRequest
+	212, // 193: game.v1.ClientMessage.recap:type_name -> game.v1.RecapRequest
+	213, // 194: game.v1.ClientMessage.formation:type_name -> game.v1.FormationRequest
+	214, // 195: game.v1.ClientMessage.order:type_name -> game.v1.OrderRequest
+	215, // 196: game.v1.ClientMessage.job_ability:type_name -> game.v1.JobAbilityRequest
+	216, // 197: game.v1.ClientMessage.respec:type_name -> game.v1.RespecRequest
+	217, // 198: game.v1.ClientMessage.export_character:type_name -> game.v1.ExportCharacterRequest
+	55,  // 199: game.v1.ServerEvent.room_view:type_name -> game.v1.RoomView
+	59,  // 200: game.v1.ServerEvent.message:type_name -> game.v1.MessageEvent
+	60,  // 201: game.v1.ServerEvent.room_event:type_name -> game.v1.RoomEvent
+	61,  // 202: game.v1.ServerEvent.player_list:type_name -> game.v1.PlayerList
+	63,  // 203: game.v1.ServerEvent.exit_list:type_name -> game.v1.ExitList
+	64,  // 204: game.v1.ServerEvent.error:type_name -> game.v1.ErrorEvent
+	65,  // 205: game.v1.ServerEvent.disconnected:type_name -> game.v1.Disconnected
+	67,  // 206: game.v1.ServerEvent.character_info:type_name -> game.v1.CharacterInfo
+	70,  // 207: game.v1.ServerEvent.npc_view:type_name -> game.v1.NpcView
+	130, // 208: game.v1.ServerEvent.combat_event:type_name -> game.v1.CombatEvent
+	125, // 209: game.v1.ServerEvent.round_start:type_name -> game.v1.RoundStartEvent
+	126, // 210: game.v1.ServerEvent.round_end:type_name -> game.v1.RoundEndEvent
+	132, // 211: game.v1.ServerEvent.condition_event:type_name -> game.v1.ConditionEvent
+	121, // 212: game.v1.ServerEvent.inventory_view:type_name -> game.v1.InventoryView
+	66,  // 213: game.v1.ServerEvent.time_of_day:type_name -> game.v1.TimeOfDayEvent
+	152, // 214: game.v1.ServerEvent.character_sheet:type_name -> game.v1.CharacterSheetView
+	115, // 215: game.v1.ServerEvent.map:type_name -> game.v1.MapResponse
+	118, // 216: game.v1.ServerEvent.skills_response:type_name -> game.v1.SkillsResponse
+	140, // 217: game.v1.ServerEvent.feats_response:type_name -> game.v1.FeatsResponse
+	145, // 218: game.v1.ServerEvent.interact_response:type_name -> game.v1.InteractResponse
+	148, // 219: game.v1.ServerEvent.use_response:type_name -> game.v1.UseResponse
+	143, // 220: game.v1.ServerEvent.class_features_response:type_name -> game.v1.ClassFeaturesResponse
+	158, // 221: game.v1.ServerEvent.proficiencies_response:type_name -> game.v1.ProficienciesResponse
+	43,  // 222: game.v1.ServerEvent.hp_update:type_name -> game.v1.HpUpdateEvent
+	269, // 223: game.v1.ServerEvent.tab_complete:type_name -> game.v1.TabCompleteResponse
+	285, // 224: game.v1.ServerEvent.craft_result:type_name -> game.v1.CraftResultEvent
+	281, // 225: game.v1.ServerEvent.hotbar_update:type_name -> game.v1.HotbarUpdateEvent
+	42,  // 226: game.v1.ServerEvent.shop_view:type_name -> game.v1.ShopView
+	71,  // 227: game.v1.ServerEvent.healer_view:type_name -> game.v1.HealerView
+	73,  // 228: game.v1.ServerEvent.trainer_view:type_name -> game.v1.TrainerView
+	287, // 229: game.v1.ServerEvent.weather:type_name -> game.v1.WeatherEvent
+	94,  // 230: game.v1.ServerEvent.loadout_view:type_name -> game.v1.LoadoutView
+	76,  // 231: game.v1.ServerEvent.fixer_view:type_name -> game.v1.FixerView
+	291, // 232: game.v1.ServerEvent.job_grants_response:type_name -> game.v1.JobGrantsResponse
+	77,  // 233: game.v1.ServerEvent.rest_view:type_name -> game.v1.RestView
+	127, // 234: game.v1.ServerEvent.ap_update:type_name -> game.v1.APUpdateEvent
+	97,  // 235: game.v1.ServerEvent.quest_giver_view:type_name -> game.v1.QuestGiverView
+	98,  // 236: game.v1.ServerEvent.quest_log_view:type_name -> game.v1.QuestLogView
+	99,  // 237: game.v1.ServerEvent.quest_complete:type_name -> game.v1.QuestCompleteEvent
+	74,  // 238: game.v1.ServerEvent.tech_trainer_view:type_name -> game.v1.TechTrainerView
+	114, // 239: game.v1.ServerEvent.game_config:type_name -> game.v1.GameConfig
+	38,  // 240: game.v1.ServerEvent.reaction_prompt:type_name -> game.v1.ReactionPromptEvent
+	44,  // 241: game.v1.ServerEvent.title_update:type_name -> game.v1.TitleEvent
+	46,  // 242: game.v1.ServerEvent.account_settings:type_name -> game.v1.AccountSettings
+	128, // 243: game.v1.ServerEvent.combat_state:type_name -> game.v1.CombatStateEvent
+	57,  // 244: game.v1.ServerEvent.room_peek:type_name -> game.v1.RoomPeekView
+	39,  // 245: game.v1.ReactionPromptEvent.options:type_name -> game.v1.ReactionPromptOption
+	41,  // 246: game.v1.ShopView.items:type_name -> game.v1.ShopItem
+	46,  // 247: game.v1.JoinWorldRequest.settings:type_name -> game.v1.AccountSettings
+	58,  // 248: game.v1.RoomView.exits:type_name -> game.v1.ExitInfo
+	68,  // 249: game.v1.RoomView.npcs:type_name -> game.v1.NpcInfo
+	135, // 250: game.v1.RoomView.active_conditions:type_name -> game.v1.ConditionInfo
+	105, // 251: game.v1.RoomView.floor_items:type_name -> game.v1.FloorItem
+	106, // 252: game.v1.RoomView.equipment:type_name -> game.v1.RoomEquipmentItem
+	56,  // 253: game.v1.RoomView.vehicles:type_name -> game.v1.VehicleInfo
+	68,  // 254: game.v1.RoomPeekView.npcs:type_name -> game.v1.NpcInfo
+	0,   // 255: game.v1.MessageEvent.type:type_name -> game.v1.MessageType
+	1,   // 256: game.v1.RoomEvent.type:type_name -> game.v1.RoomEventType
+	62,  // 257: game.v1.PlayerList.players:type_name -> game.v1.PlayerInfo
+	2,   // 258: game.v1.PlayerInfo.status:type_name -> game.v1.CombatStatus
+	58,  // 259: game.v1.ExitList.exits:type_name -> game.v1.ExitInfo
+	72,  // 260: game.v1.TrainerView.jobs:type_name -> game.v1.JobOfferEntry
+	75,  // 261: game.v1.TechTrainerView.offers:type_name -> game.v1.TechOfferEntry
+	324, // 262: game.v1.FixerView.bribe_costs:type_name -> game.v1.FixerView.BribeCostsEntry
+	93,  // 263: game.v1.LoadoutView.presets:type_name -> game.v1.LoadoutWeaponPreset
+	95,  // 264: game.v1.QuestEntryView.objectives:type_name -> game.v1.QuestObjectiveView
+	96,  // 265: game.v1.QuestGiverView.quests:type_name -> game.v1.QuestEntryView
+	96,  // 266: game.v1.QuestLogView.quests:type_name -> game.v1.QuestEntryView
+	109, // 267: game.v1.MapTile.poi_npcs:type_name -> game.v1.PoiWithNpc
+	110, // 268: game.v1.MapTile.zone_exits:type_name -> game.v1.ZoneExitInfo
+	111, // 269: game.v1.MapTile.same_zone_exit_targets:type_name -> game.v1.SameZoneExitTarget
+	112, // 270: game.v1.MapResponse.tiles:type_name -> game.v1.MapTile
+	113, // 271: game.v1.MapResponse.world_tiles:type_name -> game.v1.WorldZoneTile
+	117, // 272: game.v1.SkillsResponse.skills:type_name -> game.v1.SkillEntry
+	120, // 273: game.v1.InventoryView.items:type_name -> game.v1.InventoryItem
+	122, // 274: game.v1.RoundStartEvent.initial_positions:type_name -> game.v1.CombatantPosition
+	123, // 275: game.v1.RoundStartEvent.cover_objects:type_name -> game.v1.CoverObjectPosition
+	124, // 276: game.v1.RoundStartEvent.terrain:type_name -> game.v1.TerrainCell
+	129, // 277: game.v1.CombatStateEvent.combatants:type_name -> game.v1.CombatantStatus
+	4,   // 278: game.v1.CombatEvent.type:type_name -> game.v1.CombatEventType
+	3,   // 279: game.v1.CombatEvent.attacker_relation:type_name -> game.v1.CombatRelation
+	3,   // 280: game.v1.CombatEvent.target_relation:type_name -> game.v1.CombatRelation
+	139, // 281: game.v1.FeatsResponse.feats:type_name -> game.v1.FeatEntry
+	142, // 282: game.v1.ClassFeaturesResponse.archetype_features:type_name -> game.v1.ClassFeatureEntry
+	142, // 283: game.v1.ClassFeaturesResponse.job_features:type_name -> game.v1.ClassFeatureEntry
+	5,   // 284: game.v1.AoeTemplate.shape:type_name -> game.v1.AoeTemplate.Shape
+	6,   // 285: game.v1.AoeTemplate.facing:type_name -> game.v1.AoeTemplate.Direction
+	325, // 286: game.v1.AoeTemplate.cells:type_name -> game.v1.AoeTemplate.Cell
+	146, // 287: game.v1.UseRequest.template:type_name -> game.v1.AoeTemplate
+	139, // 288: game.v1.UseResponse.choices:type_name -> game.v1.FeatEntry
+	326, // 289: game.v1.CharacterSheetView.armor:type_name -> game.v1.CharacterSheetView.ArmorEntry
+	327, // 290: game.v1.CharacterSheetView.accessories:type_name -> game.v1.CharacterSheetView.AccessoriesEntry
+	155, // 291: game.v1.CharacterSheetView.player_resistances:type_name -> game.v1.ResistanceEntry
+	155, // 292: game.v1.CharacterSheetView.player_weaknesses:type_name -> game.v1.ResistanceEntry
+	117, // 293: game.v1.CharacterSheetView.skills:type_name -> game.v1.SkillEntry
+	139, // 294: game.v1.CharacterSheetView.feats:type_name -> game.v1.FeatEntry
+	142, // 295: game.v1.CharacterSheetView.class_features:type_name -> game.v1.ClassFeatureEntry
+	157, // 296: game.v1.CharacterSheetView.proficiencies:type_name -> game.v1.ProficiencyEntry
+	149, // 297: game.v1.CharacterSheetView.prepared_slots:type_name -> game.v1.PreparedSlotView
+	154, // 298: game.v1.CharacterSheetView.spontaneous_use_pools:type_name -> game.v1.SpontaneousUsePoolView
+	153, // 299: game.v1.CharacterSheetView.innate_slots:type_name -> game.v1.InnateSlotView
+	150, // 300: game.v1.CharacterSheetView.hardwired_slots:type_name -> game.v1.HardwiredSlotView
+	151, // 301: game.v1.CharacterSheetView.spontaneous_known:type_name -> game.v1.SpontaneousKnownEntry
+	328, // 302: game.v1.CharacterSheetView.armor_categories:type_name -> game.v1.CharacterSheetView.ArmorCategoriesEntry
+	157, // 303: game.v1.ProficienciesResponse.proficiencies:type_name -> game.v1.ProficiencyEntry
+	7,   // 304: game.v1.ForceRequest.command:type_name -> game.v1.ClientMessage
+	279, // 305: game.v1.HotbarUpdateEvent.slots:type_name -> game.v1.HotbarSlot
+	284, // 306: game.v1.CraftResultEvent.materials_lost:type_name -> game.v1.MaterialLoss
+	289, // 307: game.v1.JobGrantsResponse.feat_grants:type_name -> game.v1.JobFeatGrant
+	290, // 308: game.v1.JobGrantsResponse.tech_grants:type_name -> game.v1.JobTechGrant
+	293, // 309: game.v1.JobGrantsResponse.pending_feat_choices:type_name -> game.v1.PendingFeatChoice
+	292, // 310: game.v1.PendingFeatChoice.options:type_name -> game.v1.FeatOption
+	295, // 311: game.v1.AdminListSessionsResponse.sessions:type_name -> game.v1.AdminSessionInfo
+	305, // 312: game.v1.AdminListZonesResponse.zones:type_name -> game.v1.AdminZoneSummary
+	308, // 313: game.v1.AdminListRoomsResponse.rooms:type_name -> game.v1.AdminRoomSummary
+	313, // 314: game.v1.AdminListNPCTemplatesResponse.templates:type_name -> game.v1.AdminNPCTemplateSummary
+	321, // 315: game.v1.GetCommandsResponse.commands:type_name -> game.v1.CommandInfo
+	7,   // 316: game.v1.GameService.Session:input_type -> game.v1.ClientMessage
+	296, // 317: game.v1.GameService.AdminListSessions:input_type -> game.v1.AdminListSessionsRequest
+	298, // 318: game.v1.GameService.AdminKickPlayer:input_type -> game.v1.AdminKickRequest
+	300, // 319: game.v1.GameService.AdminMessagePlayer:input_type -> game.v1.AdminMessageRequest
+	302, // 320: game.v1.GameService.AdminTeleportPlayer:input_type -> game.v1.AdminTeleportRequest
+	304, // 321: game.v1.GameService.AdminListZones:input_type -> game.v1.AdminListZonesRequest
+	307, // 322: game.v1.GameService.AdminListRooms:input_type -> game.v1.AdminListRoomsRequest
+	310, // 323: game.v1.GameService.AdminUpdateRoom:input_type -> game.v1.AdminUpdateRoomRequest
+	312, // 324: game.v1.GameService.AdminListNPCTemplates:input_type -> game.v1.AdminListNPCTemplatesRequest
+	315, // 325: game.v1.GameService.AdminSpawnNPC:input_type -> game.v1.AdminSpawnNPCRequest
+	317, // 326: game.v1.GameService.AdminGiveItem:input_type -> game.v1.AdminGiveItemRequest
+	319, // 327: game.v1.GameService.AdminGiveCurrency:input_type -> game.v1.AdminGiveCurrencyRequest
+	322, // 328: game.v1.GameService.GetCommands:input_type -> game.v1.GetCommandsRequest
+	37,  // 329: game.v1.GameService.Session:output_type -> game.v1.ServerEvent
+	297, // 330: game.v1.GameService.AdminListSessions:output_type -> game.v1.AdminListSessionsResponse
+	299, // 331: game.v1.GameService.AdminKickPlayer:output_type -> game.v1.AdminKickResponse
+	301, // 332: game.v1.GameService.AdminMessagePlayer:output_type -> game.v1.AdminMessageResponse
+	303, // 333: game.v1.GameService.AdminTeleportPlayer:output_type -> game.v1.AdminTeleportResponse
+	306, // 334: game.v1.GameService.AdminListZones:output_type -> game.v1.AdminListZonesResponse
+	309, // 335: game.v1.GameService.AdminListRooms:output_type -> game.v1.AdminListRoomsResponse
+	311, // 336: game.v1.GameService.AdminUpdateRoom:output_type -> game.v1.AdminUpdateRoomResponse
+	314, // 337: game.v1.GameService.AdminListNPCTemplates:output_type -> game.v1.AdminListNPCTemplatesResponse
+	316, // 338: game.v1.GameService.AdminSpawnNPC:output_type -> game.v1.AdminSpawnNPCResponse
+	318, // 339: game.v1.GameService.AdminGiveItem:output_type -> game.v1.AdminGiveItemResponse
+	320, // 340: game.v1.GameService.AdminGiveCurrency:output_type -> game.v1.AdminGiveCurrencyResponse
+	323, // 341: game.v1.GameService.GetCommands:output_type -> game.v1.GetCommandsResponse
+	329, // [329:342] is the sub-list for method output_type
+	316, // [316:329] is the sub-list for method input_type
+	316, // [316:316] is the sub-list for extension type_name
+	316, // [316:316] is the sub-list for extension extendee
+	0,   // [0:316] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_game_proto_init() }
@@ -19916,6 +25245,65 @@ func file_game_v1_game_proto_init() {
 		(*ClientMessage_ChooseFeat)(nil),
 		(*ClientMessage_MoveTo)(nil),
 		(*ClientMessage_ReactionResponse)(nil),
+		(*ClientMessage_CombatVerbosity)(nil),
+		(*ClientMessage_Locale)(nil),
+		(*ClientMessage_ChatMute)(nil),
+		(*ClientMessage_Audit)(nil),
+		(*ClientMessage_Settings)(nil),
+		(*ClientMessage_Rename)(nil),
+		(*ClientMessage_CharacterSlots)(nil),
+		(*ClientMessage_Tutorial)(nil),
+		(*ClientMessage_Home)(nil),
+		(*ClientMessage_Clan)(nil),
+		(*ClientMessage_Achievements)(nil),
+		(*ClientMessage_Title)(nil),
+		(*ClientMessage_Top)(nil),
+		(*ClientMessage_Played)(nil),
+		(*ClientMessage_Afk)(nil),
+		(*ClientMessage_SiteBan)(nil),
+		(*ClientMessage_Consider)(nil),
+		(*ClientMessage_Scan)(nil),
+		(*ClientMessage_Light)(nil),
+		(*ClientMessage_Board)(nil),
+		(*ClientMessage_Disembark)(nil),
+		(*ClientMessage_Drive)(nil),
+		(*ClientMessage_Refuel)(nil),
+		(*ClientMessage_Shout)(nil),
+		(*ClientMessage_Yell)(nil),
+		(*ClientMessage_PayFine)(nil),
+		(*ClientMessage_Steal)(nil),
+		(*ClientMessage_Market)(nil),
+		(*ClientMessage_Auction)(nil),
+		(*ClientMessage_Pick)(nil),
+		(*ClientMessage_DetectTraps)(nil),
+		(*ClientMessage_Log)(nil),
+		(*ClientMessage_Report)(nil),
+		(*ClientMessage_Reports)(nil),
+		(*ClientMessage_Invis)(nil),
+		(*ClientMessage_Snoop)(nil),
+		(*ClientMessage_Force)(nil),
+		(*ClientMessage_Puppet)(nil),
+		(*ClientMessage_Gm)(nil),
+		(*ClientMessage_Time)(nil),
+		(*ClientMessage_Schedule)(nil),
+		(*ClientMessage_Engrave)(nil),
+		(*ClientMessage_Dig)(nil),
+		(*ClientMessage_Answer)(nil),
+		(*ClientMessage_ReloadConfig)(nil),
+		(*ClientMessage_Flag)(nil),
+		(*ClientMessage_TransferCharacter)(nil),
+		(*ClientMessage_SharedStash)(nil),
+		(*ClientMessage_Parley)(nil),
+		(*ClientMessage_Social)(nil),
+		(*ClientMessage_Restrain)(nil),
+		(*ClientMessage_Nonlethal)(nil),
+		(*ClientMessage_Practice)(nil),
+		(*ClientMessage_Recap)(nil),
+		(*ClientMessage_Formation)(nil),
+		(*ClientMessage_Order)(nil),
+		(*ClientMessage_JobAbility)(nil),
+		(*ClientMessage_Respec)(nil),
+		(*ClientMessage_ExportCharacter)(nil),
 	}
 	file_game_v1_game_proto_msgTypes[30].OneofWrappers = []any{
 		(*ServerEvent_RoomView)(nil),
@@ -19960,14 +25348,18 @@ func file_game_v1_game_proto_init() {
 		(*ServerEvent_TechTrainerView)(nil),
 		(*ServerEvent_GameConfig)(nil),
 		(*ServerEvent_ReactionPrompt)(nil),
+		(*ServerEvent_TitleUpdate)(nil),
+		(*ServerEvent_AccountSettings)(nil),
+		(*ServerEvent_CombatState)(nil),
+		(*ServerEvent_RoomPeek)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_game_proto_rawDesc), len(file_game_v1_game_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   254,
+			NumEnums:      7,
+			NumMessages:   322,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,6 +31,7 @@ const (
 	GameService_AdminSpawnNPC_FullMethodName         = "/game.v1.GameService/AdminSpawnNPC"
 	GameService_AdminGiveItem_FullMethodName         = "/game.v1.GameService/AdminGiveItem"
 	GameService_AdminGiveCurrency_FullMethodName     = "/game.v1.GameService/AdminGiveCurrency"
+	GameService_GetCommands_FullMethodName           = "/game.v1.GameService/GetCommands"
 )
 
 // GameServiceClient is the client API for GameService service.
@@ -58,6 +59,9 @@ type GameServiceClient interface {
 	// Inventory admin RPCs.
 	AdminGiveItem(ctx context.Context, in *AdminGiveItemRequest, opts ...grpc.CallOption) (*AdminGiveItemResponse, error)
 	AdminGiveCurrency(ctx context.Context, in *AdminGiveCurrencyRequest, opts ...grpc.CallOption) (*AdminGiveCurrencyResponse, error)
+	// GetCommands returns the server's command catalog, so frontends resolve
+	// input and render help from the same data the server dispatches on.
+	GetCommands(ctx context.Context, in *GetCommandsRequest, opts ...grpc.CallOption) (*GetCommandsResponse, error)
 }
 
 type gameServiceClient struct {
@@ -191,6 +195,16 @@ func (c *gameServiceClient) AdminGiveCurrency(ctx context.Context, in *AdminGive
 	return out, nil
 }
 
+func (c *gameServiceClient) GetCommands(ctx context.Context, in *GetCommandsRequest, opts ...grpc.CallOption) (*GetCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommandsResponse)
+	err := c.cc.Invoke(ctx, GameService_GetCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServiceServer is the server API for GameService service.
 // All implementations must embed UnimplementedGameServiceServer
 // for forward compatibility.
@@ -216,6 +230,9 @@ type GameServiceServer interface {
 	// Inventory admin RPCs.
 	AdminGiveItem(context.Context, *AdminGiveItemRequest) (*AdminGiveItemResponse, error)
 	AdminGiveCurrency(context.Context, *AdminGiveCurrencyRequest) (*AdminGiveCurrencyResponse, error)
+	// GetCommands returns the server's command catalog, so frontends resolve
+	// input and render help from the same data the server dispatches on.
+	GetCommands(context.Context, *GetCommandsRequest) (*GetCommandsResponse, error)
 	mustEmbedUnimplementedGameServiceServer()
 }
 
@@ -262,6 +279,9 @@ func (UnimplementedGameServiceServer) AdminGiveItem(context.Context, *AdminGiveI
 func (UnimplementedGameServiceServer) AdminGiveCurrency(context.Context, *AdminGiveCurrencyRequest) (*AdminGiveCurrencyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AdminGiveCurrency not implemented")
 }
+func (UnimplementedGameServiceServer) GetCommands(context.Context, *GetCommandsRequest) (*GetCommandsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCommands not implemented")
+}
 func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
 func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
 
@@ -488,6 +508,24 @@ func _GameService_AdminGiveCurrency_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_GetCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetCommands(ctx, req.(*GetCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -539,6 +577,10 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminGiveCurrency",
 			Handler:    _GameService_AdminGiveCurrency_Handler,
 		},
+		{
+			MethodName: "GetCommands",
+			Handler:    _GameService_GetCommands_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -20,9 +20,14 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+	"github.com/cory-johannsen/mud/internal/game/auction"
 	"github.com/cory-johannsen/mud/internal/game/ai"
 	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/clan"
 	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/flags"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 	"github.com/cory-johannsen/mud/internal/game/combat"
 	"github.com/cory-johannsen/mud/internal/game/command"
 	"github.com/cory-johannsen/mud/internal/game/condition"
@@ -32,25 +37,38 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/dice"
 	"github.com/cory-johannsen/mud/internal/game/downtime"
 	"github.com/cory-johannsen/mud/internal/game/drawback"
+	"github.com/cory-johannsen/mud/internal/game/economy"
 	"github.com/cory-johannsen/mud/internal/game/faction"
 	"github.com/cory-johannsen/mud/internal/game/focuspoints"
+	"github.com/cory-johannsen/mud/internal/game/housing"
+	"github.com/cory-johannsen/mud/internal/game/i18n"
 	"github.com/cory-johannsen/mud/internal/game/inventory"
 	"github.com/cory-johannsen/mud/internal/game/maputil"
 	"github.com/cory-johannsen/mud/internal/game/mentalstate"
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	"github.com/cory-johannsen/mud/internal/game/npc/behavior"
+	"github.com/cory-johannsen/mud/internal/game/permission"
 	"github.com/cory-johannsen/mud/internal/game/quest"
+	"github.com/cory-johannsen/mud/internal/game/raid"
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/schedule"
 	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/settings"
 	"github.com/cory-johannsen/mud/internal/game/skillaction"
 	"github.com/cory-johannsen/mud/internal/game/skillcheck"
+	"github.com/cory-johannsen/mud/internal/game/social"
+	"github.com/cory-johannsen/mud/internal/game/stats"
 	"github.com/cory-johannsen/mud/internal/game/substance"
 	"github.com/cory-johannsen/mud/internal/game/technology"
+	"github.com/cory-johannsen/mud/internal/game/transcript"
 	"github.com/cory-johannsen/mud/internal/game/trap"
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
+	"github.com/cory-johannsen/mud/internal/game/vehicle"
 	"github.com/cory-johannsen/mud/internal/game/world"
 	"github.com/cory-johannsen/mud/internal/game/xp"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 	"github.com/cory-johannsen/mud/internal/scripting"
+	"github.com/cory-johannsen/mud/internal/siteban"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 
 	lua "github.com/yuin/gopher-lua"
@@ -59,26 +77,6 @@ import (
 // errQuit is returned by handleQuit to signal the command loop to stop cleanly.
 var errQuit = fmt.Errorf("quit")
 
-// requireEditor returns an error ServerEvent if the session lacks editor or admin role.
-// Precondition: sess must be non-nil.
-// Postcondition: Returns nil if role is editor or admin; returns error ServerEvent otherwise.
-func requireEditor(sess *session.PlayerSession) *gamev1.ServerEvent {
-	if sess.Role != postgres.RoleEditor && sess.Role != postgres.RoleAdmin {
-		return errorEvent("permission denied: editor role required")
-	}
-	return nil
-}
-
-// requireAdmin returns an error ServerEvent if the session lacks admin role.
-// Precondition: sess must be non-nil.
-// Postcondition: Returns nil if role is admin; returns error ServerEvent otherwise.
-func requireAdmin(sess *session.PlayerSession) *gamev1.ServerEvent {
-	if sess.Role != postgres.RoleAdmin {
-		return errorEvent("permission denied: admin role required")
-	}
-	return nil
-}
-
 // AccountAdmin provides account lookup and role mutation for in-game admin commands.
 //
 // Precondition: Implementations must be safe for concurrent use.
@@ -181,13 +179,15 @@ type CharacterFeatsGetter interface {
 }
 
 // CharacterFeatsRepo extends CharacterFeatsGetter with mutation needed for
-// level-up feat grants.
+// level-up feat grants and respecs.
 //
 // Precondition: characterID must be > 0; featID must be non-empty.
-// Postcondition: Add inserts a feat row; duplicate adds are no-ops.
+// Postcondition: Add inserts a feat row; duplicate adds are no-ops. Remove
+// deletes a feat row; removing an absent feat is a no-op.
 type CharacterFeatsRepo interface {
 	CharacterFeatsGetter
 	Add(ctx context.Context, characterID int64, featID string) error
+	Remove(ctx context.Context, characterID int64, featID string) error
 }
 
 // CharacterFeatLevelGrantsRepo tracks which character levels have had their
@@ -261,6 +261,7 @@ type GameServiceServer struct {
 	charAbilityBoostsRepo      postgres.CharacterAbilityBoostsRepository
 	archetypes                 map[string]*ruleset.Archetype
 	regions                    map[string]*ruleset.Region
+	heritages                  map[string]*ruleset.Heritage
 	xpSvc                      *xp.Service
 	progressRepo               ProgressRepository
 	mentalStateMgr             *mentalstate.Manager
@@ -274,6 +275,89 @@ type GameServiceServer struct {
 	trapTemplates              map[string]*trap.TrapTemplate
 	// merchantRuntimeStates maps NPC instance ID to active merchant runtime state.
 	merchantRuntimeStates map[string]*npc.MerchantRuntimeState
+	// merchantStateStore persists merchant runtime state. May be nil (state is in-memory only).
+	merchantStateStore MerchantStateStore
+	// market tracks regional trade volume and the price indices it drives. May be nil (prices never drift).
+	market *economy.Market
+	// housing tracks player room leases. May be nil (housing is disabled).
+	housing *housing.Registry
+	// housingStore persists leases. May be nil (leases are in-memory only).
+	housingStore HousingStore
+	// stashStore persists stash balances. May be nil (balances are in-memory only).
+	stashStore StashStore
+	// accountStash persists shared stash balances. May be nil (the shared stash is unavailable).
+	accountStash AccountStashStore
+	// clans tracks player clans and pending invitations. May be nil (clans are disabled).
+	clans *clan.Registry
+	// clanStore persists clans. May be nil (clans are in-memory only).
+	clanStore ClanStore
+	// auctions is the server-wide consignment house. May be nil (auctions are disabled).
+	auctions *auction.House
+	// auctionStore persists listings and escrowed claims. May be nil (auctions are in-memory only).
+	auctionStore AuctionStore
+	// delayed runs timed player actions such as lockpicking stages.
+	delayed *delayedActions
+	// achievements defines earnable achievements. May be nil (achievements are disabled).
+	achievements *achievement.Registry
+	// achievementStore persists achievement progress. May be nil (progress is in-memory only).
+	achievementStore AchievementStore
+	// achievementSaves queues achievement writes made with combatMu held.
+	achievementSaves saveQueue
+	// statsStore persists lifetime stats. May be nil (stats are not tracked).
+	statsStore StatsStore
+	// leaderboards caches the leaderboards computed by the last stats rollup.
+	leaderboards *stats.Board
+	// playSessionStore records login sessions. May be nil (total playtime is unavailable).
+	playSessionStore PlaySessionStore
+	// transcriptStore saves session transcripts. May be nil (logging is unavailable).
+	transcriptStore TranscriptStore
+	// reportStore persists player reports. May be nil (reports can't be filed).
+	reportStore ReportStore
+	// weaponPracticeStore persists weapon practice. May be nil (practice lasts for the session only).
+	weaponPracticeStore WeaponPracticeStore
+	// practiceSaves queues weapon practice writes made with combatMu held.
+	practiceSaves saveQueue
+	// pointBuyStore persists point-buy adjustments. May be nil (respecs last for the session only).
+	pointBuyStore PointBuyStore
+	// scheduleMu guards schedule and scheduleLastRun.
+	scheduleMu sync.Mutex
+	// schedule holds the scheduled server events; empty when none are configured.
+	schedule []*schedule.Event
+	// scheduleLastRun records, by event ID, when each scheduled event last fired.
+	scheduleLastRun map[string]time.Time
+	// zoneResetMu guards zoneResets.
+	zoneResetMu sync.Mutex
+	// zoneResets holds the reset timer of each zone with a reset config.
+	zoneResets map[string]*zoneResetTimer
+	// raidMu guards raids and activeRaids.
+	raidMu sync.Mutex
+	// raids holds the raid definitions by ID; empty when none are configured.
+	raids map[string]*raid.Def
+	// activeRaids holds the raids under way, by raid ID.
+	activeRaids map[string]*activeRaid
+	// flood rate-limits each player's commands. Nil disables flood protection.
+	flood *floodGuard
+	// siteBans is the site allow/deny list managed by the siteban command; nil when unavailable.
+	siteBans *siteban.List
+	// vehicles tracks cars and boats and who is aboard. May be nil (vehicles are disabled).
+	vehicles *vehicle.Manager
+	// socialMu guards socialEncounters.
+	socialMu sync.Mutex
+	// socialEncounters holds each player's social encounter under way, by UID.
+	socialEncounters map[string]*social.Encounter
+	// soundMu guards lastNoise and lastShout.
+	soundMu sync.Mutex
+	// lastNoise records when each room last made each noise, keyed by room ID and noise, so
+	// repeats within noiseCooldown are not re-reported to neighbouring rooms.
+	lastNoise map[string]time.Time
+	// lastShout records when each player, keyed by UID, last shouted or yelled.
+	lastShout map[string]time.Time
+	// ambientMu guards ambientInterval and nextAmbient.
+	ambientMu sync.Mutex
+	// ambientInterval is the shortest time between ambient messages in one room; zero disables them.
+	ambientInterval time.Duration
+	// nextAmbient records, by room ID, the earliest time the room may show its next ambient message.
+	nextAmbient map[string]time.Time
 	// bankerRuntimeStates maps NPC instance ID to active banker runtime state.
 	bankerRuntimeStates map[string]*npc.BankerRuntimeState
 	// healerRuntimeStates maps NPC instance ID to active healer runtime state.
@@ -336,9 +420,42 @@ type GameServiceServer struct {
 	// downtimeQueueRepo manages the per-character downtime activity queue.
 	// May be nil (queue features are disabled if not set).
 	downtimeQueueRepo DowntimeQueueRepo
+	// combatVerbosityRepo persists each character's combat narration level.
+	// May be nil (the setting lasts only for the session if not set).
+	combatVerbosityRepo CombatVerbositySaver
+	// accountLocales loads and persists each account's message locale.
+	// May be nil (players use the default locale for the session only if not set).
+	accountLocales AccountLocaleStore
+	// adminAudit persists privileged commands. May be nil (actions are only logged).
+	adminAudit AdminAuditStore
+	// accountSettings persists account-level preferences. May be nil (changes last for the session only).
+	accountSettings AccountSettingsSaver
+	// characterRenamer renames characters. May be nil (rename is unavailable).
+	characterRenamer CharacterRenamer
+	// characterTransferer moves characters between accounts. May be nil (transfer is unavailable).
+	characterTransferer CharacterTransferer
+	// characterSlots holds the per-account character limits transfers respect.
+	characterSlots config.CharactersConfig
+	// characterExporter snapshots characters as JSON. May be nil (exportchar is unavailable).
+	characterExporter CharacterExporter
+	// accountSlots persists character limit overrides. May be nil (charslots is unavailable).
+	accountSlots AccountSlotsStore
+	// permissions maps roles to capabilities for privileged commands.
+	// A nil matrix applies the embedded defaults.
+	permissions *permission.Matrix
+	// catalog renders player-facing narratives by message ID and locale.
+	// A nil catalog renders the embedded English defaults.
+	catalog *i18n.Catalog
 	// downtimeQueueLimitReg holds per-tier/per-level queue slot limits.
 	// May be nil (queue limit lookups are skipped if not set).
 	downtimeQueueLimitReg *downtime.DowntimeQueueLimitRegistry
+	// backgrounds resolves creation questionnaire answers into starting grants.
+	// May be nil (background grants are skipped).
+	backgrounds *ruleset.Backgrounds
+	// tutorialDef is the first-login tutorial. May be nil (tutorial is skipped).
+	tutorialDef *tutorial.Definition
+	// tutorialStore persists tutorial completion. May be nil (completion is not persisted).
+	tutorialStore TutorialStore
 	// drawbackEngine evaluates situational drawback triggers and applies conditions (REQ-JD-10).
 	drawbackEngine *drawback.Engine
 	// characterJobsRepo persists the set of all jobs held by each character (REQ-JD-4).
@@ -368,6 +485,20 @@ type GameServiceServer struct {
 	// the deadline_unix_ms sent to clients when non-zero; default
 	// config.DefaultReactionPromptTimeout applies at Set time.
 	reactionPromptTimeout time.Duration
+	// commandTimeout bounds each player command and the work done outside
+	// commands on a player's behalf; 0 disables it. See SetTimeouts.
+	commandTimeout time.Duration
+	// inflight holds the context of the command each player is running.
+	inflight commandContexts
+	// reload re-reads the config file for the reloadconfig command and
+	// SIGHUP. See SetConfigReload.
+	reload configReload
+	// featureFlags is the feature flag set the flag command edits; nil
+	// disables the command.
+	featureFlags *flags.Set
+	// telemetry exports game events for economy and balance analysis; nil
+	// exports nothing. See SetTelemetry.
+	telemetry *telemetry.Emitter
 }
 
 // applyArmorTrainingProficiency applies the armor_training feat choice as a real proficiency
@@ -524,12 +655,13 @@ func NewGameServiceServer(
 		charAbilityBoostsRepo:      storage.AbilityBoostsRepo,
 		archetypes:                 content.ArchetypeMap,
 		regions:                    content.RegionMap,
+		heritages:                  content.HeritageMap,
 		mentalStateMgr:             content.MentalStateMgr,
 		actionH:                    handlers.ActionHandler,
 		wantedRepo:                 storage.WantedRepo,
 		detainedUntilRepo:          storage.DetainedUntilRepo,
-		trapMgr:                    nil, // trap loading not yet wired
-		trapTemplates:              nil, // trap loading not yet wired
+		trapMgr:                    nil, // set by InitTraps
+		trapTemplates:              nil, // set by InitTraps
 		setRegistry:                content.SetRegistry,
 		substanceReg:               content.SubstanceRegistry,
 		factionRepRepo:             storage.FactionRepRepo,
@@ -541,6 +673,7 @@ func NewGameServiceServer(
 		seduceConditions:           make(map[string]*condition.ActiveSet),
 		reactionPromptHub:          newReactionPromptHub(),
 		reactionPromptTimeout:      config.DefaultReactionPromptTimeout,
+		commandTimeout:             defaultCommandTimeout,
 	}
 	if content.FactionRegistry != nil {
 		s.factionRegistry = content.FactionRegistry
@@ -583,9 +716,92 @@ func NewGameServiceServer(
 	if storage.DowntimeQueueRepo != nil {
 		s.downtimeQueueRepo = storage.DowntimeQueueRepo
 	}
+	if storage.CombatVerbosityRepo != nil {
+		s.combatVerbosityRepo = storage.CombatVerbosityRepo
+	}
+	if storage.AccountLocaleRepo != nil {
+		s.accountLocales = storage.AccountLocaleRepo
+	}
+	if storage.AdminAuditRepo != nil {
+		s.adminAudit = storage.AdminAuditRepo
+	}
+	if storage.AccountSettingsRepo != nil {
+		s.accountSettings = storage.AccountSettingsRepo
+	}
+	if storage.CharacterRenamer != nil {
+		s.characterRenamer = storage.CharacterRenamer
+	}
+	if storage.CharacterTransferer != nil {
+		s.characterTransferer = storage.CharacterTransferer
+	}
+	if storage.CharacterExporter != nil {
+		s.characterExporter = storage.CharacterExporter
+	}
+	if storage.AccountSlotsRepo != nil {
+		s.accountSlots = storage.AccountSlotsRepo
+	}
 	if content.DowntimeQueueLimitRegistry != nil {
 		s.downtimeQueueLimitReg = content.DowntimeQueueLimitRegistry
 	}
+	s.backgrounds = content.Backgrounds
+	s.tutorialDef = content.Tutorial
+	if storage.TutorialRepo != nil {
+		s.tutorialStore = storage.TutorialRepo
+	}
+	if storage.MerchantStateRepo != nil {
+		s.merchantStateStore = storage.MerchantStateRepo
+	}
+	s.housing = housing.NewRegistry()
+	if s.worldH != nil {
+		s.worldH.SetHousing(s.housing)
+	}
+	if storage.HousingRepo != nil {
+		s.housingStore = storage.HousingRepo
+	}
+	if storage.StashRepo != nil {
+		s.stashStore = storage.StashRepo
+	}
+	if storage.AccountStashRepo != nil {
+		s.accountStash = storage.AccountStashRepo
+	}
+	s.clans = clan.NewRegistry()
+	if s.worldH != nil {
+		s.worldH.SetClans(s.clans)
+	}
+	if s.chatH != nil {
+		s.chatH.SetClans(s.clans)
+	}
+	if storage.ClanRepo != nil {
+		s.clanStore = storage.ClanRepo
+	}
+	s.auctions = auction.NewHouse()
+	s.delayed = newDelayedActions()
+	if storage.AuctionRepo != nil {
+		s.auctionStore = storage.AuctionRepo
+	}
+	s.achievements = content.Achievements
+	if storage.AchievementRepo != nil {
+		s.achievementStore = storage.AchievementRepo
+	}
+	s.leaderboards = stats.NewBoard()
+	if storage.StatsRepo != nil {
+		s.statsStore = storage.StatsRepo
+	}
+	if storage.PlaySessionRepo != nil {
+		s.playSessionStore = storage.PlaySessionRepo
+	}
+	if storage.TranscriptRepo != nil {
+		s.transcriptStore = storage.TranscriptRepo
+	}
+	if storage.ReportRepo != nil {
+		s.reportStore = storage.ReportRepo
+	}
+	if storage.WeaponPracticeRepo != nil {
+		s.weaponPracticeStore = storage.WeaponPracticeRepo
+	}
+	if storage.PointBuyRepo != nil {
+		s.pointBuyStore = storage.PointBuyRepo
+	}
 	// gameHourFn defaults to reading from calendar if available. REQ-NB-16.
 	s.gameHourFn = func() int {
 		if s.calendar != nil {
@@ -613,6 +829,7 @@ func NewGameServiceServer(
 			// Push updated room view so "fighting X" labels clear immediately.
 			s.pushRoomViewToAllInRoom(roomID)
 		})
+		s.combatH.SetOnForcedMove(s.resolveForcedMove)
 		s.combatH.SetRoundStartBroadcastFn(func(roomID string, evt *gamev1.RoundStartEvent) {
 			s.broadcastToRoom(roomID, "", &gamev1.ServerEvent{
 				Payload: &gamev1.ServerEvent_RoundStart{RoundStart: evt},
@@ -623,6 +840,11 @@ func NewGameServiceServer(
 				Payload: &gamev1.ServerEvent_ApUpdate{ApUpdate: evt},
 			})
 		})
+		s.combatH.SetCombatStateBroadcastFn(func(roomID string, evt *gamev1.CombatStateEvent) {
+			s.broadcastToRoom(roomID, "", &gamev1.ServerEvent{
+				Payload: &gamev1.ServerEvent_CombatState{CombatState: evt},
+			})
+		})
 		s.worldH.SetCombatHandler(s.combatH)
 		// REQ-AH-21: wire substance service for poison-on-hit.
 		s.combatH.SetSubstanceSvc(s)
@@ -639,11 +861,17 @@ func NewGameServiceServer(
 		// Wire pushQuestLogView so the quest drawer auto-refreshes when a kill quest completes.
 		s.combatH.SetPushQuestLogFn(s.pushQuestLogView)
 		// REQ-BUG99-1: wire applyLevelUpTechGrants so organic XP level-ups issue trainer quests.
-		s.combatH.SetOnLevelUpFn(s.applyLevelUpTechGrants)
+		s.combatH.SetOnLevelUpFn(s.onLevelUp)
 		// REQ-BUG92-1: wire pushInventory so the web UI Inventory tab updates after currency award.
 		s.combatH.SetPushInventoryFn(s.pushInventory)
 		// REQ-BUG96-1: wire saveInventory so looted items are persisted immediately.
 		s.combatH.SetSaveInventoryFn(s.saveInventory)
+		// Loot granted by combat completes the tutorial's loot objective. The tracker
+		// is advanced off the combat goroutine because finishing the tutorial
+		// removes the player from combat, which takes combatMu.
+		s.combatH.SetOnItemsLootedFn(func(uid string) {
+			go s.advanceTutorial(uid, tutorial.KindLoot)
+		})
 		// Wire tech use resolver so ActionUseTech round events apply effects post-round.
 		// cbt is passed directly from resolveAndAdvanceLocked to avoid re-acquiring combatMu
 		// inside the callback (which would deadlock since combatMu is already held at call time).
@@ -659,8 +887,11 @@ func NewGameServiceServer(
 			}
 			s.pushEventToUID(uid, evt)
 		})
+		// Job abilities queued with the ability command resolve the same way.
+		s.combatH.SetAbilityUseResolverFn(s.resolveJobAbility)
 	}
 	s.merchantRuntimeStates = make(map[string]*npc.MerchantRuntimeState)
+	s.market = economy.NewMarket(economy.DefaultConfig())
 	s.bankerRuntimeStates = make(map[string]*npc.BankerRuntimeState)
 	s.healerRuntimeStates = make(map[string]*npc.HealerRuntimeState)
 	s.hirelingRuntimeStates = make(map[string]*npc.HirelingRuntimeState)
@@ -668,6 +899,7 @@ func NewGameServiceServer(
 	s.WireConsumableTrapTrigger()
 	s.wireRevealZone()
 	s.wireScriptMgrCombatCallbacks()
+	s.wireScriptMgrPlayerCallbacks()
 	// Initialize drawback engine for situational trigger evaluation (REQ-JD-10).
 	s.drawbackEngine = drawback.NewEngine(s.condRegistry)
 	// REQ-JD-10: Wire on_take_damage_in_one_hit_above_threshold drawback trigger into CombatHandler.
@@ -684,11 +916,20 @@ func NewGameServiceServer(
 			s.drawbackEngine.FireTrigger(uid, drawback.TriggerOnTakeDamageInOneHitAboveThreshold, heldJobs, playerSess.Conditions, time.Now())
 		})
 		s.combatH.SetOnNPCDamageTaken(s.pauseRovingOnCombat)
+		s.combatH.SetSoundFn(s.emitSound)
+		s.combatH.SetOnNPCKilledFn(s.onNPCKilled)
+		s.combatH.SetOnCurrencyChangedFn(s.emitCurrency)
+		s.combatH.SetOnDamageDealtFn(func(sess *session.PlayerSession, amount int) {
+			s.tallyStat(sess, stats.Damage, int64(amount))
+		})
+		s.combatH.SetOnWeaponPracticeFn(s.practiceWeapon)
 		s.combatH.SetOnNPCDeath(func(instID string) {
 			if s.rovingMgr != nil {
 				s.rovingMgr.Unregister(instID)
 			}
+			s.releaseDeadHireling(instID)
 		})
+		s.combatH.SetHiredHirelingOf(s.findHiredHireling)
 		// REQ-ZN-9: wire seduceConditions so CombatHandler can process charmed saves at round end.
 		s.combatH.SetSeduceConditions(s.seduceConditions)
 	}
@@ -950,9 +1191,19 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 	}
 	genderVal := ""
 	teamVal := ""
+	combatVerbosity := ""
+	var accountID int64
 	if dbChar != nil {
 		genderVal = dbChar.Gender
 		teamVal = dbChar.Team
+		combatVerbosity = dbChar.CombatVerbosity
+		accountID = dbChar.AccountID
+	}
+	locale := s.loadAccountLocale(stream.Context(), accountID)
+	// Account-level settings follow the player across characters and frontends.
+	accountSettings := settings.FromProto(joinReq.GetSettings())
+	if isValidCombatVerbosity(accountSettings.CombatVerbosity) {
+		combatVerbosity = accountSettings.CombatVerbosity
 	}
 
 	// Team territory enforcement: redirect spawn to home room if saved location is in enemy territory (REQ-TEAM-3).
@@ -974,6 +1225,13 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 		}
 	}
 
+	// Characters that have not finished the tutorial start in it; the tutorial
+	// sends them on to the room they would otherwise have spawned in.
+	tutorialRoom, tutorialReturn, inTutorial := s.tutorialSpawn(dbChar, spawnRoom, teamVal)
+	if inTutorial {
+		spawnRoom = tutorialRoom
+	}
+
 	sess, err := s.sessions.AddPlayer(session.AddPlayerOptions{
 		UID:                 uid,
 		Username:            username,
@@ -988,6 +1246,10 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 		Class:               joinReq.Class,
 		Level:               int(joinReq.Level),
 		DefaultCombatAction: defaultCombatAction,
+		CombatVerbosity:     combatVerbosity,
+		AccountID:           accountID,
+		Locale:              locale,
+		Settings:            accountSettings,
 		Gender:              genderVal,
 		Team:                teamVal,
 	})
@@ -998,6 +1260,9 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 	// against stale cleanup after a rapid reconnect evicts this session.
 	myEntity := sess.Entity
 	defer func() { s.cleanupPlayer(uid, username, myEntity) }()
+	if inTutorial {
+		sess.Tutorial = tutorial.NewTracker(s.tutorialDef, tutorialReturn)
+	}
 
 	// Restore combat status if the player reconnected mid-combat.
 	// AddPlayer always initialises Status=1 (idle); if an active combat in this room
@@ -1025,7 +1290,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 						RoundStart: &gamev1.RoundStartEvent{
 							Round:            int32(cbt.Round),
 							ActionsPerTurn:   3,
-							DurationMs:       int32(s.combatH.roundDuration.Milliseconds()),
+							DurationMs:       int32(s.combatH.RoundDuration().Milliseconds()),
 							TurnOrder:        turnOrder,
 							InitialPositions: positions,
 							Terrain:          terrainToProto(cbt.Terrain),
@@ -1038,6 +1303,8 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 
 	// Propagate headless flag from the join request; used to skip interactive prompts.
 	sess.Headless = joinReq.Headless
+	// Remember where the player connected from so site bans can disconnect them.
+	sess.RemoteAddr = siteban.ClientAddr(stream.Context())
 
 	// Set home region ID for Honkeypot targeting.
 	if dbChar != nil {
@@ -1171,7 +1438,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 
 	// Load persisted equipment state if charSaver supports it.
 	if characterID > 0 && s.charSaver != nil {
-		loadCtx, loadCancel := context.WithTimeout(stream.Context(), 5*time.Second)
+		loadCtx, loadCancel := s.withCommandTimeout(stream.Context())
 		ls, lsErr := s.charSaver.LoadWeaponPresets(loadCtx, characterID, s.invRegistry)
 		loadCancel()
 		if lsErr != nil {
@@ -1185,7 +1452,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 			sess.LoadoutSet = ls
 		}
 
-		loadCtx2, loadCancel2 := context.WithTimeout(stream.Context(), 5*time.Second)
+		loadCtx2, loadCancel2 := s.withCommandTimeout(stream.Context())
 		eq, eqErr := s.charSaver.LoadEquipment(loadCtx2, characterID)
 		loadCancel2()
 		if eqErr != nil {
@@ -1208,6 +1475,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 			loginDef := sess.Equipment.ComputedDefensesWithProficienciesAndSetBonuses(s.invRegistry, loginDexMod, sess.Proficiencies, sess.Level, sess.SetBonusSummary)
 			sess.Resistances = loginDef.Resistances
 			sess.Weaknesses = loginDef.Weaknesses
+			sess.Immunities = loginDef.Immunities
 		}
 
 		// Compute passive material bonuses from equipped items at login.
@@ -1229,7 +1497,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 				)
 			} else {
 				for _, it := range invItems {
-					if _, addErr := sess.Backpack.Add(it.ItemDefID, it.Quantity, s.invRegistry); addErr != nil {
+					if _, addErr := sess.Backpack.AddWithMetadata(it.ItemDefID, it.Quantity, it.Metadata, s.invRegistry); addErr != nil {
 						s.logger.Warn("failed to restore inventory item",
 							zap.String("item", it.ItemDefID),
 							zap.Error(addErr),
@@ -1265,6 +1533,24 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 			} else {
 				sess.Currency = savedCurrency
 			}
+			// Load persisted stash balance.
+			if s.stashStore != nil {
+				if balance, stashErr := s.stashStore.LoadStashBalance(stream.Context(), characterID); stashErr != nil {
+					s.logger.Warn("failed to load stash balance on login",
+						zap.String("uid", uid),
+						zap.Int64("character_id", characterID),
+						zap.Error(stashErr),
+					)
+				} else {
+					sess.StashBalance = balance
+				}
+			}
+			// Load achievement progress and the displayed title.
+			s.loadAchievements(stream.Context(), sess)
+			// Start counting lifetime stats, including playtime.
+			s.startStatsTally(sess)
+			// Record the play session for the played command.
+			s.startPlaySession(stream.Context(), sess, dbChar)
 			// Hydrate quest state from DB (REQ-QU-14).
 			if s.questSvc != nil {
 				qRecords, qErr := s.questSvc.LoadQuests(stream.Context(), characterID)
@@ -1328,6 +1614,21 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 					}
 				}
 			}
+			// Apply background trait conditions chosen at creation.
+			var background ruleset.BackgroundGrants
+			if dbChar != nil {
+				background = s.backgrounds.Resolve(dbChar.BackgroundAnswers)
+			}
+			if sess.Conditions != nil {
+				for _, condID := range background.Conditions {
+					if sess.Conditions.Has(condID) {
+						continue
+					}
+					if def, ok := s.condRegistry.Get(condID); ok {
+						_ = sess.Conditions.ApplyTagged(uid, def, 1, -1, "background")
+					}
+				}
+			}
 			// Grant starting kit on first login.
 			if s.loadoutsDir != "" {
 				received, flagErr := s.charSaver.HasReceivedStartingInventory(stream.Context(), characterID)
@@ -1357,7 +1658,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 							jobOverride = job.StartingInventory
 						}
 					}
-					if grantErr := s.grantStartingInventory(stream.Context(), sess, characterID, archetype, team, jobOverride); grantErr != nil {
+					if grantErr := s.grantStartingInventory(stream.Context(), sess, characterID, archetype, team, jobOverride, background.Items); grantErr != nil {
 						s.logger.Error("failed to grant starting inventory",
 							zap.String("uid", uid),
 							zap.Int64("character_id", characterID),
@@ -1470,6 +1771,8 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 			}
 		}
 	}
+	s.loadWeaponPractice(stream.Context(), sess)
+	s.loadPointBuy(stream.Context(), sess)
 
 	// Initialize out-of-combat conditions set for this session.
 	sess.Conditions = condition.NewActiveSet()
@@ -1496,7 +1799,11 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 			}
 		}
 	}
-	// Populate passive feat cache from player feats (e.g. snap_shot).
+	// Populate passive feat cache from player feats (e.g. snap_shot), after
+	// granting the heritage trait's feat so it applies from the first login.
+	if characterID > 0 {
+		s.grantHeritageFeat(stream.Context(), characterID, dbChar)
+	}
 	if characterID > 0 && s.characterFeatsRepo != nil && s.featRegistry != nil {
 		pfIDs, pfErr := s.characterFeatsRepo.GetAll(stream.Context(), characterID)
 		if pfErr != nil {
@@ -1614,6 +1921,24 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 		}
 	}
 
+	// Introduce the tutorial and its first objective.
+	if sess.Tutorial != nil {
+		if intro := s.tutorialDef.Intro; intro != "" {
+			_ = stream.Send(messageEvent(intro))
+		}
+		_ = stream.Send(messageEvent(tutorialHint(sess.Tutorial)))
+	}
+
+	// Show the clan's message of the day.
+	if motd := s.clanMOTD(sess); motd != "" {
+		_ = stream.Send(messageEvent(motd))
+	}
+
+	// Show the player's title in the prompt.
+	if title := displayTitle(sess); title != "" {
+		_ = stream.Send(titleEvent(sess))
+	}
+
 	// Resolve any missing feature/feat choices interactively now that split-screen is active.
 	// promptFeatureChoice blocks on stream.Recv(); no entity/clock goroutines are running yet
 	// so there are no concurrent stream.Send calls during this phase.
@@ -1773,7 +2098,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 
 		// Recompute scores from scratch, apply all boosts, persist.
 		if dbChar != nil {
-			baseScores := recomputeBaseScores(dbChar, s.regions, s.jobRegistry)
+			baseScores := recomputeBaseScores(dbChar, s.regions, s.heritages, s.jobRegistry)
 			archetypeBoosts := (*ruleset.AbilityBoostGrant)(nil)
 			if archetypeID != "" {
 				if archetype, ok := s.archetypes[archetypeID]; ok {
@@ -1785,6 +2110,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 				regionBoosts = region.AbilityBoosts
 			}
 			newAbilities := character.ApplyAbilityBoosts(baseScores, archetypeBoosts, storedBoosts["archetype"], regionBoosts, storedBoosts["region"])
+			newAbilities = character.ApplyPointBuy(newAbilities, sess.PointBuy)
 			sess.Abilities = newAbilities
 			if saveErr := s.charSaver.SaveAbilities(stream.Context(), characterID, newAbilities); saveErr != nil {
 				s.logger.Warn("saving ability scores to DB", zap.Error(saveErr))
@@ -2121,7 +2447,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 	// Wrap the stream in a mutex-protected sender so that forwardEvents,
 	// the calendar goroutine, and commandLoop can all call Send concurrently
 	// without data races.  Recv and other stream methods are not affected.
-	ss := &syncStream{GameService_SessionServer: stream}
+	ss := &syncStream{GameService_SessionServer: stream, transcript: sess.Transcript, mirror: s.snoopMirror(uid)}
 
 	// REQ-RXN20: build and store the interactive reaction callback.
 	// Must use ss so that reaction prompts go through the mutex.
@@ -2179,6 +2505,11 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 					if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Conditions != nil {
 						sess.Conditions.TickCalendar(uid, time.Now())
 					}
+					if lightEvt := s.tickLightSource(uid); lightEvt != nil {
+						if err := ss.Send(lightEvt); err != nil {
+							return
+						}
+					}
 					// On period change, push an updated RoomView so the room
 					// display reflects the new time-of-day flavor text.
 					if period != lastPeriod {
@@ -2217,6 +2548,7 @@ func (s *GameServiceServer) Session(stream gamev1.GameService_SessionServer) err
 				}
 				s.tickSubstances(uid)     // REQ-AH-12
 				s.tickAmbientSubstances() // REQ-OCF-8
+				s.tickDeepWater(uid)
 				// REQ-DT-7: check all sessions with active downtime
 				for _, activeUID := range s.sessions.AllUIDs() {
 					s.checkDowntimeCompletion(activeUID)
@@ -2264,6 +2596,11 @@ func (s *GameServiceServer) commandLoop(ctx context.Context, uid string, stream
 			return fmt.Errorf("receiving message: %w", err)
 		}
 
+		// Any input other than afk itself brings an AFK player back.
+		if _, ok := msg.Payload.(*gamev1.ClientMessage_Afk); !ok {
+			s.clearAFK(uid)
+		}
+
 		// status is a personal query: all ConditionEvents are sent directly on the stream.
 		if _, ok := msg.Payload.(*gamev1.ClientMessage_Status); ok {
 			if err := s.handleStatus(uid, msg.RequestId, stream); err != nil {
@@ -2334,7 +2671,14 @@ func (s *GameServiceServer) commandLoop(ctx context.Context, uid string, stream
 			continue
 		}
 
-		resp, err := s.dispatch(uid, msg)
+		// The interactive payloads above wait on the player, so only
+		// dispatched commands run under the command deadline.
+		endCommand := s.beginCommand(ctx, uid)
+		resp, err := s.dispatchSafely(uid, msg)
+		endCommand()
+		s.auditAdminCommand(uid, msg, resp, err)
+		s.noteCommand(uid, msg)
+		s.mirrorCommand(uid, msg)
 		if err == errQuit {
 			// Send Disconnected event then exit cleanly.
 			if resp != nil {
@@ -2359,6 +2703,11 @@ func (s *GameServiceServer) commandLoop(ctx context.Context, uid string, stream
 
 		if resp != nil {
 			resp.RequestId = msg.RequestId
+			if ce, ok := resp.Payload.(*gamev1.ServerEvent_CombatEvent); ok {
+				if sess, ok := s.sessions.GetPlayer(uid); ok {
+					ce.CombatEvent = s.withCombatPerspective(sess, ce.CombatEvent)
+				}
+			}
 			if err := stream.Send(resp); err != nil {
 				return fmt.Errorf("sending response: %w", err)
 			}
@@ -2368,6 +2717,16 @@ func (s *GameServiceServer) commandLoop(ctx context.Context, uid string, stream
 
 // dispatch routes a ClientMessage to the appropriate handler.
 func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*gamev1.ServerEvent, error) {
+	// Flooded commands are dropped before they can reach round resolution.
+	if evt, handled, err := s.checkFlood(uid, msg); handled {
+		return evt, err
+	}
+
+	// Privileged commands are authorized here, before any handler runs.
+	if evt := s.authorize(uid, msg); evt != nil {
+		return evt, nil
+	}
+
 	// Check whether any in-flight detention has expired before processing the command.
 	if sess, ok := s.sessions.GetPlayer(uid); ok {
 		s.checkDetentionCompletion(sess)
@@ -2406,6 +2765,9 @@ func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*ga
 	case *gamev1.ClientMessage_Move:
 		return s.handleMove(uid, p.Move)
 	case *gamev1.ClientMessage_Look:
+		if dir := p.Look.GetDirection(); dir != "" {
+			return s.handleLookDirection(uid, dir)
+		}
 		return s.handleLook(uid)
 	case *gamev1.ClientMessage_Exits:
 		return s.handleExits(uid)
@@ -2413,6 +2775,10 @@ func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*ga
 		return s.handleSay(uid, p.Say)
 	case *gamev1.ClientMessage_Emote:
 		return s.handleEmote(uid, p.Emote)
+	case *gamev1.ClientMessage_Shout:
+		return s.handleShout(uid, p.Shout)
+	case *gamev1.ClientMessage_Yell:
+		return s.handleYell(uid, p.Yell)
 	case *gamev1.ClientMessage_Who:
 		return s.handleWho(uid)
 	case *gamev1.ClientMessage_Quit:
@@ -2492,7 +2858,7 @@ func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*ga
 	case *gamev1.ClientMessage_TakeCover:
 		return s.handleTakeCover(uid)
 	case *gamev1.ClientMessage_FirstAid:
-		return s.handleFirstAid(uid)
+		return s.handleFirstAid(uid, p.FirstAid)
 	case *gamev1.ClientMessage_Feint:
 		return s.handleFeint(uid, p.Feint)
 	case *gamev1.ClientMessage_Demoralize:
@@ -2535,6 +2901,58 @@ func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*ga
 		return s.handleLevelUp(uid, p.LevelUp.Ability)
 	case *gamev1.ClientMessage_CombatDefault:
 		return s.handleCombatDefault(uid, p.CombatDefault.Action)
+	case *gamev1.ClientMessage_CombatVerbosity:
+		return s.handleCombatVerbosity(uid, p.CombatVerbosity.GetLevel())
+	case *gamev1.ClientMessage_Locale:
+		return s.handleLocale(uid, p.Locale.GetLocale())
+	case *gamev1.ClientMessage_ChatMute:
+		return s.handleChatMute(uid, p.ChatMute)
+	case *gamev1.ClientMessage_Audit:
+		return s.handleAudit(uid, p.Audit)
+	case *gamev1.ClientMessage_Settings:
+		return s.handleSettings(uid, p.Settings)
+	case *gamev1.ClientMessage_Rename:
+		return s.handleRename(uid, p.Rename)
+	case *gamev1.ClientMessage_TransferCharacter:
+		return s.handleTransferCharacter(uid, p.TransferCharacter)
+	case *gamev1.ClientMessage_ExportCharacter:
+		return s.handleExportCharacter(uid, p.ExportCharacter)
+	case *gamev1.ClientMessage_CharacterSlots:
+		return s.handleCharacterSlots(uid, p.CharacterSlots)
+	case *gamev1.ClientMessage_Tutorial:
+		return s.handleTutorial(uid, p.Tutorial)
+	case *gamev1.ClientMessage_Home:
+		return s.handleHome(uid, p.Home)
+	case *gamev1.ClientMessage_Clan:
+		return s.handleClan(uid, p.Clan)
+	case *gamev1.ClientMessage_Achievements:
+		return s.handleAchievements(uid)
+	case *gamev1.ClientMessage_Title:
+		return s.handleTitle(uid, p.Title)
+	case *gamev1.ClientMessage_Top:
+		return s.handleTop(uid, p.Top)
+	case *gamev1.ClientMessage_Played:
+		return s.handlePlayed(uid)
+	case *gamev1.ClientMessage_Time:
+		return s.handleTime(uid)
+	case *gamev1.ClientMessage_Afk:
+		return s.handleAfk(uid, p.Afk)
+	case *gamev1.ClientMessage_SiteBan:
+		return s.handleSiteBan(uid, p.SiteBan)
+	case *gamev1.ClientMessage_Consider:
+		return s.handleConsider(uid, p.Consider)
+	case *gamev1.ClientMessage_Scan:
+		return s.handleScan(uid)
+	case *gamev1.ClientMessage_Light:
+		return s.handleLight(uid, p.Light)
+	case *gamev1.ClientMessage_Board:
+		return s.handleBoard(uid, p.Board)
+	case *gamev1.ClientMessage_Disembark:
+		return s.handleDisembark(uid, p.Disembark)
+	case *gamev1.ClientMessage_Drive:
+		return s.handleDrive(uid, p.Drive)
+	case *gamev1.ClientMessage_Refuel:
+		return s.handleRefuel(uid, p.Refuel)
 	case *gamev1.ClientMessage_TrainSkill:
 		return s.handleTrainSkill(uid, p.TrainSkill.SkillId)
 	case *gamev1.ClientMessage_Grant:
@@ -2583,6 +3001,28 @@ func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*ga
 		return s.handleStashDeposit(uid, p.StashDeposit)
 	case *gamev1.ClientMessage_StashWithdraw:
 		return s.handleStashWithdraw(uid, p.StashWithdraw)
+	case *gamev1.ClientMessage_SharedStash:
+		return s.handleSharedStash(uid, p.SharedStash)
+	case *gamev1.ClientMessage_Parley:
+		return s.handleParley(uid, p.Parley)
+	case *gamev1.ClientMessage_Social:
+		return s.handleSocial(uid, p.Social)
+	case *gamev1.ClientMessage_Restrain:
+		return s.handleRestrain(uid, p.Restrain)
+	case *gamev1.ClientMessage_Nonlethal:
+		return s.handleNonlethal(uid, p.Nonlethal)
+	case *gamev1.ClientMessage_Practice:
+		return s.handlePractice(uid, p.Practice)
+	case *gamev1.ClientMessage_Recap:
+		return s.handleRecap(uid, p.Recap)
+	case *gamev1.ClientMessage_Formation:
+		return s.handleFormation(uid, p.Formation)
+	case *gamev1.ClientMessage_Order:
+		return s.handleOrder(uid, p.Order)
+	case *gamev1.ClientMessage_JobAbility:
+		return s.handleJobAbility(uid, p.JobAbility)
+	case *gamev1.ClientMessage_Respec:
+		return s.handleRespec(uid, p.Respec)
 	case *gamev1.ClientMessage_StashBalance:
 		return s.handleStashBalance(uid, p.StashBalance)
 	case *gamev1.ClientMessage_Heal:
@@ -2619,6 +3059,46 @@ func (s *GameServiceServer) dispatch(uid string, msg *gamev1.ClientMessage) (*ga
 		return s.handleBribeConfirm(uid, p.BribeConfirmRequest)
 	case *gamev1.ClientMessage_SurrenderRequest:
 		return s.handleSurrender(uid, p.SurrenderRequest)
+	case *gamev1.ClientMessage_PayFine:
+		return s.handlePayFine(uid, p.PayFine)
+	case *gamev1.ClientMessage_Steal:
+		return s.handleSteal(uid, p.Steal)
+	case *gamev1.ClientMessage_Market:
+		return s.handleMarket(uid, p.Market)
+	case *gamev1.ClientMessage_Auction:
+		return s.handleAuction(uid, p.Auction)
+	case *gamev1.ClientMessage_Pick:
+		return s.handlePick(uid, p.Pick)
+	case *gamev1.ClientMessage_DetectTraps:
+		return s.handleDetectTraps(uid)
+	case *gamev1.ClientMessage_Log:
+		return s.handleLog(uid, p.Log)
+	case *gamev1.ClientMessage_Report:
+		return s.handleReport(uid, p.Report)
+	case *gamev1.ClientMessage_Reports:
+		return s.handleReports(uid, p.Reports)
+	case *gamev1.ClientMessage_Invis:
+		return s.handleInvis(uid, p.Invis)
+	case *gamev1.ClientMessage_Snoop:
+		return s.handleSnoop(uid, p.Snoop)
+	case *gamev1.ClientMessage_Force:
+		return s.handleForce(uid, p.Force)
+	case *gamev1.ClientMessage_Puppet:
+		return s.handlePuppet(uid, p.Puppet)
+	case *gamev1.ClientMessage_Gm:
+		return s.handleGM(uid, p.Gm)
+	case *gamev1.ClientMessage_Schedule:
+		return s.handleSchedule(uid, p.Schedule)
+	case *gamev1.ClientMessage_Engrave:
+		return s.handleEngrave(uid, p.Engrave)
+	case *gamev1.ClientMessage_Dig:
+		return s.handleDig(uid, p.Dig)
+	case *gamev1.ClientMessage_Answer:
+		return s.handleAnswer(uid, p.Answer)
+	case *gamev1.ClientMessage_ReloadConfig:
+		return s.handleReloadConfig(uid, p.ReloadConfig)
+	case *gamev1.ClientMessage_Flag:
+		return s.handleFlag(uid, p.Flag)
 	case *gamev1.ClientMessage_ReleaseRequest:
 		return s.handleRelease(uid, p.ReleaseRequest)
 	case *gamev1.ClientMessage_SpawnNpc:
@@ -2717,23 +3197,25 @@ func isPureDigitDirection(dir string) bool {
 
 func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*gamev1.ServerEvent, error) {
 	dir := world.Direction(req.Direction)
+	// msgSess selects the locale for player-facing messages; nil renders the default locale.
+	msgSess, _ := s.sessions.GetPlayer(uid)
 
 	// #360: a pure-digit input is a menu-option pick that leaked through the
 	// client's room-input fallthrough. Rejecting it here keeps the player out
 	// of a confusing "no exit" loop and surfaces the actual cause regardless
 	// of which menu (level-up tech, feat choice, hotbar number, etc.) is open.
 	if isPureDigitDirection(string(dir)) {
-		return messageEvent("Numbers select menu options, not movement directions. Finish your pending selection first, or type a direction (n/s/e/w/...)."), nil
+		return messageEvent(s.t(msgSess, "move.numeric_direction", nil)), nil
 	}
 
 	// REQ-MV-1: movement is blocked while the player is in combat.
 	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Status == statusInCombat {
-		return messageEvent("You cannot move while in combat."), nil
+		return messageEvent(s.t(sess, "move.in_combat", nil)), nil
 	}
 
 	// REQ-DT-5: movement is blocked while a downtime activity is active.
 	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.DowntimeBusy {
-		return messageEvent("You are busy with a downtime activity. Use 'downtime cancel' to stop."), nil
+		return messageEvent(s.t(sess, "move.busy_downtime", nil)), nil
 	}
 
 	// REQ-REST-16: cancel camping if player moves voluntarily.
@@ -2748,13 +3230,19 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 		s.pushMessageToUID(uid, "You stop refocusing.")
 	}
 
+	// Walking away abandons a lock in progress.
+	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Lockpick != nil {
+		s.stopLockpick(uid, sess)
+		s.pushMessageToUID(uid, "You leave the lock half-picked.")
+	}
+
 	// IMMOBILIZED: grabbed condition prevents leaving the room.
 	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Conditions != nil {
 		if condition.IsActionRestricted(sess.Conditions, "move") {
-			return errorEvent("You are grabbed and cannot move!"), nil
+			return errorEvent(s.t(sess, "move.grabbed", nil)), nil
 		}
 		if condition.IsMovementPrevented(sess.Conditions) {
-			return errorEvent("You are detained and cannot move."), nil
+			return errorEvent(s.t(sess, "move.detained", nil)), nil
 		}
 	}
 
@@ -2766,7 +3254,7 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 					if zone, zoneOK := s.world.GetZone(destRoom.ZoneID); zoneOK {
 						if !s.factionSvc.CanEnterRoom(moveSess, destRoom, zone) {
 							tierLabel, factionName := s.factionSvc.MinTierLabelForRoom(destRoom, zone)
-							return messageEvent(fmt.Sprintf("Only %s members of %s may enter here.", tierLabel, factionName)), nil
+							return messageEvent(s.t(moveSess, "move.faction_gated", i18n.Args{"tier": tierLabel, "faction": factionName})), nil
 						}
 					}
 				}
@@ -2774,11 +3262,59 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 		}
 	}
 
+	// Reputation gates on the exit and the room beyond it.
+	if repSess, repSessOK := s.sessions.GetPlayer(uid); repSessOK {
+		if msg := s.moveRepRefusal(repSess, dir); msg != "" {
+			return messageEvent(msg), nil
+		}
+	}
+
+	// Rented rooms are locked to everyone but the tenant.
+	if homeSess, homeSessOK := s.sessions.GetPlayer(uid); homeSessOK {
+		if destRoom, navErr := s.world.Navigate(homeSess.RoomID, dir); navErr == nil {
+			if !s.canEnterHome(homeSess, destRoom.ID) {
+				return messageEvent("The door is locked."), nil
+			}
+		}
+	}
+
 	// Team territory enforcement: block movement into enemy zones (REQ-TEAM-1).
 	if teamSess, teamSessOK := s.sessions.GetPlayer(uid); teamSessOK && teamSess.Team != "" {
 		if destRoom, navErr := s.world.Navigate(teamSess.RoomID, dir); navErr == nil {
 			if isEnemyZone(teamSess.Team, destRoom.ZoneID) {
-				return messageEvent("Enemy territory — that zone is closed to you."), nil
+				return messageEvent(s.t(teamSess, "move.enemy_territory", nil)), nil
+			}
+		}
+	}
+
+	// Players aboard a vehicle travel with it; walking off means disembarking first.
+	if s.vehicles != nil {
+		if v, aboard := s.vehicles.Aboard(uid); aboard {
+			return messageEvent(fmt.Sprintf("You're aboard the %s. Use drive, or disembark first.", v.Name)), nil
+		}
+	}
+
+	// Hard terrain (water, rubble, walls) slows movement and may need a muscle check.
+	var terrainRule world.TerrainRule
+	var terrainNote string
+	if terrSess, ok := s.sessions.GetPlayer(uid); ok {
+		var blocked bool
+		terrainRule, terrainNote, blocked = s.checkTerrainEntry(uid, terrSess, dir)
+		if blocked {
+			return messageEvent(terrainNote), nil
+		}
+	}
+
+	// Door traps go off as the player steps through.
+	if trapSess, ok := s.sessions.GetPlayer(uid); ok {
+		if fromRoom, roomOK := s.world.GetRoom(trapSess.RoomID); roomOK {
+			if exit, exitOK := fromRoom.ExitForDirection(dir); exitOK && !exit.Locked && s.checkExitTrap(uid, trapSess, dir) {
+				if trapSess.Dead {
+					return messageEvent("You never make it through."), nil
+				}
+				if trapSess.Conditions != nil && condition.IsActionRestricted(trapSess.Conditions, "move") {
+					return errorEvent(s.t(trapSess, "move.grabbed", nil)), nil
+				}
 			}
 		}
 	}
@@ -2792,6 +3328,13 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 	if !ok {
 		return nil, fmt.Errorf("player %q session not found after move", uid)
 	}
+	if terrainRule.MoveDelay > 0 {
+		sess.MoveReadyAt = time.Now().Add(terrainRule.MoveDelay)
+	}
+	if terrainNote != "" {
+		s.pushMessageToUID(uid, terrainNote)
+	}
+	s.advanceTutorial(uid, tutorial.KindMove)
 
 	// Broadcast departure from old room
 	s.broadcastRoomEvent(result.OldRoomID, uid, &gamev1.RoomEvent{
@@ -2841,6 +3384,7 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 			newZoneID = newRoom.ZoneID
 		}
 		s.moveHirelingWithPlayer(uid, result.View.RoomId, oldZoneID, newZoneID)
+		s.movePrisonersWithPlayer(uid, result.OldRoomID, result.View.RoomId)
 	}
 
 	// Fire on_enter skill check triggers for the new room.
@@ -2940,12 +3484,14 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 		if !sess.ExploredCache[zID][newRoom.ID] {
 			sess.ExploredCache[zID][newRoom.ID] = true
 			if s.automapRepo != nil {
-				if err := s.automapRepo.Insert(context.Background(), sess.CharacterID, zID, newRoom.ID, true); err != nil {
+				if err := s.automapRepo.Insert(s.commandCtx(uid), sess.CharacterID, zID, newRoom.ID, true); err != nil {
 					s.logger.Warn("persisting exploration flag", zap.Error(err))
 				}
 			}
+			s.tallyStat(sess, stats.Explored, 1)
+			s.recordAchievement(sess, achievement.Event{Kind: achievement.KindExplore, Target: zID})
 			if s.questSvc != nil {
-				if exploreMsgs, exploreErr := s.questSvc.RecordExplore(context.Background(), sess, sess.CharacterID, newRoom.ID); exploreErr == nil {
+				if exploreMsgs, exploreErr := s.questSvc.RecordExplore(s.commandCtx(uid), sess, sess.CharacterID, newRoom.ID); exploreErr == nil {
 					for _, em := range exploreMsgs {
 						s.pushMessageToUID(uid, em)
 					}
@@ -2959,7 +3505,7 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 			// Award room discovery XP on first physical entry.
 			if s.xpSvc != nil {
 				oldLevel := sess.Level
-				if xpMsgs, xpErr := s.xpSvc.AwardRoomDiscovery(context.Background(), sess, sess.CharacterID); xpErr != nil {
+				if xpMsgs, xpErr := s.xpSvc.AwardRoomDiscovery(s.commandCtx(uid), sess, sess.CharacterID); xpErr != nil {
 					s.logger.Warn("awarding room discovery XP", zap.String("uid", uid), zap.Error(xpErr))
 				} else {
 					for _, msg := range xpMsgs {
@@ -2983,7 +3529,7 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 						// REQ-BUG99-3: apply tech grants for organic level-ups from room discovery XP.
 						// Guard on actual level change: AwardRoomDiscovery always returns >= 1 message.
 						if sess.Level > oldLevel {
-							s.applyLevelUpTechGrants(context.Background(), sess, oldLevel, sess.Level)
+							s.onLevelUp(s.commandCtx(uid), sess, oldLevel, sess.Level)
 						}
 					}
 				}
@@ -2993,7 +3539,7 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 		if !sess.AutomapCache[zID][newRoom.ID] {
 			sess.AutomapCache[zID][newRoom.ID] = true
 			if s.automapRepo != nil {
-				if err := s.automapRepo.Insert(context.Background(), sess.CharacterID, zID, newRoom.ID, false); err != nil {
+				if err := s.automapRepo.Insert(s.commandCtx(uid), sess.CharacterID, zID, newRoom.ID, false); err != nil {
 					s.logger.Warn("persisting map discovery", zap.Error(err))
 				}
 			}
@@ -3114,6 +3660,9 @@ func (s *GameServiceServer) handleMove(uid string, req *gamev1.MoveRequest) (*ga
 		}
 	}
 
+	// Lingering area effects of a fight here hit the player on the way in.
+	s.exposeToRoomEffects(uid, sess)
+
 	// Combat join trigger — prompt the player if entering a room with active combat.
 	s.notifyCombatJoinIfEligible(sess, result.View.RoomId)
 
@@ -3286,7 +3835,7 @@ func (s *GameServiceServer) applyRoomSkillChecks(uid string, room *world.Room) [
 		if s.xpSvc != nil && (result.Outcome == skillcheck.CritSuccess || result.Outcome == skillcheck.Success) {
 			isCrit := result.Outcome == skillcheck.CritSuccess
 			oldLevel := sess.Level
-			if xpMsgs, xpErr := s.xpSvc.AwardSkillCheck(context.Background(), sess, trigger.Skill, trigger.DC, isCrit, sess.CharacterID); xpErr != nil {
+			if xpMsgs, xpErr := s.xpSvc.AwardSkillCheck(s.commandCtx(uid), sess, trigger.Skill, trigger.DC, isCrit, sess.CharacterID); xpErr != nil {
 				s.logger.Warn("awarding skill check XP", zap.String("uid", uid), zap.Error(xpErr))
 			} else {
 				msgs = append(msgs, xpMsgs...)
@@ -3296,7 +3845,7 @@ func (s *GameServiceServer) applyRoomSkillChecks(uid string, room *world.Room) [
 					// REQ-BUG99-4: apply tech grants for organic level-ups from skill check XP.
 					// Guard on actual level change: AwardSkillCheck always returns >= 1 message.
 					if sess.Level > oldLevel {
-						s.applyLevelUpTechGrants(context.Background(), sess, oldLevel, sess.Level)
+						s.onLevelUp(s.commandCtx(uid), sess, oldLevel, sess.Level)
 					}
 				}
 			}
@@ -3437,7 +3986,7 @@ func (s *GameServiceServer) applyNPCSkillChecks(uid string, roomID string) []str
 			if s.xpSvc != nil && (result.Outcome == skillcheck.CritSuccess || result.Outcome == skillcheck.Success) {
 				isCrit := result.Outcome == skillcheck.CritSuccess
 				oldLevel := sess.Level
-				if xpMsgs, xpErr := s.xpSvc.AwardSkillCheck(context.Background(), sess, trigger.Skill, trigger.DC, isCrit, sess.CharacterID); xpErr != nil {
+				if xpMsgs, xpErr := s.xpSvc.AwardSkillCheck(s.commandCtx(uid), sess, trigger.Skill, trigger.DC, isCrit, sess.CharacterID); xpErr != nil {
 					s.logger.Warn("awarding NPC skill check XP", zap.String("uid", uid), zap.Error(xpErr))
 				} else {
 					msgs = append(msgs, xpMsgs...)
@@ -3447,7 +3996,7 @@ func (s *GameServiceServer) applyNPCSkillChecks(uid string, roomID string) []str
 						// REQ-BUG99-5: apply tech grants for organic level-ups from NPC skill check XP.
 						// Guard on actual level change: AwardSkillCheck always returns >= 1 message.
 						if sess.Level > oldLevel {
-							s.applyLevelUpTechGrants(context.Background(), sess, oldLevel, sess.Level)
+							s.onLevelUp(s.commandCtx(uid), sess, oldLevel, sess.Level)
 						}
 					}
 				}
@@ -3487,9 +4036,9 @@ func (s *GameServiceServer) handleLook(uid string) (*gamev1.ServerEvent, error)
 	//
 	// Precondition: view is a fully constructed RoomView from worldH.Look.
 	// Postcondition: view.FloorItems contains all items on the floor of the
-	// player's current room; item names are resolved from the registry when
+	// player's current room unless the room is dark; item names are resolved from the registry when
 	// available, falling back to the raw ItemDefID.
-	if sess, ok := s.sessions.GetPlayer(uid); ok && s.floorMgr != nil {
+	if sess, ok := s.sessions.GetPlayer(uid); ok && s.floorMgr != nil && !view.Dark {
 		floorItems := s.floorMgr.ItemsInRoom(sess.RoomID)
 		for _, fi := range floorItems {
 			name := fi.ItemDefID
@@ -3506,11 +4055,29 @@ func (s *GameServiceServer) handleLook(uid string) (*gamev1.ServerEvent, error)
 		}
 	}
 
+	s.advanceTutorial(uid, tutorial.KindLook)
 	return &gamev1.ServerEvent{
 		Payload: &gamev1.ServerEvent_RoomView{RoomView: view},
 	}, nil
 }
 
+// handleLookDirection peeks at the room beyond the player's exit in direction.
+//
+// Precondition: uid must be a valid connected player; direction is non-empty.
+// Postcondition: Returns a RoomPeekView, or a message when the view is blocked.
+func (s *GameServiceServer) handleLookDirection(uid, direction string) (*gamev1.ServerEvent, error) {
+	view, blocked, err := s.worldH.LookDirection(uid, direction)
+	if err != nil {
+		return nil, err
+	}
+	if view == nil {
+		return messageEvent(blocked), nil
+	}
+	return &gamev1.ServerEvent{
+		Payload: &gamev1.ServerEvent_RoomPeek{RoomPeek: view},
+	}, nil
+}
+
 func (s *GameServiceServer) handleExits(uid string) (*gamev1.ServerEvent, error) {
 	exitList, err := s.worldH.Exits(uid)
 	if err != nil {
@@ -3614,13 +4181,16 @@ func (s *GameServiceServer) handleExamine(uid string, req *gamev1.ExamineRequest
 		return &gamev1.ServerEvent{
 			Payload: &gamev1.ServerEvent_CharacterInfo{
 				CharacterInfo: &gamev1.CharacterInfo{
-					CharacterId: target.CharacterID,
-					Name:        target.CharName,
-					Region:      target.RegionDisplayName,
-					Class:       target.Class,
-					Level:       int32(target.Level),
-					CurrentHp:   int32(target.CurrentHP),
-					MaxHp:       int32(target.MaxHP),
+					CharacterId:       target.CharacterID,
+					Name:              target.CharName,
+					Region:            target.RegionDisplayName,
+					Class:             target.Class,
+					Level:             int32(target.Level),
+					CurrentHp:         int32(target.CurrentHP),
+					MaxHp:             int32(target.MaxHP),
+					Afk:               target.AFK,
+					AfkMessage:        target.AFKMessage,
+					HealthDescription: combat.HealthLabel(target.CurrentHP, target.MaxHP, examiner.Settings.AccessibleMode),
 				},
 			},
 		}, nil
@@ -3649,6 +4219,7 @@ func (s *GameServiceServer) handleExamine(uid string, req *gamev1.ExamineRequest
 		if err == nil {
 			if inst != nil {
 				view.NpcType = inst.NPCType
+				view.HealthDescription = combat.HealthLabel(inst.CurrentHP, inst.MaxHP, examiner.Settings.AccessibleMode)
 			}
 			return &gamev1.ServerEvent{
 				Payload: &gamev1.ServerEvent_NpcView{NpcView: view},
@@ -3668,7 +4239,12 @@ func (s *GameServiceServer) handleExamine(uid string, req *gamev1.ExamineRequest
 }
 
 // broadcastRoomEvent sends a RoomEvent to all players in a room except the excluded UID.
+// The excluded UID is the player arriving or leaving; nothing is sent when
+// that player is invisible.
 func (s *GameServiceServer) broadcastRoomEvent(roomID, excludeUID string, evt *gamev1.RoomEvent) {
+	if actor, ok := s.sessions.GetPlayer(excludeUID); ok && actor.Invisible {
+		return
+	}
 	serverEvt := &gamev1.ServerEvent{
 		Payload: &gamev1.ServerEvent_RoomEvent{RoomEvent: evt},
 	}
@@ -3680,12 +4256,21 @@ func (s *GameServiceServer) broadcastMessage(roomID, excludeUID string, evt *gam
 	serverEvt := &gamev1.ServerEvent{
 		Payload: &gamev1.ServerEvent_Message{Message: evt},
 	}
-	s.broadcastToRoom(roomID, excludeUID, serverEvt)
+	channel := messageChannel(evt.GetType())
+	s.broadcastToRoomFiltered(roomID, excludeUID, serverEvt, func(sess *session.PlayerSession) bool {
+		return channel != "" && sess.Settings.Muted(channel)
+	})
 }
 
 // broadcastToRoom serializes a ServerEvent and pushes it to all BridgeEntities
 // in the room, excluding the specified UID.
 func (s *GameServiceServer) broadcastToRoom(roomID, excludeUID string, evt *gamev1.ServerEvent) {
+	s.broadcastToRoomFiltered(roomID, excludeUID, evt, nil)
+}
+
+// broadcastToRoomFiltered is broadcastToRoom that additionally skips every
+// recipient for which skip returns true. A nil skip delivers to everyone.
+func (s *GameServiceServer) broadcastToRoomFiltered(roomID, excludeUID string, evt *gamev1.ServerEvent, skip func(*session.PlayerSession) bool) {
 	data, err := proto.Marshal(evt)
 	if err != nil {
 		s.logger.Error("marshaling broadcast event", zap.Error(err))
@@ -3701,6 +4286,9 @@ func (s *GameServiceServer) broadcastToRoom(roomID, excludeUID string, evt *game
 		if !ok {
 			continue
 		}
+		if skip != nil && skip(sess) {
+			continue
+		}
 		if err := sess.Entity.Push(data); err != nil {
 			s.logger.Warn("push to entity failed",
 				zap.String("uid", uid),
@@ -3752,11 +4340,23 @@ func (s *GameServiceServer) pushRoomViewToAllInRoom(roomID string) {
 type syncStream struct {
 	mu sync.Mutex
 	gamev1.GameService_SessionServer
+	// transcript, when non-nil, records every event sent while the player
+	// has logging on.
+	transcript *transcript.Recorder
+	// mirror, when non-nil, is called with every event sent; it copies the
+	// event to a staff member snooping the player.
+	mirror func(*gamev1.ServerEvent)
 }
 
 func (ss *syncStream) Send(evt *gamev1.ServerEvent) error {
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
+	if ss.transcript != nil && ss.transcript.Active() {
+		recordTranscript(ss.transcript, evt)
+	}
+	if ss.mirror != nil {
+		ss.mirror(evt)
+	}
 	return ss.GameService_SessionServer.Send(evt)
 }
 
@@ -3786,12 +4386,13 @@ func (s *GameServiceServer) forwardEvents(ctx context.Context, entity *session.B
 
 func (s *GameServiceServer) handleAttack(uid string, req *gamev1.AttackRequest) (*gamev1.ServerEvent, error) {
 	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Conditions != nil && sess.Conditions.Has("submerged") {
-		return messageEvent("You are submerged underwater and cannot attack. Swim or Escape to surface."), nil
+		return messageEvent(s.t(sess, "combat.submerged_no_attack", nil)), nil
 	}
 	// GH #231: after Overpower, further attack actions are blocked until the
 	// player's next turn (overpower_committed condition, rounds=1).
 	if s.overpowerCommitted(uid) {
-		return messageEvent("You've committed your attacks this round to the Overpower strike."), nil
+		msgSess, _ := s.sessions.GetPlayer(uid)
+		return messageEvent(s.t(msgSess, "combat.overpower_committed", nil)), nil
 	}
 
 	// Safe-room and danger-level enforcement.
@@ -3833,6 +4434,21 @@ func (s *GameServiceServer) handleAttack(uid string, req *gamev1.AttackRequest)
 		s.pushMessageToUID(uid, "Your focus is broken — combat has started!")
 	}
 
+	// Vehicles are struck directly rather than entering combat; NPCs win name clashes.
+	if sess, ok := s.sessions.GetPlayer(uid); ok && s.vehicles != nil && s.npcMgr.FindInRoom(sess.RoomID, req.Target) == nil {
+		if v, found := s.vehicles.FindInRoom(sess.RoomID, req.Target); found {
+			return s.attackVehicle(uid, sess, v)
+		}
+	}
+
+	// Starting a fight with a civilian is a crime; piling onto one already fighting is not a new one.
+	var civilian *npc.Instance
+	if sess, ok := s.sessions.GetPlayer(uid); ok {
+		if inst := s.npcMgr.FindInRoom(sess.RoomID, req.Target); inst != nil && inst.Civilian && !s.combatH.IsInCombat(inst.ID) {
+			civilian = inst
+		}
+	}
+
 	events, err := s.combatH.Attack(uid, req.Target)
 	if err != nil {
 		return nil, err
@@ -3843,12 +4459,18 @@ func (s *GameServiceServer) handleAttack(uid string, req *gamev1.AttackRequest)
 	// Track last explicit combat target and broadcast all events except the first.
 	sess, ok := s.sessions.GetPlayer(uid)
 	if ok {
+		if civilian != nil {
+			if room, roomOK := s.world.GetRoom(sess.RoomID); roomOK {
+				s.reportCrime(sess, room, "attack "+civilian.Name())
+			}
+		}
 		sess.LastCombatTarget = req.Target
 		for _, evt := range events[1:] {
 			s.broadcastCombatEvent(sess.RoomID, uid, evt)
 		}
 		// Push updated room view so all players see the combat status immediately.
 		s.pushRoomViewToAllInRoom(sess.RoomID)
+		s.advanceTutorial(uid, tutorial.KindAttack)
 	}
 	return &gamev1.ServerEvent{
 		Payload: &gamev1.ServerEvent_CombatEvent{CombatEvent: events[0]},
@@ -3901,19 +4523,55 @@ func (s *GameServiceServer) handleFlee(uid string) (*gamev1.ServerEvent, error)
 	}, nil
 }
 
+// broadcastCombatEvent sends evt to every player in roomID except excludeUID,
+// annotated with each recipient's perspective.
 func (s *GameServiceServer) broadcastCombatEvent(roomID, excludeUID string, evt *gamev1.CombatEvent) {
-	s.broadcastToRoom(roomID, excludeUID, &gamev1.ServerEvent{
-		Payload: &gamev1.ServerEvent_CombatEvent{CombatEvent: evt},
+	for _, uid := range s.sessions.PlayerUIDsInRoom(roomID) {
+		if uid == excludeUID {
+			continue
+		}
+		sess, ok := s.sessions.GetPlayer(uid)
+		if !ok {
+			continue
+		}
+		s.pushCombatEvent(sess, evt)
+	}
+}
+
+// pushCombatEvent annotates evt with sess's perspective and pushes it to sess.
+//
+// Precondition: sess and evt must be non-nil.
+// Postcondition: evt is not mutated; push failures are logged.
+func (s *GameServiceServer) pushCombatEvent(sess *session.PlayerSession, evt *gamev1.CombatEvent) {
+	data, err := proto.Marshal(&gamev1.ServerEvent{
+		Payload: &gamev1.ServerEvent_CombatEvent{CombatEvent: s.withCombatPerspective(sess, evt)},
 	})
+	if err != nil {
+		s.logger.Error("marshaling combat event", zap.Error(err))
+		return
+	}
+	if err := sess.Entity.Push(data); err != nil {
+		s.logger.Warn("push to entity failed",
+			zap.String("uid", sess.UID),
+			zap.Error(err),
+		)
+	}
 }
 
 // BroadcastCombatEvents sends combat events to all players in the room.
 // Called by CombatHandler's round timer callback.
 //
-// Postcondition: Each event is delivered to all sessions in roomID.
+// Postcondition: Each session in roomID receives the events filtered through
+// its combat narration level and annotated with its perspective.
 func (s *GameServiceServer) BroadcastCombatEvents(roomID string, events []*gamev1.CombatEvent) {
-	for _, evt := range events {
-		s.broadcastCombatEvent(roomID, "", evt)
+	for _, uid := range s.sessions.PlayerUIDsInRoom(roomID) {
+		sess, ok := s.sessions.GetPlayer(uid)
+		if !ok {
+			continue
+		}
+		for _, evt := range filterCombatEventsForVerbosity(sess.CombatNarration(), events) {
+			s.pushCombatEvent(sess, evt)
+		}
 	}
 }
 
@@ -3940,7 +4598,8 @@ func (s *GameServiceServer) handleStrike(uid string, req *gamev1.StrikeRequest)
 	// GH #231: after Overpower, further attack actions are blocked until the
 	// player's next turn.
 	if s.overpowerCommitted(uid) {
-		return messageEvent("You've committed your attacks this round to the Overpower strike."), nil
+		msgSess, _ := s.sessions.GetPlayer(uid)
+		return messageEvent(s.t(msgSess, "combat.overpower_committed", nil)), nil
 	}
 	events, err := s.combatH.Strike(uid, req.GetTarget())
 	if err != nil {
@@ -4120,6 +4779,7 @@ func (s *GameServiceServer) handleMotelRest(uid string, sess *session.PlayerSess
 		return sendMsg(fmt.Sprintf("A night here costs %d credits. You only have %d.", cost, sess.Currency))
 	}
 	sess.Currency -= cost
+	s.emitCurrency(sess, -cost, telemetry.SourceRest)
 	ctx := stream.Context()
 	if s.charSaver != nil {
 		if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
@@ -4170,6 +4830,7 @@ func (s *GameServiceServer) handleBrothelRest(uid string, sess *session.PlayerSe
 
 	// REQ-BR-7: deduct credits, save, confirm, apply full rest.
 	sess.Currency -= brothelConfig.RestCost
+	s.emitCurrency(sess, -brothelConfig.RestCost, telemetry.SourceRest)
 	if s.charSaver != nil {
 		if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
 			s.logger.Warn("handleBrothelRest: failed to save currency", zap.Error(err))
@@ -4231,6 +4892,7 @@ func (s *GameServiceServer) handleBrothelRest(uid string, sess *session.PlayerSe
 				stolenCrypto = 1
 			}
 			sess.Currency -= stolenCrypto
+			s.emitCurrency(sess, -stolenCrypto, telemetry.SourceRobbed)
 			if s.charSaver != nil {
 				if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
 					s.logger.Warn("handleBrothelRest: failed to save currency after robbery", zap.Error(err))
@@ -4362,7 +5024,7 @@ func (s *GameServiceServer) cancelCamping(uid string, sess *session.PlayerSessio
 		if sess.CurrentHP > sess.MaxHP {
 			sess.CurrentHP = sess.MaxHP
 		}
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		if s.charSaver != nil {
 			if err := s.charSaver.SaveState(ctx, sess.CharacterID, sess.RoomID, sess.CurrentHP); err != nil {
 				s.logger.Warn("cancelCamping: failed to save HP", zap.Error(err))
@@ -4372,7 +5034,7 @@ func (s *GameServiceServer) cancelCamping(uid string, sess *session.PlayerSessio
 
 	// Partial tech pool restore.
 	if s.spontaneousUsePoolRepo != nil {
-		if err := s.spontaneousUsePoolRepo.RestorePartial(context.Background(), sess.CharacterID, frac); err != nil {
+		if err := s.spontaneousUsePoolRepo.RestorePartial(s.commandCtx(uid), sess.CharacterID, frac); err != nil {
 			s.logger.Warn("cancelCamping: failed to restore tech pools", zap.Error(err))
 		}
 	}
@@ -5089,6 +5751,15 @@ func (s *GameServiceServer) cleanupPlayer(uid, username string, myEntity *sessio
 		s.sessions.SetPendingGroupInvite(sess.UID, "")
 	}
 
+	// Snoops this player takes part in end with the session; tell the other side.
+	if snooper, ok := s.sessions.Snooper(uid); ok {
+		s.pushMessageToUID(snooper, fmt.Sprintf("%s has left the game; your snoop has ended.", charName))
+	}
+	if targetUID, ok := s.sessions.StopSnoop(uid); ok {
+		s.pushMessageToUID(targetUID, fmt.Sprintf("%s has stopped observing your session.", charName))
+	}
+	s.releasePuppet(sess)
+
 	// Handle group membership on disconnect.
 	if sess.GroupID != "" {
 		if grp, ok := s.sessions.GroupByID(sess.GroupID); ok {
@@ -5123,13 +5794,46 @@ func (s *GameServiceServer) cleanupPlayer(uid, username string, myEntity *sessio
 		}
 	}
 
+	// Leave any vehicle so the seat (and the wheel) is free for others.
+	if s.vehicles != nil {
+		s.disembark(uid, sess)
+	}
+
 	if err := s.sessions.RemovePlayer(uid); err != nil {
 		s.logger.Warn("removing player on cleanup", zap.String("uid", uid), zap.Error(err))
 	}
 
+	// Flush lifetime stats, including playtime, on disconnect.
+	statsCtx, statsCancel := s.withCommandTimeout(context.Background())
+	s.flushStats(statsCtx, sess)
+	statsCancel()
+
+	if s.flood != nil {
+		s.flood.forget(uid)
+	}
+	if s.chatH != nil && s.chatH.Filter() != nil {
+		s.chatH.Filter().Forget(uid)
+	}
+	// A script's question dies with the session.
+	if s.scriptMgr != nil {
+		s.scriptMgr.CancelPrompt(uid)
+	}
+
+	// Close the play session record.
+	playCtx, playCancel := s.withCommandTimeout(context.Background())
+	s.touchPlaySession(playCtx, sess)
+	playCancel()
+
+	// Save a transcript the player left recording.
+	if sess.Transcript != nil && sess.Transcript.Active() {
+		logCtx, logCancel := s.withCommandTimeout(context.Background())
+		s.saveTranscript(logCtx, sess)
+		logCancel()
+	}
+
 	// Persist character state on disconnect.
 	if characterID > 0 && s.charSaver != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := s.withCommandTimeout(context.Background())
 		defer cancel()
 		if err := s.charSaver.SaveState(ctx, characterID, roomID, currentHP); err != nil {
 			s.logger.Warn("saving character state on disconnect",
@@ -5188,12 +5892,14 @@ func (s *GameServiceServer) cleanupPlayer(uid, username string, myEntity *sessio
 	)
 }
 
-// grantStartingInventory grants the starting kit for a new character and auto-equips it.
+// grantStartingInventory grants the starting kit for a new character and auto-equips it,
+// along with any themed items earned from the background questionnaire.
 //
-// Precondition: sess must be non-nil; characterID must be > 0; loadoutsDir must be non-empty.
+// Precondition: sess must be non-nil; characterID must be > 0; loadoutsDir must be non-empty;
+// backgroundItems may be nil.
 // Postcondition: Backpack populated, weapon equipped, armor equipped in slots,
 // currency set, inventory saved, flag marked. Returns nil on success.
-func (s *GameServiceServer) grantStartingInventory(ctx context.Context, sess *session.PlayerSession, characterID int64, archetype, team string, jobOverride *inventory.StartingLoadoutOverride) error {
+func (s *GameServiceServer) grantStartingInventory(ctx context.Context, sess *session.PlayerSession, characterID int64, archetype, team string, jobOverride *inventory.StartingLoadoutOverride, backgroundItems []ruleset.BackgroundItem) error {
 	sl, err := inventory.LoadStartingLoadoutWithOverride(s.loadoutsDir, archetype, team, jobOverride)
 	if err != nil {
 		return fmt.Errorf("resolving starting loadout: %w", err)
@@ -5224,6 +5930,13 @@ func (s *GameServiceServer) grantStartingInventory(ctx context.Context, sess *se
 		}
 	}
 
+	// Add background items.
+	for _, bi := range backgroundItems {
+		if _, addErr := sess.Backpack.Add(bi.ItemID, bi.Quantity, s.invRegistry); addErr != nil {
+			s.logger.Warn("failed to add background item", zap.String("item", bi.ItemID), zap.Error(addErr))
+		}
+	}
+
 	// Set currency.
 	sess.Currency = sl.Currency
 
@@ -5251,21 +5964,13 @@ func (s *GameServiceServer) grantStartingInventory(ctx context.Context, sess *se
 	return nil
 }
 
-// backpackToInventoryItems converts backpack contents to a deduplicated slice of InventoryItem.
+// backpackToInventoryItems converts backpack contents to the persisted InventoryItem form.
 //
 // Precondition: bp must be non-nil.
-// Postcondition: Returns a slice with one entry per unique item def ID, summing quantities.
+// Postcondition: Returns one entry per unique item def ID for instances without
+// state, summing quantities, plus one entry per instance carrying Metadata.
 func backpackToInventoryItems(bp *inventory.Backpack) []inventory.InventoryItem {
-	instances := bp.Items()
-	counts := make(map[string]int, len(instances))
-	for _, inst := range instances {
-		counts[inst.ItemDefID] += inst.Quantity
-	}
-	out := make([]inventory.InventoryItem, 0, len(counts))
-	for id, qty := range counts {
-		out = append(out, inventory.InventoryItem{ItemDefID: id, Quantity: qty})
-	}
-	return out
+	return bp.InventoryItems()
 }
 
 // errorEvent builds a ServerEvent carrying an ErrorEvent with the given message.
@@ -5287,6 +5992,9 @@ func buildConsumableEffectsSummary(def *inventory.ItemDef) string {
 		if def.Effect.Heal != "" {
 			parts = append(parts, "Heal: "+def.Effect.Heal)
 		}
+		if def.Effect.TempHP != "" {
+			parts = append(parts, "Temp HP: "+def.Effect.TempHP)
+		}
 		for _, c := range def.Effect.Conditions {
 			parts = append(parts, "Applies "+c.ConditionID+" ("+c.Duration+")")
 		}
@@ -5343,6 +6051,9 @@ func buildConsumableResultMsg(itemName string, r inventory.ConsumableResult) str
 	if r.HealApplied > 0 {
 		parts = append(parts, fmt.Sprintf("You recover %d HP.", r.HealApplied))
 	}
+	if r.TempHPApplied > 0 {
+		parts = append(parts, fmt.Sprintf("You gain %d temporary HP.", r.TempHPApplied))
+	}
 	for _, cid := range r.ConditionsRemoved {
 		parts = append(parts, fmt.Sprintf("Removed condition: %s.", cid))
 	}
@@ -5380,7 +6091,7 @@ func (s *GameServiceServer) handleEquip(uid string, req *gamev1.EquipRequest) (*
 	}
 	// Persist the updated loadout if we have a character saver.
 	if s.charSaver != nil && sess.LoadoutSet != nil {
-		if err := s.charSaver.SaveWeaponPresets(context.Background(), sess.CharacterID, sess.LoadoutSet); err != nil {
+		if err := s.charSaver.SaveWeaponPresets(s.commandCtx(uid), sess.CharacterID, sess.LoadoutSet); err != nil {
 			s.logger.Warn("failed to persist weapon presets after equip", zap.Error(err))
 		}
 	}
@@ -5394,6 +6105,11 @@ func (s *GameServiceServer) handleEquip(uid string, req *gamev1.EquipRequest) (*
 	s.pushInventory(sess)
 	s.pushLoadout(sess)
 	s.pushCharacterSheet(sess)
+	if strings.HasPrefix(result, "Equipped ") {
+		s.advanceTutorial(uid, tutorial.KindEquip)
+		s.notifyObservers(npc.RoomEvent{Kind: npc.EventDrawWeapon, RoomID: sess.RoomID, ActorUID: uid,
+			ItemID: req.GetWeaponId(), Team: s.gearTeam(req.GetWeaponId())})
+	}
 	return messageEvent(result), nil
 }
 
@@ -5437,7 +6153,7 @@ func (s *GameServiceServer) handleWear(uid string, req *gamev1.WearRequest) (*ga
 
 	// Persist and refresh UI after a successful wear.
 	if strings.HasPrefix(result, "Wore ") {
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		invItems := backpackToInventoryItems(sess.Backpack)
 		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, invItems); err != nil {
 			s.logger.Error("handleWear: SaveInventory failed", zap.String("uid", uid), zap.Error(err))
@@ -5451,6 +6167,9 @@ func (s *GameServiceServer) handleWear(uid string, req *gamev1.WearRequest) (*ga
 			s.pushInventory(sess2)
 			s.pushCharacterSheet(sess2)
 		}
+		s.advanceTutorial(uid, tutorial.KindEquip)
+		s.notifyObservers(npc.RoomEvent{Kind: npc.EventWearArmor, RoomID: sess.RoomID, ActorUID: uid,
+			ItemID: req.GetItemId(), Team: s.gearTeam(req.GetItemId())})
 	}
 	return messageEvent(result), nil
 }
@@ -5475,7 +6194,7 @@ func (s *GameServiceServer) handleRemoveArmor(uid string, req *gamev1.RemoveArmo
 			}
 		}
 		// Persist immediately so removal survives a disconnect or crash.
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		invItems := backpackToInventoryItems(sess.Backpack)
 		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, invItems); err != nil {
 			s.logger.Error("handleRemoveArmor: SaveInventory failed", zap.String("uid", uid), zap.Error(err))
@@ -5546,7 +6265,7 @@ func (s *GameServiceServer) handleReload(uid string, req *gamev1.ReloadRequest)
 
 func (s *GameServiceServer) handleFireBurst(uid string, req *gamev1.FireBurstRequest) (*gamev1.ServerEvent, error) {
 	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Conditions != nil && sess.Conditions.Has("submerged") {
-		return messageEvent("You are submerged underwater and cannot attack. Swim or Escape to surface."), nil
+		return messageEvent(s.t(sess, "combat.submerged_no_attack", nil)), nil
 	}
 	events, err := s.combatH.FireBurst(uid, req.GetTarget())
 	if err != nil {
@@ -5561,7 +6280,7 @@ func (s *GameServiceServer) handleFireBurst(uid string, req *gamev1.FireBurstReq
 
 func (s *GameServiceServer) handleFireAutomatic(uid string, req *gamev1.FireAutomaticRequest) (*gamev1.ServerEvent, error) {
 	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Conditions != nil && sess.Conditions.Has("submerged") {
-		return messageEvent("You are submerged underwater and cannot attack. Swim or Escape to surface."), nil
+		return messageEvent(s.t(sess, "combat.submerged_no_attack", nil)), nil
 	}
 	events, err := s.combatH.FireAutomatic(uid, req.GetTarget())
 	if err != nil {
@@ -5667,8 +6386,8 @@ func (s *GameServiceServer) StopCarrierRadHook() {
 	}
 }
 
-// tickZone executes one game tick for the given zone, advancing NPC AI and
-// draining the respawn queue.
+// tickZone executes one game tick for the given zone, showing ambient flavor,
+// advancing NPC AI, and draining the respawn queue.
 //
 // Precondition: zoneID must be a valid zone identifier loaded in worldMgr.
 func (s *GameServiceServer) tickZone(zoneID string, aiReg *ai.Registry) {
@@ -5676,6 +6395,8 @@ func (s *GameServiceServer) tickZone(zoneID string, aiReg *ai.Registry) {
 		if zone.ID != zoneID {
 			continue
 		}
+		s.tickAmbient(zone)
+		s.tickZoneReset(zone, time.Now())
 		if s.npcH == nil {
 			break
 		}
@@ -5684,6 +6405,10 @@ func (s *GameServiceServer) tickZone(zoneID string, aiReg *ai.Registry) {
 				if s.combatH != nil && s.combatH.IsInCombat(inst.ID) {
 					continue
 				}
+				if inst.CaptorUID != "" {
+					s.tickPrisoner(inst)
+					continue
+				}
 				s.tickNPCIdle(inst, zoneID, aiReg)
 			}
 		}
@@ -5698,6 +6423,14 @@ func (s *GameServiceServer) tickNPCIdle(inst *npc.Instance, zoneID string, aiReg
 	if inst.Immobile {
 		return
 	}
+	// A puppeted NPC does only what its puppeteer tells it.
+	if inst.PuppetedBy != "" {
+		return
+	}
+	// A surrendered NPC waits to be dealt with.
+	if inst.Surrendered {
+		return
+	}
 
 	// REQ-NB-34: NPC was recruited via call_for_help on the previous tick; join the active combat.
 	if inst.PendingJoinCombatRoomID != "" {
@@ -6098,7 +6831,7 @@ func (s *GameServiceServer) handleInventory(uid string) (*gamev1.ServerEvent, er
 		throwable := false
 		if s.invRegistry != nil {
 			if def, ok := s.invRegistry.Item(inst.ItemDefID); ok {
-				name = def.Name
+				name = s.invRegistry.InstanceName(def.Name, inst.CustomName, inst.Affixes)
 				kind = def.Kind
 				weight = def.Weight
 				throwable = def.HasTag("throwable")
@@ -6111,6 +6844,9 @@ func (s *GameServiceServer) handleInventory(uid string) (*gamev1.ServerEvent, er
 				if def.Kind == inventory.KindConsumable {
 					effectsSummary = buildConsumableEffectsSummary(def)
 				}
+				if def.TreasureCache != nil {
+					effectsSummary = strings.Join(s.treasureMapClues(inst), " ")
+				}
 			}
 		}
 		items = append(items, &gamev1.InventoryItem{
@@ -6227,12 +6963,12 @@ func (s *GameServiceServer) buildLoadoutView(sess *session.PlayerSession) *gamev
 			wp := &gamev1.LoadoutWeaponPreset{}
 			if preset.MainHand != nil {
 				def := preset.MainHand.Def
-				wp.MainHand = def.Name
+				wp.MainHand = s.equippedWeaponName(preset.MainHand)
 				wp.MainHandDamage = weaponDamageString(def.DamageDice, brutalityMod, def.IsMelee())
 			}
 			if preset.OffHand != nil {
 				def := preset.OffHand.Def
-				wp.OffHand = def.Name
+				wp.OffHand = s.equippedWeaponName(preset.OffHand)
 				wp.OffHandDamage = weaponDamageString(def.DamageDice, brutalityMod, def.IsMelee())
 			}
 			lv.Presets = append(lv.Presets, wp)
@@ -6254,6 +6990,17 @@ func (s *GameServiceServer) buildLoadoutView(sess *session.PlayerSession) *gamev
 	}
 }
 
+// equippedWeaponName returns the display name of an equipped weapon, including
+// its affixes and engraved name when the item registry is available.
+//
+// Precondition: ew must be non-nil with a non-nil Def.
+func (s *GameServiceServer) equippedWeaponName(ew *inventory.EquippedWeapon) string {
+	if s.invRegistry == nil {
+		return ew.Def.Name
+	}
+	return s.invRegistry.InstanceName(ew.Def.Name, ew.CustomName, ew.Affixes)
+}
+
 // handleUnequip removes the item in the given slot and returns it to the backpack.
 //
 // Precondition: uid must be a valid connected player with non-nil LoadoutSet and Equipment; req.Slot must be non-empty.
@@ -6418,6 +7165,7 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 	view.TotalAc = int32(10 + def.EffectiveDex + def.ACBonus)
 	sess.Resistances = def.Resistances
 	sess.Weaknesses = def.Weaknesses
+	sess.Immunities = def.Immunities
 
 	// Player resistances and weaknesses from equipped armor.
 	for dmgType, val := range sess.Resistances {
@@ -6518,7 +7266,7 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 
 	// Skills block.
 	if s.characterSkillsRepo != nil && len(s.allSkills) > 0 {
-		skillMap, err := s.characterSkillsRepo.GetAll(context.Background(), sess.CharacterID)
+		skillMap, err := s.characterSkillsRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
 		if err == nil {
 			for _, sk := range s.allSkills {
 				rank := skillMap[sk.ID]
@@ -6537,7 +7285,7 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 
 	// Feats block.
 	if s.characterFeatsRepo != nil && s.featRegistry != nil {
-		featIDs, err := s.characterFeatsRepo.GetAll(context.Background(), sess.CharacterID)
+		featIDs, err := s.characterFeatsRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
 		if err == nil {
 			for _, fid := range featIDs {
 				f, ok := s.featRegistry.Feat(fid)
@@ -6564,7 +7312,7 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 
 	// Class features block.
 	if s.characterClassFeaturesRepo != nil && s.classFeatureRegistry != nil {
-		cfIDs, err := s.characterClassFeaturesRepo.GetAll(context.Background(), sess.CharacterID)
+		cfIDs, err := s.characterClassFeaturesRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
 		if err == nil {
 			for _, cfid := range cfIDs {
 				cf, ok := s.classFeatureRegistry.ClassFeature(cfid)
@@ -6589,7 +7337,7 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 	if level < 1 {
 		level = 1
 	}
-	view.Proficiencies = buildProficiencyEntries(sess.Proficiencies, level)
+	view.Proficiencies = buildProficiencyEntries(sess.Proficiencies, sess.WeaponPractice, level)
 
 	// Saves: static bonus = ability_mod + CombatProficiencyBonus(level, rank).
 	view.ToughnessSave = int32(combat.AbilityMod(sess.Abilities.Grit) +
@@ -6807,6 +7555,7 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 	} else {
 		view.EffectsSummary = effectrender.EffectsBlock(nil, nil, 80)
 	}
+	view.Title = displayTitle(sess)
 
 	return &gamev1.ServerEvent{
 		Payload: &gamev1.ServerEvent_CharacterSheet{CharacterSheet: view},
@@ -6818,13 +7567,9 @@ func (s *GameServiceServer) handleChar(uid string) (*gamev1.ServerEvent, error)
 // Precondition: uid must be a valid connected player with admin role.
 // Postcondition: Returns a success message or an error event.
 func (s *GameServiceServer) handleSetRole(uid string, req *gamev1.SetRoleRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return errorEvent("player not found"), nil
 	}
-	if evt := requireAdmin(sess); evt != nil {
-		return evt, nil
-	}
 	if s.accountAdmin == nil {
 		return errorEvent("account administration not available"), nil
 	}
@@ -6832,11 +7577,11 @@ func (s *GameServiceServer) handleSetRole(uid string, req *gamev1.SetRoleRequest
 		return errorEvent("usage: setrole <username> <role>"), nil
 	}
 
-	target, err := s.accountAdmin.GetAccountByUsername(context.Background(), req.TargetUsername)
+	target, err := s.accountAdmin.GetAccountByUsername(s.commandCtx(uid), req.TargetUsername)
 	if err != nil {
 		return errorEvent(fmt.Sprintf("account %q not found", req.TargetUsername)), nil
 	}
-	if err := s.accountAdmin.SetAccountRole(context.Background(), target.ID, req.Role); err != nil {
+	if err := s.accountAdmin.SetAccountRole(s.commandCtx(uid), target.ID, req.Role); err != nil {
 		return errorEvent(fmt.Sprintf("failed to set role: %v", err)), nil
 	}
 
@@ -6854,9 +7599,6 @@ func (s *GameServiceServer) handleSummonItem(uid string, req *gamev1.SummonItemR
 	if !ok {
 		return errorEvent("player not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if s.invRegistry == nil {
 		return errorEvent("item registry unavailable"), nil
 	}
@@ -6885,13 +7627,9 @@ func (s *GameServiceServer) handleSummonItem(uid string, req *gamev1.SummonItemR
 // Precondition: uid must be a valid connected player with admin role.
 // Postcondition: Target player is moved, location is persisted, target receives a message and room view.
 func (s *GameServiceServer) handleTeleport(uid string, req *gamev1.TeleportRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return errorEvent("player not found"), nil
 	}
-	if evt := requireAdmin(sess); evt != nil {
-		return evt, nil
-	}
 	if req.TargetCharacter == "" || req.RoomId == "" {
 		return errorEvent("usage: teleport <character> <room_id>"), nil
 	}
@@ -6913,8 +7651,7 @@ func (s *GameServiceServer) handleTeleport(uid string, req *gamev1.TeleportReque
 
 	// Persist location immediately.
 	if target.CharacterID > 0 && s.charSaver != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+		ctx := s.commandCtx(uid)
 		if err := s.charSaver.SaveState(ctx, target.CharacterID, targetRoom.ID, target.CurrentHP); err != nil {
 			s.logger.Warn("persisting teleport location",
 				zap.String("target", target.CharName),
@@ -6974,17 +7711,17 @@ func (s *GameServiceServer) handleGetItem(uid, target string) (*gamev1.ServerEve
 	if target == "all" {
 		items := s.floorMgr.PickupAll(sess.RoomID)
 		if len(items) == 0 {
-			return messageEvent("There is nothing here to pick up."), nil
+			return messageEvent(s.t(sess, "inventory.nothing_to_pick_up", nil)), nil
 		}
 		picked := 0
 		for _, item := range items {
-			_, err := sess.Backpack.Add(item.ItemDefID, item.Quantity, s.invRegistry)
+			_, err := sess.Backpack.AddWithMetadata(item.ItemDefID, item.Quantity, item.Metadata(), s.invRegistry)
 			if err != nil {
 				s.floorMgr.Drop(sess.RoomID, item)
 				continue
 			}
 			if s.questSvc != nil {
-				if questMsgs, questErr := s.questSvc.RecordFetch(context.Background(), sess, sess.CharacterID, item.ItemDefID, item.Quantity); questErr == nil {
+				if questMsgs, questErr := s.questSvc.RecordFetch(s.commandCtx(uid), sess, sess.CharacterID, item.ItemDefID, item.Quantity); questErr == nil {
 					for _, qm := range questMsgs {
 						s.pushMessageToUID(uid, qm)
 					}
@@ -6995,7 +7732,10 @@ func (s *GameServiceServer) handleGetItem(uid, target string) (*gamev1.ServerEve
 			}
 			picked++
 		}
-		return messageEvent(fmt.Sprintf("Picked up %d item(s).", picked)), nil
+		if picked > 0 {
+			s.advanceTutorial(uid, tutorial.KindLoot)
+		}
+		return messageEvent(s.t(sess, "inventory.picked_up_count", i18n.Args{"count": picked})), nil
 	}
 
 	floorItems := s.floorMgr.ItemsInRoom(sess.RoomID)
@@ -7009,15 +7749,15 @@ func (s *GameServiceServer) handleGetItem(uid, target string) (*gamev1.ServerEve
 		if strings.EqualFold(name, target) || strings.EqualFold(item.ItemDefID, target) {
 			picked, ok := s.floorMgr.Pickup(sess.RoomID, item.InstanceID)
 			if !ok {
-				return errorEvent("Item is no longer there."), nil
+				return errorEvent(s.t(sess, "inventory.item_gone", nil)), nil
 			}
-			_, err := sess.Backpack.Add(picked.ItemDefID, picked.Quantity, s.invRegistry)
+			_, err := sess.Backpack.AddWithMetadata(picked.ItemDefID, picked.Quantity, picked.Metadata(), s.invRegistry)
 			if err != nil {
 				s.floorMgr.Drop(sess.RoomID, picked)
-				return errorEvent(fmt.Sprintf("Cannot pick up: %v", err)), nil
+				return errorEvent(s.t(sess, "inventory.cannot_pick_up", i18n.Args{"reason": err.Error()})), nil
 			}
 			if s.questSvc != nil {
-				if questMsgs, questErr := s.questSvc.RecordFetch(context.Background(), sess, sess.CharacterID, picked.ItemDefID, picked.Quantity); questErr == nil {
+				if questMsgs, questErr := s.questSvc.RecordFetch(s.commandCtx(uid), sess, sess.CharacterID, picked.ItemDefID, picked.Quantity); questErr == nil {
 					for _, qm := range questMsgs {
 						s.pushMessageToUID(uid, qm)
 					}
@@ -7026,7 +7766,8 @@ func (s *GameServiceServer) handleGetItem(uid, target string) (*gamev1.ServerEve
 					}
 				}
 			}
-			return messageEvent(fmt.Sprintf("You pick up %s.", name)), nil
+			s.advanceTutorial(uid, tutorial.KindLoot)
+			return messageEvent(s.t(sess, "inventory.pick_up", i18n.Args{"item": name})), nil
 		}
 	}
 	floorMats := s.floorMgr.MaterialsInRoom(sess.RoomID)
@@ -7045,18 +7786,18 @@ func (s *GameServiceServer) handleGetItem(uid, target string) (*gamev1.ServerEve
 		}
 		taken := s.floorMgr.TakeMaterial(sess.RoomID, matID, qty)
 		if taken == 0 {
-			return errorEvent("Material is no longer there."), nil
+			return errorEvent(s.t(sess, "inventory.material_gone", nil)), nil
 		}
 		if sess.Materials == nil {
 			sess.Materials = make(map[string]int)
 		}
 		sess.Materials[matID] += taken
 		if s.materialRepo != nil {
-			_ = s.materialRepo.Add(context.Background(), sess.CharacterID, matID, taken)
+			_ = s.materialRepo.Add(s.commandCtx(uid), sess.CharacterID, matID, taken)
 		}
-		return messageEvent(fmt.Sprintf("You pick up %d %s.", taken, matName)), nil
+		return messageEvent(s.t(sess, "inventory.pick_up_material", i18n.Args{"count": taken, "material": matName})), nil
 	}
-	return messageEvent("You don't see that here."), nil
+	return messageEvent(s.t(sess, "inventory.not_here", nil)), nil
 }
 
 // handleDropItem drops an item from the player's backpack to the room floor.
@@ -7080,13 +7821,15 @@ func (s *GameServiceServer) handleDropItem(uid, target string) (*gamev1.ServerEv
 		}
 		if strings.EqualFold(name, target) || strings.EqualFold(inst.ItemDefID, target) {
 			if err := sess.Backpack.Remove(inst.InstanceID, inst.Quantity); err != nil {
-				return errorEvent(fmt.Sprintf("Cannot drop: %v", err)), nil
+				return errorEvent(s.t(sess, "inventory.cannot_drop", i18n.Args{"reason": err.Error()})), nil
 			}
 			s.floorMgr.Drop(sess.RoomID, inst)
-			return messageEvent(fmt.Sprintf("You drop %s.", name)), nil
+			s.notifyObservers(npc.RoomEvent{Kind: npc.EventDropItem, RoomID: sess.RoomID, ActorUID: uid,
+				ItemID: inst.ItemDefID, Team: s.gearTeam(inst.ItemDefID)})
+			return messageEvent(s.t(sess, "inventory.drop", i18n.Args{"item": name})), nil
 		}
 	}
-	return messageEvent("You don't have that."), nil
+	return messageEvent(s.t(sess, "inventory.not_carried", nil)), nil
 }
 
 // handleArchetypeSelection acknowledges an archetype selection during character creation.
@@ -7118,12 +7861,17 @@ func (s *GameServiceServer) handleUseEquipment(uid, instanceID string) (*gamev1.
 	if inst == nil {
 		return messageEvent("That item is not here."), nil
 	}
+	if inst.LockDC > 0 {
+		return messageEvent(fmt.Sprintf("The %s is locked.", s.equipmentLabel(inst))), nil
+	}
 
 	// Resolve the zone ID once; used for Lua hooks below.
 	room, roomOk := s.world.GetRoom(sess.RoomID)
 	zoneID := ""
 	if roomOk {
 		zoneID = room.ZoneID
+		// Interaction trap: fire if this equipment has an armed interaction-trigger trap.
+		s.checkInteractionTrap(uid, sess, room, inst.ItemDefID)
 	}
 
 	// Fire on_use skill check triggers BEFORE invoking the item Lua script.
@@ -7215,10 +7963,6 @@ func (s *GameServiceServer) handleUseEquipment(uid, instanceID string) (*gamev1.
 	if len(skillMsgs) > 0 {
 		msg = strings.Join(skillMsgs, "\r\n") + "\r\n" + msg
 	}
-	// Interaction trap: fire if this equipment has an armed interaction-trigger trap.
-	if s.trapMgr != nil && s.trapTemplates != nil && roomOk {
-		s.checkInteractionTrap(uid, sess, room, instanceID)
-	}
 	return messageEvent(msg), nil
 }
 
@@ -7232,9 +7976,6 @@ func (s *GameServiceServer) handleRoomEquip(uid string, req *gamev1.RoomEquipReq
 	if !ok {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if s.roomEquipMgr == nil {
 		return messageEvent("Room equipment manager not available."), nil
 	}
@@ -7551,13 +8292,15 @@ func (s *GameServiceServer) wireRevealZone() {
 // the combat engine and faction registry into the script manager.
 //
 // Precondition: Must be called after s.scriptMgr, s.combatH, and s.factionRegistry are initialized.
-// Postcondition: GetCombatantsInRoom and GetFactionHostiles are set on s.scriptMgr when non-nil.
+// Postcondition: GetCombatantsInRoom, GrantTempHP, and GetFactionHostiles are set on s.scriptMgr when non-nil.
 func (s *GameServiceServer) wireScriptMgrCombatCallbacks() {
 	if s.scriptMgr == nil {
 		return
 	}
 	if s.combatH != nil {
 		s.scriptMgr.GetCombatantsInRoom = s.combatH.GetCombatantsInRoom
+		s.scriptMgr.GrantTempHP = s.combatH.GrantTempHP
+		s.scriptMgr.AddCombatant = s.combatH.SpawnCombatant
 	}
 	if s.factionRegistry != nil {
 		reg := *s.factionRegistry
@@ -7583,7 +8326,7 @@ func (s *GameServiceServer) handleSkills(uid string) (*gamev1.ServerEvent, error
 	if s.characterSkillsRepo == nil || len(s.allSkills) == 0 {
 		return messageEvent("Skill data is not available."), nil
 	}
-	skills, err := s.characterSkillsRepo.GetAll(context.Background(), sess.CharacterID)
+	skills, err := s.characterSkillsRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
 	if err != nil {
 		return nil, fmt.Errorf("getting skills for %s: %w", uid, err)
 	}
@@ -7621,7 +8364,7 @@ func (s *GameServiceServer) handleFeats(uid string) (*gamev1.ServerEvent, error)
 	if s.characterFeatsRepo == nil || s.featRegistry == nil {
 		return messageEvent("Feat data is not available."), nil
 	}
-	featIDs, err := s.characterFeatsRepo.GetAll(context.Background(), sess.CharacterID)
+	featIDs, err := s.characterFeatsRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
 	if err != nil {
 		return nil, fmt.Errorf("getting feats for %s: %w", uid, err)
 	}
@@ -7667,7 +8410,7 @@ func (s *GameServiceServer) handleClassFeatures(uid string) (*gamev1.ServerEvent
 	if s.characterClassFeaturesRepo == nil || s.classFeatureRegistry == nil {
 		return messageEvent("Class feature data is not available."), nil
 	}
-	featureIDs, err := s.characterClassFeaturesRepo.GetAll(context.Background(), sess.CharacterID)
+	featureIDs, err := s.characterClassFeaturesRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
 	if err != nil {
 		return nil, fmt.Errorf("getting class features: %w", err)
 	}
@@ -7748,7 +8491,7 @@ func (s *GameServiceServer) handleJobGrants(uid string) (*gamev1.ServerEvent, er
 	// Choice-pool grants show what the player picked; unresolved pools show the option label.
 	playerFeatIDs := make(map[string]bool)
 	if s.characterFeatsRepo != nil {
-		if ids, err := s.characterFeatsRepo.GetAll(context.Background(), sess.CharacterID); err == nil {
+		if ids, err := s.characterFeatsRepo.GetAll(s.commandCtx(uid), sess.CharacterID); err == nil {
 			for _, id := range ids {
 				playerFeatIDs[id] = true
 			}
@@ -8036,7 +8779,7 @@ func (s *GameServiceServer) handleProficiencies(uid string) (*gamev1.ServerEvent
 	if level < 1 {
 		level = 1
 	}
-	entries := buildProficiencyEntries(sess.Proficiencies, level)
+	entries := buildProficiencyEntries(sess.Proficiencies, sess.WeaponPractice, level)
 	return &gamev1.ServerEvent{
 		Payload: &gamev1.ServerEvent_ProficienciesResponse{
 			ProficienciesResponse: &gamev1.ProficienciesResponse{
@@ -8088,7 +8831,7 @@ func (s *GameServiceServer) handleLevelUp(uid, ability string) (*gamev1.ServerEv
 		}, nil
 	}
 
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	if sess.CharacterID > 0 && s.charSaver != nil {
 		if err := s.charSaver.SaveAbilities(ctx, sess.CharacterID, updated); err != nil {
 			s.logger.Warn("handleLevelUp: SaveAbilities failed", zap.Error(err))
@@ -8170,7 +8913,7 @@ func (s *GameServiceServer) handleCombatDefault(uid, action string) (*gamev1.Ser
 			},
 		}, nil
 	}
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	if sess.CharacterID > 0 && s.charSaver != nil {
 		if err := s.charSaver.SaveDefaultCombatAction(ctx, sess.CharacterID, action); err != nil {
 			s.logger.Warn("handleCombatDefault: SaveDefaultCombatAction failed", zap.Error(err))
@@ -8189,24 +8932,29 @@ func (s *GameServiceServer) handleCombatDefault(uid, action string) (*gamev1.Ser
 	}, nil
 }
 
-// buildProficiencyEntries constructs a slice of ProficiencyEntry from a proficiency map.
+// proficiencyDisplayNames maps each armor and weapon proficiency category to
+// its display name.
+var proficiencyDisplayNames = map[string]string{
+	"unarmored":       "Unarmored",
+	"light_armor":     "Light Armor",
+	"medium_armor":    "Medium Armor",
+	"heavy_armor":     "Heavy Armor",
+	"simple_weapons":  "Simple Weapons",
+	"simple_ranged":   "Simple Ranged",
+	"martial_weapons": "Martial Weapons",
+	"martial_ranged":  "Martial Ranged",
+	"martial_melee":   "Martial Melee",
+	"unarmed":         "Unarmed",
+	"specialized":     "Specialized",
+}
+
+// buildProficiencyEntries constructs a slice of ProficiencyEntry from a
+// proficiency map, with each weapon category's practice toward its next rank.
 //
-// Precondition: profs may be nil (treated as all untrained); level must be >= 1.
+// Precondition: profs and practice may be nil (treated as all untrained and
+// unpracticed); level must be >= 1.
 // Postcondition: Returns entries in canonical armor-then-weapon order.
-func buildProficiencyEntries(profs map[string]string, level int) []*gamev1.ProficiencyEntry {
-	displayNames := map[string]string{
-		"unarmored":       "Unarmored",
-		"light_armor":     "Light Armor",
-		"medium_armor":    "Medium Armor",
-		"heavy_armor":     "Heavy Armor",
-		"simple_weapons":  "Simple Weapons",
-		"simple_ranged":   "Simple Ranged",
-		"martial_weapons": "Martial Weapons",
-		"martial_ranged":  "Martial Ranged",
-		"martial_melee":   "Martial Melee",
-		"unarmed":         "Unarmed",
-		"specialized":     "Specialized",
-	}
+func buildProficiencyEntries(profs map[string]string, practice map[string]int, level int) []*gamev1.ProficiencyEntry {
 	order := []struct{ cat, kind string }{
 		{"unarmored", "armor"},
 		{"light_armor", "armor"},
@@ -8229,13 +8977,19 @@ func buildProficiencyEntries(profs map[string]string, level int) []*gamev1.Profi
 			}
 		}
 		bonus := combatProficiencyBonusForRank(level, rank)
-		entries = append(entries, &gamev1.ProficiencyEntry{
+		entry := &gamev1.ProficiencyEntry{
 			Category: o.cat,
-			Name:     displayNames[o.cat],
+			Name:     proficiencyDisplayNames[o.cat],
 			Rank:     rank,
 			Bonus:    int32(bonus),
 			Kind:     o.kind,
-		})
+		}
+		if o.kind == "weapon" {
+			effective, _ := resolveWeaponProficiency(profs, o.cat)
+			entry.Practice = int32(practice[o.cat])
+			entry.PracticeNext = int32(practiceNext(o.cat, effective))
+		}
+		entries = append(entries, entry)
 	}
 	return entries
 }
@@ -8314,7 +9068,7 @@ func (s *GameServiceServer) handleTabComplete(uid, prefix string) (*gamev1.Serve
 
 		// Complete active feat names.
 		if s.characterFeatsRepo != nil && s.featRegistry != nil {
-			ctx := context.Background()
+			ctx := s.commandCtx(uid)
 			featIDs, err := s.characterFeatsRepo.GetAll(ctx, sess.CharacterID)
 			if err == nil {
 				for _, id := range featIDs {
@@ -8424,7 +9178,7 @@ func (s *GameServiceServer) handleUseWithTemplate(uid, abilityID, targetID strin
 		return messageEvent("Ability data is not available."), nil
 	}
 
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	var active []*gamev1.FeatEntry
 
 	// Collect active feats
@@ -9264,7 +10018,7 @@ func (s *GameServiceServer) handleAmpedUse(
 		}
 		if resolvedTech.Targets == technology.TargetsAllEnemies && cbt != nil {
 			for _, c := range cbt.Combatants {
-				if c.Kind == combat.KindNPC && !c.IsDead() {
+				if c.IsHostileNPC() && !c.IsDead() {
 					techTargets = append(techTargets, c)
 				}
 			}
@@ -9314,7 +10068,7 @@ func (s *GameServiceServer) resolveUseTarget(uid, targetID string, tech *technol
 	if targetID == "" {
 		// Default: first living NPC combatant.
 		for _, c := range cbt.Combatants {
-			if c.Kind == combat.KindNPC && !c.IsDead() {
+			if c.IsHostileNPC() && !c.IsDead() {
 				return c, ""
 			}
 		}
@@ -9380,7 +10134,7 @@ func (s *GameServiceServer) activateTechWithEffectsAndHeighten(sess *session.Pla
 		}
 		sess.FocusPoints = next
 		if s.charSaver != nil {
-			if err := s.charSaver.SaveFocusPoints(context.Background(), sess.CharacterID, sess.FocusPoints); err != nil {
+			if err := s.charSaver.SaveFocusPoints(s.commandCtx(uid), sess.CharacterID, sess.FocusPoints); err != nil {
 				return nil, fmt.Errorf("persist focus points: %w", err)
 			}
 		}
@@ -9407,7 +10161,7 @@ func (s *GameServiceServer) activateTechWithEffectsAndHeighten(sess *session.Pla
 		}
 		if techDef.Targets == technology.TargetsAllEnemies && cbt != nil { //nolint:gocritic
 			for _, c := range cbt.Combatants {
-				if c.Kind == combat.KindNPC && !c.IsDead() {
+				if c.IsHostileNPC() && !c.IsDead() {
 					techTargets = append(techTargets, c)
 				}
 			}
@@ -9420,7 +10174,7 @@ func (s *GameServiceServer) activateTechWithEffectsAndHeighten(sess *session.Pla
 			// Find the default target from active combat.
 			if targetID == "" {
 				for _, c := range cbt.Combatants {
-					if c.Kind == combat.KindNPC && !c.IsDead() {
+					if c.IsHostileNPC() && !c.IsDead() {
 						techTargets = []*combat.Combatant{c}
 						break
 					}
@@ -9464,7 +10218,7 @@ func (s *GameServiceServer) activateTechWithEffectsWithCombat(sess *session.Play
 		}
 		sess.FocusPoints = next
 		if s.charSaver != nil {
-			if err := s.charSaver.SaveFocusPoints(context.Background(), sess.CharacterID, sess.FocusPoints); err != nil {
+			if err := s.charSaver.SaveFocusPoints(s.commandCtx(uid), sess.CharacterID, sess.FocusPoints); err != nil {
 				return nil, fmt.Errorf("persist focus points: %w", err)
 			}
 		}
@@ -9478,7 +10232,7 @@ func (s *GameServiceServer) activateTechWithEffectsWithCombat(sess *session.Play
 		techTargets, _ = resolveAoeCells(nil, techDef.AoeRadius, techDef.AoeLength, techDef.AoeWidth, targetX, targetY, cbt)
 	} else if techDef.Targets == technology.TargetsAllEnemies && cbt != nil {
 		for _, c := range cbt.Combatants {
-			if c.Kind == combat.KindNPC && !c.IsDead() {
+			if c.IsHostileNPC() && !c.IsDead() {
 				techTargets = append(techTargets, c)
 			}
 		}
@@ -9489,7 +10243,7 @@ func (s *GameServiceServer) activateTechWithEffectsWithCombat(sess *session.Play
 		// still require an NPC target for damage application.
 		if targetID == "" {
 			for _, c := range cbt.Combatants {
-				if c.Kind == combat.KindNPC && !c.IsDead() {
+				if c.IsHostileNPC() && !c.IsDead() {
 					techTargets = []*combat.Combatant{c}
 					break
 				}
@@ -9519,7 +10273,7 @@ func (s *GameServiceServer) handleAction(uid string, req *gamev1.ActionRequest)
 	if !ok {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	if err := s.actionH.Handle(ctx, sess, req.GetName(), req.GetTarget()); err != nil {
 		return nil, err
 	}
@@ -9899,54 +10653,50 @@ func (s *GameServiceServer) promptTechSlotChoice(
 }
 
 // recomputeBaseScores returns ability scores before any archetype/region boost choices are applied.
-// Computes: base 10 + region modifiers + job key ability (+2).
+// Computes: base 10 + region modifiers + heritage modifiers + job key ability (+2),
+// the same fixed sources character creation applies.
 //
-// Precondition: dbChar must not be nil.
-// Postcondition: Scores reflect only fixed sources (region modifiers and job key ability).
-func recomputeBaseScores(dbChar *character.Character, regions map[string]*ruleset.Region, jobReg *ruleset.JobRegistry) character.AbilityScores {
+// Precondition: dbChar must not be nil; heritages may be nil.
+// Postcondition: Scores reflect only fixed sources (region and heritage modifiers and job key ability).
+func recomputeBaseScores(dbChar *character.Character, regions map[string]*ruleset.Region, heritages map[string]*ruleset.Heritage, jobReg *ruleset.JobRegistry) character.AbilityScores {
 	base := character.AbilityScores{
 		Brutality: 10, Grit: 10, Quickness: 10,
 		Reasoning: 10, Savvy: 10, Flair: 10,
 	}
 	if region, ok := regions[dbChar.Region]; ok {
-		for ab, delta := range region.Modifiers {
-			switch ab {
-			case "brutality":
-				base.Brutality += delta
-			case "grit":
-				base.Grit += delta
-			case "quickness":
-				base.Quickness += delta
-			case "reasoning":
-				base.Reasoning += delta
-			case "savvy":
-				base.Savvy += delta
-			case "flair":
-				base.Flair += delta
-			}
-		}
+		addAbilityModifiers(&base, region.Modifiers)
+	}
+	if heritage, ok := heritages[dbChar.Heritage]; ok {
+		addAbilityModifiers(&base, heritage.Modifiers)
 	}
 	if jobReg != nil {
 		if job, ok := jobReg.Job(dbChar.Class); ok {
-			switch job.KeyAbility {
-			case "brutality":
-				base.Brutality += 2
-			case "grit":
-				base.Grit += 2
-			case "quickness":
-				base.Quickness += 2
-			case "reasoning":
-				base.Reasoning += 2
-			case "savvy":
-				base.Savvy += 2
-			case "flair":
-				base.Flair += 2
-			}
+			addAbilityModifiers(&base, map[string]int{job.KeyAbility: 2})
 		}
 	}
 	return base
 }
 
+// addAbilityModifiers adds each named ability modifier in mods to a; unknown names are ignored.
+func addAbilityModifiers(a *character.AbilityScores, mods map[string]int) {
+	for ab, delta := range mods {
+		switch ab {
+		case "brutality":
+			a.Brutality += delta
+		case "grit":
+			a.Grit += delta
+		case "quickness":
+			a.Quickness += delta
+		case "reasoning":
+			a.Reasoning += delta
+		case "savvy":
+			a.Savvy += delta
+		case "flair":
+			a.Flair += delta
+		}
+	}
+}
+
 // pushHPUpdate sends a CharacterInfo event to the player with the current
 // and maximum HP from the session. Used to keep the frontend prompt in sync
 // after any event that changes sess.CurrentHP or sess.MaxHP.
@@ -9999,7 +10749,7 @@ func nextSkillRank(currentRank string, level int) (nextRank string, gateLevel in
 	return s.next, 0, nil
 }
 
-// handleTrainSkill advances a skill proficiency rank for the player.
+// handleTrainSkill advances a skill, or a weapon proficiency category, rank for the player.
 //
 // Precondition: uid must identify an active session; skillID must be a valid skill ID.
 // Postcondition: if no pending skill increases, returns error message event;
@@ -10017,6 +10767,9 @@ func (s *GameServiceServer) handleTrainSkill(uid, skillID string) (*gamev1.Serve
 			},
 		}, nil
 	}
+	if command.IsWeaponCategory(skillID) {
+		return s.trainWeaponCategory(sess, skillID)
+	}
 
 	currentRank := sess.Skills[skillID]
 	if currentRank == "" {
@@ -10041,7 +10794,7 @@ func (s *GameServiceServer) handleTrainSkill(uid, skillID string) (*gamev1.Serve
 		}, nil
 	}
 
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	if s.characterSkillsRepo != nil {
 		if err := s.characterSkillsRepo.UpgradeSkill(ctx, sess.CharacterID, skillID, nextRank); err != nil {
 			s.logger.Warn("handleTrainSkill: UpgradeSkill failed", zap.Error(err))
@@ -10084,7 +10837,8 @@ func (s *GameServiceServer) handleTrainSkill(uid, skillID string) (*gamev1.Serve
 // Requires a shield equipped in the off-hand slot.
 //
 // Precondition: uid must identify a valid player session.
-// Postcondition: Applies shield_raised condition; in combat, deducts 1 AP and updates Combatant ACMod.
+// Postcondition: Applies shield_raised condition; in combat, deducts 1 AP, updates Combatant ACMod,
+// and sets the combatant's ShieldBlock to the shield's hardness (defaultShieldBlock when unset).
 func (s *GameServiceServer) handleRaiseShield(uid string) (*gamev1.ServerEvent, error) {
 	sess, ok := s.sessions.GetPlayer(uid)
 	if !ok {
@@ -10100,7 +10854,9 @@ func (s *GameServiceServer) handleRaiseShield(uid string) (*gamev1.ServerEvent,
 		return errorEvent("You must have a shield equipped in the off-hand slot to raise a shield."), nil
 	}
 
-	// In combat: spend 1 AP and update the combatant's ACMod.
+	// In combat: spend 1 AP, update the combatant's ACMod, and set the
+	// shield's per-round damage block.
+	block := 0
 	if sess.Status == statusInCombat {
 		if s.combatH == nil {
 			return errorEvent("Combat handler unavailable."), nil
@@ -10113,6 +10869,15 @@ func (s *GameServiceServer) handleRaiseShield(uid string) (*gamev1.ServerEvent,
 				zap.String("uid", uid), zap.Error(err))
 			return errorEvent(fmt.Sprintf("Failed to raise shield: %v", err)), nil
 		}
+		block = preset.OffHand.Def.Hardness
+		if block <= 0 {
+			block = defaultShieldBlock
+		}
+		if err := s.combatH.RaiseShield(uid, block); err != nil {
+			s.logger.Warn("handleRaiseShield: RaiseShield failed",
+				zap.String("uid", uid), zap.Error(err))
+			block = 0
+		}
 	}
 
 	// Apply the shield_raised condition to the player session.
@@ -10132,6 +10897,9 @@ func (s *GameServiceServer) handleRaiseShield(uid string) (*gamev1.ServerEvent,
 		}
 	}
 
+	if block > 0 {
+		return messageEvent(fmt.Sprintf("You raise your shield. (+2 AC and blocks %d damage until start of next turn)", block)), nil
+	}
 	return messageEvent("You raise your shield. (+2 AC until start of next turn)"), nil
 }
 
@@ -10283,15 +11051,19 @@ func (s *GameServiceServer) handleUncover(uid string) (*gamev1.ServerEvent, erro
 }
 
 // handleFirstAid performs a patch_job skill check (DC 15).
-// On success, heals 2d8+4 HP (self). Costs 2 AP in combat.
+// On success, heals 2d8+4 HP (self). Costs 2 AP in combat. When req names an
+// ally, the check instead tries to stabilize that dying ally.
 //
-// Precondition: uid must identify a valid player session.
+// Precondition: uid must identify a valid player session; req may be nil.
 // Postcondition: On skill check success, heals player HP and persists via charSaver.
-func (s *GameServiceServer) handleFirstAid(uid string) (*gamev1.ServerEvent, error) {
+func (s *GameServiceServer) handleFirstAid(uid string, req *gamev1.FirstAidRequest) (*gamev1.ServerEvent, error) {
 	sess, ok := s.sessions.GetPlayer(uid)
 	if !ok {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
+	if target := req.GetTarget(); target != "" {
+		return s.handleFirstAidStabilize(sess, target)
+	}
 
 	// In combat: spend 2 AP.
 	if sess.Status == statusInCombat {
@@ -10337,7 +11109,7 @@ func (s *GameServiceServer) handleFirstAid(uid string) (*gamev1.ServerEvent, err
 	}
 	sess.CurrentHP = newHP
 
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	if s.charSaver != nil {
 		if saveErr := s.charSaver.SaveState(ctx, sess.CharacterID, sess.RoomID, newHP); saveErr != nil {
 			s.logger.Warn("handleFirstAid: saving HP", zap.String("uid", uid), zap.Error(saveErr))
@@ -10683,6 +11455,10 @@ func (s *GameServiceServer) handleDisarm(uid string, req *gamev1.DisarmRequest)
 	}
 
 	if sess.Status != statusInCombat {
+		// Out of combat, disarm works on a detected trap instead.
+		if s.trapMgr != nil && req.GetTarget() != "" {
+			return s.handleDisarmTrap(uid, &gamev1.DisarmTrapRequest{TrapName: req.GetTarget()})
+		}
 		return errorEvent("Disarm is only available in combat."), nil
 	}
 
@@ -10808,18 +11584,18 @@ func (s *GameServiceServer) handleShove(uid string, req *gamev1.ShoveRequest) (*
 		pushFt = 10
 	}
 
-	if err := s.combatH.ShoveNPC(uid, inst.ID, pushFt); err != nil {
+	outcome := " — success! "
+	if pushFt == 10 {
+		outcome = " — critical success! "
+	}
+	move, err := s.combatH.ShoveNPC(uid, inst.ID, pushFt)
+	if err != nil {
 		s.logger.Warn("handleShove: ShoveNPC failed",
 			zap.String("npc_id", inst.ID), zap.Error(err))
-	} else {
-		s.combatH.FireCombatantMoved(sess.RoomID, inst.ID)
-		s.combatH.BroadcastAllPositions(sess.RoomID)
+		return messageEvent(detail + outcome + fmt.Sprintf("%s is pushed back %d ft.", inst.Name(), pushFt)), nil
 	}
-
-	if pushFt == 10 {
-		return messageEvent(detail + fmt.Sprintf(" — critical success! %s is shoved back 10 ft!", inst.Name())), nil
-	}
-	return messageEvent(detail + fmt.Sprintf(" — success! %s is pushed back 5 ft.", inst.Name())), nil
+	s.resolveForcedMove(sess.RoomID, inst.ID, move.ExitRoomID)
+	return messageEvent(detail + outcome + move.Narrative), nil
 }
 
 // handleStride moves the player their full speed in the requested direction.
@@ -10946,11 +11722,7 @@ func (s *GameServiceServer) handleStride(uid string, req *gamev1.StrideRequest)
 	for _, ev := range allRSEvents {
 		msg += "\n" + ev.Narrative
 	}
-	if room, ok := s.world.GetRoom(sess.RoomID); ok {
-		s.checkPressurePlateTraps(uid, sess, room)
-	}
-	s.combatH.FireCombatantMoved(sess.RoomID, uid)
-	s.combatH.BroadcastAllPositions(sess.RoomID)
+	s.settleCombatMove(sess.RoomID, uid)
 	return messageEvent(msg), nil
 }
 
@@ -11110,8 +11882,7 @@ func (s *GameServiceServer) handleMoveTo(uid string, req *gamev1.MoveToRequest)
 		}
 	}
 
-	s.combatH.FireCombatantMoved(sess.RoomID, uid)
-	s.combatH.BroadcastAllPositions(sess.RoomID)
+	s.settleCombatMove(sess.RoomID, uid)
 
 	msg := fmt.Sprintf("You move to (%d, %d) (%d ft).", targetX, targetY, dist*5)
 	for _, ev := range allRSEvents {
@@ -11373,11 +12144,7 @@ func (s *GameServiceServer) handleStep(uid string, req *gamev1.StepRequest) (*ga
 		dist = combat.CombatRange(*combatant, *opponent)
 	}
 	msg := fmt.Sprintf("You step %s. Distance to target: %d ft.", dir, dist)
-	if room, ok := s.world.GetRoom(sess.RoomID); ok {
-		s.checkPressurePlateTraps(uid, sess, room)
-	}
-	s.combatH.FireCombatantMoved(sess.RoomID, uid)
-	s.combatH.BroadcastAllPositions(sess.RoomID)
+	s.settleCombatMove(sess.RoomID, uid)
 	return messageEvent(msg), nil
 }
 
@@ -11769,6 +12536,9 @@ func (s *GameServiceServer) handleMotiveInCombat(detail string, outcome combat.O
 		if len(inst.Weaknesses) > 0 {
 			msg += "Weak to: " + formatResistanceMap(inst.Weaknesses) + "\n"
 		}
+		if len(inst.Immunities) > 0 {
+			msg += "Immune to: " + strings.Join(inst.Immunities, ", ") + "\n"
+		}
 		return messageEvent(strings.TrimRight(msg, "\n")), nil
 
 	case combat.Success:
@@ -12091,7 +12861,7 @@ func (s *GameServiceServer) handleHeroPointReroll(sess *session.PlayerSession) (
 	sess.LastCheckRoll = winner
 
 	if s.charSaver != nil && sess.CharacterID != 0 {
-		if hpErr := s.charSaver.SaveHeroPoints(context.Background(), sess.CharacterID, sess.HeroPoints); hpErr != nil {
+		if hpErr := s.charSaver.SaveHeroPoints(s.commandCtx(sess.UID), sess.CharacterID, sess.HeroPoints); hpErr != nil {
 			s.logger.Warn("handleHeroPointReroll: SaveHeroPoints failed", zap.Error(hpErr))
 		}
 	}
@@ -12102,13 +12872,18 @@ func (s *GameServiceServer) handleHeroPointReroll(sess *session.PlayerSession) (
 }
 
 // handleHeroPointStabilize spends 1 hero point to stabilize a dying player at 0 HP.
+// A player dying mid-fight instead loses the dying condition without gaining
+// wounded and comes to with 1 HP.
 //
-// Precondition: sess.HeroPoints >= 1; sess.Dead == true.
+// Precondition: sess.HeroPoints >= 1; sess.Dead == true or the player is dying in combat.
 // Postcondition: Dead cleared; CurrentHP set to 0; HeroPoints decremented; SaveHeroPoints called.
 func (s *GameServiceServer) handleHeroPointStabilize(sess *session.PlayerSession) (*gamev1.ServerEvent, error) {
 	if sess.HeroPoints < 1 {
 		return errorEvent("You have no hero points remaining."), nil
 	}
+	if s.combatH != nil && s.combatH.DyingValue(sess.UID) > 0 {
+		return s.heroicRecovery(sess)
+	}
 	if !sess.Dead {
 		return errorEvent("You are not dying — stabilize requires being at death's door."), nil
 	}
@@ -12118,7 +12893,7 @@ func (s *GameServiceServer) handleHeroPointStabilize(sess *session.PlayerSession
 	sess.HeroPoints--
 
 	if s.charSaver != nil && sess.CharacterID != 0 {
-		if hpErr := s.charSaver.SaveHeroPoints(context.Background(), sess.CharacterID, sess.HeroPoints); hpErr != nil {
+		if hpErr := s.charSaver.SaveHeroPoints(s.commandCtx(sess.UID), sess.CharacterID, sess.HeroPoints); hpErr != nil {
 			s.logger.Warn("handleHeroPointStabilize: SaveHeroPoints failed", zap.Error(hpErr))
 		}
 	}
@@ -12137,6 +12912,8 @@ func (s *GameServiceServer) respawnPlayer(uid string) {
 	if !ok {
 		return
 	}
+	s.tallyStat(sess, stats.Deaths, 1)
+	s.emitEvent(sess, telemetry.Event{Type: telemetry.TypeDeath, Amount: int64(sess.Level)})
 
 	// Resolve spawn room: use the zone start room for the player's current room.
 	spawnRoomID := ""
@@ -12172,7 +12949,7 @@ func (s *GameServiceServer) respawnPlayer(uid string) {
 		sess.Conditions.ClearAll()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := s.withCommandTimeout(context.Background())
 	defer cancel()
 
 	// Restore spontaneous tech use pools via DB then reload into session.
@@ -12797,9 +13574,6 @@ func (s *GameServiceServer) handleGrant(uid string, req *gamev1.GrantRequest) (*
 	if !ok {
 		return errorEvent("player not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 
 	// Handle item grant: drop item on editor's room floor (no target player needed).
 	if req.GrantType == "item" {
@@ -12826,7 +13600,7 @@ func (s *GameServiceServer) handleGrant(uid string, req *gamev1.GrantRequest) (*
 	// Handle money grant with empty char_name: give to all players in editor's room.
 	if req.GrantType == "money" && req.CharName == "" {
 		amount := int(req.Amount)
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		roomPlayers := s.sessions.PlayersInRoomDetails(sess.RoomID)
 		count := 0
 		for _, p := range roomPlayers {
@@ -12834,6 +13608,7 @@ func (s *GameServiceServer) handleGrant(uid string, req *gamev1.GrantRequest) (*
 				continue // skip the editor themselves
 			}
 			p.Currency += amount
+			s.emitCurrency(p, amount, telemetry.SourceAdminGrant)
 			if p.CharacterID > 0 && s.charSaver != nil {
 				if err := s.charSaver.SaveCurrency(ctx, p.CharacterID, p.Currency); err != nil {
 					s.logger.Warn("handleGrant: SaveCurrency failed", zap.Int64("char_id", p.CharacterID), zap.Error(err))
@@ -12851,7 +13626,7 @@ func (s *GameServiceServer) handleGrant(uid string, req *gamev1.GrantRequest) (*
 	}
 
 	amount := int(req.Amount)
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 
 	switch req.GrantType {
 	case "xp":
@@ -12899,7 +13674,7 @@ func (s *GameServiceServer) handleGrant(uid string, req *gamev1.GrantRequest) (*
 				levelMsgs = append(levelMsgs, "You earned 1 hero point!")
 				// REQ-BUG99-2: apply technology level-up grants via shared method so all
 				// grant paths (admin and organic) behave identically.
-				s.applyLevelUpTechGrants(ctx, target, oldLevel, result.NewLevel)
+				s.onLevelUp(ctx, target, oldLevel, result.NewLevel)
 			}
 			// Apply feat level-up grants for each level gained.
 			// Fixed feats are granted immediately; choice feats are auto-picked
@@ -12988,6 +13763,7 @@ func (s *GameServiceServer) handleGrant(uid string, req *gamev1.GrantRequest) (*
 
 	case "money":
 		target.Currency += amount
+		s.emitCurrency(target, amount, telemetry.SourceAdminGrant)
 		if s.charSaver != nil && target.CharacterID > 0 {
 			if cErr := s.charSaver.SaveCurrency(ctx, target.CharacterID, target.Currency); cErr != nil {
 				s.logger.Warn("handleGrant: SaveCurrency failed", zap.Error(cErr))
@@ -13379,57 +14155,36 @@ func (s *GameServiceServer) handleAid(uid string, req *gamev1.AidRequest) (*game
 	}, nil
 }
 
-// handleJoin joins an active combat in the player's pending room.
+// handleJoin joins the combat under way in the player's current room, whether
+// or not they were invited on arrival.
 //
 // Precondition: uid must identify an existing player session.
-// Postcondition: if PendingCombatJoin is non-empty and the combat exists, the player is
+// Postcondition: if the player's room has an active combat, the player is inserted
 //
-//	inserted into the combat, sess.Status is set to statusInCombat, and PendingCombatJoin is cleared.
+//	into it with initiative and this round's AP, sess.Status is set to statusInCombat,
+//	and PendingCombatJoin is cleared; a stale invitation is cleared otherwise.
 func (s *GameServiceServer) handleJoin(uid string, _ *gamev1.JoinRequest) (*gamev1.ServerEvent, error) {
 	sess, ok := s.sessions.GetPlayer(uid)
 	if !ok {
 		return nil, fmt.Errorf("player %q not found", uid)
 	}
 
-	s.combatH.combatMu.Lock()
-	roomID := sess.PendingCombatJoin
-	if roomID == "" {
-		s.combatH.combatMu.Unlock()
-		return messageEvent("No combat to join."), nil
-	}
-
-	_, exists := s.combatH.engine.GetCombat(roomID)
-	if !exists {
+	if _, exists := s.combatH.GetCombatForRoom(sess.RoomID); !exists {
+		s.combatH.combatMu.Lock()
+		pending := sess.PendingCombatJoin
 		sess.PendingCombatJoin = ""
 		s.combatH.combatMu.Unlock()
-		return messageEvent("The combat has ended."), nil
-	}
-	s.combatH.combatMu.Unlock()
-
-	// Build player combatant outside combatMu (RollInitiative modifies only the struct).
-	playerCbt := buildPlayerCombatant(sess, s.combatH)
-
-	// Roll initiative for just this player against existing combatants.
-	if s.dice != nil {
-		roll := s.dice.Src().Intn(20) + 1
-		playerCbt.Initiative = roll + playerCbt.DexMod
+		if pending != "" {
+			return messageEvent("The combat has ended."), nil
+		}
+		return messageEvent("No combat to join."), nil
 	}
 
-	// AddCombatant acquires engine.mu internally.
-	if err := s.combatH.engine.AddCombatant(roomID, playerCbt); err != nil {
+	initiative, ap, err := s.combatH.JoinCombat(uid)
+	if err != nil {
 		return errorEvent(fmt.Sprintf("Could not join combat: %v", err)), nil
 	}
-
-	s.combatH.combatMu.Lock()
-	sess.Status = statusInCombat
-	sess.PendingCombatJoin = ""
-	s.combatH.combatMu.Unlock()
-
-	s.broadcastMessage(roomID, uid, &gamev1.MessageEvent{
-		Content: fmt.Sprintf("%s joins the combat!", sess.CharName),
-	})
-
-	return messageEvent(fmt.Sprintf("You join the combat in %s!", roomID)), nil
+	return messageEvent(fmt.Sprintf("You join the fight (initiative %d) with %d AP this round.", initiative, ap)), nil
 }
 
 // handleDecline declines a pending combat join invitation.
@@ -0,0 +1,311 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// AchievementStore persists per-character achievement progress and titles,
+// and per-account progress toward account-wide achievements.
+type AchievementStore interface {
+	LoadProgress(ctx context.Context, characterID int64) (*achievement.Progress, error)
+	SaveProgress(ctx context.Context, characterID int64, achievementID string, progress int, unlockedAt time.Time) error
+	SaveTitle(ctx context.Context, characterID int64, title string) error
+	LoadAccountProgress(ctx context.Context, accountID int64) (*achievement.Progress, error)
+	SaveAccountProgress(ctx context.Context, accountID int64, achievementID string, progress int, unlockedAt time.Time) error
+}
+
+// loadAchievements attaches the character's achievement record to sess,
+// linked to its account's record of account-wide achievements, and credits
+// the level the character has already reached.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when achievements are not configured; otherwise
+// sess.Achievements is non-nil, and linked to an account record when
+// sess.AccountID > 0. Load failures are logged and start an empty record.
+func (s *GameServiceServer) loadAchievements(ctx context.Context, sess *session.PlayerSession) {
+	if s.achievements == nil {
+		return
+	}
+	if s.achievementStore != nil && sess.CharacterID > 0 {
+		p, err := s.achievementStore.LoadProgress(ctx, sess.CharacterID)
+		if err != nil {
+			s.logger.Warn("failed to load achievements on login",
+				zap.String("uid", sess.UID),
+				zap.Int64("character_id", sess.CharacterID),
+				zap.Error(err),
+			)
+		} else {
+			sess.Achievements = p
+		}
+	}
+	if sess.Achievements == nil {
+		sess.Achievements = achievement.NewProgress(nil, nil, "")
+	}
+	if account := s.accountAchievements(ctx, sess); account != nil {
+		sess.Achievements.LinkAccount(account)
+	}
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindLevel, Value: sess.Level})
+}
+
+// accountAchievements returns the record of account-wide achievements for
+// sess's account. Characters of one account online at once share a record,
+// so their progress is pooled as it happens.
+//
+// Postcondition: Returns nil when sess has no account. Load failures are
+// logged and start an empty record.
+func (s *GameServiceServer) accountAchievements(ctx context.Context, sess *session.PlayerSession) *achievement.Progress {
+	if sess.AccountID <= 0 {
+		return nil
+	}
+	for _, other := range s.sessions.AllPlayers() {
+		if other != sess && other.AccountID == sess.AccountID && other.Achievements != nil {
+			if account := other.Achievements.Account(); account != nil {
+				return account
+			}
+		}
+	}
+	if s.achievementStore != nil {
+		account, err := s.achievementStore.LoadAccountProgress(ctx, sess.AccountID)
+		if err == nil {
+			return account
+		}
+		s.logger.Warn("failed to load account achievements on login",
+			zap.String("uid", sess.UID),
+			zap.Int64("account_id", sess.AccountID),
+			zap.Error(err),
+		)
+	}
+	return achievement.NewProgress(nil, nil, "")
+}
+
+// recordAchievement reports ev to the achievement engine for sess, persisting
+// progress and announcing unlocks.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when achievements are not configured or not loaded for sess.
+func (s *GameServiceServer) recordAchievement(sess *session.PlayerSession, ev achievement.Event) {
+	updates := s.advanceAchievements(sess, ev)
+	s.saveAchievementUpdates(sess, updates)
+	s.announceAchievements(sess, updates)
+}
+
+// advanceAchievements applies ev to sess's in-memory achievement record.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: Returns nil when achievements are not configured or not loaded for sess.
+func (s *GameServiceServer) advanceAchievements(sess *session.PlayerSession, ev achievement.Event) []achievement.Update {
+	if s.achievements == nil || sess.Achievements == nil {
+		return nil
+	}
+	return s.achievements.Record(sess.Achievements, ev, time.Now())
+}
+
+// saveAchievementUpdates persists updates, logging failures.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when no AchievementStore is configured.
+func (s *GameServiceServer) saveAchievementUpdates(sess *session.PlayerSession, updates []achievement.Update) {
+	if s.achievementStore == nil {
+		return
+	}
+	shared := sess.Achievements != nil && sess.Achievements.Account() != nil
+	for _, u := range updates {
+		var err error
+		switch {
+		case u.Def.Account && shared:
+			err = s.achievementStore.SaveAccountProgress(context.Background(), sess.AccountID, u.Def.ID, u.Count, u.UnlockedAt)
+		case sess.CharacterID > 0:
+			err = s.achievementStore.SaveProgress(context.Background(), sess.CharacterID, u.Def.ID, u.Count, u.UnlockedAt)
+		}
+		if err != nil {
+			s.logger.Warn("saving achievement progress",
+				zap.String("uid", sess.UID),
+				zap.String("achievement", u.Def.ID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// announceAchievements tells sess about every unlock in updates. Account-wide
+// unlocks are also announced to the account's other characters online.
+//
+// Precondition: sess must be non-nil.
+func (s *GameServiceServer) announceAchievements(sess *session.PlayerSession, updates []achievement.Update) {
+	shared := sess.Achievements != nil && sess.Achievements.Account() != nil
+	for _, u := range updates {
+		if u.UnlockedAt.IsZero() {
+			continue
+		}
+		msg := fmt.Sprintf("Achievement unlocked: %s — %s", u.Def.Name, u.Def.Description)
+		if u.Def.Title != "" {
+			msg += fmt.Sprintf(" You may now use the title %q (see `title`).", u.Def.Title)
+		}
+		s.pushMessageToUID(sess.UID, msg)
+		if u.Def.Account && shared {
+			// The account's other characters online now share it too.
+			for _, other := range s.sessions.AllPlayers() {
+				if other != sess && other.AccountID == sess.AccountID {
+					s.pushMessageToUID(other.UID, fmt.Sprintf("%s earned an account achievement for all your characters. %s", sess.CharName, msg))
+				}
+			}
+		}
+	}
+}
+
+// recordKillAchievements credits sess with killing inst.
+//
+// Precondition: sess and inst must be non-nil.
+// Postcondition: Progress is advanced and unlocks announced immediately; the
+// writes are queued, since this runs with combatMu held and must not wait on
+// the database.
+func (s *GameServiceServer) recordKillAchievements(sess *session.PlayerSession, inst *npc.Instance) {
+	updates := s.advanceAchievements(sess, achievement.Event{Kind: achievement.KindKill, Target: inst.TemplateID})
+	if inst.Tier == "boss" {
+		updates = append(updates, s.advanceAchievements(sess, achievement.Event{Kind: achievement.KindBossKill, Target: inst.TemplateID})...)
+	}
+	if len(updates) == 0 {
+		return
+	}
+	if s.achievementStore != nil {
+		s.achievementSaves.push(func() { s.saveAchievementUpdates(sess, updates) })
+	}
+	s.announceAchievements(sess, updates)
+}
+
+// saveQueue runs writes queued from paths that must not block on the
+// database. A single worker drains it in order, so a later count never lands
+// before an earlier one. The zero value is ready to use.
+type saveQueue struct {
+	once sync.Once
+	ch   chan func()
+}
+
+// push queues fn, starting the worker on first use.
+//
+// Precondition: fn must be non-nil.
+// Postcondition: fn runs on the worker after every earlier queued fn.
+func (q *saveQueue) push(fn func()) {
+	q.once.Do(func() {
+		q.ch = make(chan func(), 256)
+		go func() {
+			for f := range q.ch {
+				f()
+			}
+		}()
+	})
+	q.ch <- fn
+}
+
+// onLevelUp applies everything a level-up grants: technology grants and level
+// achievements, and prompts the player for any feat choice it opens. It also
+// exports the level-up as a telemetry event.
+//
+// Precondition: sess non-nil; toLevel >= fromLevel.
+func (s *GameServiceServer) onLevelUp(ctx context.Context, sess *session.PlayerSession, fromLevel, toLevel int) {
+	s.applyLevelUpTechGrants(ctx, sess, fromLevel, toLevel)
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindLevel, Value: toLevel})
+	s.emitEvent(sess, telemetry.Event{Type: telemetry.TypeLevel, Amount: int64(toLevel)})
+	if evt, err := s.handleJobGrants(sess.UID); err == nil && len(evt.GetJobGrantsResponse().GetPendingFeatChoices()) > 0 {
+		s.pushMessageToUID(sess.UID, "You have a feat to choose! Type 'choosefeat' to see your options.")
+	}
+}
+
+// displayTitle returns the title sess displays, or "" for none.
+func displayTitle(sess *session.PlayerSession) string {
+	if sess == nil || sess.Achievements == nil {
+		return ""
+	}
+	return sess.Achievements.Title()
+}
+
+// titleEvent returns the silent prompt update carrying sess's title.
+func titleEvent(sess *session.PlayerSession) *gamev1.ServerEvent {
+	return &gamev1.ServerEvent{
+		Payload: &gamev1.ServerEvent_TitleUpdate{TitleUpdate: &gamev1.TitleEvent{Title: displayTitle(sess)}},
+	}
+}
+
+// handleAchievements lists every achievement with the player's progress.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: Returns a message event listing earned and pending achievements.
+func (s *GameServiceServer) handleAchievements(uid string) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.achievements == nil || sess.Achievements == nil {
+		return messageEvent("Achievements are not available."), nil
+	}
+	var b strings.Builder
+	earned := 0
+	for _, d := range s.achievements.All() {
+		if _, done := sess.Achievements.UnlockedAt(d.ID); done {
+			earned++
+			fmt.Fprintf(&b, "\n  [x] %s — %s", d.Name, d.Description)
+		} else {
+			fmt.Fprintf(&b, "\n  [ ] %s — %s (%d/%d)", d.Name, d.Description, min(sess.Achievements.Count(d.ID), d.Count), d.Count)
+		}
+		if d.Title != "" {
+			fmt.Fprintf(&b, " [title: %s]", d.Title)
+		}
+		if d.Account {
+			b.WriteString(" [account]")
+		}
+	}
+	return messageEvent(fmt.Sprintf("Achievements (%d/%d earned):%s", earned, len(s.achievements.All()), b.String())), nil
+}
+
+// handleTitle lists the player's earned titles, or selects or clears the displayed title.
+//
+// Precondition: uid must identify an existing player session; req must be non-nil.
+// Postcondition: On a change the title is persisted, and the prompt and
+// character sheet are refreshed.
+func (s *GameServiceServer) handleTitle(uid string, req *gamev1.TitleRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.achievements == nil || sess.Achievements == nil {
+		return messageEvent("Titles are not available."), nil
+	}
+	if req.GetTitle() == "" && !req.GetClear() {
+		titles := s.achievements.Titles(sess.Achievements)
+		if len(titles) == 0 {
+			return messageEvent("You have not earned any titles yet. See `achievements`."), nil
+		}
+		current := sess.Achievements.Title()
+		if current == "" {
+			current = "none"
+		}
+		return messageEvent(fmt.Sprintf("Your titles: %s\nDisplaying: %s", strings.Join(titles, ", "), current)), nil
+	}
+	if err := s.achievements.SetTitle(sess.Achievements, req.GetTitle()); err != nil {
+		return errorEvent(err.Error()), nil
+	}
+	title := sess.Achievements.Title()
+	if s.achievementStore != nil && sess.CharacterID > 0 {
+		if err := s.achievementStore.SaveTitle(s.commandCtx(uid), sess.CharacterID, title); err != nil {
+			s.logger.Warn("saving title", zap.String("uid", uid), zap.Error(err))
+		}
+	}
+	s.pushEventToUID(uid, titleEvent(sess))
+	s.pushCharacterSheet(sess)
+	if title == "" {
+		return messageEvent("You no longer display a title."), nil
+	}
+	return messageEvent(fmt.Sprintf("You are now known as %s %s.", sess.CharName, title)), nil
+}
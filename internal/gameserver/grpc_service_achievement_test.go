@@ -0,0 +1,340 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+type memAchievementStore struct {
+	counts        map[string]int
+	unlocked      map[string]time.Time
+	title         string
+	accountCounts map[string]int
+}
+
+func newMemAchievementStore() *memAchievementStore {
+	return &memAchievementStore{counts: map[string]int{}, unlocked: map[string]time.Time{}, accountCounts: map[string]int{}}
+}
+
+func (m *memAchievementStore) LoadProgress(context.Context, int64) (*achievement.Progress, error) {
+	return achievement.NewProgress(m.counts, m.unlocked, m.title), nil
+}
+
+func (m *memAchievementStore) SaveProgress(_ context.Context, _ int64, id string, progress int, unlockedAt time.Time) error {
+	m.counts[id] = progress
+	if !unlockedAt.IsZero() {
+		m.unlocked[id] = unlockedAt
+	}
+	return nil
+}
+
+func (m *memAchievementStore) SaveTitle(_ context.Context, _ int64, title string) error {
+	m.title = title
+	return nil
+}
+
+func (m *memAchievementStore) LoadAccountProgress(context.Context, int64) (*achievement.Progress, error) {
+	return achievement.NewProgress(m.accountCounts, nil, ""), nil
+}
+
+func (m *memAchievementStore) SaveAccountProgress(_ context.Context, _ int64, id string, progress int, _ time.Time) error {
+	m.accountCounts[id] = progress
+	return nil
+}
+
+// newAchievementTestServer builds a server with one level-1 player, "ace"
+// (character 1), and achievements for a first kill, three boss kills, two
+// rooms explored in zone "z", and reaching level 3.
+func newAchievementTestServer(t *testing.T) (*GameServiceServer, *memAchievementStore) {
+	t.Helper()
+	reg, err := achievement.NewRegistry([]*achievement.Definition{
+		{ID: "first_blood", Name: "First Blood", Description: "Kill an enemy.", Event: achievement.KindKill, Count: 1},
+		{ID: "boss_hunter", Name: "Boss Hunter", Description: "Defeat three bosses.", Event: achievement.KindBossKill, Count: 3, Title: "the Boss Hunter"},
+		{ID: "wanderer", Name: "Wanderer", Description: "Explore two rooms.", Event: achievement.KindExplore, Target: "z", Count: 2, Title: "the Wanderer"},
+		{ID: "seasoned", Name: "Seasoned", Description: "Reach level 3.", Event: achievement.KindLevel, Count: 3},
+	})
+	require.NoError(t, err)
+
+	room := &world.Room{ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza."}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza",
+		Rooms: map[string]*world.Room{"plaza": room}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	_, err = sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ace", Username: "ace", CharName: "Ace", CharacterID: 1, Level: 1,
+		RoomID: "plaza", CurrentHP: 10, MaxHP: 10,
+		Abilities: character.AbilityScores{}, Role: "player",
+	})
+	require.NoError(t, err)
+
+	store := newMemAchievementStore()
+	s := &GameServiceServer{
+		sessions:         sMgr,
+		world:            wMgr,
+		logger:           zap.NewNop(),
+		chatH:            NewChatHandler(sMgr),
+		achievements:     reg,
+		achievementStore: store,
+	}
+	return s, store
+}
+
+// drainTitleEvents returns the titles carried by TitleEvents pushed to sess.
+func drainTitleEvents(t *testing.T, sess *session.PlayerSession) []string {
+	t.Helper()
+	var titles []string
+	for {
+		select {
+		case data := <-sess.Entity.Events():
+			var evt gamev1.ServerEvent
+			if err := proto.Unmarshal(data, &evt); err != nil {
+				continue
+			}
+			if tu := evt.GetTitleUpdate(); tu != nil {
+				titles = append(titles, tu.GetTitle())
+			}
+		default:
+			return titles
+		}
+	}
+}
+
+func TestLoadAchievements_RestoresStoreAndCreditsLevel(t *testing.T) {
+	s, store := newAchievementTestServer(t)
+	store.unlocked["wanderer"] = time.Now()
+	store.counts["wanderer"] = 2
+	store.title = "the Wanderer"
+	sess := player(t, s, "ace")
+	sess.Level = 4
+
+	s.loadAchievements(context.Background(), sess)
+	require.NotNil(t, sess.Achievements)
+	assert.Equal(t, "the Wanderer", displayTitle(sess))
+	_, ok := sess.Achievements.UnlockedAt("seasoned")
+	assert.True(t, ok, "a character already past the level is credited at login")
+	assert.Contains(t, store.unlocked, "seasoned")
+	msgs := drainEntityMessages(t, sess)
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0], "Achievement unlocked: Seasoned")
+}
+
+func TestRecordAchievement_NotLoadedIsNoOp(t *testing.T) {
+	s, store := newAchievementTestServer(t)
+	sess := player(t, s, "ace")
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindKill})
+	assert.Empty(t, store.counts)
+	assert.Empty(t, drainEntityMessages(t, sess))
+}
+
+func TestRecordKillAchievements_CountsBossesSeparately(t *testing.T) {
+	s, store := newAchievementTestServer(t)
+	sess := player(t, s, "ace")
+	s.loadAchievements(context.Background(), sess)
+
+	s.recordKillAchievements(sess, &npc.Instance{TemplateID: "ganger"})
+	msgs := drainEntityMessages(t, sess)
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0], "First Blood")
+	assert.Zero(t, store.counts["boss_hunter"])
+
+	for i := 0; i < 3; i++ {
+		s.recordKillAchievements(sess, &npc.Instance{TemplateID: "kingpin", Tier: "boss"})
+	}
+	waitAchievementSaves(s)
+	assert.Equal(t, 3, store.counts["boss_hunter"])
+	assert.Contains(t, store.unlocked, "boss_hunter")
+	msgs = drainEntityMessages(t, sess)
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0], `"the Boss Hunter"`)
+}
+
+// waitAchievementSaves blocks until every achievement write queued so far has run.
+func waitAchievementSaves(s *GameServiceServer) {
+	done := make(chan struct{})
+	s.achievementSaves.push(func() { close(done) })
+	<-done
+}
+
+// blockingAchievementStore holds every SaveProgress until release is closed.
+type blockingAchievementStore struct {
+	*memAchievementStore
+	release chan struct{}
+}
+
+func (b *blockingAchievementStore) SaveProgress(ctx context.Context, id int64, achievementID string, progress int, unlockedAt time.Time) error {
+	<-b.release
+	return b.memAchievementStore.SaveProgress(ctx, id, achievementID, progress, unlockedAt)
+}
+
+func TestRecordKillAchievements_DoesNotWaitOnTheStore(t *testing.T) {
+	s, mem := newAchievementTestServer(t)
+	sess := player(t, s, "ace")
+	s.loadAchievements(context.Background(), sess)
+	store := &blockingAchievementStore{memAchievementStore: mem, release: make(chan struct{})}
+	s.achievementStore = store
+
+	done := make(chan struct{})
+	go func() {
+		s.recordKillAchievements(sess, &npc.Instance{TemplateID: "ganger"})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("recordKillAchievements blocked on a slow store")
+	}
+	msgs := drainEntityMessages(t, sess)
+	require.Len(t, msgs, 1, "the unlock is announced before it is saved")
+	assert.Contains(t, msgs[0], "First Blood")
+
+	close(store.release)
+	waitAchievementSaves(s)
+	assert.Contains(t, mem.unlocked, "first_blood")
+}
+
+func TestHandleAchievements_ListsProgress(t *testing.T) {
+	s, _ := newAchievementTestServer(t)
+	sess := player(t, s, "ace")
+	s.loadAchievements(context.Background(), sess)
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindKill})
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindExplore, Target: "z"})
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindExplore, Target: "elsewhere"})
+
+	evt, err := s.handleAchievements("ace")
+	require.NoError(t, err)
+	text := evt.GetMessage().GetContent()
+	assert.Contains(t, text, "Achievements (1/4 earned):")
+	assert.Contains(t, text, "[x] First Blood")
+	assert.Contains(t, text, "[ ] Wanderer — Explore two rooms. (1/2) [title: the Wanderer]")
+	assert.Contains(t, text, "[ ] Seasoned — Reach level 3. (1/3)")
+}
+
+func TestHandleTitle_SetListAndClear(t *testing.T) {
+	s, store := newAchievementTestServer(t)
+	sess := player(t, s, "ace")
+	s.loadAchievements(context.Background(), sess)
+
+	evt, err := s.handleTitle("ace", &gamev1.TitleRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "not earned any titles")
+
+	evt, err = s.handleTitle("ace", &gamev1.TitleRequest{Title: "the Wanderer"})
+	require.NoError(t, err)
+	require.NotNil(t, evt.GetError())
+	assert.Equal(t, "", store.title)
+
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindExplore, Target: "z"})
+	s.recordAchievement(sess, achievement.Event{Kind: achievement.KindExplore, Target: "z"})
+	drainEntityMessages(t, sess)
+
+	evt, err = s.handleTitle("ace", &gamev1.TitleRequest{Title: "THE WANDERER"})
+	require.NoError(t, err)
+	assert.Equal(t, "You are now known as Ace the Wanderer.", evt.GetMessage().GetContent())
+	assert.Equal(t, "the Wanderer", store.title)
+	assert.Equal(t, []string{"the Wanderer"}, drainTitleEvents(t, sess))
+
+	list, err := s.chatH.Who("ace")
+	require.NoError(t, err)
+	require.Len(t, list.Players, 1)
+	assert.Equal(t, "the Wanderer", list.Players[0].Title)
+
+	evt, err = s.handleTitle("ace", &gamev1.TitleRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "Your titles: the Wanderer\nDisplaying: the Wanderer", evt.GetMessage().GetContent())
+
+	evt, err = s.handleTitle("ace", &gamev1.TitleRequest{Clear: true})
+	require.NoError(t, err)
+	assert.Equal(t, "You no longer display a title.", evt.GetMessage().GetContent())
+	assert.Equal(t, "", store.title)
+	assert.Equal(t, []string{""}, drainTitleEvents(t, sess))
+}
+
+func TestHandleTitle_Unavailable(t *testing.T) {
+	s, _ := newAchievementTestServer(t)
+	s.achievements = nil
+	evt, err := s.handleTitle("ace", &gamev1.TitleRequest{Title: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, "Titles are not available.", evt.GetMessage().GetContent())
+}
+
+// TestProperty_RecordAchievement_StoreMatchesSession verifies that after any
+// sequence of events the persisted progress equals the in-memory progress.
+func TestProperty_RecordAchievement_StoreMatchesSession(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s, store := newAchievementTestServer(t)
+		sess := player(t, s, "ace")
+		s.loadAchievements(context.Background(), sess)
+		kinds := []achievement.Kind{achievement.KindKill, achievement.KindBossKill, achievement.KindExplore, achievement.KindLevel}
+		n := rapid.IntRange(0, 30).Draw(rt, "n")
+		for i := 0; i < n; i++ {
+			ev := achievement.Event{
+				Kind:   kinds[rapid.IntRange(0, len(kinds)-1).Draw(rt, "kind")],
+				Target: rapid.SampledFrom([]string{"z", "y"}).Draw(rt, "target"),
+				Value:  rapid.IntRange(1, 5).Draw(rt, "value"),
+			}
+			s.recordAchievement(sess, ev)
+		}
+		for _, d := range s.achievements.All() {
+			if got, want := store.counts[d.ID], sess.Achievements.Count(d.ID); got != want {
+				rt.Fatalf("%s: stored %d, session %d", d.ID, got, want)
+			}
+			_, stored := store.unlocked[d.ID]
+			_, live := sess.Achievements.UnlockedAt(d.ID)
+			if stored != live {
+				rt.Fatalf("%s: stored unlocked %v, session %v", d.ID, stored, live)
+			}
+		}
+	})
+}
+
+func TestAccountAchievements_SharedByOnlineCharacters(t *testing.T) {
+	reg, err := achievement.NewRegistry([]*achievement.Definition{
+		{ID: "old_hand", Name: "Old Hand", Description: "Kill two enemies.", Event: achievement.KindKill, Count: 2, Title: "the Old Hand", Account: true},
+	})
+	require.NoError(t, err)
+	s, store := newAchievementTestServer(t)
+	s.achievements = reg
+	store.accountCounts["old_hand"] = 1
+	ace := player(t, s, "ace")
+	ace.AccountID = 7
+	_, err = s.sessions.AddPlayer(session.AddPlayerOptions{
+		UID: "alt", Username: "ace", CharName: "Alt", CharacterID: 2, AccountID: 7, Level: 1,
+		RoomID: "plaza", CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	alt := player(t, s, "alt")
+
+	s.loadAchievements(context.Background(), ace)
+	s.loadAchievements(context.Background(), alt)
+	require.Same(t, ace.Achievements.Account(), alt.Achievements.Account())
+	assert.Equal(t, 1, alt.Achievements.Count("old_hand"), "progress from other characters carries over")
+
+	s.recordAchievement(alt, achievement.Event{Kind: achievement.KindKill})
+	assert.Equal(t, 2, store.accountCounts["old_hand"])
+	assert.Empty(t, store.counts, "account-wide progress is not saved per character")
+	_, ok := ace.Achievements.UnlockedAt("old_hand")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"the Old Hand"}, reg.Titles(ace.Achievements))
+	msgs := drainEntityMessages(t, ace)
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0], "Alt earned an account achievement")
+
+	evt, err := s.handleAchievements("ace")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "[x] Old Hand — Kill two enemies. [title: the Old Hand] [account]")
+}
@@ -92,6 +92,18 @@ func (a *playerActivateAdapter) ApplyHeal(amount int) {
 	}
 }
 
+// ApplyTempHP grants temporary hit points to the player's combatant.
+// Temporary HP only exists in combat; out of combat it returns 0.
+func (a *playerActivateAdapter) ApplyTempHP(amount int) int {
+	if a.sess.Status != statusInCombat || a.svc.combatH == nil {
+		return 0
+	}
+	if err := a.svc.combatH.GrantTempHP(a.sess.UID, amount); err != nil {
+		return 0
+	}
+	return amount
+}
+
 // ApplyCondition applies a condition with the given duration to the player.
 // Duration <= 0 is treated as a permanent/encounter-scoped condition (stacks=1, duration=-1).
 func (a *playerActivateAdapter) ApplyCondition(conditionID string, duration time.Duration) {
@@ -210,7 +222,7 @@ func (s *GameServiceServer) handleActivate(uid string, req *gamev1.ActivateItemR
 
 	// REQ-ACT-17: persist charge state BEFORE removing item from slot.
 	if s.charSaver != nil {
-		if err := s.persistChargeState(context.Background(), sess, result.ItemDefID); err != nil {
+		if err := s.persistChargeState(s.commandCtx(uid), sess, result.ItemDefID); err != nil {
 			s.logger.Warn("handleActivate: failed to persist charge state",
 				zap.String("uid", uid),
 				zap.String("item", result.ItemDefID),
@@ -267,7 +279,7 @@ func (s *GameServiceServer) persistChargeState(ctx context.Context, sess *sessio
 // removeEquippedItem removes a destroyed item from equipped slots (active preset only) and persists.
 // REQ-ACT-18/19: only the ACTIVE weapon preset slot is cleared; other presets are untouched.
 func (s *GameServiceServer) removeEquippedItem(sess *session.PlayerSession, itemDefID string) {
-	ctx := context.Background()
+	ctx := s.commandCtx(sess.UID)
 	if sess.LoadoutSet != nil && sess.LoadoutSet.Active < len(sess.LoadoutSet.Presets) {
 		active := sess.LoadoutSet.Presets[sess.LoadoutSet.Active]
 		if active != nil {
@@ -8,7 +8,6 @@ package gameserver
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -16,6 +15,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // AdminListSessions (REQ-AGA-4) returns a snapshot of all active player sessions.
@@ -236,7 +236,7 @@ func (s *GameServiceServer) AdminTeleportPlayer(ctx context.Context, req *gamev1
 
 	// Persist location immediately.
 	if target.CharacterID > 0 && s.charSaver != nil {
-		saveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		saveCtx, cancel := s.withCommandTimeout(ctx)
 		defer cancel()
 		if err := s.charSaver.SaveState(saveCtx, target.CharacterID, targetRoom.ID, target.CurrentHP); err != nil {
 			s.logger.Warn("persisting admin teleport location",
@@ -306,7 +306,7 @@ func (s *GameServiceServer) AdminGiveItem(ctx context.Context, req *gamev1.Admin
 		return nil, status.Errorf(codes.Internal, "failed to add item: %v", err)
 	}
 	if s.charSaver != nil && target.CharacterID > 0 {
-		saveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		saveCtx, cancel := s.withCommandTimeout(ctx)
 		defer cancel()
 		invItems := backpackToInventoryItems(target.Backpack)
 		if err := s.charSaver.SaveInventory(saveCtx, target.CharacterID, invItems); err != nil {
@@ -335,8 +335,9 @@ func (s *GameServiceServer) AdminGiveCurrency(ctx context.Context, req *gamev1.A
 		return nil, status.Errorf(codes.InvalidArgument, "amount must be >= 1")
 	}
 	target.Currency += amount
+	s.emitCurrency(target, amount, telemetry.SourceAdminGrant)
 	if s.charSaver != nil && target.CharacterID > 0 {
-		saveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		saveCtx, cancel := s.withCommandTimeout(ctx)
 		defer cancel()
 		if err := s.charSaver.SaveCurrency(saveCtx, target.CharacterID, target.Currency); err != nil {
 			s.logger.Warn("AdminGiveCurrency: SaveCurrency failed",
@@ -0,0 +1,174 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// AdminAuditStore persists and queries the admin command audit trail.
+//
+// Precondition: Implementations must be safe for concurrent use.
+type AdminAuditStore interface {
+	Record(ctx context.Context, e postgres.AdminAuditEntry) error
+	List(ctx context.Context, q postgres.AdminAuditQuery) ([]postgres.AdminAuditEntry, error)
+}
+
+// auditedAdminCommand classifies msg as a privileged command subject to auditing.
+//
+// Postcondition: ok is false for every non-privileged payload; otherwise action
+// is the command name, target the affected entity (may be empty), and req the
+// request message whose fields are recorded as arguments.
+func auditedAdminCommand(msg *gamev1.ClientMessage) (action, target string, req proto.Message, ok bool) {
+	switch p := msg.GetPayload().(type) {
+	case *gamev1.ClientMessage_SetRole:
+		return "setrole", p.SetRole.GetTargetUsername(), p.SetRole, true
+	case *gamev1.ClientMessage_Teleport:
+		return "teleport", p.Teleport.GetTargetCharacter(), p.Teleport, true
+	case *gamev1.ClientMessage_SummonItem:
+		return "summon_item", p.SummonItem.GetItemId(), p.SummonItem, true
+	case *gamev1.ClientMessage_Grant:
+		return "grant", p.Grant.GetCharName(), p.Grant, true
+	case *gamev1.ClientMessage_SpawnNpc:
+		return "spawn_npc", p.SpawnNpc.GetTemplateId(), p.SpawnNpc, true
+	case *gamev1.ClientMessage_KillNpcRequest:
+		return "kill_npc", p.KillNpcRequest.GetTemplateId(), p.KillNpcRequest, true
+	case *gamev1.ClientMessage_SpawnCharRequest:
+		return "spawn_char", p.SpawnCharRequest.GetName(), p.SpawnCharRequest, true
+	case *gamev1.ClientMessage_DeleteCharRequest:
+		return "delete_char", p.DeleteCharRequest.GetName(), p.DeleteCharRequest, true
+	case *gamev1.ClientMessage_RoomEquip:
+		return "roomequip", p.RoomEquip.GetItemId(), p.RoomEquip, true
+	case *gamev1.ClientMessage_ChatMute:
+		return "chatmute", p.ChatMute.GetTarget(), p.ChatMute, true
+	case *gamev1.ClientMessage_Rename:
+		return "rename", p.Rename.GetTarget(), p.Rename, true
+	case *gamev1.ClientMessage_CharacterSlots:
+		return "charslots", p.CharacterSlots.GetUsername(), p.CharacterSlots, true
+	case *gamev1.ClientMessage_TransferCharacter:
+		return "transfer", p.TransferCharacter.GetTarget(), p.TransferCharacter, true
+	case *gamev1.ClientMessage_ExportCharacter:
+		return "exportchar", p.ExportCharacter.GetTarget(), p.ExportCharacter, true
+	case *gamev1.ClientMessage_SiteBan:
+		return "siteban", p.SiteBan.GetCidr(), p.SiteBan, true
+	case *gamev1.ClientMessage_Invis:
+		return "invis", "", p.Invis, true
+	case *gamev1.ClientMessage_Snoop:
+		return "snoop", p.Snoop.GetTarget(), p.Snoop, true
+	case *gamev1.ClientMessage_Force:
+		return "force", p.Force.GetTarget(), p.Force, true
+	case *gamev1.ClientMessage_Puppet:
+		return "puppet", p.Puppet.GetTarget(), p.Puppet, true
+	case *gamev1.ClientMessage_Gm:
+		return "gm", p.Gm.GetTarget(), p.Gm, true
+	case *gamev1.ClientMessage_Schedule:
+		if p.Schedule.GetAction() != "run" {
+			return "", "", nil, false
+		}
+		return "schedule_run", p.Schedule.GetEventId(), p.Schedule, true
+	case *gamev1.ClientMessage_ReloadConfig:
+		return "reload_config", "", p.ReloadConfig, true
+	case *gamev1.ClientMessage_Flag:
+		if p.Flag.GetAction() == "list" {
+			return "", "", nil, false
+		}
+		return "flag", p.Flag.GetFlag(), p.Flag, true
+	}
+	return "", "", nil, false
+}
+
+// auditAdminCommand records msg in the admin audit trail when it is a
+// privileged command, including attempts the server refused.
+//
+// Precondition: resp and err are the results of dispatching msg for uid.
+// Postcondition: Non-privileged commands are ignored; the entry is always
+// logged, and persisted when an audit store is configured. Persistence
+// failures are logged and never returned.
+func (s *GameServiceServer) auditAdminCommand(uid string, msg *gamev1.ClientMessage, resp *gamev1.ServerEvent, err error) {
+	action, target, req, ok := auditedAdminCommand(msg)
+	if !ok {
+		return
+	}
+	entry := postgres.AdminAuditEntry{Actor: uid, Action: action, Target: target, Outcome: "ok"}
+	if sess, found := s.sessions.GetPlayer(uid); found {
+		entry.ActorAccountID = sess.AccountID
+		if sess.Username != "" {
+			entry.Actor = sess.Username
+		}
+	}
+	switch {
+	case err != nil:
+		entry.Outcome = err.Error()
+	case resp.GetError() != nil:
+		entry.Outcome = resp.GetError().GetMessage()
+	}
+	if args, mErr := protojson.Marshal(req); mErr == nil {
+		entry.Args = string(args)
+	}
+	s.logger.Info("admin action",
+		zap.String("actor", entry.Actor),
+		zap.String("action", entry.Action),
+		zap.String("target", entry.Target),
+		zap.String("args", entry.Args),
+		zap.String("outcome", entry.Outcome))
+	if s.adminAudit == nil {
+		return
+	}
+	ctx, cancel := s.withCommandTimeout(context.Background())
+	defer cancel()
+	if rErr := s.adminAudit.Record(ctx, entry); rErr != nil {
+		s.logger.Warn("recording admin audit entry", zap.String("action", action), zap.Error(rErr))
+	}
+}
+
+// handleAudit lists recent admin audit entries.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanViewAuditLog (checked by dispatch); req must be non-nil.
+// Postcondition: Returns an error event unless an audit store is configured;
+// otherwise one line per entry, newest first.
+func (s *GameServiceServer) handleAudit(uid string, req *gamev1.AuditRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.adminAudit == nil {
+		return errorEvent("the admin audit log is not available"), nil
+	}
+	ctx := s.commandCtx(uid)
+	entries, err := s.adminAudit.List(ctx, postgres.AdminAuditQuery{Subject: req.GetSubject(), Limit: int(req.GetLimit())})
+	if err != nil {
+		s.logger.Warn("listing admin audit entries", zap.Error(err))
+		return errorEvent("failed to read the admin audit log"), nil
+	}
+	if len(entries) == 0 {
+		return messageEvent("No admin actions recorded."), nil
+	}
+	var b strings.Builder
+	b.WriteString("Recent admin actions:")
+	for _, e := range entries {
+		b.WriteString("\n" + formatAdminAuditEntry(e))
+	}
+	return messageEvent(b.String()), nil
+}
+
+// formatAdminAuditEntry renders e as a single audit log line.
+func formatAdminAuditEntry(e postgres.AdminAuditEntry) string {
+	line := fmt.Sprintf("%s  %s  %s", e.CreatedAt.UTC().Format("2006-01-02 15:04:05"), e.Actor, e.Action)
+	if e.Target != "" {
+		line += " " + e.Target
+	}
+	if e.Args != "" && e.Args != "{}" {
+		line += " " + e.Args
+	}
+	if e.Outcome != "ok" {
+		line += "  [" + e.Outcome + "]"
+	}
+	return line
+}
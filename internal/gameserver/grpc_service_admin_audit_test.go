@@ -0,0 +1,144 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// fakeAdminAuditStore is an in-memory AdminAuditStore.
+type fakeAdminAuditStore struct {
+	mu      sync.Mutex
+	entries []postgres.AdminAuditEntry
+	listErr error
+	lastQ   postgres.AdminAuditQuery
+}
+
+func (f *fakeAdminAuditStore) Record(_ context.Context, e postgres.AdminAuditEntry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e.CreatedAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	f.entries = append(f.entries, e)
+	return nil
+}
+
+func (f *fakeAdminAuditStore) List(_ context.Context, q postgres.AdminAuditQuery) ([]postgres.AdminAuditEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lastQ = q
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	out := make([]postgres.AdminAuditEntry, 0, len(f.entries))
+	for i := len(f.entries) - 1; i >= 0; i-- {
+		out = append(out, f.entries[i])
+	}
+	return out, nil
+}
+
+func TestAuditAdminCommand_RecordsPrivilegedCommands(t *testing.T) {
+	store := &fakeAdminAuditStore{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.adminAudit = store
+	sess := addPlayerForCombatDefault(t, svc, "admin", 1)
+	sess.AccountID = 42
+
+	msg := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SetRole{
+		SetRole: &gamev1.SetRoleRequest{TargetUsername: "bob", Role: "editor"},
+	}}
+	svc.auditAdminCommand("admin", msg, messageEvent("done"), nil)
+
+	require.Len(t, store.entries, 1)
+	e := store.entries[0]
+	assert.Equal(t, int64(42), e.ActorAccountID)
+	assert.Equal(t, "admin", e.Actor)
+	assert.Equal(t, "setrole", e.Action)
+	assert.Equal(t, "bob", e.Target)
+	assert.JSONEq(t, `{"targetUsername":"bob","role":"editor"}`, e.Args)
+	assert.Equal(t, "ok", e.Outcome)
+}
+
+func TestAuditAdminCommand_RecordsRefusalsAndErrors(t *testing.T) {
+	store := &fakeAdminAuditStore{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.adminAudit = store
+	addPlayerForCombatDefault(t, svc, "p", 1)
+
+	tp := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Teleport{
+		Teleport: &gamev1.TeleportRequest{TargetCharacter: "x", RoomId: "room_b"},
+	}}
+	svc.auditAdminCommand("p", tp, errorEvent("permission denied: admin role required"), nil)
+	svc.auditAdminCommand("p", tp, nil, errors.New("boom"))
+
+	require.Len(t, store.entries, 2)
+	assert.Equal(t, "permission denied: admin role required", store.entries[0].Outcome)
+	assert.Equal(t, "boom", store.entries[1].Outcome)
+}
+
+func TestAuditAdminCommand_IgnoresOrdinaryCommands(t *testing.T) {
+	store := &fakeAdminAuditStore{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.adminAudit = store
+	addPlayerForCombatDefault(t, svc, "p", 1)
+
+	svc.auditAdminCommand("p", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Say{Say: &gamev1.SayRequest{Message: "hi"}}}, nil, nil)
+	assert.Empty(t, store.entries)
+}
+
+func TestHandleAudit_RequiresAdmin(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	svc.adminAudit = &fakeAdminAuditStore{}
+	addPlayerForCombatDefault(t, svc, "p", 1)
+
+	evt, err := svc.dispatch("p", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Audit{Audit: &gamev1.AuditRequest{}}})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "permission denied")
+}
+
+func TestHandleAudit_ListsEntries(t *testing.T) {
+	store := &fakeAdminAuditStore{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.adminAudit = store
+	admin := addPlayerForCombatDefault(t, svc, "admin", 1)
+	admin.Role = postgres.RoleAdmin
+
+	evt, err := svc.handleAudit("admin", &gamev1.AuditRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "No admin actions recorded.", evt.GetMessage().GetContent())
+
+	require.NoError(t, store.Record(context.Background(), postgres.AdminAuditEntry{
+		Actor: "admin", Action: "teleport", Target: "bob", Args: `{"roomId":"room_b"}`, Outcome: "ok",
+	}))
+	require.NoError(t, store.Record(context.Background(), postgres.AdminAuditEntry{
+		Actor: "eve", Action: "setrole", Target: "eve", Args: "{}", Outcome: "permission denied",
+	}))
+	evt, err = svc.handleAudit("admin", &gamev1.AuditRequest{Subject: "bob", Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, postgres.AdminAuditQuery{Subject: "bob", Limit: 5}, store.lastQ)
+	content := evt.GetMessage().GetContent()
+	assert.Contains(t, content, "2026-01-02 03:04:05  admin  teleport bob {\"roomId\":\"room_b\"}")
+	assert.Contains(t, content, "eve  setrole eve  [permission denied]")
+}
+
+func TestHandleAudit_StoreUnavailable(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	admin := addPlayerForCombatDefault(t, svc, "admin", 1)
+	admin.Role = postgres.RoleAdmin
+
+	evt, err := svc.handleAudit("admin", &gamev1.AuditRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not available")
+
+	svc.adminAudit = &fakeAdminAuditStore{listErr: errors.New("db down")}
+	evt, err = svc.handleAudit("admin", &gamev1.AuditRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "failed")
+}
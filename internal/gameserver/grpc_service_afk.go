@@ -0,0 +1,47 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleAfk marks the player away from keyboard.
+//
+// Precondition: uid must identify an existing player session; req must be non-nil.
+// Postcondition: The session is AFK. An automatic request keeps any message the
+// player already left and is silent when the player is already AFK.
+func (s *GameServiceServer) handleAfk(uid string, req *gamev1.AfkRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if req.GetAuto() {
+		if sess.AFK {
+			return nil, nil
+		}
+		sess.AFK = true
+		sess.AFKMessage = ""
+		return messageEvent("You are now marked AFK."), nil
+	}
+	sess.AFK = true
+	sess.AFKMessage = strings.TrimSpace(req.GetMessage())
+	if sess.AFKMessage == "" {
+		return messageEvent("You are now marked AFK."), nil
+	}
+	return messageEvent(fmt.Sprintf("You are now marked AFK: %s", sess.AFKMessage)), nil
+}
+
+// clearAFK returns an AFK player to active and tells them so.
+//
+// Postcondition: No-op when uid is unknown or not AFK.
+func (s *GameServiceServer) clearAFK(uid string) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok || !sess.AFK {
+		return
+	}
+	sess.AFK = false
+	sess.AFKMessage = ""
+	s.pushMessageToUID(uid, "You are no longer AFK.")
+}
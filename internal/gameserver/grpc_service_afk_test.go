@@ -0,0 +1,119 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newAFKTestServer builds a server with two players, "ace" and "bee", in one room.
+func newAFKTestServer(t *testing.T) *GameServiceServer {
+	t.Helper()
+	room := &world.Room{ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza."}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza",
+		Rooms: map[string]*world.Room{"plaza": room}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	for i, name := range []string{"Ace", "Bee"} {
+		_, err := sMgr.AddPlayer(session.AddPlayerOptions{
+			UID: []string{"ace", "bee"}[i], Username: name, CharName: name, CharacterID: int64(i + 1),
+			RoomID: "plaza", CurrentHP: 10, MaxHP: 10,
+			Abilities: character.AbilityScores{}, Role: "player",
+		})
+		require.NoError(t, err)
+	}
+	return &GameServiceServer{
+		sessions: sMgr,
+		world:    wMgr,
+		logger:   zap.NewNop(),
+		chatH:    NewChatHandler(sMgr),
+	}
+}
+
+func TestHandleAfk_ManualMessageShownOnExamineAndWho(t *testing.T) {
+	s := newAFKTestServer(t)
+
+	evt, err := s.handleAfk("ace", &gamev1.AfkRequest{Message: "  grabbing food  "})
+	require.NoError(t, err)
+	assert.Equal(t, "You are now marked AFK: grabbing food", evt.GetMessage().GetContent())
+
+	evt, err = s.handleExamine("bee", &gamev1.ExamineRequest{Target: "Ace"})
+	require.NoError(t, err)
+	info := evt.GetCharacterInfo()
+	require.NotNil(t, info)
+	assert.True(t, info.GetAfk())
+	assert.Equal(t, "grabbing food", info.GetAfkMessage())
+
+	list, err := s.chatH.Who("bee")
+	require.NoError(t, err)
+	for _, p := range list.Players {
+		assert.Equal(t, p.Name == "Ace", p.Afk, p.Name)
+	}
+}
+
+func TestHandleAfk_AutoKeepsManualMessage(t *testing.T) {
+	s := newAFKTestServer(t)
+	sess := player(t, s, "ace")
+
+	evt, err := s.handleAfk("ace", &gamev1.AfkRequest{Auto: true})
+	require.NoError(t, err)
+	assert.Equal(t, "You are now marked AFK.", evt.GetMessage().GetContent())
+	assert.True(t, sess.AFK)
+
+	sess.AFKMessage = "brb"
+	evt, err = s.handleAfk("ace", &gamev1.AfkRequest{Auto: true, Message: "ignored"})
+	require.NoError(t, err)
+	assert.Nil(t, evt, "already AFK")
+	assert.Equal(t, "brb", sess.AFKMessage)
+}
+
+func TestClearAFK(t *testing.T) {
+	s := newAFKTestServer(t)
+	sess := player(t, s, "ace")
+
+	s.clearAFK("ace")
+	assert.Empty(t, drainEntityMessages(t, sess), "not AFK")
+
+	_, err := s.handleAfk("ace", &gamev1.AfkRequest{Message: "brb"})
+	require.NoError(t, err)
+	s.clearAFK("ace")
+	assert.False(t, sess.AFK)
+	assert.Empty(t, sess.AFKMessage)
+	assert.Equal(t, []string{"You are no longer AFK."}, drainEntityMessages(t, sess))
+
+	assert.NotPanics(t, func() { s.clearAFK("nobody") })
+}
+
+// TestProperty_AFK_ClearAlwaysResets verifies that after any sequence of afk
+// requests, clearing leaves the player active with no message.
+func TestProperty_AFK_ClearAlwaysResets(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s := newAFKTestServer(t)
+		sess := player(t, s, "ace")
+		for i, n := 0, rapid.IntRange(0, 10).Draw(rt, "n"); i < n; i++ {
+			req := &gamev1.AfkRequest{
+				Message: rapid.StringMatching(`[a-z ]{0,12}`).Draw(rt, "message"),
+				Auto:    rapid.Bool().Draw(rt, "auto"),
+			}
+			if _, err := s.handleAfk("ace", req); err != nil {
+				rt.Fatal(err)
+			}
+			if !sess.AFK {
+				rt.Fatalf("not AFK after %v", req)
+			}
+		}
+		s.clearAFK("ace")
+		if sess.AFK || sess.AFKMessage != "" {
+			rt.Fatalf("AFK=%v message=%q after clear", sess.AFK, sess.AFKMessage)
+		}
+	})
+}
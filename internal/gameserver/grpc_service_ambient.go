@@ -0,0 +1,98 @@
+package gameserver
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// SetAmbientInterval sets the shortest time between ambient messages in any
+// one room. Each room waits a random extra share of d on top, so rooms do not
+// speak in lockstep.
+//
+// Precondition: d >= 0; zero disables ambient messages.
+// Postcondition: Subsequent zone ticks use d.
+func (s *GameServiceServer) SetAmbientInterval(d time.Duration) {
+	s.ambientMu.Lock()
+	defer s.ambientMu.Unlock()
+	s.ambientInterval = d
+}
+
+// wantsAmbient reports whether sess should be shown ambient flavor. Players in
+// accessible mode or brief narration are spared the extra lines.
+func wantsAmbient(sess *session.PlayerSession) bool {
+	return !sess.Dead && !sess.Settings.AccessibleMode && sess.CombatVerbosity != session.CombatVerbosityBrief
+}
+
+// ambientPool returns the flavor lines room may show: its own ambient lines
+// plus a bark from each idle NPC standing in it.
+func (s *GameServiceServer) ambientPool(room *world.Room) []string {
+	pool := append([]string(nil), room.Ambient...)
+	if s.npcH == nil {
+		return pool
+	}
+	for _, inst := range s.npcH.InstancesInRoom(room.ID) {
+		if inst.IsDead() || (s.combatH != nil && s.combatH.IsInCombat(inst.ID)) {
+			continue
+		}
+		for _, bark := range inst.Barks {
+			pool = append(pool, fmt.Sprintf("%s %s", inst.Name(), bark))
+		}
+	}
+	return pool
+}
+
+// tickAmbient shows one random flavor line in each room of zone that has an
+// audience and whose throttle has lapsed.
+//
+// Precondition: zone must be non-nil.
+// Postcondition: No room shows ambient flavor more often than the ambient
+// interval; rooms nobody would see are skipped without using up their turn.
+func (s *GameServiceServer) tickAmbient(zone *world.Zone) {
+	s.ambientMu.Lock()
+	interval := s.ambientInterval
+	s.ambientMu.Unlock()
+	if interval <= 0 {
+		return
+	}
+	now := time.Now()
+	for _, room := range zone.Rooms {
+		var audience bool
+		for _, sess := range s.sessions.PlayersInRoomDetails(room.ID) {
+			if wantsAmbient(sess) {
+				audience = true
+				break
+			}
+		}
+		if !audience {
+			continue
+		}
+		pool := s.ambientPool(room)
+		if len(pool) == 0 {
+			continue
+		}
+
+		s.ambientMu.Lock()
+		if s.nextAmbient == nil {
+			s.nextAmbient = make(map[string]time.Time)
+		}
+		next, scheduled := s.nextAmbient[room.ID]
+		due := scheduled && !now.Before(next)
+		if !scheduled || due {
+			s.nextAmbient[room.ID] = now.Add(interval + time.Duration(rand.Int63n(int64(interval))))
+		}
+		s.ambientMu.Unlock()
+		if !due {
+			continue
+		}
+
+		evt := &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: &gamev1.MessageEvent{
+			Content: pool[rand.Intn(len(pool))],
+		}}}
+		s.broadcastToRoomFiltered(room.ID, "", evt, func(sess *session.PlayerSession) bool { return !wantsAmbient(sess) })
+	}
+}
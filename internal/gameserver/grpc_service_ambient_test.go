@@ -0,0 +1,70 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+// newAmbientTestServer builds an alley with one ambient line and a barking
+// ganger, watched by a plain player, an accessible-mode player, and a player
+// on brief narration.
+func newAmbientTestServer(t *testing.T) (*GameServiceServer, *world.Zone, map[string]*session.PlayerSession) {
+	t.Helper()
+	zone := &world.Zone{ID: "z", Name: "Z", StartRoom: "alley", Rooms: map[string]*world.Room{
+		"alley": {ID: "alley", ZoneID: "z", Title: "Alley", Ambient: []string{"A siren wails."}},
+		"empty": {ID: "empty", ZoneID: "z", Title: "Empty Lot", Ambient: []string{"Wind rattles the fence."}},
+	}}
+	wMgr, err := world.NewManager([]*world.Zone{zone})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	players := make(map[string]*session.PlayerSession)
+	for _, uid := range []string{"plain", "accessible", "brief"} {
+		sess, err := sMgr.AddPlayer(session.AddPlayerOptions{
+			UID: uid, Username: uid, CharName: uid, RoomID: "alley", CurrentHP: 10, MaxHP: 10, Role: "player",
+		})
+		require.NoError(t, err)
+		players[uid] = sess
+	}
+	players["accessible"].Settings.AccessibleMode = true
+	players["brief"].CombatVerbosity = session.CombatVerbosityBrief
+
+	npcMgr := npc.NewManager()
+	_, err = npcMgr.Spawn(&npc.Template{ID: "ganger", Name: "Ganger", Level: 1, MaxHP: 10, AC: 10, Barks: []string{"spits into the gutter."}}, "alley")
+	require.NoError(t, err)
+
+	s := &GameServiceServer{sessions: sMgr, world: wMgr, npcH: NewNPCHandler(npcMgr, sMgr), logger: zap.NewNop()}
+	return s, zone, players
+}
+
+func TestTickAmbient_ThrottledAndSkipsAccessibleAndBrief(t *testing.T) {
+	s, zone, players := newAmbientTestServer(t)
+
+	s.tickAmbient(zone)
+	assert.Empty(t, s.nextAmbient, "ambient messages are off until an interval is set")
+
+	s.SetAmbientInterval(time.Minute)
+	s.tickAmbient(zone)
+	assert.Empty(t, drainAllEntityEvents(t, players["plain"].Entity.Events(), 50*time.Millisecond), "the first tick only schedules the room")
+	require.Contains(t, s.nextAmbient, "alley")
+	assert.NotContains(t, s.nextAmbient, "empty", "rooms nobody is in are left alone")
+
+	s.nextAmbient["alley"] = time.Now().Add(-time.Second)
+	s.tickAmbient(zone)
+	msgs := drainAllEntityEvents(t, players["plain"].Entity.Events(), 50*time.Millisecond)
+	require.Len(t, msgs, 1)
+	assert.Contains(t, []string{"A siren wails.", "Ganger spits into the gutter."}, msgs[0])
+	assert.Empty(t, drainAllEntityEvents(t, players["accessible"].Entity.Events(), 50*time.Millisecond))
+	assert.Empty(t, drainAllEntityEvents(t, players["brief"].Entity.Events(), 50*time.Millisecond))
+
+	s.tickAmbient(zone)
+	assert.Empty(t, drainAllEntityEvents(t, players["plain"].Entity.Events(), 50*time.Millisecond), "the room waits out its interval")
+}
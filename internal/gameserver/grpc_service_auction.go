@@ -0,0 +1,479 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/auction"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// AuctionStore persists the auction house's listings and escrowed claims.
+type AuctionStore interface {
+	LoadAuctions(ctx context.Context) ([]*auction.Listing, []*auction.Claim, error)
+	SaveListing(ctx context.Context, l *auction.Listing) error
+	// CloseListing removes the listing and records the claim it produced in one step.
+	CloseListing(ctx context.Context, listingID int64, claim *auction.Claim) error
+	DeleteClaim(ctx context.Context, id int64) error
+}
+
+// InitAuctions loads persisted listings and claims into the auction house.
+//
+// Precondition: MUST be called before players connect.
+// Postcondition: No-op when the auction house or its store is not configured; load failures are logged.
+func (s *GameServiceServer) InitAuctions(ctx context.Context) {
+	if s.auctions == nil || s.auctionStore == nil {
+		return
+	}
+	listings, claims, err := s.auctionStore.LoadAuctions(ctx)
+	if err != nil {
+		s.logger.Warn("failed to load auction house from DB", zap.Error(err))
+		return
+	}
+	s.auctions.Load(listings, claims)
+}
+
+// brokerInRoom returns the first broker NPC in roomID able to do business and
+// its broker config, or nil when there is none.
+func (s *GameServiceServer) brokerInRoom(roomID string) (*npc.Instance, *npc.BrokerConfig) {
+	for _, inst := range s.npcMgr.InstancesInRoom(roomID) {
+		if inst.IsDead() || inst.Cowering {
+			continue
+		}
+		if tmpl := s.npcMgr.TemplateByID(inst.TemplateID); tmpl != nil && tmpl.Broker != nil {
+			return inst, tmpl.Broker
+		}
+	}
+	return nil, nil
+}
+
+// closeAuctionListing persists that l closed into claim. When the write fails
+// the close is undone in memory, so l stays on sale and claim is withdrawn.
+//
+// Postcondition: Returns nil when no store is configured.
+func (s *GameServiceServer) closeAuctionListing(l *auction.Listing, claim *auction.Claim) error {
+	if s.auctionStore == nil {
+		return nil
+	}
+	if err := s.auctionStore.CloseListing(context.Background(), l.ID, claim); err != nil {
+		s.auctions.Reopen(l, claim)
+		return fmt.Errorf("closing auction listing %d: %w", l.ID, err)
+	}
+	return nil
+}
+
+// restoreBackpackStacks puts back units taken from a backpack, undoing a
+// sequence of Remove calls. A stack that was only partly taken is topped up;
+// a stack that was taken whole is re-added as it was.
+func restoreBackpackStacks(sess *session.PlayerSession, taken []inventory.ItemInstance) {
+	for _, it := range taken {
+		if cur := sess.Backpack.MutableItem(it.InstanceID); cur != nil {
+			cur.Quantity += it.Quantity
+			continue
+		}
+		inst := it
+		_ = sess.Backpack.AddInstance(&inst)
+	}
+}
+
+// saveAuctionTrade persists sess's inventory and credits after an auction
+// transaction and refreshes their character sheet.
+func (s *GameServiceServer) saveAuctionTrade(sess *session.PlayerSession) {
+	if s.charSaver != nil && sess.CharacterID > 0 {
+		ctx := s.commandCtx(sess.UID)
+		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
+			s.logger.Warn("auction: SaveInventory failed", zap.String("uid", sess.UID), zap.Error(err))
+		}
+		if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
+			s.logger.Warn("auction: SaveCurrency failed", zap.String("uid", sess.UID), zap.Error(err))
+		}
+	}
+	s.pushCharacterSheet(sess)
+}
+
+// formatTimeLeft renders d as hours and minutes, e.g. "5h 04m" or "12m".
+func formatTimeLeft(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	d = d.Round(time.Minute)
+	if h := int(d.Hours()); h > 0 {
+		return fmt.Sprintf("%dh %02dm", h, int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
+
+// lotLabel names a lot, e.g. "Stim Pack", "3× Stim Pack", or an engraved
+// weapon's full instance name.
+func (s *GameServiceServer) lotLabel(itemID string, meta *inventory.ItemMetadata, qty int) string {
+	if qty > 1 {
+		return fmt.Sprintf("%d× %s", qty, s.instanceDisplayName(itemID, meta))
+	}
+	return s.instanceDisplayName(itemID, meta)
+}
+
+// handleAuction dispatches the auction command. Every action needs a broker in
+// the room; listings themselves are shared by every broker on the server.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleAuction(uid string, req *gamev1.AuctionRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.auctions == nil || sess.CharacterID <= 0 {
+		return messageEvent("The auction house is not available."), nil
+	}
+	broker, cfg := s.brokerInRoom(sess.RoomID)
+	if broker == nil {
+		return messageEvent("There is no broker here to deal with."), nil
+	}
+	// Enemy faction non-combat NPC check (REQ-FA-28).
+	if s.factionSvc != nil && broker.FactionID != "" && s.factionSvc.IsEnemyOf(sess, broker.FactionID) {
+		return messageEvent(fmt.Sprintf("%s eyes you coldly. 'We don't serve your kind here.'", broker.Name())), nil
+	}
+	now := time.Now()
+	switch req.GetAction() {
+	case "", "browse":
+		return messageEvent(s.browseAuctions(req.GetItem(), now)), nil
+	case "list":
+		return messageEvent(s.listAuction(sess, broker, cfg, req, now)), nil
+	case "buy":
+		return messageEvent(s.buyAuction(sess, req.GetListingId(), now)), nil
+	case "cancel":
+		return messageEvent(s.cancelAuction(sess, req.GetListingId())), nil
+	case "mine":
+		return messageEvent(s.myAuctions(sess, now)), nil
+	case "collect":
+		return messageEvent(s.collectAuctionClaims(sess)), nil
+	}
+	return messageEvent(fmt.Sprintf("Unknown auction action %q.", req.GetAction())), nil
+}
+
+// browseAuctions lists every open listing whose item name or ID contains filter.
+func (s *GameServiceServer) browseAuctions(filter string, now time.Time) string {
+	filter = strings.ToLower(strings.TrimSpace(filter))
+	var b strings.Builder
+	n := 0
+	for _, l := range s.auctions.Listings() {
+		name := s.instanceDisplayName(l.ItemID, l.Metadata)
+		if filter != "" && !strings.Contains(strings.ToLower(name), filter) && !strings.Contains(l.ItemID, filter) {
+			continue
+		}
+		if n == 0 {
+			b.WriteString("Auction house listings:")
+		}
+		n++
+		fmt.Fprintf(&b, "\n  #%-4d %-28s %6d credits  (%s, %s left)",
+			l.ID, s.lotLabel(l.ItemID, l.Metadata, l.Quantity), l.Price, l.SellerName, formatTimeLeft(l.ExpiresAt.Sub(now)))
+	}
+	if n == 0 {
+		if filter != "" {
+			return fmt.Sprintf("No listings match %q.", filter)
+		}
+		return "The auction house has no listings."
+	}
+	b.WriteString("\nUse 'auction buy <id>' to buy a listing outright.")
+	return b.String()
+}
+
+// backpackLot finds the backpack item named query and the stacks a transfer of
+// it draws from. A match on an engraved or affixed name picks that instance;
+// otherwise plain stacks are preferred, and an item carrying instance state
+// (affixes, wear, an engraved name) moves on its own so it arrives intact.
+//
+// Postcondition: Returns an empty itemID when nothing matches.
+func (s *GameServiceServer) backpackLot(sess *session.PlayerSession, query string) (itemID string, meta *inventory.ItemMetadata, stacks []string, owned int) {
+	var withState *inventory.ItemInstance
+	for _, it := range sess.Backpack.Items() {
+		plain := s.itemDisplayName(it.ItemDefID)
+		if m := it.Metadata(); m != nil {
+			full := s.instanceDisplayName(it.ItemDefID, m)
+			if (it.CustomName != "" && strings.EqualFold(it.CustomName, query)) || (full != plain && strings.EqualFold(full, query)) {
+				return it.ItemDefID, m, []string{it.InstanceID}, it.Quantity
+			}
+		}
+		if !strings.EqualFold(it.ItemDefID, query) && !strings.EqualFold(plain, query) {
+			continue
+		}
+		if it.Metadata() != nil {
+			if withState == nil {
+				inst := it
+				withState = &inst
+			}
+			continue
+		}
+		if itemID == "" {
+			itemID = it.ItemDefID
+		}
+	}
+	if itemID != "" {
+		for _, it := range sess.Backpack.Items() {
+			if it.ItemDefID == itemID && it.Metadata() == nil {
+				stacks = append(stacks, it.InstanceID)
+				owned += it.Quantity
+			}
+		}
+		return itemID, nil, stacks, owned
+	}
+	if withState != nil {
+		return withState.ItemDefID, withState.Metadata(), []string{withState.InstanceID}, withState.Quantity
+	}
+	return "", nil, nil, 0
+}
+
+// listAuction puts req.Quantity of the named backpack item up for sale at
+// req.Price for req.Hours, charging the broker's listing fee.
+func (s *GameServiceServer) listAuction(sess *session.PlayerSession, broker *npc.Instance, cfg *npc.BrokerConfig, req *gamev1.AuctionRequest, now time.Time) string {
+	qty := int(req.GetQuantity())
+	if qty < 1 {
+		qty = 1
+	}
+	price := int(req.GetPrice())
+	if price < 1 || req.GetItem() == "" {
+		return "usage: auction list [qty] <item> <price> [hours]"
+	}
+	if sess.Backpack == nil {
+		return "You aren't carrying anything to list."
+	}
+	itemID, meta, stacks, owned := s.backpackLot(sess, req.GetItem())
+	if itemID == "" {
+		return fmt.Sprintf("You aren't carrying %q.", req.GetItem())
+	}
+	if owned < qty {
+		return fmt.Sprintf("You only have %d of %s.", owned, s.instanceDisplayName(itemID, meta))
+	}
+	d := auction.DefaultDuration
+	if req.GetHours() > 0 {
+		d = auction.ClampDuration(time.Duration(req.GetHours()) * time.Hour)
+	}
+	fee := auction.ListingFee(price, d, cfg.FeeRate)
+	if sess.Currency < fee {
+		return fmt.Sprintf("%s wants a %d credit listing fee, but you only have %d.", broker.Name(), fee, sess.Currency)
+	}
+
+	// The lot goes into the broker's keeping, drawn from the player's stacks in
+	// order. Every unit is taken before the listing opens so a failed removal
+	// can never list items the player still holds.
+	var taken []inventory.ItemInstance
+	remaining := qty
+	for _, instanceID := range stacks {
+		if remaining <= 0 {
+			break
+		}
+		it := sess.Backpack.GetByInstanceID(instanceID)
+		if it == nil {
+			continue
+		}
+		take := min(it.Quantity, remaining)
+		snapshot := *it
+		if err := sess.Backpack.Remove(instanceID, take); err != nil {
+			s.logger.Warn("listAuction: failed to remove item from backpack", zap.String("uid", sess.UID), zap.Error(err))
+			restoreBackpackStacks(sess, taken)
+			return fmt.Sprintf("%s can't take your %s right now.", broker.Name(), s.itemDisplayName(itemID))
+		}
+		snapshot.Quantity = take
+		taken = append(taken, snapshot)
+		remaining -= take
+	}
+	if remaining > 0 {
+		restoreBackpackStacks(sess, taken)
+		return fmt.Sprintf("You only have %d of %s.", qty-remaining, s.itemDisplayName(itemID))
+	}
+
+	l, err := s.auctions.List(auction.Listing{
+		SellerID: sess.CharacterID, SellerName: sess.CharName, ItemID: itemID, Quantity: qty, Metadata: meta, Price: price,
+	}, d, now)
+	if err != nil {
+		restoreBackpackStacks(sess, taken)
+		if errors.Is(err, auction.ErrTooManyListings) {
+			return fmt.Sprintf("You already have %d listings open. Cancel one or wait for them to sell.", auction.MaxListingsPerSeller)
+		}
+		return fmt.Sprintf("%s can't list that: %v.", broker.Name(), err)
+	}
+	if s.auctionStore != nil {
+		if err := s.auctionStore.SaveListing(s.commandCtx(sess.UID), l); err != nil {
+			s.logger.Warn("saving auction listing", zap.Int64("listing", l.ID), zap.Error(err))
+			s.auctions.Unlist(l.ID)
+			restoreBackpackStacks(sess, taken)
+			return fmt.Sprintf("%s can't list that right now. Try again shortly.", broker.Name())
+		}
+	}
+	sess.Currency -= fee
+	s.emitCurrency(sess, -fee, telemetry.SourceAuctionFee)
+	s.saveAuctionTrade(sess)
+	return fmt.Sprintf("%s takes your %s and lists it as #%d for %d credits, running %s. Listing fee: %d credits.",
+		broker.Name(), s.lotLabel(itemID, meta, qty), l.ID, price, formatTimeLeft(d), fee)
+}
+
+// buyAuction buys listing id outright. The buyout is escrowed for the seller
+// until they collect it from a broker.
+func (s *GameServiceServer) buyAuction(sess *session.PlayerSession, id int64, now time.Time) string {
+	l, ok := s.auctions.Get(id)
+	if !ok || !now.Before(l.ExpiresAt) {
+		return fmt.Sprintf("There is no listing #%d.", id)
+	}
+	if l.SellerID == sess.CharacterID {
+		return "That's your own listing. Use 'auction cancel' to take it back."
+	}
+	if sess.Currency < l.Price {
+		return fmt.Sprintf("Listing #%d costs %d credits and you have %d.", id, l.Price, sess.Currency)
+	}
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return "You have nowhere to carry it."
+	}
+	added, err := sess.Backpack.AddWithMetadata(l.ItemID, l.Quantity, l.Metadata, s.invRegistry)
+	if err != nil {
+		return fmt.Sprintf("You can't carry %s: %v.", s.lotLabel(l.ItemID, l.Metadata, l.Quantity), err)
+	}
+	sold, claim, err := s.auctions.Buy(id, sess.CharacterID, now)
+	if err != nil {
+		if removeErr := sess.Backpack.Remove(added.InstanceID, l.Quantity); removeErr != nil {
+			s.logger.Warn("buyAuction: rolling back backpack failed", zap.String("uid", sess.UID), zap.Error(removeErr))
+		}
+		return fmt.Sprintf("Listing #%d is no longer available.", id)
+	}
+	if err := s.closeAuctionListing(sold, claim); err != nil {
+		s.logger.Warn("buyAuction: recording sale", zap.String("uid", sess.UID), zap.Error(err))
+		if removeErr := sess.Backpack.Remove(added.InstanceID, l.Quantity); removeErr != nil {
+			s.logger.Warn("buyAuction: rolling back backpack failed", zap.String("uid", sess.UID), zap.Error(removeErr))
+		}
+		return fmt.Sprintf("The broker couldn't close listing #%d. You haven't been charged; try again shortly.", id)
+	}
+	sess.Currency -= sold.Price
+	s.emitTrade(sess, telemetry.SourceAuctionBuy, sold.ItemID, sold.Quantity, sold.Price, sold.SellerName)
+	s.saveAuctionTrade(sess)
+	if seller := s.sessions.GetPlayerByCharID(sold.SellerID); seller != nil {
+		s.pushMessageToUID(seller.UID, fmt.Sprintf("Your auction listing #%d (%s) sold for %d credits. Collect the proceeds from any broker.",
+			sold.ID, s.lotLabel(sold.ItemID, sold.Metadata, sold.Quantity), sold.Price))
+	}
+	return fmt.Sprintf("You buy %s from %s for %d credits.", s.lotLabel(sold.ItemID, sold.Metadata, sold.Quantity), sold.SellerName, sold.Price)
+}
+
+// cancelAuction withdraws the player's listing id and hands the lot back.
+func (s *GameServiceServer) cancelAuction(sess *session.PlayerSession, id int64) string {
+	l, claim, err := s.auctions.Cancel(id, sess.CharacterID)
+	switch {
+	case errors.Is(err, auction.ErrNotSeller):
+		return fmt.Sprintf("Listing #%d isn't yours.", id)
+	case err != nil:
+		return fmt.Sprintf("There is no listing #%d.", id)
+	}
+	if err := s.closeAuctionListing(l, claim); err != nil {
+		s.logger.Warn("cancelAuction: recording cancellation", zap.String("uid", sess.UID), zap.Error(err))
+		return fmt.Sprintf("The broker couldn't withdraw listing #%d. It is still on sale; try again shortly.", id)
+	}
+	return fmt.Sprintf("You withdraw listing #%d. The listing fee is not refunded.\n%s", l.ID, s.collectAuctionClaims(sess))
+}
+
+// myAuctions lists the player's open listings and what is waiting for them.
+func (s *GameServiceServer) myAuctions(sess *session.PlayerSession, now time.Time) string {
+	var b strings.Builder
+	b.WriteString("Your listings:")
+	n := 0
+	for _, l := range s.auctions.Listings() {
+		if l.SellerID != sess.CharacterID {
+			continue
+		}
+		n++
+		fmt.Fprintf(&b, "\n  #%-4d %-28s %6d credits  (%s left)", l.ID, s.lotLabel(l.ItemID, l.Metadata, l.Quantity), l.Price, formatTimeLeft(l.ExpiresAt.Sub(now)))
+	}
+	if n == 0 {
+		b.WriteString(" none.")
+	}
+	claims := s.auctions.Claims(sess.CharacterID)
+	if len(claims) > 0 {
+		credits, lots := 0, 0
+		for _, c := range claims {
+			if c.Credits > 0 {
+				credits += c.Credits
+			} else {
+				lots++
+			}
+		}
+		fmt.Fprintf(&b, "\nWaiting for you to collect: %d credits and %d returned lot(s). Use 'auction collect'.", credits, lots)
+	}
+	return b.String()
+}
+
+// collectAuctionClaims hands the player everything the house holds for them.
+// Returned lots that will not fit in the backpack stay in escrow. Each claim
+// is deleted from the store before it is granted, so a claim whose delete
+// fails stays in escrow rather than being collected twice after a restart.
+func (s *GameServiceServer) collectAuctionClaims(sess *session.PlayerSession) string {
+	claims := s.auctions.Collect(sess.CharacterID)
+	if len(claims) == 0 {
+		return "The broker has nothing waiting for you."
+	}
+	var (
+		b    strings.Builder
+		kept []*auction.Claim
+	)
+	for _, c := range claims {
+		if c.Credits <= 0 {
+			if sess.Backpack == nil || s.invRegistry == nil {
+				kept = append(kept, c)
+				continue
+			}
+			if err := sess.Backpack.Fits(c.ItemID, c.Quantity, c.Metadata, s.invRegistry); err != nil {
+				kept = append(kept, c)
+				fmt.Fprintf(&b, "\nYou can't carry %s yet: %v.", s.lotLabel(c.ItemID, c.Metadata, c.Quantity), err)
+				continue
+			}
+		}
+		if s.auctionStore != nil {
+			if err := s.auctionStore.DeleteClaim(s.commandCtx(sess.UID), c.ID); err != nil {
+				s.logger.Warn("deleting auction claim", zap.Int64("claim", c.ID), zap.Error(err))
+				kept = append(kept, c)
+				b.WriteString("\nThe broker can't release one of your claims right now. Try again shortly.")
+				continue
+			}
+		}
+		if c.Credits > 0 {
+			sess.Currency += c.Credits
+			s.emitCurrency(sess, c.Credits, telemetry.SourceAuctionSale)
+			fmt.Fprintf(&b, "\nYou collect %d credits (%s).", c.Credits, c.Note)
+			continue
+		}
+		if _, err := sess.Backpack.AddWithMetadata(c.ItemID, c.Quantity, c.Metadata, s.invRegistry); err != nil {
+			// Fits just succeeded on the same backpack, so this cannot happen.
+			s.logger.Error("granting auction claim", zap.Int64("claim", c.ID), zap.Error(err))
+			continue
+		}
+		fmt.Fprintf(&b, "\nYou take back %s (%s).", s.lotLabel(c.ItemID, c.Metadata, c.Quantity), c.Note)
+	}
+	if len(kept) > 0 {
+		s.auctions.Restore(kept)
+	}
+	s.saveAuctionTrade(sess)
+	return strings.TrimPrefix(b.String(), "\n")
+}
+
+// tickAuctions closes expired listings, returning their lots to escrow and
+// telling sellers who are online.
+func (s *GameServiceServer) tickAuctions(now time.Time) {
+	if s.auctions == nil {
+		return
+	}
+	for _, e := range s.auctions.Expire(now) {
+		if err := s.closeAuctionListing(e.Listing, e.Claim); err != nil {
+			// The listing was reopened; the next tick retries it.
+			s.logger.Warn("tickAuctions: recording expiry", zap.Error(err))
+			continue
+		}
+		if seller := s.sessions.GetPlayerByCharID(e.Listing.SellerID); seller != nil {
+			s.pushMessageToUID(seller.UID, fmt.Sprintf("Your auction listing #%d (%s) expired unsold. Collect it from any broker.",
+				e.Listing.ID, s.lotLabel(e.Listing.ItemID, e.Listing.Metadata, e.Listing.Quantity)))
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/auction"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newAuctionTestServer builds a server with a broker in room_a and two
+// players there: a seller carrying three stim packs and a buyer with credits.
+func newAuctionTestServer(t *testing.T) (svc *GameServiceServer, seller, buyer *session.PlayerSession) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcManager := npc.NewManager()
+	svc = testServiceWithNPCMgr(t, worldMgr, sessMgr, npcManager)
+
+	invReg := inventory.NewRegistry()
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{
+		ID: "stim_pack", Name: "Stim Pack", Kind: "consumable", Stackable: true, MaxStack: 10,
+	}))
+	svc.invRegistry = invReg
+
+	add := func(uid, name string, charID int64) *session.PlayerSession {
+		sess, err := svc.sessions.AddPlayer(session.AddPlayerOptions{
+			UID: uid, Username: uid, CharName: name, CharacterID: charID,
+			RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player",
+		})
+		require.NoError(t, err)
+		return sess
+	}
+	seller = add("seller_u1", "Rook", 101)
+	seller.Currency = 100
+	_, err := seller.Backpack.Add("stim_pack", 3, invReg)
+	require.NoError(t, err)
+	buyer = add("buyer_u1", "Vex", 102)
+	buyer.Currency = 500
+
+	_, err = svc.npcMgr.Spawn(&npc.Template{
+		ID: "test_broker", Name: "Broker", NPCType: "broker", Level: 1, MaxHP: 10, AC: 10,
+		Broker: &npc.BrokerConfig{FeeRate: 0.1},
+	}, "room_a")
+	require.NoError(t, err)
+	return svc, seller, buyer
+}
+
+func auctionMsg(t *testing.T, svc *GameServiceServer, uid string, req *gamev1.AuctionRequest) string {
+	t.Helper()
+	evt, err := svc.handleAuction(uid, req)
+	require.NoError(t, err)
+	return evt.GetMessage().Content
+}
+
+func TestHandleAuction_NoBroker(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+	seller.RoomID = "room_b"
+
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "browse"}), "no broker here")
+}
+
+func TestHandleAuction_ListEscrowsItemAndChargesFee(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+
+	msg := auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim pack", Quantity: 2, Price: 200})
+	assert.Contains(t, msg, "lists it as #1 for 200 credits")
+	assert.Contains(t, msg, "Listing fee: 20 credits")
+	assert.Equal(t, 80, seller.Currency)
+	stacks := seller.Backpack.FindByItemDefID("stim_pack")
+	require.Len(t, stacks, 1)
+	assert.Equal(t, 1, stacks[0].Quantity)
+
+	listings := svc.auctions.Listings()
+	require.Len(t, listings, 1)
+	assert.Equal(t, "Rook", listings[0].SellerName)
+	assert.Equal(t, 2, listings[0].Quantity)
+}
+
+func TestHandleAuction_ListRefusals(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "crowbar", Price: 10}), "aren't carrying")
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 5, Price: 10}), "only have 3")
+	seller.Currency = 0
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Price: 10}), "listing fee")
+	assert.Empty(t, svc.auctions.Listings())
+}
+
+func TestHandleAuction_BuyEscrowsProceedsUntilCollected(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 2, Price: 200})
+
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1}), "your own listing")
+
+	msg := auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1})
+	assert.Contains(t, msg, "You buy 2× Stim Pack from Rook for 200 credits")
+	assert.Equal(t, 300, buyer.Currency)
+	require.Len(t, buyer.Backpack.FindByItemDefID("stim_pack"), 1)
+	assert.Empty(t, svc.auctions.Listings())
+	assert.Equal(t, 80, seller.Currency, "proceeds stay in escrow until collected")
+
+	msgs := drainAllEntityEvents(t, seller.Entity.Events(), 50*time.Millisecond)
+	assert.Contains(t, strings.Join(msgs, "\n"), "sold for 200 credits")
+
+	msg = auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "collect"})
+	assert.Contains(t, msg, "You collect 200 credits")
+	assert.Equal(t, 280, seller.Currency)
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "collect"}), "nothing waiting")
+}
+
+func TestHandleAuction_BuyNeedsCredits(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Price: 200})
+	buyer.Currency = 50
+
+	assert.Contains(t, auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1}), "costs 200 credits and you have 50")
+	assert.Len(t, svc.auctions.Listings(), 1)
+	assert.Empty(t, buyer.Backpack.FindByItemDefID("stim_pack"))
+}
+
+func TestHandleAuction_CancelReturnsLot(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 3, Price: 90})
+	assert.Empty(t, seller.Backpack.FindByItemDefID("stim_pack"))
+
+	assert.Contains(t, auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "cancel", ListingId: 1}), "isn't yours")
+
+	msg := auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "cancel", ListingId: 1})
+	assert.Contains(t, msg, "You withdraw listing #1")
+	assert.Contains(t, msg, "You take back 3× Stim Pack")
+	stacks := seller.Backpack.FindByItemDefID("stim_pack")
+	require.Len(t, stacks, 1)
+	assert.Equal(t, 3, stacks[0].Quantity)
+}
+
+func TestTickAuctions_ExpiredLotsReturnToEscrow(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Price: 90, Hours: 1})
+
+	svc.tickAuctions(time.Now().Add(auction.MinDuration))
+	assert.Empty(t, svc.auctions.Listings())
+	msgs := drainAllEntityEvents(t, seller.Entity.Events(), 50*time.Millisecond)
+	assert.Contains(t, strings.Join(msgs, "\n"), "expired unsold")
+
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "mine"}), "1 returned lot(s)")
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "collect"}), "expired listing")
+	stacks := seller.Backpack.FindByItemDefID("stim_pack")
+	require.Len(t, stacks, 1)
+	assert.Equal(t, 3, stacks[0].Quantity)
+}
+
+func TestHandleAuction_BrowseFilters(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	assert.Contains(t, auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "browse"}), "no listings")
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Price: 90})
+
+	msg := auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "browse", Item: "stim"})
+	assert.Contains(t, msg, "#1")
+	assert.Contains(t, msg, "Stim Pack")
+	assert.Contains(t, msg, "Rook")
+	assert.Contains(t, auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "browse", Item: "rifle"}), "No listings match")
+}
+
+func TestFormatTimeLeft(t *testing.T) {
+	assert.Equal(t, "<1m", formatTimeLeft(30*time.Second))
+	assert.Equal(t, "12m", formatTimeLeft(12*time.Minute))
+	assert.Equal(t, "5h 04m", formatTimeLeft(5*time.Hour+4*time.Minute))
+}
+
+// failingAuctionStore is an AuctionStore whose writes fail on demand.
+type failingAuctionStore struct {
+	failSave, failClose, failDelete bool
+}
+
+func (f *failingAuctionStore) LoadAuctions(context.Context) ([]*auction.Listing, []*auction.Claim, error) {
+	return nil, nil, nil
+}
+
+func (f *failingAuctionStore) SaveListing(context.Context, *auction.Listing) error {
+	if f.failSave {
+		return errors.New("db down")
+	}
+	return nil
+}
+
+func (f *failingAuctionStore) CloseListing(context.Context, int64, *auction.Claim) error {
+	if f.failClose {
+		return errors.New("db down")
+	}
+	return nil
+}
+
+func (f *failingAuctionStore) DeleteClaim(context.Context, int64) error {
+	if f.failDelete {
+		return errors.New("db down")
+	}
+	return nil
+}
+
+func TestHandleAuction_ListRefusedAtCapKeepsItems(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+	for i := 0; i < auction.MaxListingsPerSeller; i++ {
+		_, err := svc.auctions.List(auction.Listing{SellerID: seller.CharacterID, ItemID: "stim_pack", Quantity: 1, Price: 1}, auction.DefaultDuration, time.Now())
+		require.NoError(t, err)
+	}
+
+	msg := auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 2, Price: 200})
+	assert.Contains(t, msg, "listings open")
+	assert.Equal(t, 100, seller.Currency, "no fee is charged")
+	stacks := seller.Backpack.FindByItemDefID("stim_pack")
+	require.Len(t, stacks, 1)
+	assert.Equal(t, 3, stacks[0].Quantity, "the lot stays in the backpack")
+}
+
+func TestHandleAuction_ListRollsBackWhenSaveFails(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+	svc.auctionStore = &failingAuctionStore{failSave: true}
+
+	msg := auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 3, Price: 200})
+	assert.Contains(t, msg, "can't list that right now")
+	assert.Empty(t, svc.auctions.Listings())
+	assert.Equal(t, 100, seller.Currency)
+	stacks := seller.Backpack.FindByItemDefID("stim_pack")
+	require.Len(t, stacks, 1)
+	assert.Equal(t, 3, stacks[0].Quantity)
+}
+
+func TestHandleAuction_BuyRollsBackWhenCloseFails(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	store := &failingAuctionStore{}
+	svc.auctionStore = store
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 2, Price: 200})
+	store.failClose = true
+
+	msg := auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1})
+	assert.Contains(t, msg, "couldn't close listing #1")
+	assert.Equal(t, 500, buyer.Currency)
+	assert.Empty(t, buyer.Backpack.FindByItemDefID("stim_pack"))
+	assert.Len(t, svc.auctions.Listings(), 1, "the listing stays on sale")
+	assert.Empty(t, svc.auctions.Claims(seller.CharacterID), "the seller is owed nothing")
+
+	store.failClose = false
+	assert.Contains(t, auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1}), "You buy 2× Stim Pack")
+}
+
+func TestHandleAuction_CollectKeepsClaimWhenDeleteFails(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	store := &failingAuctionStore{}
+	svc.auctionStore = store
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Quantity: 3, Price: 200})
+	auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1})
+	require.Len(t, svc.auctions.Claims(seller.CharacterID), 1)
+	store.failDelete = true
+
+	msg := auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "collect"})
+	assert.Contains(t, msg, "can't release")
+	assert.Equal(t, 80, seller.Currency, "nothing is granted while the claim survives in the store")
+	assert.Len(t, svc.auctions.Claims(seller.CharacterID), 1)
+
+	store.failDelete = false
+	assert.Contains(t, auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "collect"}), "You collect 200 credits")
+	assert.Equal(t, 280, seller.Currency)
+	assert.Empty(t, svc.auctions.Claims(seller.CharacterID))
+}
+
+func TestTickAuctions_KeepsListingWhenCloseFails(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+	store := &failingAuctionStore{}
+	svc.auctionStore = store
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "stim_pack", Price: 90, Hours: 1})
+	store.failClose = true
+
+	svc.tickAuctions(time.Now().Add(auction.MinDuration))
+	assert.Len(t, svc.auctions.Listings(), 1)
+	assert.Empty(t, svc.auctions.Claims(seller.CharacterID))
+
+	store.failClose = false
+	svc.tickAuctions(time.Now().Add(auction.MinDuration))
+	assert.Empty(t, svc.auctions.Listings())
+	assert.Len(t, svc.auctions.Claims(seller.CharacterID), 1)
+}
+
+func TestHandleAuction_EngravedLotKeepsItsState(t *testing.T) {
+	svc, seller, buyer := newAuctionTestServer(t)
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "trophy", Name: "Trophy", Kind: inventory.KindJunk, MaxStack: 1, Weight: 1,
+	}))
+	meta := &inventory.ItemMetadata{CustomName: "Old Faithful", Affixes: []string{"keen"}}
+	_, err := seller.Backpack.Add("trophy", 1, svc.invRegistry)
+	require.NoError(t, err)
+	_, err = seller.Backpack.AddWithMetadata("trophy", 1, meta, svc.invRegistry)
+	require.NoError(t, err)
+
+	msg := auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "old faithful", Price: 300})
+	assert.Contains(t, msg, "takes your Old Faithful")
+	plain := seller.Backpack.FindByItemDefID("trophy")
+	require.Len(t, plain, 1, "the plain trophy stays behind")
+	assert.Nil(t, plain[0].Metadata())
+
+	auctionMsg(t, svc, buyer.UID, &gamev1.AuctionRequest{Action: "buy", ListingId: 1})
+	got := buyer.Backpack.FindByItemDefID("trophy")
+	require.Len(t, got, 1)
+	assert.Equal(t, meta, got[0].Metadata(), "the buyer receives the engraved instance")
+}
+
+func TestHandleAuction_CancelledLotKeepsItsState(t *testing.T) {
+	svc, seller, _ := newAuctionTestServer(t)
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "trophy", Name: "Trophy", Kind: inventory.KindJunk, MaxStack: 1, Weight: 1,
+	}))
+	meta := &inventory.ItemMetadata{Affixes: []string{"keen"}, Durability: 3, MaxDurability: 10}
+	_, err := seller.Backpack.AddWithMetadata("trophy", 1, meta, svc.invRegistry)
+	require.NoError(t, err)
+
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "list", Item: "trophy", Price: 300})
+	assert.Empty(t, seller.Backpack.FindByItemDefID("trophy"))
+	auctionMsg(t, svc, seller.UID, &gamev1.AuctionRequest{Action: "cancel", ListingId: 1})
+	got := seller.Backpack.FindByItemDefID("trophy")
+	require.Len(t, got, 1)
+	assert.Equal(t, meta, got[0].Metadata())
+}
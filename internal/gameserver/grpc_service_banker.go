@@ -6,6 +6,7 @@ import (
 
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 var bankerRuntimeMu sync.RWMutex
@@ -60,10 +61,12 @@ func (s *GameServiceServer) handleStashDeposit(uid string, req *gamev1.StashDepo
 	rate := s.bankerRateFor(inst.ID)
 	stashAdded := npc.ComputeDeposit(amount, rate)
 	sess.Currency -= amount
-	sess.StashBalance += stashAdded
+	balance := sess.CreditStash(stashAdded)
+	s.emitCurrency(sess, stashAdded-amount, telemetry.SourceBankFee)
+	s.saveStashBalance(sess)
 	return messageEvent(fmt.Sprintf(
 		"You deposited %d credits. %d added to your stash (rate: %.2f). Stash balance: %d.",
-		amount, stashAdded, rate, sess.StashBalance,
+		amount, stashAdded, rate, balance,
 	)), nil
 }
 
@@ -85,16 +88,18 @@ func (s *GameServiceServer) handleStashWithdraw(uid string, req *gamev1.StashWit
 	if amount <= 0 {
 		return messageEvent("You must withdraw a positive amount."), nil
 	}
-	if sess.StashBalance < amount {
-		return messageEvent(fmt.Sprintf("You don't have enough in your stash. Balance: %d.", sess.StashBalance)), nil
+	balance, ok := sess.DebitStash(amount)
+	if !ok {
+		return messageEvent(fmt.Sprintf("You don't have enough in your stash. Balance: %d.", balance)), nil
 	}
 	rate := s.bankerRateFor(inst.ID)
 	creditsReceived := npc.ComputeWithdrawal(amount, rate)
-	sess.StashBalance -= amount
 	sess.Currency += creditsReceived
+	s.emitCurrency(sess, creditsReceived-amount, telemetry.SourceBankFee)
+	s.saveStashBalance(sess)
 	return messageEvent(fmt.Sprintf(
 		"You withdrew %d from your stash and received %d credits (rate: %.2f). Stash balance: %d.",
-		amount, creditsReceived, rate, sess.StashBalance,
+		amount, creditsReceived, rate, balance,
 	)), nil
 }
 
@@ -115,6 +120,6 @@ func (s *GameServiceServer) handleStashBalance(uid string, req *gamev1.StashBala
 	rate := s.bankerRateFor(inst.ID)
 	return messageEvent(fmt.Sprintf(
 		"Stash balance: %d credits.\n%s's current rate: %.2f.",
-		sess.StashBalance, inst.Name(), rate,
+		sess.Stash(), inst.Name(), rate,
 	)), nil
 }
@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"math"
 	"strings"
@@ -10,6 +9,7 @@ import (
 
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // zoneMultiplier returns the bribe cost multiplier for a given danger level.
@@ -196,6 +196,7 @@ func (s *GameServiceServer) handleBribeConfirm(uid string, req *gamev1.BribeConf
 
 	// Deduct credits.
 	sess.Currency -= cost
+	s.emitCurrency(sess, -cost, telemetry.SourceBribe)
 
 	// Decrement wanted level (floor at 0).
 	if sess.WantedLevel[zoneID] > 0 {
@@ -203,7 +204,7 @@ func (s *GameServiceServer) handleBribeConfirm(uid string, req *gamev1.BribeConf
 	}
 	newLevel := sess.WantedLevel[zoneID]
 
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 
 	// Persist wanted level.
 	if s.wantedRepo != nil {
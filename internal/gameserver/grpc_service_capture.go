@@ -0,0 +1,169 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// findRestraint returns the first restraint item in the player's backpack
+// and its definition.
+//
+// Postcondition: Returns (nil, nil) when the player carries none.
+func (s *GameServiceServer) findRestraint(sess *session.PlayerSession) (*inventory.ItemInstance, *inventory.ItemDef) {
+	if s.invRegistry == nil || sess.Backpack == nil {
+		return nil, nil
+	}
+	for _, it := range sess.Backpack.Items() {
+		def, ok := s.invRegistry.Item(it.ItemDefID)
+		if ok && def.HasTag(npc.RestraintTag) {
+			return sess.Backpack.MutableItem(it.InstanceID), def
+		}
+	}
+	return nil, nil
+}
+
+// handleRestrain binds a surrendered NPC with a restraint from the player's
+// backpack, making it the player's prisoner.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil. On
+// success one restraint is used up and the NPC's captor is uid.
+func (s *GameServiceServer) handleRestrain(uid string, req *gamev1.RestrainRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return messageEvent("player not found"), nil
+	}
+	inst := s.npcMgr.FindInRoom(sess.RoomID, req.GetTarget())
+	if inst == nil {
+		return messageEvent(fmt.Sprintf("You don't see %q here.", req.GetTarget())), nil
+	}
+	switch {
+	case inst.CaptorUID != "":
+		return messageEvent(fmt.Sprintf("%s is already restrained.", inst.Name())), nil
+	case !inst.Restrainable():
+		return messageEvent(fmt.Sprintf("%s must surrender before you can restrain them.", inst.Name())), nil
+	}
+	item, def := s.findRestraint(sess)
+	if item == nil {
+		return messageEvent("You have nothing to restrain them with."), nil
+	}
+	if err := sess.Backpack.Remove(item.InstanceID, 1); err != nil {
+		return messageEvent(fmt.Sprintf("You fumble the %s.", def.Name)), nil
+	}
+	inst.Restrain(uid, def.EscapeDC)
+	s.pushRoomViewToAllInRoom(sess.RoomID)
+	return messageEvent(fmt.Sprintf("You bind %s with %s. They are your prisoner and will follow you.", inst.Name(), def.Name)), nil
+}
+
+// prisonersOf returns the prisoners uid holds in roomID.
+//
+// Postcondition: Returns nil when there are none.
+func (s *GameServiceServer) prisonersOf(uid, roomID string) []*npc.Instance {
+	var out []*npc.Instance
+	for _, inst := range s.npcMgr.InstancesInRoom(roomID) {
+		if inst.CaptorUID == uid && !inst.IsDead() {
+			out = append(out, inst)
+		}
+	}
+	return out
+}
+
+// movePrisonersWithPlayer escorts uid's prisoners from oldRoomID into
+// newRoomID behind their captor.
+//
+// Precondition: uid, oldRoomID, and newRoomID must be non-empty.
+// Postcondition: Every prisoner uid held in oldRoomID is in newRoomID.
+func (s *GameServiceServer) movePrisonersWithPlayer(uid, oldRoomID, newRoomID string) {
+	if oldRoomID == newRoomID {
+		return
+	}
+	for _, inst := range s.prisonersOf(uid, oldRoomID) {
+		if err := s.npcMgr.Move(inst.ID, newRoomID); err != nil {
+			s.logger.Warn("escorting prisoner", zap.String("npc", inst.ID), zap.Error(err))
+		}
+	}
+}
+
+// tickPrisoner gives a prisoner its chance each zone tick to slip its
+// restraints. A prisoner whose captor is gone or has left it behind walks
+// off without a check.
+//
+// Precondition: inst must not be nil and be a prisoner.
+// Postcondition: An escaped prisoner has left the world and is scheduled
+// to respawn; its captor is told.
+func (s *GameServiceServer) tickPrisoner(inst *npc.Instance) {
+	captor, online := s.sessions.GetPlayer(inst.CaptorUID)
+	if online && captor.RoomID == inst.RoomID {
+		if s.dice == nil || !inst.EscapeCheck(s.dice.Src().Intn(20)+1) {
+			return
+		}
+		s.pushMessageToUID(inst.CaptorUID, fmt.Sprintf("%s slips their restraints and bolts!", inst.Name()))
+	} else if online {
+		s.pushMessageToUID(inst.CaptorUID, fmt.Sprintf("Left unguarded, %s works free of their restraints and disappears.", inst.Name()))
+	}
+	s.removePrisoner(inst)
+}
+
+// removePrisoner takes a prisoner out of the world — escaped or handed over —
+// and schedules its respawn.
+//
+// Precondition: inst must not be nil.
+func (s *GameServiceServer) removePrisoner(inst *npc.Instance) {
+	roomID := inst.RoomID
+	if err := s.npcMgr.Remove(inst.ID); err != nil {
+		return
+	}
+	if s.respawnMgr != nil {
+		s.respawnMgr.Schedule(inst.TemplateID, roomID, time.Now(), s.respawnMgr.ResolvedDelay(inst.TemplateID, roomID))
+	}
+	s.pushRoomViewToAllInRoom(roomID)
+}
+
+// handOverPrisoners turns uid's prisoners in the room over to quest giver
+// giver for each of its active quests with an unfinished capture objective
+// on them.
+//
+// Precondition: sess belongs to uid; giver must not be nil.
+// Postcondition: Each prisoner handed over has left the world and counted
+// toward its quest; returns the messages for the player.
+func (s *GameServiceServer) handOverPrisoners(uid string, sess *session.PlayerSession, giver *npc.Instance) []string {
+	if s.questSvc == nil {
+		return nil
+	}
+	reg := s.questSvc.Registry()
+	var msgs []string
+	for _, prisoner := range s.prisonersOf(uid, sess.RoomID) {
+		for qid, aq := range sess.ActiveQuests {
+			def, ok := reg[qid]
+			if !ok || def.GiverNPCID != giver.TemplateID {
+				continue
+			}
+			wanted := false
+			for _, obj := range def.Objectives {
+				if obj.Type == "capture" && obj.TargetID == prisoner.TemplateID && aq.ObjectiveProgress[obj.ID] < obj.Quantity {
+					wanted = true
+					break
+				}
+			}
+			if !wanted {
+				continue
+			}
+			msgs = append(msgs, fmt.Sprintf("You hand %s over to %s.", prisoner.Name(), giver.Name()))
+			completion, err := s.questSvc.RecordCapture(s.commandCtx(uid), sess, sess.CharacterID, qid, prisoner.TemplateID)
+			if err != nil {
+				s.logger.Warn("RecordCapture failed", zap.String("uid", uid), zap.Error(err))
+			}
+			msgs = append(msgs, completion...)
+			s.removePrisoner(prisoner)
+			break
+		}
+	}
+	return msgs
+}
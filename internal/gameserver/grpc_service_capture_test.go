@@ -0,0 +1,155 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/quest"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newCaptureSvc returns a server whose player u_capture stands in room_a
+// with a Ganger, carries two zip ties (escape DC 15), holds a bounty for one
+// captured ganger from a Fixer in the room, and rolls diceVal.
+func newCaptureSvc(t *testing.T, diceVal int) (*GameServiceServer, *session.PlayerSession, *npc.Instance, *npc.Instance) {
+	t.Helper()
+	def := &quest.QuestDef{
+		ID:         "ganger_bounty",
+		Title:      "Ganger Bounty",
+		GiverNPCID: "fixer",
+		Objectives: []quest.QuestObjective{
+			{ID: "capture_obj", Type: "capture", Description: "Bring in a ganger", TargetID: "ganger", Quantity: 1},
+		},
+	}
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcManager := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcManager)
+	svc.questSvc = quest.NewService(quest.QuestRegistry{def.ID: def}, &noOpQuestRepo{}, nil, nil, nil)
+	svc.dice = dice.NewLoggedRoller(&fixedDiceSource{val: diceVal}, zaptest.NewLogger(t))
+	invReg := inventory.NewRegistry()
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{
+		ID: "zip_ties", Name: "Zip Ties", Kind: "junk", Stackable: true, MaxStack: 10,
+		Tags: []string{npc.RestraintTag}, EscapeDC: 15,
+	}))
+	svc.invRegistry = invReg
+
+	ganger, err := npcManager.Spawn(&npc.Template{ID: "ganger", Name: "Ganger", Level: 1, MaxHP: 10, AC: 10}, "room_a")
+	require.NoError(t, err)
+	fixer, err := npcManager.Spawn(&npc.Template{ID: "fixer", Name: "Fixer", Level: 1, MaxHP: 10, AC: 10}, "room_a")
+	require.NoError(t, err)
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_capture", Username: "captor", CharName: "Captor",
+		RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	_, err = sess.Backpack.Add("zip_ties", 2, invReg)
+	require.NoError(t, err)
+	sess.ActiveQuests[def.ID] = &quest.ActiveQuest{QuestID: def.ID, ObjectiveProgress: map[string]int{"capture_obj": 0}}
+	return svc, sess, ganger, fixer
+}
+
+// restrain sends u_capture's RestrainRequest and returns the reply.
+func restrain(t *testing.T, svc *GameServiceServer, target string) string {
+	t.Helper()
+	event, err := svc.handleRestrain("u_capture", &gamev1.RestrainRequest{Target: target})
+	require.NoError(t, err)
+	return event.GetMessage().GetContent()
+}
+
+// zipTies returns how many zip ties sess carries.
+func zipTies(sess *session.PlayerSession) int {
+	n := 0
+	for _, it := range sess.Backpack.FindByItemDefID("zip_ties") {
+		n += it.Quantity
+	}
+	return n
+}
+
+func TestHandleRestrain_RequiresSurrenderAndConsumesRestraint(t *testing.T) {
+	svc, sess, ganger, _ := newCaptureSvc(t, 0)
+
+	assert.Contains(t, restrain(t, svc, "Ganger"), "must surrender")
+	assert.Equal(t, 2, zipTies(sess))
+
+	ganger.Surrendered = true
+	assert.Contains(t, restrain(t, svc, "Ganger"), "You bind Ganger with Zip Ties")
+	assert.Equal(t, "u_capture", ganger.CaptorUID)
+	assert.Equal(t, 15, ganger.EscapeDC)
+	assert.Equal(t, 1, zipTies(sess))
+
+	assert.Contains(t, restrain(t, svc, "Ganger"), "already restrained")
+	assert.Equal(t, 1, zipTies(sess), "a refused restrain uses nothing")
+}
+
+func TestHandleRestrain_NeedsARestraint(t *testing.T) {
+	svc, sess, ganger, _ := newCaptureSvc(t, 0)
+	for _, it := range sess.Backpack.Items() {
+		require.NoError(t, sess.Backpack.Remove(it.InstanceID, it.Quantity))
+	}
+	ganger.Surrendered = true
+
+	assert.Contains(t, restrain(t, svc, "Ganger"), "nothing to restrain them with")
+	assert.Empty(t, ganger.CaptorUID)
+}
+
+func TestMovePrisonersWithPlayer_EscortsOnlyTheCaptorsPrisoners(t *testing.T) {
+	svc, _, ganger, fixer := newCaptureSvc(t, 0)
+	ganger.Surrendered = true
+	ganger.Restrain("u_capture", 15)
+
+	svc.movePrisonersWithPlayer("u_capture", "room_a", "room_b")
+
+	assert.Equal(t, "room_b", ganger.RoomID)
+	assert.Equal(t, "room_a", fixer.RoomID)
+}
+
+func TestTickPrisoner_EscapesOnlyOnAHighRollOrWhenLeftBehind(t *testing.T) {
+	// d20 6 + level 1 = 7 vs DC 15: held.
+	svc, _, ganger, _ := newCaptureSvc(t, 5)
+	ganger.Surrendered = true
+	ganger.Restrain("u_capture", 15)
+	svc.tickPrisoner(ganger)
+	_, present := svc.npcMgr.Get(ganger.ID)
+	assert.True(t, present)
+
+	// d20 20 + level 1 = 21 vs DC 15: escapes.
+	svc, _, ganger, _ = newCaptureSvc(t, 19)
+	ganger.Surrendered = true
+	ganger.Restrain("u_capture", 15)
+	svc.tickPrisoner(ganger)
+	_, present = svc.npcMgr.Get(ganger.ID)
+	assert.False(t, present)
+
+	// Captor gone: the prisoner walks off without a roll.
+	svc, sess, ganger, _ := newCaptureSvc(t, 0)
+	ganger.Surrendered = true
+	ganger.Restrain("u_capture", 15)
+	sess.RoomID = "room_b"
+	svc.tickPrisoner(ganger)
+	_, present = svc.npcMgr.Get(ganger.ID)
+	assert.False(t, present)
+}
+
+func TestHandOverPrisoners_CompletesCaptureBounty(t *testing.T) {
+	svc, sess, ganger, fixer := newCaptureSvc(t, 0)
+
+	assert.Empty(t, svc.handOverPrisoners("u_capture", sess, fixer), "a free ganger can't be handed over")
+
+	ganger.Surrendered = true
+	ganger.Restrain("u_capture", 15)
+	msgs := svc.handOverPrisoners("u_capture", sess, fixer)
+
+	require.NotEmpty(t, msgs)
+	assert.Equal(t, "You hand Ganger over to Fixer.", msgs[0])
+	_, present := svc.npcMgr.Get(ganger.ID)
+	assert.False(t, present)
+	assert.NotContains(t, sess.ActiveQuests, "ganger_bounty")
+	assert.Contains(t, sess.CompletedQuests, "ganger_bounty")
+}
@@ -0,0 +1,48 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// AccountSlotsStore persists per-account character limit overrides.
+//
+// Precondition: accountID must be > 0; slots must be >= 0 (0 clears the override).
+type AccountSlotsStore interface {
+	SetAccountCharacterSlots(ctx context.Context, accountID int64, slots int) error
+}
+
+// handleCharacterSlots sets or clears an account's character limit override.
+//
+// Precondition: uid must identify an active session authorized for
+// permission.CanSetCharacterSlots; req must be non-nil.
+// Postcondition: On success the override is persisted; an unknown account or
+// failed write leaves it unchanged and reports why.
+func (s *GameServiceServer) handleCharacterSlots(uid string, req *gamev1.CharacterSlotsRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.accountAdmin == nil || s.accountSlots == nil {
+		return errorEvent("Character slot overrides are not available."), nil
+	}
+	if req.GetUsername() == "" || req.GetSlots() < 0 {
+		return errorEvent("usage: charslots <username> <count|default>"), nil
+	}
+	ctx := s.commandCtx(uid)
+	target, err := s.accountAdmin.GetAccountByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return errorEvent(fmt.Sprintf("account %q not found", req.GetUsername())), nil
+	}
+	if err := s.accountSlots.SetAccountCharacterSlots(ctx, target.ID, int(req.GetSlots())); err != nil {
+		s.logger.Warn("handleCharacterSlots: saving override failed", zap.Int64("account_id", target.ID), zap.Error(err))
+		return errorEvent("Failed to save character slots. Please try again."), nil
+	}
+	if req.GetSlots() == 0 {
+		return messageEvent(fmt.Sprintf("Cleared the character slot override for %s; the configured limit for role %s applies.", target.Username, target.Role)), nil
+	}
+	return messageEvent(fmt.Sprintf("%s may now own up to %d characters.", target.Username, req.GetSlots())), nil
+}
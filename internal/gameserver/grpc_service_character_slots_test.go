@@ -0,0 +1,82 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// fakeAccountSlots records character slot overrides and optionally fails.
+type fakeAccountSlots struct {
+	slots map[int64]int
+	err   error
+}
+
+func (f *fakeAccountSlots) SetAccountCharacterSlots(_ context.Context, accountID int64, slots int) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.slots == nil {
+		f.slots = make(map[int64]int)
+	}
+	f.slots[accountID] = slots
+	return nil
+}
+
+func newCharacterSlotsTestService(t *testing.T, store *fakeAccountSlots) *GameServiceServer {
+	t.Helper()
+	svc := testServiceForCombatDefault(t, nil)
+	svc.accountAdmin = &mockAccountAdmin{accounts: map[string]AccountInfo{
+		"kira": {ID: 9, Username: "kira", Role: "player"},
+	}}
+	svc.accountSlots = store
+	addPlayerForCombatDefault(t, svc, "admin", 1)
+	return svc
+}
+
+func TestHandleCharacterSlots_SetsAndClearsOverride(t *testing.T) {
+	store := &fakeAccountSlots{}
+	svc := newCharacterSlotsTestService(t, store)
+
+	evt, err := svc.handleCharacterSlots("admin", &gamev1.CharacterSlotsRequest{Username: "kira", Slots: 8})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "up to 8 characters")
+	assert.Equal(t, 8, store.slots[9])
+
+	evt, err = svc.handleCharacterSlots("admin", &gamev1.CharacterSlotsRequest{Username: "kira"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Cleared")
+	assert.Equal(t, 0, store.slots[9])
+}
+
+func TestHandleCharacterSlots_UnknownAccount(t *testing.T) {
+	store := &fakeAccountSlots{}
+	svc := newCharacterSlotsTestService(t, store)
+
+	evt, err := svc.handleCharacterSlots("admin", &gamev1.CharacterSlotsRequest{Username: "nobody", Slots: 3})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not found")
+	assert.Empty(t, store.slots)
+}
+
+func TestHandleCharacterSlots_SaveFailure(t *testing.T) {
+	svc := newCharacterSlotsTestService(t, &fakeAccountSlots{err: errors.New("db down")})
+
+	evt, err := svc.handleCharacterSlots("admin", &gamev1.CharacterSlotsRequest{Username: "kira", Slots: 3})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "Failed to save")
+}
+
+func TestHandleCharacterSlots_Unavailable(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "admin", 1)
+
+	evt, err := svc.handleCharacterSlots("admin", &gamev1.CharacterSlotsRequest{Username: "kira", Slots: 3})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not available")
+}
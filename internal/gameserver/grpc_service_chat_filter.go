@@ -0,0 +1,51 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// SetChatFilter installs the filter applied to say and emote text.
+//
+// Precondition: f may be nil to disable filtering.
+// Postcondition: Subsequent chat is screened by f.
+func (s *GameServiceServer) SetChatFilter(f *ChatFilter) {
+	if f != nil {
+		f.SetPermissions(s.permissions)
+	}
+	s.chatH.SetFilter(f)
+}
+
+// handleChatMute mutes or unmutes a player's chat on behalf of a moderator.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Precondition: the caller holds permission.CanModerateChat (checked by dispatch).
+// Postcondition: Returns an error event unless a chat filter is configured and
+// the target is online. Minutes of 0 lift the target's mute and clear their strikes.
+func (s *GameServiceServer) handleChatMute(uid string, req *gamev1.ChatMuteRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	filter := s.chatH.Filter()
+	if filter == nil {
+		return errorEvent("chat filtering is not enabled"), nil
+	}
+	if req.GetMinutes() < 0 {
+		return errorEvent("minutes must be >= 0"), nil
+	}
+	target := s.sessions.GetPlayerByCharNameCI(req.GetTarget())
+	if target == nil {
+		return errorEvent(fmt.Sprintf("player %q is not online", req.GetTarget())), nil
+	}
+	if req.GetMinutes() == 0 {
+		filter.Unmute(target.UID)
+		s.pushMessageToUID(target.UID, "Your chat mute has been lifted.")
+		return messageEvent(fmt.Sprintf("%s may chat again.", target.CharName)), nil
+	}
+	d := time.Duration(req.GetMinutes()) * time.Minute
+	filter.Mute(target.UID, d)
+	s.pushMessageToUID(target.UID, fmt.Sprintf("You have been muted for %s.", d))
+	return messageEvent(fmt.Sprintf("%s is muted for %s.", target.CharName, d)), nil
+}
@@ -0,0 +1,57 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestHandleChatMute_RequiresModerator(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	svc.SetChatFilter(NewChatFilter(testChatConfig(), nil))
+	addPlayerForCombatDefault(t, svc, "mod", 1)
+	addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.dispatch("mod", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_ChatMute{ChatMute: &gamev1.ChatMuteRequest{Target: "target", Minutes: 5}}})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "permission denied")
+}
+
+func TestHandleChatMute_MutesAndUnmutes(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	svc.SetChatFilter(NewChatFilter(testChatConfig(), nil))
+	mod := addPlayerForCombatDefault(t, svc, "mod", 1)
+	mod.Role = postgres.RoleModerator
+	addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.handleChatMute("mod", &gamev1.ChatMuteRequest{Target: "TARGET", Minutes: 5})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "muted for 5m0s")
+	_, err = svc.chatH.Say("target", "hello")
+	assert.ErrorIs(t, err, ErrChatMuted)
+
+	evt, err = svc.handleChatMute("mod", &gamev1.ChatMuteRequest{Target: "target"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "may chat again")
+	_, err = svc.chatH.Say("target", "hello")
+	assert.NoError(t, err)
+}
+
+func TestHandleChatMute_UnknownTargetAndNoFilter(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	admin := addPlayerForCombatDefault(t, svc, "admin", 1)
+	admin.Role = postgres.RoleAdmin
+
+	evt, err := svc.handleChatMute("admin", &gamev1.ChatMuteRequest{Target: "nobody", Minutes: 1})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not enabled")
+
+	svc.SetChatFilter(NewChatFilter(testChatConfig(), nil))
+	evt, err = svc.handleChatMute("admin", &gamev1.ChatMuteRequest{Target: "nobody", Minutes: 1})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not online")
+}
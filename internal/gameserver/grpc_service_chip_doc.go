@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +8,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	"github.com/cory-johannsen/mud/internal/game/skillcheck"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // findChipDocInRoom looks up a chip_doc NPC by name in the given room.
@@ -102,6 +102,7 @@ func (s *GameServiceServer) handleUncurse(uid string, req *gamev1.UncurseRequest
 	}
 
 	sess.Currency -= cfg.RemovalCost
+	s.emitCurrency(sess, -cfg.RemovalCost, telemetry.SourceCurseRemoval)
 
 	var roll int
 	if s.dice != nil {
@@ -151,7 +152,7 @@ func (s *GameServiceServer) handleUncurse(uid string, req *gamev1.UncurseRequest
 		}
 
 		if s.charSaver != nil && sess.CharacterID > 0 {
-			ctx := context.Background()
+			ctx := s.commandCtx(uid)
 			_ = s.charSaver.SaveEquipment(ctx, sess.CharacterID, sess.Equipment)
 			invItems := backpackToInventoryItems(sess.Backpack)
 			_ = s.charSaver.SaveInventory(ctx, sess.CharacterID, invItems)
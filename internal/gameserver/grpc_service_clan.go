@@ -0,0 +1,505 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/clan"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// ClanStore persists player clans.
+type ClanStore interface {
+	LoadClans(ctx context.Context) ([]*clan.Clan, error)
+	SaveClan(ctx context.Context, c *clan.Clan) error
+	AdjustBank(ctx context.Context, name string, delta int) error
+	DeleteClan(ctx context.Context, name string) error
+	DeletedMembers(ctx context.Context, ids []int64) ([]int64, error)
+}
+
+// InitClans loads persisted clans into the clan registry.
+//
+// Precondition: MUST be called before players connect.
+// Postcondition: No-op when clans or their store are not configured; load failures are logged.
+func (s *GameServiceServer) InitClans(ctx context.Context) {
+	if s.clans == nil || s.clanStore == nil {
+		return
+	}
+	clans, err := s.clanStore.LoadClans(ctx)
+	if err != nil {
+		s.logger.Warn("failed to load clans from DB", zap.Error(err))
+		return
+	}
+	s.clans.Load(clans)
+}
+
+// StartClanReconcileHook subscribes to the calendar and drops deleted
+// characters from their clans on every calendar tick.
+//
+// Precondition: MUST be called after GameServiceServer is fully initialized.
+// Postcondition: returns a stop function; call it to unsubscribe and stop the goroutine.
+func (s *GameServiceServer) StartClanReconcileHook() func() {
+	if s.calendar == nil || s.clans == nil {
+		return func() {}
+	}
+	ch := make(chan GameDateTime, 4)
+	s.calendar.Subscribe(ch)
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ch:
+				s.releaseDeletedClanMembers()
+			case <-stop:
+				s.calendar.Unsubscribe(ch)
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// releaseDeletedClanMembers removes every soft-deleted or purged character
+// from their clan. Characters are deleted by the frontends and purged by the
+// purge loop, outside the clan registry, so it is reconciled against the
+// store on each calendar tick.
+//
+// Postcondition: No-op when no ClanStore is configured; failures are logged.
+func (s *GameServiceServer) releaseDeletedClanMembers() {
+	if s.clanStore == nil {
+		return
+	}
+	ids, err := s.clanStore.DeletedMembers(context.Background(), s.clans.MemberIDs())
+	if err != nil {
+		s.logger.Warn("listing deleted clan members", zap.Error(err))
+		return
+	}
+	for _, id := range ids {
+		c, ok := s.clans.RemoveCharacter(id)
+		if !ok {
+			continue
+		}
+		if len(c.Members) == 0 {
+			if err := s.deleteClan(context.Background(), c.Name); err != nil {
+				s.logger.Warn("deleting clan", zap.String("clan", c.Name), zap.Error(err))
+			}
+			continue
+		}
+		if err := s.saveClan(context.Background(), c); err != nil {
+			s.logger.Warn("saving clan", zap.String("clan", c.Name), zap.Error(err))
+		}
+	}
+}
+
+// clanMOTD returns the login banner for sess's clan, or "" when sess has no
+// clan or the clan has no message of the day.
+func (s *GameServiceServer) clanMOTD(sess *session.PlayerSession) string {
+	if s.clans == nil {
+		return ""
+	}
+	c, ok := s.clans.ForCharacter(sess.CharacterID)
+	if !ok || c.MOTD == "" {
+		return ""
+	}
+	return fmt.Sprintf("[%s] Message of the day: %s", c.Tag, c.MOTD)
+}
+
+// clanNotSavedNote is the player-facing note appended when a clan change
+// applied in memory could not be saved. The clan's next save writes the
+// whole membership, so the change is persisted then.
+const clanNotSavedNote = " (The clan record couldn't be saved right now; it will be saved with the clan's next change.)"
+
+// saveClan persists c's details and membership.
+//
+// Postcondition: No-op when no ClanStore is configured.
+func (s *GameServiceServer) saveClan(ctx context.Context, c *clan.Clan) error {
+	if s.clanStore == nil {
+		return nil
+	}
+	if err := s.clanStore.SaveClan(ctx, c); err != nil {
+		return fmt.Errorf("saving clan %q: %w", c.Name, err)
+	}
+	return nil
+}
+
+// saveClanNote persists c for the command sess is running, returning "" on
+// success or clanNotSavedNote after logging a failure.
+func (s *GameServiceServer) saveClanNote(sess *session.PlayerSession, c *clan.Clan) string {
+	if err := s.saveClan(s.commandCtx(sess.UID), c); err != nil {
+		s.logger.Warn("saving clan", zap.String("uid", sess.UID), zap.Error(err))
+		return clanNotSavedNote
+	}
+	return ""
+}
+
+// adjustClanBank persists a change of delta credits to the bank of the clan
+// named name.
+//
+// Postcondition: No-op when no ClanStore is configured.
+func (s *GameServiceServer) adjustClanBank(ctx context.Context, name string, delta int) error {
+	if s.clanStore == nil {
+		return nil
+	}
+	if err := s.clanStore.AdjustBank(ctx, name, delta); err != nil {
+		return fmt.Errorf("adjusting bank of clan %q: %w", name, err)
+	}
+	return nil
+}
+
+// deleteClan removes the persisted clan named name.
+//
+// Postcondition: No-op when no ClanStore is configured.
+func (s *GameServiceServer) deleteClan(ctx context.Context, name string) error {
+	if s.clanStore == nil {
+		return nil
+	}
+	if err := s.clanStore.DeleteClan(ctx, name); err != nil {
+		return fmt.Errorf("deleting clan %q: %w", name, err)
+	}
+	return nil
+}
+
+// announceToClan sends text to every online member of c except the one with exceptCharID.
+func (s *GameServiceServer) announceToClan(c *clan.Clan, exceptCharID int64, text string) {
+	for id := range c.Members {
+		if id == exceptCharID {
+			continue
+		}
+		if member := s.sessions.GetPlayerByCharID(id); member != nil {
+			s.pushMessageToUID(member.UID, text)
+		}
+	}
+}
+
+// clanErrorText converts a clan registry error into player-facing text.
+func clanErrorText(err error) string {
+	switch {
+	case errors.Is(err, clan.ErrNotMember):
+		return "You are not in a clan."
+	case errors.Is(err, clan.ErrRank):
+		return "Your clan rank doesn't allow that."
+	case errors.Is(err, clan.ErrInClan):
+		return "You are already in a clan."
+	case errors.Is(err, clan.ErrNameTaken):
+		return "A clan with that name already exists."
+	case errors.Is(err, clan.ErrTagTaken):
+		return "Another clan already uses that tag."
+	case errors.Is(err, clan.ErrNoInvite):
+		return "You have no pending clan invitation."
+	case errors.Is(err, clan.ErrLeaderCannotLeave):
+		return "As leader you must promote an officer to lead (clan promote <officer>) or disband the clan before leaving."
+	case errors.Is(err, clan.ErrInsufficientFunds):
+		return "The clan bank doesn't hold that much."
+	}
+	return err.Error()
+}
+
+// handleClan dispatches the clan command.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleClan(uid string, req *gamev1.ClanRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.clans == nil || sess.CharacterID <= 0 {
+		return messageEvent("Clans are not available."), nil
+	}
+	switch req.GetAction() {
+	case "":
+		return messageEvent(s.clanInfo(sess)), nil
+	case "create":
+		return messageEvent(s.createClan(sess, req.GetTarget(), req.GetText())), nil
+	case "invite":
+		return messageEvent(s.inviteToClan(sess, req.GetTarget())), nil
+	case "accept":
+		return messageEvent(s.acceptClanInvite(sess)), nil
+	case "decline":
+		return messageEvent(s.declineClanInvite(sess)), nil
+	case "leave":
+		return messageEvent(s.leaveClan(sess)), nil
+	case "kick":
+		return messageEvent(s.kickFromClan(sess, req.GetTarget())), nil
+	case "promote", "demote":
+		return messageEvent(s.changeClanRank(sess, req.GetTarget(), req.GetAction() == "promote")), nil
+	case "motd":
+		return messageEvent(s.setClanMOTD(sess, req.GetText())), nil
+	case "say":
+		return s.clanSay(sess, req.GetText()), nil
+	case "deposit":
+		return messageEvent(s.depositToClan(sess, int(req.GetAmount()))), nil
+	case "withdraw":
+		return messageEvent(s.withdrawFromClan(sess, int(req.GetAmount()))), nil
+	case "disband":
+		return messageEvent(s.disbandClan(sess)), nil
+	}
+	return messageEvent(fmt.Sprintf("Unknown clan action %q.", req.GetAction())), nil
+}
+
+// clanInfo describes the player's clan, or their pending invitation.
+func (s *GameServiceServer) clanInfo(sess *session.PlayerSession) string {
+	c, ok := s.clans.ForCharacter(sess.CharacterID)
+	if !ok {
+		if name, invited := s.clans.PendingInvite(sess.CharacterID); invited {
+			return fmt.Sprintf("You are not in a clan. %s has invited you to join (clan accept / clan decline).", name)
+		}
+		return "You are not in a clan. Found one with \"clan create <tag> <name>\"."
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%s] %s — bank: %d credits", c.Tag, c.Name, c.Bank)
+	if c.MOTD != "" {
+		fmt.Fprintf(&sb, "\nMOTD: %s", c.MOTD)
+	}
+	sb.WriteString("\nMembers:")
+	for _, m := range c.Roster() {
+		online := ""
+		if s.sessions.GetPlayerByCharID(m.CharacterID) != nil {
+			online = " (online)"
+		}
+		fmt.Fprintf(&sb, "\n  %-8s %s%s", m.Rank, m.Name, online)
+	}
+	return sb.String()
+}
+
+// createClan founds a clan led by the player.
+func (s *GameServiceServer) createClan(sess *session.PlayerSession, tag, name string) string {
+	c, err := s.clans.Create(name, tag, sess.CharacterID, sess.CharName)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	return fmt.Sprintf("You found the clan %s [%s].", c.Name, c.Tag) + s.saveClanNote(sess, c)
+}
+
+// inviteToClan invites an online player to the player's clan.
+func (s *GameServiceServer) inviteToClan(sess *session.PlayerSession, targetName string) string {
+	target := s.sessions.GetPlayerByCharNameCI(targetName)
+	if target == nil || target.CharacterID <= 0 {
+		return "Player not found."
+	}
+	if target.CharacterID == sess.CharacterID {
+		return "You cannot invite yourself."
+	}
+	c, err := s.clans.Invite(sess.CharacterID, target.CharacterID)
+	if errors.Is(err, clan.ErrInClan) {
+		return fmt.Sprintf("%s is already in a clan.", target.CharName)
+	}
+	if err != nil {
+		return clanErrorText(err)
+	}
+	s.pushMessageToUID(target.UID, fmt.Sprintf(
+		"%s has invited you to join the clan %s [%s]. (clan accept / clan decline)", sess.CharName, c.Name, c.Tag))
+	return fmt.Sprintf("You invite %s to join %s.", target.CharName, c.Name)
+}
+
+// acceptClanInvite joins the player to the clan that invited them.
+func (s *GameServiceServer) acceptClanInvite(sess *session.PlayerSession) string {
+	c, err := s.clans.Accept(sess.CharacterID, sess.CharName)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	note := s.saveClanNote(sess, c)
+	s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] %s has joined the clan.", c.Tag, sess.CharName))
+	msg := fmt.Sprintf("You join the clan %s [%s].", c.Name, c.Tag) + note
+	if c.MOTD != "" {
+		msg += "\nMOTD: " + c.MOTD
+	}
+	return msg
+}
+
+// declineClanInvite discards the player's pending invitation.
+func (s *GameServiceServer) declineClanInvite(sess *session.PlayerSession) string {
+	name, err := s.clans.Decline(sess.CharacterID)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	return fmt.Sprintf("You decline the invitation to join %s.", name)
+}
+
+// leaveClan removes the player from their clan, disbanding it if they were its last member.
+func (s *GameServiceServer) leaveClan(sess *session.PlayerSession) string {
+	c, err := s.clans.Leave(sess.CharacterID)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	if len(c.Members) == 0 {
+		if err := s.deleteClan(s.commandCtx(sess.UID), c.Name); err != nil {
+			s.logger.Warn("deleting clan", zap.String("uid", sess.UID), zap.Error(err))
+		}
+		return fmt.Sprintf("You leave %s. With no members left, the clan is disbanded.", c.Name)
+	}
+	note := s.saveClanNote(sess, c)
+	s.announceToClan(c, 0, fmt.Sprintf("[%s] %s has left the clan.", c.Tag, sess.CharName))
+	return fmt.Sprintf("You leave %s.", c.Name) + note
+}
+
+// clanMemberByName finds a member of the player's clan by character name, online or not.
+func (s *GameServiceServer) clanMemberByName(sess *session.PlayerSession, name string) (clan.Member, string) {
+	c, ok := s.clans.ForCharacter(sess.CharacterID)
+	if !ok {
+		return clan.Member{}, clanErrorText(clan.ErrNotMember)
+	}
+	for _, m := range c.Members {
+		if strings.EqualFold(m.Name, name) {
+			return m, ""
+		}
+	}
+	return clan.Member{}, fmt.Sprintf("%s is not in your clan.", name)
+}
+
+// kickFromClan removes a lower-ranked member from the player's clan.
+func (s *GameServiceServer) kickFromClan(sess *session.PlayerSession, targetName string) string {
+	target, msg := s.clanMemberByName(sess, targetName)
+	if msg != "" {
+		return msg
+	}
+	if target.CharacterID == sess.CharacterID {
+		return "Use \"clan leave\" to leave your clan."
+	}
+	c, err := s.clans.Kick(sess.CharacterID, target.CharacterID)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	note := s.saveClanNote(sess, c)
+	if kicked := s.sessions.GetPlayerByCharID(target.CharacterID); kicked != nil {
+		s.pushMessageToUID(kicked.UID, fmt.Sprintf("You have been removed from %s.", c.Name))
+	}
+	s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] %s removed %s from the clan.", c.Tag, sess.CharName, target.Name))
+	return fmt.Sprintf("You remove %s from %s.", target.Name, c.Name) + note
+}
+
+// changeClanRank promotes or demotes a member of the player's clan.
+func (s *GameServiceServer) changeClanRank(sess *session.PlayerSession, targetName string, promote bool) string {
+	target, msg := s.clanMemberByName(sess, targetName)
+	if msg != "" {
+		return msg
+	}
+	var (
+		c    *clan.Clan
+		rank clan.Rank
+		err  error
+	)
+	if promote {
+		c, rank, err = s.clans.Promote(sess.CharacterID, target.CharacterID)
+	} else {
+		c, rank, err = s.clans.Demote(sess.CharacterID, target.CharacterID)
+	}
+	if err != nil {
+		return clanErrorText(err)
+	}
+	note := s.saveClanNote(sess, c)
+	if rank == clan.RankLeader {
+		s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] %s now leads the clan.", c.Tag, target.Name))
+		return fmt.Sprintf("You hand leadership of %s to %s. You are now an officer.", c.Name, target.Name) + note
+	}
+	s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] %s is now %s.", c.Tag, target.Name, rank))
+	return fmt.Sprintf("%s is now %s.", target.Name, rank) + note
+}
+
+// setClanMOTD sets or clears the clan's message of the day.
+func (s *GameServiceServer) setClanMOTD(sess *session.PlayerSession, text string) string {
+	if len(text) > clan.MaxMOTDLen {
+		return fmt.Sprintf("The message of the day is limited to %d characters.", clan.MaxMOTDLen)
+	}
+	c, err := s.clans.SetMOTD(sess.CharacterID, text)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	note := s.saveClanNote(sess, c)
+	if text == "" {
+		return "You clear the clan's message of the day." + note
+	}
+	s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] New message of the day: %s", c.Tag, text))
+	return "You update the clan's message of the day." + note
+}
+
+// clanSay sends text on the clan channel to every online member.
+func (s *GameServiceServer) clanSay(sess *session.PlayerSession, text string) *gamev1.ServerEvent {
+	c, ok := s.clans.ForCharacter(sess.CharacterID)
+	if !ok {
+		return messageEvent(clanErrorText(clan.ErrNotMember))
+	}
+	if s.chatH != nil {
+		cleaned, err := s.chatH.screen(sess, text)
+		if err != nil {
+			return errorEvent(err.Error())
+		}
+		text = cleaned
+	}
+	line := fmt.Sprintf("[%s] %s: %s", c.Tag, sess.CharName, text)
+	s.announceToClan(c, sess.CharacterID, line)
+	return messageEvent(line)
+}
+
+// depositToClan moves credits from the player's stash into the clan bank.
+func (s *GameServiceServer) depositToClan(sess *session.PlayerSession, amount int) string {
+	if amount < 1 {
+		return "Amount must be a positive number."
+	}
+	if balance, ok := sess.DebitStash(amount); !ok {
+		return fmt.Sprintf("Your stash only holds %d credits.", balance)
+	}
+	c, err := s.clans.Deposit(sess.CharacterID, amount)
+	if err != nil {
+		sess.CreditStash(amount)
+		return clanErrorText(err)
+	}
+	if err := s.adjustClanBank(s.commandCtx(sess.UID), c.Name, amount); err != nil {
+		s.logger.Warn("depositToClan: adjustClanBank failed", zap.String("uid", sess.UID), zap.Error(err))
+		s.clans.AdjustBank(c.Name, -amount)
+		sess.CreditStash(amount)
+		return "The clan bank can't take deposits right now. Please try again."
+	}
+	s.saveStashBalance(sess)
+	return fmt.Sprintf("You deposit %d credits from your stash into the clan bank. Clan bank: %d credits.", amount, c.Bank)
+}
+
+// withdrawFromClan moves credits from the clan bank into the player's stash.
+func (s *GameServiceServer) withdrawFromClan(sess *session.PlayerSession, amount int) string {
+	if amount < 1 {
+		return "Amount must be a positive number."
+	}
+	c, err := s.clans.Withdraw(sess.CharacterID, amount)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	if err := s.adjustClanBank(s.commandCtx(sess.UID), c.Name, -amount); err != nil {
+		s.logger.Warn("withdrawFromClan: adjustClanBank failed", zap.String("uid", sess.UID), zap.Error(err))
+		s.clans.AdjustBank(c.Name, amount)
+		return "The clan bank can't pay out right now. Please try again."
+	}
+	sess.CreditStash(amount)
+	s.saveStashBalance(sess)
+	s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] %s withdrew %d credits from the clan bank.", c.Tag, sess.CharName, amount))
+	return fmt.Sprintf("You withdraw %d credits from the clan bank into your stash. Clan bank: %d credits.", amount, c.Bank)
+}
+
+// disbandClan dissolves the player's clan. Anything left in the clan bank is
+// returned to the leader's stash.
+func (s *GameServiceServer) disbandClan(sess *session.PlayerSession) string {
+	c, err := s.clans.Disband(sess.CharacterID)
+	if err != nil {
+		return clanErrorText(err)
+	}
+	if err := s.deleteClan(s.commandCtx(sess.UID), c.Name); err != nil {
+		s.logger.Warn("disbandClan: deleteClan failed", zap.String("uid", sess.UID), zap.Error(err))
+		if err := s.clans.Reinstate(c); err != nil {
+			s.logger.Error("disbandClan: reinstating clan", zap.String("clan", c.Name), zap.Error(err))
+		}
+		return "The clan can't be disbanded right now. Please try again."
+	}
+	if c.Bank > 0 {
+		sess.CreditStash(c.Bank)
+		s.saveStashBalance(sess)
+	}
+	s.announceToClan(c, sess.CharacterID, fmt.Sprintf("[%s] %s has disbanded the clan.", c.Tag, sess.CharName))
+	if c.Bank > 0 {
+		return fmt.Sprintf("You disband %s. The %d credits in the clan bank go to your stash.", c.Name, c.Bank)
+	}
+	return fmt.Sprintf("You disband %s.", c.Name)
+}
@@ -0,0 +1,343 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/clan"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// memClanStore is an in-memory ClanStore. Characters in deleted count as
+// deleted; when failWrites is set every write fails.
+type memClanStore struct {
+	clans      map[string]*clan.Clan
+	deleted    map[int64]bool
+	failWrites bool
+}
+
+func (m *memClanStore) LoadClans(context.Context) ([]*clan.Clan, error) {
+	var out []*clan.Clan
+	for _, c := range m.clans {
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (m *memClanStore) SaveClan(_ context.Context, c *clan.Clan) error {
+	if m.failWrites {
+		return errors.New("db down")
+	}
+	if old, ok := m.clans[c.Name]; ok {
+		c.Bank = old.Bank
+	}
+	m.clans[c.Name] = c
+	return nil
+}
+
+func (m *memClanStore) AdjustBank(_ context.Context, name string, delta int) error {
+	c, ok := m.clans[name]
+	if m.failWrites || !ok || c.Bank+delta < 0 {
+		return errors.New("db down")
+	}
+	c.Bank += delta
+	return nil
+}
+
+func (m *memClanStore) DeleteClan(_ context.Context, name string) error {
+	if m.failWrites {
+		return errors.New("db down")
+	}
+	delete(m.clans, name)
+	return nil
+}
+
+func (m *memClanStore) DeletedMembers(_ context.Context, ids []int64) ([]int64, error) {
+	var out []int64
+	for _, id := range ids {
+		if m.deleted[id] {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// newClanTestServer builds a server with three players in one room: "ace"
+// (character 1), "bee" (character 2), and "cee" (character 3), each with 100
+// credits in their stash.
+func newClanTestServer(t *testing.T) (*GameServiceServer, *memClanStore) {
+	t.Helper()
+	room := &world.Room{ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza."}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza",
+		Rooms: map[string]*world.Room{"plaza": room}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	for i, name := range []string{"Ace", "Bee", "Cee"} {
+		uid := strings.ToLower(name)
+		_, err := sMgr.AddPlayer(session.AddPlayerOptions{
+			UID: uid, Username: uid, CharName: name, CharacterID: int64(i + 1),
+			RoomID: "plaza", CurrentHP: 10, MaxHP: 10,
+			Abilities: character.AbilityScores{}, Role: "player",
+		})
+		require.NoError(t, err)
+		sess, _ := sMgr.GetPlayer(uid)
+		sess.StashBalance = 100
+	}
+
+	store := &memClanStore{clans: map[string]*clan.Clan{}, deleted: map[int64]bool{}}
+	s := &GameServiceServer{
+		sessions:  sMgr,
+		world:     wMgr,
+		logger:    zap.NewNop(),
+		chatH:     NewChatHandler(sMgr),
+		clans:     clan.NewRegistry(),
+		clanStore: store,
+	}
+	s.chatH.SetClans(s.clans)
+	return s, store
+}
+
+func clanCmd(t *testing.T, s *GameServiceServer, uid string, args ...string) string {
+	t.Helper()
+	action, target, text, amount := "", "", "", 0
+	if len(args) > 0 {
+		action = args[0]
+	}
+	switch action {
+	case "create":
+		target, text = args[1], args[2]
+	case "invite", "kick", "promote", "demote":
+		target = args[1]
+	case "motd", "say":
+		if len(args) > 1 {
+			text = args[1]
+		}
+	case "deposit", "withdraw":
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			require.NoError(t, err)
+			amount = n
+		}
+	}
+	evt, err := s.handleClan(uid, &gamev1.ClanRequest{Action: action, Target: target, Text: text, Amount: int32(amount)})
+	require.NoError(t, err)
+	if e := evt.GetError(); e != nil {
+		return e.GetMessage()
+	}
+	return evt.GetMessage().GetContent()
+}
+
+func player(t *testing.T, s *GameServiceServer, uid string) *session.PlayerSession {
+	t.Helper()
+	sess, ok := s.sessions.GetPlayer(uid)
+	require.True(t, ok)
+	return sess
+}
+
+func TestHandleClan_CreateInviteAccept(t *testing.T) {
+	s, store := newClanTestServer(t)
+
+	assert.Contains(t, clanCmd(t, s, "ace"), "not in a clan")
+	assert.Equal(t, "You found the clan Rust Rats [RR].", clanCmd(t, s, "ace", "create", "rr", "Rust Rats"))
+	require.Contains(t, store.clans, "Rust Rats")
+	assert.Contains(t, clanCmd(t, s, "bee", "create", "RR", "Other"), "already uses that tag")
+
+	assert.Equal(t, "You invite Bee to join Rust Rats.", clanCmd(t, s, "ace", "invite", "bee"))
+	assert.Contains(t, drainEntityMessages(t, player(t, s, "bee")), "Ace has invited you to join the clan Rust Rats [RR]. (clan accept / clan decline)")
+	assert.Contains(t, clanCmd(t, s, "bee"), "Rust Rats has invited you")
+	assert.Equal(t, "You join the clan Rust Rats [RR].", clanCmd(t, s, "bee", "accept"))
+	assert.Contains(t, drainEntityMessages(t, player(t, s, "ace")), "[RR] Bee has joined the clan.")
+	assert.Len(t, store.clans["Rust Rats"].Members, 2)
+
+	assert.Contains(t, clanCmd(t, s, "bee", "invite", "cee"), "rank doesn't allow")
+	assert.Contains(t, clanCmd(t, s, "ace", "invite", "bee"), "already in a clan")
+
+	info := clanCmd(t, s, "bee")
+	assert.Contains(t, info, "[RR] Rust Rats — bank: 0 credits")
+	assert.Contains(t, info, "Leader   Ace (online)")
+	assert.Contains(t, info, "Recruit  Bee (online)")
+}
+
+func TestHandleClan_TagsInWhoAndRoom(t *testing.T) {
+	s, _ := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+
+	list, err := s.chatH.Who("bee")
+	require.NoError(t, err)
+	tags := map[string]string{}
+	for _, p := range list.Players {
+		tags[p.Name] = p.ClanTag
+	}
+	assert.Equal(t, map[string]string{"Ace": "RR", "Bee": "", "Cee": ""}, tags)
+
+	wh := NewWorldHandler(s.world, s.sessions, npc.NewManager(), nil, nil, nil)
+	wh.SetClans(s.clans)
+	room, _ := s.world.GetRoom("plaza")
+	view := wh.buildRoomView("bee", room)
+	assert.ElementsMatch(t, []string{"[RR] Ace", "Cee"}, view.Players)
+}
+
+func TestHandleClan_ChannelReachesOnlyMembers(t *testing.T) {
+	s, _ := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+	clanCmd(t, s, "ace", "invite", "bee")
+	clanCmd(t, s, "bee", "accept")
+	for _, uid := range []string{"ace", "bee", "cee"} {
+		drainEntityMessages(t, player(t, s, uid))
+	}
+
+	assert.Equal(t, "[RR] Ace: muster at dusk", clanCmd(t, s, "ace", "say", "muster at dusk"))
+	assert.Equal(t, []string{"[RR] Ace: muster at dusk"}, drainEntityMessages(t, player(t, s, "bee")))
+	assert.Empty(t, drainEntityMessages(t, player(t, s, "cee")))
+	assert.Contains(t, clanCmd(t, s, "cee", "say", "hi"), "not in a clan")
+}
+
+func TestHandleClan_RanksKickAndMOTD(t *testing.T) {
+	s, store := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+	for _, uid := range []string{"bee", "cee"} {
+		clanCmd(t, s, "ace", "invite", uid)
+		clanCmd(t, s, uid, "accept")
+	}
+
+	assert.Equal(t, "Bee is now Member.", clanCmd(t, s, "ace", "promote", "Bee"))
+	assert.Equal(t, "Bee is now Officer.", clanCmd(t, s, "ace", "promote", "bee"))
+	assert.Equal(t, "You update the clan's message of the day.", clanCmd(t, s, "bee", "motd", "Guard the docks."))
+	assert.Equal(t, "[RR] Message of the day: Guard the docks.", s.clanMOTD(player(t, s, "cee")))
+
+	assert.Equal(t, "You remove Cee from Rust Rats.", clanCmd(t, s, "bee", "kick", "cee"))
+	assert.Contains(t, drainEntityMessages(t, player(t, s, "cee")), "You have been removed from Rust Rats.")
+	assert.NotContains(t, store.clans["Rust Rats"].Members, int64(3))
+	assert.Equal(t, "", s.clanMOTD(player(t, s, "cee")))
+
+	assert.Contains(t, clanCmd(t, s, "ace", "leave"), "promote an officer")
+	assert.Contains(t, clanCmd(t, s, "ace", "promote", "bee"), "You hand leadership of Rust Rats to Bee")
+	assert.Equal(t, "You leave Rust Rats.", clanCmd(t, s, "ace", "leave"))
+	assert.Equal(t, "You leave Rust Rats. With no members left, the clan is disbanded.", clanCmd(t, s, "bee", "leave"))
+	assert.Empty(t, store.clans)
+}
+
+func TestHandleClan_SharedBank(t *testing.T) {
+	s, store := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+	clanCmd(t, s, "ace", "invite", "bee")
+	clanCmd(t, s, "bee", "accept")
+
+	assert.Contains(t, clanCmd(t, s, "bee", "deposit", "101"), "only holds 100")
+	assert.Contains(t, clanCmd(t, s, "bee", "deposit", "60"), "Clan bank: 60 credits.")
+	assert.Equal(t, 40, player(t, s, "bee").StashBalance)
+	assert.Equal(t, 60, store.clans["Rust Rats"].Bank)
+
+	assert.Contains(t, clanCmd(t, s, "bee", "withdraw", "10"), "rank doesn't allow")
+	assert.Contains(t, clanCmd(t, s, "ace", "withdraw", "61"), "doesn't hold that much")
+	assert.Contains(t, clanCmd(t, s, "ace", "withdraw", "20"), "Clan bank: 40 credits.")
+	assert.Equal(t, 120, player(t, s, "ace").StashBalance)
+
+	assert.Contains(t, clanCmd(t, s, "bee", "disband"), "rank doesn't allow")
+	assert.Equal(t, "You disband Rust Rats. The 40 credits in the clan bank go to your stash.", clanCmd(t, s, "ace", "disband"))
+	assert.Equal(t, 160, player(t, s, "ace").StashBalance)
+	assert.Empty(t, store.clans)
+	assert.Contains(t, drainEntityMessages(t, player(t, s, "bee")), "[RR] Ace has disbanded the clan.")
+}
+
+func TestHandleClan_BankRollsBackWhenSaveFails(t *testing.T) {
+	s, store := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+	clanCmd(t, s, "ace", "deposit", "50")
+	store.failWrites = true
+
+	assert.Contains(t, clanCmd(t, s, "ace", "deposit", "30"), "can't take deposits")
+	assert.Equal(t, 50, player(t, s, "ace").StashBalance, "the deposit is refunded")
+	assert.Contains(t, clanCmd(t, s, "ace", "withdraw", "20"), "can't pay out")
+	assert.Equal(t, 50, player(t, s, "ace").StashBalance)
+	c, _ := s.clans.Get("Rust Rats")
+	assert.Equal(t, 50, c.Bank)
+
+	assert.Contains(t, clanCmd(t, s, "ace", "disband"), "can't be disbanded")
+	assert.Equal(t, 50, player(t, s, "ace").StashBalance)
+	assert.Equal(t, "RR", s.clans.TagFor(1), "the clan survives")
+
+	assert.Contains(t, clanCmd(t, s, "ace", "motd", "Hold."), "couldn't be saved")
+	store.failWrites = false
+	assert.Equal(t, 50, store.clans["Rust Rats"].Bank)
+}
+
+func TestHandleClan_StaleSaveKeepsBank(t *testing.T) {
+	s, store := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+	stale, _ := s.clans.Get("Rust Rats")
+	clanCmd(t, s, "ace", "deposit", "40")
+
+	require.NoError(t, s.saveClan(context.Background(), stale))
+	assert.Equal(t, 40, store.clans["Rust Rats"].Bank)
+}
+
+func TestReleaseDeletedClanMembers(t *testing.T) {
+	s, store := newClanTestServer(t)
+	clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+	clanCmd(t, s, "ace", "invite", "bee")
+	clanCmd(t, s, "bee", "accept")
+	clanCmd(t, s, "cee", "create", "cc", "Cee Crew")
+	store.deleted[1] = true
+	store.deleted[3] = true
+
+	s.releaseDeletedClanMembers()
+	c, ok := s.clans.ForCharacter(2)
+	require.True(t, ok)
+	leader, _ := c.Leader()
+	assert.Equal(t, "Bee", leader.Name, "leadership passes to the survivor")
+	assert.NotContains(t, store.clans["Rust Rats"].Members, int64(1))
+	assert.Empty(t, s.clans.TagFor(1))
+	assert.NotContains(t, store.clans, "Cee Crew", "a clan left empty is deleted")
+}
+
+func TestInitClans_LoadsClans(t *testing.T) {
+	s, store := newClanTestServer(t)
+	store.clans["Rust Rats"] = &clan.Clan{Name: "Rust Rats", Tag: "RR", MOTD: "Welcome back.",
+		Members: map[int64]clan.Member{2: {CharacterID: 2, Name: "Bee", Rank: clan.RankLeader}}}
+	s.InitClans(context.Background())
+	assert.Equal(t, "[RR] Message of the day: Welcome back.", s.clanMOTD(player(t, s, "bee")))
+	assert.Equal(t, "RR", s.clans.TagFor(2))
+}
+
+// TestProperty_HandleClan_BankConservesCredits verifies that deposits and
+// withdrawals move credits between stashes and the clan bank without creating
+// or destroying any.
+func TestProperty_HandleClan_BankConservesCredits(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s, _ := newClanTestServer(t)
+		clanCmd(t, s, "ace", "create", "rr", "Rust Rats")
+		clanCmd(t, s, "ace", "invite", "bee")
+		clanCmd(t, s, "bee", "accept")
+		total := func() int {
+			c, _ := s.clans.Get("Rust Rats")
+			return c.Bank + player(t, s, "ace").StashBalance + player(t, s, "bee").StashBalance
+		}
+		n := rapid.IntRange(1, 20).Draw(rt, "n")
+		for i := 0; i < n; i++ {
+			uid := rapid.SampledFrom([]string{"ace", "bee"}).Draw(rt, "uid")
+			action := rapid.SampledFrom([]string{"deposit", "withdraw"}).Draw(rt, "action")
+			amount := rapid.IntRange(1, 150).Draw(rt, "amount")
+			_, err := s.handleClan(uid, &gamev1.ClanRequest{Action: action, Amount: int32(amount)})
+			if err != nil {
+				rt.Fatal(err)
+			}
+			if got := total(); got != 200 {
+				rt.Fatalf("after %s %d by %s there are %d credits, want 200", action, amount, uid, got)
+			}
+		}
+	})
+}
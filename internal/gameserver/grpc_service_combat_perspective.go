@@ -0,0 +1,45 @@
+package gameserver
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// combatRelationFor classifies the combatant named name relative to recipient.
+//
+// Players never fight each other, so any other connected player is an ally and
+// every remaining named combatant is an enemy.
+//
+// Precondition: recipient must be non-nil.
+// Postcondition: Returns COMBAT_RELATION_UNSPECIFIED iff name is empty.
+func (s *GameServiceServer) combatRelationFor(recipient *session.PlayerSession, name string) gamev1.CombatRelation {
+	switch {
+	case name == "":
+		return gamev1.CombatRelation_COMBAT_RELATION_UNSPECIFIED
+	case name == recipient.CharName:
+		return gamev1.CombatRelation_COMBAT_RELATION_SELF
+	}
+	if _, ok := s.sessions.GetPlayerByCharName(name); ok {
+		return gamev1.CombatRelation_COMBAT_RELATION_ALLY
+	}
+	return gamev1.CombatRelation_COMBAT_RELATION_ENEMY
+}
+
+// withCombatPerspective returns evt annotated with the attacker and target
+// relations as seen by recipient.
+//
+// Precondition: recipient and evt must be non-nil.
+// Postcondition: evt is never mutated; a clone is returned when any relation is set.
+func (s *GameServiceServer) withCombatPerspective(recipient *session.PlayerSession, evt *gamev1.CombatEvent) *gamev1.CombatEvent {
+	attacker := s.combatRelationFor(recipient, evt.GetAttacker())
+	target := s.combatRelationFor(recipient, evt.GetTarget())
+	if attacker == gamev1.CombatRelation_COMBAT_RELATION_UNSPECIFIED && target == gamev1.CombatRelation_COMBAT_RELATION_UNSPECIFIED {
+		return evt
+	}
+	cp := proto.Clone(evt).(*gamev1.CombatEvent)
+	cp.AttackerRelation = attacker
+	cp.TargetRelation = target
+	return cp
+}
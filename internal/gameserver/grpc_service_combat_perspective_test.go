@@ -0,0 +1,33 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestWithCombatPerspective_Relations(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	kira := addPlayerForCombatDefault(t, svc, "kira", 1)
+	bo := addPlayerForCombatDefault(t, svc, "bo", 2)
+
+	evt := &gamev1.CombatEvent{Attacker: "kira", Target: "Rat", Narrative: "kira attacks Rat."}
+
+	self := svc.withCombatPerspective(kira, evt)
+	assert.Equal(t, gamev1.CombatRelation_COMBAT_RELATION_SELF, self.AttackerRelation)
+	assert.Equal(t, gamev1.CombatRelation_COMBAT_RELATION_ENEMY, self.TargetRelation)
+
+	ally := svc.withCombatPerspective(bo, evt)
+	assert.Equal(t, gamev1.CombatRelation_COMBAT_RELATION_ALLY, ally.AttackerRelation)
+
+	assert.Equal(t, gamev1.CombatRelation_COMBAT_RELATION_UNSPECIFIED, evt.AttackerRelation, "input must not be mutated")
+}
+
+func TestWithCombatPerspective_NoNamesReturnsSameEvent(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	kira := addPlayerForCombatDefault(t, svc, "kira", 1)
+	evt := &gamev1.CombatEvent{Narrative: "Round 1 complete."}
+	assert.Same(t, evt, svc.withCombatPerspective(kira, evt))
+}
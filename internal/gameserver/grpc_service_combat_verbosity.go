@@ -0,0 +1,199 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// CombatVerbositySaver persists a character's combat narration level.
+//
+// Precondition: characterID must be > 0; level must be non-empty.
+type CombatVerbositySaver interface {
+	SaveCombatVerbosity(ctx context.Context, characterID int64, level string) error
+}
+
+// isValidCombatVerbosity reports whether level is one of the session combat verbosity constants.
+func isValidCombatVerbosity(level string) bool {
+	switch level {
+	case session.CombatVerbosityBrief, session.CombatVerbosityNormal, session.CombatVerbosityVerbose:
+		return true
+	default:
+		return false
+	}
+}
+
+// handleCombatVerbosity reports or updates the player's combat narration level.
+//
+// Precondition: uid must identify an active session.
+// Postcondition: When level is empty the current setting is reported unchanged; otherwise
+// sess.CombatVerbosity is updated and persisted (when a saver is configured) and a
+// confirmation message event is returned.
+func (s *GameServiceServer) handleCombatVerbosity(uid, level string) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if level == "" {
+		return messageEvent(fmt.Sprintf("Combat narration: %s. Usage: combat [brief|normal|verbose]", sess.CombatVerbosity)), nil
+	}
+	if !isValidCombatVerbosity(level) {
+		return messageEvent(fmt.Sprintf("Invalid combat verbosity %q. Valid levels: brief, normal, verbose.", level)), nil
+	}
+	if sess.CharacterID > 0 && s.combatVerbosityRepo != nil {
+		if err := s.combatVerbosityRepo.SaveCombatVerbosity(s.commandCtx(uid), sess.CharacterID, level); err != nil {
+			s.logger.Warn("handleCombatVerbosity: SaveCombatVerbosity failed", zap.Error(err))
+			return messageEvent("Failed to save combat verbosity. Please try again."), nil
+		}
+	}
+	sess.CombatVerbosity = level
+	// The account-level preference carries the choice to the account's other characters.
+	sess.Settings.CombatVerbosity = level
+	if err := s.saveAccountSettings(sess); err != nil {
+		s.logger.Warn("handleCombatVerbosity: saving account settings failed", zap.Error(err))
+	}
+	return messageEvent(fmt.Sprintf("Combat narration set to: %s", level)), nil
+}
+
+// filterCombatEventsForVerbosity returns the view of a combat round's events appropriate
+// for a recipient with the given narration level.
+//
+// normal returns events unchanged. verbose returns copies of attack events whose
+// narrative is suffixed with the raw roll, modifier, outcome, and damage. brief
+// collapses every per-action event into one summary line per acting combatant,
+// emitted where that combatant first acted; events without an actor (round
+// markers, mental state notes) and structural event types are kept as-is.
+//
+// Precondition: events may be empty.
+// Postcondition: The input slice and its elements are never mutated.
+func filterCombatEventsForVerbosity(level string, events []*gamev1.CombatEvent) []*gamev1.CombatEvent {
+	switch level {
+	case session.CombatVerbosityVerbose:
+		out := make([]*gamev1.CombatEvent, 0, len(events))
+		for _, evt := range events {
+			if evt.GetOutcome() == "" {
+				out = append(out, evt)
+				continue
+			}
+			cp := proto.Clone(evt).(*gamev1.CombatEvent)
+			cp.Narrative = evt.GetNarrative() + "\n" + combatRollDetail(evt)
+			out = append(out, cp)
+		}
+		return out
+	case session.CombatVerbosityBrief:
+		return briefCombatEvents(events)
+	default:
+		return events
+	}
+}
+
+// combatRollDetail formats the raw roll breakdown for a single attack event.
+//
+// Precondition: evt must carry an Outcome.
+// Postcondition: Returns a single bracketed line.
+func combatRollDetail(evt *gamev1.CombatEvent) string {
+	mod := evt.GetAttackTotal() - evt.GetAttackRoll()
+	detail := fmt.Sprintf("  [d20 %d %+d = %d; %s", evt.GetAttackRoll(), mod, evt.GetAttackTotal(), evt.GetOutcome())
+	if evt.GetFlanking() {
+		detail += "; flanking"
+	}
+	if evt.GetDamage() > 0 {
+		detail += fmt.Sprintf("; %d damage", evt.GetDamage())
+	}
+	return detail + "]"
+}
+
+// briefTally accumulates one combatant's actions for a brief round summary.
+type briefTally struct {
+	actions int
+	hits    int
+	crits   int
+	misses  int
+	damage  int32
+}
+
+// summary renders the tally as a compact "Name: ..." line.
+func (t *briefTally) summary(name string) string {
+	var parts []string
+	if t.hits > 0 {
+		hit := fmt.Sprintf("%d %s", t.hits, pluralize(t.hits, "hit", "hits"))
+		if t.crits > 0 {
+			hit += fmt.Sprintf(" (%d crit)", t.crits)
+		}
+		parts = append(parts, hit)
+	}
+	if t.misses > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", t.misses, pluralize(t.misses, "miss", "misses")))
+	}
+	if t.damage > 0 {
+		parts = append(parts, fmt.Sprintf("%d damage", t.damage))
+	}
+	if other := t.actions - t.hits - t.misses; other > 0 {
+		parts = append(parts, fmt.Sprintf("%d other %s", other, pluralize(other, "action", "actions")))
+	}
+	return name + ": " + strings.Join(parts, ", ") + "."
+}
+
+// pluralize returns one when n == 1 and many otherwise.
+func pluralize(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
+
+// briefCombatEvents implements the brief branch of filterCombatEventsForVerbosity.
+func briefCombatEvents(events []*gamev1.CombatEvent) []*gamev1.CombatEvent {
+	tallies := make(map[string]*briefTally)
+	// slots records, for each output position, either a passthrough event or the
+	// actor whose summary belongs there.
+	type slot struct {
+		evt   *gamev1.CombatEvent
+		actor string
+	}
+	var slots []slot
+	for _, evt := range events {
+		actor := evt.GetAttacker()
+		if evt.GetType() != gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK || actor == "" {
+			slots = append(slots, slot{evt: evt})
+			continue
+		}
+		t, seen := tallies[actor]
+		if !seen {
+			t = &briefTally{}
+			tallies[actor] = t
+			slots = append(slots, slot{actor: actor})
+		}
+		t.actions++
+		switch evt.GetOutcome() {
+		case "critical success":
+			t.hits++
+			t.crits++
+		case "success":
+			t.hits++
+		case "failure", "critical failure":
+			t.misses++
+		}
+		t.damage += evt.GetDamage()
+	}
+	out := make([]*gamev1.CombatEvent, 0, len(slots))
+	for _, sl := range slots {
+		if sl.evt != nil {
+			out = append(out, sl.evt)
+			continue
+		}
+		out = append(out, &gamev1.CombatEvent{
+			Type:      gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK,
+			Attacker:  sl.actor,
+			Damage:    tallies[sl.actor].damage,
+			Narrative: tallies[sl.actor].summary(sl.actor),
+		})
+	}
+	return out
+}
@@ -0,0 +1,159 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// mockCombatVerbositySaver is a CombatVerbositySaver test double.
+//
+// Precondition: none.
+// Postcondition: SaveCombatVerbosity records calls and returns saveErr if set.
+type mockCombatVerbositySaver struct {
+	saveErr   error
+	saveCalls int
+	saved     string
+}
+
+// SaveCombatVerbosity records the call and returns saveErr if set.
+func (m *mockCombatVerbositySaver) SaveCombatVerbosity(_ context.Context, _ int64, level string) error {
+	m.saveCalls++
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.saved = level
+	return nil
+}
+
+func TestHandleCombatVerbosity_NewSessionDefaultsToNormal(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	sess := addPlayerForCombatDefault(t, svc, "u_default", 1)
+	assert.Equal(t, session.CombatVerbosityNormal, sess.CombatVerbosity)
+
+	evt, err := svc.handleCombatVerbosity("u_default", "")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "normal")
+}
+
+func TestHandleCombatVerbosity_PlayerNotFound(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	_, err := svc.handleCombatVerbosity("nobody", "brief")
+	assert.Error(t, err)
+}
+
+func TestHandleCombatVerbosity_InvalidLevel(t *testing.T) {
+	saver := &mockCombatVerbositySaver{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.combatVerbosityRepo = saver
+	sess := addPlayerForCombatDefault(t, svc, "u_invalid", 1)
+
+	evt, err := svc.handleCombatVerbosity("u_invalid", "loud")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Invalid")
+	assert.Equal(t, 0, saver.saveCalls)
+	assert.Equal(t, session.CombatVerbosityNormal, sess.CombatVerbosity)
+}
+
+func TestHandleCombatVerbosity_PersistenceFailure(t *testing.T) {
+	saver := &mockCombatVerbositySaver{saveErr: errors.New("db error")}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.combatVerbosityRepo = saver
+	sess := addPlayerForCombatDefault(t, svc, "u_fail", 7)
+
+	evt, err := svc.handleCombatVerbosity("u_fail", "brief")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Failed")
+	assert.Equal(t, session.CombatVerbosityNormal, sess.CombatVerbosity)
+}
+
+func TestHandleCombatVerbosity_HappyPath(t *testing.T) {
+	saver := &mockCombatVerbositySaver{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.combatVerbosityRepo = saver
+	sess := addPlayerForCombatDefault(t, svc, "u_ok", 7)
+
+	evt, err := svc.handleCombatVerbosity("u_ok", "verbose")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "verbose")
+	assert.Equal(t, 1, saver.saveCalls)
+	assert.Equal(t, "verbose", saver.saved)
+	assert.Equal(t, session.CombatVerbosityVerbose, sess.CombatVerbosity)
+}
+
+func sampleRoundEvents() []*gamev1.CombatEvent {
+	return []*gamev1.CombatEvent{
+		{Type: gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK, Attacker: "Kira", Target: "Rat", AttackRoll: 15, AttackTotal: 20, Outcome: "success", Damage: 6, Narrative: "Kira hits Rat."},
+		{Type: gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK, Attacker: "Rat", Target: "Kira", AttackRoll: 3, AttackTotal: 5, Outcome: "failure", Narrative: "Rat misses Kira."},
+		{Type: gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK, Attacker: "Kira", Target: "Rat", AttackRoll: 20, AttackTotal: 25, Outcome: "critical success", Damage: 12, Narrative: "Kira crits Rat."},
+		{Type: gamev1.CombatEventType_COMBAT_EVENT_TYPE_DEATH, Attacker: "Kira", Target: "Rat", Narrative: "Rat dies."},
+		{Type: gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK, Narrative: "Round 1 complete."},
+	}
+}
+
+func TestFilterCombatEventsForVerbosity_NormalUnchanged(t *testing.T) {
+	events := sampleRoundEvents()
+	out := filterCombatEventsForVerbosity(session.CombatVerbosityNormal, events)
+	assert.Equal(t, events, out)
+}
+
+func TestFilterCombatEventsForVerbosity_VerboseAddsRollDetail(t *testing.T) {
+	events := sampleRoundEvents()
+	out := filterCombatEventsForVerbosity(session.CombatVerbosityVerbose, events)
+	require.Len(t, out, len(events))
+	assert.Contains(t, out[0].Narrative, "d20 15 +5 = 20; success; 6 damage")
+	assert.Equal(t, "Kira hits Rat.", events[0].Narrative, "input must not be mutated")
+	assert.Equal(t, "Round 1 complete.", out[4].Narrative)
+}
+
+func TestFilterCombatEventsForVerbosity_BriefSummarizesPerActor(t *testing.T) {
+	out := filterCombatEventsForVerbosity(session.CombatVerbosityBrief, sampleRoundEvents())
+	require.Len(t, out, 4)
+	assert.Equal(t, "Kira: 2 hits (1 crit), 18 damage.", out[0].Narrative)
+	assert.Equal(t, "Rat: 1 miss.", out[1].Narrative)
+	assert.Equal(t, gamev1.CombatEventType_COMBAT_EVENT_TYPE_DEATH, out[2].Type)
+	assert.Equal(t, "Round 1 complete.", out[3].Narrative)
+}
+
+// TestProperty_FilterCombatEventsForVerbosity_BriefNeverGrows verifies that brief
+// output never exceeds the input length and preserves total damage.
+func TestProperty_FilterCombatEventsForVerbosity_BriefNeverGrows(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		actors := []string{"", "A", "B", "C"}
+		outcomes := []string{"", "success", "failure", "critical success", "critical failure"}
+		n := rapid.IntRange(0, 20).Draw(rt, "n")
+		events := make([]*gamev1.CombatEvent, n)
+		var total int32
+		for i := range events {
+			events[i] = &gamev1.CombatEvent{
+				Type:     gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK,
+				Attacker: rapid.SampledFrom(actors).Draw(rt, "actor"),
+				Outcome:  rapid.SampledFrom(outcomes).Draw(rt, "outcome"),
+				Damage:   int32(rapid.IntRange(0, 20).Draw(rt, "dmg")),
+			}
+			total += events[i].Damage
+		}
+		out := filterCombatEventsForVerbosity(session.CombatVerbosityBrief, events)
+		if len(out) > len(events) {
+			rt.Fatalf("brief output grew: %d > %d", len(out), len(events))
+		}
+		var got int32
+		for _, evt := range out {
+			got += evt.Damage
+			if evt.Attacker != "" && !strings.HasPrefix(evt.Narrative, evt.Attacker+":") {
+				rt.Fatalf("summary %q does not start with actor %q", evt.Narrative, evt.Attacker)
+			}
+		}
+		if got != total {
+			rt.Fatalf("damage total changed: %d != %d", got, total)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleConsider estimates how hard a fight with an NPC in the player's room would be.
+//
+// Precondition: uid must identify an existing player session; req must be non-nil.
+// Postcondition: Returns a message naming the difficulty tier, or a message that
+// the target is not here.
+func (s *GameServiceServer) handleConsider(uid string, req *gamev1.ConsiderRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	inst := s.npcMgr.FindInRoom(sess.RoomID, req.GetTarget())
+	if inst == nil || inst.IsDead() {
+		return messageEvent(fmt.Sprintf("You don't see %q here.", req.GetTarget())), nil
+	}
+
+	// Defense stats (dex mod from Quickness), as on the character sheet.
+	dexMod := (sess.Abilities.Quickness - 10) / 2
+	playerAC := 10 + dexMod
+	if s.invRegistry != nil && sess.Equipment != nil {
+		def := sess.Equipment.ComputedDefensesWithProficienciesAndSetBonuses(s.invRegistry, dexMod, sess.Proficiencies, sess.Level, sess.SetBonusSummary)
+		playerAC = 10 + def.EffectiveDex + def.ACBonus
+	}
+
+	d := npc.Consider(
+		npc.CombatStats{Level: sess.Level, CurrentHP: sess.CurrentHP, AC: playerAC},
+		npc.CombatStats{Level: inst.Level, CurrentHP: inst.CurrentHP, AC: inst.AC},
+	)
+	return messageEvent(fmt.Sprintf("%s [%s]: %s", inst.Name(), d, d.Verdict(inst.Name()))), nil
+}
+
+// handleScan lists the living NPCs in each room reachable through an open exit.
+// Hidden and locked exits are skipped.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: Returns one line per exit with NPCs beyond it, or a line saying nothing is nearby.
+func (s *GameServiceServer) handleScan(uid string) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return nil, fmt.Errorf("room %q not found", sess.RoomID)
+	}
+
+	var lines []string
+	for _, e := range room.VisibleExits() {
+		if e.Locked {
+			continue
+		}
+		var names []string
+		for _, inst := range s.npcMgr.InstancesInRoom(e.TargetRoom) {
+			if !inst.IsDead() {
+				names = append(names, inst.Name())
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		where := string(e.Direction)
+		if target, ok := s.world.GetRoom(e.TargetRoom); ok {
+			where = fmt.Sprintf("%s (%s)", where, target.Title)
+		}
+		lines = append(lines, fmt.Sprintf("  %s: %s", where, strings.Join(names, ", ")))
+	}
+	if len(lines) == 0 {
+		return messageEvent("You scan your surroundings. Nothing stirs nearby."), nil
+	}
+	return messageEvent("You scan your surroundings.\n" + strings.Join(lines, "\n")), nil
+}
@@ -0,0 +1,99 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newScanTestServer builds a server with player "ace" in "plaza". North leads
+// to an open alley, east to a locked vault, and west through a hidden door.
+func newScanTestServer(t *testing.T) (*GameServiceServer, *npc.Manager) {
+	t.Helper()
+	rooms := map[string]*world.Room{
+		"plaza": {ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza.", Exits: []world.Exit{
+			{Direction: world.North, TargetRoom: "alley"},
+			{Direction: world.East, TargetRoom: "vault", Locked: true},
+			{Direction: world.West, TargetRoom: "den", Hidden: true},
+		}},
+		"alley": {ID: "alley", ZoneID: "z", Title: "Rust Alley", Description: "An alley."},
+		"vault": {ID: "vault", ZoneID: "z", Title: "Vault", Description: "A vault."},
+		"den":   {ID: "den", ZoneID: "z", Title: "Den", Description: "A den."},
+	}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza", Rooms: rooms}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	_, err = sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ace", Username: "ace", CharName: "Ace", CharacterID: 1,
+		RoomID: "plaza", CurrentHP: 30, MaxHP: 30, Level: 3,
+		Abilities: character.AbilityScores{Quickness: 14}, Role: "player",
+	})
+	require.NoError(t, err)
+
+	npcMgr := npc.NewManager()
+	return &GameServiceServer{
+		sessions: sMgr,
+		world:    wMgr,
+		npcMgr:   npcMgr,
+		logger:   zap.NewNop(),
+	}, npcMgr
+}
+
+func spawnConsiderNPC(t *testing.T, npcMgr *npc.Manager, id, name, roomID string, level, hp, ac int) *npc.Instance {
+	t.Helper()
+	inst, err := npcMgr.Spawn(&npc.Template{ID: id, Name: name, Level: level, MaxHP: hp, AC: ac}, roomID)
+	require.NoError(t, err)
+	return inst
+}
+
+func TestHandleConsider_RatesNPCInRoom(t *testing.T) {
+	s, npcMgr := newScanTestServer(t)
+	spawnConsiderNPC(t, npcMgr, "rat", "Sewer Rat", "plaza", 0, 4, 10)
+	spawnConsiderNPC(t, npcMgr, "boss", "Warlord", "plaza", 9, 150, 24)
+
+	evt, err := s.handleConsider("ace", &gamev1.ConsiderRequest{Target: "sewer"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Sewer Rat [trivial]")
+
+	evt, err = s.handleConsider("ace", &gamev1.ConsiderRequest{Target: "Warlord"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Warlord [deadly]")
+
+	evt, err = s.handleConsider("ace", &gamev1.ConsiderRequest{Target: "ghost"})
+	require.NoError(t, err)
+	assert.Equal(t, `You don't see "ghost" here.`, evt.GetMessage().GetContent())
+}
+
+func TestHandleScan_ListsNPCsThroughOpenExitsOnly(t *testing.T) {
+	s, npcMgr := newScanTestServer(t)
+
+	evt, err := s.handleScan("ace")
+	require.NoError(t, err)
+	assert.Equal(t, "You scan your surroundings. Nothing stirs nearby.", evt.GetMessage().GetContent())
+
+	spawnConsiderNPC(t, npcMgr, "ganger", "Ganger", "alley", 2, 20, 14)
+	spawnConsiderNPC(t, npcMgr, "dog", "Scav Dog", "alley", 1, 10, 12)
+	spawnConsiderNPC(t, npcMgr, "guard", "Vault Guard", "vault", 4, 40, 18)
+	spawnConsiderNPC(t, npcMgr, "thief", "Thief", "den", 1, 10, 12)
+	dead := spawnConsiderNPC(t, npcMgr, "corpse", "Corpse", "alley", 1, 10, 12)
+	dead.CurrentHP = 0
+
+	evt, err = s.handleScan("ace")
+	require.NoError(t, err)
+	out := evt.GetMessage().GetContent()
+	assert.Contains(t, out, "north (Rust Alley): ")
+	assert.Contains(t, out, "Ganger")
+	assert.Contains(t, out, "Scav Dog")
+	assert.NotContains(t, out, "Corpse")
+	assert.NotContains(t, out, "Vault Guard", "locked exits are not scanned")
+	assert.NotContains(t, out, "Thief", "hidden exits are not scanned")
+}
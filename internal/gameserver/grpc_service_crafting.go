@@ -8,6 +8,8 @@ import (
 
 	"github.com/cory-johannsen/mud/internal/game/command"
 	"github.com/cory-johannsen/mud/internal/game/crafting"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/session"
 	"github.com/cory-johannsen/mud/internal/game/skillcheck"
 	"github.com/cory-johannsen/mud/internal/game/world"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
@@ -168,7 +170,7 @@ func (s *GameServiceServer) handleScavenge(uid string) (*gamev1.ServerEvent, err
 		}
 		sess.Materials[matID] += qty
 		if s.materialRepo != nil {
-			_ = s.materialRepo.Add(context.Background(), sess.CharacterID, matID, qty)
+			_ = s.materialRepo.Add(s.commandCtx(uid), sess.CharacterID, matID, qty)
 		}
 	}
 
@@ -285,6 +287,8 @@ func (s *GameServiceServer) handleCraftList(uid string, req *gamev1.CraftListReq
 				missingTypes++
 			}
 		}
+		_, missingItems := s.planRecipeItems(sess, recipe, 1)
+		missingTypes += len(missingItems)
 
 		// Determine quick-craft eligibility by proficiency rank comparison.
 		canQuickCraft := skillcheck.ProficiencyBonus(riggingRank) >= skillcheck.ProficiencyBonus(recipe.EffectiveMinRank())
@@ -374,6 +378,9 @@ func (s *GameServiceServer) handleCraft(uid string, req *gamev1.CraftRequest) (*
 			missing = append(missing, fmt.Sprintf("%s (need %d, have %d)", name, rm.Quantity, have))
 		}
 	}
+	if _, missingItems := s.planRecipeItems(sess, recipe, 1); len(missingItems) > 0 {
+		missing = append(missing, missingItems...)
+	}
 	if len(missing) > 0 {
 		sort.Strings(missing)
 		return errorEvent(fmt.Sprintf("Missing materials: %s", strings.Join(missing, ", "))), nil
@@ -417,6 +424,9 @@ func (s *GameServiceServer) handleCraftConfirm(uid string) (*gamev1.ServerEvent,
 			missing = append(missing, rm.ID)
 		}
 	}
+	if _, missingItems := s.planRecipeItems(sess, recipe, 1); len(missingItems) > 0 {
+		missing = append(missing, missingItems...)
+	}
 	if len(missing) > 0 {
 		sess.PendingCraftRecipeID = ""
 		sort.Strings(missing)
@@ -462,7 +472,7 @@ func (s *GameServiceServer) handleCraftConfirm(uid string) (*gamev1.ServerEvent,
 
 	// Deduct only the engine-computed amounts via repo and session state.
 	if s.materialRepo != nil && len(craftResult.MaterialsDeducted) > 0 {
-		if err := s.materialRepo.DeductMany(context.Background(), sess.CharacterID, craftResult.MaterialsDeducted); err != nil {
+		if err := s.materialRepo.DeductMany(s.commandCtx(uid), sess.CharacterID, craftResult.MaterialsDeducted); err != nil {
 			return errorEvent("Failed to deduct materials."), nil
 		}
 	}
@@ -472,6 +482,13 @@ func (s *GameServiceServer) handleCraftConfirm(uid string) (*gamev1.ServerEvent,
 			delete(sess.Materials, matID)
 		}
 	}
+	// Item inputs follow the same rule: a Failure wastes half of each.
+	itemDivisor := 1
+	if craftOutcome == crafting.Failure {
+		itemDivisor = 2
+	}
+	itemPlan, _ := s.planRecipeItems(sess, recipe, itemDivisor)
+	s.consumeRecipeItems(sess, itemPlan)
 
 	var sb strings.Builder
 	switch craftOutcome {
@@ -493,6 +510,71 @@ func (s *GameServiceServer) handleCraftConfirm(uid string) (*gamev1.ServerEvent,
 	return messageEvent(sb.String()), nil
 }
 
+// planRecipeItems matches recipe's item inputs against the player's backpack.
+// Each input needs its Quantity divided by divisor (2 when a failed craft
+// wastes half); a tagged input accepts any item for which the registry's
+// ItemHasTag holds. Units claimed by one input are not offered to the next.
+//
+// Precondition: sess must be non-nil; divisor >= 1.
+// Postcondition: Returns instance ID → units to remove, and a "need/have"
+// label for every input the backpack cannot cover.
+func (s *GameServiceServer) planRecipeItems(sess *session.PlayerSession, recipe *crafting.Recipe, divisor int) (map[string]int, []string) {
+	plan := make(map[string]int)
+	var missing []string
+	var items []inventory.ItemInstance
+	if sess.Backpack != nil {
+		items = sess.Backpack.Items()
+	}
+	for _, ri := range recipe.Items {
+		need := ri.Quantity / divisor
+		have := 0
+		for _, inst := range items {
+			if have >= need {
+				break
+			}
+			matches := inst.ItemDefID == ri.ItemID
+			if ri.Tag != "" {
+				matches = s.invRegistry != nil && s.invRegistry.ItemHasTag(inst.ItemDefID, ri.Tag)
+			}
+			free := inst.Quantity - plan[inst.InstanceID]
+			if !matches || free <= 0 {
+				continue
+			}
+			take := free
+			if take > need-have {
+				take = need - have
+			}
+			plan[inst.InstanceID] += take
+			have += take
+		}
+		if have < need {
+			missing = append(missing, fmt.Sprintf("%s (need %d, have %d)", ri.Label(), need, have))
+		}
+	}
+	return plan, missing
+}
+
+// consumeRecipeItems removes the planned units from the player's backpack and
+// persists the result.
+//
+// Precondition: plan was returned by planRecipeItems for the same session.
+// Postcondition: Each planned instance loses its planned units.
+func (s *GameServiceServer) consumeRecipeItems(sess *session.PlayerSession, plan map[string]int) {
+	if len(plan) == 0 || sess.Backpack == nil {
+		return
+	}
+	for instanceID, qty := range plan {
+		if qty > 0 {
+			_ = sess.Backpack.Remove(instanceID, qty)
+		}
+	}
+	if s.charSaver != nil && sess.CharacterID > 0 {
+		if err := s.charSaver.SaveInventory(context.Background(), sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
+			s.logger.Warn("craft: SaveInventory failed", zap.String("uid", sess.UID), zap.Error(err))
+		}
+	}
+}
+
 // findRecipe looks up a recipe by exact ID first, then by case-insensitive name match.
 //
 // Precondition: s.recipeReg must not be nil.
@@ -530,7 +612,7 @@ func (s *GameServiceServer) handleAffix(uid string, req *gamev1.AffixRequest) (*
 	rng := &diceRollerAdapter{r: s.dice}
 	result := command.HandleAffix(as, s.invRegistry, materialQuery, targetQuery, rng)
 
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	characterID := sess.CharacterID
 
 	switch result.Outcome {
@@ -13,6 +13,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/character"
 	"github.com/cory-johannsen/mud/internal/game/command"
 	"github.com/cory-johannsen/mud/internal/game/crafting"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	"github.com/cory-johannsen/mud/internal/game/session"
 	"github.com/cory-johannsen/mud/internal/game/world"
@@ -615,3 +616,56 @@ func TestHandleCraftConfirm_CritFailure_AllMaterialsConsumed(t *testing.T) {
 	// CritFailure: all 4 materials consumed, map entry deleted.
 	assert.Equal(t, 0, sess.Materials["scrap_metal"], "all materials consumed on CritFailure")
 }
+
+// TestHandleCraft_TaggedItemInput verifies that a recipe item input named by tag
+// accepts any backpack item carrying the tag, reports it as missing otherwise,
+// and is consumed from the backpack on a successful craft.
+//
+// Precondition: recipe needs one "melee" item; the knife's weapon carries the tag.
+// Postcondition: craft fails without the knife; confirm removes it on Success.
+func TestHandleCraft_TaggedItemInput(t *testing.T) {
+	invReg := inventory.NewRegistry()
+	require.NoError(t, invReg.RegisterWeapon(&inventory.WeaponDef{ID: "knife", Tags: []string{"melee"}}))
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{ID: "knife_item", Name: "Knife", Kind: inventory.KindWeapon, WeaponRef: "knife", MaxStack: 1}))
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{ID: "rag", Name: "Rag", Kind: inventory.KindJunk, MaxStack: 1}))
+	recipes := []*crafting.Recipe{
+		{
+			ID:          "spear",
+			Name:        "Spear",
+			Category:    "weapon",
+			Complexity:  1,
+			DC:          5,
+			OutputCount: 1,
+			Items:       []crafting.RecipeItem{{Tag: "melee", Quantity: 1}},
+		},
+	}
+	svc, uid := buildCraftingServer(t, newMaterialRegistry(nil), &stubMaterialsRepo{}, nil)
+	svc.recipeReg = newRecipeRegistry(recipes)
+	svc.craftEngine = crafting.NewEngine()
+	svc.invRegistry = invReg
+
+	sess, ok := svc.sessions.GetPlayer(uid)
+	require.True(t, ok)
+	sess.Skills = map[string]string{"rigging": "trained"}
+	sess.Abilities = character.AbilityScores{Savvy: 10}
+	sess.Backpack = inventory.NewBackpack(10, 100)
+	_, err := sess.Backpack.Add("rag", 1, invReg)
+	require.NoError(t, err)
+
+	evt, err := svc.handleCraft(uid, &gamev1.CraftRequest{RecipeId: "spear"})
+	require.NoError(t, err)
+	require.NotNil(t, evt.GetError(), "a rag does not satisfy a melee input")
+	assert.Contains(t, evt.GetError().Message, "any melee (need 1, have 0)")
+
+	_, err = sess.Backpack.Add("knife_item", 1, invReg)
+	require.NoError(t, err)
+	evt, err = svc.handleCraft(uid, &gamev1.CraftRequest{RecipeId: "spear"})
+	require.NoError(t, err)
+	require.Nil(t, evt.GetError())
+
+	evt, err = svc.handleCraftConfirm(uid)
+	require.NoError(t, err)
+	require.NotNil(t, evt.GetMessage())
+	assert.Empty(t, sess.Backpack.FindByItemDefID("knife_item"), "the knife must be consumed")
+	assert.Len(t, sess.Backpack.FindByItemDefID("rag"), 1, "untagged items are left alone")
+}
@@ -0,0 +1,140 @@
+package gameserver
+
+import (
+	"fmt"
+	"math"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/danger"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// defaultFinePerLevel is the fine, in credits per wanted level, charged by
+// guards whose template does not set fine_per_level.
+const defaultFinePerLevel = 200
+
+// maxWantedLevel is the highest wanted level a player can reach in a zone.
+const maxWantedLevel = 4
+
+// crimeProtected reports whether crimes committed in room are policed: the
+// room's effective danger level is safe or sketchy.
+func (s *GameServiceServer) crimeProtected(room *world.Room) bool {
+	zone, ok := s.world.GetZone(room.ZoneID)
+	if !ok {
+		return false
+	}
+	level := danger.EffectiveDangerLevel(zone.DangerLevel, room.DangerLevel)
+	return level == danger.Safe || level == danger.Sketchy
+}
+
+// reportCrime raises the player's wanted level in room's zone when room is
+// protected, persists it, tells the player, and calls in any guards present.
+// deed completes the sentence "Witnesses saw you ...".
+//
+// Precondition: sess and room must be non-nil.
+// Postcondition: Returns true when the deed counted as a crime; the wanted
+// level is capped at maxWantedLevel.
+func (s *GameServiceServer) reportCrime(sess *session.PlayerSession, room *world.Room, deed string) bool {
+	if !s.crimeProtected(room) {
+		return false
+	}
+	zoneID := room.ZoneID
+	level := sess.WantedLevel[zoneID] + 1
+	if level > maxWantedLevel {
+		level = maxWantedLevel
+	}
+	sess.WantedLevel[zoneID] = level
+	if s.wantedRepo != nil {
+		if err := s.wantedRepo.Upsert(s.commandCtx(sess.UID), sess.CharacterID, zoneID, level); err != nil {
+			s.logger.Warn("reportCrime: Upsert wanted level failed", zap.String("uid", sess.UID), zap.Error(err))
+		}
+	}
+	zoneName := zoneID
+	if zone, ok := s.world.GetZone(zoneID); ok {
+		zoneName = zone.Name
+	}
+	s.pushMessageToUID(sess.UID, fmt.Sprintf("Witnesses saw you %s. You are now wanted in %s (wanted level %d).", deed, zoneName, level))
+	if s.combatH != nil && level >= 2 {
+		s.combatH.InitiateGuardCombat(sess.UID, zoneID, level)
+	}
+	return true
+}
+
+// fineFor returns the fine guard charges to clear wantedLevel in room.
+//
+// Postcondition: Returns a positive amount when wantedLevel > 0.
+func (s *GameServiceServer) fineFor(guard *npc.Instance, room *world.Room, wantedLevel int) int {
+	perLevel := defaultFinePerLevel
+	if tmpl := s.npcMgr.TemplateByID(guard.TemplateID); tmpl != nil && tmpl.Guard != nil && tmpl.Guard.FinePerLevel > 0 {
+		perLevel = tmpl.Guard.FinePerLevel
+	}
+	level := string(danger.Sketchy)
+	if zone, ok := s.world.GetZone(room.ZoneID); ok {
+		level = string(danger.EffectiveDangerLevel(zone.DangerLevel, room.DangerLevel))
+	}
+	return int(math.Ceil(float64(wantedLevel*perLevel) * zoneMultiplier(level)))
+}
+
+// handlePayFine pays a guard in the room the fine for the player's crimes in
+// the current zone, clearing their wanted level there.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: On success, credits are deducted, WantedLevel for the zone is
+// 0, and both are persisted.
+func (s *GameServiceServer) handlePayFine(uid string, _ *gamev1.PayFineRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return messageEvent("current room not found"), nil
+	}
+	zoneID := room.ZoneID
+	wantedLevel := sess.WantedLevel[zoneID]
+	if wantedLevel == 0 {
+		return messageEvent("You are not wanted here. There is no fine to pay."), nil
+	}
+	if sess.Status == statusInCombat {
+		return messageEvent("You can't pay a fine in the middle of a fight."), nil
+	}
+
+	var guard *npc.Instance
+	for _, inst := range s.npcMgr.InstancesInRoom(sess.RoomID) {
+		if inst.NPCType != "guard" || inst.IsDead() || (s.combatH != nil && s.combatH.IsInCombat(inst.ID)) {
+			continue
+		}
+		guard = inst
+		break
+	}
+	if guard == nil {
+		return messageEvent("There is no guard here to pay."), nil
+	}
+
+	fine := s.fineFor(guard, room, wantedLevel)
+	if sess.Currency < fine {
+		return messageEvent(fmt.Sprintf("%s wants %d credits for your fine, but you only have %d.", guard.Name(), fine, sess.Currency)), nil
+	}
+	sess.Currency -= fine
+	s.emitCurrency(sess, -fine, telemetry.SourceFine)
+	sess.WantedLevel[zoneID] = 0
+	sess.SafeViolations[zoneID] = 0
+
+	ctx := s.commandCtx(uid)
+	if s.wantedRepo != nil {
+		if err := s.wantedRepo.Upsert(ctx, sess.CharacterID, zoneID, 0); err != nil {
+			s.logger.Warn("handlePayFine: Upsert wanted level failed", zap.String("uid", uid), zap.Error(err))
+		}
+	}
+	if s.charSaver != nil {
+		if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
+			s.logger.Warn("handlePayFine: SaveCurrency failed", zap.String("uid", uid), zap.Error(err))
+		}
+	}
+	return messageEvent(fmt.Sprintf("You pay %s a fine of %d credits. Your record here is clear.", guard.Name(), fine)), nil
+}
@@ -0,0 +1,125 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/danger"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// setRoomDanger overrides the danger level of room_a on svc's world.
+func setRoomDanger(t *testing.T, svc *GameServiceServer, level danger.DangerLevel) {
+	t.Helper()
+	room, ok := svc.world.GetRoom("room_a")
+	require.True(t, ok)
+	room.DangerLevel = string(level)
+}
+
+func TestHandlePayFine_NotWanted(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	sess.WantedLevel["test"] = 0
+	spawnGuard(t, svc)
+
+	evt, err := svc.handlePayFine(uid, &gamev1.PayFineRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "not wanted")
+	assert.Equal(t, 1000, sess.Currency)
+}
+
+func TestHandlePayFine_NoGuard(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+
+	evt, err := svc.handlePayFine(uid, &gamev1.PayFineRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "no guard here")
+}
+
+func TestHandlePayFine_InsufficientFunds(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	sess.Currency = 10
+	spawnGuard(t, svc)
+
+	evt, err := svc.handlePayFine(uid, &gamev1.PayFineRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "only have 10")
+	assert.Equal(t, 10, sess.Currency)
+	assert.Equal(t, 2, sess.WantedLevel["test"])
+}
+
+func TestHandlePayFine_ClearsWantedLevel(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	sess.SafeViolations["test"] = 1
+	spawnGuard(t, svc)
+
+	evt, err := svc.handlePayFine(uid, &gamev1.PayFineRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "a fine of 400 credits")
+	assert.Equal(t, 600, sess.Currency)
+	assert.Equal(t, 0, sess.WantedLevel["test"])
+	assert.Equal(t, 0, sess.SafeViolations["test"])
+}
+
+func TestHandlePayFine_ScalesWithZoneDanger(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+	setRoomDanger(t, svc, danger.Safe)
+	spawnGuard(t, svc)
+
+	evt, err := svc.handlePayFine(uid, &gamev1.PayFineRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "a fine of 320 credits")
+}
+
+func TestReportCrime_IgnoredInUnprotectedRoom(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	setRoomDanger(t, svc, danger.Dangerous)
+	room, _ := svc.world.GetRoom("room_a")
+
+	assert.False(t, svc.reportCrime(sess, room, "attack a bystander"))
+	assert.Equal(t, 2, sess.WantedLevel["test"])
+}
+
+func TestReportCrime_RaisesWantedLevel(t *testing.T) {
+	svc, uid := newBribeTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	sess.WantedLevel["test"] = 0
+	setRoomDanger(t, svc, danger.Sketchy)
+	room, _ := svc.world.GetRoom("room_a")
+
+	require.True(t, svc.reportCrime(sess, room, "attack a bystander"))
+	assert.Equal(t, 1, sess.WantedLevel["test"])
+
+	msgs := drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond)
+	require.NotEmpty(t, msgs)
+	assert.Contains(t, msgs[0], "Witnesses saw you attack a bystander")
+}
+
+// TestProperty_ReportCrime_CapsWantedLevel verifies the wanted level never
+// exceeds maxWantedLevel however many crimes are reported.
+func TestProperty_ReportCrime_CapsWantedLevel(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		svc, uid := newBribeTestServer(t)
+		sess, _ := svc.sessions.GetPlayer(uid)
+		sess.WantedLevel["test"] = 0
+		setRoomDanger(t, svc, danger.Safe)
+		room, _ := svc.world.GetRoom("room_a")
+
+		n := rapid.IntRange(1, 10).Draw(rt, "crimes")
+		for i := 0; i < n; i++ {
+			svc.reportCrime(sess, room, "attack a bystander")
+		}
+		want := n
+		if want > maxWantedLevel {
+			want = maxWantedLevel
+		}
+		assert.Equal(rt, want, sess.WantedLevel["test"])
+	})
+}
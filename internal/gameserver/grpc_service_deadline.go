@@ -0,0 +1,98 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/config"
+)
+
+// defaultCommandTimeout bounds each command until SetTimeouts configures it.
+const defaultCommandTimeout = 5 * time.Second
+
+// commandContexts tracks the context of the command each player is running,
+// so handlers can bound their database and script calls by it. It is safe
+// for concurrent use; the zero value is ready to use.
+type commandContexts struct {
+	mu    sync.Mutex
+	byUID map[string]context.Context
+}
+
+// SetTimeouts installs the per-command and per-script-call deadlines.
+//
+// Precondition: cfg must have passed config validation; MUST be called before sessions start.
+// Postcondition: Subsequent commands run under cfg.Command and Lua calls under cfg.Script.
+func (s *GameServiceServer) SetTimeouts(cfg config.TimeoutConfig) {
+	s.commandTimeout = cfg.Command
+	if s.scriptMgr != nil {
+		s.scriptMgr.SetCallTimeout(cfg.Script)
+	}
+}
+
+// withCommandTimeout derives a context from parent bounded by the command
+// timeout, for work a player's session does outside a command, such as
+// loading or saving the character.
+//
+// Postcondition: The caller must call the returned cancel.
+func (s *GameServiceServer) withCommandTimeout(parent context.Context) (context.Context, context.CancelFunc) {
+	if s.commandTimeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, s.commandTimeout)
+}
+
+// beginCommand starts a command for uid: it derives the command's context
+// from parent, which is the player's stream context, and makes it the context
+// commandCtx returns for uid until the returned func ends the command.
+//
+// Postcondition: Ending the command cancels its context and restores uid's
+// previous command context, so a command forced on a player who is running
+// one of their own leaves theirs in place.
+func (s *GameServiceServer) beginCommand(parent context.Context, uid string) func() {
+	ctx, cancel := s.withCommandTimeout(parent)
+	c := &s.inflight
+	c.mu.Lock()
+	if c.byUID == nil {
+		c.byUID = make(map[string]context.Context)
+	}
+	prev, hadPrev := c.byUID[uid]
+	c.byUID[uid] = ctx
+	c.mu.Unlock()
+
+	start := time.Now()
+	return func() {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			s.logger.Warn("command exceeded its deadline",
+				zap.String("uid", uid),
+				zap.Duration("elapsed", time.Since(start)),
+			)
+		}
+		cancel()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.byUID[uid] != ctx {
+			return
+		}
+		if hadPrev {
+			c.byUID[uid] = prev
+		} else {
+			delete(c.byUID, uid)
+		}
+	}
+}
+
+// commandCtx returns the context of the command uid is running, for the
+// database calls a handler makes. Outside a command, as when a handler is
+// called directly, it returns context.Background().
+func (s *GameServiceServer) commandCtx(uid string) context.Context {
+	s.inflight.mu.Lock()
+	defer s.inflight.mu.Unlock()
+	if ctx, ok := s.inflight.byUID[uid]; ok {
+		return ctx
+	}
+	return context.Background()
+}
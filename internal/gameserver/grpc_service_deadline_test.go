@@ -0,0 +1,73 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// stalledReportStore never answers CreateReport before its context ends.
+type stalledReportStore struct {
+	fakeReportStore
+}
+
+func (f *stalledReportStore) CreateReport(ctx context.Context, _ postgres.Report) (int64, error) {
+	<-ctx.Done()
+	return 0, ctx.Err()
+}
+
+func TestBeginCommand_DerivesDeadlineAndRestores(t *testing.T) {
+	s := &GameServiceServer{logger: zap.NewNop()}
+	s.SetTimeouts(config.TimeoutConfig{Command: time.Minute})
+	assert.Equal(t, context.Background(), s.commandCtx("u1"))
+
+	stream, cancelStream := context.WithCancel(context.Background())
+	defer cancelStream()
+	end := s.beginCommand(stream, "u1")
+	ctx := s.commandCtx("u1")
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	assert.Equal(t, context.Background(), s.commandCtx("u2"), "other players are unaffected")
+
+	forced := s.beginCommand(ctx, "u1")
+	assert.NotEqual(t, ctx, s.commandCtx("u1"))
+	forced()
+	assert.Equal(t, ctx, s.commandCtx("u1"), "ending a nested command restores the outer one")
+
+	cancelStream()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "a closed stream cancels its command")
+	end()
+	assert.Equal(t, context.Background(), s.commandCtx("u1"))
+}
+
+func TestBeginCommand_ZeroTimeoutHasNoDeadline(t *testing.T) {
+	s := &GameServiceServer{logger: zap.NewNop()}
+	s.SetTimeouts(config.TimeoutConfig{})
+	end := s.beginCommand(context.Background(), "u1")
+	defer end()
+	_, ok := s.commandCtx("u1").Deadline()
+	assert.False(t, ok)
+}
+
+func TestCommandDeadline_BoundsStalledStore(t *testing.T) {
+	svc, player, _, _ := newReportTestServer(t)
+	svc.reportStore = &stalledReportStore{}
+	svc.SetTimeouts(config.TimeoutConfig{Command: 20 * time.Millisecond})
+
+	end := svc.beginCommand(context.Background(), player.UID)
+	defer end()
+	start := time.Now()
+	evt, err := svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "bug", Text: "stuck"})
+	require.NoError(t, err)
+	assert.Equal(t, "Your report could not be filed. Please try again later.", evt.GetError().GetMessage())
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,234 @@
+package gameserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// pickTreasureRoom chooses the room a new treasure map points to: an outdoor,
+// ungated, non-boss room in the same zone as fromRoomID, other than it.
+//
+// Precondition: w and src must not be nil.
+// Postcondition: Returns ("", false) when the zone has no eligible room.
+func pickTreasureRoom(w *world.Manager, fromRoomID string, src interface{ Intn(n int) int }) (string, bool) {
+	from, ok := w.GetRoom(fromRoomID)
+	if !ok {
+		return "", false
+	}
+	zone, ok := w.GetZone(from.ZoneID)
+	if !ok {
+		return "", false
+	}
+	var candidates []string
+	for id, r := range zone.Rooms {
+		if id == fromRoomID || r.Indoor || r.BossRoom || r.MinFactionTierID != "" || r.MinRep != nil {
+			continue
+		}
+		candidates = append(candidates, id)
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.Strings(candidates)
+	return candidates[src.Intn(len(candidates))], true
+}
+
+// treasureMapClue returns the n-th clue (1-based) a treasure map gives about
+// its target room: the zone, then a landmark from the room's description,
+// then the room itself.
+//
+// Precondition: n is in [1, inventory.TreasureMapClues].
+func treasureMapClue(w *world.Manager, target *world.Room, n int) string {
+	switch n {
+	case 1:
+		zoneName := target.ZoneID
+		if zone, ok := w.GetZone(target.ZoneID); ok {
+			zoneName = zone.Name
+		}
+		return fmt.Sprintf("The map shows a stretch of %s.", zoneName)
+	case 2:
+		landmark, _, _ := strings.Cut(strings.TrimSpace(target.Description), ".")
+		return fmt.Sprintf("A note in the margin reads: %q", strings.TrimSpace(landmark)+".")
+	default:
+		return fmt.Sprintf("An X marks %s.", target.Title)
+	}
+}
+
+// treasureMapClues returns the clues revealed so far on the map inst.
+//
+// Postcondition: Returns nil when inst points at no known room.
+func (s *GameServiceServer) treasureMapClues(inst inventory.ItemInstance) []string {
+	target, ok := s.world.GetRoom(inst.MapRoomID)
+	if !ok {
+		return nil
+	}
+	clues := make([]string, 0, inst.MapClues)
+	for n := 1; n <= inst.MapClues && n <= inventory.TreasureMapClues; n++ {
+		clues = append(clues, treasureMapClue(s.world, target, n))
+	}
+	return clues
+}
+
+// diceSrc returns the server's dice source, or a crypto source when the
+// server has no roller.
+func (s *GameServiceServer) diceSrc() dice.Source {
+	if s.dice != nil {
+		return s.dice.Src()
+	}
+	return dice.NewCryptoSource()
+}
+
+// handleDig digs in the player's current room. If a treasure map in the pack
+// points here, its cache is unearthed: the credits go to the player, the items
+// are left on the floor, and the map is used up. Otherwise each map reveals its
+// next clue once the player digs close enough to its target — anywhere in the
+// target's zone for the landmark, and one exit away for the room itself.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil.
+func (s *GameServiceServer) handleDig(uid string, _ *gamev1.DigRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return errorEvent("player not found"), nil
+	}
+	if sess.Status == statusInCombat {
+		return messageEvent("You can't dig in the middle of a fight."), nil
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return messageEvent("current room not found"), nil
+	}
+	if room.Indoor {
+		return messageEvent("The floor here is too hard to dig."), nil
+	}
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return messageEvent("You dig around but find nothing."), nil
+	}
+
+	src := s.diceSrc()
+	var maps []*inventory.ItemInstance
+	var lines []string
+	for _, it := range sess.Backpack.Items() {
+		def, ok := s.invRegistry.Item(it.ItemDefID)
+		if !ok || def.TreasureCache == nil {
+			continue
+		}
+		inst := sess.Backpack.MutableItem(it.InstanceID)
+		if inst.MapRoomID == "" {
+			// Maps that did not come from loot are charted on first use.
+			target, ok := pickTreasureRoom(s.world, sess.RoomID, src)
+			if !ok {
+				continue
+			}
+			inst.MapRoomID, inst.MapClues = target, 1
+			if targetRoom, ok := s.world.GetRoom(target); ok {
+				lines = append(lines, "You unfold a fresh map. "+treasureMapClue(s.world, targetRoom, 1))
+			}
+			continue
+		}
+		if inst.MapRoomID == room.ID {
+			return s.unearthCache(sess, inst, def, src), nil
+		}
+		maps = append(maps, inst)
+	}
+	if len(maps) == 0 && len(lines) == 0 {
+		return messageEvent("You dig around but, without a map, find nothing."), nil
+	}
+
+	for _, inst := range maps {
+		target, ok := s.world.GetRoom(inst.MapRoomID)
+		if !ok || inst.MapClues >= inventory.TreasureMapClues {
+			continue
+		}
+		near := target.ZoneID == room.ZoneID
+		if inst.MapClues >= 2 {
+			near = roomsAdjacent(room, target)
+		}
+		if near {
+			inst.MapClues++
+			lines = append(lines, "Studying your map again, you notice something new. "+treasureMapClue(s.world, target, inst.MapClues))
+		}
+	}
+	if len(lines) > 0 {
+		if s.charSaver != nil {
+			if err := s.saveInventory(sess); err != nil {
+				s.logger.Warn("handleDig: SaveInventory failed", zap.Error(err))
+			}
+		}
+		s.pushInventory(sess)
+	}
+	return messageEvent(strings.Join(append([]string{"You dig but find nothing buried here."}, lines...), "\n")), nil
+}
+
+// unearthCache digs up the cache the map inst points to.
+//
+// Precondition: inst is a treasure map in sess.Backpack targeting sess.RoomID.
+// Postcondition: The map is removed, the cache's credits are added to the
+// player, and its items are dropped on the room floor.
+func (s *GameServiceServer) unearthCache(sess *session.PlayerSession, inst *inventory.ItemInstance, def *inventory.ItemDef, src dice.Source) *gamev1.ServerEvent {
+	cache := s.invRegistry.RollTreasureCache(def.TreasureCache, src)
+	if err := sess.Backpack.Remove(inst.InstanceID, 1); err != nil {
+		s.logger.Warn("handleDig: removing map failed", zap.Error(err))
+		return messageEvent("You dig but find nothing buried here.")
+	}
+	sess.Currency += cache.Currency
+	s.emitCurrency(sess, cache.Currency, telemetry.SourceDig)
+
+	var found []string
+	if cache.Currency > 0 {
+		found = append(found, inventory.FormatCrypto(cache.Currency))
+	}
+	if s.floorMgr != nil {
+		for _, id := range cache.ItemIDs {
+			item := inventory.ItemInstance{InstanceID: uuid.New().String(), ItemDefID: id, Quantity: 1}
+			itemDef, _ := s.invRegistry.Item(id)
+			if !itemDef.Stackable {
+				item.Affixes = s.invRegistry.RollAffixes(itemDef.Kind, src)
+			}
+			s.floorMgr.Drop(sess.RoomID, item)
+			found = append(found, s.invRegistry.AffixedName(itemDef.Name, item.Affixes))
+		}
+	}
+
+	if s.charSaver != nil {
+		if err := s.saveInventory(sess); err != nil {
+			s.logger.Warn("handleDig: SaveInventory failed", zap.Error(err))
+		}
+		if err := s.charSaver.SaveCurrency(s.commandCtx(sess.UID), sess.CharacterID, sess.Currency); err != nil {
+			s.logger.Warn("handleDig: SaveCurrency failed", zap.Error(err))
+		}
+	}
+	s.pushInventory(sess)
+	s.pushRoomViewToAllInRoom(sess.RoomID)
+
+	if len(found) == 0 {
+		return messageEvent("You unearth a buried cache, but it has been picked clean.")
+	}
+	return messageEvent("You unearth a buried cache: " + strings.Join(found, ", ") + ".")
+}
+
+// roomsAdjacent reports whether an exit leads between a and b.
+func roomsAdjacent(a, b *world.Room) bool {
+	for _, e := range a.Exits {
+		if e.TargetRoom == b.ID {
+			return true
+		}
+	}
+	for _, e := range b.Exits {
+		if e.TargetRoom == a.ID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newDigTestServer builds a zone of four rooms in a row — camp, road, ridge,
+// grove — plus an indoor shack, and a player in camp carrying a treasure map
+// that points at grove.
+func newDigTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	room := func(id, title, desc string, exits ...world.Exit) *world.Room {
+		return &world.Room{ID: id, ZoneID: "wilds", Title: title, Description: desc, Exits: exits}
+	}
+	east := func(to string) world.Exit { return world.Exit{Direction: world.East, TargetRoom: to} }
+	west := func(to string) world.Exit { return world.Exit{Direction: world.West, TargetRoom: to} }
+	rooms := map[string]*world.Room{
+		"camp":  room("camp", "Camp", "A cold fire pit.", east("road")),
+		"road":  room("road", "Old Road", "Cracked asphalt.", east("ridge"), west("camp")),
+		"ridge": room("ridge", "Ridge", "Wind-bent pines.", east("grove"), west("road")),
+		"grove": room("grove", "Hollow Grove", "A dead oak leans over a rusted bathtub. Crows watch.", west("ridge")),
+		"shack": {ID: "shack", ZoneID: "wilds", Title: "Shack", Description: "Four walls.", Indoor: true},
+	}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "wilds", Name: "The Wilds", StartRoom: "camp", Rooms: rooms}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	svc := testServiceWithNPCMgr(t, wMgr, sMgr, npc.NewManager())
+	svc.floorMgr = inventory.NewFloorManager()
+	svc.dice = dice.NewRoller(dice.NewDeterministicSource([]int{0}))
+	svc.invRegistry = inventory.NewRegistry()
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "treasure_map", Name: "Treasure Map", Kind: inventory.KindJunk, MaxStack: 1,
+		TreasureCache: &inventory.TreasureCacheDef{CurrencyMin: 250, CurrencyMax: 250, ItemTags: []string{"buried_treasure"}},
+	}))
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "idol", Name: "Golden Idol", Kind: inventory.KindJunk, MaxStack: 1, Tags: []string{"buried_treasure"},
+	}))
+
+	sess, err := sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "digger", Username: "digger", CharName: "Digger", RoomID: "camp",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	_, err = sess.Backpack.AddWithMetadata("treasure_map", 1,
+		&inventory.ItemMetadata{MapRoomID: "grove", MapClues: 1}, svc.invRegistry)
+	require.NoError(t, err)
+	return svc, sess
+}
+
+func digMessage(t *testing.T, svc *GameServiceServer) string {
+	t.Helper()
+	evt, err := svc.handleDig("digger", &gamev1.DigRequest{})
+	require.NoError(t, err)
+	return evt.GetMessage().GetContent()
+}
+
+func mapClues(t *testing.T, sess *session.PlayerSession) int {
+	t.Helper()
+	maps := sess.Backpack.FindByItemDefID("treasure_map")
+	require.Len(t, maps, 1)
+	return maps[0].MapClues
+}
+
+func TestHandleDig_RevealsCluesAsPlayerClosesIn(t *testing.T) {
+	svc, sess := newDigTestServer(t)
+
+	// Anywhere in the zone reveals the landmark; the room itself needs adjacency.
+	assert.Contains(t, digMessage(t, svc), `"A dead oak leans over a rusted bathtub."`)
+	assert.Equal(t, 2, mapClues(t, sess))
+	assert.Equal(t, "You dig but find nothing buried here.", digMessage(t, svc))
+	assert.Equal(t, 2, mapClues(t, sess))
+
+	sess.RoomID = "ridge"
+	assert.Contains(t, digMessage(t, svc), "An X marks Hollow Grove.")
+	assert.Equal(t, 3, mapClues(t, sess))
+
+	inv, err := svc.handleInventory("digger")
+	require.NoError(t, err)
+	require.Len(t, inv.GetInventoryView().GetItems(), 1)
+	assert.Contains(t, inv.GetInventoryView().GetItems()[0].GetEffectsSummary(), "The map shows a stretch of The Wilds.")
+
+	sess.RoomID = "shack"
+	assert.Equal(t, "The floor here is too hard to dig.", digMessage(t, svc))
+}
+
+func TestHandleDig_UnearthsCacheInTargetRoom(t *testing.T) {
+	svc, sess := newDigTestServer(t)
+	sess.RoomID = "grove"
+
+	msg := digMessage(t, svc)
+	assert.Contains(t, msg, "You unearth a buried cache")
+	assert.Contains(t, msg, "Golden Idol")
+	assert.Equal(t, 250, sess.Currency)
+	assert.Empty(t, sess.Backpack.FindByItemDefID("treasure_map"))
+	floor := svc.floorMgr.ItemsInRoom("grove")
+	require.Len(t, floor, 1)
+	assert.Equal(t, "idol", floor[0].ItemDefID)
+
+	assert.Contains(t, digMessage(t, svc), "without a map")
+}
+
+func TestHandleDig_ChartsUnchartedMap(t *testing.T) {
+	svc, sess := newDigTestServer(t)
+	maps := sess.Backpack.FindByItemDefID("treasure_map")
+	require.Len(t, maps, 1)
+	sess.Backpack.MutableItem(maps[0].InstanceID).MapRoomID = ""
+
+	assert.Contains(t, digMessage(t, svc), "You unfold a fresh map. The map shows a stretch of The Wilds.")
+	charted := sess.Backpack.FindByItemDefID("treasure_map")[0]
+	// Camp itself and the indoor shack are never chosen; the first of grove, ridge, road is.
+	assert.Equal(t, "grove", charted.MapRoomID)
+	assert.Equal(t, 1, charted.MapClues)
+}
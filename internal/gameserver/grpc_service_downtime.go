@@ -92,7 +92,7 @@ func (s *GameServiceServer) handleDowntimeQueueAdd(uid string, sess *session.Pla
 	if s.downtimeQueueRepo == nil {
 		return messageEvent("Downtime queue is not available."), nil
 	}
-	entries, err := s.downtimeQueueRepo.ListQueue(context.Background(), sess.CharacterID)
+	entries, err := s.downtimeQueueRepo.ListQueue(s.commandCtx(uid), sess.CharacterID)
 	if err != nil {
 		return messageEvent("Failed to read queue."), nil
 	}
@@ -116,7 +116,7 @@ func (s *GameServiceServer) handleDowntimeQueueAdd(uid string, sess *session.Pla
 			s.pushMessageToUID(uid, fmt.Sprintf("Warning: %q is not currently in your inventory. It will be re-checked when the activity starts.", activityArgs))
 		}
 	}
-	if err := s.downtimeQueueRepo.Enqueue(context.Background(), sess.CharacterID, act.ID, activityArgs); err != nil {
+	if err := s.downtimeQueueRepo.Enqueue(s.commandCtx(uid), sess.CharacterID, act.ID, activityArgs); err != nil {
 		return messageEvent("Failed to add to queue."), nil
 	}
 	return messageEvent(fmt.Sprintf("Queued: %s (position %d).", act.Name, len(entries)+1)), nil
@@ -130,7 +130,7 @@ func (s *GameServiceServer) handleDowntimeQueueList(uid string, sess *session.Pl
 	if s.downtimeQueueRepo == nil {
 		return messageEvent("Downtime queue is not available."), nil
 	}
-	entries, err := s.downtimeQueueRepo.ListQueue(context.Background(), sess.CharacterID)
+	entries, err := s.downtimeQueueRepo.ListQueue(s.commandCtx(uid), sess.CharacterID)
 	if err != nil {
 		return messageEvent("Failed to read queue."), nil
 	}
@@ -169,7 +169,7 @@ func (s *GameServiceServer) handleDowntimeQueueClear(uid string, sess *session.P
 	if s.downtimeQueueRepo == nil {
 		return messageEvent("Downtime queue is not available."), nil
 	}
-	if err := s.downtimeQueueRepo.Clear(context.Background(), sess.CharacterID); err != nil {
+	if err := s.downtimeQueueRepo.Clear(s.commandCtx(uid), sess.CharacterID); err != nil {
 		return messageEvent(fmt.Sprintf("Failed to clear queue: %v", err)), nil
 	}
 	return messageEvent("Downtime queue cleared. Active activity (if any) is unaffected."), nil
@@ -188,7 +188,7 @@ func (s *GameServiceServer) handleDowntimeQueueRemove(uid string, sess *session.
 	if pos < 1 {
 		return messageEvent("Usage: downtime queue remove <position>"), nil
 	}
-	if err := s.downtimeQueueRepo.RemoveAt(context.Background(), sess.CharacterID, pos); err != nil {
+	if err := s.downtimeQueueRepo.RemoveAt(s.commandCtx(uid), sess.CharacterID, pos); err != nil {
 		return messageEvent(fmt.Sprintf("Failed to remove queue entry: %v", err)), nil
 	}
 	return messageEvent(fmt.Sprintf("Queue position %d removed.", pos)), nil
@@ -248,7 +248,7 @@ func (s *GameServiceServer) downtimeCancel(uid string, sess *session.PlayerSessi
 	sess.DowntimeMetadata = ""
 
 	if s.downtimeRepo != nil && sess.CharacterID > 0 {
-		_ = s.downtimeRepo.Clear(context.Background(), sess.CharacterID)
+		_ = s.downtimeRepo.Clear(s.commandCtx(uid), sess.CharacterID)
 	}
 
 	act, ok := downtime.ActivityByID(actID)
@@ -311,6 +311,8 @@ func (s *GameServiceServer) downtimeStart(uid string, sess *session.PlayerSessio
 				missing = append(missing, rm.ID)
 			}
 		}
+		itemPlan, missingItems := s.planRecipeItems(sess, recipe, 1)
+		missing = append(missing, missingItems...)
 		if len(missing) > 0 {
 			sort.Strings(missing)
 			return messageEvent(fmt.Sprintf("Missing materials for %s: %s.", recipe.Name, strings.Join(missing, ", ")))
@@ -321,7 +323,7 @@ func (s *GameServiceServer) downtimeStart(uid string, sess *session.PlayerSessio
 			for _, rm := range recipe.Materials {
 				deductions[rm.ID] = rm.Quantity
 			}
-			if err := s.materialRepo.DeductMany(context.Background(), sess.CharacterID, deductions); err != nil {
+			if err := s.materialRepo.DeductMany(s.commandCtx(uid), sess.CharacterID, deductions); err != nil {
 				return messageEvent("Failed to deduct materials. Please try again.")
 			}
 		}
@@ -331,6 +333,7 @@ func (s *GameServiceServer) downtimeStart(uid string, sess *session.PlayerSessio
 				delete(sess.Materials, rm.ID)
 			}
 		}
+		s.consumeRecipeItems(sess, itemPlan)
 	}
 
 	// Gate retrain on feat selection and validation. (REQ-RETRAIN-DT-1, REQ-RETRAIN-DT-2)
@@ -367,7 +370,7 @@ func (s *GameServiceServer) downtimeStart(uid string, sess *session.PlayerSessio
 			CompletesAt: completesAt,
 			RoomID:      sess.RoomID,
 		}
-		_ = s.downtimeRepo.Save(context.Background(), sess.CharacterID, state)
+		_ = s.downtimeRepo.Save(s.commandCtx(uid), sess.CharacterID, state)
 	}
 
 	return messageEvent(fmt.Sprintf(
@@ -482,6 +485,8 @@ func (s *GameServiceServer) startNext(uid string) {
 				missing = append(missing, rm.ID)
 			}
 		}
+		itemPlan, missingItems := s.planRecipeItems(sess, recipe, 1)
+		missing = append(missing, missingItems...)
 		if len(missing) > 0 {
 			sort.Strings(missing)
 			s.pushMessageToUID(uid, fmt.Sprintf("Skipped queued craft (%s): missing materials: %s.", recipe.Name, strings.Join(missing, ", ")))
@@ -506,6 +511,7 @@ func (s *GameServiceServer) startNext(uid string) {
 				delete(sess.Materials, rm.ID)
 			}
 		}
+		s.consumeRecipeItems(sess, itemPlan)
 	}
 
 	durationMin := downtimeActivityDuration(act, entry.ActivityArgs, s.recipeReg)
@@ -15,6 +15,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/inventory"
 	"github.com/cory-johannsen/mud/internal/game/session"
 	"github.com/cory-johannsen/mud/internal/game/skillcheck"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // defaultDC is the fallback DC for skill checks when no zone or metadata DC is provided.
@@ -180,6 +181,7 @@ func (s *GameServiceServer) resolveEarnCreds(uid string, sess *session.PlayerSes
 	}
 
 	sess.Currency += earned
+	s.emitCurrency(sess, earned, telemetry.SourceDowntime)
 
 	if s.charSaver != nil && sess.CharacterID > 0 {
 		_ = s.charSaver.SaveCurrency(context.Background(), sess.CharacterID, sess.Currency)
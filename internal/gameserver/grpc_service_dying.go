@@ -0,0 +1,100 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// stabilizeBaseDC is the DC of stabilizing a dying ally with first aid; the
+// ally's dying value is added to it.
+const stabilizeBaseDC = 15
+
+// handleFirstAidStabilize tries to stabilize the dying ally named target with
+// a patch_job check against DC 15 + their dying value. A success ends the
+// ally's dying condition (they gain wounded and come to with 1 HP); a critical
+// failure raises their dying value by 1. Costs 2 AP in combat.
+//
+// Precondition: sess must not be nil; target non-empty.
+// Postcondition: Returns a non-nil ServerEvent; error only on dice failures.
+func (s *GameServiceServer) handleFirstAidStabilize(sess *session.PlayerSession, target string) (*gamev1.ServerEvent, error) {
+	var ally *session.PlayerSession
+	for _, p := range s.sessions.PlayersInRoomDetails(sess.RoomID) {
+		if strings.EqualFold(p.CharName, target) {
+			ally = p
+			break
+		}
+	}
+	if ally == nil {
+		return errorEvent(fmt.Sprintf("You don't see %s here.", target)), nil
+	}
+	if ally.UID == sess.UID {
+		return errorEvent("You cannot stabilize yourself. Spend a hero point instead: heropoint stabilize."), nil
+	}
+	if s.combatH == nil {
+		return errorEvent("Combat handler unavailable."), nil
+	}
+	dying := s.combatH.DyingValue(ally.UID)
+	if dying == 0 {
+		return errorEvent(fmt.Sprintf("%s is not dying.", ally.CharName)), nil
+	}
+	if sess.Status == statusInCombat {
+		if err := s.combatH.SpendAP(sess.UID, 2); err != nil {
+			return errorEvent(err.Error()), nil
+		}
+	}
+	if s.dice == nil {
+		return nil, fmt.Errorf("handleFirstAidStabilize: dice roller not configured")
+	}
+	rollResult, err := s.dice.RollExpr("1d20")
+	if err != nil {
+		return nil, fmt.Errorf("handleFirstAidStabilize: rolling d20: %w", err)
+	}
+	roll := rollResult.Total()
+	bonus := skillRankBonus(sess.Skills["patch_job"])
+	total := roll + bonus
+	dc := stabilizeBaseDC + dying
+	sess.LastCheckRoll = roll
+	sess.LastCheckDC = dc
+	sess.LastCheckName = "first_aid"
+
+	check := fmt.Sprintf("Stabilize check: rolled %d+%d=%d vs DC %d", roll, bonus, total, dc)
+	switch {
+	case total >= dc:
+		if err := s.combatH.StabilizeDying(ally.UID, true); err != nil {
+			return errorEvent(err.Error()), nil
+		}
+		s.pushMessageToUID(ally.UID, fmt.Sprintf("%s stabilizes you. You come to with 1 HP.", sess.CharName))
+		return messageEvent(fmt.Sprintf("%s — success! You stabilize %s.", check, ally.CharName)), nil
+	case total <= dc-10:
+		if err := s.combatH.WorsenDying(ally.UID, 1); err != nil {
+			s.logger.Warn("handleFirstAidStabilize: worsening dying", zap.String("uid", ally.UID), zap.Error(err))
+		}
+		return messageEvent(fmt.Sprintf("%s — critical failure. Your fumbling makes %s's condition worse.", check, ally.CharName)), nil
+	default:
+		return messageEvent(fmt.Sprintf("%s — failure. %s is still dying.", check, ally.CharName)), nil
+	}
+}
+
+// heroicRecovery spends a hero point to pull a player back from dying mid-fight:
+// the dying condition ends without adding wounded and they come to with 1 HP.
+//
+// Precondition: sess.HeroPoints >= 1; the player is dying in combat.
+// Postcondition: HeroPoints decremented and persisted on success.
+func (s *GameServiceServer) heroicRecovery(sess *session.PlayerSession) (*gamev1.ServerEvent, error) {
+	if err := s.combatH.StabilizeDying(sess.UID, false); err != nil {
+		return errorEvent(err.Error()), nil
+	}
+	sess.HeroPoints--
+	if s.charSaver != nil && sess.CharacterID != 0 {
+		if hpErr := s.charSaver.SaveHeroPoints(s.commandCtx(sess.UID), sess.CharacterID, sess.HeroPoints); hpErr != nil {
+			s.logger.Warn("heroicRecovery: SaveHeroPoints failed", zap.Error(hpErr))
+		}
+	}
+	s.pushCharacterSheet(sess)
+	return messageEvent("You spend a hero point and fight your way back from the brink. You come to with 1 HP."), nil
+}
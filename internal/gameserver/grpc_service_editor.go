@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -21,9 +20,6 @@ func (s *GameServiceServer) handleSpawnNPC(uid string, req *gamev1.SpawnNPCReque
 	if !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 
 	tmpl, ok := s.respawnMgr.GetTemplate(req.GetTemplateId())
 	if !ok {
@@ -51,13 +47,9 @@ func (s *GameServiceServer) handleSpawnNPC(uid string, req *gamev1.SpawnNPCReque
 // Precondition: worldEditor must be non-nil; zone_id and room_id must be non-empty.
 // Postcondition: New room added to zone YAML and hot-reloaded.
 func (s *GameServiceServer) handleAddRoom(uid string, req *gamev1.AddRoomRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if s.worldEditor == nil {
 		return errorEvent("world-editing is not available on this server"), nil
 	}
@@ -73,13 +65,9 @@ func (s *GameServiceServer) handleAddRoom(uid string, req *gamev1.AddRoomRequest
 // Precondition: worldEditor must be non-nil; from_room_id, direction, to_room_id must be non-empty.
 // Postcondition: Exit added in affected zone YAML(s) and hot-reloaded.
 func (s *GameServiceServer) handleAddLink(uid string, req *gamev1.AddLinkRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if s.worldEditor == nil {
 		return errorEvent("world-editing is not available on this server"), nil
 	}
@@ -95,13 +83,9 @@ func (s *GameServiceServer) handleAddLink(uid string, req *gamev1.AddLinkRequest
 // Precondition: worldEditor must be non-nil; room_id and direction must be non-empty.
 // Postcondition: Exit removed in zone YAML and hot-reloaded.
 func (s *GameServiceServer) handleRemoveLink(uid string, req *gamev1.RemoveLinkRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if s.worldEditor == nil {
 		return errorEvent("world-editing is not available on this server"), nil
 	}
@@ -122,9 +106,6 @@ func (s *GameServiceServer) handleSetRoom(uid string, req *gamev1.SetRoomRequest
 	if !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if s.worldEditor == nil {
 		return errorEvent("world-editing is not available on this server"), nil
 	}
@@ -147,13 +128,9 @@ func (s *GameServiceServer) handleSetRoom(uid string, req *gamev1.SetRoomRequest
 // Precondition: caller must have editor or admin role.
 // Postcondition: Returns sorted list of all CategoryEditor commands with descriptions.
 func (s *GameServiceServer) handleEditorCmds(uid string) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 
 	allCmds := s.commands.Commands()
 	var editorCmds []*command.Command
@@ -180,13 +157,9 @@ func (s *GameServiceServer) handleEditorCmds(uid string) (*gamev1.ServerEvent, e
 // Postcondition: A new character is created and persisted for the claude_player account,
 // or an error event is returned if any precondition fails.
 func (s *GameServiceServer) handleSpawnChar(uid string, req *gamev1.SpawnCharRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return messageEvent("You are not in the game."), nil
 	}
-	if deny := requireEditor(sess); deny != nil {
-		return deny, nil
-	}
 	if req.GetName() == "" {
 		return messageEvent("Usage: spawn_char <name>"), nil
 	}
@@ -197,7 +170,7 @@ func (s *GameServiceServer) handleSpawnChar(uid string, req *gamev1.SpawnCharReq
 	if !ok {
 		return messageEvent("spawn_char: character repository does not support creation"), nil
 	}
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	claudeAcct, err := s.accountAdmin.GetAccountByUsername(ctx, "claude_player")
 	if err != nil {
 		return messageEvent(fmt.Sprintf("spawn_char: claude_player account not found: %v", err)), nil
@@ -231,13 +204,9 @@ func (s *GameServiceServer) handleSpawnChar(uid string, req *gamev1.SpawnCharReq
 // Precondition: uid session must exist; req.Name must be non-empty; character must belong to claude_player.
 // Postcondition: The named character is permanently removed, or an error event is returned.
 func (s *GameServiceServer) handleDeleteChar(uid string, req *gamev1.DeleteCharRequest) (*gamev1.ServerEvent, error) {
-	sess, ok := s.sessions.GetPlayer(uid)
-	if !ok {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
 		return messageEvent("You are not in the game."), nil
 	}
-	if deny := requireEditor(sess); deny != nil {
-		return deny, nil
-	}
 	if req.GetName() == "" {
 		return messageEvent("Usage: delete_char <name>"), nil
 	}
@@ -248,7 +217,7 @@ func (s *GameServiceServer) handleDeleteChar(uid string, req *gamev1.DeleteCharR
 	if !ok {
 		return messageEvent("delete_char: character repository does not support deletion"), nil
 	}
-	ctx := context.Background()
+	ctx := s.commandCtx(uid)
 	claudeAcct, err := s.accountAdmin.GetAccountByUsername(ctx, "claude_player")
 	if err != nil {
 		return messageEvent(fmt.Sprintf("delete_char: claude_player account not found: %v", err)), nil
@@ -269,9 +238,6 @@ func (s *GameServiceServer) handleKillNPC(uid string, req *gamev1.KillNPCRequest
 	if !ok {
 		return errorEvent("session not found"), nil
 	}
-	if evt := requireEditor(sess); evt != nil {
-		return evt, nil
-	}
 	if req.GetTemplateId() == "" {
 		return errorEvent("killnpc: template_id must be non-empty"), nil
 	}
@@ -154,7 +154,7 @@ func TestHandleSpawnNPC_DeniedPlayerRole(t *testing.T) {
 
 	tmpl := &npc.Template{ID: "guard", Name: "Guard", Level: 1}
 	svc.respawnMgr = npc.NewRespawnManager(nil, map[string]*npc.Template{"guard": tmpl}, nil, nil)
-	evt, err := svc.handleSpawnNPC("u1", &gamev1.SpawnNPCRequest{TemplateId: "guard", RoomId: "r1"})
+	evt, err := svc.dispatch("u1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SpawnNpc{SpawnNpc: &gamev1.SpawnNPCRequest{TemplateId: "guard", RoomId: "r1"}}})
 	require.NoError(t, err)
 	require.NotNil(t, evt)
 	assert.Contains(t, evtText(evt), "permission denied")
@@ -316,7 +316,7 @@ func TestHandleEditorCmds_DeniedPlayerRole(t *testing.T) {
 	svc, _, sessMgr, _ := setupEditorService(t)
 	addPlayerSession(t, sessMgr, "u1", "r1")
 
-	evt, err := svc.handleEditorCmds("u1")
+	evt, err := svc.dispatch("u1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_EditorCmds{EditorCmds: &gamev1.EditorCmdsRequest{}}})
 	require.NoError(t, err)
 	require.NotNil(t, evt)
 	assert.Contains(t, evtText(evt), "permission denied")
@@ -348,7 +348,7 @@ func TestHandleSpawnChar_RequiresEditorRole(t *testing.T) {
 	svc, _, sessMgr, _ := setupEditorService(t)
 	addPlayerSession(t, sessMgr, "u1", "r1")
 
-	evt, err := svc.handleSpawnChar("u1", &gamev1.SpawnCharRequest{Name: "TestHero"})
+	evt, err := svc.dispatch("u1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SpawnCharRequest{SpawnCharRequest: &gamev1.SpawnCharRequest{Name: "TestHero"}}})
 	require.NoError(t, err)
 	require.NotNil(t, evt)
 	assert.Contains(t, evtText(evt), "permission denied")
@@ -376,7 +376,7 @@ func TestHandleDeleteChar_RequiresEditorRole(t *testing.T) {
 	svc, _, sessMgr, _ := setupEditorService(t)
 	addPlayerSession(t, sessMgr, "u1", "r1")
 
-	evt, err := svc.handleDeleteChar("u1", &gamev1.DeleteCharRequest{Name: "TestHero"})
+	evt, err := svc.dispatch("u1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_DeleteCharRequest{DeleteCharRequest: &gamev1.DeleteCharRequest{Name: "TestHero"}}})
 	require.NoError(t, err)
 	require.NotNil(t, evt)
 	assert.Contains(t, evtText(evt), "permission denied")
@@ -6,48 +6,58 @@ import (
 	"github.com/stretchr/testify/assert"
 	"pgregory.net/rapid"
 
-	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/permission"
 	"github.com/cory-johannsen/mud/internal/gameserver"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 	"github.com/cory-johannsen/mud/internal/storage/postgres"
 )
 
-func TestRequireEditor_AllowsEditorRole(t *testing.T) {
-	sess := &session.PlayerSession{Role: postgres.RoleEditor}
-	assert.Nil(t, gameserver.RequireEditor(sess))
+var addRoomMsg = &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_AddRoom{AddRoom: &gamev1.AddRoomRequest{}}}
+var setRoleMsg = &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SetRole{SetRole: &gamev1.SetRoleRequest{}}}
+
+func TestEditorCommand_AllowsEditorRole(t *testing.T) {
+	c, ok := gameserver.RequiredCapability(addRoomMsg)
+	assert.True(t, ok)
+	assert.True(t, permission.Default().Allows(postgres.RoleEditor, c))
+}
+
+func TestEditorCommand_AllowsAdminRole(t *testing.T) {
+	c, _ := gameserver.RequiredCapability(addRoomMsg)
+	assert.True(t, permission.Default().Allows(postgres.RoleAdmin, c))
 }
 
-func TestRequireEditor_AllowsAdminRole(t *testing.T) {
-	sess := &session.PlayerSession{Role: postgres.RoleAdmin}
-	assert.Nil(t, gameserver.RequireEditor(sess))
+func TestEditorCommand_DeniesPlayerRole(t *testing.T) {
+	c, _ := gameserver.RequiredCapability(addRoomMsg)
+	assert.False(t, permission.Default().Allows(postgres.RolePlayer, c))
 }
 
-func TestRequireEditor_DeniesPlayerRole(t *testing.T) {
-	sess := &session.PlayerSession{Role: postgres.RolePlayer}
-	evt := gameserver.RequireEditor(sess)
-	assert.NotNil(t, evt)
+func TestAdminCommand_AllowsAdminRole(t *testing.T) {
+	c, ok := gameserver.RequiredCapability(setRoleMsg)
+	assert.True(t, ok)
+	assert.True(t, permission.Default().Allows(postgres.RoleAdmin, c))
 }
 
-func TestRequireAdmin_AllowsAdminRole(t *testing.T) {
-	sess := &session.PlayerSession{Role: postgres.RoleAdmin}
-	assert.Nil(t, gameserver.RequireAdmin(sess))
+func TestAdminCommand_DeniesEditorRole(t *testing.T) {
+	c, _ := gameserver.RequiredCapability(setRoleMsg)
+	assert.False(t, permission.Default().Allows(postgres.RoleEditor, c))
 }
 
-func TestRequireAdmin_DeniesEditorRole(t *testing.T) {
-	sess := &session.PlayerSession{Role: postgres.RoleEditor}
-	evt := gameserver.RequireAdmin(sess)
-	assert.NotNil(t, evt)
+func TestOrdinaryCommand_NeedsNoCapability(t *testing.T) {
+	_, ok := gameserver.RequiredCapability(&gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}})
+	assert.False(t, ok)
 }
 
-// Property: requireEditor denies all roles except editor and admin.
-func TestRequireEditorProperty(t *testing.T) {
+// Property: editor commands are denied to all roles except editor and admin.
+func TestEditorCommandProperty(t *testing.T) {
+	c, _ := gameserver.RequiredCapability(addRoomMsg)
+	m := permission.Default()
 	rapid.Check(t, func(t *rapid.T) {
 		role := rapid.StringOf(rapid.Rune()).Draw(t, "role")
-		sess := &session.PlayerSession{Role: role}
-		evt := gameserver.RequireEditor(sess)
+		allowed := m.Allows(role, c)
 		if role == postgres.RoleEditor || role == postgres.RoleAdmin {
-			assert.Nil(t, evt)
+			assert.True(t, allowed)
 		} else {
-			assert.NotNil(t, evt)
+			assert.False(t, allowed)
 		}
 	})
 }
@@ -0,0 +1,134 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// engravingKitTag marks items that let their carrier engrave for free.
+const engravingKitTag = "engraving_kit"
+
+// handleEngrave engraves a custom display name on a non-stackable item in the
+// player's backpack. Carrying an item tagged engraving_kit makes it free;
+// otherwise a merchant in the room with an engrave_fee must do the work.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil. On success
+// the item's CustomName is set, any fee is deducted, and inventory and
+// currency are persisted.
+func (s *GameServiceServer) handleEngrave(uid string, req *gamev1.EngraveRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return errorEvent("player not found"), nil
+	}
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return messageEvent("Engraving is unavailable."), nil
+	}
+	query := strings.TrimSpace(req.GetItem())
+	name := strings.TrimSpace(req.GetName())
+	if query == "" || name == "" {
+		return messageEvent("Usage: engrave <item> <name>"), nil
+	}
+	if err := inventory.ValidateCustomName(name); err != nil {
+		return messageEvent(fmt.Sprintf("You can't engrave that: %s.", err.Error())), nil
+	}
+
+	target := s.findEngravable(sess, query)
+	if target == nil {
+		return messageEvent(fmt.Sprintf("You have no single item matching %q to engrave.", query)), nil
+	}
+	def, _ := s.invRegistry.Item(target.ItemDefID)
+	oldName := s.invRegistry.InstanceName(def.Name, target.CustomName, target.Affixes)
+
+	var engraver *npc.Instance
+	fee := 0
+	if !s.carriesEngravingKit(sess) {
+		engraver, fee = s.findEngraverInRoom(sess.RoomID)
+		if engraver == nil {
+			return messageEvent("You need an engraving kit, or a merchant here who offers engraving."), nil
+		}
+		if sess.Currency < fee {
+			return messageEvent(fmt.Sprintf("You can't afford that. Engraving costs %d credits and you have %d.", fee, sess.Currency)), nil
+		}
+		sess.Currency -= fee
+		s.emitCurrency(sess, -fee, telemetry.SourceEngraving)
+	}
+	target.CustomName = name
+
+	if s.charSaver != nil && sess.CharacterID > 0 {
+		ctx := s.commandCtx(uid)
+		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
+			s.logger.Warn("handleEngrave: SaveInventory failed", zap.Error(err))
+		}
+		if fee > 0 {
+			if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
+				s.logger.Warn("handleEngrave: SaveCurrency failed", zap.Error(err))
+			}
+		}
+	}
+	s.pushInventory(sess)
+
+	if engraver != nil {
+		return messageEvent(fmt.Sprintf("%s engraves \"%s\" on your %s for %d credits.", engraver.Name(), name, oldName, fee)), nil
+	}
+	return messageEvent(fmt.Sprintf("You engrave \"%s\" on your %s.", name, oldName)), nil
+}
+
+// findEngravable returns the first non-stackable backpack item whose def ID,
+// name, or current custom name matches query (case-insensitive).
+//
+// Postcondition: Returns a pointer into sess.Backpack, or nil when nothing matches.
+func (s *GameServiceServer) findEngravable(sess *session.PlayerSession, query string) *inventory.ItemInstance {
+	for _, inst := range sess.Backpack.Items() {
+		def, ok := s.invRegistry.Item(inst.ItemDefID)
+		if !ok || def.Stackable {
+			continue
+		}
+		if strings.EqualFold(inst.ItemDefID, query) || strings.EqualFold(def.Name, query) ||
+			(inst.CustomName != "" && strings.EqualFold(inst.CustomName, query)) {
+			return sess.Backpack.MutableItem(inst.InstanceID)
+		}
+	}
+	return nil
+}
+
+// carriesEngravingKit reports whether the player's backpack holds an item
+// tagged engraving_kit.
+func (s *GameServiceServer) carriesEngravingKit(sess *session.PlayerSession) bool {
+	for _, inst := range sess.Backpack.Items() {
+		if s.invRegistry.ItemHasTag(inst.ItemDefID, engravingKitTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// findEngraverInRoom returns the cheapest merchant in roomID offering engraving
+// and its fee.
+//
+// Postcondition: Returns (nil, 0) when no merchant in the room has an engrave_fee.
+func (s *GameServiceServer) findEngraverInRoom(roomID string) (*npc.Instance, int) {
+	if s.npcMgr == nil {
+		return nil, 0
+	}
+	var best *npc.Instance
+	bestFee := 0
+	for _, inst := range s.npcMgr.InstancesInRoom(roomID) {
+		tmpl := s.npcMgr.TemplateByID(inst.TemplateID)
+		if tmpl == nil || tmpl.Merchant == nil || tmpl.Merchant.EngraveFee <= 0 {
+			continue
+		}
+		if best == nil || tmpl.Merchant.EngraveFee < bestFee {
+			best, bestFee = inst, tmpl.Merchant.EngraveFee
+		}
+	}
+	return best, bestFee
+}
@@ -0,0 +1,76 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newEngraveTestServer extends the merchant test server with a non-stackable
+// trinket and an engraving kit in the registry, and a trinket in the player's pack.
+func newEngraveTestServer(t *testing.T) (*GameServiceServer, string, *npc.Instance) {
+	t.Helper()
+	svc, uid, inst := newMerchantTestServer(t)
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "lucky_coin", Name: "Lucky Coin", Kind: inventory.KindJunk, MaxStack: 1,
+	}))
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "engraving_kit", Name: "Engraving Kit", Kind: inventory.KindJunk, MaxStack: 1,
+		Tags: []string{engravingKitTag},
+	}))
+	sess, ok := svc.sessions.GetPlayer(uid)
+	require.True(t, ok)
+	_, err := sess.Backpack.Add("lucky_coin", 1, svc.invRegistry)
+	require.NoError(t, err)
+	return svc, uid, inst
+}
+
+func TestHandleEngrave_MerchantChargesFee(t *testing.T) {
+	svc, uid, _ := newEngraveTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+
+	evt, err := svc.handleEngrave(uid, &gamev1.EngraveRequest{Item: "lucky coin", Name: "Old Faithful"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "You need an engraving kit")
+
+	svc.npcMgr.TemplateByID("test_merchant").Merchant.EngraveFee = 60
+	evt, err = svc.handleEngrave(uid, &gamev1.EngraveRequest{Item: "lucky coin", Name: "Old Faithful"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "for 60 credits")
+	assert.Equal(t, 440, sess.Currency)
+	coins := sess.Backpack.FindByItemDefID("lucky_coin")
+	require.Len(t, coins, 1)
+	assert.Equal(t, "Old Faithful", coins[0].CustomName)
+	assert.Equal(t, "Old Faithful", coins[0].Metadata().CustomName)
+
+	// A renamed item can be found by its engraved name.
+	_, err = svc.handleEngrave(uid, &gamev1.EngraveRequest{Item: "old faithful", Name: "Lady Luck"})
+	require.NoError(t, err)
+	assert.Equal(t, "Lady Luck", sess.Backpack.FindByItemDefID("lucky_coin")[0].CustomName)
+	assert.Equal(t, 380, sess.Currency)
+}
+
+func TestHandleEngrave_KitIsFreeAndNamesAreValidated(t *testing.T) {
+	svc, uid, _ := newEngraveTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	_, err := sess.Backpack.Add("engraving_kit", 1, svc.invRegistry)
+	require.NoError(t, err)
+
+	evt, err := svc.handleEngrave(uid, &gamev1.EngraveRequest{Item: "lucky_coin", Name: "<script>"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "You can't engrave that")
+
+	evt, err = svc.handleEngrave(uid, &gamev1.EngraveRequest{Item: "stim_pack", Name: "Pick-me-up"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "no single item")
+
+	evt, err = svc.handleEngrave(uid, &gamev1.EngraveRequest{Item: "lucky_coin", Name: "Old Faithful"})
+	require.NoError(t, err)
+	assert.Equal(t, `You engrave "Old Faithful" on your Lucky Coin.`, evt.GetMessage().GetContent())
+	assert.Equal(t, 500, sess.Currency)
+}
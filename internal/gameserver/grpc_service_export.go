@@ -0,0 +1,103 @@
+package gameserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// CharacterExporter looks up characters and snapshots them for
+// charactertool import.
+//
+// Precondition: name must be non-empty; id must be > 0.
+type CharacterExporter interface {
+	GetIDByName(ctx context.Context, name string) (int64, error)
+	ExportCharacter(ctx context.Context, id int64) (*postgres.CharacterExport, error)
+}
+
+// handleExportCharacter returns the named character as JSON, saving its
+// session first when it is online so the export matches what the player sees.
+//
+// Precondition: uid must identify an active session authorized for
+// permission.CanExportCharacters; req must be non-nil.
+// Postcondition: On success the message holds the indented JSON export; an
+// unknown character or a failed save or export reports why.
+func (s *GameServiceServer) handleExportCharacter(uid string, req *gamev1.ExportCharacterRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.characterExporter == nil {
+		return errorEvent("Character export is not available."), nil
+	}
+	name := req.GetTarget()
+	if name == "" {
+		return errorEvent("usage: exportchar <character>"), nil
+	}
+	ctx := s.commandCtx(uid)
+
+	if online := s.sessions.GetPlayerByCharNameCI(name); online != nil {
+		if err := s.saveForExport(ctx, online); err != nil {
+			s.logger.Warn("handleExportCharacter: saving online character failed", zap.String("target", name), zap.Error(err))
+			return errorEvent(fmt.Sprintf("Failed to save %s before the export. Please try again.", online.CharName)), nil
+		}
+	}
+	charID, err := s.characterExporter.GetIDByName(ctx, name)
+	if errors.Is(err, postgres.ErrCharacterNotFound) {
+		return errorEvent(fmt.Sprintf("No character named %q.", name)), nil
+	}
+	if err != nil {
+		s.logger.Warn("handleExportCharacter: looking up character failed", zap.String("target", name), zap.Error(err))
+		return errorEvent("Failed to export character. Please try again."), nil
+	}
+	exp, err := s.characterExporter.ExportCharacter(ctx, charID)
+	if err != nil {
+		s.logger.Warn("handleExportCharacter: export failed", zap.Int64("character_id", charID), zap.Error(err))
+		return errorEvent("Failed to export character. Please try again."), nil
+	}
+	data, err := json.MarshalIndent(exp, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding export of character %d: %w", charID, err)
+	}
+	return messageEvent(fmt.Sprintf("Export of %s; save it to a file and load it with charactertool import:\n%s", exp.Name, data)), nil
+}
+
+// saveForExport persists the session state that is otherwise only written at
+// logout: location, HP, currency, equipment, weapon presets, and backpack.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: Returns the first save error; nil when there is no
+// character saver.
+func (s *GameServiceServer) saveForExport(ctx context.Context, sess *session.PlayerSession) error {
+	if s.charSaver == nil || sess.CharacterID <= 0 {
+		return nil
+	}
+	if err := s.charSaver.SaveState(ctx, sess.CharacterID, sess.RoomID, sess.CurrentHP); err != nil {
+		return fmt.Errorf("saving state: %w", err)
+	}
+	if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
+		return fmt.Errorf("saving currency: %w", err)
+	}
+	if sess.Equipment != nil {
+		if err := s.charSaver.SaveEquipment(ctx, sess.CharacterID, sess.Equipment); err != nil {
+			return fmt.Errorf("saving equipment: %w", err)
+		}
+	}
+	if sess.LoadoutSet != nil {
+		if err := s.charSaver.SaveWeaponPresets(ctx, sess.CharacterID, sess.LoadoutSet); err != nil {
+			return fmt.Errorf("saving weapon presets: %w", err)
+		}
+	}
+	if sess.Backpack != nil {
+		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
+			return fmt.Errorf("saving inventory: %w", err)
+		}
+	}
+	return nil
+}
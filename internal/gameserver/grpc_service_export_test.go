@@ -0,0 +1,124 @@
+package gameserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// exportSaver is a CharacterSaver that records the currency an online
+// character is saved with before an export.
+type exportSaver struct {
+	mockCharSaverFull
+	currency map[int64]int
+}
+
+func (m *exportSaver) SaveCurrency(_ context.Context, id int64, currency int) error {
+	m.currency[id] = currency
+	return nil
+}
+
+// fakeExporter is an in-memory CharacterExporter that exports the currency
+// last saved for a character.
+type fakeExporter struct {
+	names map[int64]string
+	saver *exportSaver
+	err   error
+}
+
+func (f *fakeExporter) GetIDByName(_ context.Context, name string) (int64, error) {
+	for id, n := range f.names {
+		if strings.EqualFold(n, name) {
+			return id, nil
+		}
+	}
+	return 0, postgres.ErrCharacterNotFound
+}
+
+func (f *fakeExporter) ExportCharacter(_ context.Context, id int64) (*postgres.CharacterExport, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &postgres.CharacterExport{Version: postgres.CharacterExportVersion, Name: f.names[id], Currency: f.saver.currency[id]}, nil
+}
+
+func newExportTestService(t *testing.T) (*GameServiceServer, *fakeExporter) {
+	t.Helper()
+	saver := &exportSaver{currency: map[int64]int{2: 5, 3: 40}}
+	svc := testServiceForCombatDefault(t, saver)
+	exporter := &fakeExporter{names: map[int64]string{2: "target", 3: "Offline"}, saver: saver}
+	svc.characterExporter = exporter
+	admin := addPlayerForCombatDefault(t, svc, "admin", 1)
+	admin.Role = postgres.RoleAdmin
+	return svc, exporter
+}
+
+// exportedJSON decodes the export in a successful exportchar reply.
+func exportedJSON(t *testing.T, evt *gamev1.ServerEvent) postgres.CharacterExport {
+	t.Helper()
+	content := evt.GetMessage().GetContent()
+	i := strings.Index(content, "{")
+	require.GreaterOrEqual(t, i, 0, content)
+	var exp postgres.CharacterExport
+	require.NoError(t, json.Unmarshal([]byte(content[i:]), &exp))
+	return exp
+}
+
+func TestHandleExportCharacter_RequiresCapability(t *testing.T) {
+	svc, _ := newExportTestService(t)
+	addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.dispatch("target", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_ExportCharacter{ExportCharacter: &gamev1.ExportCharacterRequest{Target: "Offline"}}})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "permission denied")
+}
+
+func TestHandleExportCharacter_Offline(t *testing.T) {
+	svc, _ := newExportTestService(t)
+
+	evt, err := svc.handleExportCharacter("admin", &gamev1.ExportCharacterRequest{Target: "offline"})
+	require.NoError(t, err)
+	exp := exportedJSON(t, evt)
+	assert.Equal(t, "Offline", exp.Name)
+	assert.Equal(t, 40, exp.Currency)
+}
+
+func TestHandleExportCharacter_SavesOnlineCharacterFirst(t *testing.T) {
+	svc, _ := newExportTestService(t)
+	target := addPlayerForCombatDefault(t, svc, "target", 2)
+	target.Currency = 75
+
+	evt, err := svc.handleExportCharacter("admin", &gamev1.ExportCharacterRequest{Target: "target"})
+	require.NoError(t, err)
+	assert.Equal(t, 75, exportedJSON(t, evt).Currency)
+}
+
+func TestHandleExportCharacter_Rejects(t *testing.T) {
+	svc, exporter := newExportTestService(t)
+
+	evt, err := svc.handleExportCharacter("admin", &gamev1.ExportCharacterRequest{Target: "nobody"})
+	require.NoError(t, err)
+	assert.Equal(t, `No character named "nobody".`, evt.GetError().GetMessage())
+
+	evt, err = svc.handleExportCharacter("admin", &gamev1.ExportCharacterRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "usage")
+
+	exporter.err = errors.New("db down")
+	evt, err = svc.handleExportCharacter("admin", &gamev1.ExportCharacterRequest{Target: "Offline"})
+	require.NoError(t, err)
+	assert.Equal(t, "Failed to export character. Please try again.", evt.GetError().GetMessage())
+
+	svc.characterExporter = nil
+	evt, err = svc.handleExportCharacter("admin", &gamev1.ExportCharacterRequest{Target: "Offline"})
+	require.NoError(t, err)
+	assert.Equal(t, "Character export is not available.", evt.GetError().GetMessage())
+}
@@ -1,13 +1,13 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"math"
 	"sort"
 	"strings"
 
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // handleFaction shows the player's faction, tier, rep, and perks (REQ-FA-37).
@@ -190,8 +190,9 @@ func (s *GameServiceServer) handleChangeRep(uid string, req *gamev1.ChangeRepReq
 	}
 
 	sess.Currency -= cost
+	s.emitCurrency(sess, -cost, telemetry.SourceFactionFixer)
 	if s.charSaver != nil {
-		if err := s.charSaver.SaveCurrency(context.Background(), sess.CharacterID, sess.Currency); err != nil {
+		if err := s.charSaver.SaveCurrency(s.commandCtx(uid), sess.CharacterID, sess.Currency); err != nil {
 			return messageEvent("Failed to save currency."), nil
 		}
 	}
@@ -209,7 +210,7 @@ func (s *GameServiceServer) handleChangeRep(uid string, req *gamev1.ChangeRepReq
 		}
 	}
 
-	msg, err := s.factionSvc.AwardRep(context.Background(), sess, sess.CharacterID, sess.FactionID, amount)
+	msg, err := s.factionSvc.AwardRep(s.commandCtx(uid), sess, sess.CharacterID, sess.FactionID, amount)
 	if err != nil {
 		return messageEvent(fmt.Sprintf("Failed to award rep: %v", err)), nil
 	}
@@ -6,15 +6,20 @@ package gameserver
 // REQ-FCM-9: handleChooseFeat MUST persist the feat and mark the grant level on success.
 
 import (
-	"context"
 	"fmt"
+	"strings"
 
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 )
 
-// handleChooseFeat resolves one pending feat choice slot for a player.
+// handleChooseFeat resolves one pending feat choice slot for a player. An empty
+// featID lists the player's pending choices instead; a grantLevel of 0 picks the
+// earliest pending choice offering featID, which may be a feat ID or name.
 //
-// Precondition: uid non-empty; grantLevel >= 1; featID non-empty.
+// Precondition: uid non-empty; grantLevel >= 0.
 // Postcondition: On success — feat persisted, grant level marked, CharacterSheetView and
 //
 //	JobGrantsResponse pushed to player stream; success MessageEvent returned.
@@ -35,57 +40,88 @@ func (s *GameServiceServer) handleChooseFeat(uid string, grantLevel int, featID
 		return messageEvent("Feat grant data is not available."), nil
 	}
 
+	// Load the feats the player already owns, for prerequisites and ownership.
+	owned := make(map[string]bool)
+	if s.characterFeatsRepo != nil {
+		existing, err := s.characterFeatsRepo.GetAll(s.commandCtx(uid), sess.CharacterID)
+		if err != nil {
+			return nil, fmt.Errorf("handleChooseFeat: GetAll feats: %w", err)
+		}
+		for _, id := range existing {
+			owned[id] = true
+		}
+	}
+
+	if featID == "" {
+		return messageEvent(s.featChoicesText(sess, jobResp.PendingFeatChoices, owned)), nil
+	}
+
 	// Step 2: Find the PendingFeatChoice for the requested grant level.
 	var pendingChoice *gamev1.PendingFeatChoice
 	for _, pfc := range jobResp.PendingFeatChoices {
-		if pfc.GrantLevel == int32(grantLevel) {
+		if pfc.GrantLevel == int32(grantLevel) || (grantLevel == 0 && featOptionFor(pfc, featID) != nil) {
 			pendingChoice = pfc
 			break
 		}
 	}
 	if pendingChoice == nil {
+		if grantLevel == 0 {
+			if len(jobResp.PendingFeatChoices) == 0 {
+				return messageEvent("You have no feat choices pending."), nil
+			}
+			return messageEvent(fmt.Sprintf("%q is not among your feat choices. Type 'choosefeat' to see them.", featID)), nil
+		}
 		return messageEvent(fmt.Sprintf("No pending feat choice at level %d.", grantLevel)), nil
 	}
+	grantLevel = int(pendingChoice.GrantLevel)
 
 	// Step 3: Verify feat_id is in the pool.
-	inPool := false
-	for _, opt := range pendingChoice.Options {
-		if opt.FeatId == featID {
-			inPool = true
-			break
-		}
-	}
-	if !inPool {
+	opt := featOptionFor(pendingChoice, featID)
+	if opt == nil {
 		return messageEvent(fmt.Sprintf("%q is not a valid choice at level %d.", featID, grantLevel)), nil
 	}
+	featID = opt.FeatId
 
-	// Step 4: Verify player does not already own the feat.
-	if s.characterFeatsRepo != nil {
-		existing, err := s.characterFeatsRepo.GetAll(context.Background(), sess.CharacterID)
-		if err != nil {
-			return nil, fmt.Errorf("handleChooseFeat: GetAll feats: %w", err)
-		}
-		for _, id := range existing {
-			if id == featID {
-				return messageEvent(fmt.Sprintf("You already have %q.", featID)), nil
+	// Step 4: Verify player does not already own the feat and meets its prerequisites.
+	if owned[featID] {
+		return messageEvent(fmt.Sprintf("You already have %q.", featID)), nil
+	}
+	if s.featRegistry != nil {
+		if f, ok := s.featRegistry.Feat(featID); ok {
+			if unmet := f.Prerequisites.Unmet(featCandidate(sess, owned)); len(unmet) > 0 {
+				return messageEvent(fmt.Sprintf("You don't meet the prerequisites for %s: requires %s.", f.Name, strings.Join(unmet, ", "))), nil
 			}
 		}
 	}
 
 	// Step 5: Persist the feat.
 	if s.characterFeatsRepo != nil {
-		if err := s.characterFeatsRepo.Add(context.Background(), sess.CharacterID, featID); err != nil {
+		if err := s.characterFeatsRepo.Add(s.commandCtx(uid), sess.CharacterID, featID); err != nil {
 			return nil, fmt.Errorf("handleChooseFeat: Add feat: %w", err)
 		}
 	}
 
 	// Step 6: Mark grant level as fulfilled.
 	if s.featLevelGrantsRepo != nil {
-		if err := s.featLevelGrantsRepo.MarkLevelGranted(context.Background(), sess.CharacterID, grantLevel); err != nil {
+		if err := s.featLevelGrantsRepo.MarkLevelGranted(s.commandCtx(uid), sess.CharacterID, grantLevel); err != nil {
 			return nil, fmt.Errorf("handleChooseFeat: MarkLevelGranted: %w", err)
 		}
 	}
 
+	// A passive feat takes effect at once; its bonuses join the next fight.
+	featName := featID
+	if s.featRegistry != nil {
+		if f, ok := s.featRegistry.Feat(featID); ok {
+			featName = f.Name
+			if !f.Active {
+				if sess.PassiveFeats == nil {
+					sess.PassiveFeats = make(map[string]bool)
+				}
+				sess.PassiveFeats[featID] = true
+			}
+		}
+	}
+
 	// Step 7: Push updated CharacterSheetView and JobGrantsResponse to player stream.
 	s.pushCharacterSheet(sess)
 	if updatedGrantsEvt, err := s.handleJobGrants(uid); err == nil && updatedGrantsEvt != nil {
@@ -93,11 +129,60 @@ func (s *GameServiceServer) handleChooseFeat(uid string, grantLevel int, featID
 	}
 
 	// Step 8: Return success event.
-	featName := featID
-	if s.featRegistry != nil {
-		if f, ok := s.featRegistry.Feat(featID); ok {
-			featName = f.Name
+	return messageEvent(fmt.Sprintf("You have learned %s.", featName)), nil
+}
+
+// featOptionFor returns the option in pfc whose feat ID or name matches
+// featID, ignoring case, or nil.
+func featOptionFor(pfc *gamev1.PendingFeatChoice, featID string) *gamev1.FeatOption {
+	for _, opt := range pfc.Options {
+		if strings.EqualFold(opt.FeatId, featID) || strings.EqualFold(opt.Name, featID) ||
+			strings.EqualFold(opt.FeatId, strings.ReplaceAll(featID, " ", "_")) {
+			return opt
 		}
 	}
-	return messageEvent(fmt.Sprintf("You have learned %s.", featName)), nil
+	return nil
+}
+
+// featCandidate returns the part of sess that feat prerequisites test, with
+// owned as the feats the character has.
+//
+// Precondition: sess must not be nil.
+func featCandidate(sess *session.PlayerSession, owned map[string]bool) ruleset.FeatCandidate {
+	abilities := make(map[string]int, len(character.AbilityNames))
+	for _, name := range character.AbilityNames {
+		abilities[name], _ = sess.Abilities.Score(name)
+	}
+	return ruleset.FeatCandidate{Level: sess.Level, Feats: owned, Abilities: abilities, Skills: sess.Skills}
+}
+
+// featChoicesText lists the player's pending feat choices, marking options
+// whose prerequisites they don't yet meet.
+//
+// Precondition: sess must not be nil.
+func (s *GameServiceServer) featChoicesText(sess *session.PlayerSession, choices []*gamev1.PendingFeatChoice, owned map[string]bool) string {
+	if len(choices) == 0 {
+		return "You have no feat choices pending."
+	}
+	candidate := featCandidate(sess, owned)
+	var b strings.Builder
+	b.WriteString("Feat choices:")
+	for _, pfc := range choices {
+		fmt.Fprintf(&b, "\n  Level %d — choose %d:", pfc.GrantLevel, pfc.Count)
+		for _, opt := range pfc.Options {
+			fmt.Fprintf(&b, "\n    %s (%s)", opt.Name, opt.FeatId)
+			if s.featRegistry != nil {
+				if f, ok := s.featRegistry.Feat(opt.FeatId); ok {
+					if unmet := f.Prerequisites.Unmet(candidate); len(unmet) > 0 {
+						fmt.Fprintf(&b, " [requires %s]", strings.Join(unmet, ", "))
+					}
+				}
+			}
+			if opt.Description != "" {
+				fmt.Fprintf(&b, " — %s", opt.Description)
+			}
+		}
+	}
+	b.WriteString("\nType 'choosefeat <feat>' to learn one.")
+	return b.String()
 }
@@ -439,3 +439,42 @@ func TestHandleChooseFeat_AlreadyOwned_ReturnsDenial(t *testing.T) {
 	require.NotNil(t, msg, "expected a MessageEvent")
 	assert.NotEmpty(t, msg.Content, "denial message must not be empty")
 }
+
+// TestHandleChooseFeat_TelnetFlow verifies that an empty feat lists the
+// pending choices, prerequisites gate a pick, a feat may be named rather than
+// given by ID without a grant level, and a passive pick takes effect at once.
+func TestHandleChooseFeat_TelnetFlow(t *testing.T) {
+	const uid = "fcm-telnet-player"
+
+	feats, job := testChooseFeatSetup()
+	feats[1].Prerequisites = &ruleset.FeatPrerequisites{Level: 4}
+	featsRepo := &stubFeatsRepo{data: map[int64][]string{}}
+	svc, sessMgr := buildChooseFeatSvc(t, feats, job, featsRepo, &stubFeatLevelGrantsRepo{})
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: uid, Username: uid, CharName: uid,
+		RoomID: "room_a", CurrentHP: 20, MaxHP: 20, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.Class = "test_job"
+	sess.Level = 2
+
+	evt, err := svc.handleChooseFeat(uid, 0, "")
+	require.NoError(t, err)
+	list := evt.GetMessage().GetContent()
+	assert.Contains(t, list, "Level 2 — choose 1")
+	assert.Contains(t, list, "Rage (rage)")
+	assert.Contains(t, list, "Overpower (overpower) [requires level 4]")
+
+	evt, err = svc.handleChooseFeat(uid, 0, "Overpower")
+	require.NoError(t, err)
+	assert.Equal(t, "You don't meet the prerequisites for Overpower: requires level 4.", evt.GetMessage().GetContent())
+
+	evt, err = svc.handleChooseFeat(uid, 0, "RAGE")
+	require.NoError(t, err)
+	assert.Equal(t, "You have learned Rage.", evt.GetMessage().GetContent())
+	assert.True(t, sess.PassiveFeats["rage"], "a passive feat takes effect at once")
+
+	evt, err = svc.handleChooseFeat(uid, 0, "overpower")
+	require.NoError(t, err)
+	assert.Equal(t, "You have no feat choices pending.", evt.GetMessage().GetContent())
+}
@@ -0,0 +1,115 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/flags"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// SetFeatureFlags installs the feature flag set managed by the flag command.
+//
+// Precondition: set should be the set installed with flags.Install.
+// Postcondition: The flag command edits set, and config reloads replace its defaults.
+func (s *GameServiceServer) SetFeatureFlags(set *flags.Set) {
+	s.featureFlags = set
+}
+
+// handleFlag lists the feature flags or sets or clears an override, for
+// everyone or for one account.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: Overrides are persisted before they take effect.
+func (s *GameServiceServer) handleFlag(uid string, req *gamev1.FlagRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.featureFlags == nil {
+		return errorEvent("Feature flags are not available."), nil
+	}
+	action := req.GetAction()
+	if action == "" || action == "list" {
+		return messageEvent(formatFlags(s.featureFlags.Flags())), nil
+	}
+	if action != "on" && action != "off" && action != "default" {
+		return errorEvent("usage: flag [list] | flag <name> on|off|default [username]"), nil
+	}
+	name := req.GetFlag()
+	if !s.featureFlags.Declared(name) {
+		return errorEvent(fmt.Sprintf("No feature flag %q; flags are declared in the server config.", name)), nil
+	}
+	ctx := s.commandCtx(uid)
+
+	o := flags.Override{Flag: name, Enabled: action == "on", SetBy: sess.Username}
+	scope := "everyone"
+	if req.GetUsername() != "" {
+		if s.accountAdmin == nil {
+			return errorEvent("Account overrides are not available."), nil
+		}
+		target, err := s.accountAdmin.GetAccountByUsername(ctx, req.GetUsername())
+		if err != nil {
+			return errorEvent(fmt.Sprintf("account %q not found", req.GetUsername())), nil
+		}
+		o.AccountID, o.Username = target.ID, target.Username
+		scope = target.Username
+	}
+
+	if action == "default" {
+		found, err := s.featureFlags.Clear(ctx, name, o.AccountID)
+		if err != nil {
+			s.logger.Warn("handleFlag: clearing override failed", zap.String("flag", name), zap.Int64("account_id", o.AccountID), zap.Error(err))
+			return errorEvent("Failed to clear the flag override. Please try again."), nil
+		}
+		if !found {
+			return errorEvent(fmt.Sprintf("Flag %s has no override for %s.", name, scope)), nil
+		}
+		return messageEvent(fmt.Sprintf("Cleared the override of %s for %s; it is now %s.", name, scope,
+			onOff(s.featureFlags.EnabledFor(name, o.AccountID)))), nil
+	}
+
+	if err := s.featureFlags.Put(ctx, o); err != nil {
+		s.logger.Warn("handleFlag: saving override failed", zap.String("flag", name), zap.Int64("account_id", o.AccountID), zap.Error(err))
+		return errorEvent("Failed to save the flag override. Please try again."), nil
+	}
+	s.logger.Info("feature flag overridden",
+		zap.String("flag", name),
+		zap.Bool("enabled", o.Enabled),
+		zap.String("scope", scope),
+		zap.String("by", sess.Username),
+	)
+	return messageEvent(fmt.Sprintf("Flag %s is now %s for %s.", name, onOff(o.Enabled), scope)), nil
+}
+
+// formatFlags renders the flag list for the flag command.
+func formatFlags(list []flags.Flag) string {
+	if len(list) == 0 {
+		return "No feature flags are declared."
+	}
+	var b strings.Builder
+	b.WriteString("Feature flags:")
+	for _, f := range list {
+		on := f.Default
+		source := "default"
+		if f.Global != nil {
+			on = f.Global.Enabled
+			source = "set by " + f.Global.SetBy
+		}
+		fmt.Fprintf(&b, "\n  %s: %s (%s; configured %s)", f.Name, onOff(on), source, onOff(f.Default))
+		for _, o := range f.Accounts {
+			fmt.Fprintf(&b, "\n    %s: %s (set by %s)", o.Username, onOff(o.Enabled), o.SetBy)
+		}
+	}
+	return b.String()
+}
+
+// onOff renders a flag state.
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
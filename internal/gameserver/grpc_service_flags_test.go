@@ -0,0 +1,85 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/flags"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func newFlagTestService(t *testing.T) (*GameServiceServer, *flags.Set) {
+	t.Helper()
+	svc := testServiceForCombatDefault(t, nil)
+	svc.accountAdmin = &mockAccountAdmin{accounts: map[string]AccountInfo{
+		"kira": {ID: 9, Username: "kira", Role: "player"},
+	}}
+	set := flags.New(map[string]bool{"pvp": false, "economy": true}, nil)
+	svc.SetFeatureFlags(set)
+	addPlayerForCombatDefault(t, svc, "admin", 1)
+	return svc, set
+}
+
+func TestHandleFlag_GlobalAndAccountOverrides(t *testing.T) {
+	svc, set := newFlagTestService(t)
+
+	evt, err := svc.handleFlag("admin", &gamev1.FlagRequest{Action: "on", Flag: "pvp", Username: "kira"})
+	require.NoError(t, err)
+	assert.Equal(t, "Flag pvp is now on for kira.", evt.GetMessage().GetContent())
+	assert.True(t, set.EnabledFor("pvp", 9))
+	assert.False(t, set.Enabled("pvp"))
+
+	evt, err = svc.handleFlag("admin", &gamev1.FlagRequest{Action: "off", Flag: "economy"})
+	require.NoError(t, err)
+	assert.Equal(t, "Flag economy is now off for everyone.", evt.GetMessage().GetContent())
+	assert.False(t, set.Enabled("economy"))
+
+	evt, err = svc.handleFlag("admin", &gamev1.FlagRequest{Action: "list"})
+	require.NoError(t, err)
+	assert.Equal(t, "Feature flags:\n"+
+		"  economy: off (set by admin; configured on)\n"+
+		"  pvp: off (default; configured off)\n"+
+		"    kira: on (set by admin)", evt.GetMessage().GetContent())
+
+	evt, err = svc.handleFlag("admin", &gamev1.FlagRequest{Action: "default", Flag: "economy"})
+	require.NoError(t, err)
+	assert.Equal(t, "Cleared the override of economy for everyone; it is now on.", evt.GetMessage().GetContent())
+	evt, err = svc.handleFlag("admin", &gamev1.FlagRequest{Action: "default", Flag: "economy"})
+	require.NoError(t, err)
+	assert.Equal(t, "Flag economy has no override for everyone.", evt.GetError().GetMessage())
+}
+
+func TestHandleFlag_Rejects(t *testing.T) {
+	svc, set := newFlagTestService(t)
+
+	evt, err := svc.handleFlag("admin", &gamev1.FlagRequest{Action: "on", Flag: "survival_needs"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "No feature flag \"survival_needs\"")
+
+	evt, err = svc.handleFlag("admin", &gamev1.FlagRequest{Action: "on", Flag: "pvp", Username: "nobody"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not found")
+	assert.False(t, set.Enabled("pvp"))
+
+	svc.SetFeatureFlags(nil)
+	evt, err = svc.handleFlag("admin", &gamev1.FlagRequest{Action: "list"})
+	require.NoError(t, err)
+	assert.Equal(t, "Feature flags are not available.", evt.GetError().GetMessage())
+}
+
+func TestReloadConfig_ReplacesFlagDefaults(t *testing.T) {
+	start := reloadTestConfig()
+	start.Flags = map[string]bool{"pvp": false}
+	next := start
+	next.Flags = map[string]bool{"pvp": true}
+	var loadErr error
+	s, _, _ := newReloadTestServer(start, &next, &loadErr)
+	set := flags.New(start.Flags, nil)
+	s.SetFeatureFlags(set)
+
+	_, err := s.ReloadConfig("tester")
+	require.NoError(t, err)
+	assert.True(t, set.Enabled("pvp"))
+}
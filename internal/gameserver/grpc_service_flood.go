@@ -0,0 +1,138 @@
+package gameserver
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/flood"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// panelFloodFactor scales the command rate and burst for the panel bucket,
+// which the webclient's panels draw on when they refresh themselves.
+const panelFloodFactor = 4
+
+// floodGuard holds each player's command-rate limiters: one for commands and
+// a larger one for panel refreshes. It is safe for concurrent use.
+type floodGuard struct {
+	mu       sync.Mutex
+	cfg      config.FloodConfig
+	panelCfg config.FloodConfig
+	limiters map[string]*flood.Limiter
+	panels   map[string]*flood.Limiter
+	now      func() time.Time
+}
+
+// newFloodGuard returns a guard applying cfg to every player's commands, and
+// cfg scaled by panelFloodFactor to their panel refreshes.
+func newFloodGuard(cfg config.FloodConfig) *floodGuard {
+	panelCfg := cfg
+	panelCfg.CommandsPerSecond *= panelFloodFactor
+	panelCfg.Burst *= panelFloodFactor
+	return &floodGuard{
+		cfg:      cfg,
+		panelCfg: panelCfg,
+		limiters: make(map[string]*flood.Limiter),
+		panels:   make(map[string]*flood.Limiter),
+		now:      time.Now,
+	}
+}
+
+// check records a command from uid, against the panel bucket when panel is
+// set, and returns the verdict.
+func (g *floodGuard) check(uid string, panel bool) (flood.Verdict, *flood.Limiter) {
+	g.mu.Lock()
+	now := g.now()
+	limiters, cfg := g.limiters, g.cfg
+	if panel {
+		limiters, cfg = g.panels, g.panelCfg
+	}
+	l, ok := limiters[uid]
+	if !ok {
+		l = flood.New(cfg, now)
+		limiters[uid] = l
+	}
+	g.mu.Unlock()
+	return l.Check(now), l
+}
+
+// forget drops uid's limiters.
+func (g *floodGuard) forget(uid string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.limiters, uid)
+	delete(g.panels, uid)
+}
+
+// SetFloodLimits installs per-player command-rate limits.
+//
+// Precondition: cfg must have passed config validation; MUST be called before sessions start.
+// Postcondition: Subsequent commands are rate-limited; a disabled cfg removes limiting.
+func (s *GameServiceServer) SetFloodLimits(cfg config.FloodConfig) {
+	if cfg.CommandsPerSecond <= 0 {
+		s.flood = nil
+		return
+	}
+	s.flood = newFloodGuard(cfg)
+}
+
+// floodExempt reports whether msg is sent by the client itself rather than
+// typed by the player, and so does not count against the rate limit.
+func floodExempt(msg *gamev1.ClientMessage) bool {
+	switch p := msg.Payload.(type) {
+	case *gamev1.ClientMessage_TabComplete:
+		return true
+	case *gamev1.ClientMessage_Afk:
+		return p.Afk.GetAuto()
+	}
+	return false
+}
+
+// floodPanel reports whether msg is a read-only panel refresh, which draws on
+// the larger panel bucket. The webclient issues these on its own: the map on
+// each room change, the character sheet on its retry interval, and the
+// inventory and loadout drawers. Players can type them too, so they are
+// limited rather than exempt.
+func floodPanel(msg *gamev1.ClientMessage) bool {
+	switch msg.Payload.(type) {
+	case *gamev1.ClientMessage_Map,
+		*gamev1.ClientMessage_CharSheet,
+		*gamev1.ClientMessage_InventoryReq,
+		*gamev1.ClientMessage_Loadout:
+		return true
+	}
+	return false
+}
+
+// checkFlood rate-limits uid's commands.
+//
+// Postcondition: Returns handled=false when msg may run. Otherwise msg is
+// dropped and evt, possibly nil, is the reply; err is errQuit when the player
+// is being disconnected for flooding.
+func (s *GameServiceServer) checkFlood(uid string, msg *gamev1.ClientMessage) (evt *gamev1.ServerEvent, handled bool, err error) {
+	if s.flood == nil || floodExempt(msg) {
+		return nil, false, nil
+	}
+	verdict, l := s.flood.check(uid, floodPanel(msg))
+	switch verdict {
+	case flood.Warn:
+		return errorEvent("You are sending commands too quickly. Slow down."), true, nil
+	case flood.Drop, flood.Squelched:
+		return nil, true, nil
+	case flood.Squelch:
+		s.logger.Info("squelching flooding player", zap.String("uid", uid))
+		return errorEvent(fmt.Sprintf("Too many commands. Your input is ignored for %s.", l.SquelchDuration().Round(time.Second))), true, nil
+	case flood.Disconnect:
+		s.logger.Warn("disconnecting flooding player", zap.String("uid", uid))
+		return &gamev1.ServerEvent{
+			Payload: &gamev1.ServerEvent_Disconnected{
+				Disconnected: &gamev1.Disconnected{Reason: "Disconnected for flooding."},
+			},
+		}, true, errQuit
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,141 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func testFloodConfig() config.FloodConfig {
+	return config.FloodConfig{
+		CommandsPerSecond: 1,
+		Burst:             2,
+		SquelchAfter:      2,
+		SquelchDuration:   5 * time.Second,
+		DisconnectAfter:   2,
+	}
+}
+
+// newFloodTestServer returns a server with flood limits whose clock is frozen at *now.
+func newFloodTestServer(t *testing.T) (*GameServiceServer, *time.Time) {
+	t.Helper()
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "ace", 1)
+	svc.SetFloodLimits(testFloodConfig())
+	now := time.Unix(0, 0)
+	svc.flood.now = func() time.Time { return now }
+	return svc, &now
+}
+
+func whoMsg() *gamev1.ClientMessage {
+	return &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Who{Who: &gamev1.WhoRequest{}}}
+}
+
+func TestDispatch_FloodEscalates(t *testing.T) {
+	svc, now := newFloodTestServer(t)
+
+	for i := 0; i < 2; i++ {
+		evt, err := svc.dispatch("ace", whoMsg())
+		require.NoError(t, err)
+		require.NotNil(t, evt.GetPlayerList(), "within the burst")
+	}
+
+	evt, err := svc.dispatch("ace", whoMsg())
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "too quickly")
+
+	evt, err = svc.dispatch("ace", whoMsg())
+	require.NoError(t, err)
+	assert.Equal(t, "Too many commands. Your input is ignored for 5s.", evt.GetError().GetMessage())
+
+	evt, err = svc.dispatch("ace", whoMsg())
+	require.NoError(t, err)
+	assert.Nil(t, evt, "squelched input is dropped silently")
+
+	// Exempt client traffic still flows while squelched.
+	_, err = svc.dispatch("ace", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Afk{Afk: &gamev1.AfkRequest{Auto: true}}})
+	require.NoError(t, err)
+
+	*now = now.Add(5 * time.Second)
+	_, _ = svc.dispatch("ace", whoMsg())
+	evt, err = svc.dispatch("ace", whoMsg())
+	assert.ErrorIs(t, err, errQuit)
+	assert.Equal(t, "Disconnected for flooding.", evt.GetDisconnected().GetReason())
+}
+
+func TestDispatch_FloodIsPerPlayer(t *testing.T) {
+	svc, _ := newFloodTestServer(t)
+	addPlayerForCombatDefault(t, svc, "bee", 2)
+	for i := 0; i < 3; i++ {
+		_, _ = svc.dispatch("ace", whoMsg())
+	}
+	evt, err := svc.dispatch("bee", whoMsg())
+	require.NoError(t, err)
+	assert.NotNil(t, evt.GetPlayerList())
+
+	svc.flood.forget("ace")
+	evt, err = svc.dispatch("ace", whoMsg())
+	require.NoError(t, err)
+	assert.NotNil(t, evt.GetPlayerList(), "a new session starts with a full bucket")
+}
+
+func TestSetFloodLimits_Disabled(t *testing.T) {
+	svc, _ := newFloodTestServer(t)
+	svc.SetFloodLimits(config.FloodConfig{})
+	assert.Nil(t, svc.flood)
+	for i := 0; i < 20; i++ {
+		evt, err := svc.dispatch("ace", whoMsg())
+		require.NoError(t, err)
+		require.NotNil(t, evt.GetPlayerList())
+	}
+}
+
+// TestProperty_FloodExempt_TabCompleteNeverLimited verifies that client-issued
+// tab completion is never counted, however much of it arrives.
+func TestProperty_FloodExempt_TabCompleteNeverLimited(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		svc, _ := newFloodTestServer(t)
+		n := rapid.IntRange(1, 50).Draw(rt, "n")
+		for i := 0; i < n; i++ {
+			msg := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_TabComplete{TabComplete: &gamev1.TabCompleteRequest{Prefix: "lo"}}}
+			if _, handled, _ := svc.checkFlood("ace", msg); handled {
+				rt.Fatalf("tab completion %d was limited", i)
+			}
+		}
+		if _, handled, _ := svc.checkFlood("ace", whoMsg()); handled {
+			rt.Fatal("the first typed command was limited")
+		}
+	})
+}
+
+func TestCheckFlood_PanelRefreshesHaveTheirOwnBucket(t *testing.T) {
+	svc, _ := newFloodTestServer(t)
+	refreshes := []*gamev1.ClientMessage{
+		{Payload: &gamev1.ClientMessage_Map{Map: &gamev1.MapRequest{}}},
+		{Payload: &gamev1.ClientMessage_CharSheet{CharSheet: &gamev1.CharacterSheetRequest{}}},
+		{Payload: &gamev1.ClientMessage_InventoryReq{InventoryReq: &gamev1.InventoryRequest{}}},
+		{Payload: &gamev1.ClientMessage_Loadout{Loadout: &gamev1.LoadoutRequest{}}},
+	}
+	burst := testFloodConfig().Burst * panelFloodFactor
+	for i := 0; i < burst; i++ {
+		msg := refreshes[i%len(refreshes)]
+		_, handled, err := svc.checkFlood("ace", msg)
+		require.NoError(t, err)
+		assert.False(t, handled, "%T refresh %d was limited", msg.Payload, i)
+	}
+	for i := 0; i < 2; i++ {
+		_, handled, _ := svc.checkFlood("ace", whoMsg())
+		assert.False(t, handled, "refreshes do not use up the command burst")
+	}
+
+	evt, handled, err := svc.checkFlood("ace", refreshes[1])
+	require.NoError(t, err)
+	assert.True(t, handled, "refreshes past the panel burst are limited")
+	assert.Contains(t, evt.GetError().GetMessage(), "too quickly")
+}
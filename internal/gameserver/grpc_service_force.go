@@ -0,0 +1,59 @@
+package gameserver
+
+import (
+	"fmt"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleForce runs req's command as the target player. The target sees who
+// forced them, and the force is recorded in the admin audit trail by dispatch.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanForce (checked by dispatch); req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; the target receives the
+// command's response. Error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleForce(uid string, req *gamev1.ForceRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	inner := req.GetCommand()
+	if req.GetTarget() == "" || inner.GetPayload() == nil {
+		return errorEvent("usage: force <player> <command>"), nil
+	}
+	target := s.sessions.GetPlayerByCharNameCI(req.GetTarget())
+	if target == nil {
+		return errorEvent(fmt.Sprintf("No player named %q is online.", req.GetTarget())), nil
+	}
+	if target.UID == uid {
+		return errorEvent("You can't force yourself."), nil
+	}
+	switch inner.GetPayload().(type) {
+	case *gamev1.ClientMessage_Force, *gamev1.ClientMessage_Quit, *gamev1.ClientMessage_SwitchCharacter:
+		return errorEvent("That command can't be forced."), nil
+	}
+	// Forcing must not lend the target privileges, nor use the target's.
+	if _, privileged := requiredCapability(inner); privileged {
+		return errorEvent("Privileged commands can't be forced."), nil
+	}
+	text := req.GetText()
+	if text == "" {
+		text = commandSummary(inner)
+	}
+
+	s.pushMessageToUID(target.UID, fmt.Sprintf("%s forces you to: %s", sess.CharName, text))
+	endCommand := s.beginCommand(s.commandCtx(uid), target.UID)
+	resp, err := s.dispatchSafely(target.UID, inner)
+	endCommand()
+	s.noteCommand(target.UID, inner)
+	s.mirrorCommand(target.UID, inner)
+	if err != nil {
+		s.pushEventToUID(target.UID, errorEvent(err.Error()))
+		return errorEvent(fmt.Sprintf("%s couldn't do that: %v", target.CharName, err)), nil
+	}
+	if resp != nil {
+		s.pushEventToUID(target.UID, resp)
+	}
+	return messageEvent(fmt.Sprintf("You force %s to: %s", target.CharName, text)), nil
+}
@@ -0,0 +1,81 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newForceTestServer builds a server with an admin and a player in room_a.
+func newForceTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *session.PlayerSession) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	admin, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "admin_u1", Username: "root", CharName: "Overseer", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "admin",
+	})
+	require.NoError(t, err)
+	player, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "pc_u1", Username: "pc", CharName: "Vex", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, admin, player
+}
+
+func forceMsg(target string, inner *gamev1.ClientMessage, text string) *gamev1.ForceRequest {
+	return &gamev1.ForceRequest{Target: target, Command: inner, Text: text}
+}
+
+func TestForce_RunsCommandAsTarget(t *testing.T) {
+	svc, admin, player := newForceTestServer(t)
+	move := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Move{Move: &gamev1.MoveRequest{Direction: "north"}}}
+
+	evt, err := svc.handleForce(admin.UID, forceMsg("vex", move, "north"))
+	require.NoError(t, err)
+	assert.Equal(t, "You force Vex to: north", trapEventText(evt))
+	assert.Equal(t, "room_b", player.RoomID)
+	assert.Equal(t, "room_a", admin.RoomID)
+	assert.Contains(t, pushedText(t, player), "Overseer forces you to: north")
+	assert.Equal(t, []string{`move {"direction":"north"}`}, player.RecentCommands)
+}
+
+func TestForce_Refusals(t *testing.T) {
+	svc, admin, _ := newForceTestServer(t)
+	say := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Say{Say: &gamev1.SayRequest{Message: "hi"}}}
+
+	cases := []struct {
+		req  *gamev1.ForceRequest
+		want string
+	}{
+		{forceMsg("ghost", say, "say hi"), "No player named"},
+		{forceMsg("Overseer", say, "say hi"), "You can't force yourself."},
+		{forceMsg("Vex", nil, ""), "usage: force"},
+		{forceMsg("Vex", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Quit{Quit: &gamev1.QuitRequest{}}}, "quit"), "can't be forced"},
+		{forceMsg("Vex", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Force{Force: forceMsg("Overseer", say, "")}}, "force"), "can't be forced"},
+		{forceMsg("Vex", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Teleport{Teleport: &gamev1.TeleportRequest{}}}, "teleport"), "Privileged commands"},
+	}
+	for _, tc := range cases {
+		evt, err := svc.handleForce(admin.UID, tc.req)
+		require.NoError(t, err)
+		assert.Contains(t, trapEventText(evt), tc.want)
+	}
+}
+
+func TestForce_IsAuditedAndAdminOnly(t *testing.T) {
+	svc, admin, player := newForceTestServer(t)
+	msg := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Force{Force: forceMsg("Vex", nil, "look")}}
+
+	action, target, _, ok := auditedAdminCommand(msg)
+	assert.True(t, ok)
+	assert.Equal(t, "force", action)
+	assert.Equal(t, "Vex", target)
+	assert.Nil(t, svc.authorize(admin.UID, msg))
+	assert.NotNil(t, svc.authorize(player.UID, msg))
+}
@@ -0,0 +1,48 @@
+package gameserver
+
+import (
+	"fmt"
+)
+
+// settleCombatMove runs everything that follows a change of grid position in
+// roomID's fight, whether the combatant strode there or was shoved or blown
+// there: a player sets off pressure plates, the movement hooks (consumable
+// traps, readied triggers) fire, and every position is rebroadcast.
+//
+// Precondition: s.combatH must not be nil.
+// Postcondition: Every client in roomID has the combatants' current positions.
+func (s *GameServiceServer) settleCombatMove(roomID, movedID string) {
+	if sess, ok := s.sessions.GetPlayer(movedID); ok && sess.RoomID == roomID {
+		if room, ok := s.world.GetRoom(roomID); ok {
+			s.checkPressurePlateTraps(movedID, sess, room)
+		}
+	}
+	s.combatH.FireCombatantMoved(roomID, movedID)
+	s.combatH.BroadcastAllPositions(roomID)
+}
+
+// resolveForcedMove settles a combatant that a shove or blast moved. One that
+// stayed on the grid settles like any other move; one driven out of the room
+// through an exit updates both rooms' views, and a player is told where they
+// landed and meets that room's lingering effects.
+//
+// Precondition: s.combatH must not be nil.
+// Postcondition: toRoomID == "" behaves as settleCombatMove(roomID, movedID).
+func (s *GameServiceServer) resolveForcedMove(roomID, movedID, toRoomID string) {
+	if toRoomID == "" {
+		s.settleCombatMove(roomID, movedID)
+		return
+	}
+	s.combatH.FireCombatantMoved(roomID, movedID)
+	s.combatH.BroadcastAllPositions(roomID)
+	s.pushRoomViewToAllInRoom(roomID)
+	s.pushRoomViewToAllInRoom(toRoomID)
+	if sess, ok := s.sessions.GetPlayer(movedID); ok {
+		title := toRoomID
+		if room, ok := s.world.GetRoom(toRoomID); ok {
+			title = room.Title
+		}
+		pushMessage(sess, fmt.Sprintf("You are thrown out of the fight into %s.", title))
+		s.exposeToRoomEffects(movedID, sess)
+	}
+}
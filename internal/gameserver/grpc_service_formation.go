@@ -0,0 +1,97 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// rowName returns the name of the formation row a back-row flag stands for.
+func rowName(back bool) string {
+	if back {
+		return "back"
+	}
+	return "front"
+}
+
+// handleFormation shows or changes the party formation. A player moves
+// themself between the front and back rows; the party leader may also place
+// any member.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when
+// the session is missing. On a move the member's session and combatant are
+// in the requested row.
+func (s *GameServiceServer) handleFormation(uid string, req *gamev1.FormationRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	group := s.sessions.GroupByUID(uid)
+	if req.GetRow() == "status" {
+		return messageEvent(s.formationStatus(sess, group)), nil
+	}
+	back := req.GetRow() == "back"
+	if req.GetMember() == "" {
+		s.setBackRow(sess, back)
+		if back {
+			return messageEvent("You fall back to the back row. You can only make ranged attacks, and you are harder to reach in melee while someone holds the front."), nil
+		}
+		return messageEvent("You step up to the front row."), nil
+	}
+
+	if group == nil {
+		return messageEvent("You are not in a group."), nil
+	}
+	if group.LeaderUID != uid {
+		return messageEvent("Only the group leader can place other members."), nil
+	}
+	var member *session.PlayerSession
+	for _, memberUID := range group.MemberUIDs {
+		if m, ok := s.sessions.GetPlayer(memberUID); ok && strings.EqualFold(m.CharName, req.GetMember()) {
+			member = m
+			break
+		}
+	}
+	if member == nil {
+		return messageEvent(fmt.Sprintf("%q is not in your group.", req.GetMember())), nil
+	}
+	s.setBackRow(member, back)
+	if member.UID != uid {
+		s.pushMessageToUID(member.UID, fmt.Sprintf("%s puts you in the %s row.", sess.CharName, rowName(back)))
+	}
+	return messageEvent(fmt.Sprintf("%s now fights from the %s row.", member.CharName, rowName(back))), nil
+}
+
+// setBackRow puts sess in the back or front row, including in a fight under
+// way.
+//
+// Precondition: sess must not be nil.
+// Postcondition: sess.BackRow == back.
+func (s *GameServiceServer) setBackRow(sess *session.PlayerSession, back bool) {
+	sess.BackRow = back
+	if s.combatH != nil {
+		s.combatH.SetCombatantBackRow(sess.RoomID, sess.UID, back)
+	}
+}
+
+// formationStatus describes the row sess fights from and, in a group, the
+// row of every member.
+//
+// Precondition: sess must not be nil; group may be nil.
+// Postcondition: Returns a non-empty string.
+func (s *GameServiceServer) formationStatus(sess *session.PlayerSession, group *session.Group) string {
+	if group == nil {
+		return fmt.Sprintf("You fight from the %s row.", rowName(sess.BackRow))
+	}
+	var b strings.Builder
+	b.WriteString("Formation:")
+	for _, memberUID := range group.MemberUIDs {
+		if m, ok := s.sessions.GetPlayer(memberUID); ok {
+			fmt.Fprintf(&b, "\n  %-20s %s", m.CharName, rowName(m.BackRow))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// formation sends uid's FormationRequest and returns the reply.
+func formation(t *testing.T, svc *GameServiceServer, uid, member, row string) string {
+	t.Helper()
+	event, err := svc.handleFormation(uid, &gamev1.FormationRequest{Member: member, Row: row})
+	require.NoError(t, err)
+	return event.GetMessage().GetContent()
+}
+
+func TestHandleFormation_LeaderPlacesMembers(t *testing.T) {
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	for _, name := range []string{"Lead", "Medic"} {
+		_, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+			UID: "u_" + name, Username: name, CharName: name,
+			RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player",
+		})
+		require.NoError(t, err)
+	}
+	lead, _ := sessMgr.GetPlayer("u_Lead")
+	medic, _ := sessMgr.GetPlayer("u_Medic")
+
+	assert.Equal(t, "You fight from the front row.", formation(t, svc, "u_Lead", "", "status"))
+	assert.Contains(t, formation(t, svc, "u_Lead", "Medic", "back"), "not in a group")
+
+	g := sessMgr.CreateGroup("u_Lead")
+	require.NoError(t, sessMgr.AddGroupMember(g.ID, "u_Medic"))
+	assert.Contains(t, formation(t, svc, "u_Medic", "Lead", "back"), "Only the group leader")
+	assert.False(t, lead.BackRow)
+
+	assert.Equal(t, "Medic now fights from the back row.", formation(t, svc, "u_Lead", "medic", "back"))
+	assert.True(t, medic.BackRow)
+	assert.Contains(t, formation(t, svc, "u_Medic", "", "front"), "front row")
+	assert.False(t, medic.BackRow)
+	formation(t, svc, "u_Lead", "", "back")
+	status := formation(t, svc, "u_Medic", "", "status")
+	assert.Contains(t, status, "Formation:")
+	assert.Regexp(t, `Lead\s+back`, status)
+	assert.Regexp(t, `Medic\s+front`, status)
+}
+
+func TestLegacyAutoQueueLocked_MeleePassesOverScreenedBackRow(t *testing.T) {
+	svc, sess, _, combatHandler, inst := newSurrenderFight(t)
+	formation(t, svc, sess.UID, "", "back")
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	require.True(t, cbt.GetCombatant(sess.UID).BackRow, "a formation change reaches the fight under way")
+
+	ganger := cbt.GetCombatant(inst.ID)
+	cbt.ActionQueues[inst.ID] = combat.NewActionQueue(inst.ID, 3)
+	combatHandler.legacyAutoQueueLocked(cbt, ganger)
+	queued := cbt.ActionQueues[inst.ID].QueuedActions()
+	require.NotEmpty(t, queued)
+	assert.Equal(t, "Fighter", queued[len(queued)-1].Target, "with no one in front the back row is fair game")
+
+	cbt.Combatants = append(cbt.Combatants, &combat.Combatant{
+		ID: "u_tank", Kind: combat.KindPlayer, Name: "Tank", MaxHP: 30, CurrentHP: 30, AC: 16,
+	})
+	cbt.ActionQueues[inst.ID] = combat.NewActionQueue(inst.ID, 3)
+	combatHandler.legacyAutoQueueLocked(cbt, ganger)
+	queued = cbt.ActionQueues[inst.ID].QueuedActions()
+	require.NotEmpty(t, queued)
+	assert.Equal(t, "Tank", queued[len(queued)-1].Target)
+}
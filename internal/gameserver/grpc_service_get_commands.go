@@ -0,0 +1,16 @@
+package gameserver
+
+import (
+	"context"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// GetCommands returns the command catalog this server dispatches on.
+//
+// Precondition: none.
+// Postcondition: Every built-in command is returned, ordered by name.
+func (s *GameServiceServer) GetCommands(_ context.Context, _ *gamev1.GetCommandsRequest) (*gamev1.GetCommandsResponse, error) {
+	return &gamev1.GetCommandsResponse{Commands: command.DefaultRegistry().CatalogProto()}, nil
+}
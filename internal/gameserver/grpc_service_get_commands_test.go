@@ -0,0 +1,26 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestGetCommands_ReturnsBuiltinCatalog(t *testing.T) {
+	s := &GameServiceServer{}
+	resp, err := s.GetCommands(context.Background(), &gamev1.GetCommandsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.GetCommands(), len(command.BuiltinCommands()))
+
+	r, err := command.RegistryFromProto(resp.GetCommands())
+	require.NoError(t, err)
+	cmd, ok := r.Resolve("siteban")
+	require.True(t, ok)
+	assert.Equal(t, command.HandlerSiteBan, cmd.Handler)
+	assert.Equal(t, command.RoleAdmin, cmd.RequiredRole())
+}
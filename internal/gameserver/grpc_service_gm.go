@@ -0,0 +1,131 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleGM runs a live-event action in the sender's room: freezing the combat
+// clock, healing or damaging a player or NPC, replacing the room description,
+// or calling a zone script hook.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanRunEvents (checked by dispatch); req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleGM(uid string, req *gamev1.GMRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	roomID := sess.RoomID
+	switch req.GetAction() {
+	case "freeze":
+		if s.combatH == nil || !s.combatH.FreezeRoom(roomID) {
+			return messageEvent("Combat here is already frozen."), nil
+		}
+		s.broadcastMessage(roomID, uid, &gamev1.MessageEvent{Content: "The combat clock stops. Rounds wait until everyone has acted."})
+		return messageEvent("Combat in this room is frozen. Use gm unfreeze to restart the clock."), nil
+	case "unfreeze":
+		if s.combatH == nil || !s.combatH.UnfreezeRoom(roomID) {
+			return messageEvent("Combat here isn't frozen."), nil
+		}
+		s.broadcastMessage(roomID, uid, &gamev1.MessageEvent{Content: "The combat clock is running again."})
+		return messageEvent("Combat in this room is unfrozen."), nil
+	case "heal", "damage":
+		return s.gmAdjustHP(sess, req), nil
+	case "desc":
+		if req.GetText() == "" {
+			if !s.worldH.ClearScene(roomID) {
+				return messageEvent("This room already shows its own description."), nil
+			}
+			s.pushRoomViewToAllInRoom(roomID)
+			return messageEvent("The room's own description is restored."), nil
+		}
+		s.worldH.SetScene(roomID, req.GetText())
+		s.pushRoomViewToAllInRoom(roomID)
+		return messageEvent("Scene description set. Use gm desc clear to restore the room's own."), nil
+	case "script":
+		return s.gmRunScript(sess, req.GetText()), nil
+	}
+	return errorEvent(fmt.Sprintf("unknown gm action %q", req.GetAction())), nil
+}
+
+// gmAdjustHP fully heals, or damages by req.Amount, the player or NPC named
+// req.Target in gm's room. GM damage never drops a target below 1 HP.
+func (s *GameServiceServer) gmAdjustHP(gm *session.PlayerSession, req *gamev1.GMRequest) *gamev1.ServerEvent {
+	hpAfter := func(current, maxHP int) int {
+		if req.GetAction() == "heal" {
+			return maxHP
+		}
+		return max(current-int(req.GetAmount()), 1)
+	}
+	for _, p := range s.sessions.PlayersInRoomDetails(gm.RoomID) {
+		if !strings.EqualFold(p.CharName, req.GetTarget()) {
+			continue
+		}
+		if p.Dead {
+			return errorEvent(fmt.Sprintf("%s is dead.", p.CharName))
+		}
+		before := p.CurrentHP
+		p.CurrentHP = hpAfter(p.CurrentHP, p.MaxHP)
+		if s.combatH != nil {
+			s.combatH.SetCombatantHP(gm.RoomID, p.UID, p.CurrentHP)
+		}
+		s.pushHPUpdate(p.UID, p)
+		if p.UID != gm.UID {
+			s.pushMessageToUID(p.UID, gmHPNotice(before, p.CurrentHP))
+		}
+		return messageEvent(fmt.Sprintf("%s is now at %d/%d HP.", p.CharName, p.CurrentHP, p.MaxHP))
+	}
+	var inst *npc.Instance
+	if s.npcMgr != nil {
+		inst = s.npcMgr.FindInRoom(gm.RoomID, req.GetTarget())
+	}
+	if inst == nil || inst.IsDead() {
+		return errorEvent(fmt.Sprintf("You don't see %q here.", req.GetTarget()))
+	}
+	inst.CurrentHP = hpAfter(inst.CurrentHP, inst.MaxHP)
+	if s.combatH != nil {
+		s.combatH.SetCombatantHP(gm.RoomID, inst.ID, inst.CurrentHP)
+	}
+	return messageEvent(fmt.Sprintf("%s is now at %d/%d HP.", inst.Name(), inst.CurrentHP, inst.MaxHP))
+}
+
+// gmHPNotice tells a player how a GM changed their HP.
+func gmHPNotice(before, after int) string {
+	if after >= before {
+		return "A surge of vitality washes over you. You are at full health."
+	}
+	return fmt.Sprintf("Pain lances through you. You take %d damage.", before-after)
+}
+
+// gmRunScript calls hook in the Lua VM of gm's zone, passing gm's UID and
+// room ID, and reports what it returned.
+func (s *GameServiceServer) gmRunScript(gm *session.PlayerSession, hook string) *gamev1.ServerEvent {
+	room, ok := s.world.GetRoom(gm.RoomID)
+	if !ok {
+		return errorEvent("You aren't in a known room.")
+	}
+	if s.scriptMgr == nil || !s.scriptMgr.HasHook(room.ZoneID, hook) {
+		return errorEvent(fmt.Sprintf("Zone %s has no script hook %q.", room.ZoneID, hook))
+	}
+	s.logger.Info("gm script hook",
+		zap.String("actor", gm.Username),
+		zap.String("zone", room.ZoneID),
+		zap.String("hook", hook))
+	ret, err := s.scriptMgr.CallHook(room.ZoneID, hook, lua.LString(gm.UID), lua.LString(gm.RoomID))
+	if err != nil {
+		return errorEvent(fmt.Sprintf("Running %s failed: %v", hook, err))
+	}
+	if ret == lua.LNil {
+		return messageEvent(fmt.Sprintf("Ran %s in zone %s.", hook, room.ZoneID))
+	}
+	return messageEvent(fmt.Sprintf("Ran %s in zone %s; it returned %s.", hook, room.ZoneID, ret.String()))
+}
@@ -0,0 +1,170 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+func gmText(t *testing.T, svc *GameServiceServer, uid string, req *gamev1.GMRequest) string {
+	t.Helper()
+	evt, err := svc.handleGM(uid, req)
+	require.NoError(t, err)
+	return trapEventText(evt)
+}
+
+func TestGM_FreezeHoldsTheRoundClock(t *testing.T) {
+	h := makeAbilityCombatHandler(t, func(_ string, _ []*gamev1.CombatEvent) {}, nil)
+	const roomID = "room-gm-freeze"
+	inst := spawnAbilityTestNPC(t, h.npcMgr, roomID, nil)
+	addTestPlayer(t, h.sessions, "p1", roomID)
+	cbt := startAbilityCombat(t, h, roomID, []*combat.Combatant{
+		{ID: inst.ID, Name: inst.Name(), Kind: combat.KindNPC, CurrentHP: 20, MaxHP: 20, AC: 13, Level: 1, Initiative: 5},
+		{ID: "p1", Name: "Alpha", Kind: combat.KindPlayer, CurrentHP: 10, MaxHP: 10, AC: 14, Level: 1, Initiative: 10},
+	})
+	round := func() int {
+		h.combatMu.RLock()
+		defer h.combatMu.RUnlock()
+		return cbt.Round
+	}
+	h.combatMu.Lock()
+	_ = cbt.StartRound(3)
+	h.startTimerLocked(roomID)
+	h.combatMu.Unlock()
+	defer h.cancelTimer(roomID)
+
+	require.True(t, h.FreezeRoom(roomID))
+	assert.False(t, h.FreezeRoom(roomID), "already frozen")
+	assert.True(t, h.IsRoomFrozen(roomID))
+	assert.True(t, h.IsRoomInCombat(roomID), "freezing keeps the combat")
+
+	// A round restarted while frozen keeps the clock stopped too.
+	h.combatMu.Lock()
+	h.startTimerLocked(roomID)
+	h.combatMu.Unlock()
+	time.Sleep(3 * testRoundDuration)
+	assert.Equal(t, 1, round(), "no round resolves while frozen")
+
+	require.True(t, h.UnfreezeRoom(roomID))
+	assert.False(t, h.UnfreezeRoom(roomID))
+	assert.Eventually(t, func() bool {
+		_, ok := h.engine.GetCombat(roomID)
+		return !ok || round() > 1
+	}, 10*testRoundDuration, testRoundDuration/4, "the clock runs again after unfreeze")
+}
+
+func TestSetCombatantHP_UpdatesActiveCombat(t *testing.T) {
+	h := makeAbilityCombatHandler(t, func(_ string, _ []*gamev1.CombatEvent) {}, nil)
+	const roomID = "room-gm-hp"
+	p1 := &combat.Combatant{ID: "p1", Name: "Alpha", Kind: combat.KindPlayer, CurrentHP: 3, MaxHP: 10, AC: 14, Level: 1}
+	startAbilityCombat(t, h, roomID, []*combat.Combatant{
+		p1,
+		{ID: "n1", Name: "Ganger", Kind: combat.KindNPC, CurrentHP: 20, MaxHP: 20, AC: 13, Level: 1},
+	})
+	h.SetCombatantHP(roomID, "p1", 10)
+	assert.Equal(t, 10, p1.CurrentHP)
+	h.SetCombatantHP("elsewhere", "p1", 1)
+	assert.Equal(t, 10, p1.CurrentHP)
+}
+
+// newGMTestServer builds a server with an editor, a wounded player, and a
+// ganger in room_a.
+func newGMTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *session.PlayerSession, *npc.Instance) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcMgr := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcMgr)
+	inst, err := npcMgr.Spawn(&npc.Template{ID: "ganger", Name: "Ganger", Level: 1, MaxHP: 20, AC: 12}, "room_a")
+	require.NoError(t, err)
+	editor, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ed_u1", Username: "ed", CharName: "Maker", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "editor",
+	})
+	require.NoError(t, err)
+	player, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "pc_u1", Username: "pc", CharName: "Vex", RoomID: "room_a",
+		CurrentHP: 4, MaxHP: 12, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, editor, player, inst
+}
+
+func TestGM_HealAndDamage(t *testing.T) {
+	svc, editor, player, inst := newGMTestServer(t)
+
+	assert.Equal(t, "Vex is now at 12/12 HP.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "heal", Target: "vex"}))
+	assert.Equal(t, 12, player.CurrentHP)
+	assert.Contains(t, pushedText(t, player), "You are at full health.")
+
+	assert.Equal(t, "Vex is now at 7/12 HP.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "damage", Target: "Vex", Amount: 5}))
+	assert.Contains(t, pushedText(t, player), "You take 5 damage.")
+	gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "damage", Target: "Vex", Amount: 50})
+	assert.Equal(t, 1, player.CurrentHP, "GM damage never kills")
+
+	assert.Equal(t, "Ganger is now at 12/20 HP.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "damage", Target: "gan", Amount: 8}))
+	assert.Equal(t, 12, inst.CurrentHP)
+	gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "heal", Target: "Ganger"})
+	assert.Equal(t, 20, inst.CurrentHP)
+
+	assert.Contains(t, gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "heal", Target: "nobody"}), `You don't see "nobody" here.`)
+}
+
+func TestGM_SceneDescription(t *testing.T) {
+	svc, editor, player, _ := newGMTestServer(t)
+	room, ok := svc.world.GetRoom("room_a")
+	require.True(t, ok)
+	own := room.Description
+
+	gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "desc", Text: "Sirens wail as smoke fills the street."})
+	view := svc.worldH.buildRoomView(player.UID, room)
+	assert.Contains(t, view.GetDescription(), "Sirens wail as smoke fills the street.")
+	assert.Equal(t, own, room.Description, "the room itself is untouched")
+
+	assert.Equal(t, "The room's own description is restored.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "desc"}))
+	assert.NotContains(t, svc.worldH.buildRoomView(player.UID, room).GetDescription(), "Sirens")
+	assert.Equal(t, "This room already shows its own description.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "desc"}))
+}
+
+func TestGM_RunsZoneScriptHooks(t *testing.T) {
+	svc, editor, _, _ := newGMTestServer(t)
+	room, ok := svc.world.GetRoom("room_a")
+	require.True(t, ok)
+
+	assert.Contains(t, gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "script", Text: "start_raid"}), "has no script hook")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "event.lua"), []byte(`
+		function start_raid(uid, room_id)
+			return "raid in " .. room_id
+		end
+	`), 0o644))
+	logger := zap.NewNop()
+	svc.scriptMgr = scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), logger), logger)
+	require.NoError(t, svc.scriptMgr.LoadZone(room.ZoneID, dir, 0))
+
+	assert.Equal(t, "Ran start_raid in zone "+room.ZoneID+"; it returned raid in room_a.",
+		gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "script", Text: "start_raid"}))
+}
+
+func TestGM_FreezeAnnouncesToTheRoom(t *testing.T) {
+	svc, editor, player, _ := newGMTestServer(t)
+	svc.combatH = makeAbilityCombatHandler(t, func(_ string, _ []*gamev1.CombatEvent) {}, nil)
+	assert.Contains(t, gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "freeze"}), "frozen")
+	assert.True(t, svc.combatH.IsRoomFrozen("room_a"))
+	assert.Contains(t, pushedText(t, player), "The combat clock stops.")
+	assert.Equal(t, "Combat here is already frozen.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "freeze"}))
+	assert.Equal(t, "Combat in this room is unfrozen.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "unfreeze"}))
+	assert.Equal(t, "Combat here isn't frozen.", gmText(t, svc, editor.UID, &gamev1.GMRequest{Action: "unfreeze"}))
+}
@@ -48,11 +48,11 @@ func TestHandleGrant_HeroPoint_PermissionDenied(t *testing.T) {
 	// Add a player (non-editor).
 	_ = addTargetForGrant(t, svc, "player-uid", "RegularPlayer")
 
-	resp, err := svc.handleGrant("player-uid", &gamev1.GrantRequest{
+	resp, err := svc.dispatch("player-uid", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Grant{Grant: &gamev1.GrantRequest{
 		GrantType: "heropoint",
 		CharName:  "SomeTarget",
 		Amount:    0,
-	})
+	}}})
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 
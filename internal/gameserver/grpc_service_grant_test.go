@@ -334,11 +334,11 @@ func TestHandleGrant_PlayerRoleDenied(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	evt, err := svc.handleGrant("player1", &gamev1.GrantRequest{
+	evt, err := svc.dispatch("player1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Grant{Grant: &gamev1.GrantRequest{
 		GrantType: "xp",
 		CharName:  "Anyone",
 		Amount:    100,
-	})
+	}}})
 
 	require.NoError(t, err)
 	require.NotNil(t, evt)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 var healerRuntimeMu sync.RWMutex
@@ -163,8 +164,9 @@ func (s *GameServiceServer) handleHeal(uid string, req *gamev1.HealRequest) (*ga
 	healerRuntimeMu.Unlock()
 	sess.CurrentHP = newHP
 	sess.Currency -= cost
+	s.emitCurrency(sess, -cost, telemetry.SourceHealing)
 	if s.healerCapacityRepo != nil {
-		if saveErr := s.healerCapacityRepo.Save(context.Background(), inst.TemplateID, newUsed); saveErr != nil {
+		if saveErr := s.healerCapacityRepo.Save(s.commandCtx(uid), inst.TemplateID, newUsed); saveErr != nil {
 			s.logger.Warn("failed to persist healer capacity after heal",
 				zap.String("template_id", inst.TemplateID),
 				zap.Error(saveErr),
@@ -231,8 +233,9 @@ func (s *GameServiceServer) handleHealAmount(uid string, req *gamev1.HealAmountR
 	healerRuntimeMu.Unlock()
 	sess.CurrentHP += amount
 	sess.Currency -= cost
+	s.emitCurrency(sess, -cost, telemetry.SourceHealing)
 	if s.healerCapacityRepo != nil {
-		if saveErr := s.healerCapacityRepo.Save(context.Background(), inst.TemplateID, newCapacity); saveErr != nil {
+		if saveErr := s.healerCapacityRepo.Save(s.commandCtx(uid), inst.TemplateID, newCapacity); saveErr != nil {
 			s.logger.Warn("failed to persist healer capacity after heal amount",
 				zap.String("template_id", inst.TemplateID),
 				zap.Error(saveErr),
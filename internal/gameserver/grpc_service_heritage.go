@@ -0,0 +1,45 @@
+package gameserver
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+)
+
+// grantHeritageFeat records the feat named by the character's heritage trait
+// when the character does not already hold it. Characters created with a
+// heritage receive it at their first login; the feat carries the trait's
+// gameplay effect.
+//
+// Precondition: characterID > 0; dbChar may be nil.
+// Postcondition: character_feats holds the heritage feat unless the heritage,
+// its feat, or the repositories are unavailable; failures are logged.
+func (s *GameServiceServer) grantHeritageFeat(ctx context.Context, characterID int64, dbChar *character.Character) {
+	if dbChar == nil || dbChar.Heritage == "" || s.characterFeatsRepo == nil || s.featRegistry == nil {
+		return
+	}
+	h, ok := s.heritages[dbChar.Heritage]
+	if !ok {
+		return
+	}
+	if _, ok := s.featRegistry.Feat(h.Trait.ID); !ok {
+		s.logger.Warn("heritage trait names an unknown feat",
+			zap.String("heritage", h.ID), zap.String("feat", h.Trait.ID))
+		return
+	}
+	held, err := s.characterFeatsRepo.GetAll(ctx, characterID)
+	if err != nil {
+		s.logger.Warn("loading feats for heritage grant", zap.Int64("character_id", characterID), zap.Error(err))
+		return
+	}
+	if slices.Contains(held, h.Trait.ID) {
+		return
+	}
+	if err := s.characterFeatsRepo.Add(ctx, characterID, h.Trait.ID); err != nil {
+		s.logger.Warn("granting heritage feat", zap.Int64("character_id", characterID),
+			zap.String("feat", h.Trait.ID), zap.Error(err))
+	}
+}
@@ -0,0 +1,55 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+)
+
+var testHeritages = map[string]*ruleset.Heritage{
+	"street_born": {
+		ID:        "street_born",
+		Modifiers: map[string]int{"quickness": 2, "reasoning": -2},
+		Trait:     ruleset.HeritageTrait{ID: "alley_sense", Name: "Alley Sense"},
+	},
+}
+
+func TestRecomputeBaseScores_AppliesHeritageModifiers(t *testing.T) {
+	regions := map[string]*ruleset.Region{"gresham": {ID: "gresham", Modifiers: map[string]int{"grit": 2}}}
+	jobReg := ruleset.NewJobRegistry()
+	jobReg.Register(&ruleset.Job{ID: "scavenger", KeyAbility: "savvy"})
+	dbChar := &character.Character{Region: "gresham", Heritage: "street_born", Class: "scavenger"}
+
+	got := recomputeBaseScores(dbChar, regions, testHeritages, jobReg)
+
+	assert.Equal(t, character.AbilityScores{
+		Brutality: 10, Grit: 12, Quickness: 12, Reasoning: 8, Savvy: 12, Flair: 10,
+	}, got)
+	assert.Equal(t, 10, recomputeBaseScores(&character.Character{Heritage: "street_born"}, nil, nil, nil).Quickness,
+		"no heritage registry leaves scores unmodified")
+}
+
+func TestGrantHeritageFeat(t *testing.T) {
+	repo := &stubFeatsRepo{data: map[int64][]string{}}
+	s := &GameServiceServer{
+		logger:             zap.NewNop(),
+		heritages:          testHeritages,
+		characterFeatsRepo: repo,
+		featRegistry:       ruleset.NewFeatRegistry([]*ruleset.Feat{{ID: "alley_sense", Category: "heritage"}}),
+	}
+	ctx := context.Background()
+
+	s.grantHeritageFeat(ctx, 1, &character.Character{ID: 1, Heritage: "street_born"})
+	s.grantHeritageFeat(ctx, 1, &character.Character{ID: 1, Heritage: "street_born"})
+	assert.Equal(t, []string{"alley_sense"}, repo.data[1], "granted once")
+
+	s.grantHeritageFeat(ctx, 2, &character.Character{ID: 2})
+	s.grantHeritageFeat(ctx, 3, &character.Character{ID: 3, Heritage: "unknown"})
+	assert.Empty(t, repo.data[2])
+	assert.Empty(t, repo.data[3])
+}
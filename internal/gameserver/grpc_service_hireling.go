@@ -6,6 +6,7 @@ import (
 
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 var hirelingRuntimeMu sync.RWMutex
@@ -61,6 +62,25 @@ func (s *GameServiceServer) findHiredHireling(uid string) *npc.Instance {
 	return nil
 }
 
+// releaseDeadHireling forgets the hire of a hireling instance that has died.
+//
+// Postcondition: hirelingRuntimeStates has no entry for instID.
+func (s *GameServiceServer) releaseDeadHireling(instID string) {
+	hirelingRuntimeMu.Lock()
+	defer hirelingRuntimeMu.Unlock()
+	delete(s.hirelingRuntimeStates, instID)
+}
+
+// sendHirelingHome moves a released hireling back to the post it was hired from.
+//
+// Precondition: inst must not be nil.
+// Postcondition: inst is in its home room when it has one.
+func (s *GameServiceServer) sendHirelingHome(inst *npc.Instance) {
+	if inst.HomeRoomID != "" && inst.RoomID != inst.HomeRoomID {
+		_ = s.npcMgr.Move(inst.ID, inst.HomeRoomID)
+	}
+}
+
 // handleHire processes a HireRequest: validates the hireling NPC exists in the
 // player's current room, checks availability and sufficient credits, then
 // deducts the daily cost and records the hire.
@@ -105,7 +125,12 @@ func (s *GameServiceServer) handleHire(uid string, req *gamev1.HireRequest) (*ga
 	hirelingRuntimeMu.Unlock()
 
 	sess.Currency -= cfg.DailyCost
-	return messageEvent(fmt.Sprintf("%s agrees to work with you for %d credits per day.", inst.Name(), cfg.DailyCost)), nil
+	s.emitCurrency(sess, -cfg.DailyCost, telemetry.SourceHireling)
+	msg := fmt.Sprintf("%s agrees to work with you for %d credits per day.", inst.Name(), cfg.DailyCost)
+	if cfg.LootCut > 0 {
+		msg += fmt.Sprintf(" They keep %d%% of any credits looted in your fights.", cfg.LootCut)
+	}
+	return messageEvent(msg), nil
 }
 
 // handleDismiss releases the hireling currently employed by uid.
@@ -126,6 +151,7 @@ func (s *GameServiceServer) handleDismiss(uid string, req *gamev1.DismissRequest
 		state.ZonesFollowed = 0
 	}
 	hirelingRuntimeMu.Unlock()
+	s.sendHirelingHome(inst)
 	return messageEvent(fmt.Sprintf("You dismiss %s. They head back to their post.", inst.Name())), nil
 }
 
@@ -162,12 +188,12 @@ func (s *GameServiceServer) moveHirelingWithPlayer(uid, newRoomID, fromZoneID, t
 }
 
 // tickHirelingDailyCost deducts the daily cost from each hiring player.
-// Hirelings whose employer cannot pay are automatically dismissed.
+// Hirelings whose employer cannot pay leave their service and return to their post.
 // Intended to be called once per in-game day.
 //
 // Precondition: s.hirelingRuntimeStates MUST NOT be nil.
 // Postcondition: all hired hirelings with solvent employers have had DailyCost deducted;
-// insolvent or orphaned hirelings are released.
+// insolvent or orphaned hirelings are released and sent home.
 func (s *GameServiceServer) tickHirelingDailyCost() {
 	hirelingRuntimeMu.Lock()
 	defer hirelingRuntimeMu.Unlock()
@@ -175,15 +201,16 @@ func (s *GameServiceServer) tickHirelingDailyCost() {
 		if state.HiredByPlayerID == "" {
 			continue
 		}
-		sess, ok := s.sessions.GetPlayer(state.HiredByPlayerID)
-		if !ok {
+		inst := s.npcMgr.InstanceByID(instID)
+		if inst == nil {
 			state.HiredByPlayerID = ""
-			state.ZonesFollowed = 0
 			continue
 		}
-		inst := s.npcMgr.InstanceByID(instID)
-		if inst == nil {
+		sess, ok := s.sessions.GetPlayer(state.HiredByPlayerID)
+		if !ok {
 			state.HiredByPlayerID = ""
+			state.ZonesFollowed = 0
+			s.sendHirelingHome(inst)
 			continue
 		}
 		tmpl := s.npcMgr.TemplateByID(inst.TemplateID)
@@ -193,8 +220,11 @@ func (s *GameServiceServer) tickHirelingDailyCost() {
 		if sess.Currency < tmpl.Hireling.DailyCost {
 			state.HiredByPlayerID = ""
 			state.ZonesFollowed = 0
+			s.sendHirelingHome(inst)
+			s.pushMessageToUID(sess.UID, fmt.Sprintf("You can't pay %s's %d-credit daily wage, so they leave your service.", inst.Name(), tmpl.Hireling.DailyCost))
 		} else {
 			sess.Currency -= tmpl.Hireling.DailyCost
+			s.emitCurrency(sess, -tmpl.Hireling.DailyCost, telemetry.SourceHireling)
 		}
 	}
 }
@@ -199,3 +199,33 @@ func TestHirelingZoneFollowLimit(t *testing.T) {
 	require.NotNil(t, movedInst)
 	assert.NotEqual(t, "room_d", movedInst.RoomID, "hireling must NOT follow when zone limit exceeded")
 }
+
+func TestTickHirelingDailyCost_UnpaidHirelingReturnsHome(t *testing.T) {
+	svc, uid := newHirelingTestServer(t)
+	_, err := svc.handleHire(uid, &gamev1.HireRequest{NpcName: "Patch"})
+	require.NoError(t, err)
+	inst := svc.npcMgr.FindInRoom("room_a", "Patch")
+	require.NotNil(t, inst)
+	svc.moveHirelingWithPlayer(uid, "room_b", "test", "test")
+	require.Equal(t, "room_b", svc.npcMgr.InstanceByID(inst.ID).RoomID)
+
+	sess, _ := svc.sessions.GetPlayer(uid)
+	sess.Currency = 0
+	svc.tickHirelingDailyCost()
+
+	assert.Equal(t, "room_a", svc.npcMgr.InstanceByID(inst.ID).RoomID, "an unpaid hireling returns to its post")
+	assert.Equal(t, "", svc.HirelingOwnerOf(inst.ID))
+	assert.Nil(t, svc.findHiredHireling(uid))
+}
+
+func TestReleaseDeadHireling(t *testing.T) {
+	svc, uid := newHirelingTestServer(t)
+	_, err := svc.handleHire(uid, &gamev1.HireRequest{NpcName: "Patch"})
+	require.NoError(t, err)
+	inst := svc.npcMgr.FindInRoom("room_a", "Patch")
+	require.NotNil(t, inst)
+
+	svc.releaseDeadHireling(inst.ID)
+	assert.Nil(t, svc.hirelingStateFor(inst.ID))
+	assert.Nil(t, svc.findHiredHireling(uid))
+}
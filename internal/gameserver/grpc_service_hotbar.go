@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -59,7 +58,7 @@ func (s *GameServiceServer) handleHotbar(uid string, req *gamev1.HotbarRequest)
 		}
 		sess.Hotbars[sess.ActiveHotbarIndex][idx] = slot
 		if s.charSaver != nil && sess.CharacterID > 0 {
-			if err := s.charSaver.SaveHotbars(context.Background(), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
+			if err := s.charSaver.SaveHotbars(s.commandCtx(uid), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
 				s.logger.Warn("SaveHotbars failed", zap.String("uid", uid), zap.Error(err))
 			}
 		}
@@ -75,7 +74,7 @@ func (s *GameServiceServer) handleHotbar(uid string, req *gamev1.HotbarRequest)
 		idx := int(req.Slot) - 1
 		sess.Hotbars[sess.ActiveHotbarIndex][idx] = session.HotbarSlot{}
 		if s.charSaver != nil && sess.CharacterID > 0 {
-			if err := s.charSaver.SaveHotbars(context.Background(), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
+			if err := s.charSaver.SaveHotbars(s.commandCtx(uid), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
 				s.logger.Warn("SaveHotbars failed", zap.String("uid", uid), zap.Error(err))
 			}
 		}
@@ -107,7 +106,7 @@ func (s *GameServiceServer) handleHotbar(uid string, req *gamev1.HotbarRequest)
 		sess.Hotbars = append(sess.Hotbars, [10]session.HotbarSlot{})
 		sess.ActiveHotbarIndex = len(sess.Hotbars) - 1
 		if s.charSaver != nil && sess.CharacterID > 0 {
-			if err := s.charSaver.SaveHotbars(context.Background(), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
+			if err := s.charSaver.SaveHotbars(s.commandCtx(uid), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
 				s.logger.Warn("SaveHotbars failed", zap.String("uid", uid), zap.Error(err))
 			}
 		}
@@ -122,7 +121,7 @@ func (s *GameServiceServer) handleHotbar(uid string, req *gamev1.HotbarRequest)
 		}
 		sess.ActiveHotbarIndex = targetIdx
 		if s.charSaver != nil && sess.CharacterID > 0 {
-			if err := s.charSaver.SaveHotbars(context.Background(), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
+			if err := s.charSaver.SaveHotbars(s.commandCtx(uid), sess.CharacterID, sess.Hotbars, sess.ActiveHotbarIndex); err != nil {
 				s.logger.Warn("SaveHotbars failed", zap.String("uid", uid), zap.Error(err))
 			}
 		}
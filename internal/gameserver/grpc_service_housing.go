@@ -0,0 +1,601 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/housing"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/permission"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// HousingStore persists player room leases.
+type HousingStore interface {
+	LoadLeases(ctx context.Context) ([]*housing.Lease, error)
+	SaveLease(ctx context.Context, lease *housing.Lease) error
+	DeleteLease(ctx context.Context, roomID string) error
+	// DeletedTenants returns the IDs of soft-deleted characters that still hold a lease.
+	DeletedTenants(ctx context.Context) ([]int64, error)
+}
+
+// StashStore persists stash (bank) balances.
+//
+// Precondition: characterID must be > 0.
+type StashStore interface {
+	LoadStashBalance(ctx context.Context, characterID int64) (int, error)
+	SaveStashBalance(ctx context.Context, characterID int64, balance int) error
+	// ChargeStash deducts amount when the balance covers it and reports whether it did.
+	ChargeStash(ctx context.Context, characterID int64, amount int) (bool, error)
+}
+
+// InitHousing loads persisted leases into the lease registry.
+//
+// Precondition: MUST be called before players connect.
+// Postcondition: No-op when housing or its store is not configured; load failures are logged.
+func (s *GameServiceServer) InitHousing(ctx context.Context) {
+	if s.housing == nil || s.housingStore == nil {
+		return
+	}
+	leases, err := s.housingStore.LoadLeases(ctx)
+	if err != nil {
+		s.logger.Warn("failed to load player housing from DB", zap.Error(err))
+		return
+	}
+	s.housing.Load(leases)
+}
+
+// StartHousingRentHook subscribes to the calendar and collects rent on every calendar tick.
+//
+// Precondition: MUST be called after GameServiceServer is fully initialized.
+// Postcondition: returns a stop function; call it to unsubscribe and stop the goroutine.
+func (s *GameServiceServer) StartHousingRentHook() func() {
+	if s.calendar == nil || s.housing == nil {
+		return func() {}
+	}
+	ch := make(chan GameDateTime, 4)
+	s.calendar.Subscribe(ch)
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ch:
+				s.tickHousingRent(time.Now())
+			case <-stop:
+				s.calendar.Unsubscribe(ch)
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// canEnterHome reports whether sess may enter roomID.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: Returns false only for a room rented by another character,
+// unless sess's role may teleport.
+func (s *GameServiceServer) canEnterHome(sess *session.PlayerSession, roomID string) bool {
+	if s.housing == nil || s.housing.CanEnter(roomID, sess.CharacterID) {
+		return true
+	}
+	return s.can(sess, permission.CanTeleport)
+}
+
+// tickHousingRent charges rent on every lease that has fallen due.
+//
+// Precondition: s.housing must be non-nil.
+// Postcondition: Leases held by deleted characters are released first.
+// Each due lease is renewed when its tenant's stash covers the
+// rent; otherwise it is marked past due, and evicted once past due for a full
+// rent period. Tenants who are online are told what happened.
+func (s *GameServiceServer) tickHousingRent(now time.Time) {
+	s.releaseDeletedTenants()
+	for _, lease := range s.housing.Due(now) {
+		room, ok := s.world.GetRoom(lease.RoomID)
+		if !ok || room.Housing == nil {
+			s.logger.Warn("lease on a room that is no longer rentable", zap.String("room", lease.RoomID))
+			continue
+		}
+		terms := room.Housing
+		if s.chargeRent(lease.CharacterID, terms.Rent) {
+			if renewed, ok := s.housing.Renew(lease.RoomID, terms.Period()); ok {
+				s.saveLease(renewed)
+				s.notifyTenant(lease.CharacterID, fmt.Sprintf("Rent of %d credits for %s was paid from your stash.", terms.Rent, room.Title))
+			}
+			continue
+		}
+		pastDue, ok := s.housing.MarkPastDue(lease.RoomID, now)
+		if !ok {
+			continue
+		}
+		if housing.ShouldEvict(pastDue, terms.Period(), now) {
+			s.evictTenant(room)
+			continue
+		}
+		s.saveLease(pastDue)
+		if lease.PastDueSince.IsZero() {
+			s.notifyTenant(lease.CharacterID, fmt.Sprintf(
+				"Your rent of %d credits for %s is overdue and your stash can't cover it. Deposit credits before %s or you will be evicted.",
+				terms.Rent, room.Title, pastDue.PastDueSince.Add(terms.Period()).Format(time.RFC1123)))
+		}
+	}
+}
+
+// chargeRent deducts amount from the character's stash.
+//
+// Postcondition: Returns true when the stash covered amount. The live session
+// balance is charged when the tenant is online; otherwise the stored balance is.
+// Runs on the calendar goroutine, so the live balance is only touched under the
+// session's StashMu.
+func (s *GameServiceServer) chargeRent(characterID int64, amount int) bool {
+	if sess := s.sessions.GetPlayerByCharID(characterID); sess != nil {
+		if _, ok := sess.DebitStash(amount); !ok {
+			return false
+		}
+		s.saveStashBalance(sess)
+		return true
+	}
+	if s.stashStore == nil {
+		return false
+	}
+	ok, err := s.stashStore.ChargeStash(context.Background(), characterID, amount)
+	if err != nil {
+		s.logger.Warn("charging rent", zap.Int64("character_id", characterID), zap.Error(err))
+		return false
+	}
+	return ok
+}
+
+// releaseDeletedTenants ends every lease held by a deleted character.
+// Characters are deleted by the frontends, outside this process, so the
+// registry is reconciled against the store on each rent tick.
+//
+// Postcondition: No-op when no HousingStore is configured; failures are logged.
+func (s *GameServiceServer) releaseDeletedTenants() {
+	if s.housingStore == nil {
+		return
+	}
+	ids, err := s.housingStore.DeletedTenants(context.Background())
+	if err != nil {
+		s.logger.Warn("listing deleted tenants", zap.Error(err))
+		return
+	}
+	for _, id := range ids {
+		if lease, ok := s.housing.ForCharacter(id); ok {
+			s.endLease(lease.RoomID)
+		}
+	}
+}
+
+// evictTenant ends the lease on room for unpaid rent.
+//
+// Precondition: room must be non-nil.
+func (s *GameServiceServer) evictTenant(room *world.Room) {
+	if lease, ok := s.endLease(room.ID); ok {
+		s.notifyTenant(lease.CharacterID, fmt.Sprintf("You have been evicted from %s for unpaid rent.", room.Title))
+	}
+}
+
+// endLease removes the lease on roomID from the registry and the store.
+// Anything left in storage is dumped on the floor, free for whoever walks in next.
+//
+// Postcondition: Returns the removed lease, or false when roomID was not rented.
+func (s *GameServiceServer) endLease(roomID string) (*housing.Lease, bool) {
+	lease, ok := s.housing.Evict(roomID)
+	if !ok {
+		return nil, false
+	}
+	s.deleteLease(roomID)
+	if s.floorMgr != nil {
+		for itemID, qty := range lease.Storage {
+			s.floorMgr.Drop(roomID, inventory.ItemInstance{
+				InstanceID: uuid.New().String(),
+				ItemDefID:  itemID,
+				Quantity:   qty,
+			})
+		}
+		for _, it := range lease.Instances {
+			inst := inventory.ItemInstance{
+				InstanceID: uuid.New().String(),
+				ItemDefID:  it.ItemDefID,
+				Quantity:   it.Quantity,
+			}
+			if it.Metadata != nil {
+				inst.ApplyMetadata(it.Metadata)
+			}
+			s.floorMgr.Drop(roomID, inst)
+		}
+	}
+	return lease, true
+}
+
+// notifyTenant sends text to the character if they are online.
+func (s *GameServiceServer) notifyTenant(characterID int64, text string) {
+	if sess := s.sessions.GetPlayerByCharID(characterID); sess != nil {
+		s.pushMessageToUID(sess.UID, text)
+	}
+}
+
+// saveLease persists lease, logging failures.
+func (s *GameServiceServer) saveLease(lease *housing.Lease) {
+	if s.housingStore == nil {
+		return
+	}
+	if err := s.housingStore.SaveLease(context.Background(), lease); err != nil {
+		s.logger.Warn("saving lease", zap.String("room", lease.RoomID), zap.Error(err))
+	}
+}
+
+// deleteLease removes the persisted lease on roomID, logging failures.
+func (s *GameServiceServer) deleteLease(roomID string) {
+	if s.housingStore == nil {
+		return
+	}
+	if err := s.housingStore.DeleteLease(context.Background(), roomID); err != nil {
+		s.logger.Warn("deleting lease", zap.String("room", roomID), zap.Error(err))
+	}
+}
+
+// saveStashBalance persists sess's stash balance, logging failures.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when no StashStore is configured or sess.CharacterID is 0.
+func (s *GameServiceServer) saveStashBalance(sess *session.PlayerSession) {
+	if s.stashStore == nil || sess.CharacterID <= 0 {
+		return
+	}
+	// Hold the lock across the write so concurrent saves land in balance order.
+	sess.StashMu.Lock()
+	defer sess.StashMu.Unlock()
+	if err := s.stashStore.SaveStashBalance(s.commandCtx(sess.UID), sess.CharacterID, sess.StashBalance); err != nil {
+		s.logger.Warn("saving stash balance", zap.Int64("character_id", sess.CharacterID), zap.Error(err))
+	}
+}
+
+// itemDisplayName returns the registry name for itemID, or itemID when unknown.
+func (s *GameServiceServer) itemDisplayName(itemID string) string {
+	if s.invRegistry != nil {
+		if def, ok := s.invRegistry.Item(itemID); ok {
+			return def.Name
+		}
+	}
+	return itemID
+}
+
+// instanceDisplayName names an item instance with meta, including its affixes
+// and engraved name; plain items get their registry name.
+func (s *GameServiceServer) instanceDisplayName(itemID string, meta *inventory.ItemMetadata) string {
+	name := s.itemDisplayName(itemID)
+	if meta == nil || s.invRegistry == nil {
+		return name
+	}
+	return s.invRegistry.InstanceName(name, meta.CustomName, meta.Affixes)
+}
+
+// handleHome dispatches the home command.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleHome(uid string, req *gamev1.HomeRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.housing == nil || sess.CharacterID <= 0 {
+		return messageEvent("Housing is not available."), nil
+	}
+	switch req.GetAction() {
+	case "":
+		return messageEvent(s.homeStatus(sess)), nil
+	case "rent":
+		return messageEvent(s.rentHome(sess)), nil
+	case "vacate":
+		return messageEvent(s.vacateHome(sess)), nil
+	case "describe":
+		return messageEvent(s.describeHome(sess, req.GetTarget())), nil
+	case "store":
+		return messageEvent(s.storeInHome(sess, req.GetTarget(), int(req.GetQuantity()))), nil
+	case "take":
+		return messageEvent(s.takeFromHome(sess, req.GetTarget(), int(req.GetQuantity()))), nil
+	}
+	return messageEvent(fmt.Sprintf("Unknown home action %q.", req.GetAction())), nil
+}
+
+// homeStatus describes the player's lease and whether the current room is for rent.
+func (s *GameServiceServer) homeStatus(sess *session.PlayerSession) string {
+	var lines []string
+	if lease, ok := s.housing.ForCharacter(sess.CharacterID); ok {
+		title := lease.RoomID
+		if room, ok := s.world.GetRoom(lease.RoomID); ok {
+			title = room.Title
+			if room.Housing != nil {
+				lines = append(lines, fmt.Sprintf("Your home: %s. Rent: %d credits every %d hours, paid until %s.",
+					title, room.Housing.Rent, room.Housing.PeriodHours, lease.PaidUntil.Format(time.RFC1123)))
+				if !lease.PastDueSince.IsZero() {
+					lines = append(lines, fmt.Sprintf("Your rent is overdue! Deposit credits in your stash before %s or you will be evicted.",
+						lease.PastDueSince.Add(room.Housing.Period()).Format(time.RFC1123)))
+				}
+			}
+		}
+		if len(lines) == 0 {
+			lines = append(lines, fmt.Sprintf("Your home: %s.", title))
+		}
+		lines = append(lines, "Storage: "+s.formatStorage(lease))
+	}
+	if room, ok := s.world.GetRoom(sess.RoomID); ok && room.Housing != nil {
+		if lease, rented := s.housing.Get(room.ID); !rented {
+			lines = append(lines, fmt.Sprintf("This room is for rent: %d credits every %d hours, charged from your stash. Type \"home rent\" to rent it.",
+				room.Housing.Rent, room.Housing.PeriodHours))
+		} else if lease.CharacterID != sess.CharacterID {
+			lines = append(lines, fmt.Sprintf("This room is rented by %s.", lease.OwnerName))
+		}
+	}
+	if len(lines) == 0 {
+		return "You don't rent a room."
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatStorage lists the lease's stored items by display name.
+func (s *GameServiceServer) formatStorage(lease *housing.Lease) string {
+	if lease.Empty() {
+		return "empty."
+	}
+	entries := make([]string, 0, len(lease.Storage)+len(lease.Instances))
+	for itemID, qty := range lease.Storage {
+		entries = append(entries, fmt.Sprintf("%d× %s", qty, s.itemDisplayName(itemID)))
+	}
+	for _, it := range lease.Instances {
+		entries = append(entries, fmt.Sprintf("%d× %s", it.Quantity, s.instanceDisplayName(it.ItemDefID, it.Metadata)))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ", ") + "."
+}
+
+// rentHome rents the player's current room, charging the first period from their stash.
+func (s *GameServiceServer) rentHome(sess *session.PlayerSession) string {
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok || room.Housing == nil {
+		return "This room is not for rent."
+	}
+	if lease, rented := s.housing.Get(room.ID); rented {
+		if lease.CharacterID == sess.CharacterID {
+			return "You already rent this room."
+		}
+		return "This room is already rented."
+	}
+	if _, has := s.housing.ForCharacter(sess.CharacterID); has {
+		return "You already rent a room. Vacate it first (home vacate)."
+	}
+	rent := room.Housing.Rent
+	if balance, ok := sess.DebitStash(rent); !ok {
+		return fmt.Sprintf("Rent is %d credits, charged from your stash, which holds %d. Deposit credits with a banker first.", rent, balance)
+	}
+	lease, err := s.housing.Rent(room.ID, sess.CharacterID, sess.CharName, time.Now().Add(room.Housing.Period()))
+	if err != nil {
+		sess.CreditStash(rent)
+		return "You can't rent this room right now."
+	}
+	s.saveStashBalance(sess)
+	s.saveLease(lease)
+	return fmt.Sprintf("You rent %s for %d credits. Rent is charged from your stash every %d hours; the door now locks behind you.",
+		room.Title, rent, room.Housing.PeriodHours)
+}
+
+// vacateHome ends the player's lease.
+func (s *GameServiceServer) vacateHome(sess *session.PlayerSession) string {
+	lease, ok := s.housing.ForCharacter(sess.CharacterID)
+	if !ok {
+		return "You don't rent a room."
+	}
+	if err := s.housing.Vacate(lease.RoomID, sess.CharacterID); err != nil {
+		if errors.Is(err, housing.ErrNotEmpty) {
+			return "Empty your storage first (home take <item>)."
+		}
+		return "You can't vacate right now."
+	}
+	s.deleteLease(lease.RoomID)
+	return "You hand back the keys. The room is no longer yours."
+}
+
+// currentHome returns the player's lease when they are standing in their home.
+//
+// Postcondition: Returns (nil, message) when the player is elsewhere.
+func (s *GameServiceServer) currentHome(sess *session.PlayerSession) (*housing.Lease, string) {
+	lease, ok := s.housing.Get(sess.RoomID)
+	if !ok || lease.CharacterID != sess.CharacterID {
+		return nil, "You can only do that in your own home."
+	}
+	return lease, ""
+}
+
+// describeHome sets or clears the custom description of the player's home.
+func (s *GameServiceServer) describeHome(sess *session.PlayerSession, text string) string {
+	if _, msg := s.currentHome(sess); msg != "" {
+		return msg
+	}
+	text = strings.TrimSpace(text)
+	if len(text) > housing.MaxDescriptionLen {
+		return fmt.Sprintf("Descriptions are limited to %d characters.", housing.MaxDescriptionLen)
+	}
+	lease, err := s.housing.SetDescription(sess.RoomID, sess.CharacterID, text)
+	if err != nil {
+		return "You can only do that in your own home."
+	}
+	s.saveLease(lease)
+	if text == "" {
+		return "You restore your home's original description."
+	}
+	return "You update your home's description."
+}
+
+// storeInHome moves qty of a carried item into the home's storage container.
+// An item carrying instance state is stored whole so it comes back intact.
+func (s *GameServiceServer) storeInHome(sess *session.PlayerSession, target string, qty int) string {
+	if _, msg := s.currentHome(sess); msg != "" {
+		return msg
+	}
+	if qty < 1 {
+		qty = 1
+	}
+	if sess.Backpack == nil {
+		return "You aren't carrying that."
+	}
+	itemID, meta, stacks, owned := s.backpackLot(sess, target)
+	if itemID == "" {
+		return "You aren't carrying that."
+	}
+	if owned < qty {
+		return fmt.Sprintf("You only have %d.", owned)
+	}
+	var taken []inventory.ItemInstance
+	removed := 0
+	for _, instanceID := range stacks {
+		if removed == qty {
+			break
+		}
+		it := sess.Backpack.GetByInstanceID(instanceID)
+		if it == nil {
+			continue
+		}
+		take := min(it.Quantity, qty-removed)
+		snapshot := *it
+		if err := sess.Backpack.Remove(instanceID, take); err != nil {
+			break
+		}
+		snapshot.Quantity = take
+		taken = append(taken, snapshot)
+		removed += take
+	}
+	if removed == 0 {
+		return "You can't put that away right now."
+	}
+	qty = removed
+	var (
+		lease *housing.Lease
+		err   error
+	)
+	if meta != nil {
+		lease, err = s.housing.StoreInstance(sess.RoomID, sess.CharacterID, inventory.InventoryItem{ItemDefID: itemID, Quantity: qty, Metadata: meta})
+	} else {
+		lease, err = s.housing.Store(sess.RoomID, sess.CharacterID, itemID, qty)
+	}
+	if err != nil {
+		// The lease vanished mid-command; give the items back.
+		restoreBackpackStacks(sess, taken)
+		return "You can only do that in your own home."
+	}
+	s.saveLease(lease)
+	s.saveHomeInventory(sess)
+	return fmt.Sprintf("You store %d× %s.", qty, s.instanceDisplayName(itemID, meta))
+}
+
+// storedInstanceIndex returns the index in home.Instances of the stored item
+// named target, or -1. With byDefName it matches the item's def ID or name;
+// otherwise only an engraved or affixed name.
+func (s *GameServiceServer) storedInstanceIndex(home *housing.Lease, target string, byDefName bool) int {
+	for i, it := range home.Instances {
+		if byDefName {
+			if strings.EqualFold(it.ItemDefID, target) || strings.EqualFold(s.itemDisplayName(it.ItemDefID), target) {
+				return i
+			}
+			continue
+		}
+		if it.Metadata == nil {
+			continue
+		}
+		full := s.instanceDisplayName(it.ItemDefID, it.Metadata)
+		if (it.Metadata.CustomName != "" && strings.EqualFold(it.Metadata.CustomName, target)) ||
+			(full != s.itemDisplayName(it.ItemDefID) && strings.EqualFold(full, target)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// takeFromHome moves qty of a stored item into the player's backpack. A
+// stored item carrying instance state is taken back whole.
+func (s *GameServiceServer) takeFromHome(sess *session.PlayerSession, target string, qty int) string {
+	home, msg := s.currentHome(sess)
+	if msg != "" {
+		return msg
+	}
+	if qty < 1 {
+		qty = 1
+	}
+	itemID := ""
+	for id := range home.Storage {
+		if strings.EqualFold(id, target) || strings.EqualFold(s.itemDisplayName(id), target) {
+			itemID = id
+			break
+		}
+	}
+	instance := s.storedInstanceIndex(home, target, false)
+	if instance < 0 && itemID == "" {
+		instance = s.storedInstanceIndex(home, target, true)
+	}
+	if instance < 0 && itemID == "" {
+		return "You don't have that in storage."
+	}
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return "You can't carry anything right now."
+	}
+	if instance >= 0 {
+		return s.takeInstanceFromHome(sess, instance)
+	}
+	lease, err := s.housing.Take(sess.RoomID, sess.CharacterID, itemID, qty)
+	if errors.Is(err, housing.ErrInsufficient) {
+		return fmt.Sprintf("You only have %d in storage.", home.Storage[itemID])
+	}
+	if err != nil {
+		return "You can only do that in your own home."
+	}
+	if _, addErr := sess.Backpack.Add(itemID, qty, s.invRegistry); addErr != nil {
+		if restored, storeErr := s.housing.Store(sess.RoomID, sess.CharacterID, itemID, qty); storeErr == nil {
+			lease = restored
+		}
+		s.saveLease(lease)
+		return fmt.Sprintf("You can't carry that: %s", addErr.Error())
+	}
+	s.saveLease(lease)
+	s.saveHomeInventory(sess)
+	return fmt.Sprintf("You take %d× %s from storage.", qty, s.itemDisplayName(itemID))
+}
+
+// takeInstanceFromHome moves the stored instance at index i into the player's backpack.
+func (s *GameServiceServer) takeInstanceFromHome(sess *session.PlayerSession, i int) string {
+	lease, item, err := s.housing.TakeInstance(sess.RoomID, sess.CharacterID, i)
+	if err != nil {
+		return "You can only do that in your own home."
+	}
+	if _, addErr := sess.Backpack.AddWithMetadata(item.ItemDefID, item.Quantity, item.Metadata, s.invRegistry); addErr != nil {
+		if restored, storeErr := s.housing.StoreInstance(sess.RoomID, sess.CharacterID, item); storeErr == nil {
+			lease = restored
+		}
+		s.saveLease(lease)
+		return fmt.Sprintf("You can't carry that: %s", addErr.Error())
+	}
+	s.saveLease(lease)
+	s.saveHomeInventory(sess)
+	return fmt.Sprintf("You take %d× %s from storage.", item.Quantity, s.instanceDisplayName(item.ItemDefID, item.Metadata))
+}
+
+// saveHomeInventory persists the player's backpack after a storage transfer.
+func (s *GameServiceServer) saveHomeInventory(sess *session.PlayerSession) {
+	if s.charSaver == nil || sess.CharacterID <= 0 {
+		return
+	}
+	if err := s.charSaver.SaveInventory(s.commandCtx(sess.UID), sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
+		s.logger.Warn("saving inventory after home storage", zap.Int64("character_id", sess.CharacterID), zap.Error(err))
+	}
+}
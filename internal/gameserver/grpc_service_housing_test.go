@@ -0,0 +1,361 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/housing"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+type memHousingStore struct {
+	leases  map[string]*housing.Lease
+	deleted map[int64]bool
+}
+
+func (m *memHousingStore) LoadLeases(context.Context) ([]*housing.Lease, error) {
+	var out []*housing.Lease
+	for _, l := range m.leases {
+		out = append(out, l)
+	}
+	return out, nil
+}
+
+func (m *memHousingStore) SaveLease(_ context.Context, l *housing.Lease) error {
+	m.leases[l.RoomID] = l
+	return nil
+}
+
+func (m *memHousingStore) DeleteLease(_ context.Context, roomID string) error {
+	delete(m.leases, roomID)
+	return nil
+}
+
+func (m *memHousingStore) DeletedTenants(context.Context) ([]int64, error) {
+	var out []int64
+	for _, l := range m.leases {
+		if m.deleted[l.CharacterID] {
+			out = append(out, l.CharacterID)
+		}
+	}
+	return out, nil
+}
+
+type memStashStore struct {
+	balances map[int64]int
+}
+
+func (m *memStashStore) LoadStashBalance(_ context.Context, id int64) (int, error) {
+	return m.balances[id], nil
+}
+
+func (m *memStashStore) SaveStashBalance(_ context.Context, id int64, balance int) error {
+	m.balances[id] = balance
+	return nil
+}
+
+func (m *memStashStore) ChargeStash(_ context.Context, id int64, amount int) (bool, error) {
+	if m.balances[id] < amount {
+		return false, nil
+	}
+	m.balances[id] -= amount
+	return true, nil
+}
+
+// newHousingTestServer builds a server with a hallway and a rentable flat east
+// of it. Player "uid1" (character 7) stands in the flat with 100 credits in
+// their stash and two stim packs in their backpack.
+func newHousingTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *memHousingStore, *memStashStore) {
+	t.Helper()
+	hall := &world.Room{ID: "hall", ZoneID: "z", Title: "Hall", Description: "A hall.",
+		Exits: []world.Exit{{Direction: world.East, TargetRoom: "flat"}}}
+	flat := &world.Room{ID: "flat", ZoneID: "z", Title: "Flat", Description: "A bare flat.", MapX: 1,
+		Exits:   []world.Exit{{Direction: world.West, TargetRoom: "hall"}},
+		Housing: &world.HousingConfig{Rent: 40, PeriodHours: 24}}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "hall",
+		Rooms: map[string]*world.Room{"hall": hall, "flat": flat}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	_, err = sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "uid1", Username: "u", CharName: "Tenant", CharacterID: 7,
+		RoomID: "flat", CurrentHP: 10, MaxHP: 10,
+		Abilities: character.AbilityScores{}, Role: "player",
+	})
+	require.NoError(t, err)
+	sess, _ := sMgr.GetPlayer("uid1")
+	sess.StashBalance = 100
+
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{
+		ID: "stim_pack", Name: "Stim Pack", Kind: inventory.KindConsumable, Stackable: true, MaxStack: 10, Weight: 0.1,
+	}))
+	_, err = sess.Backpack.Add("stim_pack", 2, reg)
+	require.NoError(t, err)
+
+	hStore := &memHousingStore{leases: map[string]*housing.Lease{}}
+	sStore := &memStashStore{balances: map[int64]int{}}
+	s := &GameServiceServer{
+		sessions:     sMgr,
+		world:        wMgr,
+		logger:       zap.NewNop(),
+		invRegistry:  reg,
+		floorMgr:     inventory.NewFloorManager(),
+		housing:      housing.NewRegistry(),
+		housingStore: hStore,
+		stashStore:   sStore,
+	}
+	return s, sess, hStore, sStore
+}
+
+func homeCmd(t *testing.T, s *GameServiceServer, action, target string, qty int) string {
+	t.Helper()
+	evt, err := s.handleHome("uid1", &gamev1.HomeRequest{Action: action, Target: target, Quantity: int32(qty)})
+	require.NoError(t, err)
+	return evt.GetMessage().GetContent()
+}
+
+func TestHandleHome_RentChargesStashAndLocks(t *testing.T) {
+	s, sess, hStore, sStore := newHousingTestServer(t)
+
+	assert.Contains(t, homeCmd(t, s, "", "", 0), "for rent: 40 credits every 24 hours")
+	assert.Contains(t, homeCmd(t, s, "rent", "", 0), "You rent Flat")
+	assert.Equal(t, 60, sess.StashBalance)
+	assert.Equal(t, 60, sStore.balances[7], "the stash charge is persisted")
+	require.Contains(t, hStore.leases, "flat")
+	assert.Contains(t, homeCmd(t, s, "rent", "", 0), "already rent")
+
+	visitor := &session.PlayerSession{CharacterID: 8, Role: "player"}
+	assert.False(t, s.canEnterHome(visitor, "flat"))
+	assert.True(t, s.canEnterHome(visitor, "hall"))
+	assert.True(t, s.canEnterHome(sess, "flat"))
+	assert.True(t, s.canEnterHome(&session.PlayerSession{CharacterID: 9, Role: "admin"}, "flat"),
+		"staff who can teleport are not locked out")
+}
+
+func TestHandleHome_RentRequiresStash(t *testing.T) {
+	s, sess, _, _ := newHousingTestServer(t)
+	sess.StashBalance = 39
+	assert.Contains(t, homeCmd(t, s, "rent", "", 0), "Deposit credits")
+	_, rented := s.housing.Get("flat")
+	assert.False(t, rented)
+}
+
+func TestHandleHome_DescribeShowsInRoomView(t *testing.T) {
+	s, sess, hStore, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	assert.Equal(t, "You update your home's description.", homeCmd(t, s, "describe", "Posters everywhere.", 0))
+	assert.Equal(t, "Posters everywhere.", hStore.leases["flat"].Description)
+
+	wh := NewWorldHandler(s.world, s.sessions, npc.NewManager(), nil, nil, nil)
+	wh.SetHousing(s.housing)
+	flat, _ := s.world.GetRoom("flat")
+	view := wh.buildRoomView(sess.UID, flat)
+	assert.Contains(t, view.Description, "Posters everywhere.")
+	assert.Contains(t, view.Description, "Tenant's home")
+
+	sess.RoomID = "hall"
+	assert.Contains(t, homeCmd(t, s, "describe", "Mine.", 0), "own home")
+}
+
+func TestHandleHome_StoreTakeAndVacate(t *testing.T) {
+	s, sess, hStore, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+
+	assert.Equal(t, "You store 2× Stim Pack.", homeCmd(t, s, "store", "stim pack", 2))
+	assert.Empty(t, sess.Backpack.FindByItemDefID("stim_pack"))
+	assert.Equal(t, map[string]int{"stim_pack": 2}, hStore.leases["flat"].Storage)
+	assert.Contains(t, homeCmd(t, s, "", "", 0), "Storage: 2× Stim Pack.")
+
+	assert.Contains(t, homeCmd(t, s, "vacate", "", 0), "Empty your storage")
+	assert.Contains(t, homeCmd(t, s, "take", "stim_pack", 3), "only have 2")
+	assert.Equal(t, "You take 2× Stim Pack from storage.", homeCmd(t, s, "take", "stim_pack", 2))
+	assert.Len(t, sess.Backpack.FindByItemDefID("stim_pack"), 1)
+
+	assert.Contains(t, homeCmd(t, s, "vacate", "", 0), "hand back the keys")
+	assert.Empty(t, hStore.leases)
+	sess.RoomID = "hall"
+	assert.Equal(t, "You don't rent a room.", homeCmd(t, s, "", "", 0))
+}
+
+// addEngravedTrophy registers a plain trophy item and gives sess one plain
+// trophy and one engraved with affixes.
+func addEngravedTrophy(t *testing.T, s *GameServiceServer, sess *session.PlayerSession) *inventory.ItemMetadata {
+	t.Helper()
+	require.NoError(t, s.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "trophy", Name: "Trophy", Kind: inventory.KindJunk, MaxStack: 1, Weight: 1,
+	}))
+	_, err := sess.Backpack.Add("trophy", 1, s.invRegistry)
+	require.NoError(t, err)
+	meta := &inventory.ItemMetadata{CustomName: "Old Faithful", Affixes: []string{"keen"}, Durability: 7, MaxDurability: 10}
+	_, err = sess.Backpack.AddWithMetadata("trophy", 1, meta, s.invRegistry)
+	require.NoError(t, err)
+	return meta
+}
+
+func TestHandleHome_StoredInstancesKeepTheirState(t *testing.T) {
+	s, sess, hStore, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	meta := addEngravedTrophy(t, s, sess)
+
+	assert.Equal(t, "You store 1× Trophy.", homeCmd(t, s, "store", "trophy", 1), "the plain trophy goes first")
+	assert.Contains(t, homeCmd(t, s, "store", "old faithful", 1), "You store 1× Old Faithful")
+	assert.Empty(t, sess.Backpack.FindByItemDefID("trophy"))
+	assert.Equal(t, map[string]int{"trophy": 1}, hStore.leases["flat"].Storage)
+	require.Len(t, hStore.leases["flat"].Instances, 1)
+	assert.Equal(t, meta, hStore.leases["flat"].Instances[0].Metadata)
+
+	assert.Contains(t, homeCmd(t, s, "take", "old faithful", 1), "You take 1× Old Faithful")
+	got := sess.Backpack.FindByItemDefID("trophy")
+	require.Len(t, got, 1)
+	assert.Equal(t, meta, got[0].Metadata(), "the engraving and affixes survive storage")
+}
+
+func TestEndLease_DropsStoredInstancesIntact(t *testing.T) {
+	s, sess, _, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	meta := addEngravedTrophy(t, s, sess)
+	homeCmd(t, s, "store", "old faithful", 1)
+
+	_, ok := s.endLease("flat")
+	require.True(t, ok)
+	floor := s.floorMgr.ItemsInRoom("flat")
+	require.Len(t, floor, 1)
+	assert.Equal(t, meta, floor[0].Metadata())
+}
+
+func TestTickHousingRent_RenewsFromStash(t *testing.T) {
+	s, sess, hStore, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	lease, _ := s.housing.Get("flat")
+
+	s.tickHousingRent(lease.PaidUntil)
+	renewed, ok := s.housing.Get("flat")
+	require.True(t, ok)
+	assert.Equal(t, lease.PaidUntil.Add(24*time.Hour), renewed.PaidUntil)
+	assert.Equal(t, 20, sess.StashBalance)
+	assert.Equal(t, renewed.PaidUntil, hStore.leases["flat"].PaidUntil)
+}
+
+func TestTickHousingRent_EvictsAfterGracePeriod(t *testing.T) {
+	s, _, hStore, sStore := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	homeCmd(t, s, "store", "stim_pack", 2)
+	// The tenant logs off with an empty stash.
+	require.NoError(t, s.sessions.RemovePlayer("uid1"))
+	sStore.balances[7] = 0
+
+	lease, _ := s.housing.Get("flat")
+	due := lease.PaidUntil
+	s.tickHousingRent(due)
+	pastDue, ok := s.housing.Get("flat")
+	require.True(t, ok, "the tenant gets a grace period")
+	assert.Equal(t, due, pastDue.PastDueSince)
+
+	s.tickHousingRent(due.Add(23 * time.Hour))
+	_, ok = s.housing.Get("flat")
+	require.True(t, ok)
+
+	s.tickHousingRent(due.Add(24 * time.Hour))
+	_, ok = s.housing.Get("flat")
+	assert.False(t, ok, "evicted once a full period passes unpaid")
+	assert.Empty(t, hStore.leases)
+	floor := s.floorMgr.ItemsInRoom("flat")
+	require.Len(t, floor, 1, "stored items are left behind")
+	assert.Equal(t, 2, floor[0].Quantity)
+}
+
+func TestTickHousingRent_OfflineTenantPaysFromStoredStash(t *testing.T) {
+	s, _, _, sStore := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	require.NoError(t, s.sessions.RemovePlayer("uid1"))
+	sStore.balances[7] = 45
+
+	lease, _ := s.housing.Get("flat")
+	s.tickHousingRent(lease.PaidUntil)
+	renewed, _ := s.housing.Get("flat")
+	assert.True(t, renewed.PastDueSince.IsZero())
+	assert.Equal(t, 5, sStore.balances[7])
+}
+
+func TestTickHousingRent_ReleasesDeletedTenants(t *testing.T) {
+	s, _, hStore, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	homeCmd(t, s, "store", "stim_pack", 2)
+	require.NoError(t, s.sessions.RemovePlayer("uid1"))
+	hStore.deleted = map[int64]bool{7: true}
+
+	s.tickHousingRent(time.Now())
+	_, ok := s.housing.Get("flat")
+	assert.False(t, ok, "a deleted character's lease is dropped")
+	_, ok = s.housing.ForCharacter(7)
+	assert.False(t, ok)
+	assert.Empty(t, hStore.leases)
+	require.Len(t, s.floorMgr.ItemsInRoom("flat"), 1, "stored items are left behind")
+}
+
+func TestStashRace_RentTickAndBankerDoNotLoseCredits(t *testing.T) {
+	s, sess, _, _ := newHousingTestServer(t)
+	homeCmd(t, s, "rent", "", 0)
+	sess.StashBalance = 1000
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			s.chargeRent(7, 1)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		sess.CreditStash(2)
+	}
+	<-done
+	assert.Equal(t, 1100, sess.Stash())
+}
+
+func TestInitHousing_LoadsLeases(t *testing.T) {
+	s, sess, hStore, _ := newHousingTestServer(t)
+	hStore.leases["flat"] = &housing.Lease{RoomID: "flat", CharacterID: 8, OwnerName: "Other", PaidUntil: time.Now().Add(time.Hour)}
+	s.InitHousing(context.Background())
+	assert.False(t, s.canEnterHome(sess, "flat"))
+	assert.Contains(t, homeCmd(t, s, "", "", 0), "rented by Other")
+}
+
+// TestProperty_HandleHome_StorageConservesItems verifies that storing and
+// taking never creates or destroys items.
+func TestProperty_HandleHome_StorageConservesItems(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s, sess, _, _ := newHousingTestServer(t)
+		homeCmd(t, s, "rent", "", 0)
+		count := func() int {
+			n := 0
+			for _, inst := range sess.Backpack.FindByItemDefID("stim_pack") {
+				n += inst.Quantity
+			}
+			lease, _ := s.housing.Get("flat")
+			return n + lease.Storage["stim_pack"]
+		}
+		ops := rapid.SliceOfN(rapid.IntRange(-3, 3), 1, 10).Draw(rt, "ops")
+		for _, n := range ops {
+			if n > 0 {
+				homeCmd(t, s, "store", "stim_pack", n)
+			} else if n < 0 {
+				homeCmd(t, s, "take", "stim_pack", -n)
+			}
+			if got := count(); got != 2 {
+				rt.Fatalf("after op %d there are %d stim packs, want 2", n, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package gameserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleJobAbility uses one of the player's job abilities in combat, or lists
+// them when no ability is named.
+//
+// Precondition: uid must identify an active session.
+// Postcondition: On success the ability is queued for the next round
+// resolution and the player is sent a confirmation.
+func (s *GameServiceServer) handleJobAbility(uid string, req *gamev1.JobAbilityRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	abilities := s.jobAbilities(sess)
+	if len(abilities) == 0 {
+		return messageEvent("Your job has no combat abilities."), nil
+	}
+	if req.GetAbility() == "" {
+		return messageEvent(s.jobAbilitiesText(sess, abilities)), nil
+	}
+	ab, target := matchJobAbility(abilities, req.GetAbility(), req.GetTarget())
+	if ab == nil {
+		return messageEvent(fmt.Sprintf("You don't know an ability called %q. Type 'ability' to see yours.", req.GetAbility())), nil
+	}
+	if s.combatH == nil || s.combatH.ActiveCombatForPlayer(uid) == nil {
+		return messageEvent(fmt.Sprintf("You can only use %s in combat.", ab.Name)), nil
+	}
+	if err := s.combatH.QueueJobAbility(uid, ab, target); err != nil {
+		return messageEvent(fmt.Sprintf("You can't use %s: %s.", ab.Name, err)), nil
+	}
+	return nil, nil
+}
+
+// jobAbilities returns the active abilities the player's job inherits from its
+// archetype, or nil when the job or archetype is unknown.
+func (s *GameServiceServer) jobAbilities(sess *session.PlayerSession) []*ruleset.JobAbility {
+	if s.jobRegistry == nil {
+		return nil
+	}
+	job, ok := s.jobRegistry.Job(sess.Class)
+	if !ok {
+		return nil
+	}
+	arch, ok := s.archetypes[job.Archetype]
+	if !ok {
+		return nil
+	}
+	return arch.Abilities
+}
+
+// matchJobAbility finds the ability named by ability and the leading words of
+// target, preferring the longest name, so "second wind goblin" names Second
+// Wind aimed at the goblin. It returns the ability and the words left over.
+func matchJobAbility(abilities []*ruleset.JobAbility, ability, target string) (*ruleset.JobAbility, string) {
+	words := append([]string{ability}, strings.Fields(target)...)
+	for n := len(words); n >= 1; n-- {
+		if ab := findJobAbility(abilities, strings.Join(words[:n], " ")); ab != nil {
+			return ab, strings.Join(words[n:], " ")
+		}
+	}
+	return nil, ""
+}
+
+// findJobAbility returns the ability whose ID or name matches name, ignoring
+// case and treating spaces as underscores.
+func findJobAbility(abilities []*ruleset.JobAbility, name string) *ruleset.JobAbility {
+	id := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+	for _, ab := range abilities {
+		if ab.ID == id || strings.EqualFold(ab.Name, name) {
+			return ab
+		}
+	}
+	return nil
+}
+
+// jobAbilitiesText lists abilities with their AP cost, cooldown, and — in
+// combat — the rounds left before each is ready.
+func (s *GameServiceServer) jobAbilitiesText(sess *session.PlayerSession, abilities []*ruleset.JobAbility) string {
+	var c *combat.Combatant
+	if s.combatH != nil {
+		if cbt := s.combatH.ActiveCombatForPlayer(sess.UID); cbt != nil {
+			c = cbt.GetCombatant(sess.UID)
+		}
+	}
+	var b strings.Builder
+	b.WriteString("Your abilities:\n")
+	for _, ab := range abilities {
+		fmt.Fprintf(&b, "  %s (%d AP, cooldown %d) — %s", ab.Name, ab.APCost, ab.Cooldown, ab.Description)
+		if c != nil {
+			if left := c.Cooldown(ab.Name); left > 0 {
+				fmt.Fprintf(&b, " [ready in %d]", left)
+			}
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("Usage: ability <name> [target]")
+	return b.String()
+}
+
+// resolveJobAbility runs the effects of the job ability abilityID used by uid
+// against target and reports the outcome to the player. Called after round
+// resolution with combatMu held, so it works on cbt directly.
+//
+// Precondition: cbt is the player's active combat.
+// Postcondition: Damage, healing, and conditions from the ability's effects
+// are applied to the combatants they land on.
+func (s *GameServiceServer) resolveJobAbility(uid, abilityID, target string, cbt *combat.Combat) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return
+	}
+	ab := findJobAbility(s.jobAbilities(sess), abilityID)
+	actor := cbt.GetCombatant(uid)
+	if ab == nil || actor == nil || actor.IsDead() {
+		return
+	}
+	var enemy *combat.Combatant
+	for _, c := range cbt.Combatants {
+		if !c.IsDead() && c.Name == target {
+			enemy = c
+			break
+		}
+	}
+
+	var parts []string
+	for _, e := range ab.Effects {
+		on := actor
+		if e.Target == "target" {
+			on = enemy
+		}
+		if on == nil {
+			parts = append(parts, fmt.Sprintf("%s is already down", target))
+			continue
+		}
+		if part := s.applyJobAbilityEffect(sess, e, on, cbt); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	msg := firstOf(ab.ActivateText, fmt.Sprintf("You use %s.", ab.Name))
+	if len(parts) > 0 {
+		msg += " " + strings.Join(parts, "; ") + "."
+	}
+	s.pushMessageToUID(uid, msg)
+}
+
+// applyJobAbilityEffect applies one ability effect to on and describes it.
+//
+// Precondition: on is a living combatant in cbt.
+func (s *GameServiceServer) applyJobAbilityEffect(sess *session.PlayerSession, e ruleset.ActionEffect, on *combat.Combatant, cbt *combat.Combat) string {
+	switch e.Type {
+	case "damage":
+		adjusted := combat.AdjustDamage(cbt, on, e.DamageType, s.rollEffectAmount(e.Amount), "ability")
+		on.ApplyDamage(adjusted.Final)
+		if s.npcMgr != nil {
+			if inst, ok := s.npcMgr.Get(on.ID); ok {
+				inst.CurrentHP = on.CurrentHP
+			}
+		}
+		return fmt.Sprintf("%d %s damage to %s%s", adjusted.Final, e.DamageType, on.Name, techAdjustmentNote(adjusted))
+	case "heal":
+		before := on.CurrentHP
+		on.CurrentHP = min(on.MaxHP, on.CurrentHP+s.rollEffectAmount(e.Amount))
+		if on.ID == sess.UID {
+			sess.CurrentHP = on.CurrentHP
+		}
+		return fmt.Sprintf("%s recovers %d HP", on.Name, on.CurrentHP-before)
+	case "condition":
+		duration := e.Rounds
+		if duration == 0 {
+			duration = -1
+		}
+		if err := cbt.ApplyCondition(on.ID, e.ConditionID, 1, duration); err != nil {
+			return ""
+		}
+		name := e.ConditionID
+		if s.condRegistry != nil {
+			if def, ok := s.condRegistry.Get(e.ConditionID); ok {
+				name = def.Name
+			}
+		}
+		return fmt.Sprintf("%s is %s", on.Name, name)
+	}
+	return ""
+}
+
+// rollEffectAmount rolls amount as a dice expression, or reads it as a flat
+// number. Returns 0 when it is neither.
+func (s *GameServiceServer) rollEffectAmount(amount string) int {
+	if n, err := strconv.Atoi(amount); err == nil {
+		return n
+	}
+	if s.dice == nil {
+		return 0
+	}
+	res, err := s.dice.RollExpr(amount)
+	if err != nil {
+		return 0
+	}
+	return res.Total()
+}
@@ -0,0 +1,102 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newJobAbilityFight starts a fight against a Ganger for a player whose
+// brawler job inherits Second Wind and Haymaker from the aggressor archetype.
+func newJobAbilityFight(t *testing.T) (*GameServiceServer, *session.PlayerSession, *combat.Combat, *npc.Instance) {
+	t.Helper()
+	svc, sess, _, combatHandler, inst := newSurrenderFight(t)
+	jobs := ruleset.NewJobRegistry()
+	jobs.Register(&ruleset.Job{ID: "brawler", Name: "Brawler", Archetype: "aggressor"})
+	svc.jobRegistry = jobs
+	svc.archetypes = map[string]*ruleset.Archetype{"aggressor": {
+		ID: "aggressor",
+		Abilities: []*ruleset.JobAbility{
+			{ID: "second_wind", Name: "Second Wind", Description: "Keep going.", APCost: 1, Cooldown: 2,
+				Effects: []ruleset.ActionEffect{{Type: "heal", Target: "self", Amount: "4"}}},
+			{ID: "haymaker", Name: "Haymaker", Description: "A wild swing.", APCost: 2, Cooldown: 3,
+				Effects: []ruleset.ActionEffect{
+					{Type: "damage", Target: "target", Amount: "5", DamageType: "bludgeoning"},
+					{Type: "condition", Target: "target", ConditionID: "flat_footed", Rounds: 1},
+				}},
+		},
+	}}
+	sess.Class = "brawler"
+	cbt, ok := combatHandler.GetCombatForRoom("room_a")
+	require.True(t, ok)
+	return svc, sess, cbt, inst
+}
+
+func useAbility(t *testing.T, svc *GameServiceServer, uid, ability, target string) string {
+	t.Helper()
+	ev, err := svc.handleJobAbility(uid, &gamev1.JobAbilityRequest{Ability: ability, Target: target})
+	require.NoError(t, err)
+	return ev.GetMessage().GetContent()
+}
+
+func TestHandleJobAbility_ListsAbilities(t *testing.T) {
+	svc, sess, _, _ := newJobAbilityFight(t)
+	out := useAbility(t, svc, sess.UID, "", "")
+	assert.Contains(t, out, "Second Wind (1 AP, cooldown 2) — Keep going.")
+	assert.Contains(t, out, "Haymaker (2 AP, cooldown 3)")
+	assert.Contains(t, out, "Usage: ability <name> [target]")
+	assert.Contains(t, useAbility(t, svc, sess.UID, "fireball", ""), `You don't know an ability called "fireball"`)
+}
+
+func TestHandleJobAbility_QueuesAndStartsCooldown(t *testing.T) {
+	svc, sess, cbt, _ := newJobAbilityFight(t)
+
+	assert.Empty(t, useAbility(t, svc, sess.UID, "second", "wind"), "a multi-word name is rejoined and queued")
+	me := cbt.GetCombatant(sess.UID)
+	assert.Equal(t, 2, me.Cooldown("Second Wind"))
+	queued := cbt.ActionQueues[sess.UID].QueuedActions()
+	last := queued[len(queued)-1]
+	assert.Equal(t, combat.ActionUseAbility, last.Type)
+	assert.Equal(t, "second_wind", last.AbilityID)
+
+	var status *gamev1.CombatantStatus
+	for _, st := range combatStateEvent(cbt).GetCombatants() {
+		if st.GetName() == me.Name {
+			status = st
+		}
+	}
+	require.NotNil(t, status)
+	assert.Equal(t, []string{"Second Wind (2)"}, status.GetCooldowns())
+
+	assert.Contains(t, useAbility(t, svc, sess.UID, "second_wind", ""), "recharging: ready in 2 round(s)")
+}
+
+func TestHandleJobAbility_OutsideCombat(t *testing.T) {
+	svc, sess, _, _ := newJobAbilityFight(t)
+	svc.combatH = nil
+	assert.Equal(t, "You can only use Haymaker in combat.", useAbility(t, svc, sess.UID, "haymaker", ""))
+}
+
+func TestResolveJobAbility_AppliesEffects(t *testing.T) {
+	svc, sess, cbt, inst := newJobAbilityFight(t)
+	ganger := cbt.GetCombatant(inst.ID)
+	hp := ganger.CurrentHP
+
+	svc.resolveJobAbility(sess.UID, "haymaker", "Ganger", cbt)
+	assert.Equal(t, hp-5, ganger.CurrentHP)
+	assert.Equal(t, ganger.CurrentHP, inst.CurrentHP, "the NPC instance tracks the damage")
+	assert.True(t, cbt.Conditions[inst.ID].Has("flat_footed"))
+
+	me := cbt.GetCombatant(sess.UID)
+	me.CurrentHP = me.MaxHP - 2
+	svc.resolveJobAbility(sess.UID, "second_wind", "", cbt)
+	assert.Equal(t, me.MaxHP, me.CurrentHP, "healing stops at max HP")
+	assert.Equal(t, me.MaxHP, sess.CurrentHP)
+}
@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -13,6 +12,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/ruleset"
 	"github.com/cory-johannsen/mud/internal/game/session"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // findJobTrainerInRoom locates a job_trainer NPC by name in roomID.
@@ -90,6 +90,7 @@ func (s *GameServiceServer) handleTrainJob(uid string, req *gamev1.TrainJobReque
 		return messageEvent(fmt.Sprintf("Training costs %d credits but you only have %d.", trainable.TrainingCost, sess.Currency)), nil
 	}
 	sess.Currency -= trainable.TrainingCost
+	s.emitCurrency(sess, -trainable.TrainingCost, telemetry.SourceTraining)
 	if sess.Jobs == nil {
 		sess.Jobs = map[string]int{}
 	}
@@ -126,7 +127,7 @@ func (s *GameServiceServer) handleTrainJob(uid string, req *gamev1.TrainJobReque
 		}
 	}
 	if s.charSaver != nil && sess.CharacterID > 0 {
-		if saveErr := s.charSaver.SaveJobs(context.Background(), sess.CharacterID, sess.Jobs, sess.ActiveJobID); saveErr != nil {
+		if saveErr := s.charSaver.SaveJobs(s.commandCtx(uid), sess.CharacterID, sess.Jobs, sess.ActiveJobID); saveErr != nil {
 			s.logger.Warn("failed to save jobs after training",
 				zap.String("uid", uid),
 				zap.Int64("character_id", sess.CharacterID),
@@ -136,7 +137,7 @@ func (s *GameServiceServer) handleTrainJob(uid string, req *gamev1.TrainJobReque
 	}
 	// Persist the new job to character_jobs table (REQ-JD-4).
 	if s.characterJobsRepo != nil && sess.CharacterID > 0 {
-		if err := s.characterJobsRepo.AddJob(context.Background(), sess.CharacterID, jobID); err != nil {
+		if err := s.characterJobsRepo.AddJob(s.commandCtx(uid), sess.CharacterID, jobID); err != nil {
 			s.logger.Warn("failed to persist job to character_jobs",
 				zap.String("uid", uid),
 				zap.Int64("character_id", sess.CharacterID),
@@ -198,7 +199,7 @@ func (s *GameServiceServer) handleSetJob(uid string, req *gamev1.SetJobRequest)
 	}
 	sess.ActiveJobID = jobID
 	if s.charSaver != nil && sess.CharacterID > 0 {
-		if saveErr := s.charSaver.SaveJobs(context.Background(), sess.CharacterID, sess.Jobs, sess.ActiveJobID); saveErr != nil {
+		if saveErr := s.charSaver.SaveJobs(s.commandCtx(uid), sess.CharacterID, sess.Jobs, sess.ActiveJobID); saveErr != nil {
 			s.logger.Warn("failed to save jobs after set-job",
 				zap.String("uid", uid),
 				zap.Int64("character_id", sess.CharacterID),
@@ -140,6 +140,28 @@ func TestHandleJoin_WithPendingJoin_JoinsCombatAndClearsField(t *testing.T) {
 	require.NotNil(t, resp)
 	assert.Equal(t, "", sess.PendingCombatJoin, "PendingCombatJoin must be cleared after join")
 	assert.Equal(t, statusInCombat, sess.Status, "Status must be statusInCombat after join")
+	assert.Contains(t, resp.GetMessage().GetContent(), "You join the fight")
+}
+
+// handleJoin works without an invitation when the player's room has a fight.
+func TestHandleJoin_WithoutInvitation_JoinsFightInRoom(t *testing.T) {
+	svc, sessMgr, combatHandler := newJoinSvc(t)
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_join_walkin", Username: "Walker", CharName: "Walker",
+		RoomID: "room-2", CurrentHP: 20, MaxHP: 20, Role: "player",
+	})
+	require.NoError(t, err)
+
+	npc1 := &combat.Combatant{ID: "n1", Kind: combat.KindNPC, Name: "Ganger",
+		MaxHP: 10, CurrentHP: 10, AC: 12, Level: 1, Initiative: 8}
+	_, err = combatHandler.engine.StartCombat("room-2", []*combat.Combatant{npc1},
+		makeTestConditionRegistry(), nil, "")
+	require.NoError(t, err)
+
+	resp, err := svc.handleJoin("u_join_walkin", &gamev1.JoinRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, resp.GetMessage().GetContent(), "You join the fight")
+	assert.Equal(t, statusInCombat, sess.Status)
 }
 
 // REQ-T-PROP: handleDecline always clears PendingCombatJoin regardless of the room ID.
@@ -0,0 +1,111 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// litLightSource returns the player's lit light source.
+//
+// Precondition: sess must be non-nil; reg may be nil.
+// Postcondition: ok is true iff the lit item is still in the backpack, is a
+// light source, and has fuel left.
+func litLightSource(sess *session.PlayerSession, reg *inventory.Registry) (inst *inventory.ItemInstance, def *inventory.ItemDef, ok bool) {
+	if sess.LitLightID == "" || sess.Backpack == nil || reg == nil {
+		return nil, nil, false
+	}
+	inst = sess.Backpack.GetByInstanceID(sess.LitLightID)
+	if inst == nil {
+		return nil, nil, false
+	}
+	def, found := reg.Item(inst.ItemDefID)
+	if !found || !def.IsLightSource() || inventory.FuelRemaining(inst, def) == 0 {
+		return nil, nil, false
+	}
+	return inst, def, true
+}
+
+// handleLight lights a light source from the player's backpack, puts out the
+// lit one when the target is "off", or reports the lit one when no target is given.
+// Only one light source burns at a time.
+//
+// Precondition: uid must identify an existing player session; req must be non-nil.
+// Postcondition: sess.LitLightID names the lit item, or is empty after "off".
+func (s *GameServiceServer) handleLight(uid string, req *gamev1.LightRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	target := strings.TrimSpace(req.GetTarget())
+	switch {
+	case target == "":
+		inst, def, lit := litLightSource(sess, s.invRegistry)
+		if !lit {
+			return messageEvent("You have no light lit."), nil
+		}
+		return messageEvent(fmt.Sprintf("Your %s is lit (%s of fuel left).", def.Name, fuelHours(inventory.FuelRemaining(inst, def)))), nil
+	case strings.EqualFold(target, "off"):
+		_, def, lit := litLightSource(sess, s.invRegistry)
+		sess.LitLightID = ""
+		if !lit {
+			return messageEvent("You have no light lit."), nil
+		}
+		return messageEvent(fmt.Sprintf("You put out your %s.", def.Name)), nil
+	}
+
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return messageEvent("You aren't carrying that."), nil
+	}
+	for _, it := range sess.Backpack.Items() {
+		def, ok := s.invRegistry.Item(it.ItemDefID)
+		if !ok || !(strings.EqualFold(def.Name, target) || strings.EqualFold(def.ID, target)) {
+			continue
+		}
+		if !def.IsLightSource() {
+			return messageEvent(fmt.Sprintf("You can't light the %s.", def.Name)), nil
+		}
+		left := inventory.FuelRemaining(&it, def)
+		if left == 0 {
+			return messageEvent(fmt.Sprintf("Your %s is out of fuel.", def.Name)), nil
+		}
+		sess.LitLightID = it.InstanceID
+		return messageEvent(fmt.Sprintf("You light your %s (%s of fuel left).", def.Name, fuelHours(left))), nil
+	}
+	return messageEvent("You aren't carrying that."), nil
+}
+
+// tickLightSource burns one game hour of fuel from the player's lit light
+// source. Called on each calendar tick.
+//
+// Precondition: uid may name a disconnected player.
+// Postcondition: Returns a message when the light burns out and sess.LitLightID
+// is cleared; returns nil otherwise.
+func (s *GameServiceServer) tickLightSource(uid string) *gamev1.ServerEvent {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok || sess.LitLightID == "" {
+		return nil
+	}
+	inst, def, lit := litLightSource(sess, s.invRegistry)
+	if !lit {
+		// Dropped, sold, or already empty: nothing is burning any more.
+		sess.LitLightID = ""
+		return nil
+	}
+	if inventory.BurnFuel(inst, def, 1) > 0 {
+		return nil
+	}
+	sess.LitLightID = ""
+	return messageEvent(fmt.Sprintf("Your %s sputters and goes out.", def.Name))
+}
+
+// fuelHours formats a fuel amount in game hours.
+func fuelHours(h int) string {
+	if h == 1 {
+		return "1 hour"
+	}
+	return fmt.Sprintf("%d hours", h)
+}
@@ -0,0 +1,123 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newLightTestServer builds a server with player "ace" in a dark cellar
+// carrying a flare (2 hours of fuel) and a brick.
+func newLightTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	rooms := map[string]*world.Room{
+		"cellar": {ID: "cellar", ZoneID: "z", Title: "Cellar", Description: "A cellar full of junk.",
+			Properties: map[string]string{"lighting": "dark"}},
+	}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "cellar", Rooms: rooms}})
+	require.NoError(t, err)
+
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "road_flare", Name: "Road Flare", Kind: inventory.KindJunk, MaxStack: 1, LightFuel: 2}))
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "brick", Name: "Brick", Kind: inventory.KindJunk, MaxStack: 1}))
+
+	sMgr := session.NewManager()
+	sess, err := sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ace", Username: "ace", CharName: "Ace", CharacterID: 1,
+		RoomID: "cellar", CurrentHP: 10, MaxHP: 10, Abilities: character.AbilityScores{}, Role: "player",
+	})
+	require.NoError(t, err)
+	_, err = sess.Backpack.Add("road_flare", 1, reg)
+	require.NoError(t, err)
+	_, err = sess.Backpack.Add("brick", 1, reg)
+	require.NoError(t, err)
+
+	npcMgr := npc.NewManager()
+	_, err = npcMgr.Spawn(&npc.Template{ID: "rat", Name: "Cellar Rat", Level: 1, MaxHP: 5, AC: 10}, "cellar")
+	require.NoError(t, err)
+
+	worldH := NewWorldHandler(wMgr, sMgr, npcMgr, nil, nil, reg)
+	return &GameServiceServer{
+		sessions:    sMgr,
+		world:       wMgr,
+		npcMgr:      npcMgr,
+		worldH:      worldH,
+		invRegistry: reg,
+		logger:      zap.NewNop(),
+	}, sess
+}
+
+func TestHandleLight_LightAndPutOut(t *testing.T) {
+	s, sess := newLightTestServer(t)
+
+	evt, err := s.handleLight("ace", &gamev1.LightRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You have no light lit.", evt.GetMessage().GetContent())
+
+	evt, err = s.handleLight("ace", &gamev1.LightRequest{Target: "brick"})
+	require.NoError(t, err)
+	assert.Equal(t, "You can't light the Brick.", evt.GetMessage().GetContent())
+	assert.Empty(t, sess.LitLightID)
+
+	evt, err = s.handleLight("ace", &gamev1.LightRequest{Target: "road flare"})
+	require.NoError(t, err)
+	assert.Equal(t, "You light your Road Flare (2 hours of fuel left).", evt.GetMessage().GetContent())
+	assert.NotEmpty(t, sess.LitLightID)
+
+	evt, err = s.handleLight("ace", &gamev1.LightRequest{Target: "off"})
+	require.NoError(t, err)
+	assert.Equal(t, "You put out your Road Flare.", evt.GetMessage().GetContent())
+	assert.Empty(t, sess.LitLightID)
+
+	evt, err = s.handleLight("ace", &gamev1.LightRequest{Target: "lantern"})
+	require.NoError(t, err)
+	assert.Equal(t, "You aren't carrying that.", evt.GetMessage().GetContent())
+}
+
+func TestTickLightSource_BurnsOutAfterFuelIsSpent(t *testing.T) {
+	s, sess := newLightTestServer(t)
+	_, err := s.handleLight("ace", &gamev1.LightRequest{Target: "road_flare"})
+	require.NoError(t, err)
+
+	assert.Nil(t, s.tickLightSource("ace"))
+	evt := s.tickLightSource("ace")
+	require.NotNil(t, evt)
+	assert.Equal(t, "Your Road Flare sputters and goes out.", evt.GetMessage().GetContent())
+	assert.Empty(t, sess.LitLightID)
+
+	evt, err = s.handleLight("ace", &gamev1.LightRequest{Target: "road_flare"})
+	require.NoError(t, err)
+	assert.Equal(t, "Your Road Flare is out of fuel.", evt.GetMessage().GetContent())
+}
+
+func TestHandleLook_DarkRoomHidesDetailsUntilLit(t *testing.T) {
+	s, _ := newLightTestServer(t)
+
+	evt, err := s.handleLook("ace")
+	require.NoError(t, err)
+	rv := evt.GetRoomView()
+	assert.True(t, rv.Dark)
+	assert.NotContains(t, rv.Description, "junk")
+	require.Len(t, rv.Npcs, 1)
+	assert.Equal(t, "a shadowy figure", rv.Npcs[0].Name)
+	assert.Empty(t, rv.Npcs[0].InstanceId)
+
+	_, err = s.handleLight("ace", &gamev1.LightRequest{Target: "road_flare"})
+	require.NoError(t, err)
+	evt, err = s.handleLook("ace")
+	require.NoError(t, err)
+	rv = evt.GetRoomView()
+	assert.False(t, rv.Dark)
+	assert.Equal(t, "A cellar full of junk.", rv.Description)
+	require.Len(t, rv.Npcs, 1)
+	assert.Equal(t, "Cellar Rat", rv.Npcs[0].Name)
+}
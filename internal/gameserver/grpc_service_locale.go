@@ -0,0 +1,90 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/i18n"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// AccountLocaleStore loads and persists an account's preferred message locale.
+//
+// Precondition: accountID must be > 0.
+type AccountLocaleStore interface {
+	GetAccountLocale(ctx context.Context, accountID int64) (string, error)
+	SetAccountLocale(ctx context.Context, accountID int64, locale string) error
+}
+
+// SetCatalog replaces the message catalog used to render player-facing narratives.
+//
+// Precondition: c must be non-nil.
+// Postcondition: Subsequent messages are rendered from c.
+func (s *GameServiceServer) SetCatalog(c *i18n.Catalog) {
+	s.catalog = c
+}
+
+// t renders message id in sess's locale.
+//
+// Precondition: id must be a catalog message ID; sess may be nil (default locale).
+// Postcondition: Returns the rendered text; see i18n.Catalog.T for fallbacks.
+func (s *GameServiceServer) t(sess *session.PlayerSession, id string, args i18n.Args) string {
+	locale := i18n.DefaultLocale
+	if sess != nil && sess.Locale != "" {
+		locale = sess.Locale
+	}
+	return s.catalog.T(locale, id, args)
+}
+
+// loadAccountLocale returns the stored locale for accountID, or "" when it
+// cannot be determined.
+//
+// Postcondition: Lookup failures are logged and never returned.
+func (s *GameServiceServer) loadAccountLocale(ctx context.Context, accountID int64) string {
+	if s.accountLocales == nil || accountID <= 0 {
+		return ""
+	}
+	locale, err := s.accountLocales.GetAccountLocale(ctx, accountID)
+	if err != nil {
+		s.logger.Warn("loading account locale", zap.Int64("account_id", accountID), zap.Error(err))
+		return ""
+	}
+	return locale
+}
+
+// handleLocale reports or updates the account's message locale.
+//
+// Precondition: uid must identify an active session.
+// Postcondition: When locale is empty the current setting is reported; when the
+// catalog has no messages for locale an error message is returned; otherwise
+// sess.Locale is updated and persisted (when a store is configured).
+func (s *GameServiceServer) handleLocale(uid, locale string) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	catalog := s.catalog
+	if catalog == nil {
+		catalog = i18n.Default()
+	}
+	available := strings.Join(catalog.Locales(), ", ")
+	if locale == "" {
+		return messageEvent(s.t(sess, "locale.current", i18n.Args{"locale": sess.Locale, "available": available})), nil
+	}
+	locale = i18n.NormalizeLocale(locale)
+	if !catalog.HasLocale(locale) {
+		return messageEvent(s.t(sess, "locale.unknown", i18n.Args{"locale": locale, "available": available})), nil
+	}
+	if s.accountLocales != nil && sess.AccountID > 0 {
+		if err := s.accountLocales.SetAccountLocale(s.commandCtx(uid), sess.AccountID, locale); err != nil {
+			s.logger.Warn("handleLocale: SetAccountLocale failed", zap.Error(err))
+			return messageEvent(s.t(sess, "locale.save_failed", nil)), nil
+		}
+	}
+	sess.Locale = locale
+	return messageEvent(s.t(sess, "locale.set", i18n.Args{"locale": locale})), nil
+}
@@ -0,0 +1,104 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/i18n"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// mockAccountLocaleStore is an AccountLocaleStore test double.
+type mockAccountLocaleStore struct {
+	locale string
+	setErr error
+	sets   int
+}
+
+func (m *mockAccountLocaleStore) GetAccountLocale(_ context.Context, _ int64) (string, error) {
+	return m.locale, nil
+}
+
+func (m *mockAccountLocaleStore) SetAccountLocale(_ context.Context, _ int64, locale string) error {
+	m.sets++
+	if m.setErr != nil {
+		return m.setErr
+	}
+	m.locale = locale
+	return nil
+}
+
+// testLocaleCatalog returns a catalog with a small "xx" translation.
+func testLocaleCatalog(t *testing.T) *i18n.Catalog {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "xx.yaml"), []byte(`move.in_combat: "xx-in-combat"
+locale.set: "xx-set {{.locale}}"
+`), 0o644))
+	c, err := i18n.LoadCatalogFromDir(dir)
+	require.NoError(t, err)
+	return c
+}
+
+func TestHandleLocale_QueryReportsDefault(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "u_q", 1)
+
+	evt, err := svc.handleLocale("u_q", "")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Language: en")
+}
+
+func TestHandleLocale_UnknownLocaleRejected(t *testing.T) {
+	store := &mockAccountLocaleStore{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.accountLocales = store
+	sess := addPlayerForCombatDefault(t, svc, "u_unknown", 1)
+	sess.AccountID = 5
+
+	evt, err := svc.handleLocale("u_unknown", "zz")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Unknown language")
+	assert.Equal(t, 0, store.sets)
+	assert.Equal(t, i18n.DefaultLocale, sess.Locale)
+}
+
+func TestHandleLocale_PersistsAndRendersInNewLocale(t *testing.T) {
+	store := &mockAccountLocaleStore{}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.SetCatalog(testLocaleCatalog(t))
+	svc.accountLocales = store
+	sess := addPlayerForCombatDefault(t, svc, "u_set", 1)
+	sess.AccountID = 5
+
+	evt, err := svc.handleLocale("u_set", "XX")
+	require.NoError(t, err)
+	assert.Equal(t, "xx-set xx", evt.GetMessage().GetContent())
+	assert.Equal(t, "xx", store.locale)
+	assert.Equal(t, "xx", sess.Locale)
+
+	sess.Status = statusInCombat
+	moveEvt, err := svc.handleMove("u_set", &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Equal(t, "xx-in-combat", moveEvt.GetMessage().GetContent())
+}
+
+func TestHandleLocale_PersistenceFailureKeepsLocale(t *testing.T) {
+	store := &mockAccountLocaleStore{setErr: errors.New("db down")}
+	svc := testServiceForCombatDefault(t, nil)
+	svc.SetCatalog(testLocaleCatalog(t))
+	svc.accountLocales = store
+	sess := addPlayerForCombatDefault(t, svc, "u_fail", 1)
+	sess.AccountID = 5
+
+	evt, err := svc.handleLocale("u_fail", "xx")
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Failed")
+	assert.Equal(t, i18n.DefaultLocale, sess.Locale)
+}
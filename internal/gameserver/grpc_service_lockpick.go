@@ -0,0 +1,252 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/lockpick"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// lockpickAction is the delayed-action label for a lockpicking stage.
+const lockpickAction = "lockpick"
+
+// equipmentLabel returns the name players see for a piece of room equipment.
+func (s *GameServiceServer) equipmentLabel(inst *inventory.EquipmentInstance) string {
+	if inst.Description != "" {
+		return inst.Description
+	}
+	return s.itemDisplayName(inst.ItemDefID)
+}
+
+// findLockpick returns the first unbroken lockpick in the player's backpack.
+//
+// Postcondition: Returns nil when the player carries none.
+func (s *GameServiceServer) findLockpick(sess *session.PlayerSession) *inventory.ItemInstance {
+	if s.invRegistry == nil || sess.Backpack == nil {
+		return nil
+	}
+	for _, it := range sess.Backpack.Items() {
+		def, ok := s.invRegistry.Item(it.ItemDefID)
+		if !ok || !def.HasTag(lockpick.ToolTag) {
+			continue
+		}
+		if it.MaxDurability > 0 && it.Durability <= 0 {
+			continue
+		}
+		return sess.Backpack.MutableItem(it.InstanceID)
+	}
+	return nil
+}
+
+// handlePick dispatches the pick command.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handlePick(uid string, req *gamev1.PickRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	switch req.GetAction() {
+	case "start":
+		return s.startLockpick(uid, sess, req.GetTarget()), nil
+	case "stop":
+		if sess.Lockpick == nil {
+			return messageEvent("You aren't working on a lock."), nil
+		}
+		s.stopLockpick(uid, sess)
+		return messageEvent("You ease your pick out of the lock."), nil
+	case "":
+		return errorEvent("usage: pick <direction|item> | pick tension | pick rake | pick stop"), nil
+	}
+	if tech, ok := lockpick.ParseTechnique(req.GetAction()); ok {
+		return s.workLockpick(uid, sess, tech), nil
+	}
+	a := sess.Lockpick
+	if a == nil {
+		return messageEvent("You aren't working on a lock."), nil
+	}
+	if a.Working != "" {
+		return messageEvent(fmt.Sprintf("You're working a pin in %s with a %s.", a.Label, a.Working)), nil
+	}
+	return messageEvent(fmt.Sprintf("You're working on %s: %d of %d pins set. %s", a.Label, a.Set, len(a.Stiff), a.Feel())), nil
+}
+
+// startLockpick begins work on the locked exit or room item named target.
+//
+// Precondition: sess must be non-nil and belong to uid.
+// Postcondition: On success sess.Lockpick is a new attempt with no stage in progress.
+func (s *GameServiceServer) startLockpick(uid string, sess *session.PlayerSession, target string) *gamev1.ServerEvent {
+	if sess.Status == statusInCombat {
+		return errorEvent("You can't pick a lock in the middle of a fight.")
+	}
+	if sess.Lockpick != nil {
+		return messageEvent(fmt.Sprintf("You're already working on %s. Use pick stop to give up.", sess.Lockpick.Label))
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return errorEvent("You can't pick anything here.")
+	}
+
+	var key, label, equipItemID string
+	var dc int
+	if exit, found := room.ExitForDirection(world.Direction(strings.ToLower(target))); found && !exit.Hidden {
+		if !exit.Locked {
+			return messageEvent(fmt.Sprintf("The way %s isn't locked.", exit.Direction))
+		}
+		if exit.LockDC <= 0 {
+			return messageEvent(fmt.Sprintf("The lock on the way %s is beyond any pick. You'll need a key.", exit.Direction))
+		}
+		key, label, dc = "exit:"+string(exit.Direction), fmt.Sprintf("the lock on the way %s", exit.Direction), exit.LockDC
+	} else {
+		var inst *inventory.EquipmentInstance
+		if s.roomEquipMgr != nil {
+			inst = s.roomEquipMgr.GetInstance(sess.RoomID, target)
+		}
+		if inst == nil {
+			return messageEvent(fmt.Sprintf("You don't see a lock on %q here.", target))
+		}
+		if inst.LockDC <= 0 {
+			return messageEvent(fmt.Sprintf("The %s isn't locked.", s.equipmentLabel(inst)))
+		}
+		key, label, dc = "equip:"+inst.InstanceID, "the "+s.equipmentLabel(inst), inst.LockDC
+		equipItemID = inst.ItemDefID
+	}
+
+	tool := s.findLockpick(sess)
+	if tool == nil {
+		return messageEvent("You need a lockpick for that.")
+	}
+	// Working a trapped lock sets the trap off.
+	if dir, isExit := strings.CutPrefix(key, "exit:"); isExit {
+		s.checkExitTrap(uid, sess, world.Direction(dir))
+	} else {
+		s.checkInteractionTrap(uid, sess, room, equipItemID)
+	}
+	if sess.Dead {
+		return messageEvent("You never get the chance.")
+	}
+	if tool.MaxDurability <= 0 {
+		tool.MaxDurability = lockpick.PickDurability
+		tool.Durability = lockpick.PickDurability
+	}
+	intn := rand.Intn
+	if s.dice != nil {
+		intn = s.dice.Src().Intn
+	}
+	sess.Lockpick = lockpick.NewAttempt(key, label, sess.RoomID, tool.InstanceID, dc, intn)
+	return messageEvent(fmt.Sprintf(
+		"You set your %s to %s. It has %d pins. %s Work each pin with pick tension (slow and steady) or pick rake (fast, but clumsy on stiff pins).",
+		s.itemDisplayName(tool.ItemDefID), label, len(sess.Lockpick.Stiff), sess.Lockpick.Feel()))
+}
+
+// workLockpick starts a stage on the current pin. The stage resolves after
+// the technique's delay through the delayed-action scheduler.
+//
+// Precondition: sess must be non-nil and belong to uid.
+// Postcondition: On success sess.Lockpick.Working is tech and a stage is scheduled.
+func (s *GameServiceServer) workLockpick(uid string, sess *session.PlayerSession, tech lockpick.Technique) *gamev1.ServerEvent {
+	a := sess.Lockpick
+	if a == nil {
+		return messageEvent("You aren't working on a lock. Use pick <direction|item> to start.")
+	}
+	if a.Working != "" {
+		return messageEvent("Easy — you're still working the last pin.")
+	}
+	if sess.Status == statusInCombat {
+		return errorEvent("You can't pick a lock in the middle of a fight.")
+	}
+	a.Working = tech
+	s.delayed.Schedule(uid, lockpickAction, tech.Delay(), func() {
+		s.resolveLockpickStage(uid, a, tech)
+	})
+	if tech == lockpick.Rake {
+		return messageEvent("You scrub the rake back and forth across the pins...")
+	}
+	return messageEvent("You lean on the tension wrench and feel for the pin...")
+}
+
+// stopLockpick abandons the player's lock in progress.
+//
+// Postcondition: sess.Lockpick is nil and any pending stage is cancelled.
+func (s *GameServiceServer) stopLockpick(uid string, sess *session.PlayerSession) {
+	sess.Lockpick = nil
+	if label, ok := s.delayed.Pending(uid); ok && label == lockpickAction {
+		s.delayed.Cancel(uid)
+	}
+}
+
+// resolveLockpickStage rolls a finished stage: d20 + grift rank bonus +
+// Quickness modifier + the technique's modifier for the current pin, against
+// the lock's DC. The pick wears with every stage and snaps when worn out. When
+// the last pin sets, the lock opens.
+//
+// Precondition: a is the attempt the stage was scheduled for.
+// Postcondition: The attempt is dropped if the player left, entered combat,
+// lost their pick, or opened the lock; otherwise it awaits the next stage.
+func (s *GameServiceServer) resolveLockpickStage(uid string, a *lockpick.Attempt, tech lockpick.Technique) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok || sess.Lockpick != a {
+		return
+	}
+	if sess.RoomID != a.RoomID || sess.Status == statusInCombat {
+		sess.Lockpick = nil
+		s.pushMessageToUID(uid, "You lose your place in the lock.")
+		return
+	}
+	tool := sess.Backpack.MutableItem(a.ToolID)
+	if tool == nil {
+		sess.Lockpick = nil
+		s.pushMessageToUID(uid, "Your lockpick is gone.")
+		return
+	}
+
+	d20 := s.rollD20()
+	bonus := skillRankBonus(sess.Skills["grift"]) + combat.AbilityMod(sess.Abilities.Quickness) + a.Modifier(tech)
+	total := d20 + bonus
+	sess.LastCheckRoll = d20
+	sess.LastCheckDC = a.DC
+	sess.LastCheckName = "pick lock"
+	res := a.Resolve(tech, total)
+	msg := fmt.Sprintf("%s (rolled %d%+d=%d vs DC %d)", res.Message, d20, bonus, total, a.DC)
+
+	tool.Durability -= res.Wear
+	if tool.Durability <= 0 {
+		name := s.itemDisplayName(tool.ItemDefID)
+		if err := sess.Backpack.Remove(tool.InstanceID, 1); err == nil && s.charSaver != nil && sess.CharacterID > 0 {
+			if err := s.charSaver.SaveInventory(context.Background(), sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
+				s.logger.Warn("lockpick: SaveInventory failed", zap.String("uid", uid), zap.Error(err))
+			}
+		}
+		msg += fmt.Sprintf(" Your %s snaps.", name)
+		if !a.Open() {
+			sess.Lockpick = nil
+			s.pushMessageToUID(uid, msg)
+			return
+		}
+	}
+
+	if !a.Open() {
+		s.pushMessageToUID(uid, msg+" Pick tension or rake to continue.")
+		return
+	}
+	sess.Lockpick = nil
+	if dir, isExit := strings.CutPrefix(a.Target, "exit:"); isExit {
+		s.world.UnlockExit(a.RoomID, dir)
+	} else if id, isEquip := strings.CutPrefix(a.Target, "equip:"); isEquip && s.roomEquipMgr != nil {
+		s.roomEquipMgr.Unlock(a.RoomID, id)
+	}
+	s.pushMessageToUID(uid, msg)
+	s.broadcastMessage(a.RoomID, uid, &gamev1.MessageEvent{
+		Content: fmt.Sprintf("%s picks %s.", sess.CharName, a.Label),
+	})
+}
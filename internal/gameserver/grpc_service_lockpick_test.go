@@ -0,0 +1,181 @@
+package gameserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/lockpick"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newLockpickTestServer builds a server whose room_a has a DC 10 lock on its
+// north exit and a locked cashbox, with a trained picker carrying a lockpick.
+// Stages resolve only when the returned timers fire. With no dice configured
+// every d20 is a 10, so tension always sets a pin.
+func newLockpickTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *manualTimers) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	room, ok := worldMgr.GetRoom("room_a")
+	require.True(t, ok)
+	room.Exits[0].Locked = true
+	room.Exits[0].LockDC = 10
+
+	invReg := inventory.NewRegistry()
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{
+		ID: "lockpick", Name: "Lockpick", Kind: "junk", MaxStack: 1, Tags: []string{lockpick.ToolTag},
+	}))
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{ID: "cashbox", Name: "Cashbox", Kind: "junk", MaxStack: 1}))
+	svc.invRegistry = invReg
+	svc.roomEquipMgr = inventory.NewRoomEquipmentManager()
+	svc.roomEquipMgr.InitRoom("room_a", []world.RoomEquipmentConfig{
+		{ItemID: "cashbox", Description: "Cashbox", MaxCount: 1, Immovable: true, LockDC: 10},
+	})
+
+	var timers *manualTimers
+	svc.delayed, timers = newManualDelayedActions()
+
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "picker_u1", Username: "picker", CharName: "Nix", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player", Abilities: character.AbilityScores{Quickness: 10},
+	})
+	require.NoError(t, err)
+	sess.Skills = map[string]string{"grift": "trained"}
+	_, err = sess.Backpack.Add("lockpick", 1, invReg)
+	require.NoError(t, err)
+	return svc, sess, timers
+}
+
+func pickMsg(t *testing.T, svc *GameServiceServer, uid, action, target string) string {
+	t.Helper()
+	evt, err := svc.handlePick(uid, &gamev1.PickRequest{Action: action, Target: target})
+	require.NoError(t, err)
+	if m := evt.GetMessage(); m != nil {
+		return m.Content
+	}
+	return evt.GetError().GetMessage()
+}
+
+func pushedText(t *testing.T, sess *session.PlayerSession) string {
+	t.Helper()
+	return strings.Join(drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond), "\n")
+}
+
+func TestHandlePick_OpensLockedExitOverStages(t *testing.T) {
+	svc, sess, timers := newLockpickTestServer(t)
+
+	msg := pickMsg(t, svc, sess.UID, "start", "north")
+	assert.Contains(t, msg, "the lock on the way north")
+	assert.Contains(t, msg, "It has 2 pins.")
+	require.NotNil(t, sess.Lockpick)
+
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "tension", ""), "tension wrench")
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "rake", ""), "still working the last pin")
+	_, pending := svc.delayed.Pending(sess.UID)
+	assert.True(t, pending, "the stage waits on the scheduler")
+	assert.Zero(t, sess.Lockpick.Set, "nothing resolves until the delay passes")
+
+	timers.fire()
+	assert.Contains(t, pushedText(t, sess), "A pin clicks into place. (1/2 set)")
+
+	pickMsg(t, svc, sess.UID, "tension", "")
+	timers.fire()
+	assert.Contains(t, pushedText(t, sess), "The lock turns.")
+	assert.Nil(t, sess.Lockpick)
+
+	room, _ := svc.world.GetRoom("room_a")
+	assert.False(t, room.Exits[0].Locked)
+	tool := sess.Backpack.FindByItemDefID("lockpick")
+	require.Len(t, tool, 1)
+	assert.Equal(t, lockpick.PickDurability-2, tool[0].Durability, "each stage wears the pick")
+}
+
+func TestHandlePick_UnlocksContainer(t *testing.T) {
+	svc, sess, timers := newLockpickTestServer(t)
+	evt, err := svc.handleUseEquipment(sess.UID, "Cashbox")
+	require.NoError(t, err)
+	assert.Equal(t, "The Cashbox is locked.", evt.GetMessage().Content)
+
+	pickMsg(t, svc, sess.UID, "start", "cashbox")
+	require.NotNil(t, sess.Lockpick)
+	sess.Lockpick.Stiff = []bool{false, false}
+	pickMsg(t, svc, sess.UID, "rake", "")
+	timers.fire()
+	assert.Contains(t, pushedText(t, sess), "2 pins click into place. The lock turns.")
+
+	evt, err = svc.handleUseEquipment(sess.UID, "Cashbox")
+	require.NoError(t, err)
+	assert.Equal(t, "Nothing happens.", evt.GetMessage().Content)
+}
+
+func TestHandlePick_Refusals(t *testing.T) {
+	svc, sess, _ := newLockpickTestServer(t)
+
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "tension", ""), "aren't working on a lock")
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "start", "east"), `don't see a lock on "east"`)
+
+	room, _ := svc.world.GetRoom("room_a")
+	room.Exits[0].LockDC = 0
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "start", "north"), "You'll need a key")
+	room.Exits[0].Locked = false
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "start", "north"), "isn't locked")
+
+	for _, it := range sess.Backpack.Items() {
+		require.NoError(t, sess.Backpack.Remove(it.InstanceID, it.Quantity))
+	}
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "start", "cashbox"), "You need a lockpick")
+	assert.Nil(t, sess.Lockpick)
+}
+
+func TestHandlePick_WornOutPickSnaps(t *testing.T) {
+	svc, sess, timers := newLockpickTestServer(t)
+	pickMsg(t, svc, sess.UID, "start", "north")
+	tool := sess.Backpack.MutableItem(sess.Lockpick.ToolID)
+	tool.Durability = 1
+
+	pickMsg(t, svc, sess.UID, "tension", "")
+	timers.fire()
+	assert.Contains(t, pushedText(t, sess), "Your Lockpick snaps.")
+	assert.Nil(t, sess.Lockpick)
+	assert.Empty(t, sess.Backpack.FindByItemDefID("lockpick"))
+	room, _ := svc.world.GetRoom("room_a")
+	assert.True(t, room.Exits[0].Locked)
+}
+
+func TestHandlePick_StopAndLeavingAbandonTheLock(t *testing.T) {
+	svc, sess, timers := newLockpickTestServer(t)
+	pickMsg(t, svc, sess.UID, "start", "north")
+	pickMsg(t, svc, sess.UID, "tension", "")
+
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "stop", ""), "ease your pick out")
+	assert.Nil(t, sess.Lockpick)
+	_, pending := svc.delayed.Pending(sess.UID)
+	assert.False(t, pending)
+	timers.fire()
+	assert.Equal(t, lockpick.PickDurability, sess.Backpack.FindByItemDefID("lockpick")[0].Durability)
+
+	pickMsg(t, svc, sess.UID, "start", "north")
+	pickMsg(t, svc, sess.UID, "tension", "")
+	sess.RoomID = "room_b"
+	timers.fire()
+	assert.Contains(t, pushedText(t, sess), "You lose your place in the lock.")
+	assert.Nil(t, sess.Lockpick)
+}
+
+func TestHandlePick_Status(t *testing.T) {
+	svc, sess, _ := newLockpickTestServer(t)
+	pickMsg(t, svc, sess.UID, "start", "north")
+	sess.Lockpick.Stiff = []bool{true, false}
+
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "status", ""), "0 of 2 pins set. The next pin feels stiff.")
+	assert.Contains(t, pickMsg(t, svc, sess.UID, "start", "cashbox"), "already working on the lock on the way north")
+}
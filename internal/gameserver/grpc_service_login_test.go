@@ -366,7 +366,7 @@ base:
 		commands:    command.DefaultRegistry(),
 	}
 
-	err = svc.grantStartingInventory(context.Background(), sess, 99, "test_arch", "", nil)
+	err = svc.grantStartingInventory(context.Background(), sess, 99, "test_arch", "", nil, nil)
 	require.NoError(t, err)
 
 	assert.Equal(t, int32(1), saver.saveInventoryCalled.Load(), "SaveInventory must be called once")
@@ -374,6 +374,57 @@ base:
 	assert.Equal(t, int32(1), saver.saveWeaponPresetsCalledGrant.Load(), "SaveWeaponPresets must be called once during starting grant")
 }
 
+// TestGrantStartingInventory_AddsBackgroundItems verifies that items earned from the
+// background questionnaire are added alongside the starting kit.
+func TestGrantStartingInventory_AddsBackgroundItems(t *testing.T) {
+	loadoutsDir := t.TempDir()
+	loadoutYAML := `archetype: test_arch
+base:
+  weapon: ""
+  armor: {}
+  consumables: []
+  currency: 0
+`
+	require.NoError(t, os.WriteFile(filepath.Join(loadoutsDir, "test_arch.yaml"), []byte(loadoutYAML), 0600))
+
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{
+		ID: "scrap_bandage", Name: "Scrap Bandage", Kind: inventory.KindJunk,
+		MaxStack: 10, Stackable: true,
+	}))
+
+	mgr := session.NewManager()
+	sess, err := mgr.AddPlayer(session.AddPlayerOptions{
+		UID:         "u-bg",
+		Username:    "bguser",
+		CharName:    "Backstory",
+		CharacterID: 98,
+		RoomID:      "room1",
+		CurrentHP:   10,
+		MaxHP:       10,
+		Role:        "player",
+		Level:       1,
+	})
+	require.NoError(t, err)
+
+	svc := &GameServiceServer{
+		charSaver:   &mockCharSaverGrantTracking{},
+		invRegistry: reg,
+		loadoutsDir: loadoutsDir,
+		logger:      zaptest.NewLogger(t),
+		sessions:    mgr,
+		commands:    command.DefaultRegistry(),
+	}
+
+	items := []ruleset.BackgroundItem{{ItemID: "scrap_bandage", Quantity: 2}, {ItemID: "missing_item", Quantity: 1}}
+	require.NoError(t, svc.grantStartingInventory(context.Background(), sess, 98, "test_arch", "", nil, items))
+
+	found := sess.Backpack.FindByItemDefID("scrap_bandage")
+	require.Len(t, found, 1)
+	assert.Equal(t, 2, found[0].Quantity)
+	assert.Empty(t, sess.Backpack.FindByItemDefID("missing_item"), "unknown items are skipped")
+}
+
 // mockCharSaverWithFP embeds mockCharSaverFull and overrides LoadFocusPoints to
 // return a configurable stored value.
 type mockCharSaverWithFP struct {
@@ -0,0 +1,116 @@
+package gameserver
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// marketRegion returns the market region for roomID: the room's zone ID, or ""
+// when the room is unknown.
+func (s *GameServiceServer) marketRegion(roomID string) string {
+	if room, ok := s.world.GetRoom(roomID); ok {
+		return room.ZoneID
+	}
+	return ""
+}
+
+// regionalItem returns item with its base price scaled by the item's price
+// index in region, so the existing margin, stock and negotiate pricing applies
+// on top of regional supply and demand.
+//
+// Postcondition: The returned BasePrice is >= 1.
+func (s *GameServiceServer) regionalItem(item npc.MerchantItem, region string) npc.MerchantItem {
+	if s.market == nil {
+		return item
+	}
+	price := int(math.Round(float64(item.BasePrice) * s.market.Index(region, item.ItemID)))
+	if price < 1 {
+		price = 1
+	}
+	item.BasePrice = price
+	return item
+}
+
+// regionalMerchantConfig returns a copy of cfg whose inventory is priced for
+// region by regionalItem.
+//
+// Precondition: cfg must be non-nil.
+// Postcondition: Returns cfg itself when no market is configured; otherwise a
+// copy whose Inventory slice is owned by the caller and index-aligned with cfg's.
+func (s *GameServiceServer) regionalMerchantConfig(cfg *npc.MerchantConfig, region string) *npc.MerchantConfig {
+	if s.market == nil {
+		return cfg
+	}
+	out := *cfg
+	out.Inventory = make([]npc.MerchantItem, len(cfg.Inventory))
+	for i, item := range cfg.Inventory {
+		out.Inventory[i] = s.regionalItem(item, region)
+	}
+	return &out
+}
+
+// recordTrade notes qty of itemID changing hands with a vendor in region.
+// bought is true when the player bought from the vendor.
+func (s *GameServiceServer) recordTrade(region, itemID string, qty int, bought bool) {
+	if s.market == nil || region == "" {
+		return
+	}
+	if bought {
+		s.market.RecordBuy(region, itemID, qty)
+		return
+	}
+	s.market.RecordSell(region, itemID, qty)
+}
+
+// tickMarket settles the day's trade into the regional price indices.
+func (s *GameServiceServer) tickMarket() {
+	if s.market != nil {
+		s.market.Settle()
+	}
+}
+
+// handleMarket lists the goods trading above or below their usual price in the
+// player's zone, with today's trade volume.
+//
+// Precondition: uid must identify an active session.
+// Postcondition: Returns a non-nil message event.
+func (s *GameServiceServer) handleMarket(uid string, _ *gamev1.MarketRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return messageEvent("current room not found"), nil
+	}
+	zoneName := room.ZoneID
+	if zone, ok := s.world.GetZone(room.ZoneID); ok {
+		zoneName = zone.Name
+	}
+	if s.market == nil {
+		return messageEvent(fmt.Sprintf("Prices in %s are steady.", zoneName)), nil
+	}
+	quotes := s.market.Quotes(room.ZoneID)
+	if len(quotes) == 0 {
+		return messageEvent(fmt.Sprintf("Prices in %s are steady. Nothing is trading away from its usual price.", zoneName)), nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Market prices in %s:", zoneName)
+	for _, q := range quotes {
+		name := q.ItemID
+		if s.invRegistry != nil {
+			if def, ok := s.invRegistry.Item(q.ItemID); ok {
+				name = def.Name
+			}
+		}
+		fmt.Fprintf(&b, "\n  %-24s %+5.1f%%", name, (q.Index-1.0)*100)
+		if q.Bought > 0 || q.Sold > 0 {
+			fmt.Fprintf(&b, "  (today: %d bought, %d sold)", q.Bought, q.Sold)
+		}
+	}
+	return messageEvent(b.String()), nil
+}
@@ -0,0 +1,86 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestHandleBuy_RecordsRegionalTrade(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+
+	_, err := svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 2})
+	require.NoError(t, err)
+
+	quotes := svc.market.Quotes("test")
+	require.Len(t, quotes, 1)
+	assert.Equal(t, "stim_pack", quotes[0].ItemID)
+	assert.Equal(t, 2, quotes[0].Bought)
+}
+
+func TestHandleBuy_PriceFollowsRegionalIndex(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	svc.market.RecordBuy("test", "stim_pack", 20)
+	svc.tickMarket()
+
+	sess, _ := svc.sessions.GetPlayer(uid)
+	evt, err := svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "for 53 credits")
+	assert.Equal(t, 447, sess.Currency)
+
+	shop, err := svc.buildShopView(uid, inst.Name())
+	require.NoError(t, err)
+	require.Len(t, shop.GetShopView().Items, 1)
+	assert.Greater(t, shop.GetShopView().Items[0].BuyPrice, int32(50))
+}
+
+func TestHandleSell_PayoutFollowsRegionalIndex(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	_, err := sess.Backpack.Add("stim_pack", 1, svc.invRegistry)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		svc.market.RecordSell("test", "stim_pack", 20)
+		svc.tickMarket()
+	}
+	require.InDelta(t, 0.5, svc.market.Index("test", "stim_pack"), 1e-9)
+
+	evt, err := svc.handleSell(uid, &gamev1.SellRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+	require.NoError(t, err)
+	// Base 50 halved by the index, then a 0.5 buy margin.
+	assert.Contains(t, evt.GetMessage().Content, "for 12 credits")
+	assert.Equal(t, 1, svc.market.Quotes("test")[0].Sold)
+}
+
+func TestHandleMarket_Steady(t *testing.T) {
+	svc, uid, _ := newMerchantTestServer(t)
+
+	evt, err := svc.handleMarket(uid, &gamev1.MarketRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "are steady")
+}
+
+func TestHandleMarket_ListsIndices(t *testing.T) {
+	svc, uid, _ := newMerchantTestServer(t)
+	svc.market.RecordBuy("test", "stim_pack", 20)
+	svc.tickMarket()
+	svc.market.RecordSell("test", "stim_pack", 3)
+
+	evt, err := svc.handleMarket(uid, &gamev1.MarketRequest{})
+	require.NoError(t, err)
+	content := evt.GetMessage().Content
+	assert.Contains(t, content, "Stim Pack")
+	assert.Contains(t, content, "+5.0%")
+	assert.Contains(t, content, "today: 0 bought, 3 sold")
+}
+
+func TestHandleMarket_UnknownPlayer(t *testing.T) {
+	svc, _, _ := newMerchantTestServer(t)
+
+	_, err := svc.handleMarket("nobody", &gamev1.MarketRequest{})
+	assert.Error(t, err)
+}
@@ -16,14 +16,27 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	"github.com/cory-johannsen/mud/internal/game/session"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 var merchantRuntimeMu sync.RWMutex
 
+// MerchantStateStore persists merchant stock, budget, and restock timers so
+// vendors keep what players sold them across restarts.
+// State is keyed by (template ID, room ID), which is stable across respawns.
+type MerchantStateStore interface {
+	// Load returns the saved state, or (nil, nil) when none has been saved.
+	Load(ctx context.Context, templateID, roomID string) (*npc.MerchantRuntimeState, error)
+	// Save upserts state.
+	Save(ctx context.Context, templateID, roomID string, state *npc.MerchantRuntimeState) error
+}
+
 // initMerchantRuntimeState initialises runtime state for a merchant instance if absent.
 //
 // Precondition: inst must be non-nil.
 // Postcondition: merchantRuntimeStates[inst.ID] is set iff inst.NPCType == "merchant" and template is found.
+// Saved state is restored when a MerchantStateStore is configured; items added
+// to the template since the save start at their InitStock.
 func (s *GameServiceServer) initMerchantRuntimeState(inst *npc.Instance) {
 	if inst.NPCType != "merchant" {
 		return
@@ -32,13 +45,117 @@ func (s *GameServiceServer) initMerchantRuntimeState(inst *npc.Instance) {
 	if tmpl == nil || tmpl.Merchant == nil {
 		return
 	}
+	if s.merchantStateFor(inst.ID) != nil {
+		return
+	}
+	var loaded *npc.MerchantRuntimeState
+	if s.merchantStateStore != nil {
+		saved, err := s.merchantStateStore.Load(context.Background(), inst.TemplateID, inst.RoomID)
+		if err != nil {
+			s.logger.Warn("loading merchant state",
+				zap.String("npc", inst.ID),
+				zap.Error(err),
+			)
+		} else if saved != nil {
+			if saved.Stock == nil {
+				saved.Stock = make(map[string]int, len(tmpl.Merchant.Inventory))
+			}
+			for _, item := range tmpl.Merchant.Inventory {
+				if _, ok := saved.Stock[item.ItemID]; !ok {
+					saved.Stock[item.ItemID] = item.InitStock
+				}
+			}
+			loaded = saved
+		}
+	}
 	merchantRuntimeMu.Lock()
 	defer merchantRuntimeMu.Unlock()
 	if _, ok := s.merchantRuntimeStates[inst.ID]; !ok {
-		s.merchantRuntimeStates[inst.ID] = npc.InitRuntimeState(tmpl.Merchant, time.Now())
+		if loaded == nil {
+			loaded = npc.InitRuntimeState(tmpl.Merchant, time.Now())
+		}
+		s.merchantRuntimeStates[inst.ID] = loaded
 	}
 }
 
+// persistMerchantState saves the current runtime state of inst.
+//
+// Precondition: inst must be non-nil.
+// Postcondition: No-op when no MerchantStateStore is configured or inst has no
+// runtime state; failures are logged, not returned.
+func (s *GameServiceServer) persistMerchantState(inst *npc.Instance) {
+	if s.merchantStateStore == nil {
+		return
+	}
+	merchantRuntimeMu.RLock()
+	state := s.merchantRuntimeStates[inst.ID]
+	var snapshot *npc.MerchantRuntimeState
+	if state != nil {
+		snapshot = copyMerchantState(state)
+	}
+	merchantRuntimeMu.RUnlock()
+	if snapshot == nil {
+		return
+	}
+	s.saveMerchantState(inst.TemplateID, inst.RoomID, snapshot)
+}
+
+// saveMerchantState writes state through the MerchantStateStore, logging failures.
+//
+// Precondition: s.merchantStateStore must be non-nil; state must not be shared with other goroutines.
+func (s *GameServiceServer) saveMerchantState(templateID, roomID string, state *npc.MerchantRuntimeState) {
+	if err := s.merchantStateStore.Save(context.Background(), templateID, roomID, state); err != nil {
+		s.logger.Warn("saving merchant state",
+			zap.String("template", templateID),
+			zap.String("room", roomID),
+			zap.Error(err),
+		)
+	}
+}
+
+// copyMerchantState returns a deep copy of state.
+//
+// Precondition: state must be non-nil; the caller holds merchantRuntimeMu.
+func copyMerchantState(state *npc.MerchantRuntimeState) *npc.MerchantRuntimeState {
+	stock := make(map[string]int, len(state.Stock))
+	for k, v := range state.Stock {
+		stock[k] = v
+	}
+	return &npc.MerchantRuntimeState{
+		Stock:           stock,
+		CurrentBudget:   state.CurrentBudget,
+		NextReplenishAt: state.NextReplenishAt,
+	}
+}
+
+// merchantFactionDiscount returns the standing discount the player receives
+// from a merchant of their own faction (REQ-FA-32, 33). The discount scales
+// with the player's rep, climbing between tier thresholds instead of jumping
+// only when a new tier is reached.
+//
+// Precondition: sess and inst must be non-nil.
+// Postcondition: Returns 0 when the merchant is unaffiliated, of another faction, or no faction service is configured.
+func (s *GameServiceServer) merchantFactionDiscount(sess *session.PlayerSession, inst *npc.Instance) float64 {
+	if s.factionSvc == nil || inst.FactionID == "" || inst.FactionID != sess.FactionID {
+		return 0
+	}
+	return s.factionSvc.StandingDiscount(sess.FactionID, sess.FactionRep[sess.FactionID])
+}
+
+// merchantUnitPrice returns what the player pays for one unit of item given the
+// merchant's current stock of it.
+//
+// Precondition: cfg must be non-nil.
+// Postcondition: The supply/demand-adjusted base price is used; a positive
+// faction discount replaces the wanted surcharge and negotiate modifier.
+func merchantUnitPrice(cfg *npc.MerchantConfig, item npc.MerchantItem, stock int, surcharge, negotiateMod, discount float64) int {
+	base := npc.AdjustedBasePrice(cfg, item, stock)
+	if discount > 0 {
+		return int(math.Floor(float64(base) * cfg.SellMargin * (1.0 - discount)))
+	}
+	return npc.ComputeBuyPrice(base, cfg.SellMargin, surcharge, negotiateMod)
+}
+
 // merchantStateFor returns the MerchantRuntimeState for instID, or nil if absent.
 //
 // Precondition: none.
@@ -114,9 +231,18 @@ func (s *GameServiceServer) buildShopView(uid string, npcName string) (*gamev1.S
 		state = s.merchantStateFor(inst.ID)
 	}
 	surcharge := s.wantedSurchargeFor(sess, inst)
-	rows := npc.BrowseLines(tmpl.Merchant, state, surcharge, sess.NegotiateModifier)
+	// Prices follow the regional market index for each item.
+	cfg := s.regionalMerchantConfig(tmpl.Merchant, s.marketRegion(sess.RoomID))
+	merchantRuntimeMu.RLock()
+	rows := npc.BrowseLines(cfg, state, surcharge, sess.NegotiateModifier)
+	merchantRuntimeMu.RUnlock()
+	if discount := s.merchantFactionDiscount(sess, inst); discount > 0 {
+		for i := range rows {
+			rows[i].BuyPrice = merchantUnitPrice(cfg, cfg.Inventory[i], rows[i].Stock, surcharge, sess.NegotiateModifier, discount)
+		}
+	}
 	items := make([]*gamev1.ShopItem, 0, len(rows))
-	for _, row := range rows {
+	for i, row := range rows {
 		shopItem := &gamev1.ShopItem{
 			ItemId:    row.ItemID,
 			BuyPrice:  int32(row.BuyPrice),
@@ -124,6 +250,9 @@ func (s *GameServiceServer) buildShopView(uid string, npcName string) (*gamev1.S
 			Stock:     int32(row.Stock),
 			Name:      row.ItemID,
 		}
+		if gate := tmpl.Merchant.Inventory[i].MinRep; !gate.Met(sess.FactionRep) {
+			shopItem.LockedReason = s.repLockLabel(gate)
+		}
 		if s.invRegistry != nil {
 			if def, ok := s.invRegistry.Item(row.ItemID); ok {
 				shopItem.Name = def.Name
@@ -257,11 +386,12 @@ func (s *GameServiceServer) handleBuy(uid string, req *gamev1.BuyRequest) (*game
 				sess.Materials = make(map[string]int)
 			}
 			sess.Materials[ms.ID]++
+			s.emitTrade(sess, telemetry.SourceMerchantBuy, ms.ID, 1, ms.Price, inst.TemplateID)
 			if s.materialRepo != nil {
-				_ = s.materialRepo.Add(context.Background(), sess.CharacterID, ms.ID, 1)
+				_ = s.materialRepo.Add(s.commandCtx(uid), sess.CharacterID, ms.ID, 1)
 			}
 			if s.charSaver != nil && sess.CharacterID > 0 {
-				if saveErr := s.charSaver.SaveCurrency(context.Background(), sess.CharacterID, sess.Currency); saveErr != nil {
+				if saveErr := s.charSaver.SaveCurrency(s.commandCtx(uid), sess.CharacterID, sess.Currency); saveErr != nil {
 					s.logger.Warn("handleBuy(material): SaveCurrency failed", zap.Error(saveErr))
 				}
 			}
@@ -278,6 +408,11 @@ func (s *GameServiceServer) handleBuy(uid string, req *gamev1.BuyRequest) (*game
 	if s.factionSvc != nil && inst.FactionID != "" && s.factionSvc.IsEnemyOf(sess, inst.FactionID) {
 		return messageEvent(fmt.Sprintf("%s eyes you coldly. 'We don't serve your kind here.'", inst.Name())), nil
 	}
+	// Reputation-gated stock.
+	if gate := itemCfg.MinRep; !gate.Met(sess.FactionRep) {
+		return messageEvent(fmt.Sprintf("%s won't sell you that until you have %d reputation with %s (you have %d).",
+			inst.Name(), gate.MinRep, s.factionDisplayName(gate.Faction), sess.FactionRep[gate.Faction])), nil
+	}
 	merchantRuntimeMu.RLock()
 	stock := state.Stock[itemID]
 	merchantRuntimeMu.RUnlock()
@@ -285,15 +420,9 @@ func (s *GameServiceServer) handleBuy(uid string, req *gamev1.BuyRequest) (*game
 		return messageEvent(fmt.Sprintf("%s is out of stock on %s.", inst.Name(), itemID)), nil
 	}
 	surcharge := s.wantedSurchargeFor(sess, inst)
-	unitPrice := npc.ComputeBuyPrice(itemCfg.BasePrice, tmpl.Merchant.SellMargin, surcharge, sess.NegotiateModifier)
-	// Apply faction discount if merchant belongs to player's faction (REQ-FA-32, 33).
-	if s.factionSvc != nil && inst.FactionID != "" && inst.FactionID == sess.FactionID {
-		rep := sess.FactionRep[sess.FactionID]
-		discount := s.factionSvc.DiscountFor(sess.FactionID, rep)
-		if discount > 0 {
-			unitPrice = int(math.Floor(float64(itemCfg.BasePrice) * float64(tmpl.Merchant.SellMargin) * (1.0 - discount)))
-		}
-	}
+	region := s.marketRegion(sess.RoomID)
+	// Price at current stock and regional index, applying any faction discount (REQ-FA-32, 33).
+	unitPrice := merchantUnitPrice(tmpl.Merchant, s.regionalItem(*itemCfg, region), stock, surcharge, sess.NegotiateModifier, s.merchantFactionDiscount(sess, inst))
 	total := unitPrice * qty
 	if sess.Currency < total {
 		return messageEvent(fmt.Sprintf("You can't afford that. It costs %d credits and you have %d.", total, sess.Currency)), nil
@@ -327,10 +456,13 @@ func (s *GameServiceServer) handleBuy(uid string, req *gamev1.BuyRequest) (*game
 		)
 		return messageEvent(fmt.Sprintf("Purchase failed: %s", addErr.Error())), nil
 	}
+	s.persistMerchantState(inst)
+	s.recordTrade(region, itemID, qty, true)
+	s.emitTrade(sess, telemetry.SourceMerchantBuy, itemID, qty, total, inst.TemplateID)
 
 	// Persist inventory and currency.
 	if s.charSaver != nil && sess.CharacterID > 0 {
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
 			s.logger.Warn("handleBuy: SaveInventory failed", zap.Error(err))
 		}
@@ -372,7 +504,8 @@ func (s *GameServiceServer) handleBuy(uid string, req *gamev1.BuyRequest) (*game
 //
 // Precondition: uid identifies an active player session; req is non-nil.
 // Postcondition: Returns a non-nil ServerEvent; error is always nil.
-// On success, sess.Currency is increased by payout and state.CurrentBudget is reduced.
+// On success, sess.Currency is increased by payout, state.CurrentBudget is reduced,
+// and state.Stock[itemID] is increased by qty.
 func (s *GameServiceServer) handleSell(uid string, req *gamev1.SellRequest) (*gamev1.ServerEvent, error) {
 	sess, ok := s.sessions.GetPlayer(uid)
 	if !ok {
@@ -423,16 +556,23 @@ func (s *GameServiceServer) handleSell(uid string, req *gamev1.SellRequest) (*ga
 		return messageEvent(fmt.Sprintf("You only have %d of %q.", owned, itemID)), nil
 	}
 
-	payout := npc.ComputeSellPayout(itemCfg.BasePrice, tmpl.Merchant.BuyMargin, qty, sess.NegotiateModifier)
 	merchantRuntimeMu.RLock()
 	budget := state.CurrentBudget
+	stock := state.Stock[itemID]
 	merchantRuntimeMu.RUnlock()
+	// Merchants pay less for items they already hold plenty of, and track the regional market index.
+	region := s.marketRegion(sess.RoomID)
+	payout := npc.ComputeSellPayout(npc.AdjustedBasePrice(tmpl.Merchant, s.regionalItem(*itemCfg, region), stock), tmpl.Merchant.BuyMargin, qty, sess.NegotiateModifier)
 	if budget < payout {
 		return messageEvent(fmt.Sprintf("%s can't afford to buy that right now.", inst.Name())), nil
 	}
+	// Sold items join the merchant's stock for resale.
 	merchantRuntimeMu.Lock()
 	state.CurrentBudget -= payout
+	state.Stock[itemID] += qty
 	merchantRuntimeMu.Unlock()
+	s.persistMerchantState(inst)
+	s.recordTrade(region, itemID, qty, false)
 
 	// Remove qty items from the backpack, draining stacks in order.
 	remaining := qty
@@ -455,10 +595,11 @@ func (s *GameServiceServer) handleSell(uid string, req *gamev1.SellRequest) (*ga
 	}
 
 	sess.Currency += payout
+	s.emitTrade(sess, telemetry.SourceMerchantSell, itemID, qty, payout, inst.TemplateID)
 
 	// Persist inventory and currency.
 	if s.charSaver != nil && sess.CharacterID > 0 {
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		if err := s.charSaver.SaveInventory(ctx, sess.CharacterID, backpackToInventoryItems(sess.Backpack)); err != nil {
 			s.logger.Warn("handleSell: SaveInventory failed", zap.Error(err))
 		}
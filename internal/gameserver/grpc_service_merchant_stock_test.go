@@ -0,0 +1,197 @@
+package gameserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/faction"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// memMerchantStateStore is an in-memory MerchantStateStore.
+type memMerchantStateStore struct {
+	mu    sync.Mutex
+	saved map[string]*npc.MerchantRuntimeState
+	saves int
+}
+
+func newMemMerchantStateStore() *memMerchantStateStore {
+	return &memMerchantStateStore{saved: make(map[string]*npc.MerchantRuntimeState)}
+}
+
+func (m *memMerchantStateStore) Load(_ context.Context, templateID, roomID string) (*npc.MerchantRuntimeState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.saved[templateID+"/"+roomID], nil
+}
+
+func (m *memMerchantStateStore) Save(_ context.Context, templateID, roomID string, state *npc.MerchantRuntimeState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saved[templateID+"/"+roomID] = state
+	m.saves++
+	return nil
+}
+
+func (m *memMerchantStateStore) get(templateID, roomID string) *npc.MerchantRuntimeState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.saved[templateID+"/"+roomID]
+}
+
+func TestHandleSell_AddsStockForResale(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	_, err := sess.Backpack.Add("stim_pack", 2, svc.invRegistry)
+	require.NoError(t, err)
+
+	_, err = svc.handleSell(uid, &gamev1.SellRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 5, svc.merchantStateFor(inst.ID).Stock["stim_pack"])
+
+	_, err = svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 5})
+	require.NoError(t, err)
+	assert.Len(t, sess.Backpack.FindByItemDefID("stim_pack"), 5, "resold stock can be bought back")
+	assert.Equal(t, 0, svc.merchantStateFor(inst.ID).Stock["stim_pack"])
+}
+
+func TestHandleBuy_ScarcityRaisesPrice(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	svc.npcMgr.TemplateByID(inst.TemplateID).Merchant.PriceElasticity = 0.5
+	svc.merchantStateFor(inst.ID).Stock["stim_pack"] = 1
+
+	_, err := svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+	require.NoError(t, err)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	// 50 * (1 + 0.5*(1 - 1/5)) = 70
+	assert.Equal(t, 430, sess.Currency)
+}
+
+func TestHandleBrowse_ShowsSupplyAdjustedPrices(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	svc.npcMgr.TemplateByID(inst.TemplateID).Merchant.PriceElasticity = 0.5
+	svc.merchantStateFor(inst.ID).Stock["stim_pack"] = 10
+
+	evt, err := svc.handleBrowse(uid, &gamev1.BrowseRequest{NpcName: inst.Name()})
+	require.NoError(t, err)
+	items := evt.GetShopView().GetItems()
+	require.Len(t, items, 1)
+	// 50 * (1 + 0.5*(1 - 2)) = 25
+	assert.Equal(t, int32(25), items[0].BuyPrice)
+	assert.Equal(t, int32(12), items[0].SellPrice)
+}
+
+func TestMerchantState_PersistedAndRestored(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	store := newMemMerchantStateStore()
+	svc.merchantStateStore = store
+
+	_, err := svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+	require.NoError(t, err)
+	saved := store.get(inst.TemplateID, inst.RoomID)
+	require.NotNil(t, saved, "a purchase persists merchant state")
+	assert.Equal(t, 2, saved.Stock["stim_pack"])
+
+	// Simulate a restart: runtime state is gone and is restored from the store.
+	merchantRuntimeMu.Lock()
+	delete(svc.merchantRuntimeStates, inst.ID)
+	merchantRuntimeMu.Unlock()
+	store.saved[inst.TemplateID+"/"+inst.RoomID] = &npc.MerchantRuntimeState{
+		Stock:           map[string]int{"stim_pack": 9},
+		CurrentBudget:   42,
+		NextReplenishAt: time.Now().Add(time.Hour),
+	}
+	svc.initMerchantRuntimeState(inst)
+	state := svc.merchantStateFor(inst.ID)
+	require.NotNil(t, state)
+	assert.Equal(t, 9, state.Stock["stim_pack"])
+	assert.Equal(t, 42, state.CurrentBudget)
+}
+
+func TestMerchantState_RestoreFillsNewItems(t *testing.T) {
+	svc, _, inst := newMerchantTestServer(t)
+	store := newMemMerchantStateStore()
+	store.saved[inst.TemplateID+"/"+inst.RoomID] = &npc.MerchantRuntimeState{CurrentBudget: 5}
+	svc.merchantStateStore = store
+	merchantRuntimeMu.Lock()
+	delete(svc.merchantRuntimeStates, inst.ID)
+	merchantRuntimeMu.Unlock()
+
+	svc.initMerchantRuntimeState(inst)
+	assert.Equal(t, 3, svc.merchantStateFor(inst.ID).Stock["stim_pack"], "items missing from the save start at InitStock")
+}
+
+func TestTickMerchantReplenish_PersistsState(t *testing.T) {
+	svc, _, inst := newMerchantTestServer(t)
+	store := newMemMerchantStateStore()
+	svc.merchantStateStore = store
+	svc.tickMerchantReplenish(time.Now().Add(5 * time.Hour))
+
+	saved := store.get(inst.TemplateID, inst.RoomID)
+	require.NotNil(t, saved)
+	assert.Equal(t, 5, saved.Stock["stim_pack"])
+}
+
+// TestProperty_HandleSell_ConservesCreditsAndStock verifies that a sale moves
+// exactly qty items into the merchant's stock and exactly the payout from the
+// merchant's budget to the player.
+func TestProperty_HandleSell_ConservesCreditsAndStock(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		svc, uid, inst := newMerchantTestServer(t)
+		svc.npcMgr.TemplateByID(inst.TemplateID).Merchant.PriceElasticity = rapid.Float64Range(0, 0.9).Draw(rt, "elasticity")
+		state := svc.merchantStateFor(inst.ID)
+		state.Stock["stim_pack"] = rapid.IntRange(0, 12).Draw(rt, "stock")
+		qty := rapid.IntRange(1, 4).Draw(rt, "qty")
+		sess, _ := svc.sessions.GetPlayer(uid)
+		if _, err := sess.Backpack.Add("stim_pack", qty, svc.invRegistry); err != nil {
+			rt.Fatalf("adding to backpack: %v", err)
+		}
+		stockBefore, budgetBefore, currencyBefore := state.Stock["stim_pack"], state.CurrentBudget, sess.Currency
+
+		if _, err := svc.handleSell(uid, &gamev1.SellRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: int32(qty)}); err != nil {
+			rt.Fatalf("handleSell: %v", err)
+		}
+		if got := state.Stock["stim_pack"]; got != stockBefore+qty {
+			rt.Fatalf("stock = %d, want %d", got, stockBefore+qty)
+		}
+		if budgetBefore+currencyBefore != state.CurrentBudget+sess.Currency {
+			rt.Fatalf("credits not conserved: %d+%d -> %d+%d", budgetBefore, currencyBefore, state.CurrentBudget, sess.Currency)
+		}
+	})
+}
+
+func TestHandleBuy_FactionDiscountScalesWithStanding(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	reg := faction.FactionRegistry{"gun": &faction.FactionDef{
+		ID: "gun", Name: "Team Gun",
+		Tiers: []faction.FactionTier{
+			{ID: "outsider", MinRep: 0},
+			{ID: "gunhand", MinRep: 100, PriceDiscount: 0.10},
+			{ID: "sharpshooter", MinRep: 300, PriceDiscount: 0.20},
+			{ID: "warchief", MinRep: 600, PriceDiscount: 0.30},
+		},
+	}}
+	svc.factionSvc = faction.NewService(reg)
+	inst.FactionID = "gun"
+	sess, _ := svc.sessions.GetPlayer(uid)
+	sess.FactionID = "gun"
+
+	for rep, want := range map[int]int{0: 50, 50: 47, 100: 45, 200: 42} {
+		sess.FactionRep = map[string]int{"gun": rep}
+		state := svc.merchantStateFor(inst.ID)
+		merchantRuntimeMu.Lock()
+		state.Stock["stim_pack"] = 3
+		merchantRuntimeMu.Unlock()
+		before := sess.Currency
+		_, err := svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+		require.NoError(t, err)
+		assert.Equal(t, want, before-sess.Currency, "rep %d", rep)
+	}
+}
@@ -0,0 +1,34 @@
+package gameserver
+
+import (
+	"fmt"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleNonlethal switches the player between lethal and nonlethal attacks.
+// With nonlethal on, a target the player's attacks drop to 0 HP is knocked
+// out instead of killed; a weapon with the nonlethal trait always is.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when
+// the session is missing. sess.Nonlethal reflects "on" or "off".
+func (s *GameServiceServer) handleNonlethal(uid string, req *gamev1.NonlethalRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	switch req.GetMode() {
+	case "on":
+		sess.Nonlethal = true
+		return messageEvent("You pull your blows. Anyone you drop will be knocked out, not killed."), nil
+	case "off":
+		sess.Nonlethal = false
+		return messageEvent("You fight to kill."), nil
+	default:
+		if sess.Nonlethal {
+			return messageEvent("You are pulling your blows: targets you drop are knocked out. Use nonlethal off to fight to kill."), nil
+		}
+		return messageEvent("You are fighting to kill. Use nonlethal on to knock targets out instead."), nil
+	}
+}
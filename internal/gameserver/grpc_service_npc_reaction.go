@@ -0,0 +1,76 @@
+package gameserver
+
+import (
+	"fmt"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// reactionAttack is the Lua hook return value that makes an NPC start a fight.
+const reactionAttack = "attack"
+
+// notifyObservers lets every NPC in ev.RoomID that reacts to ev run its Lua
+// hook. A hook returning "attack" starts combat with the acting player; any
+// other non-empty string is spoken to the room by the NPC.
+//
+// Precondition: ev.RoomID and ev.ActorUID should be set.
+// Postcondition: NPCs already in combat are skipped; no-op without scripting.
+func (s *GameServiceServer) notifyObservers(ev npc.RoomEvent) {
+	if s.scriptMgr == nil || s.npcMgr == nil {
+		return
+	}
+	room, ok := s.world.GetRoom(ev.RoomID)
+	if !ok {
+		return
+	}
+	for _, obs := range s.npcMgr.Observers(ev) {
+		if s.combatH != nil && s.combatH.IsInCombat(obs.Inst.ID) {
+			continue
+		}
+		ret, err := s.scriptMgr.CallHookWithContext(room.ZoneID, obs.Reaction.Hook, obs.Inst.ID, ev.ActorUID, map[string]lua.LValue{
+			"kind": lua.LString(ev.Kind),
+			"item": lua.LString(ev.ItemID),
+			"team": lua.LString(ev.Team),
+		})
+		if err != nil || ret == lua.LNil {
+			continue
+		}
+		switch reply := ret.String(); reply {
+		case "":
+		case reactionAttack:
+			if s.combatH != nil {
+				s.combatH.InitiateNPCCombat(obs.Inst, ev.ActorUID)
+			}
+		default:
+			s.broadcastMessage(ev.RoomID, "", &gamev1.MessageEvent{
+				Content: fmt.Sprintf("%s says \"%s\"", obs.Inst.Name(), reply),
+			})
+		}
+	}
+}
+
+// gearTeam returns the team affinity of the weapon or armor behind itemDefID,
+// or "" when it has none.
+func (s *GameServiceServer) gearTeam(itemDefID string) string {
+	if s.invRegistry == nil {
+		return ""
+	}
+	itemDef, ok := s.invRegistry.Item(itemDefID)
+	if !ok {
+		return ""
+	}
+	if itemDef.WeaponRef != "" {
+		if w := s.invRegistry.Weapon(itemDef.WeaponRef); w != nil {
+			return w.TeamAffinity
+		}
+	}
+	if itemDef.ArmorRef != "" {
+		if a, ok := s.invRegistry.Armor(itemDef.ArmorRef); ok {
+			return a.TeamAffinity
+		}
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+const reactionTestLua = `
+function sweeper_tut(npc_uid, player_uid, event)
+  return "Pick up your " .. event.item .. ", " .. player_uid .. "."
+end
+
+function sweeper_shrug(npc_uid, player_uid, event)
+  return nil
+end
+`
+
+// newReactionTestServer builds a bar holding a sweeper who scolds anyone
+// dropping litter, watched by player "ace".
+func newReactionTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "bar", Rooms: map[string]*world.Room{
+		"bar": {ID: "bar", ZoneID: "z", Title: "Bar"},
+	}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	sess, err := sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ace", Username: "ace", CharName: "ace", RoomID: "bar", CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "reactions.lua"), []byte(reactionTestLua), 0o644))
+	logger := zap.NewNop()
+	scriptMgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), logger), logger)
+	require.NoError(t, scriptMgr.LoadZone("z", dir, 0))
+
+	npcMgr := npc.NewManager()
+	_, err = npcMgr.Spawn(&npc.Template{ID: "sweeper", Name: "Sweeper", Level: 1, MaxHP: 10, AC: 10, Reactions: []npc.Reaction{
+		{On: npc.EventDropItem, Team: "machete", Hook: "sweeper_shrug"},
+		{On: npc.EventDropItem, Hook: "sweeper_tut"},
+	}}, "bar")
+	require.NoError(t, err)
+
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "bottle", Name: "Bottle", Kind: inventory.KindJunk, MaxStack: 1}))
+
+	s := &GameServiceServer{
+		sessions:    sMgr,
+		world:       wMgr,
+		npcMgr:      npcMgr,
+		scriptMgr:   scriptMgr,
+		invRegistry: reg,
+		floorMgr:    inventory.NewFloorManager(),
+		logger:      logger,
+	}
+	return s, sess
+}
+
+func TestHandleDropItem_NPCReactsThroughLua(t *testing.T) {
+	s, sess := newReactionTestServer(t)
+	_, err := sess.Backpack.Add("bottle", 1, s.invRegistry)
+	require.NoError(t, err)
+
+	_, err = s.handleDropItem("ace", "bottle")
+	require.NoError(t, err)
+	assert.Equal(t, []string{`Sweeper says "Pick up your bottle, ace."`}, drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond))
+}
+
+func TestNotifyObservers_NilReplyIsSilent(t *testing.T) {
+	s, sess := newReactionTestServer(t)
+
+	s.notifyObservers(npc.RoomEvent{Kind: npc.EventDropItem, RoomID: "bar", ActorUID: "ace", ItemID: "machete", Team: "machete"})
+	assert.Empty(t, drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond))
+	s.notifyObservers(npc.RoomEvent{Kind: npc.EventDrawWeapon, RoomID: "bar", ActorUID: "ace", ItemID: "bottle"})
+	assert.Empty(t, drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond), "the sweeper ignores drawn weapons")
+}
@@ -10,12 +10,33 @@ import (
 // tickMerchantReplenish advances all overdue merchant runtime states by one replenishment cycle.
 //
 // Precondition: s.merchantRuntimeStates MUST NOT be nil.
-// Postcondition: every state whose NextReplenishAt is not after now has been advanced via npc.ApplyReplenish.
+// Postcondition: every state whose NextReplenishAt is not after now has been advanced via npc.ApplyReplenish
+// and, when a MerchantStateStore is configured, persisted.
 func (s *GameServiceServer) tickMerchantReplenish(now time.Time) {
+	s.replenishMerchants(func(state *npc.MerchantRuntimeState) bool {
+		return !now.Before(state.NextReplenishAt)
+	})
+}
+
+// restockAllMerchants advances every merchant by one replenishment cycle at
+// once, whether or not it is due.
+//
+// Postcondition: every merchant runtime state has been replenished and, when a
+// MerchantStateStore is configured, persisted.
+func (s *GameServiceServer) restockAllMerchants() {
+	s.replenishMerchants(func(*npc.MerchantRuntimeState) bool { return true })
+}
+
+// replenishMerchants applies one replenishment cycle to each merchant state due reports true for.
+func (s *GameServiceServer) replenishMerchants(due func(*npc.MerchantRuntimeState) bool) {
+	type pending struct {
+		inst  *npc.Instance
+		state *npc.MerchantRuntimeState
+	}
+	var toSave []pending
 	merchantRuntimeMu.Lock()
-	defer merchantRuntimeMu.Unlock()
 	for instID, state := range s.merchantRuntimeStates {
-		if now.Before(state.NextReplenishAt) {
+		if !due(state) {
 			continue
 		}
 		inst := s.npcMgr.InstanceByID(instID)
@@ -26,7 +47,15 @@ func (s *GameServiceServer) tickMerchantReplenish(now time.Time) {
 		if tmpl == nil || tmpl.Merchant == nil {
 			continue
 		}
-		s.merchantRuntimeStates[instID] = npc.ApplyReplenish(tmpl.Merchant, state, 0)
+		next := npc.ApplyReplenish(tmpl.Merchant, state, 0)
+		s.merchantRuntimeStates[instID] = next
+		if s.merchantStateStore != nil {
+			toSave = append(toSave, pending{inst: inst, state: copyMerchantState(next)})
+		}
+	}
+	merchantRuntimeMu.Unlock()
+	for _, p := range toSave {
+		s.saveMerchantState(p.inst.TemplateID, p.inst.RoomID, p.state)
 	}
 }
 
@@ -54,8 +83,8 @@ func (s *GameServiceServer) tickBankerRates() {
 }
 
 // StartNPCTickHook subscribes to the calendar and drives periodic NPC state updates.
-// tickMerchantReplenish is called on every calendar tick; tickBankerRates is called
-// once per in-game day (when dt.Hour == 0).
+// tickMerchantReplenish and tickAuctions are called on every calendar tick; tickBankerRates and
+// tickMarket are called once per in-game day (when dt.Hour == 0).
 //
 // Precondition: MUST be called after GameServiceServer is fully initialized.
 // Precondition: s.calendar MUST NOT be nil.
@@ -72,10 +101,12 @@ func (s *GameServiceServer) StartNPCTickHook() func() {
 			select {
 			case dt := <-ch:
 				s.tickMerchantReplenish(time.Now())
+				s.tickAuctions(time.Now())
 				if dt.Hour == 0 {
 					s.tickBankerRates()
 					s.tickHealerCapacity()
 					s.tickHirelingDailyCost()
+					s.tickMarket()
 				}
 			case <-stop:
 				s.calendar.Unsubscribe(ch)
@@ -0,0 +1,80 @@
+package gameserver
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleOrder gives the companion fighting at the player's side an order.
+// Attack, guard, and fallback stand for the rest of the fight; use has the
+// companion spend an action handing the player a consumable from their pack.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil when the
+// session is missing or the order can't be given.
+func (s *GameServiceServer) handleOrder(uid string, req *gamev1.OrderRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.combatH == nil {
+		return messageEvent("You can only give orders in a fight."), nil
+	}
+
+	if req.GetAction() == "use" {
+		return s.orderUseItem(uid, req.GetCompanion(), req.GetArg())
+	}
+	name, err := s.combatH.OrderCompanion(uid, req.GetCompanion(), req.GetAction(), req.GetArg())
+	if err != nil {
+		return nil, err
+	}
+	switch req.GetAction() {
+	case "attack":
+		return messageEvent(fmt.Sprintf("You point %s at %s.", name, req.GetArg())), nil
+	case "guard":
+		return messageEvent(fmt.Sprintf("%s steps in to guard you, taking on whoever comes closest.", name)), nil
+	default:
+		return messageEvent(fmt.Sprintf("%s falls back to the back row.", name)), nil
+	}
+}
+
+// orderUseItem has companion hand the player the consumable named item from
+// their pack, spending one of the companion's actions this round.
+//
+// Precondition: uid must identify an active session; s.combatH must not be nil.
+// Postcondition: On success one item is removed from the player's pack and
+// its effect applied to them.
+func (s *GameServiceServer) orderUseItem(uid, companion, item string) (*gamev1.ServerEvent, error) {
+	sess, _ := s.sessions.GetPlayer(uid)
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return messageEvent("You aren't carrying that."), nil
+	}
+	for _, it := range sess.Backpack.Items() {
+		def, ok := s.invRegistry.Item(it.ItemDefID)
+		if !ok || !(strings.EqualFold(def.Name, item) || strings.EqualFold(def.ID, item)) {
+			continue
+		}
+		if def.Kind != inventory.KindConsumable || def.SubstanceID != "" || def.Effect == nil {
+			return messageEvent(fmt.Sprintf("Your companion can't use the %s on you.", def.Name)), nil
+		}
+		name, err := s.combatH.SpendCompanionAP(uid, companion, 1)
+		if err != nil {
+			return nil, err
+		}
+		var rng inventory.Roller
+		if s.dice != nil {
+			rng = &diceRollerAdapter{r: s.dice}
+		} else {
+			rng = NewDurabilityRoller(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+		}
+		result := inventory.ApplyConsumable(&playerActivateAdapter{sess: sess, svc: s}, def, rng)
+		_ = sess.Backpack.Remove(it.InstanceID, 1)
+		s.pushInventory(sess)
+		return messageEvent(fmt.Sprintf("%s hands you the %s. %s", name, def.Name, buildConsumableResultMsg(def.Name, result))), nil
+	}
+	return messageEvent("You aren't carrying that."), nil
+}
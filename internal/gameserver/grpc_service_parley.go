@@ -0,0 +1,104 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleParley deals with an NPC that has surrendered: without an option it
+// lists the choices, otherwise it loots or releases the NPC, or opens a
+// social encounter to interrogate it.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil. A
+// released NPC leaves the world and is scheduled to respawn.
+func (s *GameServiceServer) handleParley(uid string, req *gamev1.ParleyRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return messageEvent("player not found"), nil
+	}
+	inst := s.npcMgr.FindInRoom(sess.RoomID, req.GetTarget())
+	if inst == nil {
+		return messageEvent(fmt.Sprintf("You don't see %q here.", req.GetTarget())), nil
+	}
+	if !inst.Surrendered {
+		return messageEvent(fmt.Sprintf("%s has not surrendered.", inst.Name())), nil
+	}
+
+	switch strings.ToLower(req.GetOption()) {
+	case "":
+		return messageEvent(fmt.Sprintf("%s waits, hands raised. You can loot, interrogate, or release them.", inst.Name())), nil
+	case "loot":
+		if s.combatH == nil || !s.combatH.LootSurrendered(sess, inst) {
+			return messageEvent(fmt.Sprintf("%s has nothing left to give.", inst.Name())), nil
+		}
+		return messageEvent(fmt.Sprintf("%s empties their pockets for you.", inst.Name())), nil
+	case "interrogate":
+		return s.handleSocial(uid, &gamev1.SocialRequest{Target: req.GetTarget()})
+	case "release":
+		return messageEvent(s.releaseSurrendered(uid, sess, inst)), nil
+	default:
+		return messageEvent("usage: parley <npc> [loot|interrogate|release]"), nil
+	}
+}
+
+// interrogate returns what a surrendered NPC tells its captor once its
+// resolve breaks. An NPC gives up its information once.
+//
+// Precondition: inst must not be nil.
+// Postcondition: inst.SurrenderInterrogated is true.
+func interrogate(inst *npc.Instance) string {
+	if inst.SurrenderInterrogated {
+		return fmt.Sprintf("%s has already told you everything they know.", inst.Name())
+	}
+	inst.SurrenderInterrogated = true
+	if inst.Surrender == nil || len(inst.Surrender.Info) == 0 {
+		return fmt.Sprintf("%s knows nothing useful.", inst.Name())
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s talks:", inst.Name())
+	for _, line := range inst.Surrender.Info {
+		fmt.Fprintf(&b, "\n  \"%s\"", line)
+	}
+	return b.String()
+}
+
+// releaseSurrendered lets a surrendered NPC go. Mercy earns standing with the
+// NPC's faction when its template sets release_rep.
+//
+// Precondition: sess belongs to uid; inst must not be nil and be surrendered.
+// Postcondition: inst is removed from the world and its respawn is scheduled;
+// returns the message for the releasing player.
+func (s *GameServiceServer) releaseSurrendered(uid string, sess *session.PlayerSession, inst *npc.Instance) string {
+	msg := fmt.Sprintf("You let %s go. They slink away.", inst.Name())
+	if s.factionSvc != nil && inst.FactionID != "" && inst.Surrender != nil && inst.Surrender.ReleaseRep > 0 {
+		if sess.FactionRep == nil {
+			sess.FactionRep = make(map[string]int)
+		}
+		tierMsg, err := s.factionSvc.AwardRep(s.commandCtx(uid), sess, sess.CharacterID, inst.FactionID, inst.Surrender.ReleaseRep)
+		if err != nil {
+			s.logger.Warn("awarding release rep", zap.String("npc", inst.ID), zap.Error(err))
+		} else {
+			msg += fmt.Sprintf(" Your standing with %s improves.", inst.FactionID)
+			if tierMsg != "" {
+				msg += "\n" + tierMsg
+			}
+		}
+	}
+	roomID := inst.RoomID
+	if err := s.npcMgr.Remove(inst.ID); err != nil {
+		return fmt.Sprintf("%s will not leave.", inst.Name())
+	}
+	if s.respawnMgr != nil {
+		s.respawnMgr.Schedule(inst.TemplateID, roomID, time.Now(), s.respawnMgr.ResolvedDelay(inst.TemplateID, roomID))
+	}
+	s.pushRoomViewToAllInRoom(roomID)
+	return msg
+}
@@ -0,0 +1,102 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/permission"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// SetPermissions replaces the role → capability matrix used to authorize
+// privileged commands.
+//
+// Precondition: m may be nil to use the embedded default matrix.
+// Postcondition: Subsequent commands and chat filtering use m.
+func (s *GameServiceServer) SetPermissions(m *permission.Matrix) {
+	s.permissions = m
+	if f := s.chatH.Filter(); f != nil {
+		f.SetPermissions(m)
+	}
+}
+
+// can reports whether sess's role grants c.
+//
+// Precondition: sess must be non-nil.
+func (s *GameServiceServer) can(sess *session.PlayerSession, c permission.Capability) bool {
+	return s.permissions.Allows(sess.Role, c)
+}
+
+// requiredCapability returns the capability needed to dispatch msg.
+//
+// Postcondition: ok is false for commands every player may issue.
+func requiredCapability(msg *gamev1.ClientMessage) (c permission.Capability, ok bool) {
+	switch msg.GetPayload().(type) {
+	case *gamev1.ClientMessage_SetRole:
+		return permission.CanSetRole, true
+	case *gamev1.ClientMessage_Teleport:
+		return permission.CanTeleport, true
+	case *gamev1.ClientMessage_SummonItem:
+		return permission.CanSummonItems, true
+	case *gamev1.ClientMessage_Grant:
+		return permission.CanGrant, true
+	case *gamev1.ClientMessage_SpawnNpc, *gamev1.ClientMessage_KillNpcRequest:
+		return permission.CanSpawnNPCs, true
+	case *gamev1.ClientMessage_AddRoom, *gamev1.ClientMessage_AddLink,
+		*gamev1.ClientMessage_RemoveLink, *gamev1.ClientMessage_SetRoom,
+		*gamev1.ClientMessage_EditorCmds, *gamev1.ClientMessage_RoomEquip:
+		return permission.CanEditWorld, true
+	case *gamev1.ClientMessage_SpawnCharRequest, *gamev1.ClientMessage_DeleteCharRequest:
+		return permission.CanManageTestCharacters, true
+	case *gamev1.ClientMessage_ChatMute:
+		return permission.CanModerateChat, true
+	case *gamev1.ClientMessage_Audit:
+		return permission.CanViewAuditLog, true
+	case *gamev1.ClientMessage_Rename:
+		return permission.CanRenameCharacters, true
+	case *gamev1.ClientMessage_CharacterSlots:
+		return permission.CanSetCharacterSlots, true
+	case *gamev1.ClientMessage_TransferCharacter:
+		return permission.CanTransferCharacters, true
+	case *gamev1.ClientMessage_ExportCharacter:
+		return permission.CanExportCharacters, true
+	case *gamev1.ClientMessage_SiteBan:
+		return permission.CanManageSiteBans, true
+	case *gamev1.ClientMessage_Reports:
+		return permission.CanReviewReports, true
+	case *gamev1.ClientMessage_Invis:
+		return permission.CanGoInvisible, true
+	case *gamev1.ClientMessage_Snoop:
+		return permission.CanSnoop, true
+	case *gamev1.ClientMessage_Force:
+		return permission.CanForce, true
+	case *gamev1.ClientMessage_Puppet:
+		return permission.CanPuppet, true
+	case *gamev1.ClientMessage_Gm:
+		return permission.CanRunEvents, true
+	case *gamev1.ClientMessage_Schedule:
+		return permission.CanManageSchedule, true
+	case *gamev1.ClientMessage_ReloadConfig:
+		return permission.CanReloadConfig, true
+	case *gamev1.ClientMessage_Flag:
+		return permission.CanManageFlags, true
+	}
+	return "", false
+}
+
+// authorize checks that uid may dispatch msg.
+//
+// Postcondition: Returns nil when msg needs no capability, the session is
+// unknown (the handler reports it), or the role grants the capability;
+// otherwise returns a permission-denied error event.
+func (s *GameServiceServer) authorize(uid string, msg *gamev1.ClientMessage) *gamev1.ServerEvent {
+	c, ok := requiredCapability(msg)
+	if !ok {
+		return nil
+	}
+	sess, found := s.sessions.GetPlayer(uid)
+	if !found || s.can(sess, c) {
+		return nil
+	}
+	return errorEvent(fmt.Sprintf("permission denied: %s required", c))
+}
@@ -0,0 +1,66 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/permission"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// loadTestPermissions writes yaml to a temp file and loads it as a matrix.
+func loadTestPermissions(t *testing.T, yaml string) *permission.Matrix {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "permissions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(yaml), 0o644))
+	m, err := permission.LoadMatrix(path)
+	require.NoError(t, err)
+	return m
+}
+
+func TestAuthorize_DeniesMissingCapability(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "p", 1)
+
+	evt := svc.authorize("p", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Teleport{Teleport: &gamev1.TeleportRequest{}}})
+	require.NotNil(t, evt)
+	assert.Equal(t, "permission denied: can_teleport required", evt.GetError().GetMessage())
+}
+
+func TestAuthorize_IgnoresOrdinaryCommands(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "p", 1)
+
+	assert.Nil(t, svc.authorize("p", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}}))
+}
+
+func TestAuthorize_UsesConfiguredMatrix(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	svc.SetPermissions(loadTestPermissions(t, "roles:\n  moderator: [can_teleport]\n"))
+	mod := addPlayerForCombatDefault(t, svc, "mod", 1)
+	mod.Role = postgres.RoleModerator
+
+	assert.Nil(t, svc.authorize("mod", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Teleport{Teleport: &gamev1.TeleportRequest{}}}))
+	assert.NotNil(t, svc.authorize("mod", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_ChatMute{ChatMute: &gamev1.ChatMuteRequest{}}}),
+		"capabilities omitted from the matrix are denied")
+}
+
+func TestSetPermissions_PropagatesToChatFilter(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	svc.SetChatFilter(NewChatFilter(testChatConfig(), []string{"darn"}))
+	sender := chatSender(postgres.RoleEditor)
+
+	out, err := svc.chatH.Filter().Apply(sender, "darn")
+	require.NoError(t, err)
+	assert.Equal(t, "darn", out, "editors bypass by default")
+
+	svc.SetPermissions(loadTestPermissions(t, "roles:\n  editor: [can_edit_world]\n"))
+	out, err = svc.chatH.Filter().Apply(sender, "darn")
+	require.NoError(t, err)
+	assert.Equal(t, "****", out)
+}
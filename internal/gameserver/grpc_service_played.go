@@ -0,0 +1,156 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// playSessionHeartbeatInterval is how often open play sessions are extended, bounding
+// how much playtime a server crash can lose. Each extension also credits the
+// playtime stat, so `played` and `top playtime` read the same clock.
+const playSessionHeartbeatInterval = time.Minute
+
+// PlaySessionStore records character login sessions and totals their duration.
+// It is the only source of playtime: TouchPlaySession credits the time it adds
+// to the character's playtime stat.
+type PlaySessionStore interface {
+	StartPlaySession(ctx context.Context, characterID int64, startedAt time.Time) (int64, error)
+	TouchPlaySession(ctx context.Context, id int64, endedAt time.Time) error
+	TotalPlaytime(ctx context.Context, characterID, excludeID int64) (time.Duration, error)
+}
+
+// startPlaySession stamps sess with its login time and character age and opens
+// its play-session record.
+//
+// Precondition: sess must be non-nil; dbChar may be nil.
+// Postcondition: sess.LoginAt is set; sess.PlaySessionID is set when the record was created.
+func (s *GameServiceServer) startPlaySession(ctx context.Context, sess *session.PlayerSession, dbChar *character.Character) {
+	sess.LoginAt = time.Now()
+	if dbChar != nil {
+		sess.CharacterCreatedAt = dbChar.CreatedAt
+	}
+	if s.playSessionStore == nil || sess.CharacterID <= 0 {
+		return
+	}
+	id, err := s.playSessionStore.StartPlaySession(ctx, sess.CharacterID, sess.LoginAt)
+	if err != nil {
+		s.logger.Warn("recording play session",
+			zap.String("uid", sess.UID),
+			zap.Int64("character_id", sess.CharacterID),
+			zap.Error(err),
+		)
+		return
+	}
+	sess.PlaySessionID = id
+}
+
+// touchPlaySession extends sess's play-session record to end at the current
+// time. The heartbeat calls it while the player is online and cleanup calls it
+// once more on disconnect.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when the session has no record.
+func (s *GameServiceServer) touchPlaySession(ctx context.Context, sess *session.PlayerSession) {
+	if s.playSessionStore == nil || sess.PlaySessionID == 0 {
+		return
+	}
+	if err := s.playSessionStore.TouchPlaySession(ctx, sess.PlaySessionID, time.Now()); err != nil {
+		s.logger.Warn("extending play session",
+			zap.String("uid", sess.UID),
+			zap.Int64("play_session_id", sess.PlaySessionID),
+			zap.Error(err),
+		)
+	}
+}
+
+// StartPlaySessionHeartbeat extends every online player's play session each
+// playSessionHeartbeatInterval.
+//
+// Precondition: MUST be called after GameServiceServer is fully initialized.
+// Postcondition: returns a stop function; call it to stop the goroutine.
+func (s *GameServiceServer) StartPlaySessionHeartbeat() func() {
+	if s.playSessionStore == nil {
+		return func() {}
+	}
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(playSessionHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				for _, sess := range s.sessions.AllPlayers() {
+					s.touchPlaySession(context.Background(), sess)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// formatPlayed renders d as days, hours, and minutes, e.g. "3d 4h 12m".
+func formatPlayed(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	mins := int64(d / time.Minute)
+	days, hours, mins := mins/(24*60), mins/60%24, mins%60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh %dm", days, hours, mins)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, mins)
+	default:
+		return fmt.Sprintf("%dm", mins)
+	}
+}
+
+// handlePlayed reports the character's total playtime, current session length, and age.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: The total is omitted when sessions are not recorded or cannot be read.
+func (s *GameServiceServer) handlePlayed(uid string) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	now := time.Now()
+	current := now.Sub(sess.LoginAt)
+	if sess.LoginAt.IsZero() {
+		current = 0
+	}
+
+	var b strings.Builder
+	if s.playSessionStore != nil && sess.CharacterID > 0 {
+		past, err := s.playSessionStore.TotalPlaytime(s.commandCtx(uid), sess.CharacterID, sess.PlaySessionID)
+		if err != nil {
+			s.logger.Warn("loading total playtime",
+				zap.String("uid", uid),
+				zap.Int64("character_id", sess.CharacterID),
+				zap.Error(err),
+			)
+		} else {
+			fmt.Fprintf(&b, "Total time played: %s\n", formatPlayed(past+current))
+		}
+	}
+	fmt.Fprintf(&b, "This session: %s", formatPlayed(current))
+	if !sess.CharacterCreatedAt.IsZero() {
+		days := int(now.Sub(sess.CharacterCreatedAt) / (24 * time.Hour))
+		unit := "days"
+		if days == 1 {
+			unit = "day"
+		}
+		fmt.Fprintf(&b, "\nCharacter age: %d %s (created %s)", days, unit, sess.CharacterCreatedAt.Format("2006-01-02"))
+	}
+	return messageEvent(b.String()), nil
+}
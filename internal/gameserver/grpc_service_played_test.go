@@ -0,0 +1,171 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+type memPlaySession struct {
+	characterID    int64
+	started, ended time.Time
+}
+
+type memPlaySessionStore struct {
+	sessions []memPlaySession
+	// fail makes every method return an error.
+	fail bool
+}
+
+func (m *memPlaySessionStore) StartPlaySession(_ context.Context, characterID int64, startedAt time.Time) (int64, error) {
+	if m.fail {
+		return 0, errors.New("db down")
+	}
+	m.sessions = append(m.sessions, memPlaySession{characterID: characterID, started: startedAt, ended: startedAt})
+	return int64(len(m.sessions)), nil
+}
+
+func (m *memPlaySessionStore) TouchPlaySession(_ context.Context, id int64, endedAt time.Time) error {
+	if m.fail {
+		return errors.New("db down")
+	}
+	m.sessions[id-1].ended = endedAt
+	return nil
+}
+
+func (m *memPlaySessionStore) TotalPlaytime(_ context.Context, characterID, excludeID int64) (time.Duration, error) {
+	if m.fail {
+		return 0, errors.New("db down")
+	}
+	var total time.Duration
+	for i, ps := range m.sessions {
+		if ps.characterID == characterID && int64(i+1) != excludeID {
+			total += ps.ended.Sub(ps.started)
+		}
+	}
+	return total, nil
+}
+
+// newPlayedTestServer builds a server with one player, "ace" (character 1).
+func newPlayedTestServer(t *testing.T) (*GameServiceServer, *memPlaySessionStore) {
+	t.Helper()
+	room := &world.Room{ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza."}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza",
+		Rooms: map[string]*world.Room{"plaza": room}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	_, err = sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ace", Username: "ace", CharName: "Ace", CharacterID: 1,
+		RoomID: "plaza", CurrentHP: 10, MaxHP: 10,
+		Abilities: character.AbilityScores{}, Role: "player",
+	})
+	require.NoError(t, err)
+
+	store := &memPlaySessionStore{}
+	s := &GameServiceServer{
+		sessions:         sMgr,
+		world:            wMgr,
+		logger:           zap.NewNop(),
+		playSessionStore: store,
+	}
+	return s, store
+}
+
+func TestFormatPlayed(t *testing.T) {
+	assert.Equal(t, "0m", formatPlayed(-time.Minute))
+	assert.Equal(t, "59m", formatPlayed(59*time.Minute+59*time.Second))
+	assert.Equal(t, "2h 5m", formatPlayed(2*time.Hour+5*time.Minute))
+	assert.Equal(t, "3d 4h 12m", formatPlayed(76*time.Hour+12*time.Minute))
+}
+
+func TestPlaySession_StartAndTouch(t *testing.T) {
+	s, store := newPlayedTestServer(t)
+	sess := player(t, s, "ace")
+	created := time.Now().Add(-72 * time.Hour)
+
+	s.startPlaySession(context.Background(), sess, &character.Character{CreatedAt: created})
+	assert.False(t, sess.LoginAt.IsZero())
+	assert.Equal(t, created, sess.CharacterCreatedAt)
+	require.Equal(t, int64(1), sess.PlaySessionID)
+
+	sess.LoginAt = sess.LoginAt.Add(-time.Hour)
+	store.sessions[0].started = sess.LoginAt
+	s.touchPlaySession(context.Background(), sess)
+	assert.InDelta(t, time.Hour, store.sessions[0].ended.Sub(store.sessions[0].started), float64(time.Second))
+}
+
+func TestPlaySession_StoreFailureStillStampsLogin(t *testing.T) {
+	s, store := newPlayedTestServer(t)
+	store.fail = true
+	sess := player(t, s, "ace")
+
+	s.startPlaySession(context.Background(), sess, nil)
+	assert.False(t, sess.LoginAt.IsZero())
+	assert.Zero(t, sess.PlaySessionID)
+	assert.NotPanics(t, func() { s.touchPlaySession(context.Background(), sess) })
+
+	evt, err := s.handlePlayed("ace")
+	require.NoError(t, err)
+	assert.Equal(t, "This session: 0m", evt.GetMessage().GetContent())
+}
+
+func TestHandlePlayed_ReportsTotalSessionAndAge(t *testing.T) {
+	s, store := newPlayedTestServer(t)
+	now := time.Now()
+	store.sessions = append(store.sessions, memPlaySession{characterID: 1, started: now.Add(-50 * time.Hour), ended: now.Add(-25 * time.Hour)})
+	store.sessions = append(store.sessions, memPlaySession{characterID: 2, started: now.Add(-9 * time.Hour), ended: now})
+	sess := player(t, s, "ace")
+	created := now.Add(-49 * time.Hour)
+	s.startPlaySession(context.Background(), sess, &character.Character{CreatedAt: created})
+	sess.LoginAt = now.Add(-90 * time.Minute)
+
+	evt, err := s.handlePlayed("ace")
+	require.NoError(t, err)
+	assert.Equal(t,
+		"Total time played: 1d 2h 30m\nThis session: 1h 30m\nCharacter age: 2 days (created "+created.Format("2006-01-02")+")",
+		evt.GetMessage().GetContent())
+
+	s.playSessionStore = nil
+	evt, err = s.handlePlayed("ace")
+	require.NoError(t, err)
+	assert.NotContains(t, evt.GetMessage().GetContent(), "Total time played")
+
+	_, err = s.handlePlayed("nobody")
+	assert.Error(t, err)
+}
+
+// TestProperty_PlaySessions_TotalSumsClosedSessions verifies that after any
+// sequence of logins and logouts the total excluding the open session equals
+// the time spent in the closed ones.
+func TestProperty_PlaySessions_TotalSumsClosedSessions(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s, store := newPlayedTestServer(t)
+		sess := player(t, s, "ace")
+		var want time.Duration
+		for i, n := 0, rapid.IntRange(1, 10).Draw(rt, "logins"); i < n; i++ {
+			s.startPlaySession(context.Background(), sess, nil)
+			length := time.Duration(rapid.IntRange(0, 600).Draw(rt, "minutes")) * time.Minute
+			store.sessions[sess.PlaySessionID-1].started = time.Now().Add(-length)
+			s.touchPlaySession(context.Background(), sess)
+			if i < n-1 {
+				want += store.sessions[sess.PlaySessionID-1].ended.Sub(store.sessions[sess.PlaySessionID-1].started)
+			}
+		}
+		got, err := store.TotalPlaytime(context.Background(), 1, sess.PlaySessionID)
+		require.NoError(rt, err)
+		if got != want {
+			rt.Fatalf("total %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package gameserver
+
+import (
+	"fmt"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handlePractice switches practice mode, which narrates combat verbosely —
+// every roll, modifier, and damage step — whatever the player's combat
+// narration setting.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when
+// the session is missing. sess.Practice reflects "on" or "off".
+func (s *GameServiceServer) handlePractice(uid string, req *gamev1.PracticeRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	switch req.GetMode() {
+	case "on":
+		sess.Practice = true
+		return messageEvent("Practice mode is on. Every roll, modifier, and damage step is shown in combat. Training dummies make safe targets."), nil
+	case "off":
+		sess.Practice = false
+		return messageEvent(fmt.Sprintf("Practice mode is off. Combat narration is back to %s.", sess.CombatVerbosity)), nil
+	default:
+		if sess.Practice {
+			return messageEvent("Practice mode is on. Use practice off to leave it."), nil
+		}
+		return messageEvent("Practice mode is off. Use practice on to see every roll in combat."), nil
+	}
+}
@@ -0,0 +1,117 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handlePuppet takes or releases control of an NPC, or acts through it. A
+// puppeted NPC stops its idle behavior and, in combat, attacks only the
+// combatant its puppeteer orders.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanPuppet (checked by dispatch); req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handlePuppet(uid string, req *gamev1.PuppetRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	switch req.GetAction() {
+	case "control":
+		inst := s.npcMgr.FindInRoom(sess.RoomID, req.GetTarget())
+		if inst == nil || inst.IsDead() {
+			return errorEvent(fmt.Sprintf("You don't see %q here.", req.GetTarget())), nil
+		}
+		if inst.PuppetedBy != "" && inst.PuppetedBy != uid {
+			return errorEvent(fmt.Sprintf("Someone else is already controlling %s.", inst.Name())), nil
+		}
+		s.releasePuppet(sess)
+		inst.PuppetedBy = uid
+		inst.PuppetOrder = ""
+		sess.Puppet = inst.ID
+		return messageEvent(fmt.Sprintf("You take control of %s. Use puppet say, emote, attack, or hold, and puppet off to let go.", inst.Name())), nil
+	case "release":
+		inst := s.puppetOf(sess)
+		if inst == nil {
+			return messageEvent("You aren't controlling an NPC."), nil
+		}
+		s.releasePuppet(sess)
+		return messageEvent(fmt.Sprintf("You release %s.", inst.Name())), nil
+	}
+
+	inst := s.puppetOf(sess)
+	if inst == nil {
+		return errorEvent("You aren't controlling an NPC. Use puppet <npc> first."), nil
+	}
+	switch req.GetAction() {
+	case "say":
+		evt := &gamev1.MessageEvent{Content: fmt.Sprintf("%s says \"%s\"", inst.Name(), req.GetText())}
+		s.broadcastMessage(inst.RoomID, uid, evt)
+		return &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: evt}}, nil
+	case "emote":
+		evt := &gamev1.MessageEvent{Content: fmt.Sprintf("%s %s", inst.Name(), req.GetText())}
+		s.broadcastMessage(inst.RoomID, uid, evt)
+		return &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: evt}}, nil
+	case "attack":
+		if s.combatH != nil && s.combatH.IsInCombat(inst.ID) {
+			inst.PuppetOrder = req.GetTarget()
+			return messageEvent(fmt.Sprintf("%s will attack %s.", inst.Name(), req.GetTarget())), nil
+		}
+		var victim *session.PlayerSession
+		for _, p := range s.sessions.PlayersInRoomDetails(inst.RoomID) {
+			if p.UID != uid && strings.EqualFold(p.CharName, req.GetTarget()) {
+				victim = p
+				break
+			}
+		}
+		if victim == nil || s.combatH == nil {
+			return errorEvent(fmt.Sprintf("%s can only start a fight with a player in its room.", inst.Name())), nil
+		}
+		inst.PuppetOrder = victim.CharName
+		s.combatH.InitiateNPCCombat(inst, victim.UID)
+		return messageEvent(fmt.Sprintf("%s attacks %s.", inst.Name(), victim.CharName)), nil
+	case "hold":
+		inst.PuppetOrder = ""
+		return messageEvent(fmt.Sprintf("%s holds its actions.", inst.Name())), nil
+	}
+	order := "holding"
+	if inst.PuppetOrder != "" {
+		order = "attacking " + inst.PuppetOrder
+	}
+	return messageEvent(fmt.Sprintf("You are controlling %s in %s (%s).", inst.Name(), inst.RoomID, order)), nil
+}
+
+// puppetOf returns the living NPC sess controls.
+//
+// Postcondition: Returns nil, clearing sess.Puppet, when the NPC is gone, dead,
+// or no longer controlled by sess.
+func (s *GameServiceServer) puppetOf(sess *session.PlayerSession) *npc.Instance {
+	if sess.Puppet == "" {
+		return nil
+	}
+	inst, ok := s.npcMgr.Get(sess.Puppet)
+	if !ok || inst.IsDead() || inst.PuppetedBy != sess.UID {
+		sess.Puppet = ""
+		return nil
+	}
+	return inst
+}
+
+// releasePuppet hands sess's NPC back to its own AI.
+//
+// Postcondition: sess.Puppet is empty.
+func (s *GameServiceServer) releasePuppet(sess *session.PlayerSession) {
+	if sess.Puppet == "" {
+		return
+	}
+	if inst, ok := s.npcMgr.Get(sess.Puppet); ok && inst.PuppetedBy == sess.UID {
+		inst.PuppetedBy = ""
+		inst.PuppetOrder = ""
+	}
+	sess.Puppet = ""
+}
@@ -0,0 +1,107 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newPuppetTestServer builds a server with an editor, a player, and a ganger
+// in room_a.
+func newPuppetTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *session.PlayerSession, *npc.Instance) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcMgr := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcMgr)
+	inst, err := npcMgr.Spawn(&npc.Template{ID: "ganger", Name: "Ganger", Level: 1, MaxHP: 20, AC: 12}, "room_a")
+	require.NoError(t, err)
+	editor, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "ed_u1", Username: "ed", CharName: "Maker", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "editor",
+	})
+	require.NoError(t, err)
+	player, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "pc_u1", Username: "pc", CharName: "Vex", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, editor, player, inst
+}
+
+func puppetText(t *testing.T, svc *GameServiceServer, uid string, req *gamev1.PuppetRequest) string {
+	t.Helper()
+	evt, err := svc.handlePuppet(uid, req)
+	require.NoError(t, err)
+	return trapEventText(evt)
+}
+
+func TestPuppet_ControlSpeakAndRelease(t *testing.T) {
+	svc, editor, player, inst := newPuppetTestServer(t)
+
+	assert.Contains(t, puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "say", Text: "hi"}), "You aren't controlling an NPC.")
+	assert.Contains(t, puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "control", Target: "gan"}), "You take control of Ganger.")
+	assert.Equal(t, editor.UID, inst.PuppetedBy)
+	assert.Equal(t, inst.ID, editor.Puppet)
+
+	assert.Equal(t, `Ganger says "Pay up."`, puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "say", Text: "Pay up."}))
+	puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "emote", Text: "cracks his knuckles."})
+	heard := pushedText(t, player)
+	assert.Contains(t, heard, `Ganger says "Pay up."`)
+	assert.Contains(t, heard, "Ganger cracks his knuckles.")
+
+	assert.Contains(t, puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "status"}), "You are controlling Ganger in room_a (holding).")
+	assert.Equal(t, "You release Ganger.", puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "release"}))
+	assert.Empty(t, inst.PuppetedBy)
+	assert.Empty(t, editor.Puppet)
+}
+
+func TestPuppet_OneControllerAtATime(t *testing.T) {
+	svc, editor, _, inst := newPuppetTestServer(t)
+	other, err := svc.sessions.AddPlayer(session.AddPlayerOptions{
+		UID: "ed_u2", Username: "ed2", CharName: "Shaper", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "editor",
+	})
+	require.NoError(t, err)
+
+	puppetText(t, svc, editor.UID, &gamev1.PuppetRequest{Action: "control", Target: "Ganger"})
+	assert.Contains(t, puppetText(t, svc, other.UID, &gamev1.PuppetRequest{Action: "control", Target: "Ganger"}), "Someone else is already controlling Ganger.")
+
+	svc.cleanupPlayer(editor.UID, editor.Username, editor.Entity)
+	assert.Empty(t, inst.PuppetedBy, "logging out lets the NPC go")
+}
+
+func TestPuppet_CombatOrdersOverrideAI(t *testing.T) {
+	h := makeAbilityCombatHandler(t, func(_ string, _ []*gamev1.CombatEvent) {}, nil)
+	const roomID = "room-puppet"
+	inst := spawnAbilityTestNPC(t, h.npcMgr, roomID, nil)
+	addTestPlayer(t, h.sessions, "p1", roomID)
+	addTestPlayer(t, h.sessions, "p2", roomID)
+	npcCbt := &combat.Combatant{ID: inst.ID, Name: inst.Name(), Kind: combat.KindNPC, CurrentHP: 20, MaxHP: 20, AC: 13, Level: 1, Initiative: 5}
+	cbt := startAbilityCombat(t, h, roomID, []*combat.Combatant{
+		npcCbt,
+		{ID: "p1", Name: "Alpha", Kind: combat.KindPlayer, CurrentHP: 10, MaxHP: 10, AC: 14, Level: 1, Initiative: 10},
+		{ID: "p2", Name: "Bravo", Kind: combat.KindPlayer, CurrentHP: 10, MaxHP: 10, AC: 14, Level: 1, Initiative: 9},
+	})
+	queued := func() []combat.QueuedAction {
+		_ = cbt.StartRound(3)
+		h.combatMu.Lock()
+		h.autoQueueNPCsLocked(cbt)
+		h.combatMu.Unlock()
+		return cbt.ActionQueues[inst.ID].QueuedActions()
+	}
+
+	inst.PuppetedBy = "ed_u1"
+	assert.Empty(t, queued(), "a puppet with no order holds")
+
+	inst.PuppetOrder = "bravo"
+	actions := queued()
+	require.Len(t, actions, 1)
+	assert.Equal(t, combat.ActionAttack, actions[0].Type)
+	assert.Equal(t, "Bravo", actions[0].Target)
+}
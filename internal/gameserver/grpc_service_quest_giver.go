@@ -94,7 +94,7 @@ func (s *GameServiceServer) handleTalkAccept(uid, questID string) (*gamev1.Serve
 	if !ok {
 		return messageEvent("player not found"), nil
 	}
-	title, objDescs, err := s.questSvc.Accept(context.Background(), sess, sess.CharacterID, questID)
+	title, objDescs, err := s.questSvc.Accept(s.commandCtx(uid), sess, sess.CharacterID, questID)
 	if err != nil {
 		return messageEvent(fmt.Sprintf("Cannot accept quest: %v", err)), nil
 	}
@@ -177,12 +177,17 @@ func (s *GameServiceServer) handleTalk(uid string, req *gamev1.TalkRequest) (*ga
 					removed += take
 				}
 				if removed > 0 {
-					_, _ = s.questSvc.RecordDeliver(context.Background(), sess, sess.CharacterID, qid, obj.ID)
+					_, _ = s.questSvc.RecordDeliver(s.commandCtx(uid), sess, sess.CharacterID, qid, obj.ID)
 				}
 			}
 		}
 	}
 
+	// Hand over prisoners wanted by this giver's capture objectives.
+	for _, msg := range s.handOverPrisoners(uid, sess, inst) {
+		s.pushMessageToUID(uid, msg)
+	}
+
 	// Complete quests for this giver where all objectives are satisfied.
 	// This handles kill/fetch/explore quests whose auto-completion at event time failed,
 	// as well as quest designs that require turn-in at the NPC giver.
@@ -208,7 +213,7 @@ func (s *GameServiceServer) handleTalk(uid string, req *gamev1.TalkRequest) (*ga
 		anyCompleted := false
 		for _, qid := range readyIDs {
 			def := reg[qid]
-			msgs, err := s.questSvc.Complete(context.Background(), sess, sess.CharacterID, qid)
+			msgs, err := s.questSvc.Complete(s.commandCtx(uid), sess, sess.CharacterID, qid)
 			if err != nil {
 				s.logger.Warn("handleTalk: quest completion failed",
 					zap.String("uid", uid),
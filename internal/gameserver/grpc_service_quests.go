@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -39,7 +38,7 @@ func (s *GameServiceServer) handleQuestCommand(uid, args string) (*gamev1.Server
 		}
 		questID := parts[1]
 		confirm := len(parts) >= 3 && parts[2] == "confirm"
-		msg, err := s.questSvc.Abandon(context.Background(), sess, sess.CharacterID, questID, confirm)
+		msg, err := s.questSvc.Abandon(s.commandCtx(uid), sess, sess.CharacterID, questID, confirm)
 		if err != nil {
 			return messageEvent(fmt.Sprintf("Error: %v", err)), nil
 		}
@@ -0,0 +1,223 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/raid"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// activeRaid is a raid under way: the wave currently attacking, the NPC
+// instances still standing from it, and when it must be cleared by.
+type activeRaid struct {
+	def      *raid.Def
+	wave     int
+	npcIDs   []string
+	deadline time.Time
+}
+
+// InitRaids loads the raid definitions in path. An empty path disables raids.
+//
+// Precondition: The world's NPCs have spawned; raid templates are looked up
+// among the templates they registered.
+// Postcondition: Returns an error when the file cannot be read, a raid is
+// invalid, or a raid names an unknown room, faction, or NPC template.
+func (s *GameServiceServer) InitRaids(path string) error {
+	var defs []*raid.Def
+	if path != "" {
+		var err error
+		if defs, err = raid.Load(path); err != nil {
+			return err
+		}
+	}
+	byID := make(map[string]*raid.Def, len(defs))
+	for _, d := range defs {
+		if _, ok := s.world.GetRoom(d.Room); !ok {
+			return fmt.Errorf("raid %q: unknown room %q", d.ID, d.Room)
+		}
+		if s.factionRegistry != nil {
+			if _, ok := (*s.factionRegistry)[d.Faction]; !ok {
+				return fmt.Errorf("raid %q: unknown faction %q", d.ID, d.Faction)
+			}
+		}
+		for i, w := range d.Waves {
+			for _, sp := range w.NPCs {
+				if s.npcMgr == nil || s.npcMgr.TemplateByID(sp.Template) == nil {
+					return fmt.Errorf("raid %q: wave %d: unknown npc template %q", d.ID, i+1, sp.Template)
+				}
+			}
+		}
+		byID[d.ID] = d
+	}
+	s.raidMu.Lock()
+	defer s.raidMu.Unlock()
+	s.raids = byID
+	s.activeRaids = make(map[string]*activeRaid)
+	return nil
+}
+
+// startRaid sends the first wave of raid id against its stronghold.
+//
+// Postcondition: Returns an error when id is unknown or the raid is already
+// under way; otherwise the first wave has spawned.
+func (s *GameServiceServer) startRaid(id string, now time.Time) error {
+	s.raidMu.Lock()
+	defer s.raidMu.Unlock()
+	def, ok := s.raids[id]
+	if !ok {
+		return fmt.Errorf("unknown raid %q", id)
+	}
+	if _, running := s.activeRaids[id]; running {
+		return fmt.Errorf("raid %q is already under way", id)
+	}
+	ar := &activeRaid{def: def}
+	s.activeRaids[id] = ar
+	s.logger.Info("raid started", zap.String("raid", id), zap.String("room", def.Room))
+	s.spawnRaidWaveLocked(ar, now)
+	return nil
+}
+
+// spawnRaidWaveLocked spawns ar's current wave in the stronghold and warns
+// the zone.
+//
+// Precondition: s.raidMu is held; ar.wave indexes ar.def.Waves.
+// Postcondition: ar.npcIDs holds the spawned instances; the wave must be
+// cleared within the raid's wave time limit.
+func (s *GameServiceServer) spawnRaidWaveLocked(ar *activeRaid, now time.Time) {
+	w := ar.def.Waves[ar.wave]
+	ar.npcIDs = ar.npcIDs[:0]
+	for _, sp := range w.NPCs {
+		tmpl := s.npcMgr.TemplateByID(sp.Template)
+		if tmpl == nil {
+			continue
+		}
+		for i := 0; i < sp.Count; i++ {
+			inst, err := s.npcMgr.Spawn(tmpl, ar.def.Room)
+			if err != nil {
+				s.logger.Warn("raid spawn failed", zap.String("raid", ar.def.ID), zap.String("template", sp.Template), zap.Error(err))
+				continue
+			}
+			ar.npcIDs = append(ar.npcIDs, inst.ID)
+		}
+	}
+	ar.deadline = now.Add(ar.def.WaveTimeLimit())
+	msg := w.Message
+	if msg == "" {
+		msg = fmt.Sprintf("%s: wave %d of %d is assaulting the stronghold!", raidName(ar.def), ar.wave+1, len(ar.def.Waves))
+	}
+	if room, ok := s.world.GetRoom(ar.def.Room); ok {
+		s.messageZone(room.ZoneID, msg)
+	}
+	s.pushRoomViewToAllInRoom(ar.def.Room)
+}
+
+// tickRaids advances every raid under way. A wave with no attackers left is
+// repelled: the defenders are rewarded and the next wave spawns, or the raid
+// ends in victory after the last. A wave still standing at its deadline ends
+// the raid in defeat.
+//
+// Postcondition: Finished raids are no longer active.
+func (s *GameServiceServer) tickRaids(now time.Time) {
+	s.raidMu.Lock()
+	defer s.raidMu.Unlock()
+	for id, ar := range s.activeRaids {
+		standing := ar.npcIDs[:0]
+		for _, npcID := range ar.npcIDs {
+			if inst, ok := s.npcMgr.Get(npcID); ok && !inst.IsDead() {
+				standing = append(standing, npcID)
+			}
+		}
+		ar.npcIDs = standing
+		zoneID := ""
+		if room, ok := s.world.GetRoom(ar.def.Room); ok {
+			zoneID = room.ZoneID
+		}
+		switch {
+		case len(ar.npcIDs) == 0:
+			s.rewardRaidDefenders(ar)
+			ar.wave++
+			if ar.wave < len(ar.def.Waves) {
+				s.spawnRaidWaveLocked(ar, now)
+				continue
+			}
+			delete(s.activeRaids, id)
+			msg := ar.def.Victory
+			if msg == "" {
+				msg = fmt.Sprintf("%s has been repelled. The stronghold holds!", raidName(ar.def))
+			}
+			s.messageZone(zoneID, msg)
+			s.logger.Info("raid repelled", zap.String("raid", id))
+		case !now.Before(ar.deadline):
+			delete(s.activeRaids, id)
+			s.withdrawRaiders(ar)
+			msg := ar.def.Defeat
+			if msg == "" {
+				msg = fmt.Sprintf("%s has overrun the stronghold before pulling back.", raidName(ar.def))
+			}
+			s.messageZone(zoneID, msg)
+			s.logger.Info("raid failed", zap.String("raid", id), zap.Int("wave", ar.wave+1))
+		}
+	}
+}
+
+// rewardRaidDefenders pays every defender in the stronghold for repelling a
+// wave. Players whose faction is hostile to the defending faction are not
+// defenders.
+//
+// Postcondition: Each defender gains the raid's reputation with its faction
+// and its credits, and is told so.
+func (s *GameServiceServer) rewardRaidDefenders(ar *activeRaid) {
+	ctx := context.Background()
+	for _, sess := range s.sessions.PlayersInRoomDetails(ar.def.Room) {
+		if s.factionSvc != nil && s.factionSvc.IsEnemyOf(sess, ar.def.Faction) {
+			continue
+		}
+		msg := fmt.Sprintf("You helped repel wave %d of %s.", ar.wave+1, raidName(ar.def))
+		if ar.def.RepPerWave > 0 && s.factionSvc != nil {
+			tierMsg, err := s.factionSvc.AwardRep(ctx, sess, sess.CharacterID, ar.def.Faction, ar.def.RepPerWave)
+			if err != nil {
+				s.logger.Warn("raid: awarding reputation", zap.String("raid", ar.def.ID), zap.Error(err))
+			} else {
+				msg += fmt.Sprintf(" +%d reputation.", ar.def.RepPerWave)
+				if tierMsg != "" {
+					msg += "\n" + tierMsg
+				}
+			}
+		}
+		if ar.def.CreditsPerWave > 0 {
+			sess.Currency += ar.def.CreditsPerWave
+			s.emitCurrency(sess, ar.def.CreditsPerWave, telemetry.SourceRaid)
+			if s.charSaver != nil && sess.CharacterID > 0 {
+				if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
+					s.logger.Warn("raid: saving currency", zap.String("raid", ar.def.ID), zap.Error(err))
+				}
+			}
+			msg += fmt.Sprintf(" You earn %d credits.", ar.def.CreditsPerWave)
+		}
+		s.pushMessageToUID(sess.UID, msg)
+	}
+}
+
+// withdrawRaiders removes the attackers of ar's wave that are not mid-fight;
+// raiders in combat stay until the fight ends. Raiders never respawn.
+func (s *GameServiceServer) withdrawRaiders(ar *activeRaid) {
+	for _, npcID := range ar.npcIDs {
+		if s.combatH != nil && s.combatH.IsInCombat(npcID) {
+			continue
+		}
+		_ = s.npcMgr.Remove(npcID)
+	}
+	s.pushRoomViewToAllInRoom(ar.def.Room)
+}
+
+// raidName returns d's display name, falling back to its ID.
+func raidName(d *raid.Def) string {
+	if d.Name != "" {
+		return d.Name
+	}
+	return d.ID
+}
@@ -0,0 +1,117 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+const testRaidYAML = `raids:
+  - id: siege
+    name: The Siege
+    faction: gun
+    room: room_a
+    time_limit: 5m
+    credits_per_wave: 30
+    waves:
+      - message: Raiders at the gate!
+        npcs:
+          - template: raider
+            count: 2
+      - npcs:
+          - template: raider
+            count: 1
+    victory: The siege is broken.
+    defeat: The raiders sack the fort.
+`
+
+// testRaidService returns a service with testRaidYAML loaded and one
+// defender, u1, in room_a holding 100 credits.
+func testRaidService(t *testing.T) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcMgr := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcMgr)
+	tmpl := &npc.Template{ID: "raider", Name: "Raider", Level: 1, MaxHP: 10, AC: 10}
+	seed, err := npcMgr.Spawn(tmpl, "room_b")
+	require.NoError(t, err)
+	require.NoError(t, npcMgr.Remove(seed.ID))
+
+	path := filepath.Join(t.TempDir(), "raids.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testRaidYAML), 0o600))
+	require.NoError(t, svc.InitRaids(path))
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{UID: "u1", Username: "u", CharName: "Vex", RoomID: "room_a", Role: "player"})
+	require.NoError(t, err)
+	sess.Currency = 100
+	return svc, sess
+}
+
+// killRaiders removes every NPC in room, as if the defenders had killed them.
+func killRaiders(t *testing.T, svc *GameServiceServer, room string) {
+	t.Helper()
+	for _, inst := range svc.npcMgr.InstancesInRoom(room) {
+		require.NoError(t, svc.npcMgr.Remove(inst.ID))
+	}
+}
+
+func TestInitRaids_RejectsUnknownRoomAndTemplate(t *testing.T) {
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	path := filepath.Join(t.TempDir(), "raids.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testRaidYAML), 0o600))
+	assert.ErrorContains(t, svc.InitRaids(path), "unknown npc template")
+
+	require.NoError(t, os.WriteFile(path, []byte("raids:\n  - {id: a, faction: gun, room: nowhere, waves: [{npcs: [{template: raider, count: 1}]}]}\n"), 0o600))
+	assert.ErrorContains(t, svc.InitRaids(path), "unknown room")
+}
+
+func TestRaid_WavesRepelledRewardDefenders(t *testing.T) {
+	svc, sess := testRaidService(t)
+	now := time.Now()
+
+	require.NoError(t, svc.startRaid("siege", now))
+	assert.Len(t, svc.npcMgr.InstancesInRoom("room_a"), 2)
+	assert.Contains(t, pushedText(t, sess), "Raiders at the gate!")
+	assert.Error(t, svc.startRaid("siege", now), "a raid under way cannot restart")
+
+	svc.tickRaids(now)
+	assert.Equal(t, 100, sess.Currency, "no reward while raiders stand")
+
+	killRaiders(t, svc, "room_a")
+	svc.tickRaids(now)
+	assert.Equal(t, 130, sess.Currency)
+	assert.Len(t, svc.npcMgr.InstancesInRoom("room_a"), 1, "second wave arrives")
+	text := pushedText(t, sess)
+	assert.Contains(t, text, "You helped repel wave 1 of The Siege.")
+	assert.Contains(t, text, "wave 2 of 2")
+
+	killRaiders(t, svc, "room_a")
+	svc.tickRaids(now)
+	assert.Equal(t, 160, sess.Currency)
+	assert.Contains(t, pushedText(t, sess), "The siege is broken.")
+	assert.Empty(t, svc.activeRaids)
+}
+
+func TestRaid_WaveOutlastingDeadlineEndsRaid(t *testing.T) {
+	svc, sess := testRaidService(t)
+	now := time.Now()
+
+	require.NoError(t, svc.startRaid("siege", now))
+	svc.tickRaids(now.Add(5 * time.Minute))
+	assert.Contains(t, pushedText(t, sess), "The raiders sack the fort.")
+	assert.Empty(t, svc.npcMgr.InstancesInRoom("room_a"), "raiders withdraw")
+	assert.Equal(t, 100, sess.Currency)
+	assert.Empty(t, svc.activeRaids)
+}
+
+func TestStartRaid_Unknown(t *testing.T) {
+	svc, _ := testRaidService(t)
+	assert.ErrorContains(t, svc.startRaid("nope", time.Now()), "unknown raid")
+}
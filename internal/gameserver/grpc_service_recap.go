@@ -0,0 +1,24 @@
+package gameserver
+
+import (
+	"fmt"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleRecap re-shows the statistics recap of the player's last finished
+// encounter.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when
+// the session is missing.
+func (s *GameServiceServer) handleRecap(uid string, _ *gamev1.RecapRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if sess.LastRecap == "" {
+		return messageEvent("You have no finished fight to recap."), nil
+	}
+	return messageEvent(sess.LastRecap), nil
+}
@@ -0,0 +1,31 @@
+package gameserver
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// errCommandPanicked is reported to a player whose command panicked.
+var errCommandPanicked = errors.New("something went wrong handling that command")
+
+// dispatchSafely runs dispatch, converting a handler panic into an error so
+// one faulty command does not end the player's session.
+//
+// Postcondition: A panic is logged with its stack and reported as errCommandPanicked.
+func (s *GameServiceServer) dispatchSafely(uid string, msg *gamev1.ClientMessage) (evt *gamev1.ServerEvent, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("panic handling command",
+				zap.String("uid", uid),
+				zap.String("request_id", msg.GetRequestId()),
+				zap.Any("panic", r),
+				zap.Stack("stack"),
+			)
+			evt, err = nil, errCommandPanicked
+		}
+	}()
+	return s.dispatch(uid, msg)
+}
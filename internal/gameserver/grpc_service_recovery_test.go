@@ -0,0 +1,31 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestDispatchSafely_RecoversHandlerPanic(t *testing.T) {
+	core, logs := observer.New(zap.ErrorLevel)
+	// A server without a session manager panics as soon as dispatch looks up the player.
+	s := &GameServiceServer{logger: zap.New(core)}
+	msg := &gamev1.ClientMessage{RequestId: "r1", Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}}
+
+	var (
+		evt *gamev1.ServerEvent
+		err error
+	)
+	assert.NotPanics(t, func() { evt, err = s.dispatchSafely("u1", msg) })
+	assert.Nil(t, evt)
+	assert.ErrorIs(t, err, errCommandPanicked)
+	if assert.Equal(t, 1, logs.Len()) {
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, "u1", fields["uid"])
+		assert.Equal(t, "r1", fields["request_id"])
+	}
+}
@@ -1,7 +1,6 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -114,7 +113,7 @@ func (s *GameServiceServer) handleRelease(uid string, req *gamev1.ReleaseRequest
 
 		// Persist cleared detention.
 		if s.detainedUntilRepo != nil {
-			ctx := context.Background()
+			ctx := s.commandCtx(uid)
 			if err := s.detainedUntilRepo.UpdateDetainedUntil(ctx, target.CharacterID, nil); err != nil {
 				s.logger.Warn("handleRelease: UpdateDetainedUntil(nil) failed",
 					zap.String("uid", uid),
@@ -0,0 +1,110 @@
+package gameserver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/observability"
+)
+
+// configReload holds what ReloadConfig needs to re-read and apply the config.
+type configReload struct {
+	// mu serializes reloads and guards cfg.
+	mu sync.Mutex
+	// cfg is the config most recently applied.
+	cfg config.Config
+	// load re-reads and validates the config file; nil disables reloads.
+	load  func() (config.Config, error)
+	level zap.AtomicLevel
+	ticks *ZoneTickManager
+}
+
+// SetConfigReload enables ReloadConfig. cfg is the config the server started
+// with, load re-reads and validates the config file, level is the logger's
+// level, and ticks runs the zone AI.
+//
+// Precondition: load must not be nil; MUST be called before ReloadConfig.
+func (s *GameServiceServer) SetConfigReload(cfg config.Config, load func() (config.Config, error), level zap.AtomicLevel, ticks *ZoneTickManager) {
+	s.reload.mu.Lock()
+	defer s.reload.mu.Unlock()
+	s.reload.cfg = cfg
+	s.reload.load = load
+	s.reload.level = level
+	s.reload.ticks = ticks
+}
+
+// ReloadConfig re-reads the config file and applies the values that are safe
+// to change while running: the combat round duration, the AI tick interval,
+// the log level, and the feature flag defaults. Every changed value is logged
+// with by, the operator or signal that asked for the reload.
+//
+// Postcondition: Returns an error, and applies nothing, when the file fails
+// to load or validate; otherwise returns every value that changed since the
+// last reload, including those that need a restart to take effect.
+func (s *GameServiceServer) ReloadConfig(by string) ([]config.Change, error) {
+	r := &s.reload
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.load == nil {
+		return nil, errors.New("config reload is not enabled")
+	}
+	cfg, err := r.load()
+	if err != nil {
+		s.logger.Warn("config reload rejected", zap.String("by", by), zap.Error(err))
+		return nil, fmt.Errorf("reloading config: %w", err)
+	}
+
+	if s.combatH != nil {
+		s.combatH.SetRoundDuration(roundDuration(RoundDurationMs(cfg.GameServer.RoundDurationMs)))
+	}
+	if r.ticks != nil {
+		r.ticks.SetInterval(cfg.GameServer.AITickInterval)
+	}
+	if level, err := zapcore.ParseLevel(cfg.Logging.Level); err == nil {
+		r.level.SetLevel(level)
+	}
+	if s.featureFlags != nil {
+		s.featureFlags.SetDefaults(cfg.Flags)
+	}
+
+	changes := config.Diff(r.cfg, cfg)
+	r.cfg = cfg
+	observability.LogConfigChanges(s.logger, changes, by)
+	return changes, nil
+}
+
+// handleReloadConfig reloads the config for an admin and lists what changed.
+func (s *GameServiceServer) handleReloadConfig(uid string, _ *gamev1.ReloadConfigRequest) (*gamev1.ServerEvent, error) {
+	by := uid
+	if sess, ok := s.sessions.GetPlayer(uid); ok && sess.Username != "" {
+		by = sess.Username
+	}
+	changes, err := s.ReloadConfig(by)
+	if err != nil {
+		return errorEvent(fmt.Sprintf("Config reload failed: %v", err)), nil
+	}
+	return messageEvent(describeConfigChanges(changes)), nil
+}
+
+// describeConfigChanges renders the reply to a reloadconfig command.
+func describeConfigChanges(changes []config.Change) string {
+	if len(changes) == 0 {
+		return "Config reloaded; no values changed."
+	}
+	var b strings.Builder
+	b.WriteString("Config reloaded:")
+	for _, c := range changes {
+		fmt.Fprintf(&b, "\n  %s: %s -> %s", c.Key, c.Old, c.New)
+		if !c.Reloadable {
+			b.WriteString(" (restart required)")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,105 @@
+package gameserver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newReloadTestServer returns a server whose config file "contains" *next.
+func newReloadTestServer(start config.Config, next *config.Config, loadErr *error) (*GameServiceServer, zap.AtomicLevel, *ZoneTickManager) {
+	s := &GameServiceServer{
+		logger:   zap.NewNop(),
+		sessions: session.NewManager(),
+		combatH:  NewCombatHandler(nil, nil, nil, nil, nil, 6*time.Second, nil, nil, nil, nil, nil, nil, nil, nil, nil),
+	}
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	ticks := NewZoneTickManager(start.GameServer.AITickInterval)
+	s.SetConfigReload(start, func() (config.Config, error) {
+		return *next, *loadErr
+	}, level, ticks)
+	return s, level, ticks
+}
+
+func reloadTestConfig() config.Config {
+	return config.Config{
+		Logging:    config.LoggingConfig{Level: "info", Format: "json"},
+		GameServer: config.GameServerConfig{GRPCPort: 50051, RoundDurationMs: 6000, AITickInterval: 10 * time.Second},
+	}
+}
+
+func TestReloadConfig_AppliesSafeValues(t *testing.T) {
+	start := reloadTestConfig()
+	next := start
+	var loadErr error
+	s, level, ticks := newReloadTestServer(start, &next, &loadErr)
+
+	next.GameServer.RoundDurationMs = 4000
+	next.GameServer.AITickInterval = 3 * time.Second
+	next.GameServer.GRPCPort = 6000
+	next.Logging.Level = "debug"
+	changes, err := s.ReloadConfig("tester")
+	require.NoError(t, err)
+
+	assert.Equal(t, 4*time.Second, s.combatH.RoundDuration())
+	assert.Equal(t, 3*time.Second, ticks.Interval())
+	assert.Equal(t, zapcore.DebugLevel, level.Level())
+	require.Len(t, changes, 4)
+	assert.Equal(t, config.Change{Key: "gameserver.grpc_port", Old: "50051", New: "6000"}, changes[1])
+
+	changes, err = s.ReloadConfig("tester")
+	require.NoError(t, err)
+	assert.Empty(t, changes, "changes are reported against the last reload")
+}
+
+func TestReloadConfig_InvalidFileAppliesNothing(t *testing.T) {
+	start := reloadTestConfig()
+	next := start
+	next.GameServer.RoundDurationMs = 1000
+	loadErr := errors.New("gameserver.ai_tick_interval must be positive")
+	s, _, ticks := newReloadTestServer(start, &next, &loadErr)
+
+	_, err := s.ReloadConfig("tester")
+	require.Error(t, err)
+	assert.Equal(t, 6*time.Second, s.combatH.RoundDuration())
+	assert.Equal(t, 10*time.Second, ticks.Interval())
+}
+
+func TestReloadConfig_NotEnabled(t *testing.T) {
+	s := &GameServiceServer{logger: zap.NewNop()}
+	_, err := s.ReloadConfig("tester")
+	assert.Error(t, err)
+}
+
+func TestHandleReloadConfig_ListsChanges(t *testing.T) {
+	start := reloadTestConfig()
+	next := start
+	var loadErr error
+	s, _, _ := newReloadTestServer(start, &next, &loadErr)
+
+	evt, err := s.handleReloadConfig("u1", &gamev1.ReloadConfigRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "Config reloaded; no values changed.", evt.GetMessage().GetContent())
+
+	next.GameServer.AITickInterval = 5 * time.Second
+	next.GameServer.GRPCPort = 6000
+	evt, err = s.handleReloadConfig("u1", &gamev1.ReloadConfigRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "Config reloaded:\n"+
+		"  gameserver.ai_tick_interval: 10s -> 5s\n"+
+		"  gameserver.grpc_port: 50051 -> 6000 (restart required)", evt.GetMessage().GetContent())
+
+	loadErr = errors.New("bad yaml")
+	evt, err = s.handleReloadConfig("u1", &gamev1.ReloadConfigRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "bad yaml")
+}
@@ -0,0 +1,101 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// Character names follow the creation rule of 2-32 characters.
+const (
+	minCharacterNameLen = 2
+	maxCharacterNameLen = 32
+)
+
+// CharacterRenamer looks up and renames characters.
+//
+// Precondition: name arguments must be non-empty; id must be > 0.
+type CharacterRenamer interface {
+	GetIDByName(ctx context.Context, name string) (int64, error)
+	Rename(ctx context.Context, id int64, name string) error
+}
+
+// handleRename renames a character, online or offline.
+//
+// Precondition: uid must identify an active session authorized for
+// permission.CanRenameCharacters; req must be non-nil.
+// Postcondition: On success the character row is renamed and, when the
+// character is online, its session CharName is updated and the player is
+// told, and the character's clan roster entry and lease owner name follow
+// the new name. An invalid new name, a target in combat, an unknown target,
+// or a name already in use leaves everything unchanged and reports why.
+func (s *GameServiceServer) handleRename(uid string, req *gamev1.RenameRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.characterRenamer == nil {
+		return errorEvent("Character renaming is not available."), nil
+	}
+	oldName, newName := req.GetTarget(), strings.TrimSpace(req.GetNewName())
+	if len(newName) < minCharacterNameLen || len(newName) > maxCharacterNameLen {
+		return errorEvent(fmt.Sprintf("Name must be %d-%d characters.", minCharacterNameLen, maxCharacterNameLen)), nil
+	}
+	ctx := s.commandCtx(uid)
+
+	target := s.sessions.GetPlayerByCharNameCI(oldName)
+	if target != nil && target.Status == statusInCombat {
+		return errorEvent(fmt.Sprintf("%s is in combat; try again once the fight is over.", target.CharName)), nil
+	}
+	if other := s.sessions.GetPlayerByCharNameCI(newName); other != nil && other != target {
+		return errorEvent(fmt.Sprintf("The name %q is already taken.", newName)), nil
+	}
+
+	var charID int64
+	if target != nil {
+		oldName = target.CharName
+		charID = target.CharacterID
+	} else {
+		id, err := s.characterRenamer.GetIDByName(ctx, oldName)
+		if errors.Is(err, postgres.ErrCharacterNotFound) {
+			return errorEvent(fmt.Sprintf("No character named %q.", oldName)), nil
+		}
+		if err != nil {
+			s.logger.Warn("handleRename: looking up character failed", zap.String("target", oldName), zap.Error(err))
+			return errorEvent("Failed to rename character. Please try again."), nil
+		}
+		charID = id
+	}
+
+	if err := s.characterRenamer.Rename(ctx, charID, newName); err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrCharacterNameTaken):
+			return errorEvent(fmt.Sprintf("The name %q is already taken.", newName)), nil
+		case errors.Is(err, postgres.ErrCharacterNotFound):
+			return errorEvent(fmt.Sprintf("No character named %q.", oldName)), nil
+		}
+		s.logger.Warn("handleRename: renaming character failed", zap.Int64("character_id", charID), zap.Error(err))
+		return errorEvent("Failed to rename character. Please try again."), nil
+	}
+
+	if s.clans != nil {
+		s.clans.RenameMember(charID, newName)
+	}
+	if s.housing != nil {
+		s.housing.RenameOwner(charID, newName)
+	}
+	if target != nil {
+		if err := s.sessions.RenamePlayer(target.UID, newName); err != nil {
+			// The row is already renamed; the session catches up at next login.
+			s.logger.Warn("handleRename: updating session name failed", zap.String("uid", target.UID), zap.Error(err))
+		} else {
+			s.pushMessageToUID(target.UID, fmt.Sprintf("Your character has been renamed to %s.", newName))
+		}
+	}
+	return messageEvent(fmt.Sprintf("Renamed %s to %s.", oldName, newName)), nil
+}
@@ -0,0 +1,140 @@
+package gameserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// fakeRenamer is an in-memory CharacterRenamer keyed by character ID.
+type fakeRenamer struct {
+	names map[int64]string
+}
+
+func (f *fakeRenamer) GetIDByName(_ context.Context, name string) (int64, error) {
+	for id, n := range f.names {
+		if strings.EqualFold(n, name) {
+			return id, nil
+		}
+	}
+	return 0, postgres.ErrCharacterNotFound
+}
+
+func (f *fakeRenamer) Rename(_ context.Context, id int64, name string) error {
+	if _, ok := f.names[id]; !ok {
+		return postgres.ErrCharacterNotFound
+	}
+	for other, n := range f.names {
+		if other != id && strings.EqualFold(n, name) {
+			return postgres.ErrCharacterNameTaken
+		}
+	}
+	f.names[id] = name
+	return nil
+}
+
+func newRenameTestService(t *testing.T) (*GameServiceServer, *fakeRenamer) {
+	t.Helper()
+	svc := testServiceForCombatDefault(t, nil)
+	renamer := &fakeRenamer{names: map[int64]string{1: "admin", 2: "target", 3: "Offline"}}
+	svc.characterRenamer = renamer
+	admin := addPlayerForCombatDefault(t, svc, "admin", 1)
+	admin.Role = postgres.RoleAdmin
+	return svc, renamer
+}
+
+func TestHandleRename_RequiresCapability(t *testing.T) {
+	svc, renamer := newRenameTestService(t)
+	addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.dispatch("target", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Rename{Rename: &gamev1.RenameRequest{Target: "Offline", NewName: "Hacked"}}})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "permission denied")
+	assert.Equal(t, "Offline", renamer.names[3])
+}
+
+func TestHandleRename_OnlineUpdatesSession(t *testing.T) {
+	svc, renamer := newRenameTestService(t)
+	target := addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.handleRename("admin", &gamev1.RenameRequest{Target: "TARGET", NewName: "Vex"})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed target to Vex.", evt.GetMessage().GetContent())
+	assert.Equal(t, "Vex", renamer.names[2])
+	assert.Equal(t, "Vex", target.CharName)
+	assert.Same(t, target, svc.sessions.GetPlayerByCharNameCI("vex"))
+}
+
+func TestHandleRename_Offline(t *testing.T) {
+	svc, renamer := newRenameTestService(t)
+
+	evt, err := svc.handleRename("admin", &gamev1.RenameRequest{Target: "offline", NewName: "Online"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "to Online")
+	assert.Equal(t, "Online", renamer.names[3])
+}
+
+func TestHandleRename_Refusals(t *testing.T) {
+	svc, renamer := newRenameTestService(t)
+	target := addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.handleRename("admin", &gamev1.RenameRequest{Target: "nobody", NewName: "Ghost"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "No character")
+
+	evt, err = svc.handleRename("admin", &gamev1.RenameRequest{Target: "Offline", NewName: "TARGET"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "already taken")
+
+	target.Status = statusInCombat
+	evt, err = svc.handleRename("admin", &gamev1.RenameRequest{Target: "target", NewName: "Vex"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "in combat")
+	assert.Equal(t, "target", renamer.names[2])
+	assert.Equal(t, "target", target.CharName)
+}
+
+func TestHandleRename_RejectsInvalidName(t *testing.T) {
+	svc, renamer := newRenameTestService(t)
+
+	for _, name := range []string{"", "   ", "X", strings.Repeat("a", 33)} {
+		evt, err := svc.handleRename("admin", &gamev1.RenameRequest{Target: "Offline", NewName: name})
+		require.NoError(t, err)
+		assert.Contains(t, evt.GetError().GetMessage(), "2-32 characters", "name %q", name)
+	}
+	assert.Equal(t, "Offline", renamer.names[3])
+}
+
+func TestHandleRename_UpdatesClanAndLease(t *testing.T) {
+	svc, _ := newRenameTestService(t)
+	_, err := svc.clans.Create("Rust Saints", "RUST", 3, "Offline")
+	require.NoError(t, err)
+	_, err = svc.housing.Rent("home_1", 3, "Offline", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = svc.handleRename("admin", &gamev1.RenameRequest{Target: "Offline", NewName: "Wren"})
+	require.NoError(t, err)
+
+	c, ok := svc.clans.ForCharacter(3)
+	require.True(t, ok)
+	assert.Equal(t, "Wren", c.Members[3].Name)
+	l, ok := svc.housing.ForCharacter(3)
+	require.True(t, ok)
+	assert.Equal(t, "Wren", l.OwnerName)
+}
+
+func TestHandleRename_Unavailable(t *testing.T) {
+	svc, _ := newRenameTestService(t)
+	svc.characterRenamer = nil
+
+	evt, err := svc.handleRename("admin", &gamev1.RenameRequest{Target: "target", NewName: "Vex"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not available")
+}
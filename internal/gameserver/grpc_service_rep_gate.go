@@ -0,0 +1,58 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/i18n"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+// factionDisplayName returns the name of factionID, or the ID itself when the
+// faction is not loaded.
+func (s *GameServiceServer) factionDisplayName(factionID string) string {
+	if s.factionRegistry != nil {
+		if def := s.factionRegistry.ByID(factionID); def != nil {
+			return def.Name
+		}
+	}
+	return factionID
+}
+
+// moveRepRefusal returns why sess may not walk dir, checking the reputation
+// gates on the exit and on the room beyond it, or "" when both are met or the
+// exit does not exist.
+//
+// Precondition: sess must be non-nil.
+func (s *GameServiceServer) moveRepRefusal(sess *session.PlayerSession, dir world.Direction) string {
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return ""
+	}
+	exit, ok := room.ExitForDirection(dir)
+	if !ok {
+		return ""
+	}
+	gate := exit.MinRep
+	if gate.Met(sess.FactionRep) {
+		gate = nil
+		if dest, destOK := s.world.GetRoom(exit.TargetRoom); destOK && !dest.MinRep.Met(sess.FactionRep) {
+			gate = dest.MinRep
+		}
+	}
+	if gate == nil {
+		return ""
+	}
+	return s.t(sess, "move.rep_gated", i18n.Args{
+		"need":    gate.MinRep,
+		"faction": s.factionDisplayName(gate.Faction),
+		"have":    sess.FactionRep[gate.Faction],
+	})
+}
+
+// repLockLabel is the short browse annotation for stock locked behind gate.
+//
+// Precondition: gate must be non-nil.
+func (s *GameServiceServer) repLockLabel(gate *world.RepRequirement) string {
+	return fmt.Sprintf("Requires %d rep with %s", gate.MinRep, s.factionDisplayName(gate.Faction))
+}
@@ -0,0 +1,82 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newRepGateMoveServer returns a server with a player in room_a, whose only
+// exit leads north to room_b.
+func newRepGateMoveServer(t *testing.T) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "rep_u1", Username: "rep", CharName: "Rep",
+		RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, sess
+}
+
+func TestHandleMove_ExitRepGate(t *testing.T) {
+	svc, sess := newRepGateMoveServer(t)
+	roomA, _ := svc.world.GetRoom("room_a")
+	roomA.Exits[0].MinRep = &world.RepRequirement{Faction: "gun", MinRep: 50}
+	sess.FactionRep["gun"] = 10
+
+	evt, err := svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Equal(t, "You need 50 reputation with gun to go that way (you have 10).", evt.GetMessage().Content)
+	assert.Equal(t, "room_a", sess.RoomID)
+
+	sess.FactionRep["gun"] = 50
+	_, err = svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Equal(t, "room_b", sess.RoomID)
+}
+
+func TestHandleMove_RoomRepGate(t *testing.T) {
+	svc, sess := newRepGateMoveServer(t)
+	roomB, _ := svc.world.GetRoom("room_b")
+	roomB.MinRep = &world.RepRequirement{Faction: "machete", MinRep: 20}
+
+	evt, err := svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "You need 20 reputation with machete")
+	assert.Equal(t, "room_a", sess.RoomID)
+}
+
+func TestHandleBuy_RepGatedStock(t *testing.T) {
+	svc, uid, inst := newMerchantTestServer(t)
+	sess, _ := svc.sessions.GetPlayer(uid)
+	tmpl := svc.npcMgr.TemplateByID(inst.TemplateID)
+	tmpl.Merchant.Inventory[0].MinRep = &world.RepRequirement{Faction: "gun", MinRep: 30}
+
+	evt, err := svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "won't sell you that until you have 30 reputation with gun")
+	assert.Equal(t, 500, sess.Currency)
+
+	view, err := svc.handleBrowse(uid, &gamev1.BrowseRequest{NpcName: inst.Name()})
+	require.NoError(t, err)
+	require.Len(t, view.GetShopView().Items, 1)
+	assert.Equal(t, "Requires 30 rep with gun", view.GetShopView().Items[0].LockedReason)
+
+	sess.FactionRep["gun"] = 30
+	evt, err = svc.handleBuy(uid, &gamev1.BuyRequest{NpcName: inst.Name(), ItemId: "stim_pack", Quantity: 1})
+	require.NoError(t, err)
+	assert.NotContains(t, evt.GetMessage().Content, "won't sell")
+	assert.Less(t, sess.Currency, 500)
+
+	view, err = svc.handleBrowse(uid, &gamev1.BrowseRequest{NpcName: inst.Name()})
+	require.NoError(t, err)
+	assert.Empty(t, view.GetShopView().Items[0].LockedReason)
+}
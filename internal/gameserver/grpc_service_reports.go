@@ -0,0 +1,202 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/permission"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// reportPreviewLen is how much of a report's text a listing shows.
+const reportPreviewLen = 60
+
+// ReportStore persists and queries player bug, typo, and idea reports.
+//
+// Precondition: Implementations must be safe for concurrent use.
+type ReportStore interface {
+	CreateReport(ctx context.Context, r postgres.Report) (int64, error)
+	ListReports(ctx context.Context, q postgres.ReportQuery) ([]postgres.Report, error)
+	GetReport(ctx context.Context, id int64) (postgres.Report, error)
+	CloseReport(ctx context.Context, id int64, closedBy string) error
+}
+
+// commandSummary describes msg for a report's command context: the payload
+// name followed by its fields as JSON, e.g. `move {"direction":"north"}`.
+//
+// Postcondition: Returns "" when msg has no payload.
+func commandSummary(msg *gamev1.ClientMessage) string {
+	m := msg.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("payload"))
+	if fd == nil {
+		return ""
+	}
+	name := string(fd.Name())
+	if fd.Message() == nil {
+		return name
+	}
+	args, err := protojson.Marshal(m.Get(fd).Message().Interface())
+	if err != nil || strings.TrimSpace(string(args)) == "{}" {
+		return name
+	}
+	return name + " " + string(args)
+}
+
+// noteCommand remembers msg in uid's recent commands.
+func (s *GameServiceServer) noteCommand(uid string, msg *gamev1.ClientMessage) {
+	summary := commandSummary(msg)
+	if summary == "" {
+		return
+	}
+	if sess, ok := s.sessions.GetPlayer(uid); ok {
+		sess.NoteCommand(summary)
+	}
+}
+
+// handleReport files a bug, typo, or idea report with the player's room and
+// recent commands, and alerts staff who can review reports.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleReport(uid string, req *gamev1.ReportRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	kind := req.GetKind()
+	switch kind {
+	case command.HandlerBug, command.HandlerTypo, command.HandlerIdea:
+	default:
+		return errorEvent(fmt.Sprintf("unknown report kind %q", kind)), nil
+	}
+	text, err := command.HandleReport(kind, req.GetText())
+	if err != nil {
+		return errorEvent(err.Error()), nil
+	}
+	if s.reportStore == nil {
+		return errorEvent("Reports can't be filed right now."), nil
+	}
+	ctx := s.commandCtx(uid)
+	id, err := s.reportStore.CreateReport(ctx, postgres.Report{
+		Kind:        kind,
+		CharacterID: sess.CharacterID,
+		CharName:    sess.CharName,
+		RoomID:      sess.RoomID,
+		Body:        text,
+		Context:     strings.Join(sess.RecentCommands, "\n"),
+	})
+	if err != nil {
+		s.logger.Warn("filing report", zap.String("uid", uid), zap.String("kind", kind), zap.Error(err))
+		return errorEvent("Your report could not be filed. Please try again later."), nil
+	}
+	alert := fmt.Sprintf("[report] %s filed %s #%d in %s: %s", sess.CharName, kind, id, sess.RoomID, reportPreview(text))
+	for _, staff := range s.sessions.AllPlayers() {
+		if staff.UID != uid && s.can(staff, permission.CanReviewReports) {
+			s.pushMessageToUID(staff.UID, alert)
+		}
+	}
+	return messageEvent(fmt.Sprintf("Thanks! Your %s report (#%d) has been filed.", kind, id)), nil
+}
+
+// handleReports lists, shows, or closes player reports.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanReviewReports (checked by dispatch); req must be non-nil.
+// Postcondition: Returns an error event unless a report store is configured.
+func (s *GameServiceServer) handleReports(uid string, req *gamev1.ReportsRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.reportStore == nil {
+		return errorEvent("player reports are not available"), nil
+	}
+	ctx := s.commandCtx(uid)
+	switch req.GetAction() {
+	case "show":
+		r, err := s.reportStore.GetReport(ctx, req.GetId())
+		if errors.Is(err, postgres.ErrReportNotFound) {
+			return errorEvent(fmt.Sprintf("no report #%d", req.GetId())), nil
+		}
+		if err != nil {
+			s.logger.Warn("loading report", zap.Int64("id", req.GetId()), zap.Error(err))
+			return errorEvent("failed to read the report"), nil
+		}
+		return messageEvent(formatReportDetail(r)), nil
+	case "close":
+		err := s.reportStore.CloseReport(ctx, req.GetId(), sess.Username)
+		if errors.Is(err, postgres.ErrReportNotFound) {
+			return errorEvent(fmt.Sprintf("no open report #%d", req.GetId())), nil
+		}
+		if err != nil {
+			s.logger.Warn("closing report", zap.Int64("id", req.GetId()), zap.Error(err))
+			return errorEvent("failed to close the report"), nil
+		}
+		return messageEvent(fmt.Sprintf("Report #%d closed.", req.GetId())), nil
+	}
+	reports, err := s.reportStore.ListReports(ctx, postgres.ReportQuery{Kind: req.GetKind(), IncludeClosed: req.GetAll()})
+	if err != nil {
+		s.logger.Warn("listing reports", zap.Error(err))
+		return errorEvent("failed to read player reports"), nil
+	}
+	if len(reports) == 0 {
+		if req.GetAll() {
+			return messageEvent("No reports filed."), nil
+		}
+		return messageEvent("No open reports."), nil
+	}
+	var b strings.Builder
+	if req.GetAll() {
+		b.WriteString("Player reports:")
+	} else {
+		b.WriteString("Open player reports:")
+	}
+	for _, r := range reports {
+		b.WriteString("\n" + formatReportLine(r))
+	}
+	return messageEvent(b.String()), nil
+}
+
+// reportPreview shortens text to reportPreviewLen characters for listings.
+func reportPreview(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if r := []rune(text); len(r) > reportPreviewLen {
+		return string(r[:reportPreviewLen-3]) + "..."
+	}
+	return text
+}
+
+// formatReportLine renders r as a single listing line.
+func formatReportLine(r postgres.Report) string {
+	line := fmt.Sprintf("#%d  %s  %-4s  %s @ %s: %s", r.ID, r.CreatedAt.UTC().Format("2006-01-02 15:04"),
+		r.Kind, r.CharName, r.RoomID, reportPreview(r.Body))
+	if r.ClosedAt != nil {
+		line += "  [closed]"
+	}
+	return line
+}
+
+// formatReportDetail renders r in full with its command context.
+func formatReportDetail(r postgres.Report) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Report #%d (%s) by %s in %s, %s", r.ID, r.Kind, r.CharName, r.RoomID,
+		r.CreatedAt.UTC().Format("2006-01-02 15:04:05"))
+	if r.ClosedAt != nil {
+		fmt.Fprintf(&b, "\nClosed by %s, %s", r.ClosedBy, r.ClosedAt.UTC().Format("2006-01-02 15:04:05"))
+	}
+	b.WriteString("\n" + r.Body)
+	if r.Context != "" {
+		b.WriteString("\nRecent commands:")
+		for _, line := range strings.Split(r.Context, "\n") {
+			b.WriteString("\n  " + line)
+		}
+	}
+	return b.String()
+}
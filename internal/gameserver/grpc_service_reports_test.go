@@ -0,0 +1,193 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// fakeReportStore is an in-memory ReportStore.
+type fakeReportStore struct {
+	mu        sync.Mutex
+	reports   []postgres.Report
+	createErr error
+}
+
+func (f *fakeReportStore) CreateReport(_ context.Context, r postgres.Report) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.createErr != nil {
+		return 0, f.createErr
+	}
+	r.ID = int64(len(f.reports) + 1)
+	r.CreatedAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	f.reports = append(f.reports, r)
+	return r.ID, nil
+}
+
+func (f *fakeReportStore) ListReports(_ context.Context, q postgres.ReportQuery) ([]postgres.Report, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []postgres.Report
+	for i := len(f.reports) - 1; i >= 0; i-- {
+		r := f.reports[i]
+		if (q.Kind == "" || r.Kind == q.Kind) && (q.IncludeClosed || r.ClosedAt == nil) {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeReportStore) GetReport(_ context.Context, id int64) (postgres.Report, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if id <= 0 || id > int64(len(f.reports)) {
+		return postgres.Report{}, postgres.ErrReportNotFound
+	}
+	return f.reports[id-1], nil
+}
+
+func (f *fakeReportStore) CloseReport(_ context.Context, id int64, closedBy string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if id <= 0 || id > int64(len(f.reports)) || f.reports[id-1].ClosedAt != nil {
+		return postgres.ErrReportNotFound
+	}
+	now := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	f.reports[id-1].ClosedAt = &now
+	f.reports[id-1].ClosedBy = closedBy
+	return nil
+}
+
+func newReportTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *session.PlayerSession, *fakeReportStore) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	store := &fakeReportStore{}
+	svc.reportStore = store
+	player, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "rep_u1", Username: "tester", CharName: "Mira", CharacterID: 5, RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	mod, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "rep_mod", Username: "modjo", CharName: "Warden", RoomID: "room_b",
+		CurrentHP: 10, MaxHP: 10, Role: "moderator",
+	})
+	require.NoError(t, err)
+	return svc, player, mod, store
+}
+
+func TestCommandSummary(t *testing.T) {
+	move := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Move{Move: &gamev1.MoveRequest{Direction: "north"}}}
+	assert.Regexp(t, `^move \{"direction":\s*"north"\}$`, commandSummary(move))
+	look := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}}
+	assert.Equal(t, "look", commandSummary(look))
+	assert.Empty(t, commandSummary(&gamev1.ClientMessage{}))
+}
+
+func TestHandleReport_FilesWithContextAndAlertsStaff(t *testing.T) {
+	svc, player, mod, store := newReportTestServer(t)
+	svc.noteCommand(player.UID, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Move{Move: &gamev1.MoveRequest{Direction: "north"}}})
+	svc.noteCommand(player.UID, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}})
+
+	evt, err := svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "bug", Text: "  the door ate my key "})
+	require.NoError(t, err)
+	assert.Equal(t, "Thanks! Your bug report (#1) has been filed.", evt.GetMessage().GetContent())
+
+	require.Len(t, store.reports, 1)
+	r := store.reports[0]
+	assert.Equal(t, "bug", r.Kind)
+	assert.Equal(t, int64(5), r.CharacterID)
+	assert.Equal(t, "Mira", r.CharName)
+	assert.Equal(t, "room_a", r.RoomID)
+	assert.Equal(t, "the door ate my key", r.Body)
+	assert.Regexp(t, `^move .*north.*\nlook$`, r.Context)
+
+	assert.Contains(t, pushedText(t, mod), "[report] Mira filed bug #1 in room_a: the door ate my key")
+	assert.NotContains(t, pushedText(t, player), "[report]")
+}
+
+func TestHandleReport_Rejections(t *testing.T) {
+	svc, player, _, store := newReportTestServer(t)
+
+	evt, err := svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "rant", Text: "x"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "unknown report kind")
+
+	evt, err = svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "typo", Text: " "})
+	require.NoError(t, err)
+	assert.Equal(t, "usage: typo <text>", evt.GetError().GetMessage())
+
+	store.createErr = errors.New("db down")
+	evt, err = svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "idea", Text: "more cats"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "could not be filed")
+
+	svc.reportStore = nil
+	evt, err = svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "idea", Text: "more cats"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "can't be filed")
+}
+
+func TestHandleReports_ListShowClose(t *testing.T) {
+	svc, player, mod, _ := newReportTestServer(t)
+	player.NoteCommand(`say {"message":"hi"}`)
+	_, err := svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "typo", Text: "'recieve' on the sign"})
+	require.NoError(t, err)
+	_, err = svc.handleReport(player.UID, &gamev1.ReportRequest{Kind: "idea", Text: "more cats"})
+	require.NoError(t, err)
+
+	evt, err := svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "list"})
+	require.NoError(t, err)
+	msg := evt.GetMessage().GetContent()
+	assert.Contains(t, msg, "Open player reports:")
+	assert.Contains(t, msg, "#1  2026-01-02 03:04  typo  Mira @ room_a: 'recieve' on the sign")
+	assert.Contains(t, msg, "#2")
+
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "list", Kind: "idea"})
+	require.NoError(t, err)
+	assert.NotContains(t, evt.GetMessage().GetContent(), "#1")
+
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "show", Id: 1})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Report #1 (typo) by Mira in room_a")
+	assert.Contains(t, evt.GetMessage().GetContent(), "Recent commands:\n  say {\"message\":\"hi\"}")
+
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "close", Id: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Report #1 closed.", evt.GetMessage().GetContent())
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "close", Id: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "no open report #1", evt.GetError().GetMessage())
+
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "list"})
+	require.NoError(t, err)
+	assert.NotContains(t, evt.GetMessage().GetContent(), "#1 ")
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "list", All: true})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "[closed]")
+
+	evt, err = svc.handleReports(mod.UID, &gamev1.ReportsRequest{Action: "show", Id: 9})
+	require.NoError(t, err)
+	assert.Equal(t, "no report #9", evt.GetError().GetMessage())
+}
+
+func TestRequiredCapability_ReportsNeedsReviewPermission(t *testing.T) {
+	svc, player, mod, _ := newReportTestServer(t)
+	msg := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Reports{Reports: &gamev1.ReportsRequest{Action: "list"}}}
+	assert.NotNil(t, svc.authorize(player.UID, msg))
+	assert.Nil(t, svc.authorize(mod.UID, msg))
+	report := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Report{Report: &gamev1.ReportRequest{Kind: "bug", Text: "x"}}}
+	assert.Nil(t, svc.authorize(player.UID, report), "anyone may file a report")
+}
@@ -0,0 +1,382 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// PointBuyStore persists the point-buy adjustments characters make to their
+// ability scores at a respec.
+type PointBuyStore interface {
+	GetAll(ctx context.Context, characterID int64) (map[string]int, error)
+	SetAll(ctx context.Context, characterID int64, adj map[string]int) error
+}
+
+// loadPointBuy reads the character's point-buy adjustments into sess.
+//
+// Precondition: sess must not be nil.
+// Postcondition: sess.PointBuy is non-nil; it is empty when nothing is stored
+// or the store fails.
+func (s *GameServiceServer) loadPointBuy(ctx context.Context, sess *session.PlayerSession) {
+	sess.PointBuy = make(map[string]int)
+	if s.pointBuyStore == nil || sess.CharacterID <= 0 {
+		return
+	}
+	adj, err := s.pointBuyStore.GetAll(ctx, sess.CharacterID)
+	if err != nil {
+		s.logger.Warn("loading point buy",
+			zap.Int64("character_id", sess.CharacterID),
+			zap.Error(err),
+		)
+		return
+	}
+	for ability, delta := range adj {
+		sess.PointBuy[ability] = delta
+	}
+}
+
+// handleRespec lets a player pay a job trainer to re-allocate their point-buy
+// abilities or swap a feat they chose for another from the same choice. With
+// neither requested, the trainer explains the service and its fee.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil. On
+// success the fee is deducted, the change is persisted, and derived stats are
+// recomputed.
+func (s *GameServiceServer) handleRespec(uid string, req *gamev1.RespecRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return messageEvent("player not found"), nil
+	}
+	inst, errMsg := s.findJobTrainerInRoom(sess.RoomID, req.GetNpcName())
+	if inst == nil {
+		return messageEvent(errMsg), nil
+	}
+	if s.factionSvc != nil && s.factionSvc.IsEnemyOf(sess, inst.FactionID) {
+		return messageEvent(fmt.Sprintf("%s eyes you coldly. 'We don't serve your kind here.'", inst.Name())), nil
+	}
+	if s.combatH != nil && s.combatH.ActiveCombatForPlayer(uid) != nil {
+		return messageEvent("You can't retrain in the middle of a fight."), nil
+	}
+	tmpl := s.npcMgr.TemplateByID(inst.TemplateID)
+	if tmpl == nil || tmpl.JobTrainer == nil {
+		return messageEvent(fmt.Sprintf("%s doesn't offer respecs.", inst.Name())), nil
+	}
+	fee := tmpl.JobTrainer.RespecFee(sess.Level)
+	switch {
+	case req.GetOldFeat() != "":
+		return s.respecFeat(uid, sess, inst, fee, req.GetOldFeat(), req.GetNewFeat()), nil
+	case req.GetAbilities() != "":
+		return s.respecAbilities(uid, sess, inst, fee, req.GetAbilities()), nil
+	}
+	return messageEvent(s.respecMenuText(sess, inst, fee)), nil
+}
+
+// respecAbilities replaces the player's point-buy adjustments with those in
+// line, or clears them when line is "reset".
+//
+// Precondition: sess, inst non-nil; fee > 0.
+// Postcondition: On success sess.Abilities, sess.PointBuy, sess.MaxHP, and
+// sess.Currency are updated and persisted.
+func (s *GameServiceServer) respecAbilities(uid string, sess *session.PlayerSession, inst *npc.Instance, fee int, line string) *gamev1.ServerEvent {
+	adj := map[string]int{}
+	if !strings.EqualFold(strings.TrimSpace(line), "reset") {
+		parsed, err := character.ParsePointBuy(line)
+		if err != nil {
+			return messageEvent(fmt.Sprintf("Invalid allocation: %v.", err))
+		}
+		adj = parsed
+	}
+	base := character.RemovePointBuy(sess.Abilities, sess.PointBuy)
+	if err := character.ValidatePointBuy(base, adj); err != nil {
+		return messageEvent(fmt.Sprintf("Invalid allocation: %v.", err))
+	}
+	updated := character.ApplyPointBuy(base, adj)
+	if updated == sess.Abilities {
+		return messageEvent("Your abilities are already allocated that way.")
+	}
+	if unmet := s.unmetEquipmentRequirements(sess, updated); len(unmet) > 0 {
+		return messageEvent(fmt.Sprintf("You couldn't wear your gear with that allocation: %s. Remove it first.", strings.Join(unmet, "; ")))
+	}
+	if sess.Currency < fee {
+		return messageEvent(fmt.Sprintf("A respec costs %d credits but you only have %d.", fee, sess.Currency))
+	}
+
+	maxHP := max(sess.MaxHP+combat.AbilityMod(updated.Grit)-combat.AbilityMod(sess.Abilities.Grit), 1)
+	ctx := s.commandCtx(uid)
+	if sess.CharacterID > 0 {
+		if s.pointBuyStore != nil {
+			if err := s.pointBuyStore.SetAll(ctx, sess.CharacterID, adj); err != nil {
+				s.logger.Warn("respec: saving point buy", zap.Int64("character_id", sess.CharacterID), zap.Error(err))
+				return messageEvent("Failed to save your respec. Please try again.")
+			}
+		}
+		if s.charSaver != nil {
+			if err := s.charSaver.SaveAbilities(ctx, sess.CharacterID, updated); err != nil {
+				s.logger.Warn("respec: saving abilities", zap.Int64("character_id", sess.CharacterID), zap.Error(err))
+				return messageEvent("Failed to save your respec. Please try again.")
+			}
+			if err := s.charSaver.SaveProgress(ctx, sess.CharacterID, sess.Level, sess.Experience, maxHP, sess.PendingBoosts); err != nil {
+				s.logger.Warn("respec: saving max HP", zap.Int64("character_id", sess.CharacterID), zap.Error(err))
+			}
+		}
+	}
+
+	sess.Abilities = updated
+	sess.PointBuy = adj
+	sess.MaxHP = maxHP
+	sess.CurrentHP = min(sess.CurrentHP, sess.MaxHP)
+	sess.Currency -= fee
+	s.emitCurrency(sess, -fee, telemetry.SourceTraining)
+	s.pushCharacterSheet(sess)
+	return messageEvent(fmt.Sprintf("%s drills you in a new regimen. %s. Max HP %d. Cost: %d credits.",
+		inst.Name(), abilityScoresText(updated), sess.MaxHP, fee))
+}
+
+// respecFeat swaps oldFeat, which the player chose from a feat choice, for
+// newFeat from the same choice.
+//
+// Precondition: sess, inst non-nil; fee > 0.
+// Postcondition: On success oldFeat is replaced by newFeat in storage and in
+// sess.PassiveFeats, and the fee is deducted.
+func (s *GameServiceServer) respecFeat(uid string, sess *session.PlayerSession, inst *npc.Instance, fee int, oldFeat, newFeat string) *gamev1.ServerEvent {
+	if s.characterFeatsRepo == nil || s.featRegistry == nil {
+		return messageEvent("Feats can't be retrained right now.")
+	}
+	if newFeat == "" {
+		return messageEvent("Usage: respec <npc> feat <old feat> to <new feat>")
+	}
+	ctx := s.commandCtx(uid)
+	existing, err := s.characterFeatsRepo.GetAll(ctx, sess.CharacterID)
+	if err != nil {
+		s.logger.Warn("respec: loading feats", zap.Int64("character_id", sess.CharacterID), zap.Error(err))
+		return messageEvent("Your feats couldn't be loaded. Please try again.")
+	}
+	owned := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		owned[id] = true
+	}
+
+	oldF, pool := s.respecFeatChoice(sess, owned, oldFeat)
+	if oldF == nil {
+		return messageEvent(fmt.Sprintf("%q isn't a feat you chose. Only feats picked from a feat choice can be retrained.", oldFeat))
+	}
+	var newF *ruleset.Feat
+	for _, f := range pool {
+		if featMatches(f, newFeat) {
+			newF = f
+			break
+		}
+	}
+	if newF == nil {
+		return messageEvent(fmt.Sprintf("%q isn't offered in place of %s.", newFeat, oldF.Name))
+	}
+	if owned[newF.ID] {
+		return messageEvent(fmt.Sprintf("You already have %s.", newF.Name))
+	}
+
+	after := make(map[string]bool, len(owned))
+	for id := range owned {
+		after[id] = true
+	}
+	delete(after, oldF.ID)
+	candidate := featCandidate(sess, after)
+	if unmet := newF.Prerequisites.Unmet(candidate); len(unmet) > 0 {
+		return messageEvent(fmt.Sprintf("You don't meet the prerequisites for %s: requires %s.", newF.Name, strings.Join(unmet, ", ")))
+	}
+	after[newF.ID] = true
+	candidate.Feats = after
+	for id := range owned {
+		if f, ok := s.featRegistry.Feat(id); ok && id != oldF.ID && len(f.Prerequisites.Unmet(candidate)) > 0 {
+			return messageEvent(fmt.Sprintf("You can't give up %s: %s depends on it.", oldF.Name, f.Name))
+		}
+	}
+	if sess.Currency < fee {
+		return messageEvent(fmt.Sprintf("A respec costs %d credits but you only have %d.", fee, sess.Currency))
+	}
+
+	if err := s.characterFeatsRepo.Remove(ctx, sess.CharacterID, oldF.ID); err != nil {
+		s.logger.Warn("respec: removing feat", zap.String("feat_id", oldF.ID), zap.Error(err))
+		return messageEvent("Failed to save your respec. Please try again.")
+	}
+	if err := s.characterFeatsRepo.Add(ctx, sess.CharacterID, newF.ID); err != nil {
+		s.logger.Warn("respec: adding feat", zap.String("feat_id", newF.ID), zap.Error(err))
+		if rbErr := s.characterFeatsRepo.Add(ctx, sess.CharacterID, oldF.ID); rbErr != nil {
+			s.logger.Warn("respec: restoring feat", zap.String("feat_id", oldF.ID), zap.Error(rbErr))
+		}
+		return messageEvent("Failed to save your respec. Please try again.")
+	}
+
+	delete(sess.PassiveFeats, oldF.ID)
+	if !newF.Active {
+		if sess.PassiveFeats == nil {
+			sess.PassiveFeats = make(map[string]bool)
+		}
+		sess.PassiveFeats[newF.ID] = true
+	}
+	sess.Currency -= fee
+	s.emitCurrency(sess, -fee, telemetry.SourceTraining)
+	s.pushCharacterSheet(sess)
+	return messageEvent(fmt.Sprintf("%s helps you unlearn %s and learn %s. Cost: %d credits.", inst.Name(), oldF.Name, newF.Name, fee))
+}
+
+// respecFeatChoice finds the owned feat named name among the player's feat
+// choices and returns it with the other feats of that choice.
+//
+// Precondition: sess non-nil; s.featRegistry non-nil.
+// Postcondition: Returns (nil, nil) when name is not an owned feat picked from
+// a feat choice available at the player's level.
+func (s *GameServiceServer) respecFeatChoice(sess *session.PlayerSession, owned map[string]bool, name string) (*ruleset.Feat, []*ruleset.Feat) {
+	for _, grants := range s.featChoiceGrants(sess) {
+		var chosen *ruleset.Feat
+		var pool []*ruleset.Feat
+		for _, id := range grants.Choices.Pool {
+			f, ok := s.featRegistry.Feat(id)
+			if !ok {
+				continue
+			}
+			if chosen == nil && owned[f.ID] && featMatches(f, name) {
+				chosen = f
+				continue
+			}
+			pool = append(pool, f)
+		}
+		if chosen != nil {
+			return chosen, pool
+		}
+	}
+	return nil, nil
+}
+
+// featChoiceGrants returns the job's feat grants that offer a choice, from
+// character creation up to the player's level, lowest level first.
+//
+// Precondition: sess non-nil.
+func (s *GameServiceServer) featChoiceGrants(sess *session.PlayerSession) []*ruleset.FeatGrants {
+	if s.jobRegistry == nil {
+		return nil
+	}
+	job, ok := s.jobRegistry.Job(sess.Class)
+	if !ok {
+		return nil
+	}
+	var archGrants map[int]*ruleset.FeatGrants
+	if arch, ok := s.archetypes[job.Archetype]; ok {
+		archGrants = arch.LevelUpFeatGrants
+	}
+	merged := ruleset.MergeFeatLevelUpGrants(archGrants, job.LevelUpFeatGrants)
+	levels := make([]int, 0, len(merged))
+	for lvl := range merged {
+		if lvl >= 2 && lvl <= sess.Level {
+			levels = append(levels, lvl)
+		}
+	}
+	sort.Ints(levels)
+
+	var out []*ruleset.FeatGrants
+	add := func(fg *ruleset.FeatGrants) {
+		if fg != nil && fg.Choices != nil && len(fg.Choices.Pool) > 0 {
+			out = append(out, fg)
+		}
+	}
+	add(job.FeatGrants)
+	for _, lvl := range levels {
+		add(merged[lvl])
+	}
+	return out
+}
+
+// featMatches reports whether name names f by ID or name, ignoring case and
+// treating spaces as underscores.
+func featMatches(f *ruleset.Feat, name string) bool {
+	name = strings.TrimSpace(name)
+	return strings.EqualFold(f.ID, name) || strings.EqualFold(f.Name, name) ||
+		strings.EqualFold(f.ID, strings.ReplaceAll(name, " ", "_"))
+}
+
+// unmetEquipmentRequirements lists the equipped armor whose strength
+// requirement scores would fail, as "<item> needs brutality <n>".
+//
+// Precondition: sess non-nil.
+func (s *GameServiceServer) unmetEquipmentRequirements(sess *session.PlayerSession, scores character.AbilityScores) []string {
+	if sess.Equipment == nil || s.invRegistry == nil {
+		return nil
+	}
+	var unmet []string
+	for _, slotted := range sess.Equipment.Armor {
+		if slotted == nil {
+			continue
+		}
+		def, ok := s.invRegistry.Armor(slotted.ItemDefID)
+		if !ok || def.StrengthReq == 0 || scores.Brutality >= def.StrengthReq {
+			continue
+		}
+		unmet = append(unmet, fmt.Sprintf("%s needs brutality %d", def.Name, def.StrengthReq))
+	}
+	sort.Strings(unmet)
+	return unmet
+}
+
+// abilityScoresText formats scores as "Brutality 12, Grit 10, ...".
+func abilityScoresText(scores character.AbilityScores) string {
+	parts := make([]string, 0, len(character.AbilityNames))
+	for _, name := range character.AbilityNames {
+		score, _ := scores.Score(name)
+		parts = append(parts, fmt.Sprintf("%s %d", strings.ToUpper(name[:1])+name[1:], score))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// respecMenuText describes the trainer's respec service: the fee, the
+// player's current point-buy, and the feats they could retrain.
+//
+// Precondition: sess, inst non-nil.
+func (s *GameServiceServer) respecMenuText(sess *session.PlayerSession, inst *npc.Instance, fee int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "=== %s's Respec ===\n", inst.Name())
+	fmt.Fprintf(&b, "Fee: %d credits (you have %d).\n", fee, sess.Currency)
+	fmt.Fprintf(&b, "Abilities: %s.\n", abilityScoresText(sess.Abilities))
+	if len(sess.PointBuy) > 0 {
+		names := make([]string, 0, len(sess.PointBuy))
+		for name := range sess.PointBuy {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			parts = append(parts, fmt.Sprintf("%s %+d", name, sess.PointBuy[name]))
+		}
+		fmt.Fprintf(&b, "Point-buy: %s.\n", strings.Join(parts, ", "))
+	}
+	fmt.Fprintf(&b, "Point-buy moves up to %d points, at most %d per ability, and must balance.\n",
+		character.PointBuyPool, character.PointBuyMaxShift)
+	if s.characterFeatsRepo != nil && s.featRegistry != nil {
+		if existing, err := s.characterFeatsRepo.GetAll(s.commandCtx(sess.UID), sess.CharacterID); err == nil {
+			var chosen []string
+			for _, id := range existing {
+				owned := map[string]bool{id: true}
+				if f, _ := s.respecFeatChoice(sess, owned, id); f != nil {
+					chosen = append(chosen, f.Name)
+				}
+			}
+			if len(chosen) > 0 {
+				sort.Strings(chosen)
+				fmt.Fprintf(&b, "Retrainable feats: %s.\n", strings.Join(chosen, ", "))
+			}
+		}
+	}
+	b.WriteString("Usage: respec <npc> <ability> <+/-n> ... | respec <npc> reset | respec <npc> feat <old> to <new>")
+	return b.String()
+}
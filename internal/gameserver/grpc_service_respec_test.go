@@ -0,0 +1,178 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// fakePointBuyStore is an in-memory PointBuyStore.
+type fakePointBuyStore struct {
+	adj map[string]int
+}
+
+func (f *fakePointBuyStore) GetAll(_ context.Context, _ int64) (map[string]int, error) {
+	out := make(map[string]int, len(f.adj))
+	for k, v := range f.adj {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (f *fakePointBuyStore) SetAll(_ context.Context, _ int64, adj map[string]int) error {
+	f.adj = make(map[string]int, len(adj))
+	for k, v := range adj {
+		if v != 0 {
+			f.adj[k] = v
+		}
+	}
+	return nil
+}
+
+// newRespecTestServer returns the job trainer test server with a point-buy
+// store, a feats repo, and a job whose level 2 feat choice offers Brawler,
+// Sucker Punch, and Haymaker (which requires Brawler).
+func newRespecTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *fakePointBuyStore, *stubFeatsRepo) {
+	t.Helper()
+	svc, uid := newJobTrainerTestServer(t)
+	sess, ok := svc.sessions.GetPlayer(uid)
+	require.True(t, ok)
+	sess.CharacterID = 7
+	sess.Class = "respec_job"
+	sess.Abilities = character.AbilityScores{Brutality: 12, Grit: 12, Quickness: 10, Reasoning: 10, Savvy: 10, Flair: 10}
+	sess.PointBuy = map[string]int{}
+
+	store := &fakePointBuyStore{}
+	svc.pointBuyStore = store
+	featsRepo := &stubFeatsRepo{data: map[int64][]string{7: {"brawler"}}}
+	svc.characterFeatsRepo = featsRepo
+	svc.featRegistry = ruleset.NewFeatRegistry([]*ruleset.Feat{
+		{ID: "brawler", Name: "Brawler"},
+		{ID: "sucker_punch", Name: "Sucker Punch"},
+		{ID: "haymaker", Name: "Haymaker", Prerequisites: &ruleset.FeatPrerequisites{Feats: []string{"brawler"}}},
+	})
+	jobReg := ruleset.NewJobRegistry()
+	jobReg.Register(&ruleset.Job{
+		ID: "respec_job",
+		LevelUpFeatGrants: map[int]*ruleset.FeatGrants{
+			2: {Choices: &ruleset.FeatChoices{Count: 1, Pool: []string{"brawler", "sucker_punch", "haymaker"}}},
+		},
+	})
+	svc.jobRegistry = jobReg
+	return svc, sess, store, featsRepo
+}
+
+func respec(t *testing.T, svc *GameServiceServer, sess *session.PlayerSession, req *gamev1.RespecRequest) string {
+	t.Helper()
+	req.NpcName = "Rio Wrench"
+	evt, err := svc.handleRespec(sess.UID, req)
+	require.NoError(t, err)
+	return evt.GetMessage().GetContent()
+}
+
+func TestHandleRespec_Menu(t *testing.T) {
+	svc, sess, _, _ := newRespecTestServer(t)
+	msg := respec(t, svc, sess, &gamev1.RespecRequest{})
+	assert.Contains(t, msg, "Fee: 250 credits")
+	assert.Contains(t, msg, "Retrainable feats: Brawler")
+	assert.Equal(t, 1000, sess.Currency)
+}
+
+func TestHandleRespec_Abilities(t *testing.T) {
+	svc, sess, store, _ := newRespecTestServer(t)
+	msg := respec(t, svc, sess, &gamev1.RespecRequest{Abilities: "grit +2 flair -2"})
+	assert.Contains(t, msg, "Grit 14")
+	assert.Equal(t, 14, sess.Abilities.Grit)
+	assert.Equal(t, 8, sess.Abilities.Flair)
+	assert.Equal(t, map[string]int{"grit": 2, "flair": -2}, store.adj)
+	assert.Equal(t, 51, sess.MaxHP, "a higher grit modifier raises max HP")
+	assert.Equal(t, 750, sess.Currency)
+
+	respec(t, svc, sess, &gamev1.RespecRequest{Abilities: "reset"})
+	assert.Equal(t, 12, sess.Abilities.Grit)
+	assert.Equal(t, 10, sess.Abilities.Flair)
+	assert.Empty(t, store.adj)
+	assert.Equal(t, 50, sess.MaxHP)
+	assert.Equal(t, 500, sess.Currency)
+}
+
+func TestHandleRespec_Abilities_Rejected(t *testing.T) {
+	svc, sess, _, _ := newRespecTestServer(t)
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{Abilities: "grit +2"}), "must balance")
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{Abilities: "reset"}), "already allocated")
+	sess.Currency = 10
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{Abilities: "grit +1 flair -1"}), "only have 10")
+	assert.Equal(t, 12, sess.Abilities.Grit)
+	assert.Equal(t, 10, sess.Currency)
+}
+
+func TestHandleRespec_Abilities_EquipmentRequirement(t *testing.T) {
+	svc, sess, _, _ := newRespecTestServer(t)
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterArmor(&inventory.ArmorDef{ID: "plate", Name: "Scrap Plate", Slot: inventory.SlotTorso, StrengthReq: 12}))
+	svc.invRegistry = reg
+	sess.Equipment = inventory.NewEquipment()
+	sess.Equipment.Armor[inventory.SlotTorso] = &inventory.SlottedItem{ItemDefID: "plate", Name: "Scrap Plate"}
+
+	msg := respec(t, svc, sess, &gamev1.RespecRequest{Abilities: "brutality -2 grit +2"})
+	assert.Contains(t, msg, "Scrap Plate needs brutality 12")
+	assert.Equal(t, 12, sess.Abilities.Brutality)
+	assert.Equal(t, 1000, sess.Currency)
+}
+
+func TestHandleRespec_Feat(t *testing.T) {
+	svc, sess, _, featsRepo := newRespecTestServer(t)
+	msg := respec(t, svc, sess, &gamev1.RespecRequest{OldFeat: "brawler", NewFeat: "sucker punch"})
+	assert.Contains(t, msg, "unlearn Brawler and learn Sucker Punch")
+	assert.Equal(t, []string{"sucker_punch"}, featsRepo.data[7])
+	assert.True(t, sess.PassiveFeats["sucker_punch"])
+	assert.False(t, sess.PassiveFeats["brawler"])
+	assert.Equal(t, 750, sess.Currency)
+}
+
+func TestHandleRespec_Feat_Rejected(t *testing.T) {
+	svc, sess, _, featsRepo := newRespecTestServer(t)
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{OldFeat: "sucker punch", NewFeat: "brawler"}), "isn't a feat you chose")
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{OldFeat: "brawler", NewFeat: "haymaker"}), "requires")
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{OldFeat: "brawler", NewFeat: "cleave"}), "isn't offered")
+
+	featsRepo.data[7] = []string{"brawler", "haymaker"}
+	assert.Contains(t, respec(t, svc, sess, &gamev1.RespecRequest{OldFeat: "brawler", NewFeat: "sucker punch"}), "Haymaker depends on it")
+	assert.Equal(t, []string{"brawler", "haymaker"}, featsRepo.data[7])
+	assert.Equal(t, 1000, sess.Currency)
+}
+
+func TestProperty_HandleRespec_AbilitiesStayWithinPointBuy(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		svc, sess, store, _ := newRespecTestServer(t)
+		sess.Currency = 1 << 20
+		base := sess.Abilities
+		for _, step := range rapid.SliceOfN(rapid.IntRange(-2, 2), 1, 4).Draw(rt, "steps") {
+			up := rapid.SampledFrom(character.AbilityNames).Draw(rt, "up")
+			down := rapid.SampledFrom(character.AbilityNames).Draw(rt, "down")
+			adj := map[string]int{up: step}
+			adj[down] -= step
+			line := ""
+			for name, d := range adj {
+				line += fmt.Sprintf("%s %+d ", name, d)
+			}
+			respec(t, svc, sess, &gamev1.RespecRequest{Abilities: line})
+			if got := character.RemovePointBuy(sess.Abilities, store.adj); got != base {
+				rt.Fatalf("removing stored point-buy %v from %+v gives %+v, want %+v", store.adj, sess.Abilities, got, base)
+			}
+			if err := character.ValidatePointBuy(base, store.adj); err != nil {
+				rt.Fatalf("stored point-buy %v is invalid: %v", store.adj, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+// exposeToRoomEffects fires the lingering area effects of the fight in the
+// player's room (burning ground, gas clouds) on a player who has just walked
+// in. Armor resistances, weaknesses, and immunities apply.
+//
+// Precondition: sess must belong to uid and already stand in the new room.
+// Postcondition: The player takes each effect's damage and is told so; a
+// player brought to 0 HP goes through the non-combat death path.
+func (s *GameServiceServer) exposeToRoomEffects(uid string, sess *session.PlayerSession) {
+	if s.combatH == nil || s.dice == nil {
+		return
+	}
+	effects := s.combatH.RoomEffects(sess.RoomID)
+	if len(effects) == 0 {
+		return
+	}
+	target := &combat.Combatant{
+		ID:          uid,
+		Kind:        combat.KindPlayer,
+		Name:        sess.CharName,
+		Resistances: sess.Resistances,
+		Weaknesses:  sess.Weaknesses,
+		Immunities:  sess.Immunities,
+	}
+	for _, e := range effects {
+		result := combat.RoomEffectDamage(nil, e, target, s.dice.Src())
+		note := combat.AdjustmentNote(result.Breakdown)
+		if result.Final <= 0 && note == "" {
+			continue
+		}
+		sess.CurrentHP = max(sess.CurrentHP-result.Final, 0)
+		msg := fmt.Sprintf("You walk into the %s and take %d damage.", e.Name, result.Final)
+		if note != "" {
+			msg += " " + note
+		}
+		pushMessage(sess, msg)
+	}
+	s.checkNonCombatDeath(uid, sess)
+}
@@ -0,0 +1,61 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+)
+
+func addBurningGround(t *testing.T, combatH *CombatHandler, roomID string) {
+	t.Helper()
+	combatH.combatMu.Lock()
+	defer combatH.combatMu.Unlock()
+	cbt, ok := combatH.engine.GetCombat(roomID)
+	require.True(t, ok)
+	cbt.AddRoomEffect(combat.RoomEffect{
+		ID: "incendiary_grenade", Name: "burning ground",
+		DamageDice: "1d4", DamageType: "fire", RoundsRemaining: 2,
+	})
+}
+
+func TestExposeToRoomEffects_WalkInTakesDamage(t *testing.T) {
+	svc, _, combatH, _ := newTempHPSvc(t)
+	addBurningGround(t, combatH, "room_a")
+	sess := addTestPlayerNamed(t, combatH.sessions, "walker", "room_a", "Walker")
+	sess.CurrentHP, sess.MaxHP = 30, 30
+
+	svc.exposeToRoomEffects("walker", sess)
+	assert.Less(t, sess.CurrentHP, 30)
+	assert.False(t, sess.Dead)
+
+	sess.CurrentHP = 30
+	sess.Immunities = []string{"fire"}
+	svc.exposeToRoomEffects("walker", sess)
+	assert.Equal(t, 30, sess.CurrentHP, "fire immunity keeps a walk-in unhurt")
+}
+
+func TestRoomView_ShowsRoomEffectsOnFloor(t *testing.T) {
+	svc, _, combatH, _ := newTempHPSvc(t)
+	addBurningGround(t, combatH, "room_a")
+
+	rv, err := svc.worldH.Look("hero")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"burning ground (2 rounds)"}, rv.RoomEffects)
+}
+
+func TestSpawnCombatant_JoinerEntersRoomEffects(t *testing.T) {
+	_, _, combatH, _ := newTempHPSvc(t)
+	addBurningGround(t, combatH, "room_a")
+
+	uid, err := combatH.SpawnCombatant("room_a", "goblin")
+	require.NoError(t, err)
+	inst, ok := combatH.npcMgr.Get(uid)
+	require.True(t, ok)
+	assert.Less(t, inst.CurrentHP, inst.MaxHP, "reinforcements are hit by the burning ground on arrival")
+
+	cbt, _ := combatH.GetCombatForRoom("room_a")
+	assert.Equal(t, inst.CurrentHP, cbt.GetCombatant(uid).CurrentHP)
+}
@@ -0,0 +1,223 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/schedule"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// schedulePollInterval is how often the scheduler looks for a new real-time minute.
+const schedulePollInterval = 10 * time.Second
+
+// InitSchedule loads the scheduled server events in path. An empty path
+// disables scheduled events.
+//
+// Postcondition: Returns an error when the file cannot be read or an event is invalid.
+func (s *GameServiceServer) InitSchedule(path string) error {
+	var events []*schedule.Event
+	if path != "" {
+		var err error
+		if events, err = schedule.Load(path); err != nil {
+			return err
+		}
+	}
+	s.scheduleMu.Lock()
+	defer s.scheduleMu.Unlock()
+	s.schedule = events
+	s.scheduleLastRun = make(map[string]time.Time)
+	return nil
+}
+
+// StartScheduler runs real-time events as their minute arrives and game-time
+// events on each calendar tick, and advances raids under way.
+//
+// Precondition: MUST be called after InitSchedule.
+// Postcondition: returns a stop function; call it to stop the scheduler.
+func (s *GameServiceServer) StartScheduler() func() {
+	var calCh chan GameDateTime
+	if s.calendar != nil {
+		calCh = make(chan GameDateTime, 4)
+		s.calendar.Subscribe(calCh)
+	}
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(schedulePollInterval)
+		defer ticker.Stop()
+		lastMinute := time.Now().UTC().Truncate(time.Minute)
+		for {
+			select {
+			case now := <-ticker.C:
+				s.tickRaids(now)
+				if minute := now.UTC().Truncate(time.Minute); minute.After(lastMinute) {
+					lastMinute = minute
+					s.runDueEvents(func(e *schedule.Event) bool {
+						return !e.IsGameTime() && e.Spec().MatchesReal(minute)
+					})
+				}
+			case dt := <-calCh:
+				s.runDueEvents(func(e *schedule.Event) bool {
+					return e.IsGameTime() && e.Spec().MatchesGame(int(dt.Hour), dt.Day, dt.Month)
+				})
+			case <-stop:
+				if calCh != nil {
+					s.calendar.Unsubscribe(calCh)
+				}
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// runDueEvents runs every scheduled event due reports true for.
+func (s *GameServiceServer) runDueEvents(due func(*schedule.Event) bool) {
+	s.scheduleMu.Lock()
+	var run []*schedule.Event
+	for _, e := range s.schedule {
+		if due(e) {
+			run = append(run, e)
+		}
+	}
+	s.scheduleMu.Unlock()
+	for _, e := range run {
+		s.runScheduledEvent(e, time.Now())
+	}
+}
+
+// runScheduledEvent broadcasts e's message and carries out its action.
+//
+// Postcondition: e's last run time is now.
+func (s *GameServiceServer) runScheduledEvent(e *schedule.Event, now time.Time) {
+	s.logger.Info("scheduled event", zap.String("event", e.ID), zap.String("action", string(e.Action)))
+	s.scheduleMu.Lock()
+	if s.scheduleLastRun == nil {
+		s.scheduleLastRun = make(map[string]time.Time)
+	}
+	s.scheduleLastRun[e.ID] = now
+	s.scheduleMu.Unlock()
+	if e.Message != "" {
+		for _, sess := range s.sessions.AllPlayers() {
+			s.pushMessageToUID(sess.UID, e.Message)
+		}
+	}
+	switch e.Action {
+	case schedule.ActionXPBonus:
+		if s.xpSvc != nil {
+			s.xpSvc.SetBonus(e.Multiplier, now.Add(e.Duration))
+		}
+	case schedule.ActionRestock:
+		s.restockAllMerchants()
+	case schedule.ActionHook:
+		if s.scriptMgr == nil {
+			return
+		}
+		if e.Zone != "" {
+			s.scriptMgr.CallHook(e.Zone, e.Hook, lua.LString(e.ID)) //nolint:errcheck
+			return
+		}
+		s.scriptMgr.CallHookEverywhere(e.Hook, lua.LString(e.ID))
+	case schedule.ActionZoneReset:
+		if zone, ok := s.world.GetZone(e.Zone); ok {
+			s.resetZone(zone, now)
+		} else {
+			s.logger.Warn("scheduled event: unknown zone", zap.String("event", e.ID), zap.String("zone", e.Zone))
+		}
+	case schedule.ActionRaid:
+		if err := s.startRaid(e.Raid, now); err != nil {
+			s.logger.Warn("scheduled event: raid not started", zap.String("event", e.ID), zap.Error(err))
+		}
+	}
+}
+
+// handleSchedule lists the scheduled server events or runs one now.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanManageSchedule (checked by dispatch); req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil.
+func (s *GameServiceServer) handleSchedule(uid string, req *gamev1.ScheduleRequest) (*gamev1.ServerEvent, error) {
+	s.scheduleMu.Lock()
+	events := s.schedule
+	lastRun := make(map[string]time.Time, len(s.scheduleLastRun))
+	for id, at := range s.scheduleLastRun {
+		lastRun[id] = at
+	}
+	s.scheduleMu.Unlock()
+
+	now := time.Now()
+	if req.GetAction() == "run" {
+		for _, e := range events {
+			if strings.EqualFold(e.ID, req.GetEventId()) {
+				s.runScheduledEvent(e, now)
+				return messageEvent(fmt.Sprintf("Ran scheduled event %s.", e.ID)), nil
+			}
+		}
+		return errorEvent(fmt.Sprintf("No scheduled event %q.", req.GetEventId())), nil
+	}
+
+	var b strings.Builder
+	if len(events) == 0 {
+		b.WriteString("No scheduled events.")
+	} else {
+		b.WriteString("Scheduled events:")
+	}
+	for _, e := range events {
+		fmt.Fprintf(&b, "\n  %s  %s  %s", e.ID, s.describeSchedule(e, now), describeAction(e))
+		last := "never"
+		if at, ok := lastRun[e.ID]; ok {
+			last = at.UTC().Format("2006-01-02 15:04 UTC")
+		}
+		fmt.Fprintf(&b, "  last run: %s", last)
+	}
+	if s.xpSvc != nil {
+		if multiplier, until := s.xpSvc.Bonus(now); multiplier != 1 {
+			fmt.Fprintf(&b, "\nXP bonus x%g is active until %s.", multiplier, until.UTC().Format("2006-01-02 15:04 UTC"))
+		}
+	}
+	return messageEvent(b.String()), nil
+}
+
+// describeSchedule renders e's schedule and when it next runs.
+func (s *GameServiceServer) describeSchedule(e *schedule.Event, now time.Time) string {
+	if !e.IsGameTime() {
+		desc := fmt.Sprintf("real %q", e.Real)
+		if next, ok := e.Spec().NextReal(now); ok {
+			return desc + ", next " + next.Format("2006-01-02 15:04 UTC")
+		}
+		return desc + ", never due"
+	}
+	desc := fmt.Sprintf("game %q", e.Game)
+	if s.calendar == nil {
+		return desc
+	}
+	dt := s.calendar.CurrentDateTime()
+	hours, ok := e.Spec().NextGame(int(dt.Hour), dt.Day, dt.Month)
+	if !ok {
+		return desc + ", never due"
+	}
+	return fmt.Sprintf("%s, next in %d game hours (%s real)", desc, hours,
+		formatRealDuration(time.Duration(hours)*s.calendar.clock.TickInterval()))
+}
+
+// describeAction renders what e does when it fires.
+func describeAction(e *schedule.Event) string {
+	switch e.Action {
+	case schedule.ActionXPBonus:
+		return fmt.Sprintf("xp_bonus x%g for %s", e.Multiplier, e.Duration)
+	case schedule.ActionHook:
+		if e.Zone != "" {
+			return fmt.Sprintf("hook %s in %s", e.Hook, e.Zone)
+		}
+		return "hook " + e.Hook
+	case schedule.ActionZoneReset:
+		return "zone_reset " + e.Zone
+	case schedule.ActionRaid:
+		return "raid " + e.Raid
+	}
+	return string(e.Action)
+}
@@ -0,0 +1,114 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/schedule"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/xp"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+const testScheduleYAML = `events:
+  - id: weekend_xp
+    real: "0 0 * * 6"
+    action: xp_bonus
+    multiplier: 2
+    duration: 48h
+    message: Double XP weekend has begun!
+  - id: dawn_market
+    game: "6 * *"
+    action: restock
+`
+
+// testScheduleService returns a service with testScheduleYAML loaded and one
+// player, u1, in room_a.
+func testScheduleService(t *testing.T) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testMinimalService(t, sessMgr)
+	svc.world = worldMgr
+	svc.xpSvc = xp.NewService(testXPConfig(), &grantXPProgressSaver{})
+	path := filepath.Join(t.TempDir(), "schedule.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testScheduleYAML), 0o600))
+	require.NoError(t, svc.InitSchedule(path))
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{UID: "u1", Username: "u", CharName: "Vex", RoomID: "room_a", Role: "admin"})
+	require.NoError(t, err)
+	return svc, sess
+}
+
+func TestInitSchedule_EmptyPathDisables(t *testing.T) {
+	_, sessMgr := testWorldAndSession(t)
+	svc := testMinimalService(t, sessMgr)
+	require.NoError(t, svc.InitSchedule(""))
+
+	evt, err := svc.handleSchedule("u1", &gamev1.ScheduleRequest{Action: "list"})
+	require.NoError(t, err)
+	assert.Equal(t, "No scheduled events.", trapEventText(evt))
+}
+
+func TestInitSchedule_RejectsInvalidFile(t *testing.T) {
+	_, sessMgr := testWorldAndSession(t)
+	svc := testMinimalService(t, sessMgr)
+	path := filepath.Join(t.TempDir(), "schedule.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("events:\n  - id: bad\n    real: \"nope\"\n    action: announce\n"), 0o600))
+	assert.Error(t, svc.InitSchedule(path))
+}
+
+func TestHandleSchedule_RunAppliesXPBonus(t *testing.T) {
+	svc, sess := testScheduleService(t)
+
+	evt, err := svc.handleSchedule("u1", &gamev1.ScheduleRequest{Action: "run", EventId: "weekend_xp"})
+	require.NoError(t, err)
+	assert.Equal(t, "Ran scheduled event weekend_xp.", trapEventText(evt))
+	assert.Contains(t, pushedText(t, sess), "Double XP weekend has begun!")
+
+	multiplier, until := svc.xpSvc.Bonus(time.Now())
+	assert.Equal(t, 2.0, multiplier)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), until, time.Minute)
+}
+
+func TestHandleSchedule_RunUnknownEvent(t *testing.T) {
+	svc, _ := testScheduleService(t)
+
+	evt, err := svc.handleSchedule("u1", &gamev1.ScheduleRequest{Action: "run", EventId: "nope"})
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), `No scheduled event "nope".`)
+}
+
+func TestHandleSchedule_List(t *testing.T) {
+	svc, _ := testScheduleService(t)
+	svc.calendar = NewGameCalendar(NewGameClock(4, time.Minute), 1, 1, 0, &noopRepo{})
+
+	evt, err := svc.handleSchedule("u1", &gamev1.ScheduleRequest{Action: "list"})
+	require.NoError(t, err)
+	text := trapEventText(evt)
+	assert.Contains(t, text, `weekend_xp  real "0 0 * * 6", next `)
+	assert.Contains(t, text, "xp_bonus x2 for 48h0m0s  last run: never")
+	assert.Contains(t, text, `dawn_market  game "6 * *", next in 2 game hours (2 minutes real)  restock`)
+	assert.NotContains(t, text, "XP bonus")
+
+	_, err = svc.handleSchedule("u1", &gamev1.ScheduleRequest{Action: "run", EventId: "dawn_market"})
+	require.NoError(t, err)
+	evt, err = svc.handleSchedule("u1", &gamev1.ScheduleRequest{Action: "list"})
+	require.NoError(t, err)
+	assert.NotContains(t, trapEventText(evt), "restock  last run: never")
+}
+
+func TestRunDueEvents_RunsOnlyMatchingEvents(t *testing.T) {
+	svc, _ := testScheduleService(t)
+
+	svc.runDueEvents(func(e *schedule.Event) bool { return e.IsGameTime() })
+	svc.scheduleMu.Lock()
+	_, ranMarket := svc.scheduleLastRun["dawn_market"]
+	_, ranXP := svc.scheduleLastRun["weekend_xp"]
+	svc.scheduleMu.Unlock()
+	assert.True(t, ranMarket)
+	assert.False(t, ranXP)
+}
@@ -0,0 +1,166 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/scripting"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// wireScriptMgrPlayerCallbacks wires the engine.player.* Lua callbacks, which
+// read and mutate a player's backpack and currency and talk to the player,
+// into the script manager.
+//
+// Precondition: Must be called after s.scriptMgr and s.invRegistry are initialized.
+// Postcondition: CountItem, GiveItem, TakeItem, GetCurrency, AdjustCurrency,
+// SendMessage, and SendPrompt are set on s.scriptMgr when it is non-nil; each
+// mutation is persisted and pushed to the player.
+func (s *GameServiceServer) wireScriptMgrPlayerCallbacks() {
+	if s.scriptMgr == nil {
+		return
+	}
+	s.scriptMgr.SendMessage = s.pushMessageToUID
+	s.scriptMgr.SendPrompt = func(uid, question string, options []string) {
+		s.pushMessageToUID(uid, renderScriptPrompt(question, options))
+	}
+	s.scriptMgr.CountItem = func(uid, itemDefID string) int {
+		sess, ok := s.sessions.GetPlayer(uid)
+		if !ok || sess.Backpack == nil {
+			return 0
+		}
+		n := 0
+		for _, inst := range sess.Backpack.FindByItemDefID(itemDefID) {
+			n += inst.Quantity
+		}
+		return n
+	}
+	s.scriptMgr.GiveItem = func(uid, itemDefID string, qty int) error {
+		sess, err := s.scriptPlayer(uid)
+		if err != nil {
+			return err
+		}
+		if s.invRegistry == nil {
+			return fmt.Errorf("no item registry")
+		}
+		if _, err := sess.Backpack.Add(itemDefID, qty, s.invRegistry); err != nil {
+			return err
+		}
+		s.scriptInventoryChanged(sess)
+		return nil
+	}
+	s.scriptMgr.TakeItem = func(uid, itemDefID string, qty int) error {
+		sess, err := s.scriptPlayer(uid)
+		if err != nil {
+			return err
+		}
+		insts := sess.Backpack.FindByItemDefID(itemDefID)
+		have := 0
+		for _, inst := range insts {
+			have += inst.Quantity
+		}
+		if have < qty {
+			return fmt.Errorf("player carries %d of %q, need %d", have, itemDefID, qty)
+		}
+		for _, inst := range insts {
+			if qty == 0 {
+				break
+			}
+			n := min(inst.Quantity, qty)
+			if err := sess.Backpack.Remove(inst.InstanceID, n); err != nil {
+				return err
+			}
+			qty -= n
+		}
+		s.scriptInventoryChanged(sess)
+		return nil
+	}
+	s.scriptMgr.GetCurrency = func(uid string) int {
+		sess, ok := s.sessions.GetPlayer(uid)
+		if !ok {
+			return 0
+		}
+		return sess.Currency
+	}
+	s.scriptMgr.AdjustCurrency = func(uid string, delta int) (int, error) {
+		sess, ok := s.sessions.GetPlayer(uid)
+		if !ok {
+			return 0, fmt.Errorf("player %q not found", uid)
+		}
+		if sess.Currency+delta < 0 {
+			return sess.Currency, fmt.Errorf("insufficient credits: have %d, need %d", sess.Currency, -delta)
+		}
+		sess.Currency += delta
+		s.emitCurrency(sess, delta, telemetry.SourceScript)
+		if s.charSaver != nil {
+			if err := s.charSaver.SaveCurrency(context.Background(), sess.CharacterID, sess.Currency); err != nil {
+				s.logger.Warn("engine.player: SaveCurrency failed", zap.String("uid", uid), zap.Error(err))
+			}
+		}
+		s.pushInventory(sess)
+		return sess.Currency, nil
+	}
+}
+
+// scriptPlayer returns the session of the player uid for an engine.player
+// inventory callback.
+//
+// Postcondition: On success the session has a non-nil Backpack.
+func (s *GameServiceServer) scriptPlayer(uid string) (*session.PlayerSession, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if sess.Backpack == nil {
+		return nil, fmt.Errorf("player %q has no backpack", uid)
+	}
+	return sess, nil
+}
+
+// scriptInventoryChanged persists and pushes sess's backpack after a script
+// changed it.
+func (s *GameServiceServer) scriptInventoryChanged(sess *session.PlayerSession) {
+	if s.charSaver != nil {
+		if err := s.saveInventory(sess); err != nil {
+			s.logger.Warn("engine.player: SaveInventory failed", zap.String("uid", sess.UID), zap.Error(err))
+		}
+	}
+	s.pushInventory(sess)
+}
+
+// renderScriptPrompt formats a script's question and its numbered options.
+func renderScriptPrompt(question string, options []string) string {
+	var sb strings.Builder
+	sb.WriteString(question)
+	for i, opt := range options {
+		fmt.Fprintf(&sb, "\n  %d. %s", i+1, opt)
+	}
+	sb.WriteString("\n(answer <number> to reply)")
+	return sb.String()
+}
+
+// handleAnswer answers the question a zone script last asked the player. The
+// script's callback runs with the answer and responds on its own.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a message when there is nothing to answer or the
+// answer matches no option; otherwise returns a nil event. error is always nil.
+func (s *GameServiceServer) handleAnswer(uid string, req *gamev1.AnswerRequest) (*gamev1.ServerEvent, error) {
+	if s.scriptMgr == nil {
+		return messageEvent("No one is waiting for your answer."), nil
+	}
+	err := s.scriptMgr.AnswerPrompt(uid, req.GetAnswer())
+	switch {
+	case errors.Is(err, scripting.ErrNoPrompt):
+		return messageEvent("No one is waiting for your answer."), nil
+	case err != nil:
+		return messageEvent(fmt.Sprintf("That is not one of the choices; %s.", err)), nil
+	}
+	return nil, nil
+}
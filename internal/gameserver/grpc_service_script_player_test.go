@@ -0,0 +1,101 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+func TestScriptMgrPlayerCallbacks_Items(t *testing.T) {
+	svc, uid, _ := newMerchantTestServer(t)
+	svc.scriptMgr = scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	svc.wireScriptMgrPlayerCallbacks()
+	mgr := svc.scriptMgr
+
+	require.NoError(t, mgr.GiveItem(uid, "stim_pack", 3))
+	assert.Equal(t, 3, mgr.CountItem(uid, "stim_pack"))
+	assert.Error(t, mgr.GiveItem(uid, "no_such_item", 1))
+	assert.Error(t, mgr.GiveItem("nobody", "stim_pack", 1))
+
+	err := mgr.TakeItem(uid, "stim_pack", 4)
+	require.Error(t, err)
+	assert.Equal(t, 3, mgr.CountItem(uid, "stim_pack"), "a failed take must leave the pack unchanged")
+
+	require.NoError(t, mgr.TakeItem(uid, "stim_pack", 2))
+	assert.Equal(t, 1, mgr.CountItem(uid, "stim_pack"))
+	assert.Equal(t, 0, mgr.CountItem("nobody", "stim_pack"))
+}
+
+func TestScriptMgrPlayerCallbacks_Currency(t *testing.T) {
+	svc, uid, _ := newMerchantTestServer(t)
+	svc.scriptMgr = scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	svc.wireScriptMgrPlayerCallbacks()
+	mgr := svc.scriptMgr
+
+	assert.Equal(t, 500, mgr.GetCurrency(uid))
+	balance, err := mgr.AdjustCurrency(uid, 25)
+	require.NoError(t, err)
+	assert.Equal(t, 525, balance)
+
+	_, err = mgr.AdjustCurrency(uid, -600)
+	require.Error(t, err)
+	assert.Equal(t, 525, mgr.GetCurrency(uid))
+
+	_, err = mgr.AdjustCurrency("nobody", 10)
+	assert.Error(t, err)
+}
+
+func TestWireScriptMgrPlayerCallbacks_NilScriptMgr(t *testing.T) {
+	s := &GameServiceServer{}
+	require.NotPanics(t, func() { s.wireScriptMgrPlayerCallbacks() })
+}
+
+func TestHandleAnswer_RoutesToScriptCallback(t *testing.T) {
+	svc, uid, _ := newMerchantTestServer(t)
+	svc.scriptMgr = scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	svc.wireScriptMgrPlayerCallbacks()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "riddle.lua"), []byte(`
+answered = ""
+function ask(uid)
+    engine.player.prompt(uid, "What has keys but no locks?", {"A piano", "A map"}, function(who, i, opt)
+        answered = opt
+        engine.player.message(who, "The sphinx nods.")
+    end)
+end
+function last() return answered end
+`), 0644))
+	require.NoError(t, svc.scriptMgr.LoadZone("test", dir, 10000))
+
+	evt, err := svc.handleAnswer(uid, &gamev1.AnswerRequest{Answer: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "No one is waiting for your answer.", evt.GetMessage().GetContent())
+
+	_, err = svc.scriptMgr.CallHook("test", "ask", lua.LString(uid))
+	require.NoError(t, err)
+
+	evt, err = svc.handleAnswer(uid, &gamev1.AnswerRequest{Answer: "7"})
+	require.NoError(t, err)
+	assert.Equal(t, "That is not one of the choices; choose 1-2.", evt.GetMessage().GetContent())
+
+	evt, err = svc.handleAnswer(uid, &gamev1.AnswerRequest{Answer: "a piano"})
+	require.NoError(t, err)
+	assert.Nil(t, evt)
+	ret, err := svc.scriptMgr.CallHook("test", "last")
+	require.NoError(t, err)
+	assert.Equal(t, "A piano", ret.String())
+}
+
+func TestRenderScriptPrompt(t *testing.T) {
+	assert.Equal(t, "Which way?\n  1. Left\n  2. Right\n(answer <number> to reply)",
+		renderScriptPrompt("Which way?", []string{"Left", "Right"}))
+}
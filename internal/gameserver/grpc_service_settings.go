@@ -0,0 +1,186 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/settings"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// AccountSettingsSaver persists an account's preferences.
+//
+// Precondition: accountID must be > 0; s must pass Validate.
+type AccountSettingsSaver interface {
+	Save(ctx context.Context, accountID int64, s settings.Settings) error
+}
+
+// messageChannel maps a chat message type to the settings channel that mutes it.
+//
+// Postcondition: Returns "" for message types that cannot be muted.
+func messageChannel(t gamev1.MessageType) string {
+	switch t {
+	case gamev1.MessageType_MESSAGE_TYPE_SAY:
+		return settings.ChannelSay
+	case gamev1.MessageType_MESSAGE_TYPE_EMOTE:
+		return settings.ChannelEmote
+	}
+	return ""
+}
+
+// saveAccountSettings persists sess.Settings for the session's account.
+//
+// Postcondition: Returns nil without writing when no saver is configured or
+// the account is unknown.
+func (s *GameServiceServer) saveAccountSettings(sess *session.PlayerSession) error {
+	if s.accountSettings == nil || sess.AccountID <= 0 {
+		return nil
+	}
+	return s.accountSettings.Save(s.commandCtx(sess.UID), sess.AccountID, sess.Settings)
+}
+
+// formatAccountSettings renders st as the settings command listing.
+func formatAccountSettings(st settings.Settings) string {
+	muted := "none"
+	if len(st.MutedChannels) > 0 {
+		muted = strings.Join(st.MutedChannels, ", ")
+	}
+	verbosity := st.CombatVerbosity
+	if verbosity == "" {
+		verbosity = "per character"
+	}
+	accessible := "off"
+	if st.AccessibleMode {
+		accessible = "on"
+	}
+	promptFormat := "default"
+	if st.PromptFormat != "" {
+		promptFormat = st.PromptFormat
+	}
+	return fmt.Sprintf("Account settings:\n  theme:      %s\n  accessible: %s\n  combat:     %s\n  muted:      %s\n  pager:      %s\n  prompt:     %s\nUsage: settings [theme <%s>|accessible <on|off>|mute <channel>|unmute <channel>|pager <auto|off|lines>]; prompt [set <format>|reset]",
+		st.Theme(), accessible, verbosity, muted, formatPageLength(st.PageLength), promptFormat, strings.Join(settings.Themes, "|"))
+}
+
+// formatPageLength describes a pager setting.
+func formatPageLength(n int) string {
+	switch n {
+	case settings.PageLengthAuto:
+		return "auto (terminal height)"
+	case settings.PageLengthOff:
+		return "off"
+	}
+	return fmt.Sprintf("%d lines", n)
+}
+
+// parsePageLength reads a pager setting of "auto", "off", or a line count.
+//
+// Postcondition: ok is false unless the result passes settings.IsValidPageLength.
+func parsePageLength(value string) (int, bool) {
+	switch value {
+	case "auto":
+		return settings.PageLengthAuto, true
+	case "off":
+		return settings.PageLengthOff, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 || !settings.IsValidPageLength(n) {
+		return 0, false
+	}
+	return n, true
+}
+
+// pushAccountSettings sends sess its settings so the frontend can apply the
+// ones it renders, such as the pager length.
+func pushAccountSettings(sess *session.PlayerSession) {
+	evt := &gamev1.ServerEvent{
+		Payload: &gamev1.ServerEvent_AccountSettings{AccountSettings: sess.Settings.ToProto()},
+	}
+	if data, err := proto.Marshal(evt); err == nil && sess.Entity != nil {
+		_ = sess.Entity.Push(data)
+	}
+}
+
+// handleSettings lists or changes an account-level preference.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: An empty key lists the settings unchanged. A valid change is
+// applied to sess.Settings, persisted (when a saver is configured), and pushed
+// to the session as an AccountSettings event; an
+// invalid value or failed save leaves sess.Settings unchanged and reports why.
+func (s *GameServiceServer) handleSettings(uid string, req *gamev1.SettingsRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if req.GetKey() == "" {
+		return messageEvent(formatAccountSettings(sess.Settings)), nil
+	}
+	next := sess.Settings
+	next.MutedChannels = append([]string(nil), sess.Settings.MutedChannels...)
+	value := strings.ToLower(req.GetValue())
+	var confirm string
+	switch req.GetKey() {
+	case "theme":
+		if !settings.IsValidTheme(value) {
+			return errorEvent(fmt.Sprintf("Unknown theme %q. Themes: %s.", value, strings.Join(settings.Themes, ", "))), nil
+		}
+		next.ColorTheme = value
+		confirm = fmt.Sprintf("Color theme set to %s.", value)
+	case "accessible":
+		switch value {
+		case "on":
+			next.AccessibleMode = true
+		case "off":
+			next.AccessibleMode = false
+		default:
+			return errorEvent("Usage: settings accessible <on|off>"), nil
+		}
+		confirm = fmt.Sprintf("Accessible mode %s.", value)
+	case "mute", "unmute":
+		if !settings.IsValidChannel(value) {
+			return errorEvent(fmt.Sprintf("Unknown channel %q. Channels: %s.", value, strings.Join(settings.Channels, ", "))), nil
+		}
+		if req.GetKey() == "mute" {
+			next.Mute(value)
+			confirm = fmt.Sprintf("You will no longer hear %s.", value)
+		} else {
+			next.Unmute(value)
+			confirm = fmt.Sprintf("You will hear %s again.", value)
+		}
+	case "pager":
+		n, ok := parsePageLength(value)
+		if !ok {
+			return errorEvent(fmt.Sprintf("Usage: settings pager <auto|off|%d-%d>", settings.MinPageLength, settings.MaxPageLength)), nil
+		}
+		next.PageLength = n
+		confirm = fmt.Sprintf("Pager set to %s.", formatPageLength(n))
+	case "prompt":
+		// Formats are case-sensitive; token names are checked by the frontend.
+		format := strings.TrimSpace(req.GetValue())
+		if !settings.IsValidPromptFormat(format) {
+			return errorEvent(fmt.Sprintf("A prompt may hold at most %d printable characters.", settings.MaxPromptFormatLength)), nil
+		}
+		next.PromptFormat = format
+		confirm = "Prompt reset to the default."
+		if format != "" {
+			confirm = "Prompt set."
+		}
+	default:
+		return errorEvent(fmt.Sprintf("Unknown setting %q.", req.GetKey())), nil
+	}
+	prev := sess.Settings
+	sess.Settings = next
+	if err := s.saveAccountSettings(sess); err != nil {
+		sess.Settings = prev
+		s.logger.Warn("handleSettings: saving account settings failed", zap.Error(err))
+		return errorEvent("Failed to save settings. Please try again."), nil
+	}
+	pushAccountSettings(sess)
+	return messageEvent(confirm), nil
+}
@@ -0,0 +1,200 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/settings"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// fakeSettingsSaver records saved settings and optionally fails.
+type fakeSettingsSaver struct {
+	saved map[int64]settings.Settings
+	err   error
+}
+
+func (f *fakeSettingsSaver) Save(_ context.Context, accountID int64, st settings.Settings) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.saved == nil {
+		f.saved = make(map[int64]settings.Settings)
+	}
+	f.saved[accountID] = st
+	return nil
+}
+
+func TestHandleSettings_ListsCurrentSettings(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+	sess.Settings = settings.Settings{ColorTheme: "dark", MutedChannels: []string{"emote"}}
+
+	evt, err := svc.handleSettings("u1", &gamev1.SettingsRequest{})
+	require.NoError(t, err)
+	content := evt.GetMessage().GetContent()
+	assert.Contains(t, content, "theme:      dark")
+	assert.Contains(t, content, "muted:      emote")
+	assert.Contains(t, content, "per character")
+}
+
+func TestHandleSettings_AppliesAndPersistsChanges(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	saver := &fakeSettingsSaver{}
+	svc.accountSettings = saver
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+	sess.AccountID = 42
+
+	for _, req := range []*gamev1.SettingsRequest{
+		{Key: "theme", Value: "High_Contrast"},
+		{Key: "accessible", Value: "on"},
+		{Key: "mute", Value: "say"},
+	} {
+		evt, err := svc.handleSettings("u1", req)
+		require.NoError(t, err)
+		require.Nil(t, evt.GetError(), req.GetKey())
+	}
+
+	want := settings.Settings{ColorTheme: "high_contrast", AccessibleMode: true, MutedChannels: []string{"say"}}
+	assert.Equal(t, want, sess.Settings)
+	assert.Equal(t, want, saver.saved[42])
+
+	_, err := svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "unmute", Value: "say"})
+	require.NoError(t, err)
+	assert.False(t, saver.saved[42].Muted("say"))
+}
+
+func TestHandleSettings_RejectsInvalidValues(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+
+	for _, req := range []*gamev1.SettingsRequest{
+		{Key: "theme", Value: "neon"},
+		{Key: "accessible", Value: "maybe"},
+		{Key: "mute", Value: "ooc"},
+		{Key: "volume", Value: "11"},
+	} {
+		evt, err := svc.handleSettings("u1", req)
+		require.NoError(t, err)
+		assert.NotEmpty(t, evt.GetError().GetMessage(), req.GetKey())
+	}
+	assert.Equal(t, settings.Settings{}, sess.Settings)
+}
+
+func TestHandleSettings_PagerPushesSettings(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+
+	for _, tc := range []struct {
+		value string
+		want  int
+	}{
+		{"40", 40},
+		{"off", settings.PageLengthOff},
+		{"AUTO", settings.PageLengthAuto},
+	} {
+		evt, err := svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "pager", Value: tc.value})
+		require.NoError(t, err)
+		require.Nil(t, evt.GetError(), tc.value)
+		assert.Equal(t, tc.want, sess.Settings.PageLength)
+
+		select {
+		case data := <-sess.Entity.Events():
+			var pushed gamev1.ServerEvent
+			require.NoError(t, proto.Unmarshal(data, &pushed))
+			assert.Equal(t, int32(tc.want), pushed.GetAccountSettings().GetPageLength(), tc.value)
+		case <-time.After(time.Second):
+			t.Fatalf("no AccountSettings event pushed for pager %s", tc.value)
+		}
+	}
+
+	for _, value := range []string{"5", "0", "-1", "many"} {
+		evt, err := svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "pager", Value: value})
+		require.NoError(t, err)
+		assert.Contains(t, evt.GetError().GetMessage(), "settings pager", value)
+	}
+	assert.Equal(t, settings.PageLengthAuto, sess.Settings.PageLength)
+	evt, err := svc.handleSettings("u1", &gamev1.SettingsRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "pager:      auto")
+}
+
+func TestHandleSettings_PromptFormat(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	saver := &fakeSettingsSaver{}
+	svc.accountSettings = saver
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+	sess.AccountID = 42
+
+	evt, err := svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "prompt", Value: "%HP %ap> "})
+	require.NoError(t, err)
+	assert.Equal(t, "Prompt set.", evt.GetMessage().GetContent())
+	assert.Equal(t, "%HP %ap>", sess.Settings.PromptFormat, "formats keep their case")
+	assert.Equal(t, "%HP %ap>", saver.saved[42].PromptFormat)
+
+	evt, err = svc.handleSettings("u1", &gamev1.SettingsRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "prompt:     %HP %ap>")
+
+	evt, err = svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "prompt", Value: "\x1b[2J"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, evt.GetError().GetMessage())
+
+	evt, err = svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "prompt"})
+	require.NoError(t, err)
+	assert.Equal(t, "Prompt reset to the default.", evt.GetMessage().GetContent())
+	assert.Empty(t, sess.Settings.PromptFormat)
+}
+
+func TestHandleSettings_RollsBackOnSaveFailure(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	svc.accountSettings = &fakeSettingsSaver{err: errors.New("db down")}
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+	sess.AccountID = 42
+
+	evt, err := svc.handleSettings("u1", &gamev1.SettingsRequest{Key: "mute", Value: "emote"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "Failed to save")
+	assert.Empty(t, sess.Settings.MutedChannels)
+}
+
+func TestHandleSettings_PlayerNotFound(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	_, err := svc.handleSettings("ghost", &gamev1.SettingsRequest{})
+	assert.Error(t, err)
+}
+
+func TestBroadcastMessage_SkipsMutedRecipients(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "speaker", 1)
+	listener := addPlayerForCombatDefault(t, svc, "listener", 2)
+	muter := addPlayerForCombatDefault(t, svc, "muter", 3)
+	muter.Settings.Mute(settings.ChannelSay)
+
+	svc.broadcastMessage("room_a", "speaker", &gamev1.MessageEvent{
+		Sender:  "speaker",
+		Content: "hello",
+		Type:    gamev1.MessageType_MESSAGE_TYPE_SAY,
+	})
+
+	assert.Contains(t, drainAllEntityEvents(t, listener.Entity.Events(), 50*time.Millisecond), "hello")
+	assert.Empty(t, drainAllEntityEvents(t, muter.Entity.Events(), 50*time.Millisecond))
+}
+
+func TestHandleCombatVerbosity_SavesAccountSettings(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	saver := &fakeSettingsSaver{}
+	svc.accountSettings = saver
+	sess := addPlayerForCombatDefault(t, svc, "u1", 1)
+	sess.AccountID = 7
+
+	_, err := svc.handleCombatVerbosity("u1", "brief")
+	require.NoError(t, err)
+	assert.Equal(t, "brief", saver.saved[7].CombatVerbosity)
+}
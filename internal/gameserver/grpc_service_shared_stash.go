@@ -0,0 +1,86 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// AccountStashStore persists the stash shared by all of an account's characters.
+//
+// Precondition: accountID must be > 0.
+type AccountStashStore interface {
+	LoadSharedStash(ctx context.Context, accountID int64) (int, error)
+	// AdjustSharedStash adds delta, returning postgres.ErrSharedStashShort
+	// when the balance would go below zero.
+	AdjustSharedStash(ctx context.Context, accountID int64, delta int) (int, error)
+}
+
+// handleSharedStash shows the account's shared stash or moves credits
+// between it and the character's own stash.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: The shared balance is only changed in storage, atomically,
+// so every character of the account sees the same balance; a failed move
+// leaves both stashes unchanged.
+func (s *GameServiceServer) handleSharedStash(uid string, req *gamev1.SharedStashRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.accountStash == nil || sess.AccountID <= 0 {
+		return errorEvent("The shared stash is not available."), nil
+	}
+	ctx := s.commandCtx(uid)
+	amount := int(req.GetAmount())
+
+	switch req.GetAction() {
+	case "":
+		shared, err := s.accountStash.LoadSharedStash(ctx, sess.AccountID)
+		if err != nil {
+			s.logger.Warn("handleSharedStash: loading balance failed", zap.Int64("account_id", sess.AccountID), zap.Error(err))
+			return errorEvent("Failed to read the shared stash. Please try again."), nil
+		}
+		return messageEvent(fmt.Sprintf(
+			"Shared stash: %d credits, available to every character on your account.\nYour stash: %d credits.",
+			shared, sess.Stash(),
+		)), nil
+	case "deposit":
+		if amount < 1 {
+			return errorEvent("Amount must be a positive number."), nil
+		}
+		if balance, ok := sess.DebitStash(amount); !ok {
+			return errorEvent(fmt.Sprintf("Your stash only holds %d credits.", balance)), nil
+		}
+		shared, err := s.accountStash.AdjustSharedStash(ctx, sess.AccountID, amount)
+		if err != nil {
+			sess.CreditStash(amount)
+			s.logger.Warn("handleSharedStash: deposit failed", zap.Int64("account_id", sess.AccountID), zap.Error(err))
+			return errorEvent("Failed to deposit into the shared stash. Please try again."), nil
+		}
+		s.saveStashBalance(sess)
+		return messageEvent(fmt.Sprintf("You move %d credits from your stash into the shared stash. Shared stash: %d credits.", amount, shared)), nil
+	case "withdraw":
+		if amount < 1 {
+			return errorEvent("Amount must be a positive number."), nil
+		}
+		shared, err := s.accountStash.AdjustSharedStash(ctx, sess.AccountID, -amount)
+		if errors.Is(err, postgres.ErrSharedStashShort) {
+			return errorEvent(fmt.Sprintf("The shared stash holds less than %d credits.", amount)), nil
+		}
+		if err != nil {
+			s.logger.Warn("handleSharedStash: withdrawal failed", zap.Int64("account_id", sess.AccountID), zap.Error(err))
+			return errorEvent("Failed to withdraw from the shared stash. Please try again."), nil
+		}
+		sess.CreditStash(amount)
+		s.saveStashBalance(sess)
+		return messageEvent(fmt.Sprintf("You move %d credits from the shared stash into your stash. Shared stash: %d credits.", amount, shared)), nil
+	default:
+		return errorEvent("usage: shared [deposit|withdraw <amount>]"), nil
+	}
+}
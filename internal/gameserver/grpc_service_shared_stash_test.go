@@ -0,0 +1,88 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// fakeAccountStash is an in-memory AccountStashStore.
+type fakeAccountStash struct {
+	balances map[int64]int
+}
+
+func (f *fakeAccountStash) LoadSharedStash(_ context.Context, accountID int64) (int, error) {
+	return f.balances[accountID], nil
+}
+
+func (f *fakeAccountStash) AdjustSharedStash(_ context.Context, accountID int64, delta int) (int, error) {
+	if f.balances[accountID]+delta < 0 {
+		return 0, postgres.ErrSharedStashShort
+	}
+	f.balances[accountID] += delta
+	return f.balances[accountID], nil
+}
+
+func newSharedStashTestService(t *testing.T) (*GameServiceServer, *fakeAccountStash) {
+	t.Helper()
+	svc := testServiceForCombatDefault(t, nil)
+	store := &fakeAccountStash{balances: map[int64]int{7: 30}}
+	svc.accountStash = store
+	sess := addPlayerForCombatDefault(t, svc, "ace", 1)
+	sess.AccountID = 7
+	sess.StashBalance = 100
+	return svc, store
+}
+
+func TestHandleSharedStash_ShowsBalances(t *testing.T) {
+	svc, _ := newSharedStashTestService(t)
+
+	evt, err := svc.handleSharedStash("ace", &gamev1.SharedStashRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Shared stash: 30 credits")
+	assert.Contains(t, evt.GetMessage().GetContent(), "Your stash: 100 credits.")
+}
+
+func TestHandleSharedStash_DepositAndWithdraw(t *testing.T) {
+	svc, store := newSharedStashTestService(t)
+	sess := player(t, svc, "ace")
+
+	evt, err := svc.handleSharedStash("ace", &gamev1.SharedStashRequest{Action: "deposit", Amount: 40})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Shared stash: 70 credits.")
+	assert.Equal(t, 60, sess.StashBalance)
+	assert.Equal(t, 70, store.balances[7])
+
+	evt, err = svc.handleSharedStash("ace", &gamev1.SharedStashRequest{Action: "withdraw", Amount: 70})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Shared stash: 0 credits.")
+	assert.Equal(t, 130, sess.StashBalance)
+}
+
+func TestHandleSharedStash_Rejects(t *testing.T) {
+	svc, store := newSharedStashTestService(t)
+	sess := player(t, svc, "ace")
+
+	evt, err := svc.handleSharedStash("ace", &gamev1.SharedStashRequest{Action: "deposit", Amount: 101})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "only holds 100")
+	evt, err = svc.handleSharedStash("ace", &gamev1.SharedStashRequest{Action: "withdraw", Amount: 31})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "less than 31")
+	assert.Equal(t, 100, sess.StashBalance)
+	assert.Equal(t, 30, store.balances[7])
+}
+
+func TestHandleSharedStash_Unavailable(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "ace", 1)
+
+	evt, err := svc.handleSharedStash("ace", &gamev1.SharedStashRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not available")
+}
@@ -0,0 +1,80 @@
+package gameserver
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// Raised voices cost a breath: a player must wait this long after shouting
+// or yelling before doing either again.
+const (
+	shoutCooldown = 10 * time.Second
+	yellCooldown  = 3 * time.Second
+)
+
+// handleShout speaks to the sender's room and carries the words across the zone.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns the sender's echo, or an error event when the chat
+// filter rejects the message or the sender is still catching their breath.
+func (s *GameServiceServer) handleShout(uid string, req *gamev1.ShoutRequest) (*gamev1.ServerEvent, error) {
+	return s.raiseVoice(uid, req.GetMessage(), "shout", world.LoudnessZone, shoutCooldown)
+}
+
+// handleYell speaks to the sender's room and carries the words into the
+// neighbouring rooms.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: As handleShout, but heard only one room away.
+func (s *GameServiceServer) handleYell(uid string, req *gamev1.YellRequest) (*gamev1.ServerEvent, error) {
+	return s.raiseVoice(uid, req.GetMessage(), "yell", world.LoudnessAdjacent, yellCooldown)
+}
+
+// raiseVoice implements shout and yell: verb names the action, loud how far
+// it carries, and cooldown how long the sender must wait before raising their
+// voice again.
+func (s *GameServiceServer) raiseVoice(uid, text, verb string, loud world.Loudness, cooldown time.Duration) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if text == "" {
+		return errorEvent(fmt.Sprintf("What do you want to %s?", verb)), nil
+	}
+
+	now := time.Now()
+	s.soundMu.Lock()
+	if s.lastShout == nil {
+		s.lastShout = make(map[string]time.Time)
+	}
+	if last, ok := s.lastShout[uid]; ok && now.Sub(last) < cooldown {
+		s.soundMu.Unlock()
+		wait := cooldown - now.Sub(last)
+		return errorEvent(fmt.Sprintf("You need to catch your breath before you %s again (%ds).", verb, int(math.Ceil(wait.Seconds())))), nil
+	}
+	s.soundMu.Unlock()
+
+	if s.chatH != nil {
+		cleaned, err := s.chatH.screen(sess, text)
+		if err != nil {
+			return errorEvent(err.Error()), nil
+		}
+		text = cleaned
+	}
+
+	s.soundMu.Lock()
+	s.lastShout[uid] = now
+	s.soundMu.Unlock()
+
+	s.broadcastMessage(sess.RoomID, uid, &gamev1.MessageEvent{
+		Content: fmt.Sprintf("%s %ss, \"%s\"", sess.CharName, verb, text),
+	})
+	s.carrySound(sess.RoomID, loud, func(from string) string {
+		return fmt.Sprintf("You hear %s %sing %s, \"%s\"", sess.CharName, verb, from, text)
+	})
+	return messageEvent(fmt.Sprintf("You %s, \"%s\"", verb, text)), nil
+}
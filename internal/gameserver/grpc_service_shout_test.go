@@ -0,0 +1,40 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+func TestHandleShout_CarriesAcrossZoneFilteredWithCooldown(t *testing.T) {
+	s, players := newSoundTestServer(t)
+	s.chatH = NewChatHandler(s.sessions)
+	s.chatH.SetFilter(NewChatFilter(config.ChatConfig{}, []string{"darn"}))
+
+	evt, err := s.handleShout("bar", &gamev1.ShoutRequest{Message: "darn raiders!"})
+	require.NoError(t, err)
+	assert.Equal(t, `You shout, "**** raiders!"`, evt.GetMessage().GetContent())
+	assert.Equal(t, []string{`You hear bar shouting to the east, "**** raiders!"`}, drainAllEntityEvents(t, players["street"].Entity.Events(), 50*time.Millisecond))
+	assert.Equal(t, []string{`You hear bar shouting somewhere in the distance, "**** raiders!"`}, drainAllEntityEvents(t, players["backroom"].Entity.Events(), 50*time.Millisecond))
+
+	evt, err = s.handleYell("bar", &gamev1.YellRequest{Message: "again"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "catch your breath")
+	assert.Empty(t, drainAllEntityEvents(t, players["street"].Entity.Events(), 50*time.Millisecond))
+}
+
+func TestHandleYell_ReachesOnlyAdjacentRooms(t *testing.T) {
+	s, players := newSoundTestServer(t)
+
+	evt, err := s.handleYell("cellar", &gamev1.YellRequest{Message: "down here"})
+	require.NoError(t, err)
+	assert.Equal(t, `You yell, "down here"`, evt.GetMessage().GetContent())
+	assert.Equal(t, []string{`You hear cellar yelling from below, "down here"`}, drainAllEntityEvents(t, players["bar"].Entity.Events(), 50*time.Millisecond))
+	assert.Equal(t, []string{`You hear cellar yelling to the south, "down here"`}, drainAllEntityEvents(t, players["backroom"].Entity.Events(), 50*time.Millisecond))
+	assert.Empty(t, drainAllEntityEvents(t, players["street"].Entity.Events(), 50*time.Millisecond))
+}
@@ -0,0 +1,140 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/siteban"
+)
+
+// SetSiteBans installs the site allow/deny list managed by the siteban command.
+//
+// Precondition: l should be the list the gRPC interceptor enforces.
+// Postcondition: The siteban command edits l.
+func (s *GameServiceServer) SetSiteBans(l *siteban.List) {
+	s.siteBans = l
+}
+
+// handleSiteBan lists, adds, or removes site allow/deny rules. Adding a deny
+// rule disconnects online players it now blocks, other than the issuer.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: Rule changes are persisted before they take effect.
+func (s *GameServiceServer) handleSiteBan(uid string, req *gamev1.SiteBanRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.siteBans == nil {
+		return errorEvent("Site bans are not available."), nil
+	}
+	action := req.GetAction()
+	if action == "" || action == "list" {
+		return messageEvent(formatSiteBans(s.siteBans.Rules())), nil
+	}
+	if action != "add" && action != "allow" && action != "remove" {
+		return errorEvent("usage: siteban list | add <cidr> [reason] | allow <cidr> [reason] | remove <cidr>"), nil
+	}
+	prefix, err := siteban.ParsePrefix(req.GetCidr())
+	if err != nil {
+		return errorEvent(err.Error()), nil
+	}
+	ctx := s.commandCtx(uid)
+
+	if action == "remove" {
+		found, err := s.siteBans.Remove(ctx, prefix)
+		if err != nil {
+			s.logger.Warn("handleSiteBan: removing rule failed", zap.String("cidr", prefix.String()), zap.Error(err))
+			return errorEvent("Failed to remove the site ban. Please try again."), nil
+		}
+		if !found {
+			return errorEvent(fmt.Sprintf("No site ban rule for %s.", prefix)), nil
+		}
+		return messageEvent(fmt.Sprintf("Removed the site ban rule for %s.", prefix)), nil
+	}
+
+	rule := siteban.Rule{Prefix: prefix, Allow: action == "allow", Reason: req.GetReason(), CreatedBy: sess.Username}
+	if err := s.siteBans.Put(ctx, rule); err != nil {
+		s.logger.Warn("handleSiteBan: saving rule failed", zap.String("cidr", prefix.String()), zap.Error(err))
+		return errorEvent("Failed to save the site ban. Please try again."), nil
+	}
+	if rule.Allow {
+		return messageEvent(fmt.Sprintf("Allowed %s.", prefix)), nil
+	}
+	msg := fmt.Sprintf("Banned %s.", prefix)
+	if n := s.disconnectSiteBanned(ctx, prefix, uid); n > 0 {
+		msg += fmt.Sprintf(" Disconnected %d %s.", n, pluralize(n, "player", "players"))
+	}
+	if addr, ok := siteban.ParseAddr(sess.RemoteAddr); ok && prefix.Contains(addr) {
+		msg += " Warning: your own address is in this range."
+	}
+	return messageEvent(msg), nil
+}
+
+// disconnectSiteBanned disconnects every online player other than exceptUID
+// whose address lies in prefix and is now blocked.
+//
+// Postcondition: Returns the number of players disconnected.
+func (s *GameServiceServer) disconnectSiteBanned(ctx context.Context, prefix netip.Prefix, exceptUID string) int {
+	n := 0
+	for _, target := range s.sessions.AllPlayers() {
+		if target.UID == exceptUID {
+			continue
+		}
+		addr, ok := siteban.ParseAddr(target.RemoteAddr)
+		if !ok || !prefix.Contains(addr) {
+			continue
+		}
+		d, err := s.siteBans.Check(ctx, addr)
+		if err != nil || !d.Blocked {
+			continue
+		}
+		s.pushSiteBanDisconnect(target, d)
+		n++
+	}
+	return n
+}
+
+// pushSiteBanDisconnect tells target's client it has been disconnected by a site ban.
+func (s *GameServiceServer) pushSiteBanDisconnect(target *session.PlayerSession, d siteban.Decision) {
+	evt := &gamev1.ServerEvent{
+		Payload: &gamev1.ServerEvent_Disconnected{
+			Disconnected: &gamev1.Disconnected{Reason: d.Message()},
+		},
+	}
+	if data, err := proto.Marshal(evt); err == nil {
+		_ = target.Entity.Push(data)
+	}
+	s.logger.Info("disconnected site-banned player",
+		zap.String("uid", target.UID),
+		zap.String("remote_addr", target.RemoteAddr),
+	)
+}
+
+// formatSiteBans renders rules one per line.
+func formatSiteBans(rules []siteban.Rule) string {
+	if len(rules) == 0 {
+		return "No site ban rules."
+	}
+	var b strings.Builder
+	b.WriteString("Site ban rules:")
+	for _, r := range rules {
+		kind := "deny "
+		if r.Allow {
+			kind = "allow"
+		}
+		fmt.Fprintf(&b, "\n  %s %-18s", kind, r.Prefix)
+		if r.Reason != "" {
+			fmt.Fprintf(&b, " %s", r.Reason)
+		}
+		fmt.Fprintf(&b, " (%s, %s)", r.CreatedBy, r.CreatedAt.Format("2006-01-02"))
+	}
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package gameserver
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/siteban"
+)
+
+// newSiteBanTestServer builds a server whose players "admin", "bee", and "cat"
+// connected from 10.0.0.1, 203.0.113.5, and 203.0.113.6.
+func newSiteBanTestServer(t *testing.T) *GameServiceServer {
+	t.Helper()
+	room := &world.Room{ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza."}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza",
+		Rooms: map[string]*world.Room{"plaza": room}}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	addrs := map[string]string{"admin": "10.0.0.1:4000", "bee": "203.0.113.5:4000", "cat": "203.0.113.6:4000"}
+	for i, uid := range []string{"admin", "bee", "cat"} {
+		sess, err := sMgr.AddPlayer(session.AddPlayerOptions{
+			UID: uid, Username: uid, CharName: uid, CharacterID: int64(i + 1),
+			RoomID: "plaza", CurrentHP: 10, MaxHP: 10,
+			Abilities: character.AbilityScores{}, Role: "admin",
+		})
+		require.NoError(t, err)
+		sess.RemoteAddr = addrs[uid]
+	}
+	s := &GameServiceServer{sessions: sMgr, world: wMgr, logger: zap.NewNop()}
+	s.SetSiteBans(siteban.NewList(nil))
+	return s
+}
+
+func siteBanCmd(t *testing.T, s *GameServiceServer, action, cidr, reason string) string {
+	t.Helper()
+	evt, err := s.handleSiteBan("admin", &gamev1.SiteBanRequest{Action: action, Cidr: cidr, Reason: reason})
+	require.NoError(t, err)
+	if e := evt.GetError(); e != nil {
+		return e.GetMessage()
+	}
+	return evt.GetMessage().GetContent()
+}
+
+// disconnectReasons drains sess's pushed events and returns the Disconnected reasons.
+func disconnectReasons(t *testing.T, sess *session.PlayerSession) []string {
+	t.Helper()
+	var reasons []string
+	for {
+		select {
+		case data := <-sess.Entity.Events():
+			var evt gamev1.ServerEvent
+			if err := proto.Unmarshal(data, &evt); err == nil && evt.GetDisconnected() != nil {
+				reasons = append(reasons, evt.GetDisconnected().GetReason())
+			}
+		default:
+			return reasons
+		}
+	}
+}
+
+func TestHandleSiteBan_AddDisconnectsBlockedPlayers(t *testing.T) {
+	s := newSiteBanTestServer(t)
+	assert.Equal(t, "Allowed 203.0.113.6/32.", siteBanCmd(t, s, "allow", "203.0.113.6", ""))
+	assert.Equal(t, "Banned 203.0.113.0/24. Disconnected 1 player.", siteBanCmd(t, s, "add", "203.0.113.9/24", "abuse"))
+
+	assert.Equal(t, []string{"Your address is banned from this site: abuse"}, disconnectReasons(t, player(t, s, "bee")))
+	assert.Empty(t, disconnectReasons(t, player(t, s, "cat")), "a more specific allow rule wins")
+	assert.Empty(t, disconnectReasons(t, player(t, s, "admin")))
+
+	list := siteBanCmd(t, s, "list", "", "")
+	assert.Contains(t, list, "deny  203.0.113.0/24")
+	assert.Contains(t, list, "abuse (admin, ")
+	assert.Contains(t, list, "allow 203.0.113.6/32")
+}
+
+func TestHandleSiteBan_IssuerIsWarnedNotDisconnected(t *testing.T) {
+	s := newSiteBanTestServer(t)
+	assert.Equal(t, "Banned 10.0.0.0/8. Warning: your own address is in this range.", siteBanCmd(t, s, "add", "10.0.0.0/8", ""))
+	assert.Empty(t, disconnectReasons(t, player(t, s, "admin")))
+}
+
+func TestHandleSiteBan_Remove(t *testing.T) {
+	s := newSiteBanTestServer(t)
+	assert.Equal(t, "No site ban rule for 198.51.100.0/24.", siteBanCmd(t, s, "remove", "198.51.100.0/24", ""))
+	siteBanCmd(t, s, "add", "198.51.100.0/24", "")
+	assert.Equal(t, "Removed the site ban rule for 198.51.100.0/24.", siteBanCmd(t, s, "remove", "198.51.100.1/24", ""))
+	assert.Equal(t, "No site ban rules.", siteBanCmd(t, s, "list", "", ""))
+	d, err := s.siteBans.Check(context.Background(), netip.MustParseAddr("198.51.100.1"))
+	require.NoError(t, err)
+	assert.False(t, d.Blocked)
+}
+
+func TestHandleSiteBan_InvalidInputAndUnavailable(t *testing.T) {
+	s := newSiteBanTestServer(t)
+	assert.Contains(t, siteBanCmd(t, s, "add", "not-an-ip", ""), "invalid address or CIDR")
+	assert.Contains(t, siteBanCmd(t, s, "ban", "10.0.0.1", ""), "usage: siteban")
+
+	s.siteBans = nil
+	assert.Equal(t, "Site bans are not available.", siteBanCmd(t, s, "list", "", ""))
+	_, err := s.handleSiteBan("nobody", &gamev1.SiteBanRequest{})
+	assert.Error(t, err)
+}
+
+func TestSiteBan_RequiresCapability(t *testing.T) {
+	c, ok := requiredCapability(&gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SiteBan{SiteBan: &gamev1.SiteBanRequest{}}})
+	require.True(t, ok)
+	assert.Equal(t, "can_manage_site_bans", string(c))
+}
@@ -0,0 +1,145 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// handleInvis hides or reveals a staff member. Invisible players are left out
+// of room views, who lists, and arrival and departure broadcasts.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanGoInvisible (checked by dispatch); req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleInvis(uid string, req *gamev1.InvisRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	on := !sess.Invisible
+	switch req.GetAction() {
+	case "on":
+		on = true
+	case "off":
+		on = false
+	}
+	if on == sess.Invisible {
+		if on {
+			return messageEvent("You are already invisible."), nil
+		}
+		return messageEvent("You are already visible."), nil
+	}
+	if err := s.sessions.SetInvisible(uid, on); err != nil {
+		return nil, err
+	}
+	// Refresh everyone's player list so the change shows at once.
+	s.pushRoomViewToAllInRoom(sess.RoomID)
+	if on {
+		return messageEvent("You fade from view. Other players won't see you in rooms, who lists, or arrivals."), nil
+	}
+	return messageEvent("You are visible again."), nil
+}
+
+// handleSnoop starts or stops mirroring a player's session to uid. The
+// snooped player is told who is watching and why, and the snoop is recorded
+// in the admin audit trail by dispatch.
+//
+// Precondition: uid must identify an active session holding
+// permission.CanSnoop (checked by dispatch); req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleSnoop(uid string, req *gamev1.SnoopRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if req.GetTarget() == "" {
+		targetUID, ok := s.sessions.StopSnoop(uid)
+		if !ok {
+			return messageEvent("You aren't snooping anyone."), nil
+		}
+		name := targetUID
+		if target, found := s.sessions.GetPlayer(targetUID); found {
+			name = target.CharName
+			s.pushMessageToUID(targetUID, fmt.Sprintf("%s has stopped observing your session.", sess.CharName))
+		}
+		return messageEvent(fmt.Sprintf("You stop snooping %s.", name)), nil
+	}
+	if strings.TrimSpace(req.GetReason()) == "" {
+		return errorEvent("Give a reason: snoop <player> <reason>."), nil
+	}
+	target := s.sessions.GetPlayerByCharNameCI(req.GetTarget())
+	if target == nil {
+		return errorEvent(fmt.Sprintf("No player named %q is online.", req.GetTarget())), nil
+	}
+	previousUID, err := s.sessions.StartSnoop(uid, target.UID)
+	if err != nil {
+		return errorEvent(err.Error()), nil
+	}
+	if previousUID == target.UID {
+		return messageEvent(fmt.Sprintf("You are already snooping %s.", target.CharName)), nil
+	}
+	if previousUID != "" {
+		s.pushMessageToUID(previousUID, fmt.Sprintf("%s has stopped observing your session.", sess.CharName))
+	}
+	s.logger.Info("snoop started",
+		zap.String("actor", sess.Username),
+		zap.String("target", target.CharName),
+		zap.String("reason", req.GetReason()))
+	s.pushMessageToUID(target.UID, fmt.Sprintf(
+		"%s is observing your session for moderation (%s). What you type and see is shared until they stop.",
+		sess.CharName, req.GetReason()))
+	return messageEvent(fmt.Sprintf("You are now snooping %s. Type snoop off to stop.", target.CharName)), nil
+}
+
+// snoopPrefix marks mirrored lines with the snooped character's name.
+func snoopPrefix(charName string) string {
+	return "[snoop " + charName + "] "
+}
+
+// snoopMirror returns the syncStream mirror for uid: it copies the text of
+// each event sent to uid to whoever is snooping them. Room views are copied
+// once per room visited.
+//
+// Postcondition: The returned func must be called serially, as syncStream
+// does under its lock.
+func (s *GameServiceServer) snoopMirror(uid string) func(*gamev1.ServerEvent) {
+	var lastRoom string
+	return func(evt *gamev1.ServerEvent) {
+		snooper, ok := s.sessions.Snooper(uid)
+		if !ok {
+			lastRoom = ""
+			return
+		}
+		text := readableText(evt)
+		if rv := evt.GetRoomView(); rv != nil {
+			if rv.GetRoomId() == lastRoom {
+				return
+			}
+			lastRoom = rv.GetRoomId()
+			text = transcriptRoomView(rv)
+		}
+		sess, ok := s.sessions.GetPlayer(uid)
+		if !ok || strings.TrimSpace(text) == "" {
+			return
+		}
+		s.pushMessageToUID(snooper, snoopPrefix(sess.CharName)+text)
+	}
+}
+
+// mirrorCommand copies a command uid entered to whoever is snooping them.
+func (s *GameServiceServer) mirrorCommand(uid string, msg *gamev1.ClientMessage) {
+	snooper, ok := s.sessions.Snooper(uid)
+	if !ok {
+		return
+	}
+	sess, ok := s.sessions.GetPlayer(uid)
+	summary := commandSummary(msg)
+	if !ok || summary == "" {
+		return
+	}
+	s.pushMessageToUID(snooper, snoopPrefix(sess.CharName)+"> "+summary)
+}
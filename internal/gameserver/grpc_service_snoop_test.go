@@ -0,0 +1,128 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newSnoopTestServer builds a server with a moderator and a player in room_a.
+func newSnoopTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *session.PlayerSession) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	staff, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "mod_u1", Username: "mod", CharName: "Warden", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "moderator",
+	})
+	require.NoError(t, err)
+	player, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "pc_u1", Username: "pc", CharName: "Vex", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, staff, player
+}
+
+// roomEventsFor drains sess's pushed events and returns the players named in
+// arrival and departure events.
+func roomEventsFor(t *testing.T, sess *session.PlayerSession) []string {
+	t.Helper()
+	var names []string
+	for {
+		select {
+		case data := <-sess.Entity.Events():
+			var evt gamev1.ServerEvent
+			require.NoError(t, proto.Unmarshal(data, &evt))
+			if re := evt.GetRoomEvent(); re != nil {
+				names = append(names, re.GetPlayer())
+			}
+		case <-time.After(50 * time.Millisecond):
+			return names
+		}
+	}
+}
+
+func TestInvis_HidesStaffFromRoomWhoAndArrivals(t *testing.T) {
+	svc, staff, player := newSnoopTestServer(t)
+
+	evt, err := svc.handleInvis(staff.UID, &gamev1.InvisRequest{Action: "toggle"})
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "You fade from view.")
+	assert.True(t, staff.Invisible)
+
+	room, _ := svc.world.GetRoom("room_a")
+	assert.NotContains(t, svc.worldH.buildRoomView(player.UID, room).GetPlayers(), "Warden")
+	who, err := svc.chatH.Who(player.UID)
+	require.NoError(t, err)
+	for _, p := range who.GetPlayers() {
+		assert.NotEqual(t, "Warden", p.GetName())
+	}
+	selfWho, err := svc.chatH.Who(staff.UID)
+	require.NoError(t, err)
+	assert.Len(t, selfWho.GetPlayers(), 2, "staff still see themselves")
+
+	roomEventsFor(t, player)
+	_, err = svc.handleMove(staff.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Empty(t, roomEventsFor(t, player), "no departure is announced")
+
+	evt, err = svc.handleInvis(staff.UID, &gamev1.InvisRequest{Action: "off"})
+	require.NoError(t, err)
+	assert.Equal(t, "You are visible again.", trapEventText(evt))
+	_, err = svc.handleMove(staff.UID, &gamev1.MoveRequest{Direction: "south"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Warden"}, roomEventsFor(t, player))
+}
+
+func TestSnoop_MirrorsTargetAndTellsThem(t *testing.T) {
+	svc, staff, player := newSnoopTestServer(t)
+
+	evt, err := svc.handleSnoop(staff.UID, &gamev1.SnoopRequest{Target: "vex"})
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "Give a reason")
+
+	evt, err = svc.handleSnoop(staff.UID, &gamev1.SnoopRequest{Target: "vex", Reason: "harassment report #4"})
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "You are now snooping Vex.")
+	assert.Contains(t, pushedText(t, player), "Warden is observing your session for moderation (harassment report #4).")
+
+	mirror := svc.snoopMirror(player.UID)
+	mirror(messageEvent("You feel watched."))
+	svc.mirrorCommand(player.UID, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Move{Move: &gamev1.MoveRequest{Direction: "north"}}})
+	got := pushedText(t, staff)
+	assert.Contains(t, got, "[snoop Vex] You feel watched.")
+	assert.Contains(t, got, `[snoop Vex] > move {"direction":"north"}`)
+
+	evt, err = svc.handleSnoop(staff.UID, &gamev1.SnoopRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You stop snooping Vex.", trapEventText(evt))
+	assert.Contains(t, pushedText(t, player), "Warden has stopped observing your session.")
+	mirror(messageEvent("Alone again."))
+	assert.Empty(t, pushedText(t, staff))
+
+	evt, err = svc.handleSnoop(staff.UID, &gamev1.SnoopRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You aren't snooping anyone.", trapEventText(evt))
+}
+
+func TestSnoop_IsAuditedAndRequiresCapability(t *testing.T) {
+	svc, staff, player := newSnoopTestServer(t)
+	msg := &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Snoop{Snoop: &gamev1.SnoopRequest{Target: "Vex", Reason: "spam"}}}
+
+	action, target, _, ok := auditedAdminCommand(msg)
+	assert.True(t, ok)
+	assert.Equal(t, "snoop", action)
+	assert.Equal(t, "Vex", target)
+
+	assert.Nil(t, svc.authorize(staff.UID, msg))
+	assert.NotNil(t, svc.authorize(player.UID, msg))
+	assert.NotNil(t, svc.authorize(player.UID, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Invis{Invis: &gamev1.InvisRequest{}}}))
+}
@@ -0,0 +1,173 @@
+package gameserver
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/social"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// socialHelp lists the moves available in a social encounter.
+const socialHelp = "Use social persuade, intimidate, or deceive (1 AP each), social pass to end the round, or social leave."
+
+// handleSocial runs a social encounter: an empty action opens one with the
+// target NPC, and persuade, intimidate, deceive, pass, or leave is a move in
+// the encounter under way. Only a surrendered NPC that has not yet talked or
+// the target of one of the player's persuade objectives will engage.
+//
+// Precondition: uid identifies an active player session; req is non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil.
+func (s *GameServiceServer) handleSocial(uid string, req *gamev1.SocialRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return messageEvent("player not found"), nil
+	}
+	s.socialMu.Lock()
+	defer s.socialMu.Unlock()
+
+	enc, inst := s.currentEncounterLocked(sess)
+	if req.GetAction() == "" {
+		if enc != nil {
+			return messageEvent(fmt.Sprintf("You are already talking to %s. %s", enc.NPCName, enc.Status())), nil
+		}
+		return messageEvent(s.startEncounterLocked(sess, req.GetTarget())), nil
+	}
+	if enc == nil {
+		return messageEvent("You are not in a conversation. Start one with social <npc>."), nil
+	}
+
+	switch req.GetAction() {
+	case "leave":
+		delete(s.socialEncounters, uid)
+		return messageEvent(fmt.Sprintf("You walk away from %s.", enc.NPCName)), nil
+	case "pass":
+		enc.EndRound()
+		if enc.Lost() {
+			return messageEvent(s.loseEncounterLocked(sess, enc, inst)), nil
+		}
+		return messageEvent(fmt.Sprintf("%s waits for you to go on. %s", enc.NPCName, enc.Status())), nil
+	}
+
+	approach, ok := social.ParseApproach(req.GetAction())
+	if !ok {
+		return messageEvent("usage: social <npc> | social <persuade|intimidate|deceive|pass|leave>"), nil
+	}
+	if s.dice == nil {
+		return messageEvent("Dice are unavailable."), nil
+	}
+	skill := approach.Skill()
+	res, err := enc.Attack(approach, abilityModFrom(s.abilityScoreForSkill(sess, skill)), sess.Skills[skill], s.dice.Src())
+	if err != nil {
+		return messageEvent(fmt.Sprintf("%s. %s", err.Error(), enc.Status())), nil
+	}
+	msg := res.Narrative(enc.NPCName)
+	if enc.Won() {
+		return messageEvent(msg + "\n" + s.winEncounterLocked(sess, enc, inst)), nil
+	}
+	if enc.Queue.RemainingPoints() == 0 {
+		enc.EndRound()
+		if enc.Lost() {
+			return messageEvent(msg + "\n" + s.loseEncounterLocked(sess, enc, inst)), nil
+		}
+	}
+	return messageEvent(msg + "\n" + enc.Status()), nil
+}
+
+// currentEncounterLocked returns sess's encounter under way and its NPC,
+// dropping an encounter the player has walked out of, fought through, or
+// whose NPC is gone.
+//
+// Precondition: s.socialMu is held; sess must not be nil.
+// Postcondition: Returns (nil, nil) when the player has no live encounter.
+func (s *GameServiceServer) currentEncounterLocked(sess *session.PlayerSession) (*social.Encounter, *npc.Instance) {
+	enc, ok := s.socialEncounters[sess.UID]
+	if !ok {
+		return nil, nil
+	}
+	inst, found := s.npcMgr.Get(enc.NPCID)
+	if !found || inst.IsDead() || inst.RoomID != enc.RoomID || sess.RoomID != enc.RoomID || sess.Status == statusInCombat {
+		delete(s.socialEncounters, sess.UID)
+		return nil, nil
+	}
+	return enc, inst
+}
+
+// startEncounterLocked opens a social encounter between sess and the NPC
+// named target.
+//
+// Precondition: s.socialMu is held; sess must not be nil.
+// Postcondition: Returns the message for the player; on success the
+// encounter is recorded in s.socialEncounters.
+func (s *GameServiceServer) startEncounterLocked(sess *session.PlayerSession, target string) string {
+	if sess.Status == statusInCombat {
+		return "You can't talk your way through a fight."
+	}
+	inst := s.npcMgr.FindInRoom(sess.RoomID, target)
+	if inst == nil {
+		return fmt.Sprintf("You don't see %q here.", target)
+	}
+	questTarget := s.questSvc != nil && s.questSvc.WantsPersuaded(sess, inst.TemplateID)
+	switch {
+	case inst.SocialRebuffed[sess.UID]:
+		return fmt.Sprintf("%s has heard enough from you.", inst.Name())
+	case inst.Surrendered && inst.SurrenderInterrogated && !questTarget:
+		return fmt.Sprintf("%s has already told you everything they know.", inst.Name())
+	case !inst.Surrendered && !questTarget:
+		return fmt.Sprintf("%s has nothing to discuss with you.", inst.Name())
+	}
+	enc := social.NewEncounter(sess.UID, inst.ID, inst.Name(), sess.RoomID, inst.Level, inst.Savvy, inst.Surrendered)
+	if s.socialEncounters == nil {
+		s.socialEncounters = make(map[string]*social.Encounter)
+	}
+	s.socialEncounters[sess.UID] = enc
+	return fmt.Sprintf("You square off with %s. %s\n%s", inst.Name(), enc.Status(), socialHelp)
+}
+
+// winEncounterLocked settles an encounter whose NPC's resolve broke: a
+// surrendered NPC spills what it knows, and the player's persuade
+// objectives on the NPC advance.
+//
+// Precondition: s.socialMu is held; sess, enc, and inst must not be nil.
+// Postcondition: The encounter is over; returns the message for the player.
+func (s *GameServiceServer) winEncounterLocked(sess *session.PlayerSession, enc *social.Encounter, inst *npc.Instance) string {
+	delete(s.socialEncounters, sess.UID)
+	lines := []string{fmt.Sprintf("%s's resolve breaks.", enc.NPCName)}
+	if inst.Surrendered && !inst.SurrenderInterrogated {
+		lines = append(lines, interrogate(inst))
+	}
+	if s.questSvc != nil && s.questSvc.WantsPersuaded(sess, inst.TemplateID) {
+		msgs, err := s.questSvc.RecordPersuade(s.commandCtx(sess.UID), sess, sess.CharacterID, inst.TemplateID)
+		if err != nil {
+			s.logger.Warn("RecordPersuade failed", zap.String("uid", sess.UID), zap.Error(err))
+		}
+		lines = append(lines, msgs...)
+		if len(msgs) > 0 {
+			s.pushCharacterSheet(sess)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// loseEncounterLocked settles an encounter the NPC outlasted: it will not
+// talk to this player again.
+//
+// Precondition: s.socialMu is held; sess, enc, and inst must not be nil.
+// Postcondition: The encounter is over and inst has rebuffed sess; a
+// surrendered NPC will no longer be interrogated. Returns the message for the player.
+func (s *GameServiceServer) loseEncounterLocked(sess *session.PlayerSession, enc *social.Encounter, inst *npc.Instance) string {
+	delete(s.socialEncounters, sess.UID)
+	if inst.SocialRebuffed == nil {
+		inst.SocialRebuffed = make(map[string]bool)
+	}
+	inst.SocialRebuffed[sess.UID] = true
+	if inst.Surrendered {
+		inst.SurrenderInterrogated = true
+		return fmt.Sprintf("%s clams up. You will get nothing out of them.", enc.NPCName)
+	}
+	return fmt.Sprintf("%s has heard enough and turns away.", enc.NPCName)
+}
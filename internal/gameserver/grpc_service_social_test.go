@@ -0,0 +1,90 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/quest"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newSocialSvc returns a server whose player u_social stands in room_a with
+// an Informant NPC, has an active quest to persuade it, and rolls diceVal.
+func newSocialSvc(t *testing.T, diceVal int) (*GameServiceServer, *session.PlayerSession, *npc.Instance) {
+	t.Helper()
+	def := &quest.QuestDef{
+		ID:         "turn_informant",
+		Title:      "Turn the Informant",
+		GiverNPCID: "fixer",
+		Objectives: []quest.QuestObjective{
+			{ID: "persuade_obj", Type: "persuade", Description: "Win over the informant", TargetID: "informant", Quantity: 1},
+		},
+	}
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcManager := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcManager)
+	svc.questSvc = quest.NewService(quest.QuestRegistry{def.ID: def}, &noOpQuestRepo{}, nil, nil, nil)
+	svc.dice = dice.NewLoggedRoller(&fixedDiceSource{val: diceVal}, zaptest.NewLogger(t))
+	inst, err := npcManager.Spawn(&npc.Template{
+		ID: "informant", Name: "Informant", Level: 1, MaxHP: 10, AC: 10,
+		Abilities: npc.Abilities{Savvy: 10},
+	}, "room_a")
+	require.NoError(t, err)
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_social", Username: "talker", CharName: "Talker",
+		RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.ActiveQuests[def.ID] = &quest.ActiveQuest{QuestID: def.ID, ObjectiveProgress: map[string]int{"persuade_obj": 0}}
+	return svc, sess, inst
+}
+
+// talk sends u_social's SocialRequest and returns the reply.
+func talk(t *testing.T, svc *GameServiceServer, action, target string) string {
+	t.Helper()
+	event, err := svc.handleSocial("u_social", &gamev1.SocialRequest{Action: action, Target: target})
+	require.NoError(t, err)
+	return event.GetMessage().GetContent()
+}
+
+func TestHandleSocial_WinningCompletesPersuadeObjective(t *testing.T) {
+	svc, sess, _ := newSocialSvc(t, 10)
+
+	assert.Contains(t, talk(t, svc, "persuade", ""), "not in a conversation")
+	assert.Contains(t, talk(t, svc, "", "Informant"), "You square off with Informant. Informant: resolve 8/8, round 1 of 3, 3 AP left.")
+	assert.Contains(t, talk(t, svc, "", "Informant"), "already talking to Informant")
+
+	msg := talk(t, svc, "persuade", "")
+	assert.Contains(t, msg, "You persuade Informant (11 vs DC 11): success")
+	assert.Contains(t, msg, "Informant's resolve breaks.")
+	assert.NotContains(t, sess.ActiveQuests, "turn_informant", "the quest is complete")
+	assert.Contains(t, talk(t, svc, "", "Informant"), "nothing to discuss", "the quest no longer needs the informant")
+}
+
+func TestHandleSocial_PatienceRunsOut(t *testing.T) {
+	svc, sess, inst := newSocialSvc(t, 0)
+	talk(t, svc, "", "Informant")
+
+	msg := talk(t, svc, "deceive", "")
+	assert.Contains(t, msg, "You deceive Informant (1 vs DC 11): failure.")
+	assert.Contains(t, msg, "round 1 of 3, 2 AP left.")
+	assert.Contains(t, talk(t, svc, "pass", ""), "round 2 of 3, 3 AP left.")
+	talk(t, svc, "pass", "")
+	assert.Contains(t, talk(t, svc, "pass", ""), "Informant has heard enough and turns away.")
+	assert.True(t, inst.SocialRebuffed["u_social"])
+	assert.Contains(t, talk(t, svc, "", "Informant"), "heard enough from you")
+	assert.Contains(t, sess.ActiveQuests, "turn_informant")
+}
+
+func TestHandleSocial_EndsWhenPlayerLeaves(t *testing.T) {
+	svc, sess, _ := newSocialSvc(t, 10)
+	talk(t, svc, "", "Informant")
+	sess.RoomID = "room_b"
+	assert.Contains(t, talk(t, svc, "persuade", ""), "not in a conversation")
+}
@@ -0,0 +1,135 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// noise is a sound loud enough to be heard outside the room it is made in.
+type noise struct {
+	// what is the sound as heard from a distance ("gunfire").
+	what string
+	// loudness is how far the sound carries.
+	loudness world.Loudness
+}
+
+var (
+	noiseFighting  = noise{what: "the sounds of a fight", loudness: world.LoudnessAdjacent}
+	noiseGunfire   = noise{what: "gunfire", loudness: world.LoudnessAdjacent}
+	noiseExplosion = noise{what: "an explosion", loudness: world.LoudnessZone}
+)
+
+// noiseCooldown is how long a room must stay quiet before the same noise
+// from it is reported to its neighbours again, so a long firefight is heard
+// as a running battle rather than one message per round.
+const noiseCooldown = 15 * time.Second
+
+// soundFrom phrases where a sound heard through dir comes from.
+//
+// Postcondition: Returns a phrase such as "to the north", "from above", or
+// "somewhere in the distance".
+func soundFrom(dir world.Direction) string {
+	switch dir {
+	case "":
+		return "somewhere in the distance"
+	case world.Up:
+		return "from above"
+	case world.Down:
+		return "from below"
+	}
+	if dir.Opposite() == "" {
+		return "nearby"
+	}
+	return "to the " + string(dir)
+}
+
+// emitSound reports n, made in roomID, to every player within earshot
+// outside that room. Players in roomID see the event itself and are not told.
+//
+// Precondition: roomID should name a loaded room.
+// Postcondition: Repeats of the same noise from the same room within
+// noiseCooldown are dropped; dead players hear nothing.
+func (s *GameServiceServer) emitSound(roomID string, n noise) {
+	if s.world == nil || n.loudness <= world.LoudnessRoom {
+		return
+	}
+	key := roomID + "\x00" + n.what
+	now := time.Now()
+	s.soundMu.Lock()
+	if s.lastNoise == nil {
+		s.lastNoise = make(map[string]time.Time)
+	}
+	if last, ok := s.lastNoise[key]; ok && now.Sub(last) < noiseCooldown {
+		s.soundMu.Unlock()
+		return
+	}
+	s.lastNoise[key] = now
+	s.soundMu.Unlock()
+
+	s.carrySound(roomID, n.loudness, func(from string) string {
+		return fmt.Sprintf("You hear %s %s.", n.what, from)
+	})
+}
+
+// carrySound sends every living player within earshot of a sound of the given
+// loudness made in roomID the text heard(from), where from is the soundFrom
+// phrase for the direction the sound reaches them from.
+//
+// Precondition: heard must be non-nil.
+// Postcondition: Players in roomID itself receive nothing.
+func (s *GameServiceServer) carrySound(roomID string, loud world.Loudness, heard func(from string) string) {
+	if s.world == nil {
+		return
+	}
+	for _, h := range s.world.SoundReach(roomID, loud) {
+		evt := &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: &gamev1.MessageEvent{
+			Content: heard(soundFrom(h.Direction)),
+		}}}
+		s.broadcastToRoomFiltered(h.RoomID, "", evt, func(sess *session.PlayerSession) bool { return sess.Dead })
+	}
+}
+
+// roundNoise returns the loudest noise made by a round's events: an
+// explosion for any thrown explosive, gunfire for any shot, and otherwise
+// the sounds of a fight for any attack.
+//
+// Postcondition: ok is false when the round made no noise worth hearing.
+func (h *CombatHandler) roundNoise(events []combat.RoundEvent) (n noise, ok bool) {
+	for _, ev := range events {
+		switch ev.ActionType {
+		case combat.ActionThrow:
+			return noiseExplosion, true
+		case combat.ActionFireBurst, combat.ActionFireAutomatic:
+			n, ok = noiseGunfire, true
+		case combat.ActionAttack, combat.ActionStrike:
+			if h.firesGun(ev.ActorID) {
+				n, ok = noiseGunfire, true
+			} else if !ok {
+				n, ok = noiseFighting, true
+			}
+		}
+	}
+	return n, ok
+}
+
+// firesGun reports whether the combatant with id attacks with a firearm.
+func (h *CombatHandler) firesGun(id string) bool {
+	if inst, ok := h.npcMgr.Get(id); ok {
+		if inst.WeaponID == "" || h.invRegistry == nil {
+			return false
+		}
+		wDef := h.invRegistry.Weapon(inst.WeaponID)
+		return wDef != nil && wDef.IsFirearm()
+	}
+	sess, ok := h.sessions.GetPlayer(id)
+	if !ok || sess.LoadoutSet == nil {
+		return false
+	}
+	preset := sess.LoadoutSet.ActivePreset()
+	return preset != nil && preset.MainHand != nil && preset.MainHand.Def != nil && preset.MainHand.Def.IsFirearm()
+}
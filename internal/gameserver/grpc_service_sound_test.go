@@ -0,0 +1,115 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+// newSoundTestServer builds a bar with a street to the west, a cellar below,
+// and a back room two doors away, with one player standing in each room.
+func newSoundTestServer(t *testing.T) (*GameServiceServer, map[string]*session.PlayerSession) {
+	t.Helper()
+	rooms := map[string]*world.Room{
+		"bar": {ID: "bar", ZoneID: "z", Title: "Bar", Exits: []world.Exit{
+			{Direction: world.West, TargetRoom: "street"},
+			{Direction: world.Down, TargetRoom: "cellar"},
+		}},
+		"street": {ID: "street", ZoneID: "z", Title: "Street", Exits: []world.Exit{
+			{Direction: world.East, TargetRoom: "bar"},
+		}},
+		"cellar": {ID: "cellar", ZoneID: "z", Title: "Cellar", Exits: []world.Exit{
+			{Direction: world.Up, TargetRoom: "bar"},
+			{Direction: world.North, TargetRoom: "backroom"},
+		}},
+		"backroom": {ID: "backroom", ZoneID: "z", Title: "Back Room", Exits: []world.Exit{
+			{Direction: world.South, TargetRoom: "cellar"},
+		}},
+	}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "bar", Rooms: rooms}})
+	require.NoError(t, err)
+
+	sMgr := session.NewManager()
+	players := make(map[string]*session.PlayerSession)
+	for id := range rooms {
+		sess, err := sMgr.AddPlayer(session.AddPlayerOptions{
+			UID: id, Username: id, CharName: id, RoomID: id, CurrentHP: 10, MaxHP: 10, Role: "player",
+		})
+		require.NoError(t, err)
+		players[id] = sess
+	}
+	return &GameServiceServer{sessions: sMgr, world: wMgr, logger: zap.NewNop()}, players
+}
+
+func TestEmitSound_GunfireReachesAdjacentRoomsWithDirection(t *testing.T) {
+	s, players := newSoundTestServer(t)
+	players["cellar"].Dead = true
+
+	s.emitSound("bar", noiseGunfire)
+
+	assert.Equal(t, []string{"You hear gunfire to the east."}, drainAllEntityEvents(t, players["street"].Entity.Events(), 50*time.Millisecond))
+	assert.Empty(t, drainAllEntityEvents(t, players["cellar"].Entity.Events(), 50*time.Millisecond), "the dead hear nothing")
+	assert.Empty(t, drainAllEntityEvents(t, players["bar"].Entity.Events(), 50*time.Millisecond), "the source room sees the fight itself")
+	assert.Empty(t, drainAllEntityEvents(t, players["backroom"].Entity.Events(), 50*time.Millisecond), "gunfire carries one room")
+
+	s.emitSound("bar", noiseGunfire)
+	assert.Empty(t, drainAllEntityEvents(t, players["street"].Entity.Events(), 50*time.Millisecond), "repeats within the cooldown are dropped")
+}
+
+func TestEmitSound_ExplosionCarriesAcrossZone(t *testing.T) {
+	s, players := newSoundTestServer(t)
+
+	s.emitSound("bar", noiseExplosion)
+
+	assert.Equal(t, []string{"You hear an explosion from above."}, drainAllEntityEvents(t, players["cellar"].Entity.Events(), 50*time.Millisecond))
+	assert.Equal(t, []string{"You hear an explosion somewhere in the distance."}, drainAllEntityEvents(t, players["backroom"].Entity.Events(), 50*time.Millisecond))
+}
+
+func TestRoundNoise_PicksLoudestSound(t *testing.T) {
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterWeapon(&inventory.WeaponDef{
+		ID: "pistol", Name: "Pistol", Kind: inventory.WeaponKindOneHanded, DamageDice: "1d6", DamageType: "piercing",
+		ProficiencyCategory: "simple_weapons", Rarity: "salvage", FiringModes: []inventory.FiringMode{inventory.FiringModeSingle},
+	}))
+	npcMgr := npc.NewManager()
+	gunman, err := npcMgr.Spawn(&npc.Template{ID: "gunman", Name: "Gunman", Level: 1, MaxHP: 10, AC: 10}, "bar")
+	require.NoError(t, err)
+	gunman.WeaponID = "pistol"
+	brawler, err := npcMgr.Spawn(&npc.Template{ID: "brawler", Name: "Brawler", Level: 1, MaxHP: 10, AC: 10}, "bar")
+	require.NoError(t, err)
+	h := &CombatHandler{npcMgr: npcMgr, invRegistry: reg, sessions: session.NewManager()}
+
+	_, ok := h.roundNoise([]combat.RoundEvent{{ActionType: combat.ActionPass, ActorID: brawler.ID}})
+	assert.False(t, ok)
+
+	n, ok := h.roundNoise([]combat.RoundEvent{{ActionType: combat.ActionAttack, ActorID: brawler.ID}})
+	require.True(t, ok)
+	assert.Equal(t, noiseFighting, n)
+
+	n, _ = h.roundNoise([]combat.RoundEvent{
+		{ActionType: combat.ActionAttack, ActorID: gunman.ID},
+		{ActionType: combat.ActionAttack, ActorID: brawler.ID},
+	})
+	assert.Equal(t, noiseGunfire, n)
+
+	n, _ = h.roundNoise([]combat.RoundEvent{
+		{ActionType: combat.ActionFireBurst, ActorID: brawler.ID},
+		{ActionType: combat.ActionThrow, ActorID: brawler.ID},
+	})
+	assert.Equal(t, noiseExplosion, n)
+}
+
+func TestSoundFrom(t *testing.T) {
+	assert.Equal(t, "to the north", soundFrom(world.North))
+	assert.Equal(t, "from below", soundFrom(world.Down))
+	assert.Equal(t, "somewhere in the distance", soundFrom(""))
+}
@@ -0,0 +1,162 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/stats"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// statsRollupInterval is how often online players' stats are flushed and the
+// leaderboards recomputed.
+const statsRollupInterval = 5 * time.Minute
+
+// leaderboardSize is how many characters each leaderboard lists.
+const leaderboardSize = 10
+
+// StatsStore persists lifetime character statistics and ranks characters on them.
+type StatsStore interface {
+	AddStats(ctx context.Context, characterID int64, deltas map[stats.Stat]int64) error
+	TopStats(ctx context.Context, st stats.Stat, limit int) ([]stats.Entry, error)
+}
+
+// startStatsTally begins counting sess's stats.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when stats are not persisted.
+func (s *GameServiceServer) startStatsTally(sess *session.PlayerSession) {
+	if s.statsStore == nil || sess.CharacterID <= 0 {
+		return
+	}
+	sess.Stats = stats.NewTally()
+}
+
+// tallyStat adds n to sess's pending total for st.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when stats are not being counted for sess.
+func (s *GameServiceServer) tallyStat(sess *session.PlayerSession, st stats.Stat, n int64) {
+	if sess.Stats != nil {
+		sess.Stats.Add(st, n)
+	}
+}
+
+// flushStats writes sess's pending stats to the store.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: On a write failure the increments stay pending for the next flush.
+func (s *GameServiceServer) flushStats(ctx context.Context, sess *session.PlayerSession) {
+	if s.statsStore == nil || sess.Stats == nil {
+		return
+	}
+	deltas := sess.Stats.Drain()
+	if deltas == nil {
+		return
+	}
+	if err := s.statsStore.AddStats(ctx, sess.CharacterID, deltas); err != nil {
+		sess.Stats.Restore(deltas)
+		s.logger.Warn("saving character stats",
+			zap.String("uid", sess.UID),
+			zap.Int64("character_id", sess.CharacterID),
+			zap.Error(err),
+		)
+	}
+}
+
+// RollupStats flushes every online player's stats and recomputes the leaderboards.
+//
+// Postcondition: No-op when stats are not persisted. The previous leaderboards
+// are kept when any of them fails to load.
+func (s *GameServiceServer) RollupStats(ctx context.Context) {
+	if s.statsStore == nil {
+		return
+	}
+	for _, sess := range s.sessions.AllPlayers() {
+		s.flushStats(ctx, sess)
+	}
+	tops := make(map[stats.Stat][]stats.Entry, len(stats.All))
+	for _, st := range stats.All {
+		entries, err := s.statsStore.TopStats(ctx, st, leaderboardSize)
+		if err != nil {
+			s.logger.Warn("computing leaderboard", zap.String("stat", string(st)), zap.Error(err))
+			return
+		}
+		tops[st] = entries
+	}
+	s.leaderboards.Set(tops, time.Now())
+}
+
+// StartStatsRollup rolls up stats immediately and then every statsRollupInterval.
+//
+// Precondition: MUST be called after GameServiceServer is fully initialized.
+// Postcondition: returns a stop function; call it to stop the goroutine.
+func (s *GameServiceServer) StartStatsRollup() func() {
+	if s.statsStore == nil {
+		return func() {}
+	}
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(statsRollupInterval)
+		defer ticker.Stop()
+		for {
+			s.RollupStats(context.Background())
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// onNPCKilled credits sess with killing inst.
+//
+// Precondition: sess and inst must be non-nil.
+func (s *GameServiceServer) onNPCKilled(sess *session.PlayerSession, inst *npc.Instance) {
+	s.tallyStat(sess, stats.Kills, 1)
+	s.recordKillAchievements(sess, inst)
+	s.emitEvent(sess, telemetry.Event{Type: telemetry.TypeKill, Target: inst.TemplateID, Amount: int64(inst.Level)})
+}
+
+// handleTop shows the most recently computed leaderboard for the requested stat.
+//
+// Precondition: uid must identify an existing player session; req must be non-nil.
+// Postcondition: Returns the ranked list with the player's own row marked.
+func (s *GameServiceServer) handleTop(uid string, req *gamev1.TopRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.statsStore == nil || s.leaderboards == nil {
+		return messageEvent("Leaderboards are not available."), nil
+	}
+	st, err := stats.Parse(req.GetStat())
+	if err != nil {
+		return errorEvent(err.Error()), nil
+	}
+	entries, computedAt, ok := s.leaderboards.Top(st)
+	if !ok {
+		return messageEvent("The leaderboards are still being tallied. Try again shortly."), nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Top %s (updated %d min ago):", st.Label(), int(time.Since(computedAt)/time.Minute))
+	if len(entries) == 0 {
+		b.WriteString("\n  Nobody is ranked yet.")
+	}
+	for _, e := range entries {
+		fmt.Fprintf(&b, "\n  %2d. %-20s %s", e.Rank, e.Name, st.Format(e.Value))
+		if e.Name == sess.CharName {
+			b.WriteString("  <- you")
+		}
+	}
+	return messageEvent(b.String()), nil
+}
@@ -0,0 +1,199 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/stats"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+type memStatsStore struct {
+	totals map[int64]map[stats.Stat]int64
+	names  map[int64]string
+	// fail makes AddStats return an error.
+	fail bool
+}
+
+func (m *memStatsStore) AddStats(_ context.Context, characterID int64, deltas map[stats.Stat]int64) error {
+	if m.fail {
+		return errors.New("db down")
+	}
+	if m.totals[characterID] == nil {
+		m.totals[characterID] = map[stats.Stat]int64{}
+	}
+	for st, n := range deltas {
+		m.totals[characterID][st] += n
+	}
+	return nil
+}
+
+func (m *memStatsStore) TopStats(_ context.Context, st stats.Stat, limit int) ([]stats.Entry, error) {
+	var out []stats.Entry
+	for id, t := range m.totals {
+		if t[st] > 0 {
+			out = append(out, stats.Entry{Name: m.names[id], Value: t[st]})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Value != out[j].Value {
+			return out[i].Value > out[j].Value
+		}
+		return out[i].Name < out[j].Name
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	for i := range out {
+		out[i].Rank = i + 1
+	}
+	return out, nil
+}
+
+// newStatsTestServer builds a server with two players, "ace" (character 1)
+// and "bee" (character 2), both counting stats.
+func newStatsTestServer(t *testing.T) (*GameServiceServer, *memStatsStore) {
+	t.Helper()
+	room := &world.Room{ID: "plaza", ZoneID: "z", Title: "Plaza", Description: "A plaza."}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "plaza",
+		Rooms: map[string]*world.Room{"plaza": room}}})
+	require.NoError(t, err)
+
+	store := &memStatsStore{totals: map[int64]map[stats.Stat]int64{}, names: map[int64]string{1: "Ace", 2: "Bee"}}
+	s := &GameServiceServer{
+		sessions:     session.NewManager(),
+		world:        wMgr,
+		logger:       zap.NewNop(),
+		statsStore:   store,
+		leaderboards: stats.NewBoard(),
+	}
+	for i, name := range []string{"Ace", "Bee"} {
+		uid := strings.ToLower(name)
+		sess, err := s.sessions.AddPlayer(session.AddPlayerOptions{
+			UID: uid, Username: uid, CharName: name, CharacterID: int64(i + 1),
+			RoomID: "plaza", CurrentHP: 10, MaxHP: 10,
+			Abilities: character.AbilityScores{}, Role: "player",
+		})
+		require.NoError(t, err)
+		s.startStatsTally(sess)
+	}
+	return s, store
+}
+
+func TestStartStatsTally_RequiresStore(t *testing.T) {
+	s, _ := newStatsTestServer(t)
+	sess := player(t, s, "ace")
+	require.NotNil(t, sess.Stats)
+
+	s.statsStore = nil
+	sess.Stats = nil
+	s.startStatsTally(sess)
+	assert.Nil(t, sess.Stats)
+	assert.NotPanics(t, func() { s.tallyStat(sess, stats.Kills, 1) })
+}
+
+func TestFlushStats_RetriesAfterFailure(t *testing.T) {
+	s, store := newStatsTestServer(t)
+	sess := player(t, s, "ace")
+	s.onNPCKilled(sess, &npc.Instance{TemplateID: "ganger"})
+	s.tallyStat(sess, stats.Damage, 7)
+
+	store.fail = true
+	s.flushStats(context.Background(), sess)
+	assert.Empty(t, store.totals)
+
+	store.fail = false
+	s.flushStats(context.Background(), sess)
+	assert.Equal(t, int64(1), store.totals[1][stats.Kills])
+	assert.Equal(t, int64(7), store.totals[1][stats.Damage])
+}
+
+func TestRollupStats_FlushesAndRanks(t *testing.T) {
+	s, store := newStatsTestServer(t)
+	ace, bee := player(t, s, "ace"), player(t, s, "bee")
+
+	evt, err := s.handleTop("ace", &gamev1.TopRequest{Stat: "kills"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "still being tallied")
+
+	s.tallyStat(ace, stats.Kills, 3)
+	s.tallyStat(bee, stats.Kills, 5)
+	s.tallyStat(bee, stats.Explored, 2)
+	s.RollupStats(context.Background())
+	assert.Equal(t, int64(5), store.totals[2][stats.Kills])
+
+	evt, err = s.handleTop("ace", &gamev1.TopRequest{Stat: "kills"})
+	require.NoError(t, err)
+	text := evt.GetMessage().GetContent()
+	assert.Contains(t, text, "Top Kills (updated 0 min ago):")
+	assert.Regexp(t, `1\. Bee +5\n`, text)
+	assert.Regexp(t, `2\. Ace +3  <- you$`, text)
+
+	evt, err = s.handleTop("ace", &gamev1.TopRequest{Stat: "deaths"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Nobody is ranked yet.")
+
+	evt, err = s.handleTop("ace", &gamev1.TopRequest{Stat: "gold"})
+	require.NoError(t, err)
+	assert.NotNil(t, evt.GetError())
+}
+
+func TestHandleTop_Unavailable(t *testing.T) {
+	s, _ := newStatsTestServer(t)
+	s.statsStore = nil
+	evt, err := s.handleTop("ace", &gamev1.TopRequest{Stat: "kills"})
+	require.NoError(t, err)
+	assert.Equal(t, "Leaderboards are not available.", evt.GetMessage().GetContent())
+}
+
+func TestStartStatsRollup_RunsImmediately(t *testing.T) {
+	s, _ := newStatsTestServer(t)
+	stop := s.StartStatsRollup()
+	defer stop()
+	require.Eventually(t, func() bool {
+		_, _, ok := s.leaderboards.Top(stats.Kills)
+		return ok
+	}, time.Second, 5*time.Millisecond)
+}
+
+// TestProperty_FlushStats_ConservesIncrements verifies that every increment
+// reaches the store exactly once, however flushes interleave with store failures.
+func TestProperty_FlushStats_ConservesIncrements(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s, store := newStatsTestServer(t)
+		sess := player(t, s, "ace")
+		var want int64
+		for i, n := 0, rapid.IntRange(1, 30).Draw(rt, "steps"); i < n; i++ {
+			switch rapid.IntRange(0, 2).Draw(rt, "op") {
+			case 0:
+				k := rapid.Int64Range(1, 50).Draw(rt, "k")
+				s.tallyStat(sess, stats.Damage, k)
+				want += k
+			case 1:
+				store.fail = rapid.Bool().Draw(rt, "fail")
+				s.flushStats(context.Background(), sess)
+			case 2:
+				store.fail = false
+				s.RollupStats(context.Background())
+			}
+		}
+		store.fail = false
+		s.flushStats(context.Background(), sess)
+		if got := store.totals[1][stats.Damage]; got != want {
+			rt.Fatalf("stored %d damage, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package gameserver
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// stealCooldown is how long a player must wait before trying the same NPC's
+// pockets again, whatever the outcome of the last try.
+const stealCooldown = 5 * time.Minute
+
+// stealCurrencyWords are the item names that ask for an NPC's cash.
+var stealCurrencyWords = map[string]bool{"credits": true, "money": true, "cash": true}
+
+// stealMark resolves what the player asked to steal against inst's pickpocket
+// table. It returns the display name and the matching item drop, or a nil drop
+// with currency true when the player is after cash.
+//
+// Postcondition: ok is false when inst carries nothing matching item.
+func (s *GameServiceServer) stealMark(inst *npc.Instance, item string) (name string, drop *npc.ItemDrop, currency, ok bool) {
+	lt := inst.Pickpocket
+	if lt == nil {
+		return "", nil, false, false
+	}
+	if stealCurrencyWords[strings.ToLower(item)] {
+		return "credits", nil, true, lt.Currency != nil && lt.Currency.Max > 0
+	}
+	for i := range lt.Items {
+		d := &lt.Items[i]
+		name := d.ItemID
+		if s.invRegistry != nil {
+			if def, found := s.invRegistry.Item(d.ItemID); found {
+				name = def.Name
+			}
+		}
+		if strings.EqualFold(item, d.ItemID) || strings.EqualFold(item, name) {
+			return name, d, false, true
+		}
+	}
+	return "", nil, false, false
+}
+
+// handleSteal tries to lift req.Item from the NPC named by req.Target.
+//
+// Resolution: opposed check. The player rolls d20 + grift rank bonus +
+// Quickness modifier against the NPC's Perception, d20 + Awareness modifier;
+// ties go to the thief. On success the item is taken if the NPC has it on them
+// this time (its table chance). On failure the NPC catches the thief: combat
+// NPCs attack, and in a protected zone the theft is reported as a crime.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Each attempt, successful or not, starts the per-NPC cooldown.
+func (s *GameServiceServer) handleSteal(uid string, req *gamev1.StealRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if req.GetItem() == "" || req.GetTarget() == "" {
+		return errorEvent("Usage: steal <item> from <npc>"), nil
+	}
+	if sess.Status == statusInCombat {
+		return errorEvent("You can't pick pockets in the middle of a fight."), nil
+	}
+	inst := s.npcMgr.FindInRoom(sess.RoomID, req.GetTarget())
+	if inst == nil || inst.IsDead() {
+		return errorEvent(fmt.Sprintf("You don't see %q here.", req.GetTarget())), nil
+	}
+	if s.combatH != nil && s.combatH.IsInCombat(inst.ID) {
+		return errorEvent(fmt.Sprintf("%s is too busy fighting to get close to.", inst.Name())), nil
+	}
+	name, drop, currency, ok := s.stealMark(inst, req.GetItem())
+	if !ok {
+		return messageEvent(fmt.Sprintf("You can't see any way to lift %s from %s.", req.GetItem(), inst.Name())), nil
+	}
+	now := time.Now()
+	if last, tried := inst.StealAttempts[uid]; tried && now.Sub(last) < stealCooldown {
+		return messageEvent(fmt.Sprintf("%s is still keeping a hand on their pockets. Give it time.", inst.Name())), nil
+	}
+
+	playerD20 := s.rollD20()
+	npcD20 := s.rollD20()
+	playerTotal := playerD20 + skillRankBonus(sess.Skills["grift"]) + combat.AbilityMod(sess.Abilities.Quickness)
+	perception := npcD20 + combat.AbilityMod(inst.Awareness)
+	sess.LastCheckRoll = playerD20
+	sess.LastCheckDC = perception
+	sess.LastCheckName = "steal"
+
+	if inst.StealAttempts == nil {
+		inst.StealAttempts = make(map[string]time.Time)
+	}
+	inst.StealAttempts[uid] = now
+
+	if playerTotal < perception {
+		s.catchThief(sess, inst)
+		return messageEvent(fmt.Sprintf("%s catches your hand in their pocket!", inst.Name())), nil
+	}
+
+	ctx := s.commandCtx(uid)
+	if currency {
+		amount := inst.Pickpocket.Currency.Min
+		if span := inst.Pickpocket.Currency.Max - inst.Pickpocket.Currency.Min; span > 0 {
+			amount += rand.Intn(span + 1)
+		}
+		if amount <= 0 {
+			return messageEvent(fmt.Sprintf("You slip a hand into %s's pocket unnoticed, but come away with nothing.", inst.Name())), nil
+		}
+		sess.Currency += amount
+		s.emitCurrency(sess, amount, telemetry.SourcePickpocket)
+		if s.charSaver != nil {
+			if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
+				s.logger.Warn("handleSteal: SaveCurrency failed", zap.String("uid", uid), zap.Error(err))
+			}
+		}
+		return messageEvent(fmt.Sprintf("You lift %d credits from %s without them noticing.", amount, inst.Name())), nil
+	}
+
+	if rand.Float64() >= drop.Chance {
+		return messageEvent(fmt.Sprintf("You slip a hand into %s's pocket unnoticed, but there's no %s on them today.", inst.Name(), name)), nil
+	}
+	qty := drop.MinQty
+	if drop.MaxQty > drop.MinQty {
+		qty += rand.Intn(drop.MaxQty - drop.MinQty + 1)
+	}
+	if sess.Backpack == nil || s.invRegistry == nil {
+		return messageEvent(fmt.Sprintf("You have nowhere to stash the %s.", name)), nil
+	}
+	if _, err := sess.Backpack.Add(drop.ItemID, qty, s.invRegistry); err != nil {
+		return messageEvent(fmt.Sprintf("You get a hand on the %s but can't carry it: %v.", name, err)), nil
+	}
+	if qty > 1 {
+		return messageEvent(fmt.Sprintf("You lift %d× %s from %s without them noticing.", qty, name, inst.Name())), nil
+	}
+	return messageEvent(fmt.Sprintf("You lift the %s from %s without them noticing.", name, inst.Name())), nil
+}
+
+// catchThief handles an NPC noticing a pickpocket: the room sees it, a combat
+// NPC turns on the thief, and the theft is reported as a crime.
+//
+// Precondition: sess and inst must be non-nil.
+func (s *GameServiceServer) catchThief(sess *session.PlayerSession, inst *npc.Instance) {
+	s.broadcastMessage(sess.RoomID, sess.UID, &gamev1.MessageEvent{
+		Content: fmt.Sprintf("%s catches %s with a hand in their pocket!", inst.Name(), sess.CharName),
+	})
+	if s.combatH != nil && (inst.NPCType == "" || inst.NPCType == "combat" || inst.NPCType == "guard") {
+		inst.GrudgePlayerID = sess.UID
+		s.combatH.InitiateNPCCombat(inst, sess.UID)
+	}
+	if room, ok := s.world.GetRoom(sess.RoomID); ok {
+		s.reportCrime(sess, room, "pick "+inst.Name()+"'s pocket")
+	}
+}
@@ -0,0 +1,115 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/danger"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newStealTestServer builds a server with a player in room_a and a mark
+// carrying a stim pack and some credits. Without a dice source both sides
+// roll 10, so the outcome is decided by the player's grift and the mark's
+// Awareness.
+func newStealTestServer(t *testing.T, awareness int) (*GameServiceServer, *session.PlayerSession, *npc.Instance) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcManager := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcManager)
+
+	invReg := inventory.NewRegistry()
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{
+		ID: "stim_pack", Name: "Stim Pack", Kind: "consumable", MaxStack: 10,
+	}))
+	svc.invRegistry = invReg
+
+	sess, err := svc.sessions.AddPlayer(session.AddPlayerOptions{
+		UID: "thief_u1", Username: "thief", CharName: "Thief",
+		RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	sess.Skills = map[string]string{"grift": "trained"}
+	sess.Abilities.Quickness = 10
+
+	inst, err := svc.npcMgr.Spawn(&npc.Template{
+		ID: "mark", Name: "Mark", NPCType: "merchant", Level: 1, MaxHP: 10, AC: 10,
+		Awareness: awareness,
+		Pickpocket: &npc.LootTable{
+			Currency: &npc.CurrencyDrop{Min: 25, Max: 25},
+			Items:    []npc.ItemDrop{{ItemID: "stim_pack", Chance: 1.0, MinQty: 1, MaxQty: 1}},
+		},
+	}, "room_a")
+	require.NoError(t, err)
+	return svc, sess, inst
+}
+
+func TestHandleSteal_LiftsItem(t *testing.T) {
+	svc, sess, _ := newStealTestServer(t, 10)
+
+	evt, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "stim pack", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "You lift the Stim Pack from Mark")
+	assert.Len(t, sess.Backpack.FindByItemDefID("stim_pack"), 1)
+}
+
+func TestHandleSteal_LiftsCredits(t *testing.T) {
+	svc, sess, _ := newStealTestServer(t, 10)
+	sess.Currency = 5
+
+	evt, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "credits", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "25 credits")
+	assert.Equal(t, 30, sess.Currency)
+}
+
+func TestHandleSteal_UnknownItem(t *testing.T) {
+	svc, sess, inst := newStealTestServer(t, 10)
+
+	evt, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "crowbar", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "can't see any way")
+	assert.Empty(t, inst.StealAttempts, "a request for something the mark lacks must not use up the attempt")
+}
+
+func TestHandleSteal_Cooldown(t *testing.T) {
+	svc, sess, inst := newStealTestServer(t, 10)
+
+	_, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "stim_pack", Target: "Mark"})
+	require.NoError(t, err)
+	evt, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "stim_pack", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "Give it time")
+	assert.Len(t, sess.Backpack.FindByItemDefID("stim_pack"), 1)
+
+	inst.StealAttempts[sess.UID] = time.Now().Add(-stealCooldown)
+	evt, err = svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "stim_pack", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "You lift")
+}
+
+func TestHandleSteal_CaughtIsACrime(t *testing.T) {
+	svc, sess, _ := newStealTestServer(t, 30)
+	setRoomDanger(t, svc, danger.Safe)
+
+	evt, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "stim_pack", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().Content, "catches your hand")
+	assert.Empty(t, sess.Backpack.FindByItemDefID("stim_pack"))
+	assert.Equal(t, 1, sess.WantedLevel["test"])
+}
+
+func TestHandleSteal_RefusedInCombat(t *testing.T) {
+	svc, sess, _ := newStealTestServer(t, 10)
+	sess.Status = statusInCombat
+
+	evt, err := svc.handleSteal(sess.UID, &gamev1.StealRequest{Item: "stim_pack", Target: "Mark"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().Message, "middle of a fight")
+}
@@ -87,7 +87,7 @@ func (s *GameServiceServer) handleSurrender(uid string, req *gamev1.SurrenderReq
 
 	// Persist detention expiry.
 	if s.detainedUntilRepo != nil {
-		ctx := context.Background()
+		ctx := s.commandCtx(uid)
 		if err := s.detainedUntilRepo.UpdateDetainedUntil(ctx, sess.CharacterID, sess.DetainedUntil); err != nil {
 			s.logger.Warn("handleSurrender: UpdateDetainedUntil failed",
 				zap.String("uid", uid),
@@ -19,6 +19,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/session"
 	"github.com/cory-johannsen/mud/internal/game/technology"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/telemetry"
 )
 
 // findTechTrainerInRoom locates a tech_trainer NPC by name in roomID.
@@ -92,7 +93,7 @@ func (s *GameServiceServer) handleTrainTech(uid, npcName, techID string) (*gamev
 	if techID == "" {
 		return s.listTechTrainerOfferings(inst, cfg, sess), nil
 	}
-	evt := s.doTrainTech(context.Background(), sess, inst, cfg, techID)
+	evt := s.doTrainTech(s.commandCtx(uid), sess, inst, cfg, techID)
 	// Push updated character sheet so the client reflects the newly trained tech.
 	s.pushCharacterSheet(sess)
 	return evt, nil
@@ -419,6 +420,7 @@ func (s *GameServiceServer) doTrainTech(
 
 	// Deduct currency.
 	sess.Currency -= cost
+	s.emitCurrency(sess, -cost, telemetry.SourceTraining)
 	if s.charSaver != nil && sess.CharacterID > 0 {
 		if err := s.charSaver.SaveCurrency(ctx, sess.CharacterID, sess.Currency); err != nil {
 			s.logger.Warn("doTrainTech: SaveCurrency failed",
@@ -535,7 +537,7 @@ func (s *GameServiceServer) consumePendingTechGrant(sess *session.PlayerSession,
 		for lvl := range sess.PendingTechGrants {
 			levels = append(levels, lvl)
 		}
-		if err := s.progressRepo.SetPendingTechLevels(context.Background(), sess.CharacterID, levels); err != nil {
+		if err := s.progressRepo.SetPendingTechLevels(s.commandCtx(sess.UID), sess.CharacterID, levels); err != nil {
 			s.logger.Warn("consumePendingTechGrant: SetPendingTechLevels failed",
 				zap.Int64("character_id", sess.CharacterID),
 				zap.Error(err),
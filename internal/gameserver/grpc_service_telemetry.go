@@ -0,0 +1,63 @@
+package gameserver
+
+import (
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// SetTelemetry installs the emitter game events are exported to.
+//
+// Postcondition: e may be nil, which exports nothing.
+func (s *GameServiceServer) SetTelemetry(e *telemetry.Emitter) {
+	s.telemetry = e
+}
+
+// emitEvent stamps ev with sess's character and location and exports it.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: No-op when telemetry is not configured.
+func (s *GameServiceServer) emitEvent(sess *session.PlayerSession, ev telemetry.Event) {
+	if s.telemetry == nil {
+		return
+	}
+	ev.CharacterID, ev.Character, ev.Room = sess.CharacterID, sess.CharName, sess.RoomID
+	if s.world != nil {
+		if room, ok := s.world.GetRoom(sess.RoomID); ok {
+			ev.Zone = room.ZoneID
+		}
+	}
+	s.telemetry.Emit(ev)
+}
+
+// emitCurrency exports a change of delta credits to sess's wallet: positive
+// when credits enter the economy or the player's hands, negative when they leave.
+//
+// Precondition: sess must be non-nil; source should be one of the telemetry.Source constants.
+// Postcondition: No-op when delta is 0.
+func (s *GameServiceServer) emitCurrency(sess *session.PlayerSession, delta int, source string) {
+	if delta == 0 {
+		return
+	}
+	s.emitEvent(sess, telemetry.Event{Type: telemetry.TypeCurrency, Amount: int64(delta), Source: source})
+}
+
+// emitTrade exports qty of itemID changing hands with counterparty for price
+// credits in total, followed by the matching currency event.
+//
+// Precondition: sess must be non-nil; source is SourceMerchantBuy,
+// SourceMerchantSell, or SourceAuctionBuy.
+func (s *GameServiceServer) emitTrade(sess *session.PlayerSession, source, itemID string, qty, price int, counterparty string) {
+	s.emitEvent(sess, telemetry.Event{
+		Type:         telemetry.TypeTrade,
+		Target:       itemID,
+		Counterparty: counterparty,
+		Quantity:     qty,
+		Amount:       int64(price),
+		Source:       source,
+	})
+	delta := price
+	if source != telemetry.SourceMerchantSell {
+		delta = -price
+	}
+	s.emitCurrency(sess, delta, source)
+}
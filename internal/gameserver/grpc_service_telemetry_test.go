@@ -0,0 +1,89 @@
+package gameserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// recordingSink is a telemetry.Sink that keeps every event written.
+type recordingSink struct {
+	mu     sync.Mutex
+	events []telemetry.Event
+}
+
+func (r *recordingSink) Write(_ context.Context, events []telemetry.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *recordingSink) Close() error { return nil }
+
+func TestTelemetry_EventsCarryCharacterAndLocation(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	sink := &recordingSink{}
+	emitter := telemetry.NewEmitter(sink, 100, time.Hour, zap.NewNop())
+	svc.SetTelemetry(emitter)
+	sess := addPlayerForCombatDefault(t, svc, "vex", 42)
+
+	svc.onNPCKilled(sess, &npc.Instance{TemplateID: "ganger", Level: 3})
+	svc.emitTrade(sess, telemetry.SourceMerchantSell, "stim_pack", 2, 30, "fence")
+	svc.emitTrade(sess, telemetry.SourceMerchantBuy, "pipe", 1, 12, "fence")
+	svc.emitCurrency(sess, 0, telemetry.SourceLoot)
+	require.NoError(t, emitter.Close())
+
+	require.Len(t, sink.events, 5, "a zero currency change is not exported")
+	kill := sink.events[0]
+	assert.Equal(t, telemetry.TypeKill, kill.Type)
+	assert.Equal(t, int64(42), kill.CharacterID)
+	assert.Equal(t, "vex", kill.Character)
+	assert.Equal(t, "test", kill.Zone)
+	assert.Equal(t, "room_a", kill.Room)
+	assert.Equal(t, "ganger", kill.Target)
+	assert.Equal(t, int64(3), kill.Amount)
+
+	sale, proceeds := sink.events[1], sink.events[2]
+	assert.Equal(t, telemetry.TypeTrade, sale.Type)
+	assert.Equal(t, "stim_pack", sale.Target)
+	assert.Equal(t, 2, sale.Quantity)
+	assert.Equal(t, "fence", sale.Counterparty)
+	assert.Equal(t, telemetry.TypeCurrency, proceeds.Type)
+	assert.Equal(t, int64(30), proceeds.Amount, "selling brings credits in")
+
+	assert.Equal(t, int64(-12), sink.events[4].Amount, "buying spends credits")
+	assert.Equal(t, telemetry.SourceMerchantBuy, sink.events[4].Source)
+}
+
+func TestTelemetry_DisabledIsNoOp(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	sess := addPlayerForCombatDefault(t, svc, "vex", 42)
+	assert.NotPanics(t, func() {
+		svc.emitCurrency(sess, 5, telemetry.SourceLoot)
+		svc.emitTrade(sess, telemetry.SourceAuctionBuy, "pipe", 1, 12, "kira")
+	})
+}
+
+func TestCombatHandler_DistributeCurrencyReportsLoot(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	a := addPlayerForCombatDefault(t, svc, "a", 1)
+	b := addPlayerForCombatDefault(t, svc, "b", 2)
+	h := &CombatHandler{}
+	got := map[string]int{}
+	h.SetOnCurrencyChangedFn(func(sess *session.PlayerSession, delta int, source string) {
+		assert.Equal(t, telemetry.SourceLoot, source)
+		got[sess.UID] += delta
+	})
+	h.distributeCurrencyLocked(context.Background(), []*session.PlayerSession{a, b}, 10)
+	assert.Equal(t, map[string]int{"a": 5, "b": 5}, got)
+}
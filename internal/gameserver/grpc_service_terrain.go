@@ -0,0 +1,123 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+// carriesGear reports whether the player's backpack holds an item tagged tag.
+//
+// Precondition: sess must be non-nil.
+// Postcondition: Returns false when tag is empty or no registry is configured.
+func (s *GameServiceServer) carriesGear(sess *session.PlayerSession, tag string) bool {
+	if tag == "" || s.invRegistry == nil || sess.Backpack == nil {
+		return false
+	}
+	for _, it := range sess.Backpack.Items() {
+		if def, ok := s.invRegistry.Item(it.ItemDefID); ok && def.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTerrainEntry decides whether the player may move dir into a room with
+// hard terrain. Players still recovering from their last crossing must wait;
+// otherwise players without the terrain's gear roll muscle against its DC.
+// A critical failure on vertical terrain is a fall, and in water a dunking,
+// both dealing 1d6 damage.
+//
+// Precondition: sess must be non-nil and belong to uid.
+// Postcondition: blocked is true when the move must not happen, with msg
+// explaining why; otherwise rule is the destination's terrain rule and msg is
+// an optional note describing the crossing.
+func (s *GameServiceServer) checkTerrainEntry(uid string, sess *session.PlayerSession, dir world.Direction) (rule world.TerrainRule, msg string, blocked bool) {
+	if wait := time.Until(sess.MoveReadyAt); wait > 0 {
+		return rule, fmt.Sprintf("You're still catching your breath from the last crossing (%ds).", int(wait.Seconds())+1), true
+	}
+	dest, err := s.world.Navigate(sess.RoomID, dir)
+	if err != nil {
+		// Missing and locked exits are reported by the move itself.
+		return rule, "", false
+	}
+	rule, ok := world.TerrainRuleFor(dest.Terrain)
+	if !ok || rule.DC == 0 || s.dice == nil {
+		return rule, "", false
+	}
+	if s.carriesGear(sess, rule.Gear) {
+		return rule, fmt.Sprintf("Your gear makes it easy to %s %s.", rule.Verb, dir), false
+	}
+
+	rollResult, err := s.dice.RollExpr("1d20")
+	if err != nil {
+		return rule, "", false
+	}
+	roll := rollResult.Total()
+	bonus := skillRankBonus(sess.Skills["muscle"])
+	total := roll + bonus
+	sess.LastCheckRoll = roll
+	sess.LastCheckDC = rule.DC
+	sess.LastCheckName = rule.Verb
+
+	switch combat.OutcomeFor(total, rule.DC) {
+	case combat.CritSuccess, combat.Success:
+		return rule, fmt.Sprintf("You %s %s (rolled %d+%d=%d vs DC %d).", rule.Verb, dir, roll, bonus, total, rule.DC), false
+	case combat.Failure:
+		return rule, fmt.Sprintf("You try to %s %s but can't make it (rolled %d+%d=%d vs DC %d).", rule.Verb, dir, roll, bonus, total, rule.DC), true
+	}
+
+	if !rule.Fall && !rule.Deep {
+		return rule, fmt.Sprintf("You try to %s %s but can't make it (rolled %d+%d=%d vs DC %d).", rule.Verb, dir, roll, bonus, total, rule.DC), true
+	}
+	dmg := 1
+	if dmgResult, err := s.dice.RollExpr("1d6"); err == nil && dmgResult.Total() > 1 {
+		dmg = dmgResult.Total()
+	}
+	sess.CurrentHP = max(sess.CurrentHP-dmg, 0)
+	s.checkNonCombatDeath(uid, sess) // REQ-BUG100-1
+	if rule.Fall {
+		return rule, fmt.Sprintf("You lose your grip and fall (rolled %d+%d=%d vs DC %d), taking %d damage.", roll, bonus, total, rule.DC, dmg), true
+	}
+	return rule, fmt.Sprintf("The water drags you under and spits you back out (rolled %d+%d=%d vs DC %d). You take %d damage.", roll, bonus, total, rule.DC, dmg), true
+}
+
+// tickDeepWater handles a player lingering in deep water without swim gear.
+// The first tick is a warning; every later tick deals 1d6 drowning damage.
+// Called from the periodic room refresh.
+//
+// Precondition: uid may name a disconnected player.
+// Postcondition: sess.DeepWaterTicks is reset once the player is out of deep
+// water, has gear, is aboard a vehicle, or is in combat.
+func (s *GameServiceServer) tickDeepWater(uid string) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok || sess.Dead {
+		return
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return
+	}
+	rule, ok := world.TerrainRuleFor(room.Terrain)
+	if !ok || !rule.Deep || sess.Status == statusInCombat || s.carriesGear(sess, rule.Gear) || s.riding(uid) {
+		sess.DeepWaterTicks = 0
+		return
+	}
+	sess.DeepWaterTicks++
+	if sess.DeepWaterTicks == 1 {
+		s.pushMessageToUID(uid, "You tread water, fighting to keep your head up. Get out of the water before you tire.")
+		return
+	}
+	dmg := 1
+	if s.dice != nil {
+		if dmgResult, err := s.dice.RollExpr("1d6"); err == nil && dmgResult.Total() > 1 {
+			dmg = dmgResult.Total()
+		}
+	}
+	sess.CurrentHP = max(sess.CurrentHP-dmg, 0)
+	s.pushMessageToUID(uid, fmt.Sprintf("You swallow water and choke! You take %d drowning damage.", dmg))
+	s.checkNonCombatDeath(uid, sess) // REQ-BUG100-1
+}
@@ -0,0 +1,104 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newTerrainSvc builds the swim world with player "u_terrain" on the river
+// bank, south of the river, rolling val on every die.
+func newTerrainSvc(t *testing.T, val int) (*GameServiceServer, *session.PlayerSession) {
+	t.Helper()
+	roller := dice.NewLoggedRoller(&fixedDiceSource{val: val}, zaptest.NewLogger(t))
+	svc, sessMgr := newSwimSvc(t, roller, makeTestConditionRegistryWithSubmerged())
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "u_terrain", Username: "Wader", CharName: "Wader", Role: "player",
+		RoomID: "room_bank", CurrentHP: 20, MaxHP: 20,
+	})
+	require.NoError(t, err)
+	return svc, sess
+}
+
+func TestHandleMove_HardTerrainSuccessSlowsNextMove(t *testing.T) {
+	// val=17 → d20 roll 18 vs river DC 15.
+	svc, sess := newTerrainSvc(t, 17)
+
+	_, err := svc.handleMove("u_terrain", &gamev1.MoveRequest{Direction: "south"})
+	require.NoError(t, err)
+	assert.Equal(t, "room_water", sess.RoomID)
+	assert.Equal(t, 15, sess.LastCheckDC)
+	assert.True(t, sess.MoveReadyAt.After(time.Now()))
+
+	evt, err := svc.handleMove("u_terrain", &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "catching your breath")
+	assert.Equal(t, "room_water", sess.RoomID)
+}
+
+func TestHandleMove_HardTerrainFailureBlocksEntry(t *testing.T) {
+	// val=8 → d20 roll 9 vs DC 15: failure.
+	svc, sess := newTerrainSvc(t, 8)
+
+	evt, err := svc.handleMove("u_terrain", &gamev1.MoveRequest{Direction: "south"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "You try to swim south but can't make it")
+	assert.Equal(t, "room_bank", sess.RoomID)
+	assert.Equal(t, 20, sess.CurrentHP)
+}
+
+func TestHandleMove_HardTerrainCritFailureInWaterDealsDamage(t *testing.T) {
+	// val=0 → d20 roll 1: critical failure; d6 roll 1.
+	svc, sess := newTerrainSvc(t, 0)
+
+	evt, err := svc.handleMove("u_terrain", &gamev1.MoveRequest{Direction: "south"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "drags you under")
+	assert.Equal(t, "room_bank", sess.RoomID)
+	assert.Equal(t, 19, sess.CurrentHP)
+}
+
+func TestHandleMove_SwimGearSkipsCheck(t *testing.T) {
+	svc, sess := newTerrainSvc(t, 0)
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "float_vest", Name: "Float Vest", Kind: inventory.KindJunk, MaxStack: 1, Tags: []string{world.GearSwim}}))
+	svc.invRegistry = reg
+	_, err := sess.Backpack.Add("float_vest", 1, reg)
+	require.NoError(t, err)
+
+	_, err = svc.handleMove("u_terrain", &gamev1.MoveRequest{Direction: "south"})
+	require.NoError(t, err)
+	assert.Equal(t, "room_water", sess.RoomID)
+	assert.Zero(t, sess.LastCheckDC, "no check is rolled with gear")
+
+	svc.tickDeepWater("u_terrain")
+	assert.Zero(t, sess.DeepWaterTicks, "gear keeps the player afloat")
+	assert.Equal(t, 20, sess.CurrentHP)
+}
+
+func TestTickDeepWater_WarnsThenDrowns(t *testing.T) {
+	// val=2 → d6 roll 3.
+	svc, sess := newTerrainSvc(t, 2)
+	sess.RoomID = "room_water"
+
+	svc.tickDeepWater("u_terrain")
+	assert.Equal(t, 1, sess.DeepWaterTicks)
+	assert.Equal(t, 20, sess.CurrentHP, "first tick only warns")
+
+	svc.tickDeepWater("u_terrain")
+	assert.Equal(t, 17, sess.CurrentHP)
+
+	sess.RoomID = "room_bank"
+	svc.tickDeepWater("u_terrain")
+	assert.Zero(t, sess.DeepWaterTicks)
+	assert.Equal(t, 17, sess.CurrentHP)
+}
@@ -89,6 +89,17 @@ func (r *stubFeatsRepo) Add(_ context.Context, characterID int64, featID string)
 	return nil
 }
 
+func (r *stubFeatsRepo) Remove(_ context.Context, characterID int64, featID string) error {
+	kept := r.data[characterID][:0]
+	for _, id := range r.data[characterID] {
+		if id != featID {
+			kept = append(kept, id)
+		}
+	}
+	r.data[characterID] = kept
+	return nil
+}
+
 // stubClassFeaturesRepo is an in-memory implementation of CharacterClassFeaturesGetter for testing.
 type stubClassFeaturesRepo struct {
 	data map[int64][]string
@@ -2407,7 +2418,7 @@ func newFirstAidSvc(t *testing.T, roller *dice.Roller, combatHandler *CombatHand
 // Postcondition: error is returned; event is nil.
 func TestHandleFirstAid_NoSession(t *testing.T) {
 	svc, _ := newFirstAidSvc(t, nil, nil)
-	event, err := svc.handleFirstAid("unknown_fa_uid")
+	event, err := svc.handleFirstAid("unknown_fa_uid", &gamev1.FirstAidRequest{})
 	require.Error(t, err)
 	assert.Nil(t, event)
 }
@@ -2436,7 +2447,7 @@ func TestHandleFirstAid_OutOfCombat_FailedCheck(t *testing.T) {
 	require.NoError(t, err)
 	sess.Skills = map[string]string{} // no patch_job → bonus=0
 
-	event, err := svc.handleFirstAid("u_fa_fail")
+	event, err := svc.handleFirstAid("u_fa_fail", &gamev1.FirstAidRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, event)
 	msgEvt := event.GetMessage()
@@ -2469,7 +2480,7 @@ func TestHandleFirstAid_OutOfCombat_SuccessCheck(t *testing.T) {
 	require.NoError(t, err)
 	sess.Skills = map[string]string{"patch_job": "trained"}
 
-	event, err := svc.handleFirstAid("u_fa_success")
+	event, err := svc.handleFirstAid("u_fa_success", &gamev1.FirstAidRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, event)
 	msgEvt := event.GetMessage()
@@ -2520,7 +2531,7 @@ func TestHandleFirstAid_InCombat_InsufficientAP(t *testing.T) {
 	require.NoError(t, err)
 	sess.Status = statusInCombat // triggers SpendAP path
 
-	event, err := svc.handleFirstAid("u_fa_combat")
+	event, err := svc.handleFirstAid("u_fa_combat", &gamev1.FirstAidRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, event)
 	errEvt := event.GetError()
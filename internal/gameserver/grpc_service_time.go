@@ -0,0 +1,94 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// seasonHook is the Lua function called in every script VM when the season turns.
+const seasonHook = "on_season_change"
+
+// handleTime reports the game time, date, and season, and how fast game time runs.
+//
+// Precondition: uid must identify an active session.
+// Postcondition: Returns a non-nil ServerEvent; error is always nil.
+func (s *GameServiceServer) handleTime(uid string) (*gamev1.ServerEvent, error) {
+	if s.calendar == nil {
+		return errorEvent("The game clock isn't running."), nil
+	}
+	return messageEvent(formatGameTime(s.calendar.CurrentDateTime(), s.calendar.clock.TickInterval())), nil
+}
+
+// formatGameTime renders dt and the length of a game hour for the time command.
+func formatGameTime(dt GameDateTime, hourLength time.Duration) string {
+	return fmt.Sprintf("It is %s (%s) on %s.\nIt is day %d of %s.\nA game hour passes every %s of real time; a game day every %s.",
+		dt.Hour, dt.Hour.Period(), FormatDate(dt.Month, dt.Day),
+		dt.DayOfSeason(), dt.Season(),
+		formatRealDuration(hourLength), formatRealDuration(24*hourLength))
+}
+
+// formatRealDuration renders d in the largest whole unit that fits, e.g. "1 minute" or "90 seconds".
+func formatRealDuration(d time.Duration) string {
+	plural := func(n int64, unit string) string {
+		if n == 1 {
+			return "1 " + unit
+		}
+		return fmt.Sprintf("%d %ss", n, unit)
+	}
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return plural(int64(d/time.Hour), "hour")
+	case d >= time.Minute && d%time.Minute == 0:
+		return plural(int64(d/time.Minute), "minute")
+	case d >= time.Second && d%time.Second == 0:
+		return plural(int64(d/time.Second), "second")
+	}
+	return d.String()
+}
+
+// StartSeasonHook subscribes to the calendar and announces each change of
+// season: players are told, and on_season_change(season) is called in every
+// loaded script VM so zones can swap in seasonal content.
+//
+// Precondition: MUST be called after GameServiceServer is fully initialized.
+// Postcondition: returns a stop function; call it to unsubscribe and stop the goroutine.
+func (s *GameServiceServer) StartSeasonHook() func() {
+	if s.calendar == nil {
+		return func() {}
+	}
+	ch := make(chan GameDateTime, 4)
+	s.calendar.Subscribe(ch)
+	stop := make(chan struct{})
+	last := s.calendar.CurrentDateTime().Season()
+	go func() {
+		for {
+			select {
+			case dt := <-ch:
+				if season := dt.Season(); season != last {
+					last = season
+					s.changeSeason(season)
+				}
+			case <-stop:
+				s.calendar.Unsubscribe(ch)
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// changeSeason tells every player the season has turned and runs the season hook.
+func (s *GameServiceServer) changeSeason(season string) {
+	s.logger.Info("season changed", zap.String("season", season))
+	for _, sess := range s.sessions.AllPlayers() {
+		s.pushMessageToUID(sess.UID, fmt.Sprintf("The season turns. It is now %s.", season))
+	}
+	if s.scriptMgr != nil {
+		s.scriptMgr.CallHookEverywhere(seasonHook, lua.LString(season))
+	}
+}
@@ -0,0 +1,55 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+func TestFormatGameTime(t *testing.T) {
+	dt := GameDateTime{Hour: 14, Day: 3, Month: 7}
+	assert.Equal(t, "It is 14:00 (Afternoon) on July 3rd.\n"+
+		"It is day 33 of summer.\n"+
+		"A game hour passes every 1 minute of real time; a game day every 24 minutes.",
+		formatGameTime(dt, time.Minute))
+}
+
+func TestFormatRealDuration(t *testing.T) {
+	assert.Equal(t, "1 hour", formatRealDuration(time.Hour))
+	assert.Equal(t, "90 seconds", formatRealDuration(90*time.Second))
+	assert.Equal(t, "2 minutes", formatRealDuration(2*time.Minute))
+	assert.Equal(t, "1.5s", formatRealDuration(1500*time.Millisecond))
+}
+
+func TestHandleTime(t *testing.T) {
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testMinimalService(t, sessMgr)
+	svc.world = worldMgr
+	_, err := sessMgr.AddPlayer(session.AddPlayerOptions{UID: "u1", Username: "u", CharName: "Vex", RoomID: "room_a", Role: "player"})
+	require.NoError(t, err)
+
+	evt, err := svc.handleTime("u1")
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "isn't running")
+
+	svc.calendar = NewGameCalendar(NewGameClock(20, 30*time.Second), 1, 12, 0, &noopRepo{})
+	evt, err = svc.handleTime("u1")
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "It is 20:00 (Evening) on December 1st.\nIt is day 1 of winter.")
+	assert.Contains(t, trapEventText(evt), "every 30 seconds of real time")
+}
+
+func TestChangeSeason_TellsPlayers(t *testing.T) {
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testMinimalService(t, sessMgr)
+	svc.world = worldMgr
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{UID: "u1", Username: "u", CharName: "Vex", RoomID: "room_a", Role: "player"})
+	require.NoError(t, err)
+
+	svc.changeSeason("winter")
+	assert.Contains(t, pushedText(t, sess), "The season turns. It is now winter.")
+}
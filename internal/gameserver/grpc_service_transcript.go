@@ -0,0 +1,164 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// TranscriptStore persists finished session transcripts.
+type TranscriptStore interface {
+	SaveTranscript(ctx context.Context, t transcript.Transcript) (int64, error)
+}
+
+// handleLog turns session logging on or off, or reports it.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Returns a non-nil ServerEvent; error is non-nil only when the session is missing.
+func (s *GameServiceServer) handleLog(uid string, req *gamev1.LogRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if sess.Transcript == nil {
+		return errorEvent("Logging isn't available for this session."), nil
+	}
+	switch req.GetAction() {
+	case "on":
+		if s.transcriptStore == nil {
+			return errorEvent("Session logging is unavailable right now."), nil
+		}
+		if !sess.Transcript.Start(time.Now()) {
+			return messageEvent("You're already logging this session. Use log off to save it."), nil
+		}
+		return messageEvent("Logging is on. Everything you see is recorded until you type log off or leave the game."), nil
+	case "off":
+		if !sess.Transcript.Active() {
+			return messageEvent("You aren't logging this session."), nil
+		}
+		return messageEvent(s.saveTranscript(s.commandCtx(uid), sess)), nil
+	default:
+		if !sess.Transcript.Active() {
+			return messageEvent("Logging is off. Use log on to record this session."), nil
+		}
+		since := time.Since(sess.Transcript.StartedAt()).Round(time.Second)
+		return messageEvent(fmt.Sprintf("Logging is on (recording for %s). Use log off to save the transcript.", since)), nil
+	}
+}
+
+// saveTranscript stops sess's recording and stores it.
+//
+// Precondition: sess must be non-nil with a non-nil Transcript.
+// Postcondition: The recorder is idle; returns the text to show the player.
+func (s *GameServiceServer) saveTranscript(ctx context.Context, sess *session.PlayerSession) string {
+	t, ok := sess.Transcript.Stop(time.Now())
+	if !ok {
+		return "You aren't logging this session."
+	}
+	if s.transcriptStore == nil || sess.CharacterID <= 0 {
+		return "Logging is off. The transcript could not be saved."
+	}
+	t.CharacterID = sess.CharacterID
+	t.CharName = sess.CharName
+	id, err := s.transcriptStore.SaveTranscript(ctx, t)
+	if err != nil {
+		s.logger.Warn("saving transcript",
+			zap.String("uid", sess.UID),
+			zap.Int64("character_id", sess.CharacterID),
+			zap.Error(err),
+		)
+		return "Logging is off. The transcript could not be saved."
+	}
+	return fmt.Sprintf("Logging is off. Transcript #%d saved; download it from /api/transcripts/%d while signed in to the web client.", id, id)
+}
+
+// recordTranscript adds the text a client would show for evt to rec.
+//
+// Precondition: rec must be non-nil.
+// Postcondition: Events with no readable text are ignored; room views are
+// recorded once per room visited.
+func recordTranscript(rec *transcript.Recorder, evt *gamev1.ServerEvent) {
+	now := time.Now()
+	if rv := evt.GetRoomView(); rv != nil {
+		rec.WriteRoom(now, rv.GetRoomId(), transcriptRoomView(rv))
+		return
+	}
+	rec.Write(now, readableText(evt))
+}
+
+// readableText renders the text a client would show for evt, other than a room
+// view.
+//
+// Postcondition: Returns "" for events with no readable text.
+func readableText(evt *gamev1.ServerEvent) string {
+	switch p := evt.GetPayload().(type) {
+	case *gamev1.ServerEvent_Message:
+		return transcriptMessage(p.Message)
+	case *gamev1.ServerEvent_Error:
+		return p.Error.GetMessage()
+	case *gamev1.ServerEvent_CombatEvent:
+		return p.CombatEvent.GetNarrative()
+	case *gamev1.ServerEvent_RoomEvent:
+		return transcriptRoomEvent(p.RoomEvent)
+	}
+	return ""
+}
+
+// transcriptMessage renders a message the way the text client does.
+func transcriptMessage(me *gamev1.MessageEvent) string {
+	switch me.GetType() {
+	case gamev1.MessageType_MESSAGE_TYPE_SAY:
+		return fmt.Sprintf("%s says: %s", me.GetSender(), me.GetContent())
+	case gamev1.MessageType_MESSAGE_TYPE_EMOTE:
+		return fmt.Sprintf("%s %s", me.GetSender(), me.GetContent())
+	}
+	if me.GetSender() != "" {
+		return fmt.Sprintf("%s: %s", me.GetSender(), me.GetContent())
+	}
+	return me.GetContent()
+}
+
+// transcriptRoomEvent renders an arrival or departure.
+func transcriptRoomEvent(re *gamev1.RoomEvent) string {
+	switch re.GetType() {
+	case gamev1.RoomEventType_ROOM_EVENT_TYPE_ARRIVE:
+		if re.GetDirection() != "" {
+			return fmt.Sprintf("%s arrived from the %s.", re.GetPlayer(), re.GetDirection())
+		}
+		return fmt.Sprintf("%s has arrived.", re.GetPlayer())
+	case gamev1.RoomEventType_ROOM_EVENT_TYPE_DEPART:
+		if re.GetDirection() != "" {
+			return fmt.Sprintf("%s left to the %s.", re.GetPlayer(), re.GetDirection())
+		}
+		return fmt.Sprintf("%s has left.", re.GetPlayer())
+	}
+	return ""
+}
+
+// transcriptRoomView renders a room's title, description, and visible exits.
+func transcriptRoomView(rv *gamev1.RoomView) string {
+	var b strings.Builder
+	b.WriteString(rv.GetTitle())
+	if rv.GetDescription() != "" {
+		b.WriteString("\n")
+		b.WriteString(rv.GetDescription())
+	}
+	var exits []string
+	for _, e := range rv.GetExits() {
+		if !e.GetHidden() {
+			exits = append(exits, e.GetDirection())
+		}
+	}
+	if len(exits) > 0 {
+		b.WriteString("\nExits: ")
+		b.WriteString(strings.Join(exits, ", "))
+	}
+	return b.String()
+}
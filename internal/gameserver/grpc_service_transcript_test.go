@@ -0,0 +1,115 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// fakeTranscriptStore keeps saved transcripts in memory.
+type fakeTranscriptStore struct {
+	saved []transcript.Transcript
+	err   error
+}
+
+func (f *fakeTranscriptStore) SaveTranscript(_ context.Context, t transcript.Transcript) (int64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.saved = append(f.saved, t)
+	return int64(len(f.saved)), nil
+}
+
+func newTranscriptTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *fakeTranscriptStore) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	store := &fakeTranscriptStore{}
+	svc.transcriptStore = store
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "log_u1", Username: "scribe", CharName: "Mira", CharacterID: 11, RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, sess, store
+}
+
+func logMsg(t *testing.T, svc *GameServiceServer, uid, action string) string {
+	t.Helper()
+	evt, err := svc.handleLog(uid, &gamev1.LogRequest{Action: action})
+	require.NoError(t, err)
+	return trapEventText(evt)
+}
+
+func TestHandleLog_RecordsSentEventsUntilOff(t *testing.T) {
+	svc, sess, store := newTranscriptTestServer(t)
+	ss := &syncStream{GameService_SessionServer: &atomicFakeStream{}, transcript: sess.Transcript}
+
+	require.NoError(t, ss.Send(messageEvent("not recorded yet")))
+	assert.Contains(t, logMsg(t, svc, sess.UID, "status"), "Logging is off.")
+	assert.Contains(t, logMsg(t, svc, sess.UID, "on"), "Logging is on.")
+	assert.Contains(t, logMsg(t, svc, sess.UID, "on"), "already logging")
+	assert.Contains(t, logMsg(t, svc, sess.UID, "status"), "recording for")
+
+	room := &gamev1.ServerEvent{Payload: &gamev1.ServerEvent_RoomView{RoomView: &gamev1.RoomView{
+		RoomId: "room_a", Title: "Room A", Description: "A bare room.",
+		Exits: []*gamev1.ExitInfo{{Direction: "north"}, {Direction: "down", Hidden: true}},
+	}}}
+	require.NoError(t, ss.Send(room))
+	require.NoError(t, ss.Send(room))
+	require.NoError(t, ss.Send(&gamev1.ServerEvent{Payload: &gamev1.ServerEvent_Message{Message: &gamev1.MessageEvent{
+		Sender: "Jax", Content: "hi there", Type: gamev1.MessageType_MESSAGE_TYPE_SAY,
+	}}}))
+	require.NoError(t, ss.Send(&gamev1.ServerEvent{Payload: &gamev1.ServerEvent_RoomEvent{RoomEvent: &gamev1.RoomEvent{
+		Player: "Jax", Type: gamev1.RoomEventType_ROOM_EVENT_TYPE_DEPART, Direction: "north",
+	}}}))
+	require.NoError(t, ss.Send(errorEvent("You can't do that.")))
+
+	msg := logMsg(t, svc, sess.UID, "off")
+	assert.Contains(t, msg, "Transcript #1 saved")
+	assert.Contains(t, msg, "/api/transcripts/1")
+	require.Len(t, store.saved, 1)
+	got := store.saved[0]
+	assert.Equal(t, int64(11), got.CharacterID)
+	assert.Equal(t, "Mira", got.CharName)
+	assert.NotContains(t, got.Body, "not recorded yet")
+	assert.Equal(t, 1, strings.Count(got.Body, "Room A"), "a repeated room view is recorded once")
+	assert.Contains(t, got.Body, "A bare room.\nExits: north\n")
+	assert.Contains(t, got.Body, "Jax says: hi there")
+	assert.Contains(t, got.Body, "Jax left to the north.")
+	assert.Contains(t, got.Body, "You can't do that.")
+
+	assert.Contains(t, logMsg(t, svc, sess.UID, "off"), "aren't logging")
+}
+
+func TestHandleLog_Unavailable(t *testing.T) {
+	svc, sess, store := newTranscriptTestServer(t)
+	svc.transcriptStore = nil
+	assert.Contains(t, logMsg(t, svc, sess.UID, "on"), "unavailable")
+	assert.False(t, sess.Transcript.Active())
+
+	svc.transcriptStore = &fakeTranscriptStore{err: errors.New("db down")}
+	logMsg(t, svc, sess.UID, "on")
+	assert.Contains(t, logMsg(t, svc, sess.UID, "off"), "could not be saved")
+	assert.False(t, sess.Transcript.Active())
+	assert.Empty(t, store.saved)
+}
+
+func TestCleanupPlayer_SavesActiveTranscript(t *testing.T) {
+	svc, sess, store := newTranscriptTestServer(t)
+	logMsg(t, svc, sess.UID, "on")
+	sess.Transcript.Write(sess.LoginAt, "You wave goodbye.")
+
+	svc.cleanupPlayer(sess.UID, sess.Username, sess.Entity)
+	require.Len(t, store.saved, 1)
+	assert.Contains(t, store.saved[0].Body, "You wave goodbye.")
+}
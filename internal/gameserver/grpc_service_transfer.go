@@ -0,0 +1,86 @@
+package gameserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// CharacterTransferer looks up characters and moves them between accounts.
+//
+// Precondition: name must be non-empty; id and toAccountID must be > 0.
+type CharacterTransferer interface {
+	GetIDByName(ctx context.Context, name string) (int64, error)
+	TransferToAccount(ctx context.Context, id, toAccountID int64, slots config.CharactersConfig) (int64, error)
+}
+
+// SetCharacterSlots configures the per-account character limits a transfer
+// must respect.
+//
+// Postcondition: Subsequent transfers refuse accounts with no free slot under cfg.
+func (s *GameServiceServer) SetCharacterSlots(cfg config.CharactersConfig) {
+	s.characterSlots = cfg
+}
+
+// handleTransferCharacter moves an offline character to another account.
+//
+// Precondition: uid must identify an active session authorized for
+// permission.CanTransferCharacters; req must be non-nil.
+// Postcondition: On success the character, with everything it owns, belongs
+// to the named account. An online character, an unknown character or
+// account, a character the account already owns, or an account with no free
+// character slot leaves everything unchanged and reports why.
+func (s *GameServiceServer) handleTransferCharacter(uid string, req *gamev1.TransferCharacterRequest) (*gamev1.ServerEvent, error) {
+	if _, ok := s.sessions.GetPlayer(uid); !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.accountAdmin == nil || s.characterTransferer == nil {
+		return errorEvent("Character transfers are not available."), nil
+	}
+	name, username := req.GetTarget(), req.GetUsername()
+	if name == "" || username == "" {
+		return errorEvent("usage: transfer <character> <username>"), nil
+	}
+	// An online character's session would save it back over the transfer.
+	if online := s.sessions.GetPlayerByCharNameCI(name); online != nil {
+		return errorEvent(fmt.Sprintf("%s is online; they must log out before the transfer.", online.CharName)), nil
+	}
+	ctx := s.commandCtx(uid)
+
+	target, err := s.accountAdmin.GetAccountByUsername(ctx, username)
+	if err != nil {
+		return errorEvent(fmt.Sprintf("account %q not found", username)), nil
+	}
+	charID, err := s.characterTransferer.GetIDByName(ctx, name)
+	if errors.Is(err, postgres.ErrCharacterNotFound) {
+		return errorEvent(fmt.Sprintf("No character named %q.", name)), nil
+	}
+	if err != nil {
+		s.logger.Warn("handleTransferCharacter: looking up character failed", zap.String("target", name), zap.Error(err))
+		return errorEvent("Failed to transfer character. Please try again."), nil
+	}
+
+	if _, err := s.characterTransferer.TransferToAccount(ctx, charID, target.ID, s.characterSlots); err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrTransferSameAccount):
+			return errorEvent(fmt.Sprintf("%s already belongs to %s.", name, target.Username)), nil
+		case errors.Is(err, postgres.ErrCharacterNotFound):
+			return errorEvent(fmt.Sprintf("No character named %q.", name)), nil
+		case errors.Is(err, postgres.ErrAccountNotFound):
+			return errorEvent(fmt.Sprintf("account %q not found", username)), nil
+		case errors.Is(err, postgres.ErrCharacterSlotsFull):
+			return errorEvent(fmt.Sprintf("%s has no free character slot.", target.Username)), nil
+		case errors.Is(err, postgres.ErrCharacterNameTaken):
+			return errorEvent(fmt.Sprintf("%s already has a character named %s.", target.Username, name)), nil
+		}
+		s.logger.Warn("handleTransferCharacter: transfer failed", zap.Int64("character_id", charID), zap.Error(err))
+		return errorEvent("Failed to transfer character. Please try again."), nil
+	}
+	return messageEvent(fmt.Sprintf("Transferred %s to account %s.", name, target.Username)), nil
+}
@@ -0,0 +1,136 @@
+package gameserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// fakeTransferer is an in-memory CharacterTransferer.
+type fakeTransferer struct {
+	names  map[int64]string
+	owners map[int64]int64
+}
+
+func (f *fakeTransferer) GetIDByName(_ context.Context, name string) (int64, error) {
+	for id, n := range f.names {
+		if strings.EqualFold(n, name) {
+			return id, nil
+		}
+	}
+	return 0, postgres.ErrCharacterNotFound
+}
+
+func (f *fakeTransferer) TransferToAccount(_ context.Context, id, toAccountID int64, slots config.CharactersConfig) (int64, error) {
+	from, ok := f.owners[id]
+	if !ok {
+		return 0, postgres.ErrCharacterNotFound
+	}
+	if from == toAccountID {
+		return 0, postgres.ErrTransferSameAccount
+	}
+	owned := 0
+	for _, owner := range f.owners {
+		if owner == toAccountID {
+			owned++
+		}
+	}
+	if limit := slots.SlotLimit("player", 0); limit > 0 && owned >= limit {
+		return 0, postgres.ErrCharacterSlotsFull
+	}
+	f.owners[id] = toAccountID
+	return from, nil
+}
+
+func newTransferTestService(t *testing.T) (*GameServiceServer, *fakeTransferer) {
+	t.Helper()
+	svc := testServiceForCombatDefault(t, nil)
+	svc.accountAdmin = &mockAccountAdmin{accounts: map[string]AccountInfo{
+		"kira": {ID: 9, Username: "kira", Role: "player"},
+	}}
+	transferer := &fakeTransferer{
+		names:  map[int64]string{2: "target", 3: "Offline", 4: "Kept"},
+		owners: map[int64]int64{2: 5, 3: 5, 4: 9},
+	}
+	svc.characterTransferer = transferer
+	admin := addPlayerForCombatDefault(t, svc, "admin", 1)
+	admin.Role = postgres.RoleAdmin
+	return svc, transferer
+}
+
+func TestHandleTransferCharacter_RequiresCapability(t *testing.T) {
+	svc, transferer := newTransferTestService(t)
+	addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.dispatch("target", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_TransferCharacter{TransferCharacter: &gamev1.TransferCharacterRequest{Target: "Offline", Username: "kira"}}})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "permission denied")
+	assert.Equal(t, int64(5), transferer.owners[3])
+}
+
+func TestHandleTransferCharacter_Offline(t *testing.T) {
+	svc, transferer := newTransferTestService(t)
+
+	evt, err := svc.handleTransferCharacter("admin", &gamev1.TransferCharacterRequest{Target: "offline", Username: "kira"})
+	require.NoError(t, err)
+	assert.Equal(t, "Transferred offline to account kira.", evt.GetMessage().GetContent())
+	assert.Equal(t, int64(9), transferer.owners[3])
+}
+
+func TestHandleTransferCharacter_RefusesOnlineCharacter(t *testing.T) {
+	svc, transferer := newTransferTestService(t)
+	addPlayerForCombatDefault(t, svc, "target", 2)
+
+	evt, err := svc.handleTransferCharacter("admin", &gamev1.TransferCharacterRequest{Target: "TARGET", Username: "kira"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "is online")
+	assert.Equal(t, int64(5), transferer.owners[2])
+}
+
+func TestHandleTransferCharacter_Rejects(t *testing.T) {
+	svc, _ := newTransferTestService(t)
+
+	for _, tc := range []struct {
+		req  *gamev1.TransferCharacterRequest
+		want string
+	}{
+		{&gamev1.TransferCharacterRequest{Target: "Offline", Username: "nobody"}, "not found"},
+		{&gamev1.TransferCharacterRequest{Target: "Ghost", Username: "kira"}, "No character"},
+		{&gamev1.TransferCharacterRequest{Target: "Kept", Username: "kira"}, "already belongs"},
+	} {
+		evt, err := svc.handleTransferCharacter("admin", tc.req)
+		require.NoError(t, err)
+		assert.Contains(t, evt.GetError().GetMessage(), tc.want, "%v", tc.req)
+	}
+}
+
+func TestHandleTransferCharacter_RespectsSlotLimit(t *testing.T) {
+	svc, transferer := newTransferTestService(t)
+	svc.SetCharacterSlots(config.CharactersConfig{MaxPerAccount: 1})
+
+	evt, err := svc.handleTransferCharacter("admin", &gamev1.TransferCharacterRequest{Target: "Offline", Username: "kira"})
+	require.NoError(t, err)
+	assert.Equal(t, "kira has no free character slot.", evt.GetError().GetMessage())
+	assert.Equal(t, int64(5), transferer.owners[3])
+
+	svc.SetCharacterSlots(config.CharactersConfig{MaxPerAccount: 2})
+	evt, err = svc.handleTransferCharacter("admin", &gamev1.TransferCharacterRequest{Target: "Offline", Username: "kira"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Transferred")
+}
+
+func TestHandleTransferCharacter_Unavailable(t *testing.T) {
+	svc := testServiceForCombatDefault(t, nil)
+	addPlayerForCombatDefault(t, svc, "admin", 1)
+
+	evt, err := svc.handleTransferCharacter("admin", &gamev1.TransferCharacterRequest{Target: "Offline", Username: "kira"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetError().GetMessage(), "not available")
+}
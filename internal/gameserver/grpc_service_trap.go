@@ -3,6 +3,7 @@ package gameserver
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/cory-johannsen/mud/internal/game/combat"
@@ -11,6 +12,7 @@ import (
 	"github.com/cory-johannsen/mud/internal/game/trap"
 	"github.com/cory-johannsen/mud/internal/game/world"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -35,6 +37,26 @@ func pushMessage(sess *session.PlayerSession, msg string) {
 	_ = sess.Entity.Push(data)
 }
 
+// InitTraps loads the trap templates in dir and arms every trap declared in
+// zone YAML. Procedural placement is not run.
+//
+// Precondition: Call once at startup, before players connect.
+// Postcondition: On success trap commands and triggers are live; on error the
+// server is unchanged.
+func (s *GameServiceServer) InitTraps(dir string) error {
+	templates, err := trap.LoadTrapTemplates(dir)
+	if err != nil {
+		return err
+	}
+	mgr := trap.NewTrapManager()
+	for _, zone := range s.world.AllZones() {
+		trap.PlaceStaticTraps(zone, mgr)
+	}
+	s.trapTemplates = templates
+	s.trapMgr = mgr
+	return nil
+}
+
 // checkEntryTraps fires all armed entry-trigger traps in room for the given player,
 // and performs Search-mode trap detection (REQ-TR-3/4/5/6/7).
 //
@@ -87,7 +109,9 @@ func (s *GameServiceServer) checkEntryTraps(uid string, sess *session.PlayerSess
 
 	// Search mode detection (REQ-TR-3/4/5/6/7).
 	if sess.ExploreMode == session.ExploreModeCaseIt {
-		s.detectTrapsInRoom(uid, sess, room, zone.ID, dangerLevel)
+		for _, notice := range s.detectTrapsInRoom(uid, sess, room, zone.ID, dangerLevel) {
+			pushMessage(sess, notice)
+		}
 	}
 }
 
@@ -128,11 +152,14 @@ func (s *GameServiceServer) fireTrap(uid string, sess *session.PlayerSession, tm
 			}
 			msg += fmt.Sprintf(" You are now %s!", result.ConditionID)
 		}
-		pushMessage(target, msg)
+		if msg = strings.TrimSpace(msg); msg != "" {
+			pushMessage(target, msg)
+		}
 	}
 
 	// Apply to triggering player.
 	applyToPlayer(sess, result.Narrative)
+	s.callTrapHook(uid, sess, tmpl, instanceID)
 	s.checkNonCombatDeath(uid, sess)
 	// REQ-AH-22: apply substance if trap payload has a substance_id.
 	if result.SubstanceID != "" {
@@ -158,19 +185,150 @@ func (s *GameServiceServer) fireTrap(uid string, sess *session.PlayerSession, tm
 	s.trapMgr.Disarm(instanceID) // sets Armed=false; one_shot stays in map but disarmed.
 }
 
-// detectTrapsInRoom rolls secret Perception checks for the player against each armed trap.
-// Uses TrapsForRoom to cover both room-level and equipment-level traps (REQ-TR-4).
+// callTrapHook runs a fired trap's Lua hook, if it has one, and shows the
+// victim any string the hook returns.
+//
+// Precondition: tmpl must be non-nil; sess must belong to uid.
+func (s *GameServiceServer) callTrapHook(uid string, sess *session.PlayerSession, tmpl *trap.TrapTemplate, instanceID string) {
+	if tmpl.Hook == "" || s.scriptMgr == nil {
+		return
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return
+	}
+	ret, err := s.scriptMgr.CallHook(room.ZoneID, tmpl.Hook,
+		lua.LString(uid), lua.LString(tmpl.ID), lua.LString(instanceID))
+	if err != nil {
+		s.logger.Warn("trap hook failed", zap.String("template", tmpl.ID), zap.Error(err))
+		return
+	}
+	if msg, ok := ret.(lua.LString); ok && msg != "" {
+		pushMessage(sess, string(msg))
+	}
+}
+
+// trapLocation describes where a trap instance sits, for detection notices.
+func trapLocation(instanceID string) string {
+	if _, desc, ok := strings.Cut(instanceID, "/equip/"); ok {
+		return desc
+	}
+	if _, dir, ok := strings.Cut(instanceID, "/"+trap.TrapKindExit+"/"); ok {
+		return "the way " + dir
+	}
+	return "the room"
+}
+
+// checkExitTrap fires an armed door trap on the exit in dir from the player's
+// room. Door traps go off when the door is used: walked through or worked on.
+//
+// Precondition: sess must belong to uid.
+// Postcondition: Returns true when a trap fired.
+func (s *GameServiceServer) checkExitTrap(uid string, sess *session.PlayerSession, dir world.Direction) bool {
+	if s.trapMgr == nil || s.trapTemplates == nil {
+		return false
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return false
+	}
+	exit, ok := room.ExitForDirection(dir)
+	if !ok {
+		return false
+	}
+	zone, ok := s.world.GetZone(room.ZoneID)
+	if !ok {
+		return false
+	}
+	instanceID := trap.TrapInstanceID(zone.ID, room.ID, trap.TrapKindExit, string(exit.Direction))
+	state, ok := s.trapMgr.GetTrap(instanceID)
+	if !ok || !state.Armed {
+		return false
+	}
+	tmpl, ok := s.trapTemplates[state.TemplateID]
+	if !ok {
+		return false
+	}
+	if tmpl.Trigger == trap.TriggerRegion && !isTrapTargeted(sess.Region, tmpl.TargetRegions) {
+		return false
+	}
+	dangerLevel := room.DangerLevel
+	if dangerLevel == "" {
+		dangerLevel = zone.DangerLevel
+	}
+	s.fireTrap(uid, sess, tmpl, instanceID, dangerLevel, false)
+	return true
+}
+
+// handleDetectTraps searches the player's room for traps: a Perception check
+// (d20 + Savvy) against each hidden trap's stealth DC, then a list of every
+// trap the player knows about here.
+//
+// Precondition: uid must identify a connected player.
+// Postcondition: Spotted traps are marked detected for uid.
+func (s *GameServiceServer) handleDetectTraps(uid string) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.trapMgr == nil {
+		return messageEvent("Traps are not active in this world."), nil
+	}
+	if sess.Status == statusInCombat {
+		return errorEvent("You're too busy fighting to search for traps."), nil
+	}
+	room, ok := s.world.GetRoom(sess.RoomID)
+	if !ok {
+		return nil, fmt.Errorf("room %q not found", sess.RoomID)
+	}
+	zone, ok := s.world.GetZone(room.ZoneID)
+	if !ok {
+		return nil, fmt.Errorf("zone for room %q not found", sess.RoomID)
+	}
+	dangerLevel := room.DangerLevel
+	if dangerLevel == "" {
+		dangerLevel = zone.DangerLevel
+	}
+
+	lines := []string{"You search carefully for traps."}
+	lines = append(lines, s.detectTrapsInRoom(uid, sess, room, zone.ID, dangerLevel)...)
+	var known []string
+	for _, instanceID := range s.trapMgr.TrapsForRoom(zone.ID, room.ID) {
+		state, ok := s.trapMgr.GetTrap(instanceID)
+		if !ok || !state.Armed || !s.trapMgr.IsDetected(uid, instanceID) {
+			continue
+		}
+		if tmpl, ok := s.trapTemplates[state.TemplateID]; ok {
+			known = append(known, fmt.Sprintf("  %s near %s", tmpl.Name, trapLocation(instanceID)))
+		}
+	}
+	if len(known) == 0 {
+		lines = append(lines, "You find no traps.")
+		return messageEvent(strings.Join(lines, "\n")), nil
+	}
+	sort.Strings(known)
+	lines = append(lines, "Known traps here:")
+	lines = append(lines, known...)
+	return messageEvent(strings.Join(lines, "\n")), nil
+}
+
+// detectTrapsInRoom rolls secret Perception checks for the player against each
+// armed trap they have not already found, and returns a notice for each one spotted.
+// Uses TrapsForRoom to cover room-level, equipment-level, and door traps (REQ-TR-4).
 // REQ-TR-3/4/5/6/7.
-func (s *GameServiceServer) detectTrapsInRoom(uid string, sess *session.PlayerSession, room *world.Room, zoneID, dangerLevel string) {
+//
+// Postcondition: Spotted traps are marked detected for uid.
+func (s *GameServiceServer) detectTrapsInRoom(uid string, sess *session.PlayerSession, room *world.Room, zoneID, dangerLevel string) []string {
 	if s.trapMgr == nil {
-		return
+		return nil
 	}
+	var notices []string
 	// Perception modifier: Savvy ability modifier.
 	perceptionMod := combat.AbilityMod(sess.Abilities.Savvy)
 	allInstanceIDs := s.trapMgr.TrapsForRoom(zoneID, room.ID)
 	for _, instanceID := range allInstanceIDs {
 		state, ok := s.trapMgr.GetTrap(instanceID)
-		if !ok || !state.Armed {
+		if !ok || !state.Armed || s.trapMgr.IsDetected(uid, instanceID) {
 			continue
 		}
 		tmpl, ok := s.trapTemplates[state.TemplateID]
@@ -196,17 +354,11 @@ func (s *GameServiceServer) detectTrapsInRoom(uid string, sess *session.PlayerSe
 		if total >= dc {
 			// REQ-TR-5: Mark detected, reveal name and location.
 			s.trapMgr.MarkDetected(uid, instanceID)
-			location := "the room"
-			if strings.Contains(instanceID, "/equip/") {
-				parts := strings.SplitN(instanceID, "/equip/", 2)
-				if len(parts) == 2 {
-					location = parts[1]
-				}
-			}
-			pushMessage(sess, fmt.Sprintf("You notice a %s near %s!", tmpl.Name, location))
+			notices = append(notices, fmt.Sprintf("You notice a %s near %s!", tmpl.Name, trapLocation(instanceID)))
 		}
 		// REQ-TR-6: On failure, no message.
 	}
+	return notices
 }
 
 // isTrapTargeted returns true if playerRegion is in targetRegions.
@@ -222,9 +374,12 @@ func isTrapTargeted(playerRegion string, targetRegions []string) bool {
 // fireConsumableTrapOnCombatant applies a consumable trap's payload to a single combat.Combatant.
 // Handles both player and NPC targets. Does NOT call trapMgr.Disarm — caller is responsible.
 //
-// Precondition: target, tmpl must be non-nil; instanceID and dangerLevel must be non-empty.
-// Postcondition: Damage applied and message sent; trap state unchanged (caller disarms).
+// Precondition: target, tmpl must be non-nil; instanceID and dangerLevel must be non-empty;
+// cbt may be nil (target's own damage matrix only).
+// Postcondition: Damage adjusted for the target's resistances and applied, message sent;
+// trap state unchanged (caller disarms).
 func (s *GameServiceServer) fireConsumableTrapOnCombatant(
+	cbt *combat.Combat,
 	target *combat.Combatant,
 	tmpl *trap.TrapTemplate,
 	instanceID, dangerLevel, roomID string,
@@ -244,6 +399,16 @@ func (s *GameServiceServer) fireConsumableTrapOnCombatant(
 			dmg = 0
 		}
 	}
+	dt := ""
+	if len(result.DamageTypes) > 0 {
+		dt = result.DamageTypes[0]
+	}
+	adjusted := combat.AdjustDamage(cbt, target, dt, dmg, "trap:"+tmpl.ID)
+	dmg = adjusted.Final
+	note := combat.AdjustmentNote(adjusted.Breakdown)
+	if note != "" {
+		note = " " + note
+	}
 	target.ApplyDamage(dmg)
 
 	// Player target: apply condition + substance + send personal message.
@@ -259,14 +424,14 @@ func (s *GameServiceServer) fireConsumableTrapOnCombatant(
 				s.logger.Warn("consumable trap ApplySubstanceByID failed", zap.Error(err))
 			}
 		}
-		pushMessage(sess, fmt.Sprintf("A %s triggers on you! (%d damage)", tmpl.Name, dmg))
+		pushMessage(sess, fmt.Sprintf("A %s triggers on you! (%d damage)%s", tmpl.Name, dmg, note))
 		return
 	}
 
 	// NPC target: broadcast to all players in the same room.
 	for _, p := range s.sessions.AllPlayers() {
 		if p.RoomID == roomID {
-			pushMessage(p, fmt.Sprintf("A %s catches %s! (%d damage)", tmpl.Name, target.Name, dmg))
+			pushMessage(p, fmt.Sprintf("A %s catches %s! (%d damage)%s", tmpl.Name, target.Name, dmg, note))
 		}
 	}
 }
@@ -309,6 +474,7 @@ func (s *GameServiceServer) checkConsumableTraps(roomID, movedCombatantID string
 		return
 	}
 	movedPos := mover.GridX * 5
+	cbt := s.combatH.ActiveCombatForRoom(roomID)
 
 	instanceIDs := s.trapMgr.TrapsForRoom(zone.ID, roomID)
 	for _, instanceID := range instanceIDs {
@@ -331,7 +497,7 @@ func (s *GameServiceServer) checkConsumableTraps(roomID, movedCombatantID string
 
 		// Trap fires. Multiple overlapping traps all fire independently.
 		if tmpl.BlastRadiusFt == 0 {
-			s.fireConsumableTrapOnCombatant(mover, tmpl, instanceID, dangerLevel, roomID)
+			s.fireConsumableTrapOnCombatant(cbt, mover, tmpl, instanceID, dangerLevel, roomID)
 		} else {
 			for _, c := range combatants {
 				d := c.GridX*5 - inst.DeployPosition
@@ -339,7 +505,7 @@ func (s *GameServiceServer) checkConsumableTraps(roomID, movedCombatantID string
 					d = -d
 				}
 				if d <= tmpl.BlastRadiusFt {
-					s.fireConsumableTrapOnCombatant(c, tmpl, instanceID, dangerLevel, roomID)
+					s.fireConsumableTrapOnCombatant(cbt, c, tmpl, instanceID, dangerLevel, roomID)
 				}
 			}
 		}
@@ -628,6 +794,26 @@ func (s *GameServiceServer) findDetectedTrap(uid string, sess *session.PlayerSes
 			return instanceID, tmpl
 		}
 	}
+	// Also search door traps and room-level traps, which are keyed by direction
+	// and by a generated ID rather than by anything in room.Traps.
+	for _, instanceID := range s.trapMgr.TrapsForRoom(zoneID, room.ID) {
+		if !strings.Contains(instanceID, "/"+trap.TrapKindExit+"/") && !strings.Contains(instanceID, "/room/") {
+			continue
+		}
+		inst, ok := s.trapMgr.GetTrap(instanceID)
+		if !ok || !s.trapMgr.IsDetected(uid, instanceID) {
+			continue
+		}
+		tmpl, ok := s.trapTemplates[inst.TemplateID]
+		if !ok {
+			continue
+		}
+		candidate := strings.ToLower(tmpl.Name)
+		withLocation := candidate + " near " + strings.ToLower(trapLocation(instanceID))
+		if lowerName == candidate || lowerName == withLocation {
+			return instanceID, tmpl
+		}
+	}
 	// Also search consumable traps armed via TrapManager (REQ-CTR-12).
 	for _, instanceID := range s.trapMgr.TrapsForRoom(zoneID, room.ID) {
 		inst, ok := s.trapMgr.GetTrap(instanceID)
@@ -0,0 +1,210 @@
+package gameserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/trap"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+// newDoorTrapTestServer builds a server with a needle trap on room_a's north
+// exit and a trapped strongbox in room_a. Dice always roll high.
+func newDoorTrapTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *trap.TrapManager) {
+	t.Helper()
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	svc.dice = dice.NewLoggedRoller(&fixedDiceSource{val: 19}, zaptest.NewLogger(t))
+
+	room, ok := worldMgr.GetRoom("room_a")
+	require.True(t, ok)
+	room.Equipment = []world.RoomEquipmentConfig{{ItemID: "strongbox", Description: "Strongbox", MaxCount: 1, Immovable: true}}
+	room.Traps = []world.RoomTrapConfig{
+		{TemplateID: "needle", Position: "exit:north"},
+		{TemplateID: "needle", Position: "Strongbox"},
+	}
+
+	mgr := trap.NewTrapManager()
+	zone, ok := worldMgr.GetZone("test")
+	require.True(t, ok)
+	trap.PlaceStaticTraps(zone, mgr)
+	svc.trapMgr = mgr
+	svc.trapTemplates = map[string]*trap.TrapTemplate{
+		"needle": {
+			ID: "needle", Name: "Needle Trap", Trigger: trap.TriggerInteraction, StealthDC: 15, DisableDC: 15,
+			Payload: &trap.TrapPayload{Type: "trip_wire", Message: "A needle jabs your hand!"},
+		},
+	}
+
+	invReg := inventory.NewRegistry()
+	require.NoError(t, invReg.RegisterItem(&inventory.ItemDef{ID: "strongbox", Name: "Strongbox", Kind: "junk", MaxStack: 1}))
+	svc.invRegistry = invReg
+	svc.roomEquipMgr = inventory.NewRoomEquipmentManager()
+	svc.roomEquipMgr.InitRoom("room_a", room.Equipment)
+
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "trap_u1", Username: "sneak", CharName: "Vex", RoomID: "room_a",
+		CurrentHP: 10, MaxHP: 10, Role: "player",
+	})
+	require.NoError(t, err)
+	return svc, sess, mgr
+}
+
+func trapEventText(evt *gamev1.ServerEvent) string {
+	if m := evt.GetMessage(); m != nil {
+		return m.Content
+	}
+	return evt.GetError().GetMessage()
+}
+
+func TestDoorTrap_FiresWhenWalkingThrough(t *testing.T) {
+	svc, sess, mgr := newDoorTrapTestServer(t)
+	doorID := trap.TrapInstanceID("test", "room_a", trap.TrapKindExit, "north")
+
+	_, err := svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Equal(t, "room_b", sess.RoomID)
+	assert.Contains(t, pushedText(t, sess), "A needle jabs your hand!")
+	state, _ := mgr.GetTrap(doorID)
+	assert.False(t, state.Armed)
+
+	_, err = svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "south"})
+	require.NoError(t, err)
+	assert.NotContains(t, pushedText(t, sess), "needle", "the way back is not trapped")
+}
+
+func TestContainerTrap_FiresWhenOpened(t *testing.T) {
+	svc, sess, mgr := newDoorTrapTestServer(t)
+
+	evt, err := svc.handleUseEquipment(sess.UID, "Strongbox")
+	require.NoError(t, err)
+	assert.Equal(t, "Nothing happens.", trapEventText(evt))
+	assert.Contains(t, pushedText(t, sess), "A needle jabs your hand!")
+	state, _ := mgr.GetTrap(trap.TrapInstanceID("test", "room_a", "equip", "Strongbox"))
+	assert.False(t, state.Armed)
+}
+
+func TestDetectTraps_FindsThenListsKnownTraps(t *testing.T) {
+	svc, sess, _ := newDoorTrapTestServer(t)
+
+	evt, err := svc.handleDetectTraps(sess.UID)
+	require.NoError(t, err)
+	msg := trapEventText(evt)
+	assert.Contains(t, msg, "You notice a Needle Trap near the way north!")
+	assert.Contains(t, msg, "You notice a Needle Trap near Strongbox!")
+	assert.Contains(t, msg, "Known traps here:")
+
+	evt, err = svc.handleDetectTraps(sess.UID)
+	require.NoError(t, err)
+	msg = trapEventText(evt)
+	assert.NotContains(t, msg, "You notice", "known traps are not found twice")
+	assert.Contains(t, msg, "Needle Trap near the way north")
+}
+
+func TestDetectTraps_NothingFound(t *testing.T) {
+	svc, sess, _ := newDoorTrapTestServer(t)
+	svc.trapTemplates["needle"].StealthDC = 40
+
+	evt, err := svc.handleDetectTraps(sess.UID)
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "You find no traps.")
+
+	sess.Status = statusInCombat
+	evt, err = svc.handleDetectTraps(sess.UID)
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "too busy fighting")
+}
+
+func TestDisarm_OutOfCombatDisarmsDetectedDoorTrap(t *testing.T) {
+	svc, sess, mgr := newDoorTrapTestServer(t)
+	doorID := trap.TrapInstanceID("test", "room_a", trap.TrapKindExit, "north")
+
+	evt, err := svc.handleDisarm(sess.UID, &gamev1.DisarmRequest{Target: "needle trap"})
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "don't see any trap")
+
+	mgr.MarkDetected(sess.UID, doorID)
+	evt, err = svc.handleDisarm(sess.UID, &gamev1.DisarmRequest{Target: "needle trap near the way north"})
+	require.NoError(t, err)
+	assert.Contains(t, trapEventText(evt), "successfully disarm the Needle Trap")
+	state, _ := mgr.GetTrap(doorID)
+	assert.False(t, state.Armed)
+
+	_, err = svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.NotContains(t, pushedText(t, sess), "needle")
+}
+
+func TestDoorTrap_FiresWhenPickingItsLock(t *testing.T) {
+	svc, sess, mgr := newDoorTrapTestServer(t)
+	room, _ := svc.world.GetRoom("room_a")
+	room.Exits[0].Locked = true
+	room.Exits[0].LockDC = 10
+	require.NoError(t, svc.invRegistry.RegisterItem(&inventory.ItemDef{
+		ID: "lockpick", Name: "Lockpick", Kind: "junk", MaxStack: 1, Tags: []string{"lockpick"},
+	}))
+	_, err := sess.Backpack.Add("lockpick", 1, svc.invRegistry)
+	require.NoError(t, err)
+
+	pickMsg(t, svc, sess.UID, "start", "north")
+	assert.Contains(t, pushedText(t, sess), "A needle jabs your hand!")
+	assert.NotNil(t, sess.Lockpick, "a nonlethal trap doesn't stop the work")
+	state, _ := mgr.GetTrap(trap.TrapInstanceID("test", "room_a", trap.TrapKindExit, "north"))
+	assert.False(t, state.Armed)
+}
+
+func TestTrapHook_RunsLuaEffect(t *testing.T) {
+	svc, sess, _ := newDoorTrapTestServer(t)
+	logger := zaptest.NewLogger(t)
+	scriptMgr := scripting.NewManager(dice.NewLoggedRoller(&fixedDiceSource{val: 10}, logger), logger)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "traps.lua"), []byte(`
+function on_glyph(uid, template_id, instance_id)
+  return "The glyph flares under " .. uid .. " (" .. template_id .. ")."
+end
+`), 0644))
+	require.NoError(t, scriptMgr.LoadZone("test", dir, 0))
+	svc.scriptMgr = scriptMgr
+	svc.trapTemplates["needle"] = &trap.TrapTemplate{ID: "glyph", Name: "Glyph", Trigger: trap.TriggerInteraction, Hook: "on_glyph"}
+
+	_, err := svc.handleMove(sess.UID, &gamev1.MoveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Contains(t, pushedText(t, sess), "The glyph flares under trap_u1 (glyph).")
+}
+
+func TestInitTraps_ArmsStaticTraps(t *testing.T) {
+	worldMgr, sessMgr := testWorldAndSession(t)
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npc.NewManager())
+	room, _ := worldMgr.GetRoom("room_b")
+	room.Traps = []world.RoomTrapConfig{{TemplateID: "needle", Position: "exit:south"}}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "needle.yaml"), []byte(`
+id: needle
+name: Needle Trap
+trigger: interaction
+payload:
+  type: trip_wire
+stealth_dc: 15
+disable_dc: 15
+reset_mode: one_shot
+`), 0644))
+
+	require.NoError(t, svc.InitTraps(dir))
+	require.Contains(t, svc.trapTemplates, "needle")
+	state, ok := svc.trapMgr.GetTrap(trap.TrapInstanceID("test", "room_b", trap.TrapKindExit, "south"))
+	require.True(t, ok)
+	assert.True(t, state.Armed)
+
+	assert.Error(t, svc.InitTraps(filepath.Join(dir, "missing")))
+}
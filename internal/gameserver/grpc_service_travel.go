@@ -1,11 +1,12 @@
 package gameserver
 
 import (
-	"context"
 	"fmt"
 	"time"
 
 	lua "github.com/yuin/gopher-lua"
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+	"github.com/cory-johannsen/mud/internal/game/stats"
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -133,11 +134,12 @@ func (s *GameServiceServer) handleTravel(uid string, req *gamev1.TravelRequest)
 	if sess.ExploredCache[destRoom.ZoneID] == nil {
 		sess.ExploredCache[destRoom.ZoneID] = make(map[string]bool)
 	}
+	firstVisit := !sess.ExploredCache[destRoom.ZoneID][destRoom.ID]
 	if !sess.AutomapCache[destRoom.ZoneID][destRoom.ID] {
 		sess.AutomapCache[destRoom.ZoneID][destRoom.ID] = true
 		sess.ExploredCache[destRoom.ZoneID][destRoom.ID] = true
 		if s.automapRepo != nil {
-			if err := s.automapRepo.Insert(context.Background(), sess.CharacterID, destRoom.ZoneID, destRoom.ID, true); err != nil {
+			if err := s.automapRepo.Insert(s.commandCtx(uid), sess.CharacterID, destRoom.ZoneID, destRoom.ID, true); err != nil {
 				s.logger.Warn("persisting travel room discovery", zap.Error(err))
 			}
 		}
@@ -145,11 +147,15 @@ func (s *GameServiceServer) handleTravel(uid string, req *gamev1.TravelRequest)
 		// Room was previously revealed via map item but not physically visited yet.
 		sess.ExploredCache[destRoom.ZoneID][destRoom.ID] = true
 		if s.automapRepo != nil {
-			if err := s.automapRepo.Insert(context.Background(), sess.CharacterID, destRoom.ZoneID, destRoom.ID, true); err != nil {
+			if err := s.automapRepo.Insert(s.commandCtx(uid), sess.CharacterID, destRoom.ZoneID, destRoom.ID, true); err != nil {
 				s.logger.Warn("persisting travel room exploration", zap.Error(err))
 			}
 		}
 	}
+	if firstVisit {
+		s.tallyStat(sess, stats.Explored, 1)
+		s.recordAchievement(sess, achievement.Event{Kind: achievement.KindExplore, Target: destRoom.ZoneID})
+	}
 
 	// Apply room effects, skill checks, entry traps, wanted-level guard check.
 	s.applyRoomEffectsOnEntry(sess, uid, destRoom, time.Now().Unix())
@@ -172,6 +178,7 @@ func (s *GameServiceServer) handleTravel(uid string, req *gamev1.TravelRequest)
 			s.combatH.InitiateGuardCombat(uid, destRoom.ZoneID, wantedLevel)
 		}
 	}
+	s.exposeToRoomEffects(uid, sess)
 	s.notifyCombatJoinIfEligible(sess, destRoom.ID)
 	s.clearNegotiateState(sess)
 
@@ -0,0 +1,211 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// TutorialStore records that a character has finished or skipped the tutorial.
+//
+// Precondition: characterID must be > 0.
+type TutorialStore interface {
+	MarkTutorialComplete(ctx context.Context, characterID int64) error
+}
+
+// tutorialSpawn decides whether a joining character starts in the tutorial.
+//
+// Precondition: spawn must be non-nil; dbChar may be nil.
+// Postcondition: Returns the tutorial start room, the room the player moves to
+// when the tutorial ends, and true when the character has not yet completed the
+// tutorial and a tutorial is configured; otherwise returns (nil, "", false).
+// The return room is spawn, unless spawn is itself inside the tutorial zone (a
+// reconnect mid-tutorial), in which case the team home room or global start
+// room is used.
+func (s *GameServiceServer) tutorialSpawn(dbChar *character.Character, spawn *world.Room, team string) (*world.Room, string, bool) {
+	if s.tutorialDef == nil || dbChar == nil || dbChar.TutorialComplete {
+		return nil, "", false
+	}
+	start, ok := s.world.GetRoom(s.tutorialDef.StartRoom)
+	if !ok {
+		return nil, "", false
+	}
+	if spawn.ZoneID != start.ZoneID {
+		return start, spawn.ID, true
+	}
+	if homeRoomID, ok := teamHomeRooms[team]; ok {
+		if _, ok := s.world.GetRoom(homeRoomID); ok {
+			return start, homeRoomID, true
+		}
+	}
+	returnRoom := ""
+	if r := s.world.StartRoom(); r != nil {
+		returnRoom = r.ID
+	}
+	return start, returnRoom, true
+}
+
+// tutorialHint formats the hint for the player's current objective.
+//
+// Precondition: tr must be non-nil.
+// Postcondition: Returns "" when no objective remains.
+func tutorialHint(tr *tutorial.Tracker) string {
+	cur, ok := tr.Current()
+	if !ok {
+		return ""
+	}
+	done, total := tr.Progress()
+	return fmt.Sprintf("Tutorial (%d/%d): %s", done+1, total, cur.Hint)
+}
+
+// advanceTutorial reports a player action to the player's tutorial tracker.
+//
+// Precondition: uid may identify any session; sessions outside the tutorial are ignored.
+// Postcondition: When kind completes the current objective the player is sent
+// its completion text and the next hint; completing the last objective ends the
+// tutorial via finishTutorial.
+func (s *GameServiceServer) advanceTutorial(uid string, kind tutorial.Kind) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok || sess.Tutorial == nil {
+		return
+	}
+	obj, ok := sess.Tutorial.Record(kind)
+	if !ok {
+		return
+	}
+	if obj.Done != "" {
+		s.pushMessageToUID(uid, obj.Done)
+	}
+	if hint := tutorialHint(sess.Tutorial); hint != "" {
+		s.pushMessageToUID(uid, hint)
+		return
+	}
+	s.finishTutorial(uid, sess, false)
+}
+
+// finishTutorial ends the tutorial and sends the player on to their return room.
+//
+// Precondition: sess must be the live session for uid.
+// Postcondition: The first call for a tracker marks the character's tutorial
+// complete (persisted when a TutorialStore is configured), sends the outro when
+// the tutorial was completed rather than skipped, and relocates the player.
+// Later calls, and calls for sessions outside the tutorial, do nothing.
+func (s *GameServiceServer) finishTutorial(uid string, sess *session.PlayerSession, skipped bool) {
+	tr := sess.Tutorial
+	if tr == nil || !tr.Finish() {
+		return
+	}
+	if s.tutorialStore != nil && sess.CharacterID > 0 {
+		if err := s.tutorialStore.MarkTutorialComplete(s.commandCtx(uid), sess.CharacterID); err != nil {
+			s.logger.Warn("persisting tutorial completion",
+				zap.String("uid", uid),
+				zap.Error(err),
+			)
+		}
+	}
+	if !skipped && tr.Definition().Outro != "" {
+		s.pushMessageToUID(uid, tr.Definition().Outro)
+	}
+	dest, ok := s.world.GetRoom(tr.ReturnRoom)
+	if !ok {
+		dest = s.world.StartRoom()
+	}
+	if dest == nil || dest.ID == sess.RoomID {
+		return
+	}
+	s.leaveTutorial(uid, sess, dest)
+}
+
+// leaveTutorial moves the player out of the tutorial zone into dest.
+//
+// Precondition: sess must be the live session for uid; dest must be non-nil.
+// Postcondition: The player is in dest, the new location is persisted, room
+// departure/arrival is broadcast, zone hooks fire, and the player is sent the
+// new room view.
+func (s *GameServiceServer) leaveTutorial(uid string, sess *session.PlayerSession, dest *world.Room) {
+	if s.combatH != nil {
+		s.combatH.RemovePlayerFromCombat(uid, sess.RoomID)
+	}
+	oldRoomID, err := s.sessions.MovePlayer(uid, dest.ID)
+	if err != nil {
+		s.logger.Warn("moving player out of tutorial", zap.String("uid", uid), zap.Error(err))
+		return
+	}
+	if sess.CharacterID > 0 && s.charSaver != nil {
+		ctx := s.commandCtx(uid)
+		if err := s.charSaver.SaveState(ctx, sess.CharacterID, dest.ID, sess.CurrentHP); err != nil {
+			s.logger.Warn("persisting location after tutorial",
+				zap.String("uid", uid),
+				zap.Error(err),
+			)
+		}
+	}
+
+	s.broadcastRoomEvent(oldRoomID, uid, &gamev1.RoomEvent{
+		Player: sess.CharName,
+		Type:   gamev1.RoomEventType_ROOM_EVENT_TYPE_DEPART,
+	})
+	s.broadcastRoomEvent(dest.ID, uid, &gamev1.RoomEvent{
+		Player: sess.CharName,
+		Type:   gamev1.RoomEventType_ROOM_EVENT_TYPE_ARRIVE,
+	})
+	s.triggerPassiveTechsForRoom(dest.ID)
+
+	if s.scriptMgr != nil {
+		if oldRoom, ok := s.world.GetRoom(oldRoomID); ok {
+			s.scriptMgr.CallHook(oldRoom.ZoneID, "on_exit", //nolint:errcheck
+				lua.LString(uid),
+				lua.LString(oldRoomID),
+				lua.LString(dest.ID),
+			)
+		}
+		s.scriptMgr.CallHook(dest.ZoneID, "on_enter", //nolint:errcheck
+			lua.LString(uid),
+			lua.LString(dest.ID),
+			lua.LString(oldRoomID),
+		)
+	}
+
+	if s.worldH == nil || sess.Entity == nil {
+		return
+	}
+	view := &gamev1.ServerEvent{
+		Payload: &gamev1.ServerEvent_RoomView{RoomView: s.worldH.buildRoomView(uid, dest)},
+	}
+	if data, err := proto.Marshal(view); err == nil {
+		_ = sess.Entity.Push(data)
+	}
+}
+
+// handleTutorial shows tutorial progress or, with req.Skip, leaves the tutorial.
+//
+// Precondition: uid must identify an active session; req must be non-nil.
+// Postcondition: Outside the tutorial, reports that there is nothing to do.
+// Skipping is refused in combat; otherwise the tutorial is marked complete and
+// the player is sent on to their return room.
+func (s *GameServiceServer) handleTutorial(uid string, req *gamev1.TutorialRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if sess.Tutorial == nil || sess.Tutorial.Finished() {
+		return messageEvent("You are not in the tutorial."), nil
+	}
+	if !req.GetSkip() {
+		return messageEvent(tutorialHint(sess.Tutorial) + "\nType \"tutorial skip\" to leave the tutorial."), nil
+	}
+	if sess.Status == statusInCombat {
+		return messageEvent("You can't leave the tutorial in the middle of a fight."), nil
+	}
+	s.finishTutorial(uid, sess, true)
+	return messageEvent("You skip the rest of the tutorial."), nil
+}
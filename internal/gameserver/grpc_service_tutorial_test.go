@@ -0,0 +1,153 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/tutorial"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+type recordingTutorialStore struct {
+	marked []int64
+}
+
+func (r *recordingTutorialStore) MarkTutorialComplete(_ context.Context, characterID int64) error {
+	r.marked = append(r.marked, characterID)
+	return nil
+}
+
+// newTutorialTestServer builds a server whose world has a tutorial zone and a
+// city zone, with the player "uid1" standing in the tutorial.
+func newTutorialTestServer(t *testing.T) (*GameServiceServer, *session.PlayerSession, *recordingTutorialStore) {
+	t.Helper()
+	tutRoom := &world.Room{ID: "tut_start", ZoneID: "tut", Title: "Briefing", Description: "D"}
+	cityRoom := &world.Room{ID: "city_start", ZoneID: "city", Title: "City", Description: "D"}
+	wMgr, err := world.NewManager([]*world.Zone{
+		{ID: "city", Name: "City", StartRoom: "city_start", Rooms: map[string]*world.Room{"city_start": cityRoom}},
+		{ID: "tut", Name: "Tutorial", StartRoom: "tut_start", Rooms: map[string]*world.Room{"tut_start": tutRoom}},
+	})
+	require.NoError(t, err)
+	def := &tutorial.Definition{
+		StartRoom: "tut_start",
+		Outro:     "Graduated.",
+		Objectives: []tutorial.Objective{
+			{Kind: tutorial.KindLook, Hint: "Look around.", Done: "Looked."},
+			{Kind: tutorial.KindEquip, Hint: "Equip something."},
+		},
+	}
+	sMgr := session.NewManager()
+	_, err = sMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "uid1", Username: "u", CharName: "Rookie", CharacterID: 7,
+		RoomID: "tut_start", CurrentHP: 10, MaxHP: 10,
+		Abilities: character.AbilityScores{}, Role: "player",
+		RegionDisplayName: "test", Class: "Gunner", Level: 1,
+	})
+	require.NoError(t, err)
+	sess, _ := sMgr.GetPlayer("uid1")
+	sess.Tutorial = tutorial.NewTracker(def, "city_start")
+	store := &recordingTutorialStore{}
+	s := &GameServiceServer{
+		sessions:      sMgr,
+		world:         wMgr,
+		logger:        zap.NewNop(),
+		tutorialDef:   def,
+		tutorialStore: store,
+	}
+	return s, sess, store
+}
+
+func TestTutorialSpawn(t *testing.T) {
+	s, _, _ := newTutorialTestServer(t)
+	city, _ := s.world.GetRoom("city_start")
+	tut, _ := s.world.GetRoom("tut_start")
+
+	room, ret, ok := s.tutorialSpawn(&character.Character{}, city, "")
+	require.True(t, ok)
+	assert.Equal(t, "tut_start", room.ID)
+	assert.Equal(t, "city_start", ret, "new characters return to where they would have spawned")
+
+	_, ret, ok = s.tutorialSpawn(&character.Character{}, tut, "")
+	require.True(t, ok)
+	assert.Equal(t, "city_start", ret, "reconnecting mid-tutorial falls back to the start room")
+
+	_, _, ok = s.tutorialSpawn(&character.Character{TutorialComplete: true}, city, "")
+	assert.False(t, ok)
+	_, _, ok = s.tutorialSpawn(nil, city, "")
+	assert.False(t, ok)
+	s.tutorialDef = nil
+	_, _, ok = s.tutorialSpawn(&character.Character{}, city, "")
+	assert.False(t, ok)
+}
+
+func TestAdvanceTutorial_CompletesAndRelocates(t *testing.T) {
+	s, sess, store := newTutorialTestServer(t)
+
+	s.advanceTutorial("uid1", tutorial.KindEquip)
+	assert.Equal(t, "tut_start", sess.RoomID, "out-of-order actions are ignored")
+
+	s.advanceTutorial("uid1", tutorial.KindLook)
+	msgs := drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond)
+	assert.Equal(t, []string{"Looked.", "Tutorial (2/2): Equip something."}, msgs)
+
+	s.advanceTutorial("uid1", tutorial.KindEquip)
+	assert.Equal(t, "city_start", sess.RoomID)
+	assert.Equal(t, []int64{7}, store.marked)
+	assert.Contains(t, drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond), "Graduated.")
+	assert.True(t, sess.Tutorial.Finished())
+}
+
+func TestHandleTutorial_Progress(t *testing.T) {
+	s, _, _ := newTutorialTestServer(t)
+	evt, err := s.handleTutorial("uid1", &gamev1.TutorialRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "Tutorial (1/2): Look around.")
+}
+
+func TestHandleTutorial_Skip(t *testing.T) {
+	s, sess, store := newTutorialTestServer(t)
+
+	sess.Status = statusInCombat
+	evt, err := s.handleTutorial("uid1", &gamev1.TutorialRequest{Skip: true})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "middle of a fight")
+	assert.Equal(t, "tut_start", sess.RoomID)
+
+	sess.Status = 1
+	evt, err = s.handleTutorial("uid1", &gamev1.TutorialRequest{Skip: true})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "skip")
+	assert.Equal(t, "city_start", sess.RoomID)
+	assert.Equal(t, []int64{7}, store.marked)
+	assert.NotContains(t, drainAllEntityEvents(t, sess.Entity.Events(), 50*time.Millisecond), "Graduated.",
+		"skipping does not show the outro")
+
+	evt, err = s.handleTutorial("uid1", &gamev1.TutorialRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You are not in the tutorial.", evt.GetMessage().GetContent())
+}
+
+func TestProperty_FinishTutorial_PersistsOnce(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		s, sess, store := newTutorialTestServer(t)
+		calls := rapid.IntRange(1, 5).Draw(rt, "calls")
+		for i := 0; i < calls; i++ {
+			s.finishTutorial("uid1", sess, rapid.Bool().Draw(rt, "skipped"))
+		}
+		if len(store.marked) != 1 {
+			rt.Fatalf("completion persisted %d times, want 1", len(store.marked))
+		}
+		if sess.RoomID != "city_start" {
+			rt.Fatalf("player in %q, want city_start", sess.RoomID)
+		}
+	})
+}
@@ -0,0 +1,339 @@
+package gameserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/vehicle"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// vehicleFuelTag is the item tag that marks a backpack item as vehicle fuel.
+const vehicleFuelTag = "vehicle_fuel"
+
+// fuelPerCan is how much fuel one vehicle_fuel item pours into a tank.
+const fuelPerCan = 10
+
+// SetVehicles installs the vehicle manager used by board, drive, and refuel,
+// and shows its vehicles in room views.
+//
+// Precondition: mgr may be nil to disable vehicles.
+// Postcondition: Vehicle commands and room views use mgr.
+func (s *GameServiceServer) SetVehicles(mgr *vehicle.Manager) {
+	s.vehicles = mgr
+	s.worldH.SetVehicles(mgr)
+}
+
+// riding reports whether uid is aboard a vehicle.
+func (s *GameServiceServer) riding(uid string) bool {
+	if s.vehicles == nil {
+		return false
+	}
+	_, ok := s.vehicles.Aboard(uid)
+	return ok
+}
+
+// vehicleVerb is the travel verb for a vehicle's kind.
+func vehicleVerb(v *vehicle.Instance) string {
+	if v.Kind == vehicle.KindBoat {
+		return "sail"
+	}
+	return "drive"
+}
+
+// vehicleRouteBlocked reports why v cannot travel through exit into dest.
+// Cars need an exit flagged as a road; boats need a water room at the other end.
+//
+// Precondition: v and dest must be non-nil.
+// Postcondition: Returns "" when the route is passable.
+func vehicleRouteBlocked(v *vehicle.Instance, exit world.Exit, dest *world.Room) string {
+	if exit.Locked {
+		return fmt.Sprintf("The way %s is blocked.", exit.Direction)
+	}
+	if v.Kind == vehicle.KindBoat {
+		if rule, ok := world.TerrainRuleFor(dest.Terrain); !ok || !rule.Water {
+			return fmt.Sprintf("The %s can't leave the water.", v.Name)
+		}
+		return ""
+	}
+	if !exit.Road {
+		return fmt.Sprintf("There's no road %s.", exit.Direction)
+	}
+	return ""
+}
+
+// handleBoard puts the player aboard a vehicle in their room. The first
+// player aboard takes the driver's seat.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: On success the player is aboard and the room sees the new passenger count.
+func (s *GameServiceServer) handleBoard(uid string, req *gamev1.BoardRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.vehicles == nil {
+		return messageEvent("There are no vehicles here."), nil
+	}
+	if sess.Status == statusInCombat {
+		return messageEvent("You can't climb aboard in the middle of a fight."), nil
+	}
+	found, ok := s.vehicles.FindInRoom(sess.RoomID, req.GetTarget())
+	if !ok {
+		return messageEvent("You don't see that vehicle here."), nil
+	}
+	v, err := s.vehicles.Board(found.ID, uid)
+	switch {
+	case errors.Is(err, vehicle.ErrAboard):
+		return messageEvent("You're already aboard a vehicle. Disembark first."), nil
+	case errors.Is(err, vehicle.ErrWrecked):
+		return messageEvent(fmt.Sprintf("The %s is a burnt-out wreck.", found.Name)), nil
+	case errors.Is(err, vehicle.ErrFull):
+		return messageEvent(fmt.Sprintf("There's no room left in the %s.", found.Name)), nil
+	case err != nil:
+		return nil, err
+	}
+	s.broadcastMessage(sess.RoomID, uid, &gamev1.MessageEvent{
+		Content: fmt.Sprintf("%s climbs into the %s.", sess.CharName, v.Name),
+	})
+	s.pushRoomViewToAllInRoom(sess.RoomID)
+	if v.Driver == uid {
+		return messageEvent(fmt.Sprintf("You climb into the driver's seat of the %s (fuel %d/%d).", v.Name, v.Fuel, v.MaxFuel)), nil
+	}
+	return messageEvent(fmt.Sprintf("You climb into the %s.", v.Name)), nil
+}
+
+// handleDisembark takes the player out of their vehicle. If they were
+// driving, the next passenger takes the wheel.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: The player is no longer aboard any vehicle.
+func (s *GameServiceServer) handleDisembark(uid string, _ *gamev1.DisembarkRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.vehicles == nil {
+		return messageEvent("You aren't riding anything."), nil
+	}
+	v, ok := s.disembark(uid, sess)
+	if !ok {
+		return messageEvent("You aren't riding anything."), nil
+	}
+	s.pushRoomViewToAllInRoom(sess.RoomID)
+	return messageEvent(fmt.Sprintf("You climb out of the %s.", v.Name)), nil
+}
+
+// disembark removes uid from their vehicle, tells the room, and hands the
+// wheel to the next passenger when the driver leaves.
+//
+// Precondition: s.vehicles must be non-nil; sess must belong to uid.
+// Postcondition: Returns the vehicle left and true, or (nil, false) when uid was not aboard.
+func (s *GameServiceServer) disembark(uid string, sess *session.PlayerSession) (*vehicle.Instance, bool) {
+	before, ok := s.vehicles.Aboard(uid)
+	if !ok {
+		return nil, false
+	}
+	v, err := s.vehicles.Disembark(uid)
+	if err != nil {
+		return nil, false
+	}
+	s.broadcastMessage(sess.RoomID, uid, &gamev1.MessageEvent{
+		Content: fmt.Sprintf("%s climbs out of the %s.", sess.CharName, v.Name),
+	})
+	if before.Driver == uid && v.Driver != "" {
+		s.pushMessageToUID(v.Driver, fmt.Sprintf("You slide into the driver's seat of the %s.", v.Name))
+	}
+	return v, true
+}
+
+// handleDrive moves the player's vehicle one room in the requested
+// direction, carrying every passenger with it.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: On success the vehicle and all passengers are in the
+// destination room, one unit of fuel is spent, and both rooms are told.
+func (s *GameServiceServer) handleDrive(uid string, req *gamev1.DriveRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.vehicles == nil {
+		return messageEvent("You aren't driving anything."), nil
+	}
+	v, ok := s.vehicles.Aboard(uid)
+	if !ok {
+		return messageEvent("You aren't driving anything."), nil
+	}
+	verb := vehicleVerb(v)
+	if v.Driver != uid {
+		return messageEvent(fmt.Sprintf("Only the driver can %s the %s.", verb, v.Name)), nil
+	}
+	for _, p := range v.Passengers {
+		if ps, ok := s.sessions.GetPlayer(p); ok && ps.Status == statusInCombat {
+			return messageEvent(fmt.Sprintf("You can't %s off in the middle of a fight.", verb)), nil
+		}
+	}
+	room, ok := s.world.GetRoom(v.RoomID)
+	if !ok {
+		return nil, fmt.Errorf("vehicle %q is in unknown room %q", v.ID, v.RoomID)
+	}
+	dir := world.Direction(req.GetDirection())
+	exit, ok := room.ExitForDirection(dir)
+	if !ok || exit.Hidden {
+		return messageEvent(fmt.Sprintf("You can't %s that way.", verb)), nil
+	}
+	dest, ok := s.world.GetRoom(exit.TargetRoom)
+	if !ok {
+		return messageEvent(fmt.Sprintf("You can't %s that way.", verb)), nil
+	}
+	if msg := vehicleRouteBlocked(v, exit, dest); msg != "" {
+		return messageEvent(msg), nil
+	}
+	if !s.canEnterHome(sess, dest.ID) {
+		return messageEvent("The door is locked."), nil
+	}
+
+	moved, err := s.vehicles.Drive(uid, dest.ID)
+	switch {
+	case errors.Is(err, vehicle.ErrNoFuel):
+		return messageEvent(fmt.Sprintf("The %s coughs and dies. It's out of fuel.", v.Name)), nil
+	case errors.Is(err, vehicle.ErrWrecked):
+		return messageEvent(fmt.Sprintf("The %s is a burnt-out wreck.", v.Name)), nil
+	case err != nil:
+		return nil, err
+	}
+
+	for _, p := range moved.Passengers {
+		if _, err := s.sessions.MovePlayer(p, dest.ID); err != nil {
+			return nil, fmt.Errorf("moving passenger %q: %w", p, err)
+		}
+		if p != uid {
+			s.pushMessageToUID(p, fmt.Sprintf("The %s heads %s.", moved.Name, dir))
+		}
+	}
+	s.broadcastMessage(room.ID, "", &gamev1.MessageEvent{
+		Content: fmt.Sprintf("The %s heads off %s.", moved.Name, dir),
+	})
+	aboard := make(map[string]bool, len(moved.Passengers))
+	for _, p := range moved.Passengers {
+		aboard[p] = true
+	}
+	s.broadcastToRoomFiltered(dest.ID, "", messageEvent(fmt.Sprintf("A %s arrives from the %s.", moved.Name, dir.Opposite())),
+		func(ps *session.PlayerSession) bool { return aboard[ps.UID] })
+	s.pushRoomViewToAllInRoom(room.ID)
+	s.pushRoomViewToAllInRoom(dest.ID)
+	return messageEvent(fmt.Sprintf("You %s %s (fuel %d/%d).", verb, dir, moved.Fuel, moved.MaxFuel)), nil
+}
+
+// handleRefuel pours one vehicle_fuel item from the player's backpack into
+// the named vehicle, or the one they are riding when no target is given.
+//
+// Precondition: uid must identify an existing player session.
+// Postcondition: On success one fuel item is consumed and the tank gains up to fuelPerCan.
+func (s *GameServiceServer) handleRefuel(uid string, req *gamev1.RefuelRequest) (*gamev1.ServerEvent, error) {
+	sess, ok := s.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, fmt.Errorf("player %q not found", uid)
+	}
+	if s.vehicles == nil {
+		return messageEvent("There are no vehicles here."), nil
+	}
+	var v *vehicle.Instance
+	if req.GetTarget() == "" {
+		v, ok = s.vehicles.Aboard(uid)
+		if !ok {
+			return messageEvent("Refuel what?"), nil
+		}
+	} else if v, ok = s.vehicles.FindInRoom(sess.RoomID, req.GetTarget()); !ok {
+		return messageEvent("You don't see that vehicle here."), nil
+	}
+	if v.Fuel >= v.MaxFuel {
+		return messageEvent(fmt.Sprintf("The %s's tank is already full.", v.Name)), nil
+	}
+
+	var canID, canName string
+	if s.invRegistry != nil && sess.Backpack != nil {
+		for _, it := range sess.Backpack.Items() {
+			if def, ok := s.invRegistry.Item(it.ItemDefID); ok && def.HasTag(vehicleFuelTag) {
+				canID, canName = it.InstanceID, def.Name
+				break
+			}
+		}
+	}
+	if canID == "" {
+		return messageEvent("You have no fuel to pour."), nil
+	}
+	refuelled, err := s.vehicles.Refuel(v.ID, fuelPerCan)
+	if errors.Is(err, vehicle.ErrWrecked) {
+		return messageEvent(fmt.Sprintf("The %s is a burnt-out wreck.", v.Name)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := sess.Backpack.Remove(canID, 1); err != nil {
+		return nil, fmt.Errorf("consuming fuel: %w", err)
+	}
+	return messageEvent(fmt.Sprintf("You empty your %s into the %s (fuel %d/%d).", canName, refuelled.Name, refuelled.Fuel, refuelled.MaxFuel)), nil
+}
+
+// attackVehicle resolves one strike against a vehicle: d20 plus brutality
+// modifier against its AC, dealing main-hand weapon damage (1d4 unarmed).
+// A vehicle reduced to 0 HP is wrecked and its passengers thrown clear.
+//
+// Precondition: sess must belong to uid; v must be in the player's room.
+// Postcondition: In combat, the strike costs 1 AP.
+func (s *GameServiceServer) attackVehicle(uid string, sess *session.PlayerSession, v *vehicle.Instance) (*gamev1.ServerEvent, error) {
+	if v.Wrecked() {
+		return messageEvent(fmt.Sprintf("The %s is already a wreck.", v.Name)), nil
+	}
+	if s.dice == nil {
+		return messageEvent("You can't attack that right now."), nil
+	}
+	if sess.Status == statusInCombat {
+		if err := s.combatH.SpendAP(uid, 1); err != nil {
+			return messageEvent("Not enough AP to attack."), nil
+		}
+	}
+	rollResult, err := s.dice.RollExpr("1d20")
+	if err != nil {
+		return nil, err
+	}
+	roll := rollResult.Total()
+	bonus := sess.Abilities.Modifier(sess.Abilities.Brutality)
+	total := roll + bonus
+	s.broadcastMessage(sess.RoomID, uid, &gamev1.MessageEvent{
+		Content: fmt.Sprintf("%s attacks the %s.", sess.CharName, v.Name),
+	})
+	if total < v.AC {
+		return messageEvent(fmt.Sprintf("You strike at the %s and miss (rolled %d+%d=%d vs AC %d).", v.Name, roll, bonus, total, v.AC)), nil
+	}
+
+	dmgExpr := "1d4"
+	if sess.LoadoutSet != nil {
+		if preset := sess.LoadoutSet.ActivePreset(); preset != nil && preset.MainHand != nil && preset.MainHand.Def.DamageDice != "" {
+			dmgExpr = preset.MainHand.Def.DamageDice
+		}
+	}
+	dmg := 1
+	if dmgResult, err := s.dice.RollExpr(dmgExpr); err == nil {
+		dmg = max(dmgResult.Total()+bonus, 1)
+	}
+	hit, ejected, err := s.vehicles.Damage(v.ID, dmg)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range ejected {
+		s.pushMessageToUID(p, fmt.Sprintf("The %s is wrecked and you're thrown clear!", hit.Name))
+	}
+	s.pushRoomViewToAllInRoom(sess.RoomID)
+	if hit.Wrecked() {
+		s.broadcastMessage(sess.RoomID, uid, &gamev1.MessageEvent{
+			Content: fmt.Sprintf("The %s is wrecked!", hit.Name),
+		})
+		return messageEvent(fmt.Sprintf("You hit the %s for %d damage (rolled %d+%d=%d vs AC %d). It's wrecked!", hit.Name, dmg, roll, bonus, total, hit.AC)), nil
+	}
+	return messageEvent(fmt.Sprintf("You hit the %s for %d damage (rolled %d+%d=%d vs AC %d). It looks %s.", hit.Name, dmg, roll, bonus, total, hit.AC, hit.Condition())), nil
+}
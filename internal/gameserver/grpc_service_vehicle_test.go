@@ -0,0 +1,172 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/vehicle"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// newVehicleTestServer builds a server with players "ace" and "bee" in a
+// parking lot holding a sedan. The lot has a road east to the street and a
+// footpath north to a shop. Dice always roll val+1.
+func newVehicleTestServer(t *testing.T, val int) (*GameServiceServer, *session.Manager, *vehicle.Instance) {
+	t.Helper()
+	rooms := map[string]*world.Room{
+		"lot": {ID: "lot", ZoneID: "z", Title: "Parking Lot", Exits: []world.Exit{
+			{Direction: world.East, TargetRoom: "street", Road: true},
+			{Direction: world.North, TargetRoom: "shop"},
+		}},
+		"street": {ID: "street", ZoneID: "z", Title: "Street", Exits: []world.Exit{
+			{Direction: world.West, TargetRoom: "lot", Road: true},
+		}},
+		"shop": {ID: "shop", ZoneID: "z", Title: "Shop", Exits: []world.Exit{
+			{Direction: world.South, TargetRoom: "lot"},
+		}},
+	}
+	wMgr, err := world.NewManager([]*world.Zone{{ID: "z", Name: "Z", StartRoom: "lot", Rooms: rooms}})
+	require.NoError(t, err)
+
+	reg := inventory.NewRegistry()
+	require.NoError(t, reg.RegisterItem(&inventory.ItemDef{ID: "gas_can", Name: "Gas Can", Kind: inventory.KindJunk, MaxStack: 1, Tags: []string{vehicleFuelTag}}))
+
+	sMgr := session.NewManager()
+	for _, name := range []string{"ace", "bee"} {
+		_, err := sMgr.AddPlayer(session.AddPlayerOptions{
+			UID: name, Username: name, CharName: name, CharacterID: int64(len(name)),
+			RoomID: "lot", CurrentHP: 10, MaxHP: 10, Abilities: character.AbilityScores{Brutality: 14}, Role: "player",
+		})
+		require.NoError(t, err)
+	}
+
+	npcMgr := npc.NewManager()
+	worldH := NewWorldHandler(wMgr, sMgr, npcMgr, nil, nil, reg)
+	s := &GameServiceServer{
+		sessions:    sMgr,
+		world:       wMgr,
+		npcMgr:      npcMgr,
+		worldH:      worldH,
+		invRegistry: reg,
+		dice:        dice.NewLoggedRoller(&fixedDiceSource{val: val}, zaptest.NewLogger(t)),
+		logger:      zap.NewNop(),
+	}
+	vehicles := vehicle.NewManager()
+	v := vehicles.Spawn(&vehicle.Template{ID: "sedan", Name: "Rusted Sedan", Kind: vehicle.KindCar, MaxHP: 10, AC: 12, MaxFuel: 1, Seats: 2}, "lot")
+	s.SetVehicles(vehicles)
+	return s, sMgr, v
+}
+
+func TestHandleDrive_CarriesAllPassengersAlongRoads(t *testing.T) {
+	s, sMgr, _ := newVehicleTestServer(t, 0)
+
+	evt, err := s.handleLook("ace")
+	require.NoError(t, err)
+	require.Len(t, evt.GetRoomView().Vehicles, 1)
+	assert.Equal(t, "pristine", evt.GetRoomView().Vehicles[0].Condition)
+
+	evt, err = s.handleBoard("ace", &gamev1.BoardRequest{Target: "boat"})
+	require.NoError(t, err)
+	assert.Equal(t, "You don't see that vehicle here.", evt.GetMessage().GetContent())
+	evt, err = s.handleBoard("ace", &gamev1.BoardRequest{Target: "sedan"})
+	require.NoError(t, err)
+	assert.Equal(t, "You climb into the driver's seat of the Rusted Sedan (fuel 1/1).", evt.GetMessage().GetContent())
+	_, err = s.handleBoard("bee", &gamev1.BoardRequest{Target: "rusted"})
+	require.NoError(t, err)
+
+	evt, err = s.handleMove("ace", &gamev1.MoveRequest{Direction: "east"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "disembark first")
+
+	evt, err = s.handleDrive("bee", &gamev1.DriveRequest{Direction: "east"})
+	require.NoError(t, err)
+	assert.Equal(t, "Only the driver can drive the Rusted Sedan.", evt.GetMessage().GetContent())
+	evt, err = s.handleDrive("ace", &gamev1.DriveRequest{Direction: "north"})
+	require.NoError(t, err)
+	assert.Equal(t, "There's no road north.", evt.GetMessage().GetContent())
+
+	evt, err = s.handleDrive("ace", &gamev1.DriveRequest{Direction: "east"})
+	require.NoError(t, err)
+	assert.Equal(t, "You drive east (fuel 0/1).", evt.GetMessage().GetContent())
+	for _, uid := range []string{"ace", "bee"} {
+		sess, ok := sMgr.GetPlayer(uid)
+		require.True(t, ok)
+		assert.Equal(t, "street", sess.RoomID)
+	}
+
+	evt, err = s.handleDrive("ace", &gamev1.DriveRequest{Direction: "west"})
+	require.NoError(t, err)
+	assert.Contains(t, evt.GetMessage().GetContent(), "out of fuel")
+
+	evt, err = s.handleDisembark("ace", &gamev1.DisembarkRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You climb out of the Rusted Sedan.", evt.GetMessage().GetContent())
+	v, ok := s.vehicles.Aboard("bee")
+	require.True(t, ok)
+	assert.Equal(t, "bee", v.Driver)
+}
+
+func TestHandleRefuel_ConsumesFuelItem(t *testing.T) {
+	s, sMgr, v := newVehicleTestServer(t, 0)
+	_, err := s.vehicles.Board(v.ID, "ace")
+	require.NoError(t, err)
+	_, err = s.vehicles.Drive("ace", "street")
+	require.NoError(t, err)
+
+	evt, err := s.handleRefuel("ace", &gamev1.RefuelRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You have no fuel to pour.", evt.GetMessage().GetContent())
+
+	sess, _ := sMgr.GetPlayer("ace")
+	_, err = sess.Backpack.Add("gas_can", 1, s.invRegistry)
+	require.NoError(t, err)
+	evt, err = s.handleRefuel("ace", &gamev1.RefuelRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "You empty your Gas Can into the Rusted Sedan (fuel 1/1).", evt.GetMessage().GetContent())
+	assert.Empty(t, sess.Backpack.Items())
+}
+
+func TestAttackVehicle_MissesBelowAC(t *testing.T) {
+	// d20 rolls 5; brutality 14 adds 2.
+	s, _, v := newVehicleTestServer(t, 4)
+	evt, err := s.attackVehicle("ace", mustSession(t, s, "ace"), v)
+	require.NoError(t, err)
+	assert.Equal(t, "You strike at the Rusted Sedan and miss (rolled 5+2=7 vs AC 12).", evt.GetMessage().GetContent())
+	v, _ = s.vehicles.FindInRoom("lot", "sedan")
+	assert.Equal(t, "pristine", v.Condition())
+}
+
+func TestAttackVehicle_WrecksAndEjectsPassengers(t *testing.T) {
+	// Every die rolls 10, so the strike hits AC 12 and deals 10+2 damage.
+	s, _, v := newVehicleTestServer(t, 9)
+	_, err := s.vehicles.Board(v.ID, "bee")
+	require.NoError(t, err)
+
+	evt, err := s.attackVehicle("ace", mustSession(t, s, "ace"), v)
+	require.NoError(t, err)
+	assert.Equal(t, "You hit the Rusted Sedan for 12 damage (rolled 10+2=12 vs AC 12). It's wrecked!", evt.GetMessage().GetContent())
+	_, aboard := s.vehicles.Aboard("bee")
+	assert.False(t, aboard)
+
+	v, _ = s.vehicles.FindInRoom("lot", "sedan")
+	evt, err = s.attackVehicle("ace", mustSession(t, s, "ace"), v)
+	require.NoError(t, err)
+	assert.Equal(t, "The Rusted Sedan is already a wreck.", evt.GetMessage().GetContent())
+}
+
+func mustSession(t *testing.T, s *GameServiceServer, uid string) *session.PlayerSession {
+	t.Helper()
+	sess, ok := s.sessions.GetPlayer(uid)
+	require.True(t, ok)
+	return sess
+}
@@ -0,0 +1,194 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// WeaponPracticeStore persists the practice points characters earn with each
+// weapon proficiency category by fighting with it.
+type WeaponPracticeStore interface {
+	GetAll(ctx context.Context, characterID int64) (map[string]int, error)
+	SetPractice(ctx context.Context, characterID int64, category string, points int) error
+}
+
+// weaponPracticeThresholds maps a weapon proficiency rank to the total practice
+// points at which fighting advances a category past it. Use alone tops out at
+// master; legendary takes a skill increase.
+var weaponPracticeThresholds = map[string]int{
+	"untrained": 40,
+	"trained":   300,
+	"expert":    1000,
+}
+
+// practiceNext returns the practice points at which use advances weapon
+// category from rank, or 0 when use can't advance it. Exotic weapons take a
+// skill increase to learn at all.
+func practiceNext(category, rank string) int {
+	if category == "specialized" && rank == "untrained" {
+		return 0
+	}
+	return weaponPracticeThresholds[rank]
+}
+
+// practicedRank returns the rank weapon category at rank reaches with points
+// of practice at level: the next rank once the threshold is met and the
+// level gate for it is passed, otherwise rank.
+//
+// Postcondition: Returns rank or the rank immediately after it.
+func practicedRank(category, rank string, points, level int) string {
+	threshold := practiceNext(category, rank)
+	if threshold == 0 || points < threshold {
+		return rank
+	}
+	next, gate, err := nextSkillRank(rank, level)
+	if err != nil || gate > 0 {
+		return rank
+	}
+	return next
+}
+
+// loadWeaponPractice loads the character's weapon practice into sess.
+//
+// Precondition: sess must not be nil.
+// Postcondition: sess.WeaponPractice is non-nil.
+func (s *GameServiceServer) loadWeaponPractice(ctx context.Context, sess *session.PlayerSession) {
+	sess.WeaponPractice = make(map[string]int)
+	if s.weaponPracticeStore == nil || sess.CharacterID <= 0 {
+		return
+	}
+	practice, err := s.weaponPracticeStore.GetAll(ctx, sess.CharacterID)
+	if err != nil {
+		s.logger.Warn("loading weapon practice",
+			zap.Int64("character_id", sess.CharacterID),
+			zap.Error(err),
+		)
+		return
+	}
+	for cat, points := range practice {
+		sess.WeaponPractice[cat] = points
+	}
+}
+
+// practiceWeapon credits sess with one point of practice in weapon category
+// and advances its rank once the practice reaches the next threshold.
+//
+// Precondition: sess must not be nil; category must be non-empty.
+// Postcondition: Returns the player's effective rank with category. The
+// practice and rank change apply immediately; their writes are queued, since
+// this runs with combatMu held and must not wait on the database.
+func (s *GameServiceServer) practiceWeapon(sess *session.PlayerSession, category string) string {
+	if sess.WeaponPractice == nil {
+		sess.WeaponPractice = make(map[string]int)
+	}
+	sess.WeaponPractice[category]++
+	points := sess.WeaponPractice[category]
+	charID := sess.CharacterID
+	if s.weaponPracticeStore != nil && charID > 0 {
+		s.practiceSaves.push(func() { s.saveWeaponPractice(charID, category, points) })
+	}
+
+	rank, _ := resolveWeaponProficiency(sess.Proficiencies, category)
+	next := practicedRank(category, rank, points, sess.Level)
+	if next == rank {
+		return rank
+	}
+	if sess.Proficiencies == nil {
+		sess.Proficiencies = make(map[string]string)
+	}
+	sess.Proficiencies[category] = next
+	if s.characterProficienciesRepo != nil && charID > 0 {
+		s.practiceSaves.push(func() { s.saveWeaponProficiency(charID, category, next) })
+	}
+	s.pushMessageToUID(sess.UID, fmt.Sprintf("All that fighting pays off: you are now %s with %s.", next, proficiencyDisplayNames[category]))
+	return next
+}
+
+// saveWeaponPractice persists a character's practice points with weapon
+// category, bounded by the command timeout, logging failures.
+//
+// Precondition: s.weaponPracticeStore must be non-nil.
+func (s *GameServiceServer) saveWeaponPractice(characterID int64, category string, points int) {
+	ctx, cancel := s.withCommandTimeout(context.Background())
+	defer cancel()
+	if err := s.weaponPracticeStore.SetPractice(ctx, characterID, category, points); err != nil {
+		s.logger.Warn("saving weapon practice",
+			zap.Int64("character_id", characterID),
+			zap.String("category", category),
+			zap.Error(err),
+		)
+	}
+}
+
+// saveWeaponProficiency persists the rank a character reached with weapon
+// category by practice, bounded by the command timeout, logging failures.
+//
+// Precondition: s.characterProficienciesRepo must be non-nil.
+func (s *GameServiceServer) saveWeaponProficiency(characterID int64, category, rank string) {
+	ctx, cancel := s.withCommandTimeout(context.Background())
+	defer cancel()
+	if err := s.characterProficienciesRepo.Upsert(ctx, characterID, category, rank); err != nil {
+		s.logger.Warn("advancing weapon proficiency by practice",
+			zap.Int64("character_id", characterID),
+			zap.String("category", category),
+			zap.Error(err),
+		)
+	}
+}
+
+// setWeaponProficiency records rank as the character's proficiency with
+// weapon category.
+//
+// Precondition: sess must not be nil; category and rank must be non-empty.
+// Postcondition: On success sess.Proficiencies[category] == rank.
+func (s *GameServiceServer) setWeaponProficiency(ctx context.Context, sess *session.PlayerSession, category, rank string) error {
+	if s.characterProficienciesRepo != nil && sess.CharacterID > 0 {
+		if err := s.characterProficienciesRepo.Upsert(ctx, sess.CharacterID, category, rank); err != nil {
+			return fmt.Errorf("saving %s proficiency: %w", category, err)
+		}
+	}
+	if sess.Proficiencies == nil {
+		sess.Proficiencies = make(map[string]string)
+	}
+	sess.Proficiencies[category] = rank
+	return nil
+}
+
+// trainWeaponCategory spends one of the player's pending skill increases to
+// advance their proficiency with weapon category.
+//
+// Precondition: sess must not be nil and have a pending skill increase;
+// category must be a weapon proficiency category.
+// Postcondition: On success the category is one rank higher and
+// sess.PendingSkillIncreases is decremented.
+func (s *GameServiceServer) trainWeaponCategory(sess *session.PlayerSession, category string) (*gamev1.ServerEvent, error) {
+	name := proficiencyDisplayNames[category]
+	current, _ := resolveWeaponProficiency(sess.Proficiencies, category)
+	next, gateLevel, err := nextSkillRank(current, sess.Level)
+	if err != nil {
+		return messageEvent(fmt.Sprintf("You are already legendary with %s.", name)), nil
+	}
+	if gateLevel > 0 {
+		return messageEvent(fmt.Sprintf("You must be level %d to advance %s to %s.", gateLevel, name, next)), nil
+	}
+
+	ctx := s.commandCtx(sess.UID)
+	if err := s.setWeaponProficiency(ctx, sess, category, next); err != nil {
+		s.logger.Warn("trainWeaponCategory: setWeaponProficiency failed", zap.Error(err))
+		return messageEvent("Failed to upgrade proficiency. Please try again."), nil
+	}
+	if s.progressRepo != nil {
+		if err := s.progressRepo.ConsumePendingSkillIncrease(ctx, sess.CharacterID); err != nil {
+			s.logger.Warn("trainWeaponCategory: ConsumePendingSkillIncrease failed", zap.Error(err))
+			return messageEvent("Failed to consume skill increase. Please try again."), nil
+		}
+	}
+	sess.PendingSkillIncreases--
+	return messageEvent(fmt.Sprintf("You advanced %s from %s to %s. Pending skill increases remaining: %d.",
+		name, current, next, sess.PendingSkillIncreases)), nil
+}
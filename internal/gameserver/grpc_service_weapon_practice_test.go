@@ -0,0 +1,157 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/session"
+)
+
+// fakeWeaponPracticeStore is an in-memory WeaponPracticeStore. When release
+// is non-nil, SetPractice waits for it to close.
+type fakeWeaponPracticeStore struct {
+	points      map[string]int
+	release     chan struct{}
+	hadDeadline bool
+}
+
+func (f *fakeWeaponPracticeStore) GetAll(_ context.Context, _ int64) (map[string]int, error) {
+	out := make(map[string]int, len(f.points))
+	for k, v := range f.points {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (f *fakeWeaponPracticeStore) SetPractice(ctx context.Context, _ int64, category string, points int) error {
+	if f.release != nil {
+		<-f.release
+	}
+	_, f.hadDeadline = ctx.Deadline()
+	f.points[category] = points
+	return nil
+}
+
+// newWeaponPracticeService returns a service with a fake practice store and a
+// player "wp-p1" at level holding profs.
+func newWeaponPracticeService(t *testing.T, level int, profs map[string]string) (*GameServiceServer, *fakeWeaponPracticeStore, *session.PlayerSession) {
+	t.Helper()
+	sessMgr := session.NewManager()
+	svc := testMinimalService(t, sessMgr)
+	store := &fakeWeaponPracticeStore{points: map[string]int{}}
+	svc.weaponPracticeStore = store
+	_, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+		UID: "wp-p1", Username: "wp-p1", CharName: "Hero", CharacterID: 1,
+		RoomID: "room_a", CurrentHP: 10, MaxHP: 10, Role: "player", Level: level,
+	})
+	require.NoError(t, err)
+	sess, ok := sessMgr.GetPlayer("wp-p1")
+	require.True(t, ok)
+	sess.Proficiencies = profs
+	return svc, store, sess
+}
+
+func TestPracticeNext(t *testing.T) {
+	assert.Equal(t, 40, practiceNext("simple_weapons", "untrained"))
+	assert.Equal(t, 300, practiceNext("martial_weapons", "trained"))
+	assert.Equal(t, 1000, practiceNext("unarmed", "expert"))
+	assert.Zero(t, practiceNext("unarmed", "master"), "legendary takes a skill increase")
+	assert.Zero(t, practiceNext("specialized", "untrained"), "exotic weapons must be learned first")
+	assert.Equal(t, 300, practiceNext("specialized", "trained"))
+}
+
+func TestPracticedRank_LevelGates(t *testing.T) {
+	assert.Equal(t, "trained", practicedRank("simple_weapons", "untrained", 40, 1))
+	assert.Equal(t, "untrained", practicedRank("simple_weapons", "untrained", 39, 1))
+	assert.Equal(t, "trained", practicedRank("simple_weapons", "trained", 300, 14), "expert is gated at level 15")
+	assert.Equal(t, "expert", practicedRank("simple_weapons", "trained", 300, 15))
+	assert.Equal(t, "master", practicedRank("simple_weapons", "master", 1e6, 100))
+}
+
+func TestProperty_PracticedRank_AdvancesAtMostOneRank(t *testing.T) {
+	ranks := []string{"untrained", "trained", "expert", "master", "legendary"}
+	rapid.Check(t, func(rt *rapid.T) {
+		i := rapid.IntRange(0, len(ranks)-1).Draw(rt, "rank")
+		cat := rapid.SampledFrom([]string{"simple_weapons", "martial_weapons", "unarmed", "specialized"}).Draw(rt, "cat")
+		points := rapid.IntRange(0, 5000).Draw(rt, "points")
+		level := rapid.IntRange(1, 100).Draw(rt, "level")
+		got := practicedRank(cat, ranks[i], points, level)
+		if got != ranks[i] && (i+1 >= len(ranks) || got != ranks[i+1]) {
+			rt.Fatalf("practicedRank(%s, %s, %d, %d) = %s", cat, ranks[i], points, level, got)
+		}
+	})
+}
+
+func TestPracticeWeapon_RanksUpAtThreshold(t *testing.T) {
+	svc, store, sess := newWeaponPracticeService(t, 1, map[string]string{})
+	sess.WeaponPractice = map[string]int{"simple_weapons": 38}
+
+	assert.Equal(t, "untrained", svc.practiceWeapon(sess, "simple_weapons"))
+	waitPracticeSaves(svc)
+	assert.Equal(t, 39, store.points["simple_weapons"])
+	assert.True(t, store.hadDeadline, "queued writes are bounded by the command timeout")
+	assert.Equal(t, "trained", svc.practiceWeapon(sess, "simple_weapons"))
+	assert.Equal(t, "trained", sess.Proficiencies["simple_weapons"])
+	assert.Equal(t, 40, sess.WeaponPractice["simple_weapons"])
+}
+
+// waitPracticeSaves blocks until every weapon practice write queued so far has run.
+func waitPracticeSaves(s *GameServiceServer) {
+	done := make(chan struct{})
+	s.practiceSaves.push(func() { close(done) })
+	<-done
+}
+
+func TestPracticeWeapon_DoesNotWaitOnTheStore(t *testing.T) {
+	svc, store, sess := newWeaponPracticeService(t, 1, map[string]string{})
+	store.release = make(chan struct{})
+	sess.WeaponPractice = map[string]int{"simple_weapons": 39}
+
+	done := make(chan string)
+	go func() { done <- svc.practiceWeapon(sess, "simple_weapons") }()
+	select {
+	case rank := <-done:
+		assert.Equal(t, "trained", rank, "the rank-up applies before it is saved")
+	case <-time.After(2 * time.Second):
+		t.Fatal("practiceWeapon blocked on a slow store")
+	}
+
+	close(store.release)
+	waitPracticeSaves(svc)
+	assert.Equal(t, 40, store.points["simple_weapons"])
+}
+
+func TestPracticeWeapon_SpecializedNeedsTraining(t *testing.T) {
+	svc, _, sess := newWeaponPracticeService(t, 1, nil)
+	sess.WeaponPractice = map[string]int{"specialized": 500}
+	assert.Equal(t, "untrained", svc.practiceWeapon(sess, "specialized"))
+}
+
+func TestLoadWeaponPractice(t *testing.T) {
+	svc, store, sess := newWeaponPracticeService(t, 1, nil)
+	store.points["unarmed"] = 12
+	svc.loadWeaponPractice(context.Background(), sess)
+	assert.Equal(t, map[string]int{"unarmed": 12}, sess.WeaponPractice)
+}
+
+func TestTrainWeaponCategory(t *testing.T) {
+	svc, _, sess := newWeaponPracticeService(t, 1, map[string]string{})
+	sess.PendingSkillIncreases = 2
+
+	ev, err := svc.handleTrainSkill("wp-p1", "specialized")
+	require.NoError(t, err)
+	assert.Contains(t, ev.GetMessage().GetContent(), "from untrained to trained")
+	assert.Equal(t, "trained", sess.Proficiencies["specialized"])
+	assert.Equal(t, 1, sess.PendingSkillIncreases)
+
+	ev, err = svc.handleTrainSkill("wp-p1", "specialized")
+	require.NoError(t, err)
+	assert.Contains(t, ev.GetMessage().GetContent(), "level 15")
+	assert.Equal(t, "trained", sess.Proficiencies["specialized"])
+	assert.Equal(t, 1, sess.PendingSkillIncreases)
+}
@@ -0,0 +1,149 @@
+package gameserver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+// zoneResetTimer tracks when a zone next resets and whether its players have
+// been warned.
+type zoneResetTimer struct {
+	at     time.Time
+	warned bool
+}
+
+// zoneResetResult counts what a zone reset put back.
+type zoneResetResult struct {
+	Doors, Equipment, NPCs, FloorItems int
+}
+
+// InitFloorItems drops every room's designated floor items.
+//
+// Precondition: MUST be called once at startup, before players connect.
+func (s *GameServiceServer) InitFloorItems() {
+	for _, zone := range s.world.AllZones() {
+		for _, room := range zone.Rooms {
+			s.refillFloorItems(room)
+		}
+	}
+}
+
+// tickZoneReset warns the zone's players as its reset approaches and resets
+// the zone when the time comes. The first tick starts the zone's timer.
+//
+// Precondition: zone must be non-nil.
+func (s *GameServiceServer) tickZoneReset(zone *world.Zone, now time.Time) {
+	if zone.Reset == nil {
+		return
+	}
+	s.zoneResetMu.Lock()
+	timer, ok := s.zoneResets[zone.ID]
+	if !ok {
+		if s.zoneResets == nil {
+			s.zoneResets = make(map[string]*zoneResetTimer)
+		}
+		s.zoneResets[zone.ID] = &zoneResetTimer{at: now.Add(zone.Reset.Interval)}
+		s.zoneResetMu.Unlock()
+		return
+	}
+	warn := !timer.warned && zone.Reset.Warning > 0 && !now.Before(timer.at.Add(-zone.Reset.Warning))
+	reset := !now.Before(timer.at)
+	if warn {
+		timer.warned = true
+	}
+	s.zoneResetMu.Unlock()
+
+	if warn && !reset {
+		msg := zone.Reset.Message
+		if msg == "" {
+			msg = fmt.Sprintf("You sense %s stirring. It will reset in %s.", zone.Name, formatRealDuration(timer.at.Sub(now).Round(time.Second)))
+		}
+		s.messageZone(zone.ID, msg)
+	}
+	if reset {
+		s.resetZone(zone, now)
+	}
+}
+
+// resetZone restores zone to its loaded state: doors relock, room equipment
+// refills, missing NPCs respawn, and designated floor items return. Players
+// in the zone see their rooms refresh.
+//
+// Precondition: zone must be non-nil.
+// Postcondition: The zone's next reset, if it resets on a timer, is one
+// interval after now.
+func (s *GameServiceServer) resetZone(zone *world.Zone, now time.Time) zoneResetResult {
+	var res zoneResetResult
+	res.Doors = s.world.ResetDoors(zone.ID)
+	for _, room := range zone.Rooms {
+		if s.roomEquipMgr != nil {
+			res.Equipment += s.roomEquipMgr.ResetRoom(room.ID)
+		}
+		if s.respawnMgr != nil && s.npcMgr != nil {
+			res.NPCs += s.respawnMgr.Repopulate(room.ID, s.npcMgr)
+		}
+		res.FloorItems += s.refillFloorItems(room)
+	}
+	if zone.Reset != nil {
+		s.zoneResetMu.Lock()
+		if s.zoneResets == nil {
+			s.zoneResets = make(map[string]*zoneResetTimer)
+		}
+		s.zoneResets[zone.ID] = &zoneResetTimer{at: now.Add(zone.Reset.Interval)}
+		s.zoneResetMu.Unlock()
+	}
+	s.logger.Info("zone reset", zap.String("zone", zone.ID),
+		zap.Int("doors", res.Doors), zap.Int("equipment", res.Equipment),
+		zap.Int("npcs", res.NPCs), zap.Int("floor_items", res.FloorItems))
+
+	occupied := make(map[string]bool)
+	for _, sess := range s.sessions.AllPlayers() {
+		if room, ok := zone.Rooms[sess.RoomID]; ok && !occupied[room.ID] {
+			occupied[room.ID] = true
+			s.pushRoomViewToAllInRoom(room.ID)
+		}
+	}
+	return res
+}
+
+// refillFloorItems tops up each of the room's designated floor items to its
+// quantity.
+//
+// Postcondition: Returns the number of items dropped.
+func (s *GameServiceServer) refillFloorItems(room *world.Room) int {
+	if s.floorMgr == nil || len(room.FloorItems) == 0 {
+		return 0
+	}
+	have := make(map[string]int)
+	for _, it := range s.floorMgr.ItemsInRoom(room.ID) {
+		have[it.ItemDefID] += it.Quantity
+	}
+	dropped := 0
+	for _, f := range room.FloorItems {
+		if missing := f.Quantity - have[f.ItemID]; missing > 0 {
+			s.floorMgr.Drop(room.ID, inventory.ItemInstance{
+				InstanceID: uuid.New().String(),
+				ItemDefID:  f.ItemID,
+				Quantity:   missing,
+			})
+			have[f.ItemID] = f.Quantity
+			dropped += missing
+		}
+	}
+	return dropped
+}
+
+// messageZone sends msg to every player in the zone.
+func (s *GameServiceServer) messageZone(zoneID, msg string) {
+	for _, sess := range s.sessions.AllPlayers() {
+		if room, ok := s.world.GetRoom(sess.RoomID); ok && room.ZoneID == zoneID {
+			s.pushMessageToUID(sess.UID, msg)
+		}
+	}
+}
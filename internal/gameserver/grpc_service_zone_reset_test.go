@@ -0,0 +1,147 @@
+package gameserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+)
+
+// testResetService returns a service over a zone that resets every ten
+// minutes with a one-minute warning. room_a has a locked door north, a
+// locked strongbox, a ganger spawn, and two bandages on the floor; player u1
+// stands in room_a.
+func testResetService(t *testing.T) (*GameServiceServer, *session.PlayerSession, *world.Zone) {
+	t.Helper()
+	zone := &world.Zone{
+		ID: "docks", Name: "The Docks", Description: "Reset test zone", StartRoom: "room_a",
+		Reset: &world.ZoneReset{Interval: 10 * time.Minute, Warning: time.Minute},
+		Rooms: map[string]*world.Room{
+			"room_a": {
+				ID: "room_a", ZoneID: "docks", Title: "Pier", Description: "A pier.",
+				Exits:      []world.Exit{{Direction: world.North, TargetRoom: "room_b", Locked: true}},
+				Properties: map[string]string{},
+				Equipment:  []world.RoomEquipmentConfig{{ItemID: "strongbox", MaxCount: 1, Immovable: true, LockDC: 15}},
+				Spawns:     []world.RoomSpawnConfig{{Template: "ganger", Count: 2}},
+				FloorItems: []world.RoomFloorItem{{ItemID: "bandage", Quantity: 2}},
+			},
+			"room_b": {
+				ID: "room_b", ZoneID: "docks", Title: "Warehouse", Description: "A warehouse.", MapY: 1,
+				Exits:      []world.Exit{{Direction: world.South, TargetRoom: "room_a"}},
+				Properties: map[string]string{},
+			},
+		},
+	}
+	worldMgr, err := world.NewManager([]*world.Zone{zone})
+	require.NoError(t, err)
+	sessMgr := session.NewManager()
+	npcMgr := npc.NewManager()
+	svc := testServiceWithNPCMgr(t, worldMgr, sessMgr, npcMgr)
+
+	svc.floorMgr = inventory.NewFloorManager()
+	svc.roomEquipMgr = inventory.NewRoomEquipmentManager()
+	svc.roomEquipMgr.InitRoom("room_a", zone.Rooms["room_a"].Equipment)
+	ganger := &npc.Template{ID: "ganger", Name: "Ganger", Description: "A ganger.", Level: 1, MaxHP: 10, AC: 10}
+	svc.respawnMgr = npc.NewRespawnManager(
+		map[string][]npc.RoomSpawn{"room_a": {{TemplateID: "ganger", Max: 2}}},
+		map[string]*npc.Template{"ganger": ganger}, nil, nil)
+	svc.respawnMgr.PopulateRoom("room_a", npcMgr)
+	svc.InitFloorItems()
+
+	sess, err := sessMgr.AddPlayer(session.AddPlayerOptions{UID: "u1", Username: "u", CharName: "Vex", RoomID: "room_a", Role: "player"})
+	require.NoError(t, err)
+	return svc, sess, zone
+}
+
+func floorQuantity(svc *GameServiceServer, roomID, itemID string) int {
+	n := 0
+	for _, it := range svc.floorMgr.ItemsInRoom(roomID) {
+		if it.ItemDefID == itemID {
+			n += it.Quantity
+		}
+	}
+	return n
+}
+
+func TestInitFloorItems_DropsDesignatedItemsOnce(t *testing.T) {
+	svc, _, _ := testResetService(t)
+	assert.Equal(t, 2, floorQuantity(svc, "room_a", "bandage"))
+
+	svc.InitFloorItems()
+	assert.Equal(t, 2, floorQuantity(svc, "room_a", "bandage"), "items already on the floor are not doubled")
+}
+
+func TestResetZone_RestoresZone(t *testing.T) {
+	svc, _, zone := testResetService(t)
+
+	require.True(t, svc.world.UnlockExit("room_a", "north"))
+	box := svc.roomEquipMgr.EquipmentInRoom("room_a")[0]
+	require.True(t, svc.roomEquipMgr.Unlock("room_a", box.InstanceID))
+	gangers := svc.npcMgr.InstancesInRoom("room_a")
+	require.Len(t, gangers, 2)
+	require.NoError(t, svc.npcMgr.Remove(gangers[0].ID))
+	for _, it := range svc.floorMgr.ItemsInRoom("room_a") {
+		_, ok := svc.floorMgr.Pickup("room_a", it.InstanceID)
+		require.True(t, ok)
+	}
+
+	res := svc.resetZone(zone, time.Now())
+	assert.Equal(t, zoneResetResult{Doors: 1, Equipment: 1, NPCs: 1, FloorItems: 2}, res)
+	room, _ := svc.world.GetRoom("room_a")
+	assert.True(t, room.Exits[0].Locked)
+	assert.Equal(t, 15, svc.roomEquipMgr.EquipmentInRoom("room_a")[0].LockDC)
+	assert.Len(t, svc.npcMgr.InstancesInRoom("room_a"), 2)
+	assert.Equal(t, 2, floorQuantity(svc, "room_a", "bandage"))
+
+	assert.Equal(t, zoneResetResult{}, svc.resetZone(zone, time.Now()), "a fresh zone needs nothing")
+}
+
+func TestTickZoneReset_WarnsThenResets(t *testing.T) {
+	svc, sess, zone := testResetService(t)
+	start := time.Now()
+
+	svc.tickZoneReset(zone, start)
+	require.True(t, svc.world.UnlockExit("room_a", "north"))
+	svc.tickZoneReset(zone, start.Add(8*time.Minute))
+	room, _ := svc.world.GetRoom("room_a")
+	assert.False(t, room.Exits[0].Locked, "nothing happens before the warning")
+
+	svc.tickZoneReset(zone, start.Add(9*time.Minute+30*time.Second))
+	assert.Contains(t, pushedText(t, sess), "You sense The Docks stirring. It will reset in 30 seconds.")
+	assert.False(t, room.Exits[0].Locked)
+
+	svc.tickZoneReset(zone, start.Add(10*time.Minute))
+	assert.True(t, room.Exits[0].Locked)
+
+	svc.zoneResetMu.Lock()
+	next := svc.zoneResets["docks"]
+	svc.zoneResetMu.Unlock()
+	assert.Equal(t, start.Add(20*time.Minute), next.at)
+	assert.False(t, next.warned)
+}
+
+func TestTickZoneReset_CustomWarningOnlyInZone(t *testing.T) {
+	svc, sess, zone := testResetService(t)
+	zone.Reset.Message = "Foghorns sound across the water."
+	start := time.Now()
+
+	svc.tickZoneReset(zone, start)
+	svc.tickZoneReset(zone, start.Add(9*time.Minute))
+	assert.Contains(t, pushedText(t, sess), "Foghorns sound across the water.")
+}
+
+func TestTickZoneReset_ZoneWithoutResetIsIgnored(t *testing.T) {
+	svc, _, zone := testResetService(t)
+	zone.Reset = nil
+
+	svc.tickZoneReset(zone, time.Now())
+	svc.zoneResetMu.Lock()
+	defer svc.zoneResetMu.Unlock()
+	assert.Empty(t, svc.zoneResets)
+}
@@ -26,6 +26,18 @@ import (
 // RoundDurationMs is the combat round duration in milliseconds.
 type RoundDurationMs int
 
+// defaultRoundDuration is the round length used when none is configured.
+const defaultRoundDuration = 6 * time.Second
+
+// roundDuration converts ms to a round length, substituting
+// defaultRoundDuration when it is unset.
+func roundDuration(ms RoundDurationMs) time.Duration {
+	if d := time.Duration(ms) * time.Millisecond; d > 0 {
+		return d
+	}
+	return defaultRoundDuration
+}
+
 // AITickInterval is the NPC AI tick interval.
 type AITickInterval time.Duration
 
@@ -93,11 +105,7 @@ func NewCombatHandlerProvider(
 	mentalMgr *mentalstate.Manager,
 	logger *zap.Logger,
 ) *CombatHandler {
-	roundDuration := time.Duration(roundDurationMs) * time.Millisecond
-	if roundDuration <= 0 {
-		roundDuration = 6 * time.Second
-	}
-	h := NewCombatHandler(combatEngine, npcMgr, sessMgr, diceRoller, nil, roundDuration, condRegistry, worldMgr, scriptMgr, invRegistry, aiRegistry, nil, respawnMgr, floorMgr, mentalMgr)
+	h := NewCombatHandler(combatEngine, npcMgr, sessMgr, diceRoller, nil, roundDuration(roundDurationMs), condRegistry, worldMgr, scriptMgr, invRegistry, aiRegistry, nil, respawnMgr, floorMgr, mentalMgr)
 	h.SetLogger(logger)
 	return h
 }
@@ -148,6 +156,15 @@ func (h *CombatHandler) SetOnMassiveDamage(fn func(uid string)) {
 	h.onMassiveDamage = fn
 }
 
+// SetSoundFn sets the optional callback that carries the noise of each resolved combat round
+// to neighbouring rooms. May be set to nil to keep fights silent.
+//
+// Precondition: none.
+// Postcondition: h.soundFn == fn.
+func (h *CombatHandler) SetSoundFn(fn func(roomID string, n noise)) {
+	h.soundFn = fn
+}
+
 // SetOnPlayerDeath sets the optional callback fired for each player who is downed (HP=0) when
 // all-players-down combat ends. The callback receives the downed player's uid and should handle
 // respawn (move to zone start room, restore HP, push room view). May be set to nil to disable.
@@ -177,4 +194,7 @@ var ServerProviders = wire.NewSet(
 	NewSessionManager,
 	NewAccountRepoAdapter,
 	wire.Bind(new(AccountAdmin), new(*AccountRepoAdapter)),
+	wire.Bind(new(AccountLocaleStore), new(*AccountRepoAdapter)),
+	wire.Bind(new(AccountSlotsStore), new(*AccountRepoAdapter)),
+	wire.Bind(new(AccountStashStore), new(*AccountRepoAdapter)),
 )
@@ -103,10 +103,10 @@ func TestHandleSetRole_NonAdminDenied(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	resp, err := svc.handleSetRole("u1", &gamev1.SetRoleRequest{
+	resp, err := svc.dispatch("u1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SetRole{SetRole: &gamev1.SetRoleRequest{
 		TargetUsername: "someone",
 		Role:           "admin",
-	})
+	}}})
 	require.NoError(t, err)
 	errEvt := resp.GetError()
 	require.NotNil(t, errEvt)
@@ -196,12 +196,12 @@ func TestPropertySetRole_NonAdminAlwaysDenied(t *testing.T) {
 		}
 		defer func() { _ = svc.sessions.RemovePlayer(uid) }()
 
-		resp, err := svc.handleSetRole(uid, &gamev1.SetRoleRequest{
+		resp, err := svc.dispatch(uid, &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SetRole{SetRole: &gamev1.SetRoleRequest{
 			TargetUsername: "anyone",
 			Role:           "admin",
-		})
+		}}})
 		if err != nil {
-			t.Fatalf("handleSetRole: %v", err)
+			t.Fatalf("dispatch: %v", err)
 		}
 		errEvt := resp.GetError()
 		if errEvt == nil {
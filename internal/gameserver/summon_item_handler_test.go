@@ -138,10 +138,10 @@ func TestHandleSummonItem_PlayerDenied(t *testing.T) {
 	svc := newSummonItemService(t, fm, reg)
 	addSummonTestPlayer(t, svc, "u3", "room_a", "player")
 
-	resp, err := svc.handleSummonItem("u3", &gamev1.SummonItemRequest{
+	resp, err := svc.dispatch("u3", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_SummonItem{SummonItem: &gamev1.SummonItemRequest{
 		ItemId:   "sword_01",
 		Quantity: 1,
-	})
+	}}})
 	require.NoError(t, err)
 
 	errEvt := resp.GetError()
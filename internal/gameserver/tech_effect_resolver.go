@@ -305,6 +305,15 @@ func applyEffects(
 	return msgs
 }
 
+// techAdjustmentNote returns the space-prefixed resistance annotation for a tech
+// damage result, or "" when no immunity, weakness, or resistance applied.
+func techAdjustmentNote(r combat.DamageResult) string {
+	if note := combat.AdjustmentNote(r.Breakdown); note != "" {
+		return " " + note
+	}
+	return ""
+}
+
 // applyEffect applies a single TechEffect and returns a description message.
 func applyEffect(
 	sess *session.PlayerSession,
@@ -335,24 +344,20 @@ func applyEffect(
 				rolls[i] = rollAmount(e.Dice, e.Amount, src)
 				total += rolls[i]
 			}
-			target.CurrentHP -= total
-			if target.CurrentHP < 0 {
-				target.CurrentHP = 0
-			}
+			adjusted := combat.AdjustDamage(cbt, target, e.DamageType, total, "tech")
+			target.ApplyDamage(adjusted.Final)
 			// #350: surface per-projectile rolls so players see the volley variance,
 			// not just the lump sum.
 			parts := make([]string, len(rolls))
 			for i, r := range rolls {
 				parts[i] = strconv.Itoa(r)
 			}
-			return fmt.Sprintf("%d %s damage from %d projectiles (%s).", total, e.DamageType, shots, strings.Join(parts, "+"))
+			return fmt.Sprintf("%d %s damage from %d projectiles (%s)%s.", adjusted.Final, e.DamageType, shots, strings.Join(parts, "+"), techAdjustmentNote(adjusted))
 		}
 		dmg := rollAmount(e.Dice, e.Amount, src)
-		target.CurrentHP -= dmg
-		if target.CurrentHP < 0 {
-			target.CurrentHP = 0
-		}
-		return fmt.Sprintf("%d %s damage.", dmg, e.DamageType)
+		adjusted := combat.AdjustDamage(cbt, target, e.DamageType, dmg, "tech")
+		target.ApplyDamage(adjusted.Final)
+		return fmt.Sprintf("%d %s damage%s.", adjusted.Final, e.DamageType, techAdjustmentNote(adjusted))
 
 	case technology.EffectHeal:
 		heal := rollAmount(e.Dice, e.Amount, src)
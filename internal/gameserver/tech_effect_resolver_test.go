@@ -102,6 +102,28 @@ func TestResolveTechEffects_REQ_TER7_DamageReducesHP(t *testing.T) {
 	assert.Less(t, target.CurrentHP, 5, "HP should be reduced")
 }
 
+// Tech damage is adjusted by the target's damage matrix and annotated.
+func TestResolveTechEffects_DamageRespectsResistanceAndImmunity(t *testing.T) {
+	sess := &session.PlayerSession{UID: "p1"}
+	tech := makeAttackTech(
+		[]technology.TechEffect{{Type: technology.EffectDamage, Amount: 6, DamageType: "acid"}},
+		nil,
+	)
+	src := &deterministicSrc{val: 10} // roll=11 vs AC=1 → hit
+
+	resistant := makeTarget("npc1", 30, 30, 1)
+	resistant.Resistances = map[string]int{"acid": 2}
+	msgs := ResolveTechEffects(sess, tech, []*combat.Combatant{resistant}, nil, nil, src, nil)
+	assert.Equal(t, 26, resistant.CurrentHP)
+	assert.Contains(t, strings.Join(msgs, " "), "(resisted 2)")
+
+	immune := makeTarget("npc2", 30, 30, 1)
+	immune.Immunities = []string{"acid"}
+	msgs = ResolveTechEffects(sess, tech, []*combat.Combatant{immune}, nil, nil, src, nil)
+	assert.Equal(t, 30, immune.CurrentHP)
+	assert.Contains(t, strings.Join(msgs, " "), "(immune to acid)")
+}
+
 // REQ-TER8: Heal effect — sess.CurrentHP increases; never above MaxHP.
 func TestResolveTechEffects_REQ_TER8_HealIncreasesHP(t *testing.T) {
 	sess := &session.PlayerSession{UID: "p1"}
@@ -166,10 +166,10 @@ func TestHandleTeleport_NonAdminDenied(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	resp, err := svc.handleTeleport("u1", &gamev1.TeleportRequest{
+	resp, err := svc.dispatch("u1", &gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Teleport{Teleport: &gamev1.TeleportRequest{
 		TargetCharacter: "Someone",
 		RoomId:          "room_b",
-	})
+	}}})
 	require.NoError(t, err)
 	errEvt := resp.GetError()
 	require.NotNil(t, errEvt)
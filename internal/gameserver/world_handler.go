@@ -2,13 +2,19 @@ package gameserver
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 
 	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
 
+	"github.com/cory-johannsen/mud/internal/game/combat"
 	"github.com/cory-johannsen/mud/internal/game/condition"
+	"github.com/cory-johannsen/mud/internal/game/clan"
+	"github.com/cory-johannsen/mud/internal/game/housing"
 	"github.com/cory-johannsen/mud/internal/game/inventory"
 	"github.com/cory-johannsen/mud/internal/game/npc"
 	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/vehicle"
 	"github.com/cory-johannsen/mud/internal/game/world"
 )
 
@@ -21,6 +27,37 @@ type WorldHandler struct {
 	roomEquipMgr *inventory.RoomEquipmentManager
 	invRegistry  *inventory.Registry
 	combatH      *CombatHandler
+	housing      *housing.Registry
+	clans        *clan.Registry
+	vehicles     *vehicle.Manager
+
+	// scenes holds GM-set room descriptions that replace a room's own until cleared.
+	scenesMu sync.RWMutex
+	scenes   map[string]string
+}
+
+// SetHousing wires in the lease registry so rented rooms show their owner's description.
+//
+// Precondition: none (may be nil to disable housing descriptions).
+// Postcondition: h.housing is set to reg.
+func (h *WorldHandler) SetHousing(reg *housing.Registry) {
+	h.housing = reg
+}
+
+// SetClans wires in the clan registry so room presence lists show clan tags.
+//
+// Precondition: none (may be nil to omit clan tags).
+// Postcondition: h.clans is set to reg.
+func (h *WorldHandler) SetClans(reg *clan.Registry) {
+	h.clans = reg
+}
+
+// SetVehicles wires in the vehicle manager so room views list parked vehicles.
+//
+// Precondition: none (may be nil to omit vehicles).
+// Postcondition: h.vehicles is set to mgr.
+func (h *WorldHandler) SetVehicles(mgr *vehicle.Manager) {
+	h.vehicles = mgr
 }
 
 // SetCombatHandler wires in the CombatHandler for NPC combat status lookup.
@@ -31,6 +68,44 @@ func (h *WorldHandler) SetCombatHandler(combatH *CombatHandler) {
 	h.combatH = combatH
 }
 
+// SetScene replaces roomID's description with text until ClearScene is
+// called or the server restarts.
+//
+// Precondition: roomID and text must be non-empty.
+// Postcondition: Room views of roomID show text as the description.
+func (h *WorldHandler) SetScene(roomID, text string) {
+	h.scenesMu.Lock()
+	defer h.scenesMu.Unlock()
+	if h.scenes == nil {
+		h.scenes = make(map[string]string)
+	}
+	h.scenes[roomID] = text
+}
+
+// ClearScene restores roomID's own description.
+//
+// Postcondition: Returns false when roomID had no scene description.
+func (h *WorldHandler) ClearScene(roomID string) bool {
+	h.scenesMu.Lock()
+	defer h.scenesMu.Unlock()
+	if _, ok := h.scenes[roomID]; !ok {
+		return false
+	}
+	delete(h.scenes, roomID)
+	return true
+}
+
+// roomDescription returns the description room views show for room: the GM
+// scene description when one is set, otherwise the room's own.
+func (h *WorldHandler) roomDescription(room *world.Room) string {
+	h.scenesMu.RLock()
+	defer h.scenesMu.RUnlock()
+	if scene, ok := h.scenes[room.ID]; ok {
+		return scene
+	}
+	return room.Description
+}
+
 // NewWorldHandler creates a WorldHandler with the given dependencies.
 //
 // Precondition: worldMgr, sessMgr, and npcMgr must be non-nil. clock, roomEquipMgr, and invRegistry may be nil.
@@ -122,6 +197,80 @@ func (h *WorldHandler) Look(uid string) (*gamev1.RoomView, error) {
 	return h.buildRoomView(uid, room), nil
 }
 
+// LookDirection peeks through the player's exit in direction at the adjacent room.
+// Hidden exits are treated as absent, locked exits block the view, and an outdoor
+// room cannot be made out during a dark period.
+//
+// Precondition: uid must be a valid connected player; direction is a full direction name.
+// Postcondition: Returns a RoomPeekView when the room can be seen; otherwise view is nil
+// and blocked is a message telling the player why.
+func (h *WorldHandler) LookDirection(uid, direction string) (view *gamev1.RoomPeekView, blocked string, err error) {
+	sess, ok := h.sessions.GetPlayer(uid)
+	if !ok {
+		return nil, "", fmt.Errorf("player %q not found", uid)
+	}
+	room, ok := h.world.GetRoom(sess.RoomID)
+	if !ok {
+		return nil, "", fmt.Errorf("room %q not found", sess.RoomID)
+	}
+
+	var exit *world.Exit
+	for i := range room.Exits {
+		if !room.Exits[i].Hidden && strings.EqualFold(string(room.Exits[i].Direction), direction) {
+			exit = &room.Exits[i]
+			break
+		}
+	}
+	if exit == nil {
+		return nil, fmt.Sprintf("You see nothing of interest to the %s.", direction), nil
+	}
+	if exit.Locked {
+		return nil, fmt.Sprintf("The way %s is shut; you can't see past it.", exit.Direction), nil
+	}
+	target, ok := h.world.GetRoom(exit.TargetRoom)
+	if !ok {
+		return nil, fmt.Sprintf("You see nothing of interest to the %s.", direction), nil
+	}
+	if h.clock != nil && IsDarkPeriod(h.clock.CurrentHour().Period()) && target.Properties["outdoor"] == "true" {
+		return nil, fmt.Sprintf("It is too dark to make out anything to the %s.", exit.Direction), nil
+	}
+
+	view = &gamev1.RoomPeekView{
+		Direction:   string(exit.Direction),
+		Title:       target.Title,
+		Description: briefDescription(h.roomDescription(target)),
+	}
+	for _, p := range h.sessions.VisiblePlayersInRoom(target.ID, uid) {
+		view.Players = append(view.Players, p.CharName)
+	}
+	for _, inst := range h.npcMgr.InstancesInRoom(target.ID) {
+		if inst.IsDead() {
+			continue
+		}
+		view.Npcs = append(view.Npcs, &gamev1.NpcInfo{
+			InstanceId:        inst.ID,
+			Name:              inst.Name(),
+			HealthDescription: combat.HealthLabel(inst.CurrentHP, inst.MaxHP, sess.Settings.AccessibleMode),
+			NpcType:           inst.NPCType,
+		})
+	}
+	return view, "", nil
+}
+
+// briefDescription returns the first sentence of a room description.
+func briefDescription(desc string) string {
+	desc = strings.TrimSpace(desc)
+	if i := strings.IndexByte(desc, '\n'); i >= 0 {
+		desc = desc[:i]
+	}
+	for _, end := range []string{". ", "! ", "? "} {
+		if i := strings.Index(desc, end); i >= 0 {
+			desc = desc[:i+1]
+		}
+	}
+	return desc
+}
+
 // Exits returns the list of exits from the player's current room.
 //
 // Precondition: uid must be a valid connected player.
@@ -158,15 +307,28 @@ func (h *WorldHandler) Exits(uid string) (*gamev1.ExitList, error) {
 // from the players list and including live NPC instances in the room.
 func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomView {
 	sess, _ := h.sessions.GetPlayer(uid)
+	// Accessible mode shows hit points as numbers instead of wound descriptors.
+	numericHealth := sess != nil && sess.Settings.AccessibleMode
+	// A dark room hides its description, NPC names, and floor items unless
+	// the player carries a lit light source.
+	dark := h.isDarkFor(sess, room)
 	var otherPlayers []string
-	for _, p := range h.sessions.PlayersInRoomDetails(room.ID) {
+	for _, p := range h.sessions.VisiblePlayersInRoom(room.ID, uid) {
 		if sess != nil && p.CharName == sess.CharName {
 			continue
 		}
 		name := p.CharName
+		if h.clans != nil {
+			if tag := h.clans.TagFor(p.CharacterID); tag != "" {
+				name = "[" + tag + "] " + name
+			}
+		}
 		if p.Conditions != nil && condition.IsTargetingPrevented(p.Conditions) {
 			name = name + " (detained)"
 		}
+		if p.CurrentHP < p.MaxHP {
+			name = name + " (" + combat.HealthLabel(p.CurrentHP, p.MaxHP, numericHealth) + ")"
+		}
 		otherPlayers = append(otherPlayers, name)
 	}
 
@@ -188,6 +350,10 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 	instances := h.npcMgr.InstancesInRoom(room.ID)
 	npcInfos := make([]*gamev1.NpcInfo, 0, len(instances))
 	for _, inst := range instances {
+		if dark && !inst.IsDead() {
+			npcInfos = append(npcInfos, &gamev1.NpcInfo{Name: "a shadowy figure"})
+			continue
+		}
 		if !inst.IsDead() {
 			// DETECT-16/17/18: per-recipient detection redaction. Drop
 			// Unnoticed NPCs from the recipient's view entirely; replace
@@ -217,6 +383,12 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 					}
 				}
 			}
+			if inst.Surrendered {
+				condNames = append(condNames, "surrendered")
+			}
+			if inst.CaptorUID != "" {
+				condNames = append(condNames, "restrained")
+			}
 			tradition := ""
 			if inst.NPCType == "tech_trainer" {
 				if tmpl := h.npcMgr.TemplateByID(inst.TemplateID); tmpl != nil && tmpl.TechTrainer != nil {
@@ -230,7 +402,7 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 			npcInfos = append(npcInfos, &gamev1.NpcInfo{
 				InstanceId:        inst.ID,
 				Name:              displayName,
-				HealthDescription: inst.HealthDescription(),
+				HealthDescription: combat.HealthLabel(inst.CurrentHP, inst.MaxHP, numericHealth),
 				FightingTarget:    fightingTarget,
 				Conditions:        condNames,
 				NpcType:           inst.NPCType,
@@ -252,13 +424,24 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 		exitInfos = nil
 	}
 
-	description := room.Description
+	description := h.roomDescription(room)
+	if h.housing != nil {
+		if lease, ok := h.housing.Get(room.ID); ok {
+			if lease.Description != "" {
+				description = lease.Description
+			}
+			description = fmt.Sprintf("%s\nThis is %s's home.", description, lease.OwnerName)
+		}
+	}
 	if flavor := FlavorText(period, isOutdoor); flavor != "" {
 		description = description + " " + flavor
 	}
+	if dark {
+		description = "It is too dark to see much here. You'll need a light."
+	}
 
 	var equipInfos []*gamev1.RoomEquipmentItem
-	if h.roomEquipMgr != nil {
+	if h.roomEquipMgr != nil && !dark {
 		for _, eq := range h.roomEquipMgr.EquipmentInRoom(room.ID) {
 			name := eq.Description
 			if name == "" {
@@ -280,6 +463,20 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 		}
 	}
 
+	var vehicleInfos []*gamev1.VehicleInfo
+	if h.vehicles != nil && !dark {
+		for _, v := range h.vehicles.InRoom(room.ID) {
+			vehicleInfos = append(vehicleInfos, &gamev1.VehicleInfo{
+				InstanceId: v.ID,
+				Name:       v.Name,
+				Kind:       v.Kind,
+				Condition:  v.Condition(),
+				Passengers: int32(len(v.Passengers)),
+				Seats:      int32(v.Seats),
+			})
+		}
+	}
+
 	var zoneName string
 	if zone, ok := h.world.GetZone(room.ZoneID); ok {
 		zoneName = zone.Name
@@ -298,6 +495,15 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 		}
 	}
 
+	var combatRound int
+	var combatants, roomEffects []string
+	if h.combatH != nil {
+		combatRound, combatants = h.combatH.CombatSummary(room.ID)
+		for _, e := range h.combatH.RoomEffects(room.ID) {
+			roomEffects = append(roomEffects, e.Label())
+		}
+	}
+
 	return &gamev1.RoomView{
 		RoomId:           room.ID,
 		Title:            room.Title,
@@ -310,5 +516,28 @@ func (h *WorldHandler) buildRoomView(uid string, room *world.Room) *gamev1.RoomV
 		Equipment:        equipInfos,
 		ZoneName:         zoneName,
 		ActiveConditions: activeConditions,
+		Dark:             dark,
+		Vehicles:         vehicleInfos,
+		CombatRound:      int32(combatRound),
+		Combatants:       combatants,
+		RoomEffects:      roomEffects,
+	}
+}
+
+// isDarkFor reports whether room is too dark for the player in sess to see.
+// A room is dark when its light level, after the day/night modifier, is
+// LightDark and the player has no lit light source with fuel left.
+//
+// Precondition: room must be non-nil; sess may be nil.
+// Postcondition: Returns false whenever the room is dim or brighter.
+func (h *WorldHandler) isDarkFor(sess *session.PlayerSession, room *world.Room) bool {
+	darkPeriod := h.clock != nil && IsDarkPeriod(h.clock.CurrentHour().Period())
+	if room.Light(darkPeriod) > world.LightDark {
+		return false
+	}
+	if sess == nil {
+		return true
 	}
+	_, _, lit := litLightSource(sess, h.invRegistry)
+	return !lit
 }
@@ -482,3 +482,111 @@ func TestProperty_BuildRoomView_HourAlwaysInRange(t *testing.T) {
 		assert.Equal(rt, startHour, view.Hour, "RoomView.Hour must equal the clock's startHour")
 	})
 }
+
+func TestWorldHandler_Look_HealthDescriptors(t *testing.T) {
+	worldMgr, sessMgr := testWorldAndSession(t)
+	npcMgr := npc.NewManager()
+	h := NewWorldHandler(worldMgr, sessMgr, npcMgr, nil, nil, nil)
+
+	for _, p := range []struct {
+		uid string
+		hp  int
+	}{{"u1", 20}, {"u2", 5}, {"u3", 20}} {
+		_, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+			UID: p.uid, Username: p.uid, CharName: strings.ToUpper(p.uid), RoomID: "room_a",
+			CurrentHP: p.hp, MaxHP: 20, Abilities: character.AbilityScores{}, Role: "player",
+		})
+		require.NoError(t, err)
+	}
+	inst, err := npcMgr.Spawn(&npc.Template{ID: "ganger", Name: "Ganger", Level: 1, MaxHP: 20, AC: 12}, "room_a")
+	require.NoError(t, err)
+	inst.CurrentHP = 3
+
+	view, err := h.Look("u1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"U2 (bloodied)", "U3"}, view.Players)
+	require.Len(t, view.Npcs, 1)
+	assert.Equal(t, "near death", view.Npcs[0].HealthDescription)
+
+	u1, ok := sessMgr.GetPlayer("u1")
+	require.True(t, ok)
+	u1.Settings.AccessibleMode = true
+	view, err = h.Look("u1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"U2 (5/20 HP)", "U3"}, view.Players)
+	assert.Equal(t, "3/20 HP", view.Npcs[0].HealthDescription)
+}
+
+// lookDirectionWorld builds room_a with an open north exit to room_b, a locked
+// east exit to room_c, and a hidden west exit to room_d, and puts player u1 in room_a.
+func lookDirectionWorld(t *testing.T, clock *GameClock) (*WorldHandler, *npc.Manager) {
+	t.Helper()
+	rooms := map[string]*world.Room{
+		"room_a": {ID: "room_a", ZoneID: "test", Title: "Room A", Description: "The first room.", Exits: []world.Exit{
+			{Direction: world.North, TargetRoom: "room_b"},
+			{Direction: world.East, TargetRoom: "room_c", Locked: true},
+			{Direction: world.West, TargetRoom: "room_d", Hidden: true},
+		}},
+		"room_b": {ID: "room_b", ZoneID: "test", Title: "Rust Alley",
+			Description: "A narrow alley choked with scrap. Rain drips from broken gutters.",
+			Properties:  map[string]string{"outdoor": "true"}},
+		"room_c": {ID: "room_c", ZoneID: "test", Title: "Vault", Description: "A vault."},
+		"room_d": {ID: "room_d", ZoneID: "test", Title: "Den", Description: "A den."},
+	}
+	worldMgr, err := world.NewManager([]*world.Zone{{ID: "test", Name: "Test", StartRoom: "room_a", Rooms: rooms}})
+	require.NoError(t, err)
+	sessMgr := session.NewManager()
+	npcMgr := npc.NewManager()
+	for _, p := range []struct{ uid, room string }{{"u1", "room_a"}, {"u2", "room_b"}} {
+		_, err := sessMgr.AddPlayer(session.AddPlayerOptions{
+			UID: p.uid, Username: p.uid, CharName: strings.ToUpper(p.uid), RoomID: p.room,
+			CurrentHP: 10, MaxHP: 10, Abilities: character.AbilityScores{}, Role: "player",
+		})
+		require.NoError(t, err)
+	}
+	return NewWorldHandler(worldMgr, sessMgr, npcMgr, clock, nil, nil), npcMgr
+}
+
+func TestWorldHandler_LookDirection_ShowsAdjacentRoom(t *testing.T) {
+	h, npcMgr := lookDirectionWorld(t, NewGameClock(12, time.Hour*24))
+	_, err := npcMgr.Spawn(&npc.Template{ID: "ganger", Name: "Ganger", Level: 1, MaxHP: 10, AC: 12}, "room_b")
+	require.NoError(t, err)
+
+	view, blocked, err := h.LookDirection("u1", "north")
+	require.NoError(t, err)
+	require.NotNil(t, view, blocked)
+	assert.Equal(t, "north", view.Direction)
+	assert.Equal(t, "Rust Alley", view.Title)
+	assert.Equal(t, "A narrow alley choked with scrap.", view.Description)
+	assert.Equal(t, []string{"U2"}, view.Players)
+	require.Len(t, view.Npcs, 1)
+	assert.Equal(t, "Ganger", view.Npcs[0].Name)
+	assert.Equal(t, "unharmed", view.Npcs[0].HealthDescription)
+}
+
+func TestWorldHandler_LookDirection_Blocked(t *testing.T) {
+	h, _ := lookDirectionWorld(t, NewGameClock(12, time.Hour*24))
+	for dir, want := range map[string]string{
+		"east":  "The way east is shut; you can't see past it.",
+		"west":  "You see nothing of interest to the west.",
+		"south": "You see nothing of interest to the south.",
+	} {
+		view, blocked, err := h.LookDirection("u1", dir)
+		require.NoError(t, err)
+		assert.Nil(t, view, dir)
+		assert.Equal(t, want, blocked)
+	}
+
+	dark, _ := lookDirectionWorld(t, NewGameClock(0, time.Hour*24))
+	view, blocked, err := dark.LookDirection("u1", "north")
+	require.NoError(t, err)
+	assert.Nil(t, view)
+	assert.Equal(t, "It is too dark to make out anything to the north.", blocked)
+}
+
+func TestBriefDescription(t *testing.T) {
+	assert.Equal(t, "One.", briefDescription("One. Two."))
+	assert.Equal(t, "Stop!", briefDescription("Stop! Go. Now."))
+	assert.Equal(t, "First line", briefDescription("First line\nSecond line."))
+	assert.Equal(t, "No end", briefDescription("  No end  "))
+}
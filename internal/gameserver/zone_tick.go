@@ -14,6 +14,8 @@ type ZoneTickManager struct {
 	interval time.Duration
 	mu       sync.Mutex
 	ticks    map[string]func()
+	// reset carries interval changes to the running tick loop.
+	reset chan time.Duration
 }
 
 // NewZoneTickManager returns a manager that fires ticks every interval.
@@ -26,6 +28,7 @@ func NewZoneTickManager(interval time.Duration) *ZoneTickManager {
 	return &ZoneTickManager{
 		interval: interval,
 		ticks:    make(map[string]func()),
+		reset:    make(chan time.Duration, 1),
 	}
 }
 
@@ -43,17 +46,45 @@ func (z *ZoneTickManager) Unregister(zoneID string) {
 	delete(z.ticks, zoneID)
 }
 
+// Interval returns the current tick interval.
+func (z *ZoneTickManager) Interval() time.Duration {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.interval
+}
+
+// SetInterval changes the tick interval, taking effect from the next tick of
+// a running loop.
+//
+// Precondition: interval must be > 0.
+func (z *ZoneTickManager) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	z.interval = interval
+	// Replace any change the loop has not picked up yet.
+	select {
+	case <-z.reset:
+	default:
+	}
+	z.reset <- interval
+}
+
 // Start begins the tick loop. Runs until ctx is cancelled.
 //
 // Postcondition: all registered tick callbacks are invoked once per interval.
 func (z *ZoneTickManager) Start(ctx context.Context) {
 	go func() {
-		ticker := time.NewTicker(z.interval)
+		ticker := time.NewTicker(z.Interval())
 		defer ticker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
 				return
+			case d := <-z.reset:
+				ticker.Reset(d)
 			case <-ticker.C:
 				z.mu.Lock()
 				callbacks := make(map[string]func(), len(z.ticks))
@@ -54,3 +54,33 @@ func TestZoneTickManager_UnregisterStopsCallback(t *testing.T) {
 		t.Fatalf("tick continued after unregister: before=%d after=%d", countAfterUnregister, count.Load())
 	}
 }
+
+func TestZoneTickManager_SetIntervalRetimesRunningLoop(t *testing.T) {
+	zm := gameserver.NewZoneTickManager(time.Hour)
+	called := make(chan struct{}, 1)
+	zm.RegisterTick("zone1", func() {
+		select {
+		case called <- struct{}{}:
+		default:
+		}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	zm.Start(ctx)
+
+	zm.SetInterval(20 * time.Millisecond)
+	if got := zm.Interval(); got != 20*time.Millisecond {
+		t.Fatalf("Interval() = %v, want 20ms", got)
+	}
+	select {
+	case <-called:
+		// success
+	case <-ctx.Done():
+		t.Fatal("tick callback not invoked at the new interval")
+	}
+
+	zm.SetInterval(0)
+	if got := zm.Interval(); got != 20*time.Millisecond {
+		t.Fatalf("SetInterval(0) changed the interval to %v", got)
+	}
+}
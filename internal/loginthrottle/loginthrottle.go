@@ -0,0 +1,226 @@
+// Package loginthrottle locks out accounts and source addresses after repeated
+// failed logins, doubling each lockout up to a cap. Failure records live in a
+// Store so a restart does not reset them.
+package loginthrottle
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/config"
+)
+
+// Record is the failure history of one key.
+type Record struct {
+	Key string
+	// Failures counts failed logins since the count last started over.
+	Failures int
+	// LockedUntil is when the current lockout ends; zero when never locked.
+	LockedUntil time.Time
+	// LastFailureAt is when the most recent failure happened.
+	LastFailureAt time.Time
+}
+
+// Store persists failure records.
+type Store interface {
+	// Load returns the record for key, or a zero Record carrying key when none exists.
+	Load(ctx context.Context, key string) (Record, error)
+	Save(ctx context.Context, rec Record) error
+	Clear(ctx context.Context, key string) error
+}
+
+// AccountKey returns the record key for username. Usernames are matched case-insensitively.
+func AccountKey(username string) string {
+	return "account:" + strings.ToLower(username)
+}
+
+// IPKey returns the record key for the host part of addr.
+//
+// Postcondition: Returns "" when addr is nil.
+func IPKey(addr net.Addr) string {
+	if addr == nil {
+		return ""
+	}
+	return HostKey(addr.String())
+}
+
+// HostKey returns the record key for the host part of a "host:port" string,
+// such as http.Request.RemoteAddr. A string without a port is used whole.
+//
+// Postcondition: Returns "" when hostport is empty.
+func HostKey(hostport string) string {
+	if hostport == "" {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+	return "ip:" + host
+}
+
+// Throttle applies a LoginThrottleConfig to a Store. A nil Throttle never
+// locks anything out. It is safe for concurrent use.
+type Throttle struct {
+	// mu serializes read-modify-write cycles against the store.
+	mu    sync.Mutex
+	cfg   config.LoginThrottleConfig
+	store Store
+}
+
+// New returns a throttle for cfg backed by store.
+//
+// Precondition: cfg must have passed config validation.
+// Postcondition: Returns nil when cfg disables throttling; a nil store keeps
+// records in memory only.
+func New(cfg config.LoginThrottleConfig, store Store) *Throttle {
+	if cfg.BaseLockout <= 0 {
+		return nil
+	}
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Throttle{cfg: cfg, store: store}
+}
+
+// Lockout returns how long a key with free attempts is locked out after its
+// failures-th consecutive failure.
+//
+// Postcondition: Returns 0 while failures <= free; otherwise BaseLockout
+// doubled once per further failure, capped at MaxLockout.
+func Lockout(cfg config.LoginThrottleConfig, free, failures int) time.Duration {
+	if failures <= free || cfg.BaseLockout <= 0 {
+		return 0
+	}
+	d := cfg.BaseLockout
+	for i := free + 1; i < failures && d < cfg.MaxLockout; i++ {
+		d *= 2
+	}
+	return min(d, max(cfg.MaxLockout, cfg.BaseLockout))
+}
+
+// Locked returns how much longer username or addrKey is locked out at now.
+// Either key may be empty.
+//
+// Postcondition: Returns 0 when neither key is locked.
+func (t *Throttle) Locked(ctx context.Context, username, addrKey string, now time.Time) (time.Duration, error) {
+	if t == nil {
+		return 0, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var wait time.Duration
+	for _, key := range t.keys(username, addrKey) {
+		rec, err := t.store.Load(ctx, key)
+		if err != nil {
+			return 0, fmt.Errorf("loading login failures for %s: %w", key, err)
+		}
+		wait = max(wait, rec.LockedUntil.Sub(now))
+	}
+	return wait, nil
+}
+
+// Fail records a failed login for username from addrKey at now. Either key may be empty.
+//
+// Postcondition: Returns the lockout the failure started, or 0 when none did.
+func (t *Throttle) Fail(ctx context.Context, username, addrKey string, now time.Time) (time.Duration, error) {
+	if t == nil {
+		return 0, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var wait time.Duration
+	for _, key := range t.keys(username, addrKey) {
+		rec, err := t.store.Load(ctx, key)
+		if err != nil {
+			return 0, fmt.Errorf("loading login failures for %s: %w", key, err)
+		}
+		if now.Sub(rec.LastFailureAt) > t.cfg.ResetAfter {
+			rec.Failures = 0
+		}
+		rec.Key = key
+		rec.Failures++
+		rec.LastFailureAt = now
+		free := t.cfg.AccountFreeAttempts
+		if key == addrKey {
+			free = t.cfg.IPFreeAttempts
+		}
+		if d := Lockout(t.cfg, free, rec.Failures); d > 0 {
+			rec.LockedUntil = now.Add(d)
+			wait = max(wait, d)
+		}
+		if err := t.store.Save(ctx, rec); err != nil {
+			return 0, fmt.Errorf("saving login failures for %s: %w", key, err)
+		}
+	}
+	return wait, nil
+}
+
+// Succeed forgets username's failures after a successful login. Address
+// records are left to expire so one good account cannot clear an address
+// that is guessing at others.
+func (t *Throttle) Succeed(ctx context.Context, username string) error {
+	if t == nil || username == "" {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := AccountKey(username)
+	if err := t.store.Clear(ctx, key); err != nil {
+		return fmt.Errorf("clearing login failures for %s: %w", key, err)
+	}
+	return nil
+}
+
+// keys returns the non-empty record keys for username and addrKey.
+func (t *Throttle) keys(username, addrKey string) []string {
+	keys := make([]string, 0, 2)
+	if username != "" {
+		keys = append(keys, AccountKey(username))
+	}
+	if addrKey != "" {
+		keys = append(keys, addrKey)
+	}
+	return keys
+}
+
+// MemoryStore is a Store that keeps records in process memory.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]Record)}
+}
+
+// Load implements Store.
+func (m *MemoryStore) Load(_ context.Context, key string) (Record, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if rec, ok := m.records[key]; ok {
+		return rec, nil
+	}
+	return Record{Key: key}, nil
+}
+
+// Save implements Store.
+func (m *MemoryStore) Save(_ context.Context, rec Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[rec.Key] = rec
+	return nil
+}
+
+// Clear implements Store.
+func (m *MemoryStore) Clear(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, key)
+	return nil
+}
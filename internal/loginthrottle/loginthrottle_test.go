@@ -0,0 +1,162 @@
+package loginthrottle
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/config"
+)
+
+func testConfig() config.LoginThrottleConfig {
+	return config.LoginThrottleConfig{
+		AccountFreeAttempts: 3,
+		IPFreeAttempts:      5,
+		BaseLockout:         5 * time.Second,
+		MaxLockout:          time.Minute,
+		ResetAfter:          time.Hour,
+	}
+}
+
+func TestNew_DisabledReturnsNilThatNeverLocks(t *testing.T) {
+	th := New(config.LoginThrottleConfig{}, nil)
+	require.Nil(t, th)
+	ctx := context.Background()
+	wait, err := th.Fail(ctx, "ace", "ip:1.2.3.4", time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	wait, err = th.Locked(ctx, "ace", "ip:1.2.3.4", time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	assert.NoError(t, th.Succeed(ctx, "ace"))
+}
+
+func TestIPKey(t *testing.T) {
+	assert.Equal(t, "ip:10.0.0.7", IPKey(&net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 5555}))
+	assert.Equal(t, "ip:::1", IPKey(&net.TCPAddr{IP: net.ParseIP("::1"), Port: 5555}))
+	assert.Empty(t, IPKey(nil))
+}
+
+func TestHostKey(t *testing.T) {
+	assert.Equal(t, "ip:10.0.0.7", HostKey("10.0.0.7:5555"))
+	assert.Equal(t, "ip:::1", HostKey("[::1]:5555"))
+	assert.Equal(t, "ip:10.0.0.7", HostKey("10.0.0.7"))
+	assert.Empty(t, HostKey(""))
+}
+
+func TestLockout(t *testing.T) {
+	cfg := testConfig()
+	assert.Zero(t, Lockout(cfg, 3, 3))
+	assert.Equal(t, 5*time.Second, Lockout(cfg, 3, 4))
+	assert.Equal(t, 10*time.Second, Lockout(cfg, 3, 5))
+	assert.Equal(t, 40*time.Second, Lockout(cfg, 3, 7))
+	assert.Equal(t, time.Minute, Lockout(cfg, 3, 8))
+	assert.Equal(t, time.Minute, Lockout(cfg, 3, 1000))
+}
+
+func TestThrottle_LocksAccountAfterFreeAttempts(t *testing.T) {
+	th := New(testConfig(), nil)
+	ctx := context.Background()
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		wait, err := th.Fail(ctx, "Ace", "", now)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+	wait, err := th.Fail(ctx, "ace", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, wait)
+
+	wait, err = th.Locked(ctx, "ACE", "", now.Add(2*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Second, wait, "usernames match case-insensitively")
+	wait, err = th.Locked(ctx, "ace", "", now.Add(6*time.Second))
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	wait, err = th.Fail(ctx, "ace", "", now.Add(6*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, wait, "each further failure doubles the lockout")
+
+	require.NoError(t, th.Succeed(ctx, "ace"))
+	wait, err = th.Locked(ctx, "ace", "", now.Add(7*time.Second))
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestThrottle_AddressLockSurvivesSuccess(t *testing.T) {
+	th := New(testConfig(), nil)
+	ctx := context.Background()
+	now := time.Now()
+	const addr = "ip:10.0.0.7"
+
+	for i := 0; i < 6; i++ {
+		_, err := th.Fail(ctx, "", addr, now)
+		require.NoError(t, err)
+	}
+	require.NoError(t, th.Succeed(ctx, "someone"))
+	wait, err := th.Locked(ctx, "someone", addr, now)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, wait)
+}
+
+func TestThrottle_FailuresResetAfterQuietPeriod(t *testing.T) {
+	th := New(testConfig(), nil)
+	ctx := context.Background()
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		_, err := th.Fail(ctx, "ace", "", now)
+		require.NoError(t, err)
+	}
+	wait, err := th.Fail(ctx, "ace", "", now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, wait, "the count starts over after reset_after")
+}
+
+func TestThrottle_RecordsSurviveNewThrottle(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := New(testConfig(), store).Fail(ctx, "ace", "", now)
+		require.NoError(t, err)
+	}
+	wait, err := New(testConfig(), store).Locked(ctx, "ace", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, wait)
+}
+
+// TestProperty_Lockout_MonotoneAndCapped verifies that lockouts never shrink as
+// failures accumulate and never exceed MaxLockout.
+func TestProperty_Lockout_MonotoneAndCapped(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		base := time.Duration(rapid.IntRange(1, 600).Draw(rt, "base_s")) * time.Second
+		cfg := config.LoginThrottleConfig{
+			BaseLockout: base,
+			MaxLockout:  base * time.Duration(rapid.IntRange(1, 1000).Draw(rt, "max_mult")),
+			ResetAfter:  time.Hour,
+		}
+		free := rapid.IntRange(0, 10).Draw(rt, "free")
+		prev := time.Duration(0)
+		for n := 0; n <= free+80; n++ {
+			d := Lockout(cfg, free, n)
+			if d < prev {
+				rt.Fatalf("lockout shrank from %v to %v at %d failures", prev, d, n)
+			}
+			if d > cfg.MaxLockout {
+				rt.Fatalf("lockout %v exceeds max %v", d, cfg.MaxLockout)
+			}
+			if (n > free) != (d > 0) {
+				rt.Fatalf("lockout %v at %d failures with %d free", d, n, free)
+			}
+			prev = d
+		}
+	})
+}
@@ -0,0 +1,213 @@
+package observability
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SlowRequestThreshold is the latency above which a request or stream message
+// is logged at warn rather than debug level.
+const SlowRequestThreshold = 250 * time.Millisecond
+
+// errorPayload is the oneof field name of a reply reporting a failed request.
+const errorPayload = "error"
+
+// UnaryRecoveryInterceptor converts a panic in a unary handler into a
+// codes.Internal error.
+//
+// Postcondition: The panic and its stack are logged at error level.
+func UnaryRecoveryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logger, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecoveryInterceptor converts a panic in a stream handler into a
+// codes.Internal error, ending that stream instead of the process.
+//
+// Postcondition: The panic and its stack are logged at error level.
+func StreamRecoveryInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logger, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// recovered logs a recovered panic and returns the error reported to the client.
+func recovered(logger *zap.Logger, method string, r any) error {
+	logger.Error("panic in gRPC handler",
+		zap.String("method", method),
+		zap.Any("panic", r),
+		zap.Stack("stack"),
+	)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// UnaryLoggingInterceptor logs each unary call and records its latency in
+// metrics under the short method name.
+//
+// Precondition: metrics may be nil to skip recording.
+func UnaryLoggingInterceptor(logger *zap.Logger, metrics *Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		d := time.Since(start)
+		metrics.Observe(path.Base(info.FullMethod), d, err != nil)
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.Duration("duration", d),
+			zap.Stringer("code", status.Code(err)),
+		}
+		switch {
+		case err != nil:
+			logger.Warn("gRPC call failed", append(fields, zap.Error(err))...)
+		case d >= SlowRequestThreshold:
+			logger.Warn("slow gRPC call", fields...)
+		default:
+			logger.Debug("gRPC call", fields...)
+		}
+		return resp, err
+	}
+}
+
+// StreamLoggingInterceptor logs each message received on a stream with its
+// request ID and records how long the handler spent on it, from receipt
+// until the handler asks for the next message. Latencies are recorded in
+// metrics as "<method>/<payload>", where payload is the name of the
+// message's populated oneof field. A reply carrying the same request ID and
+// an "error" payload marks the message as failed.
+//
+// Precondition: metrics may be nil to skip recording.
+func StreamLoggingInterceptor(logger *zap.Logger, metrics *Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		wrapped := &observedStream{ServerStream: ss, method: path.Base(info.FullMethod), logger: logger, metrics: metrics}
+		err := handler(srv, wrapped)
+		wrapped.finish(err != nil)
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.Duration("duration", time.Since(start)),
+			zap.Stringer("code", status.Code(err)),
+		}
+		if err != nil {
+			logger.Info("gRPC stream ended", append(fields, zap.Error(err))...)
+		} else {
+			logger.Debug("gRPC stream ended", fields...)
+		}
+		return err
+	}
+}
+
+// observedStream times the handling of each received message. SendMsg may be
+// called from other goroutines, so the in-flight message is guarded by mu.
+type observedStream struct {
+	grpc.ServerStream
+	method  string
+	logger  *zap.Logger
+	metrics *Metrics
+
+	mu       sync.Mutex
+	payload  string
+	reqID    string
+	received time.Time
+	failed   bool
+}
+
+// RecvMsg implements grpc.ServerStream, closing out the previous message.
+func (s *observedStream) RecvMsg(m any) error {
+	s.finish(false)
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.payload = payloadName(m)
+	s.reqID = requestID(m)
+	s.received = time.Now()
+	s.failed = false
+	return nil
+}
+
+// SendMsg implements grpc.ServerStream, noting error replies to the in-flight message.
+func (s *observedStream) SendMsg(m any) error {
+	if payloadName(m) == errorPayload {
+		s.mu.Lock()
+		if !s.received.IsZero() && requestID(m) == s.reqID {
+			s.failed = true
+		}
+		s.mu.Unlock()
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// finish logs and records the in-flight message, if any.
+func (s *observedStream) finish(failed bool) {
+	s.mu.Lock()
+	if s.received.IsZero() {
+		s.mu.Unlock()
+		return
+	}
+	d := time.Since(s.received)
+	failed = failed || s.failed
+	payload, reqID := s.payload, s.reqID
+	s.received = time.Time{}
+	s.mu.Unlock()
+
+	s.metrics.Observe(s.method+"/"+payload, d, failed)
+	fields := []zap.Field{
+		zap.String("method", s.method),
+		zap.String("payload", payload),
+		zap.String("request_id", reqID),
+		zap.Duration("duration", d),
+		zap.Bool("failed", failed),
+	}
+	if d >= SlowRequestThreshold {
+		s.logger.Warn("slow stream message", fields...)
+	} else {
+		s.logger.Debug("stream message", fields...)
+	}
+}
+
+// payloadName returns the name of m's populated oneof field, falling back to
+// the message type name.
+func payloadName(m any) string {
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return "unknown"
+	}
+	r := pm.ProtoReflect()
+	oneofs := r.Descriptor().Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		if od := oneofs.Get(i); !od.IsSynthetic() {
+			if fd := r.WhichOneof(od); fd != nil {
+				return string(fd.Name())
+			}
+		}
+	}
+	return string(r.Descriptor().Name())
+}
+
+// requestID returns m's request ID, or "" when it has none.
+func requestID(m any) string {
+	if r, ok := m.(interface{ GetRequestId() string }); ok {
+		return r.GetRequestId()
+	}
+	return ""
+}
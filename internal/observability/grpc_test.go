@@ -0,0 +1,141 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// fakeStream replays queued ClientMessages and collects sent events.
+type fakeStream struct {
+	grpc.ServerStream
+	in   []*gamev1.ClientMessage
+	sent []any
+}
+
+func (f *fakeStream) Context() context.Context { return context.Background() }
+
+func (f *fakeStream) RecvMsg(m any) error {
+	if len(f.in) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), f.in[0])
+	f.in = f.in[1:]
+	return nil
+}
+
+func (f *fakeStream) SendMsg(m any) error {
+	f.sent = append(f.sent, m)
+	return nil
+}
+
+var sessionInfo = &grpc.StreamServerInfo{FullMethod: "/game.v1.GameService/Session", IsClientStream: true, IsServerStream: true}
+
+func TestStreamRecoveryInterceptor(t *testing.T) {
+	core, logs := observer.New(zap.ErrorLevel)
+	intercept := StreamRecoveryInterceptor(zap.New(core))
+	err := intercept(nil, &fakeStream{}, sessionInfo, func(any, grpc.ServerStream) error {
+		panic("boom")
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "boom", logs.All()[0].ContextMap()["panic"])
+}
+
+func TestUnaryRecoveryInterceptor(t *testing.T) {
+	intercept := UnaryRecoveryInterceptor(zap.NewNop())
+	info := &grpc.UnaryServerInfo{FullMethod: "/game.v1.GameService/AdminKickPlayer"}
+	_, err := intercept(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		var m map[string]int
+		m["x"] = 1
+		return nil, nil
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	resp, err := intercept(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestUnaryLoggingInterceptor_RecordsLatency(t *testing.T) {
+	m := NewMetrics()
+	intercept := UnaryLoggingInterceptor(zap.NewNop(), m)
+	info := &grpc.UnaryServerInfo{FullMethod: "/game.v1.GameService/AdminKickPlayer"}
+	_, _ = intercept(context.Background(), nil, info, func(context.Context, any) (any, error) { return nil, nil })
+	_, _ = intercept(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "no such player")
+	})
+	snap := m.Snapshot()
+	require.Len(t, snap, 1)
+	assert.Equal(t, "AdminKickPlayer", snap[0].Name)
+	assert.Equal(t, int64(2), snap[0].Count)
+	assert.Equal(t, int64(1), snap[0].Errors)
+}
+
+func TestStreamLoggingInterceptor_LogsEachMessage(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	m := NewMetrics()
+	intercept := StreamLoggingInterceptor(zap.New(core), m)
+	ss := &fakeStream{in: []*gamev1.ClientMessage{
+		{RequestId: "r1", Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}},
+		{RequestId: "r2", Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}},
+		{RequestId: "r3", Payload: &gamev1.ClientMessage_Who{Who: &gamev1.WhoRequest{}}},
+	}}
+	// The handler answers r2 with an error and leaves r3 in flight when it fails.
+	handlerErr := errors.New("stream broke")
+	err := intercept(nil, ss, sessionInfo, func(_ any, stream grpc.ServerStream) error {
+		for {
+			var msg gamev1.ClientMessage
+			if err := stream.RecvMsg(&msg); err != nil {
+				return nil
+			}
+			switch msg.GetRequestId() {
+			case "r2":
+				_ = stream.SendMsg(&gamev1.ServerEvent{RequestId: "r2",
+					Payload: &gamev1.ServerEvent_Error{Error: &gamev1.ErrorEvent{Message: "no"}}})
+			case "r3":
+				return handlerErr
+			default:
+				_ = stream.SendMsg(&gamev1.ServerEvent{RequestId: msg.GetRequestId()})
+			}
+		}
+	})
+	require.ErrorIs(t, err, handlerErr)
+	assert.Len(t, ss.sent, 2)
+
+	snap := m.Snapshot()
+	require.Len(t, snap, 2)
+	assert.Equal(t, "Session/look", snap[0].Name)
+	assert.Equal(t, int64(2), snap[0].Count)
+	assert.Equal(t, int64(1), snap[0].Errors)
+	assert.Equal(t, "Session/who", snap[1].Name)
+	assert.Equal(t, int64(1), snap[1].Errors)
+
+	var ids []string
+	for _, e := range logs.FilterMessage("stream message").All() {
+		ids = append(ids, e.ContextMap()["request_id"].(string))
+	}
+	assert.Equal(t, []string{"r1", "r2", "r3"}, ids)
+	assert.Equal(t, 1, logs.FilterMessage("gRPC stream ended").Len())
+}
+
+func TestPayloadName(t *testing.T) {
+	assert.Equal(t, "look", payloadName(&gamev1.ClientMessage{Payload: &gamev1.ClientMessage_Look{Look: &gamev1.LookRequest{}}}))
+	assert.Equal(t, "ClientMessage", payloadName(&gamev1.ClientMessage{}))
+	assert.Equal(t, "unknown", payloadName("not a proto"))
+}
@@ -16,9 +16,20 @@ import (
 // Precondition: cfg.Format must be "json" or "console".
 // Postcondition: Returns a configured zap.Logger or a non-nil error.
 func NewLogger(cfg config.LoggingConfig) (*zap.Logger, error) {
+	logger, _, err := NewLeveledLogger(cfg)
+	return logger, err
+}
+
+// NewLeveledLogger is NewLogger, but also returns the logger's level so it
+// can be changed while the process runs, as on a config reload.
+//
+// Precondition: cfg.Level must be one of "debug", "info", "warn", "error".
+// Precondition: cfg.Format must be "json" or "console".
+// Postcondition: Returns a configured zap.Logger and its level, or a non-nil error.
+func NewLeveledLogger(cfg config.LoggingConfig) (*zap.Logger, zap.AtomicLevel, error) {
 	level, err := zapcore.ParseLevel(cfg.Level)
 	if err != nil {
-		return nil, fmt.Errorf("parsing log level %q: %w", cfg.Level, err)
+		return nil, zap.AtomicLevel{}, fmt.Errorf("parsing log level %q: %w", cfg.Level, err)
 	}
 
 	var zapCfg zap.Config
@@ -28,7 +39,7 @@ func NewLogger(cfg config.LoggingConfig) (*zap.Logger, error) {
 	case "console":
 		zapCfg = zap.NewDevelopmentConfig()
 	default:
-		return nil, fmt.Errorf("unknown log format %q", cfg.Format)
+		return nil, zap.AtomicLevel{}, fmt.Errorf("unknown log format %q", cfg.Format)
 	}
 
 	zapCfg.Level = zap.NewAtomicLevelAt(level)
@@ -36,7 +47,24 @@ func NewLogger(cfg config.LoggingConfig) (*zap.Logger, error) {
 
 	logger, err := zapCfg.Build()
 	if err != nil {
-		return nil, fmt.Errorf("building logger: %w", err)
+		return nil, zap.AtomicLevel{}, fmt.Errorf("building logger: %w", err)
+	}
+	return logger, zapCfg.Level, nil
+}
+
+// LogConfigChanges records the values a config reload changed, and who asked
+// for it, as an audit trail. Changes the running process does not apply are
+// logged as warnings, since they take effect only after a restart.
+func LogConfigChanges(logger *zap.Logger, changes []config.Change, by string) {
+	for _, c := range changes {
+		fields := []zap.Field{
+			zap.String("key", c.Key), zap.String("old", c.Old), zap.String("new", c.New), zap.String("by", by),
+		}
+		if c.Reloadable {
+			logger.Info("config value changed", fields...)
+		} else {
+			logger.Warn("config value changed; restart required to apply it", fields...)
+		}
 	}
-	return logger, nil
+	logger.Info("config reloaded", zap.String("by", by), zap.Int("changes", len(changes)))
 }
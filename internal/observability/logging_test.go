@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/cory-johannsen/mud/internal/config"
 )
@@ -43,3 +44,12 @@ func TestNewLogger_AllLevels(t *testing.T) {
 		assert.NotNil(t, logger)
 	}
 }
+
+func TestNewLeveledLogger_LevelIsAdjustable(t *testing.T) {
+	logger, level, err := NewLeveledLogger(config.LoggingConfig{Level: "info", Format: "json"})
+	require.NoError(t, err)
+	assert.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+
+	level.SetLevel(zapcore.DebugLevel)
+	assert.True(t, logger.Core().Enabled(zapcore.DebugLevel))
+}
@@ -0,0 +1,156 @@
+package observability
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// latencyBounds are the upper bounds of the latency histogram buckets. A
+// final overflow bucket counts observations above the last bound.
+var latencyBounds = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+// LatencyStats summarizes the observations recorded under one name.
+type LatencyStats struct {
+	Name   string
+	Count  int64
+	Errors int64
+	Total  time.Duration
+	Max    time.Duration
+	// Buckets[i] counts observations no greater than latencyBounds[i] and
+	// above the previous bound; the last entry counts the overflow.
+	Buckets []int64
+}
+
+// Mean returns the average latency, or zero when nothing was observed.
+func (s LatencyStats) Mean() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+// Quantile estimates the q-th latency quantile as the upper bound of the
+// bucket holding it.
+//
+// Precondition: q must be in [0, 1].
+// Postcondition: Returns Max when the quantile falls in the overflow bucket
+// and zero when nothing was observed.
+func (s LatencyStats) Quantile(q float64) time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	rank := int64(q*float64(s.Count) + 0.5)
+	if rank < 1 {
+		rank = 1
+	}
+	var seen int64
+	for i, n := range s.Buckets {
+		seen += n
+		if seen >= rank {
+			if i < len(latencyBounds) && latencyBounds[i] < s.Max {
+				return latencyBounds[i]
+			}
+			return s.Max
+		}
+	}
+	return s.Max
+}
+
+// Metrics records request latencies by name. A nil Metrics discards
+// observations. It is safe for concurrent use.
+type Metrics struct {
+	mu    sync.Mutex
+	stats map[string]*LatencyStats
+}
+
+// NewMetrics returns an empty Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{stats: make(map[string]*LatencyStats)}
+}
+
+// Observe records one request under name that took d.
+//
+// Postcondition: failed requests are also counted in Errors.
+func (m *Metrics) Observe(name string, d time.Duration, failed bool) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st, ok := m.stats[name]
+	if !ok {
+		st = &LatencyStats{Name: name, Buckets: make([]int64, len(latencyBounds)+1)}
+		m.stats[name] = st
+	}
+	st.Count++
+	if failed {
+		st.Errors++
+	}
+	st.Total += d
+	if d > st.Max {
+		st.Max = d
+	}
+	i := sort.Search(len(latencyBounds), func(i int) bool { return d <= latencyBounds[i] })
+	st.Buckets[i]++
+}
+
+// Snapshot returns a copy of the recorded stats ordered by name.
+func (m *Metrics) Snapshot() []LatencyStats {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]LatencyStats, 0, len(m.stats))
+	for _, st := range m.stats {
+		cp := *st
+		cp.Buckets = append([]int64(nil), st.Buckets...)
+		out = append(out, cp)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// StartReport logs a latency summary line per name every interval.
+//
+// Postcondition: returns a stop function; call it to stop the goroutine.
+func (m *Metrics) StartReport(interval time.Duration, logger *zap.Logger) func() {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				for _, st := range m.Snapshot() {
+					logger.Info("request latency",
+						zap.String("name", st.Name),
+						zap.Int64("count", st.Count),
+						zap.Int64("errors", st.Errors),
+						zap.Duration("mean", st.Mean()),
+						zap.Duration("p50", st.Quantile(0.5)),
+						zap.Duration("p99", st.Quantile(0.99)),
+						zap.Duration("max", st.Max),
+					)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
@@ -0,0 +1,67 @@
+package observability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestMetrics_ObserveAndSnapshot(t *testing.T) {
+	m := NewMetrics()
+	m.Observe("b", 3*time.Millisecond, false)
+	m.Observe("a", 2*time.Millisecond, false)
+	m.Observe("a", 40*time.Millisecond, true)
+	m.Observe("a", 7*time.Second, false)
+
+	snap := m.Snapshot()
+	require.Len(t, snap, 2)
+	a := snap[0]
+	assert.Equal(t, "a", a.Name)
+	assert.Equal(t, int64(3), a.Count)
+	assert.Equal(t, int64(1), a.Errors)
+	assert.Equal(t, 7*time.Second, a.Max)
+	assert.Equal(t, (7*time.Second+42*time.Millisecond)/3, a.Mean())
+	assert.Equal(t, 50*time.Millisecond, a.Quantile(0.5))
+	assert.Equal(t, 7*time.Second, a.Quantile(0.99), "the overflow bucket reports the max")
+	assert.Equal(t, "b", snap[1].Name)
+
+	snap[0].Buckets[0] = 99
+	assert.NotEqual(t, int64(99), m.Snapshot()[0].Buckets[0], "snapshots are copies")
+}
+
+func TestMetrics_NilAndEmpty(t *testing.T) {
+	var m *Metrics
+	m.Observe("a", time.Millisecond, false)
+	assert.Nil(t, m.Snapshot())
+	assert.Zero(t, LatencyStats{}.Mean())
+	assert.Zero(t, LatencyStats{}.Quantile(0.5))
+}
+
+// TestProperty_QuantileBoundedByMax verifies that every quantile estimate is
+// positive and never exceeds the largest observation.
+func TestProperty_QuantileBoundedByMax(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		m := NewMetrics()
+		n := rapid.IntRange(1, 50).Draw(rt, "n")
+		for i := 0; i < n; i++ {
+			d := time.Duration(rapid.Int64Range(1, int64(10*time.Second)).Draw(rt, "d"))
+			m.Observe("x", d, false)
+		}
+		st := m.Snapshot()[0]
+		q := rapid.Float64Range(0, 1).Draw(rt, "q")
+		got := st.Quantile(q)
+		if got <= 0 || got > st.Max {
+			rt.Fatalf("quantile %v = %v, max %v", q, got, st.Max)
+		}
+		var total int64
+		for _, b := range st.Buckets {
+			total += b
+		}
+		if total != st.Count {
+			rt.Fatalf("buckets sum to %d, count %d", total, st.Count)
+		}
+	})
+}
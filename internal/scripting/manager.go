@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
@@ -26,6 +28,8 @@ type CombatantInfo struct {
 	MaxHP      int
 	AC         int
 	Conditions []string
+	// TempHP is the combatant's remaining temporary hit points.
+	TempHP int
 	// Kind is "player" or "npc" — used by Lua to distinguish combatant types.
 	Kind string
 	// FactionID is the faction this combatant belongs to; empty for players and faction-less NPCs.
@@ -47,13 +51,21 @@ type zoneState struct {
 	instLimit int                // per-call instruction budget
 }
 
-// resetContext installs a fresh per-call instruction budget on zs.L.
+// resetContext installs a fresh per-call instruction budget on zs.L, also
+// bounded by timeout when it is positive.
 // Must be called with zs.mu held.
 // Returns the cancel function for the new context; the caller must defer it.
-func (zs *zoneState) resetContext() context.CancelFunc {
-	ctx, cancel := NewCountingContext(zs.instLimit)
+func (zs *zoneState) resetContext(timeout time.Duration) context.CancelFunc {
+	parent, stop := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		parent, stop = context.WithTimeout(parent, timeout)
+	}
+	ctx, cancel := newCountingContext(parent, zs.instLimit)
 	zs.L.SetContext(ctx)
-	return cancel
+	return func() {
+		cancel()
+		stop()
+	}
 }
 
 // Manager owns one sandboxed LState per zone and exposes hook dispatch.
@@ -71,6 +83,7 @@ type Manager struct {
 	GetCombatant   func(uid string) *CombatantInfo
 	ApplyCondition func(uid, condID string, stacks, duration int) error
 	ApplyDamage    func(uid string, hp int) error
+	GrantTempHP    func(uid string, hp int) error
 	Broadcast      func(roomID, msg string)
 	QueryRoom      func(roomID string) *RoomInfo
 
@@ -80,6 +93,12 @@ type Manager struct {
 	// Postcondition: Returns nil when no combat is active in roomID.
 	GetCombatantsInRoom func(roomID string) []*CombatantInfo
 
+	// AddCombatant spawns an NPC from templateID into the active combat in
+	// roomID mid-round and returns the new combatant's UID; backs
+	// engine.combat.add_combatant. Injected after construction; nil = the
+	// call fails with "unavailable".
+	AddCombatant func(roomID, templateID string) (string, error)
+
 	// GetEntityRoom returns the room ID where the entity currently resides.
 	// Returns empty string when the entity is unknown.
 	GetEntityRoom func(uid string) string
@@ -93,6 +112,61 @@ type Manager struct {
 	// Precondition: factionID must be non-empty.
 	// Postcondition: Returns nil or an empty slice when no hostile factions are defined.
 	GetFactionHostiles func(factionID string) []string
+
+	// CountItem, GiveItem, TakeItem, GetCurrency, and AdjustCurrency back
+	// engine.player. Injected after construction; nil = the engine.player
+	// function reports failure or an empty inventory.
+	//
+	// CountItem returns how many units of itemDefID the player uid carries.
+	CountItem func(uid, itemDefID string) int
+	// GiveItem adds qty units of itemDefID to the player's backpack.
+	// Postcondition: on error, the backpack is unchanged.
+	GiveItem func(uid, itemDefID string, qty int) error
+	// TakeItem removes qty units of itemDefID from the player's backpack.
+	// Postcondition: on error, the backpack is unchanged.
+	TakeItem func(uid, itemDefID string, qty int) error
+	// GetCurrency returns the player's credits; 0 for an unknown player.
+	GetCurrency func(uid string) int
+	// AdjustCurrency adds delta to the player's credits and returns the new balance.
+	// Postcondition: on error, including a balance that would go negative, credits are unchanged.
+	AdjustCurrency func(uid string, delta int) (int, error)
+
+	// SendMessage delivers text to the player uid; backs engine.player.message.
+	// Injected after construction; nil = no-op.
+	SendMessage func(uid, text string)
+	// SendPrompt shows the player uid a question and its numbered options;
+	// backs engine.player.prompt, whose answer arrives through AnswerPrompt.
+	// Injected after construction; nil = prompts fail with "unavailable".
+	SendPrompt func(uid, question string, options []string)
+
+	// modifiers holds each zone's engine.zone modifiers, keyed by zone ID
+	// then modifier name; guarded by modMu.
+	modMu     sync.RWMutex
+	modifiers map[string]map[string]float64
+
+	// prompts holds each player's pending engine.player.prompt, keyed by
+	// UID; guarded by promptMu.
+	promptMu sync.Mutex
+	prompts  map[string]*pendingPrompt
+
+	// callTimeout is the wall-clock deadline of each Lua call, in
+	// nanoseconds; 0 means only the instruction budget applies.
+	callTimeout atomic.Int64
+}
+
+// SetCallTimeout bounds the wall-clock time of every subsequent Lua call, so
+// a script stuck in a slow engine callback cannot stall its caller forever.
+//
+// Precondition: d >= 0; 0 disables the deadline.
+// Postcondition: Calls already running keep their previous deadline.
+func (m *Manager) SetCallTimeout(d time.Duration) {
+	m.callTimeout.Store(int64(d))
+}
+
+// resetCall installs a fresh per-call context on zs.L using m's call timeout.
+// Must be called with zs.mu held; the caller must defer the returned cancel.
+func (m *Manager) resetCall(zs *zoneState) context.CancelFunc {
+	return zs.resetContext(time.Duration(m.callTimeout.Load()))
 }
 
 // dispatchHook resets the instruction budget, looks up hook in zs.L, then calls
@@ -100,7 +174,7 @@ type Manager struct {
 // Returns (LNil, nil) when the hook global is not defined.
 // Lua runtime errors are logged at Warn level and not propagated.
 func (m *Manager) dispatchHook(zs *zoneState, zoneID, hook string, args ...lua.LValue) (lua.LValue, error) {
-	cancelCall := zs.resetContext()
+	cancelCall := m.resetCall(zs)
 	defer cancelCall()
 
 	fn := zs.L.GetGlobal(hook)
@@ -145,7 +219,8 @@ func NewManager(roller *dice.Roller, logger *zap.Logger) *Manager {
 }
 
 // LoadZone creates a sandboxed VM for zoneID, registers all engine.* modules,
-// then executes every *.lua file in scriptDir in lexicographic order.
+// then executes every *.lua file in scriptDir in lexicographic order, skipping
+// test_*.lua spec files.
 //
 // Precondition: zoneID must be non-empty; scriptDir must be a readable directory.
 // Precondition: concurrent calls with the same zoneID are not safe; call LoadZone at startup before any concurrent CallHook.
@@ -169,18 +244,22 @@ func (m *Manager) loadInto(key, scriptDir string, instLimit int) error {
 	}
 
 	L, cancel := NewSandboxedState(instLimit)
-	m.RegisterModules(L)
+	m.RegisterModules(L, key)
+	// Modifiers are re-established by the scripts being loaded; a failed
+	// load puts the previous ones back.
+	prevMods := m.swapZoneModifiers(key, nil)
 
 	entries, err := os.ReadDir(scriptDir)
 	if err != nil {
 		cancel()
 		L.Close()
+		m.swapZoneModifiers(key, prevMods)
 		return fmt.Errorf("scripting: reading script dir %q for %q: %w", scriptDir, key, err)
 	}
 
 	var luaFiles []string
 	for _, e := range entries {
-		if !e.IsDir() && filepath.Ext(e.Name()) == ".lua" {
+		if !e.IsDir() && filepath.Ext(e.Name()) == ".lua" && !IsSpecFile(e.Name()) {
 			luaFiles = append(luaFiles, filepath.Join(scriptDir, e.Name()))
 		}
 	}
@@ -190,6 +269,7 @@ func (m *Manager) loadInto(key, scriptDir string, instLimit int) error {
 		if err := L.DoFile(path); err != nil {
 			cancel()
 			L.Close()
+			m.swapZoneModifiers(key, prevMods)
 			return fmt.Errorf("scripting: loading %q for %q: %w", path, key, err)
 		}
 	}
@@ -239,6 +319,53 @@ func (m *Manager) CallHook(zoneID, hook string, args ...lua.LValue) (lua.LValue,
 	return m.dispatchHook(zs, zoneID, hook, args...)
 }
 
+// CallHookEverywhere calls the named Lua global function once in every loaded
+// VM, zone and global alike, for world-wide events. VMs that do not define
+// the hook are skipped; runtime errors are logged as in CallHook.
+//
+// Precondition: args must be valid lua.LValue instances.
+// Postcondition: Returns the number of VMs that define the hook.
+func (m *Manager) CallHookEverywhere(hook string, args ...lua.LValue) int {
+	m.mapMu.RLock()
+	ids := make([]string, 0, len(m.zones))
+	states := make([]*zoneState, 0, len(m.zones))
+	for id, zs := range m.zones {
+		ids = append(ids, id)
+		states = append(states, zs)
+	}
+	m.mapMu.RUnlock()
+
+	called := 0
+	for i, zs := range states {
+		zs.mu.Lock()
+		if zs.L.GetGlobal(hook).Type() == lua.LTFunction {
+			called++
+			_, _ = m.dispatchHook(zs, ids[i], hook, args...)
+		}
+		zs.mu.Unlock()
+	}
+	return called
+}
+
+// HasHook reports whether hook is a Lua function in the VM CallHook would use
+// for zoneID.
+//
+// Postcondition: Returns false when no VM exists for zoneID or the global fallback.
+func (m *Manager) HasHook(zoneID, hook string) bool {
+	m.mapMu.RLock()
+	zs, ok := m.zones[zoneID]
+	if !ok {
+		zs = m.zones[globalZoneID]
+	}
+	m.mapMu.RUnlock()
+	if zs == nil {
+		return false
+	}
+	zs.mu.Lock()
+	defer zs.mu.Unlock()
+	return zs.L.GetGlobal(hook).Type() == lua.LTFunction
+}
+
 // CallHookWithContext calls the named Lua global function in zoneID's VM, passing
 // uid and hookArg as the first two arguments, followed by a Lua table built from ctx.
 // The ctx map keys become string keys in the table; values must be valid lua.LValue instances.
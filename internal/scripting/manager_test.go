@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,40 @@ func TestManager_CallHook_MissingHook_NoOp(t *testing.T) {
 	assert.Equal(t, lua.LNil, ret)
 }
 
+func TestManager_HasHook(t *testing.T) {
+	mgr, _ := newTestManager(t)
+	assert.False(t, mgr.HasHook("testzone", "start_raid"), "no VM loaded")
+	dir := writeTempLua(t, "hooks.lua", `
+		function start_raid() end
+		not_a_hook = 3
+	`)
+	require.NoError(t, mgr.LoadZone("testzone", dir, 0))
+	assert.True(t, mgr.HasHook("testzone", "start_raid"))
+	assert.False(t, mgr.HasHook("testzone", "not_a_hook"))
+	assert.False(t, mgr.HasHook("testzone", "missing"))
+	assert.False(t, mgr.HasHook("otherzone", "start_raid"), "zones do not share VMs")
+}
+
+func TestManager_CallHookEverywhere(t *testing.T) {
+	mgr, _ := newTestManager(t)
+	hook := `
+		seen = nil
+		function on_season_change(season) seen = season end
+		function last_seen() return seen end
+	`
+	require.NoError(t, mgr.LoadZone("a", writeTempLua(t, "a.lua", hook), 0))
+	require.NoError(t, mgr.LoadGlobal(writeTempLua(t, "g.lua", hook), 0))
+	require.NoError(t, mgr.LoadZone("quiet", writeTempLua(t, "q.lua", `-- no hooks`), 0))
+
+	assert.Equal(t, 2, mgr.CallHookEverywhere("on_season_change", lua.LString("winter")))
+	ret, err := mgr.CallHook("a", "last_seen")
+	require.NoError(t, err)
+	assert.Equal(t, lua.LString("winter"), ret)
+	ret, err = mgr.CallHook("no_vm", "last_seen")
+	require.NoError(t, err)
+	assert.Equal(t, lua.LString("winter"), ret, "the global VM is called too")
+}
+
 func TestManager_CallHook_UnknownZone_LogsInfoReturnsNil(t *testing.T) {
 	mgr, logs := newTestManager(t)
 	ret, err := mgr.CallHook("no_such_zone", "some_hook")
@@ -219,6 +254,38 @@ func TestCallHook_BudgetResetAcrossCalls(t *testing.T) {
 	}
 }
 
+func TestCallHook_CallTimeoutStopsSlowScript(t *testing.T) {
+	mgr, logs := newTestManager(t)
+	dir := writeTempLua(t, "slow.lua", `
+		function slow(uid)
+			for i = 1, 1000 do engine.player.message(uid, "tick") end
+			return "done"
+		end
+	`)
+	require.NoError(t, mgr.LoadZone("test", dir, 1_000_000))
+	defer mgr.Close()
+	sent := 0
+	mgr.SendMessage = func(string, string) {
+		sent++
+		time.Sleep(5 * time.Millisecond)
+	}
+	mgr.SetCallTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+	ret, err := mgr.CallHook("test", "slow", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, lua.LNil, ret, "a timed-out call returns nil")
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.Less(t, sent, 1000)
+	assert.Equal(t, 1, logs.FilterMessage("scripting: Lua runtime error").Len())
+
+	mgr.SetCallTimeout(0)
+	mgr.SendMessage = func(string, string) {}
+	ret, err = mgr.CallHook("test", "slow", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, lua.LString("done"), ret)
+}
+
 // TestProperty_CallHook_NeverPermanentlyFails is a property-based version of
 // the BUG-16 regression. For random small budgets and random call counts, the
 // hook must always return the correct value.
@@ -5,13 +5,13 @@ import (
 	"go.uber.org/zap"
 )
 
-// RegisterModules registers all engine.* Lua tables into L.
+// RegisterModules registers all engine.* Lua tables into L, the VM for zoneID.
 //
 // Precondition: L must be from NewSandboxedState.
 // Postcondition: engine global is defined in L with submodules:
 //
-//	engine.log, engine.dice, engine.entity, engine.combat, engine.world, engine.event, engine.map.
-func (m *Manager) RegisterModules(L *lua.LState) {
+//	engine.log, engine.dice, engine.entity, engine.combat, engine.world, engine.event, engine.map, engine.zone, engine.player.
+func (m *Manager) RegisterModules(L *lua.LState, zoneID string) {
 	engine := L.NewTable()
 	L.SetGlobal("engine", engine)
 
@@ -22,6 +22,8 @@ func (m *Manager) RegisterModules(L *lua.LState) {
 	L.SetField(engine, "world", m.newWorldModule(L))
 	L.SetField(engine, "event", m.newEventModule(L))
 	L.SetField(engine, "map", m.newMapModule(L))
+	L.SetField(engine, "zone", m.newZoneModule(L, zoneID))
+	L.SetField(engine, "player", m.newPlayerModule(L, zoneID))
 }
 
 // newLogModule returns the engine.log table with debug/info/warn/error functions.
@@ -83,13 +85,14 @@ func (m *Manager) newDiceModule(L *lua.LState) *lua.LTable {
 // combatantToTable converts a CombatantInfo snapshot to a Lua table.
 //
 // Precondition: L and c must be non-nil.
-// Postcondition: Returned table has uid, name, hp, max_hp, ac, kind, faction_id, conditions fields.
+// Postcondition: Returned table has uid, name, hp, max_hp, temp_hp, ac, kind, faction_id, conditions fields.
 func combatantToTable(L *lua.LState, c *CombatantInfo) *lua.LTable {
 	tbl := L.NewTable()
 	L.SetField(tbl, "uid", lua.LString(c.UID))
 	L.SetField(tbl, "name", lua.LString(c.Name))
 	L.SetField(tbl, "hp", lua.LNumber(c.HP))
 	L.SetField(tbl, "max_hp", lua.LNumber(c.MaxHP))
+	L.SetField(tbl, "temp_hp", lua.LNumber(c.TempHP))
 	L.SetField(tbl, "ac", lua.LNumber(c.AC))
 	L.SetField(tbl, "kind", lua.LString(c.Kind))
 	L.SetField(tbl, "faction_id", lua.LString(c.FactionID))
@@ -242,6 +245,39 @@ func (m *Manager) newCombatModule(L *lua.LState) *lua.LTable {
 		}
 		return 0
 	}))
+	L.SetField(t, "grant_temp_hp", L.NewFunction(func(L *lua.LState) int {
+		if m.GrantTempHP == nil {
+			return 0
+		}
+		uid := L.CheckString(1)
+		hp := L.CheckInt(2)
+		if err := m.GrantTempHP(uid, hp); err != nil {
+			m.logger.Warn("engine.combat.grant_temp_hp error",
+				zap.String("uid", uid),
+				zap.Int("hp", hp),
+				zap.Error(err),
+			)
+		}
+		return 0
+	}))
+	// add_combatant(room_id, template_id) → the new combatant's uid, or nil and an error message.
+	L.SetField(t, "add_combatant", L.NewFunction(func(L *lua.LState) int {
+		roomID := L.CheckString(1)
+		templateID := L.CheckString(2)
+		if m.AddCombatant == nil {
+			L.Push(lua.LNil)
+			L.Push(lua.LString("unavailable"))
+			return 2
+		}
+		uid, err := m.AddCombatant(roomID, templateID)
+		if err != nil {
+			L.Push(lua.LNil)
+			L.Push(lua.LString(err.Error()))
+			return 2
+		}
+		L.Push(lua.LString(uid))
+		return 1
+	}))
 	L.SetField(t, "query_combatant", L.NewFunction(func(L *lua.LState) int {
 		if m.GetCombatant == nil {
 			L.Push(lua.LNil)
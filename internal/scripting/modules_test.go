@@ -176,6 +176,44 @@ func TestEngineCombat_ApplyCondition_CallsCallback(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestEngineCombat_GrantTempHP_CallsCallback(t *testing.T) {
+	mgr, _ := newTestManager(t)
+	var gotUID string
+	var gotHP int
+	mgr.GrantTempHP = func(uid string, hp int) error {
+		gotUID, gotHP = uid, hp
+		return nil
+	}
+	runScript(t, mgr, `
+		function do_grant()
+			engine.combat.grant_temp_hp("uid1", 5)
+		end
+	`, "do_grant")
+	assert.Equal(t, "uid1", gotUID)
+	assert.Equal(t, 5, gotHP)
+}
+
+func TestEngineCombat_AddCombatant_ReturnsUIDOrError(t *testing.T) {
+	mgr, _ := newTestManager(t)
+	mgr.AddCombatant = func(roomID, templateID string) (string, error) {
+		if roomID != "arena" {
+			return "", fmt.Errorf("no active combat in room %q", roomID)
+		}
+		return templateID + "-1", nil
+	}
+	ret := runScript(t, mgr, `
+		function do_add()
+			local uid = engine.combat.add_combatant("arena", "ganger")
+			local none, err = engine.combat.add_combatant("street", "ganger")
+			if none ~= nil or err == nil then
+				return "unexpected"
+			end
+			return uid
+		end
+	`, "do_add")
+	assert.Equal(t, "ganger-1", ret.String())
+}
+
 func TestEngineCombat_QueryCombatant_WithCallback(t *testing.T) {
 	mgr, _ := newTestManager(t)
 	mgr.GetCombatant = func(uid string) *scripting.CombatantInfo {
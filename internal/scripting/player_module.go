@@ -0,0 +1,134 @@
+package scripting
+
+import (
+	"fmt"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// newPlayerModule returns the engine.player table for the VM of zoneID, which
+// reads and mutates a player's backpack and currency through the inventory
+// callbacks and talks to the player through SendMessage and SendPrompt.
+//
+// Precondition: L must be non-nil.
+// Postcondition: engine.player functions are callable; with a nil callback,
+// has_item returns false, get_currency returns 0, message does nothing, and
+// give_item, take_item, adjust_currency, and prompt fail with the message
+// "unavailable".
+func (m *Manager) newPlayerModule(L *lua.LState, zoneID string) *lua.LTable {
+	t := L.NewTable()
+	vm := L
+	// fail pushes the Lua failure convention: nil or false, then a message.
+	fail := func(L *lua.LState, first lua.LValue, msg string) int {
+		L.Push(first)
+		L.Push(lua.LString(msg))
+		return 2
+	}
+	// has_item(uid, def_id [, qty]) → true when the player carries at least qty (default 1).
+	L.SetField(t, "has_item", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		defID := L.CheckString(2)
+		qty := L.OptInt(3, 1)
+		if m.CountItem == nil {
+			L.Push(lua.LFalse)
+			return 1
+		}
+		L.Push(lua.LBool(m.CountItem(uid, defID) >= qty))
+		return 1
+	}))
+	// give_item(uid, def_id [, qty]) → true, or false and an error message.
+	L.SetField(t, "give_item", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		defID := L.CheckString(2)
+		qty := L.OptInt(3, 1)
+		if qty <= 0 {
+			L.ArgError(3, "quantity must be positive")
+		}
+		if m.GiveItem == nil {
+			return fail(L, lua.LFalse, "unavailable")
+		}
+		if err := m.GiveItem(uid, defID, qty); err != nil {
+			return fail(L, lua.LFalse, err.Error())
+		}
+		L.Push(lua.LTrue)
+		return 1
+	}))
+	// take_item(uid, def_id [, qty]) → true, or false and an error message;
+	// nothing is taken unless the player carries the full quantity.
+	L.SetField(t, "take_item", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		defID := L.CheckString(2)
+		qty := L.OptInt(3, 1)
+		if qty <= 0 {
+			L.ArgError(3, "quantity must be positive")
+		}
+		if m.TakeItem == nil {
+			return fail(L, lua.LFalse, "unavailable")
+		}
+		if err := m.TakeItem(uid, defID, qty); err != nil {
+			return fail(L, lua.LFalse, err.Error())
+		}
+		L.Push(lua.LTrue)
+		return 1
+	}))
+	// get_currency(uid) → the player's credits.
+	L.SetField(t, "get_currency", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		if m.GetCurrency == nil {
+			L.Push(lua.LNumber(0))
+			return 1
+		}
+		L.Push(lua.LNumber(m.GetCurrency(uid)))
+		return 1
+	}))
+	// adjust_currency(uid, delta) → the new balance, or nil and an error message.
+	L.SetField(t, "adjust_currency", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		delta := L.CheckInt(2)
+		if m.AdjustCurrency == nil {
+			return fail(L, lua.LNil, "unavailable")
+		}
+		balance, err := m.AdjustCurrency(uid, delta)
+		if err != nil {
+			return fail(L, lua.LNil, err.Error())
+		}
+		L.Push(lua.LNumber(balance))
+		return 1
+	}))
+	// message(uid, text) sends text to the player.
+	L.SetField(t, "message", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		text := L.CheckString(2)
+		if m.SendMessage != nil {
+			m.SendMessage(uid, text)
+		}
+		return 0
+	}))
+	// prompt(uid, question, options, callback) → true, or false and an error
+	// message. The player's answer calls callback(uid, index, option) later.
+	L.SetField(t, "prompt", L.NewFunction(func(L *lua.LState) int {
+		uid := L.CheckString(1)
+		question := L.CheckString(2)
+		tbl := L.CheckTable(3)
+		fn := L.CheckFunction(4)
+		var options []string
+		for i := 1; i <= tbl.Len(); i++ {
+			opt, ok := tbl.RawGetInt(i).(lua.LString)
+			if !ok || opt == "" {
+				L.ArgError(3, "options must be non-empty strings")
+			}
+			options = append(options, string(opt))
+		}
+		if len(options) == 0 || len(options) > MaxPromptOptions {
+			L.ArgError(3, fmt.Sprintf("between 1 and %d options required", MaxPromptOptions))
+		}
+		if m.SendPrompt == nil {
+			return fail(L, lua.LFalse, "unavailable")
+		}
+		m.setPrompt(uid, &pendingPrompt{zoneID: zoneID, L: vm, fn: fn, options: options})
+		m.SendPrompt(uid, question, options)
+		L.Push(lua.LTrue)
+		return 1
+	}))
+	return t
+}
@@ -0,0 +1,211 @@
+package scripting_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+func newPlayerModuleManager(t *testing.T, script string) *scripting.Manager {
+	t.Helper()
+	roller := dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop())
+	mgr := scripting.NewManager(roller, zap.NewNop())
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "player.lua"), []byte(script), 0644))
+	require.NoError(t, mgr.LoadZone("downtown", dir, 10000))
+	return mgr
+}
+
+// wireFakePlayer backs engine.player with an in-memory pack and purse for "p1".
+func wireFakePlayer(mgr *scripting.Manager, pack map[string]int, purse *int) {
+	mgr.CountItem = func(uid, defID string) int { return pack[defID] }
+	mgr.GiveItem = func(uid, defID string, qty int) error {
+		pack[defID] += qty
+		return nil
+	}
+	mgr.TakeItem = func(uid, defID string, qty int) error {
+		if pack[defID] < qty {
+			return fmt.Errorf("not enough %s", defID)
+		}
+		pack[defID] -= qty
+		return nil
+	}
+	mgr.GetCurrency = func(uid string) int { return *purse }
+	mgr.AdjustCurrency = func(uid string, delta int) (int, error) {
+		if *purse+delta < 0 {
+			return 0, fmt.Errorf("insufficient credits")
+		}
+		*purse += delta
+		return *purse, nil
+	}
+}
+
+func TestEnginePlayer_GateAndReward(t *testing.T) {
+	mgr := newPlayerModuleManager(t, `
+function on_turn_in(uid)
+    if not engine.player.has_item(uid, "rat_tail", 3) then
+        return "need tails"
+    end
+    local ok, err = engine.player.take_item(uid, "rat_tail", 3)
+    if not ok then return err end
+    engine.player.give_item(uid, "stimpak")
+    return engine.player.adjust_currency(uid, 50)
+end
+`)
+	pack := map[string]int{"rat_tail": 2}
+	purse := 10
+	wireFakePlayer(mgr, pack, &purse)
+
+	ret, err := mgr.CallHook("downtown", "on_turn_in", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "need tails", ret.String())
+	assert.Equal(t, 10, purse)
+
+	pack["rat_tail"] = 4
+	ret, err = mgr.CallHook("downtown", "on_turn_in", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, lua.LNumber(60), ret)
+	assert.Equal(t, 1, pack["rat_tail"])
+	assert.Equal(t, 1, pack["stimpak"])
+}
+
+func TestEnginePlayer_FailuresReturnMessage(t *testing.T) {
+	mgr := newPlayerModuleManager(t, `
+function take_too_many(uid)
+    local ok, err = engine.player.take_item(uid, "rat_tail", 5)
+    return tostring(ok) .. ":" .. err
+end
+function overspend(uid)
+    local balance, err = engine.player.adjust_currency(uid, -100)
+    return tostring(balance) .. ":" .. err .. ":" .. engine.player.get_currency(uid)
+end
+`)
+	pack := map[string]int{"rat_tail": 1}
+	purse := 10
+	wireFakePlayer(mgr, pack, &purse)
+
+	ret, err := mgr.CallHook("downtown", "take_too_many", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "false:not enough rat_tail", ret.String())
+	assert.Equal(t, 1, pack["rat_tail"])
+
+	ret, err = mgr.CallHook("downtown", "overspend", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "nil:insufficient credits:10", ret.String())
+}
+
+func TestEnginePlayer_NilCallbacks(t *testing.T) {
+	mgr := newPlayerModuleManager(t, `
+function probe(uid)
+    local ok, err = engine.player.give_item(uid, "stimpak")
+    return tostring(engine.player.has_item(uid, "stimpak")) .. ":" ..
+        engine.player.get_currency(uid) .. ":" .. tostring(ok) .. ":" .. err
+end
+`)
+	ret, err := mgr.CallHook("downtown", "probe", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "false:0:false:unavailable", ret.String())
+}
+
+func TestEnginePlayer_Message(t *testing.T) {
+	mgr := newPlayerModuleManager(t, `
+function greet(uid)
+    engine.player.message(uid, "A voice whispers your name.")
+end
+`)
+	var gotUID, gotText string
+	mgr.SendMessage = func(uid, text string) { gotUID, gotText = uid, text }
+	_, err := mgr.CallHook("downtown", "greet", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "p1", gotUID)
+	assert.Equal(t, "A voice whispers your name.", gotText)
+}
+
+func TestEnginePlayer_PromptRoutesAnswerToCallback(t *testing.T) {
+	mgr := newPlayerModuleManager(t, `
+answers = {}
+function riddle(uid)
+    return engine.player.prompt(uid, "What walks on four legs?", {"A dog", "A man"}, function(who, i, opt)
+        table.insert(answers, who .. ":" .. i .. ":" .. opt)
+        if i == 1 then
+            engine.player.prompt(who, "Try again?", {"Yes", "No"}, function() end)
+        end
+    end)
+end
+function last_answer()
+    return answers[#answers]
+end
+`)
+	var question string
+	var options []string
+	mgr.SendPrompt = func(uid, q string, opts []string) { question, options = q, opts }
+
+	ret, err := mgr.CallHook("downtown", "riddle", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, lua.LTrue, ret)
+	assert.Equal(t, "What walks on four legs?", question)
+	assert.Equal(t, []string{"A dog", "A man"}, options)
+	assert.True(t, mgr.HasPrompt("p1"))
+
+	assert.ErrorIs(t, mgr.AnswerPrompt("p2", "1"), scripting.ErrNoPrompt)
+	assert.Error(t, mgr.AnswerPrompt("p1", "3"))
+	assert.True(t, mgr.HasPrompt("p1"), "an invalid answer keeps the prompt pending")
+
+	require.NoError(t, mgr.AnswerPrompt("p1", "a DOG"))
+	ret, err = mgr.CallHook("downtown", "last_answer")
+	require.NoError(t, err)
+	assert.Equal(t, "p1:1:A dog", ret.String())
+	assert.Equal(t, "Try again?", question, "the callback may prompt again")
+	assert.True(t, mgr.HasPrompt("p1"))
+
+	mgr.CancelPrompt("p1")
+	assert.ErrorIs(t, mgr.AnswerPrompt("p1", "1"), scripting.ErrNoPrompt)
+}
+
+func TestEnginePlayer_PromptVoidedByReload(t *testing.T) {
+	script := `
+function ask(uid)
+    engine.player.prompt(uid, "Left or right?", {"Left", "Right"}, function() error("stale") end)
+end
+`
+	mgr := newPlayerModuleManager(t, script)
+	mgr.SendPrompt = func(string, string, []string) {}
+	_, err := mgr.CallHook("downtown", "ask", lua.LString("p1"))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "player.lua"), []byte(script), 0644))
+	require.NoError(t, mgr.LoadZone("downtown", dir, 10000))
+	assert.ErrorIs(t, mgr.AnswerPrompt("p1", "1"), scripting.ErrNoPrompt)
+}
+
+func TestEnginePlayer_PromptRejectsBadOptions(t *testing.T) {
+	mgr := newPlayerModuleManager(t, `
+function ask(uid)
+    local ok, err = pcall(engine.player.prompt, uid, "Pick", {}, function() end)
+    return tostring(ok)
+end
+function ask_unwired(uid)
+    local ok, err = engine.player.prompt(uid, "Pick", {"One"}, function() end)
+    return tostring(ok) .. ":" .. err
+end
+`)
+	ret, err := mgr.CallHook("downtown", "ask_unwired", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "false:unavailable", ret.String())
+
+	mgr.SendPrompt = func(string, string, []string) {}
+	ret, err = mgr.CallHook("downtown", "ask", lua.LString("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, "false", ret.String())
+	assert.False(t, mgr.HasPrompt("p1"))
+}
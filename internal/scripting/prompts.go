@@ -0,0 +1,115 @@
+package scripting
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+// MaxPromptOptions is the most options an engine.player.prompt may offer.
+const MaxPromptOptions = 9
+
+// ErrNoPrompt is returned by AnswerPrompt when the player has no pending prompt.
+var ErrNoPrompt = errors.New("no pending prompt")
+
+// pendingPrompt is a question a script asked a player, awaiting the answer.
+type pendingPrompt struct {
+	zoneID  string
+	L       *lua.LState // the VM that asked; a reload replaces it and voids the prompt
+	fn      *lua.LFunction
+	options []string
+}
+
+// setPrompt records p as uid's pending prompt, replacing any earlier one.
+func (m *Manager) setPrompt(uid string, p *pendingPrompt) {
+	m.promptMu.Lock()
+	defer m.promptMu.Unlock()
+	if m.prompts == nil {
+		m.prompts = make(map[string]*pendingPrompt)
+	}
+	m.prompts[uid] = p
+}
+
+// HasPrompt reports whether uid has a pending engine.player.prompt.
+func (m *Manager) HasPrompt(uid string) bool {
+	m.promptMu.Lock()
+	defer m.promptMu.Unlock()
+	_, ok := m.prompts[uid]
+	return ok
+}
+
+// CancelPrompt discards uid's pending prompt without calling its callback.
+//
+// Postcondition: HasPrompt(uid) is false.
+func (m *Manager) CancelPrompt(uid string) {
+	m.promptMu.Lock()
+	defer m.promptMu.Unlock()
+	delete(m.prompts, uid)
+}
+
+// AnswerPrompt answers uid's pending prompt with answer — an option number
+// (1-based) or an option's text, case-insensitively — and calls the script's
+// callback as callback(uid, index, option) in the VM that asked.
+//
+// Postcondition: Returns ErrNoPrompt when uid has no pending prompt, or when
+// the asking VM has since been reloaded. Returns an error and keeps the prompt
+// pending when answer matches no option. Otherwise the prompt is cleared
+// before the callback runs, so the callback may prompt again; Lua runtime
+// errors in the callback are logged and not propagated.
+func (m *Manager) AnswerPrompt(uid, answer string) error {
+	m.promptMu.Lock()
+	p, ok := m.prompts[uid]
+	if !ok {
+		m.promptMu.Unlock()
+		return ErrNoPrompt
+	}
+	idx := matchPromptOption(p.options, answer)
+	if idx < 0 {
+		m.promptMu.Unlock()
+		return fmt.Errorf("choose 1-%d", len(p.options))
+	}
+	delete(m.prompts, uid)
+	m.promptMu.Unlock()
+
+	m.mapMu.RLock()
+	zs := m.zones[p.zoneID]
+	m.mapMu.RUnlock()
+	if zs == nil || zs.L != p.L {
+		return ErrNoPrompt
+	}
+
+	zs.mu.Lock()
+	defer zs.mu.Unlock()
+	cancelCall := m.resetCall(zs)
+	defer cancelCall()
+	if err := zs.L.CallByParam(lua.P{Fn: p.fn, NRet: 0, Protect: true},
+		lua.LString(uid), lua.LNumber(idx+1), lua.LString(p.options[idx])); err != nil {
+		m.logger.Warn("scripting: Lua runtime error in prompt callback",
+			zap.String("zone", p.zoneID),
+			zap.String("uid", uid),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
+
+// matchPromptOption returns the index in options that answer selects, or -1.
+func matchPromptOption(options []string, answer string) int {
+	answer = strings.TrimSpace(answer)
+	if n, err := strconv.Atoi(answer); err == nil {
+		if n >= 1 && n <= len(options) {
+			return n - 1
+		}
+		return -1
+	}
+	for i, opt := range options {
+		if strings.EqualFold(opt, answer) {
+			return i
+		}
+	}
+	return -1
+}
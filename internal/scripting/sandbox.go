@@ -39,10 +39,16 @@ func (c *countingContext) Done() <-chan struct{} {
 // Precondition: limit > 0; panics if limit <= 0.
 // Postcondition: Returns a context and a cancel function; caller must call cancel.
 func NewCountingContext(limit int) (context.Context, context.CancelFunc) {
+	return newCountingContext(context.Background(), limit)
+}
+
+// newCountingContext is NewCountingContext derived from parent, so the VM also
+// stops when parent is done.
+func newCountingContext(parent context.Context, limit int) (context.Context, context.CancelFunc) {
 	if limit <= 0 {
 		panic("newCountingContext: limit must be > 0")
 	}
-	base, cancel := context.WithCancel(context.Background())
+	base, cancel := context.WithCancel(parent)
 	rem := &atomic.Int64{}
 	rem.Store(int64(limit))
 	return &countingContext{
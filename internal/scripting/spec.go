@@ -0,0 +1,145 @@
+package scripting
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// specPrefix marks Lua spec files and the test functions inside them.
+const specPrefix = "test_"
+
+// IsSpecFile reports whether name is a Lua spec file (test_*.lua). Spec files
+// are skipped when a zone's scripts are loaded and run only by RunSpec.
+func IsSpecFile(name string) bool {
+	base := filepath.Base(name)
+	return strings.HasPrefix(base, specPrefix) && filepath.Ext(base) == ".lua"
+}
+
+// SpecResult is the outcome of one test function in a spec file.
+type SpecResult struct {
+	Name string
+	// Err is nil when the test passed.
+	Err error
+}
+
+// SpecOptions customizes a RunSpec call.
+type SpecOptions struct {
+	// Globals installs extra globals, such as mocks, into the VM before the
+	// spec file runs. The VM is locked for the duration of the call.
+	Globals func(L *lua.LState)
+	// BeforeEach runs before each test function, outside the VM lock.
+	BeforeEach func()
+	// Match selects the test functions to run by name; nil runs them all.
+	Match func(name string) bool
+}
+
+// RunSpec runs the spec file at path in the VM CallHook would use for zoneID.
+// The file is executed once; then every global function whose name starts
+// with "test_" is called in name order, each with a fresh instruction budget.
+// A test fails when it raises a Lua error. Spec files also get two helpers:
+//
+//	expect(cond [, msg])                     -- fails the test unless cond is truthy
+//	expect_eq(actual, expected [, msg])      -- fails the test unless actual == expected
+//
+// Precondition: the zone or global VM must have been loaded.
+// Postcondition: Returns an error, and no results, when no VM exists or the
+// spec file fails to load; otherwise one result per test run.
+func (m *Manager) RunSpec(zoneID, path string, opts SpecOptions) ([]SpecResult, error) {
+	m.mapMu.RLock()
+	zs, ok := m.zones[zoneID]
+	if !ok {
+		zs = m.zones[globalZoneID]
+	}
+	m.mapMu.RUnlock()
+	if zs == nil {
+		return nil, fmt.Errorf("scripting: no VM for zone %q", zoneID)
+	}
+
+	names, err := m.loadSpec(zs, path, opts.Globals)
+	if err != nil {
+		return nil, err
+	}
+	var results []SpecResult
+	for _, name := range names {
+		if opts.Match != nil && !opts.Match(name) {
+			continue
+		}
+		if opts.BeforeEach != nil {
+			opts.BeforeEach()
+		}
+		results = append(results, SpecResult{Name: name, Err: m.runSpecTest(zs, name)})
+	}
+	return results, nil
+}
+
+// loadSpec installs the spec helpers and globals, executes the spec file, and
+// returns the names of its test functions in order.
+func (m *Manager) loadSpec(zs *zoneState, path string, globals func(L *lua.LState)) ([]string, error) {
+	zs.mu.Lock()
+	defer zs.mu.Unlock()
+	cancelCall := m.resetCall(zs)
+	defer cancelCall()
+
+	L := zs.L
+	L.SetGlobal("expect", L.NewFunction(func(L *lua.LState) int {
+		if !lua.LVAsBool(L.Get(1)) {
+			L.RaiseError("%s", L.OptString(2, "expectation failed"))
+		}
+		return 0
+	}))
+	L.SetGlobal("expect_eq", L.NewFunction(func(L *lua.LState) int {
+		actual, expected := L.Get(1), L.Get(2)
+		if !L.Equal(actual, expected) {
+			msg := fmt.Sprintf("expected %s, got %s", luaRepr(expected), luaRepr(actual))
+			if note := L.OptString(3, ""); note != "" {
+				msg = note + ": " + msg
+			}
+			L.RaiseError("%s", msg)
+		}
+		return 0
+	}))
+	if globals != nil {
+		globals(L)
+	}
+	if err := L.DoFile(path); err != nil {
+		return nil, fmt.Errorf("scripting: loading spec %q: %w", path, err)
+	}
+
+	var names []string
+	L.G.Global.ForEach(func(k, v lua.LValue) {
+		if name, ok := k.(lua.LString); ok && strings.HasPrefix(string(name), specPrefix) && v.Type() == lua.LTFunction {
+			names = append(names, string(name))
+		}
+	})
+	sort.Strings(names)
+	return names, nil
+}
+
+// runSpecTest calls the test function name and returns its error, if any.
+func (m *Manager) runSpecTest(zs *zoneState, name string) error {
+	zs.mu.Lock()
+	defer zs.mu.Unlock()
+	cancelCall := m.resetCall(zs)
+	defer cancelCall()
+
+	err := zs.L.CallByParam(lua.P{Fn: zs.L.GetGlobal(name), NRet: 0, Protect: true})
+	var apiErr *lua.ApiError
+	if errors.As(err, &apiErr) && apiErr.Object != nil {
+		// Drop the traceback; the message already carries file and line.
+		return errors.New(apiErr.Object.String())
+	}
+	return err
+}
+
+// luaRepr renders v for an expect_eq failure message.
+func luaRepr(v lua.LValue) string {
+	if s, ok := v.(lua.LString); ok {
+		return fmt.Sprintf("%q", string(s))
+	}
+	return v.String()
+}
@@ -0,0 +1,85 @@
+package scripting_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+func writeSpecZone(t *testing.T) (dir, spec string) {
+	t.Helper()
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hooks.lua"), []byte(`
+function double(n) return n * 2 end
+`), 0644))
+	spec = filepath.Join(dir, "test_hooks.lua")
+	require.NoError(t, os.WriteFile(spec, []byte(`
+function test_b_fails() expect_eq(double(2), 5, "double") end
+function test_a_passes() expect_eq(double(2), 4); expect(seeded == 7) end
+function test_c_raises() error("boom") end
+function helper() end
+`), 0644))
+	return dir, spec
+}
+
+func TestIsSpecFile(t *testing.T) {
+	assert.True(t, scripting.IsSpecFile("content/scripts/zones/downtown/test_rooms.lua"))
+	assert.False(t, scripting.IsSpecFile("rooms.lua"))
+	assert.False(t, scripting.IsSpecFile("test_rooms.txt"))
+}
+
+func TestLoadZone_SkipsSpecFiles(t *testing.T) {
+	dir, _ := writeSpecZone(t)
+	mgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	// The spec file calls nothing at load time, but its functions must not be defined.
+	require.NoError(t, mgr.LoadZone("z", dir, 0))
+	assert.True(t, mgr.HasHook("z", "double"))
+	assert.False(t, mgr.HasHook("z", "test_a_passes"))
+}
+
+func TestManager_RunSpec(t *testing.T) {
+	dir, spec := writeSpecZone(t)
+	mgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	require.NoError(t, mgr.LoadZone("z", dir, 0))
+
+	before := 0
+	results, err := mgr.RunSpec("z", spec, scripting.SpecOptions{
+		Globals:    func(L *lua.LState) { L.SetGlobal("seeded", lua.LNumber(7)) },
+		BeforeEach: func() { before++ },
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, 3, before)
+
+	assert.Equal(t, "test_a_passes", results[0].Name)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "test_b_fails", results[1].Name)
+	assert.ErrorContains(t, results[1].Err, "double: expected 5, got 4")
+	assert.ErrorContains(t, results[2].Err, "boom")
+	assert.NotContains(t, results[2].Err.Error(), "stack traceback")
+
+	results, err = mgr.RunSpec("z", spec, scripting.SpecOptions{Match: func(name string) bool { return name == "test_c_raises" }})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+}
+
+func TestManager_RunSpec_Errors(t *testing.T) {
+	mgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	_, err := mgr.RunSpec("missing", "test_x.lua", scripting.SpecOptions{})
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "test_bad.lua")
+	require.NoError(t, os.WriteFile(spec, []byte(`function test_x(`), 0644))
+	require.NoError(t, mgr.LoadZone("z", dir, 0))
+	_, err = mgr.RunSpec("z", spec, scripting.SpecOptions{})
+	assert.ErrorContains(t, err, "loading spec")
+}
@@ -0,0 +1,119 @@
+package scripting
+
+import (
+	"fmt"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// Zone modifier names accepted by engine.zone.set_modifier. Each is a
+// multiplier; an unset modifier is 1.
+const (
+	// ModDamageMultiplier scales all combat damage dealt in the zone.
+	ModDamageMultiplier = "damage_multiplier"
+	// ModRespawnRate scales how fast NPCs respawn in the zone; 2 halves
+	// respawn delays.
+	ModRespawnRate = "respawn_rate"
+	// ModLootMultiplier scales currency and item drop chances from NPCs
+	// killed in the zone.
+	ModLootMultiplier = "loot_multiplier"
+)
+
+// maxZoneModifier bounds every zone modifier to keep scripts from breaking
+// the game's economy or combat math.
+const maxZoneModifier = 10.0
+
+var zoneModifierNames = map[string]bool{
+	ModDamageMultiplier: true,
+	ModRespawnRate:      true,
+	ModLootMultiplier:   true,
+}
+
+// SetZoneModifier sets the named modifier for zoneID.
+//
+// Precondition: zoneID must be non-empty.
+// Postcondition: Returns an error, leaving modifiers unchanged, when name is
+// unknown or value is outside (0, 10]; otherwise ZoneModifier(zoneID, name)
+// returns value.
+func (m *Manager) SetZoneModifier(zoneID, name string, value float64) error {
+	if !zoneModifierNames[name] {
+		return fmt.Errorf("scripting: unknown zone modifier %q", name)
+	}
+	if value <= 0 || value > maxZoneModifier {
+		return fmt.Errorf("scripting: zone modifier %q must be in (0, %g], got %g", name, maxZoneModifier, value)
+	}
+	m.modMu.Lock()
+	defer m.modMu.Unlock()
+	if m.modifiers == nil {
+		m.modifiers = make(map[string]map[string]float64)
+	}
+	if m.modifiers[zoneID] == nil {
+		m.modifiers[zoneID] = make(map[string]float64)
+	}
+	m.modifiers[zoneID][name] = value
+	return nil
+}
+
+// ZoneModifier returns the named modifier for zoneID.
+//
+// Postcondition: Returns 1 when the zone's scripts have not set it.
+func (m *Manager) ZoneModifier(zoneID, name string) float64 {
+	m.modMu.RLock()
+	defer m.modMu.RUnlock()
+	if v, ok := m.modifiers[zoneID][name]; ok {
+		return v
+	}
+	return 1
+}
+
+// swapZoneModifiers replaces zoneID's modifiers with mods and returns the
+// previous set, so a zone reload starts clean and a failed reload can restore.
+func (m *Manager) swapZoneModifiers(zoneID string, mods map[string]float64) map[string]float64 {
+	m.modMu.Lock()
+	defer m.modMu.Unlock()
+	prev := m.modifiers[zoneID]
+	if mods == nil {
+		delete(m.modifiers, zoneID)
+	} else {
+		if m.modifiers == nil {
+			m.modifiers = make(map[string]map[string]float64)
+		}
+		m.modifiers[zoneID] = mods
+	}
+	return prev
+}
+
+// newZoneModule returns the engine.zone table for the VM of zoneID.
+//
+// Precondition: L must be non-nil.
+// Postcondition: engine.zone.set_modifier(name, value [, zone]) and
+// engine.zone.get_modifier(name [, zone]) act on the VM's own zone unless
+// zone is given; the global VM must name one. set_modifier raises a Lua error
+// for an unknown name or out-of-range value.
+func (m *Manager) newZoneModule(L *lua.LState, zoneID string) *lua.LTable {
+	t := L.NewTable()
+	target := func(L *lua.LState, arg int) string {
+		z := L.OptString(arg, "")
+		if z == "" {
+			z = zoneID
+		}
+		if z == globalZoneID {
+			L.ArgError(arg, "zone is required outside zone scripts")
+		}
+		return z
+	}
+	L.SetField(t, "set_modifier", L.NewFunction(func(L *lua.LState) int {
+		name := L.CheckString(1)
+		value := float64(L.CheckNumber(2))
+		if err := m.SetZoneModifier(target(L, 3), name, value); err != nil {
+			L.RaiseError("engine.zone.set_modifier: %s", err.Error())
+		}
+		return 0
+	}))
+	L.SetField(t, "get_modifier", L.NewFunction(func(L *lua.LState) int {
+		name := L.CheckString(1)
+		L.Push(lua.LNumber(m.ZoneModifier(target(L, 2), name)))
+		return 1
+	}))
+	return t
+}
@@ -0,0 +1,63 @@
+package scripting_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/scripting"
+)
+
+func writeZoneScript(t *testing.T, src string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "modifiers.lua"), []byte(src), 0644))
+	return dir
+}
+
+func TestEngineZone_SetModifier_ScopedToScriptZone(t *testing.T) {
+	mgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	require.NoError(t, mgr.LoadZone("warzone", writeZoneScript(t, `
+engine.zone.set_modifier("damage_multiplier", 1.5)
+engine.zone.set_modifier("loot_multiplier", 2, "suburbs")
+function current_damage() return engine.zone.get_modifier("damage_multiplier") end
+`), 0))
+
+	assert.Equal(t, 1.5, mgr.ZoneModifier("warzone", scripting.ModDamageMultiplier))
+	assert.Equal(t, 2.0, mgr.ZoneModifier("suburbs", scripting.ModLootMultiplier))
+	assert.Equal(t, 1.0, mgr.ZoneModifier("warzone", scripting.ModRespawnRate))
+	ret, err := mgr.CallHook("warzone", "current_damage")
+	require.NoError(t, err)
+	assert.Equal(t, "1.5", ret.String())
+
+	// Reloading the zone drops modifiers its scripts no longer set.
+	require.NoError(t, mgr.LoadZone("warzone", writeZoneScript(t, `engine.zone.set_modifier("respawn_rate", 3)`), 0))
+	assert.Equal(t, 1.0, mgr.ZoneModifier("warzone", scripting.ModDamageMultiplier))
+	assert.Equal(t, 3.0, mgr.ZoneModifier("warzone", scripting.ModRespawnRate))
+
+	// A failed reload keeps the previous modifiers.
+	require.Error(t, mgr.LoadZone("warzone", writeZoneScript(t, `engine.zone.set_modifier("respawn_rate", 0)`), 0))
+	assert.Equal(t, 3.0, mgr.ZoneModifier("warzone", scripting.ModRespawnRate))
+}
+
+func TestEngineZone_SetModifier_RejectsInvalid(t *testing.T) {
+	for _, src := range []string{
+		`engine.zone.set_modifier("xp_multiplier", 2)`,
+		`engine.zone.set_modifier("damage_multiplier", -1)`,
+		`engine.zone.set_modifier("damage_multiplier", 11)`,
+	} {
+		mgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+		assert.Error(t, mgr.LoadZone("warzone", writeZoneScript(t, src), 0), src)
+	}
+
+	mgr := scripting.NewManager(dice.NewLoggedRoller(dice.NewCryptoSource(), zap.NewNop()), zap.NewNop())
+	err := mgr.LoadGlobal(writeZoneScript(t, `engine.zone.set_modifier("damage_multiplier", 2)`), 0)
+	assert.ErrorContains(t, err, "zone is required")
+	require.NoError(t, mgr.LoadGlobal(writeZoneScript(t, `engine.zone.set_modifier("damage_multiplier", 2, "warzone")`), 0))
+	assert.Equal(t, 2.0, mgr.ZoneModifier("warzone", scripting.ModDamageMultiplier))
+}
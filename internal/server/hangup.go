@@ -0,0 +1,35 @@
+package server
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// OnHangup calls fn each time the process receives SIGHUP, the conventional
+// request to re-read configuration.
+//
+// Precondition: fn must not be nil.
+// Postcondition: Returns a stop function; after it returns, fn is not called again.
+func OnHangup(fn func()) func() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-sigCh:
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(sigCh)
+		close(done)
+		<-stopped
+	}
+}
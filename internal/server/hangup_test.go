@@ -0,0 +1,22 @@
+package server
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnHangup_CallsFnOnSIGHUP(t *testing.T) {
+	called := make(chan struct{}, 1)
+	stop := OnHangup(func() { called <- struct{}{} })
+	defer stop()
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+	select {
+	case <-called:
+	case <-time.After(2 * time.Second):
+		t.Fatal("fn was not called after SIGHUP")
+	}
+}
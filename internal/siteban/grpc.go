@@ -0,0 +1,70 @@
+package siteban
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// ClientAddrMetadataKey carries the player's address from a frontend to the
+// game server, whose own peer is the frontend. The game server's gRPC port
+// is internal, so frontends are trusted to set it.
+const ClientAddrMetadataKey = "x-client-addr"
+
+// WithClientAddr returns ctx with addr attached as outgoing metadata.
+//
+// Postcondition: Returns ctx unchanged when addr is empty.
+func WithClientAddr(ctx context.Context, addr string) context.Context {
+	if addr == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, ClientAddrMetadataKey, addr)
+}
+
+// ClientAddr returns the address a frontend forwarded on an incoming call,
+// falling back to the call's peer address.
+//
+// Postcondition: Returns "" when neither is known.
+func ClientAddr(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(ClientAddrMetadataKey); len(v) > 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// StreamServerInterceptor refuses streams from addresses l blocks with
+// codes.PermissionDenied.
+//
+// Precondition: logger must be non-nil; l may be nil to allow everything.
+func StreamServerInterceptor(l *List, logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		raw := ClientAddr(ss.Context())
+		addr, ok := ParseAddr(raw)
+		if !ok {
+			return handler(srv, ss)
+		}
+		d, err := l.Check(ss.Context(), addr)
+		if err != nil {
+			logger.Warn("site ban check failed", zap.String("client_addr", raw), zap.Error(err))
+		}
+		if d.Blocked {
+			logger.Info("refused banned client",
+				zap.String("client_addr", raw),
+				zap.String("method", info.FullMethod),
+				zap.String("reason", d.Reason),
+			)
+			return status.Error(codes.PermissionDenied, d.Message())
+		}
+		return handler(srv, ss)
+	}
+}
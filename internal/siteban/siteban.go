@@ -0,0 +1,283 @@
+// Package siteban decides whether a client address may connect. Operators
+// keep allow and deny rules over CIDR ranges; addresses no rule covers may be
+// referred to a pluggable Hook such as an IP reputation or geolocation service.
+package siteban
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// hookTimeout bounds each Hook call so a slow external service cannot stall accepts.
+const hookTimeout = 2 * time.Second
+
+// Rule allows or denies every address in Prefix.
+type Rule struct {
+	Prefix netip.Prefix
+	// Allow exempts matching addresses from broader deny rules and the Hook.
+	Allow     bool
+	Reason    string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// Store persists rules.
+type Store interface {
+	ListSiteBans(ctx context.Context) ([]Rule, error)
+	// SaveSiteBan inserts r, replacing any rule for the same prefix.
+	SaveSiteBan(ctx context.Context, r Rule) error
+	// DeleteSiteBan removes the rule for p, reporting whether one existed.
+	DeleteSiteBan(ctx context.Context, p netip.Prefix) (bool, error)
+}
+
+// Hook consults an external source about an address no rule covers.
+type Hook interface {
+	Check(ctx context.Context, addr netip.Addr) (blocked bool, reason string, err error)
+}
+
+// HookFunc adapts a function to Hook.
+type HookFunc func(ctx context.Context, addr netip.Addr) (bool, string, error)
+
+// Check implements Hook.
+func (f HookFunc) Check(ctx context.Context, addr netip.Addr) (bool, string, error) {
+	return f(ctx, addr)
+}
+
+// Decision is the outcome of checking an address.
+type Decision struct {
+	Blocked bool
+	// Reason explains a block; it may be empty.
+	Reason string
+}
+
+// List holds the active rules. A nil List blocks nothing. It is safe for
+// concurrent use.
+type List struct {
+	mu    sync.RWMutex
+	rules []Rule
+	store Store
+	hook  Hook
+}
+
+// NewList returns an empty list backed by store.
+//
+// Precondition: store may be nil to keep rules in memory only.
+// Postcondition: Call Reload to load persisted rules.
+func NewList(store Store) *List {
+	return &List{store: store}
+}
+
+// SetHook installs the hook consulted for addresses no rule covers.
+//
+// Postcondition: h may be nil to disable the hook.
+func (l *List) SetHook(h Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hook = h
+}
+
+// Reload replaces the in-memory rules with the persisted ones.
+//
+// Postcondition: On error the current rules are kept.
+func (l *List) Reload(ctx context.Context) error {
+	if l.store == nil {
+		return nil
+	}
+	rules, err := l.store.ListSiteBans(ctx)
+	if err != nil {
+		return fmt.Errorf("loading site bans: %w", err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rules = rules
+	return nil
+}
+
+// StartRefresh reloads the rules every interval so changes made by another
+// process take effect.
+//
+// Postcondition: returns a stop function; call it to stop the goroutine.
+func (l *List) StartRefresh(interval time.Duration, logger *zap.Logger) func() {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := l.Reload(context.Background()); err != nil {
+					logger.Warn("refreshing site bans", zap.Error(err))
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// Rules returns a copy of the active rules ordered by prefix.
+func (l *List) Rules() []Rule {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	out := append([]Rule(nil), l.rules...)
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Prefix.String() < out[j].Prefix.String()
+	})
+	return out
+}
+
+// Put persists r and makes it active, replacing any rule for the same prefix.
+//
+// Precondition: r.Prefix must be valid.
+// Postcondition: r.Prefix is stored masked to its network address; a zero
+// r.CreatedAt is stamped with the current time.
+func (l *List) Put(ctx context.Context, r Rule) error {
+	r.Prefix = r.Prefix.Masked()
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = time.Now()
+	}
+	if l.store != nil {
+		if err := l.store.SaveSiteBan(ctx, r); err != nil {
+			return fmt.Errorf("saving site ban %s: %w", r.Prefix, err)
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range l.rules {
+		if l.rules[i].Prefix == r.Prefix {
+			l.rules[i] = r
+			return nil
+		}
+	}
+	l.rules = append(l.rules, r)
+	return nil
+}
+
+// Remove deletes the rule for p.
+//
+// Postcondition: Reports whether a rule existed.
+func (l *List) Remove(ctx context.Context, p netip.Prefix) (bool, error) {
+	p = p.Masked()
+	found := false
+	if l.store != nil {
+		var err error
+		if found, err = l.store.DeleteSiteBan(ctx, p); err != nil {
+			return false, fmt.Errorf("deleting site ban %s: %w", p, err)
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range l.rules {
+		if l.rules[i].Prefix == p {
+			l.rules = append(l.rules[:i], l.rules[i+1:]...)
+			return true, nil
+		}
+	}
+	return found, nil
+}
+
+// Check decides whether addr may connect. The most specific rule covering
+// addr wins, so an allowed host inside a denied range is let through and a
+// denied host inside an allowed range is not. Addresses no rule covers are
+// referred to the hook.
+//
+// Postcondition: A hook error is returned alongside a not-blocked Decision;
+// the list fails open rather than locking everyone out.
+func (l *List) Check(ctx context.Context, addr netip.Addr) (Decision, error) {
+	if l == nil {
+		return Decision{}, nil
+	}
+	addr = addr.Unmap()
+	l.mu.RLock()
+	var match *Rule
+	for i := range l.rules {
+		r := &l.rules[i]
+		if r.Prefix.Contains(addr) && (match == nil || r.Prefix.Bits() > match.Prefix.Bits()) {
+			match = r
+		}
+	}
+	var decided *Decision
+	if match != nil {
+		decided = &Decision{Blocked: !match.Allow, Reason: match.Reason}
+	}
+	hook := l.hook
+	l.mu.RUnlock()
+
+	if decided != nil {
+		return *decided, nil
+	}
+	if hook == nil {
+		return Decision{}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, hookTimeout)
+	defer cancel()
+	blocked, reason, err := hook.Check(ctx, addr)
+	if err != nil {
+		return Decision{}, fmt.Errorf("site ban hook for %s: %w", addr, err)
+	}
+	return Decision{Blocked: blocked, Reason: reason}, nil
+}
+
+// Message is the notice shown to a client refused by d.
+func (d Decision) Message() string {
+	if d.Reason == "" {
+		return "Your address is banned from this site."
+	}
+	return "Your address is banned from this site: " + d.Reason
+}
+
+// ParsePrefix parses a CIDR range or a bare address, which covers only itself.
+//
+// Postcondition: Returns the prefix masked to its network address, with
+// IPv4-mapped IPv6 addresses reduced to IPv4.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid address or CIDR %q", s)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid address or CIDR %q", s)
+	}
+	if p.Addr().Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	return p.Masked(), nil
+}
+
+// ParseAddr extracts the host address from "host:port" or a bare host.
+//
+// Postcondition: ok is false when s holds no IP address.
+func ParseAddr(s string) (addr netip.Addr, ok bool) {
+	if ap, err := netip.ParseAddrPort(s); err == nil {
+		return ap.Addr().Unmap(), true
+	}
+	if a, err := netip.ParseAddr(s); err == nil {
+		return a.Unmap(), true
+	}
+	return netip.Addr{}, false
+}
+
+// AddrOf returns the IP address of a network peer.
+//
+// Postcondition: ok is false for nil or non-IP addresses.
+func AddrOf(a net.Addr) (addr netip.Addr, ok bool) {
+	if a == nil {
+		return netip.Addr{}, false
+	}
+	return ParseAddr(a.String())
+}
@@ -0,0 +1,255 @@
+package siteban
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"pgregory.net/rapid"
+)
+
+type memStore struct {
+	rules map[netip.Prefix]Rule
+	fail  bool
+}
+
+func newMemStore() *memStore { return &memStore{rules: make(map[netip.Prefix]Rule)} }
+
+func (m *memStore) ListSiteBans(context.Context) ([]Rule, error) {
+	if m.fail {
+		return nil, errors.New("db down")
+	}
+	var out []Rule
+	for _, r := range m.rules {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (m *memStore) SaveSiteBan(_ context.Context, r Rule) error {
+	if m.fail {
+		return errors.New("db down")
+	}
+	m.rules[r.Prefix] = r
+	return nil
+}
+
+func (m *memStore) DeleteSiteBan(_ context.Context, p netip.Prefix) (bool, error) {
+	if m.fail {
+		return false, errors.New("db down")
+	}
+	_, ok := m.rules[p]
+	delete(m.rules, p)
+	return ok, nil
+}
+
+func mustPrefix(t *testing.T, s string) netip.Prefix {
+	t.Helper()
+	p, err := ParsePrefix(s)
+	require.NoError(t, err)
+	return p
+}
+
+func check(t *testing.T, l *List, addr string) Decision {
+	t.Helper()
+	d, err := l.Check(context.Background(), netip.MustParseAddr(addr))
+	require.NoError(t, err)
+	return d
+}
+
+func TestParsePrefix(t *testing.T) {
+	assert.Equal(t, "10.1.0.0/16", mustPrefix(t, "10.1.2.3/16").String())
+	assert.Equal(t, "10.1.2.3/32", mustPrefix(t, " 10.1.2.3 ").String())
+	assert.Equal(t, "2001:db8::/32", mustPrefix(t, "2001:db8::1/32").String())
+	assert.Equal(t, "10.1.2.3/32", mustPrefix(t, "::ffff:10.1.2.3").String())
+	assert.Equal(t, "10.1.0.0/16", mustPrefix(t, "::ffff:10.1.2.3/112").String())
+	_, err := ParsePrefix("example.com")
+	assert.Error(t, err)
+	_, err = ParsePrefix("10.0.0.0/33")
+	assert.Error(t, err)
+}
+
+func TestParseAddr(t *testing.T) {
+	a, ok := ParseAddr("10.0.0.7:4000")
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.7", a.String())
+	a, ok = ParseAddr("[::ffff:10.0.0.7]:4000")
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.7", a.String())
+	_, ok = ParseAddr("pipe")
+	assert.False(t, ok)
+	_, ok = AddrOf(nil)
+	assert.False(t, ok)
+	a, ok = AddrOf(&net.TCPAddr{IP: net.ParseIP("::1"), Port: 1})
+	require.True(t, ok)
+	assert.Equal(t, "::1", a.String())
+}
+
+func TestList_MostSpecificRuleWins(t *testing.T) {
+	l := NewList(nil)
+	ctx := context.Background()
+	require.NoError(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.0.0.0/8"), Reason: "abuse"}))
+	require.NoError(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.1.0.0/16"), Allow: true}))
+	require.NoError(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.1.2.3"), Reason: "spammer"}))
+
+	assert.Equal(t, Decision{Blocked: true, Reason: "abuse"}, check(t, l, "10.9.9.9"))
+	assert.Equal(t, Decision{}, check(t, l, "10.1.9.9"))
+	assert.Equal(t, Decision{Blocked: true, Reason: "spammer"}, check(t, l, "10.1.2.3"))
+	assert.Equal(t, Decision{Blocked: true, Reason: "spammer"}, check(t, l, "::ffff:10.1.2.3"))
+	assert.Equal(t, Decision{}, check(t, l, "192.168.0.1"))
+}
+
+func TestList_NilBlocksNothing(t *testing.T) {
+	var l *List
+	assert.Equal(t, Decision{}, check(t, l, "10.0.0.1"))
+}
+
+func TestList_PutReplacesAndRemoveDeletes(t *testing.T) {
+	store := newMemStore()
+	l := NewList(store)
+	ctx := context.Background()
+	require.NoError(t, l.Put(ctx, Rule{Prefix: netip.MustParsePrefix("10.1.2.3/16"), Reason: "first"}))
+	require.NoError(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.1.0.0/16"), Reason: "second"}))
+	require.Len(t, l.Rules(), 1)
+	assert.Equal(t, "second", l.Rules()[0].Reason)
+	assert.Contains(t, store.rules, mustPrefix(t, "10.1.0.0/16"))
+
+	found, err := l.Remove(ctx, netip.MustParsePrefix("10.1.9.9/16"))
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Empty(t, l.Rules())
+	assert.Empty(t, store.rules)
+
+	found, err = l.Remove(ctx, mustPrefix(t, "10.1.0.0/16"))
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestList_StoreFailureLeavesRulesUnchanged(t *testing.T) {
+	store := newMemStore()
+	l := NewList(store)
+	ctx := context.Background()
+	require.NoError(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.0.0.1")}))
+	store.fail = true
+	assert.Error(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.0.0.2")}))
+	assert.Error(t, l.Reload(ctx))
+	_, err := l.Remove(ctx, mustPrefix(t, "10.0.0.1"))
+	assert.Error(t, err)
+	assert.Len(t, l.Rules(), 1)
+}
+
+func TestList_ReloadPicksUpRulesFromStore(t *testing.T) {
+	store := newMemStore()
+	store.rules[mustPrefix(t, "10.0.0.1")] = Rule{Prefix: mustPrefix(t, "10.0.0.1"), Reason: "elsewhere"}
+	l := NewList(store)
+	assert.False(t, check(t, l, "10.0.0.1").Blocked)
+	require.NoError(t, l.Reload(context.Background()))
+	assert.Equal(t, Decision{Blocked: true, Reason: "elsewhere"}, check(t, l, "10.0.0.1"))
+}
+
+func TestList_HookConsultedOnlyWithoutMatchingRule(t *testing.T) {
+	l := NewList(nil)
+	ctx := context.Background()
+	require.NoError(t, l.Put(ctx, Rule{Prefix: mustPrefix(t, "10.0.0.0/8"), Allow: true}))
+	calls := 0
+	l.SetHook(HookFunc(func(_ context.Context, addr netip.Addr) (bool, string, error) {
+		calls++
+		if addr.Is6() {
+			return false, "", errors.New("lookup failed")
+		}
+		return true, "geo-blocked", nil
+	}))
+
+	assert.Equal(t, Decision{}, check(t, l, "10.0.0.1"))
+	assert.Zero(t, calls, "allow rules skip the hook")
+	assert.Equal(t, Decision{Blocked: true, Reason: "geo-blocked"}, check(t, l, "192.0.2.1"))
+	assert.Equal(t, 1, calls)
+
+	d, err := l.Check(ctx, netip.MustParseAddr("2001:db8::1"))
+	assert.Error(t, err)
+	assert.False(t, d.Blocked, "hook errors fail open")
+}
+
+func TestDecisionMessage(t *testing.T) {
+	assert.Equal(t, "Your address is banned from this site.", Decision{Blocked: true}.Message())
+	assert.Equal(t, "Your address is banned from this site: abuse", Decision{Blocked: true, Reason: "abuse"}.Message())
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context { return f.ctx }
+
+func TestClientAddr_PrefersForwardedAddress(t *testing.T) {
+	out := WithClientAddr(context.Background(), "203.0.113.9:5555")
+	md, _ := metadata.FromOutgoingContext(out)
+	in := peer.NewContext(metadata.NewIncomingContext(context.Background(), md),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 1}})
+	assert.Equal(t, "203.0.113.9:5555", ClientAddr(in))
+
+	in = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 1}})
+	assert.Equal(t, "10.0.0.2:1", ClientAddr(in))
+	assert.Empty(t, ClientAddr(context.Background()))
+	assert.Equal(t, context.Background(), WithClientAddr(context.Background(), ""))
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	l := NewList(nil)
+	require.NoError(t, l.Put(context.Background(), Rule{Prefix: mustPrefix(t, "203.0.113.0/24"), Reason: "abuse"}))
+	intercept := StreamServerInterceptor(l, zap.NewNop())
+	info := &grpc.StreamServerInfo{FullMethod: "/game.v1.GameService/Session"}
+	stream := func(addr string) grpc.ServerStream {
+		md := metadata.Pairs(ClientAddrMetadataKey, addr)
+		return &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	}
+	handled := 0
+	handler := func(any, grpc.ServerStream) error { handled++; return nil }
+
+	err := intercept(nil, stream("203.0.113.9:4000"), info, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "abuse")
+	assert.Zero(t, handled)
+
+	require.NoError(t, intercept(nil, stream("198.51.100.1:4000"), info, handler))
+	require.NoError(t, intercept(nil, &fakeServerStream{ctx: context.Background()}, info, handler))
+	assert.Equal(t, 2, handled)
+}
+
+// TestProperty_DenyRuleBlocksEveryAddressInRange verifies that a lone deny
+// rule blocks exactly the addresses its prefix contains.
+func TestProperty_DenyRuleBlocksEveryAddressInRange(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		var raw [4]byte
+		for i := range raw {
+			raw[i] = byte(rapid.IntRange(0, 255).Draw(rt, "octet"))
+		}
+		bits := rapid.IntRange(0, 32).Draw(rt, "bits")
+		p := netip.PrefixFrom(netip.AddrFrom4(raw), bits).Masked()
+		l := NewList(nil)
+		require.NoError(rt, l.Put(context.Background(), Rule{Prefix: p}))
+
+		var probe [4]byte
+		for i := range probe {
+			probe[i] = byte(rapid.IntRange(0, 255).Draw(rt, "probe"))
+		}
+		addr := netip.AddrFrom4(probe)
+		d, err := l.Check(context.Background(), addr)
+		require.NoError(rt, err)
+		if d.Blocked != p.Contains(addr) {
+			rt.Fatalf("%s in %s: blocked=%v", addr, p, d.Blocked)
+		}
+	})
+}
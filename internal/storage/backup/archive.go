@@ -0,0 +1,287 @@
+// Package backup defines the portable archive format used to back up and
+// restore the game database.
+//
+// An archive is a gzip-compressed tar file. Its first entry is manifest.json,
+// which records the migration version the data was taken at and, for every
+// table, its columns, row count, and the SHA-256 of its data. Each table
+// follows as tables/<name>.csv, in CSV with a header row, in the order the
+// manifest lists them: parents before the tables whose foreign keys point at
+// them, so restoring in archive order never violates a constraint.
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"time"
+)
+
+// FormatVersion is the archive layout this package writes and reads.
+const FormatVersion = 1
+
+// manifestName is the archive entry holding the Manifest.
+const manifestName = "manifest.json"
+
+// Manifest describes an archive's contents.
+type Manifest struct {
+	FormatVersion int       `json:"format_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	// SchemaVersion is the database migration version the data was taken at.
+	SchemaVersion uint    `json:"schema_version"`
+	Tables        []Table `json:"tables"`
+}
+
+// Table describes one table's data in an archive.
+type Table struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+	// SHA256 is the hex digest of the table's CSV entry.
+	SHA256 string `json:"sha256"`
+}
+
+// File returns the archive entry holding the table's data.
+func (t Table) File() string {
+	return path.Join("tables", t.Name+".csv")
+}
+
+// Writer builds an archive. Tables are staged in temporary files until Close,
+// because the manifest, which needs every table's checksum, comes first.
+type Writer struct {
+	w        io.Writer
+	manifest Manifest
+	staged   []*os.File
+}
+
+// NewWriter returns a Writer that writes an archive of data taken at
+// schemaVersion to w.
+//
+// Postcondition: Nothing is written to w until Close.
+func NewWriter(w io.Writer, schemaVersion uint) *Writer {
+	return &Writer{w: w, manifest: Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     time.Now().UTC(),
+		SchemaVersion: schemaVersion,
+	}}
+}
+
+// AddTable stages a table whose CSV, header row included, copy writes to its
+// argument, returning the number of data rows written.
+//
+// Precondition: Tables must be added parents first.
+// Postcondition: On error the Writer must be abandoned with Discard.
+func (w *Writer) AddTable(name string, columns []string, copy func(io.Writer) (int64, error)) error {
+	f, err := os.CreateTemp("", "mud-backup-*.csv")
+	if err != nil {
+		return fmt.Errorf("staging table %s: %w", name, err)
+	}
+	w.staged = append(w.staged, f)
+	h := sha256.New()
+	rows, err := copy(io.MultiWriter(f, h))
+	if err != nil {
+		return fmt.Errorf("copying table %s: %w", name, err)
+	}
+	w.manifest.Tables = append(w.manifest.Tables, Table{
+		Name:    name,
+		Columns: columns,
+		Rows:    rows,
+		SHA256:  hex.EncodeToString(h.Sum(nil)),
+	})
+	return nil
+}
+
+// Close writes the archive and removes the staged tables.
+//
+// Postcondition: Returns the archive's manifest, or a non-nil error.
+func (w *Writer) Close() (Manifest, error) {
+	defer w.Discard()
+	gz := gzip.NewWriter(w.w)
+	tw := tar.NewWriter(gz)
+	manifest, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, fmt.Errorf("encoding manifest: %w", err)
+	}
+	if err := writeEntry(tw, manifestName, int64(len(manifest)), w.manifest.CreatedAt, bytes.NewReader(manifest)); err != nil {
+		return Manifest{}, err
+	}
+	for i, t := range w.manifest.Tables {
+		f := w.staged[i]
+		size, err := f.Seek(0, io.SeekEnd)
+		if err == nil {
+			_, err = f.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			return Manifest{}, fmt.Errorf("rewinding table %s: %w", t.Name, err)
+		}
+		if err := writeEntry(tw, t.File(), size, w.manifest.CreatedAt, f); err != nil {
+			return Manifest{}, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return Manifest{}, fmt.Errorf("finishing archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return Manifest{}, fmt.Errorf("finishing archive: %w", err)
+	}
+	return w.manifest, nil
+}
+
+// Discard removes the staged tables without writing the archive. It is safe
+// to call more than once.
+func (w *Writer) Discard() {
+	for _, f := range w.staged {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	w.staged = nil
+}
+
+// writeEntry writes one regular file to tw.
+func writeEntry(tw *tar.Writer, name string, size int64, modTime time.Time, r io.Reader) error {
+	hdr := &tar.Header{Name: name, Mode: 0o644, Size: size, ModTime: modTime, Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
+
+// Reader reads an archive's tables in order.
+type Reader struct {
+	tr       *tar.Reader
+	manifest Manifest
+	next     int
+}
+
+// NewReader reads the manifest at the start of the archive in r.
+//
+// Postcondition: Returns a Reader positioned at the first table, or a
+// non-nil error if r is not an archive in a supported format.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	if hdr.Name != manifestName {
+		return nil, fmt.Errorf("archive starts with %s, not %s", hdr.Name, manifestName)
+	}
+	var m Manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w", err)
+	}
+	if m.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("archive format version %d is not supported (want %d)", m.FormatVersion, FormatVersion)
+	}
+	return &Reader{tr: tr, manifest: m}, nil
+}
+
+// Manifest returns the archive's manifest.
+func (r *Reader) Manifest() Manifest {
+	return r.manifest
+}
+
+// Next returns the next table and a reader of its CSV data.
+//
+// Postcondition: Returns io.EOF after the last table. The data reader fails
+// at its end if the data does not match the manifest's checksum, and is only
+// valid until the next call to Next.
+func (r *Reader) Next() (Table, io.Reader, error) {
+	if r.next == len(r.manifest.Tables) {
+		return Table{}, nil, io.EOF
+	}
+	t := r.manifest.Tables[r.next]
+	r.next++
+	hdr, err := r.tr.Next()
+	if errors.Is(err, io.EOF) {
+		return Table{}, nil, fmt.Errorf("archive ends before table %s", t.Name)
+	}
+	if err != nil {
+		return Table{}, nil, fmt.Errorf("reading archive: %w", err)
+	}
+	if hdr.Name != t.File() {
+		return Table{}, nil, fmt.Errorf("archive has %s where the manifest expects %s", hdr.Name, t.File())
+	}
+	return t, &checkedReader{r: r.tr, h: sha256.New(), table: t}, nil
+}
+
+// checkedReader hashes what it reads and fails at EOF on a checksum mismatch.
+type checkedReader struct {
+	r     io.Reader
+	h     hash.Hash
+	table Table
+}
+
+func (c *checkedReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.h.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		if sum := hex.EncodeToString(c.h.Sum(nil)); sum != c.table.SHA256 {
+			return n, fmt.Errorf("table %s is corrupt: checksum %s, manifest says %s", c.table.Name, sum, c.table.SHA256)
+		}
+	}
+	return n, err
+}
+
+// Verify reads the whole archive in r, checking every table against the
+// manifest without touching a database.
+//
+// Postcondition: Returns the manifest, or a non-nil error naming the first
+// table whose data is missing, corrupt, or has a different row count or
+// header than the manifest records.
+func Verify(r io.Reader) (Manifest, error) {
+	ar, err := NewReader(r)
+	if err != nil {
+		return Manifest{}, err
+	}
+	for {
+		t, data, err := ar.Next()
+		if errors.Is(err, io.EOF) {
+			return ar.Manifest(), nil
+		}
+		if err != nil {
+			return Manifest{}, err
+		}
+		cr := csv.NewReader(data)
+		cr.FieldsPerRecord = len(t.Columns)
+		header, err := cr.Read()
+		if err != nil {
+			return Manifest{}, fmt.Errorf("table %s: reading header: %w", t.Name, err)
+		}
+		for i, col := range header {
+			if col != t.Columns[i] {
+				return Manifest{}, fmt.Errorf("table %s: column %d is %q, manifest says %q", t.Name, i+1, col, t.Columns[i])
+			}
+		}
+		var rows int64
+		for {
+			_, err := cr.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return Manifest{}, fmt.Errorf("table %s: %w", t.Name, err)
+			}
+			rows++
+		}
+		if rows != t.Rows {
+			return Manifest{}, fmt.Errorf("table %s has %d rows, manifest says %d", t.Name, rows, t.Rows)
+		}
+	}
+}
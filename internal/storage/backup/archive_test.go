@@ -0,0 +1,118 @@
+package backup_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/backup"
+)
+
+// csvCopy returns an AddTable copy func that writes data and reports rows.
+func csvCopy(data string, rows int64) func(io.Writer) (int64, error) {
+	return func(w io.Writer) (int64, error) {
+		_, err := io.WriteString(w, data)
+		return rows, err
+	}
+}
+
+func writeArchive(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := backup.NewWriter(&buf, 90)
+	require.NoError(t, w.AddTable("accounts", []string{"id", "username"}, csvCopy("id,username\n1,vex\n2,\"o,neil\"\n", 2)))
+	require.NoError(t, w.AddTable("characters", []string{"id", "account_id", "name"}, csvCopy("id,account_id,name\n7,1,Vex\n", 1)))
+	m, err := w.Close()
+	require.NoError(t, err)
+	require.Len(t, m.Tables, 2)
+	return buf.Bytes()
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	data := writeArchive(t)
+
+	r, err := backup.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	m := r.Manifest()
+	assert.Equal(t, backup.FormatVersion, m.FormatVersion)
+	assert.Equal(t, uint(90), m.SchemaVersion)
+
+	table, body, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "accounts", table.Name)
+	assert.Equal(t, int64(2), table.Rows)
+	got, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "id,username\n1,vex\n2,\"o,neil\"\n", string(got))
+
+	table, _, err = r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "characters", table.Name)
+	_, _, err = r.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestVerify_AcceptsIntactArchive(t *testing.T) {
+	m, err := backup.Verify(bytes.NewReader(writeArchive(t)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"accounts", "characters"}, []string{m.Tables[0].Name, m.Tables[1].Name})
+}
+
+func TestVerify_RejectsRowCountMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	w := backup.NewWriter(&buf, 1)
+	require.NoError(t, w.AddTable("mail", []string{"id"}, csvCopy("id\n1\n2\n", 3)))
+	_, err := w.Close()
+	require.NoError(t, err)
+
+	_, err = backup.Verify(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mail has 2 rows, manifest says 3")
+}
+
+func TestVerify_RejectsHeaderMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	w := backup.NewWriter(&buf, 1)
+	require.NoError(t, w.AddTable("mail", []string{"id", "body"}, csvCopy("id,subject\n1,hi\n", 1)))
+	_, err := w.Close()
+	require.NoError(t, err)
+
+	_, err = backup.Verify(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `column 2 is "subject"`)
+}
+
+func TestVerify_DetectsCorruptTable(t *testing.T) {
+	// Hand-build an archive whose data does not match its manifest's checksum.
+	manifest, err := json.Marshal(backup.Manifest{
+		FormatVersion: backup.FormatVersion,
+		Tables:        []backup.Table{{Name: "mail", Columns: []string{"body"}, Rows: 1, SHA256: "00"}},
+	})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range []struct{ name, body string }{{"manifest.json", string(manifest)}, {"tables/mail.csv", "body\nhello\n"}} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(e.body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	_, err = backup.Verify(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mail is corrupt")
+}
+
+func TestNewReader_RejectsNonArchive(t *testing.T) {
+	_, err := backup.NewReader(strings.NewReader("not an archive"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Order sorts tables so that every table comes after the tables it
+// references, breaking ties by name so the order is stable across runs.
+//
+// Precondition: refs maps a table to the tables its foreign keys reference;
+// a table referencing itself is allowed.
+// Postcondition: Returns every table exactly once, or a non-nil error naming
+// the tables caught in a reference cycle.
+func Order(tables []string, refs map[string][]string) ([]string, error) {
+	pending := make(map[string]int, len(tables))
+	children := make(map[string][]string)
+	for _, t := range tables {
+		pending[t] = 0
+	}
+	for child, parents := range refs {
+		if _, ok := pending[child]; !ok {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, p := range parents {
+			if _, ok := pending[p]; !ok || p == child || seen[p] {
+				continue
+			}
+			seen[p] = true
+			pending[child]++
+			children[p] = append(children[p], child)
+		}
+	}
+
+	var ready, out []string
+	for t, n := range pending {
+		if n == 0 {
+			ready = append(ready, t)
+		}
+	}
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		t := ready[0]
+		ready = ready[1:]
+		out = append(out, t)
+		delete(pending, t)
+		for _, c := range children[t] {
+			pending[c]--
+			if pending[c] == 0 {
+				ready = append(ready, c)
+			}
+		}
+	}
+	if len(pending) > 0 {
+		var cycle []string
+		for t := range pending {
+			cycle = append(cycle, t)
+		}
+		sort.Strings(cycle)
+		return nil, fmt.Errorf("tables reference each other in a cycle: %s", strings.Join(cycle, ", "))
+	}
+	return out, nil
+}
@@ -0,0 +1,67 @@
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/storage/backup"
+)
+
+func TestOrder_ParentsFirst(t *testing.T) {
+	got, err := backup.Order(
+		[]string{"mail", "characters", "accounts", "character_inventory"},
+		map[string][]string{
+			"characters":          {"accounts"},
+			"character_inventory": {"characters", "characters"},
+			"mail":                {"characters", "mail"},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"accounts", "characters", "character_inventory", "mail"}, got)
+}
+
+func TestOrder_RejectsCycle(t *testing.T) {
+	_, err := backup.Order([]string{"a", "b", "c"}, map[string][]string{"a": {"b"}, "b": {"a"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a, b")
+}
+
+func TestProperty_OrderPlacesParentsBeforeChildren(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		n := rapid.IntRange(1, 12).Draw(t, "tables")
+		tables := make([]string, n)
+		for i := range tables {
+			tables[i] = string(rune('a' + i))
+		}
+		// Only reference earlier tables so the graph is acyclic.
+		refs := make(map[string][]string)
+		for i := 1; i < n; i++ {
+			for j := 0; j < i; j++ {
+				if rapid.Bool().Draw(t, "ref") {
+					refs[tables[i]] = append(refs[tables[i]], tables[j])
+				}
+			}
+		}
+		got, err := backup.Order(tables, refs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pos := make(map[string]int, len(got))
+		for i, tbl := range got {
+			pos[tbl] = i
+		}
+		if len(pos) != n {
+			t.Fatalf("got %v, want each of %v once", got, tables)
+		}
+		for child, parents := range refs {
+			for _, p := range parents {
+				if pos[p] > pos[child] {
+					t.Fatalf("%s placed after its child %s in %v", p, child, got)
+				}
+			}
+		}
+	})
+}
@@ -38,7 +38,14 @@ type Account struct {
 	PasswordHash string
 	Role         string
 	Banned       bool
-	CreatedAt    time.Time
+	// Locale is the account's preferred message locale (e.g. "en", "es").
+	Locale string
+	// CharacterSlots overrides the configured character limit; 0 means no override.
+	CharacterSlots int
+	// DeletionRequestedAt is when the owner asked to delete the account; nil
+	// when no deletion is pending. See AccountDeleteWindow.
+	DeletionRequestedAt *time.Time
+	CreatedAt           time.Time
 }
 
 // ErrAccountNotFound is returned when an account lookup yields no results.
@@ -99,10 +106,10 @@ func (r *AccountRepository) Create(ctx context.Context, username, password strin
 func (r *AccountRepository) Authenticate(ctx context.Context, username, password string) (Account, error) {
 	var acct Account
 	err := r.db.QueryRow(ctx,
-		`SELECT id, username, password_hash, role, banned, created_at
+		`SELECT id, username, password_hash, role, banned, character_slots, deletion_requested_at, created_at
 		 FROM accounts WHERE username = $1`,
 		username,
-	).Scan(&acct.ID, &acct.Username, &acct.PasswordHash, &acct.Role, &acct.Banned, &acct.CreatedAt)
+	).Scan(&acct.ID, &acct.Username, &acct.PasswordHash, &acct.Role, &acct.Banned, &acct.CharacterSlots, &acct.DeletionRequestedAt, &acct.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return Account{}, ErrAccountNotFound
@@ -124,10 +131,10 @@ func (r *AccountRepository) Authenticate(ctx context.Context, username, password
 func (r *AccountRepository) GetByUsername(ctx context.Context, username string) (Account, error) {
 	var acct Account
 	err := r.db.QueryRow(ctx,
-		`SELECT id, username, password_hash, role, banned, created_at
+		`SELECT id, username, password_hash, role, banned, locale, character_slots, deletion_requested_at, created_at
 		 FROM accounts WHERE username = $1`,
 		username,
-	).Scan(&acct.ID, &acct.Username, &acct.PasswordHash, &acct.Role, &acct.Banned, &acct.CreatedAt)
+	).Scan(&acct.ID, &acct.Username, &acct.PasswordHash, &acct.Role, &acct.Banned, &acct.Locale, &acct.CharacterSlots, &acct.DeletionRequestedAt, &acct.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return Account{}, ErrAccountNotFound
@@ -166,10 +173,10 @@ func (r *AccountRepository) SetRole(ctx context.Context, accountID int64, role s
 func (r *AccountRepository) GetByID(ctx context.Context, id int64) (Account, error) {
 	var acct Account
 	err := r.db.QueryRow(ctx,
-		`SELECT id, username, password_hash, role, banned, created_at
+		`SELECT id, username, password_hash, role, banned, locale, character_slots, deletion_requested_at, created_at
 		 FROM accounts WHERE id = $1`,
 		id,
-	).Scan(&acct.ID, &acct.Username, &acct.PasswordHash, &acct.Role, &acct.Banned, &acct.CreatedAt)
+	).Scan(&acct.ID, &acct.Username, &acct.PasswordHash, &acct.Role, &acct.Banned, &acct.Locale, &acct.CharacterSlots, &acct.DeletionRequestedAt, &acct.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return Account{}, ErrAccountNotFound
@@ -238,6 +245,45 @@ func (r *AccountRepository) UpdateRoleAndBanned(ctx context.Context, id int64, r
 	return nil
 }
 
+// SetLocale updates the preferred message locale for the given account.
+//
+// Precondition: accountID must be > 0; locale must be non-empty.
+// Postcondition: The account's locale is updated, or ErrAccountNotFound is returned.
+func (r *AccountRepository) SetLocale(ctx context.Context, accountID int64, locale string) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE accounts SET locale = $1 WHERE id = $2`,
+		locale, accountID,
+	)
+	if err != nil {
+		return fmt.Errorf("updating locale: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrAccountNotFound
+	}
+	return nil
+}
+
+// SetCharacterSlots sets the account's character limit override; 0 clears it.
+//
+// Precondition: accountID must be > 0; slots must be >= 0.
+// Postcondition: The override is updated, or ErrAccountNotFound is returned.
+func (r *AccountRepository) SetCharacterSlots(ctx context.Context, accountID int64, slots int) error {
+	if slots < 0 {
+		return fmt.Errorf("character slots must be >= 0, got %d", slots)
+	}
+	tag, err := r.db.Exec(ctx,
+		`UPDATE accounts SET character_slots = $1 WHERE id = $2`,
+		slots, accountID,
+	)
+	if err != nil {
+		return fmt.Errorf("updating character slots: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrAccountNotFound
+	}
+	return nil
+}
+
 // HashPassword creates a bcrypt hash of the given password.
 //
 // Precondition: password must be non-empty.
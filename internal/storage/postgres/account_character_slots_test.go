@@ -0,0 +1,35 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestAccountRepository_SetCharacterSlots(t *testing.T) {
+	repo := postgres.NewAccountRepository(sharedPool)
+	ctx := context.Background()
+	acct, err := repo.Create(ctx, uniqueName("slots"), "password123")
+	require.NoError(t, err)
+	assert.Equal(t, 0, acct.CharacterSlots)
+
+	require.NoError(t, repo.SetCharacterSlots(ctx, acct.ID, 8))
+	got, err := repo.GetByID(ctx, acct.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 8, got.CharacterSlots)
+	authed, err := repo.Authenticate(ctx, acct.Username, "password123")
+	require.NoError(t, err)
+	assert.Equal(t, 8, authed.CharacterSlots)
+
+	require.NoError(t, repo.SetCharacterSlots(ctx, acct.ID, 0))
+	got, err = repo.GetByUsername(ctx, acct.Username)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.CharacterSlots)
+
+	assert.Error(t, repo.SetCharacterSlots(ctx, acct.ID, -1))
+	assert.ErrorIs(t, repo.SetCharacterSlots(ctx, 99999999, 3), postgres.ErrAccountNotFound)
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// AccountDeleteWindow is how long an account whose owner asked to delete it
+// can still be recovered before PurgeAccount removes it.
+const AccountDeleteWindow = 14 * 24 * time.Hour
+
+// ErrNoDeletionPending is returned when cancelling a deletion that was never requested.
+var ErrNoDeletionPending = errors.New("no account deletion pending")
+
+// PurgeMode selects how PurgeAccount treats an account's personal data.
+type PurgeMode string
+
+const (
+	// PurgeAnonymize keeps the account row and the game's history, such as
+	// telemetry events and player reports, with every name scrubbed.
+	PurgeAnonymize PurgeMode = "anonymize"
+	// PurgeDelete removes the account row and the history of its characters.
+	PurgeDelete PurgeMode = "delete"
+)
+
+// ValidPurgeMode reports whether mode is a recognised PurgeMode.
+func ValidPurgeMode(mode PurgeMode) bool {
+	return mode == PurgeAnonymize || mode == PurgeDelete
+}
+
+// PurgedName replaces a purged account's or character's name wherever it is kept.
+const PurgedName = "[purged]"
+
+// PurgeReport describes what PurgeAccount removed.
+type PurgeReport struct {
+	AccountID  int64
+	Mode       PurgeMode
+	Characters int
+	// Events and Reports count the telemetry events and player reports that
+	// were deleted or, with PurgeAnonymize, scrubbed.
+	Events  int64
+	Reports int64
+}
+
+// RequestDeletion schedules the account for purging once AccountDeleteWindow
+// elapses. Requesting again keeps the original time.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns when the deletion was requested, or ErrAccountNotFound.
+func (r *AccountRepository) RequestDeletion(ctx context.Context, accountID int64) (time.Time, error) {
+	var at time.Time
+	err := r.db.QueryRow(ctx,
+		`UPDATE accounts SET deletion_requested_at = COALESCE(deletion_requested_at, NOW())
+		 WHERE id = $1 AND purged_at IS NULL
+		 RETURNING deletion_requested_at`,
+		accountID,
+	).Scan(&at)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, ErrAccountNotFound
+		}
+		return time.Time{}, fmt.Errorf("requesting deletion of account %d: %w", accountID, err)
+	}
+	return at, nil
+}
+
+// CancelDeletion keeps an account whose deletion is pending.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns ErrNoDeletionPending if the account has no pending deletion.
+func (r *AccountRepository) CancelDeletion(ctx context.Context, accountID int64) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE accounts SET deletion_requested_at = NULL WHERE id = $1 AND deletion_requested_at IS NOT NULL`,
+		accountID,
+	)
+	if err != nil {
+		return fmt.Errorf("cancelling deletion of account %d: %w", accountID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNoDeletionPending
+	}
+	return nil
+}
+
+// ListExpiredDeletions returns the IDs of accounts whose deletion was
+// requested before cutoff, oldest request first.
+func (r *AccountRepository) ListExpiredDeletions(ctx context.Context, cutoff time.Time) ([]int64, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id FROM accounts
+		 WHERE deletion_requested_at IS NOT NULL AND deletion_requested_at < $1
+		 ORDER BY deletion_requested_at`,
+		cutoff,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing expired account deletions: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, fmt.Errorf("listing expired account deletions: %w", err)
+	}
+	return ids, nil
+}
+
+// PurgeAccount removes the account's personal data in one transaction: its
+// characters and everything they own, its settings, flags, shared stash and
+// achievements, and login throttle records, and every copy of its username
+// and character names in telemetry events, player reports, and the admin
+// audit log. With
+// PurgeAnonymize the account row is kept with its username replaced and its
+// password cleared so it can never sign in; with PurgeDelete it is removed.
+//
+// The admin audit log is always scrubbed rather than trimmed, so staff
+// actions stay accountable.
+//
+// Precondition: accountID must be > 0; mode must satisfy ValidPurgeMode.
+// Postcondition: Either everything is purged or nothing changes and a non-nil
+// error is returned; ErrAccountNotFound if no unpurged account has the ID.
+func (r *AccountRepository) PurgeAccount(ctx context.Context, accountID int64, mode PurgeMode) (PurgeReport, error) {
+	if !ValidPurgeMode(mode) {
+		return PurgeReport{}, fmt.Errorf("invalid purge mode %q", mode)
+	}
+	report := PurgeReport{AccountID: accountID, Mode: mode}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("starting purge of account %d: %w", accountID, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var username string
+	err = tx.QueryRow(ctx,
+		`SELECT username FROM accounts WHERE id = $1 AND purged_at IS NULL FOR UPDATE`, accountID,
+	).Scan(&username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PurgeReport{}, ErrAccountNotFound
+		}
+		return PurgeReport{}, fmt.Errorf("locking account %d: %w", accountID, err)
+	}
+
+	rows, err := tx.Query(ctx, `SELECT id, name FROM characters WHERE account_id = $1`, accountID)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("listing characters of account %d: %w", accountID, err)
+	}
+	var (
+		charIDs []int64
+		names   = []string{strings.ToLower(username)}
+		id      int64
+		name    string
+	)
+	_, err = pgx.ForEachRow(rows, []any{&id, &name}, func() error {
+		charIDs = append(charIDs, id)
+		names = append(names, strings.ToLower(name))
+		return nil
+	})
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("listing characters of account %d: %w", accountID, err)
+	}
+	report.Characters = len(charIDs)
+
+	// History kept after the characters are gone.
+	eventsSQL := `DELETE FROM game_events WHERE character_id = ANY($1)`
+	reportsSQL := `DELETE FROM reports WHERE character_id = ANY($1)`
+	if mode == PurgeAnonymize {
+		eventsSQL = `UPDATE game_events SET character_id = 0, character = '` + PurgedName + `' WHERE character_id = ANY($1)`
+		reportsSQL = `UPDATE reports SET character_id = NULL, char_name = '` + PurgedName + `' WHERE character_id = ANY($1)`
+	}
+	tag, err := tx.Exec(ctx, eventsSQL, charIDs)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("purging telemetry events: %w", err)
+	}
+	report.Events = tag.RowsAffected()
+	tag, err = tx.Exec(ctx, reportsSQL, charIDs)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("purging player reports: %w", err)
+	}
+	report.Reports = tag.RowsAffected()
+
+	// Other players' history naming these characters keeps its rows.
+	scrubs := []struct{ what, sql string }{
+		{"telemetry counterparties", `UPDATE game_events SET counterparty = '` + PurgedName + `' WHERE lower(counterparty) = ANY($1)`},
+		{"admin audit actors", `UPDATE admin_audit SET actor = '` + PurgedName + `' WHERE lower(actor) = ANY($1)`},
+		{"admin audit targets", `UPDATE admin_audit SET target = '` + PurgedName + `' WHERE lower(target) = ANY($1)`},
+		{"report closers", `UPDATE reports SET closed_by = '` + PurgedName + `' WHERE lower(closed_by) = ANY($1)`},
+	}
+	for _, s := range scrubs {
+		if _, err := tx.Exec(ctx, s.sql, names); err != nil {
+			return PurgeReport{}, fmt.Errorf("scrubbing %s: %w", s.what, err)
+		}
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM login_failures WHERE key = $1`, "account:"+strings.ToLower(username)); err != nil {
+		return PurgeReport{}, fmt.Errorf("purging login failures: %w", err)
+	}
+
+	// Everything a character owns cascades from its row.
+	if _, err := tx.Exec(ctx, `DELETE FROM characters WHERE account_id = $1`, accountID); err != nil {
+		return PurgeReport{}, fmt.Errorf("deleting characters of account %d: %w", accountID, err)
+	}
+
+	if mode == PurgeDelete {
+		if _, err := tx.Exec(ctx, `DELETE FROM accounts WHERE id = $1`, accountID); err != nil {
+			return PurgeReport{}, fmt.Errorf("deleting account %d: %w", accountID, err)
+		}
+	} else {
+		for _, sql := range []string{
+			`DELETE FROM account_settings WHERE account_id = $1`,
+			`DELETE FROM account_feature_flags WHERE account_id = $1`,
+			`DELETE FROM account_stash WHERE account_id = $1`,
+			`DELETE FROM account_achievements WHERE account_id = $1`,
+			`UPDATE accounts SET username = 'purged_' || id, password_hash = '', role = 'player',
+			   locale = 'en', character_slots = 0, deletion_requested_at = NULL, purged_at = NOW()
+			 WHERE id = $1`,
+		} {
+			if _, err := tx.Exec(ctx, sql, accountID); err != nil {
+				return PurgeReport{}, fmt.Errorf("anonymizing account %d: %w", accountID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PurgeReport{}, fmt.Errorf("committing purge of account %d: %w", accountID, err)
+	}
+	return report, nil
+}
@@ -0,0 +1,111 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// seedPurgeTarget creates an account with one character that has a telemetry
+// event, a player report, a faction standing, and an audit entry naming it.
+func seedPurgeTarget(t *testing.T) (postgres.Account, int64, string) {
+	t.Helper()
+	ctx := context.Background()
+	accounts := postgres.NewAccountRepository(sharedPool)
+	acct, err := accounts.Create(ctx, uniqueName("purge"), "password123")
+	require.NoError(t, err)
+	name := uniqueName("Purgee")
+	ch, err := postgres.NewCharacterRepository(sharedPool).Create(ctx, makeTestCharacter(acct.ID, name))
+	require.NoError(t, err)
+
+	require.NoError(t, postgres.NewGameEventRepository(sharedPool).Write(ctx, []telemetry.Event{
+		{Type: telemetry.TypeLevel, Time: time.Now(), CharacterID: ch.ID, Character: name, Amount: 2},
+	}))
+	_, err = postgres.NewReportRepository(sharedPool).CreateReport(ctx, postgres.Report{Kind: "bug", CharacterID: ch.ID, CharName: name, Body: "stuck"})
+	require.NoError(t, err)
+	_, err = sharedPool.Exec(ctx, `INSERT INTO character_faction_rep (character_id, faction_id, rep) VALUES ($1, 'gangers', 5)`, ch.ID)
+	require.NoError(t, err)
+	require.NoError(t, postgres.NewAdminAuditRepository(sharedPool).Record(ctx, postgres.AdminAuditEntry{
+		Actor: "cli", Action: "setrole", Target: acct.Username, Outcome: "ok",
+	}))
+	return acct, ch.ID, name
+}
+
+func count(t *testing.T, sql string, args ...any) int {
+	t.Helper()
+	var n int
+	require.NoError(t, sharedPool.QueryRow(context.Background(), sql, args...).Scan(&n))
+	return n
+}
+
+func TestPurgeAccount_Anonymize(t *testing.T) {
+	ctx := context.Background()
+	acct, charID, name := seedPurgeTarget(t)
+	accounts := postgres.NewAccountRepository(sharedPool)
+
+	report, err := accounts.PurgeAccount(ctx, acct.ID, postgres.PurgeAnonymize)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Characters)
+	assert.Equal(t, int64(1), report.Events)
+	assert.Equal(t, int64(1), report.Reports)
+
+	assert.Zero(t, count(t, `SELECT count(*) FROM characters WHERE id = $1`, charID))
+	assert.Zero(t, count(t, `SELECT count(*) FROM game_events WHERE character = $1 OR character_id = $2`, name, charID))
+	assert.Zero(t, count(t, `SELECT count(*) FROM reports WHERE char_name = $1`, name))
+	assert.Zero(t, count(t, `SELECT count(*) FROM admin_audit WHERE target = $1`, acct.Username))
+
+	purged, err := accounts.GetByID(ctx, acct.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, acct.Username, purged.Username)
+	_, err = accounts.Authenticate(ctx, purged.Username, "password123")
+	assert.ErrorIs(t, err, postgres.ErrInvalidCredentials)
+
+	_, err = accounts.PurgeAccount(ctx, acct.ID, postgres.PurgeAnonymize)
+	assert.ErrorIs(t, err, postgres.ErrAccountNotFound, "an anonymized account cannot be purged twice")
+}
+
+func TestPurgeAccount_Delete(t *testing.T) {
+	ctx := context.Background()
+	acct, charID, _ := seedPurgeTarget(t)
+	accounts := postgres.NewAccountRepository(sharedPool)
+
+	_, err := accounts.PurgeAccount(ctx, acct.ID, postgres.PurgeDelete)
+	require.NoError(t, err)
+	_, err = accounts.GetByID(ctx, acct.ID)
+	assert.ErrorIs(t, err, postgres.ErrAccountNotFound)
+	assert.Zero(t, count(t, `SELECT count(*) FROM game_events WHERE character_id = $1`, charID))
+	assert.Zero(t, count(t, `SELECT count(*) FROM reports WHERE character_id = $1`, charID))
+}
+
+func TestAccountDeletion_RequestCancelExpire(t *testing.T) {
+	ctx := context.Background()
+	accounts := postgres.NewAccountRepository(sharedPool)
+	acct, err := accounts.Create(ctx, uniqueName("leaver"), "password123")
+	require.NoError(t, err)
+
+	first, err := accounts.RequestDeletion(ctx, acct.ID)
+	require.NoError(t, err)
+	again, err := accounts.RequestDeletion(ctx, acct.ID)
+	require.NoError(t, err)
+	assert.True(t, first.Equal(again), "requesting again keeps the original time")
+
+	got, err := accounts.GetByID(ctx, acct.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.DeletionRequestedAt)
+
+	ids, err := accounts.ListExpiredDeletions(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Contains(t, ids, acct.ID)
+	ids, err = accounts.ListExpiredDeletions(ctx, time.Now().Add(-postgres.AccountDeleteWindow))
+	require.NoError(t, err)
+	assert.NotContains(t, ids, acct.ID)
+
+	require.NoError(t, accounts.CancelDeletion(ctx, acct.ID))
+	assert.ErrorIs(t, accounts.CancelDeletion(ctx, acct.ID), postgres.ErrNoDeletionPending)
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/settings"
+)
+
+// AccountSettingsRepository persists account-level preferences as a JSONB document.
+type AccountSettingsRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewAccountSettingsRepository constructs an AccountSettingsRepository backed by the given pool.
+func NewAccountSettingsRepository(db *pgxpool.Pool) *AccountSettingsRepository {
+	return &AccountSettingsRepository{db: db}
+}
+
+// Get returns the stored settings for accountID.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns the zero Settings when no row exists; unknown JSON
+// keys are ignored.
+func (r *AccountSettingsRepository) Get(ctx context.Context, accountID int64) (settings.Settings, error) {
+	var raw []byte
+	err := r.db.QueryRow(ctx,
+		`SELECT settings FROM account_settings WHERE account_id = $1`, accountID,
+	).Scan(&raw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return settings.Settings{}, nil
+	}
+	if err != nil {
+		return settings.Settings{}, fmt.Errorf("loading account settings: %w", err)
+	}
+	var s settings.Settings
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return settings.Settings{}, fmt.Errorf("decoding account settings: %w", err)
+	}
+	return s, nil
+}
+
+// Save replaces the stored settings for accountID.
+//
+// Precondition: accountID must reference an existing account; s must pass Validate.
+// Postcondition: The account's settings row equals s.
+func (r *AccountSettingsRepository) Save(ctx context.Context, accountID int64, s settings.Settings) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encoding account settings: %w", err)
+	}
+	_, err = r.db.Exec(ctx,
+		`INSERT INTO account_settings (account_id, settings, updated_at)
+		 VALUES ($1, $2::jsonb, NOW())
+		 ON CONFLICT (account_id) DO UPDATE SET settings = EXCLUDED.settings, updated_at = NOW()`,
+		accountID, string(raw))
+	if err != nil {
+		return fmt.Errorf("saving account settings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/settings"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestAccountSettingsRepository_GetMissingReturnsZero(t *testing.T) {
+	repo := postgres.NewAccountSettingsRepository(sharedPool)
+	got, err := repo.Get(context.Background(), 987654321)
+	require.NoError(t, err)
+	assert.Equal(t, settings.Settings{}, got)
+}
+
+func TestAccountSettingsRepository_SaveAndGet(t *testing.T) {
+	ctx := context.Background()
+	acct, err := postgres.NewAccountRepository(sharedPool).Create(ctx, uniqueName("settings_user"), "password123")
+	require.NoError(t, err)
+	repo := postgres.NewAccountSettingsRepository(sharedPool)
+
+	want := settings.Settings{ColorTheme: settings.ThemeDark, CombatVerbosity: "brief", AccessibleMode: true, MutedChannels: []string{settings.ChannelEmote}}
+	require.NoError(t, repo.Save(ctx, acct.ID, want))
+	got, err := repo.Get(ctx, acct.ID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	want.MutedChannels = nil
+	require.NoError(t, repo.Save(ctx, acct.ID, want))
+	got, err = repo.Get(ctx, acct.ID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got, "save replaces the document")
+}
+
+func TestAccountSettingsRepository_SaveRejectsInvalid(t *testing.T) {
+	repo := postgres.NewAccountSettingsRepository(sharedPool)
+	err := repo.Save(context.Background(), 1, settings.Settings{ColorTheme: "neon"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+)
+
+// ErrSharedStashShort is returned when a withdrawal exceeds the shared stash balance.
+var ErrSharedStashShort = errors.New("shared stash balance too low")
+
+// AccountShared summarizes what an account's characters share.
+type AccountShared struct {
+	// StashBalance is the shared stash balance in credits.
+	StashBalance int
+	// Achievements counts the account-wide achievements earned.
+	Achievements int
+}
+
+// LoadSharedStash returns the account's shared stash balance.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns 0 for an account that has never used the shared stash.
+func (r *AccountRepository) LoadSharedStash(ctx context.Context, accountID int64) (int, error) {
+	var balance int
+	err := r.db.QueryRow(ctx, `SELECT balance FROM account_stash WHERE account_id = $1`, accountID).Scan(&balance)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("loading shared stash of account %d: %w", accountID, err)
+	}
+	return balance, nil
+}
+
+// AdjustSharedStash adds delta, which may be negative, to the account's
+// shared stash in one statement, so characters of the same account online at
+// once cannot overdraw it.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns the new balance, or ErrSharedStashShort, leaving the
+// balance unchanged, when it would go below zero.
+func (r *AccountRepository) AdjustSharedStash(ctx context.Context, accountID int64, delta int) (int, error) {
+	sql := `
+		INSERT INTO account_stash (account_id, balance) VALUES ($1, $2)
+		ON CONFLICT (account_id) DO UPDATE SET balance = account_stash.balance + $2
+		RETURNING balance`
+	if delta < 0 {
+		sql = `
+			UPDATE account_stash SET balance = balance + $2
+			WHERE account_id = $1 AND balance + $2 >= 0
+			RETURNING balance`
+	}
+	var balance int
+	err := r.db.QueryRow(ctx, sql, accountID, delta).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrSharedStashShort
+		}
+		return 0, fmt.Errorf("adjusting shared stash of account %d: %w", accountID, err)
+	}
+	return balance, nil
+}
+
+// LoadAccountShared returns what the account's characters share, for the
+// character selection screen.
+//
+// Precondition: accountID must be > 0.
+func (r *AccountRepository) LoadAccountShared(ctx context.Context, accountID int64) (AccountShared, error) {
+	var shared AccountShared
+	err := r.db.QueryRow(ctx, `
+		SELECT
+			COALESCE((SELECT balance FROM account_stash WHERE account_id = $1), 0),
+			(SELECT count(*) FROM account_achievements WHERE account_id = $1 AND unlocked_at IS NOT NULL)`,
+		accountID,
+	).Scan(&shared.StashBalance, &shared.Achievements)
+	if err != nil {
+		return AccountShared{}, fmt.Errorf("loading shared resources of account %d: %w", accountID, err)
+	}
+	return shared, nil
+}
+
+// LoadAccountProgress returns the account's progress toward account-wide
+// achievements.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns a non-nil Progress with no title; it is empty for an
+// account with no records.
+func (r *AchievementRepository) LoadAccountProgress(ctx context.Context, accountID int64) (*achievement.Progress, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT achievement_id, progress, unlocked_at
+		FROM account_achievements WHERE account_id = $1`, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("AchievementRepository.LoadAccountProgress: %w", err)
+	}
+	counts := make(map[string]int)
+	unlocked := make(map[string]time.Time)
+	var (
+		id       string
+		progress int
+		at       *time.Time
+	)
+	_, err = pgx.ForEachRow(rows, []any{&id, &progress, &at}, func() error {
+		counts[id] = progress
+		if at != nil {
+			unlocked[id] = *at
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("AchievementRepository.LoadAccountProgress: %w", err)
+	}
+	return achievement.NewProgress(counts, unlocked, ""), nil
+}
+
+// SaveAccountProgress upserts the account's progress toward one account-wide
+// achievement. Progress never moves backwards, so two characters of the
+// account saving at once keep the higher count and the first unlock.
+//
+// Precondition: accountID must be > 0; unlockedAt is zero while the achievement is locked.
+// Postcondition: The account_achievements row reflects the arguments.
+func (r *AchievementRepository) SaveAccountProgress(ctx context.Context, accountID int64, achievementID string, progress int, unlockedAt time.Time) error {
+	var at *time.Time
+	if !unlockedAt.IsZero() {
+		at = &unlockedAt
+	}
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO account_achievements (account_id, achievement_id, progress, unlocked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (account_id, achievement_id) DO UPDATE SET
+			progress = GREATEST(account_achievements.progress, EXCLUDED.progress),
+			unlocked_at = COALESCE(account_achievements.unlocked_at, EXCLUDED.unlocked_at)`,
+		accountID, achievementID, progress, at,
+	)
+	if err != nil {
+		return fmt.Errorf("AchievementRepository.SaveAccountProgress: %w", err)
+	}
+	return nil
+}
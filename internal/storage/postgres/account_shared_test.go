@@ -0,0 +1,59 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestAccountRepository_SharedStash(t *testing.T) {
+	_, accountID := setupCharRepos(t)
+	repo := postgres.NewAccountRepository(sharedPool)
+	ctx := context.Background()
+
+	balance, err := repo.LoadSharedStash(ctx, accountID)
+	require.NoError(t, err)
+	assert.Zero(t, balance)
+	_, err = repo.AdjustSharedStash(ctx, accountID, -1)
+	assert.ErrorIs(t, err, postgres.ErrSharedStashShort, "an unused stash cannot be drawn on")
+
+	balance, err = repo.AdjustSharedStash(ctx, accountID, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 50, balance)
+	balance, err = repo.AdjustSharedStash(ctx, accountID, -20)
+	require.NoError(t, err)
+	assert.Equal(t, 30, balance)
+	_, err = repo.AdjustSharedStash(ctx, accountID, -31)
+	assert.ErrorIs(t, err, postgres.ErrSharedStashShort)
+
+	balance, err = repo.LoadSharedStash(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, balance)
+}
+
+func TestAchievementRepository_AccountProgress(t *testing.T) {
+	_, accountID := setupCharRepos(t)
+	repo := postgres.NewAchievementRepository(sharedPool)
+	ctx := context.Background()
+
+	at := time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.SaveAccountProgress(ctx, accountID, "old_hand", 40, time.Time{}))
+	require.NoError(t, repo.SaveAccountProgress(ctx, accountID, "old_hand", 12, time.Time{}))
+	require.NoError(t, repo.SaveAccountProgress(ctx, accountID, "dynasty", 10, at))
+
+	p, err := repo.LoadAccountProgress(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, 40, p.Count("old_hand"), "a stale save does not move progress backwards")
+	got, unlocked := p.UnlockedAt("dynasty")
+	require.True(t, unlocked)
+	assert.True(t, got.Equal(at))
+
+	shared, err := postgres.NewAccountRepository(sharedPool).LoadAccountShared(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, postgres.AccountShared{Achievements: 1}, shared)
+}
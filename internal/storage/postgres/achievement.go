@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/achievement"
+)
+
+// AchievementRepository persists per-character achievement progress and titles.
+type AchievementRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewAchievementRepository creates an AchievementRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewAchievementRepository(db *pgxpool.Pool) *AchievementRepository {
+	return &AchievementRepository{db: db}
+}
+
+// LoadProgress returns the character's achievement progress and displayed title.
+//
+// Precondition: characterID must be > 0.
+// Postcondition: Returns a non-nil Progress; it is empty for a character with no records.
+func (r *AchievementRepository) LoadProgress(ctx context.Context, characterID int64) (*achievement.Progress, error) {
+	var title string
+	if err := r.db.QueryRow(ctx, `SELECT title FROM characters WHERE id = $1`, characterID).Scan(&title); err != nil {
+		return nil, fmt.Errorf("AchievementRepository.LoadProgress title: %w", err)
+	}
+	rows, err := r.db.Query(ctx, `
+		SELECT achievement_id, progress, unlocked_at
+		FROM character_achievements WHERE character_id = $1`, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("AchievementRepository.LoadProgress: %w", err)
+	}
+	defer rows.Close()
+	counts := make(map[string]int)
+	unlocked := make(map[string]time.Time)
+	for rows.Next() {
+		var (
+			id       string
+			progress int
+			at       *time.Time
+		)
+		if err := rows.Scan(&id, &progress, &at); err != nil {
+			return nil, fmt.Errorf("AchievementRepository.LoadProgress scan: %w", err)
+		}
+		counts[id] = progress
+		if at != nil {
+			unlocked[id] = *at
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("AchievementRepository.LoadProgress: %w", err)
+	}
+	return achievement.NewProgress(counts, unlocked, title), nil
+}
+
+// SaveProgress upserts the character's progress toward one achievement.
+//
+// Precondition: characterID must be > 0; unlockedAt is zero while the achievement is locked.
+// Postcondition: The character_achievements row matches the arguments.
+func (r *AchievementRepository) SaveProgress(ctx context.Context, characterID int64, achievementID string, progress int, unlockedAt time.Time) error {
+	var at *time.Time
+	if !unlockedAt.IsZero() {
+		at = &unlockedAt
+	}
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO character_achievements (character_id, achievement_id, progress, unlocked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (character_id, achievement_id)
+			DO UPDATE SET progress = EXCLUDED.progress, unlocked_at = EXCLUDED.unlocked_at`,
+		characterID, achievementID, progress, at,
+	)
+	if err != nil {
+		return fmt.Errorf("AchievementRepository.SaveProgress: %w", err)
+	}
+	return nil
+}
+
+// SaveTitle sets the character's displayed title.
+//
+// Precondition: characterID must be > 0.
+// Postcondition: characters.title equals title.
+func (r *AchievementRepository) SaveTitle(ctx context.Context, characterID int64, title string) error {
+	if _, err := r.db.Exec(ctx, `UPDATE characters SET title = $2 WHERE id = $1`, characterID, title); err != nil {
+		return fmt.Errorf("AchievementRepository.SaveTitle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestAchievementRepository_RoundTrip(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewAchievementRepository(sharedPool)
+	ctx := context.Background()
+
+	created, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Hero")))
+	require.NoError(t, err)
+
+	p, err := repo.LoadProgress(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "", p.Title())
+	assert.Zero(t, p.Count("first_blood"))
+
+	at := time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.SaveProgress(ctx, created.ID, "explorer", 40, time.Time{}))
+	require.NoError(t, repo.SaveProgress(ctx, created.ID, "first_blood", 0, time.Time{}))
+	require.NoError(t, repo.SaveProgress(ctx, created.ID, "first_blood", 1, at))
+	require.NoError(t, repo.SaveTitle(ctx, created.ID, "the Blooded"))
+
+	p, err = repo.LoadProgress(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "the Blooded", p.Title())
+	assert.Equal(t, 40, p.Count("explorer"))
+	_, unlocked := p.UnlockedAt("explorer")
+	assert.False(t, unlocked)
+	got, unlocked := p.UnlockedAt("first_blood")
+	require.True(t, unlocked)
+	assert.True(t, at.Equal(got))
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultAdminAuditLimit is the number of entries returned when a query sets no limit.
+const DefaultAdminAuditLimit = 20
+
+// MaxAdminAuditLimit caps the number of entries returned by a single query.
+const MaxAdminAuditLimit = 200
+
+// AdminAuditEntry is one recorded privileged command.
+type AdminAuditEntry struct {
+	ID int64
+	// ActorAccountID is the issuing account; 0 for out-of-game tools.
+	ActorAccountID int64
+	// Actor names who issued the command (account username, or "cli:<user>").
+	Actor string
+	// Action is the command name (e.g. "setrole", "teleport").
+	Action string
+	// Target is the affected account, character, or content ID; may be empty.
+	Target string
+	// Args is the full command arguments as a JSON object.
+	Args string
+	// Outcome is "ok" or the error reported to the actor.
+	Outcome   string
+	CreatedAt time.Time
+}
+
+// AdminAuditQuery filters AdminAuditRepository.List results.
+type AdminAuditQuery struct {
+	// Subject, when non-empty, matches actor or target case-insensitively.
+	Subject string
+	// Action, when non-empty, matches the action exactly.
+	Action string
+	// Limit bounds the result count; <= 0 selects DefaultAdminAuditLimit.
+	Limit int
+}
+
+// AdminAuditRepository persists the admin command audit trail.
+type AdminAuditRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewAdminAuditRepository constructs an AdminAuditRepository backed by the given pool.
+func NewAdminAuditRepository(db *pgxpool.Pool) *AdminAuditRepository {
+	return &AdminAuditRepository{db: db}
+}
+
+// Record appends e to the audit trail.
+//
+// Precondition: e.Actor and e.Action must be non-empty; e.Args must be a JSON
+// object or empty.
+// Postcondition: A new admin_audit row exists stamped with the current time.
+func (r *AdminAuditRepository) Record(ctx context.Context, e AdminAuditEntry) error {
+	args := e.Args
+	if args == "" {
+		args = "{}"
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO admin_audit (actor_account_id, actor, action, target, args, outcome)
+		 VALUES ($1, $2, $3, $4, $5::jsonb, $6)`,
+		e.ActorAccountID, e.Actor, e.Action, e.Target, args, e.Outcome)
+	if err != nil {
+		return fmt.Errorf("recording admin audit entry: %w", err)
+	}
+	return nil
+}
+
+// List returns audit entries matching q, newest first.
+//
+// Postcondition: At most min(q.Limit, MaxAdminAuditLimit) entries are returned;
+// the slice is non-nil.
+func (r *AdminAuditRepository) List(ctx context.Context, q AdminAuditQuery) ([]AdminAuditEntry, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultAdminAuditLimit
+	}
+	if limit > MaxAdminAuditLimit {
+		limit = MaxAdminAuditLimit
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id, actor_account_id, actor, action, target, args::text, outcome, created_at
+		 FROM admin_audit
+		 WHERE ($1 = '' OR lower(actor) = lower($1) OR lower(target) = lower($1))
+		   AND ($2 = '' OR action = $2)
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $3`,
+		q.Subject, q.Action, limit)
+	if err != nil {
+		return nil, fmt.Errorf("listing admin audit entries: %w", err)
+	}
+	defer rows.Close()
+	entries := make([]AdminAuditEntry, 0, limit)
+	for rows.Next() {
+		var e AdminAuditEntry
+		if err := rows.Scan(&e.ID, &e.ActorAccountID, &e.Actor, &e.Action, &e.Target, &e.Args, &e.Outcome, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning admin audit entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,51 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestAdminAuditRepository_RecordAndList(t *testing.T) {
+	repo := postgres.NewAdminAuditRepository(sharedPool)
+	ctx := context.Background()
+	actor := uniqueName("audit_admin")
+	target := uniqueName("audit_target")
+
+	require.NoError(t, repo.Record(ctx, postgres.AdminAuditEntry{
+		ActorAccountID: 7, Actor: actor, Action: "setrole", Target: target,
+		Args: `{"role":"editor"}`, Outcome: "ok",
+	}))
+	require.NoError(t, repo.Record(ctx, postgres.AdminAuditEntry{
+		Actor: actor, Action: "teleport", Target: "someone_else", Outcome: "ok",
+	}))
+
+	byActor, err := repo.List(ctx, postgres.AdminAuditQuery{Subject: actor})
+	require.NoError(t, err)
+	require.Len(t, byActor, 2)
+	assert.Equal(t, "teleport", byActor[0].Action, "newest first")
+	assert.JSONEq(t, "{}", byActor[0].Args, "empty args stored as an object")
+
+	byTarget, err := repo.List(ctx, postgres.AdminAuditQuery{Subject: target})
+	require.NoError(t, err)
+	require.Len(t, byTarget, 1)
+	assert.Equal(t, int64(7), byTarget[0].ActorAccountID)
+	assert.JSONEq(t, `{"role":"editor"}`, byTarget[0].Args)
+
+	byAction, err := repo.List(ctx, postgres.AdminAuditQuery{Subject: actor, Action: "setrole", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, byAction, 1)
+	assert.Equal(t, target, byAction[0].Target)
+}
+
+func TestAdminAuditRepository_List_EmptyIsNonNil(t *testing.T) {
+	repo := postgres.NewAdminAuditRepository(sharedPool)
+	entries, err := repo.List(context.Background(), postgres.AdminAuditQuery{Subject: uniqueName("nobody")})
+	require.NoError(t, err)
+	assert.NotNil(t, entries)
+	assert.Empty(t, entries)
+}
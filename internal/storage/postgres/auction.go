@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/auction"
+)
+
+// AuctionRepository persists auction house listings and escrowed claims.
+type AuctionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewAuctionRepository creates an AuctionRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewAuctionRepository(db *pgxpool.Pool) *AuctionRepository {
+	return &AuctionRepository{db: db}
+}
+
+// LoadAuctions returns every open listing and uncollected claim.
+//
+// Postcondition: Listings are ordered by ID; claims are ordered by ID, so each
+// character's claims come back oldest first.
+func (r *AuctionRepository) LoadAuctions(ctx context.Context) ([]*auction.Listing, []*auction.Claim, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, seller_id, seller_name, item_id, quantity, metadata, price, listed_at, expires_at
+		FROM auction_listings ORDER BY id`)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions: %w", err)
+	}
+	var listings []*auction.Listing
+	for rows.Next() {
+		l := &auction.Listing{}
+		var metadata []byte
+		if err := rows.Scan(&l.ID, &l.SellerID, &l.SellerName, &l.ItemID, &l.Quantity, &metadata, &l.Price, &l.ListedAt, &l.ExpiresAt); err != nil {
+			rows.Close()
+			return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions scan: %w", err)
+		}
+		if l.Metadata, err = decodeItemMetadata(metadata); err != nil {
+			rows.Close()
+			return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions decoding metadata of listing %d: %w", l.ID, err)
+		}
+		listings = append(listings, l)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions: %w", err)
+	}
+
+	rows, err = r.db.Query(ctx, `
+		SELECT id, character_id, credits, item_id, quantity, metadata, note
+		FROM auction_claims ORDER BY id`)
+	if err != nil {
+		return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions claims: %w", err)
+	}
+	defer rows.Close()
+	var claims []*auction.Claim
+	for rows.Next() {
+		c := &auction.Claim{}
+		var metadata []byte
+		if err := rows.Scan(&c.ID, &c.CharacterID, &c.Credits, &c.ItemID, &c.Quantity, &metadata, &c.Note); err != nil {
+			return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions claim scan: %w", err)
+		}
+		if c.Metadata, err = decodeItemMetadata(metadata); err != nil {
+			return nil, nil, fmt.Errorf("AuctionRepository.LoadAuctions decoding metadata of claim %d: %w", c.ID, err)
+		}
+		claims = append(claims, c)
+	}
+	return listings, claims, rows.Err()
+}
+
+// SaveListing inserts a new listing.
+//
+// Precondition: l must be non-nil with a unique ID.
+// Postcondition: An auction_listings row exists for l.ID.
+func (r *AuctionRepository) SaveListing(ctx context.Context, l *auction.Listing) error {
+	metadata, err := encodeItemMetadata(l.Metadata)
+	if err != nil {
+		return fmt.Errorf("AuctionRepository.SaveListing encoding metadata: %w", err)
+	}
+	_, err = r.db.Exec(ctx, `
+		INSERT INTO auction_listings (id, seller_id, seller_name, item_id, quantity, metadata, price, listed_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		l.ID, l.SellerID, l.SellerName, l.ItemID, l.Quantity, metadata, l.Price, l.ListedAt, l.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("AuctionRepository.SaveListing: %w", err)
+	}
+	return nil
+}
+
+// CloseListing deletes listing listingID and records claim in one transaction,
+// so a lot or its proceeds are never lost or duplicated across a crash.
+//
+// Precondition: claim must be non-nil with a unique ID.
+// Postcondition: No auction_listings row exists for listingID; an
+// auction_claims row exists for claim.ID.
+func (r *AuctionRepository) CloseListing(ctx context.Context, listingID int64, claim *auction.Claim) error {
+	metadata, err := encodeItemMetadata(claim.Metadata)
+	if err != nil {
+		return fmt.Errorf("encoding metadata of auction claim %d: %w", claim.ID, err)
+	}
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning auction transaction for listing %d: %w", listingID, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+	if _, err := tx.Exec(ctx, `DELETE FROM auction_listings WHERE id = $1`, listingID); err != nil {
+		return fmt.Errorf("deleting auction listing %d: %w", listingID, err)
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO auction_claims (id, character_id, credits, item_id, quantity, metadata, note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		claim.ID, claim.CharacterID, claim.Credits, claim.ItemID, claim.Quantity, metadata, claim.Note,
+	); err != nil {
+		return fmt.Errorf("saving auction claim %d: %w", claim.ID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing auction listing %d: %w", listingID, err)
+	}
+	return nil
+}
+
+// DeleteClaim removes a collected claim.
+//
+// Postcondition: No auction_claims row exists for id.
+func (r *AuctionRepository) DeleteClaim(ctx context.Context, id int64) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM auction_claims WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("AuctionRepository.DeleteClaim: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/auction"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestAuctionRepository_RoundTrip(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewAuctionRepository(sharedPool)
+	ctx := context.Background()
+
+	sellerName := uniqueName("Seller")
+	seller, err := charRepo.Create(ctx, makeTestCharacter(accountID, sellerName))
+	require.NoError(t, err)
+
+	// IDs are assigned by the house; keep them unique across test runs.
+	base := time.Now().UnixNano()
+	listed := time.Now().UTC().Truncate(time.Second)
+	l := &auction.Listing{
+		ID: base, SellerID: seller.ID, SellerName: sellerName, ItemID: "stim_pack",
+		Quantity: 2, Price: 150, ListedAt: listed, ExpiresAt: listed.Add(24 * time.Hour),
+	}
+	require.NoError(t, repo.SaveListing(ctx, l))
+
+	find := func() (*auction.Listing, []*auction.Claim) {
+		listings, claims, err := repo.LoadAuctions(ctx)
+		require.NoError(t, err)
+		var gotListing *auction.Listing
+		for _, got := range listings {
+			if got.ID == base {
+				gotListing = got
+			}
+		}
+		var gotClaims []*auction.Claim
+		for _, c := range claims {
+			if c.CharacterID == seller.ID {
+				gotClaims = append(gotClaims, c)
+			}
+		}
+		return gotListing, gotClaims
+	}
+
+	got, claims := find()
+	require.NotNil(t, got)
+	assert.Equal(t, l.Price, got.Price)
+	assert.True(t, l.ExpiresAt.Equal(got.ExpiresAt))
+	assert.Empty(t, claims)
+
+	claim := &auction.Claim{ID: base + 1, CharacterID: seller.ID, Credits: 150, Note: "listing sold"}
+	require.NoError(t, repo.CloseListing(ctx, l.ID, claim))
+	got, claims = find()
+	assert.Nil(t, got)
+	require.Len(t, claims, 1)
+	assert.Equal(t, *claim, *claims[0])
+
+	require.NoError(t, repo.DeleteClaim(ctx, claim.ID))
+	_, claims = find()
+	assert.Empty(t, claims)
+}
+
+func TestAuctionRepository_KeepsInstanceMetadata(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewAuctionRepository(sharedPool)
+	ctx := context.Background()
+
+	sellerName := uniqueName("Seller")
+	seller, err := charRepo.Create(ctx, makeTestCharacter(accountID, sellerName))
+	require.NoError(t, err)
+
+	base := time.Now().UnixNano()
+	listed := time.Now().UTC().Truncate(time.Second)
+	meta := &inventory.ItemMetadata{CustomName: "Old Faithful", Affixes: []string{"keen"}}
+	l := &auction.Listing{
+		ID: base, SellerID: seller.ID, SellerName: sellerName, ItemID: "rifle", Quantity: 1,
+		Metadata: meta, Price: 900, ListedAt: listed, ExpiresAt: listed.Add(24 * time.Hour),
+	}
+	require.NoError(t, repo.SaveListing(ctx, l))
+	listings, _, err := repo.LoadAuctions(ctx)
+	require.NoError(t, err)
+	var got *auction.Listing
+	for _, gl := range listings {
+		if gl.ID == base {
+			got = gl
+		}
+	}
+	require.NotNil(t, got)
+	assert.Equal(t, meta, got.Metadata)
+
+	claim := &auction.Claim{ID: base + 1, CharacterID: seller.ID, ItemID: "rifle", Quantity: 1, Metadata: meta, Note: "expired listing"}
+	require.NoError(t, repo.CloseListing(ctx, l.ID, claim))
+	_, claims, err := repo.LoadAuctions(ctx)
+	require.NoError(t, err)
+	var gotClaim *auction.Claim
+	for _, c := range claims {
+		if c.ID == claim.ID {
+			gotClaim = c
+		}
+	}
+	require.NotNil(t, gotClaim)
+	assert.Equal(t, meta, gotClaim.Metadata)
+	require.NoError(t, repo.DeleteClaim(ctx, claim.ID))
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/storage/backup"
+)
+
+// ExportBackup writes every table in the public schema except the migration
+// bookkeeping to w as a backup archive.
+//
+// Precondition: The database must be fully migrated and not dirty.
+// Postcondition: Every table is read in one read-only repeatable-read
+// transaction, so the archive is a consistent snapshot even while the game is
+// running. Returns the archive's manifest or a non-nil error.
+func ExportBackup(ctx context.Context, db *pgxpool.Pool, w io.Writer) (backup.Manifest, error) {
+	tx, err := db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return backup.Manifest{}, fmt.Errorf("starting snapshot: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return backup.Manifest{}, err
+	}
+	tables, err := backupTables(ctx, tx)
+	if err != nil {
+		return backup.Manifest{}, err
+	}
+
+	bw := backup.NewWriter(w, version)
+	defer bw.Discard()
+	for _, table := range tables {
+		cols, err := tableColumns(ctx, tx, table)
+		if err != nil {
+			return backup.Manifest{}, err
+		}
+		sql := fmt.Sprintf("COPY %s (%s) TO STDOUT WITH (FORMAT csv, HEADER true)", quoteTable(table), quoteColumns(cols))
+		err = bw.AddTable(table, cols, func(out io.Writer) (int64, error) {
+			tag, err := tx.Conn().PgConn().CopyTo(ctx, out, sql)
+			return tag.RowsAffected(), err
+		})
+		if err != nil {
+			return backup.Manifest{}, err
+		}
+	}
+	return bw.Close()
+}
+
+// RestoreReport summarizes a restore.
+type RestoreReport struct {
+	Manifest backup.Manifest
+	// Rows maps each table to the rows loaded into it.
+	Rows map[string]int64
+}
+
+// RestoreBackup loads the backup archive in r into db in a single
+// transaction. With dryRun the transaction is rolled back after every table
+// has loaded, which proves the archive restores cleanly without keeping it.
+//
+// Precondition: db must be migrated to the archive's schema version and every
+// archived table must be empty.
+// Postcondition: Either every table is loaded, row counts match the manifest,
+// and each serial column's sequence continues after the highest restored
+// value, or nothing is changed and a non-nil error is returned.
+func RestoreBackup(ctx context.Context, db *pgxpool.Pool, r io.Reader, dryRun bool) (RestoreReport, error) {
+	ar, err := backup.NewReader(r)
+	if err != nil {
+		return RestoreReport{}, err
+	}
+	m := ar.Manifest()
+	report := RestoreReport{Manifest: m, Rows: make(map[string]int64, len(m.Tables))}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return RestoreReport{}, fmt.Errorf("starting restore: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return RestoreReport{}, err
+	}
+	if version != m.SchemaVersion {
+		return RestoreReport{}, fmt.Errorf("archive is at migration %d but the database is at %d; migrate the database to %d first", m.SchemaVersion, version, m.SchemaVersion)
+	}
+	for _, t := range m.Tables {
+		var nonEmpty bool
+		if err := tx.QueryRow(ctx, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", quoteTable(t.Name))).Scan(&nonEmpty); err != nil {
+			return RestoreReport{}, fmt.Errorf("checking table %s: %w", t.Name, err)
+		}
+		if nonEmpty {
+			return RestoreReport{}, fmt.Errorf("table %s is not empty; restore only into a fresh database", t.Name)
+		}
+	}
+
+	for {
+		t, data, err := ar.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return RestoreReport{}, err
+		}
+		sql := fmt.Sprintf("COPY %s (%s) FROM STDIN WITH (FORMAT csv, HEADER true)", quoteTable(t.Name), quoteColumns(t.Columns))
+		tag, err := tx.Conn().PgConn().CopyFrom(ctx, data, sql)
+		if err != nil {
+			return RestoreReport{}, fmt.Errorf("restoring table %s: %w", t.Name, err)
+		}
+		if tag.RowsAffected() != t.Rows {
+			return RestoreReport{}, fmt.Errorf("restored %d rows into %s, manifest says %d", tag.RowsAffected(), t.Name, t.Rows)
+		}
+		if err := resetSequences(ctx, tx, t); err != nil {
+			return RestoreReport{}, err
+		}
+		report.Rows[t.Name] = tag.RowsAffected()
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return RestoreReport{}, fmt.Errorf("committing restore: %w", err)
+	}
+	return report, nil
+}
+
+// schemaVersion returns the applied migration version.
+//
+// Postcondition: Returns an error if migrations have never run or the last
+// one failed part way.
+func schemaVersion(ctx context.Context, tx pgx.Tx) (uint, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := tx.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("reading migration version: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("migration %d is dirty; fix the schema before backing up or restoring", version)
+	}
+	return uint(version), nil
+}
+
+// backupTables lists the public tables to back up, parents before the tables
+// whose foreign keys reference them.
+func backupTables(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT tablename FROM pg_tables
+		WHERE schemaname = 'public' AND tablename <> 'schema_migrations'`)
+	if err != nil {
+		return nil, fmt.Errorf("listing tables: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("listing tables: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT child.relname, parent.relname
+		FROM pg_constraint con
+		JOIN pg_class child ON child.oid = con.conrelid
+		JOIN pg_class parent ON parent.oid = con.confrelid
+		JOIN pg_namespace ns ON ns.oid = child.relnamespace
+		WHERE con.contype = 'f' AND ns.nspname = 'public'`)
+	if err != nil {
+		return nil, fmt.Errorf("listing foreign keys: %w", err)
+	}
+	refs := make(map[string][]string)
+	var child, parent string
+	_, err = pgx.ForEachRow(rows, []any{&child, &parent}, func() error {
+		refs[child] = append(refs[child], parent)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing foreign keys: %w", err)
+	}
+	return backup.Order(tables, refs)
+}
+
+// tableColumns lists a table's stored columns in definition order; generated
+// columns are left out because the database recomputes them.
+func tableColumns(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT attname FROM pg_attribute
+		WHERE attrelid = $1::text::regclass AND attnum > 0 AND NOT attisdropped AND attgenerated = ''
+		ORDER BY attnum`, quoteTable(table))
+	if err != nil {
+		return nil, fmt.Errorf("listing columns of %s: %w", table, err)
+	}
+	cols, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("listing columns of %s: %w", table, err)
+	}
+	return cols, nil
+}
+
+// resetSequences moves each sequence owned by one of t's columns past the
+// column's highest restored value, so new rows do not collide with it.
+func resetSequences(ctx context.Context, tx pgx.Tx, t backup.Table) error {
+	for _, col := range t.Columns {
+		var seq *string
+		if err := tx.QueryRow(ctx, `SELECT pg_get_serial_sequence($1, $2)`, quoteTable(t.Name), col).Scan(&seq); err != nil {
+			return fmt.Errorf("finding sequence of %s.%s: %w", t.Name, col, err)
+		}
+		if seq == nil {
+			continue
+		}
+		sql := fmt.Sprintf(`SELECT setval($1::text::regclass, COALESCE((SELECT max(%s) FROM %s), 0) + 1, false)`,
+			pgx.Identifier{col}.Sanitize(), quoteTable(t.Name))
+		if _, err := tx.Exec(ctx, sql, *seq); err != nil {
+			return fmt.Errorf("resetting sequence of %s.%s: %w", t.Name, col, err)
+		}
+	}
+	return nil
+}
+
+// quoteTable returns the quoted, schema-qualified name of a public table.
+func quoteTable(name string) string {
+	return pgx.Identifier{"public", name}.Sanitize()
+}
+
+// quoteColumns returns a comma-separated list of quoted column names.
+func quoteColumns(cols []string) string {
+	quoted := make([]string, len(cols))
+	for i, c := range cols {
+		quoted[i] = pgx.Identifier{c}.Sanitize()
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,63 @@
+package postgres_test
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/backup"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestExportBackup_SnapshotVerifies(t *testing.T) {
+	ctx := context.Background()
+	_, err := postgres.NewAccountRepository(sharedPool).Create(ctx, uniqueName("backup"), "password123")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	m, err := postgres.ExportBackup(ctx, sharedPool, &buf)
+	require.NoError(t, err)
+	assert.NotZero(t, m.SchemaVersion)
+
+	names := make([]string, len(m.Tables))
+	for i, tbl := range m.Tables {
+		names[i] = tbl.Name
+	}
+	assert.NotContains(t, names, "schema_migrations")
+	accounts, characters := slices.Index(names, "accounts"), slices.Index(names, "characters")
+	require.NotEqual(t, -1, accounts)
+	require.NotEqual(t, -1, characters)
+	assert.Less(t, accounts, characters, "parents are archived before children")
+	assert.Positive(t, m.Tables[accounts].Rows)
+
+	verified, err := backup.Verify(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, m.Tables, verified.Tables)
+}
+
+func TestRestoreBackup_RefusesNonEmptyDatabase(t *testing.T) {
+	ctx := context.Background()
+	_, err := postgres.NewAccountRepository(sharedPool).Create(ctx, uniqueName("backup"), "password123")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	_, err = postgres.ExportBackup(ctx, sharedPool, &buf)
+	require.NoError(t, err)
+
+	_, err = postgres.RestoreBackup(ctx, sharedPool, &buf, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not empty")
+}
+
+func TestRestoreBackup_RefusesOtherSchemaVersion(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := backup.NewWriter(&buf, 1).Close()
+	require.NoError(t, err)
+
+	_, err = postgres.RestoreBackup(context.Background(), sharedPool, &buf, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "archive is at migration 1")
+}
@@ -17,6 +17,10 @@ import (
 // ErrCharacterNotFound is returned when a character lookup yields no results.
 var ErrCharacterNotFound = errors.New("character not found")
 
+// CharacterDeleteWindow is how long a soft-deleted character can be restored
+// before PurgeDeletedCharacters removes it permanently.
+const CharacterDeleteWindow = 7 * 24 * time.Hour
+
 // ErrCharacterNameTaken is returned when creating a character with a name already used by the account.
 var ErrCharacterNameTaken = errors.New("character name already taken")
 
@@ -39,31 +43,45 @@ func (r *CharacterRepository) Pool() *pgxpool.Pool {
 	return r.db
 }
 
-// Create inserts a new character and returns it with ID and timestamps set.
+// Create inserts a new character, together with its creation point-buy
+// adjustments, and returns it with ID and timestamps set.
 //
 // Precondition: c.AccountID must reference an existing account; c.Name must be non-empty.
 // Postcondition: Returns the created character with ID set, or ErrCharacterNameTaken on duplicate.
+// The characters row and its character_point_buy rows are written in one transaction.
 func (r *CharacterRepository) Create(ctx context.Context, c *character.Character) (*character.Character, error) {
+	answers, err := json.Marshal(backgroundAnswersOrEmpty(c.BackgroundAnswers))
+	if err != nil {
+		return nil, fmt.Errorf("encoding background answers: %w", err)
+	}
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("beginning character insert: %w", err)
+	}
+	defer tx.Rollback(ctx)
 	var out character.Character
-	err := r.db.QueryRow(ctx, `
+	var answersRaw []byte
+	err = tx.QueryRow(ctx, `
 		INSERT INTO characters
 			(account_id, name, region, class, team, level, experience, location,
 			 brutality, quickness, grit, reasoning, savvy, flair,
-			 max_hp, current_hp, gender, faction_id)
-		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18)
+			 max_hp, current_hp, gender, faction_id, heritage, background_answers)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20::jsonb)
 		RETURNING id, account_id, name, region, class, team, level, experience, location,
 		          brutality, quickness, grit, reasoning, savvy, flair,
-		          max_hp, current_hp, created_at, updated_at, default_combat_action, gender, faction_id`,
+		          max_hp, current_hp, created_at, updated_at, default_combat_action, gender, faction_id, heritage,
+		          background_answers`,
 		c.AccountID, c.Name, c.Region, c.Class, c.Team, c.Level, c.Experience, c.Location,
 		c.Abilities.Brutality, c.Abilities.Quickness, c.Abilities.Grit,
 		c.Abilities.Reasoning, c.Abilities.Savvy, c.Abilities.Flair,
-		c.MaxHP, c.CurrentHP, c.Gender, c.FactionID,
+		c.MaxHP, c.CurrentHP, c.Gender, c.FactionID, c.Heritage, string(answers),
 	).Scan(
 		&out.ID, &out.AccountID, &out.Name, &out.Region, &out.Class, &out.Team,
 		&out.Level, &out.Experience, &out.Location,
 		&out.Abilities.Brutality, &out.Abilities.Quickness, &out.Abilities.Grit,
 		&out.Abilities.Reasoning, &out.Abilities.Savvy, &out.Abilities.Flair,
-		&out.MaxHP, &out.CurrentHP, &out.CreatedAt, &out.UpdatedAt, &out.DefaultCombatAction, &out.Gender, &out.FactionID,
+		&out.MaxHP, &out.CurrentHP, &out.CreatedAt, &out.UpdatedAt, &out.DefaultCombatAction, &out.Gender, &out.FactionID, &out.Heritage,
+		&answersRaw,
 	)
 	if err != nil {
 		if isDuplicateKeyError(err) {
@@ -71,19 +89,40 @@ func (r *CharacterRepository) Create(ctx context.Context, c *character.Character
 		}
 		return nil, fmt.Errorf("inserting character: %w", err)
 	}
+	if out.BackgroundAnswers, err = decodeBackgroundAnswers(answersRaw); err != nil {
+		return nil, err
+	}
+	for ability, delta := range c.PointBuy {
+		if delta == 0 {
+			continue
+		}
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO character_point_buy (character_id, ability, delta) VALUES ($1, $2, $3)`,
+			out.ID, ability, delta,
+		); err != nil {
+			return nil, fmt.Errorf("inserting point buy %s for character %d: %w", ability, out.ID, err)
+		}
+		if out.PointBuy == nil {
+			out.PointBuy = make(map[string]int, len(c.PointBuy))
+		}
+		out.PointBuy[ability] = delta
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing character insert: %w", err)
+	}
 	return &out, nil
 }
 
-// ListByAccount returns all characters for the given account ID, ordered by created_at.
+// ListByAccount returns all live (not soft-deleted) characters for the given account ID, ordered by created_at.
 // Precondition: accountID must be > 0.
 // Postcondition: Returns a slice (may be empty) or a non-nil error.
 func (r *CharacterRepository) ListByAccount(ctx context.Context, accountID int64) ([]*character.Character, error) {
 	rows, err := r.db.Query(ctx, `
 		SELECT id, account_id, name, region, class, team, level, experience, location,
 		       brutality, quickness, grit, reasoning, savvy, flair,
-		       max_hp, current_hp, created_at, updated_at, default_combat_action, gender, faction_id
+		       max_hp, current_hp, created_at, updated_at, default_combat_action, gender, faction_id, heritage
 		FROM characters
-		WHERE account_id = $1
+		WHERE account_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at ASC`,
 		accountID,
 	)
@@ -100,7 +139,7 @@ func (r *CharacterRepository) ListByAccount(ctx context.Context, accountID int64
 			&c.Level, &c.Experience, &c.Location,
 			&c.Abilities.Brutality, &c.Abilities.Quickness, &c.Abilities.Grit,
 			&c.Abilities.Reasoning, &c.Abilities.Savvy, &c.Abilities.Flair,
-			&c.MaxHP, &c.CurrentHP, &c.CreatedAt, &c.UpdatedAt, &c.DefaultCombatAction, &c.Gender, &c.FactionID,
+			&c.MaxHP, &c.CurrentHP, &c.CreatedAt, &c.UpdatedAt, &c.DefaultCombatAction, &c.Gender, &c.FactionID, &c.Heritage,
 		); err != nil {
 			return nil, fmt.Errorf("scanning character row: %w", err)
 		}
@@ -109,18 +148,20 @@ func (r *CharacterRepository) ListByAccount(ctx context.Context, accountID int64
 	return chars, rows.Err()
 }
 
-// GetByID retrieves a character by its primary key.
+// GetByID retrieves a live character by its primary key.
 //
 // Precondition: id must be > 0.
-// Postcondition: Returns the Character or ErrCharacterNotFound.
+// Postcondition: Returns the Character, or ErrCharacterNotFound when no live row exists.
 func (r *CharacterRepository) GetByID(ctx context.Context, id int64) (*character.Character, error) {
 	var c character.Character
+	var answersRaw []byte
 	err := r.db.QueryRow(ctx, `
 		SELECT id, account_id, name, region, class, team, level, experience, location,
 		       brutality, quickness, grit, reasoning, savvy, flair,
 		       max_hp, current_hp, created_at, updated_at, default_combat_action, gender,
-		       detained_until, faction_id
-		FROM characters WHERE id = $1`,
+		       detained_until, faction_id, combat_verbosity, heritage, background_answers,
+		       tutorial_complete
+		FROM characters WHERE id = $1 AND deleted_at IS NULL`,
 		id,
 	).Scan(
 		&c.ID, &c.AccountID, &c.Name, &c.Region, &c.Class, &c.Team,
@@ -128,7 +169,8 @@ func (r *CharacterRepository) GetByID(ctx context.Context, id int64) (*character
 		&c.Abilities.Brutality, &c.Abilities.Quickness, &c.Abilities.Grit,
 		&c.Abilities.Reasoning, &c.Abilities.Savvy, &c.Abilities.Flair,
 		&c.MaxHP, &c.CurrentHP, &c.CreatedAt, &c.UpdatedAt, &c.DefaultCombatAction, &c.Gender,
-		&c.DetainedUntil, &c.FactionID,
+		&c.DetainedUntil, &c.FactionID, &c.CombatVerbosity, &c.Heritage, &answersRaw,
+		&c.TutorialComplete,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -136,9 +178,35 @@ func (r *CharacterRepository) GetByID(ctx context.Context, id int64) (*character
 		}
 		return nil, fmt.Errorf("querying character: %w", err)
 	}
+	if c.BackgroundAnswers, err = decodeBackgroundAnswers(answersRaw); err != nil {
+		return nil, err
+	}
 	return &c, nil
 }
 
+// backgroundAnswersOrEmpty returns answers, or an empty map when answers is nil,
+// so the column always holds a JSON object.
+func backgroundAnswersOrEmpty(answers map[string]string) map[string]string {
+	if answers == nil {
+		return map[string]string{}
+	}
+	return answers
+}
+
+// decodeBackgroundAnswers parses the background_answers JSONB column.
+//
+// Postcondition: Returns nil for an empty object; a non-nil error on malformed JSON.
+func decodeBackgroundAnswers(raw []byte) (map[string]string, error) {
+	var answers map[string]string
+	if err := json.Unmarshal(raw, &answers); err != nil {
+		return nil, fmt.Errorf("decoding background answers: %w", err)
+	}
+	if len(answers) == 0 {
+		return nil, nil
+	}
+	return answers, nil
+}
+
 // IsNameAvailable returns true if no character with the given name exists.
 //
 // Precondition: name must be non-empty.
@@ -200,6 +268,30 @@ func (r *CharacterRepository) SaveDefaultCombatAction(ctx context.Context, chara
 	return nil
 }
 
+// SaveCombatVerbosity persists the player's combat narration level.
+//
+// Precondition: characterID > 0; level must be non-empty.
+// Postcondition: characters.combat_verbosity updated for the given ID.
+func (r *CharacterRepository) SaveCombatVerbosity(ctx context.Context, characterID int64, level string) error {
+	if characterID <= 0 {
+		return fmt.Errorf("SaveCombatVerbosity: characterID must be > 0")
+	}
+	if level == "" {
+		return fmt.Errorf("SaveCombatVerbosity: level must be non-empty")
+	}
+	tag, err := r.db.Exec(ctx,
+		`UPDATE characters SET combat_verbosity = $2 WHERE id = $1`,
+		characterID, level,
+	)
+	if err != nil {
+		return fmt.Errorf("SaveCombatVerbosity: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("SaveCombatVerbosity: %w", ErrCharacterNotFound)
+	}
+	return nil
+}
+
 // SaveGender persists the player's gender to the characters table.
 //
 // Precondition: id > 0; gender must be non-empty.
@@ -279,7 +371,7 @@ func (r *CharacterRepository) SaveAbilities(ctx context.Context, characterID int
 func (r *CharacterRepository) LoadWeaponPresets(ctx context.Context, characterID int64, reg *inventory.Registry) (*inventory.LoadoutSet, error) {
 	rows, err := r.db.Query(ctx, `
 		SELECT preset_index, slot, item_def_id, ammo_count,
-		       affixed_materials, material_max_durability_bonus
+		       affixed_materials, material_max_durability_bonus, affixes, custom_name
 		FROM character_weapon_presets
 		WHERE character_id = $1
 		ORDER BY preset_index, slot`,
@@ -298,8 +390,10 @@ func (r *CharacterRepository) LoadWeaponPresets(ctx context.Context, characterID
 		var ammoCount int
 		var affixedMaterials []string
 		var materialMaxDurBonus int
+		var affixes []string
+		var customName string
 		if err := rows.Scan(&presetIdx, &slot, &itemDefID, &ammoCount,
-			&affixedMaterials, &materialMaxDurBonus); err != nil {
+			&affixedMaterials, &materialMaxDurBonus, &affixes, &customName); err != nil {
 			return nil, fmt.Errorf("scanning weapon preset row: %w", err)
 		}
 		// Grow Presets slice if needed (class features may add more presets).
@@ -320,12 +414,16 @@ func (r *CharacterRepository) LoadWeaponPresets(ctx context.Context, characterID
 			}
 			preset.MainHand.AffixedMaterials = affixedMaterials
 			preset.MainHand.MaterialMaxDurabilityBonus = materialMaxDurBonus
+			preset.MainHand.Affixes = affixes
+			preset.MainHand.CustomName = customName
 		case "off_hand":
 			if equipErr := preset.EquipOffHand(def); equipErr != nil {
 				return nil, fmt.Errorf("rehydrating off_hand preset %d for character %d: %w", presetIdx, characterID, equipErr)
 			}
 			preset.OffHand.AffixedMaterials = affixedMaterials
 			preset.OffHand.MaterialMaxDurabilityBonus = materialMaxDurBonus
+			preset.OffHand.Affixes = affixes
+			preset.OffHand.CustomName = customName
 		}
 		_ = ammoCount // ammo count restoration is deferred to magazine hydration feature
 	}
@@ -355,18 +453,24 @@ func (r *CharacterRepository) SaveWeaponPresets(ctx context.Context, characterID
 			if mhMaterials == nil {
 				mhMaterials = []string{}
 			}
+			mhAffixes := preset.MainHand.Affixes
+			if mhAffixes == nil {
+				mhAffixes = []string{}
+			}
 			if _, err := r.db.Exec(ctx, `
 				INSERT INTO character_weapon_presets
 					(character_id, preset_index, slot, item_def_id, ammo_count,
-					 affixed_materials, material_max_durability_bonus)
-				VALUES ($1, $2, $3, $4, $5, $6, $7)
+					 affixed_materials, material_max_durability_bonus, affixes, custom_name)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 				ON CONFLICT (character_id, preset_index, slot)
 					DO UPDATE SET item_def_id = EXCLUDED.item_def_id,
 					              ammo_count  = EXCLUDED.ammo_count,
 					              affixed_materials = EXCLUDED.affixed_materials,
-					              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus`,
+					              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus,
+					              affixes = EXCLUDED.affixes,
+					              custom_name = EXCLUDED.custom_name`,
 				characterID, i, "main_hand", preset.MainHand.Def.ID, ammo,
-				mhMaterials, preset.MainHand.MaterialMaxDurabilityBonus,
+				mhMaterials, preset.MainHand.MaterialMaxDurabilityBonus, mhAffixes, preset.MainHand.CustomName,
 			); err != nil {
 				return fmt.Errorf("saving main_hand for character %d preset %d: %w", characterID, i, err)
 			}
@@ -380,18 +484,24 @@ func (r *CharacterRepository) SaveWeaponPresets(ctx context.Context, characterID
 			if ohMaterials == nil {
 				ohMaterials = []string{}
 			}
+			ohAffixes := preset.OffHand.Affixes
+			if ohAffixes == nil {
+				ohAffixes = []string{}
+			}
 			if _, err := r.db.Exec(ctx, `
 				INSERT INTO character_weapon_presets
 					(character_id, preset_index, slot, item_def_id, ammo_count,
-					 affixed_materials, material_max_durability_bonus)
-				VALUES ($1, $2, $3, $4, $5, $6, $7)
+					 affixed_materials, material_max_durability_bonus, affixes, custom_name)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 				ON CONFLICT (character_id, preset_index, slot)
 					DO UPDATE SET item_def_id = EXCLUDED.item_def_id,
 					              ammo_count  = EXCLUDED.ammo_count,
 					              affixed_materials = EXCLUDED.affixed_materials,
-					              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus`,
+					              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus,
+					              affixes = EXCLUDED.affixes,
+					              custom_name = EXCLUDED.custom_name`,
 				characterID, i, "off_hand", preset.OffHand.Def.ID, ammo,
-				ohMaterials, preset.OffHand.MaterialMaxDurabilityBonus,
+				ohMaterials, preset.OffHand.MaterialMaxDurabilityBonus, ohAffixes, preset.OffHand.CustomName,
 			); err != nil {
 				return fmt.Errorf("saving off_hand for character %d preset %d: %w", characterID, i, err)
 			}
@@ -407,7 +517,7 @@ func (r *CharacterRepository) SaveWeaponPresets(ctx context.Context, characterID
 // Postcondition: Returns a non-nil *inventory.Equipment and nil error on success.
 func (r *CharacterRepository) LoadEquipment(ctx context.Context, characterID int64) (*inventory.Equipment, error) {
 	rows, err := r.db.Query(ctx, `
-		SELECT slot, item_def_id, affixed_materials, material_max_durability_bonus
+		SELECT slot, item_def_id, affixed_materials, material_max_durability_bonus, affixes, custom_name
 		FROM character_equipment
 		WHERE character_id = $1`,
 		characterID,
@@ -422,7 +532,9 @@ func (r *CharacterRepository) LoadEquipment(ctx context.Context, characterID int
 		var slot, itemDefID string
 		var affixedMaterials []string
 		var materialMaxDurBonus int
-		if err := rows.Scan(&slot, &itemDefID, &affixedMaterials, &materialMaxDurBonus); err != nil {
+		var affixes []string
+		var customName string
+		if err := rows.Scan(&slot, &itemDefID, &affixedMaterials, &materialMaxDurBonus, &affixes, &customName); err != nil {
 			return nil, fmt.Errorf("scanning equipment row: %w", err)
 		}
 		item := &inventory.SlottedItem{
@@ -430,6 +542,8 @@ func (r *CharacterRepository) LoadEquipment(ctx context.Context, characterID int
 			Name:                       itemDefID,
 			AffixedMaterials:           affixedMaterials,
 			MaterialMaxDurabilityBonus: materialMaxDurBonus,
+			Affixes:                    affixes,
+			CustomName:                 customName,
 		}
 		// Determine slot type and populate the appropriate map.
 		// Full name hydration is deferred to feature #4 (weapon and armor library);
@@ -470,14 +584,20 @@ func (r *CharacterRepository) SaveEquipment(ctx context.Context, characterID int
 		if armorMaterials == nil {
 			armorMaterials = []string{}
 		}
+		armorAffixes := item.Affixes
+		if armorAffixes == nil {
+			armorAffixes = []string{}
+		}
 		if _, err := tx.Exec(ctx, `
-			INSERT INTO character_equipment (character_id, slot, item_def_id, affixed_materials, material_max_durability_bonus)
-			VALUES ($1, $2, $3, $4, $5)
+			INSERT INTO character_equipment (character_id, slot, item_def_id, affixed_materials, material_max_durability_bonus, affixes, custom_name)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			ON CONFLICT (character_id, slot)
 				DO UPDATE SET item_def_id = EXCLUDED.item_def_id,
 				              affixed_materials = EXCLUDED.affixed_materials,
-				              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus`,
-			characterID, string(slot), item.ItemDefID, armorMaterials, item.MaterialMaxDurabilityBonus,
+				              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus,
+				              affixes = EXCLUDED.affixes,
+				              custom_name = EXCLUDED.custom_name`,
+			characterID, string(slot), item.ItemDefID, armorMaterials, item.MaterialMaxDurabilityBonus, armorAffixes, item.CustomName,
 		); err != nil {
 			return fmt.Errorf("saving armor slot %s for character %d: %w", slot, characterID, err)
 		}
@@ -490,14 +610,20 @@ func (r *CharacterRepository) SaveEquipment(ctx context.Context, characterID int
 		if accMaterials == nil {
 			accMaterials = []string{}
 		}
+		accAffixes := item.Affixes
+		if accAffixes == nil {
+			accAffixes = []string{}
+		}
 		if _, err := tx.Exec(ctx, `
-			INSERT INTO character_equipment (character_id, slot, item_def_id, affixed_materials, material_max_durability_bonus)
-			VALUES ($1, $2, $3, $4, $5)
+			INSERT INTO character_equipment (character_id, slot, item_def_id, affixed_materials, material_max_durability_bonus, affixes, custom_name)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			ON CONFLICT (character_id, slot)
 				DO UPDATE SET item_def_id = EXCLUDED.item_def_id,
 				              affixed_materials = EXCLUDED.affixed_materials,
-				              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus`,
-			characterID, string(slot), item.ItemDefID, accMaterials, item.MaterialMaxDurabilityBonus,
+				              material_max_durability_bonus = EXCLUDED.material_max_durability_bonus,
+				              affixes = EXCLUDED.affixes,
+				              custom_name = EXCLUDED.custom_name`,
+			characterID, string(slot), item.ItemDefID, accMaterials, item.MaterialMaxDurabilityBonus, accAffixes, item.CustomName,
 		); err != nil {
 			return fmt.Errorf("saving accessory slot %s for character %d: %w", slot, characterID, err)
 		}
@@ -514,9 +640,10 @@ func (r *CharacterRepository) SaveEquipment(ctx context.Context, characterID int
 // Postcondition: Returns nil slice and nil error when no rows exist.
 func (r *CharacterRepository) LoadInventory(ctx context.Context, characterID int64) ([]inventory.InventoryItem, error) {
 	rows, err := r.db.Query(ctx, `
-		SELECT item_def_id, quantity
+		SELECT item_def_id, quantity, metadata
 		FROM character_inventory
-		WHERE character_id = $1`,
+		WHERE character_id = $1
+		ORDER BY id`,
 		characterID,
 	)
 	if err != nil {
@@ -527,9 +654,13 @@ func (r *CharacterRepository) LoadInventory(ctx context.Context, characterID int
 	var items []inventory.InventoryItem
 	for rows.Next() {
 		var it inventory.InventoryItem
-		if err := rows.Scan(&it.ItemDefID, &it.Quantity); err != nil {
+		var metadata []byte
+		if err := rows.Scan(&it.ItemDefID, &it.Quantity, &metadata); err != nil {
 			return nil, fmt.Errorf("scanning inventory row: %w", err)
 		}
+		if it.Metadata, err = decodeItemMetadata(metadata); err != nil {
+			return nil, fmt.Errorf("decoding metadata of inventory item %q for character %d: %w", it.ItemDefID, characterID, err)
+		}
 		items = append(items, it)
 	}
 	return items, rows.Err()
@@ -551,11 +682,14 @@ func (r *CharacterRepository) SaveInventory(ctx context.Context, characterID int
 		return fmt.Errorf("clearing inventory for character %d: %w", characterID, err)
 	}
 	for _, it := range items {
+		metadata, err := encodeItemMetadata(it.Metadata)
+		if err != nil {
+			return fmt.Errorf("encoding metadata of inventory item %q for character %d: %w", it.ItemDefID, characterID, err)
+		}
 		if _, err := tx.Exec(ctx, `
-			INSERT INTO character_inventory (character_id, item_def_id, quantity)
-			VALUES ($1, $2, $3)
-			ON CONFLICT (character_id, item_def_id) DO UPDATE SET quantity = EXCLUDED.quantity`,
-			characterID, it.ItemDefID, it.Quantity,
+			INSERT INTO character_inventory (character_id, item_def_id, quantity, metadata)
+			VALUES ($1, $2, $3, $4)`,
+			characterID, it.ItemDefID, it.Quantity, metadata,
 		); err != nil {
 			return fmt.Errorf("saving inventory item %q for character %d: %w", it.ItemDefID, characterID, err)
 		}
@@ -566,6 +700,30 @@ func (r *CharacterRepository) SaveInventory(ctx context.Context, characterID int
 	return nil
 }
 
+// encodeItemMetadata returns the JSONB form of an item's instance state.
+//
+// Postcondition: A nil m encodes as an empty object.
+func encodeItemMetadata(m *inventory.ItemMetadata) ([]byte, error) {
+	if m == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m)
+}
+
+// decodeItemMetadata parses a metadata column written by encodeItemMetadata.
+//
+// Postcondition: Returns nil for an item with no instance state.
+func decodeItemMetadata(raw []byte) (*inventory.ItemMetadata, error) {
+	var m inventory.ItemMetadata
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	if m.IsZero() {
+		return nil, nil
+	}
+	return &m, nil
+}
+
 // HasReceivedStartingInventory returns whether the character has already received their starting kit.
 //
 // Precondition: characterID must be > 0.
@@ -604,6 +762,24 @@ func (r *CharacterRepository) MarkStartingInventoryGranted(ctx context.Context,
 	return nil
 }
 
+// MarkTutorialComplete records that the character has finished or skipped the tutorial.
+//
+// Precondition: characterID > 0.
+// Postcondition: tutorial_complete is TRUE; returns ErrCharacterNotFound when no row matches.
+func (r *CharacterRepository) MarkTutorialComplete(ctx context.Context, characterID int64) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE characters SET tutorial_complete = TRUE WHERE id = $1`,
+		characterID,
+	)
+	if err != nil {
+		return fmt.Errorf("marking tutorial complete for character %d: %w", characterID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCharacterNotFound
+	}
+	return nil
+}
+
 // SaveProgress persists level, experience, max_hp and pending ability boost count
 // for a character, delegating to CharacterProgressRepository.
 //
@@ -625,6 +801,50 @@ func (r *CharacterRepository) SaveCurrency(ctx context.Context, id int64, curren
 	return err
 }
 
+// SaveStashBalance persists the character's stash (bank) balance.
+//
+// Precondition: id > 0.
+// Postcondition: stash_balance column updated.
+func (r *CharacterRepository) SaveStashBalance(ctx context.Context, id int64, balance int) error {
+	if id <= 0 {
+		return fmt.Errorf("characterID must be > 0, got %d", id)
+	}
+	_, err := r.db.Exec(ctx, `UPDATE characters SET stash_balance = $2 WHERE id = $1`, id, balance)
+	return err
+}
+
+// LoadStashBalance returns the stored stash (bank) balance for the given character.
+//
+// Precondition: id > 0.
+// Postcondition: returns (balance, nil) on success.
+func (r *CharacterRepository) LoadStashBalance(ctx context.Context, id int64) (int, error) {
+	if id <= 0 {
+		return 0, fmt.Errorf("characterID must be > 0, got %d", id)
+	}
+	var balance int
+	err := r.db.QueryRow(ctx, `SELECT stash_balance FROM characters WHERE id = $1`, id).Scan(&balance)
+	return balance, err
+}
+
+// ChargeStash deducts amount from the character's stash balance when the balance covers it.
+//
+// Precondition: id > 0; amount >= 0.
+// Postcondition: Returns true and the balance is reduced by amount, or false with the balance unchanged.
+func (r *CharacterRepository) ChargeStash(ctx context.Context, id int64, amount int) (bool, error) {
+	if id <= 0 {
+		return false, fmt.Errorf("characterID must be > 0, got %d", id)
+	}
+	tag, err := r.db.Exec(ctx, `
+		UPDATE characters SET stash_balance = stash_balance - $2
+		WHERE id = $1 AND stash_balance >= $2`,
+		id, amount,
+	)
+	if err != nil {
+		return false, fmt.Errorf("charging stash for character %d: %w", id, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
 // LoadCurrency returns the stored currency value for the given character.
 //
 // Precondition: id > 0.
@@ -780,6 +1000,151 @@ func (r *CharacterRepository) DeleteByAccountAndName(ctx context.Context, accoun
 	return nil
 }
 
+// SoftDeleteByAccountAndName marks the named live character of accountID as
+// deleted. The row is kept for CharacterDeleteWindow so it can be restored.
+//
+// Precondition: accountID must be > 0; name must be non-empty.
+// Postcondition: Returns ErrCharacterNotFound if no matching live character exists; nil on success.
+func (r *CharacterRepository) SoftDeleteByAccountAndName(ctx context.Context, accountID int64, name string) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE characters SET deleted_at = NOW() WHERE account_id = $1 AND name = $2 AND deleted_at IS NULL`,
+		accountID, name,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting character %q for account %d: %w", name, accountID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCharacterNotFound
+	}
+	return nil
+}
+
+// SoftDeleteByID marks the live character charID owned by accountID as deleted.
+//
+// Precondition: accountID and charID must be > 0.
+// Postcondition: Returns ErrCharacterNotFound if no matching live character exists; nil on success.
+func (r *CharacterRepository) SoftDeleteByID(ctx context.Context, accountID, charID int64) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE characters SET deleted_at = NOW() WHERE id = $1 AND account_id = $2 AND deleted_at IS NULL`,
+		charID, accountID,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting character %d for account %d: %w", charID, accountID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCharacterNotFound
+	}
+	return nil
+}
+
+// ListDeletedByAccount returns the account's soft-deleted characters that are
+// still inside CharacterDeleteWindow, oldest deletion first.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Every returned character has DeletedAt set.
+func (r *CharacterRepository) ListDeletedByAccount(ctx context.Context, accountID int64) ([]*character.Character, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, account_id, name, class, level, deleted_at
+		FROM characters
+		WHERE account_id = $1 AND deleted_at IS NOT NULL AND deleted_at > $2
+		ORDER BY deleted_at ASC`,
+		accountID, time.Now().Add(-CharacterDeleteWindow),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing deleted characters: %w", err)
+	}
+	defer rows.Close()
+
+	chars := make([]*character.Character, 0)
+	for rows.Next() {
+		var c character.Character
+		if err := rows.Scan(&c.ID, &c.AccountID, &c.Name, &c.Class, &c.Level, &c.DeletedAt); err != nil {
+			return nil, fmt.Errorf("scanning deleted character row: %w", err)
+		}
+		chars = append(chars, &c)
+	}
+	return chars, rows.Err()
+}
+
+// RestoreByAccountAndName clears the deletion mark on the named character of
+// accountID if it was soft-deleted within CharacterDeleteWindow.
+//
+// Precondition: accountID must be > 0; name must be non-empty.
+// Postcondition: Returns ErrCharacterNotFound if no restorable character exists; nil on success.
+func (r *CharacterRepository) RestoreByAccountAndName(ctx context.Context, accountID int64, name string) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE characters SET deleted_at = NULL
+		WHERE account_id = $1 AND name = $2 AND deleted_at IS NOT NULL AND deleted_at > $3`,
+		accountID, name, time.Now().Add(-CharacterDeleteWindow),
+	)
+	if err != nil {
+		return fmt.Errorf("restoring character %q for account %d: %w", name, accountID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCharacterNotFound
+	}
+	return nil
+}
+
+// PurgeDeletedCharacters permanently removes characters soft-deleted before cutoff.
+//
+// Postcondition: Returns the number of characters removed.
+func (r *CharacterRepository) PurgeDeletedCharacters(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx,
+		`DELETE FROM characters WHERE deleted_at IS NOT NULL AND deleted_at < $1`, cutoff,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("purging deleted characters: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetIDByName returns the ID of the live character called name, case-insensitively.
+//
+// Precondition: name must be non-empty.
+// Postcondition: Returns ErrCharacterNotFound when no live character has the name.
+func (r *CharacterRepository) GetIDByName(ctx context.Context, name string) (int64, error) {
+	var id int64
+	err := r.db.QueryRow(ctx,
+		`SELECT id FROM characters WHERE lower(name) = lower($1) AND deleted_at IS NULL ORDER BY id LIMIT 1`, name,
+	).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrCharacterNotFound
+		}
+		return 0, fmt.Errorf("looking up character %q: %w", name, err)
+	}
+	return id, nil
+}
+
+// Rename changes the name of the live character id.
+//
+// Precondition: id must be > 0; name must be non-empty.
+// Postcondition: Returns ErrCharacterNameTaken if any character (live or
+// soft-deleted) already uses name case-insensitively, ErrCharacterNotFound if
+// no live character has id, and nil on success.
+func (r *CharacterRepository) Rename(ctx context.Context, id int64, name string) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE characters SET name = $2, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM characters WHERE lower(name) = lower($2) AND id <> $1)`,
+		id, name,
+	)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return ErrCharacterNameTaken
+		}
+		return fmt.Errorf("renaming character %d: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		if _, getErr := r.GetByID(ctx, id); getErr != nil {
+			return getErr
+		}
+		return ErrCharacterNameTaken
+	}
+	return nil
+}
+
 // LoadInstanceCharges returns a map of instanceID → InstanceChargeState for all instances
 // belonging to characterID that have non-sentinel charge state (charges_remaining != -1).
 //
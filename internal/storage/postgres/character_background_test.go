@@ -0,0 +1,36 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacterRepository_PersistsBackgroundAnswers(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	c := makeTestCharacter(accountID, uniqueName("Bond"))
+	c.BackgroundAnswers = map[string]string{"upbringing": "jade_district", "bond": "old_debt"}
+	created, err := repo.Create(ctx, c)
+	require.NoError(t, err)
+	assert.Equal(t, c.BackgroundAnswers, created.BackgroundAnswers)
+
+	fetched, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, c.BackgroundAnswers, fetched.BackgroundAnswers)
+}
+
+func TestCharacterRepository_BackgroundAnswersDefaultEmpty(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(accountID, uniqueName("Blank")))
+	require.NoError(t, err)
+
+	fetched, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Empty(t, fetched.BackgroundAnswers)
+}
@@ -0,0 +1,381 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// CharacterExportVersion is the format version written by ExportCharacter and
+// the only one ImportCharacter accepts.
+const CharacterExportVersion = 1
+
+// ErrUnsupportedExportVersion is returned when importing a document written
+// in a different format version.
+var ErrUnsupportedExportVersion = errors.New("unsupported character export version")
+
+// CharacterExport is a portable JSON snapshot of a character: its stats,
+// progression, inventory, equipment, and quests. It carries no database IDs,
+// so it can be imported into another environment.
+type CharacterExport struct {
+	Version           int                  `json:"version"`
+	ExportedAt        time.Time            `json:"exported_at"`
+	Name              string               `json:"name"`
+	Region            string               `json:"region"`
+	Heritage          string               `json:"heritage,omitempty"`
+	Class             string               `json:"class"`
+	Team              string               `json:"team,omitempty"`
+	Gender            string               `json:"gender,omitempty"`
+	FactionID         string               `json:"faction_id,omitempty"`
+	Level             int                  `json:"level"`
+	Experience        int                  `json:"experience"`
+	Location          string               `json:"location"`
+	Abilities         ExportedAbilities    `json:"abilities"`
+	PointBuy          map[string]int       `json:"point_buy,omitempty"`
+	MaxHP             int                  `json:"max_hp"`
+	CurrentHP         int                  `json:"current_hp"`
+	Currency          int                  `json:"currency"`
+	HeroPoints        int                  `json:"hero_points,omitempty"`
+	TutorialComplete  bool                 `json:"tutorial_complete,omitempty"`
+	Jobs              map[string]int       `json:"jobs,omitempty"`
+	ActiveJob         string               `json:"active_job,omitempty"`
+	Skills            map[string]string    `json:"skills,omitempty"`
+	Proficiencies     map[string]string    `json:"proficiencies,omitempty"`
+	Feats             []string             `json:"feats,omitempty"`
+	Inventory         []ExportedItem       `json:"inventory,omitempty"`
+	Equipment         []ExportedSlot       `json:"equipment,omitempty"`
+	WeaponPresets     []ExportedPresetSlot `json:"weapon_presets,omitempty"`
+	Quests            []ExportedQuest      `json:"quests,omitempty"`
+	BackgroundAnswers map[string]string    `json:"background_answers,omitempty"`
+}
+
+// ExportedAbilities are a character's ability scores.
+type ExportedAbilities struct {
+	Brutality int `json:"brutality"`
+	Grit      int `json:"grit"`
+	Quickness int `json:"quickness"`
+	Reasoning int `json:"reasoning"`
+	Savvy     int `json:"savvy"`
+	Flair     int `json:"flair"`
+}
+
+// ExportedItem is one backpack stack.
+type ExportedItem struct {
+	ItemDefID string                  `json:"item"`
+	Quantity  int                     `json:"quantity"`
+	Metadata  *inventory.ItemMetadata `json:"metadata,omitempty"`
+}
+
+// ExportedSlot is an item equipped in an armor or accessory slot.
+type ExportedSlot struct {
+	Slot                       string   `json:"slot"`
+	ItemDefID                  string   `json:"item"`
+	AffixedMaterials           []string `json:"affixed_materials,omitempty"`
+	MaterialMaxDurabilityBonus int      `json:"material_max_durability_bonus,omitempty"`
+	Affixes                    []string `json:"affixes,omitempty"`
+	CustomName                 string   `json:"custom_name,omitempty"`
+}
+
+// ExportedPresetSlot is a weapon in the main_hand or off_hand slot of a
+// weapon preset.
+type ExportedPresetSlot struct {
+	Preset int `json:"preset"`
+	Ammo   int `json:"ammo,omitempty"`
+	ExportedSlot
+}
+
+// ExportedQuest is a quest's status and objective progress.
+type ExportedQuest struct {
+	QuestID     string         `json:"quest"`
+	Status      string         `json:"status"`
+	Progress    map[string]int `json:"progress,omitempty"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+}
+
+// ExportCharacter snapshots the live character id.
+//
+// Precondition: id must be > 0; the character should be offline or saved,
+// or the snapshot misses unsaved session state.
+// Postcondition: Returns the snapshot, or ErrCharacterNotFound when no live
+// character has id.
+func (r *CharacterRepository) ExportCharacter(ctx context.Context, id int64) (*CharacterExport, error) {
+	c, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	exp := &CharacterExport{
+		Version:           CharacterExportVersion,
+		ExportedAt:        time.Now().UTC(),
+		Name:              c.Name,
+		Region:            c.Region,
+		Heritage:          c.Heritage,
+		Class:             c.Class,
+		Team:              c.Team,
+		Gender:            c.Gender,
+		FactionID:         c.FactionID,
+		Level:             c.Level,
+		Experience:        c.Experience,
+		Location:          c.Location,
+		Abilities:         ExportedAbilities(c.Abilities),
+		MaxHP:             c.MaxHP,
+		CurrentHP:         c.CurrentHP,
+		TutorialComplete:  c.TutorialComplete,
+		BackgroundAnswers: c.BackgroundAnswers,
+	}
+	if exp.Currency, err = r.LoadCurrency(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting currency of character %d: %w", id, err)
+	}
+	if exp.HeroPoints, err = r.LoadHeroPoints(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting hero points of character %d: %w", id, err)
+	}
+	if exp.Jobs, exp.ActiveJob, err = r.LoadJobs(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting jobs of character %d: %w", id, err)
+	}
+	if exp.PointBuy, err = NewCharacterPointBuyRepository(r.db).GetAll(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting point-buy of character %d: %w", id, err)
+	}
+	if exp.Skills, err = NewCharacterSkillsRepository(r.db).GetAll(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting skills of character %d: %w", id, err)
+	}
+	if exp.Proficiencies, err = NewCharacterProficienciesRepository(r.db).GetAll(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting proficiencies of character %d: %w", id, err)
+	}
+	if exp.Feats, err = NewCharacterFeatsRepository(r.db).GetAll(ctx, id); err != nil {
+		return nil, fmt.Errorf("exporting feats of character %d: %w", id, err)
+	}
+
+	items, err := r.LoadInventory(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("exporting inventory of character %d: %w", id, err)
+	}
+	for _, it := range items {
+		exp.Inventory = append(exp.Inventory, ExportedItem{ItemDefID: it.ItemDefID, Quantity: it.Quantity, Metadata: it.Metadata})
+	}
+	eq, err := r.LoadEquipment(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("exporting equipment of character %d: %w", id, err)
+	}
+	for slot, item := range eq.Armor {
+		exp.Equipment = append(exp.Equipment, exportSlot(string(slot), item))
+	}
+	for slot, item := range eq.Accessories {
+		exp.Equipment = append(exp.Equipment, exportSlot(string(slot), item))
+	}
+	sort.Slice(exp.Equipment, func(i, j int) bool { return exp.Equipment[i].Slot < exp.Equipment[j].Slot })
+	if exp.WeaponPresets, err = r.exportWeaponPresets(ctx, id); err != nil {
+		return nil, err
+	}
+
+	quests, err := NewQuestRepository(r.db).LoadQuests(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("exporting quests of character %d: %w", id, err)
+	}
+	for _, q := range quests {
+		exp.Quests = append(exp.Quests, ExportedQuest{QuestID: q.QuestID, Status: q.Status, Progress: q.Progress, CompletedAt: q.CompletedAt})
+	}
+	return exp, nil
+}
+
+// exportSlot converts an equipped item to its exported form.
+func exportSlot(slot string, item *inventory.SlottedItem) ExportedSlot {
+	return ExportedSlot{
+		Slot:                       slot,
+		ItemDefID:                  item.ItemDefID,
+		AffixedMaterials:           item.AffixedMaterials,
+		MaterialMaxDurabilityBonus: item.MaterialMaxDurabilityBonus,
+		Affixes:                    item.Affixes,
+		CustomName:                 item.CustomName,
+	}
+}
+
+// exportWeaponPresets reads the stored weapon preset rows as they are, so
+// the export does not depend on the item registry.
+func (r *CharacterRepository) exportWeaponPresets(ctx context.Context, id int64) ([]ExportedPresetSlot, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT preset_index, ammo_count, slot, item_def_id,
+		       affixed_materials, material_max_durability_bonus, affixes, custom_name
+		FROM character_weapon_presets
+		WHERE character_id = $1
+		ORDER BY preset_index, slot`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("exporting weapon presets of character %d: %w", id, err)
+	}
+	defer rows.Close()
+
+	var out []ExportedPresetSlot
+	for rows.Next() {
+		var p ExportedPresetSlot
+		if err := rows.Scan(&p.Preset, &p.Ammo, &p.Slot, &p.ItemDefID,
+			&p.AffixedMaterials, &p.MaterialMaxDurabilityBonus, &p.Affixes, &p.CustomName); err != nil {
+			return nil, fmt.Errorf("scanning weapon preset row: %w", err)
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// ImportCharacter creates a character owned by accountID from exp. When name
+// is non-empty it replaces the exported name, so a character can be imported
+// next to the one it was copied from.
+//
+// Precondition: accountID must reference an existing account; exp must be
+// non-nil.
+// Postcondition: Returns the created character. ErrUnsupportedExportVersion
+// if exp has a different version, ErrCharacterNameTaken if the name is in
+// use. On error no character is left behind.
+func (r *CharacterRepository) ImportCharacter(ctx context.Context, accountID int64, exp *CharacterExport, name string) (*character.Character, error) {
+	if exp.Version != CharacterExportVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedExportVersion, exp.Version)
+	}
+	if name == "" {
+		name = exp.Name
+	}
+	if name == "" {
+		return nil, errors.New("character export has no name")
+	}
+	available, err := r.IsNameAvailable(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("checking name %q: %w", name, err)
+	}
+	if !available {
+		return nil, ErrCharacterNameTaken
+	}
+	c, err := r.Create(ctx, &character.Character{
+		AccountID:         accountID,
+		Name:              name,
+		Region:            exp.Region,
+		Heritage:          exp.Heritage,
+		Class:             exp.Class,
+		Team:              exp.Team,
+		Gender:            exp.Gender,
+		FactionID:         exp.FactionID,
+		Level:             exp.Level,
+		Experience:        exp.Experience,
+		Location:          exp.Location,
+		Abilities:         character.AbilityScores(exp.Abilities),
+		MaxHP:             exp.MaxHP,
+		CurrentHP:         exp.CurrentHP,
+		BackgroundAnswers: exp.BackgroundAnswers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.importDetails(ctx, c.ID, exp); err != nil {
+		// Everything else the import wrote is keyed by the character and
+		// cascades with it.
+		if delErr := r.DeleteByID(ctx, accountID, c.ID); delErr != nil {
+			return nil, fmt.Errorf("%w (removing partial import %d: %v)", err, c.ID, delErr)
+		}
+		return nil, err
+	}
+	c.TutorialComplete = exp.TutorialComplete
+	return c, nil
+}
+
+// importDetails writes everything in exp beyond the characters row to the
+// character id.
+func (r *CharacterRepository) importDetails(ctx context.Context, id int64, exp *CharacterExport) error {
+	// The exported inventory replaces the starting kit, which must not be
+	// granted again at first login.
+	if err := r.MarkStartingInventoryGranted(ctx, id); err != nil {
+		return fmt.Errorf("importing character %d: %w", id, err)
+	}
+	if exp.TutorialComplete {
+		if err := r.MarkTutorialComplete(ctx, id); err != nil {
+			return fmt.Errorf("importing tutorial state of character %d: %w", id, err)
+		}
+	}
+	if err := r.SaveCurrency(ctx, id, exp.Currency); err != nil {
+		return fmt.Errorf("importing currency of character %d: %w", id, err)
+	}
+	if err := r.SaveHeroPoints(ctx, id, exp.HeroPoints); err != nil {
+		return fmt.Errorf("importing hero points of character %d: %w", id, err)
+	}
+	if err := r.SaveJobs(ctx, id, exp.Jobs, exp.ActiveJob); err != nil {
+		return fmt.Errorf("importing jobs of character %d: %w", id, err)
+	}
+	if err := NewCharacterPointBuyRepository(r.db).SetAll(ctx, id, exp.PointBuy); err != nil {
+		return fmt.Errorf("importing point-buy of character %d: %w", id, err)
+	}
+	if err := NewCharacterSkillsRepository(r.db).SetAll(ctx, id, exp.Skills); err != nil {
+		return fmt.Errorf("importing skills of character %d: %w", id, err)
+	}
+	profs := NewCharacterProficienciesRepository(r.db)
+	for category, rank := range exp.Proficiencies {
+		if err := profs.Upsert(ctx, id, category, rank); err != nil {
+			return fmt.Errorf("importing proficiency %q of character %d: %w", category, id, err)
+		}
+	}
+	if err := NewCharacterFeatsRepository(r.db).SetAll(ctx, id, exp.Feats); err != nil {
+		return fmt.Errorf("importing feats of character %d: %w", id, err)
+	}
+
+	items := make([]inventory.InventoryItem, 0, len(exp.Inventory))
+	for _, it := range exp.Inventory {
+		items = append(items, inventory.InventoryItem{ItemDefID: it.ItemDefID, Quantity: it.Quantity, Metadata: it.Metadata})
+	}
+	if err := r.SaveInventory(ctx, id, items); err != nil {
+		return fmt.Errorf("importing inventory of character %d: %w", id, err)
+	}
+	eq := inventory.NewEquipment()
+	armorSlots := inventory.ValidArmorSlots()
+	for _, s := range exp.Equipment {
+		item := &inventory.SlottedItem{
+			ItemDefID:                  s.ItemDefID,
+			Name:                       s.ItemDefID,
+			AffixedMaterials:           s.AffixedMaterials,
+			MaterialMaxDurabilityBonus: s.MaterialMaxDurabilityBonus,
+			Affixes:                    s.Affixes,
+			CustomName:                 s.CustomName,
+		}
+		if _, ok := armorSlots[inventory.ArmorSlot(s.Slot)]; ok {
+			eq.Armor[inventory.ArmorSlot(s.Slot)] = item
+		} else {
+			eq.Accessories[inventory.AccessorySlot(s.Slot)] = item
+		}
+	}
+	if err := r.SaveEquipment(ctx, id, eq); err != nil {
+		return fmt.Errorf("importing equipment of character %d: %w", id, err)
+	}
+	for _, p := range exp.WeaponPresets {
+		if _, err := r.db.Exec(ctx, `
+			INSERT INTO character_weapon_presets
+				(character_id, preset_index, slot, item_def_id, ammo_count,
+				 affixed_materials, material_max_durability_bonus, affixes, custom_name)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			id, p.Preset, p.Slot, p.ItemDefID, p.Ammo,
+			stringsOrEmpty(p.AffixedMaterials), p.MaterialMaxDurabilityBonus, stringsOrEmpty(p.Affixes), p.CustomName,
+		); err != nil {
+			return fmt.Errorf("importing %s of weapon preset %d for character %d: %w", p.Slot, p.Preset, id, err)
+		}
+	}
+
+	quests := NewQuestRepository(r.db)
+	for _, q := range exp.Quests {
+		if err := quests.SaveQuestStatus(ctx, id, q.QuestID, q.Status, q.CompletedAt); err != nil {
+			return fmt.Errorf("importing quest %q of character %d: %w", q.QuestID, id, err)
+		}
+		for objective, progress := range q.Progress {
+			if err := quests.SaveObjectiveProgress(ctx, id, q.QuestID, objective, progress); err != nil {
+				return fmt.Errorf("importing quest %q of character %d: %w", q.QuestID, id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// stringsOrEmpty returns s, or an empty slice when s is nil, for NOT NULL
+// array columns.
+func stringsOrEmpty(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
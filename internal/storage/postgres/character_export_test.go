@@ -0,0 +1,84 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterRepository_ExportImport_RoundTrip(t *testing.T) {
+	repo, fromID := setupCharRepos(t)
+	_, toID := setupCharRepos(t)
+	ctx := context.Background()
+
+	src, err := repo.Create(ctx, makeTestCharacter(fromID, uniqueName("Exported")))
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveCurrency(ctx, src.ID, 321))
+	require.NoError(t, repo.SaveJobs(ctx, src.ID, map[string]int{"ganger": 3}, "ganger"))
+	require.NoError(t, repo.SaveInventory(ctx, src.ID, []inventory.InventoryItem{
+		{ItemDefID: "stim_pack", Quantity: 2},
+		{ItemDefID: "pipe_wrench", Quantity: 1, Metadata: &inventory.ItemMetadata{Durability: 7, CustomName: "Lucky"}},
+	}))
+	eq := inventory.NewEquipment()
+	eq.Armor[inventory.SlotTorso] = &inventory.SlottedItem{ItemDefID: "leather_jacket", Affixes: []string{"padded"}}
+	require.NoError(t, repo.SaveEquipment(ctx, src.ID, eq))
+	require.NoError(t, postgres.NewCharacterFeatsRepository(sharedPool).SetAll(ctx, src.ID, []string{"brawler"}))
+	require.NoError(t, postgres.NewCharacterSkillsRepository(sharedPool).SetAll(ctx, src.ID, map[string]string{"muscle": "trained"}))
+	quests := postgres.NewQuestRepository(sharedPool)
+	require.NoError(t, quests.SaveQuestStatus(ctx, src.ID, "rat_problem", "active", nil))
+	require.NoError(t, quests.SaveObjectiveProgress(ctx, src.ID, "rat_problem", "kill_rats", 4))
+	_, err = sharedPool.Exec(ctx, `
+		INSERT INTO character_weapon_presets (character_id, preset_index, slot, item_def_id, ammo_count)
+		VALUES ($1, 1, 'main_hand', 'cheap_blade', 0)`, src.ID)
+	require.NoError(t, err)
+
+	exp, err := repo.ExportCharacter(ctx, src.ID)
+	require.NoError(t, err)
+	raw, err := json.Marshal(exp)
+	require.NoError(t, err)
+	var decoded postgres.CharacterExport
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+
+	copyName := uniqueName("Imported")
+	dst, err := repo.ImportCharacter(ctx, toID, &decoded, copyName)
+	require.NoError(t, err)
+	assert.Equal(t, copyName, dst.Name)
+	assert.Equal(t, toID, dst.AccountID)
+	assert.Equal(t, src.Abilities, dst.Abilities)
+
+	got, err := repo.ExportCharacter(ctx, dst.ID)
+	require.NoError(t, err)
+	got.Name, got.ExportedAt = exp.Name, exp.ExportedAt
+	assert.Equal(t, exp, got)
+	assert.Equal(t, 321, got.Currency)
+	assert.Len(t, got.Inventory, 2)
+	assert.Equal(t, map[string]int{"kill_rats": 4}, got.Quests[0].Progress)
+	assert.Equal(t, "cheap_blade", got.WeaponPresets[0].ItemDefID)
+	assert.Equal(t, 1, got.WeaponPresets[0].Preset)
+}
+
+func TestCharacterRepository_ImportCharacter_Rejects(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	src, err := repo.Create(ctx, makeTestCharacter(accountID, uniqueName("Taken")))
+	require.NoError(t, err)
+	exp, err := repo.ExportCharacter(ctx, src.ID)
+	require.NoError(t, err)
+
+	_, err = repo.ImportCharacter(ctx, accountID, exp, "")
+	assert.ErrorIs(t, err, postgres.ErrCharacterNameTaken)
+
+	exp.Version = postgres.CharacterExportVersion + 1
+	_, err = repo.ImportCharacter(ctx, accountID, exp, uniqueName("Future"))
+	assert.ErrorIs(t, err, postgres.ErrUnsupportedExportVersion)
+
+	_, err = repo.ExportCharacter(ctx, 99999999)
+	assert.ErrorIs(t, err, postgres.ErrCharacterNotFound)
+}
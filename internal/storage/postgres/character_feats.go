@@ -74,6 +74,22 @@ func (r *CharacterFeatsRepository) Add(ctx context.Context, characterID int64, f
 	return nil
 }
 
+// Remove deletes a single feat from a character. Removing a feat the
+// character does not have is a no-op.
+//
+// Precondition: characterID > 0; featID non-empty.
+// Postcondition: No feat_id row exists for this character.
+func (r *CharacterFeatsRepository) Remove(ctx context.Context, characterID int64, featID string) error {
+	_, err := r.db.Exec(ctx,
+		`DELETE FROM character_feats WHERE character_id = $1 AND feat_id = $2`,
+		characterID, featID,
+	)
+	if err != nil {
+		return fmt.Errorf("Remove feat %s: %w", featID, err)
+	}
+	return nil
+}
+
 // SetAll writes the complete feat list for a character, replacing any existing rows.
 //
 // Precondition: characterID > 0; feats must not be nil.
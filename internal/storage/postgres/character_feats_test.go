@@ -158,4 +158,18 @@ func TestCharacterFeatsRepository_Add(t *testing.T) {
 	if len(got) != 2 {
 		t.Errorf("expected still 2 feats after duplicate Add, got %d: %v", len(got), got)
 	}
+
+	// Remove feat_a, then remove it again — must be idempotent.
+	for i := 0; i < 2; i++ {
+		if err := repo.Remove(ctx, ch.ID, "feat_a"); err != nil {
+			t.Fatalf("Remove feat_a: %v", err)
+		}
+	}
+	got, err = repo.GetAll(ctx, ch.ID)
+	if err != nil {
+		t.Fatalf("GetAll after Remove: %v", err)
+	}
+	if len(got) != 1 || got[0] != "feat_b" {
+		t.Errorf("expected [feat_b] after Remove, got %v", got)
+	}
 }
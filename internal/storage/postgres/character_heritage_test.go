@@ -0,0 +1,29 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacterRepository_PersistsHeritage(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	c := makeTestCharacter(accountID, uniqueName("Heir"))
+	c.Heritage = "street_born"
+	created, err := repo.Create(ctx, c)
+	require.NoError(t, err)
+	assert.Equal(t, "street_born", created.Heritage)
+
+	fetched, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "street_born", fetched.Heritage)
+
+	listed, err := repo.ListByAccount(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "street_born", listed[0].Heritage)
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CharacterPointBuyRepository persists the point-buy adjustments characters
+// make to their ability scores.
+type CharacterPointBuyRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewCharacterPointBuyRepository creates a repository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewCharacterPointBuyRepository(db *pgxpool.Pool) *CharacterPointBuyRepository {
+	return &CharacterPointBuyRepository{db: db}
+}
+
+// GetAll returns a character's point-buy adjustments as an ability→delta map.
+//
+// Precondition: characterID > 0.
+// Postcondition: Returns an empty map (not nil) if no rows exist.
+func (r *CharacterPointBuyRepository) GetAll(ctx context.Context, characterID int64) (map[string]int, error) {
+	if characterID <= 0 {
+		return make(map[string]int), nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT ability, delta FROM character_point_buy WHERE character_id = $1`, characterID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("GetAll point buy: %w", err)
+	}
+	defer rows.Close()
+	out := make(map[string]int)
+	for rows.Next() {
+		var ability string
+		var delta int
+		if err := rows.Scan(&ability, &delta); err != nil {
+			return nil, fmt.Errorf("scanning point buy row: %w", err)
+		}
+		out[ability] = delta
+	}
+	return out, rows.Err()
+}
+
+// SetAll replaces a character's point-buy adjustments with adj. Zero deltas
+// are dropped, so an empty adj clears the character's point-buy.
+//
+// Precondition: characterID > 0; adj keys must not be empty.
+// Postcondition: The stored adjustments equal the non-zero entries of adj.
+func (r *CharacterPointBuyRepository) SetAll(ctx context.Context, characterID int64, adj map[string]int) error {
+	if characterID <= 0 {
+		return fmt.Errorf("SetAll: characterID must be > 0")
+	}
+	for ability := range adj {
+		if ability == "" {
+			return fmt.Errorf("SetAll: ability must not be empty")
+		}
+	}
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("SetAll begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	if _, err := tx.Exec(ctx, `DELETE FROM character_point_buy WHERE character_id = $1`, characterID); err != nil {
+		return fmt.Errorf("SetAll clear: %w", err)
+	}
+	for ability, delta := range adj {
+		if delta == 0 {
+			continue
+		}
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO character_point_buy (character_id, ability, delta) VALUES ($1, $2, $3)`,
+			characterID, ability, delta,
+		); err != nil {
+			return fmt.Errorf("SetAll %s: %w", ability, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("SetAll commit: %w", err)
+	}
+	return nil
+}
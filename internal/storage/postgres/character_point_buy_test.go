@@ -0,0 +1,96 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	pgstore "github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterPointBuyRepository_ValidatesInputs(t *testing.T) {
+	repo := pgstore.NewCharacterPointBuyRepository(nil)
+	ctx := context.Background()
+
+	got, err := repo.GetAll(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	assert.ErrorContains(t, repo.SetAll(ctx, 0, nil), "characterID must be > 0")
+	assert.ErrorContains(t, repo.SetAll(ctx, 1, map[string]int{"": 2}), "ability must not be empty")
+}
+
+func TestCharacterPointBuyRepository_RoundTrip(t *testing.T) {
+	pool := testDBWithProficiencies(t)
+	ctx := context.Background()
+	charRepo := pgstore.NewCharacterRepository(pool)
+	ch := createTestCharacter(t, charRepo, ctx)
+	repo := pgstore.NewCharacterPointBuyRepository(pool)
+
+	got, err := repo.GetAll(ctx, ch.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	require.NoError(t, repo.SetAll(ctx, ch.ID, map[string]int{"grit": 2, "flair": -2}))
+	require.NoError(t, repo.SetAll(ctx, ch.ID, map[string]int{"savvy": 1, "reasoning": -1, "grit": 0}))
+
+	got, err = repo.GetAll(ctx, ch.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"savvy": 1, "reasoning": -1}, got)
+
+	require.NoError(t, repo.SetAll(ctx, ch.ID, nil))
+	got, err = repo.GetAll(ctx, ch.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestCharacterRepository_CreatePersistsPointBuy(t *testing.T) {
+	pool := testDBWithProficiencies(t)
+	ctx := context.Background()
+	charRepo := pgstore.NewCharacterRepository(pool)
+	acct, err := pgstore.NewAccountRepository(pool).Create(ctx, "pointbuy_owner", "password123")
+	require.NoError(t, err)
+
+	created, err := charRepo.Create(ctx, &character.Character{
+		AccountID: acct.ID,
+		Name:      "pointbuy_hero",
+		Region:    "old_town",
+		Class:     "ganger",
+		Level:     1,
+		Location:  "grinders_row",
+		Abilities: character.AbilityScores{Brutality: 14, Quickness: 12, Grit: 12, Reasoning: 10, Savvy: 8, Flair: 10},
+		MaxHP:     10,
+		CurrentHP: 10,
+		PointBuy:  map[string]int{"grit": 2, "flair": -2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"grit": 2, "flair": -2}, created.PointBuy)
+
+	got, err := pgstore.NewCharacterPointBuyRepository(pool).GetAll(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"grit": 2, "flair": -2}, got)
+}
+
+func TestProperty_CharacterPointBuyRepository_LastWriteWins(t *testing.T) {
+	pool := testDBWithProficiencies(t)
+	ctx := context.Background()
+	charRepo := pgstore.NewCharacterRepository(pool)
+	repo := pgstore.NewCharacterPointBuyRepository(pool)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		ch := createTestCharacter(t, charRepo, ctx)
+		var last int
+		for _, d := range rapid.SliceOfN(rapid.IntRange(-2, 2), 1, 4).Draw(rt, "writes") {
+			require.NoError(rt, repo.SetAll(ctx, ch.ID, map[string]int{"grit": d, "flair": -d}))
+			last = d
+		}
+		got, err := repo.GetAll(ctx, ch.ID)
+		require.NoError(rt, err)
+		assert.Equal(rt, last, got["grit"])
+		assert.Equal(rt, -last, got["flair"])
+	})
+}
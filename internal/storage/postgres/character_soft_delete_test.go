@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterRepository_SoftDeleteHidesAndRestores(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(accountID, "Doomed"))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SoftDeleteByAccountAndName(ctx, accountID, "Doomed"))
+
+	live, err := repo.ListByAccount(ctx, accountID)
+	require.NoError(t, err)
+	assert.Empty(t, live)
+	_, err = repo.GetByID(ctx, created.ID)
+	assert.ErrorIs(t, err, postgres.ErrCharacterNotFound)
+
+	deleted, err := repo.ListDeletedByAccount(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "Doomed", deleted[0].Name)
+	require.NotNil(t, deleted[0].DeletedAt)
+
+	assert.ErrorIs(t, repo.SoftDeleteByAccountAndName(ctx, accountID, "Doomed"), postgres.ErrCharacterNotFound)
+
+	require.NoError(t, repo.RestoreByAccountAndName(ctx, accountID, "Doomed"))
+	live, err = repo.ListByAccount(ctx, accountID)
+	require.NoError(t, err)
+	assert.Len(t, live, 1)
+	assert.ErrorIs(t, repo.RestoreByAccountAndName(ctx, accountID, "Doomed"), postgres.ErrCharacterNotFound)
+}
+
+func TestCharacterRepository_SoftDeleteByID_ChecksOwnership(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	_, otherAccountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(accountID, "Owned"))
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, repo.SoftDeleteByID(ctx, otherAccountID, created.ID), postgres.ErrCharacterNotFound)
+	require.NoError(t, repo.SoftDeleteByID(ctx, accountID, created.ID))
+}
+
+func TestCharacterRepository_PurgeDeletedCharacters(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(accountID, "Purged"))
+	require.NoError(t, err)
+	require.NoError(t, repo.SoftDeleteByID(ctx, accountID, created.ID))
+
+	n, err := repo.PurgeDeletedCharacters(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	deleted, err := repo.ListDeletedByAccount(ctx, accountID)
+	require.NoError(t, err)
+	assert.Len(t, deleted, 1, "a recent deletion is kept (purged %d others)", n)
+
+	_, err = repo.PurgeDeletedCharacters(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	deleted, err = repo.ListDeletedByAccount(ctx, accountID)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+}
+
+func TestCharacterRepository_Rename(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	first, err := repo.Create(ctx, makeTestCharacter(accountID, uniqueName("Old")))
+	require.NoError(t, err)
+	second, err := repo.Create(ctx, makeTestCharacter(accountID, uniqueName("Other")))
+	require.NoError(t, err)
+
+	newName := uniqueName("New")
+	require.NoError(t, repo.Rename(ctx, first.ID, newName))
+	fetched, err := repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, newName, fetched.Name)
+
+	assert.ErrorIs(t, repo.Rename(ctx, first.ID, second.Name), postgres.ErrCharacterNameTaken)
+	assert.ErrorIs(t, repo.Rename(ctx, 99999999, uniqueName("Ghost")), postgres.ErrCharacterNotFound)
+}
@@ -268,6 +268,25 @@ func TestCharacterRepository_Inventory_RoundTrip(t *testing.T) {
 	assert.ElementsMatch(t, items, loaded)
 }
 
+func TestCharacterRepository_Inventory_RoundTripsInstanceMetadata(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+	char, err := repo.Create(ctx, makeTestCharacter(accountID, "InvMetadata"))
+	require.NoError(t, err)
+
+	items := []inventory.InventoryItem{
+		{ItemDefID: "combat_knife", Quantity: 1},
+		{ItemDefID: "combat_knife", Quantity: 1, Metadata: &inventory.ItemMetadata{
+			Durability: 3, MaxDurability: 10, Modifier: "tuned", Affixes: []string{"hair_trigger"},
+		}},
+	}
+	require.NoError(t, repo.SaveInventory(ctx, char.ID, items))
+
+	loaded, err := repo.LoadInventory(ctx, char.ID)
+	require.NoError(t, err)
+	assert.Equal(t, items, loaded)
+}
+
 func TestCharacterRepository_HasReceivedStartingInventory_DefaultFalse(t *testing.T) {
 	repo, accountID := setupCharRepos(t)
 	ctx := context.Background()
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cory-johannsen/mud/internal/config"
+)
+
+// ErrTransferSameAccount is returned when transferring a character to the
+// account that already owns it.
+var ErrTransferSameAccount = errors.New("character already belongs to that account")
+
+// ErrCharacterSlotsFull is returned when transferring a character to an
+// account that already owns as many characters as its limit allows.
+var ErrCharacterSlotsFull = errors.New("account has no free character slot")
+
+// TransferToAccount moves the live character id to the account toAccountID.
+// Everything the character owns, such as its inventory, equipment presets,
+// stash, and housing, is keyed by the character and moves with it.
+// The target account's limit under slots is enforced the same way as at
+// character creation.
+//
+// Precondition: id and toAccountID must be > 0; the character must not be
+// online, or its session would save it back over the transfer.
+// Postcondition: Returns the account the character was moved from.
+// ErrCharacterNotFound if no live character has id, ErrAccountNotFound if no
+// unpurged account has toAccountID, ErrTransferSameAccount if it already owns
+// the character, ErrCharacterSlotsFull if it has no free character slot, and
+// ErrCharacterNameTaken if the target account has a character with the same
+// name. On error nothing changes.
+func (r *CharacterRepository) TransferToAccount(ctx context.Context, id, toAccountID int64, slots config.CharactersConfig) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting transfer of character %d: %w", id, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var fromAccountID int64
+	err = tx.QueryRow(ctx,
+		`SELECT account_id FROM characters WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, id,
+	).Scan(&fromAccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrCharacterNotFound
+		}
+		return 0, fmt.Errorf("locking character %d: %w", id, err)
+	}
+	if fromAccountID == toAccountID {
+		return 0, ErrTransferSameAccount
+	}
+
+	// Locking the target keeps a purge from removing it mid-transfer.
+	var (
+		role     string
+		override int
+	)
+	err = tx.QueryRow(ctx,
+		`SELECT role, character_slots FROM accounts WHERE id = $1 AND purged_at IS NULL FOR UPDATE`, toAccountID,
+	).Scan(&role, &override)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrAccountNotFound
+		}
+		return 0, fmt.Errorf("locking account %d: %w", toAccountID, err)
+	}
+	if limit := slots.SlotLimit(role, override); limit > 0 {
+		// Locking the target's characters keeps a concurrent restore or
+		// transfer from filling the slot this one counts as free.
+		rows, err := tx.Query(ctx,
+			`SELECT id FROM characters WHERE account_id = $1 AND deleted_at IS NULL FOR UPDATE`, toAccountID,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("counting characters of account %d: %w", toAccountID, err)
+		}
+		owned := 0
+		for rows.Next() {
+			owned++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return 0, fmt.Errorf("counting characters of account %d: %w", toAccountID, err)
+		}
+		if owned >= limit {
+			return 0, ErrCharacterSlotsFull
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE characters SET account_id = $2, updated_at = NOW() WHERE id = $1`, id, toAccountID,
+	)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, ErrCharacterNameTaken
+		}
+		return 0, fmt.Errorf("transferring character %d to account %d: %w", id, toAccountID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("committing transfer of character %d: %w", id, err)
+	}
+	return fromAccountID, nil
+}
@@ -0,0 +1,79 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterRepository_TransferToAccount(t *testing.T) {
+	repo, fromID := setupCharRepos(t)
+	_, toID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(fromID, uniqueName("Mover")))
+	require.NoError(t, err)
+	_, err = sharedPool.Exec(ctx, `UPDATE characters SET stash_balance = 40 WHERE id = $1`, created.ID)
+	require.NoError(t, err)
+
+	got, err := repo.TransferToAccount(ctx, created.ID, toID, config.CharactersConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, fromID, got)
+
+	moved, err := repo.ListByAccount(ctx, toID)
+	require.NoError(t, err)
+	require.Len(t, moved, 1)
+	assert.Equal(t, created.ID, moved[0].ID)
+	left, err := repo.ListByAccount(ctx, fromID)
+	require.NoError(t, err)
+	assert.Empty(t, left)
+	assert.Equal(t, 40, count(t, `SELECT stash_balance FROM characters WHERE id = $1`, created.ID))
+}
+
+func TestCharacterRepository_TransferToAccount_Rejects(t *testing.T) {
+	repo, fromID := setupCharRepos(t)
+	_, toID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(fromID, uniqueName("Stay")))
+	require.NoError(t, err)
+
+	_, err = repo.TransferToAccount(ctx, created.ID, fromID, config.CharactersConfig{})
+	assert.ErrorIs(t, err, postgres.ErrTransferSameAccount)
+	_, err = repo.TransferToAccount(ctx, created.ID, 99999999, config.CharactersConfig{})
+	assert.ErrorIs(t, err, postgres.ErrAccountNotFound)
+	_, err = repo.TransferToAccount(ctx, 99999999, toID, config.CharactersConfig{})
+	assert.ErrorIs(t, err, postgres.ErrCharacterNotFound)
+
+	require.NoError(t, repo.SoftDeleteByID(ctx, fromID, created.ID))
+	_, err = repo.TransferToAccount(ctx, created.ID, toID, config.CharactersConfig{})
+	assert.ErrorIs(t, err, postgres.ErrCharacterNotFound)
+}
+
+func TestCharacterRepository_TransferToAccount_RespectsSlotLimit(t *testing.T) {
+	repo, fromID := setupCharRepos(t)
+	_, toID := setupCharRepos(t)
+	ctx := context.Background()
+
+	mover, err := repo.Create(ctx, makeTestCharacter(fromID, uniqueName("Mover")))
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, makeTestCharacter(toID, uniqueName("Resident")))
+	require.NoError(t, err)
+	deleted, err := repo.Create(ctx, makeTestCharacter(toID, uniqueName("Gone")))
+	require.NoError(t, err)
+	require.NoError(t, repo.SoftDeleteByID(ctx, toID, deleted.ID))
+
+	_, err = repo.TransferToAccount(ctx, mover.ID, toID, config.CharactersConfig{MaxPerAccount: 1})
+	assert.ErrorIs(t, err, postgres.ErrCharacterSlotsFull)
+	left, err := repo.ListByAccount(ctx, fromID)
+	require.NoError(t, err)
+	assert.Len(t, left, 1, "a refused transfer changes nothing")
+
+	_, err = repo.TransferToAccount(ctx, mover.ID, toID, config.CharactersConfig{MaxPerAccount: 2})
+	assert.NoError(t, err, "deleted characters do not take a slot")
+}
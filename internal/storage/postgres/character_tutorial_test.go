@@ -0,0 +1,33 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterRepository_MarkTutorialComplete(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, makeTestCharacter(accountID, uniqueName("Rookie")))
+	require.NoError(t, err)
+	fetched, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, fetched.TutorialComplete, "new characters start the tutorial")
+
+	require.NoError(t, repo.MarkTutorialComplete(ctx, created.ID))
+	fetched, err = repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, fetched.TutorialComplete)
+}
+
+func TestCharacterRepository_MarkTutorialComplete_NotFound(t *testing.T) {
+	repo, _ := setupCharRepos(t)
+	err := repo.MarkTutorialComplete(context.Background(), 999999999)
+	assert.ErrorIs(t, err, postgres.ErrCharacterNotFound)
+}
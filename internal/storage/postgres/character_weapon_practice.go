@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CharacterWeaponPracticeRepository persists the practice points characters
+// earn with each weapon proficiency category by fighting with it.
+type CharacterWeaponPracticeRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewCharacterWeaponPracticeRepository creates a repository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewCharacterWeaponPracticeRepository(db *pgxpool.Pool) *CharacterWeaponPracticeRepository {
+	return &CharacterWeaponPracticeRepository{db: db}
+}
+
+// GetAll returns a character's practice points as a category→points map.
+//
+// Precondition: characterID > 0.
+// Postcondition: Returns an empty map (not nil) if no rows exist.
+func (r *CharacterWeaponPracticeRepository) GetAll(ctx context.Context, characterID int64) (map[string]int, error) {
+	if characterID <= 0 {
+		return make(map[string]int), nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT category, points FROM character_weapon_practice WHERE character_id = $1`, characterID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("GetAll weapon practice: %w", err)
+	}
+	defer rows.Close()
+	out := make(map[string]int)
+	for rows.Next() {
+		var cat string
+		var points int
+		if err := rows.Scan(&cat, &points); err != nil {
+			return nil, fmt.Errorf("scanning weapon practice row: %w", err)
+		}
+		out[cat] = points
+	}
+	return out, rows.Err()
+}
+
+// SetPractice records a character's practice points with a weapon category.
+//
+// Precondition: characterID > 0; category must not be empty; points >= 0.
+// Postcondition: Exactly one row exists for (characterID, category) holding points.
+func (r *CharacterWeaponPracticeRepository) SetPractice(ctx context.Context, characterID int64, category string, points int) error {
+	if characterID <= 0 {
+		return fmt.Errorf("SetPractice: characterID must be > 0")
+	}
+	if category == "" {
+		return fmt.Errorf("SetPractice: category must not be empty")
+	}
+	if points < 0 {
+		return fmt.Errorf("SetPractice: points must be >= 0")
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO character_weapon_practice (character_id, category, points) VALUES ($1, $2, $3)
+         ON CONFLICT (character_id, category) DO UPDATE SET points = EXCLUDED.points`,
+		characterID, category, points,
+	)
+	if err != nil {
+		return fmt.Errorf("SetPractice %s: %w", category, err)
+	}
+	return nil
+}
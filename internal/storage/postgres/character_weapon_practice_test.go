@@ -0,0 +1,63 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	pgstore "github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestCharacterWeaponPracticeRepository_ValidatesInputs(t *testing.T) {
+	repo := pgstore.NewCharacterWeaponPracticeRepository(nil)
+	ctx := context.Background()
+
+	got, err := repo.GetAll(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	assert.ErrorContains(t, repo.SetPractice(ctx, 0, "unarmed", 1), "characterID must be > 0")
+	assert.ErrorContains(t, repo.SetPractice(ctx, 1, "", 1), "category must not be empty")
+	assert.ErrorContains(t, repo.SetPractice(ctx, 1, "unarmed", -1), "points must be >= 0")
+}
+
+func TestCharacterWeaponPracticeRepository_RoundTrip(t *testing.T) {
+	pool := testDBWithProficiencies(t)
+	ctx := context.Background()
+	charRepo := pgstore.NewCharacterRepository(pool)
+	ch := createTestCharacter(t, charRepo, ctx)
+	repo := pgstore.NewCharacterWeaponPracticeRepository(pool)
+
+	got, err := repo.GetAll(ctx, ch.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	require.NoError(t, repo.SetPractice(ctx, ch.ID, "martial_ranged", 12))
+	require.NoError(t, repo.SetPractice(ctx, ch.ID, "unarmed", 3))
+	require.NoError(t, repo.SetPractice(ctx, ch.ID, "martial_ranged", 13))
+
+	got, err = repo.GetAll(ctx, ch.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"martial_ranged": 13, "unarmed": 3}, got)
+}
+
+func TestProperty_CharacterWeaponPracticeRepository_LastWriteWins(t *testing.T) {
+	pool := testDBWithProficiencies(t)
+	ctx := context.Background()
+	charRepo := pgstore.NewCharacterRepository(pool)
+	repo := pgstore.NewCharacterWeaponPracticeRepository(pool)
+
+	rapid.Check(t, func(rt *rapid.T) {
+		ch := createTestCharacter(t, charRepo, ctx)
+		writes := rapid.SliceOfN(rapid.IntRange(0, 1000), 1, 5).Draw(rt, "writes")
+		for _, p := range writes {
+			require.NoError(rt, repo.SetPractice(ctx, ch.ID, "simple_weapons", p))
+		}
+		got, err := repo.GetAll(ctx, ch.ID)
+		require.NoError(rt, err)
+		assert.Equal(rt, writes[len(writes)-1], got["simple_weapons"])
+	})
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/clan"
+)
+
+// ClanRepository persists player clans and their memberships.
+type ClanRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewClanRepository creates a ClanRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewClanRepository(db *pgxpool.Pool) *ClanRepository {
+	return &ClanRepository{db: db}
+}
+
+// LoadClans returns every clan with its live members.
+//
+// Postcondition: Member names are the members' current character names;
+// soft-deleted characters are omitted.
+func (r *ClanRepository) LoadClans(ctx context.Context) ([]*clan.Clan, error) {
+	rows, err := r.db.Query(ctx, `SELECT name, tag, motd, bank FROM clans`)
+	if err != nil {
+		return nil, fmt.Errorf("ClanRepository.LoadClans: %w", err)
+	}
+	byName := make(map[string]*clan.Clan)
+	var out []*clan.Clan
+	for rows.Next() {
+		c := &clan.Clan{Members: make(map[int64]clan.Member)}
+		if err := rows.Scan(&c.Name, &c.Tag, &c.MOTD, &c.Bank); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("ClanRepository.LoadClans scan: %w", err)
+		}
+		byName[c.Name] = c
+		out = append(out, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ClanRepository.LoadClans: %w", err)
+	}
+
+	rows, err = r.db.Query(ctx, `
+		SELECT m.clan_name, m.character_id, c.name, m.rank
+		FROM clan_members m
+		JOIN characters c ON c.id = m.character_id
+		WHERE c.deleted_at IS NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("ClanRepository.LoadClans members: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			clanName string
+			m        clan.Member
+			rank     int16
+		)
+		if err := rows.Scan(&clanName, &m.CharacterID, &m.Name, &rank); err != nil {
+			return nil, fmt.Errorf("ClanRepository.LoadClans member scan: %w", err)
+		}
+		m.Rank = clan.Rank(rank)
+		if c, ok := byName[clanName]; ok {
+			c.Members[m.CharacterID] = m
+		}
+	}
+	return out, rows.Err()
+}
+
+// SaveClan upserts c and replaces its membership. The bank is written only
+// when the clan is first saved; later changes go through AdjustBank so a
+// stale snapshot never overwrites a newer balance. Members whose characters
+// no longer exist are skipped.
+//
+// Precondition: c must be non-nil with a non-empty Name.
+// Postcondition: The clans row and clan_members rows for c.Name match c,
+// apart from the bank of an existing clan and any purged members.
+func (r *ClanRepository) SaveClan(ctx context.Context, c *clan.Clan) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning clan transaction for %q: %w", c.Name, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO clans (name, tag, motd, bank)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (name) DO UPDATE SET tag = EXCLUDED.tag, motd = EXCLUDED.motd`,
+		c.Name, c.Tag, c.MOTD, c.Bank,
+	); err != nil {
+		return fmt.Errorf("saving clan %q: %w", c.Name, err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM clan_members WHERE clan_name = $1`, c.Name); err != nil {
+		return fmt.Errorf("clearing members of clan %q: %w", c.Name, err)
+	}
+	for _, m := range c.Members {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO clan_members (character_id, clan_name, rank)
+			SELECT $1, $2, $3 WHERE EXISTS (SELECT 1 FROM characters WHERE id = $1)
+			ON CONFLICT (character_id) DO UPDATE SET clan_name = EXCLUDED.clan_name, rank = EXCLUDED.rank`,
+			m.CharacterID, c.Name, int16(m.Rank),
+		); err != nil {
+			return fmt.Errorf("saving member %d of clan %q: %w", m.CharacterID, c.Name, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing clan %q: %w", c.Name, err)
+	}
+	return nil
+}
+
+// AdjustBank adds delta, which may be negative, to the bank of the clan
+// named name.
+//
+// Postcondition: Returns an error, leaving the bank unchanged, when the clan
+// does not exist or the change would take the bank below zero.
+func (r *ClanRepository) AdjustBank(ctx context.Context, name string, delta int) error {
+	tag, err := r.db.Exec(ctx, `UPDATE clans SET bank = bank + $2 WHERE name = $1 AND bank + $2 >= 0`, name, delta)
+	if err != nil {
+		return fmt.Errorf("ClanRepository.AdjustBank: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("ClanRepository.AdjustBank: clan %q is missing or its bank would go below zero", name)
+	}
+	return nil
+}
+
+// DeletedMembers returns those of ids whose characters have been
+// soft-deleted or purged.
+//
+// Postcondition: Each ID appears at most once.
+func (r *ClanRepository) DeletedMembers(ctx context.Context, ids []int64) ([]int64, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT DISTINCT m.id
+		FROM unnest($1::bigint[]) AS m(id)
+		WHERE NOT EXISTS (SELECT 1 FROM characters c WHERE c.id = m.id AND c.deleted_at IS NULL)`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("ClanRepository.DeletedMembers: %w", err)
+	}
+	defer rows.Close()
+	var out []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("ClanRepository.DeletedMembers scan: %w", err)
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// DeleteClan removes the clan named name and all of its memberships.
+//
+// Postcondition: No clans or clan_members rows exist for name.
+func (r *ClanRepository) DeleteClan(ctx context.Context, name string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM clans WHERE name = $1`, name); err != nil {
+		return fmt.Errorf("ClanRepository.DeleteClan: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/clan"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestClanRepository_RoundTrip(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewClanRepository(sharedPool)
+	ctx := context.Background()
+
+	leaderName := uniqueName("Boss")
+	leader, err := charRepo.Create(ctx, makeTestCharacter(accountID, leaderName))
+	require.NoError(t, err)
+	recruit, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Grunt")))
+	require.NoError(t, err)
+
+	name := uniqueName("C")
+	c := &clan.Clan{
+		Name: name, Tag: name[len(name)-4:], MOTD: "Hold the line.", Bank: 75,
+		Members: map[int64]clan.Member{
+			leader.ID:  {CharacterID: leader.ID, Name: leaderName, Rank: clan.RankLeader},
+			recruit.ID: {CharacterID: recruit.ID, Rank: clan.RankRecruit},
+		},
+	}
+	require.NoError(t, repo.SaveClan(ctx, c))
+	delete(c.Members, recruit.ID)
+	c.Bank = 0
+	require.NoError(t, repo.SaveClan(ctx, c), "a stale bank is not written back")
+	require.NoError(t, repo.AdjustBank(ctx, name, -35))
+	assert.Error(t, repo.AdjustBank(ctx, name, -41), "the bank never goes below zero")
+
+	find := func() *clan.Clan {
+		clans, err := repo.LoadClans(ctx)
+		require.NoError(t, err)
+		for _, got := range clans {
+			if got.Name == name {
+				return got
+			}
+		}
+		return nil
+	}
+	got := find()
+	require.NotNil(t, got)
+	assert.Equal(t, "Hold the line.", got.MOTD)
+	assert.Equal(t, 40, got.Bank)
+	require.Len(t, got.Members, 1)
+	assert.Equal(t, clan.Member{CharacterID: leader.ID, Name: leaderName, Rank: clan.RankLeader}, got.Members[leader.ID])
+
+	require.NoError(t, repo.DeleteClan(ctx, name))
+	assert.Nil(t, find())
+}
+
+func TestClanRepository_DeletedMembers(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewClanRepository(sharedPool)
+	ctx := context.Background()
+
+	live, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Live")))
+	require.NoError(t, err)
+	gone, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Gone")))
+	require.NoError(t, err)
+	_, err = sharedPool.Exec(ctx, `UPDATE characters SET deleted_at = now() WHERE id = $1`, gone.ID)
+	require.NoError(t, err)
+	const purged = int64(-1)
+
+	got, err := repo.DeletedMembers(ctx, []int64{live.ID, gone.ID, purged})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{gone.ID, purged}, got)
+
+	name := uniqueName("C")
+	require.NoError(t, repo.SaveClan(ctx, &clan.Clan{Name: name, Tag: name[len(name)-4:],
+		Members: map[int64]clan.Member{
+			live.ID: {CharacterID: live.ID, Rank: clan.RankLeader},
+			purged:  {CharacterID: purged, Rank: clan.RankRecruit},
+		}}), "purged members are skipped rather than failing the save")
+	require.NoError(t, repo.DeleteClan(ctx, name))
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/flags"
+)
+
+// FeatureFlagRepository persists feature flag overrides.
+type FeatureFlagRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewFeatureFlagRepository creates a FeatureFlagRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewFeatureFlagRepository(db *pgxpool.Pool) *FeatureFlagRepository {
+	return &FeatureFlagRepository{db: db}
+}
+
+// ListFlagOverrides returns every stored override, global ones first.
+//
+// Postcondition: Account overrides carry the account's username.
+func (r *FeatureFlagRepository) ListFlagOverrides(ctx context.Context) ([]flags.Override, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT flag, 0::bigint, '', enabled, set_by, set_at
+		FROM feature_flags
+		UNION ALL
+		SELECT f.flag, f.account_id, a.username, f.enabled, f.set_by, f.set_at
+		FROM account_feature_flags f
+		JOIN accounts a ON a.id = f.account_id
+		ORDER BY 2, 1`)
+	if err != nil {
+		return nil, fmt.Errorf("FeatureFlagRepository.ListFlagOverrides: %w", err)
+	}
+	defer rows.Close()
+	var out []flags.Override
+	for rows.Next() {
+		var o flags.Override
+		if err := rows.Scan(&o.Flag, &o.AccountID, &o.Username, &o.Enabled, &o.SetBy, &o.SetAt); err != nil {
+			return nil, fmt.Errorf("FeatureFlagRepository.ListFlagOverrides: scan: %w", err)
+		}
+		out = append(out, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("FeatureFlagRepository.ListFlagOverrides: %w", err)
+	}
+	return out, nil
+}
+
+// SaveFlagOverride inserts o, replacing any override of the same flag for
+// the same account. AccountID 0 saves a global override.
+//
+// Precondition: o.AccountID must be 0 or name an existing account.
+func (r *FeatureFlagRepository) SaveFlagOverride(ctx context.Context, o flags.Override) error {
+	var err error
+	if o.AccountID == 0 {
+		_, err = r.db.Exec(ctx, `
+			INSERT INTO feature_flags (flag, enabled, set_by, set_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (flag) DO UPDATE
+			SET enabled = EXCLUDED.enabled,
+			    set_by = EXCLUDED.set_by,
+			    set_at = EXCLUDED.set_at`,
+			o.Flag, o.Enabled, o.SetBy, o.SetAt,
+		)
+	} else {
+		_, err = r.db.Exec(ctx, `
+			INSERT INTO account_feature_flags (account_id, flag, enabled, set_by, set_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (account_id, flag) DO UPDATE
+			SET enabled = EXCLUDED.enabled,
+			    set_by = EXCLUDED.set_by,
+			    set_at = EXCLUDED.set_at`,
+			o.AccountID, o.Flag, o.Enabled, o.SetBy, o.SetAt,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("FeatureFlagRepository.SaveFlagOverride: %w", err)
+	}
+	return nil
+}
+
+// DeleteFlagOverride removes the override of flag for accountID, or the
+// global override when accountID is 0.
+//
+// Postcondition: Reports whether an override existed.
+func (r *FeatureFlagRepository) DeleteFlagOverride(ctx context.Context, flag string, accountID int64) (bool, error) {
+	var (
+		n   int64
+		err error
+	)
+	if accountID == 0 {
+		tag, execErr := r.db.Exec(ctx, `DELETE FROM feature_flags WHERE flag = $1`, flag)
+		n, err = tag.RowsAffected(), execErr
+	} else {
+		tag, execErr := r.db.Exec(ctx, `DELETE FROM account_feature_flags WHERE account_id = $1 AND flag = $2`, accountID, flag)
+		n, err = tag.RowsAffected(), execErr
+	}
+	if err != nil {
+		return false, fmt.Errorf("FeatureFlagRepository.DeleteFlagOverride: %w", err)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,56 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/flags"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestFeatureFlagRepository_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	acct, err := postgres.NewAccountRepository(sharedPool).Create(ctx, uniqueName("flag_user"), "password123")
+	require.NoError(t, err)
+	repo := postgres.NewFeatureFlagRepository(sharedPool)
+	t.Cleanup(func() {
+		_, _ = repo.DeleteFlagOverride(ctx, "repo_test_flag", 0)
+		_, _ = repo.DeleteFlagOverride(ctx, "repo_test_flag", acct.ID)
+	})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.SaveFlagOverride(ctx, flags.Override{Flag: "repo_test_flag", Enabled: false, SetBy: "admin", SetAt: now}))
+	require.NoError(t, repo.SaveFlagOverride(ctx, flags.Override{Flag: "repo_test_flag", Enabled: true, SetBy: "admin", SetAt: now}))
+	require.NoError(t, repo.SaveFlagOverride(ctx, flags.Override{Flag: "repo_test_flag", AccountID: acct.ID, Enabled: false, SetBy: "admin", SetAt: now}))
+
+	list, err := repo.ListFlagOverrides(ctx)
+	require.NoError(t, err)
+	var global, account *flags.Override
+	for i := range list {
+		if list[i].Flag != "repo_test_flag" {
+			continue
+		}
+		if list[i].AccountID == 0 {
+			global = &list[i]
+		} else {
+			account = &list[i]
+		}
+	}
+	require.NotNil(t, global)
+	assert.True(t, global.Enabled, "saving the same flag replaces the override")
+	assert.True(t, now.Equal(global.SetAt))
+	require.NotNil(t, account)
+	assert.False(t, account.Enabled)
+	assert.Equal(t, acct.Username, account.Username)
+
+	found, err := repo.DeleteFlagOverride(ctx, "repo_test_flag", acct.ID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = repo.DeleteFlagOverride(ctx, "repo_test_flag", acct.ID)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+// GameEventRepository is a telemetry.Sink that stores events in the
+// game_events table.
+type GameEventRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGameEventRepository creates a GameEventRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewGameEventRepository(db *pgxpool.Pool) *GameEventRepository {
+	return &GameEventRepository{db: db}
+}
+
+// gameEventColumns are the game_events columns Write fills, in row order.
+var gameEventColumns = []string{
+	"type", "occurred_at", "character_id", "character", "zone", "room",
+	"target", "counterparty", "quantity", "amount", "source",
+}
+
+// Write stores events with a single COPY.
+func (r *GameEventRepository) Write(ctx context.Context, events []telemetry.Event) error {
+	rows := make([][]any, len(events))
+	for i, ev := range events {
+		rows[i] = []any{
+			string(ev.Type), ev.Time, ev.CharacterID, ev.Character, ev.Zone, ev.Room,
+			ev.Target, ev.Counterparty, ev.Quantity, ev.Amount, ev.Source,
+		}
+	}
+	if _, err := r.db.CopyFrom(ctx, pgx.Identifier{"game_events"}, gameEventColumns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("GameEventRepository.Write: %w", err)
+	}
+	return nil
+}
+
+// Close is a no-op; the pool is owned by the caller.
+func (r *GameEventRepository) Close() error {
+	return nil
+}
@@ -0,0 +1,33 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+	"github.com/cory-johannsen/mud/internal/telemetry"
+)
+
+func TestGameEventRepository_Write(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewGameEventRepository(sharedPool)
+	character := uniqueName("telemetry")
+	at := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.Write(ctx, []telemetry.Event{
+		{Type: telemetry.TypeKill, Time: at, CharacterID: 7, Character: character, Zone: "downtown", Target: "ganger", Amount: 2},
+		{Type: telemetry.TypeCurrency, Time: at, CharacterID: 7, Character: character, Amount: -50, Source: telemetry.SourceMerchantBuy},
+	}))
+
+	var kills int
+	var net int64
+	require.NoError(t, sharedPool.QueryRow(ctx,
+		`SELECT count(*) FILTER (WHERE type = 'kill'), coalesce(sum(amount) FILTER (WHERE type = 'currency'), 0)
+		 FROM game_events WHERE character = $1`, character).Scan(&kills, &net))
+	assert.Equal(t, 1, kills)
+	assert.Equal(t, int64(-50), net)
+}
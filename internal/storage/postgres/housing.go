@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/housing"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+)
+
+// storedInstance is the JSONB form of one entry of housing.Lease.Instances.
+type storedInstance struct {
+	ItemDefID string                  `json:"item_def_id"`
+	Quantity  int                     `json:"quantity"`
+	Metadata  *inventory.ItemMetadata `json:"metadata,omitempty"`
+}
+
+// HousingRepository persists player room leases.
+type HousingRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewHousingRepository creates a HousingRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewHousingRepository(db *pgxpool.Pool) *HousingRepository {
+	return &HousingRepository{db: db}
+}
+
+// LoadLeases returns every lease held by a live character.
+//
+// Postcondition: OwnerName is the tenant's current character name.
+func (r *HousingRepository) LoadLeases(ctx context.Context) ([]*housing.Lease, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT h.room_id, h.character_id, c.name, h.description, h.storage, h.stored_instances, h.paid_until, h.past_due_since
+		FROM player_housing h
+		JOIN characters c ON c.id = h.character_id
+		WHERE c.deleted_at IS NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("HousingRepository.LoadLeases: %w", err)
+	}
+	defer rows.Close()
+	var out []*housing.Lease
+	for rows.Next() {
+		var (
+			l            housing.Lease
+			storageRaw   []byte
+			instancesRaw []byte
+			pastDue      *time.Time
+		)
+		if err := rows.Scan(&l.RoomID, &l.CharacterID, &l.OwnerName, &l.Description, &storageRaw, &instancesRaw, &l.PaidUntil, &pastDue); err != nil {
+			return nil, fmt.Errorf("HousingRepository.LoadLeases scan: %w", err)
+		}
+		l.Storage = make(map[string]int)
+		if err := json.Unmarshal(storageRaw, &l.Storage); err != nil {
+			return nil, fmt.Errorf("HousingRepository.LoadLeases decoding storage for %s: %w", l.RoomID, err)
+		}
+		var instances []storedInstance
+		if err := json.Unmarshal(instancesRaw, &instances); err != nil {
+			return nil, fmt.Errorf("HousingRepository.LoadLeases decoding stored instances for %s: %w", l.RoomID, err)
+		}
+		for _, inst := range instances {
+			l.Instances = append(l.Instances, inventory.InventoryItem{ItemDefID: inst.ItemDefID, Quantity: inst.Quantity, Metadata: inst.Metadata})
+		}
+		if pastDue != nil {
+			l.PastDueSince = *pastDue
+		}
+		out = append(out, &l)
+	}
+	return out, rows.Err()
+}
+
+// SaveLease upserts a lease.
+//
+// Precondition: lease must be non-nil with a non-empty RoomID and CharacterID > 0.
+// Postcondition: The player_housing row for lease.RoomID matches lease.
+func (r *HousingRepository) SaveLease(ctx context.Context, lease *housing.Lease) error {
+	storage := lease.Storage
+	if storage == nil {
+		storage = map[string]int{}
+	}
+	storageRaw, err := json.Marshal(storage)
+	if err != nil {
+		return fmt.Errorf("HousingRepository.SaveLease encoding storage: %w", err)
+	}
+	instances := make([]storedInstance, 0, len(lease.Instances))
+	for _, it := range lease.Instances {
+		instances = append(instances, storedInstance{ItemDefID: it.ItemDefID, Quantity: it.Quantity, Metadata: it.Metadata})
+	}
+	instancesRaw, err := json.Marshal(instances)
+	if err != nil {
+		return fmt.Errorf("HousingRepository.SaveLease encoding stored instances: %w", err)
+	}
+	var pastDue *time.Time
+	if !lease.PastDueSince.IsZero() {
+		pastDue = &lease.PastDueSince
+	}
+	_, err = r.db.Exec(ctx, `
+		INSERT INTO player_housing (room_id, character_id, description, storage, stored_instances, paid_until, past_due_since)
+		VALUES ($1, $2, $3, $4::jsonb, $5::jsonb, $6, $7)
+		ON CONFLICT (room_id)
+			DO UPDATE SET character_id = EXCLUDED.character_id, description = EXCLUDED.description,
+				storage = EXCLUDED.storage, stored_instances = EXCLUDED.stored_instances,
+				paid_until = EXCLUDED.paid_until, past_due_since = EXCLUDED.past_due_since`,
+		lease.RoomID, lease.CharacterID, lease.Description, string(storageRaw), string(instancesRaw), lease.PaidUntil, pastDue,
+	)
+	if err != nil {
+		return fmt.Errorf("HousingRepository.SaveLease: %w", err)
+	}
+	return nil
+}
+
+// DeletedTenants returns the IDs of characters that still hold a lease but
+// have been soft-deleted.
+//
+// Postcondition: Each ID appears at most once.
+func (r *HousingRepository) DeletedTenants(ctx context.Context) ([]int64, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT DISTINCT h.character_id
+		FROM player_housing h
+		JOIN characters c ON c.id = h.character_id
+		WHERE c.deleted_at IS NOT NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("HousingRepository.DeletedTenants: %w", err)
+	}
+	defer rows.Close()
+	var out []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("HousingRepository.DeletedTenants scan: %w", err)
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// DeleteLease removes the lease on roomID.
+//
+// Postcondition: No player_housing row exists for roomID.
+func (r *HousingRepository) DeleteLease(ctx context.Context, roomID string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM player_housing WHERE room_id = $1`, roomID); err != nil {
+		return fmt.Errorf("HousingRepository.DeleteLease: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/housing"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestHousingRepository_RoundTrip(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewHousingRepository(sharedPool)
+	ctx := context.Background()
+
+	name := uniqueName("Tenant")
+	created, err := charRepo.Create(ctx, makeTestCharacter(accountID, name))
+	require.NoError(t, err)
+	room := uniqueName("flat")
+	paid := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	require.NoError(t, repo.SaveLease(ctx, &housing.Lease{
+		RoomID: room, CharacterID: created.ID, PaidUntil: paid,
+	}))
+	pastDue := paid.Add(-time.Hour)
+	require.NoError(t, repo.SaveLease(ctx, &housing.Lease{
+		RoomID: room, CharacterID: created.ID, Description: "Cozy.",
+		Storage: map[string]int{"stim_pack": 2}, PaidUntil: paid, PastDueSince: pastDue,
+		Instances: []inventory.InventoryItem{{
+			ItemDefID: "rifle", Quantity: 1,
+			Metadata: &inventory.ItemMetadata{CustomName: "Old Faithful", Affixes: []string{"keen"}},
+		}},
+	}))
+
+	leases, err := repo.LoadLeases(ctx)
+	require.NoError(t, err)
+	var got *housing.Lease
+	for _, l := range leases {
+		if l.RoomID == room {
+			got = l
+		}
+	}
+	require.NotNil(t, got)
+	assert.Equal(t, name, got.OwnerName)
+	assert.Equal(t, "Cozy.", got.Description)
+	assert.Equal(t, map[string]int{"stim_pack": 2}, got.Storage)
+	require.Len(t, got.Instances, 1)
+	assert.Equal(t, "rifle", got.Instances[0].ItemDefID)
+	require.NotNil(t, got.Instances[0].Metadata)
+	assert.Equal(t, "Old Faithful", got.Instances[0].Metadata.CustomName)
+	assert.Equal(t, []string{"keen"}, got.Instances[0].Metadata.Affixes)
+	assert.True(t, paid.Equal(got.PaidUntil))
+	assert.True(t, pastDue.Equal(got.PastDueSince))
+
+	require.NoError(t, repo.DeleteLease(ctx, room))
+	leases, err = repo.LoadLeases(ctx)
+	require.NoError(t, err)
+	for _, l := range leases {
+		assert.NotEqual(t, room, l.RoomID)
+	}
+}
+
+func TestHousingRepository_DeletedTenants(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewHousingRepository(sharedPool)
+	ctx := context.Background()
+
+	name := uniqueName("Gone")
+	created, err := charRepo.Create(ctx, makeTestCharacter(accountID, name))
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveLease(ctx, &housing.Lease{
+		RoomID: uniqueName("flat"), CharacterID: created.ID, PaidUntil: time.Now().Add(time.Hour),
+	}))
+
+	ids, err := repo.DeletedTenants(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, created.ID)
+
+	require.NoError(t, charRepo.SoftDeleteByAccountAndName(ctx, accountID, name))
+	ids, err = repo.DeletedTenants(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, ids, created.ID)
+}
+
+func TestCharacterRepository_StashBalance(t *testing.T) {
+	repo, accountID := setupCharRepos(t)
+	ctx := context.Background()
+	created, err := repo.Create(ctx, makeTestCharacter(accountID, uniqueName("Saver")))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SaveStashBalance(ctx, created.ID, 100))
+	ok, err := repo.ChargeStash(ctx, created.ID, 60)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = repo.ChargeStash(ctx, created.ID, 60)
+	require.NoError(t, err)
+	assert.False(t, ok, "charges larger than the balance are refused")
+
+	balance, err := repo.LoadStashBalance(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 40, balance)
+}
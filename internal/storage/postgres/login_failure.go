@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
+)
+
+// LoginFailureRepository persists failed-login records for login throttling.
+type LoginFailureRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewLoginFailureRepository creates a LoginFailureRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewLoginFailureRepository(db *pgxpool.Pool) *LoginFailureRepository {
+	return &LoginFailureRepository{db: db}
+}
+
+// Load returns the record for key.
+//
+// Postcondition: Returns a zero Record carrying key when none is stored.
+func (r *LoginFailureRepository) Load(ctx context.Context, key string) (loginthrottle.Record, error) {
+	rec := loginthrottle.Record{Key: key}
+	var lockedUntil *time.Time
+	err := r.db.QueryRow(ctx, `
+		SELECT failures, locked_until, last_failure_at
+		FROM login_failures
+		WHERE key = $1`,
+		key,
+	).Scan(&rec.Failures, &lockedUntil, &rec.LastFailureAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return rec, nil
+	}
+	if err != nil {
+		return loginthrottle.Record{}, fmt.Errorf("LoginFailureRepository.Load: %w", err)
+	}
+	if lockedUntil != nil {
+		rec.LockedUntil = *lockedUntil
+	}
+	return rec, nil
+}
+
+// Save inserts or replaces rec.
+//
+// Precondition: rec.Key must be non-empty.
+// Postcondition: A later Load of rec.Key returns rec.
+func (r *LoginFailureRepository) Save(ctx context.Context, rec loginthrottle.Record) error {
+	var lockedUntil *time.Time
+	if !rec.LockedUntil.IsZero() {
+		lockedUntil = &rec.LockedUntil
+	}
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO login_failures (key, failures, locked_until, last_failure_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = EXCLUDED.failures,
+		    locked_until = EXCLUDED.locked_until,
+		    last_failure_at = EXCLUDED.last_failure_at`,
+		rec.Key, rec.Failures, lockedUntil, rec.LastFailureAt,
+	)
+	if err != nil {
+		return fmt.Errorf("LoginFailureRepository.Save: %w", err)
+	}
+	return nil
+}
+
+// Clear deletes the record for key.
+//
+// Postcondition: A later Load of key returns a zero Record.
+func (r *LoginFailureRepository) Clear(ctx context.Context, key string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM login_failures WHERE key = $1`, key); err != nil {
+		return fmt.Errorf("LoginFailureRepository.Clear: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/loginthrottle"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestLoginFailureRepository_RoundTrip(t *testing.T) {
+	repo := postgres.NewLoginFailureRepository(sharedPool)
+	ctx := context.Background()
+	key := loginthrottle.AccountKey(uniqueName("Locked"))
+
+	rec, err := repo.Load(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, loginthrottle.Record{Key: key}, rec)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.Save(ctx, loginthrottle.Record{Key: key, Failures: 2, LastFailureAt: now}))
+	rec, err = repo.Load(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rec.Failures)
+	assert.True(t, rec.LockedUntil.IsZero())
+	assert.True(t, now.Equal(rec.LastFailureAt))
+
+	require.NoError(t, repo.Save(ctx, loginthrottle.Record{Key: key, Failures: 4, LockedUntil: now.Add(time.Minute), LastFailureAt: now}))
+	rec, err = repo.Load(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, 4, rec.Failures)
+	assert.True(t, now.Add(time.Minute).Equal(rec.LockedUntil))
+
+	require.NoError(t, repo.Clear(ctx, key))
+	rec, err = repo.Load(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, rec.Failures)
+}
@@ -160,10 +160,11 @@ func applyAllMigrations(pool *pgxpool.Pool) error {
 		);
 
 		CREATE TABLE IF NOT EXISTS character_inventory (
+			id           BIGSERIAL PRIMARY KEY,
 			character_id BIGINT NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
 			item_def_id  TEXT   NOT NULL,
 			quantity     INT    NOT NULL DEFAULT 1,
-			PRIMARY KEY (character_id, item_def_id)
+			metadata     JSONB  NOT NULL DEFAULT '{}'
 		);
 
 		CREATE TABLE IF NOT EXISTS character_map_rooms (
@@ -418,6 +419,277 @@ func applyAllMigrations(pool *pgxpool.Pool) error {
 			PRIMARY KEY (character_id, char_level, tech_level, tradition, usage_type)
 		);
 
+		-- Migration 066
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS combat_verbosity TEXT NOT NULL DEFAULT 'normal';
+
+		-- Migration 067
+		ALTER TABLE accounts ADD COLUMN IF NOT EXISTS locale TEXT NOT NULL DEFAULT 'en';
+
+		-- Migration 068
+		CREATE TABLE IF NOT EXISTS admin_audit (
+			id               BIGSERIAL   PRIMARY KEY,
+			actor_account_id BIGINT      NOT NULL DEFAULT 0,
+			actor            TEXT        NOT NULL,
+			action           TEXT        NOT NULL,
+			target           TEXT        NOT NULL DEFAULT '',
+			args             JSONB       NOT NULL DEFAULT '{}',
+			outcome          TEXT        NOT NULL DEFAULT '',
+			created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);
+
+		-- Migration 069
+		CREATE TABLE IF NOT EXISTS account_settings (
+			account_id BIGINT      PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+			settings   JSONB       NOT NULL DEFAULT '{}',
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);
+
+		-- Migration 070
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+		-- Migration 071
+		ALTER TABLE accounts ADD COLUMN IF NOT EXISTS character_slots INT NOT NULL DEFAULT 0;
+
+		-- Migration 072
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS heritage TEXT NOT NULL DEFAULT '';
+
+		-- Migration 073
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS background_answers JSONB NOT NULL DEFAULT '{}';
+
+		-- Migration 074
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS tutorial_complete BOOLEAN NOT NULL DEFAULT FALSE;
+
+		-- Migration 075
+		CREATE TABLE IF NOT EXISTS npc_merchant_state (
+			npc_template_id   VARCHAR(64)  NOT NULL,
+			room_id           VARCHAR(128) NOT NULL,
+			stock             JSONB        NOT NULL DEFAULT '{}',
+			budget            INTEGER      NOT NULL DEFAULT 0,
+			next_replenish_at TIMESTAMPTZ  NOT NULL,
+			updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (npc_template_id, room_id)
+		);
+
+		-- Migration 076
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS stash_balance INTEGER NOT NULL DEFAULT 0;
+		CREATE TABLE IF NOT EXISTS player_housing (
+			room_id        VARCHAR(128) PRIMARY KEY,
+			character_id   BIGINT       NOT NULL UNIQUE REFERENCES characters(id) ON DELETE CASCADE,
+			description    TEXT         NOT NULL DEFAULT '',
+			storage        JSONB        NOT NULL DEFAULT '{}',
+			paid_until     TIMESTAMPTZ  NOT NULL,
+			past_due_since TIMESTAMPTZ
+		);
+
+		-- Migration 077
+		CREATE TABLE IF NOT EXISTS clans (
+			name       VARCHAR(24) PRIMARY KEY,
+			tag        VARCHAR(4)  NOT NULL,
+			motd       TEXT        NOT NULL DEFAULT '',
+			bank       INTEGER     NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		);
+		CREATE UNIQUE INDEX IF NOT EXISTS clans_lower_name_idx ON clans (lower(name));
+		CREATE UNIQUE INDEX IF NOT EXISTS clans_tag_idx ON clans (tag);
+		CREATE TABLE IF NOT EXISTS clan_members (
+			character_id BIGINT      PRIMARY KEY REFERENCES characters(id) ON DELETE CASCADE,
+			clan_name    VARCHAR(24) NOT NULL REFERENCES clans(name) ON DELETE CASCADE,
+			rank         SMALLINT    NOT NULL DEFAULT 0
+		);
+		CREATE INDEX IF NOT EXISTS clan_members_clan_idx ON clan_members (clan_name);
+
+		-- Migration 078
+		ALTER TABLE characters ADD COLUMN IF NOT EXISTS title TEXT NOT NULL DEFAULT '';
+		CREATE TABLE IF NOT EXISTS character_achievements (
+			character_id   BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			achievement_id VARCHAR(64) NOT NULL,
+			progress       INTEGER     NOT NULL DEFAULT 0,
+			unlocked_at    TIMESTAMPTZ,
+			PRIMARY KEY (character_id, achievement_id)
+		);
+
+		-- Migration 079
+		CREATE TABLE IF NOT EXISTS character_stats (
+			character_id     BIGINT      PRIMARY KEY REFERENCES characters(id) ON DELETE CASCADE,
+			kills            BIGINT      NOT NULL DEFAULT 0,
+			deaths           BIGINT      NOT NULL DEFAULT 0,
+			damage_dealt     BIGINT      NOT NULL DEFAULT 0,
+			rooms_explored   BIGINT      NOT NULL DEFAULT 0,
+			playtime_seconds BIGINT      NOT NULL DEFAULT 0,
+			updated_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+		);
+
+		-- Migration 080
+		CREATE TABLE IF NOT EXISTS character_sessions (
+			id           BIGSERIAL   PRIMARY KEY,
+			character_id BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			started_at   TIMESTAMPTZ NOT NULL,
+			ended_at     TIMESTAMPTZ NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS character_sessions_character_id_idx ON character_sessions (character_id);
+
+		-- Migration 081
+		CREATE TABLE IF NOT EXISTS login_failures (
+			key             TEXT        PRIMARY KEY,
+			failures        INTEGER     NOT NULL DEFAULT 0,
+			locked_until    TIMESTAMPTZ,
+			last_failure_at TIMESTAMPTZ NOT NULL
+		);
+
+		-- Migration 082
+		CREATE TABLE IF NOT EXISTS site_bans (
+			cidr       CIDR        PRIMARY KEY,
+			allow      BOOLEAN     NOT NULL DEFAULT FALSE,
+			reason     TEXT        NOT NULL DEFAULT '',
+			created_by TEXT        NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		);
+
+		-- Migration 083
+		CREATE TABLE IF NOT EXISTS auction_listings (
+			id           BIGINT      PRIMARY KEY,
+			seller_id    BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			seller_name  TEXT        NOT NULL,
+			item_id      TEXT        NOT NULL,
+			quantity     INTEGER     NOT NULL CHECK (quantity > 0),
+			price        INTEGER     NOT NULL CHECK (price > 0),
+			listed_at    TIMESTAMPTZ NOT NULL,
+			expires_at   TIMESTAMPTZ NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS auction_listings_seller_idx ON auction_listings (seller_id);
+		CREATE TABLE IF NOT EXISTS auction_claims (
+			id           BIGINT  PRIMARY KEY,
+			character_id BIGINT  NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			credits      INTEGER NOT NULL DEFAULT 0,
+			item_id      TEXT    NOT NULL DEFAULT '',
+			quantity     INTEGER NOT NULL DEFAULT 0,
+			note         TEXT    NOT NULL DEFAULT ''
+		);
+		CREATE INDEX IF NOT EXISTS auction_claims_character_idx ON auction_claims (character_id);
+
+		-- Migration 084
+		CREATE TABLE IF NOT EXISTS character_transcripts (
+			id           BIGSERIAL   PRIMARY KEY,
+			character_id BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			char_name    TEXT        NOT NULL,
+			started_at   TIMESTAMPTZ NOT NULL,
+			ended_at     TIMESTAMPTZ NOT NULL,
+			body         TEXT        NOT NULL DEFAULT ''
+		);
+		CREATE INDEX IF NOT EXISTS character_transcripts_character_id_idx ON character_transcripts (character_id);
+
+		-- Migration 085
+		CREATE TABLE IF NOT EXISTS reports (
+			id           BIGSERIAL   PRIMARY KEY,
+			kind         TEXT        NOT NULL CHECK (kind IN ('bug', 'typo', 'idea')),
+			character_id BIGINT      REFERENCES characters(id) ON DELETE SET NULL,
+			char_name    TEXT        NOT NULL,
+			room_id      TEXT        NOT NULL DEFAULT '',
+			body         TEXT        NOT NULL,
+			context      TEXT        NOT NULL DEFAULT '',
+			closed_by    TEXT        NOT NULL DEFAULT '',
+			closed_at    TIMESTAMPTZ,
+			created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);
+
+		-- Migration 086
+		ALTER TABLE character_equipment ADD COLUMN IF NOT EXISTS affixes text[] NOT NULL DEFAULT '{}';
+		ALTER TABLE character_weapon_presets ADD COLUMN IF NOT EXISTS affixes text[] NOT NULL DEFAULT '{}';
+
+		-- Migration 088
+		ALTER TABLE character_equipment ADD COLUMN IF NOT EXISTS custom_name text NOT NULL DEFAULT '';
+		ALTER TABLE character_weapon_presets ADD COLUMN IF NOT EXISTS custom_name text NOT NULL DEFAULT '';
+
+		-- Migration 089
+		CREATE TABLE IF NOT EXISTS feature_flags (
+			flag    TEXT        PRIMARY KEY,
+			enabled BOOLEAN     NOT NULL,
+			set_by  TEXT        NOT NULL DEFAULT '',
+			set_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+		);
+		CREATE TABLE IF NOT EXISTS account_feature_flags (
+			account_id BIGINT      NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+			flag       TEXT        NOT NULL,
+			enabled    BOOLEAN     NOT NULL,
+			set_by     TEXT        NOT NULL DEFAULT '',
+			set_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+			PRIMARY KEY (account_id, flag)
+		);
+
+		-- Migration 090
+		CREATE TABLE IF NOT EXISTS game_events (
+			id           BIGSERIAL   PRIMARY KEY,
+			type         TEXT        NOT NULL,
+			occurred_at  TIMESTAMPTZ NOT NULL,
+			character_id BIGINT      NOT NULL DEFAULT 0,
+			character    TEXT        NOT NULL DEFAULT '',
+			zone         TEXT        NOT NULL DEFAULT '',
+			room         TEXT        NOT NULL DEFAULT '',
+			target       TEXT        NOT NULL DEFAULT '',
+			counterparty TEXT        NOT NULL DEFAULT '',
+			quantity     INT         NOT NULL DEFAULT 0,
+			amount       BIGINT      NOT NULL DEFAULT 0,
+			source       TEXT        NOT NULL DEFAULT ''
+		);
+		CREATE INDEX IF NOT EXISTS game_events_type_time_idx ON game_events (type, occurred_at);
+		CREATE INDEX IF NOT EXISTS game_events_character_idx ON game_events (character_id, occurred_at);
+
+		-- Migration 091
+		ALTER TABLE accounts ADD COLUMN IF NOT EXISTS deletion_requested_at TIMESTAMPTZ;
+		ALTER TABLE accounts ADD COLUMN IF NOT EXISTS purged_at TIMESTAMPTZ;
+		CREATE INDEX IF NOT EXISTS accounts_deletion_requested_idx ON accounts (deletion_requested_at)
+			WHERE deletion_requested_at IS NOT NULL;
+		ALTER TABLE character_inventory_instances
+			DROP CONSTRAINT IF EXISTS character_inventory_instances_character_id_fkey,
+			ADD CONSTRAINT character_inventory_instances_character_id_fkey
+				FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+		ALTER TABLE character_faction_rep
+			DROP CONSTRAINT IF EXISTS character_faction_rep_character_id_fkey,
+			ADD CONSTRAINT character_faction_rep_character_id_fkey
+				FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+		ALTER TABLE character_quests
+			DROP CONSTRAINT IF EXISTS character_quests_character_id_fkey,
+			ADD CONSTRAINT character_quests_character_id_fkey
+				FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+		ALTER TABLE character_quest_progress
+			DROP CONSTRAINT IF EXISTS character_quest_progress_character_id_fkey,
+			ADD CONSTRAINT character_quest_progress_character_id_fkey
+				FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+
+		-- Migration 092
+		CREATE TABLE IF NOT EXISTS account_stash (
+			account_id BIGINT  PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+			balance    INTEGER NOT NULL DEFAULT 0 CHECK (balance >= 0)
+		);
+		CREATE TABLE IF NOT EXISTS account_achievements (
+			account_id     BIGINT      NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+			achievement_id VARCHAR(64) NOT NULL,
+			progress       INTEGER     NOT NULL DEFAULT 0,
+			unlocked_at    TIMESTAMPTZ,
+			PRIMARY KEY (account_id, achievement_id)
+		);
+
+		-- Migration 093
+		CREATE TABLE IF NOT EXISTS character_weapon_practice (
+			character_id BIGINT  NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			category     TEXT    NOT NULL,
+			points       INTEGER NOT NULL DEFAULT 0 CHECK (points >= 0),
+			PRIMARY KEY (character_id, category)
+		);
+
+		-- Migration 094
+		CREATE TABLE IF NOT EXISTS character_point_buy (
+			character_id BIGINT  NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+			ability      TEXT    NOT NULL,
+			delta        INTEGER NOT NULL CHECK (delta <> 0),
+			PRIMARY KEY (character_id, ability)
+		);
+
+		-- Migration 095
+		ALTER TABLE auction_listings ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+		ALTER TABLE auction_claims ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+		ALTER TABLE player_housing ADD COLUMN IF NOT EXISTS stored_instances JSONB NOT NULL DEFAULT '[]';
+
 		-- Migration 002: zones and rooms schema (matches 002_zones_rooms.up.sql)
 		CREATE TABLE IF NOT EXISTS zones (
 			id          TEXT PRIMARY KEY,
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+)
+
+// MerchantStateRepository persists merchant runtime state keyed by NPC template and spawn room.
+type MerchantStateRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewMerchantStateRepository creates a MerchantStateRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewMerchantStateRepository(db *pgxpool.Pool) *MerchantStateRepository {
+	return &MerchantStateRepository{db: db}
+}
+
+// Load returns the stored state for the merchant, or nil when none has been saved.
+//
+// Precondition: templateID and roomID must be non-empty.
+// Postcondition: Returns (state, nil) when found; (nil, nil) when absent.
+func (r *MerchantStateRepository) Load(ctx context.Context, templateID, roomID string) (*npc.MerchantRuntimeState, error) {
+	var (
+		stockRaw []byte
+		state    npc.MerchantRuntimeState
+	)
+	err := r.db.QueryRow(ctx, `
+		SELECT stock, budget, next_replenish_at
+		FROM npc_merchant_state
+		WHERE npc_template_id = $1 AND room_id = $2`,
+		templateID, roomID,
+	).Scan(&stockRaw, &state.CurrentBudget, &state.NextReplenishAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("MerchantStateRepository.Load: %w", err)
+	}
+	state.Stock = make(map[string]int)
+	if err := json.Unmarshal(stockRaw, &state.Stock); err != nil {
+		return nil, fmt.Errorf("MerchantStateRepository.Load decoding stock: %w", err)
+	}
+	return &state, nil
+}
+
+// Save upserts the merchant's state.
+//
+// Precondition: templateID and roomID must be non-empty; state must be non-nil.
+// Postcondition: npc_merchant_state row is inserted or updated.
+func (r *MerchantStateRepository) Save(ctx context.Context, templateID, roomID string, state *npc.MerchantRuntimeState) error {
+	if templateID == "" || roomID == "" {
+		return fmt.Errorf("MerchantStateRepository.Save: templateID and roomID must be non-empty")
+	}
+	stock := state.Stock
+	if stock == nil {
+		stock = map[string]int{}
+	}
+	stockRaw, err := json.Marshal(stock)
+	if err != nil {
+		return fmt.Errorf("MerchantStateRepository.Save encoding stock: %w", err)
+	}
+	_, err = r.db.Exec(ctx, `
+		INSERT INTO npc_merchant_state (npc_template_id, room_id, stock, budget, next_replenish_at, updated_at)
+		VALUES ($1, $2, $3::jsonb, $4, $5, NOW())
+		ON CONFLICT (npc_template_id, room_id)
+			DO UPDATE SET stock = EXCLUDED.stock, budget = EXCLUDED.budget,
+				next_replenish_at = EXCLUDED.next_replenish_at, updated_at = NOW()`,
+		templateID, roomID, string(stockRaw), state.CurrentBudget, state.NextReplenishAt,
+	)
+	if err != nil {
+		return fmt.Errorf("MerchantStateRepository.Save: %w", err)
+	}
+	return nil
+}
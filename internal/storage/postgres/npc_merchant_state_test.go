@@ -0,0 +1,38 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestMerchantStateRepository_RoundTrip(t *testing.T) {
+	repo := postgres.NewMerchantStateRepository(testDB(t))
+	ctx := context.Background()
+	room := uniqueName("market")
+
+	got, err := repo.Load(ctx, "test_merchant", room)
+	require.NoError(t, err)
+	assert.Nil(t, got, "unsaved merchants load as nil")
+
+	next := time.Now().Add(3 * time.Hour).UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.Save(ctx, "test_merchant", room, &npc.MerchantRuntimeState{
+		Stock: map[string]int{"sword": 2, "stim": 9}, CurrentBudget: 450, NextReplenishAt: next,
+	}))
+	require.NoError(t, repo.Save(ctx, "test_merchant", room, &npc.MerchantRuntimeState{
+		Stock: map[string]int{"sword": 1}, CurrentBudget: 300, NextReplenishAt: next,
+	}))
+
+	got, err = repo.Load(ctx, "test_merchant", room)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, map[string]int{"sword": 1}, got.Stock, "save overwrites the previous state")
+	assert.Equal(t, 300, got.CurrentBudget)
+	assert.True(t, next.Equal(got.NextReplenishAt))
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PlaySessionRepository records character login sessions.
+type PlaySessionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPlaySessionRepository creates a PlaySessionRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewPlaySessionRepository(db *pgxpool.Pool) *PlaySessionRepository {
+	return &PlaySessionRepository{db: db}
+}
+
+// StartPlaySession records a session that began at startedAt.
+//
+// Precondition: characterID must be > 0.
+// Postcondition: Returns the new session's ID; the session ends at startedAt until touched.
+func (r *PlaySessionRepository) StartPlaySession(ctx context.Context, characterID int64, startedAt time.Time) (int64, error) {
+	var id int64
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO character_sessions (character_id, started_at, ended_at)
+		VALUES ($1, $2, $2)
+		RETURNING id`,
+		characterID, startedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("PlaySessionRepository.StartPlaySession: %w", err)
+	}
+	return id, nil
+}
+
+// TouchPlaySession extends session id to end at endedAt and credits the added
+// time to the character's playtime_seconds stat, so the playtime leaderboard is
+// derived from session records rather than counted separately.
+//
+// Precondition: id must come from StartPlaySession.
+// Postcondition: The session's ended_at is endedAt unless it was already later;
+// playtime_seconds grows by the whole seconds the session gained.
+func (r *PlaySessionRepository) TouchPlaySession(ctx context.Context, id int64, endedAt time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("PlaySessionRepository.TouchPlaySession begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+	var (
+		characterID     int64
+		started, before time.Time
+	)
+	err = tx.QueryRow(ctx,
+		`SELECT character_id, started_at, ended_at FROM character_sessions WHERE id = $1 FOR UPDATE`, id,
+	).Scan(&characterID, &started, &before)
+	if err != nil {
+		return fmt.Errorf("PlaySessionRepository.TouchPlaySession: %w", err)
+	}
+	if !endedAt.After(before) {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `UPDATE character_sessions SET ended_at = $2 WHERE id = $1`, id, endedAt); err != nil {
+		return fmt.Errorf("PlaySessionRepository.TouchPlaySession: %w", err)
+	}
+	// Whole seconds since the session started, less those already credited,
+	// so repeated touches never drift from the session's length.
+	secs := int64(endedAt.Sub(started)/time.Second) - int64(before.Sub(started)/time.Second)
+	if secs > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO character_stats (character_id, playtime_seconds)
+			VALUES ($1, $2)
+			ON CONFLICT (character_id) DO UPDATE SET
+				playtime_seconds = character_stats.playtime_seconds + EXCLUDED.playtime_seconds,
+				updated_at       = now()`,
+			characterID, secs,
+		)
+		if err != nil {
+			return fmt.Errorf("PlaySessionRepository.TouchPlaySession crediting playtime: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("PlaySessionRepository.TouchPlaySession commit: %w", err)
+	}
+	return nil
+}
+
+// TotalPlaytime returns the summed duration of the character's sessions other than excludeID.
+//
+// Precondition: characterID must be > 0.
+// Postcondition: Returns 0 for a character with no other sessions.
+func (r *PlaySessionRepository) TotalPlaytime(ctx context.Context, characterID, excludeID int64) (time.Duration, error) {
+	var secs float64
+	err := r.db.QueryRow(ctx, `
+		SELECT COALESCE(SUM(EXTRACT(EPOCH FROM ended_at - started_at)), 0)::float8
+		FROM character_sessions
+		WHERE character_id = $1 AND id <> $2`,
+		characterID, excludeID,
+	).Scan(&secs)
+	if err != nil {
+		return 0, fmt.Errorf("PlaySessionRepository.TotalPlaytime: %w", err)
+	}
+	return time.Duration(secs * float64(time.Second)), nil
+}
@@ -0,0 +1,58 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gstats "github.com/cory-johannsen/mud/internal/game/stats"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestPlaySessionRepository_TotalPlaytime(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewPlaySessionRepository(sharedPool)
+	ctx := context.Background()
+
+	created, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Hero")))
+	require.NoError(t, err)
+
+	total, err := repo.TotalPlaytime(ctx, created.ID, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	start := time.Now().UTC().Add(-3 * time.Hour).Truncate(time.Second)
+	first, err := repo.StartPlaySession(ctx, created.ID, start)
+	require.NoError(t, err)
+	require.NoError(t, repo.TouchPlaySession(ctx, first, start.Add(time.Hour)))
+	second, err := repo.StartPlaySession(ctx, created.ID, start.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repo.TouchPlaySession(ctx, second, start.Add(2*time.Hour+30*time.Minute)))
+
+	total, err = repo.TotalPlaytime(ctx, created.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, total)
+
+	total, err = repo.TotalPlaytime(ctx, created.ID, second)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, total, "the excluded session is not counted")
+
+	top, err := postgres.NewStatsRepository(sharedPool).TopStats(ctx, gstats.Playtime, 1000)
+	require.NoError(t, err)
+	var credited int64
+	for _, e := range top {
+		if e.Name == created.Name {
+			credited = e.Value
+		}
+	}
+	assert.Equal(t, int64(90*60), credited, "playtime_seconds is derived from the sessions")
+
+	// Touching with an earlier time neither shortens the session nor credits time.
+	require.NoError(t, repo.TouchPlaySession(ctx, first, start))
+	total, err = repo.TotalPlaytime(ctx, created.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, total)
+}
@@ -18,6 +18,8 @@ var StorageProviders = wire.NewSet(
 	NewAccountRepository,
 	NewCharacterSkillsRepository,
 	NewCharacterProficienciesRepository,
+	NewCharacterWeaponPracticeRepository,
+	NewCharacterPointBuyRepository,
 	NewCharacterFeatsRepository,
 	NewCharacterClassFeaturesRepository,
 	NewCharacterFeatureChoicesRepo,
@@ -34,5 +36,16 @@ var StorageProviders = wire.NewSet(
 	NewQuestRepository,
 	NewCharacterDowntimeRepository,
 	NewWeatherRepo,
+	NewAdminAuditRepository,
+	NewAccountSettingsRepository,
+	NewMerchantStateRepository,
+	NewHousingRepository,
+	NewClanRepository,
+	NewAuctionRepository,
+	NewAchievementRepository,
+	NewStatsRepository,
+	NewPlaySessionRepository,
+	NewTranscriptRepository,
+	NewReportRepository,
 	wire.Bind(new(CharacterAbilityBoostsRepository), new(*PostgresCharacterAbilityBoostsRepository)),
 )
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultReportLimit is the number of reports returned when a query sets no limit.
+const DefaultReportLimit = 20
+
+// MaxReportLimit caps the number of reports returned by a single query.
+const MaxReportLimit = 200
+
+// ErrReportNotFound is returned when a report ID matches no row.
+var ErrReportNotFound = errors.New("report not found")
+
+// Report is one player bug, typo, or idea report.
+type Report struct {
+	ID int64
+	// Kind is "bug", "typo", or "idea".
+	Kind string
+	// CharacterID is the reporting character; 0 once the character is deleted.
+	CharacterID int64
+	CharName    string
+	// RoomID is the room the reporter stood in.
+	RoomID string
+	Body   string
+	// Context is the reporter's recent commands, one per line, oldest first.
+	Context string
+	// ClosedBy names the staff member who closed the report; empty while open.
+	ClosedBy  string
+	ClosedAt  *time.Time
+	CreatedAt time.Time
+}
+
+// ReportQuery filters ReportRepository.ListReports results.
+type ReportQuery struct {
+	// Kind, when non-empty, matches the report kind exactly.
+	Kind string
+	// IncludeClosed includes closed reports; otherwise only open ones are listed.
+	IncludeClosed bool
+	// Limit bounds the result count; <= 0 selects DefaultReportLimit.
+	Limit int
+}
+
+// ReportRepository persists player reports.
+type ReportRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewReportRepository constructs a ReportRepository backed by the given pool.
+func NewReportRepository(db *pgxpool.Pool) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// CreateReport files r and returns its new ID.
+//
+// Precondition: r.Kind must be bug, typo, or idea; r.Body must be non-empty.
+// Postcondition: A new open reports row exists stamped with the current time.
+func (r *ReportRepository) CreateReport(ctx context.Context, rep Report) (int64, error) {
+	var charID *int64
+	if rep.CharacterID > 0 {
+		charID = &rep.CharacterID
+	}
+	var id int64
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO reports (kind, character_id, char_name, room_id, body, context)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id`,
+		rep.Kind, charID, rep.CharName, rep.RoomID, rep.Body, rep.Context,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("creating report: %w", err)
+	}
+	return id, nil
+}
+
+// reportColumns is the column list scanned by scanReport.
+const reportColumns = `id, kind, COALESCE(character_id, 0), char_name, room_id, body, context, closed_by, closed_at, created_at`
+
+// scanReport reads one row selected with reportColumns.
+func scanReport(row pgx.Row) (Report, error) {
+	var rep Report
+	err := row.Scan(&rep.ID, &rep.Kind, &rep.CharacterID, &rep.CharName, &rep.RoomID,
+		&rep.Body, &rep.Context, &rep.ClosedBy, &rep.ClosedAt, &rep.CreatedAt)
+	return rep, err
+}
+
+// ListReports returns reports matching q, newest first.
+//
+// Postcondition: At most min(q.Limit, MaxReportLimit) reports are returned;
+// the slice is non-nil.
+func (r *ReportRepository) ListReports(ctx context.Context, q ReportQuery) ([]Report, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultReportLimit
+	}
+	if limit > MaxReportLimit {
+		limit = MaxReportLimit
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT `+reportColumns+`
+		 FROM reports
+		 WHERE ($1 = '' OR kind = $1)
+		   AND ($2 OR closed_at IS NULL)
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $3`,
+		q.Kind, q.IncludeClosed, limit)
+	if err != nil {
+		return nil, fmt.Errorf("listing reports: %w", err)
+	}
+	defer rows.Close()
+	reports := make([]Report, 0, limit)
+	for rows.Next() {
+		rep, err := scanReport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning report: %w", err)
+		}
+		reports = append(reports, rep)
+	}
+	return reports, rows.Err()
+}
+
+// GetReport returns report id.
+//
+// Postcondition: Returns ErrReportNotFound when no such report exists.
+func (r *ReportRepository) GetReport(ctx context.Context, id int64) (Report, error) {
+	rep, err := scanReport(r.db.QueryRow(ctx, `SELECT `+reportColumns+` FROM reports WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Report{}, ErrReportNotFound
+	}
+	if err != nil {
+		return Report{}, fmt.Errorf("loading report: %w", err)
+	}
+	return rep, nil
+}
+
+// CloseReport marks report id closed by closedBy.
+//
+// Precondition: closedBy must be non-empty.
+// Postcondition: Returns ErrReportNotFound when no open report has that ID.
+func (r *ReportRepository) CloseReport(ctx context.Context, id int64, closedBy string) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE reports SET closed_by = $2, closed_at = NOW() WHERE id = $1 AND closed_at IS NULL`,
+		id, closedBy)
+	if err != nil {
+		return fmt.Errorf("closing report: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrReportNotFound
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestReportRepository_CreateListClose(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewReportRepository(sharedPool)
+	ctx := context.Background()
+
+	created, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Tester")))
+	require.NoError(t, err)
+
+	bugID, err := repo.CreateReport(ctx, postgres.Report{
+		Kind: "bug", CharacterID: created.ID, CharName: created.Name, RoomID: "room_a",
+		Body: "the door eats my keys", Context: "move {\"direction\":\"north\"}",
+	})
+	require.NoError(t, err)
+	typoID, err := repo.CreateReport(ctx, postgres.Report{Kind: "typo", CharName: "Ghost", Body: "recieve"})
+	require.NoError(t, err)
+
+	bugs, err := repo.ListReports(ctx, postgres.ReportQuery{Kind: "bug", Limit: postgres.MaxReportLimit})
+	require.NoError(t, err)
+	require.NotEmpty(t, bugs)
+	var found bool
+	for _, r := range bugs {
+		assert.Equal(t, "bug", r.Kind)
+		found = found || r.ID == bugID
+	}
+	assert.True(t, found)
+
+	got, err := repo.GetReport(ctx, typoID)
+	require.NoError(t, err)
+	assert.Zero(t, got.CharacterID, "reports need not name a stored character")
+	assert.Nil(t, got.ClosedAt)
+
+	require.NoError(t, repo.CloseReport(ctx, bugID, "mod"))
+	assert.ErrorIs(t, repo.CloseReport(ctx, bugID, "mod"), postgres.ErrReportNotFound, "already closed")
+	got, err = repo.GetReport(ctx, bugID)
+	require.NoError(t, err)
+	assert.Equal(t, "mod", got.ClosedBy)
+	assert.NotNil(t, got.ClosedAt)
+
+	open, err := repo.ListReports(ctx, postgres.ReportQuery{Kind: "bug", Limit: postgres.MaxReportLimit})
+	require.NoError(t, err)
+	for _, r := range open {
+		assert.NotEqual(t, bugID, r.ID, "closed reports are not listed by default")
+	}
+
+	_, err = repo.GetReport(ctx, 1<<40)
+	assert.ErrorIs(t, err, postgres.ErrReportNotFound)
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/siteban"
+)
+
+// SiteBanRepository persists site allow/deny rules.
+type SiteBanRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewSiteBanRepository creates a SiteBanRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewSiteBanRepository(db *pgxpool.Pool) *SiteBanRepository {
+	return &SiteBanRepository{db: db}
+}
+
+// ListSiteBans returns every stored rule.
+//
+// Postcondition: Returns an empty slice when no rules are stored.
+func (r *SiteBanRepository) ListSiteBans(ctx context.Context) ([]siteban.Rule, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT cidr::text, allow, reason, created_by, created_at
+		FROM site_bans
+		ORDER BY cidr`)
+	if err != nil {
+		return nil, fmt.Errorf("SiteBanRepository.ListSiteBans: %w", err)
+	}
+	defer rows.Close()
+	var out []siteban.Rule
+	for rows.Next() {
+		var (
+			cidr string
+			rule siteban.Rule
+		)
+		if err := rows.Scan(&cidr, &rule.Allow, &rule.Reason, &rule.CreatedBy, &rule.CreatedAt); err != nil {
+			return nil, fmt.Errorf("SiteBanRepository.ListSiteBans: scan: %w", err)
+		}
+		if rule.Prefix, err = netip.ParsePrefix(cidr); err != nil {
+			return nil, fmt.Errorf("SiteBanRepository.ListSiteBans: parsing %q: %w", cidr, err)
+		}
+		out = append(out, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SiteBanRepository.ListSiteBans: %w", err)
+	}
+	return out, nil
+}
+
+// SaveSiteBan inserts rule, replacing any rule for the same prefix.
+//
+// Precondition: rule.Prefix must be valid and masked.
+func (r *SiteBanRepository) SaveSiteBan(ctx context.Context, rule siteban.Rule) error {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO site_bans (cidr, allow, reason, created_by, created_at)
+		VALUES ($1::cidr, $2, $3, $4, $5)
+		ON CONFLICT (cidr) DO UPDATE
+		SET allow = EXCLUDED.allow,
+		    reason = EXCLUDED.reason,
+		    created_by = EXCLUDED.created_by,
+		    created_at = EXCLUDED.created_at`,
+		rule.Prefix.String(), rule.Allow, rule.Reason, rule.CreatedBy, rule.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("SiteBanRepository.SaveSiteBan: %w", err)
+	}
+	return nil
+}
+
+// DeleteSiteBan removes the rule for p.
+//
+// Postcondition: Reports whether a rule existed.
+func (r *SiteBanRepository) DeleteSiteBan(ctx context.Context, p netip.Prefix) (bool, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM site_bans WHERE cidr = $1::cidr`, p.String())
+	if err != nil {
+		return false, fmt.Errorf("SiteBanRepository.DeleteSiteBan: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
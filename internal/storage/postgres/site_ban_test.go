@@ -0,0 +1,50 @@
+package postgres_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/siteban"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestSiteBanRepository_RoundTrip(t *testing.T) {
+	repo := postgres.NewSiteBanRepository(sharedPool)
+	ctx := context.Background()
+	deny := netip.MustParsePrefix("198.51.100.0/24")
+	allow := netip.MustParsePrefix("198.51.100.7/32")
+	t.Cleanup(func() {
+		_, _ = repo.DeleteSiteBan(ctx, deny)
+		_, _ = repo.DeleteSiteBan(ctx, allow)
+	})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.SaveSiteBan(ctx, siteban.Rule{Prefix: deny, Reason: "abuse", CreatedBy: "admin", CreatedAt: now}))
+	require.NoError(t, repo.SaveSiteBan(ctx, siteban.Rule{Prefix: allow, Allow: true, CreatedBy: "admin", CreatedAt: now}))
+	require.NoError(t, repo.SaveSiteBan(ctx, siteban.Rule{Prefix: deny, Reason: "spam", CreatedBy: "admin", CreatedAt: now}))
+
+	rules, err := repo.ListSiteBans(ctx)
+	require.NoError(t, err)
+	byPrefix := make(map[netip.Prefix]siteban.Rule)
+	for _, r := range rules {
+		byPrefix[r.Prefix] = r
+	}
+	require.Contains(t, byPrefix, deny)
+	assert.Equal(t, "spam", byPrefix[deny].Reason, "saving the same prefix replaces the rule")
+	assert.False(t, byPrefix[deny].Allow)
+	assert.True(t, now.Equal(byPrefix[deny].CreatedAt))
+	require.Contains(t, byPrefix, allow)
+	assert.True(t, byPrefix[allow].Allow)
+
+	found, err := repo.DeleteSiteBan(ctx, deny)
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = repo.DeleteSiteBan(ctx, deny)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
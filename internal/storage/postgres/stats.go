@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/stats"
+)
+
+// statColumns maps each stat to its character_stats column. Queries build
+// column names only from this map.
+var statColumns = map[stats.Stat]string{
+	stats.Kills:    "kills",
+	stats.Deaths:   "deaths",
+	stats.Damage:   "damage_dealt",
+	stats.Explored: "rooms_explored",
+	stats.Playtime: "playtime_seconds",
+}
+
+// StatsRepository persists lifetime character statistics.
+type StatsRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewStatsRepository creates a StatsRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewStatsRepository(db *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// AddStats adds deltas to the character's lifetime statistics. Playtime is
+// normally credited by PlaySessionRepository.TouchPlaySession instead.
+//
+// Precondition: characterID must be > 0.
+// Postcondition: Each stat's stored total grows by its delta; a missing row is created.
+func (r *StatsRepository) AddStats(ctx context.Context, characterID int64, deltas map[stats.Stat]int64) error {
+	cols := make([]string, 0, len(statColumns))
+	for _, col := range statColumns {
+		cols = append(cols, col)
+	}
+	// Sorted so the statement text is stable across calls.
+	sort.Strings(cols)
+	byCol := make(map[string]int64, len(deltas))
+	for st, n := range deltas {
+		col, ok := statColumns[st]
+		if !ok {
+			return fmt.Errorf("StatsRepository.AddStats: unknown stat %q", st)
+		}
+		byCol[col] = n
+	}
+	args := make([]any, 0, len(cols)+1)
+	args = append(args, characterID)
+	placeholders := make([]string, len(cols))
+	updates := make([]string, len(cols))
+	for i, col := range cols {
+		args = append(args, byCol[col])
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		updates[i] = fmt.Sprintf("%[1]s = character_stats.%[1]s + EXCLUDED.%[1]s", col)
+	}
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO character_stats (character_id, %s)
+		VALUES ($1, %s)
+		ON CONFLICT (character_id) DO UPDATE SET %s, updated_at = now()`,
+		strings.Join(cols, ", "), strings.Join(placeholders, ", "), strings.Join(updates, ", ")),
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("StatsRepository.AddStats: %w", err)
+	}
+	return nil
+}
+
+// TopStats returns the characters with the highest totals for st.
+//
+// Precondition: limit must be > 0.
+// Postcondition: Entries are ranked from 1 in descending order, ties broken by
+// name; characters with a zero total and soft-deleted characters are omitted.
+func (r *StatsRepository) TopStats(ctx context.Context, st stats.Stat, limit int) ([]stats.Entry, error) {
+	col, ok := statColumns[st]
+	if !ok {
+		return nil, fmt.Errorf("StatsRepository.TopStats: unknown stat %q", st)
+	}
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT c.name, s.%[1]s
+		FROM character_stats s JOIN characters c ON c.id = s.character_id
+		WHERE c.deleted_at IS NULL AND s.%[1]s > 0
+		ORDER BY s.%[1]s DESC, c.name
+		LIMIT $1`, col), limit)
+	if err != nil {
+		return nil, fmt.Errorf("StatsRepository.TopStats: %w", err)
+	}
+	defer rows.Close()
+	var out []stats.Entry
+	for rows.Next() {
+		e := stats.Entry{Rank: len(out) + 1}
+		if err := rows.Scan(&e.Name, &e.Value); err != nil {
+			return nil, fmt.Errorf("StatsRepository.TopStats scan: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("StatsRepository.TopStats: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,80 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/stats"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestStatsRepository_AddAndTop(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewStatsRepository(sharedPool)
+	ctx := context.Background()
+
+	aceName, beeName := uniqueName("Ace"), uniqueName("Bee")
+	ace, err := charRepo.Create(ctx, makeTestCharacter(accountID, aceName))
+	require.NoError(t, err)
+	bee, err := charRepo.Create(ctx, makeTestCharacter(accountID, beeName))
+	require.NoError(t, err)
+
+	const base = int64(1) << 40
+	require.NoError(t, repo.AddStats(ctx, ace.ID, map[stats.Stat]int64{stats.Kills: base, stats.Playtime: 60}))
+	require.NoError(t, repo.AddStats(ctx, ace.ID, map[stats.Stat]int64{stats.Kills: 5}))
+	require.NoError(t, repo.AddStats(ctx, bee.ID, map[stats.Stat]int64{stats.Kills: base + 1}))
+
+	top, err := repo.TopStats(ctx, stats.Kills, 2)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, stats.Entry{Rank: 1, Name: aceName, Value: base + 5}, top[0])
+	assert.Equal(t, stats.Entry{Rank: 2, Name: beeName, Value: base + 1}, top[1])
+
+	top, err = repo.TopStats(ctx, stats.Playtime, 100)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range top {
+		names = append(names, e.Name)
+	}
+	assert.Contains(t, names, aceName)
+	assert.NotContains(t, names, beeName, "zero totals are not ranked")
+
+	_, err = repo.TopStats(ctx, stats.Stat("gold"), 10)
+	assert.Error(t, err)
+}
+
+func TestStatsRepository_AddStatsRejectsUnknownStat(t *testing.T) {
+	repo := postgres.NewStatsRepository(nil)
+	err := repo.AddStats(context.Background(), 1, map[stats.Stat]int64{stats.Stat("gold"): 1})
+	assert.ErrorContains(t, err, "unknown stat")
+}
+
+func TestStatsRepository_AddStatsWritesEveryStatToItsColumn(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewStatsRepository(sharedPool)
+	ctx := context.Background()
+
+	name := uniqueName("Tally")
+	ch, err := charRepo.Create(ctx, makeTestCharacter(accountID, name))
+	require.NoError(t, err)
+	deltas := make(map[stats.Stat]int64, len(stats.All))
+	for i, st := range stats.All {
+		deltas[st] = int64(i + 1)
+	}
+	require.NoError(t, repo.AddStats(ctx, ch.ID, deltas))
+
+	for _, st := range stats.All {
+		top, err := repo.TopStats(ctx, st, 1000)
+		require.NoError(t, err)
+		var got int64
+		for _, e := range top {
+			if e.Name == name {
+				got = e.Value
+			}
+		}
+		assert.Equal(t, deltas[st], got, "stat %s", st)
+	}
+}
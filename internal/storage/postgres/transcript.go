@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+)
+
+// ErrTranscriptNotFound is returned when a transcript does not exist or
+// belongs to another account.
+var ErrTranscriptNotFound = errors.New("transcript not found")
+
+// TranscriptRepository stores session transcripts.
+type TranscriptRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewTranscriptRepository creates a TranscriptRepository backed by the given pool.
+//
+// Precondition: db must be a valid, open connection pool.
+func NewTranscriptRepository(db *pgxpool.Pool) *TranscriptRepository {
+	return &TranscriptRepository{db: db}
+}
+
+// SaveTranscript stores t and returns its new ID.
+//
+// Precondition: t.CharacterID must be > 0.
+func (r *TranscriptRepository) SaveTranscript(ctx context.Context, t transcript.Transcript) (int64, error) {
+	var id int64
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO character_transcripts (character_id, char_name, started_at, ended_at, body)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		t.CharacterID, t.CharName, t.StartedAt, t.EndedAt, t.Body,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("TranscriptRepository.SaveTranscript: %w", err)
+	}
+	return id, nil
+}
+
+// ListTranscripts returns the transcripts recorded by the account's
+// characters, newest first, without their bodies.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns an empty slice when there are none.
+func (r *TranscriptRepository) ListTranscripts(ctx context.Context, accountID int64) ([]transcript.Transcript, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT t.id, t.character_id, t.char_name, t.started_at, t.ended_at
+		FROM character_transcripts t
+		JOIN characters c ON c.id = t.character_id
+		WHERE c.account_id = $1
+		ORDER BY t.started_at DESC, t.id DESC`,
+		accountID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("TranscriptRepository.ListTranscripts: %w", err)
+	}
+	defer rows.Close()
+	out := []transcript.Transcript{}
+	for rows.Next() {
+		var t transcript.Transcript
+		if err := rows.Scan(&t.ID, &t.CharacterID, &t.CharName, &t.StartedAt, &t.EndedAt); err != nil {
+			return nil, fmt.Errorf("TranscriptRepository.ListTranscripts: %w", err)
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("TranscriptRepository.ListTranscripts: %w", err)
+	}
+	return out, nil
+}
+
+// GetTranscript returns transcript id if one of the account's characters recorded it.
+//
+// Precondition: accountID must be > 0.
+// Postcondition: Returns ErrTranscriptNotFound when the transcript is missing or not the account's.
+func (r *TranscriptRepository) GetTranscript(ctx context.Context, accountID, id int64) (transcript.Transcript, error) {
+	var t transcript.Transcript
+	err := r.db.QueryRow(ctx, `
+		SELECT t.id, t.character_id, t.char_name, t.started_at, t.ended_at, t.body
+		FROM character_transcripts t
+		JOIN characters c ON c.id = t.character_id
+		WHERE t.id = $1 AND c.account_id = $2`,
+		id, accountID,
+	).Scan(&t.ID, &t.CharacterID, &t.CharName, &t.StartedAt, &t.EndedAt, &t.Body)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return transcript.Transcript{}, ErrTranscriptNotFound
+	}
+	if err != nil {
+		return transcript.Transcript{}, fmt.Errorf("TranscriptRepository.GetTranscript: %w", err)
+	}
+	return t, nil
+}
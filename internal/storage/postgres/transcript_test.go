@@ -0,0 +1,47 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/transcript"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+func TestTranscriptRepository_SaveListGet(t *testing.T) {
+	charRepo, accountID := setupCharRepos(t)
+	repo := postgres.NewTranscriptRepository(sharedPool)
+	ctx := context.Background()
+
+	created, err := charRepo.Create(ctx, makeTestCharacter(accountID, uniqueName("Scribe")))
+	require.NoError(t, err)
+
+	list, err := repo.ListTranscripts(ctx, accountID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	id, err := repo.SaveTranscript(ctx, transcript.Transcript{
+		CharacterID: created.ID, CharName: created.Name,
+		StartedAt: start, EndedAt: start.Add(time.Hour), Body: "[20:00:00] You wave.\n",
+	})
+	require.NoError(t, err)
+
+	list, err = repo.ListTranscripts(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, id, list[0].ID)
+	assert.Empty(t, list[0].Body, "listings leave the body out")
+
+	got, err := repo.GetTranscript(ctx, accountID, id)
+	require.NoError(t, err)
+	assert.Equal(t, "[20:00:00] You wave.\n", got.Body)
+	assert.True(t, start.Equal(got.StartedAt))
+
+	_, err = repo.GetTranscript(ctx, accountID+1000000, id)
+	assert.ErrorIs(t, err, postgres.ErrTranscriptNotFound, "another account can't read it")
+}
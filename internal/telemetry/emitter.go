@@ -0,0 +1,155 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Sink stores or forwards exported events.
+type Sink interface {
+	// Write exports events, which are in the order they were emitted. It
+	// must not retain events after returning.
+	Write(ctx context.Context, events []Event) error
+	// Close releases the sink's resources.
+	Close() error
+}
+
+// maxBatch is the most events handed to a Sink in one Write.
+const maxBatch = 500
+
+// writeTimeout bounds one Write so a stalled sink cannot hold events forever.
+const writeTimeout = 10 * time.Second
+
+// Emitter queues events and writes them to a Sink in batches from a
+// background goroutine, so emitting never blocks the game. A nil Emitter
+// discards events. It is safe for concurrent use.
+type Emitter struct {
+	sink   Sink
+	logger *zap.Logger
+	every  time.Duration
+
+	// mu guards closed and sends on events against Close.
+	mu      sync.RWMutex
+	closed  bool
+	events  chan Event
+	done    chan struct{}
+	dropped atomic.Int64
+}
+
+// NewEmitter starts an Emitter that writes to sink.
+//
+// Precondition: sink and logger must be non-nil; bufferSize and flushInterval must be > 0.
+// Postcondition: Events are written at least every flushInterval, or sooner
+// when maxBatch are waiting; call Close to flush and stop.
+func NewEmitter(sink Sink, bufferSize int, flushInterval time.Duration, logger *zap.Logger) *Emitter {
+	e := &Emitter{
+		sink:   sink,
+		logger: logger,
+		every:  flushInterval,
+		events: make(chan Event, bufferSize),
+		done:   make(chan struct{}),
+	}
+	go e.run()
+	return e
+}
+
+// Emit queues ev for export.
+//
+// Postcondition: A zero ev.Time is stamped with the current time. When the
+// buffer is full or the Emitter is closed, ev is dropped and counted.
+func (e *Emitter) Emit(ev Event) {
+	if e == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closed {
+		e.dropped.Add(1)
+		return
+	}
+	select {
+	case e.events <- ev:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// Dropped returns how many events were lost to a full buffer, a closed
+// Emitter, or a failed write.
+func (e *Emitter) Dropped() int64 {
+	if e == nil {
+		return 0
+	}
+	return e.dropped.Load()
+}
+
+// Close writes every queued event and closes the sink.
+//
+// Postcondition: Later calls to Emit drop their events; later calls to Close return nil.
+func (e *Emitter) Close() error {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	if e.closed {
+		e.mu.Unlock()
+		return nil
+	}
+	e.closed = true
+	close(e.events)
+	e.mu.Unlock()
+	<-e.done
+	if err := e.sink.Close(); err != nil {
+		return fmt.Errorf("closing telemetry sink: %w", err)
+	}
+	return nil
+}
+
+// run batches queued events until the channel is closed.
+func (e *Emitter) run() {
+	defer close(e.done)
+	ticker := time.NewTicker(e.every)
+	defer ticker.Stop()
+	batch := make([]Event, 0, maxBatch)
+	for {
+		select {
+		case ev, ok := <-e.events:
+			if !ok {
+				e.write(batch)
+				return
+			}
+			batch = append(batch, ev)
+			if len(batch) < maxBatch {
+				continue
+			}
+		case <-ticker.C:
+		}
+		e.write(batch)
+		batch = batch[:0]
+	}
+}
+
+// write hands batch to the sink, logging and counting the events lost on failure.
+func (e *Emitter) write(batch []Event) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if err := e.sink.Write(ctx, batch); err != nil {
+		e.dropped.Add(int64(len(batch)))
+		e.logger.Warn("exporting telemetry events failed",
+			zap.Int("events", len(batch)),
+			zap.Int64("dropped_total", e.dropped.Load()),
+			zap.Error(err),
+		)
+	}
+}
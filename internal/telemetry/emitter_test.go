@@ -0,0 +1,115 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pgregory.net/rapid"
+)
+
+type memSink struct {
+	mu      sync.Mutex
+	events  []Event
+	writes  int
+	fail    bool
+	closed  bool
+	written chan struct{}
+}
+
+func newMemSink() *memSink { return &memSink{written: make(chan struct{}, 100)} }
+
+func (m *memSink) Write(_ context.Context, events []Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.writes++
+	defer func() { m.written <- struct{}{} }()
+	if m.fail {
+		return errors.New("sink down")
+	}
+	m.events = append(m.events, events...)
+	return nil
+}
+
+func (m *memSink) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	return nil
+}
+
+func (m *memSink) snapshot() []Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Event(nil), m.events...)
+}
+
+func TestEmitter_FlushesOnIntervalAndClose(t *testing.T) {
+	sink := newMemSink()
+	e := NewEmitter(sink, 10, 10*time.Millisecond, zap.NewNop())
+	e.Emit(Event{Type: TypeKill, Target: "ganger"})
+
+	select {
+	case <-sink.written:
+	case <-time.After(time.Second):
+		t.Fatal("the interval flush did not write the event")
+	}
+	got := sink.snapshot()
+	require.Len(t, got, 1)
+	assert.Equal(t, "ganger", got[0].Target)
+	assert.False(t, got[0].Time.IsZero(), "Emit stamps the time")
+
+	e.Emit(Event{Type: TypeLevel, Amount: 2})
+	require.NoError(t, e.Close())
+	assert.Len(t, sink.snapshot(), 2, "Close writes what is queued")
+	assert.True(t, sink.closed)
+
+	e.Emit(Event{Type: TypeDeath})
+	assert.Equal(t, int64(1), e.Dropped(), "events after Close are dropped")
+	assert.NoError(t, e.Close())
+}
+
+func TestEmitter_DropsWhenFullOrSinkFails(t *testing.T) {
+	sink := newMemSink()
+	sink.fail = true
+	e := NewEmitter(sink, 2, time.Hour, zap.NewNop())
+	// The run goroutine may take one event off the buffer, so emit enough to
+	// overflow it regardless.
+	for i := 0; i < 5; i++ {
+		e.Emit(Event{Type: TypeCurrency, Amount: int64(i)})
+	}
+	require.NoError(t, e.Close())
+	assert.Equal(t, int64(5), e.Dropped(), "overflowed and failed events are all counted")
+}
+
+func TestNilEmitter_Discards(t *testing.T) {
+	var e *Emitter
+	e.Emit(Event{Type: TypeKill})
+	assert.Zero(t, e.Dropped())
+	assert.NoError(t, e.Close())
+}
+
+// TestProperty_EmitterPreservesOrder verifies that every event emitted into a
+// buffer large enough to hold them reaches the sink exactly once, in order.
+func TestProperty_EmitterPreservesOrder(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		n := rapid.IntRange(0, 2*maxBatch).Draw(rt, "n")
+		sink := newMemSink()
+		sink.written = make(chan struct{}, 2*maxBatch)
+		e := NewEmitter(sink, 2*maxBatch, time.Hour, zap.NewNop())
+		for i := 0; i < n; i++ {
+			e.Emit(Event{Type: TypeCurrency, Amount: int64(i)})
+		}
+		require.NoError(rt, e.Close())
+		got := sink.snapshot()
+		require.Len(rt, got, n)
+		for i, ev := range got {
+			assert.Equal(rt, int64(i), ev.Amount)
+		}
+	})
+}
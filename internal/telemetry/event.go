@@ -0,0 +1,83 @@
+// Package telemetry exports normalized game events — kills, deaths, level-ups,
+// trades, and currency entering or leaving the economy — to a pluggable Sink
+// so operators can analyze the economy and game balance offline.
+//
+// Every event shares one flat schema so a sink can store them in a single
+// table or stream and consumers can aggregate across types:
+//
+//	{"type":"currency","time":"…","character_id":42,"character":"Vex",
+//	 "zone":"downtown","room":"downtown_market","amount":-120,"source":"merchant_buy"}
+package telemetry
+
+import "time"
+
+// Type identifies what happened.
+type Type string
+
+const (
+	// TypeKill is a player credited with killing an NPC. Target is the NPC
+	// template ID and Amount is the NPC's level.
+	TypeKill Type = "kill"
+	// TypeDeath is a player dying. Amount is the character's level.
+	TypeDeath Type = "death"
+	// TypeLevel is a player reaching a new level. Amount is the level reached.
+	TypeLevel Type = "level"
+	// TypeTrade is an item changing hands for credits. Target is the item ID,
+	// Quantity how many changed hands, Amount the total price, Counterparty
+	// the merchant template ID or the other character's name, and Source the
+	// player's side of the trade: SourceMerchantBuy, SourceMerchantSell, or
+	// SourceAuctionBuy.
+	TypeTrade Type = "trade"
+	// TypeCurrency is a change to a player's credits. Amount is positive for
+	// credits entering the player's wallet and negative for credits leaving
+	// it; Source names the cause.
+	TypeCurrency Type = "currency"
+)
+
+// Currency sources. A source is a faucet when its Amount is positive and a
+// sink when negative; sources between players, such as auction sales, move
+// credits without creating or destroying them.
+const (
+	SourceLoot         = "loot"
+	SourcePickpocket   = "pickpocket"
+	SourceRobbed       = "robbed"
+	SourceDig          = "dig"
+	SourceDowntime     = "downtime"
+	SourceScript       = "script"
+	SourceAdminGrant   = "admin_grant"
+	SourceMerchantBuy  = "merchant_buy"
+	SourceMerchantSell = "merchant_sell"
+	SourceAuctionFee   = "auction_fee"
+	SourceAuctionBuy   = "auction_buy"
+	SourceAuctionSale  = "auction_sale"
+	SourceBankFee      = "bank_fee"
+	SourceTraining     = "training"
+	SourceHealing      = "healing"
+	SourceRest         = "rest"
+	SourceHireling     = "hireling"
+	SourceFine         = "fine"
+	SourceBribe        = "bribe"
+	SourceFactionFixer = "faction_fixer"
+	SourceEngraving    = "engraving"
+	SourceCurseRemoval = "curse_removal"
+	SourceRaid         = "raid"
+)
+
+// Event is one game event. Fields that do not apply to an event's Type are
+// left zero and omitted from its encoding.
+type Event struct {
+	Type Type      `json:"type"`
+	Time time.Time `json:"time"`
+	// CharacterID and Character identify the player the event happened to.
+	CharacterID int64  `json:"character_id,omitempty"`
+	Character   string `json:"character,omitempty"`
+	// Zone and Room locate the player when the event happened.
+	Zone string `json:"zone,omitempty"`
+	Room string `json:"room,omitempty"`
+	// Target is what the event acted on; see each Type.
+	Target       string `json:"target,omitempty"`
+	Counterparty string `json:"counterparty,omitempty"`
+	Quantity     int    `json:"quantity,omitempty"`
+	Amount       int64  `json:"amount"`
+	Source       string `json:"source,omitempty"`
+}
@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// FileSink appends events to a file as JSON Lines, one event per line.
+type FileSink struct {
+	f *os.File
+}
+
+// NewFileSink opens path for appending, creating it if needed.
+//
+// Postcondition: Returns a non-nil FileSink or a non-nil error.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening telemetry file: %w", err)
+	}
+	return &FileSink{f: f}, nil
+}
+
+// Write appends one line per event.
+func (s *FileSink) Write(_ context.Context, events []Event) error {
+	w := bufio.NewWriter(s.f)
+	enc := json.NewEncoder(w)
+	for _, ev := range events {
+		if err := enc.Encode(ev); err != nil {
+			return fmt.Errorf("encoding telemetry event: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("writing telemetry file: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,37 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), []Event{
+		{Type: TypeCurrency, Time: at, CharacterID: 42, Character: "Vex", Amount: -120, Source: SourceMerchantBuy},
+	}))
+	require.NoError(t, sink.Close())
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), []Event{{Type: TypeLevel, Time: at, Amount: 3}}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `{"type":"currency","time":"2026-03-01T12:00:00Z","character_id":42,"character":"Vex","amount":-120,"source":"merchant_buy"}`, lines[0])
+	assert.Equal(t, `{"type":"level","time":"2026-03-01T12:00:00Z","amount":3}`, lines[1])
+}
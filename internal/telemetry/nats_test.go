@@ -0,0 +1,119 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNATS accepts connections and records the subject and payload of each
+// PUB. It answers PING with PONG, and sends -ERR for the subject "deny.*".
+type fakeNATS struct {
+	ln    net.Listener
+	pubs  chan string
+	conns chan net.Conn
+}
+
+func newFakeNATS(t *testing.T) *fakeNATS {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &fakeNATS{ln: ln, pubs: make(chan string, 100), conns: make(chan net.Conn, 10)}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			f.conns <- conn
+			go f.serve(conn)
+		}
+	}()
+	return f
+}
+
+func (f *fakeNATS) url() string { return "nats://" + f.ln.Addr().String() }
+
+func (f *fakeNATS) serve(conn net.Conn) {
+	defer conn.Close()
+	fmt.Fprint(conn, "INFO {\"server_id\":\"fake\"}\r\n")
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case strings.HasPrefix(line, "PUB "):
+			var subject string
+			var n int
+			fmt.Sscanf(line, "PUB %s %d", &subject, &n)
+			payload := make([]byte, n+2)
+			if _, err := io.ReadFull(r, payload); err != nil {
+				return
+			}
+			if strings.HasPrefix(subject, "deny.") {
+				fmt.Fprint(conn, "-ERR 'Permissions Violation'\r\n")
+				continue
+			}
+			f.pubs <- subject + " " + string(payload[:n])
+		}
+	}
+}
+
+func TestNATSSink_PublishesPerType(t *testing.T) {
+	srv := newFakeNATS(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sink, err := NewNATSSink(ctx, srv.url(), "mud.events")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, sink.Write(ctx, []Event{
+		{Type: TypeKill, Time: at, Target: "ganger", Amount: 2},
+		{Type: TypeDeath, Time: at, Amount: 4},
+	}))
+	assert.Equal(t, `mud.events.kill {"type":"kill","time":"2026-03-01T12:00:00Z","target":"ganger","amount":2}`, <-srv.pubs)
+	assert.Equal(t, `mud.events.death {"type":"death","time":"2026-03-01T12:00:00Z","amount":4}`, <-srv.pubs)
+}
+
+func TestNATSSink_ReconnectsAfterDisconnect(t *testing.T) {
+	srv := newFakeNATS(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sink, err := NewNATSSink(ctx, srv.url(), "mud.events")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	(<-srv.conns).Close()
+	require.NoError(t, sink.Write(ctx, []Event{{Type: TypeLevel, Amount: 5}}))
+	assert.Contains(t, <-srv.pubs, "mud.events.level ")
+}
+
+func TestNATSSink_Errors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := NewNATSSink(ctx, "http://localhost:4222", "mud.events")
+	assert.Error(t, err)
+
+	srv := newFakeNATS(t)
+	sink, err := NewNATSSink(ctx, srv.url(), "deny")
+	require.NoError(t, err)
+	defer sink.Close()
+	err = sink.Write(ctx, []Event{{Type: TypeKill}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Permissions Violation")
+}
@@ -0,0 +1,138 @@
+package harness
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+)
+
+// DefaultExpectTimeout bounds each Client.Expect when the client has no
+// timeout of its own.
+const DefaultExpectTimeout = 5 * time.Second
+
+// Prompt is the tail of the in-game prompt the frontend writes after each
+// command's output.
+const Prompt = "hp]"
+
+// Client is a scripted telnet client. Output is read into a buffer with ANSI
+// escapes removed; each Expect consumes the buffer through its match, so a
+// script reads like the session it drives.
+type Client struct {
+	t       testing.TB
+	conn    net.Conn
+	pending string // raw bytes ending in an incomplete escape sequence
+	buffer  string
+	all     strings.Builder
+	Timeout time.Duration
+}
+
+// Dial connects a Client to the telnet frontend at addr.
+//
+// Precondition: addr must be a listening telnet frontend.
+// Postcondition: The connection is closed on test cleanup.
+func Dial(t testing.TB, addr string) *Client {
+	t.Helper()
+	conn, err := net.DialTimeout("tcp", addr, 2*time.Second)
+	if err != nil {
+		t.Fatalf("dialing telnet frontend %s: %v", addr, err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return &Client{t: t, conn: conn, Timeout: DefaultExpectTimeout}
+}
+
+// Send writes line followed by CRLF.
+func (c *Client) Send(line string) {
+	c.t.Helper()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.conn.Write([]byte(line + "\r\n")); err != nil {
+		c.t.Fatalf("sending %q: %v", line, err)
+	}
+}
+
+// Expect reads until substr appears and returns the output up to and
+// including it.
+//
+// Postcondition: Fails the test, showing the unconsumed output, when substr
+// does not arrive within c.Timeout.
+func (c *Client) Expect(substr string) string {
+	c.t.Helper()
+	deadline := time.Now().Add(c.Timeout)
+	tmp := make([]byte, 4096)
+	for {
+		if idx := strings.Index(c.buffer, substr); idx >= 0 {
+			end := idx + len(substr)
+			out := c.buffer[:end]
+			c.buffer = c.buffer[end:]
+			return out
+		}
+		_ = c.conn.SetReadDeadline(deadline)
+		n, err := c.conn.Read(tmp)
+		if n > 0 {
+			c.pending += string(tmp[:n])
+			cut := partialEscape(c.pending)
+			text := telnet.StripANSI(c.pending[:cut])
+			c.pending = c.pending[cut:]
+			c.buffer += text
+			c.all.WriteString(text)
+		}
+		if err != nil {
+			c.t.Fatalf("waiting for %q: %v\nunconsumed output:\n%s", substr, err, c.buffer)
+			return ""
+		}
+	}
+}
+
+// partialEscape returns the index of an escape sequence left incomplete at
+// the end of s, or len(s). Holding it back until the rest arrives keeps a
+// sequence split across reads from leaking into the buffer.
+func partialEscape(s string) int {
+	i := strings.LastIndexByte(s, '\033')
+	if i < 0 {
+		return len(s)
+	}
+	if i+1 == len(s) {
+		return i
+	}
+	if s[i+1] != '[' {
+		return len(s)
+	}
+	for j := i + 2; j < len(s); j++ {
+		if s[j] >= 0x40 && s[j] <= 0x7E {
+			return len(s)
+		}
+	}
+	return i
+}
+
+// ExpectSequence expects each of substrs in order and returns the output
+// through the last.
+func (c *Client) ExpectSequence(substrs ...string) string {
+	c.t.Helper()
+	var b strings.Builder
+	for _, s := range substrs {
+		b.WriteString(c.Expect(s))
+	}
+	return b.String()
+}
+
+// Command sends line and returns its output through the next prompt. Output
+// that arrives on its own, such as combat rounds, can put a prompt ahead of
+// the reply; wait for such output with Expect first.
+func (c *Client) Command(line string) string {
+	c.t.Helper()
+	c.Send(line)
+	return c.Expect(Prompt)
+}
+
+// Transcript returns everything the client has read, ANSI escapes removed.
+func (c *Client) Transcript() string {
+	return c.all.String()
+}
+
+// Close disconnects the client.
+func (c *Client) Close() {
+	c.conn.Close()
+}
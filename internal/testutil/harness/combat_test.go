@@ -0,0 +1,89 @@
+package harness_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+	"github.com/cory-johannsen/mud/internal/testutil/harness"
+)
+
+var testPistol = &inventory.WeaponDef{
+	ID: "test_pistol", Name: "Test Pistol",
+	DamageDice: "1d6", DamageType: "piercing",
+	RangeIncrement: 100, ReloadActions: 1, MagazineCapacity: 30,
+	FiringModes:         []inventory.FiringMode{inventory.FiringModeSingle},
+	ProficiencyCategory: "simple_ranged",
+	Rarity:              "salvage",
+}
+
+var junkScrap = &inventory.ItemDef{
+	ID: "junk_scrap", Name: "Junk Scrap", Kind: inventory.KindJunk,
+	MaxStack: 10, Stackable: true,
+}
+
+func TestCombat_KillingAnNPCAwardsLoot(t *testing.T) {
+	h := harness.Start(t, harness.Options{
+		Items:   []*inventory.ItemDef{junkScrap},
+		Weapons: []*inventory.WeaponDef{testPistol},
+	})
+	vex := h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+	h.Arm(t, vex.ID, testPistol)
+	rat := h.SpawnNPC(t, &npc.Template{
+		ID: "rat", Name: "Rat", Level: 1, MaxHP: 1, AC: 1, Awareness: 2,
+		Loot: &npc.LootTable{
+			Currency: &npc.CurrencyDrop{Min: 25, Max: 25},
+			Items:    []npc.ItemDrop{{ItemID: "junk_scrap", Chance: 1.0, MinQty: 1, MaxQty: 1}},
+		},
+	}, "room_a")
+
+	c := h.Login(t, "alice", "secret")
+	c.Send("attack rat")
+	c.Expect("initiative")
+
+	h.Events.WaitForSequence(t, 5*time.Second,
+		harness.OfType(gamev1.CombatEventType_COMBAT_EVENT_TYPE_ATTACK).InRoom("room_a").Involving("Rat"),
+		harness.OfType(gamev1.CombatEventType_COMBAT_EVENT_TYPE_END),
+	)
+	h.Events.WaitForSequence(t, 5*time.Second,
+		harness.OfType(gamev1.CombatEventType_COMBAT_EVENT_TYPE_DEATH).Involving("Rat").Narrating("dead"),
+	)
+	require.Eventually(t, func() bool {
+		_, alive := h.NPCs.Get(rat.ID)
+		return !alive
+	}, 3*time.Second, 10*time.Millisecond, "the rat should be removed once dead")
+
+	c.ExpectSequence("Combat complete.", "You gain 25 Crypto from Rat.", "You looted: Junk Scrap", "Rat is dead!")
+	c.Send("inventory")
+	inv := c.ExpectSequence("=== Inventory ===", "Currency: 25 Crypto")
+	assert.Contains(t, inv, "Junk Scrap")
+	c.Send("look")
+	c.Expect("The first room.")
+	assert.NotContains(t, c.Expect(harness.Prompt), "Rat")
+
+	c.Send("quit")
+	require.Eventually(t, func() bool {
+		currency, err := h.Characters.LoadCurrency(context.Background(), vex.ID)
+		return err == nil && currency == 25
+	}, 3*time.Second, 10*time.Millisecond, "logout should save the looted currency")
+}
+
+func TestCombat_AttackIsRefusedInSafeRooms(t *testing.T) {
+	zone := harness.DefaultZone()
+	zone.Rooms["room_a"].DangerLevel = "safe"
+	h := harness.Start(t, harness.Options{Zones: []*world.Zone{zone}})
+	h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+	h.SpawnNPC(t, &npc.Template{ID: "rat", Name: "Rat", Level: 1, MaxHP: 5, AC: 10, Awareness: 2}, "room_a")
+
+	c := h.Login(t, "alice", "secret")
+	assert.Contains(t, c.Command("attack rat"), "combat is not permitted in this area")
+	assert.Empty(t, h.Events.Events())
+}
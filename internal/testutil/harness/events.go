@@ -0,0 +1,144 @@
+package harness
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// RecordedEvent is a combat event together with the room it was broadcast to.
+type RecordedEvent struct {
+	RoomID string
+	Event  *gamev1.CombatEvent
+}
+
+// EventMatcher reports whether a recorded event is the one a test expects.
+type EventMatcher struct {
+	desc  string
+	match func(RecordedEvent) bool
+}
+
+// String describes the matcher for failure messages.
+func (m EventMatcher) String() string { return m.desc }
+
+// OfType matches events of the given type in any room.
+func OfType(typ gamev1.CombatEventType) EventMatcher {
+	return EventMatcher{desc: typ.String(), match: func(e RecordedEvent) bool { return e.Event.GetType() == typ }}
+}
+
+// InRoom narrows m to events broadcast to roomID.
+func (m EventMatcher) InRoom(roomID string) EventMatcher {
+	return EventMatcher{
+		desc:  fmt.Sprintf("%s in %s", m.desc, roomID),
+		match: func(e RecordedEvent) bool { return e.RoomID == roomID && m.match(e) },
+	}
+}
+
+// Narrating narrows m to events whose narrative contains substr.
+func (m EventMatcher) Narrating(substr string) EventMatcher {
+	return EventMatcher{
+		desc:  fmt.Sprintf("%s narrating %q", m.desc, substr),
+		match: func(e RecordedEvent) bool { return strings.Contains(e.Event.GetNarrative(), substr) && m.match(e) },
+	}
+}
+
+// Involving narrows m to events whose attacker or target is name.
+func (m EventMatcher) Involving(name string) EventMatcher {
+	return EventMatcher{
+		desc: fmt.Sprintf("%s involving %s", m.desc, name),
+		match: func(e RecordedEvent) bool {
+			return (e.Event.GetAttacker() == name || e.Event.GetTarget() == name) && m.match(e)
+		},
+	}
+}
+
+// EventLog records every combat event the game server broadcasts, in order.
+//
+// It is safe for concurrent use; combat rounds resolve on timer goroutines.
+type EventLog struct {
+	mu     sync.Mutex
+	events []RecordedEvent
+	added  chan struct{}
+}
+
+// NewEventLog returns an empty EventLog.
+func NewEventLog() *EventLog {
+	return &EventLog{added: make(chan struct{})}
+}
+
+// Record appends events broadcast to roomID. Its signature matches
+// CombatHandler.SetBroadcastFn.
+func (l *EventLog) Record(roomID string, events []*gamev1.CombatEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range events {
+		l.events = append(l.events, RecordedEvent{RoomID: roomID, Event: e})
+	}
+	close(l.added)
+	l.added = make(chan struct{})
+}
+
+// Events returns a copy of everything recorded so far.
+func (l *EventLog) Events() []RecordedEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]RecordedEvent(nil), l.events...)
+}
+
+// WaitForSequence waits until the log holds events matching want in order,
+// other events may come between them, and returns the matched events.
+//
+// Precondition: want must be non-empty; timeout must be > 0.
+// Postcondition: Fails t, listing what was recorded, if the sequence is not
+// complete within timeout.
+func (l *EventLog) WaitForSequence(t testing.TB, timeout time.Duration, want ...EventMatcher) []RecordedEvent {
+	t.Helper()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		l.mu.Lock()
+		matched, next := matchSequence(l.events, want)
+		added := l.added
+		l.mu.Unlock()
+		if next == len(want) {
+			return matched
+		}
+		select {
+		case <-added:
+		case <-deadline.C:
+			t.Fatalf("combat events: timed out after %s waiting for %s (matched %d of %d)\nrecorded:\n%s",
+				timeout, want[next], next, len(want), l.describe())
+			return nil
+		}
+	}
+}
+
+// matchSequence greedily matches want against events in order. It returns
+// the matched events and the index of the first unmatched matcher.
+func matchSequence(events []RecordedEvent, want []EventMatcher) ([]RecordedEvent, int) {
+	var matched []RecordedEvent
+	next := 0
+	for _, e := range events {
+		if next == len(want) {
+			break
+		}
+		if want[next].match(e) {
+			matched = append(matched, e)
+			next++
+		}
+	}
+	return matched, next
+}
+
+// describe lists the recorded events one per line.
+func (l *EventLog) describe() string {
+	var b strings.Builder
+	for _, e := range l.Events() {
+		fmt.Fprintf(&b, "  [%s] %s %s -> %s: %s\n", e.RoomID, e.Event.GetType(), e.Event.GetAttacker(), e.Event.GetTarget(), e.Event.GetNarrative())
+	}
+	return b.String()
+}
@@ -0,0 +1,359 @@
+// Package harness boots the game server and the telnet frontend in-process
+// for end-to-end tests, with in-memory stores standing in for Postgres.
+//
+// A test starts a Harness, seeds accounts, characters, NPCs, and floor items,
+// then drives the game through scripted telnet clients exactly as a player
+// would, asserting on the rendered output and on the combat events the
+// server broadcasts:
+//
+//	h := harness.Start(t, harness.Options{})
+//	h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+//	c := h.Login(t, "alice", "secret")
+//	c.Command("north")
+//
+// Unlike internal/e2e, nothing here needs Docker or built binaries, so these
+// tests run with the rest of go test ./....
+package harness
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+
+	"github.com/cory-johannsen/mud/internal/config"
+	"github.com/cory-johannsen/mud/internal/frontend/handlers"
+	"github.com/cory-johannsen/mud/internal/frontend/telnet"
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/combat"
+	"github.com/cory-johannsen/mud/internal/game/command"
+	"github.com/cory-johannsen/mud/internal/game/condition"
+	"github.com/cory-johannsen/mud/internal/game/danger"
+	"github.com/cory-johannsen/mud/internal/game/dice"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/npc"
+	"github.com/cory-johannsen/mud/internal/game/ruleset"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/world"
+	"github.com/cory-johannsen/mud/internal/gameserver"
+	gamev1 "github.com/cory-johannsen/mud/internal/gameserver/gamev1"
+)
+
+// DefaultRoundDuration keeps combat rounds short enough for tests.
+const DefaultRoundDuration = 200 * time.Millisecond
+
+// Options configures a Harness. The zero value is a usable two-room world.
+type Options struct {
+	// Zones is the world; nil means DefaultZone.
+	Zones []*world.Zone
+	// Items are registered with the item registry before the server starts.
+	Items []*inventory.ItemDef
+	// Weapons are registered with the item registry before the server starts.
+	Weapons []*inventory.WeaponDef
+	// Conditions replaces DefaultConditions when non-nil.
+	Conditions *condition.Registry
+	// RoundDuration is the combat round length; zero means
+	// DefaultRoundDuration.
+	RoundDuration time.Duration
+	// Logger receives server and frontend logs; nil means a zaptest logger
+	// that prints only for failing tests.
+	Logger *zap.Logger
+}
+
+// Harness is a running game server and telnet frontend.
+//
+// Fields expose the server's managers so tests can seed state and inspect
+// results; everything is stopped on test cleanup.
+type Harness struct {
+	Accounts   *Accounts
+	Characters *Characters
+	World      *world.Manager
+	Sessions   *session.Manager
+	NPCs       *npc.Manager
+	Floor      *inventory.FloorManager
+	Items      *inventory.Registry
+	Combat     *gameserver.CombatHandler
+	Server     *gameserver.GameServiceServer
+	// Events records every combat event the server broadcasts.
+	Events *EventLog
+	// GameAddr is the gRPC game server's address.
+	GameAddr string
+	// TelnetAddr is the telnet frontend's address.
+	TelnetAddr string
+}
+
+// Start boots a game server and telnet frontend configured by opts.
+//
+// Precondition: opts.Zones, when set, must form a valid world.
+// Postcondition: Both servers are listening on loopback ports and are stopped
+// on test cleanup; setup failures fail t.
+func Start(t testing.TB, opts Options) *Harness {
+	t.Helper()
+	logger := opts.Logger
+	if logger == nil {
+		logger = zaptest.NewLogger(t)
+	}
+	zones := opts.Zones
+	if zones == nil {
+		zones = []*world.Zone{DefaultZone()}
+	}
+	conds := opts.Conditions
+	if conds == nil {
+		conds = DefaultConditions()
+	}
+	roundDuration := opts.RoundDuration
+	if roundDuration <= 0 {
+		roundDuration = DefaultRoundDuration
+	}
+
+	worldMgr, err := world.NewManager(zones)
+	if err != nil {
+		t.Fatalf("building world: %v", err)
+	}
+	items := inventory.NewRegistry()
+	for _, d := range opts.Items {
+		if err := items.RegisterItem(d); err != nil {
+			t.Fatalf("registering item %s: %v", d.ID, err)
+		}
+	}
+	for _, w := range opts.Weapons {
+		if err := items.RegisterWeapon(w); err != nil {
+			t.Fatalf("registering weapon %s: %v", w.ID, err)
+		}
+	}
+
+	h := &Harness{
+		Accounts:   NewAccounts(),
+		Characters: NewCharacters(),
+		World:      worldMgr,
+		Sessions:   session.NewManager(),
+		NPCs:       npc.NewManager(),
+		Floor:      inventory.NewFloorManager(),
+		Items:      items,
+		Events:     NewEventLog(),
+	}
+	roller := dice.NewLoggedRoller(dice.NewCryptoSource(), logger)
+	h.Combat = gameserver.NewCombatHandler(
+		combat.NewEngine(), h.NPCs, h.Sessions, roller, nil, roundDuration,
+		conds, worldMgr, nil, items, nil, nil, nil, h.Floor, nil,
+	)
+	h.Combat.SetLogger(logger)
+	h.Combat.SetCurrencySaver(h.Characters)
+
+	h.Server = gameserver.NewGameServiceServer(
+		gameserver.StorageDeps{CharRepo: h.Characters},
+		gameserver.ContentDeps{
+			WorldMgr:     worldMgr,
+			NpcMgr:       h.NPCs,
+			InvRegistry:  items,
+			FloorMgr:     h.Floor,
+			CondRegistry: conds,
+		},
+		gameserver.HandlerDeps{
+			WorldHandler:  gameserver.NewWorldHandler(worldMgr, h.Sessions, h.NPCs, nil, nil, items),
+			ChatHandler:   gameserver.NewChatHandler(h.Sessions),
+			NPCHandler:    gameserver.NewNPCHandler(h.NPCs, h.Sessions),
+			CombatHandler: h.Combat,
+		},
+		h.Sessions,
+		command.DefaultRegistry(),
+		nil,
+		logger,
+	)
+	h.Combat.SetBroadcastFn(func(roomID string, events []*gamev1.CombatEvent) {
+		h.Events.Record(roomID, events)
+		h.Server.BroadcastCombatEvents(roomID, events)
+	})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening for game server: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	gamev1.RegisterGameServiceServer(grpcServer, h.Server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+	h.GameAddr = lis.Addr().String()
+
+	auth := handlers.NewAuthHandler(
+		h.Accounts, h.Characters,
+		[]*ruleset.Region{}, []*ruleset.Team{}, []*ruleset.Job{}, []*ruleset.Archetype{},
+		logger, h.GameAddr,
+		config.TelnetConfig{IdleTimeout: 5 * time.Minute, IdleGracePeriod: time.Minute, AllowGameCommands: true},
+		nil, nil, nil, nil, nil, nil,
+	)
+	auth.SetAccountSettingsLoader(h.Accounts)
+	acc := telnet.NewAcceptor(config.TelnetConfig{
+		Host:         "127.0.0.1",
+		Port:         0,
+		ReadTimeout:  time.Minute,
+		WriteTimeout: 5 * time.Second,
+	}, auth, logger)
+	go func() { _ = acc.ListenAndServe() }()
+	deadline := time.Now().Add(2 * time.Second)
+	for !acc.IsRunning() || acc.Addr() == "" {
+		if time.Now().After(deadline) {
+			t.Fatal("telnet frontend did not start in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Cleanup(acc.Stop)
+	h.TelnetAddr = acc.Addr()
+	return h
+}
+
+// DefaultZone returns the two-room zone Start uses when Options.Zones is nil:
+// "Room A" (room_a, the start room) with an exit north to "Room B" (room_b).
+// The zone is sketchy, so players may start fights but NPCs never do.
+func DefaultZone() *world.Zone {
+	return &world.Zone{
+		ID:          "test",
+		Name:        "Test",
+		Description: "Test zone",
+		DangerLevel: string(danger.Sketchy),
+		StartRoom:   "room_a",
+		Rooms: map[string]*world.Room{
+			"room_a": {
+				ID:          "room_a",
+				ZoneID:      "test",
+				Title:       "Room A",
+				Description: "The first room.",
+				Exits:       []world.Exit{{Direction: world.North, TargetRoom: "room_b"}},
+				Properties:  map[string]string{},
+			},
+			"room_b": {
+				ID:          "room_b",
+				ZoneID:      "test",
+				Title:       "Room B",
+				Description: "The second room.",
+				Exits:       []world.Exit{{Direction: world.South, TargetRoom: "room_a"}},
+				Properties:  map[string]string{},
+			},
+		},
+	}
+}
+
+// DefaultConditions returns the conditions combat applies on its own: dying,
+// wounded, and the common attack and defense penalties.
+func DefaultConditions() *condition.Registry {
+	reg := condition.NewRegistry()
+	reg.Register(&condition.ConditionDef{ID: "dying", Name: "Dying", DurationType: "until_save", MaxStacks: 4, RestrictActions: []string{"attack", "strike", "pass"}})
+	reg.Register(&condition.ConditionDef{ID: "wounded", Name: "Wounded", DurationType: "permanent", MaxStacks: 3})
+	reg.Register(&condition.ConditionDef{ID: "stunned", Name: "Stunned", DurationType: "rounds", MaxStacks: 3})
+	reg.Register(&condition.ConditionDef{ID: "prone", Name: "Prone", DurationType: "permanent", AttackPenalty: 2})
+	reg.Register(&condition.ConditionDef{ID: "flat_footed", Name: "Flat-Footed", DurationType: "rounds", ACPenalty: 2})
+	reg.Register(&condition.ConditionDef{ID: "frightened", Name: "Frightened", DurationType: "rounds", MaxStacks: 4, AttackPenalty: 1, ACPenalty: 1})
+	return reg
+}
+
+// AddCharacter stores c for the account username, creating the account with
+// password if it does not exist. Unset fields get playable defaults: level 1,
+// 10 HP, the "ganger" job, and a gender, so login goes straight to the game.
+//
+// Precondition: c must be non-nil with a unique Name.
+// Postcondition: Returns the stored character with its ID set.
+func (h *Harness) AddCharacter(t testing.TB, username, password string, c *character.Character) *character.Character {
+	t.Helper()
+	ctx := context.Background()
+	acct, err := h.Accounts.Authenticate(ctx, username, password)
+	if err != nil {
+		if acct, err = h.Accounts.Create(ctx, username, password); err != nil {
+			t.Fatalf("creating account %s: %v", username, err)
+		}
+	}
+	seed := *c
+	seed.AccountID = acct.ID
+	if seed.Level == 0 {
+		seed.Level = 1
+	}
+	if seed.MaxHP == 0 {
+		seed.MaxHP = 10
+	}
+	if seed.CurrentHP == 0 {
+		seed.CurrentHP = seed.MaxHP
+	}
+	if seed.Class == "" {
+		seed.Class = "ganger"
+	}
+	if seed.Gender == "" {
+		seed.Gender = "indeterminate"
+	}
+	stored, err := h.Characters.Create(ctx, &seed)
+	if err != nil {
+		t.Fatalf("creating character %s: %v", c.Name, err)
+	}
+	return stored
+}
+
+// Arm makes weapon the main hand of the character's active preset, as if the
+// player had equipped it before logging out.
+//
+// Precondition: charID must be a stored character; weapon must be non-nil.
+func (h *Harness) Arm(t testing.TB, charID int64, weapon *inventory.WeaponDef) {
+	t.Helper()
+	ls := inventory.NewLoadoutSet()
+	if err := ls.ActivePreset().EquipMainHand(weapon); err != nil {
+		t.Fatalf("equipping %s: %v", weapon.ID, err)
+	}
+	if err := h.Characters.SaveWeaponPresets(context.Background(), charID, ls); err != nil {
+		t.Fatalf("saving weapon presets: %v", err)
+	}
+}
+
+// SpawnNPC places an instance of tmpl in roomID.
+//
+// Precondition: tmpl must be non-nil; roomID must exist in the world.
+func (h *Harness) SpawnNPC(t testing.TB, tmpl *npc.Template, roomID string) *npc.Instance {
+	t.Helper()
+	inst, err := h.NPCs.Spawn(tmpl, roomID)
+	if err != nil {
+		t.Fatalf("spawning %s in %s: %v", tmpl.ID, roomID, err)
+	}
+	return inst
+}
+
+// DropItem puts quantity of the registered item itemID on the floor of roomID.
+//
+// Precondition: itemID must be registered; quantity must be > 0.
+func (h *Harness) DropItem(t testing.TB, roomID, itemID string, quantity int) {
+	t.Helper()
+	if _, ok := h.Items.Item(itemID); !ok {
+		t.Fatalf("dropping unregistered item %q", itemID)
+	}
+	h.Floor.Drop(roomID, inventory.ItemInstance{
+		InstanceID:    uuid.New().String(),
+		ItemDefID:     itemID,
+		Quantity:      quantity,
+		Durability:    -1,
+		MaxDurability: -1,
+	})
+}
+
+// Connect opens a telnet client and reads through the welcome banner.
+func (h *Harness) Connect(t testing.TB) *Client {
+	t.Helper()
+	c := Dial(t, h.TelnetAddr)
+	c.Expect("to disconnect.")
+	return c
+}
+
+// Login connects, logs in as username, and selects the account's first
+// character, returning once the first in-game prompt arrives.
+//
+// Precondition: the account must have at least one character.
+func (h *Harness) Login(t testing.TB, username, password string) *Client {
+	t.Helper()
+	c := h.Connect(t)
+	c.Send("login " + username)
+	c.Expect("Password:")
+	c.Send(password)
+	c.ExpectSequence("Logged in as", "Your characters:")
+	c.Send("1")
+	c.Expect(Prompt)
+	return c
+}
@@ -0,0 +1,52 @@
+package harness_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/testutil/harness"
+)
+
+func TestHarness_LoginAndMove(t *testing.T) {
+	h := harness.Start(t, harness.Options{})
+	h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+
+	c := h.Login(t, "alice", "secret")
+	assert.Contains(t, c.Transcript(), "Room A")
+	assert.Contains(t, c.Command("north"), "Room B")
+	assert.Contains(t, c.Command("south"), "Room A")
+	_, ok := h.Sessions.GetPlayerByCharName("Vex")
+	assert.True(t, ok, "character should be in the game")
+}
+
+func TestHarness_RejectsBadPassword(t *testing.T) {
+	h := harness.Start(t, harness.Options{})
+	h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+
+	c := h.Connect(t)
+	c.Send("login alice")
+	c.Expect("Password:")
+	c.Send("wrong")
+	c.Expect("Invalid password.")
+}
+
+func TestHarness_StatePersistsAcrossLogins(t *testing.T) {
+	h := harness.Start(t, harness.Options{})
+	vex := h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+
+	c := h.Login(t, "alice", "secret")
+	c.Command("north")
+	c.Send("quit")
+	require.Eventually(t, func() bool {
+		got, err := h.Characters.GetByID(context.Background(), vex.ID)
+		return err == nil && got.Location == "room_b"
+	}, 3*time.Second, 10*time.Millisecond, "logout should save the character's room")
+
+	again := h.Login(t, "alice", "secret")
+	assert.Contains(t, again.Transcript(), "Room B")
+}
@@ -0,0 +1,40 @@
+package harness_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/testutil/harness"
+)
+
+func TestInventory_PickUpDropAndPersist(t *testing.T) {
+	h := harness.Start(t, harness.Options{Items: []*inventory.ItemDef{junkScrap}})
+	vex := h.AddCharacter(t, "alice", "secret", &character.Character{Name: "Vex"})
+	h.DropItem(t, "room_a", "junk_scrap", 3)
+
+	c := h.Login(t, "alice", "secret")
+	assert.Contains(t, c.Command("get junk scrap"), "You pick up Junk Scrap.")
+	assert.Contains(t, c.Command("inventory"), "Junk Scrap (x3)")
+	assert.Empty(t, h.Floor.ItemsInRoom("room_a"))
+
+	c.Command("north")
+	c.Command("drop junk scrap")
+	require.Len(t, h.Floor.ItemsInRoom("room_b"), 1)
+	assert.Equal(t, 3, h.Floor.ItemsInRoom("room_b")[0].Quantity)
+	c.Command("get junk scrap")
+
+	c.Send("quit")
+	require.Eventually(t, func() bool {
+		items, err := h.Characters.LoadInventory(context.Background(), vex.ID)
+		return err == nil && len(items) == 1 && items[0].ItemDefID == "junk_scrap" && items[0].Quantity == 3
+	}, 3*time.Second, 10*time.Millisecond, "logout should save the backpack")
+
+	again := h.Login(t, "alice", "secret")
+	assert.Contains(t, again.Command("inventory"), "Junk Scrap (x3)")
+}
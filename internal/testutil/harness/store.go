@@ -0,0 +1,413 @@
+package harness
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cory-johannsen/mud/internal/game/character"
+	"github.com/cory-johannsen/mud/internal/game/inventory"
+	"github.com/cory-johannsen/mud/internal/game/session"
+	"github.com/cory-johannsen/mud/internal/game/settings"
+	"github.com/cory-johannsen/mud/internal/storage/postgres"
+)
+
+// Accounts is an in-memory stand-in for the Postgres account and account
+// settings repositories, implementing handlers.AccountStore and
+// handlers.AccountSettingsLoader. Passwords are compared in plain text; it is
+// for tests only.
+type Accounts struct {
+	mu        sync.Mutex
+	accounts  map[string]postgres.Account
+	passwords map[string]string
+	settings  map[int64]settings.Settings
+}
+
+// NewAccounts returns an empty Accounts.
+//
+// Postcondition: Returns a non-nil Accounts with no accounts.
+func NewAccounts() *Accounts {
+	return &Accounts{
+		accounts:  make(map[string]postgres.Account),
+		passwords: make(map[string]string),
+		settings:  make(map[int64]settings.Settings),
+	}
+}
+
+// Create registers a player account.
+//
+// Postcondition: Returns postgres.ErrAccountExists when username is taken.
+func (a *Accounts) Create(_ context.Context, username, password string) (postgres.Account, error) {
+	return a.CreateWithRole(username, password, postgres.RolePlayer)
+}
+
+// CreateWithRole registers an account with the given role.
+//
+// Postcondition: Returns postgres.ErrAccountExists when username is taken.
+func (a *Accounts) CreateWithRole(username, password, role string) (postgres.Account, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.accounts[username]; ok {
+		return postgres.Account{}, postgres.ErrAccountExists
+	}
+	acct := postgres.Account{ID: int64(len(a.accounts) + 1), Username: username, Role: role, CreatedAt: time.Now()}
+	a.accounts[username] = acct
+	a.passwords[username] = password
+	return acct, nil
+}
+
+// Authenticate checks username and password.
+//
+// Postcondition: Returns postgres.ErrAccountNotFound or
+// postgres.ErrInvalidCredentials on failure.
+func (a *Accounts) Authenticate(_ context.Context, username, password string) (postgres.Account, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	acct, ok := a.accounts[username]
+	if !ok {
+		return postgres.Account{}, postgres.ErrAccountNotFound
+	}
+	if a.passwords[username] != password {
+		return postgres.Account{}, postgres.ErrInvalidCredentials
+	}
+	return acct, nil
+}
+
+// Get returns the account's settings. Accounts without saved settings have
+// the pager off, so scripted clients never stop at a --More-- prompt.
+func (a *Accounts) Get(_ context.Context, accountID int64) (settings.Settings, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if s, ok := a.settings[accountID]; ok {
+		return s, nil
+	}
+	return settings.Settings{PageLength: settings.PageLengthOff}, nil
+}
+
+// SetSettings replaces the account's settings, taking effect at its next
+// login.
+func (a *Accounts) SetSettings(accountID int64, s settings.Settings) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.settings[accountID] = s
+}
+
+// Characters is an in-memory stand-in for the Postgres character
+// repository. It implements handlers.CharacterStore and
+// gameserver.CharacterSaver, so state the game server saves when a player
+// logs out is loaded again when they log back in.
+type Characters struct {
+	mu     sync.Mutex
+	chars  map[int64]*storedCharacter
+	nextID int64
+}
+
+// storedCharacter is everything Characters persists for one character.
+type storedCharacter struct {
+	char            character.Character
+	presets         *inventory.LoadoutSet
+	equipment       *inventory.Equipment
+	inventory       []inventory.InventoryItem
+	startingGranted bool
+	currency        int
+	heroPoints      int
+	jobs            map[string]int
+	activeJob       string
+	focusPoints     int
+	hotbars         [][10]session.HotbarSlot
+	activeHotbar    int
+}
+
+// NewCharacters returns an empty Characters.
+//
+// Postcondition: Returns a non-nil Characters with no characters.
+func NewCharacters() *Characters {
+	return &Characters{chars: make(map[int64]*storedCharacter)}
+}
+
+// ListByAccount returns the account's live characters in creation order.
+func (s *Characters) ListByAccount(_ context.Context, accountID int64) ([]*character.Character, error) {
+	return s.list(accountID, false), nil
+}
+
+// ListDeletedByAccount returns the account's soft-deleted characters in
+// creation order.
+func (s *Characters) ListDeletedByAccount(_ context.Context, accountID int64) ([]*character.Character, error) {
+	return s.list(accountID, true), nil
+}
+
+func (s *Characters) list(accountID int64, deleted bool) []*character.Character {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*character.Character
+	for _, sc := range s.chars {
+		if sc.char.AccountID == accountID && (sc.char.DeletedAt != nil) == deleted {
+			c := sc.char
+			out = append(out, &c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// Create stores a new character and assigns its ID.
+//
+// Precondition: c must be non-nil.
+// Postcondition: Returns postgres.ErrCharacterNameTaken when another
+// character has the same name, ignoring case.
+func (s *Characters) Create(_ context.Context, c *character.Character) (*character.Character, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sc := range s.chars {
+		if strings.EqualFold(sc.char.Name, c.Name) {
+			return nil, postgres.ErrCharacterNameTaken
+		}
+	}
+	s.nextID++
+	created := *c
+	created.ID = s.nextID
+	created.CreatedAt = time.Now()
+	created.UpdatedAt = created.CreatedAt
+	s.chars[created.ID] = &storedCharacter{char: created, startingGranted: true, jobs: map[string]int{}}
+	out := created
+	return &out, nil
+}
+
+// GetByID returns a copy of the stored character.
+//
+// Postcondition: Returns postgres.ErrCharacterNotFound for an unknown id.
+func (s *Characters) GetByID(_ context.Context, id int64) (*character.Character, error) {
+	var out *character.Character
+	err := s.with(id, func(sc *storedCharacter) {
+		c := sc.char
+		out = &c
+	})
+	return out, err
+}
+
+// SoftDeleteByAccountAndName marks the named character deleted.
+func (s *Characters) SoftDeleteByAccountAndName(_ context.Context, accountID int64, name string) error {
+	return s.setDeleted(accountID, name, true)
+}
+
+// RestoreByAccountAndName undoes SoftDeleteByAccountAndName.
+func (s *Characters) RestoreByAccountAndName(_ context.Context, accountID int64, name string) error {
+	return s.setDeleted(accountID, name, false)
+}
+
+func (s *Characters) setDeleted(accountID int64, name string, deleted bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sc := range s.chars {
+		if sc.char.AccountID != accountID || !strings.EqualFold(sc.char.Name, name) || (sc.char.DeletedAt != nil) == deleted {
+			continue
+		}
+		if deleted {
+			now := time.Now()
+			sc.char.DeletedAt = &now
+		} else {
+			sc.char.DeletedAt = nil
+		}
+		return nil
+	}
+	return postgres.ErrCharacterNotFound
+}
+
+// with runs fn on the character with the given id while holding the lock.
+//
+// Postcondition: Returns postgres.ErrCharacterNotFound for an unknown id.
+func (s *Characters) with(id int64, fn func(sc *storedCharacter)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sc, ok := s.chars[id]
+	if !ok {
+		return postgres.ErrCharacterNotFound
+	}
+	fn(sc)
+	return nil
+}
+
+// SaveState stores the character's room and current HP.
+func (s *Characters) SaveState(_ context.Context, id int64, location string, currentHP int) error {
+	return s.with(id, func(sc *storedCharacter) {
+		sc.char.Location = location
+		sc.char.CurrentHP = currentHP
+	})
+}
+
+// SaveGender stores the character's gender.
+func (s *Characters) SaveGender(_ context.Context, id int64, gender string) error {
+	return s.with(id, func(sc *storedCharacter) { sc.char.Gender = gender })
+}
+
+// SaveAbilities stores the character's ability scores.
+func (s *Characters) SaveAbilities(_ context.Context, id int64, abilities character.AbilityScores) error {
+	return s.with(id, func(sc *storedCharacter) { sc.char.Abilities = abilities })
+}
+
+// SaveProgress stores the character's level, experience, and max HP.
+// Pending boosts are not tracked.
+func (s *Characters) SaveProgress(_ context.Context, id int64, level, experience, maxHP, _ int) error {
+	return s.with(id, func(sc *storedCharacter) {
+		sc.char.Level = level
+		sc.char.Experience = experience
+		sc.char.MaxHP = maxHP
+	})
+}
+
+// SaveDefaultCombatAction stores the character's default combat action.
+func (s *Characters) SaveDefaultCombatAction(_ context.Context, id int64, action string) error {
+	return s.with(id, func(sc *storedCharacter) { sc.char.DefaultCombatAction = action })
+}
+
+// LoadWeaponPresets returns the stored presets, or an empty set.
+func (s *Characters) LoadWeaponPresets(_ context.Context, id int64, _ *inventory.Registry) (*inventory.LoadoutSet, error) {
+	ls := inventory.NewLoadoutSet()
+	err := s.with(id, func(sc *storedCharacter) {
+		if sc.presets != nil {
+			ls = sc.presets
+		}
+	})
+	return ls, err
+}
+
+// SaveWeaponPresets stores ls; tests may call it before login to arm a
+// character.
+func (s *Characters) SaveWeaponPresets(_ context.Context, id int64, ls *inventory.LoadoutSet) error {
+	return s.with(id, func(sc *storedCharacter) { sc.presets = ls })
+}
+
+// LoadEquipment returns the stored equipment, or an empty set.
+func (s *Characters) LoadEquipment(_ context.Context, id int64) (*inventory.Equipment, error) {
+	eq := inventory.NewEquipment()
+	err := s.with(id, func(sc *storedCharacter) {
+		if sc.equipment != nil {
+			eq = sc.equipment
+		}
+	})
+	return eq, err
+}
+
+// SaveEquipment stores eq.
+func (s *Characters) SaveEquipment(_ context.Context, id int64, eq *inventory.Equipment) error {
+	return s.with(id, func(sc *storedCharacter) { sc.equipment = eq })
+}
+
+// LoadInventory returns a copy of the stored backpack items.
+func (s *Characters) LoadInventory(_ context.Context, id int64) ([]inventory.InventoryItem, error) {
+	var items []inventory.InventoryItem
+	err := s.with(id, func(sc *storedCharacter) {
+		items = append(items, sc.inventory...)
+	})
+	return items, err
+}
+
+// SaveInventory replaces the stored backpack items.
+func (s *Characters) SaveInventory(_ context.Context, id int64, items []inventory.InventoryItem) error {
+	return s.with(id, func(sc *storedCharacter) {
+		sc.inventory = append([]inventory.InventoryItem(nil), items...)
+	})
+}
+
+// HasReceivedStartingInventory reports whether the starting kit was granted.
+// Characters created here start with it granted, so tests begin
+// with an empty backpack unless they mark it otherwise.
+func (s *Characters) HasReceivedStartingInventory(_ context.Context, id int64) (bool, error) {
+	var granted bool
+	err := s.with(id, func(sc *storedCharacter) { granted = sc.startingGranted })
+	return granted, err
+}
+
+// MarkStartingInventoryGranted records that the starting kit was granted.
+func (s *Characters) MarkStartingInventoryGranted(_ context.Context, id int64) error {
+	return s.with(id, func(sc *storedCharacter) { sc.startingGranted = true })
+}
+
+// SaveCurrency stores the character's currency.
+func (s *Characters) SaveCurrency(_ context.Context, id int64, currency int) error {
+	return s.with(id, func(sc *storedCharacter) { sc.currency = currency })
+}
+
+// LoadCurrency returns the character's currency.
+func (s *Characters) LoadCurrency(_ context.Context, id int64) (int, error) {
+	var currency int
+	err := s.with(id, func(sc *storedCharacter) { currency = sc.currency })
+	return currency, err
+}
+
+// SaveHeroPoints stores the character's hero points.
+func (s *Characters) SaveHeroPoints(_ context.Context, id int64, heroPoints int) error {
+	return s.with(id, func(sc *storedCharacter) { sc.heroPoints = heroPoints })
+}
+
+// LoadHeroPoints returns the character's hero points.
+func (s *Characters) LoadHeroPoints(_ context.Context, id int64) (int, error) {
+	var heroPoints int
+	err := s.with(id, func(sc *storedCharacter) { heroPoints = sc.heroPoints })
+	return heroPoints, err
+}
+
+// SaveJobs stores the character's job levels and active job.
+func (s *Characters) SaveJobs(_ context.Context, id int64, jobs map[string]int, activeJobID string) error {
+	return s.with(id, func(sc *storedCharacter) {
+		sc.jobs = make(map[string]int, len(jobs))
+		for j, lvl := range jobs {
+			sc.jobs[j] = lvl
+		}
+		sc.activeJob = activeJobID
+	})
+}
+
+// LoadJobs returns a copy of the character's job levels and its active job.
+func (s *Characters) LoadJobs(_ context.Context, id int64) (map[string]int, string, error) {
+	jobs := map[string]int{}
+	var active string
+	err := s.with(id, func(sc *storedCharacter) {
+		for j, lvl := range sc.jobs {
+			jobs[j] = lvl
+		}
+		active = sc.activeJob
+	})
+	return jobs, active, err
+}
+
+// SaveInstanceCharges is a no-op; item charges are not persisted.
+func (s *Characters) SaveInstanceCharges(_ context.Context, id int64, _, _ string, _ int, _ bool) error {
+	return s.with(id, func(*storedCharacter) {})
+}
+
+// SaveFocusPoints stores the character's focus points.
+func (s *Characters) SaveFocusPoints(_ context.Context, id int64, focusPoints int) error {
+	return s.with(id, func(sc *storedCharacter) { sc.focusPoints = focusPoints })
+}
+
+// LoadFocusPoints returns the character's focus points.
+func (s *Characters) LoadFocusPoints(_ context.Context, id int64) (int, error) {
+	var focusPoints int
+	err := s.with(id, func(sc *storedCharacter) { focusPoints = sc.focusPoints })
+	return focusPoints, err
+}
+
+// SaveHotbars stores the character's hotbars.
+func (s *Characters) SaveHotbars(_ context.Context, id int64, bars [][10]session.HotbarSlot, activeIdx int) error {
+	return s.with(id, func(sc *storedCharacter) {
+		sc.hotbars = append([][10]session.HotbarSlot(nil), bars...)
+		sc.activeHotbar = activeIdx
+	})
+}
+
+// LoadHotbars returns a copy of the character's hotbars; like the Postgres
+// repository it returns one empty bar when none are saved.
+func (s *Characters) LoadHotbars(_ context.Context, id int64) ([][10]session.HotbarSlot, int, error) {
+	bars := [][10]session.HotbarSlot{{}}
+	var active int
+	err := s.with(id, func(sc *storedCharacter) {
+		if len(sc.hotbars) > 0 {
+			bars = append([][10]session.HotbarSlot(nil), sc.hotbars...)
+			active = sc.activeHotbar
+		}
+	})
+	return bars, active, err
+}
@@ -168,10 +168,11 @@ func (pc *PostgresContainer) ApplyMigrations(t *testing.T) {
 		);
 
 		CREATE TABLE IF NOT EXISTS character_inventory (
+			id           BIGSERIAL PRIMARY KEY,
 			character_id BIGINT NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
 			item_def_id  TEXT   NOT NULL,
 			quantity     INT    NOT NULL DEFAULT 1,
-			PRIMARY KEY (character_id, item_def_id)
+			metadata     JSONB  NOT NULL DEFAULT '{}'
 		);
 
 		CREATE TABLE IF NOT EXISTS character_map_rooms (
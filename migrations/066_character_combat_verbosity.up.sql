@@ -0,0 +1 @@
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS combat_verbosity TEXT NOT NULL DEFAULT 'normal';
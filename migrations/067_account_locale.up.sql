@@ -0,0 +1 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS locale TEXT NOT NULL DEFAULT 'en';
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS admin_audit (
+    id               BIGSERIAL   PRIMARY KEY,
+    actor_account_id BIGINT      NOT NULL DEFAULT 0,
+    actor            TEXT        NOT NULL,
+    action           TEXT        NOT NULL,
+    target           TEXT        NOT NULL DEFAULT '',
+    args             JSONB       NOT NULL DEFAULT '{}',
+    outcome          TEXT        NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_created_at ON admin_audit (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_actor ON admin_audit (lower(actor));
+CREATE INDEX IF NOT EXISTS idx_admin_audit_target ON admin_audit (lower(target));
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS account_settings (
+    account_id BIGINT      PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    settings   JSONB       NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_characters_deleted_at;
+ALTER TABLE characters DROP COLUMN IF EXISTS deleted_at;
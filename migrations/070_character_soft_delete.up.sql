@@ -0,0 +1,2 @@
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_characters_deleted_at ON characters (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+-- character_slots overrides the configured per-account character limit; 0 means no override.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS character_slots INT NOT NULL DEFAULT 0;
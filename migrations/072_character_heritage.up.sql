@@ -0,0 +1,2 @@
+-- heritage is the character's heritage ID chosen at creation; empty for older characters.
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS heritage TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- background_answers maps background question ID to the answer chosen at creation.
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS background_answers JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,4 @@
+-- tutorial_complete is set once a character finishes or skips the first-login tutorial.
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS tutorial_complete BOOLEAN NOT NULL DEFAULT FALSE;
+-- Characters that already exist have played past the point the tutorial covers.
+UPDATE characters SET tutorial_complete = TRUE;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS npc_merchant_state;
@@ -0,0 +1,12 @@
+-- npc_merchant_state persists each merchant's stock, budget and restock timer so
+-- limited stock and items bought from players survive a server restart.
+-- Merchants are keyed by template and spawn room since instance IDs are per-process.
+CREATE TABLE IF NOT EXISTS npc_merchant_state (
+    npc_template_id   VARCHAR(64)  NOT NULL,
+    room_id           VARCHAR(128) NOT NULL,
+    stock             JSONB        NOT NULL DEFAULT '{}',
+    budget            INTEGER      NOT NULL DEFAULT 0,
+    next_replenish_at TIMESTAMPTZ  NOT NULL,
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (npc_template_id, room_id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_housing;
+ALTER TABLE characters DROP COLUMN IF EXISTS stash_balance;
@@ -0,0 +1,12 @@
+-- Stash (bank) balances are persisted so rent can be charged while the tenant is offline.
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS stash_balance INTEGER NOT NULL DEFAULT 0;
+
+-- player_housing holds one row per rented room.
+CREATE TABLE IF NOT EXISTS player_housing (
+    room_id        VARCHAR(128) PRIMARY KEY,
+    character_id   BIGINT       NOT NULL UNIQUE REFERENCES characters(id) ON DELETE CASCADE,
+    description    TEXT         NOT NULL DEFAULT '',
+    storage        JSONB        NOT NULL DEFAULT '{}',
+    paid_until     TIMESTAMPTZ  NOT NULL,
+    past_due_since TIMESTAMPTZ
+);
@@ -0,0 +1,18 @@
+-- clans holds player-created clans and their shared bank.
+CREATE TABLE IF NOT EXISTS clans (
+    name       VARCHAR(24) PRIMARY KEY,
+    tag        VARCHAR(4)  NOT NULL,
+    motd       TEXT        NOT NULL DEFAULT '',
+    bank       INTEGER     NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS clans_lower_name_idx ON clans (lower(name));
+CREATE UNIQUE INDEX IF NOT EXISTS clans_tag_idx ON clans (tag);
+
+-- clan_members holds one row per clan member; a character belongs to at most one clan.
+CREATE TABLE IF NOT EXISTS clan_members (
+    character_id BIGINT      PRIMARY KEY REFERENCES characters(id) ON DELETE CASCADE,
+    clan_name    VARCHAR(24) NOT NULL REFERENCES clans(name) ON DELETE CASCADE,
+    rank         SMALLINT    NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS clan_members_clan_idx ON clan_members (clan_name);
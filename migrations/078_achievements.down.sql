@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS character_achievements;
+ALTER TABLE characters DROP COLUMN IF EXISTS title;
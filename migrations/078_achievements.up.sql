@@ -0,0 +1,11 @@
+-- title is the achievement title a character displays; empty for none.
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS title TEXT NOT NULL DEFAULT '';
+
+-- character_achievements holds progress toward, and unlocks of, content-defined achievements.
+CREATE TABLE IF NOT EXISTS character_achievements (
+    character_id   BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    achievement_id VARCHAR(64) NOT NULL,
+    progress       INTEGER     NOT NULL DEFAULT 0,
+    unlocked_at    TIMESTAMPTZ,
+    PRIMARY KEY (character_id, achievement_id)
+);
@@ -0,0 +1,10 @@
+-- character_stats holds lifetime statistics ranked by the top command.
+CREATE TABLE IF NOT EXISTS character_stats (
+    character_id     BIGINT      PRIMARY KEY REFERENCES characters(id) ON DELETE CASCADE,
+    kills            BIGINT      NOT NULL DEFAULT 0,
+    deaths           BIGINT      NOT NULL DEFAULT 0,
+    damage_dealt     BIGINT      NOT NULL DEFAULT 0,
+    rooms_explored   BIGINT      NOT NULL DEFAULT 0,
+    playtime_seconds BIGINT      NOT NULL DEFAULT 0,
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
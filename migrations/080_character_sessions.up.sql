@@ -0,0 +1,10 @@
+-- character_sessions records each login. ended_at is advanced periodically while
+-- the session is open and set at logout, so a crash loses at most one interval.
+CREATE TABLE IF NOT EXISTS character_sessions (
+    id           BIGSERIAL   PRIMARY KEY,
+    character_id BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    started_at   TIMESTAMPTZ NOT NULL,
+    ended_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS character_sessions_character_id_idx ON character_sessions (character_id);
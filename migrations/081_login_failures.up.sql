@@ -0,0 +1,8 @@
+-- login_failures holds failed-login history per account ("account:<name>") or
+-- source address ("ip:<host>") so lockouts survive frontend restarts.
+CREATE TABLE IF NOT EXISTS login_failures (
+    key             TEXT        PRIMARY KEY,
+    failures        INTEGER     NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMPTZ,
+    last_failure_at TIMESTAMPTZ NOT NULL
+);
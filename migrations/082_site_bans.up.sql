@@ -0,0 +1,9 @@
+-- site_bans holds operator allow/deny rules over client address ranges. The
+-- most specific rule covering an address decides whether it may connect.
+CREATE TABLE IF NOT EXISTS site_bans (
+    cidr       CIDR        PRIMARY KEY,
+    allow      BOOLEAN     NOT NULL DEFAULT FALSE,
+    reason     TEXT        NOT NULL DEFAULT '',
+    created_by TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
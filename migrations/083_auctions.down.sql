@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auction_claims;
+DROP TABLE IF EXISTS auction_listings;
@@ -0,0 +1,23 @@
+-- auction_listings holds the open lots on the server-wide auction house.
+CREATE TABLE IF NOT EXISTS auction_listings (
+    id           BIGINT      PRIMARY KEY,
+    seller_id    BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    seller_name  TEXT        NOT NULL,
+    item_id      TEXT        NOT NULL,
+    quantity     INTEGER     NOT NULL CHECK (quantity > 0),
+    price        INTEGER     NOT NULL CHECK (price > 0),
+    listed_at    TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS auction_listings_seller_idx ON auction_listings (seller_id);
+
+-- auction_claims holds sale proceeds and returned lots escrowed until their owner collects them.
+CREATE TABLE IF NOT EXISTS auction_claims (
+    id           BIGINT  PRIMARY KEY,
+    character_id BIGINT  NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    credits      INTEGER NOT NULL DEFAULT 0,
+    item_id      TEXT    NOT NULL DEFAULT '',
+    quantity     INTEGER NOT NULL DEFAULT 0,
+    note         TEXT    NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS auction_claims_character_idx ON auction_claims (character_id);
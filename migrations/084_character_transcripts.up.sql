@@ -0,0 +1,11 @@
+-- character_transcripts holds session logs recorded with the log command.
+CREATE TABLE IF NOT EXISTS character_transcripts (
+    id           BIGSERIAL   PRIMARY KEY,
+    character_id BIGINT      NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    char_name    TEXT        NOT NULL,
+    started_at   TIMESTAMPTZ NOT NULL,
+    ended_at     TIMESTAMPTZ NOT NULL,
+    body         TEXT        NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS character_transcripts_character_id_idx ON character_transcripts (character_id);
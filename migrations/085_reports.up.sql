@@ -0,0 +1,15 @@
+-- reports holds player bug, typo, and idea reports awaiting staff review.
+-- character_id is cleared rather than cascaded so reports outlive the character.
+CREATE TABLE IF NOT EXISTS reports (
+    id           BIGSERIAL   PRIMARY KEY,
+    kind         TEXT        NOT NULL CHECK (kind IN ('bug', 'typo', 'idea')),
+    character_id BIGINT      REFERENCES characters(id) ON DELETE SET NULL,
+    char_name    TEXT        NOT NULL,
+    room_id      TEXT        NOT NULL DEFAULT '',
+    body         TEXT        NOT NULL,
+    context      TEXT        NOT NULL DEFAULT '',
+    closed_by    TEXT        NOT NULL DEFAULT '',
+    closed_at    TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_reports_open ON reports (created_at DESC) WHERE closed_at IS NULL;
@@ -0,0 +1,7 @@
+-- migrations/086_item_affixes.down.sql
+
+ALTER TABLE character_equipment
+    DROP COLUMN affixes;
+
+ALTER TABLE character_weapon_presets
+    DROP COLUMN affixes;
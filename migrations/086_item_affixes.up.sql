@@ -0,0 +1,7 @@
+-- migrations/086_item_affixes.up.sql
+
+ALTER TABLE character_equipment
+    ADD COLUMN affixes text[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE character_weapon_presets
+    ADD COLUMN affixes text[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,24 @@
+-- migrations/087_inventory_metadata.down.sql
+
+-- Collapse per-instance rows back to one row per def ID; instance state is lost.
+UPDATE character_inventory ci
+SET quantity = totals.quantity
+FROM (
+    SELECT MIN(id) AS id, SUM(quantity) AS quantity
+    FROM character_inventory
+    GROUP BY character_id, item_def_id
+) totals
+WHERE ci.id = totals.id;
+
+DELETE FROM character_inventory ci
+WHERE ci.id NOT IN (
+    SELECT MIN(id) FROM character_inventory GROUP BY character_id, item_def_id
+);
+
+DROP INDEX character_inventory_character_id_idx;
+
+ALTER TABLE character_inventory
+    DROP COLUMN metadata,
+    DROP COLUMN id;
+
+ALTER TABLE character_inventory ADD PRIMARY KEY (character_id, item_def_id);
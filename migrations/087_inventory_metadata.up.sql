@@ -0,0 +1,11 @@
+-- migrations/087_inventory_metadata.up.sql
+
+-- Items carrying instance state (durability, affixes, charges, ...) are
+-- stored one row per instance, so a def ID may appear in several rows.
+ALTER TABLE character_inventory DROP CONSTRAINT character_inventory_pkey;
+
+ALTER TABLE character_inventory
+    ADD COLUMN id       BIGSERIAL PRIMARY KEY,
+    ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX character_inventory_character_id_idx ON character_inventory (character_id);
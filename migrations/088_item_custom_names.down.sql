@@ -0,0 +1,7 @@
+-- migrations/088_item_custom_names.down.sql
+
+ALTER TABLE character_equipment
+    DROP COLUMN custom_name;
+
+ALTER TABLE character_weapon_presets
+    DROP COLUMN custom_name;
@@ -0,0 +1,7 @@
+-- migrations/088_item_custom_names.up.sql
+
+ALTER TABLE character_equipment
+    ADD COLUMN custom_name text NOT NULL DEFAULT '';
+
+ALTER TABLE character_weapon_presets
+    ADD COLUMN custom_name text NOT NULL DEFAULT '';
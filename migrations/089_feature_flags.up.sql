@@ -0,0 +1,19 @@
+-- feature_flags holds admin overrides of the feature flags declared in the
+-- server config, applying to every account.
+CREATE TABLE IF NOT EXISTS feature_flags (
+    flag    TEXT        PRIMARY KEY,
+    enabled BOOLEAN     NOT NULL,
+    set_by  TEXT        NOT NULL DEFAULT '',
+    set_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- account_feature_flags holds per-account overrides, which win over both the
+-- configured default and the global override.
+CREATE TABLE IF NOT EXISTS account_feature_flags (
+    account_id BIGINT      NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    flag       TEXT        NOT NULL,
+    enabled    BOOLEAN     NOT NULL,
+    set_by     TEXT        NOT NULL DEFAULT '',
+    set_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (account_id, flag)
+);
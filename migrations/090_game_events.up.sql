@@ -0,0 +1,19 @@
+-- game_events holds exported telemetry events (see internal/telemetry). It
+-- has no foreign keys so the history survives characters being deleted.
+CREATE TABLE IF NOT EXISTS game_events (
+    id           BIGSERIAL   PRIMARY KEY,
+    type         TEXT        NOT NULL,
+    occurred_at  TIMESTAMPTZ NOT NULL,
+    character_id BIGINT      NOT NULL DEFAULT 0,
+    character    TEXT        NOT NULL DEFAULT '',
+    zone         TEXT        NOT NULL DEFAULT '',
+    room         TEXT        NOT NULL DEFAULT '',
+    target       TEXT        NOT NULL DEFAULT '',
+    counterparty TEXT        NOT NULL DEFAULT '',
+    quantity     INT         NOT NULL DEFAULT 0,
+    amount       BIGINT      NOT NULL DEFAULT 0,
+    source       TEXT        NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS game_events_type_time_idx ON game_events (type, occurred_at);
+CREATE INDEX IF NOT EXISTS game_events_character_idx ON game_events (character_id, occurred_at);
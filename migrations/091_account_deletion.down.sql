@@ -0,0 +1,20 @@
+ALTER TABLE character_quest_progress
+    DROP CONSTRAINT IF EXISTS character_quest_progress_character_id_fkey,
+    ADD CONSTRAINT character_quest_progress_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id);
+ALTER TABLE character_quests
+    DROP CONSTRAINT IF EXISTS character_quests_character_id_fkey,
+    ADD CONSTRAINT character_quests_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id);
+ALTER TABLE character_faction_rep
+    DROP CONSTRAINT IF EXISTS character_faction_rep_character_id_fkey,
+    ADD CONSTRAINT character_faction_rep_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id);
+ALTER TABLE character_inventory_instances
+    DROP CONSTRAINT IF EXISTS character_inventory_instances_character_id_fkey,
+    ADD CONSTRAINT character_inventory_instances_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id);
+
+DROP INDEX IF EXISTS accounts_deletion_requested_idx;
+ALTER TABLE accounts DROP COLUMN IF EXISTS purged_at;
+ALTER TABLE accounts DROP COLUMN IF EXISTS deletion_requested_at;
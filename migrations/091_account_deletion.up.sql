@@ -0,0 +1,26 @@
+-- deletion_requested_at marks an account its owner asked to delete; it is
+-- purged once the grace period elapses unless the owner cancels first.
+-- purged_at marks an account whose personal data was anonymized in place.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS deletion_requested_at TIMESTAMPTZ;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS purged_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS accounts_deletion_requested_idx ON accounts (deletion_requested_at)
+    WHERE deletion_requested_at IS NOT NULL;
+
+-- These character tables predate cascading deletes and would otherwise block
+-- removing a character that has rows in them.
+ALTER TABLE character_inventory_instances
+    DROP CONSTRAINT IF EXISTS character_inventory_instances_character_id_fkey,
+    ADD CONSTRAINT character_inventory_instances_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+ALTER TABLE character_faction_rep
+    DROP CONSTRAINT IF EXISTS character_faction_rep_character_id_fkey,
+    ADD CONSTRAINT character_faction_rep_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+ALTER TABLE character_quests
+    DROP CONSTRAINT IF EXISTS character_quests_character_id_fkey,
+    ADD CONSTRAINT character_quests_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
+ALTER TABLE character_quest_progress
+    DROP CONSTRAINT IF EXISTS character_quest_progress_character_id_fkey,
+    ADD CONSTRAINT character_quest_progress_character_id_fkey
+        FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE;
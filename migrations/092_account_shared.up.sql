@@ -0,0 +1,16 @@
+-- account_stash holds the credits every character on an account can move in
+-- and out of their own stash.
+CREATE TABLE IF NOT EXISTS account_stash (
+    account_id BIGINT  PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    balance    INTEGER NOT NULL DEFAULT 0 CHECK (balance >= 0)
+);
+
+-- account_achievements holds progress toward, and unlocks of, account-wide
+-- achievements shared by all of an account's characters.
+CREATE TABLE IF NOT EXISTS account_achievements (
+    account_id     BIGINT      NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    achievement_id VARCHAR(64) NOT NULL,
+    progress       INTEGER     NOT NULL DEFAULT 0,
+    unlocked_at    TIMESTAMPTZ,
+    PRIMARY KEY (account_id, achievement_id)
+);
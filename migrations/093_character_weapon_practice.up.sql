@@ -0,0 +1,8 @@
+-- character_weapon_practice holds the practice points a character has earned
+-- with each weapon proficiency category by fighting with it.
+CREATE TABLE IF NOT EXISTS character_weapon_practice (
+    character_id BIGINT  NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    category     TEXT    NOT NULL,
+    points       INTEGER NOT NULL DEFAULT 0 CHECK (points >= 0),
+    PRIMARY KEY (character_id, category)
+);
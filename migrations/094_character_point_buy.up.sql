@@ -0,0 +1,8 @@
+-- character_point_buy holds the point-buy adjustments a character has made to
+-- their ability scores, applied on top of region, job, and boost scores.
+CREATE TABLE IF NOT EXISTS character_point_buy (
+    character_id BIGINT  NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    ability      TEXT    NOT NULL,
+    delta        INTEGER NOT NULL CHECK (delta <> 0),
+    PRIMARY KEY (character_id, ability)
+);
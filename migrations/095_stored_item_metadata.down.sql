@@ -0,0 +1,3 @@
+ALTER TABLE player_housing DROP COLUMN IF EXISTS stored_instances;
+ALTER TABLE auction_claims DROP COLUMN IF EXISTS metadata;
+ALTER TABLE auction_listings DROP COLUMN IF EXISTS metadata;
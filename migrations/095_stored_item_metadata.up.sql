@@ -0,0 +1,8 @@
+-- Auction lots and home storage keep the instance state (wear, affixes, an
+-- engraved name) of the items they hold, in the same form as character_inventory.metadata.
+ALTER TABLE auction_listings ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE auction_claims ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+-- stored_instances holds stored items carrying instance state, one entry per
+-- instance; plain items stay counted in storage.
+ALTER TABLE player_housing ADD COLUMN IF NOT EXISTS stored_instances JSONB NOT NULL DEFAULT '[]';